@@ -14,8 +14,19 @@
 # See the License for the specific language governing permissions and
 # limitations under the License.
 
-# Setup the Vschema Folder
-/vt/setup_vschema_folder.sh "$KEYSPACES" "$NUM_SHARDS"
+# The topology is either read from $TOPOLOGY_FILE, which can define the
+# vschema and the seed files of each keyspace and the routing rules, or built
+# from $KEYSPACES and $NUM_SHARDS.
+if [[ -n $TOPOLOGY_FILE ]]; then
+  TOPOLOGY_ARGS=(-topology_file "$TOPOLOGY_FILE")
+  if [[ -n $SCHEMA_DIR ]]; then
+    TOPOLOGY_ARGS+=(-schema_dir "$SCHEMA_DIR")
+  fi
+else
+  # Setup the Vschema Folder
+  /vt/setup_vschema_folder.sh "$KEYSPACES" "$NUM_SHARDS"
+  TOPOLOGY_ARGS=(-keyspaces "$KEYSPACES" -num_shards "$NUM_SHARDS" -schema_dir="/vt/schema/")
+fi
 
 # Set the maximum connections in the cnf file
 # use 1000 as the default if it is unspecified
@@ -32,14 +43,12 @@ rm -vf "$VTDATAROOT"/"$tablet_dir"/{mysql.sock,mysql.sock.lock}
 # Run the vttestserver binary
 /vt/bin/vttestserver \
 	-port "$PORT" \
-	-keyspaces "$KEYSPACES" \
-	-num_shards "$NUM_SHARDS" \
+	"${TOPOLOGY_ARGS[@]}" \
 	-mysql_bind_host "${MYSQL_BIND_HOST:-127.0.0.1}" \
 	-mysql_server_version "${MYSQL_SERVER_VERSION:-$1}" \
 	-charset "${CHARSET:-utf8mb4}" \
 	-foreign_key_mode "${FOREIGN_KEY_MODE:-allow}" \
 	-enable_online_ddl="${ENABLE_ONLINE_DDL:-true}" \
 	-enable_direct_ddl="${ENABLE_DIRECT_DDL:-true}" \
-	-vschema_ddl_authorized_users=% \
-	-schema_dir="/vt/schema/"
+	-vschema_ddl_authorized_users=%
 
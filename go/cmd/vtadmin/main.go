@@ -159,6 +159,13 @@ func main() {
 			"address for a tablet. Currently used to make passthrough "+
 			"requests to /debug/vars endpoints.",
 	)
+	rootCmd.Flags().StringVar(&httpOpts.ExperimentalOptions.VTGateURLTmpl,
+		"http-vtgate-url-tmpl",
+		"https://{{ .Hostname }}:80",
+		"[EXPERIMENTAL] Go template string to generate a reachable http(s) "+
+			"address for a vtgate. Currently used to make passthrough "+
+			"requests to /debug/vars endpoints.",
+	)
 
 	// rbac flags
 	rootCmd.Flags().StringVar(&rbacConfigPath, "rbac-config", "rbac.yaml", "")
@@ -0,0 +1,118 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"net/http"
+	"time"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/log"
+)
+
+var (
+	successWebhookURL = flag.String("success_webhook_url", "", "If set, POST a JSON summary of the run to this URL when vtbackup succeeds.")
+	failureWebhookURL = flag.String("failure_webhook_url", "", "If set, POST a JSON summary of the run to this URL when vtbackup fails.")
+	webhookTimeout    = flag.Duration("webhook_timeout", 30*time.Second, "Timeout for calling the success_webhook_url or failure_webhook_url.")
+
+	runDurationSeconds = stats.NewGauge("RunDurationSeconds", "How long this run of vtbackup took, in seconds")
+	runSucceeded       = stats.NewGauge("RunSucceeded", "1 if this run of vtbackup succeeded, 0 otherwise")
+	backupsTaken       = stats.NewCountersWithSingleLabel("BackupsTaken", "Number of backups taken by this run of vtbackup, by type", "Type")
+)
+
+// runResult is the summary of a run of vtbackup, as sent to the webhooks.
+type runResult struct {
+	Keyspace        string  `json:"keyspace"`
+	Shard           string  `json:"shard"`
+	BackupTaken     bool    `json:"backup_taken"`
+	Incremental     bool    `json:"incremental"`
+	Success         bool    `json:"success"`
+	Error           string  `json:"error,omitempty"`
+	DurationSeconds float64 `json:"duration_seconds"`
+}
+
+// finish records the outcome of the run, in the result and in the stats.
+func (r *runResult) finish(duration time.Duration, err error) {
+	r.DurationSeconds = duration.Seconds()
+	r.Success = err == nil
+	if err != nil {
+		r.Error = err.Error()
+	}
+
+	runDurationSeconds.Set(int64(duration.Seconds()))
+	if r.Success {
+		runSucceeded.Set(1)
+		if r.BackupTaken {
+			backupType := "full"
+			if r.Incremental {
+				backupType = "incremental"
+			}
+			backupsTaken.Add(backupType, 1)
+		}
+	} else {
+		runSucceeded.Set(0)
+	}
+}
+
+// notifyCompletion pushes the stats of the run and calls the webhook for its
+// outcome, if any. Failures are logged, but do not fail the run: it is up to
+// the monitoring to notice missing notifications.
+func notifyCompletion(r *runResult) {
+	if err := stats.PushAll(); err != nil {
+		log.Warningf("Failed to push stats: %v", err)
+	}
+
+	url := *successWebhookURL
+	if !r.Success {
+		url = *failureWebhookURL
+	}
+	if url == "" {
+		return
+	}
+	// Notify even if the run was cancelled, that's when it matters.
+	ctx, cancel := context.WithTimeout(context.Background(), *webhookTimeout)
+	defer cancel()
+	if err := callWebhook(ctx, url, r); err != nil {
+		log.Warningf("Failed to call webhook %v: %v", url, err)
+	}
+}
+
+func callWebhook(ctx context.Context, url string, r *runResult) error {
+	body, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status %v", resp.Status)
+	}
+	return nil
+}
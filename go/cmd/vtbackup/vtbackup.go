@@ -50,10 +50,25 @@ query traffic will ever be routed to vtbackup's mysqld. This silent operation
 mode helps make backups minimally disruptive to serving capacity and orthogonal
 to the handling of the query path.
 
+With -incremental, if the most recent full backup is recent enough, vtbackup
+instead skips the restore and streams the binary logs of the primary since the
+last backup into an incremental backup, which is much cheaper to take. A full
+backup is taken whenever there is no recent full backup to build upon.
+
+With -archive_binlogs, vtbackup also archives the binary logs of the primary
+since the end of the binlog archive of the shard to the backup storage, from
+which tablets restore to a point in time and serve the transactions purged
+from the binary logs of the mysqlds of the shard.
+
 The command-line parameters to vtbackup specify a policy for when a new backup
 is needed, and when old backups should be removed. If the existing backups
 already satisfy the policy, then vtbackup will do nothing and return success
 immediately.
+
+When it is done, vtbackup pushes its stats to the configured stats backend, and
+calls the -success_webhook_url or -failure_webhook_url with a JSON summary of
+the run. This makes it easy to monitor vtbackup when it runs as a Kubernetes
+CronJob, or under any other scheduler.
 */
 package main
 
@@ -107,20 +122,28 @@ var (
 	_ = flag.Duration("timeout", 2*time.Hour, "DEPRECATED AND UNUSED")
 	_ = flag.Duration("replication_timeout", 1*time.Hour, "DEPRECATED AND UNUSED")
 
-	minBackupInterval = flag.Duration("min_backup_interval", 0, "Only take a new backup if it's been at least this long since the most recent backup.")
-	minRetentionTime  = flag.Duration("min_retention_time", 0, "Keep each old backup for at least this long before removing it. Set to 0 to disable pruning of old backups.")
-	minRetentionCount = flag.Int("min_retention_count", 1, "Always keep at least this many of the most recent backups in this backup storage location, even if some are older than the min_retention_time. This must be at least 1 since a backup must always exist to allow new backups to be made")
+	minBackupInterval  = flag.Duration("min_backup_interval", 0, "Only take a new backup if it's been at least this long since the most recent backup.")
+	minRetentionTime   = flag.Duration("min_retention_time", 0, "Keep each old backup for at least this long before removing it. Set to 0, along with min_retention_daily and min_retention_weekly, to disable pruning of old backups.")
+	minRetentionCount  = flag.Int("min_retention_count", 1, "Always keep at least this many of the most recent backups in this backup storage location, even if some are older than the min_retention_time. This must be at least 1 since a backup must always exist to allow new backups to be made")
+	minRetentionDaily  = flag.Int("min_retention_daily", 0, "Keep the most recent backup of each of the last N days that have a backup, even if it is older than the min_retention_time.")
+	minRetentionWeekly = flag.Int("min_retention_weekly", 0, "Keep the most recent backup of each of the last N weeks that have a backup, even if it is older than the min_retention_time.")
+	pruneDryRun        = flag.Bool("prune_dry_run", false, "Only log the old backups that would be removed by the retention policy, without removing them.")
 
 	initialBackup    = flag.Bool("initial_backup", false, "Instead of restoring from backup, initialize an empty database with the provided init_db_sql_file and upload a backup of that for the shard, if the shard has no backups yet. This can be used to seed a brand new shard with an initial, empty backup. If any backups already exist for the shard, this will be considered a successful no-op. This can only be done before the shard exists in topology (i.e. before any tablets are deployed).")
 	allowFirstBackup = flag.Bool("allow_first_backup", false, "Allow this job to take the first backup of an existing shard.")
 
+	incrementalBackup     = flag.Bool("incremental", false, "Take an incremental backup of the binary logs of the primary since the last backup, without restoring it first, if there is a recent enough full backup to apply it to. Otherwise, a full backup is taken.")
+	incrementalMaxBaseAge = flag.Duration("incremental_max_base_age", 24*time.Hour, "With -incremental, take a full backup rather than an incremental one if the last full backup is older than this.")
+	archiveBinlogs        = flag.Bool("archive_binlogs", false, "Also archive the binary logs of the primary since the end of the binlog archive of the shard to the backup storage.")
+
 	restartBeforeBackup = flag.Bool("restart_before_backup", false, "Perform a mysqld clean/full restart after applying binlogs, but before taking the backup. Only makes sense to work around xtrabackup bugs.")
 
 	// vttablet-like flags
-	initDbNameOverride = flag.String("init_db_name_override", "", "(init parameter) override the name of the db used by vttablet")
-	initKeyspace       = flag.String("init_keyspace", "", "(init parameter) keyspace to use for this tablet")
-	initShard          = flag.String("init_shard", "", "(init parameter) shard to use for this tablet")
-	concurrency        = flag.Int("concurrency", 4, "(init restore parameter) how many concurrent files to restore at once")
+	initDbNameOverride  = flag.String("init_db_name_override", "", "(init parameter) override the name of the db used by vttablet")
+	initKeyspace        = flag.String("init_keyspace", "", "(init parameter) keyspace to use for this tablet")
+	initShard           = flag.String("init_shard", "", "(init parameter) shard to use for this tablet")
+	concurrency         = flag.Int("concurrency", 4, "(init restore parameter) how many concurrent files to restore at once")
+	restoreMaxBandwidth = flag.Int64("restore_max_bandwidth", 0, "(init restore parameter) if greater than 0, the maximum number of bytes per second to read from the backup storage while restoring, across all the concurrent files")
 
 	// mysqlctld-like flags
 	mysqlPort     = flag.Int("mysql_port", 3306, "mysql port")
@@ -136,6 +159,7 @@ func main() {
 	mysqlctl.RegisterFlags()
 
 	servenv.ParseFlags("vtbackup")
+	servenv.FireRunHooks()
 
 	if *detachedMode {
 		// this method will call os.Exit and kill this process
@@ -170,42 +194,61 @@ func main() {
 	topoServer := topo.Open()
 	defer topoServer.Close()
 
+	startTime := time.Now()
+	result := &runResult{
+		Keyspace: *initKeyspace,
+		Shard:    *initShard,
+	}
+	err = run(ctx, topoServer, backupStorage, result)
+	result.finish(time.Since(startTime), err)
+	notifyCompletion(result)
+	if err != nil {
+		log.Error(err)
+		exit.Return(1)
+	}
+}
+
+func run(ctx context.Context, topoServer *topo.Server, backupStorage backupstorage.BackupStorage, result *runResult) error {
 	// Try to take a backup, if it's been long enough since the last one.
 	// Skip pruning if backup wasn't fully successful. We don't want to be
 	// deleting things if the backup process is not healthy.
 	backupDir := mysqlctl.GetBackupDir(*initKeyspace, *initShard)
 	doBackup, err := shouldBackup(ctx, topoServer, backupStorage, backupDir)
 	if err != nil {
-		log.Errorf("Can't take backup: %v", err)
-		exit.Return(1)
+		return fmt.Errorf("can't take backup: %v", err)
 	}
 	if doBackup {
-		if err := takeBackup(ctx, topoServer, backupStorage); err != nil {
-			log.Errorf("Failed to take backup: %v", err)
-			exit.Return(1)
+		result.BackupTaken = true
+		if *incrementalBackup && !*initialBackup {
+			result.Incremental, err = takeIncrementalBackup(ctx, topoServer, backupStorage, backupDir)
+			if err != nil {
+				return fmt.Errorf("failed to take incremental backup: %v", err)
+			}
+		}
+		if !result.Incremental {
+			if err := takeBackup(ctx, topoServer, backupStorage); err != nil {
+				return fmt.Errorf("failed to take backup: %v", err)
+			}
+		}
+	}
+
+	if *archiveBinlogs {
+		if err := archivePrimaryBinlogs(ctx, topoServer, backupStorage); err != nil {
+			return fmt.Errorf("failed to archive binlogs: %v", err)
 		}
 	}
 
 	// Prune old backups.
 	if err := pruneBackups(ctx, backupStorage, backupDir); err != nil {
-		log.Errorf("Couldn't prune old backups: %v", err)
-		exit.Return(1)
+		return fmt.Errorf("couldn't prune old backups: %v", err)
 	}
+	return nil
 }
 
 func takeBackup(ctx context.Context, topoServer *topo.Server, backupStorage backupstorage.BackupStorage) error {
-	// This is an imaginary tablet alias. The value doesn't matter for anything,
-	// except that we generate a random UID to ensure the target backup
-	// directory is unique if multiple vtbackup instances are launched for the
-	// same shard, at exactly the same second, pointed at the same backup
-	// storage location.
-	bigN, err := rand.Int(rand.Reader, big.NewInt(math.MaxUint32))
+	tabletAlias, err := newTabletAlias()
 	if err != nil {
-		return fmt.Errorf("can't generate random tablet UID: %v", err)
-	}
-	tabletAlias := &topodatapb.TabletAlias{
-		Cell: "vtbackup",
-		Uid:  uint32(bigN.Uint64()),
+		return err
 	}
 
 	// Clean up our temporary data dir if we exit for any reason, to make sure
@@ -290,6 +333,7 @@ func takeBackup(ctx context.Context, topoServer *topo.Server, backupStorage back
 		Mysqld:              mysqld,
 		Logger:              logutil.NewConsoleLogger(),
 		Concurrency:         *concurrency,
+		MaxBandwidth:        *restoreMaxBandwidth,
 		HookExtraEnv:        extraEnv,
 		LocalMetadata:       map[string]string{},
 		DeleteBeforeRestore: true,
@@ -337,7 +381,7 @@ func takeBackup(ctx context.Context, topoServer *topo.Server, backupStorage back
 		// Add a per-operation timeout so we re-read topo if the primary is unreachable.
 		opCtx, cancel := context.WithTimeout(ctx, operationTimeout)
 		defer cancel()
-		pos, err := getPrimaryPosition(opCtx, tmc, topoServer)
+		_, pos, err := getPrimaryPosition(opCtx, tmc, topoServer)
 		if err != nil {
 			return fmt.Errorf("can't get the primary replication position: %v", err)
 		}
@@ -426,6 +470,157 @@ func takeBackup(ctx context.Context, topoServer *topo.Server, backupStorage back
 	return nil
 }
 
+// takeIncrementalBackup backs up the transactions of the primary since the
+// last backup, by streaming its binary logs. It returns false, without taking
+// any backup, if there is no recent enough base for an incremental backup, or
+// if the primary has no transaction since the last backup, in which case a
+// full backup should be taken instead.
+func takeIncrementalBackup(ctx context.Context, topoServer *topo.Server, backupStorage backupstorage.BackupStorage, backupDir string) (bool, error) {
+	backups, err := backupStorage.ListBackups(ctx, backupDir)
+	if err != nil {
+		return false, fmt.Errorf("can't list backups: %v", err)
+	}
+	basePos, ok := mysqlctl.FindIncrementalBackupBase(ctx, logutil.NewConsoleLogger(), backups, *incrementalMaxBaseAge)
+	if !ok {
+		log.Infof("No base for an incremental backup more recent than %v, taking a full backup instead.", *incrementalMaxBaseAge)
+		return false, nil
+	}
+
+	tmc := tmclient.NewTabletManagerClient()
+	defer tmc.Close()
+	var (
+		primary    *topodatapb.Tablet
+		primaryPos mysql.Position
+	)
+	err = retryOnError(ctx, func() error {
+		opCtx, cancel := context.WithTimeout(ctx, operationTimeout)
+		defer cancel()
+		tablet, pos, err := getPrimaryPosition(opCtx, tmc, topoServer)
+		if err != nil {
+			return fmt.Errorf("can't get the primary replication position: %v", err)
+		}
+		primary, primaryPos = tablet, pos
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+	backupTime := time.Now()
+
+	if basePos.AtLeast(primaryPos) {
+		// An incremental backup would be empty, and the shard would still be
+		// due for a backup on the next run.
+		log.Infof("The last backup at %v is up to date with the primary at %v, taking a full backup instead.", basePos, primaryPos)
+		return false, nil
+	}
+
+	connector, err := primaryReplConnector(primary)
+	if err != nil {
+		return false, err
+	}
+	tabletAlias, err := newTabletAlias()
+	if err != nil {
+		return false, err
+	}
+	_, err = mysqlctl.IncrementalBackup(ctx, mysqlctl.IncrementalBackupParams{
+		Logger:       logutil.NewConsoleLogger(),
+		Connector:    connector,
+		Keyspace:     *initKeyspace,
+		Shard:        *initShard,
+		TabletAlias:  topoproto.TabletAliasString(tabletAlias),
+		BackupTime:   backupTime,
+		FromPosition: basePos,
+		ToPosition:   primaryPos,
+	})
+	if err != nil {
+		return false, err
+	}
+	log.Info("Incremental backup successful.")
+	return true, nil
+}
+
+// archivePrimaryBinlogs archives the binary logs of the primary since the end
+// of the binlog archive of the shard, or since the last backup if the archive
+// is empty.
+func archivePrimaryBinlogs(ctx context.Context, topoServer *topo.Server, backupStorage backupstorage.BackupStorage) error {
+	tmc := tmclient.NewTabletManagerClient()
+	defer tmc.Close()
+	var (
+		primary    *topodatapb.Tablet
+		primaryPos mysql.Position
+	)
+	err := retryOnError(ctx, func() error {
+		opCtx, cancel := context.WithTimeout(ctx, operationTimeout)
+		defer cancel()
+		tablet, pos, err := getPrimaryPosition(opCtx, tmc, topoServer)
+		if err != nil {
+			return fmt.Errorf("can't get the primary replication position: %v", err)
+		}
+		primary, primaryPos = tablet, pos
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	connector, err := primaryReplConnector(primary)
+	if err != nil {
+		return err
+	}
+	tabletAlias, err := newTabletAlias()
+	if err != nil {
+		return err
+	}
+	bm, err := mysqlctl.ArchiveBinlogs(ctx, backupStorage, mysqlctl.ArchiveBinlogsParams{
+		Logger:      logutil.NewConsoleLogger(),
+		Connector:   connector,
+		Keyspace:    *initKeyspace,
+		Shard:       *initShard,
+		TabletAlias: topoproto.TabletAliasString(tabletAlias),
+		ToPosition:  primaryPos,
+	})
+	if err != nil {
+		return err
+	}
+	if bm == nil {
+		log.Infof("The binlog archive is up to date with the primary at %v.", primaryPos)
+		return nil
+	}
+	log.Infof("Archived the binlogs of the primary from %v to %v.", bm.FromPosition, bm.Position)
+	return nil
+}
+
+// primaryReplConnector returns a connector to read the binary logs of the
+// primary as a replica would.
+func primaryReplConnector(primary *topodatapb.Tablet) (dbconfigs.Connector, error) {
+	dbconfigs.GlobalDBConfigs.InitWithSocket("")
+	replParams, err := dbconfigs.GlobalDBConfigs.ReplConnector().MysqlParams()
+	if err != nil {
+		return dbconfigs.Connector{}, fmt.Errorf("can't get replication connection parameters: %v", err)
+	}
+	primaryParams := *replParams
+	primaryParams.Host = primary.MysqlHostname
+	primaryParams.Port = int(primary.MysqlPort)
+	primaryParams.UnixSocket = ""
+	return dbconfigs.New(&primaryParams), nil
+}
+
+// newTabletAlias returns the imaginary tablet alias of this vtbackup run. The
+// value doesn't matter for anything, except that we generate a random UID to
+// ensure the target backup directory is unique if multiple vtbackup instances
+// are launched for the same shard, at exactly the same second, pointed at the
+// same backup storage location.
+func newTabletAlias() (*topodatapb.TabletAlias, error) {
+	bigN, err := rand.Int(rand.Reader, big.NewInt(math.MaxUint32))
+	if err != nil {
+		return nil, fmt.Errorf("can't generate random tablet UID: %v", err)
+	}
+	return &topodatapb.TabletAlias{
+		Cell: "vtbackup",
+		Uid:  uint32(bigN.Uint64()),
+	}, nil
+}
+
 func resetReplication(ctx context.Context, pos mysql.Position, mysqld mysqlctl.MysqlDaemon) error {
 	cmds := []string{
 		"STOP SLAVE",
@@ -473,31 +668,31 @@ func startReplication(ctx context.Context, mysqld mysqlctl.MysqlDaemon, topoServ
 	return nil
 }
 
-func getPrimaryPosition(ctx context.Context, tmc tmclient.TabletManagerClient, ts *topo.Server) (mysql.Position, error) {
+func getPrimaryPosition(ctx context.Context, tmc tmclient.TabletManagerClient, ts *topo.Server) (*topodatapb.Tablet, mysql.Position, error) {
 	si, err := ts.GetShard(ctx, *initKeyspace, *initShard)
 	if err != nil {
-		return mysql.Position{}, vterrors.Wrap(err, "can't read shard")
+		return nil, mysql.Position{}, vterrors.Wrap(err, "can't read shard")
 	}
 	if topoproto.TabletAliasIsZero(si.PrimaryAlias) {
 		// Normal tablets will sit around waiting to be reparented in this case.
 		// Since vtbackup is a batch job, we just have to fail.
-		return mysql.Position{}, fmt.Errorf("shard %v/%v has no primary", *initKeyspace, *initShard)
+		return nil, mysql.Position{}, fmt.Errorf("shard %v/%v has no primary", *initKeyspace, *initShard)
 	}
 	ti, err := ts.GetTablet(ctx, si.PrimaryAlias)
 	if err != nil {
-		return mysql.Position{}, fmt.Errorf("can't get primary tablet record %v: %v", topoproto.TabletAliasString(si.PrimaryAlias), err)
+		return nil, mysql.Position{}, fmt.Errorf("can't get primary tablet record %v: %v", topoproto.TabletAliasString(si.PrimaryAlias), err)
 	}
 	// Use old RPC for backwards-compatibility
 	// TODO(deepthi): change to PrimaryPosition after v12.0
 	posStr, err := tmc.MasterPosition(ctx, ti.Tablet)
 	if err != nil {
-		return mysql.Position{}, fmt.Errorf("can't get primary replication position: %v", err)
+		return nil, mysql.Position{}, fmt.Errorf("can't get primary replication position: %v", err)
 	}
 	pos, err := mysql.DecodePosition(posStr)
 	if err != nil {
-		return mysql.Position{}, fmt.Errorf("can't decode primary replication position %q: %v", posStr, err)
+		return nil, mysql.Position{}, fmt.Errorf("can't decode primary replication position %q: %v", posStr, err)
 	}
-	return pos, nil
+	return ti.Tablet, pos, nil
 }
 
 // retryOnError keeps calling the given function until it succeeds, or the given
@@ -525,43 +720,24 @@ func retryOnError(ctx context.Context, fn func() error) error {
 }
 
 func pruneBackups(ctx context.Context, backupStorage backupstorage.BackupStorage, backupDir string) error {
-	if *minRetentionTime == 0 {
+	if *minRetentionTime == 0 && *minRetentionDaily == 0 && *minRetentionWeekly == 0 {
 		log.Info("Pruning of old backups is disabled.")
 		return nil
 	}
-	backups, err := backupStorage.ListBackups(ctx, backupDir)
-	if err != nil {
-		return fmt.Errorf("can't list backups: %v", err)
+	policy := backupstorage.RetentionPolicy{
+		KeepLast:   *minRetentionCount,
+		KeepDaily:  *minRetentionDaily,
+		KeepWeekly: *minRetentionWeekly,
+		MaxAge:     *minRetentionTime,
 	}
-	numBackups := len(backups)
-	if numBackups <= *minRetentionCount {
-		log.Infof("Found %v backups. Not pruning any since this is within the min_retention_count of %v.", numBackups, *minRetentionCount)
-		return nil
+	pruned, err := mysqlctl.PruneBackups(ctx, logutil.NewConsoleLogger(), backupStorage, *initKeyspace, *initShard, policy, *pruneDryRun)
+	if err != nil {
+		return err
 	}
-	// We have more than the minimum retention count, so we could afford to
-	// prune some. See if any are beyond the minimum retention time.
-	// ListBackups returns them sorted by oldest first.
-	for _, backup := range backups {
-		backupTime, err := parseBackupTime(backup.Name())
-		if err != nil {
-			return err
-		}
-		if time.Since(backupTime) < *minRetentionTime {
-			// The oldest remaining backup is not old enough to prune.
-			log.Infof("Oldest backup taken at %v has not reached min_retention_time of %v. Nothing left to prune.", backupTime, *minRetentionTime)
-			break
-		}
-		// Remove the backup.
-		log.Infof("Removing old backup %v from %v, since it's older than min_retention_time of %v", backup.Name(), backupDir, *minRetentionTime)
-		if err := backupStorage.RemoveBackup(ctx, backupDir, backup.Name()); err != nil {
-			return fmt.Errorf("couldn't remove backup %v from %v: %v", backup.Name(), backupDir, err)
-		}
-		// We successfully removed one backup. Can we afford to prune any more?
-		numBackups--
-		if numBackups == *minRetentionCount {
-			log.Infof("Successfully pruned backup count to min_retention_count of %v.", *minRetentionCount)
-			break
-		}
+	if *pruneDryRun {
+		log.Infof("Dry run: would have removed %v old backups from %v: %v", len(pruned), backupDir, pruned)
+	} else {
+		log.Infof("Removed %v old backups from %v", len(pruned), backupDir)
 	}
 	return nil
 }
@@ -19,12 +19,16 @@ package command
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"vitess.io/vitess/go/cmd/vtctldclient/cli"
+	"vitess.io/vitess/go/protoutil"
 	"vitess.io/vitess/go/vt/topo/topoproto"
 
+	mysqlctlpb "vitess.io/vitess/go/vt/proto/mysqlctl"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
 )
 
@@ -77,8 +81,172 @@ func commandGetBackups(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// GetRestoreProgress makes a GetRestoreProgress gRPC call to a vtctld.
+var GetRestoreProgress = &cobra.Command{
+	Use:   "GetRestoreProgress <tablet_alias>",
+	Short: "Outputs the progress of the restore from backup of a tablet, as JSON.",
+	Args:  cobra.ExactArgs(1),
+	RunE:  commandGetRestoreProgress,
+}
+
+func commandGetRestoreProgress(cmd *cobra.Command, args []string) error {
+	alias, err := topoproto.ParseTabletAlias(cmd.Flags().Arg(0))
+	if err != nil {
+		return err
+	}
+
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.GetRestoreProgress(commandCtx, &vtctldatapb.GetRestoreProgressRequest{
+		TabletAlias: alias,
+	})
+	if err != nil {
+		return err
+	}
+
+	data, err := cli.MarshalJSON(resp.Progress)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s\n", data)
+
+	return nil
+}
+
+// PruneBackups makes a PruneBackups gRPC call to a vtctld.
+var PruneBackups = &cobra.Command{
+	Use:   "PruneBackups [--keep-last <n>] [--keep-daily <n>] [--keep-weekly <n>] [--max-age <duration>] [--dry-run] <keyspace/shard>",
+	Short: "Removes the backups of a shard that are not kept by the given retention policy.",
+	Long: `Removes the backups of a shard that are not kept by the given retention policy.
+
+A backup is kept if it is one of the --keep-last most recent backups, the most recent backup of one of the last --keep-daily days
+or --keep-weekly weeks that have a backup, or if it is younger than --max-age. All the other backups are removed. The policy only
+counts the full backups: the incremental backups are kept or removed with the full backup they apply to.`,
+	Args: cobra.ExactArgs(1),
+	RunE: commandPruneBackups,
+}
+
+var pruneBackupsOptions = struct {
+	KeepLast   uint32
+	KeepDaily  uint32
+	KeepWeekly uint32
+	MaxAge     time.Duration
+	DryRun     bool
+}{}
+
+func commandPruneBackups(cmd *cobra.Command, args []string) error {
+	keyspace, shard, err := topoproto.ParseKeyspaceShard(cmd.Flags().Arg(0))
+	if err != nil {
+		return err
+	}
+
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.PruneBackups(commandCtx, &vtctldatapb.PruneBackupsRequest{
+		Keyspace:   keyspace,
+		Shard:      shard,
+		KeepLast:   pruneBackupsOptions.KeepLast,
+		KeepDaily:  pruneBackupsOptions.KeepDaily,
+		KeepWeekly: pruneBackupsOptions.KeepWeekly,
+		MaxAge:     protoutil.DurationToProto(pruneBackupsOptions.MaxAge),
+		DryRun:     pruneBackupsOptions.DryRun,
+	})
+	if err != nil {
+		return err
+	}
+
+	data, err := cli.MarshalJSON(resp)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s\n", data)
+
+	return nil
+}
+
+// VerifyBackup makes a VerifyBackup gRPC call to a vtctld.
+var VerifyBackup = &cobra.Command{
+	Use:   "VerifyBackup [--backup-name <name>] [--level {manifest|checksum|restore}] [--tablet-alias <alias>] <keyspace/shard>",
+	Short: "Verifies a backup of a shard, and records the outcome in the topo.",
+	Long: `Verifies a backup of a shard, by default the most recent one, and records the outcome in the topo.
+
+The manifest level checks that the backup manifest and all the files it lists can be read. The checksum level additionally checks
+the content of the files against the hashes in the manifest. The restore level restores the most recent full backup on the given scratch
+tablet, which must be a SPARE or DRAINED tablet of the shard.`,
+	Args: cobra.ExactArgs(1),
+	RunE: commandVerifyBackup,
+}
+
+var verifyBackupOptions = struct {
+	BackupName  string
+	Level       string
+	TabletAlias string
+}{}
+
+func commandVerifyBackup(cmd *cobra.Command, args []string) error {
+	keyspace, shard, err := topoproto.ParseKeyspaceShard(cmd.Flags().Arg(0))
+	if err != nil {
+		return err
+	}
+
+	level, ok := mysqlctlpb.BackupVerification_Level_value[strings.ToUpper(verifyBackupOptions.Level)]
+	if !ok {
+		return fmt.Errorf("invalid level %q", verifyBackupOptions.Level)
+	}
+
+	var tabletAlias *topodatapb.TabletAlias
+	if verifyBackupOptions.TabletAlias != "" {
+		tabletAlias, err = topoproto.ParseTabletAlias(verifyBackupOptions.TabletAlias)
+		if err != nil {
+			return err
+		}
+	}
+
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.VerifyBackup(commandCtx, &vtctldatapb.VerifyBackupRequest{
+		Keyspace:    keyspace,
+		Shard:       shard,
+		BackupName:  verifyBackupOptions.BackupName,
+		Level:       mysqlctlpb.BackupVerification_Level(level),
+		TabletAlias: tabletAlias,
+	})
+	if err != nil {
+		return err
+	}
+
+	data, err := cli.MarshalJSON(resp.Verification)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s\n", data)
+
+	if resp.Verification.Status != mysqlctlpb.BackupInfo_VALID {
+		return fmt.Errorf("backup %s failed verification: %s", resp.Verification.BackupName, resp.Verification.Error)
+	}
+
+	return nil
+}
+
 func init() {
 	GetBackups.Flags().Uint32VarP(&getBackupsOptions.Limit, "limit", "l", 0, "Retrieve only the most recent N backups")
 	GetBackups.Flags().BoolVarP(&getBackupsOptions.OutputJSON, "json", "j", false, "Output backup info in JSON format rather than a list of backups")
 	Root.AddCommand(GetBackups)
+
+	Root.AddCommand(GetRestoreProgress)
+
+	PruneBackups.Flags().Uint32Var(&pruneBackupsOptions.KeepLast, "keep-last", 1, "Number of most recent backups to keep, regardless of their age. Must be at least 1")
+	PruneBackups.Flags().Uint32Var(&pruneBackupsOptions.KeepDaily, "keep-daily", 0, "Keep the most recent backup of each of the last N days that have a backup")
+	PruneBackups.Flags().Uint32Var(&pruneBackupsOptions.KeepWeekly, "keep-weekly", 0, "Keep the most recent backup of each of the last N weeks that have a backup")
+	PruneBackups.Flags().DurationVar(&pruneBackupsOptions.MaxAge, "max-age", 0, "Keep all the backups younger than this. 0 disables age-based retention")
+	PruneBackups.Flags().BoolVar(&pruneBackupsOptions.DryRun, "dry-run", false, "Only list the backups that would be removed, without removing them")
+	Root.AddCommand(PruneBackups)
+
+	VerifyBackup.Flags().StringVar(&verifyBackupOptions.BackupName, "backup-name", "", "Name of the backup to verify. Defaults to the most recent backup of the shard")
+	VerifyBackup.Flags().StringVar(&verifyBackupOptions.Level, "level", "manifest", "Verification level: manifest, checksum or restore")
+	VerifyBackup.Flags().StringVar(&verifyBackupOptions.TabletAlias, "tablet-alias", "", "Scratch tablet to restore the backup on, required at the restore level")
+	Root.AddCommand(VerifyBackup)
 }
@@ -0,0 +1,99 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package command
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/spf13/cobra"
+
+	"vitess.io/vitess/go/cmd/vtctldclient/cli"
+
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+var (
+	// ExplainQuery makes an ExplainQuery gRPC call to a vtctld.
+	ExplainQuery = &cobra.Command{
+		Use:                   "ExplainQuery {--sql SQL | --sql-file SQL_FILE} [--cell CELL] [--target TARGET] [--normalize] [--execution-mode {multi|twopc}] [--output-mode {text|json}]",
+		Short:                 "Explains how the queries would be routed and executed in the cluster, with its current vschema, shards and schema, without executing them.",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.NoArgs,
+		RunE:                  commandExplainQuery,
+	}
+)
+
+var explainQueryOptions = struct {
+	SQL           string
+	SQLFilePath   string
+	Cell          string
+	Target        string
+	Normalize     bool
+	ExecutionMode string
+	OutputMode    string
+}{}
+
+func commandExplainQuery(cmd *cobra.Command, args []string) error {
+	if explainQueryOptions.SQL != "" && explainQueryOptions.SQLFilePath != "" {
+		return fmt.Errorf("cannot pass both --sql (=%s) and --sql-file (=%s)", explainQueryOptions.SQL, explainQueryOptions.SQLFilePath)
+	}
+
+	if explainQueryOptions.SQL == "" && explainQueryOptions.SQLFilePath == "" {
+		return errors.New("must pass exactly one of --sql and --sql-file")
+	}
+
+	cli.FinishedParsing(cmd)
+
+	sql := explainQueryOptions.SQL
+	if explainQueryOptions.SQLFilePath != "" {
+		data, err := ioutil.ReadFile(explainQueryOptions.SQLFilePath)
+		if err != nil {
+			return err
+		}
+
+		sql = string(data)
+	}
+
+	resp, err := client.ExplainQuery(commandCtx, &vtctldatapb.ExplainQueryRequest{
+		Sql:           sql,
+		Cell:          explainQueryOptions.Cell,
+		Target:        explainQueryOptions.Target,
+		Normalize:     explainQueryOptions.Normalize,
+		ExecutionMode: explainQueryOptions.ExecutionMode,
+		OutputFormat:  explainQueryOptions.OutputMode,
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Print(resp.Explain)
+
+	return nil
+}
+
+func init() {
+	ExplainQuery.Flags().StringVar(&explainQueryOptions.SQL, "sql", "", "Semicolon-separated queries to explain.")
+	ExplainQuery.Flags().StringVar(&explainQueryOptions.SQLFilePath, "sql-file", "", "Path to a file with the queries to explain.")
+	ExplainQuery.Flags().StringVar(&explainQueryOptions.Cell, "cell", "", "Cell whose serving vschema the queries are routed with. Defaults to the first cell of the cluster.")
+	ExplainQuery.Flags().StringVar(&explainQueryOptions.Target, "target", "", "Database target of the queries, as with `USE <target>`.")
+	ExplainQuery.Flags().BoolVar(&explainQueryOptions.Normalize, "normalize", false, "Normalize the queries, as vtgate does with --normalize_queries.")
+	ExplainQuery.Flags().StringVar(&explainQueryOptions.ExecutionMode, "execution-mode", "multi", "Execution mode to simulate, multi or twopc.")
+	ExplainQuery.Flags().StringVar(&explainQueryOptions.OutputMode, "output-mode", "text", "Output the explanation as text or json.")
+	Root.AddCommand(ExplainQuery)
+}
@@ -0,0 +1,174 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package command
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"vitess.io/vitess/go/cmd/vtctldclient/cli"
+
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+var (
+	// DeregisterExternalMysql makes a DeregisterExternalMysql gRPC call to a vtctld.
+	DeregisterExternalMysql = &cobra.Command{
+		Use:                   "DeregisterExternalMysql <name>",
+		Short:                 "Removes an external MySQL registered as a source of vreplication workflows.",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+		RunE:                  commandDeregisterExternalMysql,
+	}
+	// GetExternalMysqls makes a GetExternalMysqls gRPC call to a vtctld.
+	GetExternalMysqls = &cobra.Command{
+		Use:                   "GetExternalMysqls [--check-health] [<name> ...]",
+		Short:                 "Displays the external MySQLs registered as sources of vreplication workflows, and optionally their health.",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ArbitraryArgs,
+		RunE:                  commandGetExternalMysqls,
+	}
+	// RegisterExternalMysql makes a RegisterExternalMysql gRPC call to a vtctld.
+	RegisterExternalMysql = &cobra.Command{
+		Use:   "RegisterExternalMysql --host <host> --port <port> --db-name <db> --user <user> {--password <password> | --password-file <file>} [--skip-health-check] <name>",
+		Short: "Registers an unmanaged MySQL as a read-only source of MoveTables and Migrate workflows.",
+		Long: `Registers an unmanaged MySQL as a read-only source of MoveTables and Migrate workflows.
+
+The credentials are stored in the topo, and are those of a replication user.
+Unless --skip-health-check is passed, vtctld first checks that it can connect to
+the MySQL, and that its gtid_mode, binlog_format and binlog_row_image allow
+vreplication to stream its binlogs. Workflows refer to the MySQL as
+<name>.<db-name>, e.g. with Migrate --source <name>.<db-name>.`,
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+		RunE:                  commandRegisterExternalMysql,
+	}
+)
+
+func commandDeregisterExternalMysql(cmd *cobra.Command, args []string) error {
+	cli.FinishedParsing(cmd)
+
+	name := cmd.Flags().Arg(0)
+	_, err := client.DeregisterExternalMysql(commandCtx, &vtctldatapb.DeregisterExternalMysqlRequest{
+		Name: name,
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Deregistered external MySQL %s.\n", name)
+
+	return nil
+}
+
+var getExternalMysqlsOptions = struct {
+	CheckHealth bool
+}{}
+
+func commandGetExternalMysqls(cmd *cobra.Command, args []string) error {
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.GetExternalMysqls(commandCtx, &vtctldatapb.GetExternalMysqlsRequest{
+		Names:       cmd.Flags().Args(),
+		CheckHealth: getExternalMysqlsOptions.CheckHealth,
+	})
+	if err != nil {
+		return err
+	}
+
+	data, err := cli.MarshalJSON(resp.ExternalMysqls)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s\n", data)
+
+	return nil
+}
+
+var registerExternalMysqlOptions = struct {
+	Host            string
+	Port            int32
+	DBName          string
+	User            string
+	Password        string
+	PasswordFile    string
+	SkipHealthCheck bool
+}{}
+
+func commandRegisterExternalMysql(cmd *cobra.Command, args []string) error {
+	if registerExternalMysqlOptions.Password != "" && registerExternalMysqlOptions.PasswordFile != "" {
+		return fmt.Errorf("cannot pass both --password and --password-file")
+	}
+
+	cli.FinishedParsing(cmd)
+
+	password := registerExternalMysqlOptions.Password
+	if registerExternalMysqlOptions.PasswordFile != "" {
+		data, err := ioutil.ReadFile(registerExternalMysqlOptions.PasswordFile)
+		if err != nil {
+			return err
+		}
+
+		password = strings.TrimSpace(string(data))
+	}
+
+	name := cmd.Flags().Arg(0)
+	resp, err := client.RegisterExternalMysql(commandCtx, &vtctldatapb.RegisterExternalMysqlRequest{
+		Name:            name,
+		Host:            registerExternalMysqlOptions.Host,
+		Port:            registerExternalMysqlOptions.Port,
+		DbName:          registerExternalMysqlOptions.DBName,
+		User:            registerExternalMysqlOptions.User,
+		Password:        password,
+		SkipHealthCheck: registerExternalMysqlOptions.SkipHealthCheck,
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Registered external MySQL %s.\n", name)
+	if resp.Health != nil {
+		data, err := cli.MarshalJSON(resp.Health)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("%s\n", data)
+	}
+
+	return nil
+}
+
+func init() {
+	Root.AddCommand(DeregisterExternalMysql)
+
+	GetExternalMysqls.Flags().BoolVar(&getExternalMysqlsOptions.CheckHealth, "check-health", false, "Connect to the external MySQLs to check their health.")
+	Root.AddCommand(GetExternalMysqls)
+
+	RegisterExternalMysql.Flags().StringVar(&registerExternalMysqlOptions.Host, "host", "", "Host of the MySQL.")
+	RegisterExternalMysql.Flags().Int32Var(&registerExternalMysqlOptions.Port, "port", 3306, "Port of the MySQL.")
+	RegisterExternalMysql.Flags().StringVar(&registerExternalMysqlOptions.DBName, "db-name", "", "Database of the MySQL that workflows replicate from.")
+	RegisterExternalMysql.Flags().StringVar(&registerExternalMysqlOptions.User, "user", "", "Replication user of the MySQL.")
+	RegisterExternalMysql.Flags().StringVar(&registerExternalMysqlOptions.Password, "password", "", "Password of the replication user.")
+	RegisterExternalMysql.Flags().StringVar(&registerExternalMysqlOptions.PasswordFile, "password-file", "", "Path to a file containing the password of the replication user.")
+	RegisterExternalMysql.Flags().BoolVar(&registerExternalMysqlOptions.SkipHealthCheck, "skip-health-check", false, "Register the MySQL even if vtctld cannot connect to it, or its binlog settings do not allow vreplication.")
+	Root.AddCommand(RegisterExternalMysql)
+}
@@ -19,11 +19,13 @@ package command
 import (
 	"errors"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/spf13/cobra"
 
 	"vitess.io/vitess/go/cmd/vtctldclient/cli"
+	"vitess.io/vitess/go/protoutil"
 	"vitess.io/vitess/go/vt/logutil"
 	"vitess.io/vitess/go/vt/topo"
 
@@ -35,7 +37,7 @@ import (
 var (
 	// CreateKeyspace makes a CreateKeyspace gRPC call to a vtctld.
 	CreateKeyspace = &cobra.Command{
-		Use:  "CreateKeyspace KEYSPACE_NAME [--force] [--sharding-column-name NAME --sharding-column-type TYPE] [--base-keyspace KEYSPACE --snapshot-timestamp TIME] [--served-from DB_TYPE:KEYSPACE ...]",
+		Use:  "CreateKeyspace KEYSPACE_NAME [--force] [--sharding-column-name NAME --sharding-column-type TYPE] [--base-keyspace KEYSPACE --snapshot-timestamp TIME] [--served-from DB_TYPE:KEYSPACE ...] [--durability-policy POLICY]",
 		Args: cobra.ExactArgs(1),
 		RunE: commandCreateKeyspace,
 	}
@@ -47,7 +49,7 @@ var (
 	}
 	// FindAllShardsInKeyspace makes a FindAllShardsInKeyspace gRPC call to a vtctld.
 	FindAllShardsInKeyspace = &cobra.Command{
-		Use:     "FindAllShardsInKeyspace keyspace",
+		Use:     "FindAllShardsInKeyspace [--page-size <n> [--page-token <token>]] keyspace",
 		Aliases: []string{"findallshardsinkeyspace"},
 		Args:    cobra.ExactArgs(1),
 		RunE:    commandFindAllShardsInKeyspace,
@@ -59,6 +61,13 @@ var (
 		Args:    cobra.ExactArgs(1),
 		RunE:    commandGetKeyspace,
 	}
+	// GetKeyspaceGraph makes a GetKeyspaceGraph gRPC call to a vtctld.
+	GetKeyspaceGraph = &cobra.Command{
+		Use:   "GetKeyspaceGraph <keyspace>",
+		Short: "Outputs the serving graph of the keyspace: its shards, tablets, and the replication relationships and lag of the tablets, along with any problems found.",
+		Args:  cobra.ExactArgs(1),
+		RunE:  commandGetKeyspaceGraph,
+	}
 	// GetKeyspaces makes a GetKeyspaces gRPC call to a vtctld.
 	GetKeyspaces = &cobra.Command{
 		Use:     "GetKeyspaces",
@@ -66,12 +75,40 @@ var (
 		Args:    cobra.NoArgs,
 		RunE:    commandGetKeyspaces,
 	}
+	// KillQueries makes a KillQueries gRPC call to a vtctld.
+	KillQueries = &cobra.Command{
+		Use:   "KillQueries --keyspace <keyspace> [--fingerprint <fingerprint>] [--user <user>] [--deny-duration <duration>]",
+		Short: "Kills the in-flight queries of a fingerprint or of a user on the tablets of a keyspace, and optionally makes vtgate and vttablet deny them for a while.",
+		Args:  cobra.NoArgs,
+		RunE:  commandKillQueries,
+	}
 	// RemoveKeyspaceCell makes a RemoveKeyspaceCell gRPC call to a vtctld.
 	RemoveKeyspaceCell = &cobra.Command{
 		Use:  "RemoveKeyspaceCell <keyspace> <cell>",
 		Args: cobra.ExactArgs(2),
 		RunE: commandRemoveKeyspaceCell,
 	}
+	// SetKeyspaceDurabilityPolicy makes a SetKeyspaceDurabilityPolicy gRPC call to a vtctld.
+	SetKeyspaceDurabilityPolicy = &cobra.Command{
+		Use:   "SetKeyspaceDurabilityPolicy [--durability-policy <policy>] [--force] <keyspace>",
+		Short: "Sets the durability policy of a keyspace, which the reparents enforce. An empty policy reverts the tablets to the -enable_semi_sync flag.",
+		Args:  cobra.ExactArgs(1),
+		RunE:  commandSetKeyspaceDurabilityPolicy,
+	}
+	// SetKeyspacePolicy makes a SetKeyspacePolicy gRPC call to a vtctld.
+	SetKeyspacePolicy = &cobra.Command{
+		Use:   "SetKeyspacePolicy [--query-timeout <duration>] [--max-rows <rows>] [--default-workload <workload>] <keyspace>",
+		Short: "Replaces the query limits vtgate and vttablet apply to a keyspace. Without flags, removes them.",
+		Args:  cobra.ExactArgs(1),
+		RunE:  commandSetKeyspacePolicy,
+	}
+	// UpdateThrottlerConfig makes an UpdateThrottlerConfig gRPC call to a vtctld.
+	UpdateThrottlerConfig = &cobra.Command{
+		Use:   "UpdateThrottlerConfig [--metric <name> --threshold <threshold> [--query <query>]] [--app <app> [--app-metrics <metrics>] [--app-thresholds <metric:threshold,...>] [--priority <priority>]] [--remove-metrics <names>] [--remove-apps <apps>] <keyspace>",
+		Short: "Updates the metrics and the app policies of the tablet throttler of a keyspace.",
+		Args:  cobra.ExactArgs(1),
+		RunE:  commandUpdateThrottlerConfig,
+	}
 )
 
 var createKeyspaceOptions = struct {
@@ -86,6 +123,8 @@ var createKeyspaceOptions = struct {
 	KeyspaceType      cli.KeyspaceTypeFlag
 	BaseKeyspace      string
 	SnapshotTimestamp string
+
+	DurabilityPolicy string
 }{
 	KeyspaceType: cli.KeyspaceTypeFlag(topodatapb.KeyspaceType_NORMAL),
 }
@@ -132,6 +171,7 @@ func commandCreateKeyspace(cmd *cobra.Command, args []string) error {
 		Type:               topodatapb.KeyspaceType(createKeyspaceOptions.KeyspaceType),
 		BaseKeyspace:       createKeyspaceOptions.BaseKeyspace,
 		SnapshotTime:       snapshotTime,
+		DurabilityPolicy:   createKeyspaceOptions.DurabilityPolicy,
 	}
 
 	for n, v := range createKeyspaceOptions.ServedFromsMap.StringMapValue {
@@ -183,12 +223,19 @@ func commandDeleteKeyspace(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+var findAllShardsInKeyspaceOptions = struct {
+	PageSize  int32
+	PageToken string
+}{}
+
 func commandFindAllShardsInKeyspace(cmd *cobra.Command, args []string) error {
 	cli.FinishedParsing(cmd)
 
 	ks := cmd.Flags().Arg(0)
 	resp, err := client.FindAllShardsInKeyspace(commandCtx, &vtctldatapb.FindAllShardsInKeyspaceRequest{
-		Keyspace: ks,
+		Keyspace:  ks,
+		PageSize:  findAllShardsInKeyspaceOptions.PageSize,
+		PageToken: findAllShardsInKeyspaceOptions.PageToken,
 	})
 
 	if err != nil {
@@ -221,6 +268,26 @@ func commandGetKeyspace(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+func commandGetKeyspaceGraph(cmd *cobra.Command, args []string) error {
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.GetKeyspaceGraph(commandCtx, &vtctldatapb.GetKeyspaceGraphRequest{
+		Keyspace: cmd.Flags().Arg(0),
+	})
+	if err != nil {
+		return err
+	}
+
+	data, err := cli.MarshalJSON(resp.Graph)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s\n", data)
+
+	return nil
+}
+
 func commandGetKeyspaces(cmd *cobra.Command, args []string) error {
 	cli.FinishedParsing(cmd)
 
@@ -239,6 +306,44 @@ func commandGetKeyspaces(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+var killQueriesOptions = struct {
+	Keyspace     string
+	Fingerprint  string
+	User         string
+	DenyDuration time.Duration
+}{}
+
+func commandKillQueries(cmd *cobra.Command, args []string) error {
+	if killQueriesOptions.Fingerprint == "" && killQueriesOptions.User == "" {
+		return errors.New("at least one of --fingerprint and --user is required")
+	}
+
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.KillQueries(commandCtx, &vtctldatapb.KillQueriesRequest{
+		Keyspace:     killQueriesOptions.Keyspace,
+		Fingerprint:  killQueriesOptions.Fingerprint,
+		User:         killQueriesOptions.User,
+		DenyDuration: protoutil.DurationToProto(killQueriesOptions.DenyDuration),
+	})
+	if err != nil {
+		return err
+	}
+
+	data, err := cli.MarshalJSON(resp)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s\n", data)
+
+	if len(resp.TabletErrors) > 0 {
+		return fmt.Errorf("%d tablets could not kill their queries", len(resp.TabletErrors))
+	}
+
+	return nil
+}
+
 var removeKeyspaceCellOptions = struct {
 	Force     bool
 	Recursive bool
@@ -266,6 +371,134 @@ func commandRemoveKeyspaceCell(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+var setKeyspaceDurabilityPolicyOptions = struct {
+	DurabilityPolicy string
+	Force            bool
+}{}
+
+func commandSetKeyspaceDurabilityPolicy(cmd *cobra.Command, args []string) error {
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.SetKeyspaceDurabilityPolicy(commandCtx, &vtctldatapb.SetKeyspaceDurabilityPolicyRequest{
+		Keyspace:         cmd.Flags().Arg(0),
+		DurabilityPolicy: setKeyspaceDurabilityPolicyOptions.DurabilityPolicy,
+		Force:            setKeyspaceDurabilityPolicyOptions.Force,
+	})
+	if err != nil {
+		return err
+	}
+
+	data, err := cli.MarshalJSON(resp.Keyspace)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s\n", data)
+
+	return nil
+}
+
+var setKeyspacePolicyOptions = struct {
+	QueryTimeout    time.Duration
+	MaxRows         uint64
+	DefaultWorkload string
+}{}
+
+func commandSetKeyspacePolicy(cmd *cobra.Command, args []string) error {
+	cli.FinishedParsing(cmd)
+
+	req := &vtctldatapb.SetKeyspacePolicyRequest{
+		Keyspace: cmd.Flags().Arg(0),
+		Policy: &topodatapb.KeyspacePolicy{
+			QueryTimeoutMs:  setKeyspacePolicyOptions.QueryTimeout.Milliseconds(),
+			MaxRows:         setKeyspacePolicyOptions.MaxRows,
+			DefaultWorkload: setKeyspacePolicyOptions.DefaultWorkload,
+		},
+	}
+	resp, err := client.SetKeyspacePolicy(commandCtx, req)
+	if err != nil {
+		return err
+	}
+
+	data, err := cli.MarshalJSON(resp.Policy)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Successfully set the policy of keyspace %s. Result:\n%s\n", req.Keyspace, data)
+
+	return nil
+}
+
+var updateThrottlerConfigOptions = struct {
+	Metric        string
+	Query         string
+	Threshold     float64
+	RemoveMetrics []string
+
+	App           string
+	AppMetrics    []string
+	AppThresholds cli.StringMapValue
+	Priority      int32
+	RemoveApps    []string
+}{}
+
+func commandUpdateThrottlerConfig(cmd *cobra.Command, args []string) error {
+	opts := updateThrottlerConfigOptions
+	if opts.Metric == "" && (cmd.Flags().Changed("query") || cmd.Flags().Changed("threshold")) {
+		return errors.New("--query and --threshold require --metric")
+	}
+	if opts.App == "" && (cmd.Flags().Changed("app-metrics") || cmd.Flags().Changed("app-thresholds") || cmd.Flags().Changed("priority")) {
+		return errors.New("--app-metrics, --app-thresholds and --priority require --app")
+	}
+
+	req := &vtctldatapb.UpdateThrottlerConfigRequest{
+		Keyspace:          cmd.Flags().Arg(0),
+		RemoveMetrics:     opts.RemoveMetrics,
+		RemoveAppPolicies: opts.RemoveApps,
+	}
+	if opts.Metric != "" {
+		req.SetMetrics = map[string]*topodatapb.ThrottlerConfig_Metric{
+			opts.Metric: {Query: opts.Query, Threshold: opts.Threshold},
+		}
+	}
+	if opts.App != "" {
+		policy := &topodatapb.ThrottlerConfig_AppPolicy{
+			Metrics:  opts.AppMetrics,
+			Priority: opts.Priority,
+		}
+		for metric, value := range opts.AppThresholds.StringMapValue {
+			threshold, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return fmt.Errorf("invalid threshold %q for metric %s: %w", value, metric, err)
+			}
+			if policy.Thresholds == nil {
+				policy.Thresholds = map[string]float64{}
+			}
+			policy.Thresholds[metric] = threshold
+		}
+		req.SetAppPolicies = map[string]*topodatapb.ThrottlerConfig_AppPolicy{
+			opts.App: policy,
+		}
+	}
+
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.UpdateThrottlerConfig(commandCtx, req)
+	if err != nil {
+		return err
+	}
+
+	data, err := cli.MarshalJSON(resp.ThrottlerConfig)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Successfully updated the throttler config of keyspace %s. Result:\n%s\n", req.Keyspace, data)
+
+	return nil
+}
+
 func init() {
 	CreateKeyspace.Flags().BoolVarP(&createKeyspaceOptions.Force, "force", "f", false, "Proceeds even if the keyspace already exists. Does not overwrite the existing keyspace record")
 	CreateKeyspace.Flags().BoolVarP(&createKeyspaceOptions.AllowEmptyVSchema, "allow-empty-vschema", "e", false, "Allows a new keyspace to have no vschema")
@@ -275,16 +508,47 @@ func init() {
 	CreateKeyspace.Flags().Var(&createKeyspaceOptions.KeyspaceType, "type", "The type of the keyspace")
 	CreateKeyspace.Flags().StringVar(&createKeyspaceOptions.BaseKeyspace, "base-keyspace", "", "The base keyspace for a snapshot keyspace.")
 	CreateKeyspace.Flags().StringVar(&createKeyspaceOptions.SnapshotTimestamp, "snapshot-timestamp", "", "The snapshot time for a snapshot keyspace, as a timestamp in RFC3339 format.")
+	CreateKeyspace.Flags().StringVar(&createKeyspaceOptions.DurabilityPolicy, "durability-policy", "", "The durability policy of the keyspace (none, semi_sync, cross_cell), which the reparents enforce.")
 	Root.AddCommand(CreateKeyspace)
 
 	DeleteKeyspace.Flags().BoolVarP(&deleteKeyspaceOptions.Recursive, "recursive", "r", false, "Recursively delete all shards in the keyspace, and all tablets in those shards.")
 	Root.AddCommand(DeleteKeyspace)
 
+	FindAllShardsInKeyspace.Flags().Int32Var(&findAllShardsInKeyspaceOptions.PageSize, "page-size", 0, "Maximum number of shards to return, in key range order. Zero returns all shards.")
+	FindAllShardsInKeyspace.Flags().StringVar(&findAllShardsInKeyspaceOptions.PageToken, "page-token", "", "The next_page_token of a previous FindAllShardsInKeyspace, to return the next page of shards.")
 	Root.AddCommand(FindAllShardsInKeyspace)
 	Root.AddCommand(GetKeyspace)
+	Root.AddCommand(GetKeyspaceGraph)
 	Root.AddCommand(GetKeyspaces)
 
+	KillQueries.Flags().StringVarP(&killQueriesOptions.Keyspace, "keyspace", "k", "", "The keyspace whose queries to kill.")
+	KillQueries.MarkFlagRequired("keyspace")
+	KillQueries.Flags().StringVar(&killQueriesOptions.Fingerprint, "fingerprint", "", "The fingerprint of the queries to kill, as shown in the vtgate query plans and in the query logs.")
+	KillQueries.Flags().StringVar(&killQueriesOptions.User, "user", "", "The user of the queries to kill.")
+	KillQueries.Flags().DurationVar(&killQueriesOptions.DenyDuration, "deny-duration", 0, "How long vtgate and vttablet reject the matching queries afterwards. Zero for not denying them.")
+	Root.AddCommand(KillQueries)
+
 	RemoveKeyspaceCell.Flags().BoolVarP(&removeKeyspaceCellOptions.Force, "force", "f", false, "Proceed even if the cell's topology server cannot be reached. The assumption is that you turned down the entire cell, and just need to update the global topo data.")
 	RemoveKeyspaceCell.Flags().BoolVarP(&removeKeyspaceCellOptions.Recursive, "recursive", "r", false, "Also delete all tablets in that cell beloning to the specified keyspace.")
 	Root.AddCommand(RemoveKeyspaceCell)
+
+	SetKeyspaceDurabilityPolicy.Flags().StringVar(&setKeyspaceDurabilityPolicyOptions.DurabilityPolicy, "durability-policy", "", "The durability policy of the keyspace (none, semi_sync, cross_cell).")
+	SetKeyspaceDurabilityPolicy.Flags().BoolVarP(&setKeyspaceDurabilityPolicyOptions.Force, "force", "f", false, "Sets the policy even if the current primaries do not have enough semi-sync ackers under it.")
+	Root.AddCommand(SetKeyspaceDurabilityPolicy)
+
+	SetKeyspacePolicy.Flags().DurationVar(&setKeyspacePolicyOptions.QueryTimeout, "query-timeout", 0, "The maximum duration of the queries of the keyspace. Zero for no limit.")
+	SetKeyspacePolicy.Flags().Uint64Var(&setKeyspacePolicyOptions.MaxRows, "max-rows", 0, "The maximum number of rows a query of the keyspace can return or affect. Zero for no limit.")
+	SetKeyspacePolicy.Flags().StringVar(&setKeyspacePolicyOptions.DefaultWorkload, "default-workload", "", "The workload of the new sessions of the keyspace (OLTP, OLAP, DBA). Empty for the vtgate default.")
+	Root.AddCommand(SetKeyspacePolicy)
+
+	UpdateThrottlerConfig.Flags().StringVar(&updateThrottlerConfigOptions.Metric, "metric", "", "The name of the metric to add or update. \"lag\" overrides the replication lag of the flags, \"threads_running\" is a builtin metric.")
+	UpdateThrottlerConfig.Flags().StringVar(&updateThrottlerConfigOptions.Query, "query", "", "The SELECT or SHOW GLOBAL query of the metric. Optional for the builtin metrics.")
+	UpdateThrottlerConfig.Flags().Float64Var(&updateThrottlerConfigOptions.Threshold, "threshold", 0, "The threshold of the metric, above which the apps are throttled.")
+	UpdateThrottlerConfig.Flags().StringSliceVar(&updateThrottlerConfigOptions.RemoveMetrics, "remove-metrics", nil, "The comma-separated names of the metrics to remove.")
+	UpdateThrottlerConfig.Flags().StringVar(&updateThrottlerConfigOptions.App, "app", "", "The name of the app whose policy to add or update.")
+	UpdateThrottlerConfig.Flags().StringSliceVar(&updateThrottlerConfigOptions.AppMetrics, "app-metrics", nil, "The comma-separated metrics checked for the app. Defaults to all the metrics.")
+	UpdateThrottlerConfig.Flags().Var(&updateThrottlerConfigOptions.AppThresholds, "app-thresholds", "The thresholds of the app, which override the ones of the metrics, as a comma-separated list of metric:threshold pairs.")
+	UpdateThrottlerConfig.Flags().Int32Var(&updateThrottlerConfigOptions.Priority, "priority", 0, "The priority of the app. The apps of lower priorities are throttled while an app of higher priority is throttled on a metric.")
+	UpdateThrottlerConfig.Flags().StringSliceVar(&updateThrottlerConfigOptions.RemoveApps, "remove-apps", nil, "The comma-separated names of the apps whose policies to remove.")
+	Root.AddCommand(UpdateThrottlerConfig)
 }
@@ -0,0 +1,161 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package command
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"vitess.io/vitess/go/cmd/vtctldclient/cli"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+var (
+	// AddQueryRule makes an AddQueryRule gRPC call to a vtctld.
+	AddQueryRule = &cobra.Command{
+		Use:   "AddQueryRule --name <name> [--description <description>] [--fingerprint <fingerprint>] [--query <regexp>] [--user <regexp>] [--plans <plan1,plan2,...>] [--tables <table1,table2,...>] [--action {FAIL|FAIL_RETRY|ALLOW}] [--before <name>] <keyspace>",
+		Short: "Adds a query rule to the keyspace, or replaces the rule of the same name, and pushes it to its tablets.",
+		Long: `Adds a query rule to the keyspace, or replaces the rule of the same name, and pushes it to its tablets.
+
+The tablets apply the rules of the keyspace in order to the queries matching all
+the conditions of a rule. FAIL and FAIL_RETRY reject the queries, and ALLOW lets
+them skip the next rules of the keyspace.`,
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+		RunE:                  commandAddQueryRule,
+	}
+	// GetQueryRules makes a GetQueryRules gRPC call to a vtctld.
+	GetQueryRules = &cobra.Command{
+		Use:                   "GetQueryRules <keyspace>",
+		Short:                 "Displays the query rules of the keyspace.",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+		RunE:                  commandGetQueryRules,
+	}
+	// RemoveQueryRule makes a RemoveQueryRule gRPC call to a vtctld.
+	RemoveQueryRule = &cobra.Command{
+		Use:                   "RemoveQueryRule <keyspace> <name>",
+		Short:                 "Removes a query rule from the keyspace and its tablets.",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(2),
+		RunE:                  commandRemoveQueryRule,
+	}
+)
+
+var addQueryRuleOptions = struct {
+	Name        string
+	Description string
+	Fingerprint string
+	Query       string
+	User        string
+	Plans       []string
+	Tables      []string
+	Action      string
+	Before      string
+}{}
+
+func commandAddQueryRule(cmd *cobra.Command, args []string) error {
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.AddQueryRule(commandCtx, &vtctldatapb.AddQueryRuleRequest{
+		Keyspace: cmd.Flags().Arg(0),
+		Rule: &topodatapb.QueryRule{
+			Name:        addQueryRuleOptions.Name,
+			Description: addQueryRuleOptions.Description,
+			Fingerprint: addQueryRuleOptions.Fingerprint,
+			Query:       addQueryRuleOptions.Query,
+			User:        addQueryRuleOptions.User,
+			Plans:       addQueryRuleOptions.Plans,
+			TableNames:  addQueryRuleOptions.Tables,
+			Action:      addQueryRuleOptions.Action,
+		},
+		Before: addQueryRuleOptions.Before,
+	})
+	if err != nil {
+		return err
+	}
+
+	data, err := cli.MarshalJSON(resp)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s\n", data)
+
+	return nil
+}
+
+func commandGetQueryRules(cmd *cobra.Command, args []string) error {
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.GetQueryRules(commandCtx, &vtctldatapb.GetQueryRulesRequest{
+		Keyspace: cmd.Flags().Arg(0),
+	})
+	if err != nil {
+		return err
+	}
+
+	data, err := cli.MarshalJSON(resp)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s\n", data)
+
+	return nil
+}
+
+func commandRemoveQueryRule(cmd *cobra.Command, args []string) error {
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.RemoveQueryRule(commandCtx, &vtctldatapb.RemoveQueryRuleRequest{
+		Keyspace: cmd.Flags().Arg(0),
+		Name:     cmd.Flags().Arg(1),
+	})
+	if err != nil {
+		return err
+	}
+
+	data, err := cli.MarshalJSON(resp)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s\n", data)
+
+	return nil
+}
+
+func init() {
+	AddQueryRule.Flags().StringVar(&addQueryRuleOptions.Name, "name", "", "The name of the rule, unique in the keyspace.")
+	AddQueryRule.MarkFlagRequired("name")
+	AddQueryRule.Flags().StringVar(&addQueryRuleOptions.Description, "description", "", "The description of the rule, returned in the errors of the rejected queries.")
+	AddQueryRule.Flags().StringVar(&addQueryRuleOptions.Fingerprint, "fingerprint", "", "The fingerprint of the queries, as shown in the vttablet query stats.")
+	AddQueryRule.Flags().StringVar(&addQueryRuleOptions.Query, "query", "", "A regexp matching the whole queries.")
+	AddQueryRule.Flags().StringVar(&addQueryRuleOptions.User, "user", "", "A regexp matching the whole name of the user of the queries.")
+	AddQueryRule.Flags().StringSliceVar(&addQueryRuleOptions.Plans, "plans", nil, "The plan types of the queries, e.g. Select or Insert.")
+	AddQueryRule.Flags().StringSliceVar(&addQueryRuleOptions.Tables, "tables", nil, "The tables of the queries.")
+	AddQueryRule.Flags().StringVar(&addQueryRuleOptions.Action, "action", "FAIL", "The action on the matching queries: FAIL, FAIL_RETRY, or ALLOW to make them skip the next rules.")
+	AddQueryRule.Flags().StringVar(&addQueryRuleOptions.Before, "before", "", "The name of the rule before which to insert a new rule. By default, it is appended to the rules.")
+	Root.AddCommand(AddQueryRule)
+
+	Root.AddCommand(GetQueryRules)
+	Root.AddCommand(RemoveQueryRule)
+}
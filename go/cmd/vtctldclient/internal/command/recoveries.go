@@ -0,0 +1,231 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package command
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"vitess.io/vitess/go/cmd/vtctldclient/cli"
+	"vitess.io/vitess/go/protoutil"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+var (
+	// AddMaintenanceWindow makes an UpdateRecoverySettings gRPC call to a
+	// vtctld.
+	AddMaintenanceWindow = &cobra.Command{
+		Use:                   "AddMaintenanceWindow --name <name> [--start <time>] {--end <time> | --duration <duration>} [--reason <reason>] [<keyspace>[/<shard>]]",
+		Short:                 "Adds or replaces a maintenance window, during which VTOrc does not recover the keyspace or the shard, or any of them if none is given.",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.MaximumNArgs(1),
+		RunE:                  commandAddMaintenanceWindow,
+	}
+	// DisableRecoveries makes an UpdateRecoverySettings gRPC call to a vtctld.
+	DisableRecoveries = &cobra.Command{
+		Use:                   "DisableRecoveries [<keyspace>[/<shard>]]",
+		Short:                 "Disables the VTOrc recoveries of the keyspace or the shard, or of all of them if none is given. The problems are still detected.",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.MaximumNArgs(1),
+		RunE:                  commandDisableRecoveries,
+	}
+	// EnableRecoveries makes an UpdateRecoverySettings gRPC call to a vtctld.
+	EnableRecoveries = &cobra.Command{
+		Use:                   "EnableRecoveries [<keyspace>[/<shard>]]",
+		Short:                 "Enables the VTOrc recoveries of the keyspace or the shard, or of all of them if none is given.",
+		Long:                  "Enables the VTOrc recoveries of the keyspace or the shard, or of all of them if none is given.\nEnabling the recoveries of all the keyspaces does not enable the ones of the keyspaces and shards disabled individually.",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.MaximumNArgs(1),
+		RunE:                  commandEnableRecoveries,
+	}
+	// GetRecoverySettings makes a GetRecoverySettings gRPC call to a vtctld.
+	GetRecoverySettings = &cobra.Command{
+		Use:                   "GetRecoverySettings",
+		Short:                 "Displays the settings of the VTOrc recoveries.",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.NoArgs,
+		RunE:                  commandGetRecoverySettings,
+	}
+	// RemoveMaintenanceWindow makes an UpdateRecoverySettings gRPC call to a
+	// vtctld.
+	RemoveMaintenanceWindow = &cobra.Command{
+		Use:                   "RemoveMaintenanceWindow <name> [<name> ...]",
+		Short:                 "Removes maintenance windows, by name.",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.MinimumNArgs(1),
+		RunE:                  commandRemoveMaintenanceWindow,
+	}
+)
+
+// parseOptionalKeyspaceShard parses the optional <keyspace>[/<shard>]
+// argument of the recovery commands.
+func parseOptionalKeyspaceShard(args []string) (keyspace string, shard string, err error) {
+	if len(args) == 0 {
+		return "", "", nil
+	}
+	if !strings.ContainsAny(args[0], "/:") {
+		return args[0], "", nil
+	}
+	return topoproto.ParseKeyspaceShard(args[0])
+}
+
+var addMaintenanceWindowOptions = struct {
+	Name     string
+	Start    string
+	End      string
+	Duration time.Duration
+	Reason   string
+}{}
+
+func commandAddMaintenanceWindow(cmd *cobra.Command, args []string) error {
+	keyspace, shard, err := parseOptionalKeyspaceShard(args)
+	if err != nil {
+		return err
+	}
+
+	if addMaintenanceWindowOptions.Name == "" {
+		return errors.New("--name is required")
+	}
+
+	if (addMaintenanceWindowOptions.End == "") == (addMaintenanceWindowOptions.Duration == 0) {
+		return errors.New("must pass exactly one of --end and --duration")
+	}
+
+	start := time.Now()
+	if addMaintenanceWindowOptions.Start != "" {
+		start, err = time.Parse(time.RFC3339, addMaintenanceWindowOptions.Start)
+		if err != nil {
+			return fmt.Errorf("cannot parse --start as RFC3339: %w", err)
+		}
+	}
+
+	end := start.Add(addMaintenanceWindowOptions.Duration)
+	if addMaintenanceWindowOptions.End != "" {
+		end, err = time.Parse(time.RFC3339, addMaintenanceWindowOptions.End)
+		if err != nil {
+			return fmt.Errorf("cannot parse --end as RFC3339: %w", err)
+		}
+	}
+
+	cli.FinishedParsing(cmd)
+
+	return updateRecoverySettings(&vtctldatapb.UpdateRecoverySettingsRequest{
+		AddMaintenanceWindows: []*topodatapb.RecoverySettings_MaintenanceWindow{{
+			Name:      addMaintenanceWindowOptions.Name,
+			Keyspace:  keyspace,
+			Shard:     shard,
+			StartTime: protoutil.TimeToProto(start),
+			EndTime:   protoutil.TimeToProto(end),
+			Reason:    addMaintenanceWindowOptions.Reason,
+		}},
+	})
+}
+
+func commandDisableRecoveries(cmd *cobra.Command, args []string) error {
+	keyspace, shard, err := parseOptionalKeyspaceShard(args)
+	if err != nil {
+		return err
+	}
+
+	cli.FinishedParsing(cmd)
+
+	return updateRecoverySettings(&vtctldatapb.UpdateRecoverySettingsRequest{
+		Keyspace:          keyspace,
+		Shard:             shard,
+		DisableRecoveries: true,
+	})
+}
+
+func commandEnableRecoveries(cmd *cobra.Command, args []string) error {
+	keyspace, shard, err := parseOptionalKeyspaceShard(args)
+	if err != nil {
+		return err
+	}
+
+	cli.FinishedParsing(cmd)
+
+	return updateRecoverySettings(&vtctldatapb.UpdateRecoverySettingsRequest{
+		Keyspace:         keyspace,
+		Shard:            shard,
+		EnableRecoveries: true,
+	})
+}
+
+func commandGetRecoverySettings(cmd *cobra.Command, args []string) error {
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.GetRecoverySettings(commandCtx, &vtctldatapb.GetRecoverySettingsRequest{})
+	if err != nil {
+		return err
+	}
+
+	data, err := cli.MarshalJSON(resp.RecoverySettings)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s\n", data)
+
+	return nil
+}
+
+func commandRemoveMaintenanceWindow(cmd *cobra.Command, args []string) error {
+	cli.FinishedParsing(cmd)
+
+	return updateRecoverySettings(&vtctldatapb.UpdateRecoverySettingsRequest{
+		RemoveMaintenanceWindows: cmd.Flags().Args(),
+	})
+}
+
+// updateRecoverySettings makes the UpdateRecoverySettings call, and displays
+// the updated settings.
+func updateRecoverySettings(req *vtctldatapb.UpdateRecoverySettingsRequest) error {
+	resp, err := client.UpdateRecoverySettings(commandCtx, req)
+	if err != nil {
+		return err
+	}
+
+	data, err := cli.MarshalJSON(resp.RecoverySettings)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s\n", data)
+
+	return nil
+}
+
+func init() {
+	AddMaintenanceWindow.Flags().StringVar(&addMaintenanceWindowOptions.Name, "name", "", "Name of the maintenance window. A window of the same name is replaced.")
+	AddMaintenanceWindow.Flags().StringVar(&addMaintenanceWindowOptions.Start, "start", "", "Start time of the maintenance window, in RFC3339 format. Defaults to now.")
+	AddMaintenanceWindow.Flags().StringVar(&addMaintenanceWindowOptions.End, "end", "", "End time of the maintenance window, in RFC3339 format.")
+	AddMaintenanceWindow.Flags().DurationVar(&addMaintenanceWindowOptions.Duration, "duration", 0, "Duration of the maintenance window, from its start time.")
+	AddMaintenanceWindow.Flags().StringVar(&addMaintenanceWindowOptions.Reason, "reason", "", "Reason of the maintenance window, reported by VTOrc when it skips a recovery.")
+	Root.AddCommand(AddMaintenanceWindow)
+
+	Root.AddCommand(DisableRecoveries)
+	Root.AddCommand(EnableRecoveries)
+	Root.AddCommand(GetRecoverySettings)
+	Root.AddCommand(RemoveMaintenanceWindow)
+}
@@ -76,6 +76,12 @@ var emergencyReparentShardOptions = struct {
 	WaitReplicasTimeout       time.Duration
 	NewPrimaryAliasStr        string
 	IgnoreReplicaAliasStrList []string
+	PreferredCells            []string
+	PreventCrossCellPromotion bool
+	AvoidPrimaryHost          bool
+	AntiAffinityTags          []string
+	RequiredTags              map[string]string
+	MaxLostTransactions       int64
 }{}
 
 func commandEmergencyReparentShard(cmd *cobra.Command, args []string) error {
@@ -113,6 +119,13 @@ func commandEmergencyReparentShard(cmd *cobra.Command, args []string) error {
 		NewPrimary:          newPrimaryAlias,
 		IgnoreReplicas:      ignoreReplicaAliases,
 		WaitReplicasTimeout: protoutil.DurationToProto(emergencyReparentShardOptions.WaitReplicasTimeout),
+
+		PreferredCells:            emergencyReparentShardOptions.PreferredCells,
+		PreventCrossCellPromotion: emergencyReparentShardOptions.PreventCrossCellPromotion,
+		AvoidPrimaryHost:          emergencyReparentShardOptions.AvoidPrimaryHost,
+		AntiAffinityTags:          emergencyReparentShardOptions.AntiAffinityTags,
+		RequiredTags:              emergencyReparentShardOptions.RequiredTags,
+		MaxLostTransactions:       emergencyReparentShardOptions.MaxLostTransactions,
 	})
 	if err != nil {
 		return err
@@ -122,6 +135,15 @@ func commandEmergencyReparentShard(cmd *cobra.Command, args []string) error {
 		fmt.Println(logutil.EventString(event))
 	}
 
+	if resp.CandidateSelection != nil {
+		data, err := cli.MarshalJSON(resp.CandidateSelection)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("%s\n", data)
+	}
+
 	return nil
 }
 
@@ -262,6 +284,12 @@ func init() {
 	EmergencyReparentShard.Flags().DurationVar(&emergencyReparentShardOptions.WaitReplicasTimeout, "wait-replicas-timeout", *topo.RemoteOperationTimeout, "Time to wait for replicas to catch up in reparenting.")
 	EmergencyReparentShard.Flags().StringVar(&emergencyReparentShardOptions.NewPrimaryAliasStr, "new-primary", "", "Alias of a tablet that should be the new primary. If not specified, the vtctld will select the best candidate to promote.")
 	EmergencyReparentShard.Flags().StringSliceVarP(&emergencyReparentShardOptions.IgnoreReplicaAliasStrList, "ignore-replicas", "i", nil, "Comma-separated, repeated list of replica tablet aliases to ignore during the emergency reparent.")
+	EmergencyReparentShard.Flags().StringSliceVar(&emergencyReparentShardOptions.PreferredCells, "preferred-cells", nil, "Cells whose candidates are preferred, in order, when no --new-primary is specified.")
+	EmergencyReparentShard.Flags().BoolVar(&emergencyReparentShardOptions.PreventCrossCellPromotion, "prevent-cross-cell-promotion", false, "Only promote a candidate in the cell of the failed primary.")
+	EmergencyReparentShard.Flags().BoolVar(&emergencyReparentShardOptions.AvoidPrimaryHost, "avoid-primary-host", false, "Avoid the candidates on the host of the failed primary.")
+	EmergencyReparentShard.Flags().StringSliceVar(&emergencyReparentShardOptions.AntiAffinityTags, "anti-affinity-tags", nil, "Tablet tag names, such as rack, whose values should differ from the ones of the failed primary.")
+	EmergencyReparentShard.Flags().StringToStringVar(&emergencyReparentShardOptions.RequiredTags, "required-tags", nil, "Tablet tags, as name=value, that the candidates must have.")
+	EmergencyReparentShard.Flags().Int64Var(&emergencyReparentShardOptions.MaxLostTransactions, "max-lost-transactions", 0, "Number of transactions a candidate may be missing, relative to the most advanced candidate, and still be promoted for the other rules. The transactions it is missing are lost.")
 	Root.AddCommand(EmergencyReparentShard)
 
 	InitShardPrimary.Flags().DurationVar(&initShardPrimaryOptions.WaitReplicasTimeout, "wait-replicas-timeout", 30*time.Second, "time to wait for replicas to catch up in reparenting")
@@ -29,11 +29,41 @@ import (
 	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
 )
 
-// GetSchema makes a GetSchema gRPC call to a vtctld.
-var GetSchema = &cobra.Command{
-	Use:  "GetSchema [--tables TABLES ...] [--exclude-tables EXCLUDE_TABLES ...] [{--table-names-only | --table-sizes-only}] [--include-views] alias",
-	Args: cobra.ExactArgs(1),
-	RunE: commandGetSchema,
+var (
+	// GetDDLStatus makes a GetDDLStatus gRPC call to a vtctld.
+	GetDDLStatus = &cobra.Command{
+		Use:                   "GetDDLStatus <uuid>",
+		Short:                 "Displays the per-shard status of a DDL that vtgate applied to the shards of a keyspace.",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+		RunE:                  commandGetDDLStatus,
+	}
+	// GetSchema makes a GetSchema gRPC call to a vtctld.
+	GetSchema = &cobra.Command{
+		Use:  "GetSchema [--tables TABLES ...] [--exclude-tables EXCLUDE_TABLES ...] [{--table-names-only | --table-sizes-only}] [--include-views] alias",
+		Args: cobra.ExactArgs(1),
+		RunE: commandGetSchema,
+	}
+)
+
+func commandGetDDLStatus(cmd *cobra.Command, args []string) error {
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.GetDDLStatus(commandCtx, &vtctldatapb.GetDDLStatusRequest{
+		Uuid: cmd.Flags().Arg(0),
+	})
+	if err != nil {
+		return err
+	}
+
+	data, err := cli.MarshalJSON(resp.Status)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s\n", data)
+
+	return nil
 }
 
 var getSchemaOptions = struct {
@@ -91,6 +121,8 @@ func commandGetSchema(cmd *cobra.Command, args []string) error {
 }
 
 func init() {
+	Root.AddCommand(GetDDLStatus)
+
 	GetSchema.Flags().StringSliceVar(&getSchemaOptions.Tables, "tables", nil, "TODO")
 	GetSchema.Flags().StringSliceVar(&getSchemaOptions.ExcludeTables, "exclude-tables", nil, "TODO")
 	GetSchema.Flags().BoolVar(&getSchemaOptions.IncludeViews, "include-views", false, "TODO")
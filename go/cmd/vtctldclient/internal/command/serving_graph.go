@@ -22,7 +22,9 @@ import (
 	"github.com/spf13/cobra"
 
 	"vitess.io/vitess/go/cmd/vtctldclient/cli"
+	"vitess.io/vitess/go/vt/topo/topoproto"
 
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
 )
 
@@ -45,7 +47,7 @@ var (
 	}
 	// GetSrvKeyspaces makes a GetSrvKeyspaces gRPC call to a vtctld.
 	GetSrvKeyspaces = &cobra.Command{
-		Use:                   "GetSrvKeyspaces <keyspace> [<cell> ...]",
+		Use:                   "GetSrvKeyspaces [--tablet-type <type> ...] <keyspace> [<cell> ...]",
 		Short:                 "Returns the SrvKeyspaces for the given keyspace in one or more cells.",
 		Args:                  cobra.MinimumNArgs(1),
 		RunE:                  commandGetSrvKeyspaces,
@@ -75,6 +77,17 @@ var (
 		Args:                  cobra.NoArgs,
 		RunE:                  commandRebuildVSchemaGraph,
 	}
+	// ValidateServingGraph makes a ValidateServingGraph gRPC call to a vtctld.
+	ValidateServingGraph = &cobra.Command{
+		Use:   "ValidateServingGraph [--keyspaces=k1,k2,...] [--cells=c1,c2,...] [--rebuild]",
+		Short: "Compares the SrvKeyspace and SrvVSchema objects of the provided cells (or all cells if none provided) with the global topo.",
+		Long: `Compares the SrvKeyspace and SrvVSchema objects of the provided cells (or all cells if none provided) with the global topo.
+
+Outputs a JSON list of the objects that diverge from what RebuildKeyspaceGraph and RebuildVSchemaGraph would save. With --rebuild, these objects are rebuilt.`,
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.NoArgs,
+		RunE:                  commandValidateServingGraph,
+	}
 )
 
 func commandDeleteSrvVSchema(cmd *cobra.Command, args []string) error {
@@ -108,15 +121,30 @@ func commandGetSrvKeyspaceNames(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+var getSrvKeyspacesOptions = struct {
+	TabletTypes []string
+}{}
+
 func commandGetSrvKeyspaces(cmd *cobra.Command, args []string) error {
+	tabletTypes := make([]topodatapb.TabletType, 0, len(getSrvKeyspacesOptions.TabletTypes))
+	for _, typeStr := range getSrvKeyspacesOptions.TabletTypes {
+		tabletType, err := topoproto.ParseTabletType(typeStr)
+		if err != nil {
+			return err
+		}
+
+		tabletTypes = append(tabletTypes, tabletType)
+	}
+
 	cli.FinishedParsing(cmd)
 
 	keyspace := cmd.Flags().Arg(0)
 	cells := cmd.Flags().Args()[1:]
 
 	resp, err := client.GetSrvKeyspaces(commandCtx, &vtctldatapb.GetSrvKeyspacesRequest{
-		Keyspace: keyspace,
-		Cells:    cells,
+		Keyspace:    keyspace,
+		Cells:       cells,
+		TabletTypes: tabletTypes,
 	})
 	if err != nil {
 		return err
@@ -200,14 +228,53 @@ func commandRebuildVSchemaGraph(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+var validateServingGraphOptions = struct {
+	Keyspaces []string
+	Cells     []string
+	Rebuild   bool
+}{}
+
+func commandValidateServingGraph(cmd *cobra.Command, args []string) error {
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.ValidateServingGraph(commandCtx, &vtctldatapb.ValidateServingGraphRequest{
+		Keyspaces: validateServingGraphOptions.Keyspaces,
+		Cells:     validateServingGraphOptions.Cells,
+		Rebuild:   validateServingGraphOptions.Rebuild,
+	})
+	if err != nil {
+		return err
+	}
+
+	// By default, an empty array will serialize as `null`, but `[]` is a little nicer.
+	data := []byte("[]")
+
+	if len(resp.Divergences) > 0 {
+		data, err = cli.MarshalJSON(resp.Divergences)
+		if err != nil {
+			return err
+		}
+	}
+
+	fmt.Printf("%s\n", data)
+
+	return nil
+}
+
 func init() {
 	Root.AddCommand(DeleteSrvVSchema)
 
 	Root.AddCommand(GetSrvKeyspaceNames)
+	GetSrvKeyspaces.Flags().StringSliceVar(&getSrvKeyspacesOptions.TabletTypes, "tablet-type", nil, "List of tablet types whose partitions to return. Omit to return all partitions.")
 	Root.AddCommand(GetSrvKeyspaces)
 	Root.AddCommand(GetSrvVSchema)
 	Root.AddCommand(GetSrvVSchemas)
 
 	RebuildVSchemaGraph.Flags().StringSliceVarP(&rebuildVSchemaGraphOptions.Cells, "cells", "c", nil, "Specifies a comma-separated list of cells to look for tablets")
 	Root.AddCommand(RebuildVSchemaGraph)
+
+	ValidateServingGraph.Flags().StringSliceVarP(&validateServingGraphOptions.Keyspaces, "keyspaces", "k", nil, "Specifies a comma-separated list of keyspaces to check the SrvKeyspace objects of")
+	ValidateServingGraph.Flags().StringSliceVarP(&validateServingGraphOptions.Cells, "cells", "c", nil, "Specifies a comma-separated list of cells to check")
+	ValidateServingGraph.Flags().BoolVar(&validateServingGraphOptions.Rebuild, "rebuild", false, "Rebuilds the objects that diverge from the global topo")
+	Root.AddCommand(ValidateServingGraph)
 }
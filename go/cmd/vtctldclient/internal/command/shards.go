@@ -28,6 +28,17 @@ import (
 )
 
 var (
+	// CheckErrantGTIDs makes a CheckErrantGTIDs gRPC request to a vtctld.
+	CheckErrantGTIDs = &cobra.Command{
+		Use: "CheckErrantGTIDs [--fix] <keyspace/shard>",
+		Long: `Lists the replicas of the shard that have errant GTIDs, i.e. transactions that
+the primary does not have. With --fix, the errant GTIDs are removed from the GTID
+set of each replica, without changing its data: its replication is stopped, its
+binlogs are reset, and its gtid_purged is set to the GTIDs it shares with the
+primary. Only use --fix once the errant transactions are known to be harmless.`,
+		Args: cobra.ExactArgs(1),
+		RunE: commandCheckErrantGTIDs,
+	}
 	// CreateShard makes a CreateShard gRPC request to a vtctld.
 	CreateShard = &cobra.Command{
 		Use:  "CreateShard <keyspace/shard>",
@@ -52,6 +63,25 @@ var (
 		Args: cobra.ExactArgs(2),
 		RunE: commandRemoveShardCell,
 	}
+	// RepairReplication makes a RepairReplication gRPC request to a vtctld.
+	RepairReplication = &cobra.Command{
+		Use: "RepairReplication [--dry-run] <keyspace/shard>",
+		Long: `Checks that each replica of the shard replicates from its primary, and has no
+GTIDs that the primary lacks or that the primary purged before the replica received
+them. Replicas that point at the wrong source, or whose replication is stopped, are
+repointed or restarted when it is safe; the other problems are reported with the
+manual action they need. Use --dry-run to only report the problems.`,
+		Args: cobra.ExactArgs(1),
+		RunE: commandRepairReplication,
+	}
+	// SetShardWriteFence makes a SetShardWriteFence gRPC request to a vtctld.
+	SetShardWriteFence = &cobra.Command{
+		Use: "SetShardWriteFence [--reason <reason>] [--remove] <keyspace/shard>",
+		Long: `Fences the writes to a shard: its primary denies writes with an error that
+includes the reason, while reads keep being served. Use --remove to lift the fence.`,
+		Args: cobra.ExactArgs(1),
+		RunE: commandSetShardWriteFence,
+	}
 	// ShardReplicationPositions makes a ShardReplicationPositions gRPC request
 	// to a vtctld.
 	ShardReplicationPositions = &cobra.Command{
@@ -64,6 +94,37 @@ Use ctrl-C to interrupt the command and see partial results if needed.`,
 	}
 )
 
+var checkErrantGTIDsOptions = struct {
+	Fix bool
+}{}
+
+func commandCheckErrantGTIDs(cmd *cobra.Command, args []string) error {
+	keyspace, shard, err := topoproto.ParseKeyspaceShard(cmd.Flags().Arg(0))
+	if err != nil {
+		return err
+	}
+
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.CheckErrantGTIDs(commandCtx, &vtctldatapb.CheckErrantGTIDsRequest{
+		Keyspace: keyspace,
+		Shard:    shard,
+		Fix:      checkErrantGTIDsOptions.Fix,
+	})
+	if err != nil {
+		return err
+	}
+
+	data, err := cli.MarshalJSON(resp)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s\n", data)
+
+	return nil
+}
+
 var createShardOptions = struct {
 	Force         bool
 	IncludeParent bool
@@ -183,6 +244,70 @@ func commandRemoveShardCell(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+var repairReplicationOptions = struct {
+	DryRun bool
+}{}
+
+func commandRepairReplication(cmd *cobra.Command, args []string) error {
+	keyspace, shard, err := topoproto.ParseKeyspaceShard(cmd.Flags().Arg(0))
+	if err != nil {
+		return err
+	}
+
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.RepairReplication(commandCtx, &vtctldatapb.RepairReplicationRequest{
+		Keyspace: keyspace,
+		Shard:    shard,
+		DryRun:   repairReplicationOptions.DryRun,
+	})
+	if err != nil {
+		return err
+	}
+
+	data, err := cli.MarshalJSON(resp)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s\n", data)
+
+	return nil
+}
+
+var setShardWriteFenceOptions = struct {
+	Reason string
+	Remove bool
+}{}
+
+func commandSetShardWriteFence(cmd *cobra.Command, args []string) error {
+	keyspace, shard, err := topoproto.ParseKeyspaceShard(cmd.Flags().Arg(0))
+	if err != nil {
+		return err
+	}
+
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.SetShardWriteFence(commandCtx, &vtctldatapb.SetShardWriteFenceRequest{
+		Keyspace: keyspace,
+		Shard:    shard,
+		Reason:   setShardWriteFenceOptions.Reason,
+		Remove:   setShardWriteFenceOptions.Remove,
+	})
+	if err != nil {
+		return err
+	}
+
+	data, err := cli.MarshalJSON(resp.Shard)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s\n", data)
+
+	return nil
+}
+
 func commandShardReplicationPositions(cmd *cobra.Command, args []string) error {
 	keyspace, shard, err := topoproto.ParseKeyspaceShard(cmd.Flags().Arg(0))
 	if err != nil {
@@ -216,6 +341,9 @@ func commandShardReplicationPositions(cmd *cobra.Command, args []string) error {
 }
 
 func init() {
+	CheckErrantGTIDs.Flags().BoolVar(&checkErrantGTIDsOptions.Fix, "fix", false, "Remove the errant GTIDs from the GTID sets of the replicas.")
+	Root.AddCommand(CheckErrantGTIDs)
+
 	CreateShard.Flags().BoolVarP(&createShardOptions.Force, "force", "f", false, "")
 	CreateShard.Flags().BoolVarP(&createShardOptions.IncludeParent, "include-parent", "p", false, "")
 	Root.AddCommand(CreateShard)
@@ -230,5 +358,12 @@ func init() {
 	RemoveShardCell.Flags().BoolVarP(&removeShardCellOptions.Recursive, "recursive", "r", false, "Also delete all tablets in that cell beloning to the specified shard.")
 	Root.AddCommand(RemoveShardCell)
 
+	RepairReplication.Flags().BoolVar(&repairReplicationOptions.DryRun, "dry-run", false, "Report the problems without repairing them.")
+	Root.AddCommand(RepairReplication)
+
+	SetShardWriteFence.Flags().StringVar(&setShardWriteFenceOptions.Reason, "reason", "", "Reason of the fence, returned in the error of the denied writes. Required unless --remove is set.")
+	SetShardWriteFence.Flags().BoolVar(&setShardWriteFenceOptions.Remove, "remove", false, "Lift the fence instead of setting it.")
+	Root.AddCommand(SetShardWriteFence)
+
 	Root.AddCommand(ShardReplicationPositions)
 }
@@ -18,11 +18,14 @@ package command
 
 import (
 	"fmt"
+	"os"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"vitess.io/vitess/go/cmd/vtctldclient/cli"
+	"vitess.io/vitess/go/protoutil"
 	"vitess.io/vitess/go/vt/topo/topoproto"
 
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
@@ -42,6 +45,19 @@ NOTE: This command automatically updates the serving graph.`,
 		Args:                  cobra.ExactArgs(2),
 		RunE:                  commandChangeTabletType,
 	}
+	// ChangeTabletTags makes a ChangeTabletTags gRPC call to a vtctld.
+	ChangeTabletTags = &cobra.Command{
+		Use:   "ChangeTabletTags [--replace] <alias> --tag $name=$value [--tag $name=$value ...]",
+		Short: "Changes the tags of the specified tablet.",
+		Long: `Changes the tags of the specified tablet.
+
+A tag with an empty value, e.g. --tag pool=, is removed. With --replace, the
+tablet has only the specified tags afterwards.
+NOTE: The tablet is tagged with its -init_tags again when it restarts.`,
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+		RunE:                  commandChangeTabletTags,
+	}
 	// DeleteTablets makes a DeleteTablets gRPC call to a vtctld.
 	DeleteTablets = &cobra.Command{
 		Use:                   "DeleteTablets <alias> [ <alias> ... ]",
@@ -60,7 +76,7 @@ NOTE: This command automatically updates the serving graph.`,
 	}
 	// GetTablets makes a GetTablets gRPC call to a vtctld.
 	GetTablets = &cobra.Command{
-		Use:   "GetTablets [--strict] [{--cell $c1 [--cell $c2 ...], --keyspace $ks [--shard $shard], --tablet-alias $alias}]",
+		Use:   "GetTablets [--strict] [{--cell $c1 [--cell $c2 ...], --keyspace $ks [--shard $shard], --tablet-alias $alias}] [--tablet-type $type ...] [--tag $name=$value ...] [--sort-by $field [--desc]] [--page-size $n [--page-token $token]]",
 		Short: "Looks up tablets according to filter criteria.",
 		Long: `Looks up tablets according to the filter criteria.
 
@@ -75,6 +91,14 @@ Passing --cell limits the set of tablets to those in the specified cells. The
 --cell flag accepts a CSV argument (e.g. --cell "c1,c2") and may be repeated
 (e.g. --cell "c1" --cell "c2").
 
+Passing --tablet-type and --tag further limits the set of tablets to those of
+the specified types, and with all the specified tags.
+
+The tablets are sorted by --sort-by (alias, keyspace_shard, type, hostname),
+if it is passed. Passing --page-size returns at most that many tablets, sorted
+by alias by default; the token of the next page, if there is one, is printed to
+stderr, to pass to --page-token with the same other flags.
+
 Valid output formats are "awk" and "json".`,
 		DisableFlagsInUseLine: true,
 		Args:                  cobra.NoArgs,
@@ -90,12 +114,28 @@ Valid output formats are "awk" and "json".`,
 	}
 	// RefreshStateByShard makes a RefreshStateByShard gRPC call to a vtcld.
 	RefreshStateByShard = &cobra.Command{
-		Use:                   "RefreshStateByShard [--cell <cell1> ...] <keyspace/shard>",
-		Short:                 "Reloads the tablet record all tablets in the shard, optionally limited to the specified cells.",
+		Use:                   "RefreshStateByShard [--cell <cell1> ...] [--tag <name=value> ...] <keyspace/shard>",
+		Short:                 "Reloads the tablet record all tablets in the shard, optionally limited to the specified cells and tags.",
 		DisableFlagsInUseLine: true,
 		Args:                  cobra.ExactArgs(1),
 		RunE:                  commandRefreshStateByShard,
 	}
+	// SetReplicationDelay makes a SetReplicationDelay gRPC call to a vtctld.
+	SetReplicationDelay = &cobra.Command{
+		Use:   "SetReplicationDelay --delay <duration> <alias>",
+		Short: "Makes a replica a delayed replica, which applies the events of its source with a delay.",
+		Long: `Makes a replica a delayed replica, which applies the events of its source with a delay.
+
+A delayed replica does not serve queries, and is never promoted by reparents.
+It keeps the data of the shard as it was the delay ago, which makes it a fast
+source to recover the data of a transaction that was applied by mistake: stop
+its replication before it applies the transaction. A zero delay makes it a
+regular replica again. The delay is kept across tablet restarts, unless the
+tablet is started with -init_replication_delay.`,
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+		RunE:                  commandSetReplicationDelay,
+	}
 )
 
 var changeTabletTypeOptions = struct {
@@ -137,6 +177,38 @@ func commandChangeTabletType(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+var changeTabletTagsOptions = struct {
+	Tags    map[string]string
+	Replace bool
+}{}
+
+func commandChangeTabletTags(cmd *cobra.Command, args []string) error {
+	alias, err := topoproto.ParseTabletAlias(cmd.Flags().Arg(0))
+	if err != nil {
+		return err
+	}
+
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.ChangeTabletTags(commandCtx, &vtctldatapb.ChangeTabletTagsRequest{
+		TabletAlias: alias,
+		Tags:        changeTabletTagsOptions.Tags,
+		Replace:     changeTabletTagsOptions.Replace,
+	})
+	if err != nil {
+		return err
+	}
+
+	data, err := cli.MarshalJSON(resp)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s\n", data)
+
+	return nil
+}
+
 var deleteTabletsOptions = struct {
 	AllowPrimary bool
 }{}
@@ -194,6 +266,14 @@ var getTabletsOptions = struct {
 
 	TabletAliasStrings []string
 
+	TabletTypes []string
+	Tags        map[string]string
+
+	SortBy         string
+	SortDescending bool
+	PageSize       int32
+	PageToken      string
+
 	Format string
 	Strict bool
 }{}
@@ -230,19 +310,44 @@ func commandGetTablets(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("--shard (= %s) cannot be passed without also passing --keyspace", getTabletsOptions.Shard)
 	}
 
+	tabletTypes := make([]topodatapb.TabletType, 0, len(getTabletsOptions.TabletTypes))
+	for _, typeStr := range getTabletsOptions.TabletTypes {
+		tabletType, err := topoproto.ParseTabletType(typeStr)
+		if err != nil {
+			return err
+		}
+
+		tabletTypes = append(tabletTypes, tabletType)
+	}
+
+	sortBy, ok := vtctldatapb.GetTabletsRequest_SortField_value[strings.ToUpper(getTabletsOptions.SortBy)]
+	if !ok {
+		return fmt.Errorf("invalid --sort-by (= %s)", getTabletsOptions.SortBy)
+	}
+
 	cli.FinishedParsing(cmd)
 
 	resp, err := client.GetTablets(commandCtx, &vtctldatapb.GetTabletsRequest{
-		TabletAliases: aliases,
-		Cells:         getTabletsOptions.Cells,
-		Keyspace:      getTabletsOptions.Keyspace,
-		Shard:         getTabletsOptions.Shard,
-		Strict:        getTabletsOptions.Strict,
+		TabletAliases:  aliases,
+		Cells:          getTabletsOptions.Cells,
+		Keyspace:       getTabletsOptions.Keyspace,
+		Shard:          getTabletsOptions.Shard,
+		Strict:         getTabletsOptions.Strict,
+		TabletTypes:    tabletTypes,
+		Tags:           getTabletsOptions.Tags,
+		SortBy:         vtctldatapb.GetTabletsRequest_SortField(sortBy),
+		SortDescending: getTabletsOptions.SortDescending,
+		PageSize:       getTabletsOptions.PageSize,
+		PageToken:      getTabletsOptions.PageToken,
 	})
 	if err != nil {
 		return err
 	}
 
+	if resp.NextPageToken != "" {
+		fmt.Fprintf(os.Stderr, "next page token: %s\n", resp.NextPageToken)
+	}
+
 	switch format {
 	case "awk":
 		for _, t := range resp.Tablets {
@@ -281,6 +386,7 @@ func commandRefreshState(cmd *cobra.Command, args []string) error {
 
 var refreshStateByShardOptions = struct {
 	Cells []string
+	Tags  map[string]string
 }{}
 
 func commandRefreshStateByShard(cmd *cobra.Command, args []string) error {
@@ -295,6 +401,7 @@ func commandRefreshStateByShard(cmd *cobra.Command, args []string) error {
 		Keyspace: keyspace,
 		Shard:    shard,
 		Cells:    refreshStateByShardOptions.Cells,
+		Tags:     refreshStateByShardOptions.Tags,
 	})
 	if err != nil {
 		return err
@@ -314,10 +421,44 @@ func commandRefreshStateByShard(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+var setReplicationDelayOptions = struct {
+	Delay time.Duration
+}{}
+
+func commandSetReplicationDelay(cmd *cobra.Command, args []string) error {
+	alias, err := topoproto.ParseTabletAlias(cmd.Flags().Arg(0))
+	if err != nil {
+		return err
+	}
+
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.SetReplicationDelay(commandCtx, &vtctldatapb.SetReplicationDelayRequest{
+		TabletAlias: alias,
+		Delay:       protoutil.DurationToProto(setReplicationDelayOptions.Delay),
+	})
+	if err != nil {
+		return err
+	}
+
+	data, err := cli.MarshalJSON(resp.Tablet)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s\n", data)
+
+	return nil
+}
+
 func init() {
 	ChangeTabletType.Flags().BoolVarP(&changeTabletTypeOptions.DryRun, "dry-run", "d", false, "Shows the proposed change without actually executing it")
 	Root.AddCommand(ChangeTabletType)
 
+	ChangeTabletTags.Flags().StringToStringVar(&changeTabletTagsOptions.Tags, "tag", nil, "Tablet tags to set, as name=value. An empty value removes the tag.")
+	ChangeTabletTags.Flags().BoolVar(&changeTabletTagsOptions.Replace, "replace", false, "Replace all the tags of the tablet with the specified ones.")
+	Root.AddCommand(ChangeTabletTags)
+
 	DeleteTablets.Flags().BoolVarP(&deleteTabletsOptions.AllowPrimary, "allow-primary", "p", false, "Allow the primary tablet of a shard to be deleted. Use with caution.")
 	Root.AddCommand(DeleteTablets)
 
@@ -329,10 +470,21 @@ func init() {
 	GetTablets.Flags().StringVarP(&getTabletsOptions.Shard, "shard", "s", "", "Shard to filter tablets by")
 	GetTablets.Flags().StringVar(&getTabletsOptions.Format, "format", "awk", "Output format to use; valid choices are (json, awk)")
 	GetTablets.Flags().BoolVar(&getTabletsOptions.Strict, "strict", false, "Require all cells to return successful tablet data. Without --strict, tablet listings may be partial.")
+	GetTablets.Flags().StringSliceVar(&getTabletsOptions.TabletTypes, "tablet-type", nil, "List of tablet types to filter tablets by")
+	GetTablets.Flags().StringToStringVar(&getTabletsOptions.Tags, "tag", nil, "Tablet tags, as name=value, to filter tablets by")
+	GetTablets.Flags().StringVar(&getTabletsOptions.SortBy, "sort-by", "unsorted", "Field to sort tablets by; valid choices are (alias, keyspace_shard, type, hostname)")
+	GetTablets.Flags().BoolVar(&getTabletsOptions.SortDescending, "desc", false, "Sort tablets in descending order of --sort-by")
+	GetTablets.Flags().Int32Var(&getTabletsOptions.PageSize, "page-size", 0, "Maximum number of tablets to return. Zero returns all tablets.")
+	GetTablets.Flags().StringVar(&getTabletsOptions.PageToken, "page-token", "", "Token of the page of tablets to return, from a previous GetTablets with the same flags")
 	Root.AddCommand(GetTablets)
 
 	Root.AddCommand(RefreshState)
 
 	RefreshStateByShard.Flags().StringSliceVarP(&refreshStateByShardOptions.Cells, "cells", "c", nil, "If specified, only call RefreshState on tablets in the specified cells. If empty, all cells are considered.")
+	RefreshStateByShard.Flags().StringToStringVar(&refreshStateByShardOptions.Tags, "tag", nil, "If specified, only call RefreshState on tablets with all these tags, as name=value.")
 	Root.AddCommand(RefreshStateByShard)
+
+	SetReplicationDelay.Flags().DurationVar(&setReplicationDelayOptions.Delay, "delay", 0, "Delay with which the replica applies the events of its source, in whole seconds. Zero makes it a regular replica again.")
+	SetReplicationDelay.MarkFlagRequired("delay")
+	Root.AddCommand(SetReplicationDelay)
 }
@@ -17,13 +17,20 @@ limitations under the License.
 package command
 
 import (
+	"bytes"
 	"fmt"
 	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os/user"
+	"strconv"
 
 	"github.com/spf13/cobra"
 
 	"vitess.io/vitess/go/cmd/vtctldclient/cli"
 	"vitess.io/vitess/go/json2"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topo/topoproto"
 
 	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
 	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
@@ -38,11 +45,52 @@ var (
 	}
 	// ApplyVSchema makes an ApplyVSchema gRPC call to a vtctld.
 	ApplyVSchema = &cobra.Command{
-		Use:                   "ApplyVSchema {-vschema=<vschema> || -vschema-file=<vschema file> || -sql=<sql> || -sql-file=<sql file>} [-cells=c1,c2,...] [-skip-rebuild] [-dry-run] <keyspace>",
+		Use:                   "ApplyVSchema {-vschema=<vschema> || -vschema-file=<vschema file> || -sql=<sql> || -sql-file=<sql file>} [-cells=c1,c2,...] [-skip-rebuild] [-dry-run [-plans-from=vtgate1,vtgate2,...]] [-author=<author>] [-comment=<comment>] <keyspace>",
 		Args:                  cobra.ExactArgs(1),
 		DisableFlagsInUseLine: true,
 		RunE:                  commandApplyVSchema,
 		Short:                 "Applies the VTGate routing schema to the provided keyspace. Shows the result after application.",
+		Long: `Applies the VTGate routing schema to the provided keyspace, and records it as a new revision of the vschema of the keyspace, with the author and comment.
+
+With --dry-run, the vschema is not saved. If --plans-from lists the web addresses of vtgates, the queries of their plan caches are planned again with the new vschema, and those whose plans would change are shown, by fingerprint.`,
+	}
+	// GetVSchemaRevisions makes a GetVSchemaRevisions gRPC call to a vtctld.
+	GetVSchemaRevisions = &cobra.Command{
+		Use:   "GetVSchemaRevisions <keyspace>",
+		Short: "Lists the recorded revisions of the vschema of the keyspace, oldest first.",
+		Args:  cobra.ExactArgs(1),
+		RunE:  commandGetVSchemaRevisions,
+	}
+	// RollbackVSchema makes a RollbackVSchema gRPC call to a vtctld.
+	RollbackVSchema = &cobra.Command{
+		Use:                   "RollbackVSchema [--cells=c1,c2,...] [--skip-rebuild] [--author=<author>] [--comment=<comment>] <keyspace> <version>",
+		Short:                 "Restores the vschema of the keyspace to the given revision, and records it as a new revision.",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(2),
+		RunE:                  commandRollbackVSchema,
+	}
+	// ScaffoldVSchema makes a ScaffoldVSchema gRPC call to a vtctld.
+	ScaffoldVSchema = &cobra.Command{
+		Use:   "ScaffoldVSchema [--tablet=<alias>] [--queries-file=<file>] <keyspace>",
+		Short: "Suggests a sharded vschema for the tables of an unsharded keyspace, and prints it as JSON with the reason for each table.",
+		Long: `Suggests a sharded vschema for the tables of an unsharded keyspace, from the schema of its primary, or of --tablet, and prints it as JSON with the reason for each table.
+
+The primary vindex of each table is the column of its foreign key to a parent table sharded by the referenced column, so that child rows live with their parent rows, or the column that the queries of --queries-file filter and join on most often, or its primary key. Small tables that other tables reference are suggested as reference tables. The notes of each table suggest the sequences to replace MySQL AUTO_INCREMENT columns with, and the lookup vindexes for the other filtered columns.
+
+The suggestion is not applied: review it, then apply it with ApplyVSchema once the keyspace is resharded.`,
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+		RunE:                  commandScaffoldVSchema,
+	}
+	// ValidateVSchema makes a ValidateVSchema gRPC call to a vtctld.
+	ValidateVSchema = &cobra.Command{
+		Use:   "ValidateVSchema [--shards=<shard>,...] [--exclude-tables=<table>,...] [--include-views] <keyspace>",
+		Short: "Checks the vschema of the keyspace against the schemas of the primaries of its shards, and prints its findings as JSON.",
+		Long: `Checks the vschema of the keyspace against the schemas of the primaries of its shards, and prints its findings as JSON, each with a suggested fix:
+tables of the vschema missing from the shards, tables of a sharded keyspace missing from the vschema, vindex and auto-increment columns missing from their tables, columns whose type does not suit their vindex, missing and unused sequences, unowned lookup vindexes, missing lookup tables, and MySQL AUTO_INCREMENT columns in sharded keyspaces.`,
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+		RunE:                  commandValidateVSchema,
 	}
 )
 
@@ -52,8 +100,11 @@ var applyVSchemaOptions = struct {
 	SQL         string
 	SQLFile     string
 	DryRun      bool
+	PlansFrom   []string
 	SkipRebuild bool
 	Cells       []string
+	Author      string
+	Comment     string
 }{}
 
 func commandApplyVSchema(cmd *cobra.Command, args []string) error {
@@ -73,6 +124,8 @@ func commandApplyVSchema(cmd *cobra.Command, args []string) error {
 		SkipRebuild: applyVSchemaOptions.SkipRebuild,
 		Cells:       applyVSchemaOptions.Cells,
 		DryRun:      applyVSchemaOptions.DryRun,
+		Author:      vschemaAuthor(applyVSchemaOptions.Author),
+		Comment:     applyVSchemaOptions.Comment,
 	}
 
 	var err error
@@ -97,7 +150,7 @@ func commandApplyVSchema(cmd *cobra.Command, args []string) error {
 			schema = []byte(applyVSchemaOptions.VSchema)
 		}
 
-		var vs *vschemapb.Keyspace
+		vs := &vschemapb.Keyspace{}
 		err = json2.Unmarshal(schema, vs)
 		if err != nil {
 			return err
@@ -116,9 +169,62 @@ func commandApplyVSchema(cmd *cobra.Command, args []string) error {
 		return err
 	}
 	fmt.Printf("New VSchema object:\n%s\nIf this is not what you expected, check the input data (as JSON parsing will skip unexpected fields).\n", data)
+	if !req.DryRun {
+		fmt.Printf("Saved as revision %d of the VSchema.\n", res.Version)
+		return nil
+	}
+
+	for _, vtgate := range applyVSchemaOptions.PlansFrom {
+		diffs, err := fetchVSchemaPlanDiff(vtgate, req.Keyspace, data)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Plans of the vtgate at %s that would change:\n%s\n", vtgate, diffs)
+	}
 	return nil
 }
 
+// fetchVSchemaPlanDiff returns the plans of the plan cache of the vtgate
+// that change with the vschema of the keyspace, as JSON.
+func fetchVSchemaPlanDiff(vtgate string, keyspace string, vschema []byte) ([]byte, error) {
+	u := url.URL{
+		Scheme:   "http",
+		Host:     vtgate,
+		Path:     "/debug/vschema_plan_diff",
+		RawQuery: url.Values{"keyspace": []string{keyspace}}.Encode(),
+	}
+	req, err := http.NewRequestWithContext(commandCtx, http.MethodPost, u.String(), bytes.NewReader(vschema))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("cannot get the plan diff from the vtgate at %s: %s: %s", vtgate, resp.Status, bytes.TrimSpace(body))
+	}
+	return body, nil
+}
+
+// vschemaAuthor returns the author of a vschema change, which defaults to
+// the user running the command.
+func vschemaAuthor(author string) string {
+	if author != "" {
+		return author
+	}
+	if u, err := user.Current(); err == nil {
+		return u.Username
+	}
+	return ""
+}
+
 func commandGetVSchema(cmd *cobra.Command, args []string) error {
 	cli.FinishedParsing(cmd)
 
@@ -141,6 +247,136 @@ func commandGetVSchema(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+func commandGetVSchemaRevisions(cmd *cobra.Command, args []string) error {
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.GetVSchemaRevisions(commandCtx, &vtctldatapb.GetVSchemaRevisionsRequest{
+		Keyspace: cmd.Flags().Arg(0),
+	})
+	if err != nil {
+		return err
+	}
+
+	data, err := cli.MarshalJSON(resp)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s\n", data)
+
+	return nil
+}
+
+var rollbackVSchemaOptions = struct {
+	SkipRebuild bool
+	Cells       []string
+	Author      string
+	Comment     string
+}{}
+
+func commandRollbackVSchema(cmd *cobra.Command, args []string) error {
+	version, err := strconv.ParseInt(cmd.Flags().Arg(1), 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid version %s: %w", cmd.Flags().Arg(1), err)
+	}
+
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.RollbackVSchema(commandCtx, &vtctldatapb.RollbackVSchemaRequest{
+		Keyspace:    cmd.Flags().Arg(0),
+		Version:     version,
+		Author:      vschemaAuthor(rollbackVSchemaOptions.Author),
+		Comment:     rollbackVSchemaOptions.Comment,
+		SkipRebuild: rollbackVSchemaOptions.SkipRebuild,
+		Cells:       rollbackVSchemaOptions.Cells,
+	})
+	if err != nil {
+		return err
+	}
+
+	data, err := cli.MarshalJSON(resp.VSchema)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Restored VSchema object:\n%s\nSaved as revision %d of the VSchema.\n", data, resp.Version)
+
+	return nil
+}
+
+var scaffoldVSchemaOptions = struct {
+	Tablet      string
+	QueriesFile string
+}{}
+
+func commandScaffoldVSchema(cmd *cobra.Command, args []string) error {
+	req := &vtctldatapb.ScaffoldVSchemaRequest{
+		Keyspace: cmd.Flags().Arg(0),
+	}
+	if scaffoldVSchemaOptions.Tablet != "" {
+		alias, err := topoproto.ParseTabletAlias(scaffoldVSchemaOptions.Tablet)
+		if err != nil {
+			return err
+		}
+		req.TabletAlias = alias
+	}
+	if scaffoldVSchemaOptions.QueriesFile != "" {
+		data, err := ioutil.ReadFile(scaffoldVSchemaOptions.QueriesFile)
+		if err != nil {
+			return err
+		}
+		req.Queries, err = sqlparser.SplitStatementToPieces(string(data))
+		if err != nil {
+			return err
+		}
+	}
+
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.ScaffoldVSchema(commandCtx, req)
+	if err != nil {
+		return err
+	}
+
+	data, err := cli.MarshalJSON(resp)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s\n", data)
+
+	return nil
+}
+
+var validateVSchemaOptions = struct {
+	Shards        []string
+	ExcludeTables []string
+	IncludeViews  bool
+}{}
+
+func commandValidateVSchema(cmd *cobra.Command, args []string) error {
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.ValidateVSchema(commandCtx, &vtctldatapb.ValidateVSchemaRequest{
+		Keyspace:      cmd.Flags().Arg(0),
+		Shards:        validateVSchemaOptions.Shards,
+		ExcludeTables: validateVSchemaOptions.ExcludeTables,
+		IncludeViews:  validateVSchemaOptions.IncludeViews,
+	})
+	if err != nil {
+		return err
+	}
+
+	data, err := cli.MarshalJSON(resp)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s\n", data)
+
+	return nil
+}
+
 func init() {
 	ApplyVSchema.Flags().StringVar(&applyVSchemaOptions.VSchema, "vschema", "", "VSchema")
 	ApplyVSchema.Flags().StringVar(&applyVSchemaOptions.VSchemaFile, "vschema-file", "", "VSchema File")
@@ -149,7 +385,26 @@ func init() {
 	ApplyVSchema.Flags().BoolVar(&applyVSchemaOptions.DryRun, "dry-run", false, "If set, do not save the altered vschema, simply echo to console.")
 	ApplyVSchema.Flags().BoolVar(&applyVSchemaOptions.SkipRebuild, "skip-rebuild", false, "If set, do no rebuild the SrvSchema objects.")
 	ApplyVSchema.Flags().StringSliceVar(&applyVSchemaOptions.Cells, "cells", nil, "If specified, limits the rebuild to the cells, after upload. Ignored if skipRebuild is set.")
+	ApplyVSchema.Flags().StringSliceVar(&applyVSchemaOptions.PlansFrom, "plans-from", nil, "With --dry-run, the web addresses (host:port) of the vtgates whose cached plans are checked against the new vschema.")
+	ApplyVSchema.Flags().StringVar(&applyVSchemaOptions.Author, "author", "", "The author of the change, recorded in the revision of the vschema. Defaults to the current user.")
+	ApplyVSchema.Flags().StringVar(&applyVSchemaOptions.Comment, "comment", "", "A description of the change, recorded in the revision of the vschema.")
 	Root.AddCommand(ApplyVSchema)
 
 	Root.AddCommand(GetVSchema)
+	Root.AddCommand(GetVSchemaRevisions)
+
+	RollbackVSchema.Flags().BoolVar(&rollbackVSchemaOptions.SkipRebuild, "skip-rebuild", false, "If set, do no rebuild the SrvSchema objects.")
+	RollbackVSchema.Flags().StringSliceVar(&rollbackVSchemaOptions.Cells, "cells", nil, "If specified, limits the rebuild to the cells, after upload. Ignored if skipRebuild is set.")
+	RollbackVSchema.Flags().StringVar(&rollbackVSchemaOptions.Author, "author", "", "The author of the rollback, recorded in the new revision of the vschema. Defaults to the current user.")
+	RollbackVSchema.Flags().StringVar(&rollbackVSchemaOptions.Comment, "comment", "", "A description of the rollback, recorded in the new revision of the vschema. Defaults to \"rollback to version <version>\".")
+	Root.AddCommand(RollbackVSchema)
+
+	ScaffoldVSchema.Flags().StringVar(&scaffoldVSchemaOptions.Tablet, "tablet", "", "The alias of the tablet to read the schema from. Defaults to the primary of the single shard of the keyspace.")
+	ScaffoldVSchema.Flags().StringVar(&scaffoldVSchemaOptions.QueriesFile, "queries-file", "", "A file of sample queries, separated by semicolons, whose predicates are used to pick the primary vindex columns.")
+	Root.AddCommand(ScaffoldVSchema)
+
+	ValidateVSchema.Flags().StringSliceVar(&validateVSchemaOptions.Shards, "shards", nil, "The shards to check the vschema against. Defaults to all the shards of the keyspace.")
+	ValidateVSchema.Flags().StringSliceVar(&validateVSchemaOptions.ExcludeTables, "exclude-tables", nil, "Tables of the shards to ignore.")
+	ValidateVSchema.Flags().BoolVar(&validateVSchemaOptions.IncludeViews, "include-views", true, "Include the views of the shards, so that the views of the vschema are not reported missing.")
+	Root.AddCommand(ValidateVSchema)
 }
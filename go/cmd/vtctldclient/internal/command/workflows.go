@@ -18,11 +18,22 @@ package command
 
 import (
 	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/proto"
 
 	"vitess.io/vitess/go/cmd/vtctldclient/cli"
+	"vitess.io/vitess/go/json2"
+	"vitess.io/vitess/go/protoutil"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/topo/topoproto"
 
+	logutilpb "vitess.io/vitess/go/vt/proto/logutil"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
 )
 
@@ -33,12 +44,216 @@ var (
 		Args: cobra.ExactArgs(1),
 		RunE: commandGetWorkflows,
 	}
+	// Materialize makes a MaterializeCreate gRPC call to a vtctld.
+	Materialize = &cobra.Command{
+		Use:                   "Materialize [--cells <cells>] [--tablet-types <types>] [--format {text|json}] <json_spec>",
+		Short:                 "Creates a Materialize workflow from a JSON MaterializeSettings spec.",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+		RunE:                  commandMaterialize,
+	}
+	// Migrate is the parent of the commands which create and drive Migrate
+	// workflows, which move tables from a keyspace of an external cluster.
+	Migrate = &cobra.Command{
+		Use:   "Migrate",
+		Short: "Creates and drives the Migrate workflows, which move tables from a keyspace of an external cluster mounted in the topo.",
+		Args:  cobra.NoArgs,
+	}
+	// MoveTables is the parent of the commands which create and drive
+	// MoveTables workflows.
+	MoveTables = &cobra.Command{
+		Use:   "MoveTables",
+		Short: "Creates and drives the MoveTables workflows, which move tables from one keyspace to another.",
+		Args:  cobra.NoArgs,
+	}
+	// ReferenceTables is the parent of the commands which create and drive
+	// the reference tables workflows.
+	ReferenceTables = &cobra.Command{
+		Use:   "ReferenceTables",
+		Short: "Creates and drives the reference tables workflows, which replicate reference tables from a keyspace to all the shards of other keyspaces.",
+		Long: `Creates and drives the reference tables workflows, which replicate reference tables from a keyspace to all the shards of other keyspaces.
+
+The workflows are stored in the topo. The vtctlds refresh them every --reference_tables_refresh_interval, creating the streams of the shards that a resharding added to the target keyspaces, and export the staleness of the streams of each shard as the ReferenceTablesStaleness gauge.`,
+		Args: cobra.NoArgs,
+	}
+	// Reshard is the parent of the commands which create and drive Reshard
+	// workflows.
+	Reshard = &cobra.Command{
+		Use:   "Reshard",
+		Short: "Creates and drives the Reshard workflows, which move the data of a keyspace from some shards to others.",
+		Args:  cobra.NoArgs,
+	}
+	// VDiff makes a VDiff gRPC call to a vtctld.
+	VDiff = &cobra.Command{
+		Use:                   "VDiff [--source-cell <cell>] [--target-cell <cell>] [--tablet-types <types>] [--filtered-replication-wait-time <duration>] [--limit <rows>] [--tables <tables>] [--debug-query] [--only-pks] [--format {text|json}] <keyspace.workflow>",
+		Short:                 "Compares the source and the target tables of a workflow.",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+		RunE:                  commandVDiff,
+	}
 )
 
-var getWorkflowsOptions = struct {
-	ShowAll bool
+// The workflow subcommands are built by functions, since each parent
+// command needs its own instances.
+
+func newWorkflowShowCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:                   "Show <keyspace.workflow>",
+		Aliases:               []string{"show"},
+		Short:                 "Displays the streams of the workflow.",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+		RunE:                  commandWorkflowShow,
+	}
+}
+
+func newWorkflowSwitchTrafficCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                   "SwitchTraffic [--cells <cells>] [--tablet-types <types>] [--timeout <duration>] [--enable-reverse-replication] [--dry-run] <keyspace.workflow>",
+		Aliases:               []string{"switchtraffic"},
+		Short:                 "Switches the traffic of the workflow to the target.",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+		RunE:                  commandWorkflowSwitchTraffic,
+	}
+	addWorkflowSwitchTrafficFlags(cmd)
+	return cmd
+}
+
+func newWorkflowReverseTrafficCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                   "ReverseTraffic [--cells <cells>] [--tablet-types <types>] [--timeout <duration>] [--enable-reverse-replication] [--dry-run] <keyspace.workflow>",
+		Aliases:               []string{"reversetraffic"},
+		Short:                 "Switches the traffic of the workflow back to the source.",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+		RunE:                  commandWorkflowReverseTraffic,
+	}
+	addWorkflowSwitchTrafficFlags(cmd)
+	return cmd
+}
+
+func newWorkflowCancelCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                   "Cancel [--keep-data] <keyspace.workflow>",
+		Aliases:               []string{"cancel"},
+		Short:                 "Deletes the target tables or shards, and the streams, of a workflow which has not switched any traffic.",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+		RunE:                  commandWorkflowCancel,
+	}
+	cmd.Flags().BoolVar(&workflowCancelOptions.KeepData, "keep-data", false, "Keep the target tables or shards, and only delete the streams.")
+	return cmd
+}
+
+func newWorkflowCompleteCommand(renameTables bool) *cobra.Command {
+	use := "Complete [--keep-data] [--dry-run] <keyspace.workflow>"
+	if renameTables {
+		use = "Complete [--keep-data] [--rename-tables] [--dry-run] <keyspace.workflow>"
+	}
+
+	cmd := &cobra.Command{
+		Use:                   use,
+		Aliases:               []string{"complete"},
+		Short:                 "Deletes the source tables or shards, and the streams, of a workflow which has switched all the traffic.",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+		RunE:                  commandWorkflowComplete,
+	}
+	cmd.Flags().BoolVar(&workflowCompleteOptions.KeepData, "keep-data", false, "Keep the source tables or shards, and only delete the streams.")
+	cmd.Flags().BoolVar(&workflowCompleteOptions.DryRun, "dry-run", false, "Only report the actions which would be taken.")
+	if renameTables {
+		cmd.Flags().BoolVar(&workflowCompleteOptions.RenameTables, "rename-tables", false, "Rename the source tables instead of dropping them.")
+	}
+	return cmd
+}
+
+func newWorkflowPauseCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                   "Pause [--dry-run] <keyspace.workflow> <reason>",
+		Aliases:               []string{"pause"},
+		Short:                 "Stops the streams of the workflow at a transaction boundary, recording the reason and the position in their message. Only Resume restarts them.",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(2),
+		RunE:                  commandWorkflowPause,
+	}
+	cmd.Flags().BoolVar(&workflowPauseOptions.DryRun, "dry-run", false, "Only report the streams which would be paused.")
+	return cmd
+}
+
+func newWorkflowResumeCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                   "Resume [--dry-run] <keyspace.workflow>",
+		Aliases:               []string{"resume"},
+		Short:                 "Restarts the paused streams of the workflow.",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+		RunE:                  commandWorkflowResume,
+	}
+	cmd.Flags().BoolVar(&workflowResumeOptions.DryRun, "dry-run", false, "Only report the streams which would be resumed.")
+	return cmd
+}
+
+var workflowOptions = struct {
+	Format string
 }{}
 
+// parseKeyspaceWorkflow parses a <keyspace.workflow> argument.
+func parseKeyspaceWorkflow(arg string) (keyspace string, workflow string, err error) {
+	parts := strings.Split(arg, ".")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("invalid format for <keyspace.workflow>: %s", arg)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+// parseWorkflowTabletTypes parses a comma-separated list of tablet types,
+// which may be empty.
+func parseWorkflowTabletTypes(tabletTypes string) ([]topodatapb.TabletType, error) {
+	if tabletTypes == "" {
+		return nil, nil
+	}
+
+	return topoproto.ParseTabletTypes(tabletTypes)
+}
+
+// checkWorkflowOutputFormat checks the --format flag of a workflow command.
+func checkWorkflowOutputFormat() error {
+	switch workflowOptions.Format {
+	case "text", "json":
+		return nil
+	default:
+		return fmt.Errorf("invalid output format, got %s", workflowOptions.Format)
+	}
+}
+
+// printWorkflowResponse prints the response of a workflow command as JSON
+// with --format=json, or else its events, and then the text output, if
+// any.
+func printWorkflowResponse(resp proto.Message, events []*logutilpb.Event, text func()) error {
+	if workflowOptions.Format == "json" {
+		data, err := cli.MarshalJSON(resp)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("%s\n", data)
+
+		return nil
+	}
+
+	for _, event := range events {
+		fmt.Println(logutil.EventString(event))
+	}
+
+	if text != nil {
+		text()
+	}
+
+	return nil
+}
+
 func commandGetWorkflows(cmd *cobra.Command, args []string) error {
 	cli.FinishedParsing(cmd)
 
@@ -63,7 +278,673 @@ func commandGetWorkflows(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+var getWorkflowsOptions = struct {
+	ShowAll bool
+}{}
+
+var materializeOptions = struct {
+	Cells       string
+	TabletTypes string
+}{}
+
+func commandMaterialize(cmd *cobra.Command, args []string) error {
+	if err := checkWorkflowOutputFormat(); err != nil {
+		return err
+	}
+
+	settings := &vtctldatapb.MaterializeSettings{}
+	if err := json2.Unmarshal([]byte(cmd.Flags().Arg(0)), settings); err != nil {
+		return err
+	}
+
+	if cmd.Flags().Changed("cells") {
+		settings.Cell = materializeOptions.Cells
+	}
+
+	if cmd.Flags().Changed("tablet-types") {
+		settings.TabletTypes = materializeOptions.TabletTypes
+	}
+
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.MaterializeCreate(commandCtx, &vtctldatapb.MaterializeCreateRequest{
+		Settings: settings,
+	})
+	if err != nil {
+		return err
+	}
+
+	return printWorkflowResponse(resp, resp.Events, nil)
+}
+
+var moveTablesCreateOptions = struct {
+	SourceKeyspace  string
+	ExternalCluster string
+	Cells           []string
+	TabletTypes     string
+	Tables          string
+	AllTables       bool
+	ExcludeTables   []string
+	AutoStart       bool
+	StopAfterCopy   bool
+}{}
+
+func commandMoveTablesCreate(cmd *cobra.Command, args []string) error {
+	if err := checkWorkflowOutputFormat(); err != nil {
+		return err
+	}
+
+	keyspace, workflowName, err := parseKeyspaceWorkflow(cmd.Flags().Arg(0))
+	if err != nil {
+		return err
+	}
+
+	tabletTypes, err := parseWorkflowTabletTypes(moveTablesCreateOptions.TabletTypes)
+	if err != nil {
+		return err
+	}
+
+	// The tables are not split, since they may be a JSON table spec.
+	var includeTables []string
+	if moveTablesCreateOptions.Tables != "" {
+		includeTables = []string{moveTablesCreateOptions.Tables}
+	}
+
+	if cmd.Parent() == Migrate && moveTablesCreateOptions.ExternalCluster == "" {
+		return fmt.Errorf("--external-cluster is required")
+	}
+
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.MoveTablesCreate(commandCtx, &vtctldatapb.MoveTablesCreateRequest{
+		Workflow:            workflowName,
+		SourceKeyspace:      moveTablesCreateOptions.SourceKeyspace,
+		TargetKeyspace:      keyspace,
+		Cells:               moveTablesCreateOptions.Cells,
+		TabletTypes:         tabletTypes,
+		IncludeTables:       includeTables,
+		AllTables:           moveTablesCreateOptions.AllTables,
+		ExcludeTables:       moveTablesCreateOptions.ExcludeTables,
+		ExternalClusterName: moveTablesCreateOptions.ExternalCluster,
+		AutoStart:           moveTablesCreateOptions.AutoStart,
+		StopAfterCopy:       moveTablesCreateOptions.StopAfterCopy,
+	})
+	if err != nil {
+		return err
+	}
+
+	return printWorkflowResponse(resp, resp.Events, func() {
+		fmt.Printf("Workflow %s.%s created\n", keyspace, workflowName)
+	})
+}
+
+var referenceTablesCreateOptions = struct {
+	SourceKeyspace  string
+	TargetKeyspaces []string
+	Tables          []string
+	Cells           []string
+	TabletTypes     string
+}{}
+
+func commandReferenceTablesCreate(cmd *cobra.Command, args []string) error {
+	if err := checkWorkflowOutputFormat(); err != nil {
+		return err
+	}
+
+	tabletTypes, err := parseWorkflowTabletTypes(referenceTablesCreateOptions.TabletTypes)
+	if err != nil {
+		return err
+	}
+
+	cli.FinishedParsing(cmd)
+
+	workflowName := cmd.Flags().Arg(0)
+	resp, err := client.ReferenceTablesCreate(commandCtx, &vtctldatapb.ReferenceTablesCreateRequest{
+		Workflow:        workflowName,
+		SourceKeyspace:  referenceTablesCreateOptions.SourceKeyspace,
+		TargetKeyspaces: referenceTablesCreateOptions.TargetKeyspaces,
+		Tables:          referenceTablesCreateOptions.Tables,
+		Cells:           referenceTablesCreateOptions.Cells,
+		TabletTypes:     tabletTypes,
+	})
+	if err != nil {
+		return err
+	}
+
+	return printWorkflowResponse(resp, resp.Events, func() {
+		fmt.Printf("Reference tables workflow %s created\n", workflowName)
+		printReferenceTablesShardStatuses(resp.Shards)
+	})
+}
+
+func commandReferenceTablesDelete(cmd *cobra.Command, args []string) error {
+	if err := checkWorkflowOutputFormat(); err != nil {
+		return err
+	}
+
+	cli.FinishedParsing(cmd)
+
+	workflowName := cmd.Flags().Arg(0)
+	resp, err := client.ReferenceTablesDelete(commandCtx, &vtctldatapb.ReferenceTablesDeleteRequest{
+		Workflow: workflowName,
+	})
+	if err != nil {
+		return err
+	}
+
+	return printWorkflowResponse(resp, resp.Events, func() {
+		fmt.Printf("Reference tables workflow %s deleted\n", workflowName)
+	})
+}
+
+func commandReferenceTablesRefresh(cmd *cobra.Command, args []string) error {
+	if err := checkWorkflowOutputFormat(); err != nil {
+		return err
+	}
+
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.ReferenceTablesRefresh(commandCtx, &vtctldatapb.ReferenceTablesRefreshRequest{
+		Workflows: cmd.Flags().Args(),
+	})
+	if err != nil {
+		return err
+	}
+
+	return printWorkflowResponse(resp, resp.Events, func() {
+		printReferenceTablesShardStatuses(resp.Shards)
+	})
+}
+
+func printReferenceTablesShardStatuses(statuses []*vtctldatapb.ReferenceTablesShardStatus) {
+	for _, status := range statuses {
+		line := fmt.Sprintf("%s %s/%s: %s", status.Workflow, status.Keyspace, status.Shard, status.State)
+		switch {
+		case status.Created:
+			line += ", streams created"
+		case status.Staleness != nil:
+			staleness, _, _ := protoutil.DurationFromProto(status.Staleness)
+			line += fmt.Sprintf(", staleness %v", staleness.Round(time.Second))
+		}
+		if status.Message != "" {
+			line += fmt.Sprintf(" (%s)", status.Message)
+		}
+		fmt.Println(line)
+	}
+}
+
+var reshardCreateOptions = struct {
+	SourceShards   []string
+	TargetShards   []string
+	Cells          []string
+	TabletTypes    string
+	SkipSchemaCopy bool
+	AutoStart      bool
+	StopAfterCopy  bool
+}{}
+
+func commandReshardCreate(cmd *cobra.Command, args []string) error {
+	if err := checkWorkflowOutputFormat(); err != nil {
+		return err
+	}
+
+	keyspace, workflowName, err := parseKeyspaceWorkflow(cmd.Flags().Arg(0))
+	if err != nil {
+		return err
+	}
+
+	tabletTypes, err := parseWorkflowTabletTypes(reshardCreateOptions.TabletTypes)
+	if err != nil {
+		return err
+	}
+
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.ReshardCreate(commandCtx, &vtctldatapb.ReshardCreateRequest{
+		Workflow:       workflowName,
+		Keyspace:       keyspace,
+		SourceShards:   reshardCreateOptions.SourceShards,
+		TargetShards:   reshardCreateOptions.TargetShards,
+		Cells:          reshardCreateOptions.Cells,
+		TabletTypes:    tabletTypes,
+		SkipSchemaCopy: reshardCreateOptions.SkipSchemaCopy,
+		AutoStart:      reshardCreateOptions.AutoStart,
+		StopAfterCopy:  reshardCreateOptions.StopAfterCopy,
+	})
+	if err != nil {
+		return err
+	}
+
+	return printWorkflowResponse(resp, resp.Events, func() {
+		fmt.Printf("Workflow %s.%s created\n", keyspace, workflowName)
+	})
+}
+
+var vDiffOptions = struct {
+	SourceCell                  string
+	TargetCell                  string
+	TabletTypes                 string
+	FilteredReplicationWaitTime time.Duration
+	Limit                       int64
+	Tables                      []string
+	DebugQuery                  bool
+	OnlyPks                     bool
+}{}
+
+func commandVDiff(cmd *cobra.Command, args []string) error {
+	if err := checkWorkflowOutputFormat(); err != nil {
+		return err
+	}
+
+	keyspace, workflowName, err := parseKeyspaceWorkflow(cmd.Flags().Arg(0))
+	if err != nil {
+		return err
+	}
+
+	tabletTypes, err := parseWorkflowTabletTypes(vDiffOptions.TabletTypes)
+	if err != nil {
+		return err
+	}
+
+	if vDiffOptions.Limit <= 0 {
+		return fmt.Errorf("--limit must be greater than 0")
+	}
+
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.VDiff(commandCtx, &vtctldatapb.VDiffRequest{
+		Keyspace:                    keyspace,
+		Workflow:                    workflowName,
+		SourceCell:                  vDiffOptions.SourceCell,
+		TargetCell:                  vDiffOptions.TargetCell,
+		TabletTypes:                 tabletTypes,
+		FilteredReplicationWaitTime: protoutil.DurationToProto(vDiffOptions.FilteredReplicationWaitTime),
+		MaxRows:                     vDiffOptions.Limit,
+		Tables:                      vDiffOptions.Tables,
+		DebugQuery:                  vDiffOptions.DebugQuery,
+		OnlyPks:                     vDiffOptions.OnlyPks,
+	})
+	if err != nil {
+		return err
+	}
+
+	return printWorkflowResponse(resp, nil, func() {
+		tables := make([]string, 0, len(resp.TableReports))
+		for table := range resp.TableReports {
+			tables = append(tables, table)
+		}
+		sort.Strings(tables)
+
+		for _, table := range tables {
+			report := resp.TableReports[table]
+			fmt.Printf("Summary for table %v:\n", table)
+			fmt.Printf("\tProcessedRows: %v\n", report.ProcessedRows)
+			fmt.Printf("\tMatchingRows: %v\n", report.MatchingRows)
+			fmt.Printf("\tMismatchedRows: %v\n", report.MismatchedRows)
+			fmt.Printf("\tExtraRowsSource: %v\n", report.ExtraRowsSource)
+			fmt.Printf("\tExtraRowsTarget: %v\n", report.ExtraRowsTarget)
+		}
+	})
+}
+
+func commandWorkflowShow(cmd *cobra.Command, args []string) error {
+	keyspace, workflowName, err := parseKeyspaceWorkflow(cmd.Flags().Arg(0))
+	if err != nil {
+		return err
+	}
+
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.GetWorkflows(commandCtx, &vtctldatapb.GetWorkflowsRequest{
+		Keyspace: keyspace,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, wf := range resp.Workflows {
+		if wf.Name != workflowName {
+			continue
+		}
+
+		data, err := cli.MarshalJSON(wf)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("%s\n", data)
+
+		return nil
+	}
+
+	return fmt.Errorf("workflow %s.%s does not exist", keyspace, workflowName)
+}
+
+var workflowSwitchTrafficOptions = struct {
+	Cells                    []string
+	TabletTypes              string
+	Timeout                  time.Duration
+	EnableReverseReplication bool
+	DryRun                   bool
+}{}
+
+func addWorkflowSwitchTrafficFlags(cmd *cobra.Command) {
+	cmd.Flags().StringSliceVar(&workflowSwitchTrafficOptions.Cells, "cells", nil, "Cells in which to switch the read traffic. Defaults to all cells.")
+	cmd.Flags().StringVar(&workflowSwitchTrafficOptions.TabletTypes, "tablet-types", "", "Comma-separated tablet types whose traffic is switched. Defaults to primary,replica,rdonly.")
+	cmd.Flags().DurationVar(&workflowSwitchTrafficOptions.Timeout, "timeout", 30*time.Second, "Maximum time to wait for vreplication to catch up when switching the writes.")
+	cmd.Flags().BoolVar(&workflowSwitchTrafficOptions.EnableReverseReplication, "enable-reverse-replication", true, "Start the reverse replication, from the target to the source, when switching the writes.")
+	cmd.Flags().BoolVar(&workflowSwitchTrafficOptions.DryRun, "dry-run", false, "Only report the actions which would be taken.")
+}
+
+// The traffic switch directions of the WorkflowSwitchTraffic requests.
+const (
+	workflowDirectionForward  int32 = 0
+	workflowDirectionBackward int32 = 1
+)
+
+func commandWorkflowSwitchTraffic(cmd *cobra.Command, args []string) error {
+	return switchWorkflowTraffic(cmd, workflowDirectionForward)
+}
+
+func commandWorkflowReverseTraffic(cmd *cobra.Command, args []string) error {
+	return switchWorkflowTraffic(cmd, workflowDirectionBackward)
+}
+
+func switchWorkflowTraffic(cmd *cobra.Command, direction int32) error {
+	if err := checkWorkflowOutputFormat(); err != nil {
+		return err
+	}
+
+	keyspace, workflowName, err := parseKeyspaceWorkflow(cmd.Flags().Arg(0))
+	if err != nil {
+		return err
+	}
+
+	tabletTypes, err := parseWorkflowTabletTypes(workflowSwitchTrafficOptions.TabletTypes)
+	if err != nil {
+		return err
+	}
+
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.WorkflowSwitchTraffic(commandCtx, &vtctldatapb.WorkflowSwitchTrafficRequest{
+		Keyspace:                 keyspace,
+		Workflow:                 workflowName,
+		Cells:                    workflowSwitchTrafficOptions.Cells,
+		TabletTypes:              tabletTypes,
+		Timeout:                  protoutil.DurationToProto(workflowSwitchTrafficOptions.Timeout),
+		EnableReverseReplication: workflowSwitchTrafficOptions.EnableReverseReplication,
+		Direction:                direction,
+		DryRun:                   workflowSwitchTrafficOptions.DryRun,
+	})
+	if err != nil {
+		return err
+	}
+
+	return printWorkflowResponse(resp, resp.Events, func() {
+		if workflowSwitchTrafficOptions.DryRun {
+			fmt.Printf("Dry Run results for %s.%s:\n\n%s\n", keyspace, workflowName, strings.Join(resp.DryRunResults, "\n"))
+			return
+		}
+
+		fmt.Printf("Start State: %s\n", resp.StartState)
+		fmt.Printf("Current State: %s\n", resp.CurrentState)
+	})
+}
+
+var workflowCancelOptions = struct {
+	KeepData bool
+}{}
+
+func commandWorkflowCancel(cmd *cobra.Command, args []string) error {
+	if err := checkWorkflowOutputFormat(); err != nil {
+		return err
+	}
+
+	keyspace, workflowName, err := parseKeyspaceWorkflow(cmd.Flags().Arg(0))
+	if err != nil {
+		return err
+	}
+
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.WorkflowCancel(commandCtx, &vtctldatapb.WorkflowCancelRequest{
+		Keyspace: keyspace,
+		Workflow: workflowName,
+		KeepData: workflowCancelOptions.KeepData,
+	})
+	if err != nil {
+		return err
+	}
+
+	return printWorkflowResponse(resp, resp.Events, func() {
+		fmt.Printf("Workflow %s.%s cancelled\n", keyspace, workflowName)
+	})
+}
+
+var workflowCompleteOptions = struct {
+	KeepData     bool
+	RenameTables bool
+	DryRun       bool
+}{}
+
+func commandWorkflowComplete(cmd *cobra.Command, args []string) error {
+	if err := checkWorkflowOutputFormat(); err != nil {
+		return err
+	}
+
+	keyspace, workflowName, err := parseKeyspaceWorkflow(cmd.Flags().Arg(0))
+	if err != nil {
+		return err
+	}
+
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.WorkflowComplete(commandCtx, &vtctldatapb.WorkflowCompleteRequest{
+		Keyspace:     keyspace,
+		Workflow:     workflowName,
+		KeepData:     workflowCompleteOptions.KeepData,
+		RenameTables: workflowCompleteOptions.RenameTables,
+		DryRun:       workflowCompleteOptions.DryRun,
+	})
+	if err != nil {
+		return err
+	}
+
+	return printWorkflowResponse(resp, resp.Events, func() {
+		if workflowCompleteOptions.DryRun {
+			fmt.Printf("Dry Run results for %s.%s:\n\n%s\n", keyspace, workflowName, strings.Join(resp.DryRunResults, "\n"))
+			return
+		}
+
+		fmt.Printf("Workflow %s.%s completed\n", keyspace, workflowName)
+	})
+}
+
+var workflowPauseOptions = struct {
+	DryRun bool
+}{}
+
+func commandWorkflowPause(cmd *cobra.Command, args []string) error {
+	if err := checkWorkflowOutputFormat(); err != nil {
+		return err
+	}
+
+	keyspace, workflowName, err := parseKeyspaceWorkflow(cmd.Flags().Arg(0))
+	if err != nil {
+		return err
+	}
+
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.WorkflowPause(commandCtx, &vtctldatapb.WorkflowPauseRequest{
+		Keyspace: keyspace,
+		Workflow: workflowName,
+		Reason:   cmd.Flags().Arg(1),
+		DryRun:   workflowPauseOptions.DryRun,
+	})
+	if err != nil {
+		return err
+	}
+
+	return printWorkflowResponse(resp, resp.Events, func() {
+		if workflowPauseOptions.DryRun {
+			return
+		}
+
+		fmt.Printf("Workflow %s.%s paused\n", keyspace, workflowName)
+		printStreamsByTablet(resp.StreamsByTablet)
+	})
+}
+
+var workflowResumeOptions = struct {
+	DryRun bool
+}{}
+
+func commandWorkflowResume(cmd *cobra.Command, args []string) error {
+	if err := checkWorkflowOutputFormat(); err != nil {
+		return err
+	}
+
+	keyspace, workflowName, err := parseKeyspaceWorkflow(cmd.Flags().Arg(0))
+	if err != nil {
+		return err
+	}
+
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.WorkflowResume(commandCtx, &vtctldatapb.WorkflowResumeRequest{
+		Keyspace: keyspace,
+		Workflow: workflowName,
+		DryRun:   workflowResumeOptions.DryRun,
+	})
+	if err != nil {
+		return err
+	}
+
+	return printWorkflowResponse(resp, resp.Events, func() {
+		if workflowResumeOptions.DryRun {
+			return
+		}
+
+		fmt.Printf("Workflow %s.%s resumed\n", keyspace, workflowName)
+		printStreamsByTablet(resp.StreamsByTablet)
+	})
+}
+
+// printStreamsByTablet prints the numbers of streams updated on each target
+// primary, in tablet alias order.
+func printStreamsByTablet(streamsByTablet map[string]uint64) {
+	aliases := make([]string, 0, len(streamsByTablet))
+	for alias := range streamsByTablet {
+		aliases = append(aliases, alias)
+	}
+	sort.Strings(aliases)
+
+	for _, alias := range aliases {
+		fmt.Printf("%s: %d streams\n", alias, streamsByTablet[alias])
+	}
+}
+
 func init() {
 	GetWorkflows.Flags().BoolVarP(&getWorkflowsOptions.ShowAll, "show-all", "a", false, "Show all workflows instead of just active workflows")
 	Root.AddCommand(GetWorkflows)
+
+	for _, cmd := range []*cobra.Command{Materialize, Migrate, MoveTables, ReferenceTables, Reshard, VDiff} {
+		cmd.PersistentFlags().StringVar(&workflowOptions.Format, "format", "text", "Output format to use; valid choices are (text, json)")
+	}
+
+	Materialize.Flags().StringVar(&materializeOptions.Cells, "cells", "", "Comma-separated cells to replicate from. Overrides the cell of the spec.")
+	Materialize.Flags().StringVar(&materializeOptions.TabletTypes, "tablet-types", "", "Comma-separated source tablet types to replicate from. Overrides the tablet types of the spec.")
+	Root.AddCommand(Materialize)
+
+	for _, parent := range []*cobra.Command{MoveTables, Migrate} {
+		create := &cobra.Command{
+			Use:                   "Create --source <keyspace> {--tables <tables> | --all [--exclude <tables>]} [--cells <cells>] [--tablet-types <types>] [--auto-start] [--stop-after-copy] <keyspace.workflow>",
+			Aliases:               []string{"create"},
+			Short:                 "Creates a workflow which moves tables from the source keyspace to the target keyspace.",
+			DisableFlagsInUseLine: true,
+			Args:                  cobra.ExactArgs(1),
+			RunE:                  commandMoveTablesCreate,
+		}
+		if parent == Migrate {
+			create.Use = "Create --external-cluster <cluster> --source <keyspace> {--tables <tables> | --all [--exclude <tables>]} [--cells <cells>] [--tablet-types <types>] [--auto-start] [--stop-after-copy] <keyspace.workflow>"
+			create.Short = "Creates a workflow which moves tables from the source keyspace of an external cluster to the target keyspace."
+			create.Flags().StringVar(&moveTablesCreateOptions.ExternalCluster, "external-cluster", "", "Name of the external cluster, mounted in the topo, which has the source keyspace.")
+		}
+		create.Flags().StringVar(&moveTablesCreateOptions.SourceKeyspace, "source", "", "Source keyspace.")
+		create.Flags().StringSliceVar(&moveTablesCreateOptions.Cells, "cells", nil, "Cells, or cell aliases, to replicate from. Defaults to the cell of each target primary.")
+		create.Flags().StringVar(&moveTablesCreateOptions.TabletTypes, "tablet-types", "", "Comma-separated source tablet types to replicate from, in order of preference.")
+		create.Flags().StringVar(&moveTablesCreateOptions.Tables, "tables", "", "Comma-separated tables to move, or a JSON table spec.")
+		create.Flags().BoolVar(&moveTablesCreateOptions.AllTables, "all", false, "Move all the tables of the source keyspace.")
+		create.Flags().StringSliceVar(&moveTablesCreateOptions.ExcludeTables, "exclude", nil, "Tables not to move, with --all.")
+		create.Flags().BoolVar(&moveTablesCreateOptions.AutoStart, "auto-start", true, "Start the streams once they are created. Otherwise they are created in the Stopped state.")
+		create.Flags().BoolVar(&moveTablesCreateOptions.StopAfterCopy, "stop-after-copy", false, "Stop the streams once the copy phase is completed.")
+		parent.AddCommand(create)
+	}
+
+	referenceTablesCreate := &cobra.Command{
+		Use:                   "Create --source <keyspace> --targets <keyspaces> --tables <tables> [--cells <cells>] [--tablet-types <types>] <workflow>",
+		Aliases:               []string{"create"},
+		Short:                 "Creates a workflow which replicates reference tables from the source keyspace to all the shards of the target keyspaces.",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+		RunE:                  commandReferenceTablesCreate,
+	}
+	referenceTablesCreate.Flags().StringVar(&referenceTablesCreateOptions.SourceKeyspace, "source", "", "Source keyspace.")
+	referenceTablesCreate.Flags().StringSliceVar(&referenceTablesCreateOptions.TargetKeyspaces, "targets", nil, "Target keyspaces.")
+	referenceTablesCreate.Flags().StringSliceVar(&referenceTablesCreateOptions.Tables, "tables", nil, "Tables to replicate, which must be reference tables in the vschemas of the sharded target keyspaces.")
+	referenceTablesCreate.Flags().StringSliceVar(&referenceTablesCreateOptions.Cells, "cells", nil, "Cells, or cell aliases, to replicate from. Defaults to the cell of each target primary.")
+	referenceTablesCreate.Flags().StringVar(&referenceTablesCreateOptions.TabletTypes, "tablet-types", "", "Comma-separated source tablet types to replicate from, in order of preference.")
+	ReferenceTables.AddCommand(referenceTablesCreate)
+	ReferenceTables.AddCommand(&cobra.Command{
+		Use:                   "Refresh [<workflow> ...]",
+		Aliases:               []string{"refresh"},
+		Short:                 "Creates the missing streams of the workflows, or of all of them, and displays the status of the streams of every target shard.",
+		DisableFlagsInUseLine: true,
+		RunE:                  commandReferenceTablesRefresh,
+	})
+	ReferenceTables.AddCommand(&cobra.Command{
+		Use:                   "Delete <workflow>",
+		Aliases:               []string{"delete"},
+		Short:                 "Deletes the streams of the workflow, and the workflow. The replicated tables are kept.",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+		RunE:                  commandReferenceTablesDelete,
+	})
+	Root.AddCommand(ReferenceTables)
+
+	reshardCreate := &cobra.Command{
+		Use:                   "Create --source-shards <shards> --target-shards <shards> [--cells <cells>] [--tablet-types <types>] [--skip-schema-copy] [--auto-start] [--stop-after-copy] <keyspace.workflow>",
+		Aliases:               []string{"create"},
+		Short:                 "Creates a workflow which moves the data of the keyspace from the source shards to the target shards.",
+		DisableFlagsInUseLine: true,
+		Args:                  cobra.ExactArgs(1),
+		RunE:                  commandReshardCreate,
+	}
+	reshardCreate.Flags().StringSliceVar(&reshardCreateOptions.SourceShards, "source-shards", nil, "Source shards.")
+	reshardCreate.Flags().StringSliceVar(&reshardCreateOptions.TargetShards, "target-shards", nil, "Target shards.")
+	reshardCreate.Flags().StringSliceVar(&reshardCreateOptions.Cells, "cells", nil, "Cells, or cell aliases, to replicate from. Defaults to the cell of each target primary.")
+	reshardCreate.Flags().StringVar(&reshardCreateOptions.TabletTypes, "tablet-types", "", "Comma-separated source tablet types to replicate from, in order of preference.")
+	reshardCreate.Flags().BoolVar(&reshardCreateOptions.SkipSchemaCopy, "skip-schema-copy", false, "Do not copy the schema of the source shards to the target shards, which must already have it.")
+	reshardCreate.Flags().BoolVar(&reshardCreateOptions.AutoStart, "auto-start", true, "Start the streams once they are created. Otherwise they are created in the Stopped state.")
+	reshardCreate.Flags().BoolVar(&reshardCreateOptions.StopAfterCopy, "stop-after-copy", false, "Stop the streams once the copy phase is completed.")
+	Reshard.AddCommand(reshardCreate)
+
+	MoveTables.AddCommand(newWorkflowShowCommand(), newWorkflowPauseCommand(), newWorkflowResumeCommand(), newWorkflowSwitchTrafficCommand(), newWorkflowReverseTrafficCommand(), newWorkflowCancelCommand(), newWorkflowCompleteCommand(true))
+	Reshard.AddCommand(newWorkflowShowCommand(), newWorkflowPauseCommand(), newWorkflowResumeCommand(), newWorkflowSwitchTrafficCommand(), newWorkflowReverseTrafficCommand(), newWorkflowCancelCommand(), newWorkflowCompleteCommand(false))
+	Migrate.AddCommand(newWorkflowShowCommand(), newWorkflowPauseCommand(), newWorkflowResumeCommand(), newWorkflowCancelCommand(), newWorkflowCompleteCommand(false))
+	Root.AddCommand(Migrate)
+	Root.AddCommand(MoveTables)
+	Root.AddCommand(Reshard)
+
+	VDiff.Flags().StringVar(&vDiffOptions.SourceCell, "source-cell", "", "Source cell to compare from. Defaults to any available cell.")
+	VDiff.Flags().StringVar(&vDiffOptions.TargetCell, "target-cell", "", "Target cell to compare with. Defaults to any available cell.")
+	VDiff.Flags().StringVar(&vDiffOptions.TabletTypes, "tablet-types", "primary,replica,rdonly", "Comma-separated tablet types for the source and the target.")
+	VDiff.Flags().DurationVar(&vDiffOptions.FilteredReplicationWaitTime, "filtered-replication-wait-time", 30*time.Second, "Maximum time to wait for the target to catch up with the source.")
+	VDiff.Flags().Int64Var(&vDiffOptions.Limit, "limit", math.MaxInt64, "Maximum number of rows to compare, per table.")
+	VDiff.Flags().StringSliceVar(&vDiffOptions.Tables, "tables", nil, "Only diff these tables of the workflow.")
+	VDiff.Flags().BoolVar(&vDiffOptions.DebugQuery, "debug-query", false, "Add the queries of the sampled rows to the report.")
+	VDiff.Flags().BoolVar(&vDiffOptions.OnlyPks, "only-pks", false, "Only sample the primary key columns of the rows.")
+	Root.AddCommand(VDiff)
 }
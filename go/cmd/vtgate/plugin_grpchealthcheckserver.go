@@ -0,0 +1,41 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+// Imports and registers the gRPC health check server, which streams the health
+// of the tablets seen by the tablet gateway.
+
+import (
+	"vitess.io/vitess/go/vt/discovery/grpchealthcheckserver"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/servenv"
+	"vitess.io/vitess/go/vt/vtgate"
+)
+
+func init() {
+	servenv.OnRun(func() {
+		if !servenv.GRPCCheckServiceMap("healthcheck") {
+			return
+		}
+		hc := vtgate.HealthCheck()
+		if hc == nil {
+			log.Warning("Not registering the healthcheck gRPC service: only the tabletgateway has a health check")
+			return
+		}
+		grpchealthcheckserver.RegisterServer(servenv.GRPCServer, hc)
+	})
+}
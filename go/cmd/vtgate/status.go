@@ -46,5 +46,8 @@ func addStatusParts(vtg *vtgate.VTGate) {
 		servenv.AddStatusPart("Health Check Cache", discovery.HealthCheckTemplate, func() interface{} {
 			return vtg.Gateway().TabletsCacheStatus()
 		})
+		servenv.AddStatusPart("Circuit Breakers", vtgate.CircuitBreakerTemplate, func() interface{} {
+			return vtg.Gateway().CircuitBreakerStatus()
+		})
 	}
 }
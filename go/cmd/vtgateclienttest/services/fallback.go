@@ -52,6 +52,10 @@ func (c fallbackClient) StreamExecute(ctx context.Context, session *vtgatepb.Ses
 	return c.fallback.StreamExecute(ctx, session, sql, bindVariables, callback)
 }
 
+func (c fallbackClient) StreamExecuteBatch(ctx context.Context, session *vtgatepb.Session, queries []*vtgatepb.BatchQuery, abortOnError bool, callback func(int, *vtgatepb.Session, *sqltypes.Result, error) error) error {
+	return c.fallback.StreamExecuteBatch(ctx, session, queries, abortOnError, callback)
+}
+
 func (c fallbackClient) Prepare(ctx context.Context, session *vtgatepb.Session, sql string, bindVariables map[string]*querypb.BindVariable) (*vtgatepb.Session, []*querypb.Field, error) {
 	return c.fallback.Prepare(ctx, session, sql, bindVariables)
 }
@@ -60,6 +64,14 @@ func (c fallbackClient) CloseSession(ctx context.Context, session *vtgatepb.Sess
 	return c.fallback.CloseSession(ctx, session)
 }
 
+func (c fallbackClient) ExportSession(ctx context.Context, session *vtgatepb.Session) (string, error) {
+	return c.fallback.ExportSession(ctx, session)
+}
+
+func (c fallbackClient) ImportSession(ctx context.Context, token string) (*vtgatepb.Session, error) {
+	return c.fallback.ImportSession(ctx, token)
+}
+
 func (c fallbackClient) ResolveTransaction(ctx context.Context, dtid string) error {
 	return c.fallback.ResolveTransaction(ctx, dtid)
 }
@@ -62,6 +62,10 @@ func (c *terminalClient) StreamExecute(ctx context.Context, session *vtgatepb.Se
 	return errTerminal
 }
 
+func (c *terminalClient) StreamExecuteBatch(ctx context.Context, session *vtgatepb.Session, queries []*vtgatepb.BatchQuery, abortOnError bool, callback func(int, *vtgatepb.Session, *sqltypes.Result, error) error) error {
+	return errTerminal
+}
+
 func (c *terminalClient) Prepare(ctx context.Context, session *vtgatepb.Session, sql string, bindVariables map[string]*querypb.BindVariable) (*vtgatepb.Session, []*querypb.Field, error) {
 	return session, nil, errTerminal
 }
@@ -70,6 +74,14 @@ func (c *terminalClient) CloseSession(ctx context.Context, session *vtgatepb.Ses
 	return errTerminal
 }
 
+func (c *terminalClient) ExportSession(ctx context.Context, session *vtgatepb.Session) (string, error) {
+	return "", errTerminal
+}
+
+func (c *terminalClient) ImportSession(ctx context.Context, token string) (*vtgatepb.Session, error) {
+	return nil, errTerminal
+}
+
 func (c *terminalClient) ResolveTransaction(ctx context.Context, dtid string) error {
 	return errTerminal
 }
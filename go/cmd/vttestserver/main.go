@@ -22,12 +22,15 @@ import (
 	"encoding/json"
 	"flag"
 	"fmt"
+	"io/ioutil"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"syscall"
 
+	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/encoding/prototext"
 
 	"vitess.io/vitess/go/vt/log"
@@ -44,13 +47,14 @@ type topoFlags struct {
 }
 
 var (
-	basePort  int
-	config    vttest.Config
-	doSeed    bool
-	mycnf     string
-	protoTopo string
-	seed      vttest.SeedConfig
-	topo      topoFlags
+	basePort     int
+	config       vttest.Config
+	doSeed       bool
+	mycnf        string
+	protoTopo    string
+	topologyFile string
+	seed         vttest.SeedConfig
+	topo         topoFlags
 )
 
 func init() {
@@ -61,6 +65,13 @@ func init() {
 		"Define the fake cluster topology as a compact text format encoded"+
 			" vttest proto. See vttest.proto for more information.")
 
+	flag.StringVar(&topologyFile, "topology_file", "",
+		"File defining the fake cluster topology as a vttest proto, in the"+
+			" JSON format if the file name ends with .json, and in the compact"+
+			" text format otherwise. Besides the keyspaces and their shards, the"+
+			" topology can define the vschema and the seed files of each"+
+			" keyspace, and the routing rules. See vttest.proto for more information.")
+
 	flag.StringVar(&config.SchemaDir, "schema_dir", "",
 		"Directory for initial schema files. Within this dir,"+
 			" there should be a subdir for each keyspace. Within"+
@@ -188,6 +199,42 @@ func (t *topoFlags) buildTopology() (*vttestpb.VTTestTopology, error) {
 	return topo, nil
 }
 
+// loadTopologyFile reads a vttest topology from a file, in the JSON format if
+// its name ends with .json, and in the compact text format otherwise.
+func loadTopologyFile(name string) (*vttestpb.VTTestTopology, error) {
+	data, err := ioutil.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+
+	topology := &vttestpb.VTTestTopology{}
+	if strings.EqualFold(filepath.Ext(name), ".json") {
+		err = protojson.Unmarshal(data, topology)
+	} else {
+		err = prototext.Unmarshal(data, topology)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse the topology file %s: %v", name, err)
+	}
+
+	if len(topology.Cells) == 0 {
+		topology.Cells = append(topology.Cells, "test")
+	}
+	for _, ks := range topology.Keyspaces {
+		if ks.ServedFrom == "" && len(ks.Shards) == 0 {
+			return nil, fmt.Errorf("keyspace %s of the topology file %s has no shards", ks.Name, name)
+		}
+		// Seed files are relative to the topology file.
+		for i, file := range ks.SeedFiles {
+			if !filepath.IsAbs(file) {
+				ks.SeedFiles[i] = filepath.Join(filepath.Dir(name), file)
+			}
+		}
+	}
+
+	return topology, nil
+}
+
 func parseFlags() (env vttest.Environment, err error) {
 	flag.Parse()
 
@@ -205,12 +252,11 @@ func parseFlags() (env vttest.Environment, err error) {
 		}
 	}
 
-	if protoTopo == "" {
-		config.Topology, err = topo.buildTopology()
-		if err != nil {
-			return
-		}
-	} else {
+	switch {
+	case protoTopo != "" && topologyFile != "":
+		err = fmt.Errorf("cannot pass both -proto_topo and -topology_file")
+		return
+	case protoTopo != "":
 		var topology vttestpb.VTTestTopology
 		err = prototext.Unmarshal([]byte(protoTopo), &topology)
 		if err != nil {
@@ -220,6 +266,16 @@ func parseFlags() (env vttest.Environment, err error) {
 			topology.Cells = append(topology.Cells, "test")
 		}
 		config.Topology = &topology
+	case topologyFile != "":
+		config.Topology, err = loadTopologyFile(topologyFile)
+		if err != nil {
+			return
+		}
+	default:
+		config.Topology, err = topo.buildTopology()
+		if err != nil {
+			return
+		}
 	}
 
 	if doSeed {
@@ -309,6 +309,54 @@ func TestMtlsAuthUnauthorizedFails(t *testing.T) {
 	assert.Contains(t, err.Error(), "code = Unauthenticated desc = client certificate not authorized")
 }
 
+func TestLoadTopologyFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "vttestserver_topology_")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	jsonFile := path.Join(dir, "topology.json")
+	err = ioutil.WriteFile(jsonFile, []byte(`{
+		"keyspaces": [{
+			"name": "user",
+			"shards": [{"name": "-80"}, {"name": "80-"}],
+			"vschema": {
+				"sharded": true,
+				"vindexes": {"hash": {"type": "hash"}},
+				"tables": {"user": {"column_vindexes": [{"column": "id", "name": "hash"}]}}
+			},
+			"seed_files": ["user.sql", "/data/user_extra.sql"]
+		}, {
+			"name": "lookup",
+			"shards": [{"name": "0"}]
+		}],
+		"routing_rules": {"rules": [{"from_table": "users", "to_tables": ["user.user"]}]}
+	}`), 0644)
+	require.NoError(t, err)
+
+	topology, err := loadTopologyFile(jsonFile)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"test"}, topology.Cells)
+	require.Len(t, topology.Keyspaces, 2)
+	assert.True(t, topology.Keyspaces[0].Vschema.Sharded)
+	assert.Equal(t, "hash", topology.Keyspaces[0].Vschema.Tables["user"].ColumnVindexes[0].Name)
+	assert.Equal(t, []string{path.Join(dir, "user.sql"), "/data/user_extra.sql"}, topology.Keyspaces[0].SeedFiles)
+	assert.Equal(t, "users", topology.RoutingRules.Rules[0].FromTable)
+
+	textFile := path.Join(dir, "topology.txt")
+	err = ioutil.WriteFile(textFile, []byte(`cells: "zone1" keyspaces { name: "user" shards { name: "0" } seed_files: "user.sql" }`), 0644)
+	require.NoError(t, err)
+
+	topology, err = loadTopologyFile(textFile)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"zone1"}, topology.Cells)
+	assert.Equal(t, []string{path.Join(dir, "user.sql")}, topology.Keyspaces[0].SeedFiles)
+
+	err = ioutil.WriteFile(textFile, []byte(`keyspaces { name: "user" }`), 0644)
+	require.NoError(t, err)
+	_, err = loadTopologyFile(textFile)
+	assert.EqualError(t, err, fmt.Sprintf("keyspace user of the topology file %s has no shards", textFile))
+}
+
 func startPersistentCluster(dir string, flags ...string) (vttest.LocalCluster, error) {
 	flags = append(flags, []string{
 		"-persistent_mode",
@@ -0,0 +1,199 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysql
+
+import (
+	"flag"
+	"net"
+	"strings"
+	"sync"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/log"
+)
+
+var (
+	mysqlAuthServerChain             = flag.String("mysql_auth_server_chain", "", "Comma-separated list of the auth server implementations to try in order when mysql_auth_server_impl is chain, e.g. static,ldap,jwt.")
+	mysqlAuthServerChainUserBackends = flag.String("mysql_auth_server_chain_user_backends", "", "Comma-separated list of user:impl pairs, to only authenticate a user with the given auth server implementation of the chain.")
+
+	authFailures = stats.NewCountersWithSingleLabel("MysqlServerAuthFailures", "MySQL server authentication failures by auth server backend", "backend")
+)
+
+// AuthServerChain implements AuthServer by chaining several registered
+// AuthServer implementations. A user is authenticated by the first backend
+// that accepts its credentials, or only by its own backend if one is
+// configured for it.
+type AuthServerChain struct {
+	names        []string
+	userBackends map[string]string
+
+	// The backends are resolved on first use, as they may be registered
+	// after the chain.
+	once     sync.Once
+	backends map[string]AuthServer
+	methods  []AuthMethod
+}
+
+// InitAuthServerChain handles initializing the AuthServerChain if necessary.
+func InitAuthServerChain() {
+	if *mysqlAuthServerChain == "" {
+		log.Infof("Not configuring AuthServerChain, as mysql_auth_server_chain is empty")
+		return
+	}
+
+	userBackends := make(map[string]string)
+	if *mysqlAuthServerChainUserBackends != "" {
+		for _, pair := range strings.Split(*mysqlAuthServerChainUserBackends, ",") {
+			parts := strings.Split(pair, ":")
+			if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+				log.Exitf("Invalid mysql_auth_server_chain_user_backends entry %q, must be user:impl", pair)
+			}
+			userBackends[parts[0]] = parts[1]
+		}
+	}
+	RegisterAuthServer("chain", NewAuthServerChain(strings.Split(*mysqlAuthServerChain, ","), userBackends))
+}
+
+// NewAuthServerChain returns a new AuthServerChain of the AuthServer
+// implementations registered with the given names.
+func NewAuthServerChain(names []string, userBackends map[string]string) *AuthServerChain {
+	return &AuthServerChain{
+		names:        names,
+		userBackends: userBackends,
+	}
+}
+
+func (a *AuthServerChain) init() {
+	a.once.Do(func() {
+		a.backends = make(map[string]AuthServer)
+		byName := make(map[AuthMethodDescription]*chainAuthMethod)
+		for _, name := range a.names {
+			mu.Lock()
+			backend, ok := authServers[name]
+			mu.Unlock()
+			if !ok {
+				log.Errorf("AuthServerChain: no AuthServer name %v registered, skipping it", name)
+				continue
+			}
+			a.backends[name] = backend
+
+			for _, m := range backend.AuthMethods() {
+				cm, ok := byName[m.Name()]
+				if !ok {
+					cm = &chainAuthMethod{chain: a, name: m.Name()}
+					byName[m.Name()] = cm
+					a.methods = append(a.methods, cm)
+				}
+				cm.backends = append(cm.backends, chainBackendMethod{name: name, method: m})
+			}
+		}
+	})
+}
+
+// AuthMethods returns the AuthMethod instances this auth server can handle.
+// There is one per auth method of the backends, which tries the backends
+// implementing it.
+func (a *AuthServerChain) AuthMethods() []AuthMethod {
+	a.init()
+	return a.methods
+}
+
+// DefaultAuthMethodDescription returns the default auth method of the
+// first backend of the chain.
+func (a *AuthServerChain) DefaultAuthMethodDescription() AuthMethodDescription {
+	a.init()
+	for _, name := range a.names {
+		if backend, ok := a.backends[name]; ok {
+			return backend.DefaultAuthMethodDescription()
+		}
+	}
+	return MysqlNativePassword
+}
+
+// handlesUser returns true if the backend may authenticate the user.
+func (a *AuthServerChain) handlesUser(backend, user string) bool {
+	userBackend, ok := a.userBackends[user]
+	return !ok || userBackend == backend
+}
+
+type chainBackendMethod struct {
+	name   string
+	method AuthMethod
+}
+
+// chainAuthMethod implements an auth method by trying, in order, the
+// backends of the chain implementing it.
+type chainAuthMethod struct {
+	chain    *AuthServerChain
+	name     AuthMethodDescription
+	backends []chainBackendMethod
+}
+
+func (cm *chainAuthMethod) Name() AuthMethodDescription {
+	return cm.name
+}
+
+func (cm *chainAuthMethod) HandleUser(conn *Conn, user string) bool {
+	return len(cm.userBackends(conn, user)) > 0
+}
+
+func (cm *chainAuthMethod) userBackends(conn *Conn, user string) []chainBackendMethod {
+	var result []chainBackendMethod
+	for _, b := range cm.backends {
+		if cm.chain.handlesUser(b.name, user) && b.method.HandleUser(conn, user) {
+			result = append(result, b)
+		}
+	}
+	return result
+}
+
+// AllowClearTextWithoutTLS only allows clear text if all the backends
+// implementing the method do.
+func (cm *chainAuthMethod) AllowClearTextWithoutTLS() bool {
+	for _, b := range cm.backends {
+		if !b.method.AllowClearTextWithoutTLS() {
+			return false
+		}
+	}
+	return true
+}
+
+// AuthPluginData returns the plugin data of the first backend, as the
+// implementations of an auth method only differ by their storage.
+func (cm *chainAuthMethod) AuthPluginData() ([]byte, error) {
+	return cm.backends[0].method.AuthPluginData()
+}
+
+func (cm *chainAuthMethod) HandleAuthPluginData(conn *Conn, user string, serverAuthPluginData []byte, clientAuthPluginData []byte, remoteAddr net.Addr) (Getter, error) {
+	backends := cm.userBackends(conn, user)
+	// caching_sha2_password may exchange more packets with the client, so
+	// only the first backend can authenticate the user with it.
+	if cm.name == CachingSha2Password && len(backends) > 1 {
+		backends = backends[:1]
+	}
+
+	var err error = NewSQLError(ERAccessDeniedError, SSAccessDeniedError, "Access denied for user '%v'", user)
+	for _, b := range backends {
+		var getter Getter
+		getter, err = b.method.HandleAuthPluginData(conn, user, serverAuthPluginData, clientAuthPluginData, remoteAddr)
+		if err == nil {
+			return getter, nil
+		}
+		authFailures.Add(b.name, 1)
+	}
+	return nil, err
+}
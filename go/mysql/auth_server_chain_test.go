@@ -0,0 +1,71 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthServerChain(t *testing.T) {
+	RegisterAuthServer("chaintest1", NewAuthServerStaticWithAuthMethodDescription("", `{"user1": [{"Password": "password1", "UserData": "user1"}], "user3": [{"Password": "password3", "UserData": "user3"}]}`, 0, MysqlClearPassword))
+	RegisterAuthServer("chaintest2", NewAuthServerStaticWithAuthMethodDescription("", `{"user2": [{"Password": "password2", "UserData": "user2"}], "user3": [{"Password": "other", "UserData": "user3"}]}`, 0, MysqlClearPassword))
+	RegisterAuthServer("chaintest3", NewAuthServerStaticWithAuthMethodDescription("", `{"user4": [{"Password": "password4"}]}`, 0, MysqlNativePassword))
+
+	a := NewAuthServerChain([]string{"chaintest1", "chaintest2", "chaintest3", "unknown"}, map[string]string{"user3": "chaintest2"})
+	methods := a.AuthMethods()
+	require.Len(t, methods, 2)
+	assert.Equal(t, MysqlClearPassword, methods[0].Name())
+	assert.Equal(t, MysqlNativePassword, methods[1].Name())
+	assert.Equal(t, MysqlNativePassword, a.DefaultAuthMethodDescription())
+
+	clear := methods[0]
+	conn := &Conn{}
+	authenticate := func(user, password string) (string, error) {
+		getter, err := clear.HandleAuthPluginData(conn, user, nil, append([]byte(password), 0), nil)
+		if err != nil {
+			return "", err
+		}
+		return getter.Get().Username, nil
+	}
+
+	failures := authFailures.Counts()
+	username, err := authenticate("user1", "password1")
+	require.NoError(t, err)
+	assert.Equal(t, "user1", username)
+
+	// user2 is only known by the second backend.
+	username, err = authenticate("user2", "password2")
+	require.NoError(t, err)
+	assert.Equal(t, "user2", username)
+	assert.Equal(t, failures["chaintest1"]+1, authFailures.Counts()["chaintest1"])
+
+	// user3 is only authenticated by the second backend.
+	_, err = authenticate("user3", "password3")
+	assert.EqualError(t, err, "Access denied for user 'user3' (errno 1045) (sqlstate 28000)")
+	username, err = authenticate("user3", "other")
+	require.NoError(t, err)
+	assert.Equal(t, "user3", username)
+	assert.Equal(t, failures["chaintest2"]+1, authFailures.Counts()["chaintest2"])
+
+	_, err = authenticate("user2", "wrong")
+	assert.Error(t, err)
+	assert.Equal(t, failures["chaintest1"]+2, authFailures.Counts()["chaintest1"])
+	assert.Equal(t, failures["chaintest2"]+2, authFailures.Counts()["chaintest2"])
+}
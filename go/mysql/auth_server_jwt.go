@@ -0,0 +1,277 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysql
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/hmac"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/vt/log"
+)
+
+var (
+	mysqlAuthJWTKeyFile       = flag.String("mysql_auth_jwt_key_file", "", "File holding the key to verify the JWTs with: a PEM encoded RSA or ECDSA public key or certificate for RS256 and ES256 tokens, such as the signing key of an OIDC provider, or else a shared secret for HS256 tokens.")
+	mysqlAuthJWTIssuer        = flag.String("mysql_auth_jwt_issuer", "", "If set, the issuer (iss claim) the JWTs must carry.")
+	mysqlAuthJWTAudience      = flag.String("mysql_auth_jwt_audience", "", "If set, the audience (aud claim) the JWTs must carry.")
+	mysqlAuthJWTUsernameClaim = flag.String("mysql_auth_jwt_username_claim", "sub", "The claim of the JWTs holding the username, which must match the MySQL user.")
+	mysqlAuthJWTGroupsClaim   = flag.String("mysql_auth_jwt_groups_claim", "groups", "The claim of the JWTs holding the groups of the user.")
+)
+
+// AuthServerJWT implements AuthServer by validating a JWT, as issued for
+// instance by an OIDC provider, that the client sends as clear text
+// password.
+type AuthServerJWT struct {
+	methods []AuthMethod
+
+	// hmacKey is set for HS256 tokens, publicKey for RS256 and ES256 ones.
+	hmacKey   []byte
+	publicKey crypto.PublicKey
+
+	issuer, audience           string
+	usernameClaim, groupsClaim string
+
+	now func() time.Time
+}
+
+// InitAuthServerJWT handles initializing the AuthServerJWT if necessary.
+func InitAuthServerJWT() {
+	if *mysqlAuthJWTKeyFile == "" {
+		log.Infof("Not configuring AuthServerJWT, as mysql_auth_jwt_key_file is empty")
+		return
+	}
+
+	key, err := ioutil.ReadFile(*mysqlAuthJWTKeyFile)
+	if err != nil {
+		log.Exitf("Failed to read mysql_auth_jwt_key_file %v: %v", *mysqlAuthJWTKeyFile, err)
+	}
+	authServerJWT, err := NewAuthServerJWT(key, *mysqlAuthJWTIssuer, *mysqlAuthJWTAudience, *mysqlAuthJWTUsernameClaim, *mysqlAuthJWTGroupsClaim)
+	if err != nil {
+		log.Exitf("%v", err)
+	}
+	RegisterAuthServer("jwt", authServerJWT)
+}
+
+// NewAuthServerJWT returns a new AuthServerJWT verifying the tokens with
+// the given key, which is either PEM encoded, or an HMAC secret.
+func NewAuthServerJWT(key []byte, issuer, audience, usernameClaim, groupsClaim string) (*AuthServerJWT, error) {
+	a := &AuthServerJWT{
+		issuer:        issuer,
+		audience:      audience,
+		usernameClaim: usernameClaim,
+		groupsClaim:   groupsClaim,
+		now:           time.Now,
+	}
+
+	if block, _ := pem.Decode(key); block != nil {
+		switch block.Type {
+		case "CERTIFICATE":
+			cert, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse the JWT certificate: %v", err)
+			}
+			a.publicKey = cert.PublicKey
+		default:
+			publicKey, err := x509.ParsePKIXPublicKey(block.Bytes)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse the JWT public key: %v", err)
+			}
+			a.publicKey = publicKey
+		}
+		switch a.publicKey.(type) {
+		case *rsa.PublicKey, *ecdsa.PublicKey:
+		default:
+			return nil, fmt.Errorf("unsupported JWT public key type %T", a.publicKey)
+		}
+	} else {
+		a.hmacKey = bytes.TrimSpace(key)
+		if len(a.hmacKey) == 0 {
+			return nil, fmt.Errorf("empty JWT key")
+		}
+	}
+
+	a.methods = []AuthMethod{NewMysqlClearAuthMethod(a, a)}
+	return a, nil
+}
+
+// AuthMethods returns the AuthMethod instances this auth server can handle.
+func (a *AuthServerJWT) AuthMethods() []AuthMethod {
+	return a.methods
+}
+
+// DefaultAuthMethodDescription returns MysqlNativePassword as the default
+// authentication method for the auth server implementation, so that the
+// clients are switched to clear text to send their token.
+func (a *AuthServerJWT) DefaultAuthMethodDescription() AuthMethodDescription {
+	return MysqlNativePassword
+}
+
+// HandleUser is part of the Validator interface. We
+// handle any user here since we don't check up front.
+func (a *AuthServerJWT) HandleUser(user string) bool {
+	return true
+}
+
+// UserEntryWithPassword is part of the PlainTextStorage interface. The
+// password is the JWT of the user.
+func (a *AuthServerJWT) UserEntryWithPassword(userCerts []*x509.Certificate, user string, password string, remoteAddr net.Addr) (Getter, error) {
+	userData, err := a.validate(password, user)
+	if err != nil {
+		log.Infof("Rejected JWT of user %v from %v: %v", user, remoteAddr, err)
+		return nil, NewSQLError(ERAccessDeniedError, SSAccessDeniedError, "Access denied for user '%v'", user)
+	}
+	return userData, nil
+}
+
+type jwtHeader struct {
+	Alg string `json:"alg"`
+}
+
+// validate checks the signature and the claims of the token, and returns
+// the user data it carries.
+func (a *AuthServerJWT) validate(token, user string) (*StaticUserData, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("malformed token")
+	}
+
+	var header jwtHeader
+	if err := decodeJWTPart(parts[0], &header); err != nil {
+		return nil, fmt.Errorf("malformed token header: %v", err)
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("malformed token signature: %v", err)
+	}
+	if err := a.verifySignature(header.Alg, parts[0]+"."+parts[1], signature); err != nil {
+		return nil, err
+	}
+
+	var claims map[string]interface{}
+	if err := decodeJWTPart(parts[1], &claims); err != nil {
+		return nil, fmt.Errorf("malformed token claims: %v", err)
+	}
+
+	now := a.now()
+	exp, ok := claims["exp"].(float64)
+	if !ok {
+		return nil, fmt.Errorf("token has no expiration")
+	}
+	if now.After(time.Unix(int64(exp), 0)) {
+		return nil, fmt.Errorf("token expired")
+	}
+	if nbf, ok := claims["nbf"].(float64); ok && now.Before(time.Unix(int64(nbf), 0)) {
+		return nil, fmt.Errorf("token not valid yet")
+	}
+	if a.issuer != "" && claims["iss"] != a.issuer {
+		return nil, fmt.Errorf("token issuer %v is not %v", claims["iss"], a.issuer)
+	}
+	if a.audience != "" && !jwtClaimContains(claims["aud"], a.audience) {
+		return nil, fmt.Errorf("token audience %v does not include %v", claims["aud"], a.audience)
+	}
+	if username, _ := claims[a.usernameClaim].(string); username != user {
+		return nil, fmt.Errorf("token %v claim %q does not match the user", a.usernameClaim, username)
+	}
+
+	return &StaticUserData{username: user, groups: jwtClaimStrings(claims[a.groupsClaim])}, nil
+}
+
+func (a *AuthServerJWT) verifySignature(alg, signed string, signature []byte) error {
+	hash := sha256.Sum256([]byte(signed))
+	switch alg {
+	case "HS256":
+		if a.hmacKey == nil {
+			return fmt.Errorf("unexpected token algorithm %v", alg)
+		}
+		mac := hmac.New(sha256.New, a.hmacKey)
+		mac.Write([]byte(signed))
+		if !hmac.Equal(signature, mac.Sum(nil)) {
+			return fmt.Errorf("invalid token signature")
+		}
+	case "RS256":
+		publicKey, ok := a.publicKey.(*rsa.PublicKey)
+		if !ok {
+			return fmt.Errorf("unexpected token algorithm %v", alg)
+		}
+		if err := rsa.VerifyPKCS1v15(publicKey, crypto.SHA256, hash[:], signature); err != nil {
+			return fmt.Errorf("invalid token signature")
+		}
+	case "ES256":
+		publicKey, ok := a.publicKey.(*ecdsa.PublicKey)
+		if !ok || len(signature) != 64 {
+			return fmt.Errorf("unexpected token algorithm %v", alg)
+		}
+		r := new(big.Int).SetBytes(signature[:32])
+		s := new(big.Int).SetBytes(signature[32:])
+		if !ecdsa.Verify(publicKey, hash[:], r, s) {
+			return fmt.Errorf("invalid token signature")
+		}
+	default:
+		// This also rejects the unsigned tokens, with the "none" algorithm.
+		return fmt.Errorf("unsupported token algorithm %v", alg)
+	}
+	return nil
+}
+
+func decodeJWTPart(part string, v interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(part)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// jwtClaimStrings returns the values of a claim that is either a string
+// or a list of strings.
+func jwtClaimStrings(claim interface{}) []string {
+	switch claim := claim.(type) {
+	case string:
+		return []string{claim}
+	case []interface{}:
+		var result []string
+		for _, v := range claim {
+			if s, ok := v.(string); ok {
+				result = append(result, s)
+			}
+		}
+		return result
+	}
+	return nil
+}
+
+func jwtClaimContains(claim interface{}, value string) bool {
+	for _, v := range jwtClaimStrings(claim) {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,174 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysql
+
+import (
+	"crypto"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func signJWT(t *testing.T, alg string, claims map[string]interface{}, sign func(signed string) []byte) string {
+	header, err := json.Marshal(map[string]string{"alg": alg, "typ": "JWT"})
+	require.NoError(t, err)
+	payload, err := json.Marshal(claims)
+	require.NoError(t, err)
+	signed := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	return signed + "." + base64.RawURLEncoding.EncodeToString(sign(signed))
+}
+
+func TestAuthServerJWTHMAC(t *testing.T) {
+	key := []byte("secret")
+	a, err := NewAuthServerJWT(append(key, '\n'), "issuer", "vtgate", "sub", "groups")
+	require.NoError(t, err)
+	now := time.Now()
+	a.now = func() time.Time { return now }
+
+	hs256 := func(key []byte) func(string) []byte {
+		return func(signed string) []byte {
+			mac := hmac.New(sha256.New, key)
+			mac.Write([]byte(signed))
+			return mac.Sum(nil)
+		}
+	}
+	claims := func(overrides map[string]interface{}) map[string]interface{} {
+		claims := map[string]interface{}{
+			"sub":    "user1",
+			"iss":    "issuer",
+			"aud":    []string{"other", "vtgate"},
+			"exp":    now.Add(time.Minute).Unix(),
+			"groups": []string{"group1", "group2"},
+		}
+		for k, v := range overrides {
+			if v == nil {
+				delete(claims, k)
+			} else {
+				claims[k] = v
+			}
+		}
+		return claims
+	}
+
+	getter, err := a.UserEntryWithPassword(nil, "user1", signJWT(t, "HS256", claims(nil), hs256(key)), nil)
+	require.NoError(t, err)
+	assert.Equal(t, &querypb.VTGateCallerID{Username: "user1", Groups: []string{"group1", "group2"}}, getter.Get())
+
+	_, err = a.UserEntryWithPassword(nil, "user2", signJWT(t, "HS256", claims(nil), hs256(key)), nil)
+	assert.EqualError(t, err, "Access denied for user 'user2' (errno 1045) (sqlstate 28000)")
+
+	testcases := []struct {
+		name  string
+		token string
+		err   string
+	}{{
+		name:  "malformed",
+		token: "password",
+		err:   "malformed token",
+	}, {
+		name:  "bad signature",
+		token: signJWT(t, "HS256", claims(nil), hs256([]byte("other"))),
+		err:   "invalid token signature",
+	}, {
+		name:  "no signature",
+		token: signJWT(t, "none", claims(nil), func(string) []byte { return nil }),
+		err:   "unsupported token algorithm none",
+	}, {
+		name:  "wrong algorithm",
+		token: signJWT(t, "RS256", claims(nil), hs256(key)),
+		err:   "unexpected token algorithm RS256",
+	}, {
+		name:  "expired",
+		token: signJWT(t, "HS256", claims(map[string]interface{}{"exp": now.Add(-time.Minute).Unix()}), hs256(key)),
+		err:   "token expired",
+	}, {
+		name:  "no expiration",
+		token: signJWT(t, "HS256", claims(map[string]interface{}{"exp": nil}), hs256(key)),
+		err:   "token has no expiration",
+	}, {
+		name:  "not valid yet",
+		token: signJWT(t, "HS256", claims(map[string]interface{}{"nbf": now.Add(time.Minute).Unix()}), hs256(key)),
+		err:   "token not valid yet",
+	}, {
+		name:  "wrong issuer",
+		token: signJWT(t, "HS256", claims(map[string]interface{}{"iss": "other"}), hs256(key)),
+		err:   "token issuer other is not issuer",
+	}, {
+		name:  "wrong audience",
+		token: signJWT(t, "HS256", claims(map[string]interface{}{"aud": "other"}), hs256(key)),
+		err:   "token audience other does not include vtgate",
+	}, {
+		name:  "wrong user",
+		token: signJWT(t, "HS256", claims(map[string]interface{}{"sub": "user2"}), hs256(key)),
+		err:   `token sub claim "user2" does not match the user`,
+	}}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := a.validate(tc.token, "user1")
+			assert.EqualError(t, err, tc.err)
+		})
+	}
+}
+
+func TestAuthServerJWTRSA(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	publicKey, err := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+	require.NoError(t, err)
+
+	a, err := NewAuthServerJWT(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKey}), "", "", "email", "roles")
+	require.NoError(t, err)
+
+	rs256 := func(signed string) []byte {
+		hash := sha256.Sum256([]byte(signed))
+		signature, err := rsa.SignPKCS1v15(rand.Reader, privateKey, crypto.SHA256, hash[:])
+		require.NoError(t, err)
+		return signature
+	}
+	token := signJWT(t, "RS256", map[string]interface{}{
+		"email": "user1@example.com",
+		"exp":   time.Now().Add(time.Minute).Unix(),
+		"roles": "admin",
+	}, rs256)
+	userData, err := a.validate(token, "user1@example.com")
+	require.NoError(t, err)
+	assert.Equal(t, &querypb.VTGateCallerID{Username: "user1@example.com", Groups: []string{"admin"}}, userData.Get())
+
+	// An HMAC token signed with the public key must not be accepted.
+	hs256 := func(signed string) []byte {
+		mac := hmac.New(sha256.New, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKey}))
+		mac.Write([]byte(signed))
+		return mac.Sum(nil)
+	}
+	_, err = a.validate(signJWT(t, "HS256", map[string]interface{}{
+		"email": "user1@example.com",
+		"exp":   time.Now().Add(time.Minute).Unix(),
+	}, hs256), "user1@example.com")
+	assert.EqualError(t, err, "unexpected token algorithm HS256")
+}
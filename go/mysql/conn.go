@@ -201,6 +201,9 @@ type PrepareData struct {
 	BindVars    map[string]*querypb.BindVariable
 	StatementID uint32
 	ParamsCount uint16
+	// ParamsFieldType holds the types reported for the parameters by
+	// COM_STMT_PREPARE, which the handler may infer from the statement.
+	ParamsFieldType []querypb.Type
 }
 
 // execResult is an enum signifying the result of executing a query
@@ -826,6 +829,20 @@ func (c *Conn) writeErrorPacket(errorCode uint16, sqlState string, format string
 	return c.writeEphemeralPacket()
 }
 
+// writeHandshakeErrorPacket writes an error packet in place of the initial
+// handshake packet, to refuse a connection. Such a packet has no SQL state.
+// Server -> Client.
+func (c *Conn) writeHandshakeErrorPacket(errorCode uint16, format string, args ...interface{}) error {
+	errorMessage := fmt.Sprintf(format, args...)
+	length := 1 + 2 + len(errorMessage)
+	data, pos := c.startEphemeralPacketWithHeader(length)
+	pos = writeByte(data, pos, ErrPacket)
+	pos = writeUint16(data, pos, errorCode)
+	_ = writeEOFString(data, pos, errorMessage)
+
+	return c.writeEphemeralPacket()
+}
+
 // writeErrorPacketFromError writes an error packet, from a regular error.
 // See writeErrorPacket for other info.
 func (c *Conn) writeErrorPacketFromError(err error) error {
@@ -1150,6 +1167,13 @@ func (c *Conn) handleComPrepare(handler Handler, data []byte) (kontinue bool) {
 		return c.writeErrorPacketFromErrorAndLog(err)
 	}
 
+	if paramsCount > 0 {
+		prepare.ParamsFieldType = make([]querypb.Type, paramsCount)
+		for i := uint16(0); i < paramsCount; i++ {
+			prepare.ParamsFieldType[i] = bindVars[fmt.Sprintf("v%d", i+1)].Type
+		}
+	}
+
 	if err := c.writePrepare(fld, c.PrepareData[c.StatementID]); err != nil {
 		log.Error("Error writing prepare data to client %v: %v", c.ConnectionID, err)
 		return false
@@ -0,0 +1,166 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysql
+
+import (
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/sync2"
+)
+
+const (
+	connRejectedMaxConns        = "MaxConns"
+	connRejectedMaxConnsPerUser = "MaxConnsPerUser"
+)
+
+var (
+	connQueued       = stats.NewGaugesWithSingleLabel("MysqlServerConnQueued", "MySQL server connections waiting for a connection slot", "limit")
+	connWaitTimings  = stats.NewTimings("MysqlServerConnWaitTimings", "Time MySQL server connections waited for a connection slot", "limit")
+	connRejected     = stats.NewCountersWithSingleLabel("MysqlServerConnRejected", "MySQL server connections rejected because no connection slot freed up in time", "limit")
+	handshakeTimings = stats.NewTimings("MysqlServerHandshakeTimings", "MySQL server handshake and authentication timings, by TLS version", "tls")
+)
+
+// ConnLimiter limits the number of connections served by one or more
+// Listeners, overall and per user. A connection beyond a limit waits for a
+// slot to free up, for up to the wait timeout, and is refused after that.
+// A zero limit means no limit, and a zero wait timeout means that the
+// connections beyond a limit are refused right away.
+type ConnLimiter struct {
+	waitTimeout     time.Duration
+	maxConnsPerUser int
+
+	// conns is nil if the number of connections is not limited.
+	conns *sync2.Semaphore
+
+	mu sync.Mutex
+	// userConns holds the slots of each user which has connections holding
+	// or waiting for one. The entry of a user is deleted with its last
+	// connection.
+	userConns map[string]*userSlots
+}
+
+// userSlots are the connection slots of a user.
+type userSlots struct {
+	sem *sync2.Semaphore
+	// refs is the number of connections of the user holding or waiting for
+	// a slot.
+	refs int
+}
+
+// NewConnLimiter creates a ConnLimiter.
+func NewConnLimiter(maxConns, maxConnsPerUser int, waitTimeout time.Duration) *ConnLimiter {
+	cl := &ConnLimiter{
+		waitTimeout:     waitTimeout,
+		maxConnsPerUser: maxConnsPerUser,
+		userConns:       make(map[string]*userSlots),
+	}
+	if maxConns > 0 {
+		cl.conns = sync2.NewSemaphore(maxConns, waitTimeout)
+	}
+	return cl
+}
+
+// acquire waits for a connection slot. It returns false if none freed up
+// in time.
+func (cl *ConnLimiter) acquire() bool {
+	if cl.conns == nil {
+		return true
+	}
+	return cl.acquireSemaphore(cl.conns, connRejectedMaxConns)
+}
+
+// release releases a slot acquired with acquire.
+func (cl *ConnLimiter) release() {
+	if cl.conns != nil {
+		cl.conns.Release()
+	}
+}
+
+// acquireUser waits for a connection slot of the user. It returns false if
+// none freed up in time.
+func (cl *ConnLimiter) acquireUser(user string) bool {
+	if cl.maxConnsPerUser <= 0 {
+		return true
+	}
+
+	slots := cl.refUser(user)
+	if !cl.acquireSemaphore(slots.sem, connRejectedMaxConnsPerUser) {
+		cl.unrefUser(user, slots)
+		return false
+	}
+	return true
+}
+
+// releaseUser releases a slot acquired with acquireUser.
+func (cl *ConnLimiter) releaseUser(user string) {
+	if cl.maxConnsPerUser <= 0 {
+		return
+	}
+
+	cl.mu.Lock()
+	slots := cl.userConns[user]
+	cl.mu.Unlock()
+	slots.sem.Release()
+	cl.unrefUser(user, slots)
+}
+
+// refUser returns the slots of the user, creating them if it has no other
+// connection, and counts a connection of the user.
+func (cl *ConnLimiter) refUser(user string) *userSlots {
+	cl.mu.Lock()
+	defer cl.mu.Unlock()
+	slots, ok := cl.userConns[user]
+	if !ok {
+		slots = &userSlots{sem: sync2.NewSemaphore(cl.maxConnsPerUser, cl.waitTimeout)}
+		cl.userConns[user] = slots
+	}
+	slots.refs++
+	return slots
+}
+
+// unrefUser uncounts a connection of the user, and deletes its slots with
+// its last connection.
+func (cl *ConnLimiter) unrefUser(user string, slots *userSlots) {
+	cl.mu.Lock()
+	defer cl.mu.Unlock()
+	slots.refs--
+	if slots.refs == 0 {
+		delete(cl.userConns, user)
+	}
+}
+
+func (cl *ConnLimiter) acquireSemaphore(sem *sync2.Semaphore, limit string) bool {
+	if sem.TryAcquire() {
+		return true
+	}
+	if cl.waitTimeout == 0 {
+		connRejected.Add(limit, 1)
+		return false
+	}
+
+	start := time.Now()
+	connQueued.Add(limit, 1)
+	defer connQueued.Add(limit, -1)
+	ok := sem.Acquire()
+	connWaitTimings.Record(limit, start)
+	if !ok {
+		connRejected.Add(limit, 1)
+	}
+	return ok
+}
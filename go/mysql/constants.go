@@ -324,6 +324,9 @@ const (
 const (
 	// ERVitessMaxRowsExceeded is when a user tries to select more rows than the max rows as enforced by vitess.
 	ERVitessMaxRowsExceeded = 10001
+
+	// ERVitessCircuitBreakerOpen is when vtgate fails a query fast because the circuit breakers of all the tablets it could use are open.
+	ERVitessCircuitBreakerOpen = 10002
 )
 
 // Error codes for server-side errors.
@@ -359,6 +362,7 @@ const (
 
 	// deadline exceeded
 	ERLockWaitTimeout = 1205
+	ERQueryTimeout    = 3024
 
 	// unavailable
 	ERServerShutdown = 1053
@@ -525,6 +529,7 @@ const (
 	ERDerivedMustHaveAlias         = 1248
 	ERTableNameNotAllowedHere      = 1250
 	ERQueryInterrupted             = 1317
+	ERDivisionByZero               = 1365
 	ERTruncatedWrongValueForField  = 1366
 	ERDataTooLong                  = 1406
 	ERForbidSchemaChange           = 1450
@@ -555,6 +560,12 @@ const (
 	// SSDataOutOfRange is ER_DATA_OUT_OF_RANGE
 	SSDataOutOfRange = "22003"
 
+	// SSTruncatedWrongValue is ER_TRUNCATED_WRONG_VALUE
+	SSTruncatedWrongValue = "22007"
+
+	// SSDivisionByZero is ER_DIVISION_BY_ZERO
+	SSDivisionByZero = "22012"
+
 	// SSConstraintViolation is constraint violation
 	SSConstraintViolation = "23000"
 
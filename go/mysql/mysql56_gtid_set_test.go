@@ -497,6 +497,18 @@ func TestMysql56GTIDSetDifference(t *testing.T) {
 	}
 }
 
+func TestMysql56GTIDSetCount(t *testing.T) {
+	sid1 := SID{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}
+	sid2 := SID{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 255}
+
+	assert.EqualValues(t, 0, Mysql56GTIDSet{}.Count())
+	assert.EqualValues(t, 1, Mysql56GTIDSet{sid1: []interval{{12, 12}}}.Count())
+	assert.EqualValues(t, 23, Mysql56GTIDSet{
+		sid1: []interval{{1, 5}, {10, 20}},
+		sid2: []interval{{1, 5}, {50, 51}},
+	}.Count())
+}
+
 func TestMysql56GTIDSetSIDBlock(t *testing.T) {
 	sid1 := SID{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}
 	sid2 := SID{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 16}
@@ -1071,9 +1071,13 @@ func (c *Conn) writePrepare(fld []*querypb.Field, prepare *PrepareData) error {
 
 	if paramsCount > 0 {
 		for i := uint16(0); i < paramsCount; i++ {
+			typ := sqltypes.VarBinary
+			if int(i) < len(prepare.ParamsFieldType) && prepare.ParamsFieldType[i] != sqltypes.Null {
+				typ = prepare.ParamsFieldType[i]
+			}
 			if err := c.writeColumnDefinition(&querypb.Field{
 				Name:    "?",
-				Type:    sqltypes.VarBinary,
+				Type:    typ,
 				Charset: 63}); err != nil {
 				return err
 			}
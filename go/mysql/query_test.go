@@ -208,6 +208,34 @@ func TestComStmtPrepareUpdStmt(t *testing.T) {
 	}
 }
 
+func TestComStmtPrepareParamsFieldType(t *testing.T) {
+	listener, sConn, cConn := createSocketPair(t)
+	defer func() {
+		listener.Close()
+		sConn.Close()
+		cConn.Close()
+	}()
+
+	prepare := &PrepareData{
+		StatementID:     1,
+		PrepareStmt:     "select id from test where id = ? and name = ? and col = ?",
+		ParamsCount:     3,
+		ParamsFieldType: []querypb.Type{querypb.Type_INT64, querypb.Type_INT32, querypb.Type_NULL_TYPE},
+	}
+	err := sConn.writePrepare(nil, prepare)
+	require.NoError(t, err, "sConn.writePrepare failed")
+
+	_, err = cConn.ReadPacket()
+	require.NoError(t, err, "cConn.ReadPacket failed")
+
+	// The untyped parameters are reported as VARBINARY.
+	for _, want := range []byte{0x08, 0x03, 0xfd} {
+		resp, err := cConn.ReadPacket()
+		require.NoError(t, err, "cConn.ReadPacket failed")
+		require.EqualValues(t, want, resp[17], "Received incorrect parameter type")
+	}
+}
+
 func TestComStmtSendLongData(t *testing.T) {
 	listener, sConn, cConn := createSocketPair(t)
 	defer func() {
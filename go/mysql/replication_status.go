@@ -155,3 +155,26 @@ func (s *ReplicationStatus) FindErrantGTIDs(otherReplicaStatuses []*ReplicationS
 
 	return diffSet, nil
 }
+
+// ErrantGTIDs returns the GTIDs of a replica position that the position of
+// its primary does not contain, or nil if there are none. The positions must
+// be read in that order, so that the primary position contains all the
+// transactions the replica received from the primary. This method only works
+// for the MySQL flavor.
+func ErrantGTIDs(replica, primary Position) (Mysql56GTIDSet, error) {
+	replicaSet, ok := replica.GTIDSet.(Mysql56GTIDSet)
+	if !ok {
+		return nil, fmt.Errorf("errant GTIDs can only be computed on the MySQL flavor")
+	}
+	primarySet, ok := primary.GTIDSet.(Mysql56GTIDSet)
+	if !ok {
+		return nil, fmt.Errorf("errant GTIDs can only be computed on the MySQL flavor")
+	}
+
+	diffSet := replicaSet.Difference(primarySet)
+	if len(diffSet) == 0 {
+		return nil, nil
+	}
+
+	return diffSet, nil
+}
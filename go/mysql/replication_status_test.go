@@ -100,3 +100,39 @@ func TestFindErrantGTIDs(t *testing.T) {
 		t.Errorf("got %#v; want %#v", got, want)
 	}
 }
+
+func TestErrantGTIDs(t *testing.T) {
+	sid1 := SID{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}
+	sid2 := SID{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 16}
+
+	primary := Position{GTIDSet: Mysql56GTIDSet{
+		sid1: []interval{{1, 100}},
+	}}
+	replica := Position{GTIDSet: Mysql56GTIDSet{
+		sid1: []interval{{1, 90}},
+		sid2: []interval{{1, 3}},
+	}}
+
+	got, err := ErrantGTIDs(replica, primary)
+	if err != nil {
+		t.Errorf("%v", err)
+	}
+	want := Mysql56GTIDSet{
+		sid2: []interval{{1, 3}},
+	}
+	if !got.Equal(want) {
+		t.Errorf("got %#v; want %#v", got, want)
+	}
+
+	got, err = ErrantGTIDs(primary, primary)
+	if err != nil {
+		t.Errorf("%v", err)
+	}
+	if got != nil {
+		t.Errorf("got %#v; want nil", got)
+	}
+
+	if _, err := ErrantGTIDs(Position{GTIDSet: MariadbGTIDSet{}}, primary); err == nil {
+		t.Errorf("expected an error for the MariaDB flavor")
+	}
+}
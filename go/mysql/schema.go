@@ -109,12 +109,56 @@ order by table_name, ordinal_position`
 from _vt.schemacopy 
 where table_schema = database() 
 order by table_name, ordinal_position`
+
+	// CreateTableStatisticsTable query creates table_statistics table in _vt schema.
+	// The row of a table with an empty column_name holds its row count, the
+	// others hold the cardinality of the columns that lead an index.
+	CreateTableStatisticsTable = `
+CREATE TABLE if not exists _vt.table_statistics (
+	table_schema varchar(64) NOT NULL,
+	table_name varchar(64) NOT NULL,
+	column_name varchar(64) NOT NULL,
+	row_count bigint unsigned NOT NULL,
+	cardinality bigint unsigned NOT NULL,
+	PRIMARY KEY (table_schema, table_name, column_name))`
+
+	// SampleTableStatistics query samples the row counts of the tables and
+	// the cardinalities of the columns that lead an index, as estimated by MySQL.
+	SampleTableStatistics = `select table_name, '' as column_name, ifnull(table_rows, 0) as row_count, ifnull(table_rows, 0) as cardinality
+from information_schema.tables
+where table_schema = database() and table_type = 'BASE TABLE'
+union all
+select S.table_name, S.column_name, ifnull(T.table_rows, 0), ifnull(max(S.cardinality), 0)
+from information_schema.statistics as S
+	join information_schema.tables as T on
+		S.table_schema = T.table_schema and
+		S.table_name = T.table_name
+where S.table_schema = database() and S.seq_in_index = 1
+group by S.table_name, S.column_name, T.table_rows`
+
+	// ClearTableStatistics query clears the table_statistics table.
+	ClearTableStatistics = `delete from _vt.table_statistics where table_schema = database()`
+
+	// InsertIntoTableStatistics query inserts sampled statistics into the table_statistics table.
+	InsertIntoTableStatistics = `insert into _vt.table_statistics(table_schema, table_name, column_name, row_count, cardinality) values `
+
+	// FetchUpdatedTableStatistics queries fetches the statistics of updated tables
+	FetchUpdatedTableStatistics = `select table_name, column_name, row_count, cardinality
+from _vt.table_statistics
+where table_schema = database() and
+	table_name in ::tableNames`
+
+	// FetchTableStatistics queries fetches the statistics of all tables
+	FetchTableStatistics = `select table_name, column_name, row_count, cardinality
+from _vt.table_statistics
+where table_schema = database()`
 )
 
 // VTDatabaseInit contains all the schema creation queries needed to
 var VTDatabaseInit = []string{
 	CreateVTDatabase,
 	CreateSchemaCopyTable,
+	CreateTableStatisticsTable,
 }
 
 // BaseShowTablesFields contains the fields returned by a BaseShowTables or a BaseShowTablesForTable command.
@@ -104,7 +104,9 @@ type Handler interface {
 	ComQuery(c *Conn, query string, callback func(*sqltypes.Result) error) error
 
 	// ComPrepare is called when a connection receives a prepared
-	// statement query.
+	// statement query. The handler may set the types of the bind
+	// variables of the parameters, which are then reported to the
+	// client instead of VARBINARY.
 	ComPrepare(c *Conn, query string, bindVars map[string]*querypb.BindVariable) ([]*querypb.Field, error)
 
 	// ComStmtExecute is called when a connection receives a statement
@@ -175,6 +177,10 @@ type Listener struct {
 	// RequireSecureTransport configures the server to reject connections from insecure clients
 	RequireSecureTransport bool
 
+	// ConnLimiter, if set, limits the number of connections served at once,
+	// overall and per user. It may be shared by several listeners.
+	ConnLimiter *ConnLimiter
+
 	// PreHandleFunc is called for each incoming connection, immediately after
 	// accepting a new connection. By default it's no-op. Useful for custom
 	// connection inspection or TLS termination. The returned connection is
@@ -309,6 +315,18 @@ func (l *Listener) handle(conn net.Conn, connectionID uint32, acceptTime time.Ti
 		conn.Close()
 	}()
 
+	if l.ConnLimiter != nil {
+		if !l.ConnLimiter.acquire() {
+			log.Warningf("Refusing connection from %s: too many connections", c)
+			c.writeHandshakeErrorPacket(ERConCount, "Too many connections")
+			connCount.Add(-1)
+			return
+		}
+		defer l.ConnLimiter.release()
+	}
+	handshakeStart := time.Now()
+	tlsVersion := versionNoTLS
+
 	// Tell the handler about the connection coming and going.
 	l.handler.NewConnection(c)
 	defer l.handler.ConnectionClosed(c)
@@ -363,6 +381,7 @@ func (l *Listener) handle(conn net.Conn, connectionID uint32, acceptTime time.Ti
 			connState := con.ConnectionState()
 			tlsVerStr := tlsVersionToString(connState.Version)
 			if tlsVerStr != "" {
+				tlsVersion = tlsVerStr
 				connCountByTLSVer.Add(tlsVerStr, 1)
 				defer connCountByTLSVer.Add(tlsVerStr, -1)
 			}
@@ -438,6 +457,15 @@ func (l *Listener) handle(conn net.Conn, connectionID uint32, acceptTime time.Ti
 	c.User = user
 	c.UserData = userData
 
+	if l.ConnLimiter != nil {
+		if !l.ConnLimiter.acquireUser(c.User) {
+			log.Warningf("Refusing connection from %s: user %s has too many connections", c, c.User)
+			c.writeErrorPacket(ERTooManyUserConnections, SSClientError, "User %s already has more than 'max_user_connections' active connections", c.User)
+			return
+		}
+		defer l.ConnLimiter.releaseUser(c.User)
+	}
+
 	if c.User != "" {
 		connCountPerUser.Add(c.User, 1)
 		defer connCountPerUser.Add(c.User, -1)
@@ -462,6 +490,7 @@ func (l *Listener) handle(conn net.Conn, connectionID uint32, acceptTime time.Ti
 
 	// Record how long we took to establish the connection
 	timings.Record(connectTimingKey, acceptTime)
+	handshakeTimings.Record(tlsVersion, handshakeStart)
 
 	// Log a warning if it took too long to connect
 	connectTime := time.Since(acceptTime)
@@ -508,6 +508,93 @@ func TestConnCounts(t *testing.T) {
 	//checkCountsForUser(t, user, 0)
 }
 
+func TestConnLimiter(t *testing.T) {
+	th := &testHandler{}
+
+	authServer := NewAuthServerStatic("", "", 0)
+	for _, user := range []string{"user1", "user2"} {
+		authServer.entries[user] = []*AuthServerStaticEntry{{
+			Password: "password1",
+		}}
+	}
+	defer authServer.close()
+	l, err := NewListener("tcp", "127.0.0.1:", authServer, th, 0, 0, false)
+	require.NoError(t, err, "NewListener failed")
+	defer l.Close()
+	l.ConnLimiter = NewConnLimiter(2, 1, 0)
+	go l.Accept()
+	initialRejected := connRejected.Counts()[connRejectedMaxConns]
+
+	host, port := getHostPort(t, l.Addr())
+	params := func(user string) *ConnParams {
+		return &ConnParams{
+			Host:  host,
+			Port:  port,
+			Uname: user,
+			Pass:  "password1",
+		}
+	}
+
+	c1, err := Connect(context.Background(), params("user1"))
+	require.NoError(t, err)
+	defer c1.Close()
+
+	// user1 already has its single connection.
+	_, err = Connect(context.Background(), params("user1"))
+	require.Error(t, err)
+	assert.Equal(t, ERTooManyUserConnections, err.(*SQLError).Number(), err.Error())
+
+	c2, err := Connect(context.Background(), params("user2"))
+	require.NoError(t, err)
+	defer c2.Close()
+
+	// The listener serves at most two connections.
+	_, err = Connect(context.Background(), params("user2"))
+	require.Error(t, err)
+	assert.Equal(t, CRServerHandshakeErr, err.(*SQLError).Number(), err.Error())
+	assert.Contains(t, err.Error(), "errorCode=1040")
+	assert.EqualValues(t, initialRejected+1, connRejected.Counts()[connRejectedMaxConns])
+
+	// With a wait timeout, the connection waits for a slot to free up.
+	l2, err := NewListener("tcp", "127.0.0.1:", authServer, th, 0, 0, false)
+	require.NoError(t, err, "NewListener failed")
+	defer l2.Close()
+	l2.ConnLimiter = NewConnLimiter(1, 0, 10*time.Second)
+	go l2.Accept()
+	host, port = getHostPort(t, l2.Addr())
+
+	c3, err := Connect(context.Background(), params("user1"))
+	require.NoError(t, err)
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		c3.Close()
+	}()
+	c4, err := Connect(context.Background(), params("user1"))
+	require.NoError(t, err)
+	c4.Close()
+}
+
+func TestConnLimiterUserConns(t *testing.T) {
+	cl := NewConnLimiter(0, 1, 0)
+
+	require.True(t, cl.acquireUser("user1"))
+	require.True(t, cl.acquireUser("user2"))
+	// A rejected connection does not keep the slots of its user.
+	require.False(t, cl.acquireUser("user1"))
+	assert.Len(t, cl.userConns, 2)
+	assert.Equal(t, 1, cl.userConns["user1"].refs)
+
+	// The slots of a user are deleted with its last connection.
+	cl.releaseUser("user1")
+	assert.Len(t, cl.userConns, 1)
+	cl.releaseUser("user2")
+	assert.Empty(t, cl.userConns)
+
+	require.True(t, cl.acquireUser("user1"))
+	cl.releaseUser("user1")
+	assert.Empty(t, cl.userConns)
+}
+
 func checkCountsForUser(t *testing.T, user string, expected int64) {
 	connCounts := connCountPerUser.Counts()
 
@@ -164,6 +164,7 @@ var stateToMysqlCode = map[vterrors.State]struct {
 	vterrors.CantUseOptionHere:            {num: ERCantUseOptionHere, state: SSClientError},
 	vterrors.DataOutOfRange:               {num: ERDataOutOfRange, state: SSDataOutOfRange},
 	vterrors.DbCreateExists:               {num: ERDbCreateExists, state: SSUnknownSQLState},
+	vterrors.DivisionByZero:               {num: ERDivisionByZero, state: SSDivisionByZero},
 	vterrors.DbDropExists:                 {num: ERDbDropExists, state: SSUnknownSQLState},
 	vterrors.DupFieldName:                 {num: ERDupFieldName, state: SSDupFieldName},
 	vterrors.EmptyQuery:                   {num: EREmptyQuery, state: SSClientError},
@@ -182,6 +183,7 @@ var stateToMysqlCode = map[vterrors.State]struct {
 	vterrors.QueryInterrupted:             {num: ERQueryInterrupted, state: SSQueryInterrupted},
 	vterrors.SPDoesNotExist:               {num: ERSPDoesNotExist, state: SSClientError},
 	vterrors.SyntaxError:                  {num: ERSyntaxError, state: SSClientError},
+	vterrors.TruncatedWrongValue:          {num: ERTruncatedWrongValue, state: SSTruncatedWrongValue},
 	vterrors.UnsupportedPS:                {num: ERUnsupportedPS, state: SSUnknownSQLState},
 	vterrors.UnknownSystemVariable:        {num: ERUnknownSystemVariable, state: SSUnknownSQLState},
 	vterrors.UnknownTable:                 {num: ERUnknownTable, state: SSUnknownTable},
@@ -194,6 +196,7 @@ var stateToMysqlCode = map[vterrors.State]struct {
 	vterrors.CantDoThisInTransaction:      {num: ERCantDoThisDuringAnTransaction, state: SSCantDoThisDuringAnTransaction},
 	vterrors.RequiresPrimaryKey:           {num: ERRequiresPrimaryKey, state: SSClientError},
 	vterrors.NoSuchSession:                {num: ERUnknownComError, state: SSNetError},
+	vterrors.CircuitBreakerOpen:           {num: ERVitessCircuitBreakerOpen, state: SSNetError},
 }
 
 func init() {
@@ -46,7 +46,7 @@ func (cached *Result) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(82)
+		size += int64(112)
 	}
 	// field Fields []*vitess.io/vitess/go/vt/proto/query.Field
 	{
@@ -69,6 +69,13 @@ func (cached *Result) CachedSize(alloc bool) int64 {
 	}
 	// field SessionStateChanges string
 	size += int64(len(cached.SessionStateChanges))
+	// field Warnings []*vitess.io/vitess/go/vt/proto/query.QueryWarning
+	{
+		size += int64(cap(cached.Warnings)) * int64(8)
+		for _, elem := range cached.Warnings {
+			size += elem.CachedSize(true)
+		}
+	}
 	return size
 }
 func (cached *Value) CachedSize(alloc bool) int64 {
@@ -104,6 +104,7 @@ func ResultToProto3(qr *Result) *querypb.QueryResult {
 		RowsAffected: qr.RowsAffected,
 		InsertId:     qr.InsertID,
 		Rows:         RowsToProto3(qr.Rows),
+		Warnings:     qr.Warnings,
 	}
 }
 
@@ -118,6 +119,7 @@ func Proto3ToResult(qr *querypb.QueryResult) *Result {
 		RowsAffected: qr.RowsAffected,
 		InsertID:     qr.InsertId,
 		Rows:         proto3ToRows(qr.Fields, qr.Rows),
+		Warnings:     qr.Warnings,
 	}
 }
 
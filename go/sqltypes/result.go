@@ -32,6 +32,8 @@ type Result struct {
 	Rows                [][]Value        `json:"rows"`
 	SessionStateChanges string           `json:"session_state_changes"`
 	StatusFlags         uint16           `json:"status_flags"`
+	// Warnings are returned by vttablet for vtgate to record in its session.
+	Warnings []*querypb.QueryWarning `json:"warnings,omitempty"`
 }
 
 //goland:noinspection GoUnusedConst
@@ -59,6 +59,12 @@ func (c *counters) set(name string, value int64) {
 	c.counts[name] = value
 }
 
+func (c *counters) delete(name string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.counts, name)
+}
+
 func (c *counters) reset() {
 	c.mu.Lock()
 	defer c.mu.Unlock()
@@ -99,6 +105,7 @@ type CountersWithSingleLabel struct {
 	counters
 	label         string
 	labelCombined bool
+	labelValues   labelValues
 }
 
 // NewCountersWithSingleLabel create a new Counters instance.
@@ -139,6 +146,8 @@ func (c *CountersWithSingleLabel) Label() string {
 func (c *CountersWithSingleLabel) Add(name string, value int64) {
 	if c.labelCombined {
 		name = StatsAllStr
+	} else {
+		name = c.labelValues.limit(0, name)
 	}
 	c.counters.add(name, value)
 }
@@ -163,6 +172,7 @@ type CountersWithMultiLabels struct {
 	counters
 	labels         []string
 	combinedLabels []bool
+	labelValues    labelValues
 }
 
 // NewCountersWithMultiLabels creates a new CountersWithMultiLabels
@@ -196,7 +206,7 @@ func (mc *CountersWithMultiLabels) Add(names []string, value int64) {
 	if len(names) != len(mc.labels) {
 		panic("CountersWithMultiLabels: wrong number of values in Add")
 	}
-	mc.counters.add(safeJoinLabels(names, mc.combinedLabels), value)
+	mc.counters.add(safeJoinLabels(mc.labelValues.limitAll(names, mc.combinedLabels), mc.combinedLabels), value)
 }
 
 // Reset resets the value of a named counter back to 0.
@@ -320,7 +330,7 @@ func NewGaugesWithSingleLabel(name, help, label string, tags ...string) *GaugesW
 
 // Set sets the value of a named gauge.
 func (g *GaugesWithSingleLabel) Set(name string, value int64) {
-	g.counters.set(name, value)
+	g.counters.set(g.labelValues.limit(0, name), value)
 }
 
 // GaugesWithMultiLabels is a CountersWithMultiLabels implementation where
@@ -353,7 +363,16 @@ func (mg *GaugesWithMultiLabels) Set(names []string, value int64) {
 	if len(names) != len(mg.CountersWithMultiLabels.labels) {
 		panic("GaugesWithMultiLabels: wrong number of values in Set")
 	}
-	mg.counters.set(safeJoinLabels(names, nil), value)
+	mg.counters.set(safeJoinLabels(mg.labelValues.limitAll(names, nil), nil), value)
+}
+
+// Delete removes a named gauge, e.g. once the object it measures is gone.
+// len(names) must be equal to len(Labels).
+func (mg *GaugesWithMultiLabels) Delete(names []string) {
+	if len(names) != len(mg.CountersWithMultiLabels.labels) {
+		panic("GaugesWithMultiLabels: wrong number of values in Delete")
+	}
+	mg.counters.delete(safeJoinLabels(names, nil))
 }
 
 // GaugesFuncWithMultiLabels is a wrapper around CountersFuncWithMultiLabels
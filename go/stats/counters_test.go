@@ -271,3 +271,37 @@ func TestCountersCombineDimension(t *testing.T) {
 	c4.Add([]string{"c4", "c2", "c5"}, 1)
 	assert.Equal(t, `{"all.c2.all": 2}`, c4.String())
 }
+
+func TestCountersMaxLabelValues(t *testing.T) {
+	clear()
+	defer func(max int) { *maxLabelValues = max }(*maxLabelValues)
+	*maxLabelValues = 2
+
+	c := NewCountersWithSingleLabel("counter_max_label_values", "help", "label")
+	for _, name := range []string{"c1", "c2", "c3", "c1", "c4"} {
+		c.Add(name, 1)
+	}
+	assert.Equal(t, map[string]int64{"c1": 2, "c2": 1, StatsOtherStr: 2}, c.Counts())
+
+	mc := NewCountersWithMultiLabels("counter_max_label_values_multi", "help", []string{"a", "b"})
+	mc.Add([]string{"a1", "b1"}, 1)
+	mc.Add([]string{"a2", "b2"}, 1)
+	mc.Add([]string{"a3", "b3"}, 1)
+	assert.Equal(t, map[string]int64{"a1.b1": 1, "a2.b2": 1, "other.other": 1}, mc.Counts())
+
+	g := NewGaugesWithMultiLabels("gauge_max_label_values", "help", []string{"a"})
+	g.Set([]string{"a1"}, 1)
+	g.Set([]string{"a2"}, 2)
+	g.Set([]string{"a3"}, 3)
+	assert.Equal(t, map[string]int64{"a1": 1, "a2": 2, "other": 3}, g.Counts())
+}
+
+func TestGaugesWithMultiLabelsDelete(t *testing.T) {
+	clear()
+	g := NewGaugesWithMultiLabels("gauge_delete", "help", []string{"a", "b"})
+	g.Set([]string{"a1", "b1"}, 1)
+	g.Add([]string{"a2", "b2"}, 2)
+	g.Delete([]string{"a1", "b1"})
+	g.Delete([]string{"a3", "b3"})
+	assert.Equal(t, map[string]int64{"a2.b2": 2}, g.Counts())
+}
@@ -45,6 +45,7 @@ var statsEmitPeriod = flag.Duration("stats_emit_period", time.Duration(60*time.S
 var statsBackend = flag.String("stats_backend", "", "The name of the registered push-based monitoring/stats backend to use")
 var combineDimensions = flag.String("stats_combine_dimensions", "", `List of dimensions to be combined into a single "all" value in exported stats vars`)
 var dropVariables = flag.String("stats_drop_variables", "", `Variables to be dropped from the list of exported variables.`)
+var maxLabelValues = flag.Int("stats_max_label_values", 0, `Maximum number of distinct values of each label of the counters, gauges and timings with labels. The new values seen once it is reached are exported as "other". 0 means no limit.`)
 
 // CommonTags is a comma-separated list of common tags for stats backends
 var CommonTags = flag.String("stats_common_tags", "", `Comma-separated list of common tags for the stats backend. It provides both label and values. Example: label1:value1,label2:value2`)
@@ -52,6 +53,10 @@ var CommonTags = flag.String("stats_common_tags", "", `Comma-separated list of c
 // StatsAllStr is the consolidated name if a dimension gets combined.
 const StatsAllStr = "all"
 
+// StatsOtherStr is the consolidated name of the values of a label beyond
+// -stats_max_label_values.
+const StatsOtherStr = "other"
+
 // NewVarHook is the type of a hook to export variables in a different way
 type NewVarHook func(name string, v expvar.Var)
 
@@ -142,6 +147,22 @@ func RegisterPushBackend(name string, backend PushBackend) {
 	}
 }
 
+// PushAll pushes all stats to the selected PushBackend right away, if stats
+// are emitted. Short-lived processes call it before exiting, so the stats of
+// their last moments are not lost.
+func PushAll() error {
+	if !*emitStats {
+		return nil
+	}
+	pushBackendsLock.Lock()
+	backend, ok := pushBackends[*statsBackend]
+	pushBackendsLock.Unlock()
+	if !ok {
+		return fmt.Errorf("no PushBackend registered with name %s", *statsBackend)
+	}
+	return backend.PushAll()
+}
+
 // emitToBackend does a periodic emit to the selected PushBackend. If a push fails,
 // it will be logged as a warning (but things will otherwise proceed as normal).
 func emitToBackend(emitPeriod *time.Duration) {
@@ -300,6 +321,52 @@ func safeJoinLabels(labels []string, combinedLabels []bool) string {
 	return strings.Join(sanitizedLabels, ".")
 }
 
+// labelValues caps the number of distinct values of each label of a
+// variable to -stats_max_label_values.
+type labelValues struct {
+	mu   sync.Mutex
+	seen []map[string]bool
+}
+
+// limit returns the value of the label of index idx, or StatsOtherStr if it
+// is a new value and the label already has the maximum number of values.
+func (lv *labelValues) limit(idx int, value string) string {
+	max := *maxLabelValues
+	if max <= 0 {
+		return value
+	}
+	lv.mu.Lock()
+	defer lv.mu.Unlock()
+	for len(lv.seen) <= idx {
+		lv.seen = append(lv.seen, make(map[string]bool))
+	}
+	seen := lv.seen[idx]
+	if !seen[value] {
+		if len(seen) >= max {
+			return StatsOtherStr
+		}
+		seen[value] = true
+	}
+	return value
+}
+
+// limitAll returns the values of the labels capped by limit, except the
+// combined ones.
+func (lv *labelValues) limitAll(values []string, combinedLabels []bool) []string {
+	if *maxLabelValues <= 0 {
+		return values
+	}
+	limited := make([]string, len(values))
+	for idx, value := range values {
+		if combinedLabels != nil && combinedLabels[idx] {
+			limited[idx] = value
+		} else {
+			limited[idx] = lv.limit(idx, value)
+		}
+	}
+	return limited
+}
+
 func safeLabel(label string) string {
 	return strings.Replace(label, ".", "_", -1)
 }
@@ -157,3 +157,48 @@ func TestParseCommonTags(t *testing.T) {
 		t.Errorf("expected %v, got %v", expected2, res)
 	}
 }
+
+type fakePushBackend struct {
+	pushes int
+}
+
+func (b *fakePushBackend) PushAll() error {
+	b.pushes++
+	return nil
+}
+
+func TestPushAll(t *testing.T) {
+	backend := &fakePushBackend{}
+	pushBackendsLock.Lock()
+	pushBackends["fakepushall"] = backend
+	pushBackendsLock.Unlock()
+	defer func() {
+		pushBackendsLock.Lock()
+		delete(pushBackends, "fakepushall")
+		pushBackendsLock.Unlock()
+		*emitStats = false
+		*statsBackend = ""
+	}()
+
+	// Nothing is pushed unless stats are emitted.
+	*statsBackend = "fakepushall"
+	if err := PushAll(); err != nil {
+		t.Errorf("PushAll() failed: %v", err)
+	}
+	if backend.pushes != 0 {
+		t.Errorf("want 0 pushes, got %v", backend.pushes)
+	}
+
+	*emitStats = true
+	if err := PushAll(); err != nil {
+		t.Errorf("PushAll() failed: %v", err)
+	}
+	if backend.pushes != 1 {
+		t.Errorf("want 1 push, got %v", backend.pushes)
+	}
+
+	*statsBackend = "unknown"
+	if err := PushAll(); err == nil {
+		t.Errorf("PushAll() with an unknown backend should fail")
+	}
+}
@@ -19,6 +19,8 @@ package stats
 import (
 	"bytes"
 	"fmt"
+	"sync"
+	"time"
 
 	"vitess.io/vitess/go/sync2"
 )
@@ -37,6 +39,23 @@ type Histogram struct {
 
 	buckets []sync2.AtomicInt64
 	total   sync2.AtomicInt64
+
+	// nativeScale is the factor by which the values are multiplied in the
+	// native buckets, which are only tracked if it is set.
+	nativeScale float64
+
+	mu sync.Mutex
+	// exemplars are the last exemplars of each bucket.
+	exemplars []*Exemplar
+	native    *NativeBuckets
+}
+
+// Exemplar is a value added to a Histogram along with the ID of the trace
+// that measured it.
+type Exemplar struct {
+	TraceID string
+	Value   int64
+	Time    time.Time
 }
 
 // NewHistogram creates a histogram with auto-generated labels
@@ -77,13 +96,27 @@ func NewGenericHistogram(name, help string, cutoffs []int64, labels []string, co
 
 // Add adds a new measurement to the Histogram.
 func (h *Histogram) Add(value int64) {
+	h.AddWithTraceID(value, "")
+}
+
+// AddWithTraceID adds a new measurement to the Histogram, and keeps it as the
+// exemplar of its bucket if the trace ID is set.
+func (h *Histogram) AddWithTraceID(value int64, traceID string) {
 	for i := range h.labels {
 		if i == len(h.labels)-1 || value <= h.cutoffs[i] {
 			h.buckets[i].Add(1)
 			h.total.Add(value)
+			if traceID != "" {
+				h.setExemplar(i, &Exemplar{TraceID: traceID, Value: value, Time: time.Now()})
+			}
 			break
 		}
 	}
+	if h.nativeScale != 0 {
+		if schema, ok := nativeSchema(); ok {
+			h.addNative(schema, float64(value)*h.nativeScale)
+		}
+	}
 	if h.hook != nil {
 		h.hook(value)
 	}
@@ -173,3 +206,42 @@ func (h *Histogram) Buckets() []int64 {
 func (h *Histogram) Help() string {
 	return h.help
 }
+
+func (h *Histogram) setExemplar(bucket int, exemplar *Exemplar) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.exemplars == nil {
+		h.exemplars = make([]*Exemplar, len(h.buckets))
+	}
+	h.exemplars[bucket] = exemplar
+}
+
+// Exemplars returns the last exemplar of each bucket, nil for the buckets
+// that have none.
+func (h *Histogram) Exemplars() []*Exemplar {
+	exemplars := make([]*Exemplar, len(h.buckets))
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	copy(exemplars, h.exemplars)
+	return exemplars
+}
+
+func (h *Histogram) addNative(schema int32, value float64) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.native == nil {
+		h.native = &NativeBuckets{Schema: schema, Counts: make(map[int]uint64)}
+	}
+	h.native.add(value)
+}
+
+// NativeBuckets returns a snapshot of the native buckets of the Histogram,
+// or nil if it does not track them.
+func (h *Histogram) NativeBuckets() *NativeBuckets {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.native == nil {
+		return nil
+	}
+	return h.native.copy()
+}
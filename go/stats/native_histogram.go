@@ -0,0 +1,125 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stats
+
+import (
+	"flag"
+	"math"
+	"sort"
+	"sync"
+
+	"vitess.io/vitess/go/vt/log"
+)
+
+var nativeHistogramBucketFactor = flag.Float64("stats_native_histogram_bucket_factor", 0, "If greater than 1, the timings also track native histograms for Prometheus, with exponential buckets growing by at most this factor, e.g. 1.1 for buckets at most 10% wider than the previous one. 0 disables them.")
+
+// NativeHistogramZeroThreshold is the upper bound of the zero bucket of the
+// native histograms. It is the default of the Prometheus client.
+const NativeHistogramZeroThreshold = 2.938735877055719e-39 // 2^-128
+
+// NativeBuckets is a snapshot of the exponential buckets of a native
+// histogram, as defined by Prometheus: with a schema s, the bucket of index i
+// counts the values in (2^((i-1)/2^s), 2^(i/2^s)].
+type NativeBuckets struct {
+	Schema int32
+	// ZeroCount is the number of values up to NativeHistogramZeroThreshold.
+	ZeroCount uint64
+	// Counts maps the indexes of the non-empty buckets to their count.
+	Counts map[int]uint64
+}
+
+var (
+	nativeHistogramOnce   sync.Once
+	nativeHistogramSchema int32
+	nativeHistogramOn     bool
+	// nativeHistogramBounds are the fractions of math.Frexp at which the
+	// buckets of a positive schema start, e.g. [0.5, 0.7071] for schema 1.
+	nativeHistogramBounds []float64
+)
+
+// nativeSchema returns the schema of the native histograms picked from
+// -stats_native_histogram_bucket_factor, or false if they are disabled.
+// Like the Prometheus client, it picks the largest schema whose buckets grow
+// by at most the factor, between -4 and 8.
+func nativeSchema() (int32, bool) {
+	nativeHistogramOnce.Do(func() {
+		nativeHistogramOn = false
+		nativeHistogramBounds = nil
+		factor := *nativeHistogramBucketFactor
+		if factor == 0 {
+			return
+		}
+		if factor <= 1 {
+			log.Errorf("Ignoring -stats_native_histogram_bucket_factor %v: it must be greater than 1", factor)
+			return
+		}
+		schema := -math.Floor(math.Log2(math.Log2(factor)))
+		switch {
+		case schema > 8:
+			schema = 8
+		case schema < -4:
+			schema = -4
+		}
+		nativeHistogramSchema = int32(schema)
+		nativeHistogramOn = true
+		if nativeHistogramSchema > 0 {
+			n := 1 << nativeHistogramSchema
+			nativeHistogramBounds = make([]float64, n)
+			for i := range nativeHistogramBounds {
+				nativeHistogramBounds[i] = math.Exp2(float64(i)/float64(n) - 1)
+			}
+		}
+	})
+	return nativeHistogramSchema, nativeHistogramOn
+}
+
+// nativeBucketIndex returns the index of the bucket of the positive value
+// in the schema, the same way as the Prometheus client.
+func nativeBucketIndex(schema int32, value float64) int {
+	frac, exp := math.Frexp(value)
+	if schema > 0 {
+		return sort.SearchFloat64s(nativeHistogramBounds, frac) + (exp-1)*len(nativeHistogramBounds)
+	}
+	index := exp
+	if frac == 0.5 {
+		index--
+	}
+	div := 1 << -schema
+	return (index + div - 1) / div
+}
+
+// add counts the value in its bucket. It must be called with the lock of
+// the histogram held.
+func (nb *NativeBuckets) add(value float64) {
+	if value <= NativeHistogramZeroThreshold {
+		nb.ZeroCount++
+		return
+	}
+	nb.Counts[nativeBucketIndex(nb.Schema, value)]++
+}
+
+func (nb *NativeBuckets) copy() *NativeBuckets {
+	c := &NativeBuckets{
+		Schema:    nb.Schema,
+		ZeroCount: nb.ZeroCount,
+		Counts:    make(map[int]uint64, len(nb.Counts)),
+	}
+	for index, count := range nb.Counts {
+		c.Counts[index] = count
+	}
+	return c
+}
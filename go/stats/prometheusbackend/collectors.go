@@ -17,9 +17,14 @@ limitations under the License.
 package prometheusbackend
 
 import (
+	"math"
+	"sort"
 	"strings"
 
 	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"vitess.io/vitess/go/stats"
 	"vitess.io/vitess/go/vt/log"
@@ -281,11 +286,7 @@ func (c *timingsCollector) Describe(ch chan<- *prometheus.Desc) {
 // Collect implements Collector.
 func (c *timingsCollector) Collect(ch chan<- prometheus.Metric) {
 	for cat, his := range c.t.Histograms() {
-		metric, err := prometheus.NewConstHistogram(c.desc,
-			uint64(his.Count()),
-			float64(his.Total())/1000000000,
-			makeCumulativeBuckets(c.cutoffs,
-				his.Buckets()), cat)
+		metric, err := newHistogramMetric(c.desc, c.cutoffs, his, 1000000000, cat)
 		if err != nil {
 			log.Errorf("Error adding metric: %s", c.desc)
 		} else {
@@ -305,6 +306,113 @@ func makeCumulativeBuckets(cutoffs []float64, buckets []int64) map[float64]uint6
 	return output
 }
 
+// newHistogramMetric returns the metric of the histogram, with the exemplars
+// of its buckets and its native buckets. The values of the histogram are
+// divided by unit.
+func newHistogramMetric(desc *prometheus.Desc, cutoffs []float64, his *stats.Histogram, unit float64, labelValues ...string) (prometheus.Metric, error) {
+	metric, err := prometheus.NewConstHistogram(desc,
+		uint64(his.Count()),
+		float64(his.Total())/unit,
+		makeCumulativeBuckets(cutoffs, his.Buckets()),
+		labelValues...)
+	if err != nil {
+		return nil, err
+	}
+	hm := &histogramMetric{Metric: metric, native: his.NativeBuckets()}
+	for i, e := range his.Exemplars() {
+		if e == nil {
+			continue
+		}
+		if hm.exemplars == nil {
+			hm.exemplars = make(map[int]*dto.Exemplar)
+		}
+		hm.exemplars[i] = &dto.Exemplar{
+			Label:     []*dto.LabelPair{{Name: proto.String("trace_id"), Value: proto.String(e.TraceID)}},
+			Value:     proto.Float64(float64(e.Value) / unit),
+			Timestamp: timestamppb.New(e.Time),
+		}
+	}
+	if hm.native == nil && hm.exemplars == nil {
+		return metric, nil
+	}
+	return hm, nil
+}
+
+// histogramMetric adds the exemplars of the buckets and the native buckets
+// to a histogram metric.
+type histogramMetric struct {
+	prometheus.Metric
+	// exemplars maps the indexes of the buckets to their exemplar, the last
+	// index being the +Inf bucket.
+	exemplars map[int]*dto.Exemplar
+	native    *stats.NativeBuckets
+}
+
+// Write implements Metric.
+func (m *histogramMetric) Write(out *dto.Metric) error {
+	if err := m.Metric.Write(out); err != nil {
+		return err
+	}
+	h := out.Histogram
+	for i, e := range m.exemplars {
+		if i < len(h.Bucket) {
+			h.Bucket[i].Exemplar = e
+			continue
+		}
+		// The +Inf bucket is implicit unless it has an exemplar.
+		h.Bucket = append(h.Bucket, &dto.Bucket{
+			CumulativeCount: proto.Uint64(h.GetSampleCount()),
+			UpperBound:      proto.Float64(math.Inf(1)),
+			Exemplar:        e,
+		})
+	}
+	if m.native != nil {
+		h.Schema = proto.Int32(m.native.Schema)
+		h.ZeroThreshold = proto.Float64(stats.NativeHistogramZeroThreshold)
+		h.ZeroCount = proto.Uint64(m.native.ZeroCount)
+		h.PositiveSpan, h.PositiveDelta = makeNativeBuckets(m.native.Counts)
+	}
+	return nil
+}
+
+// makeNativeBuckets encodes the counts of the native buckets as spans of
+// consecutive buckets and the deltas between their counts, the same way as
+// the Prometheus client.
+func makeNativeBuckets(counts map[int]uint64) ([]*dto.BucketSpan, []int64) {
+	indexes := make([]int, 0, len(counts))
+	for index := range counts {
+		indexes = append(indexes, index)
+	}
+	sort.Ints(indexes)
+
+	var (
+		spans     []*dto.BucketSpan
+		deltas    []int64
+		prevCount int64
+		next      int
+	)
+	appendDelta := func(count int64) {
+		*spans[len(spans)-1].Length++
+		deltas = append(deltas, count-prevCount)
+		prevCount = count
+	}
+	for n, index := range indexes {
+		gap := int32(index - next)
+		// Gaps of up to two empty buckets are cheaper to encode as zero
+		// deltas than as a new span.
+		if n == 0 || gap > 2 {
+			spans = append(spans, &dto.BucketSpan{Offset: proto.Int32(gap), Length: proto.Uint32(0)})
+		} else {
+			for i := int32(0); i < gap; i++ {
+				appendDelta(0)
+			}
+		}
+		appendDelta(int64(counts[index]))
+		next = index + 1
+	}
+	return spans, deltas
+}
+
 type multiTimingsCollector struct {
 	mt      *stats.MultiTimings
 	cutoffs []float64
@@ -339,12 +447,7 @@ func (c *multiTimingsCollector) Describe(ch chan<- *prometheus.Desc) {
 func (c *multiTimingsCollector) Collect(ch chan<- prometheus.Metric) {
 	for cat, his := range c.mt.Timings.Histograms() {
 		labelValues := strings.Split(cat, ".")
-		metric, err := prometheus.NewConstHistogram(
-			c.desc,
-			uint64(his.Count()),
-			float64(his.Total())/1000000000,
-			makeCumulativeBuckets(c.cutoffs, his.Buckets()),
-			labelValues...)
+		metric, err := newHistogramMetric(c.desc, c.cutoffs, his, 1000000000, labelValues...)
 		if err != nil {
 			log.Errorf("Error adding metric: %s", c.desc)
 		} else {
@@ -385,10 +488,7 @@ func (c *histogramCollector) Describe(ch chan<- *prometheus.Desc) {
 
 // Collect implements Collector.
 func (c *histogramCollector) Collect(ch chan<- prometheus.Metric) {
-	metric, err := prometheus.NewConstHistogram(c.desc,
-		uint64(c.h.Count()),
-		float64(c.h.Total()),
-		makeCumulativeBuckets(c.cutoffs, c.h.Buckets()))
+	metric, err := newHistogramMetric(c.desc, c.cutoffs, c.h, 1)
 	if err != nil {
 		log.Errorf("Error adding metric: %s", c.desc)
 	} else {
@@ -19,6 +19,11 @@ package prometheusbackend
 import (
 	"testing"
 
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
 	"vitess.io/vitess/go/stats"
 )
 
@@ -36,3 +41,30 @@ func TestCollector(t *testing.T) {
 	c := stats.NewCountersFuncWithMultiLabels("Name", "description", []string{"Table", "Plan"}, getStats)
 	c.Counts()
 }
+
+func TestNativeHistogramMetric(t *testing.T) {
+	desc := prometheus.NewDesc("native", "help", nil, nil)
+	metric, err := prometheus.NewConstHistogram(desc, 7, 1, map[float64]uint64{1: 7})
+	require.NoError(t, err)
+	metric = &histogramMetric{Metric: metric, native: &stats.NativeBuckets{
+		Schema:    3,
+		ZeroCount: 1,
+		Counts:    map[int]uint64{-2: 1, 0: 2, 1: 1, 8: 2},
+	}}
+
+	out := &dto.Metric{}
+	require.NoError(t, metric.Write(out))
+	h := out.Histogram
+	assert.EqualValues(t, 7, h.GetSampleCount())
+	assert.EqualValues(t, 3, h.GetSchema())
+	assert.EqualValues(t, 1, h.GetZeroCount())
+	assert.Equal(t, stats.NativeHistogramZeroThreshold, h.GetZeroThreshold())
+	// The gap of one bucket at -1 is a zero delta, the one of six buckets
+	// starts a new span.
+	require.Len(t, h.PositiveSpan, 2)
+	assert.EqualValues(t, -2, h.PositiveSpan[0].GetOffset())
+	assert.EqualValues(t, 4, h.PositiveSpan[0].GetLength())
+	assert.EqualValues(t, 6, h.PositiveSpan[1].GetOffset())
+	assert.EqualValues(t, 1, h.PositiveSpan[1].GetLength())
+	assert.Equal(t, []int64{1, -1, 2, -1, 1}, h.PositiveDelta)
+}
@@ -340,6 +340,28 @@ func TestPrometheusHistogram(t *testing.T) {
 	}
 }
 
+func TestPrometheusTimingsExemplars(t *testing.T) {
+	name := "blah_timings_exemplars"
+	timing := stats.NewTimings(name, "help", "category")
+	timing.AddWithTraceID("cat1", time.Duration(30*time.Millisecond), "abc")
+	timing.AddWithTraceID("cat1", time.Duration(20*time.Second), "def")
+
+	// The exemplars are only exported in the OpenMetrics format.
+	req, _ := http.NewRequest("GET", "/metrics", nil)
+	req.Header.Set("Accept", "application/openmetrics-text; version=0.0.1")
+	response := httptest.NewRecorder()
+	http.DefaultServeMux.ServeHTTP(response, req)
+
+	for _, line := range []string{
+		fmt.Sprintf("%s_%s_bucket{category=\"cat1\",le=\"0.05\"} 1 # {trace_id=\"abc\"} 0.03 ", namespace, name),
+		fmt.Sprintf("%s_%s_bucket{category=\"cat1\",le=\"+Inf\"} 2 # {trace_id=\"def\"} 20.0 ", namespace, name),
+	} {
+		if !strings.Contains(response.Body.String(), line) {
+			t.Fatalf("Expected result to contain %s, got %s", line, response.Body.String())
+		}
+	}
+}
+
 func testMetricsHandler(t *testing.T) *httptest.ResponseRecorder {
 	req, _ := http.NewRequest("GET", "/metrics", nil)
 	response := httptest.NewRecorder()
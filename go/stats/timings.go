@@ -38,6 +38,7 @@ type Timings struct {
 	help          string
 	label         string
 	labelCombined bool
+	labelValues   labelValues
 }
 
 // NewTimings creates a new Timings object, and publishes it if name is set.
@@ -53,7 +54,7 @@ func NewTimings(name, help, label string, categories ...string) *Timings {
 		labelCombined: IsDimensionCombined(label),
 	}
 	for _, cat := range categories {
-		t.histograms[cat] = NewGenericHistogram("", "", bucketCutoffs, bucketLabels, "Count", "Time")
+		t.histograms[cat] = newTimingsHistogram()
 	}
 	if name != "" {
 		publish(name, t)
@@ -71,9 +72,21 @@ func (t *Timings) Reset() {
 
 // Add will add a new value to the named histogram.
 func (t *Timings) Add(name string, elapsed time.Duration) {
+	t.AddWithTraceID(name, elapsed, "")
+}
+
+// AddWithTraceID will add a new value to the named histogram, and keep it as
+// the exemplar of its bucket if the trace ID is set.
+func (t *Timings) AddWithTraceID(name string, elapsed time.Duration, traceID string) {
 	if t.labelCombined {
 		name = StatsAllStr
+	} else {
+		name = t.labelValues.limit(0, name)
 	}
+	t.add(name, elapsed, traceID)
+}
+
+func (t *Timings) add(name string, elapsed time.Duration, traceID string) {
 	// Get existing Histogram.
 	t.mu.RLock()
 	hist, ok := t.histograms[name]
@@ -84,7 +97,7 @@ func (t *Timings) Add(name string, elapsed time.Duration) {
 		t.mu.Lock()
 		hist, ok = t.histograms[name]
 		if !ok {
-			hist = NewGenericHistogram("", "", bucketCutoffs, bucketLabels, "Count", "Time")
+			hist = newTimingsHistogram()
 			t.histograms[name] = hist
 		}
 		t.mu.Unlock()
@@ -94,7 +107,7 @@ func (t *Timings) Add(name string, elapsed time.Duration) {
 	}
 
 	elapsedNs := int64(elapsed)
-	hist.Add(elapsedNs)
+	hist.AddWithTraceID(elapsedNs, traceID)
 	t.totalCount.Add(1)
 	t.totalTime.Add(elapsedNs)
 }
@@ -102,10 +115,13 @@ func (t *Timings) Add(name string, elapsed time.Duration) {
 // Record is a convenience function that records completion
 // timing data based on the provided start time of an event.
 func (t *Timings) Record(name string, startTime time.Time) {
-	if t.labelCombined {
-		name = StatsAllStr
-	}
-	t.Add(name, time.Since(startTime))
+	t.AddWithTraceID(name, time.Since(startTime), "")
+}
+
+// RecordWithTraceID is like Record, and keeps the timing as the exemplar of
+// its bucket if the trace ID is set.
+func (t *Timings) RecordWithTraceID(name string, startTime time.Time, traceID string) {
+	t.AddWithTraceID(name, time.Since(startTime), traceID)
 }
 
 // String is for expvar.
@@ -184,6 +200,14 @@ var bucketCutoffs = []int64{5e5, 1e6, 5e6, 1e7, 5e7, 1e8, 5e8, 1e9, 5e9, 1e10}
 
 var bucketLabels []string
 
+// newTimingsHistogram returns a histogram of durations in nanoseconds, which
+// tracks native buckets in seconds.
+func newTimingsHistogram() *Histogram {
+	h := NewGenericHistogram("", "", bucketCutoffs, bucketLabels, "Count", "Time")
+	h.nativeScale = 1 / float64(time.Second)
+	return h
+}
+
 func init() {
 	bucketLabels = make([]string, len(bucketCutoffs)+1)
 	for i, v := range bucketCutoffs {
@@ -234,7 +258,16 @@ func (mt *MultiTimings) Add(names []string, elapsed time.Duration) {
 	if len(names) != len(mt.labels) {
 		panic("MultiTimings: wrong number of values in Add")
 	}
-	mt.Timings.Add(safeJoinLabels(names, mt.combinedLabels), elapsed)
+	mt.AddWithTraceID(names, elapsed, "")
+}
+
+// AddWithTraceID will add a new value to the named histogram, and keep it as
+// the exemplar of its bucket if the trace ID is set.
+func (mt *MultiTimings) AddWithTraceID(names []string, elapsed time.Duration, traceID string) {
+	if len(names) != len(mt.labels) {
+		panic("MultiTimings: wrong number of values in Add")
+	}
+	mt.Timings.add(safeJoinLabels(mt.labelValues.limitAll(names, mt.combinedLabels), mt.combinedLabels), elapsed, traceID)
 }
 
 // Record is a convenience function that records completion
@@ -243,7 +276,16 @@ func (mt *MultiTimings) Record(names []string, startTime time.Time) {
 	if len(names) != len(mt.labels) {
 		panic("MultiTimings: wrong number of values in Record")
 	}
-	mt.Timings.Record(safeJoinLabels(names, mt.combinedLabels), startTime)
+	mt.AddWithTraceID(names, time.Since(startTime), "")
+}
+
+// RecordWithTraceID is like Record, and keeps the timing as the exemplar of
+// its bucket if the trace ID is set.
+func (mt *MultiTimings) RecordWithTraceID(names []string, startTime time.Time, traceID string) {
+	if len(names) != len(mt.labels) {
+		panic("MultiTimings: wrong number of values in Record")
+	}
+	mt.AddWithTraceID(names, time.Since(startTime), traceID)
 }
 
 // Cutoffs returns the cutoffs used in the component histograms.
@@ -19,6 +19,7 @@ package stats
 import (
 	"expvar"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -99,3 +100,78 @@ func TestTimingsCombineDimension(t *testing.T) {
 	want = `{"TotalCount":1,"TotalTime":1,"Histograms":{"all.c2.all":{"500000":1,"1000000":0,"5000000":0,"10000000":0,"50000000":0,"100000000":0,"500000000":0,"1000000000":0,"5000000000":0,"10000000000":0,"inf":0,"Count":1,"Time":1}}}`
 	assert.Equal(t, want, t3.String())
 }
+
+func TestTimingsExemplars(t *testing.T) {
+	clear()
+	tm := NewTimings("timings_exemplars", "help", "category")
+	tm.Add("tag1", 1*time.Millisecond)
+	assert.Equal(t, make([]*Exemplar, len(bucketLabels)), tm.Histograms()["tag1"].Exemplars())
+
+	tm.AddWithTraceID("tag1", 2*time.Millisecond, "trace1")
+	tm.AddWithTraceID("tag1", 3*time.Millisecond, "trace2")
+	tm.AddWithTraceID("tag1", 20*time.Second, "trace3")
+	exemplars := tm.Histograms()["tag1"].Exemplars()
+	// The last exemplars of the 5ms and inf buckets.
+	assert.Equal(t, "trace2", exemplars[2].TraceID)
+	assert.EqualValues(t, 3*time.Millisecond, exemplars[2].Value)
+	assert.Equal(t, "trace3", exemplars[10].TraceID)
+	assert.Nil(t, exemplars[1])
+
+	mt := NewMultiTimings("multitimings_exemplars", "help", []string{"label1", "label2"})
+	mt.RecordWithTraceID([]string{"value1", "value2"}, time.Now(), "trace4")
+	assert.Equal(t, "trace4", mt.Histograms()["value1.value2"].Exemplars()[0].TraceID)
+}
+
+func TestTimingsNativeBuckets(t *testing.T) {
+	clear()
+	defer func(factor float64) {
+		*nativeHistogramBucketFactor = factor
+		nativeHistogramOnce = sync.Once{}
+	}(*nativeHistogramBucketFactor)
+	*nativeHistogramBucketFactor = 1.1
+	nativeHistogramOnce = sync.Once{}
+
+	tm := NewTimings("timings_native", "help", "category")
+	tm.Add("tag1", 0)
+	tm.Add("tag1", 1*time.Second)
+	tm.Add("tag1", 2*time.Second)
+	tm.Add("tag1", 2*time.Second)
+	tm.Add("tag1", 2100*time.Millisecond)
+	// A factor of 1.1 is schema 3, with 8 buckets per power of 2.
+	want := &NativeBuckets{
+		Schema:    3,
+		ZeroCount: 1,
+		Counts:    map[int]uint64{0: 1, 8: 2, 9: 1},
+	}
+	assert.Equal(t, want, tm.Histograms()["tag1"].NativeBuckets())
+
+	// The native buckets are only tracked by the timings.
+	h := NewHistogram("", "help", []int64{1, 5})
+	h.Add(2)
+	assert.Nil(t, h.NativeBuckets())
+
+	*nativeHistogramBucketFactor = 0
+	nativeHistogramOnce = sync.Once{}
+	tm = NewTimings("timings_native_disabled", "help", "category")
+	tm.Add("tag1", 1*time.Second)
+	assert.Nil(t, tm.Histograms()["tag1"].NativeBuckets())
+}
+
+func TestTimingsMaxLabelValues(t *testing.T) {
+	clear()
+	defer func(max int) { *maxLabelValues = max }(*maxLabelValues)
+	*maxLabelValues = 2
+
+	tm := NewTimings("timings_max_label_values", "help", "category")
+	for _, name := range []string{"tag1", "tag2", "tag3", "tag1", "tag4"} {
+		tm.Add(name, 1)
+	}
+	assert.Equal(t, map[string]int64{"tag1": 2, "tag2": 1, StatsOtherStr: 2, "All": 5}, tm.Counts())
+
+	mt := NewMultiTimings("multitimings_max_label_values", "help", []string{"label1", "label2"})
+	mt.Add([]string{"a", "x"}, 1)
+	mt.Add([]string{"b", "y"}, 1)
+	mt.Add([]string{"c", "x"}, 1)
+	mt.Add([]string{"a", "z"}, 1)
+	assert.Equal(t, map[string]int64{"a.x": 1, "b.y": 1, "other.x": 1, "a.other": 1, "All": 4}, mt.Counts())
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package streamlog
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// FilterFields are the fields of a message on which the HTTP endpoints
+// filter it.
+type FilterFields struct {
+	// User is the immediate caller of the query.
+	User        string
+	Keyspace    string
+	Fingerprint string
+	Duration    time.Duration
+}
+
+// Filterable is implemented by the messages which the HTTP endpoints can
+// filter.
+type Filterable interface {
+	FilterFields() FilterFields
+}
+
+// Filter selects the messages streamed by the HTTP endpoints, from the
+// user, keyspace, fingerprint and min_duration parameters of the request.
+// A message matches if it has one of the values of each parameter which is
+// set, and lasted at least min_duration. A nil Filter matches all messages.
+type Filter struct {
+	users        map[string]bool
+	keyspaces    map[string]bool
+	fingerprints map[string]bool
+	minDuration  time.Duration
+}
+
+// ParseFilter returns the filter of the parameters, or nil if they have
+// none.
+func ParseFilter(params url.Values) (*Filter, error) {
+	filter := &Filter{
+		users:        filterValues(params["user"]),
+		keyspaces:    filterValues(params["keyspace"]),
+		fingerprints: filterValues(params["fingerprint"]),
+	}
+	if minDuration := params.Get("min_duration"); minDuration != "" {
+		d, err := time.ParseDuration(minDuration)
+		if err != nil || d < 0 {
+			return nil, fmt.Errorf("invalid min_duration %q", minDuration)
+		}
+		filter.minDuration = d
+	}
+	if filter.users == nil && filter.keyspaces == nil && filter.fingerprints == nil && filter.minDuration == 0 {
+		return nil, nil
+	}
+	return filter, nil
+}
+
+func filterValues(values []string) map[string]bool {
+	var set map[string]bool
+	for _, value := range values {
+		if value == "" {
+			continue
+		}
+		if set == nil {
+			set = make(map[string]bool)
+		}
+		set[value] = true
+	}
+	return set
+}
+
+// Match returns true if the message passes the filter. The messages which
+// are not Filterable always pass it.
+func (filter *Filter) Match(message interface{}) bool {
+	if filter == nil {
+		return true
+	}
+	fm, ok := message.(Filterable)
+	if !ok {
+		return true
+	}
+	fields := fm.FilterFields()
+	if filter.users != nil && !filter.users[fields.User] {
+		return false
+	}
+	if filter.keyspaces != nil && !filter.keyspaces[fields.Keyspace] {
+		return false
+	}
+	if filter.fingerprints != nil && !filter.fingerprints[fields.Fingerprint] {
+		return false
+	}
+	return fields.Duration >= filter.minDuration
+}
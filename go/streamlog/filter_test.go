@@ -0,0 +1,64 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package streamlog
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type filterableMessage FilterFields
+
+func (m filterableMessage) FilterFields() FilterFields {
+	return FilterFields(m)
+}
+
+func TestFilter(t *testing.T) {
+	filter, err := ParseFilter(url.Values{"full": {""}, "user": {""}})
+	require.NoError(t, err)
+	assert.Nil(t, filter)
+	assert.True(t, filter.Match(filterableMessage{}))
+
+	filter, err = ParseFilter(url.Values{
+		"user":         {"app", "batch"},
+		"keyspace":     {"ks"},
+		"fingerprint":  {"f1"},
+		"min_duration": {"100ms"},
+	})
+	require.NoError(t, err)
+	match := filterableMessage{User: "batch", Keyspace: "ks", Fingerprint: "f1", Duration: time.Second}
+	assert.True(t, filter.Match(match))
+	for _, m := range []filterableMessage{
+		{User: "other", Keyspace: "ks", Fingerprint: "f1", Duration: time.Second},
+		{User: "app", Keyspace: "other", Fingerprint: "f1", Duration: time.Second},
+		{User: "app", Keyspace: "ks", Fingerprint: "f2", Duration: time.Second},
+		{User: "app", Keyspace: "ks", Fingerprint: "f1", Duration: time.Millisecond},
+	} {
+		assert.False(t, filter.Match(m), "%+v", m)
+	}
+	// The messages which cannot be filtered always match.
+	assert.True(t, filter.Match(&logMessage{"val"}))
+
+	for _, invalid := range []string{"soon", "-1s"} {
+		_, err = ParseFilter(url.Values{"min_duration": {invalid}})
+		assert.Error(t, err, invalid)
+	}
+}
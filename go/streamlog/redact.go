@@ -0,0 +1,136 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package streamlog
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"sync"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/log"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var (
+	// QueryLogRedactBindVars controls how the values of the bind variables
+	// are redacted from the query logs.
+	QueryLogRedactBindVars = flag.String("querylog-redact-bind-vars", "", "redact the values of the bind variables from the query logs: \"type\" replaces them by their type and length, \"hmac\" by their type and an HMAC token of the key of -querylog-redact-hmac-key-file, which is the same for the same values in the logs of all the servers sharing the key. vtgate also redacts the literals of the logged queries, which are not bind variables without -normalize_queries")
+
+	queryLogRedactHMACKeyFile = flag.String("querylog-redact-hmac-key-file", "", "file containing the key of the HMAC tokens of -querylog-redact-bind-vars=hmac")
+)
+
+const (
+	// QueryLogRedactType is the -querylog-redact-bind-vars mode replacing
+	// the values by their type and length.
+	QueryLogRedactType = "type"
+
+	// QueryLogRedactHMAC is the -querylog-redact-bind-vars mode replacing
+	// the values by their type and an HMAC token.
+	QueryLogRedactHMAC = "hmac"
+
+	// hmacTokenLength is the number of hex digits of the HMAC tokens.
+	hmacTokenLength = 16
+)
+
+var (
+	hmacKeyOnce sync.Once
+	hmacKey     []byte
+)
+
+// RedactsBindVars returns true if the values of the bind variables are
+// redacted from the query logs, by -redact-debug-ui-queries or
+// -querylog-redact-bind-vars. The queries embedding them, e.g. the rewritten
+// queries of vttablet, must not be logged either then.
+func RedactsBindVars() bool {
+	return *RedactDebugUIQueries || *QueryLogRedactBindVars != ""
+}
+
+// FormatBindVariables formats the bind variables of a query log like
+// sqltypes.FormatBindVariables, redacted according to
+// -redact-debug-ui-queries and -querylog-redact-bind-vars.
+func FormatBindVariables(bindVariables map[string]*querypb.BindVariable, full, asJSON bool) string {
+	if *RedactDebugUIQueries {
+		return "\"[REDACTED]\""
+	}
+	if *QueryLogRedactBindVars == "" {
+		return sqltypes.FormatBindVariables(bindVariables, full, asJSON)
+	}
+	// The placeholders are short, and always logged in full.
+	return sqltypes.FormatBindVariables(RedactBindVariables(bindVariables), true, asJSON)
+}
+
+// RedactBindVariables returns the bind variables with their values replaced
+// by the placeholders of -querylog-redact-bind-vars. The placeholders are
+// strings.
+func RedactBindVariables(bindVariables map[string]*querypb.BindVariable) map[string]*querypb.BindVariable {
+	redacted := make(map[string]*querypb.BindVariable, len(bindVariables))
+	for name, bv := range bindVariables {
+		var placeholder string
+		if bv.Type == querypb.Type_TUPLE {
+			values := make([]string, 0, len(bv.Values))
+			for _, value := range bv.Values {
+				values = append(values, redactValue(value.Type, value.Value))
+			}
+			placeholder = fmt.Sprintf("TUPLE(%s)", strings.Join(values, ", "))
+		} else {
+			placeholder = redactValue(bv.Type, bv.Value)
+		}
+		redacted[name] = sqltypes.StringBindVariable(placeholder)
+	}
+	return redacted
+}
+
+// redactValue returns the placeholder of a value, e.g. VARCHAR(12) for the
+// type mode, or VARCHAR:3f2a9c0d1b4e6a75 for the hmac mode. The HMAC token
+// does not depend on the type, so the same value has the same token in
+// all the logs. Without a key, the hmac mode falls back to the type mode.
+func redactValue(typ querypb.Type, value []byte) string {
+	if *QueryLogRedactBindVars == QueryLogRedactHMAC {
+		if key := loadHMACKey(); key != nil {
+			mac := hmac.New(sha256.New, key)
+			mac.Write(value)
+			return fmt.Sprintf("%v:%s", typ, hex.EncodeToString(mac.Sum(nil))[:hmacTokenLength])
+		}
+	}
+	return fmt.Sprintf("%v(%d)", typ, len(value))
+}
+
+func loadHMACKey() []byte {
+	hmacKeyOnce.Do(func() {
+		if *queryLogRedactHMACKeyFile == "" {
+			log.Errorf("-querylog-redact-bind-vars=hmac requires -querylog-redact-hmac-key-file, redacting the bind variables by type")
+			return
+		}
+		key, err := ioutil.ReadFile(*queryLogRedactHMACKeyFile)
+		if err == nil && len(strings.TrimSpace(string(key))) == 0 {
+			err = fmt.Errorf("the key is empty")
+		}
+		if err != nil {
+			log.Errorf("Cannot read the HMAC key of the query logs from %v, redacting the bind variables by type: %v", *queryLogRedactHMACKeyFile, err)
+			return
+		}
+		hmacKey = []byte(strings.TrimSpace(string(key)))
+	})
+	return hmacKey
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package streamlog
+
+import (
+	"io/ioutil"
+	"path"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestFormatBindVariablesRedacted(t *testing.T) {
+	defer func() {
+		*QueryLogRedactBindVars = ""
+		*queryLogRedactHMACKeyFile = ""
+		hmacKeyOnce = sync.Once{}
+		hmacKey = nil
+	}()
+	bindVars := map[string]*querypb.BindVariable{
+		"id":   sqltypes.Int64BindVariable(123),
+		"name": sqltypes.StringBindVariable("alice"),
+	}
+
+	assert.False(t, RedactsBindVars())
+	assert.Equal(t, `{"id": {"type": "INT64", "value": 123}}`, FormatBindVariables(map[string]*querypb.BindVariable{"id": bindVars["id"]}, false, true))
+
+	*QueryLogRedactBindVars = QueryLogRedactType
+	assert.True(t, RedactsBindVars())
+	redacted := RedactBindVariables(bindVars)
+	assert.Equal(t, "INT64(3)", string(redacted["id"].Value))
+	assert.Equal(t, "VARBINARY(5)", string(redacted["name"].Value))
+	tuple, err := sqltypes.BuildBindVariable([]interface{}{1, "ab"})
+	require.NoError(t, err)
+	assert.Equal(t, "TUPLE(INT64(1), VARBINARY(2))", string(RedactBindVariables(map[string]*querypb.BindVariable{"ids": tuple})["ids"].Value))
+	// The placeholders are always logged, and the JSON stays valid.
+	assert.Equal(t, `{"id": {"type": "VARBINARY", "value": "INT64(3)"}}`, FormatBindVariables(map[string]*querypb.BindVariable{"id": bindVars["id"]}, false, true))
+
+	// Without a key, the hmac mode redacts by type.
+	*QueryLogRedactBindVars = QueryLogRedactHMAC
+	assert.Equal(t, "INT64(3)", string(RedactBindVariables(bindVars)["id"].Value))
+
+	keyFile := path.Join(t.TempDir(), "key")
+	require.NoError(t, ioutil.WriteFile(keyFile, []byte("secret\n"), 0600))
+	*queryLogRedactHMACKeyFile = keyFile
+	hmacKeyOnce = sync.Once{}
+	redacted = RedactBindVariables(bindVars)
+	assert.Regexp(t, "^INT64:[0-9a-f]{16}$", string(redacted["id"].Value))
+	assert.Regexp(t, "^VARBINARY:[0-9a-f]{16}$", string(redacted["name"].Value))
+	// The same values have the same tokens.
+	again := RedactBindVariables(map[string]*querypb.BindVariable{
+		"other": sqltypes.StringBindVariable("alice"),
+		"bob":   sqltypes.StringBindVariable("bob"),
+	})
+	assert.Equal(t, string(redacted["name"].Value), string(again["other"].Value))
+	assert.NotEqual(t, string(redacted["name"].Value), string(again["bob"].Value))
+}
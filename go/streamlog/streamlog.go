@@ -132,6 +132,8 @@ func (logger *StreamLogger) Name() string {
 
 // ServeLogs registers the URL on which messages will be broadcast.
 // It is safe to register multiple URLs for the same StreamLogger.
+// The user, keyspace, fingerprint and min_duration parameters of the
+// requests filter the messages, see Filter.
 func (logger *StreamLogger) ServeLogs(url string, logf LogFormatter) {
 	http.HandleFunc(url, func(w http.ResponseWriter, r *http.Request) {
 		if err := acl.CheckAccessHTTP(r, acl.DEBUGGING); err != nil {
@@ -141,6 +143,11 @@ func (logger *StreamLogger) ServeLogs(url string, logf LogFormatter) {
 		if err := r.ParseForm(); err != nil {
 			http.Error(w, err.Error(), http.StatusBadRequest)
 		}
+		filter, err := ParseFilter(r.Form)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
 		ch := logger.Subscribe("ServeLogs")
 		defer logger.Unsubscribe(ch)
 
@@ -149,6 +156,9 @@ func (logger *StreamLogger) ServeLogs(url string, logf LogFormatter) {
 		w.(http.Flusher).Flush()
 
 		for message := range ch {
+			if !filter.Match(message) {
+				continue
+			}
 			if err := logf(w, r.Form, message); err != nil {
 				return
 			}
@@ -19,6 +19,7 @@ package asthelpergen
 import (
 	"fmt"
 	"go/types"
+	"reflect"
 
 	"github.com/dave/jennifer/jen"
 )
@@ -151,6 +152,10 @@ func compareAllStructFields(strct *types.Struct, spi generatorSPI) jen.Code {
 			// we can safely ignore this, we do not want ast to contain interface{} types.
 			continue
 		}
+		if reflect.StructTag(strct.Tag(i)).Get("equals") == "-" {
+			// fields such as positions in the query text do not change what the node means
+			continue
+		}
 		fieldA := jen.Id("a").Dot(field.Name())
 		fieldB := jen.Id("b").Dot(field.Name())
 		pred := compareValueType(field.Type(), fieldA, fieldB, true, spi)
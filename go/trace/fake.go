@@ -33,6 +33,7 @@ func (noopTracingServer) NewClientSpan(parent Span, serviceName, label string) S
 func (noopTracingServer) FromContext(context.Context) (Span, bool)                  { return nil, false }
 func (noopTracingServer) NewFromString(parent, label string) (Span, error)          { return NoopSpan{}, nil }
 func (noopTracingServer) NewContext(parent context.Context, _ Span) context.Context { return parent }
+func (noopTracingServer) TraceID(Span) string                                       { return "" }
 func (noopTracingServer) AddGrpcServerOptions(addInterceptors func(s grpc.StreamServerInterceptor, u grpc.UnaryServerInterceptor)) {
 }
 func (noopTracingServer) AddGrpcClientOptions(addInterceptors func(s grpc.StreamClientInterceptor, u grpc.UnaryClientInterceptor)) {
@@ -49,6 +49,9 @@ var _ tracingService = (*openTracingService)(nil)
 
 type tracer interface {
 	GetOpenTracingTracer() opentracing.Tracer
+	// TraceID returns the ID of the trace of the span context, or "" if it
+	// is unknown.
+	TraceID(spanContext opentracing.SpanContext) string
 }
 
 type openTracingService struct {
@@ -125,6 +128,15 @@ func (jf openTracingService) FromContext(ctx context.Context) (Span, bool) {
 	return openTracingSpan{otSpan: innerSpan}, true
 }
 
+// TraceID is part of an interface implementation
+func (jf openTracingService) TraceID(s Span) string {
+	span, ok := s.(openTracingSpan)
+	if !ok {
+		return ""
+	}
+	return jf.Tracer.TraceID(span.otSpan.Context())
+}
+
 // NewContext is part of an interface implementation
 func (jf openTracingService) NewContext(parent context.Context, s Span) context.Context {
 	span, ok := s.(openTracingSpan)
@@ -17,12 +17,14 @@ limitations under the License.
 package trace
 
 import (
+	"context"
 	"encoding/base64"
 	"encoding/json"
 	"testing"
 
 	"github.com/opentracing/opentracing-go"
 	"github.com/stretchr/testify/assert"
+	"github.com/uber/jaeger-client-go"
 )
 
 func TestExtractMapFromString(t *testing.T) {
@@ -47,3 +49,18 @@ func TestErrorConditions(t *testing.T) {
 	_, err = extractMapFromString("this is not base64") // malformed base64
 	assert.Error(t, err)
 }
+
+func TestTraceID(t *testing.T) {
+	tracer, closer := jaeger.NewTracer("test", jaeger.NewConstSampler(true), jaeger.NewNullReporter())
+	defer closer.Close()
+	defer func(saved tracingService) { currentTracer = saved }(currentTracer)
+	currentTracer = openTracingService{Tracer: &jaegerTracer{actual: tracer}}
+
+	assert.Empty(t, TraceID(context.Background()))
+
+	span, ctx := NewSpan(context.Background(), "test")
+	defer span.Finish()
+	want := span.(openTracingSpan).otSpan.Context().(jaeger.SpanContext).TraceID().String()
+	assert.NotEmpty(t, want)
+	assert.Equal(t, want, TraceID(ctx))
+}
@@ -4,8 +4,10 @@ import (
 	"flag"
 	"fmt"
 	"io"
+	"strconv"
 
 	"github.com/opentracing/opentracing-go"
+	"gopkg.in/DataDog/dd-trace-go.v1/ddtrace"
 	"gopkg.in/DataDog/dd-trace-go.v1/ddtrace/opentracer"
 	ddtracer "gopkg.in/DataDog/dd-trace-go.v1/ddtrace/tracer"
 )
@@ -60,3 +62,10 @@ type datadogTracer struct {
 func (dt *datadogTracer) GetOpenTracingTracer() opentracing.Tracer {
 	return dt.actual
 }
+
+func (dt *datadogTracer) TraceID(spanContext opentracing.SpanContext) string {
+	if sc, ok := spanContext.(ddtrace.SpanContext); ok {
+		return strconv.FormatUint(sc.TraceID(), 10)
+	}
+	return ""
+}
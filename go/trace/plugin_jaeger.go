@@ -129,3 +129,10 @@ type jaegerTracer struct {
 func (jt *jaegerTracer) GetOpenTracingTracer() opentracing.Tracer {
 	return jt.actual
 }
+
+func (jt *jaegerTracer) TraceID(spanContext opentracing.SpanContext) string {
+	if sc, ok := spanContext.(jaeger.SpanContext); ok {
+		return sc.TraceID().String()
+	}
+	return ""
+}
@@ -82,6 +82,16 @@ func NewContext(parent context.Context, span Span) context.Context {
 	return currentTracer.NewContext(parent, span)
 }
 
+// TraceID returns the ID of the trace of the Span of the context, or "" if
+// it has none or the tracing plugin does not expose it.
+func TraceID(ctx context.Context) string {
+	span, ok := FromContext(ctx)
+	if !ok {
+		return ""
+	}
+	return currentTracer.TraceID(span)
+}
+
 // CopySpan creates a new context from parentCtx, with only the trace span
 // copied over from spanCtx, if it has any. If not, parentCtx is returned.
 func CopySpan(parentCtx, spanCtx context.Context) context.Context {
@@ -115,6 +125,9 @@ type tracingService interface {
 	// NewContext creates a new context containing the provided span
 	NewContext(parent context.Context, span Span) context.Context
 
+	// TraceID returns the ID of the trace of the span, or "" if it is unknown
+	TraceID(span Span) string
+
 	// AddGrpcServerOptions allows a tracing system to add interceptors to grpc server traffic
 	AddGrpcServerOptions(addInterceptors func(s grpc.StreamServerInterceptor, u grpc.UnaryServerInterceptor))
 
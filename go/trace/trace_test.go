@@ -112,6 +112,10 @@ func (f *fakeTracer) NewContext(parent context.Context, span Span) context.Conte
 	return parent
 }
 
+func (f *fakeTracer) TraceID(span Span) string {
+	return ""
+}
+
 func (f *fakeTracer) AddGrpcServerOptions(addInterceptors func(s grpc.StreamServerInterceptor, u grpc.UnaryServerInterceptor)) {
 	panic("implement me")
 }
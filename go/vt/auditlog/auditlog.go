@@ -0,0 +1,198 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package auditlog records the DDLs and the admin operations, such as the
+// mutating vtctld RPCs and the reparents, to an audit log. The events of a
+// process carry consecutive sequence numbers and are chained by their
+// hashes, so that removing or altering an event can be detected with Verify.
+package auditlog
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"flag"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/callerid"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	auditlogdatapb "vitess.io/vitess/go/vt/proto/auditlogdata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var (
+	sinkName = flag.String("audit_log_sink", "", "Where to record the audit log of the DDLs and admin operations. Options: file, syslog, grpc. The audit log is disabled if unset.")
+
+	recordErrors = stats.NewCountersWithSingleLabel("AuditLogErrors", "Audit log events that could not be recorded, by sink", "sink")
+)
+
+// Sink records the events of the audit log.
+type Sink interface {
+	// Record records an event. It is called in the order of the events.
+	Record(ctx context.Context, event *auditlogdatapb.Event) error
+}
+
+// sinkFactories holds the sinks, by name.
+var sinkFactories = make(map[string]func() (Sink, error))
+
+// RegisterSink registers a sink, for -audit_log_sink to select it.
+func RegisterSink(name string, factory func() (Sink, error)) {
+	if _, ok := sinkFactories[name]; ok {
+		log.Fatalf("audit log sink %v already registered", name)
+	}
+	sinkFactories[name] = factory
+}
+
+// Logger records the events of a process to a sink, numbering and chaining
+// them.
+type Logger struct {
+	component string
+	sinkName  string
+	sink      Sink
+
+	mu           sync.Mutex
+	sequence     uint64
+	previousHash string
+}
+
+// NewLogger returns a Logger recording the events of the component to the
+// sink.
+func NewLogger(component, sinkName string, sink Sink) *Logger {
+	return &Logger{
+		component: component,
+		sinkName:  sinkName,
+		sink:      sink,
+	}
+}
+
+// Record numbers and chains the event, and records it. An event that cannot
+// be recorded is logged, and still numbered, so that the gap in the
+// sequence numbers shows it is missing.
+func (l *Logger) Record(ctx context.Context, event *auditlogdatapb.Event) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.sequence++
+	event.Sequence = l.sequence
+	if event.Time == nil {
+		event.Time = logutil.TimeToProto(time.Now())
+	}
+	if event.Component == "" {
+		event.Component = l.component
+	}
+	event.PreviousHash = l.previousHash
+	event.Hash = hashEvent(event)
+	l.previousHash = event.Hash
+
+	if err := l.sink.Record(ctx, event); err != nil {
+		recordErrors.Add(l.sinkName, 1)
+		log.Errorf("Cannot record audit log event %v: %v", event, err)
+	}
+}
+
+func hashEvent(event *auditlogdatapb.Event) string {
+	unhashed := proto.Clone(event).(*auditlogdatapb.Event)
+	unhashed.Hash = ""
+	// Marshaling an event cannot fail, it has no required or invalid field.
+	data, _ := proto.MarshalOptions{Deterministic: true}.Marshal(unhashed)
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:])
+}
+
+// Verify checks that the events, recorded in that order by a process, are
+// consecutive and unaltered.
+func Verify(events []*auditlogdatapb.Event) error {
+	for i, event := range events {
+		if hashEvent(event) != event.Hash {
+			return vterrors.Errorf(vtrpcpb.Code_DATA_LOSS, "audit log event %v was altered", event.Sequence)
+		}
+		if i == 0 {
+			continue
+		}
+		previous := events[i-1]
+		if event.Sequence != previous.Sequence+1 {
+			return vterrors.Errorf(vtrpcpb.Code_DATA_LOSS, "audit log events %v to %v are missing", previous.Sequence+1, event.Sequence-1)
+		}
+		if event.PreviousHash != previous.Hash {
+			return vterrors.Errorf(vtrpcpb.Code_DATA_LOSS, "audit log event %v does not follow event %v", event.Sequence, previous.Sequence)
+		}
+	}
+	return nil
+}
+
+var (
+	defaultLoggerOnce sync.Once
+	defaultLogger     *Logger
+)
+
+// getDefaultLogger returns the logger selected by -audit_log_sink, or nil if
+// the audit log is disabled.
+func getDefaultLogger() *Logger {
+	defaultLoggerOnce.Do(func() {
+		if *sinkName == "" {
+			return
+		}
+		factory, ok := sinkFactories[*sinkName]
+		if !ok {
+			log.Exitf("unknown audit log sink %v", *sinkName)
+		}
+		sink, err := factory()
+		if err != nil {
+			log.Exitf("cannot create the %v audit log sink: %v", *sinkName, err)
+		}
+		defaultLogger = NewLogger(filepath.Base(os.Args[0]), *sinkName, sink)
+	})
+	return defaultLogger
+}
+
+// Enabled returns true if the audit log is enabled.
+func Enabled() bool {
+	return getDefaultLogger() != nil
+}
+
+// Record records an event to the audit log, if it is enabled.
+func Record(ctx context.Context, event *auditlogdatapb.Event) {
+	if l := getDefaultLogger(); l != nil {
+		l.Record(ctx, event)
+	}
+}
+
+// Actor returns the user performing an operation, from the caller IDs of
+// its context.
+func Actor(ctx context.Context) string {
+	if principal := callerid.GetPrincipal(callerid.EffectiveCallerIDFromContext(ctx)); principal != "" {
+		return principal
+	}
+	return callerid.GetUsername(callerid.ImmediateCallerIDFromContext(ctx))
+}
+
+// ErrorString returns the error field of an event for the outcome of an
+// operation.
+func ErrorString(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
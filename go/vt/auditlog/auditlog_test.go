@@ -0,0 +1,125 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auditlog
+
+import (
+	"bufio"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"vitess.io/vitess/go/vt/callerid"
+
+	auditlogdatapb "vitess.io/vitess/go/vt/proto/auditlogdata"
+)
+
+type memorySink struct {
+	events []*auditlogdatapb.Event
+}
+
+func (s *memorySink) Record(ctx context.Context, event *auditlogdatapb.Event) error {
+	s.events = append(s.events, proto.Clone(event).(*auditlogdatapb.Event))
+	return nil
+}
+
+func TestLogger(t *testing.T) {
+	sink := &memorySink{}
+	l := NewLogger("vtgate", "memory", sink)
+	ctx := callerid.NewContext(context.Background(), callerid.NewEffectiveCallerID("user1", "", ""), nil)
+	for _, statement := range []string{"create table t1(id int)", "drop table t2", "alter table t3 add c int"} {
+		l.Record(ctx, &auditlogdatapb.Event{Actor: Actor(ctx), Action: "ddl", Statement: statement, Keyspace: "ks"})
+	}
+
+	require.Len(t, sink.events, 3)
+	for i, event := range sink.events {
+		assert.EqualValues(t, i+1, event.Sequence)
+		assert.Equal(t, "vtgate", event.Component)
+		assert.Equal(t, "user1", event.Actor)
+		assert.NotNil(t, event.Time)
+	}
+	assert.Empty(t, sink.events[0].PreviousHash)
+	assert.Equal(t, sink.events[0].Hash, sink.events[1].PreviousHash)
+	require.NoError(t, Verify(sink.events))
+
+	altered := proto.Clone(sink.events[1]).(*auditlogdatapb.Event)
+	altered.Statement = "drop table t4"
+	assert.EqualError(t, Verify([]*auditlogdatapb.Event{sink.events[0], altered, sink.events[2]}), "audit log event 2 was altered")
+	assert.EqualError(t, Verify([]*auditlogdatapb.Event{sink.events[0], sink.events[2]}), "audit log events 2 to 2 are missing")
+
+	// Rehashing an altered event breaks the chain with the next one.
+	altered.Hash = hashEvent(altered)
+	assert.EqualError(t, Verify([]*auditlogdatapb.Event{sink.events[0], altered, sink.events[2]}), "audit log event 3 does not follow event 2")
+}
+
+func TestFileSink(t *testing.T) {
+	defer func(f string) { *file = f }(*file)
+	*file = filepath.Join(t.TempDir(), "audit.log")
+
+	sink, err := newFileSink()
+	require.NoError(t, err)
+	l := NewLogger("vtctld", "file", sink)
+	l.Record(context.Background(), &auditlogdatapb.Event{Action: "PlannedReparentShard", Keyspace: "ks", Shard: "0"})
+	l.Record(context.Background(), &auditlogdatapb.Event{Action: "DeleteTablets", Error: "node doesn't exist"})
+
+	f, err := os.Open(*file)
+	require.NoError(t, err)
+	defer f.Close()
+	var events []*auditlogdatapb.Event
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		event := &auditlogdatapb.Event{}
+		require.NoError(t, protojson.Unmarshal(scanner.Bytes(), event))
+		events = append(events, event)
+	}
+	require.Len(t, events, 2)
+	assert.Equal(t, "node doesn't exist", events[1].Error)
+	assert.NoError(t, Verify(events))
+}
+
+func TestIsMutating(t *testing.T) {
+	assert.False(t, IsMutating("GetKeyspace"))
+	assert.False(t, IsMutating("ValidateSchemaKeyspace"))
+	assert.True(t, IsMutating("PlannedReparentShard"))
+	assert.True(t, IsMutating("ApplySchema"))
+}
+
+func TestRecordRPC(t *testing.T) {
+	sink := &memorySink{}
+	defaultLoggerOnce.Do(func() {})
+	defer func() { defaultLogger = nil }()
+	defaultLogger = NewLogger("vtctld", "memory", sink)
+
+	interceptor := UnaryServerInterceptor("vtctlservice.Vtctld")
+	ctx := context.Background()
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return nil, nil }
+	_, err := interceptor(ctx, &auditlogdatapb.RecordRequest{}, &grpc.UnaryServerInfo{FullMethod: "/vtctlservice.Vtctld/GetKeyspace"}, handler)
+	require.NoError(t, err)
+	_, err = interceptor(ctx, &auditlogdatapb.RecordRequest{}, &grpc.UnaryServerInfo{FullMethod: "/vtctlservice.Vtctld/DeleteKeyspace"}, handler)
+	require.NoError(t, err)
+	_, err = interceptor(ctx, &auditlogdatapb.RecordRequest{}, &grpc.UnaryServerInfo{FullMethod: "/vtgateservice.Vitess/Execute"}, handler)
+	require.NoError(t, err)
+
+	require.Len(t, sink.events, 1)
+	assert.Equal(t, "DeleteKeyspace", sink.events[0].Action)
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auditlog
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/proto"
+
+	"vitess.io/vitess/go/json2"
+
+	auditlogdatapb "vitess.io/vitess/go/vt/proto/auditlogdata"
+)
+
+// readOnlyPrefixes are the prefixes of the names of the RPCs and vtctl
+// commands that do not change anything, and are not audited.
+var readOnlyPrefixes = []string{
+	"Find",
+	"Get",
+	"Help",
+	"List",
+	"Ping",
+	"ShardReplicationPositions",
+	"Validate",
+}
+
+// IsMutating returns true if the RPC or vtctl command may change
+// something.
+func IsMutating(name string) bool {
+	for _, prefix := range readOnlyPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return false
+		}
+	}
+	return true
+}
+
+// UnaryServerInterceptor returns an interceptor recording the calls to the
+// mutating RPCs of the given gRPC services, e.g. vtctlservice.Vtctld.
+func UnaryServerInterceptor(services ...string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !Enabled() || !isAudited(info.FullMethod, services) {
+			return handler(ctx, req)
+		}
+
+		resp, err := handler(ctx, req)
+		recordRPC(ctx, info.FullMethod, req, err)
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor returns an interceptor recording the calls to
+// the mutating streaming RPCs of the given gRPC services. The vtctl commands
+// run by ExecuteVtctlCommand are recorded under their own names.
+func StreamServerInterceptor(services ...string) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if !Enabled() || !isAudited(info.FullMethod, services) {
+			return handler(srv, stream)
+		}
+
+		audited := &auditedServerStream{ServerStream: stream}
+		err := handler(srv, audited)
+		recordRPC(stream.Context(), info.FullMethod, audited.request, err)
+		return err
+	}
+}
+
+// auditedServerStream keeps the request of a streaming RPC.
+type auditedServerStream struct {
+	grpc.ServerStream
+	request interface{}
+}
+
+func (s *auditedServerStream) RecvMsg(m interface{}) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err == nil && s.request == nil {
+		s.request = m
+	}
+	return err
+}
+
+func isAudited(fullMethod string, services []string) bool {
+	for _, service := range services {
+		if strings.HasPrefix(fullMethod, "/"+service+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+func recordRPC(ctx context.Context, fullMethod string, req interface{}, err error) {
+	action := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+	if r, ok := req.(interface{ GetArgs() []string }); ok && len(r.GetArgs()) > 0 {
+		action = r.GetArgs()[0]
+	}
+	if !IsMutating(action) {
+		return
+	}
+
+	event := &auditlogdatapb.Event{
+		Actor:  Actor(ctx),
+		Action: action,
+		Error:  ErrorString(err),
+	}
+	if event.Actor == "" {
+		if p, ok := peer.FromContext(ctx); ok {
+			event.Actor = p.Addr.String()
+		}
+	}
+	if r, ok := req.(proto.Message); ok {
+		if data, err := json2.MarshalPB(r); err == nil {
+			event.Statement = string(data)
+		}
+	}
+	if r, ok := req.(interface{ GetKeyspace() string }); ok {
+		event.Keyspace = r.GetKeyspace()
+	}
+	if r, ok := req.(interface{ GetShard() string }); ok {
+		event.Shard = r.GetShard()
+	}
+	Record(ctx, event)
+}
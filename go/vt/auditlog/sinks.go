@@ -0,0 +1,126 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auditlog
+
+import (
+	"context"
+	"flag"
+	"log/syslog"
+	"os"
+	"path/filepath"
+	"time"
+
+	"vitess.io/vitess/go/json2"
+	"vitess.io/vitess/go/vt/grpcclient"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	auditlogdatapb "vitess.io/vitess/go/vt/proto/auditlogdata"
+	auditlogservicepb "vitess.io/vitess/go/vt/proto/auditlogservice"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var (
+	file = flag.String("audit_log_file", "", "With -audit_log_sink file, the file to append the audit log events to, one JSON event per line.")
+
+	grpcServer  = flag.String("audit_log_grpc_server", "", "With -audit_log_sink grpc, the address of the audit log collector.")
+	grpcTimeout = flag.Duration("audit_log_grpc_timeout", 5*time.Second, "With -audit_log_sink grpc, how long to wait for the audit log collector to record an event.")
+	grpcCert    = flag.String("audit_log_grpc_cert", "", "the cert to use to connect to the audit log collector")
+	grpcKey     = flag.String("audit_log_grpc_key", "", "the key to use to connect to the audit log collector")
+	grpcCA      = flag.String("audit_log_grpc_ca", "", "the server ca to use to validate the audit log collector when connecting")
+	grpcName    = flag.String("audit_log_grpc_server_name", "", "the server name to use to validate the audit log collector certificate")
+)
+
+func init() {
+	RegisterSink("file", newFileSink)
+	RegisterSink("syslog", newSyslogSink)
+	RegisterSink("grpc", newGRPCSink)
+}
+
+// fileSink appends the events to a file, one JSON event per line.
+type fileSink struct {
+	f *os.File
+}
+
+func newFileSink() (Sink, error) {
+	if *file == "" {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "-audit_log_file is required")
+	}
+	f, err := os.OpenFile(filepath.Clean(*file), os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, err
+	}
+	return &fileSink{f: f}, nil
+}
+
+func (s *fileSink) Record(ctx context.Context, event *auditlogdatapb.Event) error {
+	data, err := json2.MarshalPB(event)
+	if err != nil {
+		return err
+	}
+	_, err = s.f.Write(append(data, '\n'))
+	return err
+}
+
+// syslogSink sends the events to the syslog daemon, as JSON.
+type syslogSink struct {
+	w *syslog.Writer
+}
+
+func newSyslogSink() (Sink, error) {
+	w, err := syslog.New(syslog.LOG_INFO|syslog.LOG_AUTH, filepath.Base(os.Args[0]))
+	if err != nil {
+		return nil, err
+	}
+	return &syslogSink{w: w}, nil
+}
+
+func (s *syslogSink) Record(ctx context.Context, event *auditlogdatapb.Event) error {
+	data, err := json2.MarshalPB(event)
+	if err != nil {
+		return err
+	}
+	return s.w.Info(string(data))
+}
+
+// grpcSink sends the events to an audit log collector implementing the
+// AuditLog service.
+type grpcSink struct {
+	client auditlogservicepb.AuditLogClient
+}
+
+func newGRPCSink() (Sink, error) {
+	if *grpcServer == "" {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "-audit_log_grpc_server is required")
+	}
+	opt, err := grpcclient.SecureDialOption(*grpcCert, *grpcKey, *grpcCA, *grpcName)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := grpcclient.Dial(*grpcServer, grpcclient.FailFast(false), opt)
+	if err != nil {
+		return nil, err
+	}
+	return &grpcSink{client: auditlogservicepb.NewAuditLogClient(conn)}, nil
+}
+
+func (s *grpcSink) Record(_ context.Context, event *auditlogdatapb.Event) error {
+	// The event is recorded even if the operation was canceled.
+	ctx, cancel := context.WithTimeout(context.Background(), *grpcTimeout)
+	defer cancel()
+	_, err := s.client.Record(ctx, &auditlogdatapb.RecordRequest{Events: []*auditlogdatapb.Event{event}})
+	return vterrors.FromGRPC(err)
+}
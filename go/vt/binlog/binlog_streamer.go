@@ -31,6 +31,7 @@ import (
 	"vitess.io/vitess/go/stats"
 	"vitess.io/vitess/go/vt/dbconfigs"
 	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/mysqlctl"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
 
@@ -146,7 +147,7 @@ type Streamer struct {
 	sendTransaction  sendTransactionFunc
 	usePreviousGTIDs bool
 
-	conn *BinlogConnection
+	conn *mysqlctl.BinlogConnection
 }
 
 // NewStreamer creates a binlog Streamer.
@@ -177,13 +178,13 @@ func (bls *Streamer) Stream(ctx context.Context) (err error) {
 	}
 	stopPos := bls.startPos
 	defer func() {
-		if err != nil && err != ErrBinlogUnavailable {
+		if err != nil && err != mysqlctl.ErrBinlogUnavailable {
 			err = fmt.Errorf("stream error @ %v: %v", stopPos, err)
 		}
 		log.Infof("stream ended @ %v, err = %v", stopPos, err)
 	}()
 
-	if bls.conn, err = NewBinlogConnection(bls.cp); err != nil {
+	if bls.conn, err = mysqlctl.NewBinlogConnection(bls.cp); err != nil {
 		return err
 	}
 	defer bls.conn.Close()
@@ -69,6 +69,9 @@ var (
 	BlpStopped = "Stopped"
 	// BlpError is for the Error state.
 	BlpError = "Error"
+	// BlpPaused is for the Paused state: the stream was stopped by a Pause
+	// of its workflow, and only a Resume restarts it.
+	BlpPaused = "Paused"
 )
 
 // Stats is the internal stats of a player. It is a different
@@ -98,9 +101,22 @@ type Stats struct {
 	CopyLoopCount  *stats.Counter
 	ErrorCounts    *stats.CountersWithMultiLabels
 	NoopQueryCount *stats.CountersWithSingleLabel
+	// ConvertedValueCount counts the values whose charset is converted,
+	// keyed by "<table>.<column>".
+	ConvertedValueCount *stats.CountersWithSingleLabel
 
 	VReplicationLags     *stats.Timings
 	VReplicationLagRates *stats.Rates
+
+	// EventCounts counts the events received by the stream, keyed by
+	// "Events", and the bytes of their rows, keyed by "Bytes".
+	EventCounts *stats.CountersWithSingleLabel
+	EventRates  *stats.Rates
+	// FatalErrorCount counts the errors that stopped the stream, which is
+	// not retried.
+	FatalErrorCount *stats.Counter
+	// TablesToCopy is the number of tables left to copy in the copy phase.
+	TablesToCopy sync2.AtomicInt64
 }
 
 // RecordHeartbeat updates the time the last heartbeat from vstreamer was seen
@@ -157,8 +173,12 @@ func NewStats() *Stats {
 	bps.CopyLoopCount = stats.NewCounter("", "")
 	bps.ErrorCounts = stats.NewCountersWithMultiLabels("", "", []string{"type"})
 	bps.NoopQueryCount = stats.NewCountersWithSingleLabel("", "", "Statement", "")
+	bps.ConvertedValueCount = stats.NewCountersWithSingleLabel("", "", "Column", "")
 	bps.VReplicationLags = stats.NewTimings("", "", "")
 	bps.VReplicationLagRates = stats.NewRates("", bps.VReplicationLags, 15*60/5, 5*time.Second)
+	bps.EventCounts = stats.NewCountersWithSingleLabel("", "", "Unit", "")
+	bps.EventRates = stats.NewRates("", bps.EventCounts, 15*60/5, 5*time.Second)
+	bps.FatalErrorCount = stats.NewCounter("", "")
 	return bps
 }
 
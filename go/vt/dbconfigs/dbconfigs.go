@@ -29,6 +29,7 @@ import (
 
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/vt/log"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/yaml2"
@@ -416,6 +417,25 @@ func (dbcfgs *DBConfigs) SetDbParams(dbaParams, appParams mysql.ConnParams) {
 	dbcfgs.appParams = appParams
 }
 
+// NewExternalMysqlDBConfigs returns the DBConfigs of an external mysql
+// registered in the topo, which connect all users with its credentials.
+func NewExternalMysqlDBConfigs(em *topodatapb.ExternalMysql) *DBConfigs {
+	uc := UserConfig{User: em.User, Password: em.Password, UseTCP: true}
+	dbcfgs := &DBConfigs{
+		Host:     em.Host,
+		Port:     int(em.Port),
+		DBName:   em.DbName,
+		App:      uc,
+		Appdebug: uc,
+		Allprivs: uc,
+		Dba:      uc,
+		Filtered: uc,
+		Repl:     uc,
+	}
+	dbcfgs.InitWithSocket("")
+	return dbcfgs
+}
+
 // NewTestDBConfigs returns a DBConfigs meant for testing.
 func NewTestDBConfigs(genParams, appDebugParams mysql.ConnParams, dbname string) *DBConfigs {
 	return &DBConfigs{
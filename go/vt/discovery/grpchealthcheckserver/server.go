@@ -0,0 +1,159 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package grpchealthcheckserver contains the gRPC implementation of the server
+// side of the health check service.
+package grpchealthcheckserver
+
+import (
+	"google.golang.org/grpc"
+
+	"vitess.io/vitess/go/vt/discovery"
+	"vitess.io/vitess/go/vt/servenv"
+
+	healthcheckdatapb "vitess.io/vitess/go/vt/proto/healthcheckdata"
+	healthcheckservicepb "vitess.io/vitess/go/vt/proto/healthcheckservice"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// Server is the gRPC server implementation of the HealthCheck service.
+type Server struct {
+	healthcheckservicepb.UnimplementedHealthCheckServer
+	hc discovery.HealthCheck
+}
+
+// NewServer creates a new RPC server for a given health check.
+func NewServer(hc discovery.HealthCheck) *Server {
+	return &Server{hc: hc}
+}
+
+// StreamHealthCheck implements the gRPC server interface. It sends the
+// current health of all the tablets matching the request, and then every
+// update of their health until the client goes away.
+//
+// Updates are dropped by the health check while the client is not reading
+// them fast enough. A client can start a new stream to get the current health
+// of all the tablets again.
+func (s *Server) StreamHealthCheck(request *healthcheckdatapb.StreamHealthCheckRequest, stream healthcheckservicepb.HealthCheck_StreamHealthCheckServer) (err error) {
+	defer servenv.HandlePanic("healthcheck", &err)
+
+	// Subscribe first, so no update is missed while sending the snapshot.
+	c := s.hc.Subscribe()
+	defer s.hc.Unsubscribe(c)
+
+	filter := newFilter(request)
+	for _, tcs := range s.hc.CacheStatus() {
+		for _, th := range tcs.TabletsStats {
+			if !filter.matches(th) {
+				continue
+			}
+			if err := stream.Send(&healthcheckdatapb.StreamHealthCheckResponse{TabletHealth: TabletHealthToProto(th)}); err != nil {
+				return err
+			}
+		}
+	}
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case th, ok := <-c:
+			if !ok {
+				return nil
+			}
+			if !filter.matches(th) {
+				continue
+			}
+			if err := stream.Send(&healthcheckdatapb.StreamHealthCheckResponse{TabletHealth: TabletHealthToProto(th)}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// filter selects the tablets a stream is restricted to.
+type filter struct {
+	keyspaces   map[string]bool
+	tabletTypes map[topodatapb.TabletType]bool
+}
+
+func newFilter(request *healthcheckdatapb.StreamHealthCheckRequest) *filter {
+	f := &filter{}
+	if len(request.Keyspaces) > 0 {
+		f.keyspaces = make(map[string]bool, len(request.Keyspaces))
+		for _, keyspace := range request.Keyspaces {
+			f.keyspaces[keyspace] = true
+		}
+	}
+	if len(request.TabletTypes) > 0 {
+		f.tabletTypes = make(map[topodatapb.TabletType]bool, len(request.TabletTypes))
+		for _, tabletType := range request.TabletTypes {
+			f.tabletTypes[tabletType] = true
+		}
+	}
+	return f
+}
+
+func (f *filter) matches(th *discovery.TabletHealth) bool {
+	if th.Target == nil {
+		return false
+	}
+	if f.keyspaces != nil && !f.keyspaces[th.Target.Keyspace] {
+		return false
+	}
+	if f.tabletTypes != nil && !f.tabletTypes[th.Target.TabletType] {
+		return false
+	}
+	return true
+}
+
+// TabletHealthToProto converts the health of a tablet to its protobuf
+// representation.
+func TabletHealthToProto(th *discovery.TabletHealth) *healthcheckdatapb.TabletHealth {
+	res := &healthcheckdatapb.TabletHealth{
+		Tablet:               th.Tablet,
+		Target:               th.Target,
+		State:                tabletState(th),
+		Serving:              th.Serving,
+		Stats:                th.Stats,
+		PrimaryTermStartTime: th.PrimaryTermStartTime,
+	}
+	if th.LastError != nil {
+		res.LastError = th.LastError.Error()
+	}
+	return res
+}
+
+func tabletState(th *discovery.TabletHealth) healthcheckdatapb.TabletState {
+	switch {
+	case th.LastError != nil || (th.Stats != nil && th.Stats.HealthError != ""):
+		return healthcheckdatapb.TabletState_DOWN
+	case th.Stats == nil:
+		return healthcheckdatapb.TabletState_UNKNOWN
+	case !th.Serving:
+		return healthcheckdatapb.TabletState_DOWN
+	case th.Target.TabletType != topodatapb.TabletType_PRIMARY && discovery.IsReplicationLagHigh(th):
+		return healthcheckdatapb.TabletState_LAGGING
+	default:
+		return healthcheckdatapb.TabletState_UP
+	}
+}
+
+// RegisterServer registers a new health check server instance with the gRPC
+// server.
+func RegisterServer(s *grpc.Server, hc discovery.HealthCheck) {
+	healthcheckservicepb.RegisterHealthCheckServer(s, NewServer(hc))
+}
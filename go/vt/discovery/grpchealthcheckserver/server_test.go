@@ -0,0 +1,137 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpchealthcheckserver
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"vitess.io/vitess/go/vt/discovery"
+	"vitess.io/vitess/go/vt/topo"
+
+	healthcheckdatapb "vitess.io/vitess/go/vt/proto/healthcheckdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// fakeHealthCheck serves a fixed snapshot, and the updates sent to its
+// subscriber.
+type fakeHealthCheck struct {
+	discovery.HealthCheck
+
+	snapshot     []*discovery.TabletHealth
+	updates      chan *discovery.TabletHealth
+	unsubscribed bool
+}
+
+func (hc *fakeHealthCheck) CacheStatus() discovery.TabletsCacheStatusList {
+	return discovery.TabletsCacheStatusList{{TabletsStats: hc.snapshot}}
+}
+
+func (hc *fakeHealthCheck) Subscribe() chan *discovery.TabletHealth {
+	return hc.updates
+}
+
+func (hc *fakeHealthCheck) Unsubscribe(c chan *discovery.TabletHealth) {
+	hc.unsubscribed = true
+}
+
+type fakeStream struct {
+	grpc.ServerStream
+
+	ctx       context.Context
+	responses chan *healthcheckdatapb.StreamHealthCheckResponse
+}
+
+func (s *fakeStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeStream) Send(response *healthcheckdatapb.StreamHealthCheckResponse) error {
+	s.responses <- response
+	return nil
+}
+
+func newTabletHealth(uid uint32, keyspace string, tabletType topodatapb.TabletType, serving bool, lag uint32) *discovery.TabletHealth {
+	return &discovery.TabletHealth{
+		Tablet:  topo.NewTablet(uid, "cell", "host"),
+		Target:  &querypb.Target{Keyspace: keyspace, Shard: "0", TabletType: tabletType},
+		Serving: serving,
+		Stats:   &querypb.RealtimeStats{ReplicationLagSeconds: lag},
+	}
+}
+
+func TestStreamHealthCheck(t *testing.T) {
+	hc := &fakeHealthCheck{
+		snapshot: []*discovery.TabletHealth{
+			newTabletHealth(1, "ks", topodatapb.TabletType_PRIMARY, true, 0),
+			newTabletHealth(2, "ks", topodatapb.TabletType_REPLICA, true, 3600),
+			newTabletHealth(3, "other", topodatapb.TabletType_REPLICA, true, 0),
+		},
+		updates: make(chan *discovery.TabletHealth, 10),
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &fakeStream{ctx: ctx, responses: make(chan *healthcheckdatapb.StreamHealthCheckResponse, 10)}
+
+	done := make(chan error)
+	go func() {
+		done <- NewServer(hc).StreamHealthCheck(&healthcheckdatapb.StreamHealthCheckRequest{Keyspaces: []string{"ks"}}, stream)
+	}()
+
+	// The snapshot comes first.
+	response := <-stream.responses
+	assert.Equal(t, uint32(1), response.TabletHealth.Tablet.Alias.Uid)
+	assert.Equal(t, healthcheckdatapb.TabletState_UP, response.TabletHealth.State)
+	response = <-stream.responses
+	assert.Equal(t, uint32(2), response.TabletHealth.Tablet.Alias.Uid)
+	assert.Equal(t, healthcheckdatapb.TabletState_LAGGING, response.TabletHealth.State)
+
+	// Then the updates.
+	hc.updates <- newTabletHealth(4, "other", topodatapb.TabletType_REPLICA, false, 0)
+	down := newTabletHealth(2, "ks", topodatapb.TabletType_REPLICA, false, 0)
+	down.LastError = errors.New("connection refused")
+	hc.updates <- down
+	response = <-stream.responses
+	assert.Equal(t, uint32(2), response.TabletHealth.Tablet.Alias.Uid)
+	assert.Equal(t, healthcheckdatapb.TabletState_DOWN, response.TabletHealth.State)
+	assert.Equal(t, "connection refused", response.TabletHealth.LastError)
+
+	cancel()
+	require.NoError(t, <-done)
+	assert.True(t, hc.unsubscribed)
+	assert.Empty(t, stream.responses)
+}
+
+func TestTabletState(t *testing.T) {
+	th := newTabletHealth(1, "ks", topodatapb.TabletType_REPLICA, false, 0)
+	th.Stats = nil
+	assert.Equal(t, healthcheckdatapb.TabletState_UNKNOWN, tabletState(th))
+
+	th = newTabletHealth(1, "ks", topodatapb.TabletType_REPLICA, true, 0)
+	th.Stats.HealthError = "mysqld is down"
+	assert.Equal(t, healthcheckdatapb.TabletState_DOWN, tabletState(th))
+
+	assert.Equal(t, healthcheckdatapb.TabletState_DOWN, tabletState(newTabletHealth(1, "ks", topodatapb.TabletType_REPLICA, false, 0)))
+	assert.Equal(t, healthcheckdatapb.TabletState_UP, tabletState(newTabletHealth(1, "ks", topodatapb.TabletType_REPLICA, true, 1)))
+	// The primary doesn't lag.
+	assert.Equal(t, healthcheckdatapb.TabletState_UP, tabletState(newTabletHealth(1, "ks", topodatapb.TabletType_PRIMARY, true, 3600)))
+}
@@ -65,7 +65,9 @@ var (
 	hcMasterPromotedCounters = stats.NewCountersWithMultiLabels("HealthcheckMasterPromoted", "Primary promoted in keyspace/shard name because of health check errors", []string{"Keyspace", "ShardName"})
 
 	hcPrimaryPromotedCounters = stats.NewCountersWithMultiLabels("HealthcheckPrimaryPromoted", "Primary promoted in keyspace/shard name because of health check errors", []string{"Keyspace", "ShardName"})
-	healthcheckOnce           sync.Once
+
+	hcReplicationLagExclusions = stats.NewCountersWithMultiLabels("HealthcheckReplicationLagExclusions", "Number of times a serving tablet was removed from the healthy tablets because of its replication lag", []string{"Keyspace", "ShardName", "TabletType"})
+	healthcheckOnce            sync.Once
 
 	// TabletURLTemplateString is a flag to generate URLs for the tablets that vtgate discovers.
 	TabletURLTemplateString = flag.String("tablet_url_template", "http://{{.GetTabletHostPort}}", "format string describing debug tablet url formatting. See the Go code for getTabletDebugURL() how to customize this.")
@@ -80,12 +82,22 @@ var (
 	// KeyspacesToWatch - if provided this specifies which keyspaces should be
 	// visible to the healthcheck. By default the healthcheck will watch all keyspaces.
 	KeyspacesToWatch flagutil.StringListValue
+	// CellAffinityGroups maps cells to their affinity group. The healthcheck
+	// routes the replica queries of a cell to the tablets of the cells of its
+	// group, like it does for the cells of its cell alias.
+	CellAffinityGroups flagutil.StringMapValue
+	// AllowedRemoteCells are the cells, outside of the cell alias and
+	// affinity group of the local cell, whose replicas may also serve queries.
+	AllowedRemoteCells flagutil.StringListValue
 	// RefreshInterval is the interval at which healthcheck refreshes its list of tablets from topo
 	RefreshInterval = flag.Duration("tablet_refresh_interval", 1*time.Minute, "tablet refresh interval")
 	// RefreshKnownTablets tells us whether to process all tablets or only new tablets
 	RefreshKnownTablets = flag.Bool("tablet_refresh_known_tablets", true, "tablet refresh reloads the tablet address/port map from topo in case it changes")
 	// TopoReadConcurrency tells us how many topo reads are allowed in parallel
 	TopoReadConcurrency = flag.Int("topo_read_concurrency", 32, "concurrent topo reads")
+
+	// avoidErrantGTIDs excludes the replicas with errant GTIDs from the healthy tablets.
+	avoidErrantGTIDs = flag.Bool("healthcheck_avoid_errant_gtids", false, "Do not route queries to the replicas that have errant GTIDs, i.e. transactions that the shard primary does not have, unless all the serving replicas of the tablet type have them.")
 )
 
 // See the documentation for NewHealthCheck below for an explanation of these parameters.
@@ -148,6 +160,30 @@ func init() {
 	flag.Var(&TabletFilters, "tablet_filters", "Specifies a comma-separated list of 'keyspace|shard_name or keyrange' values to filter the tablets to watch")
 	topoproto.TabletTypeListVar(&AllowedTabletTypes, "allowed_tablet_types", "Specifies the tablet types this vtgate is allowed to route queries to")
 	flag.Var(&KeyspacesToWatch, "keyspaces_to_watch", "Specifies which keyspaces this vtgate should have access to while routing queries or accessing the vschema")
+	flag.Var(&CellAffinityGroups, "cell_affinity_groups", "Specifies a comma-separated list of cell:group pairs. Replica queries are routed to the tablets of the cells in the group of the local cell, like to those of its cell alias. The cells must be watched with -cells_to_watch")
+	flag.Var(&AllowedRemoteCells, "allowed_remote_cells", "Specifies a comma-separated list of remote cells whose replicas may serve queries, after those of the local cell, cell alias and affinity group. The cells must be watched with -cells_to_watch; the cell_affinity tablet balancer policy penalizes them")
+}
+
+// SameCellAffinityGroup returns true if both cells are in the same group of
+// -cell_affinity_groups.
+func SameCellAffinityGroup(cell1, cell2 string) bool {
+	group1, ok1 := CellAffinityGroups[cell1]
+	group2, ok2 := CellAffinityGroups[cell2]
+	return ok1 && ok2 && group1 == group2
+}
+
+// IsRemoteCell returns true if the replicas of a cell may only serve the
+// queries of the local cell as a remote cell of -allowed_remote_cells.
+func IsRemoteCell(localCell, cell string) bool {
+	if cell == localCell || SameCellAffinityGroup(localCell, cell) {
+		return false
+	}
+	for _, remote := range AllowedRemoteCells {
+		if remote == cell {
+			return true
+		}
+	}
+	return false
 }
 
 // FilteringKeyspaces returns true if any keyspaces have been configured to be filtered.
@@ -498,7 +534,55 @@ func (hc *HealthCheckImpl) recomputeHealthy(key keyspaceShardTabletType) {
 			allArray = append(allArray, s)
 		}
 	}
-	hc.healthy[key] = FilterStatsByReplicationLag(allArray)
+	healthy := FilterStatsByReplicationLag(filterStatsByErrantGTIDs(allArray))
+	countReplicationLagExclusions(all, hc.healthy[key], healthy)
+	hc.healthy[key] = healthy
+}
+
+// filterStatsByErrantGTIDs removes the tablets with errant GTIDs from the
+// list if -healthcheck_avoid_errant_gtids is set, unless all the serving
+// tablets have some.
+func filterStatsByErrantGTIDs(tabletHealthList []*TabletHealth) []*TabletHealth {
+	if !*avoidErrantGTIDs {
+		return tabletHealthList
+	}
+	res := make([]*TabletHealth, 0, len(tabletHealthList))
+	for _, th := range tabletHealthList {
+		if th.Stats != nil && th.Stats.ErrantGtids != "" {
+			continue
+		}
+		res = append(res, th)
+	}
+	for _, th := range res {
+		if th.Serving && th.LastError == nil && th.Stats != nil {
+			return res
+		}
+	}
+	return tabletHealthList
+}
+
+// countReplicationLagExclusions counts the tablets that were healthy and are
+// not anymore, although they are still serving: the only reason for it is
+// their replication lag.
+func countReplicationLagExclusions(all map[tabletAliasString]*TabletHealth, prevHealthy, healthy []*TabletHealth) {
+	stillHealthy := make(map[tabletAliasString]bool, len(healthy))
+	for _, th := range healthy {
+		stillHealthy[tabletAliasString(topoproto.TabletAliasString(th.Tablet.Alias))] = true
+	}
+	for _, prev := range prevHealthy {
+		alias := tabletAliasString(topoproto.TabletAliasString(prev.Tablet.Alias))
+		if stillHealthy[alias] {
+			continue
+		}
+		th, ok := all[alias]
+		if !ok || !th.Serving || th.LastError != nil || th.Stats == nil {
+			continue
+		}
+		if *avoidErrantGTIDs && th.Stats.ErrantGtids != "" {
+			continue
+		}
+		hcReplicationLagExclusions.Add([]string{th.Target.Keyspace, th.Target.Shard, topoproto.TabletTypeLString(th.Target.TabletType)}, 1)
+	}
 }
 
 // Subscribe adds a listener. Used by vtgate buffer to learn about primary changes.
@@ -752,6 +836,9 @@ func (hc *HealthCheckImpl) isIncluded(tabletType topodata.TabletType, tabletAlia
 	if hc.getAliasByCell(tabletAlias.Cell) == hc.getAliasByCell(hc.cell) {
 		return true
 	}
+	if SameCellAffinityGroup(tabletAlias.Cell, hc.cell) || IsRemoteCell(hc.cell, tabletAlias.Cell) {
+		return true
+	}
 	return false
 }
 
@@ -797,6 +884,12 @@ func (hc *HealthCheckImpl) RegisterStats() {
 		[]string{"Keyspace", "ShardName", "TabletType"},
 		hc.servingConnStats)
 
+	stats.NewGaugesFuncWithMultiLabels(
+		"HealthcheckReplicationLagExcluded",
+		"the number of serving tablets currently excluded from the healthy tablets because of their replication lag",
+		[]string{"Keyspace", "ShardName", "TabletType"},
+		hc.replicationLagExcludedStats)
+
 	stats.NewGaugeFunc(
 		"HealthcheckChecksum",
 		"crc32 checksum of the current healthcheck state",
@@ -841,6 +934,33 @@ func (hc *HealthCheckImpl) servingConnStats() map[string]int64 {
 	return res
 }
 
+// replicationLagExcludedStats returns the number of serving tablets excluded
+// from the healthy tablets because of their replication lag, per
+// keyspace/shard/tablet type.
+func (hc *HealthCheckImpl) replicationLagExcludedStats() map[string]int64 {
+	res := make(map[string]int64)
+	hc.mu.Lock()
+	defer hc.mu.Unlock()
+	for key, ths := range hc.healthData {
+		healthy := make(map[tabletAliasString]bool, len(hc.healthy[key]))
+		for _, th := range hc.healthy[key] {
+			healthy[tabletAliasString(topoproto.TabletAliasString(th.Tablet.Alias))] = true
+		}
+		for alias, th := range ths {
+			if th.Target.TabletType == topodata.TabletType_PRIMARY || !hc.isIncluded(th.Tablet.Type, th.Tablet.Alias) {
+				continue
+			}
+			if *avoidErrantGTIDs && th.Stats != nil && th.Stats.ErrantGtids != "" {
+				continue
+			}
+			if th.Serving && th.LastError == nil && th.Stats != nil && !healthy[alias] {
+				res[string(key)]++
+			}
+		}
+	}
+	return res
+}
+
 // stateChecksum returns a crc32 checksum of the healthcheck state
 func (hc *HealthCheckImpl) stateChecksum() int64 {
 	// CacheStatus is sorted so this should be stable across vtgates
@@ -894,6 +894,114 @@ func TestGetHealthyTablets(t *testing.T) {
 	mustMatch(t, want, a, "unexpected result")
 }
 
+func TestHealthCheckReplicationLagExclusions(t *testing.T) {
+	testSetLegacyReplicationLagAlgorithm(false)
+	defer testSetLegacyReplicationLagAlgorithm(true)
+	testSetMinNumTablets(1)
+	defer testSetMinNumTablets(2)
+
+	ts := memorytopo.NewServer("cell")
+	hc := createTestHc(ts)
+	defer hc.Close()
+
+	target := &querypb.Target{Keyspace: "lagks", Shard: "s", TabletType: topodatapb.TabletType_REPLICA}
+	statsKey := "lagks.s.replica"
+	resultChan := hc.Subscribe()
+	var inputs []chan *querypb.StreamHealthResponse
+	var tablets []*topodatapb.Tablet
+	for i := 0; i < 2; i++ {
+		tablet := createTestTablet(uint32(i+1), "cell", fmt.Sprintf("host%v", i))
+		tablet.Type = topodatapb.TabletType_REPLICA
+		input := make(chan *querypb.StreamHealthResponse)
+		createFakeConn(tablet, input)
+		hc.AddTablet(tablet)
+		<-resultChan
+		inputs = append(inputs, input)
+		tablets = append(tablets, tablet)
+	}
+	sendLag := func(i int, lag uint32) {
+		inputs[i] <- &querypb.StreamHealthResponse{
+			TabletAlias:   tablets[i].Alias,
+			Target:        target,
+			Serving:       true,
+			RealtimeStats: &querypb.RealtimeStats{ReplicationLagSeconds: lag},
+		}
+		<-resultChan
+	}
+
+	sendLag(0, 1)
+	sendLag(1, 1)
+	assert.Len(t, hc.GetHealthyTabletStats(target), 2)
+	exclusions := hcReplicationLagExclusions.Counts()[statsKey]
+
+	// A lagging tablet is excluded.
+	sendLag(1, 60)
+	assert.Len(t, hc.GetHealthyTabletStats(target), 1)
+	assert.Equal(t, exclusions+1, hcReplicationLagExclusions.Counts()[statsKey])
+	assert.Equal(t, int64(1), hc.replicationLagExcludedStats()[statsKey])
+
+	// Staying excluded isn't counted again.
+	sendLag(1, 70)
+	assert.Equal(t, exclusions+1, hcReplicationLagExclusions.Counts()[statsKey])
+
+	// Once caught up, it's back.
+	sendLag(1, 1)
+	assert.Len(t, hc.GetHealthyTabletStats(target), 2)
+	assert.Zero(t, hc.replicationLagExcludedStats()[statsKey])
+}
+
+func TestHealthCheckAvoidErrantGTIDs(t *testing.T) {
+	defer func(saved bool) { *avoidErrantGTIDs = saved }(*avoidErrantGTIDs)
+	*avoidErrantGTIDs = true
+
+	ts := memorytopo.NewServer("cell")
+	hc := createTestHc(ts)
+	defer hc.Close()
+
+	target := &querypb.Target{Keyspace: "errantks", Shard: "s", TabletType: topodatapb.TabletType_REPLICA}
+	resultChan := hc.Subscribe()
+	var inputs []chan *querypb.StreamHealthResponse
+	var tablets []*topodatapb.Tablet
+	for i := 0; i < 2; i++ {
+		tablet := createTestTablet(uint32(i+1), "cell", fmt.Sprintf("host%v", i))
+		tablet.Type = topodatapb.TabletType_REPLICA
+		input := make(chan *querypb.StreamHealthResponse)
+		createFakeConn(tablet, input)
+		hc.AddTablet(tablet)
+		<-resultChan
+		inputs = append(inputs, input)
+		tablets = append(tablets, tablet)
+	}
+	sendErrantGTIDs := func(i int, gtids string) {
+		inputs[i] <- &querypb.StreamHealthResponse{
+			TabletAlias:   tablets[i].Alias,
+			Target:        target,
+			Serving:       true,
+			RealtimeStats: &querypb.RealtimeStats{ReplicationLagSeconds: 1, ErrantGtids: gtids},
+		}
+		<-resultChan
+	}
+
+	sendErrantGTIDs(0, "")
+	sendErrantGTIDs(1, "")
+	assert.Len(t, hc.GetHealthyTabletStats(target), 2)
+
+	// A tablet with errant GTIDs is excluded.
+	sendErrantGTIDs(1, "00000000-0000-0000-0000-000000000002:1")
+	healthy := hc.GetHealthyTabletStats(target)
+	require.Len(t, healthy, 1)
+	assert.Equal(t, topoproto.TabletAliasString(tablets[0].Alias), topoproto.TabletAliasString(healthy[0].Tablet.Alias))
+
+	// Unless all the tablets have some.
+	sendErrantGTIDs(0, "00000000-0000-0000-0000-000000000003:1")
+	assert.Len(t, hc.GetHealthyTabletStats(target), 2)
+
+	// Once fixed, it's back.
+	sendErrantGTIDs(0, "")
+	sendErrantGTIDs(1, "")
+	assert.Len(t, hc.GetHealthyTabletStats(target), 2)
+}
+
 func TestPrimaryInOtherCell(t *testing.T) {
 	ts := memorytopo.NewServer("cell1", "cell2")
 	hc := NewHealthCheck(context.Background(), 1*time.Millisecond, time.Hour, ts, "cell1", "cell1, cell2")
@@ -1113,6 +1221,29 @@ func TestCellAliases(t *testing.T) {
 	mustMatch(t, want, a, "Wrong TabletHealth data")
 }
 
+func TestCellAffinityGroupsAndRemoteCells(t *testing.T) {
+	defer func() {
+		CellAffinityGroups = nil
+		AllowedRemoteCells = nil
+	}()
+	require.NoError(t, CellAffinityGroups.Set("cell1:east,cell2:east,cell3:west"))
+	require.NoError(t, AllowedRemoteCells.Set("cell2,cell3"))
+
+	ts := memorytopo.NewServer("cell1", "cell2", "cell3", "cell4")
+	hc := NewHealthCheck(context.Background(), 1*time.Millisecond, time.Hour, ts, "cell1", "cell1,cell2,cell3,cell4")
+	defer hc.Close()
+
+	// A cell of the affinity group is not remote, even if it is listed.
+	assert.True(t, SameCellAffinityGroup("cell1", "cell2"))
+	assert.False(t, IsRemoteCell("cell1", "cell2"))
+	assert.True(t, IsRemoteCell("cell1", "cell3"))
+	assert.False(t, IsRemoteCell("cell1", "cell4"))
+
+	for cell, included := range map[string]bool{"cell1": true, "cell2": true, "cell3": true, "cell4": false} {
+		assert.Equal(t, included, hc.isIncluded(topodatapb.TabletType_REPLICA, &topodatapb.TabletAlias{Cell: cell, Uid: 1}), cell)
+	}
+}
+
 func TestHealthCheckChecksGrpcPort(t *testing.T) {
 	ts := memorytopo.NewServer("cell")
 	hc := createTestHc(ts)
@@ -20,7 +20,11 @@ import (
 	"flag"
 	"fmt"
 	"sort"
+	"strings"
+	"sync"
 	"time"
+
+	"vitess.io/vitess/go/flagutil"
 )
 
 var (
@@ -29,8 +33,71 @@ var (
 	highReplicationLagMinServing  = flag.Duration("discovery_high_replication_lag_minimum_serving", 2*time.Hour, "the replication lag that is considered too high when applying the min_number_serving_vttablets threshold")
 	minNumTablets                 = flag.Int("min_number_serving_vttablets", 2, "the minimum number of vttablets for each replicating tablet_type (e.g. replica, rdonly) that will be continue to be used even with replication lag above discovery_low_replication_lag, but still below discovery_high_replication_lag_minimum_serving")
 	legacyReplicationLagAlgorithm = flag.Bool("legacy_replication_lag_algorithm", true, "use the legacy algorithm when selecting the vttablets for serving")
+
+	// keyspaceLowReplicationLag and keyspaceHighReplicationLag override
+	// lowReplicationLag and highReplicationLagMinServing for some keyspaces.
+	keyspaceLowReplicationLag  = &keyspaceDurations{}
+	keyspaceHighReplicationLag = &keyspaceDurations{}
 )
 
+func init() {
+	flag.Var(keyspaceLowReplicationLag, "discovery_keyspace_low_replication_lag", "comma-separated list of keyspace:duration pairs, overriding discovery_low_replication_lag for the tablets of these keyspaces")
+	flag.Var(keyspaceHighReplicationLag, "discovery_keyspace_high_replication_lag_minimum_serving", "comma-separated list of keyspace:duration pairs, overriding discovery_high_replication_lag_minimum_serving for the tablets of these keyspaces")
+}
+
+// keyspaceDurations is a flag.Value holding a duration per keyspace. It can be
+// changed at runtime.
+type keyspaceDurations struct {
+	mu        sync.RWMutex
+	durations map[string]time.Duration
+}
+
+// Set is part of the flag.Value interface. It parses a comma-separated list
+// of keyspace:duration pairs.
+func (kd *keyspaceDurations) Set(v string) error {
+	var pairs flagutil.StringMapValue
+	if err := pairs.Set(v); err != nil {
+		return err
+	}
+	durations := make(map[string]time.Duration, len(pairs))
+	for keyspace, value := range pairs {
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return fmt.Errorf("invalid duration for keyspace %v: %v", keyspace, err)
+		}
+		durations[keyspace] = d
+	}
+
+	kd.mu.Lock()
+	defer kd.mu.Unlock()
+	kd.durations = durations
+	return nil
+}
+
+// String is part of the flag.Value interface.
+func (kd *keyspaceDurations) String() string {
+	kd.mu.RLock()
+	defer kd.mu.RUnlock()
+
+	parts := make([]string, 0, len(kd.durations))
+	for keyspace, d := range kd.durations {
+		parts = append(parts, fmt.Sprintf("%v:%v", keyspace, d))
+	}
+	sort.Strings(parts)
+	return strings.Join(parts, ",")
+}
+
+// get returns the duration of the keyspace, or def if it has none.
+func (kd *keyspaceDurations) get(keyspace string, def time.Duration) time.Duration {
+	kd.mu.RLock()
+	defer kd.mu.RUnlock()
+
+	if d, ok := kd.durations[keyspace]; ok {
+		return d
+	}
+	return def
+}
+
 // GetLowReplicationLag getter for use by debugenv
 func GetLowReplicationLag() time.Duration {
 	return *lowReplicationLag
@@ -61,16 +128,51 @@ func SetMinNumTablets(numTablets int) {
 	minNumTablets = &numTablets
 }
 
+// GetKeyspaceLowReplicationLag getter for use by debugenv
+func GetKeyspaceLowReplicationLag() string {
+	return keyspaceLowReplicationLag.String()
+}
+
+// SetKeyspaceLowReplicationLag setter for use by debugenv
+func SetKeyspaceLowReplicationLag(value string) error {
+	return keyspaceLowReplicationLag.Set(value)
+}
+
+// GetKeyspaceHighReplicationLagMinServing getter for use by debugenv
+func GetKeyspaceHighReplicationLagMinServing() string {
+	return keyspaceHighReplicationLag.String()
+}
+
+// SetKeyspaceHighReplicationLagMinServing setter for use by debugenv
+func SetKeyspaceHighReplicationLagMinServing(value string) error {
+	return keyspaceHighReplicationLag.Set(value)
+}
+
+// LowReplicationLag returns the replication lag that is considered low enough
+// for the tablets of the keyspace to be healthy.
+func LowReplicationLag(keyspace string) time.Duration {
+	return keyspaceLowReplicationLag.get(keyspace, *lowReplicationLag)
+}
+
+// HighReplicationLagMinServing returns the replication lag that is considered
+// too high for the tablets of the keyspace when applying the
+// min_number_serving_vttablets threshold.
+func HighReplicationLagMinServing(keyspace string) time.Duration {
+	return keyspaceHighReplicationLag.get(keyspace, *highReplicationLagMinServing)
+}
+
 // IsReplicationLagHigh verifies that the given LegacytabletHealth refers to a tablet with high
-// replication lag, i.e. higher than the configured discovery_low_replication_lag flag.
+// replication lag, i.e. higher than the configured discovery_low_replication_lag flag,
+// or discovery_keyspace_low_replication_lag for its keyspace.
 func IsReplicationLagHigh(tabletHealth *TabletHealth) bool {
-	return float64(tabletHealth.Stats.ReplicationLagSeconds) > lowReplicationLag.Seconds()
+	return float64(tabletHealth.Stats.ReplicationLagSeconds) > LowReplicationLag(tabletHealth.keyspace()).Seconds()
 }
 
 // IsReplicationLagVeryHigh verifies that the given LegacytabletHealth refers to a tablet with very high
-// replication lag, i.e. higher than the configured discovery_high_replication_lag_minimum_serving flag.
+// replication lag, i.e. higher than the configured discovery_high_replication_lag_minimum_serving flag,
+// or discovery_keyspace_high_replication_lag_minimum_serving for its keyspace.
 func IsReplicationLagVeryHigh(tabletHealth *TabletHealth) bool {
-	return float64(tabletHealth.Stats.ReplicationLagSeconds) > highReplicationLagMinServing.Seconds()
+	return float64(tabletHealth.Stats.ReplicationLagSeconds) > HighReplicationLagMinServing(tabletHealth.keyspace()).Seconds()
 }
 
 // FilterStatsByReplicationLag filters the list of TabletHealth by TabletHealth.Stats.ReplicationLagSeconds.
@@ -93,6 +195,9 @@ func IsReplicationLagVeryHigh(tabletHealth *TabletHealth) bool {
 // For example, lags of (5s, 10s, 15s, 120s) return the first three;
 // lags of (30m, 35m, 40m, 45m) return all.
 //
+// Both thresholds can be overridden per keyspace, with the discovery_keyspace_low_replication_lag
+// and discovery_keyspace_high_replication_lag_minimum_serving flags.
+//
 // One thing to know about this code: vttablet also has a couple flags that impact the logic here:
 // * unhealthy_threshold: if replication lag is higher than this, a tablet will be reported as unhealthy.
 //   The default for this is 2h, same as the discovery_high_replication_lag_minimum_serving here.
@@ -19,6 +19,10 @@ package discovery
 import (
 	"fmt"
 	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"vitess.io/vitess/go/test/utils"
 
@@ -124,6 +128,53 @@ func TestFilterByReplicationLag(t *testing.T) {
 	testSetLegacyReplicationLagAlgorithm(true)
 }
 
+func TestFilterByReplicationLagPerKeyspace(t *testing.T) {
+	// Use simplified logic
+	testSetLegacyReplicationLagAlgorithm(false)
+	defer func() {
+		require.NoError(t, SetKeyspaceLowReplicationLag(""))
+		require.NoError(t, SetKeyspaceHighReplicationLagMinServing(""))
+	}()
+
+	require.NoError(t, SetKeyspaceLowReplicationLag("fresh:5s"))
+	require.NoError(t, SetKeyspaceHighReplicationLagMinServing("fresh:1m"))
+	assert.Equal(t, "fresh:5s", GetKeyspaceLowReplicationLag())
+	assert.Equal(t, 5*time.Second, LowReplicationLag("fresh"))
+	assert.Equal(t, *lowReplicationLag, LowReplicationLag("other"))
+	assert.Equal(t, time.Minute, HighReplicationLagMinServing("fresh"))
+	assert.Equal(t, *highReplicationLagMinServing, HighReplicationLagMinServing("other"))
+
+	assert.Error(t, SetKeyspaceLowReplicationLag("fresh:soon"))
+	assert.Error(t, SetKeyspaceLowReplicationLag("fresh"))
+
+	newTablets := func(keyspace string, lags ...uint32) []*TabletHealth {
+		tablets := make([]*TabletHealth, len(lags))
+		for i, lag := range lags {
+			tablets[i] = &TabletHealth{
+				Tablet:  topo.NewTablet(uint32(i+1), "cell", fmt.Sprintf("host-%vs-behind", lag)),
+				Target:  &querypb.Target{Keyspace: keyspace, Shard: "0"},
+				Serving: true,
+				Stats:   &querypb.RealtimeStats{ReplicationLagSeconds: lag},
+			}
+		}
+		return tablets
+	}
+	lagsOf := func(tablets []*TabletHealth) []uint32 {
+		lags := make([]uint32, len(tablets))
+		for i, th := range tablets {
+			lags[i] = th.Stats.ReplicationLagSeconds
+		}
+		return lags
+	}
+
+	// The defaults apply to other keyspaces.
+	assert.Equal(t, []uint32{1, 10, 20}, lagsOf(FilterStatsByReplicationLag(newTablets("other", 1, 10, 20, 90))))
+	// Tablets over the soft threshold are only used to reach min_number_serving_vttablets.
+	assert.Equal(t, []uint32{1, 10}, lagsOf(FilterStatsByReplicationLag(newTablets("fresh", 1, 10, 20, 90))))
+	// Tablets over the hard threshold are never used.
+	assert.Equal(t, []uint32{10}, lagsOf(FilterStatsByReplicationLag(newTablets("fresh", 10, 90, 120))))
+}
+
 func TestFilterByReplicationLagThreeTabletMin(t *testing.T) {
 	// Use at least 3 tablets if possible
 	testSetMinNumTablets(3)
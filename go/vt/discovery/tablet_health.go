@@ -60,6 +60,15 @@ func (th *TabletHealth) GetTabletHostPort() string {
 	return netutil.JoinHostPort(hostname, vtPort)
 }
 
+// keyspace returns the keyspace of the tablet, as reported by its health
+// check if it did.
+func (th *TabletHealth) keyspace() string {
+	if th.Target != nil {
+		return th.Target.Keyspace
+	}
+	return th.Tablet.Keyspace
+}
+
 // GetHostNameLevel returns the specified hostname level. If the level does not exist it will pick the closest level.
 // This seems unused but can be utilized by certain url formatting templates. See getTabletDebugURL for more details.
 func (th *TabletHealth) GetHostNameLevel(level int) string {
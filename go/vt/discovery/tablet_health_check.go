@@ -187,7 +187,8 @@ func (thc *tabletHealthCheck) processResponse(hc *HealthCheckImpl, shr *query.St
 	prevTarget := thc.Target
 	// check whether this is a trivial update so as to update healthy map
 	trivialUpdate := thc.LastError == nil && thc.Serving && shr.RealtimeStats.HealthError == "" && shr.Serving &&
-		prevTarget.TabletType != topodata.TabletType_PRIMARY && prevTarget.TabletType == shr.Target.TabletType && thc.isTrivialReplagChange(shr.RealtimeStats)
+		prevTarget.TabletType != topodata.TabletType_PRIMARY && prevTarget.TabletType == shr.Target.TabletType && thc.isTrivialReplagChange(shr.RealtimeStats) &&
+		thc.Stats.ErrantGtids == shr.RealtimeStats.ErrantGtids
 	thc.lastResponseTimestamp = time.Now()
 	thc.Target = shr.Target
 	thc.PrimaryTermStartTime = shr.TabletExternallyReparentedTimestamp
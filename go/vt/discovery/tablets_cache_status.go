@@ -5,6 +5,7 @@ import (
 	"html/template"
 	"sort"
 	"strings"
+	"time"
 
 	"google.golang.org/protobuf/proto"
 
@@ -65,15 +66,29 @@ func (tcs *TabletsCacheStatus) StatusAsHTML() template.HTML {
 		if ts.LastError != nil {
 			color = "red"
 			extra = fmt.Sprintf(" (%v)", ts.LastError)
+		} else if !ts.Serving && ts.Tablet.ReplicationDelaySeconds > 0 {
+			color = "gray"
+			extra = fmt.Sprintf(" (Delayed Replica: %v)", time.Duration(ts.Tablet.ReplicationDelaySeconds)*time.Second)
 		} else if !ts.Serving {
 			color = "red"
 			extra = " (Not Serving)"
+		} else if ts.Stats != nil && ts.Stats.ErrantGtids != "" {
+			color = "yellow"
+			extra = fmt.Sprintf(" (RepLag: %v, Errant GTIDs: %v)", ts.Stats.ReplicationLagSeconds, ts.Stats.ErrantGtids)
 		} else if ts.Target.TabletType == topodatapb.TabletType_PRIMARY {
 			extra = fmt.Sprintf(" (PrimaryTermStartTime: %v)", ts.PrimaryTermStartTime)
 		} else {
 			extra = fmt.Sprintf(" (RepLag: %v)", ts.Stats.ReplicationLagSeconds)
 		}
 		name := topoproto.TabletAliasString(ts.Tablet.Alias)
+		if len(ts.Tablet.Tags) > 0 {
+			tags := make([]string, 0, len(ts.Tablet.Tags))
+			for k, v := range ts.Tablet.Tags {
+				tags = append(tags, k+"="+v)
+			}
+			sort.Strings(tags)
+			extra = fmt.Sprintf(" [%v]%v", template.HTMLEscapeString(strings.Join(tags, ",")), extra)
+		}
 		tLinks = append(tLinks, fmt.Sprintf(`<a href="%s" style="color:%v">%v</a>%v`, ts.getTabletDebugURL(), color, name, extra))
 	}
 	return template.HTML(strings.Join(tLinks, "<br>"))
@@ -220,12 +220,14 @@ func (tw *TopologyWatcher) loadTablets() {
 			tw.tabletRecorder.AddTablet(newVal.tablet)
 			topologyWatcherOperations.Add(topologyWatcherOpAddTablet, 1)
 		} else {
-			// check if the host and port have changed. If yes, replace tablet
+			// check if the host and port, or the tags, have changed. If yes,
+			// replace tablet
 			oldKey := TabletToMapKey(val.tablet)
 			newKey := TabletToMapKey(newVal.tablet)
-			if oldKey != newKey {
+			sameTags := len(val.tablet.Tags) == len(newVal.tablet.Tags) && topoproto.TabletHasTags(newVal.tablet, val.tablet.Tags)
+			if oldKey != newKey || !sameTags {
 				// This is the case where the same tablet alias is now reporting
-				// a different address key.
+				// a different address key, or different tags.
 				tw.tabletRecorder.ReplaceTablet(val.tablet, newVal.tablet)
 				topologyWatcherOperations.Add(topologyWatcherOpReplaceTablet, 1)
 			}
@@ -240,6 +240,23 @@ func checkWatcher(t *testing.T, refreshKnownTablets bool) {
 		counts = checkOpCounts(t, counts, map[string]int64{"ListTablets": 1, "GetTablet": 2, "ReplaceTablet": 2})
 	}
 
+	// Same tablet, different tags, should update.
+	if refreshKnownTablets {
+		if _, err := ts.UpdateTabletFields(context.Background(), tablet.Alias, func(t *topodatapb.Tablet) error {
+			t.Tags = map[string]string{"az": "1a"}
+			tablet = t
+			return nil
+		}); err != nil {
+			t.Fatalf("UpdateTabletFields failed: %v", err)
+		}
+		tw.loadTablets()
+		counts = checkOpCounts(t, counts, map[string]int64{"ListTablets": 1, "GetTablet": 2, "ReplaceTablet": 1})
+		allTablets = fhc.GetAllTablets()
+		if got := allTablets[TabletToMapKey(tablet)]; !proto.Equal(got, tablet) {
+			t.Errorf("fhc.GetAllTablets() = %+v; want %+v", got, tablet)
+		}
+	}
+
 	// Remove the tablet and check that it is detected as being gone.
 	if err := ts.DeleteTablet(context.Background(), tablet.Alias); err != nil {
 		t.Fatalf("DeleteTablet failed: %v", err)
@@ -27,6 +27,7 @@ import (
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/backoff"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/keepalive"
 
@@ -43,6 +44,9 @@ var (
 	keepaliveTimeout      = flag.Duration("grpc_keepalive_timeout", 10*time.Second, "After having pinged for keepalive check, the client waits for a duration of Timeout and if no activity is seen even after that the connection is closed.")
 	initialConnWindowSize = flag.Int("grpc_initial_conn_window_size", 0, "gRPC initial connection window size")
 	initialWindowSize     = flag.Int("grpc_initial_window_size", 0, "gRPC initial window size")
+	keepaliveWithoutCalls = flag.Bool("grpc_keepalive_permit_without_stream", true, "If true, the client also pings the server to check the transport when it has no active RPC, so that idle connections to a target that went away are detected before the next RPC.")
+	reconnectBaseDelay    = flag.Duration("grpc_reconnect_base_delay", 0, "Delay before the first reconnection attempt of a failed gRPC connection, growing exponentially up to -grpc_reconnect_max_delay. 0 keeps the gRPC default of 1s.")
+	reconnectMaxDelay     = flag.Duration("grpc_reconnect_max_delay", 0, "Upper bound of the delay between the reconnection attempts of a failed gRPC connection. 0 keeps the gRPC default of 120s.")
 )
 
 // FailFast is a self-documenting type for the grpc.FailFast.
@@ -86,11 +90,25 @@ func DialContext(ctx context.Context, target string, failFast FailFast, opts ...
 			// After having pinged for keepalive check, the client waits for a duration of Timeout and if no activity is seen even after that
 			// the connection is closed. (This will eagerly fail inflight grpc requests even if they don't have timeouts.)
 			Timeout:             *keepaliveTimeout,
-			PermitWithoutStream: true,
+			PermitWithoutStream: *keepaliveWithoutCalls,
 		}
 		newopts = append(newopts, grpc.WithKeepaliveParams(kp))
 	}
 
+	if *reconnectBaseDelay != 0 || *reconnectMaxDelay != 0 {
+		bc := backoff.DefaultConfig
+		if *reconnectBaseDelay != 0 {
+			bc.BaseDelay = *reconnectBaseDelay
+		}
+		if *reconnectMaxDelay != 0 {
+			bc.MaxDelay = *reconnectMaxDelay
+		}
+		if bc.BaseDelay > bc.MaxDelay {
+			bc.BaseDelay = bc.MaxDelay
+		}
+		newopts = append(newopts, grpc.WithConnectParams(grpc.ConnectParams{Backoff: bc}))
+	}
+
 	if *initialConnWindowSize != 0 {
 		newopts = append(newopts, grpc.WithInitialConnWindowSize(int32(*initialConnWindowSize)))
 	}
@@ -133,10 +151,19 @@ func SecureDialOption(cert, key, ca, name string) (grpc.DialOption, error) {
 
 	// Load the config. At this point we know
 	// we want a strict config with verify identity.
-	config, err := vttls.ClientConfig(vttls.VerifyIdentity, cert, key, ca, name, tls.VersionTLS12)
+	clientConfig := vttls.ClientConfig
+	if *grpccommon.TLSReload {
+		clientConfig = vttls.ClientConfigWithReload
+	}
+	config, err := clientConfig(vttls.VerifyIdentity, cert, key, ca, name, tls.VersionTLS12)
 	if err != nil {
 		return nil, err
 	}
+	if spiffeIDs := grpccommon.SPIFFEIDs(); len(spiffeIDs) > 0 {
+		if err := vttls.VerifyServerSPIFFEIDs(config, spiffeIDs); err != nil {
+			return nil, err
+		}
+	}
 
 	// Create the creds server options.
 	creds := credentials.NewTLS(config)
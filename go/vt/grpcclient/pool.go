@@ -0,0 +1,160 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcclient
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+
+	"vitess.io/vitess/go/stats"
+)
+
+var (
+	channelStateChanges = stats.NewCountersWithMultiLabels(
+		"GRPCClientChannelStateChanges",
+		"Number of times the pooled gRPC client channels changed state, by component and new state",
+		[]string{"Component", "State"})
+
+	channels = stats.NewGaugesFuncWithMultiLabels(
+		"GRPCClientChannels",
+		"Number of pooled gRPC client channels, by component and state",
+		[]string{"Component", "State"},
+		channelStates)
+
+	// pools are the open pools, for the GRPCClientChannels gauge.
+	poolsMu sync.Mutex
+	pools   = make(map[*ConnPool]bool)
+)
+
+func channelStates() map[string]int64 {
+	poolsMu.Lock()
+	defer poolsMu.Unlock()
+	states := make(map[string]int64)
+	for pool := range pools {
+		for _, cc := range pool.conns {
+			states[pool.component+"."+cc.GetState().String()]++
+		}
+	}
+	return states
+}
+
+// ConnPool is a pool of gRPC channels to the same target, which spreads the
+// RPCs of a component over several HTTP/2 connections.
+//
+// It prefers the channels that are not failing, and makes a failed channel
+// reconnect right away, instead of after its connection backoff, when
+// another channel of the pool is connected, since the target is then up.
+type ConnPool struct {
+	component string
+	conns     []*grpc.ClientConn
+	next      uint32
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// DialPool creates a pool of size channels to the target, with the same
+// options as Dial. component names the user of the pool in the metrics. A size
+// below 1 is one channel.
+func DialPool(component, target string, size int, failFast FailFast, opts ...grpc.DialOption) (*ConnPool, error) {
+	if size < 1 {
+		size = 1
+	}
+	pool := &ConnPool{component: component}
+	for i := 0; i < size; i++ {
+		cc, err := Dial(target, failFast, opts...)
+		if err != nil {
+			for _, cc := range pool.conns {
+				cc.Close()
+			}
+			return nil, err
+		}
+		pool.conns = append(pool.conns, cc)
+	}
+
+	pool.ctx, pool.cancel = context.WithCancel(context.Background())
+	for _, cc := range pool.conns {
+		pool.wg.Add(1)
+		go pool.watch(cc)
+	}
+
+	poolsMu.Lock()
+	pools[pool] = true
+	poolsMu.Unlock()
+	return pool, nil
+}
+
+// Conn returns the next channel of the pool that is not failing, or the next
+// channel if they all are.
+func (pool *ConnPool) Conn() *grpc.ClientConn {
+	n := uint32(len(pool.conns))
+	start := atomic.AddUint32(&pool.next, 1)
+	for i := uint32(0); i < n; i++ {
+		cc := pool.conns[(start+i)%n]
+		switch cc.GetState() {
+		case connectivity.TransientFailure, connectivity.Shutdown:
+			continue
+		}
+		return cc
+	}
+	return pool.conns[start%n]
+}
+
+// Close closes the channels of the pool.
+func (pool *ConnPool) Close() error {
+	poolsMu.Lock()
+	delete(pools, pool)
+	poolsMu.Unlock()
+
+	pool.cancel()
+	pool.wg.Wait()
+	var err error
+	for _, cc := range pool.conns {
+		if closeErr := cc.Close(); closeErr != nil && err == nil {
+			err = closeErr
+		}
+	}
+	return err
+}
+
+// watch counts the state changes of a channel, and resets its connection
+// backoff when it fails while another channel of the pool is connected.
+func (pool *ConnPool) watch(cc *grpc.ClientConn) {
+	defer pool.wg.Done()
+	state := cc.GetState()
+	for cc.WaitForStateChange(pool.ctx, state) {
+		state = cc.GetState()
+		channelStateChanges.Add([]string{pool.component, state.String()}, 1)
+		if state == connectivity.TransientFailure && pool.hasReadyConn() {
+			cc.ResetConnectBackoff()
+		}
+	}
+}
+
+func (pool *ConnPool) hasReadyConn() bool {
+	for _, cc := range pool.conns {
+		if cc.GetState() == connectivity.Ready {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcclient
+
+import (
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+func TestConnPool(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := grpc.NewServer()
+	go server.Serve(listener)
+
+	pool, err := DialPool("pooltest", listener.Addr().String(), 3, FailFast(false), grpc.WithInsecure(), grpc.WithBlock())
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, channels.Counts()["pooltest.READY"])
+
+	// The channels are picked in turn.
+	picked := make(map[*grpc.ClientConn]bool)
+	for i := 0; i < 3; i++ {
+		picked[pool.Conn()] = true
+	}
+	assert.Len(t, picked, 3)
+
+	// The state changes of the channels are counted when the server goes away.
+	changes := func() int64 {
+		var changes int64
+		for key, count := range channelStateChanges.Counts() {
+			if strings.HasPrefix(key, "pooltest.") {
+				changes += count
+			}
+		}
+		return changes
+	}
+	before := changes()
+	server.Stop()
+	deadline := time.Now().Add(10 * time.Second)
+	for changes() < before+3 || channels.Counts()["pooltest.READY"] != 0 {
+		require.True(t, time.Now().Before(deadline), "state changes: %v", channelStateChanges.Counts())
+		time.Sleep(10 * time.Millisecond)
+	}
+	// A channel is still returned when none is connected.
+	assert.NotNil(t, pool.Conn())
+
+	require.NoError(t, pool.Close())
+	for key := range channels.Counts() {
+		assert.False(t, strings.HasPrefix(key, "pooltest."), key)
+	}
+}
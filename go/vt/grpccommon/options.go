@@ -18,6 +18,7 @@ package grpccommon
 
 import (
 	"flag"
+	"strings"
 	"sync"
 
 	"google.golang.org/grpc"
@@ -36,6 +37,13 @@ var (
 
 	// EnableGRPCPrometheus sets a flag to enable grpc client/server grpc monitoring.
 	EnableGRPCPrometheus = flag.Bool("grpc_prometheus", false, "Enable gRPC monitoring with Prometheus")
+
+	// TLSReload sets a flag to reload the gRPC client/server TLS certificates
+	// when their files change.
+	TLSReload = flag.Bool("grpc_tls_reload", false, "Watch the TLS certificate, key and CA files of the gRPC servers and clients, and reload them when they change, without restarting.")
+
+	// AllowedSPIFFEIDs sets a flag to verify the SPIFFE IDs of the gRPC peers.
+	AllowedSPIFFEIDs = flag.String("grpc_allowed_spiffe_ids", "", "Comma-separated list of the SPIFFE IDs allowed as gRPC peers, where spiffe://<trust domain> allows all the IDs of a trust domain. If set, gRPC servers require client certificates carrying one of them, and gRPC clients require it from the server certificates, in place of verifying the server name.")
 )
 
 var enableTracing sync.Once
@@ -52,3 +60,11 @@ func EnableTracingOpt() {
 func init() {
 	stats.NewString("GrpcVersion").Set(grpc.Version)
 }
+
+// SPIFFEIDs returns the SPIFFE IDs allowed as gRPC peers, if any.
+func SPIFFEIDs() []string {
+	if *AllowedSPIFFEIDs == "" {
+		return nil
+	}
+	return strings.Split(*AllowedSPIFFEIDs, ",")
+}
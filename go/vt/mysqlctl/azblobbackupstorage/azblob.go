@@ -215,6 +215,21 @@ func (bh *AZBlobBackupHandle) AddFile(ctx context.Context, filename string, file
 	return writer, nil
 }
 
+// WriteMetadataFile implements MetadataWriter.
+func (bh *AZBlobBackupHandle) WriteMetadataFile(ctx context.Context, filename string, data []byte) error {
+	// The directory of the read-only backups already holds their name.
+	obj := objName(bh.dir, filename)
+	if !bh.readOnly {
+		obj = objName(bh.dir, bh.name, filename)
+	}
+	containerURL, err := bh.bs.containerURL()
+	if err != nil {
+		return err
+	}
+	_, err = azblob.UploadBufferToBlockBlob(ctx, data, containerURL.NewBlockBlobURL(obj), azblob.UploadToBlockBlobOptions{})
+	return err
+}
+
 // EndBackup implements BackupHandle.
 func (bh *AZBlobBackupHandle) EndBackup(ctx context.Context) error {
 	if bh.readOnly {
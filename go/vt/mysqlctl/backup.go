@@ -30,6 +30,7 @@ import (
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/stats"
 	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/logutil"
 	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
 	"vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/topo/topoproto"
@@ -174,6 +175,31 @@ func ParseBackupName(dir string, name string) (backupTime *time.Time, alias *top
 	return backupTime, alias, nil
 }
 
+// VerifyBackup checks the integrity of a backup without restoring it. The
+// MANIFEST is always checked. The files of the backup are checked as well if
+// the engine that created the backup implements BackupVerifier, and so is the
+// binary log file of an incremental backup.
+func VerifyBackup(ctx context.Context, logger logutil.Logger, bh backupstorage.BackupHandle, checksums bool) error {
+	bm, err := GetBackupManifest(ctx, bh)
+	if err != nil {
+		return vterrors.Wrap(err, "can't get backup MANIFEST")
+	}
+	if bm.BackupMethod == IncrementalBackupMethod {
+		return verifyIncrementalBackup(ctx, logger, bh, checksums)
+	}
+
+	re, err := GetRestoreEngine(ctx, bh)
+	if err != nil {
+		return err
+	}
+	verifier, ok := re.(BackupVerifier)
+	if !ok {
+		logger.Infof("VerifyBackup: %T cannot verify backup files, only the MANIFEST of %v/%v was checked", re, bh.Directory(), bh.Name())
+		return nil
+	}
+	return verifier.VerifyBackup(ctx, logger, bh, checksums)
+}
+
 // checkNoDB makes sure there is no user data already there.
 // Used by Restore, as we do not want to destroy an existing DB.
 // The user's database name must be given since we ignore all others.
@@ -315,10 +341,15 @@ func Restore(ctx context.Context, params RestoreParams) (*BackupManifest, error)
 		return nil, vterrors.Wrap(err, "Failed to find restore engine")
 	}
 
-	manifest, err := re.ExecuteRestore(ctx, params, bh)
+	restoreProgress.start()
+	stopLogging := logRestoreProgress(params.Logger)
+	manifest, err := re.ExecuteRestore(ctx, params, newRestoreBackupHandle(bh, params.MaxBandwidth))
+	stopLogging()
+	restoreProgress.finish()
 	if err != nil {
 		return nil, err
 	}
+	params.Logger.Infof("Restore: %v", restoreProgress.Status())
 
 	// mysqld needs to be running in order for mysql_upgrade to work.
 	// If we've just restored from a backup from previous MySQL version then mysqld
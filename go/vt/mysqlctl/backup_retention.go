@@ -0,0 +1,110 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"context"
+	"time"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+	"vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+var (
+	backupsPruned = stats.NewCountersWithMultiLabels(
+		"BackupsPruned",
+		"Number of backups removed by the retention policy, by keyspace and shard",
+		[]string{"Keyspace", "Shard"})
+	backupPruneErrors = stats.NewCountersWithMultiLabels(
+		"BackupPruneErrors",
+		"Number of backups the retention policy failed to remove, by keyspace and shard",
+		[]string{"Keyspace", "Shard"})
+)
+
+// PruneBackups removes the backups of a shard that the retention policy does
+// not keep, and returns their names. With dryRun, nothing is removed, and the
+// names of the backups that would be removed are returned.
+//
+// The policy applies to the full backups only. An incremental backup is kept
+// as long as the full backup before it, which it applies to, so that it is
+// pruned with its base, and a full backup is never pruned in favor of the
+// incremental backups taken since. Backups whose MANIFEST cannot be read
+// count as full backups.
+//
+// Backups whose name cannot be parsed are always kept, since their age is
+// unknown.
+func PruneBackups(ctx context.Context, logger logutil.Logger, bs backupstorage.BackupStorage, keyspace, shard string, policy backupstorage.RetentionPolicy, dryRun bool) ([]string, error) {
+	if err := policy.Validate(); err != nil {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "invalid retention policy: %v", err)
+	}
+
+	dir := GetBackupDir(keyspace, shard)
+	bhs, err := bs.ListBackups(ctx, dir)
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "can't list backups of %v", dir)
+	}
+
+	// fullTimes are the times of the full backups, and base is the index in
+	// fullTimes of the full backup each candidate is or applies to, or -1 for
+	// the incremental backups before the first full backup.
+	candidates := make([]backupstorage.BackupHandle, 0, len(bhs))
+	base := make([]int, 0, len(bhs))
+	var fullTimes []time.Time
+	for _, bh := range bhs {
+		backupTime, _, err := ParseBackupName(dir, bh.Name())
+		if err != nil || backupTime == nil {
+			logger.Warningf("Keeping backup %v/%v, since its time cannot be parsed from its name", dir, bh.Name())
+			continue
+		}
+		if bm, err := GetBackupManifest(ctx, bh); err != nil || bm.BackupMethod != IncrementalBackupMethod {
+			fullTimes = append(fullTimes, *backupTime)
+		}
+		candidates = append(candidates, bh)
+		base = append(base, len(fullTimes)-1)
+	}
+
+	var pruned []string
+	retained := policy.Retain(fullTimes, time.Now())
+	for i, bh := range candidates {
+		if base[i] >= 0 && retained[base[i]] {
+			continue
+		}
+
+		name := bh.Name()
+		if dryRun {
+			logger.Infof("Would remove backup %v/%v, which is not kept by the retention policy %+v", dir, name, policy)
+			pruned = append(pruned, name)
+			continue
+		}
+
+		logger.Infof("Removing backup %v/%v, which is not kept by the retention policy %+v", dir, name, policy)
+		if err := bs.RemoveBackup(ctx, dir, name); err != nil {
+			backupPruneErrors.Add([]string{keyspace, shard}, 1)
+			return pruned, vterrors.Wrapf(err, "couldn't remove backup %v from %v", name, dir)
+		}
+		backupsPruned.Add([]string{keyspace, shard}, 1)
+		pruned = append(pruned, name)
+	}
+
+	if len(pruned) == 0 {
+		logger.Infof("Found %v backups in %v, none to prune with the retention policy %+v", len(bhs), dir, policy)
+	}
+	return pruned, nil
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+	"vitess.io/vitess/go/vt/mysqlctl/filebackupstorage"
+)
+
+func TestPruneBackups(t *testing.T) {
+	root, err := os.MkdirTemp("", "prunetest")
+	require.NoError(t, err)
+	defer os.RemoveAll(root)
+
+	oldRoot := *filebackupstorage.FileBackupStorageRoot
+	defer func() { *filebackupstorage.FileBackupStorageRoot = oldRoot }()
+	*filebackupstorage.FileBackupStorageRoot = root
+
+	ctx := context.Background()
+	bs := &filebackupstorage.FileBackupStorage{}
+	dir := GetBackupDir("ks", "-")
+	now := time.Now().UTC()
+
+	var names []string
+	for _, age := range []time.Duration{72 * time.Hour, 48 * time.Hour, 24 * time.Hour, time.Hour} {
+		name := fmt.Sprintf("%v.zone1-0000000100", now.Add(-age).Format(BackupTimestampFormat))
+		bh, err := bs.StartBackup(ctx, dir, name)
+		require.NoError(t, err)
+		require.NoError(t, bh.EndBackup(ctx))
+		names = append(names, name)
+	}
+	// A backup with an unexpected name is never pruned.
+	bh, err := bs.StartBackup(ctx, dir, "unnamed")
+	require.NoError(t, err)
+	require.NoError(t, bh.EndBackup(ctx))
+
+	logger := logutil.NewMemoryLogger()
+	policy := backupstorage.RetentionPolicy{KeepLast: 1, MaxAge: 36 * time.Hour}
+
+	pruned, err := PruneBackups(ctx, logger, bs, "ks", "-", policy, true /* dryRun */)
+	require.NoError(t, err)
+	assert.Equal(t, names[:2], pruned)
+
+	bhs, err := bs.ListBackups(ctx, dir)
+	require.NoError(t, err)
+	assert.Len(t, bhs, 5)
+
+	pruned, err = PruneBackups(ctx, logger, bs, "ks", "-", policy, false /* dryRun */)
+	require.NoError(t, err)
+	assert.Equal(t, names[:2], pruned)
+
+	bhs, err = bs.ListBackups(ctx, dir)
+	require.NoError(t, err)
+	require.Len(t, bhs, 3)
+	assert.Equal(t, names[2], bhs[0].Name())
+	assert.Equal(t, names[3], bhs[1].Name())
+	assert.Equal(t, "unnamed", bhs[2].Name())
+
+	_, err = PruneBackups(ctx, logger, bs, "ks", "-", backupstorage.RetentionPolicy{}, false /* dryRun */)
+	assert.Error(t, err)
+}
+
+func TestPruneIncrementalBackups(t *testing.T) {
+	root, err := os.MkdirTemp("", "prunetest")
+	require.NoError(t, err)
+	defer os.RemoveAll(root)
+
+	oldRoot := *filebackupstorage.FileBackupStorageRoot
+	defer func() { *filebackupstorage.FileBackupStorageRoot = oldRoot }()
+	*filebackupstorage.FileBackupStorageRoot = root
+
+	ctx := context.Background()
+	bs := &filebackupstorage.FileBackupStorage{}
+	dir := GetBackupDir("ks", "-")
+	now := time.Now().UTC()
+
+	full := &BackupManifest{BackupMethod: builtinBackupEngineName}
+	incremental := &BackupManifest{BackupMethod: IncrementalBackupMethod}
+	var names []string
+	for _, backup := range []struct {
+		age      time.Duration
+		manifest *BackupManifest
+	}{
+		{96 * time.Hour, incremental},
+		{72 * time.Hour, full},
+		{60 * time.Hour, incremental},
+		{48 * time.Hour, full},
+		{36 * time.Hour, incremental},
+		{time.Hour, incremental},
+	} {
+		names = append(names, addTestBackup(t, bs, dir, now.Add(-backup.age), backup.manifest, map[string][]byte{}))
+	}
+
+	// The last full backup is kept with the incremental backups since, and
+	// the incremental backups of the pruned full backup are pruned with it.
+	logger := logutil.NewMemoryLogger()
+	pruned, err := PruneBackups(ctx, logger, bs, "ks", "-", backupstorage.RetentionPolicy{KeepLast: 1}, false /* dryRun */)
+	require.NoError(t, err)
+	assert.Equal(t, names[:3], pruned)
+
+	bhs, err := bs.ListBackups(ctx, dir)
+	require.NoError(t, err)
+	require.Len(t, bhs, 3)
+	for i, bh := range bhs {
+		assert.Equal(t, names[3+i], bh.Name())
+	}
+}
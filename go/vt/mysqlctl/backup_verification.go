@@ -0,0 +1,70 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"context"
+	"io/ioutil"
+
+	"vitess.io/vitess/go/json2"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	mysqlctlpb "vitess.io/vitess/go/vt/proto/mysqlctl"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// backupVerificationFileName is the file of a backup that records the
+// outcome of its last verification, next to its MANIFEST. Since it is part
+// of the backup, it is copied and removed along with it.
+const backupVerificationFileName = "VERIFICATION"
+
+// SaveBackupVerification records the outcome of a verification of the
+// backup in the backup storage, replacing the previous one, if any.
+func SaveBackupVerification(ctx context.Context, bh backupstorage.BackupHandle, verification *mysqlctlpb.BackupVerification) error {
+	writer, ok := bh.(backupstorage.MetadataWriter)
+	if !ok {
+		return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "backup storage %T can not record the verification of a backup", bh)
+	}
+
+	data, err := json2.MarshalPB(verification)
+	if err != nil {
+		return err
+	}
+	return writer.WriteMetadataFile(ctx, backupVerificationFileName, data)
+}
+
+// GetBackupVerification returns the outcome of the last verification of the
+// backup. It returns an error if the backup was never verified, as the
+// backup storages report the missing files differently.
+func GetBackupVerification(ctx context.Context, bh backupstorage.BackupHandle) (*mysqlctlpb.BackupVerification, error) {
+	file, err := bh.ReadFile(ctx, backupVerificationFileName)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "can't read VERIFICATION")
+	}
+	defer file.Close()
+
+	data, err := ioutil.ReadAll(file)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "can't read VERIFICATION")
+	}
+	verification := &mysqlctlpb.BackupVerification{}
+	if err := json2.Unmarshal(data, verification); err != nil {
+		return nil, vterrors.Wrap(err, "can't decode VERIFICATION")
+	}
+	return verification, nil
+}
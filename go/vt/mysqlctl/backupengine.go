@@ -66,6 +66,9 @@ type BackupParams struct {
 	TabletAlias string
 	// BackupTime is the time at which the backup is being started
 	BackupTime time.Time
+	// CompressionEngine is the engine used to compress the backup. If empty,
+	// the one of -compression_engine_name is used.
+	CompressionEngine string
 }
 
 // RestoreParams is the struct that holds all params passed to ExecuteRestore
@@ -76,6 +79,10 @@ type RestoreParams struct {
 	// Concurrency is the value of -restore_concurrency flag (init restore parameter)
 	// It determines how many files are processed in parallel
 	Concurrency int
+	// MaxBandwidth is the maximum number of bytes per second read from the
+	// BackupStorage, shared by all the files restored in parallel. It is
+	// not limited if not positive.
+	MaxBandwidth int64
 	// Extra env variables for pre-restore and post-restore transform hooks
 	HookExtraEnv map[string]string
 	// Metadata to write into database after restore. See PopulateMetadataTables
@@ -100,6 +107,15 @@ type RestoreEngine interface {
 	ExecuteRestore(ctx context.Context, params RestoreParams, bh backupstorage.BackupHandle) (*BackupManifest, error)
 }
 
+// BackupVerifier is implemented by the backup engines that can check the
+// integrity of a backup without restoring it.
+type BackupVerifier interface {
+	// VerifyBackup checks that all the files listed in the MANIFEST exist.
+	// If checksums is true, it also reads every file, and compares its hash
+	// with the one recorded in the MANIFEST.
+	VerifyBackup(ctx context.Context, logger logutil.Logger, bh backupstorage.BackupHandle, checksums bool) error
+}
+
 // BackupRestoreEngine is a combination of BackupEngine and RestoreEngine.
 type BackupRestoreEngine interface {
 	BackupEngine
@@ -192,7 +208,8 @@ type BackupManifest struct {
 
 // FindBackupToRestore returns a selected candidate backup to be restored.
 // It returns the most recent backup that is complete, meaning it has a valid
-// MANIFEST file.
+// MANIFEST file. Incremental backups are skipped, since they cannot be
+// restored on their own.
 func FindBackupToRestore(ctx context.Context, params RestoreParams, bhs []backupstorage.BackupHandle) (backupstorage.BackupHandle, error) {
 	var bh backupstorage.BackupHandle
 	var index int
@@ -208,6 +225,10 @@ func FindBackupToRestore(ctx context.Context, params RestoreParams, bhs []backup
 			params.Logger.Warningf("Possibly incomplete backup %v in directory %v on BackupStorage: can't read MANIFEST: %v)", bh.Name(), backupDir, err)
 			continue
 		}
+		if bm.BackupMethod == IncrementalBackupMethod {
+			params.Logger.Infof("Restore: skipping incremental backup %v/%v", backupDir, bh.Name())
+			continue
+		}
 
 		var backupTime time.Time
 		if checkBackupTime {
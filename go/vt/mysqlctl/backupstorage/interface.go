@@ -82,6 +82,16 @@ type BackupHandle interface {
 	concurrency.ErrorRecorder
 }
 
+// MetadataWriter is implemented by the backup handles that can write a
+// file to a backup after it ended, to record metadata next to its MANIFEST,
+// like the outcome of its last verification.
+type MetadataWriter interface {
+	// WriteMetadataFile writes a file to the backup, replacing it if it
+	// exists. Unlike AddFile, it works for read-only backups (returned by
+	// ListBackups).
+	WriteMetadataFile(ctx context.Context, filename string, data []byte) error
+}
+
 // BackupStorage is the interface to the storage system
 type BackupStorage interface {
 	// ListBackups returns all the backups in a directory.  The
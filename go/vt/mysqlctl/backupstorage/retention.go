@@ -0,0 +1,87 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backupstorage
+
+import (
+	"fmt"
+	"time"
+)
+
+// RetentionPolicy describes which backups of a backup directory must be kept.
+// A backup is kept if any of the rules selects it, and can be removed
+// otherwise.
+type RetentionPolicy struct {
+	// KeepLast is the number of most recent backups to keep, regardless of
+	// their age. It must be at least 1, since a backup must always exist to
+	// allow new backups to be made.
+	KeepLast int
+	// KeepDaily is the number of days for which the most recent backup of
+	// the day is kept. Only days with at least one backup are counted.
+	KeepDaily int
+	// KeepWeekly is the number of ISO weeks for which the most recent backup
+	// of the week is kept. Only weeks with at least one backup are counted.
+	KeepWeekly int
+	// MaxAge, if set, keeps all the backups younger than this.
+	MaxAge time.Duration
+}
+
+// Validate returns an error if the policy is not usable.
+func (p RetentionPolicy) Validate() error {
+	if p.KeepLast < 1 {
+		return fmt.Errorf("retention policy must keep at least the last backup, got %v", p.KeepLast)
+	}
+	if p.KeepDaily < 0 || p.KeepWeekly < 0 || p.MaxAge < 0 {
+		return fmt.Errorf("retention policy values cannot be negative: %+v", p)
+	}
+	return nil
+}
+
+// Retain applies the policy to the start times of the backups of a
+// directory, sorted in ascending order as returned by ListBackups. It returns,
+// for each backup, whether the policy keeps it.
+func (p RetentionPolicy) Retain(times []time.Time, now time.Time) []bool {
+	keep := make([]bool, len(times))
+	days := make(map[string]bool, p.KeepDaily)
+	weeks := make(map[string]bool, p.KeepWeekly)
+
+	// Walk from the most recent backup, so the first backup seen on a given
+	// day or week is the most recent one of that period.
+	for n, i := 0, len(times)-1; i >= 0; n, i = n+1, i-1 {
+		t := times[i].UTC()
+
+		if n < p.KeepLast {
+			keep[i] = true
+		}
+		if p.MaxAge > 0 && now.Sub(t) < p.MaxAge {
+			keep[i] = true
+		}
+
+		day := t.Format("2006-01-02")
+		if !days[day] && len(days) < p.KeepDaily {
+			days[day] = true
+			keep[i] = true
+		}
+
+		year, w := t.ISOWeek()
+		week := fmt.Sprintf("%d-W%02d", year, w)
+		if !weeks[week] && len(weeks) < p.KeepWeekly {
+			weeks[week] = true
+			keep[i] = true
+		}
+	}
+	return keep
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backupstorage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetentionPolicyValidate(t *testing.T) {
+	assert.NoError(t, RetentionPolicy{KeepLast: 1}.Validate())
+	assert.Error(t, RetentionPolicy{}.Validate())
+	assert.Error(t, RetentionPolicy{KeepLast: 1, KeepDaily: -1}.Validate())
+	assert.Error(t, RetentionPolicy{KeepLast: 1, MaxAge: -time.Hour}.Validate())
+}
+
+func TestRetentionPolicyRetain(t *testing.T) {
+	// Monday 2021-06-14 at noon.
+	now := time.Date(2021, 6, 14, 12, 0, 0, 0, time.UTC)
+	times := []time.Time{
+		now.Add(-21 * 24 * time.Hour),        // 0: Monday, three weeks ago
+		now.Add(-8 * 24 * time.Hour),         // 1: Sunday, two weeks ago
+		now.Add(-8*24*time.Hour + time.Hour), // 2: later that Sunday
+		now.Add(-2 * 24 * time.Hour),         // 3: Saturday
+		now.Add(-24 * time.Hour),             // 4: Sunday
+		now.Add(-24*time.Hour + 2*time.Hour), // 5: later that Sunday
+		now.Add(-2 * time.Hour),              // 6: today
+		now.Add(-time.Hour),                  // 7: today, most recent
+	}
+
+	tcs := []struct {
+		name   string
+		policy RetentionPolicy
+		keep   []int
+	}{
+		{
+			name:   "keep last",
+			policy: RetentionPolicy{KeepLast: 2},
+			keep:   []int{6, 7},
+		},
+		{
+			name:   "keep more than there is",
+			policy: RetentionPolicy{KeepLast: 20},
+			keep:   []int{0, 1, 2, 3, 4, 5, 6, 7},
+		},
+		{
+			name:   "max age",
+			policy: RetentionPolicy{KeepLast: 1, MaxAge: 50 * time.Hour},
+			keep:   []int{3, 4, 5, 6, 7},
+		},
+		{
+			name:   "daily",
+			policy: RetentionPolicy{KeepLast: 1, KeepDaily: 3},
+			keep:   []int{3, 5, 7},
+		},
+		{
+			name:   "weekly",
+			policy: RetentionPolicy{KeepLast: 1, KeepWeekly: 3},
+			keep:   []int{2, 5, 7},
+		},
+		{
+			name:   "combined",
+			policy: RetentionPolicy{KeepLast: 1, KeepDaily: 2, KeepWeekly: 2},
+			keep:   []int{5, 7},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			var kept []int
+			for i, keep := range tc.policy.Retain(times, now) {
+				if keep {
+					kept = append(kept, i)
+				}
+			}
+			assert.Equal(t, tc.keep, kept)
+		})
+	}
+}
@@ -14,7 +14,7 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
-package binlog
+package mysqlctl
 
 import (
 	crand "crypto/rand"
@@ -41,6 +41,11 @@ var (
 // connecting for replication. Each such connection must identify itself to
 // mysqld with a server ID that is unique both among other BinlogConnections and
 // among actual replicas in the topology.
+//
+// It is in mysqlctl, rather than with the binlog streamers of go/vt/binlog,
+// so that the incremental backups can also stream the binlogs: go/vt/binlog
+// imports tabletserver/schema, so mysqlctl importing it would create an
+// import cycle in the tests that use vttest, such as the ones of withddl.
 type BinlogConnection struct {
 	*mysql.Conn
 	cp       dbconfigs.Connector
@@ -0,0 +1,431 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path"
+	"time"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/dbconfigs"
+	vtenv "vitess.io/vitess/go/vt/env"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+	"vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// BinlogArchiveMethod is the BackupMethod of the segments of the binlog
+// archive of a shard. Each segment holds the binary logs of the transactions
+// after the previous segment, so the segments of the archive form a chain
+// from the position of a backup, and outlive the binary logs purged from the
+// mysqlds of the shard.
+const BinlogArchiveMethod = "binlog-archive"
+
+// binlogEventHeaderLength is the length of the header of the binlog events,
+// which ends with the length of the event and the position of the next one.
+const binlogEventHeaderLength = 19
+
+var (
+	binlogArchiveSegments = stats.NewCounter("BinlogArchiveSegments", "Number of binlog segments archived to the backup storage")
+	binlogArchiveBytes    = stats.NewCounter("BinlogArchiveBytes", "Number of bytes of binlogs archived to the backup storage")
+)
+
+// BinlogSegmentManifest is the MANIFEST of a segment of the binlog archive.
+type BinlogSegmentManifest struct {
+	// BackupManifest is common across all BackupEngines. Its Position is the
+	// position the segment ends at.
+	BackupManifest
+
+	// FromPosition is the position the segment starts from, which is the
+	// one the previous segment ends at.
+	FromPosition mysql.Position
+
+	// FirstTimestamp and LastTimestamp are the commit times of the first
+	// and last transactions of the segment, in seconds since the epoch.
+	FirstTimestamp int64
+	LastTimestamp  int64
+
+	// TabletAlias is the tablet of the mysqld the binlogs were streamed from.
+	TabletAlias string
+
+	// Hash and Size describe the binary log file of this segment.
+	Hash string
+	Size int64
+}
+
+// ArchivedBinlogSegment is a segment of the binlog archive of a shard.
+type ArchivedBinlogSegment struct {
+	Handle   backupstorage.BackupHandle
+	Manifest *BinlogSegmentManifest
+}
+
+// ArchiveBinlogsParams are the parameters of ArchiveBinlogs.
+type ArchiveBinlogsParams struct {
+	Logger logutil.Logger
+	// Connector connects to the mysqld to stream the binlogs from, as a
+	// replica would.
+	Connector dbconfigs.Connector
+	// Keyspace and Shard are used to infer the directory of the archive.
+	Keyspace string
+	Shard    string
+	// TabletAlias is used along with the time of the segment to name it.
+	TabletAlias string
+	// ToPosition is the position to archive the binlogs up to, typically
+	// the current position of the mysqld.
+	ToPosition mysql.Position
+}
+
+// GetBinlogArchiveDir returns the directory of the binlog archive of a
+// shard in the backup storage. It is kept apart from the backups of the
+// shard, which are not binlog segments.
+func GetBinlogArchiveDir(keyspace, shard string) string {
+	return fmt.Sprintf("%v/%v.binlogs", keyspace, shard)
+}
+
+// ArchiveBinlogs archives a new segment of binlogs for a shard, with the
+// transactions from the end of the last segment of the archive, or from the
+// position of the last backup of the shard when it has none, up to
+// params.ToPosition. It returns a nil manifest if there is nothing to
+// archive.
+func ArchiveBinlogs(ctx context.Context, bs backupstorage.BackupStorage, params ArchiveBinlogsParams) (*BinlogSegmentManifest, error) {
+	from, err := nextArchivePosition(ctx, params.Logger, bs, params.Keyspace, params.Shard)
+	if err != nil {
+		return nil, err
+	}
+	if from.IsZero() {
+		return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "no backup of %v/%v to start the binlog archive from", params.Keyspace, params.Shard)
+	}
+	if from.AtLeast(params.ToPosition) {
+		return nil, nil
+	}
+
+	dir := GetBinlogArchiveDir(params.Keyspace, params.Shard)
+	now := time.Now().UTC()
+	name := fmt.Sprintf("%v.%v", now.Format(BackupTimestampFormat), params.TabletAlias)
+	bh, err := bs.StartBackup(ctx, dir, name)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "StartBackup failed")
+	}
+	bm, err := archiveBinlogSegment(ctx, params, bh, from, now)
+	if err != nil {
+		if abortErr := bh.AbortBackup(ctx); abortErr != nil {
+			params.Logger.Errorf2(abortErr, "failed to abort binlog segment %v/%v", dir, name)
+		}
+		return nil, err
+	}
+	if err := bh.EndBackup(ctx); err != nil {
+		return nil, vterrors.Wrap(err, "EndBackup failed")
+	}
+
+	binlogArchiveSegments.Add(1)
+	binlogArchiveBytes.Add(bm.Size)
+	params.Logger.Infof("Archived binlog segment %v/%v of %v bytes, from %v to %v", dir, name, bm.Size, bm.FromPosition, bm.Position)
+	return bm, nil
+}
+
+func archiveBinlogSegment(ctx context.Context, params ArchiveBinlogsParams, bh backupstorage.BackupHandle, from mysql.Position, now time.Time) (*BinlogSegmentManifest, error) {
+	wc, err := bh.AddFile(ctx, incrementalBackupFileName, backupstorage.FileSizeUnknown)
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "cannot add %v to binlog segment", incrementalBackupFileName)
+	}
+	hasher := newHasher()
+	dst := bufio.NewWriterSize(wc, writerBufferSize)
+	streamed, err := streamBinlogs(ctx, params.Connector, from, params.ToPosition, io.MultiWriter(dst, hasher))
+	if err == nil {
+		err = dst.Flush()
+	}
+	if closeErr := wc.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return nil, vterrors.Wrap(err, "cannot archive binary logs")
+	}
+
+	bm := &BinlogSegmentManifest{
+		BackupManifest: BackupManifest{
+			BackupMethod: BinlogArchiveMethod,
+			Position:     streamed.Position,
+			BackupTime:   now.Format(time.RFC3339),
+			FinishedTime: time.Now().UTC().Format(time.RFC3339),
+		},
+		FromPosition:   from,
+		FirstTimestamp: streamed.FirstTimestamp,
+		LastTimestamp:  streamed.LastTimestamp,
+		TabletAlias:    params.TabletAlias,
+		Hash:           hasher.HashString(),
+		Size:           hasher.size,
+	}
+	data, err := json.MarshalIndent(bm, "", "  ")
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "cannot JSON encode %v", backupManifestFileName)
+	}
+	wc, err = bh.AddFile(ctx, backupManifestFileName, backupstorage.FileSizeUnknown)
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "cannot add %v to binlog segment", backupManifestFileName)
+	}
+	if _, err := wc.Write(data); err != nil {
+		wc.Close()
+		return nil, vterrors.Wrapf(err, "cannot write %v", backupManifestFileName)
+	}
+	if err := wc.Close(); err != nil {
+		return nil, vterrors.Wrapf(err, "cannot close %v", backupManifestFileName)
+	}
+	return bm, nil
+}
+
+// nextArchivePosition returns the position the next segment of the binlog
+// archive of a shard starts from.
+func nextArchivePosition(ctx context.Context, logger logutil.Logger, bs backupstorage.BackupStorage, keyspace, shard string) (mysql.Position, error) {
+	segments, err := listArchivedBinlogs(ctx, logger, bs, keyspace, shard)
+	if err != nil {
+		return mysql.Position{}, err
+	}
+	if len(segments) > 0 {
+		return segments[len(segments)-1].Manifest.Position, nil
+	}
+
+	bhs, err := bs.ListBackups(ctx, GetBackupDir(keyspace, shard))
+	if err != nil {
+		return mysql.Position{}, vterrors.Wrap(err, "ListBackups failed")
+	}
+	for i := len(bhs) - 1; i >= 0; i-- {
+		bm, err := GetBackupManifest(ctx, bhs[i])
+		if err != nil {
+			logger.Warningf("Ignoring backup %v: can't read MANIFEST: %v", bhs[i].Name(), err)
+			continue
+		}
+		return bm.Position, nil
+	}
+	return mysql.Position{}, nil
+}
+
+// listArchivedBinlogs returns the segments of the binlog archive of a
+// shard, in the order they were archived.
+func listArchivedBinlogs(ctx context.Context, logger logutil.Logger, bs backupstorage.BackupStorage, keyspace, shard string) ([]ArchivedBinlogSegment, error) {
+	bhs, err := bs.ListBackups(ctx, GetBinlogArchiveDir(keyspace, shard))
+	if err != nil {
+		return nil, vterrors.Wrap(err, "ListBackups failed")
+	}
+	var segments []ArchivedBinlogSegment
+	for _, bh := range bhs {
+		bm := &BinlogSegmentManifest{}
+		if err := getBackupManifestInto(ctx, bh, bm); err != nil {
+			logger.Warningf("Ignoring binlog segment %v: can't read MANIFEST: %v", bh.Name(), err)
+			continue
+		}
+		if bm.BackupMethod != BinlogArchiveMethod {
+			logger.Warningf("Ignoring binlog segment %v: unexpected backup method %v", bh.Name(), bm.BackupMethod)
+			continue
+		}
+		segments = append(segments, ArchivedBinlogSegment{Handle: bh, Manifest: bm})
+	}
+	return segments, nil
+}
+
+// FindArchivedBinlogs returns the chain of segments of the binlog archive of
+// a shard with the transactions after a position: it starts with the segment
+// that contains the transaction after the position, and ends with the last
+// segment, or before the first gap in the archive. It returns no segments
+// if the archive has no transactions after the position, and an error if
+// they do not start from the position.
+func FindArchivedBinlogs(ctx context.Context, logger logutil.Logger, bs backupstorage.BackupStorage, keyspace, shard string, from mysql.Position) ([]ArchivedBinlogSegment, error) {
+	segments, err := listArchivedBinlogs(ctx, logger, bs, keyspace, shard)
+	if err != nil {
+		return nil, err
+	}
+	start := -1
+	for i, segment := range segments {
+		if !from.AtLeast(segment.Manifest.Position) && from.AtLeast(segment.Manifest.FromPosition) {
+			start = i
+			break
+		}
+	}
+	if start == -1 {
+		if len(segments) == 0 || from.AtLeast(segments[len(segments)-1].Manifest.Position) {
+			return nil, nil
+		}
+		return nil, vterrors.Errorf(vtrpc.Code_NOT_FOUND, "the binlog archive of %v/%v has no segment from position %v", keyspace, shard, from)
+	}
+	chain := []ArchivedBinlogSegment{segments[start]}
+	for _, segment := range segments[start+1:] {
+		if !segment.Manifest.FromPosition.Equal(chain[len(chain)-1].Manifest.Position) {
+			logger.Warningf("The binlog archive of %v/%v has a gap before segment %v, which starts at %v", keyspace, shard, segment.Handle.Name(), segment.Manifest.FromPosition)
+			break
+		}
+		chain = append(chain, segment)
+	}
+	return chain, nil
+}
+
+// ReadArchivedBinlogEvents calls callback with each of the raw binlog events
+// of a segment of the binlog archive, in order.
+func ReadArchivedBinlogEvents(ctx context.Context, segment ArchivedBinlogSegment, callback func(event []byte) error) error {
+	rc, err := segment.Handle.ReadFile(ctx, incrementalBackupFileName)
+	if err != nil {
+		return vterrors.Wrapf(err, "cannot read binlog segment %v", segment.Handle.Name())
+	}
+	defer rc.Close()
+	return readBinlogEvents(bufio.NewReaderSize(rc, writerBufferSize), callback)
+}
+
+// readBinlogEvents reads the events of a binary log file.
+func readBinlogEvents(r io.Reader, callback func(event []byte) error) error {
+	magic := make([]byte, len(binlogMagic))
+	if _, err := io.ReadFull(r, magic); err != nil {
+		return vterrors.Wrap(err, "cannot read binlog header")
+	}
+	if !bytes.Equal(magic, binlogMagic) {
+		return vterrors.Errorf(vtrpc.Code_DATA_LOSS, "invalid binlog header %x", magic)
+	}
+	header := make([]byte, binlogEventHeaderLength)
+	for {
+		if _, err := io.ReadFull(r, header); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return vterrors.Wrap(err, "cannot read binlog event header")
+		}
+		length := binary.LittleEndian.Uint32(header[9:13])
+		if length < binlogEventHeaderLength {
+			return vterrors.Errorf(vtrpc.Code_DATA_LOSS, "invalid binlog event length %v", length)
+		}
+		event := make([]byte, length)
+		copy(event, header)
+		if _, err := io.ReadFull(r, event[binlogEventHeaderLength:]); err != nil {
+			return vterrors.Wrap(err, "cannot read binlog event")
+		}
+		if err := callback(event); err != nil {
+			return err
+		}
+	}
+}
+
+// RestoreFromBinlogArchive applies the transactions of the binlog archive of
+// a shard after a position, typically the one of the backup the mysqld was
+// restored from, up to stopTime. It returns false if the archive has no
+// transaction after the position.
+func RestoreFromBinlogArchive(ctx context.Context, logger logutil.Logger, bs backupstorage.BackupStorage, mysqld MysqlDaemon, keyspace, shard string, from mysql.Position, stopTime time.Time) (bool, error) {
+	segments, err := FindArchivedBinlogs(ctx, logger, bs, keyspace, shard, from)
+	if err != nil || len(segments) == 0 {
+		return false, err
+	}
+
+	dir, err := os.MkdirTemp("", "binlogarchive")
+	if err != nil {
+		return false, err
+	}
+	defer os.RemoveAll(dir)
+
+	var files []string
+	for _, segment := range segments {
+		if segment.Manifest.FirstTimestamp > stopTime.Unix() {
+			break
+		}
+		file := path.Join(dir, segment.Handle.Name())
+		if err := copyArchivedBinlog(ctx, segment, file); err != nil {
+			return false, err
+		}
+		files = append(files, file)
+	}
+	if len(files) == 0 {
+		return false, nil
+	}
+	logger.Infof("Applying %v binlog segments of the archive of %v/%v from %v, up to %v", len(files), keyspace, shard, from, stopTime)
+	if err := mysqld.ApplyBinlogFiles(ctx, files, stopTime); err != nil {
+		return false, vterrors.Wrap(err, "cannot apply the archived binlogs")
+	}
+	return true, nil
+}
+
+func copyArchivedBinlog(ctx context.Context, segment ArchivedBinlogSegment, file string) error {
+	rc, err := segment.Handle.ReadFile(ctx, incrementalBackupFileName)
+	if err != nil {
+		return vterrors.Wrapf(err, "cannot read binlog segment %v", segment.Handle.Name())
+	}
+	defer rc.Close()
+	f, err := os.Create(file)
+	if err != nil {
+		return err
+	}
+	hasher := newHasher()
+	if _, err := io.Copy(io.MultiWriter(f, hasher), rc); err != nil {
+		f.Close()
+		return vterrors.Wrapf(err, "cannot copy binlog segment %v", segment.Handle.Name())
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	if hash := hasher.HashString(); hash != segment.Manifest.Hash {
+		return vterrors.Errorf(vtrpc.Code_DATA_LOSS, "binlog segment %v has hash %v, expected %v", segment.Handle.Name(), hash, segment.Manifest.Hash)
+	}
+	return nil
+}
+
+// ApplyBinlogFiles is part of the MysqlDaemon interface. It replays the
+// binlog files with mysqlbinlog, piped to the mysql client. The transactions
+// whose GTIDs the mysqld already executed are skipped.
+func (mysqld *Mysqld) ApplyBinlogFiles(ctx context.Context, files []string, stopTime time.Time) error {
+	dir, err := vtenv.VtMysqlRoot()
+	if err != nil {
+		return err
+	}
+	name, err := binaryPath(dir, "mysqlbinlog")
+	if err != nil {
+		return err
+	}
+	params, err := mysqld.dbcfgs.DbaConnector().MysqlParams()
+	if err != nil {
+		return err
+	}
+	ldPaths, err := buildLdPaths()
+	if err != nil {
+		return err
+	}
+
+	var args []string
+	if !stopTime.IsZero() {
+		args = append(args, "--stop-datetime="+stopTime.UTC().Format("2006-01-02 15:04:05"))
+	}
+	cmd := exec.CommandContext(ctx, name, append(args, files...)...)
+	// mysqlbinlog reads the stop time in the time zone of the environment.
+	cmd.Env = append(ldPaths, "TZ=UTC")
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return err
+	}
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+	err = mysqld.executeMysqlScript(params, stdout)
+	if waitErr := cmd.Wait(); err == nil && waitErr != nil {
+		err = fmt.Errorf("%v failed: %v, %v", name, waitErr, stderr.String())
+	}
+	return err
+}
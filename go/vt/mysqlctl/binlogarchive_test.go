@@ -0,0 +1,214 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+	"vitess.io/vitess/go/vt/mysqlctl/filebackupstorage"
+)
+
+// binlogApplier records the binlog files applied to a mysqld.
+type binlogApplier struct {
+	MysqlDaemon
+	files    [][]byte
+	stopTime time.Time
+}
+
+func (ba *binlogApplier) ApplyBinlogFiles(ctx context.Context, files []string, stopTime time.Time) error {
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		ba.files = append(ba.files, data)
+	}
+	ba.stopTime = stopTime
+	return nil
+}
+
+// binlogEvent returns a fake binlog event with a body.
+func binlogEvent(body string) []byte {
+	event := make([]byte, binlogEventHeaderLength+len(body))
+	binary.LittleEndian.PutUint32(event[9:13], uint32(len(event)))
+	copy(event[binlogEventHeaderLength:], body)
+	return event
+}
+
+func TestBinlogArchive(t *testing.T) {
+	root, err := os.MkdirTemp("", "binlogarchivetest")
+	require.NoError(t, err)
+	defer os.RemoveAll(root)
+
+	oldRoot := *filebackupstorage.FileBackupStorageRoot
+	defer func() { *filebackupstorage.FileBackupStorageRoot = oldRoot }()
+	*filebackupstorage.FileBackupStorageRoot = root
+
+	ctx := context.Background()
+	bs := &filebackupstorage.FileBackupStorage{}
+	now := time.Now().UTC()
+	logger := logutil.NewMemoryLogger()
+
+	position := func(s string) mysql.Position {
+		pos, err := mysql.DecodePosition("MySQL56/16b1039f-22b6-11ed-b765-0a43f95f28a3:" + s)
+		require.NoError(t, err)
+		return pos
+	}
+	addFile := func(bh backupstorage.BackupHandle, name string, data []byte) {
+		wc, err := bh.AddFile(ctx, name, backupstorage.FileSizeUnknown)
+		require.NoError(t, err)
+		_, err = wc.Write(data)
+		require.NoError(t, err)
+		require.NoError(t, wc.Close())
+	}
+	segmentNum := 0
+	addSegment := func(shard string, from, to string, firstTimestamp int64, events ...[]byte) []byte {
+		segmentNum++
+		bh, err := bs.StartBackup(ctx, GetBinlogArchiveDir("ks", shard), fmt.Sprintf("%v.zone1-0000000100", now.Add(time.Duration(segmentNum)*time.Second).Format(BackupTimestampFormat)))
+		require.NoError(t, err)
+		binlog := append([]byte{}, binlogMagic...)
+		for _, event := range events {
+			binlog = append(binlog, event...)
+		}
+		hasher := newHasher()
+		hasher.Write(binlog)
+		addFile(bh, incrementalBackupFileName, binlog)
+		data, err := json.Marshal(&BinlogSegmentManifest{
+			BackupManifest: BackupManifest{
+				BackupMethod: BinlogArchiveMethod,
+				Position:     position(to),
+			},
+			FromPosition:   position(from),
+			FirstTimestamp: firstTimestamp,
+			LastTimestamp:  firstTimestamp,
+			Hash:           hasher.HashString(),
+			Size:           hasher.size,
+		})
+		require.NoError(t, err)
+		addFile(bh, backupManifestFileName, data)
+		require.NoError(t, bh.EndBackup(ctx))
+		return binlog
+	}
+	find := func(shard, from string) ([]string, error) {
+		segments, err := FindArchivedBinlogs(ctx, logger, bs, "ks", shard, position(from))
+		var positions []string
+		for _, segment := range segments {
+			positions = append(positions, segment.Manifest.FromPosition.GTIDSet.String()+"/"+segment.Manifest.Position.GTIDSet.String())
+		}
+		return positions, err
+	}
+
+	// The archive starts from the last backup.
+	_, err = nextArchivePosition(ctx, logger, bs, "ks", "chain")
+	require.NoError(t, err)
+	bh, err := bs.StartBackup(ctx, GetBackupDir("ks", "chain"), "backup")
+	require.NoError(t, err)
+	data, err := json.Marshal(&BackupManifest{BackupMethod: builtinBackupEngineName, Position: position("1-10")})
+	require.NoError(t, err)
+	addFile(bh, backupManifestFileName, data)
+	require.NoError(t, bh.EndBackup(ctx))
+	pos, err := nextArchivePosition(ctx, logger, bs, "ks", "chain")
+	require.NoError(t, err)
+	assert.True(t, pos.Equal(position("1-10")), "got %v", pos)
+
+	// A chain of segments, which the archive continues from.
+	first := addSegment("chain", "1-10", "1-20", 100, binlogEvent("a"), binlogEvent("bb"))
+	second := addSegment("chain", "1-20", "1-30", 200, binlogEvent("ccc"))
+	pos, err = nextArchivePosition(ctx, logger, bs, "ks", "chain")
+	require.NoError(t, err)
+	assert.True(t, pos.Equal(position("1-30")), "got %v", pos)
+
+	positions, err := find("chain", "1-10")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"16b1039f-22b6-11ed-b765-0a43f95f28a3:1-10/16b1039f-22b6-11ed-b765-0a43f95f28a3:1-20", "16b1039f-22b6-11ed-b765-0a43f95f28a3:1-20/16b1039f-22b6-11ed-b765-0a43f95f28a3:1-30"}, positions)
+	positions, err = find("chain", "1-25")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"16b1039f-22b6-11ed-b765-0a43f95f28a3:1-20/16b1039f-22b6-11ed-b765-0a43f95f28a3:1-30"}, positions)
+	positions, err = find("chain", "1-30")
+	require.NoError(t, err)
+	assert.Empty(t, positions)
+	_, err = find("chain", "1-5")
+	assert.EqualError(t, err, "the binlog archive of ks/chain has no segment from position 16b1039f-22b6-11ed-b765-0a43f95f28a3:1-5")
+
+	// The chain stops at a gap.
+	addSegment("gap", "1-10", "1-20", 100, binlogEvent("a"))
+	addSegment("gap", "1-25", "1-30", 200, binlogEvent("b"))
+	positions, err = find("gap", "1-10")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"16b1039f-22b6-11ed-b765-0a43f95f28a3:1-10/16b1039f-22b6-11ed-b765-0a43f95f28a3:1-20"}, positions)
+
+	// The events of a segment.
+	segments, err := FindArchivedBinlogs(ctx, logger, bs, "ks", "chain", position("1-10"))
+	require.NoError(t, err)
+	var events [][]byte
+	err = ReadArchivedBinlogEvents(ctx, segments[0], func(event []byte) error {
+		events = append(events, event)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{binlogEvent("a"), binlogEvent("bb")}, events)
+
+	// The restores apply the segments up to the stop time.
+	applier := &binlogApplier{}
+	applied, err := RestoreFromBinlogArchive(ctx, logger, bs, applier, "ks", "chain", position("1-10"), time.Unix(150, 0))
+	require.NoError(t, err)
+	assert.True(t, applied)
+	assert.Equal(t, [][]byte{first}, applier.files)
+	assert.Equal(t, time.Unix(150, 0), applier.stopTime)
+
+	applier = &binlogApplier{}
+	applied, err = RestoreFromBinlogArchive(ctx, logger, bs, applier, "ks", "chain", position("1-10"), time.Unix(250, 0))
+	require.NoError(t, err)
+	assert.True(t, applied)
+	assert.Equal(t, [][]byte{first, second}, applier.files)
+
+	applier = &binlogApplier{}
+	applied, err = RestoreFromBinlogArchive(ctx, logger, bs, applier, "ks", "empty", position("1-10"), time.Unix(250, 0))
+	require.NoError(t, err)
+	assert.False(t, applied)
+	assert.Empty(t, applier.files)
+}
+
+func TestReadBinlogEvents(t *testing.T) {
+	binlog := append(append(append([]byte{}, binlogMagic...), binlogEvent("a")...), binlogEvent("")...)
+	var events [][]byte
+	err := readBinlogEvents(bytes.NewReader(binlog), func(event []byte) error {
+		events = append(events, event)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{binlogEvent("a"), binlogEvent("")}, events)
+
+	err = readBinlogEvents(bytes.NewReader([]byte("nope")), func(event []byte) error { return nil })
+	assert.EqualError(t, err, "invalid binlog header 6e6f7065")
+
+	err = readBinlogEvents(bytes.NewReader(binlog[:len(binlog)-3]), func(event []byte) error { return nil })
+	assert.Error(t, err)
+}
@@ -28,14 +28,12 @@ import (
 	"sync"
 	"time"
 
-	"github.com/klauspost/pgzip"
-	"github.com/planetscale/pargzip"
-
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/sync2"
 	"vitess.io/vitess/go/vt/concurrency"
 	"vitess.io/vitess/go/vt/hook"
 	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/logutil"
 	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
 	"vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/topo"
@@ -82,6 +80,16 @@ type builtinBackupManifest struct {
 	// false for backups that were created before the field existed, and those
 	// backups all had compression enabled.
 	SkipCompress bool
+
+	// CompressionEngine stores which compression engine was used to
+	// compress the files, if any. Backups created before the field
+	// existed were compressed with gzip, and leave it empty.
+	CompressionEngine string `json:",omitempty"`
+
+	// ExternalDecompressor is the command to use to decompress the files
+	// when CompressionEngine is external. It can be overridden by the
+	// external_decompressor flag at restore time.
+	ExternalDecompressor string `json:",omitempty"`
 }
 
 // FileEntry is one file to backup
@@ -98,6 +106,10 @@ type FileEntry struct {
 	// Hash is the hash of the final data (transformed and
 	// compressed if specified) stored in the BackupStorage.
 	Hash string
+
+	// Size is the size of the final data stored in the BackupStorage.
+	// It is 0 for backups taken before it was recorded.
+	Size int64 `json:",omitempty"`
 }
 
 func (fe *FileEntry) open(cnf *Mycnf, readOnly bool) (*os.File, error) {
@@ -139,6 +151,15 @@ func (fe *FileEntry) open(cnf *Mycnf, readOnly bool) (*os.File, error) {
 func (be *BuiltinBackupEngine) ExecuteBackup(ctx context.Context, params BackupParams, bh backupstorage.BackupHandle) (bool, error) {
 
 	params.Logger.Infof("Hook: %v, Compress: %v", *backupStorageHook, *backupStorageCompress)
+	if *backupStorageCompress {
+		if params.CompressionEngine == "" {
+			params.CompressionEngine = *CompressionEngineName
+		}
+		if err := validateCompressionEngine(params.CompressionEngine); err != nil {
+			return false, vterrors.Wrap(err, "invalid compression settings")
+		}
+		params.Logger.Infof("Compression engine: %v", params.CompressionEngine)
+	}
 
 	// Save initial state so we can restore.
 	replicaStartRequired := false
@@ -348,6 +369,12 @@ func (be *BuiltinBackupEngine) backupFiles(ctx context.Context, params BackupPar
 		TransformHook: *backupStorageHook,
 		SkipCompress:  !*backupStorageCompress,
 	}
+	if *backupStorageCompress {
+		bm.CompressionEngine = params.CompressionEngine
+		if bm.CompressionEngine == ExternalCompressor {
+			bm.ExternalDecompressor = *ExternalDecompressorCmd
+		}
+	}
 	data, err := json.MarshalIndent(bm, "", "  ")
 	if err != nil {
 		return vterrors.Wrapf(err, "cannot JSON encode %v", backupManifestFileName)
@@ -408,27 +435,27 @@ func (be *BuiltinBackupEngine) backupFile(ctx context.Context, params BackupPara
 		writer = pipe
 	}
 
-	// Create the gzip compression pipe, if necessary.
-	var gzip *pargzip.Writer
+	// Create the compression pipe, if necessary.
+	var compressor io.WriteCloser
 	if *backupStorageCompress {
-		gzip = pargzip.NewWriter(writer)
-		gzip.ChunkSize = *backupCompressBlockSize
-		gzip.Parallel = *backupCompressBlocks
-		gzip.CompressionLevel = pargzip.BestSpeed
-		writer = gzip
+		compressor, err = newCompressor(ctx, params.CompressionEngine, writer, params.Logger)
+		if err != nil {
+			return vterrors.Wrap(err, "can't create compressor")
+		}
+		writer = compressor
 	}
 
-	// Copy from the source file to writer (optional gzip,
+	// Copy from the source file to writer (optional compressor,
 	// optional pipe, tee, output file and hasher).
 	_, err = io.Copy(writer, source)
 	if err != nil {
 		return vterrors.Wrap(err, "cannot copy data")
 	}
 
-	// Close gzip to flush it, after that all data is sent to writer.
-	if gzip != nil {
-		if err = gzip.Close(); err != nil {
-			return vterrors.Wrap(err, "cannot close gzip")
+	// Close the compressor to flush it, after that all data is sent to writer.
+	if compressor != nil {
+		if err = compressor.Close(); err != nil {
+			return vterrors.Wrap(err, "cannot close compressor")
 		}
 	}
 
@@ -451,8 +478,9 @@ func (be *BuiltinBackupEngine) backupFile(ctx context.Context, params BackupPara
 		return vterrors.Wrapf(err, "cannot flush destination: %v", name)
 	}
 
-	// Save the hash.
+	// Save the hash and the size.
 	fe.Hash = hasher.HashString()
+	fe.Size = hasher.size
 	return nil
 }
 
@@ -478,6 +506,14 @@ func (be *BuiltinBackupEngine) ExecuteRestore(ctx context.Context, params Restor
 
 	params.Logger.Infof("Restore: copying %v files", len(bm.FileEntries))
 
+	// The total is 0, meaning unknown, for backups taken before the size of
+	// the files was recorded.
+	var totalSize int64
+	for _, fe := range bm.FileEntries {
+		totalSize += fe.Size
+	}
+	restoreProgress.expect(len(bm.FileEntries), totalSize)
+
 	if err := be.restoreFiles(context.Background(), params, bh, bm); err != nil {
 		// don't delete the file here because that is how we detect an interrupted restore
 		return nil, vterrors.Wrap(err, "failed to restore files")
@@ -510,10 +546,12 @@ func (be *BuiltinBackupEngine) restoreFiles(ctx context.Context, params RestoreP
 			// And restore the file.
 			name := fmt.Sprintf("%v", i)
 			params.Logger.Infof("Copying file %v: %v", name, fes[i].Name)
-			err := be.restoreFile(ctx, params, bh, &fes[i], bm.TransformHook, !bm.SkipCompress, name)
+			err := be.restoreFile(ctx, params, bh, &fes[i], bm, name)
 			if err != nil {
 				rec.RecordError(vterrors.Wrapf(err, "can't restore file %v to %v", name, fes[i].Name))
+				return
 			}
+			restoreProgress.fileRestored()
 		}(i)
 	}
 	wg.Wait()
@@ -521,7 +559,8 @@ func (be *BuiltinBackupEngine) restoreFiles(ctx context.Context, params RestoreP
 }
 
 // restoreFile restores an individual file.
-func (be *BuiltinBackupEngine) restoreFile(ctx context.Context, params RestoreParams, bh backupstorage.BackupHandle, fe *FileEntry, transformHook string, compress bool, name string) (finalErr error) {
+func (be *BuiltinBackupEngine) restoreFile(ctx context.Context, params RestoreParams, bh backupstorage.BackupHandle, fe *FileEntry, bm builtinBackupManifest, name string) (finalErr error) {
+	transformHook := bm.TransformHook
 	// Open the source file for reading.
 	source, err := bh.ReadFile(ctx, name)
 	if err != nil {
@@ -552,7 +591,7 @@ func (be *BuiltinBackupEngine) restoreFile(ctx context.Context, params RestorePa
 	hasher := newHasher()
 
 	// Create a Tee: we split the input into the hasher
-	// and into the gunziper.
+	// and into the decompressor.
 	reader := io.TeeReader(source, hasher)
 
 	// Create the external read pipe, if any.
@@ -567,22 +606,27 @@ func (be *BuiltinBackupEngine) restoreFile(ctx context.Context, params RestorePa
 	}
 
 	// Create the uncompresser if needed.
-	if compress {
-		gz, err := pgzip.NewReader(reader)
+	if !bm.SkipCompress {
+		engine := bm.CompressionEngine
+		if engine == "" {
+			// Backups taken before the engine was recorded are gzip.
+			engine = PgzipCompressor
+		}
+		decompressor, err := newDecompressor(ctx, engine, bm.ExternalDecompressor, reader, params.Logger)
 		if err != nil {
-			return vterrors.Wrap(err, "can't open gzip decompressor")
+			return vterrors.Wrap(err, "can't create decompressor")
 		}
 		defer func() {
-			if cerr := gz.Close(); cerr != nil {
+			if cerr := decompressor.Close(); cerr != nil {
 				if finalErr != nil {
 					// We already have an error, just log this one.
-					log.Errorf("failed to close gzip decompressor %v: %v", name, cerr)
+					log.Errorf("failed to close decompressor %v: %v", name, cerr)
 				} else {
-					finalErr = vterrors.Wrap(err, "failed to close gzip decompressor")
+					finalErr = vterrors.Wrap(cerr, "failed to close decompressor")
 				}
 			}
 		}()
-		reader = gz
+		reader = decompressor
 	}
 
 	// Copy the data. Will also write to the hasher.
@@ -615,6 +659,52 @@ func (be *BuiltinBackupEngine) restoreFile(ctx context.Context, params RestorePa
 	return nil
 }
 
+// VerifyBackup is part of the BackupVerifier interface. Since the hashes in
+// the MANIFEST are computed on the stored data, files don't need to go
+// through the transform hook or the decompressor to be checked.
+func (be *BuiltinBackupEngine) VerifyBackup(ctx context.Context, logger logutil.Logger, bh backupstorage.BackupHandle, checksums bool) error {
+	var bm builtinBackupManifest
+	if err := getBackupManifestInto(ctx, bh, &bm); err != nil {
+		return err
+	}
+
+	for i := range bm.FileEntries {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		name := fmt.Sprintf("%v", i)
+		if err := be.verifyFile(ctx, bh, &bm.FileEntries[i], name, checksums); err != nil {
+			return vterrors.Wrapf(err, "backup file %v (%v) failed verification", name, bm.FileEntries[i].Name)
+		}
+	}
+
+	logger.Infof("VerifyBackup: %v files of %v/%v verified (checksums: %v)", len(bm.FileEntries), bh.Directory(), bh.Name(), checksums)
+	return nil
+}
+
+// verifyFile checks that a file of the backup can be opened, and compares
+// its hash with the MANIFEST if needed.
+func (be *BuiltinBackupEngine) verifyFile(ctx context.Context, bh backupstorage.BackupHandle, fe *FileEntry, name string, checksums bool) error {
+	source, err := bh.ReadFile(ctx, name)
+	if err != nil {
+		return vterrors.Wrap(err, "can't open file for reading")
+	}
+	defer source.Close()
+
+	if !checksums {
+		return nil
+	}
+
+	hasher := newHasher()
+	if _, err := io.Copy(hasher, source); err != nil {
+		return vterrors.Wrap(err, "failed to read file contents")
+	}
+	if hash := hasher.HashString(); hash != fe.Hash {
+		return vterrors.Errorf(vtrpc.Code_DATA_LOSS, "hash mismatch, got %v expected %v", hash, fe.Hash)
+	}
+	return nil
+}
+
 // ShouldDrainForBackup satisfies the BackupEngine interface
 // backup requires query service to be stopped, hence true
 func (be *BuiltinBackupEngine) ShouldDrainForBackup() bool {
@@ -14,6 +14,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/mysql/fakesqldb"
 	"vitess.io/vitess/go/vt/logutil"
 	"vitess.io/vitess/go/vt/mysqlctl"
@@ -134,3 +135,121 @@ func TestExecuteBackup(t *testing.T) {
 	assert.Error(t, err)
 	assert.False(t, ok)
 }
+
+func TestVerifyBackup(t *testing.T) {
+	// Set up local backup directory
+	backupRoot := "testdata/builtinbackup_verify_test"
+	*filebackupstorage.FileBackupStorageRoot = path.Join(backupRoot, "backups")
+	require.NoError(t, createBackupDir(backupRoot, "innodb", "log", "datadir/vt_db", "backups"))
+	defer os.RemoveAll(backupRoot)
+	require.NoError(t, os.WriteFile(path.Join(backupRoot, "datadir/vt_db/t1.ibd"), []byte("some table data"), 0644))
+
+	ctx := context.Background()
+	keyspace, shard := "mykeyspace", "-80"
+	ts := memorytopo.NewServer("cell1")
+	defer ts.Close()
+
+	require.NoError(t, ts.CreateKeyspace(ctx, keyspace, &topodata.Keyspace{}))
+	require.NoError(t, ts.CreateShard(ctx, keyspace, shard))
+
+	bs := &filebackupstorage.FileBackupStorage{}
+	bh, err := bs.StartBackup(ctx, path.Join(keyspace, shard), "mybackup")
+	require.NoError(t, err)
+
+	// As a primary, the backup doesn't need to touch replication.
+	mysqld := fakemysqldaemon.NewFakeMysqlDaemon(fakesqldb.New(t))
+	mysqld.ReplicationStatusError = mysql.ErrNotReplica
+	be := &mysqlctl.BuiltinBackupEngine{}
+	ok, err := be.ExecuteBackup(ctx, mysqlctl.BackupParams{
+		Logger: logutil.NewConsoleLogger(),
+		Mysqld: mysqld,
+		Cnf: &mysqlctl.Mycnf{
+			InnodbDataHomeDir:     path.Join(backupRoot, "innodb"),
+			InnodbLogGroupHomeDir: path.Join(backupRoot, "log"),
+			DataDir:               path.Join(backupRoot, "datadir"),
+		},
+		Concurrency:  1,
+		HookExtraEnv: map[string]string{},
+		TopoServer:   ts,
+		Keyspace:     keyspace,
+		Shard:        shard,
+	}, bh)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.NoError(t, bh.EndBackup(ctx))
+
+	bhs, err := bs.ListBackups(ctx, path.Join(keyspace, shard))
+	require.NoError(t, err)
+	require.Len(t, bhs, 1)
+
+	logger := logutil.NewMemoryLogger()
+	assert.NoError(t, mysqlctl.VerifyBackup(ctx, logger, bhs[0], false))
+	assert.NoError(t, mysqlctl.VerifyBackup(ctx, logger, bhs[0], true))
+
+	// Corrupting the file is only caught when checking checksums.
+	backupFile := path.Join(*filebackupstorage.FileBackupStorageRoot, keyspace, shard, "mybackup", "0")
+	require.NoError(t, os.WriteFile(backupFile, []byte("corrupted"), 0644))
+	assert.NoError(t, mysqlctl.VerifyBackup(ctx, logger, bhs[0], false))
+	assert.Error(t, mysqlctl.VerifyBackup(ctx, logger, bhs[0], true))
+
+	// A missing file is caught at the MANIFEST level.
+	require.NoError(t, os.Remove(backupFile))
+	assert.Error(t, mysqlctl.VerifyBackup(ctx, logger, bhs[0], false))
+}
+
+func TestExecuteBackupCompressionEngine(t *testing.T) {
+	backupRoot := "testdata/builtinbackup_compression_test"
+	*filebackupstorage.FileBackupStorageRoot = path.Join(backupRoot, "backups")
+	require.NoError(t, createBackupDir(backupRoot, "innodb", "log", "datadir/vt_db", "backups"))
+	defer os.RemoveAll(backupRoot)
+	require.NoError(t, os.WriteFile(path.Join(backupRoot, "datadir/vt_db/t1.ibd"), []byte("some table data"), 0644))
+
+	ctx := context.Background()
+	keyspace, shard := "mykeyspace", "-80"
+	bs := &filebackupstorage.FileBackupStorage{}
+	bh, err := bs.StartBackup(ctx, path.Join(keyspace, shard), "mybackup")
+	require.NoError(t, err)
+
+	// The engine of the backup takes precedence over -compression_engine_name.
+	mysqld := fakemysqldaemon.NewFakeMysqlDaemon(fakesqldb.New(t))
+	mysqld.ReplicationStatusError = mysql.ErrNotReplica
+	be := &mysqlctl.BuiltinBackupEngine{}
+	ok, err := be.ExecuteBackup(ctx, mysqlctl.BackupParams{
+		Logger: logutil.NewConsoleLogger(),
+		Mysqld: mysqld,
+		Cnf: &mysqlctl.Mycnf{
+			InnodbDataHomeDir:     path.Join(backupRoot, "innodb"),
+			InnodbLogGroupHomeDir: path.Join(backupRoot, "log"),
+			DataDir:               path.Join(backupRoot, "datadir"),
+		},
+		Concurrency:       1,
+		HookExtraEnv:      map[string]string{},
+		Keyspace:          keyspace,
+		Shard:             shard,
+		CompressionEngine: mysqlctl.ZstdCompressor,
+	}, bh)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.NoError(t, bh.EndBackup(ctx))
+
+	manifest, err := os.ReadFile(path.Join(*filebackupstorage.FileBackupStorageRoot, keyspace, shard, "mybackup", "MANIFEST"))
+	require.NoError(t, err)
+	assert.Contains(t, string(manifest), `"CompressionEngine": "zstd"`)
+
+	bhs, err := bs.ListBackups(ctx, path.Join(keyspace, shard))
+	require.NoError(t, err)
+	require.Len(t, bhs, 1)
+	assert.NoError(t, mysqlctl.VerifyBackup(ctx, logutil.NewMemoryLogger(), bhs[0], true))
+
+	// An unknown engine fails the backup before it starts.
+	bh, err = bs.StartBackup(ctx, path.Join(keyspace, shard), "badbackup")
+	require.NoError(t, err)
+	ok, err = be.ExecuteBackup(ctx, mysqlctl.BackupParams{
+		Logger:            logutil.NewConsoleLogger(),
+		Mysqld:            mysqld,
+		Cnf:               &mysqlctl.Mycnf{},
+		CompressionEngine: "snappy",
+	}, bh)
+	assert.Error(t, err)
+	assert.False(t, ok)
+}
@@ -19,6 +19,7 @@ limitations under the License.
 package cephbackupstorage
 
 import (
+	"bytes"
 	"encoding/json"
 	"flag"
 	"fmt"
@@ -117,6 +118,14 @@ func (bh *CephBackupHandle) AddFile(ctx context.Context, filename string, filesi
 	return writer, nil
 }
 
+// WriteMetadataFile implements MetadataWriter.
+func (bh *CephBackupHandle) WriteMetadataFile(ctx context.Context, filename string, data []byte) error {
+	bucket := alterBucketName(bh.dir)
+	object := objName(bh.dir, bh.name, filename)
+	_, err := bh.client.PutObjectWithContext(ctx, bucket, object, bytes.NewReader(data), int64(len(data)), minio.PutObjectOptions{ContentType: "application/octet-stream"})
+	return err
+}
+
 // EndBackup implements BackupHandle.
 func (bh *CephBackupHandle) EndBackup(ctx context.Context) error {
 	if bh.readOnly {
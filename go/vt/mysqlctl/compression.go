@@ -0,0 +1,311 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os/exec"
+	"strings"
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/klauspost/pgzip"
+	"github.com/pierrec/lz4/v4"
+	"github.com/planetscale/pargzip"
+
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// This file contains the compression abstraction used by the backup engines.
+
+const (
+	// PgzipCompressor is the name of the pgzip compression engine.
+	PgzipCompressor = "pgzip"
+	// PargzipCompressor is the name of the pargzip compression engine.
+	// It produces regular gzip output, and is what backups used before
+	// the compression engine was configurable.
+	PargzipCompressor = "pargzip"
+	// ZstdCompressor is the name of the zstd compression engine.
+	ZstdCompressor = "zstd"
+	// Lz4Compressor is the name of the lz4 compression engine.
+	Lz4Compressor = "lz4"
+	// ExternalCompressor is the name used when compression and
+	// decompression are done by external commands.
+	ExternalCompressor = "external"
+)
+
+var (
+	// CompressionEngineName is the engine used to compress new backups.
+	CompressionEngineName = flag.String("compression_engine_name", PargzipCompressor, "compressor engine used for compression: pgzip, pargzip, zstd, lz4 or external. If external is used, external_compressor must be set. The engine is recorded in the backup manifest so restores pick the matching decompressor.")
+	// CompressionLevel is the compression level handed to the builtin engines.
+	CompressionLevel = flag.Int("compression_level", 1, "what level to pass to the builtin compressor engines (1 is the fastest setting for all of them)")
+	// ExternalCompressorCmd is the command used to compress backups when
+	// the engine is external.
+	ExternalCompressorCmd = flag.String("external_compressor", "", "command with arguments to use when compressing a backup, it must read from stdin and write to stdout")
+	// ExternalCompressorExt is the file extension recorded for backups
+	// compressed with an external command.
+	ExternalCompressorExt = flag.String("external_compressor_extension", "", "extension to use when using an external compressor")
+	// ExternalDecompressorCmd overrides the decompressor recorded in the
+	// manifest at restore time.
+	ExternalDecompressorCmd = flag.String("external_decompressor", "", "command with arguments to use when decompressing a backup, overrides the value stored in the manifest")
+
+	errUnsupportedCompressionEngine   = vterrors.New(vtrpc.Code_INVALID_ARGUMENT, "unsupported engine")
+	errUnsupportedDecompressionEngine = vterrors.New(vtrpc.Code_INVALID_ARGUMENT, "unsupported engine in MANIFEST")
+)
+
+// getExtensionFromEngine returns the file extension used for files
+// compressed with the given engine.
+func getExtensionFromEngine(engine string) (string, error) {
+	switch engine {
+	case PgzipCompressor, PargzipCompressor:
+		return ".gz", nil
+	case ZstdCompressor:
+		return ".zst", nil
+	case Lz4Compressor:
+		return ".lz4", nil
+	case ExternalCompressor:
+		return *ExternalCompressorExt, nil
+	}
+	return "", vterrors.Wrapf(errUnsupportedCompressionEngine, "%q", engine)
+}
+
+// validateExternalCmd checks that the binary of an external command
+// exists in the PATH, and returns its full path.
+func validateExternalCmd(cmd string) (string, error) {
+	if cmd == "" {
+		return "", vterrors.New(vtrpc.Code_INVALID_ARGUMENT, "external command is empty")
+	}
+	return exec.LookPath(cmd)
+}
+
+// prepareExternalCmd splits a command line into its binary and arguments
+// and returns the matching exec.Cmd.
+func prepareExternalCmd(ctx context.Context, cmdStr string) (*exec.Cmd, error) {
+	cmdArgs := strings.Fields(cmdStr)
+	if len(cmdArgs) < 1 {
+		return nil, vterrors.New(vtrpc.Code_INVALID_ARGUMENT, "external command is empty")
+	}
+	cmdPath, err := validateExternalCmd(cmdArgs[0])
+	if err != nil {
+		return nil, err
+	}
+	return exec.CommandContext(ctx, cmdPath, cmdArgs[1:]...), nil
+}
+
+// externalCompressor pipes everything written to it through the stdin of
+// an external command, whose stdout goes to the wrapped writer.
+type externalCompressor struct {
+	cmd    *exec.Cmd
+	stdin  io.WriteCloser
+	stderr strings.Builder
+	logger logutil.Logger
+}
+
+func newExternalCompressor(ctx context.Context, cmdStr string, writer io.Writer, logger logutil.Logger) (io.WriteCloser, error) {
+	cmd, err := prepareExternalCmd(ctx, cmdStr)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "unable to start external command")
+	}
+	compressor := &externalCompressor{cmd: cmd, logger: logger}
+	cmd.Stdout = writer
+	cmd.Stderr = &compressor.stderr
+	if compressor.stdin, err = cmd.StdinPipe(); err != nil {
+		return nil, vterrors.Wrap(err, "cannot create external command stdin pipe")
+	}
+	logger.Infof("Compressing using external command: %q", cmdStr)
+	if err := cmd.Start(); err != nil {
+		return nil, vterrors.Wrap(err, "cannot start external command")
+	}
+	return compressor, nil
+}
+
+func (e *externalCompressor) Write(p []byte) (n int, err error) {
+	return e.stdin.Write(p)
+}
+
+// Close closes the stdin of the command, and waits for it to flush
+// its output and exit.
+func (e *externalCompressor) Close() error {
+	if err := e.stdin.Close(); err != nil {
+		return err
+	}
+	err := e.cmd.Wait()
+	if stderr := e.stderr.String(); stderr != "" {
+		e.logger.Infof("external compressor returned stderr: %v", stderr)
+	}
+	return err
+}
+
+// externalDecompressor reads the stdout of an external command, whose
+// stdin is fed from the wrapped reader.
+type externalDecompressor struct {
+	cmd    *exec.Cmd
+	stdout io.ReadCloser
+	stderr strings.Builder
+	logger logutil.Logger
+	once   sync.Once
+}
+
+func newExternalDecompressor(ctx context.Context, cmdStr string, reader io.Reader, logger logutil.Logger) (io.ReadCloser, error) {
+	cmd, err := prepareExternalCmd(ctx, cmdStr)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "unable to start external command")
+	}
+	decompressor := &externalDecompressor{cmd: cmd, logger: logger}
+	cmd.Stdin = reader
+	cmd.Stderr = &decompressor.stderr
+	if decompressor.stdout, err = cmd.StdoutPipe(); err != nil {
+		return nil, vterrors.Wrap(err, "cannot create external command stdout pipe")
+	}
+	logger.Infof("Decompressing using external command: %q", cmdStr)
+	if err := cmd.Start(); err != nil {
+		return nil, vterrors.Wrap(err, "cannot start external command")
+	}
+	return decompressor, nil
+}
+
+func (e *externalDecompressor) Read(p []byte) (n int, err error) {
+	return e.stdout.Read(p)
+}
+
+// Close waits for the command to exit. It must only be called after
+// the output was fully read.
+func (e *externalDecompressor) Close() (err error) {
+	e.once.Do(func() {
+		err = e.cmd.Wait()
+		if stderr := e.stderr.String(); stderr != "" {
+			e.logger.Infof("external decompressor returned stderr: %v", stderr)
+		}
+	})
+	return err
+}
+
+// newBuiltinCompressor returns a compressor for one of the builtin engines.
+func newBuiltinCompressor(engine string, writer io.Writer) (io.WriteCloser, error) {
+	switch engine {
+	case PgzipCompressor:
+		gzip, err := pgzip.NewWriterLevel(writer, *CompressionLevel)
+		if err != nil {
+			return nil, vterrors.Wrap(err, "cannot create pgzip compressor")
+		}
+		if err := gzip.SetConcurrency(*backupCompressBlockSize, *backupCompressBlocks); err != nil {
+			return nil, vterrors.Wrap(err, "cannot set pgzip concurrency")
+		}
+		return gzip, nil
+	case PargzipCompressor:
+		gzip := pargzip.NewWriter(writer)
+		gzip.ChunkSize = *backupCompressBlockSize
+		gzip.Parallel = *backupCompressBlocks
+		gzip.CompressionLevel = *CompressionLevel
+		return gzip, nil
+	case ZstdCompressor:
+		zst, err := zstd.NewWriter(writer, zstd.WithEncoderLevel(zstd.EncoderLevelFromZstd(*CompressionLevel)))
+		if err != nil {
+			return nil, vterrors.Wrap(err, "cannot create zstd compressor")
+		}
+		return zst, nil
+	case Lz4Compressor:
+		lz := lz4.NewWriter(writer)
+		if err := lz.Apply(lz4.CompressionLevelOption(lz4CompressionLevel(*CompressionLevel))); err != nil {
+			return nil, vterrors.Wrap(err, "cannot create lz4 compressor")
+		}
+		return lz, nil
+	}
+	return nil, vterrors.Wrapf(errUnsupportedCompressionEngine, "%q", engine)
+}
+
+// lz4Levels maps the 1-9 compression levels onto the lz4 ones.
+var lz4Levels = []lz4.CompressionLevel{lz4.Level1, lz4.Level2, lz4.Level3, lz4.Level4, lz4.Level5, lz4.Level6, lz4.Level7, lz4.Level8, lz4.Level9}
+
+// lz4CompressionLevel returns the lz4 level for a 1-9 level, with anything
+// lower than 1 meaning the fast mode.
+func lz4CompressionLevel(level int) lz4.CompressionLevel {
+	switch {
+	case level < 1:
+		return lz4.Fast
+	case level > len(lz4Levels):
+		level = len(lz4Levels)
+	}
+	return lz4Levels[level-1]
+}
+
+// newBuiltinDecompressor returns a decompressor for one of the builtin engines.
+func newBuiltinDecompressor(engine string, reader io.Reader) (io.ReadCloser, error) {
+	switch engine {
+	case PgzipCompressor, PargzipCompressor:
+		// pargzip only provides a compressor, its output is regular
+		// gzip that pgzip decompresses in parallel.
+		gz, err := pgzip.NewReader(reader)
+		if err != nil {
+			return nil, vterrors.Wrap(err, "can't open gzip decompressor")
+		}
+		return gz, nil
+	case ZstdCompressor:
+		zst, err := zstd.NewReader(reader)
+		if err != nil {
+			return nil, vterrors.Wrap(err, "can't open zstd decompressor")
+		}
+		return zst.IOReadCloser(), nil
+	case Lz4Compressor:
+		return io.NopCloser(lz4.NewReader(reader)), nil
+	}
+	return nil, vterrors.Wrapf(errUnsupportedDecompressionEngine, "%q", engine)
+}
+
+// newCompressor returns the compressor matching the current flags.
+func newCompressor(ctx context.Context, engine string, writer io.Writer, logger logutil.Logger) (io.WriteCloser, error) {
+	if engine == ExternalCompressor {
+		return newExternalCompressor(ctx, *ExternalCompressorCmd, writer, logger)
+	}
+	return newBuiltinCompressor(engine, writer)
+}
+
+// newDecompressor returns the decompressor for a backup compressed with
+// engine. externalCmd is the decompression command recorded in the
+// manifest; for the external engine, the external_decompressor flag
+// takes precedence over it.
+func newDecompressor(ctx context.Context, engine, externalCmd string, reader io.Reader, logger logutil.Logger) (io.ReadCloser, error) {
+	if engine == ExternalCompressor {
+		if *ExternalDecompressorCmd != "" {
+			externalCmd = *ExternalDecompressorCmd
+		}
+		if externalCmd == "" {
+			return nil, vterrors.New(vtrpc.Code_FAILED_PRECONDITION, "backup was compressed with an external command, but no external_decompressor is set or recorded in the MANIFEST")
+		}
+		return newExternalDecompressor(ctx, externalCmd, reader, logger)
+	}
+	return newBuiltinDecompressor(engine, reader)
+}
+
+// validateCompressionEngine checks that the compression engine of a backup
+// and the compression flags are consistent before the backup starts.
+func validateCompressionEngine(engine string) error {
+	if _, err := getExtensionFromEngine(engine); err != nil {
+		return err
+	}
+	if engine == ExternalCompressor {
+		if _, err := prepareExternalCmd(context.Background(), *ExternalCompressorCmd); err != nil {
+			return fmt.Errorf("invalid external_compressor %q: %v", *ExternalCompressorCmd, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/logutil"
+)
+
+func compressAndDecompress(t *testing.T, engine, decompressCmd string, data []byte) []byte {
+	t.Helper()
+	ctx := context.Background()
+	logger := logutil.NewMemoryLogger()
+
+	var compressed bytes.Buffer
+	compressor, err := newCompressor(ctx, engine, &compressed, logger)
+	require.NoError(t, err)
+	_, err = compressor.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, compressor.Close())
+	require.NotEqual(t, data, compressed.Bytes())
+
+	decompressor, err := newDecompressor(ctx, engine, decompressCmd, &compressed, logger)
+	require.NoError(t, err)
+	out, err := io.ReadAll(decompressor)
+	require.NoError(t, err)
+	require.NoError(t, decompressor.Close())
+	return out
+}
+
+func TestBuiltinCompressors(t *testing.T) {
+	data := []byte(strings.Repeat("This is a test of the compression engines. ", 10000))
+
+	for _, engine := range []string{PgzipCompressor, PargzipCompressor, ZstdCompressor, Lz4Compressor} {
+		t.Run(engine, func(t *testing.T) {
+			assert.Equal(t, data, compressAndDecompress(t, engine, "", data))
+		})
+	}
+}
+
+func TestGzipEnginesAreCompatible(t *testing.T) {
+	// Backups taken before the engine was recorded were compressed
+	// with pargzip and must still be readable with the default engine.
+	data := []byte(strings.Repeat("gzip ", 10000))
+
+	var compressed bytes.Buffer
+	compressor, err := newBuiltinCompressor(PargzipCompressor, &compressed)
+	require.NoError(t, err)
+	_, err = compressor.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, compressor.Close())
+
+	decompressor, err := newBuiltinDecompressor(PgzipCompressor, &compressed)
+	require.NoError(t, err)
+	out, err := io.ReadAll(decompressor)
+	require.NoError(t, err)
+	assert.Equal(t, data, out)
+}
+
+func TestExternalCompressor(t *testing.T) {
+	if _, err := exec.LookPath("gzip"); err != nil {
+		t.Skip("gzip is not in the PATH")
+	}
+	oldCmd := *ExternalCompressorCmd
+	defer func() { *ExternalCompressorCmd = oldCmd }()
+	*ExternalCompressorCmd = "gzip -c"
+
+	data := []byte(strings.Repeat("external ", 10000))
+	assert.Equal(t, data, compressAndDecompress(t, ExternalCompressor, "gzip -d -c", data))
+
+	// An external backup can't be restored without a decompressor.
+	_, err := newDecompressor(context.Background(), ExternalCompressor, "", &bytes.Buffer{}, logutil.NewMemoryLogger())
+	assert.Error(t, err)
+}
+
+func TestValidateCompressionEngine(t *testing.T) {
+	oldCmd := *ExternalCompressorCmd
+	defer func() { *ExternalCompressorCmd = oldCmd }()
+
+	assert.NoError(t, validateCompressionEngine(ZstdCompressor))
+	assert.Error(t, validateCompressionEngine("snappy"))
+
+	*ExternalCompressorCmd = ""
+	assert.Error(t, validateCompressionEngine(ExternalCompressor))
+
+	*ExternalCompressorCmd = "command-that-does-not-exist -9"
+	assert.Error(t, validateCompressionEngine(ExternalCompressor))
+}
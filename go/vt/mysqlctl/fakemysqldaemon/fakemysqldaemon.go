@@ -18,6 +18,7 @@ package fakemysqldaemon
 
 import (
 	"fmt"
+	"os"
 	"reflect"
 	"strings"
 	"sync"
@@ -116,6 +117,9 @@ type FakeMysqlDaemon struct {
 	// SetReplicationSourceError is used by SetReplicationSource
 	SetReplicationSourceError error
 
+	// ReplicationDelay is the current delay set by SetReplicationDelay
+	ReplicationDelay time.Duration
+
 	// WaitPrimaryPosition is checked by WaitSourcePos, if the
 	// same it returns nil, if different it returns an error
 	WaitPrimaryPosition mysql.Position
@@ -160,6 +164,13 @@ type FakeMysqlDaemon struct {
 	// BinlogPlayerEnabled is used by {Enable,Disable}BinlogPlayer
 	BinlogPlayerEnabled sync2.AtomicBool
 
+	// AppliedBinlogFiles has the contents of the binlog files given to
+	// ApplyBinlogFiles, and AppliedBinlogStopTime its stop time.
+	AppliedBinlogFiles    [][]byte
+	AppliedBinlogStopTime time.Time
+	// ApplyBinlogFilesError is returned by ApplyBinlogFiles.
+	ApplyBinlogFilesError error
+
 	// SemiSyncMasterEnabled represents the state of rpl_semi_sync_master_enabled.
 	SemiSyncMasterEnabled bool
 	// SemiSyncReplicaEnabled represents the state of rpl_semi_sync_slave_enabled.
@@ -393,6 +404,12 @@ func (fmd *FakeMysqlDaemon) SetReplicationSource(ctx context.Context, host strin
 	return fmd.ExecuteSuperQueryList(ctx, cmds)
 }
 
+// SetReplicationDelay is part of the MysqlDaemon interface.
+func (fmd *FakeMysqlDaemon) SetReplicationDelay(ctx context.Context, delay time.Duration) error {
+	fmd.ReplicationDelay = delay
+	return nil
+}
+
 // WaitForReparentJournal is part of the MysqlDaemon interface
 func (fmd *FakeMysqlDaemon) WaitForReparentJournal(ctx context.Context, timeCreatedNS int64) error {
 	return nil
@@ -481,6 +498,22 @@ func (fmd *FakeMysqlDaemon) DisableBinlogPlayback() error {
 	return nil
 }
 
+// ApplyBinlogFiles is part of the MysqlDaemon interface
+func (fmd *FakeMysqlDaemon) ApplyBinlogFiles(ctx context.Context, files []string, stopTime time.Time) error {
+	if fmd.ApplyBinlogFilesError != nil {
+		return fmd.ApplyBinlogFilesError
+	}
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		fmd.AppliedBinlogFiles = append(fmd.AppliedBinlogFiles, data)
+	}
+	fmd.AppliedBinlogStopTime = stopTime
+	return nil
+}
+
 // Close is part of the MysqlDaemon interface
 func (fmd *FakeMysqlDaemon) Close() {
 	if fmd.appPool != nil {
@@ -106,6 +106,12 @@ func (fbh *FileBackupHandle) ReadFile(ctx context.Context, filename string) (io.
 	return os.Open(p)
 }
 
+// WriteMetadataFile is part of the MetadataWriter interface
+func (fbh *FileBackupHandle) WriteMetadataFile(ctx context.Context, filename string, data []byte) error {
+	p := path.Join(*FileBackupStorageRoot, fbh.dir, fbh.name, filename)
+	return ioutil.WriteFile(p, data, 0644)
+}
+
 // FileBackupStorage implements BackupStorage for local file system.
 type FileBackupStorage struct{}
 
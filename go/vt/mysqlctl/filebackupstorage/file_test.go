@@ -204,3 +204,39 @@ func TestFileContents(t *testing.T) {
 		t.Fatalf("rc.Close failed: %v", err)
 	}
 }
+
+func TestWriteMetadataFile(t *testing.T) {
+	fbs := setupFileBackupStorage(t)
+	defer cleanupFileBackupStorage(fbs)
+	ctx := context.Background()
+
+	dir := "keyspace/shard"
+	name := "cell-0001-2015-01-14-10-00-00"
+	bh, err := fbs.StartBackup(ctx, dir, name)
+	if err != nil {
+		t.Fatalf("fbs.StartBackup failed: %v", err)
+	}
+	if err := bh.EndBackup(ctx); err != nil {
+		t.Fatalf("bh.EndBackup failed: %v", err)
+	}
+
+	// the metadata files are written to the read-only backups, and replaced
+	bhs, err := fbs.ListBackups(ctx, dir)
+	if err != nil || len(bhs) != 1 {
+		t.Fatalf("ListBackups failed: %v %v", bhs, err)
+	}
+	for _, contents := range []string{"first", "second"} {
+		if err := bhs[0].(*FileBackupHandle).WriteMetadataFile(ctx, "metadata", []byte(contents)); err != nil {
+			t.Fatalf("WriteMetadataFile failed: %v", err)
+		}
+	}
+	rc, err := bhs[0].ReadFile(ctx, "metadata")
+	if err != nil {
+		t.Fatalf("ReadFile failed: %v", err)
+	}
+	defer rc.Close()
+	buf, err := ioutil.ReadAll(rc)
+	if err != nil || string(buf) != "second" {
+		t.Fatalf("ReadFile returned %q, %v", buf, err)
+	}
+}
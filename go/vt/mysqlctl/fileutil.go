@@ -27,10 +27,18 @@ import (
 // our hasher, implemented using crc32
 type hasher struct {
 	hash.Hash32
+
+	// size is the number of bytes hashed so far.
+	size int64
 }
 
 func newHasher() *hasher {
-	return &hasher{crc32.NewIEEE()}
+	return &hasher{Hash32: crc32.NewIEEE()}
+}
+
+func (h *hasher) Write(p []byte) (int, error) {
+	h.size += int64(len(p))
+	return h.Hash32.Write(p)
 }
 
 func (h *hasher) HashString() string {
@@ -90,6 +90,17 @@ func (bh *GCSBackupHandle) AddFile(ctx context.Context, filename string, filesiz
 	return bh.client.Bucket(*bucket).Object(object).NewWriter(ctx), nil
 }
 
+// WriteMetadataFile implements MetadataWriter.
+func (bh *GCSBackupHandle) WriteMetadataFile(ctx context.Context, filename string, data []byte) error {
+	object := objName(bh.dir, bh.name, filename)
+	w := bh.client.Bucket(*bucket).Object(object).NewWriter(ctx)
+	if _, err := w.Write(data); err != nil {
+		w.Close()
+		return err
+	}
+	return w.Close()
+}
+
 // EndBackup implements BackupHandle.
 func (bh *GCSBackupHandle) EndBackup(ctx context.Context) error {
 	if bh.readOnly {
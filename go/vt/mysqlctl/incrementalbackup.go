@@ -0,0 +1,388 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/vt/dbconfigs"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+	"vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+const (
+	// IncrementalBackupMethod is the BackupMethod of incremental backups.
+	// Rather than a copy of the data, an incremental backup contains the
+	// binary logs between the position of a previous backup and its own.
+	// Incremental backups are skipped by Restore, and are kept by the
+	// retention policy as long as the full backup they apply to.
+	IncrementalBackupMethod = "binlog"
+
+	// incrementalBackupFileName is the name of the binary log file in an
+	// incremental backup.
+	incrementalBackupFileName = "binlog"
+)
+
+// binlogMagic is the header of a binary log file.
+var binlogMagic = []byte{0xfe, 'b', 'i', 'n'}
+
+// rawBinlogEvent is implemented by the binlog events read from a mysqld,
+// which hold the event as it is stored in the binary logs.
+type rawBinlogEvent interface {
+	Bytes() []byte
+}
+
+// IncrementalBackupManifest is the MANIFEST of an incremental backup.
+type IncrementalBackupManifest struct {
+	// BackupManifest is common across all BackupEngines.
+	BackupManifest
+
+	// FromPosition is the position of the backup the binary logs of this
+	// backup apply to.
+	FromPosition mysql.Position
+
+	// Hash and Size describe the binary log file of this backup.
+	Hash string
+	Size int64
+}
+
+// IncrementalBackupParams are the parameters of IncrementalBackup.
+type IncrementalBackupParams struct {
+	Logger logutil.Logger
+	// Connector connects to the mysqld to read the binary logs from, as a
+	// replica would.
+	Connector dbconfigs.Connector
+	// Keyspace and Shard are used to infer the directory where backups are
+	// stored.
+	Keyspace string
+	Shard    string
+	// TabletAlias is used along with BackupTime to name the backup.
+	TabletAlias string
+	BackupTime  time.Time
+	// FromPosition is the position of the previous backup, and ToPosition
+	// the position to stop at. The transactions in between are backed up.
+	FromPosition mysql.Position
+	ToPosition   mysql.Position
+}
+
+// IncrementalBackup takes an incremental backup of the transactions between
+// two positions, by streaming them from the binary logs of a mysqld.
+func IncrementalBackup(ctx context.Context, params IncrementalBackupParams) (*IncrementalBackupManifest, error) {
+	if params.FromPosition.IsZero() {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "an incremental backup needs the position of a previous backup")
+	}
+	if params.FromPosition.AtLeast(params.ToPosition) {
+		return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "nothing to back up: %v already contains %v", params.FromPosition, params.ToPosition)
+	}
+
+	bs, err := backupstorage.GetBackupStorage()
+	if err != nil {
+		return nil, vterrors.Wrap(err, "unable to get backup storage")
+	}
+	defer bs.Close()
+
+	backupDir := GetBackupDir(params.Keyspace, params.Shard)
+	name := fmt.Sprintf("%v.%v", params.BackupTime.UTC().Format(BackupTimestampFormat), params.TabletAlias)
+	bh, err := bs.StartBackup(ctx, backupDir, name)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "StartBackup failed")
+	}
+
+	bm, err := takeIncrementalBackup(ctx, params, bh)
+	if err != nil {
+		if abortErr := bh.AbortBackup(ctx); abortErr != nil {
+			params.Logger.Errorf2(abortErr, "failed to abort incremental backup %v/%v", backupDir, name)
+		}
+		return nil, err
+	}
+	if err := bh.EndBackup(ctx); err != nil {
+		return nil, vterrors.Wrap(err, "EndBackup failed")
+	}
+
+	params.Logger.Infof("Incremental backup %v/%v of %v bytes done, from %v to %v", backupDir, name, bm.Size, bm.FromPosition, bm.Position)
+	return bm, nil
+}
+
+func takeIncrementalBackup(ctx context.Context, params IncrementalBackupParams, bh backupstorage.BackupHandle) (*IncrementalBackupManifest, error) {
+	wc, err := bh.AddFile(ctx, incrementalBackupFileName, backupstorage.FileSizeUnknown)
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "cannot add %v to backup", incrementalBackupFileName)
+	}
+	hasher := newHasher()
+	dst := bufio.NewWriterSize(wc, writerBufferSize)
+	streamed, err := streamBinlogs(ctx, params.Connector, params.FromPosition, params.ToPosition, io.MultiWriter(dst, hasher))
+	if err == nil {
+		err = dst.Flush()
+	}
+	if closeErr := wc.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return nil, vterrors.Wrap(err, "cannot back up binary logs")
+	}
+
+	bm := &IncrementalBackupManifest{
+		BackupManifest: BackupManifest{
+			BackupMethod: IncrementalBackupMethod,
+			Position:     streamed.Position,
+			BackupTime:   params.BackupTime.UTC().Format(time.RFC3339),
+			FinishedTime: time.Now().UTC().Format(time.RFC3339),
+		},
+		FromPosition: params.FromPosition,
+		Hash:         hasher.HashString(),
+		Size:         hasher.size,
+	}
+	data, err := json.MarshalIndent(bm, "", "  ")
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "cannot JSON encode %v", backupManifestFileName)
+	}
+	wc, err = bh.AddFile(ctx, backupManifestFileName, backupstorage.FileSizeUnknown)
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "cannot add %v to backup", backupManifestFileName)
+	}
+	if _, err := wc.Write(data); err != nil {
+		wc.Close()
+		return nil, vterrors.Wrapf(err, "cannot write %v", backupManifestFileName)
+	}
+	if err := wc.Close(); err != nil {
+		return nil, vterrors.Wrapf(err, "cannot close %v", backupManifestFileName)
+	}
+	return bm, nil
+}
+
+// streamedBinlogs describes the transactions written by streamBinlogs.
+type streamedBinlogs struct {
+	// Position is the position of the last transaction written.
+	Position mysql.Position
+	// FirstTimestamp and LastTimestamp are the commit times of the first
+	// and last transactions written, in seconds since the epoch.
+	FirstTimestamp int64
+	LastTimestamp  int64
+}
+
+// streamBinlogs writes a binary log file with the transactions from
+// from, until to is reached, streamed from the mysqld of connector.
+func streamBinlogs(ctx context.Context, connector dbconfigs.Connector, from, to mysql.Position, w io.Writer) (streamedBinlogs, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	conn, err := NewBinlogConnection(connector)
+	if err != nil {
+		return streamedBinlogs{}, err
+	}
+	defer conn.Close()
+
+	events, err := conn.StartBinlogDumpFromPosition(ctx, from)
+	if err != nil {
+		return streamedBinlogs{}, err
+	}
+
+	if _, err := w.Write(binlogMagic); err != nil {
+		return streamedBinlogs{}, err
+	}
+
+	var (
+		format mysql.BinlogFormat
+		gtid   mysql.GTID
+		inTx   bool
+	)
+	streamed := streamedBinlogs{Position: from}
+	for {
+		var ev mysql.BinlogEvent
+		var ok bool
+		select {
+		case ev, ok = <-events:
+			if !ok {
+				return streamed, vterrors.Errorf(vtrpc.Code_UNAVAILABLE, "binlog stream ended at %v, before reaching %v", streamed.Position, to)
+			}
+		case <-ctx.Done():
+			return streamed, ctx.Err()
+		}
+
+		if !ev.IsValid() {
+			return streamed, vterrors.Errorf(vtrpc.Code_INTERNAL, "invalid binlog event: %v", ev)
+		}
+		raw, ok := ev.(rawBinlogEvent)
+		if !ok {
+			return streamed, vterrors.Errorf(vtrpc.Code_UNIMPLEMENTED, "binlog events of type %T cannot be backed up", ev)
+		}
+
+		switch {
+		case ev.IsRotate():
+			// Rotations only make sense for the files of the server.
+			continue
+		case ev.IsFormatDescription():
+			if format, err = ev.Format(); err != nil {
+				return streamed, vterrors.Wrap(err, "can't parse FORMAT_DESCRIPTION_EVENT")
+			}
+		case format.IsZero():
+			// Nothing can be parsed before the first FORMAT_DESCRIPTION_EVENT.
+			continue
+		}
+
+		if _, err := w.Write(raw.Bytes()); err != nil {
+			return streamed, err
+		}
+		if ev.IsFormatDescription() {
+			continue
+		}
+
+		ev, _, err = ev.StripChecksum(format)
+		if err != nil {
+			return streamed, vterrors.Wrap(err, "can't strip checksum from binlog event")
+		}
+
+		commit := false
+		switch {
+		case ev.IsGTID():
+			if gtid, _, err = ev.GTID(format); err != nil {
+				return streamed, vterrors.Wrap(err, "can't get GTID from binlog event")
+			}
+		case ev.IsXID():
+			commit = true
+		case ev.IsQuery():
+			q, err := ev.Query(format)
+			if err != nil {
+				return streamed, vterrors.Wrap(err, "can't get query from binlog event")
+			}
+			switch q.SQL {
+			case "BEGIN":
+				inTx = true
+			case "COMMIT":
+				commit = true
+			default:
+				// Statements outside of a transaction, like DDLs, commit on
+				// their own.
+				commit = !inTx
+			}
+		}
+
+		if commit && gtid != nil {
+			streamed.Position = mysql.AppendGTID(streamed.Position, gtid)
+			streamed.LastTimestamp = int64(ev.Timestamp())
+			if streamed.FirstTimestamp == 0 {
+				streamed.FirstTimestamp = streamed.LastTimestamp
+			}
+			gtid, inTx = nil, false
+			if streamed.Position.AtLeast(to) {
+				return streamed, nil
+			}
+		}
+	}
+}
+
+// GetIncrementalBackupManifest returns the MANIFEST of an incremental backup.
+func GetIncrementalBackupManifest(ctx context.Context, bh backupstorage.BackupHandle) (*IncrementalBackupManifest, error) {
+	bm := &IncrementalBackupManifest{}
+	if err := getBackupManifestInto(ctx, bh, bm); err != nil {
+		return nil, err
+	}
+	if bm.BackupMethod != IncrementalBackupMethod {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "backup %v/%v is not an incremental backup", bh.Directory(), bh.Name())
+	}
+	return bm, nil
+}
+
+// verifyIncrementalBackup checks that the binary log file of an incremental
+// backup can be opened, and compares its hash and size with the MANIFEST if
+// needed.
+func verifyIncrementalBackup(ctx context.Context, logger logutil.Logger, bh backupstorage.BackupHandle, checksums bool) error {
+	bm, err := GetIncrementalBackupManifest(ctx, bh)
+	if err != nil {
+		return err
+	}
+	source, err := bh.ReadFile(ctx, incrementalBackupFileName)
+	if err != nil {
+		return vterrors.Wrapf(err, "can't open %v for reading", incrementalBackupFileName)
+	}
+	defer source.Close()
+
+	if checksums {
+		hasher := newHasher()
+		if _, err := io.Copy(hasher, source); err != nil {
+			return vterrors.Wrapf(err, "failed to read %v", incrementalBackupFileName)
+		}
+		if hash := hasher.HashString(); hash != bm.Hash || hasher.size != bm.Size {
+			return vterrors.Errorf(vtrpc.Code_DATA_LOSS, "%v mismatch, got hash %v and size %v, expected %v and %v", incrementalBackupFileName, hash, hasher.size, bm.Hash, bm.Size)
+		}
+	}
+
+	logger.Infof("VerifyBackup: incremental backup %v/%v verified (checksums: %v)", bh.Directory(), bh.Name(), checksums)
+	return nil
+}
+
+// FindIncrementalBackupBase returns the position an incremental backup of
+// the given backups should start from: the position of the most recent full
+// backup, or of the most recent incremental backup that applies on top of it.
+// It returns false if there is no complete full backup more recent than
+// maxAge, or if the incremental backups taken since are not contiguous, in
+// which case a new full backup should be taken instead.
+func FindIncrementalBackupBase(ctx context.Context, logger logutil.Logger, bhs []backupstorage.BackupHandle, maxAge time.Duration) (mysql.Position, bool) {
+	var (
+		chainEnd  mysql.Position
+		chainFrom mysql.Position
+		inChain   bool
+	)
+	for i := len(bhs) - 1; i >= 0; i-- {
+		bh := bhs[i]
+		bm, err := GetBackupManifest(ctx, bh)
+		if err != nil {
+			logger.Warningf("Ignoring backup %v: can't read MANIFEST: %v", bh.Name(), err)
+			continue
+		}
+
+		if inChain && !chainFrom.Equal(bm.Position) {
+			logger.Infof("Backup %v at %v is not the base of the incremental backups since, which start at %v", bh.Name(), bm.Position, chainFrom)
+			return mysql.Position{}, false
+		}
+		if !inChain {
+			chainEnd = bm.Position
+			inChain = true
+		}
+
+		if bm.BackupMethod != IncrementalBackupMethod {
+			backupTime, err := time.Parse(time.RFC3339, bm.BackupTime)
+			if err != nil {
+				logger.Warningf("Can't parse the time of backup %v: %v", bh.Name(), err)
+				return mysql.Position{}, false
+			}
+			if age := time.Since(backupTime); age > maxAge {
+				logger.Infof("The last full backup %v is %v old, more than %v", bh.Name(), age, maxAge)
+				return mysql.Position{}, false
+			}
+			return chainEnd, true
+		}
+
+		ibm, err := GetIncrementalBackupManifest(ctx, bh)
+		if err != nil {
+			logger.Warningf("Ignoring incremental backup %v: %v", bh.Name(), err)
+			return mysql.Position{}, false
+		}
+		chainFrom = ibm.FromPosition
+	}
+
+	logger.Infof("No full backup to take an incremental backup from")
+	return mysql.Position{}, false
+}
@@ -0,0 +1,216 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+	"vitess.io/vitess/go/vt/mysqlctl/filebackupstorage"
+	"vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+func TestFindIncrementalBackupBase(t *testing.T) {
+	root, err := os.MkdirTemp("", "incrementaltest")
+	require.NoError(t, err)
+	defer os.RemoveAll(root)
+
+	oldRoot := *filebackupstorage.FileBackupStorageRoot
+	defer func() { *filebackupstorage.FileBackupStorageRoot = oldRoot }()
+	*filebackupstorage.FileBackupStorageRoot = root
+
+	ctx := context.Background()
+	bs := &filebackupstorage.FileBackupStorage{}
+	now := time.Now().UTC()
+	logger := logutil.NewMemoryLogger()
+
+	position := func(s string) mysql.Position {
+		pos, err := mysql.DecodePosition("MySQL56/16b1039f-22b6-11ed-b765-0a43f95f28a3:" + s)
+		require.NoError(t, err)
+		return pos
+	}
+	addBackup := func(dir string, age time.Duration, manifest interface{}) {
+		backupTime := now.Add(-age)
+		bh, err := bs.StartBackup(ctx, dir, fmt.Sprintf("%v.zone1-0000000100", backupTime.Format(BackupTimestampFormat)))
+		require.NoError(t, err)
+		data, err := json.Marshal(manifest)
+		require.NoError(t, err)
+		wc, err := bh.AddFile(ctx, backupManifestFileName, backupstorage.FileSizeUnknown)
+		require.NoError(t, err)
+		_, err = wc.Write(data)
+		require.NoError(t, err)
+		require.NoError(t, wc.Close())
+		require.NoError(t, bh.EndBackup(ctx))
+	}
+	full := func(age time.Duration, pos string) BackupManifest {
+		return BackupManifest{
+			BackupMethod: builtinBackupEngineName,
+			Position:     position(pos),
+			BackupTime:   now.Add(-age).Format(time.RFC3339),
+		}
+	}
+	incremental := func(age time.Duration, from, to string) IncrementalBackupManifest {
+		bm := full(age, to)
+		bm.BackupMethod = IncrementalBackupMethod
+		return IncrementalBackupManifest{BackupManifest: bm, FromPosition: position(from)}
+	}
+	find := func(dir string, maxAge time.Duration) (mysql.Position, bool) {
+		bhs, err := bs.ListBackups(ctx, dir)
+		require.NoError(t, err)
+		return FindIncrementalBackupBase(ctx, logger, bhs, maxAge)
+	}
+
+	// No backup at all.
+	_, ok := find("empty", 24*time.Hour)
+	assert.False(t, ok)
+
+	// A full backup followed by contiguous incremental backups.
+	addBackup("chain", 3*time.Hour, full(3*time.Hour, "1-10"))
+	addBackup("chain", 2*time.Hour, incremental(2*time.Hour, "1-10", "1-20"))
+	addBackup("chain", time.Hour, incremental(time.Hour, "1-20", "1-30"))
+	pos, ok := find("chain", 24*time.Hour)
+	require.True(t, ok)
+	assert.True(t, pos.Equal(position("1-30")), "got %v", pos)
+
+	// The full backup is too old.
+	_, ok = find("chain", 2*time.Hour)
+	assert.False(t, ok)
+
+	// A gap in the incremental backups.
+	addBackup("gap", 3*time.Hour, full(3*time.Hour, "1-10"))
+	addBackup("gap", time.Hour, incremental(time.Hour, "1-20", "1-30"))
+	_, ok = find("gap", 24*time.Hour)
+	assert.False(t, ok)
+
+	// Only a full backup.
+	addBackup("full", time.Hour, full(time.Hour, "1-10"))
+	pos, ok = find("full", 24*time.Hour)
+	require.True(t, ok)
+	assert.True(t, pos.Equal(position("1-10")), "got %v", pos)
+}
+
+// addTestBackup adds a backup of the given files, and of the JSON encoding of
+// manifest as its MANIFEST if it is not nil, and returns its name.
+func addTestBackup(t *testing.T, bs backupstorage.BackupStorage, dir string, backupTime time.Time, manifest interface{}, files map[string][]byte) string {
+	ctx := context.Background()
+	name := fmt.Sprintf("%v.zone1-0000000100", backupTime.Format(BackupTimestampFormat))
+	bh, err := bs.StartBackup(ctx, dir, name)
+	require.NoError(t, err)
+	if manifest != nil {
+		data, err := json.Marshal(manifest)
+		require.NoError(t, err)
+		files[backupManifestFileName] = data
+	}
+	for file, data := range files {
+		wc, err := bh.AddFile(ctx, file, int64(len(data)))
+		require.NoError(t, err)
+		_, err = wc.Write(data)
+		require.NoError(t, err)
+		require.NoError(t, wc.Close())
+	}
+	require.NoError(t, bh.EndBackup(ctx))
+	return name
+}
+
+func TestVerifyIncrementalBackup(t *testing.T) {
+	root, err := os.MkdirTemp("", "incrementaltest")
+	require.NoError(t, err)
+	defer os.RemoveAll(root)
+
+	oldRoot := *filebackupstorage.FileBackupStorageRoot
+	defer func() { *filebackupstorage.FileBackupStorageRoot = oldRoot }()
+	*filebackupstorage.FileBackupStorageRoot = root
+
+	ctx := context.Background()
+	bs := &filebackupstorage.FileBackupStorage{}
+	now := time.Now().UTC()
+	logger := logutil.NewMemoryLogger()
+
+	binlogs := append(append([]byte{}, binlogMagic...), "events"...)
+	hasher := newHasher()
+	hasher.Write(binlogs)
+	manifest := func(hash string) *IncrementalBackupManifest {
+		return &IncrementalBackupManifest{
+			BackupManifest: BackupManifest{BackupMethod: IncrementalBackupMethod, BackupTime: now.Format(time.RFC3339)},
+			Hash:           hash,
+			Size:           hasher.size,
+		}
+	}
+	verify := func(dir string, checksums bool) error {
+		bhs, err := bs.ListBackups(ctx, dir)
+		require.NoError(t, err)
+		require.Len(t, bhs, 1)
+		return VerifyBackup(ctx, logger, bhs[0], checksums)
+	}
+
+	addTestBackup(t, bs, "valid", now, manifest(hasher.HashString()), map[string][]byte{incrementalBackupFileName: binlogs})
+	assert.NoError(t, verify("valid", false))
+	assert.NoError(t, verify("valid", true))
+
+	// The binary log file does not match its MANIFEST.
+	addTestBackup(t, bs, "corrupt", now, manifest("00000000"), map[string][]byte{incrementalBackupFileName: binlogs})
+	assert.NoError(t, verify("corrupt", false))
+	err = verify("corrupt", true)
+	assert.Equal(t, vtrpc.Code_DATA_LOSS, vterrors.Code(err), "got %v", err)
+
+	// The binary log file is missing.
+	addTestBackup(t, bs, "missing", now, manifest(hasher.HashString()), map[string][]byte{})
+	assert.Error(t, verify("missing", false))
+}
+
+func TestFindBackupToRestoreSkipsIncrementalBackups(t *testing.T) {
+	root, err := os.MkdirTemp("", "incrementaltest")
+	require.NoError(t, err)
+	defer os.RemoveAll(root)
+
+	oldRoot := *filebackupstorage.FileBackupStorageRoot
+	defer func() { *filebackupstorage.FileBackupStorageRoot = oldRoot }()
+	*filebackupstorage.FileBackupStorageRoot = root
+
+	ctx := context.Background()
+	bs := &filebackupstorage.FileBackupStorage{}
+	now := time.Now().UTC()
+	params := RestoreParams{Logger: logutil.NewMemoryLogger(), Keyspace: "ks", Shard: "-"}
+	dir := GetBackupDir("ks", "-")
+
+	incremental := &BackupManifest{BackupMethod: IncrementalBackupMethod, BackupTime: now.Format(time.RFC3339)}
+	addTestBackup(t, bs, dir, now.Add(-time.Hour), incremental, map[string][]byte{})
+	bhs, err := bs.ListBackups(ctx, dir)
+	require.NoError(t, err)
+	_, err = FindBackupToRestore(ctx, params, bhs)
+	assert.Equal(t, ErrNoCompleteBackup, err)
+
+	full := &BackupManifest{BackupMethod: builtinBackupEngineName, BackupTime: now.Format(time.RFC3339)}
+	name := addTestBackup(t, bs, dir, now.Add(-2*time.Hour), full, map[string][]byte{})
+	bhs, err = bs.ListBackups(ctx, dir)
+	require.NoError(t, err)
+	require.Len(t, bhs, 2)
+	bh, err := FindBackupToRestore(ctx, params, bhs)
+	require.NoError(t, err)
+	assert.Equal(t, name, bh.Name())
+}
@@ -151,7 +151,7 @@ func (cnf *Mycnf) fillMycnfTemplate(tmplSrc string) (string, error) {
 // The value assigned to ServerID will be in the range [100, 2^31):
 // - It avoids 0 because that's reserved for mysqlbinlog dumps.
 // - It also avoids 1-99 because low numbers are used for fake
-// connections.  See NewBinlogConnection() in binlog/binlog_connection.go
+// connections.  See NewBinlogConnection() in binlog_connection.go
 // for more on that.
 // - It avoids the 2^31 - 2^32-1 range, as there seems to be some
 // confusion there. The main MySQL documentation at:
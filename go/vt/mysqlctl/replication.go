@@ -345,6 +345,31 @@ func (mysqld *Mysqld) SetReplicationSource(ctx context.Context, host string, por
 	return mysqld.executeSuperQueryListConn(ctx, conn, cmds)
 }
 
+// SetReplicationDelay sets the delay with which the replica applies the
+// events it receives from its source. The SQL thread is stopped while the
+// delay is changed, and restarted only if it was running.
+func (mysqld *Mysqld) SetReplicationDelay(ctx context.Context, delay time.Duration) error {
+	conn, err := getPoolReconnect(ctx, mysqld.dbaPool)
+	if err != nil {
+		return err
+	}
+	defer conn.Recycle()
+
+	status, err := conn.ShowReplicationStatus()
+	if err != nil {
+		return err
+	}
+	cmds := []string{}
+	if status.SQLThreadRunning {
+		cmds = append(cmds, "STOP SLAVE SQL_THREAD")
+	}
+	cmds = append(cmds, fmt.Sprintf("CHANGE MASTER TO MASTER_DELAY = %d", int(delay.Seconds())))
+	if status.SQLThreadRunning {
+		cmds = append(cmds, "START SLAVE SQL_THREAD")
+	}
+	return mysqld.executeSuperQueryListConn(ctx, conn, cmds)
+}
+
 // ResetReplication resets all replication for this host.
 func (mysqld *Mysqld) ResetReplication(ctx context.Context) error {
 	conn, connErr := getPoolReconnect(ctx, mysqld.dbaPool)
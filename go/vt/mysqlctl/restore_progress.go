@@ -0,0 +1,239 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+)
+
+// maxRestoreBandwidthBurst caps the number of bytes read from the backup
+// storage in one go while the restore bandwidth is limited.
+const maxRestoreBandwidthBurst = 1024 * 1024
+
+var (
+	// restoreProgressLogInterval is how often the progress of a restore is
+	// logged to the restore logger.
+	restoreProgressLogInterval = 30 * time.Second
+
+	restoreProgress = &RestoreProgress{}
+
+	_ = stats.NewGaugeFunc("restore_bytes_total", "Number of bytes to read from the backup storage for the current restore, or 0 if unknown", func() int64 {
+		return restoreProgress.Status().BytesTotal
+	})
+	_ = stats.NewGaugeFunc("restore_bytes_restored", "Number of bytes read from the backup storage so far for the current restore", func() int64 {
+		return restoreProgress.Status().BytesRestored
+	})
+	_ = stats.NewGaugeFunc("restore_eta_seconds", "Estimated number of seconds left for the current restore, or 0 if unknown", func() int64 {
+		return int64(restoreProgress.Status().ETA.Seconds())
+	})
+)
+
+// RestoreProgress tracks the progress of the restore running in this process.
+type RestoreProgress struct {
+	mu            sync.Mutex
+	inProgress    bool
+	startTime     time.Time
+	filesTotal    int
+	filesRestored int
+	bytesTotal    int64
+	bytesRestored int64
+}
+
+// RestoreProgressStatus is a snapshot of the progress of a restore.
+type RestoreProgressStatus struct {
+	InProgress    bool
+	FilesTotal    int
+	FilesRestored int
+	// BytesTotal is the number of bytes to read from the backup storage, or
+	// 0 if the backup engine does not know it.
+	BytesTotal    int64
+	BytesRestored int64
+	Elapsed       time.Duration
+	// ETA is the estimated time left, based on the throughput of the restore
+	// so far, or 0 if it cannot be estimated.
+	ETA time.Duration
+}
+
+// GetRestoreProgress returns the progress of the restore running in this
+// process, if any.
+func GetRestoreProgress() RestoreProgressStatus {
+	return restoreProgress.Status()
+}
+
+func (p *RestoreProgress) start() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.inProgress = true
+	p.startTime = time.Now()
+	p.filesTotal, p.filesRestored = 0, 0
+	p.bytesTotal, p.bytesRestored = 0, 0
+}
+
+func (p *RestoreProgress) finish() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.inProgress = false
+}
+
+// expect records the amount of work of the restore, once the backup engine
+// knows it.
+func (p *RestoreProgress) expect(files int, bytes int64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.filesTotal = files
+	p.bytesTotal = bytes
+}
+
+func (p *RestoreProgress) addBytes(n int64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.bytesRestored += n
+}
+
+func (p *RestoreProgress) fileRestored() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.filesRestored++
+}
+
+// Status returns a snapshot of the progress.
+func (p *RestoreProgress) Status() RestoreProgressStatus {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	status := RestoreProgressStatus{
+		InProgress:    p.inProgress,
+		FilesTotal:    p.filesTotal,
+		FilesRestored: p.filesRestored,
+		BytesTotal:    p.bytesTotal,
+		BytesRestored: p.bytesRestored,
+	}
+	if !p.inProgress {
+		return status
+	}
+
+	status.Elapsed = time.Since(p.startTime)
+	if p.bytesTotal > 0 && p.bytesRestored > 0 && p.bytesRestored < p.bytesTotal {
+		left := float64(p.bytesTotal-p.bytesRestored) / float64(p.bytesRestored)
+		status.ETA = time.Duration(left * float64(status.Elapsed))
+	}
+	return status
+}
+
+// String is part of the fmt.Stringer interface.
+func (s RestoreProgressStatus) String() string {
+	if s.BytesTotal == 0 {
+		return fmt.Sprintf("restored %v bytes and %v files in %v", s.BytesRestored, s.FilesRestored, s.Elapsed.Round(time.Second))
+	}
+	return fmt.Sprintf("restored %v of %v bytes (%.1f%%) and %v of %v files in %v, ETA %v",
+		s.BytesRestored, s.BytesTotal, 100*float64(s.BytesRestored)/float64(s.BytesTotal),
+		s.FilesRestored, s.FilesTotal, s.Elapsed.Round(time.Second), s.ETA.Round(time.Second))
+}
+
+// logRestoreProgress logs the progress of the restore at regular intervals,
+// until the returned function is called.
+func logRestoreProgress(logger logutil.Logger) (stop func()) {
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(restoreProgressLogInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				logger.Infof("Restore: %v", restoreProgress.Status())
+			}
+		}
+	}()
+	return func() { close(done) }
+}
+
+// restoreBackupHandle wraps the backup handle of a restore, to limit the
+// bandwidth used to read the backup files, and to track the progress of the
+// restore.
+type restoreBackupHandle struct {
+	backupstorage.BackupHandle
+
+	limiter *rate.Limiter
+}
+
+// newRestoreBackupHandle returns a backup handle reading the files of bh at
+// most at maxBandwidth bytes per second, or without limit if maxBandwidth is
+// not positive.
+func newRestoreBackupHandle(bh backupstorage.BackupHandle, maxBandwidth int64) *restoreBackupHandle {
+	rbh := &restoreBackupHandle{BackupHandle: bh}
+	if maxBandwidth > 0 {
+		burst := maxBandwidth
+		if burst > maxRestoreBandwidthBurst {
+			burst = maxRestoreBandwidthBurst
+		}
+		rbh.limiter = rate.NewLimiter(rate.Limit(maxBandwidth), int(burst))
+	}
+	return rbh
+}
+
+// ReadFile is part of the backupstorage.BackupHandle interface.
+func (rbh *restoreBackupHandle) ReadFile(ctx context.Context, filename string) (io.ReadCloser, error) {
+	rc, err := rbh.BackupHandle.ReadFile(ctx, filename)
+	if err != nil || filename == backupManifestFileName {
+		return rc, err
+	}
+	return &restoreReader{ReadCloser: rc, ctx: ctx, limiter: rbh.limiter}, nil
+}
+
+// restoreReader reads a backup file for a restore.
+type restoreReader struct {
+	io.ReadCloser
+
+	ctx     context.Context
+	limiter *rate.Limiter
+}
+
+// Read is part of the io.Reader interface.
+func (rr *restoreReader) Read(p []byte) (int, error) {
+	if rr.limiter != nil && len(p) > rr.limiter.Burst() {
+		p = p[:rr.limiter.Burst()]
+	}
+
+	n, err := rr.ReadCloser.Read(p)
+	if n > 0 {
+		restoreProgress.addBytes(int64(n))
+		if rr.limiter != nil {
+			if werr := rr.limiter.WaitN(rr.ctx, n); werr != nil && err == nil {
+				err = werr
+			}
+		}
+	}
+	return n, err
+}
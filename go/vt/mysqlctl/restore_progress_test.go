@@ -0,0 +1,118 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+)
+
+func TestRestoreProgressStatus(t *testing.T) {
+	p := &RestoreProgress{}
+	assert.False(t, p.Status().InProgress)
+
+	p.start()
+	p.expect(4, 1000)
+	p.startTime = time.Now().Add(-10 * time.Second)
+	p.addBytes(250)
+	p.fileRestored()
+
+	status := p.Status()
+	assert.True(t, status.InProgress)
+	assert.Equal(t, 4, status.FilesTotal)
+	assert.Equal(t, 1, status.FilesRestored)
+	assert.Equal(t, int64(1000), status.BytesTotal)
+	assert.Equal(t, int64(250), status.BytesRestored)
+	// A quarter of the restore took 10s, the rest should take 30s.
+	assert.InDelta(t, 30*time.Second, status.ETA, float64(time.Second))
+	assert.Contains(t, status.String(), "restored 250 of 1000 bytes (25.0%) and 1 of 4 files")
+
+	// The ETA is unknown without the total size.
+	p.expect(4, 0)
+	assert.Zero(t, p.Status().ETA)
+
+	p.finish()
+	assert.False(t, p.Status().InProgress)
+
+	// A new restore starts from scratch.
+	p.start()
+	assert.Zero(t, p.Status().BytesRestored)
+}
+
+// fakeBackupHandle serves the content of a single file.
+type fakeBackupHandle struct {
+	backupstorage.BackupHandle
+
+	data []byte
+}
+
+func (fbh *fakeBackupHandle) ReadFile(ctx context.Context, filename string) (io.ReadCloser, error) {
+	return ioutil.NopCloser(bytes.NewReader(fbh.data)), nil
+}
+
+func TestRestoreBackupHandle(t *testing.T) {
+	ctx := context.Background()
+	data := bytes.Repeat([]byte("x"), 20000)
+
+	restoreProgress.start()
+	defer restoreProgress.finish()
+
+	// Without limit.
+	rbh := newRestoreBackupHandle(&fakeBackupHandle{data: data}, 0)
+	rc, err := rbh.ReadFile(ctx, "0")
+	require.NoError(t, err)
+	out, err := ioutil.ReadAll(rc)
+	require.NoError(t, err)
+	assert.Equal(t, data, out)
+	assert.Equal(t, int64(len(data)), GetRestoreProgress().BytesRestored)
+
+	// The MANIFEST doesn't count towards the progress.
+	rc, err = rbh.ReadFile(ctx, backupManifestFileName)
+	require.NoError(t, err)
+	_, err = ioutil.ReadAll(rc)
+	require.NoError(t, err)
+	assert.Equal(t, int64(len(data)), GetRestoreProgress().BytesRestored)
+
+	// At 10000 bytes per second, with a burst of 10000 bytes, reading 20000
+	// bytes takes at least a second.
+	rbh = newRestoreBackupHandle(&fakeBackupHandle{data: data}, 10000)
+	rc, err = rbh.ReadFile(ctx, "1")
+	require.NoError(t, err)
+	start := time.Now()
+	out, err = ioutil.ReadAll(rc)
+	require.NoError(t, err)
+	assert.Equal(t, data, out)
+	assert.GreaterOrEqual(t, time.Since(start), 900*time.Millisecond)
+	assert.Equal(t, int64(2*len(data)), GetRestoreProgress().BytesRestored)
+
+	// A cancelled restore stops waiting for the limiter.
+	cctx, cancel := context.WithCancel(ctx)
+	cancel()
+	rc, err = newRestoreBackupHandle(&fakeBackupHandle{data: data}, 10000).ReadFile(cctx, "2")
+	require.NoError(t, err)
+	_, err = ioutil.ReadAll(rc)
+	assert.Error(t, err)
+}
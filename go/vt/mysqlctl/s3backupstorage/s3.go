@@ -24,6 +24,7 @@ limitations under the License.
 package s3backupstorage
 
 import (
+	"bytes"
 	"crypto/md5"
 	"crypto/tls"
 	"encoding/base64"
@@ -188,6 +189,21 @@ func (bh *S3BackupHandle) AbortBackup(ctx context.Context) error {
 	return bh.bs.RemoveBackup(ctx, bh.dir, bh.name)
 }
 
+// WriteMetadataFile is part of the backupstorage.MetadataWriter interface.
+func (bh *S3BackupHandle) WriteMetadataFile(ctx context.Context, filename string, data []byte) error {
+	object := objName(bh.dir, bh.name, filename)
+	_, err := bh.client.PutObjectWithContext(ctx, &s3.PutObjectInput{
+		Bucket:               bucket,
+		Key:                  object,
+		Body:                 bytes.NewReader(data),
+		ServerSideEncryption: bh.bs.s3SSE.awsAlg,
+		SSECustomerAlgorithm: bh.bs.s3SSE.customerAlg,
+		SSECustomerKey:       bh.bs.s3SSE.customerKey,
+		SSECustomerKeyMD5:    bh.bs.s3SSE.customerMd5,
+	})
+	return err
+}
+
 // ReadFile is part of the backupstorage.BackupHandle interface.
 func (bh *S3BackupHandle) ReadFile(ctx context.Context, filename string) (io.ReadCloser, error) {
 	if !bh.readOnly {
@@ -208,6 +224,7 @@ func (bh *S3BackupHandle) ReadFile(ctx context.Context, filename string) (io.Rea
 }
 
 var _ backupstorage.BackupHandle = (*S3BackupHandle)(nil)
+var _ backupstorage.MetadataWriter = (*S3BackupHandle)(nil)
 
 type S3ServerSideEncryption struct {
 	awsAlg      *string
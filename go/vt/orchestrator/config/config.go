@@ -210,11 +210,18 @@ type Configuration struct {
 	PostIntermediatePrimaryFailoverProcesses    []string          // Processes to execute after doing a primary failover (order of execution undefined). Uses same placeholders as PostFailoverProcesses
 	PostGracefulTakeoverProcesses               []string          // Processes to execute after running a graceful primary takeover. Uses same placeholders as PostFailoverProcesses
 	PostTakePrimaryProcesses                    []string          // Processes to execute after a successful Take-Primary event has taken place
+	RecoveryAuditWebhookURLs                    []string          // URLs to which the audit events of the detections and recoveries are posted as JSON
+	RecoveryAuditWebhookTimeoutSeconds          int               // Timeout of the posts to the RecoveryAuditWebhookURLs
 	CoPrimaryRecoveryMustPromoteOtherCoPrimary  bool              // When 'false', anything can get promoted (and candidates are prefered over others). When 'true', orchestrator will promote the other co-primary or else fail
 	DetachLostReplicasAfterPrimaryFailover      bool              // Should replicas that are not to be lost in primary recovery (i.e. were more up-to-date than promoted replica) be forcibly detached
 	ApplyMySQLPromotionAfterPrimaryFailover     bool              // Should orchestrator take upon itself to apply MySQL primary promotion: set read_only=0, detach replication, etc.
 	PreventCrossDataCenterPrimaryFailover       bool              // When true (default: false), cross-DC primary failover are not allowed, orchestrator will do all it can to only fail over within same DC, or else not fail over at all.
 	PreventCrossRegionPrimaryFailover           bool              // When true (default: false), cross-region primary failover are not allowed, orchestrator will do all it can to only fail over within same region, or else not fail over at all.
+	ReparentPreferredCells                      []string          // Cells whose replicas are preferred, in order, when choosing the replacement of a promoted replica, like the preferred cells of EmergencyReparentShard
+	ReparentPreventCrossCellPromotion           bool              // When true (default: false), only replicas in the cell of the failed primary replace a promoted replica
+	ReparentAvoidPrimaryHost                    bool              // When true (default: false), replicas on the host of the failed primary are avoided when choosing the replacement of a promoted replica
+	ReparentAntiAffinityTags                    []string          // Tablet tag names, such as "rack", whose values should differ from the ones of the failed primary when choosing the replacement of a promoted replica
+	ReparentRequiredTags                        map[string]string // Tablet tags that the replacement of a promoted replica must have
 	PrimaryFailoverLostInstancesDowntimeMinutes uint              // Number of minutes to downtime any server that was lost after a primary failover (including failed primary & lost replicas). 0 to disable
 	PrimaryFailoverDetachReplicaPrimaryHost     bool              // Should orchestrator issue a detach-replica-primary-host on newly promoted primary (this makes sure the new primary will not attempt to replicate old primary if that comes back to life). Defaults 'false'. Meaningless if ApplyMySQLPromotionAfterPrimaryFailover is 'true'.
 	FailPrimaryPromotionOnLagMinutes            uint              // when > 0, fail a primary promotion if the candidate replica is lagging >= configured number of minutes.
@@ -372,11 +379,18 @@ func newConfiguration() *Configuration {
 		PostUnsuccessfulFailoverProcesses:           []string{},
 		PostGracefulTakeoverProcesses:               []string{},
 		PostTakePrimaryProcesses:                    []string{},
+		RecoveryAuditWebhookURLs:                    []string{},
+		RecoveryAuditWebhookTimeoutSeconds:          10,
 		CoPrimaryRecoveryMustPromoteOtherCoPrimary:  true,
 		DetachLostReplicasAfterPrimaryFailover:      true,
 		ApplyMySQLPromotionAfterPrimaryFailover:     true,
 		PreventCrossDataCenterPrimaryFailover:       false,
 		PreventCrossRegionPrimaryFailover:           false,
+		ReparentPreferredCells:                      []string{},
+		ReparentPreventCrossCellPromotion:           false,
+		ReparentAvoidPrimaryHost:                    false,
+		ReparentAntiAffinityTags:                    []string{},
+		ReparentRequiredTags:                        make(map[string]string),
 		PrimaryFailoverLostInstancesDowntimeMinutes: 0,
 		PrimaryFailoverDetachReplicaPrimaryHost:     false,
 		FailPrimaryPromotionOnLagMinutes:            0,
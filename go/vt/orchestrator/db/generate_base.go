@@ -870,4 +870,42 @@ var generateSQLBase = []string{
 	`
 		CREATE INDEX ks_idx_vitess_tablet ON vitess_tablet (keyspace, shard)
 	`,
+	`
+		CREATE TABLE IF NOT EXISTS topology_recovery_audit_event (
+			event_id bigint unsigned not null auto_increment,
+			event_type varchar(128) CHARACTER SET ascii NOT NULL,
+			recovery_uid varchar(128) CHARACTER SET ascii NOT NULL,
+			hostname varchar(128) NOT NULL,
+			port smallint unsigned NOT NULL,
+			analysis varchar(128) NOT NULL,
+			description text CHARACTER SET utf8 NOT NULL,
+			cluster_name varchar(128) NOT NULL,
+			keyspace varchar(128) CHARACTER SET ascii NOT NULL,
+			shard varchar(128) CHARACTER SET ascii NOT NULL,
+			is_actionable tinyint unsigned NOT NULL,
+			steps mediumtext CHARACTER SET utf8 NOT NULL,
+			start_timestamp timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			end_timestamp timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			duration_millis bigint unsigned NOT NULL,
+			is_successful tinyint unsigned NOT NULL,
+			successor_hostname varchar(128) NOT NULL,
+			successor_port smallint unsigned NOT NULL,
+			all_errors mediumtext CHARACTER SET utf8 NOT NULL,
+			message text CHARACTER SET utf8 NOT NULL,
+			processing_node_hostname varchar(128) CHARACTER SET ascii NOT NULL,
+			PRIMARY KEY (event_id)
+		) ENGINE=InnoDB DEFAULT CHARSET=ascii
+	`,
+	`
+		CREATE INDEX cluster_name_idx_topology_recovery_audit_event ON topology_recovery_audit_event (cluster_name)
+	`,
+	`
+		CREATE INDEX ks_idx_topology_recovery_audit_event ON topology_recovery_audit_event (keyspace, shard)
+	`,
+	`
+		CREATE INDEX recovery_uid_idx_topology_recovery_audit_event ON topology_recovery_audit_event (recovery_uid)
+	`,
+	`
+		CREATE INDEX end_timestamp_idx_topology_recovery_audit_event ON topology_recovery_audit_event (end_timestamp)
+	`,
 }
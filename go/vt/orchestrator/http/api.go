@@ -2455,6 +2455,29 @@ func (this *HttpAPI) AuditRecoverySteps(params martini.Params, r render.Render,
 	r.JSON(http.StatusOK, audits)
 }
 
+// AuditRecoveryEvents provides list of the audit events of detections and recoveries
+func (this *HttpAPI) AuditRecoveryEvents(params martini.Params, r render.Render, req *http.Request) {
+	var events []*logic.RecoveryAuditEvent
+	var err error
+
+	if recoveryUID := params["uid"]; recoveryUID != "" {
+		events, err = logic.ReadRecoveryAuditEventsByUID(recoveryUID)
+	} else {
+		page, derr := strconv.Atoi(params["page"])
+		if derr != nil || page < 0 {
+			page = 0
+		}
+		events, err = logic.ReadRecentRecoveryAuditEvents(params["clusterName"], params["keyspace"], params["shard"], page)
+	}
+
+	if err != nil {
+		Respond(r, &APIResponse{Code: ERROR, Message: fmt.Sprintf("%+v", err)})
+		return
+	}
+
+	r.JSON(http.StatusOK, events)
+}
+
 // ReadReplicationAnalysisChangelog lists instances and their analysis changelog
 func (this *HttpAPI) ReadReplicationAnalysisChangelog(params martini.Params, r render.Render, req *http.Request) {
 	changelogs, err := inst.ReadReplicationAnalysisChangelog()
@@ -2952,6 +2975,15 @@ func (this *HttpAPI) RegisterRequests(m *martini.ClassicMartini) {
 	this.registerAPIRequest(m, "audit-recovery/alias/:clusterAlias", this.AuditRecovery)
 	this.registerAPIRequest(m, "audit-recovery/alias/:clusterAlias/:page", this.AuditRecovery)
 	this.registerAPIRequest(m, "audit-recovery-steps/:uid", this.AuditRecoverySteps)
+	this.registerAPIRequest(m, "audit-recovery-events", this.AuditRecoveryEvents)
+	this.registerAPIRequest(m, "audit-recovery-events/:page", this.AuditRecoveryEvents)
+	this.registerAPIRequest(m, "audit-recovery-events/uid/:uid", this.AuditRecoveryEvents)
+	this.registerAPIRequest(m, "audit-recovery-events/cluster/:clusterName", this.AuditRecoveryEvents)
+	this.registerAPIRequest(m, "audit-recovery-events/cluster/:clusterName/:page", this.AuditRecoveryEvents)
+	this.registerAPIRequest(m, "audit-recovery-events/keyspace/:keyspace", this.AuditRecoveryEvents)
+	this.registerAPIRequest(m, "audit-recovery-events/keyspace/:keyspace/:page", this.AuditRecoveryEvents)
+	this.registerAPIRequest(m, "audit-recovery-events/shard/:keyspace/:shard", this.AuditRecoveryEvents)
+	this.registerAPIRequest(m, "audit-recovery-events/shard/:keyspace/:shard/:page", this.AuditRecoveryEvents)
 	this.registerAPIRequest(m, "active-cluster-recovery/:clusterName", this.ActiveClusterRecovery)
 	this.registerAPIRequest(m, "recently-active-cluster-recovery/:clusterName", this.RecentlyActiveClusterRecovery)
 	this.registerAPIRequest(m, "recently-active-instance-recovery/:host/:port", this.RecentlyActiveInstanceRecovery)
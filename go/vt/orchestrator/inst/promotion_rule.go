@@ -17,46 +17,23 @@
 package inst
 
 import (
-	"fmt"
+	"vitess.io/vitess/go/vt/vtctl/reparentutil/promotionrule"
 )
 
 // CandidatePromotionRule describe the promotion preference/rule for an instance.
-// It maps to promotion_rule column in candidate_database_instance
-type CandidatePromotionRule string
+// It is shared with the reparent operations of vtctld.
+type CandidatePromotionRule = promotionrule.CandidatePromotionRule
 
 const (
-	MustPromoteRule      CandidatePromotionRule = "must"
-	PreferPromoteRule    CandidatePromotionRule = "prefer"
-	NeutralPromoteRule   CandidatePromotionRule = "neutral"
-	PreferNotPromoteRule CandidatePromotionRule = "prefer_not"
-	MustNotPromoteRule   CandidatePromotionRule = "must_not"
+	MustPromoteRule      = promotionrule.Must
+	PreferPromoteRule    = promotionrule.Prefer
+	NeutralPromoteRule   = promotionrule.Neutral
+	PreferNotPromoteRule = promotionrule.PreferNot
+	MustNotPromoteRule   = promotionrule.MustNot
 )
 
-var promotionRuleOrderMap = map[CandidatePromotionRule]int{
-	MustPromoteRule:      0,
-	PreferPromoteRule:    1,
-	NeutralPromoteRule:   2,
-	PreferNotPromoteRule: 3,
-	MustNotPromoteRule:   4,
-}
-
-func (this *CandidatePromotionRule) BetterThan(other CandidatePromotionRule) bool {
-	otherOrder, ok := promotionRuleOrderMap[other]
-	if !ok {
-		return false
-	}
-	return promotionRuleOrderMap[*this] < otherOrder
-}
-
 // ParseCandidatePromotionRule returns a CandidatePromotionRule by name.
 // It returns an error if there is no known rule by the given name.
 func ParseCandidatePromotionRule(ruleName string) (CandidatePromotionRule, error) {
-	switch ruleName {
-	case "prefer", "neutral", "prefer_not", "must_not":
-		return CandidatePromotionRule(ruleName), nil
-	case "must":
-		return CandidatePromotionRule(""), fmt.Errorf("CandidatePromotionRule: %v not supported yet", ruleName)
-	default:
-		return CandidatePromotionRule(""), fmt.Errorf("Invalid CandidatePromotionRule: %v", ruleName)
-	}
+	return promotionrule.Parse(ruleName)
 }
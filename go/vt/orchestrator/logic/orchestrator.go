@@ -463,6 +463,7 @@ func ContinuousDiscovery() {
 					go ExpireFailureDetectionHistory()
 					go ExpireTopologyRecoveryHistory()
 					go ExpireTopologyRecoveryStepsHistory()
+					go ExpireRecoveryAuditEventsHistory()
 
 					if runCheckAndRecoverOperationsTimeRipe() && IsLeader() {
 						go SubmitPrimariesToKvStores("", false)
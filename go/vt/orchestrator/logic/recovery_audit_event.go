@@ -0,0 +1,150 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logic
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"vitess.io/vitess/go/vt/orchestrator/config"
+	"vitess.io/vitess/go/vt/orchestrator/external/golib/log"
+	"vitess.io/vitess/go/vt/orchestrator/inst"
+	"vitess.io/vitess/go/vt/orchestrator/process"
+)
+
+// AuditEventType is the type of a RecoveryAuditEvent
+type AuditEventType string
+
+const (
+	// FailureDetectedAuditEvent is audited when a failure with a recovery path is first detected
+	FailureDetectedAuditEvent AuditEventType = "FailureDetected"
+	// RecoverySkippedAuditEvent is audited when a detected failure is not recovered because recoveries are disabled
+	RecoverySkippedAuditEvent AuditEventType = "RecoverySkipped"
+	// RecoveryCompletedAuditEvent is audited when a recovery was attempted, successfully or not
+	RecoveryCompletedAuditEvent AuditEventType = "RecoveryCompleted"
+)
+
+// auditEventTimeFormat is the format of the timestamps of the audit events, which the backend databases accept
+const auditEventTimeFormat = "2006-01-02 15:04:05"
+
+// RecoveryAuditEvent is an entry of the audit trail of the detections and recoveries, in the
+// topology_recovery_audit_event table. It is also the payload of the webhook notifications.
+type RecoveryAuditEvent struct {
+	Id                     int64
+	EventType              AuditEventType
+	RecoveryUID            string
+	Analysis               inst.AnalysisCode
+	Description            string
+	AnalyzedInstanceKey    inst.InstanceKey
+	ClusterName            string
+	Keyspace               string
+	Shard                  string
+	IsActionable           bool
+	Steps                  []string
+	StartTimestamp         string
+	EndTimestamp           string
+	DurationMillis         int64
+	IsSuccessful           bool
+	SuccessorKey           *inst.InstanceKey
+	Errors                 []string
+	Message                string
+	ProcessingNodeHostname string
+}
+
+// newRecoveryAuditEvent returns an event for the analysis, which started at the given time
+func newRecoveryAuditEvent(eventType AuditEventType, analysisEntry *inst.ReplicationAnalysis, start time.Time) *RecoveryAuditEvent {
+	end := time.Now()
+	event := &RecoveryAuditEvent{
+		EventType:              eventType,
+		Analysis:               analysisEntry.Analysis,
+		Description:            analysisEntry.Description,
+		AnalyzedInstanceKey:    analysisEntry.AnalyzedInstanceKey,
+		ClusterName:            analysisEntry.ClusterDetails.ClusterName,
+		IsActionable:           analysisEntry.IsActionableRecovery,
+		Steps:                  []string{},
+		StartTimestamp:         start.Format(auditEventTimeFormat),
+		EndTimestamp:           end.Format(auditEventTimeFormat),
+		DurationMillis:         end.Sub(start).Milliseconds(),
+		Errors:                 []string{},
+		ProcessingNodeHostname: process.ThisHostname,
+	}
+	if tablet, err := inst.ReadTablet(analysisEntry.AnalyzedInstanceKey); err == nil {
+		event.Keyspace = tablet.Keyspace
+		event.Shard = tablet.Shard
+	}
+	return event
+}
+
+// newRecoveryCompletedAuditEvent returns the event of an attempted recovery, with its audited steps
+func newRecoveryCompletedAuditEvent(topologyRecovery *TopologyRecovery, start time.Time) *RecoveryAuditEvent {
+	event := newRecoveryAuditEvent(RecoveryCompletedAuditEvent, &topologyRecovery.AnalysisEntry, start)
+	event.RecoveryUID = topologyRecovery.UID
+	event.IsSuccessful = topologyRecovery.IsSuccessful
+	event.SuccessorKey = topologyRecovery.SuccessorKey
+	event.Errors = append(event.Errors, topologyRecovery.AllErrors...)
+	if steps, err := ReadTopologyRecoverySteps(topologyRecovery.UID); err == nil {
+		for _, step := range steps {
+			event.Steps = append(event.Steps, step.Message)
+		}
+	}
+	return event
+}
+
+// auditRecoveryEvent writes the event to the backend database, and notifies the webhooks
+func auditRecoveryEvent(event *RecoveryAuditEvent) {
+	if err := writeRecoveryAuditEvent(event); err != nil {
+		log.Errorf("auditRecoveryEvent: cannot write %s event of %+v: %+v", event.EventType, event.AnalyzedInstanceKey, err)
+	}
+	notifyRecoveryAuditWebhooks(event)
+}
+
+// notifyRecoveryAuditWebhooks posts the event to the RecoveryAuditWebhookURLs, asynchronously
+func notifyRecoveryAuditWebhooks(event *RecoveryAuditEvent) {
+	if len(config.Config.RecoveryAuditWebhookURLs) == 0 {
+		return
+	}
+	body, err := json.Marshal(event)
+	if err != nil {
+		log.Errore(err)
+		return
+	}
+	timeout := time.Duration(config.Config.RecoveryAuditWebhookTimeoutSeconds) * time.Second
+	for _, url := range config.Config.RecoveryAuditWebhookURLs {
+		go func(url string) {
+			if err := postRecoveryAuditWebhook(url, body, timeout); err != nil {
+				log.Errorf("notifyRecoveryAuditWebhooks: %+v", err)
+			}
+		}(url)
+	}
+}
+
+// postRecoveryAuditWebhook posts a JSON event to a webhook
+func postRecoveryAuditWebhook(url string, body []byte, timeout time.Duration) error {
+	client := &http.Client{Timeout: timeout}
+	resp, err := client.Post(url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("webhook %s: %v", url, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook %s: unexpected status %s", url, resp.Status)
+	}
+	return nil
+}
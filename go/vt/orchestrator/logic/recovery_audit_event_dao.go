@@ -0,0 +1,169 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logic
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"vitess.io/vitess/go/vt/orchestrator/config"
+	"vitess.io/vitess/go/vt/orchestrator/db"
+	"vitess.io/vitess/go/vt/orchestrator/external/golib/log"
+	"vitess.io/vitess/go/vt/orchestrator/external/golib/sqlutils"
+	"vitess.io/vitess/go/vt/orchestrator/inst"
+)
+
+// writeRecoveryAuditEvent writes an event to the topology_recovery_audit_event table
+func writeRecoveryAuditEvent(event *RecoveryAuditEvent) error {
+	steps, err := json.Marshal(event.Steps)
+	if err != nil {
+		return err
+	}
+	errors, err := json.Marshal(event.Errors)
+	if err != nil {
+		return err
+	}
+	var successorKey inst.InstanceKey
+	if event.SuccessorKey != nil {
+		successorKey = *event.SuccessorKey
+	}
+	sqlResult, err := db.ExecOrchestrator(`
+			insert
+				into topology_recovery_audit_event (
+					event_type, recovery_uid, hostname, port, analysis, description, cluster_name, keyspace, shard,
+					is_actionable, steps, start_timestamp, end_timestamp, duration_millis, is_successful,
+					successor_hostname, successor_port, all_errors, message, processing_node_hostname
+				) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+			`,
+		string(event.EventType), event.RecoveryUID, event.AnalyzedInstanceKey.Hostname, event.AnalyzedInstanceKey.Port,
+		string(event.Analysis), event.Description, event.ClusterName, event.Keyspace, event.Shard,
+		event.IsActionable, string(steps), event.StartTimestamp, event.EndTimestamp, event.DurationMillis, event.IsSuccessful,
+		successorKey.Hostname, successorKey.Port, string(errors), event.Message, event.ProcessingNodeHostname,
+	)
+	if err != nil {
+		return log.Errore(err)
+	}
+	event.Id, err = sqlResult.LastInsertId()
+	return log.Errore(err)
+}
+
+// readRecoveryAuditEvents reads events from the topology_recovery_audit_event table
+func readRecoveryAuditEvents(whereCondition string, limit string, args []interface{}) ([]*RecoveryAuditEvent, error) {
+	res := []*RecoveryAuditEvent{}
+	query := fmt.Sprintf(`
+		select
+			event_id,
+			event_type,
+			recovery_uid,
+			hostname,
+			port,
+			analysis,
+			description,
+			cluster_name,
+			keyspace,
+			shard,
+			is_actionable,
+			steps,
+			start_timestamp,
+			end_timestamp,
+			duration_millis,
+			is_successful,
+			successor_hostname,
+			successor_port,
+			all_errors,
+			message,
+			processing_node_hostname
+		from
+			topology_recovery_audit_event
+		%s
+		order by
+			event_id desc
+		%s
+		`, whereCondition, limit)
+	err := db.QueryOrchestrator(query, args, func(m sqlutils.RowMap) error {
+		event := &RecoveryAuditEvent{
+			Id:                     m.GetInt64("event_id"),
+			EventType:              AuditEventType(m.GetString("event_type")),
+			RecoveryUID:            m.GetString("recovery_uid"),
+			Analysis:               inst.AnalysisCode(m.GetString("analysis")),
+			Description:            m.GetString("description"),
+			ClusterName:            m.GetString("cluster_name"),
+			Keyspace:               m.GetString("keyspace"),
+			Shard:                  m.GetString("shard"),
+			IsActionable:           m.GetBool("is_actionable"),
+			StartTimestamp:         m.GetString("start_timestamp"),
+			EndTimestamp:           m.GetString("end_timestamp"),
+			DurationMillis:         m.GetInt64("duration_millis"),
+			IsSuccessful:           m.GetBool("is_successful"),
+			Message:                m.GetString("message"),
+			ProcessingNodeHostname: m.GetString("processing_node_hostname"),
+		}
+		event.AnalyzedInstanceKey.Hostname = m.GetString("hostname")
+		event.AnalyzedInstanceKey.Port = m.GetInt("port")
+		if successorHostname := m.GetString("successor_hostname"); successorHostname != "" {
+			event.SuccessorKey = &inst.InstanceKey{Hostname: successorHostname, Port: m.GetInt("successor_port")}
+		}
+		if err := json.Unmarshal([]byte(m.GetString("steps")), &event.Steps); err != nil {
+			return err
+		}
+		if err := json.Unmarshal([]byte(m.GetString("all_errors")), &event.Errors); err != nil {
+			return err
+		}
+		res = append(res, event)
+		return nil
+	})
+	return res, log.Errore(err)
+}
+
+// ReadRecentRecoveryAuditEvents reads the latest audit events, optionally of a cluster, a keyspace or a shard
+func ReadRecentRecoveryAuditEvents(clusterName string, keyspace string, shard string, page int) ([]*RecoveryAuditEvent, error) {
+	whereConditions := []string{}
+	whereClause := ""
+	args := sqlutils.Args()
+	if clusterName != "" {
+		whereConditions = append(whereConditions, `cluster_name=?`)
+		args = append(args, clusterName)
+	}
+	if keyspace != "" {
+		whereConditions = append(whereConditions, `keyspace=?`)
+		args = append(args, keyspace)
+	}
+	if shard != "" {
+		whereConditions = append(whereConditions, `shard=?`)
+		args = append(args, shard)
+	}
+	if len(whereConditions) > 0 {
+		whereClause = fmt.Sprintf("where %s", strings.Join(whereConditions, " and "))
+	}
+	limit := `
+		limit ?
+		offset ?`
+	args = append(args, config.AuditPageSize, page*config.AuditPageSize)
+	return readRecoveryAuditEvents(whereClause, limit, args)
+}
+
+// ReadRecoveryAuditEventsByUID reads the audit events of a recovery
+func ReadRecoveryAuditEventsByUID(recoveryUID string) ([]*RecoveryAuditEvent, error) {
+	whereClause := `where recovery_uid=?`
+	return readRecoveryAuditEvents(whereClause, ``, sqlutils.Args(recoveryUID))
+}
+
+// ExpireRecoveryAuditEventsHistory removes old rows from the topology_recovery_audit_event table
+func ExpireRecoveryAuditEventsHistory() error {
+	return inst.ExpireTableData("topology_recovery_audit_event", "end_timestamp")
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logic
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/orchestrator/inst"
+)
+
+func TestRecoveryAuditEvents(t *testing.T) {
+	analysisEntry := &inst.ReplicationAnalysis{
+		AnalyzedInstanceKey: inst.InstanceKey{Hostname: "host1", Port: 3306},
+		Analysis:            inst.DeadPrimary,
+		ClusterDetails:      inst.ClusterInfo{ClusterName: "ks1"},
+	}
+	detection := newRecoveryAuditEvent(FailureDetectedAuditEvent, analysisEntry, time.Now())
+	require.NoError(t, writeRecoveryAuditEvent(detection))
+
+	recovery := newRecoveryAuditEvent(RecoveryCompletedAuditEvent, analysisEntry, time.Now().Add(-time.Second))
+	recovery.RecoveryUID = "uid1"
+	recovery.IsSuccessful = true
+	recovery.SuccessorKey = &inst.InstanceKey{Hostname: "host2", Port: 3306}
+	recovery.Steps = []string{"step1", "step2"}
+	require.NoError(t, writeRecoveryAuditEvent(recovery))
+
+	events, err := ReadRecentRecoveryAuditEvents("ks1", "", "", 0)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	assert.Equal(t, RecoveryCompletedAuditEvent, events[0].EventType)
+	assert.Equal(t, FailureDetectedAuditEvent, events[1].EventType)
+
+	events, err = ReadRecoveryAuditEventsByUID("uid1")
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, analysisEntry.AnalyzedInstanceKey, events[0].AnalyzedInstanceKey)
+	assert.Equal(t, recovery.SuccessorKey, events[0].SuccessorKey)
+	assert.Equal(t, []string{"step1", "step2"}, events[0].Steps)
+	assert.Equal(t, []string{}, events[0].Errors)
+	assert.True(t, events[0].IsSuccessful)
+	assert.GreaterOrEqual(t, events[0].DurationMillis, int64(1000))
+
+	events, err = ReadRecentRecoveryAuditEvents("ks2", "", "", 0)
+	require.NoError(t, err)
+	assert.Empty(t, events)
+}
+
+func TestPostRecoveryAuditWebhook(t *testing.T) {
+	received := make(chan *RecoveryAuditEvent, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		event := &RecoveryAuditEvent{}
+		if err := json.Unmarshal(body, event); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		received <- event
+	}))
+	defer server.Close()
+
+	body, err := json.Marshal(&RecoveryAuditEvent{EventType: RecoverySkippedAuditEvent, Message: "recoveries are disabled globally"})
+	require.NoError(t, err)
+	require.NoError(t, postRecoveryAuditWebhook(server.URL, body, time.Second))
+	event := <-received
+	assert.Equal(t, RecoverySkippedAuditEvent, event.EventType)
+
+	assert.Error(t, postRecoveryAuditWebhook(server.URL, []byte("not json"), time.Second))
+}
@@ -85,9 +85,15 @@ func refreshTabletsUsing(loader func(instanceKey *inst.InstanceKey)) {
 			log.Errore(err)
 			return
 		}
+		keyspaces, err := ts.GetKeyspaces(ctx)
+		if err != nil {
+			log.Errore(err)
+			return
+		}
 
 		refreshCtx, refreshCancel := context.WithTimeout(context.Background(), *topo.RemoteOperationTimeout)
 		defer refreshCancel()
+		refreshKeyspaces(refreshCtx, keyspaces)
 		var wg sync.WaitGroup
 		for _, cell := range cells {
 			wg.Add(1)
@@ -131,6 +137,13 @@ func refreshTabletsUsing(loader func(instanceKey *inst.InstanceKey)) {
 		}
 		refreshCtx, refreshCancel := context.WithTimeout(context.Background(), *topo.RemoteOperationTimeout)
 		defer refreshCancel()
+		var keyspaces []string
+		for _, ks := range keyspaceShards {
+			if len(keyspaces) == 0 || keyspaces[len(keyspaces)-1] != ks.Keyspace {
+				keyspaces = append(keyspaces, ks.Keyspace)
+			}
+		}
+		refreshKeyspaces(refreshCtx, keyspaces)
 		var wg sync.WaitGroup
 		for _, ks := range keyspaceShards {
 			wg.Add(1)
@@ -143,6 +156,27 @@ func refreshTabletsUsing(loader func(instanceKey *inst.InstanceKey)) {
 	}
 }
 
+// refreshKeyspaces reloads the durability policies of the keyspaces from topo,
+// before their tablets are refreshed.
+func refreshKeyspaces(ctx context.Context, keyspaces []string) {
+	var wg sync.WaitGroup
+	for _, keyspace := range keyspaces {
+		wg.Add(1)
+		go func(keyspace string) {
+			defer wg.Done()
+			ki, err := ts.GetKeyspace(ctx, keyspace)
+			if err != nil {
+				log.Errorf("Error fetching keyspace %v: %v", keyspace, err)
+				return
+			}
+			if err := inst.SetKeyspaceDurabilityPolicy(keyspace, ki.DurabilityPolicy); err != nil {
+				log.Errorf("Invalid durability policy of keyspace %v: %v", keyspace, err)
+			}
+		}(keyspace)
+	}
+	wg.Wait()
+}
+
 func refreshTabletsInCell(ctx context.Context, cell string, loader func(instanceKey *inst.InstanceKey)) {
 	tablets, err := topotools.GetTabletMapForCell(ctx, ts, cell)
 	if err != nil {
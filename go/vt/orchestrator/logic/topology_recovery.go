@@ -17,6 +17,7 @@
 package logic
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"math/rand"
@@ -39,6 +40,9 @@ import (
 	"vitess.io/vitess/go/vt/orchestrator/process"
 	"vitess.io/vitess/go/vt/orchestrator/util"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vtctl/reparentutil"
 )
 
 var countPendingRecoveries int64
@@ -617,6 +621,19 @@ func SuggestReplacementForPromotedReplica(topologyRecovery *TopologyRecovery, de
 	// Maybe we promoted a server in a different DC than the primary
 	// There's many options. We may wish to replace the server we promoted with a better one.
 	AuditTopologyRecovery(topologyRecovery, "checking if should replace promoted replica with a better candidate")
+	if candidateInstanceKey == nil && candidateSelectionRulesConfigured() {
+		AuditTopologyRecovery(topologyRecovery, "+ choosing a candidate with the candidate selection rules")
+		if candidate := chooseCandidateBySelectionRules(topologyRecovery, deadInstanceKey, promotedReplica); candidate != nil {
+			if satisfied, reason := PrimaryFailoverGeographicConstraintSatisfied(&topologyRecovery.AnalysisEntry, candidate); !satisfied {
+				AuditTopologyRecovery(topologyRecovery, fmt.Sprintf("skipping %+v; %s", candidate.Key, reason))
+			} else if candidate.Key.Equals(&promotedReplica.Key) {
+				AuditTopologyRecovery(topologyRecovery, fmt.Sprintf("promoted replica %+v is the best candidate", promotedReplica.Key))
+				return promotedReplica, false, nil
+			} else {
+				candidateInstanceKey = &candidate.Key
+			}
+		}
+	}
 	if candidateInstanceKey == nil {
 		AuditTopologyRecovery(topologyRecovery, "+ checking if promoted replica is the ideal candidate")
 		if deadInstance != nil {
@@ -1032,6 +1049,75 @@ func isGenerallyValidAsWouldBePrimary(replica *inst.Instance, requireLogReplicat
 	return true
 }
 
+// candidateSelectionRules returns the candidate selection rules of the
+// configuration. MaxLostTransactions does not apply: the replacement of a
+// promoted replica replicates from it, and catches up before taking over.
+func candidateSelectionRules() reparentutil.CandidateSelectionRules {
+	return reparentutil.CandidateSelectionRules{
+		PreferredCells:            config.Config.ReparentPreferredCells,
+		PreventCrossCellPromotion: config.Config.ReparentPreventCrossCellPromotion,
+		AvoidPrimaryHost:          config.Config.ReparentAvoidPrimaryHost,
+		AntiAffinityTags:          config.Config.ReparentAntiAffinityTags,
+		RequiredTags:              config.Config.ReparentRequiredTags,
+	}
+}
+
+// candidateSelectionRulesConfigured returns true if any candidate selection
+// rule is configured.
+func candidateSelectionRulesConfigured() bool {
+	return len(config.Config.ReparentPreferredCells) > 0 ||
+		config.Config.ReparentPreventCrossCellPromotion ||
+		config.Config.ReparentAvoidPrimaryHost ||
+		len(config.Config.ReparentAntiAffinityTags) > 0 ||
+		len(config.Config.ReparentRequiredTags) > 0
+}
+
+// chooseCandidateBySelectionRules chooses, with the candidate selection
+// rules, among the promoted replica and its replicas which can take over
+// from it. It returns nil if none of them is eligible.
+func chooseCandidateBySelectionRules(topologyRecovery *TopologyRecovery, deadInstanceKey *inst.InstanceKey, promotedReplica *inst.Instance) *inst.Instance {
+	instances := map[string]*inst.Instance{}
+	candidates := map[string]*topodatapb.Tablet{}
+	addCandidate := func(instance *inst.Instance) {
+		tablet, err := inst.ReadTablet(instance.Key)
+		if err != nil {
+			AuditTopologyRecovery(topologyRecovery, fmt.Sprintf("skipping %+v; cannot read its tablet: %v", instance.Key, err))
+			return
+		}
+		alias := topoproto.TabletAliasString(tablet.Alias)
+		instances[alias] = instance
+		candidates[alias] = tablet
+	}
+	addCandidate(promotedReplica)
+	replicas, _ := inst.ReadReplicaInstances(&promotedReplica.Key)
+	for _, replica := range replicas {
+		if canTakeOverPromotedServerAsPrimary(replica, promotedReplica) {
+			addCandidate(replica)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	failedPrimary, err := inst.ReadTablet(*deadInstanceKey)
+	if err != nil {
+		failedPrimary = nil
+	}
+	var durability reparentutil.Durabler
+	for _, tablet := range candidates {
+		durability = inst.DurabilityPolicy(tablet.Keyspace)
+		break
+	}
+	// The positions are nil: the candidates are equally advanced, since
+	// they catch up with the promoted replica before taking over.
+	selection, err := reparentutil.ChooseEmergencyReparentCandidate(candidateSelectionRules(), durability, failedPrimary, candidates, nil)
+	AuditTopologyRecovery(topologyRecovery, selection.Reason)
+	if err != nil {
+		return nil
+	}
+	return instances[topoproto.TabletAliasString(selection.Chosen)]
+}
+
 func canTakeOverPromotedServerAsPrimary(wantToTakeOver *inst.Instance, toBeTakenOver *inst.Instance) bool {
 	if !isGenerallyValidAsWouldBePrimary(wantToTakeOver, true) {
 		return false
@@ -1601,11 +1687,34 @@ func runEmergentOperations(analysisEntry *inst.ReplicationAnalysis) {
 	}
 }
 
+// topoRecoveriesDisabledReason returns why the recoveries of the shard of
+// the instance are disabled by the RecoverySettings in the topo, or an empty
+// string if they are enabled. The recoveries proceed if the settings cannot
+// be read.
+func topoRecoveriesDisabledReason(instanceKey *inst.InstanceKey) string {
+	if ts == nil {
+		return ""
+	}
+	tablet, err := inst.ReadTablet(*instanceKey)
+	if err != nil {
+		return ""
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), *topo.RemoteOperationTimeout)
+	defer cancel()
+	settings, err := ts.GetRecoverySettings(ctx)
+	if err != nil {
+		log.Errorf("Unable to read the recovery settings from the topo: %v", err)
+		return ""
+	}
+	return topoproto.RecoveriesDisabledReason(settings, tablet.Keyspace, tablet.Shard, time.Now())
+}
+
 // executeCheckAndRecoverFunction will choose the correct check & recovery function based on analysis.
 // It executes the function synchronuously
 func executeCheckAndRecoverFunction(analysisEntry inst.ReplicationAnalysis, candidateInstanceKey *inst.InstanceKey, forceInstanceRecovery bool, skipProcesses bool) (recoveryAttempted bool, topologyRecovery *TopologyRecovery, err error) {
 	atomic.AddInt64(&countPendingRecoveries, 1)
 	defer atomic.AddInt64(&countPendingRecoveries, -1)
+	start := time.Now()
 
 	checkAndRecoverFunction, isActionableRecovery := getCheckAndRecoverFunction(analysisEntry.Analysis, &analysisEntry.AnalyzedInstanceKey)
 	analysisEntry.IsActionableRecovery = isActionableRecovery
@@ -1630,11 +1739,15 @@ func executeCheckAndRecoverFunction(analysisEntry inst.ReplicationAnalysis, cand
 	// At this point we have validated there's a failure scenario for which we have a recovery path.
 
 	// Initiate detection:
-	_, _, err = checkAndExecuteFailureDetectionProcesses(analysisEntry, skipProcesses)
+	detectionRegistrationSuccess, _, err := checkAndExecuteFailureDetectionProcesses(analysisEntry, skipProcesses)
 	if err != nil {
 		log.Errorf("executeCheckAndRecoverFunction: error on failure detection: %+v", err)
 		return false, nil, err
 	}
+	// Only the first detection of a failure is audited.
+	if detectionRegistrationSuccess {
+		auditRecoveryEvent(newRecoveryAuditEvent(FailureDetectedAuditEvent, &analysisEntry, start))
+	}
 	// We don't mind whether detection really executed the processes or not
 	// (it may have been silenced due to previous detection). We only care there's no error.
 
@@ -1649,6 +1762,11 @@ func executeCheckAndRecoverFunction(analysisEntry inst.ReplicationAnalysis, cand
 			log.Infof("CheckAndRecover: Analysis: %+v, InstanceKey: %+v, candidateInstanceKey: %+v, "+
 				"skipProcesses: %v: NOT Recovering host (disabled globally)",
 				analysisEntry.Analysis, analysisEntry.AnalyzedInstanceKey, candidateInstanceKey, skipProcesses)
+			if detectionRegistrationSuccess {
+				event := newRecoveryAuditEvent(RecoverySkippedAuditEvent, &analysisEntry, start)
+				event.Message = "recoveries are disabled globally"
+				auditRecoveryEvent(event)
+			}
 
 			return false, nil, err
 		}
@@ -1657,6 +1775,26 @@ func executeCheckAndRecoverFunction(analysisEntry inst.ReplicationAnalysis, cand
 			analysisEntry.Analysis, analysisEntry.AnalyzedInstanceKey, candidateInstanceKey, skipProcesses)
 	}
 
+	// Check for recovery being disabled for the shard in the topo, or for a
+	// maintenance window.
+	if reason := topoRecoveriesDisabledReason(&analysisEntry.AnalyzedInstanceKey); reason != "" {
+		if !forceInstanceRecovery {
+			log.Infof("CheckAndRecover: Analysis: %+v, InstanceKey: %+v, candidateInstanceKey: %+v, "+
+				"skipProcesses: %v: NOT Recovering host (%v)",
+				analysisEntry.Analysis, analysisEntry.AnalyzedInstanceKey, candidateInstanceKey, skipProcesses, reason)
+			if detectionRegistrationSuccess {
+				event := newRecoveryAuditEvent(RecoverySkippedAuditEvent, &analysisEntry, start)
+				event.Message = reason
+				auditRecoveryEvent(event)
+			}
+
+			return false, nil, nil
+		}
+		log.Infof("CheckAndRecover: Analysis: %+v, InstanceKey: %+v, candidateInstanceKey: %+v, "+
+			"skipProcesses: %v: %v but forcing this recovery",
+			analysisEntry.Analysis, analysisEntry.AnalyzedInstanceKey, candidateInstanceKey, skipProcesses, reason)
+	}
+
 	// Actually attempt recovery:
 	if isActionableRecovery || util.ClearToLog("executeCheckAndRecoverFunction: recovery", analysisEntry.AnalyzedInstanceKey.StringCode()) {
 		log.Infof("executeCheckAndRecoverFunction: proceeding with %+v recovery on %+v; isRecoverable?: %+v; skipProcesses: %+v", analysisEntry.Analysis, analysisEntry.AnalyzedInstanceKey, isActionableRecovery, skipProcesses)
@@ -1689,6 +1827,11 @@ func executeCheckAndRecoverFunction(analysisEntry inst.ReplicationAnalysis, cand
 	if topologyRecovery.PostponedFunctionsContainer.Len() > 0 {
 		AuditTopologyRecovery(topologyRecovery, fmt.Sprintf("Executed postponed functions: %+v", strings.Join(topologyRecovery.PostponedFunctionsContainer.Descriptions(), ", ")))
 	}
+	event := newRecoveryCompletedAuditEvent(topologyRecovery, start)
+	if err != nil {
+		event.Message = err.Error()
+	}
+	auditRecoveryEvent(event)
 	return recoveryAttempted, topologyRecovery, err
 }
 
@@ -0,0 +1,104 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package auditlogservice
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	auditlogdata "vitess.io/vitess/go/vt/proto/auditlogdata"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// AuditLogClient is the client API for AuditLog service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AuditLogClient interface {
+	// Record stores the given events.
+	Record(ctx context.Context, in *auditlogdata.RecordRequest, opts ...grpc.CallOption) (*auditlogdata.RecordResponse, error)
+}
+
+type auditLogClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAuditLogClient(cc grpc.ClientConnInterface) AuditLogClient {
+	return &auditLogClient{cc}
+}
+
+func (c *auditLogClient) Record(ctx context.Context, in *auditlogdata.RecordRequest, opts ...grpc.CallOption) (*auditlogdata.RecordResponse, error) {
+	out := new(auditlogdata.RecordResponse)
+	err := c.cc.Invoke(ctx, "/auditlogservice.AuditLog/Record", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AuditLogServer is the server API for AuditLog service.
+// All implementations must embed UnimplementedAuditLogServer
+// for forward compatibility
+type AuditLogServer interface {
+	// Record stores the given events.
+	Record(context.Context, *auditlogdata.RecordRequest) (*auditlogdata.RecordResponse, error)
+	mustEmbedUnimplementedAuditLogServer()
+}
+
+// UnimplementedAuditLogServer must be embedded to have forward compatible implementations.
+type UnimplementedAuditLogServer struct {
+}
+
+func (UnimplementedAuditLogServer) Record(context.Context, *auditlogdata.RecordRequest) (*auditlogdata.RecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Record not implemented")
+}
+func (UnimplementedAuditLogServer) mustEmbedUnimplementedAuditLogServer() {}
+
+// UnsafeAuditLogServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AuditLogServer will
+// result in compilation errors.
+type UnsafeAuditLogServer interface {
+	mustEmbedUnimplementedAuditLogServer()
+}
+
+func RegisterAuditLogServer(s grpc.ServiceRegistrar, srv AuditLogServer) {
+	s.RegisterService(&AuditLog_ServiceDesc, srv)
+}
+
+func _AuditLog_Record_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(auditlogdata.RecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuditLogServer).Record(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auditlogservice.AuditLog/Record",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuditLogServer).Record(ctx, req.(*auditlogdata.RecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AuditLog_ServiceDesc is the grpc.ServiceDesc for AuditLog service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AuditLog_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "auditlogservice.AuditLog",
+	HandlerType: (*AuditLogServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Record",
+			Handler:    _AuditLog_Record_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "auditlogservice.proto",
+}
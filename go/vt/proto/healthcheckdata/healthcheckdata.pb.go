@@ -0,0 +1,453 @@
+//
+//Copyright 2021 The Vitess Authors.
+//
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+// Data structures for the health check RPC interface.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.6.1
+// source: healthcheckdata.proto
+
+package healthcheckdata
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	query "vitess.io/vitess/go/vt/proto/query"
+	topodata "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// TabletState is the state of a tablet, as seen by the health check.
+type TabletState int32
+
+const (
+	// UNKNOWN is the state of a tablet which did not report its health yet.
+	TabletState_UNKNOWN TabletState = 0
+	// UP tablets are serving, with a low enough replication lag.
+	TabletState_UP TabletState = 1
+	// LAGGING tablets are serving, but with a replication lag above
+	// discovery_low_replication_lag. Queries are only routed to them if there
+	// are not enough healthy tablets.
+	TabletState_LAGGING TabletState = 2
+	// DOWN tablets are not serving, or their health check failed.
+	TabletState_DOWN TabletState = 3
+)
+
+// Enum value maps for TabletState.
+var (
+	TabletState_name = map[int32]string{
+		0: "UNKNOWN",
+		1: "UP",
+		2: "LAGGING",
+		3: "DOWN",
+	}
+	TabletState_value = map[string]int32{
+		"UNKNOWN": 0,
+		"UP":      1,
+		"LAGGING": 2,
+		"DOWN":    3,
+	}
+)
+
+func (x TabletState) Enum() *TabletState {
+	p := new(TabletState)
+	*p = x
+	return p
+}
+
+func (x TabletState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TabletState) Descriptor() protoreflect.EnumDescriptor {
+	return file_healthcheckdata_proto_enumTypes[0].Descriptor()
+}
+
+func (TabletState) Type() protoreflect.EnumType {
+	return &file_healthcheckdata_proto_enumTypes[0]
+}
+
+func (x TabletState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TabletState.Descriptor instead.
+func (TabletState) EnumDescriptor() ([]byte, []int) {
+	return file_healthcheckdata_proto_rawDescGZIP(), []int{0}
+}
+
+// TabletHealth is the health of a tablet.
+type TabletHealth struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tablet               *topodata.Tablet     `protobuf:"bytes,1,opt,name=tablet,proto3" json:"tablet,omitempty"`
+	Target               *query.Target        `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	State                TabletState          `protobuf:"varint,3,opt,name=state,proto3,enum=healthcheckdata.TabletState" json:"state,omitempty"`
+	Serving              bool                 `protobuf:"varint,4,opt,name=serving,proto3" json:"serving,omitempty"`
+	Stats                *query.RealtimeStats `protobuf:"bytes,5,opt,name=stats,proto3" json:"stats,omitempty"`
+	PrimaryTermStartTime int64                `protobuf:"varint,6,opt,name=primary_term_start_time,json=primaryTermStartTime,proto3" json:"primary_term_start_time,omitempty"`
+	// last_error is the error of the health check of the tablet, if any.
+	LastError string `protobuf:"bytes,7,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+}
+
+func (x *TabletHealth) Reset() {
+	*x = TabletHealth{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_healthcheckdata_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TabletHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TabletHealth) ProtoMessage() {}
+
+func (x *TabletHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_healthcheckdata_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TabletHealth.ProtoReflect.Descriptor instead.
+func (*TabletHealth) Descriptor() ([]byte, []int) {
+	return file_healthcheckdata_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TabletHealth) GetTablet() *topodata.Tablet {
+	if x != nil {
+		return x.Tablet
+	}
+	return nil
+}
+
+func (x *TabletHealth) GetTarget() *query.Target {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *TabletHealth) GetState() TabletState {
+	if x != nil {
+		return x.State
+	}
+	return TabletState_UNKNOWN
+}
+
+func (x *TabletHealth) GetServing() bool {
+	if x != nil {
+		return x.Serving
+	}
+	return false
+}
+
+func (x *TabletHealth) GetStats() *query.RealtimeStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+func (x *TabletHealth) GetPrimaryTermStartTime() int64 {
+	if x != nil {
+		return x.PrimaryTermStartTime
+	}
+	return 0
+}
+
+func (x *TabletHealth) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+// StreamHealthCheckRequest is the payload for the StreamHealthCheck RPC.
+type StreamHealthCheckRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// keyspaces restricts the stream to the tablets of these keyspaces, if set.
+	Keyspaces []string `protobuf:"bytes,1,rep,name=keyspaces,proto3" json:"keyspaces,omitempty"`
+	// tablet_types restricts the stream to the tablets of these types, if set.
+	TabletTypes []topodata.TabletType `protobuf:"varint,2,rep,packed,name=tablet_types,json=tabletTypes,proto3,enum=topodata.TabletType" json:"tablet_types,omitempty"`
+}
+
+func (x *StreamHealthCheckRequest) Reset() {
+	*x = StreamHealthCheckRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_healthcheckdata_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamHealthCheckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamHealthCheckRequest) ProtoMessage() {}
+
+func (x *StreamHealthCheckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_healthcheckdata_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamHealthCheckRequest.ProtoReflect.Descriptor instead.
+func (*StreamHealthCheckRequest) Descriptor() ([]byte, []int) {
+	return file_healthcheckdata_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StreamHealthCheckRequest) GetKeyspaces() []string {
+	if x != nil {
+		return x.Keyspaces
+	}
+	return nil
+}
+
+func (x *StreamHealthCheckRequest) GetTabletTypes() []topodata.TabletType {
+	if x != nil {
+		return x.TabletTypes
+	}
+	return nil
+}
+
+// StreamHealthCheckResponse is streamed by the StreamHealthCheck RPC.
+type StreamHealthCheckResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TabletHealth *TabletHealth `protobuf:"bytes,1,opt,name=tablet_health,json=tabletHealth,proto3" json:"tablet_health,omitempty"`
+}
+
+func (x *StreamHealthCheckResponse) Reset() {
+	*x = StreamHealthCheckResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_healthcheckdata_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamHealthCheckResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamHealthCheckResponse) ProtoMessage() {}
+
+func (x *StreamHealthCheckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_healthcheckdata_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamHealthCheckResponse.ProtoReflect.Descriptor instead.
+func (*StreamHealthCheckResponse) Descriptor() ([]byte, []int) {
+	return file_healthcheckdata_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *StreamHealthCheckResponse) GetTabletHealth() *TabletHealth {
+	if x != nil {
+		return x.TabletHealth
+	}
+	return nil
+}
+
+var File_healthcheckdata_proto protoreflect.FileDescriptor
+
+var file_healthcheckdata_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x64, 0x61, 0x74,
+	0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x0b, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0e, 0x74, 0x6f, 0x70, 0x6f, 0x64, 0x61, 0x74, 0x61, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xaf, 0x02, 0x0a, 0x0c, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x74,
+	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x28, 0x0a, 0x06, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x6f, 0x70, 0x6f, 0x64, 0x61, 0x74,
+	0x61, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x52, 0x06, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x74,
+	0x12, 0x25, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0d, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52,
+	0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x32, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x6e, 0x67, 0x12, 0x2a, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x52, 0x65, 0x61,
+	0x6c, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x73, 0x12, 0x35, 0x0a, 0x17, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x5f, 0x74, 0x65, 0x72,
+	0x6d, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x14, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x54, 0x65, 0x72, 0x6d, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74,
+	0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61,
+	0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x71, 0x0a, 0x18, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6b, 0x65, 0x79, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x6b, 0x65, 0x79, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x73, 0x12, 0x37, 0x0a, 0x0c, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x74, 0x6f, 0x70, 0x6f, 0x64, 0x61,
+	0x74, 0x61, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x22, 0x5f, 0x0a, 0x19, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x0d, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x74, 0x5f, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x64, 0x61, 0x74, 0x61,
+	0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x0c, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2a, 0x39, 0x0a, 0x0b, 0x54,
+	0x61, 0x62, 0x6c, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e,
+	0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x06, 0x0a, 0x02, 0x55, 0x50, 0x10, 0x01, 0x12,
+	0x0b, 0x0a, 0x07, 0x4c, 0x41, 0x47, 0x47, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04,
+	0x44, 0x4f, 0x57, 0x4e, 0x10, 0x03, 0x42, 0x2e, 0x5a, 0x2c, 0x76, 0x69, 0x74, 0x65, 0x73, 0x73,
+	0x2e, 0x69, 0x6f, 0x2f, 0x76, 0x69, 0x74, 0x65, 0x73, 0x73, 0x2f, 0x67, 0x6f, 0x2f, 0x76, 0x74,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x64, 0x61, 0x74, 0x61, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_healthcheckdata_proto_rawDescOnce sync.Once
+	file_healthcheckdata_proto_rawDescData = file_healthcheckdata_proto_rawDesc
+)
+
+func file_healthcheckdata_proto_rawDescGZIP() []byte {
+	file_healthcheckdata_proto_rawDescOnce.Do(func() {
+		file_healthcheckdata_proto_rawDescData = protoimpl.X.CompressGZIP(file_healthcheckdata_proto_rawDescData)
+	})
+	return file_healthcheckdata_proto_rawDescData
+}
+
+var file_healthcheckdata_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_healthcheckdata_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_healthcheckdata_proto_goTypes = []interface{}{
+	(TabletState)(0),                  // 0: healthcheckdata.TabletState
+	(*TabletHealth)(nil),              // 1: healthcheckdata.TabletHealth
+	(*StreamHealthCheckRequest)(nil),  // 2: healthcheckdata.StreamHealthCheckRequest
+	(*StreamHealthCheckResponse)(nil), // 3: healthcheckdata.StreamHealthCheckResponse
+	(*topodata.Tablet)(nil),           // 4: topodata.Tablet
+	(*query.Target)(nil),              // 5: query.Target
+	(*query.RealtimeStats)(nil),       // 6: query.RealtimeStats
+	(topodata.TabletType)(0),          // 7: topodata.TabletType
+}
+var file_healthcheckdata_proto_depIdxs = []int32{
+	4, // 0: healthcheckdata.TabletHealth.tablet:type_name -> topodata.Tablet
+	5, // 1: healthcheckdata.TabletHealth.target:type_name -> query.Target
+	0, // 2: healthcheckdata.TabletHealth.state:type_name -> healthcheckdata.TabletState
+	6, // 3: healthcheckdata.TabletHealth.stats:type_name -> query.RealtimeStats
+	7, // 4: healthcheckdata.StreamHealthCheckRequest.tablet_types:type_name -> topodata.TabletType
+	1, // 5: healthcheckdata.StreamHealthCheckResponse.tablet_health:type_name -> healthcheckdata.TabletHealth
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_healthcheckdata_proto_init() }
+func file_healthcheckdata_proto_init() {
+	if File_healthcheckdata_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_healthcheckdata_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TabletHealth); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_healthcheckdata_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamHealthCheckRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_healthcheckdata_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamHealthCheckResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_healthcheckdata_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_healthcheckdata_proto_goTypes,
+		DependencyIndexes: file_healthcheckdata_proto_depIdxs,
+		EnumInfos:         file_healthcheckdata_proto_enumTypes,
+		MessageInfos:      file_healthcheckdata_proto_msgTypes,
+	}.Build()
+	File_healthcheckdata_proto = out.File
+	file_healthcheckdata_proto_rawDesc = nil
+	file_healthcheckdata_proto_goTypes = nil
+	file_healthcheckdata_proto_depIdxs = nil
+}
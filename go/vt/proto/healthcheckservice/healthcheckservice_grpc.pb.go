@@ -0,0 +1,133 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package healthcheckservice
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	healthcheckdata "vitess.io/vitess/go/vt/proto/healthcheckdata"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// HealthCheckClient is the client API for HealthCheck service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type HealthCheckClient interface {
+	// StreamHealthCheck streams the health of all the tablets known to the
+	// health check first, and then every change in their health.
+	StreamHealthCheck(ctx context.Context, in *healthcheckdata.StreamHealthCheckRequest, opts ...grpc.CallOption) (HealthCheck_StreamHealthCheckClient, error)
+}
+
+type healthCheckClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewHealthCheckClient(cc grpc.ClientConnInterface) HealthCheckClient {
+	return &healthCheckClient{cc}
+}
+
+func (c *healthCheckClient) StreamHealthCheck(ctx context.Context, in *healthcheckdata.StreamHealthCheckRequest, opts ...grpc.CallOption) (HealthCheck_StreamHealthCheckClient, error) {
+	stream, err := c.cc.NewStream(ctx, &HealthCheck_ServiceDesc.Streams[0], "/healthcheckservice.HealthCheck/StreamHealthCheck", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &healthCheckStreamHealthCheckClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type HealthCheck_StreamHealthCheckClient interface {
+	Recv() (*healthcheckdata.StreamHealthCheckResponse, error)
+	grpc.ClientStream
+}
+
+type healthCheckStreamHealthCheckClient struct {
+	grpc.ClientStream
+}
+
+func (x *healthCheckStreamHealthCheckClient) Recv() (*healthcheckdata.StreamHealthCheckResponse, error) {
+	m := new(healthcheckdata.StreamHealthCheckResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// HealthCheckServer is the server API for HealthCheck service.
+// All implementations must embed UnimplementedHealthCheckServer
+// for forward compatibility
+type HealthCheckServer interface {
+	// StreamHealthCheck streams the health of all the tablets known to the
+	// health check first, and then every change in their health.
+	StreamHealthCheck(*healthcheckdata.StreamHealthCheckRequest, HealthCheck_StreamHealthCheckServer) error
+	mustEmbedUnimplementedHealthCheckServer()
+}
+
+// UnimplementedHealthCheckServer must be embedded to have forward compatible implementations.
+type UnimplementedHealthCheckServer struct {
+}
+
+func (UnimplementedHealthCheckServer) StreamHealthCheck(*healthcheckdata.StreamHealthCheckRequest, HealthCheck_StreamHealthCheckServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamHealthCheck not implemented")
+}
+func (UnimplementedHealthCheckServer) mustEmbedUnimplementedHealthCheckServer() {}
+
+// UnsafeHealthCheckServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to HealthCheckServer will
+// result in compilation errors.
+type UnsafeHealthCheckServer interface {
+	mustEmbedUnimplementedHealthCheckServer()
+}
+
+func RegisterHealthCheckServer(s grpc.ServiceRegistrar, srv HealthCheckServer) {
+	s.RegisterService(&HealthCheck_ServiceDesc, srv)
+}
+
+func _HealthCheck_StreamHealthCheck_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(healthcheckdata.StreamHealthCheckRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(HealthCheckServer).StreamHealthCheck(m, &healthCheckStreamHealthCheckServer{stream})
+}
+
+type HealthCheck_StreamHealthCheckServer interface {
+	Send(*healthcheckdata.StreamHealthCheckResponse) error
+	grpc.ServerStream
+}
+
+type healthCheckStreamHealthCheckServer struct {
+	grpc.ServerStream
+}
+
+func (x *healthCheckStreamHealthCheckServer) Send(m *healthcheckdata.StreamHealthCheckResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// HealthCheck_ServiceDesc is the grpc.ServiceDesc for HealthCheck service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var HealthCheck_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "healthcheckservice.HealthCheck",
+	HandlerType: (*HealthCheckServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamHealthCheck",
+			Handler:       _HealthCheck_StreamHealthCheck_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "healthcheckservice.proto",
+}
@@ -100,6 +100,61 @@ func (BackupInfo_Status) EnumDescriptor() ([]byte, []int) {
 	return file_mysqlctl_proto_rawDescGZIP(), []int{10, 0}
 }
 
+// Level is how thoroughly a backup is verified.
+type BackupVerification_Level int32
+
+const (
+	// MANIFEST checks that the MANIFEST can be read, and that every file
+	// it lists exists in the backup storage.
+	BackupVerification_MANIFEST BackupVerification_Level = 0
+	// CHECKSUM reads every file of the backup, and compares its hash
+	// with the one stored in the MANIFEST.
+	BackupVerification_CHECKSUM BackupVerification_Level = 1
+	// RESTORE restores the backup on a scratch tablet.
+	BackupVerification_RESTORE BackupVerification_Level = 2
+)
+
+// Enum value maps for BackupVerification_Level.
+var (
+	BackupVerification_Level_name = map[int32]string{
+		0: "MANIFEST",
+		1: "CHECKSUM",
+		2: "RESTORE",
+	}
+	BackupVerification_Level_value = map[string]int32{
+		"MANIFEST": 0,
+		"CHECKSUM": 1,
+		"RESTORE":  2,
+	}
+)
+
+func (x BackupVerification_Level) Enum() *BackupVerification_Level {
+	p := new(BackupVerification_Level)
+	*p = x
+	return p
+}
+
+func (x BackupVerification_Level) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BackupVerification_Level) Descriptor() protoreflect.EnumDescriptor {
+	return file_mysqlctl_proto_enumTypes[1].Descriptor()
+}
+
+func (BackupVerification_Level) Type() protoreflect.EnumType {
+	return &file_mysqlctl_proto_enumTypes[1]
+}
+
+func (x BackupVerification_Level) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BackupVerification_Level.Descriptor instead.
+func (BackupVerification_Level) EnumDescriptor() ([]byte, []int) {
+	return file_mysqlctl_proto_rawDescGZIP(), []int{11, 0}
+}
+
 type StartRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -604,6 +659,100 @@ func (x *BackupInfo) GetStatus() BackupInfo_Status {
 	return BackupInfo_UNKNOWN
 }
 
+// BackupVerification records the outcome of the last verification of a
+// backup. It is stored in the VERIFICATION file of the backup, next to its
+// MANIFEST.
+type BackupVerification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BackupName string                   `protobuf:"bytes,1,opt,name=backup_name,json=backupName,proto3" json:"backup_name,omitempty"`
+	Level      BackupVerification_Level `protobuf:"varint,2,opt,name=level,proto3,enum=mysqlctl.BackupVerification_Level" json:"level,omitempty"`
+	// Status is VALID if the backup passed verification, INVALID otherwise.
+	Status BackupInfo_Status `protobuf:"varint,3,opt,name=status,proto3,enum=mysqlctl.BackupInfo_Status" json:"status,omitempty"`
+	Time   *vttime.Time      `protobuf:"bytes,4,opt,name=time,proto3" json:"time,omitempty"`
+	// Error describes why the backup failed verification, if it did.
+	Error string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	// TabletAlias is the scratch tablet the backup was restored on, for
+	// verifications at the RESTORE level.
+	TabletAlias *topodata.TabletAlias `protobuf:"bytes,6,opt,name=tablet_alias,json=tabletAlias,proto3" json:"tablet_alias,omitempty"`
+}
+
+func (x *BackupVerification) Reset() {
+	*x = BackupVerification{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mysqlctl_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BackupVerification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackupVerification) ProtoMessage() {}
+
+func (x *BackupVerification) ProtoReflect() protoreflect.Message {
+	mi := &file_mysqlctl_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackupVerification.ProtoReflect.Descriptor instead.
+func (*BackupVerification) Descriptor() ([]byte, []int) {
+	return file_mysqlctl_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *BackupVerification) GetBackupName() string {
+	if x != nil {
+		return x.BackupName
+	}
+	return ""
+}
+
+func (x *BackupVerification) GetLevel() BackupVerification_Level {
+	if x != nil {
+		return x.Level
+	}
+	return BackupVerification_MANIFEST
+}
+
+func (x *BackupVerification) GetStatus() BackupInfo_Status {
+	if x != nil {
+		return x.Status
+	}
+	return BackupInfo_UNKNOWN
+}
+
+func (x *BackupVerification) GetTime() *vttime.Time {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *BackupVerification) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *BackupVerification) GetTabletAlias() *topodata.TabletAlias {
+	if x != nil {
+		return x.TabletAlias
+	}
+	return nil
+}
+
 var File_mysqlctl_proto protoreflect.FileDescriptor
 
 var file_mysqlctl_proto_rawDesc = []byte{
@@ -651,35 +800,55 @@ var file_mysqlctl_proto_rawDesc = []byte{
 	0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x49, 0x4e, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x10,
 	0x01, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x02, 0x12,
 	0x0b, 0x0a, 0x07, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x03, 0x12, 0x09, 0x0a, 0x05,
-	0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x04, 0x32, 0x8a, 0x03, 0x0a, 0x08, 0x4d, 0x79, 0x73, 0x71,
-	0x6c, 0x43, 0x74, 0x6c, 0x12, 0x3a, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x16, 0x2e,
-	0x6d, 0x79, 0x73, 0x71, 0x6c, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x6d, 0x79, 0x73, 0x71, 0x6c, 0x63, 0x74, 0x6c,
-	0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x43, 0x0a, 0x08, 0x53, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x12, 0x19, 0x2e, 0x6d,
-	0x79, 0x73, 0x71, 0x6c, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6d, 0x79, 0x73, 0x71, 0x6c, 0x63,
-	0x74, 0x6c, 0x2e, 0x53, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x0f, 0x52, 0x75, 0x6e, 0x4d, 0x79, 0x73, 0x71,
-	0x6c, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x12, 0x20, 0x2e, 0x6d, 0x79, 0x73, 0x71, 0x6c,
-	0x63, 0x74, 0x6c, 0x2e, 0x52, 0x75, 0x6e, 0x4d, 0x79, 0x73, 0x71, 0x6c, 0x55, 0x70, 0x67, 0x72,
-	0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6d, 0x79, 0x73,
-	0x71, 0x6c, 0x63, 0x74, 0x6c, 0x2e, 0x52, 0x75, 0x6e, 0x4d, 0x79, 0x73, 0x71, 0x6c, 0x55, 0x70,
-	0x67, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x4f, 0x0a, 0x0c, 0x52, 0x65, 0x69, 0x6e, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
-	0x1d, 0x2e, 0x6d, 0x79, 0x73, 0x71, 0x6c, 0x63, 0x74, 0x6c, 0x2e, 0x52, 0x65, 0x69, 0x6e, 0x69,
-	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
-	0x2e, 0x6d, 0x79, 0x73, 0x71, 0x6c, 0x63, 0x74, 0x6c, 0x2e, 0x52, 0x65, 0x69, 0x6e, 0x69, 0x74,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x52, 0x0a, 0x0d, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x12, 0x1e, 0x2e, 0x6d, 0x79, 0x73, 0x71, 0x6c, 0x63, 0x74, 0x6c, 0x2e, 0x52, 0x65, 0x66,
-	0x72, 0x65, 0x73, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1f, 0x2e, 0x6d, 0x79, 0x73, 0x71, 0x6c, 0x63, 0x74, 0x6c, 0x2e, 0x52, 0x65, 0x66,
-	0x72, 0x65, 0x73, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x42, 0x27, 0x5a, 0x25, 0x76, 0x69, 0x74, 0x65, 0x73, 0x73, 0x2e, 0x69,
-	0x6f, 0x2f, 0x76, 0x69, 0x74, 0x65, 0x73, 0x73, 0x2f, 0x67, 0x6f, 0x2f, 0x76, 0x74, 0x2f, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6d, 0x79, 0x73, 0x71, 0x6c, 0x63, 0x74, 0x6c, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x04, 0x22, 0xc8, 0x02, 0x0a, 0x12, 0x42, 0x61, 0x63, 0x6b,
+	0x75, 0x70, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f,
+	0x0a, 0x0b, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x38, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22,
+	0x2e, 0x6d, 0x79, 0x73, 0x71, 0x6c, 0x63, 0x74, 0x6c, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70,
+	0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4c, 0x65, 0x76,
+	0x65, 0x6c, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x33, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x6d, 0x79, 0x73, 0x71,
+	0x6c, 0x63, 0x74, 0x6c, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x20,
+	0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x76,
+	0x74, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x38, 0x0a, 0x0c, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x74,
+	0x5f, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74,
+	0x6f, 0x70, 0x6f, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x41, 0x6c,
+	0x69, 0x61, 0x73, 0x52, 0x0b, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x41, 0x6c, 0x69, 0x61, 0x73,
+	0x22, 0x30, 0x0a, 0x05, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x0c, 0x0a, 0x08, 0x4d, 0x41, 0x4e,
+	0x49, 0x46, 0x45, 0x53, 0x54, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x48, 0x45, 0x43, 0x4b,
+	0x53, 0x55, 0x4d, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x45, 0x53, 0x54, 0x4f, 0x52, 0x45,
+	0x10, 0x02, 0x32, 0x8a, 0x03, 0x0a, 0x08, 0x4d, 0x79, 0x73, 0x71, 0x6c, 0x43, 0x74, 0x6c, 0x12,
+	0x3a, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x16, 0x2e, 0x6d, 0x79, 0x73, 0x71, 0x6c,
+	0x63, 0x74, 0x6c, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x17, 0x2e, 0x6d, 0x79, 0x73, 0x71, 0x6c, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x08, 0x53,
+	0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x12, 0x19, 0x2e, 0x6d, 0x79, 0x73, 0x71, 0x6c, 0x63,
+	0x74, 0x6c, 0x2e, 0x53, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6d, 0x79, 0x73, 0x71, 0x6c, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x68,
+	0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x58, 0x0a, 0x0f, 0x52, 0x75, 0x6e, 0x4d, 0x79, 0x73, 0x71, 0x6c, 0x55, 0x70, 0x67, 0x72,
+	0x61, 0x64, 0x65, 0x12, 0x20, 0x2e, 0x6d, 0x79, 0x73, 0x71, 0x6c, 0x63, 0x74, 0x6c, 0x2e, 0x52,
+	0x75, 0x6e, 0x4d, 0x79, 0x73, 0x71, 0x6c, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6d, 0x79, 0x73, 0x71, 0x6c, 0x63, 0x74, 0x6c,
+	0x2e, 0x52, 0x75, 0x6e, 0x4d, 0x79, 0x73, 0x71, 0x6c, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0c, 0x52, 0x65,
+	0x69, 0x6e, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1d, 0x2e, 0x6d, 0x79, 0x73,
+	0x71, 0x6c, 0x63, 0x74, 0x6c, 0x2e, 0x52, 0x65, 0x69, 0x6e, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6d, 0x79, 0x73, 0x71,
+	0x6c, 0x63, 0x74, 0x6c, 0x2e, 0x52, 0x65, 0x69, 0x6e, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x0d, 0x52,
+	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1e, 0x2e, 0x6d,
+	0x79, 0x73, 0x71, 0x6c, 0x63, 0x74, 0x6c, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6d,
+	0x79, 0x73, 0x71, 0x6c, 0x63, 0x74, 0x6c, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42,
+	0x27, 0x5a, 0x25, 0x76, 0x69, 0x74, 0x65, 0x73, 0x73, 0x2e, 0x69, 0x6f, 0x2f, 0x76, 0x69, 0x74,
+	0x65, 0x73, 0x73, 0x2f, 0x67, 0x6f, 0x2f, 0x76, 0x74, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
+	0x6d, 0x79, 0x73, 0x71, 0x6c, 0x63, 0x74, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -694,43 +863,49 @@ func file_mysqlctl_proto_rawDescGZIP() []byte {
 	return file_mysqlctl_proto_rawDescData
 }
 
-var file_mysqlctl_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_mysqlctl_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_mysqlctl_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_mysqlctl_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_mysqlctl_proto_goTypes = []interface{}{
 	(BackupInfo_Status)(0),          // 0: mysqlctl.BackupInfo.Status
-	(*StartRequest)(nil),            // 1: mysqlctl.StartRequest
-	(*StartResponse)(nil),           // 2: mysqlctl.StartResponse
-	(*ShutdownRequest)(nil),         // 3: mysqlctl.ShutdownRequest
-	(*ShutdownResponse)(nil),        // 4: mysqlctl.ShutdownResponse
-	(*RunMysqlUpgradeRequest)(nil),  // 5: mysqlctl.RunMysqlUpgradeRequest
-	(*RunMysqlUpgradeResponse)(nil), // 6: mysqlctl.RunMysqlUpgradeResponse
-	(*ReinitConfigRequest)(nil),     // 7: mysqlctl.ReinitConfigRequest
-	(*ReinitConfigResponse)(nil),    // 8: mysqlctl.ReinitConfigResponse
-	(*RefreshConfigRequest)(nil),    // 9: mysqlctl.RefreshConfigRequest
-	(*RefreshConfigResponse)(nil),   // 10: mysqlctl.RefreshConfigResponse
-	(*BackupInfo)(nil),              // 11: mysqlctl.BackupInfo
-	(*topodata.TabletAlias)(nil),    // 12: topodata.TabletAlias
-	(*vttime.Time)(nil),             // 13: vttime.Time
+	(BackupVerification_Level)(0),   // 1: mysqlctl.BackupVerification.Level
+	(*StartRequest)(nil),            // 2: mysqlctl.StartRequest
+	(*StartResponse)(nil),           // 3: mysqlctl.StartResponse
+	(*ShutdownRequest)(nil),         // 4: mysqlctl.ShutdownRequest
+	(*ShutdownResponse)(nil),        // 5: mysqlctl.ShutdownResponse
+	(*RunMysqlUpgradeRequest)(nil),  // 6: mysqlctl.RunMysqlUpgradeRequest
+	(*RunMysqlUpgradeResponse)(nil), // 7: mysqlctl.RunMysqlUpgradeResponse
+	(*ReinitConfigRequest)(nil),     // 8: mysqlctl.ReinitConfigRequest
+	(*ReinitConfigResponse)(nil),    // 9: mysqlctl.ReinitConfigResponse
+	(*RefreshConfigRequest)(nil),    // 10: mysqlctl.RefreshConfigRequest
+	(*RefreshConfigResponse)(nil),   // 11: mysqlctl.RefreshConfigResponse
+	(*BackupInfo)(nil),              // 12: mysqlctl.BackupInfo
+	(*BackupVerification)(nil),      // 13: mysqlctl.BackupVerification
+	(*topodata.TabletAlias)(nil),    // 14: topodata.TabletAlias
+	(*vttime.Time)(nil),             // 15: vttime.Time
 }
 var file_mysqlctl_proto_depIdxs = []int32{
-	12, // 0: mysqlctl.BackupInfo.tablet_alias:type_name -> topodata.TabletAlias
-	13, // 1: mysqlctl.BackupInfo.time:type_name -> vttime.Time
+	14, // 0: mysqlctl.BackupInfo.tablet_alias:type_name -> topodata.TabletAlias
+	15, // 1: mysqlctl.BackupInfo.time:type_name -> vttime.Time
 	0,  // 2: mysqlctl.BackupInfo.status:type_name -> mysqlctl.BackupInfo.Status
-	1,  // 3: mysqlctl.MysqlCtl.Start:input_type -> mysqlctl.StartRequest
-	3,  // 4: mysqlctl.MysqlCtl.Shutdown:input_type -> mysqlctl.ShutdownRequest
-	5,  // 5: mysqlctl.MysqlCtl.RunMysqlUpgrade:input_type -> mysqlctl.RunMysqlUpgradeRequest
-	7,  // 6: mysqlctl.MysqlCtl.ReinitConfig:input_type -> mysqlctl.ReinitConfigRequest
-	9,  // 7: mysqlctl.MysqlCtl.RefreshConfig:input_type -> mysqlctl.RefreshConfigRequest
-	2,  // 8: mysqlctl.MysqlCtl.Start:output_type -> mysqlctl.StartResponse
-	4,  // 9: mysqlctl.MysqlCtl.Shutdown:output_type -> mysqlctl.ShutdownResponse
-	6,  // 10: mysqlctl.MysqlCtl.RunMysqlUpgrade:output_type -> mysqlctl.RunMysqlUpgradeResponse
-	8,  // 11: mysqlctl.MysqlCtl.ReinitConfig:output_type -> mysqlctl.ReinitConfigResponse
-	10, // 12: mysqlctl.MysqlCtl.RefreshConfig:output_type -> mysqlctl.RefreshConfigResponse
-	8,  // [8:13] is the sub-list for method output_type
-	3,  // [3:8] is the sub-list for method input_type
-	3,  // [3:3] is the sub-list for extension type_name
-	3,  // [3:3] is the sub-list for extension extendee
-	0,  // [0:3] is the sub-list for field type_name
+	1,  // 3: mysqlctl.BackupVerification.level:type_name -> mysqlctl.BackupVerification.Level
+	0,  // 4: mysqlctl.BackupVerification.status:type_name -> mysqlctl.BackupInfo.Status
+	15, // 5: mysqlctl.BackupVerification.time:type_name -> vttime.Time
+	14, // 6: mysqlctl.BackupVerification.tablet_alias:type_name -> topodata.TabletAlias
+	2,  // 7: mysqlctl.MysqlCtl.Start:input_type -> mysqlctl.StartRequest
+	4,  // 8: mysqlctl.MysqlCtl.Shutdown:input_type -> mysqlctl.ShutdownRequest
+	6,  // 9: mysqlctl.MysqlCtl.RunMysqlUpgrade:input_type -> mysqlctl.RunMysqlUpgradeRequest
+	8,  // 10: mysqlctl.MysqlCtl.ReinitConfig:input_type -> mysqlctl.ReinitConfigRequest
+	10, // 11: mysqlctl.MysqlCtl.RefreshConfig:input_type -> mysqlctl.RefreshConfigRequest
+	3,  // 12: mysqlctl.MysqlCtl.Start:output_type -> mysqlctl.StartResponse
+	5,  // 13: mysqlctl.MysqlCtl.Shutdown:output_type -> mysqlctl.ShutdownResponse
+	7,  // 14: mysqlctl.MysqlCtl.RunMysqlUpgrade:output_type -> mysqlctl.RunMysqlUpgradeResponse
+	9,  // 15: mysqlctl.MysqlCtl.ReinitConfig:output_type -> mysqlctl.ReinitConfigResponse
+	11, // 16: mysqlctl.MysqlCtl.RefreshConfig:output_type -> mysqlctl.RefreshConfigResponse
+	12, // [12:17] is the sub-list for method output_type
+	7,  // [7:12] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_mysqlctl_proto_init() }
@@ -871,14 +1046,26 @@ func file_mysqlctl_proto_init() {
 				return nil
 			}
 		}
+		file_mysqlctl_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackupVerification); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_mysqlctl_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   11,
+			NumEnums:      2,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -33,6 +33,7 @@ import (
 	query "vitess.io/vitess/go/vt/proto/query"
 	replicationdata "vitess.io/vitess/go/vt/proto/replicationdata"
 	topodata "vitess.io/vitess/go/vt/proto/topodata"
+	vttime "vitess.io/vitess/go/vt/proto/vttime"
 )
 
 const (
@@ -784,18 +785,26 @@ func (x *ExecuteHookResponse) GetStderr() string {
 	return ""
 }
 
-type GetSchemaRequest struct {
+// LifecycleHook is a hook of the lifecycle hook registry of a tablet.
+type LifecycleHook struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Tables        []string `protobuf:"bytes,1,rep,name=tables,proto3" json:"tables,omitempty"`
-	IncludeViews  bool     `protobuf:"varint,2,opt,name=include_views,json=includeViews,proto3" json:"include_views,omitempty"`
-	ExcludeTables []string `protobuf:"bytes,3,rep,name=exclude_tables,json=excludeTables,proto3" json:"exclude_tables,omitempty"`
+	// event is the lifecycle event the hook runs on: pre-backup,
+	// post-restore, pre-reparent or post-promotion.
+	Event string `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	// name is the name of the hook in $VTROOT/vthook.
+	Name       string           `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Parameters []string         `protobuf:"bytes,3,rep,name=parameters,proto3" json:"parameters,omitempty"`
+	Timeout    *vttime.Duration `protobuf:"bytes,4,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// required hooks abort the backup or the reparent when they fail on a
+	// pre- event. The failures of the other hooks are only reported.
+	Required bool `protobuf:"varint,5,opt,name=required,proto3" json:"required,omitempty"`
 }
 
-func (x *GetSchemaRequest) Reset() {
-	*x = GetSchemaRequest{}
+func (x *LifecycleHook) Reset() {
+	*x = LifecycleHook{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tabletmanagerdata_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -803,13 +812,13 @@ func (x *GetSchemaRequest) Reset() {
 	}
 }
 
-func (x *GetSchemaRequest) String() string {
+func (x *LifecycleHook) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSchemaRequest) ProtoMessage() {}
+func (*LifecycleHook) ProtoMessage() {}
 
-func (x *GetSchemaRequest) ProtoReflect() protoreflect.Message {
+func (x *LifecycleHook) ProtoReflect() protoreflect.Message {
 	mi := &file_tabletmanagerdata_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -821,42 +830,62 @@ func (x *GetSchemaRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSchemaRequest.ProtoReflect.Descriptor instead.
-func (*GetSchemaRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use LifecycleHook.ProtoReflect.Descriptor instead.
+func (*LifecycleHook) Descriptor() ([]byte, []int) {
 	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *GetSchemaRequest) GetTables() []string {
+func (x *LifecycleHook) GetEvent() string {
 	if x != nil {
-		return x.Tables
+		return x.Event
 	}
-	return nil
+	return ""
 }
 
-func (x *GetSchemaRequest) GetIncludeViews() bool {
+func (x *LifecycleHook) GetName() string {
 	if x != nil {
-		return x.IncludeViews
+		return x.Name
 	}
-	return false
+	return ""
 }
 
-func (x *GetSchemaRequest) GetExcludeTables() []string {
+func (x *LifecycleHook) GetParameters() []string {
 	if x != nil {
-		return x.ExcludeTables
+		return x.Parameters
 	}
 	return nil
 }
 
-type GetSchemaResponse struct {
+func (x *LifecycleHook) GetTimeout() *vttime.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *LifecycleHook) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+// LifecycleHookRun is an execution of a lifecycle hook.
+type LifecycleHookRun struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SchemaDefinition *SchemaDefinition `protobuf:"bytes,1,opt,name=schema_definition,json=schemaDefinition,proto3" json:"schema_definition,omitempty"`
+	Hook       *LifecycleHook   `protobuf:"bytes,1,opt,name=hook,proto3" json:"hook,omitempty"`
+	StartTime  *vttime.Time     `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	Duration   *vttime.Duration `protobuf:"bytes,3,opt,name=duration,proto3" json:"duration,omitempty"`
+	ExitStatus int64            `protobuf:"varint,4,opt,name=exit_status,json=exitStatus,proto3" json:"exit_status,omitempty"`
+	Stdout     string           `protobuf:"bytes,5,opt,name=stdout,proto3" json:"stdout,omitempty"`
+	Stderr     string           `protobuf:"bytes,6,opt,name=stderr,proto3" json:"stderr,omitempty"`
 }
 
-func (x *GetSchemaResponse) Reset() {
-	*x = GetSchemaResponse{}
+func (x *LifecycleHookRun) Reset() {
+	*x = LifecycleHookRun{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tabletmanagerdata_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -864,13 +893,13 @@ func (x *GetSchemaResponse) Reset() {
 	}
 }
 
-func (x *GetSchemaResponse) String() string {
+func (x *LifecycleHookRun) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSchemaResponse) ProtoMessage() {}
+func (*LifecycleHookRun) ProtoMessage() {}
 
-func (x *GetSchemaResponse) ProtoReflect() protoreflect.Message {
+func (x *LifecycleHookRun) ProtoReflect() protoreflect.Message {
 	mi := &file_tabletmanagerdata_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -882,26 +911,61 @@ func (x *GetSchemaResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSchemaResponse.ProtoReflect.Descriptor instead.
-func (*GetSchemaResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use LifecycleHookRun.ProtoReflect.Descriptor instead.
+func (*LifecycleHookRun) Descriptor() ([]byte, []int) {
 	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *GetSchemaResponse) GetSchemaDefinition() *SchemaDefinition {
+func (x *LifecycleHookRun) GetHook() *LifecycleHook {
 	if x != nil {
-		return x.SchemaDefinition
+		return x.Hook
 	}
 	return nil
 }
 
-type GetPermissionsRequest struct {
+func (x *LifecycleHookRun) GetStartTime() *vttime.Time {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *LifecycleHookRun) GetDuration() *vttime.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *LifecycleHookRun) GetExitStatus() int64 {
+	if x != nil {
+		return x.ExitStatus
+	}
+	return 0
+}
+
+func (x *LifecycleHookRun) GetStdout() string {
+	if x != nil {
+		return x.Stdout
+	}
+	return ""
+}
+
+func (x *LifecycleHookRun) GetStderr() string {
+	if x != nil {
+		return x.Stderr
+	}
+	return ""
+}
+
+type GetLifecycleHooksRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *GetPermissionsRequest) Reset() {
-	*x = GetPermissionsRequest{}
+func (x *GetLifecycleHooksRequest) Reset() {
+	*x = GetLifecycleHooksRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tabletmanagerdata_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -909,13 +973,13 @@ func (x *GetPermissionsRequest) Reset() {
 	}
 }
 
-func (x *GetPermissionsRequest) String() string {
+func (x *GetLifecycleHooksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPermissionsRequest) ProtoMessage() {}
+func (*GetLifecycleHooksRequest) ProtoMessage() {}
 
-func (x *GetPermissionsRequest) ProtoReflect() protoreflect.Message {
+func (x *GetLifecycleHooksRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_tabletmanagerdata_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -927,21 +991,24 @@ func (x *GetPermissionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPermissionsRequest.ProtoReflect.Descriptor instead.
-func (*GetPermissionsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetLifecycleHooksRequest.ProtoReflect.Descriptor instead.
+func (*GetLifecycleHooksRequest) Descriptor() ([]byte, []int) {
 	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{14}
 }
 
-type GetPermissionsResponse struct {
+type GetLifecycleHooksResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Permissions *Permissions `protobuf:"bytes,1,opt,name=permissions,proto3" json:"permissions,omitempty"`
+	Hooks []*LifecycleHook `protobuf:"bytes,1,rep,name=hooks,proto3" json:"hooks,omitempty"`
+	// runs are the most recent executions of the hooks, the most recent
+	// first.
+	Runs []*LifecycleHookRun `protobuf:"bytes,2,rep,name=runs,proto3" json:"runs,omitempty"`
 }
 
-func (x *GetPermissionsResponse) Reset() {
-	*x = GetPermissionsResponse{}
+func (x *GetLifecycleHooksResponse) Reset() {
+	*x = GetLifecycleHooksResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tabletmanagerdata_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -949,13 +1016,13 @@ func (x *GetPermissionsResponse) Reset() {
 	}
 }
 
-func (x *GetPermissionsResponse) String() string {
+func (x *GetLifecycleHooksResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPermissionsResponse) ProtoMessage() {}
+func (*GetLifecycleHooksResponse) ProtoMessage() {}
 
-func (x *GetPermissionsResponse) ProtoReflect() protoreflect.Message {
+func (x *GetLifecycleHooksResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_tabletmanagerdata_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -967,26 +1034,33 @@ func (x *GetPermissionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPermissionsResponse.ProtoReflect.Descriptor instead.
-func (*GetPermissionsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetLifecycleHooksResponse.ProtoReflect.Descriptor instead.
+func (*GetLifecycleHooksResponse) Descriptor() ([]byte, []int) {
 	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *GetPermissionsResponse) GetPermissions() *Permissions {
+func (x *GetLifecycleHooksResponse) GetHooks() []*LifecycleHook {
 	if x != nil {
-		return x.Permissions
+		return x.Hooks
 	}
 	return nil
 }
 
-type SetReadOnlyRequest struct {
+func (x *GetLifecycleHooksResponse) GetRuns() []*LifecycleHookRun {
+	if x != nil {
+		return x.Runs
+	}
+	return nil
+}
+
+type GetVersionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *SetReadOnlyRequest) Reset() {
-	*x = SetReadOnlyRequest{}
+func (x *GetVersionRequest) Reset() {
+	*x = GetVersionRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tabletmanagerdata_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -994,13 +1068,13 @@ func (x *SetReadOnlyRequest) Reset() {
 	}
 }
 
-func (x *SetReadOnlyRequest) String() string {
+func (x *GetVersionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetReadOnlyRequest) ProtoMessage() {}
+func (*GetVersionRequest) ProtoMessage() {}
 
-func (x *SetReadOnlyRequest) ProtoReflect() protoreflect.Message {
+func (x *GetVersionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_tabletmanagerdata_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1012,19 +1086,27 @@ func (x *SetReadOnlyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetReadOnlyRequest.ProtoReflect.Descriptor instead.
-func (*SetReadOnlyRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetVersionRequest.ProtoReflect.Descriptor instead.
+func (*GetVersionRequest) Descriptor() ([]byte, []int) {
 	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{16}
 }
 
-type SetReadOnlyResponse struct {
+type GetVersionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// version is the @@version of mysqld, e.g. 8.0.23-14.
+	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	// flavor is mysql, percona or mariadb.
+	Flavor string `protobuf:"bytes,2,opt,name=flavor,proto3" json:"flavor,omitempty"`
+	Major  int32  `protobuf:"varint,3,opt,name=major,proto3" json:"major,omitempty"`
+	Minor  int32  `protobuf:"varint,4,opt,name=minor,proto3" json:"minor,omitempty"`
+	Patch  int32  `protobuf:"varint,5,opt,name=patch,proto3" json:"patch,omitempty"`
 }
 
-func (x *SetReadOnlyResponse) Reset() {
-	*x = SetReadOnlyResponse{}
+func (x *GetVersionResponse) Reset() {
+	*x = GetVersionResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tabletmanagerdata_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1032,13 +1114,13 @@ func (x *SetReadOnlyResponse) Reset() {
 	}
 }
 
-func (x *SetReadOnlyResponse) String() string {
+func (x *GetVersionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetReadOnlyResponse) ProtoMessage() {}
+func (*GetVersionResponse) ProtoMessage() {}
 
-func (x *SetReadOnlyResponse) ProtoReflect() protoreflect.Message {
+func (x *GetVersionResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_tabletmanagerdata_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1050,19 +1132,58 @@ func (x *SetReadOnlyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetReadOnlyResponse.ProtoReflect.Descriptor instead.
-func (*SetReadOnlyResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetVersionResponse.ProtoReflect.Descriptor instead.
+func (*GetVersionResponse) Descriptor() ([]byte, []int) {
 	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{17}
 }
 
-type SetReadWriteRequest struct {
+func (x *GetVersionResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *GetVersionResponse) GetFlavor() string {
+	if x != nil {
+		return x.Flavor
+	}
+	return ""
+}
+
+func (x *GetVersionResponse) GetMajor() int32 {
+	if x != nil {
+		return x.Major
+	}
+	return 0
+}
+
+func (x *GetVersionResponse) GetMinor() int32 {
+	if x != nil {
+		return x.Minor
+	}
+	return 0
+}
+
+func (x *GetVersionResponse) GetPatch() int32 {
+	if x != nil {
+		return x.Patch
+	}
+	return 0
+}
+
+type GetSchemaRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Tables        []string `protobuf:"bytes,1,rep,name=tables,proto3" json:"tables,omitempty"`
+	IncludeViews  bool     `protobuf:"varint,2,opt,name=include_views,json=includeViews,proto3" json:"include_views,omitempty"`
+	ExcludeTables []string `protobuf:"bytes,3,rep,name=exclude_tables,json=excludeTables,proto3" json:"exclude_tables,omitempty"`
 }
 
-func (x *SetReadWriteRequest) Reset() {
-	*x = SetReadWriteRequest{}
+func (x *GetSchemaRequest) Reset() {
+	*x = GetSchemaRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tabletmanagerdata_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1070,13 +1191,13 @@ func (x *SetReadWriteRequest) Reset() {
 	}
 }
 
-func (x *SetReadWriteRequest) String() string {
+func (x *GetSchemaRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetReadWriteRequest) ProtoMessage() {}
+func (*GetSchemaRequest) ProtoMessage() {}
 
-func (x *SetReadWriteRequest) ProtoReflect() protoreflect.Message {
+func (x *GetSchemaRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_tabletmanagerdata_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1088,19 +1209,42 @@ func (x *SetReadWriteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetReadWriteRequest.ProtoReflect.Descriptor instead.
-func (*SetReadWriteRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetSchemaRequest.ProtoReflect.Descriptor instead.
+func (*GetSchemaRequest) Descriptor() ([]byte, []int) {
 	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{18}
 }
 
-type SetReadWriteResponse struct {
+func (x *GetSchemaRequest) GetTables() []string {
+	if x != nil {
+		return x.Tables
+	}
+	return nil
+}
+
+func (x *GetSchemaRequest) GetIncludeViews() bool {
+	if x != nil {
+		return x.IncludeViews
+	}
+	return false
+}
+
+func (x *GetSchemaRequest) GetExcludeTables() []string {
+	if x != nil {
+		return x.ExcludeTables
+	}
+	return nil
+}
+
+type GetSchemaResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	SchemaDefinition *SchemaDefinition `protobuf:"bytes,1,opt,name=schema_definition,json=schemaDefinition,proto3" json:"schema_definition,omitempty"`
 }
 
-func (x *SetReadWriteResponse) Reset() {
-	*x = SetReadWriteResponse{}
+func (x *GetSchemaResponse) Reset() {
+	*x = GetSchemaResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tabletmanagerdata_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1108,13 +1252,13 @@ func (x *SetReadWriteResponse) Reset() {
 	}
 }
 
-func (x *SetReadWriteResponse) String() string {
+func (x *GetSchemaResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetReadWriteResponse) ProtoMessage() {}
+func (*GetSchemaResponse) ProtoMessage() {}
 
-func (x *SetReadWriteResponse) ProtoReflect() protoreflect.Message {
+func (x *GetSchemaResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_tabletmanagerdata_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1126,21 +1270,26 @@ func (x *SetReadWriteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetReadWriteResponse.ProtoReflect.Descriptor instead.
-func (*SetReadWriteResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetSchemaResponse.ProtoReflect.Descriptor instead.
+func (*GetSchemaResponse) Descriptor() ([]byte, []int) {
 	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{19}
 }
 
-type ChangeTypeRequest struct {
+func (x *GetSchemaResponse) GetSchemaDefinition() *SchemaDefinition {
+	if x != nil {
+		return x.SchemaDefinition
+	}
+	return nil
+}
+
+type GetPermissionsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	TabletType topodata.TabletType `protobuf:"varint,1,opt,name=tablet_type,json=tabletType,proto3,enum=topodata.TabletType" json:"tablet_type,omitempty"`
 }
 
-func (x *ChangeTypeRequest) Reset() {
-	*x = ChangeTypeRequest{}
+func (x *GetPermissionsRequest) Reset() {
+	*x = GetPermissionsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tabletmanagerdata_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1148,13 +1297,13 @@ func (x *ChangeTypeRequest) Reset() {
 	}
 }
 
-func (x *ChangeTypeRequest) String() string {
+func (x *GetPermissionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangeTypeRequest) ProtoMessage() {}
+func (*GetPermissionsRequest) ProtoMessage() {}
 
-func (x *ChangeTypeRequest) ProtoReflect() protoreflect.Message {
+func (x *GetPermissionsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_tabletmanagerdata_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1166,26 +1315,21 @@ func (x *ChangeTypeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangeTypeRequest.ProtoReflect.Descriptor instead.
-func (*ChangeTypeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetPermissionsRequest.ProtoReflect.Descriptor instead.
+func (*GetPermissionsRequest) Descriptor() ([]byte, []int) {
 	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *ChangeTypeRequest) GetTabletType() topodata.TabletType {
-	if x != nil {
-		return x.TabletType
-	}
-	return topodata.TabletType(0)
-}
-
-type ChangeTypeResponse struct {
+type GetPermissionsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Permissions *Permissions `protobuf:"bytes,1,opt,name=permissions,proto3" json:"permissions,omitempty"`
 }
 
-func (x *ChangeTypeResponse) Reset() {
-	*x = ChangeTypeResponse{}
+func (x *GetPermissionsResponse) Reset() {
+	*x = GetPermissionsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tabletmanagerdata_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1193,13 +1337,13 @@ func (x *ChangeTypeResponse) Reset() {
 	}
 }
 
-func (x *ChangeTypeResponse) String() string {
+func (x *GetPermissionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangeTypeResponse) ProtoMessage() {}
+func (*GetPermissionsResponse) ProtoMessage() {}
 
-func (x *ChangeTypeResponse) ProtoReflect() protoreflect.Message {
+func (x *GetPermissionsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_tabletmanagerdata_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1211,19 +1355,26 @@ func (x *ChangeTypeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangeTypeResponse.ProtoReflect.Descriptor instead.
-func (*ChangeTypeResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetPermissionsResponse.ProtoReflect.Descriptor instead.
+func (*GetPermissionsResponse) Descriptor() ([]byte, []int) {
 	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{21}
 }
 
-type RefreshStateRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-}
-
-func (x *RefreshStateRequest) Reset() {
-	*x = RefreshStateRequest{}
+func (x *GetPermissionsResponse) GetPermissions() *Permissions {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+type SetReadOnlyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetReadOnlyRequest) Reset() {
+	*x = SetReadOnlyRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tabletmanagerdata_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1231,13 +1382,13 @@ func (x *RefreshStateRequest) Reset() {
 	}
 }
 
-func (x *RefreshStateRequest) String() string {
+func (x *SetReadOnlyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RefreshStateRequest) ProtoMessage() {}
+func (*SetReadOnlyRequest) ProtoMessage() {}
 
-func (x *RefreshStateRequest) ProtoReflect() protoreflect.Message {
+func (x *SetReadOnlyRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_tabletmanagerdata_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1249,19 +1400,19 @@ func (x *RefreshStateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RefreshStateRequest.ProtoReflect.Descriptor instead.
-func (*RefreshStateRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetReadOnlyRequest.ProtoReflect.Descriptor instead.
+func (*SetReadOnlyRequest) Descriptor() ([]byte, []int) {
 	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{22}
 }
 
-type RefreshStateResponse struct {
+type SetReadOnlyResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *RefreshStateResponse) Reset() {
-	*x = RefreshStateResponse{}
+func (x *SetReadOnlyResponse) Reset() {
+	*x = SetReadOnlyResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tabletmanagerdata_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1269,13 +1420,13 @@ func (x *RefreshStateResponse) Reset() {
 	}
 }
 
-func (x *RefreshStateResponse) String() string {
+func (x *SetReadOnlyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RefreshStateResponse) ProtoMessage() {}
+func (*SetReadOnlyResponse) ProtoMessage() {}
 
-func (x *RefreshStateResponse) ProtoReflect() protoreflect.Message {
+func (x *SetReadOnlyResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_tabletmanagerdata_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1287,19 +1438,19 @@ func (x *RefreshStateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RefreshStateResponse.ProtoReflect.Descriptor instead.
-func (*RefreshStateResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetReadOnlyResponse.ProtoReflect.Descriptor instead.
+func (*SetReadOnlyResponse) Descriptor() ([]byte, []int) {
 	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{23}
 }
 
-type RunHealthCheckRequest struct {
+type SetReadWriteRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *RunHealthCheckRequest) Reset() {
-	*x = RunHealthCheckRequest{}
+func (x *SetReadWriteRequest) Reset() {
+	*x = SetReadWriteRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tabletmanagerdata_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1307,13 +1458,13 @@ func (x *RunHealthCheckRequest) Reset() {
 	}
 }
 
-func (x *RunHealthCheckRequest) String() string {
+func (x *SetReadWriteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RunHealthCheckRequest) ProtoMessage() {}
+func (*SetReadWriteRequest) ProtoMessage() {}
 
-func (x *RunHealthCheckRequest) ProtoReflect() protoreflect.Message {
+func (x *SetReadWriteRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_tabletmanagerdata_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1325,19 +1476,19 @@ func (x *RunHealthCheckRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RunHealthCheckRequest.ProtoReflect.Descriptor instead.
-func (*RunHealthCheckRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetReadWriteRequest.ProtoReflect.Descriptor instead.
+func (*SetReadWriteRequest) Descriptor() ([]byte, []int) {
 	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{24}
 }
 
-type RunHealthCheckResponse struct {
+type SetReadWriteResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *RunHealthCheckResponse) Reset() {
-	*x = RunHealthCheckResponse{}
+func (x *SetReadWriteResponse) Reset() {
+	*x = SetReadWriteResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tabletmanagerdata_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1345,13 +1496,13 @@ func (x *RunHealthCheckResponse) Reset() {
 	}
 }
 
-func (x *RunHealthCheckResponse) String() string {
+func (x *SetReadWriteResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RunHealthCheckResponse) ProtoMessage() {}
+func (*SetReadWriteResponse) ProtoMessage() {}
 
-func (x *RunHealthCheckResponse) ProtoReflect() protoreflect.Message {
+func (x *SetReadWriteResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_tabletmanagerdata_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1363,21 +1514,21 @@ func (x *RunHealthCheckResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RunHealthCheckResponse.ProtoReflect.Descriptor instead.
-func (*RunHealthCheckResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetReadWriteResponse.ProtoReflect.Descriptor instead.
+func (*SetReadWriteResponse) Descriptor() ([]byte, []int) {
 	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{25}
 }
 
-type IgnoreHealthErrorRequest struct {
+type ChangeTypeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Pattern string `protobuf:"bytes,1,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	TabletType topodata.TabletType `protobuf:"varint,1,opt,name=tablet_type,json=tabletType,proto3,enum=topodata.TabletType" json:"tablet_type,omitempty"`
 }
 
-func (x *IgnoreHealthErrorRequest) Reset() {
-	*x = IgnoreHealthErrorRequest{}
+func (x *ChangeTypeRequest) Reset() {
+	*x = ChangeTypeRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tabletmanagerdata_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1385,13 +1536,13 @@ func (x *IgnoreHealthErrorRequest) Reset() {
 	}
 }
 
-func (x *IgnoreHealthErrorRequest) String() string {
+func (x *ChangeTypeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IgnoreHealthErrorRequest) ProtoMessage() {}
+func (*ChangeTypeRequest) ProtoMessage() {}
 
-func (x *IgnoreHealthErrorRequest) ProtoReflect() protoreflect.Message {
+func (x *ChangeTypeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_tabletmanagerdata_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1403,26 +1554,26 @@ func (x *IgnoreHealthErrorRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IgnoreHealthErrorRequest.ProtoReflect.Descriptor instead.
-func (*IgnoreHealthErrorRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ChangeTypeRequest.ProtoReflect.Descriptor instead.
+func (*ChangeTypeRequest) Descriptor() ([]byte, []int) {
 	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *IgnoreHealthErrorRequest) GetPattern() string {
+func (x *ChangeTypeRequest) GetTabletType() topodata.TabletType {
 	if x != nil {
-		return x.Pattern
+		return x.TabletType
 	}
-	return ""
+	return topodata.TabletType(0)
 }
 
-type IgnoreHealthErrorResponse struct {
+type ChangeTypeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *IgnoreHealthErrorResponse) Reset() {
-	*x = IgnoreHealthErrorResponse{}
+func (x *ChangeTypeResponse) Reset() {
+	*x = ChangeTypeResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tabletmanagerdata_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1430,13 +1581,13 @@ func (x *IgnoreHealthErrorResponse) Reset() {
 	}
 }
 
-func (x *IgnoreHealthErrorResponse) String() string {
+func (x *ChangeTypeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IgnoreHealthErrorResponse) ProtoMessage() {}
+func (*ChangeTypeResponse) ProtoMessage() {}
 
-func (x *IgnoreHealthErrorResponse) ProtoReflect() protoreflect.Message {
+func (x *ChangeTypeResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_tabletmanagerdata_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1448,24 +1599,24 @@ func (x *IgnoreHealthErrorResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IgnoreHealthErrorResponse.ProtoReflect.Descriptor instead.
-func (*IgnoreHealthErrorResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ChangeTypeResponse.ProtoReflect.Descriptor instead.
+func (*ChangeTypeResponse) Descriptor() ([]byte, []int) {
 	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{27}
 }
 
-type ReloadSchemaRequest struct {
+type ChangeTagsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// wait_position allows scheduling a schema reload to occur after a
-	// given DDL has replicated to this server, by specifying a replication
-	// position to wait for. Leave empty to trigger the reload immediately.
-	WaitPosition string `protobuf:"bytes,1,opt,name=wait_position,json=waitPosition,proto3" json:"wait_position,omitempty"`
+	// tags are the tags to set. A tag with an empty value is removed.
+	Tags map[string]string `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// replace replaces all the tags of the tablet with tags.
+	Replace bool `protobuf:"varint,2,opt,name=replace,proto3" json:"replace,omitempty"`
 }
 
-func (x *ReloadSchemaRequest) Reset() {
-	*x = ReloadSchemaRequest{}
+func (x *ChangeTagsRequest) Reset() {
+	*x = ChangeTagsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tabletmanagerdata_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1473,13 +1624,13 @@ func (x *ReloadSchemaRequest) Reset() {
 	}
 }
 
-func (x *ReloadSchemaRequest) String() string {
+func (x *ChangeTagsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReloadSchemaRequest) ProtoMessage() {}
+func (*ChangeTagsRequest) ProtoMessage() {}
 
-func (x *ReloadSchemaRequest) ProtoReflect() protoreflect.Message {
+func (x *ChangeTagsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_tabletmanagerdata_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1491,26 +1642,35 @@ func (x *ReloadSchemaRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReloadSchemaRequest.ProtoReflect.Descriptor instead.
-func (*ReloadSchemaRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ChangeTagsRequest.ProtoReflect.Descriptor instead.
+func (*ChangeTagsRequest) Descriptor() ([]byte, []int) {
 	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *ReloadSchemaRequest) GetWaitPosition() string {
+func (x *ChangeTagsRequest) GetTags() map[string]string {
 	if x != nil {
-		return x.WaitPosition
+		return x.Tags
 	}
-	return ""
+	return nil
 }
 
-type ReloadSchemaResponse struct {
+func (x *ChangeTagsRequest) GetReplace() bool {
+	if x != nil {
+		return x.Replace
+	}
+	return false
+}
+
+type ChangeTagsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Tags map[string]string `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *ReloadSchemaResponse) Reset() {
-	*x = ReloadSchemaResponse{}
+func (x *ChangeTagsResponse) Reset() {
+	*x = ChangeTagsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tabletmanagerdata_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1518,13 +1678,13 @@ func (x *ReloadSchemaResponse) Reset() {
 	}
 }
 
-func (x *ReloadSchemaResponse) String() string {
+func (x *ChangeTagsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReloadSchemaResponse) ProtoMessage() {}
+func (*ChangeTagsResponse) ProtoMessage() {}
 
-func (x *ReloadSchemaResponse) ProtoReflect() protoreflect.Message {
+func (x *ChangeTagsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_tabletmanagerdata_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1536,21 +1696,26 @@ func (x *ReloadSchemaResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReloadSchemaResponse.ProtoReflect.Descriptor instead.
-func (*ReloadSchemaResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ChangeTagsResponse.ProtoReflect.Descriptor instead.
+func (*ChangeTagsResponse) Descriptor() ([]byte, []int) {
 	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{29}
 }
 
-type PreflightSchemaRequest struct {
+func (x *ChangeTagsResponse) GetTags() map[string]string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type RefreshStateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Changes []string `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
 }
 
-func (x *PreflightSchemaRequest) Reset() {
-	*x = PreflightSchemaRequest{}
+func (x *RefreshStateRequest) Reset() {
+	*x = RefreshStateRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tabletmanagerdata_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1558,13 +1723,13 @@ func (x *PreflightSchemaRequest) Reset() {
 	}
 }
 
-func (x *PreflightSchemaRequest) String() string {
+func (x *RefreshStateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PreflightSchemaRequest) ProtoMessage() {}
+func (*RefreshStateRequest) ProtoMessage() {}
 
-func (x *PreflightSchemaRequest) ProtoReflect() protoreflect.Message {
+func (x *RefreshStateRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_tabletmanagerdata_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1576,30 +1741,19 @@ func (x *PreflightSchemaRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PreflightSchemaRequest.ProtoReflect.Descriptor instead.
-func (*PreflightSchemaRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RefreshStateRequest.ProtoReflect.Descriptor instead.
+func (*RefreshStateRequest) Descriptor() ([]byte, []int) {
 	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *PreflightSchemaRequest) GetChanges() []string {
-	if x != nil {
-		return x.Changes
-	}
-	return nil
-}
-
-type PreflightSchemaResponse struct {
+type RefreshStateResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// change_results has for each change the schema before and after it.
-	// The number of elements is identical to the length of "changes" in the request.
-	ChangeResults []*SchemaChangeResult `protobuf:"bytes,1,rep,name=change_results,json=changeResults,proto3" json:"change_results,omitempty"`
 }
 
-func (x *PreflightSchemaResponse) Reset() {
-	*x = PreflightSchemaResponse{}
+func (x *RefreshStateResponse) Reset() {
+	*x = RefreshStateResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tabletmanagerdata_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1607,13 +1761,13 @@ func (x *PreflightSchemaResponse) Reset() {
 	}
 }
 
-func (x *PreflightSchemaResponse) String() string {
+func (x *RefreshStateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PreflightSchemaResponse) ProtoMessage() {}
+func (*RefreshStateResponse) ProtoMessage() {}
 
-func (x *PreflightSchemaResponse) ProtoReflect() protoreflect.Message {
+func (x *RefreshStateResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_tabletmanagerdata_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1625,32 +1779,19 @@ func (x *PreflightSchemaResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PreflightSchemaResponse.ProtoReflect.Descriptor instead.
-func (*PreflightSchemaResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RefreshStateResponse.ProtoReflect.Descriptor instead.
+func (*RefreshStateResponse) Descriptor() ([]byte, []int) {
 	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *PreflightSchemaResponse) GetChangeResults() []*SchemaChangeResult {
-	if x != nil {
-		return x.ChangeResults
-	}
-	return nil
-}
-
-type ApplySchemaRequest struct {
+type RunHealthCheckRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Sql              string            `protobuf:"bytes,1,opt,name=sql,proto3" json:"sql,omitempty"`
-	Force            bool              `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
-	AllowReplication bool              `protobuf:"varint,3,opt,name=allow_replication,json=allowReplication,proto3" json:"allow_replication,omitempty"`
-	BeforeSchema     *SchemaDefinition `protobuf:"bytes,4,opt,name=before_schema,json=beforeSchema,proto3" json:"before_schema,omitempty"`
-	AfterSchema      *SchemaDefinition `protobuf:"bytes,5,opt,name=after_schema,json=afterSchema,proto3" json:"after_schema,omitempty"`
 }
 
-func (x *ApplySchemaRequest) Reset() {
-	*x = ApplySchemaRequest{}
+func (x *RunHealthCheckRequest) Reset() {
+	*x = RunHealthCheckRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tabletmanagerdata_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1658,13 +1799,13 @@ func (x *ApplySchemaRequest) Reset() {
 	}
 }
 
-func (x *ApplySchemaRequest) String() string {
+func (x *RunHealthCheckRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApplySchemaRequest) ProtoMessage() {}
+func (*RunHealthCheckRequest) ProtoMessage() {}
 
-func (x *ApplySchemaRequest) ProtoReflect() protoreflect.Message {
+func (x *RunHealthCheckRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_tabletmanagerdata_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1676,71 +1817,33 @@ func (x *ApplySchemaRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApplySchemaRequest.ProtoReflect.Descriptor instead.
-func (*ApplySchemaRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RunHealthCheckRequest.ProtoReflect.Descriptor instead.
+func (*RunHealthCheckRequest) Descriptor() ([]byte, []int) {
 	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *ApplySchemaRequest) GetSql() string {
-	if x != nil {
-		return x.Sql
-	}
-	return ""
+type RunHealthCheckResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *ApplySchemaRequest) GetForce() bool {
-	if x != nil {
-		return x.Force
+func (x *RunHealthCheckResponse) Reset() {
+	*x = RunHealthCheckResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tabletmanagerdata_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return false
 }
 
-func (x *ApplySchemaRequest) GetAllowReplication() bool {
-	if x != nil {
-		return x.AllowReplication
-	}
-	return false
+func (x *RunHealthCheckResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ApplySchemaRequest) GetBeforeSchema() *SchemaDefinition {
-	if x != nil {
-		return x.BeforeSchema
-	}
-	return nil
-}
-
-func (x *ApplySchemaRequest) GetAfterSchema() *SchemaDefinition {
-	if x != nil {
-		return x.AfterSchema
-	}
-	return nil
-}
-
-type ApplySchemaResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	BeforeSchema *SchemaDefinition `protobuf:"bytes,1,opt,name=before_schema,json=beforeSchema,proto3" json:"before_schema,omitempty"`
-	AfterSchema  *SchemaDefinition `protobuf:"bytes,2,opt,name=after_schema,json=afterSchema,proto3" json:"after_schema,omitempty"`
-}
-
-func (x *ApplySchemaResponse) Reset() {
-	*x = ApplySchemaResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_tabletmanagerdata_proto_msgTypes[33]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *ApplySchemaResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ApplySchemaResponse) ProtoMessage() {}
+func (*RunHealthCheckResponse) ProtoMessage() {}
 
-func (x *ApplySchemaResponse) ProtoReflect() protoreflect.Message {
+func (x *RunHealthCheckResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_tabletmanagerdata_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1752,33 +1855,21 @@ func (x *ApplySchemaResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApplySchemaResponse.ProtoReflect.Descriptor instead.
-func (*ApplySchemaResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RunHealthCheckResponse.ProtoReflect.Descriptor instead.
+func (*RunHealthCheckResponse) Descriptor() ([]byte, []int) {
 	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *ApplySchemaResponse) GetBeforeSchema() *SchemaDefinition {
-	if x != nil {
-		return x.BeforeSchema
-	}
-	return nil
-}
-
-func (x *ApplySchemaResponse) GetAfterSchema() *SchemaDefinition {
-	if x != nil {
-		return x.AfterSchema
-	}
-	return nil
-}
-
-type LockTablesRequest struct {
+type IgnoreHealthErrorRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Pattern string `protobuf:"bytes,1,opt,name=pattern,proto3" json:"pattern,omitempty"`
 }
 
-func (x *LockTablesRequest) Reset() {
-	*x = LockTablesRequest{}
+func (x *IgnoreHealthErrorRequest) Reset() {
+	*x = IgnoreHealthErrorRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tabletmanagerdata_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1786,13 +1877,13 @@ func (x *LockTablesRequest) Reset() {
 	}
 }
 
-func (x *LockTablesRequest) String() string {
+func (x *IgnoreHealthErrorRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LockTablesRequest) ProtoMessage() {}
+func (*IgnoreHealthErrorRequest) ProtoMessage() {}
 
-func (x *LockTablesRequest) ProtoReflect() protoreflect.Message {
+func (x *IgnoreHealthErrorRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_tabletmanagerdata_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1804,19 +1895,26 @@ func (x *LockTablesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LockTablesRequest.ProtoReflect.Descriptor instead.
-func (*LockTablesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use IgnoreHealthErrorRequest.ProtoReflect.Descriptor instead.
+func (*IgnoreHealthErrorRequest) Descriptor() ([]byte, []int) {
 	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{34}
 }
 
-type LockTablesResponse struct {
+func (x *IgnoreHealthErrorRequest) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+type IgnoreHealthErrorResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *LockTablesResponse) Reset() {
-	*x = LockTablesResponse{}
+func (x *IgnoreHealthErrorResponse) Reset() {
+	*x = IgnoreHealthErrorResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tabletmanagerdata_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1824,13 +1922,13 @@ func (x *LockTablesResponse) Reset() {
 	}
 }
 
-func (x *LockTablesResponse) String() string {
+func (x *IgnoreHealthErrorResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LockTablesResponse) ProtoMessage() {}
+func (*IgnoreHealthErrorResponse) ProtoMessage() {}
 
-func (x *LockTablesResponse) ProtoReflect() protoreflect.Message {
+func (x *IgnoreHealthErrorResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_tabletmanagerdata_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1842,19 +1940,24 @@ func (x *LockTablesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LockTablesResponse.ProtoReflect.Descriptor instead.
-func (*LockTablesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use IgnoreHealthErrorResponse.ProtoReflect.Descriptor instead.
+func (*IgnoreHealthErrorResponse) Descriptor() ([]byte, []int) {
 	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{35}
 }
 
-type UnlockTablesRequest struct {
+type ReloadSchemaRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// wait_position allows scheduling a schema reload to occur after a
+	// given DDL has replicated to this server, by specifying a replication
+	// position to wait for. Leave empty to trigger the reload immediately.
+	WaitPosition string `protobuf:"bytes,1,opt,name=wait_position,json=waitPosition,proto3" json:"wait_position,omitempty"`
 }
 
-func (x *UnlockTablesRequest) Reset() {
-	*x = UnlockTablesRequest{}
+func (x *ReloadSchemaRequest) Reset() {
+	*x = ReloadSchemaRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tabletmanagerdata_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1862,13 +1965,13 @@ func (x *UnlockTablesRequest) Reset() {
 	}
 }
 
-func (x *UnlockTablesRequest) String() string {
+func (x *ReloadSchemaRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnlockTablesRequest) ProtoMessage() {}
+func (*ReloadSchemaRequest) ProtoMessage() {}
 
-func (x *UnlockTablesRequest) ProtoReflect() protoreflect.Message {
+func (x *ReloadSchemaRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_tabletmanagerdata_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1880,19 +1983,26 @@ func (x *UnlockTablesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnlockTablesRequest.ProtoReflect.Descriptor instead.
-func (*UnlockTablesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReloadSchemaRequest.ProtoReflect.Descriptor instead.
+func (*ReloadSchemaRequest) Descriptor() ([]byte, []int) {
 	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{36}
 }
 
-type UnlockTablesResponse struct {
+func (x *ReloadSchemaRequest) GetWaitPosition() string {
+	if x != nil {
+		return x.WaitPosition
+	}
+	return ""
+}
+
+type ReloadSchemaResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *UnlockTablesResponse) Reset() {
-	*x = UnlockTablesResponse{}
+func (x *ReloadSchemaResponse) Reset() {
+	*x = ReloadSchemaResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tabletmanagerdata_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1900,13 +2010,13 @@ func (x *UnlockTablesResponse) Reset() {
 	}
 }
 
-func (x *UnlockTablesResponse) String() string {
+func (x *ReloadSchemaResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnlockTablesResponse) ProtoMessage() {}
+func (*ReloadSchemaResponse) ProtoMessage() {}
 
-func (x *UnlockTablesResponse) ProtoReflect() protoreflect.Message {
+func (x *ReloadSchemaResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_tabletmanagerdata_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1918,23 +2028,21 @@ func (x *UnlockTablesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnlockTablesResponse.ProtoReflect.Descriptor instead.
-func (*UnlockTablesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReloadSchemaResponse.ProtoReflect.Descriptor instead.
+func (*ReloadSchemaResponse) Descriptor() ([]byte, []int) {
 	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{37}
 }
 
-type ExecuteQueryRequest struct {
+type PreflightSchemaRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Query   []byte `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
-	DbName  string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	MaxRows uint64 `protobuf:"varint,3,opt,name=max_rows,json=maxRows,proto3" json:"max_rows,omitempty"`
+	Changes []string `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
 }
 
-func (x *ExecuteQueryRequest) Reset() {
-	*x = ExecuteQueryRequest{}
+func (x *PreflightSchemaRequest) Reset() {
+	*x = PreflightSchemaRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tabletmanagerdata_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1942,13 +2050,13 @@ func (x *ExecuteQueryRequest) Reset() {
 	}
 }
 
-func (x *ExecuteQueryRequest) String() string {
+func (x *PreflightSchemaRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExecuteQueryRequest) ProtoMessage() {}
+func (*PreflightSchemaRequest) ProtoMessage() {}
 
-func (x *ExecuteQueryRequest) ProtoReflect() protoreflect.Message {
+func (x *PreflightSchemaRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_tabletmanagerdata_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1960,42 +2068,30 @@ func (x *ExecuteQueryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExecuteQueryRequest.ProtoReflect.Descriptor instead.
-func (*ExecuteQueryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PreflightSchemaRequest.ProtoReflect.Descriptor instead.
+func (*PreflightSchemaRequest) Descriptor() ([]byte, []int) {
 	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *ExecuteQueryRequest) GetQuery() []byte {
+func (x *PreflightSchemaRequest) GetChanges() []string {
 	if x != nil {
-		return x.Query
+		return x.Changes
 	}
 	return nil
 }
 
-func (x *ExecuteQueryRequest) GetDbName() string {
-	if x != nil {
-		return x.DbName
-	}
-	return ""
-}
-
-func (x *ExecuteQueryRequest) GetMaxRows() uint64 {
-	if x != nil {
-		return x.MaxRows
-	}
-	return 0
-}
-
-type ExecuteQueryResponse struct {
+type PreflightSchemaResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Result *query.QueryResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	// change_results has for each change the schema before and after it.
+	// The number of elements is identical to the length of "changes" in the request.
+	ChangeResults []*SchemaChangeResult `protobuf:"bytes,1,rep,name=change_results,json=changeResults,proto3" json:"change_results,omitempty"`
 }
 
-func (x *ExecuteQueryResponse) Reset() {
-	*x = ExecuteQueryResponse{}
+func (x *PreflightSchemaResponse) Reset() {
+	*x = PreflightSchemaResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tabletmanagerdata_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2003,13 +2099,13 @@ func (x *ExecuteQueryResponse) Reset() {
 	}
 }
 
-func (x *ExecuteQueryResponse) String() string {
+func (x *PreflightSchemaResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExecuteQueryResponse) ProtoMessage() {}
+func (*PreflightSchemaResponse) ProtoMessage() {}
 
-func (x *ExecuteQueryResponse) ProtoReflect() protoreflect.Message {
+func (x *PreflightSchemaResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_tabletmanagerdata_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2021,32 +2117,32 @@ func (x *ExecuteQueryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExecuteQueryResponse.ProtoReflect.Descriptor instead.
-func (*ExecuteQueryResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PreflightSchemaResponse.ProtoReflect.Descriptor instead.
+func (*PreflightSchemaResponse) Descriptor() ([]byte, []int) {
 	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *ExecuteQueryResponse) GetResult() *query.QueryResult {
+func (x *PreflightSchemaResponse) GetChangeResults() []*SchemaChangeResult {
 	if x != nil {
-		return x.Result
+		return x.ChangeResults
 	}
 	return nil
 }
 
-type ExecuteFetchAsDbaRequest struct {
+type ApplySchemaRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Query          []byte `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
-	DbName         string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	MaxRows        uint64 `protobuf:"varint,3,opt,name=max_rows,json=maxRows,proto3" json:"max_rows,omitempty"`
-	DisableBinlogs bool   `protobuf:"varint,4,opt,name=disable_binlogs,json=disableBinlogs,proto3" json:"disable_binlogs,omitempty"`
-	ReloadSchema   bool   `protobuf:"varint,5,opt,name=reload_schema,json=reloadSchema,proto3" json:"reload_schema,omitempty"`
+	Sql              string            `protobuf:"bytes,1,opt,name=sql,proto3" json:"sql,omitempty"`
+	Force            bool              `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+	AllowReplication bool              `protobuf:"varint,3,opt,name=allow_replication,json=allowReplication,proto3" json:"allow_replication,omitempty"`
+	BeforeSchema     *SchemaDefinition `protobuf:"bytes,4,opt,name=before_schema,json=beforeSchema,proto3" json:"before_schema,omitempty"`
+	AfterSchema      *SchemaDefinition `protobuf:"bytes,5,opt,name=after_schema,json=afterSchema,proto3" json:"after_schema,omitempty"`
 }
 
-func (x *ExecuteFetchAsDbaRequest) Reset() {
-	*x = ExecuteFetchAsDbaRequest{}
+func (x *ApplySchemaRequest) Reset() {
+	*x = ApplySchemaRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tabletmanagerdata_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2054,13 +2150,13 @@ func (x *ExecuteFetchAsDbaRequest) Reset() {
 	}
 }
 
-func (x *ExecuteFetchAsDbaRequest) String() string {
+func (x *ApplySchemaRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExecuteFetchAsDbaRequest) ProtoMessage() {}
+func (*ApplySchemaRequest) ProtoMessage() {}
 
-func (x *ExecuteFetchAsDbaRequest) ProtoReflect() protoreflect.Message {
+func (x *ApplySchemaRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_tabletmanagerdata_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2072,56 +2168,57 @@ func (x *ExecuteFetchAsDbaRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExecuteFetchAsDbaRequest.ProtoReflect.Descriptor instead.
-func (*ExecuteFetchAsDbaRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ApplySchemaRequest.ProtoReflect.Descriptor instead.
+func (*ApplySchemaRequest) Descriptor() ([]byte, []int) {
 	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *ExecuteFetchAsDbaRequest) GetQuery() []byte {
+func (x *ApplySchemaRequest) GetSql() string {
 	if x != nil {
-		return x.Query
+		return x.Sql
 	}
-	return nil
+	return ""
 }
 
-func (x *ExecuteFetchAsDbaRequest) GetDbName() string {
+func (x *ApplySchemaRequest) GetForce() bool {
 	if x != nil {
-		return x.DbName
+		return x.Force
 	}
-	return ""
+	return false
 }
 
-func (x *ExecuteFetchAsDbaRequest) GetMaxRows() uint64 {
+func (x *ApplySchemaRequest) GetAllowReplication() bool {
 	if x != nil {
-		return x.MaxRows
+		return x.AllowReplication
 	}
-	return 0
+	return false
 }
 
-func (x *ExecuteFetchAsDbaRequest) GetDisableBinlogs() bool {
+func (x *ApplySchemaRequest) GetBeforeSchema() *SchemaDefinition {
 	if x != nil {
-		return x.DisableBinlogs
+		return x.BeforeSchema
 	}
-	return false
+	return nil
 }
 
-func (x *ExecuteFetchAsDbaRequest) GetReloadSchema() bool {
+func (x *ApplySchemaRequest) GetAfterSchema() *SchemaDefinition {
 	if x != nil {
-		return x.ReloadSchema
+		return x.AfterSchema
 	}
-	return false
+	return nil
 }
 
-type ExecuteFetchAsDbaResponse struct {
+type ApplySchemaResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Result *query.QueryResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	BeforeSchema *SchemaDefinition `protobuf:"bytes,1,opt,name=before_schema,json=beforeSchema,proto3" json:"before_schema,omitempty"`
+	AfterSchema  *SchemaDefinition `protobuf:"bytes,2,opt,name=after_schema,json=afterSchema,proto3" json:"after_schema,omitempty"`
 }
 
-func (x *ExecuteFetchAsDbaResponse) Reset() {
-	*x = ExecuteFetchAsDbaResponse{}
+func (x *ApplySchemaResponse) Reset() {
+	*x = ApplySchemaResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tabletmanagerdata_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2129,13 +2226,13 @@ func (x *ExecuteFetchAsDbaResponse) Reset() {
 	}
 }
 
-func (x *ExecuteFetchAsDbaResponse) String() string {
+func (x *ApplySchemaResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExecuteFetchAsDbaResponse) ProtoMessage() {}
+func (*ApplySchemaResponse) ProtoMessage() {}
 
-func (x *ExecuteFetchAsDbaResponse) ProtoReflect() protoreflect.Message {
+func (x *ApplySchemaResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_tabletmanagerdata_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2147,31 +2244,33 @@ func (x *ExecuteFetchAsDbaResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExecuteFetchAsDbaResponse.ProtoReflect.Descriptor instead.
-func (*ExecuteFetchAsDbaResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ApplySchemaResponse.ProtoReflect.Descriptor instead.
+func (*ApplySchemaResponse) Descriptor() ([]byte, []int) {
 	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *ExecuteFetchAsDbaResponse) GetResult() *query.QueryResult {
+func (x *ApplySchemaResponse) GetBeforeSchema() *SchemaDefinition {
 	if x != nil {
-		return x.Result
+		return x.BeforeSchema
 	}
 	return nil
 }
 
-type ExecuteFetchAsAllPrivsRequest struct {
+func (x *ApplySchemaResponse) GetAfterSchema() *SchemaDefinition {
+	if x != nil {
+		return x.AfterSchema
+	}
+	return nil
+}
+
+type LockTablesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Query        []byte `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
-	DbName       string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	MaxRows      uint64 `protobuf:"varint,3,opt,name=max_rows,json=maxRows,proto3" json:"max_rows,omitempty"`
-	ReloadSchema bool   `protobuf:"varint,4,opt,name=reload_schema,json=reloadSchema,proto3" json:"reload_schema,omitempty"`
 }
 
-func (x *ExecuteFetchAsAllPrivsRequest) Reset() {
-	*x = ExecuteFetchAsAllPrivsRequest{}
+func (x *LockTablesRequest) Reset() {
+	*x = LockTablesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tabletmanagerdata_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2179,13 +2278,13 @@ func (x *ExecuteFetchAsAllPrivsRequest) Reset() {
 	}
 }
 
-func (x *ExecuteFetchAsAllPrivsRequest) String() string {
+func (x *LockTablesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExecuteFetchAsAllPrivsRequest) ProtoMessage() {}
+func (*LockTablesRequest) ProtoMessage() {}
 
-func (x *ExecuteFetchAsAllPrivsRequest) ProtoReflect() protoreflect.Message {
+func (x *LockTablesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_tabletmanagerdata_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2197,49 +2296,19 @@ func (x *ExecuteFetchAsAllPrivsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExecuteFetchAsAllPrivsRequest.ProtoReflect.Descriptor instead.
-func (*ExecuteFetchAsAllPrivsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use LockTablesRequest.ProtoReflect.Descriptor instead.
+func (*LockTablesRequest) Descriptor() ([]byte, []int) {
 	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *ExecuteFetchAsAllPrivsRequest) GetQuery() []byte {
-	if x != nil {
-		return x.Query
-	}
-	return nil
-}
-
-func (x *ExecuteFetchAsAllPrivsRequest) GetDbName() string {
-	if x != nil {
-		return x.DbName
-	}
-	return ""
-}
-
-func (x *ExecuteFetchAsAllPrivsRequest) GetMaxRows() uint64 {
-	if x != nil {
-		return x.MaxRows
-	}
-	return 0
-}
-
-func (x *ExecuteFetchAsAllPrivsRequest) GetReloadSchema() bool {
-	if x != nil {
-		return x.ReloadSchema
-	}
-	return false
-}
-
-type ExecuteFetchAsAllPrivsResponse struct {
+type LockTablesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Result *query.QueryResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
 }
 
-func (x *ExecuteFetchAsAllPrivsResponse) Reset() {
-	*x = ExecuteFetchAsAllPrivsResponse{}
+func (x *LockTablesResponse) Reset() {
+	*x = LockTablesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tabletmanagerdata_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2247,13 +2316,13 @@ func (x *ExecuteFetchAsAllPrivsResponse) Reset() {
 	}
 }
 
-func (x *ExecuteFetchAsAllPrivsResponse) String() string {
+func (x *LockTablesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExecuteFetchAsAllPrivsResponse) ProtoMessage() {}
+func (*LockTablesResponse) ProtoMessage() {}
 
-func (x *ExecuteFetchAsAllPrivsResponse) ProtoReflect() protoreflect.Message {
+func (x *LockTablesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_tabletmanagerdata_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2265,29 +2334,19 @@ func (x *ExecuteFetchAsAllPrivsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExecuteFetchAsAllPrivsResponse.ProtoReflect.Descriptor instead.
-func (*ExecuteFetchAsAllPrivsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use LockTablesResponse.ProtoReflect.Descriptor instead.
+func (*LockTablesResponse) Descriptor() ([]byte, []int) {
 	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *ExecuteFetchAsAllPrivsResponse) GetResult() *query.QueryResult {
-	if x != nil {
-		return x.Result
-	}
-	return nil
-}
-
-type ExecuteFetchAsAppRequest struct {
+type UnlockTablesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Query   []byte `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
-	MaxRows uint64 `protobuf:"varint,2,opt,name=max_rows,json=maxRows,proto3" json:"max_rows,omitempty"`
 }
 
-func (x *ExecuteFetchAsAppRequest) Reset() {
-	*x = ExecuteFetchAsAppRequest{}
+func (x *UnlockTablesRequest) Reset() {
+	*x = UnlockTablesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tabletmanagerdata_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2295,13 +2354,13 @@ func (x *ExecuteFetchAsAppRequest) Reset() {
 	}
 }
 
-func (x *ExecuteFetchAsAppRequest) String() string {
+func (x *UnlockTablesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExecuteFetchAsAppRequest) ProtoMessage() {}
+func (*UnlockTablesRequest) ProtoMessage() {}
 
-func (x *ExecuteFetchAsAppRequest) ProtoReflect() protoreflect.Message {
+func (x *UnlockTablesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_tabletmanagerdata_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2313,35 +2372,19 @@ func (x *ExecuteFetchAsAppRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExecuteFetchAsAppRequest.ProtoReflect.Descriptor instead.
-func (*ExecuteFetchAsAppRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnlockTablesRequest.ProtoReflect.Descriptor instead.
+func (*UnlockTablesRequest) Descriptor() ([]byte, []int) {
 	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *ExecuteFetchAsAppRequest) GetQuery() []byte {
-	if x != nil {
-		return x.Query
-	}
-	return nil
-}
-
-func (x *ExecuteFetchAsAppRequest) GetMaxRows() uint64 {
-	if x != nil {
-		return x.MaxRows
-	}
-	return 0
-}
-
-type ExecuteFetchAsAppResponse struct {
+type UnlockTablesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Result *query.QueryResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
 }
 
-func (x *ExecuteFetchAsAppResponse) Reset() {
-	*x = ExecuteFetchAsAppResponse{}
+func (x *UnlockTablesResponse) Reset() {
+	*x = UnlockTablesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tabletmanagerdata_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2349,13 +2392,13 @@ func (x *ExecuteFetchAsAppResponse) Reset() {
 	}
 }
 
-func (x *ExecuteFetchAsAppResponse) String() string {
+func (x *UnlockTablesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExecuteFetchAsAppResponse) ProtoMessage() {}
+func (*UnlockTablesResponse) ProtoMessage() {}
 
-func (x *ExecuteFetchAsAppResponse) ProtoReflect() protoreflect.Message {
+func (x *UnlockTablesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_tabletmanagerdata_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2367,26 +2410,23 @@ func (x *ExecuteFetchAsAppResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExecuteFetchAsAppResponse.ProtoReflect.Descriptor instead.
-func (*ExecuteFetchAsAppResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnlockTablesResponse.ProtoReflect.Descriptor instead.
+func (*UnlockTablesResponse) Descriptor() ([]byte, []int) {
 	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *ExecuteFetchAsAppResponse) GetResult() *query.QueryResult {
-	if x != nil {
-		return x.Result
-	}
-	return nil
-}
-
-type ReplicationStatusRequest struct {
+type ExecuteQueryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Query   []byte `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	DbName  string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	MaxRows uint64 `protobuf:"varint,3,opt,name=max_rows,json=maxRows,proto3" json:"max_rows,omitempty"`
 }
 
-func (x *ReplicationStatusRequest) Reset() {
-	*x = ReplicationStatusRequest{}
+func (x *ExecuteQueryRequest) Reset() {
+	*x = ExecuteQueryRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tabletmanagerdata_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2394,13 +2434,13 @@ func (x *ReplicationStatusRequest) Reset() {
 	}
 }
 
-func (x *ReplicationStatusRequest) String() string {
+func (x *ExecuteQueryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReplicationStatusRequest) ProtoMessage() {}
+func (*ExecuteQueryRequest) ProtoMessage() {}
 
-func (x *ReplicationStatusRequest) ProtoReflect() protoreflect.Message {
+func (x *ExecuteQueryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_tabletmanagerdata_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2412,21 +2452,42 @@ func (x *ReplicationStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReplicationStatusRequest.ProtoReflect.Descriptor instead.
-func (*ReplicationStatusRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExecuteQueryRequest.ProtoReflect.Descriptor instead.
+func (*ExecuteQueryRequest) Descriptor() ([]byte, []int) {
 	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{46}
 }
 
-type ReplicationStatusResponse struct {
+func (x *ExecuteQueryRequest) GetQuery() []byte {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+func (x *ExecuteQueryRequest) GetDbName() string {
+	if x != nil {
+		return x.DbName
+	}
+	return ""
+}
+
+func (x *ExecuteQueryRequest) GetMaxRows() uint64 {
+	if x != nil {
+		return x.MaxRows
+	}
+	return 0
+}
+
+type ExecuteQueryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status *replicationdata.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Result *query.QueryResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
 }
 
-func (x *ReplicationStatusResponse) Reset() {
-	*x = ReplicationStatusResponse{}
+func (x *ExecuteQueryResponse) Reset() {
+	*x = ExecuteQueryResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tabletmanagerdata_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2434,13 +2495,13 @@ func (x *ReplicationStatusResponse) Reset() {
 	}
 }
 
-func (x *ReplicationStatusResponse) String() string {
+func (x *ExecuteQueryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReplicationStatusResponse) ProtoMessage() {}
+func (*ExecuteQueryResponse) ProtoMessage() {}
 
-func (x *ReplicationStatusResponse) ProtoReflect() protoreflect.Message {
+func (x *ExecuteQueryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_tabletmanagerdata_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2452,26 +2513,32 @@ func (x *ReplicationStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReplicationStatusResponse.ProtoReflect.Descriptor instead.
-func (*ReplicationStatusResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExecuteQueryResponse.ProtoReflect.Descriptor instead.
+func (*ExecuteQueryResponse) Descriptor() ([]byte, []int) {
 	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *ReplicationStatusResponse) GetStatus() *replicationdata.Status {
+func (x *ExecuteQueryResponse) GetResult() *query.QueryResult {
 	if x != nil {
-		return x.Status
+		return x.Result
 	}
 	return nil
 }
 
-type PrimaryStatusRequest struct {
+type ExecuteFetchAsDbaRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Query          []byte `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	DbName         string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	MaxRows        uint64 `protobuf:"varint,3,opt,name=max_rows,json=maxRows,proto3" json:"max_rows,omitempty"`
+	DisableBinlogs bool   `protobuf:"varint,4,opt,name=disable_binlogs,json=disableBinlogs,proto3" json:"disable_binlogs,omitempty"`
+	ReloadSchema   bool   `protobuf:"varint,5,opt,name=reload_schema,json=reloadSchema,proto3" json:"reload_schema,omitempty"`
 }
 
-func (x *PrimaryStatusRequest) Reset() {
-	*x = PrimaryStatusRequest{}
+func (x *ExecuteFetchAsDbaRequest) Reset() {
+	*x = ExecuteFetchAsDbaRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tabletmanagerdata_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2479,13 +2546,13 @@ func (x *PrimaryStatusRequest) Reset() {
 	}
 }
 
-func (x *PrimaryStatusRequest) String() string {
+func (x *ExecuteFetchAsDbaRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PrimaryStatusRequest) ProtoMessage() {}
+func (*ExecuteFetchAsDbaRequest) ProtoMessage() {}
 
-func (x *PrimaryStatusRequest) ProtoReflect() protoreflect.Message {
+func (x *ExecuteFetchAsDbaRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_tabletmanagerdata_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2497,21 +2564,56 @@ func (x *PrimaryStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PrimaryStatusRequest.ProtoReflect.Descriptor instead.
-func (*PrimaryStatusRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExecuteFetchAsDbaRequest.ProtoReflect.Descriptor instead.
+func (*ExecuteFetchAsDbaRequest) Descriptor() ([]byte, []int) {
 	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{48}
 }
 
-type PrimaryStatusResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *ExecuteFetchAsDbaRequest) GetQuery() []byte {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
 
-	Status *replicationdata.PrimaryStatus `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+func (x *ExecuteFetchAsDbaRequest) GetDbName() string {
+	if x != nil {
+		return x.DbName
+	}
+	return ""
 }
 
-func (x *PrimaryStatusResponse) Reset() {
-	*x = PrimaryStatusResponse{}
+func (x *ExecuteFetchAsDbaRequest) GetMaxRows() uint64 {
+	if x != nil {
+		return x.MaxRows
+	}
+	return 0
+}
+
+func (x *ExecuteFetchAsDbaRequest) GetDisableBinlogs() bool {
+	if x != nil {
+		return x.DisableBinlogs
+	}
+	return false
+}
+
+func (x *ExecuteFetchAsDbaRequest) GetReloadSchema() bool {
+	if x != nil {
+		return x.ReloadSchema
+	}
+	return false
+}
+
+type ExecuteFetchAsDbaResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result *query.QueryResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *ExecuteFetchAsDbaResponse) Reset() {
+	*x = ExecuteFetchAsDbaResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tabletmanagerdata_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2519,13 +2621,13 @@ func (x *PrimaryStatusResponse) Reset() {
 	}
 }
 
-func (x *PrimaryStatusResponse) String() string {
+func (x *ExecuteFetchAsDbaResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PrimaryStatusResponse) ProtoMessage() {}
+func (*ExecuteFetchAsDbaResponse) ProtoMessage() {}
 
-func (x *PrimaryStatusResponse) ProtoReflect() protoreflect.Message {
+func (x *ExecuteFetchAsDbaResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_tabletmanagerdata_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2537,26 +2639,31 @@ func (x *PrimaryStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PrimaryStatusResponse.ProtoReflect.Descriptor instead.
-func (*PrimaryStatusResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExecuteFetchAsDbaResponse.ProtoReflect.Descriptor instead.
+func (*ExecuteFetchAsDbaResponse) Descriptor() ([]byte, []int) {
 	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *PrimaryStatusResponse) GetStatus() *replicationdata.PrimaryStatus {
+func (x *ExecuteFetchAsDbaResponse) GetResult() *query.QueryResult {
 	if x != nil {
-		return x.Status
+		return x.Result
 	}
 	return nil
 }
 
-type PrimaryPositionRequest struct {
+type ExecuteFetchAsAllPrivsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Query        []byte `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	DbName       string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	MaxRows      uint64 `protobuf:"varint,3,opt,name=max_rows,json=maxRows,proto3" json:"max_rows,omitempty"`
+	ReloadSchema bool   `protobuf:"varint,4,opt,name=reload_schema,json=reloadSchema,proto3" json:"reload_schema,omitempty"`
 }
 
-func (x *PrimaryPositionRequest) Reset() {
-	*x = PrimaryPositionRequest{}
+func (x *ExecuteFetchAsAllPrivsRequest) Reset() {
+	*x = ExecuteFetchAsAllPrivsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tabletmanagerdata_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2564,13 +2671,13 @@ func (x *PrimaryPositionRequest) Reset() {
 	}
 }
 
-func (x *PrimaryPositionRequest) String() string {
+func (x *ExecuteFetchAsAllPrivsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PrimaryPositionRequest) ProtoMessage() {}
+func (*ExecuteFetchAsAllPrivsRequest) ProtoMessage() {}
 
-func (x *PrimaryPositionRequest) ProtoReflect() protoreflect.Message {
+func (x *ExecuteFetchAsAllPrivsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_tabletmanagerdata_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2582,21 +2689,49 @@ func (x *PrimaryPositionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PrimaryPositionRequest.ProtoReflect.Descriptor instead.
-func (*PrimaryPositionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExecuteFetchAsAllPrivsRequest.ProtoReflect.Descriptor instead.
+func (*ExecuteFetchAsAllPrivsRequest) Descriptor() ([]byte, []int) {
 	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{50}
 }
 
-type PrimaryPositionResponse struct {
+func (x *ExecuteFetchAsAllPrivsRequest) GetQuery() []byte {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+func (x *ExecuteFetchAsAllPrivsRequest) GetDbName() string {
+	if x != nil {
+		return x.DbName
+	}
+	return ""
+}
+
+func (x *ExecuteFetchAsAllPrivsRequest) GetMaxRows() uint64 {
+	if x != nil {
+		return x.MaxRows
+	}
+	return 0
+}
+
+func (x *ExecuteFetchAsAllPrivsRequest) GetReloadSchema() bool {
+	if x != nil {
+		return x.ReloadSchema
+	}
+	return false
+}
+
+type ExecuteFetchAsAllPrivsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Position string `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
+	Result *query.QueryResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
 }
 
-func (x *PrimaryPositionResponse) Reset() {
-	*x = PrimaryPositionResponse{}
+func (x *ExecuteFetchAsAllPrivsResponse) Reset() {
+	*x = ExecuteFetchAsAllPrivsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tabletmanagerdata_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2604,13 +2739,13 @@ func (x *PrimaryPositionResponse) Reset() {
 	}
 }
 
-func (x *PrimaryPositionResponse) String() string {
+func (x *ExecuteFetchAsAllPrivsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PrimaryPositionResponse) ProtoMessage() {}
+func (*ExecuteFetchAsAllPrivsResponse) ProtoMessage() {}
 
-func (x *PrimaryPositionResponse) ProtoReflect() protoreflect.Message {
+func (x *ExecuteFetchAsAllPrivsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_tabletmanagerdata_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2622,28 +2757,29 @@ func (x *PrimaryPositionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PrimaryPositionResponse.ProtoReflect.Descriptor instead.
-func (*PrimaryPositionResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExecuteFetchAsAllPrivsResponse.ProtoReflect.Descriptor instead.
+func (*ExecuteFetchAsAllPrivsResponse) Descriptor() ([]byte, []int) {
 	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *PrimaryPositionResponse) GetPosition() string {
+func (x *ExecuteFetchAsAllPrivsResponse) GetResult() *query.QueryResult {
 	if x != nil {
-		return x.Position
+		return x.Result
 	}
-	return ""
+	return nil
 }
 
-type WaitForPositionRequest struct {
+type ExecuteFetchAsAppRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Position string `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
+	Query   []byte `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	MaxRows uint64 `protobuf:"varint,2,opt,name=max_rows,json=maxRows,proto3" json:"max_rows,omitempty"`
 }
 
-func (x *WaitForPositionRequest) Reset() {
-	*x = WaitForPositionRequest{}
+func (x *ExecuteFetchAsAppRequest) Reset() {
+	*x = ExecuteFetchAsAppRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tabletmanagerdata_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2651,13 +2787,13 @@ func (x *WaitForPositionRequest) Reset() {
 	}
 }
 
-func (x *WaitForPositionRequest) String() string {
+func (x *ExecuteFetchAsAppRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WaitForPositionRequest) ProtoMessage() {}
+func (*ExecuteFetchAsAppRequest) ProtoMessage() {}
 
-func (x *WaitForPositionRequest) ProtoReflect() protoreflect.Message {
+func (x *ExecuteFetchAsAppRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_tabletmanagerdata_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2669,26 +2805,35 @@ func (x *WaitForPositionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WaitForPositionRequest.ProtoReflect.Descriptor instead.
-func (*WaitForPositionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExecuteFetchAsAppRequest.ProtoReflect.Descriptor instead.
+func (*ExecuteFetchAsAppRequest) Descriptor() ([]byte, []int) {
 	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *WaitForPositionRequest) GetPosition() string {
+func (x *ExecuteFetchAsAppRequest) GetQuery() []byte {
 	if x != nil {
-		return x.Position
+		return x.Query
 	}
-	return ""
+	return nil
 }
 
-type WaitForPositionResponse struct {
+func (x *ExecuteFetchAsAppRequest) GetMaxRows() uint64 {
+	if x != nil {
+		return x.MaxRows
+	}
+	return 0
+}
+
+type ExecuteFetchAsAppResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Result *query.QueryResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
 }
 
-func (x *WaitForPositionResponse) Reset() {
-	*x = WaitForPositionResponse{}
+func (x *ExecuteFetchAsAppResponse) Reset() {
+	*x = ExecuteFetchAsAppResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tabletmanagerdata_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2696,13 +2841,13 @@ func (x *WaitForPositionResponse) Reset() {
 	}
 }
 
-func (x *WaitForPositionResponse) String() string {
+func (x *ExecuteFetchAsAppResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WaitForPositionResponse) ProtoMessage() {}
+func (*ExecuteFetchAsAppResponse) ProtoMessage() {}
 
-func (x *WaitForPositionResponse) ProtoReflect() protoreflect.Message {
+func (x *ExecuteFetchAsAppResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_tabletmanagerdata_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2714,19 +2859,33 @@ func (x *WaitForPositionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WaitForPositionResponse.ProtoReflect.Descriptor instead.
-func (*WaitForPositionResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExecuteFetchAsAppResponse.ProtoReflect.Descriptor instead.
+func (*ExecuteFetchAsAppResponse) Descriptor() ([]byte, []int) {
 	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{53}
 }
 
-type StopReplicationRequest struct {
+func (x *ExecuteFetchAsAppResponse) GetResult() *query.QueryResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type KillQueriesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// fingerprint is the fingerprint of the queries to kill, or empty for any
+	// query.
+	Fingerprint string `protobuf:"bytes,1,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	// user is the immediate caller of the queries to kill, or empty for any
+	// user.
+	User string `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
 }
 
-func (x *StopReplicationRequest) Reset() {
-	*x = StopReplicationRequest{}
+func (x *KillQueriesRequest) Reset() {
+	*x = KillQueriesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tabletmanagerdata_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2734,13 +2893,13 @@ func (x *StopReplicationRequest) Reset() {
 	}
 }
 
-func (x *StopReplicationRequest) String() string {
+func (x *KillQueriesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StopReplicationRequest) ProtoMessage() {}
+func (*KillQueriesRequest) ProtoMessage() {}
 
-func (x *StopReplicationRequest) ProtoReflect() protoreflect.Message {
+func (x *KillQueriesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_tabletmanagerdata_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2752,19 +2911,36 @@ func (x *StopReplicationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StopReplicationRequest.ProtoReflect.Descriptor instead.
-func (*StopReplicationRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use KillQueriesRequest.ProtoReflect.Descriptor instead.
+func (*KillQueriesRequest) Descriptor() ([]byte, []int) {
 	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{54}
 }
 
-type StopReplicationResponse struct {
+func (x *KillQueriesRequest) GetFingerprint() string {
+	if x != nil {
+		return x.Fingerprint
+	}
+	return ""
+}
+
+func (x *KillQueriesRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+type KillQueriesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// killed is the number of queries killed.
+	Killed int64 `protobuf:"varint,1,opt,name=killed,proto3" json:"killed,omitempty"`
 }
 
-func (x *StopReplicationResponse) Reset() {
-	*x = StopReplicationResponse{}
+func (x *KillQueriesResponse) Reset() {
+	*x = KillQueriesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tabletmanagerdata_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2772,13 +2948,13 @@ func (x *StopReplicationResponse) Reset() {
 	}
 }
 
-func (x *StopReplicationResponse) String() string {
+func (x *KillQueriesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StopReplicationResponse) ProtoMessage() {}
+func (*KillQueriesResponse) ProtoMessage() {}
 
-func (x *StopReplicationResponse) ProtoReflect() protoreflect.Message {
+func (x *KillQueriesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_tabletmanagerdata_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2790,22 +2966,26 @@ func (x *StopReplicationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StopReplicationResponse.ProtoReflect.Descriptor instead.
-func (*StopReplicationResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use KillQueriesResponse.ProtoReflect.Descriptor instead.
+func (*KillQueriesResponse) Descriptor() ([]byte, []int) {
 	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{55}
 }
 
-type StopReplicationMinimumRequest struct {
+func (x *KillQueriesResponse) GetKilled() int64 {
+	if x != nil {
+		return x.Killed
+	}
+	return 0
+}
+
+type ReplicationStatusRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Position    string `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
-	WaitTimeout int64  `protobuf:"varint,2,opt,name=wait_timeout,json=waitTimeout,proto3" json:"wait_timeout,omitempty"`
 }
 
-func (x *StopReplicationMinimumRequest) Reset() {
-	*x = StopReplicationMinimumRequest{}
+func (x *ReplicationStatusRequest) Reset() {
+	*x = ReplicationStatusRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tabletmanagerdata_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2813,13 +2993,13 @@ func (x *StopReplicationMinimumRequest) Reset() {
 	}
 }
 
-func (x *StopReplicationMinimumRequest) String() string {
+func (x *ReplicationStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StopReplicationMinimumRequest) ProtoMessage() {}
+func (*ReplicationStatusRequest) ProtoMessage() {}
 
-func (x *StopReplicationMinimumRequest) ProtoReflect() protoreflect.Message {
+func (x *ReplicationStatusRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_tabletmanagerdata_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2831,35 +3011,21 @@ func (x *StopReplicationMinimumRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StopReplicationMinimumRequest.ProtoReflect.Descriptor instead.
-func (*StopReplicationMinimumRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReplicationStatusRequest.ProtoReflect.Descriptor instead.
+func (*ReplicationStatusRequest) Descriptor() ([]byte, []int) {
 	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *StopReplicationMinimumRequest) GetPosition() string {
-	if x != nil {
-		return x.Position
-	}
-	return ""
-}
-
-func (x *StopReplicationMinimumRequest) GetWaitTimeout() int64 {
-	if x != nil {
-		return x.WaitTimeout
-	}
-	return 0
-}
-
-type StopReplicationMinimumResponse struct {
+type ReplicationStatusResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Position string `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
+	Status *replicationdata.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *StopReplicationMinimumResponse) Reset() {
-	*x = StopReplicationMinimumResponse{}
+func (x *ReplicationStatusResponse) Reset() {
+	*x = ReplicationStatusResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tabletmanagerdata_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2867,13 +3033,13 @@ func (x *StopReplicationMinimumResponse) Reset() {
 	}
 }
 
-func (x *StopReplicationMinimumResponse) String() string {
+func (x *ReplicationStatusResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StopReplicationMinimumResponse) ProtoMessage() {}
+func (*ReplicationStatusResponse) ProtoMessage() {}
 
-func (x *StopReplicationMinimumResponse) ProtoReflect() protoreflect.Message {
+func (x *ReplicationStatusResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_tabletmanagerdata_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2885,26 +3051,26 @@ func (x *StopReplicationMinimumResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StopReplicationMinimumResponse.ProtoReflect.Descriptor instead.
-func (*StopReplicationMinimumResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReplicationStatusResponse.ProtoReflect.Descriptor instead.
+func (*ReplicationStatusResponse) Descriptor() ([]byte, []int) {
 	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *StopReplicationMinimumResponse) GetPosition() string {
+func (x *ReplicationStatusResponse) GetStatus() *replicationdata.Status {
 	if x != nil {
-		return x.Position
+		return x.Status
 	}
-	return ""
+	return nil
 }
 
-type StartReplicationRequest struct {
+type PrimaryStatusRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *StartReplicationRequest) Reset() {
-	*x = StartReplicationRequest{}
+func (x *PrimaryStatusRequest) Reset() {
+	*x = PrimaryStatusRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tabletmanagerdata_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2912,13 +3078,13 @@ func (x *StartReplicationRequest) Reset() {
 	}
 }
 
-func (x *StartReplicationRequest) String() string {
+func (x *PrimaryStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartReplicationRequest) ProtoMessage() {}
+func (*PrimaryStatusRequest) ProtoMessage() {}
 
-func (x *StartReplicationRequest) ProtoReflect() protoreflect.Message {
+func (x *PrimaryStatusRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_tabletmanagerdata_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2930,19 +3096,21 @@ func (x *StartReplicationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartReplicationRequest.ProtoReflect.Descriptor instead.
-func (*StartReplicationRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PrimaryStatusRequest.ProtoReflect.Descriptor instead.
+func (*PrimaryStatusRequest) Descriptor() ([]byte, []int) {
 	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{58}
 }
 
-type StartReplicationResponse struct {
+type PrimaryStatusResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Status *replicationdata.PrimaryStatus `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *StartReplicationResponse) Reset() {
-	*x = StartReplicationResponse{}
+func (x *PrimaryStatusResponse) Reset() {
+	*x = PrimaryStatusResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tabletmanagerdata_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2950,13 +3118,13 @@ func (x *StartReplicationResponse) Reset() {
 	}
 }
 
-func (x *StartReplicationResponse) String() string {
+func (x *PrimaryStatusResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartReplicationResponse) ProtoMessage() {}
+func (*PrimaryStatusResponse) ProtoMessage() {}
 
-func (x *StartReplicationResponse) ProtoReflect() protoreflect.Message {
+func (x *PrimaryStatusResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_tabletmanagerdata_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2968,22 +3136,26 @@ func (x *StartReplicationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartReplicationResponse.ProtoReflect.Descriptor instead.
-func (*StartReplicationResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PrimaryStatusResponse.ProtoReflect.Descriptor instead.
+func (*PrimaryStatusResponse) Descriptor() ([]byte, []int) {
 	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{59}
 }
 
-type StartReplicationUntilAfterRequest struct {
+func (x *PrimaryStatusResponse) GetStatus() *replicationdata.PrimaryStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type PrimaryPositionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Position    string `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
-	WaitTimeout int64  `protobuf:"varint,2,opt,name=wait_timeout,json=waitTimeout,proto3" json:"wait_timeout,omitempty"`
 }
 
-func (x *StartReplicationUntilAfterRequest) Reset() {
-	*x = StartReplicationUntilAfterRequest{}
+func (x *PrimaryPositionRequest) Reset() {
+	*x = PrimaryPositionRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tabletmanagerdata_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2991,13 +3163,13 @@ func (x *StartReplicationUntilAfterRequest) Reset() {
 	}
 }
 
-func (x *StartReplicationUntilAfterRequest) String() string {
+func (x *PrimaryPositionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartReplicationUntilAfterRequest) ProtoMessage() {}
+func (*PrimaryPositionRequest) ProtoMessage() {}
 
-func (x *StartReplicationUntilAfterRequest) ProtoReflect() protoreflect.Message {
+func (x *PrimaryPositionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_tabletmanagerdata_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3009,33 +3181,21 @@ func (x *StartReplicationUntilAfterRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartReplicationUntilAfterRequest.ProtoReflect.Descriptor instead.
-func (*StartReplicationUntilAfterRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PrimaryPositionRequest.ProtoReflect.Descriptor instead.
+func (*PrimaryPositionRequest) Descriptor() ([]byte, []int) {
 	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *StartReplicationUntilAfterRequest) GetPosition() string {
-	if x != nil {
-		return x.Position
-	}
-	return ""
-}
-
-func (x *StartReplicationUntilAfterRequest) GetWaitTimeout() int64 {
-	if x != nil {
-		return x.WaitTimeout
-	}
-	return 0
-}
-
-type StartReplicationUntilAfterResponse struct {
+type PrimaryPositionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Position string `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
 }
 
-func (x *StartReplicationUntilAfterResponse) Reset() {
-	*x = StartReplicationUntilAfterResponse{}
+func (x *PrimaryPositionResponse) Reset() {
+	*x = PrimaryPositionResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tabletmanagerdata_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3043,13 +3203,13 @@ func (x *StartReplicationUntilAfterResponse) Reset() {
 	}
 }
 
-func (x *StartReplicationUntilAfterResponse) String() string {
+func (x *PrimaryPositionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartReplicationUntilAfterResponse) ProtoMessage() {}
+func (*PrimaryPositionResponse) ProtoMessage() {}
 
-func (x *StartReplicationUntilAfterResponse) ProtoReflect() protoreflect.Message {
+func (x *PrimaryPositionResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_tabletmanagerdata_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3061,19 +3221,28 @@ func (x *StartReplicationUntilAfterResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartReplicationUntilAfterResponse.ProtoReflect.Descriptor instead.
-func (*StartReplicationUntilAfterResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PrimaryPositionResponse.ProtoReflect.Descriptor instead.
+func (*PrimaryPositionResponse) Descriptor() ([]byte, []int) {
 	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{61}
 }
 
-type GetReplicasRequest struct {
+func (x *PrimaryPositionResponse) GetPosition() string {
+	if x != nil {
+		return x.Position
+	}
+	return ""
+}
+
+type WaitForPositionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Position string `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
 }
 
-func (x *GetReplicasRequest) Reset() {
-	*x = GetReplicasRequest{}
+func (x *WaitForPositionRequest) Reset() {
+	*x = WaitForPositionRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tabletmanagerdata_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3081,13 +3250,13 @@ func (x *GetReplicasRequest) Reset() {
 	}
 }
 
-func (x *GetReplicasRequest) String() string {
+func (x *WaitForPositionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetReplicasRequest) ProtoMessage() {}
+func (*WaitForPositionRequest) ProtoMessage() {}
 
-func (x *GetReplicasRequest) ProtoReflect() protoreflect.Message {
+func (x *WaitForPositionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_tabletmanagerdata_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3099,21 +3268,26 @@ func (x *GetReplicasRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetReplicasRequest.ProtoReflect.Descriptor instead.
-func (*GetReplicasRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use WaitForPositionRequest.ProtoReflect.Descriptor instead.
+func (*WaitForPositionRequest) Descriptor() ([]byte, []int) {
 	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{62}
 }
 
-type GetReplicasResponse struct {
+func (x *WaitForPositionRequest) GetPosition() string {
+	if x != nil {
+		return x.Position
+	}
+	return ""
+}
+
+type WaitForPositionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Addrs []string `protobuf:"bytes,1,rep,name=addrs,proto3" json:"addrs,omitempty"`
 }
 
-func (x *GetReplicasResponse) Reset() {
-	*x = GetReplicasResponse{}
+func (x *WaitForPositionResponse) Reset() {
+	*x = WaitForPositionResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tabletmanagerdata_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3121,13 +3295,13 @@ func (x *GetReplicasResponse) Reset() {
 	}
 }
 
-func (x *GetReplicasResponse) String() string {
+func (x *WaitForPositionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetReplicasResponse) ProtoMessage() {}
+func (*WaitForPositionResponse) ProtoMessage() {}
 
-func (x *GetReplicasResponse) ProtoReflect() protoreflect.Message {
+func (x *WaitForPositionResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_tabletmanagerdata_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3139,26 +3313,19 @@ func (x *GetReplicasResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetReplicasResponse.ProtoReflect.Descriptor instead.
-func (*GetReplicasResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use WaitForPositionResponse.ProtoReflect.Descriptor instead.
+func (*WaitForPositionResponse) Descriptor() ([]byte, []int) {
 	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *GetReplicasResponse) GetAddrs() []string {
-	if x != nil {
-		return x.Addrs
-	}
-	return nil
-}
-
-type ResetReplicationRequest struct {
+type StopReplicationRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *ResetReplicationRequest) Reset() {
-	*x = ResetReplicationRequest{}
+func (x *StopReplicationRequest) Reset() {
+	*x = StopReplicationRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tabletmanagerdata_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3166,13 +3333,13 @@ func (x *ResetReplicationRequest) Reset() {
 	}
 }
 
-func (x *ResetReplicationRequest) String() string {
+func (x *StopReplicationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResetReplicationRequest) ProtoMessage() {}
+func (*StopReplicationRequest) ProtoMessage() {}
 
-func (x *ResetReplicationRequest) ProtoReflect() protoreflect.Message {
+func (x *StopReplicationRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_tabletmanagerdata_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3184,19 +3351,19 @@ func (x *ResetReplicationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResetReplicationRequest.ProtoReflect.Descriptor instead.
-func (*ResetReplicationRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use StopReplicationRequest.ProtoReflect.Descriptor instead.
+func (*StopReplicationRequest) Descriptor() ([]byte, []int) {
 	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{64}
 }
 
-type ResetReplicationResponse struct {
+type StopReplicationResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *ResetReplicationResponse) Reset() {
-	*x = ResetReplicationResponse{}
+func (x *StopReplicationResponse) Reset() {
+	*x = StopReplicationResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tabletmanagerdata_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3204,13 +3371,13 @@ func (x *ResetReplicationResponse) Reset() {
 	}
 }
 
-func (x *ResetReplicationResponse) String() string {
+func (x *StopReplicationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResetReplicationResponse) ProtoMessage() {}
+func (*StopReplicationResponse) ProtoMessage() {}
 
-func (x *ResetReplicationResponse) ProtoReflect() protoreflect.Message {
+func (x *StopReplicationResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_tabletmanagerdata_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3222,21 +3389,22 @@ func (x *ResetReplicationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResetReplicationResponse.ProtoReflect.Descriptor instead.
-func (*ResetReplicationResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use StopReplicationResponse.ProtoReflect.Descriptor instead.
+func (*StopReplicationResponse) Descriptor() ([]byte, []int) {
 	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{65}
 }
 
-type VReplicationExecRequest struct {
+type StopReplicationMinimumRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Position    string `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
+	WaitTimeout int64  `protobuf:"varint,2,opt,name=wait_timeout,json=waitTimeout,proto3" json:"wait_timeout,omitempty"`
 }
 
-func (x *VReplicationExecRequest) Reset() {
-	*x = VReplicationExecRequest{}
+func (x *StopReplicationMinimumRequest) Reset() {
+	*x = StopReplicationMinimumRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tabletmanagerdata_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3244,13 +3412,13 @@ func (x *VReplicationExecRequest) Reset() {
 	}
 }
 
-func (x *VReplicationExecRequest) String() string {
+func (x *StopReplicationMinimumRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VReplicationExecRequest) ProtoMessage() {}
+func (*StopReplicationMinimumRequest) ProtoMessage() {}
 
-func (x *VReplicationExecRequest) ProtoReflect() protoreflect.Message {
+func (x *StopReplicationMinimumRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_tabletmanagerdata_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3262,28 +3430,35 @@ func (x *VReplicationExecRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VReplicationExecRequest.ProtoReflect.Descriptor instead.
-func (*VReplicationExecRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use StopReplicationMinimumRequest.ProtoReflect.Descriptor instead.
+func (*StopReplicationMinimumRequest) Descriptor() ([]byte, []int) {
 	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *VReplicationExecRequest) GetQuery() string {
+func (x *StopReplicationMinimumRequest) GetPosition() string {
 	if x != nil {
-		return x.Query
+		return x.Position
 	}
 	return ""
 }
 
-type VReplicationExecResponse struct {
+func (x *StopReplicationMinimumRequest) GetWaitTimeout() int64 {
+	if x != nil {
+		return x.WaitTimeout
+	}
+	return 0
+}
+
+type StopReplicationMinimumResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Result *query.QueryResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	Position string `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
 }
 
-func (x *VReplicationExecResponse) Reset() {
-	*x = VReplicationExecResponse{}
+func (x *StopReplicationMinimumResponse) Reset() {
+	*x = StopReplicationMinimumResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tabletmanagerdata_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3291,13 +3466,13 @@ func (x *VReplicationExecResponse) Reset() {
 	}
 }
 
-func (x *VReplicationExecResponse) String() string {
+func (x *StopReplicationMinimumResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VReplicationExecResponse) ProtoMessage() {}
+func (*StopReplicationMinimumResponse) ProtoMessage() {}
 
-func (x *VReplicationExecResponse) ProtoReflect() protoreflect.Message {
+func (x *StopReplicationMinimumResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_tabletmanagerdata_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3309,29 +3484,26 @@ func (x *VReplicationExecResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VReplicationExecResponse.ProtoReflect.Descriptor instead.
-func (*VReplicationExecResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use StopReplicationMinimumResponse.ProtoReflect.Descriptor instead.
+func (*StopReplicationMinimumResponse) Descriptor() ([]byte, []int) {
 	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *VReplicationExecResponse) GetResult() *query.QueryResult {
+func (x *StopReplicationMinimumResponse) GetPosition() string {
 	if x != nil {
-		return x.Result
+		return x.Position
 	}
-	return nil
+	return ""
 }
 
-type VReplicationWaitForPosRequest struct {
+type StartReplicationRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+}
 
-	Id       int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Position string `protobuf:"bytes,2,opt,name=position,proto3" json:"position,omitempty"`
-}
-
-func (x *VReplicationWaitForPosRequest) Reset() {
-	*x = VReplicationWaitForPosRequest{}
+func (x *StartReplicationRequest) Reset() {
+	*x = StartReplicationRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tabletmanagerdata_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3339,13 +3511,13 @@ func (x *VReplicationWaitForPosRequest) Reset() {
 	}
 }
 
-func (x *VReplicationWaitForPosRequest) String() string {
+func (x *StartReplicationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VReplicationWaitForPosRequest) ProtoMessage() {}
+func (*StartReplicationRequest) ProtoMessage() {}
 
-func (x *VReplicationWaitForPosRequest) ProtoReflect() protoreflect.Message {
+func (x *StartReplicationRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_tabletmanagerdata_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3357,33 +3529,19 @@ func (x *VReplicationWaitForPosRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VReplicationWaitForPosRequest.ProtoReflect.Descriptor instead.
-func (*VReplicationWaitForPosRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use StartReplicationRequest.ProtoReflect.Descriptor instead.
+func (*StartReplicationRequest) Descriptor() ([]byte, []int) {
 	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *VReplicationWaitForPosRequest) GetId() int64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *VReplicationWaitForPosRequest) GetPosition() string {
-	if x != nil {
-		return x.Position
-	}
-	return ""
-}
-
-type VReplicationWaitForPosResponse struct {
+type StartReplicationResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *VReplicationWaitForPosResponse) Reset() {
-	*x = VReplicationWaitForPosResponse{}
+func (x *StartReplicationResponse) Reset() {
+	*x = StartReplicationResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tabletmanagerdata_proto_msgTypes[69]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3391,13 +3549,13 @@ func (x *VReplicationWaitForPosResponse) Reset() {
 	}
 }
 
-func (x *VReplicationWaitForPosResponse) String() string {
+func (x *StartReplicationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VReplicationWaitForPosResponse) ProtoMessage() {}
+func (*StartReplicationResponse) ProtoMessage() {}
 
-func (x *VReplicationWaitForPosResponse) ProtoReflect() protoreflect.Message {
+func (x *StartReplicationResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_tabletmanagerdata_proto_msgTypes[69]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3409,19 +3567,22 @@ func (x *VReplicationWaitForPosResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VReplicationWaitForPosResponse.ProtoReflect.Descriptor instead.
-func (*VReplicationWaitForPosResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use StartReplicationResponse.ProtoReflect.Descriptor instead.
+func (*StartReplicationResponse) Descriptor() ([]byte, []int) {
 	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{69}
 }
 
-type InitPrimaryRequest struct {
+type StartReplicationUntilAfterRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Position    string `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
+	WaitTimeout int64  `protobuf:"varint,2,opt,name=wait_timeout,json=waitTimeout,proto3" json:"wait_timeout,omitempty"`
 }
 
-func (x *InitPrimaryRequest) Reset() {
-	*x = InitPrimaryRequest{}
+func (x *StartReplicationUntilAfterRequest) Reset() {
+	*x = StartReplicationUntilAfterRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tabletmanagerdata_proto_msgTypes[70]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3429,13 +3590,13 @@ func (x *InitPrimaryRequest) Reset() {
 	}
 }
 
-func (x *InitPrimaryRequest) String() string {
+func (x *StartReplicationUntilAfterRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InitPrimaryRequest) ProtoMessage() {}
+func (*StartReplicationUntilAfterRequest) ProtoMessage() {}
 
-func (x *InitPrimaryRequest) ProtoReflect() protoreflect.Message {
+func (x *StartReplicationUntilAfterRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_tabletmanagerdata_proto_msgTypes[70]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3447,21 +3608,33 @@ func (x *InitPrimaryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InitPrimaryRequest.ProtoReflect.Descriptor instead.
-func (*InitPrimaryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use StartReplicationUntilAfterRequest.ProtoReflect.Descriptor instead.
+func (*StartReplicationUntilAfterRequest) Descriptor() ([]byte, []int) {
 	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{70}
 }
 
-type InitPrimaryResponse struct {
+func (x *StartReplicationUntilAfterRequest) GetPosition() string {
+	if x != nil {
+		return x.Position
+	}
+	return ""
+}
+
+func (x *StartReplicationUntilAfterRequest) GetWaitTimeout() int64 {
+	if x != nil {
+		return x.WaitTimeout
+	}
+	return 0
+}
+
+type StartReplicationUntilAfterResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Position string `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
 }
 
-func (x *InitPrimaryResponse) Reset() {
-	*x = InitPrimaryResponse{}
+func (x *StartReplicationUntilAfterResponse) Reset() {
+	*x = StartReplicationUntilAfterResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tabletmanagerdata_proto_msgTypes[71]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3469,13 +3642,13 @@ func (x *InitPrimaryResponse) Reset() {
 	}
 }
 
-func (x *InitPrimaryResponse) String() string {
+func (x *StartReplicationUntilAfterResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InitPrimaryResponse) ProtoMessage() {}
+func (*StartReplicationUntilAfterResponse) ProtoMessage() {}
 
-func (x *InitPrimaryResponse) ProtoReflect() protoreflect.Message {
+func (x *StartReplicationUntilAfterResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_tabletmanagerdata_proto_msgTypes[71]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3487,31 +3660,19 @@ func (x *InitPrimaryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InitPrimaryResponse.ProtoReflect.Descriptor instead.
-func (*InitPrimaryResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use StartReplicationUntilAfterResponse.ProtoReflect.Descriptor instead.
+func (*StartReplicationUntilAfterResponse) Descriptor() ([]byte, []int) {
 	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *InitPrimaryResponse) GetPosition() string {
-	if x != nil {
-		return x.Position
-	}
-	return ""
-}
-
-type PopulateReparentJournalRequest struct {
+type GetReplicasRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	TimeCreatedNs       int64                 `protobuf:"varint,1,opt,name=time_created_ns,json=timeCreatedNs,proto3" json:"time_created_ns,omitempty"`
-	ActionName          string                `protobuf:"bytes,2,opt,name=action_name,json=actionName,proto3" json:"action_name,omitempty"`
-	PrimaryAlias        *topodata.TabletAlias `protobuf:"bytes,3,opt,name=primary_alias,json=primaryAlias,proto3" json:"primary_alias,omitempty"`
-	ReplicationPosition string                `protobuf:"bytes,4,opt,name=replication_position,json=replicationPosition,proto3" json:"replication_position,omitempty"`
 }
 
-func (x *PopulateReparentJournalRequest) Reset() {
-	*x = PopulateReparentJournalRequest{}
+func (x *GetReplicasRequest) Reset() {
+	*x = GetReplicasRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tabletmanagerdata_proto_msgTypes[72]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3519,13 +3680,13 @@ func (x *PopulateReparentJournalRequest) Reset() {
 	}
 }
 
-func (x *PopulateReparentJournalRequest) String() string {
+func (x *GetReplicasRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PopulateReparentJournalRequest) ProtoMessage() {}
+func (*GetReplicasRequest) ProtoMessage() {}
 
-func (x *PopulateReparentJournalRequest) ProtoReflect() protoreflect.Message {
+func (x *GetReplicasRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_tabletmanagerdata_proto_msgTypes[72]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3537,47 +3698,21 @@ func (x *PopulateReparentJournalRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PopulateReparentJournalRequest.ProtoReflect.Descriptor instead.
-func (*PopulateReparentJournalRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetReplicasRequest.ProtoReflect.Descriptor instead.
+func (*GetReplicasRequest) Descriptor() ([]byte, []int) {
 	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *PopulateReparentJournalRequest) GetTimeCreatedNs() int64 {
-	if x != nil {
-		return x.TimeCreatedNs
-	}
-	return 0
-}
-
-func (x *PopulateReparentJournalRequest) GetActionName() string {
-	if x != nil {
-		return x.ActionName
-	}
-	return ""
-}
-
-func (x *PopulateReparentJournalRequest) GetPrimaryAlias() *topodata.TabletAlias {
-	if x != nil {
-		return x.PrimaryAlias
-	}
-	return nil
-}
-
-func (x *PopulateReparentJournalRequest) GetReplicationPosition() string {
-	if x != nil {
-		return x.ReplicationPosition
-	}
-	return ""
-}
-
-type PopulateReparentJournalResponse struct {
+type GetReplicasResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Addrs []string `protobuf:"bytes,1,rep,name=addrs,proto3" json:"addrs,omitempty"`
 }
 
-func (x *PopulateReparentJournalResponse) Reset() {
-	*x = PopulateReparentJournalResponse{}
+func (x *GetReplicasResponse) Reset() {
+	*x = GetReplicasResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tabletmanagerdata_proto_msgTypes[73]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3585,13 +3720,13 @@ func (x *PopulateReparentJournalResponse) Reset() {
 	}
 }
 
-func (x *PopulateReparentJournalResponse) String() string {
+func (x *GetReplicasResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PopulateReparentJournalResponse) ProtoMessage() {}
+func (*GetReplicasResponse) ProtoMessage() {}
 
-func (x *PopulateReparentJournalResponse) ProtoReflect() protoreflect.Message {
+func (x *GetReplicasResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_tabletmanagerdata_proto_msgTypes[73]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3603,23 +3738,26 @@ func (x *PopulateReparentJournalResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PopulateReparentJournalResponse.ProtoReflect.Descriptor instead.
-func (*PopulateReparentJournalResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetReplicasResponse.ProtoReflect.Descriptor instead.
+func (*GetReplicasResponse) Descriptor() ([]byte, []int) {
 	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{73}
 }
 
-type InitReplicaRequest struct {
+func (x *GetReplicasResponse) GetAddrs() []string {
+	if x != nil {
+		return x.Addrs
+	}
+	return nil
+}
+
+type ResetReplicationRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Parent              *topodata.TabletAlias `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
-	ReplicationPosition string                `protobuf:"bytes,2,opt,name=replication_position,json=replicationPosition,proto3" json:"replication_position,omitempty"`
-	TimeCreatedNs       int64                 `protobuf:"varint,3,opt,name=time_created_ns,json=timeCreatedNs,proto3" json:"time_created_ns,omitempty"`
 }
 
-func (x *InitReplicaRequest) Reset() {
-	*x = InitReplicaRequest{}
+func (x *ResetReplicationRequest) Reset() {
+	*x = ResetReplicationRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tabletmanagerdata_proto_msgTypes[74]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3627,13 +3765,13 @@ func (x *InitReplicaRequest) Reset() {
 	}
 }
 
-func (x *InitReplicaRequest) String() string {
+func (x *ResetReplicationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InitReplicaRequest) ProtoMessage() {}
+func (*ResetReplicationRequest) ProtoMessage() {}
 
-func (x *InitReplicaRequest) ProtoReflect() protoreflect.Message {
+func (x *ResetReplicationRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_tabletmanagerdata_proto_msgTypes[74]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3645,40 +3783,19 @@ func (x *InitReplicaRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InitReplicaRequest.ProtoReflect.Descriptor instead.
-func (*InitReplicaRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResetReplicationRequest.ProtoReflect.Descriptor instead.
+func (*ResetReplicationRequest) Descriptor() ([]byte, []int) {
 	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *InitReplicaRequest) GetParent() *topodata.TabletAlias {
-	if x != nil {
-		return x.Parent
-	}
-	return nil
-}
-
-func (x *InitReplicaRequest) GetReplicationPosition() string {
-	if x != nil {
-		return x.ReplicationPosition
-	}
-	return ""
-}
-
-func (x *InitReplicaRequest) GetTimeCreatedNs() int64 {
-	if x != nil {
-		return x.TimeCreatedNs
-	}
-	return 0
-}
-
-type InitReplicaResponse struct {
+type ResetReplicationResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *InitReplicaResponse) Reset() {
-	*x = InitReplicaResponse{}
+func (x *ResetReplicationResponse) Reset() {
+	*x = ResetReplicationResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tabletmanagerdata_proto_msgTypes[75]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3686,13 +3803,13 @@ func (x *InitReplicaResponse) Reset() {
 	}
 }
 
-func (x *InitReplicaResponse) String() string {
+func (x *ResetReplicationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InitReplicaResponse) ProtoMessage() {}
+func (*ResetReplicationResponse) ProtoMessage() {}
 
-func (x *InitReplicaResponse) ProtoReflect() protoreflect.Message {
+func (x *ResetReplicationResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_tabletmanagerdata_proto_msgTypes[75]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3704,19 +3821,21 @@ func (x *InitReplicaResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InitReplicaResponse.ProtoReflect.Descriptor instead.
-func (*InitReplicaResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResetReplicationResponse.ProtoReflect.Descriptor instead.
+func (*ResetReplicationResponse) Descriptor() ([]byte, []int) {
 	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{75}
 }
 
-type DemotePrimaryRequest struct {
+type VReplicationExecRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
 }
 
-func (x *DemotePrimaryRequest) Reset() {
-	*x = DemotePrimaryRequest{}
+func (x *VReplicationExecRequest) Reset() {
+	*x = VReplicationExecRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tabletmanagerdata_proto_msgTypes[76]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3724,13 +3843,13 @@ func (x *DemotePrimaryRequest) Reset() {
 	}
 }
 
-func (x *DemotePrimaryRequest) String() string {
+func (x *VReplicationExecRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DemotePrimaryRequest) ProtoMessage() {}
+func (*VReplicationExecRequest) ProtoMessage() {}
 
-func (x *DemotePrimaryRequest) ProtoReflect() protoreflect.Message {
+func (x *VReplicationExecRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_tabletmanagerdata_proto_msgTypes[76]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3742,26 +3861,28 @@ func (x *DemotePrimaryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DemotePrimaryRequest.ProtoReflect.Descriptor instead.
-func (*DemotePrimaryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use VReplicationExecRequest.ProtoReflect.Descriptor instead.
+func (*VReplicationExecRequest) Descriptor() ([]byte, []int) {
 	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{76}
 }
 
-type DemotePrimaryResponse struct {
+func (x *VReplicationExecRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+type VReplicationExecResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Position is deprecated, and is a string representation of a demoted primaries executed position.
-	//
-	// Deprecated: Do not use.
-	DeprecatedPosition string `protobuf:"bytes,1,opt,name=deprecated_position,json=deprecatedPosition,proto3" json:"deprecated_position,omitempty"`
-	// PrimaryStatus represents the response from calling `SHOW MASTER STATUS` on a primary that has been demoted.
-	PrimaryStatus *replicationdata.PrimaryStatus `protobuf:"bytes,2,opt,name=primary_status,json=primaryStatus,proto3" json:"primary_status,omitempty"`
+	Result *query.QueryResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
 }
 
-func (x *DemotePrimaryResponse) Reset() {
-	*x = DemotePrimaryResponse{}
+func (x *VReplicationExecResponse) Reset() {
+	*x = VReplicationExecResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tabletmanagerdata_proto_msgTypes[77]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3769,13 +3890,13 @@ func (x *DemotePrimaryResponse) Reset() {
 	}
 }
 
-func (x *DemotePrimaryResponse) String() string {
+func (x *VReplicationExecResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DemotePrimaryResponse) ProtoMessage() {}
+func (*VReplicationExecResponse) ProtoMessage() {}
 
-func (x *DemotePrimaryResponse) ProtoReflect() protoreflect.Message {
+func (x *VReplicationExecResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_tabletmanagerdata_proto_msgTypes[77]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3787,34 +3908,29 @@ func (x *DemotePrimaryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DemotePrimaryResponse.ProtoReflect.Descriptor instead.
-func (*DemotePrimaryResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use VReplicationExecResponse.ProtoReflect.Descriptor instead.
+func (*VReplicationExecResponse) Descriptor() ([]byte, []int) {
 	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{77}
 }
 
-// Deprecated: Do not use.
-func (x *DemotePrimaryResponse) GetDeprecatedPosition() string {
-	if x != nil {
-		return x.DeprecatedPosition
-	}
-	return ""
-}
-
-func (x *DemotePrimaryResponse) GetPrimaryStatus() *replicationdata.PrimaryStatus {
+func (x *VReplicationExecResponse) GetResult() *query.QueryResult {
 	if x != nil {
-		return x.PrimaryStatus
+		return x.Result
 	}
 	return nil
 }
 
-type UndoDemotePrimaryRequest struct {
+type VReplicationWaitForPosRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Id       int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Position string `protobuf:"bytes,2,opt,name=position,proto3" json:"position,omitempty"`
 }
 
-func (x *UndoDemotePrimaryRequest) Reset() {
-	*x = UndoDemotePrimaryRequest{}
+func (x *VReplicationWaitForPosRequest) Reset() {
+	*x = VReplicationWaitForPosRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tabletmanagerdata_proto_msgTypes[78]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3822,13 +3938,13 @@ func (x *UndoDemotePrimaryRequest) Reset() {
 	}
 }
 
-func (x *UndoDemotePrimaryRequest) String() string {
+func (x *VReplicationWaitForPosRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UndoDemotePrimaryRequest) ProtoMessage() {}
+func (*VReplicationWaitForPosRequest) ProtoMessage() {}
 
-func (x *UndoDemotePrimaryRequest) ProtoReflect() protoreflect.Message {
+func (x *VReplicationWaitForPosRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_tabletmanagerdata_proto_msgTypes[78]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3840,19 +3956,33 @@ func (x *UndoDemotePrimaryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UndoDemotePrimaryRequest.ProtoReflect.Descriptor instead.
-func (*UndoDemotePrimaryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use VReplicationWaitForPosRequest.ProtoReflect.Descriptor instead.
+func (*VReplicationWaitForPosRequest) Descriptor() ([]byte, []int) {
 	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{78}
 }
 
-type UndoDemotePrimaryResponse struct {
+func (x *VReplicationWaitForPosRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *VReplicationWaitForPosRequest) GetPosition() string {
+	if x != nil {
+		return x.Position
+	}
+	return ""
+}
+
+type VReplicationWaitForPosResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *UndoDemotePrimaryResponse) Reset() {
-	*x = UndoDemotePrimaryResponse{}
+func (x *VReplicationWaitForPosResponse) Reset() {
+	*x = VReplicationWaitForPosResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tabletmanagerdata_proto_msgTypes[79]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3860,13 +3990,13 @@ func (x *UndoDemotePrimaryResponse) Reset() {
 	}
 }
 
-func (x *UndoDemotePrimaryResponse) String() string {
+func (x *VReplicationWaitForPosResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UndoDemotePrimaryResponse) ProtoMessage() {}
+func (*VReplicationWaitForPosResponse) ProtoMessage() {}
 
-func (x *UndoDemotePrimaryResponse) ProtoReflect() protoreflect.Message {
+func (x *VReplicationWaitForPosResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_tabletmanagerdata_proto_msgTypes[79]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3878,19 +4008,19 @@ func (x *UndoDemotePrimaryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UndoDemotePrimaryResponse.ProtoReflect.Descriptor instead.
-func (*UndoDemotePrimaryResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use VReplicationWaitForPosResponse.ProtoReflect.Descriptor instead.
+func (*VReplicationWaitForPosResponse) Descriptor() ([]byte, []int) {
 	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{79}
 }
 
-type ReplicaWasPromotedRequest struct {
+type InitPrimaryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *ReplicaWasPromotedRequest) Reset() {
-	*x = ReplicaWasPromotedRequest{}
+func (x *InitPrimaryRequest) Reset() {
+	*x = InitPrimaryRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tabletmanagerdata_proto_msgTypes[80]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3898,13 +4028,13 @@ func (x *ReplicaWasPromotedRequest) Reset() {
 	}
 }
 
-func (x *ReplicaWasPromotedRequest) String() string {
+func (x *InitPrimaryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReplicaWasPromotedRequest) ProtoMessage() {}
+func (*InitPrimaryRequest) ProtoMessage() {}
 
-func (x *ReplicaWasPromotedRequest) ProtoReflect() protoreflect.Message {
+func (x *InitPrimaryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_tabletmanagerdata_proto_msgTypes[80]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3916,19 +4046,21 @@ func (x *ReplicaWasPromotedRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReplicaWasPromotedRequest.ProtoReflect.Descriptor instead.
-func (*ReplicaWasPromotedRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use InitPrimaryRequest.ProtoReflect.Descriptor instead.
+func (*InitPrimaryRequest) Descriptor() ([]byte, []int) {
 	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{80}
 }
 
-type ReplicaWasPromotedResponse struct {
+type InitPrimaryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Position string `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
 }
 
-func (x *ReplicaWasPromotedResponse) Reset() {
-	*x = ReplicaWasPromotedResponse{}
+func (x *InitPrimaryResponse) Reset() {
+	*x = InitPrimaryResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tabletmanagerdata_proto_msgTypes[81]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3936,13 +4068,13 @@ func (x *ReplicaWasPromotedResponse) Reset() {
 	}
 }
 
-func (x *ReplicaWasPromotedResponse) String() string {
+func (x *InitPrimaryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReplicaWasPromotedResponse) ProtoMessage() {}
+func (*InitPrimaryResponse) ProtoMessage() {}
 
-func (x *ReplicaWasPromotedResponse) ProtoReflect() protoreflect.Message {
+func (x *InitPrimaryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_tabletmanagerdata_proto_msgTypes[81]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3954,24 +4086,31 @@ func (x *ReplicaWasPromotedResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReplicaWasPromotedResponse.ProtoReflect.Descriptor instead.
-func (*ReplicaWasPromotedResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use InitPrimaryResponse.ProtoReflect.Descriptor instead.
+func (*InitPrimaryResponse) Descriptor() ([]byte, []int) {
 	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{81}
 }
 
-type SetReplicationSourceRequest struct {
+func (x *InitPrimaryResponse) GetPosition() string {
+	if x != nil {
+		return x.Position
+	}
+	return ""
+}
+
+type PopulateReparentJournalRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Parent                *topodata.TabletAlias `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
-	TimeCreatedNs         int64                 `protobuf:"varint,2,opt,name=time_created_ns,json=timeCreatedNs,proto3" json:"time_created_ns,omitempty"`
-	ForceStartReplication bool                  `protobuf:"varint,3,opt,name=force_start_replication,json=forceStartReplication,proto3" json:"force_start_replication,omitempty"`
-	WaitPosition          string                `protobuf:"bytes,4,opt,name=wait_position,json=waitPosition,proto3" json:"wait_position,omitempty"`
+	TimeCreatedNs       int64                 `protobuf:"varint,1,opt,name=time_created_ns,json=timeCreatedNs,proto3" json:"time_created_ns,omitempty"`
+	ActionName          string                `protobuf:"bytes,2,opt,name=action_name,json=actionName,proto3" json:"action_name,omitempty"`
+	PrimaryAlias        *topodata.TabletAlias `protobuf:"bytes,3,opt,name=primary_alias,json=primaryAlias,proto3" json:"primary_alias,omitempty"`
+	ReplicationPosition string                `protobuf:"bytes,4,opt,name=replication_position,json=replicationPosition,proto3" json:"replication_position,omitempty"`
 }
 
-func (x *SetReplicationSourceRequest) Reset() {
-	*x = SetReplicationSourceRequest{}
+func (x *PopulateReparentJournalRequest) Reset() {
+	*x = PopulateReparentJournalRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_tabletmanagerdata_proto_msgTypes[82]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3979,13 +4118,13 @@ func (x *SetReplicationSourceRequest) Reset() {
 	}
 }
 
-func (x *SetReplicationSourceRequest) String() string {
+func (x *PopulateReparentJournalRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetReplicationSourceRequest) ProtoMessage() {}
+func (*PopulateReparentJournalRequest) ProtoMessage() {}
 
-func (x *SetReplicationSourceRequest) ProtoReflect() protoreflect.Message {
+func (x *PopulateReparentJournalRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_tabletmanagerdata_proto_msgTypes[82]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3997,62 +4136,1039 @@ func (x *SetReplicationSourceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetReplicationSourceRequest.ProtoReflect.Descriptor instead.
-func (*SetReplicationSourceRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PopulateReparentJournalRequest.ProtoReflect.Descriptor instead.
+func (*PopulateReparentJournalRequest) Descriptor() ([]byte, []int) {
 	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *SetReplicationSourceRequest) GetParent() *topodata.TabletAlias {
+func (x *PopulateReparentJournalRequest) GetTimeCreatedNs() int64 {
 	if x != nil {
-		return x.Parent
+		return x.TimeCreatedNs
 	}
-	return nil
+	return 0
 }
 
-func (x *SetReplicationSourceRequest) GetTimeCreatedNs() int64 {
+func (x *PopulateReparentJournalRequest) GetActionName() string {
 	if x != nil {
-		return x.TimeCreatedNs
+		return x.ActionName
 	}
-	return 0
+	return ""
 }
 
-func (x *SetReplicationSourceRequest) GetForceStartReplication() bool {
+func (x *PopulateReparentJournalRequest) GetPrimaryAlias() *topodata.TabletAlias {
 	if x != nil {
-		return x.ForceStartReplication
+		return x.PrimaryAlias
 	}
-	return false
+	return nil
+}
+
+func (x *PopulateReparentJournalRequest) GetReplicationPosition() string {
+	if x != nil {
+		return x.ReplicationPosition
+	}
+	return ""
+}
+
+type PopulateReparentJournalResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PopulateReparentJournalResponse) Reset() {
+	*x = PopulateReparentJournalResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tabletmanagerdata_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PopulateReparentJournalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PopulateReparentJournalResponse) ProtoMessage() {}
+
+func (x *PopulateReparentJournalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tabletmanagerdata_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PopulateReparentJournalResponse.ProtoReflect.Descriptor instead.
+func (*PopulateReparentJournalResponse) Descriptor() ([]byte, []int) {
+	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{83}
+}
+
+type InitReplicaRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Parent              *topodata.TabletAlias `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	ReplicationPosition string                `protobuf:"bytes,2,opt,name=replication_position,json=replicationPosition,proto3" json:"replication_position,omitempty"`
+	TimeCreatedNs       int64                 `protobuf:"varint,3,opt,name=time_created_ns,json=timeCreatedNs,proto3" json:"time_created_ns,omitempty"`
+}
+
+func (x *InitReplicaRequest) Reset() {
+	*x = InitReplicaRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tabletmanagerdata_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InitReplicaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InitReplicaRequest) ProtoMessage() {}
+
+func (x *InitReplicaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tabletmanagerdata_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InitReplicaRequest.ProtoReflect.Descriptor instead.
+func (*InitReplicaRequest) Descriptor() ([]byte, []int) {
+	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *InitReplicaRequest) GetParent() *topodata.TabletAlias {
+	if x != nil {
+		return x.Parent
+	}
+	return nil
+}
+
+func (x *InitReplicaRequest) GetReplicationPosition() string {
+	if x != nil {
+		return x.ReplicationPosition
+	}
+	return ""
+}
+
+func (x *InitReplicaRequest) GetTimeCreatedNs() int64 {
+	if x != nil {
+		return x.TimeCreatedNs
+	}
+	return 0
+}
+
+type InitReplicaResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *InitReplicaResponse) Reset() {
+	*x = InitReplicaResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tabletmanagerdata_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InitReplicaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InitReplicaResponse) ProtoMessage() {}
+
+func (x *InitReplicaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tabletmanagerdata_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InitReplicaResponse.ProtoReflect.Descriptor instead.
+func (*InitReplicaResponse) Descriptor() ([]byte, []int) {
+	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{85}
+}
+
+type DemotePrimaryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DemotePrimaryRequest) Reset() {
+	*x = DemotePrimaryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tabletmanagerdata_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DemotePrimaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DemotePrimaryRequest) ProtoMessage() {}
+
+func (x *DemotePrimaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tabletmanagerdata_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DemotePrimaryRequest.ProtoReflect.Descriptor instead.
+func (*DemotePrimaryRequest) Descriptor() ([]byte, []int) {
+	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{86}
+}
+
+type DemotePrimaryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Position is deprecated, and is a string representation of a demoted primaries executed position.
+	//
+	// Deprecated: Do not use.
+	DeprecatedPosition string `protobuf:"bytes,1,opt,name=deprecated_position,json=deprecatedPosition,proto3" json:"deprecated_position,omitempty"`
+	// PrimaryStatus represents the response from calling `SHOW MASTER STATUS` on a primary that has been demoted.
+	PrimaryStatus *replicationdata.PrimaryStatus `protobuf:"bytes,2,opt,name=primary_status,json=primaryStatus,proto3" json:"primary_status,omitempty"`
+}
+
+func (x *DemotePrimaryResponse) Reset() {
+	*x = DemotePrimaryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tabletmanagerdata_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DemotePrimaryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DemotePrimaryResponse) ProtoMessage() {}
+
+func (x *DemotePrimaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tabletmanagerdata_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DemotePrimaryResponse.ProtoReflect.Descriptor instead.
+func (*DemotePrimaryResponse) Descriptor() ([]byte, []int) {
+	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{87}
+}
+
+// Deprecated: Do not use.
+func (x *DemotePrimaryResponse) GetDeprecatedPosition() string {
+	if x != nil {
+		return x.DeprecatedPosition
+	}
+	return ""
+}
+
+func (x *DemotePrimaryResponse) GetPrimaryStatus() *replicationdata.PrimaryStatus {
+	if x != nil {
+		return x.PrimaryStatus
+	}
+	return nil
+}
+
+type UndoDemotePrimaryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UndoDemotePrimaryRequest) Reset() {
+	*x = UndoDemotePrimaryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tabletmanagerdata_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UndoDemotePrimaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndoDemotePrimaryRequest) ProtoMessage() {}
+
+func (x *UndoDemotePrimaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tabletmanagerdata_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndoDemotePrimaryRequest.ProtoReflect.Descriptor instead.
+func (*UndoDemotePrimaryRequest) Descriptor() ([]byte, []int) {
+	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{88}
+}
+
+type UndoDemotePrimaryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UndoDemotePrimaryResponse) Reset() {
+	*x = UndoDemotePrimaryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tabletmanagerdata_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UndoDemotePrimaryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndoDemotePrimaryResponse) ProtoMessage() {}
+
+func (x *UndoDemotePrimaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tabletmanagerdata_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndoDemotePrimaryResponse.ProtoReflect.Descriptor instead.
+func (*UndoDemotePrimaryResponse) Descriptor() ([]byte, []int) {
+	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{89}
+}
+
+type ReplicaWasPromotedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ReplicaWasPromotedRequest) Reset() {
+	*x = ReplicaWasPromotedRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tabletmanagerdata_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplicaWasPromotedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplicaWasPromotedRequest) ProtoMessage() {}
+
+func (x *ReplicaWasPromotedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tabletmanagerdata_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplicaWasPromotedRequest.ProtoReflect.Descriptor instead.
+func (*ReplicaWasPromotedRequest) Descriptor() ([]byte, []int) {
+	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{90}
+}
+
+type ReplicaWasPromotedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ReplicaWasPromotedResponse) Reset() {
+	*x = ReplicaWasPromotedResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tabletmanagerdata_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplicaWasPromotedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplicaWasPromotedResponse) ProtoMessage() {}
+
+func (x *ReplicaWasPromotedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tabletmanagerdata_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplicaWasPromotedResponse.ProtoReflect.Descriptor instead.
+func (*ReplicaWasPromotedResponse) Descriptor() ([]byte, []int) {
+	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{91}
+}
+
+type SetReplicationSourceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Parent                *topodata.TabletAlias `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	TimeCreatedNs         int64                 `protobuf:"varint,2,opt,name=time_created_ns,json=timeCreatedNs,proto3" json:"time_created_ns,omitempty"`
+	ForceStartReplication bool                  `protobuf:"varint,3,opt,name=force_start_replication,json=forceStartReplication,proto3" json:"force_start_replication,omitempty"`
+	WaitPosition          string                `protobuf:"bytes,4,opt,name=wait_position,json=waitPosition,proto3" json:"wait_position,omitempty"`
+}
+
+func (x *SetReplicationSourceRequest) Reset() {
+	*x = SetReplicationSourceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tabletmanagerdata_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetReplicationSourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetReplicationSourceRequest) ProtoMessage() {}
+
+func (x *SetReplicationSourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tabletmanagerdata_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetReplicationSourceRequest.ProtoReflect.Descriptor instead.
+func (*SetReplicationSourceRequest) Descriptor() ([]byte, []int) {
+	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *SetReplicationSourceRequest) GetParent() *topodata.TabletAlias {
+	if x != nil {
+		return x.Parent
+	}
+	return nil
+}
+
+func (x *SetReplicationSourceRequest) GetTimeCreatedNs() int64 {
+	if x != nil {
+		return x.TimeCreatedNs
+	}
+	return 0
+}
+
+func (x *SetReplicationSourceRequest) GetForceStartReplication() bool {
+	if x != nil {
+		return x.ForceStartReplication
+	}
+	return false
+}
+
+func (x *SetReplicationSourceRequest) GetWaitPosition() string {
+	if x != nil {
+		return x.WaitPosition
+	}
+	return ""
+}
+
+type SetReplicationSourceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetReplicationSourceResponse) Reset() {
+	*x = SetReplicationSourceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tabletmanagerdata_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetReplicationSourceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetReplicationSourceResponse) ProtoMessage() {}
+
+func (x *SetReplicationSourceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tabletmanagerdata_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetReplicationSourceResponse.ProtoReflect.Descriptor instead.
+func (*SetReplicationSourceResponse) Descriptor() ([]byte, []int) {
+	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{93}
+}
+
+type SetReplicationDelayRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// delay_seconds is the delay with which the replica applies the events of
+	// its source. 0 makes it a regular replica again.
+	DelaySeconds int32 `protobuf:"varint,1,opt,name=delay_seconds,json=delaySeconds,proto3" json:"delay_seconds,omitempty"`
+}
+
+func (x *SetReplicationDelayRequest) Reset() {
+	*x = SetReplicationDelayRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tabletmanagerdata_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetReplicationDelayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetReplicationDelayRequest) ProtoMessage() {}
+
+func (x *SetReplicationDelayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tabletmanagerdata_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetReplicationDelayRequest.ProtoReflect.Descriptor instead.
+func (*SetReplicationDelayRequest) Descriptor() ([]byte, []int) {
+	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *SetReplicationDelayRequest) GetDelaySeconds() int32 {
+	if x != nil {
+		return x.DelaySeconds
+	}
+	return 0
+}
+
+type SetReplicationDelayResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetReplicationDelayResponse) Reset() {
+	*x = SetReplicationDelayResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tabletmanagerdata_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetReplicationDelayResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetReplicationDelayResponse) ProtoMessage() {}
+
+func (x *SetReplicationDelayResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tabletmanagerdata_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetReplicationDelayResponse.ProtoReflect.Descriptor instead.
+func (*SetReplicationDelayResponse) Descriptor() ([]byte, []int) {
+	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{95}
+}
+
+type ReplicaWasRestartedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the parent alias the tablet should have
+	Parent *topodata.TabletAlias `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+}
+
+func (x *ReplicaWasRestartedRequest) Reset() {
+	*x = ReplicaWasRestartedRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tabletmanagerdata_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplicaWasRestartedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplicaWasRestartedRequest) ProtoMessage() {}
+
+func (x *ReplicaWasRestartedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tabletmanagerdata_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplicaWasRestartedRequest.ProtoReflect.Descriptor instead.
+func (*ReplicaWasRestartedRequest) Descriptor() ([]byte, []int) {
+	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *ReplicaWasRestartedRequest) GetParent() *topodata.TabletAlias {
+	if x != nil {
+		return x.Parent
+	}
+	return nil
+}
+
+type ReplicaWasRestartedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ReplicaWasRestartedResponse) Reset() {
+	*x = ReplicaWasRestartedResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tabletmanagerdata_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplicaWasRestartedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplicaWasRestartedResponse) ProtoMessage() {}
+
+func (x *ReplicaWasRestartedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tabletmanagerdata_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplicaWasRestartedResponse.ProtoReflect.Descriptor instead.
+func (*ReplicaWasRestartedResponse) Descriptor() ([]byte, []int) {
+	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{97}
+}
+
+type StopReplicationAndGetStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StopReplicationMode replicationdata.StopReplicationMode `protobuf:"varint,1,opt,name=stop_replication_mode,json=stopReplicationMode,proto3,enum=replicationdata.StopReplicationMode" json:"stop_replication_mode,omitempty"`
+}
+
+func (x *StopReplicationAndGetStatusRequest) Reset() {
+	*x = StopReplicationAndGetStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tabletmanagerdata_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StopReplicationAndGetStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopReplicationAndGetStatusRequest) ProtoMessage() {}
+
+func (x *StopReplicationAndGetStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tabletmanagerdata_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopReplicationAndGetStatusRequest.ProtoReflect.Descriptor instead.
+func (*StopReplicationAndGetStatusRequest) Descriptor() ([]byte, []int) {
+	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *StopReplicationAndGetStatusRequest) GetStopReplicationMode() replicationdata.StopReplicationMode {
+	if x != nil {
+		return x.StopReplicationMode
+	}
+	return replicationdata.StopReplicationMode(0)
+}
+
+type StopReplicationAndGetStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// HybridStatus is deprecated. It currently represents a hybrid struct where all data represents the before state,
+	// except for all position related data which comes from the after state. Please use status instead, which holds
+	// discrete replication status calls before and after stopping the replica, or stopping the replica's io_thread.
+	//
+	// Deprecated: Do not use.
+	HybridStatus *replicationdata.Status `protobuf:"bytes,1,opt,name=hybrid_status,json=hybridStatus,proto3" json:"hybrid_status,omitempty"`
+	// Status represents the replication status call right before, and right after telling the replica to stop.
+	Status *replicationdata.StopReplicationStatus `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *StopReplicationAndGetStatusResponse) Reset() {
+	*x = StopReplicationAndGetStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tabletmanagerdata_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StopReplicationAndGetStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopReplicationAndGetStatusResponse) ProtoMessage() {}
+
+func (x *StopReplicationAndGetStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tabletmanagerdata_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopReplicationAndGetStatusResponse.ProtoReflect.Descriptor instead.
+func (*StopReplicationAndGetStatusResponse) Descriptor() ([]byte, []int) {
+	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{99}
+}
+
+// Deprecated: Do not use.
+func (x *StopReplicationAndGetStatusResponse) GetHybridStatus() *replicationdata.Status {
+	if x != nil {
+		return x.HybridStatus
+	}
+	return nil
+}
+
+func (x *StopReplicationAndGetStatusResponse) GetStatus() *replicationdata.StopReplicationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type PromoteReplicaRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PromoteReplicaRequest) Reset() {
+	*x = PromoteReplicaRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tabletmanagerdata_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PromoteReplicaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromoteReplicaRequest) ProtoMessage() {}
+
+func (x *PromoteReplicaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tabletmanagerdata_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromoteReplicaRequest.ProtoReflect.Descriptor instead.
+func (*PromoteReplicaRequest) Descriptor() ([]byte, []int) {
+	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{100}
+}
+
+type PromoteReplicaResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Position string `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
+}
+
+func (x *PromoteReplicaResponse) Reset() {
+	*x = PromoteReplicaResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tabletmanagerdata_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PromoteReplicaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromoteReplicaResponse) ProtoMessage() {}
+
+func (x *PromoteReplicaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tabletmanagerdata_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromoteReplicaResponse.ProtoReflect.Descriptor instead.
+func (*PromoteReplicaResponse) Descriptor() ([]byte, []int) {
+	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *PromoteReplicaResponse) GetPosition() string {
+	if x != nil {
+		return x.Position
+	}
+	return ""
+}
+
+type BackupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Concurrency  int64 `protobuf:"varint,1,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
+	AllowPrimary bool  `protobuf:"varint,2,opt,name=allow_primary,json=allowPrimary,proto3" json:"allow_primary,omitempty"`
+	// compression_engine is the engine used to compress the backup with the
+	// builtin backup engine, instead of the one of -compression_engine_name.
+	CompressionEngine string `protobuf:"bytes,3,opt,name=compression_engine,json=compressionEngine,proto3" json:"compression_engine,omitempty"`
+}
+
+func (x *BackupRequest) Reset() {
+	*x = BackupRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tabletmanagerdata_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BackupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackupRequest) ProtoMessage() {}
+
+func (x *BackupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tabletmanagerdata_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackupRequest.ProtoReflect.Descriptor instead.
+func (*BackupRequest) Descriptor() ([]byte, []int) {
+	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *BackupRequest) GetConcurrency() int64 {
+	if x != nil {
+		return x.Concurrency
+	}
+	return 0
+}
+
+func (x *BackupRequest) GetAllowPrimary() bool {
+	if x != nil {
+		return x.AllowPrimary
+	}
+	return false
+}
+
+func (x *BackupRequest) GetCompressionEngine() string {
+	if x != nil {
+		return x.CompressionEngine
+	}
+	return ""
+}
+
+type BackupResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Event *logutil.Event `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+}
+
+func (x *BackupResponse) Reset() {
+	*x = BackupResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tabletmanagerdata_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BackupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackupResponse) ProtoMessage() {}
+
+func (x *BackupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tabletmanagerdata_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *SetReplicationSourceRequest) GetWaitPosition() string {
+// Deprecated: Use BackupResponse.ProtoReflect.Descriptor instead.
+func (*BackupResponse) Descriptor() ([]byte, []int) {
+	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *BackupResponse) GetEvent() *logutil.Event {
 	if x != nil {
-		return x.WaitPosition
+		return x.Event
 	}
-	return ""
+	return nil
 }
 
-type SetReplicationSourceResponse struct {
+type RestoreFromBackupRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *SetReplicationSourceResponse) Reset() {
-	*x = SetReplicationSourceResponse{}
+func (x *RestoreFromBackupRequest) Reset() {
+	*x = RestoreFromBackupRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tabletmanagerdata_proto_msgTypes[83]
+		mi := &file_tabletmanagerdata_proto_msgTypes[104]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SetReplicationSourceResponse) String() string {
+func (x *RestoreFromBackupRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetReplicationSourceResponse) ProtoMessage() {}
+func (*RestoreFromBackupRequest) ProtoMessage() {}
 
-func (x *SetReplicationSourceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tabletmanagerdata_proto_msgTypes[83]
+func (x *RestoreFromBackupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tabletmanagerdata_proto_msgTypes[104]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4063,37 +5179,36 @@ func (x *SetReplicationSourceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetReplicationSourceResponse.ProtoReflect.Descriptor instead.
-func (*SetReplicationSourceResponse) Descriptor() ([]byte, []int) {
-	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{83}
+// Deprecated: Use RestoreFromBackupRequest.ProtoReflect.Descriptor instead.
+func (*RestoreFromBackupRequest) Descriptor() ([]byte, []int) {
+	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{104}
 }
 
-type ReplicaWasRestartedRequest struct {
+type RestoreFromBackupResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// the parent alias the tablet should have
-	Parent *topodata.TabletAlias `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	Event *logutil.Event `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
 }
 
-func (x *ReplicaWasRestartedRequest) Reset() {
-	*x = ReplicaWasRestartedRequest{}
+func (x *RestoreFromBackupResponse) Reset() {
+	*x = RestoreFromBackupResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tabletmanagerdata_proto_msgTypes[84]
+		mi := &file_tabletmanagerdata_proto_msgTypes[105]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReplicaWasRestartedRequest) String() string {
+func (x *RestoreFromBackupResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReplicaWasRestartedRequest) ProtoMessage() {}
+func (*RestoreFromBackupResponse) ProtoMessage() {}
 
-func (x *ReplicaWasRestartedRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tabletmanagerdata_proto_msgTypes[84]
+func (x *RestoreFromBackupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tabletmanagerdata_proto_msgTypes[105]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4104,41 +5219,56 @@ func (x *ReplicaWasRestartedRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReplicaWasRestartedRequest.ProtoReflect.Descriptor instead.
-func (*ReplicaWasRestartedRequest) Descriptor() ([]byte, []int) {
-	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{84}
+// Deprecated: Use RestoreFromBackupResponse.ProtoReflect.Descriptor instead.
+func (*RestoreFromBackupResponse) Descriptor() ([]byte, []int) {
+	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{105}
 }
 
-func (x *ReplicaWasRestartedRequest) GetParent() *topodata.TabletAlias {
+func (x *RestoreFromBackupResponse) GetEvent() *logutil.Event {
 	if x != nil {
-		return x.Parent
+		return x.Event
 	}
 	return nil
 }
 
-type ReplicaWasRestartedResponse struct {
+// RestoreProgress is the progress of the restore from backup of a tablet.
+type RestoreProgress struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-}
 
-func (x *ReplicaWasRestartedResponse) Reset() {
-	*x = ReplicaWasRestartedResponse{}
+	// in_progress is whether the tablet is restoring from a backup. If not,
+	// the file and byte counts are the ones of its last restore, if any.
+	InProgress    bool  `protobuf:"varint,1,opt,name=in_progress,json=inProgress,proto3" json:"in_progress,omitempty"`
+	FilesTotal    int64 `protobuf:"varint,2,opt,name=files_total,json=filesTotal,proto3" json:"files_total,omitempty"`
+	FilesRestored int64 `protobuf:"varint,3,opt,name=files_restored,json=filesRestored,proto3" json:"files_restored,omitempty"`
+	// bytes_total is the number of bytes to read from the backup storage, or
+	// 0 if the backup engine does not know it.
+	BytesTotal    int64            `protobuf:"varint,4,opt,name=bytes_total,json=bytesTotal,proto3" json:"bytes_total,omitempty"`
+	BytesRestored int64            `protobuf:"varint,5,opt,name=bytes_restored,json=bytesRestored,proto3" json:"bytes_restored,omitempty"`
+	Elapsed       *vttime.Duration `protobuf:"bytes,6,opt,name=elapsed,proto3" json:"elapsed,omitempty"`
+	// eta is the estimated time left, based on the throughput of the restore
+	// so far, or unset if it cannot be estimated.
+	Eta *vttime.Duration `protobuf:"bytes,7,opt,name=eta,proto3" json:"eta,omitempty"`
+}
+
+func (x *RestoreProgress) Reset() {
+	*x = RestoreProgress{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tabletmanagerdata_proto_msgTypes[85]
+		mi := &file_tabletmanagerdata_proto_msgTypes[106]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReplicaWasRestartedResponse) String() string {
+func (x *RestoreProgress) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReplicaWasRestartedResponse) ProtoMessage() {}
+func (*RestoreProgress) ProtoMessage() {}
 
-func (x *ReplicaWasRestartedResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tabletmanagerdata_proto_msgTypes[85]
+func (x *RestoreProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_tabletmanagerdata_proto_msgTypes[106]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4149,36 +5279,83 @@ func (x *ReplicaWasRestartedResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReplicaWasRestartedResponse.ProtoReflect.Descriptor instead.
-func (*ReplicaWasRestartedResponse) Descriptor() ([]byte, []int) {
-	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{85}
+// Deprecated: Use RestoreProgress.ProtoReflect.Descriptor instead.
+func (*RestoreProgress) Descriptor() ([]byte, []int) {
+	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{106}
 }
 
-type StopReplicationAndGetStatusRequest struct {
+func (x *RestoreProgress) GetInProgress() bool {
+	if x != nil {
+		return x.InProgress
+	}
+	return false
+}
+
+func (x *RestoreProgress) GetFilesTotal() int64 {
+	if x != nil {
+		return x.FilesTotal
+	}
+	return 0
+}
+
+func (x *RestoreProgress) GetFilesRestored() int64 {
+	if x != nil {
+		return x.FilesRestored
+	}
+	return 0
+}
+
+func (x *RestoreProgress) GetBytesTotal() int64 {
+	if x != nil {
+		return x.BytesTotal
+	}
+	return 0
+}
+
+func (x *RestoreProgress) GetBytesRestored() int64 {
+	if x != nil {
+		return x.BytesRestored
+	}
+	return 0
+}
+
+func (x *RestoreProgress) GetElapsed() *vttime.Duration {
+	if x != nil {
+		return x.Elapsed
+	}
+	return nil
+}
+
+func (x *RestoreProgress) GetEta() *vttime.Duration {
+	if x != nil {
+		return x.Eta
+	}
+	return nil
+}
+
+type GetRestoreProgressRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	StopReplicationMode replicationdata.StopReplicationMode `protobuf:"varint,1,opt,name=stop_replication_mode,json=stopReplicationMode,proto3,enum=replicationdata.StopReplicationMode" json:"stop_replication_mode,omitempty"`
 }
 
-func (x *StopReplicationAndGetStatusRequest) Reset() {
-	*x = StopReplicationAndGetStatusRequest{}
+func (x *GetRestoreProgressRequest) Reset() {
+	*x = GetRestoreProgressRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tabletmanagerdata_proto_msgTypes[86]
+		mi := &file_tabletmanagerdata_proto_msgTypes[107]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StopReplicationAndGetStatusRequest) String() string {
+func (x *GetRestoreProgressRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StopReplicationAndGetStatusRequest) ProtoMessage() {}
+func (*GetRestoreProgressRequest) ProtoMessage() {}
 
-func (x *StopReplicationAndGetStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tabletmanagerdata_proto_msgTypes[86]
+func (x *GetRestoreProgressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tabletmanagerdata_proto_msgTypes[107]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4189,50 +5366,36 @@ func (x *StopReplicationAndGetStatusRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StopReplicationAndGetStatusRequest.ProtoReflect.Descriptor instead.
-func (*StopReplicationAndGetStatusRequest) Descriptor() ([]byte, []int) {
-	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{86}
-}
-
-func (x *StopReplicationAndGetStatusRequest) GetStopReplicationMode() replicationdata.StopReplicationMode {
-	if x != nil {
-		return x.StopReplicationMode
-	}
-	return replicationdata.StopReplicationMode(0)
+// Deprecated: Use GetRestoreProgressRequest.ProtoReflect.Descriptor instead.
+func (*GetRestoreProgressRequest) Descriptor() ([]byte, []int) {
+	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{107}
 }
 
-type StopReplicationAndGetStatusResponse struct {
+type GetRestoreProgressResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// HybridStatus is deprecated. It currently represents a hybrid struct where all data represents the before state,
-	// except for all position related data which comes from the after state. Please use status instead, which holds
-	// discrete replication status calls before and after stopping the replica, or stopping the replica's io_thread.
-	//
-	// Deprecated: Do not use.
-	HybridStatus *replicationdata.Status `protobuf:"bytes,1,opt,name=hybrid_status,json=hybridStatus,proto3" json:"hybrid_status,omitempty"`
-	// Status represents the replication status call right before, and right after telling the replica to stop.
-	Status *replicationdata.StopReplicationStatus `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Progress *RestoreProgress `protobuf:"bytes,1,opt,name=progress,proto3" json:"progress,omitempty"`
 }
 
-func (x *StopReplicationAndGetStatusResponse) Reset() {
-	*x = StopReplicationAndGetStatusResponse{}
+func (x *GetRestoreProgressResponse) Reset() {
+	*x = GetRestoreProgressResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tabletmanagerdata_proto_msgTypes[87]
+		mi := &file_tabletmanagerdata_proto_msgTypes[108]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StopReplicationAndGetStatusResponse) String() string {
+func (x *GetRestoreProgressResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StopReplicationAndGetStatusResponse) ProtoMessage() {}
+func (*GetRestoreProgressResponse) ProtoMessage() {}
 
-func (x *StopReplicationAndGetStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tabletmanagerdata_proto_msgTypes[87]
+func (x *GetRestoreProgressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tabletmanagerdata_proto_msgTypes[108]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4243,49 +5406,44 @@ func (x *StopReplicationAndGetStatusResponse) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StopReplicationAndGetStatusResponse.ProtoReflect.Descriptor instead.
-func (*StopReplicationAndGetStatusResponse) Descriptor() ([]byte, []int) {
-	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{87}
-}
-
-// Deprecated: Do not use.
-func (x *StopReplicationAndGetStatusResponse) GetHybridStatus() *replicationdata.Status {
-	if x != nil {
-		return x.HybridStatus
-	}
-	return nil
+// Deprecated: Use GetRestoreProgressResponse.ProtoReflect.Descriptor instead.
+func (*GetRestoreProgressResponse) Descriptor() ([]byte, []int) {
+	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{108}
 }
 
-func (x *StopReplicationAndGetStatusResponse) GetStatus() *replicationdata.StopReplicationStatus {
+func (x *GetRestoreProgressResponse) GetProgress() *RestoreProgress {
 	if x != nil {
-		return x.Status
+		return x.Progress
 	}
 	return nil
 }
 
-type PromoteReplicaRequest struct {
+type StreamArchivedBinlogsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// position is the GTID position to serve the archived binlogs from.
+	Position string `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
 }
 
-func (x *PromoteReplicaRequest) Reset() {
-	*x = PromoteReplicaRequest{}
+func (x *StreamArchivedBinlogsRequest) Reset() {
+	*x = StreamArchivedBinlogsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tabletmanagerdata_proto_msgTypes[88]
+		mi := &file_tabletmanagerdata_proto_msgTypes[109]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PromoteReplicaRequest) String() string {
+func (x *StreamArchivedBinlogsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PromoteReplicaRequest) ProtoMessage() {}
+func (*StreamArchivedBinlogsRequest) ProtoMessage() {}
 
-func (x *PromoteReplicaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tabletmanagerdata_proto_msgTypes[88]
+func (x *StreamArchivedBinlogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tabletmanagerdata_proto_msgTypes[109]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4296,36 +5454,50 @@ func (x *PromoteReplicaRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PromoteReplicaRequest.ProtoReflect.Descriptor instead.
-func (*PromoteReplicaRequest) Descriptor() ([]byte, []int) {
-	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{88}
+// Deprecated: Use StreamArchivedBinlogsRequest.ProtoReflect.Descriptor instead.
+func (*StreamArchivedBinlogsRequest) Descriptor() ([]byte, []int) {
+	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{109}
 }
 
-type PromoteReplicaResponse struct {
+func (x *StreamArchivedBinlogsRequest) GetPosition() string {
+	if x != nil {
+		return x.Position
+	}
+	return ""
+}
+
+type StreamArchivedBinlogsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Position string `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
+	// segment is the name of the archived binlog segment of the events.
+	Segment string `protobuf:"bytes,1,opt,name=segment,proto3" json:"segment,omitempty"`
+	// events are raw binlog events, as they are stored in the binlog files.
+	// The first events of a segment start with its FORMAT_DESCRIPTION_EVENT.
+	Events [][]byte `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+	// position is set on the last response of a segment, to the position
+	// the segment ends at.
+	Position string `protobuf:"bytes,3,opt,name=position,proto3" json:"position,omitempty"`
 }
 
-func (x *PromoteReplicaResponse) Reset() {
-	*x = PromoteReplicaResponse{}
+func (x *StreamArchivedBinlogsResponse) Reset() {
+	*x = StreamArchivedBinlogsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tabletmanagerdata_proto_msgTypes[89]
+		mi := &file_tabletmanagerdata_proto_msgTypes[110]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PromoteReplicaResponse) String() string {
+func (x *StreamArchivedBinlogsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PromoteReplicaResponse) ProtoMessage() {}
+func (*StreamArchivedBinlogsResponse) ProtoMessage() {}
 
-func (x *PromoteReplicaResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tabletmanagerdata_proto_msgTypes[89]
+func (x *StreamArchivedBinlogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tabletmanagerdata_proto_msgTypes[110]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4336,44 +5508,59 @@ func (x *PromoteReplicaResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PromoteReplicaResponse.ProtoReflect.Descriptor instead.
-func (*PromoteReplicaResponse) Descriptor() ([]byte, []int) {
-	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{89}
+// Deprecated: Use StreamArchivedBinlogsResponse.ProtoReflect.Descriptor instead.
+func (*StreamArchivedBinlogsResponse) Descriptor() ([]byte, []int) {
+	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{110}
 }
 
-func (x *PromoteReplicaResponse) GetPosition() string {
+func (x *StreamArchivedBinlogsResponse) GetSegment() string {
+	if x != nil {
+		return x.Segment
+	}
+	return ""
+}
+
+func (x *StreamArchivedBinlogsResponse) GetEvents() [][]byte {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *StreamArchivedBinlogsResponse) GetPosition() string {
 	if x != nil {
 		return x.Position
 	}
 	return ""
 }
 
-type BackupRequest struct {
+type VExecRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Concurrency  int64 `protobuf:"varint,1,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
-	AllowPrimary bool  `protobuf:"varint,2,opt,name=allow_primary,json=allowPrimary,proto3" json:"allow_primary,omitempty"`
+	Query    string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Workflow string `protobuf:"bytes,2,opt,name=workflow,proto3" json:"workflow,omitempty"`
+	Keyspace string `protobuf:"bytes,3,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
 }
 
-func (x *BackupRequest) Reset() {
-	*x = BackupRequest{}
+func (x *VExecRequest) Reset() {
+	*x = VExecRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tabletmanagerdata_proto_msgTypes[90]
+		mi := &file_tabletmanagerdata_proto_msgTypes[111]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BackupRequest) String() string {
+func (x *VExecRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BackupRequest) ProtoMessage() {}
+func (*VExecRequest) ProtoMessage() {}
 
-func (x *BackupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tabletmanagerdata_proto_msgTypes[90]
+func (x *VExecRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tabletmanagerdata_proto_msgTypes[111]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4384,50 +5571,57 @@ func (x *BackupRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BackupRequest.ProtoReflect.Descriptor instead.
-func (*BackupRequest) Descriptor() ([]byte, []int) {
-	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{90}
+// Deprecated: Use VExecRequest.ProtoReflect.Descriptor instead.
+func (*VExecRequest) Descriptor() ([]byte, []int) {
+	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{111}
 }
 
-func (x *BackupRequest) GetConcurrency() int64 {
+func (x *VExecRequest) GetQuery() string {
 	if x != nil {
-		return x.Concurrency
+		return x.Query
 	}
-	return 0
+	return ""
 }
 
-func (x *BackupRequest) GetAllowPrimary() bool {
+func (x *VExecRequest) GetWorkflow() string {
 	if x != nil {
-		return x.AllowPrimary
+		return x.Workflow
 	}
-	return false
+	return ""
 }
 
-type BackupResponse struct {
+func (x *VExecRequest) GetKeyspace() string {
+	if x != nil {
+		return x.Keyspace
+	}
+	return ""
+}
+
+type VExecResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Event *logutil.Event `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	Result *query.QueryResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
 }
 
-func (x *BackupResponse) Reset() {
-	*x = BackupResponse{}
+func (x *VExecResponse) Reset() {
+	*x = VExecResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tabletmanagerdata_proto_msgTypes[91]
+		mi := &file_tabletmanagerdata_proto_msgTypes[112]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BackupResponse) String() string {
+func (x *VExecResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BackupResponse) ProtoMessage() {}
+func (*VExecResponse) ProtoMessage() {}
 
-func (x *BackupResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tabletmanagerdata_proto_msgTypes[91]
+func (x *VExecResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tabletmanagerdata_proto_msgTypes[112]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4437,42 +5631,44 @@ func (x *BackupResponse) ProtoReflect() protoreflect.Message {
 	}
 	return mi.MessageOf(x)
 }
-
-// Deprecated: Use BackupResponse.ProtoReflect.Descriptor instead.
-func (*BackupResponse) Descriptor() ([]byte, []int) {
-	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{91}
+
+// Deprecated: Use VExecResponse.ProtoReflect.Descriptor instead.
+func (*VExecResponse) Descriptor() ([]byte, []int) {
+	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{112}
 }
 
-func (x *BackupResponse) GetEvent() *logutil.Event {
+func (x *VExecResponse) GetResult() *query.QueryResult {
 	if x != nil {
-		return x.Event
+		return x.Result
 	}
 	return nil
 }
 
-type RestoreFromBackupRequest struct {
+type CheckThrottlerRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Checks []*CheckThrottlerRequest_Check `protobuf:"bytes,1,rep,name=checks,proto3" json:"checks,omitempty"`
 }
 
-func (x *RestoreFromBackupRequest) Reset() {
-	*x = RestoreFromBackupRequest{}
+func (x *CheckThrottlerRequest) Reset() {
+	*x = CheckThrottlerRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tabletmanagerdata_proto_msgTypes[92]
+		mi := &file_tabletmanagerdata_proto_msgTypes[113]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RestoreFromBackupRequest) String() string {
+func (x *CheckThrottlerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RestoreFromBackupRequest) ProtoMessage() {}
+func (*CheckThrottlerRequest) ProtoMessage() {}
 
-func (x *RestoreFromBackupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tabletmanagerdata_proto_msgTypes[92]
+func (x *CheckThrottlerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tabletmanagerdata_proto_msgTypes[113]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4483,36 +5679,44 @@ func (x *RestoreFromBackupRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RestoreFromBackupRequest.ProtoReflect.Descriptor instead.
-func (*RestoreFromBackupRequest) Descriptor() ([]byte, []int) {
-	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{92}
+// Deprecated: Use CheckThrottlerRequest.ProtoReflect.Descriptor instead.
+func (*CheckThrottlerRequest) Descriptor() ([]byte, []int) {
+	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{113}
 }
 
-type RestoreFromBackupResponse struct {
+func (x *CheckThrottlerRequest) GetChecks() []*CheckThrottlerRequest_Check {
+	if x != nil {
+		return x.Checks
+	}
+	return nil
+}
+
+type CheckThrottlerResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Event *logutil.Event `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	// results are in the order of the checks of the request.
+	Results []*CheckThrottlerResponse_Result `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
 }
 
-func (x *RestoreFromBackupResponse) Reset() {
-	*x = RestoreFromBackupResponse{}
+func (x *CheckThrottlerResponse) Reset() {
+	*x = CheckThrottlerResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tabletmanagerdata_proto_msgTypes[93]
+		mi := &file_tabletmanagerdata_proto_msgTypes[114]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RestoreFromBackupResponse) String() string {
+func (x *CheckThrottlerResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RestoreFromBackupResponse) ProtoMessage() {}
+func (*CheckThrottlerResponse) ProtoMessage() {}
 
-func (x *RestoreFromBackupResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tabletmanagerdata_proto_msgTypes[93]
+func (x *CheckThrottlerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tabletmanagerdata_proto_msgTypes[114]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4523,45 +5727,53 @@ func (x *RestoreFromBackupResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RestoreFromBackupResponse.ProtoReflect.Descriptor instead.
-func (*RestoreFromBackupResponse) Descriptor() ([]byte, []int) {
-	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{93}
+// Deprecated: Use CheckThrottlerResponse.ProtoReflect.Descriptor instead.
+func (*CheckThrottlerResponse) Descriptor() ([]byte, []int) {
+	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{114}
 }
 
-func (x *RestoreFromBackupResponse) GetEvent() *logutil.Event {
+func (x *CheckThrottlerResponse) GetResults() []*CheckThrottlerResponse_Result {
 	if x != nil {
-		return x.Event
+		return x.Results
 	}
 	return nil
 }
 
-type VExecRequest struct {
+type CheckThrottlerRequest_Check struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Query    string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
-	Workflow string `protobuf:"bytes,2,opt,name=workflow,proto3" json:"workflow,omitempty"`
-	Keyspace string `protobuf:"bytes,3,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
-}
-
-func (x *VExecRequest) Reset() {
-	*x = VExecRequest{}
+	AppName string `protobuf:"bytes,1,opt,name=app_name,json=appName,proto3" json:"app_name,omitempty"`
+	// self checks the metrics of this tablet only, like
+	// /throttler/check-self, rather than the ones of the shard, like
+	// /throttler/check.
+	Self        bool `protobuf:"varint,2,opt,name=self,proto3" json:"self,omitempty"`
+	LowPriority bool `protobuf:"varint,3,opt,name=low_priority,json=lowPriority,proto3" json:"low_priority,omitempty"`
+	// override_threshold replaces the threshold of the metrics if set.
+	OverrideThreshold float64 `protobuf:"fixed64,4,opt,name=override_threshold,json=overrideThreshold,proto3" json:"override_threshold,omitempty"`
+	// ok_if_not_exists returns OK rather than Not Found for the metrics
+	// which are not collected yet.
+	OkIfNotExists bool `protobuf:"varint,5,opt,name=ok_if_not_exists,json=okIfNotExists,proto3" json:"ok_if_not_exists,omitempty"`
+}
+
+func (x *CheckThrottlerRequest_Check) Reset() {
+	*x = CheckThrottlerRequest_Check{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tabletmanagerdata_proto_msgTypes[94]
+		mi := &file_tabletmanagerdata_proto_msgTypes[120]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *VExecRequest) String() string {
+func (x *CheckThrottlerRequest_Check) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VExecRequest) ProtoMessage() {}
+func (*CheckThrottlerRequest_Check) ProtoMessage() {}
 
-func (x *VExecRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tabletmanagerdata_proto_msgTypes[94]
+func (x *CheckThrottlerRequest_Check) ProtoReflect() protoreflect.Message {
+	mi := &file_tabletmanagerdata_proto_msgTypes[120]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4572,57 +5784,79 @@ func (x *VExecRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VExecRequest.ProtoReflect.Descriptor instead.
-func (*VExecRequest) Descriptor() ([]byte, []int) {
-	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{94}
+// Deprecated: Use CheckThrottlerRequest_Check.ProtoReflect.Descriptor instead.
+func (*CheckThrottlerRequest_Check) Descriptor() ([]byte, []int) {
+	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{113, 0}
 }
 
-func (x *VExecRequest) GetQuery() string {
+func (x *CheckThrottlerRequest_Check) GetAppName() string {
 	if x != nil {
-		return x.Query
+		return x.AppName
 	}
 	return ""
 }
 
-func (x *VExecRequest) GetWorkflow() string {
+func (x *CheckThrottlerRequest_Check) GetSelf() bool {
 	if x != nil {
-		return x.Workflow
+		return x.Self
 	}
-	return ""
+	return false
 }
 
-func (x *VExecRequest) GetKeyspace() string {
+func (x *CheckThrottlerRequest_Check) GetLowPriority() bool {
 	if x != nil {
-		return x.Keyspace
+		return x.LowPriority
 	}
-	return ""
+	return false
 }
 
-type VExecResponse struct {
+func (x *CheckThrottlerRequest_Check) GetOverrideThreshold() float64 {
+	if x != nil {
+		return x.OverrideThreshold
+	}
+	return 0
+}
+
+func (x *CheckThrottlerRequest_Check) GetOkIfNotExists() bool {
+	if x != nil {
+		return x.OkIfNotExists
+	}
+	return false
+}
+
+type CheckThrottlerResponse_Result struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Result *query.QueryResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	// status_code is the HTTP status code of the check: 200 if the app may
+	// proceed, 429 if it is throttled, 417 if it is denied...
+	StatusCode int32   `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	Value      float64 `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
+	Threshold  float64 `protobuf:"fixed64,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Error      string  `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	Message    string  `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	// metric_name is the metric which throttled the app, if any.
+	MetricName string `protobuf:"bytes,6,opt,name=metric_name,json=metricName,proto3" json:"metric_name,omitempty"`
 }
 
-func (x *VExecResponse) Reset() {
-	*x = VExecResponse{}
+func (x *CheckThrottlerResponse_Result) Reset() {
+	*x = CheckThrottlerResponse_Result{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tabletmanagerdata_proto_msgTypes[95]
+		mi := &file_tabletmanagerdata_proto_msgTypes[121]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *VExecResponse) String() string {
+func (x *CheckThrottlerResponse_Result) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VExecResponse) ProtoMessage() {}
+func (*CheckThrottlerResponse_Result) ProtoMessage() {}
 
-func (x *VExecResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tabletmanagerdata_proto_msgTypes[95]
+func (x *CheckThrottlerResponse_Result) ProtoReflect() protoreflect.Message {
+	mi := &file_tabletmanagerdata_proto_msgTypes[121]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4633,16 +5867,51 @@ func (x *VExecResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VExecResponse.ProtoReflect.Descriptor instead.
-func (*VExecResponse) Descriptor() ([]byte, []int) {
-	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{95}
+// Deprecated: Use CheckThrottlerResponse_Result.ProtoReflect.Descriptor instead.
+func (*CheckThrottlerResponse_Result) Descriptor() ([]byte, []int) {
+	return file_tabletmanagerdata_proto_rawDescGZIP(), []int{114, 0}
 }
 
-func (x *VExecResponse) GetResult() *query.QueryResult {
+func (x *CheckThrottlerResponse_Result) GetStatusCode() int32 {
 	if x != nil {
-		return x.Result
+		return x.StatusCode
 	}
-	return nil
+	return 0
+}
+
+func (x *CheckThrottlerResponse_Result) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *CheckThrottlerResponse_Result) GetThreshold() float64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *CheckThrottlerResponse_Result) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *CheckThrottlerResponse_Result) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CheckThrottlerResponse_Result) GetMetricName() string {
+	if x != nil {
+		return x.MetricName
+	}
+	return ""
 }
 
 var File_tabletmanagerdata_proto protoreflect.FileDescriptor
@@ -4654,449 +5923,604 @@ var file_tabletmanagerdata_proto_rawDesc = []byte{
 	0x65, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0e, 0x74, 0x6f, 0x70, 0x6f, 0x64,
 	0x61, 0x74, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x15, 0x72, 0x65, 0x70, 0x6c, 0x69,
 	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x1a, 0x0d, 0x6c, 0x6f, 0x67, 0x75, 0x74, 0x69, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
-	0xff, 0x01, 0x0a, 0x0f, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d,
-	0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12,
-	0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x70, 0x72, 0x69,
-	0x6d, 0x61, 0x72, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73,
-	0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b,
-	0x65, 0x79, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a,
-	0x0b, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x1b,
-	0x0a, 0x09, 0x72, 0x6f, 0x77, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x08, 0x72, 0x6f, 0x77, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x06, 0x66,
-	0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x71, 0x75,
-	0x65, 0x72, 0x79, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64,
-	0x73, 0x22, 0xa6, 0x01, 0x0a, 0x10, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x44, 0x65, 0x66, 0x69,
-	0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61,
-	0x73, 0x65, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0e, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12,
-	0x4f, 0x0a, 0x11, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x74, 0x61, 0x62,
-	0x6c, 0x65, 0x74, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x54,
-	0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x10,
-	0x74, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xa6, 0x01, 0x0a, 0x12, 0x53,
-	0x63, 0x68, 0x65, 0x6d, 0x61, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x12, 0x48, 0x0a, 0x0d, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x5f, 0x73, 0x63, 0x68, 0x65,
-	0x6d, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x74, 0x61, 0x62, 0x6c, 0x65,
-	0x74, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x53, 0x63, 0x68,
-	0x65, 0x6d, 0x61, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x62,
-	0x65, 0x66, 0x6f, 0x72, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x46, 0x0a, 0x0c, 0x61,
-	0x66, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x23, 0x2e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
-	0x72, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x44, 0x65, 0x66, 0x69,
-	0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x61, 0x66, 0x74, 0x65, 0x72, 0x53, 0x63, 0x68,
-	0x65, 0x6d, 0x61, 0x22, 0xf7, 0x01, 0x0a, 0x0e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x65, 0x72, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73,
-	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x2b,
-	0x0a, 0x11, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b,
-	0x73, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x70, 0x61, 0x73, 0x73, 0x77,
-	0x6f, 0x72, 0x64, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x12, 0x51, 0x0a, 0x0a, 0x70,
-	0x72, 0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x31, 0x2e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x64,
-	0x61, 0x74, 0x61, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x2e, 0x50, 0x72, 0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x73, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x73, 0x1a, 0x3d,
-	0x0a, 0x0f, 0x50, 0x72, 0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd6, 0x01,
-	0x0a, 0x0c, 0x44, 0x62, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12,
-	0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f,
-	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x64, 0x62, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x64, 0x62, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x4f, 0x0a, 0x0a, 0x70, 0x72, 0x69, 0x76, 0x69, 0x6c,
-	0x65, 0x67, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x74, 0x61, 0x62,
+	0x1a, 0x0d, 0x6c, 0x6f, 0x67, 0x75, 0x74, 0x69, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x0c, 0x76, 0x74, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xff, 0x01,
+	0x0a, 0x0f, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07,
+	0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x70, 0x72, 0x69, 0x6d, 0x61,
+	0x72, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65, 0x79,
+	0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x64,
+	0x61, 0x74, 0x61, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x1b, 0x0a, 0x09,
+	0x72, 0x6f, 0x77, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x08, 0x72, 0x6f, 0x77, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x06, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22,
+	0xa6, 0x01, 0x0a, 0x10, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
+	0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x64,
+	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x4f, 0x0a,
+	0x11, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x74, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x54, 0x61, 0x62,
+	0x6c, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x74, 0x61,
+	0x62, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xa6, 0x01, 0x0a, 0x12, 0x53, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x48, 0x0a, 0x0d, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x6d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x62, 0x65, 0x66,
+	0x6f, 0x72, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x46, 0x0a, 0x0c, 0x61, 0x66, 0x74,
+	0x65, 0x72, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x23, 0x2e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x64,
+	0x61, 0x74, 0x61, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x61, 0x66, 0x74, 0x65, 0x72, 0x53, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x22, 0xf7, 0x01, 0x0a, 0x0e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x2b, 0x0a, 0x11,
+	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75,
+	0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x12, 0x51, 0x0a, 0x0a, 0x70, 0x72, 0x69,
+	0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x64, 0x61, 0x74,
+	0x61, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x2e, 0x50, 0x72, 0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x0a, 0x70, 0x72, 0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x73, 0x1a, 0x3d, 0x0a, 0x0f,
+	0x50, 0x72, 0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd6, 0x01, 0x0a, 0x0c,
+	0x44, 0x62, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04,
+	0x68, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x64, 0x62, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x64, 0x62,
+	0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x75, 0x73, 0x65, 0x72, 0x12, 0x4f, 0x0a, 0x0a, 0x70, 0x72, 0x69, 0x76, 0x69, 0x6c, 0x65, 0x67,
+	0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x74, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x44, 0x62, 0x50,
+	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x50, 0x72, 0x69, 0x76, 0x69, 0x6c,
+	0x65, 0x67, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x76, 0x69,
+	0x6c, 0x65, 0x67, 0x65, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x50, 0x72, 0x69, 0x76, 0x69, 0x6c, 0x65,
+	0x67, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0xa3, 0x01, 0x0a, 0x0b, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x4c, 0x0a, 0x10, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x70, 0x65, 0x72,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21,
+	0x2e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x64, 0x61,
+	0x74, 0x61, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x0f, 0x75, 0x73, 0x65, 0x72, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x46, 0x0a, 0x0e, 0x64, 0x62, 0x5f, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x74, 0x61, 0x62,
 	0x6c, 0x65, 0x74, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x44,
-	0x62, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x50, 0x72, 0x69, 0x76,
-	0x69, 0x6c, 0x65, 0x67, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x70, 0x72, 0x69,
-	0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x50, 0x72, 0x69, 0x76, 0x69,
-	0x6c, 0x65, 0x67, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xa3, 0x01, 0x0a, 0x0b, 0x50, 0x65, 0x72, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x4c, 0x0a, 0x10, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x70,
-	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x21, 0x2e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72,
-	0x64, 0x61, 0x74, 0x61, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x75, 0x73, 0x65, 0x72, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x46, 0x0a, 0x0e, 0x64, 0x62, 0x5f, 0x70, 0x65, 0x72, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x74,
-	0x61, 0x62, 0x6c, 0x65, 0x74, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x64, 0x61, 0x74, 0x61,
-	0x2e, 0x44, 0x62, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x64,
-	0x62, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x27, 0x0a, 0x0b,
-	0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70,
-	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61,
-	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x28, 0x0a, 0x0c, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22,
-	0x2a, 0x0a, 0x0c, 0x53, 0x6c, 0x65, 0x65, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x0f, 0x0a, 0x0d, 0x53,
-	0x6c, 0x65, 0x65, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xd7, 0x01, 0x0a,
-	0x12, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x48, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d,
-	0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x72,
-	0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x50, 0x0a, 0x09, 0x65, 0x78, 0x74, 0x72, 0x61,
-	0x5f, 0x65, 0x6e, 0x76, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x74, 0x61, 0x62,
-	0x6c, 0x65, 0x74, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x45,
+	0x62, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x64, 0x62, 0x50,
+	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x27, 0x0a, 0x0b, 0x50, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x22, 0x28, 0x0a, 0x0c, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x2a, 0x0a,
+	0x0c, 0x53, 0x6c, 0x65, 0x65, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x0f, 0x0a, 0x0d, 0x53, 0x6c, 0x65,
+	0x65, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xd7, 0x01, 0x0a, 0x12, 0x45,
 	0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x48, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x45, 0x6e, 0x76, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-	0x08, 0x65, 0x78, 0x74, 0x72, 0x61, 0x45, 0x6e, 0x76, 0x1a, 0x3b, 0x0a, 0x0d, 0x45, 0x78, 0x74,
-	0x72, 0x61, 0x45, 0x6e, 0x76, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74,
+	0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d,
+	0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x50, 0x0a, 0x09, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x65,
+	0x6e, 0x76, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x74, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x45, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x65, 0x48, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
+	0x45, 0x78, 0x74, 0x72, 0x61, 0x45, 0x6e, 0x76, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x65,
+	0x78, 0x74, 0x72, 0x61, 0x45, 0x6e, 0x76, 0x1a, 0x3b, 0x0a, 0x0d, 0x45, 0x78, 0x74, 0x72, 0x61,
+	0x45, 0x6e, 0x76, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0x66, 0x0a, 0x13, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x48,
+	0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x65,
+	0x78, 0x69, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x65, 0x78, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74,
+	0x64, 0x6f, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x22, 0xa1, 0x01, 0x0a,
+	0x0d, 0x4c, 0x69, 0x66, 0x65, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x48, 0x6f, 0x6f, 0x6b, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x61,
+	0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61,
+	0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x2a, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x76, 0x74, 0x74, 0x69,
+	0x6d, 0x65, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x74, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64,
+	0x22, 0xf4, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x66, 0x65, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x48, 0x6f,
+	0x6f, 0x6b, 0x52, 0x75, 0x6e, 0x12, 0x34, 0x0a, 0x04, 0x68, 0x6f, 0x6f, 0x6b, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x6d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x72, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4c, 0x69, 0x66, 0x65, 0x63, 0x79, 0x63, 0x6c,
+	0x65, 0x48, 0x6f, 0x6f, 0x6b, 0x52, 0x04, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x2b, 0x0a, 0x0a, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x76, 0x74, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x09, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x76, 0x74, 0x74,
+	0x69, 0x6d, 0x65, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x78, 0x69,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x64, 0x6f, 0x75,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x22, 0x1a, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x4c, 0x69,
+	0x66, 0x65, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x8c, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x66, 0x65, 0x63,
+	0x79, 0x63, 0x6c, 0x65, 0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x36, 0x0a, 0x05, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x20, 0x2e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72,
+	0x64, 0x61, 0x74, 0x61, 0x2e, 0x4c, 0x69, 0x66, 0x65, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x48, 0x6f,
+	0x6f, 0x6b, 0x52, 0x05, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x37, 0x0a, 0x04, 0x72, 0x75, 0x6e,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x74,
+	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4c, 0x69, 0x66, 0x65,
+	0x63, 0x79, 0x63, 0x6c, 0x65, 0x48, 0x6f, 0x6f, 0x6b, 0x52, 0x75, 0x6e, 0x52, 0x04, 0x72, 0x75,
+	0x6e, 0x73, 0x22, 0x13, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x88, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6c, 0x61, 0x76,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6c, 0x61, 0x76, 0x6f, 0x72,
+	0x12, 0x14, 0x0a, 0x05, 0x6d, 0x61, 0x6a, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x6d, 0x61, 0x6a, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x69, 0x6e, 0x6f, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6d, 0x69, 0x6e, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05,
+	0x70, 0x61, 0x74, 0x63, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x70, 0x61, 0x74,
+	0x63, 0x68, 0x22, 0x76, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x23,
+	0x0a, 0x0d, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x76, 0x69, 0x65, 0x77, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x56, 0x69,
+	0x65, 0x77, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x78, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x22, 0x65, 0x0a, 0x11, 0x47, 0x65,
+	0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x50, 0x0a, 0x11, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x74, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x53,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x10, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x17, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5a, 0x0a, 0x16, 0x47, 0x65,
+	0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x74, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x50, 0x65,
+	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x14, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x52, 0x65, 0x61,
+	0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x15, 0x0a, 0x13,
+	0x53, 0x65, 0x74, 0x52, 0x65, 0x61, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x15, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x52, 0x65, 0x61, 0x64, 0x57, 0x72,
+	0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x16, 0x0a, 0x14, 0x53, 0x65,
+	0x74, 0x52, 0x65, 0x61, 0x64, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x4a, 0x0a, 0x11, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x0b, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x74,
+	0x6f, 0x70, 0x6f, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x0a, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x22, 0x14,
+	0x0a, 0x12, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0xaa, 0x01, 0x0a, 0x11, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54,
+	0x61, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x42, 0x0a, 0x04, 0x74, 0x61,
+	0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x74, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x54,
+	0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x1a, 0x37, 0x0a, 0x09, 0x54, 0x61, 0x67, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0x92, 0x01, 0x0a, 0x12, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x61, 0x67, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x6d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x61,
+	0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x1a, 0x37, 0x0a,
+	0x09, 0x54, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
 	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
 	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x66, 0x0a, 0x13, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
-	0x65, 0x48, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a,
-	0x0b, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0a, 0x65, 0x78, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16,
-	0x0a, 0x06, 0x73, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x73, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x22, 0x76,
-	0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e,
-	0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x76, 0x69, 0x65, 0x77, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x0c, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x56, 0x69, 0x65, 0x77, 0x73, 0x12,
-	0x25, 0x0a, 0x0e, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65,
-	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65,
-	0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x22, 0x65, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68,
-	0x65, 0x6d, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x11, 0x73,
-	0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x6d,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x15, 0x0a, 0x13, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73,
+	0x68, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x16, 0x0a,
+	0x14, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x0a, 0x15, 0x52, 0x75, 0x6e, 0x48, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x18,
+	0x0a, 0x16, 0x52, 0x75, 0x6e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x0a, 0x18, 0x49, 0x67, 0x6e, 0x6f,
+	0x72, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x22, 0x1b,
+	0x0a, 0x19, 0x49, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a, 0x0a, 0x13, 0x52,
+	0x65, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x77, 0x61, 0x69, 0x74, 0x50,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x16, 0x0a, 0x14, 0x52, 0x65, 0x6c, 0x6f, 0x61,
+	0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x32, 0x0a, 0x16, 0x50, 0x72, 0x65, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x53, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x73, 0x22, 0x67, 0x0a, 0x17, 0x50, 0x72, 0x65, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74,
+	0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c,
+	0x0a, 0x0e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x6d,
 	0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d,
-	0x61, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x73, 0x63, 0x68,
-	0x65, 0x6d, 0x61, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x17, 0x0a,
-	0x15, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5a, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x40, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x6d, 0x61,
-	0x6e, 0x61, 0x67, 0x65, 0x72, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x73, 0x22, 0x14, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x52, 0x65, 0x61, 0x64, 0x4f, 0x6e, 0x6c,
-	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x15, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x52,
-	0x65, 0x61, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x15, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x52, 0x65, 0x61, 0x64, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x16, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x52, 0x65, 0x61,
-	0x64, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4a,
-	0x0a, 0x11, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x0b, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x5f, 0x74, 0x79,
-	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x74, 0x6f, 0x70, 0x6f, 0x64,
-	0x61, 0x74, 0x61, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a,
-	0x74, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x22, 0x14, 0x0a, 0x12, 0x43, 0x68,
-	0x61, 0x6e, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x15, 0x0a, 0x13, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x16, 0x0a, 0x14, 0x52, 0x65, 0x66, 0x72, 0x65,
-	0x73, 0x68, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x17, 0x0a, 0x15, 0x52, 0x75, 0x6e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63,
-	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x18, 0x0a, 0x16, 0x52, 0x75, 0x6e, 0x48,
-	0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x34, 0x0a, 0x18, 0x49, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x48, 0x65, 0x61, 0x6c,
-	0x74, 0x68, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18,
-	0x0a, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x22, 0x1b, 0x0a, 0x19, 0x49, 0x67, 0x6e, 0x6f,
-	0x72, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a, 0x0a, 0x13, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x53,
-	0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d,
-	0x77, 0x61, 0x69, 0x74, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0c, 0x77, 0x61, 0x69, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
-	0x6e, 0x22, 0x16, 0x0a, 0x14, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d,
-	0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x32, 0x0a, 0x16, 0x50, 0x72, 0x65,
-	0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x22, 0x67, 0x0a,
-	0x17, 0x50, 0x72, 0x65, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x0e, 0x63, 0x68, 0x61, 0x6e,
-	0x67, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x25, 0x2e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72,
-	0x64, 0x61, 0x74, 0x61, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x43, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0d, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0xfb, 0x01, 0x0a, 0x12, 0x41, 0x70, 0x70, 0x6c, 0x79,
-	0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a,
-	0x03, 0x73, 0x71, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x71, 0x6c, 0x12,
-	0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
-	0x66, 0x6f, 0x72, 0x63, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x72,
-	0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x48, 0x0a, 0x0d, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x5f, 0x73, 0x63, 0x68,
-	0x65, 0x6d, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x74, 0x61, 0x62, 0x6c,
+	0x61, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0d, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0xfb, 0x01, 0x0a,
+	0x12, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x71, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x73, 0x71, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x48, 0x0a, 0x0d, 0x62, 0x65, 0x66, 0x6f,
+	0x72, 0x65, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x23, 0x2e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x64,
+	0x61, 0x74, 0x61, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x53, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x12, 0x46, 0x0a, 0x0c, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x74, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x53, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x61,
+	0x66, 0x74, 0x65, 0x72, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x22, 0xa7, 0x01, 0x0a, 0x13, 0x41,
+	0x70, 0x70, 0x6c, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x48, 0x0a, 0x0d, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x5f, 0x73, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x74, 0x61, 0x62, 0x6c,
 	0x65, 0x74, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x53, 0x63,
 	0x68, 0x65, 0x6d, 0x61, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c,
 	0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x46, 0x0a, 0x0c,
-	0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x05, 0x20, 0x01,
+	0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x02, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x6d, 0x61, 0x6e, 0x61, 0x67,
 	0x65, 0x72, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x44, 0x65, 0x66,
 	0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x61, 0x66, 0x74, 0x65, 0x72, 0x53, 0x63,
-	0x68, 0x65, 0x6d, 0x61, 0x22, 0xa7, 0x01, 0x0a, 0x13, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x53, 0x63,
-	0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0d,
-	0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x6d, 0x61, 0x6e, 0x61,
-	0x67, 0x65, 0x72, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x44, 0x65,
-	0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65,
-	0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x46, 0x0a, 0x0c, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f,
-	0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x74,
-	0x61, 0x62, 0x6c, 0x65, 0x74, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x64, 0x61, 0x74, 0x61,
-	0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x0b, 0x61, 0x66, 0x74, 0x65, 0x72, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x22, 0x13,
-	0x0a, 0x11, 0x4c, 0x6f, 0x63, 0x6b, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x22, 0x14, 0x0a, 0x12, 0x4c, 0x6f, 0x63, 0x6b, 0x54, 0x61, 0x62, 0x6c, 0x65,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x15, 0x0a, 0x13, 0x55, 0x6e, 0x6c,
-	0x6f, 0x63, 0x6b, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x22, 0x16, 0x0a, 0x14, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5f, 0x0a, 0x13, 0x45, 0x78, 0x65, 0x63,
-	0x75, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05,
-	0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x19,
-	0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x07, 0x6d, 0x61, 0x78, 0x52, 0x6f, 0x77, 0x73, 0x22, 0x42, 0x0a, 0x14, 0x45, 0x78, 0x65,
-	0x63, 0x75, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x2a, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x12, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0xb2, 0x01,
-	0x0a, 0x18, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x46, 0x65, 0x74, 0x63, 0x68, 0x41, 0x73,
-	0x44, 0x62, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75,
-	0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79,
-	0x12, 0x17, 0x0a, 0x07, 0x64, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x78,
-	0x5f, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6d, 0x61, 0x78,
-	0x52, 0x6f, 0x77, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f,
-	0x62, 0x69, 0x6e, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x64,
-	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x69, 0x6e, 0x6c, 0x6f, 0x67, 0x73, 0x12, 0x23, 0x0a,
-	0x0d, 0x72, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x05,
+	0x68, 0x65, 0x6d, 0x61, 0x22, 0x13, 0x0a, 0x11, 0x4c, 0x6f, 0x63, 0x6b, 0x54, 0x61, 0x62, 0x6c,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x14, 0x0a, 0x12, 0x4c, 0x6f, 0x63,
+	0x6b, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x15, 0x0a, 0x13, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x16, 0x0a, 0x14, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b,
+	0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5f,
+	0x0a, 0x13, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x64,
+	0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x6f, 0x77, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x52, 0x6f, 0x77, 0x73, 0x22,
+	0x42, 0x0a, 0x14, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x22, 0xb2, 0x01, 0x0a, 0x18, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x46,
+	0x65, 0x74, 0x63, 0x68, 0x41, 0x73, 0x44, 0x62, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x62, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x19, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x52, 0x6f, 0x77, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x69,
+	0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x62, 0x69, 0x6e, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0e, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x69, 0x6e, 0x6c,
+	0x6f, 0x67, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x73, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x72, 0x65, 0x6c, 0x6f,
+	0x61, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x22, 0x47, 0x0a, 0x19, 0x45, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x65, 0x46, 0x65, 0x74, 0x63, 0x68, 0x41, 0x73, 0x44, 0x62, 0x61, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x22, 0x8e, 0x01, 0x0a, 0x1d, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x46, 0x65, 0x74,
+	0x63, 0x68, 0x41, 0x73, 0x41, 0x6c, 0x6c, 0x50, 0x72, 0x69, 0x76, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x62, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x52, 0x6f, 0x77, 0x73, 0x12, 0x23, 0x0a,
+	0x0d, 0x72, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x04,
 	0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x72, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x63, 0x68, 0x65,
-	0x6d, 0x61, 0x22, 0x47, 0x0a, 0x19, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x46, 0x65, 0x74,
-	0x63, 0x68, 0x41, 0x73, 0x44, 0x62, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x2a, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x12, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x8e, 0x01, 0x0a, 0x1d,
-	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x46, 0x65, 0x74, 0x63, 0x68, 0x41, 0x73, 0x41, 0x6c,
-	0x6c, 0x50, 0x72, 0x69, 0x76, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
-	0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x71, 0x75,
-	0x65, 0x72, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x62, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08,
-	0x6d, 0x61, 0x78, 0x5f, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
-	0x6d, 0x61, 0x78, 0x52, 0x6f, 0x77, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x6c, 0x6f, 0x61,
-	0x64, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c,
-	0x72, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x22, 0x4c, 0x0a, 0x1e,
-	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x46, 0x65, 0x74, 0x63, 0x68, 0x41, 0x73, 0x41, 0x6c,
-	0x6c, 0x50, 0x72, 0x69, 0x76, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a,
-	0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
-	0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x4b, 0x0a, 0x18, 0x45, 0x78,
-	0x65, 0x63, 0x75, 0x74, 0x65, 0x46, 0x65, 0x74, 0x63, 0x68, 0x41, 0x73, 0x41, 0x70, 0x70, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x19, 0x0a, 0x08,
-	0x6d, 0x61, 0x78, 0x5f, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
-	0x6d, 0x61, 0x78, 0x52, 0x6f, 0x77, 0x73, 0x22, 0x47, 0x0a, 0x19, 0x45, 0x78, 0x65, 0x63, 0x75,
-	0x74, 0x65, 0x46, 0x65, 0x74, 0x63, 0x68, 0x41, 0x73, 0x41, 0x70, 0x70, 0x52, 0x65, 0x73, 0x70,
+	0x6d, 0x61, 0x22, 0x4c, 0x0a, 0x1e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x46, 0x65, 0x74,
+	0x63, 0x68, 0x41, 0x73, 0x41, 0x6c, 0x6c, 0x50, 0x72, 0x69, 0x76, 0x73, 0x52, 0x65, 0x73, 0x70,
 	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x51, 0x75, 0x65,
 	0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x22, 0x1a, 0x0a, 0x18, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4c, 0x0a, 0x19,
-	0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x72, 0x65, 0x70, 0x6c,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x16, 0x0a, 0x14, 0x50, 0x72,
-	0x69, 0x6d, 0x61, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x22, 0x4f, 0x0a, 0x15, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x72, 0x65,
-	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x50, 0x72,
-	0x69, 0x6d, 0x61, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x22, 0x18, 0x0a, 0x16, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x50, 0x6f,
-	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x35, 0x0a,
-	0x17, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x34, 0x0a, 0x16, 0x57, 0x61, 0x69, 0x74, 0x46, 0x6f, 0x72, 0x50,
-	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a,
-	0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x19, 0x0a, 0x17, 0x57, 0x61,
-	0x69, 0x74, 0x46, 0x6f, 0x72, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18, 0x0a, 0x16, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x70,
-	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
-	0x19, 0x0a, 0x17, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5e, 0x0a, 0x1d, 0x53, 0x74,
-	0x6f, 0x70, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x69, 0x6e,
-	0x69, 0x6d, 0x75, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70,
-	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
-	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x61, 0x69, 0x74, 0x5f,
-	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x77,
-	0x61, 0x69, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x3c, 0x0a, 0x1e, 0x53, 0x74,
-	0x6f, 0x70, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x69, 0x6e,
-	0x69, 0x6d, 0x75, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x22, 0x4b, 0x0a, 0x18, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x46, 0x65, 0x74, 0x63, 0x68,
+	0x41, 0x73, 0x41, 0x70, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x71, 0x75, 0x65,
+	0x72, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x52, 0x6f, 0x77, 0x73, 0x22, 0x47, 0x0a,
+	0x19, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x46, 0x65, 0x74, 0x63, 0x68, 0x41, 0x73, 0x41,
+	0x70, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x06, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x71, 0x75, 0x65,
+	0x72, 0x79, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x4a, 0x0a, 0x12, 0x4b, 0x69, 0x6c, 0x6c, 0x51, 0x75,
+	0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b,
+	0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73,
+	0x65, 0x72, 0x22, 0x2d, 0x0a, 0x13, 0x4b, 0x69, 0x6c, 0x6c, 0x51, 0x75, 0x65, 0x72, 0x69, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6b, 0x69, 0x6c,
+	0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6b, 0x69, 0x6c, 0x6c, 0x65,
+	0x64, 0x22, 0x1a, 0x0a, 0x18, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4c, 0x0a,
+	0x19, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x72, 0x65, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x16, 0x0a, 0x14, 0x50,
+	0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x4f, 0x0a, 0x15, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x72,
+	0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x50,
+	0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x22, 0x18, 0x0a, 0x16, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x50,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x35,
+	0x0a, 0x17, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x34, 0x0a, 0x16, 0x57, 0x61, 0x69, 0x74, 0x46, 0x6f, 0x72,
+	0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x19, 0x0a, 0x17, 0x57,
+	0x61, 0x69, 0x74, 0x46, 0x6f, 0x72, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18, 0x0a, 0x16, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x19, 0x0a, 0x17, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5e, 0x0a, 0x1d, 0x53,
+	0x74, 0x6f, 0x70, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x69,
+	0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
 	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x19, 0x0a, 0x17, 0x53, 0x74, 0x61, 0x72,
-	0x74, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x22, 0x1a, 0x0a, 0x18, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x70, 0x6c,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x62, 0x0a, 0x21, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x41, 0x66, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x21, 0x0a, 0x0c, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x77, 0x61, 0x69, 0x74, 0x54, 0x69, 0x6d, 0x65,
-	0x6f, 0x75, 0x74, 0x22, 0x24, 0x0a, 0x22, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x70, 0x6c,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x41, 0x66, 0x74, 0x65,
-	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x14, 0x0a, 0x12, 0x47, 0x65, 0x74,
-	0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
-	0x2b, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x64, 0x64, 0x72, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x61, 0x64, 0x64, 0x72, 0x73, 0x22, 0x19, 0x0a, 0x17,
-	0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x1a, 0x0a, 0x18, 0x52, 0x65, 0x73, 0x65, 0x74,
-	0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x2f, 0x0a, 0x17, 0x56, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x45, 0x78, 0x65, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14,
-	0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71,
-	0x75, 0x65, 0x72, 0x79, 0x22, 0x46, 0x0a, 0x18, 0x56, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x65, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x2a, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x12, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x4b, 0x0a, 0x1d,
-	0x56, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x61, 0x69, 0x74,
-	0x46, 0x6f, 0x72, 0x50, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a,
-	0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x20, 0x0a, 0x1e, 0x56, 0x52, 0x65,
-	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x61, 0x69, 0x74, 0x46, 0x6f, 0x72,
-	0x50, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x14, 0x0a, 0x12, 0x49,
-	0x6e, 0x69, 0x74, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x22, 0x31, 0x0a, 0x13, 0x49, 0x6e, 0x69, 0x74, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x22, 0xd8, 0x01, 0x0a, 0x1e, 0x50, 0x6f, 0x70, 0x75, 0x6c, 0x61, 0x74,
-	0x65, 0x52, 0x65, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x74, 0x69, 0x6d, 0x65, 0x5f,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x4e, 0x73, 0x12,
-	0x1f, 0x0a, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x3a, 0x0a, 0x0d, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x5f, 0x61, 0x6c, 0x69, 0x61,
-	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x6f, 0x70, 0x6f, 0x64, 0x61,
-	0x74, 0x61, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x52, 0x0c,
-	0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x31, 0x0a, 0x14,
-	0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6f, 0x73, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x72, 0x65, 0x70, 0x6c,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22,
-	0x21, 0x0a, 0x1f, 0x50, 0x6f, 0x70, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x61, 0x72,
-	0x65, 0x6e, 0x74, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x9e, 0x01, 0x0a, 0x12, 0x49, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x69,
-	0x63, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x06, 0x70, 0x61, 0x72,
-	0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x6f, 0x70, 0x6f,
-	0x64, 0x61, 0x74, 0x61, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x41, 0x6c, 0x69, 0x61, 0x73,
-	0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x31, 0x0a, 0x14, 0x72, 0x65, 0x70, 0x6c,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0f, 0x74,
-	0x69, 0x6d, 0x65, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x6e, 0x73, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x4e, 0x73, 0x22, 0x15, 0x0a, 0x13, 0x49, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x69,
-	0x63, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x16, 0x0a, 0x14, 0x44, 0x65,
-	0x6d, 0x6f, 0x74, 0x65, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x22, 0x93, 0x01, 0x0a, 0x15, 0x44, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x50, 0x72, 0x69,
-	0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x13,
-	0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x12, 0x64,
-	0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x45, 0x0a, 0x0e, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x5f, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x72, 0x65, 0x70, 0x6c,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x50, 0x72, 0x69, 0x6d,
-	0x61, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0d, 0x70, 0x72, 0x69, 0x6d, 0x61,
-	0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x1a, 0x0a, 0x18, 0x55, 0x6e, 0x64, 0x6f,
-	0x44, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x22, 0x1b, 0x0a, 0x19, 0x55, 0x6e, 0x64, 0x6f, 0x44, 0x65, 0x6d, 0x6f,
-	0x74, 0x65, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x1b, 0x0a, 0x19, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x57, 0x61, 0x73, 0x50,
-	0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x1c,
-	0x0a, 0x1a, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x57, 0x61, 0x73, 0x50, 0x72, 0x6f, 0x6d,
-	0x6f, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xd1, 0x01, 0x0a,
-	0x1b, 0x53, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x06,
-	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74,
-	0x6f, 0x70, 0x6f, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x41, 0x6c,
-	0x69, 0x61, 0x73, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x74,
-	0x69, 0x6d, 0x65, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x6e, 0x73, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x4e, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x5f, 0x73, 0x74, 0x61,
-	0x72, 0x74, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74,
-	0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x77,
-	0x61, 0x69, 0x74, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0c, 0x77, 0x61, 0x69, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x22, 0x1e, 0x0a, 0x1c, 0x53, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x4b, 0x0a, 0x1a, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x57, 0x61, 0x73, 0x52, 0x65,
-	0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d,
-	0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
-	0x2e, 0x74, 0x6f, 0x70, 0x6f, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x74,
-	0x41, 0x6c, 0x69, 0x61, 0x73, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x22, 0x1d, 0x0a,
-	0x1b, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x57, 0x61, 0x73, 0x52, 0x65, 0x73, 0x74, 0x61,
-	0x72, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x7e, 0x0a, 0x22,
-	0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41,
-	0x6e, 0x64, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x58, 0x0a, 0x15, 0x73, 0x74, 0x6f, 0x70, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x24, 0x2e, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x64,
-	0x61, 0x74, 0x61, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x13, 0x73, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x70,
-	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x22, 0xa7, 0x01, 0x0a,
-	0x23, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x41, 0x6e, 0x64, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0d, 0x68, 0x79, 0x62, 0x72, 0x69, 0x64, 0x5f, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x72, 0x65,
-	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0c, 0x68, 0x79, 0x62, 0x72, 0x69, 0x64,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3e, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x70,
-	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x17, 0x0a, 0x15, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74,
-	0x65, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
-	0x34, 0x0a, 0x16, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63,
-	0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x61, 0x69, 0x74,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b,
+	0x77, 0x61, 0x69, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x3c, 0x0a, 0x1e, 0x53,
+	0x74, 0x6f, 0x70, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x69,
+	0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x19, 0x0a, 0x17, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x1a, 0x0a, 0x18, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x62, 0x0a, 0x21, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x41, 0x66, 0x74, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x77, 0x61, 0x69, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x22, 0x24, 0x0a, 0x22, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x41, 0x66, 0x74,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x14, 0x0a, 0x12, 0x47, 0x65,
+	0x74, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x2b, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x64, 0x64, 0x72, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x61, 0x64, 0x64, 0x72, 0x73, 0x22, 0x19, 0x0a,
+	0x17, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x1a, 0x0a, 0x18, 0x52, 0x65, 0x73, 0x65,
+	0x74, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x0a, 0x17, 0x56, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x65, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x71, 0x75, 0x65, 0x72, 0x79, 0x22, 0x46, 0x0a, 0x18, 0x56, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x65, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2a, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x4b, 0x0a,
+	0x1d, 0x56, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x61, 0x69,
+	0x74, 0x46, 0x6f, 0x72, 0x50, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x20, 0x0a, 0x1e, 0x56, 0x52,
+	0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x61, 0x69, 0x74, 0x46, 0x6f,
+	0x72, 0x50, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x14, 0x0a, 0x12,
+	0x49, 0x6e, 0x69, 0x74, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x31, 0x0a, 0x13, 0x49, 0x6e, 0x69, 0x74, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73,
 	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x56, 0x0a, 0x0d, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72,
-	0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x6f, 0x6e,
-	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x6c, 0x6c, 0x6f,
-	0x77, 0x5f, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x22, 0x36, 0x0a,
-	0x0e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x24, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
-	0x2e, 0x6c, 0x6f, 0x67, 0x75, 0x74, 0x69, 0x6c, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05,
-	0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x1a, 0x0a, 0x18, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65,
-	0x46, 0x72, 0x6f, 0x6d, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x22, 0x41, 0x0a, 0x19, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x46, 0x72, 0x6f, 0x6d,
-	0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24,
-	0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
-	0x6c, 0x6f, 0x67, 0x75, 0x74, 0x69, 0x6c, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65,
-	0x76, 0x65, 0x6e, 0x74, 0x22, 0x5c, 0x0a, 0x0c, 0x56, 0x45, 0x78, 0x65, 0x63, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x6f,
-	0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x77, 0x6f,
-	0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x12, 0x1a, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x73, 0x70, 0x61,
-	0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x73, 0x70, 0x61,
-	0x63, 0x65, 0x22, 0x3b, 0x0a, 0x0d, 0x56, 0x45, 0x78, 0x65, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42,
-	0x30, 0x5a, 0x2e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x73, 0x2e, 0x69, 0x6f, 0x2f, 0x76, 0x69, 0x74,
-	0x65, 0x73, 0x73, 0x2f, 0x67, 0x6f, 0x2f, 0x76, 0x74, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
-	0x74, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x64, 0x61, 0x74,
-	0x61, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xd8, 0x01, 0x0a, 0x1e, 0x50, 0x6f, 0x70, 0x75, 0x6c, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x74, 0x69, 0x6d, 0x65,
+	0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x4e, 0x73,
+	0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x3a, 0x0a, 0x0d, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x5f, 0x61, 0x6c, 0x69,
+	0x61, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x6f, 0x70, 0x6f, 0x64,
+	0x61, 0x74, 0x61, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x52,
+	0x0c, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x31, 0x0a,
+	0x14, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x72, 0x65, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x21, 0x0a, 0x1f, 0x50, 0x6f, 0x70, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x61,
+	0x72, 0x65, 0x6e, 0x74, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x9e, 0x01, 0x0a, 0x12, 0x49, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x06, 0x70, 0x61,
+	0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x6f, 0x70,
+	0x6f, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x41, 0x6c, 0x69, 0x61,
+	0x73, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x31, 0x0a, 0x14, 0x72, 0x65, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0f,
+	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x6e, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x4e, 0x73, 0x22, 0x15, 0x0a, 0x13, 0x49, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x16, 0x0a, 0x14, 0x44,
+	0x65, 0x6d, 0x6f, 0x74, 0x65, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x93, 0x01, 0x0a, 0x15, 0x44, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x50, 0x72,
+	0x69, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a,
+	0x13, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x12,
+	0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x45, 0x0a, 0x0e, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x72, 0x65, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x50, 0x72, 0x69,
+	0x6d, 0x61, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0d, 0x70, 0x72, 0x69, 0x6d,
+	0x61, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x1a, 0x0a, 0x18, 0x55, 0x6e, 0x64,
+	0x6f, 0x44, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x1b, 0x0a, 0x19, 0x55, 0x6e, 0x64, 0x6f, 0x44, 0x65, 0x6d,
+	0x6f, 0x74, 0x65, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x1b, 0x0a, 0x19, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x57, 0x61, 0x73,
+	0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x1c, 0x0a, 0x1a, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x57, 0x61, 0x73, 0x50, 0x72, 0x6f,
+	0x6d, 0x6f, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xd1, 0x01,
+	0x0a, 0x1b, 0x53, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a,
+	0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x74, 0x6f, 0x70, 0x6f, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x41,
+	0x6c, 0x69, 0x61, 0x73, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0f,
+	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x6e, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x4e, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x5f, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d,
+	0x77, 0x61, 0x69, 0x74, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x77, 0x61, 0x69, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x1e, 0x0a, 0x1c, 0x53, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x41, 0x0a, 0x1a, 0x53, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x23, 0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x53, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x22, 0x1d, 0x0a, 0x1b, 0x53, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x4b, 0x0a, 0x1a, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x57, 0x61,
+	0x73, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x2d, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x74, 0x6f, 0x70, 0x6f, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x54, 0x61, 0x62,
+	0x6c, 0x65, 0x74, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
+	0x22, 0x1d, 0x0a, 0x1b, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x57, 0x61, 0x73, 0x52, 0x65,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x7e, 0x0a, 0x22, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x41, 0x6e, 0x64, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x58, 0x0a, 0x15, 0x73, 0x74, 0x6f, 0x70, 0x5f, 0x72, 0x65,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x13, 0x73, 0x74, 0x6f, 0x70,
+	0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x22,
+	0xa7, 0x01, 0x0a, 0x23, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x64, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0d, 0x68, 0x79, 0x62, 0x72, 0x69,
+	0x64, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x61,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0c, 0x68, 0x79, 0x62,
+	0x72, 0x69, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3e, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x72, 0x65, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x53, 0x74, 0x6f, 0x70,
+	0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x17, 0x0a, 0x15, 0x50, 0x72, 0x6f,
+	0x6d, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x34, 0x0a, 0x16, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x85, 0x01, 0x0a, 0x0d, 0x42, 0x61, 0x63,
+	0x6b, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f,
+	0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0b, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x23, 0x0a, 0x0d,
+	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72,
+	0x79, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x63,
+	0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65,
+	0x22, 0x36, 0x0a, 0x0e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x6c, 0x6f, 0x67, 0x75, 0x74, 0x69, 0x6c, 0x2e, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x1a, 0x0a, 0x18, 0x52, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x41, 0x0a, 0x19, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x46,
+	0x72, 0x6f, 0x6d, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x6c, 0x6f, 0x67, 0x75, 0x74, 0x69, 0x6c, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x92, 0x02, 0x0a, 0x0f, 0x52, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x69,
+	0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0a, 0x69, 0x6e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
+	0x66, 0x69, 0x6c, 0x65, 0x73, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x25, 0x0a,
+	0x0e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x5f, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x72,
+	0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x12, 0x2a, 0x0a, 0x07,
+	0x65, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x76, 0x74, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x07, 0x65, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x12, 0x22, 0x0a, 0x03, 0x65, 0x74, 0x61, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x76, 0x74, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x03, 0x65, 0x74, 0x61, 0x22, 0x1b, 0x0a, 0x19,
+	0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65,
+	0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5c, 0x0a, 0x1a, 0x47, 0x65, 0x74,
+	0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x74, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x52, 0x65,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x08, 0x70,
+	0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0x3a, 0x0a, 0x1c, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x42, 0x69, 0x6e, 0x6c, 0x6f, 0x67, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x6d, 0x0a, 0x1d, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x41, 0x72, 0x63,
+	0x68, 0x69, 0x76, 0x65, 0x64, 0x42, 0x69, 0x6e, 0x6c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x06,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x5c, 0x0a, 0x0c, 0x56, 0x45, 0x78, 0x65, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x6f, 0x72, 0x6b,
+	0x66, 0x6c, 0x6f, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x77, 0x6f, 0x72, 0x6b,
+	0x66, 0x6c, 0x6f, 0x77, 0x12, 0x1a, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x22, 0x3b, 0x0a, 0x0d, 0x56, 0x45, 0x78, 0x65, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2a, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x93, 0x02,
+	0x0a, 0x15, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x54, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x06, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x74,
+	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x54, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x06, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x1a,
+	0xb1, 0x01, 0x0a, 0x05, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x70, 0x70,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x70,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x6c, 0x66, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x04, 0x73, 0x65, 0x6c, 0x66, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x6f, 0x77, 0x5f,
+	0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
+	0x6c, 0x6f, 0x77, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x2d, 0x0a, 0x12, 0x6f,
+	0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64,
+	0x65, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x27, 0x0a, 0x10, 0x6f, 0x6b,
+	0x5f, 0x69, 0x66, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x6f, 0x6b, 0x49, 0x66, 0x4e, 0x6f, 0x74, 0x45, 0x78, 0x69,
+	0x73, 0x74, 0x73, 0x22, 0x95, 0x02, 0x0a, 0x16, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x54, 0x68, 0x72,
+	0x6f, 0x74, 0x74, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a,
+	0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x30, 0x2e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x64,
+	0x61, 0x74, 0x61, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x54, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x1a, 0xae, 0x01, 0x0a, 0x06, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x4e, 0x61, 0x6d, 0x65, 0x42, 0x30, 0x5a, 0x2e, 0x76,
+	0x69, 0x74, 0x65, 0x73, 0x73, 0x2e, 0x69, 0x6f, 0x2f, 0x76, 0x69, 0x74, 0x65, 0x73, 0x73, 0x2f,
+	0x67, 0x6f, 0x2f, 0x76, 0x74, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x74, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x64, 0x61, 0x74, 0x61, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -5111,7 +6535,7 @@ func file_tabletmanagerdata_proto_rawDescGZIP() []byte {
 	return file_tabletmanagerdata_proto_rawDescData
 }
 
-var file_tabletmanagerdata_proto_msgTypes = make([]protoimpl.MessageInfo, 99)
+var file_tabletmanagerdata_proto_msgTypes = make([]protoimpl.MessageInfo, 122)
 var file_tabletmanagerdata_proto_goTypes = []interface{}{
 	(*TableDefinition)(nil),                       // 0: tabletmanagerdata.TableDefinition
 	(*SchemaDefinition)(nil),                      // 1: tabletmanagerdata.SchemaDefinition
@@ -5125,144 +6549,182 @@ var file_tabletmanagerdata_proto_goTypes = []interface{}{
 	(*SleepResponse)(nil),                         // 9: tabletmanagerdata.SleepResponse
 	(*ExecuteHookRequest)(nil),                    // 10: tabletmanagerdata.ExecuteHookRequest
 	(*ExecuteHookResponse)(nil),                   // 11: tabletmanagerdata.ExecuteHookResponse
-	(*GetSchemaRequest)(nil),                      // 12: tabletmanagerdata.GetSchemaRequest
-	(*GetSchemaResponse)(nil),                     // 13: tabletmanagerdata.GetSchemaResponse
-	(*GetPermissionsRequest)(nil),                 // 14: tabletmanagerdata.GetPermissionsRequest
-	(*GetPermissionsResponse)(nil),                // 15: tabletmanagerdata.GetPermissionsResponse
-	(*SetReadOnlyRequest)(nil),                    // 16: tabletmanagerdata.SetReadOnlyRequest
-	(*SetReadOnlyResponse)(nil),                   // 17: tabletmanagerdata.SetReadOnlyResponse
-	(*SetReadWriteRequest)(nil),                   // 18: tabletmanagerdata.SetReadWriteRequest
-	(*SetReadWriteResponse)(nil),                  // 19: tabletmanagerdata.SetReadWriteResponse
-	(*ChangeTypeRequest)(nil),                     // 20: tabletmanagerdata.ChangeTypeRequest
-	(*ChangeTypeResponse)(nil),                    // 21: tabletmanagerdata.ChangeTypeResponse
-	(*RefreshStateRequest)(nil),                   // 22: tabletmanagerdata.RefreshStateRequest
-	(*RefreshStateResponse)(nil),                  // 23: tabletmanagerdata.RefreshStateResponse
-	(*RunHealthCheckRequest)(nil),                 // 24: tabletmanagerdata.RunHealthCheckRequest
-	(*RunHealthCheckResponse)(nil),                // 25: tabletmanagerdata.RunHealthCheckResponse
-	(*IgnoreHealthErrorRequest)(nil),              // 26: tabletmanagerdata.IgnoreHealthErrorRequest
-	(*IgnoreHealthErrorResponse)(nil),             // 27: tabletmanagerdata.IgnoreHealthErrorResponse
-	(*ReloadSchemaRequest)(nil),                   // 28: tabletmanagerdata.ReloadSchemaRequest
-	(*ReloadSchemaResponse)(nil),                  // 29: tabletmanagerdata.ReloadSchemaResponse
-	(*PreflightSchemaRequest)(nil),                // 30: tabletmanagerdata.PreflightSchemaRequest
-	(*PreflightSchemaResponse)(nil),               // 31: tabletmanagerdata.PreflightSchemaResponse
-	(*ApplySchemaRequest)(nil),                    // 32: tabletmanagerdata.ApplySchemaRequest
-	(*ApplySchemaResponse)(nil),                   // 33: tabletmanagerdata.ApplySchemaResponse
-	(*LockTablesRequest)(nil),                     // 34: tabletmanagerdata.LockTablesRequest
-	(*LockTablesResponse)(nil),                    // 35: tabletmanagerdata.LockTablesResponse
-	(*UnlockTablesRequest)(nil),                   // 36: tabletmanagerdata.UnlockTablesRequest
-	(*UnlockTablesResponse)(nil),                  // 37: tabletmanagerdata.UnlockTablesResponse
-	(*ExecuteQueryRequest)(nil),                   // 38: tabletmanagerdata.ExecuteQueryRequest
-	(*ExecuteQueryResponse)(nil),                  // 39: tabletmanagerdata.ExecuteQueryResponse
-	(*ExecuteFetchAsDbaRequest)(nil),              // 40: tabletmanagerdata.ExecuteFetchAsDbaRequest
-	(*ExecuteFetchAsDbaResponse)(nil),             // 41: tabletmanagerdata.ExecuteFetchAsDbaResponse
-	(*ExecuteFetchAsAllPrivsRequest)(nil),         // 42: tabletmanagerdata.ExecuteFetchAsAllPrivsRequest
-	(*ExecuteFetchAsAllPrivsResponse)(nil),        // 43: tabletmanagerdata.ExecuteFetchAsAllPrivsResponse
-	(*ExecuteFetchAsAppRequest)(nil),              // 44: tabletmanagerdata.ExecuteFetchAsAppRequest
-	(*ExecuteFetchAsAppResponse)(nil),             // 45: tabletmanagerdata.ExecuteFetchAsAppResponse
-	(*ReplicationStatusRequest)(nil),              // 46: tabletmanagerdata.ReplicationStatusRequest
-	(*ReplicationStatusResponse)(nil),             // 47: tabletmanagerdata.ReplicationStatusResponse
-	(*PrimaryStatusRequest)(nil),                  // 48: tabletmanagerdata.PrimaryStatusRequest
-	(*PrimaryStatusResponse)(nil),                 // 49: tabletmanagerdata.PrimaryStatusResponse
-	(*PrimaryPositionRequest)(nil),                // 50: tabletmanagerdata.PrimaryPositionRequest
-	(*PrimaryPositionResponse)(nil),               // 51: tabletmanagerdata.PrimaryPositionResponse
-	(*WaitForPositionRequest)(nil),                // 52: tabletmanagerdata.WaitForPositionRequest
-	(*WaitForPositionResponse)(nil),               // 53: tabletmanagerdata.WaitForPositionResponse
-	(*StopReplicationRequest)(nil),                // 54: tabletmanagerdata.StopReplicationRequest
-	(*StopReplicationResponse)(nil),               // 55: tabletmanagerdata.StopReplicationResponse
-	(*StopReplicationMinimumRequest)(nil),         // 56: tabletmanagerdata.StopReplicationMinimumRequest
-	(*StopReplicationMinimumResponse)(nil),        // 57: tabletmanagerdata.StopReplicationMinimumResponse
-	(*StartReplicationRequest)(nil),               // 58: tabletmanagerdata.StartReplicationRequest
-	(*StartReplicationResponse)(nil),              // 59: tabletmanagerdata.StartReplicationResponse
-	(*StartReplicationUntilAfterRequest)(nil),     // 60: tabletmanagerdata.StartReplicationUntilAfterRequest
-	(*StartReplicationUntilAfterResponse)(nil),    // 61: tabletmanagerdata.StartReplicationUntilAfterResponse
-	(*GetReplicasRequest)(nil),                    // 62: tabletmanagerdata.GetReplicasRequest
-	(*GetReplicasResponse)(nil),                   // 63: tabletmanagerdata.GetReplicasResponse
-	(*ResetReplicationRequest)(nil),               // 64: tabletmanagerdata.ResetReplicationRequest
-	(*ResetReplicationResponse)(nil),              // 65: tabletmanagerdata.ResetReplicationResponse
-	(*VReplicationExecRequest)(nil),               // 66: tabletmanagerdata.VReplicationExecRequest
-	(*VReplicationExecResponse)(nil),              // 67: tabletmanagerdata.VReplicationExecResponse
-	(*VReplicationWaitForPosRequest)(nil),         // 68: tabletmanagerdata.VReplicationWaitForPosRequest
-	(*VReplicationWaitForPosResponse)(nil),        // 69: tabletmanagerdata.VReplicationWaitForPosResponse
-	(*InitPrimaryRequest)(nil),                    // 70: tabletmanagerdata.InitPrimaryRequest
-	(*InitPrimaryResponse)(nil),                   // 71: tabletmanagerdata.InitPrimaryResponse
-	(*PopulateReparentJournalRequest)(nil),        // 72: tabletmanagerdata.PopulateReparentJournalRequest
-	(*PopulateReparentJournalResponse)(nil),       // 73: tabletmanagerdata.PopulateReparentJournalResponse
-	(*InitReplicaRequest)(nil),                    // 74: tabletmanagerdata.InitReplicaRequest
-	(*InitReplicaResponse)(nil),                   // 75: tabletmanagerdata.InitReplicaResponse
-	(*DemotePrimaryRequest)(nil),                  // 76: tabletmanagerdata.DemotePrimaryRequest
-	(*DemotePrimaryResponse)(nil),                 // 77: tabletmanagerdata.DemotePrimaryResponse
-	(*UndoDemotePrimaryRequest)(nil),              // 78: tabletmanagerdata.UndoDemotePrimaryRequest
-	(*UndoDemotePrimaryResponse)(nil),             // 79: tabletmanagerdata.UndoDemotePrimaryResponse
-	(*ReplicaWasPromotedRequest)(nil),             // 80: tabletmanagerdata.ReplicaWasPromotedRequest
-	(*ReplicaWasPromotedResponse)(nil),            // 81: tabletmanagerdata.ReplicaWasPromotedResponse
-	(*SetReplicationSourceRequest)(nil),           // 82: tabletmanagerdata.SetReplicationSourceRequest
-	(*SetReplicationSourceResponse)(nil),          // 83: tabletmanagerdata.SetReplicationSourceResponse
-	(*ReplicaWasRestartedRequest)(nil),            // 84: tabletmanagerdata.ReplicaWasRestartedRequest
-	(*ReplicaWasRestartedResponse)(nil),           // 85: tabletmanagerdata.ReplicaWasRestartedResponse
-	(*StopReplicationAndGetStatusRequest)(nil),    // 86: tabletmanagerdata.StopReplicationAndGetStatusRequest
-	(*StopReplicationAndGetStatusResponse)(nil),   // 87: tabletmanagerdata.StopReplicationAndGetStatusResponse
-	(*PromoteReplicaRequest)(nil),                 // 88: tabletmanagerdata.PromoteReplicaRequest
-	(*PromoteReplicaResponse)(nil),                // 89: tabletmanagerdata.PromoteReplicaResponse
-	(*BackupRequest)(nil),                         // 90: tabletmanagerdata.BackupRequest
-	(*BackupResponse)(nil),                        // 91: tabletmanagerdata.BackupResponse
-	(*RestoreFromBackupRequest)(nil),              // 92: tabletmanagerdata.RestoreFromBackupRequest
-	(*RestoreFromBackupResponse)(nil),             // 93: tabletmanagerdata.RestoreFromBackupResponse
-	(*VExecRequest)(nil),                          // 94: tabletmanagerdata.VExecRequest
-	(*VExecResponse)(nil),                         // 95: tabletmanagerdata.VExecResponse
-	nil,                                           // 96: tabletmanagerdata.UserPermission.PrivilegesEntry
-	nil,                                           // 97: tabletmanagerdata.DbPermission.PrivilegesEntry
-	nil,                                           // 98: tabletmanagerdata.ExecuteHookRequest.ExtraEnvEntry
-	(*query.Field)(nil),                           // 99: query.Field
-	(topodata.TabletType)(0),                      // 100: topodata.TabletType
-	(*query.QueryResult)(nil),                     // 101: query.QueryResult
-	(*replicationdata.Status)(nil),                // 102: replicationdata.Status
-	(*replicationdata.PrimaryStatus)(nil),         // 103: replicationdata.PrimaryStatus
-	(*topodata.TabletAlias)(nil),                  // 104: topodata.TabletAlias
-	(replicationdata.StopReplicationMode)(0),      // 105: replicationdata.StopReplicationMode
-	(*replicationdata.StopReplicationStatus)(nil), // 106: replicationdata.StopReplicationStatus
-	(*logutil.Event)(nil),                         // 107: logutil.Event
+	(*LifecycleHook)(nil),                         // 12: tabletmanagerdata.LifecycleHook
+	(*LifecycleHookRun)(nil),                      // 13: tabletmanagerdata.LifecycleHookRun
+	(*GetLifecycleHooksRequest)(nil),              // 14: tabletmanagerdata.GetLifecycleHooksRequest
+	(*GetLifecycleHooksResponse)(nil),             // 15: tabletmanagerdata.GetLifecycleHooksResponse
+	(*GetVersionRequest)(nil),                     // 16: tabletmanagerdata.GetVersionRequest
+	(*GetVersionResponse)(nil),                    // 17: tabletmanagerdata.GetVersionResponse
+	(*GetSchemaRequest)(nil),                      // 18: tabletmanagerdata.GetSchemaRequest
+	(*GetSchemaResponse)(nil),                     // 19: tabletmanagerdata.GetSchemaResponse
+	(*GetPermissionsRequest)(nil),                 // 20: tabletmanagerdata.GetPermissionsRequest
+	(*GetPermissionsResponse)(nil),                // 21: tabletmanagerdata.GetPermissionsResponse
+	(*SetReadOnlyRequest)(nil),                    // 22: tabletmanagerdata.SetReadOnlyRequest
+	(*SetReadOnlyResponse)(nil),                   // 23: tabletmanagerdata.SetReadOnlyResponse
+	(*SetReadWriteRequest)(nil),                   // 24: tabletmanagerdata.SetReadWriteRequest
+	(*SetReadWriteResponse)(nil),                  // 25: tabletmanagerdata.SetReadWriteResponse
+	(*ChangeTypeRequest)(nil),                     // 26: tabletmanagerdata.ChangeTypeRequest
+	(*ChangeTypeResponse)(nil),                    // 27: tabletmanagerdata.ChangeTypeResponse
+	(*ChangeTagsRequest)(nil),                     // 28: tabletmanagerdata.ChangeTagsRequest
+	(*ChangeTagsResponse)(nil),                    // 29: tabletmanagerdata.ChangeTagsResponse
+	(*RefreshStateRequest)(nil),                   // 30: tabletmanagerdata.RefreshStateRequest
+	(*RefreshStateResponse)(nil),                  // 31: tabletmanagerdata.RefreshStateResponse
+	(*RunHealthCheckRequest)(nil),                 // 32: tabletmanagerdata.RunHealthCheckRequest
+	(*RunHealthCheckResponse)(nil),                // 33: tabletmanagerdata.RunHealthCheckResponse
+	(*IgnoreHealthErrorRequest)(nil),              // 34: tabletmanagerdata.IgnoreHealthErrorRequest
+	(*IgnoreHealthErrorResponse)(nil),             // 35: tabletmanagerdata.IgnoreHealthErrorResponse
+	(*ReloadSchemaRequest)(nil),                   // 36: tabletmanagerdata.ReloadSchemaRequest
+	(*ReloadSchemaResponse)(nil),                  // 37: tabletmanagerdata.ReloadSchemaResponse
+	(*PreflightSchemaRequest)(nil),                // 38: tabletmanagerdata.PreflightSchemaRequest
+	(*PreflightSchemaResponse)(nil),               // 39: tabletmanagerdata.PreflightSchemaResponse
+	(*ApplySchemaRequest)(nil),                    // 40: tabletmanagerdata.ApplySchemaRequest
+	(*ApplySchemaResponse)(nil),                   // 41: tabletmanagerdata.ApplySchemaResponse
+	(*LockTablesRequest)(nil),                     // 42: tabletmanagerdata.LockTablesRequest
+	(*LockTablesResponse)(nil),                    // 43: tabletmanagerdata.LockTablesResponse
+	(*UnlockTablesRequest)(nil),                   // 44: tabletmanagerdata.UnlockTablesRequest
+	(*UnlockTablesResponse)(nil),                  // 45: tabletmanagerdata.UnlockTablesResponse
+	(*ExecuteQueryRequest)(nil),                   // 46: tabletmanagerdata.ExecuteQueryRequest
+	(*ExecuteQueryResponse)(nil),                  // 47: tabletmanagerdata.ExecuteQueryResponse
+	(*ExecuteFetchAsDbaRequest)(nil),              // 48: tabletmanagerdata.ExecuteFetchAsDbaRequest
+	(*ExecuteFetchAsDbaResponse)(nil),             // 49: tabletmanagerdata.ExecuteFetchAsDbaResponse
+	(*ExecuteFetchAsAllPrivsRequest)(nil),         // 50: tabletmanagerdata.ExecuteFetchAsAllPrivsRequest
+	(*ExecuteFetchAsAllPrivsResponse)(nil),        // 51: tabletmanagerdata.ExecuteFetchAsAllPrivsResponse
+	(*ExecuteFetchAsAppRequest)(nil),              // 52: tabletmanagerdata.ExecuteFetchAsAppRequest
+	(*ExecuteFetchAsAppResponse)(nil),             // 53: tabletmanagerdata.ExecuteFetchAsAppResponse
+	(*KillQueriesRequest)(nil),                    // 54: tabletmanagerdata.KillQueriesRequest
+	(*KillQueriesResponse)(nil),                   // 55: tabletmanagerdata.KillQueriesResponse
+	(*ReplicationStatusRequest)(nil),              // 56: tabletmanagerdata.ReplicationStatusRequest
+	(*ReplicationStatusResponse)(nil),             // 57: tabletmanagerdata.ReplicationStatusResponse
+	(*PrimaryStatusRequest)(nil),                  // 58: tabletmanagerdata.PrimaryStatusRequest
+	(*PrimaryStatusResponse)(nil),                 // 59: tabletmanagerdata.PrimaryStatusResponse
+	(*PrimaryPositionRequest)(nil),                // 60: tabletmanagerdata.PrimaryPositionRequest
+	(*PrimaryPositionResponse)(nil),               // 61: tabletmanagerdata.PrimaryPositionResponse
+	(*WaitForPositionRequest)(nil),                // 62: tabletmanagerdata.WaitForPositionRequest
+	(*WaitForPositionResponse)(nil),               // 63: tabletmanagerdata.WaitForPositionResponse
+	(*StopReplicationRequest)(nil),                // 64: tabletmanagerdata.StopReplicationRequest
+	(*StopReplicationResponse)(nil),               // 65: tabletmanagerdata.StopReplicationResponse
+	(*StopReplicationMinimumRequest)(nil),         // 66: tabletmanagerdata.StopReplicationMinimumRequest
+	(*StopReplicationMinimumResponse)(nil),        // 67: tabletmanagerdata.StopReplicationMinimumResponse
+	(*StartReplicationRequest)(nil),               // 68: tabletmanagerdata.StartReplicationRequest
+	(*StartReplicationResponse)(nil),              // 69: tabletmanagerdata.StartReplicationResponse
+	(*StartReplicationUntilAfterRequest)(nil),     // 70: tabletmanagerdata.StartReplicationUntilAfterRequest
+	(*StartReplicationUntilAfterResponse)(nil),    // 71: tabletmanagerdata.StartReplicationUntilAfterResponse
+	(*GetReplicasRequest)(nil),                    // 72: tabletmanagerdata.GetReplicasRequest
+	(*GetReplicasResponse)(nil),                   // 73: tabletmanagerdata.GetReplicasResponse
+	(*ResetReplicationRequest)(nil),               // 74: tabletmanagerdata.ResetReplicationRequest
+	(*ResetReplicationResponse)(nil),              // 75: tabletmanagerdata.ResetReplicationResponse
+	(*VReplicationExecRequest)(nil),               // 76: tabletmanagerdata.VReplicationExecRequest
+	(*VReplicationExecResponse)(nil),              // 77: tabletmanagerdata.VReplicationExecResponse
+	(*VReplicationWaitForPosRequest)(nil),         // 78: tabletmanagerdata.VReplicationWaitForPosRequest
+	(*VReplicationWaitForPosResponse)(nil),        // 79: tabletmanagerdata.VReplicationWaitForPosResponse
+	(*InitPrimaryRequest)(nil),                    // 80: tabletmanagerdata.InitPrimaryRequest
+	(*InitPrimaryResponse)(nil),                   // 81: tabletmanagerdata.InitPrimaryResponse
+	(*PopulateReparentJournalRequest)(nil),        // 82: tabletmanagerdata.PopulateReparentJournalRequest
+	(*PopulateReparentJournalResponse)(nil),       // 83: tabletmanagerdata.PopulateReparentJournalResponse
+	(*InitReplicaRequest)(nil),                    // 84: tabletmanagerdata.InitReplicaRequest
+	(*InitReplicaResponse)(nil),                   // 85: tabletmanagerdata.InitReplicaResponse
+	(*DemotePrimaryRequest)(nil),                  // 86: tabletmanagerdata.DemotePrimaryRequest
+	(*DemotePrimaryResponse)(nil),                 // 87: tabletmanagerdata.DemotePrimaryResponse
+	(*UndoDemotePrimaryRequest)(nil),              // 88: tabletmanagerdata.UndoDemotePrimaryRequest
+	(*UndoDemotePrimaryResponse)(nil),             // 89: tabletmanagerdata.UndoDemotePrimaryResponse
+	(*ReplicaWasPromotedRequest)(nil),             // 90: tabletmanagerdata.ReplicaWasPromotedRequest
+	(*ReplicaWasPromotedResponse)(nil),            // 91: tabletmanagerdata.ReplicaWasPromotedResponse
+	(*SetReplicationSourceRequest)(nil),           // 92: tabletmanagerdata.SetReplicationSourceRequest
+	(*SetReplicationSourceResponse)(nil),          // 93: tabletmanagerdata.SetReplicationSourceResponse
+	(*SetReplicationDelayRequest)(nil),            // 94: tabletmanagerdata.SetReplicationDelayRequest
+	(*SetReplicationDelayResponse)(nil),           // 95: tabletmanagerdata.SetReplicationDelayResponse
+	(*ReplicaWasRestartedRequest)(nil),            // 96: tabletmanagerdata.ReplicaWasRestartedRequest
+	(*ReplicaWasRestartedResponse)(nil),           // 97: tabletmanagerdata.ReplicaWasRestartedResponse
+	(*StopReplicationAndGetStatusRequest)(nil),    // 98: tabletmanagerdata.StopReplicationAndGetStatusRequest
+	(*StopReplicationAndGetStatusResponse)(nil),   // 99: tabletmanagerdata.StopReplicationAndGetStatusResponse
+	(*PromoteReplicaRequest)(nil),                 // 100: tabletmanagerdata.PromoteReplicaRequest
+	(*PromoteReplicaResponse)(nil),                // 101: tabletmanagerdata.PromoteReplicaResponse
+	(*BackupRequest)(nil),                         // 102: tabletmanagerdata.BackupRequest
+	(*BackupResponse)(nil),                        // 103: tabletmanagerdata.BackupResponse
+	(*RestoreFromBackupRequest)(nil),              // 104: tabletmanagerdata.RestoreFromBackupRequest
+	(*RestoreFromBackupResponse)(nil),             // 105: tabletmanagerdata.RestoreFromBackupResponse
+	(*RestoreProgress)(nil),                       // 106: tabletmanagerdata.RestoreProgress
+	(*GetRestoreProgressRequest)(nil),             // 107: tabletmanagerdata.GetRestoreProgressRequest
+	(*GetRestoreProgressResponse)(nil),            // 108: tabletmanagerdata.GetRestoreProgressResponse
+	(*StreamArchivedBinlogsRequest)(nil),          // 109: tabletmanagerdata.StreamArchivedBinlogsRequest
+	(*StreamArchivedBinlogsResponse)(nil),         // 110: tabletmanagerdata.StreamArchivedBinlogsResponse
+	(*VExecRequest)(nil),                          // 111: tabletmanagerdata.VExecRequest
+	(*VExecResponse)(nil),                         // 112: tabletmanagerdata.VExecResponse
+	(*CheckThrottlerRequest)(nil),                 // 113: tabletmanagerdata.CheckThrottlerRequest
+	(*CheckThrottlerResponse)(nil),                // 114: tabletmanagerdata.CheckThrottlerResponse
+	nil,                                           // 115: tabletmanagerdata.UserPermission.PrivilegesEntry
+	nil,                                           // 116: tabletmanagerdata.DbPermission.PrivilegesEntry
+	nil,                                           // 117: tabletmanagerdata.ExecuteHookRequest.ExtraEnvEntry
+	nil,                                           // 118: tabletmanagerdata.ChangeTagsRequest.TagsEntry
+	nil,                                           // 119: tabletmanagerdata.ChangeTagsResponse.TagsEntry
+	(*CheckThrottlerRequest_Check)(nil),           // 120: tabletmanagerdata.CheckThrottlerRequest.Check
+	(*CheckThrottlerResponse_Result)(nil),         // 121: tabletmanagerdata.CheckThrottlerResponse.Result
+	(*query.Field)(nil),                           // 122: query.Field
+	(*vttime.Duration)(nil),                       // 123: vttime.Duration
+	(*vttime.Time)(nil),                           // 124: vttime.Time
+	(topodata.TabletType)(0),                      // 125: topodata.TabletType
+	(*query.QueryResult)(nil),                     // 126: query.QueryResult
+	(*replicationdata.Status)(nil),                // 127: replicationdata.Status
+	(*replicationdata.PrimaryStatus)(nil),         // 128: replicationdata.PrimaryStatus
+	(*topodata.TabletAlias)(nil),                  // 129: topodata.TabletAlias
+	(replicationdata.StopReplicationMode)(0),      // 130: replicationdata.StopReplicationMode
+	(*replicationdata.StopReplicationStatus)(nil), // 131: replicationdata.StopReplicationStatus
+	(*logutil.Event)(nil),                         // 132: logutil.Event
 }
 var file_tabletmanagerdata_proto_depIdxs = []int32{
-	99,  // 0: tabletmanagerdata.TableDefinition.fields:type_name -> query.Field
+	122, // 0: tabletmanagerdata.TableDefinition.fields:type_name -> query.Field
 	0,   // 1: tabletmanagerdata.SchemaDefinition.table_definitions:type_name -> tabletmanagerdata.TableDefinition
 	1,   // 2: tabletmanagerdata.SchemaChangeResult.before_schema:type_name -> tabletmanagerdata.SchemaDefinition
 	1,   // 3: tabletmanagerdata.SchemaChangeResult.after_schema:type_name -> tabletmanagerdata.SchemaDefinition
-	96,  // 4: tabletmanagerdata.UserPermission.privileges:type_name -> tabletmanagerdata.UserPermission.PrivilegesEntry
-	97,  // 5: tabletmanagerdata.DbPermission.privileges:type_name -> tabletmanagerdata.DbPermission.PrivilegesEntry
+	115, // 4: tabletmanagerdata.UserPermission.privileges:type_name -> tabletmanagerdata.UserPermission.PrivilegesEntry
+	116, // 5: tabletmanagerdata.DbPermission.privileges:type_name -> tabletmanagerdata.DbPermission.PrivilegesEntry
 	3,   // 6: tabletmanagerdata.Permissions.user_permissions:type_name -> tabletmanagerdata.UserPermission
 	4,   // 7: tabletmanagerdata.Permissions.db_permissions:type_name -> tabletmanagerdata.DbPermission
-	98,  // 8: tabletmanagerdata.ExecuteHookRequest.extra_env:type_name -> tabletmanagerdata.ExecuteHookRequest.ExtraEnvEntry
-	1,   // 9: tabletmanagerdata.GetSchemaResponse.schema_definition:type_name -> tabletmanagerdata.SchemaDefinition
-	5,   // 10: tabletmanagerdata.GetPermissionsResponse.permissions:type_name -> tabletmanagerdata.Permissions
-	100, // 11: tabletmanagerdata.ChangeTypeRequest.tablet_type:type_name -> topodata.TabletType
-	2,   // 12: tabletmanagerdata.PreflightSchemaResponse.change_results:type_name -> tabletmanagerdata.SchemaChangeResult
-	1,   // 13: tabletmanagerdata.ApplySchemaRequest.before_schema:type_name -> tabletmanagerdata.SchemaDefinition
-	1,   // 14: tabletmanagerdata.ApplySchemaRequest.after_schema:type_name -> tabletmanagerdata.SchemaDefinition
-	1,   // 15: tabletmanagerdata.ApplySchemaResponse.before_schema:type_name -> tabletmanagerdata.SchemaDefinition
-	1,   // 16: tabletmanagerdata.ApplySchemaResponse.after_schema:type_name -> tabletmanagerdata.SchemaDefinition
-	101, // 17: tabletmanagerdata.ExecuteQueryResponse.result:type_name -> query.QueryResult
-	101, // 18: tabletmanagerdata.ExecuteFetchAsDbaResponse.result:type_name -> query.QueryResult
-	101, // 19: tabletmanagerdata.ExecuteFetchAsAllPrivsResponse.result:type_name -> query.QueryResult
-	101, // 20: tabletmanagerdata.ExecuteFetchAsAppResponse.result:type_name -> query.QueryResult
-	102, // 21: tabletmanagerdata.ReplicationStatusResponse.status:type_name -> replicationdata.Status
-	103, // 22: tabletmanagerdata.PrimaryStatusResponse.status:type_name -> replicationdata.PrimaryStatus
-	101, // 23: tabletmanagerdata.VReplicationExecResponse.result:type_name -> query.QueryResult
-	104, // 24: tabletmanagerdata.PopulateReparentJournalRequest.primary_alias:type_name -> topodata.TabletAlias
-	104, // 25: tabletmanagerdata.InitReplicaRequest.parent:type_name -> topodata.TabletAlias
-	103, // 26: tabletmanagerdata.DemotePrimaryResponse.primary_status:type_name -> replicationdata.PrimaryStatus
-	104, // 27: tabletmanagerdata.SetReplicationSourceRequest.parent:type_name -> topodata.TabletAlias
-	104, // 28: tabletmanagerdata.ReplicaWasRestartedRequest.parent:type_name -> topodata.TabletAlias
-	105, // 29: tabletmanagerdata.StopReplicationAndGetStatusRequest.stop_replication_mode:type_name -> replicationdata.StopReplicationMode
-	102, // 30: tabletmanagerdata.StopReplicationAndGetStatusResponse.hybrid_status:type_name -> replicationdata.Status
-	106, // 31: tabletmanagerdata.StopReplicationAndGetStatusResponse.status:type_name -> replicationdata.StopReplicationStatus
-	107, // 32: tabletmanagerdata.BackupResponse.event:type_name -> logutil.Event
-	107, // 33: tabletmanagerdata.RestoreFromBackupResponse.event:type_name -> logutil.Event
-	101, // 34: tabletmanagerdata.VExecResponse.result:type_name -> query.QueryResult
-	35,  // [35:35] is the sub-list for method output_type
-	35,  // [35:35] is the sub-list for method input_type
-	35,  // [35:35] is the sub-list for extension type_name
-	35,  // [35:35] is the sub-list for extension extendee
-	0,   // [0:35] is the sub-list for field type_name
+	117, // 8: tabletmanagerdata.ExecuteHookRequest.extra_env:type_name -> tabletmanagerdata.ExecuteHookRequest.ExtraEnvEntry
+	123, // 9: tabletmanagerdata.LifecycleHook.timeout:type_name -> vttime.Duration
+	12,  // 10: tabletmanagerdata.LifecycleHookRun.hook:type_name -> tabletmanagerdata.LifecycleHook
+	124, // 11: tabletmanagerdata.LifecycleHookRun.start_time:type_name -> vttime.Time
+	123, // 12: tabletmanagerdata.LifecycleHookRun.duration:type_name -> vttime.Duration
+	12,  // 13: tabletmanagerdata.GetLifecycleHooksResponse.hooks:type_name -> tabletmanagerdata.LifecycleHook
+	13,  // 14: tabletmanagerdata.GetLifecycleHooksResponse.runs:type_name -> tabletmanagerdata.LifecycleHookRun
+	1,   // 15: tabletmanagerdata.GetSchemaResponse.schema_definition:type_name -> tabletmanagerdata.SchemaDefinition
+	5,   // 16: tabletmanagerdata.GetPermissionsResponse.permissions:type_name -> tabletmanagerdata.Permissions
+	125, // 17: tabletmanagerdata.ChangeTypeRequest.tablet_type:type_name -> topodata.TabletType
+	118, // 18: tabletmanagerdata.ChangeTagsRequest.tags:type_name -> tabletmanagerdata.ChangeTagsRequest.TagsEntry
+	119, // 19: tabletmanagerdata.ChangeTagsResponse.tags:type_name -> tabletmanagerdata.ChangeTagsResponse.TagsEntry
+	2,   // 20: tabletmanagerdata.PreflightSchemaResponse.change_results:type_name -> tabletmanagerdata.SchemaChangeResult
+	1,   // 21: tabletmanagerdata.ApplySchemaRequest.before_schema:type_name -> tabletmanagerdata.SchemaDefinition
+	1,   // 22: tabletmanagerdata.ApplySchemaRequest.after_schema:type_name -> tabletmanagerdata.SchemaDefinition
+	1,   // 23: tabletmanagerdata.ApplySchemaResponse.before_schema:type_name -> tabletmanagerdata.SchemaDefinition
+	1,   // 24: tabletmanagerdata.ApplySchemaResponse.after_schema:type_name -> tabletmanagerdata.SchemaDefinition
+	126, // 25: tabletmanagerdata.ExecuteQueryResponse.result:type_name -> query.QueryResult
+	126, // 26: tabletmanagerdata.ExecuteFetchAsDbaResponse.result:type_name -> query.QueryResult
+	126, // 27: tabletmanagerdata.ExecuteFetchAsAllPrivsResponse.result:type_name -> query.QueryResult
+	126, // 28: tabletmanagerdata.ExecuteFetchAsAppResponse.result:type_name -> query.QueryResult
+	127, // 29: tabletmanagerdata.ReplicationStatusResponse.status:type_name -> replicationdata.Status
+	128, // 30: tabletmanagerdata.PrimaryStatusResponse.status:type_name -> replicationdata.PrimaryStatus
+	126, // 31: tabletmanagerdata.VReplicationExecResponse.result:type_name -> query.QueryResult
+	129, // 32: tabletmanagerdata.PopulateReparentJournalRequest.primary_alias:type_name -> topodata.TabletAlias
+	129, // 33: tabletmanagerdata.InitReplicaRequest.parent:type_name -> topodata.TabletAlias
+	128, // 34: tabletmanagerdata.DemotePrimaryResponse.primary_status:type_name -> replicationdata.PrimaryStatus
+	129, // 35: tabletmanagerdata.SetReplicationSourceRequest.parent:type_name -> topodata.TabletAlias
+	129, // 36: tabletmanagerdata.ReplicaWasRestartedRequest.parent:type_name -> topodata.TabletAlias
+	130, // 37: tabletmanagerdata.StopReplicationAndGetStatusRequest.stop_replication_mode:type_name -> replicationdata.StopReplicationMode
+	127, // 38: tabletmanagerdata.StopReplicationAndGetStatusResponse.hybrid_status:type_name -> replicationdata.Status
+	131, // 39: tabletmanagerdata.StopReplicationAndGetStatusResponse.status:type_name -> replicationdata.StopReplicationStatus
+	132, // 40: tabletmanagerdata.BackupResponse.event:type_name -> logutil.Event
+	132, // 41: tabletmanagerdata.RestoreFromBackupResponse.event:type_name -> logutil.Event
+	123, // 42: tabletmanagerdata.RestoreProgress.elapsed:type_name -> vttime.Duration
+	123, // 43: tabletmanagerdata.RestoreProgress.eta:type_name -> vttime.Duration
+	106, // 44: tabletmanagerdata.GetRestoreProgressResponse.progress:type_name -> tabletmanagerdata.RestoreProgress
+	126, // 45: tabletmanagerdata.VExecResponse.result:type_name -> query.QueryResult
+	120, // 46: tabletmanagerdata.CheckThrottlerRequest.checks:type_name -> tabletmanagerdata.CheckThrottlerRequest.Check
+	121, // 47: tabletmanagerdata.CheckThrottlerResponse.results:type_name -> tabletmanagerdata.CheckThrottlerResponse.Result
+	48,  // [48:48] is the sub-list for method output_type
+	48,  // [48:48] is the sub-list for method input_type
+	48,  // [48:48] is the sub-list for extension type_name
+	48,  // [48:48] is the sub-list for extension extendee
+	0,   // [0:48] is the sub-list for field type_name
 }
 
 func init() { file_tabletmanagerdata_proto_init() }
@@ -5416,7 +6878,7 @@ func file_tabletmanagerdata_proto_init() {
 			}
 		}
 		file_tabletmanagerdata_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSchemaRequest); i {
+			switch v := v.(*LifecycleHook); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5428,7 +6890,7 @@ func file_tabletmanagerdata_proto_init() {
 			}
 		}
 		file_tabletmanagerdata_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSchemaResponse); i {
+			switch v := v.(*LifecycleHookRun); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5440,7 +6902,7 @@ func file_tabletmanagerdata_proto_init() {
 			}
 		}
 		file_tabletmanagerdata_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPermissionsRequest); i {
+			switch v := v.(*GetLifecycleHooksRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5452,7 +6914,7 @@ func file_tabletmanagerdata_proto_init() {
 			}
 		}
 		file_tabletmanagerdata_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPermissionsResponse); i {
+			switch v := v.(*GetLifecycleHooksResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5464,7 +6926,7 @@ func file_tabletmanagerdata_proto_init() {
 			}
 		}
 		file_tabletmanagerdata_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetReadOnlyRequest); i {
+			switch v := v.(*GetVersionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5476,7 +6938,7 @@ func file_tabletmanagerdata_proto_init() {
 			}
 		}
 		file_tabletmanagerdata_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetReadOnlyResponse); i {
+			switch v := v.(*GetVersionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5488,7 +6950,7 @@ func file_tabletmanagerdata_proto_init() {
 			}
 		}
 		file_tabletmanagerdata_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetReadWriteRequest); i {
+			switch v := v.(*GetSchemaRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5500,7 +6962,7 @@ func file_tabletmanagerdata_proto_init() {
 			}
 		}
 		file_tabletmanagerdata_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetReadWriteResponse); i {
+			switch v := v.(*GetSchemaResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5512,7 +6974,7 @@ func file_tabletmanagerdata_proto_init() {
 			}
 		}
 		file_tabletmanagerdata_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChangeTypeRequest); i {
+			switch v := v.(*GetPermissionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5524,7 +6986,7 @@ func file_tabletmanagerdata_proto_init() {
 			}
 		}
 		file_tabletmanagerdata_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChangeTypeResponse); i {
+			switch v := v.(*GetPermissionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5536,7 +6998,7 @@ func file_tabletmanagerdata_proto_init() {
 			}
 		}
 		file_tabletmanagerdata_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RefreshStateRequest); i {
+			switch v := v.(*SetReadOnlyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5548,7 +7010,7 @@ func file_tabletmanagerdata_proto_init() {
 			}
 		}
 		file_tabletmanagerdata_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RefreshStateResponse); i {
+			switch v := v.(*SetReadOnlyResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5560,7 +7022,7 @@ func file_tabletmanagerdata_proto_init() {
 			}
 		}
 		file_tabletmanagerdata_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RunHealthCheckRequest); i {
+			switch v := v.(*SetReadWriteRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5572,7 +7034,7 @@ func file_tabletmanagerdata_proto_init() {
 			}
 		}
 		file_tabletmanagerdata_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RunHealthCheckResponse); i {
+			switch v := v.(*SetReadWriteResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5584,7 +7046,7 @@ func file_tabletmanagerdata_proto_init() {
 			}
 		}
 		file_tabletmanagerdata_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IgnoreHealthErrorRequest); i {
+			switch v := v.(*ChangeTypeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5596,7 +7058,7 @@ func file_tabletmanagerdata_proto_init() {
 			}
 		}
 		file_tabletmanagerdata_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IgnoreHealthErrorResponse); i {
+			switch v := v.(*ChangeTypeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5608,6 +7070,102 @@ func file_tabletmanagerdata_proto_init() {
 			}
 		}
 		file_tabletmanagerdata_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeTagsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tabletmanagerdata_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeTagsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tabletmanagerdata_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefreshStateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tabletmanagerdata_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefreshStateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tabletmanagerdata_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RunHealthCheckRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tabletmanagerdata_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RunHealthCheckResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tabletmanagerdata_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IgnoreHealthErrorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tabletmanagerdata_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IgnoreHealthErrorResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tabletmanagerdata_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReloadSchemaRequest); i {
 			case 0:
 				return &v.state
@@ -5619,7 +7177,7 @@ func file_tabletmanagerdata_proto_init() {
 				return nil
 			}
 		}
-		file_tabletmanagerdata_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+		file_tabletmanagerdata_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReloadSchemaResponse); i {
 			case 0:
 				return &v.state
@@ -5631,7 +7189,7 @@ func file_tabletmanagerdata_proto_init() {
 				return nil
 			}
 		}
-		file_tabletmanagerdata_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+		file_tabletmanagerdata_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PreflightSchemaRequest); i {
 			case 0:
 				return &v.state
@@ -5643,7 +7201,7 @@ func file_tabletmanagerdata_proto_init() {
 				return nil
 			}
 		}
-		file_tabletmanagerdata_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+		file_tabletmanagerdata_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PreflightSchemaResponse); i {
 			case 0:
 				return &v.state
@@ -5655,7 +7213,7 @@ func file_tabletmanagerdata_proto_init() {
 				return nil
 			}
 		}
-		file_tabletmanagerdata_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+		file_tabletmanagerdata_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ApplySchemaRequest); i {
 			case 0:
 				return &v.state
@@ -5667,7 +7225,7 @@ func file_tabletmanagerdata_proto_init() {
 				return nil
 			}
 		}
-		file_tabletmanagerdata_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+		file_tabletmanagerdata_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ApplySchemaResponse); i {
 			case 0:
 				return &v.state
@@ -5679,7 +7237,7 @@ func file_tabletmanagerdata_proto_init() {
 				return nil
 			}
 		}
-		file_tabletmanagerdata_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+		file_tabletmanagerdata_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LockTablesRequest); i {
 			case 0:
 				return &v.state
@@ -5691,7 +7249,7 @@ func file_tabletmanagerdata_proto_init() {
 				return nil
 			}
 		}
-		file_tabletmanagerdata_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+		file_tabletmanagerdata_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LockTablesResponse); i {
 			case 0:
 				return &v.state
@@ -5703,7 +7261,7 @@ func file_tabletmanagerdata_proto_init() {
 				return nil
 			}
 		}
-		file_tabletmanagerdata_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+		file_tabletmanagerdata_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UnlockTablesRequest); i {
 			case 0:
 				return &v.state
@@ -5715,7 +7273,7 @@ func file_tabletmanagerdata_proto_init() {
 				return nil
 			}
 		}
-		file_tabletmanagerdata_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+		file_tabletmanagerdata_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UnlockTablesResponse); i {
 			case 0:
 				return &v.state
@@ -5727,7 +7285,7 @@ func file_tabletmanagerdata_proto_init() {
 				return nil
 			}
 		}
-		file_tabletmanagerdata_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+		file_tabletmanagerdata_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ExecuteQueryRequest); i {
 			case 0:
 				return &v.state
@@ -5739,7 +7297,7 @@ func file_tabletmanagerdata_proto_init() {
 				return nil
 			}
 		}
-		file_tabletmanagerdata_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+		file_tabletmanagerdata_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ExecuteQueryResponse); i {
 			case 0:
 				return &v.state
@@ -5751,7 +7309,7 @@ func file_tabletmanagerdata_proto_init() {
 				return nil
 			}
 		}
-		file_tabletmanagerdata_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+		file_tabletmanagerdata_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ExecuteFetchAsDbaRequest); i {
 			case 0:
 				return &v.state
@@ -5763,7 +7321,7 @@ func file_tabletmanagerdata_proto_init() {
 				return nil
 			}
 		}
-		file_tabletmanagerdata_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+		file_tabletmanagerdata_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ExecuteFetchAsDbaResponse); i {
 			case 0:
 				return &v.state
@@ -5775,7 +7333,7 @@ func file_tabletmanagerdata_proto_init() {
 				return nil
 			}
 		}
-		file_tabletmanagerdata_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+		file_tabletmanagerdata_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ExecuteFetchAsAllPrivsRequest); i {
 			case 0:
 				return &v.state
@@ -5787,7 +7345,7 @@ func file_tabletmanagerdata_proto_init() {
 				return nil
 			}
 		}
-		file_tabletmanagerdata_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+		file_tabletmanagerdata_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ExecuteFetchAsAllPrivsResponse); i {
 			case 0:
 				return &v.state
@@ -5799,7 +7357,7 @@ func file_tabletmanagerdata_proto_init() {
 				return nil
 			}
 		}
-		file_tabletmanagerdata_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+		file_tabletmanagerdata_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ExecuteFetchAsAppRequest); i {
 			case 0:
 				return &v.state
@@ -5811,7 +7369,7 @@ func file_tabletmanagerdata_proto_init() {
 				return nil
 			}
 		}
-		file_tabletmanagerdata_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+		file_tabletmanagerdata_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ExecuteFetchAsAppResponse); i {
 			case 0:
 				return &v.state
@@ -5823,7 +7381,31 @@ func file_tabletmanagerdata_proto_init() {
 				return nil
 			}
 		}
-		file_tabletmanagerdata_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+		file_tabletmanagerdata_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KillQueriesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tabletmanagerdata_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KillQueriesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tabletmanagerdata_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReplicationStatusRequest); i {
 			case 0:
 				return &v.state
@@ -5835,7 +7417,7 @@ func file_tabletmanagerdata_proto_init() {
 				return nil
 			}
 		}
-		file_tabletmanagerdata_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+		file_tabletmanagerdata_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReplicationStatusResponse); i {
 			case 0:
 				return &v.state
@@ -5847,7 +7429,7 @@ func file_tabletmanagerdata_proto_init() {
 				return nil
 			}
 		}
-		file_tabletmanagerdata_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+		file_tabletmanagerdata_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PrimaryStatusRequest); i {
 			case 0:
 				return &v.state
@@ -5859,7 +7441,7 @@ func file_tabletmanagerdata_proto_init() {
 				return nil
 			}
 		}
-		file_tabletmanagerdata_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+		file_tabletmanagerdata_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PrimaryStatusResponse); i {
 			case 0:
 				return &v.state
@@ -5871,7 +7453,7 @@ func file_tabletmanagerdata_proto_init() {
 				return nil
 			}
 		}
-		file_tabletmanagerdata_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+		file_tabletmanagerdata_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PrimaryPositionRequest); i {
 			case 0:
 				return &v.state
@@ -5883,7 +7465,7 @@ func file_tabletmanagerdata_proto_init() {
 				return nil
 			}
 		}
-		file_tabletmanagerdata_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+		file_tabletmanagerdata_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PrimaryPositionResponse); i {
 			case 0:
 				return &v.state
@@ -5895,7 +7477,7 @@ func file_tabletmanagerdata_proto_init() {
 				return nil
 			}
 		}
-		file_tabletmanagerdata_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+		file_tabletmanagerdata_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*WaitForPositionRequest); i {
 			case 0:
 				return &v.state
@@ -5907,7 +7489,7 @@ func file_tabletmanagerdata_proto_init() {
 				return nil
 			}
 		}
-		file_tabletmanagerdata_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+		file_tabletmanagerdata_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*WaitForPositionResponse); i {
 			case 0:
 				return &v.state
@@ -5919,7 +7501,7 @@ func file_tabletmanagerdata_proto_init() {
 				return nil
 			}
 		}
-		file_tabletmanagerdata_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+		file_tabletmanagerdata_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StopReplicationRequest); i {
 			case 0:
 				return &v.state
@@ -5931,7 +7513,7 @@ func file_tabletmanagerdata_proto_init() {
 				return nil
 			}
 		}
-		file_tabletmanagerdata_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+		file_tabletmanagerdata_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StopReplicationResponse); i {
 			case 0:
 				return &v.state
@@ -5943,7 +7525,7 @@ func file_tabletmanagerdata_proto_init() {
 				return nil
 			}
 		}
-		file_tabletmanagerdata_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+		file_tabletmanagerdata_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StopReplicationMinimumRequest); i {
 			case 0:
 				return &v.state
@@ -5955,7 +7537,7 @@ func file_tabletmanagerdata_proto_init() {
 				return nil
 			}
 		}
-		file_tabletmanagerdata_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+		file_tabletmanagerdata_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StopReplicationMinimumResponse); i {
 			case 0:
 				return &v.state
@@ -5967,7 +7549,7 @@ func file_tabletmanagerdata_proto_init() {
 				return nil
 			}
 		}
-		file_tabletmanagerdata_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+		file_tabletmanagerdata_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StartReplicationRequest); i {
 			case 0:
 				return &v.state
@@ -5979,7 +7561,7 @@ func file_tabletmanagerdata_proto_init() {
 				return nil
 			}
 		}
-		file_tabletmanagerdata_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+		file_tabletmanagerdata_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StartReplicationResponse); i {
 			case 0:
 				return &v.state
@@ -5991,7 +7573,7 @@ func file_tabletmanagerdata_proto_init() {
 				return nil
 			}
 		}
-		file_tabletmanagerdata_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+		file_tabletmanagerdata_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StartReplicationUntilAfterRequest); i {
 			case 0:
 				return &v.state
@@ -6003,7 +7585,7 @@ func file_tabletmanagerdata_proto_init() {
 				return nil
 			}
 		}
-		file_tabletmanagerdata_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+		file_tabletmanagerdata_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StartReplicationUntilAfterResponse); i {
 			case 0:
 				return &v.state
@@ -6015,7 +7597,7 @@ func file_tabletmanagerdata_proto_init() {
 				return nil
 			}
 		}
-		file_tabletmanagerdata_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+		file_tabletmanagerdata_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetReplicasRequest); i {
 			case 0:
 				return &v.state
@@ -6027,7 +7609,7 @@ func file_tabletmanagerdata_proto_init() {
 				return nil
 			}
 		}
-		file_tabletmanagerdata_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+		file_tabletmanagerdata_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetReplicasResponse); i {
 			case 0:
 				return &v.state
@@ -6039,7 +7621,7 @@ func file_tabletmanagerdata_proto_init() {
 				return nil
 			}
 		}
-		file_tabletmanagerdata_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+		file_tabletmanagerdata_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ResetReplicationRequest); i {
 			case 0:
 				return &v.state
@@ -6051,7 +7633,7 @@ func file_tabletmanagerdata_proto_init() {
 				return nil
 			}
 		}
-		file_tabletmanagerdata_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+		file_tabletmanagerdata_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ResetReplicationResponse); i {
 			case 0:
 				return &v.state
@@ -6063,7 +7645,7 @@ func file_tabletmanagerdata_proto_init() {
 				return nil
 			}
 		}
-		file_tabletmanagerdata_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+		file_tabletmanagerdata_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*VReplicationExecRequest); i {
 			case 0:
 				return &v.state
@@ -6075,7 +7657,7 @@ func file_tabletmanagerdata_proto_init() {
 				return nil
 			}
 		}
-		file_tabletmanagerdata_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+		file_tabletmanagerdata_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*VReplicationExecResponse); i {
 			case 0:
 				return &v.state
@@ -6087,7 +7669,7 @@ func file_tabletmanagerdata_proto_init() {
 				return nil
 			}
 		}
-		file_tabletmanagerdata_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+		file_tabletmanagerdata_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*VReplicationWaitForPosRequest); i {
 			case 0:
 				return &v.state
@@ -6099,7 +7681,7 @@ func file_tabletmanagerdata_proto_init() {
 				return nil
 			}
 		}
-		file_tabletmanagerdata_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+		file_tabletmanagerdata_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*VReplicationWaitForPosResponse); i {
 			case 0:
 				return &v.state
@@ -6111,7 +7693,7 @@ func file_tabletmanagerdata_proto_init() {
 				return nil
 			}
 		}
-		file_tabletmanagerdata_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+		file_tabletmanagerdata_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*InitPrimaryRequest); i {
 			case 0:
 				return &v.state
@@ -6123,7 +7705,7 @@ func file_tabletmanagerdata_proto_init() {
 				return nil
 			}
 		}
-		file_tabletmanagerdata_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+		file_tabletmanagerdata_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*InitPrimaryResponse); i {
 			case 0:
 				return &v.state
@@ -6135,7 +7717,7 @@ func file_tabletmanagerdata_proto_init() {
 				return nil
 			}
 		}
-		file_tabletmanagerdata_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+		file_tabletmanagerdata_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PopulateReparentJournalRequest); i {
 			case 0:
 				return &v.state
@@ -6147,7 +7729,7 @@ func file_tabletmanagerdata_proto_init() {
 				return nil
 			}
 		}
-		file_tabletmanagerdata_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+		file_tabletmanagerdata_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PopulateReparentJournalResponse); i {
 			case 0:
 				return &v.state
@@ -6159,7 +7741,7 @@ func file_tabletmanagerdata_proto_init() {
 				return nil
 			}
 		}
-		file_tabletmanagerdata_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+		file_tabletmanagerdata_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*InitReplicaRequest); i {
 			case 0:
 				return &v.state
@@ -6171,7 +7753,7 @@ func file_tabletmanagerdata_proto_init() {
 				return nil
 			}
 		}
-		file_tabletmanagerdata_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+		file_tabletmanagerdata_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*InitReplicaResponse); i {
 			case 0:
 				return &v.state
@@ -6183,7 +7765,7 @@ func file_tabletmanagerdata_proto_init() {
 				return nil
 			}
 		}
-		file_tabletmanagerdata_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+		file_tabletmanagerdata_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DemotePrimaryRequest); i {
 			case 0:
 				return &v.state
@@ -6195,7 +7777,7 @@ func file_tabletmanagerdata_proto_init() {
 				return nil
 			}
 		}
-		file_tabletmanagerdata_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+		file_tabletmanagerdata_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DemotePrimaryResponse); i {
 			case 0:
 				return &v.state
@@ -6207,7 +7789,7 @@ func file_tabletmanagerdata_proto_init() {
 				return nil
 			}
 		}
-		file_tabletmanagerdata_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+		file_tabletmanagerdata_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UndoDemotePrimaryRequest); i {
 			case 0:
 				return &v.state
@@ -6219,7 +7801,7 @@ func file_tabletmanagerdata_proto_init() {
 				return nil
 			}
 		}
-		file_tabletmanagerdata_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+		file_tabletmanagerdata_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UndoDemotePrimaryResponse); i {
 			case 0:
 				return &v.state
@@ -6231,7 +7813,7 @@ func file_tabletmanagerdata_proto_init() {
 				return nil
 			}
 		}
-		file_tabletmanagerdata_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+		file_tabletmanagerdata_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReplicaWasPromotedRequest); i {
 			case 0:
 				return &v.state
@@ -6243,7 +7825,7 @@ func file_tabletmanagerdata_proto_init() {
 				return nil
 			}
 		}
-		file_tabletmanagerdata_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+		file_tabletmanagerdata_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReplicaWasPromotedResponse); i {
 			case 0:
 				return &v.state
@@ -6255,7 +7837,7 @@ func file_tabletmanagerdata_proto_init() {
 				return nil
 			}
 		}
-		file_tabletmanagerdata_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+		file_tabletmanagerdata_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SetReplicationSourceRequest); i {
 			case 0:
 				return &v.state
@@ -6267,7 +7849,7 @@ func file_tabletmanagerdata_proto_init() {
 				return nil
 			}
 		}
-		file_tabletmanagerdata_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+		file_tabletmanagerdata_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SetReplicationSourceResponse); i {
 			case 0:
 				return &v.state
@@ -6279,7 +7861,31 @@ func file_tabletmanagerdata_proto_init() {
 				return nil
 			}
 		}
-		file_tabletmanagerdata_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+		file_tabletmanagerdata_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetReplicationDelayRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tabletmanagerdata_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetReplicationDelayResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tabletmanagerdata_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReplicaWasRestartedRequest); i {
 			case 0:
 				return &v.state
@@ -6291,7 +7897,7 @@ func file_tabletmanagerdata_proto_init() {
 				return nil
 			}
 		}
-		file_tabletmanagerdata_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+		file_tabletmanagerdata_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReplicaWasRestartedResponse); i {
 			case 0:
 				return &v.state
@@ -6303,7 +7909,7 @@ func file_tabletmanagerdata_proto_init() {
 				return nil
 			}
 		}
-		file_tabletmanagerdata_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+		file_tabletmanagerdata_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StopReplicationAndGetStatusRequest); i {
 			case 0:
 				return &v.state
@@ -6315,7 +7921,7 @@ func file_tabletmanagerdata_proto_init() {
 				return nil
 			}
 		}
-		file_tabletmanagerdata_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+		file_tabletmanagerdata_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StopReplicationAndGetStatusResponse); i {
 			case 0:
 				return &v.state
@@ -6327,7 +7933,7 @@ func file_tabletmanagerdata_proto_init() {
 				return nil
 			}
 		}
-		file_tabletmanagerdata_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+		file_tabletmanagerdata_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PromoteReplicaRequest); i {
 			case 0:
 				return &v.state
@@ -6339,7 +7945,7 @@ func file_tabletmanagerdata_proto_init() {
 				return nil
 			}
 		}
-		file_tabletmanagerdata_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+		file_tabletmanagerdata_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PromoteReplicaResponse); i {
 			case 0:
 				return &v.state
@@ -6351,7 +7957,7 @@ func file_tabletmanagerdata_proto_init() {
 				return nil
 			}
 		}
-		file_tabletmanagerdata_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+		file_tabletmanagerdata_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BackupRequest); i {
 			case 0:
 				return &v.state
@@ -6363,7 +7969,7 @@ func file_tabletmanagerdata_proto_init() {
 				return nil
 			}
 		}
-		file_tabletmanagerdata_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+		file_tabletmanagerdata_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BackupResponse); i {
 			case 0:
 				return &v.state
@@ -6375,7 +7981,7 @@ func file_tabletmanagerdata_proto_init() {
 				return nil
 			}
 		}
-		file_tabletmanagerdata_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+		file_tabletmanagerdata_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RestoreFromBackupRequest); i {
 			case 0:
 				return &v.state
@@ -6387,7 +7993,7 @@ func file_tabletmanagerdata_proto_init() {
 				return nil
 			}
 		}
-		file_tabletmanagerdata_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+		file_tabletmanagerdata_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RestoreFromBackupResponse); i {
 			case 0:
 				return &v.state
@@ -6399,7 +8005,67 @@ func file_tabletmanagerdata_proto_init() {
 				return nil
 			}
 		}
-		file_tabletmanagerdata_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+		file_tabletmanagerdata_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreProgress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tabletmanagerdata_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRestoreProgressRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tabletmanagerdata_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRestoreProgressResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tabletmanagerdata_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamArchivedBinlogsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tabletmanagerdata_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamArchivedBinlogsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tabletmanagerdata_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*VExecRequest); i {
 			case 0:
 				return &v.state
@@ -6411,7 +8077,7 @@ func file_tabletmanagerdata_proto_init() {
 				return nil
 			}
 		}
-		file_tabletmanagerdata_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+		file_tabletmanagerdata_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*VExecResponse); i {
 			case 0:
 				return &v.state
@@ -6423,6 +8089,54 @@ func file_tabletmanagerdata_proto_init() {
 				return nil
 			}
 		}
+		file_tabletmanagerdata_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckThrottlerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tabletmanagerdata_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckThrottlerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tabletmanagerdata_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckThrottlerRequest_Check); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tabletmanagerdata_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckThrottlerResponse_Result); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -6430,7 +8144,7 @@ func file_tabletmanagerdata_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_tabletmanagerdata_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   99,
+			NumMessages:   122,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
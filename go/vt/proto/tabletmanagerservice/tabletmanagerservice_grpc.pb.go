@@ -25,6 +25,11 @@ type TabletManagerClient interface {
 	Sleep(ctx context.Context, in *tabletmanagerdata.SleepRequest, opts ...grpc.CallOption) (*tabletmanagerdata.SleepResponse, error)
 	// ExecuteHook executes the hook remotely
 	ExecuteHook(ctx context.Context, in *tabletmanagerdata.ExecuteHookRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ExecuteHookResponse, error)
+	// GetLifecycleHooks returns the lifecycle hooks of the tablet and their
+	// recent executions
+	GetLifecycleHooks(ctx context.Context, in *tabletmanagerdata.GetLifecycleHooksRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetLifecycleHooksResponse, error)
+	// GetVersion returns the version of the mysqld of the tablet
+	GetVersion(ctx context.Context, in *tabletmanagerdata.GetVersionRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetVersionResponse, error)
 	// GetSchema asks the tablet for its schema
 	GetSchema(ctx context.Context, in *tabletmanagerdata.GetSchemaRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetSchemaResponse, error)
 	// GetPermissions asks the tablet for its permissions
@@ -33,6 +38,8 @@ type TabletManagerClient interface {
 	SetReadWrite(ctx context.Context, in *tabletmanagerdata.SetReadWriteRequest, opts ...grpc.CallOption) (*tabletmanagerdata.SetReadWriteResponse, error)
 	// ChangeType asks the remote tablet to change its type
 	ChangeType(ctx context.Context, in *tabletmanagerdata.ChangeTypeRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ChangeTypeResponse, error)
+	// ChangeTags asks the remote tablet to change its tags
+	ChangeTags(ctx context.Context, in *tabletmanagerdata.ChangeTagsRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ChangeTagsResponse, error)
 	RefreshState(ctx context.Context, in *tabletmanagerdata.RefreshStateRequest, opts ...grpc.CallOption) (*tabletmanagerdata.RefreshStateResponse, error)
 	RunHealthCheck(ctx context.Context, in *tabletmanagerdata.RunHealthCheckRequest, opts ...grpc.CallOption) (*tabletmanagerdata.RunHealthCheckResponse, error)
 	IgnoreHealthError(ctx context.Context, in *tabletmanagerdata.IgnoreHealthErrorRequest, opts ...grpc.CallOption) (*tabletmanagerdata.IgnoreHealthErrorResponse, error)
@@ -45,6 +52,9 @@ type TabletManagerClient interface {
 	ExecuteFetchAsDba(ctx context.Context, in *tabletmanagerdata.ExecuteFetchAsDbaRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ExecuteFetchAsDbaResponse, error)
 	ExecuteFetchAsAllPrivs(ctx context.Context, in *tabletmanagerdata.ExecuteFetchAsAllPrivsRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ExecuteFetchAsAllPrivsResponse, error)
 	ExecuteFetchAsApp(ctx context.Context, in *tabletmanagerdata.ExecuteFetchAsAppRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ExecuteFetchAsAppResponse, error)
+	// KillQueries kills the in-flight queries of a fingerprint, of a user, or
+	// of a user with a fingerprint
+	KillQueries(ctx context.Context, in *tabletmanagerdata.KillQueriesRequest, opts ...grpc.CallOption) (*tabletmanagerdata.KillQueriesResponse, error)
 	// ReplicationStatus returns the current replication status.
 	ReplicationStatus(ctx context.Context, in *tabletmanagerdata.ReplicationStatusRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ReplicationStatusResponse, error)
 	// MasterStatus returns the current primary status.
@@ -97,6 +107,9 @@ type TabletManagerClient interface {
 	SetMaster(ctx context.Context, in *tabletmanagerdata.SetReplicationSourceRequest, opts ...grpc.CallOption) (*tabletmanagerdata.SetReplicationSourceResponse, error)
 	// SetReplicationSource tells the replica to reparent
 	SetReplicationSource(ctx context.Context, in *tabletmanagerdata.SetReplicationSourceRequest, opts ...grpc.CallOption) (*tabletmanagerdata.SetReplicationSourceResponse, error)
+	// SetReplicationDelay makes the replica a delayed replica, or a regular
+	// replica again
+	SetReplicationDelay(ctx context.Context, in *tabletmanagerdata.SetReplicationDelayRequest, opts ...grpc.CallOption) (*tabletmanagerdata.SetReplicationDelayResponse, error)
 	// ReplicaWasRestarted tells the remote tablet its primary has changed
 	ReplicaWasRestarted(ctx context.Context, in *tabletmanagerdata.ReplicaWasRestartedRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ReplicaWasRestartedResponse, error)
 	// StopReplicationAndGetStatus stops MySQL replication, and returns the
@@ -107,8 +120,17 @@ type TabletManagerClient interface {
 	Backup(ctx context.Context, in *tabletmanagerdata.BackupRequest, opts ...grpc.CallOption) (TabletManager_BackupClient, error)
 	// RestoreFromBackup deletes all local data and restores it from the latest backup.
 	RestoreFromBackup(ctx context.Context, in *tabletmanagerdata.RestoreFromBackupRequest, opts ...grpc.CallOption) (TabletManager_RestoreFromBackupClient, error)
+	// GetRestoreProgress returns the progress of the restore from backup of
+	// the tablet.
+	GetRestoreProgress(ctx context.Context, in *tabletmanagerdata.GetRestoreProgressRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetRestoreProgressResponse, error)
+	// StreamArchivedBinlogs streams the binlogs archived in the backup storage
+	// for the shard of the tablet, from the segment that contains a position.
+	StreamArchivedBinlogs(ctx context.Context, in *tabletmanagerdata.StreamArchivedBinlogsRequest, opts ...grpc.CallOption) (TabletManager_StreamArchivedBinlogsClient, error)
 	// Generic VExec request. Can be used for various purposes
 	VExec(ctx context.Context, in *tabletmanagerdata.VExecRequest, opts ...grpc.CallOption) (*tabletmanagerdata.VExecResponse, error)
+	// CheckThrottler runs a batch of tablet throttler checks, like the
+	// /throttler/check and /throttler/check-self HTTP endpoints.
+	CheckThrottler(ctx context.Context, in *tabletmanagerdata.CheckThrottlerRequest, opts ...grpc.CallOption) (*tabletmanagerdata.CheckThrottlerResponse, error)
 }
 
 type tabletManagerClient struct {
@@ -146,6 +168,24 @@ func (c *tabletManagerClient) ExecuteHook(ctx context.Context, in *tabletmanager
 	return out, nil
 }
 
+func (c *tabletManagerClient) GetLifecycleHooks(ctx context.Context, in *tabletmanagerdata.GetLifecycleHooksRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetLifecycleHooksResponse, error) {
+	out := new(tabletmanagerdata.GetLifecycleHooksResponse)
+	err := c.cc.Invoke(ctx, "/tabletmanagerservice.TabletManager/GetLifecycleHooks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) GetVersion(ctx context.Context, in *tabletmanagerdata.GetVersionRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetVersionResponse, error) {
+	out := new(tabletmanagerdata.GetVersionResponse)
+	err := c.cc.Invoke(ctx, "/tabletmanagerservice.TabletManager/GetVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *tabletManagerClient) GetSchema(ctx context.Context, in *tabletmanagerdata.GetSchemaRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetSchemaResponse, error) {
 	out := new(tabletmanagerdata.GetSchemaResponse)
 	err := c.cc.Invoke(ctx, "/tabletmanagerservice.TabletManager/GetSchema", in, out, opts...)
@@ -191,6 +231,15 @@ func (c *tabletManagerClient) ChangeType(ctx context.Context, in *tabletmanagerd
 	return out, nil
 }
 
+func (c *tabletManagerClient) ChangeTags(ctx context.Context, in *tabletmanagerdata.ChangeTagsRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ChangeTagsResponse, error) {
+	out := new(tabletmanagerdata.ChangeTagsResponse)
+	err := c.cc.Invoke(ctx, "/tabletmanagerservice.TabletManager/ChangeTags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *tabletManagerClient) RefreshState(ctx context.Context, in *tabletmanagerdata.RefreshStateRequest, opts ...grpc.CallOption) (*tabletmanagerdata.RefreshStateResponse, error) {
 	out := new(tabletmanagerdata.RefreshStateResponse)
 	err := c.cc.Invoke(ctx, "/tabletmanagerservice.TabletManager/RefreshState", in, out, opts...)
@@ -299,6 +348,15 @@ func (c *tabletManagerClient) ExecuteFetchAsApp(ctx context.Context, in *tabletm
 	return out, nil
 }
 
+func (c *tabletManagerClient) KillQueries(ctx context.Context, in *tabletmanagerdata.KillQueriesRequest, opts ...grpc.CallOption) (*tabletmanagerdata.KillQueriesResponse, error) {
+	out := new(tabletmanagerdata.KillQueriesResponse)
+	err := c.cc.Invoke(ctx, "/tabletmanagerservice.TabletManager/KillQueries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *tabletManagerClient) ReplicationStatus(ctx context.Context, in *tabletmanagerdata.ReplicationStatusRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ReplicationStatusResponse, error) {
 	out := new(tabletmanagerdata.ReplicationStatusResponse)
 	err := c.cc.Invoke(ctx, "/tabletmanagerservice.TabletManager/ReplicationStatus", in, out, opts...)
@@ -524,6 +582,15 @@ func (c *tabletManagerClient) SetReplicationSource(ctx context.Context, in *tabl
 	return out, nil
 }
 
+func (c *tabletManagerClient) SetReplicationDelay(ctx context.Context, in *tabletmanagerdata.SetReplicationDelayRequest, opts ...grpc.CallOption) (*tabletmanagerdata.SetReplicationDelayResponse, error) {
+	out := new(tabletmanagerdata.SetReplicationDelayResponse)
+	err := c.cc.Invoke(ctx, "/tabletmanagerservice.TabletManager/SetReplicationDelay", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *tabletManagerClient) ReplicaWasRestarted(ctx context.Context, in *tabletmanagerdata.ReplicaWasRestartedRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ReplicaWasRestartedResponse, error) {
 	out := new(tabletmanagerdata.ReplicaWasRestartedResponse)
 	err := c.cc.Invoke(ctx, "/tabletmanagerservice.TabletManager/ReplicaWasRestarted", in, out, opts...)
@@ -615,6 +682,47 @@ func (x *tabletManagerRestoreFromBackupClient) Recv() (*tabletmanagerdata.Restor
 	return m, nil
 }
 
+func (c *tabletManagerClient) GetRestoreProgress(ctx context.Context, in *tabletmanagerdata.GetRestoreProgressRequest, opts ...grpc.CallOption) (*tabletmanagerdata.GetRestoreProgressResponse, error) {
+	out := new(tabletmanagerdata.GetRestoreProgressResponse)
+	err := c.cc.Invoke(ctx, "/tabletmanagerservice.TabletManager/GetRestoreProgress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) StreamArchivedBinlogs(ctx context.Context, in *tabletmanagerdata.StreamArchivedBinlogsRequest, opts ...grpc.CallOption) (TabletManager_StreamArchivedBinlogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &TabletManager_ServiceDesc.Streams[2], "/tabletmanagerservice.TabletManager/StreamArchivedBinlogs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &tabletManagerStreamArchivedBinlogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TabletManager_StreamArchivedBinlogsClient interface {
+	Recv() (*tabletmanagerdata.StreamArchivedBinlogsResponse, error)
+	grpc.ClientStream
+}
+
+type tabletManagerStreamArchivedBinlogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *tabletManagerStreamArchivedBinlogsClient) Recv() (*tabletmanagerdata.StreamArchivedBinlogsResponse, error) {
+	m := new(tabletmanagerdata.StreamArchivedBinlogsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *tabletManagerClient) VExec(ctx context.Context, in *tabletmanagerdata.VExecRequest, opts ...grpc.CallOption) (*tabletmanagerdata.VExecResponse, error) {
 	out := new(tabletmanagerdata.VExecResponse)
 	err := c.cc.Invoke(ctx, "/tabletmanagerservice.TabletManager/VExec", in, out, opts...)
@@ -624,6 +732,15 @@ func (c *tabletManagerClient) VExec(ctx context.Context, in *tabletmanagerdata.V
 	return out, nil
 }
 
+func (c *tabletManagerClient) CheckThrottler(ctx context.Context, in *tabletmanagerdata.CheckThrottlerRequest, opts ...grpc.CallOption) (*tabletmanagerdata.CheckThrottlerResponse, error) {
+	out := new(tabletmanagerdata.CheckThrottlerResponse)
+	err := c.cc.Invoke(ctx, "/tabletmanagerservice.TabletManager/CheckThrottler", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TabletManagerServer is the server API for TabletManager service.
 // All implementations must embed UnimplementedTabletManagerServer
 // for forward compatibility
@@ -634,6 +751,11 @@ type TabletManagerServer interface {
 	Sleep(context.Context, *tabletmanagerdata.SleepRequest) (*tabletmanagerdata.SleepResponse, error)
 	// ExecuteHook executes the hook remotely
 	ExecuteHook(context.Context, *tabletmanagerdata.ExecuteHookRequest) (*tabletmanagerdata.ExecuteHookResponse, error)
+	// GetLifecycleHooks returns the lifecycle hooks of the tablet and their
+	// recent executions
+	GetLifecycleHooks(context.Context, *tabletmanagerdata.GetLifecycleHooksRequest) (*tabletmanagerdata.GetLifecycleHooksResponse, error)
+	// GetVersion returns the version of the mysqld of the tablet
+	GetVersion(context.Context, *tabletmanagerdata.GetVersionRequest) (*tabletmanagerdata.GetVersionResponse, error)
 	// GetSchema asks the tablet for its schema
 	GetSchema(context.Context, *tabletmanagerdata.GetSchemaRequest) (*tabletmanagerdata.GetSchemaResponse, error)
 	// GetPermissions asks the tablet for its permissions
@@ -642,6 +764,8 @@ type TabletManagerServer interface {
 	SetReadWrite(context.Context, *tabletmanagerdata.SetReadWriteRequest) (*tabletmanagerdata.SetReadWriteResponse, error)
 	// ChangeType asks the remote tablet to change its type
 	ChangeType(context.Context, *tabletmanagerdata.ChangeTypeRequest) (*tabletmanagerdata.ChangeTypeResponse, error)
+	// ChangeTags asks the remote tablet to change its tags
+	ChangeTags(context.Context, *tabletmanagerdata.ChangeTagsRequest) (*tabletmanagerdata.ChangeTagsResponse, error)
 	RefreshState(context.Context, *tabletmanagerdata.RefreshStateRequest) (*tabletmanagerdata.RefreshStateResponse, error)
 	RunHealthCheck(context.Context, *tabletmanagerdata.RunHealthCheckRequest) (*tabletmanagerdata.RunHealthCheckResponse, error)
 	IgnoreHealthError(context.Context, *tabletmanagerdata.IgnoreHealthErrorRequest) (*tabletmanagerdata.IgnoreHealthErrorResponse, error)
@@ -654,6 +778,9 @@ type TabletManagerServer interface {
 	ExecuteFetchAsDba(context.Context, *tabletmanagerdata.ExecuteFetchAsDbaRequest) (*tabletmanagerdata.ExecuteFetchAsDbaResponse, error)
 	ExecuteFetchAsAllPrivs(context.Context, *tabletmanagerdata.ExecuteFetchAsAllPrivsRequest) (*tabletmanagerdata.ExecuteFetchAsAllPrivsResponse, error)
 	ExecuteFetchAsApp(context.Context, *tabletmanagerdata.ExecuteFetchAsAppRequest) (*tabletmanagerdata.ExecuteFetchAsAppResponse, error)
+	// KillQueries kills the in-flight queries of a fingerprint, of a user, or
+	// of a user with a fingerprint
+	KillQueries(context.Context, *tabletmanagerdata.KillQueriesRequest) (*tabletmanagerdata.KillQueriesResponse, error)
 	// ReplicationStatus returns the current replication status.
 	ReplicationStatus(context.Context, *tabletmanagerdata.ReplicationStatusRequest) (*tabletmanagerdata.ReplicationStatusResponse, error)
 	// MasterStatus returns the current primary status.
@@ -706,6 +833,9 @@ type TabletManagerServer interface {
 	SetMaster(context.Context, *tabletmanagerdata.SetReplicationSourceRequest) (*tabletmanagerdata.SetReplicationSourceResponse, error)
 	// SetReplicationSource tells the replica to reparent
 	SetReplicationSource(context.Context, *tabletmanagerdata.SetReplicationSourceRequest) (*tabletmanagerdata.SetReplicationSourceResponse, error)
+	// SetReplicationDelay makes the replica a delayed replica, or a regular
+	// replica again
+	SetReplicationDelay(context.Context, *tabletmanagerdata.SetReplicationDelayRequest) (*tabletmanagerdata.SetReplicationDelayResponse, error)
 	// ReplicaWasRestarted tells the remote tablet its primary has changed
 	ReplicaWasRestarted(context.Context, *tabletmanagerdata.ReplicaWasRestartedRequest) (*tabletmanagerdata.ReplicaWasRestartedResponse, error)
 	// StopReplicationAndGetStatus stops MySQL replication, and returns the
@@ -716,8 +846,17 @@ type TabletManagerServer interface {
 	Backup(*tabletmanagerdata.BackupRequest, TabletManager_BackupServer) error
 	// RestoreFromBackup deletes all local data and restores it from the latest backup.
 	RestoreFromBackup(*tabletmanagerdata.RestoreFromBackupRequest, TabletManager_RestoreFromBackupServer) error
+	// GetRestoreProgress returns the progress of the restore from backup of
+	// the tablet.
+	GetRestoreProgress(context.Context, *tabletmanagerdata.GetRestoreProgressRequest) (*tabletmanagerdata.GetRestoreProgressResponse, error)
+	// StreamArchivedBinlogs streams the binlogs archived in the backup storage
+	// for the shard of the tablet, from the segment that contains a position.
+	StreamArchivedBinlogs(*tabletmanagerdata.StreamArchivedBinlogsRequest, TabletManager_StreamArchivedBinlogsServer) error
 	// Generic VExec request. Can be used for various purposes
 	VExec(context.Context, *tabletmanagerdata.VExecRequest) (*tabletmanagerdata.VExecResponse, error)
+	// CheckThrottler runs a batch of tablet throttler checks, like the
+	// /throttler/check and /throttler/check-self HTTP endpoints.
+	CheckThrottler(context.Context, *tabletmanagerdata.CheckThrottlerRequest) (*tabletmanagerdata.CheckThrottlerResponse, error)
 	mustEmbedUnimplementedTabletManagerServer()
 }
 
@@ -734,6 +873,12 @@ func (UnimplementedTabletManagerServer) Sleep(context.Context, *tabletmanagerdat
 func (UnimplementedTabletManagerServer) ExecuteHook(context.Context, *tabletmanagerdata.ExecuteHookRequest) (*tabletmanagerdata.ExecuteHookResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExecuteHook not implemented")
 }
+func (UnimplementedTabletManagerServer) GetLifecycleHooks(context.Context, *tabletmanagerdata.GetLifecycleHooksRequest) (*tabletmanagerdata.GetLifecycleHooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLifecycleHooks not implemented")
+}
+func (UnimplementedTabletManagerServer) GetVersion(context.Context, *tabletmanagerdata.GetVersionRequest) (*tabletmanagerdata.GetVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVersion not implemented")
+}
 func (UnimplementedTabletManagerServer) GetSchema(context.Context, *tabletmanagerdata.GetSchemaRequest) (*tabletmanagerdata.GetSchemaResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSchema not implemented")
 }
@@ -749,6 +894,9 @@ func (UnimplementedTabletManagerServer) SetReadWrite(context.Context, *tabletman
 func (UnimplementedTabletManagerServer) ChangeType(context.Context, *tabletmanagerdata.ChangeTypeRequest) (*tabletmanagerdata.ChangeTypeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ChangeType not implemented")
 }
+func (UnimplementedTabletManagerServer) ChangeTags(context.Context, *tabletmanagerdata.ChangeTagsRequest) (*tabletmanagerdata.ChangeTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeTags not implemented")
+}
 func (UnimplementedTabletManagerServer) RefreshState(context.Context, *tabletmanagerdata.RefreshStateRequest) (*tabletmanagerdata.RefreshStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RefreshState not implemented")
 }
@@ -785,6 +933,9 @@ func (UnimplementedTabletManagerServer) ExecuteFetchAsAllPrivs(context.Context,
 func (UnimplementedTabletManagerServer) ExecuteFetchAsApp(context.Context, *tabletmanagerdata.ExecuteFetchAsAppRequest) (*tabletmanagerdata.ExecuteFetchAsAppResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExecuteFetchAsApp not implemented")
 }
+func (UnimplementedTabletManagerServer) KillQueries(context.Context, *tabletmanagerdata.KillQueriesRequest) (*tabletmanagerdata.KillQueriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KillQueries not implemented")
+}
 func (UnimplementedTabletManagerServer) ReplicationStatus(context.Context, *tabletmanagerdata.ReplicationStatusRequest) (*tabletmanagerdata.ReplicationStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReplicationStatus not implemented")
 }
@@ -860,6 +1011,9 @@ func (UnimplementedTabletManagerServer) SetMaster(context.Context, *tabletmanage
 func (UnimplementedTabletManagerServer) SetReplicationSource(context.Context, *tabletmanagerdata.SetReplicationSourceRequest) (*tabletmanagerdata.SetReplicationSourceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetReplicationSource not implemented")
 }
+func (UnimplementedTabletManagerServer) SetReplicationDelay(context.Context, *tabletmanagerdata.SetReplicationDelayRequest) (*tabletmanagerdata.SetReplicationDelayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetReplicationDelay not implemented")
+}
 func (UnimplementedTabletManagerServer) ReplicaWasRestarted(context.Context, *tabletmanagerdata.ReplicaWasRestartedRequest) (*tabletmanagerdata.ReplicaWasRestartedResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReplicaWasRestarted not implemented")
 }
@@ -875,9 +1029,18 @@ func (UnimplementedTabletManagerServer) Backup(*tabletmanagerdata.BackupRequest,
 func (UnimplementedTabletManagerServer) RestoreFromBackup(*tabletmanagerdata.RestoreFromBackupRequest, TabletManager_RestoreFromBackupServer) error {
 	return status.Errorf(codes.Unimplemented, "method RestoreFromBackup not implemented")
 }
+func (UnimplementedTabletManagerServer) GetRestoreProgress(context.Context, *tabletmanagerdata.GetRestoreProgressRequest) (*tabletmanagerdata.GetRestoreProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRestoreProgress not implemented")
+}
+func (UnimplementedTabletManagerServer) StreamArchivedBinlogs(*tabletmanagerdata.StreamArchivedBinlogsRequest, TabletManager_StreamArchivedBinlogsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamArchivedBinlogs not implemented")
+}
 func (UnimplementedTabletManagerServer) VExec(context.Context, *tabletmanagerdata.VExecRequest) (*tabletmanagerdata.VExecResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VExec not implemented")
 }
+func (UnimplementedTabletManagerServer) CheckThrottler(context.Context, *tabletmanagerdata.CheckThrottlerRequest) (*tabletmanagerdata.CheckThrottlerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckThrottler not implemented")
+}
 func (UnimplementedTabletManagerServer) mustEmbedUnimplementedTabletManagerServer() {}
 
 // UnsafeTabletManagerServer may be embedded to opt out of forward compatibility for this service.
@@ -945,6 +1108,42 @@ func _TabletManager_ExecuteHook_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TabletManager_GetLifecycleHooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.GetLifecycleHooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).GetLifecycleHooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/GetLifecycleHooks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).GetLifecycleHooks(ctx, req.(*tabletmanagerdata.GetLifecycleHooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_GetVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.GetVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).GetVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/GetVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).GetVersion(ctx, req.(*tabletmanagerdata.GetVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TabletManager_GetSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(tabletmanagerdata.GetSchemaRequest)
 	if err := dec(in); err != nil {
@@ -1035,6 +1234,24 @@ func _TabletManager_ChangeType_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TabletManager_ChangeTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.ChangeTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).ChangeTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/ChangeTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).ChangeTags(ctx, req.(*tabletmanagerdata.ChangeTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TabletManager_RefreshState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(tabletmanagerdata.RefreshStateRequest)
 	if err := dec(in); err != nil {
@@ -1251,6 +1468,24 @@ func _TabletManager_ExecuteFetchAsApp_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TabletManager_KillQueries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.KillQueriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).KillQueries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/KillQueries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).KillQueries(ctx, req.(*tabletmanagerdata.KillQueriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TabletManager_ReplicationStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(tabletmanagerdata.ReplicationStatusRequest)
 	if err := dec(in); err != nil {
@@ -1701,6 +1936,24 @@ func _TabletManager_SetReplicationSource_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TabletManager_SetReplicationDelay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.SetReplicationDelayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).SetReplicationDelay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/SetReplicationDelay",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).SetReplicationDelay(ctx, req.(*tabletmanagerdata.SetReplicationDelayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TabletManager_ReplicaWasRestarted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(tabletmanagerdata.ReplicaWasRestartedRequest)
 	if err := dec(in); err != nil {
@@ -1797,6 +2050,45 @@ func (x *tabletManagerRestoreFromBackupServer) Send(m *tabletmanagerdata.Restore
 	return x.ServerStream.SendMsg(m)
 }
 
+func _TabletManager_GetRestoreProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.GetRestoreProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).GetRestoreProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/GetRestoreProgress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).GetRestoreProgress(ctx, req.(*tabletmanagerdata.GetRestoreProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_StreamArchivedBinlogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(tabletmanagerdata.StreamArchivedBinlogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TabletManagerServer).StreamArchivedBinlogs(m, &tabletManagerStreamArchivedBinlogsServer{stream})
+}
+
+type TabletManager_StreamArchivedBinlogsServer interface {
+	Send(*tabletmanagerdata.StreamArchivedBinlogsResponse) error
+	grpc.ServerStream
+}
+
+type tabletManagerStreamArchivedBinlogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *tabletManagerStreamArchivedBinlogsServer) Send(m *tabletmanagerdata.StreamArchivedBinlogsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _TabletManager_VExec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(tabletmanagerdata.VExecRequest)
 	if err := dec(in); err != nil {
@@ -1815,6 +2107,24 @@ func _TabletManager_VExec_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TabletManager_CheckThrottler_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.CheckThrottlerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).CheckThrottler(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/CheckThrottler",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).CheckThrottler(ctx, req.(*tabletmanagerdata.CheckThrottlerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TabletManager_ServiceDesc is the grpc.ServiceDesc for TabletManager service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1834,6 +2144,14 @@ var TabletManager_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ExecuteHook",
 			Handler:    _TabletManager_ExecuteHook_Handler,
 		},
+		{
+			MethodName: "GetLifecycleHooks",
+			Handler:    _TabletManager_GetLifecycleHooks_Handler,
+		},
+		{
+			MethodName: "GetVersion",
+			Handler:    _TabletManager_GetVersion_Handler,
+		},
 		{
 			MethodName: "GetSchema",
 			Handler:    _TabletManager_GetSchema_Handler,
@@ -1854,6 +2172,10 @@ var TabletManager_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ChangeType",
 			Handler:    _TabletManager_ChangeType_Handler,
 		},
+		{
+			MethodName: "ChangeTags",
+			Handler:    _TabletManager_ChangeTags_Handler,
+		},
 		{
 			MethodName: "RefreshState",
 			Handler:    _TabletManager_RefreshState_Handler,
@@ -1902,6 +2224,10 @@ var TabletManager_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ExecuteFetchAsApp",
 			Handler:    _TabletManager_ExecuteFetchAsApp_Handler,
 		},
+		{
+			MethodName: "KillQueries",
+			Handler:    _TabletManager_KillQueries_Handler,
+		},
 		{
 			MethodName: "ReplicationStatus",
 			Handler:    _TabletManager_ReplicationStatus_Handler,
@@ -2002,6 +2328,10 @@ var TabletManager_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetReplicationSource",
 			Handler:    _TabletManager_SetReplicationSource_Handler,
 		},
+		{
+			MethodName: "SetReplicationDelay",
+			Handler:    _TabletManager_SetReplicationDelay_Handler,
+		},
 		{
 			MethodName: "ReplicaWasRestarted",
 			Handler:    _TabletManager_ReplicaWasRestarted_Handler,
@@ -2014,10 +2344,18 @@ var TabletManager_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PromoteReplica",
 			Handler:    _TabletManager_PromoteReplica_Handler,
 		},
+		{
+			MethodName: "GetRestoreProgress",
+			Handler:    _TabletManager_GetRestoreProgress_Handler,
+		},
 		{
 			MethodName: "VExec",
 			Handler:    _TabletManager_VExec_Handler,
 		},
+		{
+			MethodName: "CheckThrottler",
+			Handler:    _TabletManager_CheckThrottler_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -2030,6 +2368,11 @@ var TabletManager_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _TabletManager_RestoreFromBackup_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "StreamArchivedBinlogs",
+			Handler:       _TabletManager_StreamArchivedBinlogs_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "tabletmanagerservice.proto",
 }
@@ -242,6 +242,58 @@ func (TabletType) EnumDescriptor() ([]byte, []int) {
 	return file_topodata_proto_rawDescGZIP(), []int{2}
 }
 
+type DDLShardStatus_State int32
+
+const (
+	DDLShardStatus_QUEUED   DDLShardStatus_State = 0
+	DDLShardStatus_RUNNING  DDLShardStatus_State = 1
+	DDLShardStatus_COMPLETE DDLShardStatus_State = 2
+	DDLShardStatus_FAILED   DDLShardStatus_State = 3
+)
+
+// Enum value maps for DDLShardStatus_State.
+var (
+	DDLShardStatus_State_name = map[int32]string{
+		0: "QUEUED",
+		1: "RUNNING",
+		2: "COMPLETE",
+		3: "FAILED",
+	}
+	DDLShardStatus_State_value = map[string]int32{
+		"QUEUED":   0,
+		"RUNNING":  1,
+		"COMPLETE": 2,
+		"FAILED":   3,
+	}
+)
+
+func (x DDLShardStatus_State) Enum() *DDLShardStatus_State {
+	p := new(DDLShardStatus_State)
+	*p = x
+	return p
+}
+
+func (x DDLShardStatus_State) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DDLShardStatus_State) Descriptor() protoreflect.EnumDescriptor {
+	return file_topodata_proto_enumTypes[3].Descriptor()
+}
+
+func (DDLShardStatus_State) Type() protoreflect.EnumType {
+	return &file_topodata_proto_enumTypes[3]
+}
+
+func (x DDLShardStatus_State) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DDLShardStatus_State.Descriptor instead.
+func (DDLShardStatus_State) EnumDescriptor() ([]byte, []int) {
+	return file_topodata_proto_rawDescGZIP(), []int{23, 0}
+}
+
 // KeyRange describes a range of sharding keys, when range-based
 // sharding is used.
 type KeyRange struct {
@@ -405,6 +457,11 @@ type Tablet struct {
 	// EmergencyReparentShard, and TabletExternallyReparented.
 	//
 	PrimaryTermStartTime *vttime.Time `protobuf:"bytes,14,opt,name=primary_term_start_time,json=primaryTermStartTime,proto3" json:"primary_term_start_time,omitempty"`
+	// replication_delay_seconds is the delay, in seconds, with which a delayed
+	// replica intentionally applies the events of its source. Delayed replicas
+	// do not serve queries and are never promoted, but can be used as point in
+	// time recovery sources. It is 0 for all other tablets.
+	ReplicationDelaySeconds int32 `protobuf:"varint,15,opt,name=replication_delay_seconds,json=replicationDelaySeconds,proto3" json:"replication_delay_seconds,omitempty"`
 }
 
 func (x *Tablet) Reset() {
@@ -523,6 +580,13 @@ func (x *Tablet) GetPrimaryTermStartTime() *vttime.Time {
 	return nil
 }
 
+func (x *Tablet) GetReplicationDelaySeconds() int32 {
+	if x != nil {
+		return x.ReplicationDelaySeconds
+	}
+	return 0
+}
+
 // A Shard contains data about a subset of the data whithin a keyspace.
 type Shard struct {
 	state         protoimpl.MessageState
@@ -567,6 +631,9 @@ type Shard struct {
 	// is_primary_serving sets whether this shard primary is serving traffic or not.
 	// The keyspace lock is always taken when changing this.
 	IsPrimaryServing bool `protobuf:"varint,7,opt,name=is_primary_serving,json=isPrimaryServing,proto3" json:"is_primary_serving,omitempty"`
+	// write_fence is set while writes to the shard are fenced, for instance
+	// during maintenance or migration verification.
+	WriteFence *Shard_WriteFence `protobuf:"bytes,9,opt,name=write_fence,json=writeFence,proto3" json:"write_fence,omitempty"`
 }
 
 func (x *Shard) Reset() {
@@ -643,6 +710,13 @@ func (x *Shard) GetIsPrimaryServing() bool {
 	return false
 }
 
+func (x *Shard) GetWriteFence() *Shard_WriteFence {
+	if x != nil {
+		return x.WriteFence
+	}
+	return nil
+}
+
 // A Keyspace contains data about a keyspace.
 type Keyspace struct {
 	state         protoimpl.MessageState
@@ -670,6 +744,20 @@ type Keyspace struct {
 	// keyspaces which tells us what point in time
 	// the snapshot is of
 	SnapshotTime *vttime.Time `protobuf:"bytes,7,opt,name=snapshot_time,json=snapshotTime,proto3" json:"snapshot_time,omitempty"`
+	// throttler_config is the tablet throttler configuration of the
+	// keyspace. It is copied to the SrvKeyspace of each cell.
+	ThrottlerConfig *ThrottlerConfig `protobuf:"bytes,8,opt,name=throttler_config,json=throttlerConfig,proto3" json:"throttler_config,omitempty"`
+	// durability_policy is the name of the durability policy enforced by the
+	// reparent operations and by the tablets of the keyspace: "none",
+	// "semi_sync" or "cross_cell". If empty, the tablets use their
+	// -enable_semi_sync flag, and the reparent operations do not check it.
+	DurabilityPolicy string `protobuf:"bytes,9,opt,name=durability_policy,json=durabilityPolicy,proto3" json:"durability_policy,omitempty"`
+	// policy holds the limits vtgate and vttablet apply to the queries of the
+	// keyspace. It is copied to the SrvKeyspace of each cell.
+	Policy *KeyspacePolicy `protobuf:"bytes,10,opt,name=policy,proto3" json:"policy,omitempty"`
+	// query_rules are the query rules the tablets of the keyspace apply, in
+	// order. They are copied to the SrvKeyspace of each cell.
+	QueryRules []*QueryRule `protobuf:"bytes,11,rep,name=query_rules,json=queryRules,proto3" json:"query_rules,omitempty"`
 }
 
 func (x *Keyspace) Reset() {
@@ -746,20 +834,62 @@ func (x *Keyspace) GetSnapshotTime() *vttime.Time {
 	return nil
 }
 
-// ShardReplication describes the MySQL replication relationships
-// whithin a cell.
-type ShardReplication struct {
+func (x *Keyspace) GetThrottlerConfig() *ThrottlerConfig {
+	if x != nil {
+		return x.ThrottlerConfig
+	}
+	return nil
+}
+
+func (x *Keyspace) GetDurabilityPolicy() string {
+	if x != nil {
+		return x.DurabilityPolicy
+	}
+	return ""
+}
+
+func (x *Keyspace) GetPolicy() *KeyspacePolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+func (x *Keyspace) GetQueryRules() []*QueryRule {
+	if x != nil {
+		return x.QueryRules
+	}
+	return nil
+}
+
+// QueryRule denies or allows the queries matching all its conditions on the
+// tablets of a keyspace. An empty condition matches any query.
+type QueryRule struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Note there can be only one Node in this array
-	// for a given tablet.
-	Nodes []*ShardReplication_Node `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
-}
-
-func (x *ShardReplication) Reset() {
-	*x = ShardReplication{}
+	// name identifies the rule in the keyspace and in the QueryRuleHits stats.
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// fingerprint matches the queries of a fingerprint of the vttablet stats,
+	// see sqlparser.Fingerprint.
+	Fingerprint string `protobuf:"bytes,3,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	// query is a regexp matching the whole query.
+	Query string `protobuf:"bytes,4,opt,name=query,proto3" json:"query,omitempty"`
+	// user is a regexp matching the whole name of the immediate caller.
+	User string `protobuf:"bytes,5,opt,name=user,proto3" json:"user,omitempty"`
+	// plans are the plan types of the queries, e.g. Select or Insert.
+	Plans []string `protobuf:"bytes,6,rep,name=plans,proto3" json:"plans,omitempty"`
+	// table_names are the tables of the queries.
+	TableNames []string `protobuf:"bytes,7,rep,name=table_names,json=tableNames,proto3" json:"table_names,omitempty"`
+	// action is FAIL, FAIL_RETRY, or ALLOW to make the matching queries skip
+	// the next rules of the keyspace.
+	Action string `protobuf:"bytes,8,opt,name=action,proto3" json:"action,omitempty"`
+}
+
+func (x *QueryRule) Reset() {
+	*x = QueryRule{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_topodata_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -767,13 +897,13 @@ func (x *ShardReplication) Reset() {
 	}
 }
 
-func (x *ShardReplication) String() string {
+func (x *QueryRule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ShardReplication) ProtoMessage() {}
+func (*QueryRule) ProtoMessage() {}
 
-func (x *ShardReplication) ProtoReflect() protoreflect.Message {
+func (x *QueryRule) ProtoReflect() protoreflect.Message {
 	mi := &file_topodata_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -785,31 +915,91 @@ func (x *ShardReplication) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ShardReplication.ProtoReflect.Descriptor instead.
-func (*ShardReplication) Descriptor() ([]byte, []int) {
+// Deprecated: Use QueryRule.ProtoReflect.Descriptor instead.
+func (*QueryRule) Descriptor() ([]byte, []int) {
 	return file_topodata_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *ShardReplication) GetNodes() []*ShardReplication_Node {
+func (x *QueryRule) GetName() string {
 	if x != nil {
-		return x.Nodes
+		return x.Name
+	}
+	return ""
+}
+
+func (x *QueryRule) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *QueryRule) GetFingerprint() string {
+	if x != nil {
+		return x.Fingerprint
+	}
+	return ""
+}
+
+func (x *QueryRule) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *QueryRule) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *QueryRule) GetPlans() []string {
+	if x != nil {
+		return x.Plans
 	}
 	return nil
 }
 
-// ShardReference is used as a pointer from a SrvKeyspace to a Shard
-type ShardReference struct {
+func (x *QueryRule) GetTableNames() []string {
+	if x != nil {
+		return x.TableNames
+	}
+	return nil
+}
+
+func (x *QueryRule) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+// KeyspacePolicy holds the limits applied to the queries of a keyspace, on
+// top of the ones of the vtgate and vttablet flags. The sessions of the
+// users allowed by the vtgate -keyspace_policy_exempt_users flag can skip
+// them with set skip_keyspace_policy = 1.
+type KeyspacePolicy struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Copied from Shard.
-	Name     string    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	KeyRange *KeyRange `protobuf:"bytes,2,opt,name=key_range,json=keyRange,proto3" json:"key_range,omitempty"` // Disable query serving in this shard
-}
-
-func (x *ShardReference) Reset() {
-	*x = ShardReference{}
+	// query_timeout_ms is the maximum duration of a query, or 0 for no limit.
+	QueryTimeoutMs int64 `protobuf:"varint,1,opt,name=query_timeout_ms,json=queryTimeoutMs,proto3" json:"query_timeout_ms,omitempty"`
+	// max_rows is the maximum number of rows a query can return or affect,
+	// or 0 for no limit.
+	MaxRows uint64 `protobuf:"varint,2,opt,name=max_rows,json=maxRows,proto3" json:"max_rows,omitempty"`
+	// default_workload is the workload of the new sessions of the keyspace:
+	// OLTP, OLAP or DBA. If empty, vtgate uses its -mysql_default_workload.
+	DefaultWorkload string `protobuf:"bytes,3,opt,name=default_workload,json=defaultWorkload,proto3" json:"default_workload,omitempty"`
+	// denied_queries are the queries vtgate and vttablet reject until their
+	// expire_time, set by KillQueries.
+	DeniedQueries []*DeniedQuery `protobuf:"bytes,4,rep,name=denied_queries,json=deniedQueries,proto3" json:"denied_queries,omitempty"`
+}
+
+func (x *KeyspacePolicy) Reset() {
+	*x = KeyspacePolicy{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_topodata_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -817,13 +1007,13 @@ func (x *ShardReference) Reset() {
 	}
 }
 
-func (x *ShardReference) String() string {
+func (x *KeyspacePolicy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ShardReference) ProtoMessage() {}
+func (*KeyspacePolicy) ProtoMessage() {}
 
-func (x *ShardReference) ProtoReflect() protoreflect.Message {
+func (x *KeyspacePolicy) ProtoReflect() protoreflect.Message {
 	mi := &file_topodata_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -835,40 +1025,57 @@ func (x *ShardReference) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ShardReference.ProtoReflect.Descriptor instead.
-func (*ShardReference) Descriptor() ([]byte, []int) {
+// Deprecated: Use KeyspacePolicy.ProtoReflect.Descriptor instead.
+func (*KeyspacePolicy) Descriptor() ([]byte, []int) {
 	return file_topodata_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *ShardReference) GetName() string {
+func (x *KeyspacePolicy) GetQueryTimeoutMs() int64 {
 	if x != nil {
-		return x.Name
+		return x.QueryTimeoutMs
+	}
+	return 0
+}
+
+func (x *KeyspacePolicy) GetMaxRows() uint64 {
+	if x != nil {
+		return x.MaxRows
+	}
+	return 0
+}
+
+func (x *KeyspacePolicy) GetDefaultWorkload() string {
+	if x != nil {
+		return x.DefaultWorkload
 	}
 	return ""
 }
 
-func (x *ShardReference) GetKeyRange() *KeyRange {
+func (x *KeyspacePolicy) GetDeniedQueries() []*DeniedQuery {
 	if x != nil {
-		return x.KeyRange
+		return x.DeniedQueries
 	}
 	return nil
 }
 
-// ShardTabletControl is used as a pointer from a SrvKeyspace to a Shard
-type ShardTabletControl struct {
+// DeniedQuery matches the queries of a fingerprint, of a user, or of a user
+// with a fingerprint.
+type DeniedQuery struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Copied from Shard.
-	Name     string    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	KeyRange *KeyRange `protobuf:"bytes,2,opt,name=key_range,json=keyRange,proto3" json:"key_range,omitempty"`
-	// Disable query serving in this shard
-	QueryServiceDisabled bool `protobuf:"varint,3,opt,name=query_service_disabled,json=queryServiceDisabled,proto3" json:"query_service_disabled,omitempty"`
+	// fingerprint is the fingerprint of the query vtgate executes, as shown
+	// in its query plans and in the query logs, see sqlparser.Fingerprint.
+	// Empty for any query.
+	Fingerprint string `protobuf:"bytes,1,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	// user is the immediate caller of the query. Empty for any user.
+	User       string       `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	ExpireTime *vttime.Time `protobuf:"bytes,3,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
 }
 
-func (x *ShardTabletControl) Reset() {
-	*x = ShardTabletControl{}
+func (x *DeniedQuery) Reset() {
+	*x = DeniedQuery{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_topodata_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -876,13 +1083,13 @@ func (x *ShardTabletControl) Reset() {
 	}
 }
 
-func (x *ShardTabletControl) String() string {
+func (x *DeniedQuery) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ShardTabletControl) ProtoMessage() {}
+func (*DeniedQuery) ProtoMessage() {}
 
-func (x *ShardTabletControl) ProtoReflect() protoreflect.Message {
+func (x *DeniedQuery) ProtoReflect() protoreflect.Message {
 	mi := &file_topodata_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -894,48 +1101,49 @@ func (x *ShardTabletControl) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ShardTabletControl.ProtoReflect.Descriptor instead.
-func (*ShardTabletControl) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeniedQuery.ProtoReflect.Descriptor instead.
+func (*DeniedQuery) Descriptor() ([]byte, []int) {
 	return file_topodata_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *ShardTabletControl) GetName() string {
+func (x *DeniedQuery) GetFingerprint() string {
 	if x != nil {
-		return x.Name
+		return x.Fingerprint
 	}
 	return ""
 }
 
-func (x *ShardTabletControl) GetKeyRange() *KeyRange {
+func (x *DeniedQuery) GetUser() string {
 	if x != nil {
-		return x.KeyRange
+		return x.User
 	}
-	return nil
+	return ""
 }
 
-func (x *ShardTabletControl) GetQueryServiceDisabled() bool {
+func (x *DeniedQuery) GetExpireTime() *vttime.Time {
 	if x != nil {
-		return x.QueryServiceDisabled
+		return x.ExpireTime
 	}
-	return false
+	return nil
 }
 
-// SrvKeyspace is a rollup node for the keyspace itself.
-type SrvKeyspace struct {
+// ThrottlerConfig configures the metrics checked by the tablet throttler of
+// a keyspace, and how each app is throttled.
+type ThrottlerConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The partitions this keyspace is serving, per tablet type.
-	Partitions []*SrvKeyspace_KeyspacePartition `protobuf:"bytes,1,rep,name=partitions,proto3" json:"partitions,omitempty"`
-	// copied from Keyspace
-	ShardingColumnName string                    `protobuf:"bytes,2,opt,name=sharding_column_name,json=shardingColumnName,proto3" json:"sharding_column_name,omitempty"`
-	ShardingColumnType KeyspaceIdType            `protobuf:"varint,3,opt,name=sharding_column_type,json=shardingColumnType,proto3,enum=topodata.KeyspaceIdType" json:"sharding_column_type,omitempty"`
-	ServedFrom         []*SrvKeyspace_ServedFrom `protobuf:"bytes,4,rep,name=served_from,json=servedFrom,proto3" json:"served_from,omitempty"`
+	// metrics are checked by the throttler in addition to the replication
+	// lag, by name. The builtin metrics are "lag", which overrides the
+	// replication lag settings of the flags, and "threads_running".
+	Metrics map[string]*ThrottlerConfig_Metric `protobuf:"bytes,1,rep,name=metrics,proto3" json:"metrics,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// app_policies configure how each app is throttled, by app name.
+	AppPolicies map[string]*ThrottlerConfig_AppPolicy `protobuf:"bytes,2,rep,name=app_policies,json=appPolicies,proto3" json:"app_policies,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *SrvKeyspace) Reset() {
-	*x = SrvKeyspace{}
+func (x *ThrottlerConfig) Reset() {
+	*x = ThrottlerConfig{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_topodata_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -943,13 +1151,13 @@ func (x *SrvKeyspace) Reset() {
 	}
 }
 
-func (x *SrvKeyspace) String() string {
+func (x *ThrottlerConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SrvKeyspace) ProtoMessage() {}
+func (*ThrottlerConfig) ProtoMessage() {}
 
-func (x *SrvKeyspace) ProtoReflect() protoreflect.Message {
+func (x *ThrottlerConfig) ProtoReflect() protoreflect.Message {
 	mi := &file_topodata_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -961,59 +1169,39 @@ func (x *SrvKeyspace) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SrvKeyspace.ProtoReflect.Descriptor instead.
-func (*SrvKeyspace) Descriptor() ([]byte, []int) {
+// Deprecated: Use ThrottlerConfig.ProtoReflect.Descriptor instead.
+func (*ThrottlerConfig) Descriptor() ([]byte, []int) {
 	return file_topodata_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *SrvKeyspace) GetPartitions() []*SrvKeyspace_KeyspacePartition {
+func (x *ThrottlerConfig) GetMetrics() map[string]*ThrottlerConfig_Metric {
 	if x != nil {
-		return x.Partitions
+		return x.Metrics
 	}
 	return nil
 }
 
-func (x *SrvKeyspace) GetShardingColumnName() string {
-	if x != nil {
-		return x.ShardingColumnName
-	}
-	return ""
-}
-
-func (x *SrvKeyspace) GetShardingColumnType() KeyspaceIdType {
+func (x *ThrottlerConfig) GetAppPolicies() map[string]*ThrottlerConfig_AppPolicy {
 	if x != nil {
-		return x.ShardingColumnType
-	}
-	return KeyspaceIdType_UNSET
-}
-
-func (x *SrvKeyspace) GetServedFrom() []*SrvKeyspace_ServedFrom {
-	if x != nil {
-		return x.ServedFrom
+		return x.AppPolicies
 	}
 	return nil
 }
 
-// CellInfo contains information about a cell. CellInfo objects are
-// stored in the global topology server, and describe how to reach
-// local topology servers.
-type CellInfo struct {
+// ShardReplication describes the MySQL replication relationships
+// whithin a cell.
+type ShardReplication struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// ServerAddress contains the address of the server for the cell.
-	// The syntax of this field is topology implementation specific.
-	// For instance, for Zookeeper, it is a comma-separated list of
-	// server addresses.
-	ServerAddress string `protobuf:"bytes,1,opt,name=server_address,json=serverAddress,proto3" json:"server_address,omitempty"`
-	// Root is the path to store data in. It is only used when talking
-	// to server_address.
-	Root string `protobuf:"bytes,2,opt,name=root,proto3" json:"root,omitempty"`
+	// Note there can be only one Node in this array
+	// for a given tablet.
+	Nodes []*ShardReplication_Node `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
 }
 
-func (x *CellInfo) Reset() {
-	*x = CellInfo{}
+func (x *ShardReplication) Reset() {
+	*x = ShardReplication{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_topodata_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1021,13 +1209,13 @@ func (x *CellInfo) Reset() {
 	}
 }
 
-func (x *CellInfo) String() string {
+func (x *ShardReplication) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CellInfo) ProtoMessage() {}
+func (*ShardReplication) ProtoMessage() {}
 
-func (x *CellInfo) ProtoReflect() protoreflect.Message {
+func (x *ShardReplication) ProtoReflect() protoreflect.Message {
 	mi := &file_topodata_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1039,37 +1227,31 @@ func (x *CellInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CellInfo.ProtoReflect.Descriptor instead.
-func (*CellInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use ShardReplication.ProtoReflect.Descriptor instead.
+func (*ShardReplication) Descriptor() ([]byte, []int) {
 	return file_topodata_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *CellInfo) GetServerAddress() string {
-	if x != nil {
-		return x.ServerAddress
-	}
-	return ""
-}
-
-func (x *CellInfo) GetRoot() string {
+func (x *ShardReplication) GetNodes() []*ShardReplication_Node {
 	if x != nil {
-		return x.Root
+		return x.Nodes
 	}
-	return ""
+	return nil
 }
 
-// CellsAlias
-type CellsAlias struct {
+// ShardReference is used as a pointer from a SrvKeyspace to a Shard
+type ShardReference struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Cells that map to this alias
-	Cells []string `protobuf:"bytes,2,rep,name=cells,proto3" json:"cells,omitempty"`
+	// Copied from Shard.
+	Name     string    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	KeyRange *KeyRange `protobuf:"bytes,2,opt,name=key_range,json=keyRange,proto3" json:"key_range,omitempty"` // Disable query serving in this shard
 }
 
-func (x *CellsAlias) Reset() {
-	*x = CellsAlias{}
+func (x *ShardReference) Reset() {
+	*x = ShardReference{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_topodata_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1077,13 +1259,13 @@ func (x *CellsAlias) Reset() {
 	}
 }
 
-func (x *CellsAlias) String() string {
+func (x *ShardReference) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CellsAlias) ProtoMessage() {}
+func (*ShardReference) ProtoMessage() {}
 
-func (x *CellsAlias) ProtoReflect() protoreflect.Message {
+func (x *ShardReference) ProtoReflect() protoreflect.Message {
 	mi := &file_topodata_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1095,45 +1277,785 @@ func (x *CellsAlias) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CellsAlias.ProtoReflect.Descriptor instead.
-func (*CellsAlias) Descriptor() ([]byte, []int) {
+// Deprecated: Use ShardReference.ProtoReflect.Descriptor instead.
+func (*ShardReference) Descriptor() ([]byte, []int) {
 	return file_topodata_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *CellsAlias) GetCells() []string {
+func (x *ShardReference) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ShardReference) GetKeyRange() *KeyRange {
+	if x != nil {
+		return x.KeyRange
+	}
+	return nil
+}
+
+// ShardTabletControl is used as a pointer from a SrvKeyspace to a Shard
+type ShardTabletControl struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Copied from Shard.
+	Name     string    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	KeyRange *KeyRange `protobuf:"bytes,2,opt,name=key_range,json=keyRange,proto3" json:"key_range,omitempty"`
+	// Disable query serving in this shard
+	QueryServiceDisabled bool `protobuf:"varint,3,opt,name=query_service_disabled,json=queryServiceDisabled,proto3" json:"query_service_disabled,omitempty"`
+}
+
+func (x *ShardTabletControl) Reset() {
+	*x = ShardTabletControl{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_topodata_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShardTabletControl) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShardTabletControl) ProtoMessage() {}
+
+func (x *ShardTabletControl) ProtoReflect() protoreflect.Message {
+	mi := &file_topodata_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShardTabletControl.ProtoReflect.Descriptor instead.
+func (*ShardTabletControl) Descriptor() ([]byte, []int) {
+	return file_topodata_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ShardTabletControl) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ShardTabletControl) GetKeyRange() *KeyRange {
+	if x != nil {
+		return x.KeyRange
+	}
+	return nil
+}
+
+func (x *ShardTabletControl) GetQueryServiceDisabled() bool {
+	if x != nil {
+		return x.QueryServiceDisabled
+	}
+	return false
+}
+
+// SrvKeyspace is a rollup node for the keyspace itself.
+type SrvKeyspace struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The partitions this keyspace is serving, per tablet type.
+	Partitions []*SrvKeyspace_KeyspacePartition `protobuf:"bytes,1,rep,name=partitions,proto3" json:"partitions,omitempty"`
+	// copied from Keyspace
+	ShardingColumnName string                    `protobuf:"bytes,2,opt,name=sharding_column_name,json=shardingColumnName,proto3" json:"sharding_column_name,omitempty"`
+	ShardingColumnType KeyspaceIdType            `protobuf:"varint,3,opt,name=sharding_column_type,json=shardingColumnType,proto3,enum=topodata.KeyspaceIdType" json:"sharding_column_type,omitempty"`
+	ServedFrom         []*SrvKeyspace_ServedFrom `protobuf:"bytes,4,rep,name=served_from,json=servedFrom,proto3" json:"served_from,omitempty"`
+	// copied from Keyspace
+	ThrottlerConfig *ThrottlerConfig `protobuf:"bytes,6,opt,name=throttler_config,json=throttlerConfig,proto3" json:"throttler_config,omitempty"`
+	// copied from Keyspace
+	Policy *KeyspacePolicy `protobuf:"bytes,7,opt,name=policy,proto3" json:"policy,omitempty"`
+	// copied from Keyspace
+	QueryRules []*QueryRule `protobuf:"bytes,8,rep,name=query_rules,json=queryRules,proto3" json:"query_rules,omitempty"`
+}
+
+func (x *SrvKeyspace) Reset() {
+	*x = SrvKeyspace{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_topodata_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SrvKeyspace) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SrvKeyspace) ProtoMessage() {}
+
+func (x *SrvKeyspace) ProtoReflect() protoreflect.Message {
+	mi := &file_topodata_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SrvKeyspace.ProtoReflect.Descriptor instead.
+func (*SrvKeyspace) Descriptor() ([]byte, []int) {
+	return file_topodata_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SrvKeyspace) GetPartitions() []*SrvKeyspace_KeyspacePartition {
+	if x != nil {
+		return x.Partitions
+	}
+	return nil
+}
+
+func (x *SrvKeyspace) GetShardingColumnName() string {
+	if x != nil {
+		return x.ShardingColumnName
+	}
+	return ""
+}
+
+func (x *SrvKeyspace) GetShardingColumnType() KeyspaceIdType {
+	if x != nil {
+		return x.ShardingColumnType
+	}
+	return KeyspaceIdType_UNSET
+}
+
+func (x *SrvKeyspace) GetServedFrom() []*SrvKeyspace_ServedFrom {
+	if x != nil {
+		return x.ServedFrom
+	}
+	return nil
+}
+
+func (x *SrvKeyspace) GetThrottlerConfig() *ThrottlerConfig {
+	if x != nil {
+		return x.ThrottlerConfig
+	}
+	return nil
+}
+
+func (x *SrvKeyspace) GetPolicy() *KeyspacePolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+func (x *SrvKeyspace) GetQueryRules() []*QueryRule {
+	if x != nil {
+		return x.QueryRules
+	}
+	return nil
+}
+
+// CellInfo contains information about a cell. CellInfo objects are
+// stored in the global topology server, and describe how to reach
+// local topology servers.
+type CellInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ServerAddress contains the address of the server for the cell.
+	// The syntax of this field is topology implementation specific.
+	// For instance, for Zookeeper, it is a comma-separated list of
+	// server addresses.
+	ServerAddress string `protobuf:"bytes,1,opt,name=server_address,json=serverAddress,proto3" json:"server_address,omitempty"`
+	// Root is the path to store data in. It is only used when talking
+	// to server_address.
+	Root string `protobuf:"bytes,2,opt,name=root,proto3" json:"root,omitempty"`
+}
+
+func (x *CellInfo) Reset() {
+	*x = CellInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_topodata_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CellInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CellInfo) ProtoMessage() {}
+
+func (x *CellInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_topodata_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CellInfo.ProtoReflect.Descriptor instead.
+func (*CellInfo) Descriptor() ([]byte, []int) {
+	return file_topodata_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CellInfo) GetServerAddress() string {
+	if x != nil {
+		return x.ServerAddress
+	}
+	return ""
+}
+
+func (x *CellInfo) GetRoot() string {
+	if x != nil {
+		return x.Root
+	}
+	return ""
+}
+
+// CellsAlias
+type CellsAlias struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Cells that map to this alias
+	Cells []string `protobuf:"bytes,2,rep,name=cells,proto3" json:"cells,omitempty"`
+}
+
+func (x *CellsAlias) Reset() {
+	*x = CellsAlias{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_topodata_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CellsAlias) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CellsAlias) ProtoMessage() {}
+
+func (x *CellsAlias) ProtoReflect() protoreflect.Message {
+	mi := &file_topodata_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CellsAlias.ProtoReflect.Descriptor instead.
+func (*CellsAlias) Descriptor() ([]byte, []int) {
+	return file_topodata_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CellsAlias) GetCells() []string {
+	if x != nil {
+		return x.Cells
+	}
+	return nil
+}
+
+type TopoConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TopoType string `protobuf:"bytes,1,opt,name=topo_type,json=topoType,proto3" json:"topo_type,omitempty"`
+	Server   string `protobuf:"bytes,2,opt,name=server,proto3" json:"server,omitempty"`
+	Root     string `protobuf:"bytes,3,opt,name=root,proto3" json:"root,omitempty"`
+}
+
+func (x *TopoConfig) Reset() {
+	*x = TopoConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_topodata_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TopoConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopoConfig) ProtoMessage() {}
+
+func (x *TopoConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_topodata_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopoConfig.ProtoReflect.Descriptor instead.
+func (*TopoConfig) Descriptor() ([]byte, []int) {
+	return file_topodata_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *TopoConfig) GetTopoType() string {
+	if x != nil {
+		return x.TopoType
+	}
+	return ""
+}
+
+func (x *TopoConfig) GetServer() string {
+	if x != nil {
+		return x.Server
+	}
+	return ""
+}
+
+func (x *TopoConfig) GetRoot() string {
+	if x != nil {
+		return x.Root
+	}
+	return ""
+}
+
+type ExternalVitessCluster struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TopoConfig *TopoConfig `protobuf:"bytes,1,opt,name=topo_config,json=topoConfig,proto3" json:"topo_config,omitempty"`
+}
+
+func (x *ExternalVitessCluster) Reset() {
+	*x = ExternalVitessCluster{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_topodata_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExternalVitessCluster) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExternalVitessCluster) ProtoMessage() {}
+
+func (x *ExternalVitessCluster) ProtoReflect() protoreflect.Message {
+	mi := &file_topodata_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExternalVitessCluster.ProtoReflect.Descriptor instead.
+func (*ExternalVitessCluster) Descriptor() ([]byte, []int) {
+	return file_topodata_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ExternalVitessCluster) GetTopoConfig() *TopoConfig {
+	if x != nil {
+		return x.TopoConfig
+	}
+	return nil
+}
+
+// ExternalMysql is an unmanaged mysqld registered as a read-only source of
+// vreplication workflows, so tables can be migrated from it without running a
+// tablet in front of it.
+type ExternalMysql struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Host string `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	Port int32  `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	// db_name is the database the workflows replicate from.
+	DbName string `protobuf:"bytes,3,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	// user and password are the credentials of a replication user of the
+	// mysqld, which is never written to.
+	User     string `protobuf:"bytes,4,opt,name=user,proto3" json:"user,omitempty"`
+	Password string `protobuf:"bytes,5,opt,name=password,proto3" json:"password,omitempty"`
+}
+
+func (x *ExternalMysql) Reset() {
+	*x = ExternalMysql{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_topodata_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExternalMysql) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExternalMysql) ProtoMessage() {}
+
+func (x *ExternalMysql) ProtoReflect() protoreflect.Message {
+	mi := &file_topodata_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExternalMysql.ProtoReflect.Descriptor instead.
+func (*ExternalMysql) Descriptor() ([]byte, []int) {
+	return file_topodata_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ExternalMysql) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *ExternalMysql) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *ExternalMysql) GetDbName() string {
+	if x != nil {
+		return x.DbName
+	}
+	return ""
+}
+
+func (x *ExternalMysql) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *ExternalMysql) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+// ExternalClusters
+type ExternalClusters struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VitessCluster []*ExternalVitessCluster `protobuf:"bytes,1,rep,name=vitess_cluster,json=vitessCluster,proto3" json:"vitess_cluster,omitempty"`
+}
+
+func (x *ExternalClusters) Reset() {
+	*x = ExternalClusters{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_topodata_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExternalClusters) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExternalClusters) ProtoMessage() {}
+
+func (x *ExternalClusters) ProtoReflect() protoreflect.Message {
+	mi := &file_topodata_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExternalClusters.ProtoReflect.Descriptor instead.
+func (*ExternalClusters) Descriptor() ([]byte, []int) {
+	return file_topodata_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ExternalClusters) GetVitessCluster() []*ExternalVitessCluster {
+	if x != nil {
+		return x.VitessCluster
+	}
+	return nil
+}
+
+// DynamicTabletConfig contains the tabletserver settings which vttablet
+// reloads from the topology at runtime, without a restart. It is stored
+// for a keyspace in the global topology, and for a tablet in the cell of
+// the tablet, in which case its settings override the ones of the keyspace.
+// The settings which are not set (0 or empty) keep the value of the
+// vttablet flags.
+type DynamicTabletConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// pool_size is queryserver-config-pool-size.
+	PoolSize int32 `protobuf:"varint,1,opt,name=pool_size,json=poolSize,proto3" json:"pool_size,omitempty"`
+	// stream_pool_size is queryserver-config-stream-pool-size.
+	StreamPoolSize int32 `protobuf:"varint,2,opt,name=stream_pool_size,json=streamPoolSize,proto3" json:"stream_pool_size,omitempty"`
+	// transaction_pool_size is queryserver-config-transaction-cap.
+	TransactionPoolSize int32 `protobuf:"varint,3,opt,name=transaction_pool_size,json=transactionPoolSize,proto3" json:"transaction_pool_size,omitempty"`
+	// query_timeout_seconds is queryserver-config-query-timeout.
+	QueryTimeoutSeconds float64 `protobuf:"fixed64,4,opt,name=query_timeout_seconds,json=queryTimeoutSeconds,proto3" json:"query_timeout_seconds,omitempty"`
+	// transaction_timeout_seconds is queryserver-config-transaction-timeout.
+	TransactionTimeoutSeconds float64 `protobuf:"fixed64,5,opt,name=transaction_timeout_seconds,json=transactionTimeoutSeconds,proto3" json:"transaction_timeout_seconds,omitempty"`
+	// max_result_size is queryserver-config-max-result-size.
+	MaxResultSize int32 `protobuf:"varint,6,opt,name=max_result_size,json=maxResultSize,proto3" json:"max_result_size,omitempty"`
+	// warn_result_size is queryserver-config-warn-result-size.
+	WarnResultSize int32 `protobuf:"varint,7,opt,name=warn_result_size,json=warnResultSize,proto3" json:"warn_result_size,omitempty"`
+	// consolidator is the consolidator mode: enable, disable or notOnPrimary.
+	Consolidator string `protobuf:"bytes,8,opt,name=consolidator,proto3" json:"consolidator,omitempty"`
+}
+
+func (x *DynamicTabletConfig) Reset() {
+	*x = DynamicTabletConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_topodata_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DynamicTabletConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DynamicTabletConfig) ProtoMessage() {}
+
+func (x *DynamicTabletConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_topodata_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DynamicTabletConfig.ProtoReflect.Descriptor instead.
+func (*DynamicTabletConfig) Descriptor() ([]byte, []int) {
+	return file_topodata_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *DynamicTabletConfig) GetPoolSize() int32 {
+	if x != nil {
+		return x.PoolSize
+	}
+	return 0
+}
+
+func (x *DynamicTabletConfig) GetStreamPoolSize() int32 {
+	if x != nil {
+		return x.StreamPoolSize
+	}
+	return 0
+}
+
+func (x *DynamicTabletConfig) GetTransactionPoolSize() int32 {
+	if x != nil {
+		return x.TransactionPoolSize
+	}
+	return 0
+}
+
+func (x *DynamicTabletConfig) GetQueryTimeoutSeconds() float64 {
+	if x != nil {
+		return x.QueryTimeoutSeconds
+	}
+	return 0
+}
+
+func (x *DynamicTabletConfig) GetTransactionTimeoutSeconds() float64 {
+	if x != nil {
+		return x.TransactionTimeoutSeconds
+	}
+	return 0
+}
+
+func (x *DynamicTabletConfig) GetMaxResultSize() int32 {
+	if x != nil {
+		return x.MaxResultSize
+	}
+	return 0
+}
+
+func (x *DynamicTabletConfig) GetWarnResultSize() int32 {
+	if x != nil {
+		return x.WarnResultSize
+	}
+	return 0
+}
+
+func (x *DynamicTabletConfig) GetConsolidator() string {
+	if x != nil {
+		return x.Consolidator
+	}
+	return ""
+}
+
+// RecoverySettings controls the automatic recoveries of VTOrc. It is stored
+// in the global topology, so that all the VTOrc instances agree on it. The
+// problems are still detected and reported when the recoveries are
+// disabled.
+type RecoverySettings struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// disabled disables the recoveries of all the keyspaces.
+	Disabled bool `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	// disabled_keyspaces are the keyspaces whose recoveries are disabled.
+	DisabledKeyspaces []string `protobuf:"bytes,2,rep,name=disabled_keyspaces,json=disabledKeyspaces,proto3" json:"disabled_keyspaces,omitempty"`
+	// disabled_shards are the shards whose recoveries are disabled, as
+	// "keyspace/shard".
+	DisabledShards     []string                              `protobuf:"bytes,3,rep,name=disabled_shards,json=disabledShards,proto3" json:"disabled_shards,omitempty"`
+	MaintenanceWindows []*RecoverySettings_MaintenanceWindow `protobuf:"bytes,4,rep,name=maintenance_windows,json=maintenanceWindows,proto3" json:"maintenance_windows,omitempty"`
+}
+
+func (x *RecoverySettings) Reset() {
+	*x = RecoverySettings{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_topodata_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecoverySettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecoverySettings) ProtoMessage() {}
+
+func (x *RecoverySettings) ProtoReflect() protoreflect.Message {
+	mi := &file_topodata_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecoverySettings.ProtoReflect.Descriptor instead.
+func (*RecoverySettings) Descriptor() ([]byte, []int) {
+	return file_topodata_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RecoverySettings) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *RecoverySettings) GetDisabledKeyspaces() []string {
 	if x != nil {
-		return x.Cells
+		return x.DisabledKeyspaces
 	}
 	return nil
 }
 
-type TopoConfig struct {
+func (x *RecoverySettings) GetDisabledShards() []string {
+	if x != nil {
+		return x.DisabledShards
+	}
+	return nil
+}
+
+func (x *RecoverySettings) GetMaintenanceWindows() []*RecoverySettings_MaintenanceWindow {
+	if x != nil {
+		return x.MaintenanceWindows
+	}
+	return nil
+}
+
+// ReferenceTablesWorkflow is a managed workflow that replicates reference
+// tables from a source keyspace to all the shards of target keyspaces. It is
+// stored in the global topology, so that the streams of the shards added to
+// the target keyspaces, by a resharding, can be created when the workflow is
+// refreshed.
+type ReferenceTablesWorkflow struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TopoType string `protobuf:"bytes,1,opt,name=topo_type,json=topoType,proto3" json:"topo_type,omitempty"`
-	Server   string `protobuf:"bytes,2,opt,name=server,proto3" json:"server,omitempty"`
-	Root     string `protobuf:"bytes,3,opt,name=root,proto3" json:"root,omitempty"`
-}
-
-func (x *TopoConfig) Reset() {
-	*x = TopoConfig{}
+	// name is the name of the workflow, and of its streams on the target
+	// shards.
+	Name            string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	SourceKeyspace  string   `protobuf:"bytes,2,opt,name=source_keyspace,json=sourceKeyspace,proto3" json:"source_keyspace,omitempty"`
+	TargetKeyspaces []string `protobuf:"bytes,3,rep,name=target_keyspaces,json=targetKeyspaces,proto3" json:"target_keyspaces,omitempty"`
+	Tables          []string `protobuf:"bytes,4,rep,name=tables,proto3" json:"tables,omitempty"`
+	// cells are the cells, or cell aliases, to replicate from. If empty, the
+	// cell of each target primary is used.
+	Cells []string `protobuf:"bytes,5,rep,name=cells,proto3" json:"cells,omitempty"`
+	// tablet_types are the source tablet types to replicate from, in order of
+	// preference.
+	TabletTypes []TabletType `protobuf:"varint,6,rep,packed,name=tablet_types,json=tabletTypes,proto3,enum=topodata.TabletType" json:"tablet_types,omitempty"`
+}
+
+func (x *ReferenceTablesWorkflow) Reset() {
+	*x = ReferenceTablesWorkflow{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_topodata_proto_msgTypes[11]
+		mi := &file_topodata_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TopoConfig) String() string {
+func (x *ReferenceTablesWorkflow) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TopoConfig) ProtoMessage() {}
+func (*ReferenceTablesWorkflow) ProtoMessage() {}
 
-func (x *TopoConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_topodata_proto_msgTypes[11]
+func (x *ReferenceTablesWorkflow) ProtoReflect() protoreflect.Message {
+	mi := &file_topodata_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1144,57 +2066,87 @@ func (x *TopoConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TopoConfig.ProtoReflect.Descriptor instead.
-func (*TopoConfig) Descriptor() ([]byte, []int) {
-	return file_topodata_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use ReferenceTablesWorkflow.ProtoReflect.Descriptor instead.
+func (*ReferenceTablesWorkflow) Descriptor() ([]byte, []int) {
+	return file_topodata_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *TopoConfig) GetTopoType() string {
+func (x *ReferenceTablesWorkflow) GetName() string {
 	if x != nil {
-		return x.TopoType
+		return x.Name
 	}
 	return ""
 }
 
-func (x *TopoConfig) GetServer() string {
+func (x *ReferenceTablesWorkflow) GetSourceKeyspace() string {
 	if x != nil {
-		return x.Server
+		return x.SourceKeyspace
 	}
 	return ""
 }
 
-func (x *TopoConfig) GetRoot() string {
+func (x *ReferenceTablesWorkflow) GetTargetKeyspaces() []string {
 	if x != nil {
-		return x.Root
+		return x.TargetKeyspaces
 	}
-	return ""
+	return nil
 }
 
-type ExternalVitessCluster struct {
+func (x *ReferenceTablesWorkflow) GetTables() []string {
+	if x != nil {
+		return x.Tables
+	}
+	return nil
+}
+
+func (x *ReferenceTablesWorkflow) GetCells() []string {
+	if x != nil {
+		return x.Cells
+	}
+	return nil
+}
+
+func (x *ReferenceTablesWorkflow) GetTabletTypes() []TabletType {
+	if x != nil {
+		return x.TabletTypes
+	}
+	return nil
+}
+
+// DDLStatus is the status of a DDL that vtgate applied to the shards of a
+// keyspace. It is stored in the global topology, so that it can be retrieved
+// by its uuid from any vtgate or vtctld.
+type DDLStatus struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TopoConfig *TopoConfig `protobuf:"bytes,1,opt,name=topo_config,json=topoConfig,proto3" json:"topo_config,omitempty"`
+	Uuid      string       `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Keyspace  string       `protobuf:"bytes,2,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	Sql       string       `protobuf:"bytes,3,opt,name=sql,proto3" json:"sql,omitempty"`
+	StartedAt *vttime.Time `protobuf:"bytes,4,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	// completed_at is set once the DDL is complete or failed on all the shards.
+	CompletedAt *vttime.Time      `protobuf:"bytes,5,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	Shards      []*DDLShardStatus `protobuf:"bytes,6,rep,name=shards,proto3" json:"shards,omitempty"`
 }
 
-func (x *ExternalVitessCluster) Reset() {
-	*x = ExternalVitessCluster{}
+func (x *DDLStatus) Reset() {
+	*x = DDLStatus{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_topodata_proto_msgTypes[12]
+		mi := &file_topodata_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ExternalVitessCluster) String() string {
+func (x *DDLStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExternalVitessCluster) ProtoMessage() {}
+func (*DDLStatus) ProtoMessage() {}
 
-func (x *ExternalVitessCluster) ProtoReflect() protoreflect.Message {
-	mi := &file_topodata_proto_msgTypes[12]
+func (x *DDLStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_topodata_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1205,44 +2157,82 @@ func (x *ExternalVitessCluster) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExternalVitessCluster.ProtoReflect.Descriptor instead.
-func (*ExternalVitessCluster) Descriptor() ([]byte, []int) {
-	return file_topodata_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use DDLStatus.ProtoReflect.Descriptor instead.
+func (*DDLStatus) Descriptor() ([]byte, []int) {
+	return file_topodata_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *ExternalVitessCluster) GetTopoConfig() *TopoConfig {
+func (x *DDLStatus) GetUuid() string {
 	if x != nil {
-		return x.TopoConfig
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *DDLStatus) GetKeyspace() string {
+	if x != nil {
+		return x.Keyspace
+	}
+	return ""
+}
+
+func (x *DDLStatus) GetSql() string {
+	if x != nil {
+		return x.Sql
+	}
+	return ""
+}
+
+func (x *DDLStatus) GetStartedAt() *vttime.Time {
+	if x != nil {
+		return x.StartedAt
 	}
 	return nil
 }
 
-// ExternalClusters
-type ExternalClusters struct {
+func (x *DDLStatus) GetCompletedAt() *vttime.Time {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *DDLStatus) GetShards() []*DDLShardStatus {
+	if x != nil {
+		return x.Shards
+	}
+	return nil
+}
+
+// DDLShardStatus is the status of a DDL on a shard.
+type DDLShardStatus struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	VitessCluster []*ExternalVitessCluster `protobuf:"bytes,1,rep,name=vitess_cluster,json=vitessCluster,proto3" json:"vitess_cluster,omitempty"`
+	Shard string               `protobuf:"bytes,1,opt,name=shard,proto3" json:"shard,omitempty"`
+	State DDLShardStatus_State `protobuf:"varint,2,opt,name=state,proto3,enum=topodata.DDLShardStatus_State" json:"state,omitempty"`
+	// error is the error of the DDL on the shard, if it failed.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (x *ExternalClusters) Reset() {
-	*x = ExternalClusters{}
+func (x *DDLShardStatus) Reset() {
+	*x = DDLShardStatus{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_topodata_proto_msgTypes[13]
+		mi := &file_topodata_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ExternalClusters) String() string {
+func (x *DDLShardStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExternalClusters) ProtoMessage() {}
+func (*DDLShardStatus) ProtoMessage() {}
 
-func (x *ExternalClusters) ProtoReflect() protoreflect.Message {
-	mi := &file_topodata_proto_msgTypes[13]
+func (x *DDLShardStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_topodata_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1253,16 +2243,30 @@ func (x *ExternalClusters) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExternalClusters.ProtoReflect.Descriptor instead.
-func (*ExternalClusters) Descriptor() ([]byte, []int) {
-	return file_topodata_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use DDLShardStatus.ProtoReflect.Descriptor instead.
+func (*DDLShardStatus) Descriptor() ([]byte, []int) {
+	return file_topodata_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *ExternalClusters) GetVitessCluster() []*ExternalVitessCluster {
+func (x *DDLShardStatus) GetShard() string {
 	if x != nil {
-		return x.VitessCluster
+		return x.Shard
 	}
-	return nil
+	return ""
+}
+
+func (x *DDLShardStatus) GetState() DDLShardStatus_State {
+	if x != nil {
+		return x.State
+	}
+	return DDLShardStatus_QUEUED
+}
+
+func (x *DDLShardStatus) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
 }
 
 // SourceShard represents a data source for filtered replication
@@ -1288,7 +2292,7 @@ type Shard_SourceShard struct {
 func (x *Shard_SourceShard) Reset() {
 	*x = Shard_SourceShard{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_topodata_proto_msgTypes[16]
+		mi := &file_topodata_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1301,7 +2305,7 @@ func (x *Shard_SourceShard) String() string {
 func (*Shard_SourceShard) ProtoMessage() {}
 
 func (x *Shard_SourceShard) ProtoReflect() protoreflect.Message {
-	mi := &file_topodata_proto_msgTypes[16]
+	mi := &file_topodata_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1370,7 +2374,7 @@ type Shard_TabletControl struct {
 func (x *Shard_TabletControl) Reset() {
 	*x = Shard_TabletControl{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_topodata_proto_msgTypes[17]
+		mi := &file_topodata_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1383,7 +2387,7 @@ func (x *Shard_TabletControl) String() string {
 func (*Shard_TabletControl) ProtoMessage() {}
 
 func (x *Shard_TabletControl) ProtoReflect() protoreflect.Message {
-	mi := &file_topodata_proto_msgTypes[17]
+	mi := &file_topodata_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1427,6 +2431,65 @@ func (x *Shard_TabletControl) GetFrozen() bool {
 	return false
 }
 
+// WriteFence describes a fence that makes the shard primary deny writes
+// while it keeps serving reads.
+type Shard_WriteFence struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// reason is surfaced in the error returned to the rejected writes.
+	Reason string `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	// time_created is the time at which the fence was set.
+	TimeCreated *vttime.Time `protobuf:"bytes,2,opt,name=time_created,json=timeCreated,proto3" json:"time_created,omitempty"`
+}
+
+func (x *Shard_WriteFence) Reset() {
+	*x = Shard_WriteFence{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_topodata_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Shard_WriteFence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Shard_WriteFence) ProtoMessage() {}
+
+func (x *Shard_WriteFence) ProtoReflect() protoreflect.Message {
+	mi := &file_topodata_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Shard_WriteFence.ProtoReflect.Descriptor instead.
+func (*Shard_WriteFence) Descriptor() ([]byte, []int) {
+	return file_topodata_proto_rawDescGZIP(), []int{3, 2}
+}
+
+func (x *Shard_WriteFence) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Shard_WriteFence) GetTimeCreated() *vttime.Time {
+	if x != nil {
+		return x.TimeCreated
+	}
+	return nil
+}
+
 // ServedFrom indicates a relationship between a TabletType and the
 // keyspace name that's serving it.
 type Keyspace_ServedFrom struct {
@@ -1445,7 +2508,7 @@ type Keyspace_ServedFrom struct {
 func (x *Keyspace_ServedFrom) Reset() {
 	*x = Keyspace_ServedFrom{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_topodata_proto_msgTypes[18]
+		mi := &file_topodata_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1458,7 +2521,7 @@ func (x *Keyspace_ServedFrom) String() string {
 func (*Keyspace_ServedFrom) ProtoMessage() {}
 
 func (x *Keyspace_ServedFrom) ProtoReflect() protoreflect.Message {
-	mi := &file_topodata_proto_msgTypes[18]
+	mi := &file_topodata_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1474,25 +2537,155 @@ func (*Keyspace_ServedFrom) Descriptor() ([]byte, []int) {
 	return file_topodata_proto_rawDescGZIP(), []int{4, 0}
 }
 
-func (x *Keyspace_ServedFrom) GetTabletType() TabletType {
+func (x *Keyspace_ServedFrom) GetTabletType() TabletType {
+	if x != nil {
+		return x.TabletType
+	}
+	return TabletType_UNKNOWN
+}
+
+func (x *Keyspace_ServedFrom) GetCells() []string {
+	if x != nil {
+		return x.Cells
+	}
+	return nil
+}
+
+func (x *Keyspace_ServedFrom) GetKeyspace() string {
+	if x != nil {
+		return x.Keyspace
+	}
+	return ""
+}
+
+// Metric is a metric checked by the throttler.
+type ThrottlerConfig_Metric struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// query returns the metric. It is either a SELECT returning a single
+	// row with a single value, or a SHOW GLOBAL ... LIKE ... query. It is
+	// optional for the builtin metrics.
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// threshold above which the apps are throttled.
+	Threshold float64 `protobuf:"fixed64,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+}
+
+func (x *ThrottlerConfig_Metric) Reset() {
+	*x = ThrottlerConfig_Metric{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_topodata_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ThrottlerConfig_Metric) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ThrottlerConfig_Metric) ProtoMessage() {}
+
+func (x *ThrottlerConfig_Metric) ProtoReflect() protoreflect.Message {
+	mi := &file_topodata_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThrottlerConfig_Metric.ProtoReflect.Descriptor instead.
+func (*ThrottlerConfig_Metric) Descriptor() ([]byte, []int) {
+	return file_topodata_proto_rawDescGZIP(), []int{8, 0}
+}
+
+func (x *ThrottlerConfig_Metric) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *ThrottlerConfig_Metric) GetThreshold() float64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+// AppPolicy configures how an app is throttled.
+type ThrottlerConfig_AppPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// metrics are the metrics checked for the app. All the metrics are
+	// checked if empty.
+	Metrics []string `protobuf:"bytes,1,rep,name=metrics,proto3" json:"metrics,omitempty"`
+	// thresholds override the thresholds of the metrics, by metric name.
+	Thresholds map[string]float64 `protobuf:"bytes,2,rep,name=thresholds,proto3" json:"thresholds,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	// priority of the app. While an app is throttled on a metric, the
+	// apps of lower priorities are throttled too. Requests of low priority
+	// apps (p=low) have a priority of -1 at most. The default is 0.
+	Priority int32 `protobuf:"varint,3,opt,name=priority,proto3" json:"priority,omitempty"`
+}
+
+func (x *ThrottlerConfig_AppPolicy) Reset() {
+	*x = ThrottlerConfig_AppPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_topodata_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ThrottlerConfig_AppPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ThrottlerConfig_AppPolicy) ProtoMessage() {}
+
+func (x *ThrottlerConfig_AppPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_topodata_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThrottlerConfig_AppPolicy.ProtoReflect.Descriptor instead.
+func (*ThrottlerConfig_AppPolicy) Descriptor() ([]byte, []int) {
+	return file_topodata_proto_rawDescGZIP(), []int{8, 1}
+}
+
+func (x *ThrottlerConfig_AppPolicy) GetMetrics() []string {
 	if x != nil {
-		return x.TabletType
+		return x.Metrics
 	}
-	return TabletType_UNKNOWN
+	return nil
 }
 
-func (x *Keyspace_ServedFrom) GetCells() []string {
+func (x *ThrottlerConfig_AppPolicy) GetThresholds() map[string]float64 {
 	if x != nil {
-		return x.Cells
+		return x.Thresholds
 	}
 	return nil
 }
 
-func (x *Keyspace_ServedFrom) GetKeyspace() string {
+func (x *ThrottlerConfig_AppPolicy) GetPriority() int32 {
 	if x != nil {
-		return x.Keyspace
+		return x.Priority
 	}
-	return ""
+	return 0
 }
 
 // Node describes a tablet instance within the cell
@@ -1507,7 +2700,7 @@ type ShardReplication_Node struct {
 func (x *ShardReplication_Node) Reset() {
 	*x = ShardReplication_Node{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_topodata_proto_msgTypes[19]
+		mi := &file_topodata_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1520,7 +2713,7 @@ func (x *ShardReplication_Node) String() string {
 func (*ShardReplication_Node) ProtoMessage() {}
 
 func (x *ShardReplication_Node) ProtoReflect() protoreflect.Message {
-	mi := &file_topodata_proto_msgTypes[19]
+	mi := &file_topodata_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1533,7 +2726,7 @@ func (x *ShardReplication_Node) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ShardReplication_Node.ProtoReflect.Descriptor instead.
 func (*ShardReplication_Node) Descriptor() ([]byte, []int) {
-	return file_topodata_proto_rawDescGZIP(), []int{5, 0}
+	return file_topodata_proto_rawDescGZIP(), []int{9, 0}
 }
 
 func (x *ShardReplication_Node) GetTabletAlias() *TabletAlias {
@@ -1559,7 +2752,7 @@ type SrvKeyspace_KeyspacePartition struct {
 func (x *SrvKeyspace_KeyspacePartition) Reset() {
 	*x = SrvKeyspace_KeyspacePartition{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_topodata_proto_msgTypes[20]
+		mi := &file_topodata_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1572,7 +2765,7 @@ func (x *SrvKeyspace_KeyspacePartition) String() string {
 func (*SrvKeyspace_KeyspacePartition) ProtoMessage() {}
 
 func (x *SrvKeyspace_KeyspacePartition) ProtoReflect() protoreflect.Message {
-	mi := &file_topodata_proto_msgTypes[20]
+	mi := &file_topodata_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1585,7 +2778,7 @@ func (x *SrvKeyspace_KeyspacePartition) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SrvKeyspace_KeyspacePartition.ProtoReflect.Descriptor instead.
 func (*SrvKeyspace_KeyspacePartition) Descriptor() ([]byte, []int) {
-	return file_topodata_proto_rawDescGZIP(), []int{8, 0}
+	return file_topodata_proto_rawDescGZIP(), []int{12, 0}
 }
 
 func (x *SrvKeyspace_KeyspacePartition) GetServedType() TabletType {
@@ -1625,7 +2818,7 @@ type SrvKeyspace_ServedFrom struct {
 func (x *SrvKeyspace_ServedFrom) Reset() {
 	*x = SrvKeyspace_ServedFrom{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_topodata_proto_msgTypes[21]
+		mi := &file_topodata_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1638,7 +2831,7 @@ func (x *SrvKeyspace_ServedFrom) String() string {
 func (*SrvKeyspace_ServedFrom) ProtoMessage() {}
 
 func (x *SrvKeyspace_ServedFrom) ProtoReflect() protoreflect.Message {
-	mi := &file_topodata_proto_msgTypes[21]
+	mi := &file_topodata_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1651,7 +2844,7 @@ func (x *SrvKeyspace_ServedFrom) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SrvKeyspace_ServedFrom.ProtoReflect.Descriptor instead.
 func (*SrvKeyspace_ServedFrom) Descriptor() ([]byte, []int) {
-	return file_topodata_proto_rawDescGZIP(), []int{8, 1}
+	return file_topodata_proto_rawDescGZIP(), []int{12, 1}
 }
 
 func (x *SrvKeyspace_ServedFrom) GetTabletType() TabletType {
@@ -1668,6 +2861,99 @@ func (x *SrvKeyspace_ServedFrom) GetKeyspace() string {
 	return ""
 }
 
+// MaintenanceWindow is a period of time during which the recoveries of a
+// keyspace or a shard, or of all of them, are disabled.
+type RecoverySettings_MaintenanceWindow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name identifies the window.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// keyspace is the keyspace of the window, or empty for all of them.
+	Keyspace string `protobuf:"bytes,2,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	// shard is the shard of the window, or empty for all the shards of the
+	// keyspace.
+	Shard     string       `protobuf:"bytes,3,opt,name=shard,proto3" json:"shard,omitempty"`
+	StartTime *vttime.Time `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   *vttime.Time `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Reason    string       `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *RecoverySettings_MaintenanceWindow) Reset() {
+	*x = RecoverySettings_MaintenanceWindow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_topodata_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecoverySettings_MaintenanceWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecoverySettings_MaintenanceWindow) ProtoMessage() {}
+
+func (x *RecoverySettings_MaintenanceWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_topodata_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecoverySettings_MaintenanceWindow.ProtoReflect.Descriptor instead.
+func (*RecoverySettings_MaintenanceWindow) Descriptor() ([]byte, []int) {
+	return file_topodata_proto_rawDescGZIP(), []int{20, 0}
+}
+
+func (x *RecoverySettings_MaintenanceWindow) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RecoverySettings_MaintenanceWindow) GetKeyspace() string {
+	if x != nil {
+		return x.Keyspace
+	}
+	return ""
+}
+
+func (x *RecoverySettings_MaintenanceWindow) GetShard() string {
+	if x != nil {
+		return x.Shard
+	}
+	return ""
+}
+
+func (x *RecoverySettings_MaintenanceWindow) GetStartTime() *vttime.Time {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *RecoverySettings_MaintenanceWindow) GetEndTime() *vttime.Time {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *RecoverySettings_MaintenanceWindow) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
 var File_topodata_proto protoreflect.FileDescriptor
 
 var file_topodata_proto_rawDesc = []byte{
@@ -1680,7 +2966,7 @@ var file_topodata_proto_rawDesc = []byte{
 	0x54, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x63,
 	0x65, 0x6c, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x65, 0x6c, 0x6c, 0x12,
 	0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x75, 0x69,
-	0x64, 0x22, 0xfe, 0x04, 0x0a, 0x06, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x12, 0x2b, 0x0a, 0x05,
+	0x64, 0x22, 0xba, 0x05, 0x0a, 0x06, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x12, 0x2b, 0x0a, 0x05,
 	0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x6f,
 	0x70, 0x6f, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x41, 0x6c, 0x69,
 	0x61, 0x73, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73,
@@ -1712,59 +2998,72 @@ var file_topodata_proto_rawDesc = []byte{
 	0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
 	0x76, 0x74, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x14, 0x70, 0x72, 0x69,
 	0x6d, 0x61, 0x72, 0x79, 0x54, 0x65, 0x72, 0x6d, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d,
-	0x65, 0x1a, 0x3a, 0x0a, 0x0c, 0x50, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x37, 0x0a,
-	0x09, 0x54, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x4a, 0x04, 0x08, 0x0b,
-	0x10, 0x0c, 0x22, 0xbc, 0x05, 0x0a, 0x05, 0x53, 0x68, 0x61, 0x72, 0x64, 0x12, 0x3a, 0x0a, 0x0d,
-	0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x5f, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x6f, 0x70, 0x6f, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x54,
-	0x61, 0x62, 0x6c, 0x65, 0x74, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x52, 0x0c, 0x70, 0x72, 0x69, 0x6d,
-	0x61, 0x72, 0x79, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x43, 0x0a, 0x17, 0x70, 0x72, 0x69, 0x6d,
-	0x61, 0x72, 0x79, 0x5f, 0x74, 0x65, 0x72, 0x6d, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74,
-	0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x76, 0x74, 0x74, 0x69,
-	0x6d, 0x65, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x14, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79,
-	0x54, 0x65, 0x72, 0x6d, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2f, 0x0a,
-	0x09, 0x6b, 0x65, 0x79, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x12, 0x2e, 0x74, 0x6f, 0x70, 0x6f, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4b, 0x65, 0x79, 0x52,
-	0x61, 0x6e, 0x67, 0x65, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x40,
-	0x0a, 0x0d, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x64, 0x73, 0x18,
-	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x6f, 0x70, 0x6f, 0x64, 0x61, 0x74, 0x61,
-	0x2e, 0x53, 0x68, 0x61, 0x72, 0x64, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x68, 0x61,
-	0x72, 0x64, 0x52, 0x0c, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x68, 0x61, 0x72, 0x64, 0x73,
-	0x12, 0x46, 0x0a, 0x0f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72,
-	0x6f, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x74, 0x6f, 0x70, 0x6f,
-	0x64, 0x61, 0x74, 0x61, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x64, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65,
-	0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x0e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x74,
-	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x69, 0x73, 0x5f, 0x70,
-	0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x6e, 0x67, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x69, 0x73, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x6e, 0x67, 0x1a, 0x9a, 0x01, 0x0a, 0x0b, 0x53, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x53, 0x68, 0x61, 0x72, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x73,
-	0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x73,
-	0x70, 0x61, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x61, 0x72, 0x64, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x61, 0x72, 0x64, 0x12, 0x2f, 0x0a, 0x09, 0x6b, 0x65,
-	0x79, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
-	0x74, 0x6f, 0x70, 0x6f, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4b, 0x65, 0x79, 0x52, 0x61, 0x6e, 0x67,
-	0x65, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74,
-	0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x62,
-	0x6c, 0x65, 0x73, 0x1a, 0x9f, 0x01, 0x0a, 0x0d, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x43, 0x6f,
-	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x35, 0x0a, 0x0b, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x5f,
-	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x74, 0x6f, 0x70,
-	0x6f, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65,
-	0x52, 0x0a, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05,
-	0x63, 0x65, 0x6c, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x63, 0x65, 0x6c,
-	0x6c, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x6e, 0x69, 0x65, 0x64, 0x5f, 0x74, 0x61, 0x62,
-	0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x65, 0x6e, 0x69, 0x65,
-	0x64, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x72, 0x6f, 0x7a, 0x65,
-	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x66, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x4a,
-	0x04, 0x08, 0x03, 0x10, 0x04, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x4a, 0x04, 0x08, 0x05, 0x10,
-	0x06, 0x22, 0xdc, 0x03, 0x0a, 0x08, 0x4b, 0x65, 0x79, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x30,
+	0x65, 0x12, 0x3a, 0x0a, 0x19, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x17, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x1a, 0x3a, 0x0a,
+	0x0c, 0x50, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x37, 0x0a, 0x09, 0x54, 0x61, 0x67,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x4a, 0x04, 0x08, 0x0b, 0x10, 0x0c, 0x22, 0xd0,
+	0x06, 0x0a, 0x05, 0x53, 0x68, 0x61, 0x72, 0x64, 0x12, 0x3a, 0x0a, 0x0d, 0x70, 0x72, 0x69, 0x6d,
+	0x61, 0x72, 0x79, 0x5f, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x74, 0x6f, 0x70, 0x6f, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65,
+	0x74, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x52, 0x0c, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x41,
+	0x6c, 0x69, 0x61, 0x73, 0x12, 0x43, 0x0a, 0x17, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x5f,
+	0x74, 0x65, 0x72, 0x6d, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x76, 0x74, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x52, 0x14, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x54, 0x65, 0x72, 0x6d,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2f, 0x0a, 0x09, 0x6b, 0x65, 0x79,
+	0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74,
+	0x6f, 0x70, 0x6f, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4b, 0x65, 0x79, 0x52, 0x61, 0x6e, 0x67, 0x65,
+	0x52, 0x08, 0x6b, 0x65, 0x79, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x40, 0x0a, 0x0d, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x6f, 0x70, 0x6f, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x53, 0x68, 0x61,
+	0x72, 0x64, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x68, 0x61, 0x72, 0x64, 0x52, 0x0c,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x68, 0x61, 0x72, 0x64, 0x73, 0x12, 0x46, 0x0a, 0x0f,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x74, 0x6f, 0x70, 0x6f, 0x64, 0x61, 0x74, 0x61,
+	0x2e, 0x53, 0x68, 0x61, 0x72, 0x64, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x52, 0x0e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x69, 0x73, 0x5f, 0x70, 0x72, 0x69, 0x6d, 0x61,
+	0x72, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x10, 0x69, 0x73, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x6e, 0x67, 0x12, 0x3b, 0x0a, 0x0b, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x66, 0x65, 0x6e, 0x63,
+	0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x6f, 0x70, 0x6f, 0x64, 0x61,
+	0x74, 0x61, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x64, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x46, 0x65,
+	0x6e, 0x63, 0x65, 0x52, 0x0a, 0x77, 0x72, 0x69, 0x74, 0x65, 0x46, 0x65, 0x6e, 0x63, 0x65, 0x1a,
+	0x9a, 0x01, 0x0a, 0x0b, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x68, 0x61, 0x72, 0x64, 0x12,
+	0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x75, 0x69,
+	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x68, 0x61, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68,
+	0x61, 0x72, 0x64, 0x12, 0x2f, 0x0a, 0x09, 0x6b, 0x65, 0x79, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x6f, 0x70, 0x6f, 0x64, 0x61, 0x74,
+	0x61, 0x2e, 0x4b, 0x65, 0x79, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x52,
+	0x61, 0x6e, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x1a, 0x9f, 0x01, 0x0a,
+	0x0d, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x35,
+	0x0a, 0x0b, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x74, 0x6f, 0x70, 0x6f, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x54,
+	0x61, 0x62, 0x6c, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x64,
+	0x65, 0x6e, 0x69, 0x65, 0x64, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0c, 0x64, 0x65, 0x6e, 0x69, 0x65, 0x64, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x66, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x66, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x1a, 0x55,
+	0x0a, 0x0a, 0x57, 0x72, 0x69, 0x74, 0x65, 0x46, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x76, 0x74, 0x74,
+	0x69, 0x6d, 0x65, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x4a, 0x04, 0x08, 0x05, 0x10,
+	0x06, 0x22, 0xb7, 0x05, 0x0a, 0x08, 0x4b, 0x65, 0x79, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x30,
 	0x0a, 0x14, 0x73, 0x68, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d,
 	0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x73, 0x68,
 	0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4e, 0x61, 0x6d, 0x65,
@@ -1786,119 +3085,316 @@ var file_topodata_proto_rawDesc = []byte{
 	0x12, 0x31, 0x0a, 0x0d, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x74, 0x69, 0x6d,
 	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x76, 0x74, 0x74, 0x69, 0x6d, 0x65,
 	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x0c, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x54,
-	0x69, 0x6d, 0x65, 0x1a, 0x75, 0x0a, 0x0a, 0x53, 0x65, 0x72, 0x76, 0x65, 0x64, 0x46, 0x72, 0x6f,
-	0x6d, 0x12, 0x35, 0x0a, 0x0b, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x74, 0x6f, 0x70, 0x6f, 0x64, 0x61, 0x74,
-	0x61, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x74, 0x61,
-	0x62, 0x6c, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x65, 0x6c, 0x6c,
-	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x12, 0x1a,
-	0x0a, 0x08, 0x6b, 0x65, 0x79, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x6b, 0x65, 0x79, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04,
-	0x22, 0x8b, 0x01, 0x0a, 0x10, 0x53, 0x68, 0x61, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x74, 0x6f, 0x70, 0x6f, 0x64, 0x61, 0x74, 0x61, 0x2e,
-	0x53, 0x68, 0x61, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x1a, 0x40, 0x0a, 0x04,
-	0x4e, 0x6f, 0x64, 0x65, 0x12, 0x38, 0x0a, 0x0c, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x5f, 0x61,
-	0x6c, 0x69, 0x61, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x6f, 0x70,
-	0x6f, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x41, 0x6c, 0x69, 0x61,
-	0x73, 0x52, 0x0b, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x22, 0x55,
-	0x0a, 0x0e, 0x53, 0x68, 0x61, 0x72, 0x64, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2f, 0x0a, 0x09, 0x6b, 0x65, 0x79, 0x5f, 0x72, 0x61, 0x6e, 0x67,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x6f, 0x70, 0x6f, 0x64, 0x61,
-	0x74, 0x61, 0x2e, 0x4b, 0x65, 0x79, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x08, 0x6b, 0x65, 0x79,
-	0x52, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x8f, 0x01, 0x0a, 0x12, 0x53, 0x68, 0x61, 0x72, 0x64, 0x54,
-	0x61, 0x62, 0x6c, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x2f, 0x0a, 0x09, 0x6b, 0x65, 0x79, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x6f, 0x70, 0x6f, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4b,
-	0x65, 0x79, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x52, 0x61, 0x6e, 0x67,
-	0x65, 0x12, 0x34, 0x0a, 0x16, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x5f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x14, 0x71, 0x75, 0x65, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44,
-	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0xe2, 0x04, 0x0a, 0x0b, 0x53, 0x72, 0x76, 0x4b,
-	0x65, 0x79, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x74, 0x6f,
-	0x70, 0x6f, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x53, 0x72, 0x76, 0x4b, 0x65, 0x79, 0x73, 0x70, 0x61,
-	0x63, 0x65, 0x2e, 0x4b, 0x65, 0x79, 0x73, 0x70, 0x61, 0x63, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x12, 0x30, 0x0a, 0x14, 0x73, 0x68, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x6c,
-	0x75, 0x6d, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12,
-	0x73, 0x68, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x4a, 0x0a, 0x14, 0x73, 0x68, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x63,
-	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x18, 0x2e, 0x74, 0x6f, 0x70, 0x6f, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4b, 0x65, 0x79, 0x73,
-	0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x54, 0x79, 0x70, 0x65, 0x52, 0x12, 0x73, 0x68, 0x61, 0x72,
-	0x64, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x41,
-	0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x04, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x74, 0x6f, 0x70, 0x6f, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x53,
-	0x72, 0x76, 0x4b, 0x65, 0x79, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65,
-	0x64, 0x46, 0x72, 0x6f, 0x6d, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x46, 0x72, 0x6f,
-	0x6d, 0x1a, 0xe1, 0x01, 0x0a, 0x11, 0x4b, 0x65, 0x79, 0x73, 0x70, 0x61, 0x63, 0x65, 0x50, 0x61,
-	0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x74,
-	0x6f, 0x70, 0x6f, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x54, 0x79,
-	0x70, 0x65, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x43,
-	0x0a, 0x10, 0x73, 0x68, 0x61, 0x72, 0x64, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
-	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x6f, 0x70, 0x6f, 0x64,
-	0x61, 0x74, 0x61, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x64, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
-	0x63, 0x65, 0x52, 0x0f, 0x73, 0x68, 0x61, 0x72, 0x64, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
-	0x63, 0x65, 0x73, 0x12, 0x50, 0x0a, 0x15, 0x73, 0x68, 0x61, 0x72, 0x64, 0x5f, 0x74, 0x61, 0x62,
-	0x6c, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x74, 0x6f, 0x70, 0x6f, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x53, 0x68,
-	0x61, 0x72, 0x64, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
-	0x52, 0x13, 0x73, 0x68, 0x61, 0x72, 0x64, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x43, 0x6f, 0x6e,
-	0x74, 0x72, 0x6f, 0x6c, 0x73, 0x1a, 0x5f, 0x0a, 0x0a, 0x53, 0x65, 0x72, 0x76, 0x65, 0x64, 0x46,
-	0x72, 0x6f, 0x6d, 0x12, 0x35, 0x0a, 0x0b, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x5f, 0x74, 0x79,
-	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x74, 0x6f, 0x70, 0x6f, 0x64,
-	0x61, 0x74, 0x61, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a,
-	0x74, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6b, 0x65,
+	0x69, 0x6d, 0x65, 0x12, 0x44, 0x0a, 0x10, 0x74, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x72,
+	0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x74, 0x6f, 0x70, 0x6f, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x54, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c,
+	0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0f, 0x74, 0x68, 0x72, 0x6f, 0x74, 0x74,
+	0x6c, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2b, 0x0a, 0x11, 0x64, 0x75, 0x72,
+	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x64, 0x75, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x30, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x6f, 0x70, 0x6f, 0x64, 0x61, 0x74,
+	0x61, 0x2e, 0x4b, 0x65, 0x79, 0x73, 0x70, 0x61, 0x63, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x34, 0x0a, 0x0b, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x74, 0x6f, 0x70, 0x6f, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x75,
+	0x6c, 0x65, 0x52, 0x0a, 0x71, 0x75, 0x65, 0x72, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x1a, 0x75,
+	0x0a, 0x0a, 0x53, 0x65, 0x72, 0x76, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x35, 0x0a, 0x0b,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x14, 0x2e, 0x74, 0x6f, 0x70, 0x6f, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x54, 0x61, 0x62,
+	0x6c, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x05, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6b, 0x65, 0x79,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6b, 0x65, 0x79,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x22, 0xdc, 0x01, 0x0a, 0x09,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x20, 0x0a, 0x0b, 0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x70,
+	0x6c, 0x61, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x70, 0x6c, 0x61, 0x6e,
+	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xbe, 0x01, 0x0a, 0x0e, 0x4b,
+	0x65, 0x79, 0x73, 0x70, 0x61, 0x63, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x28, 0x0a,
+	0x10, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x6d,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x54, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f, 0x72,
+	0x6f, 0x77, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x52, 0x6f,
+	0x77, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x77, 0x6f,
+	0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x3c, 0x0a,
+	0x0e, 0x64, 0x65, 0x6e, 0x69, 0x65, 0x64, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x6f, 0x70, 0x6f, 0x64, 0x61, 0x74, 0x61,
+	0x2e, 0x44, 0x65, 0x6e, 0x69, 0x65, 0x64, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x0d, 0x64, 0x65,
+	0x6e, 0x69, 0x65, 0x64, 0x51, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x22, 0x72, 0x0a, 0x0b, 0x44,
+	0x65, 0x6e, 0x69, 0x65, 0x64, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x69,
+	0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72,
+	0x12, 0x2d, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x76, 0x74, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22,
+	0xfb, 0x04, 0x0a, 0x0f, 0x54, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x72, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x40, 0x0a, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x74, 0x6f, 0x70, 0x6f, 0x64, 0x61, 0x74, 0x61, 0x2e,
+	0x54, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
+	0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x6d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x4d, 0x0a, 0x0c, 0x61, 0x70, 0x70, 0x5f, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x74, 0x6f,
+	0x70, 0x6f, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x54, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x72,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x41, 0x70, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69,
+	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x61, 0x70, 0x70, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x69, 0x65, 0x73, 0x1a, 0x3c, 0x0a, 0x06, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x14,
+	0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x1a, 0xd5, 0x01, 0x0a, 0x09, 0x41, 0x70, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x53, 0x0a, 0x0a, 0x74, 0x68,
+	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33,
+	0x2e, 0x74, 0x6f, 0x70, 0x6f, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x54, 0x68, 0x72, 0x6f, 0x74, 0x74,
+	0x6c, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x41, 0x70, 0x70, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x2e, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x0a, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x1a, 0x3d, 0x0a, 0x0f, 0x54,
+	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x5c, 0x0a, 0x0c, 0x4d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x36, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x74, 0x6f,
+	0x70, 0x6f, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x54, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x72,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x63, 0x0a, 0x10, 0x41, 0x70, 0x70, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x39,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x74, 0x6f, 0x70, 0x6f, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x54, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c,
+	0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x41, 0x70, 0x70, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x8b, 0x01,
+	0x0a, 0x10, 0x53, 0x68, 0x61, 0x72, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x74, 0x6f, 0x70, 0x6f, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x53, 0x68, 0x61,
+	0x72, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4e, 0x6f,
+	0x64, 0x65, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x1a, 0x40, 0x0a, 0x04, 0x4e, 0x6f, 0x64,
+	0x65, 0x12, 0x38, 0x0a, 0x0c, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x5f, 0x61, 0x6c, 0x69, 0x61,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x6f, 0x70, 0x6f, 0x64, 0x61,
+	0x74, 0x61, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x52, 0x0b,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x22, 0x55, 0x0a, 0x0e, 0x53,
+	0x68, 0x61, 0x72, 0x64, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x2f, 0x0a, 0x09, 0x6b, 0x65, 0x79, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x6f, 0x70, 0x6f, 0x64, 0x61, 0x74, 0x61, 0x2e,
+	0x4b, 0x65, 0x79, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x22, 0x8f, 0x01, 0x0a, 0x12, 0x53, 0x68, 0x61, 0x72, 0x64, 0x54, 0x61, 0x62, 0x6c,
+	0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2f, 0x0a,
+	0x09, 0x6b, 0x65, 0x79, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x74, 0x6f, 0x70, 0x6f, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4b, 0x65, 0x79, 0x52,
+	0x61, 0x6e, 0x67, 0x65, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x34,
+	0x0a, 0x16, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f,
+	0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14,
+	0x71, 0x75, 0x65, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x69, 0x73, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x22, 0x90, 0x06, 0x0a, 0x0b, 0x53, 0x72, 0x76, 0x4b, 0x65, 0x79, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x74, 0x6f, 0x70, 0x6f, 0x64,
+	0x61, 0x74, 0x61, 0x2e, 0x53, 0x72, 0x76, 0x4b, 0x65, 0x79, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e,
+	0x4b, 0x65, 0x79, 0x73, 0x70, 0x61, 0x63, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x30, 0x0a,
+	0x14, 0x73, 0x68, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x73, 0x68, 0x61,
+	0x72, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x4a, 0x0a, 0x14, 0x73, 0x68, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x6c, 0x75,
+	0x6d, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e,
+	0x74, 0x6f, 0x70, 0x6f, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4b, 0x65, 0x79, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x49, 0x64, 0x54, 0x79, 0x70, 0x65, 0x52, 0x12, 0x73, 0x68, 0x61, 0x72, 0x64, 0x69, 0x6e,
+	0x67, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x41, 0x0a, 0x0b, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x20, 0x2e, 0x74, 0x6f, 0x70, 0x6f, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x53, 0x72, 0x76, 0x4b,
+	0x65, 0x79, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x64, 0x46, 0x72,
+	0x6f, 0x6d, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x44,
+	0x0a, 0x10, 0x74, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x6f, 0x70, 0x6f, 0x64,
+	0x61, 0x74, 0x61, 0x2e, 0x54, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x72, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x0f, 0x74, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x72, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x30, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x6f, 0x70, 0x6f, 0x64, 0x61, 0x74, 0x61, 0x2e,
+	0x4b, 0x65, 0x79, 0x73, 0x70, 0x61, 0x63, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x06,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x34, 0x0a, 0x0b, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f,
+	0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x6f,
+	0x70, 0x6f, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x75, 0x6c, 0x65,
+	0x52, 0x0a, 0x71, 0x75, 0x65, 0x72, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x1a, 0xe1, 0x01, 0x0a,
+	0x11, 0x4b, 0x65, 0x79, 0x73, 0x70, 0x61, 0x63, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x74, 0x6f, 0x70, 0x6f, 0x64, 0x61,
+	0x74, 0x61, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x43, 0x0a, 0x10, 0x73, 0x68, 0x61,
+	0x72, 0x64, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x6f, 0x70, 0x6f, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x53,
+	0x68, 0x61, 0x72, 0x64, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x0f, 0x73,
+	0x68, 0x61, 0x72, 0x64, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x50,
+	0x0a, 0x15, 0x73, 0x68, 0x61, 0x72, 0x64, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x5f, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x74, 0x6f, 0x70, 0x6f, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x64, 0x54, 0x61,
+	0x62, 0x6c, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x13, 0x73, 0x68, 0x61,
+	0x72, 0x64, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x73,
+	0x1a, 0x5f, 0x0a, 0x0a, 0x53, 0x65, 0x72, 0x76, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x35,
+	0x0a, 0x0b, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x74, 0x6f, 0x70, 0x6f, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x54,
+	0x61, 0x62, 0x6c, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x4a, 0x04, 0x08, 0x05, 0x10, 0x06, 0x22, 0x4b, 0x0a, 0x08, 0x43, 0x65, 0x6c, 0x6c, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f,
+	0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x4a, 0x04,
+	0x08, 0x03, 0x10, 0x04, 0x22, 0x22, 0x0a, 0x0a, 0x43, 0x65, 0x6c, 0x6c, 0x73, 0x41, 0x6c, 0x69,
+	0x61, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x05, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x22, 0x55, 0x0a, 0x0a, 0x54, 0x6f, 0x70, 0x6f,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x6f, 0x70, 0x6f, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x6f, 0x70, 0x6f, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x72,
+	0x6f, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x22,
+	0x4e, 0x0a, 0x15, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x56, 0x69, 0x74, 0x65, 0x73,
+	0x73, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x35, 0x0a, 0x0b, 0x74, 0x6f, 0x70, 0x6f,
+	0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x74, 0x6f, 0x70, 0x6f, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x54, 0x6f, 0x70, 0x6f, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x0a, 0x74, 0x6f, 0x70, 0x6f, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22,
+	0x80, 0x01, 0x0a, 0x0d, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4d, 0x79, 0x73, 0x71,
+	0x6c, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x62, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x22, 0x5a, 0x0a, 0x10, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x43, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x12, 0x46, 0x0a, 0x0e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x73,
+	0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x74, 0x6f, 0x70, 0x6f, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x56, 0x69, 0x74, 0x65, 0x73, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52,
+	0x0d, 0x76, 0x69, 0x74, 0x65, 0x73, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x22, 0xfa,
+	0x02, 0x0a, 0x13, 0x44, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x74,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6f, 0x6f, 0x6c, 0x53,
+	0x69, 0x7a, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x70, 0x6f,
+	0x6f, 0x6c, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x32, 0x0a,
+	0x15, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6f, 0x6f,
+	0x6c, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x69, 0x7a,
+	0x65, 0x12, 0x32, 0x0a, 0x15, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x13, 0x71, 0x75, 0x65, 0x72, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x3e, 0x0a, 0x1b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x19, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d,
+	0x6d, 0x61, 0x78, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x28, 0x0a,
+	0x10, 0x77, 0x61, 0x72, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x77, 0x61, 0x72, 0x6e, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x73, 0x6f,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63,
+	0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x22, 0xaf, 0x03, 0x0a, 0x10,
+	0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x2d, 0x0a, 0x12,
+	0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x4b, 0x65, 0x79, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x64,
+	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x64, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x53, 0x68,
+	0x61, 0x72, 0x64, 0x73, 0x12, 0x5d, 0x0a, 0x13, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61,
+	0x6e, 0x63, 0x65, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2c, 0x2e, 0x74, 0x6f, 0x70, 0x6f, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x52, 0x65, 0x63,
+	0x6f, 0x76, 0x65, 0x72, 0x79, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x4d, 0x61,
+	0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52,
+	0x12, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x73, 0x1a, 0xc7, 0x01, 0x0a, 0x11, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61,
+	0x6e, 0x63, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x6b, 0x65, 0x79, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x6b, 0x65, 0x79, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x61,
+	0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x61, 0x72, 0x64, 0x12,
+	0x2b, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x76, 0x74, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x08,
+	0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x76, 0x74, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x07, 0x65, 0x6e,
+	0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xe8, 0x01,
+	0x0a, 0x17, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65,
+	0x73, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a,
+	0x0f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4b, 0x65,
+	0x79, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x5f, 0x6b, 0x65, 0x79, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x06, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x65, 0x6c,
+	0x6c, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x12,
+	0x37, 0x0a, 0x0c, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x74, 0x6f, 0x70, 0x6f, 0x64, 0x61, 0x74, 0x61,
+	0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x22, 0xdd, 0x01, 0x0a, 0x09, 0x44, 0x44, 0x4c,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6b, 0x65,
 	0x79, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6b, 0x65,
-	0x79, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4a, 0x04, 0x08, 0x05, 0x10, 0x06, 0x22, 0x4b, 0x0a, 0x08,
-	0x43, 0x65, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
-	0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72,
-	0x6f, 0x6f, 0x74, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x22, 0x22, 0x0a, 0x0a, 0x43, 0x65, 0x6c,
-	0x6c, 0x73, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x65, 0x6c, 0x6c, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x22, 0x55, 0x0a,
-	0x0a, 0x54, 0x6f, 0x70, 0x6f, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1b, 0x0a, 0x09, 0x74,
-	0x6f, 0x70, 0x6f, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x74, 0x6f, 0x70, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x72, 0x6f, 0x6f, 0x74, 0x22, 0x4e, 0x0a, 0x15, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x56, 0x69, 0x74, 0x65, 0x73, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x35, 0x0a,
-	0x0b, 0x74, 0x6f, 0x70, 0x6f, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x6f, 0x70, 0x6f, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x54, 0x6f,
-	0x70, 0x6f, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0a, 0x74, 0x6f, 0x70, 0x6f, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x22, 0x5a, 0x0a, 0x10, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x12, 0x46, 0x0a, 0x0e, 0x76, 0x69, 0x74, 0x65,
-	0x73, 0x73, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x1f, 0x2e, 0x74, 0x6f, 0x70, 0x6f, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x45, 0x78, 0x74, 0x65,
-	0x72, 0x6e, 0x61, 0x6c, 0x56, 0x69, 0x74, 0x65, 0x73, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
-	0x72, 0x52, 0x0d, 0x76, 0x69, 0x74, 0x65, 0x73, 0x73, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
-	0x2a, 0x28, 0x0a, 0x0c, 0x4b, 0x65, 0x79, 0x73, 0x70, 0x61, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x0a, 0x0a, 0x06, 0x4e, 0x4f, 0x52, 0x4d, 0x41, 0x4c, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08,
-	0x53, 0x4e, 0x41, 0x50, 0x53, 0x48, 0x4f, 0x54, 0x10, 0x01, 0x2a, 0x32, 0x0a, 0x0e, 0x4b, 0x65,
-	0x79, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05,
-	0x55, 0x4e, 0x53, 0x45, 0x54, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x55, 0x49, 0x4e, 0x54, 0x36,
-	0x34, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x42, 0x59, 0x54, 0x45, 0x53, 0x10, 0x02, 0x2a, 0x9d,
-	0x01, 0x0a, 0x0a, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a,
-	0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x52,
-	0x49, 0x4d, 0x41, 0x52, 0x59, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x4d, 0x41, 0x53, 0x54, 0x45,
-	0x52, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x10, 0x02,
-	0x12, 0x0a, 0x0a, 0x06, 0x52, 0x44, 0x4f, 0x4e, 0x4c, 0x59, 0x10, 0x03, 0x12, 0x09, 0x0a, 0x05,
-	0x42, 0x41, 0x54, 0x43, 0x48, 0x10, 0x03, 0x12, 0x09, 0x0a, 0x05, 0x53, 0x50, 0x41, 0x52, 0x45,
-	0x10, 0x04, 0x12, 0x10, 0x0a, 0x0c, 0x45, 0x58, 0x50, 0x45, 0x52, 0x49, 0x4d, 0x45, 0x4e, 0x54,
-	0x41, 0x4c, 0x10, 0x05, 0x12, 0x0a, 0x0a, 0x06, 0x42, 0x41, 0x43, 0x4b, 0x55, 0x50, 0x10, 0x06,
-	0x12, 0x0b, 0x0a, 0x07, 0x52, 0x45, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x10, 0x07, 0x12, 0x0b, 0x0a,
-	0x07, 0x44, 0x52, 0x41, 0x49, 0x4e, 0x45, 0x44, 0x10, 0x08, 0x1a, 0x02, 0x10, 0x01, 0x42, 0x38,
-	0x0a, 0x0f, 0x69, 0x6f, 0x2e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x5a, 0x25, 0x76, 0x69, 0x74, 0x65, 0x73, 0x73, 0x2e, 0x69, 0x6f, 0x2f, 0x76, 0x69, 0x74,
-	0x65, 0x73, 0x73, 0x2f, 0x67, 0x6f, 0x2f, 0x76, 0x74, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
-	0x74, 0x6f, 0x70, 0x6f, 0x64, 0x61, 0x74, 0x61, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x79, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x71, 0x6c, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x71, 0x6c, 0x12, 0x2b, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x76,
+	0x74, 0x74, 0x69, 0x6d, 0x65, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2f, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x76, 0x74,
+	0x74, 0x69, 0x6d, 0x65, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x72, 0x64, 0x73,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x6f, 0x70, 0x6f, 0x64, 0x61, 0x74,
+	0x61, 0x2e, 0x44, 0x44, 0x4c, 0x53, 0x68, 0x61, 0x72, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x68, 0x61, 0x72, 0x64, 0x73, 0x22, 0xae, 0x01, 0x0a, 0x0e, 0x44, 0x44, 0x4c,
+	0x53, 0x68, 0x61, 0x72, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x68, 0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x61, 0x72,
+	0x64, 0x12, 0x34, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1e, 0x2e, 0x74, 0x6f, 0x70, 0x6f, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x44, 0x44, 0x4c, 0x53,
+	0x68, 0x61, 0x72, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3a, 0x0a,
+	0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x51, 0x55, 0x45, 0x55, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12,
+	0x0c, 0x0a, 0x08, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x02, 0x12, 0x0a, 0x0a,
+	0x06, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x2a, 0x28, 0x0a, 0x0c, 0x4b, 0x65, 0x79,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x4e, 0x4f, 0x52,
+	0x4d, 0x41, 0x4c, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x53, 0x4e, 0x41, 0x50, 0x53, 0x48, 0x4f,
+	0x54, 0x10, 0x01, 0x2a, 0x32, 0x0a, 0x0e, 0x4b, 0x65, 0x79, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49,
+	0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x55, 0x4e, 0x53, 0x45, 0x54, 0x10, 0x00,
+	0x12, 0x0a, 0x0a, 0x06, 0x55, 0x49, 0x4e, 0x54, 0x36, 0x34, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05,
+	0x42, 0x59, 0x54, 0x45, 0x53, 0x10, 0x02, 0x2a, 0x9d, 0x01, 0x0a, 0x0a, 0x54, 0x61, 0x62, 0x6c,
+	0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57,
+	0x4e, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x52, 0x49, 0x4d, 0x41, 0x52, 0x59, 0x10, 0x01,
+	0x12, 0x0a, 0x0a, 0x06, 0x4d, 0x41, 0x53, 0x54, 0x45, 0x52, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07,
+	0x52, 0x45, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x44, 0x4f,
+	0x4e, 0x4c, 0x59, 0x10, 0x03, 0x12, 0x09, 0x0a, 0x05, 0x42, 0x41, 0x54, 0x43, 0x48, 0x10, 0x03,
+	0x12, 0x09, 0x0a, 0x05, 0x53, 0x50, 0x41, 0x52, 0x45, 0x10, 0x04, 0x12, 0x10, 0x0a, 0x0c, 0x45,
+	0x58, 0x50, 0x45, 0x52, 0x49, 0x4d, 0x45, 0x4e, 0x54, 0x41, 0x4c, 0x10, 0x05, 0x12, 0x0a, 0x0a,
+	0x06, 0x42, 0x41, 0x43, 0x4b, 0x55, 0x50, 0x10, 0x06, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x45, 0x53,
+	0x54, 0x4f, 0x52, 0x45, 0x10, 0x07, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x52, 0x41, 0x49, 0x4e, 0x45,
+	0x44, 0x10, 0x08, 0x1a, 0x02, 0x10, 0x01, 0x42, 0x38, 0x0a, 0x0f, 0x69, 0x6f, 0x2e, 0x76, 0x69,
+	0x74, 0x65, 0x73, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x5a, 0x25, 0x76, 0x69, 0x74, 0x65,
+	0x73, 0x73, 0x2e, 0x69, 0x6f, 0x2f, 0x76, 0x69, 0x74, 0x65, 0x73, 0x73, 0x2f, 0x67, 0x6f, 0x2f,
+	0x76, 0x74, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x6f, 0x70, 0x6f, 0x64, 0x61, 0x74,
+	0x61, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1913,73 +3409,114 @@ func file_topodata_proto_rawDescGZIP() []byte {
 	return file_topodata_proto_rawDescData
 }
 
-var file_topodata_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_topodata_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_topodata_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_topodata_proto_msgTypes = make([]protoimpl.MessageInfo, 39)
 var file_topodata_proto_goTypes = []interface{}{
-	(KeyspaceType)(0),                     // 0: topodata.KeyspaceType
-	(KeyspaceIdType)(0),                   // 1: topodata.KeyspaceIdType
-	(TabletType)(0),                       // 2: topodata.TabletType
-	(*KeyRange)(nil),                      // 3: topodata.KeyRange
-	(*TabletAlias)(nil),                   // 4: topodata.TabletAlias
-	(*Tablet)(nil),                        // 5: topodata.Tablet
-	(*Shard)(nil),                         // 6: topodata.Shard
-	(*Keyspace)(nil),                      // 7: topodata.Keyspace
-	(*ShardReplication)(nil),              // 8: topodata.ShardReplication
-	(*ShardReference)(nil),                // 9: topodata.ShardReference
-	(*ShardTabletControl)(nil),            // 10: topodata.ShardTabletControl
-	(*SrvKeyspace)(nil),                   // 11: topodata.SrvKeyspace
-	(*CellInfo)(nil),                      // 12: topodata.CellInfo
-	(*CellsAlias)(nil),                    // 13: topodata.CellsAlias
-	(*TopoConfig)(nil),                    // 14: topodata.TopoConfig
-	(*ExternalVitessCluster)(nil),         // 15: topodata.ExternalVitessCluster
-	(*ExternalClusters)(nil),              // 16: topodata.ExternalClusters
-	nil,                                   // 17: topodata.Tablet.PortMapEntry
-	nil,                                   // 18: topodata.Tablet.TagsEntry
-	(*Shard_SourceShard)(nil),             // 19: topodata.Shard.SourceShard
-	(*Shard_TabletControl)(nil),           // 20: topodata.Shard.TabletControl
-	(*Keyspace_ServedFrom)(nil),           // 21: topodata.Keyspace.ServedFrom
-	(*ShardReplication_Node)(nil),         // 22: topodata.ShardReplication.Node
-	(*SrvKeyspace_KeyspacePartition)(nil), // 23: topodata.SrvKeyspace.KeyspacePartition
-	(*SrvKeyspace_ServedFrom)(nil),        // 24: topodata.SrvKeyspace.ServedFrom
-	(*vttime.Time)(nil),                   // 25: vttime.Time
+	(KeyspaceType)(0),                          // 0: topodata.KeyspaceType
+	(KeyspaceIdType)(0),                        // 1: topodata.KeyspaceIdType
+	(TabletType)(0),                            // 2: topodata.TabletType
+	(DDLShardStatus_State)(0),                  // 3: topodata.DDLShardStatus.State
+	(*KeyRange)(nil),                           // 4: topodata.KeyRange
+	(*TabletAlias)(nil),                        // 5: topodata.TabletAlias
+	(*Tablet)(nil),                             // 6: topodata.Tablet
+	(*Shard)(nil),                              // 7: topodata.Shard
+	(*Keyspace)(nil),                           // 8: topodata.Keyspace
+	(*QueryRule)(nil),                          // 9: topodata.QueryRule
+	(*KeyspacePolicy)(nil),                     // 10: topodata.KeyspacePolicy
+	(*DeniedQuery)(nil),                        // 11: topodata.DeniedQuery
+	(*ThrottlerConfig)(nil),                    // 12: topodata.ThrottlerConfig
+	(*ShardReplication)(nil),                   // 13: topodata.ShardReplication
+	(*ShardReference)(nil),                     // 14: topodata.ShardReference
+	(*ShardTabletControl)(nil),                 // 15: topodata.ShardTabletControl
+	(*SrvKeyspace)(nil),                        // 16: topodata.SrvKeyspace
+	(*CellInfo)(nil),                           // 17: topodata.CellInfo
+	(*CellsAlias)(nil),                         // 18: topodata.CellsAlias
+	(*TopoConfig)(nil),                         // 19: topodata.TopoConfig
+	(*ExternalVitessCluster)(nil),              // 20: topodata.ExternalVitessCluster
+	(*ExternalMysql)(nil),                      // 21: topodata.ExternalMysql
+	(*ExternalClusters)(nil),                   // 22: topodata.ExternalClusters
+	(*DynamicTabletConfig)(nil),                // 23: topodata.DynamicTabletConfig
+	(*RecoverySettings)(nil),                   // 24: topodata.RecoverySettings
+	(*ReferenceTablesWorkflow)(nil),            // 25: topodata.ReferenceTablesWorkflow
+	(*DDLStatus)(nil),                          // 26: topodata.DDLStatus
+	(*DDLShardStatus)(nil),                     // 27: topodata.DDLShardStatus
+	nil,                                        // 28: topodata.Tablet.PortMapEntry
+	nil,                                        // 29: topodata.Tablet.TagsEntry
+	(*Shard_SourceShard)(nil),                  // 30: topodata.Shard.SourceShard
+	(*Shard_TabletControl)(nil),                // 31: topodata.Shard.TabletControl
+	(*Shard_WriteFence)(nil),                   // 32: topodata.Shard.WriteFence
+	(*Keyspace_ServedFrom)(nil),                // 33: topodata.Keyspace.ServedFrom
+	(*ThrottlerConfig_Metric)(nil),             // 34: topodata.ThrottlerConfig.Metric
+	(*ThrottlerConfig_AppPolicy)(nil),          // 35: topodata.ThrottlerConfig.AppPolicy
+	nil,                                        // 36: topodata.ThrottlerConfig.MetricsEntry
+	nil,                                        // 37: topodata.ThrottlerConfig.AppPoliciesEntry
+	nil,                                        // 38: topodata.ThrottlerConfig.AppPolicy.ThresholdsEntry
+	(*ShardReplication_Node)(nil),              // 39: topodata.ShardReplication.Node
+	(*SrvKeyspace_KeyspacePartition)(nil),      // 40: topodata.SrvKeyspace.KeyspacePartition
+	(*SrvKeyspace_ServedFrom)(nil),             // 41: topodata.SrvKeyspace.ServedFrom
+	(*RecoverySettings_MaintenanceWindow)(nil), // 42: topodata.RecoverySettings.MaintenanceWindow
+	(*vttime.Time)(nil),                        // 43: vttime.Time
 }
 var file_topodata_proto_depIdxs = []int32{
-	4,  // 0: topodata.Tablet.alias:type_name -> topodata.TabletAlias
-	17, // 1: topodata.Tablet.port_map:type_name -> topodata.Tablet.PortMapEntry
-	3,  // 2: topodata.Tablet.key_range:type_name -> topodata.KeyRange
+	5,  // 0: topodata.Tablet.alias:type_name -> topodata.TabletAlias
+	28, // 1: topodata.Tablet.port_map:type_name -> topodata.Tablet.PortMapEntry
+	4,  // 2: topodata.Tablet.key_range:type_name -> topodata.KeyRange
 	2,  // 3: topodata.Tablet.type:type_name -> topodata.TabletType
-	18, // 4: topodata.Tablet.tags:type_name -> topodata.Tablet.TagsEntry
-	25, // 5: topodata.Tablet.primary_term_start_time:type_name -> vttime.Time
-	4,  // 6: topodata.Shard.primary_alias:type_name -> topodata.TabletAlias
-	25, // 7: topodata.Shard.primary_term_start_time:type_name -> vttime.Time
-	3,  // 8: topodata.Shard.key_range:type_name -> topodata.KeyRange
-	19, // 9: topodata.Shard.source_shards:type_name -> topodata.Shard.SourceShard
-	20, // 10: topodata.Shard.tablet_controls:type_name -> topodata.Shard.TabletControl
-	1,  // 11: topodata.Keyspace.sharding_column_type:type_name -> topodata.KeyspaceIdType
-	21, // 12: topodata.Keyspace.served_froms:type_name -> topodata.Keyspace.ServedFrom
-	0,  // 13: topodata.Keyspace.keyspace_type:type_name -> topodata.KeyspaceType
-	25, // 14: topodata.Keyspace.snapshot_time:type_name -> vttime.Time
-	22, // 15: topodata.ShardReplication.nodes:type_name -> topodata.ShardReplication.Node
-	3,  // 16: topodata.ShardReference.key_range:type_name -> topodata.KeyRange
-	3,  // 17: topodata.ShardTabletControl.key_range:type_name -> topodata.KeyRange
-	23, // 18: topodata.SrvKeyspace.partitions:type_name -> topodata.SrvKeyspace.KeyspacePartition
-	1,  // 19: topodata.SrvKeyspace.sharding_column_type:type_name -> topodata.KeyspaceIdType
-	24, // 20: topodata.SrvKeyspace.served_from:type_name -> topodata.SrvKeyspace.ServedFrom
-	14, // 21: topodata.ExternalVitessCluster.topo_config:type_name -> topodata.TopoConfig
-	15, // 22: topodata.ExternalClusters.vitess_cluster:type_name -> topodata.ExternalVitessCluster
-	3,  // 23: topodata.Shard.SourceShard.key_range:type_name -> topodata.KeyRange
-	2,  // 24: topodata.Shard.TabletControl.tablet_type:type_name -> topodata.TabletType
-	2,  // 25: topodata.Keyspace.ServedFrom.tablet_type:type_name -> topodata.TabletType
-	4,  // 26: topodata.ShardReplication.Node.tablet_alias:type_name -> topodata.TabletAlias
-	2,  // 27: topodata.SrvKeyspace.KeyspacePartition.served_type:type_name -> topodata.TabletType
-	9,  // 28: topodata.SrvKeyspace.KeyspacePartition.shard_references:type_name -> topodata.ShardReference
-	10, // 29: topodata.SrvKeyspace.KeyspacePartition.shard_tablet_controls:type_name -> topodata.ShardTabletControl
-	2,  // 30: topodata.SrvKeyspace.ServedFrom.tablet_type:type_name -> topodata.TabletType
-	31, // [31:31] is the sub-list for method output_type
-	31, // [31:31] is the sub-list for method input_type
-	31, // [31:31] is the sub-list for extension type_name
-	31, // [31:31] is the sub-list for extension extendee
-	0,  // [0:31] is the sub-list for field type_name
+	29, // 4: topodata.Tablet.tags:type_name -> topodata.Tablet.TagsEntry
+	43, // 5: topodata.Tablet.primary_term_start_time:type_name -> vttime.Time
+	5,  // 6: topodata.Shard.primary_alias:type_name -> topodata.TabletAlias
+	43, // 7: topodata.Shard.primary_term_start_time:type_name -> vttime.Time
+	4,  // 8: topodata.Shard.key_range:type_name -> topodata.KeyRange
+	30, // 9: topodata.Shard.source_shards:type_name -> topodata.Shard.SourceShard
+	31, // 10: topodata.Shard.tablet_controls:type_name -> topodata.Shard.TabletControl
+	32, // 11: topodata.Shard.write_fence:type_name -> topodata.Shard.WriteFence
+	1,  // 12: topodata.Keyspace.sharding_column_type:type_name -> topodata.KeyspaceIdType
+	33, // 13: topodata.Keyspace.served_froms:type_name -> topodata.Keyspace.ServedFrom
+	0,  // 14: topodata.Keyspace.keyspace_type:type_name -> topodata.KeyspaceType
+	43, // 15: topodata.Keyspace.snapshot_time:type_name -> vttime.Time
+	12, // 16: topodata.Keyspace.throttler_config:type_name -> topodata.ThrottlerConfig
+	10, // 17: topodata.Keyspace.policy:type_name -> topodata.KeyspacePolicy
+	9,  // 18: topodata.Keyspace.query_rules:type_name -> topodata.QueryRule
+	11, // 19: topodata.KeyspacePolicy.denied_queries:type_name -> topodata.DeniedQuery
+	43, // 20: topodata.DeniedQuery.expire_time:type_name -> vttime.Time
+	36, // 21: topodata.ThrottlerConfig.metrics:type_name -> topodata.ThrottlerConfig.MetricsEntry
+	37, // 22: topodata.ThrottlerConfig.app_policies:type_name -> topodata.ThrottlerConfig.AppPoliciesEntry
+	39, // 23: topodata.ShardReplication.nodes:type_name -> topodata.ShardReplication.Node
+	4,  // 24: topodata.ShardReference.key_range:type_name -> topodata.KeyRange
+	4,  // 25: topodata.ShardTabletControl.key_range:type_name -> topodata.KeyRange
+	40, // 26: topodata.SrvKeyspace.partitions:type_name -> topodata.SrvKeyspace.KeyspacePartition
+	1,  // 27: topodata.SrvKeyspace.sharding_column_type:type_name -> topodata.KeyspaceIdType
+	41, // 28: topodata.SrvKeyspace.served_from:type_name -> topodata.SrvKeyspace.ServedFrom
+	12, // 29: topodata.SrvKeyspace.throttler_config:type_name -> topodata.ThrottlerConfig
+	10, // 30: topodata.SrvKeyspace.policy:type_name -> topodata.KeyspacePolicy
+	9,  // 31: topodata.SrvKeyspace.query_rules:type_name -> topodata.QueryRule
+	19, // 32: topodata.ExternalVitessCluster.topo_config:type_name -> topodata.TopoConfig
+	20, // 33: topodata.ExternalClusters.vitess_cluster:type_name -> topodata.ExternalVitessCluster
+	42, // 34: topodata.RecoverySettings.maintenance_windows:type_name -> topodata.RecoverySettings.MaintenanceWindow
+	2,  // 35: topodata.ReferenceTablesWorkflow.tablet_types:type_name -> topodata.TabletType
+	43, // 36: topodata.DDLStatus.started_at:type_name -> vttime.Time
+	43, // 37: topodata.DDLStatus.completed_at:type_name -> vttime.Time
+	27, // 38: topodata.DDLStatus.shards:type_name -> topodata.DDLShardStatus
+	3,  // 39: topodata.DDLShardStatus.state:type_name -> topodata.DDLShardStatus.State
+	4,  // 40: topodata.Shard.SourceShard.key_range:type_name -> topodata.KeyRange
+	2,  // 41: topodata.Shard.TabletControl.tablet_type:type_name -> topodata.TabletType
+	43, // 42: topodata.Shard.WriteFence.time_created:type_name -> vttime.Time
+	2,  // 43: topodata.Keyspace.ServedFrom.tablet_type:type_name -> topodata.TabletType
+	38, // 44: topodata.ThrottlerConfig.AppPolicy.thresholds:type_name -> topodata.ThrottlerConfig.AppPolicy.ThresholdsEntry
+	34, // 45: topodata.ThrottlerConfig.MetricsEntry.value:type_name -> topodata.ThrottlerConfig.Metric
+	35, // 46: topodata.ThrottlerConfig.AppPoliciesEntry.value:type_name -> topodata.ThrottlerConfig.AppPolicy
+	5,  // 47: topodata.ShardReplication.Node.tablet_alias:type_name -> topodata.TabletAlias
+	2,  // 48: topodata.SrvKeyspace.KeyspacePartition.served_type:type_name -> topodata.TabletType
+	14, // 49: topodata.SrvKeyspace.KeyspacePartition.shard_references:type_name -> topodata.ShardReference
+	15, // 50: topodata.SrvKeyspace.KeyspacePartition.shard_tablet_controls:type_name -> topodata.ShardTabletControl
+	2,  // 51: topodata.SrvKeyspace.ServedFrom.tablet_type:type_name -> topodata.TabletType
+	43, // 52: topodata.RecoverySettings.MaintenanceWindow.start_time:type_name -> vttime.Time
+	43, // 53: topodata.RecoverySettings.MaintenanceWindow.end_time:type_name -> vttime.Time
+	54, // [54:54] is the sub-list for method output_type
+	54, // [54:54] is the sub-list for method input_type
+	54, // [54:54] is the sub-list for extension type_name
+	54, // [54:54] is the sub-list for extension extendee
+	0,  // [0:54] is the sub-list for field type_name
 }
 
 func init() { file_topodata_proto_init() }
@@ -2049,7 +3586,7 @@ func file_topodata_proto_init() {
 			}
 		}
 		file_topodata_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ShardReplication); i {
+			switch v := v.(*QueryRule); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2061,7 +3598,7 @@ func file_topodata_proto_init() {
 			}
 		}
 		file_topodata_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ShardReference); i {
+			switch v := v.(*KeyspacePolicy); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2073,7 +3610,7 @@ func file_topodata_proto_init() {
 			}
 		}
 		file_topodata_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ShardTabletControl); i {
+			switch v := v.(*DeniedQuery); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2085,7 +3622,7 @@ func file_topodata_proto_init() {
 			}
 		}
 		file_topodata_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SrvKeyspace); i {
+			switch v := v.(*ThrottlerConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2097,7 +3634,7 @@ func file_topodata_proto_init() {
 			}
 		}
 		file_topodata_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CellInfo); i {
+			switch v := v.(*ShardReplication); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2109,7 +3646,7 @@ func file_topodata_proto_init() {
 			}
 		}
 		file_topodata_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CellsAlias); i {
+			switch v := v.(*ShardReference); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2121,7 +3658,7 @@ func file_topodata_proto_init() {
 			}
 		}
 		file_topodata_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TopoConfig); i {
+			switch v := v.(*ShardTabletControl); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2133,7 +3670,7 @@ func file_topodata_proto_init() {
 			}
 		}
 		file_topodata_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExternalVitessCluster); i {
+			switch v := v.(*SrvKeyspace); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2145,7 +3682,31 @@ func file_topodata_proto_init() {
 			}
 		}
 		file_topodata_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExternalClusters); i {
+			switch v := v.(*CellInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_topodata_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CellsAlias); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_topodata_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TopoConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2157,7 +3718,7 @@ func file_topodata_proto_init() {
 			}
 		}
 		file_topodata_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Shard_SourceShard); i {
+			switch v := v.(*ExternalVitessCluster); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2169,7 +3730,7 @@ func file_topodata_proto_init() {
 			}
 		}
 		file_topodata_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Shard_TabletControl); i {
+			switch v := v.(*ExternalMysql); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2181,7 +3742,7 @@ func file_topodata_proto_init() {
 			}
 		}
 		file_topodata_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Keyspace_ServedFrom); i {
+			switch v := v.(*ExternalClusters); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2193,7 +3754,7 @@ func file_topodata_proto_init() {
 			}
 		}
 		file_topodata_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ShardReplication_Node); i {
+			switch v := v.(*DynamicTabletConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2205,7 +3766,7 @@ func file_topodata_proto_init() {
 			}
 		}
 		file_topodata_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SrvKeyspace_KeyspacePartition); i {
+			switch v := v.(*RecoverySettings); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2217,6 +3778,138 @@ func file_topodata_proto_init() {
 			}
 		}
 		file_topodata_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReferenceTablesWorkflow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_topodata_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DDLStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_topodata_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DDLShardStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_topodata_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Shard_SourceShard); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_topodata_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Shard_TabletControl); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_topodata_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Shard_WriteFence); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_topodata_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Keyspace_ServedFrom); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_topodata_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ThrottlerConfig_Metric); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_topodata_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ThrottlerConfig_AppPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_topodata_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShardReplication_Node); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_topodata_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SrvKeyspace_KeyspacePartition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_topodata_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SrvKeyspace_ServedFrom); i {
 			case 0:
 				return &v.state
@@ -2228,14 +3921,26 @@ func file_topodata_proto_init() {
 				return nil
 			}
 		}
+		file_topodata_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecoverySettings_MaintenanceWindow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_topodata_proto_rawDesc,
-			NumEnums:      3,
-			NumMessages:   22,
+			NumEnums:      4,
+			NumMessages:   39,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -19,8 +19,19 @@ const _ = grpc.SupportPackageIsVersion7
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type VTAdminClient interface {
+	// CancelWorkflow cancels a MoveTables or Reshard workflow in the given
+	// cluster, deleting its target tables or shards and its streams.
+	CancelWorkflow(ctx context.Context, in *CancelWorkflowRequest, opts ...grpc.CallOption) (*vtctldata.WorkflowCancelResponse, error)
+	// CompleteWorkflow completes a MoveTables or Reshard workflow in the given
+	// cluster, deleting its source tables or shards and its streams.
+	CompleteWorkflow(ctx context.Context, in *CompleteWorkflowRequest, opts ...grpc.CallOption) (*vtctldata.WorkflowCompleteResponse, error)
 	// CreateKeyspace creates a new keyspace in the given cluster.
 	CreateKeyspace(ctx context.Context, in *CreateKeyspaceRequest, opts ...grpc.CallOption) (*CreateKeyspaceResponse, error)
+	// CreateMoveTablesWorkflow creates a MoveTables workflow in the given
+	// cluster.
+	CreateMoveTablesWorkflow(ctx context.Context, in *CreateMoveTablesWorkflowRequest, opts ...grpc.CallOption) (*vtctldata.MoveTablesCreateResponse, error)
+	// CreateReshardWorkflow creates a Reshard workflow in the given cluster.
+	CreateReshardWorkflow(ctx context.Context, in *CreateReshardWorkflowRequest, opts ...grpc.CallOption) (*vtctldata.ReshardCreateResponse, error)
 	// DeleteKeyspace deletes a keyspace in the given cluster.
 	DeleteKeyspace(ctx context.Context, in *DeleteKeyspaceRequest, opts ...grpc.CallOption) (*vtctldata.DeleteKeyspaceResponse, error)
 	// FindSchema returns a single Schema that matches the provided table name
@@ -38,6 +49,10 @@ type VTAdminClient interface {
 	GetGates(ctx context.Context, in *GetGatesRequest, opts ...grpc.CallOption) (*GetGatesResponse, error)
 	// GetKeyspace returns a keyspace by name in the specified cluster.
 	GetKeyspace(ctx context.Context, in *GetKeyspaceRequest, opts ...grpc.CallOption) (*Keyspace, error)
+	// GetKeyspaceGraph returns the serving graph of a keyspace in the
+	// specified cluster: its shards, tablets, and the replication
+	// relationships and lag of the tablets.
+	GetKeyspaceGraph(ctx context.Context, in *GetKeyspaceGraphRequest, opts ...grpc.CallOption) (*KeyspaceGraph, error)
 	// GetKeyspaces returns all keyspaces across the specified clusters.
 	GetKeyspaces(ctx context.Context, in *GetKeyspacesRequest, opts ...grpc.CallOption) (*GetKeyspacesResponse, error)
 	// GetSchema returns the schema for the specified (cluster, keyspace, table)
@@ -64,6 +79,9 @@ type VTAdminClient interface {
 	GetWorkflow(ctx context.Context, in *GetWorkflowRequest, opts ...grpc.CallOption) (*Workflow, error)
 	// GetWorkflows returns the Workflows for all specified clusters.
 	GetWorkflows(ctx context.Context, in *GetWorkflowsRequest, opts ...grpc.CallOption) (*GetWorkflowsResponse, error)
+	// SwitchWorkflowTraffic switches the traffic of a MoveTables or Reshard
+	// workflow in the given cluster, forward or in reverse.
+	SwitchWorkflowTraffic(ctx context.Context, in *SwitchWorkflowTrafficRequest, opts ...grpc.CallOption) (*vtctldata.WorkflowSwitchTrafficResponse, error)
 	// VTExplain provides information on how Vitess plans to execute a particular query.
 	VTExplain(ctx context.Context, in *VTExplainRequest, opts ...grpc.CallOption) (*VTExplainResponse, error)
 }
@@ -76,6 +94,24 @@ func NewVTAdminClient(cc grpc.ClientConnInterface) VTAdminClient {
 	return &vTAdminClient{cc}
 }
 
+func (c *vTAdminClient) CancelWorkflow(ctx context.Context, in *CancelWorkflowRequest, opts ...grpc.CallOption) (*vtctldata.WorkflowCancelResponse, error) {
+	out := new(vtctldata.WorkflowCancelResponse)
+	err := c.cc.Invoke(ctx, "/vtadmin.VTAdmin/CancelWorkflow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vTAdminClient) CompleteWorkflow(ctx context.Context, in *CompleteWorkflowRequest, opts ...grpc.CallOption) (*vtctldata.WorkflowCompleteResponse, error) {
+	out := new(vtctldata.WorkflowCompleteResponse)
+	err := c.cc.Invoke(ctx, "/vtadmin.VTAdmin/CompleteWorkflow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vTAdminClient) CreateKeyspace(ctx context.Context, in *CreateKeyspaceRequest, opts ...grpc.CallOption) (*CreateKeyspaceResponse, error) {
 	out := new(CreateKeyspaceResponse)
 	err := c.cc.Invoke(ctx, "/vtadmin.VTAdmin/CreateKeyspace", in, out, opts...)
@@ -85,6 +121,24 @@ func (c *vTAdminClient) CreateKeyspace(ctx context.Context, in *CreateKeyspaceRe
 	return out, nil
 }
 
+func (c *vTAdminClient) CreateMoveTablesWorkflow(ctx context.Context, in *CreateMoveTablesWorkflowRequest, opts ...grpc.CallOption) (*vtctldata.MoveTablesCreateResponse, error) {
+	out := new(vtctldata.MoveTablesCreateResponse)
+	err := c.cc.Invoke(ctx, "/vtadmin.VTAdmin/CreateMoveTablesWorkflow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vTAdminClient) CreateReshardWorkflow(ctx context.Context, in *CreateReshardWorkflowRequest, opts ...grpc.CallOption) (*vtctldata.ReshardCreateResponse, error) {
+	out := new(vtctldata.ReshardCreateResponse)
+	err := c.cc.Invoke(ctx, "/vtadmin.VTAdmin/CreateReshardWorkflow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vTAdminClient) DeleteKeyspace(ctx context.Context, in *DeleteKeyspaceRequest, opts ...grpc.CallOption) (*vtctldata.DeleteKeyspaceResponse, error) {
 	out := new(vtctldata.DeleteKeyspaceResponse)
 	err := c.cc.Invoke(ctx, "/vtadmin.VTAdmin/DeleteKeyspace", in, out, opts...)
@@ -139,6 +193,15 @@ func (c *vTAdminClient) GetKeyspace(ctx context.Context, in *GetKeyspaceRequest,
 	return out, nil
 }
 
+func (c *vTAdminClient) GetKeyspaceGraph(ctx context.Context, in *GetKeyspaceGraphRequest, opts ...grpc.CallOption) (*KeyspaceGraph, error) {
+	out := new(KeyspaceGraph)
+	err := c.cc.Invoke(ctx, "/vtadmin.VTAdmin/GetKeyspaceGraph", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vTAdminClient) GetKeyspaces(ctx context.Context, in *GetKeyspacesRequest, opts ...grpc.CallOption) (*GetKeyspacesResponse, error) {
 	out := new(GetKeyspacesResponse)
 	err := c.cc.Invoke(ctx, "/vtadmin.VTAdmin/GetKeyspaces", in, out, opts...)
@@ -238,6 +301,15 @@ func (c *vTAdminClient) GetWorkflows(ctx context.Context, in *GetWorkflowsReques
 	return out, nil
 }
 
+func (c *vTAdminClient) SwitchWorkflowTraffic(ctx context.Context, in *SwitchWorkflowTrafficRequest, opts ...grpc.CallOption) (*vtctldata.WorkflowSwitchTrafficResponse, error) {
+	out := new(vtctldata.WorkflowSwitchTrafficResponse)
+	err := c.cc.Invoke(ctx, "/vtadmin.VTAdmin/SwitchWorkflowTraffic", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vTAdminClient) VTExplain(ctx context.Context, in *VTExplainRequest, opts ...grpc.CallOption) (*VTExplainResponse, error) {
 	out := new(VTExplainResponse)
 	err := c.cc.Invoke(ctx, "/vtadmin.VTAdmin/VTExplain", in, out, opts...)
@@ -251,8 +323,19 @@ func (c *vTAdminClient) VTExplain(ctx context.Context, in *VTExplainRequest, opt
 // All implementations must embed UnimplementedVTAdminServer
 // for forward compatibility
 type VTAdminServer interface {
+	// CancelWorkflow cancels a MoveTables or Reshard workflow in the given
+	// cluster, deleting its target tables or shards and its streams.
+	CancelWorkflow(context.Context, *CancelWorkflowRequest) (*vtctldata.WorkflowCancelResponse, error)
+	// CompleteWorkflow completes a MoveTables or Reshard workflow in the given
+	// cluster, deleting its source tables or shards and its streams.
+	CompleteWorkflow(context.Context, *CompleteWorkflowRequest) (*vtctldata.WorkflowCompleteResponse, error)
 	// CreateKeyspace creates a new keyspace in the given cluster.
 	CreateKeyspace(context.Context, *CreateKeyspaceRequest) (*CreateKeyspaceResponse, error)
+	// CreateMoveTablesWorkflow creates a MoveTables workflow in the given
+	// cluster.
+	CreateMoveTablesWorkflow(context.Context, *CreateMoveTablesWorkflowRequest) (*vtctldata.MoveTablesCreateResponse, error)
+	// CreateReshardWorkflow creates a Reshard workflow in the given cluster.
+	CreateReshardWorkflow(context.Context, *CreateReshardWorkflowRequest) (*vtctldata.ReshardCreateResponse, error)
 	// DeleteKeyspace deletes a keyspace in the given cluster.
 	DeleteKeyspace(context.Context, *DeleteKeyspaceRequest) (*vtctldata.DeleteKeyspaceResponse, error)
 	// FindSchema returns a single Schema that matches the provided table name
@@ -270,6 +353,10 @@ type VTAdminServer interface {
 	GetGates(context.Context, *GetGatesRequest) (*GetGatesResponse, error)
 	// GetKeyspace returns a keyspace by name in the specified cluster.
 	GetKeyspace(context.Context, *GetKeyspaceRequest) (*Keyspace, error)
+	// GetKeyspaceGraph returns the serving graph of a keyspace in the
+	// specified cluster: its shards, tablets, and the replication
+	// relationships and lag of the tablets.
+	GetKeyspaceGraph(context.Context, *GetKeyspaceGraphRequest) (*KeyspaceGraph, error)
 	// GetKeyspaces returns all keyspaces across the specified clusters.
 	GetKeyspaces(context.Context, *GetKeyspacesRequest) (*GetKeyspacesResponse, error)
 	// GetSchema returns the schema for the specified (cluster, keyspace, table)
@@ -296,6 +383,9 @@ type VTAdminServer interface {
 	GetWorkflow(context.Context, *GetWorkflowRequest) (*Workflow, error)
 	// GetWorkflows returns the Workflows for all specified clusters.
 	GetWorkflows(context.Context, *GetWorkflowsRequest) (*GetWorkflowsResponse, error)
+	// SwitchWorkflowTraffic switches the traffic of a MoveTables or Reshard
+	// workflow in the given cluster, forward or in reverse.
+	SwitchWorkflowTraffic(context.Context, *SwitchWorkflowTrafficRequest) (*vtctldata.WorkflowSwitchTrafficResponse, error)
 	// VTExplain provides information on how Vitess plans to execute a particular query.
 	VTExplain(context.Context, *VTExplainRequest) (*VTExplainResponse, error)
 	mustEmbedUnimplementedVTAdminServer()
@@ -305,9 +395,21 @@ type VTAdminServer interface {
 type UnimplementedVTAdminServer struct {
 }
 
+func (UnimplementedVTAdminServer) CancelWorkflow(context.Context, *CancelWorkflowRequest) (*vtctldata.WorkflowCancelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelWorkflow not implemented")
+}
+func (UnimplementedVTAdminServer) CompleteWorkflow(context.Context, *CompleteWorkflowRequest) (*vtctldata.WorkflowCompleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteWorkflow not implemented")
+}
 func (UnimplementedVTAdminServer) CreateKeyspace(context.Context, *CreateKeyspaceRequest) (*CreateKeyspaceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateKeyspace not implemented")
 }
+func (UnimplementedVTAdminServer) CreateMoveTablesWorkflow(context.Context, *CreateMoveTablesWorkflowRequest) (*vtctldata.MoveTablesCreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMoveTablesWorkflow not implemented")
+}
+func (UnimplementedVTAdminServer) CreateReshardWorkflow(context.Context, *CreateReshardWorkflowRequest) (*vtctldata.ReshardCreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReshardWorkflow not implemented")
+}
 func (UnimplementedVTAdminServer) DeleteKeyspace(context.Context, *DeleteKeyspaceRequest) (*vtctldata.DeleteKeyspaceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteKeyspace not implemented")
 }
@@ -326,6 +428,9 @@ func (UnimplementedVTAdminServer) GetGates(context.Context, *GetGatesRequest) (*
 func (UnimplementedVTAdminServer) GetKeyspace(context.Context, *GetKeyspaceRequest) (*Keyspace, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetKeyspace not implemented")
 }
+func (UnimplementedVTAdminServer) GetKeyspaceGraph(context.Context, *GetKeyspaceGraphRequest) (*KeyspaceGraph, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetKeyspaceGraph not implemented")
+}
 func (UnimplementedVTAdminServer) GetKeyspaces(context.Context, *GetKeyspacesRequest) (*GetKeyspacesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetKeyspaces not implemented")
 }
@@ -359,6 +464,9 @@ func (UnimplementedVTAdminServer) GetWorkflow(context.Context, *GetWorkflowReque
 func (UnimplementedVTAdminServer) GetWorkflows(context.Context, *GetWorkflowsRequest) (*GetWorkflowsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetWorkflows not implemented")
 }
+func (UnimplementedVTAdminServer) SwitchWorkflowTraffic(context.Context, *SwitchWorkflowTrafficRequest) (*vtctldata.WorkflowSwitchTrafficResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwitchWorkflowTraffic not implemented")
+}
 func (UnimplementedVTAdminServer) VTExplain(context.Context, *VTExplainRequest) (*VTExplainResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VTExplain not implemented")
 }
@@ -375,6 +483,42 @@ func RegisterVTAdminServer(s grpc.ServiceRegistrar, srv VTAdminServer) {
 	s.RegisterService(&VTAdmin_ServiceDesc, srv)
 }
 
+func _VTAdmin_CancelWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelWorkflowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VTAdminServer).CancelWorkflow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtadmin.VTAdmin/CancelWorkflow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VTAdminServer).CancelWorkflow(ctx, req.(*CancelWorkflowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VTAdmin_CompleteWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteWorkflowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VTAdminServer).CompleteWorkflow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtadmin.VTAdmin/CompleteWorkflow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VTAdminServer).CompleteWorkflow(ctx, req.(*CompleteWorkflowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _VTAdmin_CreateKeyspace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateKeyspaceRequest)
 	if err := dec(in); err != nil {
@@ -393,6 +537,42 @@ func _VTAdmin_CreateKeyspace_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VTAdmin_CreateMoveTablesWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMoveTablesWorkflowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VTAdminServer).CreateMoveTablesWorkflow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtadmin.VTAdmin/CreateMoveTablesWorkflow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VTAdminServer).CreateMoveTablesWorkflow(ctx, req.(*CreateMoveTablesWorkflowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VTAdmin_CreateReshardWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReshardWorkflowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VTAdminServer).CreateReshardWorkflow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtadmin.VTAdmin/CreateReshardWorkflow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VTAdminServer).CreateReshardWorkflow(ctx, req.(*CreateReshardWorkflowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _VTAdmin_DeleteKeyspace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteKeyspaceRequest)
 	if err := dec(in); err != nil {
@@ -501,6 +681,24 @@ func _VTAdmin_GetKeyspace_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VTAdmin_GetKeyspaceGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetKeyspaceGraphRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VTAdminServer).GetKeyspaceGraph(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtadmin.VTAdmin/GetKeyspaceGraph",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VTAdminServer).GetKeyspaceGraph(ctx, req.(*GetKeyspaceGraphRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _VTAdmin_GetKeyspaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetKeyspacesRequest)
 	if err := dec(in); err != nil {
@@ -699,6 +897,24 @@ func _VTAdmin_GetWorkflows_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VTAdmin_SwitchWorkflowTraffic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SwitchWorkflowTrafficRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VTAdminServer).SwitchWorkflowTraffic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtadmin.VTAdmin/SwitchWorkflowTraffic",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VTAdminServer).SwitchWorkflowTraffic(ctx, req.(*SwitchWorkflowTrafficRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _VTAdmin_VTExplain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(VTExplainRequest)
 	if err := dec(in); err != nil {
@@ -724,10 +940,26 @@ var VTAdmin_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "vtadmin.VTAdmin",
 	HandlerType: (*VTAdminServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CancelWorkflow",
+			Handler:    _VTAdmin_CancelWorkflow_Handler,
+		},
+		{
+			MethodName: "CompleteWorkflow",
+			Handler:    _VTAdmin_CompleteWorkflow_Handler,
+		},
 		{
 			MethodName: "CreateKeyspace",
 			Handler:    _VTAdmin_CreateKeyspace_Handler,
 		},
+		{
+			MethodName: "CreateMoveTablesWorkflow",
+			Handler:    _VTAdmin_CreateMoveTablesWorkflow_Handler,
+		},
+		{
+			MethodName: "CreateReshardWorkflow",
+			Handler:    _VTAdmin_CreateReshardWorkflow_Handler,
+		},
 		{
 			MethodName: "DeleteKeyspace",
 			Handler:    _VTAdmin_DeleteKeyspace_Handler,
@@ -752,6 +984,10 @@ var VTAdmin_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetKeyspace",
 			Handler:    _VTAdmin_GetKeyspace_Handler,
 		},
+		{
+			MethodName: "GetKeyspaceGraph",
+			Handler:    _VTAdmin_GetKeyspaceGraph_Handler,
+		},
 		{
 			MethodName: "GetKeyspaces",
 			Handler:    _VTAdmin_GetKeyspaces_Handler,
@@ -796,6 +1032,10 @@ var VTAdmin_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetWorkflows",
 			Handler:    _VTAdmin_GetWorkflows_Handler,
 		},
+		{
+			MethodName: "SwitchWorkflowTraffic",
+			Handler:    _VTAdmin_SwitchWorkflowTraffic_Handler,
+		},
 		{
 			MethodName: "VTExplain",
 			Handler:    _VTAdmin_VTExplain_Handler,
@@ -142,6 +142,9 @@ type VtctldClient interface {
 	// cells within the group (alias). Only primary traffic can be routed across
 	// cells not in the same group (alias).
 	AddCellsAlias(ctx context.Context, in *vtctldata.AddCellsAliasRequest, opts ...grpc.CallOption) (*vtctldata.AddCellsAliasResponse, error)
+	// AddQueryRule adds or replaces a query rule of a keyspace, in the Keyspace
+	// and in the SrvKeyspace of each cell.
+	AddQueryRule(ctx context.Context, in *vtctldata.AddQueryRuleRequest, opts ...grpc.CallOption) (*vtctldata.AddQueryRuleResponse, error)
 	// ApplyRoutingRules applies the VSchema routing rules.
 	ApplyRoutingRules(ctx context.Context, in *vtctldata.ApplyRoutingRulesRequest, opts ...grpc.CallOption) (*vtctldata.ApplyRoutingRulesResponse, error)
 	// ApplyVSchema applies a vschema to a keyspace.
@@ -152,6 +155,13 @@ type VtctldClient interface {
 	//
 	// NOTE: This command automatically updates the serving graph.
 	ChangeTabletType(ctx context.Context, in *vtctldata.ChangeTabletTypeRequest, opts ...grpc.CallOption) (*vtctldata.ChangeTabletTypeResponse, error)
+	// ChangeTabletTags changes the tags of a tablet. The tablet publishes its
+	// new tags, which vtgate uses with the tablet routing rules.
+	ChangeTabletTags(ctx context.Context, in *vtctldata.ChangeTabletTagsRequest, opts ...grpc.CallOption) (*vtctldata.ChangeTabletTagsResponse, error)
+	// CheckErrantGTIDs lists the replicas of a shard that executed transactions
+	// the primary did not, and optionally removes those errant GTIDs from their
+	// GTID sets.
+	CheckErrantGTIDs(ctx context.Context, in *vtctldata.CheckErrantGTIDsRequest, opts ...grpc.CallOption) (*vtctldata.CheckErrantGTIDsResponse, error)
 	// CreateKeyspace creates the specified keyspace in the topology. For a
 	// SNAPSHOT keyspace, the request must specify the name of a base keyspace,
 	// as well as a snapshot time.
@@ -177,9 +187,16 @@ type VtctldClient interface {
 	DeleteSrvVSchema(ctx context.Context, in *vtctldata.DeleteSrvVSchemaRequest, opts ...grpc.CallOption) (*vtctldata.DeleteSrvVSchemaResponse, error)
 	// DeleteTablets deletes one or more tablets from the topology.
 	DeleteTablets(ctx context.Context, in *vtctldata.DeleteTabletsRequest, opts ...grpc.CallOption) (*vtctldata.DeleteTabletsResponse, error)
+	// DeregisterExternalMysql removes an external mysqld registered as a source
+	// of vreplication workflows.
+	DeregisterExternalMysql(ctx context.Context, in *vtctldata.DeregisterExternalMysqlRequest, opts ...grpc.CallOption) (*vtctldata.DeregisterExternalMysqlResponse, error)
 	// EmergencyReparentShard reparents the shard to the new primary. It assumes
 	// the old primary is dead or otherwise not responding.
 	EmergencyReparentShard(ctx context.Context, in *vtctldata.EmergencyReparentShardRequest, opts ...grpc.CallOption) (*vtctldata.EmergencyReparentShardResponse, error)
+	// ExplainQuery explains how queries would be routed and executed in the
+	// cluster, with its current vschema, shards and schema, without executing
+	// them.
+	ExplainQuery(ctx context.Context, in *vtctldata.ExplainQueryRequest, opts ...grpc.CallOption) (*vtctldata.ExplainQueryResponse, error)
 	// FindAllShardsInKeyspace returns a map of shard names to shard references
 	// for a given keyspace.
 	FindAllShardsInKeyspace(ctx context.Context, in *vtctldata.FindAllShardsInKeyspaceRequest, opts ...grpc.CallOption) (*vtctldata.FindAllShardsInKeyspaceResponse, error)
@@ -193,10 +210,28 @@ type VtctldClient interface {
 	// GetCellsAliases returns a mapping of cell alias to cells identified by that
 	// alias.
 	GetCellsAliases(ctx context.Context, in *vtctldata.GetCellsAliasesRequest, opts ...grpc.CallOption) (*vtctldata.GetCellsAliasesResponse, error)
+	// GetDDLStatus returns the per-shard status of a DDL that vtgate applied
+	// to the shards of a keyspace.
+	GetDDLStatus(ctx context.Context, in *vtctldata.GetDDLStatusRequest, opts ...grpc.CallOption) (*vtctldata.GetDDLStatusResponse, error)
+	// GetExternalMysqls returns the external mysqlds registered as sources of
+	// vreplication workflows, and optionally checks their health.
+	GetExternalMysqls(ctx context.Context, in *vtctldata.GetExternalMysqlsRequest, opts ...grpc.CallOption) (*vtctldata.GetExternalMysqlsResponse, error)
 	// GetKeyspace reads the given keyspace from the topo and returns it.
 	GetKeyspace(ctx context.Context, in *vtctldata.GetKeyspaceRequest, opts ...grpc.CallOption) (*vtctldata.GetKeyspaceResponse, error)
+	// GetKeyspaceGraph returns the serving graph of a keyspace, with the
+	// replication relationships and lag of its tablets, for visualizing the
+	// replication topology.
+	GetKeyspaceGraph(ctx context.Context, in *vtctldata.GetKeyspaceGraphRequest, opts ...grpc.CallOption) (*vtctldata.GetKeyspaceGraphResponse, error)
 	// GetKeyspaces returns the keyspace struct of all keyspaces in the topo.
 	GetKeyspaces(ctx context.Context, in *vtctldata.GetKeyspacesRequest, opts ...grpc.CallOption) (*vtctldata.GetKeyspacesResponse, error)
+	// GetQueryRules returns the query rules of a keyspace.
+	GetQueryRules(ctx context.Context, in *vtctldata.GetQueryRulesRequest, opts ...grpc.CallOption) (*vtctldata.GetQueryRulesResponse, error)
+	// GetRecoverySettings returns the settings of the automatic recoveries of
+	// VTOrc.
+	GetRecoverySettings(ctx context.Context, in *vtctldata.GetRecoverySettingsRequest, opts ...grpc.CallOption) (*vtctldata.GetRecoverySettingsResponse, error)
+	// GetRestoreProgress returns the progress of the restore from backup of a
+	// tablet.
+	GetRestoreProgress(ctx context.Context, in *vtctldata.GetRestoreProgressRequest, opts ...grpc.CallOption) (*vtctldata.GetRestoreProgressResponse, error)
 	// GetRoutingRules returns the VSchema routing rules.
 	GetRoutingRules(ctx context.Context, in *vtctldata.GetRoutingRulesRequest, opts ...grpc.CallOption) (*vtctldata.GetRoutingRulesResponse, error)
 	// GetSchema returns the schema for a tablet, or just the schema for the
@@ -221,6 +256,9 @@ type VtctldClient interface {
 	GetTablets(ctx context.Context, in *vtctldata.GetTabletsRequest, opts ...grpc.CallOption) (*vtctldata.GetTabletsResponse, error)
 	// GetVSchema returns the vschema for a keyspace.
 	GetVSchema(ctx context.Context, in *vtctldata.GetVSchemaRequest, opts ...grpc.CallOption) (*vtctldata.GetVSchemaResponse, error)
+	// GetVSchemaRevisions returns the recorded revisions of the vschema of a
+	// keyspace.
+	GetVSchemaRevisions(ctx context.Context, in *vtctldata.GetVSchemaRevisionsRequest, opts ...grpc.CallOption) (*vtctldata.GetVSchemaRevisionsResponse, error)
 	// GetWorkflows returns a list of workflows for the given keyspace.
 	GetWorkflows(ctx context.Context, in *vtctldata.GetWorkflowsRequest, opts ...grpc.CallOption) (*vtctldata.GetWorkflowsResponse, error)
 	// InitShardPrimary sets the initial primary for a shard. Will make all other
@@ -230,6 +268,15 @@ type VtctldClient interface {
 	// PlannedReparentShard or EmergencyReparentShard should be used in those
 	// cases instead.
 	InitShardPrimary(ctx context.Context, in *vtctldata.InitShardPrimaryRequest, opts ...grpc.CallOption) (*vtctldata.InitShardPrimaryResponse, error)
+	// KillQueries kills the in-flight queries of a fingerprint or of a user on
+	// the tablets of a keyspace, and optionally makes vtgate and vttablet deny
+	// them for a while.
+	KillQueries(ctx context.Context, in *vtctldata.KillQueriesRequest, opts ...grpc.CallOption) (*vtctldata.KillQueriesResponse, error)
+	// MaterializeCreate creates a Materialize workflow.
+	MaterializeCreate(ctx context.Context, in *vtctldata.MaterializeCreateRequest, opts ...grpc.CallOption) (*vtctldata.MaterializeCreateResponse, error)
+	// MoveTablesCreate creates a MoveTables workflow, or a Migrate workflow if
+	// the source keyspace is in an external cluster.
+	MoveTablesCreate(ctx context.Context, in *vtctldata.MoveTablesCreateRequest, opts ...grpc.CallOption) (*vtctldata.MoveTablesCreateResponse, error)
 	// PlannedReparentShard reparents the shard to the new primary, or away from
 	// an old primary. Both the old and new primaries need to be reachable and
 	// running.
@@ -238,25 +285,74 @@ type VtctldClient interface {
 	// current shard primary is in for promotion unless NewPrimary is explicitly
 	// provided in the request.
 	PlannedReparentShard(ctx context.Context, in *vtctldata.PlannedReparentShardRequest, opts ...grpc.CallOption) (*vtctldata.PlannedReparentShardResponse, error)
+	// PruneBackups removes the backups of a shard that are not kept by the
+	// given retention policy.
+	PruneBackups(ctx context.Context, in *vtctldata.PruneBackupsRequest, opts ...grpc.CallOption) (*vtctldata.PruneBackupsResponse, error)
 	// RebuildVSchemaGraph rebuilds the per-cell SrvVSchema from the global
 	// VSchema objects in the provided cells (or all cells in the topo none
 	// provided).
 	RebuildVSchemaGraph(ctx context.Context, in *vtctldata.RebuildVSchemaGraphRequest, opts ...grpc.CallOption) (*vtctldata.RebuildVSchemaGraphResponse, error)
+	// ReferenceTablesCreate creates a managed workflow that replicates
+	// reference tables from a source keyspace to all the shards of target
+	// keyspaces.
+	ReferenceTablesCreate(ctx context.Context, in *vtctldata.ReferenceTablesCreateRequest, opts ...grpc.CallOption) (*vtctldata.ReferenceTablesCreateResponse, error)
+	// ReferenceTablesDelete deletes the streams of a reference tables workflow,
+	// and the workflow. The replicated tables are kept.
+	ReferenceTablesDelete(ctx context.Context, in *vtctldata.ReferenceTablesDeleteRequest, opts ...grpc.CallOption) (*vtctldata.ReferenceTablesDeleteResponse, error)
+	// ReferenceTablesRefresh creates the missing streams of reference tables
+	// workflows, on the shards added to their target keyspaces, and returns
+	// the status of the streams of every shard.
+	ReferenceTablesRefresh(ctx context.Context, in *vtctldata.ReferenceTablesRefreshRequest, opts ...grpc.CallOption) (*vtctldata.ReferenceTablesRefreshResponse, error)
 	// RefreshState reloads the tablet record on the specified tablet.
 	RefreshState(ctx context.Context, in *vtctldata.RefreshStateRequest, opts ...grpc.CallOption) (*vtctldata.RefreshStateResponse, error)
 	// RefreshStateByShard calls RefreshState on all the tablets in the given shard.
 	RefreshStateByShard(ctx context.Context, in *vtctldata.RefreshStateByShardRequest, opts ...grpc.CallOption) (*vtctldata.RefreshStateByShardResponse, error)
+	// RegisterExternalMysql registers an unmanaged mysqld as a read-only source
+	// of the MoveTables and Migrate workflows, after checking that vtctld can
+	// connect to it and that vreplication can stream its binlogs.
+	RegisterExternalMysql(ctx context.Context, in *vtctldata.RegisterExternalMysqlRequest, opts ...grpc.CallOption) (*vtctldata.RegisterExternalMysqlResponse, error)
 	// RemoveKeyspaceCell removes the specified cell from the Cells list for all
 	// shards in the specified keyspace, as well as from the SrvKeyspace for that
 	// keyspace in that cell.
 	RemoveKeyspaceCell(ctx context.Context, in *vtctldata.RemoveKeyspaceCellRequest, opts ...grpc.CallOption) (*vtctldata.RemoveKeyspaceCellResponse, error)
+	// RemoveQueryRule removes a query rule of a keyspace, from the Keyspace and
+	// from the SrvKeyspace of each cell.
+	RemoveQueryRule(ctx context.Context, in *vtctldata.RemoveQueryRuleRequest, opts ...grpc.CallOption) (*vtctldata.RemoveQueryRuleResponse, error)
 	// RemoveShardCell removes the specified cell from the specified shard's Cells
 	// list.
 	RemoveShardCell(ctx context.Context, in *vtctldata.RemoveShardCellRequest, opts ...grpc.CallOption) (*vtctldata.RemoveShardCellResponse, error)
+	// RepairReplication finds the tablets of a shard that do not replicate
+	// from its primary, and repairs those it safely can by repointing or
+	// restarting their replication. It reports the problems that need a manual
+	// action, such as errant GTIDs or transactions purged from the binlogs of
+	// the primary.
+	RepairReplication(ctx context.Context, in *vtctldata.RepairReplicationRequest, opts ...grpc.CallOption) (*vtctldata.RepairReplicationResponse, error)
 	// ReparentTablet reparents a tablet to the current primary in the shard. This
 	// only works if the current replica position matches the last known reparent
 	// action.
 	ReparentTablet(ctx context.Context, in *vtctldata.ReparentTabletRequest, opts ...grpc.CallOption) (*vtctldata.ReparentTabletResponse, error)
+	// ReshardCreate creates a Reshard workflow.
+	ReshardCreate(ctx context.Context, in *vtctldata.ReshardCreateRequest, opts ...grpc.CallOption) (*vtctldata.ReshardCreateResponse, error)
+	// RollbackVSchema restores the vschema of a keyspace to one of its
+	// revisions, and records it as a new revision.
+	RollbackVSchema(ctx context.Context, in *vtctldata.RollbackVSchemaRequest, opts ...grpc.CallOption) (*vtctldata.RollbackVSchemaResponse, error)
+	// ScaffoldVSchema suggests a sharded vschema for an unsharded keyspace,
+	// from its schema and a sample of its queries.
+	ScaffoldVSchema(ctx context.Context, in *vtctldata.ScaffoldVSchemaRequest, opts ...grpc.CallOption) (*vtctldata.ScaffoldVSchemaResponse, error)
+	// SetKeyspaceDurabilityPolicy sets the durability policy of a keyspace,
+	// after checking that enough tablets of each shard may acknowledge the
+	// transactions of its primary under it.
+	SetKeyspaceDurabilityPolicy(ctx context.Context, in *vtctldata.SetKeyspaceDurabilityPolicyRequest, opts ...grpc.CallOption) (*vtctldata.SetKeyspaceDurabilityPolicyResponse, error)
+	// SetKeyspacePolicy sets the query limits of a keyspace, in the Keyspace
+	// and in the SrvKeyspace of each cell.
+	SetKeyspacePolicy(ctx context.Context, in *vtctldata.SetKeyspacePolicyRequest, opts ...grpc.CallOption) (*vtctldata.SetKeyspacePolicyResponse, error)
+	// SetReplicationDelay makes a replica a delayed replica, which applies the
+	// events of its source with a delay, and neither serves queries nor is
+	// promoted. A zero delay makes it a regular replica again.
+	SetReplicationDelay(ctx context.Context, in *vtctldata.SetReplicationDelayRequest, opts ...grpc.CallOption) (*vtctldata.SetReplicationDelayResponse, error)
+	// SetShardWriteFence fences or unfences the writes to a shard. While the
+	// fence is set, the shard primary denies writes and keeps serving reads.
+	SetShardWriteFence(ctx context.Context, in *vtctldata.SetShardWriteFenceRequest, opts ...grpc.CallOption) (*vtctldata.SetShardWriteFenceResponse, error)
 	// ShardReplicationPositions returns the replication position of each tablet
 	// in a shard. This RPC makes a best-effort to return partial results. For
 	// example, if one tablet in the shard graph is unreachable, then
@@ -278,6 +374,40 @@ type VtctldClient interface {
 	// parameters. Empty values are ignored. If the alias does not exist, the
 	// CellsAlias will be created.
 	UpdateCellsAlias(ctx context.Context, in *vtctldata.UpdateCellsAliasRequest, opts ...grpc.CallOption) (*vtctldata.UpdateCellsAliasResponse, error)
+	// UpdateRecoverySettings enables or disables the automatic recoveries of
+	// VTOrc, for all the keyspaces, a keyspace or a shard, and adds or removes
+	// maintenance windows, during which the recoveries are disabled.
+	UpdateRecoverySettings(ctx context.Context, in *vtctldata.UpdateRecoverySettingsRequest, opts ...grpc.CallOption) (*vtctldata.UpdateRecoverySettingsResponse, error)
+	// UpdateThrottlerConfig updates the tablet throttler configuration of a
+	// keyspace, in the Keyspace and in the SrvKeyspace of each cell.
+	UpdateThrottlerConfig(ctx context.Context, in *vtctldata.UpdateThrottlerConfigRequest, opts ...grpc.CallOption) (*vtctldata.UpdateThrottlerConfigResponse, error)
+	// ValidateServingGraph compares the SrvKeyspace and SrvVSchema objects of
+	// the cells with those that the global topo gives, and optionally
+	// rebuilds the ones that diverge.
+	ValidateServingGraph(ctx context.Context, in *vtctldata.ValidateServingGraphRequest, opts ...grpc.CallOption) (*vtctldata.ValidateServingGraphResponse, error)
+	// ValidateVSchema checks the vschema of a keyspace against the schemas of
+	// the primaries of its shards, and reports its issues.
+	ValidateVSchema(ctx context.Context, in *vtctldata.ValidateVSchemaRequest, opts ...grpc.CallOption) (*vtctldata.ValidateVSchemaResponse, error)
+	// VDiff compares the source and the target tables of a workflow.
+	VDiff(ctx context.Context, in *vtctldata.VDiffRequest, opts ...grpc.CallOption) (*vtctldata.VDiffResponse, error)
+	// VerifyBackup checks the integrity of a backup, and records the outcome in
+	// the topo.
+	VerifyBackup(ctx context.Context, in *vtctldata.VerifyBackupRequest, opts ...grpc.CallOption) (*vtctldata.VerifyBackupResponse, error)
+	// WorkflowCancel deletes the target tables or shards, and the streams, of
+	// a workflow which has not switched any traffic.
+	WorkflowCancel(ctx context.Context, in *vtctldata.WorkflowCancelRequest, opts ...grpc.CallOption) (*vtctldata.WorkflowCancelResponse, error)
+	// WorkflowComplete deletes the source tables or shards, and the streams,
+	// of a workflow which has switched all the traffic.
+	WorkflowComplete(ctx context.Context, in *vtctldata.WorkflowCompleteRequest, opts ...grpc.CallOption) (*vtctldata.WorkflowCompleteResponse, error)
+	// WorkflowPause stops the streams of a workflow at a transaction boundary,
+	// recording the reason and the position in their message. Only
+	// WorkflowResume restarts them.
+	WorkflowPause(ctx context.Context, in *vtctldata.WorkflowPauseRequest, opts ...grpc.CallOption) (*vtctldata.WorkflowPauseResponse, error)
+	// WorkflowResume restarts the paused streams of a workflow.
+	WorkflowResume(ctx context.Context, in *vtctldata.WorkflowResumeRequest, opts ...grpc.CallOption) (*vtctldata.WorkflowResumeResponse, error)
+	// WorkflowSwitchTraffic switches the traffic of a MoveTables or Reshard
+	// workflow to the target, or back to the source.
+	WorkflowSwitchTraffic(ctx context.Context, in *vtctldata.WorkflowSwitchTrafficRequest, opts ...grpc.CallOption) (*vtctldata.WorkflowSwitchTrafficResponse, error)
 }
 
 type vtctldClient struct {
@@ -306,6 +436,15 @@ func (c *vtctldClient) AddCellsAlias(ctx context.Context, in *vtctldata.AddCells
 	return out, nil
 }
 
+func (c *vtctldClient) AddQueryRule(ctx context.Context, in *vtctldata.AddQueryRuleRequest, opts ...grpc.CallOption) (*vtctldata.AddQueryRuleResponse, error) {
+	out := new(vtctldata.AddQueryRuleResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/AddQueryRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vtctldClient) ApplyRoutingRules(ctx context.Context, in *vtctldata.ApplyRoutingRulesRequest, opts ...grpc.CallOption) (*vtctldata.ApplyRoutingRulesResponse, error) {
 	out := new(vtctldata.ApplyRoutingRulesResponse)
 	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/ApplyRoutingRules", in, out, opts...)
@@ -333,6 +472,24 @@ func (c *vtctldClient) ChangeTabletType(ctx context.Context, in *vtctldata.Chang
 	return out, nil
 }
 
+func (c *vtctldClient) ChangeTabletTags(ctx context.Context, in *vtctldata.ChangeTabletTagsRequest, opts ...grpc.CallOption) (*vtctldata.ChangeTabletTagsResponse, error) {
+	out := new(vtctldata.ChangeTabletTagsResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/ChangeTabletTags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) CheckErrantGTIDs(ctx context.Context, in *vtctldata.CheckErrantGTIDsRequest, opts ...grpc.CallOption) (*vtctldata.CheckErrantGTIDsResponse, error) {
+	out := new(vtctldata.CheckErrantGTIDsResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/CheckErrantGTIDs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vtctldClient) CreateKeyspace(ctx context.Context, in *vtctldata.CreateKeyspaceRequest, opts ...grpc.CallOption) (*vtctldata.CreateKeyspaceResponse, error) {
 	out := new(vtctldata.CreateKeyspaceResponse)
 	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/CreateKeyspace", in, out, opts...)
@@ -405,6 +562,15 @@ func (c *vtctldClient) DeleteTablets(ctx context.Context, in *vtctldata.DeleteTa
 	return out, nil
 }
 
+func (c *vtctldClient) DeregisterExternalMysql(ctx context.Context, in *vtctldata.DeregisterExternalMysqlRequest, opts ...grpc.CallOption) (*vtctldata.DeregisterExternalMysqlResponse, error) {
+	out := new(vtctldata.DeregisterExternalMysqlResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/DeregisterExternalMysql", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vtctldClient) EmergencyReparentShard(ctx context.Context, in *vtctldata.EmergencyReparentShardRequest, opts ...grpc.CallOption) (*vtctldata.EmergencyReparentShardResponse, error) {
 	out := new(vtctldata.EmergencyReparentShardResponse)
 	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/EmergencyReparentShard", in, out, opts...)
@@ -414,6 +580,15 @@ func (c *vtctldClient) EmergencyReparentShard(ctx context.Context, in *vtctldata
 	return out, nil
 }
 
+func (c *vtctldClient) ExplainQuery(ctx context.Context, in *vtctldata.ExplainQueryRequest, opts ...grpc.CallOption) (*vtctldata.ExplainQueryResponse, error) {
+	out := new(vtctldata.ExplainQueryResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/ExplainQuery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vtctldClient) FindAllShardsInKeyspace(ctx context.Context, in *vtctldata.FindAllShardsInKeyspaceRequest, opts ...grpc.CallOption) (*vtctldata.FindAllShardsInKeyspaceResponse, error) {
 	out := new(vtctldata.FindAllShardsInKeyspaceResponse)
 	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/FindAllShardsInKeyspace", in, out, opts...)
@@ -459,6 +634,24 @@ func (c *vtctldClient) GetCellsAliases(ctx context.Context, in *vtctldata.GetCel
 	return out, nil
 }
 
+func (c *vtctldClient) GetDDLStatus(ctx context.Context, in *vtctldata.GetDDLStatusRequest, opts ...grpc.CallOption) (*vtctldata.GetDDLStatusResponse, error) {
+	out := new(vtctldata.GetDDLStatusResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/GetDDLStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) GetExternalMysqls(ctx context.Context, in *vtctldata.GetExternalMysqlsRequest, opts ...grpc.CallOption) (*vtctldata.GetExternalMysqlsResponse, error) {
+	out := new(vtctldata.GetExternalMysqlsResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/GetExternalMysqls", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vtctldClient) GetKeyspace(ctx context.Context, in *vtctldata.GetKeyspaceRequest, opts ...grpc.CallOption) (*vtctldata.GetKeyspaceResponse, error) {
 	out := new(vtctldata.GetKeyspaceResponse)
 	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/GetKeyspace", in, out, opts...)
@@ -468,6 +661,15 @@ func (c *vtctldClient) GetKeyspace(ctx context.Context, in *vtctldata.GetKeyspac
 	return out, nil
 }
 
+func (c *vtctldClient) GetKeyspaceGraph(ctx context.Context, in *vtctldata.GetKeyspaceGraphRequest, opts ...grpc.CallOption) (*vtctldata.GetKeyspaceGraphResponse, error) {
+	out := new(vtctldata.GetKeyspaceGraphResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/GetKeyspaceGraph", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vtctldClient) GetKeyspaces(ctx context.Context, in *vtctldata.GetKeyspacesRequest, opts ...grpc.CallOption) (*vtctldata.GetKeyspacesResponse, error) {
 	out := new(vtctldata.GetKeyspacesResponse)
 	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/GetKeyspaces", in, out, opts...)
@@ -477,6 +679,33 @@ func (c *vtctldClient) GetKeyspaces(ctx context.Context, in *vtctldata.GetKeyspa
 	return out, nil
 }
 
+func (c *vtctldClient) GetQueryRules(ctx context.Context, in *vtctldata.GetQueryRulesRequest, opts ...grpc.CallOption) (*vtctldata.GetQueryRulesResponse, error) {
+	out := new(vtctldata.GetQueryRulesResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/GetQueryRules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) GetRecoverySettings(ctx context.Context, in *vtctldata.GetRecoverySettingsRequest, opts ...grpc.CallOption) (*vtctldata.GetRecoverySettingsResponse, error) {
+	out := new(vtctldata.GetRecoverySettingsResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/GetRecoverySettings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) GetRestoreProgress(ctx context.Context, in *vtctldata.GetRestoreProgressRequest, opts ...grpc.CallOption) (*vtctldata.GetRestoreProgressResponse, error) {
+	out := new(vtctldata.GetRestoreProgressResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/GetRestoreProgress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vtctldClient) GetRoutingRules(ctx context.Context, in *vtctldata.GetRoutingRulesRequest, opts ...grpc.CallOption) (*vtctldata.GetRoutingRulesResponse, error) {
 	out := new(vtctldata.GetRoutingRulesResponse)
 	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/GetRoutingRules", in, out, opts...)
@@ -567,6 +796,15 @@ func (c *vtctldClient) GetVSchema(ctx context.Context, in *vtctldata.GetVSchemaR
 	return out, nil
 }
 
+func (c *vtctldClient) GetVSchemaRevisions(ctx context.Context, in *vtctldata.GetVSchemaRevisionsRequest, opts ...grpc.CallOption) (*vtctldata.GetVSchemaRevisionsResponse, error) {
+	out := new(vtctldata.GetVSchemaRevisionsResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/GetVSchemaRevisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vtctldClient) GetWorkflows(ctx context.Context, in *vtctldata.GetWorkflowsRequest, opts ...grpc.CallOption) (*vtctldata.GetWorkflowsResponse, error) {
 	out := new(vtctldata.GetWorkflowsResponse)
 	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/GetWorkflows", in, out, opts...)
@@ -585,6 +823,33 @@ func (c *vtctldClient) InitShardPrimary(ctx context.Context, in *vtctldata.InitS
 	return out, nil
 }
 
+func (c *vtctldClient) KillQueries(ctx context.Context, in *vtctldata.KillQueriesRequest, opts ...grpc.CallOption) (*vtctldata.KillQueriesResponse, error) {
+	out := new(vtctldata.KillQueriesResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/KillQueries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) MaterializeCreate(ctx context.Context, in *vtctldata.MaterializeCreateRequest, opts ...grpc.CallOption) (*vtctldata.MaterializeCreateResponse, error) {
+	out := new(vtctldata.MaterializeCreateResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/MaterializeCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) MoveTablesCreate(ctx context.Context, in *vtctldata.MoveTablesCreateRequest, opts ...grpc.CallOption) (*vtctldata.MoveTablesCreateResponse, error) {
+	out := new(vtctldata.MoveTablesCreateResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/MoveTablesCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vtctldClient) PlannedReparentShard(ctx context.Context, in *vtctldata.PlannedReparentShardRequest, opts ...grpc.CallOption) (*vtctldata.PlannedReparentShardResponse, error) {
 	out := new(vtctldata.PlannedReparentShardResponse)
 	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/PlannedReparentShard", in, out, opts...)
@@ -594,6 +859,15 @@ func (c *vtctldClient) PlannedReparentShard(ctx context.Context, in *vtctldata.P
 	return out, nil
 }
 
+func (c *vtctldClient) PruneBackups(ctx context.Context, in *vtctldata.PruneBackupsRequest, opts ...grpc.CallOption) (*vtctldata.PruneBackupsResponse, error) {
+	out := new(vtctldata.PruneBackupsResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/PruneBackups", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vtctldClient) RebuildVSchemaGraph(ctx context.Context, in *vtctldata.RebuildVSchemaGraphRequest, opts ...grpc.CallOption) (*vtctldata.RebuildVSchemaGraphResponse, error) {
 	out := new(vtctldata.RebuildVSchemaGraphResponse)
 	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/RebuildVSchemaGraph", in, out, opts...)
@@ -603,6 +877,33 @@ func (c *vtctldClient) RebuildVSchemaGraph(ctx context.Context, in *vtctldata.Re
 	return out, nil
 }
 
+func (c *vtctldClient) ReferenceTablesCreate(ctx context.Context, in *vtctldata.ReferenceTablesCreateRequest, opts ...grpc.CallOption) (*vtctldata.ReferenceTablesCreateResponse, error) {
+	out := new(vtctldata.ReferenceTablesCreateResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/ReferenceTablesCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) ReferenceTablesDelete(ctx context.Context, in *vtctldata.ReferenceTablesDeleteRequest, opts ...grpc.CallOption) (*vtctldata.ReferenceTablesDeleteResponse, error) {
+	out := new(vtctldata.ReferenceTablesDeleteResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/ReferenceTablesDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) ReferenceTablesRefresh(ctx context.Context, in *vtctldata.ReferenceTablesRefreshRequest, opts ...grpc.CallOption) (*vtctldata.ReferenceTablesRefreshResponse, error) {
+	out := new(vtctldata.ReferenceTablesRefreshResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/ReferenceTablesRefresh", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vtctldClient) RefreshState(ctx context.Context, in *vtctldata.RefreshStateRequest, opts ...grpc.CallOption) (*vtctldata.RefreshStateResponse, error) {
 	out := new(vtctldata.RefreshStateResponse)
 	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/RefreshState", in, out, opts...)
@@ -621,6 +922,15 @@ func (c *vtctldClient) RefreshStateByShard(ctx context.Context, in *vtctldata.Re
 	return out, nil
 }
 
+func (c *vtctldClient) RegisterExternalMysql(ctx context.Context, in *vtctldata.RegisterExternalMysqlRequest, opts ...grpc.CallOption) (*vtctldata.RegisterExternalMysqlResponse, error) {
+	out := new(vtctldata.RegisterExternalMysqlResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/RegisterExternalMysql", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vtctldClient) RemoveKeyspaceCell(ctx context.Context, in *vtctldata.RemoveKeyspaceCellRequest, opts ...grpc.CallOption) (*vtctldata.RemoveKeyspaceCellResponse, error) {
 	out := new(vtctldata.RemoveKeyspaceCellResponse)
 	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/RemoveKeyspaceCell", in, out, opts...)
@@ -630,6 +940,15 @@ func (c *vtctldClient) RemoveKeyspaceCell(ctx context.Context, in *vtctldata.Rem
 	return out, nil
 }
 
+func (c *vtctldClient) RemoveQueryRule(ctx context.Context, in *vtctldata.RemoveQueryRuleRequest, opts ...grpc.CallOption) (*vtctldata.RemoveQueryRuleResponse, error) {
+	out := new(vtctldata.RemoveQueryRuleResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/RemoveQueryRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vtctldClient) RemoveShardCell(ctx context.Context, in *vtctldata.RemoveShardCellRequest, opts ...grpc.CallOption) (*vtctldata.RemoveShardCellResponse, error) {
 	out := new(vtctldata.RemoveShardCellResponse)
 	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/RemoveShardCell", in, out, opts...)
@@ -639,6 +958,15 @@ func (c *vtctldClient) RemoveShardCell(ctx context.Context, in *vtctldata.Remove
 	return out, nil
 }
 
+func (c *vtctldClient) RepairReplication(ctx context.Context, in *vtctldata.RepairReplicationRequest, opts ...grpc.CallOption) (*vtctldata.RepairReplicationResponse, error) {
+	out := new(vtctldata.RepairReplicationResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/RepairReplication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vtctldClient) ReparentTablet(ctx context.Context, in *vtctldata.ReparentTabletRequest, opts ...grpc.CallOption) (*vtctldata.ReparentTabletResponse, error) {
 	out := new(vtctldata.ReparentTabletResponse)
 	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/ReparentTablet", in, out, opts...)
@@ -648,6 +976,69 @@ func (c *vtctldClient) ReparentTablet(ctx context.Context, in *vtctldata.Reparen
 	return out, nil
 }
 
+func (c *vtctldClient) ReshardCreate(ctx context.Context, in *vtctldata.ReshardCreateRequest, opts ...grpc.CallOption) (*vtctldata.ReshardCreateResponse, error) {
+	out := new(vtctldata.ReshardCreateResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/ReshardCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) RollbackVSchema(ctx context.Context, in *vtctldata.RollbackVSchemaRequest, opts ...grpc.CallOption) (*vtctldata.RollbackVSchemaResponse, error) {
+	out := new(vtctldata.RollbackVSchemaResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/RollbackVSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) ScaffoldVSchema(ctx context.Context, in *vtctldata.ScaffoldVSchemaRequest, opts ...grpc.CallOption) (*vtctldata.ScaffoldVSchemaResponse, error) {
+	out := new(vtctldata.ScaffoldVSchemaResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/ScaffoldVSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) SetKeyspaceDurabilityPolicy(ctx context.Context, in *vtctldata.SetKeyspaceDurabilityPolicyRequest, opts ...grpc.CallOption) (*vtctldata.SetKeyspaceDurabilityPolicyResponse, error) {
+	out := new(vtctldata.SetKeyspaceDurabilityPolicyResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/SetKeyspaceDurabilityPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) SetKeyspacePolicy(ctx context.Context, in *vtctldata.SetKeyspacePolicyRequest, opts ...grpc.CallOption) (*vtctldata.SetKeyspacePolicyResponse, error) {
+	out := new(vtctldata.SetKeyspacePolicyResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/SetKeyspacePolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) SetReplicationDelay(ctx context.Context, in *vtctldata.SetReplicationDelayRequest, opts ...grpc.CallOption) (*vtctldata.SetReplicationDelayResponse, error) {
+	out := new(vtctldata.SetReplicationDelayResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/SetReplicationDelay", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) SetShardWriteFence(ctx context.Context, in *vtctldata.SetShardWriteFenceRequest, opts ...grpc.CallOption) (*vtctldata.SetShardWriteFenceResponse, error) {
+	out := new(vtctldata.SetShardWriteFenceResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/SetShardWriteFence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vtctldClient) ShardReplicationPositions(ctx context.Context, in *vtctldata.ShardReplicationPositionsRequest, opts ...grpc.CallOption) (*vtctldata.ShardReplicationPositionsResponse, error) {
 	out := new(vtctldata.ShardReplicationPositionsResponse)
 	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/ShardReplicationPositions", in, out, opts...)
@@ -684,6 +1075,105 @@ func (c *vtctldClient) UpdateCellsAlias(ctx context.Context, in *vtctldata.Updat
 	return out, nil
 }
 
+func (c *vtctldClient) UpdateRecoverySettings(ctx context.Context, in *vtctldata.UpdateRecoverySettingsRequest, opts ...grpc.CallOption) (*vtctldata.UpdateRecoverySettingsResponse, error) {
+	out := new(vtctldata.UpdateRecoverySettingsResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/UpdateRecoverySettings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) UpdateThrottlerConfig(ctx context.Context, in *vtctldata.UpdateThrottlerConfigRequest, opts ...grpc.CallOption) (*vtctldata.UpdateThrottlerConfigResponse, error) {
+	out := new(vtctldata.UpdateThrottlerConfigResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/UpdateThrottlerConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) ValidateServingGraph(ctx context.Context, in *vtctldata.ValidateServingGraphRequest, opts ...grpc.CallOption) (*vtctldata.ValidateServingGraphResponse, error) {
+	out := new(vtctldata.ValidateServingGraphResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/ValidateServingGraph", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) ValidateVSchema(ctx context.Context, in *vtctldata.ValidateVSchemaRequest, opts ...grpc.CallOption) (*vtctldata.ValidateVSchemaResponse, error) {
+	out := new(vtctldata.ValidateVSchemaResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/ValidateVSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) VDiff(ctx context.Context, in *vtctldata.VDiffRequest, opts ...grpc.CallOption) (*vtctldata.VDiffResponse, error) {
+	out := new(vtctldata.VDiffResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/VDiff", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) VerifyBackup(ctx context.Context, in *vtctldata.VerifyBackupRequest, opts ...grpc.CallOption) (*vtctldata.VerifyBackupResponse, error) {
+	out := new(vtctldata.VerifyBackupResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/VerifyBackup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) WorkflowCancel(ctx context.Context, in *vtctldata.WorkflowCancelRequest, opts ...grpc.CallOption) (*vtctldata.WorkflowCancelResponse, error) {
+	out := new(vtctldata.WorkflowCancelResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/WorkflowCancel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) WorkflowComplete(ctx context.Context, in *vtctldata.WorkflowCompleteRequest, opts ...grpc.CallOption) (*vtctldata.WorkflowCompleteResponse, error) {
+	out := new(vtctldata.WorkflowCompleteResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/WorkflowComplete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) WorkflowPause(ctx context.Context, in *vtctldata.WorkflowPauseRequest, opts ...grpc.CallOption) (*vtctldata.WorkflowPauseResponse, error) {
+	out := new(vtctldata.WorkflowPauseResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/WorkflowPause", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) WorkflowResume(ctx context.Context, in *vtctldata.WorkflowResumeRequest, opts ...grpc.CallOption) (*vtctldata.WorkflowResumeResponse, error) {
+	out := new(vtctldata.WorkflowResumeResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/WorkflowResume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vtctldClient) WorkflowSwitchTraffic(ctx context.Context, in *vtctldata.WorkflowSwitchTrafficRequest, opts ...grpc.CallOption) (*vtctldata.WorkflowSwitchTrafficResponse, error) {
+	out := new(vtctldata.WorkflowSwitchTrafficResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/WorkflowSwitchTraffic", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // VtctldServer is the server API for Vtctld service.
 // All implementations must embed UnimplementedVtctldServer
 // for forward compatibility
@@ -698,6 +1188,9 @@ type VtctldServer interface {
 	// cells within the group (alias). Only primary traffic can be routed across
 	// cells not in the same group (alias).
 	AddCellsAlias(context.Context, *vtctldata.AddCellsAliasRequest) (*vtctldata.AddCellsAliasResponse, error)
+	// AddQueryRule adds or replaces a query rule of a keyspace, in the Keyspace
+	// and in the SrvKeyspace of each cell.
+	AddQueryRule(context.Context, *vtctldata.AddQueryRuleRequest) (*vtctldata.AddQueryRuleResponse, error)
 	// ApplyRoutingRules applies the VSchema routing rules.
 	ApplyRoutingRules(context.Context, *vtctldata.ApplyRoutingRulesRequest) (*vtctldata.ApplyRoutingRulesResponse, error)
 	// ApplyVSchema applies a vschema to a keyspace.
@@ -708,6 +1201,13 @@ type VtctldServer interface {
 	//
 	// NOTE: This command automatically updates the serving graph.
 	ChangeTabletType(context.Context, *vtctldata.ChangeTabletTypeRequest) (*vtctldata.ChangeTabletTypeResponse, error)
+	// ChangeTabletTags changes the tags of a tablet. The tablet publishes its
+	// new tags, which vtgate uses with the tablet routing rules.
+	ChangeTabletTags(context.Context, *vtctldata.ChangeTabletTagsRequest) (*vtctldata.ChangeTabletTagsResponse, error)
+	// CheckErrantGTIDs lists the replicas of a shard that executed transactions
+	// the primary did not, and optionally removes those errant GTIDs from their
+	// GTID sets.
+	CheckErrantGTIDs(context.Context, *vtctldata.CheckErrantGTIDsRequest) (*vtctldata.CheckErrantGTIDsResponse, error)
 	// CreateKeyspace creates the specified keyspace in the topology. For a
 	// SNAPSHOT keyspace, the request must specify the name of a base keyspace,
 	// as well as a snapshot time.
@@ -733,9 +1233,16 @@ type VtctldServer interface {
 	DeleteSrvVSchema(context.Context, *vtctldata.DeleteSrvVSchemaRequest) (*vtctldata.DeleteSrvVSchemaResponse, error)
 	// DeleteTablets deletes one or more tablets from the topology.
 	DeleteTablets(context.Context, *vtctldata.DeleteTabletsRequest) (*vtctldata.DeleteTabletsResponse, error)
+	// DeregisterExternalMysql removes an external mysqld registered as a source
+	// of vreplication workflows.
+	DeregisterExternalMysql(context.Context, *vtctldata.DeregisterExternalMysqlRequest) (*vtctldata.DeregisterExternalMysqlResponse, error)
 	// EmergencyReparentShard reparents the shard to the new primary. It assumes
 	// the old primary is dead or otherwise not responding.
 	EmergencyReparentShard(context.Context, *vtctldata.EmergencyReparentShardRequest) (*vtctldata.EmergencyReparentShardResponse, error)
+	// ExplainQuery explains how queries would be routed and executed in the
+	// cluster, with its current vschema, shards and schema, without executing
+	// them.
+	ExplainQuery(context.Context, *vtctldata.ExplainQueryRequest) (*vtctldata.ExplainQueryResponse, error)
 	// FindAllShardsInKeyspace returns a map of shard names to shard references
 	// for a given keyspace.
 	FindAllShardsInKeyspace(context.Context, *vtctldata.FindAllShardsInKeyspaceRequest) (*vtctldata.FindAllShardsInKeyspaceResponse, error)
@@ -749,10 +1256,28 @@ type VtctldServer interface {
 	// GetCellsAliases returns a mapping of cell alias to cells identified by that
 	// alias.
 	GetCellsAliases(context.Context, *vtctldata.GetCellsAliasesRequest) (*vtctldata.GetCellsAliasesResponse, error)
+	// GetDDLStatus returns the per-shard status of a DDL that vtgate applied
+	// to the shards of a keyspace.
+	GetDDLStatus(context.Context, *vtctldata.GetDDLStatusRequest) (*vtctldata.GetDDLStatusResponse, error)
+	// GetExternalMysqls returns the external mysqlds registered as sources of
+	// vreplication workflows, and optionally checks their health.
+	GetExternalMysqls(context.Context, *vtctldata.GetExternalMysqlsRequest) (*vtctldata.GetExternalMysqlsResponse, error)
 	// GetKeyspace reads the given keyspace from the topo and returns it.
 	GetKeyspace(context.Context, *vtctldata.GetKeyspaceRequest) (*vtctldata.GetKeyspaceResponse, error)
+	// GetKeyspaceGraph returns the serving graph of a keyspace, with the
+	// replication relationships and lag of its tablets, for visualizing the
+	// replication topology.
+	GetKeyspaceGraph(context.Context, *vtctldata.GetKeyspaceGraphRequest) (*vtctldata.GetKeyspaceGraphResponse, error)
 	// GetKeyspaces returns the keyspace struct of all keyspaces in the topo.
 	GetKeyspaces(context.Context, *vtctldata.GetKeyspacesRequest) (*vtctldata.GetKeyspacesResponse, error)
+	// GetQueryRules returns the query rules of a keyspace.
+	GetQueryRules(context.Context, *vtctldata.GetQueryRulesRequest) (*vtctldata.GetQueryRulesResponse, error)
+	// GetRecoverySettings returns the settings of the automatic recoveries of
+	// VTOrc.
+	GetRecoverySettings(context.Context, *vtctldata.GetRecoverySettingsRequest) (*vtctldata.GetRecoverySettingsResponse, error)
+	// GetRestoreProgress returns the progress of the restore from backup of a
+	// tablet.
+	GetRestoreProgress(context.Context, *vtctldata.GetRestoreProgressRequest) (*vtctldata.GetRestoreProgressResponse, error)
 	// GetRoutingRules returns the VSchema routing rules.
 	GetRoutingRules(context.Context, *vtctldata.GetRoutingRulesRequest) (*vtctldata.GetRoutingRulesResponse, error)
 	// GetSchema returns the schema for a tablet, or just the schema for the
@@ -777,6 +1302,9 @@ type VtctldServer interface {
 	GetTablets(context.Context, *vtctldata.GetTabletsRequest) (*vtctldata.GetTabletsResponse, error)
 	// GetVSchema returns the vschema for a keyspace.
 	GetVSchema(context.Context, *vtctldata.GetVSchemaRequest) (*vtctldata.GetVSchemaResponse, error)
+	// GetVSchemaRevisions returns the recorded revisions of the vschema of a
+	// keyspace.
+	GetVSchemaRevisions(context.Context, *vtctldata.GetVSchemaRevisionsRequest) (*vtctldata.GetVSchemaRevisionsResponse, error)
 	// GetWorkflows returns a list of workflows for the given keyspace.
 	GetWorkflows(context.Context, *vtctldata.GetWorkflowsRequest) (*vtctldata.GetWorkflowsResponse, error)
 	// InitShardPrimary sets the initial primary for a shard. Will make all other
@@ -786,6 +1314,15 @@ type VtctldServer interface {
 	// PlannedReparentShard or EmergencyReparentShard should be used in those
 	// cases instead.
 	InitShardPrimary(context.Context, *vtctldata.InitShardPrimaryRequest) (*vtctldata.InitShardPrimaryResponse, error)
+	// KillQueries kills the in-flight queries of a fingerprint or of a user on
+	// the tablets of a keyspace, and optionally makes vtgate and vttablet deny
+	// them for a while.
+	KillQueries(context.Context, *vtctldata.KillQueriesRequest) (*vtctldata.KillQueriesResponse, error)
+	// MaterializeCreate creates a Materialize workflow.
+	MaterializeCreate(context.Context, *vtctldata.MaterializeCreateRequest) (*vtctldata.MaterializeCreateResponse, error)
+	// MoveTablesCreate creates a MoveTables workflow, or a Migrate workflow if
+	// the source keyspace is in an external cluster.
+	MoveTablesCreate(context.Context, *vtctldata.MoveTablesCreateRequest) (*vtctldata.MoveTablesCreateResponse, error)
 	// PlannedReparentShard reparents the shard to the new primary, or away from
 	// an old primary. Both the old and new primaries need to be reachable and
 	// running.
@@ -794,25 +1331,74 @@ type VtctldServer interface {
 	// current shard primary is in for promotion unless NewPrimary is explicitly
 	// provided in the request.
 	PlannedReparentShard(context.Context, *vtctldata.PlannedReparentShardRequest) (*vtctldata.PlannedReparentShardResponse, error)
+	// PruneBackups removes the backups of a shard that are not kept by the
+	// given retention policy.
+	PruneBackups(context.Context, *vtctldata.PruneBackupsRequest) (*vtctldata.PruneBackupsResponse, error)
 	// RebuildVSchemaGraph rebuilds the per-cell SrvVSchema from the global
 	// VSchema objects in the provided cells (or all cells in the topo none
 	// provided).
 	RebuildVSchemaGraph(context.Context, *vtctldata.RebuildVSchemaGraphRequest) (*vtctldata.RebuildVSchemaGraphResponse, error)
+	// ReferenceTablesCreate creates a managed workflow that replicates
+	// reference tables from a source keyspace to all the shards of target
+	// keyspaces.
+	ReferenceTablesCreate(context.Context, *vtctldata.ReferenceTablesCreateRequest) (*vtctldata.ReferenceTablesCreateResponse, error)
+	// ReferenceTablesDelete deletes the streams of a reference tables workflow,
+	// and the workflow. The replicated tables are kept.
+	ReferenceTablesDelete(context.Context, *vtctldata.ReferenceTablesDeleteRequest) (*vtctldata.ReferenceTablesDeleteResponse, error)
+	// ReferenceTablesRefresh creates the missing streams of reference tables
+	// workflows, on the shards added to their target keyspaces, and returns
+	// the status of the streams of every shard.
+	ReferenceTablesRefresh(context.Context, *vtctldata.ReferenceTablesRefreshRequest) (*vtctldata.ReferenceTablesRefreshResponse, error)
 	// RefreshState reloads the tablet record on the specified tablet.
 	RefreshState(context.Context, *vtctldata.RefreshStateRequest) (*vtctldata.RefreshStateResponse, error)
 	// RefreshStateByShard calls RefreshState on all the tablets in the given shard.
 	RefreshStateByShard(context.Context, *vtctldata.RefreshStateByShardRequest) (*vtctldata.RefreshStateByShardResponse, error)
+	// RegisterExternalMysql registers an unmanaged mysqld as a read-only source
+	// of the MoveTables and Migrate workflows, after checking that vtctld can
+	// connect to it and that vreplication can stream its binlogs.
+	RegisterExternalMysql(context.Context, *vtctldata.RegisterExternalMysqlRequest) (*vtctldata.RegisterExternalMysqlResponse, error)
 	// RemoveKeyspaceCell removes the specified cell from the Cells list for all
 	// shards in the specified keyspace, as well as from the SrvKeyspace for that
 	// keyspace in that cell.
 	RemoveKeyspaceCell(context.Context, *vtctldata.RemoveKeyspaceCellRequest) (*vtctldata.RemoveKeyspaceCellResponse, error)
+	// RemoveQueryRule removes a query rule of a keyspace, from the Keyspace and
+	// from the SrvKeyspace of each cell.
+	RemoveQueryRule(context.Context, *vtctldata.RemoveQueryRuleRequest) (*vtctldata.RemoveQueryRuleResponse, error)
 	// RemoveShardCell removes the specified cell from the specified shard's Cells
 	// list.
 	RemoveShardCell(context.Context, *vtctldata.RemoveShardCellRequest) (*vtctldata.RemoveShardCellResponse, error)
+	// RepairReplication finds the tablets of a shard that do not replicate
+	// from its primary, and repairs those it safely can by repointing or
+	// restarting their replication. It reports the problems that need a manual
+	// action, such as errant GTIDs or transactions purged from the binlogs of
+	// the primary.
+	RepairReplication(context.Context, *vtctldata.RepairReplicationRequest) (*vtctldata.RepairReplicationResponse, error)
 	// ReparentTablet reparents a tablet to the current primary in the shard. This
 	// only works if the current replica position matches the last known reparent
 	// action.
 	ReparentTablet(context.Context, *vtctldata.ReparentTabletRequest) (*vtctldata.ReparentTabletResponse, error)
+	// ReshardCreate creates a Reshard workflow.
+	ReshardCreate(context.Context, *vtctldata.ReshardCreateRequest) (*vtctldata.ReshardCreateResponse, error)
+	// RollbackVSchema restores the vschema of a keyspace to one of its
+	// revisions, and records it as a new revision.
+	RollbackVSchema(context.Context, *vtctldata.RollbackVSchemaRequest) (*vtctldata.RollbackVSchemaResponse, error)
+	// ScaffoldVSchema suggests a sharded vschema for an unsharded keyspace,
+	// from its schema and a sample of its queries.
+	ScaffoldVSchema(context.Context, *vtctldata.ScaffoldVSchemaRequest) (*vtctldata.ScaffoldVSchemaResponse, error)
+	// SetKeyspaceDurabilityPolicy sets the durability policy of a keyspace,
+	// after checking that enough tablets of each shard may acknowledge the
+	// transactions of its primary under it.
+	SetKeyspaceDurabilityPolicy(context.Context, *vtctldata.SetKeyspaceDurabilityPolicyRequest) (*vtctldata.SetKeyspaceDurabilityPolicyResponse, error)
+	// SetKeyspacePolicy sets the query limits of a keyspace, in the Keyspace
+	// and in the SrvKeyspace of each cell.
+	SetKeyspacePolicy(context.Context, *vtctldata.SetKeyspacePolicyRequest) (*vtctldata.SetKeyspacePolicyResponse, error)
+	// SetReplicationDelay makes a replica a delayed replica, which applies the
+	// events of its source with a delay, and neither serves queries nor is
+	// promoted. A zero delay makes it a regular replica again.
+	SetReplicationDelay(context.Context, *vtctldata.SetReplicationDelayRequest) (*vtctldata.SetReplicationDelayResponse, error)
+	// SetShardWriteFence fences or unfences the writes to a shard. While the
+	// fence is set, the shard primary denies writes and keeps serving reads.
+	SetShardWriteFence(context.Context, *vtctldata.SetShardWriteFenceRequest) (*vtctldata.SetShardWriteFenceResponse, error)
 	// ShardReplicationPositions returns the replication position of each tablet
 	// in a shard. This RPC makes a best-effort to return partial results. For
 	// example, if one tablet in the shard graph is unreachable, then
@@ -834,6 +1420,40 @@ type VtctldServer interface {
 	// parameters. Empty values are ignored. If the alias does not exist, the
 	// CellsAlias will be created.
 	UpdateCellsAlias(context.Context, *vtctldata.UpdateCellsAliasRequest) (*vtctldata.UpdateCellsAliasResponse, error)
+	// UpdateRecoverySettings enables or disables the automatic recoveries of
+	// VTOrc, for all the keyspaces, a keyspace or a shard, and adds or removes
+	// maintenance windows, during which the recoveries are disabled.
+	UpdateRecoverySettings(context.Context, *vtctldata.UpdateRecoverySettingsRequest) (*vtctldata.UpdateRecoverySettingsResponse, error)
+	// UpdateThrottlerConfig updates the tablet throttler configuration of a
+	// keyspace, in the Keyspace and in the SrvKeyspace of each cell.
+	UpdateThrottlerConfig(context.Context, *vtctldata.UpdateThrottlerConfigRequest) (*vtctldata.UpdateThrottlerConfigResponse, error)
+	// ValidateServingGraph compares the SrvKeyspace and SrvVSchema objects of
+	// the cells with those that the global topo gives, and optionally
+	// rebuilds the ones that diverge.
+	ValidateServingGraph(context.Context, *vtctldata.ValidateServingGraphRequest) (*vtctldata.ValidateServingGraphResponse, error)
+	// ValidateVSchema checks the vschema of a keyspace against the schemas of
+	// the primaries of its shards, and reports its issues.
+	ValidateVSchema(context.Context, *vtctldata.ValidateVSchemaRequest) (*vtctldata.ValidateVSchemaResponse, error)
+	// VDiff compares the source and the target tables of a workflow.
+	VDiff(context.Context, *vtctldata.VDiffRequest) (*vtctldata.VDiffResponse, error)
+	// VerifyBackup checks the integrity of a backup, and records the outcome in
+	// the topo.
+	VerifyBackup(context.Context, *vtctldata.VerifyBackupRequest) (*vtctldata.VerifyBackupResponse, error)
+	// WorkflowCancel deletes the target tables or shards, and the streams, of
+	// a workflow which has not switched any traffic.
+	WorkflowCancel(context.Context, *vtctldata.WorkflowCancelRequest) (*vtctldata.WorkflowCancelResponse, error)
+	// WorkflowComplete deletes the source tables or shards, and the streams,
+	// of a workflow which has switched all the traffic.
+	WorkflowComplete(context.Context, *vtctldata.WorkflowCompleteRequest) (*vtctldata.WorkflowCompleteResponse, error)
+	// WorkflowPause stops the streams of a workflow at a transaction boundary,
+	// recording the reason and the position in their message. Only
+	// WorkflowResume restarts them.
+	WorkflowPause(context.Context, *vtctldata.WorkflowPauseRequest) (*vtctldata.WorkflowPauseResponse, error)
+	// WorkflowResume restarts the paused streams of a workflow.
+	WorkflowResume(context.Context, *vtctldata.WorkflowResumeRequest) (*vtctldata.WorkflowResumeResponse, error)
+	// WorkflowSwitchTraffic switches the traffic of a MoveTables or Reshard
+	// workflow to the target, or back to the source.
+	WorkflowSwitchTraffic(context.Context, *vtctldata.WorkflowSwitchTrafficRequest) (*vtctldata.WorkflowSwitchTrafficResponse, error)
 	mustEmbedUnimplementedVtctldServer()
 }
 
@@ -847,6 +1467,9 @@ func (UnimplementedVtctldServer) AddCellInfo(context.Context, *vtctldata.AddCell
 func (UnimplementedVtctldServer) AddCellsAlias(context.Context, *vtctldata.AddCellsAliasRequest) (*vtctldata.AddCellsAliasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddCellsAlias not implemented")
 }
+func (UnimplementedVtctldServer) AddQueryRule(context.Context, *vtctldata.AddQueryRuleRequest) (*vtctldata.AddQueryRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddQueryRule not implemented")
+}
 func (UnimplementedVtctldServer) ApplyRoutingRules(context.Context, *vtctldata.ApplyRoutingRulesRequest) (*vtctldata.ApplyRoutingRulesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ApplyRoutingRules not implemented")
 }
@@ -856,6 +1479,12 @@ func (UnimplementedVtctldServer) ApplyVSchema(context.Context, *vtctldata.ApplyV
 func (UnimplementedVtctldServer) ChangeTabletType(context.Context, *vtctldata.ChangeTabletTypeRequest) (*vtctldata.ChangeTabletTypeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ChangeTabletType not implemented")
 }
+func (UnimplementedVtctldServer) ChangeTabletTags(context.Context, *vtctldata.ChangeTabletTagsRequest) (*vtctldata.ChangeTabletTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeTabletTags not implemented")
+}
+func (UnimplementedVtctldServer) CheckErrantGTIDs(context.Context, *vtctldata.CheckErrantGTIDsRequest) (*vtctldata.CheckErrantGTIDsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckErrantGTIDs not implemented")
+}
 func (UnimplementedVtctldServer) CreateKeyspace(context.Context, *vtctldata.CreateKeyspaceRequest) (*vtctldata.CreateKeyspaceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateKeyspace not implemented")
 }
@@ -880,9 +1509,15 @@ func (UnimplementedVtctldServer) DeleteSrvVSchema(context.Context, *vtctldata.De
 func (UnimplementedVtctldServer) DeleteTablets(context.Context, *vtctldata.DeleteTabletsRequest) (*vtctldata.DeleteTabletsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteTablets not implemented")
 }
+func (UnimplementedVtctldServer) DeregisterExternalMysql(context.Context, *vtctldata.DeregisterExternalMysqlRequest) (*vtctldata.DeregisterExternalMysqlResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeregisterExternalMysql not implemented")
+}
 func (UnimplementedVtctldServer) EmergencyReparentShard(context.Context, *vtctldata.EmergencyReparentShardRequest) (*vtctldata.EmergencyReparentShardResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EmergencyReparentShard not implemented")
 }
+func (UnimplementedVtctldServer) ExplainQuery(context.Context, *vtctldata.ExplainQueryRequest) (*vtctldata.ExplainQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExplainQuery not implemented")
+}
 func (UnimplementedVtctldServer) FindAllShardsInKeyspace(context.Context, *vtctldata.FindAllShardsInKeyspaceRequest) (*vtctldata.FindAllShardsInKeyspaceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FindAllShardsInKeyspace not implemented")
 }
@@ -898,12 +1533,30 @@ func (UnimplementedVtctldServer) GetCellInfoNames(context.Context, *vtctldata.Ge
 func (UnimplementedVtctldServer) GetCellsAliases(context.Context, *vtctldata.GetCellsAliasesRequest) (*vtctldata.GetCellsAliasesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCellsAliases not implemented")
 }
+func (UnimplementedVtctldServer) GetDDLStatus(context.Context, *vtctldata.GetDDLStatusRequest) (*vtctldata.GetDDLStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDDLStatus not implemented")
+}
+func (UnimplementedVtctldServer) GetExternalMysqls(context.Context, *vtctldata.GetExternalMysqlsRequest) (*vtctldata.GetExternalMysqlsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExternalMysqls not implemented")
+}
 func (UnimplementedVtctldServer) GetKeyspace(context.Context, *vtctldata.GetKeyspaceRequest) (*vtctldata.GetKeyspaceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetKeyspace not implemented")
 }
+func (UnimplementedVtctldServer) GetKeyspaceGraph(context.Context, *vtctldata.GetKeyspaceGraphRequest) (*vtctldata.GetKeyspaceGraphResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetKeyspaceGraph not implemented")
+}
 func (UnimplementedVtctldServer) GetKeyspaces(context.Context, *vtctldata.GetKeyspacesRequest) (*vtctldata.GetKeyspacesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetKeyspaces not implemented")
 }
+func (UnimplementedVtctldServer) GetQueryRules(context.Context, *vtctldata.GetQueryRulesRequest) (*vtctldata.GetQueryRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQueryRules not implemented")
+}
+func (UnimplementedVtctldServer) GetRecoverySettings(context.Context, *vtctldata.GetRecoverySettingsRequest) (*vtctldata.GetRecoverySettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecoverySettings not implemented")
+}
+func (UnimplementedVtctldServer) GetRestoreProgress(context.Context, *vtctldata.GetRestoreProgressRequest) (*vtctldata.GetRestoreProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRestoreProgress not implemented")
+}
 func (UnimplementedVtctldServer) GetRoutingRules(context.Context, *vtctldata.GetRoutingRulesRequest) (*vtctldata.GetRoutingRulesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRoutingRules not implemented")
 }
@@ -934,33 +1587,87 @@ func (UnimplementedVtctldServer) GetTablets(context.Context, *vtctldata.GetTable
 func (UnimplementedVtctldServer) GetVSchema(context.Context, *vtctldata.GetVSchemaRequest) (*vtctldata.GetVSchemaResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetVSchema not implemented")
 }
+func (UnimplementedVtctldServer) GetVSchemaRevisions(context.Context, *vtctldata.GetVSchemaRevisionsRequest) (*vtctldata.GetVSchemaRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVSchemaRevisions not implemented")
+}
 func (UnimplementedVtctldServer) GetWorkflows(context.Context, *vtctldata.GetWorkflowsRequest) (*vtctldata.GetWorkflowsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetWorkflows not implemented")
 }
 func (UnimplementedVtctldServer) InitShardPrimary(context.Context, *vtctldata.InitShardPrimaryRequest) (*vtctldata.InitShardPrimaryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InitShardPrimary not implemented")
 }
+func (UnimplementedVtctldServer) KillQueries(context.Context, *vtctldata.KillQueriesRequest) (*vtctldata.KillQueriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KillQueries not implemented")
+}
+func (UnimplementedVtctldServer) MaterializeCreate(context.Context, *vtctldata.MaterializeCreateRequest) (*vtctldata.MaterializeCreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MaterializeCreate not implemented")
+}
+func (UnimplementedVtctldServer) MoveTablesCreate(context.Context, *vtctldata.MoveTablesCreateRequest) (*vtctldata.MoveTablesCreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveTablesCreate not implemented")
+}
 func (UnimplementedVtctldServer) PlannedReparentShard(context.Context, *vtctldata.PlannedReparentShardRequest) (*vtctldata.PlannedReparentShardResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PlannedReparentShard not implemented")
 }
+func (UnimplementedVtctldServer) PruneBackups(context.Context, *vtctldata.PruneBackupsRequest) (*vtctldata.PruneBackupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PruneBackups not implemented")
+}
 func (UnimplementedVtctldServer) RebuildVSchemaGraph(context.Context, *vtctldata.RebuildVSchemaGraphRequest) (*vtctldata.RebuildVSchemaGraphResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RebuildVSchemaGraph not implemented")
 }
+func (UnimplementedVtctldServer) ReferenceTablesCreate(context.Context, *vtctldata.ReferenceTablesCreateRequest) (*vtctldata.ReferenceTablesCreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReferenceTablesCreate not implemented")
+}
+func (UnimplementedVtctldServer) ReferenceTablesDelete(context.Context, *vtctldata.ReferenceTablesDeleteRequest) (*vtctldata.ReferenceTablesDeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReferenceTablesDelete not implemented")
+}
+func (UnimplementedVtctldServer) ReferenceTablesRefresh(context.Context, *vtctldata.ReferenceTablesRefreshRequest) (*vtctldata.ReferenceTablesRefreshResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReferenceTablesRefresh not implemented")
+}
 func (UnimplementedVtctldServer) RefreshState(context.Context, *vtctldata.RefreshStateRequest) (*vtctldata.RefreshStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RefreshState not implemented")
 }
 func (UnimplementedVtctldServer) RefreshStateByShard(context.Context, *vtctldata.RefreshStateByShardRequest) (*vtctldata.RefreshStateByShardResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RefreshStateByShard not implemented")
 }
+func (UnimplementedVtctldServer) RegisterExternalMysql(context.Context, *vtctldata.RegisterExternalMysqlRequest) (*vtctldata.RegisterExternalMysqlResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterExternalMysql not implemented")
+}
 func (UnimplementedVtctldServer) RemoveKeyspaceCell(context.Context, *vtctldata.RemoveKeyspaceCellRequest) (*vtctldata.RemoveKeyspaceCellResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveKeyspaceCell not implemented")
 }
+func (UnimplementedVtctldServer) RemoveQueryRule(context.Context, *vtctldata.RemoveQueryRuleRequest) (*vtctldata.RemoveQueryRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveQueryRule not implemented")
+}
 func (UnimplementedVtctldServer) RemoveShardCell(context.Context, *vtctldata.RemoveShardCellRequest) (*vtctldata.RemoveShardCellResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveShardCell not implemented")
 }
+func (UnimplementedVtctldServer) RepairReplication(context.Context, *vtctldata.RepairReplicationRequest) (*vtctldata.RepairReplicationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RepairReplication not implemented")
+}
 func (UnimplementedVtctldServer) ReparentTablet(context.Context, *vtctldata.ReparentTabletRequest) (*vtctldata.ReparentTabletResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReparentTablet not implemented")
 }
+func (UnimplementedVtctldServer) ReshardCreate(context.Context, *vtctldata.ReshardCreateRequest) (*vtctldata.ReshardCreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReshardCreate not implemented")
+}
+func (UnimplementedVtctldServer) RollbackVSchema(context.Context, *vtctldata.RollbackVSchemaRequest) (*vtctldata.RollbackVSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackVSchema not implemented")
+}
+func (UnimplementedVtctldServer) ScaffoldVSchema(context.Context, *vtctldata.ScaffoldVSchemaRequest) (*vtctldata.ScaffoldVSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScaffoldVSchema not implemented")
+}
+func (UnimplementedVtctldServer) SetKeyspaceDurabilityPolicy(context.Context, *vtctldata.SetKeyspaceDurabilityPolicyRequest) (*vtctldata.SetKeyspaceDurabilityPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetKeyspaceDurabilityPolicy not implemented")
+}
+func (UnimplementedVtctldServer) SetKeyspacePolicy(context.Context, *vtctldata.SetKeyspacePolicyRequest) (*vtctldata.SetKeyspacePolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetKeyspacePolicy not implemented")
+}
+func (UnimplementedVtctldServer) SetReplicationDelay(context.Context, *vtctldata.SetReplicationDelayRequest) (*vtctldata.SetReplicationDelayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetReplicationDelay not implemented")
+}
+func (UnimplementedVtctldServer) SetShardWriteFence(context.Context, *vtctldata.SetShardWriteFenceRequest) (*vtctldata.SetShardWriteFenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetShardWriteFence not implemented")
+}
 func (UnimplementedVtctldServer) ShardReplicationPositions(context.Context, *vtctldata.ShardReplicationPositionsRequest) (*vtctldata.ShardReplicationPositionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ShardReplicationPositions not implemented")
 }
@@ -973,6 +1680,39 @@ func (UnimplementedVtctldServer) UpdateCellInfo(context.Context, *vtctldata.Upda
 func (UnimplementedVtctldServer) UpdateCellsAlias(context.Context, *vtctldata.UpdateCellsAliasRequest) (*vtctldata.UpdateCellsAliasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateCellsAlias not implemented")
 }
+func (UnimplementedVtctldServer) UpdateRecoverySettings(context.Context, *vtctldata.UpdateRecoverySettingsRequest) (*vtctldata.UpdateRecoverySettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRecoverySettings not implemented")
+}
+func (UnimplementedVtctldServer) UpdateThrottlerConfig(context.Context, *vtctldata.UpdateThrottlerConfigRequest) (*vtctldata.UpdateThrottlerConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateThrottlerConfig not implemented")
+}
+func (UnimplementedVtctldServer) ValidateServingGraph(context.Context, *vtctldata.ValidateServingGraphRequest) (*vtctldata.ValidateServingGraphResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateServingGraph not implemented")
+}
+func (UnimplementedVtctldServer) ValidateVSchema(context.Context, *vtctldata.ValidateVSchemaRequest) (*vtctldata.ValidateVSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateVSchema not implemented")
+}
+func (UnimplementedVtctldServer) VDiff(context.Context, *vtctldata.VDiffRequest) (*vtctldata.VDiffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VDiff not implemented")
+}
+func (UnimplementedVtctldServer) VerifyBackup(context.Context, *vtctldata.VerifyBackupRequest) (*vtctldata.VerifyBackupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyBackup not implemented")
+}
+func (UnimplementedVtctldServer) WorkflowCancel(context.Context, *vtctldata.WorkflowCancelRequest) (*vtctldata.WorkflowCancelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WorkflowCancel not implemented")
+}
+func (UnimplementedVtctldServer) WorkflowComplete(context.Context, *vtctldata.WorkflowCompleteRequest) (*vtctldata.WorkflowCompleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WorkflowComplete not implemented")
+}
+func (UnimplementedVtctldServer) WorkflowPause(context.Context, *vtctldata.WorkflowPauseRequest) (*vtctldata.WorkflowPauseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WorkflowPause not implemented")
+}
+func (UnimplementedVtctldServer) WorkflowResume(context.Context, *vtctldata.WorkflowResumeRequest) (*vtctldata.WorkflowResumeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WorkflowResume not implemented")
+}
+func (UnimplementedVtctldServer) WorkflowSwitchTraffic(context.Context, *vtctldata.WorkflowSwitchTrafficRequest) (*vtctldata.WorkflowSwitchTrafficResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WorkflowSwitchTraffic not implemented")
+}
 func (UnimplementedVtctldServer) mustEmbedUnimplementedVtctldServer() {}
 
 // UnsafeVtctldServer may be embedded to opt out of forward compatibility for this service.
@@ -1022,6 +1762,24 @@ func _Vtctld_AddCellsAlias_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Vtctld_AddQueryRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.AddQueryRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).AddQueryRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/AddQueryRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).AddQueryRule(ctx, req.(*vtctldata.AddQueryRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Vtctld_ApplyRoutingRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(vtctldata.ApplyRoutingRulesRequest)
 	if err := dec(in); err != nil {
@@ -1076,6 +1834,42 @@ func _Vtctld_ChangeTabletType_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Vtctld_ChangeTabletTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.ChangeTabletTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).ChangeTabletTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/ChangeTabletTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).ChangeTabletTags(ctx, req.(*vtctldata.ChangeTabletTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_CheckErrantGTIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.CheckErrantGTIDsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).CheckErrantGTIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/CheckErrantGTIDs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).CheckErrantGTIDs(ctx, req.(*vtctldata.CheckErrantGTIDsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Vtctld_CreateKeyspace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(vtctldata.CreateKeyspaceRequest)
 	if err := dec(in); err != nil {
@@ -1220,6 +2014,24 @@ func _Vtctld_DeleteTablets_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Vtctld_DeregisterExternalMysql_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.DeregisterExternalMysqlRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).DeregisterExternalMysql(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/DeregisterExternalMysql",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).DeregisterExternalMysql(ctx, req.(*vtctldata.DeregisterExternalMysqlRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Vtctld_EmergencyReparentShard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(vtctldata.EmergencyReparentShardRequest)
 	if err := dec(in); err != nil {
@@ -1238,6 +2050,24 @@ func _Vtctld_EmergencyReparentShard_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Vtctld_ExplainQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.ExplainQueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).ExplainQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/ExplainQuery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).ExplainQuery(ctx, req.(*vtctldata.ExplainQueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Vtctld_FindAllShardsInKeyspace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(vtctldata.FindAllShardsInKeyspaceRequest)
 	if err := dec(in); err != nil {
@@ -1328,6 +2158,42 @@ func _Vtctld_GetCellsAliases_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Vtctld_GetDDLStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.GetDDLStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).GetDDLStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/GetDDLStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).GetDDLStatus(ctx, req.(*vtctldata.GetDDLStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_GetExternalMysqls_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.GetExternalMysqlsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).GetExternalMysqls(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/GetExternalMysqls",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).GetExternalMysqls(ctx, req.(*vtctldata.GetExternalMysqlsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Vtctld_GetKeyspace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(vtctldata.GetKeyspaceRequest)
 	if err := dec(in); err != nil {
@@ -1346,6 +2212,24 @@ func _Vtctld_GetKeyspace_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Vtctld_GetKeyspaceGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.GetKeyspaceGraphRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).GetKeyspaceGraph(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/GetKeyspaceGraph",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).GetKeyspaceGraph(ctx, req.(*vtctldata.GetKeyspaceGraphRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Vtctld_GetKeyspaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(vtctldata.GetKeyspacesRequest)
 	if err := dec(in); err != nil {
@@ -1364,6 +2248,60 @@ func _Vtctld_GetKeyspaces_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Vtctld_GetQueryRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.GetQueryRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).GetQueryRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/GetQueryRules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).GetQueryRules(ctx, req.(*vtctldata.GetQueryRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_GetRecoverySettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.GetRecoverySettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).GetRecoverySettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/GetRecoverySettings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).GetRecoverySettings(ctx, req.(*vtctldata.GetRecoverySettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_GetRestoreProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.GetRestoreProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).GetRestoreProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/GetRestoreProgress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).GetRestoreProgress(ctx, req.(*vtctldata.GetRestoreProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Vtctld_GetRoutingRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(vtctldata.GetRoutingRulesRequest)
 	if err := dec(in); err != nil {
@@ -1544,6 +2482,24 @@ func _Vtctld_GetVSchema_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Vtctld_GetVSchemaRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.GetVSchemaRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).GetVSchemaRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/GetVSchemaRevisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).GetVSchemaRevisions(ctx, req.(*vtctldata.GetVSchemaRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Vtctld_GetWorkflows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(vtctldata.GetWorkflowsRequest)
 	if err := dec(in); err != nil {
@@ -1580,205 +2536,709 @@ func _Vtctld_InitShardPrimary_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Vtctld_PlannedReparentShard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(vtctldata.PlannedReparentShardRequest)
+func _Vtctld_KillQueries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.KillQueriesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VtctldServer).PlannedReparentShard(ctx, in)
+		return srv.(VtctldServer).KillQueries(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/vtctlservice.Vtctld/PlannedReparentShard",
+		FullMethod: "/vtctlservice.Vtctld/KillQueries",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VtctldServer).PlannedReparentShard(ctx, req.(*vtctldata.PlannedReparentShardRequest))
+		return srv.(VtctldServer).KillQueries(ctx, req.(*vtctldata.KillQueriesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Vtctld_RebuildVSchemaGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(vtctldata.RebuildVSchemaGraphRequest)
+func _Vtctld_MaterializeCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.MaterializeCreateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VtctldServer).RebuildVSchemaGraph(ctx, in)
+		return srv.(VtctldServer).MaterializeCreate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/vtctlservice.Vtctld/RebuildVSchemaGraph",
+		FullMethod: "/vtctlservice.Vtctld/MaterializeCreate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VtctldServer).RebuildVSchemaGraph(ctx, req.(*vtctldata.RebuildVSchemaGraphRequest))
+		return srv.(VtctldServer).MaterializeCreate(ctx, req.(*vtctldata.MaterializeCreateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Vtctld_RefreshState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(vtctldata.RefreshStateRequest)
+func _Vtctld_MoveTablesCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.MoveTablesCreateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VtctldServer).RefreshState(ctx, in)
+		return srv.(VtctldServer).MoveTablesCreate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/vtctlservice.Vtctld/RefreshState",
+		FullMethod: "/vtctlservice.Vtctld/MoveTablesCreate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VtctldServer).RefreshState(ctx, req.(*vtctldata.RefreshStateRequest))
+		return srv.(VtctldServer).MoveTablesCreate(ctx, req.(*vtctldata.MoveTablesCreateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Vtctld_RefreshStateByShard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(vtctldata.RefreshStateByShardRequest)
+func _Vtctld_PlannedReparentShard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.PlannedReparentShardRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VtctldServer).RefreshStateByShard(ctx, in)
+		return srv.(VtctldServer).PlannedReparentShard(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/vtctlservice.Vtctld/RefreshStateByShard",
+		FullMethod: "/vtctlservice.Vtctld/PlannedReparentShard",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VtctldServer).RefreshStateByShard(ctx, req.(*vtctldata.RefreshStateByShardRequest))
+		return srv.(VtctldServer).PlannedReparentShard(ctx, req.(*vtctldata.PlannedReparentShardRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Vtctld_RemoveKeyspaceCell_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(vtctldata.RemoveKeyspaceCellRequest)
+func _Vtctld_PruneBackups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.PruneBackupsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VtctldServer).RemoveKeyspaceCell(ctx, in)
+		return srv.(VtctldServer).PruneBackups(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/vtctlservice.Vtctld/RemoveKeyspaceCell",
+		FullMethod: "/vtctlservice.Vtctld/PruneBackups",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VtctldServer).RemoveKeyspaceCell(ctx, req.(*vtctldata.RemoveKeyspaceCellRequest))
+		return srv.(VtctldServer).PruneBackups(ctx, req.(*vtctldata.PruneBackupsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Vtctld_RemoveShardCell_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(vtctldata.RemoveShardCellRequest)
+func _Vtctld_RebuildVSchemaGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.RebuildVSchemaGraphRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VtctldServer).RemoveShardCell(ctx, in)
+		return srv.(VtctldServer).RebuildVSchemaGraph(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/vtctlservice.Vtctld/RemoveShardCell",
+		FullMethod: "/vtctlservice.Vtctld/RebuildVSchemaGraph",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VtctldServer).RemoveShardCell(ctx, req.(*vtctldata.RemoveShardCellRequest))
+		return srv.(VtctldServer).RebuildVSchemaGraph(ctx, req.(*vtctldata.RebuildVSchemaGraphRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Vtctld_ReparentTablet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(vtctldata.ReparentTabletRequest)
+func _Vtctld_ReferenceTablesCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.ReferenceTablesCreateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VtctldServer).ReparentTablet(ctx, in)
+		return srv.(VtctldServer).ReferenceTablesCreate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/vtctlservice.Vtctld/ReparentTablet",
+		FullMethod: "/vtctlservice.Vtctld/ReferenceTablesCreate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VtctldServer).ReparentTablet(ctx, req.(*vtctldata.ReparentTabletRequest))
+		return srv.(VtctldServer).ReferenceTablesCreate(ctx, req.(*vtctldata.ReferenceTablesCreateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Vtctld_ShardReplicationPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(vtctldata.ShardReplicationPositionsRequest)
+func _Vtctld_ReferenceTablesDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.ReferenceTablesDeleteRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VtctldServer).ShardReplicationPositions(ctx, in)
+		return srv.(VtctldServer).ReferenceTablesDelete(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/vtctlservice.Vtctld/ShardReplicationPositions",
+		FullMethod: "/vtctlservice.Vtctld/ReferenceTablesDelete",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VtctldServer).ShardReplicationPositions(ctx, req.(*vtctldata.ShardReplicationPositionsRequest))
+		return srv.(VtctldServer).ReferenceTablesDelete(ctx, req.(*vtctldata.ReferenceTablesDeleteRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Vtctld_TabletExternallyReparented_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(vtctldata.TabletExternallyReparentedRequest)
+func _Vtctld_ReferenceTablesRefresh_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.ReferenceTablesRefreshRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VtctldServer).TabletExternallyReparented(ctx, in)
+		return srv.(VtctldServer).ReferenceTablesRefresh(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/vtctlservice.Vtctld/TabletExternallyReparented",
+		FullMethod: "/vtctlservice.Vtctld/ReferenceTablesRefresh",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VtctldServer).TabletExternallyReparented(ctx, req.(*vtctldata.TabletExternallyReparentedRequest))
+		return srv.(VtctldServer).ReferenceTablesRefresh(ctx, req.(*vtctldata.ReferenceTablesRefreshRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Vtctld_UpdateCellInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(vtctldata.UpdateCellInfoRequest)
+func _Vtctld_RefreshState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.RefreshStateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VtctldServer).UpdateCellInfo(ctx, in)
+		return srv.(VtctldServer).RefreshState(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/vtctlservice.Vtctld/UpdateCellInfo",
+		FullMethod: "/vtctlservice.Vtctld/RefreshState",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VtctldServer).UpdateCellInfo(ctx, req.(*vtctldata.UpdateCellInfoRequest))
+		return srv.(VtctldServer).RefreshState(ctx, req.(*vtctldata.RefreshStateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Vtctld_UpdateCellsAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(vtctldata.UpdateCellsAliasRequest)
+func _Vtctld_RefreshStateByShard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.RefreshStateByShardRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VtctldServer).UpdateCellsAlias(ctx, in)
+		return srv.(VtctldServer).RefreshStateByShard(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/vtctlservice.Vtctld/UpdateCellsAlias",
+		FullMethod: "/vtctlservice.Vtctld/RefreshStateByShard",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VtctldServer).UpdateCellsAlias(ctx, req.(*vtctldata.UpdateCellsAliasRequest))
+		return srv.(VtctldServer).RefreshStateByShard(ctx, req.(*vtctldata.RefreshStateByShardRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// Vtctld_ServiceDesc is the grpc.ServiceDesc for Vtctld service.
+func _Vtctld_RegisterExternalMysql_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.RegisterExternalMysqlRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).RegisterExternalMysql(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/RegisterExternalMysql",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).RegisterExternalMysql(ctx, req.(*vtctldata.RegisterExternalMysqlRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_RemoveKeyspaceCell_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.RemoveKeyspaceCellRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).RemoveKeyspaceCell(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/RemoveKeyspaceCell",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).RemoveKeyspaceCell(ctx, req.(*vtctldata.RemoveKeyspaceCellRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_RemoveQueryRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.RemoveQueryRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).RemoveQueryRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/RemoveQueryRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).RemoveQueryRule(ctx, req.(*vtctldata.RemoveQueryRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_RemoveShardCell_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.RemoveShardCellRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).RemoveShardCell(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/RemoveShardCell",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).RemoveShardCell(ctx, req.(*vtctldata.RemoveShardCellRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_RepairReplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.RepairReplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).RepairReplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/RepairReplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).RepairReplication(ctx, req.(*vtctldata.RepairReplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_ReparentTablet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.ReparentTabletRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).ReparentTablet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/ReparentTablet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).ReparentTablet(ctx, req.(*vtctldata.ReparentTabletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_ReshardCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.ReshardCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).ReshardCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/ReshardCreate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).ReshardCreate(ctx, req.(*vtctldata.ReshardCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_RollbackVSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.RollbackVSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).RollbackVSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/RollbackVSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).RollbackVSchema(ctx, req.(*vtctldata.RollbackVSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_ScaffoldVSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.ScaffoldVSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).ScaffoldVSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/ScaffoldVSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).ScaffoldVSchema(ctx, req.(*vtctldata.ScaffoldVSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_SetKeyspaceDurabilityPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.SetKeyspaceDurabilityPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).SetKeyspaceDurabilityPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/SetKeyspaceDurabilityPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).SetKeyspaceDurabilityPolicy(ctx, req.(*vtctldata.SetKeyspaceDurabilityPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_SetKeyspacePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.SetKeyspacePolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).SetKeyspacePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/SetKeyspacePolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).SetKeyspacePolicy(ctx, req.(*vtctldata.SetKeyspacePolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_SetReplicationDelay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.SetReplicationDelayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).SetReplicationDelay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/SetReplicationDelay",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).SetReplicationDelay(ctx, req.(*vtctldata.SetReplicationDelayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_SetShardWriteFence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.SetShardWriteFenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).SetShardWriteFence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/SetShardWriteFence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).SetShardWriteFence(ctx, req.(*vtctldata.SetShardWriteFenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_ShardReplicationPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.ShardReplicationPositionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).ShardReplicationPositions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/ShardReplicationPositions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).ShardReplicationPositions(ctx, req.(*vtctldata.ShardReplicationPositionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_TabletExternallyReparented_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.TabletExternallyReparentedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).TabletExternallyReparented(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/TabletExternallyReparented",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).TabletExternallyReparented(ctx, req.(*vtctldata.TabletExternallyReparentedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_UpdateCellInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.UpdateCellInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).UpdateCellInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/UpdateCellInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).UpdateCellInfo(ctx, req.(*vtctldata.UpdateCellInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_UpdateCellsAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.UpdateCellsAliasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).UpdateCellsAlias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/UpdateCellsAlias",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).UpdateCellsAlias(ctx, req.(*vtctldata.UpdateCellsAliasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_UpdateRecoverySettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.UpdateRecoverySettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).UpdateRecoverySettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/UpdateRecoverySettings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).UpdateRecoverySettings(ctx, req.(*vtctldata.UpdateRecoverySettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_UpdateThrottlerConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.UpdateThrottlerConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).UpdateThrottlerConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/UpdateThrottlerConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).UpdateThrottlerConfig(ctx, req.(*vtctldata.UpdateThrottlerConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_ValidateServingGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.ValidateServingGraphRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).ValidateServingGraph(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/ValidateServingGraph",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).ValidateServingGraph(ctx, req.(*vtctldata.ValidateServingGraphRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_ValidateVSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.ValidateVSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).ValidateVSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/ValidateVSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).ValidateVSchema(ctx, req.(*vtctldata.ValidateVSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_VDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.VDiffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).VDiff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/VDiff",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).VDiff(ctx, req.(*vtctldata.VDiffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_VerifyBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.VerifyBackupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).VerifyBackup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/VerifyBackup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).VerifyBackup(ctx, req.(*vtctldata.VerifyBackupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_WorkflowCancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.WorkflowCancelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).WorkflowCancel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/WorkflowCancel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).WorkflowCancel(ctx, req.(*vtctldata.WorkflowCancelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_WorkflowComplete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.WorkflowCompleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).WorkflowComplete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/WorkflowComplete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).WorkflowComplete(ctx, req.(*vtctldata.WorkflowCompleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_WorkflowPause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.WorkflowPauseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).WorkflowPause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/WorkflowPause",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).WorkflowPause(ctx, req.(*vtctldata.WorkflowPauseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_WorkflowResume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.WorkflowResumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).WorkflowResume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/WorkflowResume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).WorkflowResume(ctx, req.(*vtctldata.WorkflowResumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vtctld_WorkflowSwitchTraffic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.WorkflowSwitchTrafficRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).WorkflowSwitchTraffic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/WorkflowSwitchTraffic",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).WorkflowSwitchTraffic(ctx, req.(*vtctldata.WorkflowSwitchTrafficRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Vtctld_ServiceDesc is the grpc.ServiceDesc for Vtctld service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
 var Vtctld_ServiceDesc = grpc.ServiceDesc{
@@ -1793,6 +3253,10 @@ var Vtctld_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AddCellsAlias",
 			Handler:    _Vtctld_AddCellsAlias_Handler,
 		},
+		{
+			MethodName: "AddQueryRule",
+			Handler:    _Vtctld_AddQueryRule_Handler,
+		},
 		{
 			MethodName: "ApplyRoutingRules",
 			Handler:    _Vtctld_ApplyRoutingRules_Handler,
@@ -1805,6 +3269,14 @@ var Vtctld_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ChangeTabletType",
 			Handler:    _Vtctld_ChangeTabletType_Handler,
 		},
+		{
+			MethodName: "ChangeTabletTags",
+			Handler:    _Vtctld_ChangeTabletTags_Handler,
+		},
+		{
+			MethodName: "CheckErrantGTIDs",
+			Handler:    _Vtctld_CheckErrantGTIDs_Handler,
+		},
 		{
 			MethodName: "CreateKeyspace",
 			Handler:    _Vtctld_CreateKeyspace_Handler,
@@ -1837,10 +3309,18 @@ var Vtctld_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteTablets",
 			Handler:    _Vtctld_DeleteTablets_Handler,
 		},
+		{
+			MethodName: "DeregisterExternalMysql",
+			Handler:    _Vtctld_DeregisterExternalMysql_Handler,
+		},
 		{
 			MethodName: "EmergencyReparentShard",
 			Handler:    _Vtctld_EmergencyReparentShard_Handler,
 		},
+		{
+			MethodName: "ExplainQuery",
+			Handler:    _Vtctld_ExplainQuery_Handler,
+		},
 		{
 			MethodName: "FindAllShardsInKeyspace",
 			Handler:    _Vtctld_FindAllShardsInKeyspace_Handler,
@@ -1861,14 +3341,38 @@ var Vtctld_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCellsAliases",
 			Handler:    _Vtctld_GetCellsAliases_Handler,
 		},
+		{
+			MethodName: "GetDDLStatus",
+			Handler:    _Vtctld_GetDDLStatus_Handler,
+		},
+		{
+			MethodName: "GetExternalMysqls",
+			Handler:    _Vtctld_GetExternalMysqls_Handler,
+		},
 		{
 			MethodName: "GetKeyspace",
 			Handler:    _Vtctld_GetKeyspace_Handler,
 		},
+		{
+			MethodName: "GetKeyspaceGraph",
+			Handler:    _Vtctld_GetKeyspaceGraph_Handler,
+		},
 		{
 			MethodName: "GetKeyspaces",
 			Handler:    _Vtctld_GetKeyspaces_Handler,
 		},
+		{
+			MethodName: "GetQueryRules",
+			Handler:    _Vtctld_GetQueryRules_Handler,
+		},
+		{
+			MethodName: "GetRecoverySettings",
+			Handler:    _Vtctld_GetRecoverySettings_Handler,
+		},
+		{
+			MethodName: "GetRestoreProgress",
+			Handler:    _Vtctld_GetRestoreProgress_Handler,
+		},
 		{
 			MethodName: "GetRoutingRules",
 			Handler:    _Vtctld_GetRoutingRules_Handler,
@@ -1909,6 +3413,10 @@ var Vtctld_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetVSchema",
 			Handler:    _Vtctld_GetVSchema_Handler,
 		},
+		{
+			MethodName: "GetVSchemaRevisions",
+			Handler:    _Vtctld_GetVSchemaRevisions_Handler,
+		},
 		{
 			MethodName: "GetWorkflows",
 			Handler:    _Vtctld_GetWorkflows_Handler,
@@ -1917,14 +3425,42 @@ var Vtctld_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "InitShardPrimary",
 			Handler:    _Vtctld_InitShardPrimary_Handler,
 		},
+		{
+			MethodName: "KillQueries",
+			Handler:    _Vtctld_KillQueries_Handler,
+		},
+		{
+			MethodName: "MaterializeCreate",
+			Handler:    _Vtctld_MaterializeCreate_Handler,
+		},
+		{
+			MethodName: "MoveTablesCreate",
+			Handler:    _Vtctld_MoveTablesCreate_Handler,
+		},
 		{
 			MethodName: "PlannedReparentShard",
 			Handler:    _Vtctld_PlannedReparentShard_Handler,
 		},
+		{
+			MethodName: "PruneBackups",
+			Handler:    _Vtctld_PruneBackups_Handler,
+		},
 		{
 			MethodName: "RebuildVSchemaGraph",
 			Handler:    _Vtctld_RebuildVSchemaGraph_Handler,
 		},
+		{
+			MethodName: "ReferenceTablesCreate",
+			Handler:    _Vtctld_ReferenceTablesCreate_Handler,
+		},
+		{
+			MethodName: "ReferenceTablesDelete",
+			Handler:    _Vtctld_ReferenceTablesDelete_Handler,
+		},
+		{
+			MethodName: "ReferenceTablesRefresh",
+			Handler:    _Vtctld_ReferenceTablesRefresh_Handler,
+		},
 		{
 			MethodName: "RefreshState",
 			Handler:    _Vtctld_RefreshState_Handler,
@@ -1933,18 +3469,58 @@ var Vtctld_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RefreshStateByShard",
 			Handler:    _Vtctld_RefreshStateByShard_Handler,
 		},
+		{
+			MethodName: "RegisterExternalMysql",
+			Handler:    _Vtctld_RegisterExternalMysql_Handler,
+		},
 		{
 			MethodName: "RemoveKeyspaceCell",
 			Handler:    _Vtctld_RemoveKeyspaceCell_Handler,
 		},
+		{
+			MethodName: "RemoveQueryRule",
+			Handler:    _Vtctld_RemoveQueryRule_Handler,
+		},
 		{
 			MethodName: "RemoveShardCell",
 			Handler:    _Vtctld_RemoveShardCell_Handler,
 		},
+		{
+			MethodName: "RepairReplication",
+			Handler:    _Vtctld_RepairReplication_Handler,
+		},
 		{
 			MethodName: "ReparentTablet",
 			Handler:    _Vtctld_ReparentTablet_Handler,
 		},
+		{
+			MethodName: "ReshardCreate",
+			Handler:    _Vtctld_ReshardCreate_Handler,
+		},
+		{
+			MethodName: "RollbackVSchema",
+			Handler:    _Vtctld_RollbackVSchema_Handler,
+		},
+		{
+			MethodName: "ScaffoldVSchema",
+			Handler:    _Vtctld_ScaffoldVSchema_Handler,
+		},
+		{
+			MethodName: "SetKeyspaceDurabilityPolicy",
+			Handler:    _Vtctld_SetKeyspaceDurabilityPolicy_Handler,
+		},
+		{
+			MethodName: "SetKeyspacePolicy",
+			Handler:    _Vtctld_SetKeyspacePolicy_Handler,
+		},
+		{
+			MethodName: "SetReplicationDelay",
+			Handler:    _Vtctld_SetReplicationDelay_Handler,
+		},
+		{
+			MethodName: "SetShardWriteFence",
+			Handler:    _Vtctld_SetShardWriteFence_Handler,
+		},
 		{
 			MethodName: "ShardReplicationPositions",
 			Handler:    _Vtctld_ShardReplicationPositions_Handler,
@@ -1961,6 +3537,50 @@ var Vtctld_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateCellsAlias",
 			Handler:    _Vtctld_UpdateCellsAlias_Handler,
 		},
+		{
+			MethodName: "UpdateRecoverySettings",
+			Handler:    _Vtctld_UpdateRecoverySettings_Handler,
+		},
+		{
+			MethodName: "UpdateThrottlerConfig",
+			Handler:    _Vtctld_UpdateThrottlerConfig_Handler,
+		},
+		{
+			MethodName: "ValidateServingGraph",
+			Handler:    _Vtctld_ValidateServingGraph_Handler,
+		},
+		{
+			MethodName: "ValidateVSchema",
+			Handler:    _Vtctld_ValidateVSchema_Handler,
+		},
+		{
+			MethodName: "VDiff",
+			Handler:    _Vtctld_VDiff_Handler,
+		},
+		{
+			MethodName: "VerifyBackup",
+			Handler:    _Vtctld_VerifyBackup_Handler,
+		},
+		{
+			MethodName: "WorkflowCancel",
+			Handler:    _Vtctld_WorkflowCancel_Handler,
+		},
+		{
+			MethodName: "WorkflowComplete",
+			Handler:    _Vtctld_WorkflowComplete_Handler,
+		},
+		{
+			MethodName: "WorkflowPause",
+			Handler:    _Vtctld_WorkflowPause_Handler,
+		},
+		{
+			MethodName: "WorkflowResume",
+			Handler:    _Vtctld_WorkflowResume_Handler,
+		},
+		{
+			MethodName: "WorkflowSwitchTraffic",
+			Handler:    _Vtctld_WorkflowSwitchTraffic_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "vtctlservice.proto",
@@ -35,6 +35,12 @@ type VitessClient interface {
 	// Use this method if the query returns a large number of rows.
 	// API group: v3
 	StreamExecute(ctx context.Context, in *vtgate.StreamExecuteRequest, opts ...grpc.CallOption) (Vitess_StreamExecuteClient, error)
+	// StreamExecuteBatch executes a list of queries in order, each with its own
+	// options, and streams back the result of each query as it completes.
+	// The failure of a query does not stop the batch, unless abort_on_error
+	// is set.
+	// API group: v3
+	StreamExecuteBatch(ctx context.Context, in *vtgate.StreamExecuteBatchRequest, opts ...grpc.CallOption) (Vitess_StreamExecuteBatchClient, error)
 	// ResolveTransaction resolves a transaction.
 	// API group: Transactions
 	ResolveTransaction(ctx context.Context, in *vtgate.ResolveTransactionRequest, opts ...grpc.CallOption) (*vtgate.ResolveTransactionResponse, error)
@@ -46,6 +52,14 @@ type VitessClient interface {
 	// This has the same effect as if a "rollback" statement was executed,
 	// but does not affect the query statistics.
 	CloseSession(ctx context.Context, in *vtgate.CloseSessionRequest, opts ...grpc.CallOption) (*vtgate.CloseSessionResponse, error)
+	// ExportSession serializes the state of a session that is not in a
+	// transaction into a signed token, that can be presented to
+	// ImportSession on another vtgate to continue the session there.
+	// This eases rolling restarts of vtgates.
+	ExportSession(ctx context.Context, in *vtgate.ExportSessionRequest, opts ...grpc.CallOption) (*vtgate.ExportSessionResponse, error)
+	// ImportSession returns the session carried by a token returned by
+	// ExportSession.
+	ImportSession(ctx context.Context, in *vtgate.ImportSessionRequest, opts ...grpc.CallOption) (*vtgate.ImportSessionResponse, error)
 }
 
 type vitessClient struct {
@@ -106,6 +120,38 @@ func (x *vitessStreamExecuteClient) Recv() (*vtgate.StreamExecuteResponse, error
 	return m, nil
 }
 
+func (c *vitessClient) StreamExecuteBatch(ctx context.Context, in *vtgate.StreamExecuteBatchRequest, opts ...grpc.CallOption) (Vitess_StreamExecuteBatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Vitess_ServiceDesc.Streams[1], "/vtgateservice.Vitess/StreamExecuteBatch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &vitessStreamExecuteBatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Vitess_StreamExecuteBatchClient interface {
+	Recv() (*vtgate.StreamExecuteBatchResponse, error)
+	grpc.ClientStream
+}
+
+type vitessStreamExecuteBatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *vitessStreamExecuteBatchClient) Recv() (*vtgate.StreamExecuteBatchResponse, error) {
+	m := new(vtgate.StreamExecuteBatchResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *vitessClient) ResolveTransaction(ctx context.Context, in *vtgate.ResolveTransactionRequest, opts ...grpc.CallOption) (*vtgate.ResolveTransactionResponse, error) {
 	out := new(vtgate.ResolveTransactionResponse)
 	err := c.cc.Invoke(ctx, "/vtgateservice.Vitess/ResolveTransaction", in, out, opts...)
@@ -116,7 +162,7 @@ func (c *vitessClient) ResolveTransaction(ctx context.Context, in *vtgate.Resolv
 }
 
 func (c *vitessClient) VStream(ctx context.Context, in *vtgate.VStreamRequest, opts ...grpc.CallOption) (Vitess_VStreamClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Vitess_ServiceDesc.Streams[1], "/vtgateservice.Vitess/VStream", opts...)
+	stream, err := c.cc.NewStream(ctx, &Vitess_ServiceDesc.Streams[2], "/vtgateservice.Vitess/VStream", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -165,6 +211,24 @@ func (c *vitessClient) CloseSession(ctx context.Context, in *vtgate.CloseSession
 	return out, nil
 }
 
+func (c *vitessClient) ExportSession(ctx context.Context, in *vtgate.ExportSessionRequest, opts ...grpc.CallOption) (*vtgate.ExportSessionResponse, error) {
+	out := new(vtgate.ExportSessionResponse)
+	err := c.cc.Invoke(ctx, "/vtgateservice.Vitess/ExportSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vitessClient) ImportSession(ctx context.Context, in *vtgate.ImportSessionRequest, opts ...grpc.CallOption) (*vtgate.ImportSessionResponse, error) {
+	out := new(vtgate.ImportSessionResponse)
+	err := c.cc.Invoke(ctx, "/vtgateservice.Vitess/ImportSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // VitessServer is the server API for Vitess service.
 // All implementations must embed UnimplementedVitessServer
 // for forward compatibility
@@ -185,6 +249,12 @@ type VitessServer interface {
 	// Use this method if the query returns a large number of rows.
 	// API group: v3
 	StreamExecute(*vtgate.StreamExecuteRequest, Vitess_StreamExecuteServer) error
+	// StreamExecuteBatch executes a list of queries in order, each with its own
+	// options, and streams back the result of each query as it completes.
+	// The failure of a query does not stop the batch, unless abort_on_error
+	// is set.
+	// API group: v3
+	StreamExecuteBatch(*vtgate.StreamExecuteBatchRequest, Vitess_StreamExecuteBatchServer) error
 	// ResolveTransaction resolves a transaction.
 	// API group: Transactions
 	ResolveTransaction(context.Context, *vtgate.ResolveTransactionRequest) (*vtgate.ResolveTransactionResponse, error)
@@ -196,6 +266,14 @@ type VitessServer interface {
 	// This has the same effect as if a "rollback" statement was executed,
 	// but does not affect the query statistics.
 	CloseSession(context.Context, *vtgate.CloseSessionRequest) (*vtgate.CloseSessionResponse, error)
+	// ExportSession serializes the state of a session that is not in a
+	// transaction into a signed token, that can be presented to
+	// ImportSession on another vtgate to continue the session there.
+	// This eases rolling restarts of vtgates.
+	ExportSession(context.Context, *vtgate.ExportSessionRequest) (*vtgate.ExportSessionResponse, error)
+	// ImportSession returns the session carried by a token returned by
+	// ExportSession.
+	ImportSession(context.Context, *vtgate.ImportSessionRequest) (*vtgate.ImportSessionResponse, error)
 	mustEmbedUnimplementedVitessServer()
 }
 
@@ -212,6 +290,9 @@ func (UnimplementedVitessServer) ExecuteBatch(context.Context, *vtgate.ExecuteBa
 func (UnimplementedVitessServer) StreamExecute(*vtgate.StreamExecuteRequest, Vitess_StreamExecuteServer) error {
 	return status.Errorf(codes.Unimplemented, "method StreamExecute not implemented")
 }
+func (UnimplementedVitessServer) StreamExecuteBatch(*vtgate.StreamExecuteBatchRequest, Vitess_StreamExecuteBatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamExecuteBatch not implemented")
+}
 func (UnimplementedVitessServer) ResolveTransaction(context.Context, *vtgate.ResolveTransactionRequest) (*vtgate.ResolveTransactionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResolveTransaction not implemented")
 }
@@ -224,6 +305,12 @@ func (UnimplementedVitessServer) Prepare(context.Context, *vtgate.PrepareRequest
 func (UnimplementedVitessServer) CloseSession(context.Context, *vtgate.CloseSessionRequest) (*vtgate.CloseSessionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CloseSession not implemented")
 }
+func (UnimplementedVitessServer) ExportSession(context.Context, *vtgate.ExportSessionRequest) (*vtgate.ExportSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportSession not implemented")
+}
+func (UnimplementedVitessServer) ImportSession(context.Context, *vtgate.ImportSessionRequest) (*vtgate.ImportSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportSession not implemented")
+}
 func (UnimplementedVitessServer) mustEmbedUnimplementedVitessServer() {}
 
 // UnsafeVitessServer may be embedded to opt out of forward compatibility for this service.
@@ -294,6 +381,27 @@ func (x *vitessStreamExecuteServer) Send(m *vtgate.StreamExecuteResponse) error
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Vitess_StreamExecuteBatch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(vtgate.StreamExecuteBatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VitessServer).StreamExecuteBatch(m, &vitessStreamExecuteBatchServer{stream})
+}
+
+type Vitess_StreamExecuteBatchServer interface {
+	Send(*vtgate.StreamExecuteBatchResponse) error
+	grpc.ServerStream
+}
+
+type vitessStreamExecuteBatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *vitessStreamExecuteBatchServer) Send(m *vtgate.StreamExecuteBatchResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Vitess_ResolveTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(vtgate.ResolveTransactionRequest)
 	if err := dec(in); err != nil {
@@ -369,6 +477,42 @@ func _Vitess_CloseSession_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Vitess_ExportSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtgate.ExportSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VitessServer).ExportSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtgateservice.Vitess/ExportSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VitessServer).ExportSession(ctx, req.(*vtgate.ExportSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vitess_ImportSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtgate.ImportSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VitessServer).ImportSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtgateservice.Vitess/ImportSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VitessServer).ImportSession(ctx, req.(*vtgate.ImportSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Vitess_ServiceDesc is the grpc.ServiceDesc for Vitess service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -396,6 +540,14 @@ var Vitess_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CloseSession",
 			Handler:    _Vitess_CloseSession_Handler,
 		},
+		{
+			MethodName: "ExportSession",
+			Handler:    _Vitess_ExportSession_Handler,
+		},
+		{
+			MethodName: "ImportSession",
+			Handler:    _Vitess_ImportSession_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -403,6 +555,11 @@ var Vitess_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _Vitess_StreamExecute_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "StreamExecuteBatch",
+			Handler:       _Vitess_StreamExecuteBatch_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "VStream",
 			Handler:       _Vitess_VStream_Handler,
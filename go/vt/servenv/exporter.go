@@ -630,6 +630,24 @@ func (tw *TimingsWrapper) Record(name string, startTime time.Time) {
 	tw.timings.Record([]string{tw.name, name}, startTime)
 }
 
+// AddWithTraceID behaves like Timings.AddWithTraceID.
+func (tw *TimingsWrapper) AddWithTraceID(name string, elapsed time.Duration, traceID string) {
+	if tw.name == "" {
+		tw.timings.AddWithTraceID([]string{name}, elapsed, traceID)
+		return
+	}
+	tw.timings.AddWithTraceID([]string{tw.name, name}, elapsed, traceID)
+}
+
+// RecordWithTraceID behaves like Timings.RecordWithTraceID.
+func (tw *TimingsWrapper) RecordWithTraceID(name string, startTime time.Time, traceID string) {
+	if tw.name == "" {
+		tw.timings.RecordWithTraceID([]string{name}, startTime, traceID)
+		return
+	}
+	tw.timings.RecordWithTraceID([]string{tw.name, name}, startTime, traceID)
+}
+
 // Counts behaves like Timings.Counts.
 func (tw *TimingsWrapper) Counts() map[string]int64 {
 	return tw.timings.Counts()
@@ -133,10 +133,19 @@ func createGRPCServer() {
 
 	var opts []grpc.ServerOption
 	if GRPCPort != nil && *GRPCCert != "" && *GRPCKey != "" {
-		config, err := vttls.ServerConfig(*GRPCCert, *GRPCKey, *GRPCCA, *GRPCServerCA, tls.VersionTLS12)
+		serverConfig := vttls.ServerConfig
+		if *grpccommon.TLSReload {
+			serverConfig = vttls.ServerConfigWithReload
+		}
+		config, err := serverConfig(*GRPCCert, *GRPCKey, *GRPCCA, *GRPCServerCA, tls.VersionTLS12)
 		if err != nil {
 			log.Exitf("Failed to log gRPC cert/key/ca: %v", err)
 		}
+		if spiffeIDs := grpccommon.SPIFFEIDs(); len(spiffeIDs) > 0 {
+			if err := vttls.VerifyClientSPIFFEIDs(config, spiffeIDs); err != nil {
+				log.Exitf("Failed to verify gRPC client SPIFFE IDs: %v", err)
+			}
+		}
 
 		// create the creds server options
 		creds := credentials.NewTLS(config)
@@ -188,6 +197,16 @@ func createGRPCServer() {
 	GRPCServer = grpc.NewServer(opts...)
 }
 
+// extraInterceptors holds the interceptors added with AddGRPCServerInterceptors.
+var extraInterceptors serverInterceptorBuilder
+
+// AddGRPCServerInterceptors adds interceptors to the gRPC server, after the
+// authentication one. It must be called before the server is created, e.g.
+// in an init function.
+func AddGRPCServerInterceptors(s grpc.StreamServerInterceptor, u grpc.UnaryServerInterceptor) {
+	extraInterceptors.Add(s, u)
+}
+
 // We can only set a ServerInterceptor once, so we chain multiple interceptors into one
 func interceptors() []grpc.ServerOption {
 	interceptors := &serverInterceptorBuilder{}
@@ -203,6 +222,9 @@ func interceptors() []grpc.ServerOption {
 		interceptors.Add(authenticatingStreamInterceptor, authenticatingUnaryInterceptor)
 	}
 
+	interceptors.streamInterceptors = append(interceptors.streamInterceptors, extraInterceptors.streamInterceptors...)
+	interceptors.unaryInterceptors = append(interceptors.unaryInterceptors, extraInterceptors.unaryInterceptors...)
+
 	if *grpccommon.EnableGRPCPrometheus {
 		interceptors.Add(grpc_prometheus.StreamServerInterceptor, grpc_prometheus.UnaryServerInterceptor)
 	}
@@ -0,0 +1,177 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package sidecardb manages the schema of the _vt sidecar database. The
+// components owning _vt tables, such as vreplication or online DDL, register
+// the ordered list of their DDLs. A DDL is never changed or removed once
+// released: new ones are appended. The number of DDLs applied for each
+// component is recorded in the _vt.schema_version_sidecar table, so that only
+// the new DDLs are applied when a tablet becomes primary.
+package sidecardb
+
+import (
+	"flag"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+var (
+	// InitEnabled is true if the sidecar database schema is brought up to
+	// date when a tablet becomes primary.
+	InitEnabled = flag.Bool("init_sidecar_db", true, "Create and upgrade the tables of the _vt sidecar database when the tablet becomes primary.")
+	dryRun      = flag.Bool("sidecar_db_dry_run", false, "With -init_sidecar_db, only log the pending DDLs of the _vt sidecar database schema, without applying them.")
+
+	pendingMigrations = stats.NewGaugesWithSingleLabel("SidecarDBPendingMigrations", "Pending DDLs of the _vt sidecar database schema, by component", "component")
+	appliedMigrations = stats.NewCountersWithSingleLabel("SidecarDBAppliedMigrations", "Applied DDLs of the _vt sidecar database schema, by component", "component")
+	migrationErrors   = stats.NewCountersWithSingleLabel("SidecarDBMigrationErrors", "Failed DDLs of the _vt sidecar database schema, by component", "component")
+)
+
+const (
+	sqlCreateSidecarDB     = "create database if not exists _vt"
+	sqlCreateVersionTable  = "create table if not exists _vt.schema_version_sidecar (component varbinary(128) not null, version int unsigned not null, updated_at timestamp not null default current_timestamp on update current_timestamp, primary key (component)) engine=InnoDB"
+	sqlSelectVersions      = "select component, version from _vt.schema_version_sidecar"
+	sqlUpsertVersionFormat = "insert into _vt.schema_version_sidecar (component, version) values (%s, %d) on duplicate key update version = values(version)"
+)
+
+// Exec executes a query on the primary database, as the dba user.
+type Exec func(query string, maxrows int, wantfields bool) (*sqltypes.Result, error)
+
+var (
+	mu sync.Mutex
+	// components holds the DDLs of the components, by component name.
+	components = make(map[string][]string)
+)
+
+// Register registers the DDLs creating and upgrading the _vt tables of a
+// component. The DDLs must be idempotent, or fail with one of the errors
+// accepted by mysql.IsSchemaApplyError when they were already applied.
+func Register(component string, ddls []string) {
+	mu.Lock()
+	defer mu.Unlock()
+	if _, ok := components[component]; ok {
+		log.Fatalf("sidecar db component %v already registered", component)
+	}
+	components[component] = append([]string(nil), ddls...)
+}
+
+// Init applies the pending DDLs of the registered components, in the order
+// of the component names. It stops at the first DDL failing, the next DDLs of
+// the component depending on it. With -sidecar_db_dry_run, the pending DDLs
+// are only logged.
+func Init(exec Exec) error {
+	mu.Lock()
+	defer mu.Unlock()
+
+	versions, err := readVersions(exec)
+	if err != nil {
+		return err
+	}
+	names := make([]string, 0, len(components))
+	for name := range components {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if err := migrate(exec, name, components[name], versions[name]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func readVersions(exec Exec) (map[string]int, error) {
+	versions := make(map[string]int)
+	if *dryRun {
+		qr, err := exec(sqlSelectVersions, math.MaxInt32, false)
+		switch {
+		case err == nil:
+		case isNoTableError(err):
+			// Nothing was applied yet.
+			return versions, nil
+		default:
+			return nil, err
+		}
+		return parseVersions(qr)
+	}
+
+	for _, query := range []string{sqlCreateSidecarDB, sqlCreateVersionTable} {
+		if _, err := exec(query, 0, false); err != nil {
+			return nil, err
+		}
+	}
+	qr, err := exec(sqlSelectVersions, math.MaxInt32, false)
+	if err != nil {
+		return nil, err
+	}
+	return parseVersions(qr)
+}
+
+func parseVersions(qr *sqltypes.Result) (map[string]int, error) {
+	versions := make(map[string]int, len(qr.Rows))
+	for _, row := range qr.Rows {
+		version, err := row[1].ToInt64()
+		if err != nil {
+			return nil, err
+		}
+		versions[row[0].ToString()] = int(version)
+	}
+	return versions, nil
+}
+
+func migrate(exec Exec, component string, ddls []string, version int) error {
+	if version >= len(ddls) {
+		pendingMigrations.Set(component, 0)
+		return nil
+	}
+	pendingMigrations.Set(component, int64(len(ddls)-version))
+	if *dryRun {
+		for _, ddl := range ddls[version:] {
+			log.Infof("Dry run: pending %v sidecar db DDL: %v", component, ddl)
+		}
+		return nil
+	}
+
+	for version < len(ddls) {
+		ddl := ddls[version]
+		if _, err := exec(ddl, 0, false); err != nil && !mysql.IsSchemaApplyError(err) {
+			migrationErrors.Add(component, 1)
+			return fmt.Errorf("cannot apply %v sidecar db DDL %v: %v", component, ddl, err)
+		}
+		version++
+		upsert := fmt.Sprintf(sqlUpsertVersionFormat, sqlparser.String(sqlparser.NewStrLiteral(component)), version)
+		if _, err := exec(upsert, 0, false); err != nil {
+			migrationErrors.Add(component, 1)
+			return err
+		}
+		appliedMigrations.Add(component, 1)
+		pendingMigrations.Set(component, int64(len(ddls)-version))
+		log.Infof("Applied %v sidecar db DDL: %v", component, ddl)
+	}
+	return nil
+}
+
+func isNoTableError(err error) bool {
+	merr, ok := err.(*mysql.SQLError)
+	return ok && (merr.Num == mysql.ERNoSuchTable || merr.Num == mysql.ERBadDb)
+}
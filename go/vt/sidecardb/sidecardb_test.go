@@ -0,0 +1,109 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sidecardb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+)
+
+// fakeDB records the executed queries, and answers the version queries.
+type fakeDB struct {
+	queries  []string
+	versions *sqltypes.Result
+	errors   map[string]error
+}
+
+func (db *fakeDB) exec(query string, maxrows int, wantfields bool) (*sqltypes.Result, error) {
+	db.queries = append(db.queries, query)
+	if err := db.errors[query]; err != nil {
+		return nil, err
+	}
+	if query == sqlSelectVersions {
+		return db.versions, nil
+	}
+	return &sqltypes.Result{}, nil
+}
+
+func setComponents(t *testing.T, c map[string][]string) {
+	saved := components
+	components = make(map[string][]string)
+	t.Cleanup(func() { components = saved })
+	for name, ddls := range c {
+		Register(name, ddls)
+	}
+}
+
+func TestInit(t *testing.T) {
+	setComponents(t, map[string][]string{
+		"b": {"create table _vt.b (id int)", "alter table _vt.b add c int"},
+		"a": {"create table _vt.a (id int)"},
+	})
+	db := &fakeDB{
+		versions: sqltypes.MakeTestResult(sqltypes.MakeTestFields("component|version", "varbinary|uint32"), "b|1"),
+		errors: map[string]error{
+			"create table _vt.a (id int)": mysql.NewSQLError(mysql.ERTableExists, mysql.SSUnknownSQLState, "table exists"),
+		},
+	}
+	require.NoError(t, Init(db.exec))
+	assert.Equal(t, []string{
+		sqlCreateSidecarDB,
+		sqlCreateVersionTable,
+		sqlSelectVersions,
+		"create table _vt.a (id int)",
+		"insert into _vt.schema_version_sidecar (component, version) values ('a', 1) on duplicate key update version = values(version)",
+		"alter table _vt.b add c int",
+		"insert into _vt.schema_version_sidecar (component, version) values ('b', 2) on duplicate key update version = values(version)",
+	}, db.queries)
+	assert.EqualValues(t, 0, pendingMigrations.Counts()["b"])
+}
+
+func TestInitError(t *testing.T) {
+	setComponents(t, map[string][]string{
+		"a": {"create table _vt.a (id int)", "alter table _vt.a add c int", "alter table _vt.a add d int"},
+	})
+	db := &fakeDB{
+		versions: &sqltypes.Result{},
+		errors: map[string]error{
+			"alter table _vt.a add c int": mysql.NewSQLError(mysql.ERAccessDeniedError, mysql.SSUnknownSQLState, "denied"),
+		},
+	}
+	assert.EqualError(t, Init(db.exec), "cannot apply a sidecar db DDL alter table _vt.a add c int: denied (errno 1045) (sqlstate HY000)")
+	assert.NotContains(t, db.queries, "alter table _vt.a add d int")
+	assert.EqualValues(t, 2, pendingMigrations.Counts()["a"])
+}
+
+func TestInitDryRun(t *testing.T) {
+	defer func(d bool) { *dryRun = d }(*dryRun)
+	*dryRun = true
+	setComponents(t, map[string][]string{
+		"c": {"create table _vt.c (id int)", "alter table _vt.c add c int"},
+	})
+	db := &fakeDB{
+		errors: map[string]error{
+			sqlSelectVersions: mysql.NewSQLError(mysql.ERNoSuchTable, mysql.SSUnknownSQLState, "no such table"),
+		},
+	}
+	require.NoError(t, Init(db.exec))
+	assert.Equal(t, []string{sqlSelectVersions}, db.queries)
+	assert.EqualValues(t, 2, pendingMigrations.Counts()["c"])
+}
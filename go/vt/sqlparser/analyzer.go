@@ -221,7 +221,7 @@ func Preview(sql string) StatementType {
 		return StmtShow
 	case "use":
 		return StmtUse
-	case "describe", "desc", "explain":
+	case "describe", "desc", "explain", "vexplain":
 		return StmtExplain
 	case "analyze", "repair", "optimize":
 		return StmtOther
@@ -72,6 +72,7 @@ func TestPreview(t *testing.T) {
 		{"describe", StmtExplain},
 		{"desc", StmtExplain},
 		{"explain", StmtExplain},
+		{"vexplain all select 1", StmtExplain},
 		{"repair", StmtOther},
 		{"optimize", StmtOther},
 		{"grant", StmtPriv},
@@ -211,6 +211,7 @@ type (
 		Where       *Where
 		GroupBy     GroupBy
 		Having      *Where
+		Windows     NamedWindows
 		OrderBy     OrderBy
 		Limit       *Limit
 		Lock        Lock
@@ -234,12 +235,17 @@ type (
 	// Lock is an enum for the type of lock in the statement
 	Lock int8
 
-	// UnionSelect represents union type and select statement after first select statement.
+	// SetOpType is an enum for UnionSelect.Type
+	SetOpType int8
+
+	// UnionSelect represents the set operator (UNION, INTERSECT or EXCEPT) and
+	// select statement after first select statement.
 	UnionSelect struct {
+		Type      SetOpType
 		Distinct  bool
 		Statement SelectStatement
 	}
-	// Union represents a UNION statement.
+	// Union represents a UNION, INTERSECT or EXCEPT statement.
 	Union struct {
 		FirstStatement SelectStatement
 		UnionSelects   []*UnionSelect
@@ -583,6 +589,20 @@ type (
 		Wild  string
 	}
 
+	// VExplainKind is an enum for VExplainStmt.Kind
+	VExplainKind int8
+
+	// VExplainStmt represents a VEXPLAIN ALL statement, which executes its
+	// statement and reports how each primitive of its plan executed, or a
+	// VEXPLAIN PLAN statement, which reports the plan of its statement and
+	// the join orders the planner considered for it. Its Type is EmptyType
+	// for the tree output or JSONType for the JSON output.
+	VExplainStmt struct {
+		Kind      VExplainKind
+		Type      ExplainType
+		Statement Statement
+	}
+
 	// OtherRead represents a DESCRIBE, or EXPLAIN statement.
 	// It should be used only as an indicator. It does not contain
 	// the full AST for the statement.
@@ -639,6 +659,7 @@ func (*RenameTable) iStatement()       {}
 func (*CallProc) iStatement()          {}
 func (*ExplainStmt) iStatement()       {}
 func (*ExplainTab) iStatement()        {}
+func (*VExplainStmt) iStatement()      {}
 
 func (*CreateView) iDDLStatement()    {}
 func (*AlterView) iDDLStatement()     {}
@@ -669,8 +690,9 @@ func (*RenameIndex) iAlterOption()             {}
 func (*Validation) iAlterOption()              {}
 func (TableOptions) iAlterOption()             {}
 
-func (*ExplainStmt) iExplain() {}
-func (*ExplainTab) iExplain()  {}
+func (*ExplainStmt) iExplain()  {}
+func (*ExplainTab) iExplain()   {}
+func (*VExplainStmt) iExplain() {}
 
 // IsFullyParsed implements the DDLStatement interface
 func (*TruncateTable) IsFullyParsed() bool {
@@ -1841,6 +1863,10 @@ type (
 		Metadata  interface{}
 		Name      ColIdent
 		Qualifier TableName
+		// Pos is the position of the column in the query it was
+		// parsed from, counting from 1, or 0 if it was not parsed.
+		// Two columns at different positions are still equal.
+		Pos int `equals:"-"`
 	}
 
 	// ColTuple represents a list of column values.
@@ -1900,6 +1926,7 @@ type (
 		Name      ColIdent
 		Distinct  bool
 		Exprs     SelectExprs
+		Over      *OverClause
 	}
 
 	// GroupConcatExpr represents a call to GROUP_CONCAT
@@ -2045,6 +2072,52 @@ type Order struct {
 // OrderDirection is an enum for the direction in which to order - asc or desc.
 type OrderDirection int8
 
+// OverClause represents the OVER clause of a window function call:
+// OVER window_name or OVER (window_spec).
+type OverClause struct {
+	WindowName ColIdent
+	WindowSpec *WindowSpecification
+}
+
+// WindowSpecification represents the specification of a window, in an OVER
+// clause or in a WINDOW clause. Name is the window it refines, if any.
+type WindowSpecification struct {
+	Name            ColIdent
+	PartitionClause Exprs
+	OrderClause     OrderBy
+	FrameClause     *FrameClause
+}
+
+// FrameClause represents the frame clause of a window specification:
+// ROWS|RANGE frame_start, or ROWS|RANGE BETWEEN frame_start AND frame_end.
+type FrameClause struct {
+	Unit  FrameUnitType
+	Start *FramePoint
+	End   *FramePoint
+}
+
+// FrameUnitType is an enum for FrameClause.Unit
+type FrameUnitType int8
+
+// FramePoint represents a bound of a window frame. Expr is only set for the
+// expr PRECEDING and expr FOLLOWING bounds.
+type FramePoint struct {
+	Type FramePointType
+	Expr Expr
+}
+
+// FramePointType is an enum for FramePoint.Type
+type FramePointType int8
+
+// NamedWindow represents a window of the WINDOW clause: name AS (window_spec).
+type NamedWindow struct {
+	Name       ColIdent
+	WindowSpec *WindowSpecification
+}
+
+// NamedWindows represents the WINDOW clause of a SELECT.
+type NamedWindows []*NamedWindow
+
 // Limit represents a LIMIT clause.
 type Limit struct {
 	Offset, Rowcount Expr
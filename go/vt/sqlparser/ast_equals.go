@@ -362,6 +362,18 @@ func EqualsSQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return EqualsRefOfForeignKeyDefinition(a, b)
+	case *FrameClause:
+		b, ok := inB.(*FrameClause)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfFrameClause(a, b)
+	case *FramePoint:
+		b, ok := inB.(*FramePoint)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfFramePoint(a, b)
 	case *FuncExpr:
 		b, ok := inB.(*FuncExpr)
 		if !ok {
@@ -488,6 +500,18 @@ func EqualsSQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return EqualsRefOfModifyColumn(a, b)
+	case *NamedWindow:
+		b, ok := inB.(*NamedWindow)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfNamedWindow(a, b)
+	case NamedWindows:
+		b, ok := inB.(NamedWindows)
+		if !ok {
+			return false
+		}
+		return EqualsNamedWindows(a, b)
 	case *Nextval:
 		b, ok := inB.(*Nextval)
 		if !ok {
@@ -554,6 +578,12 @@ func EqualsSQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return EqualsRefOfOtherRead(a, b)
+	case *OverClause:
+		b, ok := inB.(*OverClause)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfOverClause(a, b)
 	case *ParenSelect:
 		b, ok := inB.(*ParenSelect)
 		if !ok {
@@ -854,6 +884,12 @@ func EqualsSQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return EqualsRefOfUse(a, b)
+	case *VExplainStmt:
+		b, ok := inB.(*VExplainStmt)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfVExplainStmt(a, b)
 	case *VStream:
 		b, ok := inB.(*VStream)
 		if !ok {
@@ -908,6 +944,12 @@ func EqualsSQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return EqualsRefOfWhere(a, b)
+	case *WindowSpecification:
+		b, ok := inB.(*WindowSpecification)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfWindowSpecification(a, b)
 	case *XorExpr:
 		b, ok := inB.(*XorExpr)
 		if !ok {
@@ -1593,6 +1635,31 @@ func EqualsRefOfForeignKeyDefinition(a, b *ForeignKeyDefinition) bool {
 		EqualsRefOfReferenceDefinition(a.ReferenceDefinition, b.ReferenceDefinition)
 }
 
+// EqualsRefOfFrameClause does deep equals between the two objects.
+func EqualsRefOfFrameClause(a, b *FrameClause) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Unit == b.Unit &&
+		EqualsRefOfFramePoint(a.Start, b.Start) &&
+		EqualsRefOfFramePoint(a.End, b.End)
+}
+
+// EqualsRefOfFramePoint does deep equals between the two objects.
+func EqualsRefOfFramePoint(a, b *FramePoint) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Type == b.Type &&
+		EqualsExpr(a.Expr, b.Expr)
+}
+
 // EqualsRefOfFuncExpr does deep equals between the two objects.
 func EqualsRefOfFuncExpr(a, b *FuncExpr) bool {
 	if a == b {
@@ -1604,7 +1671,8 @@ func EqualsRefOfFuncExpr(a, b *FuncExpr) bool {
 	return a.Distinct == b.Distinct &&
 		EqualsTableIdent(a.Qualifier, b.Qualifier) &&
 		EqualsColIdent(a.Name, b.Name) &&
-		EqualsSelectExprs(a.Exprs, b.Exprs)
+		EqualsSelectExprs(a.Exprs, b.Exprs) &&
+		EqualsRefOfOverClause(a.Over, b.Over)
 }
 
 // EqualsGroupBy does deep equals between the two objects.
@@ -1839,6 +1907,31 @@ func EqualsRefOfModifyColumn(a, b *ModifyColumn) bool {
 		EqualsRefOfColName(a.After, b.After)
 }
 
+// EqualsRefOfNamedWindow does deep equals between the two objects.
+func EqualsRefOfNamedWindow(a, b *NamedWindow) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return EqualsColIdent(a.Name, b.Name) &&
+		EqualsRefOfWindowSpecification(a.WindowSpec, b.WindowSpec)
+}
+
+// EqualsNamedWindows does deep equals between the two objects.
+func EqualsNamedWindows(a, b NamedWindows) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := 0; i < len(a); i++ {
+		if !EqualsRefOfNamedWindow(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
 // EqualsRefOfNextval does deep equals between the two objects.
 func EqualsRefOfNextval(a, b *Nextval) bool {
 	if a == b {
@@ -1966,6 +2059,18 @@ func EqualsRefOfOtherRead(a, b *OtherRead) bool {
 	return true
 }
 
+// EqualsRefOfOverClause does deep equals between the two objects.
+func EqualsRefOfOverClause(a, b *OverClause) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return EqualsColIdent(a.WindowName, b.WindowName) &&
+		EqualsRefOfWindowSpecification(a.WindowSpec, b.WindowSpec)
+}
+
 // EqualsRefOfParenSelect does deep equals between the two objects.
 func EqualsRefOfParenSelect(a, b *ParenSelect) bool {
 	if a == b {
@@ -2167,6 +2272,7 @@ func EqualsRefOfSelect(a, b *Select) bool {
 		EqualsRefOfWhere(a.Where, b.Where) &&
 		EqualsGroupBy(a.GroupBy, b.GroupBy) &&
 		EqualsRefOfWhere(a.Having, b.Having) &&
+		EqualsNamedWindows(a.Windows, b.Windows) &&
 		EqualsOrderBy(a.OrderBy, b.OrderBy) &&
 		EqualsRefOfLimit(a.Limit, b.Limit) &&
 		a.Lock == b.Lock &&
@@ -2518,7 +2624,8 @@ func EqualsRefOfUnionSelect(a, b *UnionSelect) bool {
 	if a == nil || b == nil {
 		return false
 	}
-	return a.Distinct == b.Distinct &&
+	return a.Type == b.Type &&
+		a.Distinct == b.Distinct &&
 		EqualsSelectStatement(a.Statement, b.Statement)
 }
 
@@ -2586,6 +2693,19 @@ func EqualsRefOfUse(a, b *Use) bool {
 	return EqualsTableIdent(a.DBName, b.DBName)
 }
 
+// EqualsRefOfVExplainStmt does deep equals between the two objects.
+func EqualsRefOfVExplainStmt(a, b *VExplainStmt) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Kind == b.Kind &&
+		a.Type == b.Type &&
+		EqualsStatement(a.Statement, b.Statement)
+}
+
 // EqualsRefOfVStream does deep equals between the two objects.
 func EqualsRefOfVStream(a, b *VStream) bool {
 	if a == b {
@@ -2692,6 +2812,20 @@ func EqualsRefOfWhere(a, b *Where) bool {
 		EqualsExpr(a.Expr, b.Expr)
 }
 
+// EqualsRefOfWindowSpecification does deep equals between the two objects.
+func EqualsRefOfWindowSpecification(a, b *WindowSpecification) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return EqualsColIdent(a.Name, b.Name) &&
+		EqualsExprs(a.PartitionClause, b.PartitionClause) &&
+		EqualsOrderBy(a.OrderClause, b.OrderClause) &&
+		EqualsRefOfFrameClause(a.FrameClause, b.FrameClause)
+}
+
 // EqualsRefOfXorExpr does deep equals between the two objects.
 func EqualsRefOfXorExpr(a, b *XorExpr) bool {
 	if a == b {
@@ -3037,6 +3171,12 @@ func EqualsExplain(inA, inB Explain) bool {
 			return false
 		}
 		return EqualsRefOfExplainTab(a, b)
+	case *VExplainStmt:
+		b, ok := inB.(*VExplainStmt)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfVExplainStmt(a, b)
 	default:
 		// this should never happen
 		return false
@@ -3664,6 +3804,12 @@ func EqualsStatement(inA, inB Statement) bool {
 			return false
 		}
 		return EqualsRefOfUse(a, b)
+	case *VExplainStmt:
+		b, ok := inB.(*VExplainStmt)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfVExplainStmt(a, b)
 	case *VStream:
 		b, ok := inB.(*VStream)
 		if !ok {
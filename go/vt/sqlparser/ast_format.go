@@ -51,9 +51,9 @@ func (node *Select) Format(buf *TrackedBuffer) {
 		prefix = ", "
 	}
 
-	buf.astPrintf(node, "%v%v%v%v%v%s%v",
+	buf.astPrintf(node, "%v%v%v%v%v%v%s%v",
 		node.Where,
-		node.GroupBy, node.Having, node.OrderBy,
+		node.GroupBy, node.Having, node.Windows, node.OrderBy,
 		node.Limit, node.Lock.ToString(), node.Into)
 }
 
@@ -73,11 +73,7 @@ func (node *Union) Format(buf *TrackedBuffer) {
 
 // Format formats the node.
 func (node *UnionSelect) Format(buf *TrackedBuffer) {
-	if node.Distinct {
-		buf.astPrintf(node, " %s %v", UnionStr, node.Statement)
-	} else {
-		buf.astPrintf(node, " %s %v", UnionAllStr, node.Statement)
-	}
+	buf.astPrintf(node, " %s %v", node.ToString(), node.Statement)
 }
 
 // Format formats the node.
@@ -774,6 +770,15 @@ func (node *ExplainTab) Format(buf *TrackedBuffer) {
 	}
 }
 
+// Format formats the node.
+func (node *VExplainStmt) Format(buf *TrackedBuffer) {
+	format := ""
+	if node.Type != EmptyType {
+		format = "format = " + node.Type.ToString() + " "
+	}
+	buf.astPrintf(node, "vexplain %s %s%v", node.Kind.ToString(), format, node.Statement)
+}
+
 // Format formats the node.
 func (node *CallProc) Format(buf *TrackedBuffer) {
 	buf.astPrintf(node, "call %v(%v)", node.Name, node.Params)
@@ -1107,7 +1112,76 @@ func (node *FuncExpr) Format(buf *TrackedBuffer) {
 	} else {
 		buf.WriteString(funcName)
 	}
-	buf.astPrintf(node, "(%s%v)", distinct, node.Exprs)
+	buf.astPrintf(node, "(%s%v)%v", distinct, node.Exprs, node.Over)
+}
+
+// Format formats the node.
+func (node *OverClause) Format(buf *TrackedBuffer) {
+	if node == nil {
+		return
+	}
+	if node.WindowSpec == nil {
+		buf.astPrintf(node, " over %v", node.WindowName)
+		return
+	}
+	buf.astPrintf(node, " over (%v)", node.WindowSpec)
+}
+
+// Format formats the node.
+func (node *WindowSpecification) Format(buf *TrackedBuffer) {
+	var sep string
+	if !node.Name.IsEmpty() {
+		buf.astPrintf(node, "%v", node.Name)
+		sep = " "
+	}
+	if len(node.PartitionClause) > 0 {
+		buf.astPrintf(node, "%spartition by %v", sep, node.PartitionClause)
+		sep = " "
+	}
+	if len(node.OrderClause) > 0 {
+		buf.astPrintf(node, "%sorder by ", sep)
+		prefix := ""
+		for _, n := range node.OrderClause {
+			buf.astPrintf(node, "%s%v", prefix, n)
+			prefix = ", "
+		}
+		sep = " "
+	}
+	if node.FrameClause != nil {
+		buf.astPrintf(node, "%s%v", sep, node.FrameClause)
+	}
+}
+
+// Format formats the node.
+func (node *FrameClause) Format(buf *TrackedBuffer) {
+	if node.End == nil {
+		buf.astPrintf(node, "%s %v", node.Unit.ToString(), node.Start)
+		return
+	}
+	buf.astPrintf(node, "%s between %v and %v", node.Unit.ToString(), node.Start, node.End)
+}
+
+// Format formats the node.
+func (node *FramePoint) Format(buf *TrackedBuffer) {
+	if node.Expr != nil {
+		buf.astPrintf(node, "%v %s", node.Expr, node.Type.ToString())
+		return
+	}
+	buf.astPrintf(node, "%s", node.Type.ToString())
+}
+
+// Format formats the node.
+func (node *NamedWindow) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "%v as (%v)", node.Name, node.WindowSpec)
+}
+
+// Format formats the node.
+func (node NamedWindows) Format(buf *TrackedBuffer) {
+	prefix := " window "
+	for _, n := range node {
+		buf.astPrintf(node, "%s%v", prefix, n)
+		prefix = ", "
+	}
 }
 
 // Format formats the node
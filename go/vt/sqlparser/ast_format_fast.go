@@ -61,6 +61,8 @@ func (node *Select) formatFast(buf *TrackedBuffer) {
 
 	node.Having.formatFast(buf)
 
+	node.Windows.formatFast(buf)
+
 	node.OrderBy.formatFast(buf)
 
 	node.Limit.formatFast(buf)
@@ -89,17 +91,10 @@ func (node *Union) formatFast(buf *TrackedBuffer) {
 
 // formatFast formats the node.
 func (node *UnionSelect) formatFast(buf *TrackedBuffer) {
-	if node.Distinct {
-		buf.WriteByte(' ')
-		buf.WriteString(UnionStr)
-		buf.WriteByte(' ')
-		node.Statement.formatFast(buf)
-	} else {
-		buf.WriteByte(' ')
-		buf.WriteString(UnionAllStr)
-		buf.WriteByte(' ')
-		node.Statement.formatFast(buf)
-	}
+	buf.WriteByte(' ')
+	buf.WriteString(node.ToString())
+	buf.WriteByte(' ')
+	node.Statement.formatFast(buf)
 }
 
 // formatFast formats the node.
@@ -1064,6 +1059,19 @@ func (node *ExplainTab) formatFast(buf *TrackedBuffer) {
 	}
 }
 
+// formatFast formats the node.
+func (node *VExplainStmt) formatFast(buf *TrackedBuffer) {
+	format := ""
+	if node.Type != EmptyType {
+		format = "format = " + node.Type.ToString() + " "
+	}
+	buf.WriteString("vexplain ")
+	buf.WriteString(node.Kind.ToString())
+	buf.WriteByte(' ')
+	buf.WriteString(format)
+	node.Statement.formatFast(buf)
+}
+
 // formatFast formats the node.
 func (node *CallProc) formatFast(buf *TrackedBuffer) {
 	buf.WriteString("call ")
@@ -1489,6 +1497,96 @@ func (node *FuncExpr) formatFast(buf *TrackedBuffer) {
 	buf.WriteString(distinct)
 	node.Exprs.formatFast(buf)
 	buf.WriteByte(')')
+	node.Over.formatFast(buf)
+}
+
+// formatFast formats the node.
+func (node *OverClause) formatFast(buf *TrackedBuffer) {
+	if node == nil {
+		return
+	}
+	if node.WindowSpec == nil {
+		buf.WriteString(" over ")
+		node.WindowName.formatFast(buf)
+		return
+	}
+	buf.WriteString(" over (")
+	node.WindowSpec.formatFast(buf)
+	buf.WriteByte(')')
+}
+
+// formatFast formats the node.
+func (node *WindowSpecification) formatFast(buf *TrackedBuffer) {
+	var sep string
+	if !node.Name.IsEmpty() {
+		node.Name.formatFast(buf)
+		sep = " "
+	}
+	if len(node.PartitionClause) > 0 {
+		buf.WriteString(sep)
+		buf.WriteString("partition by ")
+		node.PartitionClause.formatFast(buf)
+		sep = " "
+	}
+	if len(node.OrderClause) > 0 {
+		buf.WriteString(sep)
+		buf.WriteString("order by ")
+		prefix := ""
+		for _, n := range node.OrderClause {
+			buf.WriteString(prefix)
+			n.formatFast(buf)
+			prefix = ", "
+		}
+		sep = " "
+	}
+	if node.FrameClause != nil {
+		buf.WriteString(sep)
+		node.FrameClause.formatFast(buf)
+	}
+}
+
+// formatFast formats the node.
+func (node *FrameClause) formatFast(buf *TrackedBuffer) {
+	if node.End == nil {
+		buf.WriteString(node.Unit.ToString())
+		buf.WriteByte(' ')
+		node.Start.formatFast(buf)
+		return
+	}
+	buf.WriteString(node.Unit.ToString())
+	buf.WriteString(" between ")
+	node.Start.formatFast(buf)
+	buf.WriteString(" and ")
+	node.End.formatFast(buf)
+}
+
+// formatFast formats the node.
+func (node *FramePoint) formatFast(buf *TrackedBuffer) {
+	if node.Expr != nil {
+		node.Expr.formatFast(buf)
+		buf.WriteByte(' ')
+		buf.WriteString(node.Type.ToString())
+		return
+	}
+	buf.WriteString(node.Type.ToString())
+}
+
+// formatFast formats the node.
+func (node *NamedWindow) formatFast(buf *TrackedBuffer) {
+	node.Name.formatFast(buf)
+	buf.WriteString(" as (")
+	node.WindowSpec.formatFast(buf)
+	buf.WriteByte(')')
+}
+
+// formatFast formats the node.
+func (node NamedWindows) formatFast(buf *TrackedBuffer) {
+	prefix := " window "
+	for _, n := range node {
+		buf.WriteString(prefix)
+		n.formatFast(buf)
+		prefix = ", "
+	}
 }
 
 // formatFast formats the node
@@ -508,9 +508,15 @@ var Aggregates = map[string]bool{
 	"variance":     true,
 }
 
-// IsAggregate returns true if the function is an aggregate.
+// IsAggregate returns true if the function is an aggregate. An aggregate
+// function with an OVER clause is a window function instead.
 func (node *FuncExpr) IsAggregate() bool {
-	return Aggregates[node.Name.Lowered()]
+	return node.Over == nil && Aggregates[node.Name.Lowered()]
+}
+
+// IsWindowFunction returns true if the function is called with an OVER clause.
+func (node *FuncExpr) IsWindowFunction() bool {
+	return node.Over != nil
 }
 
 // NewColIdent makes a new ColIdent.
@@ -889,16 +895,79 @@ func (node *Union) GetComments() Comments {
 
 //Unionize returns a UNION, either creating one or adding SELECT to an existing one
 func Unionize(lhs, rhs SelectStatement, distinct bool, by OrderBy, limit *Limit, lock Lock) *Union {
+	return SetOp(lhs, rhs, UnionType, distinct, by, limit, lock)
+}
+
+// SetOp returns a UNION, INTERSECT or EXCEPT, either creating one or adding
+// SELECT to an existing one. As in MySQL, INTERSECT binds tighter than UNION
+// and EXCEPT: the SELECT is intersected with the last SELECT of an existing
+// UNION or EXCEPT, which is then parenthesized.
+func SetOp(lhs, rhs SelectStatement, typ SetOpType, distinct bool, by OrderBy, limit *Limit, lock Lock) *Union {
+	us := &UnionSelect{Type: typ, Distinct: distinct, Statement: rhs}
 	union, isUnion := lhs.(*Union)
-	if isUnion {
-		union.UnionSelects = append(union.UnionSelects, &UnionSelect{Distinct: distinct, Statement: rhs})
-		union.OrderBy = by
-		union.Limit = limit
-		union.Lock = lock
-		return union
+	if !isUnion {
+		return &Union{FirstStatement: lhs, UnionSelects: []*UnionSelect{us}, OrderBy: by, Limit: limit, Lock: lock}
+	}
+
+	if last := len(union.UnionSelects) - 1; typ == IntersectType && last >= 0 && union.UnionSelects[last].Type != IntersectType && union.OrderBy == nil && union.Limit == nil {
+		lhs := union.UnionSelects[last]
+		lhs.Statement = &ParenSelect{Select: SetOp(lhs.Statement, rhs, typ, distinct, nil, nil, NoLock)}
+	} else {
+		union.UnionSelects = append(union.UnionSelects, us)
+	}
+	union.OrderBy = by
+	union.Limit = limit
+	union.Lock = lock
+	return union
+}
+
+// ToString returns the set operator of the UnionSelect as a string.
+func (node *UnionSelect) ToString() string {
+	var op string
+	switch node.Type {
+	case UnionType:
+		op = UnionStr
+	case IntersectType:
+		op = IntersectStr
+	case ExceptType:
+		op = ExceptStr
+	default:
+		return "Unknown SetOpType"
+	}
+	if !node.Distinct {
+		op += " all"
 	}
+	return op
+}
 
-	return &Union{FirstStatement: lhs, UnionSelects: []*UnionSelect{{Distinct: distinct, Statement: rhs}}, OrderBy: by, Limit: limit, Lock: lock}
+// ToString returns the string associated with the FrameUnitType Enum
+func (unit FrameUnitType) ToString() string {
+	switch unit {
+	case FrameRowsType:
+		return RowsStr
+	case FrameRangeType:
+		return RangeStr
+	default:
+		return "Unknown FrameUnitType"
+	}
+}
+
+// ToString returns the string associated with the FramePointType Enum
+func (typ FramePointType) ToString() string {
+	switch typ {
+	case CurrentRowType:
+		return CurrentRowStr
+	case UnboundedPrecedingType:
+		return UnboundedPrecedingStr
+	case UnboundedFollowingType:
+		return UnboundedFollowingStr
+	case ExprPrecedingType:
+		return PrecedingStr
+	case ExprFollowingType:
+		return FollowingStr
+	default:
+		return "Unknown FramePointType"
+	}
 }
 
 // ToString returns the string associated with the DDLAction Enum
@@ -1213,6 +1282,18 @@ func (ty ExplainType) ToString() string {
 	}
 }
 
+// ToString returns the kind as a string
+func (kind VExplainKind) ToString() string {
+	switch kind {
+	case VExplainAll:
+		return VExplainAllStr
+	case VExplainPlan:
+		return VExplainPlanStr
+	default:
+		return "Unknown VExplainKind"
+	}
+}
+
 // ToString returns the type as a string
 func (sel SelectIntoType) ToString() string {
 	switch sel {
@@ -1318,6 +1399,8 @@ func (ty ShowCommandType) ToString() string {
 		return WarningsStr
 	case Keyspace:
 		return KeyspaceStr
+	case VitessDDLStatus:
+		return VitessDDLStatusStr
 	default:
 		return "" +
 			"Unknown ShowCommandType"
@@ -1431,6 +1514,19 @@ func ContainsAggregation(e SQLNode) bool {
 	return hasAggregates
 }
 
+// ContainsWindowFunction returns true if the expression contains a window function
+func ContainsWindowFunction(e SQLNode) bool {
+	hasWindowFunctions := false
+	_ = Walk(func(node SQLNode) (kontinue bool, err error) {
+		if fExpr, ok := node.(*FuncExpr); ok && fExpr.IsWindowFunction() {
+			hasWindowFunctions = true
+			return false, nil
+		}
+		return true, nil
+	}, e)
+	return hasWindowFunctions
+}
+
 // IsAggregation returns true if the node is an aggregation expression
 func IsAggregation(node SQLNode) bool {
 	switch node := node.(type) {
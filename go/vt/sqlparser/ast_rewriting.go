@@ -385,19 +385,25 @@ func (er *expressionRewriter) sysVarRewrite(cursor *Cursor, node *ColName) {
 	switch lowered {
 	case sysvars.Autocommit.Name,
 		sysvars.ClientFoundRows.Name,
+		sysvars.ConsistentSnapshotReads.Name,
 		sysvars.DDLStrategy.Name,
 		sysvars.TransactionMode.Name,
 		sysvars.ReadAfterWriteGTID.Name,
 		sysvars.ReadAfterWriteTimeOut.Name,
+		sysvars.MaxExecutionTime.Name,
+		sysvars.Priority.Name,
 		sysvars.SessionEnableSystemSettings.Name,
 		sysvars.SessionTrackGTIDs.Name,
 		sysvars.SessionUUID.Name,
+		sysvars.SkipKeyspacePolicy.Name,
 		sysvars.SkipQueryPlanCache.Name,
 		sysvars.Socket.Name,
 		sysvars.SQLSelectLimit.Name,
+		sysvars.Tenant.Name,
 		sysvars.Version.Name,
 		sysvars.VersionComment.Name,
-		sysvars.Workload.Name:
+		sysvars.Workload.Name,
+		sysvars.WorkloadName.Name:
 		cursor.Replace(bindVarExpression("__vt" + lowered))
 		er.bindVars.AddSysVar(lowered)
 	}
@@ -134,6 +134,10 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return VisitRefOfForce(in, f)
 	case *ForeignKeyDefinition:
 		return VisitRefOfForeignKeyDefinition(in, f)
+	case *FrameClause:
+		return VisitRefOfFrameClause(in, f)
+	case *FramePoint:
+		return VisitRefOfFramePoint(in, f)
 	case *FuncExpr:
 		return VisitRefOfFuncExpr(in, f)
 	case GroupBy:
@@ -176,6 +180,10 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return VisitRefOfMatchExpr(in, f)
 	case *ModifyColumn:
 		return VisitRefOfModifyColumn(in, f)
+	case *NamedWindow:
+		return VisitRefOfNamedWindow(in, f)
+	case NamedWindows:
+		return VisitNamedWindows(in, f)
 	case *Nextval:
 		return VisitRefOfNextval(in, f)
 	case *NotExpr:
@@ -198,6 +206,8 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return VisitRefOfOtherAdmin(in, f)
 	case *OtherRead:
 		return VisitRefOfOtherRead(in, f)
+	case *OverClause:
+		return VisitRefOfOverClause(in, f)
 	case *ParenSelect:
 		return VisitRefOfParenSelect(in, f)
 	case *ParenTableExpr:
@@ -298,6 +308,8 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return VisitUpdateExprs(in, f)
 	case *Use:
 		return VisitRefOfUse(in, f)
+	case *VExplainStmt:
+		return VisitRefOfVExplainStmt(in, f)
 	case *VStream:
 		return VisitRefOfVStream(in, f)
 	case ValTuple:
@@ -316,6 +328,8 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return VisitRefOfWhen(in, f)
 	case *Where:
 		return VisitRefOfWhere(in, f)
+	case *WindowSpecification:
+		return VisitRefOfWindowSpecification(in, f)
 	case *XorExpr:
 		return VisitRefOfXorExpr(in, f)
 	default:
@@ -1065,6 +1079,33 @@ func VisitRefOfForeignKeyDefinition(in *ForeignKeyDefinition, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfFrameClause(in *FrameClause, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitRefOfFramePoint(in.Start, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfFramePoint(in.End, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfFramePoint(in *FramePoint, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitExpr(in.Expr, f); err != nil {
+		return err
+	}
+	return nil
+}
 func VisitRefOfFuncExpr(in *FuncExpr, f Visit) error {
 	if in == nil {
 		return nil
@@ -1081,6 +1122,9 @@ func VisitRefOfFuncExpr(in *FuncExpr, f Visit) error {
 	if err := VisitSelectExprs(in.Exprs, f); err != nil {
 		return err
 	}
+	if err := VisitRefOfOverClause(in.Over, f); err != nil {
+		return err
+	}
 	return nil
 }
 func VisitGroupBy(in GroupBy, f Visit) error {
@@ -1330,6 +1374,35 @@ func VisitRefOfModifyColumn(in *ModifyColumn, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfNamedWindow(in *NamedWindow, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitColIdent(in.Name, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfWindowSpecification(in.WindowSpec, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitNamedWindows(in NamedWindows, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	for _, el := range in {
+		if err := VisitRefOfNamedWindow(el, f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 func VisitRefOfNextval(in *Nextval, f Visit) error {
 	if in == nil {
 		return nil
@@ -1460,6 +1533,21 @@ func VisitRefOfOtherRead(in *OtherRead, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfOverClause(in *OverClause, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitColIdent(in.WindowName, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfWindowSpecification(in.WindowSpec, f); err != nil {
+		return err
+	}
+	return nil
+}
 func VisitRefOfParenSelect(in *ParenSelect, f Visit) error {
 	if in == nil {
 		return nil
@@ -1695,6 +1783,9 @@ func VisitRefOfSelect(in *Select, f Visit) error {
 	if err := VisitRefOfWhere(in.Having, f); err != nil {
 		return err
 	}
+	if err := VisitNamedWindows(in.Windows, f); err != nil {
+		return err
+	}
 	if err := VisitOrderBy(in.OrderBy, f); err != nil {
 		return err
 	}
@@ -2177,6 +2268,18 @@ func VisitRefOfUse(in *Use, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfVExplainStmt(in *VExplainStmt, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitStatement(in.Statement, f); err != nil {
+		return err
+	}
+	return nil
+}
 func VisitRefOfVStream(in *VStream, f Visit) error {
 	if in == nil {
 		return nil
@@ -2306,6 +2409,27 @@ func VisitRefOfWhere(in *Where, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfWindowSpecification(in *WindowSpecification, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitColIdent(in.Name, f); err != nil {
+		return err
+	}
+	if err := VisitExprs(in.PartitionClause, f); err != nil {
+		return err
+	}
+	if err := VisitOrderBy(in.OrderClause, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfFrameClause(in.FrameClause, f); err != nil {
+		return err
+	}
+	return nil
+}
 func VisitRefOfXorExpr(in *XorExpr, f Visit) error {
 	if in == nil {
 		return nil
@@ -2464,6 +2588,8 @@ func VisitExplain(in Explain, f Visit) error {
 		return VisitRefOfExplainStmt(in, f)
 	case *ExplainTab:
 		return VisitRefOfExplainTab(in, f)
+	case *VExplainStmt:
+		return VisitRefOfVExplainStmt(in, f)
 	default:
 		// this should never happen
 		return nil
@@ -2708,6 +2834,8 @@ func VisitStatement(in Statement, f Visit) error {
 		return VisitRefOfUpdate(in, f)
 	case *Use:
 		return VisitRefOfUse(in, f)
+	case *VExplainStmt:
+		return VisitRefOfVExplainStmt(in, f)
 	case *VStream:
 		return VisitRefOfVStream(in, f)
 	default:
@@ -21,6 +21,11 @@ import (
 	"strconv"
 	"strings"
 	"unicode"
+
+	"vitess.io/vitess/go/vt/vterrors"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 )
 
 const (
@@ -39,6 +44,23 @@ const (
 	DirectiveIgnoreMaxMemoryRows = "IGNORE_MAX_MEMORY_ROWS"
 	// DirectiveAllowScatter lets scatter plans pass through even when they are turned off by `no-scatter`.
 	DirectiveAllowScatter = "ALLOW_SCATTER"
+	// DirectiveSkipConsolidator prevents vttablet from consolidating a SELECT
+	// with identical ones running at the same time.
+	DirectiveSkipConsolidator = "SKIP_CONSOLIDATOR"
+	// DirectiveConsumerGroup makes a stream statement join the named
+	// consumer group of the message table in vtgate.
+	DirectiveConsumerGroup = "CONSUMER_GROUP"
+	// DirectiveChunkedDML makes vtgate repeat a DELETE with a LIMIT until
+	// it deletes fewer rows than the limit, reporting the progress of each
+	// chunk. With a chunk size, e.g. CHUNKED_DML=1000, vtgate splits a
+	// DELETE or UPDATE into primary key ranges of that many rows instead.
+	DirectiveChunkedDML = "CHUNKED_DML"
+	// DirectiveWorkloadName labels a query with the name of its workload,
+	// overriding the workload_name of the session.
+	DirectiveWorkloadName = "WORKLOAD_NAME"
+	// DirectivePriority sets the priority of a query: HIGH, NORMAL or LOW,
+	// overriding the priority of the session.
+	DirectivePriority = "PRIORITY"
 )
 
 func isNonSpace(r rune) bool {
@@ -346,6 +368,18 @@ func SkipQueryPlanCacheDirective(stmt Statement) bool {
 	return false
 }
 
+// SkipConsolidatorDirective returns true if the skip consolidator directive
+// is set to true in the SELECT.
+func SkipConsolidatorDirective(stmt Statement) bool {
+	switch stmt := stmt.(type) {
+	case *Select:
+		directives := ExtractCommentDirectives(stmt.Comments)
+		return directives.IsSet(DirectiveSkipConsolidator)
+	default:
+		return false
+	}
+}
+
 // IgnoreMaxPayloadSizeDirective returns true if the max payload size override
 // directive is set to true.
 func IgnoreMaxPayloadSizeDirective(stmt Statement) bool {
@@ -405,3 +439,40 @@ func AllowScatterDirective(stmt Statement) bool {
 	}
 	return directives.IsSet(DirectiveAllowScatter)
 }
+
+// WorkloadDirectives returns the workload name and the priority that the
+// WORKLOAD_NAME and PRIORITY directives set on the statement, if any.
+func WorkloadDirectives(stmt Statement) (workloadName string, priority querypb.ExecuteOptions_Priority, hasPriority bool, err error) {
+	var directives CommentDirectives
+	switch stmt := stmt.(type) {
+	case *Select:
+		directives = ExtractCommentDirectives(stmt.Comments)
+	case *Insert:
+		directives = ExtractCommentDirectives(stmt.Comments)
+	case *Update:
+		directives = ExtractCommentDirectives(stmt.Comments)
+	case *Delete:
+		directives = ExtractCommentDirectives(stmt.Comments)
+	default:
+		return "", 0, false, nil
+	}
+	workloadName = directives.GetString(DirectiveWorkloadName, "")
+	if _, ok := directives[DirectivePriority]; !ok {
+		return workloadName, 0, false, nil
+	}
+	priority, err = ParsePriority(directives.GetString(DirectivePriority, ""))
+	if err != nil {
+		return "", 0, false, err
+	}
+	return workloadName, priority, true, nil
+}
+
+// ParsePriority parses the name of a priority: high, normal or low, in any
+// case.
+func ParsePriority(name string) (querypb.ExecuteOptions_Priority, error) {
+	priority, ok := querypb.ExecuteOptions_Priority_value[strings.ToUpper(name)+"_PRIORITY"]
+	if !ok {
+		return 0, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid priority: %s, it must be one of HIGH, NORMAL or LOW", name)
+	}
+	return querypb.ExecuteOptions_Priority(priority), nil
+}
@@ -22,6 +22,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
 )
 
 func TestSplitComments(t *testing.T) {
@@ -418,6 +420,26 @@ func TestSkipQueryPlanCacheDirective(t *testing.T) {
 	}
 }
 
+func TestSkipConsolidatorDirective(t *testing.T) {
+	testCases := []struct {
+		query    string
+		expected bool
+	}{
+		{"select /*vt+ SKIP_CONSOLIDATOR=1 */ * from users", true},
+		{"select /*vt+ SKIP_CONSOLIDATOR=0 */ * from users", false},
+		{"select * from users", false},
+		{"update /*vt+ SKIP_CONSOLIDATOR=1 */ users set name=1", false},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.query, func(t *testing.T) {
+			stmt, _ := Parse(test.query)
+			got := SkipConsolidatorDirective(stmt)
+			assert.Equalf(t, test.expected, got, fmt.Sprintf("SkipConsolidatorDirective(stmt) returned %v but expected %v", got, test.expected))
+		})
+	}
+}
+
 func TestIgnoreMaxPayloadSizeDirective(t *testing.T) {
 	testCases := []struct {
 		query    string
@@ -469,3 +491,37 @@ func TestIgnoreMaxMaxMemoryRowsDirective(t *testing.T) {
 		})
 	}
 }
+
+func TestWorkloadDirectives(t *testing.T) {
+	testCases := []struct {
+		query        string
+		workloadName string
+		priority     querypb.ExecuteOptions_Priority
+		hasPriority  bool
+		err          string
+	}{
+		{query: "select * from users"},
+		{query: "select /*vt+ WORKLOAD_NAME=reports */ * from users", workloadName: "reports"},
+		{query: "select /*vt+ WORKLOAD_NAME=reports PRIORITY=low */ * from users", workloadName: "reports", priority: querypb.ExecuteOptions_LOW_PRIORITY, hasPriority: true},
+		{query: "update /*vt+ PRIORITY=HIGH */ users set name=1", priority: querypb.ExecuteOptions_HIGH_PRIORITY, hasPriority: true},
+		{query: "delete /*vt+ PRIORITY=normal */ from users", priority: querypb.ExecuteOptions_NORMAL_PRIORITY, hasPriority: true},
+		{query: "insert /*vt+ WORKLOAD_NAME=batch */ into users(id) values (1)", workloadName: "batch"},
+		{query: "select /*vt+ PRIORITY=urgent */ * from users", err: "invalid priority: urgent, it must be one of HIGH, NORMAL or LOW"},
+		{query: "show /*vt+ WORKLOAD_NAME=reports */ create table users"},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.query, func(t *testing.T) {
+			stmt, _ := Parse(test.query)
+			workloadName, priority, hasPriority, err := WorkloadDirectives(stmt)
+			if test.err != "" {
+				assert.EqualError(t, err, test.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.workloadName, workloadName)
+			assert.Equal(t, test.priority, priority)
+			assert.Equal(t, test.hasPriority, hasPriority)
+		})
+	}
+}
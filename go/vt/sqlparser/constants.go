@@ -37,6 +37,19 @@ const (
 	UnionStr         = "union"
 	UnionAllStr      = "union all"
 	UnionDistinctStr = "union distinct"
+	IntersectStr     = "intersect"
+	ExceptStr        = "except"
+
+	// FrameClause.Unit
+	RowsStr  = "rows"
+	RangeStr = "range"
+
+	// FramePoint.Type
+	CurrentRowStr         = "current row"
+	UnboundedPrecedingStr = "unbounded preceding"
+	UnboundedFollowingStr = "unbounded following"
+	PrecedingStr          = "preceding"
+	FollowingStr          = "following"
 
 	// DDL strings.
 	InsertStr  = "insert"
@@ -204,6 +217,10 @@ const (
 	TraditionalStr = "traditional"
 	AnalyzeStr     = "analyze"
 
+	// VExplain kinds
+	VExplainAllStr  = "all"
+	VExplainPlanStr = "plan"
+
 	// Lock Types
 	ReadStr             = "read"
 	ReadLocalStr        = "read local"
@@ -240,6 +257,7 @@ const (
 	VGtidExecGlobalStr  = " global vgtid_executed"
 	KeyspaceStr         = " keyspaces"
 	VitessMigrationsStr = " vitess_migrations"
+	VitessDDLStatusStr  = " vitess_ddl_status"
 	WarningsStr         = " warnings"
 
 	// DropKeyType strings
@@ -398,6 +416,28 @@ const (
 	QueryExpansionOpt
 )
 
+// Constant for Enum Type - SetOpType
+const (
+	UnionType SetOpType = iota
+	IntersectType
+	ExceptType
+)
+
+// Constant for Enum Type - FrameUnitType
+const (
+	FrameRowsType FrameUnitType = iota
+	FrameRangeType
+)
+
+// Constant for Enum Type - FramePointType
+const (
+	CurrentRowType FramePointType = iota
+	UnboundedPrecedingType
+	UnboundedFollowingType
+	ExprPrecedingType
+	ExprFollowingType
+)
+
 // Constant for Enum Type - OrderDirection
 const (
 	AscOrder OrderDirection = iota
@@ -446,6 +486,12 @@ const (
 	AnalyzeType
 )
 
+// Constant for Enum Type - VExplainKind
+const (
+	VExplainAll VExplainKind = iota
+	VExplainPlan
+)
+
 // Constant for Enum Type - SelectIntoType
 const (
 	IntoOutfile SelectIntoType = iota
@@ -501,6 +547,7 @@ const (
 	VitessMigrations
 	Warnings
 	Keyspace
+	VitessDDLStatus
 )
 
 // DropKeyType constants
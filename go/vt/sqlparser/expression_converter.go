@@ -45,6 +45,17 @@ func Convert(e Expr) (evalengine.Expr, error) {
 		}
 		return evalengine.NewLiteralIntFromBytes([]byte("0"))
 	case *BinaryExpr:
+		switch node.Operator {
+		case JSONExtractOp, JSONUnquoteExtractOp:
+			return convertJSONExtract(node)
+		}
+		if interval, ok := node.Right.(*IntervalExpr); ok && (node.Operator == PlusOp || node.Operator == MinusOp) {
+			return convertDateAdd(node.Left, interval, node.Operator == MinusOp)
+		}
+		if interval, ok := node.Left.(*IntervalExpr); ok && node.Operator == PlusOp {
+			return convertDateAdd(node.Right, interval, false)
+		}
+
 		var op evalengine.BinaryExpr
 		switch node.Operator {
 		case PlusOp:
@@ -71,7 +82,105 @@ func Convert(e Expr) (evalengine.Expr, error) {
 			Left:  left,
 			Right: right,
 		}, nil
+	case *FuncExpr:
+		return convertFuncExpr(node)
+	case *TimestampFuncExpr:
+		return convertTimestampFuncExpr(node)
+	}
+	return nil, ErrExprNotSupported
+}
+
+func convertFuncExpr(node *FuncExpr) (evalengine.Expr, error) {
+	if !node.Qualifier.IsEmpty() || node.Distinct {
+		return nil, ErrExprNotSupported
+	}
+
+	var args []Expr
+	for _, arg := range node.Exprs {
+		aliased, ok := arg.(*AliasedExpr)
+		if !ok {
+			return nil, ErrExprNotSupported
+		}
+		args = append(args, aliased.Expr)
+	}
+
+	switch node.Name.Lowered() {
+	case "date_add", "adddate", "date_sub", "subdate":
+		if len(args) != 2 {
+			return nil, ErrExprNotSupported
+		}
+		interval, ok := args[1].(*IntervalExpr)
+		if !ok {
+			return nil, ErrExprNotSupported
+		}
+		sub := node.Name.Lowered() == "date_sub" || node.Name.Lowered() == "subdate"
+		return convertDateAdd(args[0], interval, sub)
+	case "json_extract":
+		if len(args) < 2 {
+			return nil, ErrExprNotSupported
+		}
+		exprs, err := convertExprs(args)
+		if err != nil {
+			return nil, err
+		}
+		return &evalengine.JSONExtract{Doc: exprs[0], Paths: exprs[1:]}, nil
+	case "json_unquote":
+		if len(args) != 1 {
+			return nil, ErrExprNotSupported
+		}
+		expr, err := Convert(args[0])
+		if err != nil {
+			return nil, err
+		}
+		return &evalengine.JSONUnquote{Expr: expr}, nil
+	}
+	return nil, ErrExprNotSupported
+}
+
+func convertTimestampFuncExpr(node *TimestampFuncExpr) (evalengine.Expr, error) {
+	exprs, err := convertExprs([]Expr{node.Expr1, node.Expr2})
+	if err != nil {
+		return nil, err
+	}
 
+	switch node.Name {
+	case "timestampadd":
+		return &evalengine.DateAdd{Date: exprs[1], Interval: exprs[0], Unit: node.Unit}, nil
+	case "timestampdiff":
+		return &evalengine.TimestampDiff{Unit: node.Unit, Left: exprs[0], Right: exprs[1]}, nil
 	}
 	return nil, ErrExprNotSupported
 }
+
+func convertDateAdd(date Expr, interval *IntervalExpr, sub bool) (evalengine.Expr, error) {
+	exprs, err := convertExprs([]Expr{date, interval.Expr})
+	if err != nil {
+		return nil, err
+	}
+	return &evalengine.DateAdd{Date: exprs[0], Interval: exprs[1], Unit: interval.Unit, Sub: sub}, nil
+}
+
+func convertJSONExtract(node *BinaryExpr) (evalengine.Expr, error) {
+	exprs, err := convertExprs([]Expr{node.Left, node.Right})
+	if err != nil {
+		return nil, err
+	}
+
+	var expr evalengine.Expr = &evalengine.JSONExtract{Doc: exprs[0], Paths: exprs[1:]}
+	if node.Operator == JSONUnquoteExtractOp {
+		expr = &evalengine.JSONUnquote{Expr: expr}
+	}
+	return expr, nil
+}
+
+func convertExprs(exprs []Expr) ([]evalengine.Expr, error) {
+	converted := make([]evalengine.Expr, 0, len(exprs))
+	for _, e := range exprs {
+		expr, err := Convert(e)
+		if err != nil {
+			return nil, err
+		}
+		converted = append(converted, expr)
+	}
+	return converted, nil
+}
@@ -70,6 +70,84 @@ func TestEvaluate(t *testing.T) {
 	}, {
 		expression: ":float_bind_variable",
 		expected:   sqltypes.NewFloat64(2.2),
+	}, {
+		expression: "date_add('2021-01-31', interval 1 month)",
+		expected:   sqltypes.NewVarChar("2021-02-28"),
+	}, {
+		expression: "date_add('2021-01-31', interval 1 hour)",
+		expected:   sqltypes.NewVarChar("2021-01-31 01:00:00"),
+	}, {
+		expression: "date_sub('2021-03-01 10:00:00', interval '1 12' day_hour)",
+		expected:   sqltypes.NewVarChar("2021-02-27 22:00:00"),
+	}, {
+		expression: "adddate('2020-02-29', interval 1 year)",
+		expected:   sqltypes.NewVarChar("2021-02-28"),
+	}, {
+		expression: "'2021-12-31' + interval 1 day",
+		expected:   sqltypes.NewVarChar("2022-01-01"),
+	}, {
+		expression: "interval 2 week + '2021-12-31'",
+		expected:   sqltypes.NewVarChar("2022-01-14"),
+	}, {
+		expression: "'2021-01-01' - interval 1.5 second",
+		expected:   sqltypes.NewVarChar("2020-12-31 23:59:58.500000"),
+	}, {
+		expression: "date_add(:date_bind_variable, interval 1 quarter)",
+		expected:   sqltypes.MakeTrusted(sqltypes.Date, []byte("2021-04-15")),
+	}, {
+		expression: "date_add(:date_bind_variable, interval 1 minute)",
+		expected:   sqltypes.MakeTrusted(sqltypes.Datetime, []byte("2021-01-15 00:01:00")),
+	}, {
+		expression: "date_add(:datetime_bind_variable, interval -1 day)",
+		expected:   sqltypes.MakeTrusted(sqltypes.Datetime, []byte("2021-01-14 10:20:30")),
+	}, {
+		expression: "date_add('2021-02-30', interval 1 day)",
+		expected:   sqltypes.NULL,
+	}, {
+		expression: "date_add(:null_bind_variable, interval 1 day)",
+		expected:   sqltypes.NULL,
+	}, {
+		expression: "timestampadd(minute, 90, '2021-01-01')",
+		expected:   sqltypes.NewVarChar("2021-01-01 01:30:00"),
+	}, {
+		expression: "timestampdiff(month, '2021-01-31', '2021-02-28')",
+		expected:   sqltypes.NewInt64(0),
+	}, {
+		expression: "timestampdiff(month, '2021-01-31', '2021-03-31')",
+		expected:   sqltypes.NewInt64(2),
+	}, {
+		expression: "timestampdiff(year, '2021-03-01', '2020-02-29')",
+		expected:   sqltypes.NewInt64(-1),
+	}, {
+		expression: "timestampdiff(day, :date_bind_variable, :datetime_bind_variable)",
+		expected:   sqltypes.NewInt64(0),
+	}, {
+		expression: "timestampdiff(second, '2021-01-01', '2021-01-01 00:01:01')",
+		expected:   sqltypes.NewInt64(61),
+	}, {
+		expression: "json_extract(:json_bind_variable, '$.b[1]')",
+		expected:   sqltypes.MakeTrusted(sqltypes.TypeJSON, []byte(`{"x": "y", "long": [true, null]}`)),
+	}, {
+		expression: "json_extract(:json_bind_variable, '$.b[1].x')",
+		expected:   sqltypes.MakeTrusted(sqltypes.TypeJSON, []byte(`"y"`)),
+	}, {
+		expression: "json_extract(:json_bind_variable, '$.b[*].x', '$.a')",
+		expected:   sqltypes.MakeTrusted(sqltypes.TypeJSON, []byte(`["y", 1]`)),
+	}, {
+		expression: "json_extract(:json_bind_variable, '$.c')",
+		expected:   sqltypes.NULL,
+	}, {
+		expression: "json_extract('[1, 2]', '$[1][0]')",
+		expected:   sqltypes.MakeTrusted(sqltypes.TypeJSON, []byte(`2`)),
+	}, {
+		expression: `json_extract('{"a b": 1}', '$."a b"')`,
+		expected:   sqltypes.MakeTrusted(sqltypes.TypeJSON, []byte(`1`)),
+	}, {
+		expression: `json_unquote('"a\\u0041b"')`,
+		expected:   sqltypes.NewVarChar("aAb"),
+	}, {
+		expression: "json_unquote('abc')",
+		expected:   sqltypes.NewVarChar("abc"),
 	}}
 
 	for _, test := range tests {
@@ -83,10 +161,14 @@ func TestEvaluate(t *testing.T) {
 			require.NotNil(t, sqltypesExpr)
 			env := evalengine.ExpressionEnv{
 				BindVars: map[string]*querypb.BindVariable{
-					"exp":                  sqltypes.Int64BindVariable(66),
-					"string_bind_variable": sqltypes.StringBindVariable("bar"),
-					"uint64_bind_variable": sqltypes.Uint64BindVariable(22),
-					"float_bind_variable":  sqltypes.Float64BindVariable(2.2),
+					"exp":                    sqltypes.Int64BindVariable(66),
+					"string_bind_variable":   sqltypes.StringBindVariable("bar"),
+					"uint64_bind_variable":   sqltypes.Uint64BindVariable(22),
+					"float_bind_variable":    sqltypes.Float64BindVariable(2.2),
+					"date_bind_variable":     sqltypes.ValueBindVariable(sqltypes.MakeTrusted(sqltypes.Date, []byte("2021-01-15"))),
+					"datetime_bind_variable": sqltypes.ValueBindVariable(sqltypes.MakeTrusted(sqltypes.Datetime, []byte("2021-01-15 10:20:30"))),
+					"json_bind_variable":     sqltypes.ValueBindVariable(sqltypes.MakeTrusted(sqltypes.TypeJSON, []byte(`{"a": 1, "b": [2, {"x": "y", "long": [true, null]}]}`))),
+					"null_bind_variable":     sqltypes.NullBindVariable,
 				},
 				Row: nil,
 			}
@@ -100,3 +182,39 @@ func TestEvaluate(t *testing.T) {
 		})
 	}
 }
+
+func TestEvaluateJSONOperators(t *testing.T) {
+	// The grammar only takes a column on the left of -> and ->>, which the
+	// callers of Convert evaluate themselves, so the tests use a bind variable.
+	env := evalengine.ExpressionEnv{
+		BindVars: map[string]*querypb.BindVariable{
+			"doc": sqltypes.ValueBindVariable(sqltypes.MakeTrusted(sqltypes.TypeJSON, []byte(`{"a": [1, "x"]}`))),
+		},
+	}
+
+	tests := []struct {
+		operator BinaryExprOperator
+		expected sqltypes.Value
+	}{{
+		operator: JSONExtractOp,
+		expected: sqltypes.MakeTrusted(sqltypes.TypeJSON, []byte(`"x"`)),
+	}, {
+		operator: JSONUnquoteExtractOp,
+		expected: sqltypes.NewVarChar("x"),
+	}}
+
+	for _, test := range tests {
+		t.Run(test.operator.ToString(), func(t *testing.T) {
+			expr, err := Convert(&BinaryExpr{
+				Left:     NewArgument("doc"),
+				Operator: test.operator,
+				Right:    NewStrLiteral("$.a[1]"),
+			})
+			require.NoError(t, err)
+
+			r, err := expr.Evaluate(env)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, r.Value())
+		})
+	}
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlparser
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cespare/xxhash/v2"
+)
+
+// The fingerprint of a query identifies the queries that only differ in
+// their values, so that vtgate and vttablet can report them under the same
+// name, and tools can correlate the logs of both. The fingerprint is the
+// xxhash64, in 16 hex digits, of the fingerprint text of the query, which is
+// the query formatted by String with these changes:
+//   - every literal and bind variable is replaced by '?', so the text has
+//     none of the values of the query, and a query gets the same fingerprint
+//     before and after its values are normalized to bind variables,
+//   - tuples of values, such as IN lists, the bind variables of IN lists and
+//     the rows of VALUES, are collapsed to '(...)', whatever their length,
+//   - comments are dropped.
+// As with String, keywords are lowercase and the spacing and quoting of
+// identifiers are those of the formatter, whatever they were in the query.
+
+// FingerprintText returns the text that the fingerprint of stmt is the hash
+// of.
+func FingerprintText(stmt Statement) string {
+	buf := NewTrackedBuffer(formatFingerprint)
+	buf.Myprintf("%v", stmt)
+	return buf.String()
+}
+
+// Fingerprint returns the fingerprint of stmt.
+func Fingerprint(stmt Statement) string {
+	return hashFingerprintText(FingerprintText(stmt))
+}
+
+// FingerprintQuery returns the fingerprint of sql. A query that does not
+// parse gets the fingerprint of its text without the comments around it,
+// so that every query has one.
+func FingerprintQuery(sql string) string {
+	sql, _ = SplitMarginComments(sql)
+	stmt, err := Parse(sql)
+	if err != nil {
+		return hashFingerprintText(strings.TrimSpace(sql))
+	}
+	return Fingerprint(stmt)
+}
+
+func hashFingerprintText(text string) string {
+	return fmt.Sprintf("%016x", xxhash.Sum64String(text))
+}
+
+func formatFingerprint(buf *TrackedBuffer, node SQLNode) {
+	switch node := node.(type) {
+	case *Literal, Argument:
+		buf.WriteByte('?')
+	case ListArg:
+		buf.WriteString("(...)")
+	case ValTuple:
+		if !isValueTuple(node) {
+			node.Format(buf)
+			return
+		}
+		buf.WriteString("(...)")
+	case Values:
+		for _, row := range node {
+			if !isValueTuple(row) {
+				node.Format(buf)
+				return
+			}
+		}
+		buf.WriteString("values (...)")
+	case Comments:
+	default:
+		node.Format(buf)
+	}
+}
+
+// isValueTuple returns true if the tuple only holds values, which its
+// fingerprint does not tell apart.
+func isValueTuple(tuple ValTuple) bool {
+	for _, expr := range tuple {
+		switch expr := expr.(type) {
+		case *Literal, Argument, ListArg, *NullVal:
+		case ValTuple:
+			if !isValueTuple(expr) {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlparser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestFingerprintText(t *testing.T) {
+	testcases := []struct {
+		in, out string
+	}{{
+		in:  "SELECT a, B FROM `t` WHERE x = 1234 AND y = 'apple' and z = -1.5 limit 10",
+		out: "select a, B from t where x = ? and y = ? and z = ? limit ?",
+	}, {
+		in:  "select * from t where id = :id and name = ?",
+		out: "select * from t where id = ? and `name` = ?",
+	}, {
+		in:  "select /* comment */ * from t where id in (1, 2, 3) and b not in ::list",
+		out: "select * from t where id in (...) and b not in (...)",
+	}, {
+		in:  "select * from t where (a, b) in ((1, 2), (3, null))",
+		out: "select * from t where (a, b) in (...)",
+	}, {
+		in:  "select * from t where id in (1, a + 1)",
+		out: "select * from t where id in (?, a + ?)",
+	}, {
+		in:  "insert into t(a, b) values (1, 'x'), (2, 'y')",
+		out: "insert into t(a, b) values (...)",
+	}, {
+		in:  "insert into t(a, b) values (1, now())",
+		out: "insert into t(a, b) values (?, now())",
+	}, {
+		in:  "update t set a = X'0a', b = null where c is null",
+		out: "update t set a = ?, b = null where c is null",
+	}}
+	for _, tc := range testcases {
+		t.Run(tc.in, func(t *testing.T) {
+			stmt, err := Parse(tc.in)
+			require.NoError(t, err)
+			assert.Equal(t, tc.out, FingerprintText(stmt))
+		})
+	}
+}
+
+func TestFingerprintOfNormalizedQuery(t *testing.T) {
+	// vtgate normalizes the values of the queries to bind variables, and
+	// vttablet gets the values of IN lists as a list bind variable, which
+	// must give the fingerprint of the query of the application.
+	sql := "select a from t where b = 1 and c in (1, 2, 3)"
+	fingerprint := FingerprintQuery("/* leading */ " + sql + " /* trailing */")
+	assert.Equal(t, FingerprintQuery(sql), fingerprint)
+	assert.Len(t, fingerprint, 16)
+
+	stmt, reserved, err := Parse2(sql)
+	require.NoError(t, err)
+	err = Normalize(stmt, NewReservedVars("vtg", reserved), map[string]*querypb.BindVariable{})
+	require.NoError(t, err)
+	assert.Equal(t, fingerprint, Fingerprint(stmt))
+	assert.Equal(t, fingerprint, FingerprintQuery("select a from t where b = :vtg1 and c in ::__vals"))
+
+	assert.NotEqual(t, fingerprint, FingerprintQuery("select a from t where b = 1 and d in (1, 2, 3)"))
+	assert.Equal(t, FingerprintQuery("not a query"), FingerprintQuery("/* comment */ not a query"))
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlparser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatImpossibleQuery(t *testing.T) {
+	tests := []struct {
+		in, out string
+	}{{
+		in:  "select a, b from t where c = 1 order by a limit 10",
+		out: "select a, b from t where 1 != 1",
+	}, {
+		in:  "select a, count(*) from t, u where t.c = u.c group by a having count(*) > 1",
+		out: "select a, count(*) from t, u where 1 != 1 group by a",
+	}, {
+		in:  "select a, sum(b) over w from t window w as (partition by a) order by a",
+		out: "select a, sum(b) over w from t where 1 != 1 window w as (partition by a)",
+	}, {
+		in:  "select a, sum(b) over w from t group by a, b window w as (partition by a)",
+		out: "select a, sum(b) over w from t where 1 != 1 group by a, b window w as (partition by a)",
+	}, {
+		in:  "select a from t where b = 1 union select a from u",
+		out: "select a from t where 1 != 1 union select a from u where 1 != 1",
+	}}
+	for _, tc := range tests {
+		t.Run(tc.in, func(t *testing.T) {
+			stmt, err := Parse(tc.in)
+			require.NoError(t, err)
+			buf := NewTrackedBuffer(FormatImpossibleQuery)
+			buf.Myprintf("%v", stmt)
+			assert.Equal(t, tc.out, buf.String())
+		})
+	}
+}
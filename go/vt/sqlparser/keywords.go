@@ -142,6 +142,7 @@ var keywords = []keyword{
 	{"create", CREATE},
 	{"cross", CROSS},
 	{"csv", CSV},
+	{"current", CURRENT},
 	{"current_date", CURRENT_DATE},
 	{"current_time", CURRENT_TIME},
 	{"current_timestamp", CURRENT_TIMESTAMP},
@@ -197,6 +198,7 @@ var keywords = []keyword{
 	{"escape", ESCAPE},
 	{"escaped", ESCAPED},
 	{"event", EVENT},
+	{"except", EXCEPT},
 	{"exchange", EXCHANGE},
 	{"exclusive", EXCLUSIVE},
 	{"exists", EXISTS},
@@ -215,6 +217,7 @@ var keywords = []keyword{
 	{"float4", UNUSED},
 	{"float8", UNUSED},
 	{"flush", FLUSH},
+	{"following", FOLLOWING},
 	{"for", FOR},
 	{"force", FORCE},
 	{"foreign", FOREIGN},
@@ -262,6 +265,7 @@ var keywords = []keyword{
 	{"int4", UNUSED},
 	{"int8", UNUSED},
 	{"integer", INTEGER},
+	{"intersect", INTERSECT},
 	{"interval", INTERVAL},
 	{"into", INTO},
 	{"io_after_gtids", UNUSED},
@@ -354,7 +358,7 @@ var keywords = []keyword{
 	{"out", UNUSED},
 	{"outer", OUTER},
 	{"outfile", OUTFILE},
-	{"over", UNUSED},
+	{"over", OVER},
 	{"overwrite", OVERWRITE},
 	{"pack_keys", PACK_KEYS},
 	{"parser", PARSER},
@@ -362,16 +366,18 @@ var keywords = []keyword{
 	{"partitioning", PARTITIONING},
 	{"password", PASSWORD},
 	{"percent_rank", UNUSED},
+	{"plan", PLAN},
 	{"plugins", PLUGINS},
 	{"point", POINT},
 	{"polygon", POLYGON},
+	{"preceding", PRECEDING},
 	{"precision", UNUSED},
 	{"primary", PRIMARY},
 	{"privileges", PRIVILEGES},
 	{"processlist", PROCESSLIST},
 	{"procedure", PROCEDURE},
 	{"query", QUERY},
-	{"range", UNUSED},
+	{"range", RANGE},
 	{"rank", UNUSED},
 	{"read", READ},
 	{"reads", UNUSED},
@@ -401,10 +407,10 @@ var keywords = []keyword{
 	{"right", RIGHT},
 	{"rlike", REGEXP},
 	{"rollback", ROLLBACK},
-	{"row", UNUSED},
+	{"row", ROW},
 	{"row_format", ROW_FORMAT},
 	{"row_number", UNUSED},
-	{"rows", UNUSED},
+	{"rows", ROWS},
 	{"s3", S3},
 	{"savepoint", SAVEPOINT},
 	{"schema", SCHEMA},
@@ -474,6 +480,7 @@ var keywords = []keyword{
 	{"triggers", TRIGGERS},
 	{"true", TRUE},
 	{"truncate", TRUNCATE},
+	{"unbounded", UNBOUNDED},
 	{"uncommitted", UNCOMMITTED},
 	{"undefined", UNDEFINED},
 	{"undo", UNUSED},
@@ -498,12 +505,14 @@ var keywords = []keyword{
 	{"varchar", VARCHAR},
 	{"varcharacter", UNUSED},
 	{"varying", UNUSED},
+	{"vexplain", VEXPLAIN},
 	{"vgtid_executed", VGTID_EXECUTED},
 	{"virtual", VIRTUAL},
 	{"vindex", VINDEX},
 	{"vindexes", VINDEXES},
 	{"view", VIEW},
 	{"vitess", VITESS},
+	{"vitess_ddl_status", VITESS_DDL_STATUS},
 	{"vitess_keyspaces", VITESS_KEYSPACES},
 	{"vitess_metadata", VITESS_METADATA},
 	{"vitess_shards", VITESS_SHARDS},
@@ -515,7 +524,7 @@ var keywords = []keyword{
 	{"when", WHEN},
 	{"where", WHERE},
 	{"while", UNUSED},
-	{"window", UNUSED},
+	{"window", WINDOW},
 	{"with", WITH},
 	{"without", WITHOUT},
 	{"work", WORK},
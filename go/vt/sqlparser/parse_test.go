@@ -173,6 +173,20 @@ var (
 		input: "(select 1 from dual order by 1 desc) order by 1 asc limit 2",
 	}, {
 		input: "(select 1 from dual)",
+	}, {
+		input: "select /* intersect */ 1 from t intersect select 1 from t",
+	}, {
+		input:  "select /* intersect distinct */ 1 from t intersect distinct select 1 from t",
+		output: "select /* intersect distinct */ 1 from t intersect select 1 from t",
+	}, {
+		input: "select /* except all */ 1 from t except all select 1 from t order by 1 asc",
+	}, {
+		input:  "select /* intersect precedence */ 1 from t union select 2 from t intersect select 3 from t except select 4 from t",
+		output: "select /* intersect precedence */ 1 from t union (select 2 from t intersect select 3 from t) except select 4 from t",
+	}, {
+		input: "select /* intersect precedence */ 1 from t intersect select 2 from t union select 3 from t",
+	}, {
+		input: "select * from t1 where col in (select 1 from dual except select 2 from dual)",
 	}, {
 		input: "((select 1 from dual))",
 	}, {
@@ -485,6 +499,20 @@ var (
 		input: "select /* function with many params */ 1 from t where a = b(c, d)",
 	}, {
 		input: "select /* function with distinct */ count(distinct a) from t",
+	}, {
+		input: "select /* window function */ row_number() over (), rank() over w from t",
+	}, {
+		input: "select /* window function */ a, sum(b) over (partition by a, c order by d desc) from t",
+	}, {
+		input:  "select /* window frame */ avg(a) over (order by b rows 2 preceding), avg(a) over (order by b range between interval 1 day preceding and current row) from t",
+		output: "select /* window frame */ avg(a) over (order by b asc rows 2 preceding), avg(a) over (order by b asc range between interval 1 day preceding and current row) from t",
+	}, {
+		input: "select /* window frame */ first_value(a) over (partition by b rows between unbounded preceding and unbounded following), lag(a, 1) over (w rows between 1 following and 3 following) from t",
+	}, {
+		input: "select /* window clause */ ntile(4) over w2 from t window w1 as (partition by a), w2 as (w1 order by b asc)",
+	}, {
+		input:  "select /* window keywords as columns */ current, rows, `range` from t having row > 1",
+		output: "select /* window keywords as columns */ `current`, `rows`, `range` from t having `row` > 1",
 	}, {
 		input:  "select count(distinctrow(1)) from (select (1) from dual union all select 1 from dual) a",
 		output: "select count(distinct 1) from (select 1 from dual union all select 1 from dual) as a",
@@ -1641,6 +1669,13 @@ var (
 		input: "show vitess_migrations like '9748c3b7_7fdb_11eb_ac2c_f875a4d24e90'",
 	}, {
 		input: "show vitess_migration '9748c3b7_7fdb_11eb_ac2c_f875a4d24e90' logs",
+	}, {
+		input: "show vitess_ddl_status",
+	}, {
+		input:  "show vitess_ddl_status '9748c3b7_7fdb_11eb_ac2c_f875a4d24e90'",
+		output: "show vitess_ddl_status where uuid = '9748c3b7_7fdb_11eb_ac2c_f875a4d24e90'",
+	}, {
+		input: "show vitess_ddl_status like '9748c3b7%'",
 	}, {
 		input: "revert vitess_migration '9748c3b7_7fdb_11eb_ac2c_f875a4d24e90'",
 	}, {
@@ -1718,6 +1753,19 @@ var (
 		input: "explain insert into t(col1, col2) values (1, 2)",
 	}, {
 		input: "explain update t set col = 2",
+	}, {
+		input: "vexplain all select * from t",
+	}, {
+		input:  "vexplain all format=json update t set col = 2",
+		output: "vexplain all format = json update t set col = 2",
+	}, {
+		input: "vexplain plan select * from t join u on t.id = u.id",
+	}, {
+		input:  "vexplain plan format=json select * from t",
+		output: "vexplain plan format = json select * from t",
+	}, {
+		input:  "select plan from t",
+		output: "select `plan` from t",
 	}, {
 		input:  "truncate table foo",
 		output: "truncate table foo",
@@ -2092,6 +2140,25 @@ func TestParallelValid(t *testing.T) {
 	wg.Wait()
 }
 
+func TestColNamePositions(t *testing.T) {
+	sql := "select a, t.b from t where ks.t.c = 1 order by `d` /*! , e */"
+	tree, err := Parse(sql)
+	require.NoError(t, err)
+
+	var positions []string
+	_ = Walk(func(node SQLNode) (bool, error) {
+		if col, ok := node.(*ColName); ok {
+			positions = append(positions, fmt.Sprintf("%s:%d", String(col), col.Pos))
+		}
+		return true, nil
+	}, tree)
+	// the columns of a MySQL-specific comment are at the comment
+	assert.Equal(t, []string{"a:8", "t.b:11", "ks.t.c:28", "d:48", "e:52"}, positions)
+
+	// the position does not change what the column means
+	assert.True(t, EqualsExpr(NewColName("a"), &ColName{Name: NewColIdent("a"), Pos: 8}))
+}
+
 func TestInvalid(t *testing.T) {
 	invalidSQL := []struct {
 		input string
@@ -47,464 +47,472 @@ func bindVariable(yylex yyLexer, bvar string) {
 
 const LEX_ERROR = 57346
 const UNION = 57347
-const SELECT = 57348
-const STREAM = 57349
-const VSTREAM = 57350
-const INSERT = 57351
-const UPDATE = 57352
-const DELETE = 57353
-const FROM = 57354
-const WHERE = 57355
-const GROUP = 57356
-const HAVING = 57357
-const ORDER = 57358
-const BY = 57359
-const LIMIT = 57360
-const OFFSET = 57361
-const FOR = 57362
-const ALL = 57363
-const DISTINCT = 57364
-const AS = 57365
-const EXISTS = 57366
-const ASC = 57367
-const DESC = 57368
-const INTO = 57369
-const DUPLICATE = 57370
-const DEFAULT = 57371
-const SET = 57372
-const LOCK = 57373
-const UNLOCK = 57374
-const KEYS = 57375
-const DO = 57376
-const CALL = 57377
-const DISTINCTROW = 57378
-const PARSER = 57379
-const GENERATED = 57380
-const ALWAYS = 57381
-const OUTFILE = 57382
-const S3 = 57383
-const DATA = 57384
-const LOAD = 57385
-const LINES = 57386
-const TERMINATED = 57387
-const ESCAPED = 57388
-const ENCLOSED = 57389
-const DUMPFILE = 57390
-const CSV = 57391
-const HEADER = 57392
-const MANIFEST = 57393
-const OVERWRITE = 57394
-const STARTING = 57395
-const OPTIONALLY = 57396
-const VALUES = 57397
-const LAST_INSERT_ID = 57398
-const NEXT = 57399
-const VALUE = 57400
-const SHARE = 57401
-const MODE = 57402
-const SQL_NO_CACHE = 57403
-const SQL_CACHE = 57404
-const SQL_CALC_FOUND_ROWS = 57405
-const JOIN = 57406
-const STRAIGHT_JOIN = 57407
-const LEFT = 57408
-const RIGHT = 57409
-const INNER = 57410
-const OUTER = 57411
-const CROSS = 57412
-const NATURAL = 57413
-const USE = 57414
-const FORCE = 57415
-const ON = 57416
-const USING = 57417
-const INPLACE = 57418
-const COPY = 57419
-const ALGORITHM = 57420
-const NONE = 57421
-const SHARED = 57422
-const EXCLUSIVE = 57423
-const ID = 57424
-const AT_ID = 57425
-const AT_AT_ID = 57426
-const HEX = 57427
-const STRING = 57428
-const INTEGRAL = 57429
-const FLOAT = 57430
-const HEXNUM = 57431
-const VALUE_ARG = 57432
-const LIST_ARG = 57433
-const COMMENT = 57434
-const COMMENT_KEYWORD = 57435
-const BIT_LITERAL = 57436
-const COMPRESSION = 57437
-const NULL = 57438
-const TRUE = 57439
-const FALSE = 57440
-const OFF = 57441
-const DISCARD = 57442
-const IMPORT = 57443
-const ENABLE = 57444
-const DISABLE = 57445
-const TABLESPACE = 57446
-const VIRTUAL = 57447
-const STORED = 57448
-const LOWER_THAN_CHARSET = 57449
-const CHARSET = 57450
-const UNIQUE = 57451
-const KEY = 57452
-const OR = 57453
-const XOR = 57454
-const AND = 57455
-const NOT = 57456
-const BETWEEN = 57457
-const CASE = 57458
-const WHEN = 57459
-const THEN = 57460
-const ELSE = 57461
-const END = 57462
-const LE = 57463
-const GE = 57464
-const NE = 57465
-const NULL_SAFE_EQUAL = 57466
-const IS = 57467
-const LIKE = 57468
-const REGEXP = 57469
-const IN = 57470
-const SHIFT_LEFT = 57471
-const SHIFT_RIGHT = 57472
-const DIV = 57473
-const MOD = 57474
-const UNARY = 57475
-const COLLATE = 57476
-const BINARY = 57477
-const UNDERSCORE_BINARY = 57478
-const UNDERSCORE_UTF8MB4 = 57479
-const UNDERSCORE_UTF8 = 57480
-const UNDERSCORE_LATIN1 = 57481
-const INTERVAL = 57482
-const JSON_EXTRACT_OP = 57483
-const JSON_UNQUOTE_EXTRACT_OP = 57484
-const CREATE = 57485
-const ALTER = 57486
-const DROP = 57487
-const RENAME = 57488
-const ANALYZE = 57489
-const ADD = 57490
-const FLUSH = 57491
-const CHANGE = 57492
-const MODIFY = 57493
-const REVERT = 57494
-const SCHEMA = 57495
-const TABLE = 57496
-const INDEX = 57497
-const VIEW = 57498
-const TO = 57499
-const IGNORE = 57500
-const IF = 57501
-const PRIMARY = 57502
-const COLUMN = 57503
-const SPATIAL = 57504
-const FULLTEXT = 57505
-const KEY_BLOCK_SIZE = 57506
-const CHECK = 57507
-const INDEXES = 57508
-const ACTION = 57509
-const CASCADE = 57510
-const CONSTRAINT = 57511
-const FOREIGN = 57512
-const NO = 57513
-const REFERENCES = 57514
-const RESTRICT = 57515
-const SHOW = 57516
-const DESCRIBE = 57517
-const EXPLAIN = 57518
-const DATE = 57519
-const ESCAPE = 57520
-const REPAIR = 57521
-const OPTIMIZE = 57522
-const TRUNCATE = 57523
-const COALESCE = 57524
-const EXCHANGE = 57525
-const REBUILD = 57526
-const PARTITIONING = 57527
-const REMOVE = 57528
-const MAXVALUE = 57529
-const PARTITION = 57530
-const REORGANIZE = 57531
-const LESS = 57532
-const THAN = 57533
-const PROCEDURE = 57534
-const TRIGGER = 57535
-const VINDEX = 57536
-const VINDEXES = 57537
-const DIRECTORY = 57538
-const NAME = 57539
-const UPGRADE = 57540
-const STATUS = 57541
-const VARIABLES = 57542
-const WARNINGS = 57543
-const CASCADED = 57544
-const DEFINER = 57545
-const OPTION = 57546
-const SQL = 57547
-const UNDEFINED = 57548
-const SEQUENCE = 57549
-const MERGE = 57550
-const TEMPORARY = 57551
-const TEMPTABLE = 57552
-const INVOKER = 57553
-const SECURITY = 57554
-const FIRST = 57555
-const AFTER = 57556
-const LAST = 57557
-const VITESS_MIGRATION = 57558
-const CANCEL = 57559
-const RETRY = 57560
-const COMPLETE = 57561
-const BEGIN = 57562
-const START = 57563
-const TRANSACTION = 57564
-const COMMIT = 57565
-const ROLLBACK = 57566
-const SAVEPOINT = 57567
-const RELEASE = 57568
-const WORK = 57569
-const BIT = 57570
-const TINYINT = 57571
-const SMALLINT = 57572
-const MEDIUMINT = 57573
-const INT = 57574
-const INTEGER = 57575
-const BIGINT = 57576
-const INTNUM = 57577
-const REAL = 57578
-const DOUBLE = 57579
-const FLOAT_TYPE = 57580
-const DECIMAL = 57581
-const NUMERIC = 57582
-const TIME = 57583
-const TIMESTAMP = 57584
-const DATETIME = 57585
-const YEAR = 57586
-const CHAR = 57587
-const VARCHAR = 57588
-const BOOL = 57589
-const CHARACTER = 57590
-const VARBINARY = 57591
-const NCHAR = 57592
-const TEXT = 57593
-const TINYTEXT = 57594
-const MEDIUMTEXT = 57595
-const LONGTEXT = 57596
-const BLOB = 57597
-const TINYBLOB = 57598
-const MEDIUMBLOB = 57599
-const LONGBLOB = 57600
-const JSON = 57601
-const ENUM = 57602
-const GEOMETRY = 57603
-const POINT = 57604
-const LINESTRING = 57605
-const POLYGON = 57606
-const GEOMETRYCOLLECTION = 57607
-const MULTIPOINT = 57608
-const MULTILINESTRING = 57609
-const MULTIPOLYGON = 57610
-const NULLX = 57611
-const AUTO_INCREMENT = 57612
-const APPROXNUM = 57613
-const SIGNED = 57614
-const UNSIGNED = 57615
-const ZEROFILL = 57616
-const CODE = 57617
-const COLLATION = 57618
-const COLUMNS = 57619
-const DATABASES = 57620
-const ENGINES = 57621
-const EVENT = 57622
-const EXTENDED = 57623
-const FIELDS = 57624
-const FULL = 57625
-const FUNCTION = 57626
-const GTID_EXECUTED = 57627
-const KEYSPACES = 57628
-const OPEN = 57629
-const PLUGINS = 57630
-const PRIVILEGES = 57631
-const PROCESSLIST = 57632
-const SCHEMAS = 57633
-const TABLES = 57634
-const TRIGGERS = 57635
-const USER = 57636
-const VGTID_EXECUTED = 57637
-const VITESS_KEYSPACES = 57638
-const VITESS_METADATA = 57639
-const VITESS_MIGRATIONS = 57640
-const VITESS_SHARDS = 57641
-const VITESS_TABLETS = 57642
-const VSCHEMA = 57643
-const NAMES = 57644
-const GLOBAL = 57645
-const SESSION = 57646
-const ISOLATION = 57647
-const LEVEL = 57648
-const READ = 57649
-const WRITE = 57650
-const ONLY = 57651
-const REPEATABLE = 57652
-const COMMITTED = 57653
-const UNCOMMITTED = 57654
-const SERIALIZABLE = 57655
-const CURRENT_TIMESTAMP = 57656
-const DATABASE = 57657
-const CURRENT_DATE = 57658
-const CURRENT_TIME = 57659
-const LOCALTIME = 57660
-const LOCALTIMESTAMP = 57661
-const CURRENT_USER = 57662
-const UTC_DATE = 57663
-const UTC_TIME = 57664
-const UTC_TIMESTAMP = 57665
-const REPLACE = 57666
-const CONVERT = 57667
-const CAST = 57668
-const SUBSTR = 57669
-const SUBSTRING = 57670
-const GROUP_CONCAT = 57671
-const SEPARATOR = 57672
-const TIMESTAMPADD = 57673
-const TIMESTAMPDIFF = 57674
-const MATCH = 57675
-const AGAINST = 57676
-const BOOLEAN = 57677
-const LANGUAGE = 57678
-const WITH = 57679
-const QUERY = 57680
-const EXPANSION = 57681
-const WITHOUT = 57682
-const VALIDATION = 57683
-const UNUSED = 57684
-const ARRAY = 57685
-const CUME_DIST = 57686
-const DESCRIPTION = 57687
-const DENSE_RANK = 57688
-const EMPTY = 57689
-const EXCEPT = 57690
-const FIRST_VALUE = 57691
-const GROUPING = 57692
-const GROUPS = 57693
-const JSON_TABLE = 57694
-const LAG = 57695
-const LAST_VALUE = 57696
-const LATERAL = 57697
-const LEAD = 57698
-const MEMBER = 57699
-const NTH_VALUE = 57700
-const NTILE = 57701
-const OF = 57702
-const OVER = 57703
-const PERCENT_RANK = 57704
-const RANK = 57705
-const RECURSIVE = 57706
-const ROW_NUMBER = 57707
-const SYSTEM = 57708
-const WINDOW = 57709
-const ACTIVE = 57710
-const ADMIN = 57711
-const BUCKETS = 57712
-const CLONE = 57713
-const COMPONENT = 57714
-const DEFINITION = 57715
-const ENFORCED = 57716
-const EXCLUDE = 57717
-const FOLLOWING = 57718
-const GEOMCOLLECTION = 57719
-const GET_MASTER_PUBLIC_KEY = 57720
-const HISTOGRAM = 57721
-const HISTORY = 57722
-const INACTIVE = 57723
-const INVISIBLE = 57724
-const LOCKED = 57725
-const MASTER_COMPRESSION_ALGORITHMS = 57726
-const MASTER_PUBLIC_KEY_PATH = 57727
-const MASTER_TLS_CIPHERSUITES = 57728
-const MASTER_ZSTD_COMPRESSION_LEVEL = 57729
-const NESTED = 57730
-const NETWORK_NAMESPACE = 57731
-const NOWAIT = 57732
-const NULLS = 57733
-const OJ = 57734
-const OLD = 57735
-const OPTIONAL = 57736
-const ORDINALITY = 57737
-const ORGANIZATION = 57738
-const OTHERS = 57739
-const PATH = 57740
-const PERSIST = 57741
-const PERSIST_ONLY = 57742
-const PRECEDING = 57743
-const PRIVILEGE_CHECKS_USER = 57744
-const PROCESS = 57745
-const RANDOM = 57746
-const REFERENCE = 57747
-const REQUIRE_ROW_FORMAT = 57748
-const RESOURCE = 57749
-const RESPECT = 57750
-const RESTART = 57751
-const RETAIN = 57752
-const REUSE = 57753
-const ROLE = 57754
-const SECONDARY = 57755
-const SECONDARY_ENGINE = 57756
-const SECONDARY_LOAD = 57757
-const SECONDARY_UNLOAD = 57758
-const SKIP = 57759
-const SRID = 57760
-const THREAD_PRIORITY = 57761
-const TIES = 57762
-const UNBOUNDED = 57763
-const VCPU = 57764
-const VISIBLE = 57765
-const FORMAT = 57766
-const TREE = 57767
-const VITESS = 57768
-const TRADITIONAL = 57769
-const LOCAL = 57770
-const LOW_PRIORITY = 57771
-const NO_WRITE_TO_BINLOG = 57772
-const LOGS = 57773
-const ERROR = 57774
-const GENERAL = 57775
-const HOSTS = 57776
-const OPTIMIZER_COSTS = 57777
-const USER_RESOURCES = 57778
-const SLOW = 57779
-const CHANNEL = 57780
-const RELAY = 57781
-const EXPORT = 57782
-const AVG_ROW_LENGTH = 57783
-const CONNECTION = 57784
-const CHECKSUM = 57785
-const DELAY_KEY_WRITE = 57786
-const ENCRYPTION = 57787
-const ENGINE = 57788
-const INSERT_METHOD = 57789
-const MAX_ROWS = 57790
-const MIN_ROWS = 57791
-const PACK_KEYS = 57792
-const PASSWORD = 57793
-const FIXED = 57794
-const DYNAMIC = 57795
-const COMPRESSED = 57796
-const REDUNDANT = 57797
-const COMPACT = 57798
-const ROW_FORMAT = 57799
-const STATS_AUTO_RECALC = 57800
-const STATS_PERSISTENT = 57801
-const STATS_SAMPLE_PAGES = 57802
-const STORAGE = 57803
-const MEMORY = 57804
-const DISK = 57805
+const INTERSECT = 57348
+const EXCEPT = 57349
+const SELECT = 57350
+const STREAM = 57351
+const VSTREAM = 57352
+const INSERT = 57353
+const UPDATE = 57354
+const DELETE = 57355
+const FROM = 57356
+const WHERE = 57357
+const GROUP = 57358
+const HAVING = 57359
+const ORDER = 57360
+const BY = 57361
+const LIMIT = 57362
+const OFFSET = 57363
+const FOR = 57364
+const ALL = 57365
+const DISTINCT = 57366
+const AS = 57367
+const EXISTS = 57368
+const ASC = 57369
+const DESC = 57370
+const INTO = 57371
+const DUPLICATE = 57372
+const DEFAULT = 57373
+const SET = 57374
+const LOCK = 57375
+const UNLOCK = 57376
+const KEYS = 57377
+const DO = 57378
+const CALL = 57379
+const DISTINCTROW = 57380
+const PARSER = 57381
+const GENERATED = 57382
+const ALWAYS = 57383
+const OUTFILE = 57384
+const S3 = 57385
+const DATA = 57386
+const LOAD = 57387
+const LINES = 57388
+const TERMINATED = 57389
+const ESCAPED = 57390
+const ENCLOSED = 57391
+const DUMPFILE = 57392
+const CSV = 57393
+const HEADER = 57394
+const MANIFEST = 57395
+const OVERWRITE = 57396
+const STARTING = 57397
+const OPTIONALLY = 57398
+const VALUES = 57399
+const LAST_INSERT_ID = 57400
+const NEXT = 57401
+const VALUE = 57402
+const SHARE = 57403
+const MODE = 57404
+const SQL_NO_CACHE = 57405
+const SQL_CACHE = 57406
+const SQL_CALC_FOUND_ROWS = 57407
+const JOIN = 57408
+const STRAIGHT_JOIN = 57409
+const LEFT = 57410
+const RIGHT = 57411
+const INNER = 57412
+const OUTER = 57413
+const CROSS = 57414
+const NATURAL = 57415
+const USE = 57416
+const FORCE = 57417
+const ON = 57418
+const USING = 57419
+const INPLACE = 57420
+const COPY = 57421
+const ALGORITHM = 57422
+const NONE = 57423
+const SHARED = 57424
+const EXCLUSIVE = 57425
+const ID = 57426
+const AT_ID = 57427
+const AT_AT_ID = 57428
+const HEX = 57429
+const STRING = 57430
+const INTEGRAL = 57431
+const FLOAT = 57432
+const HEXNUM = 57433
+const VALUE_ARG = 57434
+const LIST_ARG = 57435
+const COMMENT = 57436
+const COMMENT_KEYWORD = 57437
+const BIT_LITERAL = 57438
+const COMPRESSION = 57439
+const NULL = 57440
+const TRUE = 57441
+const FALSE = 57442
+const OFF = 57443
+const DISCARD = 57444
+const IMPORT = 57445
+const ENABLE = 57446
+const DISABLE = 57447
+const TABLESPACE = 57448
+const VIRTUAL = 57449
+const STORED = 57450
+const LOWER_THAN_CHARSET = 57451
+const CHARSET = 57452
+const UNBOUNDED = 57453
+const PRECEDING = 57454
+const FOLLOWING = 57455
+const UNIQUE = 57456
+const KEY = 57457
+const OR = 57458
+const XOR = 57459
+const AND = 57460
+const NOT = 57461
+const BETWEEN = 57462
+const CASE = 57463
+const WHEN = 57464
+const THEN = 57465
+const ELSE = 57466
+const END = 57467
+const LE = 57468
+const GE = 57469
+const NE = 57470
+const NULL_SAFE_EQUAL = 57471
+const IS = 57472
+const LIKE = 57473
+const REGEXP = 57474
+const IN = 57475
+const SHIFT_LEFT = 57476
+const SHIFT_RIGHT = 57477
+const DIV = 57478
+const MOD = 57479
+const UNARY = 57480
+const COLLATE = 57481
+const BINARY = 57482
+const UNDERSCORE_BINARY = 57483
+const UNDERSCORE_UTF8MB4 = 57484
+const UNDERSCORE_UTF8 = 57485
+const UNDERSCORE_LATIN1 = 57486
+const INTERVAL = 57487
+const JSON_EXTRACT_OP = 57488
+const JSON_UNQUOTE_EXTRACT_OP = 57489
+const CREATE = 57490
+const ALTER = 57491
+const DROP = 57492
+const RENAME = 57493
+const ANALYZE = 57494
+const ADD = 57495
+const FLUSH = 57496
+const CHANGE = 57497
+const MODIFY = 57498
+const REVERT = 57499
+const SCHEMA = 57500
+const TABLE = 57501
+const INDEX = 57502
+const VIEW = 57503
+const TO = 57504
+const IGNORE = 57505
+const IF = 57506
+const PRIMARY = 57507
+const COLUMN = 57508
+const SPATIAL = 57509
+const FULLTEXT = 57510
+const KEY_BLOCK_SIZE = 57511
+const CHECK = 57512
+const INDEXES = 57513
+const ACTION = 57514
+const CASCADE = 57515
+const CONSTRAINT = 57516
+const FOREIGN = 57517
+const NO = 57518
+const REFERENCES = 57519
+const RESTRICT = 57520
+const SHOW = 57521
+const DESCRIBE = 57522
+const EXPLAIN = 57523
+const DATE = 57524
+const ESCAPE = 57525
+const REPAIR = 57526
+const OPTIMIZE = 57527
+const TRUNCATE = 57528
+const COALESCE = 57529
+const EXCHANGE = 57530
+const REBUILD = 57531
+const PARTITIONING = 57532
+const REMOVE = 57533
+const MAXVALUE = 57534
+const PARTITION = 57535
+const REORGANIZE = 57536
+const LESS = 57537
+const THAN = 57538
+const PROCEDURE = 57539
+const TRIGGER = 57540
+const VINDEX = 57541
+const VINDEXES = 57542
+const DIRECTORY = 57543
+const NAME = 57544
+const UPGRADE = 57545
+const STATUS = 57546
+const VARIABLES = 57547
+const WARNINGS = 57548
+const CASCADED = 57549
+const DEFINER = 57550
+const OPTION = 57551
+const SQL = 57552
+const UNDEFINED = 57553
+const SEQUENCE = 57554
+const MERGE = 57555
+const TEMPORARY = 57556
+const TEMPTABLE = 57557
+const INVOKER = 57558
+const SECURITY = 57559
+const FIRST = 57560
+const AFTER = 57561
+const LAST = 57562
+const VITESS_MIGRATION = 57563
+const CANCEL = 57564
+const RETRY = 57565
+const COMPLETE = 57566
+const BEGIN = 57567
+const START = 57568
+const TRANSACTION = 57569
+const COMMIT = 57570
+const ROLLBACK = 57571
+const SAVEPOINT = 57572
+const RELEASE = 57573
+const WORK = 57574
+const BIT = 57575
+const TINYINT = 57576
+const SMALLINT = 57577
+const MEDIUMINT = 57578
+const INT = 57579
+const INTEGER = 57580
+const BIGINT = 57581
+const INTNUM = 57582
+const REAL = 57583
+const DOUBLE = 57584
+const FLOAT_TYPE = 57585
+const DECIMAL = 57586
+const NUMERIC = 57587
+const TIME = 57588
+const TIMESTAMP = 57589
+const DATETIME = 57590
+const YEAR = 57591
+const CHAR = 57592
+const VARCHAR = 57593
+const BOOL = 57594
+const CHARACTER = 57595
+const VARBINARY = 57596
+const NCHAR = 57597
+const TEXT = 57598
+const TINYTEXT = 57599
+const MEDIUMTEXT = 57600
+const LONGTEXT = 57601
+const BLOB = 57602
+const TINYBLOB = 57603
+const MEDIUMBLOB = 57604
+const LONGBLOB = 57605
+const JSON = 57606
+const ENUM = 57607
+const GEOMETRY = 57608
+const POINT = 57609
+const LINESTRING = 57610
+const POLYGON = 57611
+const GEOMETRYCOLLECTION = 57612
+const MULTIPOINT = 57613
+const MULTILINESTRING = 57614
+const MULTIPOLYGON = 57615
+const NULLX = 57616
+const AUTO_INCREMENT = 57617
+const APPROXNUM = 57618
+const SIGNED = 57619
+const UNSIGNED = 57620
+const ZEROFILL = 57621
+const CODE = 57622
+const COLLATION = 57623
+const COLUMNS = 57624
+const DATABASES = 57625
+const ENGINES = 57626
+const EVENT = 57627
+const EXTENDED = 57628
+const FIELDS = 57629
+const FULL = 57630
+const FUNCTION = 57631
+const GTID_EXECUTED = 57632
+const KEYSPACES = 57633
+const OPEN = 57634
+const PLAN = 57635
+const PLUGINS = 57636
+const PRIVILEGES = 57637
+const PROCESSLIST = 57638
+const SCHEMAS = 57639
+const TABLES = 57640
+const TRIGGERS = 57641
+const USER = 57642
+const VEXPLAIN = 57643
+const VGTID_EXECUTED = 57644
+const VITESS_DDL_STATUS = 57645
+const VITESS_KEYSPACES = 57646
+const VITESS_METADATA = 57647
+const VITESS_MIGRATIONS = 57648
+const VITESS_SHARDS = 57649
+const VITESS_TABLETS = 57650
+const VSCHEMA = 57651
+const NAMES = 57652
+const GLOBAL = 57653
+const SESSION = 57654
+const ISOLATION = 57655
+const LEVEL = 57656
+const READ = 57657
+const WRITE = 57658
+const ONLY = 57659
+const REPEATABLE = 57660
+const COMMITTED = 57661
+const UNCOMMITTED = 57662
+const SERIALIZABLE = 57663
+const CURRENT_TIMESTAMP = 57664
+const DATABASE = 57665
+const CURRENT_DATE = 57666
+const CURRENT_TIME = 57667
+const LOCALTIME = 57668
+const LOCALTIMESTAMP = 57669
+const CURRENT_USER = 57670
+const UTC_DATE = 57671
+const UTC_TIME = 57672
+const UTC_TIMESTAMP = 57673
+const REPLACE = 57674
+const CONVERT = 57675
+const CAST = 57676
+const SUBSTR = 57677
+const SUBSTRING = 57678
+const GROUP_CONCAT = 57679
+const SEPARATOR = 57680
+const TIMESTAMPADD = 57681
+const TIMESTAMPDIFF = 57682
+const MATCH = 57683
+const AGAINST = 57684
+const BOOLEAN = 57685
+const LANGUAGE = 57686
+const WITH = 57687
+const QUERY = 57688
+const EXPANSION = 57689
+const WITHOUT = 57690
+const VALIDATION = 57691
+const UNUSED = 57692
+const ARRAY = 57693
+const CUME_DIST = 57694
+const DESCRIPTION = 57695
+const DENSE_RANK = 57696
+const EMPTY = 57697
+const FIRST_VALUE = 57698
+const GROUPING = 57699
+const GROUPS = 57700
+const JSON_TABLE = 57701
+const LAG = 57702
+const LAST_VALUE = 57703
+const LATERAL = 57704
+const LEAD = 57705
+const MEMBER = 57706
+const NTH_VALUE = 57707
+const NTILE = 57708
+const OF = 57709
+const PERCENT_RANK = 57710
+const RANK = 57711
+const RECURSIVE = 57712
+const ROW_NUMBER = 57713
+const SYSTEM = 57714
+const ACTIVE = 57715
+const ADMIN = 57716
+const BUCKETS = 57717
+const CLONE = 57718
+const COMPONENT = 57719
+const DEFINITION = 57720
+const ENFORCED = 57721
+const EXCLUDE = 57722
+const GEOMCOLLECTION = 57723
+const GET_MASTER_PUBLIC_KEY = 57724
+const HISTOGRAM = 57725
+const HISTORY = 57726
+const INACTIVE = 57727
+const INVISIBLE = 57728
+const LOCKED = 57729
+const MASTER_COMPRESSION_ALGORITHMS = 57730
+const MASTER_PUBLIC_KEY_PATH = 57731
+const MASTER_TLS_CIPHERSUITES = 57732
+const MASTER_ZSTD_COMPRESSION_LEVEL = 57733
+const NESTED = 57734
+const NETWORK_NAMESPACE = 57735
+const NOWAIT = 57736
+const NULLS = 57737
+const OJ = 57738
+const OLD = 57739
+const OPTIONAL = 57740
+const ORDINALITY = 57741
+const ORGANIZATION = 57742
+const OTHERS = 57743
+const PATH = 57744
+const PERSIST = 57745
+const PERSIST_ONLY = 57746
+const PRIVILEGE_CHECKS_USER = 57747
+const PROCESS = 57748
+const RANDOM = 57749
+const REFERENCE = 57750
+const REQUIRE_ROW_FORMAT = 57751
+const RESOURCE = 57752
+const RESPECT = 57753
+const RESTART = 57754
+const RETAIN = 57755
+const REUSE = 57756
+const ROLE = 57757
+const SECONDARY = 57758
+const SECONDARY_ENGINE = 57759
+const SECONDARY_LOAD = 57760
+const SECONDARY_UNLOAD = 57761
+const SKIP = 57762
+const SRID = 57763
+const THREAD_PRIORITY = 57764
+const TIES = 57765
+const VCPU = 57766
+const VISIBLE = 57767
+const OVER = 57768
+const WINDOW = 57769
+const ROWS = 57770
+const RANGE = 57771
+const ROW = 57772
+const CURRENT = 57773
+const FORMAT = 57774
+const TREE = 57775
+const VITESS = 57776
+const TRADITIONAL = 57777
+const LOCAL = 57778
+const LOW_PRIORITY = 57779
+const NO_WRITE_TO_BINLOG = 57780
+const LOGS = 57781
+const ERROR = 57782
+const GENERAL = 57783
+const HOSTS = 57784
+const OPTIMIZER_COSTS = 57785
+const USER_RESOURCES = 57786
+const SLOW = 57787
+const CHANNEL = 57788
+const RELAY = 57789
+const EXPORT = 57790
+const AVG_ROW_LENGTH = 57791
+const CONNECTION = 57792
+const CHECKSUM = 57793
+const DELAY_KEY_WRITE = 57794
+const ENCRYPTION = 57795
+const ENGINE = 57796
+const INSERT_METHOD = 57797
+const MAX_ROWS = 57798
+const MIN_ROWS = 57799
+const PACK_KEYS = 57800
+const PASSWORD = 57801
+const FIXED = 57802
+const DYNAMIC = 57803
+const COMPRESSED = 57804
+const REDUNDANT = 57805
+const COMPACT = 57806
+const ROW_FORMAT = 57807
+const STATS_AUTO_RECALC = 57808
+const STATS_PERSISTENT = 57809
+const STATS_SAMPLE_PAGES = 57810
+const STORAGE = 57811
+const MEMORY = 57812
+const DISK = 57813
 
 var yyToknames = [...]string{
 	"$end",
@@ -512,6 +520,8 @@ var yyToknames = [...]string{
 	"$unk",
 	"LEX_ERROR",
 	"UNION",
+	"INTERSECT",
+	"EXCEPT",
 	"SELECT",
 	"STREAM",
 	"VSTREAM",
@@ -618,6 +628,9 @@ var yyToknames = [...]string{
 	"STORED",
 	"LOWER_THAN_CHARSET",
 	"CHARSET",
+	"UNBOUNDED",
+	"PRECEDING",
+	"FOLLOWING",
 	"UNIQUE",
 	"KEY",
 	"OR",
@@ -811,6 +824,7 @@ var yyToknames = [...]string{
 	"GTID_EXECUTED",
 	"KEYSPACES",
 	"OPEN",
+	"PLAN",
 	"PLUGINS",
 	"PRIVILEGES",
 	"PROCESSLIST",
@@ -818,7 +832,9 @@ var yyToknames = [...]string{
 	"TABLES",
 	"TRIGGERS",
 	"USER",
+	"VEXPLAIN",
 	"VGTID_EXECUTED",
+	"VITESS_DDL_STATUS",
 	"VITESS_KEYSPACES",
 	"VITESS_METADATA",
 	"VITESS_MIGRATIONS",
@@ -871,7 +887,6 @@ var yyToknames = [...]string{
 	"DESCRIPTION",
 	"DENSE_RANK",
 	"EMPTY",
-	"EXCEPT",
 	"FIRST_VALUE",
 	"GROUPING",
 	"GROUPS",
@@ -884,13 +899,11 @@ var yyToknames = [...]string{
 	"NTH_VALUE",
 	"NTILE",
 	"OF",
-	"OVER",
 	"PERCENT_RANK",
 	"RANK",
 	"RECURSIVE",
 	"ROW_NUMBER",
 	"SYSTEM",
-	"WINDOW",
 	"ACTIVE",
 	"ADMIN",
 	"BUCKETS",
@@ -899,7 +912,6 @@ var yyToknames = [...]string{
 	"DEFINITION",
 	"ENFORCED",
 	"EXCLUDE",
-	"FOLLOWING",
 	"GEOMCOLLECTION",
 	"GET_MASTER_PUBLIC_KEY",
 	"HISTOGRAM",
@@ -924,7 +936,6 @@ var yyToknames = [...]string{
 	"PATH",
 	"PERSIST",
 	"PERSIST_ONLY",
-	"PRECEDING",
 	"PRIVILEGE_CHECKS_USER",
 	"PROCESS",
 	"RANDOM",
@@ -944,9 +955,14 @@ var yyToknames = [...]string{
 	"SRID",
 	"THREAD_PRIORITY",
 	"TIES",
-	"UNBOUNDED",
 	"VCPU",
 	"VISIBLE",
+	"OVER",
+	"WINDOW",
+	"ROWS",
+	"RANGE",
+	"ROW",
+	"CURRENT",
 	"FORMAT",
 	"TREE",
 	"VITESS",
@@ -1003,3105 +1019,3333 @@ var yyExca = [...]int{
 	-2, 0,
 	-1, 45,
 	1, 112,
-	481, 112,
+	489, 112,
 	-2, 118,
 	-1, 46,
-	111, 118,
-	150, 118,
-	265, 118,
+	113, 118,
+	155, 118,
+	270, 118,
 	-2, 341,
 	-1, 53,
-	33, 490,
-	172, 490,
-	183, 490,
-	216, 504,
-	217, 504,
-	-2, 492,
+	35, 492,
+	177, 492,
+	188, 492,
+	221, 506,
+	222, 506,
+	-2, 494,
 	-1, 58,
-	174, 514,
-	-2, 512,
-	-1, 84,
-	57, 582,
-	-2, 590,
-	-1, 97,
-	171, 956,
+	179, 516,
+	-2, 514,
+	-1, 87,
+	59, 594,
+	-2, 602,
+	-1, 100,
+	176, 992,
 	-2, 91,
-	-1, 99,
+	-1, 102,
 	1, 113,
-	481, 113,
+	489, 113,
 	-2, 118,
-	-1, 109,
-	112, 244,
-	177, 244,
+	-1, 112,
+	117, 244,
+	182, 244,
 	-2, 335,
-	-1, 128,
-	111, 118,
-	150, 118,
-	265, 118,
+	-1, 131,
+	113, 118,
+	155, 118,
+	270, 118,
 	-2, 350,
-	-1, 570,
-	157, 977,
-	-2, 973,
-	-1, 571,
-	157, 978,
-	-2, 974,
-	-1, 590,
-	57, 583,
-	-2, 595,
-	-1, 591,
-	57, 584,
-	-2, 596,
-	-1, 612,
-	125, 1328,
+	-1, 583,
+	162, 1013,
+	-2, 1009,
+	-1, 584,
+	162, 1014,
+	-2, 1010,
+	-1, 607,
+	59, 595,
+	-2, 607,
+	-1, 608,
+	59, 596,
+	-2, 608,
+	-1, 629,
+	130, 1371,
 	-2, 84,
-	-1, 613,
-	125, 1209,
+	-1, 630,
+	130, 1248,
 	-2, 85,
-	-1, 619,
-	125, 1260,
-	-2, 950,
-	-1, 759,
-	125, 1143,
-	-2, 947,
-	-1, 795,
-	182, 38,
+	-1, 636,
+	130, 1299,
+	-2, 986,
+	-1, 778,
+	130, 1181,
+	-2, 983,
+	-1, 814,
 	187, 38,
+	192, 38,
 	-2, 255,
-	-1, 872,
+	-1, 891,
 	1, 388,
-	481, 388,
+	489, 388,
 	-2, 118,
-	-1, 1114,
+	-1, 1138,
 	1, 285,
-	481, 285,
+	489, 285,
 	-2, 118,
-	-1, 1117,
-	23, 137,
+	-1, 1141,
+	25, 137,
 	-2, 139,
-	-1, 1190,
-	112, 244,
-	177, 244,
+	-1, 1214,
+	117, 244,
+	182, 244,
 	-2, 335,
-	-1, 1199,
-	182, 39,
+	-1, 1223,
 	187, 39,
+	192, 39,
 	-2, 256,
-	-1, 1409,
-	157, 982,
-	-2, 976,
-	-1, 1500,
-	75, 66,
-	83, 66,
+	-1, 1436,
+	162, 1018,
+	-2, 1012,
+	-1, 1527,
+	77, 66,
+	85, 66,
 	-2, 70,
-	-1, 1521,
+	-1, 1548,
 	1, 286,
-	481, 286,
+	489, 286,
 	-2, 118,
-	-1, 1953,
-	5, 843,
-	18, 843,
-	20, 843,
-	31, 843,
-	84, 843,
-	-2, 622,
-	-1, 2187,
-	47, 918,
-	-2, 912,
+	-1, 1984,
+	5, 879,
+	6, 879,
+	7, 879,
+	20, 879,
+	22, 879,
+	33, 879,
+	86, 879,
+	-2, 634,
+	-1, 2228,
+	49, 954,
+	-2, 948,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 29669
+const yyLast = 31932
 
 var yyAct = [...]int{
-	570, 2107, 2283, 2012, 2240, 2227, 1740, 1778, 2164, 1785,
-	2188, 83, 3, 1933, 2217, 2134, 1707, 2253, 1015, 542,
-	2104, 1786, 1589, 1741, 1934, 1539, 1830, 1062, 1446, 1930,
-	1554, 933, 528, 1069, 1518, 1873, 1810, 1834, 1811, 511,
-	825, 2126, 1574, 1497, 513, 1559, 1945, 508, 883, 165,
-	1403, 1812, 165, 1727, 476, 165, 1395, 1667, 137, 617,
-	492, 1892, 165, 1096, 1197, 762, 123, 81, 1587, 1306,
-	165, 1620, 1561, 1804, 790, 1573, 1106, 1072, 1099, 1089,
-	1215, 1479, 1067, 1171, 504, 1090, 1486, 1372, 1448, 1092,
-	592, 1054, 492, 601, 1429, 492, 165, 492, 515, 577,
-	951, 33, 1303, 1204, 1571, 912, 1289, 1462, 793, 1550,
-	769, 770, 791, 792, 766, 583, 796, 1103, 1540, 1105,
-	1502, 1311, 79, 614, 803, 8, 100, 140, 101, 1028,
-	7, 78, 1079, 1166, 868, 6, 1031, 1853, 1852, 931,
-	1618, 106, 107, 499, 1275, 1880, 1881, 2136, 1443, 1444,
-	1361, 1360, 1359, 1358, 167, 168, 169, 952, 1189, 505,
-	1357, 1356, 1345, 1349, 599, 603, 827, 2272, 502, 763,
-	503, 1705, 450, 102, 778, 773, 2184, 1981, 2083, 841,
-	842, 578, 845, 846, 847, 848, 2160, 830, 851, 852,
-	853, 854, 855, 856, 857, 858, 859, 860, 861, 862,
-	863, 864, 865, 2159, 84, 108, 829, 500, 2102, 611,
-	618, 2103, 2298, 952, 828, 1566, 2299, 2250, 1657, 806,
-	2210, 785, 962, 2291, 2108, 1606, 784, 102, 783, 80,
-	2249, 1909, 2045, 1180, 1706, 807, 1564, 1959, 831, 832,
-	833, 86, 87, 88, 89, 90, 91, 2209, 161, 97,
-	1960, 1961, 162, 871, 1860, 445, 1513, 1514, 1859, 843,
-	35, 838, 1879, 72, 39, 40, 1107, 1655, 1108, 1512,
-	1445, 902, 103, 929, 1406, 576, 161, 1771, 962, 903,
-	1770, 1503, 574, 1772, 573, 145, 782, 1829, 877, 878,
-	896, 102, 890, 1794, 907, 908, 2014, 891, 161, 2036,
-	103, 555, 125, 561, 562, 559, 560, 2214, 558, 557,
-	556, 490, 958, 145, 2034, 950, 1533, 1532, 563, 564,
-	488, 1563, 103, 919, 1348, 921, 1775, 1295, 479, 1350,
-	1351, 1352, 479, 494, 479, 145, 71, 867, 890, 142,
-	1058, 143, 780, 891, 135, 782, 866, 1856, 1835, 124,
-	160, 889, 1265, 888, 1588, 904, 1631, 1629, 1630, 1626,
-	1621, 918, 920, 2297, 1290, 925, 897, 142, 958, 143,
-	928, 844, 2015, 786, 1191, 1192, 134, 133, 160, 2008,
-	909, 167, 168, 169, 1633, 873, 1634, 2009, 1635, 142,
-	910, 143, 1868, 2273, 1266, 911, 1267, 1781, 905, 906,
-	160, 1625, 1636, 850, 849, 2016, 1623, 479, 870, 2156,
-	2097, 814, 1627, 1590, 1480, 146, 823, 822, 821, 820,
-	819, 812, 787, 818, 151, 817, 1790, 816, 1183, 824,
-	811, 2294, 129, 1193, 136, 767, 1190, 1432, 130, 131,
-	799, 1624, 1782, 146, 2289, 165, 767, 165, 781, 916,
-	165, 1980, 151, 917, 957, 954, 955, 956, 961, 963,
-	960, 798, 959, 922, 1784, 146, 767, 1779, 923, 953,
-	765, 1304, 1296, 900, 151, 2287, 1572, 492, 492, 492,
-	1788, 1789, 480, 605, 915, 1780, 480, 1503, 480, 805,
-	1203, 1872, 1869, 869, 1565, 492, 492, 1612, 1300, 938,
-	886, 834, 892, 893, 894, 895, 1858, 781, 944, 815,
-	957, 954, 955, 956, 961, 963, 960, 924, 959, 813,
-	1988, 586, 1855, 805, 930, 953, 2215, 1918, 2208, 1917,
-	138, 2173, 977, 976, 986, 987, 979, 980, 981, 982,
-	983, 984, 985, 978, 1916, 1787, 988, 1178, 804, 840,
-	1708, 1710, 1656, 808, 798, 1202, 1177, 1790, 138, 1176,
-	1845, 480, 1301, 809, 1002, 1003, 1004, 1005, 1006, 1007,
-	1008, 1009, 1010, 1011, 165, 1686, 1174, 926, 2241, 99,
-	138, 810, 804, 449, 1277, 1276, 1278, 1279, 1280, 1875,
-	444, 1060, 805, 1875, 1874, 73, 1867, 1767, 1874, 1866,
-	887, 969, 492, 805, 2195, 165, 2065, 165, 165, 998,
-	492, 1059, 899, 132, 1000, 1001, 492, 935, 936, 1958,
-	879, 876, 947, 901, 1732, 126, 1675, 945, 127, 1598,
-	2285, 2233, 946, 2286, 2231, 2284, 1519, 505, 1508, 805,
-	1083, 614, 1608, 2235, 2236, 1016, 1026, 1788, 1789, 1013,
-	1088, 804, 2232, 1709, 881, 1783, 988, 798, 801, 802,
-	1683, 767, 804, 1055, 978, 795, 799, 988, 798, 801,
-	802, 1458, 767, 885, 1343, 94, 795, 799, 1065, 1068,
-	913, 968, 2204, 1073, 794, 1030, 1033, 1035, 1037, 1038,
-	1040, 1042, 1043, 1034, 1036, 1312, 1039, 1041, 804, 1044,
-	839, 139, 144, 141, 147, 148, 149, 150, 152, 153,
-	154, 155, 1787, 805, 1294, 1911, 1052, 156, 157, 158,
-	159, 1000, 1001, 777, 1790, 779, 95, 826, 618, 139,
-	144, 141, 147, 148, 149, 150, 152, 153, 154, 155,
-	1943, 1893, 1622, 872, 1297, 156, 157, 158, 159, 1379,
-	2174, 139, 144, 141, 147, 148, 149, 150, 152, 153,
-	154, 155, 165, 1377, 1378, 1376, 1167, 156, 157, 158,
-	159, 1109, 804, 965, 1607, 1175, 1460, 808, 798, 948,
-	1430, 782, 1693, 774, 1895, 1061, 1430, 809, 1823, 968,
-	776, 775, 2139, 884, 492, 1968, 1199, 167, 168, 169,
-	1291, 1397, 1292, 914, 1208, 1293, 1000, 1001, 1212, 1967,
-	1594, 492, 492, 1214, 492, 1213, 492, 492, 1313, 492,
-	492, 492, 492, 492, 492, 1600, 1181, 1182, 981, 982,
-	983, 984, 985, 978, 492, 1201, 988, 780, 165, 1248,
-	1459, 967, 965, 167, 168, 169, 1897, 1799, 1901, 1604,
-	1896, 1209, 1894, 1605, 165, 1603, 1188, 1899, 968, 1195,
-	814, 812, 1600, 1398, 1071, 492, 1898, 165, 1207, 2266,
-	1963, 966, 967, 965, 2295, 71, 1243, 1244, 1302, 1900,
-	1902, 1076, 165, 2292, 1251, 1252, 1602, 1375, 1104, 968,
-	1257, 1258, 1217, 2082, 1218, 1682, 1220, 1222, 165, 1245,
-	1226, 1228, 1230, 1232, 1234, 165, 1206, 1173, 2278, 1800,
-	587, 2293, 2081, 1284, 165, 165, 165, 165, 165, 165,
-	165, 165, 165, 492, 492, 492, 1205, 1205, 2282, 1198,
-	1186, 1184, 966, 967, 965, 1282, 2279, 1660, 1661, 1662,
-	1913, 1308, 1261, 781, 2296, 1316, 1272, 1185, 1986, 1808,
-	968, 165, 1320, 1246, 1322, 1323, 1324, 1325, 604, 1807,
-	1569, 1329, 977, 976, 986, 987, 979, 980, 981, 982,
-	983, 984, 985, 978, 1283, 1344, 988, 1285, 1270, 1310,
-	609, 1269, 1305, 1179, 1268, 1259, 966, 967, 965, 1396,
-	1253, 1373, 1250, 1249, 1314, 1315, 1281, 1681, 1399, 784,
-	102, 783, 1463, 1464, 968, 1680, 1224, 1271, 1319, 167,
-	168, 169, 492, 1774, 1355, 1326, 1327, 1328, 1371, 2281,
-	1318, 1380, 1381, 1382, 1383, 1384, 1385, 1386, 1387, 1388,
-	1389, 1390, 1391, 1392, 1393, 1394, 966, 967, 965, 2280,
-	1400, 1401, 1920, 2267, 2261, 1407, 492, 492, 606, 607,
-	1413, 966, 967, 965, 968, 2259, 2123, 165, 1362, 1363,
-	1364, 1365, 1339, 1340, 1341, 2079, 1418, 1421, 2053, 968,
-	1374, 492, 1431, 1409, 1966, 1922, 1451, 1817, 165, 1805,
-	1433, 492, 1651, 1408, 1616, 165, 1615, 165, 1452, 1309,
-	1921, 966, 967, 965, 1273, 165, 165, 1260, 1256, 1437,
-	1438, 1016, 492, 1255, 1254, 492, 1498, 927, 2011, 968,
-	1809, 1995, 2247, 1416, 1417, 1407, 492, 977, 976, 986,
-	987, 979, 980, 981, 982, 983, 984, 985, 978, 1995,
-	2202, 988, 587, 614, 1995, 2197, 614, 1410, 1995, 2196,
-	966, 967, 965, 1409, 1367, 1369, 1370, 82, 167, 168,
-	169, 505, 1582, 1477, 2154, 1541, 1542, 1543, 968, 1501,
-	2178, 587, 1368, 2153, 1473, 167, 168, 169, 2106, 1580,
-	1836, 492, 80, 1522, 1728, 1453, 1668, 1575, 1576, 1577,
-	2150, 1523, 1579, 1581, 1820, 1465, 167, 168, 169, 1527,
-	1526, 2100, 587, 1995, 2098, 492, 1556, 1600, 587, 2063,
-	587, 492, 1208, 1517, 1942, 1208, 1534, 1208, 1535, 1536,
-	1537, 1538, 1562, 1475, 1471, 1599, 1978, 1977, 1506, 1509,
-	618, 2060, 1510, 618, 1546, 1547, 1548, 1549, 1525, 1524,
-	1974, 1975, 977, 976, 986, 987, 979, 980, 981, 982,
-	983, 984, 985, 978, 1483, 492, 988, 1396, 1974, 1973,
-	71, 587, 1396, 1396, 531, 530, 533, 534, 535, 536,
-	1586, 1931, 1558, 532, 2203, 537, 1593, 1471, 587, 1596,
-	1942, 1597, 1552, 1553, 1503, 1854, 2048, 1170, 1838, 1568,
-	1570, 1557, 1567, 1728, 1578, 1832, 1833, 1504, 165, 2042,
-	1483, 587, 1414, 1415, 964, 165, 1420, 1423, 1424, 1504,
-	165, 165, 806, 1611, 165, 571, 165, 1595, 1613, 1614,
-	1609, 1592, 165, 1557, 1610, 1591, 1601, 35, 807, 165,
-	964, 587, 1436, 1170, 1169, 1439, 1440, 2047, 1205, 977,
-	976, 986, 987, 979, 980, 981, 982, 983, 984, 985,
-	978, 1735, 35, 988, 1115, 1114, 35, 165, 492, 1472,
-	1505, 1619, 1814, 1942, 166, 1995, 1976, 166, 1507, 1483,
-	166, 1761, 1505, 1646, 1647, 493, 1736, 166, 1649, 1503,
-	1503, 1482, 1600, 1511, 1698, 166, 2166, 1650, 1697, 1471,
-	977, 976, 986, 987, 979, 980, 981, 982, 983, 984,
-	985, 978, 1600, 71, 988, 2141, 1373, 493, 580, 1583,
-	493, 166, 493, 1461, 1639, 977, 976, 986, 987, 979,
-	980, 981, 982, 983, 984, 985, 978, 2084, 71, 988,
-	1471, 1441, 71, 871, 1483, 1664, 1665, 1666, 1239, 977,
-	976, 986, 987, 979, 980, 981, 982, 983, 984, 985,
-	978, 1353, 165, 988, 1299, 1101, 789, 788, 2105, 2076,
-	165, 2071, 1411, 1412, 1654, 979, 980, 981, 982, 983,
-	984, 985, 978, 1172, 1663, 988, 1555, 2085, 2086, 2087,
-	2088, 2010, 1970, 165, 71, 1374, 1839, 1551, 1240, 1241,
-	1242, 1714, 1545, 1544, 165, 165, 165, 165, 165, 1287,
-	1200, 1737, 1196, 1721, 1168, 96, 165, 2013, 1454, 2167,
-	165, 1813, 1566, 165, 165, 1946, 1947, 165, 165, 165,
-	1676, 1759, 578, 2263, 2228, 2275, 1730, 2089, 2090, 1993,
-	1773, 1992, 1991, 1692, 1694, 1949, 1931, 1742, 1824, 1677,
-	1640, 1055, 1733, 1704, 1346, 1752, 1236, 1750, 1712, 1952,
-	1753, 1798, 1751, 1951, 1720, 1749, 1762, 1748, 1814, 1729,
-	1764, 2248, 1717, 1718, 1719, 1068, 1754, 1923, 1492, 1493,
-	1070, 1795, 1796, 1743, 1731, 2064, 1746, 492, 1308, 2041,
-	1744, 1745, 165, 1747, 1797, 1998, 1801, 1802, 1803, 165,
-	1776, 1755, 1760, 1237, 1238, 492, 1765, 1726, 1768, 1725,
-	2277, 492, 2219, 2189, 2191, 1208, 1208, 2252, 1777, 2222,
-	2218, 492, 2192, 1562, 1816, 1488, 1491, 1492, 1493, 1489,
-	2254, 1490, 1494, 1851, 1715, 1946, 1947, 2186, 1298, 1806,
-	572, 1792, 1716, 1530, 165, 165, 165, 165, 165, 1818,
-	1842, 1426, 1063, 1815, 836, 835, 2023, 1813, 1878, 1847,
-	165, 165, 1821, 1064, 937, 1427, 1850, 1846, 1849, 2040,
-	1188, 1825, 1826, 1827, 103, 2058, 1463, 1464, 1409, 1840,
-	1841, 1456, 1989, 1488, 1491, 1492, 1493, 1489, 1408, 1490,
-	1494, 1643, 2199, 2161, 1791, 1496, 492, 1632, 1848, 1672,
-	1673, 1659, 1396, 581, 582, 977, 976, 986, 987, 979,
-	980, 981, 982, 983, 984, 985, 978, 584, 1724, 988,
-	1690, 2260, 2258, 1870, 2257, 1891, 1723, 1889, 2223, 2221,
-	1890, 2057, 492, 1994, 1584, 585, 1884, 1885, 82, 2056,
-	1926, 1728, 1882, 165, 1910, 1888, 2265, 2264, 580, 1687,
-	1684, 1905, 1906, 492, 1907, 1908, 1084, 1077, 1903, 492,
-	492, 2265, 1932, 2193, 1904, 1914, 1915, 1965, 1457, 80,
-	166, 1876, 166, 85, 1877, 166, 1935, 77, 1, 2230,
-	462, 1442, 165, 1053, 1889, 977, 976, 986, 987, 979,
-	980, 981, 982, 983, 984, 985, 978, 475, 2226, 988,
-	1742, 1274, 493, 493, 493, 1264, 2109, 1912, 2163, 2001,
-	1560, 165, 1919, 1950, 1941, 797, 128, 1520, 1521, 2243,
-	493, 493, 93, 760, 92, 1955, 800, 898, 1585, 2101,
-	1793, 1531, 1121, 1119, 1954, 1120, 1956, 1118, 1957, 1987,
-	1940, 1123, 1927, 1122, 1117, 165, 1347, 489, 1495, 1964,
-	1971, 1972, 163, 492, 1962, 1110, 1078, 1670, 837, 452,
-	492, 1671, 1979, 1342, 1617, 458, 165, 996, 1722, 1929,
-	1769, 1983, 1678, 1679, 1984, 1985, 165, 615, 1685, 608,
-	1937, 1688, 1689, 1982, 2216, 2185, 2000, 2002, 2187, 1695,
-	165, 1696, 2135, 165, 1699, 1700, 1701, 1702, 1703, 166,
-	1997, 1562, 2024, 2190, 1999, 2183, 2005, 2004, 1713, 2276,
-	2251, 2198, 1528, 1455, 1066, 2055, 1925, 1691, 1996, 597,
-	593, 1025, 1428, 1093, 597, 593, 2018, 493, 2019, 514,
-	166, 1450, 166, 166, 594, 493, 1366, 529, 526, 594,
-	527, 493, 1466, 1734, 970, 2021, 2022, 512, 2032, 1883,
-	506, 2025, 1085, 1487, 1485, 1757, 1758, 1484, 1641, 1074,
-	1075, 596, 1097, 595, 590, 591, 596, 2054, 595, 977,
-	976, 986, 987, 979, 980, 981, 982, 983, 984, 985,
-	978, 1948, 2059, 988, 1944, 1091, 2068, 1470, 1529, 1857,
-	2007, 949, 589, 501, 772, 2067, 1425, 2029, 2030, 2172,
-	2031, 1658, 2044, 2033, 588, 2035, 61, 1742, 2073, 165,
-	38, 496, 165, 165, 165, 492, 492, 2271, 2075, 2078,
-	940, 2080, 598, 32, 31, 30, 2027, 2046, 29, 2074,
-	28, 23, 22, 21, 2110, 492, 492, 492, 2077, 20,
-	19, 2095, 25, 18, 17, 16, 98, 48, 45, 43,
-	505, 2116, 105, 104, 46, 42, 874, 2069, 27, 26,
-	2070, 15, 14, 2072, 13, 12, 11, 10, 9, 5,
-	492, 492, 492, 165, 4, 2114, 2115, 943, 24, 1014,
-	2, 0, 0, 0, 492, 0, 492, 166, 0, 0,
-	0, 0, 492, 0, 2142, 0, 2138, 492, 2144, 2133,
-	1935, 2132, 0, 0, 1935, 2140, 0, 2117, 2118, 2119,
-	2120, 2121, 2130, 2131, 0, 2124, 2125, 0, 2147, 493,
-	0, 0, 0, 2149, 0, 0, 492, 0, 0, 492,
-	1886, 1887, 2155, 2151, 0, 2152, 493, 493, 0, 493,
-	2157, 493, 493, 2158, 493, 493, 493, 493, 493, 493,
-	0, 0, 2162, 0, 0, 0, 0, 0, 0, 493,
-	2165, 0, 0, 166, 0, 0, 0, 0, 0, 2182,
-	2137, 505, 0, 0, 0, 2194, 0, 0, 0, 166,
-	2122, 1935, 0, 0, 0, 0, 0, 492, 165, 0,
-	493, 0, 166, 2201, 0, 0, 1938, 0, 0, 492,
-	0, 0, 0, 2146, 0, 0, 0, 166, 0, 2148,
-	0, 0, 541, 2205, 0, 0, 492, 1953, 2220, 2213,
-	2224, 0, 0, 166, 492, 492, 2229, 0, 2234, 0,
-	166, 2237, 2242, 0, 0, 0, 0, 0, 0, 166,
-	166, 166, 166, 166, 166, 166, 166, 166, 493, 493,
-	493, 2256, 2262, 2255, 0, 2165, 2244, 0, 1742, 0,
-	0, 164, 2268, 0, 448, 0, 161, 487, 2238, 0,
-	0, 2274, 0, 0, 448, 0, 166, 1187, 0, 0,
-	0, 0, 448, 0, 0, 0, 0, 0, 2288, 0,
-	103, 0, 125, 0, 0, 0, 2290, 0, 0, 602,
-	602, 0, 0, 145, 0, 0, 0, 0, 448, 986,
-	987, 979, 980, 981, 982, 983, 984, 985, 978, 0,
-	0, 988, 167, 168, 169, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 135, 0, 0, 493, 0, 124,
-	0, 0, 0, 0, 0, 0, 2026, 0, 479, 0,
-	2028, 0, 0, 0, 0, 0, 0, 142, 0, 143,
-	0, 2037, 2038, 2039, 1191, 1192, 134, 133, 160, 0,
-	0, 493, 493, 0, 0, 0, 0, 2052, 0, 0,
-	540, 0, 166, 0, 0, 0, 0, 0, 467, 0,
-	0, 0, 0, 0, 2061, 2062, 493, 466, 2066, 0,
-	0, 0, 0, 166, 0, 0, 493, 0, 464, 0,
-	166, 0, 166, 0, 0, 0, 0, 0, 0, 0,
-	166, 166, 129, 1193, 136, 0, 1190, 493, 130, 131,
-	493, 0, 0, 146, 0, 0, 0, 0, 0, 0,
-	491, 493, 151, 0, 0, 0, 461, 0, 1056, 0,
-	0, 0, 0, 0, 0, 474, 0, 0, 0, 2099,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	472, 0, 616, 0, 0, 764, 0, 771, 0, 977,
-	976, 986, 987, 979, 980, 981, 982, 983, 984, 985,
-	978, 0, 0, 988, 0, 0, 493, 0, 0, 0,
-	447, 0, 480, 0, 0, 0, 0, 2127, 0, 0,
-	495, 0, 0, 0, 0, 0, 0, 0, 575, 0,
-	493, 0, 0, 0, 0, 0, 493, 0, 0, 0,
-	451, 0, 453, 468, 0, 482, 0, 481, 457, 0,
-	455, 459, 469, 460, 768, 454, 0, 465, 138, 0,
-	456, 470, 471, 486, 485, 473, 0, 463, 483, 976,
-	986, 987, 979, 980, 981, 982, 983, 984, 985, 978,
-	493, 1669, 988, 0, 0, 2168, 2169, 2170, 2171, 0,
-	2175, 0, 2176, 2177, 2179, 0, 0, 0, 2180, 2181,
-	0, 977, 976, 986, 987, 979, 980, 981, 982, 983,
-	984, 985, 978, 132, 0, 988, 0, 0, 0, 0,
-	0, 0, 0, 166, 0, 126, 0, 0, 127, 0,
-	166, 0, 0, 0, 0, 166, 166, 0, 0, 166,
-	2207, 166, 0, 0, 0, 0, 0, 166, 0, 0,
-	0, 0, 0, 0, 166, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 448, 0, 448,
-	0, 0, 448, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 166, 493, 0, 0, 0, 0, 0, 0,
-	0, 0, 484, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 2269, 2270, 0, 0,
-	477, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 478, 0, 0, 0, 139,
-	144, 141, 147, 148, 149, 150, 152, 153, 154, 155,
-	0, 972, 0, 975, 0, 156, 157, 158, 159, 989,
-	990, 991, 992, 993, 994, 995, 0, 973, 974, 971,
-	977, 976, 986, 987, 979, 980, 981, 982, 983, 984,
-	985, 978, 0, 0, 988, 0, 0, 166, 0, 0,
-	0, 0, 0, 0, 0, 166, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 448, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 166, 0,
-	0, 0, 602, 0, 0, 0, 0, 0, 0, 166,
-	166, 166, 166, 166, 0, 0, 0, 448, 0, 448,
-	1100, 166, 0, 0, 0, 166, 0, 0, 166, 166,
-	0, 0, 166, 166, 166, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 616, 616, 616,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 939, 941, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 493, 875, 0, 880, 0, 166, 882, 0,
-	0, 0, 0, 0, 166, 0, 0, 0, 0, 0,
-	493, 0, 0, 0, 0, 0, 493, 35, 36, 37,
-	72, 39, 40, 0, 0, 0, 493, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 76, 0, 0,
-	0, 41, 67, 68, 0, 65, 69, 0, 0, 166,
-	166, 166, 166, 166, 66, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 166, 166, 0, 0, 0,
-	0, 0, 0, 0, 448, 0, 0, 0, 0, 0,
-	0, 0, 1081, 54, 0, 0, 0, 0, 0, 0,
-	616, 0, 0, 71, 0, 0, 1111, 0, 0, 0,
-	0, 493, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1211,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 493, 0, 0,
-	0, 0, 0, 0, 1211, 1211, 0, 0, 166, 0,
-	448, 0, 0, 1087, 0, 0, 1098, 0, 493, 0,
-	0, 0, 0, 0, 493, 493, 1262, 0, 0, 0,
-	0, 44, 47, 50, 49, 52, 0, 64, 0, 448,
-	70, 0, 0, 0, 0, 0, 0, 166, 0, 0,
-	0, 0, 0, 0, 1307, 0, 0, 0, 0, 0,
-	0, 0, 53, 75, 74, 0, 0, 62, 63, 51,
-	448, 0, 0, 0, 0, 0, 166, 448, 0, 0,
-	0, 0, 0, 0, 0, 0, 1330, 1331, 448, 448,
-	448, 448, 448, 448, 448, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 55, 56,
-	166, 57, 58, 59, 60, 0, 0, 0, 493, 0,
-	0, 0, 0, 448, 0, 493, 0, 0, 0, 0,
-	0, 166, 0, 0, 764, 0, 0, 0, 0, 0,
-	0, 166, 0, 0, 0, 0, 0, 1210, 0, 0,
-	0, 1216, 1216, 0, 1216, 166, 1216, 1216, 166, 1225,
-	1216, 1216, 1216, 1216, 1216, 0, 0, 0, 0, 0,
-	1116, 0, 1210, 1210, 764, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 602, 1307, 0, 0, 0,
-	602, 602, 0, 0, 602, 602, 602, 0, 0, 0,
-	1211, 0, 0, 0, 0, 1286, 0, 0, 0, 0,
-	0, 0, 73, 0, 0, 0, 0, 0, 0, 0,
-	602, 602, 602, 602, 602, 0, 0, 0, 0, 1262,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1247, 0, 0, 0,
-	448, 0, 0, 0, 0, 0, 1307, 448, 0, 448,
-	0, 0, 0, 616, 616, 616, 0, 448, 448, 0,
-	543, 34, 0, 0, 166, 1288, 0, 166, 166, 166,
-	493, 493, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	493, 493, 493, 0, 0, 34, 1317, 0, 0, 0,
-	0, 0, 0, 1321, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1332, 1333, 1334, 1335, 1336, 1337,
-	1338, 0, 0, 0, 0, 493, 493, 493, 166, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 493,
-	579, 493, 1402, 0, 616, 0, 0, 493, 0, 1098,
-	0, 0, 493, 0, 0, 0, 0, 0, 1210, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1434, 1435, 0, 0,
-	0, 493, 0, 0, 493, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1467, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1081, 0, 0, 616, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 616, 0, 0, 616, 0, 0, 0, 0,
-	0, 0, 493, 166, 0, 0, 764, 0, 0, 0,
-	448, 0, 0, 0, 493, 0, 0, 448, 0, 0,
-	0, 0, 448, 448, 0, 0, 448, 0, 1644, 0,
-	0, 493, 0, 0, 448, 0, 1474, 0, 0, 493,
-	493, 448, 0, 1478, 0, 1481, 0, 0, 0, 0,
-	0, 0, 0, 0, 1500, 0, 0, 0, 0, 0,
-	0, 771, 0, 0, 0, 0, 0, 0, 0, 448,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 764, 0, 0, 0, 0,
-	0, 771, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 602, 602, 0,
-	0, 0, 0, 0, 0, 764, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 602, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 448, 0, 0, 0, 0, 0,
-	0, 0, 1262, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 602, 448, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1211, 448, 448, 448, 448,
-	448, 0, 0, 0, 0, 0, 0, 0, 1756, 0,
-	0, 0, 448, 0, 0, 448, 448, 0, 0, 448,
-	1766, 1307, 0, 0, 0, 0, 0, 0, 1653, 0,
-	0, 0, 0, 0, 0, 0, 1098, 0, 0, 0,
-	0, 0, 0, 1628, 0, 0, 0, 0, 1637, 1638,
-	0, 0, 1642, 0, 0, 0, 0, 0, 0, 0,
-	1645, 0, 0, 0, 0, 0, 0, 1648, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 448, 0, 0, 932, 932, 932,
-	0, 1828, 0, 0, 0, 1652, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 34, 0, 0,
-	0, 1307, 0, 0, 0, 0, 0, 0, 0, 0,
-	997, 999, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 448, 448, 448, 448,
-	448, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1012, 448, 448, 0, 1017, 1018, 1019, 1020, 1021,
-	1022, 1023, 1024, 1210, 1027, 1029, 1032, 1032, 1032, 1029,
-	1032, 1032, 1029, 1032, 1045, 1046, 1047, 1048, 1049, 1050,
-	1051, 0, 0, 0, 0, 0, 1057, 602, 0, 0,
-	34, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1094, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 448, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1819, 1211, 0,
-	0, 0, 1763, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1831, 0, 0, 0, 0,
-	0, 1837, 0, 0, 448, 0, 0, 0, 0, 616,
-	0, 1843, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 448, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1822, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 448, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1211, 0,
-	0, 0, 0, 0, 0, 0, 616, 0, 448, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 448, 0,
-	0, 0, 1861, 1862, 1863, 1864, 1865, 0, 0, 0,
-	0, 0, 448, 0, 0, 448, 0, 0, 1098, 1871,
-	0, 0, 1216, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 616, 0, 0, 1210, 0, 0, 1939,
-	1216, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1211, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1924, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 161, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 448, 0, 0, 448, 448, 448, 0, 0, 0,
-	0, 0, 0, 764, 0, 103, 1210, 125, 0, 0,
-	1831, 0, 0, 0, 0, 0, 0, 0, 145, 0,
-	0, 0, 0, 932, 932, 932, 0, 0, 0, 1969,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 135,
-	0, 0, 0, 0, 124, 1262, 0, 0, 0, 0,
-	0, 0, 0, 1990, 0, 0, 0, 0, 0, 0,
-	0, 0, 142, 0, 143, 0, 0, 0, 0, 112,
-	113, 134, 133, 160, 2003, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 2006, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 2017, 0,
-	0, 2020, 0, 1210, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 129, 110, 136,
-	117, 109, 0, 130, 131, 0, 0, 0, 146, 0,
-	0, 0, 0, 0, 0, 0, 0, 151, 118, 0,
-	0, 0, 0, 0, 0, 1831, 2096, 0, 0, 0,
-	448, 1138, 121, 119, 114, 115, 116, 120, 0, 0,
-	0, 0, 111, 0, 0, 2111, 2112, 2113, 0, 0,
-	0, 122, 0, 0, 0, 1499, 1211, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	2128, 2128, 2128, 0, 0, 0, 0, 2091, 0, 0,
-	2092, 2093, 2094, 0, 2143, 0, 2145, 0, 0, 0,
-	0, 0, 1831, 0, 0, 0, 0, 1831, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 138, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1831, 0, 0, 616,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1126, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 132, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	126, 0, 0, 127, 0, 1139, 0, 1831, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 2211,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1210, 0, 2225, 0, 0, 0,
-	0, 0, 0, 0, 616, 616, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1152, 1155, 1156, 1157,
-	1158, 1159, 1160, 0, 1161, 1162, 1163, 1164, 1165, 1140,
-	1141, 1142, 1143, 1124, 1125, 1153, 2206, 1127, 0, 1128,
-	1129, 1130, 1131, 1132, 1133, 1134, 1135, 1136, 1137, 1144,
-	1145, 1146, 1147, 1148, 1149, 1150, 1151, 0, 0, 0,
-	0, 0, 0, 0, 139, 144, 141, 147, 148, 149,
-	150, 152, 153, 154, 155, 0, 0, 0, 0, 0,
-	156, 157, 158, 159, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1154, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1674, 0,
-	0, 579, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1711, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1094, 0, 0, 0, 0, 0, 0,
-	1738, 1739, 0, 0, 1094, 1094, 1094, 1094, 1094, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1499, 0, 0, 1094, 0, 0, 0, 1094, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 393, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 317,
-	0, 0, 0, 0, 0, 0, 0, 0, 224, 0,
-	0, 0, 1844, 275, 221, 0, 0, 331, 0, 176,
-	0, 369, 209, 284, 282, 398, 235, 227, 223, 208,
-	259, 290, 329, 387, 323, 0, 279, 0, 0, 378,
-	302, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 265, 207, 175, 314,
-	379, 239, 0, 0, 0, 167, 168, 169, 0, 0,
-	0, 0, 0, 0, 0, 0, 198, 0, 205, 0,
-	0, 0, 0, 219, 263, 226, 218, 395, 0, 0,
-	0, 191, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 229, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 977, 976, 986, 987,
-	979, 980, 981, 982, 983, 984, 985, 978, 0, 0,
-	988, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 247, 1936, 303, 34,
-	0, 0, 0, 427, 0, 0, 0, 0, 0, 0,
-	0, 274, 0, 271, 171, 187, 0, 0, 313, 352,
-	358, 0, 1094, 0, 210, 0, 356, 327, 412, 194,
-	237, 349, 332, 354, 0, 0, 355, 280, 400, 344,
-	410, 428, 429, 217, 307, 418, 391, 424, 439, 188,
-	214, 321, 384, 415, 375, 300, 396, 397, 270, 374,
-	245, 174, 278, 436, 186, 364, 202, 179, 386, 408,
-	199, 367, 0, 0, 441, 181, 406, 383, 297, 267,
-	268, 180, 0, 348, 222, 243, 212, 316, 403, 404,
-	211, 442, 190, 423, 183, 0, 422, 309, 399, 407,
-	298, 289, 182, 405, 296, 288, 273, 233, 254, 342,
-	283, 343, 255, 305, 304, 306, 0, 177, 0, 380,
-	416, 443, 195, 196, 197, 0, 232, 236, 242, 244,
-	250, 251, 258, 276, 320, 341, 339, 345, 0, 394,
-	411, 419, 426, 432, 433, 437, 434, 435, 438, 308,
-	257, 376, 272, 281, 0, 0, 326, 357, 200, 414,
-	377, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	2043, 0, 0, 0, 0, 0, 0, 2049, 2050, 2051,
-	0, 170, 184, 277, 0, 346, 240, 440, 421, 417,
-	0, 0, 216, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 172, 173, 185, 193, 203,
-	215, 230, 238, 248, 253, 256, 260, 261, 264, 269,
-	286, 291, 292, 293, 294, 310, 311, 312, 315, 318,
-	319, 322, 324, 325, 328, 334, 335, 336, 337, 338,
-	340, 347, 351, 359, 360, 361, 362, 363, 365, 366,
-	370, 371, 372, 373, 381, 385, 401, 402, 413, 425,
-	430, 249, 409, 431, 0, 285, 0, 0, 287, 234,
-	252, 262, 0, 420, 382, 189, 353, 241, 178, 206,
-	192, 213, 228, 231, 266, 295, 301, 330, 333, 246,
-	225, 204, 350, 201, 368, 388, 389, 390, 392, 299,
+	583, 2140, 2329, 2147, 2345, 2275, 2043, 2235, 2288, 2263,
+	1039, 2305, 2202, 965, 3, 1806, 1813, 954, 2229, 2170,
+	1616, 1964, 1093, 1768, 1814, 1473, 1965, 1755, 2137, 2162,
+	1735, 1545, 541, 1769, 555, 1838, 526, 1961, 1862, 1839,
+	524, 1601, 1586, 1581, 1901, 1858, 781, 1840, 86, 168,
+	1524, 1086, 168, 1976, 487, 168, 84, 902, 1330, 1695,
+	503, 140, 168, 1422, 1923, 1120, 126, 1239, 1430, 931,
+	1221, 168, 1614, 1647, 1588, 634, 1600, 517, 1130, 1123,
+	809, 1506, 1113, 1832, 844, 1513, 1091, 1078, 1116, 609,
+	1096, 33, 1475, 1456, 528, 503, 1327, 1399, 503, 168,
+	503, 1566, 975, 590, 1228, 1598, 788, 1577, 810, 1313,
+	811, 1127, 631, 789, 815, 785, 1567, 1489, 1529, 1129,
+	1114, 1335, 1103, 80, 1213, 952, 964, 887, 970, 512,
+	1190, 143, 1052, 1881, 1880, 1645, 812, 1195, 109, 79,
+	1055, 110, 1299, 1908, 600, 822, 1909, 2172, 797, 103,
+	104, 1388, 1470, 1471, 1387, 1386, 170, 171, 172, 1385,
+	1384, 1383, 1369, 515, 792, 516, 1373, 2326, 976, 1733,
+	460, 111, 591, 2225, 971, 2354, 2174, 105, 782, 1916,
+	616, 620, 2297, 2298, 2012, 2116, 2135, 2198, 2197, 2136,
+	2370, 848, 847, 849, 513, 170, 171, 172, 2373, 618,
+	568, 2302, 574, 575, 572, 573, 628, 571, 570, 569,
+	2256, 635, 976, 846, 81, 82, 83, 576, 577, 1685,
+	2360, 490, 804, 2255, 2141, 1633, 860, 861, 2301, 864,
+	865, 866, 867, 986, 105, 870, 871, 872, 873, 874,
+	875, 876, 877, 878, 879, 880, 881, 882, 883, 884,
+	825, 87, 803, 802, 1940, 1593, 2078, 1204, 35, 826,
+	862, 73, 39, 40, 477, 1131, 518, 1132, 1888, 850,
+	851, 852, 1887, 476, 1472, 1433, 1591, 986, 1991, 1992,
+	1799, 1990, 1530, 1798, 474, 857, 1800, 1734, 89, 90,
+	91, 92, 93, 94, 1540, 1541, 100, 1907, 105, 165,
+	1683, 1539, 455, 890, 921, 926, 927, 796, 587, 798,
+	801, 586, 896, 897, 886, 510, 909, 950, 922, 915,
+	909, 910, 471, 589, 982, 910, 1822, 974, 1560, 1559,
+	908, 485, 907, 1681, 72, 1374, 1375, 1376, 2260, 1372,
+	490, 490, 2045, 490, 2069, 2067, 482, 2214, 1001, 1000,
+	1010, 1011, 1003, 1004, 1005, 1006, 1007, 1008, 1009, 1002,
+	505, 501, 1012, 499, 1590, 801, 799, 793, 982, 1082,
+	1863, 170, 171, 172, 795, 794, 1615, 2039, 491, 1658,
+	1656, 1657, 863, 805, 1648, 2040, 1319, 1884, 1660, 1653,
+	1661, 928, 1662, 2367, 923, 916, 2327, 490, 801, 885,
+	1314, 1289, 938, 929, 940, 944, 461, 930, 463, 478,
+	1896, 493, 892, 492, 467, 949, 465, 469, 2046, 479,
+	470, 799, 464, 1652, 475, 521, 924, 925, 483, 466,
+	480, 481, 497, 496, 484, 1663, 473, 494, 869, 868,
+	937, 939, 1654, 1290, 833, 1291, 2194, 2047, 1650, 2130,
+	2208, 1617, 1507, 842, 841, 168, 840, 168, 2011, 839,
+	168, 889, 838, 1651, 837, 836, 1809, 831, 981, 978,
+	979, 980, 985, 987, 984, 800, 983, 835, 942, 830,
+	806, 1207, 843, 977, 1818, 2364, 2351, 786, 503, 503,
+	503, 824, 818, 786, 817, 786, 1924, 491, 491, 784,
+	491, 1328, 1736, 1738, 1599, 1227, 503, 503, 824, 2349,
+	2254, 1810, 981, 978, 979, 980, 985, 987, 984, 905,
+	983, 911, 912, 913, 914, 943, 1886, 977, 935, 622,
+	800, 1897, 936, 1812, 1320, 1639, 1807, 1530, 919, 1926,
+	1592, 947, 1324, 941, 951, 834, 959, 853, 2019, 888,
+	823, 945, 1816, 1817, 491, 827, 817, 1808, 1883, 1684,
+	2261, 495, 1949, 800, 1948, 828, 934, 823, 832, 2215,
+	1226, 2289, 824, 817, 820, 821, 1900, 786, 1947, 488,
+	859, 814, 818, 829, 824, 1202, 1201, 168, 1301, 1300,
+	1302, 1303, 1304, 1200, 489, 1873, 1325, 1198, 459, 74,
+	813, 1928, 511, 1932, 898, 1927, 1084, 1925, 1737, 454,
+	906, 1635, 1930, 1083, 956, 957, 102, 1815, 1022, 503,
+	895, 1929, 168, 824, 168, 168, 2239, 503, 1318, 1818,
+	2098, 823, 1989, 503, 1931, 1933, 1760, 817, 820, 821,
+	972, 786, 1903, 823, 631, 814, 818, 1902, 1040, 1714,
+	1001, 1000, 1010, 1011, 1003, 1004, 1005, 1006, 1007, 1008,
+	1009, 1002, 1711, 1895, 1012, 2281, 1894, 2347, 2279, 1703,
+	2348, 1546, 2346, 824, 1112, 1079, 1625, 2283, 2284, 1535,
+	918, 1903, 823, 1107, 1816, 1817, 1902, 827, 817, 2280,
+	1037, 920, 1024, 1025, 900, 1002, 1012, 828, 1012, 1097,
+	1795, 1054, 1057, 1059, 1061, 1062, 1064, 1066, 1067, 1696,
+	1058, 1060, 1485, 1063, 1065, 1076, 1068, 1315, 932, 1316,
+	993, 1406, 1317, 1336, 990, 991, 989, 1811, 904, 989,
+	1459, 1367, 823, 992, 858, 1404, 1405, 1403, 904, 2248,
+	2357, 2358, 992, 635, 845, 992, 1634, 991, 989, 1815,
+	1005, 1006, 1007, 1008, 1009, 1002, 518, 1942, 1012, 1974,
+	1649, 1818, 1378, 1321, 992, 1050, 1001, 1000, 1010, 1011,
+	1003, 1004, 1005, 1006, 1007, 1008, 1009, 1002, 97, 1133,
+	1012, 168, 1710, 891, 969, 1191, 1003, 1004, 1005, 1006,
+	1007, 1008, 1009, 1002, 1199, 1457, 1012, 1721, 1024, 1025,
+	1457, 1089, 1092, 170, 171, 172, 1851, 1424, 990, 991,
+	989, 1024, 1025, 503, 948, 1223, 1944, 603, 2369, 1490,
+	1491, 1709, 2368, 1232, 2177, 1999, 992, 1236, 1998, 1708,
+	503, 503, 98, 503, 1837, 503, 503, 1621, 503, 503,
+	503, 503, 503, 503, 933, 1085, 170, 171, 172, 1337,
+	1827, 903, 1487, 503, 1238, 1237, 1225, 168, 1272, 1219,
+	1632, 903, 1233, 990, 991, 989, 1100, 990, 991, 989,
+	2355, 2356, 1425, 168, 1630, 1212, 990, 991, 989, 833,
+	1627, 992, 1095, 831, 503, 992, 168, 1267, 1268, 1205,
+	1206, 2320, 1994, 1231, 992, 2361, 1627, 1326, 1241, 1269,
+	1242, 168, 1244, 1246, 1631, 2115, 1250, 1252, 1254, 1256,
+	1258, 990, 991, 989, 1128, 1828, 1486, 168, 72, 1197,
+	1629, 2114, 2017, 2362, 168, 1836, 1230, 1835, 1596, 992,
+	1402, 2365, 1209, 168, 168, 168, 168, 168, 168, 168,
+	168, 168, 503, 503, 503, 1222, 1210, 1275, 1276, 1208,
+	990, 991, 989, 1281, 1282, 1026, 1027, 1028, 1029, 1030,
+	1031, 1032, 1033, 1034, 1035, 1285, 1229, 1229, 992, 2339,
+	1270, 1309, 1308, 1340, 1306, 168, 1332, 1338, 1339, 621,
+	1344, 1294, 1346, 1347, 1348, 1349, 1394, 1396, 1397, 1353,
+	1296, 1343, 1688, 1689, 1690, 1329, 1951, 2340, 1350, 1351,
+	1352, 2366, 1293, 1368, 170, 171, 172, 1395, 1802, 626,
+	170, 171, 172, 1423, 1609, 544, 543, 546, 547, 548,
+	549, 1292, 1426, 105, 545, 1283, 550, 170, 171, 172,
+	1400, 1607, 2042, 1307, 1277, 1305, 503, 1274, 1273, 1342,
+	1382, 803, 802, 1248, 1952, 170, 171, 172, 2343, 1203,
+	604, 1295, 2342, 2341, 2321, 1434, 2313, 2311, 2159, 2112,
+	2086, 1997, 1953, 1440, 1845, 1427, 1428, 1363, 1364, 1365,
+	503, 503, 623, 624, 1833, 1678, 1643, 1642, 1479, 1333,
+	1297, 168, 1445, 1448, 1284, 1280, 1279, 1278, 1458, 946,
+	1401, 2192, 1478, 2026, 2295, 503, 1435, 1377, 584, 1379,
+	2026, 2246, 168, 2191, 1334, 503, 2026, 2241, 2139, 168,
+	1864, 168, 1756, 1436, 2081, 1040, 2026, 2240, 1848, 168,
+	168, 2219, 604, 2133, 604, 1434, 503, 2026, 2131, 503,
+	1627, 604, 1525, 2096, 604, 2009, 2008, 2005, 2006, 1554,
+	503, 1464, 1465, 631, 2005, 2004, 631, 169, 1498, 604,
+	169, 1789, 988, 169, 1973, 81, 82, 83, 504, 1530,
+	169, 1531, 1437, 1530, 1882, 2093, 1504, 1194, 1866, 169,
+	1001, 1000, 1010, 1011, 1003, 1004, 1005, 1006, 1007, 1008,
+	1009, 1002, 1510, 1436, 1012, 1528, 1550, 1500, 1389, 1390,
+	1391, 1392, 35, 504, 1756, 503, 504, 169, 504, 1531,
+	1549, 1602, 1603, 1604, 1860, 1861, 1606, 1608, 1000, 1010,
+	1011, 1003, 1004, 1005, 1006, 1007, 1008, 1009, 1002, 503,
+	1553, 1012, 1510, 604, 1532, 503, 1232, 1502, 1480, 1232,
+	85, 1232, 1534, 1583, 35, 1589, 604, 1536, 1492, 1626,
+	1533, 2179, 635, 1443, 1444, 635, 1537, 1509, 1552, 1499,
+	1551, 988, 604, 1194, 1193, 1568, 1569, 1570, 1763, 1139,
+	1138, 2270, 1532, 1962, 1973, 1613, 2247, 2026, 72, 503,
+	1530, 1423, 1973, 1628, 2007, 1510, 1423, 1423, 1538, 35,
+	1726, 518, 1725, 1764, 1561, 1498, 1562, 1563, 1564, 1565,
+	1627, 593, 1610, 1488, 1579, 1580, 1620, 1498, 1468, 1623,
+	1510, 1624, 1573, 1574, 1575, 1576, 1584, 1597, 1594, 1605,
+	72, 72, 168, 1595, 1380, 1636, 1323, 1441, 1442, 168,
+	1498, 1447, 1450, 1451, 168, 168, 1125, 808, 168, 1627,
+	168, 1619, 1622, 1544, 1638, 2117, 168, 1618, 1584, 1640,
+	1641, 807, 1637, 168, 2204, 2138, 2109, 1463, 2104, 1196,
+	1466, 1467, 1263, 1582, 825, 72, 2041, 2001, 1515, 1518,
+	1519, 1520, 1516, 826, 1517, 1521, 2075, 72, 1977, 1978,
+	1867, 168, 503, 1229, 1578, 1572, 2317, 1646, 1571, 1311,
+	1224, 1220, 1842, 1192, 604, 2118, 2119, 2120, 890, 99,
+	1977, 1978, 1585, 2044, 2205, 1593, 1673, 1674, 2276, 2188,
+	2024, 1676, 1264, 1265, 1266, 1841, 2023, 2022, 1980, 1962,
+	1677, 1515, 1518, 1519, 1520, 1516, 1852, 1517, 1521, 2121,
+	1398, 1983, 1666, 1407, 1408, 1409, 1410, 1411, 1412, 1413,
+	1414, 1415, 1416, 1417, 1418, 1419, 1420, 1421, 1400, 1001,
+	1000, 1010, 1011, 1003, 1004, 1005, 1006, 1007, 1008, 1009,
+	1002, 1667, 1842, 1012, 1001, 1000, 1010, 1011, 1003, 1004,
+	1005, 1006, 1007, 1008, 1009, 1002, 2122, 2123, 1012, 168,
+	1260, 1370, 1982, 1780, 1778, 1777, 1776, 168, 1781, 1779,
+	2336, 2300, 1460, 1682, 1954, 1001, 1000, 1010, 1011, 1003,
+	1004, 1005, 1006, 1007, 1008, 1009, 1002, 1094, 1401, 1012,
+	168, 1691, 1782, 1745, 1519, 1520, 2097, 2230, 2232, 2029,
+	1754, 168, 168, 168, 168, 168, 2233, 1261, 1262, 1753,
+	1765, 2338, 2304, 168, 2306, 2268, 2227, 168, 1322, 591,
+	168, 168, 1742, 1761, 168, 168, 168, 614, 610, 585,
+	1787, 1704, 1820, 2265, 1749, 1557, 1770, 1801, 1846, 1720,
+	1758, 2264, 611, 169, 1743, 169, 1732, 1079, 169, 1453,
+	855, 854, 1744, 1087, 2054, 1841, 1906, 1875, 1826, 1740,
+	958, 1874, 2091, 1454, 1088, 1748, 106, 1098, 1099, 613,
+	1790, 612, 1490, 1491, 1792, 1705, 504, 504, 504, 1757,
+	1759, 1483, 2020, 1771, 503, 1670, 1774, 2271, 1825, 168,
+	1829, 1830, 1831, 2243, 504, 504, 168, 1783, 2199, 1788,
+	1804, 1332, 503, 1819, 1793, 1523, 1659, 1796, 503, 614,
+	610, 1687, 1232, 1232, 598, 599, 596, 597, 503, 1589,
+	1752, 1805, 1772, 1773, 611, 1775, 594, 595, 1751, 601,
+	1879, 2312, 2310, 1870, 2309, 2269, 2267, 2252, 2090, 2025,
+	1611, 168, 168, 168, 168, 168, 1834, 1722, 602, 607,
+	608, 613, 1843, 612, 1823, 1824, 85, 168, 168, 2089,
+	1878, 1849, 1957, 1756, 1715, 1844, 1877, 1212, 1712, 1853,
+	1854, 1855, 2319, 2318, 1435, 169, 1746, 1747, 1092, 1108,
+	1101, 2319, 1868, 1869, 2237, 1996, 1484, 81, 82, 83,
+	88, 1436, 593, 78, 503, 968, 8, 1700, 1701, 1876,
+	1423, 966, 7, 967, 6, 1, 2278, 504, 472, 1469,
+	169, 1920, 169, 169, 1077, 504, 486, 1921, 1718, 2274,
+	1298, 504, 1898, 1288, 2142, 2201, 2032, 1587, 816, 131,
+	503, 1941, 1904, 1547, 1548, 1905, 2291, 96, 779, 95,
+	819, 168, 1910, 917, 1612, 2134, 1821, 1922, 1558, 1145,
+	1143, 503, 1144, 1142, 1147, 1146, 1141, 503, 503, 1438,
+	1439, 1919, 1371, 1934, 500, 1935, 1522, 166, 1920, 1134,
+	86, 1102, 856, 462, 2010, 1366, 1644, 1963, 468, 1020,
+	168, 1750, 1797, 632, 625, 1968, 1972, 2262, 2226, 1966,
+	1770, 2228, 2171, 2231, 2224, 2337, 2303, 1950, 2242, 1555,
+	1482, 1090, 2088, 1956, 1719, 1481, 1985, 1049, 1987, 168,
+	1988, 1455, 1117, 527, 1477, 1393, 2234, 2173, 1981, 2296,
+	1692, 1693, 1694, 2207, 2148, 1971, 1914, 1915, 542, 539,
+	1986, 540, 1493, 1762, 994, 525, 519, 2018, 1109, 1514,
+	1512, 1511, 1668, 168, 1121, 2002, 2003, 1979, 1975, 1115,
+	1497, 503, 1556, 1885, 2038, 1993, 973, 606, 503, 514,
+	791, 1452, 2213, 1686, 168, 2077, 605, 61, 38, 169,
+	2014, 507, 2325, 961, 168, 615, 32, 2013, 31, 30,
+	29, 28, 23, 2033, 22, 21, 20, 19, 168, 25,
+	2028, 168, 18, 17, 16, 101, 1960, 2027, 1589, 2030,
+	2055, 504, 48, 2031, 45, 2036, 2035, 503, 43, 108,
+	107, 1943, 46, 42, 893, 27, 2015, 2016, 504, 504,
+	26, 504, 15, 504, 504, 2050, 504, 504, 504, 504,
+	504, 504, 14, 2049, 13, 12, 11, 10, 9, 5,
+	4, 504, 24, 1038, 2, 169, 1958, 0, 0, 0,
+	0, 0, 2052, 2053, 0, 0, 0, 0, 0, 556,
+	34, 169, 2065, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 504, 0, 169, 1010, 1011, 1003, 1004, 1005,
+	1006, 1007, 1008, 1009, 1002, 2100, 0, 1012, 0, 169,
+	0, 2092, 0, 0, 34, 0, 0, 2101, 2106, 0,
+	1770, 0, 0, 0, 2107, 169, 0, 0, 0, 0,
+	168, 0, 169, 168, 168, 168, 503, 503, 0, 2108,
+	0, 169, 169, 169, 169, 169, 169, 169, 169, 169,
+	504, 504, 504, 0, 0, 2143, 503, 503, 503, 0,
+	592, 0, 0, 0, 2087, 0, 0, 0, 2149, 0,
+	0, 0, 2062, 2063, 2152, 2064, 2060, 0, 2066, 0,
+	2068, 2128, 0, 169, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 503, 503, 503, 168, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 503, 0, 503,
+	0, 2176, 0, 0, 0, 503, 2111, 0, 2113, 2180,
+	503, 2168, 2150, 2166, 2167, 0, 0, 0, 0, 2182,
+	2178, 0, 1912, 1913, 0, 0, 0, 0, 1966, 0,
+	0, 0, 1966, 0, 504, 0, 0, 1936, 1937, 503,
+	1938, 1939, 503, 2189, 2079, 2190, 0, 0, 0, 0,
+	2185, 1945, 1946, 2193, 2196, 2187, 2195, 1698, 0, 2203,
+	0, 1699, 0, 0, 0, 2151, 0, 518, 504, 504,
+	0, 0, 1706, 1707, 2102, 503, 0, 2103, 1713, 169,
+	2105, 1716, 1717, 0, 2200, 0, 0, 0, 2169, 1723,
+	0, 1724, 0, 504, 1727, 1728, 1729, 1730, 1731, 2223,
+	169, 2238, 0, 504, 0, 503, 168, 169, 1741, 169,
+	0, 0, 0, 2245, 1966, 0, 0, 169, 169, 0,
+	503, 0, 2158, 0, 504, 0, 0, 504, 0, 0,
+	2251, 553, 0, 0, 0, 1995, 0, 0, 504, 0,
+	503, 0, 0, 0, 2259, 2184, 0, 2266, 503, 503,
+	2249, 2186, 0, 0, 2074, 1785, 1786, 2285, 2277, 2282,
+	2290, 2272, 0, 2299, 0, 2203, 2292, 0, 0, 0,
+	0, 503, 2308, 2307, 1770, 0, 0, 0, 2314, 0,
+	2316, 0, 0, 0, 0, 0, 0, 0, 0, 2175,
+	518, 502, 2080, 504, 2322, 0, 0, 0, 0, 0,
+	0, 2334, 2328, 0, 0, 0, 0, 0, 164, 0,
+	0, 0, 0, 0, 0, 0, 2149, 504, 0, 2344,
+	0, 0, 0, 504, 2350, 0, 633, 0, 0, 783,
+	2352, 790, 0, 2353, 106, 0, 0, 2056, 2359, 0,
+	0, 0, 0, 0, 0, 0, 0, 148, 1001, 1000,
+	1010, 1011, 1003, 1004, 1005, 1006, 1007, 1008, 1009, 1002,
+	0, 0, 1012, 0, 0, 0, 0, 504, 0, 0,
+	0, 2371, 2372, 1001, 1000, 1010, 1011, 1003, 1004, 1005,
+	1006, 1007, 1008, 1009, 1002, 0, 0, 1012, 1803, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 145, 0, 146, 0, 0, 0, 0, 0, 0,
+	169, 0, 163, 0, 0, 0, 0, 169, 0, 0,
+	0, 0, 169, 169, 0, 0, 169, 2110, 169, 0,
+	0, 1917, 1918, 0, 169, 0, 0, 0, 0, 0,
+	0, 169, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 518, 0, 0, 0, 0, 953, 953, 953,
+	0, 2073, 0, 0, 0, 0, 0, 0, 0, 169,
+	504, 0, 0, 0, 0, 0, 0, 34, 0, 0,
+	149, 0, 0, 0, 0, 0, 0, 0, 0, 154,
+	0, 0, 1021, 1023, 0, 0, 0, 1969, 2153, 2154,
+	2155, 2156, 2157, 0, 0, 0, 2160, 2161, 0, 0,
+	0, 0, 0, 0, 0, 0, 2072, 0, 1984, 0,
+	0, 0, 0, 1036, 0, 0, 0, 1041, 1042, 1043,
+	1044, 1045, 1046, 1047, 1048, 0, 1051, 1053, 1056, 1056,
+	1056, 1053, 1056, 1056, 1053, 1056, 1069, 1070, 1071, 1072,
+	1073, 1074, 1075, 0, 0, 0, 0, 0, 1081, 0,
+	0, 0, 34, 0, 0, 0, 0, 169, 0, 0,
+	0, 0, 0, 0, 0, 169, 0, 0, 0, 0,
+	1001, 1000, 1010, 1011, 1003, 1004, 1005, 1006, 1007, 1008,
+	1009, 1002, 0, 1118, 1012, 141, 0, 0, 169, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 169,
+	169, 169, 169, 169, 0, 0, 0, 0, 0, 0,
+	0, 169, 0, 0, 0, 169, 0, 0, 169, 169,
+	0, 0, 169, 169, 169, 1001, 1000, 1010, 1011, 1003,
+	1004, 1005, 1006, 1007, 1008, 1009, 1002, 0, 0, 1012,
+	2059, 0, 0, 0, 2061, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 2070, 2071, 0, 0, 0,
+	2286, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 2085, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 504, 0, 0, 0, 0, 169, 2094, 2095,
+	0, 0, 2099, 0, 169, 0, 0, 0, 0, 0,
+	504, 0, 0, 0, 0, 0, 504, 0, 0, 633,
+	633, 633, 0, 2333, 2333, 0, 504, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 960, 962, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 169,
+	169, 169, 169, 169, 0, 0, 2333, 0, 0, 0,
+	0, 2333, 0, 2132, 0, 169, 169, 0, 0, 142,
+	147, 144, 150, 151, 152, 153, 155, 156, 157, 158,
+	0, 0, 0, 0, 0, 159, 160, 161, 162, 0,
+	0, 0, 0, 0, 2333, 2333, 0, 0, 996, 0,
+	999, 0, 504, 0, 0, 0, 1013, 1014, 1015, 1016,
+	1017, 1018, 1019, 2163, 997, 998, 995, 1001, 1000, 1010,
+	1011, 1003, 1004, 1005, 1006, 1007, 1008, 1009, 1002, 164,
+	0, 1012, 0, 0, 0, 0, 0, 0, 504, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 169,
+	1105, 0, 0, 0, 0, 106, 0, 0, 633, 504,
+	0, 0, 0, 0, 1135, 504, 504, 0, 148, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 2206, 0,
+	0, 0, 0, 0, 2209, 2210, 2211, 2212, 169, 2216,
+	0, 2217, 2218, 2220, 0, 0, 0, 2221, 2222, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 953, 953, 953, 0, 0, 0, 169, 0, 0,
+	0, 0, 145, 0, 146, 0, 0, 0, 0, 0,
+	0, 554, 0, 163, 0, 0, 0, 0, 0, 0,
+	34, 0, 34, 0, 2253, 0, 0, 0, 0, 0,
+	0, 169, 0, 0, 0, 0, 0, 0, 0, 504,
+	0, 0, 0, 0, 1080, 0, 504, 0, 0, 0,
+	0, 0, 169, 0, 0, 0, 0, 0, 0, 0,
+	167, 0, 169, 458, 0, 0, 498, 0, 0, 0,
+	0, 0, 0, 458, 0, 0, 169, 0, 0, 169,
+	0, 149, 458, 0, 0, 0, 0, 0, 0, 0,
+	154, 0, 0, 0, 0, 504, 457, 2323, 2324, 0,
+	0, 619, 619, 0, 0, 1911, 506, 0, 0, 0,
+	458, 0, 0, 0, 0, 588, 0, 0, 0, 0,
+	0, 0, 0, 0, 783, 1001, 1000, 1010, 1011, 1003,
+	1004, 1005, 1006, 1007, 1008, 1009, 1002, 1234, 0, 1012,
+	0, 1240, 1240, 787, 1240, 0, 1240, 1240, 0, 1249,
+	1240, 1240, 1240, 1240, 1240, 2363, 1697, 0, 0, 0,
+	0, 0, 1234, 1234, 783, 0, 0, 0, 1526, 0,
+	0, 0, 0, 0, 0, 0, 1001, 1000, 1010, 1011,
+	1003, 1004, 1005, 1006, 1007, 1008, 1009, 1002, 0, 0,
+	1012, 0, 0, 0, 0, 1310, 141, 0, 169, 0,
+	0, 169, 169, 169, 504, 504, 1001, 1000, 1010, 1011,
+	1003, 1004, 1005, 1006, 1007, 1008, 1009, 1002, 0, 0,
+	1012, 0, 0, 0, 504, 504, 504, 0, 0, 0,
+	0, 0, 164, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 633, 633, 633, 0, 0, 106, 0,
+	128, 504, 504, 504, 169, 0, 0, 0, 0, 0,
+	0, 148, 0, 0, 0, 504, 0, 504, 0, 0,
+	0, 0, 0, 504, 0, 0, 0, 0, 504, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 138, 0, 0, 0, 0, 127, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 504, 0, 0,
+	504, 0, 0, 0, 0, 145, 0, 146, 0, 0,
+	0, 0, 115, 116, 137, 136, 163, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1429, 0, 633,
+	0, 0, 0, 504, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1234, 0, 0, 0, 0, 0, 0,
+	142, 147, 144, 150, 151, 152, 153, 155, 156, 157,
+	158, 1461, 1462, 504, 169, 0, 159, 160, 161, 162,
+	0, 0, 0, 132, 113, 139, 120, 112, 504, 133,
+	134, 0, 0, 0, 149, 0, 1494, 0, 0, 0,
+	0, 0, 0, 154, 121, 0, 1105, 0, 504, 633,
+	0, 0, 0, 0, 0, 0, 504, 504, 124, 122,
+	117, 118, 119, 123, 0, 0, 0, 633, 114, 0,
+	633, 0, 0, 0, 0, 0, 0, 125, 0, 504,
+	0, 783, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 458, 0, 458, 0,
+	0, 458, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1702, 0, 0, 592, 0, 0,
+	0, 0, 0, 0, 0, 1162, 0, 0, 0, 894,
+	0, 899, 0, 0, 901, 0, 790, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 141,
+	0, 0, 0, 0, 1739, 0, 0, 0, 0, 0,
+	783, 0, 0, 0, 0, 0, 790, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1118,
+	0, 0, 0, 0, 0, 0, 1766, 1767, 0, 0,
+	1118, 1118, 1118, 1118, 1118, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1526, 135, 0, 1118,
+	783, 0, 0, 1118, 0, 0, 0, 0, 0, 129,
+	0, 0, 130, 0, 0, 0, 0, 0, 458, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 619, 1150,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 458, 0, 458, 1124, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1163, 0, 0, 0, 1111, 0, 0, 1122,
+	0, 0, 0, 0, 0, 0, 0, 0, 1872, 0,
+	0, 0, 0, 1680, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 142, 147, 144, 150, 151, 152, 153,
+	155, 156, 157, 158, 0, 0, 0, 0, 0, 159,
+	160, 161, 162, 1176, 1179, 1180, 1181, 1182, 1183, 1184,
+	0, 1185, 1186, 1187, 1188, 1189, 1164, 1165, 1166, 1167,
+	1148, 1149, 1177, 0, 1151, 0, 1152, 1153, 1154, 1155,
+	1156, 1157, 1158, 1159, 1160, 1161, 1168, 1169, 1170, 1171,
+	1172, 1173, 1174, 1175, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 458, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1967, 0, 34, 0, 0, 0,
+	0, 1234, 0, 1178, 0, 1140, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1235, 0, 1118,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1235, 1235, 0, 0, 0, 0, 458, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1286, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 458, 0, 0,
+	0, 1271, 0, 0, 0, 1847, 0, 0, 0, 0,
+	0, 0, 1331, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1859, 0, 0, 0, 0, 458, 1865,
+	1312, 0, 0, 0, 0, 458, 0, 633, 0, 1871,
+	0, 0, 0, 0, 1354, 1355, 458, 458, 458, 458,
+	458, 458, 458, 0, 0, 0, 0, 0, 0, 0,
+	0, 1341, 0, 0, 0, 0, 2057, 0, 1345, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1356,
+	1357, 1358, 1359, 1360, 1361, 1362, 458, 0, 0, 0,
+	2076, 0, 0, 0, 0, 0, 0, 2082, 2083, 2084,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 633, 0, 0, 0, 1122,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 619, 1331,
+	0, 1240, 0, 619, 619, 0, 0, 619, 619, 619,
+	0, 0, 0, 1235, 0, 0, 0, 0, 0, 0,
+	0, 0, 633, 0, 0, 1234, 0, 0, 1970, 1240,
+	0, 0, 0, 619, 619, 619, 619, 619, 0, 0,
+	0, 0, 1286, 0, 0, 0, 0, 0, 0, 0,
+	0, 164, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 458, 1857, 0, 0, 0, 0, 1331,
+	458, 0, 458, 0, 0, 0, 0, 106, 0, 128,
+	458, 458, 0, 0, 0, 0, 0, 0, 0, 0,
+	148, 0, 0, 1967, 0, 34, 1501, 1967, 0, 0,
+	0, 0, 0, 1505, 0, 1508, 0, 0, 0, 0,
+	0, 0, 0, 0, 1527, 0, 0, 0, 0, 0,
+	0, 138, 783, 0, 0, 1234, 127, 0, 0, 1859,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 145, 0, 146, 0, 0, 0,
+	0, 1215, 1216, 137, 136, 163, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 2058, 1967,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 2244, 0, 0, 0, 0, 34,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 132, 1217, 139, 0, 1214, 0, 133, 134,
+	0, 0, 0, 149, 0, 0, 0, 0, 0, 0,
+	0, 0, 154, 0, 0, 1234, 0, 0, 0, 0,
+	0, 0, 0, 34, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 2315, 0, 458, 0, 0, 0, 0, 0, 0,
+	458, 0, 0, 0, 0, 458, 458, 1859, 2129, 458,
+	0, 1671, 0, 0, 0, 0, 0, 458, 0, 0,
+	0, 0, 0, 0, 458, 0, 1122, 2144, 2145, 2146,
+	0, 0, 0, 1655, 0, 0, 0, 0, 1664, 1665,
+	0, 0, 1669, 0, 0, 0, 0, 0, 0, 0,
+	1672, 0, 458, 0, 0, 0, 0, 1675, 141, 0,
+	0, 0, 0, 0, 2164, 2164, 2164, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 2181, 0,
+	2183, 0, 0, 0, 0, 1679, 1859, 0, 0, 0,
+	0, 1859, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 619, 619, 0, 135, 0, 0, 0,
+	1859, 0, 0, 633, 0, 0, 0, 0, 129, 0,
+	0, 130, 0, 0, 619, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	458, 0, 0, 0, 0, 0, 2236, 0, 1286, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	619, 458, 0, 0, 0, 0, 1859, 0, 0, 0,
+	0, 1235, 458, 458, 458, 458, 458, 0, 0, 0,
+	0, 2257, 0, 0, 1784, 0, 0, 0, 458, 0,
+	0, 458, 458, 0, 0, 458, 1794, 1331, 0, 1234,
+	0, 2273, 0, 0, 0, 0, 0, 0, 0, 633,
+	633, 0, 142, 147, 144, 150, 151, 152, 153, 155,
+	156, 157, 158, 0, 0, 1791, 0, 0, 159, 160,
+	161, 162, 2236, 35, 36, 37, 73, 39, 40, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 77, 0, 0, 0, 41, 68, 69,
+	458, 66, 70, 0, 0, 0, 0, 1856, 0, 0,
+	67, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1331, 0, 0,
+	0, 0, 0, 1850, 0, 0, 0, 0, 0, 54,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 72,
+	0, 0, 458, 458, 458, 458, 458, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 458, 458,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1889, 1890, 1891, 1892, 1893,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1122, 1899, 0, 619, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	44, 47, 50, 49, 52, 0, 65, 0, 0, 71,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 458, 0, 0, 0, 0, 0, 0, 0,
+	0, 53, 76, 75, 0, 1235, 63, 64, 51, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1955, 0, 0, 0, 0,
+	0, 458, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 55, 56, 0,
+	57, 58, 59, 60, 0, 0, 0, 0, 0, 0,
+	458, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 2000, 458, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1235, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 458, 0, 0, 0, 0,
+	0, 0, 0, 62, 0, 458, 0, 2021, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 458,
+	0, 0, 458, 0, 0, 0, 0, 0, 2034, 0,
+	0, 0, 0, 0, 74, 0, 0, 0, 2037, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 2048, 0, 0, 2051, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1235, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 458, 0, 0, 458, 458, 458, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 2124, 0, 0, 2125, 2126, 2127,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 761, 696, 675, 747, 401, 1286, 695, 764,
+	665, 683, 774, 686, 689, 730, 644, 709, 321, 680,
+	0, 669, 640, 676, 641, 667, 698, 228, 664, 749,
+	712, 763, 279, 225, 646, 670, 335, 685, 179, 732,
+	377, 213, 288, 286, 406, 239, 231, 227, 211, 263,
+	294, 333, 395, 327, 770, 283, 719, 0, 386, 306,
+	0, 0, 0, 700, 753, 707, 743, 694, 731, 654,
+	718, 765, 681, 727, 766, 269, 210, 178, 318, 387,
+	243, 0, 0, 0, 170, 171, 172, 0, 2293, 2294,
+	0, 0, 0, 0, 0, 201, 0, 208, 724, 760,
+	678, 726, 223, 267, 230, 222, 403, 771, 752, 0,
+	194, 421, 342, 252, 762, 702, 729, 777, 639, 721,
+	0, 642, 645, 773, 756, 673, 233, 458, 0, 0,
+	0, 0, 0, 0, 699, 708, 740, 692, 0, 0,
+	0, 0, 0, 0, 0, 0, 671, 0, 717, 0,
+	0, 0, 650, 643, 0, 0, 0, 0, 697, 1235,
+	2250, 0, 653, 0, 672, 741, 0, 637, 251, 647,
+	307, 0, 745, 755, 693, 436, 759, 691, 690, 736,
+	651, 751, 684, 278, 649, 275, 174, 190, 0, 682,
+	317, 358, 364, 750, 668, 677, 214, 674, 362, 331,
+	420, 197, 241, 355, 336, 360, 716, 734, 361, 284,
+	408, 349, 418, 437, 438, 221, 311, 426, 399, 432,
+	449, 191, 218, 325, 392, 423, 383, 304, 404, 405,
+	274, 382, 249, 177, 282, 446, 189, 370, 205, 182,
+	394, 416, 202, 373, 0, 0, 451, 184, 414, 391,
+	301, 271, 272, 183, 0, 354, 226, 247, 216, 320,
+	411, 412, 215, 452, 193, 431, 186, 955, 430, 313,
+	407, 415, 302, 293, 185, 413, 300, 292, 277, 237,
+	258, 347, 287, 348, 259, 309, 308, 310, 0, 180,
+	0, 388, 424, 453, 198, 199, 200, 663, 236, 240,
+	246, 248, 254, 255, 262, 280, 324, 341, 346, 344,
+	350, 746, 402, 419, 427, 434, 435, 441, 442, 443,
+	447, 444, 445, 448, 312, 261, 384, 276, 285, 738,
+	776, 330, 363, 203, 422, 385, 658, 662, 656, 657,
+	710, 711, 659, 767, 768, 769, 742, 652, 0, 660,
+	661, 0, 748, 757, 758, 715, 173, 187, 281, 772,
+	351, 244, 450, 429, 425, 638, 655, 220, 666, 0,
+	679, 687, 688, 701, 703, 704, 705, 706, 714, 722,
+	723, 725, 735, 737, 739, 744, 754, 175, 176, 188,
+	196, 206, 219, 234, 242, 257, 260, 264, 265, 268,
+	273, 290, 295, 296, 297, 298, 314, 315, 316, 319,
+	322, 323, 326, 328, 329, 332, 338, 339, 340, 343,
+	345, 352, 357, 365, 366, 367, 368, 369, 371, 372,
+	378, 379, 380, 381, 389, 393, 409, 410, 433, 439,
+	733, 775, 375, 353, 374, 212, 253, 417, 440, 0,
+	289, 713, 720, 291, 238, 256, 266, 728, 428, 390,
+	192, 359, 245, 181, 209, 195, 217, 232, 235, 270,
+	299, 305, 334, 337, 250, 229, 207, 356, 204, 376,
+	396, 397, 398, 400, 303, 224, 761, 696, 675, 747,
+	401, 0, 695, 764, 665, 683, 774, 686, 689, 730,
+	644, 709, 321, 680, 0, 669, 640, 676, 641, 667,
+	698, 228, 664, 749, 712, 763, 279, 225, 646, 670,
+	335, 685, 179, 732, 377, 213, 288, 286, 406, 239,
+	231, 227, 211, 263, 294, 333, 395, 327, 770, 283,
+	719, 0, 386, 306, 0, 0, 0, 700, 753, 707,
+	743, 694, 731, 654, 718, 765, 681, 727, 766, 269,
+	210, 178, 318, 387, 243, 0, 0, 0, 170, 171,
+	172, 0, 0, 0, 0, 0, 0, 0, 0, 201,
+	0, 208, 724, 760, 678, 726, 223, 267, 230, 222,
+	403, 771, 752, 0, 194, 421, 342, 252, 762, 702,
+	729, 777, 639, 721, 0, 642, 645, 773, 756, 673,
+	233, 0, 0, 0, 0, 0, 0, 0, 699, 708,
+	740, 692, 0, 0, 0, 0, 0, 0, 1959, 0,
+	671, 0, 717, 0, 0, 0, 650, 643, 0, 0,
+	0, 0, 697, 0, 0, 0, 653, 0, 672, 741,
+	0, 637, 251, 647, 307, 0, 745, 755, 693, 436,
+	759, 691, 690, 736, 651, 751, 684, 278, 649, 275,
+	174, 190, 0, 682, 317, 358, 364, 750, 668, 677,
+	214, 674, 362, 331, 420, 197, 241, 355, 336, 360,
+	716, 734, 361, 284, 408, 349, 418, 437, 438, 221,
+	311, 426, 399, 432, 449, 191, 218, 325, 392, 423,
+	383, 304, 404, 405, 274, 382, 249, 177, 282, 446,
+	189, 370, 205, 182, 394, 416, 202, 373, 0, 0,
+	451, 184, 414, 391, 301, 271, 272, 183, 0, 354,
+	226, 247, 216, 320, 411, 412, 215, 452, 193, 431,
+	186, 955, 430, 313, 407, 415, 302, 293, 185, 413,
+	300, 292, 277, 237, 258, 347, 287, 348, 259, 309,
+	308, 310, 0, 180, 0, 388, 424, 453, 198, 199,
+	200, 663, 236, 240, 246, 248, 254, 255, 262, 280,
+	324, 341, 346, 344, 350, 746, 402, 419, 427, 434,
+	435, 441, 442, 443, 447, 444, 445, 448, 312, 261,
+	384, 276, 285, 738, 776, 330, 363, 203, 422, 385,
+	658, 662, 656, 657, 710, 711, 659, 767, 768, 769,
+	742, 652, 0, 660, 661, 0, 748, 757, 758, 715,
+	173, 187, 281, 772, 351, 244, 450, 429, 425, 638,
+	655, 220, 666, 0, 679, 687, 688, 701, 703, 704,
+	705, 706, 714, 722, 723, 725, 735, 737, 739, 744,
+	754, 175, 176, 188, 196, 206, 219, 234, 242, 257,
+	260, 264, 265, 268, 273, 290, 295, 296, 297, 298,
+	314, 315, 316, 319, 322, 323, 326, 328, 329, 332,
+	338, 339, 340, 343, 345, 352, 357, 365, 366, 367,
+	368, 369, 371, 372, 378, 379, 380, 381, 389, 393,
+	409, 410, 433, 439, 733, 775, 375, 353, 374, 212,
+	253, 417, 440, 0, 289, 713, 720, 291, 238, 256,
+	266, 728, 428, 390, 192, 359, 245, 181, 209, 195,
+	217, 232, 235, 270, 299, 305, 334, 337, 250, 229,
+	207, 356, 204, 376, 396, 397, 398, 400, 303, 224,
+	761, 696, 675, 747, 401, 0, 695, 764, 665, 683,
+	774, 686, 689, 730, 644, 709, 321, 680, 0, 669,
+	640, 676, 641, 667, 698, 228, 664, 749, 712, 763,
+	279, 225, 646, 670, 335, 685, 179, 732, 377, 213,
+	288, 286, 406, 239, 231, 227, 211, 263, 294, 333,
+	395, 327, 770, 283, 719, 0, 386, 306, 0, 0,
+	0, 700, 753, 707, 743, 694, 731, 654, 718, 765,
+	681, 727, 766, 269, 210, 178, 318, 387, 243, 0,
+	0, 0, 170, 171, 172, 0, 0, 0, 0, 0,
+	0, 0, 0, 201, 0, 208, 724, 760, 678, 726,
+	223, 267, 230, 222, 403, 771, 752, 0, 194, 421,
+	342, 252, 762, 702, 729, 777, 639, 721, 0, 642,
+	645, 773, 756, 673, 233, 0, 0, 0, 0, 0,
+	0, 0, 699, 708, 740, 692, 0, 0, 0, 0,
+	0, 0, 1795, 0, 671, 0, 717, 0, 0, 0,
+	650, 643, 0, 0, 0, 0, 697, 0, 0, 0,
+	653, 0, 672, 741, 0, 637, 251, 647, 307, 0,
+	745, 755, 693, 436, 759, 691, 690, 736, 651, 751,
+	684, 278, 649, 275, 174, 190, 0, 682, 317, 358,
+	364, 750, 668, 677, 214, 674, 362, 331, 420, 197,
+	241, 355, 336, 360, 716, 734, 361, 284, 408, 349,
+	418, 437, 438, 221, 311, 426, 399, 432, 449, 191,
+	218, 325, 392, 423, 383, 304, 404, 405, 274, 382,
+	249, 177, 282, 446, 189, 370, 205, 182, 394, 416,
+	202, 373, 0, 0, 451, 184, 414, 391, 301, 271,
+	272, 183, 0, 354, 226, 247, 216, 320, 411, 412,
+	215, 452, 193, 431, 186, 955, 430, 313, 407, 415,
+	302, 293, 185, 413, 300, 292, 277, 237, 258, 347,
+	287, 348, 259, 309, 308, 310, 0, 180, 0, 388,
+	424, 453, 198, 199, 200, 663, 236, 240, 246, 248,
+	254, 255, 262, 280, 324, 341, 346, 344, 350, 746,
+	402, 419, 427, 434, 435, 441, 442, 443, 447, 444,
+	445, 448, 312, 261, 384, 276, 285, 738, 776, 330,
+	363, 203, 422, 385, 658, 662, 656, 657, 710, 711,
+	659, 767, 768, 769, 742, 652, 0, 660, 661, 0,
+	748, 757, 758, 715, 173, 187, 281, 772, 351, 244,
+	450, 429, 425, 638, 655, 220, 666, 0, 679, 687,
+	688, 701, 703, 704, 705, 706, 714, 722, 723, 725,
+	735, 737, 739, 744, 754, 175, 176, 188, 196, 206,
+	219, 234, 242, 257, 260, 264, 265, 268, 273, 290,
+	295, 296, 297, 298, 314, 315, 316, 319, 322, 323,
+	326, 328, 329, 332, 338, 339, 340, 343, 345, 352,
+	357, 365, 366, 367, 368, 369, 371, 372, 378, 379,
+	380, 381, 389, 393, 409, 410, 433, 439, 733, 775,
+	375, 353, 374, 212, 253, 417, 440, 0, 289, 713,
+	720, 291, 238, 256, 266, 728, 428, 390, 192, 359,
+	245, 181, 209, 195, 217, 232, 235, 270, 299, 305,
+	334, 337, 250, 229, 207, 356, 204, 376, 396, 397,
+	398, 400, 303, 224, 761, 696, 675, 747, 401, 0,
+	695, 764, 665, 683, 774, 686, 689, 730, 644, 709,
+	321, 680, 0, 669, 640, 676, 641, 667, 698, 228,
+	664, 749, 712, 763, 279, 225, 646, 670, 335, 685,
+	179, 732, 377, 213, 288, 286, 406, 239, 231, 227,
+	211, 263, 294, 333, 395, 327, 770, 283, 719, 0,
+	386, 306, 0, 0, 0, 700, 753, 707, 743, 694,
+	731, 654, 718, 765, 681, 727, 766, 269, 210, 178,
+	318, 387, 243, 0, 0, 0, 170, 171, 172, 0,
+	0, 0, 0, 0, 0, 0, 0, 201, 0, 208,
+	724, 760, 678, 726, 223, 267, 230, 222, 403, 771,
+	752, 0, 194, 421, 342, 252, 762, 702, 729, 777,
+	639, 721, 0, 642, 645, 773, 756, 673, 233, 0,
+	0, 0, 0, 0, 0, 0, 699, 708, 740, 692,
+	0, 0, 0, 0, 0, 0, 1503, 0, 671, 0,
+	717, 0, 0, 0, 650, 643, 0, 0, 0, 0,
+	697, 0, 0, 0, 653, 0, 672, 741, 0, 637,
+	251, 647, 307, 0, 745, 755, 693, 436, 759, 691,
+	690, 736, 651, 751, 684, 278, 649, 275, 174, 190,
+	0, 682, 317, 358, 364, 750, 668, 677, 214, 674,
+	362, 331, 420, 197, 241, 355, 336, 360, 716, 734,
+	361, 284, 408, 349, 418, 437, 438, 221, 311, 426,
+	399, 432, 449, 191, 218, 325, 392, 423, 383, 304,
+	404, 405, 274, 382, 249, 177, 282, 446, 189, 370,
+	205, 182, 394, 416, 202, 373, 0, 0, 451, 184,
+	414, 391, 301, 271, 272, 183, 0, 354, 226, 247,
+	216, 320, 411, 412, 215, 452, 193, 431, 186, 955,
+	430, 313, 407, 415, 302, 293, 185, 413, 300, 292,
+	277, 237, 258, 347, 287, 348, 259, 309, 308, 310,
+	0, 180, 0, 388, 424, 453, 198, 199, 200, 663,
+	236, 240, 246, 248, 254, 255, 262, 280, 324, 341,
+	346, 344, 350, 746, 402, 419, 427, 434, 435, 441,
+	442, 443, 447, 444, 445, 448, 312, 261, 384, 276,
+	285, 738, 776, 330, 363, 203, 422, 385, 658, 662,
+	656, 657, 710, 711, 659, 767, 768, 769, 742, 652,
+	0, 660, 661, 0, 748, 757, 758, 715, 173, 187,
+	281, 772, 351, 244, 450, 429, 425, 638, 655, 220,
+	666, 0, 679, 687, 688, 701, 703, 704, 705, 706,
+	714, 722, 723, 725, 735, 737, 739, 744, 754, 175,
+	176, 188, 196, 206, 219, 234, 242, 257, 260, 264,
+	265, 268, 273, 290, 295, 296, 297, 298, 314, 315,
+	316, 319, 322, 323, 326, 328, 329, 332, 338, 339,
+	340, 343, 345, 352, 357, 365, 366, 367, 368, 369,
+	371, 372, 378, 379, 380, 381, 389, 393, 409, 410,
+	433, 439, 733, 775, 375, 353, 374, 212, 253, 417,
+	440, 0, 289, 713, 720, 291, 238, 256, 266, 728,
+	428, 390, 192, 359, 245, 181, 209, 195, 217, 232,
+	235, 270, 299, 305, 334, 337, 250, 229, 207, 356,
+	204, 376, 396, 397, 398, 400, 303, 224, 761, 696,
+	675, 747, 401, 0, 695, 764, 665, 683, 774, 686,
+	689, 730, 644, 709, 321, 680, 0, 669, 640, 676,
+	641, 667, 698, 228, 664, 749, 712, 763, 279, 225,
+	646, 670, 335, 685, 179, 732, 377, 213, 288, 286,
+	406, 239, 231, 227, 211, 263, 294, 333, 395, 327,
+	770, 283, 719, 0, 386, 306, 0, 0, 0, 700,
+	753, 707, 743, 694, 731, 654, 718, 765, 681, 727,
+	766, 269, 210, 178, 318, 387, 243, 72, 0, 0,
+	170, 171, 172, 0, 0, 0, 0, 0, 0, 0,
+	0, 201, 0, 208, 724, 760, 678, 726, 223, 267,
+	230, 222, 403, 771, 752, 0, 194, 421, 342, 252,
+	762, 702, 729, 777, 639, 721, 0, 642, 645, 773,
+	756, 673, 233, 0, 0, 0, 0, 0, 0, 0,
+	699, 708, 740, 692, 0, 0, 0, 0, 0, 0,
+	0, 0, 671, 0, 717, 0, 0, 0, 650, 643,
+	0, 0, 0, 0, 697, 0, 0, 0, 653, 0,
+	672, 741, 0, 637, 251, 647, 307, 0, 745, 755,
+	693, 436, 759, 691, 690, 736, 651, 751, 684, 278,
+	649, 275, 174, 190, 0, 682, 317, 358, 364, 750,
+	668, 677, 214, 674, 362, 331, 420, 197, 241, 355,
+	336, 360, 716, 734, 361, 284, 408, 349, 418, 437,
+	438, 221, 311, 426, 399, 432, 449, 191, 218, 325,
+	392, 423, 383, 304, 404, 405, 274, 382, 249, 177,
+	282, 446, 189, 370, 205, 182, 394, 416, 202, 373,
+	0, 0, 451, 184, 414, 391, 301, 271, 272, 183,
+	0, 354, 226, 247, 216, 320, 411, 412, 215, 452,
+	193, 431, 186, 955, 430, 313, 407, 415, 302, 293,
+	185, 413, 300, 292, 277, 237, 258, 347, 287, 348,
+	259, 309, 308, 310, 0, 180, 0, 388, 424, 453,
+	198, 199, 200, 663, 236, 240, 246, 248, 254, 255,
+	262, 280, 324, 341, 346, 344, 350, 746, 402, 419,
+	427, 434, 435, 441, 442, 443, 447, 444, 445, 448,
+	312, 261, 384, 276, 285, 738, 776, 330, 363, 203,
+	422, 385, 658, 662, 656, 657, 710, 711, 659, 767,
+	768, 769, 742, 652, 0, 660, 661, 0, 748, 757,
+	758, 715, 173, 187, 281, 772, 351, 244, 450, 429,
+	425, 638, 655, 220, 666, 0, 679, 687, 688, 701,
+	703, 704, 705, 706, 714, 722, 723, 725, 735, 737,
+	739, 744, 754, 175, 176, 188, 196, 206, 219, 234,
+	242, 257, 260, 264, 265, 268, 273, 290, 295, 296,
+	297, 298, 314, 315, 316, 319, 322, 323, 326, 328,
+	329, 332, 338, 339, 340, 343, 345, 352, 357, 365,
+	366, 367, 368, 369, 371, 372, 378, 379, 380, 381,
+	389, 393, 409, 410, 433, 439, 733, 775, 375, 353,
+	374, 212, 253, 417, 440, 0, 289, 713, 720, 291,
+	238, 256, 266, 728, 428, 390, 192, 359, 245, 181,
+	209, 195, 217, 232, 235, 270, 299, 305, 334, 337,
+	250, 229, 207, 356, 204, 376, 396, 397, 398, 400,
+	303, 224, 761, 696, 675, 747, 401, 0, 695, 764,
+	665, 683, 774, 686, 689, 730, 644, 709, 321, 680,
+	0, 669, 640, 676, 641, 667, 698, 228, 664, 749,
+	712, 763, 279, 225, 646, 670, 335, 685, 179, 732,
+	377, 213, 288, 286, 406, 239, 231, 227, 211, 263,
+	294, 333, 395, 327, 770, 283, 719, 0, 386, 306,
+	0, 0, 0, 700, 753, 707, 743, 694, 731, 654,
+	718, 765, 681, 727, 766, 269, 210, 178, 318, 387,
+	243, 0, 0, 0, 170, 171, 172, 0, 0, 0,
+	0, 0, 0, 0, 0, 201, 0, 208, 724, 760,
+	678, 726, 223, 267, 230, 222, 403, 771, 752, 0,
+	194, 421, 342, 252, 762, 702, 729, 777, 639, 721,
+	0, 642, 645, 773, 756, 673, 233, 0, 0, 0,
+	0, 0, 0, 0, 699, 708, 740, 692, 0, 0,
+	0, 0, 0, 0, 0, 0, 671, 0, 717, 0,
+	0, 0, 650, 643, 0, 0, 0, 0, 697, 0,
+	0, 0, 653, 0, 672, 741, 0, 637, 251, 647,
+	307, 0, 745, 755, 693, 436, 759, 691, 690, 736,
+	651, 751, 684, 278, 649, 275, 174, 190, 0, 682,
+	317, 358, 364, 750, 668, 677, 214, 674, 362, 331,
+	420, 197, 241, 355, 336, 360, 716, 734, 361, 284,
+	408, 349, 418, 437, 438, 221, 311, 426, 399, 432,
+	449, 191, 218, 325, 392, 423, 383, 304, 404, 405,
+	274, 382, 249, 177, 282, 446, 189, 370, 205, 182,
+	394, 416, 202, 373, 0, 0, 451, 184, 414, 391,
+	301, 271, 272, 183, 0, 354, 226, 247, 216, 320,
+	411, 412, 215, 452, 193, 431, 186, 955, 430, 313,
+	407, 415, 302, 293, 185, 413, 300, 292, 277, 237,
+	258, 347, 287, 348, 259, 309, 308, 310, 0, 180,
+	0, 388, 424, 453, 198, 199, 200, 663, 236, 240,
+	246, 248, 254, 255, 262, 280, 324, 341, 346, 344,
+	350, 746, 402, 419, 427, 434, 435, 441, 442, 443,
+	447, 444, 445, 448, 312, 261, 384, 276, 285, 738,
+	776, 330, 363, 203, 422, 385, 658, 662, 656, 657,
+	710, 711, 659, 767, 768, 769, 742, 652, 0, 660,
+	661, 0, 748, 757, 758, 715, 173, 187, 281, 772,
+	351, 244, 450, 429, 425, 638, 655, 220, 666, 0,
+	679, 687, 688, 701, 703, 704, 705, 706, 714, 722,
+	723, 725, 735, 737, 739, 744, 754, 175, 176, 188,
+	196, 206, 219, 234, 242, 257, 260, 264, 265, 268,
+	273, 290, 295, 296, 297, 298, 314, 315, 316, 319,
+	322, 323, 326, 328, 329, 332, 338, 339, 340, 343,
+	345, 352, 357, 365, 366, 367, 368, 369, 371, 372,
+	378, 379, 380, 381, 389, 393, 409, 410, 433, 439,
+	733, 775, 375, 353, 374, 212, 253, 417, 440, 0,
+	289, 713, 720, 291, 238, 256, 266, 728, 428, 390,
+	192, 359, 245, 181, 209, 195, 217, 232, 235, 270,
+	299, 305, 334, 337, 250, 229, 207, 356, 204, 376,
+	396, 397, 398, 400, 303, 224, 761, 696, 675, 747,
+	401, 0, 695, 764, 665, 683, 774, 686, 689, 730,
+	644, 709, 321, 680, 0, 669, 640, 676, 641, 667,
+	698, 228, 664, 749, 712, 763, 279, 225, 646, 670,
+	335, 685, 179, 732, 377, 213, 288, 286, 406, 239,
+	231, 227, 211, 263, 294, 333, 395, 327, 770, 283,
+	719, 0, 386, 306, 0, 0, 0, 700, 753, 707,
+	743, 694, 731, 654, 718, 765, 681, 727, 766, 269,
+	210, 178, 318, 387, 243, 0, 0, 0, 170, 171,
+	172, 0, 0, 0, 0, 0, 0, 0, 0, 201,
+	0, 208, 724, 760, 678, 726, 223, 267, 230, 222,
+	403, 771, 752, 0, 778, 421, 342, 252, 762, 702,
+	729, 777, 639, 721, 0, 642, 645, 773, 756, 673,
+	233, 0, 0, 0, 0, 0, 0, 0, 699, 708,
+	740, 692, 0, 0, 0, 0, 0, 0, 0, 0,
+	671, 0, 717, 0, 0, 0, 650, 643, 0, 0,
+	0, 0, 697, 0, 0, 0, 653, 0, 672, 741,
+	0, 637, 251, 647, 307, 0, 745, 755, 693, 436,
+	759, 691, 690, 736, 651, 751, 684, 278, 649, 275,
+	174, 190, 0, 682, 317, 358, 364, 750, 668, 677,
+	214, 674, 362, 331, 420, 197, 241, 355, 336, 360,
+	716, 734, 361, 284, 408, 349, 418, 437, 438, 221,
+	311, 426, 399, 432, 449, 191, 218, 325, 392, 423,
+	383, 304, 404, 405, 274, 382, 249, 177, 282, 446,
+	189, 370, 205, 182, 394, 416, 202, 373, 0, 0,
+	451, 184, 414, 391, 301, 271, 272, 183, 0, 354,
+	226, 247, 216, 320, 411, 412, 215, 452, 193, 431,
+	186, 648, 430, 313, 407, 415, 302, 293, 185, 413,
+	300, 292, 277, 237, 258, 347, 287, 348, 259, 309,
+	308, 310, 0, 180, 0, 388, 424, 453, 198, 199,
+	200, 663, 236, 240, 246, 248, 254, 255, 262, 280,
+	324, 341, 346, 344, 350, 746, 402, 419, 427, 434,
+	435, 441, 442, 443, 447, 444, 445, 448, 636, 630,
+	629, 276, 285, 738, 776, 330, 363, 203, 422, 385,
+	658, 662, 656, 657, 710, 711, 659, 767, 768, 769,
+	742, 652, 0, 660, 661, 0, 748, 757, 758, 715,
+	173, 187, 281, 772, 351, 244, 450, 429, 425, 638,
+	655, 220, 666, 0, 679, 687, 688, 701, 703, 704,
+	705, 706, 714, 722, 723, 725, 735, 737, 739, 744,
+	754, 175, 176, 188, 196, 206, 219, 234, 242, 257,
+	260, 264, 265, 268, 273, 290, 295, 296, 297, 298,
+	314, 315, 316, 319, 322, 323, 326, 328, 329, 332,
+	338, 339, 340, 343, 345, 352, 357, 365, 366, 367,
+	368, 369, 371, 372, 378, 379, 380, 381, 389, 393,
+	409, 410, 433, 439, 733, 775, 375, 353, 374, 212,
+	253, 417, 440, 0, 289, 713, 720, 291, 238, 256,
+	266, 728, 428, 390, 192, 359, 245, 181, 209, 195,
+	217, 232, 235, 270, 299, 305, 334, 337, 250, 229,
+	207, 356, 204, 376, 396, 397, 398, 400, 303, 224,
+	761, 696, 675, 747, 401, 0, 695, 764, 665, 683,
+	774, 686, 689, 730, 644, 709, 321, 680, 0, 669,
+	640, 676, 641, 667, 698, 228, 664, 749, 712, 763,
+	279, 225, 646, 670, 335, 685, 179, 732, 377, 213,
+	288, 286, 406, 239, 231, 227, 211, 263, 294, 333,
+	395, 327, 770, 283, 719, 0, 386, 306, 0, 0,
+	0, 700, 753, 707, 743, 694, 731, 654, 718, 765,
+	681, 727, 766, 269, 210, 178, 318, 387, 243, 0,
+	0, 0, 170, 171, 172, 0, 0, 0, 0, 0,
+	0, 0, 0, 201, 0, 208, 724, 760, 678, 726,
+	223, 267, 230, 222, 403, 771, 752, 0, 778, 421,
+	342, 252, 762, 702, 729, 777, 639, 721, 0, 642,
+	645, 773, 756, 673, 233, 0, 0, 0, 0, 0,
+	0, 0, 699, 708, 740, 692, 0, 0, 0, 0,
+	0, 0, 0, 0, 671, 0, 717, 0, 0, 0,
+	650, 643, 0, 0, 0, 0, 697, 0, 0, 0,
+	653, 0, 672, 741, 0, 637, 251, 647, 307, 0,
+	745, 755, 693, 436, 759, 691, 690, 736, 651, 751,
+	684, 278, 649, 275, 174, 190, 0, 682, 317, 358,
+	364, 750, 668, 677, 214, 674, 362, 331, 420, 197,
+	241, 355, 336, 360, 716, 734, 361, 284, 408, 349,
+	418, 437, 438, 221, 311, 426, 399, 432, 449, 191,
+	218, 325, 392, 423, 383, 304, 404, 405, 274, 382,
+	249, 177, 282, 446, 189, 370, 205, 182, 394, 1126,
+	202, 373, 0, 0, 451, 184, 414, 391, 301, 271,
+	272, 183, 0, 354, 226, 247, 216, 320, 411, 412,
+	215, 452, 193, 431, 186, 648, 430, 313, 407, 415,
+	302, 293, 185, 413, 300, 292, 277, 237, 258, 347,
+	287, 348, 259, 309, 308, 310, 0, 180, 0, 388,
+	424, 453, 198, 199, 200, 663, 236, 240, 246, 248,
+	254, 255, 262, 280, 324, 341, 346, 344, 350, 746,
+	402, 419, 427, 434, 435, 441, 442, 443, 447, 444,
+	445, 448, 636, 630, 629, 276, 285, 738, 776, 330,
+	363, 203, 422, 385, 658, 662, 656, 657, 710, 711,
+	659, 767, 768, 769, 742, 652, 0, 660, 661, 0,
+	748, 757, 758, 715, 173, 187, 281, 772, 351, 244,
+	450, 429, 425, 638, 655, 220, 666, 0, 679, 687,
+	688, 701, 703, 704, 705, 706, 714, 722, 723, 725,
+	735, 737, 739, 744, 754, 175, 176, 188, 196, 206,
+	219, 234, 242, 257, 260, 264, 265, 268, 273, 290,
+	295, 296, 297, 298, 314, 315, 316, 319, 322, 323,
+	326, 328, 329, 332, 338, 339, 340, 343, 345, 352,
+	357, 365, 366, 367, 368, 369, 371, 372, 378, 379,
+	380, 381, 389, 393, 409, 410, 433, 439, 733, 775,
+	375, 353, 374, 212, 253, 417, 440, 0, 289, 713,
+	720, 291, 238, 256, 266, 728, 428, 390, 192, 359,
+	245, 181, 209, 195, 217, 232, 235, 270, 299, 305,
+	334, 337, 250, 229, 207, 356, 204, 376, 396, 397,
+	398, 400, 303, 224, 761, 696, 675, 747, 401, 0,
+	695, 764, 665, 683, 774, 686, 689, 730, 644, 709,
+	321, 680, 0, 669, 640, 676, 641, 667, 698, 228,
+	664, 749, 712, 763, 279, 225, 646, 670, 335, 685,
+	179, 732, 377, 213, 288, 286, 406, 239, 231, 227,
+	211, 263, 294, 333, 395, 327, 770, 283, 719, 0,
+	386, 306, 0, 0, 0, 700, 753, 707, 743, 694,
+	731, 654, 718, 765, 681, 727, 766, 269, 210, 178,
+	318, 387, 243, 0, 0, 0, 170, 171, 172, 0,
+	0, 0, 0, 0, 0, 0, 0, 201, 0, 208,
+	724, 760, 678, 726, 223, 267, 230, 222, 403, 771,
+	752, 0, 778, 421, 342, 252, 762, 702, 729, 777,
+	639, 721, 0, 642, 645, 773, 756, 673, 233, 0,
+	0, 0, 0, 0, 0, 0, 699, 708, 740, 692,
+	0, 0, 0, 0, 0, 0, 0, 0, 671, 0,
+	717, 0, 0, 0, 650, 643, 0, 0, 0, 0,
+	697, 0, 0, 0, 653, 0, 672, 741, 0, 637,
+	251, 647, 307, 0, 745, 755, 693, 436, 759, 691,
+	690, 736, 651, 751, 684, 278, 649, 275, 174, 190,
+	0, 682, 317, 358, 364, 750, 668, 677, 214, 674,
+	362, 331, 420, 197, 241, 355, 336, 360, 716, 734,
+	361, 284, 408, 349, 418, 437, 438, 221, 311, 426,
+	399, 432, 449, 191, 218, 325, 392, 423, 383, 304,
+	404, 405, 274, 382, 249, 177, 282, 446, 189, 370,
+	205, 182, 394, 627, 202, 373, 0, 0, 451, 184,
+	414, 391, 301, 271, 272, 183, 0, 354, 226, 247,
+	216, 320, 411, 412, 215, 452, 193, 431, 186, 648,
+	430, 313, 407, 415, 302, 293, 185, 413, 300, 292,
+	277, 237, 258, 347, 287, 348, 259, 309, 308, 310,
+	0, 180, 0, 388, 424, 453, 198, 199, 200, 663,
+	236, 240, 246, 248, 254, 255, 262, 280, 324, 341,
+	346, 344, 350, 746, 402, 419, 427, 434, 435, 441,
+	442, 443, 447, 444, 445, 448, 636, 630, 629, 276,
+	285, 738, 776, 330, 363, 203, 422, 385, 658, 662,
+	656, 657, 710, 711, 659, 767, 768, 769, 742, 652,
+	0, 660, 661, 0, 748, 757, 758, 715, 173, 187,
+	281, 772, 351, 244, 450, 429, 425, 638, 655, 220,
+	666, 0, 679, 687, 688, 701, 703, 704, 705, 706,
+	714, 722, 723, 725, 735, 737, 739, 744, 754, 175,
+	176, 188, 196, 206, 219, 234, 242, 257, 260, 264,
+	265, 268, 273, 290, 295, 296, 297, 298, 314, 315,
+	316, 319, 322, 323, 326, 328, 329, 332, 338, 339,
+	340, 343, 345, 352, 357, 365, 366, 367, 368, 369,
+	371, 372, 378, 379, 380, 381, 389, 393, 409, 410,
+	433, 439, 733, 775, 375, 353, 374, 212, 253, 417,
+	440, 0, 289, 713, 720, 291, 238, 256, 266, 728,
+	428, 390, 192, 359, 245, 181, 209, 195, 217, 232,
+	235, 270, 299, 305, 334, 337, 250, 229, 207, 356,
+	204, 376, 396, 397, 398, 400, 303, 224, 401, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	321, 0, 0, 1431, 0, 523, 0, 0, 0, 228,
+	522, 0, 0, 0, 279, 225, 0, 1432, 335, 0,
+	179, 0, 377, 213, 288, 286, 406, 239, 231, 227,
+	211, 263, 294, 333, 395, 327, 566, 283, 0, 0,
+	386, 306, 0, 0, 0, 0, 0, 557, 558, 0,
+	0, 0, 0, 0, 0, 0, 0, 269, 210, 178,
+	318, 387, 243, 72, 0, 0, 170, 171, 172, 544,
+	543, 546, 547, 548, 549, 0, 0, 201, 545, 208,
+	550, 551, 552, 0, 223, 267, 230, 222, 403, 0,
+	0, 0, 194, 421, 342, 252, 0, 0, 0, 0,
+	0, 520, 537, 0, 565, 0, 0, 0, 233, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 534, 535, 617, 0, 0, 0,
+	581, 0, 536, 0, 0, 529, 530, 532, 531, 533,
+	538, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	251, 0, 307, 0, 580, 0, 0, 436, 0, 0,
+	578, 0, 0, 0, 0, 278, 0, 275, 174, 190,
+	0, 0, 317, 358, 364, 0, 0, 0, 214, 0,
+	362, 331, 420, 197, 241, 355, 336, 360, 0, 0,
+	361, 284, 408, 349, 418, 437, 438, 221, 311, 426,
+	399, 432, 449, 191, 218, 325, 392, 423, 383, 304,
+	404, 405, 274, 382, 249, 177, 282, 446, 189, 370,
+	205, 182, 394, 416, 202, 373, 0, 0, 451, 184,
+	414, 391, 301, 271, 272, 183, 0, 354, 226, 247,
+	216, 320, 411, 412, 215, 452, 193, 431, 186, 0,
+	430, 313, 407, 415, 302, 293, 185, 413, 300, 292,
+	277, 237, 258, 347, 287, 348, 259, 309, 308, 310,
+	0, 180, 0, 388, 424, 453, 198, 199, 200, 0,
+	236, 240, 246, 248, 254, 255, 262, 280, 324, 341,
+	346, 344, 350, 0, 402, 419, 427, 434, 435, 441,
+	442, 443, 447, 444, 445, 448, 312, 261, 384, 276,
+	285, 0, 0, 330, 363, 203, 422, 385, 568, 579,
+	574, 575, 572, 573, 567, 571, 570, 569, 582, 559,
+	560, 561, 562, 564, 0, 576, 577, 563, 173, 187,
+	281, 0, 351, 244, 450, 429, 425, 0, 0, 220,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 175,
+	176, 188, 196, 206, 219, 234, 242, 257, 260, 264,
+	265, 268, 273, 290, 295, 296, 297, 298, 314, 315,
+	316, 319, 322, 323, 326, 328, 329, 332, 338, 339,
+	340, 343, 345, 352, 357, 365, 366, 367, 368, 369,
+	371, 372, 378, 379, 380, 381, 389, 393, 409, 410,
+	433, 439, 0, 0, 375, 353, 374, 212, 253, 417,
+	440, 0, 289, 0, 0, 291, 238, 256, 266, 0,
+	428, 390, 192, 359, 245, 181, 209, 195, 217, 232,
+	235, 270, 299, 305, 334, 337, 250, 229, 207, 356,
+	204, 376, 396, 397, 398, 400, 303, 224, 401, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	321, 0, 0, 0, 0, 523, 0, 0, 0, 228,
+	522, 0, 0, 0, 279, 225, 0, 0, 335, 0,
+	179, 0, 377, 213, 288, 286, 406, 239, 231, 227,
+	211, 263, 294, 333, 395, 327, 566, 283, 0, 0,
+	386, 306, 0, 0, 0, 0, 0, 557, 558, 0,
+	0, 0, 0, 0, 0, 1542, 0, 269, 210, 178,
+	318, 387, 243, 72, 0, 0, 170, 171, 172, 544,
+	543, 546, 547, 548, 549, 0, 0, 201, 545, 208,
+	550, 551, 552, 1543, 223, 267, 230, 222, 403, 0,
+	0, 0, 194, 421, 342, 252, 0, 0, 0, 0,
+	0, 520, 537, 0, 565, 0, 0, 0, 233, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 534, 535, 0, 0, 0, 0,
+	581, 0, 536, 0, 0, 529, 530, 532, 531, 533,
+	538, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	251, 0, 307, 0, 580, 0, 0, 436, 0, 0,
+	578, 0, 0, 0, 0, 278, 0, 275, 174, 190,
+	0, 0, 317, 358, 364, 0, 0, 0, 214, 0,
+	362, 331, 420, 197, 241, 355, 336, 360, 0, 0,
+	361, 284, 408, 349, 418, 437, 438, 221, 311, 426,
+	399, 432, 449, 191, 218, 325, 392, 423, 383, 304,
+	404, 405, 274, 382, 249, 177, 282, 446, 189, 370,
+	205, 182, 394, 416, 202, 373, 0, 0, 451, 184,
+	414, 391, 301, 271, 272, 183, 0, 354, 226, 247,
+	216, 320, 411, 412, 215, 452, 193, 431, 186, 0,
+	430, 313, 407, 415, 302, 293, 185, 413, 300, 292,
+	277, 237, 258, 347, 287, 348, 259, 309, 308, 310,
+	0, 180, 0, 388, 424, 453, 198, 199, 200, 0,
+	236, 240, 246, 248, 254, 255, 262, 280, 324, 341,
+	346, 344, 350, 0, 402, 419, 427, 434, 435, 441,
+	442, 443, 447, 444, 445, 448, 312, 261, 384, 276,
+	285, 0, 0, 330, 363, 203, 422, 385, 568, 579,
+	574, 575, 572, 573, 567, 571, 570, 569, 582, 559,
+	560, 561, 562, 564, 0, 576, 577, 563, 173, 187,
+	281, 0, 351, 244, 450, 429, 425, 0, 0, 220,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 175,
+	176, 188, 196, 206, 219, 234, 242, 257, 260, 264,
+	265, 268, 273, 290, 295, 296, 297, 298, 314, 315,
+	316, 319, 322, 323, 326, 328, 329, 332, 338, 339,
+	340, 343, 345, 352, 357, 365, 366, 367, 368, 369,
+	371, 372, 378, 379, 380, 381, 389, 393, 409, 410,
+	433, 439, 0, 0, 375, 353, 374, 212, 253, 417,
+	440, 0, 289, 0, 0, 291, 238, 256, 266, 0,
+	428, 390, 192, 359, 245, 181, 209, 195, 217, 232,
+	235, 270, 299, 305, 334, 337, 250, 229, 207, 356,
+	204, 376, 396, 397, 398, 400, 303, 224, 401, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	321, 0, 0, 0, 0, 523, 0, 0, 0, 228,
+	522, 0, 0, 0, 279, 225, 0, 0, 335, 0,
+	179, 0, 377, 213, 288, 286, 406, 239, 231, 227,
+	211, 263, 294, 333, 395, 327, 566, 283, 0, 0,
+	386, 306, 0, 0, 0, 0, 0, 557, 558, 0,
+	0, 0, 0, 0, 0, 0, 0, 269, 210, 178,
+	318, 387, 243, 72, 0, 604, 170, 171, 172, 544,
+	543, 546, 547, 548, 549, 0, 0, 201, 545, 208,
+	550, 551, 552, 0, 223, 267, 230, 222, 403, 0,
+	0, 0, 194, 421, 342, 252, 0, 0, 0, 0,
+	0, 520, 537, 0, 565, 0, 0, 0, 233, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 534, 535, 0, 0, 0, 0,
+	581, 0, 536, 0, 0, 529, 530, 532, 531, 533,
+	538, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	251, 0, 307, 0, 580, 0, 0, 436, 0, 0,
+	578, 0, 0, 0, 0, 278, 0, 275, 174, 190,
+	0, 0, 317, 358, 364, 0, 0, 0, 214, 0,
+	362, 331, 420, 197, 241, 355, 336, 360, 0, 0,
+	361, 284, 408, 349, 418, 437, 438, 221, 311, 426,
+	399, 432, 449, 191, 218, 325, 392, 423, 383, 304,
+	404, 405, 274, 382, 249, 177, 282, 446, 189, 370,
+	205, 182, 394, 416, 202, 373, 0, 0, 451, 184,
+	414, 391, 301, 271, 272, 183, 0, 354, 226, 247,
+	216, 320, 411, 412, 215, 452, 193, 431, 186, 0,
+	430, 313, 407, 415, 302, 293, 185, 413, 300, 292,
+	277, 237, 258, 347, 287, 348, 259, 309, 308, 310,
+	0, 180, 0, 388, 424, 453, 198, 199, 200, 0,
+	236, 240, 246, 248, 254, 255, 262, 280, 324, 341,
+	346, 344, 350, 0, 402, 419, 427, 434, 435, 441,
+	442, 443, 447, 444, 445, 448, 312, 261, 384, 276,
+	285, 0, 0, 330, 363, 203, 422, 385, 568, 579,
+	574, 575, 572, 573, 567, 571, 570, 569, 582, 559,
+	560, 561, 562, 564, 0, 576, 577, 563, 173, 187,
+	281, 0, 351, 244, 450, 429, 425, 0, 0, 220,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 175,
+	176, 188, 196, 206, 219, 234, 242, 257, 260, 264,
+	265, 268, 273, 290, 295, 296, 297, 298, 314, 315,
+	316, 319, 322, 323, 326, 328, 329, 332, 338, 339,
+	340, 343, 345, 352, 357, 365, 366, 367, 368, 369,
+	371, 372, 378, 379, 380, 381, 389, 393, 409, 410,
+	433, 439, 0, 0, 375, 353, 374, 212, 253, 417,
+	440, 0, 289, 0, 0, 291, 238, 256, 266, 0,
+	428, 390, 192, 359, 245, 181, 209, 195, 217, 232,
+	235, 270, 299, 305, 334, 337, 250, 229, 207, 356,
+	204, 376, 396, 397, 398, 400, 303, 224, 401, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	321, 0, 0, 0, 0, 523, 0, 0, 0, 228,
+	522, 0, 0, 0, 279, 225, 0, 0, 335, 0,
+	179, 0, 377, 213, 288, 286, 406, 239, 231, 227,
+	211, 263, 294, 333, 395, 327, 566, 283, 0, 0,
+	386, 306, 0, 0, 0, 0, 0, 557, 558, 0,
+	0, 0, 0, 0, 0, 0, 0, 269, 210, 178,
+	318, 387, 243, 72, 0, 0, 170, 171, 172, 544,
+	543, 546, 547, 548, 549, 0, 0, 201, 545, 208,
+	550, 551, 552, 0, 223, 267, 230, 222, 403, 0,
+	0, 0, 194, 421, 342, 252, 0, 0, 0, 0,
+	0, 520, 537, 0, 565, 0, 0, 0, 233, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 534, 535, 617, 0, 0, 0,
+	581, 0, 536, 0, 0, 529, 530, 532, 531, 533,
+	538, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	251, 0, 307, 0, 580, 0, 0, 436, 0, 0,
+	578, 0, 0, 0, 0, 278, 0, 275, 174, 190,
+	0, 0, 317, 358, 364, 0, 0, 0, 214, 0,
+	362, 331, 420, 197, 241, 355, 336, 360, 0, 0,
+	361, 284, 408, 349, 418, 437, 438, 221, 311, 426,
+	399, 432, 449, 191, 218, 325, 392, 423, 383, 304,
+	404, 405, 274, 382, 249, 177, 282, 446, 189, 370,
+	205, 182, 394, 416, 202, 373, 0, 0, 451, 184,
+	414, 391, 301, 271, 272, 183, 0, 354, 226, 247,
+	216, 320, 411, 412, 215, 452, 193, 431, 186, 0,
+	430, 313, 407, 415, 302, 293, 185, 413, 300, 292,
+	277, 237, 258, 347, 287, 348, 259, 309, 308, 310,
+	0, 180, 0, 388, 424, 453, 198, 199, 200, 0,
+	236, 240, 246, 248, 254, 255, 262, 280, 324, 341,
+	346, 344, 350, 0, 402, 419, 427, 434, 435, 441,
+	442, 443, 447, 444, 445, 448, 312, 261, 384, 276,
+	285, 0, 0, 330, 363, 203, 422, 385, 568, 579,
+	574, 575, 572, 573, 567, 571, 570, 569, 582, 559,
+	560, 561, 562, 564, 0, 576, 577, 563, 173, 187,
+	281, 0, 351, 244, 450, 429, 425, 0, 0, 220,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 175,
+	176, 188, 196, 206, 219, 234, 242, 257, 260, 264,
+	265, 268, 273, 290, 295, 296, 297, 298, 314, 315,
+	316, 319, 322, 323, 326, 328, 329, 332, 338, 339,
+	340, 343, 345, 352, 357, 365, 366, 367, 368, 369,
+	371, 372, 378, 379, 380, 381, 389, 393, 409, 410,
+	433, 439, 0, 0, 375, 353, 374, 212, 253, 417,
+	440, 0, 289, 0, 0, 291, 238, 256, 266, 0,
+	428, 390, 192, 359, 245, 181, 209, 195, 217, 232,
+	235, 270, 299, 305, 334, 337, 250, 229, 207, 356,
+	204, 376, 396, 397, 398, 400, 303, 224, 401, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	321, 0, 0, 0, 0, 523, 0, 0, 0, 228,
+	522, 0, 0, 0, 279, 225, 0, 0, 335, 0,
+	179, 0, 377, 213, 288, 286, 406, 239, 231, 227,
+	211, 263, 294, 333, 395, 327, 566, 283, 0, 0,
+	386, 306, 0, 0, 0, 0, 0, 557, 558, 0,
+	0, 0, 0, 0, 0, 0, 0, 269, 210, 178,
+	318, 387, 243, 72, 0, 0, 170, 171, 172, 544,
+	1449, 546, 547, 548, 549, 0, 0, 201, 545, 208,
+	550, 551, 552, 0, 223, 267, 230, 222, 403, 0,
+	0, 0, 194, 421, 342, 252, 0, 0, 0, 0,
+	0, 520, 537, 0, 565, 0, 0, 0, 233, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 534, 535, 617, 0, 0, 0,
+	581, 0, 536, 0, 0, 529, 530, 532, 531, 533,
+	538, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	251, 0, 307, 0, 580, 0, 0, 436, 0, 0,
+	578, 0, 0, 0, 0, 278, 0, 275, 174, 190,
+	0, 0, 317, 358, 364, 0, 0, 0, 214, 0,
+	362, 331, 420, 197, 241, 355, 336, 360, 0, 0,
+	361, 284, 408, 349, 418, 437, 438, 221, 311, 426,
+	399, 432, 449, 191, 218, 325, 392, 423, 383, 304,
+	404, 405, 274, 382, 249, 177, 282, 446, 189, 370,
+	205, 182, 394, 416, 202, 373, 0, 0, 451, 184,
+	414, 391, 301, 271, 272, 183, 0, 354, 226, 247,
+	216, 320, 411, 412, 215, 452, 193, 431, 186, 0,
+	430, 313, 407, 415, 302, 293, 185, 413, 300, 292,
+	277, 237, 258, 347, 287, 348, 259, 309, 308, 310,
+	0, 180, 0, 388, 424, 453, 198, 199, 200, 0,
+	236, 240, 246, 248, 254, 255, 262, 280, 324, 341,
+	346, 344, 350, 0, 402, 419, 427, 434, 435, 441,
+	442, 443, 447, 444, 445, 448, 312, 261, 384, 276,
+	285, 0, 0, 330, 363, 203, 422, 385, 568, 579,
+	574, 575, 572, 573, 567, 571, 570, 569, 582, 559,
+	560, 561, 562, 564, 0, 576, 577, 563, 173, 187,
+	281, 0, 351, 244, 450, 429, 425, 0, 0, 220,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 175,
+	176, 188, 196, 206, 219, 234, 242, 257, 260, 264,
+	265, 268, 273, 290, 295, 296, 297, 298, 314, 315,
+	316, 319, 322, 323, 326, 328, 329, 332, 338, 339,
+	340, 343, 345, 352, 357, 365, 366, 367, 368, 369,
+	371, 372, 378, 379, 380, 381, 389, 393, 409, 410,
+	433, 439, 0, 0, 375, 353, 374, 212, 253, 417,
+	440, 0, 289, 0, 0, 291, 238, 256, 266, 0,
+	428, 390, 192, 359, 245, 181, 209, 195, 217, 232,
+	235, 270, 299, 305, 334, 337, 250, 229, 207, 356,
+	204, 376, 396, 397, 398, 400, 303, 224, 401, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	321, 0, 0, 0, 0, 523, 0, 0, 0, 228,
+	522, 0, 0, 0, 279, 225, 0, 0, 335, 0,
+	179, 0, 377, 213, 288, 286, 406, 239, 231, 227,
+	211, 263, 294, 333, 395, 327, 566, 283, 0, 0,
+	386, 306, 0, 0, 0, 0, 0, 557, 558, 0,
+	0, 0, 0, 0, 0, 0, 0, 269, 210, 178,
+	318, 387, 243, 72, 0, 0, 170, 171, 172, 544,
+	1446, 546, 547, 548, 549, 0, 0, 201, 545, 208,
+	550, 551, 552, 0, 223, 267, 230, 222, 403, 0,
+	0, 0, 194, 421, 342, 252, 0, 0, 0, 0,
+	0, 520, 537, 0, 565, 0, 0, 0, 233, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 534, 535, 617, 0, 0, 0,
+	581, 0, 536, 0, 0, 529, 530, 532, 531, 533,
+	538, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	251, 0, 307, 0, 580, 0, 0, 436, 0, 0,
+	578, 0, 0, 0, 0, 278, 0, 275, 174, 190,
+	0, 0, 317, 358, 364, 0, 0, 0, 214, 0,
+	362, 331, 420, 197, 241, 355, 336, 360, 0, 0,
+	361, 284, 408, 349, 418, 437, 438, 221, 311, 426,
+	399, 432, 449, 191, 218, 325, 392, 423, 383, 304,
+	404, 405, 274, 382, 249, 177, 282, 446, 189, 370,
+	205, 182, 394, 416, 202, 373, 0, 0, 451, 184,
+	414, 391, 301, 271, 272, 183, 0, 354, 226, 247,
+	216, 320, 411, 412, 215, 452, 193, 431, 186, 0,
+	430, 313, 407, 415, 302, 293, 185, 413, 300, 292,
+	277, 237, 258, 347, 287, 348, 259, 309, 308, 310,
+	0, 180, 0, 388, 424, 453, 198, 199, 200, 0,
+	236, 240, 246, 248, 254, 255, 262, 280, 324, 341,
+	346, 344, 350, 0, 402, 419, 427, 434, 435, 441,
+	442, 443, 447, 444, 445, 448, 312, 261, 384, 276,
+	285, 0, 0, 330, 363, 203, 422, 385, 568, 579,
+	574, 575, 572, 573, 567, 571, 570, 569, 582, 559,
+	560, 561, 562, 564, 0, 576, 577, 563, 173, 187,
+	281, 0, 351, 244, 450, 429, 425, 0, 0, 220,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 175,
+	176, 188, 196, 206, 219, 234, 242, 257, 260, 264,
+	265, 268, 273, 290, 295, 296, 297, 298, 314, 315,
+	316, 319, 322, 323, 326, 328, 329, 332, 338, 339,
+	340, 343, 345, 352, 357, 365, 366, 367, 368, 369,
+	371, 372, 378, 379, 380, 381, 389, 393, 409, 410,
+	433, 439, 0, 0, 375, 353, 374, 212, 253, 417,
+	440, 0, 289, 0, 0, 291, 238, 256, 266, 0,
+	428, 390, 192, 359, 245, 181, 209, 195, 217, 232,
+	235, 270, 299, 305, 334, 337, 250, 229, 207, 356,
+	204, 376, 396, 397, 398, 400, 303, 224, 593, 401,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 321, 0, 0, 0, 0, 523, 0, 0, 0,
+	228, 522, 0, 0, 0, 279, 225, 0, 0, 335,
+	0, 179, 0, 377, 213, 288, 286, 406, 239, 231,
+	227, 211, 263, 294, 333, 395, 327, 566, 283, 0,
+	0, 386, 306, 0, 0, 0, 0, 0, 557, 558,
+	0, 0, 0, 0, 0, 0, 0, 0, 269, 210,
+	178, 318, 387, 243, 72, 0, 0, 170, 171, 172,
+	544, 543, 546, 547, 548, 549, 0, 0, 201, 545,
+	208, 550, 551, 552, 0, 223, 267, 230, 222, 403,
+	0, 0, 0, 194, 421, 342, 252, 0, 0, 0,
+	0, 0, 520, 537, 0, 565, 0, 0, 0, 233,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 534, 535, 0, 0, 0,
+	0, 581, 0, 536, 0, 0, 529, 530, 532, 531,
+	533, 538, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 251, 0, 307, 0, 580, 0, 0, 436, 0,
+	0, 578, 0, 0, 0, 0, 278, 0, 275, 174,
+	190, 0, 0, 317, 358, 364, 0, 0, 0, 214,
+	0, 362, 331, 420, 197, 241, 355, 336, 360, 0,
+	0, 361, 284, 408, 349, 418, 437, 438, 221, 311,
+	426, 399, 432, 449, 191, 218, 325, 392, 423, 383,
+	304, 404, 405, 274, 382, 249, 177, 282, 446, 189,
+	370, 205, 182, 394, 416, 202, 373, 0, 0, 451,
+	184, 414, 391, 301, 271, 272, 183, 0, 354, 226,
+	247, 216, 320, 411, 412, 215, 452, 193, 431, 186,
+	0, 430, 313, 407, 415, 302, 293, 185, 413, 300,
+	292, 277, 237, 258, 347, 287, 348, 259, 309, 308,
+	310, 0, 180, 0, 388, 424, 453, 198, 199, 200,
+	0, 236, 240, 246, 248, 254, 255, 262, 280, 324,
+	341, 346, 344, 350, 0, 402, 419, 427, 434, 435,
+	441, 442, 443, 447, 444, 445, 448, 312, 261, 384,
+	276, 285, 0, 0, 330, 363, 203, 422, 385, 568,
+	579, 574, 575, 572, 573, 567, 571, 570, 569, 582,
+	559, 560, 561, 562, 564, 0, 576, 577, 563, 173,
+	187, 281, 0, 351, 244, 450, 429, 425, 0, 0,
+	220, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	175, 176, 188, 196, 206, 219, 234, 242, 257, 260,
+	264, 265, 268, 273, 290, 295, 296, 297, 298, 314,
+	315, 316, 319, 322, 323, 326, 328, 329, 332, 338,
+	339, 340, 343, 345, 352, 357, 365, 366, 367, 368,
+	369, 371, 372, 378, 379, 380, 381, 389, 393, 409,
+	410, 433, 439, 0, 0, 375, 353, 374, 212, 253,
+	417, 440, 0, 289, 0, 0, 291, 238, 256, 266,
+	0, 428, 390, 192, 359, 245, 181, 209, 195, 217,
+	232, 235, 270, 299, 305, 334, 337, 250, 229, 207,
+	356, 204, 376, 396, 397, 398, 400, 303, 224, 401,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 321, 0, 0, 0, 0, 523, 0, 0, 0,
+	228, 522, 0, 0, 0, 279, 225, 0, 0, 335,
+	0, 179, 0, 377, 213, 288, 286, 406, 239, 231,
+	227, 211, 263, 294, 333, 395, 327, 566, 283, 0,
+	0, 386, 306, 0, 0, 0, 0, 0, 557, 558,
+	0, 0, 0, 0, 0, 0, 0, 0, 269, 210,
+	178, 318, 387, 243, 72, 0, 0, 170, 171, 172,
+	544, 543, 546, 547, 548, 549, 0, 0, 201, 545,
+	208, 550, 551, 552, 0, 223, 267, 230, 222, 403,
+	0, 0, 0, 194, 421, 342, 252, 0, 0, 0,
+	0, 0, 520, 537, 0, 565, 0, 0, 0, 233,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 534, 535, 0, 0, 0,
+	0, 581, 0, 536, 0, 0, 529, 530, 532, 531,
+	533, 538, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 251, 0, 307, 0, 580, 0, 0, 436, 0,
+	0, 578, 0, 0, 0, 0, 278, 0, 275, 174,
+	190, 0, 0, 317, 358, 364, 0, 0, 0, 214,
+	0, 362, 331, 420, 197, 241, 355, 336, 360, 0,
+	0, 361, 284, 408, 349, 418, 437, 438, 221, 311,
+	426, 399, 432, 449, 191, 218, 325, 392, 423, 383,
+	304, 404, 405, 274, 382, 249, 177, 282, 446, 189,
+	370, 205, 182, 394, 416, 202, 373, 0, 0, 451,
+	184, 414, 391, 301, 271, 272, 183, 0, 354, 226,
+	247, 216, 320, 411, 412, 215, 452, 193, 431, 186,
+	0, 430, 313, 407, 415, 302, 293, 185, 413, 300,
+	292, 277, 237, 258, 347, 287, 348, 259, 309, 308,
+	310, 0, 180, 0, 388, 424, 453, 198, 199, 200,
+	0, 236, 240, 246, 248, 254, 255, 262, 280, 324,
+	341, 346, 344, 350, 0, 402, 419, 427, 434, 435,
+	441, 442, 443, 447, 444, 445, 448, 312, 261, 384,
+	276, 285, 0, 0, 330, 363, 203, 422, 385, 568,
+	579, 574, 575, 572, 573, 567, 571, 570, 569, 582,
+	559, 560, 561, 562, 564, 0, 576, 577, 563, 173,
+	187, 281, 0, 351, 244, 450, 429, 425, 0, 0,
+	220, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	175, 176, 188, 196, 206, 219, 234, 242, 257, 260,
+	264, 265, 268, 273, 290, 295, 296, 297, 298, 314,
+	315, 316, 319, 322, 323, 326, 328, 329, 332, 338,
+	339, 340, 343, 345, 352, 357, 365, 366, 367, 368,
+	369, 371, 372, 378, 379, 380, 381, 389, 393, 409,
+	410, 433, 439, 0, 0, 375, 353, 374, 212, 253,
+	417, 440, 0, 289, 0, 0, 291, 238, 256, 266,
+	0, 428, 390, 192, 359, 245, 181, 209, 195, 217,
+	232, 235, 270, 299, 305, 334, 337, 250, 229, 207,
+	356, 204, 376, 396, 397, 398, 400, 303, 224, 401,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 321, 0, 0, 0, 0, 0, 0, 0, 0,
+	228, 0, 0, 0, 0, 279, 225, 0, 0, 335,
+	0, 179, 0, 377, 213, 288, 286, 406, 239, 231,
+	227, 211, 263, 294, 333, 395, 327, 566, 283, 0,
+	0, 386, 306, 0, 0, 0, 0, 0, 557, 558,
+	0, 0, 0, 0, 0, 0, 0, 0, 269, 210,
+	178, 318, 387, 243, 72, 0, 0, 170, 171, 172,
+	544, 543, 546, 547, 548, 549, 0, 0, 201, 545,
+	208, 550, 551, 552, 0, 223, 267, 230, 222, 403,
+	0, 0, 0, 194, 2332, 342, 252, 0, 0, 0,
+	0, 0, 0, 537, 2335, 565, 0, 0, 0, 233,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 534, 535, 0, 0, 0,
+	0, 581, 0, 536, 0, 0, 529, 530, 532, 531,
+	533, 538, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 251, 0, 307, 0, 580, 0, 0, 436, 0,
+	0, 578, 0, 0, 0, 0, 278, 0, 275, 174,
+	190, 0, 0, 317, 358, 364, 0, 0, 0, 214,
+	0, 362, 331, 420, 197, 241, 355, 336, 360, 0,
+	0, 361, 284, 408, 349, 418, 437, 438, 221, 311,
+	426, 399, 432, 449, 191, 218, 325, 392, 423, 383,
+	304, 404, 405, 274, 382, 249, 177, 282, 446, 189,
+	370, 205, 182, 394, 416, 202, 373, 0, 0, 451,
+	184, 414, 391, 301, 271, 272, 183, 0, 354, 226,
+	247, 216, 320, 411, 412, 215, 452, 193, 431, 186,
+	0, 430, 313, 407, 415, 302, 293, 185, 413, 300,
+	292, 277, 237, 258, 347, 287, 348, 259, 309, 308,
+	310, 0, 180, 0, 388, 424, 453, 198, 199, 200,
+	0, 236, 240, 246, 248, 254, 255, 262, 280, 324,
+	341, 346, 344, 350, 0, 402, 419, 427, 434, 435,
+	441, 442, 443, 447, 444, 445, 448, 312, 261, 384,
+	276, 285, 0, 0, 330, 363, 203, 422, 385, 568,
+	579, 574, 575, 572, 573, 567, 571, 570, 569, 582,
+	559, 560, 561, 562, 564, 0, 576, 577, 563, 173,
+	187, 281, 0, 351, 244, 450, 429, 425, 0, 0,
+	220, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	175, 176, 188, 196, 206, 219, 234, 242, 257, 260,
+	264, 265, 268, 273, 290, 295, 296, 297, 298, 314,
+	315, 316, 319, 322, 323, 326, 328, 329, 332, 338,
+	339, 340, 343, 345, 352, 357, 365, 366, 367, 368,
+	369, 371, 372, 378, 379, 380, 381, 389, 393, 409,
+	410, 433, 439, 0, 0, 375, 353, 374, 2331, 253,
+	417, 440, 0, 289, 0, 0, 291, 238, 256, 266,
+	0, 428, 390, 192, 359, 245, 181, 209, 195, 217,
+	232, 235, 270, 299, 305, 334, 337, 250, 229, 207,
+	356, 204, 376, 396, 397, 398, 400, 303, 224, 401,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 321, 0, 0, 0, 0, 0, 0, 0, 0,
+	228, 0, 0, 0, 0, 279, 225, 0, 0, 335,
+	0, 179, 0, 377, 213, 288, 286, 406, 239, 231,
+	227, 211, 263, 294, 333, 395, 327, 566, 283, 0,
+	0, 386, 306, 0, 0, 0, 0, 0, 557, 558,
+	0, 0, 0, 0, 0, 0, 0, 0, 269, 210,
+	178, 318, 387, 243, 72, 0, 0, 170, 171, 172,
+	544, 543, 546, 547, 548, 549, 0, 0, 201, 545,
+	208, 550, 551, 552, 0, 223, 267, 230, 222, 403,
+	0, 0, 0, 194, 2332, 342, 252, 0, 0, 0,
+	0, 0, 0, 537, 2330, 565, 0, 0, 0, 233,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 534, 535, 0, 0, 0,
+	0, 581, 0, 536, 0, 0, 529, 530, 532, 531,
+	533, 538, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 251, 0, 307, 0, 580, 0, 0, 436, 0,
+	0, 578, 0, 0, 0, 0, 278, 0, 275, 174,
+	190, 0, 0, 317, 358, 364, 0, 0, 0, 214,
+	0, 362, 331, 420, 197, 241, 355, 336, 360, 0,
+	0, 361, 284, 408, 349, 418, 437, 438, 221, 311,
+	426, 399, 432, 449, 191, 218, 325, 392, 423, 383,
+	304, 404, 405, 274, 382, 249, 177, 282, 446, 189,
+	370, 205, 182, 394, 416, 202, 373, 0, 0, 451,
+	184, 414, 391, 301, 271, 272, 183, 0, 354, 226,
+	247, 216, 320, 411, 412, 215, 452, 193, 431, 186,
+	0, 430, 313, 407, 415, 302, 293, 185, 413, 300,
+	292, 277, 237, 258, 347, 287, 348, 259, 309, 308,
+	310, 0, 180, 0, 388, 424, 453, 198, 199, 200,
+	0, 236, 240, 246, 248, 254, 255, 262, 280, 324,
+	341, 346, 344, 350, 0, 402, 419, 427, 434, 435,
+	441, 442, 443, 447, 444, 445, 448, 312, 261, 384,
+	276, 285, 0, 0, 330, 363, 203, 422, 385, 568,
+	579, 574, 575, 572, 573, 567, 571, 570, 569, 582,
+	559, 560, 561, 562, 564, 0, 576, 577, 563, 173,
+	187, 281, 0, 351, 244, 450, 429, 425, 0, 0,
+	220, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	175, 176, 188, 196, 206, 219, 234, 242, 257, 260,
+	264, 265, 268, 273, 290, 295, 296, 297, 298, 314,
+	315, 316, 319, 322, 323, 326, 328, 329, 332, 338,
+	339, 340, 343, 345, 352, 357, 365, 366, 367, 368,
+	369, 371, 372, 378, 379, 380, 381, 389, 393, 409,
+	410, 433, 439, 0, 0, 375, 353, 374, 2331, 253,
+	417, 440, 0, 289, 0, 0, 291, 238, 256, 266,
+	0, 428, 390, 192, 359, 245, 181, 209, 195, 217,
+	232, 235, 270, 299, 305, 334, 337, 250, 229, 207,
+	356, 204, 376, 396, 397, 398, 400, 303, 224, 401,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 321, 0, 0, 0, 0, 0, 0, 0, 0,
+	228, 0, 0, 0, 0, 279, 225, 0, 0, 335,
+	0, 179, 0, 377, 213, 288, 286, 406, 239, 231,
+	227, 211, 263, 294, 333, 395, 327, 566, 283, 0,
+	0, 386, 306, 0, 0, 0, 0, 0, 557, 558,
+	0, 0, 0, 0, 0, 0, 0, 0, 269, 210,
+	178, 318, 387, 243, 72, 0, 0, 170, 171, 172,
+	544, 543, 546, 547, 548, 549, 0, 0, 201, 545,
+	208, 550, 551, 552, 0, 223, 267, 230, 222, 403,
+	0, 0, 0, 194, 421, 342, 252, 0, 0, 0,
+	0, 0, 0, 537, 0, 565, 0, 0, 0, 233,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 534, 535, 0, 0, 0,
+	0, 581, 0, 536, 0, 0, 529, 530, 532, 531,
+	533, 538, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 251, 0, 307, 0, 580, 0, 0, 436, 0,
+	0, 578, 0, 0, 0, 0, 278, 0, 275, 174,
+	190, 0, 0, 317, 358, 364, 0, 0, 0, 214,
+	0, 362, 331, 420, 197, 241, 355, 336, 360, 2287,
+	0, 361, 284, 408, 349, 418, 437, 438, 221, 311,
+	426, 399, 432, 449, 191, 218, 325, 392, 423, 383,
+	304, 404, 405, 274, 382, 249, 177, 282, 446, 189,
+	370, 205, 182, 394, 416, 202, 373, 0, 0, 451,
+	184, 414, 391, 301, 271, 272, 183, 0, 354, 226,
+	247, 216, 320, 411, 412, 215, 452, 193, 431, 186,
+	0, 430, 313, 407, 415, 302, 293, 185, 413, 300,
+	292, 277, 237, 258, 347, 287, 348, 259, 309, 308,
+	310, 0, 180, 0, 388, 424, 453, 198, 199, 200,
+	0, 236, 240, 246, 248, 254, 255, 262, 280, 324,
+	341, 346, 344, 350, 0, 402, 419, 427, 434, 435,
+	441, 442, 443, 447, 444, 445, 448, 312, 261, 384,
+	276, 285, 0, 0, 330, 363, 203, 422, 385, 568,
+	579, 574, 575, 572, 573, 567, 571, 570, 569, 582,
+	559, 560, 561, 562, 564, 0, 576, 577, 563, 173,
+	187, 281, 0, 351, 244, 450, 429, 425, 0, 0,
+	220, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	175, 176, 188, 196, 206, 219, 234, 242, 257, 260,
+	264, 265, 268, 273, 290, 295, 296, 297, 298, 314,
+	315, 316, 319, 322, 323, 326, 328, 329, 332, 338,
+	339, 340, 343, 345, 352, 357, 365, 366, 367, 368,
+	369, 371, 372, 378, 379, 380, 381, 389, 393, 409,
+	410, 433, 439, 0, 0, 375, 353, 374, 212, 253,
+	417, 440, 0, 289, 0, 0, 291, 238, 256, 266,
+	0, 428, 390, 192, 359, 245, 181, 209, 195, 217,
+	232, 235, 270, 299, 305, 334, 337, 250, 229, 207,
+	356, 204, 376, 396, 397, 398, 400, 303, 224, 401,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 321, 0, 0, 0, 0, 0, 0, 0, 0,
+	228, 0, 0, 0, 0, 279, 225, 0, 0, 335,
+	0, 179, 0, 377, 213, 288, 286, 406, 239, 231,
+	227, 211, 263, 294, 333, 395, 327, 566, 283, 0,
+	0, 386, 306, 0, 0, 0, 0, 0, 557, 558,
+	0, 0, 0, 0, 0, 0, 0, 0, 269, 210,
+	178, 318, 387, 243, 72, 0, 604, 170, 171, 172,
+	544, 543, 546, 547, 548, 549, 0, 0, 201, 545,
+	208, 550, 551, 552, 0, 223, 267, 230, 222, 403,
+	0, 0, 0, 194, 421, 342, 252, 0, 0, 0,
+	0, 0, 0, 537, 0, 565, 0, 0, 0, 233,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 534, 535, 0, 0, 0,
+	0, 581, 0, 536, 0, 0, 529, 530, 532, 531,
+	533, 538, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 251, 0, 307, 0, 580, 0, 0, 436, 0,
+	0, 578, 0, 0, 0, 0, 278, 0, 275, 174,
+	190, 0, 0, 317, 358, 364, 0, 0, 0, 214,
+	0, 362, 331, 420, 197, 241, 355, 336, 360, 0,
+	0, 361, 284, 408, 349, 418, 437, 438, 221, 311,
+	426, 399, 432, 449, 191, 218, 325, 392, 423, 383,
+	304, 404, 405, 274, 382, 249, 177, 282, 446, 189,
+	370, 205, 182, 394, 416, 202, 373, 0, 0, 451,
+	184, 414, 391, 301, 271, 272, 183, 0, 354, 226,
+	247, 216, 320, 411, 412, 215, 452, 193, 431, 186,
+	0, 430, 313, 407, 415, 302, 293, 185, 413, 300,
+	292, 277, 237, 258, 347, 287, 348, 259, 309, 308,
+	310, 0, 180, 0, 388, 424, 453, 198, 199, 200,
+	0, 236, 240, 246, 248, 254, 255, 262, 280, 324,
+	341, 346, 344, 350, 0, 402, 419, 427, 434, 435,
+	441, 442, 443, 447, 444, 445, 448, 312, 261, 384,
+	276, 285, 0, 0, 330, 363, 203, 422, 385, 568,
+	579, 574, 575, 572, 573, 567, 571, 570, 569, 582,
+	559, 560, 561, 562, 564, 0, 576, 577, 563, 173,
+	187, 281, 0, 351, 244, 450, 429, 425, 0, 0,
+	220, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	175, 176, 188, 196, 206, 219, 234, 242, 257, 260,
+	264, 265, 268, 273, 290, 295, 296, 297, 298, 314,
+	315, 316, 319, 322, 323, 326, 328, 329, 332, 338,
+	339, 340, 343, 345, 352, 357, 365, 366, 367, 368,
+	369, 371, 372, 378, 379, 380, 381, 389, 393, 409,
+	410, 433, 439, 0, 0, 375, 353, 374, 212, 253,
+	417, 440, 0, 289, 0, 0, 291, 238, 256, 266,
+	0, 428, 390, 192, 359, 245, 181, 209, 195, 217,
+	232, 235, 270, 299, 305, 334, 337, 250, 229, 207,
+	356, 204, 376, 396, 397, 398, 400, 303, 224, 401,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 321, 0, 0, 0, 0, 0, 0, 0, 0,
+	228, 0, 0, 0, 0, 279, 225, 0, 0, 335,
+	0, 179, 0, 377, 213, 288, 286, 406, 239, 231,
+	227, 211, 263, 294, 333, 395, 327, 566, 283, 0,
+	0, 386, 306, 0, 0, 0, 0, 0, 557, 558,
+	0, 0, 0, 0, 0, 0, 0, 0, 269, 210,
+	178, 318, 387, 243, 72, 0, 0, 170, 171, 172,
+	544, 543, 546, 547, 548, 549, 0, 0, 201, 545,
+	208, 550, 551, 552, 0, 223, 267, 230, 222, 403,
+	0, 0, 0, 194, 2332, 342, 252, 0, 0, 0,
+	0, 0, 0, 537, 0, 565, 0, 0, 0, 233,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 534, 535, 0, 0, 0,
+	0, 581, 0, 536, 0, 0, 529, 530, 532, 531,
+	533, 538, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 251, 0, 307, 0, 580, 0, 0, 436, 0,
+	0, 578, 0, 0, 0, 0, 278, 0, 275, 174,
+	190, 0, 0, 317, 358, 364, 0, 0, 0, 214,
+	0, 362, 331, 420, 197, 241, 355, 336, 360, 0,
+	0, 361, 284, 408, 349, 418, 437, 438, 221, 311,
+	426, 399, 432, 449, 191, 218, 325, 392, 423, 383,
+	304, 404, 405, 274, 382, 249, 177, 282, 446, 189,
+	370, 205, 182, 394, 416, 202, 373, 0, 0, 451,
+	184, 414, 391, 301, 271, 272, 183, 0, 354, 226,
+	247, 216, 320, 411, 412, 215, 452, 193, 431, 186,
+	0, 430, 313, 407, 415, 302, 293, 185, 413, 300,
+	292, 277, 237, 258, 347, 287, 348, 259, 309, 308,
+	310, 0, 180, 0, 388, 424, 453, 198, 199, 200,
+	0, 236, 240, 246, 248, 254, 255, 262, 280, 324,
+	341, 346, 344, 350, 0, 402, 419, 427, 434, 435,
+	441, 442, 443, 447, 444, 445, 448, 312, 261, 384,
+	276, 285, 0, 0, 330, 363, 203, 422, 385, 568,
+	579, 574, 575, 572, 573, 567, 571, 570, 569, 582,
+	559, 560, 561, 562, 564, 0, 576, 577, 563, 173,
+	187, 281, 0, 351, 244, 450, 429, 425, 0, 0,
+	220, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	175, 176, 188, 196, 206, 219, 234, 242, 257, 260,
+	264, 265, 268, 273, 290, 295, 296, 297, 298, 314,
+	315, 316, 319, 322, 323, 326, 328, 329, 332, 338,
+	339, 340, 343, 345, 352, 357, 365, 366, 367, 368,
+	369, 371, 372, 378, 379, 380, 381, 389, 393, 409,
+	410, 433, 439, 0, 0, 375, 353, 374, 2331, 253,
+	417, 440, 0, 289, 0, 0, 291, 238, 256, 266,
+	0, 428, 390, 192, 359, 245, 181, 209, 195, 217,
+	232, 235, 270, 299, 305, 334, 337, 250, 229, 207,
+	356, 204, 376, 396, 397, 398, 400, 303, 224, 401,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 321, 0, 0, 0, 0, 0, 0, 0, 0,
+	228, 0, 0, 0, 0, 279, 225, 0, 0, 335,
+	0, 179, 0, 377, 213, 288, 286, 406, 239, 231,
+	227, 211, 263, 294, 333, 395, 327, 566, 283, 0,
+	0, 386, 306, 0, 0, 0, 0, 0, 557, 558,
+	0, 0, 0, 0, 0, 0, 0, 0, 269, 210,
+	178, 318, 387, 243, 72, 0, 0, 170, 171, 172,
+	544, 543, 546, 547, 548, 549, 0, 0, 201, 545,
+	208, 550, 551, 552, 0, 223, 267, 230, 222, 403,
+	0, 0, 0, 194, 421, 342, 252, 0, 0, 0,
+	0, 0, 0, 537, 0, 565, 0, 0, 0, 233,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 534, 535, 0, 0, 0,
+	0, 581, 0, 536, 0, 0, 529, 530, 532, 531,
+	533, 538, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 251, 0, 307, 0, 580, 0, 0, 436, 0,
+	0, 578, 0, 0, 0, 0, 278, 0, 275, 174,
+	190, 0, 0, 317, 358, 364, 0, 0, 0, 214,
+	0, 362, 331, 420, 197, 241, 355, 336, 360, 0,
+	0, 361, 284, 408, 349, 418, 437, 438, 221, 311,
+	426, 399, 432, 449, 191, 218, 325, 392, 423, 383,
+	304, 404, 405, 274, 382, 249, 177, 282, 446, 189,
+	370, 205, 182, 394, 416, 202, 373, 0, 0, 451,
+	184, 414, 391, 301, 271, 272, 183, 0, 354, 226,
+	247, 216, 320, 411, 412, 215, 452, 193, 431, 186,
+	0, 430, 313, 407, 415, 302, 293, 185, 413, 300,
+	292, 277, 237, 258, 347, 287, 348, 259, 309, 308,
+	310, 0, 180, 0, 388, 424, 453, 198, 199, 200,
+	0, 236, 240, 246, 248, 254, 255, 262, 280, 324,
+	341, 346, 344, 350, 0, 402, 419, 427, 434, 435,
+	441, 442, 443, 447, 444, 445, 448, 312, 261, 384,
+	276, 285, 0, 0, 330, 363, 203, 422, 385, 568,
+	579, 574, 575, 572, 573, 567, 571, 570, 569, 582,
+	559, 560, 561, 562, 564, 0, 576, 577, 563, 173,
+	187, 281, 0, 351, 244, 450, 429, 425, 0, 0,
+	220, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	175, 176, 188, 196, 206, 219, 234, 242, 257, 260,
+	264, 265, 268, 273, 290, 295, 296, 297, 298, 314,
+	315, 316, 319, 322, 323, 326, 328, 329, 332, 338,
+	339, 340, 343, 345, 352, 357, 365, 366, 367, 368,
+	369, 371, 372, 378, 379, 380, 381, 389, 393, 409,
+	410, 433, 439, 0, 0, 375, 353, 374, 212, 253,
+	417, 440, 0, 289, 0, 0, 291, 238, 256, 266,
+	0, 428, 390, 192, 359, 245, 181, 209, 195, 217,
+	232, 235, 270, 299, 305, 334, 337, 250, 229, 207,
+	356, 204, 376, 396, 397, 398, 400, 303, 224, 401,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 321, 0, 0, 0, 0, 0, 0, 0, 0,
+	228, 0, 0, 0, 0, 279, 225, 0, 0, 335,
+	0, 179, 0, 377, 213, 288, 286, 406, 239, 231,
+	227, 211, 263, 294, 333, 395, 327, 0, 283, 0,
+	0, 386, 306, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 269, 210,
+	178, 318, 387, 243, 0, 0, 0, 170, 171, 172,
+	0, 0, 0, 0, 0, 0, 0, 0, 201, 0,
+	208, 0, 0, 0, 0, 223, 267, 230, 222, 403,
+	0, 0, 0, 194, 421, 342, 252, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 233,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1001, 1000, 1010, 1011, 1003, 1004, 1005, 1006, 1007,
+	1008, 1009, 1002, 0, 0, 1012, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 251, 0, 307, 0, 0, 0, 0, 436, 0,
+	0, 0, 0, 0, 0, 0, 278, 0, 275, 174,
+	190, 0, 0, 317, 358, 364, 0, 0, 0, 214,
+	0, 362, 331, 420, 197, 241, 355, 336, 360, 0,
+	0, 361, 284, 408, 349, 418, 437, 438, 221, 311,
+	426, 399, 432, 449, 191, 218, 325, 392, 423, 383,
+	304, 404, 405, 274, 382, 249, 177, 282, 446, 189,
+	370, 205, 182, 394, 416, 202, 373, 0, 0, 451,
+	184, 414, 391, 301, 271, 272, 183, 0, 354, 226,
+	247, 216, 320, 411, 412, 215, 452, 193, 431, 186,
+	0, 430, 313, 407, 415, 302, 293, 185, 413, 300,
+	292, 277, 237, 258, 347, 287, 348, 259, 309, 308,
+	310, 0, 180, 0, 388, 424, 453, 198, 199, 200,
+	0, 236, 240, 246, 248, 254, 255, 262, 280, 324,
+	341, 346, 344, 350, 0, 402, 419, 427, 434, 435,
+	441, 442, 443, 447, 444, 445, 448, 312, 261, 384,
+	276, 285, 0, 0, 330, 363, 203, 422, 385, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 173,
+	187, 281, 0, 351, 244, 450, 429, 425, 0, 0,
+	220, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	175, 176, 188, 196, 206, 219, 234, 242, 257, 260,
+	264, 265, 268, 273, 290, 295, 296, 297, 298, 314,
+	315, 316, 319, 322, 323, 326, 328, 329, 332, 338,
+	339, 340, 343, 345, 352, 357, 365, 366, 367, 368,
+	369, 371, 372, 378, 379, 380, 381, 389, 393, 409,
+	410, 433, 439, 0, 0, 375, 353, 374, 212, 253,
+	417, 440, 0, 289, 0, 0, 291, 238, 256, 266,
+	0, 428, 390, 192, 359, 245, 181, 209, 195, 217,
+	232, 235, 270, 299, 305, 334, 337, 250, 229, 207,
+	356, 204, 376, 396, 397, 398, 400, 303, 224, 401,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 321, 0, 0, 0, 0, 0, 0, 0, 0,
+	228, 0, 0, 0, 0, 279, 225, 0, 0, 335,
+	0, 179, 0, 377, 213, 288, 286, 406, 239, 231,
+	227, 211, 263, 294, 333, 395, 327, 0, 283, 0,
+	0, 386, 306, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 269, 210,
+	178, 318, 387, 243, 0, 0, 0, 170, 171, 172,
+	0, 0, 0, 0, 0, 0, 0, 0, 201, 0,
+	208, 0, 0, 0, 0, 223, 267, 230, 222, 403,
+	0, 0, 0, 194, 421, 342, 252, 0, 824, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 233,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 251, 0, 307, 0, 0, 0, 823, 436, 0,
+	0, 0, 0, 0, 820, 821, 278, 786, 275, 174,
+	190, 814, 818, 317, 358, 364, 0, 0, 0, 214,
+	0, 362, 331, 420, 197, 241, 355, 336, 360, 0,
+	0, 361, 284, 408, 349, 418, 437, 438, 221, 311,
+	426, 399, 432, 449, 191, 218, 325, 392, 423, 383,
+	304, 404, 405, 274, 382, 249, 177, 282, 446, 189,
+	370, 205, 182, 394, 416, 202, 373, 0, 0, 451,
+	184, 414, 391, 301, 271, 272, 183, 0, 354, 226,
+	247, 216, 320, 411, 412, 215, 452, 193, 431, 186,
+	0, 430, 313, 407, 415, 302, 293, 185, 413, 300,
+	292, 277, 237, 258, 347, 287, 348, 259, 309, 308,
+	310, 0, 180, 0, 388, 424, 453, 198, 199, 200,
+	0, 236, 240, 246, 248, 254, 255, 262, 280, 324,
+	341, 346, 344, 350, 0, 402, 419, 427, 434, 435,
+	441, 442, 443, 447, 444, 445, 448, 312, 261, 384,
+	276, 285, 0, 0, 330, 363, 203, 422, 385, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 173,
+	187, 281, 0, 351, 244, 450, 429, 425, 0, 0,
+	220, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	175, 176, 188, 196, 206, 219, 234, 242, 257, 260,
+	264, 265, 268, 273, 290, 295, 296, 297, 298, 314,
+	315, 316, 319, 322, 323, 326, 328, 329, 332, 338,
+	339, 340, 343, 345, 352, 357, 365, 366, 367, 368,
+	369, 371, 372, 378, 379, 380, 381, 389, 393, 409,
+	410, 433, 439, 0, 0, 375, 353, 374, 212, 253,
+	417, 440, 0, 289, 0, 0, 291, 238, 256, 266,
+	0, 428, 390, 192, 359, 245, 181, 209, 195, 217,
+	232, 235, 270, 299, 305, 334, 337, 250, 229, 207,
+	356, 204, 376, 396, 397, 398, 400, 303, 224, 401,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 321, 0, 0, 0, 1104, 0, 0, 0, 0,
+	228, 0, 0, 0, 0, 279, 225, 0, 0, 335,
+	0, 179, 0, 377, 213, 288, 286, 406, 239, 231,
+	227, 211, 263, 294, 333, 395, 327, 0, 283, 0,
+	0, 386, 306, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 269, 210,
+	178, 318, 387, 243, 0, 0, 0, 170, 171, 172,
+	0, 1106, 0, 0, 0, 0, 0, 0, 201, 0,
+	208, 0, 0, 0, 0, 223, 267, 230, 222, 403,
+	0, 0, 0, 194, 421, 342, 252, 0, 0, 990,
+	991, 989, 0, 0, 0, 0, 0, 0, 0, 233,
+	0, 0, 0, 0, 0, 0, 0, 992, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 251, 0, 307, 0, 0, 0, 0, 436, 0,
+	0, 0, 0, 0, 0, 0, 278, 0, 275, 174,
+	190, 0, 0, 317, 358, 364, 0, 0, 0, 214,
+	0, 362, 331, 420, 197, 241, 355, 336, 360, 0,
+	0, 361, 284, 408, 349, 418, 437, 438, 221, 311,
+	426, 399, 432, 449, 191, 218, 325, 392, 423, 383,
+	304, 404, 405, 274, 382, 249, 177, 282, 446, 189,
+	370, 205, 182, 394, 416, 202, 373, 0, 0, 451,
+	184, 414, 391, 301, 271, 272, 183, 0, 354, 226,
+	247, 216, 320, 411, 412, 215, 452, 193, 431, 186,
+	0, 430, 313, 407, 415, 302, 293, 185, 413, 300,
+	292, 277, 237, 258, 347, 287, 348, 259, 309, 308,
+	310, 0, 180, 0, 388, 424, 453, 198, 199, 200,
+	0, 236, 240, 246, 248, 254, 255, 262, 280, 324,
+	341, 346, 344, 350, 0, 402, 419, 427, 434, 435,
+	441, 442, 443, 447, 444, 445, 448, 312, 261, 384,
+	276, 285, 0, 0, 330, 363, 203, 422, 385, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 173,
+	187, 281, 0, 351, 244, 450, 429, 425, 0, 0,
 	220, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1936, 0, 34, 0, 1936, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1936, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 2200, 0, 0,
-	0, 0, 34, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 742, 728, 393, 0, 677, 745,
-	648, 665, 755, 668, 671, 711, 627, 690, 317, 662,
-	34, 652, 623, 658, 624, 650, 679, 224, 647, 730,
-	693, 744, 275, 221, 629, 653, 331, 667, 176, 713,
-	369, 209, 284, 282, 398, 235, 227, 223, 208, 259,
-	290, 329, 387, 323, 751, 279, 700, 0, 378, 302,
-	0, 0, 0, 681, 734, 688, 724, 676, 712, 637,
-	699, 746, 663, 708, 747, 265, 207, 175, 314, 379,
-	239, 0, 0, 0, 167, 168, 169, 0, 2245, 2246,
-	0, 0, 0, 0, 0, 198, 0, 205, 705, 741,
-	660, 707, 219, 263, 226, 218, 395, 752, 733, 0,
-	191, 743, 683, 710, 758, 622, 702, 0, 625, 628,
-	754, 737, 656, 229, 0, 0, 0, 0, 0, 0,
-	0, 680, 689, 721, 674, 0, 0, 0, 0, 0,
-	0, 0, 0, 654, 0, 698, 0, 0, 0, 633,
-	626, 0, 0, 0, 0, 678, 0, 0, 0, 636,
-	0, 655, 722, 0, 620, 247, 630, 303, 0, 726,
-	736, 675, 427, 740, 673, 672, 717, 634, 732, 666,
-	274, 632, 271, 171, 187, 0, 664, 313, 352, 358,
-	731, 651, 659, 210, 657, 356, 327, 412, 194, 237,
-	349, 332, 354, 697, 715, 355, 280, 400, 344, 410,
-	428, 429, 217, 307, 418, 391, 424, 439, 188, 214,
-	321, 384, 415, 375, 300, 396, 397, 270, 374, 245,
-	174, 278, 436, 186, 364, 202, 179, 386, 408, 199,
-	367, 0, 0, 441, 181, 406, 383, 297, 267, 268,
-	180, 0, 348, 222, 243, 212, 316, 403, 404, 211,
-	442, 190, 423, 183, 934, 422, 309, 399, 407, 298,
-	289, 182, 405, 296, 288, 273, 233, 254, 342, 283,
-	343, 255, 305, 304, 306, 0, 177, 0, 380, 416,
-	443, 195, 196, 197, 646, 232, 236, 242, 244, 250,
-	251, 258, 276, 320, 341, 339, 345, 727, 394, 411,
-	419, 426, 432, 433, 437, 434, 435, 438, 308, 257,
-	376, 272, 281, 719, 757, 326, 357, 200, 414, 377,
-	641, 645, 639, 640, 691, 692, 642, 748, 749, 750,
-	723, 635, 0, 643, 644, 0, 729, 738, 739, 696,
-	170, 184, 277, 753, 346, 240, 440, 421, 417, 621,
-	638, 216, 649, 0, 0, 661, 669, 670, 682, 684,
-	685, 686, 687, 695, 703, 704, 706, 714, 716, 718,
-	720, 725, 735, 756, 172, 173, 185, 193, 203, 215,
-	230, 238, 248, 253, 256, 260, 261, 264, 269, 286,
-	291, 292, 293, 294, 310, 311, 312, 315, 318, 319,
-	322, 324, 325, 328, 334, 335, 336, 337, 338, 340,
-	347, 351, 359, 360, 361, 362, 363, 365, 366, 370,
-	371, 372, 373, 381, 385, 401, 402, 413, 425, 430,
-	249, 409, 431, 0, 285, 694, 701, 287, 234, 252,
-	262, 709, 420, 382, 189, 353, 241, 178, 206, 192,
-	213, 228, 231, 266, 295, 301, 330, 333, 246, 225,
-	204, 350, 201, 368, 388, 389, 390, 392, 299, 220,
-	742, 728, 393, 0, 677, 745, 648, 665, 755, 668,
-	671, 711, 627, 690, 317, 662, 0, 652, 623, 658,
-	624, 650, 679, 224, 647, 730, 693, 744, 275, 221,
-	629, 653, 331, 667, 176, 713, 369, 209, 284, 282,
-	398, 235, 227, 223, 208, 259, 290, 329, 387, 323,
-	751, 279, 700, 0, 378, 302, 0, 0, 0, 681,
-	734, 688, 724, 676, 712, 637, 699, 746, 663, 708,
-	747, 265, 207, 175, 314, 379, 239, 0, 0, 0,
-	167, 168, 169, 0, 0, 0, 0, 0, 0, 0,
-	0, 198, 0, 205, 705, 741, 660, 707, 219, 263,
-	226, 218, 395, 752, 733, 0, 191, 743, 683, 710,
-	758, 622, 702, 0, 625, 628, 754, 737, 656, 229,
-	0, 0, 0, 0, 0, 0, 0, 680, 689, 721,
-	674, 0, 0, 0, 0, 0, 0, 1928, 0, 654,
-	0, 698, 0, 0, 0, 633, 626, 0, 0, 0,
-	0, 678, 0, 0, 0, 636, 0, 655, 722, 0,
-	620, 247, 630, 303, 0, 726, 736, 675, 427, 740,
-	673, 672, 717, 634, 732, 666, 274, 632, 271, 171,
-	187, 0, 664, 313, 352, 358, 731, 651, 659, 210,
-	657, 356, 327, 412, 194, 237, 349, 332, 354, 697,
-	715, 355, 280, 400, 344, 410, 428, 429, 217, 307,
-	418, 391, 424, 439, 188, 214, 321, 384, 415, 375,
-	300, 396, 397, 270, 374, 245, 174, 278, 436, 186,
-	364, 202, 179, 386, 408, 199, 367, 0, 0, 441,
-	181, 406, 383, 297, 267, 268, 180, 0, 348, 222,
-	243, 212, 316, 403, 404, 211, 442, 190, 423, 183,
-	934, 422, 309, 399, 407, 298, 289, 182, 405, 296,
-	288, 273, 233, 254, 342, 283, 343, 255, 305, 304,
-	306, 0, 177, 0, 380, 416, 443, 195, 196, 197,
-	646, 232, 236, 242, 244, 250, 251, 258, 276, 320,
-	341, 339, 345, 727, 394, 411, 419, 426, 432, 433,
-	437, 434, 435, 438, 308, 257, 376, 272, 281, 719,
-	757, 326, 357, 200, 414, 377, 641, 645, 639, 640,
-	691, 692, 642, 748, 749, 750, 723, 635, 0, 643,
-	644, 0, 729, 738, 739, 696, 170, 184, 277, 753,
-	346, 240, 440, 421, 417, 621, 638, 216, 649, 0,
-	0, 661, 669, 670, 682, 684, 685, 686, 687, 695,
-	703, 704, 706, 714, 716, 718, 720, 725, 735, 756,
-	172, 173, 185, 193, 203, 215, 230, 238, 248, 253,
-	256, 260, 261, 264, 269, 286, 291, 292, 293, 294,
-	310, 311, 312, 315, 318, 319, 322, 324, 325, 328,
-	334, 335, 336, 337, 338, 340, 347, 351, 359, 360,
-	361, 362, 363, 365, 366, 370, 371, 372, 373, 381,
-	385, 401, 402, 413, 425, 430, 249, 409, 431, 0,
-	285, 694, 701, 287, 234, 252, 262, 709, 420, 382,
-	189, 353, 241, 178, 206, 192, 213, 228, 231, 266,
-	295, 301, 330, 333, 246, 225, 204, 350, 201, 368,
-	388, 389, 390, 392, 299, 220, 742, 728, 393, 0,
-	677, 745, 648, 665, 755, 668, 671, 711, 627, 690,
-	317, 662, 0, 652, 623, 658, 624, 650, 679, 224,
-	647, 730, 693, 744, 275, 221, 629, 653, 331, 667,
-	176, 713, 369, 209, 284, 282, 398, 235, 227, 223,
-	208, 259, 290, 329, 387, 323, 751, 279, 700, 0,
-	378, 302, 0, 0, 0, 681, 734, 688, 724, 676,
-	712, 637, 699, 746, 663, 708, 747, 265, 207, 175,
-	314, 379, 239, 0, 0, 0, 167, 168, 169, 0,
-	0, 0, 0, 0, 0, 0, 0, 198, 0, 205,
-	705, 741, 660, 707, 219, 263, 226, 218, 395, 752,
-	733, 0, 191, 743, 683, 710, 758, 622, 702, 0,
-	625, 628, 754, 737, 656, 229, 0, 0, 0, 0,
-	0, 0, 0, 680, 689, 721, 674, 0, 0, 0,
-	0, 0, 0, 1767, 0, 654, 0, 698, 0, 0,
-	0, 633, 626, 0, 0, 0, 0, 678, 0, 0,
-	0, 636, 0, 655, 722, 0, 620, 247, 630, 303,
-	0, 726, 736, 675, 427, 740, 673, 672, 717, 634,
-	732, 666, 274, 632, 271, 171, 187, 0, 664, 313,
-	352, 358, 731, 651, 659, 210, 657, 356, 327, 412,
-	194, 237, 349, 332, 354, 697, 715, 355, 280, 400,
-	344, 410, 428, 429, 217, 307, 418, 391, 424, 439,
-	188, 214, 321, 384, 415, 375, 300, 396, 397, 270,
-	374, 245, 174, 278, 436, 186, 364, 202, 179, 386,
-	408, 199, 367, 0, 0, 441, 181, 406, 383, 297,
-	267, 268, 180, 0, 348, 222, 243, 212, 316, 403,
-	404, 211, 442, 190, 423, 183, 934, 422, 309, 399,
-	407, 298, 289, 182, 405, 296, 288, 273, 233, 254,
-	342, 283, 343, 255, 305, 304, 306, 0, 177, 0,
-	380, 416, 443, 195, 196, 197, 646, 232, 236, 242,
-	244, 250, 251, 258, 276, 320, 341, 339, 345, 727,
-	394, 411, 419, 426, 432, 433, 437, 434, 435, 438,
-	308, 257, 376, 272, 281, 719, 757, 326, 357, 200,
-	414, 377, 641, 645, 639, 640, 691, 692, 642, 748,
-	749, 750, 723, 635, 0, 643, 644, 0, 729, 738,
-	739, 696, 170, 184, 277, 753, 346, 240, 440, 421,
-	417, 621, 638, 216, 649, 0, 0, 661, 669, 670,
-	682, 684, 685, 686, 687, 695, 703, 704, 706, 714,
-	716, 718, 720, 725, 735, 756, 172, 173, 185, 193,
-	203, 215, 230, 238, 248, 253, 256, 260, 261, 264,
-	269, 286, 291, 292, 293, 294, 310, 311, 312, 315,
-	318, 319, 322, 324, 325, 328, 334, 335, 336, 337,
-	338, 340, 347, 351, 359, 360, 361, 362, 363, 365,
-	366, 370, 371, 372, 373, 381, 385, 401, 402, 413,
-	425, 430, 249, 409, 431, 0, 285, 694, 701, 287,
-	234, 252, 262, 709, 420, 382, 189, 353, 241, 178,
-	206, 192, 213, 228, 231, 266, 295, 301, 330, 333,
-	246, 225, 204, 350, 201, 368, 388, 389, 390, 392,
-	299, 220, 742, 728, 393, 0, 677, 745, 648, 665,
-	755, 668, 671, 711, 627, 690, 317, 662, 0, 652,
-	623, 658, 624, 650, 679, 224, 647, 730, 693, 744,
-	275, 221, 629, 653, 331, 667, 176, 713, 369, 209,
-	284, 282, 398, 235, 227, 223, 208, 259, 290, 329,
-	387, 323, 751, 279, 700, 0, 378, 302, 0, 0,
-	0, 681, 734, 688, 724, 676, 712, 637, 699, 746,
-	663, 708, 747, 265, 207, 175, 314, 379, 239, 0,
-	0, 0, 167, 168, 169, 0, 0, 0, 0, 0,
-	0, 0, 0, 198, 0, 205, 705, 741, 660, 707,
-	219, 263, 226, 218, 395, 752, 733, 0, 191, 743,
-	683, 710, 758, 622, 702, 0, 625, 628, 754, 737,
-	656, 229, 0, 0, 0, 0, 0, 0, 0, 680,
-	689, 721, 674, 0, 0, 0, 0, 0, 0, 1476,
-	0, 654, 0, 698, 0, 0, 0, 633, 626, 0,
-	0, 0, 0, 678, 0, 0, 0, 636, 0, 655,
-	722, 0, 620, 247, 630, 303, 0, 726, 736, 675,
-	427, 740, 673, 672, 717, 634, 732, 666, 274, 632,
-	271, 171, 187, 0, 664, 313, 352, 358, 731, 651,
-	659, 210, 657, 356, 327, 412, 194, 237, 349, 332,
-	354, 697, 715, 355, 280, 400, 344, 410, 428, 429,
-	217, 307, 418, 391, 424, 439, 188, 214, 321, 384,
-	415, 375, 300, 396, 397, 270, 374, 245, 174, 278,
-	436, 186, 364, 202, 179, 386, 408, 199, 367, 0,
-	0, 441, 181, 406, 383, 297, 267, 268, 180, 0,
-	348, 222, 243, 212, 316, 403, 404, 211, 442, 190,
-	423, 183, 934, 422, 309, 399, 407, 298, 289, 182,
-	405, 296, 288, 273, 233, 254, 342, 283, 343, 255,
-	305, 304, 306, 0, 177, 0, 380, 416, 443, 195,
-	196, 197, 646, 232, 236, 242, 244, 250, 251, 258,
-	276, 320, 341, 339, 345, 727, 394, 411, 419, 426,
-	432, 433, 437, 434, 435, 438, 308, 257, 376, 272,
-	281, 719, 757, 326, 357, 200, 414, 377, 641, 645,
-	639, 640, 691, 692, 642, 748, 749, 750, 723, 635,
-	0, 643, 644, 0, 729, 738, 739, 696, 170, 184,
-	277, 753, 346, 240, 440, 421, 417, 621, 638, 216,
-	649, 0, 0, 661, 669, 670, 682, 684, 685, 686,
-	687, 695, 703, 704, 706, 714, 716, 718, 720, 725,
-	735, 756, 172, 173, 185, 193, 203, 215, 230, 238,
-	248, 253, 256, 260, 261, 264, 269, 286, 291, 292,
-	293, 294, 310, 311, 312, 315, 318, 319, 322, 324,
-	325, 328, 334, 335, 336, 337, 338, 340, 347, 351,
-	359, 360, 361, 362, 363, 365, 366, 370, 371, 372,
-	373, 381, 385, 401, 402, 413, 425, 430, 249, 409,
-	431, 0, 285, 694, 701, 287, 234, 252, 262, 709,
-	420, 382, 189, 353, 241, 178, 206, 192, 213, 228,
-	231, 266, 295, 301, 330, 333, 246, 225, 204, 350,
-	201, 368, 388, 389, 390, 392, 299, 220, 742, 728,
-	393, 0, 677, 745, 648, 665, 755, 668, 671, 711,
-	627, 690, 317, 662, 0, 652, 623, 658, 624, 650,
-	679, 224, 647, 730, 693, 744, 275, 221, 629, 653,
-	331, 667, 176, 713, 369, 209, 284, 282, 398, 235,
-	227, 223, 208, 259, 290, 329, 387, 323, 751, 279,
-	700, 0, 378, 302, 0, 0, 0, 681, 734, 688,
-	724, 676, 712, 637, 699, 746, 663, 708, 747, 265,
-	207, 175, 314, 379, 239, 71, 0, 0, 167, 168,
-	169, 0, 0, 0, 0, 0, 0, 0, 0, 198,
-	0, 205, 705, 741, 660, 707, 219, 263, 226, 218,
-	395, 752, 733, 0, 191, 743, 683, 710, 758, 622,
-	702, 0, 625, 628, 754, 737, 656, 229, 0, 0,
-	0, 0, 0, 0, 0, 680, 689, 721, 674, 0,
-	0, 0, 0, 0, 0, 0, 0, 654, 0, 698,
-	0, 0, 0, 633, 626, 0, 0, 0, 0, 678,
-	0, 0, 0, 636, 0, 655, 722, 0, 620, 247,
-	630, 303, 0, 726, 736, 675, 427, 740, 673, 672,
-	717, 634, 732, 666, 274, 632, 271, 171, 187, 0,
-	664, 313, 352, 358, 731, 651, 659, 210, 657, 356,
-	327, 412, 194, 237, 349, 332, 354, 697, 715, 355,
-	280, 400, 344, 410, 428, 429, 217, 307, 418, 391,
-	424, 439, 188, 214, 321, 384, 415, 375, 300, 396,
-	397, 270, 374, 245, 174, 278, 436, 186, 364, 202,
-	179, 386, 408, 199, 367, 0, 0, 441, 181, 406,
-	383, 297, 267, 268, 180, 0, 348, 222, 243, 212,
-	316, 403, 404, 211, 442, 190, 423, 183, 934, 422,
-	309, 399, 407, 298, 289, 182, 405, 296, 288, 273,
-	233, 254, 342, 283, 343, 255, 305, 304, 306, 0,
-	177, 0, 380, 416, 443, 195, 196, 197, 646, 232,
-	236, 242, 244, 250, 251, 258, 276, 320, 341, 339,
-	345, 727, 394, 411, 419, 426, 432, 433, 437, 434,
-	435, 438, 308, 257, 376, 272, 281, 719, 757, 326,
-	357, 200, 414, 377, 641, 645, 639, 640, 691, 692,
-	642, 748, 749, 750, 723, 635, 0, 643, 644, 0,
-	729, 738, 739, 696, 170, 184, 277, 753, 346, 240,
-	440, 421, 417, 621, 638, 216, 649, 0, 0, 661,
-	669, 670, 682, 684, 685, 686, 687, 695, 703, 704,
-	706, 714, 716, 718, 720, 725, 735, 756, 172, 173,
-	185, 193, 203, 215, 230, 238, 248, 253, 256, 260,
-	261, 264, 269, 286, 291, 292, 293, 294, 310, 311,
-	312, 315, 318, 319, 322, 324, 325, 328, 334, 335,
-	336, 337, 338, 340, 347, 351, 359, 360, 361, 362,
-	363, 365, 366, 370, 371, 372, 373, 381, 385, 401,
-	402, 413, 425, 430, 249, 409, 431, 0, 285, 694,
-	701, 287, 234, 252, 262, 709, 420, 382, 189, 353,
-	241, 178, 206, 192, 213, 228, 231, 266, 295, 301,
-	330, 333, 246, 225, 204, 350, 201, 368, 388, 389,
-	390, 392, 299, 220, 742, 728, 393, 0, 677, 745,
-	648, 665, 755, 668, 671, 711, 627, 690, 317, 662,
-	0, 652, 623, 658, 624, 650, 679, 224, 647, 730,
-	693, 744, 275, 221, 629, 653, 331, 667, 176, 713,
-	369, 209, 284, 282, 398, 235, 227, 223, 208, 259,
-	290, 329, 387, 323, 751, 279, 700, 0, 378, 302,
-	0, 0, 0, 681, 734, 688, 724, 676, 712, 637,
-	699, 746, 663, 708, 747, 265, 207, 175, 314, 379,
-	239, 0, 0, 0, 167, 168, 169, 0, 0, 0,
-	0, 0, 0, 0, 0, 198, 0, 205, 705, 741,
-	660, 707, 219, 263, 226, 218, 395, 752, 733, 0,
-	191, 743, 683, 710, 758, 622, 702, 0, 625, 628,
-	754, 737, 656, 229, 0, 0, 0, 0, 0, 0,
-	0, 680, 689, 721, 674, 0, 0, 0, 0, 0,
-	0, 0, 0, 654, 0, 698, 0, 0, 0, 633,
-	626, 0, 0, 0, 0, 678, 0, 0, 0, 636,
-	0, 655, 722, 0, 620, 247, 630, 303, 0, 726,
-	736, 675, 427, 740, 673, 672, 717, 634, 732, 666,
-	274, 632, 271, 171, 187, 0, 664, 313, 352, 358,
-	731, 651, 659, 210, 657, 356, 327, 412, 194, 237,
-	349, 332, 354, 697, 715, 355, 280, 400, 344, 410,
-	428, 429, 217, 307, 418, 391, 424, 439, 188, 214,
-	321, 384, 415, 375, 300, 396, 397, 270, 374, 245,
-	174, 278, 436, 186, 364, 202, 179, 386, 408, 199,
-	367, 0, 0, 441, 181, 406, 383, 297, 267, 268,
-	180, 0, 348, 222, 243, 212, 316, 403, 404, 211,
-	442, 190, 423, 183, 934, 422, 309, 399, 407, 298,
-	289, 182, 405, 296, 288, 273, 233, 254, 342, 283,
-	343, 255, 305, 304, 306, 0, 177, 0, 380, 416,
-	443, 195, 196, 197, 646, 232, 236, 242, 244, 250,
-	251, 258, 276, 320, 341, 339, 345, 727, 394, 411,
-	419, 426, 432, 433, 437, 434, 435, 438, 308, 257,
-	376, 272, 281, 719, 757, 326, 357, 200, 414, 377,
-	641, 645, 639, 640, 691, 692, 642, 748, 749, 750,
-	723, 635, 0, 643, 644, 0, 729, 738, 739, 696,
-	170, 184, 277, 753, 346, 240, 440, 421, 417, 621,
-	638, 216, 649, 0, 0, 661, 669, 670, 682, 684,
-	685, 686, 687, 695, 703, 704, 706, 714, 716, 718,
-	720, 725, 735, 756, 172, 173, 185, 193, 203, 215,
-	230, 238, 248, 253, 256, 260, 261, 264, 269, 286,
-	291, 292, 293, 294, 310, 311, 312, 315, 318, 319,
-	322, 324, 325, 328, 334, 335, 336, 337, 338, 340,
-	347, 351, 359, 360, 361, 362, 363, 365, 366, 370,
-	371, 372, 373, 381, 385, 401, 402, 413, 425, 430,
-	249, 409, 431, 0, 285, 694, 701, 287, 234, 252,
-	262, 709, 420, 382, 189, 353, 241, 178, 206, 192,
-	213, 228, 231, 266, 295, 301, 330, 333, 246, 225,
-	204, 350, 201, 368, 388, 389, 390, 392, 299, 220,
-	742, 728, 393, 0, 677, 745, 648, 665, 755, 668,
-	671, 711, 627, 690, 317, 662, 0, 652, 623, 658,
-	624, 650, 679, 224, 647, 730, 693, 744, 275, 221,
-	629, 653, 331, 667, 176, 713, 369, 209, 284, 282,
-	398, 235, 227, 223, 208, 259, 290, 329, 387, 323,
-	751, 279, 700, 0, 378, 302, 0, 0, 0, 681,
-	734, 688, 724, 676, 712, 637, 699, 746, 663, 708,
-	747, 265, 207, 175, 314, 379, 239, 0, 0, 0,
-	167, 168, 169, 0, 0, 0, 0, 0, 0, 0,
-	0, 198, 0, 205, 705, 741, 660, 707, 219, 263,
-	226, 218, 395, 752, 733, 0, 759, 743, 683, 710,
-	758, 622, 702, 0, 625, 628, 754, 737, 656, 229,
-	0, 0, 0, 0, 0, 0, 0, 680, 689, 721,
-	674, 0, 0, 0, 0, 0, 0, 0, 0, 654,
-	0, 698, 0, 0, 0, 633, 626, 0, 0, 0,
-	0, 678, 0, 0, 0, 636, 0, 655, 722, 0,
-	620, 247, 630, 303, 0, 726, 736, 675, 427, 740,
-	673, 672, 717, 634, 732, 666, 274, 632, 271, 171,
-	187, 0, 664, 313, 352, 358, 731, 651, 659, 210,
-	657, 356, 327, 412, 194, 237, 349, 332, 354, 697,
-	715, 355, 280, 400, 344, 410, 428, 429, 217, 307,
-	418, 391, 424, 439, 188, 214, 321, 384, 415, 375,
-	300, 396, 397, 270, 374, 245, 174, 278, 436, 186,
-	364, 202, 179, 386, 408, 199, 367, 0, 0, 441,
-	181, 406, 383, 297, 267, 268, 180, 0, 348, 222,
-	243, 212, 316, 403, 404, 211, 442, 190, 423, 183,
-	631, 422, 309, 399, 407, 298, 289, 182, 405, 296,
-	288, 273, 233, 254, 342, 283, 343, 255, 305, 304,
-	306, 0, 177, 0, 380, 416, 443, 195, 196, 197,
-	646, 232, 236, 242, 244, 250, 251, 258, 276, 320,
-	341, 339, 345, 727, 394, 411, 419, 426, 432, 433,
-	437, 434, 435, 438, 619, 613, 612, 272, 281, 719,
-	757, 326, 357, 200, 414, 377, 641, 645, 639, 640,
-	691, 692, 642, 748, 749, 750, 723, 635, 0, 643,
-	644, 0, 729, 738, 739, 696, 170, 184, 277, 753,
-	346, 240, 440, 421, 417, 621, 638, 216, 649, 0,
-	0, 661, 669, 670, 682, 684, 685, 686, 687, 695,
-	703, 704, 706, 714, 716, 718, 720, 725, 735, 756,
-	172, 173, 185, 193, 203, 215, 230, 238, 248, 253,
-	256, 260, 261, 264, 269, 286, 291, 292, 293, 294,
-	310, 311, 312, 315, 318, 319, 322, 324, 325, 328,
-	334, 335, 336, 337, 338, 340, 347, 351, 359, 360,
-	361, 362, 363, 365, 366, 370, 371, 372, 373, 381,
-	385, 401, 402, 413, 425, 430, 249, 409, 431, 0,
-	285, 694, 701, 287, 234, 252, 262, 709, 420, 382,
-	189, 353, 241, 178, 206, 192, 213, 228, 231, 266,
-	295, 301, 330, 333, 246, 225, 204, 350, 201, 368,
-	388, 389, 390, 392, 299, 220, 742, 728, 393, 0,
-	677, 745, 648, 665, 755, 668, 671, 711, 627, 690,
-	317, 662, 0, 652, 623, 658, 624, 650, 679, 224,
-	647, 730, 693, 744, 275, 221, 629, 653, 331, 667,
-	176, 713, 369, 209, 284, 282, 398, 235, 227, 223,
-	208, 259, 290, 329, 387, 323, 751, 279, 700, 0,
-	378, 302, 0, 0, 0, 681, 734, 688, 724, 676,
-	712, 637, 699, 746, 663, 708, 747, 265, 207, 175,
-	314, 379, 239, 0, 0, 0, 167, 168, 169, 0,
-	0, 0, 0, 0, 0, 0, 0, 198, 0, 205,
-	705, 741, 660, 707, 219, 263, 226, 218, 395, 752,
-	733, 0, 759, 743, 683, 710, 758, 622, 702, 0,
-	625, 628, 754, 737, 656, 229, 0, 0, 0, 0,
-	0, 0, 0, 680, 689, 721, 674, 0, 0, 0,
-	0, 0, 0, 0, 0, 654, 0, 698, 0, 0,
-	0, 633, 626, 0, 0, 0, 0, 678, 0, 0,
-	0, 636, 0, 655, 722, 0, 620, 247, 630, 303,
-	0, 726, 736, 675, 427, 740, 673, 672, 717, 634,
-	732, 666, 274, 632, 271, 171, 187, 0, 664, 313,
-	352, 358, 731, 651, 659, 210, 657, 356, 327, 412,
-	194, 237, 349, 332, 354, 697, 715, 355, 280, 400,
-	344, 410, 428, 429, 217, 307, 418, 391, 424, 439,
-	188, 214, 321, 384, 415, 375, 300, 396, 397, 270,
-	374, 245, 174, 278, 436, 186, 364, 202, 179, 386,
-	1102, 199, 367, 0, 0, 441, 181, 406, 383, 297,
-	267, 268, 180, 0, 348, 222, 243, 212, 316, 403,
-	404, 211, 442, 190, 423, 183, 631, 422, 309, 399,
-	407, 298, 289, 182, 405, 296, 288, 273, 233, 254,
-	342, 283, 343, 255, 305, 304, 306, 0, 177, 0,
-	380, 416, 443, 195, 196, 197, 646, 232, 236, 242,
-	244, 250, 251, 258, 276, 320, 341, 339, 345, 727,
-	394, 411, 419, 426, 432, 433, 437, 434, 435, 438,
-	619, 613, 612, 272, 281, 719, 757, 326, 357, 200,
-	414, 377, 641, 645, 639, 640, 691, 692, 642, 748,
-	749, 750, 723, 635, 0, 643, 644, 0, 729, 738,
-	739, 696, 170, 184, 277, 753, 346, 240, 440, 421,
-	417, 621, 638, 216, 649, 0, 0, 661, 669, 670,
-	682, 684, 685, 686, 687, 695, 703, 704, 706, 714,
-	716, 718, 720, 725, 735, 756, 172, 173, 185, 193,
-	203, 215, 230, 238, 248, 253, 256, 260, 261, 264,
-	269, 286, 291, 292, 293, 294, 310, 311, 312, 315,
-	318, 319, 322, 324, 325, 328, 334, 335, 336, 337,
-	338, 340, 347, 351, 359, 360, 361, 362, 363, 365,
-	366, 370, 371, 372, 373, 381, 385, 401, 402, 413,
-	425, 430, 249, 409, 431, 0, 285, 694, 701, 287,
-	234, 252, 262, 709, 420, 382, 189, 353, 241, 178,
-	206, 192, 213, 228, 231, 266, 295, 301, 330, 333,
-	246, 225, 204, 350, 201, 368, 388, 389, 390, 392,
-	299, 220, 742, 728, 393, 0, 677, 745, 648, 665,
-	755, 668, 671, 711, 627, 690, 317, 662, 0, 652,
-	623, 658, 624, 650, 679, 224, 647, 730, 693, 744,
-	275, 221, 629, 653, 331, 667, 176, 713, 369, 209,
-	284, 282, 398, 235, 227, 223, 208, 259, 290, 329,
-	387, 323, 751, 279, 700, 0, 378, 302, 0, 0,
-	0, 681, 734, 688, 724, 676, 712, 637, 699, 746,
-	663, 708, 747, 265, 207, 175, 314, 379, 239, 0,
-	0, 0, 167, 168, 169, 0, 0, 0, 0, 0,
-	0, 0, 0, 198, 0, 205, 705, 741, 660, 707,
-	219, 263, 226, 218, 395, 752, 733, 0, 759, 743,
-	683, 710, 758, 622, 702, 0, 625, 628, 754, 737,
-	656, 229, 0, 0, 0, 0, 0, 0, 0, 680,
-	689, 721, 674, 0, 0, 0, 0, 0, 0, 0,
-	0, 654, 0, 698, 0, 0, 0, 633, 626, 0,
-	0, 0, 0, 678, 0, 0, 0, 636, 0, 655,
-	722, 0, 620, 247, 630, 303, 0, 726, 736, 675,
-	427, 740, 673, 672, 717, 634, 732, 666, 274, 632,
-	271, 171, 187, 0, 664, 313, 352, 358, 731, 651,
-	659, 210, 657, 356, 327, 412, 194, 237, 349, 332,
-	354, 697, 715, 355, 280, 400, 344, 410, 428, 429,
-	217, 307, 418, 391, 424, 439, 188, 214, 321, 384,
-	415, 375, 300, 396, 397, 270, 374, 245, 174, 278,
-	436, 186, 364, 202, 179, 386, 610, 199, 367, 0,
-	0, 441, 181, 406, 383, 297, 267, 268, 180, 0,
-	348, 222, 243, 212, 316, 403, 404, 211, 442, 190,
-	423, 183, 631, 422, 309, 399, 407, 298, 289, 182,
-	405, 296, 288, 273, 233, 254, 342, 283, 343, 255,
-	305, 304, 306, 0, 177, 0, 380, 416, 443, 195,
-	196, 197, 646, 232, 236, 242, 244, 250, 251, 258,
-	276, 320, 341, 339, 345, 727, 394, 411, 419, 426,
-	432, 433, 437, 434, 435, 438, 619, 613, 612, 272,
-	281, 719, 757, 326, 357, 200, 414, 377, 641, 645,
-	639, 640, 691, 692, 642, 748, 749, 750, 723, 635,
-	0, 643, 644, 0, 729, 738, 739, 696, 170, 184,
-	277, 753, 346, 240, 440, 421, 417, 621, 638, 216,
-	649, 0, 0, 661, 669, 670, 682, 684, 685, 686,
-	687, 695, 703, 704, 706, 714, 716, 718, 720, 725,
-	735, 756, 172, 173, 185, 193, 203, 215, 230, 238,
-	248, 253, 256, 260, 261, 264, 269, 286, 291, 292,
-	293, 294, 310, 311, 312, 315, 318, 319, 322, 324,
-	325, 328, 334, 335, 336, 337, 338, 340, 347, 351,
-	359, 360, 361, 362, 363, 365, 366, 370, 371, 372,
-	373, 381, 385, 401, 402, 413, 425, 430, 249, 409,
-	431, 0, 285, 694, 701, 287, 234, 252, 262, 709,
-	420, 382, 189, 353, 241, 178, 206, 192, 213, 228,
-	231, 266, 295, 301, 330, 333, 246, 225, 204, 350,
-	201, 368, 388, 389, 390, 392, 299, 220, 393, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	317, 0, 0, 1404, 0, 510, 0, 0, 0, 224,
-	509, 0, 0, 0, 275, 221, 0, 1405, 331, 0,
-	176, 0, 369, 209, 284, 282, 398, 235, 227, 223,
-	208, 259, 290, 329, 387, 323, 553, 279, 0, 0,
-	378, 302, 0, 0, 0, 0, 0, 544, 545, 0,
-	0, 0, 0, 0, 0, 0, 0, 265, 207, 175,
-	314, 379, 239, 71, 0, 0, 167, 168, 169, 531,
-	530, 533, 534, 535, 536, 0, 0, 198, 532, 205,
-	537, 538, 539, 0, 219, 263, 226, 218, 395, 0,
-	0, 0, 191, 0, 0, 0, 0, 0, 507, 524,
-	0, 552, 0, 0, 0, 229, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 521, 522, 600, 0, 0, 0, 568, 0, 523,
-	0, 0, 516, 517, 519, 518, 520, 525, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 247, 0, 303,
-	0, 567, 0, 0, 427, 0, 0, 565, 0, 0,
-	0, 0, 274, 0, 271, 171, 187, 0, 0, 313,
-	352, 358, 0, 0, 0, 210, 0, 356, 327, 412,
-	194, 237, 349, 332, 354, 0, 0, 355, 280, 400,
-	344, 410, 428, 429, 217, 307, 418, 391, 424, 439,
-	188, 214, 321, 384, 415, 375, 300, 396, 397, 270,
-	374, 245, 174, 278, 436, 186, 364, 202, 179, 386,
-	408, 199, 367, 0, 0, 441, 181, 406, 383, 297,
-	267, 268, 180, 0, 348, 222, 243, 212, 316, 403,
-	404, 211, 442, 190, 423, 183, 0, 422, 309, 399,
-	407, 298, 289, 182, 405, 296, 288, 273, 233, 254,
-	342, 283, 343, 255, 305, 304, 306, 0, 177, 0,
-	380, 416, 443, 195, 196, 197, 0, 232, 236, 242,
-	244, 250, 251, 258, 276, 320, 341, 339, 345, 0,
-	394, 411, 419, 426, 432, 433, 437, 434, 435, 438,
-	308, 257, 376, 272, 281, 0, 0, 326, 357, 200,
-	414, 377, 555, 566, 561, 562, 559, 560, 554, 558,
-	557, 556, 569, 546, 547, 548, 549, 551, 0, 563,
-	564, 550, 170, 184, 277, 0, 346, 240, 440, 421,
-	417, 0, 0, 216, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 172, 173, 185, 193,
-	203, 215, 230, 238, 248, 253, 256, 260, 261, 264,
-	269, 286, 291, 292, 293, 294, 310, 311, 312, 315,
-	318, 319, 322, 324, 325, 328, 334, 335, 336, 337,
-	338, 340, 347, 351, 359, 360, 361, 362, 363, 365,
-	366, 370, 371, 372, 373, 381, 385, 401, 402, 413,
-	425, 430, 249, 409, 431, 0, 285, 0, 0, 287,
-	234, 252, 262, 0, 420, 382, 189, 353, 241, 178,
-	206, 192, 213, 228, 231, 266, 295, 301, 330, 333,
-	246, 225, 204, 350, 201, 368, 388, 389, 390, 392,
-	299, 220, 393, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 317, 0, 0, 0, 0, 510,
-	0, 0, 0, 224, 509, 0, 0, 0, 275, 221,
-	0, 0, 331, 0, 176, 0, 369, 209, 284, 282,
-	398, 235, 227, 223, 208, 259, 290, 329, 387, 323,
-	553, 279, 0, 0, 378, 302, 0, 0, 0, 0,
-	0, 544, 545, 0, 0, 0, 0, 0, 0, 1515,
-	0, 265, 207, 175, 314, 379, 239, 71, 0, 0,
-	167, 168, 169, 531, 530, 533, 534, 535, 536, 0,
-	0, 198, 532, 205, 537, 538, 539, 1516, 219, 263,
-	226, 218, 395, 0, 0, 0, 191, 0, 0, 0,
-	0, 0, 507, 524, 0, 552, 0, 0, 0, 229,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 521, 522, 0, 0, 0,
-	0, 568, 0, 523, 0, 0, 516, 517, 519, 518,
-	520, 525, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 247, 0, 303, 0, 567, 0, 0, 427, 0,
-	0, 565, 0, 0, 0, 0, 274, 0, 271, 171,
-	187, 0, 0, 313, 352, 358, 0, 0, 0, 210,
-	0, 356, 327, 412, 194, 237, 349, 332, 354, 0,
-	0, 355, 280, 400, 344, 410, 428, 429, 217, 307,
-	418, 391, 424, 439, 188, 214, 321, 384, 415, 375,
-	300, 396, 397, 270, 374, 245, 174, 278, 436, 186,
-	364, 202, 179, 386, 408, 199, 367, 0, 0, 441,
-	181, 406, 383, 297, 267, 268, 180, 0, 348, 222,
-	243, 212, 316, 403, 404, 211, 442, 190, 423, 183,
-	0, 422, 309, 399, 407, 298, 289, 182, 405, 296,
-	288, 273, 233, 254, 342, 283, 343, 255, 305, 304,
-	306, 0, 177, 0, 380, 416, 443, 195, 196, 197,
-	0, 232, 236, 242, 244, 250, 251, 258, 276, 320,
-	341, 339, 345, 0, 394, 411, 419, 426, 432, 433,
-	437, 434, 435, 438, 308, 257, 376, 272, 281, 0,
-	0, 326, 357, 200, 414, 377, 555, 566, 561, 562,
-	559, 560, 554, 558, 557, 556, 569, 546, 547, 548,
-	549, 551, 0, 563, 564, 550, 170, 184, 277, 0,
-	346, 240, 440, 421, 417, 0, 0, 216, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	172, 173, 185, 193, 203, 215, 230, 238, 248, 253,
-	256, 260, 261, 264, 269, 286, 291, 292, 293, 294,
-	310, 311, 312, 315, 318, 319, 322, 324, 325, 328,
-	334, 335, 336, 337, 338, 340, 347, 351, 359, 360,
-	361, 362, 363, 365, 366, 370, 371, 372, 373, 381,
-	385, 401, 402, 413, 425, 430, 249, 409, 431, 0,
-	285, 0, 0, 287, 234, 252, 262, 0, 420, 382,
-	189, 353, 241, 178, 206, 192, 213, 228, 231, 266,
-	295, 301, 330, 333, 246, 225, 204, 350, 201, 368,
-	388, 389, 390, 392, 299, 220, 393, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 317, 0,
-	0, 0, 0, 510, 0, 0, 0, 224, 509, 0,
-	0, 0, 275, 221, 0, 0, 331, 0, 176, 0,
-	369, 209, 284, 282, 398, 235, 227, 223, 208, 259,
-	290, 329, 387, 323, 553, 279, 0, 0, 378, 302,
-	0, 0, 0, 0, 0, 544, 545, 0, 0, 0,
-	0, 0, 0, 0, 0, 265, 207, 175, 314, 379,
-	239, 71, 0, 587, 167, 168, 169, 531, 530, 533,
-	534, 535, 536, 0, 0, 198, 532, 205, 537, 538,
-	539, 0, 219, 263, 226, 218, 395, 0, 0, 0,
-	191, 0, 0, 0, 0, 0, 507, 524, 0, 552,
-	0, 0, 0, 229, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 521,
-	522, 0, 0, 0, 0, 568, 0, 523, 0, 0,
-	516, 517, 519, 518, 520, 525, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 247, 0, 303, 0, 567,
-	0, 0, 427, 0, 0, 565, 0, 0, 0, 0,
-	274, 0, 271, 171, 187, 0, 0, 313, 352, 358,
-	0, 0, 0, 210, 0, 356, 327, 412, 194, 237,
-	349, 332, 354, 0, 0, 355, 280, 400, 344, 410,
-	428, 429, 217, 307, 418, 391, 424, 439, 188, 214,
-	321, 384, 415, 375, 300, 396, 397, 270, 374, 245,
-	174, 278, 436, 186, 364, 202, 179, 386, 408, 199,
-	367, 0, 0, 441, 181, 406, 383, 297, 267, 268,
-	180, 0, 348, 222, 243, 212, 316, 403, 404, 211,
-	442, 190, 423, 183, 0, 422, 309, 399, 407, 298,
-	289, 182, 405, 296, 288, 273, 233, 254, 342, 283,
-	343, 255, 305, 304, 306, 0, 177, 0, 380, 416,
-	443, 195, 196, 197, 0, 232, 236, 242, 244, 250,
-	251, 258, 276, 320, 341, 339, 345, 0, 394, 411,
-	419, 426, 432, 433, 437, 434, 435, 438, 308, 257,
-	376, 272, 281, 0, 0, 326, 357, 200, 414, 377,
-	555, 566, 561, 562, 559, 560, 554, 558, 557, 556,
-	569, 546, 547, 548, 549, 551, 0, 563, 564, 550,
-	170, 184, 277, 0, 346, 240, 440, 421, 417, 0,
-	0, 216, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 172, 173, 185, 193, 203, 215,
-	230, 238, 248, 253, 256, 260, 261, 264, 269, 286,
-	291, 292, 293, 294, 310, 311, 312, 315, 318, 319,
-	322, 324, 325, 328, 334, 335, 336, 337, 338, 340,
-	347, 351, 359, 360, 361, 362, 363, 365, 366, 370,
-	371, 372, 373, 381, 385, 401, 402, 413, 425, 430,
-	249, 409, 431, 0, 285, 0, 0, 287, 234, 252,
-	262, 0, 420, 382, 189, 353, 241, 178, 206, 192,
-	213, 228, 231, 266, 295, 301, 330, 333, 246, 225,
-	204, 350, 201, 368, 388, 389, 390, 392, 299, 220,
-	393, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 317, 0, 0, 0, 0, 510, 0, 0,
-	0, 224, 509, 0, 0, 0, 275, 221, 0, 0,
-	331, 0, 176, 0, 369, 209, 284, 282, 398, 235,
-	227, 223, 208, 259, 290, 329, 387, 323, 553, 279,
-	0, 0, 378, 302, 0, 0, 0, 0, 0, 544,
-	545, 0, 0, 0, 0, 0, 0, 0, 0, 265,
-	207, 175, 314, 379, 239, 71, 0, 0, 167, 168,
-	169, 531, 530, 533, 534, 535, 536, 0, 0, 198,
-	532, 205, 537, 538, 539, 0, 219, 263, 226, 218,
-	395, 0, 0, 0, 191, 0, 0, 0, 0, 0,
-	507, 524, 0, 552, 0, 0, 0, 229, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 521, 522, 600, 0, 0, 0, 568,
-	0, 523, 0, 0, 516, 517, 519, 518, 520, 525,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 247,
-	0, 303, 0, 567, 0, 0, 427, 0, 0, 565,
-	0, 0, 0, 0, 274, 0, 271, 171, 187, 0,
-	0, 313, 352, 358, 0, 0, 0, 210, 0, 356,
-	327, 412, 194, 237, 349, 332, 354, 0, 0, 355,
-	280, 400, 344, 410, 428, 429, 217, 307, 418, 391,
-	424, 439, 188, 214, 321, 384, 415, 375, 300, 396,
-	397, 270, 374, 245, 174, 278, 436, 186, 364, 202,
-	179, 386, 408, 199, 367, 0, 0, 441, 181, 406,
-	383, 297, 267, 268, 180, 0, 348, 222, 243, 212,
-	316, 403, 404, 211, 442, 190, 423, 183, 0, 422,
-	309, 399, 407, 298, 289, 182, 405, 296, 288, 273,
-	233, 254, 342, 283, 343, 255, 305, 304, 306, 0,
-	177, 0, 380, 416, 443, 195, 196, 197, 0, 232,
-	236, 242, 244, 250, 251, 258, 276, 320, 341, 339,
-	345, 0, 394, 411, 419, 426, 432, 433, 437, 434,
-	435, 438, 308, 257, 376, 272, 281, 0, 0, 326,
-	357, 200, 414, 377, 555, 566, 561, 562, 559, 560,
-	554, 558, 557, 556, 569, 546, 547, 548, 549, 551,
-	0, 563, 564, 550, 170, 184, 277, 0, 346, 240,
-	440, 421, 417, 0, 0, 216, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 172, 173,
-	185, 193, 203, 215, 230, 238, 248, 253, 256, 260,
-	261, 264, 269, 286, 291, 292, 293, 294, 310, 311,
-	312, 315, 318, 319, 322, 324, 325, 328, 334, 335,
-	336, 337, 338, 340, 347, 351, 359, 360, 361, 362,
-	363, 365, 366, 370, 371, 372, 373, 381, 385, 401,
-	402, 413, 425, 430, 249, 409, 431, 0, 285, 0,
-	0, 287, 234, 252, 262, 0, 420, 382, 189, 353,
-	241, 178, 206, 192, 213, 228, 231, 266, 295, 301,
-	330, 333, 246, 225, 204, 350, 201, 368, 388, 389,
-	390, 392, 299, 220, 393, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 317, 0, 0, 0,
-	0, 510, 0, 0, 0, 224, 509, 0, 0, 0,
-	275, 221, 0, 0, 331, 0, 176, 0, 369, 209,
-	284, 282, 398, 235, 227, 223, 208, 259, 290, 329,
-	387, 323, 553, 279, 0, 0, 378, 302, 0, 0,
-	0, 0, 0, 544, 545, 0, 0, 0, 0, 0,
-	0, 0, 0, 265, 207, 175, 314, 379, 239, 71,
-	0, 0, 167, 168, 169, 531, 1422, 533, 534, 535,
-	536, 0, 0, 198, 532, 205, 537, 538, 539, 0,
-	219, 263, 226, 218, 395, 0, 0, 0, 191, 0,
-	0, 0, 0, 0, 507, 524, 0, 552, 0, 0,
-	0, 229, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 521, 522, 600,
-	0, 0, 0, 568, 0, 523, 0, 0, 516, 517,
-	519, 518, 520, 525, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 247, 0, 303, 0, 567, 0, 0,
-	427, 0, 0, 565, 0, 0, 0, 0, 274, 0,
-	271, 171, 187, 0, 0, 313, 352, 358, 0, 0,
-	0, 210, 0, 356, 327, 412, 194, 237, 349, 332,
-	354, 0, 0, 355, 280, 400, 344, 410, 428, 429,
-	217, 307, 418, 391, 424, 439, 188, 214, 321, 384,
-	415, 375, 300, 396, 397, 270, 374, 245, 174, 278,
-	436, 186, 364, 202, 179, 386, 408, 199, 367, 0,
-	0, 441, 181, 406, 383, 297, 267, 268, 180, 0,
-	348, 222, 243, 212, 316, 403, 404, 211, 442, 190,
-	423, 183, 0, 422, 309, 399, 407, 298, 289, 182,
-	405, 296, 288, 273, 233, 254, 342, 283, 343, 255,
-	305, 304, 306, 0, 177, 0, 380, 416, 443, 195,
-	196, 197, 0, 232, 236, 242, 244, 250, 251, 258,
-	276, 320, 341, 339, 345, 0, 394, 411, 419, 426,
-	432, 433, 437, 434, 435, 438, 308, 257, 376, 272,
-	281, 0, 0, 326, 357, 200, 414, 377, 555, 566,
-	561, 562, 559, 560, 554, 558, 557, 556, 569, 546,
-	547, 548, 549, 551, 0, 563, 564, 550, 170, 184,
-	277, 0, 346, 240, 440, 421, 417, 0, 0, 216,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 172, 173, 185, 193, 203, 215, 230, 238,
-	248, 253, 256, 260, 261, 264, 269, 286, 291, 292,
-	293, 294, 310, 311, 312, 315, 318, 319, 322, 324,
-	325, 328, 334, 335, 336, 337, 338, 340, 347, 351,
-	359, 360, 361, 362, 363, 365, 366, 370, 371, 372,
-	373, 381, 385, 401, 402, 413, 425, 430, 249, 409,
-	431, 0, 285, 0, 0, 287, 234, 252, 262, 0,
-	420, 382, 189, 353, 241, 178, 206, 192, 213, 228,
-	231, 266, 295, 301, 330, 333, 246, 225, 204, 350,
-	201, 368, 388, 389, 390, 392, 299, 220, 393, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	317, 0, 0, 0, 0, 510, 0, 0, 0, 224,
-	509, 0, 0, 0, 275, 221, 0, 0, 331, 0,
-	176, 0, 369, 209, 284, 282, 398, 235, 227, 223,
-	208, 259, 290, 329, 387, 323, 553, 279, 0, 0,
-	378, 302, 0, 0, 0, 0, 0, 544, 545, 0,
-	0, 0, 0, 0, 0, 0, 0, 265, 207, 175,
-	314, 379, 239, 71, 0, 0, 167, 168, 169, 531,
-	1419, 533, 534, 535, 536, 0, 0, 198, 532, 205,
-	537, 538, 539, 0, 219, 263, 226, 218, 395, 0,
-	0, 0, 191, 0, 0, 0, 0, 0, 507, 524,
-	0, 552, 0, 0, 0, 229, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 521, 522, 600, 0, 0, 0, 568, 0, 523,
-	0, 0, 516, 517, 519, 518, 520, 525, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 247, 0, 303,
-	0, 567, 0, 0, 427, 0, 0, 565, 0, 0,
-	0, 0, 274, 0, 271, 171, 187, 0, 0, 313,
-	352, 358, 0, 0, 0, 210, 0, 356, 327, 412,
-	194, 237, 349, 332, 354, 0, 0, 355, 280, 400,
-	344, 410, 428, 429, 217, 307, 418, 391, 424, 439,
-	188, 214, 321, 384, 415, 375, 300, 396, 397, 270,
-	374, 245, 174, 278, 436, 186, 364, 202, 179, 386,
-	408, 199, 367, 0, 0, 441, 181, 406, 383, 297,
-	267, 268, 180, 0, 348, 222, 243, 212, 316, 403,
-	404, 211, 442, 190, 423, 183, 0, 422, 309, 399,
-	407, 298, 289, 182, 405, 296, 288, 273, 233, 254,
-	342, 283, 343, 255, 305, 304, 306, 0, 177, 0,
-	380, 416, 443, 195, 196, 197, 0, 232, 236, 242,
-	244, 250, 251, 258, 276, 320, 341, 339, 345, 0,
-	394, 411, 419, 426, 432, 433, 437, 434, 435, 438,
-	308, 257, 376, 272, 281, 0, 0, 326, 357, 200,
-	414, 377, 555, 566, 561, 562, 559, 560, 554, 558,
-	557, 556, 569, 546, 547, 548, 549, 551, 0, 563,
-	564, 550, 170, 184, 277, 0, 346, 240, 440, 421,
-	417, 0, 0, 216, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 172, 173, 185, 193,
-	203, 215, 230, 238, 248, 253, 256, 260, 261, 264,
-	269, 286, 291, 292, 293, 294, 310, 311, 312, 315,
-	318, 319, 322, 324, 325, 328, 334, 335, 336, 337,
-	338, 340, 347, 351, 359, 360, 361, 362, 363, 365,
-	366, 370, 371, 372, 373, 381, 385, 401, 402, 413,
-	425, 430, 249, 409, 431, 0, 285, 0, 0, 287,
-	234, 252, 262, 0, 420, 382, 189, 353, 241, 178,
-	206, 192, 213, 228, 231, 266, 295, 301, 330, 333,
-	246, 225, 204, 350, 201, 368, 388, 389, 390, 392,
-	299, 220, 580, 393, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 317, 0, 0, 0, 0,
-	510, 0, 0, 0, 224, 509, 0, 0, 0, 275,
-	221, 0, 0, 331, 0, 176, 0, 369, 209, 284,
-	282, 398, 235, 227, 223, 208, 259, 290, 329, 387,
-	323, 553, 279, 0, 0, 378, 302, 0, 0, 0,
-	0, 0, 544, 545, 0, 0, 0, 0, 0, 0,
-	0, 0, 265, 207, 175, 314, 379, 239, 71, 0,
-	0, 167, 168, 169, 531, 530, 533, 534, 535, 536,
-	0, 0, 198, 532, 205, 537, 538, 539, 0, 219,
-	263, 226, 218, 395, 0, 0, 0, 191, 0, 0,
-	0, 0, 0, 507, 524, 0, 552, 0, 0, 0,
-	229, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 521, 522, 0, 0,
-	0, 0, 568, 0, 523, 0, 0, 516, 517, 519,
-	518, 520, 525, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 247, 0, 303, 0, 567, 0, 0, 427,
-	0, 0, 565, 0, 0, 0, 0, 274, 0, 271,
-	171, 187, 0, 0, 313, 352, 358, 0, 0, 0,
-	210, 0, 356, 327, 412, 194, 237, 349, 332, 354,
-	0, 0, 355, 280, 400, 344, 410, 428, 429, 217,
-	307, 418, 391, 424, 439, 188, 214, 321, 384, 415,
-	375, 300, 396, 397, 270, 374, 245, 174, 278, 436,
-	186, 364, 202, 179, 386, 408, 199, 367, 0, 0,
-	441, 181, 406, 383, 297, 267, 268, 180, 0, 348,
-	222, 243, 212, 316, 403, 404, 211, 442, 190, 423,
-	183, 0, 422, 309, 399, 407, 298, 289, 182, 405,
-	296, 288, 273, 233, 254, 342, 283, 343, 255, 305,
-	304, 306, 0, 177, 0, 380, 416, 443, 195, 196,
-	197, 0, 232, 236, 242, 244, 250, 251, 258, 276,
-	320, 341, 339, 345, 0, 394, 411, 419, 426, 432,
-	433, 437, 434, 435, 438, 308, 257, 376, 272, 281,
-	0, 0, 326, 357, 200, 414, 377, 555, 566, 561,
-	562, 559, 560, 554, 558, 557, 556, 569, 546, 547,
-	548, 549, 551, 0, 563, 564, 550, 170, 184, 277,
-	0, 346, 240, 440, 421, 417, 0, 0, 216, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 172, 173, 185, 193, 203, 215, 230, 238, 248,
-	253, 256, 260, 261, 264, 269, 286, 291, 292, 293,
-	294, 310, 311, 312, 315, 318, 319, 322, 324, 325,
-	328, 334, 335, 336, 337, 338, 340, 347, 351, 359,
-	360, 361, 362, 363, 365, 366, 370, 371, 372, 373,
-	381, 385, 401, 402, 413, 425, 430, 249, 409, 431,
-	0, 285, 0, 0, 287, 234, 252, 262, 0, 420,
-	382, 189, 353, 241, 178, 206, 192, 213, 228, 231,
-	266, 295, 301, 330, 333, 246, 225, 204, 350, 201,
-	368, 388, 389, 390, 392, 299, 220, 393, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 317,
-	0, 0, 0, 0, 510, 0, 0, 0, 224, 509,
-	0, 0, 0, 275, 221, 0, 0, 331, 0, 176,
-	0, 369, 209, 284, 282, 398, 235, 227, 223, 208,
-	259, 290, 329, 387, 323, 553, 279, 0, 0, 378,
-	302, 0, 0, 0, 0, 0, 544, 545, 0, 0,
-	0, 0, 0, 0, 0, 0, 265, 207, 175, 314,
-	379, 239, 71, 0, 0, 167, 168, 169, 531, 530,
-	533, 534, 535, 536, 0, 0, 198, 532, 205, 537,
-	538, 539, 0, 219, 263, 226, 218, 395, 0, 0,
-	0, 191, 0, 0, 0, 0, 0, 507, 524, 0,
-	552, 0, 0, 0, 229, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	521, 522, 0, 0, 0, 0, 568, 0, 523, 0,
-	0, 516, 517, 519, 518, 520, 525, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 247, 0, 303, 0,
-	567, 0, 0, 427, 0, 0, 565, 0, 0, 0,
-	0, 274, 0, 271, 171, 187, 0, 0, 313, 352,
-	358, 0, 0, 0, 210, 0, 356, 327, 412, 194,
-	237, 349, 332, 354, 0, 0, 355, 280, 400, 344,
-	410, 428, 429, 217, 307, 418, 391, 424, 439, 188,
-	214, 321, 384, 415, 375, 300, 396, 397, 270, 374,
-	245, 174, 278, 436, 186, 364, 202, 179, 386, 408,
-	199, 367, 0, 0, 441, 181, 406, 383, 297, 267,
-	268, 180, 0, 348, 222, 243, 212, 316, 403, 404,
-	211, 442, 190, 423, 183, 0, 422, 309, 399, 407,
-	298, 289, 182, 405, 296, 288, 273, 233, 254, 342,
-	283, 343, 255, 305, 304, 306, 0, 177, 0, 380,
-	416, 443, 195, 196, 197, 0, 232, 236, 242, 244,
-	250, 251, 258, 276, 320, 341, 339, 345, 0, 394,
-	411, 419, 426, 432, 433, 437, 434, 435, 438, 308,
-	257, 376, 272, 281, 0, 0, 326, 357, 200, 414,
-	377, 555, 566, 561, 562, 559, 560, 554, 558, 557,
-	556, 569, 546, 547, 548, 549, 551, 0, 563, 564,
-	550, 170, 184, 277, 0, 346, 240, 440, 421, 417,
-	0, 0, 216, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 172, 173, 185, 193, 203,
-	215, 230, 238, 248, 253, 256, 260, 261, 264, 269,
-	286, 291, 292, 293, 294, 310, 311, 312, 315, 318,
-	319, 322, 324, 325, 328, 334, 335, 336, 337, 338,
-	340, 347, 351, 359, 360, 361, 362, 363, 365, 366,
-	370, 371, 372, 373, 381, 385, 401, 402, 413, 425,
-	430, 249, 409, 431, 0, 285, 0, 0, 287, 234,
-	252, 262, 0, 420, 382, 189, 353, 241, 178, 206,
-	192, 213, 228, 231, 266, 295, 301, 330, 333, 246,
-	225, 204, 350, 201, 368, 388, 389, 390, 392, 299,
-	220, 393, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 317, 0, 0, 0, 0, 0, 0,
-	0, 0, 224, 0, 0, 0, 0, 275, 221, 0,
-	0, 331, 0, 176, 0, 369, 209, 284, 282, 398,
-	235, 227, 223, 208, 259, 290, 329, 387, 323, 553,
-	279, 0, 0, 378, 302, 0, 0, 0, 0, 0,
-	544, 545, 0, 0, 0, 0, 0, 0, 0, 0,
-	265, 207, 175, 314, 379, 239, 71, 0, 0, 167,
-	168, 169, 531, 530, 533, 534, 535, 536, 0, 0,
-	198, 532, 205, 537, 538, 539, 0, 219, 263, 226,
-	218, 395, 0, 0, 0, 191, 0, 0, 0, 0,
-	0, 0, 524, 0, 552, 0, 0, 0, 229, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 521, 522, 0, 0, 0, 0,
-	568, 0, 523, 0, 0, 516, 517, 519, 518, 520,
-	525, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	247, 0, 303, 0, 567, 0, 0, 427, 0, 0,
-	565, 0, 0, 0, 0, 274, 0, 271, 171, 187,
-	0, 0, 313, 352, 358, 0, 0, 0, 210, 0,
-	356, 327, 412, 194, 237, 349, 332, 354, 2239, 0,
-	355, 280, 400, 344, 410, 428, 429, 217, 307, 418,
-	391, 424, 439, 188, 214, 321, 384, 415, 375, 300,
-	396, 397, 270, 374, 245, 174, 278, 436, 186, 364,
-	202, 179, 386, 408, 199, 367, 0, 0, 441, 181,
-	406, 383, 297, 267, 268, 180, 0, 348, 222, 243,
-	212, 316, 403, 404, 211, 442, 190, 423, 183, 0,
-	422, 309, 399, 407, 298, 289, 182, 405, 296, 288,
-	273, 233, 254, 342, 283, 343, 255, 305, 304, 306,
-	0, 177, 0, 380, 416, 443, 195, 196, 197, 0,
-	232, 236, 242, 244, 250, 251, 258, 276, 320, 341,
-	339, 345, 0, 394, 411, 419, 426, 432, 433, 437,
-	434, 435, 438, 308, 257, 376, 272, 281, 0, 0,
-	326, 357, 200, 414, 377, 555, 566, 561, 562, 559,
-	560, 554, 558, 557, 556, 569, 546, 547, 548, 549,
-	551, 0, 563, 564, 550, 170, 184, 277, 0, 346,
-	240, 440, 421, 417, 0, 0, 216, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 172,
-	173, 185, 193, 203, 215, 230, 238, 248, 253, 256,
-	260, 261, 264, 269, 286, 291, 292, 293, 294, 310,
-	311, 312, 315, 318, 319, 322, 324, 325, 328, 334,
-	335, 336, 337, 338, 340, 347, 351, 359, 360, 361,
-	362, 363, 365, 366, 370, 371, 372, 373, 381, 385,
-	401, 402, 413, 425, 430, 249, 409, 431, 0, 285,
-	0, 0, 287, 234, 252, 262, 0, 420, 382, 189,
-	353, 241, 178, 206, 192, 213, 228, 231, 266, 295,
-	301, 330, 333, 246, 225, 204, 350, 201, 368, 388,
-	389, 390, 392, 299, 220, 393, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 317, 0, 0,
-	0, 0, 0, 0, 0, 0, 224, 0, 0, 0,
-	0, 275, 221, 0, 0, 331, 0, 176, 0, 369,
-	209, 284, 282, 398, 235, 227, 223, 208, 259, 290,
-	329, 387, 323, 553, 279, 0, 0, 378, 302, 0,
-	0, 0, 0, 0, 544, 545, 0, 0, 0, 0,
-	0, 0, 0, 0, 265, 207, 175, 314, 379, 239,
-	71, 0, 587, 167, 168, 169, 531, 530, 533, 534,
-	535, 536, 0, 0, 198, 532, 205, 537, 538, 539,
-	0, 219, 263, 226, 218, 395, 0, 0, 0, 191,
-	0, 0, 0, 0, 0, 0, 524, 0, 552, 0,
-	0, 0, 229, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 521, 522,
-	0, 0, 0, 0, 568, 0, 523, 0, 0, 516,
-	517, 519, 518, 520, 525, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 247, 0, 303, 0, 567, 0,
-	0, 427, 0, 0, 565, 0, 0, 0, 0, 274,
-	0, 271, 171, 187, 0, 0, 313, 352, 358, 0,
-	0, 0, 210, 0, 356, 327, 412, 194, 237, 349,
-	332, 354, 0, 0, 355, 280, 400, 344, 410, 428,
-	429, 217, 307, 418, 391, 424, 439, 188, 214, 321,
-	384, 415, 375, 300, 396, 397, 270, 374, 245, 174,
-	278, 436, 186, 364, 202, 179, 386, 408, 199, 367,
-	0, 0, 441, 181, 406, 383, 297, 267, 268, 180,
-	0, 348, 222, 243, 212, 316, 403, 404, 211, 442,
-	190, 423, 183, 0, 422, 309, 399, 407, 298, 289,
-	182, 405, 296, 288, 273, 233, 254, 342, 283, 343,
-	255, 305, 304, 306, 0, 177, 0, 380, 416, 443,
-	195, 196, 197, 0, 232, 236, 242, 244, 250, 251,
-	258, 276, 320, 341, 339, 345, 0, 394, 411, 419,
-	426, 432, 433, 437, 434, 435, 438, 308, 257, 376,
-	272, 281, 0, 0, 326, 357, 200, 414, 377, 555,
-	566, 561, 562, 559, 560, 554, 558, 557, 556, 569,
-	546, 547, 548, 549, 551, 0, 563, 564, 550, 170,
-	184, 277, 0, 346, 240, 440, 421, 417, 0, 0,
-	216, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 172, 173, 185, 193, 203, 215, 230,
-	238, 248, 253, 256, 260, 261, 264, 269, 286, 291,
-	292, 293, 294, 310, 311, 312, 315, 318, 319, 322,
-	324, 325, 328, 334, 335, 336, 337, 338, 340, 347,
-	351, 359, 360, 361, 362, 363, 365, 366, 370, 371,
-	372, 373, 381, 385, 401, 402, 413, 425, 430, 249,
-	409, 431, 0, 285, 0, 0, 287, 234, 252, 262,
-	0, 420, 382, 189, 353, 241, 178, 206, 192, 213,
-	228, 231, 266, 295, 301, 330, 333, 246, 225, 204,
-	350, 201, 368, 388, 389, 390, 392, 299, 220, 393,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 317, 0, 0, 0, 0, 0, 0, 0, 0,
-	224, 0, 0, 0, 0, 275, 221, 0, 0, 331,
-	0, 176, 0, 369, 209, 284, 282, 398, 235, 227,
-	223, 208, 259, 290, 329, 387, 323, 553, 279, 0,
-	0, 378, 302, 0, 0, 0, 0, 0, 544, 545,
-	0, 0, 0, 0, 0, 0, 0, 0, 265, 207,
-	175, 314, 379, 239, 71, 0, 0, 167, 168, 169,
-	531, 530, 533, 534, 535, 536, 0, 0, 198, 532,
-	205, 537, 538, 539, 0, 219, 263, 226, 218, 395,
-	0, 0, 0, 191, 0, 0, 0, 0, 0, 0,
-	524, 0, 552, 0, 0, 0, 229, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 521, 522, 0, 0, 0, 0, 568, 0,
-	523, 0, 0, 516, 517, 519, 518, 520, 525, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 247, 0,
-	303, 0, 567, 0, 0, 427, 0, 0, 565, 0,
-	0, 0, 0, 274, 0, 271, 171, 187, 0, 0,
-	313, 352, 358, 0, 0, 0, 210, 0, 356, 327,
-	412, 194, 237, 349, 332, 354, 0, 0, 355, 280,
-	400, 344, 410, 428, 429, 217, 307, 418, 391, 424,
-	439, 188, 214, 321, 384, 415, 375, 300, 396, 397,
-	270, 374, 245, 174, 278, 436, 186, 364, 202, 179,
-	386, 408, 199, 367, 0, 0, 441, 181, 406, 383,
-	297, 267, 268, 180, 0, 348, 222, 243, 212, 316,
-	403, 404, 211, 442, 190, 423, 183, 0, 422, 309,
-	399, 407, 298, 289, 182, 405, 296, 288, 273, 233,
-	254, 342, 283, 343, 255, 305, 304, 306, 0, 177,
-	0, 380, 416, 443, 195, 196, 197, 0, 232, 236,
-	242, 244, 250, 251, 258, 276, 320, 341, 339, 345,
-	0, 394, 411, 419, 426, 432, 433, 437, 434, 435,
-	438, 308, 257, 376, 272, 281, 0, 0, 326, 357,
-	200, 414, 377, 555, 566, 561, 562, 559, 560, 554,
-	558, 557, 556, 569, 546, 547, 548, 549, 551, 0,
-	563, 564, 550, 170, 184, 277, 0, 346, 240, 440,
-	421, 417, 0, 0, 216, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 172, 173, 185,
-	193, 203, 215, 230, 238, 248, 253, 256, 260, 261,
-	264, 269, 286, 291, 292, 293, 294, 310, 311, 312,
-	315, 318, 319, 322, 324, 325, 328, 334, 335, 336,
-	337, 338, 340, 347, 351, 359, 360, 361, 362, 363,
-	365, 366, 370, 371, 372, 373, 381, 385, 401, 402,
-	413, 425, 430, 249, 409, 431, 0, 285, 0, 0,
-	287, 234, 252, 262, 0, 420, 382, 189, 353, 241,
-	178, 206, 192, 213, 228, 231, 266, 295, 301, 330,
-	333, 246, 225, 204, 350, 201, 368, 388, 389, 390,
-	392, 299, 220, 393, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 317, 0, 0, 0, 0,
-	0, 0, 0, 0, 224, 0, 0, 0, 0, 275,
-	221, 0, 0, 331, 0, 176, 0, 369, 209, 284,
-	282, 398, 235, 227, 223, 208, 259, 290, 329, 387,
-	323, 0, 279, 0, 0, 378, 302, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 265, 207, 175, 314, 379, 239, 0, 0,
-	0, 167, 168, 169, 0, 0, 0, 0, 0, 0,
-	0, 0, 198, 0, 205, 0, 0, 0, 0, 219,
-	263, 226, 218, 395, 0, 0, 0, 191, 0, 805,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	229, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 247, 0, 303, 0, 0, 0, 804, 427,
-	0, 0, 0, 0, 0, 801, 802, 274, 767, 271,
-	171, 187, 795, 799, 313, 352, 358, 0, 0, 0,
-	210, 0, 356, 327, 412, 194, 237, 349, 332, 354,
-	0, 0, 355, 280, 400, 344, 410, 428, 429, 217,
-	307, 418, 391, 424, 439, 188, 214, 321, 384, 415,
-	375, 300, 396, 397, 270, 374, 245, 174, 278, 436,
-	186, 364, 202, 179, 386, 408, 199, 367, 0, 0,
-	441, 181, 406, 383, 297, 267, 268, 180, 0, 348,
-	222, 243, 212, 316, 403, 404, 211, 442, 190, 423,
-	183, 0, 422, 309, 399, 407, 298, 289, 182, 405,
-	296, 288, 273, 233, 254, 342, 283, 343, 255, 305,
-	304, 306, 0, 177, 0, 380, 416, 443, 195, 196,
-	197, 0, 232, 236, 242, 244, 250, 251, 258, 276,
-	320, 341, 339, 345, 0, 394, 411, 419, 426, 432,
-	433, 437, 434, 435, 438, 308, 257, 376, 272, 281,
-	0, 0, 326, 357, 200, 414, 377, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 170, 184, 277,
-	0, 346, 240, 440, 421, 417, 0, 0, 216, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 172, 173, 185, 193, 203, 215, 230, 238, 248,
-	253, 256, 260, 261, 264, 269, 286, 291, 292, 293,
-	294, 310, 311, 312, 315, 318, 319, 322, 324, 325,
-	328, 334, 335, 336, 337, 338, 340, 347, 351, 359,
-	360, 361, 362, 363, 365, 366, 370, 371, 372, 373,
-	381, 385, 401, 402, 413, 425, 430, 249, 409, 431,
-	0, 285, 0, 0, 287, 234, 252, 262, 0, 420,
-	382, 189, 353, 241, 178, 206, 192, 213, 228, 231,
-	266, 295, 301, 330, 333, 246, 225, 204, 350, 201,
-	368, 388, 389, 390, 392, 299, 220, 393, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 317,
-	0, 0, 0, 1080, 0, 0, 0, 0, 224, 0,
-	0, 0, 0, 275, 221, 0, 0, 331, 0, 176,
-	0, 369, 209, 284, 282, 398, 235, 227, 223, 208,
-	259, 290, 329, 387, 323, 0, 279, 0, 0, 378,
-	302, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 265, 207, 175, 314,
-	379, 239, 0, 0, 0, 167, 168, 169, 0, 1082,
-	0, 0, 0, 0, 0, 0, 198, 0, 205, 0,
-	0, 0, 0, 219, 263, 226, 218, 395, 0, 0,
-	0, 191, 0, 0, 966, 967, 965, 0, 0, 0,
-	0, 0, 0, 0, 229, 0, 0, 0, 0, 0,
-	0, 0, 968, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 247, 0, 303, 0,
-	0, 0, 0, 427, 0, 0, 0, 0, 0, 0,
-	0, 274, 0, 271, 171, 187, 0, 0, 313, 352,
-	358, 0, 0, 0, 210, 0, 356, 327, 412, 194,
-	237, 349, 332, 354, 0, 0, 355, 280, 400, 344,
-	410, 428, 429, 217, 307, 418, 391, 424, 439, 188,
-	214, 321, 384, 415, 375, 300, 396, 397, 270, 374,
-	245, 174, 278, 436, 186, 364, 202, 179, 386, 408,
-	199, 367, 0, 0, 441, 181, 406, 383, 297, 267,
-	268, 180, 0, 348, 222, 243, 212, 316, 403, 404,
-	211, 442, 190, 423, 183, 0, 422, 309, 399, 407,
-	298, 289, 182, 405, 296, 288, 273, 233, 254, 342,
-	283, 343, 255, 305, 304, 306, 0, 177, 0, 380,
-	416, 443, 195, 196, 197, 0, 232, 236, 242, 244,
-	250, 251, 258, 276, 320, 341, 339, 345, 0, 394,
-	411, 419, 426, 432, 433, 437, 434, 435, 438, 308,
-	257, 376, 272, 281, 0, 0, 326, 357, 200, 414,
-	377, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 170, 184, 277, 0, 346, 240, 440, 421, 417,
-	0, 0, 216, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 172, 173, 185, 193, 203,
-	215, 230, 238, 248, 253, 256, 260, 261, 264, 269,
-	286, 291, 292, 293, 294, 310, 311, 312, 315, 318,
-	319, 322, 324, 325, 328, 334, 335, 336, 337, 338,
-	340, 347, 351, 359, 360, 361, 362, 363, 365, 366,
-	370, 371, 372, 373, 381, 385, 401, 402, 413, 425,
-	430, 249, 409, 431, 0, 285, 0, 0, 287, 234,
-	252, 262, 0, 420, 382, 189, 353, 241, 178, 206,
-	192, 213, 228, 231, 266, 295, 301, 330, 333, 246,
-	225, 204, 350, 201, 368, 388, 389, 390, 392, 299,
-	220, 35, 393, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 317, 0, 0, 0, 0, 0,
-	0, 0, 0, 224, 0, 0, 0, 0, 275, 221,
-	0, 0, 331, 0, 176, 0, 369, 209, 284, 282,
-	398, 235, 227, 223, 208, 259, 290, 329, 387, 323,
-	0, 279, 0, 0, 378, 302, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 265, 207, 175, 314, 379, 239, 71, 0, 587,
-	167, 168, 169, 0, 0, 0, 0, 0, 0, 0,
-	0, 198, 0, 205, 0, 0, 0, 0, 219, 263,
-	226, 218, 395, 0, 0, 0, 191, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 229,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 247, 0, 303, 0, 0, 0, 0, 427, 0,
-	0, 0, 0, 0, 0, 0, 274, 0, 271, 171,
-	187, 0, 0, 313, 352, 358, 0, 0, 0, 210,
-	0, 356, 327, 412, 194, 237, 349, 332, 354, 0,
-	0, 355, 280, 400, 344, 410, 428, 429, 217, 307,
-	418, 391, 424, 439, 188, 214, 321, 384, 415, 375,
-	300, 396, 397, 270, 374, 245, 174, 278, 436, 186,
-	364, 202, 179, 386, 408, 199, 367, 0, 0, 441,
-	181, 406, 383, 297, 267, 268, 180, 0, 348, 222,
-	243, 212, 316, 403, 404, 211, 442, 190, 423, 183,
-	0, 422, 309, 399, 407, 298, 289, 182, 405, 296,
-	288, 273, 233, 254, 342, 283, 343, 255, 305, 304,
-	306, 0, 177, 0, 380, 416, 443, 195, 196, 197,
-	0, 232, 236, 242, 244, 250, 251, 258, 276, 320,
-	341, 339, 345, 0, 394, 411, 419, 426, 432, 433,
-	437, 434, 435, 438, 308, 257, 376, 272, 281, 0,
-	0, 326, 357, 200, 414, 377, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 170, 184, 277, 0,
-	346, 240, 440, 421, 417, 0, 0, 216, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	172, 173, 185, 193, 203, 215, 230, 238, 248, 253,
-	256, 260, 261, 264, 269, 286, 291, 292, 293, 294,
-	310, 311, 312, 315, 318, 319, 322, 324, 325, 328,
-	334, 335, 336, 337, 338, 340, 347, 351, 359, 360,
-	361, 362, 363, 365, 366, 370, 371, 372, 373, 381,
-	385, 401, 402, 413, 425, 430, 249, 409, 431, 0,
-	285, 0, 0, 287, 234, 252, 262, 0, 420, 382,
-	189, 353, 241, 178, 206, 192, 213, 228, 231, 266,
-	295, 301, 330, 333, 246, 225, 204, 350, 201, 368,
-	388, 389, 390, 392, 299, 220, 393, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 317, 0,
-	0, 0, 1449, 0, 0, 0, 0, 224, 0, 0,
-	0, 0, 275, 221, 0, 0, 331, 0, 176, 0,
-	369, 209, 284, 282, 398, 235, 227, 223, 208, 259,
-	290, 329, 387, 323, 0, 279, 0, 0, 378, 302,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 265, 207, 175, 314, 379,
-	239, 0, 0, 0, 167, 168, 169, 0, 1263, 0,
-	0, 0, 0, 0, 0, 198, 0, 205, 0, 0,
-	0, 0, 219, 263, 226, 218, 395, 0, 0, 0,
-	191, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 229, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 247, 0, 303, 0, 0,
-	0, 0, 427, 0, 0, 0, 0, 0, 0, 0,
-	274, 0, 271, 171, 187, 0, 0, 313, 352, 358,
-	0, 0, 0, 210, 0, 356, 327, 412, 194, 237,
-	349, 332, 354, 0, 1447, 355, 280, 400, 344, 410,
-	428, 429, 217, 307, 418, 391, 424, 439, 188, 214,
-	321, 384, 415, 375, 300, 396, 397, 270, 374, 245,
-	174, 278, 436, 186, 364, 202, 179, 386, 408, 199,
-	367, 0, 0, 441, 181, 406, 383, 297, 267, 268,
-	180, 0, 348, 222, 243, 212, 316, 403, 404, 211,
-	442, 190, 423, 183, 0, 422, 309, 399, 407, 298,
-	289, 182, 405, 296, 288, 273, 233, 254, 342, 283,
-	343, 255, 305, 304, 306, 0, 177, 0, 380, 416,
-	443, 195, 196, 197, 0, 232, 236, 242, 244, 250,
-	251, 258, 276, 320, 341, 339, 345, 0, 394, 411,
-	419, 426, 432, 433, 437, 434, 435, 438, 308, 257,
-	376, 272, 281, 0, 0, 326, 357, 200, 414, 377,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	170, 184, 277, 0, 346, 240, 440, 421, 417, 0,
-	0, 216, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 172, 173, 185, 193, 203, 215,
-	230, 238, 248, 253, 256, 260, 261, 264, 269, 286,
-	291, 292, 293, 294, 310, 311, 312, 315, 318, 319,
-	322, 324, 325, 328, 334, 335, 336, 337, 338, 340,
-	347, 351, 359, 360, 361, 362, 363, 365, 366, 370,
-	371, 372, 373, 381, 385, 401, 402, 413, 425, 430,
-	249, 409, 431, 0, 285, 0, 0, 287, 234, 252,
-	262, 0, 420, 382, 189, 353, 241, 178, 206, 192,
-	213, 228, 231, 266, 295, 301, 330, 333, 246, 225,
-	204, 350, 201, 368, 388, 389, 390, 392, 299, 220,
-	393, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 317, 0, 0, 0, 0, 0, 0, 0,
-	0, 224, 0, 0, 0, 0, 275, 221, 0, 0,
-	331, 0, 176, 0, 369, 209, 284, 282, 398, 235,
-	227, 223, 208, 259, 290, 329, 387, 323, 0, 279,
-	0, 0, 378, 302, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 265,
-	207, 175, 314, 379, 239, 0, 0, 0, 167, 168,
-	169, 0, 0, 0, 0, 0, 0, 0, 0, 198,
-	0, 205, 0, 0, 0, 0, 219, 263, 226, 218,
-	395, 0, 0, 0, 191, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 229, 0, 0,
-	0, 0, 0, 0, 0, 0, 761, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 247,
-	0, 303, 0, 0, 0, 0, 427, 0, 0, 0,
-	0, 0, 0, 0, 274, 767, 271, 171, 187, 765,
-	0, 313, 352, 358, 0, 0, 0, 210, 0, 356,
-	327, 412, 194, 237, 349, 332, 354, 0, 0, 355,
-	280, 400, 344, 410, 428, 429, 217, 307, 418, 391,
-	424, 439, 188, 214, 321, 384, 415, 375, 300, 396,
-	397, 270, 374, 245, 174, 278, 436, 186, 364, 202,
-	179, 386, 408, 199, 367, 0, 0, 441, 181, 406,
-	383, 297, 267, 268, 180, 0, 348, 222, 243, 212,
-	316, 403, 404, 211, 442, 190, 423, 183, 0, 422,
-	309, 399, 407, 298, 289, 182, 405, 296, 288, 273,
-	233, 254, 342, 283, 343, 255, 305, 304, 306, 0,
-	177, 0, 380, 416, 443, 195, 196, 197, 0, 232,
-	236, 242, 244, 250, 251, 258, 276, 320, 341, 339,
-	345, 0, 394, 411, 419, 426, 432, 433, 437, 434,
-	435, 438, 308, 257, 376, 272, 281, 0, 0, 326,
-	357, 200, 414, 377, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 170, 184, 277, 0, 346, 240,
-	440, 421, 417, 0, 0, 216, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 172, 173,
-	185, 193, 203, 215, 230, 238, 248, 253, 256, 260,
-	261, 264, 269, 286, 291, 292, 293, 294, 310, 311,
-	312, 315, 318, 319, 322, 324, 325, 328, 334, 335,
-	336, 337, 338, 340, 347, 351, 359, 360, 361, 362,
-	363, 365, 366, 370, 371, 372, 373, 381, 385, 401,
-	402, 413, 425, 430, 249, 409, 431, 0, 285, 0,
-	0, 287, 234, 252, 262, 0, 420, 382, 189, 353,
-	241, 178, 206, 192, 213, 228, 231, 266, 295, 301,
-	330, 333, 246, 225, 204, 350, 201, 368, 388, 389,
-	390, 392, 299, 220, 393, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 317, 0, 0, 0,
-	1449, 0, 0, 0, 0, 224, 0, 0, 0, 0,
-	275, 221, 0, 0, 331, 0, 176, 0, 369, 209,
-	284, 282, 398, 235, 227, 223, 208, 259, 290, 329,
-	387, 323, 0, 279, 0, 0, 378, 302, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 265, 207, 175, 314, 379, 239, 0,
-	0, 0, 167, 168, 169, 0, 1263, 0, 0, 0,
-	0, 0, 0, 198, 0, 205, 0, 0, 0, 0,
-	219, 263, 226, 218, 395, 0, 0, 0, 191, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 229, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 247, 0, 303, 0, 0, 0, 0,
-	427, 0, 0, 0, 0, 0, 0, 0, 274, 0,
-	271, 171, 187, 0, 0, 313, 352, 358, 0, 0,
-	0, 210, 0, 356, 327, 412, 194, 237, 349, 332,
-	354, 0, 0, 355, 280, 400, 344, 410, 428, 429,
-	217, 307, 418, 391, 424, 439, 188, 214, 321, 384,
-	415, 375, 300, 396, 397, 270, 374, 245, 174, 278,
-	436, 186, 364, 202, 179, 386, 408, 199, 367, 0,
-	0, 441, 181, 406, 383, 297, 267, 268, 180, 0,
-	348, 222, 243, 212, 316, 403, 404, 211, 442, 190,
-	423, 183, 0, 422, 309, 399, 407, 298, 289, 182,
-	405, 296, 288, 273, 233, 254, 342, 283, 343, 255,
-	305, 304, 306, 0, 177, 0, 380, 416, 443, 195,
-	196, 197, 0, 232, 236, 242, 244, 250, 251, 258,
-	276, 320, 341, 339, 345, 0, 394, 411, 419, 426,
-	432, 433, 437, 434, 435, 438, 308, 257, 376, 272,
-	281, 0, 0, 326, 357, 200, 414, 377, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 170, 184,
-	277, 0, 346, 240, 440, 421, 417, 0, 0, 216,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 172, 173, 185, 193, 203, 215, 230, 238,
-	248, 253, 256, 260, 261, 264, 269, 286, 291, 292,
-	293, 294, 310, 311, 312, 315, 318, 319, 322, 324,
-	325, 328, 334, 335, 336, 337, 338, 340, 347, 351,
-	359, 360, 361, 362, 363, 365, 366, 370, 371, 372,
-	373, 381, 385, 401, 402, 413, 425, 430, 249, 409,
-	431, 0, 285, 0, 0, 287, 234, 252, 262, 0,
-	420, 382, 189, 353, 241, 178, 206, 192, 213, 228,
-	231, 266, 295, 301, 330, 333, 246, 225, 204, 350,
-	201, 368, 388, 389, 390, 392, 299, 220, 393, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	317, 0, 0, 0, 0, 0, 0, 0, 0, 224,
-	0, 0, 0, 0, 275, 221, 0, 0, 331, 0,
-	176, 0, 369, 209, 284, 282, 398, 235, 227, 223,
-	208, 259, 290, 329, 387, 323, 0, 279, 0, 0,
-	378, 302, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 265, 207, 175,
-	314, 379, 239, 0, 0, 587, 167, 168, 169, 0,
-	0, 0, 0, 0, 0, 0, 0, 198, 0, 205,
-	0, 0, 0, 0, 219, 263, 226, 218, 395, 0,
-	0, 0, 191, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 229, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 247, 0, 303,
-	0, 0, 0, 0, 427, 0, 0, 0, 2129, 0,
-	0, 0, 274, 0, 271, 171, 187, 0, 0, 313,
-	352, 358, 0, 0, 0, 210, 0, 356, 327, 412,
-	194, 237, 349, 332, 354, 0, 0, 355, 280, 400,
-	344, 410, 428, 429, 217, 307, 418, 391, 424, 439,
-	188, 214, 321, 384, 415, 375, 300, 396, 397, 270,
-	374, 245, 174, 278, 436, 186, 364, 202, 179, 386,
-	408, 199, 367, 0, 0, 441, 181, 406, 383, 297,
-	267, 268, 180, 0, 348, 222, 243, 212, 316, 403,
-	404, 211, 442, 190, 423, 183, 0, 422, 309, 399,
-	407, 298, 289, 182, 405, 296, 288, 273, 233, 254,
-	342, 283, 343, 255, 305, 304, 306, 0, 177, 0,
-	380, 416, 443, 195, 196, 197, 0, 232, 236, 242,
-	244, 250, 251, 258, 276, 320, 341, 339, 345, 0,
-	394, 411, 419, 426, 432, 433, 437, 434, 435, 438,
-	308, 257, 376, 272, 281, 0, 0, 326, 357, 200,
-	414, 377, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 170, 184, 277, 0, 346, 240, 440, 421,
-	417, 0, 0, 216, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 172, 173, 185, 193,
-	203, 215, 230, 238, 248, 253, 256, 260, 261, 264,
-	269, 286, 291, 292, 293, 294, 310, 311, 312, 315,
-	318, 319, 322, 324, 325, 328, 334, 335, 336, 337,
-	338, 340, 347, 351, 359, 360, 361, 362, 363, 365,
-	366, 370, 371, 372, 373, 381, 385, 401, 402, 413,
-	425, 430, 249, 409, 431, 0, 285, 0, 0, 287,
-	234, 252, 262, 0, 420, 382, 189, 353, 241, 178,
-	206, 192, 213, 228, 231, 266, 295, 301, 330, 333,
-	246, 225, 204, 350, 201, 368, 388, 389, 390, 392,
-	299, 220, 35, 393, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 317, 0, 0, 0, 0,
-	0, 0, 0, 0, 224, 0, 0, 0, 0, 275,
-	221, 0, 0, 331, 0, 176, 0, 369, 209, 284,
-	282, 398, 235, 227, 223, 208, 259, 290, 329, 387,
-	323, 0, 279, 0, 0, 378, 302, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 265, 207, 175, 314, 379, 239, 71, 0,
-	0, 167, 168, 169, 0, 0, 0, 0, 0, 0,
-	0, 0, 198, 0, 205, 0, 0, 0, 0, 219,
-	263, 226, 218, 395, 0, 0, 0, 191, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	229, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 247, 0, 303, 0, 0, 0, 0, 427,
-	0, 0, 0, 0, 0, 0, 0, 274, 0, 271,
-	171, 187, 0, 0, 313, 352, 358, 0, 0, 0,
-	210, 0, 356, 327, 412, 194, 237, 349, 332, 354,
-	0, 0, 355, 280, 400, 344, 410, 428, 429, 217,
-	307, 418, 391, 424, 439, 188, 214, 321, 384, 415,
-	375, 300, 396, 397, 270, 374, 245, 174, 278, 436,
-	186, 364, 202, 179, 386, 408, 199, 367, 0, 0,
-	441, 181, 406, 383, 297, 267, 268, 180, 0, 348,
-	222, 243, 212, 316, 403, 404, 211, 442, 190, 423,
-	183, 0, 422, 309, 399, 407, 298, 289, 182, 405,
-	296, 288, 273, 233, 254, 342, 283, 343, 255, 305,
-	304, 306, 0, 177, 0, 380, 416, 443, 195, 196,
-	197, 0, 232, 236, 242, 244, 250, 251, 258, 276,
-	320, 341, 339, 345, 0, 394, 411, 419, 426, 432,
-	433, 437, 434, 435, 438, 308, 257, 376, 272, 281,
-	0, 0, 326, 357, 200, 414, 377, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 170, 184, 277,
-	0, 346, 240, 440, 421, 417, 0, 0, 216, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 172, 173, 185, 193, 203, 215, 230, 238, 248,
-	253, 256, 260, 261, 264, 269, 286, 291, 292, 293,
-	294, 310, 311, 312, 315, 318, 319, 322, 324, 325,
-	328, 334, 335, 336, 337, 338, 340, 347, 351, 359,
-	360, 361, 362, 363, 365, 366, 370, 371, 372, 373,
-	381, 385, 401, 402, 413, 425, 430, 249, 409, 431,
-	0, 285, 0, 0, 287, 234, 252, 262, 0, 420,
-	382, 189, 353, 241, 178, 206, 192, 213, 228, 231,
-	266, 295, 301, 330, 333, 246, 225, 204, 350, 201,
-	368, 388, 389, 390, 392, 299, 220, 393, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 317,
-	0, 0, 0, 0, 0, 0, 0, 0, 224, 0,
-	0, 0, 0, 275, 221, 0, 0, 331, 0, 176,
-	0, 369, 209, 284, 282, 398, 235, 227, 223, 208,
-	259, 290, 329, 387, 323, 0, 279, 0, 0, 378,
-	302, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 265, 207, 175, 314,
-	379, 239, 0, 0, 0, 167, 168, 169, 0, 0,
-	1468, 0, 0, 1469, 0, 0, 198, 0, 205, 0,
-	0, 0, 0, 219, 263, 226, 218, 395, 0, 0,
-	0, 191, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 229, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 247, 0, 303, 0,
-	0, 0, 0, 427, 0, 0, 0, 0, 0, 0,
-	0, 274, 0, 271, 171, 187, 0, 0, 313, 352,
-	358, 0, 0, 0, 210, 0, 356, 327, 412, 194,
-	237, 349, 332, 354, 0, 0, 355, 280, 400, 344,
-	410, 428, 429, 217, 307, 418, 391, 424, 439, 188,
-	214, 321, 384, 415, 375, 300, 396, 397, 270, 374,
-	245, 174, 278, 436, 186, 364, 202, 179, 386, 408,
-	199, 367, 0, 0, 441, 181, 406, 383, 297, 267,
-	268, 180, 0, 348, 222, 243, 212, 316, 403, 404,
-	211, 442, 190, 423, 183, 0, 422, 309, 399, 407,
-	298, 289, 182, 405, 296, 288, 273, 233, 254, 342,
-	283, 343, 255, 305, 304, 306, 0, 177, 0, 380,
-	416, 443, 195, 196, 197, 0, 232, 236, 242, 244,
-	250, 251, 258, 276, 320, 341, 339, 345, 0, 394,
-	411, 419, 426, 432, 433, 437, 434, 435, 438, 308,
-	257, 376, 272, 281, 0, 0, 326, 357, 200, 414,
-	377, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 170, 184, 277, 0, 346, 240, 440, 421, 417,
-	0, 0, 216, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 172, 173, 185, 193, 203,
-	215, 230, 238, 248, 253, 256, 260, 261, 264, 269,
-	286, 291, 292, 293, 294, 310, 311, 312, 315, 318,
-	319, 322, 324, 325, 328, 334, 335, 336, 337, 338,
-	340, 347, 351, 359, 360, 361, 362, 363, 365, 366,
-	370, 371, 372, 373, 381, 385, 401, 402, 413, 425,
-	430, 249, 409, 431, 0, 285, 0, 0, 287, 234,
-	252, 262, 0, 420, 382, 189, 353, 241, 178, 206,
-	192, 213, 228, 231, 266, 295, 301, 330, 333, 246,
-	225, 204, 350, 201, 368, 388, 389, 390, 392, 299,
-	220, 393, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 317, 0, 0, 0, 0, 0, 0,
-	0, 0, 224, 1113, 0, 0, 0, 275, 221, 0,
-	0, 331, 0, 176, 0, 369, 209, 284, 282, 398,
-	235, 227, 223, 208, 259, 290, 329, 387, 323, 0,
-	279, 0, 0, 378, 302, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	265, 207, 175, 314, 379, 239, 0, 0, 0, 167,
-	168, 169, 0, 1112, 0, 0, 0, 0, 0, 0,
-	198, 0, 205, 0, 0, 0, 0, 219, 263, 226,
-	218, 395, 0, 0, 0, 191, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 229, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	247, 0, 303, 0, 0, 0, 0, 427, 0, 0,
-	0, 0, 0, 0, 0, 274, 0, 271, 171, 187,
-	0, 0, 313, 352, 358, 0, 0, 0, 210, 0,
-	356, 327, 412, 194, 237, 349, 332, 354, 0, 0,
-	355, 280, 400, 344, 410, 428, 429, 217, 307, 418,
-	391, 424, 439, 188, 214, 321, 384, 415, 375, 300,
-	396, 397, 270, 374, 245, 174, 278, 436, 186, 364,
-	202, 179, 386, 408, 199, 367, 0, 0, 441, 181,
-	406, 383, 297, 267, 268, 180, 0, 348, 222, 243,
-	212, 316, 403, 404, 211, 442, 190, 423, 183, 0,
-	422, 309, 399, 407, 298, 289, 182, 405, 296, 288,
-	273, 233, 254, 342, 283, 343, 255, 305, 304, 306,
-	0, 177, 0, 380, 416, 443, 195, 196, 197, 0,
-	232, 236, 242, 244, 250, 251, 258, 276, 320, 341,
-	339, 345, 0, 394, 411, 419, 426, 432, 433, 437,
-	434, 435, 438, 308, 257, 376, 272, 281, 0, 0,
-	326, 357, 200, 414, 377, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 170, 184, 277, 0, 346,
-	240, 440, 421, 417, 0, 0, 216, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 172,
-	173, 185, 193, 203, 215, 230, 238, 248, 253, 256,
-	260, 261, 264, 269, 286, 291, 292, 293, 294, 310,
-	311, 312, 315, 318, 319, 322, 324, 325, 328, 334,
-	335, 336, 337, 338, 340, 347, 351, 359, 360, 361,
-	362, 363, 365, 366, 370, 371, 372, 373, 381, 385,
-	401, 402, 413, 425, 430, 249, 409, 431, 0, 285,
-	0, 0, 287, 234, 252, 262, 0, 420, 382, 189,
-	353, 241, 178, 206, 192, 213, 228, 231, 266, 295,
-	301, 330, 333, 246, 225, 204, 350, 201, 368, 388,
-	389, 390, 392, 299, 220, 393, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 317, 0, 0,
-	0, 0, 0, 0, 0, 0, 224, 0, 0, 0,
-	0, 275, 221, 0, 0, 331, 0, 176, 0, 369,
-	209, 284, 282, 398, 235, 227, 223, 208, 259, 290,
-	329, 387, 323, 0, 279, 0, 0, 378, 302, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 265, 207, 175, 314, 379, 239,
-	0, 0, 0, 167, 168, 169, 0, 0, 0, 0,
-	0, 0, 0, 0, 198, 0, 205, 0, 0, 0,
-	0, 219, 263, 226, 218, 395, 0, 0, 0, 191,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 229, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 247, 0, 303, 0, 0, 0,
-	0, 427, 0, 0, 0, 2212, 0, 0, 0, 274,
-	0, 271, 171, 187, 0, 0, 313, 352, 358, 0,
-	0, 0, 210, 0, 356, 327, 412, 194, 237, 349,
-	332, 354, 0, 0, 355, 280, 400, 344, 410, 428,
-	429, 217, 307, 418, 391, 424, 439, 188, 214, 321,
-	384, 415, 375, 300, 396, 397, 270, 374, 245, 174,
-	278, 436, 186, 364, 202, 179, 386, 408, 199, 367,
-	0, 0, 441, 181, 406, 383, 297, 267, 268, 180,
-	0, 348, 222, 243, 212, 316, 403, 404, 211, 442,
-	190, 423, 183, 0, 422, 309, 399, 407, 298, 289,
-	182, 405, 296, 288, 273, 233, 254, 342, 283, 343,
-	255, 305, 304, 306, 0, 177, 0, 380, 416, 443,
-	195, 196, 197, 0, 232, 236, 242, 244, 250, 251,
-	258, 276, 320, 341, 339, 345, 0, 394, 411, 419,
-	426, 432, 433, 437, 434, 435, 438, 308, 257, 376,
-	272, 281, 0, 0, 326, 357, 200, 414, 377, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 170,
-	184, 277, 0, 346, 240, 440, 421, 417, 0, 0,
-	216, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 172, 173, 185, 193, 203, 215, 230,
-	238, 248, 253, 256, 260, 261, 264, 269, 286, 291,
-	292, 293, 294, 310, 311, 312, 315, 318, 319, 322,
-	324, 325, 328, 334, 335, 336, 337, 338, 340, 347,
-	351, 359, 360, 361, 362, 363, 365, 366, 370, 371,
-	372, 373, 381, 385, 401, 402, 413, 425, 430, 249,
-	409, 431, 0, 285, 0, 0, 287, 234, 252, 262,
-	0, 420, 382, 189, 353, 241, 178, 206, 192, 213,
-	228, 231, 266, 295, 301, 330, 333, 246, 225, 204,
-	350, 201, 368, 388, 389, 390, 392, 299, 220, 393,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 317, 0, 0, 0, 0, 0, 0, 0, 0,
-	224, 0, 0, 0, 0, 275, 221, 0, 0, 331,
-	0, 176, 0, 369, 209, 284, 282, 398, 235, 227,
-	223, 208, 259, 290, 329, 387, 323, 0, 279, 0,
-	0, 378, 302, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 265, 207,
-	175, 314, 379, 239, 0, 0, 0, 167, 168, 169,
-	0, 0, 0, 0, 0, 0, 0, 0, 198, 0,
-	205, 0, 0, 0, 0, 219, 263, 226, 218, 395,
-	0, 0, 0, 191, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 229, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 247, 0,
-	303, 0, 0, 0, 0, 427, 0, 0, 0, 2129,
-	0, 0, 0, 274, 0, 271, 171, 187, 0, 0,
-	313, 352, 358, 0, 0, 0, 210, 0, 356, 327,
-	412, 194, 237, 349, 332, 354, 0, 0, 355, 280,
-	400, 344, 410, 428, 429, 217, 307, 418, 391, 424,
-	439, 188, 214, 321, 384, 415, 375, 300, 396, 397,
-	270, 374, 245, 174, 278, 436, 186, 364, 202, 179,
-	386, 408, 199, 367, 0, 0, 441, 181, 406, 383,
-	297, 267, 268, 180, 0, 348, 222, 243, 212, 316,
-	403, 404, 211, 442, 190, 423, 183, 0, 422, 309,
-	399, 407, 298, 289, 182, 405, 296, 288, 273, 233,
-	254, 342, 283, 343, 255, 305, 304, 306, 0, 177,
-	0, 380, 416, 443, 195, 196, 197, 0, 232, 236,
-	242, 244, 250, 251, 258, 276, 320, 341, 339, 345,
-	0, 394, 411, 419, 426, 432, 433, 437, 434, 435,
-	438, 308, 257, 376, 272, 281, 0, 0, 326, 357,
-	200, 414, 377, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 170, 184, 277, 0, 346, 240, 440,
-	421, 417, 0, 0, 216, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 172, 173, 185,
-	193, 203, 215, 230, 238, 248, 253, 256, 260, 261,
-	264, 269, 286, 291, 292, 293, 294, 310, 311, 312,
-	315, 318, 319, 322, 324, 325, 328, 334, 335, 336,
-	337, 338, 340, 347, 351, 359, 360, 361, 362, 363,
-	365, 366, 370, 371, 372, 373, 381, 385, 401, 402,
-	413, 425, 430, 249, 409, 431, 0, 285, 0, 0,
-	287, 234, 252, 262, 0, 420, 382, 189, 353, 241,
-	178, 206, 192, 213, 228, 231, 266, 295, 301, 330,
-	333, 246, 225, 204, 350, 201, 368, 388, 389, 390,
-	392, 299, 220, 393, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 317, 0, 0, 0, 0,
-	0, 0, 0, 0, 224, 0, 0, 0, 0, 275,
-	221, 0, 0, 331, 0, 176, 0, 369, 209, 284,
-	282, 398, 235, 227, 223, 208, 259, 290, 329, 387,
-	323, 0, 279, 0, 0, 378, 302, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 265, 207, 175, 314, 379, 239, 71, 0,
-	0, 167, 168, 169, 0, 0, 0, 0, 0, 0,
-	0, 0, 198, 0, 205, 0, 0, 0, 0, 219,
-	263, 226, 218, 395, 0, 0, 0, 191, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	229, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 247, 0, 303, 0, 0, 0, 0, 427,
-	0, 0, 0, 0, 0, 0, 0, 274, 0, 271,
-	171, 187, 0, 0, 313, 352, 358, 0, 0, 0,
-	210, 0, 356, 327, 412, 194, 237, 349, 332, 354,
-	0, 0, 355, 280, 400, 344, 410, 428, 429, 217,
-	307, 418, 391, 424, 439, 188, 214, 321, 384, 415,
-	375, 300, 396, 397, 270, 374, 245, 174, 278, 436,
-	186, 364, 202, 179, 386, 408, 199, 367, 0, 0,
-	441, 181, 406, 383, 297, 267, 268, 180, 0, 348,
-	222, 243, 212, 316, 403, 404, 211, 442, 190, 423,
-	183, 0, 422, 309, 399, 407, 298, 289, 182, 405,
-	296, 288, 273, 233, 254, 342, 283, 343, 255, 305,
-	304, 306, 0, 177, 0, 380, 416, 443, 195, 196,
-	197, 0, 232, 236, 242, 244, 250, 251, 258, 276,
-	320, 341, 339, 345, 0, 394, 411, 419, 426, 432,
-	433, 437, 434, 435, 438, 308, 257, 376, 272, 281,
-	0, 0, 326, 357, 200, 414, 377, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 170, 184, 277,
-	0, 346, 240, 440, 421, 417, 0, 0, 216, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 172, 173, 185, 193, 203, 215, 230, 238, 248,
-	253, 256, 260, 261, 264, 269, 286, 291, 292, 293,
-	294, 310, 311, 312, 315, 318, 319, 322, 324, 325,
-	328, 334, 335, 336, 337, 338, 340, 347, 351, 359,
-	360, 361, 362, 363, 365, 366, 370, 371, 372, 373,
-	381, 385, 401, 402, 413, 425, 430, 249, 409, 431,
-	0, 285, 0, 0, 287, 234, 252, 262, 0, 420,
-	382, 189, 353, 241, 178, 206, 192, 213, 228, 231,
-	266, 295, 301, 330, 333, 246, 225, 204, 350, 201,
-	368, 388, 389, 390, 392, 299, 220, 393, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 317,
-	0, 0, 0, 0, 0, 0, 0, 0, 224, 0,
-	0, 0, 0, 275, 221, 0, 0, 331, 0, 176,
-	0, 369, 209, 284, 282, 398, 235, 227, 223, 208,
-	259, 290, 329, 387, 323, 0, 279, 0, 0, 378,
-	302, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 265, 207, 175, 314,
-	379, 239, 0, 0, 0, 167, 168, 169, 0, 1263,
-	0, 0, 0, 0, 0, 0, 198, 0, 205, 0,
-	0, 0, 0, 219, 263, 226, 218, 395, 0, 0,
-	0, 191, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 229, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 247, 0, 303, 0,
-	0, 0, 0, 427, 0, 0, 0, 0, 0, 0,
-	0, 274, 0, 271, 171, 187, 0, 0, 313, 352,
-	358, 0, 0, 0, 210, 0, 356, 327, 412, 194,
-	237, 349, 332, 354, 0, 0, 355, 280, 400, 344,
-	410, 428, 429, 217, 307, 418, 391, 424, 439, 188,
-	214, 321, 384, 415, 375, 300, 396, 397, 270, 374,
-	245, 174, 278, 436, 186, 364, 202, 179, 386, 408,
-	199, 367, 0, 0, 441, 181, 406, 383, 297, 267,
-	268, 180, 0, 348, 222, 243, 212, 316, 403, 404,
-	211, 442, 190, 423, 183, 0, 422, 309, 399, 407,
-	298, 289, 182, 405, 296, 288, 273, 233, 254, 342,
-	283, 343, 255, 305, 304, 306, 0, 177, 0, 380,
-	416, 443, 195, 196, 197, 0, 232, 236, 242, 244,
-	250, 251, 258, 276, 320, 341, 339, 345, 0, 394,
-	411, 419, 426, 432, 433, 437, 434, 435, 438, 308,
-	257, 376, 272, 281, 0, 0, 326, 357, 200, 414,
-	377, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 170, 184, 277, 0, 346, 240, 440, 421, 417,
-	0, 0, 216, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 172, 173, 185, 193, 203,
-	215, 230, 238, 248, 253, 256, 260, 261, 264, 269,
-	286, 291, 292, 293, 294, 310, 311, 312, 315, 318,
-	319, 322, 324, 325, 328, 334, 335, 336, 337, 338,
-	340, 347, 351, 359, 360, 361, 362, 363, 365, 366,
-	370, 371, 372, 373, 381, 385, 401, 402, 413, 425,
-	430, 249, 409, 431, 0, 285, 0, 0, 287, 234,
-	252, 262, 0, 420, 382, 189, 353, 241, 178, 206,
-	192, 213, 228, 231, 266, 295, 301, 330, 333, 246,
-	225, 204, 350, 201, 368, 388, 389, 390, 392, 299,
-	220, 393, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 317, 0, 0, 0, 0, 0, 0,
-	0, 0, 224, 0, 0, 0, 0, 275, 221, 0,
-	0, 331, 0, 176, 0, 369, 209, 284, 282, 398,
-	235, 227, 223, 208, 259, 290, 329, 387, 323, 0,
-	279, 0, 0, 378, 302, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	265, 207, 175, 314, 379, 239, 0, 0, 0, 167,
-	168, 169, 0, 1082, 0, 0, 0, 0, 0, 0,
-	198, 0, 205, 0, 0, 0, 0, 219, 263, 226,
-	218, 395, 0, 0, 0, 191, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 229, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	247, 0, 303, 0, 0, 0, 0, 427, 0, 0,
-	0, 0, 0, 0, 0, 274, 0, 271, 171, 187,
-	0, 0, 313, 352, 358, 0, 0, 0, 210, 0,
-	356, 327, 412, 194, 237, 349, 332, 354, 0, 0,
-	355, 280, 400, 344, 410, 428, 429, 217, 307, 418,
-	391, 424, 439, 188, 214, 321, 384, 415, 375, 300,
-	396, 397, 270, 374, 245, 174, 278, 436, 186, 364,
-	202, 179, 386, 408, 199, 367, 0, 0, 441, 181,
-	406, 383, 297, 267, 268, 180, 0, 348, 222, 243,
-	212, 316, 403, 404, 211, 442, 190, 423, 183, 0,
-	422, 309, 399, 407, 298, 289, 182, 405, 296, 288,
-	273, 233, 254, 342, 283, 343, 255, 305, 304, 306,
-	0, 177, 0, 380, 416, 443, 195, 196, 197, 0,
-	232, 236, 242, 244, 250, 251, 258, 276, 320, 341,
-	339, 345, 0, 394, 411, 419, 426, 432, 433, 437,
-	434, 435, 438, 308, 257, 376, 272, 281, 0, 0,
-	326, 357, 200, 414, 377, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 170, 184, 277, 0, 346,
-	240, 440, 421, 417, 0, 0, 216, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 172,
-	173, 185, 193, 203, 215, 230, 238, 248, 253, 256,
-	260, 261, 264, 269, 286, 291, 292, 293, 294, 310,
-	311, 312, 315, 318, 319, 322, 324, 325, 328, 334,
-	335, 336, 337, 338, 340, 347, 351, 359, 360, 361,
-	362, 363, 365, 366, 370, 371, 372, 373, 381, 385,
-	401, 402, 413, 425, 430, 249, 409, 431, 0, 285,
-	0, 0, 287, 234, 252, 262, 0, 420, 382, 189,
-	353, 241, 178, 206, 192, 213, 228, 231, 266, 295,
-	301, 330, 333, 246, 225, 204, 350, 201, 368, 388,
-	389, 390, 392, 299, 220, 393, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 317, 0, 0,
-	0, 0, 0, 0, 0, 0, 224, 0, 0, 0,
-	0, 275, 221, 0, 0, 331, 0, 176, 0, 369,
-	209, 284, 282, 398, 235, 227, 223, 208, 259, 290,
-	329, 387, 323, 0, 279, 0, 0, 378, 302, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 265, 207, 175, 314, 379, 239,
-	0, 0, 0, 167, 168, 169, 0, 0, 0, 0,
-	0, 0, 0, 0, 198, 0, 205, 0, 0, 0,
-	0, 219, 263, 226, 218, 395, 0, 0, 0, 191,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 229, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 247, 0, 303, 0, 0, 0,
-	0, 427, 0, 0, 0, 0, 0, 0, 0, 274,
-	0, 271, 171, 187, 0, 0, 313, 352, 358, 0,
-	0, 0, 210, 0, 356, 327, 412, 194, 237, 349,
-	332, 354, 0, 0, 355, 280, 400, 344, 410, 428,
-	429, 217, 307, 418, 391, 424, 439, 188, 214, 321,
-	384, 415, 375, 300, 396, 397, 270, 374, 245, 174,
-	278, 436, 186, 364, 202, 179, 386, 408, 199, 367,
-	0, 0, 441, 181, 406, 383, 297, 267, 268, 180,
-	0, 348, 222, 243, 212, 316, 403, 404, 211, 442,
-	190, 423, 183, 0, 422, 309, 399, 407, 298, 289,
-	182, 405, 296, 288, 273, 233, 254, 342, 283, 343,
-	255, 305, 304, 306, 0, 177, 0, 380, 416, 443,
-	195, 196, 197, 0, 232, 236, 242, 244, 250, 251,
-	258, 276, 320, 341, 339, 345, 0, 394, 411, 419,
-	426, 432, 433, 437, 434, 435, 438, 308, 257, 376,
-	272, 281, 0, 0, 326, 357, 200, 414, 377, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 170,
-	184, 277, 1354, 346, 240, 440, 421, 417, 0, 0,
-	216, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 172, 173, 185, 193, 203, 215, 230,
-	238, 248, 253, 256, 260, 261, 264, 269, 286, 291,
-	292, 293, 294, 310, 311, 312, 315, 318, 319, 322,
-	324, 325, 328, 334, 335, 336, 337, 338, 340, 347,
-	351, 359, 360, 361, 362, 363, 365, 366, 370, 371,
-	372, 373, 381, 385, 401, 402, 413, 425, 430, 249,
-	409, 431, 0, 285, 0, 0, 287, 234, 252, 262,
-	0, 420, 382, 189, 353, 241, 178, 206, 192, 213,
-	228, 231, 266, 295, 301, 330, 333, 246, 225, 204,
-	350, 201, 368, 388, 389, 390, 392, 299, 220, 393,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 317, 0, 1235, 0, 0, 0, 0, 0, 0,
-	224, 0, 0, 0, 0, 275, 221, 0, 0, 331,
-	0, 176, 0, 369, 209, 284, 282, 398, 235, 227,
-	223, 208, 259, 290, 329, 387, 323, 0, 279, 0,
-	0, 378, 302, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 265, 207,
-	175, 314, 379, 239, 0, 0, 0, 167, 168, 169,
-	0, 0, 0, 0, 0, 0, 0, 0, 198, 0,
-	205, 0, 0, 0, 0, 219, 263, 226, 218, 395,
-	0, 0, 0, 191, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 229, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 247, 0,
-	303, 0, 0, 0, 0, 427, 0, 0, 0, 0,
-	0, 0, 0, 274, 0, 271, 171, 187, 0, 0,
-	313, 352, 358, 0, 0, 0, 210, 0, 356, 327,
-	412, 194, 237, 349, 332, 354, 0, 0, 355, 280,
-	400, 344, 410, 428, 429, 217, 307, 418, 391, 424,
-	439, 188, 214, 321, 384, 415, 375, 300, 396, 397,
-	270, 374, 245, 174, 278, 436, 186, 364, 202, 179,
-	386, 408, 199, 367, 0, 0, 441, 181, 406, 383,
-	297, 267, 268, 180, 0, 348, 222, 243, 212, 316,
-	403, 404, 211, 442, 190, 423, 183, 0, 422, 309,
-	399, 407, 298, 289, 182, 405, 296, 288, 273, 233,
-	254, 342, 283, 343, 255, 305, 304, 306, 0, 177,
-	0, 380, 416, 443, 195, 196, 197, 0, 232, 236,
-	242, 244, 250, 251, 258, 276, 320, 341, 339, 345,
-	0, 394, 411, 419, 426, 432, 433, 437, 434, 435,
-	438, 308, 257, 376, 272, 281, 0, 0, 326, 357,
-	200, 414, 377, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 170, 184, 277, 0, 346, 240, 440,
-	421, 417, 0, 0, 216, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 172, 173, 185,
-	193, 203, 215, 230, 238, 248, 253, 256, 260, 261,
-	264, 269, 286, 291, 292, 293, 294, 310, 311, 312,
-	315, 318, 319, 322, 324, 325, 328, 334, 335, 336,
-	337, 338, 340, 347, 351, 359, 360, 361, 362, 363,
-	365, 366, 370, 371, 372, 373, 381, 385, 401, 402,
-	413, 425, 430, 249, 409, 431, 0, 285, 0, 0,
-	287, 234, 252, 262, 0, 420, 382, 189, 353, 241,
-	178, 206, 192, 213, 228, 231, 266, 295, 301, 330,
-	333, 246, 225, 204, 350, 201, 368, 388, 389, 390,
-	392, 299, 220, 393, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 317, 0, 1233, 0, 0,
-	0, 0, 0, 0, 224, 0, 0, 0, 0, 275,
-	221, 0, 0, 331, 0, 176, 0, 369, 209, 284,
-	282, 398, 235, 227, 223, 208, 259, 290, 329, 387,
-	323, 0, 279, 0, 0, 378, 302, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 265, 207, 175, 314, 379, 239, 0, 0,
-	0, 167, 168, 169, 0, 0, 0, 0, 0, 0,
-	0, 0, 198, 0, 205, 0, 0, 0, 0, 219,
-	263, 226, 218, 395, 0, 0, 0, 191, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	229, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 247, 0, 303, 0, 0, 0, 0, 427,
-	0, 0, 0, 0, 0, 0, 0, 274, 0, 271,
-	171, 187, 0, 0, 313, 352, 358, 0, 0, 0,
-	210, 0, 356, 327, 412, 194, 237, 349, 332, 354,
-	0, 0, 355, 280, 400, 344, 410, 428, 429, 217,
-	307, 418, 391, 424, 439, 188, 214, 321, 384, 415,
-	375, 300, 396, 397, 270, 374, 245, 174, 278, 436,
-	186, 364, 202, 179, 386, 408, 199, 367, 0, 0,
-	441, 181, 406, 383, 297, 267, 268, 180, 0, 348,
-	222, 243, 212, 316, 403, 404, 211, 442, 190, 423,
-	183, 0, 422, 309, 399, 407, 298, 289, 182, 405,
-	296, 288, 273, 233, 254, 342, 283, 343, 255, 305,
-	304, 306, 0, 177, 0, 380, 416, 443, 195, 196,
-	197, 0, 232, 236, 242, 244, 250, 251, 258, 276,
-	320, 341, 339, 345, 0, 394, 411, 419, 426, 432,
-	433, 437, 434, 435, 438, 308, 257, 376, 272, 281,
-	0, 0, 326, 357, 200, 414, 377, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 170, 184, 277,
-	0, 346, 240, 440, 421, 417, 0, 0, 216, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 172, 173, 185, 193, 203, 215, 230, 238, 248,
-	253, 256, 260, 261, 264, 269, 286, 291, 292, 293,
-	294, 310, 311, 312, 315, 318, 319, 322, 324, 325,
-	328, 334, 335, 336, 337, 338, 340, 347, 351, 359,
-	360, 361, 362, 363, 365, 366, 370, 371, 372, 373,
-	381, 385, 401, 402, 413, 425, 430, 249, 409, 431,
-	0, 285, 0, 0, 287, 234, 252, 262, 0, 420,
-	382, 189, 353, 241, 178, 206, 192, 213, 228, 231,
-	266, 295, 301, 330, 333, 246, 225, 204, 350, 201,
-	368, 388, 389, 390, 392, 299, 220, 393, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 317,
-	0, 1231, 0, 0, 0, 0, 0, 0, 224, 0,
-	0, 0, 0, 275, 221, 0, 0, 331, 0, 176,
-	0, 369, 209, 284, 282, 398, 235, 227, 223, 208,
-	259, 290, 329, 387, 323, 0, 279, 0, 0, 378,
-	302, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 265, 207, 175, 314,
-	379, 239, 0, 0, 0, 167, 168, 169, 0, 0,
-	0, 0, 0, 0, 0, 0, 198, 0, 205, 0,
-	0, 0, 0, 219, 263, 226, 218, 395, 0, 0,
-	0, 191, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 229, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 247, 0, 303, 0,
-	0, 0, 0, 427, 0, 0, 0, 0, 0, 0,
-	0, 274, 0, 271, 171, 187, 0, 0, 313, 352,
-	358, 0, 0, 0, 210, 0, 356, 327, 412, 194,
-	237, 349, 332, 354, 0, 0, 355, 280, 400, 344,
-	410, 428, 429, 217, 307, 418, 391, 424, 439, 188,
-	214, 321, 384, 415, 375, 300, 396, 397, 270, 374,
-	245, 174, 278, 436, 186, 364, 202, 179, 386, 408,
-	199, 367, 0, 0, 441, 181, 406, 383, 297, 267,
-	268, 180, 0, 348, 222, 243, 212, 316, 403, 404,
-	211, 442, 190, 423, 183, 0, 422, 309, 399, 407,
-	298, 289, 182, 405, 296, 288, 273, 233, 254, 342,
-	283, 343, 255, 305, 304, 306, 0, 177, 0, 380,
-	416, 443, 195, 196, 197, 0, 232, 236, 242, 244,
-	250, 251, 258, 276, 320, 341, 339, 345, 0, 394,
-	411, 419, 426, 432, 433, 437, 434, 435, 438, 308,
-	257, 376, 272, 281, 0, 0, 326, 357, 200, 414,
-	377, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 170, 184, 277, 0, 346, 240, 440, 421, 417,
-	0, 0, 216, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 172, 173, 185, 193, 203,
-	215, 230, 238, 248, 253, 256, 260, 261, 264, 269,
-	286, 291, 292, 293, 294, 310, 311, 312, 315, 318,
-	319, 322, 324, 325, 328, 334, 335, 336, 337, 338,
-	340, 347, 351, 359, 360, 361, 362, 363, 365, 366,
-	370, 371, 372, 373, 381, 385, 401, 402, 413, 425,
-	430, 249, 409, 431, 0, 285, 0, 0, 287, 234,
-	252, 262, 0, 420, 382, 189, 353, 241, 178, 206,
-	192, 213, 228, 231, 266, 295, 301, 330, 333, 246,
-	225, 204, 350, 201, 368, 388, 389, 390, 392, 299,
-	220, 393, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 317, 0, 1229, 0, 0, 0, 0,
-	0, 0, 224, 0, 0, 0, 0, 275, 221, 0,
-	0, 331, 0, 176, 0, 369, 209, 284, 282, 398,
-	235, 227, 223, 208, 259, 290, 329, 387, 323, 0,
-	279, 0, 0, 378, 302, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	265, 207, 175, 314, 379, 239, 0, 0, 0, 167,
-	168, 169, 0, 0, 0, 0, 0, 0, 0, 0,
-	198, 0, 205, 0, 0, 0, 0, 219, 263, 226,
-	218, 395, 0, 0, 0, 191, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 229, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	247, 0, 303, 0, 0, 0, 0, 427, 0, 0,
-	0, 0, 0, 0, 0, 274, 0, 271, 171, 187,
-	0, 0, 313, 352, 358, 0, 0, 0, 210, 0,
-	356, 327, 412, 194, 237, 349, 332, 354, 0, 0,
-	355, 280, 400, 344, 410, 428, 429, 217, 307, 418,
-	391, 424, 439, 188, 214, 321, 384, 415, 375, 300,
-	396, 397, 270, 374, 245, 174, 278, 436, 186, 364,
-	202, 179, 386, 408, 199, 367, 0, 0, 441, 181,
-	406, 383, 297, 267, 268, 180, 0, 348, 222, 243,
-	212, 316, 403, 404, 211, 442, 190, 423, 183, 0,
-	422, 309, 399, 407, 298, 289, 182, 405, 296, 288,
-	273, 233, 254, 342, 283, 343, 255, 305, 304, 306,
-	0, 177, 0, 380, 416, 443, 195, 196, 197, 0,
-	232, 236, 242, 244, 250, 251, 258, 276, 320, 341,
-	339, 345, 0, 394, 411, 419, 426, 432, 433, 437,
-	434, 435, 438, 308, 257, 376, 272, 281, 0, 0,
-	326, 357, 200, 414, 377, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 170, 184, 277, 0, 346,
-	240, 440, 421, 417, 0, 0, 216, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 172,
-	173, 185, 193, 203, 215, 230, 238, 248, 253, 256,
-	260, 261, 264, 269, 286, 291, 292, 293, 294, 310,
-	311, 312, 315, 318, 319, 322, 324, 325, 328, 334,
-	335, 336, 337, 338, 340, 347, 351, 359, 360, 361,
-	362, 363, 365, 366, 370, 371, 372, 373, 381, 385,
-	401, 402, 413, 425, 430, 249, 409, 431, 0, 285,
-	0, 0, 287, 234, 252, 262, 0, 420, 382, 189,
-	353, 241, 178, 206, 192, 213, 228, 231, 266, 295,
-	301, 330, 333, 246, 225, 204, 350, 201, 368, 388,
-	389, 390, 392, 299, 220, 393, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 317, 0, 1227,
-	0, 0, 0, 0, 0, 0, 224, 0, 0, 0,
-	0, 275, 221, 0, 0, 331, 0, 176, 0, 369,
-	209, 284, 282, 398, 235, 227, 223, 208, 259, 290,
-	329, 387, 323, 0, 279, 0, 0, 378, 302, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 265, 207, 175, 314, 379, 239,
-	0, 0, 0, 167, 168, 169, 0, 0, 0, 0,
-	0, 0, 0, 0, 198, 0, 205, 0, 0, 0,
-	0, 219, 263, 226, 218, 395, 0, 0, 0, 191,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 229, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 247, 0, 303, 0, 0, 0,
-	0, 427, 0, 0, 0, 0, 0, 0, 0, 274,
-	0, 271, 171, 187, 0, 0, 313, 352, 358, 0,
-	0, 0, 210, 0, 356, 327, 412, 194, 237, 349,
-	332, 354, 0, 0, 355, 280, 400, 344, 410, 428,
-	429, 217, 307, 418, 391, 424, 439, 188, 214, 321,
-	384, 415, 375, 300, 396, 397, 270, 374, 245, 174,
-	278, 436, 186, 364, 202, 179, 386, 408, 199, 367,
-	0, 0, 441, 181, 406, 383, 297, 267, 268, 180,
-	0, 348, 222, 243, 212, 316, 403, 404, 211, 442,
-	190, 423, 183, 0, 422, 309, 399, 407, 298, 289,
-	182, 405, 296, 288, 273, 233, 254, 342, 283, 343,
-	255, 305, 304, 306, 0, 177, 0, 380, 416, 443,
-	195, 196, 197, 0, 232, 236, 242, 244, 250, 251,
-	258, 276, 320, 341, 339, 345, 0, 394, 411, 419,
-	426, 432, 433, 437, 434, 435, 438, 308, 257, 376,
-	272, 281, 0, 0, 326, 357, 200, 414, 377, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 170,
-	184, 277, 0, 346, 240, 440, 421, 417, 0, 0,
-	216, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 172, 173, 185, 193, 203, 215, 230,
-	238, 248, 253, 256, 260, 261, 264, 269, 286, 291,
-	292, 293, 294, 310, 311, 312, 315, 318, 319, 322,
-	324, 325, 328, 334, 335, 336, 337, 338, 340, 347,
-	351, 359, 360, 361, 362, 363, 365, 366, 370, 371,
-	372, 373, 381, 385, 401, 402, 413, 425, 430, 249,
-	409, 431, 0, 285, 0, 0, 287, 234, 252, 262,
-	0, 420, 382, 189, 353, 241, 178, 206, 192, 213,
-	228, 231, 266, 295, 301, 330, 333, 246, 225, 204,
-	350, 201, 368, 388, 389, 390, 392, 299, 220, 393,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 317, 0, 1223, 0, 0, 0, 0, 0, 0,
-	224, 0, 0, 0, 0, 275, 221, 0, 0, 331,
-	0, 176, 0, 369, 209, 284, 282, 398, 235, 227,
-	223, 208, 259, 290, 329, 387, 323, 0, 279, 0,
-	0, 378, 302, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 265, 207,
-	175, 314, 379, 239, 0, 0, 0, 167, 168, 169,
-	0, 0, 0, 0, 0, 0, 0, 0, 198, 0,
-	205, 0, 0, 0, 0, 219, 263, 226, 218, 395,
-	0, 0, 0, 191, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 229, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 247, 0,
-	303, 0, 0, 0, 0, 427, 0, 0, 0, 0,
-	0, 0, 0, 274, 0, 271, 171, 187, 0, 0,
-	313, 352, 358, 0, 0, 0, 210, 0, 356, 327,
-	412, 194, 237, 349, 332, 354, 0, 0, 355, 280,
-	400, 344, 410, 428, 429, 217, 307, 418, 391, 424,
-	439, 188, 214, 321, 384, 415, 375, 300, 396, 397,
-	270, 374, 245, 174, 278, 436, 186, 364, 202, 179,
-	386, 408, 199, 367, 0, 0, 441, 181, 406, 383,
-	297, 267, 268, 180, 0, 348, 222, 243, 212, 316,
-	403, 404, 211, 442, 190, 423, 183, 0, 422, 309,
-	399, 407, 298, 289, 182, 405, 296, 288, 273, 233,
-	254, 342, 283, 343, 255, 305, 304, 306, 0, 177,
-	0, 380, 416, 443, 195, 196, 197, 0, 232, 236,
-	242, 244, 250, 251, 258, 276, 320, 341, 339, 345,
-	0, 394, 411, 419, 426, 432, 433, 437, 434, 435,
-	438, 308, 257, 376, 272, 281, 0, 0, 326, 357,
-	200, 414, 377, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 170, 184, 277, 0, 346, 240, 440,
-	421, 417, 0, 0, 216, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 172, 173, 185,
-	193, 203, 215, 230, 238, 248, 253, 256, 260, 261,
-	264, 269, 286, 291, 292, 293, 294, 310, 311, 312,
-	315, 318, 319, 322, 324, 325, 328, 334, 335, 336,
-	337, 338, 340, 347, 351, 359, 360, 361, 362, 363,
-	365, 366, 370, 371, 372, 373, 381, 385, 401, 402,
-	413, 425, 430, 249, 409, 431, 0, 285, 0, 0,
-	287, 234, 252, 262, 0, 420, 382, 189, 353, 241,
-	178, 206, 192, 213, 228, 231, 266, 295, 301, 330,
-	333, 246, 225, 204, 350, 201, 368, 388, 389, 390,
-	392, 299, 220, 393, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 317, 0, 1221, 0, 0,
-	0, 0, 0, 0, 224, 0, 0, 0, 0, 275,
-	221, 0, 0, 331, 0, 176, 0, 369, 209, 284,
-	282, 398, 235, 227, 223, 208, 259, 290, 329, 387,
-	323, 0, 279, 0, 0, 378, 302, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 265, 207, 175, 314, 379, 239, 0, 0,
-	0, 167, 168, 169, 0, 0, 0, 0, 0, 0,
-	0, 0, 198, 0, 205, 0, 0, 0, 0, 219,
-	263, 226, 218, 395, 0, 0, 0, 191, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	229, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 247, 0, 303, 0, 0, 0, 0, 427,
-	0, 0, 0, 0, 0, 0, 0, 274, 0, 271,
-	171, 187, 0, 0, 313, 352, 358, 0, 0, 0,
-	210, 0, 356, 327, 412, 194, 237, 349, 332, 354,
-	0, 0, 355, 280, 400, 344, 410, 428, 429, 217,
-	307, 418, 391, 424, 439, 188, 214, 321, 384, 415,
-	375, 300, 396, 397, 270, 374, 245, 174, 278, 436,
-	186, 364, 202, 179, 386, 408, 199, 367, 0, 0,
-	441, 181, 406, 383, 297, 267, 268, 180, 0, 348,
-	222, 243, 212, 316, 403, 404, 211, 442, 190, 423,
-	183, 0, 422, 309, 399, 407, 298, 289, 182, 405,
-	296, 288, 273, 233, 254, 342, 283, 343, 255, 305,
-	304, 306, 0, 177, 0, 380, 416, 443, 195, 196,
-	197, 0, 232, 236, 242, 244, 250, 251, 258, 276,
-	320, 341, 339, 345, 0, 394, 411, 419, 426, 432,
-	433, 437, 434, 435, 438, 308, 257, 376, 272, 281,
-	0, 0, 326, 357, 200, 414, 377, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 170, 184, 277,
-	0, 346, 240, 440, 421, 417, 0, 0, 216, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 172, 173, 185, 193, 203, 215, 230, 238, 248,
-	253, 256, 260, 261, 264, 269, 286, 291, 292, 293,
-	294, 310, 311, 312, 315, 318, 319, 322, 324, 325,
-	328, 334, 335, 336, 337, 338, 340, 347, 351, 359,
-	360, 361, 362, 363, 365, 366, 370, 371, 372, 373,
-	381, 385, 401, 402, 413, 425, 430, 249, 409, 431,
-	0, 285, 0, 0, 287, 234, 252, 262, 0, 420,
-	382, 189, 353, 241, 178, 206, 192, 213, 228, 231,
-	266, 295, 301, 330, 333, 246, 225, 204, 350, 201,
-	368, 388, 389, 390, 392, 299, 220, 393, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 317,
-	0, 1219, 0, 0, 0, 0, 0, 0, 224, 0,
-	0, 0, 0, 275, 221, 0, 0, 331, 0, 176,
-	0, 369, 209, 284, 282, 398, 235, 227, 223, 208,
-	259, 290, 329, 387, 323, 0, 279, 0, 0, 378,
-	302, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 265, 207, 175, 314,
-	379, 239, 0, 0, 0, 167, 168, 169, 0, 0,
-	0, 0, 0, 0, 0, 0, 198, 0, 205, 0,
-	0, 0, 0, 219, 263, 226, 218, 395, 0, 0,
-	0, 191, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 229, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 247, 0, 303, 0,
-	0, 0, 0, 427, 0, 0, 0, 0, 0, 0,
-	0, 274, 0, 271, 171, 187, 0, 0, 313, 352,
-	358, 0, 0, 0, 210, 0, 356, 327, 412, 194,
-	237, 349, 332, 354, 0, 0, 355, 280, 400, 344,
-	410, 428, 429, 217, 307, 418, 391, 424, 439, 188,
-	214, 321, 384, 415, 375, 300, 396, 397, 270, 374,
-	245, 174, 278, 436, 186, 364, 202, 179, 386, 408,
-	199, 367, 0, 0, 441, 181, 406, 383, 297, 267,
-	268, 180, 0, 348, 222, 243, 212, 316, 403, 404,
-	211, 442, 190, 423, 183, 0, 422, 309, 399, 407,
-	298, 289, 182, 405, 296, 288, 273, 233, 254, 342,
-	283, 343, 255, 305, 304, 306, 0, 177, 0, 380,
-	416, 443, 195, 196, 197, 0, 232, 236, 242, 244,
-	250, 251, 258, 276, 320, 341, 339, 345, 0, 394,
-	411, 419, 426, 432, 433, 437, 434, 435, 438, 308,
-	257, 376, 272, 281, 0, 0, 326, 357, 200, 414,
-	377, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 170, 184, 277, 0, 346, 240, 440, 421, 417,
-	0, 0, 216, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 172, 173, 185, 193, 203,
-	215, 230, 238, 248, 253, 256, 260, 261, 264, 269,
-	286, 291, 292, 293, 294, 310, 311, 312, 315, 318,
-	319, 322, 324, 325, 328, 334, 335, 336, 337, 338,
-	340, 347, 351, 359, 360, 361, 362, 363, 365, 366,
-	370, 371, 372, 373, 381, 385, 401, 402, 413, 425,
-	430, 249, 409, 431, 0, 285, 0, 0, 287, 234,
-	252, 262, 0, 420, 382, 189, 353, 241, 178, 206,
-	192, 213, 228, 231, 266, 295, 301, 330, 333, 246,
-	225, 204, 350, 201, 368, 388, 389, 390, 392, 299,
-	220, 393, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 317, 0, 0, 0, 0, 0, 0,
-	0, 0, 224, 0, 0, 0, 0, 275, 221, 0,
-	0, 331, 0, 176, 0, 369, 209, 284, 282, 398,
-	235, 227, 223, 208, 259, 290, 329, 387, 323, 0,
-	279, 0, 0, 378, 302, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	265, 207, 175, 314, 379, 239, 1194, 0, 0, 167,
-	168, 169, 0, 0, 0, 0, 0, 0, 0, 0,
-	198, 0, 205, 0, 0, 0, 0, 219, 263, 226,
-	218, 395, 0, 0, 0, 191, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 229, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	247, 0, 303, 0, 0, 0, 0, 427, 0, 0,
-	0, 0, 0, 0, 0, 274, 0, 271, 171, 187,
-	0, 0, 313, 352, 358, 0, 0, 0, 210, 0,
-	356, 327, 412, 194, 237, 349, 332, 354, 0, 0,
-	355, 280, 400, 344, 410, 428, 429, 217, 307, 418,
-	391, 424, 439, 188, 214, 321, 384, 415, 375, 300,
-	396, 397, 270, 374, 245, 174, 278, 436, 186, 364,
-	202, 179, 386, 408, 199, 367, 0, 0, 441, 181,
-	406, 383, 297, 267, 268, 180, 0, 348, 222, 243,
-	212, 316, 403, 404, 211, 442, 190, 423, 183, 0,
-	422, 309, 399, 407, 298, 289, 182, 405, 296, 288,
-	273, 233, 254, 342, 283, 343, 255, 305, 304, 306,
-	0, 177, 0, 380, 416, 443, 195, 196, 197, 0,
-	232, 236, 242, 244, 250, 251, 258, 276, 320, 341,
-	339, 345, 0, 394, 411, 419, 426, 432, 433, 437,
-	434, 435, 438, 308, 257, 376, 272, 281, 0, 0,
-	326, 357, 200, 414, 377, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 170, 184, 277, 0, 346,
-	240, 440, 421, 417, 0, 0, 216, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 172,
-	173, 185, 193, 203, 215, 230, 238, 248, 253, 256,
-	260, 261, 264, 269, 286, 291, 292, 293, 294, 310,
-	311, 312, 315, 318, 319, 322, 324, 325, 328, 334,
-	335, 336, 337, 338, 340, 347, 351, 359, 360, 361,
-	362, 363, 365, 366, 370, 371, 372, 373, 381, 385,
-	401, 402, 413, 425, 430, 249, 409, 431, 0, 285,
-	0, 0, 287, 234, 252, 262, 0, 420, 382, 189,
-	353, 241, 178, 206, 192, 213, 228, 231, 266, 295,
-	301, 330, 333, 246, 225, 204, 350, 201, 368, 388,
-	389, 390, 392, 299, 220, 393, 0, 0, 0, 0,
-	1095, 0, 0, 0, 0, 0, 0, 317, 0, 0,
-	0, 0, 0, 0, 0, 0, 224, 0, 0, 0,
-	0, 275, 221, 0, 0, 331, 0, 176, 0, 369,
-	209, 284, 282, 398, 235, 227, 223, 208, 259, 290,
-	329, 387, 323, 0, 279, 0, 0, 378, 302, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 265, 207, 175, 314, 379, 239,
-	0, 0, 0, 167, 168, 169, 0, 0, 0, 0,
-	0, 0, 0, 0, 198, 0, 205, 0, 0, 0,
-	0, 219, 263, 226, 218, 395, 0, 0, 0, 191,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 229, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 247, 0, 303, 0, 0, 0,
-	0, 427, 0, 0, 0, 0, 0, 0, 0, 274,
-	0, 271, 171, 187, 0, 0, 313, 352, 358, 0,
-	0, 0, 210, 0, 356, 327, 412, 194, 237, 349,
-	332, 354, 0, 0, 355, 280, 400, 344, 410, 428,
-	429, 217, 307, 418, 391, 424, 439, 188, 214, 321,
-	384, 415, 375, 300, 396, 397, 270, 374, 245, 174,
-	278, 436, 186, 364, 202, 179, 386, 408, 199, 367,
-	0, 0, 441, 181, 406, 383, 297, 267, 268, 180,
-	0, 348, 222, 243, 212, 316, 403, 404, 211, 442,
-	190, 423, 183, 0, 422, 309, 399, 407, 298, 289,
-	182, 405, 296, 288, 273, 233, 254, 342, 283, 343,
-	255, 305, 304, 306, 0, 177, 0, 380, 416, 443,
-	195, 196, 197, 0, 232, 236, 242, 244, 250, 251,
-	258, 276, 320, 341, 339, 345, 0, 394, 411, 419,
-	426, 432, 433, 437, 434, 435, 438, 308, 257, 376,
-	272, 281, 0, 0, 326, 357, 200, 414, 377, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 170,
-	184, 277, 0, 346, 240, 440, 421, 417, 0, 0,
-	216, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 172, 173, 185, 193, 203, 215, 230,
-	238, 248, 253, 256, 260, 261, 264, 269, 286, 291,
-	292, 293, 294, 310, 311, 312, 315, 318, 319, 322,
-	324, 325, 328, 334, 335, 336, 337, 338, 340, 347,
-	351, 359, 360, 361, 362, 363, 365, 366, 370, 371,
-	372, 373, 381, 385, 401, 402, 413, 425, 430, 249,
-	409, 431, 0, 285, 0, 0, 287, 234, 252, 262,
-	0, 420, 382, 189, 353, 241, 178, 206, 192, 213,
-	228, 231, 266, 295, 301, 330, 333, 246, 225, 204,
-	350, 201, 368, 388, 389, 390, 392, 299, 220, 393,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 317, 0, 0, 0, 0, 0, 0, 0, 1086,
-	224, 0, 0, 0, 0, 275, 221, 0, 0, 331,
-	0, 176, 0, 369, 209, 284, 282, 398, 235, 227,
-	223, 208, 259, 290, 329, 387, 323, 0, 279, 0,
-	0, 378, 302, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 265, 207,
-	175, 314, 379, 239, 0, 0, 0, 167, 168, 169,
-	0, 0, 0, 0, 0, 0, 0, 0, 198, 0,
-	205, 0, 0, 0, 0, 219, 263, 226, 218, 395,
-	0, 0, 0, 191, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 229, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 247, 0,
-	303, 0, 0, 0, 0, 427, 0, 0, 0, 0,
-	0, 0, 0, 274, 0, 271, 171, 187, 0, 0,
-	313, 352, 358, 0, 0, 0, 210, 0, 356, 327,
-	412, 194, 237, 349, 332, 354, 0, 0, 355, 280,
-	400, 344, 410, 428, 429, 217, 307, 418, 391, 424,
-	439, 188, 214, 321, 384, 415, 375, 300, 396, 397,
-	270, 374, 245, 174, 278, 436, 186, 364, 202, 179,
-	386, 408, 199, 367, 0, 0, 441, 181, 406, 383,
-	297, 267, 268, 180, 0, 348, 222, 243, 212, 316,
-	403, 404, 211, 442, 190, 423, 183, 0, 422, 309,
-	399, 407, 298, 289, 182, 405, 296, 288, 273, 233,
-	254, 342, 283, 343, 255, 305, 304, 306, 0, 177,
-	0, 380, 416, 443, 195, 196, 197, 0, 232, 236,
-	242, 244, 250, 251, 258, 276, 320, 341, 339, 345,
-	0, 394, 411, 419, 426, 432, 433, 437, 434, 435,
-	438, 308, 257, 376, 272, 281, 0, 0, 326, 357,
-	200, 414, 377, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 170, 184, 277, 0, 346, 240, 440,
-	421, 417, 0, 0, 216, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 172, 173, 185,
-	193, 203, 215, 230, 238, 248, 253, 256, 260, 261,
-	264, 269, 286, 291, 292, 293, 294, 310, 311, 312,
-	315, 318, 319, 322, 324, 325, 328, 334, 335, 336,
-	337, 338, 340, 347, 351, 359, 360, 361, 362, 363,
-	365, 366, 370, 371, 372, 373, 381, 385, 401, 402,
-	413, 425, 430, 249, 409, 431, 0, 285, 0, 0,
-	287, 234, 252, 262, 0, 420, 382, 189, 353, 241,
-	178, 206, 192, 213, 228, 231, 266, 295, 301, 330,
-	333, 246, 225, 204, 350, 201, 368, 388, 389, 390,
-	392, 299, 220, 393, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 317, 0, 0, 0, 0,
-	0, 0, 0, 0, 224, 0, 0, 0, 0, 275,
-	221, 0, 0, 331, 0, 176, 0, 369, 209, 284,
-	282, 398, 235, 227, 223, 208, 259, 290, 329, 387,
-	323, 0, 279, 0, 0, 378, 302, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 265, 207, 175, 314, 379, 239, 0, 0,
-	0, 167, 168, 169, 0, 942, 0, 0, 0, 0,
-	0, 0, 198, 0, 205, 0, 0, 0, 0, 219,
-	263, 226, 218, 395, 0, 0, 0, 191, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	229, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 247, 0, 303, 0, 0, 0, 0, 427,
-	0, 0, 0, 0, 0, 0, 0, 274, 0, 271,
-	171, 187, 0, 0, 313, 352, 358, 0, 0, 0,
-	210, 0, 356, 327, 412, 194, 237, 349, 332, 354,
-	0, 0, 355, 280, 400, 344, 410, 428, 429, 217,
-	307, 418, 391, 424, 439, 188, 214, 321, 384, 415,
-	375, 300, 396, 397, 270, 374, 245, 174, 278, 436,
-	186, 364, 202, 179, 386, 408, 199, 367, 0, 0,
-	441, 181, 406, 383, 297, 267, 268, 180, 0, 348,
-	222, 243, 212, 316, 403, 404, 211, 442, 190, 423,
-	183, 0, 422, 309, 399, 407, 298, 289, 182, 405,
-	296, 288, 273, 233, 254, 342, 283, 343, 255, 305,
-	304, 306, 0, 177, 0, 380, 416, 443, 195, 196,
-	197, 0, 232, 236, 242, 244, 250, 251, 258, 276,
-	320, 341, 339, 345, 0, 394, 411, 419, 426, 432,
-	433, 437, 434, 435, 438, 308, 257, 376, 272, 281,
-	0, 0, 326, 357, 200, 414, 377, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 170, 184, 277,
-	0, 346, 240, 440, 421, 417, 0, 0, 216, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 172, 173, 185, 193, 203, 215, 230, 238, 248,
-	253, 256, 260, 261, 264, 269, 286, 291, 292, 293,
-	294, 310, 311, 312, 315, 318, 319, 322, 324, 325,
-	328, 334, 335, 336, 337, 338, 340, 347, 351, 359,
-	360, 361, 362, 363, 365, 366, 370, 371, 372, 373,
-	381, 385, 401, 402, 413, 425, 430, 249, 409, 431,
-	0, 285, 0, 0, 287, 234, 252, 262, 0, 420,
-	382, 189, 353, 241, 178, 206, 192, 213, 228, 231,
-	266, 295, 301, 330, 333, 246, 225, 204, 350, 201,
-	368, 388, 389, 390, 392, 299, 220, 393, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 317,
-	0, 0, 0, 0, 0, 0, 0, 0, 224, 0,
-	0, 0, 0, 275, 221, 0, 0, 331, 0, 176,
-	0, 369, 209, 284, 282, 398, 235, 227, 223, 208,
-	259, 290, 329, 387, 323, 0, 279, 0, 0, 378,
-	302, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 265, 207, 175, 314,
-	379, 239, 0, 0, 0, 167, 168, 169, 0, 0,
-	0, 0, 0, 0, 0, 0, 198, 0, 205, 0,
-	0, 0, 0, 219, 263, 226, 218, 395, 0, 0,
-	0, 191, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 229, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 498, 0, 247, 0, 303, 0,
-	0, 0, 0, 427, 0, 0, 0, 0, 0, 0,
-	0, 274, 0, 271, 171, 187, 0, 0, 313, 352,
-	358, 0, 0, 0, 210, 0, 356, 327, 412, 194,
-	237, 349, 332, 354, 0, 0, 355, 280, 400, 344,
-	410, 428, 429, 217, 307, 418, 391, 424, 439, 188,
-	214, 321, 384, 415, 375, 300, 396, 397, 270, 374,
-	245, 174, 278, 436, 186, 364, 202, 179, 386, 408,
-	199, 367, 0, 0, 441, 181, 406, 383, 297, 267,
-	268, 180, 0, 348, 222, 243, 212, 316, 403, 404,
-	211, 442, 190, 423, 183, 0, 422, 309, 399, 407,
-	298, 289, 182, 405, 296, 288, 273, 233, 254, 342,
-	283, 343, 255, 305, 304, 306, 0, 177, 0, 380,
-	416, 443, 195, 196, 197, 0, 232, 236, 242, 244,
-	250, 251, 258, 276, 320, 341, 339, 345, 0, 394,
-	411, 419, 426, 432, 433, 437, 434, 435, 438, 308,
-	257, 376, 272, 281, 0, 0, 326, 357, 200, 414,
-	377, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 170, 184, 277, 0, 346, 240, 440, 421, 417,
-	0, 0, 216, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 172, 173, 185, 193, 203,
-	215, 230, 238, 248, 253, 256, 260, 261, 264, 269,
-	286, 291, 292, 293, 294, 310, 311, 312, 315, 318,
-	319, 322, 324, 325, 328, 334, 335, 336, 337, 338,
-	340, 347, 351, 359, 360, 361, 362, 363, 365, 366,
-	370, 371, 372, 373, 381, 385, 401, 402, 413, 425,
-	430, 497, 409, 431, 0, 285, 0, 0, 287, 234,
-	252, 262, 0, 420, 382, 189, 353, 241, 178, 206,
-	192, 213, 228, 231, 266, 295, 301, 330, 333, 246,
-	225, 204, 350, 201, 368, 388, 389, 390, 392, 299,
-	220, 393, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 317, 0, 0, 0, 0, 0, 0,
-	0, 0, 224, 0, 0, 0, 0, 275, 221, 0,
-	0, 331, 0, 176, 0, 369, 209, 284, 282, 398,
-	235, 227, 223, 208, 259, 290, 329, 387, 323, 0,
-	279, 0, 0, 378, 302, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	265, 207, 175, 314, 379, 239, 0, 0, 0, 167,
-	168, 169, 0, 0, 0, 0, 0, 0, 0, 0,
-	198, 0, 205, 0, 0, 0, 0, 219, 263, 226,
-	218, 395, 0, 0, 0, 191, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 229, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	247, 0, 303, 0, 0, 446, 0, 427, 0, 0,
-	0, 0, 0, 0, 0, 274, 0, 271, 171, 187,
-	0, 0, 313, 352, 358, 0, 0, 0, 210, 0,
-	356, 327, 412, 194, 237, 349, 332, 354, 0, 0,
-	355, 280, 400, 344, 410, 428, 429, 217, 307, 418,
-	391, 424, 439, 188, 214, 321, 384, 415, 375, 300,
-	396, 397, 270, 374, 245, 174, 278, 436, 186, 364,
-	202, 179, 386, 408, 199, 367, 0, 0, 441, 181,
-	406, 383, 297, 267, 268, 180, 0, 348, 222, 243,
-	212, 316, 403, 404, 211, 442, 190, 423, 183, 0,
-	422, 309, 399, 407, 298, 289, 182, 405, 296, 288,
-	273, 233, 254, 342, 283, 343, 255, 305, 304, 306,
-	0, 177, 0, 380, 416, 443, 195, 196, 197, 0,
-	232, 236, 242, 244, 250, 251, 258, 276, 320, 341,
-	339, 345, 0, 394, 411, 419, 426, 432, 433, 437,
-	434, 435, 438, 308, 257, 376, 272, 281, 0, 0,
-	326, 357, 200, 414, 377, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 170, 184, 277, 0, 346,
-	240, 440, 421, 417, 0, 0, 216, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 172,
-	173, 185, 193, 203, 215, 230, 238, 248, 253, 256,
-	260, 261, 264, 269, 286, 291, 292, 293, 294, 310,
-	311, 312, 315, 318, 319, 322, 324, 325, 328, 334,
-	335, 336, 337, 338, 340, 347, 351, 359, 360, 361,
-	362, 363, 365, 366, 370, 371, 372, 373, 381, 385,
-	401, 402, 413, 425, 430, 249, 409, 431, 0, 285,
-	0, 0, 287, 234, 252, 262, 0, 420, 382, 189,
-	353, 241, 178, 206, 192, 213, 228, 231, 266, 295,
-	301, 330, 333, 246, 225, 204, 350, 201, 368, 388,
-	389, 390, 392, 299, 220, 393, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 317, 0, 0,
-	0, 0, 0, 0, 0, 0, 224, 0, 0, 0,
-	0, 275, 221, 0, 0, 331, 0, 176, 0, 369,
-	209, 284, 282, 398, 235, 227, 223, 208, 259, 290,
-	329, 387, 323, 0, 279, 0, 0, 378, 302, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 265, 207, 175, 314, 379, 239,
-	0, 0, 0, 167, 168, 169, 0, 0, 0, 0,
-	0, 0, 0, 0, 198, 0, 205, 0, 0, 0,
-	0, 219, 263, 226, 218, 395, 0, 0, 0, 191,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 229, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 247, 0, 303, 0, 0, 0,
-	0, 427, 0, 0, 0, 0, 0, 0, 0, 274,
-	0, 271, 171, 187, 0, 0, 313, 352, 358, 0,
-	0, 0, 210, 0, 356, 327, 412, 194, 237, 349,
-	332, 354, 0, 0, 355, 280, 400, 344, 410, 428,
-	429, 217, 307, 418, 391, 424, 439, 188, 214, 321,
-	384, 415, 375, 300, 396, 397, 270, 374, 245, 174,
-	278, 436, 186, 364, 202, 179, 386, 408, 199, 367,
-	0, 0, 441, 181, 406, 383, 297, 267, 268, 180,
-	0, 348, 222, 243, 212, 316, 403, 404, 211, 442,
-	190, 423, 183, 0, 422, 309, 399, 407, 298, 289,
-	182, 405, 296, 288, 273, 233, 254, 342, 283, 343,
-	255, 305, 304, 306, 0, 177, 0, 380, 416, 443,
-	195, 196, 197, 0, 232, 236, 242, 244, 250, 251,
-	258, 276, 320, 341, 339, 345, 0, 394, 411, 419,
-	426, 432, 433, 437, 434, 435, 438, 308, 257, 376,
-	272, 281, 0, 0, 326, 357, 200, 414, 377, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 170,
-	184, 277, 0, 346, 240, 440, 421, 417, 0, 0,
-	216, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 172, 173, 185, 193, 203, 215, 230,
-	238, 248, 253, 256, 260, 261, 264, 269, 286, 291,
-	292, 293, 294, 310, 311, 312, 315, 318, 319, 322,
-	324, 325, 328, 334, 335, 336, 337, 338, 340, 347,
-	351, 359, 360, 361, 362, 363, 365, 366, 370, 371,
-	372, 373, 381, 385, 401, 402, 413, 425, 430, 249,
-	409, 431, 0, 285, 0, 0, 287, 234, 252, 262,
-	0, 420, 382, 189, 353, 241, 178, 206, 192, 213,
-	228, 231, 266, 295, 301, 330, 333, 246, 225, 204,
-	350, 201, 368, 388, 389, 390, 392, 299, 220,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	175, 176, 188, 196, 206, 219, 234, 242, 257, 260,
+	264, 265, 268, 273, 290, 295, 296, 297, 298, 314,
+	315, 316, 319, 322, 323, 326, 328, 329, 332, 338,
+	339, 340, 343, 345, 352, 357, 365, 366, 367, 368,
+	369, 371, 372, 378, 379, 380, 381, 389, 393, 409,
+	410, 433, 439, 0, 0, 375, 353, 374, 212, 253,
+	417, 440, 0, 289, 0, 0, 291, 238, 256, 266,
+	0, 428, 390, 192, 359, 245, 181, 209, 195, 217,
+	232, 235, 270, 299, 305, 334, 337, 250, 229, 207,
+	356, 204, 376, 396, 397, 398, 400, 303, 224, 35,
+	401, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 321, 0, 0, 0, 0, 0, 0, 0,
+	0, 228, 0, 0, 0, 0, 279, 225, 0, 0,
+	335, 0, 179, 0, 377, 213, 288, 286, 406, 239,
+	231, 227, 211, 263, 294, 333, 395, 327, 0, 283,
+	0, 0, 386, 306, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 269,
+	210, 178, 318, 387, 243, 72, 0, 604, 170, 171,
+	172, 0, 0, 0, 0, 0, 0, 0, 0, 201,
+	0, 208, 0, 0, 0, 0, 223, 267, 230, 222,
+	403, 0, 0, 0, 194, 421, 342, 252, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	233, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 251, 0, 307, 0, 0, 0, 0, 436,
+	0, 0, 0, 0, 0, 0, 0, 278, 0, 275,
+	174, 190, 0, 0, 317, 358, 364, 0, 0, 0,
+	214, 0, 362, 331, 420, 197, 241, 355, 336, 360,
+	0, 0, 361, 284, 408, 349, 418, 437, 438, 221,
+	311, 426, 399, 432, 449, 191, 218, 325, 392, 423,
+	383, 304, 404, 405, 274, 382, 249, 177, 282, 446,
+	189, 370, 205, 182, 394, 416, 202, 373, 0, 0,
+	451, 184, 414, 391, 301, 271, 272, 183, 0, 354,
+	226, 247, 216, 320, 411, 412, 215, 452, 193, 431,
+	186, 0, 430, 313, 407, 415, 302, 293, 185, 413,
+	300, 292, 277, 237, 258, 347, 287, 348, 259, 309,
+	308, 310, 0, 180, 0, 388, 424, 453, 198, 199,
+	200, 0, 236, 240, 246, 248, 254, 255, 262, 280,
+	324, 341, 346, 344, 350, 0, 402, 419, 427, 434,
+	435, 441, 442, 443, 447, 444, 445, 448, 312, 261,
+	384, 276, 285, 0, 0, 330, 363, 203, 422, 385,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	173, 187, 281, 0, 351, 244, 450, 429, 425, 0,
+	0, 220, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 175, 176, 188, 196, 206, 219, 234, 242, 257,
+	260, 264, 265, 268, 273, 290, 295, 296, 297, 298,
+	314, 315, 316, 319, 322, 323, 326, 328, 329, 332,
+	338, 339, 340, 343, 345, 352, 357, 365, 366, 367,
+	368, 369, 371, 372, 378, 379, 380, 381, 389, 393,
+	409, 410, 433, 439, 0, 0, 375, 353, 374, 212,
+	253, 417, 440, 0, 289, 0, 0, 291, 238, 256,
+	266, 0, 428, 390, 192, 359, 245, 181, 209, 195,
+	217, 232, 235, 270, 299, 305, 334, 337, 250, 229,
+	207, 356, 204, 376, 396, 397, 398, 400, 303, 224,
+	401, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 321, 0, 0, 0, 1476, 0, 0, 0,
+	0, 228, 0, 0, 0, 0, 279, 225, 0, 0,
+	335, 0, 179, 0, 377, 213, 288, 286, 406, 239,
+	231, 227, 211, 263, 294, 333, 395, 327, 0, 283,
+	0, 0, 386, 306, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 269,
+	210, 178, 318, 387, 243, 0, 0, 0, 170, 171,
+	172, 0, 1287, 0, 0, 0, 0, 0, 0, 201,
+	0, 208, 0, 0, 0, 0, 223, 267, 230, 222,
+	403, 0, 0, 0, 194, 421, 342, 252, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	233, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 251, 0, 307, 0, 0, 0, 0, 436,
+	0, 0, 0, 0, 0, 0, 0, 278, 0, 275,
+	174, 190, 0, 0, 317, 358, 364, 0, 0, 0,
+	214, 0, 362, 331, 420, 197, 241, 355, 336, 360,
+	0, 1474, 361, 284, 408, 349, 418, 437, 438, 221,
+	311, 426, 399, 432, 449, 191, 218, 325, 392, 423,
+	383, 304, 404, 405, 274, 382, 249, 177, 282, 446,
+	189, 370, 205, 182, 394, 416, 202, 373, 0, 0,
+	451, 184, 414, 391, 301, 271, 272, 183, 0, 354,
+	226, 247, 216, 320, 411, 412, 215, 452, 193, 431,
+	186, 0, 430, 313, 407, 415, 302, 293, 185, 413,
+	300, 292, 277, 237, 258, 347, 287, 348, 259, 309,
+	308, 310, 0, 180, 0, 388, 424, 453, 198, 199,
+	200, 0, 236, 240, 246, 248, 254, 255, 262, 280,
+	324, 341, 346, 344, 350, 0, 402, 419, 427, 434,
+	435, 441, 442, 443, 447, 444, 445, 448, 312, 261,
+	384, 276, 285, 0, 0, 330, 363, 203, 422, 385,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	173, 187, 281, 0, 351, 244, 450, 429, 425, 0,
+	0, 220, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 175, 176, 188, 196, 206, 219, 234, 242, 257,
+	260, 264, 265, 268, 273, 290, 295, 296, 297, 298,
+	314, 315, 316, 319, 322, 323, 326, 328, 329, 332,
+	338, 339, 340, 343, 345, 352, 357, 365, 366, 367,
+	368, 369, 371, 372, 378, 379, 380, 381, 389, 393,
+	409, 410, 433, 439, 0, 0, 375, 353, 374, 212,
+	253, 417, 440, 0, 289, 0, 0, 291, 238, 256,
+	266, 0, 428, 390, 192, 359, 245, 181, 209, 195,
+	217, 232, 235, 270, 299, 305, 334, 337, 250, 229,
+	207, 356, 204, 376, 396, 397, 398, 400, 303, 224,
+	401, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 321, 0, 0, 0, 0, 0, 0, 0,
+	0, 228, 0, 0, 0, 0, 279, 225, 0, 0,
+	335, 0, 179, 0, 377, 213, 288, 286, 406, 239,
+	231, 227, 211, 263, 294, 333, 395, 327, 0, 283,
+	0, 0, 386, 306, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 269,
+	210, 178, 318, 387, 243, 0, 0, 0, 170, 171,
+	172, 0, 0, 0, 0, 0, 0, 0, 0, 201,
+	0, 208, 0, 0, 0, 0, 223, 267, 230, 222,
+	403, 0, 0, 0, 194, 421, 342, 252, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	233, 0, 0, 0, 0, 0, 0, 0, 0, 780,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 251, 0, 307, 0, 0, 0, 0, 436,
+	0, 0, 0, 0, 0, 0, 0, 278, 786, 275,
+	174, 190, 784, 0, 317, 358, 364, 0, 0, 0,
+	214, 0, 362, 331, 420, 197, 241, 355, 336, 360,
+	0, 0, 361, 284, 408, 349, 418, 437, 438, 221,
+	311, 426, 399, 432, 449, 191, 218, 325, 392, 423,
+	383, 304, 404, 405, 274, 382, 249, 177, 282, 446,
+	189, 370, 205, 182, 394, 416, 202, 373, 0, 0,
+	451, 184, 414, 391, 301, 271, 272, 183, 0, 354,
+	226, 247, 216, 320, 411, 412, 215, 452, 193, 431,
+	186, 0, 430, 313, 407, 415, 302, 293, 185, 413,
+	300, 292, 277, 237, 258, 347, 287, 348, 259, 309,
+	308, 310, 0, 180, 0, 388, 424, 453, 198, 199,
+	200, 0, 236, 240, 246, 248, 254, 255, 262, 280,
+	324, 341, 346, 344, 350, 0, 402, 419, 427, 434,
+	435, 441, 442, 443, 447, 444, 445, 448, 312, 261,
+	384, 276, 285, 0, 0, 330, 363, 203, 422, 385,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	173, 187, 281, 0, 351, 244, 450, 429, 425, 0,
+	0, 220, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 175, 176, 188, 196, 206, 219, 234, 242, 257,
+	260, 264, 265, 268, 273, 290, 295, 296, 297, 298,
+	314, 315, 316, 319, 322, 323, 326, 328, 329, 332,
+	338, 339, 340, 343, 345, 352, 357, 365, 366, 367,
+	368, 369, 371, 372, 378, 379, 380, 381, 389, 393,
+	409, 410, 433, 439, 0, 0, 375, 353, 374, 212,
+	253, 417, 440, 0, 289, 0, 0, 291, 238, 256,
+	266, 0, 428, 390, 192, 359, 245, 181, 209, 195,
+	217, 232, 235, 270, 299, 305, 334, 337, 250, 229,
+	207, 356, 204, 376, 396, 397, 398, 400, 303, 224,
+	401, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 321, 0, 0, 0, 1476, 0, 0, 0,
+	0, 228, 0, 0, 0, 0, 279, 225, 0, 0,
+	335, 0, 179, 0, 377, 213, 288, 286, 406, 239,
+	231, 227, 211, 263, 294, 333, 395, 327, 0, 283,
+	0, 0, 386, 306, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 269,
+	210, 178, 318, 387, 243, 0, 0, 0, 170, 171,
+	172, 0, 1287, 0, 0, 0, 0, 0, 0, 201,
+	0, 208, 0, 0, 0, 0, 223, 267, 230, 222,
+	403, 0, 0, 0, 194, 421, 342, 252, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	233, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 251, 0, 307, 0, 0, 0, 0, 436,
+	0, 0, 0, 0, 0, 0, 0, 278, 0, 275,
+	174, 190, 0, 0, 317, 358, 364, 0, 0, 0,
+	214, 0, 362, 331, 420, 197, 241, 355, 336, 360,
+	0, 0, 361, 284, 408, 349, 418, 437, 438, 221,
+	311, 426, 399, 432, 449, 191, 218, 325, 392, 423,
+	383, 304, 404, 405, 274, 382, 249, 177, 282, 446,
+	189, 370, 205, 182, 394, 416, 202, 373, 0, 0,
+	451, 184, 414, 391, 301, 271, 272, 183, 0, 354,
+	226, 247, 216, 320, 411, 412, 215, 452, 193, 431,
+	186, 0, 430, 313, 407, 415, 302, 293, 185, 413,
+	300, 292, 277, 237, 258, 347, 287, 348, 259, 309,
+	308, 310, 0, 180, 0, 388, 424, 453, 198, 199,
+	200, 0, 236, 240, 246, 248, 254, 255, 262, 280,
+	324, 341, 346, 344, 350, 0, 402, 419, 427, 434,
+	435, 441, 442, 443, 447, 444, 445, 448, 312, 261,
+	384, 276, 285, 0, 0, 330, 363, 203, 422, 385,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	173, 187, 281, 0, 351, 244, 450, 429, 425, 0,
+	0, 220, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 175, 176, 188, 196, 206, 219, 234, 242, 257,
+	260, 264, 265, 268, 273, 290, 295, 296, 297, 298,
+	314, 315, 316, 319, 322, 323, 326, 328, 329, 332,
+	338, 339, 340, 343, 345, 352, 357, 365, 366, 367,
+	368, 369, 371, 372, 378, 379, 380, 381, 389, 393,
+	409, 410, 433, 439, 0, 0, 375, 353, 374, 212,
+	253, 417, 440, 0, 289, 0, 0, 291, 238, 256,
+	266, 0, 428, 390, 192, 359, 245, 181, 209, 195,
+	217, 232, 235, 270, 299, 305, 334, 337, 250, 229,
+	207, 356, 204, 376, 396, 397, 398, 400, 303, 224,
+	401, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 321, 0, 0, 0, 0, 0, 0, 0,
+	0, 228, 0, 0, 0, 0, 279, 225, 0, 0,
+	335, 0, 179, 0, 377, 213, 288, 286, 406, 239,
+	231, 227, 211, 263, 294, 333, 395, 327, 0, 283,
+	0, 0, 386, 306, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 269,
+	210, 178, 318, 387, 243, 0, 0, 604, 170, 171,
+	172, 0, 0, 0, 0, 0, 0, 0, 0, 201,
+	0, 208, 0, 0, 0, 0, 223, 267, 230, 222,
+	403, 0, 0, 0, 194, 421, 342, 252, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	233, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 251, 0, 307, 0, 0, 0, 0, 436,
+	0, 0, 0, 2165, 0, 0, 0, 278, 0, 275,
+	174, 190, 0, 0, 317, 358, 364, 0, 0, 0,
+	214, 0, 362, 331, 420, 197, 241, 355, 336, 360,
+	0, 0, 361, 284, 408, 349, 418, 437, 438, 221,
+	311, 426, 399, 432, 449, 191, 218, 325, 392, 423,
+	383, 304, 404, 405, 274, 382, 249, 177, 282, 446,
+	189, 370, 205, 182, 394, 416, 202, 373, 0, 0,
+	451, 184, 414, 391, 301, 271, 272, 183, 0, 354,
+	226, 247, 216, 320, 411, 412, 215, 452, 193, 431,
+	186, 0, 430, 313, 407, 415, 302, 293, 185, 413,
+	300, 292, 277, 237, 258, 347, 287, 348, 259, 309,
+	308, 310, 0, 180, 0, 388, 424, 453, 198, 199,
+	200, 0, 236, 240, 246, 248, 254, 255, 262, 280,
+	324, 341, 346, 344, 350, 0, 402, 419, 427, 434,
+	435, 441, 442, 443, 447, 444, 445, 448, 312, 261,
+	384, 276, 285, 0, 0, 330, 363, 203, 422, 385,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	173, 187, 281, 0, 351, 244, 450, 429, 425, 0,
+	0, 220, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 175, 176, 188, 196, 206, 219, 234, 242, 257,
+	260, 264, 265, 268, 273, 290, 295, 296, 297, 298,
+	314, 315, 316, 319, 322, 323, 326, 328, 329, 332,
+	338, 339, 340, 343, 345, 352, 357, 365, 366, 367,
+	368, 369, 371, 372, 378, 379, 380, 381, 389, 393,
+	409, 410, 433, 439, 0, 0, 375, 353, 374, 212,
+	253, 417, 440, 0, 289, 0, 0, 291, 238, 256,
+	266, 0, 428, 390, 192, 359, 245, 181, 209, 195,
+	217, 232, 235, 270, 299, 305, 334, 337, 250, 229,
+	207, 356, 204, 376, 396, 397, 398, 400, 303, 224,
+	35, 401, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 321, 0, 0, 0, 0, 0, 0,
+	0, 0, 228, 0, 0, 0, 0, 279, 225, 0,
+	0, 335, 0, 179, 0, 377, 213, 288, 286, 406,
+	239, 231, 227, 211, 263, 294, 333, 395, 327, 0,
+	283, 0, 0, 386, 306, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	269, 210, 178, 318, 387, 243, 72, 0, 0, 170,
+	171, 172, 0, 0, 0, 0, 0, 0, 0, 0,
+	201, 0, 208, 0, 0, 0, 0, 223, 267, 230,
+	222, 403, 0, 0, 0, 194, 421, 342, 252, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 233, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 251, 0, 307, 0, 0, 0, 0,
+	436, 0, 0, 0, 0, 0, 0, 0, 278, 0,
+	275, 174, 190, 0, 0, 317, 358, 364, 0, 0,
+	0, 214, 0, 362, 331, 420, 197, 241, 355, 336,
+	360, 0, 0, 361, 284, 408, 349, 418, 437, 438,
+	221, 311, 426, 399, 432, 449, 191, 218, 325, 392,
+	423, 383, 304, 404, 405, 274, 382, 249, 177, 282,
+	446, 189, 370, 205, 182, 394, 416, 202, 373, 0,
+	0, 451, 184, 414, 391, 301, 271, 272, 183, 0,
+	354, 226, 247, 216, 320, 411, 412, 215, 452, 193,
+	431, 186, 0, 430, 313, 407, 415, 302, 293, 185,
+	413, 300, 292, 277, 237, 258, 347, 287, 348, 259,
+	309, 308, 310, 0, 180, 0, 388, 424, 453, 198,
+	199, 200, 0, 236, 240, 246, 248, 254, 255, 262,
+	280, 324, 341, 346, 344, 350, 0, 402, 419, 427,
+	434, 435, 441, 442, 443, 447, 444, 445, 448, 312,
+	261, 384, 276, 285, 0, 0, 330, 363, 203, 422,
+	385, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 173, 187, 281, 0, 351, 244, 450, 429, 425,
+	0, 0, 220, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 175, 176, 188, 196, 206, 219, 234, 242,
+	257, 260, 264, 265, 268, 273, 290, 295, 296, 297,
+	298, 314, 315, 316, 319, 322, 323, 326, 328, 329,
+	332, 338, 339, 340, 343, 345, 352, 357, 365, 366,
+	367, 368, 369, 371, 372, 378, 379, 380, 381, 389,
+	393, 409, 410, 433, 439, 0, 0, 375, 353, 374,
+	212, 253, 417, 440, 0, 289, 0, 0, 291, 238,
+	256, 266, 0, 428, 390, 192, 359, 245, 181, 209,
+	195, 217, 232, 235, 270, 299, 305, 334, 337, 250,
+	229, 207, 356, 204, 376, 396, 397, 398, 400, 303,
+	224, 401, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 321, 0, 0, 0, 0, 0, 0,
+	0, 0, 228, 0, 0, 0, 0, 279, 225, 0,
+	0, 335, 0, 179, 0, 377, 213, 288, 286, 406,
+	239, 231, 227, 211, 263, 294, 333, 395, 327, 0,
+	283, 0, 0, 386, 306, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	269, 210, 178, 318, 387, 243, 0, 0, 0, 170,
+	171, 172, 0, 0, 1495, 0, 0, 1496, 0, 0,
+	201, 0, 208, 0, 0, 0, 0, 223, 267, 230,
+	222, 403, 0, 0, 0, 194, 421, 342, 252, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 233, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 251, 0, 307, 0, 0, 0, 0,
+	436, 0, 0, 0, 0, 0, 0, 0, 278, 0,
+	275, 174, 190, 0, 0, 317, 358, 364, 0, 0,
+	0, 214, 0, 362, 331, 420, 197, 241, 355, 336,
+	360, 0, 0, 361, 284, 408, 349, 418, 437, 438,
+	221, 311, 426, 399, 432, 449, 191, 218, 325, 392,
+	423, 383, 304, 404, 405, 274, 382, 249, 177, 282,
+	446, 189, 370, 205, 182, 394, 416, 202, 373, 0,
+	0, 451, 184, 414, 391, 301, 271, 272, 183, 0,
+	354, 226, 247, 216, 320, 411, 412, 215, 452, 193,
+	431, 186, 0, 430, 313, 407, 415, 302, 293, 185,
+	413, 300, 292, 277, 237, 258, 347, 287, 348, 259,
+	309, 308, 310, 0, 180, 0, 388, 424, 453, 198,
+	199, 200, 0, 236, 240, 246, 248, 254, 255, 262,
+	280, 324, 341, 346, 344, 350, 0, 402, 419, 427,
+	434, 435, 441, 442, 443, 447, 444, 445, 448, 312,
+	261, 384, 276, 285, 0, 0, 330, 363, 203, 422,
+	385, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 173, 187, 281, 0, 351, 244, 450, 429, 425,
+	0, 0, 220, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 175, 176, 188, 196, 206, 219, 234, 242,
+	257, 260, 264, 265, 268, 273, 290, 295, 296, 297,
+	298, 314, 315, 316, 319, 322, 323, 326, 328, 329,
+	332, 338, 339, 340, 343, 345, 352, 357, 365, 366,
+	367, 368, 369, 371, 372, 378, 379, 380, 381, 389,
+	393, 409, 410, 433, 439, 0, 0, 375, 353, 374,
+	212, 253, 417, 440, 0, 289, 0, 0, 291, 238,
+	256, 266, 0, 428, 390, 192, 359, 245, 181, 209,
+	195, 217, 232, 235, 270, 299, 305, 334, 337, 250,
+	229, 207, 356, 204, 376, 396, 397, 398, 400, 303,
+	224, 401, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 321, 0, 0, 0, 0, 0, 0,
+	0, 0, 228, 1137, 0, 0, 0, 279, 225, 0,
+	0, 335, 0, 179, 0, 377, 213, 288, 286, 406,
+	239, 231, 227, 211, 263, 294, 333, 395, 327, 0,
+	283, 0, 0, 386, 306, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	269, 210, 178, 318, 387, 243, 0, 0, 0, 170,
+	171, 172, 0, 1136, 0, 0, 0, 0, 0, 0,
+	201, 0, 208, 0, 0, 0, 0, 223, 267, 230,
+	222, 403, 0, 0, 0, 194, 421, 342, 252, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 233, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 251, 0, 307, 0, 0, 0, 0,
+	436, 0, 0, 0, 0, 0, 0, 0, 278, 0,
+	275, 174, 190, 0, 0, 317, 358, 364, 0, 0,
+	0, 214, 0, 362, 331, 420, 197, 241, 355, 336,
+	360, 0, 0, 361, 284, 408, 349, 418, 437, 438,
+	221, 311, 426, 399, 432, 449, 191, 218, 325, 392,
+	423, 383, 304, 404, 405, 274, 382, 249, 177, 282,
+	446, 189, 370, 205, 182, 394, 416, 202, 373, 0,
+	0, 451, 184, 414, 391, 301, 271, 272, 183, 0,
+	354, 226, 247, 216, 320, 411, 412, 215, 452, 193,
+	431, 186, 0, 430, 313, 407, 415, 302, 293, 185,
+	413, 300, 292, 277, 237, 258, 347, 287, 348, 259,
+	309, 308, 310, 0, 180, 0, 388, 424, 453, 198,
+	199, 200, 0, 236, 240, 246, 248, 254, 255, 262,
+	280, 324, 341, 346, 344, 350, 0, 402, 419, 427,
+	434, 435, 441, 442, 443, 447, 444, 445, 448, 312,
+	261, 384, 276, 285, 0, 0, 330, 363, 203, 422,
+	385, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 173, 187, 281, 0, 351, 244, 450, 429, 425,
+	0, 0, 220, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 175, 176, 188, 196, 206, 219, 234, 242,
+	257, 260, 264, 265, 268, 273, 290, 295, 296, 297,
+	298, 314, 315, 316, 319, 322, 323, 326, 328, 329,
+	332, 338, 339, 340, 343, 345, 352, 357, 365, 366,
+	367, 368, 369, 371, 372, 378, 379, 380, 381, 389,
+	393, 409, 410, 433, 439, 0, 0, 375, 353, 374,
+	212, 253, 417, 440, 0, 289, 0, 0, 291, 238,
+	256, 266, 0, 428, 390, 192, 359, 245, 181, 209,
+	195, 217, 232, 235, 270, 299, 305, 334, 337, 250,
+	229, 207, 356, 204, 376, 396, 397, 398, 400, 303,
+	224, 401, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 321, 0, 0, 0, 0, 0, 0,
+	0, 0, 228, 0, 0, 0, 0, 279, 225, 0,
+	0, 335, 0, 179, 0, 377, 213, 288, 286, 406,
+	239, 231, 227, 211, 263, 294, 333, 395, 327, 0,
+	283, 0, 0, 386, 306, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	269, 210, 178, 318, 387, 243, 0, 0, 0, 170,
+	171, 172, 0, 0, 0, 0, 0, 0, 0, 0,
+	201, 0, 208, 0, 0, 0, 0, 223, 267, 230,
+	222, 403, 0, 0, 0, 194, 421, 342, 252, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 233, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 251, 0, 307, 0, 0, 0, 0,
+	436, 0, 0, 0, 2258, 0, 0, 0, 278, 0,
+	275, 174, 190, 0, 0, 317, 358, 364, 0, 0,
+	0, 214, 0, 362, 331, 420, 197, 241, 355, 336,
+	360, 0, 0, 361, 284, 408, 349, 418, 437, 438,
+	221, 311, 426, 399, 432, 449, 191, 218, 325, 392,
+	423, 383, 304, 404, 405, 274, 382, 249, 177, 282,
+	446, 189, 370, 205, 182, 394, 416, 202, 373, 0,
+	0, 451, 184, 414, 391, 301, 271, 272, 183, 0,
+	354, 226, 247, 216, 320, 411, 412, 215, 452, 193,
+	431, 186, 0, 430, 313, 407, 415, 302, 293, 185,
+	413, 300, 292, 277, 237, 258, 347, 287, 348, 259,
+	309, 308, 310, 0, 180, 0, 388, 424, 453, 198,
+	199, 200, 0, 236, 240, 246, 248, 254, 255, 262,
+	280, 324, 341, 346, 344, 350, 0, 402, 419, 427,
+	434, 435, 441, 442, 443, 447, 444, 445, 448, 312,
+	261, 384, 276, 285, 0, 0, 330, 363, 203, 422,
+	385, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 173, 187, 281, 0, 351, 244, 450, 429, 425,
+	0, 0, 220, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 175, 176, 188, 196, 206, 219, 234, 242,
+	257, 260, 264, 265, 268, 273, 290, 295, 296, 297,
+	298, 314, 315, 316, 319, 322, 323, 326, 328, 329,
+	332, 338, 339, 340, 343, 345, 352, 357, 365, 366,
+	367, 368, 369, 371, 372, 378, 379, 380, 381, 389,
+	393, 409, 410, 433, 439, 0, 0, 375, 353, 374,
+	212, 253, 417, 440, 0, 289, 0, 0, 291, 238,
+	256, 266, 0, 428, 390, 192, 359, 245, 181, 209,
+	195, 217, 232, 235, 270, 299, 305, 334, 337, 250,
+	229, 207, 356, 204, 376, 396, 397, 398, 400, 303,
+	224, 401, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 321, 0, 0, 0, 0, 0, 0,
+	0, 0, 228, 0, 0, 0, 0, 279, 225, 0,
+	0, 335, 0, 179, 0, 377, 213, 288, 286, 406,
+	239, 231, 227, 211, 263, 294, 333, 395, 327, 0,
+	283, 0, 0, 386, 306, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	269, 210, 178, 318, 387, 243, 0, 0, 0, 170,
+	171, 172, 0, 0, 0, 0, 0, 0, 0, 0,
+	201, 0, 208, 0, 0, 0, 0, 223, 267, 230,
+	222, 403, 0, 0, 0, 194, 421, 342, 252, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 233, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 251, 0, 307, 0, 0, 0, 0,
+	436, 0, 0, 0, 2165, 0, 0, 0, 278, 0,
+	275, 174, 190, 0, 0, 317, 358, 364, 0, 0,
+	0, 214, 0, 362, 331, 420, 197, 241, 355, 336,
+	360, 0, 0, 361, 284, 408, 349, 418, 437, 438,
+	221, 311, 426, 399, 432, 449, 191, 218, 325, 392,
+	423, 383, 304, 404, 405, 274, 382, 249, 177, 282,
+	446, 189, 370, 205, 182, 394, 416, 202, 373, 0,
+	0, 451, 184, 414, 391, 301, 271, 272, 183, 0,
+	354, 226, 247, 216, 320, 411, 412, 215, 452, 193,
+	431, 186, 0, 430, 313, 407, 415, 302, 293, 185,
+	413, 300, 292, 277, 237, 258, 347, 287, 348, 259,
+	309, 308, 310, 0, 180, 0, 388, 424, 453, 198,
+	199, 200, 0, 236, 240, 246, 248, 254, 255, 262,
+	280, 324, 341, 346, 344, 350, 0, 402, 419, 427,
+	434, 435, 441, 442, 443, 447, 444, 445, 448, 312,
+	261, 384, 276, 285, 0, 0, 330, 363, 203, 422,
+	385, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 173, 187, 281, 0, 351, 244, 450, 429, 425,
+	0, 0, 220, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 175, 176, 188, 196, 206, 219, 234, 242,
+	257, 260, 264, 265, 268, 273, 290, 295, 296, 297,
+	298, 314, 315, 316, 319, 322, 323, 326, 328, 329,
+	332, 338, 339, 340, 343, 345, 352, 357, 365, 366,
+	367, 368, 369, 371, 372, 378, 379, 380, 381, 389,
+	393, 409, 410, 433, 439, 0, 0, 375, 353, 374,
+	212, 253, 417, 440, 0, 289, 0, 0, 291, 238,
+	256, 266, 0, 428, 390, 192, 359, 245, 181, 209,
+	195, 217, 232, 235, 270, 299, 305, 334, 337, 250,
+	229, 207, 356, 204, 376, 396, 397, 398, 400, 303,
+	224, 401, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 321, 0, 0, 0, 0, 0, 0,
+	0, 0, 228, 0, 0, 0, 0, 279, 225, 0,
+	0, 335, 0, 179, 0, 377, 213, 288, 286, 406,
+	239, 231, 227, 211, 263, 294, 333, 395, 327, 0,
+	283, 0, 0, 386, 306, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	269, 210, 178, 318, 387, 243, 72, 0, 0, 170,
+	171, 172, 0, 0, 0, 0, 0, 0, 0, 0,
+	201, 0, 208, 0, 0, 0, 0, 223, 267, 230,
+	222, 403, 0, 0, 0, 194, 421, 342, 252, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 233, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 251, 0, 307, 0, 0, 0, 0,
+	436, 0, 0, 0, 0, 0, 0, 0, 278, 0,
+	275, 174, 190, 0, 0, 317, 358, 364, 0, 0,
+	0, 214, 0, 362, 331, 420, 197, 241, 355, 336,
+	360, 0, 0, 361, 284, 408, 349, 418, 437, 438,
+	221, 311, 426, 399, 432, 449, 191, 218, 325, 392,
+	423, 383, 304, 404, 405, 274, 382, 249, 177, 282,
+	446, 189, 370, 205, 182, 394, 416, 202, 373, 0,
+	0, 451, 184, 414, 391, 301, 271, 272, 183, 0,
+	354, 226, 247, 216, 320, 411, 412, 215, 452, 193,
+	431, 186, 0, 430, 313, 407, 415, 302, 293, 185,
+	413, 300, 292, 277, 237, 258, 347, 287, 348, 259,
+	309, 308, 310, 0, 180, 0, 388, 424, 453, 198,
+	199, 200, 0, 236, 240, 246, 248, 254, 255, 262,
+	280, 324, 341, 346, 344, 350, 0, 402, 419, 427,
+	434, 435, 441, 442, 443, 447, 444, 445, 448, 312,
+	261, 384, 276, 285, 0, 0, 330, 363, 203, 422,
+	385, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 173, 187, 281, 0, 351, 244, 450, 429, 425,
+	0, 0, 220, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 175, 176, 188, 196, 206, 219, 234, 242,
+	257, 260, 264, 265, 268, 273, 290, 295, 296, 297,
+	298, 314, 315, 316, 319, 322, 323, 326, 328, 329,
+	332, 338, 339, 340, 343, 345, 352, 357, 365, 366,
+	367, 368, 369, 371, 372, 378, 379, 380, 381, 389,
+	393, 409, 410, 433, 439, 0, 0, 375, 353, 374,
+	212, 253, 417, 440, 0, 289, 0, 0, 291, 238,
+	256, 266, 0, 428, 390, 192, 359, 245, 181, 209,
+	195, 217, 232, 235, 270, 299, 305, 334, 337, 250,
+	229, 207, 356, 204, 376, 396, 397, 398, 400, 303,
+	224, 401, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 321, 0, 0, 0, 0, 0, 0,
+	0, 0, 228, 0, 0, 0, 0, 279, 225, 0,
+	0, 335, 0, 179, 0, 377, 213, 288, 286, 406,
+	239, 231, 227, 211, 263, 294, 333, 395, 327, 0,
+	283, 0, 0, 386, 306, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	269, 210, 178, 318, 387, 243, 0, 0, 0, 170,
+	171, 172, 0, 1287, 0, 0, 0, 0, 0, 0,
+	201, 0, 208, 0, 0, 0, 0, 223, 267, 230,
+	222, 403, 0, 0, 0, 194, 421, 342, 252, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 233, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 251, 0, 307, 0, 0, 0, 0,
+	436, 0, 0, 0, 0, 0, 0, 0, 278, 0,
+	275, 174, 190, 0, 0, 317, 358, 364, 0, 0,
+	0, 214, 0, 362, 331, 420, 197, 241, 355, 336,
+	360, 0, 0, 361, 284, 408, 349, 418, 437, 438,
+	221, 311, 426, 399, 432, 449, 191, 218, 325, 392,
+	423, 383, 304, 404, 405, 274, 382, 249, 177, 282,
+	446, 189, 370, 205, 182, 394, 416, 202, 373, 0,
+	0, 451, 184, 414, 391, 301, 271, 272, 183, 0,
+	354, 226, 247, 216, 320, 411, 412, 215, 452, 193,
+	431, 186, 0, 430, 313, 407, 415, 302, 293, 185,
+	413, 300, 292, 277, 237, 258, 347, 287, 348, 259,
+	309, 308, 310, 0, 180, 0, 388, 424, 453, 198,
+	199, 200, 0, 236, 240, 246, 248, 254, 255, 262,
+	280, 324, 341, 346, 344, 350, 0, 402, 419, 427,
+	434, 435, 441, 442, 443, 447, 444, 445, 448, 312,
+	261, 384, 276, 285, 0, 0, 330, 363, 203, 422,
+	385, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 173, 187, 281, 0, 351, 244, 450, 429, 425,
+	0, 0, 220, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 175, 176, 188, 196, 206, 219, 234, 242,
+	257, 260, 264, 265, 268, 273, 290, 295, 296, 297,
+	298, 314, 315, 316, 319, 322, 323, 326, 328, 329,
+	332, 338, 339, 340, 343, 345, 352, 357, 365, 366,
+	367, 368, 369, 371, 372, 378, 379, 380, 381, 389,
+	393, 409, 410, 433, 439, 0, 0, 375, 353, 374,
+	212, 253, 417, 440, 0, 289, 0, 0, 291, 238,
+	256, 266, 0, 428, 390, 192, 359, 245, 181, 209,
+	195, 217, 232, 235, 270, 299, 305, 334, 337, 250,
+	229, 207, 356, 204, 376, 396, 397, 398, 400, 303,
+	224, 401, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 321, 0, 0, 0, 0, 0, 0,
+	0, 0, 228, 0, 0, 0, 0, 279, 225, 0,
+	0, 335, 0, 179, 0, 377, 213, 288, 286, 406,
+	239, 231, 227, 211, 263, 294, 333, 395, 327, 0,
+	283, 0, 0, 386, 306, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	269, 210, 178, 318, 387, 243, 0, 0, 0, 170,
+	171, 172, 0, 1106, 0, 0, 0, 0, 0, 0,
+	201, 0, 208, 0, 0, 0, 0, 223, 267, 230,
+	222, 403, 0, 0, 0, 194, 421, 342, 252, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 233, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 251, 0, 307, 0, 0, 0, 0,
+	436, 0, 0, 0, 0, 0, 0, 0, 278, 0,
+	275, 174, 190, 0, 0, 317, 358, 364, 0, 0,
+	0, 214, 0, 362, 331, 420, 197, 241, 355, 336,
+	360, 0, 0, 361, 284, 408, 349, 418, 437, 438,
+	221, 311, 426, 399, 432, 449, 191, 218, 325, 392,
+	423, 383, 304, 404, 405, 274, 382, 249, 177, 282,
+	446, 189, 370, 205, 182, 394, 416, 202, 373, 0,
+	0, 451, 184, 414, 391, 301, 271, 272, 183, 0,
+	354, 226, 247, 216, 320, 411, 412, 215, 452, 193,
+	431, 186, 0, 430, 313, 407, 415, 302, 293, 185,
+	413, 300, 292, 277, 237, 258, 347, 287, 348, 259,
+	309, 308, 310, 0, 180, 0, 388, 424, 453, 198,
+	199, 200, 0, 236, 240, 246, 248, 254, 255, 262,
+	280, 324, 341, 346, 344, 350, 0, 402, 419, 427,
+	434, 435, 441, 442, 443, 447, 444, 445, 448, 312,
+	261, 384, 276, 285, 0, 0, 330, 363, 203, 422,
+	385, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 173, 187, 281, 0, 351, 244, 450, 429, 425,
+	0, 0, 220, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 175, 176, 188, 196, 206, 219, 234, 242,
+	257, 260, 264, 265, 268, 273, 290, 295, 296, 297,
+	298, 314, 315, 316, 319, 322, 323, 326, 328, 329,
+	332, 338, 339, 340, 343, 345, 352, 357, 365, 366,
+	367, 368, 369, 371, 372, 378, 379, 380, 381, 389,
+	393, 409, 410, 433, 439, 0, 0, 375, 353, 374,
+	212, 253, 417, 440, 0, 289, 0, 0, 291, 238,
+	256, 266, 0, 428, 390, 192, 359, 245, 181, 209,
+	195, 217, 232, 235, 270, 299, 305, 334, 337, 250,
+	229, 207, 356, 204, 376, 396, 397, 398, 400, 303,
+	224, 401, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 321, 0, 0, 0, 0, 0, 0,
+	0, 0, 228, 0, 0, 0, 0, 279, 225, 0,
+	0, 335, 0, 179, 0, 377, 213, 288, 286, 406,
+	239, 231, 227, 211, 263, 294, 333, 395, 327, 0,
+	283, 0, 0, 386, 306, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	269, 210, 178, 318, 387, 243, 0, 0, 0, 170,
+	171, 172, 0, 0, 0, 0, 0, 0, 0, 0,
+	201, 0, 208, 0, 0, 0, 0, 223, 267, 230,
+	222, 403, 0, 0, 0, 194, 421, 342, 252, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 233, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 251, 0, 307, 0, 0, 0, 0,
+	436, 0, 0, 0, 0, 0, 0, 0, 278, 0,
+	275, 174, 190, 0, 0, 317, 358, 364, 0, 0,
+	0, 214, 0, 362, 331, 420, 197, 241, 355, 336,
+	360, 0, 0, 361, 284, 408, 349, 418, 437, 438,
+	221, 311, 426, 399, 432, 449, 191, 218, 325, 392,
+	423, 383, 304, 404, 405, 274, 382, 249, 177, 282,
+	446, 189, 370, 205, 182, 394, 416, 202, 373, 0,
+	0, 451, 184, 414, 391, 301, 271, 272, 183, 0,
+	354, 226, 247, 216, 320, 411, 412, 215, 452, 193,
+	431, 186, 0, 430, 313, 407, 415, 302, 293, 185,
+	413, 300, 292, 277, 237, 258, 347, 287, 348, 259,
+	309, 308, 310, 0, 180, 0, 388, 424, 453, 198,
+	199, 200, 0, 236, 240, 246, 248, 254, 255, 262,
+	280, 324, 341, 346, 344, 350, 0, 402, 419, 427,
+	434, 435, 441, 442, 443, 447, 444, 445, 448, 312,
+	261, 384, 276, 285, 0, 0, 330, 363, 203, 422,
+	385, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 173, 187, 281, 1381, 351, 244, 450, 429, 425,
+	0, 0, 220, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 175, 176, 188, 196, 206, 219, 234, 242,
+	257, 260, 264, 265, 268, 273, 290, 295, 296, 297,
+	298, 314, 315, 316, 319, 322, 323, 326, 328, 329,
+	332, 338, 339, 340, 343, 345, 352, 357, 365, 366,
+	367, 368, 369, 371, 372, 378, 379, 380, 381, 389,
+	393, 409, 410, 433, 439, 0, 0, 375, 353, 374,
+	212, 253, 417, 440, 0, 289, 0, 0, 291, 238,
+	256, 266, 0, 428, 390, 192, 359, 245, 181, 209,
+	195, 217, 232, 235, 270, 299, 305, 334, 337, 250,
+	229, 207, 356, 204, 376, 396, 397, 398, 400, 303,
+	224, 401, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 321, 0, 1259, 0, 0, 0, 0,
+	0, 0, 228, 0, 0, 0, 0, 279, 225, 0,
+	0, 335, 0, 179, 0, 377, 213, 288, 286, 406,
+	239, 231, 227, 211, 263, 294, 333, 395, 327, 0,
+	283, 0, 0, 386, 306, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	269, 210, 178, 318, 387, 243, 0, 0, 0, 170,
+	171, 172, 0, 0, 0, 0, 0, 0, 0, 0,
+	201, 0, 208, 0, 0, 0, 0, 223, 267, 230,
+	222, 403, 0, 0, 0, 194, 421, 342, 252, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 233, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 251, 0, 307, 0, 0, 0, 0,
+	436, 0, 0, 0, 0, 0, 0, 0, 278, 0,
+	275, 174, 190, 0, 0, 317, 358, 364, 0, 0,
+	0, 214, 0, 362, 331, 420, 197, 241, 355, 336,
+	360, 0, 0, 361, 284, 408, 349, 418, 437, 438,
+	221, 311, 426, 399, 432, 449, 191, 218, 325, 392,
+	423, 383, 304, 404, 405, 274, 382, 249, 177, 282,
+	446, 189, 370, 205, 182, 394, 416, 202, 373, 0,
+	0, 451, 184, 414, 391, 301, 271, 272, 183, 0,
+	354, 226, 247, 216, 320, 411, 412, 215, 452, 193,
+	431, 186, 0, 430, 313, 407, 415, 302, 293, 185,
+	413, 300, 292, 277, 237, 258, 347, 287, 348, 259,
+	309, 308, 310, 0, 180, 0, 388, 424, 453, 198,
+	199, 200, 0, 236, 240, 246, 248, 254, 255, 262,
+	280, 324, 341, 346, 344, 350, 0, 402, 419, 427,
+	434, 435, 441, 442, 443, 447, 444, 445, 448, 312,
+	261, 384, 276, 285, 0, 0, 330, 363, 203, 422,
+	385, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 173, 187, 281, 0, 351, 244, 450, 429, 425,
+	0, 0, 220, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 175, 176, 188, 196, 206, 219, 234, 242,
+	257, 260, 264, 265, 268, 273, 290, 295, 296, 297,
+	298, 314, 315, 316, 319, 322, 323, 326, 328, 329,
+	332, 338, 339, 340, 343, 345, 352, 357, 365, 366,
+	367, 368, 369, 371, 372, 378, 379, 380, 381, 389,
+	393, 409, 410, 433, 439, 0, 0, 375, 353, 374,
+	212, 253, 417, 440, 0, 289, 0, 0, 291, 238,
+	256, 266, 0, 428, 390, 192, 359, 245, 181, 209,
+	195, 217, 232, 235, 270, 299, 305, 334, 337, 250,
+	229, 207, 356, 204, 376, 396, 397, 398, 400, 303,
+	224, 401, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 321, 0, 1257, 0, 0, 0, 0,
+	0, 0, 228, 0, 0, 0, 0, 279, 225, 0,
+	0, 335, 0, 179, 0, 377, 213, 288, 286, 406,
+	239, 231, 227, 211, 263, 294, 333, 395, 327, 0,
+	283, 0, 0, 386, 306, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	269, 210, 178, 318, 387, 243, 0, 0, 0, 170,
+	171, 172, 0, 0, 0, 0, 0, 0, 0, 0,
+	201, 0, 208, 0, 0, 0, 0, 223, 267, 230,
+	222, 403, 0, 0, 0, 194, 421, 342, 252, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 233, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 251, 0, 307, 0, 0, 0, 0,
+	436, 0, 0, 0, 0, 0, 0, 0, 278, 0,
+	275, 174, 190, 0, 0, 317, 358, 364, 0, 0,
+	0, 214, 0, 362, 331, 420, 197, 241, 355, 336,
+	360, 0, 0, 361, 284, 408, 349, 418, 437, 438,
+	221, 311, 426, 399, 432, 449, 191, 218, 325, 392,
+	423, 383, 304, 404, 405, 274, 382, 249, 177, 282,
+	446, 189, 370, 205, 182, 394, 416, 202, 373, 0,
+	0, 451, 184, 414, 391, 301, 271, 272, 183, 0,
+	354, 226, 247, 216, 320, 411, 412, 215, 452, 193,
+	431, 186, 0, 430, 313, 407, 415, 302, 293, 185,
+	413, 300, 292, 277, 237, 258, 347, 287, 348, 259,
+	309, 308, 310, 0, 180, 0, 388, 424, 453, 198,
+	199, 200, 0, 236, 240, 246, 248, 254, 255, 262,
+	280, 324, 341, 346, 344, 350, 0, 402, 419, 427,
+	434, 435, 441, 442, 443, 447, 444, 445, 448, 312,
+	261, 384, 276, 285, 0, 0, 330, 363, 203, 422,
+	385, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 173, 187, 281, 0, 351, 244, 450, 429, 425,
+	0, 0, 220, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 175, 176, 188, 196, 206, 219, 234, 242,
+	257, 260, 264, 265, 268, 273, 290, 295, 296, 297,
+	298, 314, 315, 316, 319, 322, 323, 326, 328, 329,
+	332, 338, 339, 340, 343, 345, 352, 357, 365, 366,
+	367, 368, 369, 371, 372, 378, 379, 380, 381, 389,
+	393, 409, 410, 433, 439, 0, 0, 375, 353, 374,
+	212, 253, 417, 440, 0, 289, 0, 0, 291, 238,
+	256, 266, 0, 428, 390, 192, 359, 245, 181, 209,
+	195, 217, 232, 235, 270, 299, 305, 334, 337, 250,
+	229, 207, 356, 204, 376, 396, 397, 398, 400, 303,
+	224, 401, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 321, 0, 1255, 0, 0, 0, 0,
+	0, 0, 228, 0, 0, 0, 0, 279, 225, 0,
+	0, 335, 0, 179, 0, 377, 213, 288, 286, 406,
+	239, 231, 227, 211, 263, 294, 333, 395, 327, 0,
+	283, 0, 0, 386, 306, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	269, 210, 178, 318, 387, 243, 0, 0, 0, 170,
+	171, 172, 0, 0, 0, 0, 0, 0, 0, 0,
+	201, 0, 208, 0, 0, 0, 0, 223, 267, 230,
+	222, 403, 0, 0, 0, 194, 421, 342, 252, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 233, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 251, 0, 307, 0, 0, 0, 0,
+	436, 0, 0, 0, 0, 0, 0, 0, 278, 0,
+	275, 174, 190, 0, 0, 317, 358, 364, 0, 0,
+	0, 214, 0, 362, 331, 420, 197, 241, 355, 336,
+	360, 0, 0, 361, 284, 408, 349, 418, 437, 438,
+	221, 311, 426, 399, 432, 449, 191, 218, 325, 392,
+	423, 383, 304, 404, 405, 274, 382, 249, 177, 282,
+	446, 189, 370, 205, 182, 394, 416, 202, 373, 0,
+	0, 451, 184, 414, 391, 301, 271, 272, 183, 0,
+	354, 226, 247, 216, 320, 411, 412, 215, 452, 193,
+	431, 186, 0, 430, 313, 407, 415, 302, 293, 185,
+	413, 300, 292, 277, 237, 258, 347, 287, 348, 259,
+	309, 308, 310, 0, 180, 0, 388, 424, 453, 198,
+	199, 200, 0, 236, 240, 246, 248, 254, 255, 262,
+	280, 324, 341, 346, 344, 350, 0, 402, 419, 427,
+	434, 435, 441, 442, 443, 447, 444, 445, 448, 312,
+	261, 384, 276, 285, 0, 0, 330, 363, 203, 422,
+	385, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 173, 187, 281, 0, 351, 244, 450, 429, 425,
+	0, 0, 220, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 175, 176, 188, 196, 206, 219, 234, 242,
+	257, 260, 264, 265, 268, 273, 290, 295, 296, 297,
+	298, 314, 315, 316, 319, 322, 323, 326, 328, 329,
+	332, 338, 339, 340, 343, 345, 352, 357, 365, 366,
+	367, 368, 369, 371, 372, 378, 379, 380, 381, 389,
+	393, 409, 410, 433, 439, 0, 0, 375, 353, 374,
+	212, 253, 417, 440, 0, 289, 0, 0, 291, 238,
+	256, 266, 0, 428, 390, 192, 359, 245, 181, 209,
+	195, 217, 232, 235, 270, 299, 305, 334, 337, 250,
+	229, 207, 356, 204, 376, 396, 397, 398, 400, 303,
+	224, 401, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 321, 0, 1253, 0, 0, 0, 0,
+	0, 0, 228, 0, 0, 0, 0, 279, 225, 0,
+	0, 335, 0, 179, 0, 377, 213, 288, 286, 406,
+	239, 231, 227, 211, 263, 294, 333, 395, 327, 0,
+	283, 0, 0, 386, 306, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	269, 210, 178, 318, 387, 243, 0, 0, 0, 170,
+	171, 172, 0, 0, 0, 0, 0, 0, 0, 0,
+	201, 0, 208, 0, 0, 0, 0, 223, 267, 230,
+	222, 403, 0, 0, 0, 194, 421, 342, 252, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 233, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 251, 0, 307, 0, 0, 0, 0,
+	436, 0, 0, 0, 0, 0, 0, 0, 278, 0,
+	275, 174, 190, 0, 0, 317, 358, 364, 0, 0,
+	0, 214, 0, 362, 331, 420, 197, 241, 355, 336,
+	360, 0, 0, 361, 284, 408, 349, 418, 437, 438,
+	221, 311, 426, 399, 432, 449, 191, 218, 325, 392,
+	423, 383, 304, 404, 405, 274, 382, 249, 177, 282,
+	446, 189, 370, 205, 182, 394, 416, 202, 373, 0,
+	0, 451, 184, 414, 391, 301, 271, 272, 183, 0,
+	354, 226, 247, 216, 320, 411, 412, 215, 452, 193,
+	431, 186, 0, 430, 313, 407, 415, 302, 293, 185,
+	413, 300, 292, 277, 237, 258, 347, 287, 348, 259,
+	309, 308, 310, 0, 180, 0, 388, 424, 453, 198,
+	199, 200, 0, 236, 240, 246, 248, 254, 255, 262,
+	280, 324, 341, 346, 344, 350, 0, 402, 419, 427,
+	434, 435, 441, 442, 443, 447, 444, 445, 448, 312,
+	261, 384, 276, 285, 0, 0, 330, 363, 203, 422,
+	385, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 173, 187, 281, 0, 351, 244, 450, 429, 425,
+	0, 0, 220, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 175, 176, 188, 196, 206, 219, 234, 242,
+	257, 260, 264, 265, 268, 273, 290, 295, 296, 297,
+	298, 314, 315, 316, 319, 322, 323, 326, 328, 329,
+	332, 338, 339, 340, 343, 345, 352, 357, 365, 366,
+	367, 368, 369, 371, 372, 378, 379, 380, 381, 389,
+	393, 409, 410, 433, 439, 0, 0, 375, 353, 374,
+	212, 253, 417, 440, 0, 289, 0, 0, 291, 238,
+	256, 266, 0, 428, 390, 192, 359, 245, 181, 209,
+	195, 217, 232, 235, 270, 299, 305, 334, 337, 250,
+	229, 207, 356, 204, 376, 396, 397, 398, 400, 303,
+	224, 401, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 321, 0, 1251, 0, 0, 0, 0,
+	0, 0, 228, 0, 0, 0, 0, 279, 225, 0,
+	0, 335, 0, 179, 0, 377, 213, 288, 286, 406,
+	239, 231, 227, 211, 263, 294, 333, 395, 327, 0,
+	283, 0, 0, 386, 306, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	269, 210, 178, 318, 387, 243, 0, 0, 0, 170,
+	171, 172, 0, 0, 0, 0, 0, 0, 0, 0,
+	201, 0, 208, 0, 0, 0, 0, 223, 267, 230,
+	222, 403, 0, 0, 0, 194, 421, 342, 252, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 233, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 251, 0, 307, 0, 0, 0, 0,
+	436, 0, 0, 0, 0, 0, 0, 0, 278, 0,
+	275, 174, 190, 0, 0, 317, 358, 364, 0, 0,
+	0, 214, 0, 362, 331, 420, 197, 241, 355, 336,
+	360, 0, 0, 361, 284, 408, 349, 418, 437, 438,
+	221, 311, 426, 399, 432, 449, 191, 218, 325, 392,
+	423, 383, 304, 404, 405, 274, 382, 249, 177, 282,
+	446, 189, 370, 205, 182, 394, 416, 202, 373, 0,
+	0, 451, 184, 414, 391, 301, 271, 272, 183, 0,
+	354, 226, 247, 216, 320, 411, 412, 215, 452, 193,
+	431, 186, 0, 430, 313, 407, 415, 302, 293, 185,
+	413, 300, 292, 277, 237, 258, 347, 287, 348, 259,
+	309, 308, 310, 0, 180, 0, 388, 424, 453, 198,
+	199, 200, 0, 236, 240, 246, 248, 254, 255, 262,
+	280, 324, 341, 346, 344, 350, 0, 402, 419, 427,
+	434, 435, 441, 442, 443, 447, 444, 445, 448, 312,
+	261, 384, 276, 285, 0, 0, 330, 363, 203, 422,
+	385, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 173, 187, 281, 0, 351, 244, 450, 429, 425,
+	0, 0, 220, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 175, 176, 188, 196, 206, 219, 234, 242,
+	257, 260, 264, 265, 268, 273, 290, 295, 296, 297,
+	298, 314, 315, 316, 319, 322, 323, 326, 328, 329,
+	332, 338, 339, 340, 343, 345, 352, 357, 365, 366,
+	367, 368, 369, 371, 372, 378, 379, 380, 381, 389,
+	393, 409, 410, 433, 439, 0, 0, 375, 353, 374,
+	212, 253, 417, 440, 0, 289, 0, 0, 291, 238,
+	256, 266, 0, 428, 390, 192, 359, 245, 181, 209,
+	195, 217, 232, 235, 270, 299, 305, 334, 337, 250,
+	229, 207, 356, 204, 376, 396, 397, 398, 400, 303,
+	224, 401, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 321, 0, 1247, 0, 0, 0, 0,
+	0, 0, 228, 0, 0, 0, 0, 279, 225, 0,
+	0, 335, 0, 179, 0, 377, 213, 288, 286, 406,
+	239, 231, 227, 211, 263, 294, 333, 395, 327, 0,
+	283, 0, 0, 386, 306, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	269, 210, 178, 318, 387, 243, 0, 0, 0, 170,
+	171, 172, 0, 0, 0, 0, 0, 0, 0, 0,
+	201, 0, 208, 0, 0, 0, 0, 223, 267, 230,
+	222, 403, 0, 0, 0, 194, 421, 342, 252, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 233, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 251, 0, 307, 0, 0, 0, 0,
+	436, 0, 0, 0, 0, 0, 0, 0, 278, 0,
+	275, 174, 190, 0, 0, 317, 358, 364, 0, 0,
+	0, 214, 0, 362, 331, 420, 197, 241, 355, 336,
+	360, 0, 0, 361, 284, 408, 349, 418, 437, 438,
+	221, 311, 426, 399, 432, 449, 191, 218, 325, 392,
+	423, 383, 304, 404, 405, 274, 382, 249, 177, 282,
+	446, 189, 370, 205, 182, 394, 416, 202, 373, 0,
+	0, 451, 184, 414, 391, 301, 271, 272, 183, 0,
+	354, 226, 247, 216, 320, 411, 412, 215, 452, 193,
+	431, 186, 0, 430, 313, 407, 415, 302, 293, 185,
+	413, 300, 292, 277, 237, 258, 347, 287, 348, 259,
+	309, 308, 310, 0, 180, 0, 388, 424, 453, 198,
+	199, 200, 0, 236, 240, 246, 248, 254, 255, 262,
+	280, 324, 341, 346, 344, 350, 0, 402, 419, 427,
+	434, 435, 441, 442, 443, 447, 444, 445, 448, 312,
+	261, 384, 276, 285, 0, 0, 330, 363, 203, 422,
+	385, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 173, 187, 281, 0, 351, 244, 450, 429, 425,
+	0, 0, 220, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 175, 176, 188, 196, 206, 219, 234, 242,
+	257, 260, 264, 265, 268, 273, 290, 295, 296, 297,
+	298, 314, 315, 316, 319, 322, 323, 326, 328, 329,
+	332, 338, 339, 340, 343, 345, 352, 357, 365, 366,
+	367, 368, 369, 371, 372, 378, 379, 380, 381, 389,
+	393, 409, 410, 433, 439, 0, 0, 375, 353, 374,
+	212, 253, 417, 440, 0, 289, 0, 0, 291, 238,
+	256, 266, 0, 428, 390, 192, 359, 245, 181, 209,
+	195, 217, 232, 235, 270, 299, 305, 334, 337, 250,
+	229, 207, 356, 204, 376, 396, 397, 398, 400, 303,
+	224, 401, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 321, 0, 1245, 0, 0, 0, 0,
+	0, 0, 228, 0, 0, 0, 0, 279, 225, 0,
+	0, 335, 0, 179, 0, 377, 213, 288, 286, 406,
+	239, 231, 227, 211, 263, 294, 333, 395, 327, 0,
+	283, 0, 0, 386, 306, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	269, 210, 178, 318, 387, 243, 0, 0, 0, 170,
+	171, 172, 0, 0, 0, 0, 0, 0, 0, 0,
+	201, 0, 208, 0, 0, 0, 0, 223, 267, 230,
+	222, 403, 0, 0, 0, 194, 421, 342, 252, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 233, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 251, 0, 307, 0, 0, 0, 0,
+	436, 0, 0, 0, 0, 0, 0, 0, 278, 0,
+	275, 174, 190, 0, 0, 317, 358, 364, 0, 0,
+	0, 214, 0, 362, 331, 420, 197, 241, 355, 336,
+	360, 0, 0, 361, 284, 408, 349, 418, 437, 438,
+	221, 311, 426, 399, 432, 449, 191, 218, 325, 392,
+	423, 383, 304, 404, 405, 274, 382, 249, 177, 282,
+	446, 189, 370, 205, 182, 394, 416, 202, 373, 0,
+	0, 451, 184, 414, 391, 301, 271, 272, 183, 0,
+	354, 226, 247, 216, 320, 411, 412, 215, 452, 193,
+	431, 186, 0, 430, 313, 407, 415, 302, 293, 185,
+	413, 300, 292, 277, 237, 258, 347, 287, 348, 259,
+	309, 308, 310, 0, 180, 0, 388, 424, 453, 198,
+	199, 200, 0, 236, 240, 246, 248, 254, 255, 262,
+	280, 324, 341, 346, 344, 350, 0, 402, 419, 427,
+	434, 435, 441, 442, 443, 447, 444, 445, 448, 312,
+	261, 384, 276, 285, 0, 0, 330, 363, 203, 422,
+	385, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 173, 187, 281, 0, 351, 244, 450, 429, 425,
+	0, 0, 220, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 175, 176, 188, 196, 206, 219, 234, 242,
+	257, 260, 264, 265, 268, 273, 290, 295, 296, 297,
+	298, 314, 315, 316, 319, 322, 323, 326, 328, 329,
+	332, 338, 339, 340, 343, 345, 352, 357, 365, 366,
+	367, 368, 369, 371, 372, 378, 379, 380, 381, 389,
+	393, 409, 410, 433, 439, 0, 0, 375, 353, 374,
+	212, 253, 417, 440, 0, 289, 0, 0, 291, 238,
+	256, 266, 0, 428, 390, 192, 359, 245, 181, 209,
+	195, 217, 232, 235, 270, 299, 305, 334, 337, 250,
+	229, 207, 356, 204, 376, 396, 397, 398, 400, 303,
+	224, 401, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 321, 0, 1243, 0, 0, 0, 0,
+	0, 0, 228, 0, 0, 0, 0, 279, 225, 0,
+	0, 335, 0, 179, 0, 377, 213, 288, 286, 406,
+	239, 231, 227, 211, 263, 294, 333, 395, 327, 0,
+	283, 0, 0, 386, 306, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	269, 210, 178, 318, 387, 243, 0, 0, 0, 170,
+	171, 172, 0, 0, 0, 0, 0, 0, 0, 0,
+	201, 0, 208, 0, 0, 0, 0, 223, 267, 230,
+	222, 403, 0, 0, 0, 194, 421, 342, 252, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 233, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 251, 0, 307, 0, 0, 0, 0,
+	436, 0, 0, 0, 0, 0, 0, 0, 278, 0,
+	275, 174, 190, 0, 0, 317, 358, 364, 0, 0,
+	0, 214, 0, 362, 331, 420, 197, 241, 355, 336,
+	360, 0, 0, 361, 284, 408, 349, 418, 437, 438,
+	221, 311, 426, 399, 432, 449, 191, 218, 325, 392,
+	423, 383, 304, 404, 405, 274, 382, 249, 177, 282,
+	446, 189, 370, 205, 182, 394, 416, 202, 373, 0,
+	0, 451, 184, 414, 391, 301, 271, 272, 183, 0,
+	354, 226, 247, 216, 320, 411, 412, 215, 452, 193,
+	431, 186, 0, 430, 313, 407, 415, 302, 293, 185,
+	413, 300, 292, 277, 237, 258, 347, 287, 348, 259,
+	309, 308, 310, 0, 180, 0, 388, 424, 453, 198,
+	199, 200, 0, 236, 240, 246, 248, 254, 255, 262,
+	280, 324, 341, 346, 344, 350, 0, 402, 419, 427,
+	434, 435, 441, 442, 443, 447, 444, 445, 448, 312,
+	261, 384, 276, 285, 0, 0, 330, 363, 203, 422,
+	385, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 173, 187, 281, 0, 351, 244, 450, 429, 425,
+	0, 0, 220, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 175, 176, 188, 196, 206, 219, 234, 242,
+	257, 260, 264, 265, 268, 273, 290, 295, 296, 297,
+	298, 314, 315, 316, 319, 322, 323, 326, 328, 329,
+	332, 338, 339, 340, 343, 345, 352, 357, 365, 366,
+	367, 368, 369, 371, 372, 378, 379, 380, 381, 389,
+	393, 409, 410, 433, 439, 0, 0, 375, 353, 374,
+	212, 253, 417, 440, 0, 289, 0, 0, 291, 238,
+	256, 266, 0, 428, 390, 192, 359, 245, 181, 209,
+	195, 217, 232, 235, 270, 299, 305, 334, 337, 250,
+	229, 207, 356, 204, 376, 396, 397, 398, 400, 303,
+	224, 401, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 321, 0, 0, 0, 0, 0, 0,
+	0, 0, 228, 0, 0, 0, 0, 279, 225, 0,
+	0, 335, 0, 179, 0, 377, 213, 288, 286, 406,
+	239, 231, 227, 211, 263, 294, 333, 395, 327, 0,
+	283, 0, 0, 386, 306, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	269, 210, 178, 318, 387, 243, 1218, 0, 0, 170,
+	171, 172, 0, 0, 0, 0, 0, 0, 0, 0,
+	201, 0, 208, 0, 0, 0, 0, 223, 267, 230,
+	222, 403, 0, 0, 0, 194, 421, 342, 252, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 233, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 251, 0, 307, 0, 0, 0, 0,
+	436, 0, 0, 0, 0, 0, 0, 0, 278, 0,
+	275, 174, 190, 0, 0, 317, 358, 364, 0, 0,
+	0, 214, 0, 362, 331, 420, 197, 241, 355, 336,
+	360, 0, 0, 361, 284, 408, 349, 418, 437, 438,
+	221, 311, 426, 399, 432, 449, 191, 218, 325, 392,
+	423, 383, 304, 404, 405, 274, 382, 249, 177, 282,
+	446, 189, 370, 205, 182, 394, 416, 202, 373, 0,
+	0, 451, 184, 414, 391, 301, 271, 272, 183, 0,
+	354, 226, 247, 216, 320, 411, 412, 215, 452, 193,
+	431, 186, 0, 430, 313, 407, 415, 302, 293, 185,
+	413, 300, 292, 277, 237, 258, 347, 287, 348, 259,
+	309, 308, 310, 0, 180, 0, 388, 424, 453, 198,
+	199, 200, 0, 236, 240, 246, 248, 254, 255, 262,
+	280, 324, 341, 346, 344, 350, 0, 402, 419, 427,
+	434, 435, 441, 442, 443, 447, 444, 445, 448, 312,
+	261, 384, 276, 285, 0, 0, 330, 363, 203, 422,
+	385, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 173, 187, 281, 0, 351, 244, 450, 429, 425,
+	0, 0, 220, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 175, 176, 188, 196, 206, 219, 234, 242,
+	257, 260, 264, 265, 268, 273, 290, 295, 296, 297,
+	298, 314, 315, 316, 319, 322, 323, 326, 328, 329,
+	332, 338, 339, 340, 343, 345, 352, 357, 365, 366,
+	367, 368, 369, 371, 372, 378, 379, 380, 381, 389,
+	393, 409, 410, 433, 439, 0, 0, 375, 353, 374,
+	212, 253, 417, 440, 0, 289, 0, 0, 291, 238,
+	256, 266, 0, 428, 390, 192, 359, 245, 181, 209,
+	195, 217, 232, 235, 270, 299, 305, 334, 337, 250,
+	229, 207, 356, 204, 376, 396, 397, 398, 400, 303,
+	224, 401, 0, 0, 0, 0, 1119, 0, 0, 0,
+	0, 0, 0, 321, 0, 0, 0, 0, 0, 0,
+	0, 0, 228, 0, 0, 0, 0, 279, 225, 0,
+	0, 335, 0, 179, 0, 377, 213, 288, 286, 406,
+	239, 231, 227, 211, 263, 294, 333, 395, 327, 0,
+	283, 0, 0, 386, 306, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	269, 210, 178, 318, 387, 243, 0, 0, 0, 170,
+	171, 172, 0, 0, 0, 0, 0, 0, 0, 0,
+	201, 0, 208, 0, 0, 0, 0, 223, 267, 230,
+	222, 403, 0, 0, 0, 194, 421, 342, 252, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 233, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 251, 0, 307, 0, 0, 0, 0,
+	436, 0, 0, 0, 0, 0, 0, 0, 278, 0,
+	275, 174, 190, 0, 0, 317, 358, 364, 0, 0,
+	0, 214, 0, 362, 331, 420, 197, 241, 355, 336,
+	360, 0, 0, 361, 284, 408, 349, 418, 437, 438,
+	221, 311, 426, 399, 432, 449, 191, 218, 325, 392,
+	423, 383, 304, 404, 405, 274, 382, 249, 177, 282,
+	446, 189, 370, 205, 182, 394, 416, 202, 373, 0,
+	0, 451, 184, 414, 391, 301, 271, 272, 183, 0,
+	354, 226, 247, 216, 320, 411, 412, 215, 452, 193,
+	431, 186, 0, 430, 313, 407, 415, 302, 293, 185,
+	413, 300, 292, 277, 237, 258, 347, 287, 348, 259,
+	309, 308, 310, 0, 180, 0, 388, 424, 453, 198,
+	199, 200, 0, 236, 240, 246, 248, 254, 255, 262,
+	280, 324, 341, 346, 344, 350, 0, 402, 419, 427,
+	434, 435, 441, 442, 443, 447, 444, 445, 448, 312,
+	261, 384, 276, 285, 0, 0, 330, 363, 203, 422,
+	385, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 173, 187, 281, 0, 351, 244, 450, 429, 425,
+	0, 0, 220, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 175, 176, 188, 196, 206, 219, 234, 242,
+	257, 260, 264, 265, 268, 273, 290, 295, 296, 297,
+	298, 314, 315, 316, 319, 322, 323, 326, 328, 329,
+	332, 338, 339, 340, 343, 345, 352, 357, 365, 366,
+	367, 368, 369, 371, 372, 378, 379, 380, 381, 389,
+	393, 409, 410, 433, 439, 0, 0, 375, 353, 374,
+	212, 253, 417, 440, 0, 289, 0, 0, 291, 238,
+	256, 266, 0, 428, 390, 192, 359, 245, 181, 209,
+	195, 217, 232, 235, 270, 299, 305, 334, 337, 250,
+	229, 207, 356, 204, 376, 396, 397, 398, 400, 303,
+	224, 401, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 321, 0, 0, 0, 0, 0, 0,
+	0, 1110, 228, 0, 0, 0, 0, 279, 225, 0,
+	0, 335, 0, 179, 0, 377, 213, 288, 286, 406,
+	239, 231, 227, 211, 263, 294, 333, 395, 327, 0,
+	283, 0, 0, 386, 306, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	269, 210, 178, 318, 387, 243, 0, 0, 0, 170,
+	171, 172, 0, 0, 0, 0, 0, 0, 0, 0,
+	201, 0, 208, 0, 0, 0, 0, 223, 267, 230,
+	222, 403, 0, 0, 0, 194, 421, 342, 252, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 233, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 251, 0, 307, 0, 0, 0, 0,
+	436, 0, 0, 0, 0, 0, 0, 0, 278, 0,
+	275, 174, 190, 0, 0, 317, 358, 364, 0, 0,
+	0, 214, 0, 362, 331, 420, 197, 241, 355, 336,
+	360, 0, 0, 361, 284, 408, 349, 418, 437, 438,
+	221, 311, 426, 399, 432, 449, 191, 218, 325, 392,
+	423, 383, 304, 404, 405, 274, 382, 249, 177, 282,
+	446, 189, 370, 205, 182, 394, 416, 202, 373, 0,
+	0, 451, 184, 414, 391, 301, 271, 272, 183, 0,
+	354, 226, 247, 216, 320, 411, 412, 215, 452, 193,
+	431, 186, 0, 430, 313, 407, 415, 302, 293, 185,
+	413, 300, 292, 277, 237, 258, 347, 287, 348, 259,
+	309, 308, 310, 0, 180, 0, 388, 424, 453, 198,
+	199, 200, 0, 236, 240, 246, 248, 254, 255, 262,
+	280, 324, 341, 346, 344, 350, 0, 402, 419, 427,
+	434, 435, 441, 442, 443, 447, 444, 445, 448, 312,
+	261, 384, 276, 285, 0, 0, 330, 363, 203, 422,
+	385, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 173, 187, 281, 0, 351, 244, 450, 429, 425,
+	0, 0, 220, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 175, 176, 188, 196, 206, 219, 234, 242,
+	257, 260, 264, 265, 268, 273, 290, 295, 296, 297,
+	298, 314, 315, 316, 319, 322, 323, 326, 328, 329,
+	332, 338, 339, 340, 343, 345, 352, 357, 365, 366,
+	367, 368, 369, 371, 372, 378, 379, 380, 381, 389,
+	393, 409, 410, 433, 439, 0, 0, 375, 353, 374,
+	212, 253, 417, 440, 0, 289, 0, 0, 291, 238,
+	256, 266, 0, 428, 390, 192, 359, 245, 181, 209,
+	195, 217, 232, 235, 270, 299, 305, 334, 337, 250,
+	229, 207, 356, 204, 376, 396, 397, 398, 400, 303,
+	224, 401, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 321, 0, 0, 0, 0, 0, 0,
+	0, 0, 228, 0, 0, 0, 0, 279, 225, 0,
+	0, 335, 0, 179, 0, 377, 213, 288, 286, 406,
+	239, 231, 227, 211, 263, 294, 333, 395, 327, 0,
+	283, 0, 0, 386, 306, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	269, 210, 178, 318, 387, 243, 0, 0, 0, 170,
+	171, 172, 0, 963, 0, 0, 0, 0, 0, 0,
+	201, 0, 208, 0, 0, 0, 0, 223, 267, 230,
+	222, 403, 0, 0, 0, 194, 421, 342, 252, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 233, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 251, 0, 307, 0, 0, 0, 0,
+	436, 0, 0, 0, 0, 0, 0, 0, 278, 0,
+	275, 174, 190, 0, 0, 317, 358, 364, 0, 0,
+	0, 214, 0, 362, 331, 420, 197, 241, 355, 336,
+	360, 0, 0, 361, 284, 408, 349, 418, 437, 438,
+	221, 311, 426, 399, 432, 449, 191, 218, 325, 392,
+	423, 383, 304, 404, 405, 274, 382, 249, 177, 282,
+	446, 189, 370, 205, 182, 394, 416, 202, 373, 0,
+	0, 451, 184, 414, 391, 301, 271, 272, 183, 0,
+	354, 226, 247, 216, 320, 411, 412, 215, 452, 193,
+	431, 186, 0, 430, 313, 407, 415, 302, 293, 185,
+	413, 300, 292, 277, 237, 258, 347, 287, 348, 259,
+	309, 308, 310, 0, 180, 0, 388, 424, 453, 198,
+	199, 200, 0, 236, 240, 246, 248, 254, 255, 262,
+	280, 324, 341, 346, 344, 350, 0, 402, 419, 427,
+	434, 435, 441, 442, 443, 447, 444, 445, 448, 312,
+	261, 384, 276, 285, 0, 0, 330, 363, 203, 422,
+	385, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 173, 187, 281, 0, 351, 244, 450, 429, 425,
+	0, 0, 220, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 175, 176, 188, 196, 206, 219, 234, 242,
+	257, 260, 264, 265, 268, 273, 290, 295, 296, 297,
+	298, 314, 315, 316, 319, 322, 323, 326, 328, 329,
+	332, 338, 339, 340, 343, 345, 352, 357, 365, 366,
+	367, 368, 369, 371, 372, 378, 379, 380, 381, 389,
+	393, 409, 410, 433, 439, 0, 0, 375, 353, 374,
+	212, 253, 417, 440, 0, 289, 0, 0, 291, 238,
+	256, 266, 0, 428, 390, 192, 359, 245, 181, 209,
+	195, 217, 232, 235, 270, 299, 305, 334, 337, 250,
+	229, 207, 356, 204, 376, 396, 397, 398, 400, 303,
+	224, 401, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 321, 0, 0, 0, 0, 0, 0,
+	0, 0, 228, 0, 0, 0, 0, 279, 225, 0,
+	0, 335, 0, 179, 0, 377, 213, 288, 286, 406,
+	239, 231, 227, 211, 263, 294, 333, 395, 327, 0,
+	283, 0, 0, 386, 306, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	269, 210, 178, 318, 387, 243, 0, 0, 0, 170,
+	171, 172, 0, 0, 0, 0, 0, 0, 0, 0,
+	201, 0, 208, 0, 0, 0, 0, 223, 267, 230,
+	222, 403, 0, 0, 0, 194, 421, 342, 252, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 233, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 509, 0, 251, 0, 307, 0, 0, 0, 0,
+	436, 0, 0, 0, 0, 0, 0, 0, 278, 0,
+	275, 174, 190, 0, 0, 317, 358, 364, 0, 0,
+	0, 214, 0, 362, 331, 420, 197, 241, 355, 336,
+	360, 0, 0, 361, 284, 408, 349, 418, 437, 438,
+	221, 311, 426, 399, 432, 449, 191, 218, 325, 392,
+	423, 383, 304, 404, 405, 274, 382, 249, 177, 282,
+	446, 189, 370, 205, 182, 394, 416, 202, 373, 0,
+	0, 451, 184, 414, 391, 301, 271, 272, 183, 0,
+	354, 226, 247, 216, 320, 411, 412, 215, 452, 193,
+	431, 186, 0, 430, 313, 407, 415, 302, 293, 185,
+	413, 300, 292, 277, 237, 258, 347, 287, 348, 259,
+	309, 308, 310, 0, 180, 0, 388, 424, 453, 198,
+	199, 200, 0, 236, 240, 246, 248, 254, 255, 262,
+	280, 324, 341, 346, 344, 350, 0, 402, 419, 427,
+	434, 435, 441, 442, 443, 447, 444, 445, 448, 312,
+	261, 384, 276, 285, 0, 0, 330, 363, 203, 422,
+	385, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 173, 187, 281, 0, 351, 244, 450, 429, 425,
+	0, 0, 220, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 175, 176, 188, 196, 206, 219, 234, 242,
+	257, 260, 264, 265, 268, 273, 290, 295, 296, 297,
+	298, 314, 315, 316, 319, 322, 323, 326, 328, 329,
+	332, 338, 339, 340, 343, 345, 352, 357, 365, 366,
+	367, 368, 369, 371, 372, 378, 379, 380, 381, 389,
+	393, 409, 410, 433, 439, 0, 0, 375, 353, 374,
+	212, 508, 417, 440, 0, 289, 0, 0, 291, 238,
+	256, 266, 0, 428, 390, 192, 359, 245, 181, 209,
+	195, 217, 232, 235, 270, 299, 305, 334, 337, 250,
+	229, 207, 356, 204, 376, 396, 397, 398, 400, 303,
+	224, 401, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 321, 0, 0, 0, 0, 0, 0,
+	0, 0, 228, 0, 0, 0, 0, 279, 225, 0,
+	0, 335, 0, 179, 0, 377, 213, 288, 286, 406,
+	239, 231, 227, 211, 263, 294, 333, 395, 327, 0,
+	283, 0, 0, 386, 306, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	269, 210, 178, 318, 387, 243, 0, 0, 0, 170,
+	171, 172, 0, 0, 0, 0, 0, 0, 0, 0,
+	201, 0, 208, 0, 0, 0, 0, 223, 267, 230,
+	222, 403, 0, 0, 0, 194, 421, 342, 252, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 233, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 251, 0, 307, 0, 0, 456, 0,
+	436, 0, 0, 0, 0, 0, 0, 0, 278, 0,
+	275, 174, 190, 0, 0, 317, 358, 364, 0, 0,
+	0, 214, 0, 362, 331, 420, 197, 241, 355, 336,
+	360, 0, 0, 361, 284, 408, 349, 418, 437, 438,
+	221, 311, 426, 399, 432, 449, 191, 218, 325, 392,
+	423, 383, 304, 404, 405, 274, 382, 249, 177, 282,
+	446, 189, 370, 205, 182, 394, 416, 202, 373, 0,
+	0, 451, 184, 414, 391, 301, 271, 272, 183, 0,
+	354, 226, 247, 216, 320, 411, 412, 215, 452, 193,
+	431, 186, 0, 430, 313, 407, 415, 302, 293, 185,
+	413, 300, 292, 277, 237, 258, 347, 287, 348, 259,
+	309, 308, 310, 0, 180, 0, 388, 424, 453, 198,
+	199, 200, 0, 236, 240, 246, 248, 254, 255, 262,
+	280, 324, 341, 346, 344, 350, 0, 402, 419, 427,
+	434, 435, 441, 442, 443, 447, 444, 445, 448, 312,
+	261, 384, 276, 285, 0, 0, 330, 363, 203, 422,
+	385, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 173, 187, 281, 0, 351, 244, 450, 429, 425,
+	0, 0, 220, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 175, 176, 188, 196, 206, 219, 234, 242,
+	257, 260, 264, 265, 268, 273, 290, 295, 296, 297,
+	298, 314, 315, 316, 319, 322, 323, 326, 328, 329,
+	332, 338, 339, 340, 343, 345, 352, 357, 365, 366,
+	367, 368, 369, 371, 372, 378, 379, 380, 381, 389,
+	393, 409, 410, 433, 439, 0, 0, 375, 353, 374,
+	212, 253, 417, 440, 0, 289, 0, 0, 291, 238,
+	256, 266, 0, 428, 390, 192, 359, 245, 181, 209,
+	195, 217, 232, 235, 270, 299, 305, 334, 337, 250,
+	229, 207, 356, 204, 376, 396, 397, 398, 400, 303,
+	224, 401, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 321, 0, 0, 0, 0, 0, 0,
+	0, 0, 228, 0, 0, 0, 0, 279, 225, 0,
+	0, 335, 0, 179, 0, 377, 213, 288, 286, 406,
+	239, 231, 227, 211, 263, 294, 333, 395, 327, 0,
+	283, 0, 0, 386, 306, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	269, 210, 178, 318, 387, 243, 0, 0, 0, 170,
+	171, 172, 0, 0, 0, 0, 0, 0, 0, 0,
+	201, 0, 208, 0, 0, 0, 0, 223, 267, 230,
+	222, 403, 0, 0, 0, 194, 421, 342, 252, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 233, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 251, 0, 307, 0, 0, 0, 0,
+	436, 0, 0, 0, 0, 0, 0, 0, 278, 0,
+	275, 174, 190, 0, 0, 317, 358, 364, 0, 0,
+	0, 214, 0, 362, 331, 420, 197, 241, 355, 336,
+	360, 0, 0, 361, 284, 408, 349, 418, 437, 438,
+	221, 311, 426, 399, 432, 449, 191, 218, 325, 392,
+	423, 383, 304, 404, 405, 274, 382, 249, 177, 282,
+	446, 189, 370, 205, 182, 394, 416, 202, 373, 0,
+	0, 451, 184, 414, 391, 301, 271, 272, 183, 0,
+	354, 226, 247, 216, 320, 411, 412, 215, 452, 193,
+	431, 186, 0, 430, 313, 407, 415, 302, 293, 185,
+	413, 300, 292, 277, 237, 258, 347, 287, 348, 259,
+	309, 308, 310, 0, 180, 0, 388, 424, 453, 198,
+	199, 200, 0, 236, 240, 246, 248, 254, 255, 262,
+	280, 324, 341, 346, 344, 350, 0, 402, 419, 427,
+	434, 435, 441, 442, 443, 447, 444, 445, 448, 312,
+	261, 384, 276, 285, 0, 0, 330, 363, 203, 422,
+	385, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 173, 187, 281, 0, 351, 244, 450, 429, 425,
+	0, 0, 220, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 175, 176, 188, 196, 206, 219, 234, 242,
+	257, 260, 264, 265, 268, 273, 290, 295, 296, 297,
+	298, 314, 315, 316, 319, 322, 323, 326, 328, 329,
+	332, 338, 339, 340, 343, 345, 352, 357, 365, 366,
+	367, 368, 369, 371, 372, 378, 379, 380, 381, 389,
+	393, 409, 410, 433, 439, 0, 0, 375, 353, 374,
+	212, 253, 417, 440, 0, 289, 0, 0, 291, 238,
+	256, 266, 0, 428, 390, 192, 359, 245, 181, 209,
+	195, 217, 232, 235, 270, 299, 305, 334, 337, 250,
+	229, 207, 356, 204, 376, 396, 397, 398, 400, 303,
+	224, 164, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1211, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 106, 0, 128,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	148, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 138, 0, 0, 0, 0, 127, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 145, 0, 146, 0, 0, 0,
+	0, 1215, 1216, 137, 136, 163, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 132, 1217, 139, 0, 1214, 0, 133, 134,
+	0, 0, 0, 149, 0, 0, 0, 0, 0, 0,
+	0, 0, 154, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 141, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 135, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 129, 0,
+	0, 130, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 142, 147, 144, 150, 151, 152, 153, 155,
+	156, 157, 158, 0, 0, 0, 0, 0, 159, 160,
+	161, 162,
 }
 
 var yyPact = [...]int{
-	2891, -1000, -350, 1744, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	4495, -1000, -350, 1692, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 1702, 1336, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 593, 1413, -1000, 1625, 4166, -1000, 29188, 419,
-	-1000, 28714, 412, 2217, 29188, -1000, 81, -1000, 67, 29188,
-	91, 28240, -1000, -1000, -277, 13070, 1578, -25, -27, 29188,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1392,
-	1662, 1679, 1698, 1167, 1883, -1000, 11173, 11173, 308, 308,
-	308, 9277, -1000, -1000, 16863, 29188, 29188, 611, -1000, 1625,
-	-1000, -1000, 223, -1000, 219, 1364, -1000, 1363, -1000, 479,
-	376, 225, 314, 304, 222, 220, 218, 215, 214, 213,
-	212, 211, 227, -1000, 602, 602, -144, -152, 293, 283,
-	283, 283, 327, 1602, 1601, -1000, 526, -1000, 602, 602,
-	221, 602, 602, 602, 602, 191, 190, 602, 602, 602,
-	602, 602, 602, 602, 602, 602, 602, 602, 602, 602,
-	602, 602, 175, 1625, 170, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 1648, 1271, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 694, 1305, -1000, 1545, 3147, -1000, 30962, 433,
+	-1000, 30482, 422, 108, 30962, -1000, 119, -1000, 112, 30962,
+	113, 30002, 292, -1000, -1000, -290, 12720, 1495, -4, -7,
+	30962, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	1283, 1613, 1603, 1601, 1619, 1639, 1150, 1596, -1000, 10799,
+	10799, 349, 349, 349, 8879, -1000, -1000, 18481, 30962, 30962,
+	190, -1000, 1545, -1000, -1000, 228, -1000, 272, 1256, -1000,
+	1242, -1000, 390, 373, 269, 358, 335, 267, 255, 254,
+	252, 249, 246, 244, 243, 275, -1000, 614, 614, -174,
+	-175, 2824, 311, 311, 311, 368, 1526, 1525, -1000, 555,
+	-1000, 614, 614, 227, 614, 614, 614, 614, 221, 220,
+	614, 614, 614, 614, 614, 614, 614, 614, 614, 614,
+	614, 614, 614, 614, 614, 223, 1545, 192, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -4128,27 +4372,28 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 29188, 116, 29188, -1000, 497, 29188,
-	660, 660, 44, 660, 660, 660, 660, 74, 440, -38,
-	-1000, 63, 182, 78, 179, 668, 152, 69, -1000, -1000,
-	148, 668, 1018, 61, -1000, 660, 7373, 7373, 7373, -1000,
-	1614, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 325,
-	-1000, -1000, -1000, -1000, 29188, 27766, 254, 654, -1000, -1000,
-	-1000, 6, -1000, -1000, 1211, 937, -1000, 13070, 2594, 1168,
-	1168, -1000, -1000, 456, -1000, -1000, 14492, 14492, 14492, 14492,
-	14492, 14492, 14492, 14492, 14492, 14492, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	1168, 492, -1000, 12596, 1168, 1168, 1168, 1168, 1168, 1168,
-	1168, 1168, 13070, 1168, 1168, 1168, 1168, 1168, 1168, 1168,
-	1168, 1168, 1168, 1168, 1168, 1168, 1168, 1168, 1168, 1168,
-	-1000, -1000, -1000, 29188, -1000, 1168, 107, 1702, -1000, 1336,
-	-1000, -1000, -1000, 1612, 13070, 13070, 1702, -1000, 1503, 11173,
-	-1000, -1000, 1878, -1000, -1000, -1000, -1000, -1000, 786, 1725,
-	-1000, 15440, 483, 1724, 27292, -1000, 20656, 26818, 1362, 8801,
-	-56, -1000, -1000, -1000, 646, 19234, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 1614, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 30962, 135, 30962, -1000, 532, 30962,
+	713, 713, 17, 713, 713, 713, 713, 98, 503, -11,
+	-1000, 97, 205, 84, 186, 704, 226, 69, -1000, -1000,
+	183, 704, 998, 723, 100, -1000, 713, 6943, 6943, 6943,
+	-1000, 1538, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	367, -1000, -1000, -1000, -1000, 30962, 29522, 250, 654, -1000,
+	-275, -275, -1000, -1000, 12, -1000, -1000, 1067, 605, -1000,
+	12720, 2676, 1227, 1227, -1000, -1000, 529, -1000, -1000, 15600,
+	15600, 15600, 15600, 15600, 15600, 15600, 15600, 15600, 15600, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 1227, 528, -1000, 12240, 1227, 1227, 1227,
+	1227, 1227, 1227, 1227, 1227, 12720, 1227, 1227, 1227, 1227,
+	1227, 1227, 1227, 1227, 1227, 1227, 1227, 1227, 1227, 1227,
+	1227, 1227, 1227, -1000, -1000, -1000, 30962, -1000, 1227, 131,
+	1648, -1000, 1271, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	1541, 12720, 12720, 1648, -1000, 1438, 10799, -1000, -1000, 1514,
+	-1000, -1000, -1000, -1000, -1000, 769, 1676, -1000, 17040, 521,
+	1675, 29042, -1000, 22322, 28562, 1241, 8395, -65, -1000, -1000,
+	-1000, 649, 20882, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1538, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -4160,198 +4405,211 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	1261, 29188, -1000, -1000, 4331, 1101, -1000, 1412, -1000, 1240,
-	-1000, 1381, 1345, 405, 1101, 387, 384, 375, -1000, -108,
-	-1000, -1000, -1000, -1000, -1000, 602, 602, 226, 4166, 2241,
-	-1000, -1000, -1000, 26344, 1410, 1101, -1000, 1408, -1000, 722,
-	378, 410, 410, 1101, -1000, -1000, 29188, 1101, 702, 700,
-	29188, 29188, -1000, 25870, -1000, 25396, 24922, 916, 29188, 24448,
-	23974, 23500, 23026, 22552, -1000, 1507, -1000, 1399, -1000, -1000,
-	-1000, 29188, 29188, 29188, 217, -1000, -1000, 29188, 1101, -1000,
-	-1000, 903, 902, 602, 602, 900, 1015, 1014, 1009, 602,
-	602, 895, 1008, 21130, 164, 894, 891, 888, 917, 1005,
-	115, 906, 884, 887, 29188, 1407, 29188, -1000, 144, 640,
-	238, 619, 1625, 1576, 1361, 324, 391, 1101, 295, 295,
-	-1000, 7849, -1000, -1000, 1000, 13070, -1000, 683, 668, 668,
-	-1000, -1000, -1000, -1000, -1000, -1000, 660, 29188, 683, -1000,
-	-1000, -1000, 668, 660, 29188, 660, 660, 660, 660, 668,
-	668, 668, 660, 29188, 29188, 29188, 29188, 29188, 29188, 29188,
-	29188, 29188, 7373, 7373, 7373, 541, 660, -286, -1000, 1460,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 82, -1000,
-	-1000, -1000, -1000, -1000, 1744, -1000, -1000, -1000, -113, 1358,
-	22078, -1000, -287, -288, -295, -296, -1000, -1000, -1000, -297,
-	-298, -1000, -1000, -1000, 13070, 13070, 13070, 13070, 1045, 549,
-	14492, 793, 630, 14492, 14492, 14492, 14492, 14492, 14492, 14492,
-	14492, 14492, 14492, 14492, 14492, 14492, 14492, 14492, 712, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 1101, -1000, 1722,
-	1166, 1166, 506, 506, 506, 506, 506, 506, 506, 506,
-	506, 4860, 9751, 7849, 1167, 1237, 1702, 11173, 11173, 13070,
-	13070, 12121, 11647, 11173, 1609, 665, 937, 29188, -1000, 1048,
-	-1000, -1000, 14018, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 29188, 29188, 11173, 11173, 11173,
-	11173, 11173, -1000, 1338, -1000, -176, 16389, 13070, 999, 1679,
-	1167, 1878, 1634, 1738, 536, 757, 1320, -1000, 977, 1679,
-	18760, 1337, -1000, 1878, -1000, -1000, -1000, 29188, -1000, -1000,
-	21604, -1000, -1000, 6897, 29188, 209, 29188, -1000, 1341, 1599,
-	-1000, -1000, -1000, 1652, 18286, 29188, 1287, 1275, -1000, -1000,
-	481, 8325, -56, -1000, 8325, 1290, -1000, -54, -69, 10225,
-	486, -1000, -1000, -1000, 293, 14966, 1105, 1585, 27, -1000,
-	-1000, -1000, 1381, -1000, 1381, 1381, 1381, 1381, 217, 217,
-	217, 217, -1000, -1000, -1000, -1000, -1000, 1401, 1400, -1000,
-	1381, 1381, 1381, 1381, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 1395, 1395, 1395, 1384, 1384, 264, -1000, 13070, 140,
-	29188, 1631, 870, 144, 300, 1427, 1101, 1101, 1101, 300,
-	-1000, 1080, 1063, -1000, 1316, -1000, -1000, 1697, -1000, -1000,
-	490, 754, 753, 600, 29188, 124, 208, -1000, 253, -1000,
-	29188, 1101, 697, 410, 1101, -1000, 1101, -1000, -1000, -1000,
-	-1000, 472, -1000, -1000, 1101, 1309, -1000, 1289, 779, 748,
-	742, 746, 1309, -1000, -1000, -129, 1309, -1000, 1309, -1000,
-	1309, -1000, 1309, -1000, 1309, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 612, 29188, 124, 712, -1000, 323, -1000,
-	-1000, 712, 712, -1000, -1000, -1000, -1000, 997, 995, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1174,
+	30962, -1000, -1000, 3383, 958, -1000, 1299, -1000, 1168, -1000,
+	1265, 1308, 421, 958, 416, 409, 408, -1000, -92, -1000,
+	-1000, -1000, -1000, -1000, 614, 614, 274, 3147, 31446, -1000,
+	-1000, -1000, 28082, 1297, 958, -1000, 1296, -1000, 738, 388,
+	466, 466, 958, -1000, -1000, 30962, 958, 737, 736, 30962,
+	30962, -1000, 27602, -1000, 27122, 26642, 951, 30962, 26162, 25682,
+	25202, 24722, 24242, -1000, 1439, -1000, 1321, -1000, -1000, -1000,
+	30962, 30962, 30962, 230, -1000, -1000, 30962, 958, -1000, -1000,
+	946, 945, 614, 614, 942, 996, 995, 994, 614, 614,
+	933, 993, 22802, 208, 929, 910, 889, 959, 989, 111,
+	943, 941, 879, 30962, 1295, 30962, -1000, 175, 552, 295,
+	633, 1545, 1484, 1231, 363, 420, 958, 320, 320, -1000,
+	7427, -1000, -1000, 988, 12720, -1000, 709, 704, 704, -1000,
+	-1000, -1000, -1000, -1000, -1000, 713, 30962, 709, -1000, -1000,
+	-1000, 704, 713, 30962, 713, 713, 713, 713, 704, 704,
+	704, 713, 30962, 30962, 30962, 30962, 30962, 30962, 30962, 30962,
+	30962, 6943, 6943, 6943, 593, 713, -294, -1000, -1000, -1000,
+	1395, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 92,
+	-1000, -1000, -1000, -1000, -1000, 1692, -1000, -1000, -1000, -115,
+	250, 632, 250, 1229, 23762, -1000, -295, -296, -297, -301,
+	-1000, -1000, -1000, -302, -305, -1000, -1000, -1000, 12720, 12720,
+	12720, 12720, 885, 596, 15600, 834, 597, 15600, 15600, 15600,
+	15600, 15600, 15600, 15600, 15600, 15600, 15600, 15600, 15600, 15600,
+	15600, 15600, 716, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 958, -1000, 1694, 925, 925, 541, 541, 541, 541,
+	541, 541, 541, 541, 541, 16080, 9359, 7427, 1150, 1166,
+	1648, 10799, 10799, 12720, 12720, 11759, 11279, 10799, 1535, 674,
+	605, 30962, -1000, 964, -1000, -1000, 14640, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 30962,
+	30962, 10799, 10799, 10799, 10799, 10799, -1000, 1213, -1000, -180,
+	18001, 12720, 987, 1619, 1150, 1514, 1562, 1684, 572, 831,
+	1208, -1000, 792, 1619, 20402, 1235, -1000, 1514, -1000, -1000,
+	-1000, 30962, -1000, -1000, 23282, -1000, -1000, 6459, 30962, 242,
+	30962, -1000, 1215, 1345, -1000, -1000, -1000, 1590, 19922, 30962,
+	1185, 1147, -1000, -1000, 517, 7911, -65, -1000, 7911, 1193,
+	-1000, -30, -39, 9839, 516, -1000, -1000, -1000, 2824, 16560,
+	1053, 1505, 34, -1000, -1000, -1000, 1265, -1000, 1265, 1265,
+	1265, 1265, 230, 230, 230, 230, -1000, -1000, -1000, -1000,
+	-1000, 1294, 1291, -1000, 1265, 1265, 1265, 1265, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 1290, 1290, 1290, 1269, 1269,
+	306, -1000, 12720, 178, 30962, 1555, 836, 175, 323, 1318,
+	958, 958, 958, 323, -1000, 940, 923, -1000, 1207, -1000,
+	-1000, 1631, -1000, -1000, 454, 774, 770, 505, 30962, 141,
+	241, -1000, 300, -1000, 30962, 958, 719, 466, 958, -1000,
+	958, -1000, -1000, -1000, -1000, 514, -1000, -1000, 958, 1205,
+	-1000, 1244, 811, 765, 795, 751, 1205, -1000, -1000, -137,
+	1205, -1000, 1205, -1000, 1205, -1000, 1205, -1000, 1205, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 579, 30962, 141,
+	716, -1000, 356, -1000, -1000, 716, 716, -1000, -1000, -1000,
+	-1000, 986, 985, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -338, 29188, -1000, 138,
-	617, 195, 230, 188, 29188, 122, 1656, 161, 189, 29188,
-	29188, 295, 1456, 29188, 1647, 29188, -1000, -1000, -1000, -1000,
-	937, 29188, -1000, -1000, 660, 660, -1000, -1000, 29188, 660,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 660, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 993, -1000, -1000, 29188, 29188, -1000, -1000,
-	-1000, -1000, -1000, 62, -57, 198, -1000, -1000, -1000, -1000,
-	1661, -1000, 937, 549, 726, 657, -1000, -1000, 838, -1000,
-	-1000, 1293, -1000, -1000, -1000, -1000, 793, 14492, 14492, 14492,
-	981, 1293, 2435, 2151, 2402, 506, 686, 686, 517, 517,
-	517, 517, 517, 1315, 1315, -1000, -1000, -1000, -1000, 1048,
-	-1000, -1000, -1000, 1048, 11173, 11173, 1296, 1168, 469, -1000,
-	1392, -1000, -1000, 1679, 1184, 1184, 922, 872, 648, 1718,
-	1184, 563, 1717, 1184, 1184, 11173, -1000, -1000, 659, -1000,
-	13070, 1048, -1000, 826, 1295, 1291, 1184, 1048, 1048, 1184,
-	1184, 29188, -1000, -274, -1000, -91, 478, 1168, -1000, 21130,
-	1048, 1211, -1000, 1612, -1000, -1000, 1574, -1000, 1493, 13070,
-	13070, 13070, -1000, -1000, -1000, 1612, 1686, -1000, 1534, 1532,
-	1708, 11173, 20656, 1878, -1000, -1000, -1000, 467, 1708, 1311,
-	1168, -1000, 29188, 20656, 20656, 20656, 20656, 20656, -1000, 1483,
-	1481, -1000, 1473, 1471, 1492, 29188, -1000, 1207, 1167, 18286,
-	209, 1286, 20656, 29188, -1000, -1000, 20656, 29188, 6421, -1000,
-	1290, -56, -47, -1000, -1000, -1000, -1000, 937, -1000, 924,
-	-1000, 243, -1000, 284, -1000, -1000, -1000, -1000, 368, 1651,
-	1582, 2, -1000, -1000, -1000, 217, 217, -1000, -1000, 486,
-	758, 486, 486, 486, 990, 990, -1000, -1000, -1000, -1000,
-	-1000, 869, -1000, -1000, -1000, 859, -1000, -1000, 1026, 1470,
-	140, -1000, -1000, 602, 988, 1592, 29188, -1000, -1000, 1100,
-	138, 29188, 671, 1454, -1000, 1427, 1427, 1427, 29188, -1000,
-	-1000, -1000, -1000, 271, 29188, 1202, -1000, 117, -1000, 1086,
-	29188, -1000, 1194, 1394, 1101, 1101, -1000, -1000, 7849, -1000,
-	29188, 1168, -1000, -1000, -1000, -1000, 389, 1618, 1610, 124,
-	117, 486, 1101, -1000, -1000, -1000, -1000, -1000, -342, 1191,
-	349, 118, 169, 29188, 29188, 29188, 29188, 29188, 434, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 178, 318, -1000, 29188,
-	29188, 408, -1000, -1000, -1000, 668, -1000, -1000, 668, -1000,
-	-1000, -1000, -1000, -1000, -1000, 1607, -62, -312, -1000, -309,
-	-1000, -1000, -1000, -1000, 981, 1293, 1813, -1000, 14492, 14492,
-	-1000, -1000, 1184, 1184, 11173, 7849, 1702, 1612, -1000, -1000,
-	590, 712, 590, 14492, 14492, -1000, 14492, 14492, -1000, -120,
-	1131, 591, -1000, 13070, 818, -1000, -1000, 14492, 14492, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 372, 357,
-	355, 29188, -1000, -1000, -1000, 1001, 986, 1497, 937, 937,
-	-1000, -1000, 29188, -1000, -1000, -1000, -1000, 1706, 13070, -1000,
-	1276, -1000, 5945, 1679, 1452, 29188, 1168, 1744, 15915, 29188,
-	1270, -1000, 615, 1599, 1431, 1451, 1541, -1000, -1000, -1000,
-	-1000, 1479, -1000, 1475, -1000, -1000, -1000, -1000, -1000, 1167,
-	1708, 20656, 1161, -1000, 1161, -1000, 462, -1000, -1000, -1000,
-	-87, -78, -1000, -1000, -1000, 293, -1000, -1000, -1000, -1000,
-	771, 14492, 1737, -1000, 985, -1000, -1000, 696, 682, -1000,
-	29188, 1390, -1000, -1000, -1000, 486, 486, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 1165, -1000, 1147, 1273, 1133, 60,
-	-1000, 1274, 1606, 602, 602, -1000, 858, -1000, 1101, -1000,
-	-1000, 347, -1000, 1638, 29188, 1448, 1447, 1445, -1000, 1696,
-	1272, -1000, 29188, -1000, -1000, 29188, -1000, 1520, 140, 29188,
-	-1000, -1000, -1000, -1000, 208, 29188, -1000, 1166, 117, -1000,
-	-1000, -1000, -1000, -1000, -1000, 29188, 159, -1000, 1389, 1022,
-	-1000, 1422, -1000, -1000, -1000, -1000, 85, 194, -1000, 29188,
-	404, 1470, 29188, -1000, -1000, -1000, 660, 660, -1000, 1605,
-	-1000, 1101, -1000, 14492, 1293, 1293, -1000, -1000, 1048, -1000,
-	1679, -1000, 1048, 1381, 1381, -1000, 1381, 1384, -1000, 1381,
-	64, 1381, 49, 1048, 1048, 2323, 1629, 1549, 1269, 1168,
-	-115, -1000, 937, 13070, 1244, 1193, 1168, 1168, 1168, 1114,
-	979, 217, -1000, -1000, -1000, 1704, 1694, 937, -1000, -1000,
-	-1000, 1627, 1187, 1138, -1000, -1000, 10699, 1116, 1510, 449,
-	1114, 1702, 29188, 13070, -1000, -1000, 13070, 1369, -1000, 13070,
-	-1000, -1000, -1000, 1702, 1702, 1161, -1000, -1000, 455, -1000,
-	-1000, -1000, -1000, -1000, 1293, -30, -1000, -1000, -1000, 1367,
-	14492, -1000, -1000, 217, 976, 217, 822, -1000, 803, -1000,
-	-1000, -213, -1000, -1000, 1388, 1441, -1000, -1000, 29188, -1000,
-	-1000, 29188, 29188, 29188, 29188, 29188, -1000, -1000, 203, -1000,
-	1110, 1108, -1000, -146, -1000, -1000, 1366, -1000, -1000, -1000,
-	1084, -1000, -130, 1101, 29188, 29188, 29188, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 1293, -1000, 1612, -1000, -1000,
-	296, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 14492,
-	14492, 14492, 14492, 14492, 1679, 967, 937, 14492, 14492, 17811,
-	20182, 20182, 17337, 217, -2, -1000, 13070, 13070, 679, -1000,
-	1168, -1000, 1340, 29188, 1168, 29188, -1000, 1679, -1000, 937,
-	937, 29188, 937, 1679, -1000, -1000, 29188, 1096, 486, -1000,
-	486, 1079, 1070, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 1366, -1000, -1000, -1000, 1272, -1000, 201, 237, -1000,
-	208, -1000, -155, -172, 1650, 29188, -1000, -1000, 7849, -1000,
-	-1000, 1294, 1424, -1000, -1000, -1000, -1000, 826, 826, 826,
-	826, 396, 1048, -1000, 826, 826, 1077, -1000, -1000, -1000,
-	1077, 1077, 478, -265, -1000, 1573, 1548, 937, 1211, 1733,
-	-1000, 1168, 1744, 447, 1138, -1000, -1000, 1055, -1000, 1051,
-	-1000, -1000, -1000, -1000, -1000, 1649, 1168, -1000, -1000, -1000,
-	-1000, 1336, 1046, 1181, -1000, 557, 29188, 29188, -1000, -1000,
-	-1000, -1000, 1048, 176, -135, -1000, -1000, -1000, 19708, -1000,
-	-1000, -1000, -1000, -2, 258, -1000, 1547, 1548, -1000, 1692,
-	1552, 1691, -1000, 29188, 1138, 29188, -1000, 1440, 535, 1336,
-	13544, 224, -1000, 7849, 5469, 1028, -1000, -1000, 1491, -123,
-	-139, -1000, -1000, 1546, 1560, 1560, 1547, -1000, 1687, 1685,
-	-1000, 966, 1684, 955, 1121, -1000, 1439, -1000, 1716, -1000,
-	-1000, -1000, 770, 954, -1000, -1000, -1000, 224, 826, 1048,
-	-1000, -52, -1000, -1000, -1000, -1000, -1000, 1422, -1000, 1455,
-	-1000, 1538, 834, -1000, -1000, -1000, -1000, 950, 930, -1000,
-	839, -1000, -1000, 1731, 445, 445, -1000, -1000, -1000, -1000,
-	-1000, 262, -1000, -1000, -130, -131, -1000, 809, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 247, 845, -1000, 142,
-	-1000, -143, -1000, -1000, -1000, -1000, -1000, -1000, -140, -1000,
+	-351, 30962, -1000, 157, 630, 232, 247, 213, 30962, 140,
+	1593, 160, 217, 30962, 30962, 320, 1375, 30962, 1569, 30962,
+	-1000, -1000, -1000, -1000, 605, 30962, -1000, -1000, 713, 713,
+	-1000, -1000, 30962, 713, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 713, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 984, -1000, -1000,
+	30962, 30962, -1000, -1000, -1000, -1000, -1000, -1000, 52, -1000,
+	56, -32, 197, -1000, -1000, -1000, -1000, 1599, -1000, 605,
+	596, 627, 608, -1000, -1000, 891, -1000, -1000, 2985, -1000,
+	-1000, -1000, -1000, 834, 15600, 15600, 15600, 509, 2985, 2955,
+	1842, 1066, 541, 603, 603, 543, 543, 543, 543, 543,
+	641, 641, -1000, -1000, -1000, -1000, 964, -1000, -1000, -1000,
+	964, 10799, 10799, 1200, 1227, 507, -1000, 1283, -1000, -1000,
+	1619, 1063, 1063, 744, 757, 648, 1664, 1063, 635, 1660,
+	1063, 1063, 10799, -1000, -1000, 669, -1000, 12720, 964, -1000,
+	1298, 1197, 1195, 1063, 964, 964, 1063, 1063, 30962, -1000,
+	-284, -1000, -46, 428, 1227, -1000, 22802, 964, 1067, -1000,
+	1541, -1000, -1000, 1512, -1000, 1442, 12720, 12720, 12720, -1000,
+	-1000, -1000, 1541, 1616, -1000, 1462, 1453, 1658, 10799, 22322,
+	1514, -1000, -1000, -1000, 474, 1658, 1226, 1227, -1000, 30962,
+	22322, 22322, 22322, 22322, 22322, -1000, 1410, 1409, -1000, 1408,
+	1407, 1436, 30962, -1000, 1137, 1150, 19922, 242, 1074, 22322,
+	30962, -1000, -1000, 22322, 30962, 5975, -1000, 1193, -65, -52,
+	-1000, -1000, -1000, -1000, 605, -1000, 917, -1000, 2303, -1000,
+	308, -1000, -1000, -1000, -1000, 435, 1588, 1501, 30, -1000,
+	-1000, -1000, 230, 230, -1000, -1000, 516, 759, 516, 516,
+	516, 983, 983, -1000, -1000, -1000, -1000, -1000, 835, -1000,
+	-1000, -1000, 833, -1000, -1000, 748, 1372, 178, -1000, -1000,
+	614, 973, 1509, 30962, -1000, -1000, 1032, 157, 30962, 684,
+	1340, -1000, 1318, 1318, 1318, 30962, -1000, -1000, -1000, -1000,
+	4016, 30962, 1119, -1000, 134, -1000, 1024, 30962, -1000, 1082,
+	1286, 958, 958, -1000, -1000, 7427, -1000, 30962, 1227, -1000,
+	-1000, -1000, -1000, 419, 1540, 1536, 141, 134, 516, 958,
+	-1000, -1000, -1000, -1000, -1000, -354, 1078, 380, 153, 181,
+	30962, 30962, 30962, 30962, 30962, 496, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 191, 352, -1000, 30962, 30962, 491, -1000,
+	-1000, -1000, 704, -1000, -1000, 704, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 1533, -35, -322, -1000, -317, -1000, -1000,
+	-1000, -1000, 509, 2985, 2904, -1000, 15600, 15600, -1000, -264,
+	1063, 1063, 10799, 7427, 1648, 1541, -1000, -1000, 340, 716,
+	340, 15600, 15600, -1000, 15600, 15600, -1000, -105, 1212, 628,
+	-1000, 12720, 689, -1000, -1000, 15600, 15600, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 401, 387, 385, 30962,
+	-1000, -1000, -1000, 953, 971, 1422, 605, 605, -1000, -1000,
+	30962, -1000, -1000, -1000, -1000, 1656, 12720, -1000, 1190, -1000,
+	5491, 1619, 1333, 30962, 1227, 1692, 17521, 30962, 1179, -1000,
+	629, 1345, 1314, 1332, 1292, -1000, -1000, -1000, -1000, 1406,
+	-1000, 1355, -1000, -1000, -1000, -1000, -1000, 1150, 1658, 22322,
+	1097, -1000, 1097, -1000, 470, -1000, -1000, -1000, -51, -58,
+	-1000, -1000, -1000, 2824, -1000, -1000, -1000, -1000, 791, 15600,
+	1683, -1000, 970, -1000, -1000, 710, 707, -1000, 30962, 1273,
+	-1000, -1000, -1000, 516, 516, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 1059, -1000, 1052, 1189, 1050, 62, -1000, 1302,
+	1532, 614, 614, -1000, 830, -1000, 958, -1000, -1000, 370,
+	-1000, 1566, 30962, 1331, 1330, 1324, -1000, 1630, 1182, -1000,
+	30962, -1000, -1000, 30962, -1000, 1452, 178, 30962, -1000, -1000,
+	-1000, -1000, 241, 30962, -1000, 925, 134, -1000, -1000, -1000,
+	-1000, -1000, -1000, 30962, 152, -1000, 1272, 944, -1000, 1316,
+	-1000, -1000, -1000, -1000, 126, 231, -1000, 30962, 452, 1372,
+	30962, -1000, -1000, -1000, 713, 713, -1000, 1531, -1000, 958,
+	-1000, 15600, 2985, 2985, -1000, -1000, 22322, -1000, -1000, 964,
+	-1000, 1619, -1000, 964, 1265, 1265, -1000, 1265, 1269, -1000,
+	1265, 90, 1265, 89, 964, 964, 2494, 2439, 2232, 1344,
+	1227, -99, -1000, 605, 12720, 2207, 1029, 1227, 1227, 1227,
+	1045, 969, 230, -1000, -1000, -1000, 1652, 1629, 605, -1000,
+	-1000, -1000, 1542, 1187, 1080, -1000, -1000, 10319, 1048, 1449,
+	468, 1045, 1648, 30962, 12720, -1000, -1000, 12720, 1264, -1000,
+	12720, -1000, -1000, -1000, 1648, 1648, 1097, -1000, -1000, 553,
+	-1000, -1000, -1000, -1000, -1000, 2985, -139, -1000, -1000, -1000,
+	1262, 15600, -1000, -1000, 230, 968, 230, 829, -1000, 813,
+	-1000, -1000, -211, -1000, -1000, 1304, 1388, -1000, -1000, 30962,
+	-1000, -1000, 30962, 30962, 30962, 30962, 30962, -1000, -1000, 237,
+	-1000, 1042, 1038, -1000, -176, -1000, -1000, 1261, -1000, -1000,
+	-1000, 1022, -1000, -138, 958, 30962, 30962, 30962, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 2985, 958, -1000, -1000,
+	1541, -1000, -1000, 284, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 15600, 15600, 15600, 15600, 15600, 1619, 967, 605,
+	15600, 15600, 19441, 21842, 21842, 18961, 230, 21, -268, 12720,
+	12720, 706, -1000, 1227, -1000, 1184, 30962, 1227, 30962, -1000,
+	1619, -1000, 605, 605, 30962, 605, 1619, -1000, -1000, 30962,
+	1313, 516, -1000, 516, 1017, 1005, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 1261, -1000, -1000, -1000, 1182, -1000,
+	233, 290, -1000, 241, -1000, -178, -179, 1583, 30962, -1000,
+	-1000, 7427, -1000, -1000, 1260, 1317, -1000, 964, 240, -1000,
+	-1000, -1000, -1000, 1298, 1298, 1298, 1298, 207, 964, -1000,
+	1298, 1298, 1036, -1000, -1000, -1000, 1036, 1036, 428, -276,
+	-1000, 1480, 1460, -1000, 30962, 605, 1067, 1682, -1000, 1227,
+	1692, 464, 1080, -1000, -1000, 1031, -1000, 1021, -1000, -1000,
+	-1000, -1000, -1000, 1578, 1227, -1000, -1000, -1000, -1000, 1271,
+	1015, 1181, -1000, 609, 30962, 30962, -1000, 1648, 1628, -1000,
+	-1000, -1000, -1000, 964, 150, -153, -1000, -1000, -1000, 21362,
+	-1000, -1000, -1000, -1000, 21, 287, -1000, 1496, 1460, -1000,
+	1627, 1476, 1626, -1000, 1176, -1000, 1572, 30962, 1080, 30962,
+	-1000, 1322, 567, 1271, 14160, 209, -1000, 7427, 5007, 1008,
+	-1000, -263, 12720, -1000, 1419, -133, -163, -1000, -1000, 1469,
+	1472, 1472, 1496, -1000, 1625, 1623, -1000, 966, 1622, 965,
+	30962, 1227, 1069, -1000, 1300, -1000, 1670, -1000, -1000, -1000,
+	790, 963, -1000, -1000, -1000, 209, 1298, 964, -1000, -57,
+	-1000, -1000, -1000, -1000, -1000, 1316, -1000, 13680, 13200, 1067,
+	-1000, 1418, -1000, 1467, 893, -1000, -1000, -1000, -1000, 962,
+	961, -1000, 957, -1000, -1000, 958, -1000, 1679, 477, 477,
+	-1000, -1000, -1000, -1000, -1000, 299, -1000, -1000, -138, -1000,
+	15120, -272, 755, 625, -1000, 15120, -142, -1000, 819, -1000,
+	-1000, -1000, -1000, -1000, 964, -1000, -1000, -1000, 296, 900,
+	-1000, 167, -1000, 701, -1000, -1000, -1000, -1000, -1000, 697,
+	-173, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 15120, 15120,
+	-166, -1000, -1000, -1000,
 }
 
 var yyPgo = [...]int{
-	0, 2060, 2059, 11, 101, 99, 2058, 2057, 2054, 2049,
-	135, 130, 125, 2048, 2047, 2046, 2045, 2044, 2042, 2041,
-	2039, 2038, 2036, 2035, 2034, 66, 158, 38, 51, 142,
-	2033, 2032, 36, 2029, 2028, 2027, 128, 126, 579, 2026,
-	127, 68, 2025, 2024, 2023, 2022, 2020, 2019, 2013, 2012,
-	2011, 2010, 2008, 2005, 2004, 2003, 204, 2002, 2000, 4,
-	1997, 35, 1991, 1990, 1986, 1984, 1982, 100, 1981, 1979,
-	1976, 122, 1974, 1973, 57, 274, 50, 77, 1972, 1971,
-	90, 134, 1970, 71, 106, 1969, 1968, 93, 1967, 43,
-	79, 85, 1965, 46, 1964, 1961, 63, 1942, 1938, 1937,
-	86, 1934, 1933, 2428, 1932, 78, 89, 16, 53, 1930,
-	1927, 1924, 1923, 39, 47, 1922, 1920, 32, 1918, 1917,
-	136, 1916, 87, 18, 1911, 13, 19, 24, 1909, 98,
-	1903, 44, 37, 1902, 94, 1901, 1897, 1896, 1895, 33,
-	1894, 82, 107, 115, 1893, 1892, 1891, 17, 15, 1890,
-	1889, 1885, 1883, 1872, 1868, 10, 1865, 14, 1864, 27,
-	1860, 26, 20, 41, 81, 80, 29, 6, 1859, 118,
-	1857, 23, 117, 76, 119, 1850, 1848, 1847, 958, 147,
-	1845, 1844, 48, 1843, 102, 104, 1842, 175, 1839, 1838,
-	59, 1305, 2360, 31, 132, 1836, 1835, 2192, 69, 88,
-	28, 1832, 105, 1828, 1827, 1826, 143, 139, 56, 888,
-	61, 1824, 1823, 1821, 1817, 1815, 1813, 1812, 83, 25,
-	34, 109, 30, 1811, 1810, 1809, 73, 65, 1808, 113,
-	112, 74, 124, 1807, 121, 103, 64, 1806, 40, 1804,
-	1803, 1802, 1799, 58, 1798, 1797, 1796, 1795, 111, 110,
-	72, 42, 1790, 45, 75, 116, 114, 1789, 22, 141,
-	8, 1788, 1, 0, 1786, 3, 133, 174, 108, 1785,
-	1781, 2, 1778, 5, 1777, 1763, 91, 1761, 1760, 1759,
-	9, 21, 7, 1758, 1757, 3290, 437, 120, 1753, 129,
+	0, 1954, 1953, 13, 91, 103, 1952, 126, 1950, 1949,
+	1713, 1711, 1705, 1948, 1947, 1946, 1945, 1944, 1942, 1932,
+	1930, 1925, 1924, 1923, 1922, 66, 124, 39, 47, 141,
+	1920, 1919, 35, 1918, 1914, 1912, 150, 149, 616, 1905,
+	131, 72, 1904, 1903, 1902, 1899, 1897, 1896, 1895, 1894,
+	1892, 1891, 1890, 1889, 1888, 1886, 251, 1885, 1883, 8,
+	1882, 44, 1881, 128, 1878, 1877, 1876, 1875, 102, 1873,
+	1872, 1871, 1870, 1869, 123, 59, 275, 68, 90, 1867,
+	1866, 89, 127, 1864, 73, 109, 1863, 1862, 199, 1860,
+	50, 82, 120, 1859, 53, 1858, 1857, 65, 1854, 1852,
+	1851, 85, 1850, 1849, 2964, 1848, 79, 88, 30, 27,
+	1846, 1845, 1844, 1843, 40, 425, 1842, 1841, 32, 1839,
+	1838, 140, 1837, 1836, 3, 1834, 1833, 1829, 2, 7,
+	1827, 1826, 1825, 97, 10, 1824, 21, 34, 26, 1823,
+	94, 1822, 36, 38, 1821, 93, 1817, 1814, 1813, 1812,
+	22, 1811, 86, 117, 144, 1810, 1809, 1808, 11, 19,
+	1806, 1805, 1804, 1803, 1802, 1801, 18, 1798, 9, 1797,
+	51, 1795, 45, 28, 29, 81, 67, 37, 23, 1794,
+	116, 1793, 33, 111, 78, 119, 1792, 1791, 1789, 979,
+	147, 1788, 1786, 57, 1785, 96, 105, 1784, 164, 1783,
+	1782, 75, 1098, 2231, 17, 122, 1781, 1779, 2931, 58,
+	92, 25, 1777, 69, 1776, 1774, 1772, 129, 125, 63,
+	914, 64, 1766, 1765, 1764, 1763, 1762, 1760, 1759, 137,
+	101, 31, 107, 43, 1758, 1756, 1755, 83, 46, 1754,
+	110, 108, 80, 145, 1753, 121, 104, 70, 1750, 84,
+	1749, 1748, 1747, 1746, 61, 1744, 1743, 1739, 1738, 113,
+	106, 74, 41, 1737, 42, 76, 114, 115, 1736, 20,
+	138, 12, 1735, 1, 0, 1734, 6, 130, 148, 136,
+	1733, 1730, 4, 1729, 5, 1726, 1724, 87, 1719, 1718,
+	1716, 16, 24, 15, 1715, 1703, 1969, 730, 118, 1700,
+	132,
 }
 
-//line sql.y:5330
+//line sql.y:5531
 type yySymType struct {
-	union             interface{}
-	empty             struct{}
+	union interface{}
+	empty struct{}
+	// pos is the position of the first token of a symbol, counting from 1.
+	// It is set by the lexer and carried to the symbol by the default action.
+	pos               int
 	LengthScaleOption LengthScaleOption
 	tableName         TableName
 	tableIdent        TableIdent
@@ -4515,6 +4773,16 @@ func (st *yySymType) exprsUnion() Exprs {
 	return v
 }
 
+func (st *yySymType) frameClauseUnion() *FrameClause {
+	v, _ := st.union.(*FrameClause)
+	return v
+}
+
+func (st *yySymType) framePointUnion() *FramePoint {
+	v, _ := st.union.(*FramePoint)
+	return v
+}
+
 func (st *yySymType) ignoreUnion() Ignore {
 	v, _ := st.union.(Ignore)
 	return v
@@ -4605,6 +4873,16 @@ func (st *yySymType) matchExprOptionUnion() MatchExprOption {
 	return v
 }
 
+func (st *yySymType) namedWindowUnion() *NamedWindow {
+	v, _ := st.union.(*NamedWindow)
+	return v
+}
+
+func (st *yySymType) namedWindowsUnion() NamedWindows {
+	v, _ := st.union.(NamedWindows)
+	return v
+}
+
 func (st *yySymType) optLikeUnion() *OptLike {
 	v, _ := st.union.(*OptLike)
 	return v
@@ -4630,6 +4908,11 @@ func (st *yySymType) orderDirectionUnion() OrderDirection {
 	return v
 }
 
+func (st *yySymType) overClauseUnion() *OverClause {
+	v, _ := st.union.(*OverClause)
+	return v
+}
+
 func (st *yySymType) partDefUnion() *PartitionDefinition {
 	v, _ := st.union.(*PartitionDefinition)
 	return v
@@ -4760,6 +5043,11 @@ func (st *yySymType) tableSpecUnion() *TableSpec {
 	return v
 }
 
+func (st *yySymType) unionSelectUnion() *UnionSelect {
+	v, _ := st.union.(*UnionSelect)
+	return v
+}
+
 func (st *yySymType) updateExprUnion() *UpdateExpr {
 	v, _ := st.union.(*UpdateExpr)
 	return v
@@ -4795,148 +5083,157 @@ func (st *yySymType) whensUnion() []*When {
 	return v
 }
 
+func (st *yySymType) windowSpecUnion() *WindowSpecification {
+	v, _ := st.union.(*WindowSpecification)
+	return v
+}
+
 var yyR1 = [...]int{
-	0, 283, 284, 284, 1, 1, 1, 1, 1, 1,
+	0, 294, 295, 295, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 263, 263, 263, 266, 266,
+	1, 1, 1, 1, 1, 274, 274, 274, 277, 277,
 	21, 51, 3, 3, 3, 3, 2, 2, 8, 9,
-	4, 5, 5, 10, 10, 63, 63, 11, 12, 12,
-	12, 12, 287, 287, 98, 98, 96, 96, 97, 97,
-	164, 164, 13, 14, 14, 174, 174, 173, 173, 173,
-	175, 175, 175, 175, 209, 209, 15, 15, 15, 15,
-	15, 72, 72, 265, 265, 264, 262, 262, 261, 261,
-	260, 23, 24, 33, 33, 33, 33, 34, 35, 267,
-	267, 239, 39, 39, 38, 38, 38, 38, 40, 40,
-	37, 37, 36, 36, 241, 241, 228, 228, 240, 240,
-	240, 240, 240, 240, 240, 227, 227, 86, 86, 145,
-	145, 145, 145, 145, 145, 145, 145, 279, 279, 146,
-	146, 146, 146, 146, 146, 280, 280, 280, 280, 211,
-	211, 211, 211, 214, 214, 212, 212, 212, 212, 212,
-	212, 212, 212, 212, 213, 213, 213, 213, 213, 215,
-	215, 215, 215, 215, 216, 216, 216, 216, 216, 216,
-	216, 216, 216, 216, 216, 216, 216, 216, 216, 217,
-	217, 217, 217, 217, 217, 217, 217, 226, 226, 218,
-	218, 221, 221, 222, 222, 222, 223, 223, 223, 224,
-	224, 219, 219, 219, 219, 220, 220, 220, 229, 253,
-	253, 252, 252, 250, 250, 250, 250, 238, 238, 247,
-	247, 247, 247, 247, 237, 237, 233, 233, 233, 234,
-	234, 235, 235, 232, 232, 236, 236, 249, 249, 248,
-	230, 230, 231, 231, 255, 281, 281, 281, 281, 282,
-	282, 256, 272, 273, 271, 271, 271, 271, 271, 61,
-	61, 61, 186, 186, 186, 245, 245, 244, 244, 244,
-	246, 246, 243, 243, 243, 243, 243, 243, 243, 243,
-	243, 243, 243, 243, 243, 243, 243, 243, 243, 243,
-	243, 243, 243, 243, 243, 243, 243, 243, 243, 243,
-	181, 181, 181, 270, 270, 270, 270, 270, 270, 269,
-	269, 269, 242, 242, 242, 268, 268, 41, 41, 132,
-	132, 30, 30, 30, 30, 30, 30, 29, 29, 29,
+	4, 5, 5, 10, 10, 64, 64, 11, 12, 12,
+	12, 12, 298, 298, 99, 99, 97, 97, 98, 98,
+	175, 175, 13, 14, 14, 185, 185, 184, 184, 184,
+	186, 186, 186, 186, 220, 220, 15, 15, 15, 15,
+	15, 72, 72, 276, 276, 275, 273, 273, 272, 272,
+	271, 23, 24, 33, 33, 33, 33, 34, 35, 278,
+	278, 250, 39, 39, 38, 38, 38, 38, 40, 40,
+	37, 37, 36, 36, 252, 252, 239, 239, 251, 251,
+	251, 251, 251, 251, 251, 238, 238, 87, 87, 156,
+	156, 156, 156, 156, 156, 156, 156, 290, 290, 157,
+	157, 157, 157, 157, 157, 291, 291, 291, 291, 222,
+	222, 222, 222, 225, 225, 223, 223, 223, 223, 223,
+	223, 223, 223, 223, 224, 224, 224, 224, 224, 226,
+	226, 226, 226, 226, 227, 227, 227, 227, 227, 227,
+	227, 227, 227, 227, 227, 227, 227, 227, 227, 228,
+	228, 228, 228, 228, 228, 228, 228, 237, 237, 229,
+	229, 232, 232, 233, 233, 233, 234, 234, 234, 235,
+	235, 230, 230, 230, 230, 231, 231, 231, 240, 264,
+	264, 263, 263, 261, 261, 261, 261, 249, 249, 258,
+	258, 258, 258, 258, 248, 248, 244, 244, 244, 245,
+	245, 246, 246, 243, 243, 247, 247, 260, 260, 259,
+	241, 241, 242, 242, 266, 292, 292, 292, 292, 293,
+	293, 267, 283, 284, 282, 282, 282, 282, 282, 61,
+	61, 61, 197, 197, 197, 256, 256, 255, 255, 255,
+	257, 257, 254, 254, 254, 254, 254, 254, 254, 254,
+	254, 254, 254, 254, 254, 254, 254, 254, 254, 254,
+	254, 254, 254, 254, 254, 254, 254, 254, 254, 254,
+	192, 192, 192, 281, 281, 281, 281, 281, 281, 280,
+	280, 280, 253, 253, 253, 279, 279, 41, 41, 143,
+	143, 30, 30, 30, 30, 30, 30, 29, 29, 29,
 	25, 25, 25, 25, 25, 25, 25, 25, 25, 25,
 	25, 25, 25, 25, 25, 25, 25, 25, 25, 25,
 	25, 25, 31, 31, 26, 26, 26, 26, 26, 26,
 	26, 26, 26, 16, 16, 16, 16, 16, 16, 16,
 	16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-	16, 16, 259, 259, 259, 259, 259, 259, 259, 259,
-	259, 259, 259, 259, 259, 259, 259, 259, 259, 259,
-	259, 259, 259, 259, 225, 225, 225, 257, 257, 258,
-	258, 17, 22, 22, 18, 18, 18, 18, 19, 19,
+	16, 16, 270, 270, 270, 270, 270, 270, 270, 270,
+	270, 270, 270, 270, 270, 270, 270, 270, 270, 270,
+	270, 270, 270, 270, 236, 236, 236, 268, 268, 269,
+	269, 17, 22, 22, 18, 18, 18, 18, 19, 19,
 	42, 43, 43, 43, 43, 43, 43, 43, 43, 43,
 	43, 43, 43, 43, 43, 43, 43, 43, 43, 43,
 	43, 43, 43, 43, 43, 43, 43, 43, 43, 43,
 	43, 43, 43, 43, 43, 43, 43, 43, 43, 43,
-	43, 43, 43, 43, 43, 43, 43, 43, 274, 274,
-	180, 180, 188, 188, 179, 179, 202, 202, 202, 182,
-	182, 182, 183, 183, 278, 278, 278, 44, 44, 46,
-	46, 47, 48, 48, 204, 204, 205, 205, 49, 50,
-	62, 62, 62, 62, 62, 62, 64, 64, 64, 7,
-	7, 7, 7, 58, 58, 58, 6, 6, 45, 45,
-	52, 275, 275, 276, 277, 277, 277, 277, 53, 55,
-	20, 20, 20, 20, 20, 20, 79, 79, 67, 67,
-	67, 67, 67, 67, 67, 67, 67, 67, 67, 67,
-	73, 73, 73, 68, 68, 288, 56, 57, 57, 71,
-	71, 71, 65, 65, 65, 70, 70, 70, 76, 76,
-	78, 78, 78, 78, 78, 80, 80, 80, 80, 80,
-	80, 80, 75, 75, 77, 77, 77, 77, 195, 195,
-	195, 194, 194, 88, 88, 89, 89, 90, 90, 91,
-	91, 91, 130, 106, 106, 162, 162, 161, 161, 163,
-	163, 163, 163, 165, 165, 92, 92, 92, 92, 93,
-	93, 94, 94, 95, 95, 203, 203, 200, 200, 200,
-	199, 199, 99, 99, 99, 101, 100, 100, 100, 100,
-	102, 102, 104, 104, 103, 103, 105, 107, 107, 107,
-	107, 107, 108, 108, 87, 87, 87, 87, 87, 87,
-	87, 87, 177, 177, 110, 110, 109, 109, 109, 109,
-	109, 109, 109, 109, 109, 109, 121, 121, 121, 121,
-	121, 121, 111, 111, 111, 111, 111, 111, 111, 74,
-	74, 122, 122, 122, 129, 123, 123, 114, 114, 114,
-	114, 114, 114, 114, 114, 114, 114, 114, 114, 114,
-	114, 114, 114, 114, 114, 114, 114, 114, 114, 114,
-	114, 114, 114, 114, 114, 114, 114, 114, 114, 114,
-	114, 118, 118, 118, 118, 116, 116, 116, 116, 116,
-	116, 116, 116, 116, 116, 116, 116, 116, 116, 117,
-	117, 117, 117, 117, 117, 117, 117, 117, 117, 117,
-	117, 117, 117, 117, 117, 289, 289, 120, 119, 119,
-	119, 119, 119, 119, 119, 69, 69, 69, 69, 69,
-	208, 208, 208, 210, 210, 210, 210, 210, 210, 210,
-	210, 210, 210, 210, 210, 210, 135, 135, 66, 66,
-	133, 133, 134, 136, 136, 131, 131, 131, 113, 113,
-	113, 113, 113, 113, 113, 113, 115, 115, 115, 137,
-	137, 138, 138, 139, 139, 140, 140, 141, 142, 142,
-	142, 143, 143, 143, 143, 32, 32, 32, 32, 32,
-	27, 27, 27, 27, 28, 28, 28, 81, 81, 81,
-	81, 83, 83, 82, 82, 59, 59, 60, 60, 60,
-	84, 84, 85, 85, 85, 85, 159, 159, 159, 144,
-	144, 144, 144, 151, 151, 151, 147, 147, 149, 149,
-	149, 150, 150, 150, 148, 156, 156, 158, 158, 157,
-	157, 153, 153, 154, 154, 155, 155, 155, 152, 152,
-	112, 112, 112, 112, 112, 160, 160, 160, 160, 166,
-	166, 125, 125, 127, 127, 126, 128, 167, 167, 171,
-	168, 168, 172, 172, 172, 172, 172, 169, 169, 170,
-	170, 196, 196, 196, 176, 176, 187, 187, 184, 184,
-	185, 185, 178, 178, 189, 189, 189, 54, 124, 124,
-	254, 254, 251, 192, 192, 193, 193, 197, 197, 201,
-	201, 198, 198, 190, 190, 190, 190, 190, 190, 190,
-	190, 190, 190, 190, 190, 190, 190, 190, 190, 190,
-	190, 190, 190, 190, 190, 190, 190, 190, 190, 190,
-	190, 190, 190, 190, 190, 190, 190, 190, 190, 190,
-	190, 190, 190, 190, 190, 190, 190, 190, 190, 190,
-	190, 190, 190, 190, 190, 190, 190, 190, 190, 190,
-	190, 190, 190, 190, 190, 190, 190, 190, 190, 190,
-	190, 190, 190, 190, 190, 190, 190, 190, 190, 190,
-	190, 190, 190, 190, 190, 190, 190, 190, 190, 190,
-	190, 190, 190, 190, 190, 190, 190, 190, 190, 190,
-	190, 190, 190, 190, 190, 190, 190, 190, 190, 190,
-	190, 190, 190, 190, 190, 190, 190, 190, 190, 190,
-	190, 190, 190, 190, 190, 190, 190, 190, 190, 190,
-	190, 190, 190, 190, 190, 190, 190, 190, 190, 190,
-	190, 190, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 285, 286, 206, 207,
-	207, 207,
+	43, 43, 43, 43, 43, 43, 43, 43, 43, 43,
+	285, 285, 191, 191, 199, 199, 190, 190, 213, 213,
+	213, 193, 193, 193, 194, 194, 289, 289, 289, 44,
+	44, 46, 46, 47, 48, 48, 215, 215, 216, 216,
+	49, 50, 62, 62, 62, 62, 62, 62, 63, 63,
+	65, 65, 65, 7, 7, 7, 7, 58, 58, 58,
+	6, 6, 6, 6, 45, 45, 52, 286, 286, 287,
+	288, 288, 288, 288, 53, 55, 20, 20, 20, 20,
+	20, 20, 80, 80, 68, 68, 68, 68, 68, 68,
+	68, 68, 68, 68, 68, 68, 73, 73, 73, 69,
+	69, 299, 56, 57, 57, 74, 74, 74, 74, 74,
+	74, 74, 74, 74, 66, 66, 66, 71, 71, 71,
+	77, 77, 79, 79, 79, 79, 79, 81, 81, 81,
+	81, 81, 81, 81, 76, 76, 78, 78, 78, 78,
+	206, 206, 206, 205, 205, 89, 89, 90, 90, 91,
+	91, 92, 92, 92, 141, 107, 107, 173, 173, 172,
+	172, 174, 174, 174, 174, 176, 176, 93, 93, 93,
+	93, 94, 94, 95, 95, 96, 96, 214, 214, 211,
+	211, 211, 210, 210, 100, 100, 100, 102, 101, 101,
+	101, 101, 103, 103, 105, 105, 104, 104, 106, 108,
+	108, 108, 108, 108, 109, 109, 88, 88, 88, 88,
+	88, 88, 88, 88, 188, 188, 111, 111, 110, 110,
+	110, 110, 110, 110, 110, 110, 110, 110, 132, 132,
+	132, 132, 132, 132, 112, 112, 112, 112, 112, 112,
+	112, 75, 75, 133, 133, 133, 140, 134, 134, 115,
+	115, 115, 115, 115, 115, 115, 115, 115, 115, 115,
+	115, 115, 115, 115, 115, 115, 115, 115, 115, 115,
+	115, 115, 115, 115, 115, 115, 115, 115, 115, 115,
+	115, 115, 115, 119, 119, 119, 119, 123, 123, 122,
+	122, 124, 125, 125, 126, 126, 127, 127, 127, 127,
+	127, 128, 128, 128, 128, 128, 130, 130, 131, 131,
+	129, 117, 117, 117, 117, 117, 117, 117, 117, 117,
+	117, 117, 117, 117, 117, 118, 118, 118, 118, 118,
+	118, 118, 118, 118, 118, 118, 118, 118, 118, 118,
+	118, 300, 300, 121, 120, 120, 120, 120, 120, 120,
+	120, 70, 70, 70, 70, 70, 219, 219, 219, 221,
+	221, 221, 221, 221, 221, 221, 221, 221, 221, 221,
+	221, 221, 146, 146, 67, 67, 144, 144, 145, 147,
+	147, 142, 142, 142, 114, 114, 114, 114, 114, 114,
+	114, 114, 116, 116, 116, 148, 148, 149, 149, 150,
+	150, 151, 151, 152, 153, 153, 153, 154, 154, 154,
+	154, 32, 32, 32, 32, 32, 27, 27, 27, 27,
+	28, 28, 28, 82, 82, 82, 82, 84, 84, 83,
+	83, 59, 59, 60, 60, 60, 85, 85, 86, 86,
+	86, 86, 170, 170, 170, 155, 155, 155, 155, 162,
+	162, 162, 158, 158, 160, 160, 160, 161, 161, 161,
+	159, 167, 167, 169, 169, 168, 168, 164, 164, 165,
+	165, 166, 166, 166, 163, 163, 113, 113, 113, 113,
+	113, 171, 171, 171, 171, 177, 177, 136, 136, 138,
+	138, 137, 139, 178, 178, 182, 179, 179, 183, 183,
+	183, 183, 183, 180, 180, 181, 181, 207, 207, 207,
+	187, 187, 198, 198, 195, 195, 196, 196, 189, 189,
+	200, 200, 200, 54, 135, 135, 265, 265, 262, 203,
+	203, 204, 204, 208, 208, 212, 212, 209, 209, 201,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	202, 202, 202, 202, 202, 202, 202, 202, 202, 202,
+	202, 202, 202, 202, 202, 202, 202, 202, 202, 202,
+	202, 202, 202, 202, 202, 202, 202, 202, 202, 202,
+	202, 202, 202, 202, 202, 202, 202, 202, 202, 202,
+	202, 202, 202, 202, 202, 202, 202, 202, 202, 202,
+	202, 202, 202, 202, 202, 202, 202, 202, 202, 202,
+	202, 202, 202, 202, 202, 202, 202, 202, 202, 202,
+	202, 202, 202, 202, 202, 202, 202, 202, 202, 202,
+	202, 202, 202, 202, 202, 202, 202, 202, 202, 202,
+	202, 202, 202, 202, 202, 202, 202, 202, 202, 202,
+	202, 202, 202, 202, 202, 202, 202, 202, 202, 202,
+	202, 202, 202, 202, 202, 202, 202, 202, 202, 202,
+	202, 202, 202, 202, 202, 202, 202, 202, 202, 202,
+	202, 202, 202, 202, 202, 202, 202, 202, 202, 202,
+	202, 202, 202, 202, 202, 202, 202, 202, 202, 202,
+	202, 202, 202, 202, 202, 202, 202, 202, 202, 202,
+	202, 202, 202, 202, 202, 202, 202, 202, 202, 202,
+	202, 202, 202, 202, 202, 202, 202, 202, 202, 202,
+	202, 202, 202, 202, 202, 202, 202, 202, 202, 202,
+	202, 202, 202, 202, 202, 202, 202, 202, 202, 202,
+	202, 202, 202, 202, 202, 202, 202, 202, 202, 202,
+	202, 202, 202, 202, 202, 202, 202, 202, 202, 202,
+	202, 202, 202, 202, 202, 202, 202, 202, 202, 202,
+	202, 202, 202, 202, 202, 202, 202, 202, 202, 202,
+	202, 202, 202, 202, 202, 202, 202, 202, 202, 202,
+	202, 202, 202, 202, 202, 202, 202, 202, 202, 202,
+	202, 202, 202, 202, 202, 202, 202, 202, 202, 202,
+	202, 202, 202, 202, 202, 202, 202, 202, 202, 202,
+	202, 296, 297, 217, 218, 218, 218,
 }
 
 var yyR2 = [...]int{
@@ -4945,7 +5242,7 @@ var yyR2 = [...]int{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 0, 1, 1, 1, 0, 1,
 	2, 3, 5, 6, 6, 7, 4, 6, 5, 7,
-	8, 1, 3, 7, 8, 1, 1, 9, 9, 8,
+	9, 1, 3, 7, 8, 1, 1, 9, 9, 8,
 	7, 7, 1, 1, 1, 3, 1, 3, 1, 3,
 	0, 4, 3, 5, 4, 1, 3, 3, 2, 2,
 	2, 2, 2, 1, 1, 1, 2, 2, 6, 12,
@@ -4988,56 +5285,60 @@ var yyR2 = [...]int{
 	5, 2, 4, 4, 4, 4, 4, 5, 5, 4,
 	4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
 	2, 4, 2, 4, 5, 4, 4, 4, 4, 4,
-	3, 3, 5, 2, 3, 3, 3, 3, 1, 1,
-	0, 1, 0, 1, 1, 1, 0, 2, 2, 0,
-	2, 2, 0, 2, 0, 1, 1, 2, 1, 1,
-	2, 1, 1, 5, 0, 1, 0, 1, 2, 3,
-	0, 3, 3, 3, 3, 1, 1, 1, 1, 1,
-	1, 1, 1, 0, 1, 1, 3, 3, 2, 2,
-	3, 1, 3, 2, 1, 2, 1, 2, 2, 4,
-	3, 3, 6, 4, 7, 6, 1, 3, 2, 2,
-	2, 2, 1, 1, 1, 3, 2, 1, 1, 1,
-	0, 1, 1, 0, 3, 0, 2, 0, 2, 1,
-	2, 2, 0, 1, 1, 0, 1, 1, 0, 1,
-	0, 1, 2, 3, 4, 1, 1, 1, 1, 1,
-	1, 1, 1, 3, 1, 2, 3, 5, 0, 1,
-	2, 1, 1, 0, 2, 1, 3, 1, 1, 1,
-	3, 3, 3, 3, 7, 0, 3, 1, 3, 1,
-	1, 3, 3, 1, 3, 4, 4, 4, 3, 2,
-	4, 0, 1, 0, 2, 0, 1, 0, 1, 2,
-	1, 1, 1, 2, 2, 1, 2, 3, 2, 3,
-	2, 2, 2, 1, 1, 3, 3, 0, 5, 4,
-	5, 5, 0, 2, 1, 3, 3, 3, 2, 3,
-	1, 2, 0, 3, 1, 1, 3, 3, 4, 4,
-	5, 3, 4, 5, 6, 2, 1, 2, 1, 2,
-	1, 2, 1, 1, 1, 1, 1, 1, 1, 0,
-	2, 1, 1, 1, 3, 1, 3, 1, 1, 1,
-	1, 1, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 2, 2, 2,
-	2, 2, 2, 2, 2, 2, 3, 1, 1, 1,
-	1, 4, 5, 5, 6, 4, 4, 6, 6, 6,
-	8, 8, 8, 8, 9, 8, 5, 4, 2, 2,
-	2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
-	2, 2, 2, 8, 8, 0, 2, 3, 4, 4,
-	4, 4, 4, 4, 4, 0, 3, 4, 7, 3,
-	1, 1, 1, 2, 3, 3, 1, 2, 2, 1,
-	2, 1, 2, 2, 1, 2, 0, 1, 0, 2,
-	1, 2, 4, 0, 2, 1, 3, 5, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 2, 2, 0,
-	3, 0, 2, 0, 3, 1, 3, 2, 0, 1,
-	1, 0, 2, 4, 4, 0, 2, 2, 1, 1,
-	3, 3, 3, 3, 3, 3, 3, 0, 3, 3,
-	3, 0, 3, 1, 1, 0, 4, 0, 1, 1,
-	0, 3, 1, 3, 2, 1, 0, 2, 4, 0,
-	9, 3, 5, 0, 3, 3, 0, 1, 0, 2,
-	2, 0, 2, 2, 2, 0, 2, 1, 2, 3,
-	3, 0, 2, 1, 2, 3, 4, 3, 0, 1,
-	2, 1, 5, 4, 4, 1, 3, 3, 5, 0,
-	5, 1, 3, 1, 2, 3, 1, 1, 3, 3,
-	1, 3, 3, 3, 3, 3, 2, 1, 2, 1,
-	1, 1, 1, 1, 1, 1, 0, 1, 0, 2,
-	0, 3, 0, 1, 0, 1, 1, 5, 0, 1,
-	0, 1, 2, 1, 1, 1, 1, 1, 1, 0,
+	3, 3, 3, 3, 5, 2, 3, 3, 3, 3,
+	1, 1, 0, 1, 0, 1, 1, 1, 0, 2,
+	2, 0, 2, 2, 0, 2, 0, 1, 1, 2,
+	1, 1, 2, 1, 1, 5, 0, 1, 0, 1,
+	2, 3, 0, 3, 3, 3, 3, 1, 0, 3,
+	1, 1, 1, 1, 1, 1, 1, 0, 1, 1,
+	3, 3, 4, 4, 2, 2, 3, 1, 3, 2,
+	1, 2, 1, 2, 2, 4, 3, 3, 6, 4,
+	7, 6, 1, 3, 2, 2, 2, 2, 1, 1,
+	1, 3, 2, 1, 1, 1, 0, 1, 1, 0,
+	3, 0, 2, 0, 2, 1, 2, 2, 1, 2,
+	2, 1, 2, 2, 0, 1, 1, 0, 1, 1,
+	0, 1, 0, 1, 2, 3, 4, 1, 1, 1,
+	1, 1, 1, 1, 1, 3, 1, 2, 3, 5,
+	0, 1, 2, 1, 1, 0, 2, 1, 3, 1,
+	1, 1, 3, 3, 3, 3, 7, 0, 3, 1,
+	3, 1, 1, 3, 3, 1, 3, 4, 4, 4,
+	3, 2, 4, 0, 1, 0, 2, 0, 1, 0,
+	1, 2, 1, 1, 1, 2, 2, 1, 2, 3,
+	2, 3, 2, 2, 2, 1, 1, 3, 3, 0,
+	5, 4, 5, 5, 0, 2, 1, 3, 3, 3,
+	2, 3, 1, 2, 0, 3, 1, 1, 3, 3,
+	4, 4, 5, 3, 4, 5, 6, 2, 1, 2,
+	1, 2, 1, 2, 1, 1, 1, 1, 1, 1,
+	1, 0, 2, 1, 1, 1, 3, 1, 3, 1,
+	1, 1, 1, 1, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 2,
+	2, 2, 2, 2, 2, 2, 2, 2, 3, 1,
+	1, 1, 1, 5, 5, 5, 6, 0, 1, 4,
+	2, 4, 0, 1, 0, 3, 0, 2, 2, 5,
+	5, 2, 2, 2, 2, 2, 0, 2, 1, 3,
+	5, 4, 4, 6, 6, 6, 8, 8, 8, 8,
+	9, 8, 5, 4, 2, 2, 2, 2, 2, 2,
+	2, 2, 2, 2, 2, 2, 2, 2, 2, 8,
+	8, 0, 2, 3, 4, 4, 4, 4, 4, 4,
+	4, 0, 3, 4, 7, 3, 1, 1, 1, 2,
+	3, 3, 1, 2, 2, 1, 2, 1, 2, 2,
+	1, 2, 0, 1, 0, 2, 1, 2, 4, 0,
+	2, 1, 3, 5, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 2, 2, 0, 3, 0, 2, 0,
+	3, 1, 3, 2, 0, 1, 1, 0, 2, 4,
+	4, 0, 2, 2, 1, 1, 3, 3, 3, 3,
+	3, 3, 3, 0, 3, 3, 3, 0, 3, 1,
+	1, 0, 4, 0, 1, 1, 0, 3, 1, 3,
+	2, 1, 0, 2, 4, 0, 9, 3, 5, 0,
+	3, 3, 0, 1, 0, 2, 2, 0, 2, 2,
+	2, 0, 2, 1, 2, 3, 3, 0, 2, 1,
+	2, 3, 4, 3, 0, 1, 2, 1, 5, 4,
+	4, 1, 3, 3, 5, 0, 5, 1, 3, 1,
+	2, 3, 1, 1, 3, 3, 1, 3, 3, 3,
+	3, 3, 2, 1, 2, 1, 1, 1, 1, 1,
+	1, 1, 0, 1, 0, 2, 0, 3, 0, 1,
+	0, 1, 1, 5, 0, 1, 0, 1, 2, 1,
+	1, 1, 1, 1, 1, 0, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
@@ -5079,490 +5380,506 @@ var yyR2 = [...]int{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 0, 0,
-	1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 0, 0, 1, 1,
 }
 
 var yyChk = [...]int{
-	-1000, -283, -1, -3, -8, -9, -10, -11, -12, -13,
+	-1000, -294, -1, -3, -8, -9, -10, -11, -12, -13,
 	-14, -15, -16, -17, -18, -19, -42, -43, -44, -46,
 	-47, -48, -49, -50, -6, -45, -20, -21, -51, -52,
-	-53, -54, -55, -4, -285, 6, 7, 8, -63, 10,
-	11, 30, -23, -33, 160, -34, -24, 161, -35, 163,
-	162, 198, 164, 191, 72, 237, 238, 240, 241, 242,
-	243, -64, 196, 197, 166, 34, 43, 31, 32, 35,
-	169, 82, 9, 341, 193, 192, 26, -284, 481, -71,
-	5, -139, 16, -3, -56, -288, -56, -56, -56, -56,
-	-56, -56, -239, -241, 82, 133, 82, -56, -39, -38,
-	-37, -36, -40, 29, -30, -31, -259, -29, -26, 165,
-	162, 206, 103, 104, 198, 199, 200, 164, 182, 197,
-	201, 196, 215, -25, 78, 31, 354, 357, -246, 161,
-	167, 168, 342, 106, 105, 73, 163, -243, 287, 458,
-	-40, 460, 96, 98, 459, 42, 172, 461, 462, 463,
-	464, 181, 465, 466, 467, 468, 474, 475, 476, 477,
-	107, 5, -56, -201, -197, -263, -191, 85, 86, 87,
-	351, 184, 385, 386, 231, 78, 39, 287, 458, 237,
-	251, 245, 272, 264, 352, 387, 234, 185, 219, 455,
-	262, 111, 460, 388, 199, 292, 293, 294, 96, 240,
-	328, 473, 236, 389, 471, 98, 459, 77, 49, 42,
-	194, 260, 256, 461, 220, 390, 362, 213, 106, 103,
-	480, 34, 254, 48, 28, 470, 105, 47, 462, 124,
-	391, 463, 296, 277, 449, 46, 297, 200, 392, 81,
-	356, 457, 298, 255, 299, 230, 469, 166, 393, 441,
-	300, 301, 450, 394, 278, 282, 395, 320, 302, 50,
-	396, 397, 451, 104, 398, 76, 464, 249, 250, 399,
-	228, 183, 322, 276, 181, 33, 303, 353, 232, 56,
-	207, 323, 44, 280, 43, 445, 400, 448, 275, 271,
-	51, 401, 402, 403, 404, 465, 274, 248, 270, 479,
-	225, 466, 60, 168, 284, 283, 285, 214, 319, 267,
-	405, 406, 407, 188, 79, 408, 257, 19, 409, 410,
-	304, 221, 411, 54, 412, 413, 326, 197, 414, 52,
-	467, 37, 202, 468, 415, 416, 417, 418, 419, 306,
-	420, 305, 279, 281, 209, 307, 355, 421, 253, 201,
-	472, 422, 189, 456, 203, 206, 196, 327, 190, 423,
-	424, 425, 426, 427, 235, 428, 429, 241, 474, 41,
-	430, 431, 432, 433, 229, 224, 321, 330, 59, 80,
-	289, 434, 454, 247, 222, 435, 238, 53, 475, 476,
-	477, 216, 478, 7, 309, 107, 226, 227, 45, 268,
-	208, 436, 437, 258, 259, 273, 246, 269, 239, 442,
-	210, 310, 198, 438, 329, 223, 290, 359, 215, 311,
-	453, 358, 266, 263, 217, 439, 312, 173, 211, 212,
-	440, 443, 313, 314, 316, 317, 233, 315, 318, 218,
-	357, 244, 261, 291, 171, -56, 171, -103, -197, 171,
-	-169, 293, -188, 295, 308, 303, 313, 301, -180, 304,
-	306, 209, -278, 320, 171, 310, 160, 151, 296, 305,
-	314, 315, 233, 318, 218, -274, -263, 463, 478, 111,
-	265, 300, 298, 321, 445, 317, 316, -197, 239, -204,
-	244, -192, -263, -191, 242, -103, -62, 441, 164, -206,
-	-206, -73, 445, 447, -123, -87, -109, 117, -114, 29,
-	24, -113, -110, -131, -128, -129, 151, 152, 154, 153,
-	155, 140, 141, 148, 118, 156, -118, -116, -117, -119,
-	89, 88, 97, 90, 91, 92, 93, 99, 100, 101,
-	-192, -197, -126, -285, 66, 67, 342, 343, 344, 345,
-	350, 346, 120, 55, 337, 331, 340, 339, 338, 335,
-	336, 333, 334, 348, 349, 176, 332, 170, 146, 341,
-	-263, -191, 42, 309, 309, -103, -56, -5, -4, -285,
-	6, 21, 22, -143, 18, 17, -286, 84, -65, -78,
-	61, 62, -80, 22, 36, 65, 63, 21, -57, -77,
-	142, -87, -197, -77, -178, 175, -178, -178, -168, -209,
-	239, -172, 321, 320, -193, -170, -192, -190, -169, 319,
-	165, 360, 116, 23, 25, 119, 151, 17, 120, 35,
-	167, 265, 182, 150, 178, 342, 160, 70, 361, 333,
-	334, 331, 337, 344, 345, 332, 295, 29, 11, 363,
-	26, 192, 22, 36, 144, 162, 123, 195, 24, 193,
-	101, 366, 20, 73, 187, 12, 180, 38, 14, 367,
-	368, 15, 176, 175, 135, 172, 68, 9, 156, 27,
-	132, 64, 369, 113, 370, 371, 372, 373, 66, 133,
-	18, 335, 336, 31, 446, 374, 350, 204, 146, 71,
-	57, 447, 117, 375, 376, 99, 377, 102, 74, 452,
-	114, 16, 69, 40, 378, 205, 379, 177, 380, 324,
-	381, 134, 163, 341, 67, 382, 170, 308, 6, 347,
-	30, 191, 179, 109, 65, 383, 171, 122, 348, 349,
-	174, 100, 5, 112, 32, 10, 72, 75, 338, 339,
-	340, 55, 108, 354, 121, 13, 384, 325, 115, 111,
-	-240, 133, -227, -231, -192, 186, -256, 182, -103, -249,
-	-248, -192, -72, -187, 172, 180, 179, 112, -267, 114,
-	226, 332, 170, -36, -37, -169, 150, 203, 83, 83,
-	-231, -230, -229, -268, 205, 186, -255, -247, 178, 187,
-	-237, 179, 180, -232, 172, 113, -268, -232, 177, 187,
-	205, 205, 107, 205, 107, 205, 205, 205, 205, 205,
-	205, 205, 205, 205, 202, -238, 125, -238, 358, 358,
-	-243, -268, -268, -268, 174, 33, 33, -189, -232, 174,
-	23, -238, -238, -169, 150, -238, -238, -238, -238, 213,
-	213, -238, -238, -238, -238, -238, -238, -238, -238, -238,
-	-238, -238, -238, -238, -238, -238, 171, -267, -81, 318,
-	233, 78, -38, 215, -22, -103, -187, 172, 173, -267,
-	-103, 157, -103, -182, 133, 13, -182, -179, 309, 307,
-	294, 299, -182, -182, -182, -182, 216, 292, -233, 172,
-	33, 183, 309, 216, 292, 216, 217, 216, 217, 302,
-	312, 216, -202, 12, 135, 332, 297, 301, 209, 171,
-	210, 173, 311, -263, 448, 217, -202, 89, 309, 212,
-	-182, -207, -285, -193, 265, -207, -207, 30, 174, -192,
-	-58, -192, 89, -7, -3, -11, -10, -12, 125, -79,
-	309, -67, 151, 463, 449, 450, 451, 448, 306, 456,
-	454, 452, 216, 453, 83, 116, 114, 115, 132, -87,
-	-111, 135, 117, 133, 134, 119, 137, 136, 147, 140,
-	141, 142, 143, 144, 145, 146, 138, 139, 150, 125,
-	126, 127, 128, 129, 130, 131, -177, -285, -129, -285,
-	158, 159, -114, -114, -114, -114, -114, -114, -114, -114,
-	-114, -114, -285, 157, -2, -123, -4, -285, -285, -285,
-	-285, -285, -285, -285, -285, -135, -87, -285, -289, -285,
-	-289, -120, -285, -289, -120, -289, -120, -289, -289, -120,
-	-289, -120, -289, -289, -120, -285, -285, -285, -285, -285,
-	-285, -285, -206, -275, -276, -106, -103, -285, 233, -139,
-	-3, -56, -159, 20, 31, -87, -140, -141, -87, -139,
-	57, -75, -77, -80, 61, 62, 95, 12, -195, -194,
-	23, -192, 89, 157, 12, -104, 27, -103, -89, -90,
-	-91, -92, -106, -130, -285, 12, -96, -97, -103, -105,
-	-197, 83, 239, -172, -209, -174, -173, 322, 324, 125,
-	-196, -192, 89, 29, 84, 83, -103, -211, -214, -216,
-	-215, -217, -212, -213, 262, 263, 151, 266, 268, 269,
-	270, 271, 272, 273, 274, 275, 276, 277, 30, 194,
-	258, 259, 260, 261, 278, 279, 280, 281, 282, 283,
-	284, 285, 245, 264, 352, 246, 247, 248, 249, 250,
-	251, 253, 254, 255, 256, 257, -266, -263, 82, 84,
-	83, -218, 82, -81, 171, -263, 172, 172, 172, -56,
-	341, -238, -238, 202, -29, -26, -259, 16, -25, -26,
-	165, 103, 104, 162, 82, -227, 82, -236, -266, -263,
-	82, 113, 177, 112, -235, -232, -235, -236, -263, -131,
-	-192, -197, -263, 113, 113, -165, -192, -165, -165, 21,
-	-165, 21, -165, 21, 90, -192, -165, 21, -165, 21,
-	-165, 21, -165, 21, -165, 21, 29, 76, 77, 29,
-	79, 80, 81, -131, -131, -227, -169, -103, -263, 90,
-	90, -238, -238, 90, 89, 89, 89, -238, -238, 90,
-	89, -199, -197, 89, -269, 188, 230, 232, 90, 90,
-	90, 90, 29, 89, -270, 29, 470, 469, 471, 472,
-	473, 90, 29, 90, 29, 90, -192, 82, -103, -84,
-	220, 160, 162, 165, 74, 89, 234, 125, 42, 83,
-	174, 171, -263, -184, 176, -184, -198, -197, -190, 89,
-	-87, -234, 12, 135, -202, -202, -182, -103, -234, -202,
-	-182, -103, -182, -182, -182, -182, -202, -202, -202, -182,
-	-197, -197, -103, -103, -103, -103, -103, -103, -103, -207,
-	-207, -207, -183, 133, -182, 448, 74, -205, 242, 276,
-	442, 443, 444, 83, 354, -96, 448, 448, 448, 448,
-	448, 448, -87, -87, -87, -87, -121, 99, 117, 100,
-	101, -114, -122, -126, -129, 94, 135, 133, 134, 119,
-	-114, -114, -114, -114, -114, -114, -114, -114, -114, -114,
-	-114, -114, -114, -114, -114, -208, -263, 89, 151, -263,
-	-113, -113, -192, -76, 22, 36, -75, -193, -198, -190,
-	-71, -286, -286, -139, -75, -75, -87, -87, -131, 89,
-	-75, -131, 89, -75, -75, -70, 22, 36, -133, -134,
-	121, -131, -286, -114, -192, -192, -75, -76, -76, -75,
-	-75, 83, -277, 324, 325, 446, -200, 205, -199, 23,
-	-124, -123, 89, -143, -286, -144, 27, 10, 135, 83,
-	19, 83, -142, 25, 26, -143, -115, -192, 90, 93,
-	-88, 83, 12, -80, -103, -194, 142, -198, -103, -164,
-	205, -103, 30, 83, -99, -101, -100, -102, 64, 68,
-	70, 65, 66, 67, 71, -203, 23, -89, -3, -285,
-	-103, -96, -287, 83, 12, 75, -287, 83, 157, -172,
-	-174, 83, 323, 325, 326, 74, 102, -87, -220, 150,
-	-245, -244, -243, -227, -229, -230, -231, 84, -145, -86,
-	38, -223, 290, 289, -218, -218, -218, -218, -218, -219,
-	-169, -219, -219, -219, 82, 82, -218, -218, -218, -218,
-	-221, 82, -221, -221, -222, 82, -222, -256, -87, -253,
-	-252, -250, -251, 181, 96, 354, 75, -248, -142, 90,
-	-84, -185, 176, -254, -251, -263, -263, -263, -185, -263,
-	89, -263, 89, 83, 17, -228, -227, -41, 230, -258,
-	205, -255, -249, -236, 113, -235, -236, -236, 157, -263,
-	83, 27, 107, 107, 107, 107, 354, 162, 30, -227,
-	-41, -208, 174, -208, -208, 89, 89, -181, 478, -96,
-	-83, 222, 125, 211, 211, 171, 171, 224, -103, 235,
-	236, 234, 21, 223, 225, 227, 213, -103, -103, -184,
-	74, -98, -103, 24, -197, -103, -182, -182, -103, -182,
-	-182, 89, -103, -192, -67, 324, 354, 20, -68, 20,
-	99, 100, 101, -122, -114, -114, -114, -74, 195, 116,
-	-286, -286, -75, -75, -285, 157, -5, -143, -286, -286,
-	83, 75, 23, 12, 12, -286, 12, 12, -286, -286,
-	-75, -136, -134, 123, -87, -286, -286, 83, 83, -286,
-	-286, -286, -286, -286, -276, 445, 325, -107, 72, 175,
-	73, -285, -199, -286, -159, 40, 48, 59, -87, -87,
-	-141, -159, -176, 20, 12, 55, 55, -108, 13, -77,
-	-89, -80, 157, -108, -112, 30, 55, -3, -285, -285,
-	-167, -171, -131, -90, -91, -91, -90, -91, 64, 64,
-	64, 69, 64, 69, 64, -100, -197, -286, -286, -3,
-	-164, 75, -89, -103, -89, -105, -197, 142, -173, -175,
-	327, 324, 330, -263, 89, 83, -243, -231, -282, 99,
-	117, 29, 74, 287, 96, -280, -281, 177, 112, 113,
-	189, 23, 39, -224, 291, -219, -219, -220, -263, 89,
-	151, -220, -220, -220, -226, 89, -226, 90, 90, 84,
-	-32, -27, -28, 31, 78, -250, -238, 89, 37, -192,
-	84, -83, -103, 117, 74, -254, -254, -254, -197, 16,
-	-161, -192, 83, 84, -132, 231, 84, -192, 84, 82,
-	-236, -236, -193, -192, -285, 171, 29, 29, -41, -132,
-	-220, -263, 480, 479, 84, 173, 229, -85, 337, 89,
-	85, -103, -103, -103, -103, -103, 165, 162, 214, 174,
-	-96, -103, 83, -61, 190, 185, -202, -202, 31, 324,
-	457, 455, -74, 116, -114, -114, -286, -286, -76, -193,
-	-139, -159, -210, 151, 262, 194, 260, 256, 276, 267,
-	289, 258, 290, -208, -210, -114, -114, -114, -114, 351,
-	-139, 124, -87, 122, -114, -114, 172, 172, 172, -165,
-	41, 89, 89, 60, -103, -137, 14, -87, 142, -143,
-	-166, 74, -167, -125, -127, -126, -285, -160, -286, -192,
-	-165, -108, 83, 125, -94, -93, 74, 75, -95, 74,
-	-93, 64, 64, -286, -108, -89, -108, -108, 157, 324,
-	328, 329, -243, 99, -114, 10, 89, 113, 113, -103,
-	82, -220, -220, 84, 83, 84, 83, 84, 83, -186,
-	391, 117, -28, -27, -238, -238, 90, -263, 173, 24,
-	-103, 74, 74, 74, 17, 83, -227, -131, 55, -253,
-	-161, -257, -258, -103, -113, -132, -103, -82, 220, 228,
-	82, 86, -265, 75, 211, 287, 211, -103, -61, -32,
-	-103, -182, -182, 31, -263, -114, -286, -143, -286, -218,
-	-218, -218, -222, -218, 250, -218, 250, -286, -286, 20,
-	20, 20, 20, -285, -66, 347, -87, 83, 83, -285,
-	-285, -285, -286, 89, -219, -138, 15, 17, 28, -166,
-	83, -286, -286, 83, 55, 157, -286, -139, -171, -87,
-	-87, 82, -87, -139, -108, -117, 82, -114, -219, 89,
-	-219, 90, 90, 391, 29, 79, 80, 81, 29, 76,
-	77, -103, -103, -103, -103, -161, -192, 207, 84, -286,
-	83, -225, 354, 357, -162, 82, 84, -262, 354, -264,
-	-263, -192, -192, -192, -159, -219, -263, -114, -114, -114,
-	-114, -114, -143, 89, -114, -114, -163, -286, -192, 177,
-	-163, -163, -200, -219, -148, -153, -179, -87, -123, 113,
-	-127, 55, -3, -192, -125, -192, -143, -161, -143, -161,
-	84, -220, -220, 84, 84, -162, 208, -281, -258, 358,
-	358, 23, -161, -261, -260, -193, 82, 75, -286, -286,
-	-286, -286, -69, 135, 354, -286, -286, -286, 83, -286,
-	-286, -286, -107, -151, 441, -156, 44, -154, -155, 45,
-	-152, 46, 54, 10, -125, 157, 84, 84, -146, 23,
-	-285, -3, 84, 83, 125, -161, -103, -286, 352, 71,
-	355, -192, 177, -148, 49, 268, -158, -157, 53, 45,
-	-155, 17, 47, 17, -167, -192, -272, -273, 74, -282,
-	-279, 99, 117, 96, -280, 108, 109, -3, -114, 204,
-	-59, 354, -260, -242, -193, 89, 90, 84, 60, 353,
-	356, -149, 51, -147, 50, -147, -157, 17, 17, 89,
-	17, 89, -273, 74, 11, 10, 99, 89, -59, -286,
-	-286, -60, 219, 445, -265, 60, -150, 52, 74, 102,
-	89, 89, 89, -271, 190, 185, 188, 30, -271, 182,
-	-262, 354, 74, 102, 184, 29, 99, 221, 355, 356,
+	-53, -54, -55, -4, -296, 8, 9, 10, -64, 12,
+	13, 32, -23, -33, 165, -34, -24, 166, -35, 168,
+	167, 203, 169, 196, 74, 242, 243, 245, 246, 247,
+	248, -65, 318, 201, 202, 171, 36, 45, 33, 34,
+	37, 174, 84, 11, 349, 198, 197, 28, -295, 489,
+	-74, 5, 6, 7, -150, 18, -3, -56, -299, -56,
+	-56, -56, -56, -56, -56, -250, -252, 84, 138, 84,
+	-56, -39, -38, -37, -36, -40, 31, -30, -31, -270,
+	-29, -26, 170, 167, 211, 105, 106, 203, 204, 205,
+	169, 187, 202, 206, 201, 220, -25, 80, 33, 362,
+	365, -257, 166, 172, 173, 350, 108, 107, 75, 168,
+	-254, 292, 466, -40, 468, 98, 100, 467, 44, 177,
+	469, 470, 471, 472, 186, 473, 474, 475, 476, 482,
+	483, 484, 485, 109, 5, -56, -212, -208, -274, -202,
+	87, 88, 89, 359, 189, 390, 391, 236, 80, 41,
+	292, 466, 242, 256, 250, 277, 269, 360, 392, 239,
+	190, 224, 463, 267, 113, 468, 393, 204, 297, 298,
+	299, 98, 245, 336, 481, 241, 394, 479, 100, 467,
+	79, 51, 448, 44, 199, 265, 261, 469, 225, 395,
+	370, 218, 108, 105, 488, 36, 259, 50, 30, 478,
+	107, 49, 470, 129, 396, 471, 301, 282, 457, 48,
+	302, 205, 397, 83, 364, 465, 303, 260, 304, 235,
+	477, 171, 116, 449, 305, 306, 458, 398, 283, 287,
+	399, 328, 307, 52, 400, 401, 459, 106, 402, 78,
+	472, 254, 255, 403, 233, 188, 330, 281, 186, 35,
+	308, 361, 237, 58, 212, 331, 46, 285, 45, 453,
+	404, 456, 280, 276, 53, 405, 406, 407, 408, 473,
+	279, 253, 275, 487, 230, 474, 62, 173, 289, 288,
+	290, 219, 327, 272, 409, 410, 411, 193, 81, 412,
+	262, 21, 413, 414, 309, 226, 415, 56, 416, 417,
+	334, 202, 418, 54, 475, 39, 207, 476, 419, 420,
+	421, 310, 115, 422, 312, 423, 311, 284, 286, 214,
+	313, 363, 424, 446, 258, 206, 480, 425, 194, 464,
+	208, 211, 201, 335, 195, 426, 427, 428, 429, 430,
+	240, 431, 432, 246, 447, 445, 482, 43, 433, 434,
+	435, 436, 234, 229, 329, 338, 61, 82, 294, 437,
+	462, 252, 227, 438, 243, 55, 483, 484, 485, 221,
+	486, 9, 315, 109, 231, 232, 47, 273, 213, 439,
+	440, 263, 264, 278, 251, 274, 244, 450, 215, 316,
+	203, 114, 337, 228, 295, 367, 220, 317, 461, 366,
+	271, 268, 222, 441, 318, 319, 178, 216, 217, 442,
+	451, 320, 321, 322, 324, 325, 238, 323, 326, 223,
+	365, 249, 266, 296, 176, -56, 176, -104, -208, 176,
+	-180, 298, -199, 300, 314, 308, 321, 306, -191, 309,
+	312, 214, -289, 328, 176, 316, 165, 156, 301, 311,
+	322, 323, 238, 320, 326, 223, -285, -274, 471, 486,
+	113, 270, 305, 303, 329, 453, 325, 324, -208, 244,
+	-215, 249, -203, -274, -202, 247, -104, -62, 449, 169,
+	23, 310, -217, -217, -73, 453, 455, -134, -88, -110,
+	122, -115, 31, 26, -114, -111, -142, -139, -140, 156,
+	157, 159, 158, 160, 145, 146, 153, 123, 161, -119,
+	-117, -118, -120, 91, 90, 99, 92, 93, 94, 95,
+	101, 102, 103, -203, -208, -137, -296, 68, 69, 350,
+	351, 352, 353, 358, 354, 125, 57, 345, 339, 348,
+	347, 346, 343, 344, 341, 342, 356, 357, 181, 340,
+	175, 151, 349, -274, -202, 44, 315, 315, -104, -56,
+	-5, -4, -296, 8, 23, 24, 23, 24, 23, 24,
+	-154, 20, 19, -297, 86, -66, -79, 63, 64, -81,
+	24, 38, 67, 65, 23, -57, -78, 147, -88, -208,
+	-78, -189, 180, -189, -189, -179, -220, 244, -183, 329,
+	328, -204, -181, -203, -201, -180, 327, 170, 368, 121,
+	25, 27, 124, 156, 19, 125, 37, 172, 270, 187,
+	155, 183, 350, 165, 72, 369, 341, 342, 339, 345,
+	352, 353, 340, 300, 31, 13, 371, 28, 197, 24,
+	38, 149, 167, 128, 200, 7, 26, 198, 103, 373,
+	22, 75, 192, 14, 185, 40, 16, 374, 375, 17,
+	181, 180, 140, 177, 70, 11, 6, 161, 29, 137,
+	66, 376, 118, 377, 378, 379, 380, 68, 138, 20,
+	343, 344, 33, 454, 381, 358, 209, 151, 73, 59,
+	455, 122, 382, 383, 101, 384, 104, 76, 460, 119,
+	18, 71, 42, 443, 210, 385, 182, 386, 332, 387,
+	139, 168, 349, 69, 388, 175, 314, 8, 355, 32,
+	196, 184, 111, 67, 389, 176, 127, 356, 357, 179,
+	102, 5, 117, 34, 12, 74, 77, 346, 347, 348,
+	57, 110, 362, 126, 15, 444, 333, 120, 113, -251,
+	138, -238, -242, -203, 191, -267, 187, -104, -260, -259,
+	-203, -72, -198, 177, 185, 184, 117, -278, 119, 231,
+	340, 175, -36, -37, -180, 155, 208, 85, 85, -242,
+	-241, -240, -279, 210, 191, -266, -258, 183, 192, -248,
+	184, 185, -243, 177, 118, -279, -243, 182, 192, 210,
+	210, 109, 210, 109, 210, 210, 210, 210, 210, 210,
+	210, 210, 210, 207, -249, 130, -249, 366, 366, -254,
+	-279, -279, -279, 179, 35, 35, -200, -243, 179, 25,
+	-249, -249, -180, 155, -249, -249, -249, -249, 218, 218,
+	-249, -249, -249, -249, -249, -249, -249, -249, -249, -249,
+	-249, -249, -249, -249, -249, 176, -278, -82, 326, 238,
+	80, -38, 220, -22, -104, -198, 177, 178, -278, -104,
+	162, -104, -193, 138, 15, -193, -190, 315, 313, 299,
+	304, -193, -193, -193, -193, 221, 297, -244, 177, 35,
+	188, 315, 221, 297, 221, 222, 221, 222, 307, 319,
+	221, -213, 14, 140, 340, 302, 306, 214, 176, 215,
+	178, 317, -274, 456, 222, -213, 91, -193, 91, 315,
+	217, -193, -218, -296, -204, 270, -218, -218, 32, 179,
+	-203, -58, -203, 91, -7, -3, -11, -10, -12, 130,
+	-63, 449, -63, -80, 315, -68, 156, 471, 457, 458,
+	459, 456, 312, 464, 462, 460, 221, 461, 85, 121,
+	119, 120, 137, -88, -112, 140, 122, 138, 139, 124,
+	142, 141, 152, 145, 146, 147, 148, 149, 150, 151,
+	143, 144, 155, 130, 131, 132, 133, 134, 135, 136,
+	-188, -296, -140, -296, 163, 164, -115, -115, -115, -115,
+	-115, -115, -115, -115, -115, -115, -296, 162, -2, -134,
+	-4, -296, -296, -296, -296, -296, -296, -296, -296, -146,
+	-88, -296, -300, -296, -300, -121, -296, -300, -121, -300,
+	-121, -300, -300, -121, -300, -121, -300, -300, -121, -296,
+	-296, -296, -296, -296, -296, -296, -217, -286, -287, -107,
+	-104, -296, 238, -150, -3, -56, -170, 22, 33, -88,
+	-151, -152, -88, -150, 59, -76, -78, -81, 63, 64,
+	97, 14, -206, -205, 25, -203, 91, 162, 14, -105,
+	29, -104, -90, -91, -92, -93, -107, -141, -296, 14,
+	-97, -98, -104, -106, -208, 85, 244, -183, -220, -185,
+	-184, 330, 332, 130, -207, -203, 91, 31, 86, 85,
+	-104, -222, -225, -227, -226, -228, -223, -224, 267, 268,
+	156, 271, 273, 274, 275, 276, 277, 278, 279, 280,
+	281, 282, 32, 199, 263, 264, 265, 266, 283, 284,
+	285, 286, 287, 288, 289, 290, 250, 269, 360, 251,
+	252, 253, 254, 255, 256, 258, 259, 260, 261, 262,
+	-277, -274, 84, 86, 85, -229, 84, -82, 176, -274,
+	177, 177, 177, -56, 349, -249, -249, 207, -29, -26,
+	-270, 18, -25, -26, 170, 105, 106, 167, 84, -238,
+	84, -247, -277, -274, 84, 118, 182, 117, -246, -243,
+	-246, -247, -274, -142, -203, -208, -274, 118, 118, -176,
+	-203, -176, -176, 23, -176, 23, -176, 23, 92, -203,
+	-176, 23, -176, 23, -176, 23, -176, 23, -176, 23,
+	31, 78, 79, 31, 81, 82, 83, -142, -142, -238,
+	-180, -104, -274, 92, 92, -249, -249, 92, 91, 91,
+	91, -249, -249, 92, 91, -210, -208, 91, -280, 193,
+	235, 237, 92, 92, 92, 92, 31, 91, -281, 31,
+	478, 477, 479, 480, 481, 92, 31, 92, 31, 92,
+	-203, 84, -104, -85, 225, 165, 167, 170, 76, 91,
+	239, 130, 44, 85, 179, 176, -274, -195, 181, -195,
+	-209, -208, -201, 91, -88, -245, 14, 140, -213, -213,
+	-193, -104, -245, -213, -193, -104, -193, -193, -193, -193,
+	-213, -213, -213, -193, -208, -208, -104, -104, -104, -104,
+	-104, -104, -104, -218, -218, -218, -194, 138, -193, 456,
+	76, -216, 247, 281, 450, 451, 452, -7, 130, -7,
+	85, 362, -97, 456, 456, 456, 456, 456, 456, -88,
+	-88, -88, -88, -132, 101, 122, 102, 103, -115, -133,
+	-137, -140, 96, 140, 138, 139, 124, -115, -115, -115,
+	-115, -115, -115, -115, -115, -115, -115, -115, -115, -115,
+	-115, -115, -219, -274, 91, 156, -274, -114, -114, -203,
+	-77, 24, 38, -76, -204, -209, -201, -74, -297, -297,
+	-150, -76, -76, -88, -88, -142, 91, -76, -142, 91,
+	-76, -76, -71, 24, 38, -144, -145, 126, -142, -297,
+	-115, -203, -203, -76, -77, -77, -76, -76, 85, -288,
+	332, 333, 454, -211, 210, -210, 25, -135, -134, 91,
+	-154, -297, -155, 29, 12, 140, 85, 21, 85, -153,
+	27, 28, -154, -116, -203, 92, 95, -89, 85, 14,
+	-81, -104, -205, 147, -209, -104, -175, 210, -104, 32,
+	85, -100, -102, -101, -103, 66, 70, 72, 67, 68,
+	69, 73, -214, 25, -90, -3, -296, -104, -97, -298,
+	85, 14, 77, -298, 85, 162, -183, -185, 85, 331,
+	333, 334, 76, 104, -88, -231, 155, -256, -255, -254,
+	-238, -240, -241, -242, 86, -156, -87, 40, -234, 295,
+	294, -229, -229, -229, -229, -229, -230, -180, -230, -230,
+	-230, 84, 84, -229, -229, -229, -229, -232, 84, -232,
+	-232, -233, 84, -233, -267, -88, -264, -263, -261, -262,
+	186, 98, 362, 77, -259, -153, 92, -85, -196, 181,
+	-265, -262, -274, -274, -274, -196, -274, 91, -274, 91,
+	85, 19, -239, -238, -41, 235, -269, 210, -266, -260,
+	-247, 118, -246, -247, -247, 162, -274, 85, 29, 109,
+	109, 109, 109, 362, 167, 32, -238, -41, -219, 179,
+	-219, -219, 91, 91, -192, 486, -97, -84, 227, 130,
+	216, 216, 176, 176, 229, -104, 240, 241, 239, 23,
+	228, 230, 232, 218, -104, -104, -195, 76, -99, -104,
+	26, -208, -104, -193, -193, -104, -193, -193, 91, -104,
+	-203, 281, -68, 332, 362, 22, -69, 22, 101, 102,
+	103, -133, -115, -115, -115, -75, 200, 121, -297, -297,
+	-76, -76, -296, 162, -5, -154, -297, -297, 85, 77,
+	25, 14, 14, -297, 14, 14, -297, -297, -76, -147,
+	-145, 128, -88, -297, -297, 85, 85, -297, -297, -297,
+	-297, -297, -287, 453, 333, -108, 74, 180, 75, -296,
+	-210, -297, -170, 42, 50, 61, -88, -88, -152, -170,
+	-187, 22, 14, 57, 57, -109, 15, -78, -90, -81,
+	162, -109, -113, 32, 57, -3, -296, -296, -178, -182,
+	-142, -91, -92, -92, -91, -92, 66, 66, 66, 71,
+	66, 71, 66, -101, -208, -297, -297, -3, -175, 77,
+	-90, -104, -90, -106, -208, 147, -184, -186, 335, 332,
+	338, -274, 91, 85, -254, -242, -293, 101, 122, 31,
+	76, 292, 98, -291, -292, 182, 117, 118, 194, 25,
+	41, -235, 296, -230, -230, -231, -274, 91, 156, -231,
+	-231, -231, -237, 91, -237, 92, 92, 86, -32, -27,
+	-28, 33, 80, -261, -249, 91, 39, -203, 86, -84,
+	-104, 122, 76, -265, -265, -265, -208, 18, -172, -203,
+	85, 86, -143, 236, 86, -203, 86, 84, -247, -247,
+	-204, -203, -296, 176, 31, 31, -41, -143, -231, -274,
+	488, 487, 86, 178, 234, -86, 345, 91, 87, -104,
+	-104, -104, -104, -104, 170, 167, 219, 179, -97, -104,
+	85, -61, 195, 190, -213, -213, 33, 332, 465, 463,
+	-75, 121, -115, -115, -123, -122, 443, -297, -297, -77,
+	-204, -150, -170, -221, 156, 267, 199, 265, 261, 281,
+	272, 294, 263, 295, -219, -221, -115, -115, -115, -115,
+	359, -150, 129, -88, 127, -115, -115, 177, 177, 177,
+	-176, 43, 91, 91, 62, -104, -148, 16, -88, 147,
+	-154, -177, 76, -178, -136, -138, -137, -296, -171, -297,
+	-203, -176, -109, 85, 130, -95, -94, 76, 77, -96,
+	76, -94, 66, 66, -297, -109, -90, -109, -109, 162,
+	332, 336, 337, -254, 101, -115, 12, 91, 118, 118,
+	-104, 84, -231, -231, 86, 85, 86, 85, 86, 85,
+	-197, 396, 122, -28, -27, -249, -249, 92, -274, 178,
+	26, -104, 76, 76, 76, 19, 85, -238, -142, 57,
+	-264, -172, -268, -269, -104, -114, -143, -104, -83, 225,
+	233, 84, 88, -276, 77, 216, 292, 216, -104, -61,
+	-32, -104, -193, -193, 33, -274, -115, -296, -203, -297,
+	-154, -297, -229, -229, -229, -233, -229, 255, -229, 255,
+	-297, -297, 22, 22, 22, 22, -296, -67, 355, -88,
+	85, 85, -296, -296, -296, -297, 91, -230, -149, 17,
+	19, 30, -177, 85, -297, -297, 85, 57, 162, -297,
+	-150, -182, -88, -88, 84, -88, -150, -109, -118, 84,
+	-115, -230, 91, -230, 92, 92, 396, 31, 81, 82,
+	83, 31, 78, 79, -104, -104, -104, -104, -172, -203,
+	212, 86, -297, 85, -236, 362, 365, -173, 84, 86,
+	-273, 362, -275, -274, -203, -203, -203, -124, -125, -274,
+	-170, -230, -274, -115, -115, -115, -115, -115, -154, 91,
+	-115, -115, -174, -297, -203, 182, -174, -174, -211, -230,
+	-159, -164, -190, -130, 444, -88, -134, 118, -138, 57,
+	-3, -203, -136, -203, -154, -172, -154, -172, 86, -231,
+	-231, 86, 86, -173, 213, -292, -269, 366, 366, 25,
+	-172, -272, -271, -204, 84, 77, -297, -126, 210, -297,
+	-297, -297, -297, -70, 140, 362, -297, -297, -297, 85,
+	-297, -297, -297, -108, -162, 449, -167, 46, -165, -166,
+	47, -163, 48, 56, -131, -129, -203, 12, -136, 162,
+	86, 86, -157, 25, -296, -3, 86, 85, 130, -172,
+	-104, -150, 19, -297, 360, 73, 363, -203, 182, -159,
+	51, 273, -169, -168, 55, 47, -166, 19, 49, 19,
+	85, 25, -178, -203, -283, -284, 76, -293, -290, 101,
+	122, 98, -291, 110, 111, -3, -115, 209, -59, 362,
+	-271, -253, -204, 91, 92, 86, -127, 445, 446, -134,
+	62, 361, 364, -160, 53, -158, 52, -158, -168, 19,
+	19, 91, 19, 91, -129, -296, -284, 76, 13, 12,
+	101, 91, -59, -297, -297, -60, 224, 453, -276, -128,
+	124, 448, 114, -115, -128, 124, 62, -161, 54, 76,
+	104, 91, 91, 91, -124, -282, 195, 190, 193, 32,
+	-282, 187, -273, -128, 447, 115, 116, 115, 116, -128,
+	362, 76, 104, -297, 189, 31, 101, 226, 121, 121,
+	363, -128, -128, 364,
 }
 
 var yyDef = [...]int{
 	34, -2, 2, 4, 5, 6, 7, 8, 9, 10,
 	11, 12, 13, 14, 15, 16, 17, 18, 19, 20,
 	21, 22, 23, 24, 25, 26, 27, 28, 29, 30,
-	31, 32, 33, 843, 0, 575, 575, 575, 575, 575,
-	575, 575, 0, 0, 575, -2, -2, 575, 979, 0,
-	575, 0, 0, -2, 508, 509, 0, 511, -2, 0,
-	0, 520, 1398, 1398, 570, 0, 0, 0, 0, 0,
-	575, 1396, 55, 56, 526, 527, 528, 1, 3, 0,
-	579, 851, 0, 0, -2, 577, 0, 0, 962, 962,
-	962, 0, 86, 87, 0, 0, 0, -2, 90, -2,
-	114, 115, 0, 119, 383, 344, 386, 342, 372, -2,
-	335, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 347, 237, 237, 0, 0, -2, 335,
-	335, 335, 0, 0, 0, 369, 964, 290, 237, 237,
-	0, 237, 237, 237, 237, 0, 0, 237, 237, 237,
+	31, 32, 33, 879, 0, 581, 581, 581, 581, 581,
+	581, 581, 0, 0, 581, -2, -2, 581, 1015, 0,
+	581, 0, 0, -2, 510, 511, 0, 513, -2, 0,
+	0, 522, 0, 1443, 1443, 576, 0, 0, 0, 0,
+	0, 581, 1441, 55, 56, 530, 531, 532, 1, 3,
+	0, 585, 588, 591, 887, 0, 0, -2, 583, 0,
+	0, 998, 998, 998, 0, 86, 87, 0, 0, 0,
+	-2, 90, -2, 114, 115, 0, 119, 383, 344, 386,
+	342, 372, -2, 335, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 347, 237, 237, 0,
+	0, -2, 335, 335, 335, 0, 0, 0, 369, 1000,
+	290, 237, 237, 0, 237, 237, 237, 237, 0, 0,
 	237, 237, 237, 237, 237, 237, 237, 237, 237, 237,
-	237, 237, 867, 118, 980, 977, 978, 35, 36, 37,
+	237, 237, 237, 237, 237, 903, 118, 1016, 1013, 1014,
+	35, 36, 37, 1160, 1161, 1162, 1163, 1164, 1165, 1166,
+	1167, 1168, 1169, 1170, 1171, 1172, 1173, 1174, 1175, 1176,
+	1177, 1178, 1179, 1180, 1181, 1182, 1183, 1184, 1185, 1186,
+	1187, 1188, 1189, 1190, 1191, 1192, 1193, 1194, 1195, 1196,
+	1197, 1198, 1199, 1200, 1201, 1202, 1203, 1204, 1205, 1206,
+	1207, 1208, 1209, 1210, 1211, 1212, 1213, 1214, 1215, 1216,
+	1217, 1218, 1219, 1220, 1221, 1222, 1223, 1224, 1225, 1226,
+	1227, 1228, 1229, 1230, 1231, 1232, 1233, 1234, 1235, 1236,
+	1237, 1238, 1239, 1240, 1241, 1242, 1243, 1244, 1245, 1246,
+	1247, 1248, 1249, 1250, 1251, 1252, 1253, 1254, 1255, 1256,
+	1257, 1258, 1259, 1260, 1261, 1262, 1263, 1264, 1265, 1266,
+	1267, 1268, 1269, 1270, 1271, 1272, 1273, 1274, 1275, 1276,
+	1277, 1278, 1279, 1280, 1281, 1282, 1283, 1284, 1285, 1286,
+	1287, 1288, 1289, 1290, 1291, 1292, 1293, 1294, 1295, 1296,
+	1297, 1298, 1299, 1300, 1301, 1302, 1303, 1304, 1305, 1306,
+	1307, 1308, 1309, 1310, 1311, 1312, 1313, 1314, 1315, 1316,
+	1317, 1318, 1319, 1320, 1321, 1322, 1323, 1324, 1325, 1326,
+	1327, 1328, 1329, 1330, 1331, 1332, 1333, 1334, 1335, 1336,
+	1337, 1338, 1339, 1340, 1341, 1342, 1343, 1344, 1345, 1346,
+	1347, 1348, 1349, 1350, 1351, 1352, 1353, 1354, 1355, 1356,
+	1357, 1358, 1359, 1360, 1361, 1362, 1363, 1364, 1365, 1366,
+	1367, 1368, 1369, 1370, 1371, 1372, 1373, 1374, 1375, 1376,
+	1377, 1378, 1379, 1380, 1381, 1382, 1383, 1384, 1385, 1386,
+	1387, 1388, 1389, 1390, 1391, 1392, 1393, 1394, 1395, 1396,
+	1397, 1398, 1399, 1400, 1401, 1402, 1403, 1404, 1405, 1406,
+	1407, 1408, 1409, 1410, 1411, 1412, 1413, 1414, 1415, 1416,
+	1417, 1418, 1419, 1420, 1421, 1422, 1423, 1424, 1425, 1426,
+	1427, 1428, 1429, 1430, 1431, 1432, 1433, 1434, 1435, 1436,
+	1437, 1438, 1439, 1440, 0, 992, 0, 439, 676, 0,
+	501, 501, 0, 501, 501, 501, 501, 0, 0, 0,
+	451, 0, 0, 0, 0, 498, 0, 0, 470, 472,
+	0, 498, 0, 501, 0, 485, 501, 1444, 1444, 1444,
+	983, 0, 495, 493, 507, 508, 490, 491, 509, 512,
+	0, 517, 520, 1009, 1010, 0, 537, 0, 1240, 527,
+	528, 528, 544, 545, 0, 577, 578, 40, 727, 686,
+	0, 692, 694, 0, 729, 730, 731, 732, 733, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 759,
+	760, 761, 762, 864, 865, 866, 867, 868, 869, 870,
+	871, 696, 697, 861, 0, 972, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 852, 0, 821, 821, 821,
+	821, 821, 821, 821, 821, 821, 0, 0, 0, 0,
+	0, 0, 0, -2, -2, 1443, 0, 554, 0, 0,
+	879, 51, 0, 581, 586, 587, 589, 590, 592, 593,
+	922, 0, 0, 879, 1442, 0, 0, -2, -2, 603,
+	609, 610, 611, 612, 613, 582, 0, 616, 620, 0,
+	0, 0, 999, 0, 0, 72, 0, 1403, 976, -2,
+	-2, 0, 0, 1011, 1012, 985, -2, 1019, 1020, 1021,
+	1022, 1023, 1024, 1025, 1026, 1027, 1028, 1029, 1030, 1031,
+	1032, 1033, 1034, 1035, 1036, 1037, 1038, 1039, 1040, 1041,
+	1042, 1043, 1044, 1045, 1046, 1047, 1048, 1049, 1050, 1051,
+	1052, 1053, 1054, 1055, 1056, 1057, 1058, 1059, 1060, 1061,
+	1062, 1063, 1064, 1065, 1066, 1067, 1068, 1069, 1070, 1071,
+	1072, 1073, 1074, 1075, 1076, 1077, 1078, 1079, 1080, 1081,
+	1082, 1083, 1084, 1085, 1086, 1087, 1088, 1089, 1090, 1091,
+	1092, 1093, 1094, 1095, 1096, 1097, 1098, 1099, 1100, 1101,
+	1102, 1103, 1104, 1105, 1106, 1107, 1108, 1109, 1110, 1111,
+	1112, 1113, 1114, 1115, 1116, 1117, 1118, 1119, 1120, 1121,
 	1122, 1123, 1124, 1125, 1126, 1127, 1128, 1129, 1130, 1131,
 	1132, 1133, 1134, 1135, 1136, 1137, 1138, 1139, 1140, 1141,
 	1142, 1143, 1144, 1145, 1146, 1147, 1148, 1149, 1150, 1151,
-	1152, 1153, 1154, 1155, 1156, 1157, 1158, 1159, 1160, 1161,
-	1162, 1163, 1164, 1165, 1166, 1167, 1168, 1169, 1170, 1171,
-	1172, 1173, 1174, 1175, 1176, 1177, 1178, 1179, 1180, 1181,
-	1182, 1183, 1184, 1185, 1186, 1187, 1188, 1189, 1190, 1191,
-	1192, 1193, 1194, 1195, 1196, 1197, 1198, 1199, 1200, 1201,
-	1202, 1203, 1204, 1205, 1206, 1207, 1208, 1209, 1210, 1211,
-	1212, 1213, 1214, 1215, 1216, 1217, 1218, 1219, 1220, 1221,
-	1222, 1223, 1224, 1225, 1226, 1227, 1228, 1229, 1230, 1231,
-	1232, 1233, 1234, 1235, 1236, 1237, 1238, 1239, 1240, 1241,
-	1242, 1243, 1244, 1245, 1246, 1247, 1248, 1249, 1250, 1251,
-	1252, 1253, 1254, 1255, 1256, 1257, 1258, 1259, 1260, 1261,
-	1262, 1263, 1264, 1265, 1266, 1267, 1268, 1269, 1270, 1271,
-	1272, 1273, 1274, 1275, 1276, 1277, 1278, 1279, 1280, 1281,
-	1282, 1283, 1284, 1285, 1286, 1287, 1288, 1289, 1290, 1291,
-	1292, 1293, 1294, 1295, 1296, 1297, 1298, 1299, 1300, 1301,
-	1302, 1303, 1304, 1305, 1306, 1307, 1308, 1309, 1310, 1311,
-	1312, 1313, 1314, 1315, 1316, 1317, 1318, 1319, 1320, 1321,
-	1322, 1323, 1324, 1325, 1326, 1327, 1328, 1329, 1330, 1331,
-	1332, 1333, 1334, 1335, 1336, 1337, 1338, 1339, 1340, 1341,
-	1342, 1343, 1344, 1345, 1346, 1347, 1348, 1349, 1350, 1351,
-	1352, 1353, 1354, 1355, 1356, 1357, 1358, 1359, 1360, 1361,
-	1362, 1363, 1364, 1365, 1366, 1367, 1368, 1369, 1370, 1371,
-	1372, 1373, 1374, 1375, 1376, 1377, 1378, 1379, 1380, 1381,
-	1382, 1383, 1384, 1385, 1386, 1387, 1388, 1389, 1390, 1391,
-	1392, 1393, 1394, 1395, 0, 956, 0, 439, 664, 0,
-	499, 499, 0, 499, 499, 499, 499, 0, 0, 0,
-	451, 0, 0, 0, 0, 496, 0, 0, 470, 472,
-	0, 496, 0, 0, 483, 499, 1399, 1399, 1399, 947,
-	0, 493, 491, 505, 506, 488, 489, 507, 510, 0,
-	515, 518, 973, 974, 0, 533, 0, 1201, 525, 538,
-	539, 0, 571, 572, 40, 715, 674, 0, 680, 682,
-	0, 717, 718, 719, 720, 721, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 747, 748, 749, 750,
-	828, 829, 830, 831, 832, 833, 834, 835, 684, 685,
-	825, 0, 936, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 816, 0, 785, 785, 785, 785, 785, 785,
-	785, 785, 785, 0, 0, 0, 0, 0, 0, 0,
-	-2, -2, 1398, 0, 548, 0, 0, 843, 51, 0,
-	575, 580, 581, 886, 0, 0, 843, 1397, 0, 0,
-	-2, -2, 591, 597, 598, 599, 600, 601, 576, 0,
-	604, 608, 0, 0, 0, 963, 0, 0, 72, 0,
-	1360, 940, -2, -2, 0, 0, 975, 976, 949, -2,
-	983, 984, 985, 986, 987, 988, 989, 990, 991, 992,
-	993, 994, 995, 996, 997, 998, 999, 1000, 1001, 1002,
-	1003, 1004, 1005, 1006, 1007, 1008, 1009, 1010, 1011, 1012,
-	1013, 1014, 1015, 1016, 1017, 1018, 1019, 1020, 1021, 1022,
-	1023, 1024, 1025, 1026, 1027, 1028, 1029, 1030, 1031, 1032,
-	1033, 1034, 1035, 1036, 1037, 1038, 1039, 1040, 1041, 1042,
-	1043, 1044, 1045, 1046, 1047, 1048, 1049, 1050, 1051, 1052,
-	1053, 1054, 1055, 1056, 1057, 1058, 1059, 1060, 1061, 1062,
-	1063, 1064, 1065, 1066, 1067, 1068, 1069, 1070, 1071, 1072,
-	1073, 1074, 1075, 1076, 1077, 1078, 1079, 1080, 1081, 1082,
-	1083, 1084, 1085, 1086, 1087, 1088, 1089, 1090, 1091, 1092,
-	1093, 1094, 1095, 1096, 1097, 1098, 1099, 1100, 1101, 1102,
-	1103, 1104, 1105, 1106, 1107, 1108, 1109, 1110, 1111, 1112,
-	1113, 1114, 1115, 1116, 1117, 1118, 1119, 1120, 1121, -2,
-	0, 0, 128, 129, 0, 38, 263, 0, 124, 0,
-	257, 209, 867, 0, 0, 0, 0, 0, 575, 0,
-	957, 109, 110, 116, 117, 237, 237, 0, 118, 118,
-	351, 352, 353, 0, 0, -2, 261, 0, 336, 0,
-	0, 251, 251, 255, 253, 254, 0, 0, 0, 0,
-	0, 0, 363, 0, 364, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 423, 0, 238, 0, 381, 382,
-	291, 0, 0, 0, 0, 361, 362, 0, 0, 965,
-	966, 0, 0, 237, 237, 0, 0, 0, 0, 237,
-	237, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 108, 880, 0,
-	0, 0, -2, 0, 431, 0, 0, 0, 958, 958,
-	438, 0, 440, 441, 0, 0, 442, 0, 496, 496,
-	494, 495, 444, 445, 446, 447, 499, 0, 0, 246,
-	247, 248, 496, 499, 0, 499, 499, 499, 499, 496,
-	496, 496, 499, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1399, 1399, 1399, 502, 499, 0, 480, 481,
-	484, 485, 1400, 1401, 994, 486, 487, 948, 516, 519,
-	536, 534, 535, 537, 529, 530, 531, 532, 0, 550,
-	551, 556, 0, 0, 0, 0, 562, 563, 564, 0,
-	0, 567, 568, 569, 0, 0, 0, 0, 0, 678,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 702,
-	703, 704, 705, 706, 707, 708, 681, 0, 695, 0,
-	0, 0, 737, 738, 739, 740, 741, 742, 743, 744,
-	745, 0, 588, 0, 0, 0, 843, 0, 0, 0,
-	0, 0, 0, 0, 585, 0, 817, 0, 768, 0,
-	769, 777, 0, 770, 778, 771, 779, 772, 773, 780,
-	774, 781, 775, 776, 782, 0, 0, 0, 588, 588,
-	0, 0, 41, 540, 541, 0, 647, 968, 0, 851,
-	0, 590, 889, 0, 0, 852, 844, 845, 848, 851,
-	0, 613, 602, 592, 595, 596, 578, 0, 605, 609,
-	0, 611, 612, 0, 0, 70, 0, 663, 0, 615,
-	617, 618, 619, 645, 0, 0, 0, 0, 66, 68,
-	664, 0, 1360, 946, 0, 74, 75, 0, 0, 0,
-	225, 951, 952, 953, -2, 244, 0, -2, 216, 160,
-	161, 162, 209, 164, 209, 209, 209, 209, 221, 221,
-	221, 221, 192, 193, 194, 195, 196, 0, 0, 179,
-	209, 209, 209, 209, 199, 200, 201, 202, 203, 204,
-	205, 206, 165, 166, 167, 168, 169, 170, 171, 172,
-	173, 211, 211, 211, 213, 213, 0, 39, 0, 229,
-	0, 848, 0, 880, 960, 970, 0, 0, 0, 960,
-	92, 0, 0, 384, 345, 373, 385, 0, 348, 349,
-	-2, 0, 0, 335, 0, 337, 0, 245, 0, -2,
-	0, 255, 0, 251, 255, 252, 255, 243, 256, 365,
-	825, 0, 366, 367, 0, 403, 633, 0, 0, 0,
-	0, 0, 409, 410, 411, 0, 413, 414, 415, 416,
-	417, 418, 419, 420, 421, 422, 374, 375, 376, 377,
-	378, 379, 380, 0, 0, 337, 0, 370, 0, 292,
-	293, 0, 0, 296, 297, 298, 299, 0, 0, 302,
-	303, 304, 650, 651, 305, 329, 330, 331, 306, 307,
-	308, 309, 310, 311, 312, 323, 324, 325, 326, 327,
-	328, 313, 314, 315, 316, 317, 320, 0, 102, 871,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 958, 0, 0, 0, 0, 665, 981, 982, 500,
-	501, 0, 249, 250, 499, 499, 448, 471, 0, 499,
-	452, 473, 453, 455, 454, 456, 475, 476, 499, 459,
-	497, 498, 460, 461, 462, 463, 464, 465, 466, 467,
-	468, 469, 477, 0, 478, 479, 0, 0, 517, 521,
-	522, 523, 524, 0, 0, 553, 558, 559, 560, 561,
-	573, 566, 716, 675, 676, 677, 679, 696, 0, 698,
-	700, 686, 687, 711, 712, 713, 0, 0, 0, 0,
-	709, 691, 0, 722, 723, 724, 725, 726, 727, 728,
-	729, 730, 731, 732, 733, 736, 800, 801, 802, 0,
-	734, 735, 746, 0, 0, 0, 589, 826, 0, -2,
-	0, 714, 935, 851, 0, 0, 0, 0, 719, 828,
-	0, 719, 828, 0, 0, 0, 586, 587, 823, 820,
-	0, 0, 786, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 543, 544, 546, 0, 667, 0, 648, 0,
-	0, 969, 549, 886, 52, 42, 0, 887, 0, 0,
-	0, 0, 847, 849, 850, 886, 0, 836, 0, 0,
-	672, 0, 0, 593, 48, 610, 606, 0, 672, 0,
-	0, 662, 0, 0, 0, 0, 0, 0, 652, 0,
-	0, 655, 0, 0, 0, 0, 646, 0, 0, 0,
-	-2, 0, 0, 0, 62, 63, 0, 0, 0, 941,
-	73, 0, 0, 78, 79, 942, 943, 944, 945, 0,
-	111, -2, 287, 130, 132, 133, 134, 125, 269, 0,
-	0, 219, 217, 218, 163, 221, 221, 186, 187, 225,
-	0, 225, 225, 225, 0, 0, 180, 181, 182, 183,
-	174, 0, 175, 176, 177, 0, 178, 262, 0, 855,
-	230, 231, 233, 237, 0, 0, 0, 258, 259, 0,
-	871, 0, 0, 0, 971, 970, 970, 970, 0, 120,
-	121, 122, 123, 118, 0, 0, 126, 339, 338, 0,
-	0, 260, 0, 0, 255, 255, 240, 241, 0, 368,
-	0, 0, 405, 406, 407, 408, 0, 0, 0, 337,
-	339, 225, 0, 294, 295, 300, 301, 318, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 398,
-	399, 400, 401, 868, 869, 870, 0, 0, 432, 0,
-	0, 279, 64, 959, 437, 496, 458, 474, 496, 450,
-	457, 503, 482, 513, 557, 0, 0, 0, 565, 0,
-	697, 699, 701, 688, 709, 692, 0, 689, 0, 0,
-	683, 751, 0, 0, 588, 0, 843, 886, 755, 756,
-	0, 0, 0, 0, 0, 793, 0, 0, 794, 0,
-	843, 0, 821, 0, 0, 767, 787, 0, 0, 788,
-	789, 790, 791, 792, 542, 545, 547, 623, 0, 0,
-	0, 0, 649, 967, 44, 0, 0, 0, 853, 854,
-	846, 43, 0, 954, 955, 837, 838, 839, 0, 603,
-	614, 594, 0, 851, 929, 0, 0, 921, 0, 0,
-	672, 937, 0, 616, 641, 643, 0, 638, 653, 654,
-	656, 0, 658, 0, 660, 661, 620, 621, 622, 0,
-	672, 0, 672, 67, 672, 69, 0, 666, 76, 77,
-	0, 0, 83, 226, 227, 118, 289, 131, 135, 140,
-	0, 0, 0, 144, 0, 146, 270, 0, 156, 158,
-	0, 0, 138, 159, 220, 225, 225, 188, 222, 223,
-	224, 189, 190, 191, 0, 207, 0, 0, 0, 282,
-	88, 859, 858, 237, 237, 232, 0, 235, 0, 972,
-	210, 0, 101, 0, 0, 0, 0, 0, 107, 0,
-	343, 627, 0, 354, 355, 0, 402, 0, 229, 0,
-	239, 242, 826, 634, 0, 0, 356, 0, 339, 359,
-	360, 371, 321, 322, 319, 0, 0, 881, 882, 0,
-	885, 93, 391, 393, 392, 396, 0, 0, 389, 0,
-	279, 855, 0, 436, 280, 281, 499, 499, 552, 0,
-	555, 0, 690, 0, 710, 693, 752, 753, 0, 827,
-	851, 46, 0, 209, 209, 806, 209, 213, 809, 209,
-	811, 209, 814, 0, 0, 0, 0, 0, 0, 0,
-	818, 766, 824, 0, 0, 0, 0, 0, 0, 0,
-	0, 221, 891, 888, 45, 841, 0, 673, 607, 49,
-	53, 0, 929, 920, 931, 933, 0, 0, 0, 925,
-	0, 843, 0, 0, 635, 642, 0, 0, 636, 0,
-	637, 657, 659, -2, 843, 672, 60, 61, 0, 80,
-	81, 82, 288, 141, 142, 0, 145, 155, 157, 0,
-	0, 184, 185, 221, 0, 221, 0, 214, 0, 271,
-	283, 0, 856, 857, 0, 0, 234, 236, 0, 961,
-	103, 0, 0, 0, 0, 0, 127, 340, 0, 228,
-	0, 0, 427, 424, 357, 358, 625, 872, 873, 874,
-	0, 884, 96, 0, 0, 0, 0, 433, 434, 435,
-	65, 443, 449, 554, 574, 694, 754, 886, 757, 803,
-	221, 807, 808, 810, 812, 813, 815, 759, 758, 0,
-	0, 0, 0, 0, 851, 0, 822, 0, 0, 0,
-	0, 0, 647, 221, 911, 50, 0, 0, 0, 54,
-	0, 934, 0, 0, 0, 0, 71, 851, 938, 939,
-	639, 0, 644, 851, 59, 143, 0, 0, 225, 208,
-	225, 0, 0, 284, 860, 861, 862, 863, 864, 865,
-	866, 625, 104, 105, 106, 346, 628, 0, 0, 404,
-	0, 412, 0, 0, 0, 0, 883, 390, 0, 94,
-	95, 0, 0, 395, 47, 804, 805, 0, 0, 0,
-	0, 795, 0, 819, 0, 0, 0, 669, 629, 630,
-	0, 0, 667, 893, 892, 905, 918, 842, 840, 0,
-	932, 0, 924, 927, 923, 926, 57, 0, 58, 0,
-	149, 197, 198, 212, 215, 0, 0, 264, 428, 425,
-	426, 0, 0, 97, 98, 0, 0, 0, 760, 762,
-	761, 763, 0, 0, 0, 765, 783, 784, 0, 668,
-	670, 671, 624, 911, 0, 904, 0, -2, 913, 0,
-	0, 0, 919, 0, 922, 0, 640, 265, 269, 0,
-	0, 875, 626, 0, 0, 0, 397, 764, 0, 0,
-	0, 631, 632, 898, 896, 896, 906, 907, 0, 0,
-	914, 0, 0, 0, 930, 928, 266, 267, 0, 136,
-	150, 151, 0, 0, 154, 147, 148, 875, 0, 0,
-	387, 877, 99, 100, 332, 333, 334, 93, 796, 0,
-	799, 901, 0, 894, 897, 895, 908, 0, 0, 915,
-	0, 917, 268, 0, 0, 0, 152, 153, 89, 429,
-	430, 0, 878, 879, 96, 797, 890, 0, 899, 900,
-	909, 910, 916, 272, 274, 275, 0, 0, 273, 0,
-	394, 0, 902, 903, 276, 277, 278, 876, 0, 798,
+	1152, 1153, 1154, 1155, 1156, 1157, 1158, 1159, -2, 0,
+	0, 128, 129, 0, 38, 263, 0, 124, 0, 257,
+	209, 903, 0, 0, 0, 0, 0, 581, 0, 993,
+	109, 110, 116, 117, 237, 237, 0, 118, 118, 351,
+	352, 353, 0, 0, -2, 261, 0, 336, 0, 0,
+	251, 251, 255, 253, 254, 0, 0, 0, 0, 0,
+	0, 363, 0, 364, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 423, 0, 238, 0, 381, 382, 291,
+	0, 0, 0, 0, 361, 362, 0, 0, 1001, 1002,
+	0, 0, 237, 237, 0, 0, 0, 0, 237, 237,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 108, 916, 0, 0,
+	0, -2, 0, 431, 0, 0, 0, 994, 994, 438,
+	0, 440, 441, 0, 0, 442, 0, 498, 498, 496,
+	497, 444, 445, 446, 447, 501, 0, 0, 246, 247,
+	248, 498, 501, 0, 501, 501, 501, 501, 498, 498,
+	498, 501, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1444, 1444, 1444, 504, 501, 0, 480, 481, 482,
+	483, 486, 487, 1445, 1446, 1030, 488, 489, 984, 518,
+	521, 540, 538, 539, 541, 533, 534, 535, 536, 0,
+	0, 0, 0, 556, 557, 562, 0, 0, 0, 0,
+	568, 569, 570, 0, 0, 573, 574, 575, 0, 0,
+	0, 0, 0, 690, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 714, 715, 716, 717, 718, 719, 720,
+	693, 0, 707, 0, 0, 0, 749, 750, 751, 752,
+	753, 754, 755, 756, 757, 0, 600, 0, 0, 0,
+	879, 0, 0, 0, 0, 0, 0, 0, 597, 0,
+	853, 0, 804, 0, 805, 813, 0, 806, 814, 807,
+	815, 808, 809, 816, 810, 817, 811, 812, 818, 0,
+	0, 0, 600, 600, 0, 0, 41, 546, 547, 0,
+	659, 1004, 0, 887, 0, 602, 925, 0, 0, 888,
+	880, 881, 884, 887, 0, 625, 614, 604, 607, 608,
+	584, 0, 617, 621, 0, 623, 624, 0, 0, 70,
+	0, 675, 0, 627, 629, 630, 631, 657, 0, 0,
+	0, 0, 66, 68, 676, 0, 1403, 982, 0, 74,
+	75, 0, 0, 0, 225, 987, 988, 989, -2, 244,
+	0, -2, 216, 160, 161, 162, 209, 164, 209, 209,
+	209, 209, 221, 221, 221, 221, 192, 193, 194, 195,
+	196, 0, 0, 179, 209, 209, 209, 209, 199, 200,
+	201, 202, 203, 204, 205, 206, 165, 166, 167, 168,
+	169, 170, 171, 172, 173, 211, 211, 211, 213, 213,
+	0, 39, 0, 229, 0, 884, 0, 916, 996, 1006,
+	0, 0, 0, 996, 92, 0, 0, 384, 345, 373,
+	385, 0, 348, 349, -2, 0, 0, 335, 0, 337,
+	0, 245, 0, -2, 0, 255, 0, 251, 255, 252,
+	255, 243, 256, 365, 861, 0, 366, 367, 0, 403,
+	645, 0, 0, 0, 0, 0, 409, 410, 411, 0,
+	413, 414, 415, 416, 417, 418, 419, 420, 421, 422,
+	374, 375, 376, 377, 378, 379, 380, 0, 0, 337,
+	0, 370, 0, 292, 293, 0, 0, 296, 297, 298,
+	299, 0, 0, 302, 303, 304, 662, 663, 305, 329,
+	330, 331, 306, 307, 308, 309, 310, 311, 312, 323,
+	324, 325, 326, 327, 328, 313, 314, 315, 316, 317,
+	320, 0, 102, 907, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 994, 0, 0, 0, 0,
+	677, 1017, 1018, 502, 503, 0, 249, 250, 501, 501,
+	448, 471, 0, 501, 452, 473, 453, 455, 454, 456,
+	475, 476, 501, 459, 499, 500, 460, 461, 462, 463,
+	464, 465, 466, 467, 468, 469, 477, 0, 478, 479,
+	0, 0, 519, 523, 524, 525, 526, 542, 0, 543,
+	0, 0, 559, 564, 565, 566, 567, 579, 572, 728,
+	687, 688, 689, 691, 708, 0, 710, 712, 698, 699,
+	723, 724, 725, 0, 0, 0, 0, 721, 703, 0,
+	734, 735, 736, 737, 738, 739, 740, 741, 742, 743,
+	744, 745, 748, 836, 837, 838, 0, 746, 747, 758,
+	0, 0, 0, 601, 862, 0, -2, 0, 726, 971,
+	887, 0, 0, 0, 0, 731, 864, 0, 731, 864,
+	0, 0, 0, 598, 599, 859, 856, 0, 0, 822,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 549,
+	550, 552, 0, 679, 0, 660, 0, 0, 1005, 555,
+	922, 52, 42, 0, 923, 0, 0, 0, 0, 883,
+	885, 886, 922, 0, 872, 0, 0, 684, 0, 0,
+	605, 48, 622, 618, 0, 684, 0, 0, 674, 0,
+	0, 0, 0, 0, 0, 664, 0, 0, 667, 0,
+	0, 0, 0, 658, 0, 0, 0, -2, 0, 0,
+	0, 62, 63, 0, 0, 0, 977, 73, 0, 0,
+	78, 79, 978, 979, 980, 981, 0, 111, -2, 287,
+	130, 132, 133, 134, 125, 269, 0, 0, 219, 217,
+	218, 163, 221, 221, 186, 187, 225, 0, 225, 225,
+	225, 0, 0, 180, 181, 182, 183, 174, 0, 175,
+	176, 177, 0, 178, 262, 0, 891, 230, 231, 233,
+	237, 0, 0, 0, 258, 259, 0, 907, 0, 0,
+	0, 1007, 1006, 1006, 1006, 0, 120, 121, 122, 123,
+	118, 0, 0, 126, 339, 338, 0, 0, 260, 0,
+	0, 255, 255, 240, 241, 0, 368, 0, 0, 405,
+	406, 407, 408, 0, 0, 0, 337, 339, 225, 0,
+	294, 295, 300, 301, 318, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 398, 399, 400, 401,
+	904, 905, 906, 0, 0, 432, 0, 0, 279, 64,
+	995, 437, 498, 458, 474, 498, 450, 457, 505, 484,
+	515, 529, 563, 0, 0, 0, 571, 0, 709, 711,
+	713, 700, 721, 704, 0, 701, 0, 0, 695, 767,
+	0, 0, 600, 0, 879, 922, 791, 792, 0, 0,
+	0, 0, 0, 829, 0, 0, 830, 0, 879, 0,
+	857, 0, 0, 803, 823, 0, 0, 824, 825, 826,
+	827, 828, 548, 551, 553, 635, 0, 0, 0, 0,
+	661, 1003, 44, 0, 0, 0, 889, 890, 882, 43,
+	0, 990, 991, 873, 874, 875, 0, 615, 626, 606,
+	0, 887, 965, 0, 0, 957, 0, 0, 684, 973,
+	0, 628, 653, 655, 0, 650, 665, 666, 668, 0,
+	670, 0, 672, 673, 632, 633, 634, 0, 684, 0,
+	684, 67, 684, 69, 0, 678, 76, 77, 0, 0,
+	83, 226, 227, 118, 289, 131, 135, 140, 0, 0,
+	0, 144, 0, 146, 270, 0, 156, 158, 0, 0,
+	138, 159, 220, 225, 225, 188, 222, 223, 224, 189,
+	190, 191, 0, 207, 0, 0, 0, 282, 88, 895,
+	894, 237, 237, 232, 0, 235, 0, 1008, 210, 0,
+	101, 0, 0, 0, 0, 0, 107, 0, 343, 639,
+	0, 354, 355, 0, 402, 0, 229, 0, 239, 242,
+	862, 646, 0, 0, 356, 0, 339, 359, 360, 371,
+	321, 322, 319, 0, 0, 917, 918, 0, 921, 93,
+	391, 393, 392, 396, 0, 0, 389, 0, 279, 891,
+	0, 436, 280, 281, 501, 501, 558, 0, 561, 0,
+	702, 0, 722, 705, 763, 768, 0, 764, 765, 0,
+	863, 887, 46, 0, 209, 209, 842, 209, 213, 845,
+	209, 847, 209, 850, 0, 0, 0, 0, 0, 0,
+	0, 854, 802, 860, 0, 0, 0, 0, 0, 0,
+	0, 0, 221, 927, 924, 45, 877, 0, 685, 619,
+	49, 53, 0, 965, 956, 967, 969, 0, 0, 0,
+	961, 0, 879, 0, 0, 647, 654, 0, 0, 648,
+	0, 649, 669, 671, -2, 879, 684, 60, 61, 0,
+	80, 81, 82, 288, 141, 142, 0, 145, 155, 157,
+	0, 0, 184, 185, 221, 0, 221, 0, 214, 0,
+	271, 283, 0, 892, 893, 0, 0, 234, 236, 0,
+	997, 103, 0, 0, 0, 0, 0, 127, 340, 0,
+	228, 0, 0, 427, 424, 357, 358, 637, 908, 909,
+	910, 0, 920, 96, 0, 0, 0, 0, 433, 434,
+	435, 65, 443, 449, 560, 580, 706, 772, 770, 766,
+	922, 793, 839, 221, 843, 844, 846, 848, 849, 851,
+	795, 794, 0, 0, 0, 0, 0, 887, 0, 858,
+	0, 0, 0, 0, 0, 659, 221, 947, 786, 0,
+	0, 0, 54, 0, 970, 0, 0, 0, 0, 71,
+	887, 974, 975, 651, 0, 656, 887, 59, 143, 0,
+	0, 225, 208, 225, 0, 0, 284, 896, 897, 898,
+	899, 900, 901, 902, 637, 104, 105, 106, 346, 640,
+	0, 0, 404, 0, 412, 0, 0, 0, 0, 919,
+	390, 0, 94, 95, 0, 0, 395, 0, 774, 773,
+	47, 840, 841, 0, 0, 0, 0, 831, 0, 855,
+	0, 0, 0, 681, 641, 642, 0, 0, 679, 929,
+	928, 941, 954, 50, 0, 878, 876, 0, 968, 0,
+	960, 963, 959, 962, 57, 0, 58, 0, 149, 197,
+	198, 212, 215, 0, 0, 264, 428, 425, 426, 0,
+	0, 97, 98, 0, 0, 0, 769, 879, 0, 796,
+	798, 797, 799, 0, 0, 0, 801, 819, 820, 0,
+	680, 682, 683, 636, 947, 0, 940, 0, -2, 949,
+	0, 0, 0, 955, 787, 788, 0, 0, 958, 0,
+	652, 265, 269, 0, 0, 911, 638, 0, 0, 0,
+	397, 776, 0, 800, 0, 0, 0, 643, 644, 934,
+	932, 932, 942, 943, 0, 0, 950, 0, 0, 0,
+	0, 0, 966, 964, 266, 267, 0, 136, 150, 151,
+	0, 0, 154, 147, 148, 911, 0, 0, 387, 913,
+	99, 100, 332, 333, 334, 93, 771, 0, 0, 775,
+	832, 0, 835, 937, 0, 930, 933, 931, 944, 0,
+	0, 951, 0, 953, 789, 772, 268, 0, 0, 0,
+	152, 153, 89, 429, 430, 0, 914, 915, 96, 777,
+	0, 1199, 1408, 0, 778, 0, 833, 926, 0, 935,
+	936, 945, 946, 952, 0, 272, 274, 275, 0, 0,
+	273, 0, 394, 0, 781, 782, 783, 784, 785, 0,
+	0, 938, 939, 790, 276, 277, 278, 912, 0, 0,
+	0, 779, 780, 834,
 }
 
 var yyTok1 = [...]int{
 	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 118, 3, 3, 3, 145, 137, 3,
-	82, 84, 142, 140, 83, 141, 157, 143, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 481,
-	126, 125, 127, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 123, 3, 3, 3, 150, 142, 3,
+	84, 86, 147, 145, 85, 146, 162, 148, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 489,
+	131, 130, 132, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 147, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 152, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 136, 3, 148,
+	3, 3, 3, 3, 141, 3, 153,
 }
 
 var yyTok2 = [...]int{
@@ -5574,13 +5891,13 @@ var yyTok2 = [...]int{
 	52, 53, 54, 55, 56, 57, 58, 59, 60, 61,
 	62, 63, 64, 65, 66, 67, 68, 69, 70, 71,
 	72, 73, 74, 75, 76, 77, 78, 79, 80, 81,
-	85, 86, 87, 88, 89, 90, 91, 92, 93, 94,
+	82, 83, 87, 88, 89, 90, 91, 92, 93, 94,
 	95, 96, 97, 98, 99, 100, 101, 102, 103, 104,
 	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
-	115, 116, 117, 119, 120, 121, 122, 123, 124, 128,
-	129, 130, 131, 132, 133, 134, 135, 138, 139, 144,
-	146, 149, 150, 151, 152, 153, 154, 155, 156, 158,
-	159, 160, 161, 162, 163, 164, 165, 166, 167, 168,
+	115, 116, 117, 118, 119, 120, 121, 122, 124, 125,
+	126, 127, 128, 129, 133, 134, 135, 136, 137, 138,
+	139, 140, 143, 144, 149, 151, 154, 155, 156, 157,
+	158, 159, 160, 161, 163, 164, 165, 166, 167, 168,
 	169, 170, 171, 172, 173, 174, 175, 176, 177, 178,
 	179, 180, 181, 182, 183, 184, 185, 186, 187, 188,
 	189, 190, 191, 192, 193, 194, 195, 196, 197, 198,
@@ -5636,7 +5953,8 @@ var yyTok3 = [...]int{
 	57790, 465, 57791, 466, 57792, 467, 57793, 468, 57794, 469,
 	57795, 470, 57796, 471, 57797, 472, 57798, 473, 57799, 474,
 	57800, 475, 57801, 476, 57802, 477, 57803, 478, 57804, 479,
-	57805, 480, 0,
+	57805, 480, 57806, 481, 57807, 482, 57808, 483, 57809, 484,
+	57810, 485, 57811, 486, 57812, 487, 57813, 488, 0,
 }
 
 var yyErrorMessages = [...]struct {
@@ -5986,68 +6304,68 @@ yydefault:
 
 	case 1:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:435
+//line sql.y:463
 		{
 			setParseTree(yylex, yyDollar[1].statementUnion())
 		}
 	case 2:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:440
+//line sql.y:468
 		{
 		}
 	case 3:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:441
+//line sql.y:469
 		{
 		}
 	case 4:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:445
+//line sql.y:473
 		{
 			yyLOCAL = yyDollar[1].selStmtUnion()
 		}
 		yyVAL.union = yyLOCAL
 	case 34:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:478
+//line sql.y:506
 		{
 			setParseTree(yylex, nil)
 		}
 	case 35:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:484
+//line sql.y:512
 		{
 			yyVAL.colIdent = NewColIdentWithAt(string(yyDollar[1].str), NoAt)
 		}
 	case 36:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:488
+//line sql.y:516
 		{
 			yyVAL.colIdent = NewColIdentWithAt(string(yyDollar[1].str), SingleAt)
 		}
 	case 37:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:492
+//line sql.y:520
 		{
 			yyVAL.colIdent = NewColIdentWithAt(string(yyDollar[1].str), DoubleAt)
 		}
 	case 38:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:497
+//line sql.y:525
 		{
 			yyVAL.colIdent = NewColIdentWithAt("", NoAt)
 		}
 	case 39:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:501
+//line sql.y:529
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
 	case 40:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:507
+//line sql.y:535
 		{
 			yyLOCAL = &OtherAdmin{}
 		}
@@ -6055,7 +6373,7 @@ yydefault:
 	case 41:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:513
+//line sql.y:541
 		{
 			yyLOCAL = &Load{}
 		}
@@ -6063,7 +6381,7 @@ yydefault:
 	case 42:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:519
+//line sql.y:547
 		{
 			sel := yyDollar[1].selStmtUnion().(*Select)
 			sel.OrderBy = yyDollar[2].orderByUnion()
@@ -6076,7 +6394,7 @@ yydefault:
 	case 43:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:528
+//line sql.y:556
 		{
 			yyLOCAL = &Union{FirstStatement: &ParenSelect{Select: yyDollar[2].selStmtUnion()}, OrderBy: yyDollar[4].orderByUnion(), Limit: yyDollar[5].limitUnion(), Lock: yyDollar[6].lockUnion()}
 		}
@@ -6084,15 +6402,15 @@ yydefault:
 	case 44:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:532
+//line sql.y:560
 		{
-			yyLOCAL = Unionize(yyDollar[1].selStmtUnion(), yyDollar[3].selStmtUnion(), yyDollar[2].booleanUnion(), yyDollar[4].orderByUnion(), yyDollar[5].limitUnion(), yyDollar[6].lockUnion())
+			yyLOCAL = SetOp(yyDollar[1].selStmtUnion(), yyDollar[3].selStmtUnion(), yyDollar[2].unionSelectUnion().Type, yyDollar[2].unionSelectUnion().Distinct, yyDollar[4].orderByUnion(), yyDollar[5].limitUnion(), yyDollar[6].lockUnion())
 		}
 		yyVAL.union = yyLOCAL
 	case 45:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:536
+//line sql.y:564
 		{
 			yyLOCAL = NewSelect(Comments(yyDollar[2].strs), SelectExprs{&Nextval{Expr: yyDollar[5].exprUnion()}}, []string{yyDollar[3].str} /*options*/, TableExprs{&AliasedTableExpr{Expr: yyDollar[7].tableName}}, nil /*where*/, nil /*groupBy*/, nil /*having*/)
 		}
@@ -6100,7 +6418,7 @@ yydefault:
 	case 46:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:559
+//line sql.y:587
 		{
 			sel := yyDollar[1].selStmtUnion().(*Select)
 			sel.OrderBy = yyDollar[2].orderByUnion()
@@ -6112,15 +6430,15 @@ yydefault:
 	case 47:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:567
+//line sql.y:595
 		{
-			yyLOCAL = Unionize(yyDollar[1].selStmtUnion(), yyDollar[3].selStmtUnion(), yyDollar[2].booleanUnion(), yyDollar[4].orderByUnion(), yyDollar[5].limitUnion(), yyDollar[6].lockUnion())
+			yyLOCAL = SetOp(yyDollar[1].selStmtUnion(), yyDollar[3].selStmtUnion(), yyDollar[2].unionSelectUnion().Type, yyDollar[2].unionSelectUnion().Distinct, yyDollar[4].orderByUnion(), yyDollar[5].limitUnion(), yyDollar[6].lockUnion())
 		}
 		yyVAL.union = yyLOCAL
 	case 48:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:573
+//line sql.y:601
 		{
 			yyLOCAL = &Stream{Comments: Comments(yyDollar[2].strs), SelectExpr: yyDollar[3].selectExprUnion(), Table: yyDollar[5].tableName}
 		}
@@ -6128,23 +6446,25 @@ yydefault:
 	case 49:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:579
+//line sql.y:607
 		{
 			yyLOCAL = &VStream{Comments: Comments(yyDollar[2].strs), SelectExpr: yyDollar[3].selectExprUnion(), Table: yyDollar[5].tableName, Where: NewWhere(WhereClause, yyDollar[6].exprUnion()), Limit: yyDollar[7].limitUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 50:
-		yyDollar = yyS[yypt-8 : yypt+1]
+		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:587
+//line sql.y:615
 		{
-			yyLOCAL = NewSelect(Comments(yyDollar[2].strs), yyDollar[4].selectExprsUnion() /*SelectExprs*/, yyDollar[3].strs /*options*/, yyDollar[5].tableExprsUnion() /*from*/, NewWhere(WhereClause, yyDollar[6].exprUnion()), GroupBy(yyDollar[7].exprsUnion()), NewWhere(HavingClause, yyDollar[8].exprUnion()))
+			sel := NewSelect(Comments(yyDollar[2].strs), yyDollar[4].selectExprsUnion() /*SelectExprs*/, yyDollar[3].strs /*options*/, yyDollar[5].tableExprsUnion() /*from*/, NewWhere(WhereClause, yyDollar[6].exprUnion()), GroupBy(yyDollar[7].exprsUnion()), NewWhere(HavingClause, yyDollar[8].exprUnion()))
+			sel.Windows = yyDollar[9].namedWindowsUnion()
+			yyLOCAL = sel
 		}
 		yyVAL.union = yyLOCAL
 	case 51:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:593
+//line sql.y:623
 		{
 			yyLOCAL = yyDollar[1].selStmtUnion()
 		}
@@ -6152,7 +6472,7 @@ yydefault:
 	case 52:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:597
+//line sql.y:627
 		{
 			yyLOCAL = &ParenSelect{Select: yyDollar[2].selStmtUnion()}
 		}
@@ -6160,7 +6480,7 @@ yydefault:
 	case 53:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:604
+//line sql.y:634
 		{
 			// insert_data returns a *Insert pre-filled with Columns & Values
 			ins := yyDollar[6].insUnion()
@@ -6176,7 +6496,7 @@ yydefault:
 	case 54:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:616
+//line sql.y:646
 		{
 			cols := make(Columns, 0, len(yyDollar[7].updateExprsUnion()))
 			vals := make(ValTuple, 0, len(yyDollar[8].updateExprsUnion()))
@@ -6190,7 +6510,7 @@ yydefault:
 	case 55:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL InsertAction
-//line sql.y:628
+//line sql.y:658
 		{
 			yyLOCAL = InsertAct
 		}
@@ -6198,7 +6518,7 @@ yydefault:
 	case 56:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL InsertAction
-//line sql.y:632
+//line sql.y:662
 		{
 			yyLOCAL = ReplaceAct
 		}
@@ -6206,7 +6526,7 @@ yydefault:
 	case 57:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:638
+//line sql.y:668
 		{
 			yyLOCAL = &Update{Comments: Comments(yyDollar[2].strs), Ignore: yyDollar[3].ignoreUnion(), TableExprs: yyDollar[4].tableExprsUnion(), Exprs: yyDollar[6].updateExprsUnion(), Where: NewWhere(WhereClause, yyDollar[7].exprUnion()), OrderBy: yyDollar[8].orderByUnion(), Limit: yyDollar[9].limitUnion()}
 		}
@@ -6214,7 +6534,7 @@ yydefault:
 	case 58:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:644
+//line sql.y:674
 		{
 			yyLOCAL = &Delete{Comments: Comments(yyDollar[2].strs), Ignore: yyDollar[3].ignoreUnion(), TableExprs: TableExprs{&AliasedTableExpr{Expr: yyDollar[5].tableName}}, Partitions: yyDollar[6].partitionsUnion(), Where: NewWhere(WhereClause, yyDollar[7].exprUnion()), OrderBy: yyDollar[8].orderByUnion(), Limit: yyDollar[9].limitUnion()}
 		}
@@ -6222,7 +6542,7 @@ yydefault:
 	case 59:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:648
+//line sql.y:678
 		{
 			yyLOCAL = &Delete{Comments: Comments(yyDollar[2].strs), Ignore: yyDollar[3].ignoreUnion(), Targets: yyDollar[5].tableNamesUnion(), TableExprs: yyDollar[7].tableExprsUnion(), Where: NewWhere(WhereClause, yyDollar[8].exprUnion())}
 		}
@@ -6230,7 +6550,7 @@ yydefault:
 	case 60:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:652
+//line sql.y:682
 		{
 			yyLOCAL = &Delete{Comments: Comments(yyDollar[2].strs), Ignore: yyDollar[3].ignoreUnion(), Targets: yyDollar[4].tableNamesUnion(), TableExprs: yyDollar[6].tableExprsUnion(), Where: NewWhere(WhereClause, yyDollar[7].exprUnion())}
 		}
@@ -6238,32 +6558,32 @@ yydefault:
 	case 61:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:656
+//line sql.y:686
 		{
 			yyLOCAL = &Delete{Comments: Comments(yyDollar[2].strs), Ignore: yyDollar[3].ignoreUnion(), Targets: yyDollar[4].tableNamesUnion(), TableExprs: yyDollar[6].tableExprsUnion(), Where: NewWhere(WhereClause, yyDollar[7].exprUnion())}
 		}
 		yyVAL.union = yyLOCAL
 	case 62:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:661
+//line sql.y:691
 		{
 		}
 	case 63:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:662
+//line sql.y:692
 		{
 		}
 	case 64:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableNames
-//line sql.y:666
+//line sql.y:696
 		{
 			yyLOCAL = TableNames{yyDollar[1].tableName.ToViewName()}
 		}
 		yyVAL.union = yyLOCAL
 	case 65:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:670
+//line sql.y:700
 		{
 			yySLICE := (*TableNames)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].tableName.ToViewName())
@@ -6271,14 +6591,14 @@ yydefault:
 	case 66:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableNames
-//line sql.y:676
+//line sql.y:706
 		{
 			yyLOCAL = TableNames{yyDollar[1].tableName}
 		}
 		yyVAL.union = yyLOCAL
 	case 67:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:680
+//line sql.y:710
 		{
 			yySLICE := (*TableNames)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].tableName)
@@ -6286,14 +6606,14 @@ yydefault:
 	case 68:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableNames
-//line sql.y:686
+//line sql.y:716
 		{
 			yyLOCAL = TableNames{yyDollar[1].tableName}
 		}
 		yyVAL.union = yyLOCAL
 	case 69:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:690
+//line sql.y:720
 		{
 			yySLICE := (*TableNames)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].tableName)
@@ -6301,7 +6621,7 @@ yydefault:
 	case 70:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Partitions
-//line sql.y:695
+//line sql.y:725
 		{
 			yyLOCAL = nil
 		}
@@ -6309,7 +6629,7 @@ yydefault:
 	case 71:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Partitions
-//line sql.y:699
+//line sql.y:729
 		{
 			yyLOCAL = yyDollar[3].partitionsUnion()
 		}
@@ -6317,7 +6637,7 @@ yydefault:
 	case 72:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:705
+//line sql.y:735
 		{
 			yyLOCAL = &Set{Comments: Comments(yyDollar[2].strs), Exprs: yyDollar[3].setExprsUnion()}
 		}
@@ -6325,7 +6645,7 @@ yydefault:
 	case 73:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:711
+//line sql.y:741
 		{
 			yyLOCAL = &SetTransaction{Comments: Comments(yyDollar[2].strs), Scope: yyDollar[3].scopeUnion(), Characteristics: yyDollar[5].characteristicsUnion()}
 		}
@@ -6333,7 +6653,7 @@ yydefault:
 	case 74:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:715
+//line sql.y:745
 		{
 			yyLOCAL = &SetTransaction{Comments: Comments(yyDollar[2].strs), Characteristics: yyDollar[4].characteristicsUnion(), Scope: ImplicitScope}
 		}
@@ -6341,14 +6661,14 @@ yydefault:
 	case 75:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []Characteristic
-//line sql.y:721
+//line sql.y:751
 		{
 			yyLOCAL = []Characteristic{yyDollar[1].characteristicUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 76:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:725
+//line sql.y:755
 		{
 			yySLICE := (*[]Characteristic)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].characteristicUnion())
@@ -6356,7 +6676,7 @@ yydefault:
 	case 77:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Characteristic
-//line sql.y:731
+//line sql.y:761
 		{
 			yyLOCAL = yyDollar[3].isolationLevelUnion()
 		}
@@ -6364,7 +6684,7 @@ yydefault:
 	case 78:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Characteristic
-//line sql.y:735
+//line sql.y:765
 		{
 			yyLOCAL = ReadWrite
 		}
@@ -6372,7 +6692,7 @@ yydefault:
 	case 79:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Characteristic
-//line sql.y:739
+//line sql.y:769
 		{
 			yyLOCAL = ReadOnly
 		}
@@ -6380,7 +6700,7 @@ yydefault:
 	case 80:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL IsolationLevel
-//line sql.y:745
+//line sql.y:775
 		{
 			yyLOCAL = RepeatableRead
 		}
@@ -6388,7 +6708,7 @@ yydefault:
 	case 81:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL IsolationLevel
-//line sql.y:749
+//line sql.y:779
 		{
 			yyLOCAL = ReadCommitted
 		}
@@ -6396,7 +6716,7 @@ yydefault:
 	case 82:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL IsolationLevel
-//line sql.y:753
+//line sql.y:783
 		{
 			yyLOCAL = ReadUncommitted
 		}
@@ -6404,7 +6724,7 @@ yydefault:
 	case 83:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IsolationLevel
-//line sql.y:757
+//line sql.y:787
 		{
 			yyLOCAL = Serializable
 		}
@@ -6412,7 +6732,7 @@ yydefault:
 	case 84:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Scope
-//line sql.y:763
+//line sql.y:793
 		{
 			yyLOCAL = SessionScope
 		}
@@ -6420,7 +6740,7 @@ yydefault:
 	case 85:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Scope
-//line sql.y:767
+//line sql.y:797
 		{
 			yyLOCAL = GlobalScope
 		}
@@ -6428,7 +6748,7 @@ yydefault:
 	case 86:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:773
+//line sql.y:803
 		{
 			yyDollar[1].createTableUnion().TableSpec = yyDollar[2].tableSpecUnion()
 			yyDollar[1].createTableUnion().FullyParsed = true
@@ -6438,7 +6758,7 @@ yydefault:
 	case 87:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:779
+//line sql.y:809
 		{
 			// Create table [name] like [name]
 			yyDollar[1].createTableUnion().OptLike = yyDollar[2].optLikeUnion()
@@ -6449,7 +6769,7 @@ yydefault:
 	case 88:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:786
+//line sql.y:816
 		{
 			indexDef := yyDollar[1].alterTableUnion().AlterOptions[0].(*AddIndexDefinition).IndexDefinition
 			indexDef.Columns = yyDollar[3].indexColumnsUnion()
@@ -6462,7 +6782,7 @@ yydefault:
 	case 89:
 		yyDollar = yyS[yypt-12 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:795
+//line sql.y:825
 		{
 			yyLOCAL = &CreateView{ViewName: yyDollar[8].tableName.ToViewName(), IsReplace: yyDollar[3].booleanUnion(), Algorithm: yyDollar[4].str, Definer: yyDollar[5].str, Security: yyDollar[6].str, Columns: yyDollar[9].columnsUnion(), Select: yyDollar[11].selStmtUnion(), CheckOption: yyDollar[12].str}
 		}
@@ -6470,7 +6790,7 @@ yydefault:
 	case 90:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:799
+//line sql.y:829
 		{
 			yyDollar[1].createDatabaseUnion().FullyParsed = true
 			yyDollar[1].createDatabaseUnion().CreateOptions = yyDollar[2].collateAndCharsetsUnion()
@@ -6480,7 +6800,7 @@ yydefault:
 	case 91:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:806
+//line sql.y:836
 		{
 			yyLOCAL = false
 		}
@@ -6488,33 +6808,33 @@ yydefault:
 	case 92:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:810
+//line sql.y:840
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
 	case 93:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:815
+//line sql.y:845
 		{
 			yyVAL.colIdent = NewColIdent("")
 		}
 	case 94:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:819
+//line sql.y:849
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
 	case 95:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:825
+//line sql.y:855
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
 	case 96:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []VindexParam
-//line sql.y:830
+//line sql.y:860
 		{
 			var v []VindexParam
 			yyLOCAL = v
@@ -6523,7 +6843,7 @@ yydefault:
 	case 97:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []VindexParam
-//line sql.y:835
+//line sql.y:865
 		{
 			yyLOCAL = yyDollar[2].vindexParamsUnion()
 		}
@@ -6531,7 +6851,7 @@ yydefault:
 	case 98:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []VindexParam
-//line sql.y:841
+//line sql.y:871
 		{
 			yyLOCAL = make([]VindexParam, 0, 4)
 			yyLOCAL = append(yyLOCAL, yyDollar[1].vindexParam)
@@ -6539,21 +6859,21 @@ yydefault:
 		yyVAL.union = yyLOCAL
 	case 99:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:846
+//line sql.y:876
 		{
 			yySLICE := (*[]VindexParam)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].vindexParam)
 		}
 	case 100:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:852
+//line sql.y:882
 		{
 			yyVAL.vindexParam = VindexParam{Key: yyDollar[1].colIdent, Val: yyDollar[3].str}
 		}
 	case 101:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *CreateTable
-//line sql.y:858
+//line sql.y:888
 		{
 			yyLOCAL = &CreateTable{Comments: Comments(yyDollar[2].strs), Table: yyDollar[6].tableName, IfNotExists: yyDollar[5].booleanUnion(), Temp: yyDollar[3].booleanUnion()}
 			setDDL(yylex, yyLOCAL)
@@ -6562,7 +6882,7 @@ yydefault:
 	case 102:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *AlterTable
-//line sql.y:865
+//line sql.y:895
 		{
 			yyLOCAL = &AlterTable{Comments: Comments(yyDollar[2].strs), Table: yyDollar[4].tableName}
 			setDDL(yylex, yyLOCAL)
@@ -6571,7 +6891,7 @@ yydefault:
 	case 103:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *AlterTable
-//line sql.y:872
+//line sql.y:902
 		{
 			yyLOCAL = &AlterTable{Table: yyDollar[7].tableName, AlterOptions: []AlterOption{&AddIndexDefinition{IndexDefinition: &IndexDefinition{Info: &IndexInfo{Name: yyDollar[4].colIdent, Type: string(yyDollar[3].str)}, Options: yyDollar[5].indexOptionsUnion()}}}}
 			setDDL(yylex, yyLOCAL)
@@ -6580,7 +6900,7 @@ yydefault:
 	case 104:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *AlterTable
-//line sql.y:877
+//line sql.y:907
 		{
 			yyLOCAL = &AlterTable{Table: yyDollar[8].tableName, AlterOptions: []AlterOption{&AddIndexDefinition{IndexDefinition: &IndexDefinition{Info: &IndexInfo{Name: yyDollar[5].colIdent, Type: string(yyDollar[3].str) + " " + string(yyDollar[4].str), Fulltext: true}, Options: yyDollar[6].indexOptionsUnion()}}}}
 			setDDL(yylex, yyLOCAL)
@@ -6589,7 +6909,7 @@ yydefault:
 	case 105:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *AlterTable
-//line sql.y:882
+//line sql.y:912
 		{
 			yyLOCAL = &AlterTable{Table: yyDollar[8].tableName, AlterOptions: []AlterOption{&AddIndexDefinition{IndexDefinition: &IndexDefinition{Info: &IndexInfo{Name: yyDollar[5].colIdent, Type: string(yyDollar[3].str) + " " + string(yyDollar[4].str), Spatial: true}, Options: yyDollar[6].indexOptionsUnion()}}}}
 			setDDL(yylex, yyLOCAL)
@@ -6598,7 +6918,7 @@ yydefault:
 	case 106:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *AlterTable
-//line sql.y:887
+//line sql.y:917
 		{
 			yyLOCAL = &AlterTable{Table: yyDollar[8].tableName, AlterOptions: []AlterOption{&AddIndexDefinition{IndexDefinition: &IndexDefinition{Info: &IndexInfo{Name: yyDollar[5].colIdent, Type: string(yyDollar[3].str) + " " + string(yyDollar[4].str), Unique: true}, Options: yyDollar[6].indexOptionsUnion()}}}}
 			setDDL(yylex, yyLOCAL)
@@ -6607,7 +6927,7 @@ yydefault:
 	case 107:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *CreateDatabase
-//line sql.y:894
+//line sql.y:924
 		{
 			yyLOCAL = &CreateDatabase{Comments: Comments(yyDollar[4].strs), DBName: yyDollar[6].tableIdent, IfNotExists: yyDollar[5].booleanUnion()}
 			setDDL(yylex, yyLOCAL)
@@ -6616,7 +6936,7 @@ yydefault:
 	case 108:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *AlterDatabase
-//line sql.y:901
+//line sql.y:931
 		{
 			yyLOCAL = &AlterDatabase{}
 			setDDL(yylex, yyLOCAL)
@@ -6625,7 +6945,7 @@ yydefault:
 	case 111:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *TableSpec
-//line sql.y:912
+//line sql.y:942
 		{
 			yyLOCAL = yyDollar[2].tableSpecUnion()
 			yyLOCAL.Options = yyDollar[4].tableOptionsUnion()
@@ -6634,7 +6954,7 @@ yydefault:
 	case 112:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []CollateAndCharset
-//line sql.y:918
+//line sql.y:948
 		{
 			yyLOCAL = nil
 		}
@@ -6642,7 +6962,7 @@ yydefault:
 	case 113:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []CollateAndCharset
-//line sql.y:922
+//line sql.y:952
 		{
 			yyLOCAL = yyDollar[1].collateAndCharsetsUnion()
 		}
@@ -6650,7 +6970,7 @@ yydefault:
 	case 114:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []CollateAndCharset
-//line sql.y:928
+//line sql.y:958
 		{
 			yyLOCAL = []CollateAndCharset{yyDollar[1].collateAndCharset}
 		}
@@ -6658,21 +6978,21 @@ yydefault:
 	case 115:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []CollateAndCharset
-//line sql.y:932
+//line sql.y:962
 		{
 			yyLOCAL = []CollateAndCharset{yyDollar[1].collateAndCharset}
 		}
 		yyVAL.union = yyLOCAL
 	case 116:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:936
+//line sql.y:966
 		{
 			yySLICE := (*[]CollateAndCharset)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[2].collateAndCharset)
 		}
 	case 117:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:940
+//line sql.y:970
 		{
 			yySLICE := (*[]CollateAndCharset)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[2].collateAndCharset)
@@ -6680,7 +7000,7 @@ yydefault:
 	case 118:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:946
+//line sql.y:976
 		{
 			yyLOCAL = false
 		}
@@ -6688,39 +7008,39 @@ yydefault:
 	case 119:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:950
+//line sql.y:980
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
 	case 120:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:956
+//line sql.y:986
 		{
 			yyVAL.collateAndCharset = CollateAndCharset{Type: CharacterSetType, Value: (yyDollar[4].colIdent.String()), IsDefault: yyDollar[1].booleanUnion()}
 		}
 	case 121:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:960
+//line sql.y:990
 		{
 			yyVAL.collateAndCharset = CollateAndCharset{Type: CharacterSetType, Value: (encodeSQLString(yyDollar[4].str)), IsDefault: yyDollar[1].booleanUnion()}
 		}
 	case 122:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:966
+//line sql.y:996
 		{
 			yyVAL.collateAndCharset = CollateAndCharset{Type: CollateType, Value: (yyDollar[4].colIdent.String()), IsDefault: yyDollar[1].booleanUnion()}
 		}
 	case 123:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:970
+//line sql.y:1000
 		{
 			yyVAL.collateAndCharset = CollateAndCharset{Type: CollateType, Value: (encodeSQLString(yyDollar[4].str)), IsDefault: yyDollar[1].booleanUnion()}
 		}
 	case 124:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *OptLike
-//line sql.y:977
+//line sql.y:1007
 		{
 			yyLOCAL = &OptLike{LikeTable: yyDollar[2].tableName}
 		}
@@ -6728,7 +7048,7 @@ yydefault:
 	case 125:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *OptLike
-//line sql.y:981
+//line sql.y:1011
 		{
 			yyLOCAL = &OptLike{LikeTable: yyDollar[3].tableName}
 		}
@@ -6736,14 +7056,14 @@ yydefault:
 	case 126:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*ColumnDefinition
-//line sql.y:987
+//line sql.y:1017
 		{
 			yyLOCAL = []*ColumnDefinition{yyDollar[1].columnDefinitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 127:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:991
+//line sql.y:1021
 		{
 			yySLICE := (*[]*ColumnDefinition)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].columnDefinitionUnion())
@@ -6751,7 +7071,7 @@ yydefault:
 	case 128:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *TableSpec
-//line sql.y:997
+//line sql.y:1027
 		{
 			yyLOCAL = &TableSpec{}
 			yyLOCAL.AddColumn(yyDollar[1].columnDefinitionUnion())
@@ -6760,7 +7080,7 @@ yydefault:
 	case 129:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *TableSpec
-//line sql.y:1002
+//line sql.y:1032
 		{
 			yyLOCAL = &TableSpec{}
 			yyLOCAL.AddConstraint(yyDollar[1].constraintDefinitionUnion())
@@ -6768,39 +7088,39 @@ yydefault:
 		yyVAL.union = yyLOCAL
 	case 130:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1007
+//line sql.y:1037
 		{
 			yyVAL.tableSpecUnion().AddColumn(yyDollar[3].columnDefinitionUnion())
 		}
 	case 131:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1011
+//line sql.y:1041
 		{
 			yyVAL.tableSpecUnion().AddColumn(yyDollar[3].columnDefinitionUnion())
 			yyVAL.tableSpecUnion().AddConstraint(yyDollar[4].constraintDefinitionUnion())
 		}
 	case 132:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1016
+//line sql.y:1046
 		{
 			yyVAL.tableSpecUnion().AddIndex(yyDollar[3].indexDefinitionUnion())
 		}
 	case 133:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1020
+//line sql.y:1050
 		{
 			yyVAL.tableSpecUnion().AddConstraint(yyDollar[3].constraintDefinitionUnion())
 		}
 	case 134:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1024
+//line sql.y:1054
 		{
 			yyVAL.tableSpecUnion().AddConstraint(yyDollar[3].constraintDefinitionUnion())
 		}
 	case 135:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *ColumnDefinition
-//line sql.y:1030
+//line sql.y:1060
 		{
 			yyDollar[2].columnType.Options = yyDollar[3].columnTypeOptionsUnion()
 			yyDollar[2].columnType.Options.Reference = yyDollar[4].referenceDefinitionUnion()
@@ -6810,7 +7130,7 @@ yydefault:
 	case 136:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL *ColumnDefinition
-//line sql.y:1036
+//line sql.y:1066
 		{
 			yyDollar[2].columnType.Options = yyDollar[8].columnTypeOptionsUnion()
 			yyDollar[2].columnType.Options.As = yyDollar[6].exprUnion()
@@ -6820,20 +7140,20 @@ yydefault:
 		yyVAL.union = yyLOCAL
 	case 137:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1044
+//line sql.y:1074
 		{
 			yyVAL.str = ""
 		}
 	case 138:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1048
+//line sql.y:1078
 		{
 			yyVAL.str = ""
 		}
 	case 139:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1057
+//line sql.y:1087
 		{
 			yyLOCAL = &ColumnTypeOptions{Null: nil, Default: nil, OnUpdate: nil, Autoincrement: false, KeyOpt: colKeyNone, Comment: nil, As: nil}
 		}
@@ -6841,7 +7161,7 @@ yydefault:
 	case 140:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1061
+//line sql.y:1091
 		{
 			val := true
 			yyDollar[1].columnTypeOptionsUnion().Null = &val
@@ -6851,7 +7171,7 @@ yydefault:
 	case 141:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1067
+//line sql.y:1097
 		{
 			val := false
 			yyDollar[1].columnTypeOptionsUnion().Null = &val
@@ -6861,7 +7181,7 @@ yydefault:
 	case 142:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1073
+//line sql.y:1103
 		{
 			yyDollar[1].columnTypeOptionsUnion().Default = yyDollar[3].exprUnion()
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
@@ -6870,7 +7190,7 @@ yydefault:
 	case 143:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1078
+//line sql.y:1108
 		{
 			yyDollar[1].columnTypeOptionsUnion().OnUpdate = yyDollar[4].exprUnion()
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
@@ -6879,7 +7199,7 @@ yydefault:
 	case 144:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1083
+//line sql.y:1113
 		{
 			yyDollar[1].columnTypeOptionsUnion().Autoincrement = true
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
@@ -6888,7 +7208,7 @@ yydefault:
 	case 145:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1088
+//line sql.y:1118
 		{
 			yyDollar[1].columnTypeOptionsUnion().Comment = NewStrLiteral(yyDollar[3].str)
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
@@ -6897,7 +7217,7 @@ yydefault:
 	case 146:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1093
+//line sql.y:1123
 		{
 			yyDollar[1].columnTypeOptionsUnion().KeyOpt = yyDollar[2].colKeyOptUnion()
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
@@ -6906,7 +7226,7 @@ yydefault:
 	case 147:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ColumnStorage
-//line sql.y:1100
+//line sql.y:1130
 		{
 			yyLOCAL = VirtualStorage
 		}
@@ -6914,7 +7234,7 @@ yydefault:
 	case 148:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ColumnStorage
-//line sql.y:1104
+//line sql.y:1134
 		{
 			yyLOCAL = StoredStorage
 		}
@@ -6922,7 +7242,7 @@ yydefault:
 	case 149:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1109
+//line sql.y:1139
 		{
 			yyLOCAL = &ColumnTypeOptions{}
 		}
@@ -6930,7 +7250,7 @@ yydefault:
 	case 150:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1113
+//line sql.y:1143
 		{
 			yyDollar[1].columnTypeOptionsUnion().Storage = yyDollar[2].columnStorageUnion()
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
@@ -6939,7 +7259,7 @@ yydefault:
 	case 151:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1118
+//line sql.y:1148
 		{
 			val := true
 			yyDollar[1].columnTypeOptionsUnion().Null = &val
@@ -6949,7 +7269,7 @@ yydefault:
 	case 152:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1124
+//line sql.y:1154
 		{
 			val := false
 			yyDollar[1].columnTypeOptionsUnion().Null = &val
@@ -6959,7 +7279,7 @@ yydefault:
 	case 153:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1130
+//line sql.y:1160
 		{
 			yyDollar[1].columnTypeOptionsUnion().Comment = NewStrLiteral(yyDollar[3].str)
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
@@ -6968,7 +7288,7 @@ yydefault:
 	case 154:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1135
+//line sql.y:1165
 		{
 			yyDollar[1].columnTypeOptionsUnion().KeyOpt = yyDollar[2].colKeyOptUnion()
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
@@ -6977,7 +7297,7 @@ yydefault:
 	case 155:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL ColumnKeyOption
-//line sql.y:1142
+//line sql.y:1172
 		{
 			yyLOCAL = colKeyPrimary
 		}
@@ -6985,7 +7305,7 @@ yydefault:
 	case 156:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ColumnKeyOption
-//line sql.y:1146
+//line sql.y:1176
 		{
 			yyLOCAL = colKeyUnique
 		}
@@ -6993,7 +7313,7 @@ yydefault:
 	case 157:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL ColumnKeyOption
-//line sql.y:1150
+//line sql.y:1180
 		{
 			yyLOCAL = colKeyUniqueKey
 		}
@@ -7001,14 +7321,14 @@ yydefault:
 	case 158:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ColumnKeyOption
-//line sql.y:1154
+//line sql.y:1184
 		{
 			yyLOCAL = colKey
 		}
 		yyVAL.union = yyLOCAL
 	case 159:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1160
+//line sql.y:1190
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 			yyVAL.columnType.Unsigned = yyDollar[2].booleanUnion()
@@ -7016,74 +7336,74 @@ yydefault:
 		}
 	case 163:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1171
+//line sql.y:1201
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 			yyVAL.columnType.Length = yyDollar[2].literalUnion()
 		}
 	case 164:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1176
+//line sql.y:1206
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 		}
 	case 165:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1182
+//line sql.y:1212
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 166:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1186
+//line sql.y:1216
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 167:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1190
+//line sql.y:1220
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 168:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1194
+//line sql.y:1224
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 169:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1198
+//line sql.y:1228
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 170:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1202
+//line sql.y:1232
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 171:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1206
+//line sql.y:1236
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 172:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1210
+//line sql.y:1240
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 173:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1214
+//line sql.y:1244
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 174:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1220
+//line sql.y:1250
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
@@ -7091,7 +7411,7 @@ yydefault:
 		}
 	case 175:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1226
+//line sql.y:1256
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
@@ -7099,7 +7419,7 @@ yydefault:
 		}
 	case 176:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1232
+//line sql.y:1262
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
@@ -7107,7 +7427,7 @@ yydefault:
 		}
 	case 177:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1238
+//line sql.y:1268
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
@@ -7115,7 +7435,7 @@ yydefault:
 		}
 	case 178:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1244
+//line sql.y:1274
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
@@ -7123,189 +7443,189 @@ yydefault:
 		}
 	case 179:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1252
+//line sql.y:1282
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 180:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1256
+//line sql.y:1286
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 	case 181:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1260
+//line sql.y:1290
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 	case 182:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1264
+//line sql.y:1294
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 	case 183:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1268
+//line sql.y:1298
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 	case 184:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1274
+//line sql.y:1304
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion(), Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
 	case 185:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1278
+//line sql.y:1308
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion(), Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
 	case 186:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1282
+//line sql.y:1312
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 	case 187:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1286
+//line sql.y:1316
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 	case 188:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1290
+//line sql.y:1320
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
 	case 189:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1294
+//line sql.y:1324
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
 	case 190:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1298
+//line sql.y:1328
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
 	case 191:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1302
+//line sql.y:1332
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
 	case 192:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1306
+//line sql.y:1336
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 193:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1310
+//line sql.y:1340
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 194:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1314
+//line sql.y:1344
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 195:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1318
+//line sql.y:1348
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 196:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1322
+//line sql.y:1352
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 197:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1326
+//line sql.y:1356
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
 		}
 	case 198:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1331
+//line sql.y:1361
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
 		}
 	case 199:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1337
+//line sql.y:1367
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 200:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1341
+//line sql.y:1371
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 201:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1345
+//line sql.y:1375
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 202:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1349
+//line sql.y:1379
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 203:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1353
+//line sql.y:1383
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 204:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1357
+//line sql.y:1387
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 205:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1361
+//line sql.y:1391
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 206:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1365
+//line sql.y:1395
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 207:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1371
+//line sql.y:1401
 		{
 			yyVAL.strs = make([]string, 0, 4)
 			yyVAL.strs = append(yyVAL.strs, encodeSQLString(yyDollar[1].str))
 		}
 	case 208:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1376
+//line sql.y:1406
 		{
 			yyVAL.strs = append(yyDollar[1].strs, encodeSQLString(yyDollar[3].str))
 		}
 	case 209:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *Literal
-//line sql.y:1381
+//line sql.y:1411
 		{
 			yyLOCAL = nil
 		}
@@ -7313,20 +7633,20 @@ yydefault:
 	case 210:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *Literal
-//line sql.y:1385
+//line sql.y:1415
 		{
 			yyLOCAL = NewIntLiteral(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
 	case 211:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1390
+//line sql.y:1420
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
 	case 212:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1394
+//line sql.y:1424
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntLiteral(yyDollar[2].str),
@@ -7335,13 +7655,13 @@ yydefault:
 		}
 	case 213:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1402
+//line sql.y:1432
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
 	case 214:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1406
+//line sql.y:1436
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntLiteral(yyDollar[2].str),
@@ -7349,7 +7669,7 @@ yydefault:
 		}
 	case 215:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1412
+//line sql.y:1442
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntLiteral(yyDollar[2].str),
@@ -7359,7 +7679,7 @@ yydefault:
 	case 216:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1420
+//line sql.y:1450
 		{
 			yyLOCAL = false
 		}
@@ -7367,7 +7687,7 @@ yydefault:
 	case 217:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1424
+//line sql.y:1454
 		{
 			yyLOCAL = true
 		}
@@ -7375,7 +7695,7 @@ yydefault:
 	case 218:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1428
+//line sql.y:1458
 		{
 			yyLOCAL = false
 		}
@@ -7383,7 +7703,7 @@ yydefault:
 	case 219:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1433
+//line sql.y:1463
 		{
 			yyLOCAL = false
 		}
@@ -7391,57 +7711,57 @@ yydefault:
 	case 220:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1437
+//line sql.y:1467
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
 	case 221:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1442
+//line sql.y:1472
 		{
 			yyVAL.str = ""
 		}
 	case 222:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1446
+//line sql.y:1476
 		{
 			yyVAL.str = string(yyDollar[2].colIdent.String())
 		}
 	case 223:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1450
+//line sql.y:1480
 		{
 			yyVAL.str = encodeSQLString(yyDollar[2].str)
 		}
 	case 224:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1454
+//line sql.y:1484
 		{
 			yyVAL.str = string(yyDollar[2].str)
 		}
 	case 225:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1459
+//line sql.y:1489
 		{
 			yyVAL.str = ""
 		}
 	case 226:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1463
+//line sql.y:1493
 		{
 			yyVAL.str = string(yyDollar[2].colIdent.String())
 		}
 	case 227:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1467
+//line sql.y:1497
 		{
 			yyVAL.str = encodeSQLString(yyDollar[2].str)
 		}
 	case 228:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *IndexDefinition
-//line sql.y:1474
+//line sql.y:1504
 		{
 			yyLOCAL = &IndexDefinition{Info: yyDollar[1].indexInfoUnion(), Columns: yyDollar[3].indexColumnsUnion(), Options: yyDollar[5].indexOptionsUnion()}
 		}
@@ -7449,7 +7769,7 @@ yydefault:
 	case 229:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*IndexOption
-//line sql.y:1479
+//line sql.y:1509
 		{
 			yyLOCAL = nil
 		}
@@ -7457,7 +7777,7 @@ yydefault:
 	case 230:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*IndexOption
-//line sql.y:1483
+//line sql.y:1513
 		{
 			yyLOCAL = yyDollar[1].indexOptionsUnion()
 		}
@@ -7465,14 +7785,14 @@ yydefault:
 	case 231:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*IndexOption
-//line sql.y:1489
+//line sql.y:1519
 		{
 			yyLOCAL = []*IndexOption{yyDollar[1].indexOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 232:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1493
+//line sql.y:1523
 		{
 			yySLICE := (*[]*IndexOption)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[2].indexOptionUnion())
@@ -7480,7 +7800,7 @@ yydefault:
 	case 233:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *IndexOption
-//line sql.y:1499
+//line sql.y:1529
 		{
 			yyLOCAL = yyDollar[1].indexOptionUnion()
 		}
@@ -7488,7 +7808,7 @@ yydefault:
 	case 234:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *IndexOption
-//line sql.y:1503
+//line sql.y:1533
 		{
 			// should not be string
 			yyLOCAL = &IndexOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
@@ -7497,7 +7817,7 @@ yydefault:
 	case 235:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *IndexOption
-//line sql.y:1508
+//line sql.y:1538
 		{
 			yyLOCAL = &IndexOption{Name: string(yyDollar[1].str), Value: NewStrLiteral(yyDollar[2].str)}
 		}
@@ -7505,27 +7825,27 @@ yydefault:
 	case 236:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *IndexOption
-//line sql.y:1512
+//line sql.y:1542
 		{
 			yyLOCAL = &IndexOption{Name: string(yyDollar[1].str) + " " + string(yyDollar[2].str), String: yyDollar[3].colIdent.String()}
 		}
 		yyVAL.union = yyLOCAL
 	case 237:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1518
+//line sql.y:1548
 		{
 			yyVAL.str = ""
 		}
 	case 238:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1522
+//line sql.y:1552
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 239:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *IndexInfo
-//line sql.y:1528
+//line sql.y:1558
 		{
 			yyLOCAL = &IndexInfo{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), ConstraintName: NewColIdent(yyDollar[1].str), Name: NewColIdent("PRIMARY"), Primary: true, Unique: true}
 		}
@@ -7533,7 +7853,7 @@ yydefault:
 	case 240:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *IndexInfo
-//line sql.y:1532
+//line sql.y:1562
 		{
 			yyLOCAL = &IndexInfo{Type: string(yyDollar[1].str) + " " + string(yyDollar[2].str), Name: NewColIdent(yyDollar[3].str), Spatial: true, Unique: false}
 		}
@@ -7541,7 +7861,7 @@ yydefault:
 	case 241:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *IndexInfo
-//line sql.y:1536
+//line sql.y:1566
 		{
 			yyLOCAL = &IndexInfo{Type: string(yyDollar[1].str) + " " + string(yyDollar[2].str), Name: NewColIdent(yyDollar[3].str), Fulltext: true, Unique: false}
 		}
@@ -7549,7 +7869,7 @@ yydefault:
 	case 242:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *IndexInfo
-//line sql.y:1540
+//line sql.y:1570
 		{
 			yyLOCAL = &IndexInfo{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), ConstraintName: NewColIdent(yyDollar[1].str), Name: NewColIdent(yyDollar[4].str), Unique: true}
 		}
@@ -7557,100 +7877,100 @@ yydefault:
 	case 243:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *IndexInfo
-//line sql.y:1544
+//line sql.y:1574
 		{
 			yyLOCAL = &IndexInfo{Type: string(yyDollar[1].str), Name: NewColIdent(yyDollar[2].str), Unique: false}
 		}
 		yyVAL.union = yyLOCAL
 	case 244:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1549
+//line sql.y:1579
 		{
 			yyVAL.str = ""
 		}
 	case 245:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1553
+//line sql.y:1583
 		{
 			yyVAL.str = yyDollar[2].str
 		}
 	case 246:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1559
+//line sql.y:1589
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 247:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1563
+//line sql.y:1593
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 248:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1567
+//line sql.y:1597
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 249:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1574
+//line sql.y:1604
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 250:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1578
+//line sql.y:1608
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 251:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1583
+//line sql.y:1613
 		{
 			yyVAL.str = "key"
 		}
 	case 252:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1587
+//line sql.y:1617
 		{
 			yyVAL.str = yyDollar[1].str
 		}
 	case 253:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1593
+//line sql.y:1623
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 254:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1597
+//line sql.y:1627
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 255:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1602
+//line sql.y:1632
 		{
 			yyVAL.str = ""
 		}
 	case 256:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1606
+//line sql.y:1636
 		{
 			yyVAL.str = string(yyDollar[1].colIdent.String())
 		}
 	case 257:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*IndexColumn
-//line sql.y:1612
+//line sql.y:1642
 		{
 			yyLOCAL = []*IndexColumn{yyDollar[1].indexColumnUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 258:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1616
+//line sql.y:1646
 		{
 			yySLICE := (*[]*IndexColumn)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].indexColumnUnion())
@@ -7658,7 +7978,7 @@ yydefault:
 	case 259:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *IndexColumn
-//line sql.y:1622
+//line sql.y:1652
 		{
 			yyLOCAL = &IndexColumn{Column: yyDollar[1].colIdent, Length: yyDollar[2].literalUnion(), Direction: yyDollar[3].orderDirectionUnion()}
 		}
@@ -7666,7 +7986,7 @@ yydefault:
 	case 260:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ConstraintDefinition
-//line sql.y:1628
+//line sql.y:1658
 		{
 			yyLOCAL = &ConstraintDefinition{Name: yyDollar[2].colIdent, Details: yyDollar[3].constraintInfoUnion()}
 		}
@@ -7674,7 +7994,7 @@ yydefault:
 	case 261:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConstraintDefinition
-//line sql.y:1632
+//line sql.y:1662
 		{
 			yyLOCAL = &ConstraintDefinition{Details: yyDollar[1].constraintInfoUnion()}
 		}
@@ -7682,7 +8002,7 @@ yydefault:
 	case 262:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ConstraintDefinition
-//line sql.y:1638
+//line sql.y:1668
 		{
 			yyLOCAL = &ConstraintDefinition{Name: yyDollar[2].colIdent, Details: yyDollar[3].constraintInfoUnion()}
 		}
@@ -7690,7 +8010,7 @@ yydefault:
 	case 263:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConstraintDefinition
-//line sql.y:1642
+//line sql.y:1672
 		{
 			yyLOCAL = &ConstraintDefinition{Details: yyDollar[1].constraintInfoUnion()}
 		}
@@ -7698,7 +8018,7 @@ yydefault:
 	case 264:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL ConstraintInfo
-//line sql.y:1648
+//line sql.y:1678
 		{
 			yyLOCAL = &ForeignKeyDefinition{IndexName: NewColIdent(yyDollar[3].str), Source: yyDollar[5].columnsUnion(), ReferenceDefinition: yyDollar[7].referenceDefinitionUnion()}
 		}
@@ -7706,7 +8026,7 @@ yydefault:
 	case 265:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *ReferenceDefinition
-//line sql.y:1654
+//line sql.y:1684
 		{
 			yyLOCAL = &ReferenceDefinition{ReferencedTable: yyDollar[2].tableName, ReferencedColumns: yyDollar[4].columnsUnion()}
 		}
@@ -7714,7 +8034,7 @@ yydefault:
 	case 266:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *ReferenceDefinition
-//line sql.y:1658
+//line sql.y:1688
 		{
 			yyLOCAL = &ReferenceDefinition{ReferencedTable: yyDollar[2].tableName, ReferencedColumns: yyDollar[4].columnsUnion(), OnDelete: yyDollar[6].ReferenceActionUnion()}
 		}
@@ -7722,7 +8042,7 @@ yydefault:
 	case 267:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *ReferenceDefinition
-//line sql.y:1662
+//line sql.y:1692
 		{
 			yyLOCAL = &ReferenceDefinition{ReferencedTable: yyDollar[2].tableName, ReferencedColumns: yyDollar[4].columnsUnion(), OnUpdate: yyDollar[6].ReferenceActionUnion()}
 		}
@@ -7730,7 +8050,7 @@ yydefault:
 	case 268:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *ReferenceDefinition
-//line sql.y:1666
+//line sql.y:1696
 		{
 			yyLOCAL = &ReferenceDefinition{ReferencedTable: yyDollar[2].tableName, ReferencedColumns: yyDollar[4].columnsUnion(), OnDelete: yyDollar[6].ReferenceActionUnion(), OnUpdate: yyDollar[7].ReferenceActionUnion()}
 		}
@@ -7738,7 +8058,7 @@ yydefault:
 	case 269:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *ReferenceDefinition
-//line sql.y:1671
+//line sql.y:1701
 		{
 			yyLOCAL = nil
 		}
@@ -7746,7 +8066,7 @@ yydefault:
 	case 270:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ReferenceDefinition
-//line sql.y:1675
+//line sql.y:1705
 		{
 			yyLOCAL = yyDollar[1].referenceDefinitionUnion()
 		}
@@ -7754,7 +8074,7 @@ yydefault:
 	case 271:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL ConstraintInfo
-//line sql.y:1681
+//line sql.y:1711
 		{
 			yyLOCAL = &CheckConstraintDefinition{Expr: yyDollar[3].exprUnion(), Enforced: yyDollar[5].booleanUnion()}
 		}
@@ -7762,7 +8082,7 @@ yydefault:
 	case 272:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:1687
+//line sql.y:1717
 		{
 			yyLOCAL = yyDollar[3].ReferenceActionUnion()
 		}
@@ -7770,7 +8090,7 @@ yydefault:
 	case 273:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:1693
+//line sql.y:1723
 		{
 			yyLOCAL = yyDollar[3].ReferenceActionUnion()
 		}
@@ -7778,7 +8098,7 @@ yydefault:
 	case 274:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:1699
+//line sql.y:1729
 		{
 			yyLOCAL = Restrict
 		}
@@ -7786,7 +8106,7 @@ yydefault:
 	case 275:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:1703
+//line sql.y:1733
 		{
 			yyLOCAL = Cascade
 		}
@@ -7794,7 +8114,7 @@ yydefault:
 	case 276:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:1707
+//line sql.y:1737
 		{
 			yyLOCAL = NoAction
 		}
@@ -7802,7 +8122,7 @@ yydefault:
 	case 277:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:1711
+//line sql.y:1741
 		{
 			yyLOCAL = SetDefault
 		}
@@ -7810,33 +8130,33 @@ yydefault:
 	case 278:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:1715
+//line sql.y:1745
 		{
 			yyLOCAL = SetNull
 		}
 		yyVAL.union = yyLOCAL
 	case 279:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1720
+//line sql.y:1750
 		{
 			yyVAL.str = ""
 		}
 	case 280:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1724
+//line sql.y:1754
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 281:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1728
+//line sql.y:1758
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 282:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1733
+//line sql.y:1763
 		{
 			yyLOCAL = true
 		}
@@ -7844,7 +8164,7 @@ yydefault:
 	case 283:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1737
+//line sql.y:1767
 		{
 			yyLOCAL = true
 		}
@@ -7852,7 +8172,7 @@ yydefault:
 	case 284:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1741
+//line sql.y:1771
 		{
 			yyLOCAL = false
 		}
@@ -7860,7 +8180,7 @@ yydefault:
 	case 285:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL TableOptions
-//line sql.y:1746
+//line sql.y:1776
 		{
 			yyLOCAL = nil
 		}
@@ -7868,7 +8188,7 @@ yydefault:
 	case 286:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableOptions
-//line sql.y:1750
+//line sql.y:1780
 		{
 			yyLOCAL = yyDollar[1].tableOptionsUnion()
 		}
@@ -7876,21 +8196,21 @@ yydefault:
 	case 287:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableOptions
-//line sql.y:1756
+//line sql.y:1786
 		{
 			yyLOCAL = TableOptions{yyDollar[1].tableOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 288:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1760
+//line sql.y:1790
 		{
 			yySLICE := (*TableOptions)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].tableOptionUnion())
 		}
 	case 289:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1764
+//line sql.y:1794
 		{
 			yySLICE := (*TableOptions)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[2].tableOptionUnion())
@@ -7898,14 +8218,14 @@ yydefault:
 	case 290:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableOptions
-//line sql.y:1770
+//line sql.y:1800
 		{
 			yyLOCAL = TableOptions{yyDollar[1].tableOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 291:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1774
+//line sql.y:1804
 		{
 			yySLICE := (*TableOptions)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[2].tableOptionUnion())
@@ -7913,7 +8233,7 @@ yydefault:
 	case 292:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1780
+//line sql.y:1810
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
@@ -7921,7 +8241,7 @@ yydefault:
 	case 293:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1784
+//line sql.y:1814
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
@@ -7929,7 +8249,7 @@ yydefault:
 	case 294:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1788
+//line sql.y:1818
 		{
 			yyLOCAL = &TableOption{Name: (string(yyDollar[2].str)), String: yyDollar[4].str}
 		}
@@ -7937,7 +8257,7 @@ yydefault:
 	case 295:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1792
+//line sql.y:1822
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[2].str), String: yyDollar[4].str}
 		}
@@ -7945,7 +8265,7 @@ yydefault:
 	case 296:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1796
+//line sql.y:1826
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
@@ -7953,7 +8273,7 @@ yydefault:
 	case 297:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1800
+//line sql.y:1830
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewStrLiteral(yyDollar[3].str)}
 		}
@@ -7961,7 +8281,7 @@ yydefault:
 	case 298:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1804
+//line sql.y:1834
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewStrLiteral(yyDollar[3].str)}
 		}
@@ -7969,7 +8289,7 @@ yydefault:
 	case 299:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1808
+//line sql.y:1838
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewStrLiteral(yyDollar[3].str)}
 		}
@@ -7977,7 +8297,7 @@ yydefault:
 	case 300:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1812
+//line sql.y:1842
 		{
 			yyLOCAL = &TableOption{Name: (string(yyDollar[1].str) + " " + string(yyDollar[2].str)), Value: NewStrLiteral(yyDollar[4].str)}
 		}
@@ -7985,7 +8305,7 @@ yydefault:
 	case 301:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1816
+//line sql.y:1846
 		{
 			yyLOCAL = &TableOption{Name: (string(yyDollar[1].str) + " " + string(yyDollar[2].str)), Value: NewStrLiteral(yyDollar[4].str)}
 		}
@@ -7993,7 +8313,7 @@ yydefault:
 	case 302:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1820
+//line sql.y:1850
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
@@ -8001,7 +8321,7 @@ yydefault:
 	case 303:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1824
+//line sql.y:1854
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewStrLiteral(yyDollar[3].str)}
 		}
@@ -8009,7 +8329,7 @@ yydefault:
 	case 304:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1828
+//line sql.y:1858
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: yyDollar[3].tableIdent.String()}
 		}
@@ -8017,7 +8337,7 @@ yydefault:
 	case 305:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1832
+//line sql.y:1862
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: string(yyDollar[3].str)}
 		}
@@ -8025,7 +8345,7 @@ yydefault:
 	case 306:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1836
+//line sql.y:1866
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
@@ -8033,7 +8353,7 @@ yydefault:
 	case 307:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1840
+//line sql.y:1870
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
@@ -8041,7 +8361,7 @@ yydefault:
 	case 308:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1844
+//line sql.y:1874
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
@@ -8049,7 +8369,7 @@ yydefault:
 	case 309:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1848
+//line sql.y:1878
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
@@ -8057,7 +8377,7 @@ yydefault:
 	case 310:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1852
+//line sql.y:1882
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: string(yyDollar[3].str)}
 		}
@@ -8065,7 +8385,7 @@ yydefault:
 	case 311:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1856
+//line sql.y:1886
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewStrLiteral(yyDollar[3].str)}
 		}
@@ -8073,7 +8393,7 @@ yydefault:
 	case 312:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1860
+//line sql.y:1890
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: string(yyDollar[3].str)}
 		}
@@ -8081,7 +8401,7 @@ yydefault:
 	case 313:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1864
+//line sql.y:1894
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
@@ -8089,7 +8409,7 @@ yydefault:
 	case 314:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1868
+//line sql.y:1898
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: string(yyDollar[3].str)}
 		}
@@ -8097,7 +8417,7 @@ yydefault:
 	case 315:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1872
+//line sql.y:1902
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
@@ -8105,7 +8425,7 @@ yydefault:
 	case 316:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1876
+//line sql.y:1906
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: string(yyDollar[3].str)}
 		}
@@ -8113,7 +8433,7 @@ yydefault:
 	case 317:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1880
+//line sql.y:1910
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
@@ -8121,7 +8441,7 @@ yydefault:
 	case 318:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1884
+//line sql.y:1914
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: (yyDollar[3].colIdent.String() + yyDollar[4].str)}
 		}
@@ -8129,57 +8449,57 @@ yydefault:
 	case 319:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1888
+//line sql.y:1918
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Tables: yyDollar[4].tableNamesUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 320:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1893
+//line sql.y:1923
 		{
 			yyVAL.str = ""
 		}
 	case 321:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1897
+//line sql.y:1927
 		{
 			yyVAL.str = " " + string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
 	case 322:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1901
+//line sql.y:1931
 		{
 			yyVAL.str = " " + string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
 	case 332:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1920
+//line sql.y:1950
 		{
 			yyVAL.str = yyDollar[1].colIdent.String()
 		}
 	case 333:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1924
+//line sql.y:1954
 		{
 			yyVAL.str = encodeSQLString(yyDollar[1].str)
 		}
 	case 334:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1928
+//line sql.y:1958
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 335:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1933
+//line sql.y:1963
 		{
 			yyVAL.str = ""
 		}
 	case 337:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1939
+//line sql.y:1969
 		{
 			yyLOCAL = false
 		}
@@ -8187,7 +8507,7 @@ yydefault:
 	case 338:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1943
+//line sql.y:1973
 		{
 			yyLOCAL = true
 		}
@@ -8195,7 +8515,7 @@ yydefault:
 	case 339:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *ColName
-//line sql.y:1948
+//line sql.y:1978
 		{
 			yyLOCAL = nil
 		}
@@ -8203,7 +8523,7 @@ yydefault:
 	case 340:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColName
-//line sql.y:1952
+//line sql.y:1982
 		{
 			yyLOCAL = yyDollar[2].colNameUnion()
 		}
@@ -8211,7 +8531,7 @@ yydefault:
 	case 341:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:1957
+//line sql.y:1987
 		{
 			yyLOCAL = nil
 		}
@@ -8219,14 +8539,14 @@ yydefault:
 	case 342:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:1961
+//line sql.y:1991
 		{
 			yyLOCAL = yyDollar[1].alterOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
 	case 343:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1965
+//line sql.y:1995
 		{
 			yySLICE := (*[]AlterOption)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, &OrderByOption{Cols: yyDollar[5].columnsUnion()})
@@ -8234,14 +8554,14 @@ yydefault:
 	case 344:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:1969
+//line sql.y:1999
 		{
 			yyLOCAL = yyDollar[1].alterOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
 	case 345:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1973
+//line sql.y:2003
 		{
 			yySLICE := (*[]AlterOption)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].alterOptionsUnion()...)
@@ -8249,7 +8569,7 @@ yydefault:
 	case 346:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:1977
+//line sql.y:2007
 		{
 			yyLOCAL = append(append(yyDollar[1].alterOptionsUnion(), yyDollar[3].alterOptionsUnion()...), &OrderByOption{Cols: yyDollar[7].columnsUnion()})
 		}
@@ -8257,21 +8577,21 @@ yydefault:
 	case 347:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:1983
+//line sql.y:2013
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 348:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1987
+//line sql.y:2017
 		{
 			yySLICE := (*[]AlterOption)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].alterOptionUnion())
 		}
 	case 349:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1991
+//line sql.y:2021
 		{
 			yySLICE := (*[]AlterOption)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].alterOptionUnion())
@@ -8279,7 +8599,7 @@ yydefault:
 	case 350:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:1997
+//line sql.y:2027
 		{
 			yyLOCAL = yyDollar[1].tableOptionsUnion()
 		}
@@ -8287,7 +8607,7 @@ yydefault:
 	case 351:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2001
+//line sql.y:2031
 		{
 			yyLOCAL = &AddConstraintDefinition{ConstraintDefinition: yyDollar[2].constraintDefinitionUnion()}
 		}
@@ -8295,7 +8615,7 @@ yydefault:
 	case 352:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2005
+//line sql.y:2035
 		{
 			yyLOCAL = &AddConstraintDefinition{ConstraintDefinition: yyDollar[2].constraintDefinitionUnion()}
 		}
@@ -8303,7 +8623,7 @@ yydefault:
 	case 353:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2009
+//line sql.y:2039
 		{
 			yyLOCAL = &AddIndexDefinition{IndexDefinition: yyDollar[2].indexDefinitionUnion()}
 		}
@@ -8311,7 +8631,7 @@ yydefault:
 	case 354:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2013
+//line sql.y:2043
 		{
 			yyLOCAL = &AddColumns{Columns: yyDollar[4].columnDefinitionsUnion()}
 		}
@@ -8319,7 +8639,7 @@ yydefault:
 	case 355:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2017
+//line sql.y:2047
 		{
 			yyLOCAL = &AddColumns{Columns: []*ColumnDefinition{yyDollar[3].columnDefinitionUnion()}, First: yyDollar[4].booleanUnion(), After: yyDollar[5].colNameUnion()}
 		}
@@ -8327,7 +8647,7 @@ yydefault:
 	case 356:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2021
+//line sql.y:2051
 		{
 			yyLOCAL = &AlterColumn{Column: yyDollar[3].colNameUnion(), DropDefault: true}
 		}
@@ -8335,7 +8655,7 @@ yydefault:
 	case 357:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2025
+//line sql.y:2055
 		{
 			yyLOCAL = &AlterColumn{Column: yyDollar[3].colNameUnion(), DropDefault: false, DefaultVal: yyDollar[6].exprUnion()}
 		}
@@ -8343,7 +8663,7 @@ yydefault:
 	case 358:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2029
+//line sql.y:2059
 		{
 			yyLOCAL = &ChangeColumn{OldColumn: yyDollar[3].colNameUnion(), NewColDefinition: yyDollar[4].columnDefinitionUnion(), First: yyDollar[5].booleanUnion(), After: yyDollar[6].colNameUnion()}
 		}
@@ -8351,7 +8671,7 @@ yydefault:
 	case 359:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2033
+//line sql.y:2063
 		{
 			yyLOCAL = &ModifyColumn{NewColDefinition: yyDollar[3].columnDefinitionUnion(), First: yyDollar[4].booleanUnion(), After: yyDollar[5].colNameUnion()}
 		}
@@ -8359,7 +8679,7 @@ yydefault:
 	case 360:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2037
+//line sql.y:2067
 		{
 			yyLOCAL = &AlterCharset{CharacterSet: yyDollar[4].str, Collate: yyDollar[5].str}
 		}
@@ -8367,7 +8687,7 @@ yydefault:
 	case 361:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2041
+//line sql.y:2071
 		{
 			yyLOCAL = &KeyState{Enable: false}
 		}
@@ -8375,7 +8695,7 @@ yydefault:
 	case 362:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2045
+//line sql.y:2075
 		{
 			yyLOCAL = &KeyState{Enable: true}
 		}
@@ -8383,7 +8703,7 @@ yydefault:
 	case 363:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2049
+//line sql.y:2079
 		{
 			yyLOCAL = &TablespaceOperation{Import: false}
 		}
@@ -8391,7 +8711,7 @@ yydefault:
 	case 364:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2053
+//line sql.y:2083
 		{
 			yyLOCAL = &TablespaceOperation{Import: true}
 		}
@@ -8399,7 +8719,7 @@ yydefault:
 	case 365:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2057
+//line sql.y:2087
 		{
 			yyLOCAL = &DropColumn{Name: yyDollar[3].colNameUnion()}
 		}
@@ -8407,7 +8727,7 @@ yydefault:
 	case 366:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2061
+//line sql.y:2091
 		{
 			yyLOCAL = &DropKey{Type: NormalKeyType, Name: yyDollar[3].colIdent}
 		}
@@ -8415,7 +8735,7 @@ yydefault:
 	case 367:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2065
+//line sql.y:2095
 		{
 			yyLOCAL = &DropKey{Type: PrimaryKeyType}
 		}
@@ -8423,7 +8743,7 @@ yydefault:
 	case 368:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2069
+//line sql.y:2099
 		{
 			yyLOCAL = &DropKey{Type: ForeignKeyType, Name: yyDollar[4].colIdent}
 		}
@@ -8431,7 +8751,7 @@ yydefault:
 	case 369:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2073
+//line sql.y:2103
 		{
 			yyLOCAL = &Force{}
 		}
@@ -8439,7 +8759,7 @@ yydefault:
 	case 370:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2077
+//line sql.y:2107
 		{
 			yyLOCAL = &RenameTableName{Table: yyDollar[3].tableName}
 		}
@@ -8447,7 +8767,7 @@ yydefault:
 	case 371:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2081
+//line sql.y:2111
 		{
 			yyLOCAL = &RenameIndex{OldName: yyDollar[3].colIdent, NewName: yyDollar[5].colIdent}
 		}
@@ -8455,14 +8775,14 @@ yydefault:
 	case 372:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:2087
+//line sql.y:2117
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 373:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2091
+//line sql.y:2121
 		{
 			yySLICE := (*[]AlterOption)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].alterOptionUnion())
@@ -8470,7 +8790,7 @@ yydefault:
 	case 374:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2097
+//line sql.y:2127
 		{
 			yyLOCAL = AlgorithmValue(string(yyDollar[3].str))
 		}
@@ -8478,7 +8798,7 @@ yydefault:
 	case 375:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2101
+//line sql.y:2131
 		{
 			yyLOCAL = AlgorithmValue(string(yyDollar[3].str))
 		}
@@ -8486,7 +8806,7 @@ yydefault:
 	case 376:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2105
+//line sql.y:2135
 		{
 			yyLOCAL = AlgorithmValue(string(yyDollar[3].str))
 		}
@@ -8494,7 +8814,7 @@ yydefault:
 	case 377:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2109
+//line sql.y:2139
 		{
 			yyLOCAL = &LockOption{Type: DefaultType}
 		}
@@ -8502,7 +8822,7 @@ yydefault:
 	case 378:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2113
+//line sql.y:2143
 		{
 			yyLOCAL = &LockOption{Type: NoneType}
 		}
@@ -8510,7 +8830,7 @@ yydefault:
 	case 379:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2117
+//line sql.y:2147
 		{
 			yyLOCAL = &LockOption{Type: SharedType}
 		}
@@ -8518,7 +8838,7 @@ yydefault:
 	case 380:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2121
+//line sql.y:2151
 		{
 			yyLOCAL = &LockOption{Type: ExclusiveType}
 		}
@@ -8526,7 +8846,7 @@ yydefault:
 	case 381:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2125
+//line sql.y:2155
 		{
 			yyLOCAL = &Validation{With: true}
 		}
@@ -8534,7 +8854,7 @@ yydefault:
 	case 382:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2129
+//line sql.y:2159
 		{
 			yyLOCAL = &Validation{With: false}
 		}
@@ -8542,7 +8862,7 @@ yydefault:
 	case 383:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2135
+//line sql.y:2165
 		{
 			yyDollar[1].alterTableUnion().FullyParsed = true
 			yyDollar[1].alterTableUnion().AlterOptions = yyDollar[2].alterOptionsUnion()
@@ -8552,7 +8872,7 @@ yydefault:
 	case 384:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2141
+//line sql.y:2171
 		{
 			yyDollar[1].alterTableUnion().FullyParsed = true
 			yyDollar[1].alterTableUnion().AlterOptions = yyDollar[2].alterOptionsUnion()
@@ -8563,7 +8883,7 @@ yydefault:
 	case 385:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2148
+//line sql.y:2178
 		{
 			yyDollar[1].alterTableUnion().FullyParsed = true
 			yyDollar[1].alterTableUnion().AlterOptions = yyDollar[2].alterOptionsUnion()
@@ -8574,7 +8894,7 @@ yydefault:
 	case 386:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2155
+//line sql.y:2185
 		{
 			yyDollar[1].alterTableUnion().FullyParsed = true
 			yyDollar[1].alterTableUnion().PartitionSpec = yyDollar[2].partSpecUnion()
@@ -8584,7 +8904,7 @@ yydefault:
 	case 387:
 		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2161
+//line sql.y:2191
 		{
 			yyLOCAL = &AlterView{ViewName: yyDollar[7].tableName.ToViewName(), Algorithm: yyDollar[3].str, Definer: yyDollar[4].str, Security: yyDollar[5].str, Columns: yyDollar[8].columnsUnion(), Select: yyDollar[10].selStmtUnion(), CheckOption: yyDollar[11].str}
 		}
@@ -8592,7 +8912,7 @@ yydefault:
 	case 388:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2165
+//line sql.y:2195
 		{
 			yyDollar[1].alterDatabaseUnion().FullyParsed = true
 			yyDollar[1].alterDatabaseUnion().DBName = yyDollar[2].tableIdent
@@ -8603,7 +8923,7 @@ yydefault:
 	case 389:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2172
+//line sql.y:2202
 		{
 			yyDollar[1].alterDatabaseUnion().FullyParsed = true
 			yyDollar[1].alterDatabaseUnion().DBName = yyDollar[2].tableIdent
@@ -8614,7 +8934,7 @@ yydefault:
 	case 390:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2179
+//line sql.y:2209
 		{
 			yyLOCAL = &AlterVschema{
 				Action: CreateVindexDDLAction,
@@ -8630,7 +8950,7 @@ yydefault:
 	case 391:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2191
+//line sql.y:2221
 		{
 			yyLOCAL = &AlterVschema{
 				Action: DropVindexDDLAction,
@@ -8644,7 +8964,7 @@ yydefault:
 	case 392:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2201
+//line sql.y:2231
 		{
 			yyLOCAL = &AlterVschema{Action: AddVschemaTableDDLAction, Table: yyDollar[6].tableName}
 		}
@@ -8652,7 +8972,7 @@ yydefault:
 	case 393:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2205
+//line sql.y:2235
 		{
 			yyLOCAL = &AlterVschema{Action: DropVschemaTableDDLAction, Table: yyDollar[6].tableName}
 		}
@@ -8660,7 +8980,7 @@ yydefault:
 	case 394:
 		yyDollar = yyS[yypt-13 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2209
+//line sql.y:2239
 		{
 			yyLOCAL = &AlterVschema{
 				Action: AddColVindexDDLAction,
@@ -8677,7 +8997,7 @@ yydefault:
 	case 395:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2222
+//line sql.y:2252
 		{
 			yyLOCAL = &AlterVschema{
 				Action: DropColVindexDDLAction,
@@ -8691,7 +9011,7 @@ yydefault:
 	case 396:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2232
+//line sql.y:2262
 		{
 			yyLOCAL = &AlterVschema{Action: AddSequenceDDLAction, Table: yyDollar[6].tableName}
 		}
@@ -8699,7 +9019,7 @@ yydefault:
 	case 397:
 		yyDollar = yyS[yypt-10 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2236
+//line sql.y:2266
 		{
 			yyLOCAL = &AlterVschema{
 				Action: AddAutoIncDDLAction,
@@ -8714,7 +9034,7 @@ yydefault:
 	case 398:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2247
+//line sql.y:2277
 		{
 			yyLOCAL = &AlterMigration{
 				Type: RetryMigrationType,
@@ -8725,7 +9045,7 @@ yydefault:
 	case 399:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2254
+//line sql.y:2284
 		{
 			yyLOCAL = &AlterMigration{
 				Type: CompleteMigrationType,
@@ -8736,7 +9056,7 @@ yydefault:
 	case 400:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2261
+//line sql.y:2291
 		{
 			yyLOCAL = &AlterMigration{
 				Type: CancelMigrationType,
@@ -8747,7 +9067,7 @@ yydefault:
 	case 401:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2268
+//line sql.y:2298
 		{
 			yyLOCAL = &AlterMigration{
 				Type: CancelAllMigrationType,
@@ -8757,7 +9077,7 @@ yydefault:
 	case 402:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2276
+//line sql.y:2306
 		{
 			yyLOCAL = &PartitionSpec{Action: AddAction, Definitions: []*PartitionDefinition{yyDollar[4].partDefUnion()}}
 		}
@@ -8765,7 +9085,7 @@ yydefault:
 	case 403:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2280
+//line sql.y:2310
 		{
 			yyLOCAL = &PartitionSpec{Action: DropAction, Names: yyDollar[3].partitionsUnion()}
 		}
@@ -8773,7 +9093,7 @@ yydefault:
 	case 404:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2284
+//line sql.y:2314
 		{
 			yyLOCAL = &PartitionSpec{Action: ReorganizeAction, Names: yyDollar[3].partitionsUnion(), Definitions: yyDollar[6].partDefsUnion()}
 		}
@@ -8781,7 +9101,7 @@ yydefault:
 	case 405:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2288
+//line sql.y:2318
 		{
 			yyLOCAL = &PartitionSpec{Action: DiscardAction, Names: yyDollar[3].partitionsUnion()}
 		}
@@ -8789,7 +9109,7 @@ yydefault:
 	case 406:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2292
+//line sql.y:2322
 		{
 			yyLOCAL = &PartitionSpec{Action: DiscardAction, IsAll: true}
 		}
@@ -8797,7 +9117,7 @@ yydefault:
 	case 407:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2296
+//line sql.y:2326
 		{
 			yyLOCAL = &PartitionSpec{Action: ImportAction, Names: yyDollar[3].partitionsUnion()}
 		}
@@ -8805,7 +9125,7 @@ yydefault:
 	case 408:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2300
+//line sql.y:2330
 		{
 			yyLOCAL = &PartitionSpec{Action: ImportAction, IsAll: true}
 		}
@@ -8813,7 +9133,7 @@ yydefault:
 	case 409:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2304
+//line sql.y:2334
 		{
 			yyLOCAL = &PartitionSpec{Action: TruncateAction, Names: yyDollar[3].partitionsUnion()}
 		}
@@ -8821,7 +9141,7 @@ yydefault:
 	case 410:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2308
+//line sql.y:2338
 		{
 			yyLOCAL = &PartitionSpec{Action: TruncateAction, IsAll: true}
 		}
@@ -8829,7 +9149,7 @@ yydefault:
 	case 411:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2312
+//line sql.y:2342
 		{
 			yyLOCAL = &PartitionSpec{Action: CoalesceAction, Number: NewIntLiteral(yyDollar[3].str)}
 		}
@@ -8837,7 +9157,7 @@ yydefault:
 	case 412:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2316
+//line sql.y:2346
 		{
 			yyLOCAL = &PartitionSpec{Action: ExchangeAction, Names: Partitions{yyDollar[3].colIdent}, TableName: yyDollar[6].tableName, WithoutValidation: yyDollar[7].booleanUnion()}
 		}
@@ -8845,7 +9165,7 @@ yydefault:
 	case 413:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2320
+//line sql.y:2350
 		{
 			yyLOCAL = &PartitionSpec{Action: AnalyzeAction, Names: yyDollar[3].partitionsUnion()}
 		}
@@ -8853,7 +9173,7 @@ yydefault:
 	case 414:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2324
+//line sql.y:2354
 		{
 			yyLOCAL = &PartitionSpec{Action: AnalyzeAction, IsAll: true}
 		}
@@ -8861,7 +9181,7 @@ yydefault:
 	case 415:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2328
+//line sql.y:2358
 		{
 			yyLOCAL = &PartitionSpec{Action: CheckAction, Names: yyDollar[3].partitionsUnion()}
 		}
@@ -8869,7 +9189,7 @@ yydefault:
 	case 416:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2332
+//line sql.y:2362
 		{
 			yyLOCAL = &PartitionSpec{Action: CheckAction, IsAll: true}
 		}
@@ -8877,7 +9197,7 @@ yydefault:
 	case 417:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2336
+//line sql.y:2366
 		{
 			yyLOCAL = &PartitionSpec{Action: OptimizeAction, Names: yyDollar[3].partitionsUnion()}
 		}
@@ -8885,7 +9205,7 @@ yydefault:
 	case 418:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2340
+//line sql.y:2370
 		{
 			yyLOCAL = &PartitionSpec{Action: OptimizeAction, IsAll: true}
 		}
@@ -8893,7 +9213,7 @@ yydefault:
 	case 419:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2344
+//line sql.y:2374
 		{
 			yyLOCAL = &PartitionSpec{Action: RebuildAction, Names: yyDollar[3].partitionsUnion()}
 		}
@@ -8901,7 +9221,7 @@ yydefault:
 	case 420:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2348
+//line sql.y:2378
 		{
 			yyLOCAL = &PartitionSpec{Action: RebuildAction, IsAll: true}
 		}
@@ -8909,7 +9229,7 @@ yydefault:
 	case 421:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2352
+//line sql.y:2382
 		{
 			yyLOCAL = &PartitionSpec{Action: RepairAction, Names: yyDollar[3].partitionsUnion()}
 		}
@@ -8917,7 +9237,7 @@ yydefault:
 	case 422:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2356
+//line sql.y:2386
 		{
 			yyLOCAL = &PartitionSpec{Action: RepairAction, IsAll: true}
 		}
@@ -8925,7 +9245,7 @@ yydefault:
 	case 423:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2360
+//line sql.y:2390
 		{
 			yyLOCAL = &PartitionSpec{Action: UpgradeAction}
 		}
@@ -8933,7 +9253,7 @@ yydefault:
 	case 424:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2365
+//line sql.y:2395
 		{
 			yyLOCAL = false
 		}
@@ -8941,7 +9261,7 @@ yydefault:
 	case 425:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2369
+//line sql.y:2399
 		{
 			yyLOCAL = false
 		}
@@ -8949,7 +9269,7 @@ yydefault:
 	case 426:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2373
+//line sql.y:2403
 		{
 			yyLOCAL = true
 		}
@@ -8957,14 +9277,14 @@ yydefault:
 	case 427:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*PartitionDefinition
-//line sql.y:2380
+//line sql.y:2410
 		{
 			yyLOCAL = []*PartitionDefinition{yyDollar[1].partDefUnion()}
 		}
 		yyVAL.union = yyLOCAL
 	case 428:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2384
+//line sql.y:2414
 		{
 			yySLICE := (*[]*PartitionDefinition)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].partDefUnion())
@@ -8972,7 +9292,7 @@ yydefault:
 	case 429:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *PartitionDefinition
-//line sql.y:2390
+//line sql.y:2420
 		{
 			yyLOCAL = &PartitionDefinition{Name: yyDollar[2].colIdent, Limit: yyDollar[7].exprUnion()}
 		}
@@ -8980,7 +9300,7 @@ yydefault:
 	case 430:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *PartitionDefinition
-//line sql.y:2394
+//line sql.y:2424
 		{
 			yyLOCAL = &PartitionDefinition{Name: yyDollar[2].colIdent, Maxvalue: true}
 		}
@@ -8988,7 +9308,7 @@ yydefault:
 	case 431:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2400
+//line sql.y:2430
 		{
 			yyLOCAL = &RenameTable{TablePairs: yyDollar[3].renameTablePairsUnion()}
 		}
@@ -8996,14 +9316,14 @@ yydefault:
 	case 432:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*RenameTablePair
-//line sql.y:2406
+//line sql.y:2436
 		{
 			yyLOCAL = []*RenameTablePair{{FromTable: yyDollar[1].tableName, ToTable: yyDollar[3].tableName}}
 		}
 		yyVAL.union = yyLOCAL
 	case 433:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2410
+//line sql.y:2440
 		{
 			yySLICE := (*[]*RenameTablePair)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, &RenameTablePair{FromTable: yyDollar[3].tableName, ToTable: yyDollar[5].tableName})
@@ -9011,7 +9331,7 @@ yydefault:
 	case 434:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2416
+//line sql.y:2446
 		{
 			yyLOCAL = &DropTable{FromTables: yyDollar[6].tableNamesUnion(), IfExists: yyDollar[5].booleanUnion(), Comments: Comments(yyDollar[2].strs), Temp: yyDollar[3].booleanUnion()}
 		}
@@ -9019,7 +9339,7 @@ yydefault:
 	case 435:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2420
+//line sql.y:2450
 		{
 			// Change this to an alter statement
 			if yyDollar[4].colIdent.Lowered() == "primary" {
@@ -9032,7 +9352,7 @@ yydefault:
 	case 436:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2429
+//line sql.y:2459
 		{
 			yyLOCAL = &DropView{FromTables: yyDollar[5].tableNamesUnion(), IfExists: yyDollar[4].booleanUnion()}
 		}
@@ -9040,7 +9360,7 @@ yydefault:
 	case 437:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2433
+//line sql.y:2463
 		{
 			yyLOCAL = &DropDatabase{Comments: Comments(yyDollar[2].strs), DBName: yyDollar[5].tableIdent, IfExists: yyDollar[4].booleanUnion()}
 		}
@@ -9048,7 +9368,7 @@ yydefault:
 	case 438:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2439
+//line sql.y:2469
 		{
 			yyLOCAL = &TruncateTable{Table: yyDollar[3].tableName}
 		}
@@ -9056,7 +9376,7 @@ yydefault:
 	case 439:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2443
+//line sql.y:2473
 		{
 			yyLOCAL = &TruncateTable{Table: yyDollar[2].tableName}
 		}
@@ -9064,7 +9384,7 @@ yydefault:
 	case 440:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2448
+//line sql.y:2478
 		{
 			yyLOCAL = &OtherRead{}
 		}
@@ -9072,7 +9392,7 @@ yydefault:
 	case 441:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2454
+//line sql.y:2484
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Charset, Filter: yyDollar[3].showFilterUnion()}}
 		}
@@ -9080,7 +9400,7 @@ yydefault:
 	case 442:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2458
+//line sql.y:2488
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Collation, Filter: yyDollar[3].showFilterUnion()}}
 		}
@@ -9088,7 +9408,7 @@ yydefault:
 	case 443:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2462
+//line sql.y:2492
 		{
 			yyLOCAL = &Show{&ShowBasic{Full: yyDollar[2].booleanUnion(), Command: Column, Tbl: yyDollar[5].tableName, DbName: yyDollar[6].tableIdent, Filter: yyDollar[7].showFilterUnion()}}
 		}
@@ -9096,7 +9416,7 @@ yydefault:
 	case 444:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2466
+//line sql.y:2496
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Database, Filter: yyDollar[3].showFilterUnion()}}
 		}
@@ -9104,7 +9424,7 @@ yydefault:
 	case 445:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2470
+//line sql.y:2500
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Database, Filter: yyDollar[3].showFilterUnion()}}
 		}
@@ -9112,7 +9432,7 @@ yydefault:
 	case 446:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2474
+//line sql.y:2504
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Keyspace, Filter: yyDollar[3].showFilterUnion()}}
 		}
@@ -9120,7 +9440,7 @@ yydefault:
 	case 447:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2478
+//line sql.y:2508
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Keyspace, Filter: yyDollar[3].showFilterUnion()}}
 		}
@@ -9128,7 +9448,7 @@ yydefault:
 	case 448:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2482
+//line sql.y:2512
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Function, Filter: yyDollar[4].showFilterUnion()}}
 		}
@@ -9136,7 +9456,7 @@ yydefault:
 	case 449:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2486
+//line sql.y:2516
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Index, Tbl: yyDollar[5].tableName, DbName: yyDollar[6].tableIdent, Filter: yyDollar[7].showFilterUnion()}}
 		}
@@ -9144,7 +9464,7 @@ yydefault:
 	case 450:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2490
+//line sql.y:2520
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: OpenTable, DbName: yyDollar[4].tableIdent, Filter: yyDollar[5].showFilterUnion()}}
 		}
@@ -9152,7 +9472,7 @@ yydefault:
 	case 451:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2494
+//line sql.y:2524
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Privilege}}
 		}
@@ -9160,7 +9480,7 @@ yydefault:
 	case 452:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2498
+//line sql.y:2528
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Procedure, Filter: yyDollar[4].showFilterUnion()}}
 		}
@@ -9168,7 +9488,7 @@ yydefault:
 	case 453:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2502
+//line sql.y:2532
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: StatusSession, Filter: yyDollar[4].showFilterUnion()}}
 		}
@@ -9176,7 +9496,7 @@ yydefault:
 	case 454:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2506
+//line sql.y:2536
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: StatusGlobal, Filter: yyDollar[4].showFilterUnion()}}
 		}
@@ -9184,7 +9504,7 @@ yydefault:
 	case 455:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2510
+//line sql.y:2540
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: VariableSession, Filter: yyDollar[4].showFilterUnion()}}
 		}
@@ -9192,7 +9512,7 @@ yydefault:
 	case 456:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2514
+//line sql.y:2544
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: VariableGlobal, Filter: yyDollar[4].showFilterUnion()}}
 		}
@@ -9200,7 +9520,7 @@ yydefault:
 	case 457:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2518
+//line sql.y:2548
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: TableStatus, DbName: yyDollar[4].tableIdent, Filter: yyDollar[5].showFilterUnion()}}
 		}
@@ -9208,7 +9528,7 @@ yydefault:
 	case 458:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2522
+//line sql.y:2552
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Table, Full: yyDollar[2].booleanUnion(), DbName: yyDollar[4].tableIdent, Filter: yyDollar[5].showFilterUnion()}}
 		}
@@ -9216,7 +9536,7 @@ yydefault:
 	case 459:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2526
+//line sql.y:2556
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Trigger, DbName: yyDollar[3].tableIdent, Filter: yyDollar[4].showFilterUnion()}}
 		}
@@ -9224,7 +9544,7 @@ yydefault:
 	case 460:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2530
+//line sql.y:2560
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateDb, Op: yyDollar[4].tableName}}
 		}
@@ -9232,7 +9552,7 @@ yydefault:
 	case 461:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2534
+//line sql.y:2564
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateE, Op: yyDollar[4].tableName}}
 		}
@@ -9240,7 +9560,7 @@ yydefault:
 	case 462:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2538
+//line sql.y:2568
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateF, Op: yyDollar[4].tableName}}
 		}
@@ -9248,7 +9568,7 @@ yydefault:
 	case 463:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2542
+//line sql.y:2572
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateProc, Op: yyDollar[4].tableName}}
 		}
@@ -9256,7 +9576,7 @@ yydefault:
 	case 464:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2546
+//line sql.y:2576
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateTbl, Op: yyDollar[4].tableName}}
 		}
@@ -9264,7 +9584,7 @@ yydefault:
 	case 465:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2550
+//line sql.y:2580
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateTr, Op: yyDollar[4].tableName}}
 		}
@@ -9272,7 +9592,7 @@ yydefault:
 	case 466:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2554
+//line sql.y:2584
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateV, Op: yyDollar[4].tableName}}
 		}
@@ -9280,7 +9600,7 @@ yydefault:
 	case 467:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2558
+//line sql.y:2588
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Scope: ImplicitScope}}
 		}
@@ -9288,7 +9608,7 @@ yydefault:
 	case 468:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2562
+//line sql.y:2592
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].colIdent.String()), Scope: ImplicitScope}}
 		}
@@ -9296,7 +9616,7 @@ yydefault:
 	case 469:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2566
+//line sql.y:2596
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Scope: ImplicitScope}}
 		}
@@ -9304,7 +9624,7 @@ yydefault:
 	case 470:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2570
+//line sql.y:2600
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str), Scope: ImplicitScope}}
 		}
@@ -9312,7 +9632,7 @@ yydefault:
 	case 471:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2574
+//line sql.y:2604
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Table: yyDollar[4].tableName, Scope: ImplicitScope}}
 		}
@@ -9320,7 +9640,7 @@ yydefault:
 	case 472:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2578
+//line sql.y:2608
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str), Scope: ImplicitScope}}
 		}
@@ -9328,7 +9648,7 @@ yydefault:
 	case 473:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2582
+//line sql.y:2612
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Table: yyDollar[4].tableName, Scope: ImplicitScope}}
 		}
@@ -9336,7 +9656,7 @@ yydefault:
 	case 474:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2586
+//line sql.y:2616
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[3].str), Scope: ImplicitScope}}
 		}
@@ -9344,7 +9664,7 @@ yydefault:
 	case 475:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2590
+//line sql.y:2620
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: GtidExecGlobal, DbName: yyDollar[4].tableIdent}}
 		}
@@ -9352,7 +9672,7 @@ yydefault:
 	case 476:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2594
+//line sql.y:2624
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: VGtidExecGlobal, DbName: yyDollar[4].tableIdent}}
 		}
@@ -9360,7 +9680,7 @@ yydefault:
 	case 477:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2598
+//line sql.y:2628
 		{
 			showTablesOpt := &ShowTablesOpt{Filter: yyDollar[4].showFilterUnion()}
 			yyLOCAL = &Show{&ShowLegacy{Scope: VitessMetadataScope, Type: string(yyDollar[3].str), ShowTablesOpt: showTablesOpt}}
@@ -9369,7 +9689,7 @@ yydefault:
 	case 478:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2603
+//line sql.y:2633
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: VitessMigrations, Filter: yyDollar[4].showFilterUnion(), DbName: yyDollar[3].tableIdent}}
 		}
@@ -9377,7 +9697,7 @@ yydefault:
 	case 479:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2607
+//line sql.y:2637
 		{
 			yyLOCAL = &ShowMigrationLogs{UUID: string(yyDollar[3].str)}
 		}
@@ -9385,39 +9705,55 @@ yydefault:
 	case 480:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2611
+//line sql.y:2641
 		{
-			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Scope: ImplicitScope}}
+			yyLOCAL = &Show{&ShowBasic{Command: VitessDDLStatus, Filter: yyDollar[3].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
 	case 481:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2615
+//line sql.y:2645
 		{
-			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Scope: ImplicitScope}}
+			yyLOCAL = &Show{&ShowBasic{Command: VitessDDLStatus, Filter: &ShowFilter{Filter: &ComparisonExpr{Operator: EqualOp, Left: NewColName("uuid"), Right: NewStrLiteral(yyDollar[3].str)}}}}
 		}
 		yyVAL.union = yyLOCAL
 	case 482:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL Statement
+//line sql.y:2649
+		{
+			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Scope: ImplicitScope}}
+		}
+		yyVAL.union = yyLOCAL
+	case 483:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL Statement
+//line sql.y:2653
+		{
+			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Scope: ImplicitScope}}
+		}
+		yyVAL.union = yyLOCAL
+	case 484:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2619
+//line sql.y:2657
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), OnTable: yyDollar[5].tableName, Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 483:
+	case 485:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2623
+//line sql.y:2661
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Warnings}}
 		}
 		yyVAL.union = yyLOCAL
-	case 484:
+	case 486:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2628
+//line sql.y:2666
 		{
 			// This should probably be a different type (ShowVitessTopoOpt), but
 			// just getting the thing working for now
@@ -9425,1234 +9761,1314 @@ yydefault:
 			yyLOCAL = &Show{&ShowLegacy{Type: yyDollar[2].str, ShowTablesOpt: showTablesOpt}}
 		}
 		yyVAL.union = yyLOCAL
-	case 485:
+	case 487:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2642
+//line sql.y:2680
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].colIdent.String()), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 486:
+	case 488:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2646
+//line sql.y:2684
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 487:
+	case 489:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2650
+//line sql.y:2688
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 488:
+	case 490:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2656
+//line sql.y:2694
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 489:
+	case 491:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2660
+//line sql.y:2698
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 490:
+	case 492:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2666
+//line sql.y:2704
 		{
 			yyVAL.str = ""
 		}
-	case 491:
+	case 493:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2670
+//line sql.y:2708
 		{
 			yyVAL.str = "extended "
 		}
-	case 492:
+	case 494:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2676
+//line sql.y:2714
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 493:
+	case 495:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2680
+//line sql.y:2718
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 494:
+	case 496:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2686
+//line sql.y:2724
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 495:
+	case 497:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2690
+//line sql.y:2728
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 496:
+	case 498:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2696
+//line sql.y:2734
 		{
 			yyVAL.tableIdent = NewTableIdent("")
 		}
-	case 497:
+	case 499:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2700
+//line sql.y:2738
 		{
 			yyVAL.tableIdent = yyDollar[2].tableIdent
 		}
-	case 498:
+	case 500:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2704
+//line sql.y:2742
 		{
 			yyVAL.tableIdent = yyDollar[2].tableIdent
 		}
-	case 499:
+	case 501:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *ShowFilter
-//line sql.y:2710
+//line sql.y:2748
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 500:
+	case 502:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ShowFilter
-//line sql.y:2714
+//line sql.y:2752
 		{
 			yyLOCAL = &ShowFilter{Like: string(yyDollar[2].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 501:
+	case 503:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ShowFilter
-//line sql.y:2718
+//line sql.y:2756
 		{
 			yyLOCAL = &ShowFilter{Filter: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 502:
+	case 504:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *ShowFilter
-//line sql.y:2724
+//line sql.y:2762
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 503:
+	case 505:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ShowFilter
-//line sql.y:2728
+//line sql.y:2766
 		{
 			yyLOCAL = &ShowFilter{Like: string(yyDollar[2].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 504:
+	case 506:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2734
+//line sql.y:2772
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 505:
+	case 507:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2738
+//line sql.y:2776
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 506:
+	case 508:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2742
+//line sql.y:2780
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 507:
+	case 509:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2748
+//line sql.y:2786
 		{
 			yyLOCAL = &Use{DBName: yyDollar[2].tableIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 508:
+	case 510:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2752
+//line sql.y:2790
 		{
 			yyLOCAL = &Use{DBName: TableIdent{v: ""}}
 		}
 		yyVAL.union = yyLOCAL
-	case 509:
+	case 511:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2758
+//line sql.y:2796
 		{
 			yyLOCAL = &Begin{}
 		}
 		yyVAL.union = yyLOCAL
-	case 510:
+	case 512:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2762
+//line sql.y:2800
 		{
 			yyLOCAL = &Begin{}
 		}
 		yyVAL.union = yyLOCAL
-	case 511:
+	case 513:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2768
+//line sql.y:2806
 		{
 			yyLOCAL = &Commit{}
 		}
 		yyVAL.union = yyLOCAL
-	case 512:
+	case 514:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2774
+//line sql.y:2812
 		{
 			yyLOCAL = &Rollback{}
 		}
 		yyVAL.union = yyLOCAL
-	case 513:
+	case 515:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2778
+//line sql.y:2816
 		{
 			yyLOCAL = &SRollback{Name: yyDollar[5].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 514:
+	case 516:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2783
+//line sql.y:2821
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 515:
+	case 517:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2785
+//line sql.y:2823
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 516:
+	case 518:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2788
+//line sql.y:2826
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 517:
+	case 519:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2790
+//line sql.y:2828
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 518:
+	case 520:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2795
+//line sql.y:2833
 		{
 			yyLOCAL = &Savepoint{Name: yyDollar[2].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 519:
+	case 521:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2801
+//line sql.y:2839
 		{
 			yyLOCAL = &Release{Name: yyDollar[3].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 520:
+	case 522:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:2806
+//line sql.y:2844
 		{
 			yyLOCAL = EmptyType
 		}
 		yyVAL.union = yyLOCAL
-	case 521:
+	case 523:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:2810
+//line sql.y:2848
 		{
 			yyLOCAL = JSONType
 		}
 		yyVAL.union = yyLOCAL
-	case 522:
+	case 524:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:2814
+//line sql.y:2852
 		{
 			yyLOCAL = TreeType
 		}
 		yyVAL.union = yyLOCAL
-	case 523:
+	case 525:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:2818
+//line sql.y:2856
 		{
 			yyLOCAL = VitessType
 		}
 		yyVAL.union = yyLOCAL
-	case 524:
+	case 526:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:2822
+//line sql.y:2860
 		{
 			yyLOCAL = TraditionalType
 		}
 		yyVAL.union = yyLOCAL
-	case 525:
+	case 527:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:2826
+//line sql.y:2864
 		{
 			yyLOCAL = AnalyzeType
 		}
 		yyVAL.union = yyLOCAL
-	case 526:
+	case 528:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL ExplainType
+//line sql.y:2869
+		{
+			yyLOCAL = EmptyType
+		}
+		yyVAL.union = yyLOCAL
+	case 529:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL ExplainType
+//line sql.y:2873
+		{
+			yyLOCAL = JSONType
+		}
+		yyVAL.union = yyLOCAL
+	case 530:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2832
+//line sql.y:2879
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 527:
+	case 531:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2836
+//line sql.y:2883
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 528:
+	case 532:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2840
+//line sql.y:2887
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 529:
+	case 533:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2846
+//line sql.y:2893
 		{
 			yyLOCAL = yyDollar[1].selStmtUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 530:
+	case 534:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2850
+//line sql.y:2897
 		{
 			yyLOCAL = yyDollar[1].statementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 531:
+	case 535:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2854
+//line sql.y:2901
 		{
 			yyLOCAL = yyDollar[1].statementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 532:
+	case 536:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2858
+//line sql.y:2905
 		{
 			yyLOCAL = yyDollar[1].statementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 533:
+	case 537:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2863
+//line sql.y:2910
 		{
 			yyVAL.str = ""
 		}
-	case 534:
+	case 538:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2867
+//line sql.y:2914
 		{
 			yyVAL.str = yyDollar[1].colIdent.val
 		}
-	case 535:
+	case 539:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2871
+//line sql.y:2918
 		{
 			yyVAL.str = encodeSQLString(yyDollar[1].str)
 		}
-	case 536:
+	case 540:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2877
+//line sql.y:2924
 		{
 			yyLOCAL = &ExplainTab{Table: yyDollar[2].tableName, Wild: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 537:
+	case 541:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2881
+//line sql.y:2928
 		{
 			yyLOCAL = &ExplainStmt{Type: yyDollar[2].explainTypeUnion(), Statement: yyDollar[3].statementUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 538:
+	case 542:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL Statement
+//line sql.y:2932
+		{
+			yyLOCAL = &VExplainStmt{Type: yyDollar[3].explainTypeUnion(), Statement: yyDollar[4].statementUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 543:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL Statement
+//line sql.y:2936
+		{
+			yyLOCAL = &VExplainStmt{Kind: VExplainPlan, Type: yyDollar[3].explainTypeUnion(), Statement: yyDollar[4].statementUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 544:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2887
+//line sql.y:2942
 		{
 			yyLOCAL = &OtherAdmin{}
 		}
 		yyVAL.union = yyLOCAL
-	case 539:
+	case 545:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2891
+//line sql.y:2946
 		{
 			yyLOCAL = &OtherAdmin{}
 		}
 		yyVAL.union = yyLOCAL
-	case 540:
+	case 546:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2897
+//line sql.y:2952
 		{
 			yyLOCAL = &LockTables{Tables: yyDollar[3].tableAndLockTypesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 541:
+	case 547:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableAndLockTypes
-//line sql.y:2903
+//line sql.y:2958
 		{
 			yyLOCAL = TableAndLockTypes{yyDollar[1].tableAndLockTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 542:
+	case 548:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2907
+//line sql.y:2962
 		{
 			yySLICE := (*TableAndLockTypes)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].tableAndLockTypeUnion())
 		}
-	case 543:
+	case 549:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *TableAndLockType
-//line sql.y:2913
+//line sql.y:2968
 		{
 			yyLOCAL = &TableAndLockType{Table: yyDollar[1].aliasedTableNameUnion(), Lock: yyDollar[2].lockTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 544:
+	case 550:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL LockType
-//line sql.y:2919
+//line sql.y:2974
 		{
 			yyLOCAL = Read
 		}
 		yyVAL.union = yyLOCAL
-	case 545:
+	case 551:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL LockType
-//line sql.y:2923
+//line sql.y:2978
 		{
 			yyLOCAL = ReadLocal
 		}
 		yyVAL.union = yyLOCAL
-	case 546:
+	case 552:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL LockType
-//line sql.y:2927
+//line sql.y:2982
 		{
 			yyLOCAL = Write
 		}
 		yyVAL.union = yyLOCAL
-	case 547:
+	case 553:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL LockType
-//line sql.y:2931
+//line sql.y:2986
 		{
 			yyLOCAL = LowPriorityWrite
 		}
 		yyVAL.union = yyLOCAL
-	case 548:
+	case 554:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2937
+//line sql.y:2992
 		{
 			yyLOCAL = &UnlockTables{}
 		}
 		yyVAL.union = yyLOCAL
-	case 549:
+	case 555:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2943
+//line sql.y:2998
 		{
 			yyLOCAL = &RevertMigration{Comments: Comments(yyDollar[2].strs), UUID: string(yyDollar[4].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 550:
+	case 556:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2949
+//line sql.y:3004
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion(), FlushOptions: yyDollar[3].strs}
 		}
 		yyVAL.union = yyLOCAL
-	case 551:
+	case 557:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2953
+//line sql.y:3008
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 552:
+	case 558:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2957
+//line sql.y:3012
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion(), WithLock: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 553:
+	case 559:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2961
+//line sql.y:3016
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion(), TableNames: yyDollar[4].tableNamesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 554:
+	case 560:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2965
+//line sql.y:3020
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion(), TableNames: yyDollar[4].tableNamesUnion(), WithLock: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 555:
+	case 561:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2969
+//line sql.y:3024
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion(), TableNames: yyDollar[4].tableNamesUnion(), ForExport: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 556:
+	case 562:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2975
+//line sql.y:3030
 		{
 			yyVAL.strs = []string{yyDollar[1].str}
 		}
-	case 557:
+	case 563:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2979
+//line sql.y:3034
 		{
 			yyVAL.strs = append(yyDollar[1].strs, yyDollar[3].str)
 		}
-	case 558:
+	case 564:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2985
+//line sql.y:3040
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
-	case 559:
+	case 565:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2989
+//line sql.y:3044
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
-	case 560:
+	case 566:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2993
+//line sql.y:3048
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
-	case 561:
+	case 567:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2997
+//line sql.y:3052
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
-	case 562:
+	case 568:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3001
+//line sql.y:3056
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 563:
+	case 569:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3005
+//line sql.y:3060
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 564:
+	case 570:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3009
+//line sql.y:3064
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 565:
+	case 571:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3013
+//line sql.y:3068
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str) + yyDollar[3].str
 		}
-	case 566:
+	case 572:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3017
+//line sql.y:3072
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
-	case 567:
+	case 573:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3021
+//line sql.y:3076
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 568:
+	case 574:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3025
+//line sql.y:3080
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 569:
+	case 575:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3029
+//line sql.y:3084
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 570:
+	case 576:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3034
+//line sql.y:3089
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 571:
+	case 577:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3038
+//line sql.y:3093
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 572:
+	case 578:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3042
+//line sql.y:3097
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 573:
+	case 579:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3047
+//line sql.y:3102
 		{
 			yyVAL.str = ""
 		}
-	case 574:
+	case 580:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3051
+//line sql.y:3106
 		{
 			yyVAL.str = " " + string(yyDollar[1].str) + " " + string(yyDollar[2].str) + " " + yyDollar[3].colIdent.String()
 		}
-	case 575:
+	case 581:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3056
+//line sql.y:3111
 		{
 			setAllowComments(yylex, true)
 		}
-	case 576:
+	case 582:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3060
+//line sql.y:3115
 		{
 			yyVAL.strs = yyDollar[2].strs
 			setAllowComments(yylex, false)
 		}
-	case 577:
+	case 583:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3066
+//line sql.y:3121
 		{
 			yyVAL.strs = nil
 		}
-	case 578:
+	case 584:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3070
+//line sql.y:3125
 		{
 			yyVAL.strs = append(yyDollar[1].strs, yyDollar[2].str)
 		}
-	case 579:
+	case 585:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL bool
-//line sql.y:3076
+		var yyLOCAL *UnionSelect
+//line sql.y:3131
 		{
-			yyLOCAL = true
+			yyLOCAL = &UnionSelect{Type: UnionType, Distinct: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 580:
+	case 586:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		var yyLOCAL bool
-//line sql.y:3080
+		var yyLOCAL *UnionSelect
+//line sql.y:3135
 		{
-			yyLOCAL = false
+			yyLOCAL = &UnionSelect{Type: UnionType}
 		}
 		yyVAL.union = yyLOCAL
-	case 581:
+	case 587:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		var yyLOCAL bool
-//line sql.y:3084
+		var yyLOCAL *UnionSelect
+//line sql.y:3139
 		{
-			yyLOCAL = true
+			yyLOCAL = &UnionSelect{Type: UnionType, Distinct: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 582:
+	case 588:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *UnionSelect
+//line sql.y:3143
+		{
+			yyLOCAL = &UnionSelect{Type: IntersectType, Distinct: true}
+		}
+		yyVAL.union = yyLOCAL
+	case 589:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *UnionSelect
+//line sql.y:3147
+		{
+			yyLOCAL = &UnionSelect{Type: IntersectType}
+		}
+		yyVAL.union = yyLOCAL
+	case 590:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *UnionSelect
+//line sql.y:3151
+		{
+			yyLOCAL = &UnionSelect{Type: IntersectType, Distinct: true}
+		}
+		yyVAL.union = yyLOCAL
+	case 591:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *UnionSelect
+//line sql.y:3155
+		{
+			yyLOCAL = &UnionSelect{Type: ExceptType, Distinct: true}
+		}
+		yyVAL.union = yyLOCAL
+	case 592:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *UnionSelect
+//line sql.y:3159
+		{
+			yyLOCAL = &UnionSelect{Type: ExceptType}
+		}
+		yyVAL.union = yyLOCAL
+	case 593:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *UnionSelect
+//line sql.y:3163
+		{
+			yyLOCAL = &UnionSelect{Type: ExceptType, Distinct: true}
+		}
+		yyVAL.union = yyLOCAL
+	case 594:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3089
+//line sql.y:3168
 		{
 			yyVAL.str = ""
 		}
-	case 583:
+	case 595:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3093
+//line sql.y:3172
 		{
 			yyVAL.str = SQLNoCacheStr
 		}
-	case 584:
+	case 596:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3097
+//line sql.y:3176
 		{
 			yyVAL.str = SQLCacheStr
 		}
-	case 585:
+	case 597:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3102
+//line sql.y:3181
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 586:
+	case 598:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3106
+//line sql.y:3185
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 587:
+	case 599:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3110
+//line sql.y:3189
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 588:
+	case 600:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL SelectExprs
-//line sql.y:3115
+//line sql.y:3194
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 589:
+	case 601:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL SelectExprs
-//line sql.y:3119
+//line sql.y:3198
 		{
 			yyLOCAL = yyDollar[1].selectExprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 590:
+	case 602:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3124
+//line sql.y:3203
 		{
 			yyVAL.strs = nil
 		}
-	case 591:
+	case 603:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3128
+//line sql.y:3207
 		{
 			yyVAL.strs = []string{yyDollar[1].str}
 		}
-	case 592:
+	case 604:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3132
+//line sql.y:3211
 		{ // TODO: This is a hack since I couldn't get it to work in a nicer way. I got 'conflicts: 8 shift/reduce'
 			yyVAL.strs = []string{yyDollar[1].str, yyDollar[2].str}
 		}
-	case 593:
+	case 605:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3136
+//line sql.y:3215
 		{
 			yyVAL.strs = []string{yyDollar[1].str, yyDollar[2].str, yyDollar[3].str}
 		}
-	case 594:
+	case 606:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3140
+//line sql.y:3219
 		{
 			yyVAL.strs = []string{yyDollar[1].str, yyDollar[2].str, yyDollar[3].str, yyDollar[4].str}
 		}
-	case 595:
+	case 607:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3146
+//line sql.y:3225
 		{
 			yyVAL.str = SQLNoCacheStr
 		}
-	case 596:
+	case 608:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3150
+//line sql.y:3229
 		{
 			yyVAL.str = SQLCacheStr
 		}
-	case 597:
+	case 609:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3154
+//line sql.y:3233
 		{
 			yyVAL.str = DistinctStr
 		}
-	case 598:
+	case 610:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3158
+//line sql.y:3237
 		{
 			yyVAL.str = DistinctStr
 		}
-	case 599:
+	case 611:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3162
+//line sql.y:3241
 		{
 			yyVAL.str = StraightJoinHint
 		}
-	case 600:
+	case 612:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3166
+//line sql.y:3245
 		{
 			yyVAL.str = SQLCalcFoundRowsStr
 		}
-	case 601:
+	case 613:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3170
+//line sql.y:3249
 		{
 			yyVAL.str = AllStr // These are not picked up by NewSelect, and so ALL will be dropped. But this is OK, since it's redundant anyway
 		}
-	case 602:
+	case 614:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL SelectExprs
-//line sql.y:3176
+//line sql.y:3255
 		{
 			yyLOCAL = SelectExprs{yyDollar[1].selectExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 603:
+	case 615:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3180
+//line sql.y:3259
 		{
 			yySLICE := (*SelectExprs)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].selectExprUnion())
 		}
-	case 604:
+	case 616:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL SelectExpr
-//line sql.y:3186
+//line sql.y:3265
 		{
 			yyLOCAL = &StarExpr{}
 		}
 		yyVAL.union = yyLOCAL
-	case 605:
+	case 617:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL SelectExpr
-//line sql.y:3190
+//line sql.y:3269
 		{
 			yyLOCAL = &AliasedExpr{Expr: yyDollar[1].exprUnion(), As: yyDollar[2].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 606:
+	case 618:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL SelectExpr
-//line sql.y:3194
+//line sql.y:3273
 		{
 			yyLOCAL = &StarExpr{TableName: TableName{Name: yyDollar[1].tableIdent}}
 		}
 		yyVAL.union = yyLOCAL
-	case 607:
+	case 619:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL SelectExpr
-//line sql.y:3198
+//line sql.y:3277
 		{
 			yyLOCAL = &StarExpr{TableName: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}}
 		}
 		yyVAL.union = yyLOCAL
-	case 608:
+	case 620:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3203
+//line sql.y:3282
 		{
 			yyVAL.colIdent = ColIdent{}
 		}
-	case 609:
+	case 621:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3207
+//line sql.y:3286
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 610:
+	case 622:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3211
+//line sql.y:3290
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 612:
+	case 624:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3218
+//line sql.y:3297
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].str))
 		}
-	case 613:
+	case 625:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL TableExprs
-//line sql.y:3223
+//line sql.y:3302
 		{
 			yyLOCAL = TableExprs{&AliasedTableExpr{Expr: TableName{Name: NewTableIdent("dual")}}}
 		}
 		yyVAL.union = yyLOCAL
-	case 614:
+	case 626:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL TableExprs
-//line sql.y:3227
+//line sql.y:3306
 		{
 			yyLOCAL = yyDollar[2].tableExprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 615:
+	case 627:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableExprs
-//line sql.y:3233
+//line sql.y:3312
 		{
 			yyLOCAL = TableExprs{yyDollar[1].tableExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 616:
+	case 628:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3237
+//line sql.y:3316
 		{
 			yySLICE := (*TableExprs)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].tableExprUnion())
 		}
-	case 619:
+	case 631:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3247
+//line sql.y:3326
 		{
 			yyLOCAL = yyDollar[1].aliasedTableNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 620:
+	case 632:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3251
+//line sql.y:3330
 		{
 			yyLOCAL = &AliasedTableExpr{Expr: yyDollar[1].derivedTableUnion(), As: yyDollar[3].tableIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 621:
+	case 633:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3255
+//line sql.y:3334
 		{
 			yyLOCAL = &ParenTableExpr{Exprs: yyDollar[2].tableExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 622:
+	case 634:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *DerivedTable
-//line sql.y:3261
+//line sql.y:3340
 		{
 			yyLOCAL = &DerivedTable{yyDollar[2].selStmtUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 623:
+	case 635:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *AliasedTableExpr
-//line sql.y:3267
+//line sql.y:3346
 		{
 			yyLOCAL = &AliasedTableExpr{Expr: yyDollar[1].tableName, As: yyDollar[2].tableIdent, Hints: yyDollar[3].indexHintsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 624:
+	case 636:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *AliasedTableExpr
-//line sql.y:3271
+//line sql.y:3350
 		{
 			yyLOCAL = &AliasedTableExpr{Expr: yyDollar[1].tableName, Partitions: yyDollar[4].partitionsUnion(), As: yyDollar[6].tableIdent, Hints: yyDollar[7].indexHintsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 625:
+	case 637:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:3276
+//line sql.y:3355
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 626:
+	case 638:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:3280
+//line sql.y:3359
 		{
 			yyLOCAL = yyDollar[2].columnsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 627:
+	case 639:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:3286
+//line sql.y:3365
 		{
 			yyLOCAL = Columns{yyDollar[1].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 628:
+	case 640:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3290
+//line sql.y:3369
 		{
 			yySLICE := (*Columns)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].colIdent)
 		}
-	case 629:
+	case 641:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:3296
+//line sql.y:3375
 		{
 			yyLOCAL = Columns{yyDollar[1].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 630:
+	case 642:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:3300
+//line sql.y:3379
 		{
 			yyLOCAL = Columns{NewColIdent(string(yyDollar[1].str))}
 		}
 		yyVAL.union = yyLOCAL
-	case 631:
+	case 643:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3304
+//line sql.y:3383
 		{
 			yySLICE := (*Columns)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].colIdent)
 		}
-	case 632:
+	case 644:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3308
+//line sql.y:3387
 		{
 			yySLICE := (*Columns)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, NewColIdent(string(yyDollar[3].str)))
 		}
-	case 633:
+	case 645:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Partitions
-//line sql.y:3314
+//line sql.y:3393
 		{
 			yyLOCAL = Partitions{yyDollar[1].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 634:
+	case 646:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3318
+//line sql.y:3397
 		{
 			yySLICE := (*Partitions)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].colIdent)
 		}
-	case 635:
+	case 647:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3331
+//line sql.y:3410
 		{
 			yyLOCAL = &JoinTableExpr{LeftExpr: yyDollar[1].tableExprUnion(), Join: yyDollar[2].joinTypeUnion(), RightExpr: yyDollar[3].tableExprUnion(), Condition: yyDollar[4].joinCondition}
 		}
 		yyVAL.union = yyLOCAL
-	case 636:
+	case 648:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3335
+//line sql.y:3414
 		{
 			yyLOCAL = &JoinTableExpr{LeftExpr: yyDollar[1].tableExprUnion(), Join: yyDollar[2].joinTypeUnion(), RightExpr: yyDollar[3].tableExprUnion(), Condition: yyDollar[4].joinCondition}
 		}
 		yyVAL.union = yyLOCAL
-	case 637:
+	case 649:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3339
+//line sql.y:3418
 		{
 			yyLOCAL = &JoinTableExpr{LeftExpr: yyDollar[1].tableExprUnion(), Join: yyDollar[2].joinTypeUnion(), RightExpr: yyDollar[3].tableExprUnion(), Condition: yyDollar[4].joinCondition}
 		}
 		yyVAL.union = yyLOCAL
-	case 638:
+	case 650:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3343
+//line sql.y:3422
 		{
 			yyLOCAL = &JoinTableExpr{LeftExpr: yyDollar[1].tableExprUnion(), Join: yyDollar[2].joinTypeUnion(), RightExpr: yyDollar[3].tableExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 639:
+	case 651:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3349
+//line sql.y:3428
 		{
 			yyVAL.joinCondition = &JoinCondition{On: yyDollar[2].exprUnion()}
 		}
-	case 640:
+	case 652:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3351
+//line sql.y:3430
 		{
 			yyVAL.joinCondition = &JoinCondition{Using: yyDollar[3].columnsUnion()}
 		}
-	case 641:
+	case 653:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3355
+//line sql.y:3434
 		{
 			yyVAL.joinCondition = &JoinCondition{}
 		}
-	case 642:
+	case 654:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3357
+//line sql.y:3436
 		{
 			yyVAL.joinCondition = yyDollar[1].joinCondition
 		}
-	case 643:
+	case 655:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3361
+//line sql.y:3440
 		{
 			yyVAL.joinCondition = &JoinCondition{}
 		}
-	case 644:
+	case 656:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3363
+//line sql.y:3442
 		{
 			yyVAL.joinCondition = &JoinCondition{On: yyDollar[2].exprUnion()}
 		}
-	case 645:
+	case 657:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3366
+//line sql.y:3445
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 646:
+	case 658:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3368
+//line sql.y:3447
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 647:
+	case 659:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3371
+//line sql.y:3450
 		{
 			yyVAL.tableIdent = NewTableIdent("")
 		}
-	case 648:
+	case 660:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3375
+//line sql.y:3454
 		{
 			yyVAL.tableIdent = yyDollar[1].tableIdent
 		}
-	case 649:
+	case 661:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3379
+//line sql.y:3458
 		{
 			yyVAL.tableIdent = yyDollar[2].tableIdent
 		}
-	case 651:
+	case 663:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3386
+//line sql.y:3465
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].str))
 		}
-	case 652:
+	case 664:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3392
+//line sql.y:3471
 		{
 			yyLOCAL = NormalJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 653:
+	case 665:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3396
+//line sql.y:3475
 		{
 			yyLOCAL = NormalJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 654:
+	case 666:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3400
+//line sql.y:3479
 		{
 			yyLOCAL = NormalJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 655:
+	case 667:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3406
+//line sql.y:3485
 		{
 			yyLOCAL = StraightJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 656:
+	case 668:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3412
+//line sql.y:3491
 		{
 			yyLOCAL = LeftJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 657:
+	case 669:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3416
+//line sql.y:3495
 		{
 			yyLOCAL = LeftJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 658:
+	case 670:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3420
+//line sql.y:3499
 		{
 			yyLOCAL = RightJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 659:
+	case 671:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3424
+//line sql.y:3503
 		{
 			yyLOCAL = RightJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 660:
+	case 672:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3430
+//line sql.y:3509
 		{
 			yyLOCAL = NaturalJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 661:
+	case 673:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3434
+//line sql.y:3513
 		{
 			if yyDollar[2].joinTypeUnion() == LeftJoinType {
 				yyLOCAL = NaturalLeftJoinType
@@ -10661,1336 +11077,1523 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 662:
+	case 674:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3444
+//line sql.y:3523
 		{
 			yyVAL.tableName = yyDollar[2].tableName
 		}
-	case 663:
+	case 675:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3448
+//line sql.y:3527
 		{
 			yyVAL.tableName = yyDollar[1].tableName
 		}
-	case 664:
+	case 676:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3454
+//line sql.y:3533
 		{
 			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
 		}
-	case 665:
+	case 677:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3458
+//line sql.y:3537
 		{
 			yyVAL.tableName = TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}
 		}
-	case 666:
+	case 678:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3464
+//line sql.y:3543
 		{
 			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
 		}
-	case 667:
+	case 679:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *IndexHints
-//line sql.y:3469
+//line sql.y:3548
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 668:
+	case 680:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *IndexHints
-//line sql.y:3473
+//line sql.y:3552
 		{
 			yyLOCAL = &IndexHints{Type: UseOp, Indexes: yyDollar[4].columnsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 669:
+	case 681:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *IndexHints
-//line sql.y:3477
+//line sql.y:3556
 		{
 			yyLOCAL = &IndexHints{Type: UseOp}
 		}
 		yyVAL.union = yyLOCAL
-	case 670:
+	case 682:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *IndexHints
-//line sql.y:3481
+//line sql.y:3560
 		{
 			yyLOCAL = &IndexHints{Type: IgnoreOp, Indexes: yyDollar[4].columnsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 671:
+	case 683:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *IndexHints
-//line sql.y:3485
+//line sql.y:3564
 		{
 			yyLOCAL = &IndexHints{Type: ForceOp, Indexes: yyDollar[4].columnsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 672:
+	case 684:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3490
+//line sql.y:3569
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 673:
+	case 685:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3494
+//line sql.y:3573
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 674:
+	case 686:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3500
+//line sql.y:3579
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 675:
+	case 687:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3504
+//line sql.y:3583
 		{
 			yyLOCAL = &AndExpr{Left: yyDollar[1].exprUnion(), Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 676:
+	case 688:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3508
+//line sql.y:3587
 		{
 			yyLOCAL = &OrExpr{Left: yyDollar[1].exprUnion(), Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 677:
+	case 689:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3512
+//line sql.y:3591
 		{
 			yyLOCAL = &XorExpr{Left: yyDollar[1].exprUnion(), Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 678:
+	case 690:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3516
+//line sql.y:3595
 		{
 			yyLOCAL = &NotExpr{Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 679:
+	case 691:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3520
+//line sql.y:3599
 		{
 			yyLOCAL = &IsExpr{Left: yyDollar[1].exprUnion(), Right: yyDollar[3].isExprOperatorUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 680:
+	case 692:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3524
+//line sql.y:3603
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 681:
+	case 693:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3528
+//line sql.y:3607
 		{
 			yyLOCAL = &Default{ColName: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 682:
+	case 694:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3534
+//line sql.y:3613
 		{
 			yyVAL.str = ""
 		}
-	case 683:
+	case 695:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3538
+//line sql.y:3617
 		{
 			yyVAL.str = string(yyDollar[2].colIdent.String())
 		}
-	case 684:
+	case 696:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL BoolVal
-//line sql.y:3544
+//line sql.y:3623
 		{
 			yyLOCAL = BoolVal(true)
 		}
 		yyVAL.union = yyLOCAL
-	case 685:
+	case 697:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL BoolVal
-//line sql.y:3548
+//line sql.y:3627
 		{
 			yyLOCAL = BoolVal(false)
 		}
 		yyVAL.union = yyLOCAL
-	case 686:
+	case 698:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3554
+//line sql.y:3633
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: yyDollar[2].comparisonExprOperatorUnion(), Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 687:
+	case 699:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3558
+//line sql.y:3637
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: InOp, Right: yyDollar[3].colTupleUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 688:
+	case 700:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3562
+//line sql.y:3641
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: NotInOp, Right: yyDollar[4].colTupleUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 689:
+	case 701:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3566
+//line sql.y:3645
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: LikeOp, Right: yyDollar[3].exprUnion(), Escape: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 690:
+	case 702:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3570
+//line sql.y:3649
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: NotLikeOp, Right: yyDollar[4].exprUnion(), Escape: yyDollar[5].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 691:
+	case 703:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3574
+//line sql.y:3653
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: RegexpOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 692:
+	case 704:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3578
+//line sql.y:3657
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: NotRegexpOp, Right: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 693:
+	case 705:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3582
+//line sql.y:3661
 		{
 			yyLOCAL = &RangeCond{Left: yyDollar[1].exprUnion(), Operator: BetweenOp, From: yyDollar[3].exprUnion(), To: yyDollar[5].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 694:
+	case 706:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3586
+//line sql.y:3665
 		{
 			yyLOCAL = &RangeCond{Left: yyDollar[1].exprUnion(), Operator: NotBetweenOp, From: yyDollar[4].exprUnion(), To: yyDollar[6].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 695:
+	case 707:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3590
+//line sql.y:3669
 		{
 			yyLOCAL = &ExistsExpr{Subquery: yyDollar[2].subqueryUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 696:
+	case 708:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IsExprOperator
-//line sql.y:3596
+//line sql.y:3675
 		{
 			yyLOCAL = IsNullOp
 		}
 		yyVAL.union = yyLOCAL
-	case 697:
+	case 709:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL IsExprOperator
-//line sql.y:3600
+//line sql.y:3679
 		{
 			yyLOCAL = IsNotNullOp
 		}
 		yyVAL.union = yyLOCAL
-	case 698:
+	case 710:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IsExprOperator
-//line sql.y:3604
+//line sql.y:3683
 		{
 			yyLOCAL = IsTrueOp
 		}
 		yyVAL.union = yyLOCAL
-	case 699:
+	case 711:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL IsExprOperator
-//line sql.y:3608
+//line sql.y:3687
 		{
 			yyLOCAL = IsNotTrueOp
 		}
 		yyVAL.union = yyLOCAL
-	case 700:
+	case 712:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IsExprOperator
-//line sql.y:3612
+//line sql.y:3691
 		{
 			yyLOCAL = IsFalseOp
 		}
 		yyVAL.union = yyLOCAL
-	case 701:
+	case 713:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL IsExprOperator
-//line sql.y:3616
+//line sql.y:3695
 		{
 			yyLOCAL = IsNotFalseOp
 		}
 		yyVAL.union = yyLOCAL
-	case 702:
+	case 714:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:3622
+//line sql.y:3701
 		{
 			yyLOCAL = EqualOp
 		}
 		yyVAL.union = yyLOCAL
-	case 703:
+	case 715:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:3626
+//line sql.y:3705
 		{
 			yyLOCAL = LessThanOp
 		}
 		yyVAL.union = yyLOCAL
-	case 704:
+	case 716:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:3630
+//line sql.y:3709
 		{
 			yyLOCAL = GreaterThanOp
 		}
 		yyVAL.union = yyLOCAL
-	case 705:
+	case 717:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:3634
+//line sql.y:3713
 		{
 			yyLOCAL = LessEqualOp
 		}
 		yyVAL.union = yyLOCAL
-	case 706:
+	case 718:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:3638
+//line sql.y:3717
 		{
 			yyLOCAL = GreaterEqualOp
 		}
 		yyVAL.union = yyLOCAL
-	case 707:
+	case 719:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:3642
+//line sql.y:3721
 		{
 			yyLOCAL = NotEqualOp
 		}
 		yyVAL.union = yyLOCAL
-	case 708:
+	case 720:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:3646
+//line sql.y:3725
 		{
 			yyLOCAL = NullSafeEqualOp
 		}
 		yyVAL.union = yyLOCAL
-	case 709:
+	case 721:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3651
+//line sql.y:3730
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 710:
+	case 722:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3655
+//line sql.y:3734
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 711:
+	case 723:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ColTuple
-//line sql.y:3661
+//line sql.y:3740
 		{
 			yyLOCAL = yyDollar[1].valTupleUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 712:
+	case 724:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ColTuple
-//line sql.y:3665
+//line sql.y:3744
 		{
 			yyLOCAL = yyDollar[1].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 713:
+	case 725:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ColTuple
-//line sql.y:3669
+//line sql.y:3748
 		{
 			yyLOCAL = ListArg(yyDollar[1].str[2:])
 			bindVariable(yylex, yyDollar[1].str[2:])
 		}
 		yyVAL.union = yyLOCAL
-	case 714:
+	case 726:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *Subquery
-//line sql.y:3676
+//line sql.y:3755
 		{
 			yyLOCAL = &Subquery{yyDollar[2].selStmtUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 715:
+	case 727:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Exprs
-//line sql.y:3682
+//line sql.y:3761
 		{
 			yyLOCAL = Exprs{yyDollar[1].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 716:
+	case 728:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3686
+//line sql.y:3765
 		{
 			yySLICE := (*Exprs)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].exprUnion())
 		}
-	case 717:
+	case 729:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3692
+//line sql.y:3771
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 718:
+	case 730:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3696
+//line sql.y:3775
 		{
 			yyLOCAL = yyDollar[1].boolValUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 719:
+	case 731:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3700
+//line sql.y:3779
 		{
 			yyLOCAL = yyDollar[1].colNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 720:
+	case 732:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3704
+//line sql.y:3783
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 721:
+	case 733:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3708
+//line sql.y:3787
 		{
 			yyLOCAL = yyDollar[1].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 722:
+	case 734:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3712
+//line sql.y:3791
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: BitAndOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 723:
+	case 735:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3716
+//line sql.y:3795
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: BitOrOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 724:
+	case 736:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3720
+//line sql.y:3799
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: BitXorOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 725:
+	case 737:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3724
+//line sql.y:3803
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: PlusOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 726:
+	case 738:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3728
+//line sql.y:3807
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: MinusOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 727:
+	case 739:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3732
+//line sql.y:3811
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: MultOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 728:
+	case 740:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3736
+//line sql.y:3815
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: DivOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 729:
+	case 741:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3740
+//line sql.y:3819
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: IntDivOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 730:
+	case 742:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3744
+//line sql.y:3823
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: ModOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 731:
+	case 743:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3748
+//line sql.y:3827
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: ModOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 732:
+	case 744:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3752
+//line sql.y:3831
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: ShiftLeftOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 733:
+	case 745:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3756
+//line sql.y:3835
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: ShiftRightOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 734:
+	case 746:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3760
+//line sql.y:3839
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].colNameUnion(), Operator: JSONExtractOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 735:
+	case 747:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3764
+//line sql.y:3843
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].colNameUnion(), Operator: JSONUnquoteExtractOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 736:
+	case 748:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3768
+//line sql.y:3847
 		{
 			yyLOCAL = &CollateExpr{Expr: yyDollar[1].exprUnion(), Charset: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 737:
+	case 749:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3772
+//line sql.y:3851
 		{
 			yyLOCAL = &UnaryExpr{Operator: BinaryOp, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 738:
+	case 750:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3776
+//line sql.y:3855
 		{
 			yyLOCAL = &UnaryExpr{Operator: UBinaryOp, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 739:
+	case 751:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3780
+//line sql.y:3859
 		{
 			yyLOCAL = &UnaryExpr{Operator: Utf8Op, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 740:
+	case 752:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3784
+//line sql.y:3863
 		{
 			yyLOCAL = &UnaryExpr{Operator: Utf8mb4Op, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 741:
+	case 753:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3788
+//line sql.y:3867
 		{
 			yyLOCAL = &UnaryExpr{Operator: Latin1Op, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 742:
+	case 754:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3792
+//line sql.y:3871
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 743:
+	case 755:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3796
+//line sql.y:3875
+		{
+			yyLOCAL = handleUnaryMinus(yyDollar[2].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 756:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:3879
+		{
+			yyLOCAL = &UnaryExpr{Operator: TildaOp, Expr: yyDollar[2].exprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 757:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:3883
+		{
+			yyLOCAL = &UnaryExpr{Operator: BangOp, Expr: yyDollar[2].exprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 758:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:3887
+		{
+			// This rule prevents the usage of INTERVAL
+			// as a function. If support is needed for that,
+			// we'll need to revisit this. The solution
+			// will be non-trivial because of grammar conflicts.
+			yyLOCAL = &IntervalExpr{Expr: yyDollar[2].exprUnion(), Unit: yyDollar[3].colIdent.String()}
+		}
+		yyVAL.union = yyLOCAL
+	case 763:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:3905
+		{
+			yyLOCAL = &FuncExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprsUnion(), Over: yyDollar[5].overClauseUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 764:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:3909
+		{
+			yyLOCAL = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprsUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 765:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:3913
+		{
+			yyLOCAL = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprsUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 766:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:3917
+		{
+			yyLOCAL = &FuncExpr{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].colIdent, Exprs: yyDollar[5].selectExprsUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 767:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL *OverClause
+//line sql.y:3922
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 768:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *OverClause
+//line sql.y:3926
+		{
+			yyLOCAL = yyDollar[1].overClauseUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 769:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *OverClause
+//line sql.y:3932
+		{
+			yyLOCAL = &OverClause{WindowSpec: yyDollar[3].windowSpecUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 770:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *OverClause
+//line sql.y:3936
+		{
+			yyLOCAL = &OverClause{WindowName: yyDollar[2].colIdent}
+		}
+		yyVAL.union = yyLOCAL
+	case 771:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *WindowSpecification
+//line sql.y:3942
+		{
+			yyLOCAL = &WindowSpecification{Name: yyDollar[1].colIdent, PartitionClause: yyDollar[2].exprsUnion(), OrderClause: yyDollar[3].orderByUnion(), FrameClause: yyDollar[4].frameClauseUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 772:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3949
+		{
+			yyVAL.colIdent = ColIdent{}
+		}
+	case 773:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3953
+		{
+			yyVAL.colIdent = yyDollar[1].colIdent
+		}
+	case 774:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL Exprs
+//line sql.y:3958
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 775:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL Exprs
+//line sql.y:3962
+		{
+			yyLOCAL = yyDollar[3].exprsUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 776:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL *FrameClause
+//line sql.y:3967
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 777:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *FrameClause
+//line sql.y:3971
+		{
+			yyLOCAL = &FrameClause{Unit: FrameRowsType, Start: yyDollar[2].framePointUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 778:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *FrameClause
+//line sql.y:3975
+		{
+			yyLOCAL = &FrameClause{Unit: FrameRangeType, Start: yyDollar[2].framePointUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 779:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *FrameClause
+//line sql.y:3979
+		{
+			yyLOCAL = &FrameClause{Unit: FrameRowsType, Start: yyDollar[3].framePointUnion(), End: yyDollar[5].framePointUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 780:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *FrameClause
+//line sql.y:3983
+		{
+			yyLOCAL = &FrameClause{Unit: FrameRangeType, Start: yyDollar[3].framePointUnion(), End: yyDollar[5].framePointUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 781:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *FramePoint
+//line sql.y:3989
+		{
+			yyLOCAL = &FramePoint{Type: CurrentRowType}
+		}
+		yyVAL.union = yyLOCAL
+	case 782:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *FramePoint
+//line sql.y:3993
+		{
+			yyLOCAL = &FramePoint{Type: UnboundedPrecedingType}
+		}
+		yyVAL.union = yyLOCAL
+	case 783:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *FramePoint
+//line sql.y:3997
 		{
-			yyLOCAL = handleUnaryMinus(yyDollar[2].exprUnion())
+			yyLOCAL = &FramePoint{Type: UnboundedFollowingType}
 		}
 		yyVAL.union = yyLOCAL
-	case 744:
+	case 784:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:3800
+		var yyLOCAL *FramePoint
+//line sql.y:4001
 		{
-			yyLOCAL = &UnaryExpr{Operator: TildaOp, Expr: yyDollar[2].exprUnion()}
+			yyLOCAL = &FramePoint{Type: ExprPrecedingType, Expr: yyDollar[1].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 745:
+	case 785:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:3804
+		var yyLOCAL *FramePoint
+//line sql.y:4005
 		{
-			yyLOCAL = &UnaryExpr{Operator: BangOp, Expr: yyDollar[2].exprUnion()}
+			yyLOCAL = &FramePoint{Type: ExprFollowingType, Expr: yyDollar[1].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 746:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:3808
+	case 786:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL NamedWindows
+//line sql.y:4010
 		{
-			// This rule prevents the usage of INTERVAL
-			// as a function. If support is needed for that,
-			// we'll need to revisit this. The solution
-			// will be non-trivial because of grammar conflicts.
-			yyLOCAL = &IntervalExpr{Expr: yyDollar[2].exprUnion(), Unit: yyDollar[3].colIdent.String()}
+			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 751:
-		yyDollar = yyS[yypt-4 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:3826
+	case 787:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL NamedWindows
+//line sql.y:4014
 		{
-			yyLOCAL = &FuncExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprsUnion()}
+			yyLOCAL = yyDollar[2].namedWindowsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 752:
-		yyDollar = yyS[yypt-5 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:3830
+	case 788:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL NamedWindows
+//line sql.y:4020
 		{
-			yyLOCAL = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprsUnion()}
+			yyLOCAL = NamedWindows{yyDollar[1].namedWindowUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 753:
-		yyDollar = yyS[yypt-5 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:3834
+	case 789:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4024
 		{
-			yyLOCAL = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprsUnion()}
+			yySLICE := (*NamedWindows)(yyIaddr(yyVAL.union))
+			*yySLICE = append(*yySLICE, yyDollar[3].namedWindowUnion())
 		}
-		yyVAL.union = yyLOCAL
-	case 754:
-		yyDollar = yyS[yypt-6 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:3838
+	case 790:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *NamedWindow
+//line sql.y:4030
 		{
-			yyLOCAL = &FuncExpr{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].colIdent, Exprs: yyDollar[5].selectExprsUnion()}
+			yyLOCAL = &NamedWindow{Name: yyDollar[1].colIdent, WindowSpec: yyDollar[4].windowSpecUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 755:
+	case 791:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3848
+//line sql.y:4040
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("left"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 756:
+	case 792:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3852
+//line sql.y:4044
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("right"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 757:
+	case 793:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3856
+//line sql.y:4048
 		{
 			yyLOCAL = &ConvertExpr{Expr: yyDollar[3].exprUnion(), Type: yyDollar[5].convertTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 758:
+	case 794:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3860
+//line sql.y:4052
 		{
 			yyLOCAL = &ConvertExpr{Expr: yyDollar[3].exprUnion(), Type: yyDollar[5].convertTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 759:
+	case 795:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3864
+//line sql.y:4056
 		{
 			yyLOCAL = &ConvertUsingExpr{Expr: yyDollar[3].exprUnion(), Type: yyDollar[5].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 760:
+	case 796:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3868
+//line sql.y:4060
 		{
 			yyLOCAL = &SubstrExpr{Name: yyDollar[3].colNameUnion(), From: yyDollar[5].exprUnion(), To: yyDollar[7].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 761:
+	case 797:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3872
+//line sql.y:4064
 		{
 			yyLOCAL = &SubstrExpr{Name: yyDollar[3].colNameUnion(), From: yyDollar[5].exprUnion(), To: yyDollar[7].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 762:
+	case 798:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3876
+//line sql.y:4068
 		{
 			yyLOCAL = &SubstrExpr{StrVal: NewStrLiteral(yyDollar[3].str), From: yyDollar[5].exprUnion(), To: yyDollar[7].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 763:
+	case 799:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3880
+//line sql.y:4072
 		{
 			yyLOCAL = &SubstrExpr{StrVal: NewStrLiteral(yyDollar[3].str), From: yyDollar[5].exprUnion(), To: yyDollar[7].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 764:
+	case 800:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3884
+//line sql.y:4076
 		{
 			yyLOCAL = &MatchExpr{Columns: yyDollar[3].selectExprsUnion(), Expr: yyDollar[7].exprUnion(), Option: yyDollar[8].matchExprOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 765:
+	case 801:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3888
+//line sql.y:4080
 		{
 			yyLOCAL = &GroupConcatExpr{Distinct: yyDollar[3].booleanUnion(), Exprs: yyDollar[4].selectExprsUnion(), OrderBy: yyDollar[5].orderByUnion(), Separator: yyDollar[6].str, Limit: yyDollar[7].limitUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 766:
+	case 802:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3892
+//line sql.y:4084
 		{
 			yyLOCAL = &CaseExpr{Expr: yyDollar[2].exprUnion(), Whens: yyDollar[3].whensUnion(), Else: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 767:
+	case 803:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3896
+//line sql.y:4088
 		{
 			yyLOCAL = &ValuesFuncExpr{Name: yyDollar[3].colNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 768:
+	case 804:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3900
+//line sql.y:4092
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 769:
+	case 805:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3910
+//line sql.y:4102
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("current_timestamp")}
 		}
 		yyVAL.union = yyLOCAL
-	case 770:
+	case 806:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3914
+//line sql.y:4106
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("utc_timestamp")}
 		}
 		yyVAL.union = yyLOCAL
-	case 771:
+	case 807:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3918
+//line sql.y:4110
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("utc_time")}
 		}
 		yyVAL.union = yyLOCAL
-	case 772:
+	case 808:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3923
+//line sql.y:4115
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("utc_date")}
 		}
 		yyVAL.union = yyLOCAL
-	case 773:
+	case 809:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3928
+//line sql.y:4120
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("localtime")}
 		}
 		yyVAL.union = yyLOCAL
-	case 774:
+	case 810:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3933
+//line sql.y:4125
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("localtimestamp")}
 		}
 		yyVAL.union = yyLOCAL
-	case 775:
+	case 811:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3939
+//line sql.y:4131
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("current_date")}
 		}
 		yyVAL.union = yyLOCAL
-	case 776:
+	case 812:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3944
+//line sql.y:4136
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("current_time")}
 		}
 		yyVAL.union = yyLOCAL
-	case 777:
+	case 813:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3949
+//line sql.y:4141
 		{
 			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("current_timestamp"), Fsp: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 778:
+	case 814:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3953
+//line sql.y:4145
 		{
 			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("utc_timestamp"), Fsp: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 779:
+	case 815:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3957
+//line sql.y:4149
 		{
 			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("utc_time"), Fsp: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 780:
+	case 816:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3962
+//line sql.y:4154
 		{
 			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("localtime"), Fsp: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 781:
+	case 817:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3967
+//line sql.y:4159
 		{
 			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("localtimestamp"), Fsp: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 782:
+	case 818:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3972
+//line sql.y:4164
 		{
 			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("current_time"), Fsp: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 783:
+	case 819:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3976
+//line sql.y:4168
 		{
 			yyLOCAL = &TimestampFuncExpr{Name: string("timestampadd"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].exprUnion(), Expr2: yyDollar[7].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 784:
+	case 820:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3980
+//line sql.y:4172
 		{
 			yyLOCAL = &TimestampFuncExpr{Name: string("timestampdiff"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].exprUnion(), Expr2: yyDollar[7].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 787:
+	case 823:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3990
+//line sql.y:4182
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 788:
+	case 824:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4000
+//line sql.y:4192
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("if"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 789:
+	case 825:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4004
+//line sql.y:4196
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("database"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 790:
+	case 826:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4008
+//line sql.y:4200
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("schema"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 791:
+	case 827:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4012
+//line sql.y:4204
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("mod"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 792:
+	case 828:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4016
+//line sql.y:4208
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("replace"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 793:
+	case 829:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4020
+//line sql.y:4212
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("substr"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 794:
+	case 830:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4024
+//line sql.y:4216
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("substr"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 795:
+	case 831:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL MatchExprOption
-//line sql.y:4030
+//line sql.y:4222
 		{
 			yyLOCAL = NoOption
 		}
 		yyVAL.union = yyLOCAL
-	case 796:
+	case 832:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL MatchExprOption
-//line sql.y:4034
+//line sql.y:4226
 		{
 			yyLOCAL = BooleanModeOpt
 		}
 		yyVAL.union = yyLOCAL
-	case 797:
+	case 833:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL MatchExprOption
-//line sql.y:4038
+//line sql.y:4230
 		{
 			yyLOCAL = NaturalLanguageModeOpt
 		}
 		yyVAL.union = yyLOCAL
-	case 798:
+	case 834:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL MatchExprOption
-//line sql.y:4042
+//line sql.y:4234
 		{
 			yyLOCAL = NaturalLanguageModeWithQueryExpansionOpt
 		}
 		yyVAL.union = yyLOCAL
-	case 799:
+	case 835:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL MatchExprOption
-//line sql.y:4046
+//line sql.y:4238
 		{
 			yyLOCAL = QueryExpansionOpt
 		}
 		yyVAL.union = yyLOCAL
-	case 800:
+	case 836:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4052
+//line sql.y:4244
 		{
 			yyVAL.str = string(yyDollar[1].colIdent.String())
 		}
-	case 801:
+	case 837:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4056
+//line sql.y:4248
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 802:
+	case 838:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4060
+//line sql.y:4252
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 803:
+	case 839:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4066
+//line sql.y:4258
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 804:
+	case 840:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4070
+//line sql.y:4262
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion(), Charset: yyDollar[3].str, Operator: CharacterSetOp}
 		}
 		yyVAL.union = yyLOCAL
-	case 805:
+	case 841:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4074
+//line sql.y:4266
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion(), Charset: string(yyDollar[3].colIdent.String())}
 		}
 		yyVAL.union = yyLOCAL
-	case 806:
+	case 842:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4078
+//line sql.y:4270
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 807:
+	case 843:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4082
+//line sql.y:4274
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 808:
+	case 844:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4086
+//line sql.y:4278
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 			yyLOCAL.Length = yyDollar[2].LengthScaleOption.Length
 			yyLOCAL.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
 		yyVAL.union = yyLOCAL
-	case 809:
+	case 845:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4092
+//line sql.y:4284
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 810:
+	case 846:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4096
+//line sql.y:4288
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 811:
+	case 847:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4100
+//line sql.y:4292
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 812:
+	case 848:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4104
+//line sql.y:4296
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 813:
+	case 849:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4108
+//line sql.y:4300
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 814:
+	case 850:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4112
+//line sql.y:4304
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 815:
+	case 851:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4116
+//line sql.y:4308
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 816:
+	case 852:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4121
+//line sql.y:4313
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 817:
+	case 853:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4125
+//line sql.y:4317
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 818:
+	case 854:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4130
+//line sql.y:4322
 		{
 			yyVAL.str = string("")
 		}
-	case 819:
+	case 855:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4134
+//line sql.y:4326
 		{
 			yyVAL.str = " separator " + encodeSQLString(yyDollar[2].str)
 		}
-	case 820:
+	case 856:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*When
-//line sql.y:4140
+//line sql.y:4332
 		{
 			yyLOCAL = []*When{yyDollar[1].whenUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 821:
+	case 857:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4144
+//line sql.y:4336
 		{
 			yySLICE := (*[]*When)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[2].whenUnion())
 		}
-	case 822:
+	case 858:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *When
-//line sql.y:4150
+//line sql.y:4342
 		{
 			yyLOCAL = &When{Cond: yyDollar[2].exprUnion(), Val: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 823:
+	case 859:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4155
+//line sql.y:4347
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 824:
+	case 860:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4159
+//line sql.y:4351
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 825:
+	case 861:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ColName
-//line sql.y:4165
+//line sql.y:4357
 		{
-			yyLOCAL = &ColName{Name: yyDollar[1].colIdent}
+			yyLOCAL = &ColName{Name: yyDollar[1].colIdent, Pos: yyDollar[1].pos}
 		}
 		yyVAL.union = yyLOCAL
-	case 826:
+	case 862:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ColName
-//line sql.y:4169
+//line sql.y:4361
 		{
-			yyLOCAL = &ColName{Qualifier: TableName{Name: yyDollar[1].tableIdent}, Name: yyDollar[3].colIdent}
+			yyLOCAL = &ColName{Qualifier: TableName{Name: yyDollar[1].tableIdent}, Name: yyDollar[3].colIdent, Pos: yyDollar[1].pos}
 		}
 		yyVAL.union = yyLOCAL
-	case 827:
+	case 863:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *ColName
-//line sql.y:4173
+//line sql.y:4365
 		{
-			yyLOCAL = &ColName{Qualifier: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}, Name: yyDollar[5].colIdent}
+			yyLOCAL = &ColName{Qualifier: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}, Name: yyDollar[5].colIdent, Pos: yyDollar[1].pos}
 		}
 		yyVAL.union = yyLOCAL
-	case 828:
+	case 864:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4179
+//line sql.y:4371
 		{
 			yyLOCAL = NewStrLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 829:
+	case 865:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4183
+//line sql.y:4375
 		{
 			yyLOCAL = NewHexLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 830:
+	case 866:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4187
+//line sql.y:4379
 		{
 			yyLOCAL = NewBitLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 831:
+	case 867:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4191
+//line sql.y:4383
 		{
 			yyLOCAL = NewIntLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 832:
+	case 868:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4195
+//line sql.y:4387
 		{
 			yyLOCAL = NewFloatLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 833:
+	case 869:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4199
+//line sql.y:4391
 		{
 			yyLOCAL = NewHexNumLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 834:
+	case 870:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4203
+//line sql.y:4395
 		{
 			yyLOCAL = NewArgument(yyDollar[1].str[1:])
 			bindVariable(yylex, yyDollar[1].str[1:])
 		}
 		yyVAL.union = yyLOCAL
-	case 835:
+	case 871:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4208
+//line sql.y:4400
 		{
 			yyLOCAL = &NullVal{}
 		}
 		yyVAL.union = yyLOCAL
-	case 836:
+	case 872:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4214
+//line sql.y:4406
 		{
 			// TODO(sougou): Deprecate this construct.
 			if yyDollar[1].colIdent.Lowered() != "value" {
@@ -12000,707 +12603,707 @@ yydefault:
 			yyLOCAL = NewIntLiteral("1")
 		}
 		yyVAL.union = yyLOCAL
-	case 837:
+	case 873:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4223
+//line sql.y:4415
 		{
 			yyLOCAL = NewIntLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 838:
+	case 874:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4227
+//line sql.y:4419
 		{
 			yyLOCAL = NewArgument(yyDollar[1].str[1:])
 			bindVariable(yylex, yyDollar[1].str[1:])
 		}
 		yyVAL.union = yyLOCAL
-	case 839:
+	case 875:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Exprs
-//line sql.y:4233
+//line sql.y:4425
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 840:
+	case 876:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Exprs
-//line sql.y:4237
+//line sql.y:4429
 		{
 			yyLOCAL = yyDollar[3].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 841:
+	case 877:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4242
+//line sql.y:4434
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 842:
+	case 878:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4246
+//line sql.y:4438
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 843:
+	case 879:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL OrderBy
-//line sql.y:4251
+//line sql.y:4443
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 844:
+	case 880:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL OrderBy
-//line sql.y:4255
+//line sql.y:4447
 		{
 			yyLOCAL = yyDollar[3].orderByUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 845:
+	case 881:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL OrderBy
-//line sql.y:4261
+//line sql.y:4453
 		{
 			yyLOCAL = OrderBy{yyDollar[1].orderUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 846:
+	case 882:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4265
+//line sql.y:4457
 		{
 			yySLICE := (*OrderBy)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].orderUnion())
 		}
-	case 847:
+	case 883:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *Order
-//line sql.y:4271
+//line sql.y:4463
 		{
 			yyLOCAL = &Order{Expr: yyDollar[1].exprUnion(), Direction: yyDollar[2].orderDirectionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 848:
+	case 884:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL OrderDirection
-//line sql.y:4276
+//line sql.y:4468
 		{
 			yyLOCAL = AscOrder
 		}
 		yyVAL.union = yyLOCAL
-	case 849:
+	case 885:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL OrderDirection
-//line sql.y:4280
+//line sql.y:4472
 		{
 			yyLOCAL = AscOrder
 		}
 		yyVAL.union = yyLOCAL
-	case 850:
+	case 886:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL OrderDirection
-//line sql.y:4284
+//line sql.y:4476
 		{
 			yyLOCAL = DescOrder
 		}
 		yyVAL.union = yyLOCAL
-	case 851:
+	case 887:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *Limit
-//line sql.y:4289
+//line sql.y:4481
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 852:
+	case 888:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *Limit
-//line sql.y:4293
+//line sql.y:4485
 		{
 			yyLOCAL = &Limit{Rowcount: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 853:
+	case 889:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *Limit
-//line sql.y:4297
+//line sql.y:4489
 		{
 			yyLOCAL = &Limit{Offset: yyDollar[2].exprUnion(), Rowcount: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 854:
+	case 890:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *Limit
-//line sql.y:4301
+//line sql.y:4493
 		{
 			yyLOCAL = &Limit{Offset: yyDollar[4].exprUnion(), Rowcount: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 855:
+	case 891:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:4306
+//line sql.y:4498
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 856:
+	case 892:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:4310
+//line sql.y:4502
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion(), yyDollar[2].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 857:
+	case 893:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:4314
+//line sql.y:4506
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion(), yyDollar[2].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 858:
+	case 894:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:4318
+//line sql.y:4510
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 859:
+	case 895:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:4322
+//line sql.y:4514
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 860:
+	case 896:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:4329
+//line sql.y:4521
 		{
 			yyLOCAL = &LockOption{Type: DefaultType}
 		}
 		yyVAL.union = yyLOCAL
-	case 861:
+	case 897:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:4333
+//line sql.y:4525
 		{
 			yyLOCAL = &LockOption{Type: NoneType}
 		}
 		yyVAL.union = yyLOCAL
-	case 862:
+	case 898:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:4337
+//line sql.y:4529
 		{
 			yyLOCAL = &LockOption{Type: SharedType}
 		}
 		yyVAL.union = yyLOCAL
-	case 863:
+	case 899:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:4341
+//line sql.y:4533
 		{
 			yyLOCAL = &LockOption{Type: ExclusiveType}
 		}
 		yyVAL.union = yyLOCAL
-	case 864:
+	case 900:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:4347
+//line sql.y:4539
 		{
 			yyLOCAL = AlgorithmValue(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 865:
+	case 901:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:4351
+//line sql.y:4543
 		{
 			yyLOCAL = AlgorithmValue(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 866:
+	case 902:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:4355
+//line sql.y:4547
 		{
 			yyLOCAL = AlgorithmValue(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 867:
+	case 903:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4360
+//line sql.y:4552
 		{
 			yyVAL.str = ""
 		}
-	case 868:
+	case 904:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4364
+//line sql.y:4556
 		{
 			yyVAL.str = string(yyDollar[3].str)
 		}
-	case 869:
+	case 905:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4368
+//line sql.y:4560
 		{
 			yyVAL.str = string(yyDollar[3].str)
 		}
-	case 870:
+	case 906:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4372
+//line sql.y:4564
 		{
 			yyVAL.str = string(yyDollar[3].str)
 		}
-	case 871:
+	case 907:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4377
+//line sql.y:4569
 		{
 			yyVAL.str = ""
 		}
-	case 872:
+	case 908:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4381
+//line sql.y:4573
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 873:
+	case 909:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4387
+//line sql.y:4579
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 874:
+	case 910:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4391
+//line sql.y:4583
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 875:
+	case 911:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4396
+//line sql.y:4588
 		{
 			yyVAL.str = ""
 		}
-	case 876:
+	case 912:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:4400
+//line sql.y:4592
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 877:
+	case 913:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4405
+//line sql.y:4597
 		{
 			yyVAL.str = "cascaded"
 		}
-	case 878:
+	case 914:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4409
+//line sql.y:4601
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 879:
+	case 915:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4413
+//line sql.y:4605
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 880:
+	case 916:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4418
+//line sql.y:4610
 		{
 			yyVAL.str = ""
 		}
-	case 881:
+	case 917:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4422
+//line sql.y:4614
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 882:
+	case 918:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4428
+//line sql.y:4620
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 883:
+	case 919:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4432
+//line sql.y:4624
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 884:
+	case 920:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4436
+//line sql.y:4628
 		{
 			yyVAL.str = encodeSQLString(yyDollar[1].str) + "@" + string(yyDollar[2].str)
 		}
-	case 885:
+	case 921:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4440
+//line sql.y:4632
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 886:
+	case 922:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Lock
-//line sql.y:4445
+//line sql.y:4637
 		{
 			yyLOCAL = NoLock
 		}
 		yyVAL.union = yyLOCAL
-	case 887:
+	case 923:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Lock
-//line sql.y:4449
+//line sql.y:4641
 		{
 			yyLOCAL = ForUpdateLock
 		}
 		yyVAL.union = yyLOCAL
-	case 888:
+	case 924:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Lock
-//line sql.y:4453
+//line sql.y:4645
 		{
 			yyLOCAL = ShareModeLock
 		}
 		yyVAL.union = yyLOCAL
-	case 889:
+	case 925:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *SelectInto
-//line sql.y:4458
+//line sql.y:4650
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 890:
+	case 926:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL *SelectInto
-//line sql.y:4462
+//line sql.y:4654
 		{
 			yyLOCAL = &SelectInto{Type: IntoOutfileS3, FileName: encodeSQLString(yyDollar[4].str), Charset: yyDollar[5].str, FormatOption: yyDollar[6].str, ExportOption: yyDollar[7].str, Manifest: yyDollar[8].str, Overwrite: yyDollar[9].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 891:
+	case 927:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *SelectInto
-//line sql.y:4466
+//line sql.y:4658
 		{
 			yyLOCAL = &SelectInto{Type: IntoDumpfile, FileName: encodeSQLString(yyDollar[3].str), Charset: "", FormatOption: "", ExportOption: "", Manifest: "", Overwrite: ""}
 		}
 		yyVAL.union = yyLOCAL
-	case 892:
+	case 928:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *SelectInto
-//line sql.y:4470
+//line sql.y:4662
 		{
 			yyLOCAL = &SelectInto{Type: IntoOutfile, FileName: encodeSQLString(yyDollar[3].str), Charset: yyDollar[4].str, FormatOption: "", ExportOption: yyDollar[5].str, Manifest: "", Overwrite: ""}
 		}
 		yyVAL.union = yyLOCAL
-	case 893:
+	case 929:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4475
+//line sql.y:4667
 		{
 			yyVAL.str = ""
 		}
-	case 894:
+	case 930:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4479
+//line sql.y:4671
 		{
 			yyVAL.str = " format csv" + yyDollar[3].str
 		}
-	case 895:
+	case 931:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4483
+//line sql.y:4675
 		{
 			yyVAL.str = " format text" + yyDollar[3].str
 		}
-	case 896:
+	case 932:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4488
+//line sql.y:4680
 		{
 			yyVAL.str = ""
 		}
-	case 897:
+	case 933:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4492
+//line sql.y:4684
 		{
 			yyVAL.str = " header"
 		}
-	case 898:
+	case 934:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4497
+//line sql.y:4689
 		{
 			yyVAL.str = ""
 		}
-	case 899:
+	case 935:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4501
+//line sql.y:4693
 		{
 			yyVAL.str = " manifest on"
 		}
-	case 900:
+	case 936:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4505
+//line sql.y:4697
 		{
 			yyVAL.str = " manifest off"
 		}
-	case 901:
+	case 937:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4510
+//line sql.y:4702
 		{
 			yyVAL.str = ""
 		}
-	case 902:
+	case 938:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4514
+//line sql.y:4706
 		{
 			yyVAL.str = " overwrite on"
 		}
-	case 903:
+	case 939:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4518
+//line sql.y:4710
 		{
 			yyVAL.str = " overwrite off"
 		}
-	case 904:
+	case 940:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4524
+//line sql.y:4716
 		{
 			yyVAL.str = yyDollar[1].str + yyDollar[2].str
 		}
-	case 905:
+	case 941:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4529
+//line sql.y:4721
 		{
 			yyVAL.str = ""
 		}
-	case 906:
+	case 942:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4533
+//line sql.y:4725
 		{
 			yyVAL.str = " lines" + yyDollar[2].str
 		}
-	case 907:
+	case 943:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4539
+//line sql.y:4731
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 908:
+	case 944:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4543
+//line sql.y:4735
 		{
 			yyVAL.str = yyDollar[1].str + yyDollar[2].str
 		}
-	case 909:
+	case 945:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4549
+//line sql.y:4741
 		{
 			yyVAL.str = " starting by " + encodeSQLString(yyDollar[3].str)
 		}
-	case 910:
+	case 946:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4553
+//line sql.y:4745
 		{
 			yyVAL.str = " terminated by " + encodeSQLString(yyDollar[3].str)
 		}
-	case 911:
+	case 947:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4558
+//line sql.y:4750
 		{
 			yyVAL.str = ""
 		}
-	case 912:
+	case 948:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4562
+//line sql.y:4754
 		{
 			yyVAL.str = " " + yyDollar[1].str + yyDollar[2].str
 		}
-	case 913:
+	case 949:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4568
+//line sql.y:4760
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 914:
+	case 950:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4572
+//line sql.y:4764
 		{
 			yyVAL.str = yyDollar[1].str + yyDollar[2].str
 		}
-	case 915:
+	case 951:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4578
+//line sql.y:4770
 		{
 			yyVAL.str = " terminated by " + encodeSQLString(yyDollar[3].str)
 		}
-	case 916:
+	case 952:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:4582
+//line sql.y:4774
 		{
 			yyVAL.str = yyDollar[1].str + " enclosed by " + encodeSQLString(yyDollar[4].str)
 		}
-	case 917:
+	case 953:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4586
+//line sql.y:4778
 		{
 			yyVAL.str = " escaped by " + encodeSQLString(yyDollar[3].str)
 		}
-	case 918:
+	case 954:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4591
+//line sql.y:4783
 		{
 			yyVAL.str = ""
 		}
-	case 919:
+	case 955:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4595
+//line sql.y:4787
 		{
 			yyVAL.str = " optionally"
 		}
-	case 920:
+	case 956:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *Insert
-//line sql.y:4608
+//line sql.y:4800
 		{
 			yyLOCAL = &Insert{Rows: yyDollar[2].valuesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 921:
+	case 957:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *Insert
-//line sql.y:4612
+//line sql.y:4804
 		{
 			yyLOCAL = &Insert{Rows: yyDollar[1].selStmtUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 922:
+	case 958:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *Insert
-//line sql.y:4616
+//line sql.y:4808
 		{
 			yyLOCAL = &Insert{Columns: yyDollar[2].columnsUnion(), Rows: yyDollar[5].valuesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 923:
+	case 959:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *Insert
-//line sql.y:4620
+//line sql.y:4812
 		{
 			yyLOCAL = &Insert{Rows: yyDollar[4].valuesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 924:
+	case 960:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *Insert
-//line sql.y:4624
+//line sql.y:4816
 		{
 			yyLOCAL = &Insert{Columns: yyDollar[2].columnsUnion(), Rows: yyDollar[4].selStmtUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 925:
+	case 961:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:4630
+//line sql.y:4822
 		{
 			yyLOCAL = Columns{yyDollar[1].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 926:
+	case 962:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:4634
+//line sql.y:4826
 		{
 			yyLOCAL = Columns{yyDollar[3].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 927:
+	case 963:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4638
+//line sql.y:4830
 		{
 			yySLICE := (*Columns)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].colIdent)
 		}
-	case 928:
+	case 964:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:4642
+//line sql.y:4834
 		{
 			yySLICE := (*Columns)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[5].colIdent)
 		}
-	case 929:
+	case 965:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL UpdateExprs
-//line sql.y:4647
+//line sql.y:4839
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 930:
+	case 966:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL UpdateExprs
-//line sql.y:4651
+//line sql.y:4843
 		{
 			yyLOCAL = yyDollar[5].updateExprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 931:
+	case 967:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Values
-//line sql.y:4657
+//line sql.y:4849
 		{
 			yyLOCAL = Values{yyDollar[1].valTupleUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 932:
+	case 968:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4661
+//line sql.y:4853
 		{
 			yySLICE := (*Values)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].valTupleUnion())
 		}
-	case 933:
+	case 969:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ValTuple
-//line sql.y:4667
+//line sql.y:4859
 		{
 			yyLOCAL = yyDollar[1].valTupleUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 934:
+	case 970:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL ValTuple
-//line sql.y:4671
+//line sql.y:4863
 		{
 			yyLOCAL = ValTuple{}
 		}
 		yyVAL.union = yyLOCAL
-	case 935:
+	case 971:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ValTuple
-//line sql.y:4677
+//line sql.y:4869
 		{
 			yyLOCAL = ValTuple(yyDollar[2].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 936:
+	case 972:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4683
+//line sql.y:4875
 		{
 			if len(yyDollar[1].valTupleUnion()) == 1 {
 				yyLOCAL = yyDollar[1].valTupleUnion()[0]
@@ -12709,329 +13312,329 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 937:
+	case 973:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL UpdateExprs
-//line sql.y:4693
+//line sql.y:4885
 		{
 			yyLOCAL = UpdateExprs{yyDollar[1].updateExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 938:
+	case 974:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4697
+//line sql.y:4889
 		{
 			yySLICE := (*UpdateExprs)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].updateExprUnion())
 		}
-	case 939:
+	case 975:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *UpdateExpr
-//line sql.y:4703
+//line sql.y:4895
 		{
 			yyLOCAL = &UpdateExpr{Name: yyDollar[1].colNameUnion(), Expr: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 940:
+	case 976:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL SetExprs
-//line sql.y:4709
+//line sql.y:4901
 		{
 			yyLOCAL = SetExprs{yyDollar[1].setExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 941:
+	case 977:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4713
+//line sql.y:4905
 		{
 			yySLICE := (*SetExprs)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].setExprUnion())
 		}
-	case 942:
+	case 978:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *SetExpr
-//line sql.y:4719
+//line sql.y:4911
 		{
 			yyLOCAL = &SetExpr{Name: yyDollar[1].colIdent, Scope: ImplicitScope, Expr: NewStrLiteral("on")}
 		}
 		yyVAL.union = yyLOCAL
-	case 943:
+	case 979:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *SetExpr
-//line sql.y:4723
+//line sql.y:4915
 		{
 			yyLOCAL = &SetExpr{Name: yyDollar[1].colIdent, Scope: ImplicitScope, Expr: NewStrLiteral("off")}
 		}
 		yyVAL.union = yyLOCAL
-	case 944:
+	case 980:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *SetExpr
-//line sql.y:4727
+//line sql.y:4919
 		{
 			yyLOCAL = &SetExpr{Name: yyDollar[1].colIdent, Scope: ImplicitScope, Expr: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 945:
+	case 981:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *SetExpr
-//line sql.y:4731
+//line sql.y:4923
 		{
 			yyLOCAL = &SetExpr{Name: NewColIdent(string(yyDollar[1].str)), Scope: ImplicitScope, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 946:
+	case 982:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *SetExpr
-//line sql.y:4735
+//line sql.y:4927
 		{
 			yyDollar[2].setExprUnion().Scope = yyDollar[1].scopeUnion()
 			yyLOCAL = yyDollar[2].setExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 948:
+	case 984:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4743
+//line sql.y:4935
 		{
 			yyVAL.str = "charset"
 		}
-	case 951:
+	case 987:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4753
+//line sql.y:4945
 		{
 			yyLOCAL = NewStrLiteral(yyDollar[1].colIdent.String())
 		}
 		yyVAL.union = yyLOCAL
-	case 952:
+	case 988:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4757
+//line sql.y:4949
 		{
 			yyLOCAL = NewStrLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 953:
+	case 989:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4761
+//line sql.y:4953
 		{
 			yyLOCAL = &Default{}
 		}
 		yyVAL.union = yyLOCAL
-	case 956:
+	case 992:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:4770
+//line sql.y:4962
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 957:
+	case 993:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:4772
+//line sql.y:4964
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 958:
+	case 994:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:4775
+//line sql.y:4967
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 959:
+	case 995:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:4777
+//line sql.y:4969
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 960:
+	case 996:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:4780
+//line sql.y:4972
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 961:
+	case 997:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:4782
+//line sql.y:4974
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 962:
+	case 998:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Ignore
-//line sql.y:4785
+//line sql.y:4977
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 963:
+	case 999:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Ignore
-//line sql.y:4787
+//line sql.y:4979
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 964:
+	case 1000:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4790
+//line sql.y:4982
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 965:
+	case 1001:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4792
+//line sql.y:4984
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 966:
+	case 1002:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4794
+//line sql.y:4986
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 967:
+	case 1003:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:4798
+//line sql.y:4990
 		{
 			yyLOCAL = &CallProc{Name: yyDollar[2].tableName, Params: yyDollar[4].exprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 968:
+	case 1004:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Exprs
-//line sql.y:4803
+//line sql.y:4995
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 969:
+	case 1005:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Exprs
-//line sql.y:4807
+//line sql.y:4999
 		{
 			yyLOCAL = yyDollar[1].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 970:
+	case 1006:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*IndexOption
-//line sql.y:4812
+//line sql.y:5004
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 971:
+	case 1007:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*IndexOption
-//line sql.y:4814
+//line sql.y:5006
 		{
 			yyLOCAL = []*IndexOption{yyDollar[1].indexOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 972:
+	case 1008:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *IndexOption
-//line sql.y:4818
+//line sql.y:5010
 		{
 			yyLOCAL = &IndexOption{Name: string(yyDollar[1].str), String: string(yyDollar[2].colIdent.String())}
 		}
 		yyVAL.union = yyLOCAL
-	case 973:
+	case 1009:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4824
+//line sql.y:5016
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 974:
+	case 1010:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4828
+//line sql.y:5020
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].str))
 		}
-	case 976:
+	case 1012:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4835
+//line sql.y:5027
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].str))
 		}
-	case 977:
+	case 1013:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4841
+//line sql.y:5033
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].colIdent.String()))
 		}
-	case 978:
+	case 1014:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4845
+//line sql.y:5037
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].str))
 		}
-	case 979:
+	case 1015:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4851
+//line sql.y:5043
 		{
 			yyVAL.tableIdent = NewTableIdent("")
 		}
-	case 980:
+	case 1016:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4855
+//line sql.y:5047
 		{
 			yyVAL.tableIdent = yyDollar[1].tableIdent
 		}
-	case 982:
+	case 1018:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4862
+//line sql.y:5054
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].str))
 		}
-	case 1396:
+	case 1441:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5300
+//line sql.y:5501
 		{
 			if incNesting(yylex) {
 				yylex.Error("max nesting level reached")
 				return 1
 			}
 		}
-	case 1397:
+	case 1442:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5309
+//line sql.y:5510
 		{
 			decNesting(yylex)
 		}
-	case 1398:
+	case 1443:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5314
+//line sql.y:5515
 		{
 			skipToEnd(yylex)
 		}
-	case 1399:
+	case 1444:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5319
+//line sql.y:5520
 		{
 			skipToEnd(yylex)
 		}
-	case 1400:
+	case 1445:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5323
+//line sql.y:5524
 		{
 			skipToEnd(yylex)
 		}
-	case 1401:
+	case 1446:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5327
+//line sql.y:5528
 		{
 			skipToEnd(yylex)
 		}
@@ -56,6 +56,9 @@ func bindVariable(yylex yyLexer, bvar string) {
 
 %struct {
   empty         struct{}
+  // pos is the position of the first token of a symbol, counting from 1.
+  // It is set by the lexer and carried to the symbol by the default action.
+  pos           int
   LengthScaleOption LengthScaleOption
   tableName     TableName
   tableIdent    TableIdent
@@ -157,13 +160,21 @@ func bindVariable(yylex yyLexer, bvar string) {
 
   columnStorage ColumnStorage
 
+  unionSelect   *UnionSelect
+  overClause    *OverClause
+  windowSpec    *WindowSpecification
+  frameClause   *FrameClause
+  framePoint    *FramePoint
+  namedWindow   *NamedWindow
+  namedWindows  NamedWindows
+
   boolean bool
   boolVal BoolVal
   ignore Ignore
 }
 
 %token LEX_ERROR
-%left <str> UNION
+%left <str> UNION INTERSECT EXCEPT
 %token <str> SELECT STREAM VSTREAM INSERT UPDATE DELETE FROM WHERE GROUP HAVING ORDER BY LIMIT OFFSET FOR
 %token <str> ALL DISTINCT AS EXISTS ASC DESC INTO DUPLICATE DEFAULT SET LOCK UNLOCK KEYS DO CALL
 %token <str> DISTINCTROW PARSER GENERATED ALWAYS
@@ -187,6 +198,11 @@ func bindVariable(yylex yyLexer, bvar string) {
 // * NOTE: If you change anything here, update precedence.go as well *
 %nonassoc <str> LOWER_THAN_CHARSET
 %nonassoc <str> CHARSET
+// UNBOUNDED is a non-reserved keyword, so UNBOUNDED PRECEDING in a window frame
+// is also a column named unbounded followed by PRECEDING. This resolves it as
+// the frame bound, like MySQL does.
+%nonassoc <str> UNBOUNDED
+%nonassoc <str> PRECEDING FOLLOWING
 // Resolve column attribute ambiguity.
 %right <str> UNIQUE KEY
 %left <str> OR
@@ -243,8 +259,8 @@ func bindVariable(yylex yyLexer, bvar string) {
 
 // SHOW tokens
 %token <str> CODE COLLATION COLUMNS DATABASES ENGINES EVENT EXTENDED FIELDS FULL FUNCTION GTID_EXECUTED
-%token <str> KEYSPACES OPEN PLUGINS PRIVILEGES PROCESSLIST SCHEMAS TABLES TRIGGERS USER
-%token <str> VGTID_EXECUTED VITESS_KEYSPACES VITESS_METADATA VITESS_MIGRATIONS VITESS_SHARDS VITESS_TABLETS VSCHEMA
+%token <str> KEYSPACES OPEN PLAN PLUGINS PRIVILEGES PROCESSLIST SCHEMAS TABLES TRIGGERS USER
+%token <str> VEXPLAIN VGTID_EXECUTED VITESS_DDL_STATUS VITESS_KEYSPACES VITESS_METADATA VITESS_MIGRATIONS VITESS_SHARDS VITESS_TABLETS VSCHEMA
 
 // SET tokens
 %token <str> NAMES GLOBAL SESSION ISOLATION LEVEL READ WRITE ONLY REPEATABLE COMMITTED UNCOMMITTED SERIALIZABLE
@@ -263,13 +279,16 @@ func bindVariable(yylex yyLexer, bvar string) {
 %token <str> MATCH AGAINST BOOLEAN LANGUAGE WITH QUERY EXPANSION WITHOUT VALIDATION
 
 // MySQL reserved words that are unused by this grammar will map to this token.
-%token <str> UNUSED ARRAY CUME_DIST DESCRIPTION DENSE_RANK EMPTY EXCEPT FIRST_VALUE GROUPING GROUPS JSON_TABLE LAG LAST_VALUE LATERAL LEAD MEMBER
-%token <str> NTH_VALUE NTILE OF OVER PERCENT_RANK RANK RECURSIVE ROW_NUMBER SYSTEM WINDOW
-%token <str> ACTIVE ADMIN BUCKETS CLONE COMPONENT DEFINITION ENFORCED EXCLUDE FOLLOWING GEOMCOLLECTION GET_MASTER_PUBLIC_KEY HISTOGRAM HISTORY
+%token <str> UNUSED ARRAY CUME_DIST DESCRIPTION DENSE_RANK EMPTY FIRST_VALUE GROUPING GROUPS JSON_TABLE LAG LAST_VALUE LATERAL LEAD MEMBER
+%token <str> NTH_VALUE NTILE OF PERCENT_RANK RANK RECURSIVE ROW_NUMBER SYSTEM
+%token <str> ACTIVE ADMIN BUCKETS CLONE COMPONENT DEFINITION ENFORCED EXCLUDE GEOMCOLLECTION GET_MASTER_PUBLIC_KEY HISTOGRAM HISTORY
 %token <str> INACTIVE INVISIBLE LOCKED MASTER_COMPRESSION_ALGORITHMS MASTER_PUBLIC_KEY_PATH MASTER_TLS_CIPHERSUITES MASTER_ZSTD_COMPRESSION_LEVEL
-%token <str> NESTED NETWORK_NAMESPACE NOWAIT NULLS OJ OLD OPTIONAL ORDINALITY ORGANIZATION OTHERS PATH PERSIST PERSIST_ONLY PRECEDING PRIVILEGE_CHECKS_USER PROCESS
+%token <str> NESTED NETWORK_NAMESPACE NOWAIT NULLS OJ OLD OPTIONAL ORDINALITY ORGANIZATION OTHERS PATH PERSIST PERSIST_ONLY PRIVILEGE_CHECKS_USER PROCESS
 %token <str> RANDOM REFERENCE REQUIRE_ROW_FORMAT RESOURCE RESPECT RESTART RETAIN REUSE ROLE SECONDARY SECONDARY_ENGINE SECONDARY_LOAD SECONDARY_UNLOAD SKIP SRID
-%token <str> THREAD_PRIORITY TIES UNBOUNDED VCPU VISIBLE
+%token <str> THREAD_PRIORITY TIES VCPU VISIBLE
+
+// Window function tokens
+%token <str> OVER WINDOW ROWS RANGE ROW CURRENT
 
 // Explain tokens
 %token <str> FORMAT TREE VITESS TRADITIONAL
@@ -306,12 +325,13 @@ func bindVariable(yylex yyLexer, bvar string) {
 %type <statement> revert_statement
 %type <strs> comment_opt comment_list
 %type <str> wild_opt check_option_opt cascade_or_local_opt restrict_or_cascade_opt
-%type <explainType> explain_format_opt
+%type <explainType> explain_format_opt vexplain_format_opt
 %type <insertAction> insert_or_replace
 %type <str> explain_synonyms
 %type <str> cache_opt separator_opt flush_option for_channel_opt
 %type <matchExprOption> match_option
-%type <boolean> distinct_opt union_op replace_opt local_opt
+%type <boolean> distinct_opt replace_opt local_opt
+%type <unionSelect> set_op
 %type <expr> like_escape_opt
 %type <selectExprs> select_expression_list select_expression_list_opt
 %type <selectExpr> select_expression
@@ -334,6 +354,14 @@ func bindVariable(yylex yyLexer, bvar string) {
 %type <ins> insert_data
 %type <expr> value value_expression num_val
 %type <expr> function_call_keyword function_call_nonkeyword function_call_generic function_call_conflict func_datetime_precision
+%type <overClause> over_clause over_clause_opt
+%type <windowSpec> window_spec
+%type <colIdent> window_name_opt
+%type <exprs> partition_by_opt
+%type <frameClause> frame_clause_opt
+%type <framePoint> frame_point
+%type <namedWindow> named_window
+%type <namedWindows> window_clause_opt named_window_list
 %type <isExprOperator> is_suffix
 %type <colTuple> col_tuple
 %type <exprs> expression_list expression_list_opt
@@ -528,9 +556,9 @@ select_statement:
   {
     $$ = &Union{FirstStatement: &ParenSelect{Select: $2}, OrderBy: $4, Limit:$5, Lock:$6}
   }
-| select_statement union_op union_rhs order_by_opt limit_opt lock_opt
+| select_statement set_op union_rhs order_by_opt limit_opt lock_opt
   {
-    $$ = Unionize($1, $3, $2, $4, $5, $6)
+    $$ = SetOp($1, $3, $2.Type, $2.Distinct, $4, $5, $6)
   }
 | SELECT comment_opt cache_opt NEXT num_val for_from table_name
   {
@@ -563,9 +591,9 @@ simple_select:
     sel.Lock = $4
     $$ = sel
   }
-| simple_select union_op union_rhs order_by_opt limit_opt lock_opt
+| simple_select set_op union_rhs order_by_opt limit_opt lock_opt
   {
-    $$ = Unionize($1, $3, $2, $4, $5, $6)
+    $$ = SetOp($1, $3, $2.Type, $2.Distinct, $4, $5, $6)
   }
 
 stream_statement:
@@ -582,10 +610,12 @@ vstream_statement:
 
 // base_select is an unparenthesized SELECT with no order by clause or beyond.
 base_select:
-//  1         2            3              4                    5             6                7           8
-  SELECT comment_opt select_options select_expression_list from_opt where_expression_opt group_by_opt having_opt
+//  1         2            3              4                    5             6                7           8          9
+  SELECT comment_opt select_options select_expression_list from_opt where_expression_opt group_by_opt having_opt window_clause_opt
   {
-    $$ = NewSelect(Comments($2), $4/*SelectExprs*/, $3/*options*/, $5/*from*/, NewWhere(WhereClause, $6), GroupBy($7), NewWhere(HavingClause, $8))
+    sel := NewSelect(Comments($2), $4/*SelectExprs*/, $3/*options*/, $5/*from*/, NewWhere(WhereClause, $6), GroupBy($7), NewWhere(HavingClause, $8))
+    sel.Windows = $9
+    $$ = sel
   }
 
 union_rhs:
@@ -2607,6 +2637,14 @@ show_statement:
   {
     $$ = &ShowMigrationLogs{UUID: string($3)}
   }
+| SHOW VITESS_DDL_STATUS like_or_where_opt
+  {
+    $$ = &Show{&ShowBasic{Command: VitessDDLStatus, Filter: $3}}
+  }
+| SHOW VITESS_DDL_STATUS STRING
+  {
+    $$ = &Show{&ShowBasic{Command: VitessDDLStatus, Filter: &ShowFilter{Filter: &ComparisonExpr{Operator: EqualOp, Left: NewColName("uuid"), Right: NewStrLiteral($3)}}}}
+  }
 | SHOW VSCHEMA TABLES
   {
     $$ = &Show{&ShowLegacy{Type: string($2) + " " + string($3), Scope: ImplicitScope}}
@@ -2827,6 +2865,15 @@ explain_format_opt:
     $$ = AnalyzeType
   }
 
+vexplain_format_opt:
+  {
+    $$ = EmptyType
+  }
+| FORMAT '=' JSON
+  {
+    $$ = JSONType
+  }
+
 explain_synonyms:
   EXPLAIN
   {
@@ -2881,6 +2928,14 @@ explain_statement:
   {
     $$ = &ExplainStmt{Type: $2, Statement: $3}
   }
+| VEXPLAIN ALL vexplain_format_opt explainable_statement
+  {
+    $$ = &VExplainStmt{Type: $3, Statement: $4}
+  }
+| VEXPLAIN PLAN vexplain_format_opt explainable_statement
+  {
+    $$ = &VExplainStmt{Kind: VExplainPlan, Type: $3, Statement: $4}
+  }
 
 other_statement:
   REPAIR skip_to_end
@@ -3071,18 +3126,42 @@ comment_list:
     $$ = append($1, $2)
   }
 
-union_op:
+set_op:
   UNION
   {
-    $$ = true
+    $$ = &UnionSelect{Type: UnionType, Distinct: true}
   }
 | UNION ALL
   {
-    $$ = false
+    $$ = &UnionSelect{Type: UnionType}
   }
 | UNION DISTINCT
   {
-    $$ = true
+    $$ = &UnionSelect{Type: UnionType, Distinct: true}
+  }
+| INTERSECT
+  {
+    $$ = &UnionSelect{Type: IntersectType, Distinct: true}
+  }
+| INTERSECT ALL
+  {
+    $$ = &UnionSelect{Type: IntersectType}
+  }
+| INTERSECT DISTINCT
+  {
+    $$ = &UnionSelect{Type: IntersectType, Distinct: true}
+  }
+| EXCEPT
+  {
+    $$ = &UnionSelect{Type: ExceptType, Distinct: true}
+  }
+| EXCEPT ALL
+  {
+    $$ = &UnionSelect{Type: ExceptType}
+  }
+| EXCEPT DISTINCT
+  {
+    $$ = &UnionSelect{Type: ExceptType, Distinct: true}
   }
 
 cache_opt:
@@ -3822,9 +3901,9 @@ value_expression:
   introduce side effects due to being a simple identifier
 */
 function_call_generic:
-  sql_id openb select_expression_list_opt closeb
+  sql_id openb select_expression_list_opt closeb over_clause_opt
   {
-    $$ = &FuncExpr{Name: $1, Exprs: $3}
+    $$ = &FuncExpr{Name: $1, Exprs: $3, Over: $5}
   }
 | sql_id openb DISTINCT select_expression_list closeb
   {
@@ -3839,6 +3918,119 @@ function_call_generic:
     $$ = &FuncExpr{Qualifier: $1, Name: $3, Exprs: $5}
   }
 
+over_clause_opt:
+  {
+    $$ = nil
+  }
+| over_clause
+  {
+    $$ = $1
+  }
+
+over_clause:
+  OVER openb window_spec closeb
+  {
+    $$ = &OverClause{WindowSpec: $3}
+  }
+| OVER sql_id
+  {
+    $$ = &OverClause{WindowName: $2}
+  }
+
+window_spec:
+  window_name_opt partition_by_opt order_by_opt frame_clause_opt
+  {
+    $$ = &WindowSpecification{Name: $1, PartitionClause: $2, OrderClause: $3, FrameClause: $4}
+  }
+
+// The name of the window a window specification refines can't be a
+// keyword, which would conflict with the rest of the window specification.
+window_name_opt:
+  {
+    $$ = ColIdent{}
+  }
+| id_or_var
+  {
+    $$ = $1
+  }
+
+partition_by_opt:
+  {
+    $$ = nil
+  }
+| PARTITION BY expression_list
+  {
+    $$ = $3
+  }
+
+frame_clause_opt:
+  {
+    $$ = nil
+  }
+| ROWS frame_point
+  {
+    $$ = &FrameClause{Unit: FrameRowsType, Start: $2}
+  }
+| RANGE frame_point
+  {
+    $$ = &FrameClause{Unit: FrameRangeType, Start: $2}
+  }
+| ROWS BETWEEN frame_point AND frame_point
+  {
+    $$ = &FrameClause{Unit: FrameRowsType, Start: $3, End: $5}
+  }
+| RANGE BETWEEN frame_point AND frame_point
+  {
+    $$ = &FrameClause{Unit: FrameRangeType, Start: $3, End: $5}
+  }
+
+frame_point:
+  CURRENT ROW
+  {
+    $$ = &FramePoint{Type: CurrentRowType}
+  }
+| UNBOUNDED PRECEDING
+  {
+    $$ = &FramePoint{Type: UnboundedPrecedingType}
+  }
+| UNBOUNDED FOLLOWING
+  {
+    $$ = &FramePoint{Type: UnboundedFollowingType}
+  }
+| value_expression PRECEDING
+  {
+    $$ = &FramePoint{Type: ExprPrecedingType, Expr: $1}
+  }
+| value_expression FOLLOWING
+  {
+    $$ = &FramePoint{Type: ExprFollowingType, Expr: $1}
+  }
+
+window_clause_opt:
+  {
+    $$ = nil
+  }
+| WINDOW named_window_list
+  {
+    $$ = $2
+  }
+
+named_window_list:
+  named_window
+  {
+    $$ = NamedWindows{$1}
+  }
+| named_window_list ',' named_window
+  {
+    $$ = append($1, $3)
+  }
+
+named_window:
+  sql_id AS openb window_spec closeb
+  {
+    $$ = &NamedWindow{Name: $1, WindowSpec: $4}
+  }
+
 /*
   Function calls using reserved keywords, with dedicated grammar rules
   as a result
@@ -4163,15 +4355,15 @@ else_expression_opt:
 column_name:
   sql_id
   {
-    $$ = &ColName{Name: $1}
+    $$ = &ColName{Name: $1, Pos: $<pos>1}
   }
 | table_id '.' reserved_sql_id
   {
-    $$ = &ColName{Qualifier: TableName{Name: $1}, Name: $3}
+    $$ = &ColName{Qualifier: TableName{Name: $1}, Name: $3, Pos: $<pos>1}
   }
 | table_id '.' reserved_table_id '.' reserved_sql_id
   {
-    $$ = &ColName{Qualifier: TableName{Qualifier: $1, Name: $3}, Name: $5}
+    $$ = &ColName{Qualifier: TableName{Qualifier: $1, Name: $3}, Name: $5, Pos: $<pos>1}
   }
 
 value:
@@ -4910,6 +5102,7 @@ reserved_keyword:
 | DROP
 | ELSE
 | ESCAPE
+| EXCEPT
 | EXISTS
 | EXPLAIN
 | FALSE
@@ -4930,6 +5123,7 @@ reserved_keyword:
 | INDEX
 | INNER
 | INSERT
+| INTERSECT
 | INTERVAL
 | INTO
 | IS
@@ -5059,6 +5253,7 @@ non_reserved_keyword:
 | CONNECTION
 | COPY
 | CSV
+| CURRENT
 | DATA
 | DATE
 | DATETIME
@@ -5187,6 +5382,7 @@ non_reserved_keyword:
 | PATH
 | PERSIST
 | PERSIST_ONLY
+| PLAN
 | PRECEDING
 | PRIVILEGE_CHECKS_USER
 | PRIVILEGES
@@ -5198,6 +5394,7 @@ non_reserved_keyword:
 | PROCESSLIST
 | QUERY
 | RANDOM
+| RANGE
 | REAL
 | REBUILD
 | REDUNDANT
@@ -5218,6 +5415,8 @@ non_reserved_keyword:
 | REUSE
 | ROLE
 | ROLLBACK
+| ROW
+| ROWS
 | ROW_FORMAT
 | S3
 | SECONDARY
@@ -5276,12 +5475,14 @@ non_reserved_keyword:
 | VARCHAR
 | VARIABLES
 | VCPU
+| VEXPLAIN
 | VGTID_EXECUTED
 | VIEW
 | VINDEX
 | VINDEXES
 | VISIBLE
 | VITESS
+| VITESS_DDL_STATUS
 | VITESS_KEYSPACES
 | VITESS_METADATA
 | VITESS_SHARDS
@@ -44,6 +44,9 @@ type Tokenizer struct {
 	nesting        int
 	multi          bool
 	specialComment *Tokenizer
+	// tokenStart is the position of the first byte of the last token that
+	// was scanned. Tokens of a MySQL-specific comment start at the comment.
+	tokenStart int
 
 	Pos int
 	buf string
@@ -80,6 +83,7 @@ func (tkn *Tokenizer) Lex(lval *yySymType) int {
 		tkn.partialDDL = nil
 	}
 	lval.str = val
+	lval.pos = tkn.tokenStart + 1
 	tkn.lastToken = val
 	return typ
 }
@@ -123,6 +127,7 @@ func (tkn *Tokenizer) Scan() (int, string) {
 	}
 
 	tkn.skipBlank()
+	tkn.tokenStart = tkn.Pos
 	switch ch := tkn.cur(); {
 	case ch == '@':
 		tokenID := AT_ID
@@ -45,17 +45,23 @@ var (
 
 	Autocommit                  = SystemVariable{Name: "autocommit", IsBoolean: true, Default: on}
 	Charset                     = SystemVariable{Name: "charset", Default: utf8, IdentifierAsString: true}
+	ConsistentSnapshotReads     = SystemVariable{Name: "consistent_snapshot_reads", IsBoolean: true, Default: off}
 	ClientFoundRows             = SystemVariable{Name: "client_found_rows", IsBoolean: true, Default: off}
+	MaxExecutionTime            = SystemVariable{Name: "max_execution_time", Default: off}
 	SessionEnableSystemSettings = SystemVariable{Name: "enable_system_settings", IsBoolean: true, Default: on}
 	Names                       = SystemVariable{Name: "names", Default: utf8, IdentifierAsString: true}
+	Priority                    = SystemVariable{Name: "priority", IdentifierAsString: true}
 	SessionUUID                 = SystemVariable{Name: "session_uuid", IdentifierAsString: true}
+	SkipKeyspacePolicy          = SystemVariable{Name: "skip_keyspace_policy", IsBoolean: true, Default: off}
 	SkipQueryPlanCache          = SystemVariable{Name: "skip_query_plan_cache", IsBoolean: true, Default: off}
 	Socket                      = SystemVariable{Name: "socket", Default: off}
 	SQLSelectLimit              = SystemVariable{Name: "sql_select_limit", Default: off}
+	Tenant                      = SystemVariable{Name: "tenant", IdentifierAsString: true}
 	TransactionMode             = SystemVariable{Name: "transaction_mode", IdentifierAsString: true}
 	TransactionReadOnly         = SystemVariable{Name: "transaction_read_only", IsBoolean: true, Default: off}
 	TxReadOnly                  = SystemVariable{Name: "tx_read_only", IsBoolean: true, Default: off}
 	Workload                    = SystemVariable{Name: "workload", IdentifierAsString: true}
+	WorkloadName                = SystemVariable{Name: "workload_name", IdentifierAsString: true}
 
 	// Online DDL
 	DDLStrategy    = SystemVariable{Name: "ddl_strategy", IdentifierAsString: true}
@@ -71,12 +77,17 @@ var (
 		Autocommit,
 		ClientFoundRows,
 		SkipQueryPlanCache,
+		SkipKeyspacePolicy,
 		TxReadOnly,
 		TransactionReadOnly,
 		SQLSelectLimit,
+		MaxExecutionTime,
 		TransactionMode,
 		DDLStrategy,
 		Workload,
+		WorkloadName,
+		Priority,
+		Tenant,
 		Charset,
 		Names,
 		SessionUUID,
@@ -84,6 +95,7 @@ var (
 		ReadAfterWriteGTID,
 		ReadAfterWriteTimeOut,
 		SessionTrackGTIDs,
+		ConsistentSnapshotReads,
 	}
 
 	ReadOnly = []SystemVariable{
@@ -230,7 +242,6 @@ var (
 		{Name: "lock_wait_timeout"},
 		{Name: "max_allowed_packet"},
 		{Name: "max_error_count"},
-		{Name: "max_execution_time"},
 		{Name: "max_join_size"},
 		{Name: "max_length_for_sort_data"},
 		{Name: "max_sort_length"},
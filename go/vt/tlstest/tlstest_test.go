@@ -23,6 +23,7 @@ import (
 	"io"
 	"io/ioutil"
 	"net"
+	"net/url"
 	"os"
 	"strings"
 	"sync"
@@ -313,3 +314,115 @@ func TestNumberOfCertsWithoutCombining(t *testing.T) {
 func TestNumberOfCertsWithCombining(t *testing.T) {
 	testNumberOfCertsWithOrWithoutCombining(t, 2, true)
 }
+
+func TestServerConfigWithReload(t *testing.T) {
+	// Our test root.
+	root, err := ioutil.TempDir("", "tlstest")
+	if err != nil {
+		t.Fatalf("TempDir failed: %v", err)
+	}
+	defer os.RemoveAll(root)
+
+	firstClientServerKeyPairs := CreateClientServerCertPairs(root)
+	secondClientServerKeyPairs := CreateClientServerCertPairs(root)
+
+	serverConfig, err := vttls.ServerConfigWithReload(
+		firstClientServerKeyPairs.ServerCert,
+		firstClientServerKeyPairs.ServerKey,
+		firstClientServerKeyPairs.ClientCA,
+		"",
+		tls.VersionTLS12)
+	if err != nil {
+		t.Fatalf("ServerConfigWithReload failed: %v", err)
+	}
+	firstCert, err := serverConfig.GetCertificate(nil)
+	if err != nil {
+		t.Fatalf("GetCertificate failed: %v", err)
+	}
+
+	// Replace the files the way a secret is updated: write new files and
+	// rename them over the current ones.
+	for src, dst := range map[string]string{
+		secondClientServerKeyPairs.ServerCert: firstClientServerKeyPairs.ServerCert,
+		secondClientServerKeyPairs.ServerKey:  firstClientServerKeyPairs.ServerKey,
+	} {
+		data, err := ioutil.ReadFile(src)
+		if err != nil {
+			t.Fatalf("ReadFile failed: %v", err)
+		}
+		if err := ioutil.WriteFile(dst+".new", data, 0600); err != nil {
+			t.Fatalf("WriteFile failed: %v", err)
+		}
+		if err := os.Rename(dst+".new", dst); err != nil {
+			t.Fatalf("Rename failed: %v", err)
+		}
+	}
+
+	secondCert, err := tls.LoadX509KeyPair(secondClientServerKeyPairs.ServerCert, secondClientServerKeyPairs.ServerKey)
+	if err != nil {
+		t.Fatalf("LoadX509KeyPair failed: %v", err)
+	}
+	assert.Eventually(t, func() bool {
+		cert, err := serverConfig.GetCertificate(nil)
+		return err == nil && cert != firstCert && assert.ObjectsAreEqual(secondCert.Certificate, cert.Certificate)
+	}, 10*time.Second, 10*time.Millisecond)
+
+	// The configs of the same files share their watcher.
+	otherConfig, err := vttls.ServerConfigWithReload(
+		firstClientServerKeyPairs.ServerCert,
+		firstClientServerKeyPairs.ServerKey,
+		firstClientServerKeyPairs.ClientCA,
+		"",
+		tls.VersionTLS12)
+	if err != nil {
+		t.Fatalf("ServerConfigWithReload failed: %v", err)
+	}
+	cert, _ := serverConfig.GetCertificate(nil)
+	otherCert, _ := otherConfig.GetCertificate(nil)
+	assert.Same(t, cert, otherCert)
+}
+
+func spiffeConnectionState(t *testing.T, ids ...string) tls.ConnectionState {
+	cert := &x509.Certificate{}
+	for _, id := range ids {
+		u, err := url.Parse(id)
+		if err != nil {
+			t.Fatalf("Parse failed: %v", err)
+		}
+		cert.URIs = append(cert.URIs, u)
+	}
+	return tls.ConnectionState{PeerCertificates: []*x509.Certificate{cert}}
+}
+
+func TestVerifyClientSPIFFEIDs(t *testing.T) {
+	config := &tls.Config{}
+	err := vttls.VerifyClientSPIFFEIDs(config, []string{"spiffe://example.org/vtgate"})
+	assert.EqualError(t, err, "verifying the SPIFFE IDs of the clients requires a CA to verify their certificates")
+
+	config.ClientAuth = tls.RequireAndVerifyClientCert
+	err = vttls.VerifyClientSPIFFEIDs(config, []string{"https://example.org/vtgate"})
+	assert.EqualError(t, err, `invalid SPIFFE ID "https://example.org/vtgate": must be spiffe://<trust domain>[/<path>]`)
+
+	err = vttls.VerifyClientSPIFFEIDs(config, []string{"spiffe://example.org/vtgate", "spiffe://other.org"})
+	if err != nil {
+		t.Fatalf("VerifyClientSPIFFEIDs failed: %v", err)
+	}
+
+	assert.NoError(t, config.VerifyConnection(spiffeConnectionState(t, "spiffe://example.org/vtgate")))
+	assert.NoError(t, config.VerifyConnection(spiffeConnectionState(t, "spiffe://other.org/vttablet")))
+	assert.EqualError(t, config.VerifyConnection(spiffeConnectionState(t, "spiffe://example.org/vttablet")), "peer SPIFFE ID spiffe://example.org/vttablet is not allowed")
+	assert.EqualError(t, config.VerifyConnection(spiffeConnectionState(t)), "peer certificate carries no SPIFFE ID")
+	assert.EqualError(t, config.VerifyConnection(tls.ConnectionState{}), "peer presented no certificate")
+}
+
+func TestVerifyServerSPIFFEIDs(t *testing.T) {
+	config := &tls.Config{ServerName: "server"}
+	err := vttls.VerifyServerSPIFFEIDs(config, []string{"spiffe://example.org/vttablet"})
+	if err != nil {
+		t.Fatalf("VerifyServerSPIFFEIDs failed: %v", err)
+	}
+	// The server name is not verified anymore, but the chain still is.
+	assert.True(t, config.InsecureSkipVerify)
+	err = config.VerifyConnection(spiffeConnectionState(t, "spiffe://example.org/vttablet"))
+	assert.Error(t, err)
+}
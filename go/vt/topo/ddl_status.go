@@ -0,0 +1,66 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topo
+
+import (
+	"context"
+	"path"
+
+	"google.golang.org/protobuf/proto"
+
+	"vitess.io/vitess/go/vt/vterrors"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// This file contains the utility methods to manage the statuses of the DDLs
+// that vtgate applies to the shards of a keyspace, in the global cell.
+
+const ddlStatusesPath = "ddl_statuses"
+
+func pathForDDLStatus(uuid string) string {
+	return path.Join(ddlStatusesPath, uuid)
+}
+
+// SaveDDLStatus creates or replaces the status of a DDL.
+func (ts *Server) SaveDDLStatus(ctx context.Context, status *topodatapb.DDLStatus) error {
+	data, err := proto.Marshal(status)
+	if err != nil {
+		return err
+	}
+	_, err = ts.globalCell.Update(ctx, pathForDDLStatus(status.Uuid), data, nil)
+	return err
+}
+
+// GetDDLStatus returns the status of a DDL. It returns a NoNode error if
+// there is none.
+func (ts *Server) GetDDLStatus(ctx context.Context, uuid string) (*topodatapb.DDLStatus, error) {
+	data, _, err := ts.globalCell.Get(ctx, pathForDDLStatus(uuid))
+	if err != nil {
+		return nil, err
+	}
+	status := &topodatapb.DDLStatus{}
+	if err := proto.Unmarshal(data, status); err != nil {
+		return nil, vterrors.Wrapf(err, "bad DDL status data: %q", data)
+	}
+	return status, nil
+}
+
+// DeleteDDLStatus deletes the status of a DDL.
+func (ts *Server) DeleteDDLStatus(ctx context.Context, uuid string) error {
+	return ts.globalCell.Delete(ctx, pathForDDLStatus(uuid), nil)
+}
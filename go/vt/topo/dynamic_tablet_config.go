@@ -0,0 +1,197 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topo
+
+import (
+	"context"
+	"path"
+
+	"google.golang.org/protobuf/proto"
+
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// This file contains the utility methods to manage DynamicTabletConfig
+// objects, of keyspaces in the global cell and of tablets in their cell.
+
+// DynamicTabletConfigFile is the file name of the DynamicTabletConfig
+// objects, next to the Keyspace and Tablet objects.
+const DynamicTabletConfigFile = "DynamicTabletConfig"
+
+// WatchDynamicTabletConfigData is returned / streamed by
+// WatchKeyspaceDynamicTabletConfig and WatchTabletDynamicTabletConfig.
+// The API guarantees exactly one of Value or Err will be set.
+type WatchDynamicTabletConfigData struct {
+	Value   *topodatapb.DynamicTabletConfig
+	Version Version
+	Err     error
+}
+
+func pathForKeyspaceDynamicTabletConfig(keyspace string) string {
+	return path.Join(KeyspacesPath, keyspace, DynamicTabletConfigFile)
+}
+
+func pathForTabletDynamicTabletConfig(alias *topodatapb.TabletAlias) string {
+	return path.Join(TabletsPath, topoproto.TabletAliasString(alias), DynamicTabletConfigFile)
+}
+
+// GetKeyspaceDynamicTabletConfig returns the DynamicTabletConfig of a
+// keyspace, and its version.
+func (ts *Server) GetKeyspaceDynamicTabletConfig(ctx context.Context, keyspace string) (*topodatapb.DynamicTabletConfig, Version, error) {
+	return getDynamicTabletConfig(ctx, ts.globalCell, pathForKeyspaceDynamicTabletConfig(keyspace))
+}
+
+// UpdateKeyspaceDynamicTabletConfig creates or updates the
+// DynamicTabletConfig of a keyspace. If version is not nil, the update
+// fails with a BadVersion error unless the current version matches it.
+func (ts *Server) UpdateKeyspaceDynamicTabletConfig(ctx context.Context, keyspace string, config *topodatapb.DynamicTabletConfig, version Version) (Version, error) {
+	return updateDynamicTabletConfig(ctx, ts.globalCell, pathForKeyspaceDynamicTabletConfig(keyspace), config, version)
+}
+
+// DeleteKeyspaceDynamicTabletConfig deletes the DynamicTabletConfig of a
+// keyspace. It is not an error if there is none.
+func (ts *Server) DeleteKeyspaceDynamicTabletConfig(ctx context.Context, keyspace string) error {
+	return deleteDynamicTabletConfig(ctx, ts.globalCell, pathForKeyspaceDynamicTabletConfig(keyspace))
+}
+
+// WatchKeyspaceDynamicTabletConfig will set a watch on the
+// DynamicTabletConfig of a keyspace. It has the same contract as
+// Conn.Watch, but it also unpacks the contents.
+func (ts *Server) WatchKeyspaceDynamicTabletConfig(ctx context.Context, keyspace string) (*WatchDynamicTabletConfigData, <-chan *WatchDynamicTabletConfigData, CancelFunc) {
+	return watchDynamicTabletConfig(ctx, ts.globalCell, pathForKeyspaceDynamicTabletConfig(keyspace))
+}
+
+// GetTabletDynamicTabletConfig returns the DynamicTabletConfig of a
+// tablet, and its version.
+func (ts *Server) GetTabletDynamicTabletConfig(ctx context.Context, alias *topodatapb.TabletAlias) (*topodatapb.DynamicTabletConfig, Version, error) {
+	conn, err := ts.ConnForCell(ctx, alias.Cell)
+	if err != nil {
+		return nil, nil, err
+	}
+	return getDynamicTabletConfig(ctx, conn, pathForTabletDynamicTabletConfig(alias))
+}
+
+// UpdateTabletDynamicTabletConfig creates or updates the
+// DynamicTabletConfig of a tablet. If version is not nil, the update
+// fails with a BadVersion error unless the current version matches it.
+func (ts *Server) UpdateTabletDynamicTabletConfig(ctx context.Context, alias *topodatapb.TabletAlias, config *topodatapb.DynamicTabletConfig, version Version) (Version, error) {
+	conn, err := ts.ConnForCell(ctx, alias.Cell)
+	if err != nil {
+		return nil, err
+	}
+	return updateDynamicTabletConfig(ctx, conn, pathForTabletDynamicTabletConfig(alias), config, version)
+}
+
+// DeleteTabletDynamicTabletConfig deletes the DynamicTabletConfig of a
+// tablet. It is not an error if there is none.
+func (ts *Server) DeleteTabletDynamicTabletConfig(ctx context.Context, alias *topodatapb.TabletAlias) error {
+	conn, err := ts.ConnForCell(ctx, alias.Cell)
+	if err != nil {
+		return err
+	}
+	return deleteDynamicTabletConfig(ctx, conn, pathForTabletDynamicTabletConfig(alias))
+}
+
+// WatchTabletDynamicTabletConfig will set a watch on the
+// DynamicTabletConfig of a tablet. It has the same contract as
+// Conn.Watch, but it also unpacks the contents.
+func (ts *Server) WatchTabletDynamicTabletConfig(ctx context.Context, alias *topodatapb.TabletAlias) (*WatchDynamicTabletConfigData, <-chan *WatchDynamicTabletConfigData, CancelFunc) {
+	conn, err := ts.ConnForCell(ctx, alias.Cell)
+	if err != nil {
+		return &WatchDynamicTabletConfigData{Err: err}, nil, nil
+	}
+	return watchDynamicTabletConfig(ctx, conn, pathForTabletDynamicTabletConfig(alias))
+}
+
+func getDynamicTabletConfig(ctx context.Context, conn Conn, nodePath string) (*topodatapb.DynamicTabletConfig, Version, error) {
+	data, version, err := conn.Get(ctx, nodePath)
+	if err != nil {
+		return nil, nil, err
+	}
+	config := &topodatapb.DynamicTabletConfig{}
+	if err := proto.Unmarshal(data, config); err != nil {
+		return nil, nil, vterrors.Wrapf(err, "DynamicTabletConfig unmarshal failed: %v", data)
+	}
+	return config, version, nil
+}
+
+func updateDynamicTabletConfig(ctx context.Context, conn Conn, nodePath string, config *topodatapb.DynamicTabletConfig, version Version) (Version, error) {
+	data, err := proto.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+	return conn.Update(ctx, nodePath, data, version)
+}
+
+func deleteDynamicTabletConfig(ctx context.Context, conn Conn, nodePath string) error {
+	err := conn.Delete(ctx, nodePath, nil)
+	if IsErrType(err, NoNode) {
+		return nil
+	}
+	return err
+}
+
+func watchDynamicTabletConfig(ctx context.Context, conn Conn, nodePath string) (*WatchDynamicTabletConfigData, <-chan *WatchDynamicTabletConfigData, CancelFunc) {
+	current, wdChannel, cancel := conn.Watch(ctx, nodePath)
+	if current.Err != nil {
+		return &WatchDynamicTabletConfigData{Err: current.Err}, nil, nil
+	}
+	value := &topodatapb.DynamicTabletConfig{}
+	if err := proto.Unmarshal(current.Contents, value); err != nil {
+		// Cancel the watch, drain channel.
+		cancel()
+		for range wdChannel {
+		}
+		return &WatchDynamicTabletConfigData{Err: vterrors.Wrapf(err, "error unpacking initial DynamicTabletConfig object")}, nil, nil
+	}
+
+	changes := make(chan *WatchDynamicTabletConfigData, 10)
+
+	// The background routine reads any event from the watch channel,
+	// translates it, and sends it to the caller.
+	// If cancel() is called, the underlying Watch() code will
+	// send an ErrInterrupted and then close the channel. We'll
+	// just propagate that back to our caller.
+	go func() {
+		defer close(changes)
+
+		for wd := range wdChannel {
+			if wd.Err != nil {
+				// Last error value, we're done.
+				// wdChannel will be closed right after
+				// this, no need to do anything.
+				changes <- &WatchDynamicTabletConfigData{Err: wd.Err}
+				return
+			}
+
+			value := &topodatapb.DynamicTabletConfig{}
+			if err := proto.Unmarshal(wd.Contents, value); err != nil {
+				cancel()
+				for range wdChannel {
+				}
+				changes <- &WatchDynamicTabletConfigData{Err: vterrors.Wrapf(err, "error unpacking DynamicTabletConfig object")}
+				return
+			}
+			changes <- &WatchDynamicTabletConfigData{Value: value, Version: wd.Version}
+		}
+	}()
+
+	return &WatchDynamicTabletConfigData{Value: value, Version: current.Version}, changes, cancel
+}
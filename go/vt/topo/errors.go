@@ -35,6 +35,7 @@ const (
 	PartialResult
 	NoUpdateNeeded
 	NoImplementation
+	ReadOnly
 )
 
 // Error represents a topo error.
@@ -65,6 +66,8 @@ func NewError(code ErrorCode, node string) error {
 		message = fmt.Sprintf("no update needed: %s", node)
 	case NoImplementation:
 		message = fmt.Sprintf("no such topology implementation %s", node)
+	case ReadOnly:
+		message = fmt.Sprintf("cannot change the read-only topology: %s", node)
 	default:
 		message = fmt.Sprintf("unknown code: %s", node)
 	}
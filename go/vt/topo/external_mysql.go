@@ -0,0 +1,95 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topo
+
+import (
+	"context"
+	"path"
+
+	"google.golang.org/protobuf/proto"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// ExternalMysqlInfo is a meta struct that contains metadata to give the
+// data more context and convenience. This is the main way we interact
+// with an external mysql stored in the topo.
+type ExternalMysqlInfo struct {
+	Name string
+	*topodatapb.ExternalMysql
+}
+
+// GetExternalMysqlDir returns node path containing external mysqls
+func GetExternalMysqlDir() string {
+	return path.Join(ExternalClustersFile, ExternalClusterMySQL)
+}
+
+// GetExternalMysqlPath returns node path of an external mysql
+func GetExternalMysqlPath(name string) string {
+	return path.Join(GetExternalMysqlDir(), name)
+}
+
+// CreateExternalMysql creates a topo record for the passed external mysql
+func (ts *Server) CreateExternalMysql(ctx context.Context, name string, value *topodatapb.ExternalMysql) error {
+	data, err := proto.Marshal(value)
+	if err != nil {
+		return err
+	}
+	_, err = ts.globalCell.Create(ctx, GetExternalMysqlPath(name), data)
+	return err
+}
+
+// GetExternalMysql returns a topo record for the named external mysql, or
+// nil if there is none.
+func (ts *Server) GetExternalMysql(ctx context.Context, name string) (*ExternalMysqlInfo, error) {
+	data, _, err := ts.globalCell.Get(ctx, GetExternalMysqlPath(name))
+	switch {
+	case IsErrType(err, NoNode):
+		return nil, nil
+	case err == nil:
+	default:
+		return nil, err
+	}
+	em := &topodatapb.ExternalMysql{}
+	if err = proto.Unmarshal(data, em); err != nil {
+		return nil, vterrors.Wrap(err, "bad external mysql data")
+	}
+
+	return &ExternalMysqlInfo{
+		Name:          name,
+		ExternalMysql: em,
+	}, nil
+}
+
+// DeleteExternalMysql deletes the topo record for the named external mysql
+func (ts *Server) DeleteExternalMysql(ctx context.Context, name string) error {
+	return ts.globalCell.Delete(ctx, GetExternalMysqlPath(name), nil)
+}
+
+// GetExternalMysqls returns the names of the external mysqls in the topology.
+func (ts *Server) GetExternalMysqls(ctx context.Context) ([]string, error) {
+	children, err := ts.globalCell.ListDir(ctx, GetExternalMysqlDir(), false /*full*/)
+	switch {
+	case err == nil:
+		return DirEntriesToStringArray(children), nil
+	case IsErrType(err, NoNode):
+		return nil, nil
+	default:
+		return nil, err
+	}
+}
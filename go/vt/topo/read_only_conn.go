@@ -0,0 +1,82 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topo
+
+import (
+	"context"
+)
+
+var _ Conn = (*ReadOnlyConn)(nil)
+
+// The ReadOnlyConn is a wrapper for a Conn that rejects every operation
+// changing the topology, with a ReadOnly error. It is used for the topo of
+// mounted clusters.
+type ReadOnlyConn struct {
+	conn Conn
+}
+
+// NewReadOnlyConn returns a ReadOnlyConn
+func NewReadOnlyConn(conn Conn) *ReadOnlyConn {
+	return &ReadOnlyConn{
+		conn: conn,
+	}
+}
+
+// ListDir is part of the Conn interface
+func (ro *ReadOnlyConn) ListDir(ctx context.Context, dirPath string, full bool) ([]DirEntry, error) {
+	return ro.conn.ListDir(ctx, dirPath, full)
+}
+
+// Create is part of the Conn interface
+func (ro *ReadOnlyConn) Create(ctx context.Context, filePath string, contents []byte) (Version, error) {
+	return nil, NewError(ReadOnly, filePath)
+}
+
+// Update is part of the Conn interface
+func (ro *ReadOnlyConn) Update(ctx context.Context, filePath string, contents []byte, version Version) (Version, error) {
+	return nil, NewError(ReadOnly, filePath)
+}
+
+// Get is part of the Conn interface
+func (ro *ReadOnlyConn) Get(ctx context.Context, filePath string) ([]byte, Version, error) {
+	return ro.conn.Get(ctx, filePath)
+}
+
+// Delete is part of the Conn interface
+func (ro *ReadOnlyConn) Delete(ctx context.Context, filePath string, version Version) error {
+	return NewError(ReadOnly, filePath)
+}
+
+// Lock is part of the Conn interface
+func (ro *ReadOnlyConn) Lock(ctx context.Context, dirPath, contents string) (LockDescriptor, error) {
+	return nil, NewError(ReadOnly, dirPath)
+}
+
+// Watch is part of the Conn interface
+func (ro *ReadOnlyConn) Watch(ctx context.Context, filePath string) (current *WatchData, changes <-chan *WatchData, cancel CancelFunc) {
+	return ro.conn.Watch(ctx, filePath)
+}
+
+// NewMasterParticipation is part of the Conn interface
+func (ro *ReadOnlyConn) NewMasterParticipation(name, id string) (MasterParticipation, error) {
+	return nil, NewError(ReadOnly, name)
+}
+
+// Close is part of the Conn interface
+func (ro *ReadOnlyConn) Close() {
+	ro.conn.Close()
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadOnlyConn(t *testing.T) {
+	ctx := context.Background()
+	conn := NewReadOnlyConn(&fakeConn{})
+
+	_, err := conn.ListDir(ctx, "keyspaces", false)
+	assert.NoError(t, err)
+	_, _, err = conn.Get(ctx, "keyspaces/ks/Keyspace")
+	assert.NoError(t, err)
+
+	_, err = conn.Create(ctx, "keyspaces/ks/Keyspace", nil)
+	assert.True(t, IsErrType(err, ReadOnly), "%v", err)
+	_, err = conn.Update(ctx, "keyspaces/ks/Keyspace", nil, nil)
+	assert.True(t, IsErrType(err, ReadOnly), "%v", err)
+	err = conn.Delete(ctx, "keyspaces/ks/Keyspace", nil)
+	assert.True(t, IsErrType(err, ReadOnly), "%v", err)
+	_, err = conn.Lock(ctx, "keyspaces/ks", "SwitchWrites")
+	assert.EqualError(t, err, "cannot change the read-only topology: keyspaces/ks")
+	_, err = conn.NewMasterParticipation("vtctld", "id")
+	assert.True(t, IsErrType(err, ReadOnly), "%v", err)
+}
+
+func TestServerMakeReadOnly(t *testing.T) {
+	ctx := context.Background()
+	conn := &fakeConn{}
+	ts := &Server{
+		globalCell:         conn,
+		globalReadOnlyCell: conn,
+		cells:              map[string]Conn{"zone1": conn},
+	}
+	assert.False(t, ts.IsReadOnly())
+
+	ts.makeReadOnly()
+	assert.True(t, ts.IsReadOnly())
+	assert.True(t, ts.globalCell == ts.globalReadOnlyCell)
+	_, err := ts.globalCell.Create(ctx, "keyspaces/ks/Keyspace", nil)
+	assert.True(t, IsErrType(err, ReadOnly), "%v", err)
+	cellConn, err := ts.ConnForCell(ctx, "zone1")
+	assert.NoError(t, err)
+	_, err = cellConn.Create(ctx, "tablets/zone1-0000000100/Tablet", nil)
+	assert.True(t, IsErrType(err, ReadOnly), "%v", err)
+}
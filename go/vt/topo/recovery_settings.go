@@ -0,0 +1,105 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topo
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/proto"
+
+	"vitess.io/vitess/go/vt/vterrors"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// This file contains the utility methods to manage the RecoverySettings
+// object of VTOrc, in the global cell.
+
+// RecoverySettingsFile is the file name of the RecoverySettings object.
+const RecoverySettingsFile = "RecoverySettings"
+
+// GetRecoverySettings returns the RecoverySettings of VTOrc. If there are
+// none, it returns empty settings, under which all the recoveries are
+// enabled.
+func (ts *Server) GetRecoverySettings(ctx context.Context) (*topodatapb.RecoverySettings, error) {
+	settings := &topodatapb.RecoverySettings{}
+	data, _, err := ts.globalCell.Get(ctx, RecoverySettingsFile)
+	if err != nil {
+		if IsErrType(err, NoNode) {
+			return settings, nil
+		}
+		return nil, err
+	}
+	if err := proto.Unmarshal(data, settings); err != nil {
+		return nil, vterrors.Wrapf(err, "bad recovery settings data: %q", data)
+	}
+	return settings, nil
+}
+
+// UpdateRecoverySettingsFields is a high level helper method to read the
+// RecoverySettings object, update its fields, and then write it back. If
+// the write fails due to a version mismatch, it will re-read the record and
+// retry the update. If the update method returns ErrNoUpdateNeeded, nothing
+// is written. It returns the settings as written.
+func (ts *Server) UpdateRecoverySettingsFields(ctx context.Context, update func(*topodatapb.RecoverySettings) error) (*topodatapb.RecoverySettings, error) {
+	for {
+		settings := &topodatapb.RecoverySettings{}
+
+		// Read the file, unpack the contents.
+		contents, version, err := ts.globalCell.Get(ctx, RecoverySettingsFile)
+		switch {
+		case err == nil:
+			if err := proto.Unmarshal(contents, settings); err != nil {
+				return nil, vterrors.Wrapf(err, "bad recovery settings data: %q", contents)
+			}
+		case IsErrType(err, NoNode):
+			// Nothing to do.
+		default:
+			return nil, err
+		}
+
+		// Call update method.
+		if err = update(settings); err != nil {
+			if IsErrType(err, NoUpdateNeeded) {
+				return settings, nil
+			}
+			return nil, err
+		}
+
+		// Pack and save.
+		contents, err = proto.Marshal(settings)
+		if err != nil {
+			return nil, err
+		}
+		if version == nil {
+			// We have to create, and we catch NodeExists.
+			_, err = ts.globalCell.Create(ctx, RecoverySettingsFile, contents)
+			if IsErrType(err, NodeExists) {
+				continue
+			}
+		} else {
+			_, err = ts.globalCell.Update(ctx, RecoverySettingsFile, contents, version)
+			if IsErrType(err, BadVersion) {
+				continue
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		return settings, nil
+	}
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topo
+
+import (
+	"context"
+	"path"
+	"sort"
+
+	"google.golang.org/protobuf/proto"
+
+	"vitess.io/vitess/go/vt/vterrors"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// This file contains the utility methods to manage the reference tables
+// workflows, in the global cell.
+
+const referenceTablesWorkflowsPath = "reference_tables_workflows"
+
+func pathForReferenceTablesWorkflow(name string) string {
+	return path.Join(referenceTablesWorkflowsPath, name)
+}
+
+// CreateReferenceTablesWorkflow creates the reference tables workflow. It
+// returns an ALREADY_EXISTS error if a workflow of the same name exists.
+func (ts *Server) CreateReferenceTablesWorkflow(ctx context.Context, workflow *topodatapb.ReferenceTablesWorkflow) error {
+	data, err := proto.Marshal(workflow)
+	if err != nil {
+		return err
+	}
+
+	_, err = ts.globalCell.Create(ctx, pathForReferenceTablesWorkflow(workflow.Name), data)
+	if IsErrType(err, NodeExists) {
+		return vterrors.Errorf(vtrpcpb.Code_ALREADY_EXISTS, "reference tables workflow %v already exists", workflow.Name)
+	}
+	return err
+}
+
+// GetReferenceTablesWorkflow returns the reference tables workflow.
+func (ts *Server) GetReferenceTablesWorkflow(ctx context.Context, name string) (*topodatapb.ReferenceTablesWorkflow, error) {
+	data, _, err := ts.globalCell.Get(ctx, pathForReferenceTablesWorkflow(name))
+	if err != nil {
+		return nil, err
+	}
+
+	workflow := &topodatapb.ReferenceTablesWorkflow{}
+	if err := proto.Unmarshal(data, workflow); err != nil {
+		return nil, vterrors.Wrapf(err, "bad reference tables workflow data: %q", data)
+	}
+	return workflow, nil
+}
+
+// GetReferenceTablesWorkflowNames returns the names of the reference tables
+// workflows, sorted.
+func (ts *Server) GetReferenceTablesWorkflowNames(ctx context.Context) ([]string, error) {
+	entries, err := ts.globalCell.ListDir(ctx, referenceTablesWorkflowsPath, false /*full*/)
+	switch {
+	case IsErrType(err, NoNode):
+		return nil, nil
+	case err != nil:
+		return nil, err
+	}
+
+	names := DirEntriesToStringArray(entries)
+	sort.Strings(names)
+	return names, nil
+}
+
+// DeleteReferenceTablesWorkflow deletes the reference tables workflow.
+func (ts *Server) DeleteReferenceTablesWorkflow(ctx context.Context, name string) error {
+	return ts.globalCell.Delete(ctx, pathForReferenceTablesWorkflow(name), nil)
+}
@@ -141,6 +141,10 @@ type Server struct {
 	// will read the list of addresses for that cell from the
 	// global cluster and create clients as needed.
 	cells map[string]Conn
+
+	// readOnly is set for the topo servers of mounted clusters, whose
+	// connections reject all changes.
+	readOnly bool
 }
 
 type cellsToAliasesMap struct {
@@ -273,6 +277,9 @@ func (ts *Server) ConnForCell(ctx context.Context, cell string) (Conn, error) {
 	switch {
 	case err == nil:
 		conn = NewStatsConn(cell, conn)
+		if ts.readOnly {
+			conn = NewReadOnlyConn(conn)
+		}
 		ts.cells[cell] = conn
 		return conn, nil
 	case IsErrType(err, NoNode):
@@ -334,7 +341,8 @@ func (ts *Server) clearCellAliasesCache() {
 	cellsAliases.cellsToAliases = make(map[string]string)
 }
 
-// OpenExternalVitessClusterServer returns the topo server of the external cluster
+// OpenExternalVitessClusterServer returns the read-only topo server of the
+// external cluster. The caller must close it.
 func (ts *Server) OpenExternalVitessClusterServer(ctx context.Context, clusterName string) (*Server, error) {
 	vc, err := ts.GetExternalVitessCluster(ctx, clusterName)
 	if err != nil {
@@ -351,5 +359,36 @@ func (ts *Server) OpenExternalVitessClusterServer(ctx context.Context, clusterNa
 	if externalTopo == nil {
 		return nil, fmt.Errorf("unable to open external topo for config %s", clusterName)
 	}
+	// Workflows only read the topo of a mounted cluster: they act on its
+	// tablets, e.g. to stop the writes on its primaries.
+	externalTopo.makeReadOnly()
 	return externalTopo, nil
 }
+
+// makeReadOnly makes the connections of the server reject all changes.
+func (ts *Server) makeReadOnly() {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	if ts.readOnly {
+		return
+	}
+	ts.readOnly = true
+	globalCell := NewReadOnlyConn(ts.globalCell)
+	if ts.globalReadOnlyCell == ts.globalCell {
+		ts.globalReadOnlyCell = globalCell
+	} else {
+		ts.globalReadOnlyCell = NewReadOnlyConn(ts.globalReadOnlyCell)
+	}
+	ts.globalCell = globalCell
+	for cell, conn := range ts.cells {
+		ts.cells[cell] = NewReadOnlyConn(conn)
+	}
+}
+
+// IsReadOnly returns true if the server rejects all changes, e.g. because
+// it is the topo server of a mounted cluster.
+func (ts *Server) IsReadOnly() bool {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	return ts.readOnly
+}
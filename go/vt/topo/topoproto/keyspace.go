@@ -19,7 +19,11 @@ package topoproto
 import (
 	"fmt"
 	"strings"
+	"time"
 
+	"vitess.io/vitess/go/protoutil"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 )
 
@@ -64,3 +68,49 @@ func KeyspaceIDTypeString(kidType topodatapb.KeyspaceIdType) string {
 func KeyspaceIDTypeLString(kidType topodatapb.KeyspaceIdType) string {
 	return strings.ToLower(KeyspaceIDTypeString(kidType))
 }
+
+// KeyspacePolicyWorkload returns the default workload of a KeyspacePolicy,
+// or UNSPECIFIED if it has none.
+func KeyspacePolicyWorkload(policy *topodatapb.KeyspacePolicy) (querypb.ExecuteOptions_Workload, error) {
+	if policy.GetDefaultWorkload() == "" {
+		return querypb.ExecuteOptions_UNSPECIFIED, nil
+	}
+	value, ok := querypb.ExecuteOptions_Workload_value[strings.ToUpper(policy.DefaultWorkload)]
+	if !ok || value == int32(querypb.ExecuteOptions_UNSPECIFIED) {
+		return querypb.ExecuteOptions_UNSPECIFIED, fmt.Errorf("unknown workload: %v", policy.DefaultWorkload)
+	}
+	return querypb.ExecuteOptions_Workload(value), nil
+}
+
+// ValidateKeyspacePolicy returns an error if a KeyspacePolicy is invalid.
+func ValidateKeyspacePolicy(policy *topodatapb.KeyspacePolicy) error {
+	if policy.GetQueryTimeoutMs() < 0 {
+		return fmt.Errorf("negative query timeout: %v", policy.QueryTimeoutMs)
+	}
+	for _, denied := range policy.GetDeniedQueries() {
+		if denied.Fingerprint == "" && denied.User == "" {
+			return fmt.Errorf("denied query without fingerprint nor user")
+		}
+	}
+	_, err := KeyspacePolicyWorkload(policy)
+	return err
+}
+
+// KeyspacePolicyDeniedQuery returns the denied query of a KeyspacePolicy
+// that matches a query of a fingerprint and a user, and is not expired, or
+// nil if there is none.
+func KeyspacePolicyDeniedQuery(policy *topodatapb.KeyspacePolicy, fingerprint, user string, now time.Time) *topodatapb.DeniedQuery {
+	for _, denied := range policy.GetDeniedQueries() {
+		if denied.Fingerprint != "" && denied.Fingerprint != fingerprint {
+			continue
+		}
+		if denied.User != "" && denied.User != user {
+			continue
+		}
+		if expireTime := protoutil.TimeFromProto(denied.ExpireTime); !now.Before(expireTime) {
+			continue
+		}
+		return denied
+	}
+	return nil
+}
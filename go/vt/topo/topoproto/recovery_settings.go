@@ -0,0 +1,72 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topoproto
+
+import (
+	"fmt"
+	"time"
+
+	"vitess.io/vitess/go/protoutil"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// MaintenanceWindowIsActive returns true if the maintenance window applies
+// to the shard at the given time. An empty keyspace or shard in the window
+// matches all of them.
+func MaintenanceWindowIsActive(window *topodatapb.RecoverySettings_MaintenanceWindow, keyspace, shard string, now time.Time) bool {
+	if window.Keyspace != "" && window.Keyspace != keyspace {
+		return false
+	}
+	if window.Shard != "" && window.Shard != shard {
+		return false
+	}
+	return !now.Before(protoutil.TimeFromProto(window.StartTime)) && now.Before(protoutil.TimeFromProto(window.EndTime))
+}
+
+// RecoveriesDisabledReason returns why the recoveries of the shard are
+// disabled by the settings at the given time, or an empty string if they
+// are enabled.
+func RecoveriesDisabledReason(settings *topodatapb.RecoverySettings, keyspace, shard string, now time.Time) string {
+	if settings == nil {
+		return ""
+	}
+	if settings.Disabled {
+		return "recoveries are disabled for all keyspaces"
+	}
+	for _, ks := range settings.DisabledKeyspaces {
+		if ks == keyspace {
+			return fmt.Sprintf("recoveries are disabled for keyspace %v", keyspace)
+		}
+	}
+	keyspaceShard := KeyspaceShardString(keyspace, shard)
+	for _, ksShard := range settings.DisabledShards {
+		if ksShard == keyspaceShard {
+			return fmt.Sprintf("recoveries are disabled for shard %v", keyspaceShard)
+		}
+	}
+	for _, window := range settings.MaintenanceWindows {
+		if MaintenanceWindowIsActive(window, keyspace, shard, now) {
+			reason := fmt.Sprintf("maintenance window %v is active until %v", window.Name, protoutil.TimeFromProto(window.EndTime).UTC().Format(time.RFC3339))
+			if window.Reason != "" {
+				reason += ": " + window.Reason
+			}
+			return reason
+		}
+	}
+	return ""
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topoproto
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"vitess.io/vitess/go/protoutil"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func TestRecoveriesDisabledReason(t *testing.T) {
+	now := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	window := func(keyspace, shard string, start, end time.Duration) *topodatapb.RecoverySettings_MaintenanceWindow {
+		return &topodatapb.RecoverySettings_MaintenanceWindow{
+			Name:      "upgrade",
+			Keyspace:  keyspace,
+			Shard:     shard,
+			StartTime: protoutil.TimeToProto(now.Add(start)),
+			EndTime:   protoutil.TimeToProto(now.Add(end)),
+			Reason:    "mysql upgrade",
+		}
+	}
+	testcases := []struct {
+		name     string
+		settings *topodatapb.RecoverySettings
+		want     string
+	}{{
+		name:     "no settings",
+		settings: nil,
+	}, {
+		name:     "all disabled",
+		settings: &topodatapb.RecoverySettings{Disabled: true},
+		want:     "recoveries are disabled for all keyspaces",
+	}, {
+		name:     "keyspace disabled",
+		settings: &topodatapb.RecoverySettings{DisabledKeyspaces: []string{"other", "ks"}},
+		want:     "recoveries are disabled for keyspace ks",
+	}, {
+		name:     "shard disabled",
+		settings: &topodatapb.RecoverySettings{DisabledShards: []string{"ks/-80"}},
+		want:     "recoveries are disabled for shard ks/-80",
+	}, {
+		name:     "other shard disabled",
+		settings: &topodatapb.RecoverySettings{DisabledKeyspaces: []string{"other"}, DisabledShards: []string{"ks/80-"}},
+	}, {
+		name: "active window",
+		settings: &topodatapb.RecoverySettings{MaintenanceWindows: []*topodatapb.RecoverySettings_MaintenanceWindow{
+			window("ks", "", -time.Hour, time.Hour),
+		}},
+		want: "maintenance window upgrade is active until 2021-06-01T13:00:00Z: mysql upgrade",
+	}, {
+		name: "inactive windows",
+		settings: &topodatapb.RecoverySettings{MaintenanceWindows: []*topodatapb.RecoverySettings_MaintenanceWindow{
+			window("", "", -2*time.Hour, -time.Hour),
+			window("", "", time.Hour, 2*time.Hour),
+			window("other", "", -time.Hour, time.Hour),
+			window("ks", "80-", -time.Hour, time.Hour),
+		}},
+	}}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, RecoveriesDisabledReason(tc.settings, "ks", "-80", now))
+		})
+	}
+}
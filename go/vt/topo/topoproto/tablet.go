@@ -211,6 +211,17 @@ func IsTypeInList(tabletType topodatapb.TabletType, types []topodatapb.TabletTyp
 	return false
 }
 
+// TabletHasTags returns true if the tablet has all the tags, with the same
+// values.
+func TabletHasTags(tablet *topodatapb.Tablet, tags map[string]string) bool {
+	for name, value := range tags {
+		if v, ok := tablet.Tags[name]; !ok || v != value {
+			return false
+		}
+	}
+	return true
+}
+
 // MakeStringTypeList returns a list of strings that match the input list.
 func MakeStringTypeList(types []topodatapb.TabletType) []string {
 	strs := make([]string, len(types))
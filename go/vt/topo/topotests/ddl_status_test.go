@@ -0,0 +1,68 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topotests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/test/utils"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// This file tests the DDLStatus part of the topo.Server API.
+
+func TestDDLStatus(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1")
+
+	_, err := ts.GetDDLStatus(ctx, "uuid1")
+	assert.True(t, topo.IsErrType(err, topo.NoNode), "unexpected error: %v", err)
+
+	status := &topodatapb.DDLStatus{
+		Uuid:     "uuid1",
+		Keyspace: "ks",
+		Sql:      "alter table t add c int",
+		Shards: []*topodatapb.DDLShardStatus{
+			{Shard: "-80", State: topodatapb.DDLShardStatus_RUNNING},
+			{Shard: "80-", State: topodatapb.DDLShardStatus_QUEUED},
+		},
+	}
+	require.NoError(t, ts.SaveDDLStatus(ctx, status))
+	got, err := ts.GetDDLStatus(ctx, "uuid1")
+	require.NoError(t, err)
+	utils.MustMatch(t, status, got)
+
+	// Saving the status again replaces it.
+	status.Shards[0].State = topodatapb.DDLShardStatus_COMPLETE
+	status.Shards[1].State = topodatapb.DDLShardStatus_FAILED
+	status.Shards[1].Error = "duplicate column name 'c'"
+	require.NoError(t, ts.SaveDDLStatus(ctx, status))
+	got, err = ts.GetDDLStatus(ctx, "uuid1")
+	require.NoError(t, err)
+	utils.MustMatch(t, status, got)
+
+	require.NoError(t, ts.DeleteDDLStatus(ctx, "uuid1"))
+	_, err = ts.GetDDLStatus(ctx, "uuid1")
+	assert.True(t, topo.IsErrType(err, topo.NoNode), "unexpected error: %v", err)
+}
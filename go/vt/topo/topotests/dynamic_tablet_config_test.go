@@ -0,0 +1,94 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topotests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/test/utils"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// This file tests the DynamicTabletConfig part of the topo.Server API.
+
+func TestKeyspaceDynamicTabletConfig(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1")
+
+	_, _, err := ts.GetKeyspaceDynamicTabletConfig(ctx, "ks")
+	assert.True(t, topo.IsErrType(err, topo.NoNode), "unexpected error: %v", err)
+
+	config := &topodatapb.DynamicTabletConfig{PoolSize: 10, Consolidator: "disable"}
+	version, err := ts.UpdateKeyspaceDynamicTabletConfig(ctx, "ks", config, nil)
+	require.NoError(t, err)
+	got, gotVersion, err := ts.GetKeyspaceDynamicTabletConfig(ctx, "ks")
+	require.NoError(t, err)
+	utils.MustMatch(t, config, got)
+	assert.Equal(t, version.String(), gotVersion.String())
+
+	// Updates with an old version fail.
+	config.PoolSize = 20
+	_, err = ts.UpdateKeyspaceDynamicTabletConfig(ctx, "ks", config, version)
+	require.NoError(t, err)
+	_, err = ts.UpdateKeyspaceDynamicTabletConfig(ctx, "ks", config, version)
+	assert.True(t, topo.IsErrType(err, topo.BadVersion), "unexpected error: %v", err)
+
+	require.NoError(t, ts.DeleteKeyspaceDynamicTabletConfig(ctx, "ks"))
+	require.NoError(t, ts.DeleteKeyspaceDynamicTabletConfig(ctx, "ks"))
+	_, _, err = ts.GetKeyspaceDynamicTabletConfig(ctx, "ks")
+	assert.True(t, topo.IsErrType(err, topo.NoNode), "unexpected error: %v", err)
+}
+
+func TestWatchTabletDynamicTabletConfig(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1")
+	alias := &topodatapb.TabletAlias{Cell: "cell1", Uid: 100}
+
+	// Watching a missing config fails.
+	current, _, _ := ts.WatchTabletDynamicTabletConfig(ctx, alias)
+	assert.True(t, topo.IsErrType(current.Err, topo.NoNode), "unexpected error: %v", current.Err)
+
+	config := &topodatapb.DynamicTabletConfig{QueryTimeoutSeconds: 5}
+	_, err := ts.UpdateTabletDynamicTabletConfig(ctx, alias, config, nil)
+	require.NoError(t, err)
+	current, changes, cancel := ts.WatchTabletDynamicTabletConfig(ctx, alias)
+	require.NoError(t, current.Err)
+	utils.MustMatch(t, config, current.Value)
+
+	config.QueryTimeoutSeconds = 10
+	version, err := ts.UpdateTabletDynamicTabletConfig(ctx, alias, config, nil)
+	require.NoError(t, err)
+	wd := <-changes
+	require.NoError(t, wd.Err)
+	utils.MustMatch(t, config, wd.Value)
+	assert.Equal(t, version.String(), wd.Version.String())
+
+	// Deleting the config ends the watch.
+	require.NoError(t, ts.DeleteTabletDynamicTabletConfig(ctx, alias))
+	wd = <-changes
+	assert.True(t, topo.IsErrType(wd.Err, topo.NoNode), "unexpected error: %v", wd.Err)
+	cancel()
+	for range changes {
+	}
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topotests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/test/utils"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// This file tests the RecoverySettings part of the topo.Server API.
+
+func TestRecoverySettings(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1")
+
+	// Without settings, all the recoveries are enabled.
+	settings, err := ts.GetRecoverySettings(ctx)
+	require.NoError(t, err)
+	utils.MustMatch(t, &topodatapb.RecoverySettings{}, settings)
+
+	// The first update creates the file.
+	updated, err := ts.UpdateRecoverySettingsFields(ctx, func(settings *topodatapb.RecoverySettings) error {
+		settings.DisabledKeyspaces = append(settings.DisabledKeyspaces, "ks")
+		return nil
+	})
+	require.NoError(t, err)
+	utils.MustMatch(t, &topodatapb.RecoverySettings{DisabledKeyspaces: []string{"ks"}}, updated)
+
+	// The next updates start from the current settings.
+	updated, err = ts.UpdateRecoverySettingsFields(ctx, func(settings *topodatapb.RecoverySettings) error {
+		settings.DisabledShards = append(settings.DisabledShards, "ks2/-80")
+		return nil
+	})
+	require.NoError(t, err)
+	want := &topodatapb.RecoverySettings{DisabledKeyspaces: []string{"ks"}, DisabledShards: []string{"ks2/-80"}}
+	utils.MustMatch(t, want, updated)
+	settings, err = ts.GetRecoverySettings(ctx)
+	require.NoError(t, err)
+	utils.MustMatch(t, want, settings)
+
+	// NoUpdateNeeded writes nothing, and the other errors are returned.
+	updated, err = ts.UpdateRecoverySettingsFields(ctx, func(settings *topodatapb.RecoverySettings) error {
+		settings.Disabled = true
+		return topo.NewError(topo.NoUpdateNeeded, topo.RecoverySettingsFile)
+	})
+	require.NoError(t, err)
+	assert.True(t, updated.Disabled)
+	_, err = ts.UpdateRecoverySettingsFields(ctx, func(settings *topodatapb.RecoverySettings) error {
+		settings.Disabled = true
+		return assert.AnError
+	})
+	assert.Equal(t, assert.AnError, err)
+	settings, err = ts.GetRecoverySettings(ctx)
+	require.NoError(t, err)
+	utils.MustMatch(t, want, settings)
+}
@@ -0,0 +1,172 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topo
+
+import (
+	"context"
+	"path"
+	"sort"
+	"strconv"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"vitess.io/vitess/go/protoutil"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// This file provides the utility methods to save / retrieve the revisions
+// of the vschema of a keyspace in the topology global cell. Each revision
+// is a file named after its version, so that a save that races with
+// another one fails to create its revision instead of overwriting it.
+
+const (
+	vschemaRevisionsPath = "vschema_revisions"
+
+	// initialVSchemaRevisionComment is the comment of the revision that
+	// records the vschema a keyspace had before its first revision.
+	initialVSchemaRevisionComment = "vschema before the first recorded revision"
+)
+
+func pathForVSchemaRevisions(keyspace string) string {
+	return path.Join(vschemaRevisionsPath, keyspace)
+}
+
+func pathForVSchemaRevision(keyspace string, version int64) string {
+	return path.Join(pathForVSchemaRevisions(keyspace), strconv.FormatInt(version, 10))
+}
+
+// SaveVSchemaRevision saves the vschema of the keyspace, like SaveVSchema,
+// and records it as the next revision of the vschema, with the author and
+// comment. If the keyspace has no revision yet, its current vschema is
+// recorded first, so that it can be rolled back to. It returns the saved
+// revision.
+func (ts *Server) SaveVSchemaRevision(ctx context.Context, keyspace string, vschema *vschemapb.Keyspace, author, comment string) (*vschemapb.VSchemaRevision, error) {
+	versions, err := ts.getVSchemaRevisionVersions(ctx, keyspace)
+	if err != nil {
+		return nil, err
+	}
+	var last int64
+	if len(versions) != 0 {
+		last = versions[len(versions)-1]
+	} else {
+		current, err := ts.GetVSchema(ctx, keyspace)
+		switch {
+		case IsErrType(err, NoNode):
+		case err != nil:
+			return nil, err
+		default:
+			last = 1
+			if err := ts.createVSchemaRevision(ctx, keyspace, &vschemapb.VSchemaRevision{
+				Version:     last,
+				Comment:     initialVSchemaRevisionComment,
+				TimeCreated: protoutil.TimeToProto(time.Now()),
+				Keyspace:    current,
+			}); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err := ts.SaveVSchema(ctx, keyspace, vschema); err != nil {
+		return nil, err
+	}
+
+	revision := &vschemapb.VSchemaRevision{
+		Version:     last + 1,
+		Author:      author,
+		Comment:     comment,
+		TimeCreated: protoutil.TimeToProto(time.Now()),
+		Keyspace:    vschema,
+	}
+	if err := ts.createVSchemaRevision(ctx, keyspace, revision); err != nil {
+		return nil, err
+	}
+	return revision, nil
+}
+
+func (ts *Server) createVSchemaRevision(ctx context.Context, keyspace string, revision *vschemapb.VSchemaRevision) error {
+	data, err := proto.Marshal(revision)
+	if err != nil {
+		return err
+	}
+
+	_, err = ts.globalCell.Create(ctx, pathForVSchemaRevision(keyspace, revision.Version), data)
+	if IsErrType(err, NodeExists) {
+		return vterrors.Errorf(vtrpcpb.Code_ABORTED, "revision %v of the vschema of %v was saved concurrently", revision.Version, keyspace)
+	}
+	return err
+}
+
+// GetVSchemaRevisions returns the revisions of the vschema of the keyspace,
+// oldest first. A keyspace without any revision returns an empty list.
+func (ts *Server) GetVSchemaRevisions(ctx context.Context, keyspace string) ([]*vschemapb.VSchemaRevision, error) {
+	versions, err := ts.getVSchemaRevisionVersions(ctx, keyspace)
+	if err != nil {
+		return nil, err
+	}
+
+	revisions := make([]*vschemapb.VSchemaRevision, 0, len(versions))
+	for _, version := range versions {
+		revision, err := ts.GetVSchemaRevision(ctx, keyspace, version)
+		if err != nil {
+			return nil, err
+		}
+		revisions = append(revisions, revision)
+	}
+	return revisions, nil
+}
+
+// GetVSchemaRevision returns a revision of the vschema of the keyspace.
+func (ts *Server) GetVSchemaRevision(ctx context.Context, keyspace string, version int64) (*vschemapb.VSchemaRevision, error) {
+	data, _, err := ts.globalCell.Get(ctx, pathForVSchemaRevision(keyspace, version))
+	if err != nil {
+		return nil, err
+	}
+
+	revision := &vschemapb.VSchemaRevision{}
+	if err := proto.Unmarshal(data, revision); err != nil {
+		return nil, vterrors.Wrapf(err, "bad vschema revision data: %q", data)
+	}
+	return revision, nil
+}
+
+// getVSchemaRevisionVersions returns the versions of the revisions of the
+// vschema of the keyspace, in increasing order.
+func (ts *Server) getVSchemaRevisionVersions(ctx context.Context, keyspace string) ([]int64, error) {
+	entries, err := ts.globalCell.ListDir(ctx, pathForVSchemaRevisions(keyspace), false /*full*/)
+	switch {
+	case IsErrType(err, NoNode):
+		return nil, nil
+	case err != nil:
+		return nil, err
+	}
+
+	versions := make([]int64, 0, len(entries))
+	for _, entry := range entries {
+		version, err := strconv.ParseInt(entry.Name, 10, 64)
+		if err != nil {
+			continue
+		}
+		versions = append(versions, version)
+	}
+	sort.Slice(versions, func(i, j int) bool { return versions[i] < versions[j] })
+	return versions, nil
+}
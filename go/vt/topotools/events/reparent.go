@@ -23,6 +23,7 @@ import (
 	"vitess.io/vitess/go/vt/topo"
 
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
 )
 
 // Reparent is an event that describes a single step in the reparent process.
@@ -32,4 +33,7 @@ type Reparent struct {
 	ShardInfo              topo.ShardInfo
 	OldPrimary, NewPrimary *topodatapb.Tablet
 	ExternalID             string
+	// CandidateSelection explains why an emergency reparent chose the new
+	// primary.
+	CandidateSelection *vtctldatapb.EmergencyReparentCandidateSelection
 }
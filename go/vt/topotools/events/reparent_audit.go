@@ -0,0 +1,63 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package events
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"vitess.io/vitess/go/event"
+	"vitess.io/vitess/go/vt/auditlog"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+
+	auditlogdatapb "vitess.io/vitess/go/vt/proto/auditlogdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func init() {
+	event.AddListener(auditReparent)
+}
+
+// auditReparent records the outcome of a reparent to the audit log.
+func auditReparent(r *Reparent) {
+	status := r.Status
+	var errStr string
+	switch {
+	case strings.HasPrefix(status, "finished"):
+	case strings.HasPrefix(status, "failed"):
+		errStr = status
+	default:
+		// Only the outcome of a reparent is recorded, not its steps.
+		return
+	}
+
+	var oldAlias, newAlias *topodatapb.TabletAlias
+	if r.OldPrimary != nil {
+		oldAlias = r.OldPrimary.Alias
+	}
+	if r.NewPrimary != nil {
+		newAlias = r.NewPrimary.Alias
+	}
+	auditlog.Record(context.Background(), &auditlogdatapb.Event{
+		Action:    "reparent",
+		Statement: fmt.Sprintf("%v -> %v: %s", topoproto.TabletAliasString(oldAlias), topoproto.TabletAliasString(newAlias), status),
+		Keyspace:  r.ShardInfo.Keyspace(),
+		Shard:     r.ShardInfo.ShardName(),
+		Error:     errStr,
+	})
+}
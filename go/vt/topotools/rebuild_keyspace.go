@@ -93,51 +93,12 @@ func RebuildKeyspaceLocked(ctx context.Context, log logutil.Logger, ts *topo.Ser
 		default:
 			return err
 		}
-		srvKeyspaceMap[cell] = &topodatapb.SrvKeyspace{
-			ShardingColumnName: ki.ShardingColumnName,
-			ShardingColumnType: ki.ShardingColumnType,
-			ServedFrom:         ki.ComputeCellServedFrom(cell),
+		srvKeyspaceMap[cell], err = buildSrvKeyspace(ki, cell, shards, allowPartial)
+		if err != nil {
+			return err
 		}
 	}
 
-	servedTypes := []topodatapb.TabletType{topodatapb.TabletType_PRIMARY, topodatapb.TabletType_REPLICA, topodatapb.TabletType_RDONLY}
-
-	// for each entry in the srvKeyspaceMap map, we do the following:
-	// - get the Shard structures for each shard / cell
-	// - if not present, build an empty one from global Shard
-	// - sort the shards in the list by range
-	// - check the ranges are compatible (no hole, covers everything)
-	for cell, srvKeyspace := range srvKeyspaceMap {
-		for _, si := range shards {
-			// We rebuild keyspace iff shard primary is in a serving state.
-			if !si.GetIsPrimaryServing() {
-				continue
-			}
-			// for each type this shard is supposed to serve,
-			// add it to srvKeyspace.Partitions
-			for _, tabletType := range servedTypes {
-				partition := topoproto.SrvKeyspaceGetPartition(srvKeyspace, tabletType)
-				if partition == nil {
-					partition = &topodatapb.SrvKeyspace_KeyspacePartition{
-						ServedType: tabletType,
-					}
-					srvKeyspace.Partitions = append(srvKeyspace.Partitions, partition)
-				}
-				partition.ShardReferences = append(partition.ShardReferences, &topodatapb.ShardReference{
-					Name:     si.ShardName(),
-					KeyRange: si.KeyRange,
-				})
-			}
-		}
-
-		if !(ki.KeyspaceType == topodatapb.KeyspaceType_SNAPSHOT && allowPartial) {
-			// skip this check for SNAPSHOT keyspaces so that incomplete keyspaces can still serve
-			if err := topo.OrderAndCheckPartitions(cell, srvKeyspace); err != nil {
-				return err
-			}
-		}
-
-	}
 	// And then finally save the keyspace objects, in parallel.
 	rec := concurrency.AllErrorRecorder{}
 	wg := sync.WaitGroup{}
@@ -153,3 +114,53 @@ func RebuildKeyspaceLocked(ctx context.Context, log logutil.Logger, ts *topo.Ser
 	wg.Wait()
 	return rec.Error()
 }
+
+// buildSrvKeyspace builds the SrvKeyspace of the cell from the global
+// keyspace and shards.
+func buildSrvKeyspace(ki *topo.KeyspaceInfo, cell string, shards map[string]*topo.ShardInfo, allowPartial bool) (*topodatapb.SrvKeyspace, error) {
+	srvKeyspace := &topodatapb.SrvKeyspace{
+		ShardingColumnName: ki.ShardingColumnName,
+		ShardingColumnType: ki.ShardingColumnType,
+		ServedFrom:         ki.ComputeCellServedFrom(cell),
+		ThrottlerConfig:    ki.ThrottlerConfig,
+		Policy:             ki.Policy,
+		QueryRules:         ki.QueryRules,
+	}
+
+	servedTypes := []topodatapb.TabletType{topodatapb.TabletType_PRIMARY, topodatapb.TabletType_REPLICA, topodatapb.TabletType_RDONLY}
+
+	// we do the following:
+	// - get the Shard structures for each shard / cell
+	// - if not present, build an empty one from global Shard
+	// - sort the shards in the list by range
+	// - check the ranges are compatible (no hole, covers everything)
+	for _, si := range shards {
+		// We rebuild keyspace iff shard primary is in a serving state.
+		if !si.GetIsPrimaryServing() {
+			continue
+		}
+		// for each type this shard is supposed to serve,
+		// add it to srvKeyspace.Partitions
+		for _, tabletType := range servedTypes {
+			partition := topoproto.SrvKeyspaceGetPartition(srvKeyspace, tabletType)
+			if partition == nil {
+				partition = &topodatapb.SrvKeyspace_KeyspacePartition{
+					ServedType: tabletType,
+				}
+				srvKeyspace.Partitions = append(srvKeyspace.Partitions, partition)
+			}
+			partition.ShardReferences = append(partition.ShardReferences, &topodatapb.ShardReference{
+				Name:     si.ShardName(),
+				KeyRange: si.KeyRange,
+			})
+		}
+	}
+
+	if !(ki.KeyspaceType == topodatapb.KeyspaceType_SNAPSHOT && allowPartial) {
+		// skip this check for SNAPSHOT keyspaces so that incomplete keyspaces can still serve
+		if err := topo.OrderAndCheckPartitions(cell, srvKeyspace); err != nil {
+			return nil, err
+		}
+	}
+	return srvKeyspace, nil
+}
@@ -0,0 +1,224 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topotools
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// ServingGraphDivergence is a SrvKeyspace or SrvVSchema object of a cell
+// that is not the one that the global topo gives.
+type ServingGraphDivergence struct {
+	Cell string
+	// Keyspace is the keyspace of a SrvKeyspace, and is empty for the
+	// SrvVSchema of the cell.
+	Keyspace    string
+	Description string
+}
+
+// SrvKeyspaceDivergences returns the SrvKeyspace objects of the keyspace,
+// in the cells or in all the cells if empty, that are not those that
+// RebuildKeyspace would save. The cells where the partitions of the
+// keyspace are being migrated, and so have the query service disabled in
+// their tablet controls, are skipped, as is the keyspace if its serving
+// shards do not cover the key range: RebuildKeyspace refuses to rebuild
+// those.
+func SrvKeyspaceDivergences(ctx context.Context, ts *topo.Server, keyspace string, cells []string) ([]*ServingGraphDivergence, error) {
+	ki, err := ts.GetKeyspace(ctx, keyspace)
+	if err != nil {
+		return nil, err
+	}
+	if len(cells) == 0 {
+		cells, err = ts.GetCellInfoNames(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+	shards, err := ts.FindAllShardsInKeyspace(ctx, keyspace)
+	if err != nil {
+		return nil, err
+	}
+
+	var divergences []*ServingGraphDivergence
+	for _, cell := range cells {
+		want, err := buildSrvKeyspace(ki, cell, shards, false)
+		if err != nil {
+			log.Warningf("cannot build the SrvKeyspace of %v in %v, skipping it: %v", keyspace, cell, err)
+			continue
+		}
+		got, err := ts.GetSrvKeyspace(ctx, cell, keyspace)
+		switch {
+		case topo.IsErrType(err, topo.NoNode):
+			if len(want.Partitions) != 0 {
+				divergences = append(divergences, &ServingGraphDivergence{
+					Cell:        cell,
+					Keyspace:    keyspace,
+					Description: "SrvKeyspace is missing",
+				})
+			}
+			continue
+		case err != nil:
+			return nil, err
+		}
+		if isQueryServiceDisabled(got) {
+			continue
+		}
+		if description := describeSrvKeyspaceDiff(got, want); description != "" {
+			divergences = append(divergences, &ServingGraphDivergence{
+				Cell:        cell,
+				Keyspace:    keyspace,
+				Description: description,
+			})
+		}
+	}
+	return divergences, nil
+}
+
+// SrvVSchemaDivergences returns the SrvVSchema objects of the cells, or of
+// all the cells if empty, that are not those that RebuildSrvVSchema would
+// save.
+func SrvVSchemaDivergences(ctx context.Context, ts *topo.Server, cells []string) ([]*ServingGraphDivergence, error) {
+	var err error
+	if len(cells) == 0 {
+		cells, err = ts.GetKnownCells(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+	want, err := ts.BuildSrvVSchema(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var divergences []*ServingGraphDivergence
+	for _, cell := range cells {
+		got, err := ts.GetSrvVSchema(ctx, cell)
+		switch {
+		case topo.IsErrType(err, topo.NoNode):
+			divergences = append(divergences, &ServingGraphDivergence{
+				Cell:        cell,
+				Description: "SrvVSchema is missing",
+			})
+			continue
+		case err != nil:
+			return nil, err
+		}
+		if proto.Equal(got, want) {
+			continue
+		}
+
+		var diffs []string
+		var keyspaces []string
+		for keyspace, ks := range want.Keyspaces {
+			if !proto.Equal(got.Keyspaces[keyspace], ks) {
+				keyspaces = append(keyspaces, keyspace)
+			}
+		}
+		for keyspace := range got.Keyspaces {
+			if _, ok := want.Keyspaces[keyspace]; !ok {
+				keyspaces = append(keyspaces, keyspace)
+			}
+		}
+		if len(keyspaces) != 0 {
+			sort.Strings(keyspaces)
+			diffs = append(diffs, fmt.Sprintf("the VSchema of %s", strings.Join(keyspaces, ", ")))
+		}
+		if !proto.Equal(got.RoutingRules, want.RoutingRules) {
+			diffs = append(diffs, "the routing rules")
+		}
+		description := "SrvVSchema differs from the global topo"
+		if len(diffs) != 0 {
+			description += " in " + strings.Join(diffs, " and ")
+		}
+		divergences = append(divergences, &ServingGraphDivergence{
+			Cell:        cell,
+			Description: description,
+		})
+	}
+	return divergences, nil
+}
+
+func isQueryServiceDisabled(srvKeyspace *topodatapb.SrvKeyspace) bool {
+	for _, partition := range srvKeyspace.GetPartitions() {
+		for _, shardTabletControl := range partition.GetShardTabletControls() {
+			if shardTabletControl.QueryServiceDisabled {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// describeSrvKeyspaceDiff describes how got is not want, or returns the
+// empty string if it is. The order of the partitions and shards, and the
+// tablet controls, which RebuildKeyspace does not set, are ignored.
+func describeSrvKeyspaceDiff(got, want *topodatapb.SrvKeyspace) string {
+	got, want = normalizeSrvKeyspace(got), normalizeSrvKeyspace(want)
+	if proto.Equal(got, want) {
+		return ""
+	}
+
+	var diffs []string
+	for _, tabletType := range []topodatapb.TabletType{topodatapb.TabletType_PRIMARY, topodatapb.TabletType_REPLICA, topodatapb.TabletType_RDONLY} {
+		gotShards := partitionShards(got, tabletType)
+		wantShards := partitionShards(want, tabletType)
+		if gotShards != wantShards {
+			diffs = append(diffs, fmt.Sprintf("%v is served by [%s] instead of [%s]", tabletType, gotShards, wantShards))
+		}
+	}
+	if len(diffs) == 0 {
+		return "SrvKeyspace differs from the global topo"
+	}
+	return strings.Join(diffs, ", ")
+}
+
+func normalizeSrvKeyspace(srvKeyspace *topodatapb.SrvKeyspace) *topodatapb.SrvKeyspace {
+	srvKeyspace = proto.Clone(srvKeyspace).(*topodatapb.SrvKeyspace)
+	for _, partition := range srvKeyspace.Partitions {
+		partition.ShardTabletControls = nil
+		sort.Slice(partition.ShardReferences, func(i, j int) bool {
+			return partition.ShardReferences[i].Name < partition.ShardReferences[j].Name
+		})
+	}
+	sort.Slice(srvKeyspace.Partitions, func(i, j int) bool {
+		return srvKeyspace.Partitions[i].ServedType < srvKeyspace.Partitions[j].ServedType
+	})
+	return srvKeyspace
+}
+
+func partitionShards(srvKeyspace *topodatapb.SrvKeyspace, tabletType topodatapb.TabletType) string {
+	partition := topoproto.SrvKeyspaceGetPartition(srvKeyspace, tabletType)
+	if partition == nil {
+		return ""
+	}
+	names := make([]string, 0, len(partition.ShardReferences))
+	for _, shardReference := range partition.ShardReferences {
+		names = append(names, shardReference.Name)
+	}
+	return strings.Join(names, " ")
+}
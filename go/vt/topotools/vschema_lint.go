@@ -0,0 +1,340 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topotools
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/mysqlctl/tmutils"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+// The vindex types whose columns must be integers, or text.
+var (
+	integralVindexTypes = map[string]bool{
+		"hash":               true,
+		"numeric":            true,
+		"numeric_static_map": true,
+		"reverse_bits":       true,
+	}
+	textVindexTypes = map[string]bool{
+		"unicode_loose_md5":    true,
+		"unicode_loose_xxhash": true,
+	}
+)
+
+// LintVSchema checks the vschema of the keyspace, on its own and against
+// the schemas of the primaries of its shards, keyed by shard, and returns
+// its findings. Vschemas are the vschemas of all the keyspaces, which the
+// sequences and lookup tables of the vschema of the keyspace may be in. A
+// finding that holds for several shards is returned once, with all of them.
+func LintVSchema(keyspace string, vschemas map[string]*vschemapb.Keyspace, schemas map[string]*tabletmanagerdatapb.SchemaDefinition) []*vtctldatapb.VSchemaFinding {
+	l := &vschemaLinter{
+		keyspace: keyspace,
+		vschema:  vschemas[keyspace],
+		vschemas: vschemas,
+		findings: make(map[string]*vtctldatapb.VSchemaFinding),
+	}
+	if l.vschema == nil {
+		l.vschema = &vschemapb.Keyspace{}
+	}
+
+	l.lintVindexes()
+	l.lintSequences()
+	shards := make([]string, 0, len(schemas))
+	for shard := range schemas {
+		shards = append(shards, shard)
+	}
+	sort.Strings(shards)
+	for _, shard := range shards {
+		l.lintShard(shard, schemas[shard])
+	}
+
+	findings := make([]*vtctldatapb.VSchemaFinding, 0, len(l.findings))
+	for _, finding := range l.findings {
+		findings = append(findings, finding)
+	}
+	sort.Slice(findings, func(i, j int) bool {
+		a, b := findings[i], findings[j]
+		switch {
+		case a.Kind != b.Kind:
+			return a.Kind < b.Kind
+		case a.Table != b.Table:
+			return a.Table < b.Table
+		case a.Column != b.Column:
+			return a.Column < b.Column
+		case a.Vindex != b.Vindex:
+			return a.Vindex < b.Vindex
+		}
+		return a.Message < b.Message
+	})
+	return findings
+}
+
+type vschemaLinter struct {
+	keyspace string
+	vschema  *vschemapb.Keyspace
+	vschemas map[string]*vschemapb.Keyspace
+	// findings are keyed by everything but their shards.
+	findings map[string]*vtctldatapb.VSchemaFinding
+}
+
+// add records the finding, for the shard if not empty.
+func (l *vschemaLinter) add(shard string, finding *vtctldatapb.VSchemaFinding) {
+	key := fmt.Sprintf("%d/%s/%s/%s/%s", finding.Kind, finding.Table, finding.Column, finding.Vindex, finding.Message)
+	if existing, ok := l.findings[key]; ok {
+		finding = existing
+	} else {
+		l.findings[key] = finding
+	}
+	if shard != "" {
+		finding.Shards = append(finding.Shards, shard)
+	}
+}
+
+func (l *vschemaLinter) lintVindexes() {
+	for name, vindex := range l.vschema.Vindexes {
+		if !strings.HasPrefix(vindex.Type, "lookup") && !strings.HasPrefix(vindex.Type, "consistent_lookup") {
+			continue
+		}
+		lookupTable := vindex.Params["table"]
+		if vindex.Owner == "" {
+			l.add("", &vtctldatapb.VSchemaFinding{
+				Kind:       vtctldatapb.VSchemaFinding_UNOWNED_LOOKUP_VINDEX,
+				Vindex:     name,
+				Message:    fmt.Sprintf("lookup vindex %s has no owner, so vtgate does not maintain its lookup table %s", name, lookupTable),
+				Suggestion: "set the owner of the vindex to the table whose rows the lookup table maps, unless the lookup table is maintained outside of vtgate",
+			})
+		}
+		if lookupTable != "" && !l.tableExists(lookupTable, "") {
+			l.add("", &vtctldatapb.VSchemaFinding{
+				Kind:       vtctldatapb.VSchemaFinding_LOOKUP_TABLE_NOT_FOUND,
+				Table:      lookupTable,
+				Vindex:     name,
+				Message:    fmt.Sprintf("the lookup table %s of vindex %s is not in any vschema", lookupTable, name),
+				Suggestion: "add the lookup table to the vschema of its keyspace, or fix the table parameter of the vindex",
+			})
+		}
+	}
+}
+
+func (l *vschemaLinter) lintSequences() {
+	for name, table := range l.vschema.Tables {
+		if autoInc := table.AutoIncrement; autoInc != nil && !l.tableExists(autoInc.Sequence, vindexes.TypeSequence) {
+			l.add("", &vtctldatapb.VSchemaFinding{
+				Kind:       vtctldatapb.VSchemaFinding_SEQUENCE_NOT_FOUND,
+				Table:      name,
+				Column:     autoInc.Column,
+				Message:    fmt.Sprintf("the auto-increment sequence %s of table %s is not a sequence table of any vschema", autoInc.Sequence, name),
+				Suggestion: "add the sequence table to the vschema of an unsharded keyspace, with the sequence type",
+			})
+		}
+		if table.Type == vindexes.TypeSequence && !l.sequenceUsed(name) {
+			l.add("", &vtctldatapb.VSchemaFinding{
+				Kind:       vtctldatapb.VSchemaFinding_UNUSED_SEQUENCE,
+				Table:      name,
+				Message:    fmt.Sprintf("sequence %s is not the auto-increment sequence of any table", name),
+				Suggestion: "set it as the sequence of the auto-increment of the table it generates ids for, or remove it from the vschema",
+			})
+		}
+	}
+}
+
+func (l *vschemaLinter) lintShard(shard string, sd *tabletmanagerdatapb.SchemaDefinition) {
+	tds := make(map[string]*tabletmanagerdatapb.TableDefinition, len(sd.GetTableDefinitions()))
+	for _, td := range sd.GetTableDefinitions() {
+		tds[td.Name] = td
+	}
+
+	for name, table := range l.vschema.Tables {
+		td, ok := tds[name]
+		if !ok {
+			l.add(shard, &vtctldatapb.VSchemaFinding{
+				Kind:       vtctldatapb.VSchemaFinding_TABLE_NOT_FOUND,
+				Table:      name,
+				Message:    fmt.Sprintf("table %s of the vschema is not in the schema", name),
+				Suggestion: "create the table, or remove it from the vschema",
+			})
+			continue
+		}
+
+		for _, cv := range table.ColumnVindexes {
+			columns := cv.Columns
+			if cv.Column != "" {
+				columns = []string{cv.Column}
+			}
+			for i, column := range columns {
+				field := findField(td, column)
+				if field == nil {
+					l.add(shard, &vtctldatapb.VSchemaFinding{
+						Kind:       vtctldatapb.VSchemaFinding_COLUMN_NOT_FOUND,
+						Table:      name,
+						Column:     column,
+						Vindex:     cv.Name,
+						Message:    fmt.Sprintf("column %s of vindex %s is not in table %s", column, cv.Name, name),
+						Suggestion: "fix the column of the vindex, or add the column to the table",
+					})
+					continue
+				}
+				// Only the first column of a multi-column vindex is mapped by
+				// its type.
+				if i == 0 {
+					l.lintVindexColumnType(shard, name, cv.Name, field)
+				}
+			}
+		}
+
+		if autoInc := table.AutoIncrement; autoInc != nil && findField(td, autoInc.Column) == nil {
+			l.add(shard, &vtctldatapb.VSchemaFinding{
+				Kind:       vtctldatapb.VSchemaFinding_COLUMN_NOT_FOUND,
+				Table:      name,
+				Column:     autoInc.Column,
+				Message:    fmt.Sprintf("auto-increment column %s is not in table %s", autoInc.Column, name),
+				Suggestion: "fix the column of the auto-increment, or add the column to the table",
+			})
+		}
+	}
+
+	if !l.vschema.Sharded {
+		// vtgate routes the tables that are not in the vschema of an
+		// unsharded keyspace to it, and their ids cannot conflict.
+		return
+	}
+	for _, td := range sd.GetTableDefinitions() {
+		if td.Type == tmutils.TableView {
+			continue
+		}
+		table, ok := l.vschema.Tables[td.Name]
+		if !ok {
+			l.add(shard, &vtctldatapb.VSchemaFinding{
+				Kind:       vtctldatapb.VSchemaFinding_TABLE_NOT_IN_VSCHEMA,
+				Table:      td.Name,
+				Message:    fmt.Sprintf("table %s is not in the vschema of the sharded keyspace", td.Name),
+				Suggestion: "add the table to the vschema, with its primary vindex",
+			})
+		}
+		column := mysqlAutoIncrementColumn(td)
+		if column == "" || (table.GetAutoIncrement() != nil && strings.EqualFold(table.AutoIncrement.Column, column)) {
+			continue
+		}
+		l.add(shard, &vtctldatapb.VSchemaFinding{
+			Kind:       vtctldatapb.VSchemaFinding_AUTO_INCREMENT_CONFLICT,
+			Table:      td.Name,
+			Column:     column,
+			Message:    fmt.Sprintf("column %s of table %s is AUTO_INCREMENT, so each shard generates its own values, which conflict across the shards", column, td.Name),
+			Suggestion: "generate the values of the column with a sequence: add a sequence table to an unsharded keyspace, and an auto-increment with it to the table of the vschema",
+		})
+	}
+}
+
+func (l *vschemaLinter) lintVindexColumnType(shard string, table string, vindexName string, field *querypb.Field) {
+	vindex := l.vschema.Vindexes[vindexName]
+	if vindex == nil {
+		return
+	}
+	var kind string
+	switch {
+	case integralVindexTypes[vindex.Type] && !sqltypes.IsIntegral(field.Type):
+		kind = "integers"
+	case textVindexTypes[vindex.Type] && !sqltypes.IsText(field.Type) && !sqltypes.IsBinary(field.Type):
+		kind = "text"
+	default:
+		return
+	}
+	l.add(shard, &vtctldatapb.VSchemaFinding{
+		Kind:       vtctldatapb.VSchemaFinding_VINDEX_COLUMN_TYPE_MISMATCH,
+		Table:      table,
+		Column:     field.Name,
+		Vindex:     vindexName,
+		Message:    fmt.Sprintf("column %s of table %s is %v, but vindex %s of type %s maps %s", field.Name, table, field.Type, vindexName, vindex.Type, kind),
+		Suggestion: "use a vindex type that suits the type of the column, such as xxhash or binary_md5, which map any type",
+	})
+}
+
+// tableExists returns true if the table, which may be qualified by its
+// keyspace, is in a vschema, with the table type if not empty.
+func (l *vschemaLinter) tableExists(name string, tableType string) bool {
+	keyspace, table, err := sqlparser.ParseTable(name)
+	if err != nil {
+		return false
+	}
+	for ks, vschema := range l.vschemas {
+		if keyspace != "" && ks != keyspace {
+			continue
+		}
+		if t, ok := vschema.GetTables()[table]; ok && (tableType == "" || t.Type == tableType) {
+			return true
+		}
+	}
+	return false
+}
+
+// sequenceUsed returns true if the sequence table of the keyspace is the
+// sequence of an auto-increment of any vschema.
+func (l *vschemaLinter) sequenceUsed(sequence string) bool {
+	for _, vschema := range l.vschemas {
+		for _, table := range vschema.GetTables() {
+			if table.AutoIncrement == nil {
+				continue
+			}
+			keyspace, name, err := sqlparser.ParseTable(table.AutoIncrement.Sequence)
+			if err == nil && name == sequence && (keyspace == "" || keyspace == l.keyspace) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// findField returns the field of the column of the table, whose names are
+// not case sensitive.
+func findField(td *tabletmanagerdatapb.TableDefinition, column string) *querypb.Field {
+	for _, field := range td.Fields {
+		if strings.EqualFold(field.Name, column) {
+			return field
+		}
+	}
+	return nil
+}
+
+// mysqlAutoIncrementColumn returns the AUTO_INCREMENT column of the table,
+// or the empty string if it has none.
+func mysqlAutoIncrementColumn(td *tabletmanagerdatapb.TableDefinition) string {
+	stmt, err := sqlparser.ParseStrictDDL(td.Schema)
+	if err != nil {
+		return ""
+	}
+	create, ok := stmt.(*sqlparser.CreateTable)
+	if !ok || create.TableSpec == nil {
+		return ""
+	}
+	for _, column := range create.TableSpec.Columns {
+		if column.Type.Options != nil && column.Type.Options.Autoincrement {
+			return column.Name.String()
+		}
+	}
+	return ""
+}
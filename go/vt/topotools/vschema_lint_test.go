@@ -0,0 +1,148 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topotools
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+func TestLintVSchema(t *testing.T) {
+	vschemas := map[string]*vschemapb.Keyspace{
+		"ks": {
+			Sharded: true,
+			Vindexes: map[string]*vschemapb.Vindex{
+				"hash": {Type: "hash"},
+				"unicode": {
+					Type: "unicode_loose_md5",
+				},
+				"name_lookup": {
+					Type:   "lookup_unique",
+					Params: map[string]string{"table": "unsharded.name_lookup", "from": "name", "to": "keyspace_id"},
+				},
+				"email_lookup": {
+					Type:   "consistent_lookup",
+					Params: map[string]string{"table": "unsharded.email_lookup", "from": "email", "to": "keyspace_id"},
+					Owner:  "users",
+				},
+			},
+			Tables: map[string]*vschemapb.Table{
+				"users": {
+					ColumnVindexes: []*vschemapb.ColumnVindex{
+						{Column: "id", Name: "hash"},
+						{Column: "name", Name: "name_lookup"},
+						{Column: "email", Name: "email_lookup"},
+					},
+					AutoIncrement: &vschemapb.AutoIncrement{Column: "id", Sequence: "unsharded.users_seq"},
+				},
+				"orders": {
+					ColumnVindexes: []*vschemapb.ColumnVindex{
+						{Column: "user_name", Name: "hash"},
+					},
+					AutoIncrement: &vschemapb.AutoIncrement{Column: "order_id", Sequence: "orders_seq"},
+				},
+				"notes": {
+					ColumnVindexes: []*vschemapb.ColumnVindex{
+						{Column: "id", Name: "unicode"},
+					},
+				},
+				"missing": {
+					ColumnVindexes: []*vschemapb.ColumnVindex{
+						{Column: "id", Name: "hash"},
+					},
+				},
+			},
+		},
+		"unsharded": {
+			Tables: map[string]*vschemapb.Table{
+				"users_seq":    {Type: "sequence"},
+				"unused_seq":   {Type: "sequence"},
+				"name_lookup":  {},
+				"email_lookup": {},
+			},
+		},
+	}
+
+	int64Field := func(name string) *querypb.Field { return &querypb.Field{Name: name, Type: sqltypes.Int64} }
+	varcharField := func(name string) *querypb.Field { return &querypb.Field{Name: name, Type: sqltypes.VarChar} }
+	schema := &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{{
+			Name:   "users",
+			Schema: "create table users (id bigint, name varchar(64), email varchar(64), primary key (id))",
+			Fields: []*querypb.Field{int64Field("id"), varcharField("name"), varcharField("email")},
+		}, {
+			Name:   "orders",
+			Schema: "create table orders (id bigint auto_increment, user_name varchar(64), primary key (id))",
+			Fields: []*querypb.Field{int64Field("id"), varcharField("user_name")},
+		}, {
+			Name:   "notes",
+			Schema: "create table notes (id bigint, primary key (id))",
+			Fields: []*querypb.Field{int64Field("id")},
+		}, {
+			Name:   "logs",
+			Schema: "create table logs (id bigint, primary key (id))",
+			Fields: []*querypb.Field{int64Field("id")},
+		}},
+	}
+
+	findings := LintVSchema("ks", vschemas, map[string]*tabletmanagerdatapb.SchemaDefinition{
+		"-80": schema,
+		"80-": schema,
+	})
+
+	var kinds []vtctldatapb.VSchemaFinding_Kind
+	for _, finding := range findings {
+		kinds = append(kinds, finding.Kind)
+	}
+	assert.Equal(t, []vtctldatapb.VSchemaFinding_Kind{
+		vtctldatapb.VSchemaFinding_TABLE_NOT_FOUND,
+		vtctldatapb.VSchemaFinding_TABLE_NOT_IN_VSCHEMA,
+		vtctldatapb.VSchemaFinding_COLUMN_NOT_FOUND,
+		vtctldatapb.VSchemaFinding_VINDEX_COLUMN_TYPE_MISMATCH,
+		vtctldatapb.VSchemaFinding_VINDEX_COLUMN_TYPE_MISMATCH,
+		vtctldatapb.VSchemaFinding_SEQUENCE_NOT_FOUND,
+		vtctldatapb.VSchemaFinding_UNOWNED_LOOKUP_VINDEX,
+		vtctldatapb.VSchemaFinding_AUTO_INCREMENT_CONFLICT,
+	}, kinds)
+
+	assert.Equal(t, "missing", findings[0].Table)
+	assert.Equal(t, []string{"-80", "80-"}, findings[0].Shards)
+	assert.Equal(t, "logs", findings[1].Table)
+	assert.Equal(t, "order_id", findings[2].Column)
+	assert.Equal(t, "column id of table notes is INT64, but vindex unicode of type unicode_loose_md5 maps text", findings[3].Message)
+	assert.Equal(t, "column user_name of table orders is VARCHAR, but vindex hash of type hash maps integers", findings[4].Message)
+	assert.Equal(t, "orders", findings[5].Table)
+	assert.Empty(t, findings[5].Shards)
+	assert.Equal(t, "name_lookup", findings[6].Vindex)
+	assert.Equal(t, "orders", findings[7].Table)
+	assert.Equal(t, "id", findings[7].Column)
+
+	// The unused sequence is a finding of its own keyspace.
+	findings = LintVSchema("unsharded", vschemas, nil)
+	if assert.Len(t, findings, 1) {
+		assert.Equal(t, vtctldatapb.VSchemaFinding_UNUSED_SEQUENCE, findings[0].Kind)
+		assert.Equal(t, "unused_seq", findings[0].Table)
+	}
+}
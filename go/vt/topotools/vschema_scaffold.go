@@ -0,0 +1,351 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topotools
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/mysqlctl/tmutils"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+// The scaffolding of a sharded vschema picks the primary vindex column of
+// each table, in this order of preference:
+//   - the column of a foreign key to a parent table that is sharded by the
+//     referenced column, so that the rows of the table live on the shards
+//     of their parent rows, unless the queries filter on another column
+//     more often,
+//   - the column that the queries filter on, or join on, most often,
+//   - the primary key, if it has a single column.
+// The small tables that other tables reference, and that reference none,
+// are suggested as reference tables instead.
+
+// referenceTableMaxRows is the number of rows up to which a table that
+// other tables reference is suggested as a reference table.
+const referenceTableMaxRows = 1000
+
+// ScaffoldVSchema suggests a sharded vschema for the tables of the schema
+// of an unsharded keyspace, from their primary keys and foreign keys, and
+// from the predicates of the sample of queries. The queries that do not
+// parse are skipped.
+func ScaffoldVSchema(sd *tabletmanagerdatapb.SchemaDefinition, queries []string) *vtctldatapb.ScaffoldVSchemaResponse {
+	s := &vschemaScaffolder{
+		tables:     make(map[string]*scaffoldTable),
+		predicates: make(map[string]map[string]int),
+		vschema: &vschemapb.Keyspace{
+			Sharded:  true,
+			Vindexes: make(map[string]*vschemapb.Vindex),
+			Tables:   make(map[string]*vschemapb.Table),
+		},
+	}
+	for _, td := range sd.GetTableDefinitions() {
+		if td.Type == tmutils.TableView {
+			continue
+		}
+		s.tables[td.Name] = newScaffoldTable(td)
+	}
+	for _, t := range s.tables {
+		for _, fk := range t.foreignKeys {
+			if parent, ok := s.tables[fk.table]; ok && parent != t {
+				parent.referencedBy++
+			}
+		}
+	}
+	for _, query := range queries {
+		stmt, err := sqlparser.Parse(query)
+		if err != nil {
+			log.Warningf("ScaffoldVSchema: skipping query %q: %v", query, err)
+			continue
+		}
+		s.countPredicates(stmt)
+	}
+
+	names := make([]string, 0, len(s.tables))
+	for name := range s.tables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		s.scaffold(s.tables[name])
+	}
+
+	resp := &vtctldatapb.ScaffoldVSchemaResponse{VSchema: s.vschema}
+	for _, name := range names {
+		resp.Tables = append(resp.Tables, s.tables[name].suggestion)
+	}
+	return resp
+}
+
+type vschemaScaffolder struct {
+	tables map[string]*scaffoldTable
+	// predicates counts, by table and lowercase column, the predicates of
+	// the queries that compare the column to values or join on it.
+	predicates map[string]map[string]int
+	vschema    *vschemapb.Keyspace
+}
+
+type scaffoldTable struct {
+	td            *tabletmanagerdatapb.TableDefinition
+	foreignKeys   []scaffoldForeignKey
+	autoIncrement string
+	referencedBy  int
+
+	visiting   bool
+	suggestion *vtctldatapb.ScaffoldVSchemaResponse_TableSuggestion
+}
+
+// scaffoldForeignKey is a single-column foreign key.
+type scaffoldForeignKey struct {
+	column, table, referencedColumn string
+}
+
+func newScaffoldTable(td *tabletmanagerdatapb.TableDefinition) *scaffoldTable {
+	t := &scaffoldTable{
+		td:            td,
+		autoIncrement: mysqlAutoIncrementColumn(td),
+	}
+	stmt, err := sqlparser.ParseStrictDDL(td.Schema)
+	if err != nil {
+		return t
+	}
+	create, ok := stmt.(*sqlparser.CreateTable)
+	if !ok || create.TableSpec == nil {
+		return t
+	}
+	for _, constraint := range create.TableSpec.Constraints {
+		fk, ok := constraint.Details.(*sqlparser.ForeignKeyDefinition)
+		if !ok || len(fk.Source) != 1 || len(fk.ReferenceDefinition.ReferencedColumns) != 1 {
+			continue
+		}
+		t.foreignKeys = append(t.foreignKeys, scaffoldForeignKey{
+			column:           fk.Source[0].String(),
+			table:            fk.ReferenceDefinition.ReferencedTable.Name.String(),
+			referencedColumn: fk.ReferenceDefinition.ReferencedColumns[0].String(),
+		})
+	}
+	return t
+}
+
+// countPredicates counts the columns that the statement compares to values,
+// or joins on.
+func (s *vschemaScaffolder) countPredicates(stmt sqlparser.Statement) {
+	switch stmt.(type) {
+	case *sqlparser.Select, *sqlparser.Union, *sqlparser.Update, *sqlparser.Delete:
+	default:
+		return
+	}
+
+	// The tables of the statement, by alias, and in order.
+	aliases := make(map[string]string)
+	var tables []string
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if ate, ok := node.(*sqlparser.AliasedTableExpr); ok {
+			if tableName, ok := ate.Expr.(sqlparser.TableName); ok {
+				name := tableName.Name.String()
+				alias := name
+				if !ate.As.IsEmpty() {
+					alias = ate.As.String()
+				}
+				aliases[alias] = name
+				tables = append(tables, name)
+			}
+		}
+		return true, nil
+	}, stmt)
+
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		cmp, ok := node.(*sqlparser.ComparisonExpr)
+		if !ok || (cmp.Operator != sqlparser.EqualOp && cmp.Operator != sqlparser.InOp) {
+			return true, nil
+		}
+		left, leftIsCol := cmp.Left.(*sqlparser.ColName)
+		right, rightIsCol := cmp.Right.(*sqlparser.ColName)
+		switch {
+		case leftIsCol && rightIsCol:
+			s.countColumn(aliases, tables, left)
+			s.countColumn(aliases, tables, right)
+		case leftIsCol && isValueExpr(cmp.Right):
+			s.countColumn(aliases, tables, left)
+		case rightIsCol && isValueExpr(cmp.Left):
+			s.countColumn(aliases, tables, right)
+		}
+		return true, nil
+	}, stmt)
+}
+
+func (s *vschemaScaffolder) countColumn(aliases map[string]string, tables []string, col *sqlparser.ColName) {
+	column := col.Name.Lowered()
+	var table string
+	if qualifier := col.Qualifier.Name.String(); qualifier != "" {
+		table = aliases[qualifier]
+	} else {
+		// An unqualified column is of the only table of the statement that
+		// has it.
+		for _, name := range tables {
+			if t, ok := s.tables[name]; ok && t.hasColumn(column) {
+				if table != "" && table != name {
+					return
+				}
+				table = name
+			}
+		}
+	}
+	if t, ok := s.tables[table]; !ok || !t.hasColumn(column) {
+		return
+	}
+	if s.predicates[table] == nil {
+		s.predicates[table] = make(map[string]int)
+	}
+	s.predicates[table][column]++
+}
+
+func isValueExpr(expr sqlparser.Expr) bool {
+	switch expr := expr.(type) {
+	case *sqlparser.Literal, sqlparser.Argument, sqlparser.ListArg:
+		return true
+	case sqlparser.ValTuple:
+		for _, e := range expr {
+			if !isValueExpr(e) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+func (t *scaffoldTable) hasColumn(column string) bool {
+	return findField(t.td, column) != nil
+}
+
+// scaffold suggests the primary vindex of the table, once those of the
+// tables it references are suggested.
+func (s *vschemaScaffolder) scaffold(t *scaffoldTable) {
+	if t.suggestion != nil || t.visiting {
+		return
+	}
+	t.visiting = true
+	defer func() { t.visiting = false }()
+	for _, fk := range t.foreignKeys {
+		if parent, ok := s.tables[fk.table]; ok && parent != t {
+			s.scaffold(parent)
+		}
+	}
+
+	name := t.td.Name
+	t.suggestion = &vtctldatapb.ScaffoldVSchemaResponse_TableSuggestion{Table: name}
+	if len(t.foreignKeys) == 0 && t.referencedBy > 0 && t.td.RowCount > 0 && t.td.RowCount <= referenceTableMaxRows {
+		s.vschema.Tables[name] = &vschemapb.Table{Type: vindexes.TypeReference}
+		t.suggestion.Reason = fmt.Sprintf("small table, of about %d rows, that %d tables reference: a reference table has a copy on every shard, which the tables that reference it are joined with", t.td.RowCount, t.referencedBy)
+		return
+	}
+
+	predicates := s.predicates[name]
+	var pk string
+	if len(t.td.PrimaryKeyColumns) == 1 {
+		pk = t.td.PrimaryKeyColumns[0]
+	}
+	// The column that the queries filter on most often, the primary key
+	// winning ties.
+	var best string
+	for _, field := range t.td.Fields {
+		column := strings.ToLower(field.Name)
+		if predicates[column] > predicates[strings.ToLower(best)] || (predicates[column] == predicates[strings.ToLower(best)] && strings.EqualFold(field.Name, pk)) {
+			best = field.Name
+		}
+	}
+
+	var column string
+	for _, fk := range t.foreignKeys {
+		parent, ok := s.tables[fk.table]
+		if !ok || parent == t || !strings.EqualFold(parent.suggestion.GetColumn(), fk.referencedColumn) {
+			continue
+		}
+		if predicates[strings.ToLower(fk.column)] >= predicates[strings.ToLower(best)] {
+			column = fk.column
+			t.suggestion.Reason = fmt.Sprintf("the foreign key on %s references %s.%s, which %s is sharded by: the rows of %s live on the shards of their parent rows", fk.column, fk.table, fk.referencedColumn, fk.table, name)
+			break
+		}
+	}
+	switch {
+	case column != "":
+	case best != "" && predicates[strings.ToLower(best)] > 0:
+		column = best
+		t.suggestion.Reason = fmt.Sprintf("the sampled queries filter or join on %s %d times, more than on any other column", best, predicates[strings.ToLower(best)])
+	case pk != "":
+		column = pk
+		t.suggestion.Reason = fmt.Sprintf("%s is the primary key, and the sampled queries do not filter on any column", pk)
+	default:
+		t.suggestion.Reason = "no single-column primary key, foreign key or filtered column to shard the table by: pick its primary vindex, or make it a reference table"
+		return
+	}
+
+	field := findField(t.td, column)
+	vindex := primaryVindexType(field.Type)
+	s.vschema.Vindexes[vindex] = &vschemapb.Vindex{Type: vindex}
+	table := &vschemapb.Table{
+		ColumnVindexes: []*vschemapb.ColumnVindex{{
+			Column: field.Name,
+			Name:   vindex,
+		}},
+	}
+	s.vschema.Tables[name] = table
+	t.suggestion.Column = field.Name
+	t.suggestion.Vindex = vindex
+
+	if t.autoIncrement != "" {
+		sequence := name + "_seq"
+		table.AutoIncrement = &vschemapb.AutoIncrement{
+			Column:   t.autoIncrement,
+			Sequence: sequence,
+		}
+		t.suggestion.Notes = append(t.suggestion.Notes, fmt.Sprintf("create the sequence table %s in an unsharded keyspace, add it to its vschema with the sequence type, and drop the AUTO_INCREMENT of %s, which would conflict across the shards", sequence, t.autoIncrement))
+	}
+	var others []string
+	for other, count := range predicates {
+		if count > 0 && !strings.EqualFold(other, field.Name) {
+			others = append(others, other)
+		}
+	}
+	sort.Strings(others)
+	for _, other := range others {
+		t.suggestion.Notes = append(t.suggestion.Notes, fmt.Sprintf("the sampled queries also filter or join on %s %d times, which scatters them to all the shards: consider a lookup vindex on it", other, predicates[other]))
+	}
+}
+
+// primaryVindexType returns the vindex type suggested for a primary vindex
+// column of the type.
+func primaryVindexType(typ querypb.Type) string {
+	switch {
+	case sqltypes.IsIntegral(typ):
+		return "hash"
+	case sqltypes.IsText(typ):
+		return "unicode_loose_xxhash"
+	default:
+		return "xxhash"
+	}
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topotools
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/test/utils"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+)
+
+func TestScaffoldVSchema(t *testing.T) {
+	int64Field := func(name string) *querypb.Field { return &querypb.Field{Name: name, Type: sqltypes.Int64} }
+	varcharField := func(name string) *querypb.Field { return &querypb.Field{Name: name, Type: sqltypes.VarChar} }
+	schema := &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{{
+			Name:              "customers",
+			Schema:            "create table customers (id bigint auto_increment, email varchar(64), country_id bigint, primary key (id), foreign key (country_id) references countries (id))",
+			Fields:            []*querypb.Field{int64Field("id"), varcharField("email"), int64Field("country_id")},
+			PrimaryKeyColumns: []string{"id"},
+			RowCount:          100000,
+		}, {
+			Name:              "orders",
+			Schema:            "create table orders (id bigint, customer_id bigint, primary key (id), foreign key (customer_id) references customers (id))",
+			Fields:            []*querypb.Field{int64Field("id"), int64Field("customer_id")},
+			PrimaryKeyColumns: []string{"id"},
+			RowCount:          500000,
+		}, {
+			Name:              "countries",
+			Schema:            "create table countries (id bigint, name varchar(64), primary key (id))",
+			Fields:            []*querypb.Field{int64Field("id"), varcharField("name")},
+			PrimaryKeyColumns: []string{"id"},
+			RowCount:          200,
+		}, {
+			Name:   "events",
+			Schema: "create table events (tenant varchar(64), payload varchar(64))",
+			Fields: []*querypb.Field{varcharField("tenant"), varcharField("payload")},
+		}, {
+			Name:   "logs",
+			Schema: "create table logs (message varchar(64))",
+			Fields: []*querypb.Field{varcharField("message")},
+		}},
+	}
+	queries := []string{
+		"select * from customers where id = 1",
+		"select * from customers c join orders o on c.id = o.customer_id where c.id = 1",
+		"select * from customers where email = 'a@b.c'",
+		"select * from customers where email in ('a@b.c', 'd@e.f')",
+		"select * from events where tenant = :tenant",
+		"select * from orders where",
+	}
+
+	resp := ScaffoldVSchema(schema, queries)
+	utils.MustMatch(t, &vschemapb.Keyspace{
+		Sharded: true,
+		Vindexes: map[string]*vschemapb.Vindex{
+			"hash":                 {Type: "hash"},
+			"unicode_loose_xxhash": {Type: "unicode_loose_xxhash"},
+		},
+		Tables: map[string]*vschemapb.Table{
+			"countries": {Type: "reference"},
+			"customers": {
+				ColumnVindexes: []*vschemapb.ColumnVindex{{Column: "id", Name: "hash"}},
+				AutoIncrement:  &vschemapb.AutoIncrement{Column: "id", Sequence: "customers_seq"},
+			},
+			"orders": {
+				ColumnVindexes: []*vschemapb.ColumnVindex{{Column: "customer_id", Name: "hash"}},
+			},
+			"events": {
+				ColumnVindexes: []*vschemapb.ColumnVindex{{Column: "tenant", Name: "unicode_loose_xxhash"}},
+			},
+		},
+	}, resp.VSchema)
+
+	var tables []string
+	for _, table := range resp.Tables {
+		tables = append(tables, table.Table)
+	}
+	assert.Equal(t, []string{"countries", "customers", "events", "logs", "orders"}, tables)
+	assert.Contains(t, resp.Tables[1].Reason, "filter or join on id 3 times")
+	if assert.Len(t, resp.Tables[1].Notes, 2) {
+		assert.Contains(t, resp.Tables[1].Notes[0], "create the sequence table customers_seq")
+		assert.Contains(t, resp.Tables[1].Notes[1], "consider a lookup vindex")
+	}
+	assert.Empty(t, resp.Tables[3].Column)
+	assert.Contains(t, resp.Tables[4].Reason, "the foreign key on customer_id references customers.id")
+}
@@ -155,10 +155,25 @@ func (f *fakeVTGateService) Prepare(ctx context.Context, session *vtgatepb.Sessi
 	return session, execCase.result.Fields, nil
 }
 
+// StreamExecuteBatch is part of the VTGateService interface
+func (f *fakeVTGateService) StreamExecuteBatch(ctx context.Context, session *vtgatepb.Session, queries []*vtgatepb.BatchQuery, abortOnError bool, callback func(int, *vtgatepb.Session, *sqltypes.Result, error) error) error {
+	return nil
+}
+
 func (f *fakeVTGateService) CloseSession(ctx context.Context, session *vtgatepb.Session) error {
 	return nil
 }
 
+// ExportSession is part of the VTGateService interface
+func (f *fakeVTGateService) ExportSession(ctx context.Context, session *vtgatepb.Session) (string, error) {
+	return "", nil
+}
+
+// ImportSession is part of the VTGateService interface
+func (f *fakeVTGateService) ImportSession(ctx context.Context, token string) (*vtgatepb.Session, error) {
+	return nil, nil
+}
+
 // ResolveTransaction is part of the VTGateService interface
 func (f *fakeVTGateService) ResolveTransaction(ctx context.Context, dtid string) error {
 	if dtid != dtid2 {
@@ -118,10 +118,11 @@ func NewAPI(clusters []*cluster.Cluster, opts Options) *API {
 	if authz == nil {
 		authz, _ = rbac.NewAuthorizer(&rbac.Config{
 			Rules: []*struct {
-				Resource string
-				Actions  []string
-				Subjects []string
-				Clusters []string
+				Resource  string
+				Actions   []string
+				Subjects  []string
+				Clusters  []string
+				Keyspaces []string
 			}{
 				{
 					Resource: "*",
@@ -158,6 +159,7 @@ func NewAPI(clusters []*cluster.Cluster, opts Options) *API {
 	router.HandleFunc("/keyspace/{cluster_id}", httpAPI.Adapt(vtadminhttp.CreateKeyspace)).Name("API.CreateKeyspace").Methods("POST")
 	router.HandleFunc("/keyspace/{cluster_id}/{name}", httpAPI.Adapt(vtadminhttp.DeleteKeyspace)).Name("API.DeleteKeyspace").Methods("DELETE")
 	router.HandleFunc("/keyspace/{cluster_id}/{name}", httpAPI.Adapt(vtadminhttp.GetKeyspace)).Name("API.GetKeyspace")
+	router.HandleFunc("/keyspace/{cluster_id}/{name}/graph", httpAPI.Adapt(vtadminhttp.GetKeyspaceGraph)).Name("API.GetKeyspaceGraph")
 	router.HandleFunc("/keyspaces", httpAPI.Adapt(vtadminhttp.GetKeyspaces)).Name("API.GetKeyspaces")
 	router.HandleFunc("/schema/{table}", httpAPI.Adapt(vtadminhttp.FindSchema)).Name("API.FindSchema")
 	router.HandleFunc("/schema/{cluster_id}/{keyspace}/{table}", httpAPI.Adapt(vtadminhttp.GetSchema)).Name("API.GetSchema")
@@ -169,11 +171,18 @@ func NewAPI(clusters []*cluster.Cluster, opts Options) *API {
 	router.HandleFunc("/vschema/{cluster_id}/{keyspace}", httpAPI.Adapt(vtadminhttp.GetVSchema)).Name("API.GetVSchema")
 	router.HandleFunc("/vschemas", httpAPI.Adapt(vtadminhttp.GetVSchemas)).Name("API.GetVSchemas")
 	router.HandleFunc("/vtexplain", httpAPI.Adapt(vtadminhttp.VTExplain)).Name("API.VTExplain")
+	router.HandleFunc("/workflow/{cluster_id}/movetables", httpAPI.Adapt(vtadminhttp.CreateMoveTablesWorkflow)).Name("API.CreateMoveTablesWorkflow").Methods("POST")
+	router.HandleFunc("/workflow/{cluster_id}/reshard", httpAPI.Adapt(vtadminhttp.CreateReshardWorkflow)).Name("API.CreateReshardWorkflow").Methods("POST")
+	router.HandleFunc("/workflow/{cluster_id}/{keyspace}/{name}", httpAPI.Adapt(vtadminhttp.CancelWorkflow)).Name("API.CancelWorkflow").Methods("DELETE")
 	router.HandleFunc("/workflow/{cluster_id}/{keyspace}/{name}", httpAPI.Adapt(vtadminhttp.GetWorkflow)).Name("API.GetWorkflow")
+	router.HandleFunc("/workflow/{cluster_id}/{keyspace}/{name}/complete", httpAPI.Adapt(vtadminhttp.CompleteWorkflow)).Name("API.CompleteWorkflow").Methods("POST")
+	router.HandleFunc("/workflow/{cluster_id}/{keyspace}/{name}/switch_traffic", httpAPI.Adapt(vtadminhttp.SwitchWorkflowTraffic)).Name("API.SwitchWorkflowTraffic").Methods("POST")
 	router.HandleFunc("/workflows", httpAPI.Adapt(vtadminhttp.GetWorkflows)).Name("API.GetWorkflows")
 
 	experimentalRouter := router.PathPrefix("/experimental").Subrouter()
 	experimentalRouter.HandleFunc("/tablet/{tablet}/debug/vars", httpAPI.Adapt(experimental.TabletDebugVarsPassthrough)).Name("API.TabletDebugVarsPassthrough")
+	experimentalRouter.HandleFunc("/workflow/{cluster_id}/{keyspace}/{name}/metrics", httpAPI.Adapt(experimental.WorkflowMetricsPassthrough)).Name("API.WorkflowMetricsPassthrough")
+	experimentalRouter.HandleFunc("/shard_health/{cluster_id}", httpAPI.Adapt(experimental.ShardHealthPassthrough)).Name("API.ShardHealthPassthrough")
 
 	if !opts.HTTPOpts.DisableDebug {
 		// Due to the way net/http/pprof insists on registering its handlers, we
@@ -228,17 +237,96 @@ func (api *API) ListenAndServe() error {
 	return api.serv.ListenAndServe()
 }
 
+// CancelWorkflow is part of the vtadminpb.VTAdminServer interface.
+func (api *API) CancelWorkflow(ctx context.Context, req *vtadminpb.CancelWorkflowRequest) (resp *vtctldatapb.WorkflowCancelResponse, err error) {
+	span, ctx := trace.NewSpan(ctx, "API.CancelWorkflow")
+	defer span.Finish()
+
+	span.Annotate("cluster_id", req.ClusterId)
+
+	event := &rbac.AuditEvent{
+		Method:    "CancelWorkflow",
+		ClusterID: req.ClusterId,
+		Keyspace:  req.Options.GetKeyspace(),
+		Resource:  rbac.WorkflowResource,
+		Action:    rbac.DeleteAction,
+	}
+	defer func() { api.authz.Audit(ctx, event, req, err) }()
+
+	if !api.authz.IsAuthorizedForKeyspace(ctx, event.ClusterID, event.Keyspace, event.Resource, event.Action) {
+		return nil, fmt.Errorf("%w: cannot cancel workflow in %s", errors.ErrUnauthorized, req.ClusterId)
+	}
+
+	event.Authorized = true
+
+	c, ok := api.clusterMap[req.ClusterId]
+	if !ok {
+		return nil, fmt.Errorf("%w: no cluster with id %s", errors.ErrUnsupportedCluster, req.ClusterId)
+	}
+
+	if err := c.Vtctld.Dial(ctx); err != nil {
+		return nil, err
+	}
+
+	return c.CancelWorkflow(ctx, req.Options)
+}
+
+// CompleteWorkflow is part of the vtadminpb.VTAdminServer interface.
+func (api *API) CompleteWorkflow(ctx context.Context, req *vtadminpb.CompleteWorkflowRequest) (resp *vtctldatapb.WorkflowCompleteResponse, err error) {
+	span, ctx := trace.NewSpan(ctx, "API.CompleteWorkflow")
+	defer span.Finish()
+
+	span.Annotate("cluster_id", req.ClusterId)
+
+	event := &rbac.AuditEvent{
+		Method:    "CompleteWorkflow",
+		ClusterID: req.ClusterId,
+		Keyspace:  req.Options.GetKeyspace(),
+		Resource:  rbac.WorkflowResource,
+		Action:    rbac.ControlAction,
+	}
+	defer func() { api.authz.Audit(ctx, event, req, err) }()
+
+	if !api.authz.IsAuthorizedForKeyspace(ctx, event.ClusterID, event.Keyspace, event.Resource, event.Action) {
+		return nil, fmt.Errorf("%w: cannot complete workflow in %s", errors.ErrUnauthorized, req.ClusterId)
+	}
+
+	event.Authorized = true
+
+	c, ok := api.clusterMap[req.ClusterId]
+	if !ok {
+		return nil, fmt.Errorf("%w: no cluster with id %s", errors.ErrUnsupportedCluster, req.ClusterId)
+	}
+
+	if err := c.Vtctld.Dial(ctx); err != nil {
+		return nil, err
+	}
+
+	return c.CompleteWorkflow(ctx, req.Options)
+}
+
 // CreateKeyspace is part of the vtadminpb.VTAdminServer interface.
-func (api *API) CreateKeyspace(ctx context.Context, req *vtadminpb.CreateKeyspaceRequest) (*vtadminpb.CreateKeyspaceResponse, error) {
+func (api *API) CreateKeyspace(ctx context.Context, req *vtadminpb.CreateKeyspaceRequest) (resp *vtadminpb.CreateKeyspaceResponse, err error) {
 	span, ctx := trace.NewSpan(ctx, "API.CreateKeyspace")
 	defer span.Finish()
 
 	span.Annotate("cluster_id", req.ClusterId)
 
-	if !api.authz.IsAuthorized(ctx, req.ClusterId, rbac.KeyspaceResource, rbac.CreateAction) {
+	event := &rbac.AuditEvent{
+		Method:    "CreateKeyspace",
+		ClusterID: req.ClusterId,
+		Keyspace:  req.Options.GetName(),
+		Resource:  rbac.KeyspaceResource,
+		Action:    rbac.CreateAction,
+	}
+	defer func() { api.authz.Audit(ctx, event, req, err) }()
+
+	if !api.authz.IsAuthorizedForKeyspace(ctx, event.ClusterID, event.Keyspace, event.Resource, event.Action) {
 		return nil, fmt.Errorf("%w: cannot create keyspace in %s", errors.ErrUnauthorized, req.ClusterId)
 	}
 
+	event.Authorized = true
+
 	c, ok := api.clusterMap[req.ClusterId]
 	if !ok {
 		return nil, fmt.Errorf("%w: no cluster with id %s", errors.ErrUnsupportedCluster, req.ClusterId)
@@ -258,17 +346,96 @@ func (api *API) CreateKeyspace(ctx context.Context, req *vtadminpb.CreateKeyspac
 	}, nil
 }
 
+// CreateMoveTablesWorkflow is part of the vtadminpb.VTAdminServer interface.
+func (api *API) CreateMoveTablesWorkflow(ctx context.Context, req *vtadminpb.CreateMoveTablesWorkflowRequest) (resp *vtctldatapb.MoveTablesCreateResponse, err error) {
+	span, ctx := trace.NewSpan(ctx, "API.CreateMoveTablesWorkflow")
+	defer span.Finish()
+
+	span.Annotate("cluster_id", req.ClusterId)
+
+	event := &rbac.AuditEvent{
+		Method:    "CreateMoveTablesWorkflow",
+		ClusterID: req.ClusterId,
+		Keyspace:  req.Options.GetTargetKeyspace(),
+		Resource:  rbac.WorkflowResource,
+		Action:    rbac.CreateAction,
+	}
+	defer func() { api.authz.Audit(ctx, event, req, err) }()
+
+	if !api.authz.IsAuthorizedForKeyspace(ctx, event.ClusterID, event.Keyspace, event.Resource, event.Action) {
+		return nil, fmt.Errorf("%w: cannot create workflow in %s", errors.ErrUnauthorized, req.ClusterId)
+	}
+
+	event.Authorized = true
+
+	c, ok := api.clusterMap[req.ClusterId]
+	if !ok {
+		return nil, fmt.Errorf("%w: no cluster with id %s", errors.ErrUnsupportedCluster, req.ClusterId)
+	}
+
+	if err := c.Vtctld.Dial(ctx); err != nil {
+		return nil, err
+	}
+
+	return c.CreateMoveTablesWorkflow(ctx, req.Options)
+}
+
+// CreateReshardWorkflow is part of the vtadminpb.VTAdminServer interface.
+func (api *API) CreateReshardWorkflow(ctx context.Context, req *vtadminpb.CreateReshardWorkflowRequest) (resp *vtctldatapb.ReshardCreateResponse, err error) {
+	span, ctx := trace.NewSpan(ctx, "API.CreateReshardWorkflow")
+	defer span.Finish()
+
+	span.Annotate("cluster_id", req.ClusterId)
+
+	event := &rbac.AuditEvent{
+		Method:    "CreateReshardWorkflow",
+		ClusterID: req.ClusterId,
+		Keyspace:  req.Options.GetKeyspace(),
+		Resource:  rbac.WorkflowResource,
+		Action:    rbac.CreateAction,
+	}
+	defer func() { api.authz.Audit(ctx, event, req, err) }()
+
+	if !api.authz.IsAuthorizedForKeyspace(ctx, event.ClusterID, event.Keyspace, event.Resource, event.Action) {
+		return nil, fmt.Errorf("%w: cannot create workflow in %s", errors.ErrUnauthorized, req.ClusterId)
+	}
+
+	event.Authorized = true
+
+	c, ok := api.clusterMap[req.ClusterId]
+	if !ok {
+		return nil, fmt.Errorf("%w: no cluster with id %s", errors.ErrUnsupportedCluster, req.ClusterId)
+	}
+
+	if err := c.Vtctld.Dial(ctx); err != nil {
+		return nil, err
+	}
+
+	return c.CreateReshardWorkflow(ctx, req.Options)
+}
+
 // DeleteKeyspace is part of the vtadminpb.VTAdminServer interface.
-func (api *API) DeleteKeyspace(ctx context.Context, req *vtadminpb.DeleteKeyspaceRequest) (*vtctldatapb.DeleteKeyspaceResponse, error) {
+func (api *API) DeleteKeyspace(ctx context.Context, req *vtadminpb.DeleteKeyspaceRequest) (resp *vtctldatapb.DeleteKeyspaceResponse, err error) {
 	span, ctx := trace.NewSpan(ctx, "API.DeleteKeyspace")
 	defer span.Finish()
 
 	span.Annotate("cluster_id", req.ClusterId)
 
-	if !api.authz.IsAuthorized(ctx, req.ClusterId, rbac.KeyspaceResource, rbac.DeleteAction) {
+	event := &rbac.AuditEvent{
+		Method:    "DeleteKeyspace",
+		ClusterID: req.ClusterId,
+		Keyspace:  req.Options.GetKeyspace(),
+		Resource:  rbac.KeyspaceResource,
+		Action:    rbac.DeleteAction,
+	}
+	defer func() { api.authz.Audit(ctx, event, req, err) }()
+
+	if !api.authz.IsAuthorizedForKeyspace(ctx, event.ClusterID, event.Keyspace, event.Resource, event.Action) {
 		return nil, fmt.Errorf("%w: cannot delete keyspace in %s", errors.ErrUnauthorized, req.ClusterId)
 	}
 
+	event.Authorized = true
+
 	c, ok := api.clusterMap[req.ClusterId]
 	if !ok {
 		return nil, fmt.Errorf("%w: no cluster with id %s", errors.ErrUnsupportedCluster, req.ClusterId)
@@ -495,13 +662,30 @@ func (api *API) GetKeyspace(ctx context.Context, req *vtadminpb.GetKeyspaceReque
 		return nil, fmt.Errorf("%w: %s", errors.ErrUnsupportedCluster, req.ClusterId)
 	}
 
-	if !api.authz.IsAuthorized(ctx, c.ID, rbac.KeyspaceResource, rbac.GetAction) {
+	if !api.authz.IsAuthorizedForKeyspace(ctx, c.ID, req.Keyspace, rbac.KeyspaceResource, rbac.GetAction) {
 		return nil, nil
 	}
 
 	return c.GetKeyspace(ctx, req.Keyspace)
 }
 
+// GetKeyspaceGraph is part of the vtadminpb.VTAdminServer interface.
+func (api *API) GetKeyspaceGraph(ctx context.Context, req *vtadminpb.GetKeyspaceGraphRequest) (*vtadminpb.KeyspaceGraph, error) {
+	span, ctx := trace.NewSpan(ctx, "API.GetKeyspaceGraph")
+	defer span.Finish()
+
+	c, ok := api.clusterMap[req.ClusterId]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", errors.ErrUnsupportedCluster, req.ClusterId)
+	}
+
+	if !api.authz.IsAuthorizedForKeyspace(ctx, c.ID, req.Keyspace, rbac.KeyspaceResource, rbac.GetAction) {
+		return nil, nil
+	}
+
+	return c.GetKeyspaceGraph(ctx, req.Keyspace)
+}
+
 // GetKeyspaces is part of the vtadminpb.VTAdminServer interface.
 func (api *API) GetKeyspaces(ctx context.Context, req *vtadminpb.GetKeyspacesRequest) (*vtadminpb.GetKeyspacesResponse, error) {
 	span, ctx := trace.NewSpan(ctx, "API.GetKeyspaces")
@@ -564,7 +748,7 @@ func (api *API) GetSchema(ctx context.Context, req *vtadminpb.GetSchemaRequest)
 		return nil, fmt.Errorf("%w: no cluster with id %s", errors.ErrUnsupportedCluster, req.ClusterId)
 	}
 
-	if !api.authz.IsAuthorized(ctx, c.ID, rbac.SchemaResource, rbac.GetAction) {
+	if !api.authz.IsAuthorizedForKeyspace(ctx, c.ID, req.Keyspace, rbac.SchemaResource, rbac.GetAction) {
 		return nil, nil
 	}
 
@@ -917,7 +1101,7 @@ func (api *API) GetVSchema(ctx context.Context, req *vtadminpb.GetVSchemaRequest
 
 	cluster.AnnotateSpan(c, span)
 
-	if !api.authz.IsAuthorized(ctx, c.ID, rbac.VSchemaResource, rbac.GetAction) {
+	if !api.authz.IsAuthorizedForKeyspace(ctx, c.ID, req.Keyspace, rbac.VSchemaResource, rbac.GetAction) {
 		return nil, nil
 	}
 
@@ -1045,7 +1229,7 @@ func (api *API) GetWorkflow(ctx context.Context, req *vtadminpb.GetWorkflowReque
 	span.Annotate("workflow_name", req.Name)
 	span.Annotate("active_only", req.ActiveOnly)
 
-	if !api.authz.IsAuthorized(ctx, c.ID, rbac.WorkflowResource, rbac.GetAction) {
+	if !api.authz.IsAuthorizedForKeyspace(ctx, c.ID, req.Keyspace, rbac.WorkflowResource, rbac.GetAction) {
 		return nil, nil
 	}
 
@@ -1105,6 +1289,40 @@ func (api *API) GetWorkflows(ctx context.Context, req *vtadminpb.GetWorkflowsReq
 	}, nil
 }
 
+// SwitchWorkflowTraffic is part of the vtadminpb.VTAdminServer interface.
+func (api *API) SwitchWorkflowTraffic(ctx context.Context, req *vtadminpb.SwitchWorkflowTrafficRequest) (resp *vtctldatapb.WorkflowSwitchTrafficResponse, err error) {
+	span, ctx := trace.NewSpan(ctx, "API.SwitchWorkflowTraffic")
+	defer span.Finish()
+
+	span.Annotate("cluster_id", req.ClusterId)
+
+	event := &rbac.AuditEvent{
+		Method:    "SwitchWorkflowTraffic",
+		ClusterID: req.ClusterId,
+		Keyspace:  req.Options.GetKeyspace(),
+		Resource:  rbac.WorkflowResource,
+		Action:    rbac.ControlAction,
+	}
+	defer func() { api.authz.Audit(ctx, event, req, err) }()
+
+	if !api.authz.IsAuthorizedForKeyspace(ctx, event.ClusterID, event.Keyspace, event.Resource, event.Action) {
+		return nil, fmt.Errorf("%w: cannot switch workflow traffic in %s", errors.ErrUnauthorized, req.ClusterId)
+	}
+
+	event.Authorized = true
+
+	c, ok := api.clusterMap[req.ClusterId]
+	if !ok {
+		return nil, fmt.Errorf("%w: no cluster with id %s", errors.ErrUnsupportedCluster, req.ClusterId)
+	}
+
+	if err := c.Vtctld.Dial(ctx); err != nil {
+		return nil, err
+	}
+
+	return c.SwitchWorkflowTraffic(ctx, req.Options)
+}
+
 // VTExplain is part of the vtadminpb.VTAdminServer interface.
 func (api *API) VTExplain(ctx context.Context, req *vtadminpb.VTExplainRequest) (*vtadminpb.VTExplainResponse, error) {
 	span, ctx := trace.NewSpan(ctx, "API.VTExplain")
@@ -243,6 +243,68 @@ func (c *Cluster) parseTablet(rows *sql.Rows) (*vtadminpb.Tablet, error) {
 	return tablet, nil
 }
 
+// CancelWorkflow cancels a MoveTables or Reshard workflow in the given
+// cluster, proxying a WorkflowCancelRequest to a vtctld in that cluster.
+func (c *Cluster) CancelWorkflow(ctx context.Context, req *vtctldatapb.WorkflowCancelRequest) (*vtctldatapb.WorkflowCancelResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "Cluster.CancelWorkflow")
+	defer span.Finish()
+
+	AnnotateSpan(c, span)
+
+	if req == nil {
+		return nil, fmt.Errorf("%w: request cannot be nil", errors.ErrInvalidRequest)
+	}
+
+	if req.Keyspace == "" {
+		return nil, fmt.Errorf("%w: keyspace name is required", errors.ErrInvalidRequest)
+	}
+
+	if req.Workflow == "" {
+		return nil, fmt.Errorf("%w: workflow name is required", errors.ErrInvalidRequest)
+	}
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("workflow", req.Workflow)
+
+	if err := c.topoRWPool.Acquire(ctx); err != nil {
+		return nil, fmt.Errorf("CancelWorkflow(%+v) failed to acquire topoRWPool: %w", req, err)
+	}
+	defer c.topoRWPool.Release()
+
+	return c.Vtctld.WorkflowCancel(ctx, req)
+}
+
+// CompleteWorkflow completes a MoveTables or Reshard workflow in the given
+// cluster, proxying a WorkflowCompleteRequest to a vtctld in that cluster.
+func (c *Cluster) CompleteWorkflow(ctx context.Context, req *vtctldatapb.WorkflowCompleteRequest) (*vtctldatapb.WorkflowCompleteResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "Cluster.CompleteWorkflow")
+	defer span.Finish()
+
+	AnnotateSpan(c, span)
+
+	if req == nil {
+		return nil, fmt.Errorf("%w: request cannot be nil", errors.ErrInvalidRequest)
+	}
+
+	if req.Keyspace == "" {
+		return nil, fmt.Errorf("%w: keyspace name is required", errors.ErrInvalidRequest)
+	}
+
+	if req.Workflow == "" {
+		return nil, fmt.Errorf("%w: workflow name is required", errors.ErrInvalidRequest)
+	}
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("workflow", req.Workflow)
+
+	if err := c.topoRWPool.Acquire(ctx); err != nil {
+		return nil, fmt.Errorf("CompleteWorkflow(%+v) failed to acquire topoRWPool: %w", req, err)
+	}
+	defer c.topoRWPool.Release()
+
+	return c.Vtctld.WorkflowComplete(ctx, req)
+}
+
 // CreateKeyspace creates a keyspace in the given cluster, proxying a
 // CreateKeyspaceRequest to a vtctld in that cluster.
 func (c *Cluster) CreateKeyspace(ctx context.Context, req *vtctldatapb.CreateKeyspaceRequest) (*vtadminpb.Keyspace, error) {
@@ -302,6 +364,100 @@ func (c *Cluster) DeleteKeyspace(ctx context.Context, req *vtctldatapb.DeleteKey
 	return c.Vtctld.DeleteKeyspace(ctx, req)
 }
 
+// CreateMoveTablesWorkflow creates a MoveTables workflow in the given cluster,
+// proxying a MoveTablesCreateRequest to a vtctld in that cluster.
+func (c *Cluster) CreateMoveTablesWorkflow(ctx context.Context, req *vtctldatapb.MoveTablesCreateRequest) (*vtctldatapb.MoveTablesCreateResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "Cluster.CreateMoveTablesWorkflow")
+	defer span.Finish()
+
+	AnnotateSpan(c, span)
+
+	if req == nil {
+		return nil, fmt.Errorf("%w: request cannot be nil", errors.ErrInvalidRequest)
+	}
+
+	if req.TargetKeyspace == "" {
+		return nil, fmt.Errorf("%w: keyspace name is required", errors.ErrInvalidRequest)
+	}
+
+	if req.Workflow == "" {
+		return nil, fmt.Errorf("%w: workflow name is required", errors.ErrInvalidRequest)
+	}
+
+	span.Annotate("keyspace", req.TargetKeyspace)
+	span.Annotate("workflow", req.Workflow)
+
+	if err := c.topoRWPool.Acquire(ctx); err != nil {
+		return nil, fmt.Errorf("CreateMoveTablesWorkflow(%+v) failed to acquire topoRWPool: %w", req, err)
+	}
+	defer c.topoRWPool.Release()
+
+	return c.Vtctld.MoveTablesCreate(ctx, req)
+}
+
+// CreateReshardWorkflow creates a Reshard workflow in the given cluster,
+// proxying a ReshardCreateRequest to a vtctld in that cluster.
+func (c *Cluster) CreateReshardWorkflow(ctx context.Context, req *vtctldatapb.ReshardCreateRequest) (*vtctldatapb.ReshardCreateResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "Cluster.CreateReshardWorkflow")
+	defer span.Finish()
+
+	AnnotateSpan(c, span)
+
+	if req == nil {
+		return nil, fmt.Errorf("%w: request cannot be nil", errors.ErrInvalidRequest)
+	}
+
+	if req.Keyspace == "" {
+		return nil, fmt.Errorf("%w: keyspace name is required", errors.ErrInvalidRequest)
+	}
+
+	if req.Workflow == "" {
+		return nil, fmt.Errorf("%w: workflow name is required", errors.ErrInvalidRequest)
+	}
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("workflow", req.Workflow)
+
+	if err := c.topoRWPool.Acquire(ctx); err != nil {
+		return nil, fmt.Errorf("CreateReshardWorkflow(%+v) failed to acquire topoRWPool: %w", req, err)
+	}
+	defer c.topoRWPool.Release()
+
+	return c.Vtctld.ReshardCreate(ctx, req)
+}
+
+// SwitchWorkflowTraffic switches the traffic of a MoveTables or Reshard
+// workflow in the given cluster, proxying a WorkflowSwitchTrafficRequest to a
+// vtctld in that cluster.
+func (c *Cluster) SwitchWorkflowTraffic(ctx context.Context, req *vtctldatapb.WorkflowSwitchTrafficRequest) (*vtctldatapb.WorkflowSwitchTrafficResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "Cluster.SwitchWorkflowTraffic")
+	defer span.Finish()
+
+	AnnotateSpan(c, span)
+
+	if req == nil {
+		return nil, fmt.Errorf("%w: request cannot be nil", errors.ErrInvalidRequest)
+	}
+
+	if req.Keyspace == "" {
+		return nil, fmt.Errorf("%w: keyspace name is required", errors.ErrInvalidRequest)
+	}
+
+	if req.Workflow == "" {
+		return nil, fmt.Errorf("%w: workflow name is required", errors.ErrInvalidRequest)
+	}
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("workflow", req.Workflow)
+
+	if err := c.topoRWPool.Acquire(ctx); err != nil {
+		return nil, fmt.Errorf("SwitchWorkflowTraffic(%+v) failed to acquire topoRWPool: %w", req, err)
+	}
+	defer c.topoRWPool.Release()
+
+	return c.Vtctld.WorkflowSwitchTraffic(ctx, req)
+}
+
 // FindAllShardsInKeyspaceOptions modify the behavior of a cluster's
 // FindAllShardsInKeyspace method.
 type FindAllShardsInKeyspaceOptions struct {
@@ -762,6 +918,36 @@ func (c *Cluster) GetKeyspace(ctx context.Context, name string) (*vtadminpb.Keys
 	}, nil
 }
 
+// GetKeyspaceGraph returns the serving graph of a keyspace in the cluster.
+func (c *Cluster) GetKeyspaceGraph(ctx context.Context, name string) (*vtadminpb.KeyspaceGraph, error) {
+	span, ctx := trace.NewSpan(ctx, "Cluster.GetKeyspaceGraph")
+	defer span.Finish()
+
+	AnnotateSpan(c, span)
+	span.Annotate("keyspace", name)
+
+	if err := c.Vtctld.Dial(ctx); err != nil {
+		return nil, fmt.Errorf("Vtctld.Dial failed for cluster = %s: %w", c.ID, err)
+	}
+
+	if err := c.topoReadPool.Acquire(ctx); err != nil {
+		return nil, fmt.Errorf("GetKeyspaceGraph(%s) failed to acquire topoReadPool: %w", name, err)
+	}
+	defer c.topoReadPool.Release()
+
+	resp, err := c.Vtctld.GetKeyspaceGraph(ctx, &vtctldatapb.GetKeyspaceGraphRequest{
+		Keyspace: name,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &vtadminpb.KeyspaceGraph{
+		Cluster: c.ToProto(),
+		Graph:   resp.Graph,
+	}, nil
+}
+
 // GetKeyspaces returns all keyspaces, with their shard maps, in the cluster.
 func (c *Cluster) GetKeyspaces(ctx context.Context) ([]*vtadminpb.Keyspace, error) {
 	span, ctx := trace.NewSpan(ctx, "Cluster.GetKeyspaces")
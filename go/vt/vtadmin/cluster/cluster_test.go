@@ -264,6 +264,129 @@ func TestDeleteKeyspace(t *testing.T) {
 	}
 }
 
+func TestSwitchWorkflowTraffic(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	tests := []struct {
+		name      string
+		cfg       testutil.TestClusterConfig
+		req       *vtctldatapb.WorkflowSwitchTrafficRequest
+		expected  *vtctldatapb.WorkflowSwitchTrafficResponse
+		shouldErr bool
+	}{
+		{
+			name: "dry run",
+			cfg: testutil.TestClusterConfig{
+				Cluster: &vtadminpb.Cluster{
+					Id:   "c1",
+					Name: "cluster1",
+				},
+				VtctldClient: &fakevtctldclient.VtctldClient{
+					WorkflowSwitchTrafficResults: struct {
+						Response *vtctldatapb.WorkflowSwitchTrafficResponse
+						Error    error
+					}{
+						Response: &vtctldatapb.WorkflowSwitchTrafficResponse{
+							DryRunResults: []string{"Switch reads for tables [t1] to keyspace ks1"},
+						},
+					},
+				},
+			},
+			req: &vtctldatapb.WorkflowSwitchTrafficRequest{
+				Keyspace: "ks1",
+				Workflow: "wf1",
+				DryRun:   true,
+			},
+			expected: &vtctldatapb.WorkflowSwitchTrafficResponse{
+				DryRunResults: []string{"Switch reads for tables [t1] to keyspace ks1"},
+			},
+		},
+		{
+			name: "nil request",
+			cfg: testutil.TestClusterConfig{
+				Cluster: &vtadminpb.Cluster{
+					Id:   "c1",
+					Name: "cluster1",
+				},
+				VtctldClient: &fakevtctldclient.VtctldClient{},
+			},
+			req:       nil,
+			shouldErr: true,
+		},
+		{
+			name: "missing keyspace",
+			cfg: testutil.TestClusterConfig{
+				Cluster: &vtadminpb.Cluster{
+					Id:   "c1",
+					Name: "cluster1",
+				},
+				VtctldClient: &fakevtctldclient.VtctldClient{},
+			},
+			req: &vtctldatapb.WorkflowSwitchTrafficRequest{
+				Workflow: "wf1",
+			},
+			shouldErr: true,
+		},
+		{
+			name: "missing workflow",
+			cfg: testutil.TestClusterConfig{
+				Cluster: &vtadminpb.Cluster{
+					Id:   "c1",
+					Name: "cluster1",
+				},
+				VtctldClient: &fakevtctldclient.VtctldClient{},
+			},
+			req: &vtctldatapb.WorkflowSwitchTrafficRequest{
+				Keyspace: "ks1",
+			},
+			shouldErr: true,
+		},
+		{
+			name: "failure",
+			cfg: testutil.TestClusterConfig{
+				Cluster: &vtadminpb.Cluster{
+					Id:   "c1",
+					Name: "cluster1",
+				},
+				VtctldClient: &fakevtctldclient.VtctldClient{
+					WorkflowSwitchTrafficResults: struct {
+						Response *vtctldatapb.WorkflowSwitchTrafficResponse
+						Error    error
+					}{
+						Error: assert.AnError,
+					},
+				},
+			},
+			req: &vtctldatapb.WorkflowSwitchTrafficRequest{
+				Keyspace: "ks1",
+				Workflow: "wf1",
+			},
+			shouldErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cluster := testutil.BuildCluster(t, tt.cfg)
+			err := cluster.Vtctld.Dial(ctx)
+			require.NoError(t, err, "could not dial test vtctld")
+
+			resp, err := cluster.SwitchWorkflowTraffic(ctx, tt.req)
+			if tt.shouldErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, resp)
+		})
+	}
+}
+
 func TestFindTablet(t *testing.T) {
 	t.Parallel()
 
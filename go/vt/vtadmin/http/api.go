@@ -43,6 +43,7 @@ type Options struct {
 	DisableDebug        bool
 	ExperimentalOptions struct {
 		TabletURLTmpl string
+		VTGateURLTmpl string
 	}
 }
 
@@ -0,0 +1,144 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package experimental
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	vtadminhttp "vitess.io/vitess/go/vt/vtadmin/http"
+
+	vtadminpb "vitess.io/vitess/go/vt/proto/vtadmin"
+)
+
+// ShardHealth is the health of a shard, as computed by the vtgates of its
+// cluster. It is the worst one that the vtgates report.
+type ShardHealth struct {
+	Keyspace                   string `json:"keyspace"`
+	Shard                      string `json:"shard"`
+	Score                      int64  `json:"score"`
+	ErrorBudgetBurnRatePercent int64  `json:"error_budget_burn_rate_percent"`
+	QueryLatencyP99Ms          int64  `json:"query_latency_p99_ms"`
+	ReplicationLagSeconds      int64  `json:"replication_lag_seconds"`
+}
+
+// ClusterShardHealth is the health of the shards of a cluster.
+type ClusterShardHealth struct {
+	Shards  []*ShardHealth `json:"shards"`
+	VTGates []string       `json:"vtgates"`
+	// UnreachableVTGates are the vtgates whose metrics could not be fetched.
+	UnreachableVTGates []string `json:"unreachable_vtgates,omitempty"`
+}
+
+// ShardHealthPassthrough aggregates the health of the shards that the
+// vtgates of a cluster export in their /debug/vars route, after looking up
+// the vtgates via VTAdmin's GetGates rpc.
+//
+// Its route is /experimental/shard_health/{cluster_id}.
+func ShardHealthPassthrough(ctx context.Context, r vtadminhttp.Request, api *vtadminhttp.API) *vtadminhttp.JSONResponse {
+	vars := r.Vars()
+
+	gates, err := api.Server().GetGates(ctx, &vtadminpb.GetGatesRequest{
+		ClusterIds: []string{vars["cluster_id"]},
+	})
+	if err != nil {
+		return vtadminhttp.NewJSONResponse(nil, err)
+	}
+
+	health := &ClusterShardHealth{}
+	shards := map[string]*ShardHealth{}
+	for _, gate := range gates.Gates {
+		health.VTGates = append(health.VTGates, gate.Hostname)
+
+		debugVars, err := fetchDebugVars(ctx, "vtgate-fqdn", api.Options().ExperimentalOptions.VTGateURLTmpl, gate)
+		if err != nil {
+			// Report the health seen by the other vtgates, rather than none.
+			health.UnreachableVTGates = append(health.UnreachableVTGates, gate.Hostname)
+			continue
+		}
+
+		addShardHealth(shards, debugVars)
+	}
+
+	health.Shards = make([]*ShardHealth, 0, len(shards))
+	for _, shard := range shards {
+		health.Shards = append(health.Shards, shard)
+	}
+	sort.Slice(health.Shards, func(i, j int) bool {
+		if health.Shards[i].Keyspace != health.Shards[j].Keyspace {
+			return health.Shards[i].Keyspace < health.Shards[j].Keyspace
+		}
+		return health.Shards[i].Shard < health.Shards[j].Shard
+	})
+
+	return vtadminhttp.NewJSONResponse(health, nil)
+}
+
+// addShardHealth merges the health of the shards exported by a vtgate, by
+// keeping the worst values.
+func addShardHealth(shards map[string]*ShardHealth, debugVars map[string]interface{}) {
+	scores, ok := debugVars["ShardHealthScore"].(map[string]interface{})
+	if !ok {
+		return
+	}
+
+	value := func(name, key string) int64 {
+		byShard, ok := debugVars[name].(map[string]interface{})
+		if !ok {
+			return 0
+		}
+
+		v, ok := byShard[key].(float64)
+		if !ok {
+			return 0
+		}
+
+		return int64(v)
+	}
+
+	for key, score := range scores {
+		// The keys are keyspace.shard, and shard names have no dots.
+		i := strings.LastIndex(key, ".")
+		if i < 0 {
+			continue
+		}
+
+		shard, ok := shards[key]
+		if !ok {
+			shard = &ShardHealth{
+				Keyspace: key[:i],
+				Shard:    key[i+1:],
+				Score:    100,
+			}
+			shards[key] = shard
+		}
+
+		if s, ok := score.(float64); ok && int64(s) < shard.Score {
+			shard.Score = int64(s)
+		}
+		if v := value("ShardErrorBudgetBurnRatePercent", key); v > shard.ErrorBudgetBurnRatePercent {
+			shard.ErrorBudgetBurnRatePercent = v
+		}
+		if v := value("ShardQueryLatencyP99Ms", key); v > shard.QueryLatencyP99Ms {
+			shard.QueryLatencyP99Ms = v
+		}
+		if v := value("ShardReplicationLagSeconds", key); v > shard.ReplicationLagSeconds {
+			shard.ReplicationLagSeconds = v
+		}
+	}
+}
@@ -49,13 +49,19 @@ func TabletDebugVarsPassthrough(ctx context.Context, r vtadminhttp.Request, api
 }
 
 func getDebugVars(ctx context.Context, api *vtadminhttp.API, tablet *vtadminpb.Tablet) (map[string]interface{}, error) {
-	tmpl, err := template.New("tablet-fqdn").Parse(api.Options().ExperimentalOptions.TabletURLTmpl)
+	return fetchDebugVars(ctx, "tablet-fqdn", api.Options().ExperimentalOptions.TabletURLTmpl, tablet)
+}
+
+// fetchDebugVars fetches the /debug/vars route of the address that the
+// template generates for the target, e.g. a tablet or a vtgate.
+func fetchDebugVars(ctx context.Context, name string, urlTmpl string, target interface{}) (map[string]interface{}, error) {
+	tmpl, err := template.New(name).Parse(urlTmpl)
 	if err != nil {
 		return nil, err
 	}
 
 	buf := bytes.NewBuffer(nil)
-	if err := tmpl.Execute(buf, tablet); err != nil {
+	if err := tmpl.Execute(buf, target); err != nil {
 		return nil, err
 	}
 	_, _ = buf.WriteString("/debug/vars")
@@ -0,0 +1,133 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package experimental
+
+import (
+	"context"
+	"math"
+	"sort"
+
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	vtadminhttp "vitess.io/vitess/go/vt/vtadmin/http"
+
+	vtadminpb "vitess.io/vitess/go/vt/proto/vtadmin"
+)
+
+// WorkflowMetrics are the vreplication metrics of a workflow, aggregated
+// across the tablets that run its streams.
+type WorkflowMetrics struct {
+	LagSecondsMax       int64    `json:"lag_seconds_max"`
+	Events              int64    `json:"events"`
+	EventBytes          int64    `json:"event_bytes"`
+	EventsPerSecond     int64    `json:"events_per_second"`
+	EventBytesPerSecond int64    `json:"event_bytes_per_second"`
+	RetryableErrors     int64    `json:"retryable_errors"`
+	FatalErrors         int64    `json:"fatal_errors"`
+	TablesToCopy        int64    `json:"tables_to_copy"`
+	Tablets             []string `json:"tablets"`
+	// UnreachableTablets are the tablets whose metrics could not be fetched.
+	UnreachableTablets []string `json:"unreachable_tablets,omitempty"`
+}
+
+// WorkflowMetricsPassthrough aggregates the vreplication metrics that the
+// tablets running the streams of a workflow export in their /debug/vars
+// route, after looking up the workflow via VTAdmin's GetWorkflow rpc.
+//
+// Its route is /experimental/workflow/{cluster_id}/{keyspace}/{name}/metrics.
+func WorkflowMetricsPassthrough(ctx context.Context, r vtadminhttp.Request, api *vtadminhttp.API) *vtadminhttp.JSONResponse {
+	vars := r.Vars()
+
+	workflow, err := api.Server().GetWorkflow(ctx, &vtadminpb.GetWorkflowRequest{
+		ClusterId: vars["cluster_id"],
+		Keyspace:  vars["keyspace"],
+		Name:      vars["name"],
+	})
+	if err != nil {
+		return vtadminhttp.NewJSONResponse(nil, err)
+	}
+
+	aliases := map[string]bool{}
+	for _, shardStreams := range workflow.Workflow.ShardStreams {
+		for _, stream := range shardStreams.Streams {
+			if stream.Tablet != nil {
+				aliases[topoproto.TabletAliasString(stream.Tablet)] = true
+			}
+		}
+	}
+
+	metrics := &WorkflowMetrics{}
+	for alias := range aliases {
+		metrics.Tablets = append(metrics.Tablets, alias)
+	}
+	sort.Strings(metrics.Tablets)
+
+	for _, alias := range metrics.Tablets {
+		tablet, err := api.Server().GetTablet(ctx, &vtadminpb.GetTabletRequest{
+			Alias:      alias,
+			ClusterIds: []string{vars["cluster_id"]},
+		})
+		if err != nil {
+			return vtadminhttp.NewJSONResponse(nil, err)
+		}
+
+		debugVars, err := getDebugVars(ctx, api, tablet)
+		if err != nil {
+			// Report the metrics of the other tablets, rather than none.
+			metrics.UnreachableTablets = append(metrics.UnreachableTablets, alias)
+			continue
+		}
+
+		metrics.add(debugVars, vars["name"])
+	}
+
+	return vtadminhttp.NewJSONResponse(metrics, nil)
+}
+
+// add adds the metrics of a workflow exported by a tablet.
+func (m *WorkflowMetrics) add(debugVars map[string]interface{}, workflow string) {
+	value := func(name string) int64 {
+		byWorkflow, ok := debugVars[name].(map[string]interface{})
+		if !ok {
+			return 0
+		}
+
+		v, ok := byWorkflow[workflow].(float64)
+		if !ok {
+			return 0
+		}
+
+		// The lag of stopped streams is exported as math.MaxInt64, which
+		// does not survive the float64 of the JSON.
+		if v >= math.MaxInt64 {
+			return math.MaxInt64
+		}
+
+		return int64(v)
+	}
+
+	if lag := value("VReplicationWorkflowLagSecondsMax"); lag > m.LagSecondsMax {
+		m.LagSecondsMax = lag
+	}
+
+	m.Events += value("VReplicationWorkflowEvents")
+	m.EventBytes += value("VReplicationWorkflowEventBytes")
+	m.EventsPerSecond += value("VReplicationWorkflowEventsPerSecond")
+	m.EventBytesPerSecond += value("VReplicationWorkflowEventBytesPerSecond")
+	m.RetryableErrors += value("VReplicationWorkflowRetryableErrors")
+	m.FatalErrors += value("VReplicationWorkflowFatalErrors")
+	m.TablesToCopy += value("VReplicationWorkflowTablesToCopy")
+}
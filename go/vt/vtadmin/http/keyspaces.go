@@ -77,6 +77,18 @@ func GetKeyspace(ctx context.Context, r Request, api *API) *JSONResponse {
 	return NewJSONResponse(keyspace, err)
 }
 
+// GetKeyspaceGraph implements the http wrapper for
+// /keyspace/{cluster_id}/{name}/graph.
+func GetKeyspaceGraph(ctx context.Context, r Request, api *API) *JSONResponse {
+	vars := mux.Vars(r.Request)
+	graph, err := api.server.GetKeyspaceGraph(ctx, &vtadminpb.GetKeyspaceGraphRequest{
+		ClusterId: vars["cluster_id"],
+		Keyspace:  vars["name"],
+	})
+
+	return NewJSONResponse(graph, err)
+}
+
 // GetKeyspaces implements the http wrapper for /keyspaces[?cluster=[&cluster=]].
 func GetKeyspaces(ctx context.Context, r Request, api *API) *JSONResponse {
 	keyspaces, err := api.server.GetKeyspaces(ctx, &vtadminpb.GetKeyspacesRequest{
@@ -18,10 +18,113 @@ package http
 
 import (
 	"context"
+	"encoding/json"
+	"io"
+
+	"vitess.io/vitess/go/vt/vtadmin/errors"
 
 	vtadminpb "vitess.io/vitess/go/vt/proto/vtadmin"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
 )
 
+// CancelWorkflow implements the http wrapper for the
+// VTAdminServer.CancelWorkflow method.
+//
+// Its route is DELETE /workflow/{cluster_id}/{keyspace}/{name}[?keep_data=].
+func CancelWorkflow(ctx context.Context, r Request, api *API) *JSONResponse {
+	vars := r.Vars()
+
+	keepData, err := r.ParseQueryParamAsBool("keep_data", false)
+	if err != nil {
+		return NewJSONResponse(nil, err)
+	}
+
+	resp, err := api.server.CancelWorkflow(ctx, &vtadminpb.CancelWorkflowRequest{
+		ClusterId: vars["cluster_id"],
+		Options: &vtctldatapb.WorkflowCancelRequest{
+			Keyspace: vars["keyspace"],
+			Workflow: vars["name"],
+			KeepData: keepData,
+		},
+	})
+
+	return NewJSONResponse(resp, err)
+}
+
+// CompleteWorkflow implements the http wrapper for the
+// VTAdminServer.CompleteWorkflow method.
+//
+// Its route is POST /workflow/{cluster_id}/{keyspace}/{name}/complete, with an
+// optional vtctldata.WorkflowCompleteRequest body. Set "dry_run" to get the
+// actions which would be taken, to confirm them before completing the
+// workflow.
+func CompleteWorkflow(ctx context.Context, r Request, api *API) *JSONResponse {
+	vars := r.Vars()
+
+	var req vtctldatapb.WorkflowCompleteRequest
+	if err := decodeOptionalBody(r, &req); err != nil {
+		return NewJSONResponse(nil, err)
+	}
+
+	req.Keyspace = vars["keyspace"]
+	req.Workflow = vars["name"]
+
+	resp, err := api.server.CompleteWorkflow(ctx, &vtadminpb.CompleteWorkflowRequest{
+		ClusterId: vars["cluster_id"],
+		Options:   &req,
+	})
+
+	return NewJSONResponse(resp, err)
+}
+
+// CreateMoveTablesWorkflow implements the http wrapper for the
+// VTAdminServer.CreateMoveTablesWorkflow method.
+//
+// Its route is POST /workflow/{cluster_id}/movetables, with a
+// vtctldata.MoveTablesCreateRequest body.
+func CreateMoveTablesWorkflow(ctx context.Context, r Request, api *API) *JSONResponse {
+	decoder := json.NewDecoder(r.Body)
+	defer r.Body.Close()
+
+	var req vtctldatapb.MoveTablesCreateRequest
+	if err := decoder.Decode(&req); err != nil {
+		return NewJSONResponse(nil, &errors.BadRequest{
+			Err: err,
+		})
+	}
+
+	resp, err := api.server.CreateMoveTablesWorkflow(ctx, &vtadminpb.CreateMoveTablesWorkflowRequest{
+		ClusterId: r.Vars()["cluster_id"],
+		Options:   &req,
+	})
+
+	return NewJSONResponse(resp, err)
+}
+
+// CreateReshardWorkflow implements the http wrapper for the
+// VTAdminServer.CreateReshardWorkflow method.
+//
+// Its route is POST /workflow/{cluster_id}/reshard, with a
+// vtctldata.ReshardCreateRequest body.
+func CreateReshardWorkflow(ctx context.Context, r Request, api *API) *JSONResponse {
+	decoder := json.NewDecoder(r.Body)
+	defer r.Body.Close()
+
+	var req vtctldatapb.ReshardCreateRequest
+	if err := decoder.Decode(&req); err != nil {
+		return NewJSONResponse(nil, &errors.BadRequest{
+			Err: err,
+		})
+	}
+
+	resp, err := api.server.CreateReshardWorkflow(ctx, &vtadminpb.CreateReshardWorkflowRequest{
+		ClusterId: r.Vars()["cluster_id"],
+		Options:   &req,
+	})
+
+	return NewJSONResponse(resp, err)
+}
+
 // GetWorkflow implements the http wrapper for the VTAdminServer.GetWorkflow
 // method.
 //
@@ -69,3 +172,46 @@ func GetWorkflows(ctx context.Context, r Request, api *API) *JSONResponse {
 
 	return NewJSONResponse(workflows, err)
 }
+
+// SwitchWorkflowTraffic implements the http wrapper for the
+// VTAdminServer.SwitchWorkflowTraffic method.
+//
+// Its route is POST /workflow/{cluster_id}/{keyspace}/{name}/switch_traffic,
+// with an optional vtctldata.WorkflowSwitchTrafficRequest body. Set
+// "direction" to 1 to reverse the traffic, and "dry_run" to get the actions
+// which would be taken, to confirm them before switching the traffic.
+func SwitchWorkflowTraffic(ctx context.Context, r Request, api *API) *JSONResponse {
+	vars := r.Vars()
+
+	var req vtctldatapb.WorkflowSwitchTrafficRequest
+	if err := decodeOptionalBody(r, &req); err != nil {
+		return NewJSONResponse(nil, err)
+	}
+
+	req.Keyspace = vars["keyspace"]
+	req.Workflow = vars["name"]
+
+	resp, err := api.server.SwitchWorkflowTraffic(ctx, &vtadminpb.SwitchWorkflowTrafficRequest{
+		ClusterId: vars["cluster_id"],
+		Options:   &req,
+	})
+
+	return NewJSONResponse(resp, err)
+}
+
+// decodeOptionalBody decodes the JSON body of the request into v, leaving v
+// as-is if the body is empty.
+func decodeOptionalBody(r Request, v interface{}) error {
+	if r.Body == nil {
+		return nil
+	}
+	defer r.Body.Close()
+
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil && err != io.EOF {
+		return &errors.BadRequest{
+			Err: err,
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rbac
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/vt/log"
+)
+
+// AuditEvent is the record of a mutating API call in the audit log.
+type AuditEvent struct {
+	Time time.Time `json:"time"`
+	// Actor is the authenticated actor which made the call, or nil if it was
+	// unauthenticated.
+	Actor *Actor `json:"actor"`
+	// Method is the name of the API method, e.g. "CreateKeyspace".
+	Method    string   `json:"method"`
+	ClusterID string   `json:"cluster_id"`
+	Keyspace  string   `json:"keyspace,omitempty"`
+	Resource  Resource `json:"resource"`
+	Action    Action   `json:"action"`
+	// Authorized is whether the actor was authorized to make the call. The
+	// calls which were not authorized are recorded too.
+	Authorized bool `json:"authorized"`
+	// Request is the request of the call, as JSON.
+	Request json.RawMessage `json:"request,omitempty"`
+	// Error is the error the call failed with, if any.
+	Error string `json:"error,omitempty"`
+}
+
+// Auditor records AuditEvents. Auditors are called synchronously, from the
+// API methods, and must be safe for concurrent use.
+type Auditor interface {
+	Audit(ctx context.Context, event *AuditEvent)
+}
+
+// AuditorFunc adapts a function to the Auditor interface.
+type AuditorFunc func(ctx context.Context, event *AuditEvent)
+
+// Audit is part of the Auditor interface.
+func (f AuditorFunc) Audit(ctx context.Context, event *AuditEvent) {
+	f(ctx, event)
+}
+
+// NewLogAuditor returns an Auditor which logs the AuditEvents, as JSON.
+func NewLogAuditor() Auditor {
+	return AuditorFunc(func(ctx context.Context, event *AuditEvent) {
+		data, err := json.Marshal(event)
+		if err != nil {
+			log.Errorf("[rbac]: cannot marshal audit event %+v: %s", event, err)
+			return
+		}
+
+		log.Infof("[rbac] audit: %s", data)
+	})
+}
+
+// NewWriterAuditor returns an Auditor which writes the AuditEvents to w, as
+// JSON, one per line.
+func NewWriterAuditor(w io.Writer) Auditor {
+	var m sync.Mutex
+	enc := json.NewEncoder(w)
+
+	return AuditorFunc(func(ctx context.Context, event *AuditEvent) {
+		m.Lock()
+		defer m.Unlock()
+
+		if err := enc.Encode(event); err != nil {
+			log.Errorf("[rbac]: cannot write audit event %+v: %s", event, err)
+		}
+	})
+}
+
+// newAuditor returns the Auditor of the AuditLog of a Config: nil if it is
+// empty, a log Auditor if it is "log", and otherwise a writer Auditor
+// appending to the file at that path.
+func newAuditor(auditLog string) (Auditor, error) {
+	switch auditLog {
+	case "":
+		return nil, nil
+	case "log":
+		return NewLogAuditor(), nil
+	}
+
+	f, err := os.OpenFile(auditLog, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewWriterAuditor(f), nil
+}
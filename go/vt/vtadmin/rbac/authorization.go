@@ -18,13 +18,21 @@ package rbac
 
 import (
 	"context"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"vitess.io/vitess/go/vt/log"
 )
 
 // Authorizer contains a set of rules that determine which actors may take which
-// actions on which resources in which clusters.
+// actions on which resources in which clusters, and an optional Auditor which
+// records the mutating actions.
 type Authorizer struct {
 	// keyed by resource name
 	policies map[string][]*Rule
+	auditor  Auditor
 }
 
 // NewAuthorizer returns a new Authorizer based on the given Config, which
@@ -35,10 +43,11 @@ type Authorizer struct {
 //
 // 	authz, err := rbac.NewAuthorizer(&rbac.Config{
 // 		Rules: []*struct {
-// 			Resource string
-// 			Actions  []string
-// 			Subjects []string
-// 			Clusters []string
+// 			Resource  string
+// 			Actions   []string
+// 			Subjects  []string
+// 			Clusters  []string
+// 			Keyspaces []string
 // 		}{
 // 			{
 // 				Resource: "*",
@@ -55,17 +64,27 @@ func NewAuthorizer(cfg *Config) (*Authorizer, error) {
 
 	return &Authorizer{
 		policies: cfg.cfg,
+		auditor:  cfg.auditor,
 	}, nil
 }
 
 // IsAuthorized returns whether an Actor (from the context) is permitted to take
-// the given action on the given resource in the given cluster.
+// the given action on the given resource in the given cluster, across all of
+// its keyspaces.
 func (authz *Authorizer) IsAuthorized(ctx context.Context, clusterID string, resource Resource, action Action) bool {
+	return authz.IsAuthorizedForKeyspace(ctx, clusterID, "", resource, action)
+}
+
+// IsAuthorizedForKeyspace returns whether an Actor (from the context) is
+// permitted to take the given action on the given resource in the given
+// keyspace of the given cluster. An empty keyspace is equivalent to
+// IsAuthorized.
+func (authz *Authorizer) IsAuthorizedForKeyspace(ctx context.Context, clusterID string, keyspace string, resource Resource, action Action) bool {
 	actor, _ := FromContext(ctx) // nil is ok here, since rule.Allows handles it
 	if p, ok := authz.policies["*"]; ok {
 		// We have policies for the wildcard resource to check first
 		for _, rule := range p {
-			if rule.Allows(clusterID, action, actor) {
+			if rule.Allows(clusterID, keyspace, action, actor) {
 				return true
 			}
 		}
@@ -73,7 +92,7 @@ func (authz *Authorizer) IsAuthorized(ctx context.Context, clusterID string, res
 
 	if p, ok := authz.policies[string(resource)]; ok {
 		for _, rule := range p {
-			if rule.Allows(clusterID, action, actor) {
+			if rule.Allows(clusterID, keyspace, action, actor) {
 				return true
 			}
 		}
@@ -81,3 +100,35 @@ func (authz *Authorizer) IsAuthorized(ctx context.Context, clusterID string, res
 
 	return false
 }
+
+// Audit records a mutating call in the audit log, if the Authorizer has one,
+// with the actor from the context, the request parameters and the error the
+// call failed with, if any. Non-mutating calls are not recorded.
+func (authz *Authorizer) Audit(ctx context.Context, event *AuditEvent, req proto.Message, err error) {
+	if authz.auditor == nil || !event.Action.IsMutating() {
+		return
+	}
+
+	if event.Time.IsZero() {
+		event.Time = time.Now()
+	}
+
+	if event.Actor == nil {
+		event.Actor, _ = FromContext(ctx)
+	}
+
+	if req != nil {
+		data, merr := protojson.Marshal(req)
+		if merr != nil {
+			log.Warningf("[rbac]: cannot marshal the request of %s for the audit log: %s", event.Method, merr)
+		} else {
+			event.Request = data
+		}
+	}
+
+	if err != nil {
+		event.Error = err.Error()
+	}
+
+	authz.auditor.Audit(ctx, event)
+}
@@ -18,10 +18,13 @@ package rbac
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
 )
 
 func TestIsAuthorized(t *testing.T) {
@@ -29,10 +32,11 @@ func TestIsAuthorized(t *testing.T) {
 
 	authz, err := NewAuthorizer(&Config{
 		Rules: []*struct {
-			Resource string
-			Actions  []string
-			Subjects []string
-			Clusters []string
+			Resource  string
+			Actions   []string
+			Subjects  []string
+			Clusters  []string
+			Keyspaces []string
 		}{
 			{
 				Resource: "*",
@@ -52,6 +56,13 @@ func TestIsAuthorized(t *testing.T) {
 				Subjects: []string{"*"},
 				Clusters: []string{"*"},
 			},
+			{
+				Resource:  string(ShardResource),
+				Actions:   []string{string(ReparentAction)},
+				Subjects:  []string{"role:ks1dba"},
+				Clusters:  []string{"c1"},
+				Keyspaces: []string{"ks1"},
+			},
 		},
 	})
 	require.NoError(t, err)
@@ -60,6 +71,7 @@ func TestIsAuthorized(t *testing.T) {
 		name         string
 		actor        *Actor
 		clusterID    string
+		keyspace     string
 		resource     Resource
 		action       Action
 		isAuthorized bool
@@ -101,6 +113,53 @@ func TestIsAuthorized(t *testing.T) {
 			action:       GetAction,
 			isAuthorized: true,
 		},
+		{
+			name: "keyspace rule",
+			actor: &Actor{
+				Name:  "someuser",
+				Roles: []string{"ks1dba"},
+			},
+			clusterID:    "c1",
+			keyspace:     "ks1",
+			resource:     ShardResource,
+			action:       ReparentAction,
+			isAuthorized: true,
+		},
+		{
+			name: "keyspace rule in other keyspace",
+			actor: &Actor{
+				Name:  "someuser",
+				Roles: []string{"ks1dba"},
+			},
+			clusterID:    "c1",
+			keyspace:     "ks2",
+			resource:     ShardResource,
+			action:       ReparentAction,
+			isAuthorized: false,
+		},
+		{
+			name: "keyspace rule for whole cluster",
+			actor: &Actor{
+				Name:  "someuser",
+				Roles: []string{"ks1dba"},
+			},
+			clusterID:    "c1",
+			resource:     ShardResource,
+			action:       ReparentAction,
+			isAuthorized: false,
+		},
+		{
+			name: "cluster rule in keyspace",
+			actor: &Actor{
+				Name:  "someuser",
+				Roles: []string{"testrole"},
+			},
+			clusterID:    "c1",
+			keyspace:     "ks2",
+			resource:     KeyspaceResource,
+			action:       DeleteAction,
+			isAuthorized: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -110,9 +169,40 @@ func TestIsAuthorized(t *testing.T) {
 			t.Parallel()
 
 			ctx := NewContext(context.Background(), tt.actor)
-			got := authz.IsAuthorized(ctx, tt.clusterID, tt.resource, tt.action)
+			got := authz.IsAuthorizedForKeyspace(ctx, tt.clusterID, tt.keyspace, tt.resource, tt.action)
 
 			assert.Equal(t, tt.isAuthorized, got)
 		})
 	}
 }
+
+func TestAudit(t *testing.T) {
+	t.Parallel()
+
+	var events []*AuditEvent
+	authz := &Authorizer{
+		auditor: AuditorFunc(func(ctx context.Context, event *AuditEvent) {
+			events = append(events, event)
+		}),
+	}
+
+	ctx := NewContext(context.Background(), &Actor{Name: "testuser"})
+	authz.Audit(ctx, &AuditEvent{Method: "GetTablets", Action: GetAction}, nil, nil)
+	assert.Empty(t, events, "non-mutating calls should not be audited")
+
+	authz.Audit(ctx, &AuditEvent{
+		Method:    "DeleteKeyspace",
+		ClusterID: "c1",
+		Keyspace:  "ks1",
+		Resource:  KeyspaceResource,
+		Action:    DeleteAction,
+	}, &vtctldatapb.DeleteKeyspaceRequest{Keyspace: "ks1"}, errors.New("some error"))
+	require.Len(t, events, 1)
+
+	event := events[0]
+	assert.Equal(t, &Actor{Name: "testuser"}, event.Actor)
+	assert.False(t, event.Time.IsZero())
+	assert.False(t, event.Authorized)
+	assert.JSONEq(t, `{"keyspace": "ks1"}`, string(event.Request))
+	assert.Equal(t, "some error", event.Error)
+}
@@ -30,13 +30,22 @@ import (
 // Config is the RBAC configuration representation. The public fields are
 // populated by viper during LoadConfig, and the private fields are set during
 // cfg.Reify. A config must be reified before first use.
+//
+// A rule may be scoped to keyspaces of its clusters by Keyspaces, which allows
+// the wildcard ("*"). The Authenticator may be "oidc", for the builtin OIDC
+// Authenticator configured by OIDC. AuditLog is "log" to log the audit events,
+// or the path of a file to append them to; the audit log is disabled if it is
+// empty.
 type Config struct {
 	Authenticator string
+	OIDC          *OIDCConfig
+	AuditLog      string
 	Rules         []*struct {
-		Resource string
-		Actions  []string
-		Subjects []string
-		Clusters []string
+		Resource  string
+		Actions   []string
+		Subjects  []string
+		Clusters  []string
+		Keyspaces []string
 	}
 
 	reified bool
@@ -44,6 +53,7 @@ type Config struct {
 	cfg           map[string][]*Rule
 	authenticator Authenticator
 	authorizer    *Authorizer
+	auditor       Auditor
 }
 
 // LoadConfig reads the file at path into a Config struct, and then reifies
@@ -107,10 +117,17 @@ func (c *Config) Reify() error {
 			rec.RecordError(fmt.Errorf("rule %d: clusters list cannot include wildcard and other clusters, have %v", i, clusters.List()))
 		}
 
+		keyspaces := sets.NewString(rule.Keyspaces...)
+		if keyspaces.Has("*") && keyspaces.Len() > 1 {
+			// error to have wildcard and something else
+			rec.RecordError(fmt.Errorf("rule %d: keyspaces list cannot include wildcard and other keyspaces, have %v", i, keyspaces.List()))
+		}
+
 		resourceRules = append(resourceRules, &Rule{
-			actions:  actions,
-			subjects: subjects,
-			clusters: clusters,
+			actions:   actions,
+			subjects:  subjects,
+			clusters:  clusters,
+			keyspaces: keyspaces,
 		})
 		byResource[rule.Resource] = resourceRules
 	}
@@ -121,9 +138,20 @@ func (c *Config) Reify() error {
 
 	log.Infof("[rbac]: loaded authorizer with %d rules", len(c.Rules))
 
+	auditor, err := newAuditor(c.AuditLog)
+	if err != nil {
+		return err
+	}
+
+	if auditor == nil {
+		log.Info("[rbac]: no audit log specified")
+	}
+
 	c.cfg = byResource
+	c.auditor = auditor
 	c.authorizer = &Authorizer{
 		policies: c.cfg,
+		auditor:  c.auditor,
 	}
 
 	// reify the authenticator
@@ -134,6 +162,13 @@ func (c *Config) Reify() error {
 			return err
 		}
 
+		c.authenticator = authn
+	case c.Authenticator == OIDCAuthenticatorName:
+		authn, err := NewOIDCAuthenticator(c.OIDC)
+		if err != nil {
+			return err
+		}
+
 		c.authenticator = authn
 	case c.Authenticator != "":
 		factory, ok := authenticators[c.Authenticator]
@@ -0,0 +1,337 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rbac
+
+import (
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// OIDCAuthenticatorName is the name of the builtin OIDC Authenticator in the
+// rbac config.
+const OIDCAuthenticatorName = "oidc"
+
+// OIDCConfig configures the builtin OIDC Authenticator, which authenticates
+// actors by the ID tokens issued by an OpenID Connect provider.
+//
+// The ID token is read from the "authorization" header (gRPC metadata or HTTP
+// header) as a bearer token, or from the CookieName cookie of HTTP requests.
+// Only RS256-signed tokens are supported.
+type OIDCConfig struct {
+	// IssuerURL is the URL of the provider, whose discovery document is at
+	// IssuerURL + "/.well-known/openid-configuration". It must match the "iss"
+	// claim of the tokens.
+	IssuerURL string
+	// ClientID is the client ID of vtadmin with the provider, which must be
+	// one of the audiences ("aud" claim) of the tokens.
+	ClientID string
+	// NameClaim is the claim of the actor's name. It defaults to "email".
+	NameClaim string
+	// RolesClaim is the claim of the actor's roles, which must be a list of
+	// strings or a string. It defaults to "groups".
+	RolesClaim string
+	// CookieName is the optional name of the cookie with the ID token of HTTP
+	// requests.
+	CookieName string
+}
+
+type oidcAuthenticator struct {
+	cfg    OIDCConfig
+	client *http.Client
+	now    func() time.Time
+
+	m    sync.Mutex
+	keys map[string]*rsa.PublicKey
+	// keysFetched is when the keys were last fetched, to not refetch them
+	// more often than every minute for tokens with unknown key IDs.
+	keysFetched time.Time
+}
+
+// NewOIDCAuthenticator returns the OIDC Authenticator of the config.
+func NewOIDCAuthenticator(cfg *OIDCConfig) (Authenticator, error) {
+	if cfg == nil || cfg.IssuerURL == "" || cfg.ClientID == "" {
+		return nil, errors.New("the oidc authenticator requires an issuer URL and a client ID")
+	}
+
+	authn := &oidcAuthenticator{
+		cfg:    *cfg,
+		client: &http.Client{Timeout: 10 * time.Second},
+		now:    time.Now,
+	}
+	if authn.cfg.NameClaim == "" {
+		authn.cfg.NameClaim = "email"
+	}
+	if authn.cfg.RolesClaim == "" {
+		authn.cfg.RolesClaim = "groups"
+	}
+
+	return authn, nil
+}
+
+// Authenticate is part of the Authenticator interface.
+func (authn *oidcAuthenticator) Authenticate(ctx context.Context) (*Actor, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, nil
+	}
+
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return nil, nil
+	}
+
+	return authn.authenticateToken(ctx, values[0])
+}
+
+// AuthenticateHTTP is part of the Authenticator interface.
+func (authn *oidcAuthenticator) AuthenticateHTTP(r *http.Request) (*Actor, error) {
+	if header := r.Header.Get("Authorization"); header != "" {
+		return authn.authenticateToken(r.Context(), header)
+	}
+
+	if authn.cfg.CookieName != "" {
+		if cookie, err := r.Cookie(authn.cfg.CookieName); err == nil {
+			return authn.authenticateToken(r.Context(), "Bearer "+cookie.Value)
+		}
+	}
+
+	return nil, nil
+}
+
+func (authn *oidcAuthenticator) authenticateToken(ctx context.Context, authorization string) (*Actor, error) {
+	const prefix = "bearer "
+	if len(authorization) < len(prefix) || !strings.EqualFold(authorization[:len(prefix)], prefix) {
+		return nil, errors.New("the authorization is not a bearer token")
+	}
+
+	claims, err := authn.verify(ctx, strings.TrimSpace(authorization[len(prefix):]))
+	if err != nil {
+		return nil, err
+	}
+
+	name, _ := claims[authn.cfg.NameClaim].(string)
+	if name == "" {
+		return nil, fmt.Errorf("the token has no %s claim", authn.cfg.NameClaim)
+	}
+
+	actor := &Actor{Name: name}
+	switch roles := claims[authn.cfg.RolesClaim].(type) {
+	case string:
+		actor.Roles = []string{roles}
+	case []interface{}:
+		for _, role := range roles {
+			if role, ok := role.(string); ok {
+				actor.Roles = append(actor.Roles, role)
+			}
+		}
+	}
+
+	return actor, nil
+}
+
+// verify verifies the signature and the claims of the ID token, and returns
+// its claims.
+func (authn *oidcAuthenticator) verify(ctx context.Context, token string) (map[string]interface{}, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, errors.New("malformed token")
+	}
+
+	var header struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}
+	if err := decodeTokenPart(parts[0], &header); err != nil {
+		return nil, fmt.Errorf("malformed token header: %w", err)
+	}
+
+	if header.Alg != "RS256" {
+		return nil, fmt.Errorf("unsupported token signing algorithm %s", header.Alg)
+	}
+
+	key, err := authn.key(ctx, header.Kid)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("malformed token signature: %w", err)
+	}
+
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature); err != nil {
+		return nil, fmt.Errorf("invalid token signature: %w", err)
+	}
+
+	var claims map[string]interface{}
+	if err := decodeTokenPart(parts[1], &claims); err != nil {
+		return nil, fmt.Errorf("malformed token claims: %w", err)
+	}
+
+	if iss, _ := claims["iss"].(string); iss != authn.cfg.IssuerURL {
+		return nil, fmt.Errorf("the token is issued by %q, not %q", iss, authn.cfg.IssuerURL)
+	}
+
+	if !hasAudience(claims["aud"], authn.cfg.ClientID) {
+		return nil, fmt.Errorf("the token is not issued to %s", authn.cfg.ClientID)
+	}
+
+	now := authn.now()
+	if exp, ok := claims["exp"].(float64); !ok || now.After(time.Unix(int64(exp), 0)) {
+		return nil, errors.New("the token is expired")
+	}
+
+	if nbf, ok := claims["nbf"].(float64); ok && now.Before(time.Unix(int64(nbf), 0)) {
+		return nil, errors.New("the token is not valid yet")
+	}
+
+	return claims, nil
+}
+
+// key returns the signing key of the provider with the key ID, fetching the
+// keys of the provider if the key is not known.
+func (authn *oidcAuthenticator) key(ctx context.Context, kid string) (*rsa.PublicKey, error) {
+	authn.m.Lock()
+	defer authn.m.Unlock()
+
+	if key, ok := authn.keys[kid]; ok {
+		return key, nil
+	}
+
+	if authn.keys != nil && authn.now().Sub(authn.keysFetched) < time.Minute {
+		return nil, fmt.Errorf("unknown token signing key %q", kid)
+	}
+
+	keys, err := authn.fetchKeys(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("cannot fetch the token signing keys: %w", err)
+	}
+
+	authn.keys = keys
+	authn.keysFetched = authn.now()
+
+	if key, ok := authn.keys[kid]; ok {
+		return key, nil
+	}
+
+	return nil, fmt.Errorf("unknown token signing key %q", kid)
+}
+
+func (authn *oidcAuthenticator) fetchKeys(ctx context.Context) (map[string]*rsa.PublicKey, error) {
+	var discovery struct {
+		JWKSURI string `json:"jwks_uri"`
+	}
+	if err := authn.getJSON(ctx, strings.TrimSuffix(authn.cfg.IssuerURL, "/")+"/.well-known/openid-configuration", &discovery); err != nil {
+		return nil, err
+	}
+
+	var jwks struct {
+		Keys []struct {
+			Kty string `json:"kty"`
+			Kid string `json:"kid"`
+			Use string `json:"use"`
+			N   string `json:"n"`
+			E   string `json:"e"`
+		} `json:"keys"`
+	}
+	if err := authn.getJSON(ctx, discovery.JWKSURI, &jwks); err != nil {
+		return nil, err
+	}
+
+	keys := make(map[string]*rsa.PublicKey, len(jwks.Keys))
+	for _, jwk := range jwks.Keys {
+		if jwk.Kty != "RSA" || (jwk.Use != "" && jwk.Use != "sig") {
+			continue
+		}
+
+		n, err := base64.RawURLEncoding.DecodeString(jwk.N)
+		if err != nil {
+			return nil, fmt.Errorf("malformed key %s: %w", jwk.Kid, err)
+		}
+
+		e, err := base64.RawURLEncoding.DecodeString(jwk.E)
+		if err != nil {
+			return nil, fmt.Errorf("malformed key %s: %w", jwk.Kid, err)
+		}
+
+		keys[jwk.Kid] = &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}
+	}
+
+	return keys, nil
+}
+
+func (authn *oidcAuthenticator) getJSON(ctx context.Context, url string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := authn.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %s: %s", url, resp.Status)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+func decodeTokenPart(part string, v interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(part)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, v)
+}
+
+// hasAudience returns whether the "aud" claim, a string or a list of
+// strings, contains the audience.
+func hasAudience(aud interface{}, audience string) bool {
+	switch aud := aud.(type) {
+	case string:
+		return aud == audience
+	case []interface{}:
+		for _, a := range aud {
+			if a == audience {
+				return true
+			}
+		}
+	}
+
+	return false
+}
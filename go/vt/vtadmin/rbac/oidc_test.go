@@ -0,0 +1,178 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rbac
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestOIDCAuthenticator(t *testing.T) {
+	t.Parallel()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	mux := http.NewServeMux()
+	provider := httptest.NewServer(mux)
+	t.Cleanup(provider.Close)
+
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{"jwks_uri": provider.URL + "/keys"})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"keys": []map[string]string{{
+				"kty": "RSA",
+				"kid": "key1",
+				"use": "sig",
+				"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			}},
+		})
+	})
+
+	sign := func(kid string, claims map[string]interface{}) string {
+		header, err := json.Marshal(map[string]string{"alg": "RS256", "kid": kid})
+		require.NoError(t, err)
+		payload, err := json.Marshal(claims)
+		require.NoError(t, err)
+
+		signed := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+		digest := sha256.Sum256([]byte(signed))
+		signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+		require.NoError(t, err)
+
+		return signed + "." + base64.RawURLEncoding.EncodeToString(signature)
+	}
+
+	authn, err := NewOIDCAuthenticator(&OIDCConfig{
+		IssuerURL:  provider.URL,
+		ClientID:   "vtadmin",
+		CookieName: "id_token",
+	})
+	require.NoError(t, err)
+
+	claims := func(overrides map[string]interface{}) map[string]interface{} {
+		claims := map[string]interface{}{
+			"iss":    provider.URL,
+			"aud":    []string{"other", "vtadmin"},
+			"exp":    time.Now().Add(time.Hour).Unix(),
+			"email":  "user@example.com",
+			"groups": []string{"dba", "dev"},
+		}
+		for k, v := range overrides {
+			claims[k] = v
+		}
+
+		return claims
+	}
+
+	tests := []struct {
+		name      string
+		token     string
+		expected  *Actor
+		shouldErr bool
+	}{
+		{
+			name:     "valid token",
+			token:    sign("key1", claims(nil)),
+			expected: &Actor{Name: "user@example.com", Roles: []string{"dba", "dev"}},
+		},
+		{
+			name:      "expired token",
+			token:     sign("key1", claims(map[string]interface{}{"exp": time.Now().Add(-time.Hour).Unix()})),
+			shouldErr: true,
+		},
+		{
+			name:      "other issuer",
+			token:     sign("key1", claims(map[string]interface{}{"iss": "https://example.com"})),
+			shouldErr: true,
+		},
+		{
+			name:      "other audience",
+			token:     sign("key1", claims(map[string]interface{}{"aud": "other"})),
+			shouldErr: true,
+		},
+		{
+			name:      "unknown key",
+			token:     sign("key2", claims(nil)),
+			shouldErr: true,
+		},
+		{
+			name:      "no name",
+			token:     sign("key1", claims(map[string]interface{}{"email": ""})),
+			shouldErr: true,
+		},
+		{
+			name:      "malformed token",
+			token:     "not.a.token",
+			shouldErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+tt.token))
+			actor, err := authn.Authenticate(ctx)
+			if tt.shouldErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, actor)
+		})
+	}
+
+	t.Run("http cookie", func(t *testing.T) {
+		t.Parallel()
+
+		r := httptest.NewRequest(http.MethodGet, "/api/clusters", nil)
+		r.AddCookie(&http.Cookie{Name: "id_token", Value: sign("key1", claims(nil))})
+
+		actor, err := authn.AuthenticateHTTP(r)
+		require.NoError(t, err)
+		assert.Equal(t, "user@example.com", actor.Name)
+	})
+
+	t.Run("unauthenticated", func(t *testing.T) {
+		t.Parallel()
+
+		actor, err := authn.AuthenticateHTTP(httptest.NewRequest(http.MethodGet, "/api/clusters", nil))
+		assert.NoError(t, err)
+		assert.Nil(t, actor)
+	})
+}
@@ -54,6 +54,15 @@ check before calling into the cluster.
 5. Being unauthorized for an <action, resource> for a cluster does not fail the
 overall request. Instead, the action is simply not taken in that cluster, and is
 still taken in other clusters for which the actor is authorized.
+
+6. Rules may be scoped to keyspaces within their clusters. A rule scoped to
+keyspaces only grants access to the API methods which act on one of those
+keyspaces; methods which act on a whole cluster require a rule which is not
+scoped to keyspaces.
+
+7. Every mutating API call (that is, every action other than "get") is recorded
+in the audit log, if one is configured, with its actor and parameters, whether
+or not it was authorized.
 */
 package rbac
 
@@ -66,8 +75,22 @@ const (
 	CreateAction Action = "create"
 	DeleteAction Action = "delete"
 	GetAction    Action = "get"
+
+	// ReparentAction is the action of reparenting a Shard.
+	ReparentAction Action = "reparent"
+	// ApplyAction is the action of changing a Schema or a VSchema.
+	ApplyAction Action = "apply"
+	// ControlAction is the action of starting, stopping, switching traffic
+	// for, or completing a Workflow.
+	ControlAction Action = "control"
 )
 
+// IsMutating returns whether the action changes the resource it is taken on,
+// in which case it is audited.
+func (a Action) IsMutating() bool {
+	return a != GetAction
+}
+
 // Resource is an enum representing all resources managed by vtadmin.
 type Resource string
 
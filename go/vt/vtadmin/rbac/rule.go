@@ -24,17 +24,24 @@ import (
 
 // Rule is a single rule governing access to a particular resource.
 type Rule struct {
-	clusters sets.String
-	actions  sets.String
-	subjects sets.String
+	clusters  sets.String
+	keyspaces sets.String
+	actions   sets.String
+	subjects  sets.String
 }
 
 // Allows returns true if the actor is allowed to take the specified action in
-// the specified cluster.
+// the specified cluster and keyspace. An empty keyspace signifies an action on
+// the whole cluster, which is only allowed if the rule is not scoped to
+// keyspaces, or contains the wildcard ("*") keyspace.
 //
 // A nil actor signifies the unauthenticated state, and is only allowed access
 // if the rule contains the wildcard ("*") subject.
-func (r *Rule) Allows(clusterID string, action Action, actor *Actor) bool {
+func (r *Rule) Allows(clusterID string, keyspace string, action Action, actor *Actor) bool {
+	if r.keyspaces.Len() > 0 && !r.keyspaces.Has("*") && (keyspace == "" || !r.keyspaces.Has(keyspace)) {
+		return false
+	}
+
 	if r.clusters.HasAny("*", clusterID) {
 		if r.actions.HasAny("*", string(action)) {
 			if r.subjects.Has("*") {
@@ -61,6 +61,26 @@ type VtctldClient struct {
 		Response *vtctldatapb.GetWorkflowsResponse
 		Error    error
 	}
+	MoveTablesCreateResults struct {
+		Response *vtctldatapb.MoveTablesCreateResponse
+		Error    error
+	}
+	ReshardCreateResults struct {
+		Response *vtctldatapb.ReshardCreateResponse
+		Error    error
+	}
+	WorkflowCancelResults struct {
+		Response *vtctldatapb.WorkflowCancelResponse
+		Error    error
+	}
+	WorkflowCompleteResults struct {
+		Response *vtctldatapb.WorkflowCompleteResponse
+		Error    error
+	}
+	WorkflowSwitchTrafficResults struct {
+		Response *vtctldatapb.WorkflowSwitchTrafficResponse
+		Error    error
+	}
 }
 
 // Compile-time type assertion to make sure we haven't overriden a method
@@ -180,3 +200,28 @@ func (fake *VtctldClient) GetWorkflows(ctx context.Context, req *vtctldatapb.Get
 
 	return nil, fmt.Errorf("%w: no result set for keyspace %s", assert.AnError, req.Keyspace)
 }
+
+// MoveTablesCreate is part of the vtctldclient.VtctldClient interface.
+func (fake *VtctldClient) MoveTablesCreate(ctx context.Context, req *vtctldatapb.MoveTablesCreateRequest, opts ...grpc.CallOption) (*vtctldatapb.MoveTablesCreateResponse, error) {
+	return fake.MoveTablesCreateResults.Response, fake.MoveTablesCreateResults.Error
+}
+
+// ReshardCreate is part of the vtctldclient.VtctldClient interface.
+func (fake *VtctldClient) ReshardCreate(ctx context.Context, req *vtctldatapb.ReshardCreateRequest, opts ...grpc.CallOption) (*vtctldatapb.ReshardCreateResponse, error) {
+	return fake.ReshardCreateResults.Response, fake.ReshardCreateResults.Error
+}
+
+// WorkflowCancel is part of the vtctldclient.VtctldClient interface.
+func (fake *VtctldClient) WorkflowCancel(ctx context.Context, req *vtctldatapb.WorkflowCancelRequest, opts ...grpc.CallOption) (*vtctldatapb.WorkflowCancelResponse, error) {
+	return fake.WorkflowCancelResults.Response, fake.WorkflowCancelResults.Error
+}
+
+// WorkflowComplete is part of the vtctldclient.VtctldClient interface.
+func (fake *VtctldClient) WorkflowComplete(ctx context.Context, req *vtctldatapb.WorkflowCompleteRequest, opts ...grpc.CallOption) (*vtctldatapb.WorkflowCompleteResponse, error) {
+	return fake.WorkflowCompleteResults.Response, fake.WorkflowCompleteResults.Error
+}
+
+// WorkflowSwitchTraffic is part of the vtctldclient.VtctldClient interface.
+func (fake *VtctldClient) WorkflowSwitchTraffic(ctx context.Context, req *vtctldatapb.WorkflowSwitchTrafficRequest, opts ...grpc.CallOption) (*vtctldatapb.WorkflowSwitchTrafficResponse, error) {
+	return fake.WorkflowSwitchTrafficResults.Response, fake.WorkflowSwitchTrafficResults.Error
+}
@@ -161,6 +161,12 @@ func InitTabletMap(ts *topo.Server, tpb *vttestpb.VTTestTopology, mysqld mysqlct
 		}
 	}
 
+	if tpb.RoutingRules != nil {
+		if err := ts.SaveRoutingRules(ctx, tpb.RoutingRules); err != nil {
+			return 0, fmt.Errorf("SaveRoutingRules failed: %v", err)
+		}
+	}
+
 	// Rebuild the SrvVSchema object
 	if err := ts.RebuildSrvVSchema(ctx, tpb.Cells); err != nil {
 		return 0, fmt.Errorf("RebuildVSchemaGraph failed: %v", err)
@@ -348,7 +354,12 @@ func CreateKs(ctx context.Context, ts *topo.Server, tpb *vttestpb.VTTestTopology
 	}
 
 	// vschema for the keyspace
-	if schemaDir != "" {
+	switch {
+	case kpb.Vschema != nil:
+		if err := ts.SaveVSchema(ctx, keyspace, kpb.Vschema); err != nil {
+			return 0, fmt.Errorf("SaveVSchema(%v) failed: %v", keyspace, err)
+		}
+	case schemaDir != "":
 		f := path.Join(schemaDir, keyspace, "vschema.json")
 		if _, err := os.Stat(f); err == nil {
 			// load the vschema
@@ -639,6 +650,14 @@ func (itmc *internalTabletManagerClient) SetReadWrite(ctx context.Context, table
 	return fmt.Errorf("not implemented in vtcombo")
 }
 
+func (itmc *internalTabletManagerClient) ChangeTags(ctx context.Context, tablet *topodatapb.Tablet, tags map[string]string, replace bool) (map[string]string, error) {
+	t, ok := tabletMap[tablet.Alias.Uid]
+	if !ok {
+		return nil, fmt.Errorf("tmclient: cannot find tablet %v", tablet.Alias.Uid)
+	}
+	return t.tm.ChangeTags(ctx, tags, replace)
+}
+
 func (itmc *internalTabletManagerClient) ChangeType(ctx context.Context, tablet *topodatapb.Tablet, dbType topodatapb.TabletType) error {
 	t, ok := tabletMap[tablet.Alias.Uid]
 	if !ok {
@@ -661,6 +680,22 @@ func (itmc *internalTabletManagerClient) ExecuteHook(ctx context.Context, tablet
 	return nil, fmt.Errorf("not implemented in vtcombo")
 }
 
+func (itmc *internalTabletManagerClient) GetLifecycleHooks(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.GetLifecycleHooksResponse, error) {
+	t, ok := tabletMap[tablet.Alias.Uid]
+	if !ok {
+		return nil, fmt.Errorf("tmclient: cannot find tablet %v", tablet.Alias.Uid)
+	}
+	return t.tm.GetLifecycleHooks(ctx), nil
+}
+
+func (itmc *internalTabletManagerClient) GetVersion(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.GetVersionResponse, error) {
+	t, ok := tabletMap[tablet.Alias.Uid]
+	if !ok {
+		return nil, fmt.Errorf("tmclient: cannot find tablet %v", tablet.Alias.Uid)
+	}
+	return t.tm.GetVersion(ctx)
+}
+
 func (itmc *internalTabletManagerClient) RefreshState(ctx context.Context, tablet *topodatapb.Tablet) error {
 	t, ok := tabletMap[tablet.Alias.Uid]
 	if !ok {
@@ -727,6 +762,14 @@ func (itmc *internalTabletManagerClient) ExecuteFetchAsApp(ctx context.Context,
 	return nil, fmt.Errorf("not implemented in vtcombo")
 }
 
+func (itmc *internalTabletManagerClient) KillQueries(ctx context.Context, tablet *topodatapb.Tablet, fingerprint, user string) (int64, error) {
+	t, ok := tabletMap[tablet.Alias.Uid]
+	if !ok {
+		return 0, fmt.Errorf("tmclient: cannot find tablet %v", tablet.Alias.Uid)
+	}
+	return int64(t.tm.KillQueries(ctx, fingerprint, user)), nil
+}
+
 func (itmc *internalTabletManagerClient) MasterStatus(ctx context.Context, tablet *topodatapb.Tablet) (*replicationdatapb.PrimaryStatus, error) {
 	return nil, fmt.Errorf("not implemented in vtcombo")
 }
@@ -759,6 +802,10 @@ func (itmc *internalTabletManagerClient) VReplicationWaitForPos(ctx context.Cont
 	return fmt.Errorf("not implemented in vtcombo")
 }
 
+func (itmc *internalTabletManagerClient) CheckThrottler(ctx context.Context, tablet *topodatapb.Tablet, checks []*tabletmanagerdatapb.CheckThrottlerRequest_Check) ([]*tabletmanagerdatapb.CheckThrottlerResponse_Result, error) {
+	return nil, fmt.Errorf("not implemented in vtcombo")
+}
+
 func (itmc *internalTabletManagerClient) ResetReplication(ctx context.Context, tablet *topodatapb.Tablet) error {
 	return fmt.Errorf("not implemented in vtcombo")
 }
@@ -807,7 +854,7 @@ func (itmc *internalTabletManagerClient) PromoteReplica(ctx context.Context, tab
 	return "", fmt.Errorf("not implemented in vtcombo")
 }
 
-func (itmc *internalTabletManagerClient) Backup(ctx context.Context, tablet *topodatapb.Tablet, concurrency int, allowPrimary bool) (logutil.EventStream, error) {
+func (itmc *internalTabletManagerClient) Backup(ctx context.Context, tablet *topodatapb.Tablet, concurrency int, allowPrimary bool, compressionEngine string) (logutil.EventStream, error) {
 	return nil, fmt.Errorf("not implemented in vtcombo")
 }
 
@@ -815,6 +862,18 @@ func (itmc *internalTabletManagerClient) RestoreFromBackup(ctx context.Context,
 	return nil, fmt.Errorf("not implemented in vtcombo")
 }
 
+func (itmc *internalTabletManagerClient) GetRestoreProgress(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.RestoreProgress, error) {
+	t, ok := tabletMap[tablet.Alias.Uid]
+	if !ok {
+		return nil, fmt.Errorf("tmclient: cannot find tablet %v", tablet.Alias.Uid)
+	}
+	return t.tm.GetRestoreProgress(ctx), nil
+}
+
+func (itmc *internalTabletManagerClient) StreamArchivedBinlogs(ctx context.Context, tablet *topodatapb.Tablet, position string, callback func(*tabletmanagerdatapb.StreamArchivedBinlogsResponse) error) error {
+	return fmt.Errorf("not implemented in vtcombo")
+}
+
 func (itmc *internalTabletManagerClient) Close() {
 }
 
@@ -850,6 +909,10 @@ func (itmc *internalTabletManagerClient) ReplicaWasPromoted(ctx context.Context,
 	return fmt.Errorf("not implemented in vtcombo")
 }
 
+func (itmc *internalTabletManagerClient) SetReplicationDelay(ctx context.Context, tablet *topodatapb.Tablet, delay time.Duration) error {
+	return fmt.Errorf("not implemented in vtcombo")
+}
+
 func (itmc *internalTabletManagerClient) ReplicaWasRestarted(ctx context.Context, tablet *topodatapb.Tablet, parent *topodatapb.TabletAlias) error {
 	return fmt.Errorf("not implemented in vtcombo")
 }
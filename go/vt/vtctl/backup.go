@@ -40,7 +40,7 @@ func init() {
 	addCommand("Shards", command{
 		"BackupShard",
 		commandBackupShard,
-		"[-allow_primary=false] <keyspace/shard>",
+		"[-allow_primary=false] [-compression_engine=<engine>] <keyspace/shard>",
 		"Chooses a tablet and creates a backup for a shard."})
 	addCommand("Shards", command{
 		"RemoveBackup",
@@ -51,7 +51,7 @@ func init() {
 	addCommand("Tablets", command{
 		"Backup",
 		commandBackup,
-		"[-concurrency=4] [-allow_primary=false] <tablet alias>",
+		"[-concurrency=4] [-allow_primary=false] [-compression_engine=<engine>] <tablet alias>",
 		"Stops mysqld and uses the BackupStorage service to store a new backup. This function also remembers if the tablet was replicating so that it can restore the same state after the backup completes."})
 	addCommand("Tablets", command{
 		"RestoreFromBackup",
@@ -63,6 +63,7 @@ func init() {
 func commandBackup(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	concurrency := subFlags.Int("concurrency", 4, "Specifies the number of compression/checksum jobs to run simultaneously")
 	allowPrimary := subFlags.Bool("allow_primary", false, "Allows backups to be taken on primary. Warning!! If you are using the builtin backup engine, this will shutdown your primary mysql for as long as it takes to create a backup.")
+	compressionEngine := subFlags.String("compression_engine", "", "Compression engine of the backup, e.g. zstd, instead of the -compression_engine_name of the tablet. Only used by the builtin backup engine.")
 
 	// handle deprecated flags
 	// should be deleted in a future release
@@ -87,12 +88,13 @@ func commandBackup(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.Fl
 		*allowPrimary = *deprecatedAllowMaster
 	}
 
-	return execBackup(ctx, wr, tabletInfo.Tablet, *concurrency, *allowPrimary)
+	return execBackup(ctx, wr, tabletInfo.Tablet, *concurrency, *allowPrimary, *compressionEngine)
 }
 
 func commandBackupShard(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	concurrency := subFlags.Int("concurrency", 4, "Specifies the number of compression/checksum jobs to run simultaneously")
 	allowPrimary := subFlags.Bool("allow_primary", false, "Whether to use primary tablet for backup. Warning!! If you are using the builtin backup engine, this will shutdown your primary mysql for as long as it takes to create a backup.")
+	compressionEngine := subFlags.String("compression_engine", "", "Compression engine of the backup, e.g. zstd, instead of the -compression_engine_name of the tablet. Only used by the builtin backup engine.")
 
 	// handle deprecated flags
 	// should be deleted in a future release
@@ -162,12 +164,12 @@ func commandBackupShard(ctx context.Context, wr *wrangler.Wrangler, subFlags *fl
 		*allowPrimary = *deprecatedAllowMaster
 	}
 
-	return execBackup(ctx, wr, tabletForBackup, *concurrency, *allowPrimary)
+	return execBackup(ctx, wr, tabletForBackup, *concurrency, *allowPrimary, *compressionEngine)
 }
 
 // execBackup is shared by Backup and BackupShard
-func execBackup(ctx context.Context, wr *wrangler.Wrangler, tablet *topodatapb.Tablet, concurrency int, allowPrimary bool) error {
-	stream, err := wr.TabletManagerClient().Backup(ctx, tablet, concurrency, allowPrimary)
+func execBackup(ctx context.Context, wr *wrangler.Wrangler, tablet *topodatapb.Tablet, concurrency int, allowPrimary bool, compressionEngine string) error {
+	stream, err := wr.TabletManagerClient().Backup(ctx, tablet, concurrency, allowPrimary, compressionEngine)
 	if err != nil {
 		return err
 	}
@@ -46,6 +46,15 @@ func (client *gRPCVtctldClient) AddCellsAlias(ctx context.Context, in *vtctldata
 	return client.c.AddCellsAlias(ctx, in, opts...)
 }
 
+// AddQueryRule is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) AddQueryRule(ctx context.Context, in *vtctldatapb.AddQueryRuleRequest, opts ...grpc.CallOption) (*vtctldatapb.AddQueryRuleResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.AddQueryRule(ctx, in, opts...)
+}
+
 // ApplyRoutingRules is part of the vtctlservicepb.VtctldClient interface.
 func (client *gRPCVtctldClient) ApplyRoutingRules(ctx context.Context, in *vtctldatapb.ApplyRoutingRulesRequest, opts ...grpc.CallOption) (*vtctldatapb.ApplyRoutingRulesResponse, error) {
 	if client.c == nil {
@@ -64,6 +73,15 @@ func (client *gRPCVtctldClient) ApplyVSchema(ctx context.Context, in *vtctldatap
 	return client.c.ApplyVSchema(ctx, in, opts...)
 }
 
+// ChangeTabletTags is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) ChangeTabletTags(ctx context.Context, in *vtctldatapb.ChangeTabletTagsRequest, opts ...grpc.CallOption) (*vtctldatapb.ChangeTabletTagsResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.ChangeTabletTags(ctx, in, opts...)
+}
+
 // ChangeTabletType is part of the vtctlservicepb.VtctldClient interface.
 func (client *gRPCVtctldClient) ChangeTabletType(ctx context.Context, in *vtctldatapb.ChangeTabletTypeRequest, opts ...grpc.CallOption) (*vtctldatapb.ChangeTabletTypeResponse, error) {
 	if client.c == nil {
@@ -73,6 +91,15 @@ func (client *gRPCVtctldClient) ChangeTabletType(ctx context.Context, in *vtctld
 	return client.c.ChangeTabletType(ctx, in, opts...)
 }
 
+// CheckErrantGTIDs is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) CheckErrantGTIDs(ctx context.Context, in *vtctldatapb.CheckErrantGTIDsRequest, opts ...grpc.CallOption) (*vtctldatapb.CheckErrantGTIDsResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.CheckErrantGTIDs(ctx, in, opts...)
+}
+
 // CreateKeyspace is part of the vtctlservicepb.VtctldClient interface.
 func (client *gRPCVtctldClient) CreateKeyspace(ctx context.Context, in *vtctldatapb.CreateKeyspaceRequest, opts ...grpc.CallOption) (*vtctldatapb.CreateKeyspaceResponse, error) {
 	if client.c == nil {
@@ -145,6 +172,15 @@ func (client *gRPCVtctldClient) DeleteTablets(ctx context.Context, in *vtctldata
 	return client.c.DeleteTablets(ctx, in, opts...)
 }
 
+// DeregisterExternalMysql is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) DeregisterExternalMysql(ctx context.Context, in *vtctldatapb.DeregisterExternalMysqlRequest, opts ...grpc.CallOption) (*vtctldatapb.DeregisterExternalMysqlResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.DeregisterExternalMysql(ctx, in, opts...)
+}
+
 // EmergencyReparentShard is part of the vtctlservicepb.VtctldClient interface.
 func (client *gRPCVtctldClient) EmergencyReparentShard(ctx context.Context, in *vtctldatapb.EmergencyReparentShardRequest, opts ...grpc.CallOption) (*vtctldatapb.EmergencyReparentShardResponse, error) {
 	if client.c == nil {
@@ -154,6 +190,15 @@ func (client *gRPCVtctldClient) EmergencyReparentShard(ctx context.Context, in *
 	return client.c.EmergencyReparentShard(ctx, in, opts...)
 }
 
+// ExplainQuery is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) ExplainQuery(ctx context.Context, in *vtctldatapb.ExplainQueryRequest, opts ...grpc.CallOption) (*vtctldatapb.ExplainQueryResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.ExplainQuery(ctx, in, opts...)
+}
+
 // FindAllShardsInKeyspace is part of the vtctlservicepb.VtctldClient interface.
 func (client *gRPCVtctldClient) FindAllShardsInKeyspace(ctx context.Context, in *vtctldatapb.FindAllShardsInKeyspaceRequest, opts ...grpc.CallOption) (*vtctldatapb.FindAllShardsInKeyspaceResponse, error) {
 	if client.c == nil {
@@ -199,6 +244,24 @@ func (client *gRPCVtctldClient) GetCellsAliases(ctx context.Context, in *vtctlda
 	return client.c.GetCellsAliases(ctx, in, opts...)
 }
 
+// GetDDLStatus is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) GetDDLStatus(ctx context.Context, in *vtctldatapb.GetDDLStatusRequest, opts ...grpc.CallOption) (*vtctldatapb.GetDDLStatusResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.GetDDLStatus(ctx, in, opts...)
+}
+
+// GetExternalMysqls is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) GetExternalMysqls(ctx context.Context, in *vtctldatapb.GetExternalMysqlsRequest, opts ...grpc.CallOption) (*vtctldatapb.GetExternalMysqlsResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.GetExternalMysqls(ctx, in, opts...)
+}
+
 // GetKeyspace is part of the vtctlservicepb.VtctldClient interface.
 func (client *gRPCVtctldClient) GetKeyspace(ctx context.Context, in *vtctldatapb.GetKeyspaceRequest, opts ...grpc.CallOption) (*vtctldatapb.GetKeyspaceResponse, error) {
 	if client.c == nil {
@@ -208,6 +271,15 @@ func (client *gRPCVtctldClient) GetKeyspace(ctx context.Context, in *vtctldatapb
 	return client.c.GetKeyspace(ctx, in, opts...)
 }
 
+// GetKeyspaceGraph is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) GetKeyspaceGraph(ctx context.Context, in *vtctldatapb.GetKeyspaceGraphRequest, opts ...grpc.CallOption) (*vtctldatapb.GetKeyspaceGraphResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.GetKeyspaceGraph(ctx, in, opts...)
+}
+
 // GetKeyspaces is part of the vtctlservicepb.VtctldClient interface.
 func (client *gRPCVtctldClient) GetKeyspaces(ctx context.Context, in *vtctldatapb.GetKeyspacesRequest, opts ...grpc.CallOption) (*vtctldatapb.GetKeyspacesResponse, error) {
 	if client.c == nil {
@@ -217,6 +289,33 @@ func (client *gRPCVtctldClient) GetKeyspaces(ctx context.Context, in *vtctldatap
 	return client.c.GetKeyspaces(ctx, in, opts...)
 }
 
+// GetQueryRules is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) GetQueryRules(ctx context.Context, in *vtctldatapb.GetQueryRulesRequest, opts ...grpc.CallOption) (*vtctldatapb.GetQueryRulesResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.GetQueryRules(ctx, in, opts...)
+}
+
+// GetRecoverySettings is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) GetRecoverySettings(ctx context.Context, in *vtctldatapb.GetRecoverySettingsRequest, opts ...grpc.CallOption) (*vtctldatapb.GetRecoverySettingsResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.GetRecoverySettings(ctx, in, opts...)
+}
+
+// GetRestoreProgress is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) GetRestoreProgress(ctx context.Context, in *vtctldatapb.GetRestoreProgressRequest, opts ...grpc.CallOption) (*vtctldatapb.GetRestoreProgressResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.GetRestoreProgress(ctx, in, opts...)
+}
+
 // GetRoutingRules is part of the vtctlservicepb.VtctldClient interface.
 func (client *gRPCVtctldClient) GetRoutingRules(ctx context.Context, in *vtctldatapb.GetRoutingRulesRequest, opts ...grpc.CallOption) (*vtctldatapb.GetRoutingRulesResponse, error) {
 	if client.c == nil {
@@ -307,6 +406,15 @@ func (client *gRPCVtctldClient) GetVSchema(ctx context.Context, in *vtctldatapb.
 	return client.c.GetVSchema(ctx, in, opts...)
 }
 
+// GetVSchemaRevisions is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) GetVSchemaRevisions(ctx context.Context, in *vtctldatapb.GetVSchemaRevisionsRequest, opts ...grpc.CallOption) (*vtctldatapb.GetVSchemaRevisionsResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.GetVSchemaRevisions(ctx, in, opts...)
+}
+
 // GetWorkflows is part of the vtctlservicepb.VtctldClient interface.
 func (client *gRPCVtctldClient) GetWorkflows(ctx context.Context, in *vtctldatapb.GetWorkflowsRequest, opts ...grpc.CallOption) (*vtctldatapb.GetWorkflowsResponse, error) {
 	if client.c == nil {
@@ -325,6 +433,33 @@ func (client *gRPCVtctldClient) InitShardPrimary(ctx context.Context, in *vtctld
 	return client.c.InitShardPrimary(ctx, in, opts...)
 }
 
+// KillQueries is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) KillQueries(ctx context.Context, in *vtctldatapb.KillQueriesRequest, opts ...grpc.CallOption) (*vtctldatapb.KillQueriesResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.KillQueries(ctx, in, opts...)
+}
+
+// MaterializeCreate is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) MaterializeCreate(ctx context.Context, in *vtctldatapb.MaterializeCreateRequest, opts ...grpc.CallOption) (*vtctldatapb.MaterializeCreateResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.MaterializeCreate(ctx, in, opts...)
+}
+
+// MoveTablesCreate is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) MoveTablesCreate(ctx context.Context, in *vtctldatapb.MoveTablesCreateRequest, opts ...grpc.CallOption) (*vtctldatapb.MoveTablesCreateResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.MoveTablesCreate(ctx, in, opts...)
+}
+
 // PlannedReparentShard is part of the vtctlservicepb.VtctldClient interface.
 func (client *gRPCVtctldClient) PlannedReparentShard(ctx context.Context, in *vtctldatapb.PlannedReparentShardRequest, opts ...grpc.CallOption) (*vtctldatapb.PlannedReparentShardResponse, error) {
 	if client.c == nil {
@@ -334,6 +469,15 @@ func (client *gRPCVtctldClient) PlannedReparentShard(ctx context.Context, in *vt
 	return client.c.PlannedReparentShard(ctx, in, opts...)
 }
 
+// PruneBackups is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) PruneBackups(ctx context.Context, in *vtctldatapb.PruneBackupsRequest, opts ...grpc.CallOption) (*vtctldatapb.PruneBackupsResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.PruneBackups(ctx, in, opts...)
+}
+
 // RebuildVSchemaGraph is part of the vtctlservicepb.VtctldClient interface.
 func (client *gRPCVtctldClient) RebuildVSchemaGraph(ctx context.Context, in *vtctldatapb.RebuildVSchemaGraphRequest, opts ...grpc.CallOption) (*vtctldatapb.RebuildVSchemaGraphResponse, error) {
 	if client.c == nil {
@@ -343,6 +487,33 @@ func (client *gRPCVtctldClient) RebuildVSchemaGraph(ctx context.Context, in *vtc
 	return client.c.RebuildVSchemaGraph(ctx, in, opts...)
 }
 
+// ReferenceTablesCreate is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) ReferenceTablesCreate(ctx context.Context, in *vtctldatapb.ReferenceTablesCreateRequest, opts ...grpc.CallOption) (*vtctldatapb.ReferenceTablesCreateResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.ReferenceTablesCreate(ctx, in, opts...)
+}
+
+// ReferenceTablesDelete is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) ReferenceTablesDelete(ctx context.Context, in *vtctldatapb.ReferenceTablesDeleteRequest, opts ...grpc.CallOption) (*vtctldatapb.ReferenceTablesDeleteResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.ReferenceTablesDelete(ctx, in, opts...)
+}
+
+// ReferenceTablesRefresh is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) ReferenceTablesRefresh(ctx context.Context, in *vtctldatapb.ReferenceTablesRefreshRequest, opts ...grpc.CallOption) (*vtctldatapb.ReferenceTablesRefreshResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.ReferenceTablesRefresh(ctx, in, opts...)
+}
+
 // RefreshState is part of the vtctlservicepb.VtctldClient interface.
 func (client *gRPCVtctldClient) RefreshState(ctx context.Context, in *vtctldatapb.RefreshStateRequest, opts ...grpc.CallOption) (*vtctldatapb.RefreshStateResponse, error) {
 	if client.c == nil {
@@ -361,6 +532,15 @@ func (client *gRPCVtctldClient) RefreshStateByShard(ctx context.Context, in *vtc
 	return client.c.RefreshStateByShard(ctx, in, opts...)
 }
 
+// RegisterExternalMysql is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) RegisterExternalMysql(ctx context.Context, in *vtctldatapb.RegisterExternalMysqlRequest, opts ...grpc.CallOption) (*vtctldatapb.RegisterExternalMysqlResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.RegisterExternalMysql(ctx, in, opts...)
+}
+
 // RemoveKeyspaceCell is part of the vtctlservicepb.VtctldClient interface.
 func (client *gRPCVtctldClient) RemoveKeyspaceCell(ctx context.Context, in *vtctldatapb.RemoveKeyspaceCellRequest, opts ...grpc.CallOption) (*vtctldatapb.RemoveKeyspaceCellResponse, error) {
 	if client.c == nil {
@@ -370,6 +550,15 @@ func (client *gRPCVtctldClient) RemoveKeyspaceCell(ctx context.Context, in *vtct
 	return client.c.RemoveKeyspaceCell(ctx, in, opts...)
 }
 
+// RemoveQueryRule is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) RemoveQueryRule(ctx context.Context, in *vtctldatapb.RemoveQueryRuleRequest, opts ...grpc.CallOption) (*vtctldatapb.RemoveQueryRuleResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.RemoveQueryRule(ctx, in, opts...)
+}
+
 // RemoveShardCell is part of the vtctlservicepb.VtctldClient interface.
 func (client *gRPCVtctldClient) RemoveShardCell(ctx context.Context, in *vtctldatapb.RemoveShardCellRequest, opts ...grpc.CallOption) (*vtctldatapb.RemoveShardCellResponse, error) {
 	if client.c == nil {
@@ -379,6 +568,15 @@ func (client *gRPCVtctldClient) RemoveShardCell(ctx context.Context, in *vtctlda
 	return client.c.RemoveShardCell(ctx, in, opts...)
 }
 
+// RepairReplication is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) RepairReplication(ctx context.Context, in *vtctldatapb.RepairReplicationRequest, opts ...grpc.CallOption) (*vtctldatapb.RepairReplicationResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.RepairReplication(ctx, in, opts...)
+}
+
 // ReparentTablet is part of the vtctlservicepb.VtctldClient interface.
 func (client *gRPCVtctldClient) ReparentTablet(ctx context.Context, in *vtctldatapb.ReparentTabletRequest, opts ...grpc.CallOption) (*vtctldatapb.ReparentTabletResponse, error) {
 	if client.c == nil {
@@ -388,6 +586,69 @@ func (client *gRPCVtctldClient) ReparentTablet(ctx context.Context, in *vtctldat
 	return client.c.ReparentTablet(ctx, in, opts...)
 }
 
+// ReshardCreate is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) ReshardCreate(ctx context.Context, in *vtctldatapb.ReshardCreateRequest, opts ...grpc.CallOption) (*vtctldatapb.ReshardCreateResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.ReshardCreate(ctx, in, opts...)
+}
+
+// RollbackVSchema is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) RollbackVSchema(ctx context.Context, in *vtctldatapb.RollbackVSchemaRequest, opts ...grpc.CallOption) (*vtctldatapb.RollbackVSchemaResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.RollbackVSchema(ctx, in, opts...)
+}
+
+// ScaffoldVSchema is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) ScaffoldVSchema(ctx context.Context, in *vtctldatapb.ScaffoldVSchemaRequest, opts ...grpc.CallOption) (*vtctldatapb.ScaffoldVSchemaResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.ScaffoldVSchema(ctx, in, opts...)
+}
+
+// SetKeyspaceDurabilityPolicy is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) SetKeyspaceDurabilityPolicy(ctx context.Context, in *vtctldatapb.SetKeyspaceDurabilityPolicyRequest, opts ...grpc.CallOption) (*vtctldatapb.SetKeyspaceDurabilityPolicyResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.SetKeyspaceDurabilityPolicy(ctx, in, opts...)
+}
+
+// SetKeyspacePolicy is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) SetKeyspacePolicy(ctx context.Context, in *vtctldatapb.SetKeyspacePolicyRequest, opts ...grpc.CallOption) (*vtctldatapb.SetKeyspacePolicyResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.SetKeyspacePolicy(ctx, in, opts...)
+}
+
+// SetReplicationDelay is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) SetReplicationDelay(ctx context.Context, in *vtctldatapb.SetReplicationDelayRequest, opts ...grpc.CallOption) (*vtctldatapb.SetReplicationDelayResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.SetReplicationDelay(ctx, in, opts...)
+}
+
+// SetShardWriteFence is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) SetShardWriteFence(ctx context.Context, in *vtctldatapb.SetShardWriteFenceRequest, opts ...grpc.CallOption) (*vtctldatapb.SetShardWriteFenceResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.SetShardWriteFence(ctx, in, opts...)
+}
+
 // ShardReplicationPositions is part of the vtctlservicepb.VtctldClient interface.
 func (client *gRPCVtctldClient) ShardReplicationPositions(ctx context.Context, in *vtctldatapb.ShardReplicationPositionsRequest, opts ...grpc.CallOption) (*vtctldatapb.ShardReplicationPositionsResponse, error) {
 	if client.c == nil {
@@ -423,3 +684,102 @@ func (client *gRPCVtctldClient) UpdateCellsAlias(ctx context.Context, in *vtctld
 
 	return client.c.UpdateCellsAlias(ctx, in, opts...)
 }
+
+// UpdateRecoverySettings is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) UpdateRecoverySettings(ctx context.Context, in *vtctldatapb.UpdateRecoverySettingsRequest, opts ...grpc.CallOption) (*vtctldatapb.UpdateRecoverySettingsResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.UpdateRecoverySettings(ctx, in, opts...)
+}
+
+// UpdateThrottlerConfig is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) UpdateThrottlerConfig(ctx context.Context, in *vtctldatapb.UpdateThrottlerConfigRequest, opts ...grpc.CallOption) (*vtctldatapb.UpdateThrottlerConfigResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.UpdateThrottlerConfig(ctx, in, opts...)
+}
+
+// VDiff is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) VDiff(ctx context.Context, in *vtctldatapb.VDiffRequest, opts ...grpc.CallOption) (*vtctldatapb.VDiffResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.VDiff(ctx, in, opts...)
+}
+
+// ValidateServingGraph is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) ValidateServingGraph(ctx context.Context, in *vtctldatapb.ValidateServingGraphRequest, opts ...grpc.CallOption) (*vtctldatapb.ValidateServingGraphResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.ValidateServingGraph(ctx, in, opts...)
+}
+
+// ValidateVSchema is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) ValidateVSchema(ctx context.Context, in *vtctldatapb.ValidateVSchemaRequest, opts ...grpc.CallOption) (*vtctldatapb.ValidateVSchemaResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.ValidateVSchema(ctx, in, opts...)
+}
+
+// VerifyBackup is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) VerifyBackup(ctx context.Context, in *vtctldatapb.VerifyBackupRequest, opts ...grpc.CallOption) (*vtctldatapb.VerifyBackupResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.VerifyBackup(ctx, in, opts...)
+}
+
+// WorkflowCancel is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) WorkflowCancel(ctx context.Context, in *vtctldatapb.WorkflowCancelRequest, opts ...grpc.CallOption) (*vtctldatapb.WorkflowCancelResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.WorkflowCancel(ctx, in, opts...)
+}
+
+// WorkflowComplete is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) WorkflowComplete(ctx context.Context, in *vtctldatapb.WorkflowCompleteRequest, opts ...grpc.CallOption) (*vtctldatapb.WorkflowCompleteResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.WorkflowComplete(ctx, in, opts...)
+}
+
+// WorkflowPause is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) WorkflowPause(ctx context.Context, in *vtctldatapb.WorkflowPauseRequest, opts ...grpc.CallOption) (*vtctldatapb.WorkflowPauseResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.WorkflowPause(ctx, in, opts...)
+}
+
+// WorkflowResume is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) WorkflowResume(ctx context.Context, in *vtctldatapb.WorkflowResumeRequest, opts ...grpc.CallOption) (*vtctldatapb.WorkflowResumeResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.WorkflowResume(ctx, in, opts...)
+}
+
+// WorkflowSwitchTraffic is part of the vtctlservicepb.VtctldClient interface.
+func (client *gRPCVtctldClient) WorkflowSwitchTraffic(ctx context.Context, in *vtctldatapb.WorkflowSwitchTrafficRequest, opts ...grpc.CallOption) (*vtctldatapb.WorkflowSwitchTrafficResponse, error) {
+	if client.c == nil {
+		return nil, status.Error(codes.Unavailable, connClosedMsg)
+	}
+
+	return client.c.WorkflowSwitchTraffic(ctx, in, opts...)
+}
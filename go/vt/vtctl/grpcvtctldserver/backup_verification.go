@@ -0,0 +1,170 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcvtctldserver
+
+import (
+	"context"
+	"io"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/protoutil"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+
+	mysqlctlpb "vitess.io/vitess/go/vt/proto/mysqlctl"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+var (
+	backupVerifications = stats.NewCountersWithMultiLabels(
+		"BackupVerifications",
+		"Number of backup verifications performed by this vtctld, by keyspace, shard and resulting status",
+		[]string{"Keyspace", "Shard", "Status"})
+
+	lastValidBackupVerifications = &backupVerificationTimes{times: map[string]time.Time{}}
+
+	_ = stats.NewGaugesFuncWithMultiLabels(
+		"BackupLastVerifiedAgeSeconds",
+		"Seconds since a backup of the shard last passed verification, as recorded with the backups",
+		[]string{"Keyspace", "Shard"},
+		lastValidBackupVerifications.ages)
+)
+
+// backupVerificationTimes tracks, per keyspace/shard, the last time a backup
+// passed verification, according to the verifications recorded with the
+// backups.
+type backupVerificationTimes struct {
+	mu    sync.Mutex
+	times map[string]time.Time
+}
+
+func (bvt *backupVerificationTimes) record(keyspace, shard string, t time.Time) {
+	bvt.mu.Lock()
+	defer bvt.mu.Unlock()
+
+	key := keyspace + "." + shard
+	if t.After(bvt.times[key]) {
+		bvt.times[key] = t
+	}
+}
+
+func (bvt *backupVerificationTimes) replace(times map[string]time.Time) {
+	bvt.mu.Lock()
+	defer bvt.mu.Unlock()
+
+	bvt.times = times
+}
+
+func (bvt *backupVerificationTimes) ages() map[string]int64 {
+	bvt.mu.Lock()
+	defer bvt.mu.Unlock()
+
+	now := time.Now()
+	ages := make(map[string]int64, len(bvt.times))
+	for key, t := range bvt.times {
+		ages[key] = int64(now.Sub(t).Seconds())
+	}
+	return ages
+}
+
+func recordBackupVerification(keyspace, shard string, verification *mysqlctlpb.BackupVerification) {
+	backupVerifications.Add([]string{keyspace, shard, verification.Status.String()}, 1)
+	recordStoredBackupVerification(keyspace, shard, verification)
+}
+
+// recordStoredBackupVerification updates the BackupLastVerifiedAgeSeconds
+// gauge with a verification recorded with a backup.
+func recordStoredBackupVerification(keyspace, shard string, verification *mysqlctlpb.BackupVerification) {
+	if verification.Status == mysqlctlpb.BackupInfo_VALID {
+		lastValidBackupVerifications.record(keyspace, shard, protoutil.TimeFromProto(verification.Time))
+	}
+}
+
+// RefreshBackupVerificationAges reloads the BackupLastVerifiedAgeSeconds
+// gauge from the verifications recorded with the backups of every shard, so
+// that every vtctld reports it, whichever verified the backups, and the
+// backups that were removed no longer count.
+func RefreshBackupVerificationAges(ctx context.Context, ts *topo.Server) error {
+	keyspaces, err := ts.GetKeyspaces(ctx)
+	if err != nil {
+		return err
+	}
+
+	bs, err := backupstorage.GetBackupStorage()
+	if err != nil {
+		return err
+	}
+	defer bs.Close()
+
+	times := map[string]time.Time{}
+	for _, keyspace := range keyspaces {
+		shards, err := ts.GetShardNames(ctx, keyspace)
+		if err != nil {
+			return err
+		}
+
+		for _, shard := range shards {
+			bhs, err := bs.ListBackups(ctx, filepath.Join(keyspace, shard))
+			if err != nil {
+				return err
+			}
+
+			key := keyspace + "." + shard
+			for _, bh := range bhs {
+				verification, err := mysqlctl.GetBackupVerification(ctx, bh)
+				if err != nil || verification.Status != mysqlctlpb.BackupInfo_VALID {
+					continue
+				}
+				if t := protoutil.TimeFromProto(verification.Time); t.After(times[key]) {
+					times[key] = t
+				}
+			}
+		}
+	}
+
+	lastValidBackupVerifications.replace(times)
+	return nil
+}
+
+// restoreBackupOnTablet restores the most recent backup of the tablet's shard
+// on the tablet, relaying the restore logs to the vtctld log.
+func (s *VtctldServer) restoreBackupOnTablet(ctx context.Context, tablet *topodatapb.Tablet) error {
+	stream, err := s.tmc.RestoreFromBackup(ctx, tablet)
+	if err != nil {
+		return err
+	}
+
+	alias := topoproto.TabletAliasString(tablet.Alias)
+	for {
+		e, err := stream.Recv()
+		switch err {
+		case nil:
+			log.Infof("VerifyBackup: restore on %v: %v", alias, logutil.EventString(e))
+		case io.EOF:
+			return nil
+		default:
+			return err
+		}
+	}
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcvtctldserver
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/vt/dbconfigs"
+	"vitess.io/vitess/go/vt/topo"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+// externalMysqlSettingsQuery reads the settings of an external mysqld that
+// vreplication needs to stream its binlogs, and its current position.
+const externalMysqlSettingsQuery = "select @@global.gtid_mode, @@global.binlog_format, @@global.binlog_row_image, @@global.read_only, @@global.gtid_executed"
+
+// externalMysqlProto returns an external mysql of the topo without its
+// password.
+func externalMysqlProto(name string, em *topodatapb.ExternalMysql) *vtctldatapb.ExternalMysql {
+	return &vtctldatapb.ExternalMysql{
+		Name:   name,
+		Host:   em.Host,
+		Port:   em.Port,
+		DbName: em.DbName,
+		User:   em.User,
+	}
+}
+
+// checkExternalMysqlHealth connects to an external mysqld, and checks that
+// vreplication can stream its binlogs: it must use GTIDs and log rows. Rows
+// that are not logged in full require -vstream_fill_partial_row_images on the
+// tablets that stream them.
+func checkExternalMysqlHealth(ctx context.Context, connector dbconfigs.Connector) *vtctldatapb.ExternalMysqlHealth {
+	ctx, cancel := context.WithTimeout(ctx, *topo.RemoteOperationTimeout)
+	defer cancel()
+
+	unhealthy := func(format string, args ...interface{}) *vtctldatapb.ExternalMysqlHealth {
+		return &vtctldatapb.ExternalMysqlHealth{Error: fmt.Sprintf(format, args...)}
+	}
+	conn, err := connector.Connect(ctx)
+	if err != nil {
+		return unhealthy("cannot connect to %v: %v", connector.Host(), err)
+	}
+	defer conn.Close()
+
+	qr, err := conn.ExecuteFetch(externalMysqlSettingsQuery, 1, false)
+	if err != nil {
+		return unhealthy("cannot read the binlog settings: %v", err)
+	}
+	if len(qr.Rows) != 1 || len(qr.Rows[0]) != 5 {
+		return unhealthy("unexpected result for the binlog settings: %v", qr.Rows)
+	}
+	row := qr.Rows[0]
+	pos, err := mysql.ParsePosition(mysql.Mysql56FlavorID, row[4].ToString())
+	if err != nil {
+		return unhealthy("cannot parse the GTID position %v: %v", row[4].ToString(), err)
+	}
+	health := &vtctldatapb.ExternalMysqlHealth{
+		Position: mysql.EncodePosition(pos),
+		ReadOnly: row[3].ToString() == "1",
+	}
+	switch {
+	case !strings.EqualFold(row[0].ToString(), "ON"):
+		health.Error = fmt.Sprintf("gtid_mode is %v, vreplication requires ON", row[0].ToString())
+	case !strings.EqualFold(row[1].ToString(), "ROW"):
+		health.Error = fmt.Sprintf("binlog_format is %v, vreplication requires ROW", row[1].ToString())
+	case !strings.EqualFold(row[2].ToString(), "FULL") && !strings.EqualFold(row[2].ToString(), "MINIMAL") && !strings.EqualFold(row[2].ToString(), "NOBLOB"):
+		health.Error = fmt.Sprintf("binlog_row_image is %v, vreplication requires FULL, MINIMAL or NOBLOB", row[2].ToString())
+	default:
+		health.Healthy = true
+	}
+	return health
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcvtctldserver
+
+import (
+	"fmt"
+
+	"vitess.io/vitess/go/netutil"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+
+	replicationdatapb "vitess.io/vitess/go/vt/proto/replicationdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+// buildKeyspaceGraph builds the serving graph of the keyspace from its shards
+// and tablets, and the replication statuses of the tablets, keyed by tablet
+// alias. Tablets without a status must have a replication error instead,
+// unless they are primaries.
+//
+// The shards and tablets are expected to be sorted; the graph keeps their
+// order.
+func buildKeyspaceGraph(
+	keyspace string,
+	shards []*vtctldatapb.Shard,
+	tablets []*topodatapb.Tablet,
+	statuses map[string]*replicationdatapb.Status,
+	replicationErrors map[string]string,
+) *vtctldatapb.KeyspaceGraph {
+	graph := &vtctldatapb.KeyspaceGraph{
+		Keyspace: keyspace,
+		Shards:   make([]*vtctldatapb.KeyspaceGraph_ShardNode, 0, len(shards)),
+		Tablets:  make([]*vtctldatapb.KeyspaceGraph_TabletNode, 0, len(tablets)),
+	}
+
+	shardPrimaries := make(map[string]*topodatapb.TabletAlias, len(shards))
+	for _, shard := range shards {
+		shardPrimaries[shard.Name] = shard.Shard.GetPrimaryAlias()
+	}
+
+	tabletsByAlias := make(map[string]*topodatapb.Tablet, len(tablets))
+	tabletsByMysqlAddr := make(map[string]*topodatapb.Tablet, len(tablets))
+	for _, tablet := range tablets {
+		tabletsByAlias[topoproto.TabletAliasString(tablet.Alias)] = tablet
+		if tablet.MysqlHostname != "" {
+			tabletsByMysqlAddr[netutil.JoinHostPort(tablet.MysqlHostname, tablet.MysqlPort)] = tablet
+		}
+	}
+
+	for _, shard := range shards {
+		node := &vtctldatapb.KeyspaceGraph_ShardNode{
+			Name:             shard.Name,
+			KeyRange:         shard.Shard.GetKeyRange(),
+			PrimaryAlias:     shard.Shard.GetPrimaryAlias(),
+			IsPrimaryServing: shard.Shard.GetIsPrimaryServing(),
+		}
+
+		switch primary, ok := tabletsByAlias[topoproto.TabletAliasString(node.PrimaryAlias)]; {
+		case node.PrimaryAlias == nil:
+			node.Problems = append(node.Problems, "the shard has no primary")
+		case !ok:
+			node.Problems = append(node.Problems, fmt.Sprintf("the shard primary %s is not a tablet of the keyspace", topoproto.TabletAliasString(node.PrimaryAlias)))
+		case primary.Shard != shard.Name:
+			node.Problems = append(node.Problems, fmt.Sprintf("the shard primary %s is a tablet of shard %s", topoproto.TabletAliasString(node.PrimaryAlias), primary.Shard))
+		}
+
+		graph.Shards = append(graph.Shards, node)
+	}
+
+	for _, tablet := range tablets {
+		alias := topoproto.TabletAliasString(tablet.Alias)
+		node := &vtctldatapb.KeyspaceGraph_TabletNode{
+			Tablet:            tablet,
+			ReplicationStatus: statuses[alias],
+			ReplicationError:  replicationErrors[alias],
+		}
+
+		shardPrimary, shardExists := shardPrimaries[tablet.Shard]
+		if !shardExists {
+			node.Problems = append(node.Problems, fmt.Sprintf("the tablet shard %s is not a shard of the keyspace", tablet.Shard))
+		}
+
+		if tablet.Type == topodatapb.TabletType_PRIMARY {
+			if shardExists && !topoproto.TabletAliasEqual(tablet.Alias, shardPrimary) {
+				node.Problems = append(node.Problems, fmt.Sprintf("the tablet is a PRIMARY, but the shard primary is %s", topoproto.TabletAliasString(shardPrimary)))
+			}
+
+			graph.Tablets = append(graph.Tablets, node)
+			continue
+		}
+
+		status := node.ReplicationStatus
+		switch {
+		case status == nil:
+			// The replication error, if any, explains it.
+		case status.SourceHost == "":
+			node.Problems = append(node.Problems, "the tablet is not replicating")
+		default:
+			sourceAddr := netutil.JoinHostPort(status.SourceHost, status.SourcePort)
+			source, ok := tabletsByMysqlAddr[sourceAddr]
+			if !ok {
+				node.Problems = append(node.Problems, fmt.Sprintf("the tablet replicates from %s, which is not a tablet of the keyspace", sourceAddr))
+				break
+			}
+
+			graph.Edges = append(graph.Edges, &vtctldatapb.KeyspaceGraph_ReplicationEdge{
+				Source:                source.Alias,
+				Replica:               tablet.Alias,
+				ReplicationLagSeconds: status.ReplicationLagSeconds,
+				IoThreadRunning:       status.IoThreadRunning,
+				SqlThreadRunning:      status.SqlThreadRunning,
+			})
+
+			switch {
+			case source.Shard != tablet.Shard:
+				node.Problems = append(node.Problems, fmt.Sprintf("the tablet replicates from %s, a tablet of shard %s", topoproto.TabletAliasString(source.Alias), source.Shard))
+			case shardExists && shardPrimary != nil && !topoproto.TabletAliasEqual(source.Alias, shardPrimary):
+				node.Problems = append(node.Problems, fmt.Sprintf("the tablet replicates from %s, not from the shard primary %s", topoproto.TabletAliasString(source.Alias), topoproto.TabletAliasString(shardPrimary)))
+			}
+
+			if !status.IoThreadRunning || !status.SqlThreadRunning {
+				node.Problems = append(node.Problems, "the tablet replication is stopped")
+			}
+		}
+
+		graph.Tablets = append(graph.Tablets, node)
+	}
+
+	return graph
+}
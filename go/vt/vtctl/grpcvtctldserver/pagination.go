@@ -0,0 +1,199 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcvtctldserver
+
+import (
+	"encoding/base64"
+	"sort"
+	"strconv"
+
+	"google.golang.org/protobuf/proto"
+
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+	"vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// paginate returns the bounds of the page of the given size, starting at the
+// page token, of total sorted items, along with the token of the next page.
+//
+// Page tokens are opaque to clients; they encode the offset of the page.
+func paginate(total int, pageSize int32, pageToken string) (start int, end int, nextPageToken string, err error) {
+	if pageSize < 0 {
+		return 0, 0, "", vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "page_size must not be negative, got %d", pageSize)
+	}
+
+	if pageToken != "" {
+		offset, err := base64.RawURLEncoding.DecodeString(pageToken)
+		if err == nil {
+			start, err = strconv.Atoi(string(offset))
+		}
+
+		if err != nil || start < 0 {
+			return 0, 0, "", vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "invalid page_token %q", pageToken)
+		}
+
+		if start > total {
+			start = total
+		}
+	}
+
+	end = total
+	if pageSize > 0 && start+int(pageSize) < total {
+		end = start + int(pageSize)
+		nextPageToken = base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(end)))
+	}
+
+	return start, end, nextPageToken, nil
+}
+
+// filterTablets returns the tablets with one of the tablet types and all
+// the tags of the request, if it has any.
+func filterTablets(tablets []*topodatapb.Tablet, req *vtctldatapb.GetTabletsRequest) []*topodatapb.Tablet {
+	if len(req.TabletTypes) == 0 && len(req.Tags) == 0 {
+		return tablets
+	}
+
+	filtered := make([]*topodatapb.Tablet, 0, len(tablets))
+	for _, tablet := range tablets {
+		if len(req.TabletTypes) > 0 && !topoproto.IsTypeInList(tablet.Type, req.TabletTypes) {
+			continue
+		}
+
+		if topoproto.TabletHasTags(tablet, req.Tags) {
+			filtered = append(filtered, tablet)
+		}
+	}
+
+	return filtered
+}
+
+// sortTablets sorts the tablets by the field of the request. Paginated
+// requests are always sorted, by alias if they do not specify a field, so
+// that the pages are consistent.
+func sortTablets(tablets []*topodatapb.Tablet, req *vtctldatapb.GetTabletsRequest) {
+	sortBy := req.SortBy
+	if sortBy == vtctldatapb.GetTabletsRequest_UNSORTED {
+		if req.PageSize == 0 {
+			return
+		}
+
+		sortBy = vtctldatapb.GetTabletsRequest_ALIAS
+	}
+
+	aliasLess := func(a, b *topodatapb.Tablet) bool {
+		return topoproto.TabletAliasList{a.Alias, b.Alias}.Less(0, 1)
+	}
+
+	var less func(a, b *topodatapb.Tablet) bool
+	switch sortBy {
+	case vtctldatapb.GetTabletsRequest_KEYSPACE_SHARD:
+		less = func(a, b *topodatapb.Tablet) bool {
+			if a.Keyspace != b.Keyspace {
+				return a.Keyspace < b.Keyspace
+			}
+
+			if !key.KeyRangeStartEqual(a.KeyRange, b.KeyRange) {
+				return key.KeyRangeStartSmaller(a.KeyRange, b.KeyRange)
+			}
+
+			if a.Shard != b.Shard {
+				return a.Shard < b.Shard
+			}
+
+			return aliasLess(a, b)
+		}
+	case vtctldatapb.GetTabletsRequest_TYPE:
+		less = func(a, b *topodatapb.Tablet) bool {
+			if a.Type != b.Type {
+				return a.Type < b.Type
+			}
+
+			return aliasLess(a, b)
+		}
+	case vtctldatapb.GetTabletsRequest_HOSTNAME:
+		less = func(a, b *topodatapb.Tablet) bool {
+			if a.Hostname != b.Hostname {
+				return a.Hostname < b.Hostname
+			}
+
+			return aliasLess(a, b)
+		}
+	default:
+		less = aliasLess
+	}
+
+	sort.Slice(tablets, func(i, j int) bool {
+		if req.SortDescending {
+			return less(tablets[j], tablets[i])
+		}
+
+		return less(tablets[i], tablets[j])
+	})
+}
+
+// newGetTabletsResponse filters, sorts and paginates the tablets according to
+// the request.
+func newGetTabletsResponse(tablets []*topodatapb.Tablet, req *vtctldatapb.GetTabletsRequest) (*vtctldatapb.GetTabletsResponse, error) {
+	tablets = filterTablets(tablets, req)
+	sortTablets(tablets, req)
+
+	start, end, nextPageToken, err := paginate(len(tablets), req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return &vtctldatapb.GetTabletsResponse{
+		Tablets:       tablets[start:end],
+		NextPageToken: nextPageToken,
+		TotalSize:     int32(len(tablets)),
+	}, nil
+}
+
+// sortShards sorts the shards by key range.
+func sortShards(shards []*vtctldatapb.Shard) {
+	sort.Slice(shards, func(i, j int) bool {
+		left, right := shards[i].Shard.GetKeyRange(), shards[j].Shard.GetKeyRange()
+		if !key.KeyRangeStartEqual(left, right) {
+			return key.KeyRangeStartSmaller(left, right)
+		}
+
+		return shards[i].Name < shards[j].Name
+	})
+}
+
+// filterSrvKeyspacePartitions returns a copy of the SrvKeyspace with only
+// the partitions of the tablet types, if there are any.
+func filterSrvKeyspacePartitions(srvKeyspace *topodatapb.SrvKeyspace, tabletTypes []topodatapb.TabletType) *topodatapb.SrvKeyspace {
+	if srvKeyspace == nil || len(tabletTypes) == 0 {
+		return srvKeyspace
+	}
+
+	filtered := proto.Clone(srvKeyspace).(*topodatapb.SrvKeyspace)
+	filtered.Partitions = nil
+	for _, partition := range srvKeyspace.Partitions {
+		if topoproto.IsTypeInList(partition.ServedType, tabletTypes) {
+			filtered.Partitions = append(filtered.Partitions, partition)
+		}
+	}
+
+	return filtered
+}
@@ -0,0 +1,348 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcvtctldserver
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	replicationdatapb "vitess.io/vitess/go/vt/proto/replicationdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+	"vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// replicationRepair is the repair of the replication of a tablet.
+type replicationRepair int
+
+const (
+	repairNone replicationRepair = iota
+	// repairRepoint points the replication of the tablet at the primary.
+	repairRepoint
+	// repairRestart starts the replication of the tablet.
+	repairRestart
+)
+
+// replicaState is the replication state of a tablet, as read by
+// RepairReplication.
+type replicaState struct {
+	tablet *topodatapb.Tablet
+	// status is nil if the replication of the tablet is not configured.
+	status *replicationdatapb.Status
+	// position is the GTID position the tablet executed.
+	position string
+	err      error
+}
+
+// isErrNotReplica returns whether an error is mysql.ErrNotReplica, which
+// loses its identity over gRPC.
+func isErrNotReplica(err error) bool {
+	return err == mysql.ErrNotReplica || strings.Contains(err.Error(), mysql.ErrNotReplica.Error())
+}
+
+// getShardPrimary returns a shard, its tablets and its primary tablet, which
+// must be in the shard.
+func (s *VtctldServer) getShardPrimary(ctx context.Context, keyspace string, shard string) (*topo.ShardInfo, map[string]*topo.TabletInfo, *topo.TabletInfo, error) {
+	si, err := s.ts.GetShard(ctx, keyspace, shard)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if !si.HasPrimary() {
+		return nil, nil, nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "no primary tablet for shard %v/%v", keyspace, shard)
+	}
+
+	tabletMap, err := s.ts.GetTabletMapForShard(ctx, keyspace, shard)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	primaryAlias := topoproto.TabletAliasString(si.PrimaryAlias)
+	primary, ok := tabletMap[primaryAlias]
+	if !ok {
+		return nil, nil, nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "primary tablet %v of shard %v/%v is not in the shard", primaryAlias, keyspace, shard)
+	}
+	if primary.Type != topodatapb.TabletType_PRIMARY {
+		return nil, nil, nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "TopologyServer has incosistent state for shard primary %v", primaryAlias)
+	}
+	return si, tabletMap, primary, nil
+}
+
+// readReplicaStates reads the replication states of the replicas of a shard
+// concurrently, and returns them sorted by alias. It skips the tablets whose
+// replication is managed by a backup or a restore.
+func (s *VtctldServer) readReplicaStates(ctx context.Context, tabletMap map[string]*topo.TabletInfo, primary *topo.TabletInfo) []*replicaState {
+	var (
+		m      sync.Mutex
+		wg     sync.WaitGroup
+		states []*replicaState
+	)
+	for _, tablet := range tabletMap {
+		switch {
+		case topoproto.TabletAliasEqual(tablet.Alias, primary.Alias):
+			continue
+		case tablet.Type == topodatapb.TabletType_BACKUP || tablet.Type == topodatapb.TabletType_RESTORE:
+			continue
+		}
+
+		wg.Add(1)
+		go func(tablet *topodatapb.Tablet) {
+			defer wg.Done()
+
+			state := s.readReplicaState(ctx, tablet)
+
+			m.Lock()
+			defer m.Unlock()
+			states = append(states, state)
+		}(tablet.Tablet)
+	}
+	wg.Wait()
+
+	sort.Slice(states, func(i, j int) bool {
+		return topoproto.TabletAliasString(states[i].tablet.Alias) < topoproto.TabletAliasString(states[j].tablet.Alias)
+	})
+	return states
+}
+
+// readPrimaryPosition reads the position of the primary.
+func (s *VtctldServer) readPrimaryPosition(ctx context.Context, primary *topodatapb.Tablet) (mysql.Position, error) {
+	ctx, cancel := context.WithTimeout(ctx, *topo.RemoteOperationTimeout)
+	defer cancel()
+
+	alias := topoproto.TabletAliasString(primary.Alias)
+	positionStr, err := s.tmc.MasterPosition(ctx, primary)
+	if err != nil {
+		return mysql.Position{}, vterrors.Wrapf(err, "cannot read the position of primary %v", alias)
+	}
+	position, err := mysql.DecodePosition(positionStr)
+	if err != nil {
+		return mysql.Position{}, vterrors.Wrapf(err, "cannot decode the position %v of primary %v", positionStr, alias)
+	}
+	return position, nil
+}
+
+// readReplicaState reads the replication status of a tablet, or its position
+// if its replication is not configured.
+func (s *VtctldServer) readReplicaState(ctx context.Context, tablet *topodatapb.Tablet) *replicaState {
+	ctx, cancel := context.WithTimeout(ctx, *topo.RemoteOperationTimeout)
+	defer cancel()
+
+	state := &replicaState{tablet: tablet}
+	status, err := s.tmc.ReplicationStatus(ctx, tablet)
+	switch {
+	case err == nil:
+		state.status = status
+		state.position = status.Position
+	case isErrNotReplica(err):
+		state.position, state.err = s.tmc.MasterPosition(ctx, tablet)
+	default:
+		state.err = err
+	}
+	return state
+}
+
+// readPrimaryPurgedGTIDs returns the GTIDs that the primary purged from its
+// binlogs, or nil if they cannot be read.
+func (s *VtctldServer) readPrimaryPurgedGTIDs(ctx context.Context, primary *topodatapb.Tablet, position mysql.Position) mysql.GTIDSet {
+	if _, ok := position.GTIDSet.(mysql.Mysql56GTIDSet); !ok {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, *topo.RemoteOperationTimeout)
+	defer cancel()
+
+	qr, err := s.tmc.ExecuteFetchAsDba(ctx, primary, false, []byte("SELECT @@global.gtid_purged"), 1, false, false)
+	if err != nil {
+		log.Warningf("cannot read the purged GTIDs of primary %v: %v", topoproto.TabletAliasString(primary.Alias), err)
+		return nil
+	}
+	result := sqltypes.Proto3ToResult(qr)
+	if len(result.Rows) != 1 || len(result.Rows[0]) != 1 || result.Rows[0][0].ToString() == "" {
+		return nil
+	}
+	purged, err := mysql.ParsePosition(mysql.Mysql56FlavorID, result.Rows[0][0].ToString())
+	if err != nil {
+		log.Warningf("cannot parse the purged GTIDs %v of primary %v: %v", result.Rows[0][0].ToString(), topoproto.TabletAliasString(primary.Alias), err)
+		return nil
+	}
+	return purged.GTIDSet
+}
+
+// readErrantGTIDs returns the GTIDs of a replica that the position of the
+// primary does not contain, or nil if there are none.
+func readErrantGTIDs(state *replicaState, primaryPosition mysql.Position) (mysql.Mysql56GTIDSet, error) {
+	if state.err != nil {
+		return nil, fmt.Errorf("cannot read the replication status: %w", state.err)
+	}
+	position, err := mysql.DecodePosition(state.position)
+	if err != nil {
+		return nil, fmt.Errorf("cannot decode the position %v: %w", state.position, err)
+	}
+	return mysql.ErrantGTIDs(position, primaryPosition)
+}
+
+// fixErrantGTIDs removes the errant GTIDs from the GTID set of a replica,
+// without changing its data: with its replication stopped, it resets the
+// binlogs of the replica, and sets its gtid_purged to the GTIDs it executed
+// that the primary also has. The replication of the replica is restarted
+// if it was running.
+func (s *VtctldServer) fixErrantGTIDs(ctx context.Context, state *replicaState, primary *topodatapb.Tablet) error {
+	ctx, cancel := context.WithTimeout(ctx, *topo.RemoteOperationTimeout)
+	defer cancel()
+
+	tablet := state.tablet
+	replicating := state.status != nil && (state.status.IoThreadRunning || state.status.SqlThreadRunning)
+	if replicating {
+		if err := s.tmc.StopReplication(ctx, tablet); err != nil {
+			return vterrors.Wrap(err, "cannot stop replication")
+		}
+	}
+
+	// Read the positions again, now that the replica no longer changes.
+	positionStr, err := s.tmc.MasterPosition(ctx, tablet)
+	if err != nil {
+		return vterrors.Wrap(err, "cannot read the position")
+	}
+	position, err := mysql.DecodePosition(positionStr)
+	if err != nil {
+		return vterrors.Wrapf(err, "cannot decode the position %v", positionStr)
+	}
+	primaryPosition, err := s.readPrimaryPosition(ctx, primary)
+	if err != nil {
+		return err
+	}
+	errant, err := mysql.ErrantGTIDs(position, primaryPosition)
+	if err != nil {
+		return err
+	}
+
+	if errant != nil {
+		purged := position.GTIDSet.(mysql.Mysql56GTIDSet).Difference(errant)
+		if _, err := s.tmc.ExecuteFetchAsDba(ctx, tablet, false, []byte("RESET MASTER"), 0, false, false); err != nil {
+			return vterrors.Wrap(err, "cannot reset the binlogs")
+		}
+		query := fmt.Sprintf("SET GLOBAL gtid_purged = '%s'", purged)
+		if _, err := s.tmc.ExecuteFetchAsDba(ctx, tablet, false, []byte(query), 0, false, false); err != nil {
+			// The replica lost its GTID set, so it must not replicate
+			// until the set is restored.
+			return vterrors.Wrapf(err, "cannot set gtid_purged after resetting the binlogs, set it to '%s' before restarting replication", purged)
+		}
+	}
+
+	if replicating {
+		if err := s.tmc.StartReplication(ctx, tablet); err != nil {
+			return vterrors.Wrap(err, "cannot restart replication")
+		}
+	}
+	return nil
+}
+
+// diagnoseReplica returns the problems of the replication of a tablet from
+// the primary, and the repair that fixes them. The repair is repairNone if
+// the problems cannot be repaired safely, in which case their resolution is
+// MANUAL.
+func diagnoseReplica(state *replicaState, primary *topodatapb.Tablet, primaryPosition mysql.Position, purged mysql.GTIDSet) ([]*vtctldatapb.ReplicationProblem, replicationRepair) {
+	var problems []*vtctldatapb.ReplicationProblem
+	addProblem := func(kind vtctldatapb.ReplicationProblem_Kind, action string, format string, args ...interface{}) *vtctldatapb.ReplicationProblem {
+		problem := &vtctldatapb.ReplicationProblem{
+			TabletAlias: state.tablet.Alias,
+			Kind:        kind,
+			Message:     fmt.Sprintf(format, args...),
+			Action:      action,
+		}
+		problems = append(problems, problem)
+		return problem
+	}
+	primaryAlias := topoproto.TabletAliasString(primary.Alias)
+
+	if state.err != nil {
+		addProblem(vtctldatapb.ReplicationProblem_UNREACHABLE, "check the tablet and its mysqld", "cannot read the replication status: %v", state.err)
+		return problems, repairNone
+	}
+
+	// Find what is wrong with the replication itself.
+	var (
+		repair        = repairNone
+		sourceProblem *vtctldatapb.ReplicationProblem
+		connects      bool
+	)
+	delayed := state.tablet.ReplicationDelaySeconds > 0
+	switch status := state.status; {
+	case status == nil:
+		sourceProblem = addProblem(vtctldatapb.ReplicationProblem_NO_SOURCE, fmt.Sprintf("point its replication at primary %v", primaryAlias), "its replication is not configured")
+		repair, connects = repairRepoint, true
+	case status.SourceHost != primary.MysqlHostname || status.SourcePort != primary.MysqlPort:
+		sourceProblem = addProblem(vtctldatapb.ReplicationProblem_WRONG_SOURCE, fmt.Sprintf("point its replication at primary %v", primaryAlias), "it replicates from %v:%v instead of primary %v at %v:%v", status.SourceHost, status.SourcePort, primaryAlias, primary.MysqlHostname, primary.MysqlPort)
+		repair, connects = repairRepoint, true
+	case !status.IoThreadRunning || !status.SqlThreadRunning:
+		sourceProblem = addProblem(vtctldatapb.ReplicationProblem_NOT_REPLICATING, "start its replication", "its replication is stopped (IO thread running: %v, SQL thread running: %v)", status.IoThreadRunning, status.SqlThreadRunning)
+		repair, connects = repairRestart, !status.IoThreadRunning
+		if delayed {
+			// The replication of a delayed replica is stopped to recover
+			// the data of a transaction applied by mistake.
+			sourceProblem.Action = "start its replication once the delayed replica is no longer used to recover data"
+			sourceProblem.Resolution = vtctldatapb.ReplicationProblem_MANUAL
+			repair = repairNone
+		}
+	}
+
+	// Then check that it has the transactions it should have.
+	if state.position == "" {
+		return problems, repair
+	}
+	position, err := mysql.DecodePosition(state.position)
+	if err != nil {
+		addProblem(vtctldatapb.ReplicationProblem_UNREACHABLE, "check the tablet and its mysqld", "cannot decode its position %v: %v", state.position, err)
+		return problems, repairNone
+	}
+	safe := true
+	if !primaryPosition.AtLeast(position) {
+		message := "it has transactions that the primary does not have"
+		if errant, err := mysql.ErrantGTIDs(position, primaryPosition); err == nil && errant != nil {
+			message = fmt.Sprintf("it has transactions %v that the primary does not have", errant)
+		}
+		addProblem(vtctldatapb.ReplicationProblem_ERRANT_GTIDS, "remove its errant transactions, e.g. by restoring the tablet from a backup", message)
+		safe = false
+	}
+	if connects && purged != nil {
+		// A tablet that connects to the primary must already have received
+		// the transactions purged from its binlogs.
+		received := position
+		if state.status != nil && state.status.RelayLogPosition != "" {
+			if relayLogPosition, err := mysql.DecodePosition(state.status.RelayLogPosition); err == nil {
+				received = relayLogPosition
+			}
+		}
+		if received.GTIDSet == nil || !received.GTIDSet.Contains(purged) {
+			addProblem(vtctldatapb.ReplicationProblem_PURGED_GTIDS, "restore the tablet from a backup", "it misses transactions that the primary purged from its binlogs (gtid_purged %v)", purged)
+			safe = false
+		}
+	}
+	if !safe && repair != repairNone {
+		sourceProblem.Action += ", once its transactions are fixed"
+		repair = repairNone
+	}
+	return problems, repair
+}
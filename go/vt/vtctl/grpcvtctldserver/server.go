@@ -21,6 +21,7 @@ import (
 	"errors"
 	"fmt"
 	"path/filepath"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -30,12 +31,15 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 
 	"vitess.io/vitess/go/event"
+	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/protoutil"
 	"vitess.io/vitess/go/sqlescape"
 	"vitess.io/vitess/go/trace"
 	"vitess.io/vitess/go/vt/concurrency"
+	"vitess.io/vitess/go/vt/dbconfigs"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl"
 	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
 	"vitess.io/vitess/go/vt/mysqlctl/mysqlctlproto"
 	"vitess.io/vitess/go/vt/sqlparser"
@@ -46,8 +50,12 @@ import (
 	"vitess.io/vitess/go/vt/vtctl/reparentutil"
 	"vitess.io/vitess/go/vt/vtctl/workflow"
 	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtexplain"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/rules"
 	"vitess.io/vitess/go/vt/vttablet/tmclient"
 
+	throttlemysql "vitess.io/vitess/go/vt/vttablet/tabletserver/throttle/mysql"
+
 	logutilpb "vitess.io/vitess/go/vt/proto/logutil"
 	mysqlctlpb "vitess.io/vitess/go/vt/proto/mysqlctl"
 	replicationdatapb "vitess.io/vitess/go/vt/proto/replicationdata"
@@ -123,6 +131,52 @@ func (s *VtctldServer) AddCellsAlias(ctx context.Context, req *vtctldatapb.AddCe
 	return &vtctldatapb.AddCellsAliasResponse{}, nil
 }
 
+// AddQueryRule is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) AddQueryRule(ctx context.Context, req *vtctldatapb.AddQueryRuleRequest) (*vtctldatapb.AddQueryRuleResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.AddQueryRule")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("rule", req.Rule.GetName())
+	span.Annotate("before", req.Before)
+
+	if req.Keyspace == "" {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "keyspace field is required")
+	}
+	if req.Rule.GetName() == "" {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "rule name is required")
+	}
+	if _, err := rules.BuildQueryRuleFromProto(req.Rule); err != nil {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "invalid query rule %v: %v", req.Rule.Name, err)
+	}
+
+	queryRules, err := s.updateQueryRules(ctx, req.Keyspace, "AddQueryRule", func(queryRules []*topodatapb.QueryRule) ([]*topodatapb.QueryRule, error) {
+		// A rule with the same name is replaced in place.
+		for i, rule := range queryRules {
+			if rule.Name == req.Rule.Name {
+				queryRules[i] = req.Rule
+				return queryRules, nil
+			}
+		}
+		if req.Before == "" {
+			return append(queryRules, req.Rule), nil
+		}
+		for i, rule := range queryRules {
+			if rule.Name == req.Before {
+				return append(queryRules[:i], append([]*topodatapb.QueryRule{req.Rule}, queryRules[i:]...)...), nil
+			}
+		}
+		return nil, vterrors.Errorf(vtrpc.Code_NOT_FOUND, "query rule %v not found in keyspace %v", req.Before, req.Keyspace)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &vtctldatapb.AddQueryRuleResponse{
+		Rules: queryRules,
+	}, nil
+}
+
 // ApplyRoutingRules is part of the vtctlservicepb.VtctldServer interface.
 func (s *VtctldServer) ApplyRoutingRules(ctx context.Context, req *vtctldatapb.ApplyRoutingRulesRequest) (*vtctldatapb.ApplyRoutingRulesResponse, error) {
 	span, ctx := trace.NewSpan(ctx, "VtctldServer.ApplyRoutingRules")
@@ -158,6 +212,7 @@ func (s *VtctldServer) ApplyVSchema(ctx context.Context, req *vtctldatapb.ApplyV
 	span.Annotate("cells", strings.Join(req.Cells, ","))
 	span.Annotate("skip_rebuild", req.SkipRebuild)
 	span.Annotate("dry_run", req.DryRun)
+	span.Annotate("author", req.Author)
 
 	if _, err := s.ts.GetKeyspace(ctx, req.Keyspace); err != nil {
 		if topo.IsErrType(err, topo.NoNode) {
@@ -205,8 +260,9 @@ func (s *VtctldServer) ApplyVSchema(ctx context.Context, req *vtctldatapb.ApplyV
 		return &vtctldatapb.ApplyVSchemaResponse{VSchema: vs}, nil
 	}
 
-	if err = s.ts.SaveVSchema(ctx, req.Keyspace, vs); err != nil {
-		return nil, vterrors.Wrapf(err, "SaveVSchema(%s, %v)", req.Keyspace, req.VSchema)
+	revision, err := s.ts.SaveVSchemaRevision(ctx, req.Keyspace, vs, req.Author, req.Comment)
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "SaveVSchemaRevision(%s, %v)", req.Keyspace, req.VSchema)
 	}
 
 	if !req.SkipRebuild {
@@ -218,7 +274,7 @@ func (s *VtctldServer) ApplyVSchema(ctx context.Context, req *vtctldatapb.ApplyV
 	if err != nil {
 		return nil, vterrors.Wrapf(err, "GetVSchema(%s)", req.Keyspace)
 	}
-	return &vtctldatapb.ApplyVSchemaResponse{VSchema: updatedVS}, nil
+	return &vtctldatapb.ApplyVSchemaResponse{VSchema: updatedVS, Version: revision.Version}, nil
 }
 
 // ChangeTabletType is part of the vtctlservicepb.VtctldServer interface.
@@ -276,6 +332,107 @@ func (s *VtctldServer) ChangeTabletType(ctx context.Context, req *vtctldatapb.Ch
 	}, nil
 }
 
+// ChangeTabletTags is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) ChangeTabletTags(ctx context.Context, req *vtctldatapb.ChangeTabletTagsRequest) (*vtctldatapb.ChangeTabletTagsResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.ChangeTabletTags")
+	defer span.Finish()
+
+	span.Annotate("tablet_alias", topoproto.TabletAliasString(req.TabletAlias))
+	span.Annotate("replace", req.Replace)
+
+	ctx, cancel := context.WithTimeout(ctx, *topo.RemoteOperationTimeout)
+	defer cancel()
+
+	tablet, err := s.ts.GetTablet(ctx, req.TabletAlias)
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := s.tmc.ChangeTags(ctx, tablet.Tablet, req.Tags, req.Replace)
+	if err != nil {
+		return nil, err
+	}
+
+	return &vtctldatapb.ChangeTabletTagsResponse{
+		BeforeTags: tablet.Tags,
+		AfterTags:  tags,
+	}, nil
+}
+
+// CheckErrantGTIDs is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) CheckErrantGTIDs(ctx context.Context, req *vtctldatapb.CheckErrantGTIDsRequest) (resp *vtctldatapb.CheckErrantGTIDsResponse, err error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.CheckErrantGTIDs")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("shard", req.Shard)
+	span.Annotate("fix", req.Fix)
+
+	if req.Keyspace == "" || req.Shard == "" {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "keyspace and shard fields are required")
+	}
+
+	if req.Fix {
+		var unlock func(*error)
+		ctx, unlock, err = s.ts.LockShard(ctx, req.Keyspace, req.Shard, "CheckErrantGTIDs")
+		if err != nil {
+			return nil, err
+		}
+		defer unlock(&err)
+	}
+
+	shard, tabletMap, primary, err := s.getShardPrimary(ctx, req.Keyspace, req.Shard)
+	if err != nil {
+		return nil, err
+	}
+
+	// Read the states of the replicas before the position of the primary,
+	// which must then contain their positions.
+	states := s.readReplicaStates(ctx, tabletMap, primary)
+	primaryPosition, err := s.readPrimaryPosition(ctx, primary.Tablet)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := primaryPosition.GTIDSet.(mysql.Mysql56GTIDSet); !ok {
+		return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "errant GTIDs can only be computed on the MySQL flavor, primary %v has position %v", topoproto.TabletAliasString(shard.PrimaryAlias), primaryPosition)
+	}
+
+	resp = &vtctldatapb.CheckErrantGTIDsResponse{
+		Primary: shard.PrimaryAlias,
+	}
+	for _, state := range states {
+		if state.err == nil && state.position == "" {
+			// The tablet did not execute any transaction.
+			continue
+		}
+
+		tabletErrantGTIDs := &vtctldatapb.ErrantGTIDs{
+			TabletAlias: state.tablet.Alias,
+		}
+		errant, err := readErrantGTIDs(state, primaryPosition)
+		switch {
+		case err != nil:
+			tabletErrantGTIDs.Error = err.Error()
+			resp.Tablets = append(resp.Tablets, tabletErrantGTIDs)
+			continue
+		case errant == nil:
+			continue
+		}
+
+		tabletErrantGTIDs.Gtids = errant.String()
+		if req.Fix {
+			if err := s.fixErrantGTIDs(ctx, state, primary.Tablet); err != nil {
+				tabletErrantGTIDs.Error = err.Error()
+			} else {
+				tabletErrantGTIDs.Fixed = true
+			}
+		}
+		resp.Tablets = append(resp.Tablets, tabletErrantGTIDs)
+	}
+
+	return resp, nil
+}
+
 // CreateKeyspace is part of the vtctlservicepb.VtctldServer interface.
 func (s *VtctldServer) CreateKeyspace(ctx context.Context, req *vtctldatapb.CreateKeyspaceRequest) (*vtctldatapb.CreateKeyspaceResponse, error) {
 	span, ctx := trace.NewSpan(ctx, "VtctldServer.CreateKeyspace")
@@ -287,6 +444,13 @@ func (s *VtctldServer) CreateKeyspace(ctx context.Context, req *vtctldatapb.Crea
 	span.Annotate("sharding_column_type", topoproto.KeyspaceIDTypeLString(req.ShardingColumnType))
 	span.Annotate("force", req.Force)
 	span.Annotate("allow_empty_vschema", req.AllowEmptyVSchema)
+	span.Annotate("durability_policy", req.DurabilityPolicy)
+
+	if req.DurabilityPolicy != "" {
+		if err := reparentutil.CheckKeyspaceDurabilityPolicy(req.DurabilityPolicy); err != nil {
+			return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "%v", err)
+		}
+	}
 
 	switch req.Type {
 	case topodatapb.KeyspaceType_NORMAL:
@@ -314,6 +478,8 @@ func (s *VtctldServer) CreateKeyspace(ctx context.Context, req *vtctldatapb.Crea
 
 		BaseKeyspace: req.BaseKeyspace,
 		SnapshotTime: req.SnapshotTime,
+
+		DurabilityPolicy: req.DurabilityPolicy,
 	}
 
 	err := s.ts.CreateKeyspace(ctx, req.Name, ki)
@@ -574,6 +740,30 @@ func (s *VtctldServer) DeleteTablets(ctx context.Context, req *vtctldatapb.Delet
 	return &vtctldatapb.DeleteTabletsResponse{}, nil
 }
 
+// DeregisterExternalMysql is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) DeregisterExternalMysql(ctx context.Context, req *vtctldatapb.DeregisterExternalMysqlRequest) (*vtctldatapb.DeregisterExternalMysqlResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.DeregisterExternalMysql")
+	defer span.Finish()
+
+	span.Annotate("name", req.Name)
+
+	ctx, cancel := context.WithTimeout(ctx, *topo.RemoteOperationTimeout)
+	defer cancel()
+
+	em, err := s.ts.GetExternalMysql(ctx, req.Name)
+	if err != nil {
+		return nil, err
+	}
+	if em == nil {
+		return nil, vterrors.Errorf(vtrpc.Code_NOT_FOUND, "there is no external mysql named %s", req.Name)
+	}
+	if err := s.ts.DeleteExternalMysql(ctx, req.Name); err != nil {
+		return nil, err
+	}
+
+	return &vtctldatapb.DeregisterExternalMysqlResponse{}, nil
+}
+
 // EmergencyReparentShard is part of the vtctldservicepb.VtctldServer interface.
 func (s *VtctldServer) EmergencyReparentShard(ctx context.Context, req *vtctldatapb.EmergencyReparentShardRequest) (*vtctldatapb.EmergencyReparentShardResponse, error) {
 	span, ctx := trace.NewSpan(ctx, "VtctldServer.EmergencyReparentShard")
@@ -594,6 +784,10 @@ func (s *VtctldServer) EmergencyReparentShard(ctx context.Context, req *vtctldat
 	}
 
 	span.Annotate("wait_replicas_timeout_sec", waitReplicasTimeout.Seconds())
+	span.Annotate("preferred_cells", strings.Join(req.PreferredCells, ","))
+	span.Annotate("prevent_cross_cell_promotion", req.PreventCrossCellPromotion)
+	span.Annotate("avoid_primary_host", req.AvoidPrimaryHost)
+	span.Annotate("max_lost_transactions", req.MaxLostTransactions)
 
 	m := sync.RWMutex{}
 	logstream := []*logutilpb.Event{}
@@ -611,6 +805,14 @@ func (s *VtctldServer) EmergencyReparentShard(ctx context.Context, req *vtctldat
 			NewPrimaryAlias:     req.NewPrimary,
 			IgnoreReplicas:      sets.NewString(ignoreReplicaAliases...),
 			WaitReplicasTimeout: waitReplicasTimeout,
+			CandidateSelectionRules: reparentutil.CandidateSelectionRules{
+				PreferredCells:            req.PreferredCells,
+				PreventCrossCellPromotion: req.PreventCrossCellPromotion,
+				AvoidPrimaryHost:          req.AvoidPrimaryHost,
+				AntiAffinityTags:          req.AntiAffinityTags,
+				RequiredTags:              req.RequiredTags,
+				MaxLostTransactions:       req.MaxLostTransactions,
+			},
 		},
 	)
 
@@ -626,6 +828,8 @@ func (s *VtctldServer) EmergencyReparentShard(ctx context.Context, req *vtctldat
 		if ev.NewPrimary != nil && !topoproto.TabletAliasIsZero(ev.NewPrimary.Alias) {
 			resp.PromotedPrimary = ev.NewPrimary.Alias
 		}
+
+		resp.CandidateSelection = ev.CandidateSelection
 	}
 
 	m.RLock()
@@ -637,29 +841,186 @@ func (s *VtctldServer) EmergencyReparentShard(ctx context.Context, req *vtctldat
 	return resp, err
 }
 
+// ExplainQuery is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) ExplainQuery(ctx context.Context, req *vtctldatapb.ExplainQueryRequest) (*vtctldatapb.ExplainQueryResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.ExplainQuery")
+	defer span.Finish()
+
+	span.Annotate("cell", req.Cell)
+	span.Annotate("target", req.Target)
+
+	if req.Sql == "" {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "sql must not be empty")
+	}
+
+	executionMode := req.ExecutionMode
+	switch executionMode {
+	case "":
+		executionMode = vtexplain.ModeMulti
+	case vtexplain.ModeMulti, vtexplain.ModeTwoPC:
+	default:
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "execution_mode must be %s or %s, got %q", vtexplain.ModeMulti, vtexplain.ModeTwoPC, req.ExecutionMode)
+	}
+
+	switch req.OutputFormat {
+	case "", "text", "json":
+	default:
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "output_format must be text or json, got %q", req.OutputFormat)
+	}
+
+	cell := req.Cell
+	if cell == "" {
+		cells, err := s.ts.GetCellInfoNames(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(cells) == 0 {
+			return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "the cluster has no cells")
+		}
+
+		cell = cells[0]
+	}
+
+	vschema, ksShardMap, err := vtexplain.LoadFromTopo(ctx, s.ts, cell)
+	if err != nil {
+		return nil, err
+	}
+
+	schema, err := s.getExplainSchema(ctx, cell)
+	if err != nil {
+		return nil, err
+	}
+
+	// vtexplain simulates a single cluster at a time, in global state.
+	vtexplainMu.Lock()
+	defer vtexplainMu.Unlock()
+
+	opts := &vtexplain.Options{
+		ReplicationMode: "ROW",
+		Normalize:       req.Normalize,
+		ExecutionMode:   executionMode,
+		Target:          req.Target,
+	}
+	if err := vtexplain.Init(vschema, schema, ksShardMap, opts); err != nil {
+		return nil, fmt.Errorf("vtexplain.Init failed: %w", err)
+	}
+	defer vtexplain.Stop()
+
+	explains, err := vtexplain.Run(req.Sql)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "vtexplain.Run failed")
+	}
+
+	resp := &vtctldatapb.ExplainQueryResponse{}
+	if req.OutputFormat == "json" {
+		resp.Explain = vtexplain.ExplainsAsJSON(explains)
+	} else {
+		resp.Explain = vtexplain.ExplainsAsText(explains)
+	}
+
+	return resp, nil
+}
+
+// vtexplainMu serializes the ExplainQuery calls, since vtexplain keeps its
+// simulated cluster in global state.
+var vtexplainMu sync.Mutex
+
+// getExplainSchema returns the CREATE TABLE statements of the tables of all
+// the keyspaces in the SrvVSchema of the cell, read from the primary of the
+// first shard of each keyspace.
+func (s *VtctldServer) getExplainSchema(ctx context.Context, cell string) (string, error) {
+	srvVSchema, err := s.ts.GetSrvVSchema(ctx, cell)
+	if err != nil {
+		return "", err
+	}
+
+	keyspaces := make([]string, 0, len(srvVSchema.Keyspaces))
+	for ks := range srvVSchema.Keyspaces {
+		keyspaces = append(keyspaces, ks)
+	}
+	sort.Strings(keyspaces)
+
+	var statements []string
+	for _, ks := range keyspaces {
+		shardMap, err := s.ts.FindAllShardsInKeyspace(ctx, ks)
+		if err != nil {
+			return "", err
+		}
+
+		shards := make([]*vtctldatapb.Shard, 0, len(shardMap))
+		for _, shard := range shardMap {
+			shards = append(shards, &vtctldatapb.Shard{Keyspace: ks, Name: shard.ShardName(), Shard: shard.Shard})
+		}
+		sortShards(shards)
+
+		var primary *topodatapb.TabletAlias
+		for _, shard := range shards {
+			if primary = shard.Shard.PrimaryAlias; primary != nil {
+				break
+			}
+		}
+
+		if primary == nil {
+			return "", vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "keyspace %s has no shard with a primary to read the schema from", ks)
+		}
+
+		tablet, err := s.ts.GetTablet(ctx, primary)
+		if err != nil {
+			return "", err
+		}
+
+		sd, err := s.tmc.GetSchema(ctx, tablet.Tablet, nil, nil, false)
+		if err != nil {
+			return "", fmt.Errorf("GetSchema(%s) failed: %w", topoproto.TabletAliasString(primary), err)
+		}
+
+		for _, td := range sd.TableDefinitions {
+			statements = append(statements, td.Schema)
+		}
+	}
+
+	return strings.Join(statements, ";\n"), nil
+}
+
 // FindAllShardsInKeyspace is part of the vtctlservicepb.VtctldServer interface.
 func (s *VtctldServer) FindAllShardsInKeyspace(ctx context.Context, req *vtctldatapb.FindAllShardsInKeyspaceRequest) (*vtctldatapb.FindAllShardsInKeyspaceResponse, error) {
 	span, ctx := trace.NewSpan(ctx, "VtctldServer.FindAllShardsInKeyspace")
 	defer span.Finish()
 
 	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("page_size", req.PageSize)
 
 	result, err := s.ts.FindAllShardsInKeyspace(ctx, req.Keyspace)
 	if err != nil {
 		return nil, err
 	}
 
-	shards := map[string]*vtctldatapb.Shard{}
+	allShards := make([]*vtctldatapb.Shard, 0, len(result))
 	for _, shard := range result {
-		shards[shard.ShardName()] = &vtctldatapb.Shard{
+		allShards = append(allShards, &vtctldatapb.Shard{
 			Keyspace: req.Keyspace,
 			Name:     shard.ShardName(),
 			Shard:    shard.Shard,
-		}
+		})
+	}
+
+	sortShards(allShards)
+
+	start, end, nextPageToken, err := paginate(len(allShards), req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	shards := make(map[string]*vtctldatapb.Shard, end-start)
+	for _, shard := range allShards[start:end] {
+		shards[shard.Name] = shard
 	}
 
 	return &vtctldatapb.FindAllShardsInKeyspaceResponse{
-		Shards: shards,
+		Shards:        shards,
+		NextPageToken: nextPageToken,
+		TotalSize:     int32(len(allShards)),
 	}, nil
 }
 
@@ -711,10 +1072,15 @@ func (s *VtctldServer) GetBackups(ctx context.Context, req *vtctldatapb.GetBacku
 		bi.Keyspace = req.Keyspace
 		bi.Shard = req.Shard
 
-		if req.Detailed {
-			if i >= backupsToSkipDetails { // nolint:staticcheck
-				// (TODO:@ajm188) Update backupengine/backupstorage implementations
-				// to get Status info for backups.
+		if req.Detailed && i >= backupsToSkipDetails {
+			// Backups that went through VerifyBackup get their status from
+			// the last verification.
+			//
+			// (TODO:@ajm188) Update backupengine/backupstorage implementations
+			// to get Status info for the other backups.
+			if verification, err := mysqlctl.GetBackupVerification(ctx, bh); err == nil {
+				bi.Status = verification.Status
+				recordStoredBackupVerification(req.Keyspace, req.Shard, verification)
 			}
 		}
 
@@ -775,92 +1141,299 @@ func (s *VtctldServer) GetCellsAliases(ctx context.Context, req *vtctldatapb.Get
 	return &vtctldatapb.GetCellsAliasesResponse{Aliases: aliases}, nil
 }
 
-// GetKeyspace is part of the vtctlservicepb.VtctldServer interface.
-func (s *VtctldServer) GetKeyspace(ctx context.Context, req *vtctldatapb.GetKeyspaceRequest) (*vtctldatapb.GetKeyspaceResponse, error) {
-	span, ctx := trace.NewSpan(ctx, "VtctldServer.GetKeyspace")
+// GetDDLStatus is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) GetDDLStatus(ctx context.Context, req *vtctldatapb.GetDDLStatusRequest) (*vtctldatapb.GetDDLStatusResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.GetDDLStatus")
 	defer span.Finish()
 
-	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("uuid", req.Uuid)
 
-	keyspace, err := s.ts.GetKeyspace(ctx, req.Keyspace)
+	if req.Uuid == "" {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "uuid is required")
+	}
+
+	status, err := s.ts.GetDDLStatus(ctx, req.Uuid)
 	if err != nil {
+		if topo.IsErrType(err, topo.NoNode) {
+			return nil, vterrors.Errorf(vtrpc.Code_NOT_FOUND, "no DDL with uuid %v", req.Uuid)
+		}
 		return nil, err
 	}
 
-	return &vtctldatapb.GetKeyspaceResponse{
-		Keyspace: &vtctldatapb.Keyspace{
-			Name:     req.Keyspace,
-			Keyspace: keyspace.Keyspace,
-		},
+	return &vtctldatapb.GetDDLStatusResponse{
+		Status: status,
 	}, nil
 }
 
-// GetKeyspaces is part of the vtctlservicepb.VtctldServer interface.
-func (s *VtctldServer) GetKeyspaces(ctx context.Context, req *vtctldatapb.GetKeyspacesRequest) (*vtctldatapb.GetKeyspacesResponse, error) {
-	span, ctx := trace.NewSpan(ctx, "VtctldServer.GetKeyspaces")
+// GetExternalMysqls is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) GetExternalMysqls(ctx context.Context, req *vtctldatapb.GetExternalMysqlsRequest) (*vtctldatapb.GetExternalMysqlsResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.GetExternalMysqls")
 	defer span.Finish()
 
-	names, err := s.ts.GetKeyspaces(ctx)
-	if err != nil {
-		return nil, err
-	}
+	span.Annotate("names", strings.Join(req.Names, ","))
+	span.Annotate("check_health", req.CheckHealth)
 
-	keyspaces := make([]*vtctldatapb.Keyspace, len(names))
+	names := req.Names
+	if len(names) == 0 {
+		var err error
+		names, err = s.ts.GetExternalMysqls(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
 
+	ems := make([]*vtctldatapb.ExternalMysql, len(names))
+	var wg sync.WaitGroup
 	for i, name := range names {
-		ks, err := s.GetKeyspace(ctx, &vtctldatapb.GetKeyspaceRequest{Keyspace: name})
+		em, err := s.ts.GetExternalMysql(ctx, name)
 		if err != nil {
 			return nil, err
 		}
-
-		keyspaces[i] = ks.Keyspace
+		if em == nil {
+			return nil, vterrors.Errorf(vtrpc.Code_NOT_FOUND, "there is no external mysql named %s", name)
+		}
+		ems[i] = externalMysqlProto(name, em.ExternalMysql)
+		if req.CheckHealth {
+			wg.Add(1)
+			go func(i int, em *topodatapb.ExternalMysql) {
+				defer wg.Done()
+				ems[i].Health = checkExternalMysqlHealth(ctx, dbconfigs.NewExternalMysqlDBConfigs(em).AppWithDB())
+			}(i, em.ExternalMysql)
+		}
 	}
+	wg.Wait()
 
-	return &vtctldatapb.GetKeyspacesResponse{Keyspaces: keyspaces}, nil
+	return &vtctldatapb.GetExternalMysqlsResponse{
+		ExternalMysqls: ems,
+	}, nil
 }
 
-// GetRoutingRules is part of the vtctlservicepb.VtctldServer interface.
-func (s *VtctldServer) GetRoutingRules(ctx context.Context, req *vtctldatapb.GetRoutingRulesRequest) (*vtctldatapb.GetRoutingRulesResponse, error) {
-	span, ctx := trace.NewSpan(ctx, "VtctldServer.GetRoutingRules")
+// GetKeyspace is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) GetKeyspace(ctx context.Context, req *vtctldatapb.GetKeyspaceRequest) (*vtctldatapb.GetKeyspaceResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.GetKeyspace")
 	defer span.Finish()
 
-	rr, err := s.ts.GetRoutingRules(ctx)
+	span.Annotate("keyspace", req.Keyspace)
+
+	keyspace, err := s.ts.GetKeyspace(ctx, req.Keyspace)
 	if err != nil {
 		return nil, err
 	}
 
-	return &vtctldatapb.GetRoutingRulesResponse{
-		RoutingRules: rr,
+	return &vtctldatapb.GetKeyspaceResponse{
+		Keyspace: &vtctldatapb.Keyspace{
+			Name:     req.Keyspace,
+			Keyspace: keyspace.Keyspace,
+		},
 	}, nil
 }
 
-// GetSchema is part of the vtctlservicepb.VtctldServer interface.
-func (s *VtctldServer) GetSchema(ctx context.Context, req *vtctldatapb.GetSchemaRequest) (*vtctldatapb.GetSchemaResponse, error) {
-	span, ctx := trace.NewSpan(ctx, "VtctldServer.GetSchema")
+// GetKeyspaceGraph is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) GetKeyspaceGraph(ctx context.Context, req *vtctldatapb.GetKeyspaceGraphRequest) (*vtctldatapb.GetKeyspaceGraphResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.GetKeyspaceGraph")
 	defer span.Finish()
 
-	span.Annotate("tablet_alias", topoproto.TabletAliasString(req.TabletAlias))
+	span.Annotate("keyspace", req.Keyspace)
 
-	tablet, err := s.ts.GetTablet(ctx, req.TabletAlias)
-	if err != nil {
-		return nil, fmt.Errorf("GetTablet(%v) failed: %w", req.TabletAlias, err)
+	if req.Keyspace == "" {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "keyspace must not be empty")
 	}
 
-	span.Annotate("tables", strings.Join(req.Tables, ","))
-	span.Annotate("exclude_tables", strings.Join(req.ExcludeTables, ","))
-	span.Annotate("include_views", req.IncludeViews)
-	span.Annotate("table_names_only", req.TableNamesOnly)
-	span.Annotate("table_sizes_only", req.TableSizesOnly)
-
-	sd, err := s.tmc.GetSchema(ctx, tablet.Tablet, req.Tables, req.ExcludeTables, req.IncludeViews)
+	shardMap, err := s.ts.FindAllShardsInKeyspace(ctx, req.Keyspace)
 	if err != nil {
-		return nil, fmt.Errorf("GetSchema(%v, %v, %v, %v) failed: %w", tablet.Tablet, req.Tables, req.ExcludeTables, req.IncludeViews, err)
+		return nil, err
 	}
 
-	if req.TableNamesOnly {
-		nameTds := make([]*tabletmanagerdatapb.TableDefinition, len(sd.TableDefinitions))
-
-		for i, td := range sd.TableDefinitions {
+	shards := make([]*vtctldatapb.Shard, 0, len(shardMap))
+	for _, shard := range shardMap {
+		shards = append(shards, &vtctldatapb.Shard{
+			Keyspace: req.Keyspace,
+			Name:     shard.ShardName(),
+			Shard:    shard.Shard,
+		})
+	}
+
+	sortShards(shards)
+
+	// Read the tablets of all cells, rather than the shards' replication
+	// graphs, to also find the tablets of shards which do not exist.
+	resp, err := s.GetTablets(ctx, &vtctldatapb.GetTabletsRequest{
+		Keyspace: req.Keyspace,
+		SortBy:   vtctldatapb.GetTabletsRequest_KEYSPACE_SHARD,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		m                 sync.Mutex
+		wg                sync.WaitGroup
+		statuses          = make(map[string]*replicationdatapb.Status, len(resp.Tablets))
+		replicationErrors = map[string]string{}
+	)
+
+	// Tablets which fail to return their replication status are part of the
+	// graph too, with their error, since they are often the ones to spot.
+	for _, tablet := range resp.Tablets {
+		if tablet.Type == topodatapb.TabletType_PRIMARY {
+			continue
+		}
+
+		wg.Add(1)
+
+		go func(tablet *topodatapb.Tablet) {
+			defer wg.Done()
+
+			alias := topoproto.TabletAliasString(tablet.Alias)
+
+			ctx, cancel := context.WithTimeout(ctx, *topo.RemoteOperationTimeout)
+			defer cancel()
+
+			status, err := s.tmc.ReplicationStatus(ctx, tablet)
+
+			m.Lock()
+			defer m.Unlock()
+
+			if err != nil {
+				replicationErrors[alias] = fmt.Sprintf("ReplicationStatus(%s) failed: %s", alias, err)
+				return
+			}
+
+			statuses[alias] = status
+		}(tablet)
+	}
+
+	wg.Wait()
+
+	return &vtctldatapb.GetKeyspaceGraphResponse{
+		Graph: buildKeyspaceGraph(req.Keyspace, shards, resp.Tablets, statuses, replicationErrors),
+	}, nil
+}
+
+// GetKeyspaces is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) GetKeyspaces(ctx context.Context, req *vtctldatapb.GetKeyspacesRequest) (*vtctldatapb.GetKeyspacesResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.GetKeyspaces")
+	defer span.Finish()
+
+	names, err := s.ts.GetKeyspaces(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	keyspaces := make([]*vtctldatapb.Keyspace, len(names))
+
+	for i, name := range names {
+		ks, err := s.GetKeyspace(ctx, &vtctldatapb.GetKeyspaceRequest{Keyspace: name})
+		if err != nil {
+			return nil, err
+		}
+
+		keyspaces[i] = ks.Keyspace
+	}
+
+	return &vtctldatapb.GetKeyspacesResponse{Keyspaces: keyspaces}, nil
+}
+
+// GetQueryRules is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) GetQueryRules(ctx context.Context, req *vtctldatapb.GetQueryRulesRequest) (*vtctldatapb.GetQueryRulesResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.GetQueryRules")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+
+	if req.Keyspace == "" {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "keyspace field is required")
+	}
+
+	ki, err := s.ts.GetKeyspace(ctx, req.Keyspace)
+	if err != nil {
+		return nil, err
+	}
+
+	return &vtctldatapb.GetQueryRulesResponse{
+		Rules: ki.QueryRules,
+	}, nil
+}
+
+// GetRecoverySettings is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) GetRecoverySettings(ctx context.Context, req *vtctldatapb.GetRecoverySettingsRequest) (*vtctldatapb.GetRecoverySettingsResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.GetRecoverySettings")
+	defer span.Finish()
+
+	settings, err := s.ts.GetRecoverySettings(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &vtctldatapb.GetRecoverySettingsResponse{
+		RecoverySettings: settings,
+	}, nil
+}
+
+// GetRestoreProgress is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) GetRestoreProgress(ctx context.Context, req *vtctldatapb.GetRestoreProgressRequest) (*vtctldatapb.GetRestoreProgressResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.GetRestoreProgress")
+	defer span.Finish()
+
+	span.Annotate("tablet_alias", topoproto.TabletAliasString(req.TabletAlias))
+
+	ti, err := s.ts.GetTablet(ctx, req.TabletAlias)
+	if err != nil {
+		return nil, err
+	}
+
+	progress, err := s.tmc.GetRestoreProgress(ctx, ti.Tablet)
+	if err != nil {
+		return nil, err
+	}
+
+	return &vtctldatapb.GetRestoreProgressResponse{
+		Progress: progress,
+	}, nil
+}
+
+// GetRoutingRules is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) GetRoutingRules(ctx context.Context, req *vtctldatapb.GetRoutingRulesRequest) (*vtctldatapb.GetRoutingRulesResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.GetRoutingRules")
+	defer span.Finish()
+
+	rr, err := s.ts.GetRoutingRules(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &vtctldatapb.GetRoutingRulesResponse{
+		RoutingRules: rr,
+	}, nil
+}
+
+// GetSchema is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) GetSchema(ctx context.Context, req *vtctldatapb.GetSchemaRequest) (*vtctldatapb.GetSchemaResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.GetSchema")
+	defer span.Finish()
+
+	span.Annotate("tablet_alias", topoproto.TabletAliasString(req.TabletAlias))
+
+	tablet, err := s.ts.GetTablet(ctx, req.TabletAlias)
+	if err != nil {
+		return nil, fmt.Errorf("GetTablet(%v) failed: %w", req.TabletAlias, err)
+	}
+
+	span.Annotate("tables", strings.Join(req.Tables, ","))
+	span.Annotate("exclude_tables", strings.Join(req.ExcludeTables, ","))
+	span.Annotate("include_views", req.IncludeViews)
+	span.Annotate("table_names_only", req.TableNamesOnly)
+	span.Annotate("table_sizes_only", req.TableSizesOnly)
+
+	sd, err := s.tmc.GetSchema(ctx, tablet.Tablet, req.Tables, req.ExcludeTables, req.IncludeViews)
+	if err != nil {
+		return nil, fmt.Errorf("GetSchema(%v, %v, %v, %v) failed: %w", tablet.Tablet, req.Tables, req.ExcludeTables, req.IncludeViews, err)
+	}
+
+	if req.TableNamesOnly {
+		nameTds := make([]*tabletmanagerdatapb.TableDefinition, len(sd.TableDefinitions))
+
+		for i, td := range sd.TableDefinitions {
 			nameTds[i] = &tabletmanagerdatapb.TableDefinition{
 				Name: td.Name,
 			}
@@ -961,6 +1534,7 @@ func (s *VtctldServer) GetSrvKeyspaces(ctx context.Context, req *vtctldatapb.Get
 	}
 
 	span.Annotate("cells", strings.Join(cells, ","))
+	span.Annotate("tablet_types", strings.Join(topoproto.MakeStringTypeList(req.TabletTypes), ","))
 
 	srvKeyspaces := make(map[string]*topodatapb.SrvKeyspace, len(cells))
 
@@ -977,7 +1551,7 @@ func (s *VtctldServer) GetSrvKeyspaces(ctx context.Context, req *vtctldatapb.Get
 			srvKeyspace = nil
 		}
 
-		srvKeyspaces[cell] = srvKeyspace
+		srvKeyspaces[cell] = filterSrvKeyspacePartitions(srvKeyspace, req.TabletTypes)
 	}
 
 	return &vtctldatapb.GetSrvKeyspacesResponse{
@@ -1069,6 +1643,14 @@ func (s *VtctldServer) GetTablets(ctx context.Context, req *vtctldatapb.GetTable
 
 	span.Annotate("cells", strings.Join(req.Cells, ","))
 	span.Annotate("strict", req.Strict)
+	span.Annotate("tablet_types", strings.Join(topoproto.MakeStringTypeList(req.TabletTypes), ","))
+	span.Annotate("sort_by", req.SortBy.String())
+	span.Annotate("page_size", req.PageSize)
+
+	// Fail invalid page tokens before reading all the tablets.
+	if _, _, _, err := paginate(0, req.PageSize, req.PageToken); err != nil {
+		return nil, err
+	}
 
 	// It is possible that an old primary has not yet updated its type in the
 	// topo. In that case, report its type as UNKNOWN. It used to be PRIMARY but
@@ -1143,7 +1725,7 @@ func (s *VtctldServer) GetTablets(ctx context.Context, req *vtctldatapb.GetTable
 			tablets = append(tablets, ti.Tablet)
 		}
 
-		return &vtctldatapb.GetTabletsResponse{Tablets: tablets}, nil
+		return newGetTabletsResponse(tablets, req)
 	}
 
 	cells := req.Cells
@@ -1229,9 +1811,7 @@ func (s *VtctldServer) GetTablets(ctx context.Context, req *vtctldatapb.GetTable
 		adjustedTablets[i] = ti.Tablet
 	}
 
-	return &vtctldatapb.GetTabletsResponse{
-		Tablets: adjustedTablets,
-	}, nil
+	return newGetTabletsResponse(adjustedTablets, req)
 }
 
 // GetVSchema is part of the vtctlservicepb.VtctldServer interface.
@@ -1251,6 +1831,23 @@ func (s *VtctldServer) GetVSchema(ctx context.Context, req *vtctldatapb.GetVSche
 	}, nil
 }
 
+// GetVSchemaRevisions is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) GetVSchemaRevisions(ctx context.Context, req *vtctldatapb.GetVSchemaRevisionsRequest) (*vtctldatapb.GetVSchemaRevisionsResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.GetVSchemaRevisions")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+
+	revisions, err := s.ts.GetVSchemaRevisions(ctx, req.Keyspace)
+	if err != nil {
+		return nil, err
+	}
+
+	return &vtctldatapb.GetVSchemaRevisionsResponse{
+		Revisions: revisions,
+	}, nil
+}
+
 // GetWorkflows is part of the vtctlservicepb.VtctldServer interface.
 func (s *VtctldServer) GetWorkflows(ctx context.Context, req *vtctldatapb.GetWorkflowsRequest) (*vtctldatapb.GetWorkflowsResponse, error) {
 	span, ctx := trace.NewSpan(ctx, "VtctldServer.GetWorkflows")
@@ -1522,84 +2119,385 @@ func (s *VtctldServer) InitShardPrimaryLocked(
 	return nil
 }
 
-// PlannedReparentShard is part of the vtctldservicepb.VtctldServer interface.
-func (s *VtctldServer) PlannedReparentShard(ctx context.Context, req *vtctldatapb.PlannedReparentShardRequest) (*vtctldatapb.PlannedReparentShardResponse, error) {
-	span, ctx := trace.NewSpan(ctx, "VtctldServer.PlannedReparentShard")
+// KillQueries is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) KillQueries(ctx context.Context, req *vtctldatapb.KillQueriesRequest) (*vtctldatapb.KillQueriesResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.KillQueries")
 	defer span.Finish()
 
-	waitReplicasTimeout, ok, err := protoutil.DurationFromProto(req.WaitReplicasTimeout)
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("fingerprint", req.Fingerprint)
+	span.Annotate("user", req.User)
+
+	if req.Keyspace == "" {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "keyspace field is required")
+	}
+	if req.Fingerprint == "" && req.User == "" {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "fingerprint or user field is required")
+	}
+	denyDuration, ok, err := protoutil.DurationFromProto(req.DenyDuration)
 	if err != nil {
-		return nil, err
-	} else if !ok {
-		waitReplicasTimeout = time.Second * 30
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "invalid deny duration: %v", err)
+	}
+	if ok && denyDuration < 0 {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "negative deny duration: %v", denyDuration)
 	}
 
-	span.Annotate("keyspace", req.Keyspace)
-	span.Annotate("shard", req.Shard)
-	span.Annotate("wait_replicas_timeout_sec", waitReplicasTimeout.Seconds())
+	resp := &vtctldatapb.KillQueriesResponse{
+		KilledQueries: map[string]int64{},
+		TabletErrors:  map[string]string{},
+	}
 
-	if req.AvoidPrimary != nil {
-		span.Annotate("avoid_primary_alias", topoproto.TabletAliasString(req.AvoidPrimary))
+	// The queries are denied first, so that the vtgates and the tablets do not
+	// start new ones while the in-flight ones are killed.
+	if denyDuration > 0 {
+		policy, err := s.denyQueries(ctx, req.Keyspace, &topodatapb.DeniedQuery{
+			Fingerprint: req.Fingerprint,
+			User:        req.User,
+			ExpireTime:  protoutil.TimeToProto(time.Now().Add(denyDuration)),
+		})
+		if err != nil {
+			return nil, err
+		}
+		resp.Policy = policy
 	}
 
-	if req.NewPrimary != nil {
-		span.Annotate("new_primary_alias", topoproto.TabletAliasString(req.NewPrimary))
+	shards, err := s.ts.GetShardNames(ctx, req.Keyspace)
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "GetShardNames(%s)", req.Keyspace)
 	}
 
-	m := sync.RWMutex{}
-	logstream := []*logutilpb.Event{}
-	logger := logutil.NewCallbackLogger(func(e *logutilpb.Event) {
-		m.Lock()
-		defer m.Unlock()
+	var (
+		m  sync.Mutex
+		wg sync.WaitGroup
+	)
+	for _, shard := range shards {
+		tabletMap, err := s.ts.GetTabletMapForShard(ctx, req.Keyspace, shard)
+		if err != nil {
+			return nil, fmt.Errorf("GetTabletMapForShard(%s, %s) failed: %w", req.Keyspace, shard, err)
+		}
+		for alias, ti := range tabletMap {
+			wg.Add(1)
+			go func(alias string, tablet *topodatapb.Tablet) {
+				defer wg.Done()
 
-		logstream = append(logstream, e)
-	})
+				ctx, cancel := context.WithTimeout(ctx, *topo.RemoteOperationTimeout)
+				defer cancel()
 
-	ev, err := reparentutil.NewPlannedReparenter(s.ts, s.tmc, logger).ReparentShard(ctx,
-		req.Keyspace,
-		req.Shard,
-		reparentutil.PlannedReparentOptions{
-			AvoidPrimaryAlias:   req.AvoidPrimary,
-			NewPrimaryAlias:     req.NewPrimary,
-			WaitReplicasTimeout: waitReplicasTimeout,
-		},
-	)
+				killed, err := s.tmc.KillQueries(ctx, tablet, req.Fingerprint, req.User)
 
-	resp := &vtctldatapb.PlannedReparentShardResponse{
-		Keyspace: req.Keyspace,
-		Shard:    req.Shard,
+				m.Lock()
+				defer m.Unlock()
+				if err != nil {
+					log.Warningf("KillQueries(%s) failed: %v", alias, err)
+					resp.TabletErrors[alias] = err.Error()
+					return
+				}
+				resp.KilledQueries[alias] = killed
+			}(alias, ti.Tablet)
+		}
 	}
+	wg.Wait()
 
-	if ev != nil {
-		resp.Keyspace = ev.ShardInfo.Keyspace()
-		resp.Shard = ev.ShardInfo.ShardName()
+	return resp, nil
+}
 
-		if !topoproto.TabletAliasIsZero(ev.NewPrimary.Alias) {
-			resp.PromotedPrimary = ev.NewPrimary.Alias
-		}
+// denyQueries adds a denied query to the policy of a keyspace, replacing the
+// one of the same fingerprint and user and dropping the expired ones, and
+// copies the policy to the SrvKeyspace of each cell.
+func (s *VtctldServer) denyQueries(ctx context.Context, keyspace string, denied *topodatapb.DeniedQuery) (policy *topodatapb.KeyspacePolicy, err error) {
+	ctx, unlock, lockErr := s.ts.LockKeyspace(ctx, keyspace, "KillQueries")
+	if lockErr != nil {
+		return nil, lockErr
 	}
+	defer unlock(&err)
 
-	m.RLock()
-	defer m.RUnlock()
+	ki, err := s.ts.GetKeyspace(ctx, keyspace)
+	if err != nil {
+		return nil, err
+	}
+	policy = proto.Clone(ki.Policy).(*topodatapb.KeyspacePolicy)
+	if policy == nil {
+		policy = &topodatapb.KeyspacePolicy{}
+	}
+	now := time.Now()
+	deniedQueries := []*topodatapb.DeniedQuery{denied}
+	for _, dq := range policy.DeniedQueries {
+		if dq.Fingerprint == denied.Fingerprint && dq.User == denied.User {
+			continue
+		}
+		if !now.Before(protoutil.TimeFromProto(dq.ExpireTime)) {
+			continue
+		}
+		deniedQueries = append(deniedQueries, dq)
+	}
+	policy.DeniedQueries = deniedQueries
 
-	resp.Events = make([]*logutilpb.Event, len(logstream))
-	copy(resp.Events, logstream)
+	ki.Policy = policy
+	if err := s.ts.UpdateKeyspace(ctx, ki); err != nil {
+		return nil, err
+	}
 
-	return resp, err
+	// The vtgates and the tablets read the policy from the SrvKeyspace.
+	if err := s.updateSrvKeyspaces(ctx, keyspace, func(srvKeyspace *topodatapb.SrvKeyspace) {
+		srvKeyspace.Policy = policy
+	}); err != nil {
+		return nil, err
+	}
+	return policy, nil
 }
 
-// RebuildVSchemaGraph is part of the vtctlservicepb.VtctldServer interface.
-func (s *VtctldServer) RebuildVSchemaGraph(ctx context.Context, req *vtctldatapb.RebuildVSchemaGraphRequest) (*vtctldatapb.RebuildVSchemaGraphResponse, error) {
-	span, ctx := trace.NewSpan(ctx, "VtctldServer.RebuildVSchemaGraph")
+// MaterializeCreate is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) MaterializeCreate(ctx context.Context, req *vtctldatapb.MaterializeCreateRequest) (*vtctldatapb.MaterializeCreateResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.MaterializeCreate")
 	defer span.Finish()
 
-	span.Annotate("cells", strings.Join(req.Cells, ","))
+	if req.Settings == nil {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "settings are required")
+	}
 
-	if err := s.ts.RebuildSrvVSchema(ctx, req.Cells); err != nil {
+	span.Annotate("workflow", req.Settings.Workflow)
+	span.Annotate("source_keyspace", req.Settings.SourceKeyspace)
+	span.Annotate("target_keyspace", req.Settings.TargetKeyspace)
+
+	if err := validateNewWorkflowRequest(req.Settings.Workflow, req.Settings.SourceKeyspace, req.Settings.TargetKeyspace); err != nil {
 		return nil, err
 	}
 
-	return &vtctldatapb.RebuildVSchemaGraphResponse{}, nil
+	if len(req.Settings.TableSettings) == 0 {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "no tables specified to materialize")
+	}
+
+	wm, err := s.workflowManager()
+	if err != nil {
+		return nil, err
+	}
+
+	return wm.MaterializeCreate(ctx, req)
+}
+
+// MoveTablesCreate is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) MoveTablesCreate(ctx context.Context, req *vtctldatapb.MoveTablesCreateRequest) (*vtctldatapb.MoveTablesCreateResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.MoveTablesCreate")
+	defer span.Finish()
+
+	span.Annotate("workflow", req.Workflow)
+	span.Annotate("source_keyspace", req.SourceKeyspace)
+	span.Annotate("target_keyspace", req.TargetKeyspace)
+	span.Annotate("external_cluster_name", req.ExternalClusterName)
+	span.Annotate("all_tables", req.AllTables)
+
+	if err := validateNewWorkflowRequest(req.Workflow, req.SourceKeyspace, req.TargetKeyspace); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case !req.AllTables && len(req.IncludeTables) == 0:
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "no tables specified to move")
+	case req.AllTables && len(req.IncludeTables) != 0:
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "cannot specify both all_tables and include_tables")
+	case !req.AllTables && len(req.ExcludeTables) != 0:
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "exclude_tables can only be specified with all_tables")
+	}
+
+	if _, err := s.ts.GetKeyspace(ctx, req.TargetKeyspace); err != nil {
+		return nil, err
+	}
+
+	wm, err := s.workflowManager()
+	if err != nil {
+		return nil, err
+	}
+
+	return wm.MoveTablesCreate(ctx, req)
+}
+
+// PlannedReparentShard is part of the vtctldservicepb.VtctldServer interface.
+func (s *VtctldServer) PlannedReparentShard(ctx context.Context, req *vtctldatapb.PlannedReparentShardRequest) (*vtctldatapb.PlannedReparentShardResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.PlannedReparentShard")
+	defer span.Finish()
+
+	waitReplicasTimeout, ok, err := protoutil.DurationFromProto(req.WaitReplicasTimeout)
+	if err != nil {
+		return nil, err
+	} else if !ok {
+		waitReplicasTimeout = time.Second * 30
+	}
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("shard", req.Shard)
+	span.Annotate("wait_replicas_timeout_sec", waitReplicasTimeout.Seconds())
+
+	if req.AvoidPrimary != nil {
+		span.Annotate("avoid_primary_alias", topoproto.TabletAliasString(req.AvoidPrimary))
+	}
+
+	if req.NewPrimary != nil {
+		span.Annotate("new_primary_alias", topoproto.TabletAliasString(req.NewPrimary))
+	}
+
+	m := sync.RWMutex{}
+	logstream := []*logutilpb.Event{}
+	logger := logutil.NewCallbackLogger(func(e *logutilpb.Event) {
+		m.Lock()
+		defer m.Unlock()
+
+		logstream = append(logstream, e)
+	})
+
+	ev, err := reparentutil.NewPlannedReparenter(s.ts, s.tmc, logger).ReparentShard(ctx,
+		req.Keyspace,
+		req.Shard,
+		reparentutil.PlannedReparentOptions{
+			AvoidPrimaryAlias:   req.AvoidPrimary,
+			NewPrimaryAlias:     req.NewPrimary,
+			WaitReplicasTimeout: waitReplicasTimeout,
+		},
+	)
+
+	resp := &vtctldatapb.PlannedReparentShardResponse{
+		Keyspace: req.Keyspace,
+		Shard:    req.Shard,
+	}
+
+	if ev != nil {
+		resp.Keyspace = ev.ShardInfo.Keyspace()
+		resp.Shard = ev.ShardInfo.ShardName()
+
+		if !topoproto.TabletAliasIsZero(ev.NewPrimary.Alias) {
+			resp.PromotedPrimary = ev.NewPrimary.Alias
+		}
+	}
+
+	m.RLock()
+	defer m.RUnlock()
+
+	resp.Events = make([]*logutilpb.Event, len(logstream))
+	copy(resp.Events, logstream)
+
+	return resp, err
+}
+
+// PruneBackups is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) PruneBackups(ctx context.Context, req *vtctldatapb.PruneBackupsRequest) (*vtctldatapb.PruneBackupsResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.PruneBackups")
+	defer span.Finish()
+
+	maxAge, _, err := protoutil.DurationFromProto(req.MaxAge)
+	if err != nil {
+		return nil, err
+	}
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("shard", req.Shard)
+	span.Annotate("keep_last", req.KeepLast)
+	span.Annotate("keep_daily", req.KeepDaily)
+	span.Annotate("keep_weekly", req.KeepWeekly)
+	span.Annotate("max_age_sec", maxAge.Seconds())
+	span.Annotate("dry_run", req.DryRun)
+
+	policy := backupstorage.RetentionPolicy{
+		KeepLast:   int(req.KeepLast),
+		KeepDaily:  int(req.KeepDaily),
+		KeepWeekly: int(req.KeepWeekly),
+		MaxAge:     maxAge,
+	}
+	if err := policy.Validate(); err != nil {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "%v", err)
+	}
+
+	bs, err := backupstorage.GetBackupStorage()
+	if err != nil {
+		return nil, err
+	}
+	defer bs.Close()
+
+	logger := logutil.NewCallbackLogger(func(e *logutilpb.Event) {
+		log.Infof("PruneBackups: %v", logutil.EventString(e))
+	})
+
+	pruned, err := mysqlctl.PruneBackups(ctx, logger, bs, req.Keyspace, req.Shard, policy, req.DryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	return &vtctldatapb.PruneBackupsResponse{
+		PrunedBackups: pruned,
+	}, nil
+}
+
+// RebuildVSchemaGraph is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) RebuildVSchemaGraph(ctx context.Context, req *vtctldatapb.RebuildVSchemaGraphRequest) (*vtctldatapb.RebuildVSchemaGraphResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.RebuildVSchemaGraph")
+	defer span.Finish()
+
+	span.Annotate("cells", strings.Join(req.Cells, ","))
+
+	if err := s.ts.RebuildSrvVSchema(ctx, req.Cells); err != nil {
+		return nil, err
+	}
+
+	return &vtctldatapb.RebuildVSchemaGraphResponse{}, nil
+}
+
+// ReferenceTablesCreate is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) ReferenceTablesCreate(ctx context.Context, req *vtctldatapb.ReferenceTablesCreateRequest) (*vtctldatapb.ReferenceTablesCreateResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.ReferenceTablesCreate")
+	defer span.Finish()
+
+	span.Annotate("workflow", req.Workflow)
+	span.Annotate("source_keyspace", req.SourceKeyspace)
+	span.Annotate("target_keyspaces", strings.Join(req.TargetKeyspaces, ","))
+	span.Annotate("tables", strings.Join(req.Tables, ","))
+
+	switch {
+	case req.Workflow == "":
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "workflow name is required")
+	case req.SourceKeyspace == "" || len(req.TargetKeyspaces) == 0:
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "source and target keyspaces are required")
+	case len(req.Tables) == 0:
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "no tables specified to replicate")
+	}
+
+	wm, err := s.workflowManager()
+	if err != nil {
+		return nil, err
+	}
+
+	return wm.ReferenceTablesCreate(ctx, req)
+}
+
+// ReferenceTablesDelete is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) ReferenceTablesDelete(ctx context.Context, req *vtctldatapb.ReferenceTablesDeleteRequest) (*vtctldatapb.ReferenceTablesDeleteResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.ReferenceTablesDelete")
+	defer span.Finish()
+
+	span.Annotate("workflow", req.Workflow)
+
+	if req.Workflow == "" {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "workflow name is required")
+	}
+
+	wm, err := s.workflowManager()
+	if err != nil {
+		return nil, err
+	}
+
+	return wm.ReferenceTablesDelete(ctx, req)
+}
+
+// ReferenceTablesRefresh is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) ReferenceTablesRefresh(ctx context.Context, req *vtctldatapb.ReferenceTablesRefreshRequest) (*vtctldatapb.ReferenceTablesRefreshResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.ReferenceTablesRefresh")
+	defer span.Finish()
+
+	span.Annotate("workflows", strings.Join(req.Workflows, ","))
+
+	wm, err := s.workflowManager()
+	if err != nil {
+		return nil, err
+	}
+
+	return wm.ReferenceTablesRefresh(ctx, req)
 }
 
 // RefreshState is part of the vtctldservicepb.VtctldServer interface.
@@ -1641,7 +2539,7 @@ func (s *VtctldServer) RefreshStateByShard(ctx context.Context, req *vtctldatapb
 		return nil, fmt.Errorf("Failed to get shard %s/%s/: %w", req.Keyspace, req.Shard, err)
 	}
 
-	isPartial, err := topotools.RefreshTabletsByShard(ctx, s.ts, s.tmc, si, req.Cells, logutil.NewCallbackLogger(func(e *logutilpb.Event) {
+	isPartial, err := topotools.RefreshTabletsByShard(ctx, s.ts, s.tmc, si, req.Cells, req.Tags, logutil.NewCallbackLogger(func(e *logutilpb.Event) {
 		switch e.Level {
 		case logutilpb.Level_WARNING:
 			log.Warningf(e.Value)
@@ -1660,6 +2558,70 @@ func (s *VtctldServer) RefreshStateByShard(ctx context.Context, req *vtctldatapb
 	}, nil
 }
 
+// RegisterExternalMysql is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) RegisterExternalMysql(ctx context.Context, req *vtctldatapb.RegisterExternalMysqlRequest) (*vtctldatapb.RegisterExternalMysqlResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.RegisterExternalMysql")
+	defer span.Finish()
+
+	span.Annotate("name", req.Name)
+	span.Annotate("host", req.Host)
+	span.Annotate("port", req.Port)
+	span.Annotate("db_name", req.DbName)
+	span.Annotate("skip_health_check", req.SkipHealthCheck)
+
+	switch {
+	case req.Name == "":
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "name is required")
+	case req.Host == "" || req.Port <= 0:
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "host and port are required")
+	case req.DbName == "":
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "db_name is required")
+	case req.User == "":
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "user is required")
+	}
+
+	vci, err := s.ts.GetExternalVitessCluster(ctx, req.Name)
+	if err != nil {
+		return nil, err
+	}
+	if vci != nil {
+		return nil, vterrors.Errorf(vtrpc.Code_ALREADY_EXISTS, "there is already a vitess cluster named %s", req.Name)
+	}
+	existing, err := s.ts.GetExternalMysql(ctx, req.Name)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, vterrors.Errorf(vtrpc.Code_ALREADY_EXISTS, "there is already an external mysql named %s", req.Name)
+	}
+
+	em := &topodatapb.ExternalMysql{
+		Host:     req.Host,
+		Port:     req.Port,
+		DbName:   req.DbName,
+		User:     req.User,
+		Password: req.Password,
+	}
+	var health *vtctldatapb.ExternalMysqlHealth
+	if !req.SkipHealthCheck {
+		health = checkExternalMysqlHealth(ctx, dbconfigs.NewExternalMysqlDBConfigs(em).AppWithDB())
+		if !health.Healthy {
+			return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "external mysql %s is not healthy: %s", req.Name, health.Error)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, *topo.RemoteOperationTimeout)
+	defer cancel()
+
+	if err := s.ts.CreateExternalMysql(ctx, req.Name, em); err != nil {
+		return nil, err
+	}
+
+	return &vtctldatapb.RegisterExternalMysqlResponse{
+		Health: health,
+	}, nil
+}
+
 // RemoveKeyspaceCell is part of the vtctlservicepb.VtctldServer interface.
 func (s *VtctldServer) RemoveKeyspaceCell(ctx context.Context, req *vtctldatapb.RemoveKeyspaceCellRequest) (*vtctldatapb.RemoveKeyspaceCellResponse, error) {
 	span, ctx := trace.NewSpan(ctx, "VtctldServer.RemoveKeyspaceCell")
@@ -1692,6 +2654,38 @@ func (s *VtctldServer) RemoveKeyspaceCell(ctx context.Context, req *vtctldatapb.
 	return &vtctldatapb.RemoveKeyspaceCellResponse{}, nil
 }
 
+// RemoveQueryRule is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) RemoveQueryRule(ctx context.Context, req *vtctldatapb.RemoveQueryRuleRequest) (*vtctldatapb.RemoveQueryRuleResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.RemoveQueryRule")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("rule", req.Name)
+
+	if req.Keyspace == "" {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "keyspace field is required")
+	}
+	if req.Name == "" {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "rule name is required")
+	}
+
+	queryRules, err := s.updateQueryRules(ctx, req.Keyspace, "RemoveQueryRule", func(queryRules []*topodatapb.QueryRule) ([]*topodatapb.QueryRule, error) {
+		for i, rule := range queryRules {
+			if rule.Name == req.Name {
+				return append(queryRules[:i], queryRules[i+1:]...), nil
+			}
+		}
+		return nil, vterrors.Errorf(vtrpc.Code_NOT_FOUND, "query rule %v not found in keyspace %v", req.Name, req.Keyspace)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &vtctldatapb.RemoveQueryRuleResponse{
+		Rules: queryRules,
+	}, nil
+}
+
 // RemoveShardCell is part of the vtctlservicepb.VtctldServer interface.
 func (s *VtctldServer) RemoveShardCell(ctx context.Context, req *vtctldatapb.RemoveShardCellRequest) (*vtctldatapb.RemoveShardCellResponse, error) {
 	span, ctx := trace.NewSpan(ctx, "VtctldServer.RemoveShardCell")
@@ -1710,6 +2704,83 @@ func (s *VtctldServer) RemoveShardCell(ctx context.Context, req *vtctldatapb.Rem
 	return &vtctldatapb.RemoveShardCellResponse{}, nil
 }
 
+// RepairReplication is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) RepairReplication(ctx context.Context, req *vtctldatapb.RepairReplicationRequest) (resp *vtctldatapb.RepairReplicationResponse, err error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.RepairReplication")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("shard", req.Shard)
+	span.Annotate("dry_run", req.DryRun)
+
+	if req.Keyspace == "" || req.Shard == "" {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "keyspace and shard fields are required")
+	}
+
+	if !req.DryRun {
+		var unlock func(*error)
+		ctx, unlock, err = s.ts.LockShard(ctx, req.Keyspace, req.Shard, "RepairReplication")
+		if err != nil {
+			return nil, err
+		}
+		defer unlock(&err)
+	}
+
+	shard, tabletMap, primary, err := s.getShardPrimary(ctx, req.Keyspace, req.Shard)
+	if err != nil {
+		return nil, err
+	}
+
+	// Read the states of the replicas before the position of the primary,
+	// which must then contain their positions.
+	states := s.readReplicaStates(ctx, tabletMap, primary)
+	primaryPosition, err := s.readPrimaryPosition(ctx, primary.Tablet)
+	if err != nil {
+		return nil, err
+	}
+	purged := s.readPrimaryPurgedGTIDs(ctx, primary.Tablet, primaryPosition)
+
+	resp = &vtctldatapb.RepairReplicationResponse{
+		Keyspace: req.Keyspace,
+		Shard:    req.Shard,
+		Primary:  shard.PrimaryAlias,
+	}
+	for _, state := range states {
+		problems, repair := diagnoseReplica(state, primary.Tablet, primaryPosition, purged)
+		resp.Problems = append(resp.Problems, problems...)
+		if repair == repairNone {
+			continue
+		}
+
+		// The repairable problem is the first one of the tablet.
+		if req.DryRun {
+			problems[0].Resolution = vtctldatapb.ReplicationProblem_DRY_RUN
+			continue
+		}
+
+		repairCtx, cancel := context.WithTimeout(ctx, *topo.RemoteOperationTimeout)
+		var repairErr error
+		switch repair {
+		case repairRepoint:
+			// A delayed replica whose replication is stopped keeps it stopped.
+			forceStartReplication := state.tablet.ReplicationDelaySeconds == 0
+			repairErr = s.tmc.SetMaster(repairCtx, state.tablet, shard.PrimaryAlias, 0, "", forceStartReplication)
+		case repairRestart:
+			repairErr = s.tmc.StartReplication(repairCtx, state.tablet)
+		}
+		cancel()
+
+		if repairErr != nil {
+			problems[0].Resolution = vtctldatapb.ReplicationProblem_FAILED
+			problems[0].Error = repairErr.Error()
+			continue
+		}
+		problems[0].Resolution = vtctldatapb.ReplicationProblem_REPAIRED
+	}
+
+	return resp, nil
+}
+
 // ReparentTablet is part of the vtctldservicepb.VtctldServer interface.
 func (s *VtctldServer) ReparentTablet(ctx context.Context, req *vtctldatapb.ReparentTabletRequest) (*vtctldatapb.ReparentTabletResponse, error) {
 	span, ctx := trace.NewSpan(ctx, "VtctldServer.ReparentTablet")
@@ -1763,8 +2834,359 @@ func (s *VtctldServer) ReparentTablet(ctx context.Context, req *vtctldatapb.Repa
 	}, nil
 }
 
-// ShardReplicationPositions is part of the vtctldservicepb.VtctldServer interface.
-func (s *VtctldServer) ShardReplicationPositions(ctx context.Context, req *vtctldatapb.ShardReplicationPositionsRequest) (*vtctldatapb.ShardReplicationPositionsResponse, error) {
+// ReshardCreate is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) ReshardCreate(ctx context.Context, req *vtctldatapb.ReshardCreateRequest) (*vtctldatapb.ReshardCreateResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.ReshardCreate")
+	defer span.Finish()
+
+	span.Annotate("workflow", req.Workflow)
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("source_shards", strings.Join(req.SourceShards, ","))
+	span.Annotate("target_shards", strings.Join(req.TargetShards, ","))
+
+	if err := validateNewWorkflowRequest(req.Workflow, req.Keyspace, req.Keyspace); err != nil {
+		return nil, err
+	}
+
+	if len(req.SourceShards) == 0 || len(req.TargetShards) == 0 {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "source and target shards are required")
+	}
+
+	if _, err := s.ts.GetKeyspace(ctx, req.Keyspace); err != nil {
+		return nil, err
+	}
+
+	wm, err := s.workflowManager()
+	if err != nil {
+		return nil, err
+	}
+
+	return wm.ReshardCreate(ctx, req)
+}
+
+// RollbackVSchema is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) RollbackVSchema(ctx context.Context, req *vtctldatapb.RollbackVSchemaRequest) (*vtctldatapb.RollbackVSchemaResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.RollbackVSchema")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("version", req.Version)
+	span.Annotate("author", req.Author)
+	span.Annotate("cells", strings.Join(req.Cells, ","))
+	span.Annotate("skip_rebuild", req.SkipRebuild)
+
+	target, err := s.ts.GetVSchemaRevision(ctx, req.Keyspace, req.Version)
+	if err != nil {
+		if topo.IsErrType(err, topo.NoNode) {
+			return nil, vterrors.Errorf(vtrpc.Code_NOT_FOUND, "the vschema of %s has no revision %d", req.Keyspace, req.Version)
+		}
+		return nil, vterrors.Wrapf(err, "GetVSchemaRevision(%s, %d)", req.Keyspace, req.Version)
+	}
+
+	comment := req.Comment
+	if comment == "" {
+		comment = fmt.Sprintf("rollback to version %d", req.Version)
+	}
+	revision, err := s.ts.SaveVSchemaRevision(ctx, req.Keyspace, target.Keyspace, req.Author, comment)
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "SaveVSchemaRevision(%s, %v)", req.Keyspace, target.Keyspace)
+	}
+
+	if !req.SkipRebuild {
+		if err := s.ts.RebuildSrvVSchema(ctx, req.Cells); err != nil {
+			return nil, vterrors.Wrapf(err, "RebuildSrvVSchema")
+		}
+	}
+
+	return &vtctldatapb.RollbackVSchemaResponse{
+		VSchema: revision.Keyspace,
+		Version: revision.Version,
+	}, nil
+}
+
+// ScaffoldVSchema is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) ScaffoldVSchema(ctx context.Context, req *vtctldatapb.ScaffoldVSchemaRequest) (*vtctldatapb.ScaffoldVSchemaResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.ScaffoldVSchema")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("tablet_alias", topoproto.TabletAliasString(req.TabletAlias))
+	span.Annotate("queries", len(req.Queries))
+
+	vschema, err := s.ts.GetVSchema(ctx, req.Keyspace)
+	switch {
+	case topo.IsErrType(err, topo.NoNode):
+	case err != nil:
+		return nil, vterrors.Wrapf(err, "GetVSchema(%s)", req.Keyspace)
+	case vschema.Sharded:
+		return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "keyspace %s is already sharded", req.Keyspace)
+	}
+
+	alias := req.TabletAlias
+	if alias == nil {
+		shards, err := s.ts.GetShardNames(ctx, req.Keyspace)
+		if err != nil {
+			return nil, vterrors.Wrapf(err, "GetShardNames(%s)", req.Keyspace)
+		}
+		if len(shards) != 1 {
+			return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "keyspace %s has %d shards, it must have a single one", req.Keyspace, len(shards))
+		}
+		si, err := s.ts.GetShard(ctx, req.Keyspace, shards[0])
+		if err != nil {
+			return nil, vterrors.Wrapf(err, "GetShard(%s, %s)", req.Keyspace, shards[0])
+		}
+		if si.PrimaryAlias == nil {
+			return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "shard %s/%s has no primary, pass a tablet_alias", req.Keyspace, shards[0])
+		}
+		alias = si.PrimaryAlias
+	}
+
+	ti, err := s.ts.GetTablet(ctx, alias)
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "GetTablet(%v)", topoproto.TabletAliasString(alias))
+	}
+	if ti.Keyspace != req.Keyspace {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "tablet %v is in keyspace %s, not %s", topoproto.TabletAliasString(alias), ti.Keyspace, req.Keyspace)
+	}
+
+	sd, err := s.tmc.GetSchema(ctx, ti.Tablet, nil, nil, false)
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "GetSchema(%v)", topoproto.TabletAliasString(alias))
+	}
+
+	return topotools.ScaffoldVSchema(sd, req.Queries), nil
+}
+
+// SetKeyspaceDurabilityPolicy is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) SetKeyspaceDurabilityPolicy(ctx context.Context, req *vtctldatapb.SetKeyspaceDurabilityPolicyRequest) (resp *vtctldatapb.SetKeyspaceDurabilityPolicyResponse, err error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.SetKeyspaceDurabilityPolicy")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("durability_policy", req.DurabilityPolicy)
+	span.Annotate("force", req.Force)
+
+	if req.Keyspace == "" {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "keyspace field is required")
+	}
+
+	var durability reparentutil.Durabler
+	if req.DurabilityPolicy != "" {
+		durability, err = reparentutil.GetKeyspaceDurabilityPolicy(req.DurabilityPolicy)
+		if err != nil {
+			return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "%v", err)
+		}
+	}
+
+	ctx, unlock, lockErr := s.ts.LockKeyspace(ctx, req.Keyspace, "SetKeyspaceDurabilityPolicy")
+	if lockErr != nil {
+		return nil, lockErr
+	}
+	defer unlock(&err)
+
+	ki, err := s.ts.GetKeyspace(ctx, req.Keyspace)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check that the current primaries could still commit under the new
+	// policy.
+	if durability != nil && !req.Force {
+		shards, err := s.ts.FindAllShardsInKeyspace(ctx, req.Keyspace)
+		if err != nil {
+			return nil, err
+		}
+		for shardName, shard := range shards {
+			if !shard.HasPrimary() {
+				continue
+			}
+			tabletMap, err := s.ts.GetTabletMapForShard(ctx, req.Keyspace, shardName)
+			if err != nil {
+				return nil, err
+			}
+			primary, ok := tabletMap[topoproto.TabletAliasString(shard.PrimaryAlias)]
+			if !ok {
+				return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "primary %v of shard %v/%v not found", topoproto.TabletAliasString(shard.PrimaryAlias), req.Keyspace, shardName)
+			}
+			replicas := make([]*topodatapb.Tablet, 0, len(tabletMap))
+			for _, tablet := range tabletMap {
+				replicas = append(replicas, tablet.Tablet)
+			}
+			if err := reparentutil.ValidateSemiSyncAckers(durability, primary.Tablet, replicas); err != nil {
+				return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "shard %v/%v: %v (use force to set the policy anyway)", req.Keyspace, shardName, err)
+			}
+		}
+	}
+
+	ki.DurabilityPolicy = req.DurabilityPolicy
+	if err := s.ts.UpdateKeyspace(ctx, ki); err != nil {
+		return nil, err
+	}
+
+	return &vtctldatapb.SetKeyspaceDurabilityPolicyResponse{
+		Keyspace: ki.Keyspace,
+	}, nil
+}
+
+// SetKeyspacePolicy is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) SetKeyspacePolicy(ctx context.Context, req *vtctldatapb.SetKeyspacePolicyRequest) (resp *vtctldatapb.SetKeyspacePolicyResponse, err error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.SetKeyspacePolicy")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+
+	if req.Keyspace == "" {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "keyspace field is required")
+	}
+	if err := topoproto.ValidateKeyspacePolicy(req.Policy); err != nil {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "invalid keyspace policy: %v", err)
+	}
+	policy := req.Policy
+	if proto.Equal(policy, &topodatapb.KeyspacePolicy{}) {
+		policy = nil
+	}
+
+	ctx, unlock, lockErr := s.ts.LockKeyspace(ctx, req.Keyspace, "SetKeyspacePolicy")
+	if lockErr != nil {
+		return nil, lockErr
+	}
+	defer unlock(&err)
+
+	ki, err := s.ts.GetKeyspace(ctx, req.Keyspace)
+	if err != nil {
+		return nil, err
+	}
+	// The denied queries are managed by KillQueries, and expire on their own.
+	if deniedQueries := ki.Policy.GetDeniedQueries(); len(deniedQueries) > 0 && len(req.Policy.GetDeniedQueries()) == 0 {
+		if policy == nil {
+			policy = &topodatapb.KeyspacePolicy{}
+		} else {
+			policy = proto.Clone(policy).(*topodatapb.KeyspacePolicy)
+		}
+		policy.DeniedQueries = deniedQueries
+	}
+	ki.Policy = policy
+	if err := s.ts.UpdateKeyspace(ctx, ki); err != nil {
+		return nil, err
+	}
+
+	// The vtgates and the tablets read the policy from the SrvKeyspace.
+	if err := s.updateSrvKeyspaces(ctx, req.Keyspace, func(srvKeyspace *topodatapb.SrvKeyspace) {
+		srvKeyspace.Policy = policy
+	}); err != nil {
+		return nil, err
+	}
+
+	return &vtctldatapb.SetKeyspacePolicyResponse{
+		Policy: policy,
+	}, nil
+}
+
+// SetReplicationDelay is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) SetReplicationDelay(ctx context.Context, req *vtctldatapb.SetReplicationDelayRequest) (*vtctldatapb.SetReplicationDelayResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.SetReplicationDelay")
+	defer span.Finish()
+
+	if req.TabletAlias == nil {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "tablet alias is required")
+	}
+
+	delay, _, err := protoutil.DurationFromProto(req.Delay)
+	if err != nil {
+		return nil, err
+	}
+	if delay < 0 || delay%time.Second != 0 {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "replication delay must be a non-negative number of seconds, got %v", delay)
+	}
+
+	span.Annotate("tablet_alias", topoproto.TabletAliasString(req.TabletAlias))
+	span.Annotate("delay_sec", delay.Seconds())
+
+	ctx, cancel := context.WithTimeout(ctx, *topo.RemoteOperationTimeout)
+	defer cancel()
+
+	tablet, err := s.ts.GetTablet(ctx, req.TabletAlias)
+	if err != nil {
+		return nil, err
+	}
+
+	if tablet.Type == topodatapb.TabletType_PRIMARY {
+		return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "cannot delay the replication of primary tablet %v", topoproto.TabletAliasString(req.TabletAlias))
+	}
+
+	if err := s.tmc.SetReplicationDelay(ctx, tablet.Tablet, delay); err != nil {
+		return nil, err
+	}
+
+	tablet, err = s.ts.GetTablet(ctx, req.TabletAlias)
+	if err != nil {
+		return nil, err
+	}
+
+	return &vtctldatapb.SetReplicationDelayResponse{
+		Tablet: tablet.Tablet,
+	}, nil
+}
+
+// SetShardWriteFence is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) SetShardWriteFence(ctx context.Context, req *vtctldatapb.SetShardWriteFenceRequest) (*vtctldatapb.SetShardWriteFenceResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.SetShardWriteFence")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("shard", req.Shard)
+	span.Annotate("reason", req.Reason)
+	span.Annotate("remove", req.Remove)
+
+	if req.Keyspace == "" || req.Shard == "" {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "keyspace and shard fields are required")
+	}
+
+	if !req.Remove && req.Reason == "" {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "reason field is required to set a write fence")
+	}
+
+	si, err := s.ts.UpdateShardFields(ctx, req.Keyspace, req.Shard, func(si *topo.ShardInfo) error {
+		if req.Remove {
+			si.WriteFence = nil
+			return nil
+		}
+
+		si.WriteFence = &topodatapb.Shard_WriteFence{
+			Reason:      req.Reason,
+			TimeCreated: protoutil.TimeToProto(time.Now()),
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	isPartial, err := topotools.RefreshTabletsByShard(ctx, s.ts, s.tmc, si, nil, nil, logutil.NewCallbackLogger(func(e *logutilpb.Event) {
+		switch e.Level {
+		case logutilpb.Level_WARNING:
+			log.Warningf(e.Value)
+		case logutilpb.Level_ERROR:
+			log.Errorf(e.Value)
+		default:
+			log.Infof(e.Value)
+		}
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	if isPartial {
+		return nil, vterrors.Errorf(vtrpc.Code_UNAVAILABLE, "write fence of shard %v/%v updated in the topo, but not all of its tablets were refreshed; run RefreshStateByShard to apply it", req.Keyspace, req.Shard)
+	}
+
+	return &vtctldatapb.SetShardWriteFenceResponse{
+		Shard: si.Shard,
+	}, nil
+}
+
+// ShardReplicationPositions is part of the vtctldservicepb.VtctldServer interface.
+func (s *VtctldServer) ShardReplicationPositions(ctx context.Context, req *vtctldatapb.ShardReplicationPositionsRequest) (*vtctldatapb.ShardReplicationPositionsResponse, error) {
 	span, ctx := trace.NewSpan(ctx, "VtctldServer.ShardReplicationPositions")
 	defer span.Finish()
 
@@ -2033,6 +3455,639 @@ func (s *VtctldServer) UpdateCellsAlias(ctx context.Context, req *vtctldatapb.Up
 	}, nil
 }
 
+// UpdateRecoverySettings is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) UpdateRecoverySettings(ctx context.Context, req *vtctldatapb.UpdateRecoverySettingsRequest) (*vtctldatapb.UpdateRecoverySettingsResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.UpdateRecoverySettings")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("shard", req.Shard)
+	span.Annotate("disable_recoveries", req.DisableRecoveries)
+	span.Annotate("enable_recoveries", req.EnableRecoveries)
+
+	if req.Shard != "" && req.Keyspace == "" {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "keyspace field is required with the shard field")
+	}
+	if req.DisableRecoveries && req.EnableRecoveries {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "cannot both disable and enable recoveries")
+	}
+
+	// Check the keyspaces and shards which are disabled or put in
+	// maintenance, to catch the typos.
+	if req.DisableRecoveries {
+		if err := s.checkKeyspaceShardExists(ctx, req.Keyspace, req.Shard); err != nil {
+			return nil, err
+		}
+	}
+	for _, window := range req.AddMaintenanceWindows {
+		if window.Name == "" {
+			return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "maintenance windows must have a name")
+		}
+		if window.Shard != "" && window.Keyspace == "" {
+			return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "maintenance window %v: a keyspace is required with a shard", window.Name)
+		}
+		if window.StartTime == nil || window.EndTime == nil {
+			return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "maintenance window %v: start and end times are required", window.Name)
+		}
+		if !protoutil.TimeFromProto(window.EndTime).After(protoutil.TimeFromProto(window.StartTime)) {
+			return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "maintenance window %v: the end time must be after the start time", window.Name)
+		}
+		if err := s.checkKeyspaceShardExists(ctx, window.Keyspace, window.Shard); err != nil {
+			return nil, err
+		}
+	}
+
+	now := time.Now()
+	settings, err := s.ts.UpdateRecoverySettingsFields(ctx, func(settings *topodatapb.RecoverySettings) error {
+		switch {
+		case req.Keyspace == "":
+			if req.DisableRecoveries || req.EnableRecoveries {
+				settings.Disabled = req.DisableRecoveries
+			}
+		case req.Shard == "":
+			settings.DisabledKeyspaces = updateRecoveriesDisabled(settings.DisabledKeyspaces, req.Keyspace, req.DisableRecoveries, req.EnableRecoveries)
+		default:
+			settings.DisabledShards = updateRecoveriesDisabled(settings.DisabledShards, topoproto.KeyspaceShardString(req.Keyspace, req.Shard), req.DisableRecoveries, req.EnableRecoveries)
+		}
+
+		// The windows are replaced by name, and the ones which ended are
+		// dropped.
+		removed := sets.NewString(req.RemoveMaintenanceWindows...)
+		for _, window := range req.AddMaintenanceWindows {
+			removed.Insert(window.Name)
+		}
+		windows := make([]*topodatapb.RecoverySettings_MaintenanceWindow, 0, len(settings.MaintenanceWindows)+len(req.AddMaintenanceWindows))
+		for _, window := range settings.MaintenanceWindows {
+			if removed.Has(window.Name) || !protoutil.TimeFromProto(window.EndTime).After(now) {
+				continue
+			}
+			windows = append(windows, window)
+		}
+		settings.MaintenanceWindows = append(windows, req.AddMaintenanceWindows...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &vtctldatapb.UpdateRecoverySettingsResponse{
+		RecoverySettings: settings,
+	}, nil
+}
+
+// checkKeyspaceShardExists returns an error if the keyspace, or the shard if
+// not empty, does not exist. An empty keyspace stands for all of them.
+func (s *VtctldServer) checkKeyspaceShardExists(ctx context.Context, keyspace string, shard string) error {
+	if keyspace == "" {
+		return nil
+	}
+	if shard == "" {
+		_, err := s.ts.GetKeyspace(ctx, keyspace)
+		return err
+	}
+	_, err := s.ts.GetShard(ctx, keyspace, shard)
+	return err
+}
+
+// updateRecoveriesDisabled adds name to or removes it from the names whose
+// recoveries are disabled.
+func updateRecoveriesDisabled(names []string, name string, disable bool, enable bool) []string {
+	for i, n := range names {
+		if n != name {
+			continue
+		}
+		if enable {
+			return append(names[:i], names[i+1:]...)
+		}
+		return names
+	}
+	if disable {
+		names = append(names, name)
+	}
+	return names
+}
+
+// updateQueryRules applies the update to the query rules of the keyspace,
+// under the keyspace lock, and copies them to its SrvKeyspace objects, from
+// which the tablets apply them.
+func (s *VtctldServer) updateQueryRules(ctx context.Context, keyspace string, action string, update func([]*topodatapb.QueryRule) ([]*topodatapb.QueryRule, error)) (queryRules []*topodatapb.QueryRule, err error) {
+	ctx, unlock, lockErr := s.ts.LockKeyspace(ctx, keyspace, action)
+	if lockErr != nil {
+		return nil, lockErr
+	}
+	defer unlock(&err)
+
+	ki, err := s.ts.GetKeyspace(ctx, keyspace)
+	if err != nil {
+		return nil, err
+	}
+	queryRules, err = update(append([]*topodatapb.QueryRule(nil), ki.QueryRules...))
+	if err != nil {
+		return nil, err
+	}
+	ki.QueryRules = queryRules
+	if err := s.ts.UpdateKeyspace(ctx, ki); err != nil {
+		return nil, err
+	}
+
+	if err := s.updateSrvKeyspaces(ctx, keyspace, func(srvKeyspace *topodatapb.SrvKeyspace) {
+		srvKeyspace.QueryRules = queryRules
+	}); err != nil {
+		return nil, err
+	}
+	return queryRules, nil
+}
+
+// updateSrvKeyspaces applies the update to the existing SrvKeyspace objects
+// of the keyspace.
+func (s *VtctldServer) updateSrvKeyspaces(ctx context.Context, keyspace string, update func(*topodatapb.SrvKeyspace)) error {
+	cells, err := s.ts.GetCellInfoNames(ctx)
+	if err != nil {
+		return err
+	}
+	for _, cell := range cells {
+		srvKeyspace, err := s.ts.GetSrvKeyspace(ctx, cell, keyspace)
+		switch {
+		case topo.IsErrType(err, topo.NoNode):
+			continue
+		case err != nil:
+			return fmt.Errorf("cannot get SrvKeyspace of keyspace %v in cell %v: %w", keyspace, cell, err)
+		}
+		update(srvKeyspace)
+		if err := s.ts.UpdateSrvKeyspace(ctx, cell, keyspace, srvKeyspace); err != nil {
+			return fmt.Errorf("cannot update SrvKeyspace of keyspace %v in cell %v: %w", keyspace, cell, err)
+		}
+	}
+	return nil
+}
+
+// UpdateThrottlerConfig is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) UpdateThrottlerConfig(ctx context.Context, req *vtctldatapb.UpdateThrottlerConfigRequest) (resp *vtctldatapb.UpdateThrottlerConfigResponse, err error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.UpdateThrottlerConfig")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+
+	if req.Keyspace == "" {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "keyspace field is required")
+	}
+
+	ctx, unlock, lockErr := s.ts.LockKeyspace(ctx, req.Keyspace, "UpdateThrottlerConfig")
+	if lockErr != nil {
+		return nil, lockErr
+	}
+	defer unlock(&err)
+
+	ki, err := s.ts.GetKeyspace(ctx, req.Keyspace)
+	if err != nil {
+		return nil, err
+	}
+
+	throttlerConfig := &topodatapb.ThrottlerConfig{}
+	if ki.ThrottlerConfig != nil {
+		throttlerConfig = proto.Clone(ki.ThrottlerConfig).(*topodatapb.ThrottlerConfig)
+	}
+	if throttlerConfig.Metrics == nil {
+		throttlerConfig.Metrics = map[string]*topodatapb.ThrottlerConfig_Metric{}
+	}
+	if throttlerConfig.AppPolicies == nil {
+		throttlerConfig.AppPolicies = map[string]*topodatapb.ThrottlerConfig_AppPolicy{}
+	}
+	for _, name := range req.RemoveMetrics {
+		delete(throttlerConfig.Metrics, name)
+	}
+	for name, metric := range req.SetMetrics {
+		throttlerConfig.Metrics[name] = metric
+	}
+	for _, appName := range req.RemoveAppPolicies {
+		delete(throttlerConfig.AppPolicies, appName)
+	}
+	for appName, policy := range req.SetAppPolicies {
+		throttlerConfig.AppPolicies[appName] = policy
+	}
+	if err := throttlemysql.ValidateThrottlerConfig(throttlerConfig); err != nil {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "invalid throttler config: %v", err)
+	}
+
+	ki.ThrottlerConfig = throttlerConfig
+	if err := s.ts.UpdateKeyspace(ctx, ki); err != nil {
+		return nil, err
+	}
+
+	// Copy the config to the existing SrvKeyspace objects, which the tablets
+	// watch, rather than waiting for the next RebuildKeyspaceGraph.
+	if err := s.updateSrvKeyspaces(ctx, req.Keyspace, func(srvKeyspace *topodatapb.SrvKeyspace) {
+		srvKeyspace.ThrottlerConfig = throttlerConfig
+	}); err != nil {
+		return nil, err
+	}
+
+	return &vtctldatapb.UpdateThrottlerConfigResponse{
+		ThrottlerConfig: throttlerConfig,
+	}, nil
+}
+
+// ValidateServingGraph is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) ValidateServingGraph(ctx context.Context, req *vtctldatapb.ValidateServingGraphRequest) (*vtctldatapb.ValidateServingGraphResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.ValidateServingGraph")
+	defer span.Finish()
+
+	span.Annotate("keyspaces", strings.Join(req.Keyspaces, ","))
+	span.Annotate("cells", strings.Join(req.Cells, ","))
+	span.Annotate("rebuild", req.Rebuild)
+
+	keyspaces := req.Keyspaces
+	if len(keyspaces) == 0 {
+		var err error
+		keyspaces, err = s.ts.GetKeyspaces(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var divergences []*topotools.ServingGraphDivergence
+	for _, keyspace := range keyspaces {
+		keyspaceDivergences, err := topotools.SrvKeyspaceDivergences(ctx, s.ts, keyspace, req.Cells)
+		if err != nil {
+			return nil, fmt.Errorf("cannot check the SrvKeyspace objects of keyspace %v: %w", keyspace, err)
+		}
+		divergences = append(divergences, keyspaceDivergences...)
+	}
+	vschemaDivergences, err := topotools.SrvVSchemaDivergences(ctx, s.ts, req.Cells)
+	if err != nil {
+		return nil, fmt.Errorf("cannot check the SrvVSchema objects: %w", err)
+	}
+	divergences = append(divergences, vschemaDivergences...)
+
+	logger := logutil.NewCallbackLogger(func(e *logutilpb.Event) {
+		log.Infof("ValidateServingGraph: %v", logutil.EventString(e))
+	})
+
+	resp := &vtctldatapb.ValidateServingGraphResponse{}
+	for _, d := range divergences {
+		divergence := &vtctldatapb.ValidateServingGraphResponse_Divergence{
+			Cell:        d.Cell,
+			Keyspace:    d.Keyspace,
+			Description: d.Description,
+		}
+		if req.Rebuild {
+			var err error
+			if d.Keyspace != "" {
+				err = topotools.RebuildKeyspace(ctx, logger, s.ts, d.Keyspace, []string{d.Cell}, false)
+			} else {
+				err = s.ts.RebuildSrvVSchema(ctx, []string{d.Cell})
+			}
+			if err != nil {
+				divergence.RebuildError = err.Error()
+			} else {
+				divergence.Rebuilt = true
+			}
+		}
+		resp.Divergences = append(resp.Divergences, divergence)
+	}
+
+	return resp, nil
+}
+
+// ValidateVSchema is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) ValidateVSchema(ctx context.Context, req *vtctldatapb.ValidateVSchemaRequest) (*vtctldatapb.ValidateVSchemaResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.ValidateVSchema")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("shards", strings.Join(req.Shards, ","))
+	span.Annotate("exclude_tables", strings.Join(req.ExcludeTables, ","))
+	span.Annotate("include_views", req.IncludeViews)
+
+	if _, err := s.ts.GetVSchema(ctx, req.Keyspace); err != nil {
+		return nil, vterrors.Wrapf(err, "GetVSchema(%s)", req.Keyspace)
+	}
+	// The vschemas of all the keyspaces, for the sequences and lookup tables
+	// of the keyspace.
+	srvVSchema, err := s.ts.BuildSrvVSchema(ctx)
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "BuildSrvVSchema")
+	}
+
+	shards := req.Shards
+	if len(shards) == 0 {
+		shards, err = s.ts.GetShardNames(ctx, req.Keyspace)
+		if err != nil {
+			return nil, vterrors.Wrapf(err, "GetShardNames(%s)", req.Keyspace)
+		}
+	}
+
+	var (
+		m       sync.Mutex
+		wg      sync.WaitGroup
+		rec     concurrency.AllErrorRecorder
+		schemas = make(map[string]*tabletmanagerdatapb.SchemaDefinition, len(shards))
+	)
+	for _, shard := range shards {
+		wg.Add(1)
+		go func(shard string) {
+			defer wg.Done()
+
+			si, err := s.ts.GetShard(ctx, req.Keyspace, shard)
+			if err != nil {
+				rec.RecordError(fmt.Errorf("GetShard(%s, %s) failed: %w", req.Keyspace, shard, err))
+				return
+			}
+			if si.PrimaryAlias == nil {
+				rec.RecordError(fmt.Errorf("shard %s/%s has no primary", req.Keyspace, shard))
+				return
+			}
+			primary, err := s.ts.GetTablet(ctx, si.PrimaryAlias)
+			if err != nil {
+				rec.RecordError(fmt.Errorf("GetTablet(%v) failed: %w", topoproto.TabletAliasString(si.PrimaryAlias), err))
+				return
+			}
+			sd, err := s.tmc.GetSchema(ctx, primary.Tablet, nil, req.ExcludeTables, req.IncludeViews)
+			if err != nil {
+				rec.RecordError(fmt.Errorf("GetSchema(%v, nil, %v, %v) failed: %w", topoproto.TabletAliasString(si.PrimaryAlias), req.ExcludeTables, req.IncludeViews, err))
+				return
+			}
+
+			m.Lock()
+			defer m.Unlock()
+			schemas[shard] = sd
+		}(shard)
+	}
+	wg.Wait()
+	if rec.HasErrors() {
+		return nil, rec.Error()
+	}
+
+	return &vtctldatapb.ValidateVSchemaResponse{
+		Findings: topotools.LintVSchema(req.Keyspace, srvVSchema.Keyspaces, schemas),
+	}, nil
+}
+
+// VDiff is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) VDiff(ctx context.Context, req *vtctldatapb.VDiffRequest) (*vtctldatapb.VDiffResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.VDiff")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("workflow", req.Workflow)
+	span.Annotate("source_cell", req.SourceCell)
+	span.Annotate("target_cell", req.TargetCell)
+	span.Annotate("tables", strings.Join(req.Tables, ","))
+
+	if err := validateWorkflowRequest(req.Keyspace, req.Workflow); err != nil {
+		return nil, err
+	}
+
+	if req.MaxRows < 0 {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "max_rows must not be negative")
+	}
+
+	wm, err := s.workflowManager()
+	if err != nil {
+		return nil, err
+	}
+
+	return wm.VDiff(ctx, req)
+}
+
+// VerifyBackup is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) VerifyBackup(ctx context.Context, req *vtctldatapb.VerifyBackupRequest) (*vtctldatapb.VerifyBackupResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.VerifyBackup")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("shard", req.Shard)
+	span.Annotate("backup_name", req.BackupName)
+	span.Annotate("level", req.Level.String())
+
+	var tablet *topodatapb.Tablet
+	if req.Level == mysqlctlpb.BackupVerification_RESTORE {
+		if req.TabletAlias == nil {
+			return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "a scratch tablet is required to verify a backup at the %v level", req.Level)
+		}
+
+		span.Annotate("tablet_alias", topoproto.TabletAliasString(req.TabletAlias))
+
+		ti, err := s.ts.GetTablet(ctx, req.TabletAlias)
+		if err != nil {
+			return nil, err
+		}
+
+		if ti.Keyspace != req.Keyspace || ti.Shard != req.Shard {
+			return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "tablet %v is in %v/%v, not in %v/%v", topoproto.TabletAliasString(req.TabletAlias), ti.Keyspace, ti.Shard, req.Keyspace, req.Shard)
+		}
+
+		// Restoring wipes the data of the tablet, so only the tablets that do
+		// not serve, like the ones the periodic verification picks, are used.
+		switch ti.Type {
+		case topodatapb.TabletType_SPARE, topodatapb.TabletType_DRAINED:
+		default:
+			return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "tablet %v is a %v, only SPARE and DRAINED tablets can be used to restore a backup", topoproto.TabletAliasString(req.TabletAlias), topoproto.TabletTypeLString(ti.Type))
+		}
+
+		tablet = ti.Tablet
+	}
+
+	bs, err := backupstorage.GetBackupStorage()
+	if err != nil {
+		return nil, err
+	}
+	defer bs.Close()
+
+	bhs, err := bs.ListBackups(ctx, filepath.Join(req.Keyspace, req.Shard))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(bhs) == 0 {
+		return nil, vterrors.Errorf(vtrpc.Code_NOT_FOUND, "no backups found for %v/%v", req.Keyspace, req.Shard)
+	}
+
+	logger := logutil.NewCallbackLogger(func(e *logutilpb.Event) {
+		log.Infof("VerifyBackup: %v", logutil.EventString(e))
+	})
+
+	bh := bhs[len(bhs)-1]
+	if tablet != nil {
+		// The tablet restores the backup that Restore picks, which skips the
+		// incremental backups.
+		bh, err = mysqlctl.FindBackupToRestore(ctx, mysqlctl.RestoreParams{Logger: logger, Keyspace: req.Keyspace, Shard: req.Shard}, bhs)
+		if err != nil {
+			return nil, vterrors.Errorf(vtrpc.Code_NOT_FOUND, "no complete full backup found for %v/%v: %v", req.Keyspace, req.Shard, err)
+		}
+	}
+	if req.BackupName != "" {
+		restored := bh
+		bh = nil
+		for _, candidate := range bhs {
+			if candidate.Name() == req.BackupName {
+				bh = candidate
+				break
+			}
+		}
+
+		if bh == nil {
+			return nil, vterrors.Errorf(vtrpc.Code_NOT_FOUND, "backup %v not found for %v/%v", req.BackupName, req.Keyspace, req.Shard)
+		}
+
+		if tablet != nil && bh != restored {
+			return nil, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "only the most recent full backup of %v/%v can be verified at the %v level", req.Keyspace, req.Shard, req.Level)
+		}
+	}
+
+	now := time.Now()
+	verification := &mysqlctlpb.BackupVerification{
+		BackupName: bh.Name(),
+		Level:      req.Level,
+		Time:       protoutil.TimeToProto(now),
+		Status:     mysqlctlpb.BackupInfo_VALID,
+	}
+
+	var verifyErr error
+	if tablet != nil {
+		verification.TabletAlias = tablet.Alias
+		verifyErr = s.restoreBackupOnTablet(ctx, tablet)
+	} else {
+		verifyErr = mysqlctl.VerifyBackup(ctx, logger, bh, req.Level == mysqlctlpb.BackupVerification_CHECKSUM)
+	}
+
+	if verifyErr != nil {
+		log.Warningf("VerifyBackup: backup %v/%v failed verification at the %v level: %v", bh.Directory(), bh.Name(), req.Level, verifyErr)
+		verification.Status = mysqlctlpb.BackupInfo_INVALID
+		verification.Error = verifyErr.Error()
+	}
+
+	recordBackupVerification(req.Keyspace, req.Shard, verification)
+
+	if err := mysqlctl.SaveBackupVerification(ctx, bh, verification); err != nil {
+		return nil, vterrors.Wrapf(err, "failed to save verification of backup %v", bh.Name())
+	}
+
+	return &vtctldatapb.VerifyBackupResponse{
+		Verification: verification,
+	}, nil
+}
+
+// WorkflowCancel is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) WorkflowCancel(ctx context.Context, req *vtctldatapb.WorkflowCancelRequest) (*vtctldatapb.WorkflowCancelResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.WorkflowCancel")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("workflow", req.Workflow)
+	span.Annotate("keep_data", req.KeepData)
+
+	if err := validateWorkflowRequest(req.Keyspace, req.Workflow); err != nil {
+		return nil, err
+	}
+
+	wm, err := s.workflowManager()
+	if err != nil {
+		return nil, err
+	}
+
+	return wm.WorkflowCancel(ctx, req)
+}
+
+// WorkflowComplete is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) WorkflowComplete(ctx context.Context, req *vtctldatapb.WorkflowCompleteRequest) (*vtctldatapb.WorkflowCompleteResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.WorkflowComplete")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("workflow", req.Workflow)
+	span.Annotate("keep_data", req.KeepData)
+	span.Annotate("rename_tables", req.RenameTables)
+	span.Annotate("dry_run", req.DryRun)
+
+	if err := validateWorkflowRequest(req.Keyspace, req.Workflow); err != nil {
+		return nil, err
+	}
+
+	wm, err := s.workflowManager()
+	if err != nil {
+		return nil, err
+	}
+
+	return wm.WorkflowComplete(ctx, req)
+}
+
+// WorkflowPause is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) WorkflowPause(ctx context.Context, req *vtctldatapb.WorkflowPauseRequest) (*vtctldatapb.WorkflowPauseResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.WorkflowPause")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("workflow", req.Workflow)
+	span.Annotate("dry_run", req.DryRun)
+
+	if err := validateWorkflowRequest(req.Keyspace, req.Workflow); err != nil {
+		return nil, err
+	}
+
+	if strings.TrimSpace(req.Reason) == "" {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "a reason is required to pause a workflow")
+	}
+
+	wm, err := s.workflowManager()
+	if err != nil {
+		return nil, err
+	}
+
+	return wm.WorkflowPause(ctx, req)
+}
+
+// WorkflowResume is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) WorkflowResume(ctx context.Context, req *vtctldatapb.WorkflowResumeRequest) (*vtctldatapb.WorkflowResumeResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.WorkflowResume")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("workflow", req.Workflow)
+	span.Annotate("dry_run", req.DryRun)
+
+	if err := validateWorkflowRequest(req.Keyspace, req.Workflow); err != nil {
+		return nil, err
+	}
+
+	wm, err := s.workflowManager()
+	if err != nil {
+		return nil, err
+	}
+
+	return wm.WorkflowResume(ctx, req)
+}
+
+// WorkflowSwitchTraffic is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) WorkflowSwitchTraffic(ctx context.Context, req *vtctldatapb.WorkflowSwitchTrafficRequest) (*vtctldatapb.WorkflowSwitchTrafficResponse, error) {
+	span, ctx := trace.NewSpan(ctx, "VtctldServer.WorkflowSwitchTraffic")
+	defer span.Finish()
+
+	span.Annotate("keyspace", req.Keyspace)
+	span.Annotate("workflow", req.Workflow)
+	span.Annotate("cells", strings.Join(req.Cells, ","))
+	span.Annotate("tablet_types", strings.Join(topoproto.MakeStringTypeList(req.TabletTypes), ","))
+	span.Annotate("direction", req.Direction)
+	span.Annotate("dry_run", req.DryRun)
+
+	if err := validateWorkflowRequest(req.Keyspace, req.Workflow); err != nil {
+		return nil, err
+	}
+
+	switch workflow.TrafficSwitchDirection(req.Direction) {
+	case workflow.DirectionForward, workflow.DirectionBackward:
+	default:
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "invalid traffic switch direction %d", req.Direction)
+	}
+
+	wm, err := s.workflowManager()
+	if err != nil {
+		return nil, err
+	}
+
+	return wm.WorkflowSwitchTraffic(ctx, req)
+}
+
 // StartServer registers a VtctldServer for RPCs on the given gRPC server.
 func StartServer(s *grpc.Server, ts *topo.Server) {
 	vtctlservicepb.RegisterVtctldServer(s, NewVtctldServer(ts))
@@ -167,6 +167,13 @@ func TestEmergencyReparentShardSlow(t *testing.T) {
 					Cell: "zone1",
 					Uid:  200,
 				},
+				CandidateSelection: &vtctldatapb.EmergencyReparentCandidateSelection{
+					Chosen: &topodatapb.TabletAlias{
+						Cell: "zone1",
+						Uid:  200,
+					},
+					Reason: "zone1-0000000200 was requested",
+				},
 			},
 			expectEventsToOccur: true,
 			shouldErr:           false,
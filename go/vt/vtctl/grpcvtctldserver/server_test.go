@@ -29,16 +29,23 @@ import (
 	"google.golang.org/protobuf/proto"
 
 	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/mysql/fakesqldb"
 	"vitess.io/vitess/go/protoutil"
+	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/test/utils"
 	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl"
 	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/topo/memorytopo"
 	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/topotools"
 	"vitess.io/vitess/go/vt/vtctl/grpcvtctldserver/testutil"
+	"vitess.io/vitess/go/vt/vtctl/workflow"
+	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/tmclient"
 
+	logutilpb "vitess.io/vitess/go/vt/proto/logutil"
 	mysqlctlpb "vitess.io/vitess/go/vt/proto/mysqlctl"
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	replicationdatapb "vitess.io/vitess/go/vt/proto/replicationdata"
@@ -47,6 +54,7 @@ import (
 	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
 	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
 	vtctlservicepb "vitess.io/vitess/go/vt/proto/vtctlservice"
+	"vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/proto/vttime"
 )
 
@@ -208,6 +216,75 @@ func TestAddCellsAlias(t *testing.T) {
 	}
 }
 
+func TestAddQueryRule(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ts := memorytopo.NewServer("zone1", "zone2")
+	vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, nil, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+		return NewVtctldServer(ts)
+	})
+
+	testutil.AddKeyspace(ctx, t, ts, &vtctldatapb.Keyspace{
+		Name:     "testkeyspace",
+		Keyspace: &topodatapb.Keyspace{},
+	})
+	// Only zone1 has a SrvKeyspace.
+	require.NoError(t, ts.UpdateSrvKeyspace(ctx, "zone1", "testkeyspace", &topodatapb.SrvKeyspace{}))
+
+	deny := &topodatapb.QueryRule{Name: "deny", Query: "select .* from t1", Action: "FAIL"}
+	allow := &topodatapb.QueryRule{Name: "allow", User: "admin", Action: "ALLOW"}
+	resp, err := vtctld.AddQueryRule(ctx, &vtctldatapb.AddQueryRuleRequest{
+		Keyspace: "testkeyspace",
+		Rule:     deny,
+	})
+	require.NoError(t, err)
+	utils.MustMatch(t, []*topodatapb.QueryRule{deny}, resp.Rules)
+
+	// A rule can be inserted before another one.
+	resp, err = vtctld.AddQueryRule(ctx, &vtctldatapb.AddQueryRuleRequest{
+		Keyspace: "testkeyspace",
+		Rule:     allow,
+		Before:   "deny",
+	})
+	require.NoError(t, err)
+	utils.MustMatch(t, []*topodatapb.QueryRule{allow, deny}, resp.Rules)
+
+	// A rule with the same name is replaced in place.
+	denyRetry := &topodatapb.QueryRule{Name: "deny", Plans: []string{"Select"}, TableNames: []string{"t1"}, Action: "FAIL_RETRY"}
+	resp, err = vtctld.AddQueryRule(ctx, &vtctldatapb.AddQueryRuleRequest{
+		Keyspace: "testkeyspace",
+		Rule:     denyRetry,
+	})
+	require.NoError(t, err)
+	expected := []*topodatapb.QueryRule{allow, denyRetry}
+	utils.MustMatch(t, expected, resp.Rules)
+
+	getResp, err := vtctld.GetQueryRules(ctx, &vtctldatapb.GetQueryRulesRequest{Keyspace: "testkeyspace"})
+	require.NoError(t, err)
+	utils.MustMatch(t, expected, getResp.Rules)
+	srvKeyspace, err := ts.GetSrvKeyspace(ctx, "zone1", "testkeyspace")
+	require.NoError(t, err)
+	utils.MustMatch(t, expected, srvKeyspace.QueryRules)
+	_, err = ts.GetSrvKeyspace(ctx, "zone2", "testkeyspace")
+	assert.True(t, topo.IsErrType(err, topo.NoNode), "unexpected error: %v", err)
+
+	for _, req := range []*vtctldatapb.AddQueryRuleRequest{
+		{Rule: deny},
+		{Keyspace: "testkeyspace", Rule: &topodatapb.QueryRule{Action: "FAIL"}},
+		{Keyspace: "testkeyspace", Rule: &topodatapb.QueryRule{Name: "bad", Action: "DROP"}},
+		{Keyspace: "testkeyspace", Rule: &topodatapb.QueryRule{Name: "bad", Query: "(", Action: "FAIL"}},
+		{Keyspace: "testkeyspace", Rule: &topodatapb.QueryRule{Name: "bad", Plans: []string{"Unknown"}, Action: "FAIL"}},
+		{Keyspace: "testkeyspace", Rule: &topodatapb.QueryRule{Name: "other", Action: "FAIL"}, Before: "unknown"},
+	} {
+		_, err = vtctld.AddQueryRule(ctx, req)
+		assert.Error(t, err, "request %+v", req)
+	}
+	getResp, err = vtctld.GetQueryRules(ctx, &vtctldatapb.GetQueryRulesRequest{Keyspace: "testkeyspace"})
+	require.NoError(t, err)
+	utils.MustMatch(t, expected, getResp.Rules)
+}
+
 func TestApplyRoutingRules(t *testing.T) {
 	t.Parallel()
 
@@ -344,6 +421,7 @@ func TestApplyVSchema(t *testing.T) {
 				VSchema: &vschemapb.Keyspace{
 					Sharded: false,
 				},
+				Version: 2,
 			},
 			shouldErr: false,
 		}, {
@@ -359,6 +437,7 @@ func TestApplyVSchema(t *testing.T) {
 				VSchema: &vschemapb.Keyspace{
 					Sharded: false,
 				},
+				Version: 2,
 			},
 			shouldErr: false,
 		}, {
@@ -709,6 +788,214 @@ func TestChangeTabletType(t *testing.T) {
 	})
 }
 
+func TestChangeTabletTags(t *testing.T) {
+	t.Parallel()
+
+	alias := &topodatapb.TabletAlias{Cell: "zone1", Uid: 100}
+	tests := []struct {
+		name     string
+		req      *vtctldatapb.ChangeTabletTagsRequest
+		expected map[string]string
+	}{
+		{
+			name: "merge",
+			req: &vtctldatapb.ChangeTabletTagsRequest{
+				TabletAlias: alias,
+				Tags:        map[string]string{"ssd": "true", "pool": ""},
+			},
+			expected: map[string]string{"rack": "r1", "ssd": "true"},
+		},
+		{
+			name: "replace",
+			req: &vtctldatapb.ChangeTabletTagsRequest{
+				TabletAlias: alias,
+				Tags:        map[string]string{"ssd": "true"},
+				Replace:     true,
+			},
+			expected: map[string]string{"ssd": "true"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			ts := memorytopo.NewServer("zone1")
+			vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, &testutil.TabletManagerClient{
+				TopoServer: ts,
+			}, func(ts *topo.Server) vtctlservicepb.VtctldServer { return NewVtctldServer(ts) })
+
+			testutil.AddTablets(ctx, t, ts, nil, &topodatapb.Tablet{
+				Alias: alias,
+				Type:  topodatapb.TabletType_REPLICA,
+				Tags:  map[string]string{"rack": "r1", "pool": "batch"},
+			})
+
+			resp, err := vtctld.ChangeTabletTags(ctx, tt.req)
+			require.NoError(t, err)
+			utils.MustMatch(t, map[string]string{"rack": "r1", "pool": "batch"}, resp.BeforeTags)
+			utils.MustMatch(t, tt.expected, resp.AfterTags)
+
+			tablet, err := ts.GetTablet(ctx, alias)
+			require.NoError(t, err)
+			utils.MustMatch(t, tt.expected, tablet.Tags)
+		})
+	}
+
+	t.Run("unknown tablet", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		ts := memorytopo.NewServer("zone1")
+		vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, &testutil.TabletManagerClient{
+			TopoServer: ts,
+		}, func(ts *topo.Server) vtctlservicepb.VtctldServer { return NewVtctldServer(ts) })
+
+		_, err := vtctld.ChangeTabletTags(ctx, &vtctldatapb.ChangeTabletTagsRequest{TabletAlias: alias})
+		assert.Error(t, err)
+	})
+}
+
+func TestCheckErrantGTIDs(t *testing.T) {
+	t.Parallel()
+
+	const (
+		primaryUUID = "00000000-0000-0000-0000-000000000001"
+		errantUUID  = "00000000-0000-0000-0000-000000000002"
+	)
+	replicating := func(gtids string) *replicationdatapb.Status {
+		return &replicationdatapb.Status{
+			Position:         "MySQL56/" + gtids,
+			SourceHost:       "primary",
+			SourcePort:       3306,
+			IoThreadRunning:  true,
+			SqlThreadRunning: true,
+		}
+	}
+	errantPosition := primaryUUID + ":1-10," + errantUUID + ":1-2"
+
+	tmc := &testutil.TabletManagerClient{
+		ExecuteFetchAsDbaResults: map[string]struct {
+			Response *querypb.QueryResult
+			Error    error
+		}{
+			"zone1-0000000102": {},
+		},
+		MasterPositionResults: map[string]struct {
+			Position string
+			Error    error
+		}{
+			"zone1-0000000100": {Position: "MySQL56/" + primaryUUID + ":1-10"},
+			"zone1-0000000102": {Position: "MySQL56/" + errantPosition},
+		},
+		ReplicationStatusResults: map[string]struct {
+			Position *replicationdatapb.Status
+			Error    error
+		}{
+			"zone1-0000000101": {Position: replicating(primaryUUID + ":1-9")},
+			"zone1-0000000102": {Position: replicating(errantPosition)},
+			"zone1-0000000103": {Position: replicating(errantPosition)},
+			"zone1-0000000104": {Error: assert.AnError},
+		},
+		StartReplicationResults: map[string]error{
+			"zone1-0000000102": nil,
+		},
+		StopReplicationResults: map[string]error{
+			"zone1-0000000102": nil,
+			"zone1-0000000103": assert.AnError,
+		},
+	}
+
+	tests := []struct {
+		name      string
+		req       *vtctldatapb.CheckErrantGTIDsRequest
+		expected  []string
+		shouldErr bool
+	}{
+		{
+			name: "check",
+			req: &vtctldatapb.CheckErrantGTIDsRequest{
+				Keyspace: "testkeyspace",
+				Shard:    "-",
+			},
+			expected: []string{
+				"zone1-0000000102 " + errantUUID + ":1-2 fixed=false error=false",
+				"zone1-0000000103 " + errantUUID + ":1-2 fixed=false error=false",
+				"zone1-0000000104  fixed=false error=true",
+			},
+		},
+		{
+			name: "fix",
+			req: &vtctldatapb.CheckErrantGTIDsRequest{
+				Keyspace: "testkeyspace",
+				Shard:    "-",
+				Fix:      true,
+			},
+			expected: []string{
+				"zone1-0000000102 " + errantUUID + ":1-2 fixed=true error=false",
+				"zone1-0000000103 " + errantUUID + ":1-2 fixed=false error=true",
+				"zone1-0000000104  fixed=false error=true",
+			},
+		},
+		{
+			name: "no shard",
+			req: &vtctldatapb.CheckErrantGTIDsRequest{
+				Keyspace: "testkeyspace",
+				Shard:    "80-",
+			},
+			shouldErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			ts := memorytopo.NewServer("zone1")
+			vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, tmc, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+				return NewVtctldServer(ts)
+			})
+
+			tablets := []*topodatapb.Tablet{{
+				Alias: &topodatapb.TabletAlias{Cell: "zone1", Uid: 100},
+				Type:  topodatapb.TabletType_PRIMARY,
+			}}
+			for uid := uint32(101); uid <= 104; uid++ {
+				tablets = append(tablets, &topodatapb.Tablet{
+					Alias: &topodatapb.TabletAlias{Cell: "zone1", Uid: uid},
+					Type:  topodatapb.TabletType_REPLICA,
+				})
+			}
+			for _, tablet := range tablets {
+				tablet.Keyspace = "testkeyspace"
+				tablet.Shard = "-"
+			}
+			testutil.AddTablets(ctx, t, ts, &testutil.AddTabletOptions{
+				AlsoSetShardPrimary: true,
+			}, tablets...)
+
+			resp, err := vtctld.CheckErrantGTIDs(ctx, tt.req)
+			if tt.shouldErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			var tabletErrantGTIDs []string
+			for _, errant := range resp.Tablets {
+				tabletErrantGTIDs = append(tabletErrantGTIDs, fmt.Sprintf("%s %s fixed=%v error=%v", topoproto.TabletAliasString(errant.TabletAlias), errant.Gtids, errant.Fixed, errant.Error != ""))
+			}
+			assert.Equal(t, tt.expected, tabletErrantGTIDs)
+		})
+	}
+}
+
 func TestCreateKeyspace(t *testing.T) {
 	t.Parallel()
 
@@ -744,6 +1031,40 @@ func TestCreateKeyspace(t *testing.T) {
 			},
 			shouldErr: false,
 		},
+		{
+			name: "keyspace with durability policy",
+			topo: nil,
+			req: &vtctldatapb.CreateKeyspaceRequest{
+				Name:             "testkeyspace",
+				Type:             topodatapb.KeyspaceType_NORMAL,
+				DurabilityPolicy: "semi_sync",
+			},
+			expected: &vtctldatapb.CreateKeyspaceResponse{
+				Keyspace: &vtctldatapb.Keyspace{
+					Name: "testkeyspace",
+					Keyspace: &topodatapb.Keyspace{
+						KeyspaceType:     topodatapb.KeyspaceType_NORMAL,
+						DurabilityPolicy: "semi_sync",
+					},
+				},
+			},
+			vschemaShouldExist: true,
+			expectedVSchema: &vschemapb.Keyspace{
+				Sharded: false,
+			},
+			shouldErr: false,
+		},
+		{
+			name: "unknown durability policy",
+			topo: nil,
+			req: &vtctldatapb.CreateKeyspaceRequest{
+				Name:             "testkeyspace",
+				Type:             topodatapb.KeyspaceType_NORMAL,
+				DurabilityPolicy: "unknown",
+			},
+			vschemaShouldExist: false,
+			shouldErr:          true,
+		},
 		{
 			name: "snapshot keyspace",
 			topo: map[string]*topodatapb.Keyspace{
@@ -2703,6 +3024,13 @@ func TestEmergencyReparentShard(t *testing.T) {
 					Cell: "zone1",
 					Uid:  200,
 				},
+				CandidateSelection: &vtctldatapb.EmergencyReparentCandidateSelection{
+					Chosen: &topodatapb.TabletAlias{
+						Cell: "zone1",
+						Uid:  200,
+					},
+					Reason: "zone1-0000000200 was requested",
+				},
 			},
 			expectEventsToOccur: true,
 			shouldErr:           false,
@@ -2780,6 +3108,95 @@ func TestEmergencyReparentShard(t *testing.T) {
 	}
 }
 
+func TestExplainQuery(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ts := memorytopo.NewServer("zone1")
+	tmc := &testutil.TabletManagerClient{
+		GetSchemaResults: map[string]struct {
+			Schema *tabletmanagerdatapb.SchemaDefinition
+			Error  error
+		}{
+			"zone1-0000000100": {
+				Schema: &tabletmanagerdatapb.SchemaDefinition{
+					TableDefinitions: []*tabletmanagerdatapb.TableDefinition{
+						{
+							Name:   "t1",
+							Schema: "create table t1 (id bigint, name varchar(64), primary key (id))",
+						},
+					},
+				},
+			},
+		},
+	}
+	vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, tmc, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+		return NewVtctldServer(ts)
+	})
+
+	testutil.AddTablets(ctx, t, ts, &testutil.AddTabletOptions{AlsoSetShardPrimary: true}, &topodatapb.Tablet{
+		Alias:    &topodatapb.TabletAlias{Cell: "zone1", Uid: 100},
+		Keyspace: "testkeyspace",
+		Shard:    "-80",
+		Type:     topodatapb.TabletType_PRIMARY,
+	})
+	testutil.AddShards(ctx, t, ts, &vtctldatapb.Shard{Keyspace: "testkeyspace", Name: "80-"})
+	require.NoError(t, ts.UpdateSrvVSchema(ctx, "zone1", &vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"testkeyspace": {
+				Sharded: true,
+				Vindexes: map[string]*vschemapb.Vindex{
+					"hash": {Type: "hash"},
+				},
+				Tables: map[string]*vschemapb.Table{
+					"t1": {
+						ColumnVindexes: []*vschemapb.ColumnVindex{{Column: "id", Name: "hash"}},
+					},
+				},
+			},
+		},
+	}))
+
+	resp, err := vtctld.ExplainQuery(ctx, &vtctldatapb.ExplainQueryRequest{
+		Sql: "select name from t1 where id = 1",
+	})
+	require.NoError(t, err)
+	assert.Contains(t, resp.Explain, "1 testkeyspace/-80: select `name` from t1 where id = 1 limit 10001")
+	assert.NotContains(t, resp.Explain, "testkeyspace/80-")
+
+	tests := []struct {
+		name string
+		req  *vtctldatapb.ExplainQueryRequest
+	}{
+		{
+			name: "no sql",
+			req:  &vtctldatapb.ExplainQueryRequest{},
+		},
+		{
+			name: "invalid execution mode",
+			req:  &vtctldatapb.ExplainQueryRequest{Sql: "select 1 from t1", ExecutionMode: "legacy"},
+		},
+		{
+			name: "invalid output format",
+			req:  &vtctldatapb.ExplainQueryRequest{Sql: "select 1 from t1", OutputFormat: "yaml"},
+		},
+		{
+			name: "unknown cell",
+			req:  &vtctldatapb.ExplainQueryRequest{Sql: "select 1 from t1", Cell: "zone2"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := vtctld.ExplainQuery(ctx, tt.req)
+			assert.Error(t, err)
+		})
+	}
+}
+
 func TestFindAllShardsInKeyspace(t *testing.T) {
 	t.Parallel()
 
@@ -2818,6 +3235,22 @@ func TestFindAllShardsInKeyspace(t *testing.T) {
 	}
 
 	utils.MustMatch(t, expected, resp.Shards)
+	assert.Empty(t, resp.NextPageToken)
+	assert.Equal(t, int32(2), resp.TotalSize)
+
+	// The shards are paginated in key range order.
+	resp, err = vtctld.FindAllShardsInKeyspace(ctx, &vtctldatapb.FindAllShardsInKeyspaceRequest{Keyspace: ks.Name, PageSize: 1})
+	require.NoError(t, err)
+	utils.MustMatch(t, map[string]*vtctldatapb.Shard{"-80": expected["-80"]}, resp.Shards)
+	require.NotEmpty(t, resp.NextPageToken)
+
+	resp, err = vtctld.FindAllShardsInKeyspace(ctx, &vtctldatapb.FindAllShardsInKeyspaceRequest{Keyspace: ks.Name, PageSize: 1, PageToken: resp.NextPageToken})
+	require.NoError(t, err)
+	utils.MustMatch(t, map[string]*vtctldatapb.Shard{"80-": expected["80-"]}, resp.Shards)
+	assert.Empty(t, resp.NextPageToken)
+
+	_, err = vtctld.FindAllShardsInKeyspace(ctx, &vtctldatapb.FindAllShardsInKeyspaceRequest{Keyspace: ks.Name, PageToken: "not a token"})
+	assert.Error(t, err)
 
 	_, err = vtctld.FindAllShardsInKeyspace(ctx, &vtctldatapb.FindAllShardsInKeyspaceRequest{Keyspace: "nothing"})
 	assert.Error(t, err)
@@ -2930,6 +3363,36 @@ func TestGetBackups(t *testing.T) {
 	})
 }
 
+func TestGetDDLStatus(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1")
+	vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, nil, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+		return NewVtctldServer(ts)
+	})
+
+	status := &topodatapb.DDLStatus{
+		Uuid:     "uuid1",
+		Keyspace: "testkeyspace",
+		Sql:      "alter table t add c int",
+		Shards: []*topodatapb.DDLShardStatus{
+			{Shard: "-80", State: topodatapb.DDLShardStatus_COMPLETE},
+			{Shard: "80-", State: topodatapb.DDLShardStatus_FAILED, Error: "duplicate column name 'c'"},
+		},
+	}
+	require.NoError(t, ts.SaveDDLStatus(ctx, status))
+
+	resp, err := vtctld.GetDDLStatus(ctx, &vtctldatapb.GetDDLStatusRequest{Uuid: "uuid1"})
+	require.NoError(t, err)
+	utils.MustMatch(t, &vtctldatapb.GetDDLStatusResponse{Status: status}, resp)
+
+	_, err = vtctld.GetDDLStatus(ctx, &vtctldatapb.GetDDLStatusRequest{Uuid: "notfound"})
+	assert.EqualError(t, err, "no DDL with uuid notfound")
+	_, err = vtctld.GetDDLStatus(ctx, &vtctldatapb.GetDDLStatusRequest{})
+	assert.EqualError(t, err, "uuid is required")
+}
+
 func TestGetKeyspace(t *testing.T) {
 	t.Parallel()
 
@@ -2957,21 +3420,152 @@ func TestGetKeyspace(t *testing.T) {
 	assert.Error(t, err)
 }
 
-func TestGetCellInfoNames(t *testing.T) {
+func TestGetKeyspaceGraph(t *testing.T) {
 	t.Parallel()
 
 	ctx := context.Background()
-	ts := memorytopo.NewServer("cell1", "cell2", "cell3")
-	vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, nil, func(ts *topo.Server) vtctlservicepb.VtctldServer {
-		return NewVtctldServer(ts)
-	})
-
-	resp, err := vtctld.GetCellInfoNames(ctx, &vtctldatapb.GetCellInfoNamesRequest{})
-	assert.NoError(t, err)
-	assert.ElementsMatch(t, []string{"cell1", "cell2", "cell3"}, resp.Names)
-
-	ts = memorytopo.NewServer()
-	vtctld = testutil.NewVtctldServerWithTabletManagerClient(t, ts, nil, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+	ts := memorytopo.NewServer("zone1")
+	tmc := &testutil.TabletManagerClient{
+		ReplicationStatusResults: map[string]struct {
+			Position *replicationdatapb.Status
+			Error    error
+		}{
+			"zone1-0000000101": {
+				Position: &replicationdatapb.Status{
+					SourceHost:            "primary",
+					SourcePort:            3306,
+					IoThreadRunning:       true,
+					SqlThreadRunning:      true,
+					ReplicationLagSeconds: 2,
+				},
+			},
+			"zone1-0000000102": {
+				Position: &replicationdatapb.Status{
+					SourceHost:       "elsewhere",
+					SourcePort:       3306,
+					IoThreadRunning:  true,
+					SqlThreadRunning: true,
+				},
+			},
+			"zone1-0000000103": {
+				Error: assert.AnError,
+			},
+			"zone1-0000000200": {
+				Position: &replicationdatapb.Status{
+					SourceHost: "primary",
+					SourcePort: 3306,
+				},
+			},
+		},
+	}
+	vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, tmc, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+		return NewVtctldServer(ts)
+	})
+
+	tablets := []*topodatapb.Tablet{
+		{
+			Alias:         &topodatapb.TabletAlias{Cell: "zone1", Uid: 100},
+			Keyspace:      "testkeyspace",
+			Shard:         "-",
+			Type:          topodatapb.TabletType_PRIMARY,
+			MysqlHostname: "primary",
+			MysqlPort:     3306,
+		},
+		{
+			Alias:    &topodatapb.TabletAlias{Cell: "zone1", Uid: 101},
+			Keyspace: "testkeyspace",
+			Shard:    "-",
+			Type:     topodatapb.TabletType_REPLICA,
+		},
+		{
+			Alias:    &topodatapb.TabletAlias{Cell: "zone1", Uid: 102},
+			Keyspace: "testkeyspace",
+			Shard:    "-",
+			Type:     topodatapb.TabletType_REPLICA,
+		},
+		{
+			Alias:    &topodatapb.TabletAlias{Cell: "zone1", Uid: 103},
+			Keyspace: "testkeyspace",
+			Shard:    "-",
+			Type:     topodatapb.TabletType_RDONLY,
+		},
+	}
+	testutil.AddTablets(ctx, t, ts, &testutil.AddTabletOptions{AlsoSetShardPrimary: true}, tablets...)
+	testutil.AddTablet(ctx, t, ts, &topodatapb.Tablet{
+		Alias:    &topodatapb.TabletAlias{Cell: "zone1", Uid: 200},
+		Keyspace: "testkeyspace",
+		Shard:    "80-",
+		Type:     topodatapb.TabletType_REPLICA,
+	}, &testutil.AddTabletOptions{SkipShardCreation: true})
+
+	resp, err := vtctld.GetKeyspaceGraph(ctx, &vtctldatapb.GetKeyspaceGraphRequest{Keyspace: "testkeyspace"})
+	require.NoError(t, err)
+
+	graph := resp.Graph
+	require.Len(t, graph.Shards, 1)
+	assert.Equal(t, "-", graph.Shards[0].Name)
+	utils.MustMatch(t, tablets[0].Alias, graph.Shards[0].PrimaryAlias)
+	assert.Empty(t, graph.Shards[0].Problems)
+
+	problems := map[string][]string{}
+	for _, node := range graph.Tablets {
+		problems[topoproto.TabletAliasString(node.Tablet.Alias)] = node.Problems
+	}
+	assert.Equal(t, map[string][]string{
+		"zone1-0000000100": nil,
+		"zone1-0000000101": nil,
+		"zone1-0000000102": {"the tablet replicates from elsewhere:3306, which is not a tablet of the keyspace"},
+		"zone1-0000000103": nil,
+		"zone1-0000000200": {
+			"the tablet shard 80- is not a shard of the keyspace",
+			"the tablet replicates from zone1-0000000100, a tablet of shard -",
+			"the tablet replication is stopped",
+		},
+	}, problems)
+
+	for _, node := range graph.Tablets {
+		if topoproto.TabletAliasString(node.Tablet.Alias) == "zone1-0000000103" {
+			assert.Nil(t, node.ReplicationStatus)
+			assert.NotEmpty(t, node.ReplicationError)
+		}
+	}
+
+	utils.MustMatch(t, []*vtctldatapb.KeyspaceGraph_ReplicationEdge{
+		{
+			Source:                tablets[0].Alias,
+			Replica:               tablets[1].Alias,
+			ReplicationLagSeconds: 2,
+			IoThreadRunning:       true,
+			SqlThreadRunning:      true,
+		},
+		{
+			Source:  tablets[0].Alias,
+			Replica: &topodatapb.TabletAlias{Cell: "zone1", Uid: 200},
+		},
+	}, graph.Edges)
+
+	_, err = vtctld.GetKeyspaceGraph(ctx, &vtctldatapb.GetKeyspaceGraphRequest{})
+	assert.Error(t, err)
+
+	_, err = vtctld.GetKeyspaceGraph(ctx, &vtctldatapb.GetKeyspaceGraphRequest{Keyspace: "notfound"})
+	assert.Error(t, err)
+}
+
+func TestGetCellInfoNames(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1", "cell2", "cell3")
+	vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, nil, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+		return NewVtctldServer(ts)
+	})
+
+	resp, err := vtctld.GetCellInfoNames(ctx, &vtctldatapb.GetCellInfoNamesRequest{})
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{"cell1", "cell2", "cell3"}, resp.Names)
+
+	ts = memorytopo.NewServer()
+	vtctld = testutil.NewVtctldServerWithTabletManagerClient(t, ts, nil, func(ts *topo.Server) vtctlservicepb.VtctldServer {
 		return NewVtctldServer(ts)
 	})
 
@@ -3104,6 +3698,67 @@ func TestGetKeyspaces(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestGetRestoreProgress(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ts := memorytopo.NewServer("zone1")
+	progress := &tabletmanagerdatapb.RestoreProgress{
+		InProgress:    true,
+		FilesTotal:    10,
+		FilesRestored: 4,
+		BytesTotal:    1000,
+		BytesRestored: 400,
+		Elapsed:       &vttime.Duration{Seconds: 40},
+		Eta:           &vttime.Duration{Seconds: 60},
+	}
+	tmc := &testutil.TabletManagerClient{
+		GetRestoreProgressResults: map[string]struct {
+			Progress *tabletmanagerdatapb.RestoreProgress
+			Error    error
+		}{
+			"zone1-0000000100": {
+				Progress: progress,
+			},
+			"zone1-0000000101": {
+				Error: assert.AnError,
+			},
+		},
+	}
+	vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, tmc, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+		return NewVtctldServer(ts)
+	})
+	testutil.AddTablets(ctx, t, ts, nil, &topodatapb.Tablet{
+		Alias:    &topodatapb.TabletAlias{Cell: "zone1", Uid: 100},
+		Keyspace: "testkeyspace",
+		Shard:    "-",
+		Type:     topodatapb.TabletType_RESTORE,
+	}, &topodatapb.Tablet{
+		Alias:    &topodatapb.TabletAlias{Cell: "zone1", Uid: 101},
+		Keyspace: "testkeyspace",
+		Shard:    "-",
+		Type:     topodatapb.TabletType_RESTORE,
+	})
+
+	resp, err := vtctld.GetRestoreProgress(ctx, &vtctldatapb.GetRestoreProgressRequest{
+		TabletAlias: &topodatapb.TabletAlias{Cell: "zone1", Uid: 100},
+	})
+	require.NoError(t, err)
+	utils.MustMatch(t, progress, resp.Progress)
+
+	// The tablet fails to answer.
+	_, err = vtctld.GetRestoreProgress(ctx, &vtctldatapb.GetRestoreProgressRequest{
+		TabletAlias: &topodatapb.TabletAlias{Cell: "zone1", Uid: 101},
+	})
+	assert.Error(t, err)
+
+	// The tablet does not exist.
+	_, err = vtctld.GetRestoreProgress(ctx, &vtctldatapb.GetRestoreProgressRequest{
+		TabletAlias: &topodatapb.TabletAlias{Cell: "zone1", Uid: 102},
+	})
+	assert.Error(t, err)
+}
+
 func TestGetRoutingRules(t *testing.T) {
 	t.Parallel()
 
@@ -3674,6 +4329,40 @@ func TestGetSrvKeyspaces(t *testing.T) {
 			},
 			shouldErr: false,
 		},
+		{
+			name:  "filtering by tablet type",
+			cells: []string{"zone1"},
+			srvKeyspaces: []*testutil.SrvKeyspace{
+				{
+					Cell:     "zone1",
+					Keyspace: "testkeyspace",
+					SrvKeyspace: &topodatapb.SrvKeyspace{
+						Partitions: []*topodatapb.SrvKeyspace_KeyspacePartition{
+							{ServedType: topodatapb.TabletType_PRIMARY},
+							{ServedType: topodatapb.TabletType_REPLICA},
+							{ServedType: topodatapb.TabletType_RDONLY},
+						},
+						ShardingColumnName: "zone1-sharding-col",
+					},
+				},
+			},
+			req: &vtctldatapb.GetSrvKeyspacesRequest{
+				Keyspace:    "testkeyspace",
+				TabletTypes: []topodatapb.TabletType{topodatapb.TabletType_REPLICA, topodatapb.TabletType_RDONLY},
+			},
+			expected: &vtctldatapb.GetSrvKeyspacesResponse{
+				SrvKeyspaces: map[string]*topodatapb.SrvKeyspace{
+					"zone1": {
+						Partitions: []*topodatapb.SrvKeyspace_KeyspacePartition{
+							{ServedType: topodatapb.TabletType_REPLICA},
+							{ServedType: topodatapb.TabletType_RDONLY},
+						},
+						ShardingColumnName: "zone1-sharding-col",
+					},
+				},
+			},
+			shouldErr: false,
+		},
 		{
 			name:  "no srvkeyspace for single cell",
 			cells: []string{"zone1", "zone2"},
@@ -4503,6 +5192,56 @@ func TestGetTablets(t *testing.T) {
 			},
 			shouldErr: false,
 		},
+		{
+			name:  "tablet type and tag filter",
+			cells: []string{"cell1"},
+			tablets: []*topodatapb.Tablet{
+				{
+					Alias:    &topodatapb.TabletAlias{Cell: "cell1", Uid: 100},
+					Keyspace: "ks1",
+					Shard:    "-",
+					Type:     topodatapb.TabletType_REPLICA,
+					Tags:     map[string]string{"rack": "r1"},
+				},
+				{
+					Alias:    &topodatapb.TabletAlias{Cell: "cell1", Uid: 101},
+					Keyspace: "ks1",
+					Shard:    "-",
+					Type:     topodatapb.TabletType_REPLICA,
+					Tags:     map[string]string{"rack": "r2"},
+				},
+				{
+					Alias:    &topodatapb.TabletAlias{Cell: "cell1", Uid: 102},
+					Keyspace: "ks1",
+					Shard:    "-",
+					Type:     topodatapb.TabletType_RDONLY,
+					Tags:     map[string]string{"rack": "r1"},
+				},
+			},
+			req: &vtctldatapb.GetTabletsRequest{
+				TabletTypes: []topodatapb.TabletType{topodatapb.TabletType_REPLICA},
+				Tags:        map[string]string{"rack": "r1"},
+			},
+			expected: []*topodatapb.Tablet{
+				{
+					Alias:    &topodatapb.TabletAlias{Cell: "cell1", Uid: 100},
+					Keyspace: "ks1",
+					Shard:    "-",
+					Type:     topodatapb.TabletType_REPLICA,
+					Tags:     map[string]string{"rack": "r1"},
+				},
+			},
+			shouldErr: false,
+		},
+		{
+			name:    "invalid page token",
+			cells:   []string{"cell1"},
+			tablets: []*topodatapb.Tablet{},
+			req: &vtctldatapb.GetTabletsRequest{
+				PageToken: "not a token",
+			},
+			shouldErr: true,
+		},
 		{
 			name:    "tablet alias filter with none found",
 			tablets: []*topodatapb.Tablet{},
@@ -4545,6 +5284,103 @@ func TestGetTablets(t *testing.T) {
 	}
 }
 
+func TestGetTabletsPagination(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ts := memorytopo.NewServer("zone1", "zone2")
+	vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, nil, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+		return NewVtctldServer(ts)
+	})
+
+	testutil.AddTablets(ctx, t, ts, nil, &topodatapb.Tablet{
+		Alias:    &topodatapb.TabletAlias{Cell: "zone2", Uid: 100},
+		Hostname: "host-a",
+		Keyspace: "ks",
+		Shard:    "-80",
+		Type:     topodatapb.TabletType_REPLICA,
+	}, &topodatapb.Tablet{
+		Alias:    &topodatapb.TabletAlias{Cell: "zone1", Uid: 200},
+		Hostname: "host-c",
+		Keyspace: "ks",
+		Shard:    "80-",
+		Type:     topodatapb.TabletType_RDONLY,
+	}, &topodatapb.Tablet{
+		Alias:    &topodatapb.TabletAlias{Cell: "zone1", Uid: 100},
+		Hostname: "host-b",
+		Keyspace: "ks",
+		Shard:    "80-",
+		Type:     topodatapb.TabletType_REPLICA,
+	})
+
+	tests := []struct {
+		name      string
+		req       *vtctldatapb.GetTabletsRequest
+		expected  [][]string
+		totalSize int32
+	}{
+		{
+			name:      "pages are sorted by alias by default",
+			req:       &vtctldatapb.GetTabletsRequest{PageSize: 2},
+			expected:  [][]string{{"zone1-0000000100", "zone1-0000000200"}, {"zone2-0000000100"}},
+			totalSize: 3,
+		},
+		{
+			name:      "sort by keyspace and shard",
+			req:       &vtctldatapb.GetTabletsRequest{SortBy: vtctldatapb.GetTabletsRequest_KEYSPACE_SHARD},
+			expected:  [][]string{{"zone2-0000000100", "zone1-0000000100", "zone1-0000000200"}},
+			totalSize: 3,
+		},
+		{
+			name:      "sort by type",
+			req:       &vtctldatapb.GetTabletsRequest{SortBy: vtctldatapb.GetTabletsRequest_TYPE, PageSize: 1},
+			expected:  [][]string{{"zone1-0000000100"}, {"zone2-0000000100"}, {"zone1-0000000200"}},
+			totalSize: 3,
+		},
+		{
+			name:      "sort by hostname descending",
+			req:       &vtctldatapb.GetTabletsRequest{SortBy: vtctldatapb.GetTabletsRequest_HOSTNAME, SortDescending: true, PageSize: 3},
+			expected:  [][]string{{"zone1-0000000200", "zone1-0000000100", "zone2-0000000100"}},
+			totalSize: 3,
+		},
+		{
+			name:      "pages of a cell",
+			req:       &vtctldatapb.GetTabletsRequest{Cells: []string{"zone1"}, PageSize: 1},
+			expected:  [][]string{{"zone1-0000000100"}, {"zone1-0000000200"}},
+			totalSize: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := proto.Clone(tt.req).(*vtctldatapb.GetTabletsRequest)
+			var pages [][]string
+			for {
+				resp, err := vtctld.GetTablets(ctx, req)
+				require.NoError(t, err)
+
+				var page []string
+				for _, tablet := range resp.Tablets {
+					page = append(page, topoproto.TabletAliasString(tablet.Alias))
+				}
+				pages = append(pages, page)
+				assert.Equal(t, tt.totalSize, resp.TotalSize)
+
+				if resp.NextPageToken == "" {
+					break
+				}
+				req.PageToken = resp.NextPageToken
+			}
+
+			assert.Equal(t, tt.expected, pages)
+		})
+	}
+}
+
 func TestGetVSchema(t *testing.T) {
 	t.Parallel()
 
@@ -4595,41 +5431,286 @@ func TestGetVSchema(t *testing.T) {
 	})
 }
 
-func TestPlannedReparentShard(t *testing.T) {
+// fakeWorkflowManager is a WorkflowManager which records the requests it
+// is passed.
+type fakeWorkflowManager struct {
+	WorkflowManager
+
+	moveTablesCreateRequests      []*vtctldatapb.MoveTablesCreateRequest
+	referenceTablesCreateRequests []*vtctldatapb.ReferenceTablesCreateRequest
+	workflowPauseRequests         []*vtctldatapb.WorkflowPauseRequest
+	workflowSwitchTrafficRequests []*vtctldatapb.WorkflowSwitchTrafficRequest
+}
+
+func (wm *fakeWorkflowManager) MoveTablesCreate(ctx context.Context, req *vtctldatapb.MoveTablesCreateRequest) (*vtctldatapb.MoveTablesCreateResponse, error) {
+	wm.moveTablesCreateRequests = append(wm.moveTablesCreateRequests, req)
+	return &vtctldatapb.MoveTablesCreateResponse{}, nil
+}
+
+func (wm *fakeWorkflowManager) ReferenceTablesCreate(ctx context.Context, req *vtctldatapb.ReferenceTablesCreateRequest) (*vtctldatapb.ReferenceTablesCreateResponse, error) {
+	wm.referenceTablesCreateRequests = append(wm.referenceTablesCreateRequests, req)
+	return &vtctldatapb.ReferenceTablesCreateResponse{}, nil
+}
+
+func (wm *fakeWorkflowManager) WorkflowPause(ctx context.Context, req *vtctldatapb.WorkflowPauseRequest) (*vtctldatapb.WorkflowPauseResponse, error) {
+	wm.workflowPauseRequests = append(wm.workflowPauseRequests, req)
+	return &vtctldatapb.WorkflowPauseResponse{}, nil
+}
+
+func (wm *fakeWorkflowManager) WorkflowSwitchTraffic(ctx context.Context, req *vtctldatapb.WorkflowSwitchTrafficRequest) (*vtctldatapb.WorkflowSwitchTrafficResponse, error) {
+	wm.workflowSwitchTrafficRequests = append(wm.workflowSwitchTrafficRequests, req)
+	return &vtctldatapb.WorkflowSwitchTrafficResponse{}, nil
+}
+
+// setFakeWorkflowManager registers wm as the WorkflowManager, or none if wm
+// is nil, for the duration of the test. Such tests cannot be parallel.
+func setFakeWorkflowManager(t *testing.T, wm *fakeWorkflowManager) {
+	t.Helper()
+
+	oldFactory := workflowManagerFactory
+	t.Cleanup(func() { workflowManagerFactory = oldFactory })
+
+	workflowManagerFactory = nil
+	if wm != nil {
+		workflowManagerFactory = func(ts *topo.Server, tmc tmclient.TabletManagerClient) WorkflowManager { return wm }
+	}
+}
+
+func TestKillQueries(t *testing.T) {
 	t.Parallel()
 
-	tests := []struct {
-		name    string
-		ts      *topo.Server
-		tmc     tmclient.TabletManagerClient
-		tablets []*topodatapb.Tablet
+	ctx := context.Background()
+	ts := memorytopo.NewServer("zone1")
+	tmc := &testutil.TabletManagerClient{
+		KillQueriesResults: map[string]struct {
+			Killed int64
+			Error  error
+		}{
+			"zone1-0000000100": {Killed: 2},
+			"zone1-0000000200": {Error: assert.AnError},
+		},
+	}
+	vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, tmc, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+		return NewVtctldServer(ts)
+	})
 
-		req                 *vtctldatapb.PlannedReparentShardRequest
-		expected            *vtctldatapb.PlannedReparentShardResponse
-		expectEventsToOccur bool
-		shouldErr           bool
-	}{
-		{
-			name: "successful reparent",
-			ts:   memorytopo.NewServer("zone1"),
-			tablets: []*topodatapb.Tablet{
-				{
-					Alias: &topodatapb.TabletAlias{
-						Cell: "zone1",
-						Uid:  100,
-					},
-					Type: topodatapb.TabletType_PRIMARY,
-					PrimaryTermStartTime: &vttime.Time{
-						Seconds: 100,
-					},
-					Keyspace: "testkeyspace",
-					Shard:    "-",
-				},
-				{
-					Alias: &topodatapb.TabletAlias{
-						Cell: "zone1",
-						Uid:  200,
-					},
+	testutil.AddKeyspace(ctx, t, ts, &vtctldatapb.Keyspace{
+		Name: "testkeyspace",
+		Keyspace: &topodatapb.Keyspace{
+			Policy: &topodatapb.KeyspacePolicy{
+				MaxRows: 100,
+				DeniedQueries: []*topodatapb.DeniedQuery{{
+					User:       "expired",
+					ExpireTime: protoutil.TimeToProto(time.Now().Add(-time.Minute)),
+				}},
+			},
+		},
+	})
+	require.NoError(t, ts.UpdateSrvKeyspace(ctx, "zone1", "testkeyspace", &topodatapb.SrvKeyspace{}))
+	testutil.AddTablets(ctx, t, ts, nil, &topodatapb.Tablet{
+		Alias:    &topodatapb.TabletAlias{Cell: "zone1", Uid: 100},
+		Keyspace: "testkeyspace",
+		Shard:    "-80",
+	}, &topodatapb.Tablet{
+		Alias:    &topodatapb.TabletAlias{Cell: "zone1", Uid: 200},
+		Keyspace: "testkeyspace",
+		Shard:    "80-",
+	})
+
+	for _, invalid := range []*vtctldatapb.KillQueriesRequest{
+		{Fingerprint: "f1"},
+		{Keyspace: "testkeyspace"},
+		{Keyspace: "testkeyspace", Fingerprint: "f1", DenyDuration: protoutil.DurationToProto(-time.Minute)},
+	} {
+		_, err := vtctld.KillQueries(ctx, invalid)
+		assert.Error(t, err, "request %+v", invalid)
+	}
+
+	// Without a deny duration, the policy is unchanged.
+	resp, err := vtctld.KillQueries(ctx, &vtctldatapb.KillQueriesRequest{
+		Keyspace:    "testkeyspace",
+		Fingerprint: "f1",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int64{"zone1-0000000100": 2}, resp.KilledQueries)
+	assert.Contains(t, resp.TabletErrors, "zone1-0000000200")
+	assert.Nil(t, resp.Policy)
+
+	resp, err = vtctld.KillQueries(ctx, &vtctldatapb.KillQueriesRequest{
+		Keyspace:     "testkeyspace",
+		Fingerprint:  "f1",
+		User:         "app",
+		DenyDuration: protoutil.DurationToProto(time.Hour),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int64{"zone1-0000000100": 2}, resp.KilledQueries)
+	// The expired denied query is dropped.
+	require.Len(t, resp.Policy.DeniedQueries, 1)
+	assert.Equal(t, "f1", resp.Policy.DeniedQueries[0].Fingerprint)
+	assert.Equal(t, "app", resp.Policy.DeniedQueries[0].User)
+	assert.EqualValues(t, 100, resp.Policy.MaxRows)
+
+	ki, err := ts.GetKeyspace(ctx, "testkeyspace")
+	require.NoError(t, err)
+	utils.MustMatch(t, resp.Policy, ki.Policy)
+	srvKeyspace, err := ts.GetSrvKeyspace(ctx, "zone1", "testkeyspace")
+	require.NoError(t, err)
+	utils.MustMatch(t, resp.Policy, srvKeyspace.Policy)
+
+	// SetKeyspacePolicy keeps the denied queries.
+	_, err = vtctld.SetKeyspacePolicy(ctx, &vtctldatapb.SetKeyspacePolicyRequest{
+		Keyspace: "testkeyspace",
+	})
+	require.NoError(t, err)
+	srvKeyspace, err = ts.GetSrvKeyspace(ctx, "zone1", "testkeyspace")
+	require.NoError(t, err)
+	utils.MustMatch(t, &topodatapb.KeyspacePolicy{DeniedQueries: resp.Policy.DeniedQueries}, srvKeyspace.Policy)
+}
+
+func TestMoveTablesCreate(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("zone1")
+	testutil.AddKeyspace(ctx, t, ts, &vtctldatapb.Keyspace{Name: "targetks", Keyspace: &topodatapb.Keyspace{}})
+	vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, nil, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+		return NewVtctldServer(ts)
+	})
+
+	tests := []struct {
+		name      string
+		req       *vtctldatapb.MoveTablesCreateRequest
+		shouldErr bool
+	}{
+		{
+			name: "include tables",
+			req: &vtctldatapb.MoveTablesCreateRequest{
+				Workflow:       "wf",
+				SourceKeyspace: "sourceks",
+				TargetKeyspace: "targetks",
+				IncludeTables:  []string{"t1"},
+			},
+		},
+		{
+			name: "all tables",
+			req: &vtctldatapb.MoveTablesCreateRequest{
+				Workflow:       "wf",
+				SourceKeyspace: "sourceks",
+				TargetKeyspace: "targetks",
+				AllTables:      true,
+				ExcludeTables:  []string{"t2"},
+			},
+		},
+		{
+			name: "no workflow",
+			req: &vtctldatapb.MoveTablesCreateRequest{
+				SourceKeyspace: "sourceks",
+				TargetKeyspace: "targetks",
+				IncludeTables:  []string{"t1"},
+			},
+			shouldErr: true,
+		},
+		{
+			name: "no tables",
+			req: &vtctldatapb.MoveTablesCreateRequest{
+				Workflow:       "wf",
+				SourceKeyspace: "sourceks",
+				TargetKeyspace: "targetks",
+			},
+			shouldErr: true,
+		},
+		{
+			name: "all tables and include tables",
+			req: &vtctldatapb.MoveTablesCreateRequest{
+				Workflow:       "wf",
+				SourceKeyspace: "sourceks",
+				TargetKeyspace: "targetks",
+				IncludeTables:  []string{"t1"},
+				AllTables:      true,
+			},
+			shouldErr: true,
+		},
+		{
+			name: "exclude tables without all tables",
+			req: &vtctldatapb.MoveTablesCreateRequest{
+				Workflow:       "wf",
+				SourceKeyspace: "sourceks",
+				TargetKeyspace: "targetks",
+				IncludeTables:  []string{"t1"},
+				ExcludeTables:  []string{"t2"},
+			},
+			shouldErr: true,
+		},
+		{
+			name: "target keyspace does not exist",
+			req: &vtctldatapb.MoveTablesCreateRequest{
+				Workflow:       "wf",
+				SourceKeyspace: "sourceks",
+				TargetKeyspace: "otherks",
+				IncludeTables:  []string{"t1"},
+			},
+			shouldErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wm := &fakeWorkflowManager{}
+			setFakeWorkflowManager(t, wm)
+
+			_, err := vtctld.MoveTablesCreate(ctx, tt.req)
+			if tt.shouldErr {
+				assert.Error(t, err)
+				assert.Empty(t, wm.moveTablesCreateRequests)
+				return
+			}
+
+			require.NoError(t, err)
+			utils.MustMatch(t, []*vtctldatapb.MoveTablesCreateRequest{tt.req}, wm.moveTablesCreateRequests)
+		})
+	}
+
+	t.Run("no workflow manager", func(t *testing.T) {
+		setFakeWorkflowManager(t, nil)
+
+		_, err := vtctld.MoveTablesCreate(ctx, tests[0].req)
+		assert.Equal(t, vtrpc.Code_UNIMPLEMENTED, vterrors.Code(err), "error: %v", err)
+	})
+}
+
+func TestPlannedReparentShard(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		ts      *topo.Server
+		tmc     tmclient.TabletManagerClient
+		tablets []*topodatapb.Tablet
+
+		req                 *vtctldatapb.PlannedReparentShardRequest
+		expected            *vtctldatapb.PlannedReparentShardResponse
+		expectEventsToOccur bool
+		shouldErr           bool
+	}{
+		{
+			name: "successful reparent",
+			ts:   memorytopo.NewServer("zone1"),
+			tablets: []*topodatapb.Tablet{
+				{
+					Alias: &topodatapb.TabletAlias{
+						Cell: "zone1",
+						Uid:  100,
+					},
+					Type: topodatapb.TabletType_PRIMARY,
+					PrimaryTermStartTime: &vttime.Time{
+						Seconds: 100,
+					},
+					Keyspace: "testkeyspace",
+					Shard:    "-",
+				},
+				{
+					Alias: &topodatapb.TabletAlias{
+						Cell: "zone1",
+						Uid:  200,
+					},
 					Type:     topodatapb.TabletType_REPLICA,
 					Keyspace: "testkeyspace",
 					Shard:    "-",
@@ -4781,6 +5862,69 @@ func TestPlannedReparentShard(t *testing.T) {
 	}
 }
 
+func TestPruneBackups(t *testing.T) {
+	// Not parallel, since TestGetBackups replaces the backups of the shared
+	// testutil.BackupStorage.
+	ctx := context.Background()
+	ts := memorytopo.NewServer("zone1")
+	vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, nil, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+		return NewVtctldServer(ts)
+	})
+
+	now := time.Now().UTC()
+	backupName := func(age time.Duration) string {
+		return fmt.Sprintf("%s.zone1-0000000100", now.Add(-age).Format(mysqlctl.BackupTimestampFormat))
+	}
+	names := []string{backupName(72 * time.Hour), backupName(48 * time.Hour), backupName(time.Hour)}
+	testutil.BackupStorage.Backups["pruneks/-"] = append([]string{}, names...)
+
+	t.Run("invalid policy", func(t *testing.T) {
+		_, err := vtctld.PruneBackups(ctx, &vtctldatapb.PruneBackupsRequest{
+			Keyspace: "pruneks",
+			Shard:    "-",
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		resp, err := vtctld.PruneBackups(ctx, &vtctldatapb.PruneBackupsRequest{
+			Keyspace: "pruneks",
+			Shard:    "-",
+			KeepLast: 1,
+			MaxAge:   protoutil.DurationToProto(60 * time.Hour),
+			DryRun:   true,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, names[:1], resp.PrunedBackups)
+		assert.Equal(t, names, testutil.BackupStorage.Backups["pruneks/-"])
+	})
+
+	t.Run("prune", func(t *testing.T) {
+		resp, err := vtctld.PruneBackups(ctx, &vtctldatapb.PruneBackupsRequest{
+			Keyspace: "pruneks",
+			Shard:    "-",
+			KeepLast: 1,
+			MaxAge:   protoutil.DurationToProto(60 * time.Hour),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, names[:1], resp.PrunedBackups)
+		assert.Equal(t, names[1:], testutil.BackupStorage.Backups["pruneks/-"])
+
+	})
+
+	t.Run("remove error", func(t *testing.T) {
+		testutil.BackupStorage.RemoveBackupError = assert.AnError
+		defer func() { testutil.BackupStorage.RemoveBackupError = nil }()
+
+		_, err := vtctld.PruneBackups(ctx, &vtctldatapb.PruneBackupsRequest{
+			Keyspace: "pruneks",
+			Shard:    "-",
+			KeepLast: 1,
+		})
+		assert.Error(t, err)
+	})
+}
+
 func TestRebuildVSchemaGraph(t *testing.T) {
 	t.Parallel()
 
@@ -4825,6 +5969,73 @@ func TestRebuildVSchemaGraph(t *testing.T) {
 	}
 }
 
+func TestReferenceTablesCreate(t *testing.T) {
+	ctx := context.Background()
+	vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, memorytopo.NewServer("zone1"), nil, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+		return NewVtctldServer(ts)
+	})
+
+	tests := []struct {
+		name      string
+		req       *vtctldatapb.ReferenceTablesCreateRequest
+		shouldErr bool
+	}{
+		{
+			name: "ok",
+			req: &vtctldatapb.ReferenceTablesCreateRequest{
+				Workflow:        "wf",
+				SourceKeyspace:  "sourceks",
+				TargetKeyspaces: []string{"targetks1", "targetks2"},
+				Tables:          []string{"t1"},
+			},
+		},
+		{
+			name: "no workflow",
+			req: &vtctldatapb.ReferenceTablesCreateRequest{
+				SourceKeyspace:  "sourceks",
+				TargetKeyspaces: []string{"targetks1"},
+				Tables:          []string{"t1"},
+			},
+			shouldErr: true,
+		},
+		{
+			name: "no target keyspaces",
+			req: &vtctldatapb.ReferenceTablesCreateRequest{
+				Workflow:       "wf",
+				SourceKeyspace: "sourceks",
+				Tables:         []string{"t1"},
+			},
+			shouldErr: true,
+		},
+		{
+			name: "no tables",
+			req: &vtctldatapb.ReferenceTablesCreateRequest{
+				Workflow:        "wf",
+				SourceKeyspace:  "sourceks",
+				TargetKeyspaces: []string{"targetks1"},
+			},
+			shouldErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wm := &fakeWorkflowManager{}
+			setFakeWorkflowManager(t, wm)
+
+			_, err := vtctld.ReferenceTablesCreate(ctx, tt.req)
+			if tt.shouldErr {
+				assert.Equal(t, vtrpc.Code_INVALID_ARGUMENT, vterrors.Code(err), "error: %v", err)
+				assert.Empty(t, wm.referenceTablesCreateRequests)
+				return
+			}
+
+			require.NoError(t, err)
+			utils.MustMatch(t, []*vtctldatapb.ReferenceTablesCreateRequest{tt.req}, wm.referenceTablesCreateRequests)
+		})
+	}
+}
+
 func TestRefreshState(t *testing.T) {
 	t.Parallel()
 
@@ -5113,6 +6324,110 @@ func TestRefreshStateByShard(t *testing.T) {
 	}
 }
 
+func TestRegisterExternalMysql(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ts := memorytopo.NewServer("zone1")
+	vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, nil, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+		return NewVtctldServer(ts)
+	})
+	err := ts.CreateExternalVitessCluster(ctx, "vitess", &topodatapb.ExternalVitessCluster{})
+	require.NoError(t, err)
+
+	req := func(name string) *vtctldatapb.RegisterExternalMysqlRequest {
+		return &vtctldatapb.RegisterExternalMysqlRequest{
+			Name:            name,
+			Host:            "127.0.0.1",
+			Port:            1,
+			DbName:          "commerce",
+			User:            "vt_repl",
+			Password:        "secret",
+			SkipHealthCheck: true,
+		}
+	}
+	invalid := []func(*vtctldatapb.RegisterExternalMysqlRequest){
+		func(r *vtctldatapb.RegisterExternalMysqlRequest) { r.Name = "" },
+		func(r *vtctldatapb.RegisterExternalMysqlRequest) { r.Host = "" },
+		func(r *vtctldatapb.RegisterExternalMysqlRequest) { r.Port = 0 },
+		func(r *vtctldatapb.RegisterExternalMysqlRequest) { r.DbName = "" },
+		func(r *vtctldatapb.RegisterExternalMysqlRequest) { r.User = "" },
+	}
+	for _, modify := range invalid {
+		r := req("ext")
+		modify(r)
+		_, err = vtctld.RegisterExternalMysql(ctx, r)
+		assert.Equal(t, vtrpc.Code_INVALID_ARGUMENT, vterrors.Code(err), "%v: %v", r, err)
+	}
+
+	_, err = vtctld.RegisterExternalMysql(ctx, req("vitess"))
+	assert.Equal(t, vtrpc.Code_ALREADY_EXISTS, vterrors.Code(err), "%v", err)
+
+	// Nothing listens on the port.
+	unreachable := req("ext")
+	unreachable.SkipHealthCheck = false
+	_, err = vtctld.RegisterExternalMysql(ctx, unreachable)
+	assert.Equal(t, vtrpc.Code_FAILED_PRECONDITION, vterrors.Code(err), "%v", err)
+
+	_, err = vtctld.RegisterExternalMysql(ctx, req("ext"))
+	require.NoError(t, err)
+	_, err = vtctld.RegisterExternalMysql(ctx, req("ext"))
+	assert.Equal(t, vtrpc.Code_ALREADY_EXISTS, vterrors.Code(err), "%v", err)
+
+	em, err := ts.GetExternalMysql(ctx, "ext")
+	require.NoError(t, err)
+	assert.Equal(t, "secret", em.Password)
+
+	resp, err := vtctld.GetExternalMysqls(ctx, &vtctldatapb.GetExternalMysqlsRequest{})
+	require.NoError(t, err)
+	utils.MustMatch(t, []*vtctldatapb.ExternalMysql{{
+		Name:   "ext",
+		Host:   "127.0.0.1",
+		Port:   1,
+		DbName: "commerce",
+		User:   "vt_repl",
+	}}, resp.ExternalMysqls)
+
+	resp, err = vtctld.GetExternalMysqls(ctx, &vtctldatapb.GetExternalMysqlsRequest{Names: []string{"ext"}, CheckHealth: true})
+	require.NoError(t, err)
+	require.Len(t, resp.ExternalMysqls, 1)
+	assert.False(t, resp.ExternalMysqls[0].Health.Healthy)
+	assert.Contains(t, resp.ExternalMysqls[0].Health.Error, "cannot connect to 127.0.0.1")
+
+	_, err = vtctld.GetExternalMysqls(ctx, &vtctldatapb.GetExternalMysqlsRequest{Names: []string{"nope"}})
+	assert.Equal(t, vtrpc.Code_NOT_FOUND, vterrors.Code(err), "%v", err)
+
+	_, err = vtctld.DeregisterExternalMysql(ctx, &vtctldatapb.DeregisterExternalMysqlRequest{Name: "ext"})
+	require.NoError(t, err)
+	_, err = vtctld.DeregisterExternalMysql(ctx, &vtctldatapb.DeregisterExternalMysqlRequest{Name: "ext"})
+	assert.Equal(t, vtrpc.Code_NOT_FOUND, vterrors.Code(err), "%v", err)
+	names, err := ts.GetExternalMysqls(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, names)
+}
+
+func TestCheckExternalMysqlHealth(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db := fakesqldb.New(t)
+	defer db.Close()
+
+	fields := sqltypes.MakeTestFields("gtid_mode|binlog_format|binlog_row_image|read_only|gtid_executed", "varchar|varchar|varchar|int64|varchar")
+	db.AddQuery(externalMysqlSettingsQuery, sqltypes.MakeTestResult(fields, "ON|ROW|FULL|1|16b1039f-22b6-11ed-b765-0a43f95f28a3:1-10"))
+	health := checkExternalMysqlHealth(ctx, db.ConnParams())
+	utils.MustMatch(t, &vtctldatapb.ExternalMysqlHealth{
+		Healthy:  true,
+		Position: "MySQL56/16b1039f-22b6-11ed-b765-0a43f95f28a3:1-10",
+		ReadOnly: true,
+	}, health)
+
+	db.AddQuery(externalMysqlSettingsQuery, sqltypes.MakeTestResult(fields, "ON|STATEMENT|FULL|0|16b1039f-22b6-11ed-b765-0a43f95f28a3:1-10"))
+	health = checkExternalMysqlHealth(ctx, db.ConnParams())
+	assert.False(t, health.Healthy)
+	assert.Equal(t, "binlog_format is STATEMENT, vreplication requires ROW", health.Error)
+}
+
 func TestRemoveKeyspaceCell(t *testing.T) {
 	t.Parallel()
 
@@ -5301,6 +6616,53 @@ func TestRemoveKeyspaceCell(t *testing.T) {
 	}
 }
 
+func TestRemoveQueryRule(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ts := memorytopo.NewServer("zone1")
+	vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, nil, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+		return NewVtctldServer(ts)
+	})
+
+	deny := &topodatapb.QueryRule{Name: "deny", Query: "select .* from t1", Action: "FAIL"}
+	allow := &topodatapb.QueryRule{Name: "allow", User: "admin", Action: "ALLOW"}
+	testutil.AddKeyspace(ctx, t, ts, &vtctldatapb.Keyspace{
+		Name:     "testkeyspace",
+		Keyspace: &topodatapb.Keyspace{QueryRules: []*topodatapb.QueryRule{allow, deny}},
+	})
+	require.NoError(t, ts.UpdateSrvKeyspace(ctx, "zone1", "testkeyspace", &topodatapb.SrvKeyspace{
+		QueryRules: []*topodatapb.QueryRule{allow, deny},
+	}))
+
+	resp, err := vtctld.RemoveQueryRule(ctx, &vtctldatapb.RemoveQueryRuleRequest{
+		Keyspace: "testkeyspace",
+		Name:     "allow",
+	})
+	require.NoError(t, err)
+	utils.MustMatch(t, []*topodatapb.QueryRule{deny}, resp.Rules)
+	srvKeyspace, err := ts.GetSrvKeyspace(ctx, "zone1", "testkeyspace")
+	require.NoError(t, err)
+	utils.MustMatch(t, []*topodatapb.QueryRule{deny}, srvKeyspace.QueryRules)
+
+	_, err = vtctld.RemoveQueryRule(ctx, &vtctldatapb.RemoveQueryRuleRequest{
+		Keyspace: "testkeyspace",
+		Name:     "allow",
+	})
+	assert.Error(t, err)
+	_, err = vtctld.RemoveQueryRule(ctx, &vtctldatapb.RemoveQueryRuleRequest{
+		Keyspace: "testkeyspace",
+	})
+	assert.Error(t, err)
+
+	resp, err = vtctld.RemoveQueryRule(ctx, &vtctldatapb.RemoveQueryRuleRequest{
+		Keyspace: "testkeyspace",
+		Name:     "deny",
+	})
+	require.NoError(t, err)
+	assert.Empty(t, resp.Rules)
+}
+
 func TestRemoveShardCell(t *testing.T) {
 	t.Parallel()
 
@@ -5605,14 +6967,171 @@ func TestRemoveShardCell(t *testing.T) {
 	}
 }
 
-func TestReparentTablet(t *testing.T) {
+func TestRepairReplication(t *testing.T) {
 	t.Parallel()
 
+	const (
+		primaryUUID = "00000000-0000-0000-0000-000000000001"
+		errantUUID  = "00000000-0000-0000-0000-000000000002"
+	)
+	replicating := func(host string, gtids string) *replicationdatapb.Status {
+		return &replicationdatapb.Status{
+			Position:         "MySQL56/" + gtids,
+			SourceHost:       host,
+			SourcePort:       3306,
+			IoThreadRunning:  true,
+			SqlThreadRunning: true,
+		}
+	}
+	stopped := replicating("primary", primaryUUID+":1-9")
+	stopped.IoThreadRunning = false
+	stopped.SqlThreadRunning = false
+
+	tmc := &testutil.TabletManagerClient{
+		ExecuteFetchAsDbaResults: map[string]struct {
+			Response *querypb.QueryResult
+			Error    error
+		}{
+			"zone1-0000000100": {
+				Response: sqltypes.ResultToProto3(sqltypes.MakeTestResult(sqltypes.MakeTestFields("@@global.gtid_purged", "varchar"), primaryUUID+":1-5")),
+			},
+		},
+		MasterPositionResults: map[string]struct {
+			Position string
+			Error    error
+		}{
+			"zone1-0000000100": {
+				Position: "MySQL56/" + primaryUUID + ":1-10",
+			},
+		},
+		ReplicationStatusResults: map[string]struct {
+			Position *replicationdatapb.Status
+			Error    error
+		}{
+			// healthy
+			"zone1-0000000101": {Position: replicating("primary", primaryUUID+":1-10")},
+			// replicates from the wrong source
+			"zone1-0000000102": {Position: replicating("other", primaryUUID+":1-8")},
+			// stopped replication
+			"zone1-0000000103": {Position: stopped},
+			// has errant GTIDs
+			"zone1-0000000104": {Position: replicating("primary", primaryUUID+":1-10,"+errantUUID+":1")},
+			// replicates from the wrong source, and misses purged GTIDs
+			"zone1-0000000105": {Position: replicating("other", primaryUUID+":1-3")},
+			"zone1-0000000106": {Error: assert.AnError},
+		},
+		SetMasterResults: map[string]error{
+			"zone1-0000000102": nil,
+		},
+		StartReplicationResults: map[string]error{
+			"zone1-0000000103": assert.AnError,
+		},
+	}
+
 	tests := []struct {
 		name      string
-		tmc       tmclient.TabletManagerClient
-		tablets   []*topodatapb.Tablet
-		shards    []*vtctldatapb.Shard
+		req       *vtctldatapb.RepairReplicationRequest
+		expected  []string
+		shouldErr bool
+	}{
+		{
+			name: "repair",
+			req: &vtctldatapb.RepairReplicationRequest{
+				Keyspace: "testkeyspace",
+				Shard:    "-",
+			},
+			expected: []string{
+				"zone1-0000000102 WRONG_SOURCE REPAIRED",
+				"zone1-0000000103 NOT_REPLICATING FAILED",
+				"zone1-0000000104 ERRANT_GTIDS MANUAL",
+				"zone1-0000000105 WRONG_SOURCE MANUAL",
+				"zone1-0000000105 PURGED_GTIDS MANUAL",
+				"zone1-0000000106 UNREACHABLE MANUAL",
+			},
+		},
+		{
+			name: "dry run",
+			req: &vtctldatapb.RepairReplicationRequest{
+				Keyspace: "testkeyspace",
+				Shard:    "-",
+				DryRun:   true,
+			},
+			expected: []string{
+				"zone1-0000000102 WRONG_SOURCE DRY_RUN",
+				"zone1-0000000103 NOT_REPLICATING DRY_RUN",
+				"zone1-0000000104 ERRANT_GTIDS MANUAL",
+				"zone1-0000000105 WRONG_SOURCE MANUAL",
+				"zone1-0000000105 PURGED_GTIDS MANUAL",
+				"zone1-0000000106 UNREACHABLE MANUAL",
+			},
+		},
+		{
+			name: "no shard",
+			req: &vtctldatapb.RepairReplicationRequest{
+				Keyspace: "testkeyspace",
+				Shard:    "80-",
+			},
+			shouldErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			ts := memorytopo.NewServer("zone1")
+			vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, tmc, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+				return NewVtctldServer(ts)
+			})
+
+			tablets := []*topodatapb.Tablet{{
+				Alias:         &topodatapb.TabletAlias{Cell: "zone1", Uid: 100},
+				Type:          topodatapb.TabletType_PRIMARY,
+				MysqlHostname: "primary",
+				MysqlPort:     3306,
+			}}
+			for uid := uint32(101); uid <= 106; uid++ {
+				tablets = append(tablets, &topodatapb.Tablet{
+					Alias: &topodatapb.TabletAlias{Cell: "zone1", Uid: uid},
+					Type:  topodatapb.TabletType_REPLICA,
+				})
+			}
+			for _, tablet := range tablets {
+				tablet.Keyspace = "testkeyspace"
+				tablet.Shard = "-"
+			}
+			testutil.AddTablets(ctx, t, ts, &testutil.AddTabletOptions{
+				AlsoSetShardPrimary: true,
+			}, tablets...)
+
+			resp, err := vtctld.RepairReplication(ctx, tt.req)
+			if tt.shouldErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			var problems []string
+			for _, problem := range resp.Problems {
+				problems = append(problems, fmt.Sprintf("%s %s %s", topoproto.TabletAliasString(problem.TabletAlias), problem.Kind, problem.Resolution))
+			}
+			assert.Equal(t, tt.expected, problems)
+			assert.Equal(t, "zone1-0000000100", topoproto.TabletAliasString(resp.Primary))
+		})
+	}
+}
+
+func TestReparentTablet(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		tmc       tmclient.TabletManagerClient
+		tablets   []*topodatapb.Tablet
+		shards    []*vtctldatapb.Shard
 		topoErr   error
 		req       *vtctldatapb.ReparentTabletRequest
 		expected  *vtctldatapb.ReparentTabletResponse
@@ -6171,156 +7690,518 @@ func TestReparentTablet(t *testing.T) {
 	}
 }
 
-func TestShardReplicationPositions(t *testing.T) {
+func TestRollbackVSchema(t *testing.T) {
 	t.Parallel()
 
-	tests := []struct {
-		name       string
-		ts         *topo.Server
-		tablets    []*topodatapb.Tablet
-		tmc        tmclient.TabletManagerClient
-		ctxTimeout time.Duration
-		req        *vtctldatapb.ShardReplicationPositionsRequest
-		expected   *vtctldatapb.ShardReplicationPositionsResponse
-		shouldErr  bool
-	}{
-		{
-			name: "success",
-			ts:   memorytopo.NewServer("zone1"),
-			tablets: []*topodatapb.Tablet{
-				{
-					Alias: &topodatapb.TabletAlias{
-						Cell: "zone1",
-						Uid:  100,
-					},
-					Keyspace: "testkeyspace",
-					Shard:    "-",
-					Type:     topodatapb.TabletType_PRIMARY,
-				},
-				{
-					Alias: &topodatapb.TabletAlias{
-						Cell: "zone1",
-						Uid:  101,
-					},
-					Keyspace: "testkeyspace",
-					Shard:    "-",
-					Type:     topodatapb.TabletType_REPLICA,
-				},
-			},
-			tmc: &testutil.TabletManagerClient{
-				MasterPositionResults: map[string]struct {
-					Position string
-					Error    error
-				}{
-					"zone1-0000000100": {
-						Position: "primary_tablet_position",
-					},
-				},
-				ReplicationStatusResults: map[string]struct {
-					Position *replicationdatapb.Status
-					Error    error
-				}{
-					"zone1-0000000101": {
-						Position: &replicationdatapb.Status{
-							Position: "replica_tablet_position",
-						},
-					},
-				},
-			},
-			req: &vtctldatapb.ShardReplicationPositionsRequest{
-				Keyspace: "testkeyspace",
-				Shard:    "-",
-			},
-			expected: &vtctldatapb.ShardReplicationPositionsResponse{
-				ReplicationStatuses: map[string]*replicationdatapb.Status{
-					"zone1-0000000100": {
-						Position: "primary_tablet_position",
-					},
-					"zone1-0000000101": {
-						Position: "replica_tablet_position",
-					},
-				},
-				TabletMap: map[string]*topodatapb.Tablet{
-					"zone1-0000000100": {
-						Alias: &topodatapb.TabletAlias{
-							Cell: "zone1",
-							Uid:  100,
-						},
-						Keyspace: "testkeyspace",
-						Shard:    "-",
-						Type:     topodatapb.TabletType_PRIMARY,
-					},
-					"zone1-0000000101": {
-						Alias: &topodatapb.TabletAlias{
-							Cell: "zone1",
-							Uid:  101,
-						},
-						Keyspace: "testkeyspace",
-						Shard:    "-",
-						Type:     topodatapb.TabletType_REPLICA,
-					},
-				},
+	ctx := context.Background()
+	ts := memorytopo.NewServer("zone1")
+	vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, nil, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+		return NewVtctldServer(ts)
+	})
+
+	testutil.AddKeyspace(ctx, t, ts, &vtctldatapb.Keyspace{
+		Name:     "testkeyspace",
+		Keyspace: &topodatapb.Keyspace{},
+	})
+	unsharded := &vschemapb.Keyspace{
+		Tables: map[string]*vschemapb.Table{
+			"t1": {},
+		},
+	}
+	require.NoError(t, ts.SaveVSchema(ctx, "testkeyspace", unsharded))
+
+	sharded := &vschemapb.Keyspace{
+		Sharded: true,
+		Vindexes: map[string]*vschemapb.Vindex{
+			"hash": {
+				Type: "hash",
 			},
-			shouldErr: false,
 		},
-		{
-			name: "timeouts are nonfatal",
-			ts:   memorytopo.NewServer("zone1"),
-			tablets: []*topodatapb.Tablet{
-				{
-					Alias: &topodatapb.TabletAlias{
-						Cell: "zone1",
-						Uid:  100,
-					},
-					Keyspace: "testkeyspace",
-					Shard:    "-",
-					Type:     topodatapb.TabletType_PRIMARY,
-				},
-				{
-					Alias: &topodatapb.TabletAlias{
-						Cell: "zone1",
-						Uid:  101,
-					},
-					Keyspace: "testkeyspace",
-					Shard:    "-",
-					Type:     topodatapb.TabletType_REPLICA,
-				},
+		Tables: map[string]*vschemapb.Table{
+			"t1": {
+				ColumnVindexes: []*vschemapb.ColumnVindex{{
+					Column: "id",
+					Name:   "hash",
+				}},
 			},
-			tmc: &testutil.TabletManagerClient{
-				MasterPositionDelays: map[string]time.Duration{
-					"zone1-0000000100": time.Millisecond * 100,
-				},
-				MasterPositionResults: map[string]struct {
-					Position string
-					Error    error
-				}{
-					"zone1-0000000100": {
-						Position: "primary_tablet_position",
-					},
-				},
-				ReplicationStatusDelays: map[string]time.Duration{
-					"zone1-0000000101": time.Millisecond * 100,
-				},
-				ReplicationStatusResults: map[string]struct {
-					Position *replicationdatapb.Status
-					Error    error
-				}{
-					"zone1-0000000101": {
-						Position: &replicationdatapb.Status{
-							Position: "replica_tablet_position",
-						},
-					},
+		},
+	}
+	applied, err := vtctld.ApplyVSchema(ctx, &vtctldatapb.ApplyVSchemaRequest{
+		Keyspace: "testkeyspace",
+		VSchema:  sharded,
+		Author:   "alice",
+		Comment:  "shard t1",
+	})
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, applied.Version)
+
+	// The vschema the keyspace had before its first revision is recorded too.
+	revisions, err := vtctld.GetVSchemaRevisions(ctx, &vtctldatapb.GetVSchemaRevisionsRequest{
+		Keyspace: "testkeyspace",
+	})
+	require.NoError(t, err)
+	require.Len(t, revisions.Revisions, 2)
+	assert.EqualValues(t, 1, revisions.Revisions[0].Version)
+	utils.MustMatch(t, unsharded, revisions.Revisions[0].Keyspace)
+	assert.EqualValues(t, 2, revisions.Revisions[1].Version)
+	assert.Equal(t, "alice", revisions.Revisions[1].Author)
+	assert.Equal(t, "shard t1", revisions.Revisions[1].Comment)
+	utils.MustMatch(t, sharded, revisions.Revisions[1].Keyspace)
+
+	resp, err := vtctld.RollbackVSchema(ctx, &vtctldatapb.RollbackVSchemaRequest{
+		Keyspace: "testkeyspace",
+		Version:  1,
+		Author:   "bob",
+	})
+	require.NoError(t, err)
+	utils.MustMatch(t, &vtctldatapb.RollbackVSchemaResponse{
+		VSchema: unsharded,
+		Version: 3,
+	}, resp)
+
+	vschema, err := ts.GetVSchema(ctx, "testkeyspace")
+	require.NoError(t, err)
+	utils.MustMatch(t, unsharded, vschema)
+	srvVSchema, err := ts.GetSrvVSchema(ctx, "zone1")
+	require.NoError(t, err)
+	utils.MustMatch(t, unsharded, srvVSchema.Keyspaces["testkeyspace"])
+
+	revisions, err = vtctld.GetVSchemaRevisions(ctx, &vtctldatapb.GetVSchemaRevisionsRequest{
+		Keyspace: "testkeyspace",
+	})
+	require.NoError(t, err)
+	require.Len(t, revisions.Revisions, 3)
+	assert.Equal(t, "bob", revisions.Revisions[2].Author)
+	assert.Equal(t, "rollback to version 1", revisions.Revisions[2].Comment)
+
+	_, err = vtctld.RollbackVSchema(ctx, &vtctldatapb.RollbackVSchemaRequest{
+		Keyspace: "testkeyspace",
+		Version:  4,
+	})
+	assert.Equal(t, vtrpc.Code_NOT_FOUND, vterrors.Code(err))
+}
+
+func TestScaffoldVSchema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ts := memorytopo.NewServer("zone1")
+	primary := &topodatapb.Tablet{
+		Alias:    &topodatapb.TabletAlias{Cell: "zone1", Uid: 100},
+		Keyspace: "testkeyspace",
+		Shard:    "0",
+		Type:     topodatapb.TabletType_PRIMARY,
+	}
+	testutil.AddTablet(ctx, t, ts, primary, &testutil.AddTabletOptions{AlsoSetShardPrimary: true})
+	testutil.AddTablet(ctx, t, ts, &topodatapb.Tablet{
+		Alias:    &topodatapb.TabletAlias{Cell: "zone1", Uid: 200},
+		Keyspace: "shardedkeyspace",
+		Shard:    "-",
+		Type:     topodatapb.TabletType_PRIMARY,
+	}, &testutil.AddTabletOptions{AlsoSetShardPrimary: true})
+	require.NoError(t, ts.SaveVSchema(ctx, "shardedkeyspace", &vschemapb.Keyspace{Sharded: true}))
+
+	tmc := &testutil.TabletManagerClient{
+		GetSchemaResults: map[string]struct {
+			Schema *tabletmanagerdatapb.SchemaDefinition
+			Error  error
+		}{
+			"zone1-0000000100": {
+				Schema: &tabletmanagerdatapb.SchemaDefinition{
+					TableDefinitions: []*tabletmanagerdatapb.TableDefinition{{
+						Name:              "t1",
+						Schema:            "create table t1 (id bigint, name varchar(64), primary key (id))",
+						Fields:            []*querypb.Field{{Name: "id", Type: querypb.Type_INT64}, {Name: "name", Type: querypb.Type_VARCHAR}},
+						PrimaryKeyColumns: []string{"id"},
+					}},
 				},
 			},
-			ctxTimeout: time.Millisecond * 10,
-			req: &vtctldatapb.ShardReplicationPositionsRequest{
-				Keyspace: "testkeyspace",
-				Shard:    "-",
+		},
+	}
+	vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, tmc, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+		return NewVtctldServer(ts)
+	})
+
+	resp, err := vtctld.ScaffoldVSchema(ctx, &vtctldatapb.ScaffoldVSchemaRequest{
+		Keyspace: "testkeyspace",
+		Queries:  []string{"select * from t1 where name = 'a'"},
+	})
+	require.NoError(t, err)
+	utils.MustMatch(t, &vschemapb.Keyspace{
+		Sharded: true,
+		Vindexes: map[string]*vschemapb.Vindex{
+			"unicode_loose_xxhash": {Type: "unicode_loose_xxhash"},
+		},
+		Tables: map[string]*vschemapb.Table{
+			"t1": {
+				ColumnVindexes: []*vschemapb.ColumnVindex{{Column: "name", Name: "unicode_loose_xxhash"}},
+			},
+		},
+	}, resp.VSchema)
+	if assert.Len(t, resp.Tables, 1) {
+		assert.Equal(t, "name", resp.Tables[0].Column)
+	}
+
+	_, err = vtctld.ScaffoldVSchema(ctx, &vtctldatapb.ScaffoldVSchemaRequest{
+		Keyspace: "shardedkeyspace",
+	})
+	assert.Equal(t, vtrpc.Code_FAILED_PRECONDITION, vterrors.Code(err))
+
+	_, err = vtctld.ScaffoldVSchema(ctx, &vtctldatapb.ScaffoldVSchemaRequest{
+		Keyspace:    "testkeyspace",
+		TabletAlias: &topodatapb.TabletAlias{Cell: "zone1", Uid: 200},
+	})
+	assert.Equal(t, vtrpc.Code_INVALID_ARGUMENT, vterrors.Code(err))
+}
+
+func TestSetKeyspaceDurabilityPolicy(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ts := memorytopo.NewServer("zone1")
+	vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, nil, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+		return NewVtctldServer(ts)
+	})
+
+	testutil.AddTablets(ctx, t, ts, &testutil.AddTabletOptions{AlsoSetShardPrimary: true}, &topodatapb.Tablet{
+		Alias:    &topodatapb.TabletAlias{Cell: "zone1", Uid: 100},
+		Keyspace: "testkeyspace",
+		Shard:    "-",
+		Type:     topodatapb.TabletType_PRIMARY,
+	}, &topodatapb.Tablet{
+		Alias:    &topodatapb.TabletAlias{Cell: "zone1", Uid: 101},
+		Keyspace: "testkeyspace",
+		Shard:    "-",
+		Type:     topodatapb.TabletType_RDONLY,
+	})
+
+	_, err := vtctld.SetKeyspaceDurabilityPolicy(ctx, &vtctldatapb.SetKeyspaceDurabilityPolicyRequest{
+		Keyspace:         "testkeyspace",
+		DurabilityPolicy: "unknown",
+	})
+	assert.Error(t, err)
+
+	_, err = vtctld.SetKeyspaceDurabilityPolicy(ctx, &vtctldatapb.SetKeyspaceDurabilityPolicyRequest{
+		Keyspace:         "testkeyspace",
+		DurabilityPolicy: "specified",
+		Force:            true,
+	})
+	assert.Error(t, err)
+
+	// The primary would have no semi-sync acker.
+	_, err = vtctld.SetKeyspaceDurabilityPolicy(ctx, &vtctldatapb.SetKeyspaceDurabilityPolicyRequest{
+		Keyspace:         "testkeyspace",
+		DurabilityPolicy: "semi_sync",
+	})
+	assert.Error(t, err)
+
+	resp, err := vtctld.SetKeyspaceDurabilityPolicy(ctx, &vtctldatapb.SetKeyspaceDurabilityPolicyRequest{
+		Keyspace:         "testkeyspace",
+		DurabilityPolicy: "semi_sync",
+		Force:            true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "semi_sync", resp.Keyspace.DurabilityPolicy)
+	ki, err := ts.GetKeyspace(ctx, "testkeyspace")
+	require.NoError(t, err)
+	assert.Equal(t, "semi_sync", ki.DurabilityPolicy)
+
+	resp, err = vtctld.SetKeyspaceDurabilityPolicy(ctx, &vtctldatapb.SetKeyspaceDurabilityPolicyRequest{
+		Keyspace:         "testkeyspace",
+		DurabilityPolicy: "none",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "none", resp.Keyspace.DurabilityPolicy)
+}
+
+func TestSetKeyspacePolicy(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ts := memorytopo.NewServer("zone1", "zone2")
+	vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, nil, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+		return NewVtctldServer(ts)
+	})
+
+	testutil.AddKeyspace(ctx, t, ts, &vtctldatapb.Keyspace{
+		Name:     "testkeyspace",
+		Keyspace: &topodatapb.Keyspace{},
+	})
+	// Only zone1 has a SrvKeyspace.
+	require.NoError(t, ts.UpdateSrvKeyspace(ctx, "zone1", "testkeyspace", &topodatapb.SrvKeyspace{}))
+
+	policy := &topodatapb.KeyspacePolicy{QueryTimeoutMs: 1000, MaxRows: 500, DefaultWorkload: "olap"}
+	resp, err := vtctld.SetKeyspacePolicy(ctx, &vtctldatapb.SetKeyspacePolicyRequest{
+		Keyspace: "testkeyspace",
+		Policy:   policy,
+	})
+	require.NoError(t, err)
+	utils.MustMatch(t, policy, resp.Policy)
+
+	ki, err := ts.GetKeyspace(ctx, "testkeyspace")
+	require.NoError(t, err)
+	utils.MustMatch(t, policy, ki.Policy)
+	srvKeyspace, err := ts.GetSrvKeyspace(ctx, "zone1", "testkeyspace")
+	require.NoError(t, err)
+	utils.MustMatch(t, policy, srvKeyspace.Policy)
+	_, err = ts.GetSrvKeyspace(ctx, "zone2", "testkeyspace")
+	assert.True(t, topo.IsErrType(err, topo.NoNode), "unexpected error: %v", err)
+
+	for _, invalid := range []*topodatapb.KeyspacePolicy{
+		{QueryTimeoutMs: -1},
+		{DefaultWorkload: "batch"},
+		{DefaultWorkload: "unspecified"},
+	} {
+		_, err = vtctld.SetKeyspacePolicy(ctx, &vtctldatapb.SetKeyspacePolicyRequest{
+			Keyspace: "testkeyspace",
+			Policy:   invalid,
+		})
+		assert.Error(t, err, "policy %+v", invalid)
+	}
+
+	// An empty policy removes it.
+	resp, err = vtctld.SetKeyspacePolicy(ctx, &vtctldatapb.SetKeyspacePolicyRequest{
+		Keyspace: "testkeyspace",
+		Policy:   &topodatapb.KeyspacePolicy{},
+	})
+	require.NoError(t, err)
+	assert.Nil(t, resp.Policy)
+	srvKeyspace, err = ts.GetSrvKeyspace(ctx, "zone1", "testkeyspace")
+	require.NoError(t, err)
+	assert.Nil(t, srvKeyspace.Policy)
+}
+
+func TestSetReplicationDelay(t *testing.T) {
+	t.Parallel()
+
+	replica := &topodatapb.TabletAlias{Cell: "zone1", Uid: 101}
+	primary := &topodatapb.TabletAlias{Cell: "zone1", Uid: 100}
+	tests := []struct {
+		name      string
+		req       *vtctldatapb.SetReplicationDelayRequest
+		expected  int32
+		shouldErr bool
+	}{
+		{
+			name: "delay",
+			req: &vtctldatapb.SetReplicationDelayRequest{
+				TabletAlias: replica,
+				Delay:       protoutil.DurationToProto(time.Hour),
+			},
+			expected: 3600,
+		},
+		{
+			name: "no delay",
+			req: &vtctldatapb.SetReplicationDelayRequest{
+				TabletAlias: replica,
+			},
+			expected: 0,
+		},
+		{
+			name: "negative delay",
+			req: &vtctldatapb.SetReplicationDelayRequest{
+				TabletAlias: replica,
+				Delay:       protoutil.DurationToProto(-time.Hour),
+			},
+			shouldErr: true,
+		},
+		{
+			name: "fractional delay",
+			req: &vtctldatapb.SetReplicationDelayRequest{
+				TabletAlias: replica,
+				Delay:       protoutil.DurationToProto(1500 * time.Millisecond),
+			},
+			shouldErr: true,
+		},
+		{
+			name: "primary",
+			req: &vtctldatapb.SetReplicationDelayRequest{
+				TabletAlias: primary,
+				Delay:       protoutil.DurationToProto(time.Hour),
+			},
+			shouldErr: true,
+		},
+		{
+			name: "unknown tablet",
+			req: &vtctldatapb.SetReplicationDelayRequest{
+				TabletAlias: &topodatapb.TabletAlias{Cell: "zone1", Uid: 200},
+				Delay:       protoutil.DurationToProto(time.Hour),
+			},
+			shouldErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			ts := memorytopo.NewServer("zone1")
+			vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, &testutil.TabletManagerClient{
+				TopoServer: ts,
+			}, func(ts *topo.Server) vtctlservicepb.VtctldServer { return NewVtctldServer(ts) })
+
+			testutil.AddTablets(ctx, t, ts, nil, &topodatapb.Tablet{
+				Alias:    primary,
+				Keyspace: "testkeyspace",
+				Shard:    "-",
+				Type:     topodatapb.TabletType_PRIMARY,
+			}, &topodatapb.Tablet{
+				Alias:                   replica,
+				Keyspace:                "testkeyspace",
+				Shard:                   "-",
+				Type:                    topodatapb.TabletType_REPLICA,
+				ReplicationDelaySeconds: 60,
+			})
+
+			resp, err := vtctld.SetReplicationDelay(ctx, tt.req)
+			if tt.shouldErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, resp.Tablet.ReplicationDelaySeconds)
+
+			tablet, err := ts.GetTablet(ctx, replica)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, tablet.ReplicationDelaySeconds)
+		})
+	}
+}
+
+func TestSetShardWriteFence(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ts := memorytopo.NewServer("zone1")
+	tmc := &testutil.TabletManagerClient{
+		RefreshStateResults: map[string]error{
+			"zone1-0000000100": nil,
+		},
+	}
+	vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, tmc, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+		return NewVtctldServer(ts)
+	})
+
+	testutil.AddTablets(ctx, t, ts, &testutil.AddTabletOptions{AlsoSetShardPrimary: true}, &topodatapb.Tablet{
+		Alias:    &topodatapb.TabletAlias{Cell: "zone1", Uid: 100},
+		Hostname: "primary",
+		Keyspace: "testkeyspace",
+		Shard:    "-",
+		Type:     topodatapb.TabletType_PRIMARY,
+	})
+
+	_, err := vtctld.SetShardWriteFence(ctx, &vtctldatapb.SetShardWriteFenceRequest{
+		Keyspace: "testkeyspace",
+		Shard:    "-",
+	})
+	assert.Error(t, err, "a fence requires a reason")
+
+	resp, err := vtctld.SetShardWriteFence(ctx, &vtctldatapb.SetShardWriteFenceRequest{
+		Keyspace: "testkeyspace",
+		Shard:    "-",
+		Reason:   "migration verification",
+	})
+	require.NoError(t, err)
+	require.NotNil(t, resp.Shard.WriteFence)
+	assert.Equal(t, "migration verification", resp.Shard.WriteFence.Reason)
+	si, err := ts.GetShard(ctx, "testkeyspace", "-")
+	require.NoError(t, err)
+	require.NotNil(t, si.WriteFence)
+	assert.NotNil(t, si.WriteFence.TimeCreated)
+
+	resp, err = vtctld.SetShardWriteFence(ctx, &vtctldatapb.SetShardWriteFenceRequest{
+		Keyspace: "testkeyspace",
+		Shard:    "-",
+		Remove:   true,
+	})
+	require.NoError(t, err)
+	assert.Nil(t, resp.Shard.WriteFence)
+
+	// The fence is recorded, but the error reports the tablets that could
+	// not apply it.
+	tmc.RefreshStateResults["zone1-0000000100"] = assert.AnError
+	_, err = vtctld.SetShardWriteFence(ctx, &vtctldatapb.SetShardWriteFenceRequest{
+		Keyspace: "testkeyspace",
+		Shard:    "-",
+		Reason:   "maintenance",
+	})
+	assert.Error(t, err)
+	si, err = ts.GetShard(ctx, "testkeyspace", "-")
+	require.NoError(t, err)
+	assert.NotNil(t, si.WriteFence)
+}
+
+func TestShardReplicationPositions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		ts         *topo.Server
+		tablets    []*topodatapb.Tablet
+		tmc        tmclient.TabletManagerClient
+		ctxTimeout time.Duration
+		req        *vtctldatapb.ShardReplicationPositionsRequest
+		expected   *vtctldatapb.ShardReplicationPositionsResponse
+		shouldErr  bool
+	}{
+		{
+			name: "success",
+			ts:   memorytopo.NewServer("zone1"),
+			tablets: []*topodatapb.Tablet{
+				{
+					Alias: &topodatapb.TabletAlias{
+						Cell: "zone1",
+						Uid:  100,
+					},
+					Keyspace: "testkeyspace",
+					Shard:    "-",
+					Type:     topodatapb.TabletType_PRIMARY,
+				},
+				{
+					Alias: &topodatapb.TabletAlias{
+						Cell: "zone1",
+						Uid:  101,
+					},
+					Keyspace: "testkeyspace",
+					Shard:    "-",
+					Type:     topodatapb.TabletType_REPLICA,
+				},
+			},
+			tmc: &testutil.TabletManagerClient{
+				MasterPositionResults: map[string]struct {
+					Position string
+					Error    error
+				}{
+					"zone1-0000000100": {
+						Position: "primary_tablet_position",
+					},
+				},
+				ReplicationStatusResults: map[string]struct {
+					Position *replicationdatapb.Status
+					Error    error
+				}{
+					"zone1-0000000101": {
+						Position: &replicationdatapb.Status{
+							Position: "replica_tablet_position",
+						},
+					},
+				},
+			},
+			req: &vtctldatapb.ShardReplicationPositionsRequest{
+				Keyspace: "testkeyspace",
+				Shard:    "-",
 			},
 			expected: &vtctldatapb.ShardReplicationPositionsResponse{
 				ReplicationStatuses: map[string]*replicationdatapb.Status{
-					"zone1-0000000100": nil,
-					"zone1-0000000101": nil,
+					"zone1-0000000100": {
+						Position: "primary_tablet_position",
+					},
+					"zone1-0000000101": {
+						Position: "replica_tablet_position",
+					},
 				},
 				TabletMap: map[string]*topodatapb.Tablet{
 					"zone1-0000000100": {
@@ -6346,7 +8227,7 @@ func TestShardReplicationPositions(t *testing.T) {
 			shouldErr: false,
 		},
 		{
-			name: "other rpc errors are fatal",
+			name: "timeouts are nonfatal",
 			ts:   memorytopo.NewServer("zone1"),
 			tablets: []*topodatapb.Tablet{
 				{
@@ -6369,14 +8250,20 @@ func TestShardReplicationPositions(t *testing.T) {
 				},
 			},
 			tmc: &testutil.TabletManagerClient{
+				MasterPositionDelays: map[string]time.Duration{
+					"zone1-0000000100": time.Millisecond * 100,
+				},
 				MasterPositionResults: map[string]struct {
 					Position string
 					Error    error
 				}{
 					"zone1-0000000100": {
-						Error: assert.AnError,
+						Position: "primary_tablet_position",
 					},
 				},
+				ReplicationStatusDelays: map[string]time.Duration{
+					"zone1-0000000101": time.Millisecond * 100,
+				},
 				ReplicationStatusResults: map[string]struct {
 					Position *replicationdatapb.Status
 					Error    error
@@ -6388,29 +8275,105 @@ func TestShardReplicationPositions(t *testing.T) {
 					},
 				},
 			},
+			ctxTimeout: time.Millisecond * 10,
 			req: &vtctldatapb.ShardReplicationPositionsRequest{
 				Keyspace: "testkeyspace",
 				Shard:    "-",
 			},
-			expected:  nil,
-			shouldErr: true,
-		},
-		{
-			name: "nonexistent shard",
-			ts:   memorytopo.NewServer("zone1"),
-			req: &vtctldatapb.ShardReplicationPositionsRequest{
-				Keyspace: "testkeyspace",
-				Shard:    "-",
-			},
-			expected:  nil,
-			shouldErr: true,
-		},
-	}
-
-	for _, tt := range tests {
-		tt := tt
-
-		t.Run(tt.name, func(t *testing.T) {
+			expected: &vtctldatapb.ShardReplicationPositionsResponse{
+				ReplicationStatuses: map[string]*replicationdatapb.Status{
+					"zone1-0000000100": nil,
+					"zone1-0000000101": nil,
+				},
+				TabletMap: map[string]*topodatapb.Tablet{
+					"zone1-0000000100": {
+						Alias: &topodatapb.TabletAlias{
+							Cell: "zone1",
+							Uid:  100,
+						},
+						Keyspace: "testkeyspace",
+						Shard:    "-",
+						Type:     topodatapb.TabletType_PRIMARY,
+					},
+					"zone1-0000000101": {
+						Alias: &topodatapb.TabletAlias{
+							Cell: "zone1",
+							Uid:  101,
+						},
+						Keyspace: "testkeyspace",
+						Shard:    "-",
+						Type:     topodatapb.TabletType_REPLICA,
+					},
+				},
+			},
+			shouldErr: false,
+		},
+		{
+			name: "other rpc errors are fatal",
+			ts:   memorytopo.NewServer("zone1"),
+			tablets: []*topodatapb.Tablet{
+				{
+					Alias: &topodatapb.TabletAlias{
+						Cell: "zone1",
+						Uid:  100,
+					},
+					Keyspace: "testkeyspace",
+					Shard:    "-",
+					Type:     topodatapb.TabletType_PRIMARY,
+				},
+				{
+					Alias: &topodatapb.TabletAlias{
+						Cell: "zone1",
+						Uid:  101,
+					},
+					Keyspace: "testkeyspace",
+					Shard:    "-",
+					Type:     topodatapb.TabletType_REPLICA,
+				},
+			},
+			tmc: &testutil.TabletManagerClient{
+				MasterPositionResults: map[string]struct {
+					Position string
+					Error    error
+				}{
+					"zone1-0000000100": {
+						Error: assert.AnError,
+					},
+				},
+				ReplicationStatusResults: map[string]struct {
+					Position *replicationdatapb.Status
+					Error    error
+				}{
+					"zone1-0000000101": {
+						Position: &replicationdatapb.Status{
+							Position: "replica_tablet_position",
+						},
+					},
+				},
+			},
+			req: &vtctldatapb.ShardReplicationPositionsRequest{
+				Keyspace: "testkeyspace",
+				Shard:    "-",
+			},
+			expected:  nil,
+			shouldErr: true,
+		},
+		{
+			name: "nonexistent shard",
+			ts:   memorytopo.NewServer("zone1"),
+			req: &vtctldatapb.ShardReplicationPositionsRequest{
+				Keyspace: "testkeyspace",
+				Shard:    "-",
+			},
+			expected:  nil,
+			shouldErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
 			ctx := context.Background()
@@ -7160,3 +9123,641 @@ func TestUpdateCellsAlias(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateRecoverySettings(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ts := memorytopo.NewServer("zone1")
+	vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, nil, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+		return NewVtctldServer(ts)
+	})
+
+	testutil.AddShards(ctx, t, ts, &vtctldatapb.Shard{
+		Keyspace: "testkeyspace",
+		Name:     "-80",
+	}, &vtctldatapb.Shard{
+		Keyspace: "otherkeyspace",
+		Name:     "0",
+	})
+
+	now := time.Now()
+	upgrade := &topodatapb.RecoverySettings_MaintenanceWindow{
+		Name:      "upgrade",
+		Keyspace:  "otherkeyspace",
+		StartTime: protoutil.TimeToProto(now.Add(-time.Minute)),
+		EndTime:   protoutil.TimeToProto(now.Add(time.Hour)),
+		Reason:    "mysql upgrade",
+	}
+	ended := &topodatapb.RecoverySettings_MaintenanceWindow{
+		Name:      "ended",
+		StartTime: protoutil.TimeToProto(now.Add(-time.Hour)),
+		EndTime:   protoutil.TimeToProto(now.Add(-time.Minute)),
+	}
+	_, err := ts.UpdateRecoverySettingsFields(ctx, func(settings *topodatapb.RecoverySettings) error {
+		settings.MaintenanceWindows = []*topodatapb.RecoverySettings_MaintenanceWindow{ended}
+		return nil
+	})
+	require.NoError(t, err)
+
+	for _, req := range []*vtctldatapb.UpdateRecoverySettingsRequest{
+		{Keyspace: "testkeyspace", DisableRecoveries: true},
+		{Keyspace: "testkeyspace", Shard: "-80", DisableRecoveries: true},
+		// Disabling twice is a no-op.
+		{Keyspace: "testkeyspace", DisableRecoveries: true},
+		{AddMaintenanceWindows: []*topodatapb.RecoverySettings_MaintenanceWindow{upgrade}},
+	} {
+		_, err := vtctld.UpdateRecoverySettings(ctx, req)
+		require.NoError(t, err)
+	}
+
+	resp, err := vtctld.GetRecoverySettings(ctx, &vtctldatapb.GetRecoverySettingsRequest{})
+	require.NoError(t, err)
+	// The ended window was dropped.
+	expected := &topodatapb.RecoverySettings{
+		DisabledKeyspaces:  []string{"testkeyspace"},
+		DisabledShards:     []string{"testkeyspace/-80"},
+		MaintenanceWindows: []*topodatapb.RecoverySettings_MaintenanceWindow{upgrade},
+	}
+	utils.MustMatch(t, expected, resp.RecoverySettings)
+
+	updateResp, err := vtctld.UpdateRecoverySettings(ctx, &vtctldatapb.UpdateRecoverySettingsRequest{
+		Keyspace:                 "testkeyspace",
+		EnableRecoveries:         true,
+		RemoveMaintenanceWindows: []string{"upgrade"},
+	})
+	require.NoError(t, err)
+	expected = &topodatapb.RecoverySettings{
+		DisabledShards:     []string{"testkeyspace/-80"},
+		MaintenanceWindows: []*topodatapb.RecoverySettings_MaintenanceWindow{},
+	}
+	utils.MustMatch(t, expected, updateResp.RecoverySettings)
+
+	// Invalid requests.
+	for _, req := range []*vtctldatapb.UpdateRecoverySettingsRequest{
+		{Shard: "-80", DisableRecoveries: true},
+		{DisableRecoveries: true, EnableRecoveries: true},
+		{Keyspace: "nokeyspace", DisableRecoveries: true},
+		{Keyspace: "testkeyspace", Shard: "80-", DisableRecoveries: true},
+		{AddMaintenanceWindows: []*topodatapb.RecoverySettings_MaintenanceWindow{{StartTime: upgrade.StartTime, EndTime: upgrade.EndTime}}},
+		{AddMaintenanceWindows: []*topodatapb.RecoverySettings_MaintenanceWindow{{Name: "upgrade", StartTime: upgrade.EndTime, EndTime: upgrade.StartTime}}},
+		{AddMaintenanceWindows: []*topodatapb.RecoverySettings_MaintenanceWindow{{Name: "upgrade", Shard: "-80", StartTime: upgrade.StartTime, EndTime: upgrade.EndTime}}},
+	} {
+		_, err := vtctld.UpdateRecoverySettings(ctx, req)
+		assert.Error(t, err, "request %+v", req)
+	}
+}
+
+func TestUpdateThrottlerConfig(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ts := memorytopo.NewServer("zone1", "zone2")
+	vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, nil, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+		return NewVtctldServer(ts)
+	})
+
+	testutil.AddKeyspace(ctx, t, ts, &vtctldatapb.Keyspace{
+		Name:     "testkeyspace",
+		Keyspace: &topodatapb.Keyspace{},
+	})
+	// Only zone1 has a SrvKeyspace.
+	require.NoError(t, ts.UpdateSrvKeyspace(ctx, "zone1", "testkeyspace", &topodatapb.SrvKeyspace{}))
+
+	resp, err := vtctld.UpdateThrottlerConfig(ctx, &vtctldatapb.UpdateThrottlerConfigRequest{
+		Keyspace: "testkeyspace",
+		SetMetrics: map[string]*topodatapb.ThrottlerConfig_Metric{
+			"threads_running": {Threshold: 50},
+			"lag":             {Threshold: 2},
+		},
+		SetAppPolicies: map[string]*topodatapb.ThrottlerConfig_AppPolicy{
+			"online-ddl": {Metrics: []string{"lag", "threads_running"}, Priority: -1},
+		},
+	})
+	require.NoError(t, err)
+	expected := &topodatapb.ThrottlerConfig{
+		Metrics: map[string]*topodatapb.ThrottlerConfig_Metric{
+			"threads_running": {Threshold: 50},
+			"lag":             {Threshold: 2},
+		},
+		AppPolicies: map[string]*topodatapb.ThrottlerConfig_AppPolicy{
+			"online-ddl": {Metrics: []string{"lag", "threads_running"}, Priority: -1},
+		},
+	}
+	utils.MustMatch(t, expected, resp.ThrottlerConfig)
+
+	ki, err := ts.GetKeyspace(ctx, "testkeyspace")
+	require.NoError(t, err)
+	utils.MustMatch(t, expected, ki.ThrottlerConfig)
+	srvKeyspace, err := ts.GetSrvKeyspace(ctx, "zone1", "testkeyspace")
+	require.NoError(t, err)
+	utils.MustMatch(t, expected, srvKeyspace.ThrottlerConfig)
+	_, err = ts.GetSrvKeyspace(ctx, "zone2", "testkeyspace")
+	assert.True(t, topo.IsErrType(err, topo.NoNode), "unexpected error: %v", err)
+
+	// A metric still used by an app policy cannot be removed.
+	_, err = vtctld.UpdateThrottlerConfig(ctx, &vtctldatapb.UpdateThrottlerConfigRequest{
+		Keyspace:      "testkeyspace",
+		RemoveMetrics: []string{"threads_running"},
+	})
+	assert.Error(t, err)
+
+	resp, err = vtctld.UpdateThrottlerConfig(ctx, &vtctldatapb.UpdateThrottlerConfigRequest{
+		Keyspace:          "testkeyspace",
+		RemoveMetrics:     []string{"threads_running"},
+		RemoveAppPolicies: []string{"online-ddl"},
+	})
+	require.NoError(t, err)
+	expected = &topodatapb.ThrottlerConfig{
+		Metrics: map[string]*topodatapb.ThrottlerConfig_Metric{
+			"lag": {Threshold: 2},
+		},
+		AppPolicies: map[string]*topodatapb.ThrottlerConfig_AppPolicy{},
+	}
+	utils.MustMatch(t, expected, resp.ThrottlerConfig)
+	srvKeyspace, err = ts.GetSrvKeyspace(ctx, "zone1", "testkeyspace")
+	require.NoError(t, err)
+	utils.MustMatch(t, expected, srvKeyspace.ThrottlerConfig)
+
+	_, err = vtctld.UpdateThrottlerConfig(ctx, &vtctldatapb.UpdateThrottlerConfigRequest{Keyspace: "notfound"})
+	assert.Error(t, err)
+}
+
+func TestValidateServingGraph(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ts := memorytopo.NewServer("zone1", "zone2")
+	vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, nil, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+		return NewVtctldServer(ts)
+	})
+
+	testutil.AddShards(ctx, t, ts, &vtctldatapb.Shard{
+		Keyspace: "testkeyspace",
+		Name:     "-",
+	})
+	require.NoError(t, topotools.RebuildKeyspace(ctx, logutil.NewMemoryLogger(), ts, "testkeyspace", []string{"zone1"}, false))
+	require.NoError(t, ts.RebuildSrvVSchema(ctx, []string{"zone1"}))
+
+	// zone2 was not rebuilt.
+	resp, err := vtctld.ValidateServingGraph(ctx, &vtctldatapb.ValidateServingGraphRequest{})
+	require.NoError(t, err)
+	utils.MustMatch(t, &vtctldatapb.ValidateServingGraphResponse{
+		Divergences: []*vtctldatapb.ValidateServingGraphResponse_Divergence{{
+			Cell:        "zone2",
+			Keyspace:    "testkeyspace",
+			Description: "SrvKeyspace is missing",
+		}, {
+			Cell:        "zone2",
+			Description: "SrvVSchema is missing",
+		}},
+	}, resp)
+
+	// zone1 is stale once the shard has a new key range.
+	require.NoError(t, ts.UpdateSrvKeyspace(ctx, "zone1", "testkeyspace", &topodatapb.SrvKeyspace{
+		Partitions: []*topodatapb.SrvKeyspace_KeyspacePartition{{
+			ServedType:      topodatapb.TabletType_PRIMARY,
+			ShardReferences: []*topodatapb.ShardReference{{Name: "-"}},
+		}},
+	}))
+	require.NoError(t, ts.SaveVSchema(ctx, "testkeyspace", &vschemapb.Keyspace{Sharded: true}))
+	resp, err = vtctld.ValidateServingGraph(ctx, &vtctldatapb.ValidateServingGraphRequest{
+		Cells:   []string{"zone1"},
+		Rebuild: true,
+	})
+	require.NoError(t, err)
+	utils.MustMatch(t, &vtctldatapb.ValidateServingGraphResponse{
+		Divergences: []*vtctldatapb.ValidateServingGraphResponse_Divergence{{
+			Cell:        "zone1",
+			Keyspace:    "testkeyspace",
+			Description: "REPLICA is served by [] instead of [-], RDONLY is served by [] instead of [-]",
+			Rebuilt:     true,
+		}, {
+			Cell:        "zone1",
+			Description: "SrvVSchema differs from the global topo in the VSchema of testkeyspace",
+			Rebuilt:     true,
+		}},
+	}, resp)
+
+	resp, err = vtctld.ValidateServingGraph(ctx, &vtctldatapb.ValidateServingGraphRequest{
+		Cells: []string{"zone1"},
+	})
+	require.NoError(t, err)
+	assert.Empty(t, resp.Divergences)
+
+	// The cells that are being migrated are skipped.
+	require.NoError(t, ts.UpdateSrvKeyspace(ctx, "zone1", "testkeyspace", &topodatapb.SrvKeyspace{
+		Partitions: []*topodatapb.SrvKeyspace_KeyspacePartition{{
+			ServedType:          topodatapb.TabletType_PRIMARY,
+			ShardReferences:     []*topodatapb.ShardReference{{Name: "-"}},
+			ShardTabletControls: []*topodatapb.ShardTabletControl{{Name: "-", QueryServiceDisabled: true}},
+		}},
+	}))
+	resp, err = vtctld.ValidateServingGraph(ctx, &vtctldatapb.ValidateServingGraphRequest{
+		Keyspaces: []string{"testkeyspace"},
+		Cells:     []string{"zone1"},
+	})
+	require.NoError(t, err)
+	assert.Empty(t, resp.Divergences)
+
+	_, err = vtctld.ValidateServingGraph(ctx, &vtctldatapb.ValidateServingGraphRequest{
+		Keyspaces: []string{"nosuchkeyspace"},
+	})
+	assert.Error(t, err)
+}
+
+func TestValidateVSchema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ts := memorytopo.NewServer("zone1")
+	primary := &topodatapb.Tablet{
+		Alias:    &topodatapb.TabletAlias{Cell: "zone1", Uid: 100},
+		Keyspace: "testkeyspace",
+		Shard:    "-",
+		Type:     topodatapb.TabletType_PRIMARY,
+	}
+	testutil.AddTablet(ctx, t, ts, primary, &testutil.AddTabletOptions{AlsoSetShardPrimary: true})
+	require.NoError(t, ts.SaveVSchema(ctx, "testkeyspace", &vschemapb.Keyspace{
+		Sharded: true,
+		Vindexes: map[string]*vschemapb.Vindex{
+			"hash": {Type: "hash"},
+		},
+		Tables: map[string]*vschemapb.Table{
+			"t1": {
+				ColumnVindexes: []*vschemapb.ColumnVindex{{Column: "id", Name: "hash"}},
+			},
+			"t2": {
+				ColumnVindexes: []*vschemapb.ColumnVindex{{Column: "id", Name: "hash"}},
+			},
+		},
+	}))
+
+	tmc := &testutil.TabletManagerClient{
+		GetSchemaResults: map[string]struct {
+			Schema *tabletmanagerdatapb.SchemaDefinition
+			Error  error
+		}{
+			"zone1-0000000100": {
+				Schema: &tabletmanagerdatapb.SchemaDefinition{
+					TableDefinitions: []*tabletmanagerdatapb.TableDefinition{{
+						Name:   "t1",
+						Schema: "create table t1 (id bigint, primary key (id))",
+						Fields: []*querypb.Field{{Name: "id", Type: querypb.Type_INT64}},
+					}},
+				},
+			},
+		},
+	}
+	vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, tmc, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+		return NewVtctldServer(ts)
+	})
+
+	resp, err := vtctld.ValidateVSchema(ctx, &vtctldatapb.ValidateVSchemaRequest{
+		Keyspace: "testkeyspace",
+	})
+	require.NoError(t, err)
+	utils.MustMatch(t, []*vtctldatapb.VSchemaFinding{{
+		Kind:       vtctldatapb.VSchemaFinding_TABLE_NOT_FOUND,
+		Table:      "t2",
+		Shards:     []string{"-"},
+		Message:    "table t2 of the vschema is not in the schema",
+		Suggestion: "create the table, or remove it from the vschema",
+	}}, resp.Findings)
+
+	_, err = vtctld.ValidateVSchema(ctx, &vtctldatapb.ValidateVSchemaRequest{
+		Keyspace: "doesnotexist",
+	})
+	assert.Error(t, err)
+}
+
+func TestVerifyBackup(t *testing.T) {
+	// Not parallel, since TestGetBackups replaces the backups of the shared
+	// testutil.BackupStorage.
+	ctx := context.Background()
+	ts := memorytopo.NewServer("zone1")
+	tmc := &testutil.TabletManagerClient{
+		RestoreFromBackupResults: map[string]struct {
+			Events       []*logutilpb.Event
+			EventsError  error
+			RestoreError error
+		}{
+			"zone1-0000000101": {
+				Events: []*logutilpb.Event{{Value: "restoring"}},
+			},
+			"zone1-0000000102": {
+				EventsError: assert.AnError,
+			},
+		},
+	}
+	vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, tmc, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+		return NewVtctldServer(ts)
+	})
+
+	testutil.AddTablets(ctx, t, ts, &testutil.AddTabletOptions{AlsoSetShardPrimary: true}, &topodatapb.Tablet{
+		Alias:    &topodatapb.TabletAlias{Cell: "zone1", Uid: 100},
+		Keyspace: "verifyks",
+		Shard:    "-",
+		Type:     topodatapb.TabletType_PRIMARY,
+	}, &topodatapb.Tablet{
+		Alias:    &topodatapb.TabletAlias{Cell: "zone1", Uid: 101},
+		Keyspace: "verifyks",
+		Shard:    "-",
+		Type:     topodatapb.TabletType_SPARE,
+	}, &topodatapb.Tablet{
+		Alias:    &topodatapb.TabletAlias{Cell: "zone1", Uid: 102},
+		Keyspace: "verifyks",
+		Shard:    "-",
+		Type:     topodatapb.TabletType_SPARE,
+	}, &topodatapb.Tablet{
+		Alias:    &topodatapb.TabletAlias{Cell: "zone1", Uid: 103},
+		Keyspace: "verifyks",
+		Shard:    "-",
+		Type:     topodatapb.TabletType_REPLICA,
+	}, &topodatapb.Tablet{
+		Alias:    &topodatapb.TabletAlias{Cell: "zone1", Uid: 200},
+		Keyspace: "otherks",
+		Shard:    "-",
+		Type:     topodatapb.TabletType_SPARE,
+	})
+
+	testutil.BackupStorage.Backups["verifyks/-"] = []string{"backup1", "backup2", "backup3", "backup4"}
+	testutil.BackupStorage.Files["verifyks/-/backup1/MANIFEST"] = []byte(`{"BackupMethod": "builtin", "FileEntries": [{"Base": "Data", "Name": "t.ibd", "Hash": "00000000"}]}`)
+	testutil.BackupStorage.Files["verifyks/-/backup2/MANIFEST"] = []byte(`{"BackupMethod": "builtin", "FileEntries": []}`)
+	// backup4 is an incremental backup, which applies to backup2.
+	testutil.BackupStorage.Files["verifyks/-/backup4/MANIFEST"] = []byte(`{"BackupMethod": "binlog", "Hash": "5387574a", "Size": 6}`)
+	testutil.BackupStorage.Files["verifyks/-/backup4/binlog"] = []byte("events")
+
+	t.Run("manifest level", func(t *testing.T) {
+		resp, err := vtctld.VerifyBackup(ctx, &vtctldatapb.VerifyBackupRequest{
+			Keyspace:   "verifyks",
+			Shard:      "-",
+			BackupName: "backup2",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "backup2", resp.Verification.BackupName)
+		assert.Equal(t, mysqlctlpb.BackupInfo_VALID, resp.Verification.Status)
+		assert.Empty(t, resp.Verification.Error)
+
+		// backup1 references a file that does not exist.
+		resp, err = vtctld.VerifyBackup(ctx, &vtctldatapb.VerifyBackupRequest{
+			Keyspace:   "verifyks",
+			Shard:      "-",
+			BackupName: "backup1",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, mysqlctlpb.BackupInfo_INVALID, resp.Verification.Status)
+		assert.NotEmpty(t, resp.Verification.Error)
+	})
+
+	t.Run("latest backup by default", func(t *testing.T) {
+		// backup4 is incremental: its binary logs are checked.
+		resp, err := vtctld.VerifyBackup(ctx, &vtctldatapb.VerifyBackupRequest{
+			Keyspace: "verifyks",
+			Shard:    "-",
+			Level:    mysqlctlpb.BackupVerification_CHECKSUM,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "backup4", resp.Verification.BackupName)
+		assert.Equal(t, mysqlctlpb.BackupInfo_VALID, resp.Verification.Status)
+		assert.Empty(t, resp.Verification.Error)
+
+		// backup3 has no MANIFEST.
+		resp, err = vtctld.VerifyBackup(ctx, &vtctldatapb.VerifyBackupRequest{
+			Keyspace:   "verifyks",
+			Shard:      "-",
+			BackupName: "backup3",
+			Level:      mysqlctlpb.BackupVerification_CHECKSUM,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, mysqlctlpb.BackupInfo_INVALID, resp.Verification.Status)
+	})
+
+	t.Run("restore level", func(t *testing.T) {
+		resp, err := vtctld.VerifyBackup(ctx, &vtctldatapb.VerifyBackupRequest{
+			Keyspace:    "verifyks",
+			Shard:       "-",
+			Level:       mysqlctlpb.BackupVerification_RESTORE,
+			TabletAlias: &topodatapb.TabletAlias{Cell: "zone1", Uid: 102},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, mysqlctlpb.BackupInfo_INVALID, resp.Verification.Status)
+
+		// The tablets restore the most recent full backup, skipping the
+		// incremental backup4 and the incomplete backup3.
+		resp, err = vtctld.VerifyBackup(ctx, &vtctldatapb.VerifyBackupRequest{
+			Keyspace:    "verifyks",
+			Shard:       "-",
+			Level:       mysqlctlpb.BackupVerification_RESTORE,
+			TabletAlias: &topodatapb.TabletAlias{Cell: "zone1", Uid: 101},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "backup2", resp.Verification.BackupName)
+		assert.Equal(t, mysqlctlpb.BackupInfo_VALID, resp.Verification.Status)
+		utils.MustMatch(t, &topodatapb.TabletAlias{Cell: "zone1", Uid: 101}, resp.Verification.TabletAlias)
+	})
+
+	t.Run("verifications are recorded with the backups", func(t *testing.T) {
+		for _, name := range []string{"backup1", "backup2", "backup3", "backup4"} {
+			assert.Contains(t, testutil.BackupStorage.Files, "verifyks/-/"+name+"/VERIFICATION")
+		}
+
+		resp, err := vtctld.GetBackups(ctx, &vtctldatapb.GetBackupsRequest{
+			Keyspace: "verifyks",
+			Shard:    "-",
+			Detailed: true,
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Backups, 4)
+		assert.Equal(t, mysqlctlpb.BackupInfo_INVALID, resp.Backups[0].Status)
+		assert.Equal(t, mysqlctlpb.BackupInfo_VALID, resp.Backups[1].Status)
+		assert.Equal(t, mysqlctlpb.BackupInfo_INVALID, resp.Backups[2].Status)
+		assert.Equal(t, mysqlctlpb.BackupInfo_VALID, resp.Backups[3].Status)
+
+		// The age of the last valid verification comes from the recorded
+		// verifications, whichever vtctld verified the backups.
+		lastValidBackupVerifications.replace(map[string]time.Time{})
+		require.NoError(t, RefreshBackupVerificationAges(ctx, ts))
+		assert.Contains(t, lastValidBackupVerifications.ages(), "verifyks.-")
+	})
+
+	errTests := []struct {
+		name string
+		req  *vtctldatapb.VerifyBackupRequest
+	}{
+		{
+			name: "no backups",
+			req: &vtctldatapb.VerifyBackupRequest{
+				Keyspace: "otherks",
+				Shard:    "-",
+			},
+		},
+		{
+			name: "unknown backup",
+			req: &vtctldatapb.VerifyBackupRequest{
+				Keyspace:   "verifyks",
+				Shard:      "-",
+				BackupName: "backup5",
+			},
+		},
+		{
+			name: "restore without tablet",
+			req: &vtctldatapb.VerifyBackupRequest{
+				Keyspace: "verifyks",
+				Shard:    "-",
+				Level:    mysqlctlpb.BackupVerification_RESTORE,
+			},
+		},
+		{
+			name: "restore on primary",
+			req: &vtctldatapb.VerifyBackupRequest{
+				Keyspace:    "verifyks",
+				Shard:       "-",
+				Level:       mysqlctlpb.BackupVerification_RESTORE,
+				TabletAlias: &topodatapb.TabletAlias{Cell: "zone1", Uid: 100},
+			},
+		},
+		{
+			name: "restore on serving replica",
+			req: &vtctldatapb.VerifyBackupRequest{
+				Keyspace:    "verifyks",
+				Shard:       "-",
+				Level:       mysqlctlpb.BackupVerification_RESTORE,
+				TabletAlias: &topodatapb.TabletAlias{Cell: "zone1", Uid: 103},
+			},
+		},
+		{
+			name: "restore on tablet in another shard",
+			req: &vtctldatapb.VerifyBackupRequest{
+				Keyspace:    "verifyks",
+				Shard:       "-",
+				Level:       mysqlctlpb.BackupVerification_RESTORE,
+				TabletAlias: &topodatapb.TabletAlias{Cell: "zone1", Uid: 200},
+			},
+		},
+		{
+			name: "restore of an incremental backup",
+			req: &vtctldatapb.VerifyBackupRequest{
+				Keyspace:    "verifyks",
+				Shard:       "-",
+				BackupName:  "backup4",
+				Level:       mysqlctlpb.BackupVerification_RESTORE,
+				TabletAlias: &topodatapb.TabletAlias{Cell: "zone1", Uid: 101},
+			},
+		},
+		{
+			name: "restore of an older backup",
+			req: &vtctldatapb.VerifyBackupRequest{
+				Keyspace:    "verifyks",
+				Shard:       "-",
+				BackupName:  "backup1",
+				Level:       mysqlctlpb.BackupVerification_RESTORE,
+				TabletAlias: &topodatapb.TabletAlias{Cell: "zone1", Uid: 101},
+			},
+		},
+	}
+
+	for _, tt := range errTests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := vtctld.VerifyBackup(ctx, tt.req)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestWorkflowPause(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("zone1")
+	vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, nil, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+		return NewVtctldServer(ts)
+	})
+
+	tests := []struct {
+		name      string
+		req       *vtctldatapb.WorkflowPauseRequest
+		shouldErr bool
+	}{
+		{
+			name: "ok",
+			req:  &vtctldatapb.WorkflowPauseRequest{Keyspace: "ks", Workflow: "wf", Reason: "schema change on the target"},
+		},
+		{
+			name:      "no workflow",
+			req:       &vtctldatapb.WorkflowPauseRequest{Keyspace: "ks", Reason: "schema change on the target"},
+			shouldErr: true,
+		},
+		{
+			name:      "no reason",
+			req:       &vtctldatapb.WorkflowPauseRequest{Keyspace: "ks", Workflow: "wf", Reason: " "},
+			shouldErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wm := &fakeWorkflowManager{}
+			setFakeWorkflowManager(t, wm)
+
+			_, err := vtctld.WorkflowPause(ctx, tt.req)
+			if tt.shouldErr {
+				assert.Error(t, err)
+				assert.Empty(t, wm.workflowPauseRequests)
+				return
+			}
+
+			require.NoError(t, err)
+			utils.MustMatch(t, []*vtctldatapb.WorkflowPauseRequest{tt.req}, wm.workflowPauseRequests)
+		})
+	}
+}
+
+func TestWorkflowSwitchTraffic(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("zone1")
+	vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, nil, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+		return NewVtctldServer(ts)
+	})
+
+	tests := []struct {
+		name      string
+		req       *vtctldatapb.WorkflowSwitchTrafficRequest
+		shouldErr bool
+	}{
+		{
+			name: "forward",
+			req:  &vtctldatapb.WorkflowSwitchTrafficRequest{Keyspace: "ks", Workflow: "wf", Direction: int32(workflow.DirectionForward)},
+		},
+		{
+			name: "backward",
+			req:  &vtctldatapb.WorkflowSwitchTrafficRequest{Keyspace: "ks", Workflow: "wf", Direction: int32(workflow.DirectionBackward)},
+		},
+		{
+			name:      "no workflow",
+			req:       &vtctldatapb.WorkflowSwitchTrafficRequest{Keyspace: "ks"},
+			shouldErr: true,
+		},
+		{
+			name:      "unknown direction",
+			req:       &vtctldatapb.WorkflowSwitchTrafficRequest{Keyspace: "ks", Workflow: "wf", Direction: 2},
+			shouldErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wm := &fakeWorkflowManager{}
+			setFakeWorkflowManager(t, wm)
+
+			_, err := vtctld.WorkflowSwitchTraffic(ctx, tt.req)
+			if tt.shouldErr {
+				assert.Error(t, err)
+				assert.Empty(t, wm.workflowSwitchTrafficRequests)
+				return
+			}
+
+			require.NoError(t, err)
+			utils.MustMatch(t, []*vtctldatapb.WorkflowSwitchTrafficRequest{tt.req}, wm.workflowSwitchTrafficRequests)
+		})
+	}
+}
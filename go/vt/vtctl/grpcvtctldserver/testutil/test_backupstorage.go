@@ -17,10 +17,15 @@ limitations under the License.
 package testutil
 
 import (
+	"bytes"
 	"context"
+	"io"
+	"io/ioutil"
+	"path"
 	"sort"
 
 	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+	"vitess.io/vitess/go/vt/topo"
 )
 
 type backupStorage struct {
@@ -31,6 +36,12 @@ type backupStorage struct {
 	Backups map[string][]string
 	// ListBackupsError is returned from ListBackups when it is non-nil.
 	ListBackupsError error
+	// RemoveBackupError is returned from RemoveBackup when it is non-nil.
+	RemoveBackupError error
+	// Files is a mapping of <directory>/<backup name>/<file name> to file
+	// contents, returned by ReadFile on the backup handles, and set by
+	// WriteMetadataFile.
+	Files map[string][]byte
 }
 
 // ListBackups is part of the backupstorage.BackupStorage interface.
@@ -44,7 +55,7 @@ func (bs *backupStorage) ListBackups(ctx context.Context, dir string) ([]backups
 	for k, v := range bs.Backups {
 		if k == dir {
 			for _, name := range v {
-				handles = append(handles, &backupHandle{directory: k, name: name})
+				handles = append(handles, &backupHandle{bs: bs, directory: k, name: name})
 			}
 		}
 	}
@@ -54,6 +65,23 @@ func (bs *backupStorage) ListBackups(ctx context.Context, dir string) ([]backups
 	return handles, nil
 }
 
+// RemoveBackup is part of the backupstorage.BackupStorage interface.
+func (bs *backupStorage) RemoveBackup(ctx context.Context, dir string, name string) error {
+	if bs.RemoveBackupError != nil {
+		return bs.RemoveBackupError
+	}
+
+	backups := bs.Backups[dir]
+	for i, backup := range backups {
+		if backup == name {
+			bs.Backups[dir] = append(backups[:i:i], backups[i+1:]...)
+			return nil
+		}
+	}
+
+	return topo.NewError(topo.NoNode, path.Join(dir, name))
+}
+
 // Close is part of the backupstorage.BackupStorage interface.
 func (bs *backupStorage) Close() error { return nil }
 
@@ -61,6 +89,7 @@ func (bs *backupStorage) Close() error { return nil }
 type backupHandle struct {
 	backupstorage.BackupHandle
 
+	bs        *backupStorage
 	directory string
 	name      string
 }
@@ -68,6 +97,22 @@ type backupHandle struct {
 func (bh *backupHandle) Directory() string { return bh.directory }
 func (bh *backupHandle) Name() string      { return bh.name }
 
+// ReadFile is part of the backupstorage.BackupHandle interface.
+func (bh *backupHandle) ReadFile(ctx context.Context, filename string) (io.ReadCloser, error) {
+	contents, ok := bh.bs.Files[path.Join(bh.directory, bh.name, filename)]
+	if !ok {
+		return nil, topo.NewError(topo.NoNode, filename)
+	}
+
+	return ioutil.NopCloser(bytes.NewReader(contents)), nil
+}
+
+// WriteMetadataFile is part of the backupstorage.MetadataWriter interface.
+func (bh *backupHandle) WriteMetadataFile(ctx context.Context, filename string, data []byte) error {
+	bh.bs.Files[path.Join(bh.directory, bh.name, filename)] = data
+	return nil
+}
+
 // handlesByName implements the sort interface for backup handles by Name().
 type handlesByName []backupstorage.BackupHandle
 
@@ -85,6 +130,7 @@ const BackupStorageImplementation = "grpcvtctldserver.testutil"
 // state.
 var BackupStorage = &backupStorage{
 	Backups: map[string][]string{},
+	Files:   map[string][]byte{},
 }
 
 func init() {
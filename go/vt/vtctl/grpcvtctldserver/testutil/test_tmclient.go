@@ -19,18 +19,21 @@ package testutil
 import (
 	"context"
 	"fmt"
+	"io"
 	"sync"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 
+	"vitess.io/vitess/go/vt/logutil"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/topotools"
 	"vitess.io/vitess/go/vt/vttablet/tmclient"
 
+	logutilpb "vitess.io/vitess/go/vt/proto/logutil"
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	replicationdatapb "vitess.io/vitess/go/vt/proto/replicationdata"
 	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
@@ -74,10 +77,9 @@ var (
 // vtctlservicepb.VtctldServer, tests will need to indirect that call through an
 // extra layer rather than passing the function identifier directly, e.g.:
 //
-//		vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, &testutil.TabletManagerClient{
-//			...
-//		}, func(ts *topo.Server) vtctlservicepb.VtctldServer { return NewVtctldServer(ts) })
-//
+//	vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, &testutil.TabletManagerClient{
+//		...
+//	}, func(ts *topo.Server) vtctlservicepb.VtctldServer { return NewVtctldServer(ts) })
 func NewVtctldServerWithTabletManagerClient(t *testing.T, ts *topo.Server, tmc tmclient.TabletManagerClient, newVtctldServerFn func(ts *topo.Server) vtctlservicepb.VtctldServer) vtctlservicepb.VtctldServer {
 	tmclientFactoryLock.Lock()
 	defer tmclientFactoryLock.Unlock()
@@ -135,6 +137,11 @@ type TabletManagerClient struct {
 		Error  error
 	}
 	// keyed by tablet alias.
+	ExecuteFetchAsDbaResults map[string]struct {
+		Response *querypb.QueryResult
+		Error    error
+	}
+	// keyed by tablet alias.
 	GetSchemaDelays map[string]time.Duration
 	// keyed by tablet alias.
 	GetSchemaResults map[string]struct {
@@ -142,6 +149,11 @@ type TabletManagerClient struct {
 		Error  error
 	}
 	// keyed by tablet alias.
+	KillQueriesResults map[string]struct {
+		Killed int64
+		Error  error
+	}
+	// keyed by tablet alias.
 	MasterPositionDelays map[string]time.Duration
 	// keyed by tablet alias.
 	MasterPositionResults map[string]struct {
@@ -163,13 +175,25 @@ type TabletManagerClient struct {
 		Error  error
 	}
 	// keyed by tablet alias.
-	RefreshStateResults      map[string]error
+	RefreshStateResults map[string]error
+	// keyed by tablet alias.
 	ReplicationStatusDelays  map[string]time.Duration
 	ReplicationStatusResults map[string]struct {
 		Position *replicationdatapb.Status
 		Error    error
 	}
 	// keyed by tablet alias.
+	GetRestoreProgressResults map[string]struct {
+		Progress *tabletmanagerdatapb.RestoreProgress
+		Error    error
+	}
+	// keyed by tablet alias.
+	RestoreFromBackupResults map[string]struct {
+		Events       []*logutilpb.Event
+		EventsError  error
+		RestoreError error
+	}
+	// keyed by tablet alias.
 	SetMasterDelays map[string]time.Duration
 	// keyed by tablet alias.
 	// TODO(deepthi): fix after v12.0
@@ -179,6 +203,10 @@ type TabletManagerClient struct {
 	// keyed by tablet alias.
 	SetReadWriteResults map[string]error
 	// keyed by tablet alias.
+	StartReplicationResults map[string]error
+	// keyed by tablet alias.
+	StopReplicationResults map[string]error
+	// keyed by tablet alias.
 	StopReplicationAndGetStatusDelays map[string]time.Duration
 	// keyed by tablet alias.
 	StopReplicationAndGetStatusResults map[string]struct {
@@ -207,6 +235,31 @@ type TabletManagerClient struct {
 	WaitForPositionResults map[string]map[string]error
 }
 
+// ChangeTags is part of the tmclient.TabletManagerClient interface.
+func (fake *TabletManagerClient) ChangeTags(ctx context.Context, tablet *topodatapb.Tablet, tags map[string]string, replace bool) (map[string]string, error) {
+	if fake.TopoServer == nil {
+		return nil, assert.AnError
+	}
+
+	updated, err := fake.TopoServer.UpdateTabletFields(ctx, tablet.Alias, func(t *topodatapb.Tablet) error {
+		if replace || t.Tags == nil {
+			t.Tags = make(map[string]string)
+		}
+		for name, value := range tags {
+			if value == "" {
+				delete(t.Tags, name)
+				continue
+			}
+			t.Tags[name] = value
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updated.Tags, nil
+}
+
 // ChangeType is part of the tmclient.TabletManagerClient interface.
 func (fake *TabletManagerClient) ChangeType(ctx context.Context, tablet *topodatapb.Tablet, newType topodatapb.TabletType) error {
 	if fake.TopoServer == nil {
@@ -247,6 +300,34 @@ func (fake *TabletManagerClient) DemoteMaster(ctx context.Context, tablet *topod
 	return nil, assert.AnError
 }
 
+// ExecuteFetchAsDba is part of the tmclient.TabletManagerClient interface.
+func (fake *TabletManagerClient) ExecuteFetchAsDba(ctx context.Context, tablet *topodatapb.Tablet, usePool bool, query []byte, maxRows int, disableBinlogs bool, reloadSchema bool) (*querypb.QueryResult, error) {
+	if fake.ExecuteFetchAsDbaResults == nil {
+		return nil, fmt.Errorf("%w: no ExecuteFetchAsDba results on fake TabletManagerClient", assert.AnError)
+	}
+
+	key := topoproto.TabletAliasString(tablet.Alias)
+	if result, ok := fake.ExecuteFetchAsDbaResults[key]; ok {
+		return result.Response, result.Error
+	}
+
+	return nil, fmt.Errorf("%w: no ExecuteFetchAsDba result set for tablet %s", assert.AnError, key)
+}
+
+// KillQueries is part of the tmclient.TabletManagerClient interface.
+func (fake *TabletManagerClient) KillQueries(ctx context.Context, tablet *topodatapb.Tablet, fingerprint, user string) (int64, error) {
+	if fake.KillQueriesResults == nil {
+		return 0, fmt.Errorf("%w: no KillQueries results on fake TabletManagerClient", assert.AnError)
+	}
+
+	key := topoproto.TabletAliasString(tablet.Alias)
+	if result, ok := fake.KillQueriesResults[key]; ok {
+		return result.Killed, result.Error
+	}
+
+	return 0, fmt.Errorf("%w: no KillQueries result set for tablet %s", assert.AnError, key)
+}
+
 // GetSchema is part of the tmclient.TabletManagerClient interface.
 func (fake *TabletManagerClient) GetSchema(ctx context.Context, tablet *topodatapb.Tablet, tablets []string, excludeTables []string, includeViews bool) (*tabletmanagerdatapb.SchemaDefinition, error) {
 	if fake.GetSchemaResults == nil {
@@ -409,6 +490,66 @@ func (fake *TabletManagerClient) ReplicationStatus(ctx context.Context, tablet *
 	return nil, assert.AnError
 }
 
+// RestoreFromBackup is part of the tmclient.TabletManagerClient interface.
+func (fake *TabletManagerClient) RestoreFromBackup(ctx context.Context, tablet *topodatapb.Tablet) (logutil.EventStream, error) {
+	if fake.RestoreFromBackupResults == nil {
+		return nil, assert.AnError
+	}
+
+	if tablet.Alias == nil {
+		return nil, assert.AnError
+	}
+
+	key := topoproto.TabletAliasString(tablet.Alias)
+	result, ok := fake.RestoreFromBackupResults[key]
+	if !ok {
+		return nil, assert.AnError
+	}
+
+	if result.RestoreError != nil {
+		return nil, result.RestoreError
+	}
+
+	return &eventStream{events: result.Events, err: result.EventsError}, nil
+}
+
+// GetRestoreProgress is part of the tmclient.TabletManagerClient interface.
+func (fake *TabletManagerClient) GetRestoreProgress(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.RestoreProgress, error) {
+	if fake.GetRestoreProgressResults == nil {
+		return nil, fmt.Errorf("%w: no GetRestoreProgress results on fake TabletManagerClient", assert.AnError)
+	}
+
+	key := topoproto.TabletAliasString(tablet.Alias)
+	if result, ok := fake.GetRestoreProgressResults[key]; ok {
+		return result.Progress, result.Error
+	}
+
+	return nil, fmt.Errorf("%w: no GetRestoreProgress result set for tablet %s", assert.AnError, key)
+}
+
+// eventStream is a logutil.EventStream returning a fixed set of events,
+// followed by err, or io.EOF if err is nil.
+type eventStream struct {
+	events []*logutilpb.Event
+	err    error
+}
+
+// Recv is part of the logutil.EventStream interface.
+func (stream *eventStream) Recv() (*logutilpb.Event, error) {
+	if len(stream.events) == 0 {
+		if stream.err != nil {
+			return nil, stream.err
+		}
+
+		return nil, io.EOF
+	}
+
+	e := stream.events[0]
+	stream.events = stream.events[1:]
+
+	return e, nil
+}
+
 // SetMaster is part of the tmclient.TabletManagerClient interface.
 func (fake *TabletManagerClient) SetMaster(ctx context.Context, tablet *topodatapb.Tablet, parent *topodatapb.TabletAlias, timeCreatedNS int64, waitPosition string, forceStartReplication bool) error {
 	if fake.SetMasterResults == nil {
@@ -435,6 +576,19 @@ func (fake *TabletManagerClient) SetMaster(ctx context.Context, tablet *topodata
 	return assert.AnError
 }
 
+// SetReplicationDelay is part of the tmclient.TabletManagerClient interface.
+func (fake *TabletManagerClient) SetReplicationDelay(ctx context.Context, tablet *topodatapb.Tablet, delay time.Duration) error {
+	if fake.TopoServer == nil {
+		return assert.AnError
+	}
+
+	_, err := fake.TopoServer.UpdateTabletFields(ctx, tablet.Alias, func(t *topodatapb.Tablet) error {
+		t.ReplicationDelaySeconds = int32(delay / time.Second)
+		return nil
+	})
+	return err
+}
+
 // SetReadWrite is part of the tmclient.TabletManagerClient interface.
 func (fake *TabletManagerClient) SetReadWrite(ctx context.Context, tablet *topodatapb.Tablet) error {
 	if fake.SetReadWriteResults == nil {
@@ -465,6 +619,34 @@ func (fake *TabletManagerClient) SetReadWrite(ctx context.Context, tablet *topod
 	return assert.AnError
 }
 
+// StartReplication is part of the tmclient.TabletManagerClient interface.
+func (fake *TabletManagerClient) StartReplication(ctx context.Context, tablet *topodatapb.Tablet) error {
+	if fake.StartReplicationResults == nil {
+		return fmt.Errorf("%w: no StartReplication results on fake TabletManagerClient", assert.AnError)
+	}
+
+	key := topoproto.TabletAliasString(tablet.Alias)
+	if err, ok := fake.StartReplicationResults[key]; ok {
+		return err
+	}
+
+	return fmt.Errorf("%w: no StartReplication result set for tablet %s", assert.AnError, key)
+}
+
+// StopReplication is part of the tmclient.TabletManagerClient interface.
+func (fake *TabletManagerClient) StopReplication(ctx context.Context, tablet *topodatapb.Tablet) error {
+	if fake.StopReplicationResults == nil {
+		return fmt.Errorf("%w: no StopReplication results on fake TabletManagerClient", assert.AnError)
+	}
+
+	key := topoproto.TabletAliasString(tablet.Alias)
+	if err, ok := fake.StopReplicationResults[key]; ok {
+		return err
+	}
+
+	return fmt.Errorf("%w: no StopReplication result set for tablet %s", assert.AnError, key)
+}
+
 // StopReplicationAndGetStatus is part of the tmclient.TabletManagerClient
 // interface.
 func (fake *TabletManagerClient) StopReplicationAndGetStatus(ctx context.Context, tablet *topodatapb.Tablet, mode replicationdatapb.StopReplicationMode) (*replicationdatapb.Status, *replicationdatapb.StopReplicationStatus, error) {
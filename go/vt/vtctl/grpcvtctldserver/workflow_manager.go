@@ -0,0 +1,98 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcvtctldserver
+
+import (
+	"context"
+
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+	"vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// WorkflowManager creates and drives the VReplication workflows of the
+// VtctldServer. The workflows are implemented by the wrangler, which depends
+// on this package, so the wrangler registers the WorkflowManager.
+//
+// The requests are validated by the VtctldServer before they are passed to
+// the WorkflowManager.
+type WorkflowManager interface {
+	MaterializeCreate(ctx context.Context, req *vtctldatapb.MaterializeCreateRequest) (*vtctldatapb.MaterializeCreateResponse, error)
+	MoveTablesCreate(ctx context.Context, req *vtctldatapb.MoveTablesCreateRequest) (*vtctldatapb.MoveTablesCreateResponse, error)
+	ReferenceTablesCreate(ctx context.Context, req *vtctldatapb.ReferenceTablesCreateRequest) (*vtctldatapb.ReferenceTablesCreateResponse, error)
+	ReferenceTablesDelete(ctx context.Context, req *vtctldatapb.ReferenceTablesDeleteRequest) (*vtctldatapb.ReferenceTablesDeleteResponse, error)
+	ReferenceTablesRefresh(ctx context.Context, req *vtctldatapb.ReferenceTablesRefreshRequest) (*vtctldatapb.ReferenceTablesRefreshResponse, error)
+	ReshardCreate(ctx context.Context, req *vtctldatapb.ReshardCreateRequest) (*vtctldatapb.ReshardCreateResponse, error)
+	VDiff(ctx context.Context, req *vtctldatapb.VDiffRequest) (*vtctldatapb.VDiffResponse, error)
+	WorkflowCancel(ctx context.Context, req *vtctldatapb.WorkflowCancelRequest) (*vtctldatapb.WorkflowCancelResponse, error)
+	WorkflowComplete(ctx context.Context, req *vtctldatapb.WorkflowCompleteRequest) (*vtctldatapb.WorkflowCompleteResponse, error)
+	WorkflowPause(ctx context.Context, req *vtctldatapb.WorkflowPauseRequest) (*vtctldatapb.WorkflowPauseResponse, error)
+	WorkflowResume(ctx context.Context, req *vtctldatapb.WorkflowResumeRequest) (*vtctldatapb.WorkflowResumeResponse, error)
+	WorkflowSwitchTraffic(ctx context.Context, req *vtctldatapb.WorkflowSwitchTrafficRequest) (*vtctldatapb.WorkflowSwitchTrafficResponse, error)
+}
+
+// WorkflowManagerFactory creates a WorkflowManager with the topo server and
+// the tablet manager client of a VtctldServer.
+type WorkflowManagerFactory func(ts *topo.Server, tmc tmclient.TabletManagerClient) WorkflowManager
+
+var workflowManagerFactory WorkflowManagerFactory
+
+// RegisterWorkflowManagerFactory registers the WorkflowManagerFactory. It
+// may only be called once, from an init function.
+func RegisterWorkflowManagerFactory(factory WorkflowManagerFactory) {
+	if workflowManagerFactory != nil {
+		log.Fatalf("RegisterWorkflowManagerFactory: a WorkflowManagerFactory is already registered")
+	}
+	workflowManagerFactory = factory
+}
+
+// workflowManager returns the WorkflowManager of the server, or an
+// UNIMPLEMENTED error if none is registered in this binary.
+func (s *VtctldServer) workflowManager() (WorkflowManager, error) {
+	if workflowManagerFactory == nil {
+		return nil, vterrors.Errorf(vtrpc.Code_UNIMPLEMENTED, "no WorkflowManager is registered in this binary")
+	}
+	return workflowManagerFactory(s.ts, s.tmc), nil
+}
+
+// validateNewWorkflowRequest validates the common fields of the requests
+// which create a workflow.
+func validateNewWorkflowRequest(workflow, sourceKeyspace, targetKeyspace string) error {
+	if workflow == "" {
+		return vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "workflow name is required")
+	}
+
+	if sourceKeyspace == "" || targetKeyspace == "" {
+		return vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "source and target keyspaces are required")
+	}
+
+	return nil
+}
+
+// validateWorkflowRequest validates the common fields of the requests on an
+// existing workflow.
+func validateWorkflowRequest(keyspace, workflow string) error {
+	if keyspace == "" || workflow == "" {
+		return vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "keyspace and workflow are required")
+	}
+
+	return nil
+}
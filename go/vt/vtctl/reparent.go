@@ -22,8 +22,10 @@ import (
 
 	"context"
 
+	"vitess.io/vitess/go/flagutil"
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vtctl/reparentutil"
 	"vitess.io/vitess/go/vt/wrangler"
 
 	"vitess.io/vitess/go/vt/mysqlctl"
@@ -54,8 +56,8 @@ func init() {
 	addCommand("Shards", command{
 		"EmergencyReparentShard",
 		commandEmergencyReparentShard,
-		"-keyspace_shard=<keyspace/shard> [-new_primary=<tablet alias>] [-wait_replicas_timeout=<duration>] [-ignore_replicas=<tablet alias list>]",
-		"Reparents the shard to the new primary. Assumes the old primary is dead and not responding."})
+		"-keyspace_shard=<keyspace/shard> [-new_primary=<tablet alias>] [-wait_replicas_timeout=<duration>] [-ignore_replicas=<tablet alias list>] [-preferred_cells=<cell list>] [-prevent_cross_cell_promotion] [-avoid_primary_host] [-anti_affinity_tags=<tag name list>] [-required_tags=<name:value list>] [-max_lost_transactions=<count>]",
+		"Reparents the shard to the new primary. Assumes the old primary is dead and not responding. Without -new_primary, the new primary is the best candidate under the candidate selection rules."})
 	addCommand("Shards", command{
 		"TabletExternallyReparented",
 		commandTabletExternallyReparented,
@@ -170,6 +172,14 @@ func commandEmergencyReparentShard(ctx context.Context, wr *wrangler.Wrangler, s
 	keyspaceShard := subFlags.String("keyspace_shard", "", "keyspace/shard of the shard that needs to be reparented")
 	newPrimary := subFlags.String("new_primary", "", "optional alias of a tablet that should be the new primary. If not specified, Vitess will select the best candidate")
 	ignoreReplicasList := subFlags.String("ignore_replicas", "", "comma-separated list of replica tablet aliases to ignore during emergency reparent")
+	var preferredCells, antiAffinityTags flagutil.StringListValue
+	subFlags.Var(&preferredCells, "preferred_cells", "comma-separated list of cells whose candidates are preferred, in order")
+	preventCrossCellPromotion := subFlags.Bool("prevent_cross_cell_promotion", false, "only promote a candidate in the cell of the failed primary")
+	avoidPrimaryHost := subFlags.Bool("avoid_primary_host", false, "avoid the candidates on the host of the failed primary")
+	subFlags.Var(&antiAffinityTags, "anti_affinity_tags", "comma-separated list of tablet tag names, such as rack, whose values should differ from the ones of the failed primary")
+	var requiredTags flagutil.StringMapValue
+	subFlags.Var(&requiredTags, "required_tags", "comma-separated list of name:value tablet tags that the candidates must have")
+	maxLostTransactions := subFlags.Int64("max_lost_transactions", 0, "number of transactions a candidate may be missing, relative to the most advanced candidate, and still be promoted for the other rules. The transactions it is missing are lost")
 
 	// handle deprecated flags
 	// should be deleted in a future release
@@ -205,7 +215,15 @@ func commandEmergencyReparentShard(ctx context.Context, wr *wrangler.Wrangler, s
 		}
 	}
 	unreachableReplicas := topoproto.ParseTabletSet(*ignoreReplicasList)
-	return wr.EmergencyReparentShard(ctx, keyspace, shard, tabletAlias, *waitReplicasTimeout, unreachableReplicas)
+	rules := reparentutil.CandidateSelectionRules{
+		PreferredCells:            preferredCells,
+		PreventCrossCellPromotion: *preventCrossCellPromotion,
+		AvoidPrimaryHost:          *avoidPrimaryHost,
+		AntiAffinityTags:          antiAffinityTags,
+		RequiredTags:              requiredTags,
+		MaxLostTransactions:       *maxLostTransactions,
+	}
+	return wr.EmergencyReparentShard(ctx, keyspace, shard, tabletAlias, *waitReplicasTimeout, unreachableReplicas, rules)
 }
 
 func commandTabletExternallyReparented(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
@@ -0,0 +1,240 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reparentutil
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vtctl/reparentutil/promotionrule"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+	"vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// CandidateSelectionRules are the rules by which an emergency reparent
+// chooses the new primary among the valid candidates.
+//
+// The candidates which the rules do not allow are not eligible. The
+// eligible candidates are ranked by:
+//  1. the durability policy allowing to promote them, since the durability
+//     policy checks fail the reparent otherwise,
+//  2. the number of anti-affinity rules they break,
+//  3. the order of their cell in PreferredCells, and
+//  4. the promotion rule of the durability policy,
+//
+// and then by the number of transactions they are missing.
+type CandidateSelectionRules struct {
+	// PreferredCells are the cells whose candidates are preferred, in order.
+	PreferredCells []string
+	// PreventCrossCellPromotion restricts the candidates to the cell of the
+	// failed primary.
+	PreventCrossCellPromotion bool
+	// AvoidPrimaryHost avoids the candidates on the host of the failed
+	// primary.
+	AvoidPrimaryHost bool
+	// AntiAffinityTags are tablet tag names, such as "rack": the candidates
+	// with the same values of these tags as the failed primary are avoided.
+	AntiAffinityTags []string
+	// RequiredTags restricts the candidates to the tablets with these tags.
+	RequiredTags map[string]string
+	// MaxLostTransactions is the number of transactions a candidate may be
+	// missing, relative to the most advanced candidate, and still be chosen.
+	// The transactions it is missing are lost, and the replicas which
+	// applied them have errant GTIDs afterwards.
+	MaxLostTransactions int64
+}
+
+// rankedCandidate is a candidate with the keys by which it is ranked.
+type rankedCandidate struct {
+	explanation   *vtctldatapb.EmergencyReparentCandidateSelection_Candidate
+	mustNot       bool
+	antiAffinity  int
+	cellRank      int
+	promotionRule promotionrule.CandidatePromotionRule
+}
+
+func (c *rankedCandidate) betterThan(other *rankedCandidate) bool {
+	if c.mustNot != other.mustNot {
+		return !c.mustNot
+	}
+	if c.antiAffinity != other.antiAffinity {
+		return c.antiAffinity < other.antiAffinity
+	}
+	if c.cellRank != other.cellRank {
+		return c.cellRank < other.cellRank
+	}
+	if c.promotionRule != other.promotionRule {
+		return c.promotionRule.BetterThan(other.promotionRule)
+	}
+	if c.explanation.MissingTransactions != other.explanation.MissingTransactions {
+		return c.explanation.MissingTransactions < other.explanation.MissingTransactions
+	}
+	return topoproto.TabletAliasString(c.explanation.Alias) < topoproto.TabletAliasString(other.explanation.Alias)
+}
+
+// ChooseEmergencyReparentCandidate chooses the new primary among the valid
+// candidates of an emergency reparent, keyed by tablet alias, under the
+// rules and the durability policy, which may be nil. failedPrimary is the
+// tablet of the failed primary, or nil if it is not known. positions are
+// the positions of the candidates, or nil if they are all equally advanced.
+//
+// The selection explains the choice, and is returned even when no
+// candidate is eligible, along with a FAILED_PRECONDITION error.
+func ChooseEmergencyReparentCandidate(
+	rules CandidateSelectionRules,
+	durability Durabler,
+	failedPrimary *topodatapb.Tablet,
+	candidates map[string]*topodatapb.Tablet,
+	positions map[string]mysql.Position,
+) (*vtctldatapb.EmergencyReparentCandidateSelection, error) {
+	var winningPosition mysql.Position
+	for alias := range candidates {
+		if position := positions[alias]; winningPosition.IsZero() || position.AtLeast(winningPosition) {
+			winningPosition = position
+		}
+	}
+
+	var eligible, ineligible []*rankedCandidate
+	for alias, tablet := range candidates {
+		candidate := &rankedCandidate{
+			explanation: &vtctldatapb.EmergencyReparentCandidateSelection_Candidate{
+				Alias:               tablet.Alias,
+				MissingTransactions: missingTransactions(positions[alias], winningPosition),
+				Eligible:            true,
+			},
+			cellRank:      len(rules.PreferredCells),
+			promotionRule: promotionrule.Neutral,
+		}
+		explanation := candidate.explanation
+		if positions != nil {
+			explanation.Position = mysql.EncodePosition(positions[alias])
+		}
+		notEligible := func(format string, args ...interface{}) {
+			explanation.Eligible = false
+			explanation.Reasons = append(explanation.Reasons, fmt.Sprintf(format, args...))
+		}
+
+		switch missing := explanation.MissingTransactions; {
+		case missing < 0:
+			notEligible("it is behind the most advanced candidate by an unknown number of transactions")
+		case missing > rules.MaxLostTransactions:
+			notEligible("it is missing %d transactions, more than the maximum of %d", missing, rules.MaxLostTransactions)
+		case missing > 0:
+			explanation.Reasons = append(explanation.Reasons, fmt.Sprintf("it is missing %d transactions", missing))
+		}
+		if tablet.ReplicationDelaySeconds > 0 {
+			notEligible("it is a delayed replica")
+		}
+		if rules.PreventCrossCellPromotion && failedPrimary != nil && tablet.Alias.Cell != failedPrimary.Alias.Cell {
+			notEligible("it is not in the cell %v of the failed primary", failedPrimary.Alias.Cell)
+		}
+		for _, name := range sortedTagNames(rules.RequiredTags) {
+			if value, ok := tablet.Tags[name]; !ok || value != rules.RequiredTags[name] {
+				notEligible("it does not have the required tag %v=%v", name, rules.RequiredTags[name])
+			}
+		}
+
+		if durability != nil {
+			candidate.promotionRule = durability.PromotionRule(tablet)
+			if candidate.promotionRule == promotionrule.MustNot {
+				candidate.mustNot = true
+				explanation.Reasons = append(explanation.Reasons, "the durability policy does not allow to promote it")
+			} else if candidate.promotionRule != promotionrule.Neutral {
+				explanation.Reasons = append(explanation.Reasons, fmt.Sprintf("its promotion rule is %v", candidate.promotionRule))
+			}
+		}
+		if failedPrimary != nil {
+			if rules.AvoidPrimaryHost && tablet.Hostname != "" && tablet.Hostname == failedPrimary.Hostname {
+				candidate.antiAffinity++
+				explanation.Reasons = append(explanation.Reasons, fmt.Sprintf("it is on the host %v of the failed primary", tablet.Hostname))
+			}
+			for _, name := range rules.AntiAffinityTags {
+				if value, ok := failedPrimary.Tags[name]; ok && tablet.Tags[name] == value {
+					candidate.antiAffinity++
+					explanation.Reasons = append(explanation.Reasons, fmt.Sprintf("it has the same tag %v=%v as the failed primary", name, value))
+				}
+			}
+		}
+		for i, cell := range rules.PreferredCells {
+			if tablet.Alias.Cell == cell {
+				candidate.cellRank = i
+				explanation.Reasons = append(explanation.Reasons, fmt.Sprintf("it is in the preferred cell %v", cell))
+				break
+			}
+		}
+
+		if explanation.Eligible {
+			eligible = append(eligible, candidate)
+		} else {
+			ineligible = append(ineligible, candidate)
+		}
+	}
+
+	sort.Slice(eligible, func(i, j int) bool { return eligible[i].betterThan(eligible[j]) })
+	sort.Slice(ineligible, func(i, j int) bool { return ineligible[i].betterThan(ineligible[j]) })
+
+	selection := &vtctldatapb.EmergencyReparentCandidateSelection{}
+	for _, candidate := range append(eligible, ineligible...) {
+		selection.Candidates = append(selection.Candidates, candidate.explanation)
+	}
+	if len(eligible) == 0 {
+		selection.Reason = fmt.Sprintf("none of the %d valid candidates satisfies the candidate selection rules", len(candidates))
+		return selection, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "%v", selection.Reason)
+	}
+
+	chosen := eligible[0].explanation
+	selection.Chosen = chosen.Alias
+	selection.Reason = fmt.Sprintf("%v is the best of %d eligible candidates", topoproto.TabletAliasString(chosen.Alias), len(eligible))
+	if len(chosen.Reasons) != 0 {
+		selection.Reason += ": " + strings.Join(chosen.Reasons, ", ")
+	}
+	return selection, nil
+}
+
+// missingTransactions returns the number of transactions of the winning
+// position which are not in the position, or -1 if they cannot be counted.
+func missingTransactions(position mysql.Position, winningPosition mysql.Position) int64 {
+	if position.AtLeast(winningPosition) {
+		return 0
+	}
+	winningGTIDSet, ok := winningPosition.GTIDSet.(mysql.Mysql56GTIDSet)
+	if !ok {
+		return -1
+	}
+	gtidSet := mysql.Mysql56GTIDSet{}
+	if !position.IsZero() {
+		if gtidSet, ok = position.GTIDSet.(mysql.Mysql56GTIDSet); !ok {
+			return -1
+		}
+	}
+	return winningGTIDSet.Difference(gtidSet).Count()
+}
+
+func sortedTagNames(tags map[string]string) []string {
+	names := make([]string, 0, len(tags))
+	for name := range tags {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
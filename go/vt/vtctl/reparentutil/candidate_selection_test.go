@@ -0,0 +1,196 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reparentutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func TestChooseEmergencyReparentCandidate(t *testing.T) {
+	t.Parallel()
+
+	mustPosition := func(s string) mysql.Position {
+		position, err := mysql.DecodePosition(s)
+		require.NoError(t, err)
+		return position
+	}
+
+	failedPrimary := &topodatapb.Tablet{
+		Alias:    &topodatapb.TabletAlias{Cell: "zone1", Uid: 100},
+		Hostname: "host1",
+		Tags:     map[string]string{"rack": "r1"},
+	}
+	candidates := map[string]*topodatapb.Tablet{
+		"zone1-0000000101": {
+			Alias:    &topodatapb.TabletAlias{Cell: "zone1", Uid: 101},
+			Type:     topodatapb.TabletType_REPLICA,
+			Hostname: "host1",
+			Tags:     map[string]string{"rack": "r2"},
+		},
+		"zone1-0000000102": {
+			Alias:    &topodatapb.TabletAlias{Cell: "zone1", Uid: 102},
+			Type:     topodatapb.TabletType_REPLICA,
+			Hostname: "host2",
+			Tags:     map[string]string{"rack": "r1"},
+		},
+		"zone2-0000000200": {
+			Alias:    &topodatapb.TabletAlias{Cell: "zone2", Uid: 200},
+			Type:     topodatapb.TabletType_REPLICA,
+			Hostname: "host3",
+			Tags:     map[string]string{"rack": "r3", "ssd": "true"},
+		},
+		"zone2-0000000201": {
+			Alias:    &topodatapb.TabletAlias{Cell: "zone2", Uid: 201},
+			Type:     topodatapb.TabletType_RDONLY,
+			Hostname: "host4",
+		},
+	}
+	positions := map[string]mysql.Position{
+		"zone1-0000000101": mustPosition("MySQL56/3E11FA47-71CA-11E1-9E33-C80AA9429562:1-26"),
+		"zone1-0000000102": mustPosition("MySQL56/3E11FA47-71CA-11E1-9E33-C80AA9429562:1-26"),
+		"zone2-0000000200": mustPosition("MySQL56/3E11FA47-71CA-11E1-9E33-C80AA9429562:1-24"),
+		"zone2-0000000201": mustPosition("MySQL56/3E11FA47-71CA-11E1-9E33-C80AA9429562:1-26"),
+	}
+	semiSync, err := GetDurabilityPolicy("semi_sync", nil)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		rules      CandidateSelectionRules
+		durability Durabler
+		positions  map[string]mysql.Position
+		chosen     string
+		shouldErr  bool
+	}{
+		{
+			name:      "most advanced candidate by default",
+			positions: positions,
+			chosen:    "zone1-0000000101",
+		},
+		{
+			name:       "durability policy",
+			durability: semiSync,
+			positions: map[string]mysql.Position{
+				"zone1-0000000101": positions["zone2-0000000200"],
+				"zone1-0000000102": positions["zone2-0000000200"],
+				"zone2-0000000200": positions["zone2-0000000200"],
+				"zone2-0000000201": positions["zone2-0000000201"],
+			},
+			// The rdonly tablet is the most advanced, but it cannot be
+			// promoted, and the other candidates are missing transactions.
+			chosen: "zone2-0000000201",
+		},
+		{
+			name:      "avoid the host of the failed primary",
+			rules:     CandidateSelectionRules{AvoidPrimaryHost: true},
+			positions: positions,
+			chosen:    "zone1-0000000102",
+		},
+		{
+			name:      "avoid the host and the rack of the failed primary",
+			rules:     CandidateSelectionRules{AvoidPrimaryHost: true, AntiAffinityTags: []string{"rack"}},
+			positions: positions,
+			chosen:    "zone2-0000000201",
+		},
+		{
+			name:      "preferred cells",
+			rules:     CandidateSelectionRules{PreferredCells: []string{"zone2", "zone1"}},
+			positions: positions,
+			chosen:    "zone2-0000000201",
+		},
+		{
+			name:      "preferred cells with lost transactions",
+			rules:     CandidateSelectionRules{PreferredCells: []string{"zone2"}, RequiredTags: map[string]string{"ssd": "true"}, MaxLostTransactions: 2},
+			positions: positions,
+			chosen:    "zone2-0000000200",
+		},
+		{
+			name:      "too many lost transactions",
+			rules:     CandidateSelectionRules{RequiredTags: map[string]string{"ssd": "true"}, MaxLostTransactions: 1},
+			positions: positions,
+			shouldErr: true,
+		},
+		{
+			name:      "prevent cross cell promotion",
+			rules:     CandidateSelectionRules{PreventCrossCellPromotion: true, PreferredCells: []string{"zone2"}},
+			positions: positions,
+			chosen:    "zone1-0000000101",
+		},
+		{
+			name:   "equally advanced candidates",
+			rules:  CandidateSelectionRules{PreferredCells: []string{"zone2"}, AntiAffinityTags: []string{"rack"}},
+			chosen: "zone2-0000000200",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			selection, err := ChooseEmergencyReparentCandidate(tt.rules, tt.durability, failedPrimary, candidates, tt.positions)
+			require.NotNil(t, selection)
+			assert.Len(t, selection.Candidates, len(candidates))
+			assert.NotEmpty(t, selection.Reason)
+			if tt.shouldErr {
+				assert.Error(t, err)
+				assert.Nil(t, selection.Chosen)
+				for _, candidate := range selection.Candidates {
+					assert.False(t, candidate.Eligible)
+					assert.NotEmpty(t, candidate.Reasons)
+				}
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.chosen, topoproto.TabletAliasString(selection.Chosen))
+			assert.Equal(t, selection.Chosen, selection.Candidates[0].Alias)
+			assert.True(t, selection.Candidates[0].Eligible)
+		})
+	}
+
+	t.Run("delayed replica", func(t *testing.T) {
+		t.Parallel()
+
+		delayed := make(map[string]*topodatapb.Tablet, len(candidates))
+		for alias, tablet := range candidates {
+			delayed[alias] = tablet
+		}
+		delayed["zone1-0000000101"] = proto.Clone(candidates["zone1-0000000101"]).(*topodatapb.Tablet)
+		delayed["zone1-0000000101"].ReplicationDelaySeconds = 3600
+
+		selection, err := ChooseEmergencyReparentCandidate(CandidateSelectionRules{}, nil, failedPrimary, delayed, positions)
+		require.NoError(t, err)
+		assert.Equal(t, "zone1-0000000102", topoproto.TabletAliasString(selection.Chosen))
+		for _, candidate := range selection.Candidates {
+			if topoproto.TabletAliasString(candidate.Alias) == "zone1-0000000101" {
+				assert.False(t, candidate.Eligible)
+				assert.Contains(t, candidate.Reasons, "it is a delayed replica")
+			}
+		}
+	})
+}
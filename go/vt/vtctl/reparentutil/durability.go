@@ -0,0 +1,304 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reparentutil
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vtctl/reparentutil/promotionrule"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+//=======================================================================
+
+// A NewDurabler is a function that creates a new Durabler based on the
+// properties specified in the input map. Every Durabler must
+// register a NewDurabler function.
+type NewDurabler func(map[string]string) Durabler
+
+var (
+	// durabilityPolicies is a map that stores the functions needed to create a new Durabler
+	durabilityPolicies = make(map[string]NewDurabler)
+	// durabilityPoliciesWithParams are the durability policies which need
+	// parameters, and so can not be the policies of keyspaces.
+	durabilityPoliciesWithParams = make(map[string]bool)
+)
+
+func init() {
+	// register all the durability rules with their functions to create them
+	RegisterDurability("none", func(map[string]string) Durabler {
+		return &durabilityNone{}
+	})
+	RegisterDurability("semi_sync", func(map[string]string) Durabler {
+		return &durabilitySemiSync{}
+	})
+	RegisterDurability("cross_cell", func(map[string]string) Durabler {
+		return &durabilityCrossCell{}
+	})
+	RegisterDurabilityWithParams("specified", newDurabilitySpecified)
+}
+
+// Durabler is the interface which is used to get the promotion rules for
+// candidates and the semi sync setup of a durability policy.
+type Durabler interface {
+	// PromotionRule returns the promotion rule of the tablet.
+	PromotionRule(tablet *topodatapb.Tablet) promotionrule.CandidatePromotionRule
+	// SemiSyncAckers returns the number of semi-sync acks the tablet
+	// requires when it is the primary. 0 means no semi-sync.
+	SemiSyncAckers(tablet *topodatapb.Tablet) int
+	// IsReplicaSemiSync returns true if the replica acknowledges the
+	// transactions of the primary.
+	IsReplicaSemiSync(primary, replica *topodatapb.Tablet) bool
+}
+
+// RegisterDurability registers a durability policy. It must be called from
+// an init function.
+func RegisterDurability(name string, newDurablerFunc NewDurabler) {
+	if durabilityPolicies[name] != nil {
+		log.Fatalf("durability policy %v already registered", name)
+	}
+	durabilityPolicies[name] = newDurablerFunc
+}
+
+// RegisterDurabilityWithParams registers a durability policy which needs
+// parameters. It must be called from an init function.
+func RegisterDurabilityWithParams(name string, newDurablerFunc NewDurabler) {
+	RegisterDurability(name, newDurablerFunc)
+	durabilityPoliciesWithParams[name] = true
+}
+
+// GetDurabilityPolicy returns the durability policy of the given name.
+func GetDurabilityPolicy(name string, durabilityParams map[string]string) (Durabler, error) {
+	newDurabilityCreationFunc, found := durabilityPolicies[name]
+	if !found {
+		return nil, fmt.Errorf("durability policy %v not found", name)
+	}
+	return newDurabilityCreationFunc(durabilityParams), nil
+}
+
+// CheckDurabilityPolicyExists returns true if a durability policy of the
+// given name is registered.
+func CheckDurabilityPolicyExists(name string) bool {
+	_, found := durabilityPolicies[name]
+	return found
+}
+
+// CheckKeyspaceDurabilityPolicy returns an error if the durability policy of
+// the given name can not be the policy of a keyspace: it must be registered,
+// and not need parameters, as the keyspaces have none.
+func CheckKeyspaceDurabilityPolicy(name string) error {
+	if !CheckDurabilityPolicyExists(name) {
+		return fmt.Errorf("unknown durability policy %v", name)
+	}
+	if durabilityPoliciesWithParams[name] {
+		return fmt.Errorf("durability policy %v needs parameters, and can not be the policy of a keyspace", name)
+	}
+	return nil
+}
+
+// GetKeyspaceDurabilityPolicy returns the durability policy of the given
+// name, for a keyspace.
+func GetKeyspaceDurabilityPolicy(name string) (Durabler, error) {
+	if err := CheckKeyspaceDurabilityPolicy(name); err != nil {
+		return nil, err
+	}
+	return GetDurabilityPolicy(name, nil)
+}
+
+// DurabilityPolicyNames returns the names of the registered durability
+// policies.
+func DurabilityPolicyNames() []string {
+	names := make([]string, 0, len(durabilityPolicies))
+	for name := range durabilityPolicies {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// GetKeyspaceDurability returns the durability policy of a keyspace, or nil
+// if the keyspace has none, in which case the tablets use the
+// -enable_semi_sync flag and the reparents do not enforce any policy.
+func GetKeyspaceDurability(ctx context.Context, ts *topo.Server, keyspace string) (Durabler, error) {
+	ki, err := ts.GetKeyspace(ctx, keyspace)
+	switch {
+	case topo.IsErrType(err, topo.NoNode):
+		return nil, nil
+	case err != nil:
+		return nil, err
+	case ki.DurabilityPolicy == "":
+		return nil, nil
+	}
+	return GetKeyspaceDurabilityPolicy(ki.DurabilityPolicy)
+}
+
+// ValidateSemiSyncAckers returns an error if not enough of the replicas may
+// acknowledge the transactions of the primary under the durability policy,
+// in which case the writes would block once it is promoted.
+func ValidateSemiSyncAckers(durability Durabler, primary *topodatapb.Tablet, replicas []*topodatapb.Tablet) error {
+	ackers := durability.SemiSyncAckers(primary)
+	if ackers == 0 {
+		return nil
+	}
+	eligible := 0
+	for _, replica := range replicas {
+		if topoproto.TabletAliasEqual(replica.Alias, primary.Alias) {
+			continue
+		}
+		if durability.IsReplicaSemiSync(primary, replica) {
+			eligible++
+		}
+	}
+	if eligible < ackers {
+		return fmt.Errorf("primary %v requires %d semi-sync ackers, but only %d of its replicas may acknowledge its transactions", topoproto.TabletAliasString(primary.Alias), ackers, eligible)
+	}
+	return nil
+}
+
+//=======================================================================
+
+// durabilityNone has no semi-sync and returns Neutral for Primary and Replica tablet types, MustNot for everything else
+type durabilityNone struct{}
+
+func (d *durabilityNone) PromotionRule(tablet *topodatapb.Tablet) promotionrule.CandidatePromotionRule {
+	switch tablet.Type {
+	case topodatapb.TabletType_PRIMARY, topodatapb.TabletType_REPLICA:
+		return promotionrule.Neutral
+	}
+	return promotionrule.MustNot
+}
+
+func (d *durabilityNone) SemiSyncAckers(tablet *topodatapb.Tablet) int {
+	return 0
+}
+
+func (d *durabilityNone) IsReplicaSemiSync(primary, replica *topodatapb.Tablet) bool {
+	return false
+}
+
+//=======================================================================
+
+// durabilitySemiSync has 1 semi-sync setup. It only allows Primary and Replica type servers to acknowledge semi sync
+// It returns Neutral for Primary and Replica tablet types, MustNot for everything else
+type durabilitySemiSync struct{}
+
+func (d *durabilitySemiSync) PromotionRule(tablet *topodatapb.Tablet) promotionrule.CandidatePromotionRule {
+	switch tablet.Type {
+	case topodatapb.TabletType_PRIMARY, topodatapb.TabletType_REPLICA:
+		return promotionrule.Neutral
+	}
+	return promotionrule.MustNot
+}
+
+func (d *durabilitySemiSync) SemiSyncAckers(tablet *topodatapb.Tablet) int {
+	return 1
+}
+
+func (d *durabilitySemiSync) IsReplicaSemiSync(primary, replica *topodatapb.Tablet) bool {
+	switch replica.Type {
+	case topodatapb.TabletType_PRIMARY, topodatapb.TabletType_REPLICA:
+		return true
+	}
+	return false
+}
+
+//=======================================================================
+
+// durabilityCrossCell has 1 semi-sync setup. It only allows Primary and Replica type servers from a different cell to acknowledge semi sync.
+// This means that a transaction must be in two cells for it to be acknowledged
+// It returns Neutral for Primary and Replica tablet types, MustNot for everything else
+type durabilityCrossCell struct{}
+
+func (d *durabilityCrossCell) PromotionRule(tablet *topodatapb.Tablet) promotionrule.CandidatePromotionRule {
+	switch tablet.Type {
+	case topodatapb.TabletType_PRIMARY, topodatapb.TabletType_REPLICA:
+		return promotionrule.Neutral
+	}
+	return promotionrule.MustNot
+}
+
+func (d *durabilityCrossCell) SemiSyncAckers(tablet *topodatapb.Tablet) int {
+	return 1
+}
+
+func (d *durabilityCrossCell) IsReplicaSemiSync(primary, replica *topodatapb.Tablet) bool {
+	// Prevent panics.
+	if primary == nil || primary.Alias == nil || replica.Alias == nil {
+		return false
+	}
+	switch replica.Type {
+	case topodatapb.TabletType_PRIMARY, topodatapb.TabletType_REPLICA:
+		return primary.Alias.Cell != replica.Alias.Cell
+	}
+	return false
+}
+
+//=======================================================================
+
+// durabilitySpecified is like durabilityNone. It has an additional map which it first queries with the tablet alias as the key
+// If a CandidatePromotionRule is found in that map, then that is used as the promotion rule. Otherwise, it reverts to the same logic as durabilityNone
+type durabilitySpecified struct {
+	promotionRules map[string]promotionrule.CandidatePromotionRule
+}
+
+func (d *durabilitySpecified) PromotionRule(tablet *topodatapb.Tablet) promotionrule.CandidatePromotionRule {
+	promoteRule, isFound := d.promotionRules[topoproto.TabletAliasString(tablet.Alias)]
+	if isFound {
+		return promoteRule
+	}
+
+	switch tablet.Type {
+	case topodatapb.TabletType_PRIMARY, topodatapb.TabletType_REPLICA:
+		return promotionrule.Neutral
+	}
+	return promotionrule.MustNot
+}
+
+func (d *durabilitySpecified) SemiSyncAckers(tablet *topodatapb.Tablet) int {
+	return 0
+}
+
+func (d *durabilitySpecified) IsReplicaSemiSync(primary, replica *topodatapb.Tablet) bool {
+	return false
+}
+
+// newDurabilitySpecified is a function that is used to create a new durabilitySpecified struct
+func newDurabilitySpecified(m map[string]string) Durabler {
+	promotionRules := map[string]promotionrule.CandidatePromotionRule{}
+	// range over the map given by the user
+	for tabletAliasStr, promotionRuleStr := range m {
+		// parse the promotion rule
+		promotionRule, err := promotionrule.Parse(promotionRuleStr)
+		// if parsing is not successful, skip over this rule
+		if err != nil {
+			log.Errorf("invalid promotion rule %s found, received error - %v", promotionRuleStr, err)
+			continue
+		}
+		// set the promotion rule in the map at the given tablet alias
+		promotionRules[tabletAliasStr] = promotionRule
+	}
+
+	return &durabilitySpecified{
+		promotionRules: promotionRules,
+	}
+}
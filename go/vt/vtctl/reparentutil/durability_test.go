@@ -0,0 +1,166 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reparentutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vtctl/reparentutil/promotionrule"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func TestDurabilitySpecified(t *testing.T) {
+	cellName := "cell"
+	durabilityRules := newDurabilitySpecified(
+		map[string]string{
+			"cell-0000000000": string(promotionrule.Must),
+			"cell-0000000001": string(promotionrule.Prefer),
+			"cell-0000000002": string(promotionrule.Neutral),
+			"cell-0000000003": string(promotionrule.PreferNot),
+			"cell-0000000004": string(promotionrule.MustNot),
+		})
+
+	testcases := []struct {
+		tablet        *topodatapb.Tablet
+		promotionRule promotionrule.CandidatePromotionRule
+	}{
+		{
+			tablet: &topodatapb.Tablet{
+				Alias: &topodatapb.TabletAlias{
+					Cell: cellName,
+					Uid:  0,
+				},
+			},
+			promotionRule: promotionrule.MustNot,
+		}, {
+			tablet: &topodatapb.Tablet{
+				Alias: &topodatapb.TabletAlias{
+					Cell: cellName,
+					Uid:  1,
+				},
+			},
+			promotionRule: promotionrule.Prefer,
+		}, {
+			tablet: &topodatapb.Tablet{
+				Alias: &topodatapb.TabletAlias{
+					Cell: cellName,
+					Uid:  2,
+				},
+			},
+			promotionRule: promotionrule.Neutral,
+		}, {
+			tablet: &topodatapb.Tablet{
+				Alias: &topodatapb.TabletAlias{
+					Cell: cellName,
+					Uid:  3,
+				},
+			},
+			promotionRule: promotionrule.PreferNot,
+		}, {
+			tablet: &topodatapb.Tablet{
+				Alias: &topodatapb.TabletAlias{
+					Cell: cellName,
+					Uid:  4,
+				},
+			},
+			promotionRule: promotionrule.MustNot,
+		},
+	}
+
+	for _, testcase := range testcases {
+		t.Run(topoproto.TabletAliasString(testcase.tablet.Alias), func(t *testing.T) {
+			rule := durabilityRules.PromotionRule(testcase.tablet)
+			assert.Equal(t, testcase.promotionRule, rule)
+		})
+	}
+}
+
+func TestDurabilityCrossCell(t *testing.T) {
+	durability, err := GetDurabilityPolicy("cross_cell", nil)
+	require.NoError(t, err)
+
+	primary := &topodatapb.Tablet{Alias: &topodatapb.TabletAlias{Cell: "zone1", Uid: 100}, Type: topodatapb.TabletType_PRIMARY}
+	sameCell := &topodatapb.Tablet{Alias: &topodatapb.TabletAlias{Cell: "zone1", Uid: 101}, Type: topodatapb.TabletType_REPLICA}
+	otherCell := &topodatapb.Tablet{Alias: &topodatapb.TabletAlias{Cell: "zone2", Uid: 200}, Type: topodatapb.TabletType_REPLICA}
+	rdonly := &topodatapb.Tablet{Alias: &topodatapb.TabletAlias{Cell: "zone2", Uid: 201}, Type: topodatapb.TabletType_RDONLY}
+
+	assert.Equal(t, 1, durability.SemiSyncAckers(primary))
+	assert.False(t, durability.IsReplicaSemiSync(primary, sameCell))
+	assert.True(t, durability.IsReplicaSemiSync(primary, otherCell))
+	assert.False(t, durability.IsReplicaSemiSync(primary, rdonly))
+	assert.False(t, durability.IsReplicaSemiSync(nil, otherCell))
+	assert.Equal(t, promotionrule.Neutral, durability.PromotionRule(otherCell))
+	assert.Equal(t, promotionrule.MustNot, durability.PromotionRule(rdonly))
+}
+
+func TestGetKeyspaceDurabilityPolicy(t *testing.T) {
+	durability, err := GetKeyspaceDurabilityPolicy("semi_sync")
+	require.NoError(t, err)
+	assert.IsType(t, &durabilitySemiSync{}, durability)
+
+	_, err = GetKeyspaceDurabilityPolicy("unknown")
+	assert.EqualError(t, err, "unknown durability policy unknown")
+
+	// The keyspaces have no parameters for the promotion rules of specified.
+	_, err = GetKeyspaceDurabilityPolicy("specified")
+	assert.EqualError(t, err, "durability policy specified needs parameters, and can not be the policy of a keyspace")
+}
+
+func TestValidateSemiSyncAckers(t *testing.T) {
+	primary := &topodatapb.Tablet{Alias: &topodatapb.TabletAlias{Cell: "zone1", Uid: 100}, Type: topodatapb.TabletType_PRIMARY}
+	sameCell := &topodatapb.Tablet{Alias: &topodatapb.TabletAlias{Cell: "zone1", Uid: 101}, Type: topodatapb.TabletType_REPLICA}
+	otherCell := &topodatapb.Tablet{Alias: &topodatapb.TabletAlias{Cell: "zone2", Uid: 200}, Type: topodatapb.TabletType_RDONLY}
+
+	testcases := []struct {
+		policy   string
+		replicas []*topodatapb.Tablet
+		err      string
+	}{{
+		policy: "none",
+	}, {
+		policy:   "semi_sync",
+		replicas: []*topodatapb.Tablet{primary, sameCell},
+	}, {
+		policy:   "semi_sync",
+		replicas: []*topodatapb.Tablet{primary, otherCell},
+		err:      "primary zone1-0000000100 requires 1 semi-sync ackers, but only 0 of its replicas may acknowledge its transactions",
+	}, {
+		policy:   "cross_cell",
+		replicas: []*topodatapb.Tablet{sameCell},
+		err:      "requires 1 semi-sync ackers",
+	}}
+	for _, tc := range testcases {
+		durability, err := GetDurabilityPolicy(tc.policy, nil)
+		require.NoError(t, err)
+		err = ValidateSemiSyncAckers(durability, primary, tc.replicas)
+		if tc.err == "" {
+			assert.NoError(t, err, tc.policy)
+			continue
+		}
+		if assert.Error(t, err, tc.policy) {
+			assert.Contains(t, err.Error(), tc.err)
+		}
+	}
+
+	_, err := GetDurabilityPolicy("unknown", nil)
+	assert.EqualError(t, err, "durability policy unknown not found")
+}
@@ -39,6 +39,7 @@ import (
 	logutilpb "vitess.io/vitess/go/vt/proto/logutil"
 	replicationdatapb "vitess.io/vitess/go/vt/proto/replicationdata"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
 	"vitess.io/vitess/go/vt/proto/vtrpc"
 )
 
@@ -56,6 +57,9 @@ type EmergencyReparentOptions struct {
 	NewPrimaryAlias     *topodatapb.TabletAlias
 	IgnoreReplicas      sets.String
 	WaitReplicasTimeout time.Duration
+	// CandidateSelectionRules choose the new primary when NewPrimaryAlias is
+	// not set.
+	CandidateSelectionRules CandidateSelectionRules
 
 	// Private options managed internally. We use value passing to avoid leaking
 	// these details back out.
@@ -291,33 +295,77 @@ func (erp *EmergencyReparenter) reparentShardLocked(ctx context.Context, ev *eve
 	validCandidates, err := FindValidEmergencyReparentCandidates(statusMap, primaryStatusMap)
 	if err != nil {
 		return err
-	} else if len(validCandidates) == 0 {
+	}
+
+	// Delayed replicas are never promoted, and would not apply their relay
+	// logs before the timeout.
+	for alias := range validCandidates {
+		tablet, ok := tabletMap[alias]
+		if !ok || tablet.ReplicationDelaySeconds <= 0 {
+			continue
+		}
+		if opts.NewPrimaryAlias != nil && topoproto.TabletAliasEqual(opts.NewPrimaryAlias, tablet.Alias) {
+			return vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "primary elect %v is a delayed replica", alias)
+		}
+		erp.logger.Infof("ignoring delayed replica %v as a candidate for emergency reparent", alias)
+		delete(validCandidates, alias)
+	}
+	if len(validCandidates) == 0 {
 		return vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "no valid candidates for emergency reparent")
 	}
 
+	durability, err := GetKeyspaceDurability(ctx, erp.ts, keyspace)
+	if err != nil {
+		return err
+	}
+
 	// Wait for all candidates to apply relay logs
 	if err := erp.waitForAllRelayLogsToApply(ctx, validCandidates, tabletMap, statusMap, opts); err != nil {
 		return err
 	}
 
-	// Elect the candidate with the most up-to-date position.
-	var (
-		winningPosition              mysql.Position
-		winningPrimaryTabletAliasStr string
-	)
-
-	for alias, position := range validCandidates {
+	// Find the most up-to-date position.
+	var winningPosition mysql.Position
+	for _, position := range validCandidates {
 		if winningPosition.IsZero() || position.AtLeast(winningPosition) {
 			winningPosition = position
-			winningPrimaryTabletAliasStr = alias
 		}
 	}
 
+	// Elect the best candidate under the selection rules, which is the
+	// candidate with the most up-to-date position by default.
+	var winningPrimaryTabletAliasStr string
+	if opts.NewPrimaryAlias == nil {
+		var failedPrimary *topodatapb.Tablet
+		if shardInfo.PrimaryAlias != nil {
+			if tablet, ok := tabletMap[topoproto.TabletAliasString(shardInfo.PrimaryAlias)]; ok {
+				failedPrimary = tablet.Tablet
+			}
+		}
+		candidates := make(map[string]*topodatapb.Tablet, len(validCandidates))
+		for alias := range validCandidates {
+			if tablet, ok := tabletMap[alias]; ok {
+				candidates[alias] = tablet.Tablet
+			}
+		}
+		selection, err := ChooseEmergencyReparentCandidate(opts.CandidateSelectionRules, durability, failedPrimary, candidates, validCandidates)
+		ev.CandidateSelection = selection
+		if err != nil {
+			return err
+		}
+		erp.logger.Infof("elected new primary candidate %v", selection.Reason)
+		winningPrimaryTabletAliasStr = topoproto.TabletAliasString(selection.Chosen)
+	}
+
 	// If we were requested to elect a particular primary, verify it's a valid
 	// candidate (non-zero position, no errant GTIDs) and is at least as
 	// advanced as the winning position.
 	if opts.NewPrimaryAlias != nil {
 		winningPrimaryTabletAliasStr = topoproto.TabletAliasString(opts.NewPrimaryAlias)
+		ev.CandidateSelection = &vtctldatapb.EmergencyReparentCandidateSelection{
+			Chosen: opts.NewPrimaryAlias,
+			Reason: fmt.Sprintf("%v was requested", winningPrimaryTabletAliasStr),
+		}
 		pos, ok := validCandidates[winningPrimaryTabletAliasStr]
 		switch {
 		case !ok:
@@ -327,6 +375,20 @@ func (erp *EmergencyReparenter) reparentShardLocked(ctx context.Context, ev *eve
 		}
 	}
 
+	// The other reachable tablets must be able to acknowledge the
+	// transactions of the new primary.
+	if newPrimary, ok := tabletMap[winningPrimaryTabletAliasStr]; ok && durability != nil {
+		reachableTablets := make(map[string]*topo.TabletInfo, len(statusMap))
+		for alias := range statusMap {
+			if tablet, ok := tabletMap[alias]; ok {
+				reachableTablets[alias] = tablet
+			}
+		}
+		if err := checkDurability(durability, newPrimary.Tablet, reachableTablets); err != nil {
+			return err
+		}
+	}
+
 	// Check (again) we still have the topology lock.
 	if err := topo.CheckShardLocked(ctx, keyspace, shard); err != nil {
 		return vterrors.Wrapf(err, "lost topology lock, aborting: %v", err)
@@ -59,6 +59,9 @@ type PlannedReparentOptions struct {
 	// back out to the caller.
 
 	lockAction string
+	// durability is the durability policy of the keyspace, nil if it has
+	// none.
+	durability Durabler
 }
 
 // NewPlannedReparenter returns a new PlannedReparenter object, ready to perform
@@ -155,7 +158,7 @@ func (pr *PlannedReparenter) preflightChecks(
 
 		event.DispatchUpdate(ev, "searching for primary candidate")
 
-		opts.NewPrimaryAlias, err = ChooseNewPrimary(ctx, pr.tmc, &ev.ShardInfo, tabletMap, opts.AvoidPrimaryAlias, opts.WaitReplicasTimeout, pr.logger)
+		opts.NewPrimaryAlias, err = ChooseNewPrimary(ctx, pr.tmc, &ev.ShardInfo, promotableTablets(opts.durability, tabletMap), opts.AvoidPrimaryAlias, opts.WaitReplicasTimeout, pr.logger)
 		if err != nil {
 			return true, err
 		}
@@ -181,6 +184,10 @@ func (pr *PlannedReparenter) preflightChecks(
 		return true, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "the shard has no current primary, use EmergencyReparentShard instead")
 	}
 
+	if err := checkDurability(opts.durability, newPrimaryTabletInfo.Tablet, tabletMap); err != nil {
+		return true, err
+	}
+
 	return false, nil
 }
 
@@ -474,6 +481,11 @@ func (pr *PlannedReparenter) reparentShardLocked(
 
 	ev.ShardInfo = *shardInfo
 
+	opts.durability, err = GetKeyspaceDurability(ctx, pr.ts, keyspace)
+	if err != nil {
+		return err
+	}
+
 	event.DispatchUpdate(ev, "reading tablet map")
 
 	tabletMap, err := pr.ts.GetTabletMapForShard(ctx, keyspace, shard)
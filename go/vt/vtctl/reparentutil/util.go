@@ -25,6 +25,7 @@ import (
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/topotools"
+	"vitess.io/vitess/go/vt/vtctl/reparentutil/promotionrule"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/tmclient"
 
@@ -74,6 +75,8 @@ func ChooseNewPrimary(
 			continue
 		case tablet.Tablet.Type != topodatapb.TabletType_REPLICA:
 			continue
+		case tablet.Tablet.ReplicationDelaySeconds > 0:
+			continue
 		}
 
 		wg.Add(1)
@@ -93,6 +96,45 @@ func ChooseNewPrimary(
 	return nil, nil
 }
 
+// promotableTablets returns the tablets of tabletMap which the durability
+// policy allows to promote. A nil durability policy allows all of them.
+func promotableTablets(durability Durabler, tabletMap map[string]*topo.TabletInfo) map[string]*topo.TabletInfo {
+	if durability == nil {
+		return tabletMap
+	}
+	promotable := make(map[string]*topo.TabletInfo, len(tabletMap))
+	for alias, tablet := range tabletMap {
+		if durability.PromotionRule(tablet.Tablet) != promotionrule.MustNot {
+			promotable[alias] = tablet
+		}
+	}
+	return promotable
+}
+
+// checkDurability returns an error if the durability policy does not allow
+// to promote the primary-elect, or if not enough of the other tablets of the
+// shard may acknowledge its transactions. A nil durability policy allows any
+// promotion, except that of a delayed replica.
+func checkDurability(durability Durabler, primaryElect *topodatapb.Tablet, tabletMap map[string]*topo.TabletInfo) error {
+	if primaryElect.ReplicationDelaySeconds > 0 {
+		return vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "primary-elect %v is a delayed replica", topoproto.TabletAliasString(primaryElect.Alias))
+	}
+	if durability == nil {
+		return nil
+	}
+	if durability.PromotionRule(primaryElect) == promotionrule.MustNot {
+		return vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "the durability policy does not allow to promote primary-elect %v", topoproto.TabletAliasString(primaryElect.Alias))
+	}
+	tablets := make([]*topodatapb.Tablet, 0, len(tabletMap))
+	for _, tablet := range tabletMap {
+		tablets = append(tablets, tablet.Tablet)
+	}
+	if err := ValidateSemiSyncAckers(durability, primaryElect, tablets); err != nil {
+		return vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "%v", err)
+	}
+	return nil
+}
+
 // FindCurrentPrimary returns the current primary tablet of a shard, if any. The
 // current primary is whichever tablet of type PRIMARY (if any) has the most
 // recent PrimaryTermStartTime, which is the same rule that vtgate uses to route
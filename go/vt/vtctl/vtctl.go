@@ -117,6 +117,7 @@ import (
 	"vitess.io/vitess/go/vt/topotools"
 	"vitess.io/vitess/go/vt/vtctl/workflow"
 	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
 	"vitess.io/vitess/go/vt/wrangler"
 
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
@@ -208,6 +209,9 @@ var commands = []commandGroup{
 				"<tablet alias> <hook name> [<param1=value1> <param2=value2> ...]",
 				"Runs the specified hook on the given tablet. A hook is a script that resides in the $VTROOT/vthook directory. You can put any script into that directory and use this command to run that script.\n" +
 					"For this command, the param=value arguments are parameters that the command passes to the specified hook."},
+			{"GetLifecycleHooks", commandGetLifecycleHooks,
+				"<tablet alias>",
+				"Displays the lifecycle hooks declared in the -lifecycle_hooks_config of the tablet, and their recent executions with their output."},
 			{"ExecuteFetchAsApp", commandExecuteFetchAsApp,
 				"[-max_rows=10000] [-json] [-use_pool] <tablet alias> <sql command>",
 				"Runs the given SQL command as a App on the remote tablet."},
@@ -294,6 +298,12 @@ var commands = []commandGroup{
 			{"GetKeyspaces", commandGetKeyspaces,
 				"",
 				"Outputs a sorted list of all keyspaces."},
+			{"GetDynamicTabletConfig", commandGetDynamicTabletConfig,
+				"{-keyspace=<keyspace> || -tablet_alias=<tablet alias>}",
+				"Outputs the DynamicTabletConfig of a keyspace or of a tablet, which the tablets with -enable_dynamic_tablet_config apply at runtime."},
+			{"SetDynamicTabletConfig", commandSetDynamicTabletConfig,
+				"{-keyspace=<keyspace> || -tablet_alias=<tablet alias>} {-config=<config> || -config_file=<config file> || -delete}",
+				"Sets or deletes the DynamicTabletConfig of a keyspace or of a tablet. The settings of a tablet override the ones of its keyspace."},
 			{"SetKeyspaceShardingInfo", commandSetKeyspaceShardingInfo,
 				"[-force] <keyspace name> [<column name>] [<column type>]",
 				"Updates the sharding information for a keyspace."},
@@ -310,7 +320,7 @@ var commands = []commandGroup{
 				"[-cells=<cells>] [-tablet_types=<source_tablet_types>] [-skip_schema_copy] <keyspace.workflow> <source_shards> <target_shards>",
 				"Start a Resharding process. Example: Reshard -cells='zone1,alias1' -tablet_types='primary,replica,rdonly'  ks.workflow001 '0' '-80,80-'"},
 			{"MoveTables", commandMoveTables,
-				"[-cells=<cells>] [-tablet_types=<source_tablet_types>] -workflow=<workflow> <source_keyspace> <target_keyspace> <table_specs>",
+				"[-cells=<cells>] [-tablet_types=<source_tablet_types>] [-on_ddl=<action>] -workflow=<workflow> <source_keyspace> <target_keyspace> <table_specs>",
 				`Move table(s) to another keyspace, table_specs is a list of tables or the tables section of the vschema for the target keyspace. Example: '{"t1":{"column_vindexes": [{"column": "id1", "name": "hash"}]}, "t2":{"column_vindexes": [{"column": "id2", "name": "hash"}]}}'.  In the case of an unsharded target keyspace the vschema for each table may be empty. Example: '{"t1":{}, "t2":{}}'.`},
 			{"Migrate", commandMigrate,
 				"[-cells=<cells>] [-tablet_types=<source_tablet_types>] -workflow=<workflow> <source_keyspace> <target_keyspace> <table_specs>",
@@ -326,7 +336,7 @@ var commands = []commandGroup{
 				`Externalize a backfilled vindex.`},
 			{"Materialize", commandMaterialize,
 				`[-cells=<cells>] [-tablet_types=<source_tablet_types>] <json_spec>, example : '{"workflow": "aaa", "source_keyspace": "source", "target_keyspace": "target", "table_settings": [{"target_table": "customer", "source_expression": "select * from customer", "create_ddl": "copy"}]}'`,
-				"Performs materialization based on the json spec. Is used directly to form VReplication rules, with an optional step to copy table structure/DDL."},
+				"Performs materialization based on the json spec. Is used directly to form VReplication rules, with an optional step to copy table structure/DDL. The table settings can convert the charsets of text columns, e.g. \"convert_charset\": {\"name\": {\"from_charset\": \"latin1\", \"to_charset\": \"utf8mb4\"}}."},
 			{"SplitClone", commandSplitClone,
 				"<keyspace> <from_shards> <to_shards>",
 				"Start the SplitClone process to perform horizontal resharding. Example: SplitClone ks '0' '-80,80-'"},
@@ -363,7 +373,7 @@ var commands = []commandGroup{
 					" This can be used as sanity check to ensure that the tablets were drained after running vtctl MigrateServedTypes " +
 					" and vtgate is no longer using them. If -timeout is set, it fails when the timeout is reached."},
 			{"Mount", commandMount,
-				"[-topo_type=etcd2|consul|zookeeper] [-topo_server=topo_url] [-topo_root=root_topo_node> [-unmount] [-list] [-show]  [<cluster_name>]",
+				"[-type=vitess|mysql] [-topo_type=etcd2|consul|zookeeper] [-topo_server=topo_url] [-topo_root=root_topo_node> [-mysql_host=host] [-mysql_port=port] [-mysql_db_name=db] [-mysql_user=user] [-mysql_password=password] [-skip_health_check] [-unmount] [-list] [-show]  [<cluster_name>]",
 				"Add/Remove/Display/List external cluster(s) to this vitess cluster"},
 		},
 	},
@@ -445,7 +455,7 @@ var commands = []commandGroup{
 				"<keyspace>",
 				"Displays the VTGate routing schema."},
 			{"ApplyVSchema", commandApplyVSchema,
-				"{-vschema=<vschema> || -vschema_file=<vschema file> || -sql=<sql> || -sql_file=<sql file>} [-cells=c1,c2,...] [-skip_rebuild] [-dry-run] <keyspace>",
+				"{-vschema=<vschema> || -vschema_file=<vschema file> || -sql=<sql> || -sql_file=<sql file>} [-cells=c1,c2,...] [-skip_rebuild] [-dry-run] [-author=<author>] [-comment=<comment>] <keyspace>",
 				"Applies the VTGate routing schema to the provided keyspace. Shows the result after application."},
 			{"GetRoutingRules", commandGetRoutingRules,
 				"",
@@ -493,7 +503,7 @@ var commands = []commandGroup{
 		"Workflow", []command{
 			{"Workflow", commandWorkflow,
 				"<ks.workflow> <action> --dry-run",
-				"Start/Stop/Delete/Show/ListAll/Tags Workflow on all target tablets in workflow. Example: Workflow merchant.morders Start",
+				"Start/Stop/Pause/Resume/Delete/Show/ListAll/Tags Workflow on all target tablets in workflow. Pause takes a reason, and stops the streams at a transaction boundary, recording the position and the reason in their message; paused streams are only restarted by Resume, not by Start. Example: Workflow merchant.morders Pause 'schema change on the target'",
 			},
 		},
 	},
@@ -1183,6 +1193,29 @@ func commandExecuteHook(ctx context.Context, wr *wrangler.Wrangler, subFlags *fl
 	return printJSON(wr.Logger(), hr)
 }
 
+func commandGetLifecycleHooks(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <tablet alias> argument is required for the GetLifecycleHooks command")
+	}
+
+	tabletAlias, err := topoproto.ParseTabletAlias(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	tabletInfo, err := wr.TopoServer().GetTablet(ctx, tabletAlias)
+	if err != nil {
+		return err
+	}
+	response, err := wr.TabletManagerClient().GetLifecycleHooks(ctx, tabletInfo.Tablet)
+	if err != nil {
+		return err
+	}
+	return printJSON(wr.Logger(), response)
+}
+
 func commandCreateShard(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	force := subFlags.Bool("force", false, "Proceeds with the command even if the shard already exists")
 	parent := subFlags.Bool("parent", false, "Creates the parent keyspace if it doesn't already exist")
@@ -1759,6 +1792,110 @@ func commandGetKeyspaces(ctx context.Context, wr *wrangler.Wrangler, subFlags *f
 	return nil
 }
 
+// dynamicTabletConfigFlags returns the keyspace or the tablet alias of the
+// DynamicTabletConfig commands. Exactly one of them must be set.
+func dynamicTabletConfigFlags(keyspace, tabletAlias string) (*topodatapb.TabletAlias, error) {
+	switch {
+	case keyspace == "" && tabletAlias == "":
+		return nil, fmt.Errorf("one of -keyspace or -tablet_alias is required")
+	case keyspace != "" && tabletAlias != "":
+		return nil, fmt.Errorf("-keyspace and -tablet_alias are mutually exclusive")
+	case tabletAlias != "":
+		return topoproto.ParseTabletAlias(tabletAlias)
+	}
+	return nil, nil
+}
+
+func commandGetDynamicTabletConfig(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	keyspace := subFlags.String("keyspace", "", "The keyspace of the config")
+	tabletAlias := subFlags.String("tablet_alias", "", "The tablet of the config")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 0 {
+		return fmt.Errorf("GetDynamicTabletConfig doesn't take any arguments")
+	}
+	alias, err := dynamicTabletConfigFlags(*keyspace, *tabletAlias)
+	if err != nil {
+		return err
+	}
+
+	var config *topodatapb.DynamicTabletConfig
+	var version topo.Version
+	if alias != nil {
+		config, version, err = wr.TopoServer().GetTabletDynamicTabletConfig(ctx, alias)
+	} else {
+		config, version, err = wr.TopoServer().GetKeyspaceDynamicTabletConfig(ctx, *keyspace)
+	}
+	if err != nil {
+		return err
+	}
+	wr.Logger().Printf("Version: %v\n", version)
+	return printJSON(wr.Logger(), config)
+}
+
+func commandSetDynamicTabletConfig(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	keyspace := subFlags.String("keyspace", "", "The keyspace of the config")
+	tabletAlias := subFlags.String("tablet_alias", "", "The tablet of the config")
+	configJSON := subFlags.String("config", "", "Specify the config as a JSON string")
+	configFile := subFlags.String("config_file", "", "Specify the config in a JSON file")
+	deleteConfig := subFlags.Bool("delete", false, "Delete the config, so that the tablets use the settings of the keyspace or of their flags")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 0 {
+		return fmt.Errorf("SetDynamicTabletConfig doesn't take any arguments")
+	}
+	alias, err := dynamicTabletConfigFlags(*keyspace, *tabletAlias)
+	if err != nil {
+		return err
+	}
+
+	if *deleteConfig {
+		if *configJSON != "" || *configFile != "" {
+			return fmt.Errorf("-delete is mutually exclusive with -config and -config_file")
+		}
+		if alias != nil {
+			return wr.TopoServer().DeleteTabletDynamicTabletConfig(ctx, alias)
+		}
+		return wr.TopoServer().DeleteKeyspaceDynamicTabletConfig(ctx, *keyspace)
+	}
+
+	var configBytes []byte
+	switch {
+	case *configJSON != "" && *configFile != "":
+		return fmt.Errorf("-config and -config_file are mutually exclusive")
+	case *configFile != "":
+		configBytes, err = ioutil.ReadFile(*configFile)
+		if err != nil {
+			return err
+		}
+	case *configJSON != "":
+		configBytes = []byte(*configJSON)
+	default:
+		return fmt.Errorf("one of -config, -config_file or -delete is required")
+	}
+	config := &topodatapb.DynamicTabletConfig{}
+	if err := json2.Unmarshal(configBytes, config); err != nil {
+		return err
+	}
+	if err := tabletenv.VerifyDynamicTabletConfig(config); err != nil {
+		return err
+	}
+
+	var version topo.Version
+	if alias != nil {
+		version, err = wr.TopoServer().UpdateTabletDynamicTabletConfig(ctx, alias, config, nil)
+	} else {
+		version, err = wr.TopoServer().UpdateKeyspaceDynamicTabletConfig(ctx, *keyspace, config, nil)
+	}
+	if err != nil {
+		return err
+	}
+	wr.Logger().Printf("Version: %v\n", version)
+	return printJSON(wr.Logger(), config)
+}
+
 func commandSetKeyspaceShardingInfo(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	force := subFlags.Bool("force", false, "Updates fields even if they are already set. Use caution before calling this command.")
 	if err := subFlags.Parse(args); err != nil {
@@ -1909,6 +2046,7 @@ func commandMoveTables(ctx context.Context, wr *wrangler.Wrangler, subFlags *fla
 
 	autoStart := subFlags.Bool("auto_start", true, "If false, streams will start in the Stopped state and will need to be explicitly started")
 	stopAfterCopy := subFlags.Bool("stop_after_copy", false, "Streams will be stopped once the copy phase is completed")
+	onDDL := subFlags.String("on_ddl", "IGNORE", "What to do with the DDLs of the source tables: IGNORE them, STOP the streams, EXEC them on the target, EXEC_IGNORE them ignoring their errors, or EXEC_ONLINE_DDL to submit them as online DDL migrations of the target")
 	_ = subFlags.Bool("v1", true, "")
 
 	if err := subFlags.Parse(args); err != nil {
@@ -1934,7 +2072,7 @@ func commandMoveTables(ctx context.Context, wr *wrangler.Wrangler, subFlags *fla
 	target := subFlags.Arg(1)
 	tableSpecs := subFlags.Arg(2)
 	return wr.MoveTables(ctx, *workflow, source, target, tableSpecs, *cells, *tabletTypes, *allTables,
-		*excludes, *autoStart, *stopAfterCopy, "")
+		*excludes, *autoStart, *stopAfterCopy, "", *onDDL)
 }
 
 // VReplicationWorkflowAction defines subcommands passed to vtctl for movetables or reshard
@@ -1971,9 +2109,12 @@ func commandVRWorkflow(ctx context.Context, wr *wrangler.Wrangler, subFlags *fla
 
 	cells := subFlags.String("cells", "", "Cell(s) or CellAlias(es) (comma-separated) to replicate from.")
 	tabletTypes := subFlags.String("tablet_types", "primary,replica,rdonly", "Source tablet types to replicate from (e.g. primary, replica, rdonly). Defaults to -vreplication_tablet_type parameter value for the tablet, which has the default value of replica.")
-	dryRun := subFlags.Bool("dry_run", false, "Does a dry run of SwitchReads and only reports the actions to be taken. -dry_run is only supported for SwitchTraffic, ReverseTraffic and Complete.")
+	dryRun := subFlags.Bool("dry_run", false, "Does a dry run of SwitchReads and only reports the actions to be taken. -dry_run is only supported for SwitchTraffic, ReverseTraffic, Complete and Cancel.")
+	dryRunFormat := subFlags.String("format", "text", "Format of the report of -dry_run: text, or json for the list of the topo changes, routing rule changes and queries in the order they would be made")
 	timeout := subFlags.Duration("timeout", 30*time.Second, "Specifies the maximum time to wait, in seconds, for vreplication to catch up on primary migrations. The migration will be cancelled on a timeout.")
 	reverseReplication := subFlags.Bool("reverse_replication", true, "Also reverse the replication")
+	reverseVDiffWindow := subFlags.Duration("reverse_vdiff_window", 0, "For SwitchTraffic of primary tablets with -reverse_replication, if set, diff the source keyspace against the target keyspace through the reverse workflow after the writes are switched, and fail if rows diverged. The diff must complete within this window")
+	reverseExternalCluster := subFlags.String("reverse_external_cluster", "", "For SwitchTraffic of Migrate workflows with -reverse_replication, the name under which this cluster is mounted in the source cluster")
 	keepData := subFlags.Bool("keep_data", false, "Do not drop tables or shards (if true, only vreplication artifacts are cleaned up)")
 
 	autoStart := subFlags.Bool("auto_start", true, "If false, streams will start in the Stopped state and will need to be explicitly started")
@@ -1984,6 +2125,7 @@ func commandVRWorkflow(ctx context.Context, wr *wrangler.Wrangler, subFlags *fla
 	allTables := subFlags.Bool("all", false, "Move all tables from the source keyspace")
 	excludes := subFlags.String("exclude", "", "Tables to exclude (comma-separated) if -all is specified")
 	sourceKeyspace := subFlags.String("source", "", "Source keyspace")
+	onDDL := subFlags.String("on_ddl", "IGNORE", "What to do with the DDLs of the source tables: IGNORE them, STOP the streams, EXEC them on the target, EXEC_IGNORE them ignoring their errors, or EXEC_ONLINE_DDL to submit them as online DDL migrations of the target")
 
 	// MoveTables-only params
 	renameTables := subFlags.Bool("rename_tables", false, "Rename tables instead of dropping them")
@@ -2043,6 +2185,9 @@ func commandVRWorkflow(ctx context.Context, wr *wrangler.Wrangler, subFlags *fla
 				if st.TransactionTimestamp > 0 { // if no events occur after copy phase, TransactionTimeStamp can be 0
 					msg += fmt.Sprintf(" Tx time: %s.", time.Unix(st.TransactionTimestamp, 0).Format(time.ANSIC))
 				}
+				if len(st.DDLEvents) > 0 {
+					msg += fmt.Sprintf(" Last DDL event: %s.", st.DDLEvents[0].Message)
+				}
 				s += fmt.Sprintf("id=%d on %s: Status: %s.%s\n", st.ID, ksShard, st.State, msg)
 			}
 		}
@@ -2065,7 +2210,7 @@ func commandVRWorkflow(ctx context.Context, wr *wrangler.Wrangler, subFlags *fla
 	action = strings.ToLower(action) // allow users to input action in a case-insensitive manner
 	if workflowType == wrangler.MigrateWorkflow {
 		switch action {
-		case vReplicationWorkflowActionCreate, vReplicationWorkflowActionCancel, vReplicationWorkflowActionComplete:
+		case vReplicationWorkflowActionCreate, vReplicationWorkflowActionSwitchTraffic, vReplicationWorkflowActionCancel, vReplicationWorkflowActionComplete:
 		default:
 			return fmt.Errorf("invalid action for Migrate: %s", action)
 		}
@@ -2082,21 +2227,33 @@ func commandVRWorkflow(ctx context.Context, wr *wrangler.Wrangler, subFlags *fla
 			if *sourceKeyspace == "" {
 				return fmt.Errorf("source keyspace is not specified")
 			}
+			var externalMysql *topo.ExternalMysqlInfo
 			if workflowType == wrangler.MigrateWorkflow {
 				externalClusterName, *sourceKeyspace, err = getSourceKeyspace(*sourceKeyspace)
 				if err != nil {
 					return err
 				}
-				sourceTopo, err = sourceTopo.OpenExternalVitessClusterServer(ctx, externalClusterName)
+				// The external cluster is either an external mysql, whose
+				// database is the source keyspace, or a mounted vitess cluster.
+				externalMysql, err = wr.TopoServer().GetExternalMysql(ctx, externalClusterName)
 				if err != nil {
 					return err
 				}
+				if externalMysql == nil {
+					sourceTopo, err = sourceTopo.OpenExternalVitessClusterServer(ctx, externalClusterName)
+					if err != nil {
+						return err
+					}
+					defer sourceTopo.Close()
+				}
 			}
 
-			_, err := sourceTopo.GetKeyspace(ctx, *sourceKeyspace)
-			if err != nil {
-				wr.Logger().Errorf("keyspace %s not found", *sourceKeyspace)
-				return err
+			if externalMysql == nil {
+				_, err := sourceTopo.GetKeyspace(ctx, *sourceKeyspace)
+				if err != nil {
+					wr.Logger().Errorf("keyspace %s not found", *sourceKeyspace)
+					return err
+				}
 			}
 			if !*allTables && *tables == "" {
 				return fmt.Errorf("no tables specified to move")
@@ -2107,6 +2264,7 @@ func commandVRWorkflow(ctx context.Context, wr *wrangler.Wrangler, subFlags *fla
 			vrwp.ExcludeTables = *excludes
 			vrwp.Timeout = *timeout
 			vrwp.ExternalCluster = externalClusterName
+			vrwp.OnDDL = *onDDL
 		case wrangler.ReshardWorkflow:
 			if *sourceShards == "" || *targetShards == "" {
 				return fmt.Errorf("source and target shards are not specified")
@@ -2128,6 +2286,8 @@ func commandVRWorkflow(ctx context.Context, wr *wrangler.Wrangler, subFlags *fla
 		}
 		vrwp.Timeout = *timeout
 		vrwp.EnableReverseReplication = *reverseReplication
+		vrwp.ReverseExternalCluster = *reverseExternalCluster
+		vrwp.ReverseVDiffWindow = *reverseVDiffWindow
 	case vReplicationWorkflowActionCancel:
 		vrwp.KeepData = *keepData
 	case vReplicationWorkflowActionComplete:
@@ -2188,9 +2348,15 @@ func commandVRWorkflow(ctx context.Context, wr *wrangler.Wrangler, subFlags *fla
 
 	if *dryRun {
 		switch action {
-		case vReplicationWorkflowActionSwitchTraffic, vReplicationWorkflowActionReverseTraffic, vReplicationWorkflowActionComplete:
+		case vReplicationWorkflowActionSwitchTraffic, vReplicationWorkflowActionReverseTraffic, vReplicationWorkflowActionComplete,
+			vReplicationWorkflowActionCancel:
 		default:
-			return fmt.Errorf("-dry_run is only supported for SwitchTraffic, ReverseTraffic and Complete, not for %s", originalAction)
+			return fmt.Errorf("-dry_run is only supported for SwitchTraffic, ReverseTraffic, Complete and Cancel, not for %s", originalAction)
+		}
+		switch *dryRunFormat {
+		case "text", "json":
+		default:
+			return fmt.Errorf("unknown -format %s, must be text or json", *dryRunFormat)
 		}
 	}
 
@@ -2275,7 +2441,7 @@ func commandVRWorkflow(ctx context.Context, wr *wrangler.Wrangler, subFlags *fla
 	case vReplicationWorkflowActionComplete:
 		dryRunResults, err = wf.Complete()
 	case vReplicationWorkflowActionCancel:
-		err = wf.Cancel()
+		dryRunResults, err = wf.Cancel()
 	case vReplicationWorkflowActionGetState:
 		wr.Logger().Printf(wf.CachedState() + "\n")
 		return nil
@@ -2287,8 +2453,11 @@ func commandVRWorkflow(ctx context.Context, wr *wrangler.Wrangler, subFlags *fla
 		return wrapError(wf, err)
 	}
 	if *dryRun {
+		if *dryRunFormat == "json" {
+			return printJSON(wr.Logger(), wf.DryRunPlan(originalAction))
+		}
 		if len(*dryRunResults) > 0 {
-			wr.Logger().Printf("Dry Run results for %s run at %s\nParameters: %s\n\n", time.RFC822, originalAction, strings.Join(args, " "))
+			wr.Logger().Printf("Dry Run results for %s run at %s\nParameters: %s\n\n", originalAction, time.Now().Format(time.RFC822), strings.Join(args, " "))
 			wr.Logger().Printf("%s\n", strings.Join(*dryRunResults, "\n"))
 			return nil
 		}
@@ -3221,6 +3390,8 @@ func commandApplyVSchema(ctx context.Context, wr *wrangler.Wrangler, subFlags *f
 	sqlFile := subFlags.String("sql_file", "", "A vschema ddl SQL statement (e.g. `add vindex`, `alter table t add vindex hash(id)`, etc)")
 	dryRun := subFlags.Bool("dry-run", false, "If set, do not save the altered vschema, simply echo to console.")
 	skipRebuild := subFlags.Bool("skip_rebuild", false, "If set, do no rebuild the SrvSchema objects.")
+	author := subFlags.String("author", "", "The author of the change, recorded in the revision of the vschema")
+	comment := subFlags.String("comment", "", "A description of the change, recorded in the revision of the vschema")
 	var cells flagutil.StringListValue
 	subFlags.Var(&cells, "cells", "If specified, limits the rebuild to the cells, after upload. Ignored if skipRebuild is set.")
 
@@ -3317,9 +3488,11 @@ func commandApplyVSchema(ctx context.Context, wr *wrangler.Wrangler, subFlags *f
 		return err
 	}
 
-	if err := wr.TopoServer().SaveVSchema(ctx, keyspace, vs); err != nil {
+	revision, err := wr.TopoServer().SaveVSchemaRevision(ctx, keyspace, vs, *author, *comment)
+	if err != nil {
 		return err
 	}
+	wr.Logger().Printf("Saved as revision %v of the VSchema\n", revision.Version)
 
 	if *skipRebuild {
 		wr.Logger().Warningf("Skipping rebuild of SrvVSchema, will need to run RebuildVSchemaGraph for changes to take effect")
@@ -3570,7 +3743,7 @@ func commandWorkflow(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.
 		return err
 	}
 	if subFlags.NArg() < 2 {
-		return fmt.Errorf("usage: Workflow --dry-run keyspace[.workflow] start/stop/delete/list/listall/tags [<tags>]")
+		return fmt.Errorf("usage: Workflow --dry-run keyspace[.workflow] start/stop/pause/resume/delete/list/listall/tags [<tags>|<reason>]")
 	}
 	keyspace := subFlags.Arg(0)
 	action := strings.ToLower(subFlags.Arg(1))
@@ -3601,9 +3774,17 @@ func commandWorkflow(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.
 		if err != nil {
 			return err
 		}
+	} else if action == "pause" {
+		if subFlags.NArg() != 3 {
+			return fmt.Errorf("reason incorrectly specified, usage: Workflow keyspace.workflow pause <reason>")
+		}
+		results, err = wr.WorkflowPause(ctx, workflow, keyspace, subFlags.Arg(2), *dryRun)
+		if err != nil {
+			return err
+		}
 	} else {
 		if subFlags.NArg() != 2 {
-			return fmt.Errorf("usage: Workflow --dry-run keyspace[.workflow] start/stop/delete/list/listall")
+			return fmt.Errorf("usage: Workflow --dry-run keyspace[.workflow] start/stop/resume/delete/list/listall")
 		}
 		results, err = wr.WorkflowAction(ctx, workflow, keyspace, action, *dryRun)
 		if err != nil {
@@ -3625,7 +3806,7 @@ func commandWorkflow(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.
 }
 
 func commandMount(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
-	clusterType := subFlags.String("type", "vitess", "Specify cluster type: mysql or vitess, only vitess clustered right now")
+	clusterType := subFlags.String("type", "vitess", "Specify cluster type: mysql or vitess")
 	unmount := subFlags.Bool("unmount", false, "Unmount cluster")
 	show := subFlags.Bool("show", false, "Display contents of cluster")
 	list := subFlags.Bool("list", false, "List all clusters")
@@ -3635,9 +3816,25 @@ func commandMount(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.Fla
 	topoServer := subFlags.String("topo_server", "", "Server url of cluster's topology server")
 	topoRoot := subFlags.String("topo_root", "", "Root node of cluster's topology")
 
+	// mysql cluster params
+	mysqlHost := subFlags.String("mysql_host", "", "Host of the external mysql")
+	mysqlPort := subFlags.Int("mysql_port", 3306, "Port of the external mysql")
+	mysqlDbName := subFlags.String("mysql_db_name", "", "Database of the external mysql that workflows replicate from")
+	mysqlUser := subFlags.String("mysql_user", "", "Replication user of the external mysql")
+	mysqlPassword := subFlags.String("mysql_password", "", "Password of the replication user of the external mysql")
+	skipHealthCheck := subFlags.Bool("skip_health_check", false, "Register the external mysql even if it is not reachable or its binlog settings do not allow vreplication")
+
 	if err := subFlags.Parse(args); err != nil {
 		return err
 	}
+	if *list && *clusterType == "mysql" {
+		names, err := wr.TopoServer().GetExternalMysqls(ctx)
+		if err != nil {
+			return err
+		}
+		wr.Logger().Printf("%s\n", strings.Join(names, ","))
+		return nil
+	}
 	if *list {
 		clusters, err := wr.TopoServer().GetExternalVitessClusters(ctx)
 		if err != nil {
@@ -3674,7 +3871,33 @@ func commandMount(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.Fla
 			return wr.MountExternalVitessCluster(ctx, clusterName, *topoType, *topoServer, *topoRoot)
 		}
 	case "mysql":
-		return fmt.Errorf("mysql cluster type not yet supported")
+		switch {
+		case *unmount:
+			_, err := wr.VtctldServer().DeregisterExternalMysql(ctx, &vtctldatapb.DeregisterExternalMysqlRequest{Name: clusterName})
+			return err
+		case *show:
+			resp, err := wr.VtctldServer().GetExternalMysqls(ctx, &vtctldatapb.GetExternalMysqlsRequest{Names: []string{clusterName}, CheckHealth: true})
+			if err != nil {
+				return err
+			}
+			data, err := json.Marshal(resp.ExternalMysqls[0])
+			if err != nil {
+				return err
+			}
+			wr.Logger().Printf("%s\n", string(data))
+			return nil
+		default:
+			_, err := wr.VtctldServer().RegisterExternalMysql(ctx, &vtctldatapb.RegisterExternalMysqlRequest{
+				Name:            clusterName,
+				Host:            *mysqlHost,
+				Port:            int32(*mysqlPort),
+				DbName:          *mysqlDbName,
+				User:            *mysqlUser,
+				Password:        *mysqlPassword,
+				SkipHealthCheck: *skipHealthCheck,
+			})
+			return err
+		}
 	default:
 		return fmt.Errorf("cluster type can be only one of vitess or mysql")
 	}
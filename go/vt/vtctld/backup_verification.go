@@ -0,0 +1,147 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtctld
+
+import (
+	"context"
+	"flag"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/timer"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+	"vitess.io/vitess/go/vt/servenv"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vtctl/grpcvtctldserver"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	mysqlctlpb "vitess.io/vitess/go/vt/proto/mysqlctl"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+	"vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var (
+	backupVerificationInterval = flag.Duration("backup_verification_interval", 0, "if set, vtctld periodically verifies the most recent backup of every shard at this interval")
+	backupVerificationLevel    = flag.String("backup_verification_level", "manifest", "level of the periodic backup verification: manifest, checksum or restore. At the restore level, the backup is restored on a SPARE tablet of the shard, and shards without one are skipped")
+
+	backupVerificationAgeRefreshInterval = flag.Duration("backup_verification_age_refresh_interval", 10*time.Minute, "interval at which vtctld reloads the BackupLastVerifiedAgeSeconds metric from the verifications recorded with the backups, 0 to disable")
+)
+
+func initBackupVerification(ts *topo.Server) {
+	if *backupVerificationAgeRefreshInterval > 0 && *backupstorage.BackupStorageImplementation != "" {
+		refreshCtx, refreshCancel := context.WithCancel(context.Background())
+		refreshTicks := timer.NewTimer(*backupVerificationAgeRefreshInterval)
+		refreshTicks.Start(func() {
+			if err := grpcvtctldserver.RefreshBackupVerificationAges(refreshCtx, ts); err != nil {
+				log.Errorf("vtctld.RefreshBackupVerificationAges error: %v", err)
+			}
+		})
+		// Report the metric right away after a restart.
+		refreshTicks.Trigger()
+		servenv.OnTermSync(func() {
+			refreshCancel()
+			refreshTicks.Stop()
+		})
+	}
+
+	if *backupVerificationInterval <= 0 {
+		return
+	}
+
+	level, ok := mysqlctlpb.BackupVerification_Level_value[strings.ToUpper(*backupVerificationLevel)]
+	if !ok {
+		log.Exitf("invalid backup_verification_level %q", *backupVerificationLevel)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	vtctld := grpcvtctldserver.NewVtctldServer(ts)
+	ticks := timer.NewTimer(*backupVerificationInterval)
+	ticks.Start(func() {
+		verifyAllBackups(ctx, ts, vtctld, mysqlctlpb.BackupVerification_Level(level))
+	})
+
+	servenv.OnTermSync(func() {
+		cancel()
+		ticks.Stop()
+	})
+}
+
+func verifyAllBackups(ctx context.Context, ts *topo.Server, vtctld *grpcvtctldserver.VtctldServer, level mysqlctlpb.BackupVerification_Level) {
+	keyspaces, err := ts.GetKeyspaces(ctx)
+	if err != nil {
+		log.Errorf("vtctld.verifyAllBackups GetKeyspaces error: %v", err)
+		return
+	}
+
+	for _, keyspace := range keyspaces {
+		shards, err := ts.GetShardNames(ctx, keyspace)
+		if err != nil {
+			log.Errorf("vtctld.verifyAllBackups GetShardNames(%v) error: %v", keyspace, err)
+			continue
+		}
+
+		for _, shard := range shards {
+			if ctx.Err() != nil {
+				return
+			}
+
+			req := &vtctldatapb.VerifyBackupRequest{
+				Keyspace: keyspace,
+				Shard:    shard,
+				Level:    level,
+			}
+			if level == mysqlctlpb.BackupVerification_RESTORE {
+				req.TabletAlias, err = findScratchTablet(ctx, ts, keyspace, shard)
+				if err != nil {
+					log.Errorf("vtctld.verifyAllBackups findScratchTablet(%v/%v) error: %v", keyspace, shard, err)
+					continue
+				}
+				if req.TabletAlias == nil {
+					continue
+				}
+			}
+
+			resp, err := vtctld.VerifyBackup(ctx, req)
+			switch {
+			case vterrors.Code(err) == vtrpc.Code_NOT_FOUND:
+				// No backup to verify.
+			case err != nil:
+				log.Errorf("vtctld.verifyAllBackups VerifyBackup(%v/%v) error: %v", keyspace, shard, err)
+			case resp.Verification.Status != mysqlctlpb.BackupInfo_VALID:
+				log.Warningf("backup %v of %v/%v failed verification: %v", resp.Verification.BackupName, keyspace, shard, resp.Verification.Error)
+			}
+		}
+	}
+}
+
+// findScratchTablet returns the alias of a SPARE tablet of the shard, or nil
+// if there is none.
+func findScratchTablet(ctx context.Context, ts *topo.Server, keyspace, shard string) (*topodatapb.TabletAlias, error) {
+	tablets, err := ts.GetTabletMapForShard(ctx, keyspace, shard)
+	if err != nil && !topo.IsErrType(err, topo.PartialResult) {
+		return nil, err
+	}
+
+	for _, ti := range tablets {
+		if ti.Type == topodatapb.TabletType_SPARE {
+			return ti.Alias, nil
+		}
+	}
+	return nil, nil
+}
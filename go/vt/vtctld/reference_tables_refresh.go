@@ -0,0 +1,83 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtctld
+
+import (
+	"context"
+	"flag"
+	"time"
+
+	"vitess.io/vitess/go/protoutil"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/timer"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/servenv"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vtctl/grpcvtctldserver"
+
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+var (
+	referenceTablesRefreshInterval = flag.Duration("reference_tables_refresh_interval", time.Minute, "interval at which vtctld refreshes the reference tables workflows, creating the streams of the shards added to their target keyspaces; 0 disables the refresh")
+
+	referenceTablesStaleness = stats.NewGaugesWithMultiLabels(
+		"ReferenceTablesStaleness",
+		"Seconds since the streams of the reference tables workflow on the shard last recorded a transaction or a heartbeat of the source keyspace, at the last refresh",
+		[]string{"Workflow", "Keyspace", "Shard"})
+)
+
+func initReferenceTablesRefresh(ts *topo.Server) {
+	if *referenceTablesRefreshInterval <= 0 {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	vtctld := grpcvtctldserver.NewVtctldServer(ts)
+	ticks := timer.NewTimer(*referenceTablesRefreshInterval)
+	ticks.Start(func() {
+		refreshReferenceTables(ctx, vtctld)
+	})
+
+	servenv.OnTermSync(func() {
+		cancel()
+		ticks.Stop()
+	})
+}
+
+func refreshReferenceTables(ctx context.Context, vtctld *grpcvtctldserver.VtctldServer) {
+	resp, err := vtctld.ReferenceTablesRefresh(ctx, &vtctldatapb.ReferenceTablesRefreshRequest{})
+	if err != nil {
+		// The statuses of the other workflows are lost with the error, so
+		// their staleness is kept as of the last successful refresh.
+		log.Errorf("vtctld.refreshReferenceTables ReferenceTablesRefresh error: %v", err)
+		return
+	}
+
+	referenceTablesStaleness.ResetAll()
+	for _, shard := range resp.Shards {
+		if shard.Created {
+			log.Infof("created the streams of the reference tables workflow %v on %v/%v", shard.Workflow, shard.Keyspace, shard.Shard)
+		}
+		staleness, _, err := protoutil.DurationFromProto(shard.Staleness)
+		if err != nil {
+			log.Errorf("vtctld.refreshReferenceTables bad staleness of %v on %v/%v: %v", shard.Workflow, shard.Keyspace, shard.Shard, err)
+			continue
+		}
+		referenceTablesStaleness.Set([]string{shard.Workflow, shard.Keyspace, shard.Shard}, int64(staleness.Seconds()))
+	}
+}
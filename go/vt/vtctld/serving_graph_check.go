@@ -0,0 +1,108 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtctld
+
+import (
+	"context"
+	"flag"
+	"time"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/timer"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/servenv"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vtctl/grpcvtctldserver"
+
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+var (
+	servingGraphCheckInterval = flag.Duration("serving_graph_check_interval", 0, "if set, vtctld periodically compares the SrvKeyspace and SrvVSchema objects of every cell with the global topo at this interval")
+	servingGraphCheckRebuild  = flag.Bool("serving_graph_check_rebuild", false, "if set, the periodic serving graph check rebuilds the SrvKeyspace and SrvVSchema objects that diverge from the global topo")
+
+	servingGraphDivergences = stats.NewGaugesWithSingleLabel(
+		"ServingGraphDivergences",
+		"Number of SrvKeyspace and SrvVSchema objects of the cell that diverged from the global topo at the last serving graph check",
+		"Cell")
+	servingGraphLastConsistent = stats.NewGaugesWithSingleLabel(
+		"ServingGraphLastConsistent",
+		"Unix time of the last serving graph check that found the cell consistent with the global topo, or that rebuilt it",
+		"Cell")
+)
+
+func initServingGraphCheck(ts *topo.Server) {
+	if *servingGraphCheckInterval <= 0 {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	vtctld := grpcvtctldserver.NewVtctldServer(ts)
+	ticks := timer.NewTimer(*servingGraphCheckInterval)
+	ticks.Start(func() {
+		checkServingGraph(ctx, ts, vtctld, *servingGraphCheckRebuild)
+	})
+
+	servenv.OnTermSync(func() {
+		cancel()
+		ticks.Stop()
+	})
+}
+
+func checkServingGraph(ctx context.Context, ts *topo.Server, vtctld *grpcvtctldserver.VtctldServer, rebuild bool) {
+	cells, err := ts.GetKnownCells(ctx)
+	if err != nil {
+		log.Errorf("vtctld.checkServingGraph GetKnownCells error: %v", err)
+		return
+	}
+
+	now := time.Now()
+	resp, err := vtctld.ValidateServingGraph(ctx, &vtctldatapb.ValidateServingGraphRequest{
+		Cells:   cells,
+		Rebuild: rebuild,
+	})
+	if err != nil {
+		log.Errorf("vtctld.checkServingGraph ValidateServingGraph error: %v", err)
+		return
+	}
+
+	// A cell is consistent if all of its divergences were rebuilt.
+	divergences := make(map[string]int64, len(cells))
+	consistent := make(map[string]bool, len(cells))
+	for _, cell := range cells {
+		consistent[cell] = true
+	}
+	for _, d := range resp.Divergences {
+		divergences[d.Cell]++
+		switch {
+		case d.Rebuilt:
+			log.Infof("rebuilt the serving graph of %v in %v: %v", d.Keyspace, d.Cell, d.Description)
+		case d.RebuildError != "":
+			consistent[d.Cell] = false
+			log.Errorf("cannot rebuild the serving graph of %v in %v: %v: %v", d.Keyspace, d.Cell, d.Description, d.RebuildError)
+		default:
+			consistent[d.Cell] = false
+			log.Warningf("the serving graph of %v in %v diverges from the global topo: %v", d.Keyspace, d.Cell, d.Description)
+		}
+	}
+	for _, cell := range cells {
+		servingGraphDivergences.Set(cell, divergences[cell])
+		if consistent[cell] {
+			servingGraphLastConsistent.Set(cell, now.Unix())
+		}
+	}
+}
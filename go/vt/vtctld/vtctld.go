@@ -183,6 +183,15 @@ func InitVtctld(ts *topo.Server) {
 	// Init online DDL schema manager
 	initSchemaManager(ts)
 
+	// Init the periodic backup verification, if enabled.
+	initBackupVerification(ts)
+
+	// Init the periodic serving graph check, if enabled.
+	initServingGraphCheck(ts)
+
+	// Init the periodic refresh of the reference tables workflows, if enabled.
+	initReferenceTablesRefresh(ts)
+
 	// Setup reverse proxy for all vttablets through /vttablet/.
 	initVTTabletRedirection(ts)
 }
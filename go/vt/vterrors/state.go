@@ -42,6 +42,8 @@ const (
 	LockOrActiveTransaction
 	MixOfGroupFuncAndFields
 	DupFieldName
+	DivisionByZero
+	TruncatedWrongValue
 
 	// failed precondition
 	NoDB
@@ -77,6 +79,7 @@ const (
 
 	// server not available
 	ServerNotAvailable
+	CircuitBreakerOpen
 
 	// No state should be added below NumOfStates
 	NumOfStates
@@ -28,12 +28,14 @@ import (
 	"time"
 
 	"vitess.io/vitess/go/jsonutil"
+	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/sync2"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vtgate/engine"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
 )
 
 var (
@@ -277,6 +279,26 @@ func Run(sql string) ([]*Explain, error) {
 	return explains, nil
 }
 
+// Execute runs a single query in the given vtgate session of the fake
+// execution environment, and returns its result along with how it was
+// executed. Unlike Run, the session is the caller's, so that transactions and
+// the target of the session span calls.
+func Execute(session *vtgatepb.Session, sql string, bindVars map[string]*querypb.BindVariable) (*Explain, *sqltypes.Result, error) {
+	if !session.GetInTransaction() {
+		batchTime = sync2.NewBatcher(*batchInterval)
+	}
+	plans, tabletActions, result, err := vtgateExecuteInSession(session, sql, bindVars)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &Explain{
+		SQL:           sql,
+		Plans:         plans,
+		TabletActions: tabletActions,
+	}, result, nil
+}
+
 func explain(sql string) (*Explain, error) {
 	plans, tabletActions, err := vtgateExecute(sql)
 	if err != nil {
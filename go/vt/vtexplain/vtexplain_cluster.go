@@ -0,0 +1,70 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtexplain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"vitess.io/vitess/go/json2"
+	"vitess.io/vitess/go/vt/topo"
+)
+
+// LoadFromTopo reads the vschema and the keyspace shard map inputs of Init
+// from the topo of a running cluster, so that queries are explained the way
+// they would be routed in that cluster.
+//
+// The vschema is the SrvVSchema of the cell, which is what the vtgates of the
+// cell route with, and the shard map has the current shards of each of its
+// keyspaces.
+func LoadFromTopo(ctx context.Context, ts *topo.Server, cell string) (vSchemaStr string, ksShardMapStr string, err error) {
+	srvVSchema, err := ts.GetSrvVSchema(ctx, cell)
+	if err != nil {
+		return "", "", fmt.Errorf("GetSrvVSchema(%s) failed: %w", cell, err)
+	}
+
+	vschemas := make(map[string]json.RawMessage, len(srvVSchema.Keyspaces))
+	ksShardMap := make(map[string]map[string]*topo.ShardInfo, len(srvVSchema.Keyspaces))
+	for ks, vschema := range srvVSchema.Keyspaces {
+		data, err := json2.MarshalPB(vschema)
+		if err != nil {
+			return "", "", fmt.Errorf("cannot marshal the vschema of keyspace %s: %w", ks, err)
+		}
+
+		vschemas[ks] = data
+
+		shards, err := ts.FindAllShardsInKeyspace(ctx, ks)
+		if err != nil {
+			return "", "", fmt.Errorf("FindAllShardsInKeyspace(%s) failed: %w", ks, err)
+		}
+
+		ksShardMap[ks] = shards
+	}
+
+	vSchemaData, err := json.Marshal(vschemas)
+	if err != nil {
+		return "", "", err
+	}
+
+	ksShardMapData, err := json.Marshal(ksShardMap)
+	if err != nil {
+		return "", "", err
+	}
+
+	return string(vSchemaData), string(ksShardMapData), nil
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtexplain
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/json2"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+)
+
+func TestLoadFromTopo(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1")
+
+	vSchema, err := ioutil.ReadFile("testdata/test-vschema.json")
+	require.NoError(t, err)
+
+	var srvVSchema vschemapb.SrvVSchema
+	err = json2.Unmarshal([]byte(fmt.Sprintf(`{"keyspaces": %s}`, vSchema)), &srvVSchema)
+	require.NoError(t, err)
+	require.NoError(t, ts.UpdateSrvVSchema(ctx, "cell1", &srvVSchema))
+
+	keyspaceShards := map[string][]string{
+		"ks_unsharded": {"-"},
+		"ks_sharded":   {"-20", "20-40", "40-60", "60-80", "80-a0", "a0-c0", "c0-e0", "e0-"},
+	}
+	for ks, shards := range keyspaceShards {
+		require.NoError(t, ts.CreateKeyspace(ctx, ks, &topodatapb.Keyspace{}))
+		for _, shard := range shards {
+			require.NoError(t, ts.CreateShard(ctx, ks, shard))
+		}
+	}
+
+	vSchemaStr, ksShardMapStr, err := LoadFromTopo(ctx, ts, "cell1")
+	require.NoError(t, err)
+
+	schema, err := ioutil.ReadFile("testdata/test-schema.sql")
+	require.NoError(t, err)
+
+	opts := defaultTestOpts()
+	opts.ExecutionMode = ModeMulti
+	err = Init(vSchemaStr, string(schema), ksShardMapStr, opts)
+	require.NoError(t, err)
+	defer Stop()
+
+	sql, err := ioutil.ReadFile("testdata/select-sharded-8-queries.sql")
+	require.NoError(t, err)
+
+	expected, err := ioutil.ReadFile("testdata/multi-output/select-sharded-8-output.txt")
+	require.NoError(t, err)
+
+	explains, err := Run(string(sql))
+	require.NoError(t, err)
+
+	if diff := cmp.Diff(strings.TrimSpace(string(expected)), strings.TrimSpace(ExplainsAsText(explains))); diff != "" {
+		t.Errorf("Text output did not match (-want +got):\n%s", diff)
+	}
+
+	_, _, err = LoadFromTopo(ctx, ts, "nocell")
+	require.Error(t, err)
+}
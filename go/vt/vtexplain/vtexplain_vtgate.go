@@ -30,6 +30,7 @@ import (
 	"vitess.io/vitess/go/vt/vterrors"
 
 	"vitess.io/vitess/go/json2"
+	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/discovery"
 	"vitess.io/vitess/go/vt/key"
 	"vitess.io/vitess/go/vt/log"
@@ -38,6 +39,7 @@ import (
 	"vitess.io/vitess/go/vt/vtgate/engine"
 	"vitess.io/vitess/go/vt/vttablet/queryservice"
 
+	querypb "vitess.io/vitess/go/vt/proto/query"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
 	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
@@ -185,11 +187,18 @@ func getShardRanges(ks string, vschema *vschemapb.Keyspace, ksShardMap map[strin
 }
 
 func vtgateExecute(sql string) ([]*engine.Plan, map[string]*TabletActions, error) {
+	plans, tabletActions, _, err := vtgateExecuteInSession(vtgateSession, sql, nil)
+	return plans, tabletActions, err
+}
+
+// vtgateExecuteInSession executes a query in a vtgate session, and returns
+// its result along with its plans and the queries sent to each tablet.
+func vtgateExecuteInSession(session *vtgatepb.Session, sql string, bindVars map[string]*querypb.BindVariable) ([]*engine.Plan, map[string]*TabletActions, *sqltypes.Result, error) {
 	// use the plan cache to get the set of plans used for this query, then
 	// clear afterwards for the next run
 	planCache := vtgateExecutor.Plans()
 
-	_, err := vtgateExecutor.Execute(context.Background(), "VtexplainExecute", vtgate.NewSafeSession(vtgateSession), sql, nil)
+	result, err := vtgateExecutor.Execute(context.Background(), "VtexplainExecute", vtgate.NewSafeSession(session), sql, bindVars)
 	if err != nil {
 		for _, tc := range explainTopo.TabletConns {
 			tc.tabletQueries = nil
@@ -197,7 +206,7 @@ func vtgateExecute(sql string) ([]*engine.Plan, map[string]*TabletActions, error
 		}
 		planCache.Clear()
 
-		return nil, nil, vterrors.Wrapf(err, "vtexplain execute error in '%s'", sql)
+		return nil, nil, nil, vterrors.Wrapf(err, "vtexplain execute error in '%s'", sql)
 	}
 
 	var plans []*engine.Plan
@@ -229,5 +238,5 @@ func vtgateExecute(sql string) ([]*engine.Plan, map[string]*TabletActions, error
 		}()
 	}
 
-	return plans, tabletActions, nil
+	return plans, tabletActions, result, nil
 }
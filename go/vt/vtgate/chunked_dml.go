@@ -0,0 +1,135 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// chunkedDMLFields are the fields of the progress rows that StreamExecute
+// sends for a chunked DELETE, one row per chunk.
+var chunkedDMLFields = []*querypb.Field{{
+	Name: "chunk",
+	Type: sqltypes.Uint64,
+}, {
+	Name: "rows_affected",
+	Type: sqltypes.Uint64,
+}, {
+	Name: "total_rows_affected",
+	Type: sqltypes.Uint64,
+}}
+
+// chunkedDMLLimit returns the LIMIT of a DELETE carrying the CHUNKED_DML
+// directive without a chunk size, or 0 if the query is not one. With a chunk
+// size, the DML is planned as an engine.ChunkedDML instead.
+func chunkedDMLLimit(sql string) (uint64, error) {
+	// Most queries do not carry the directive, and need not be parsed here.
+	if !strings.Contains(sql, sqlparser.DirectiveChunkedDML) {
+		return 0, nil
+	}
+	stmt, err := sqlparser.Parse(sql)
+	if err != nil {
+		// The error is returned when planning the query.
+		return 0, nil
+	}
+	var comments sqlparser.Comments
+	switch stmt := stmt.(type) {
+	case *sqlparser.Delete:
+		comments = stmt.Comments
+	case *sqlparser.Update:
+		comments = stmt.Comments
+	default:
+		return 0, nil
+	}
+	if set, _ := sqlparser.ExtractCommentDirectives(comments)[sqlparser.DirectiveChunkedDML].(bool); !set {
+		return 0, nil
+	}
+	del, ok := stmt.(*sqlparser.Delete)
+	if !ok {
+		return 0, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%s without a chunk size is only supported for DELETE", sqlparser.DirectiveChunkedDML)
+	}
+
+	if del.Limit == nil || del.Limit.Offset != nil {
+		return 0, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%s requires a DELETE with a LIMIT", sqlparser.DirectiveChunkedDML)
+	}
+	lit, ok := del.Limit.Rowcount.(*sqlparser.Literal)
+	if !ok || lit.Type != sqlparser.IntVal {
+		return 0, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%s requires a DELETE with a literal LIMIT", sqlparser.DirectiveChunkedDML)
+	}
+	limit, err := strconv.ParseUint(lit.Val, 10, 64)
+	if err != nil || limit == 0 {
+		return 0, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%s requires a DELETE with a positive LIMIT", sqlparser.DirectiveChunkedDML)
+	}
+	return limit, nil
+}
+
+// executeChunkedDML executes a DELETE with a LIMIT until it deletes fewer
+// rows than the limit, so that a large delete is done in chunks that each
+// commit in autocommit mode. progress is called after each chunk, and the
+// total number of rows affected is returned.
+func (e *Executor) executeChunkedDML(ctx context.Context, method string, safeSession *SafeSession, sql string, bindVars map[string]*querypb.BindVariable, limit uint64, progress func(chunk, rowsAffected, total uint64) error) (uint64, error) {
+	var total uint64
+	for chunk := uint64(1); ; chunk++ {
+		logStats := NewLogStats(ctx, method, sql, bindVars)
+		stmtType, qr, err := e.execute(ctx, safeSession, sql, bindVars, logStats)
+		logStats.Error = err
+		saveSessionStats(safeSession, stmtType, qr, err)
+		logStats.Send()
+		if err != nil {
+			return total, err
+		}
+
+		total += qr.RowsAffected
+		if err := progress(chunk, qr.RowsAffected, total); err != nil {
+			return total, err
+		}
+		if qr.RowsAffected < limit {
+			safeSession.RowCount = int64(total)
+			return total, nil
+		}
+		if err := ctx.Err(); err != nil {
+			return total, vterrors.Errorf(vtrpcpb.Code_CANCELED, "chunked delete interrupted after %d chunks and %d rows: %v", chunk, total, err)
+		}
+	}
+}
+
+// streamExecuteChunkedDML executes a chunked DELETE, and streams a
+// progress row after each chunk.
+func (e *Executor) streamExecuteChunkedDML(ctx context.Context, method string, safeSession *SafeSession, sql string, bindVars map[string]*querypb.BindVariable, limit uint64, callback func(*sqltypes.Result) error) error {
+	if err := callback(&sqltypes.Result{Fields: chunkedDMLFields}); err != nil {
+		return err
+	}
+	_, err := e.executeChunkedDML(ctx, method, safeSession, sql, bindVars, limit, func(chunk, rowsAffected, total uint64) error {
+		return callback(&sqltypes.Result{
+			Rows: [][]sqltypes.Value{{
+				sqltypes.NewUint64(chunk),
+				sqltypes.NewUint64(rowsAffected),
+				sqltypes.NewUint64(total),
+			}},
+		})
+	})
+	return err
+}
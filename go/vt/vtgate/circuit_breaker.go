@@ -0,0 +1,325 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"flag"
+	"sort"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var (
+	circuitBreakerFailures      = flag.Int("gateway_circuit_breaker_failures", 0, "number of consecutive failures of a tablet after which the tablet gateway trips its circuit breaker and stops sending it queries. 0 disables this trigger")
+	circuitBreakerTimeouts      = flag.Int("gateway_circuit_breaker_timeouts", 0, "number of timeouts of a tablet within gateway_circuit_breaker_timeout_window after which the tablet gateway trips its circuit breaker. 0 disables this trigger")
+	circuitBreakerTimeoutWindow = flag.Duration("gateway_circuit_breaker_timeout_window", 10*time.Second, "window over which the timeouts of a tablet are counted for gateway_circuit_breaker_timeouts")
+	circuitBreakerOpenDuration  = flag.Duration("gateway_circuit_breaker_open_duration", 10*time.Second, "how long a tripped circuit breaker fails the queries of its tablet fast, before letting probe queries through")
+	circuitBreakerProbes        = flag.Int("gateway_circuit_breaker_probes", 3, "number of successful probe queries, sent one at a time, after which a tripped circuit breaker closes again")
+)
+
+// circuitBreakersEnabled returns true if any circuit breaker trigger is set.
+func circuitBreakersEnabled() bool {
+	return *circuitBreakerFailures > 0 || *circuitBreakerTimeouts > 0
+}
+
+type circuitBreakerState int
+
+const (
+	// circuitClosed lets all the queries through.
+	circuitClosed circuitBreakerState = iota
+	// circuitOpen fails all the queries fast.
+	circuitOpen
+	// circuitHalfOpen lets one probe query through at a time.
+	circuitHalfOpen
+)
+
+func (s circuitBreakerState) String() string {
+	switch s {
+	case circuitOpen:
+		return "OPEN"
+	case circuitHalfOpen:
+		return "HALF_OPEN"
+	default:
+		return "CLOSED"
+	}
+}
+
+// circuitBreaker tracks the failures of the queries sent to one tablet.
+// It trips after too many consecutive failures or timeouts, fails the
+// queries fast for gateway_circuit_breaker_open_duration, and then only
+// lets one probe query through at a time, until enough of them succeed.
+//
+// The healthcheck only hands healthy tablets to the gateway, so a tablet
+// is only probed while it reports itself healthy.
+//
+// The methods of a nil circuitBreaker let all the queries through.
+type circuitBreaker struct {
+	target *querypb.Target
+	alias  *topodatapb.TabletAlias
+
+	mu sync.Mutex
+	// state is the current state, since changed.
+	state   circuitBreakerState
+	changed time.Time
+	// failures is the count of consecutive failures.
+	failures int
+	// timeouts are the times of the recent timeouts.
+	timeouts []time.Time
+	// probing is true while a probe query is in flight.
+	probing bool
+	// probes is the count of successful probes since the breaker became
+	// half open.
+	probes int
+	// trips is the count of times the breaker tripped.
+	trips     int
+	lastError string
+}
+
+func newCircuitBreaker(target *querypb.Target, alias *topodatapb.TabletAlias) *circuitBreaker {
+	return &circuitBreaker{
+		target: target,
+		alias:  alias,
+	}
+}
+
+// allow returns true if a query may be sent to the tablet. If it does, the
+// outcome of the query must be passed to record.
+func (cb *circuitBreaker) allow(now time.Time) bool {
+	if cb == nil {
+		return true
+	}
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	switch cb.state {
+	case circuitOpen:
+		if now.Sub(cb.changed) < *circuitBreakerOpenDuration {
+			return false
+		}
+		cb.setState(circuitHalfOpen, now)
+		cb.probes = 0
+		cb.probing = true
+		return true
+	case circuitHalfOpen:
+		if cb.probing {
+			return false
+		}
+		cb.probing = true
+		return true
+	}
+	return true
+}
+
+// closed returns true if the breaker lets all the queries through.
+func (cb *circuitBreaker) closed() bool {
+	if cb == nil {
+		return true
+	}
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	return cb.state == circuitClosed
+}
+
+// record updates the breaker with the outcome of a query.
+func (cb *circuitBreaker) record(now time.Time, err error) {
+	if cb == nil {
+		return
+	}
+	code := vterrors.Code(err)
+	failure := code == vtrpcpb.Code_UNAVAILABLE || code == vtrpcpb.Code_DEADLINE_EXCEEDED
+
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	switch cb.state {
+	case circuitOpen:
+		// The query was sent before the breaker tripped.
+		return
+	case circuitHalfOpen:
+		cb.probing = false
+		if code == vtrpcpb.Code_CANCELED {
+			// The client gave up, or a hedge answered first: the probe
+			// tells nothing.
+			return
+		}
+		if failure {
+			cb.trip(now, err)
+			return
+		}
+		cb.probes++
+		if cb.probes >= *circuitBreakerProbes {
+			cb.setState(circuitClosed, now)
+			cb.failures = 0
+			cb.timeouts = nil
+		}
+		return
+	}
+
+	if code == vtrpcpb.Code_CANCELED {
+		// Nor does a canceled query tell that the tablet recovered.
+		return
+	}
+	if !failure {
+		cb.failures = 0
+		return
+	}
+	cb.failures++
+	if code == vtrpcpb.Code_DEADLINE_EXCEEDED {
+		cb.timeouts = append(cb.timeouts, now)
+		i := 0
+		for i < len(cb.timeouts) && now.Sub(cb.timeouts[i]) > *circuitBreakerTimeoutWindow {
+			i++
+		}
+		cb.timeouts = cb.timeouts[i:]
+	}
+	if (*circuitBreakerFailures > 0 && cb.failures >= *circuitBreakerFailures) ||
+		(*circuitBreakerTimeouts > 0 && len(cb.timeouts) >= *circuitBreakerTimeouts) {
+		cb.trip(now, err)
+	}
+}
+
+func (cb *circuitBreaker) trip(now time.Time, err error) {
+	cb.setState(circuitOpen, now)
+	cb.trips++
+	cb.lastError = err.Error()
+}
+
+func (cb *circuitBreaker) setState(state circuitBreakerState, now time.Time) {
+	cb.state = state
+	cb.changed = now
+}
+
+func (cb *circuitBreaker) status() *CircuitBreakerStatus {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	return &CircuitBreakerStatus{
+		Keyspace:   cb.target.Keyspace,
+		Shard:      cb.target.Shard,
+		TabletType: cb.target.TabletType,
+		Alias:      topoproto.TabletAliasString(cb.alias),
+		State:      cb.state.String(),
+		Since:      cb.changed,
+		Failures:   cb.failures,
+		Trips:      cb.trips,
+		LastError:  cb.lastError,
+	}
+}
+
+// circuitBreakerFor returns the circuit breaker of a tablet, or nil if the
+// circuit breakers are disabled.
+func (gw *TabletGateway) circuitBreakerFor(target *querypb.Target, tablet *topodatapb.Tablet) *circuitBreaker {
+	if !circuitBreakersEnabled() {
+		return nil
+	}
+	key := topoproto.TabletAliasString(tablet.Alias) + "/" + topoproto.TabletTypeLString(target.TabletType)
+	gw.mu.Lock()
+	defer gw.mu.Unlock()
+	cb, ok := gw.circuitBreakers[key]
+	if !ok {
+		cb = newCircuitBreaker(&querypb.Target{Keyspace: target.Keyspace, Shard: target.Shard, TabletType: target.TabletType}, tablet.Alias)
+		gw.circuitBreakers[key] = cb
+	}
+	return cb
+}
+
+// errCircuitBreakerOpen returns the error of a query failed fast because the
+// circuit breakers of all the healthy tablets of its target are open.
+func errCircuitBreakerOpen(target *querypb.Target) error {
+	return vterrors.NewErrorf(vtrpcpb.Code_UNAVAILABLE, vterrors.CircuitBreakerOpen, "circuit breaker open for all the healthy tablets of '%s'", target.String())
+}
+
+// CircuitBreakerStatus is the state of the circuit breaker of a tablet,
+// displayed on the status page.
+type CircuitBreakerStatus struct {
+	Keyspace   string
+	Shard      string
+	TabletType topodatapb.TabletType
+	Alias      string
+	State      string
+	Since      time.Time
+	Failures   int
+	Trips      int
+	LastError  string
+}
+
+// CircuitBreakerStatusList is a slice of CircuitBreakerStatus.
+type CircuitBreakerStatusList []*CircuitBreakerStatus
+
+// CircuitBreakerStatus returns the state of the circuit breakers of the
+// tablets, sorted by keyspace, shard, tablet type and alias.
+func (gw *TabletGateway) CircuitBreakerStatus() CircuitBreakerStatusList {
+	gw.mu.Lock()
+	breakers := make([]*circuitBreaker, 0, len(gw.circuitBreakers))
+	for _, cb := range gw.circuitBreakers {
+		breakers = append(breakers, cb)
+	}
+	gw.mu.Unlock()
+
+	res := make(CircuitBreakerStatusList, 0, len(breakers))
+	for _, cb := range breakers {
+		res = append(res, cb.status())
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Keyspace != res[j].Keyspace {
+			return res[i].Keyspace < res[j].Keyspace
+		}
+		if res[i].Shard != res[j].Shard {
+			return res[i].Shard < res[j].Shard
+		}
+		if res[i].TabletType != res[j].TabletType {
+			return res[i].TabletType < res[j].TabletType
+		}
+		return res[i].Alias < res[j].Alias
+	})
+	return res
+}
+
+// CircuitBreakerTemplate is the HTML template for the circuit breakers of
+// the tablet gateway.
+const CircuitBreakerTemplate = `
+<table>
+  <tr>
+    <th>Keyspace</th>
+    <th>Shard</th>
+    <th>TabletType</th>
+    <th>Tablet</th>
+    <th>State</th>
+    <th>Since</th>
+    <th>Consecutive Failures</th>
+    <th>Trips</th>
+    <th>Last Error</th>
+  </tr>
+  {{range $i, $status := .}}
+  <tr>
+    <td>{{$status.Keyspace}}</td>
+    <td>{{$status.Shard}}</td>
+    <td>{{$status.TabletType}}</td>
+    <td>{{$status.Alias}}</td>
+    <td>{{$status.State}}</td>
+    <td>{{if not $status.Since.IsZero}}{{$status.Since.Format "2006-01-02 15:04:05"}}{{end}}</td>
+    <td>{{$status.Failures}}</td>
+    <td>{{$status.Trips}}</td>
+    <td>{{$status.LastError}}</td>
+  </tr>
+  {{end}}
+</table>
+`
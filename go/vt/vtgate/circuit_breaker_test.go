@@ -0,0 +1,148 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/discovery"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+func setCircuitBreakerFlags(t *testing.T, failures, timeouts, probes int, openDuration time.Duration) {
+	savedFailures, savedTimeouts, savedProbes, savedOpenDuration := *circuitBreakerFailures, *circuitBreakerTimeouts, *circuitBreakerProbes, *circuitBreakerOpenDuration
+	t.Cleanup(func() {
+		*circuitBreakerFailures, *circuitBreakerTimeouts, *circuitBreakerProbes, *circuitBreakerOpenDuration = savedFailures, savedTimeouts, savedProbes, savedOpenDuration
+	})
+	*circuitBreakerFailures, *circuitBreakerTimeouts, *circuitBreakerProbes, *circuitBreakerOpenDuration = failures, timeouts, probes, openDuration
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	setCircuitBreakerFlags(t, 3, 0, 2, time.Second)
+	cb := newCircuitBreaker(&querypb.Target{Keyspace: "ks", Shard: "0"}, topo.NewTablet(1, "cell", "host").Alias)
+	unavailable := vterrors.New(vtrpcpb.Code_UNAVAILABLE, "unavailable")
+	now := time.Now()
+
+	// A success or an application error resets the consecutive failures.
+	cb.record(now, unavailable)
+	cb.record(now, unavailable)
+	cb.record(now, vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "bad query"))
+	cb.record(now, unavailable)
+	cb.record(now, unavailable)
+	assert.True(t, cb.allow(now))
+	assert.Equal(t, circuitClosed, cb.state)
+
+	// A canceled query does not reset them, and the third consecutive
+	// failure trips the breaker.
+	cb.record(now, vterrors.New(vtrpcpb.Code_CANCELED, "canceled"))
+	assert.Equal(t, circuitClosed, cb.state)
+	cb.record(now, unavailable)
+	assert.Equal(t, circuitOpen, cb.state)
+	assert.False(t, cb.allow(now.Add(500*time.Millisecond)))
+
+	// Once open for long enough, it lets one probe through at a time...
+	now = now.Add(time.Second)
+	assert.True(t, cb.allow(now))
+	assert.Equal(t, circuitHalfOpen, cb.state)
+	assert.False(t, cb.allow(now))
+	// ...and trips again if a probe fails.
+	cb.record(now, unavailable)
+	assert.Equal(t, circuitOpen, cb.state)
+	assert.False(t, cb.allow(now))
+
+	// It closes after enough successful probes.
+	now = now.Add(time.Second)
+	for i := 0; i < 2; i++ {
+		require.True(t, cb.allow(now))
+		assert.Equal(t, circuitHalfOpen, cb.state)
+		cb.record(now, nil)
+	}
+	assert.Equal(t, circuitClosed, cb.state)
+	assert.True(t, cb.allow(now))
+
+	status := cb.status()
+	assert.Equal(t, "CLOSED", status.State)
+	assert.Equal(t, "cell-0000000001", status.Alias)
+	assert.Equal(t, 2, status.Trips)
+	assert.Equal(t, "unavailable", status.LastError)
+
+	// A nil breaker lets everything through.
+	var disabled *circuitBreaker
+	assert.True(t, disabled.allow(now))
+	disabled.record(now, unavailable)
+}
+
+func TestCircuitBreakerTimeouts(t *testing.T) {
+	setCircuitBreakerFlags(t, 0, 3, 1, time.Second)
+	defer func(window time.Duration) { *circuitBreakerTimeoutWindow = window }(*circuitBreakerTimeoutWindow)
+	*circuitBreakerTimeoutWindow = 10 * time.Second
+	cb := newCircuitBreaker(&querypb.Target{Keyspace: "ks", Shard: "0"}, topo.NewTablet(1, "cell", "host").Alias)
+	timeout := vterrors.New(vtrpcpb.Code_DEADLINE_EXCEEDED, "timeout")
+	now := time.Now()
+
+	// The timeouts count within the window, even between successes.
+	cb.record(now, timeout)
+	cb.record(now, nil)
+	cb.record(now.Add(5*time.Second), timeout)
+	cb.record(now.Add(5*time.Second), nil)
+	cb.record(now.Add(11*time.Second), timeout)
+	assert.Equal(t, circuitClosed, cb.state)
+	cb.record(now.Add(12*time.Second), timeout)
+	assert.Equal(t, circuitOpen, cb.state)
+}
+
+func TestTabletGatewayCircuitBreaker(t *testing.T) {
+	setCircuitBreakerFlags(t, 2, 0, 1, time.Hour)
+	target := &querypb.Target{Keyspace: "ks", Shard: "0", TabletType: topodatapb.TabletType_REPLICA}
+	hc := discovery.NewFakeHealthCheck()
+	tg := NewTabletGateway(context.Background(), hc, nil, "cell")
+	sc := hc.AddTestTablet("cell", "1.1.1.1", 1001, "ks", "0", topodatapb.TabletType_REPLICA, true, 10, nil)
+	sc.MustFailCodes[vtrpcpb.Code_UNAVAILABLE] = 2
+
+	for i := 0; i < 2; i++ {
+		_, err := tg.Execute(context.Background(), target, "query", nil, 0, 0, nil)
+		require.Error(t, err)
+	}
+	assert.EqualValues(t, 2, sc.ExecCount.Get())
+
+	// The tripped breaker fails the queries fast, with its own error state.
+	_, err := tg.Execute(context.Background(), target, "query", nil, 0, 0, nil)
+	require.Error(t, err)
+	assert.Equal(t, vtrpcpb.Code_UNAVAILABLE, vterrors.Code(err))
+	assert.Equal(t, vterrors.CircuitBreakerOpen, vterrors.ErrState(err))
+	assert.Contains(t, err.Error(), "circuit breaker open")
+	assert.EqualValues(t, 2, sc.ExecCount.Get())
+	status := tg.CircuitBreakerStatus()
+	require.Len(t, status, 1)
+	assert.Equal(t, "OPEN", status[0].State)
+
+	// A successful probe closes it.
+	*circuitBreakerOpenDuration = 0
+	_, err = tg.Execute(context.Background(), target, "query", nil, 0, 0, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "CLOSED", tg.CircuitBreakerStatus()[0].State)
+}
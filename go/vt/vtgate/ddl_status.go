@@ -0,0 +1,131 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"flag"
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/srvtopo"
+	"vitess.io/vitess/go/vt/topo"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+var ddlStatusHistory = flag.Int("ddl_status_history", 100, "How many statuses of the DDLs applied to several shards vtgate keeps, for show vitess_ddl_status")
+
+// ddlStatuses keeps the statuses of the latest DDLs applied to several
+// shards by this vtgate. They are also saved in the topo, so that other
+// vtgates and vtctld can show them.
+type ddlStatuses struct {
+	serv    srvtopo.Server
+	history int
+
+	mu sync.Mutex
+	// statuses holds the statuses, oldest first.
+	statuses []*topodatapb.DDLStatus
+}
+
+func newDDLStatuses(serv srvtopo.Server, history int) *ddlStatuses {
+	return &ddlStatuses{
+		serv:    serv,
+		history: history,
+	}
+}
+
+// Save records a new or updated status. The oldest statuses beyond the
+// history are forgotten, and deleted from the topo. Failing to save a status
+// in the topo is only logged: it must not fail the DDL.
+func (ds *ddlStatuses) Save(status *topodatapb.DDLStatus) {
+	status = proto.Clone(status).(*topodatapb.DDLStatus)
+	evicted := ds.add(status)
+
+	ts, err := ds.serv.GetTopoServer()
+	if err != nil {
+		return
+	}
+	// The status is saved even if the query was canceled.
+	ctx, cancel := context.WithTimeout(context.Background(), *topo.RemoteOperationTimeout)
+	defer cancel()
+	if err := ts.SaveDDLStatus(ctx, status); err != nil {
+		log.Warningf("Cannot save the status of DDL %v: %v", status.Uuid, err)
+	}
+	for _, old := range evicted {
+		if err := ts.DeleteDDLStatus(ctx, old.Uuid); err != nil && !topo.IsErrType(err, topo.NoNode) {
+			log.Warningf("Cannot delete the status of DDL %v: %v", old.Uuid, err)
+		}
+	}
+}
+
+// add records the status, and returns the evicted ones.
+func (ds *ddlStatuses) add(status *topodatapb.DDLStatus) []*topodatapb.DDLStatus {
+	ds.mu.Lock()
+	defer ds.mu.Unlock()
+
+	for i, s := range ds.statuses {
+		if s.Uuid == status.Uuid {
+			ds.statuses[i] = status
+			return nil
+		}
+	}
+	ds.statuses = append(ds.statuses, status)
+	if len(ds.statuses) <= ds.history {
+		return nil
+	}
+	evicted := ds.statuses[:len(ds.statuses)-ds.history]
+	ds.statuses = append([]*topodatapb.DDLStatus(nil), ds.statuses[len(evicted):]...)
+	return evicted
+}
+
+// Get returns the status of the DDL with the given uuid, from memory or else
+// from the topo, or all the statuses kept in memory, oldest first, if uuid
+// is empty.
+func (ds *ddlStatuses) Get(ctx context.Context, uuid string) ([]*topodatapb.DDLStatus, error) {
+	ds.mu.Lock()
+	if uuid == "" {
+		statuses := make([]*topodatapb.DDLStatus, 0, len(ds.statuses))
+		for _, s := range ds.statuses {
+			statuses = append(statuses, proto.Clone(s).(*topodatapb.DDLStatus))
+		}
+		ds.mu.Unlock()
+		return statuses, nil
+	}
+	for _, s := range ds.statuses {
+		if s.Uuid == uuid {
+			ds.mu.Unlock()
+			return []*topodatapb.DDLStatus{proto.Clone(s).(*topodatapb.DDLStatus)}, nil
+		}
+	}
+	ds.mu.Unlock()
+
+	ts, err := ds.serv.GetTopoServer()
+	if err != nil {
+		return nil, nil
+	}
+	status, err := ts.GetDDLStatus(ctx, uuid)
+	switch {
+	case topo.IsErrType(err, topo.NoNode):
+		return nil, nil
+	case err != nil:
+		return nil, err
+	}
+	return []*topodatapb.DDLStatus{status}, nil
+}
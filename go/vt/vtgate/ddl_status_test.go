@@ -0,0 +1,63 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/srvtopo"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func TestDDLStatuses(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell")
+	ds := newDDLStatuses(srvtopo.NewResilientServer(ts, "TestDDLStatuses"), 2)
+
+	for _, uuid := range []string{"a", "b", "c"} {
+		ds.Save(&topodatapb.DDLStatus{Uuid: uuid, Keyspace: "ks", Shards: []*topodatapb.DDLShardStatus{{Shard: "-80"}, {Shard: "80-"}}})
+	}
+	ds.Save(&topodatapb.DDLStatus{Uuid: "c", Keyspace: "ks", Shards: []*topodatapb.DDLShardStatus{{Shard: "-80", State: topodatapb.DDLShardStatus_COMPLETE}}})
+
+	statuses, err := ds.Get(ctx, "")
+	require.NoError(t, err)
+	require.Len(t, statuses, 2)
+	assert.Equal(t, "b", statuses[0].Uuid)
+	assert.Equal(t, "c", statuses[1].Uuid)
+	assert.Equal(t, topodatapb.DDLShardStatus_COMPLETE, statuses[1].Shards[0].State)
+
+	// The evicted status is deleted from the topo.
+	_, err = ts.GetDDLStatus(ctx, "a")
+	assert.True(t, topo.IsErrType(err, topo.NoNode), "%v", err)
+	statuses, err = ds.Get(ctx, "a")
+	require.NoError(t, err)
+	assert.Empty(t, statuses)
+
+	// A status saved by another vtgate is read from the topo.
+	require.NoError(t, ts.SaveDDLStatus(ctx, &topodatapb.DDLStatus{Uuid: "d", Keyspace: "ks"}))
+	statuses, err = ds.Get(ctx, "d")
+	require.NoError(t, err)
+	require.Len(t, statuses, 1)
+	assert.Equal(t, "ks", statuses[0].Keyspace)
+}
@@ -115,11 +115,22 @@ func debugEnvHandler(vtg *VTGate, w http.ResponseWriter, r *http.Request) {
 			f(durationVal)
 			msg = fmt.Sprintf("Setting %v to: %v", varname, value)
 		}
+		setStringVal := func(f func(string) error) {
+			if err := f(value); err != nil {
+				msg = fmt.Sprintf("Failed setting value for %v: %v", varname, err)
+				return
+			}
+			msg = fmt.Sprintf("Setting %v to: %v", varname, value)
+		}
 		switch varname {
 		case "discovery_low_replication_lag":
 			setDurationVal(discovery.SetLowReplicationLag)
 		case "discovery_high_replication_lag_minimum_serving":
 			setDurationVal(discovery.SetHighReplicationLagMinServing)
+		case "discovery_keyspace_low_replication_lag":
+			setStringVal(discovery.SetKeyspaceLowReplicationLag)
+		case "discovery_keyspace_high_replication_lag_minimum_serving":
+			setStringVal(discovery.SetKeyspaceHighReplicationLagMinServing)
 		case "min_num_tablets":
 			setIntVal(discovery.SetMinNumTablets)
 		}
@@ -138,8 +149,16 @@ func debugEnvHandler(vtg *VTGate, w http.ResponseWriter, r *http.Request) {
 			Value:   fmt.Sprintf("%v", f()),
 		})
 	}
+	addStringVar := func(varname string, f func() string) {
+		vars = append(vars, envValue{
+			VarName: varname,
+			Value:   f(),
+		})
+	}
 	addDurationVar("discovery_low_replication_lag", discovery.GetLowReplicationLag)
 	addDurationVar("discovery_high_replication_lag_minimum_serving", discovery.GetHighReplicationLagMinServing)
+	addStringVar("discovery_keyspace_low_replication_lag", discovery.GetKeyspaceLowReplicationLag)
+	addStringVar("discovery_keyspace_high_replication_lag_minimum_serving", discovery.GetKeyspaceHighReplicationLagMinServing)
 	addIntVar("min_num_tablets", discovery.GetMinNumTablets)
 
 	format := r.FormValue("format")
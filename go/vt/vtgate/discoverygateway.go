@@ -82,6 +82,26 @@ func (dg *DiscoveryGateway) TabletsCacheStatus() discovery.TabletsCacheStatusLis
 	return nil
 }
 
+// PrimaryTablet is part of the Gateway interface.
+func (dg *DiscoveryGateway) PrimaryTablet(keyspace, shard string) *topodatapb.Tablet {
+	tablets := dg.tsc.GetHealthyTabletStats(keyspace, shard, topodatapb.TabletType_PRIMARY)
+	if len(tablets) == 0 {
+		return nil
+	}
+	return tablets[0].Tablet
+}
+
+// SetTabletRoutingRules is part of the Gateway interface. DiscoveryGateway
+// ignores the tablet routing rules.
+func (dg *DiscoveryGateway) SetTabletRoutingRules(rules map[string]map[string]string) {
+}
+
+// CircuitBreakerStatus is part of the Gateway interface. DiscoveryGateway
+// has no circuit breakers.
+func (dg *DiscoveryGateway) CircuitBreakerStatus() CircuitBreakerStatusList {
+	return nil
+}
+
 var _ Gateway = (*DiscoveryGateway)(nil)
 
 func createDiscoveryGateway(ctx context.Context, hc discovery.LegacyHealthCheck, serv srvtopo.Server, cell string, retryCount int) Gateway {
@@ -57,6 +57,24 @@ func (cached *AlterVSchema) CachedSize(alloc bool) int64 {
 	size += cached.AlterVschemaDDL.CachedSize(true)
 	return size
 }
+func (cached *ChunkedDML) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(56)
+	}
+	// field Keyspace *vitess.io/vitess/go/vt/vtgate/vindexes.Keyspace
+	size += cached.Keyspace.CachedSize(true)
+	// field TableName string
+	size += int64(len(cached.TableName))
+	// field DML vitess.io/vitess/go/vt/sqlparser.Statement
+	if cc, ok := cached.DML.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
 func (cached *Concatenate) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -319,7 +337,7 @@ func (cached *MStream) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(40)
+		size += int64(56)
 	}
 	// field Keyspace *vitess.io/vitess/go/vt/vtgate/vindexes.Keyspace
 	size += cached.Keyspace.CachedSize(true)
@@ -329,6 +347,47 @@ func (cached *MStream) CachedSize(alloc bool) int64 {
 	}
 	// field TableName string
 	size += int64(len(cached.TableName))
+	// field ConsumerGroup string
+	size += int64(len(cached.ConsumerGroup))
+	return size
+}
+func (cached *Mask) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(64)
+	}
+	// field Input vitess.io/vitess/go/vt/vtgate/engine.Primitive
+	if cc, ok := cached.Input.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	// field Columns []vitess.io/vitess/go/vt/vtgate/engine.MaskedColumn
+	{
+		size += int64(cap(cached.Columns)) * int64(40)
+		for _, elem := range cached.Columns {
+			size += elem.CachedSize(false)
+		}
+	}
+	// field Unresolved []*vitess.io/vitess/go/vt/vtgate/masking.Rule
+	{
+		size += int64(cap(cached.Unresolved)) * int64(8)
+	}
+	return size
+}
+func (cached *MaskedColumn) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(40)
+	}
+	// field Rules []*vitess.io/vitess/go/vt/vtgate/masking.Rule
+	{
+		size += int64(cap(cached.Rules)) * int64(8)
+	}
 	return size
 }
 func (cached *MemorySort) CachedSize(alloc bool) int64 {
@@ -357,7 +416,7 @@ func (cached *MergeSort) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(49)
+		size += int64(64)
 	}
 	// field Primitives []vitess.io/vitess/go/vt/vtgate/engine.StreamExecutor
 	{
@@ -432,10 +491,14 @@ func (cached *Plan) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(120)
+		size += int64(176)
 	}
 	// field Original string
 	size += int64(len(cached.Original))
+	// field Fingerprint string
+	size += int64(len(cached.Fingerprint))
+	// field Target string
+	size += int64(len(cached.Target))
 	// field Instructions vitess.io/vitess/go/vt/vtgate/engine.Primitive
 	if cc, ok := cached.Instructions.(cachedObject); ok {
 		size += cc.CachedSize(true)
@@ -449,6 +512,8 @@ func (cached *Plan) CachedSize(alloc bool) int64 {
 			size += elem.CachedSize(true)
 		}
 	}
+	// field WorkloadName string
+	size += int64(len(cached.WorkloadName))
 	return size
 }
 func (cached *Projection) CachedSize(alloc bool) int64 {
@@ -570,7 +635,7 @@ func (cached *Route) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(208)
+		size += int64(336)
 	}
 	// field Keyspace *vitess.io/vitess/go/vt/vtgate/vindexes.Keyspace
 	size += cached.Keyspace.CachedSize(true)
@@ -599,6 +664,8 @@ func (cached *Route) CachedSize(alloc bool) int64 {
 	{
 		size += int64(cap(cached.OrderBy)) * int64(32)
 	}
+	// field UpperLimit vitess.io/vitess/go/sqltypes.PlanValue
+	size += cached.UpperLimit.CachedSize(false)
 	// field SysTableTableSchema []vitess.io/vitess/go/vt/vtgate/evalengine.Expr
 	{
 		size += int64(cap(cached.SysTableTableSchema)) * int64(16)
@@ -625,6 +692,8 @@ func (cached *Route) CachedSize(alloc bool) int64 {
 			}
 		}
 	}
+	// field fieldCache vitess.io/vitess/go/vt/vtgate/engine.fieldCache
+	size += cached.fieldCache.CachedSize(false)
 	return size
 }
 func (cached *Rows) CachedSize(alloc bool) int64 {
@@ -872,6 +941,20 @@ func (cached *UserDefinedVariable) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *VExplain) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(24)
+	}
+	// field Input vitess.io/vitess/go/vt/vtgate/engine.Primitive
+	if cc, ok := cached.Input.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
 func (cached *VStream) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -947,6 +1030,19 @@ func (cached *VindexValues) CachedSize(alloc bool) int64 {
 	return size
 }
 
+func (cached *fieldCache) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(32)
+	}
+	// field fields *vitess.io/vitess/go/sqltypes.Result
+	size += cached.fields.CachedSize(true)
+	return size
+}
+
 //go:nocheckptr
 func (cached *shardRoute) CachedSize(alloc bool) int64 {
 	if cached == nil {
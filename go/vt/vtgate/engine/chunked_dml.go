@@ -0,0 +1,269 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"strconv"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/srvtopo"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var _ Primitive = (*ChunkedDML)(nil)
+
+const (
+	// ChunkedDMLThrottlerApp is the app name of the throttler checks of
+	// the chunked DMLs.
+	ChunkedDMLThrottlerApp = "chunked-dml"
+
+	chunkLowerBindVar = "__chunk_lower"
+	chunkUpperBindVar = "__chunk_upper"
+
+	primaryKeyQuery = "select column_name from information_schema.key_column_usage where table_schema = database() and table_name = :table_name and constraint_name = 'PRIMARY' order by ordinal_position"
+)
+
+// chunkedDMLThrottleInterval is how long a chunked DML waits before checking
+// the throttler again when it is throttled.
+var chunkedDMLThrottleInterval = time.Second
+
+// ChunkedDML executes a DELETE or UPDATE in chunks of primary key ranges,
+// each one in its own autocommit transaction, so that a large DML does not
+// become a huge transaction stalling the replication. The throttlers of the
+// primary tablets of the keyspace are checked between two chunks.
+// The chunks are committed independently of the transaction of the session,
+// if any.
+type ChunkedDML struct {
+	// Keyspace specifies the keyspace of the table.
+	Keyspace *vindexes.Keyspace
+
+	// TableName is the name of the table in the keyspace, whose primary
+	// key must be a single column.
+	TableName string
+
+	// ChunkSize is the maximum number of rows of a chunk.
+	ChunkSize uint64
+
+	// DML is the DELETE or UPDATE to execute, without the CHUNKED_DML
+	// directive.
+	DML sqlparser.Statement
+
+	noInputs
+
+	noTxNeeded
+}
+
+// RouteType is part of the Primitive interface
+func (c *ChunkedDML) RouteType() string {
+	return "ChunkedDML"
+}
+
+// GetKeyspaceName is part of the Primitive interface
+func (c *ChunkedDML) GetKeyspaceName() string {
+	return c.Keyspace.Name
+}
+
+// GetTableName is part of the Primitive interface
+func (c *ChunkedDML) GetTableName() string {
+	return c.TableName
+}
+
+// TryExecute is part of the Primitive interface
+func (c *ChunkedDML) TryExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, _ bool) (*sqltypes.Result, error) {
+	rss, _, err := vcursor.ResolveDestinations(c.Keyspace.Name, nil, []key.Destination{key.DestinationAllShards{}})
+	if err != nil {
+		return nil, err
+	}
+	if len(rss) == 0 {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNAVAILABLE, "no shard for keyspace %s", c.Keyspace.Name)
+	}
+	pk, err := c.primaryKey(vcursor, rss[0])
+	if err != nil {
+		return nil, err
+	}
+	if upd, ok := c.DML.(*sqlparser.Update); ok {
+		for _, expr := range upd.Exprs {
+			if expr.Name.Name.Equal(pk.Name) {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%s cannot update the primary key column %s", sqlparser.DirectiveChunkedDML, pk.Name.String())
+			}
+		}
+	}
+
+	var lower *sqltypes.Value
+	result := &sqltypes.Result{}
+	for chunk := 1; ; chunk++ {
+		if chunk > 1 {
+			if err := c.waitForThrottler(vcursor, rss); err != nil {
+				return nil, vterrors.Wrapf(err, "chunked %s interrupted after %d chunks and %d rows", c.dmlType(), chunk-1, result.RowsAffected)
+			}
+		}
+
+		chunkBindVars := make(map[string]*querypb.BindVariable, len(bindVars)+2)
+		for k, v := range bindVars {
+			chunkBindVars[k] = v
+		}
+		if lower != nil {
+			chunkBindVars[chunkLowerBindVar] = sqltypes.ValueBindVariable(*lower)
+		}
+
+		qr, err := vcursor.Execute("ChunkedDML", c.boundaryQuery(pk, lower != nil), chunkBindVars, false, vtgatepb.CommitOrder_AUTOCOMMIT)
+		if err != nil {
+			return nil, err
+		}
+		var upper *sqltypes.Value
+		if len(qr.Rows) > 0 {
+			upper = &qr.Rows[0][0]
+			chunkBindVars[chunkUpperBindVar] = sqltypes.ValueBindVariable(*upper)
+		}
+
+		qr, err = vcursor.Execute("ChunkedDML", c.chunkQuery(pk, lower != nil, upper != nil), chunkBindVars, false, vtgatepb.CommitOrder_AUTOCOMMIT)
+		if err != nil {
+			return nil, vterrors.Wrapf(err, "chunked %s failed after %d chunks and %d rows", c.dmlType(), chunk-1, result.RowsAffected)
+		}
+		result.RowsAffected += qr.RowsAffected
+		if upper == nil {
+			return result, nil
+		}
+		lower = upper
+	}
+}
+
+// TryStreamExecute is part of the Primitive interface
+func (c *ChunkedDML) TryStreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	qr, err := c.TryExecute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return err
+	}
+	return callback(qr)
+}
+
+// GetFields is part of the Primitive interface
+func (c *ChunkedDML) GetFields(VCursor, map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "[BUG] unreachable code for %q", sqlparser.String(c.DML))
+}
+
+// primaryKey returns the column of the primary key of the table.
+func (c *ChunkedDML) primaryKey(vcursor VCursor, rs *srvtopo.ResolvedShard) (*sqlparser.ColName, error) {
+	qr, err := vcursor.ExecuteStandalone(primaryKeyQuery, map[string]*querypb.BindVariable{
+		"table_name": sqltypes.StringBindVariable(c.TableName),
+	}, rs)
+	if err != nil {
+		return nil, err
+	}
+	if len(qr.Rows) != 1 {
+		return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "%s requires a table with a single column primary key, table %s has %d primary key columns", sqlparser.DirectiveChunkedDML, c.TableName, len(qr.Rows))
+	}
+	return sqlparser.NewColName(qr.Rows[0][0].ToString()), nil
+}
+
+// waitForThrottler waits until the throttlers of the shards let the chunked
+// DML proceed.
+func (c *ChunkedDML) waitForThrottler(vcursor VCursor, rss []*srvtopo.ResolvedShard) error {
+	for !vcursor.CheckThrottler(rss, ChunkedDMLThrottlerApp) {
+		select {
+		case <-vcursor.Context().Done():
+			return vterrors.Errorf(vtrpcpb.Code_DEADLINE_EXCEEDED, "throttled: %v", vcursor.Context().Err())
+		case <-time.After(chunkedDMLThrottleInterval):
+		}
+	}
+	return nil
+}
+
+// boundaryQuery returns the query selecting the last primary key of the next
+// chunk. It returns no row for the last chunk.
+func (c *ChunkedDML) boundaryQuery(pk *sqlparser.ColName, hasLower bool) string {
+	var from sqlparser.TableExprs
+	var where *sqlparser.Where
+	switch dml := c.DML.(type) {
+	case *sqlparser.Delete:
+		from, where = dml.TableExprs, dml.Where
+	case *sqlparser.Update:
+		from, where = dml.TableExprs, dml.Where
+	}
+	sel := &sqlparser.Select{
+		From:        from,
+		SelectExprs: sqlparser.SelectExprs{&sqlparser.AliasedExpr{Expr: pk}},
+		Where:       chunkWhere(where, pk, hasLower, false),
+		OrderBy:     sqlparser.OrderBy{&sqlparser.Order{Expr: pk, Direction: sqlparser.AscOrder}},
+		Limit: &sqlparser.Limit{
+			Offset:   sqlparser.NewIntLiteral(strconv.FormatUint(c.ChunkSize-1, 10)),
+			Rowcount: sqlparser.NewIntLiteral("1"),
+		},
+	}
+	return sqlparser.String(sel)
+}
+
+// chunkQuery returns the DML restricted to a chunk.
+func (c *ChunkedDML) chunkQuery(pk *sqlparser.ColName, hasLower, hasUpper bool) string {
+	switch dml := c.DML.(type) {
+	case *sqlparser.Delete:
+		chunk := *dml
+		chunk.Where = chunkWhere(dml.Where, pk, hasLower, hasUpper)
+		return sqlparser.String(&chunk)
+	case *sqlparser.Update:
+		chunk := *dml
+		chunk.Where = chunkWhere(dml.Where, pk, hasLower, hasUpper)
+		return sqlparser.String(&chunk)
+	}
+	return ""
+}
+
+func (c *ChunkedDML) dmlType() string {
+	if _, ok := c.DML.(*sqlparser.Update); ok {
+		return "update"
+	}
+	return "delete"
+}
+
+// chunkWhere adds the bounds of a chunk to a WHERE clause.
+func chunkWhere(where *sqlparser.Where, pk *sqlparser.ColName, hasLower, hasUpper bool) *sqlparser.Where {
+	var exprs []sqlparser.Expr
+	if where != nil {
+		exprs = append(exprs, where.Expr)
+	}
+	if hasLower {
+		exprs = append(exprs, &sqlparser.ComparisonExpr{Operator: sqlparser.GreaterThanOp, Left: pk, Right: sqlparser.NewArgument(chunkLowerBindVar)})
+	}
+	if hasUpper {
+		exprs = append(exprs, &sqlparser.ComparisonExpr{Operator: sqlparser.LessEqualOp, Left: pk, Right: sqlparser.NewArgument(chunkUpperBindVar)})
+	}
+	if len(exprs) == 0 {
+		return nil
+	}
+	return sqlparser.NewWhere(sqlparser.WhereClause, sqlparser.AndExpressions(exprs...))
+}
+
+func (c *ChunkedDML) description() PrimitiveDescription {
+	other := map[string]interface{}{
+		"ChunkSize": c.ChunkSize,
+		"Query":     sqlparser.String(c.DML),
+		"Table":     c.TableName,
+	}
+	return PrimitiveDescription{
+		OperatorType: "ChunkedDML",
+		Keyspace:     c.Keyspace,
+		Other:        other,
+	}
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func newTestChunkedDML(t *testing.T, query string) *ChunkedDML {
+	stmt, err := sqlparser.Parse(query)
+	require.NoError(t, err)
+	return &ChunkedDML{
+		Keyspace:  &vindexes.Keyspace{Name: "ks", Sharded: true},
+		TableName: "t",
+		ChunkSize: 2,
+		DML:       stmt,
+	}
+}
+
+func TestChunkedDelete(t *testing.T) {
+	defer func(d time.Duration) { chunkedDMLThrottleInterval = d }(chunkedDMLThrottleInterval)
+	chunkedDMLThrottleInterval = time.Millisecond
+
+	pk := sqltypes.MakeTestResult(sqltypes.MakeTestFields("column_name", "varchar"), "id")
+	boundary := sqltypes.MakeTestResult(sqltypes.MakeTestFields("id", "int64"), "4")
+	vc := &loggingVCursor{
+		shards: []string{"-20", "20-"},
+		results: []*sqltypes.Result{
+			pk,
+			boundary,
+			{RowsAffected: 2},
+			{},
+			{RowsAffected: 1},
+		},
+		throttled: []bool{true},
+	}
+	del := newTestChunkedDML(t, "delete from t where col = :vtg1")
+	qr, err := del.TryExecute(vc, map[string]*querypb.BindVariable{"vtg1": sqltypes.Int64BindVariable(5)}, false)
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, qr.RowsAffected)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [] Destinations:DestinationAllShards()`,
+		`ExecuteStandalone ` + primaryKeyQuery + ` table_name: type:VARBINARY value:"t" ks -20`,
+		`ExecuteAutocommit select id from t where col = :vtg1 order by id asc limit 1, 1 vtg1: type:INT64 value:"5" false`,
+		`ExecuteAutocommit delete from t where col = :vtg1 and id <= :__chunk_upper __chunk_upper: type:INT64 value:"4" vtg1: type:INT64 value:"5" false`,
+		`CheckThrottler ks.-20,ks.20- chunked-dml`,
+		`CheckThrottler ks.-20,ks.20- chunked-dml`,
+		`ExecuteAutocommit select id from t where col = :vtg1 and id > :__chunk_lower order by id asc limit 1, 1 __chunk_lower: type:INT64 value:"4" vtg1: type:INT64 value:"5" false`,
+		`ExecuteAutocommit delete from t where col = :vtg1 and id > :__chunk_lower __chunk_lower: type:INT64 value:"4" vtg1: type:INT64 value:"5" false`,
+	})
+}
+
+func TestChunkedUpdatePrimaryKey(t *testing.T) {
+	vc := &loggingVCursor{
+		shards:  []string{"0"},
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(sqltypes.MakeTestFields("column_name", "varchar"), "id")},
+	}
+	upd := newTestChunkedDML(t, "update t set id = id + 1")
+	_, err := upd.TryExecute(vc, nil, false)
+	require.EqualError(t, err, "CHUNKED_DML cannot update the primary key column id")
+}
+
+func TestChunkedDMLCompositePrimaryKey(t *testing.T) {
+	vc := &loggingVCursor{
+		shards:  []string{"0"},
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(sqltypes.MakeTestFields("column_name", "varchar"), "a", "b")},
+	}
+	del := newTestChunkedDML(t, "delete from t")
+	_, err := del.TryExecute(vc, nil, false)
+	require.EqualError(t, err, "CHUNKED_DML requires a table with a single column primary key, table t has 2 primary key columns")
+}
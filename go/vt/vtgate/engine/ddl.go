@@ -17,11 +17,18 @@ limitations under the License.
 package engine
 
 import (
+	"sync"
+	"time"
+
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/logutil"
 	"vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/schema"
 	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/srvtopo"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
 )
@@ -109,8 +116,86 @@ func (ddl *DDL) TryExecute(vcursor VCursor, bindVars map[string]*query.BindVaria
 		if !ddl.DirectDDLEnabled {
 			return nil, schema.ErrDirectDDLDisabled
 		}
+		return ddl.executeNormalDDL(vcursor, bindVars, wantfields)
+	}
+}
+
+// executeNormalDDL sends the DDL to its shards. When there are several
+// shards, it is applied to each one in parallel, and the status of each
+// shard is recorded with vcursor.SaveDDLStatus, for show vitess_ddl_status.
+func (ddl *DDL) executeNormalDDL(vcursor VCursor, bindVars map[string]*query.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	rss, _, err := vcursor.ResolveDestinations(ddl.NormalDDL.Keyspace.Name, nil, []key.Destination{ddl.NormalDDL.TargetDestination})
+	if err != nil {
+		return nil, err
+	}
+	if len(rss) <= 1 {
 		return vcursor.ExecutePrimitive(ddl.NormalDDL, bindVars, wantfields)
 	}
+
+	uuid, err := schema.CreateUUID()
+	if err != nil {
+		return nil, err
+	}
+	status := &topodatapb.DDLStatus{
+		Uuid:      uuid,
+		Keyspace:  ddl.NormalDDL.Keyspace.Name,
+		Sql:       ddl.NormalDDL.Query,
+		StartedAt: logutil.TimeToProto(time.Now()),
+	}
+	for _, rs := range rss {
+		status.Shards = append(status.Shards, &topodatapb.DDLShardStatus{
+			Shard: rs.Target.Shard,
+			State: topodatapb.DDLShardStatus_QUEUED,
+		})
+	}
+
+	// mu protects status, and serializes its saves.
+	var mu sync.Mutex
+	setState := func(i int, state topodatapb.DDLShardStatus_State, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		status.Shards[i].State = state
+		if err != nil {
+			status.Shards[i].Error = err.Error()
+		}
+		vcursor.SaveDDLStatus(status)
+	}
+	vcursor.SaveDDLStatus(status)
+
+	var wg sync.WaitGroup
+	result := &sqltypes.Result{}
+	var failures []error
+	for i, rs := range rss {
+		wg.Add(1)
+		go func(i int, rs *srvtopo.ResolvedShard) {
+			defer wg.Done()
+			setState(i, topodatapb.DDLShardStatus_RUNNING, nil)
+			qr, errs := vcursor.ExecuteMultiShard([]*srvtopo.ResolvedShard{rs}, []*query.BoundQuery{{
+				Sql:           ddl.NormalDDL.Query,
+				BindVariables: bindVars,
+			}}, false, false)
+			if err := vterrors.Aggregate(errs); err != nil {
+				mu.Lock()
+				failures = append(failures, err)
+				mu.Unlock()
+				setState(i, topodatapb.DDLShardStatus_FAILED, err)
+				return
+			}
+			mu.Lock()
+			result.AppendResult(qr)
+			mu.Unlock()
+			setState(i, topodatapb.DDLShardStatus_COMPLETE, nil)
+		}(i, rs)
+	}
+	wg.Wait()
+
+	status.CompletedAt = logutil.TimeToProto(time.Now())
+	vcursor.SaveDDLStatus(status)
+	if len(failures) > 0 {
+		code := vterrors.Code(vterrors.Aggregate(failures))
+		return nil, vterrors.Errorf(code, "DDL %s failed on %d of %d shards, see show vitess_ddl_status '%s'", uuid, len(failures), len(rss), uuid)
+	}
+	return result, nil
 }
 
 // StreamExecute implements the Primitive interface
@@ -0,0 +1,109 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"errors"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func newTestDDL(t *testing.T, query string) *DDL {
+	stmt, err := sqlparser.Parse(query)
+	require.NoError(t, err)
+	ks := &vindexes.Keyspace{Name: "ks", Sharded: true}
+	return &DDL{
+		Keyspace: ks,
+		SQL:      query,
+		DDL:      stmt.(sqlparser.DDLStatement),
+		NormalDDL: &Send{
+			Keyspace:          ks,
+			TargetDestination: key.DestinationAllShards{},
+			Query:             query,
+		},
+		OnlineDDL:        &OnlineDDL{Keyspace: ks, DDL: stmt.(sqlparser.DDLStatement), SQL: query},
+		DirectDDLEnabled: true,
+	}
+}
+
+func TestDDLStatus(t *testing.T) {
+	ddl := newTestDDL(t, "alter table t add column c int")
+	vc := &loggingVCursor{shards: []string{"-20", "20-"}}
+	_, err := ddl.TryExecute(vc, nil, false)
+	require.NoError(t, err)
+
+	sort.Strings(vc.log)
+	vc.ExpectLog(t, []string{
+		`ExecuteMultiShard ks.-20: alter table t add column c int {} false false`,
+		`ExecuteMultiShard ks.20-: alter table t add column c int {} false false`,
+		`ResolveDestinations ks [] Destinations:DestinationAllShards()`,
+	})
+
+	statuses, err := vc.GetDDLStatuses("")
+	require.NoError(t, err)
+	require.Len(t, statuses, 1)
+	status := statuses[0]
+	assert.Equal(t, "ks", status.Keyspace)
+	assert.Equal(t, "alter table t add column c int", status.Sql)
+	assert.NotNil(t, status.StartedAt)
+	assert.NotNil(t, status.CompletedAt)
+	require.Len(t, status.Shards, 2)
+	for i, shard := range []string{"-20", "20-"} {
+		assert.Equal(t, shard, status.Shards[i].Shard)
+		assert.Equal(t, topodatapb.DDLShardStatus_COMPLETE, status.Shards[i].State)
+	}
+}
+
+func TestDDLStatusFailure(t *testing.T) {
+	ddl := newTestDDL(t, "alter table t add column c int")
+	vc := &loggingVCursor{shards: []string{"-20", "20-"}, resultErr: errors.New("duplicate column name")}
+	_, err := ddl.TryExecute(vc, nil, false)
+	require.Error(t, err)
+
+	statuses, err2 := vc.GetDDLStatuses("")
+	require.NoError(t, err2)
+	require.Len(t, statuses, 1)
+	status := statuses[0]
+	assert.EqualError(t, err, "DDL "+status.Uuid+" failed on 2 of 2 shards, see show vitess_ddl_status '"+status.Uuid+"'")
+	for _, shard := range status.Shards {
+		assert.Equal(t, topodatapb.DDLShardStatus_FAILED, shard.State)
+		assert.Equal(t, "duplicate column name", shard.Error)
+	}
+}
+
+func TestDDLSingleShard(t *testing.T) {
+	ddl := newTestDDL(t, "alter table t add column c int")
+	vc := &loggingVCursor{shards: []string{"0"}}
+	_, err := ddl.TryExecute(vc, nil, false)
+	require.NoError(t, err)
+
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [] Destinations:DestinationAllShards()`,
+		`ResolveDestinations ks [] Destinations:DestinationAllShards()`,
+		`ExecuteMultiShard ks.0: alter table t add column c int {} false false`,
+	})
+	assert.Empty(t, vc.ddlStatuses)
+}
@@ -135,7 +135,7 @@ func (del *Delete) execDeleteEqual(vcursor VCursor, bindVars map[string]*querypb
 		return &sqltypes.Result{}, nil
 	}
 	if del.OwnedVindexQuery != "" {
-		err = del.deleteVindexEntries(vcursor, bindVars, []*srvtopo.ResolvedShard{rs})
+		err = del.deleteVindexEntries(vcursor, []*srvtopo.ResolvedShard{rs}, []map[string]*querypb.BindVariable{bindVars})
 		if err != nil {
 			return nil, err
 		}
@@ -154,7 +154,7 @@ func (del *Delete) execDeleteIn(vcursor VCursor, bindVars map[string]*querypb.Bi
 	}
 
 	if del.OwnedVindexQuery != "" {
-		if err := del.deleteVindexEntries(vcursor, bindVars, rss); err != nil {
+		if err := del.deleteVindexEntries(vcursor, rss, queryBindVars(queries)); err != nil {
 			return nil, err
 		}
 	}
@@ -179,7 +179,7 @@ func (del *Delete) execDeleteByDestination(vcursor VCursor, bindVars map[string]
 		}
 	}
 	if len(del.Table.Owned) > 0 {
-		err = del.deleteVindexEntries(vcursor, bindVars, rss)
+		err = del.deleteVindexEntries(vcursor, rss, queryBindVars(queries))
 		if err != nil {
 			return nil, err
 		}
@@ -190,11 +190,8 @@ func (del *Delete) execDeleteByDestination(vcursor VCursor, bindVars map[string]
 // deleteVindexEntries performs an delete if table owns vindex.
 // Note: the commit order may be different from the DML order because it's possible
 // for DMLs to reuse existing transactions.
-func (del *Delete) deleteVindexEntries(vcursor VCursor, bindVars map[string]*querypb.BindVariable, rss []*srvtopo.ResolvedShard) error {
-	queries := make([]*querypb.BoundQuery, len(rss))
-	for i := range rss {
-		queries[i] = &querypb.BoundQuery{Sql: del.OwnedVindexQuery, BindVariables: bindVars}
-	}
+func (del *Delete) deleteVindexEntries(vcursor VCursor, rss []*srvtopo.ResolvedShard, bvs []map[string]*querypb.BindVariable) error {
+	queries := getQueries(del.OwnedVindexQuery, bvs)
 	subQueryResults, errors := vcursor.ExecuteMultiShard(rss, queries, false, false)
 	for _, err := range errors {
 		if err != nil {
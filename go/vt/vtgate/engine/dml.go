@@ -18,6 +18,7 @@ package engine
 
 import (
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/stats"
 	"vitess.io/vitess/go/vt/key"
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	"vitess.io/vitess/go/vt/srvtopo"
@@ -88,6 +89,8 @@ const (
 	ByDestination
 )
 
+var inListValuesPruned = stats.NewCounter("InListValuesPruned", "Count of the values of the large IN lists of DML statements that were not sent to the shards they do not target")
+
 var opcodeName = map[DMLOpcode]string{
 	Unsharded:     "Unsharded",
 	Equal:         "Equal",
@@ -105,20 +108,65 @@ func resolveMultiValueShards(vcursor VCursor, keyspace *vindexes.Keyspace, query
 	if err != nil {
 		return nil, nil, err
 	}
-	rss, err := resolveMultiShard(vcursor, vindex, keyspace, keys)
+	threshold := vcursor.InListPruningThreshold()
+	if pv.ListKey == "" || threshold <= 0 || len(keys) < threshold {
+		rss, err := resolveMultiShard(vcursor, vindex, keyspace, keys)
+		if err != nil {
+			return nil, nil, err
+		}
+		queries := make([]*querypb.BoundQuery, len(rss))
+		for i := range rss {
+			queries[i] = &querypb.BoundQuery{
+				Sql:           query,
+				BindVariables: bindVars,
+			}
+		}
+		return rss, queries, nil
+	}
+
+	// The list is large: each shard only receives the values that target it.
+	rss, values, err := resolveShards(vcursor, vindex, keyspace, keys)
 	if err != nil {
 		return nil, nil, err
 	}
 	queries := make([]*querypb.BoundQuery, len(rss))
-	for i := range rss {
+	for i, bv := range listVars(bindVars, pv.ListKey, values) {
 		queries[i] = &querypb.BoundQuery{
 			Sql:           query,
-			BindVariables: bindVars,
+			BindVariables: bv,
 		}
 	}
+	inListValuesPruned.Add(int64(len(keys)*len(rss) - len(keys)))
 	return rss, queries, nil
 }
 
+// listVars returns the bind variables of each shard, where the list bind
+// variable only holds the values of the shard.
+func listVars(bindVars map[string]*querypb.BindVariable, listKey string, values [][]*querypb.Value) []map[string]*querypb.BindVariable {
+	bvs := make([]map[string]*querypb.BindVariable, len(values))
+	for i, vals := range values {
+		bv := make(map[string]*querypb.BindVariable, len(bindVars))
+		for k, v := range bindVars {
+			bv[k] = v
+		}
+		bv[listKey] = &querypb.BindVariable{
+			Type:   querypb.Type_TUPLE,
+			Values: vals,
+		}
+		bvs[i] = bv
+	}
+	return bvs
+}
+
+// queryBindVars returns the bind variables of the queries.
+func queryBindVars(queries []*querypb.BoundQuery) []map[string]*querypb.BindVariable {
+	bvs := make([]map[string]*querypb.BindVariable, len(queries))
+	for i, query := range queries {
+		bvs[i] = query.BindVariables
+	}
+	return bvs
+}
+
 func execMultiShard(vcursor VCursor, rss []*srvtopo.ResolvedShard, queries []*querypb.BoundQuery, multiShardAutoCommit bool) (*sqltypes.Result, error) {
 	autocommit := (len(rss) == 1 || multiShardAutoCommit) && vcursor.AutocommitApproval()
 	result, errs := vcursor.ExecuteMultiShard(rss, queries, true /* rollbackOnError */, autocommit)
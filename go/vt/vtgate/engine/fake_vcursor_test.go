@@ -28,6 +28,7 @@ import (
 	"time"
 
 	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
 
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/test/utils"
@@ -35,6 +36,7 @@ import (
 	"vitess.io/vitess/go/vt/schema"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/srvtopo"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
 
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
@@ -45,6 +47,7 @@ import (
 
 var testMaxMemoryRows = 100
 var testIgnoreMaxMemoryRows = false
+var testInListPruningThreshold = 0
 
 var _ VCursor = (*noopVCursor)(nil)
 var _ SessionActions = (*noopVCursor)(nil)
@@ -70,7 +73,19 @@ func (t *noopVCursor) VStream(rss []*srvtopo.ResolvedShard, filter *binlogdatapb
 	panic("implement me")
 }
 
-func (t *noopVCursor) MessageStream(rss []*srvtopo.ResolvedShard, tableName string, callback func(*sqltypes.Result) error) error {
+func (t *noopVCursor) CheckThrottler(rss []*srvtopo.ResolvedShard, appName string) bool {
+	panic("implement me")
+}
+
+func (t *noopVCursor) SaveDDLStatus(status *topodatapb.DDLStatus) {
+	panic("implement me")
+}
+
+func (t *noopVCursor) GetDDLStatuses(uuid string) ([]*topodatapb.DDLStatus, error) {
+	panic("implement me")
+}
+
+func (t *noopVCursor) MessageStream(rss []*srvtopo.ResolvedShard, tableName, consumerGroup string, callback func(*sqltypes.Result) error) error {
 	panic("implement me")
 }
 
@@ -86,6 +101,10 @@ func (t *noopVCursor) GetDDLStrategy() string {
 	panic("implement me")
 }
 
+func (t *noopVCursor) GetSQLMode() evalengine.SQLMode {
+	return 0
+}
+
 func (t *noopVCursor) GetSessionUUID() string {
 	panic("implement me")
 }
@@ -173,10 +192,34 @@ func (t *noopVCursor) SetSkipQueryPlanCache(bool) error {
 	panic("implement me")
 }
 
+func (t *noopVCursor) SetSkipKeyspacePolicy(bool) error {
+	panic("implement me")
+}
+
 func (t *noopVCursor) SetSQLSelectLimit(int64) error {
 	panic("implement me")
 }
 
+func (t *noopVCursor) SetMaxExecutionTime(int64) error {
+	panic("implement me")
+}
+
+func (t *noopVCursor) SetWorkloadName(string) {
+	panic("implement me")
+}
+
+func (t *noopVCursor) SetTenant(string) error {
+	panic("implement me")
+}
+
+func (t *noopVCursor) SetPriority(querypb.ExecuteOptions_Priority) {
+	panic("implement me")
+}
+
+func (t *noopVCursor) SetConsistentSnapshotReads(bool) error {
+	panic("implement me")
+}
+
 func (t *noopVCursor) SetTransactionMode(vtgatepb.TransactionMode) {
 	panic("implement me")
 }
@@ -203,6 +246,18 @@ func (t *noopVCursor) MaxMemoryRows() int {
 	return testMaxMemoryRows
 }
 
+func (t *noopVCursor) InListPruningThreshold() int {
+	return testInListPruningThreshold
+}
+
+func (t *noopVCursor) FieldCacheVersion() (int64, bool) {
+	return 0, false
+}
+
+func (t *noopVCursor) PointQueryBatching() (time.Duration, int, string) {
+	return 0, 0, ""
+}
+
 func (t *noopVCursor) ExceedsMaxMemoryRows(numRows int) bool {
 	return !testIgnoreMaxMemoryRows && numRows > testMaxMemoryRows
 }
@@ -293,6 +348,23 @@ type loggingVCursor struct {
 	tableRoutes tableRoutes
 	dbDDLPlugin string
 	ksAvailable bool
+	sqlMode     evalengine.SQLMode
+
+	// throttled are the results of the next throttler checks, the checks
+	// pass once they are used up.
+	throttled []bool
+
+	// ddlStatuses are the saved statuses of the DDLs, by uuid.
+	ddlStatuses map[string]*topodatapb.DDLStatus
+
+	// cacheFields enables the field cache, at schemaVersion.
+	cacheFields   bool
+	schemaVersion int64
+
+	// batchWindow and batchSize enable the batching of the point selects.
+	batchWindow time.Duration
+	batchSize   int
+	batchKey    string
 }
 
 type tableRoutes struct {
@@ -307,6 +379,14 @@ func (f *loggingVCursor) StreamExecutePrimitive(primitive Primitive, bindVars ma
 	return primitive.TryStreamExecute(f, bindVars, wantfields, callback)
 }
 
+func (f *loggingVCursor) PointQueryBatching() (time.Duration, int, string) {
+	return f.batchWindow, f.batchSize, f.batchKey
+}
+
+func (f *loggingVCursor) FieldCacheVersion() (int64, bool) {
+	return f.schemaVersion, f.cacheFields
+}
+
 func (f *loggingVCursor) KeyspaceAvailable(ks string) bool {
 	return f.ksAvailable
 }
@@ -351,6 +431,10 @@ func (f *loggingVCursor) Session() SessionActions {
 	return f
 }
 
+func (f *loggingVCursor) GetSQLMode() evalengine.SQLMode {
+	return f.sqlMode
+}
+
 func (f *loggingVCursor) SetTarget(target string) error {
 	f.log = append(f.log, fmt.Sprintf("Target set to %s", target))
 	return nil
@@ -398,6 +482,8 @@ func (f *loggingVCursor) Execute(_ string, query string, bindvars map[string]*qu
 }
 
 func (f *loggingVCursor) ExecuteMultiShard(rss []*srvtopo.ResolvedShard, queries []*querypb.BoundQuery, rollbackOnError, canAutocommit bool) (*sqltypes.Result, []error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
 	f.log = append(f.log, fmt.Sprintf("ExecuteMultiShard %v%v %v", printResolvedShardQueries(rss, queries), rollbackOnError, canAutocommit))
 	res, err := f.nextResult()
 	if err != nil {
@@ -421,6 +507,41 @@ func (f *loggingVCursor) ExecuteStandalone(query string, bindvars map[string]*qu
 	return f.nextResult()
 }
 
+func (f *loggingVCursor) CheckThrottler(rss []*srvtopo.ResolvedShard, appName string) bool {
+	var shards []string
+	for _, rs := range rss {
+		shards = append(shards, rs.Target.Keyspace+"."+rs.Target.Shard)
+	}
+	f.log = append(f.log, fmt.Sprintf("CheckThrottler %s %s", strings.Join(shards, ","), appName))
+	if len(f.throttled) == 0 {
+		return true
+	}
+	throttled := f.throttled[0]
+	f.throttled = f.throttled[1:]
+	return !throttled
+}
+
+func (f *loggingVCursor) GetDDLStrategy() string {
+	return ""
+}
+
+func (f *loggingVCursor) SaveDDLStatus(status *topodatapb.DDLStatus) {
+	if f.ddlStatuses == nil {
+		f.ddlStatuses = make(map[string]*topodatapb.DDLStatus)
+	}
+	f.ddlStatuses[status.Uuid] = proto.Clone(status).(*topodatapb.DDLStatus)
+}
+
+func (f *loggingVCursor) GetDDLStatuses(uuid string) ([]*topodatapb.DDLStatus, error) {
+	var statuses []*topodatapb.DDLStatus
+	for _, status := range f.ddlStatuses {
+		if uuid == "" || status.Uuid == uuid {
+			statuses = append(statuses, status)
+		}
+	}
+	return statuses, nil
+}
+
 func (f *loggingVCursor) StreamExecuteMulti(query string, rss []*srvtopo.ResolvedShard, bindVars []map[string]*querypb.BindVariable, callback func(reply *sqltypes.Result) error) []error {
 	f.mu.Lock()
 	f.log = append(f.log, fmt.Sprintf("StreamExecuteMulti %s %s", query, printResolvedShardsBindVars(rss, bindVars)))
@@ -537,10 +658,34 @@ func (f *loggingVCursor) SetSkipQueryPlanCache(bool) error {
 	panic("implement me")
 }
 
+func (f *loggingVCursor) SetSkipKeyspacePolicy(bool) error {
+	panic("implement me")
+}
+
 func (f *loggingVCursor) SetSQLSelectLimit(int64) error {
 	panic("implement me")
 }
 
+func (f *loggingVCursor) SetMaxExecutionTime(int64) error {
+	panic("implement me")
+}
+
+func (f *loggingVCursor) SetWorkloadName(string) {
+	panic("implement me")
+}
+
+func (f *loggingVCursor) SetTenant(string) error {
+	panic("implement me")
+}
+
+func (f *loggingVCursor) SetPriority(querypb.ExecuteOptions_Priority) {
+	panic("implement me")
+}
+
+func (f *loggingVCursor) SetConsistentSnapshotReads(bool) error {
+	panic("implement me")
+}
+
 func (f *loggingVCursor) SetTransactionMode(vtgatepb.TransactionMode) {
 	panic("implement me")
 }
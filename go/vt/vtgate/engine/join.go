@@ -79,8 +79,15 @@ func (jn *Join) TryExecute(vcursor VCursor, bindVars map[string]*querypb.BindVar
 			wantfields = false
 			result.Fields = joinFields(lresult.Fields, rresult.Fields, jn.Cols)
 		}
-		for _, rrow := range rresult.Rows {
-			result.Rows = append(result.Rows, joinRows(lrow, rrow, jn.Cols))
+		switch {
+		case jn.Opcode == SemiJoin:
+			if len(rresult.Rows) > 0 {
+				result.Rows = append(result.Rows, joinRows(lrow, nil, jn.Cols))
+			}
+		default:
+			for _, rrow := range rresult.Rows {
+				result.Rows = append(result.Rows, joinRows(lrow, rrow, jn.Cols))
+			}
 		}
 		if jn.Opcode == LeftJoin && len(rresult.Rows) == 0 {
 			result.Rows = append(result.Rows, joinRows(lrow, nil, jn.Cols))
@@ -110,6 +117,17 @@ func (jn *Join) TryStreamExecute(vcursor VCursor, bindVars map[string]*querypb.B
 					wantfields = false
 					result.Fields = joinFields(lresult.Fields, rresult.Fields, jn.Cols)
 				}
+				if jn.Opcode == SemiJoin {
+					// the row of the left hand side is sent once, after
+					// the right hand side returned any row for it
+					if len(rresult.Rows) != 0 {
+						rowSent = true
+					}
+					if result.Fields == nil {
+						return nil
+					}
+					return callback(result)
+				}
 				for _, rrow := range rresult.Rows {
 					result.Rows = append(result.Rows, joinRows(lrow, rrow, jn.Cols))
 				}
@@ -121,6 +139,11 @@ func (jn *Join) TryStreamExecute(vcursor VCursor, bindVars map[string]*querypb.B
 			if err != nil {
 				return err
 			}
+			if jn.Opcode == SemiJoin && rowSent {
+				if err := callback(&sqltypes.Result{Rows: [][]sqltypes.Value{joinRows(lrow, nil, jn.Cols)}}); err != nil {
+					return err
+				}
+			}
 			if jn.Opcode == LeftJoin && !rowSent {
 				result := &sqltypes.Result{}
 				result.Rows = [][]sqltypes.Value{joinRows(
@@ -205,14 +228,20 @@ func joinRows(lrow, rrow []sqltypes.Value, cols []int) []sqltypes.Value {
 type JoinOpcode int
 
 // This is the list of JoinOpcode values.
+// SemiJoin only returns the columns of the left hand side, once for each
+// of its rows for which the right hand side returns any row.
 const (
 	InnerJoin = JoinOpcode(iota)
 	LeftJoin
+	SemiJoin
 )
 
 func (code JoinOpcode) String() string {
-	if code == InnerJoin {
+	switch code {
+	case InnerJoin:
 		return "Join"
+	case SemiJoin:
+		return "SemiJoin"
 	}
 	return "LeftJoin"
 }
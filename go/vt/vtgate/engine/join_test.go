@@ -126,6 +126,29 @@ func TestJoinExecute(t *testing.T) {
 		"3|c|6|f",
 		"3|c|7|g",
 	))
+
+	// Semi Join
+	leftPrim.rewind()
+	rightPrim.rewind()
+	jn.Opcode = SemiJoin
+	jn.Cols = []int{-1, -2}
+	r, err = jn.TryExecute(&noopVCursor{}, bv, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rightPrim.ExpectLog(t, []string{
+		`Execute a: type:INT64 value:"10" bv: type:VARCHAR value:"a" true`,
+		`Execute a: type:INT64 value:"10" bv: type:VARCHAR value:"b" false`,
+		`Execute a: type:INT64 value:"10" bv: type:VARCHAR value:"c" false`,
+	})
+	expectResult(t, "jn.Execute", r, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1|col2",
+			"int64|varchar",
+		),
+		"1|a",
+		"3|c",
+	))
 }
 
 func TestJoinExecuteMaxMemoryRows(t *testing.T) {
@@ -430,6 +453,31 @@ func TestJoinStreamExecute(t *testing.T) {
 		"3|c|6|f",
 		"3|c|7|g",
 	))
+
+	// Semi Join
+	leftPrim.rewind()
+	rightPrim.rewind()
+	jn.Opcode = SemiJoin
+	jn.Cols = []int{-1, -2}
+	r, err = wrapStreamExecute(jn, &noopVCursor{}, map[string]*querypb.BindVariable{}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rightPrim.ExpectLog(t, []string{
+		`GetFields bv: `,
+		`Execute bv:  true`,
+		`StreamExecute bv: type:VARCHAR value:"a" false`,
+		`StreamExecute bv: type:VARCHAR value:"b" false`,
+		`StreamExecute bv: type:VARCHAR value:"c" false`,
+	})
+	expectResult(t, "jn.Execute", r, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1|col2",
+			"int64|varchar",
+		),
+		"1|a",
+		"3|c",
+	))
 }
 
 func TestGetFields(t *testing.T) {
@@ -0,0 +1,210 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/callerid"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/masking"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var _ Primitive = (*Mask)(nil)
+
+var maskedResults = stats.NewCountersWithSingleLabel("MaskedResults", "Count of the results whose columns were masked by the column masking policy, by masked column", "Column")
+
+// Mask is a primitive that masks the columns of the results of its input
+// for the callers that the rules of the columns do not exempt.
+type Mask struct {
+	Input   Primitive
+	Columns []MaskedColumn
+
+	// Unresolved are the rules of the tables whose columns in the results
+	// are not known, like the ones of a star expression on a table whose
+	// columns are not in the vschema. The query fails for the callers that
+	// one of them does not exempt.
+	Unresolved []*masking.Rule
+}
+
+// MaskedColumn is a column of the results that comes from masked columns.
+type MaskedColumn struct {
+	Col   int
+	Rules []*masking.Rule
+
+	// Derived is set when the column is an expression on the masked
+	// columns, rather than one of them, and is then masked with NULL.
+	Derived bool
+}
+
+// RouteType returns a description of the query routing type used by the primitive
+func (m *Mask) RouteType() string {
+	return m.Input.RouteType()
+}
+
+// GetKeyspaceName specifies the Keyspace that this primitive routes to.
+func (m *Mask) GetKeyspaceName() string {
+	return m.Input.GetKeyspaceName()
+}
+
+// GetTableName specifies the table that this primitive routes to.
+func (m *Mask) GetTableName() string {
+	return m.Input.GetTableName()
+}
+
+// TryExecute implements the Primitive interface.
+func (m *Mask) TryExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	masks, err := m.masksFor(vcursor)
+	if err != nil {
+		return nil, err
+	}
+	result, err := vcursor.ExecutePrimitive(m.Input, bindVars, wantfields)
+	if err != nil || len(masks) == 0 {
+		return result, err
+	}
+	return applyMasks(masks, result), nil
+}
+
+// TryStreamExecute implements the Primitive interface.
+func (m *Mask) TryStreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	masks, err := m.masksFor(vcursor)
+	if err != nil {
+		return err
+	}
+	if len(masks) == 0 {
+		return vcursor.StreamExecutePrimitive(m.Input, bindVars, wantfields, callback)
+	}
+	return vcursor.StreamExecutePrimitive(m.Input, bindVars, wantfields, func(result *sqltypes.Result) error {
+		return callback(applyMasks(masks, result))
+	})
+}
+
+// GetFields implements the Primitive interface.
+func (m *Mask) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	masks, err := m.masksFor(vcursor)
+	if err != nil {
+		return nil, err
+	}
+	result, err := m.Input.GetFields(vcursor, bindVars)
+	if err != nil || len(masks) == 0 {
+		return result, err
+	}
+	return applyMasks(masks, result), nil
+}
+
+// Inputs implements the Primitive interface.
+func (m *Mask) Inputs() []Primitive {
+	return []Primitive{m.Input}
+}
+
+// NeedsTransaction implements the Primitive interface.
+func (m *Mask) NeedsTransaction() bool {
+	return m.Input.NeedsTransaction()
+}
+
+func (m *Mask) description() PrimitiveDescription {
+	var columns []string
+	for _, col := range m.Columns {
+		for _, rule := range col.Rules {
+			method := string(rule.Method)
+			if col.Derived {
+				method = string(masking.Null)
+			}
+			columns = append(columns, rule.String()+":"+method)
+		}
+	}
+	other := map[string]interface{}{"Columns": columns}
+	if len(m.Unresolved) > 0 {
+		var unresolved []string
+		for _, rule := range m.Unresolved {
+			unresolved = append(unresolved, rule.String())
+		}
+		other["Unresolved"] = unresolved
+	}
+	return PrimitiveDescription{
+		OperatorType: "Mask",
+		Other:        other,
+	}
+}
+
+// columnMask is the mask of a column for a caller.
+type columnMask struct {
+	col  int
+	rule *masking.Rule
+}
+
+// masksFor returns the masks of the columns for the caller of the query.
+func (m *Mask) masksFor(vcursor VCursor) ([]columnMask, error) {
+	caller := callerid.ImmediateCallerIDFromContext(vcursor.Context())
+	for _, rule := range m.Unresolved {
+		if !rule.Exempts(caller) {
+			return nil, vterrors.Errorf(vtrpcpb.Code_PERMISSION_DENIED, "the columns of the query can not be masked for column %s, select the columns explicitly", rule.String())
+		}
+	}
+	var masks []columnMask
+	for _, col := range m.Columns {
+		var masked []*masking.Rule
+		for _, rule := range col.Rules {
+			if !rule.Exempts(caller) {
+				masked = append(masked, rule)
+			}
+		}
+		switch {
+		case len(masked) == 0:
+			continue
+		case len(masked) == 1 && !col.Derived:
+			masks = append(masks, columnMask{col: col.Col, rule: masked[0]})
+		default:
+			masks = append(masks, columnMask{col: col.Col, rule: &masking.Rule{Method: masking.Null}})
+		}
+		for _, rule := range masked {
+			maskedResults.Add(rule.String(), 1)
+		}
+	}
+	return masks, nil
+}
+
+// applyMasks returns a copy of the result with the columns masked.
+func applyMasks(masks []columnMask, result *sqltypes.Result) *sqltypes.Result {
+	out := *result
+	if result.Fields != nil {
+		out.Fields = make([]*querypb.Field, len(result.Fields))
+		copy(out.Fields, result.Fields)
+		for _, mask := range masks {
+			if mask.col < len(out.Fields) {
+				out.Fields[mask.col] = mask.rule.MaskField(out.Fields[mask.col])
+			}
+		}
+	}
+	if result.Rows != nil {
+		out.Rows = make([][]sqltypes.Value, len(result.Rows))
+		for i, row := range result.Rows {
+			masked := make([]sqltypes.Value, len(row))
+			copy(masked, row)
+			for _, mask := range masks {
+				if mask.col < len(masked) {
+					masked[mask.col] = mask.rule.Mask(masked[mask.col])
+				}
+			}
+			out.Rows[i] = masked
+		}
+	}
+	return &out
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/test/utils"
+	"vitess.io/vitess/go/vt/callerid"
+	"vitess.io/vitess/go/vt/vtgate/masking"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestMask(t *testing.T) {
+	ssn := &masking.Rule{Keyspace: "ks", Table: "user", Column: "ssn", Method: masking.Partial, Visible: 4, Unmasked: []string{"hr", "group:auditors"}}
+	email := &masking.Rule{Keyspace: "ks", Table: "user", Column: "email", Method: masking.Hash}
+	input := sqltypes.MakeTestResult(sqltypes.MakeTestFields("id|ssn|email|name", "int64|varchar|varchar|varchar"),
+		"1|123-45-6789|a@b.c|x",
+		"2|null|b@b.c|y",
+	)
+	mask := func() *Mask {
+		return &Mask{
+			Input: &fakePrimitive{results: []*sqltypes.Result{input}},
+			Columns: []MaskedColumn{
+				{Col: 1, Rules: []*masking.Rule{ssn}},
+				{Col: 2, Rules: []*masking.Rule{email}},
+				{Col: 3, Rules: []*masking.Rule{ssn}, Derived: true},
+			},
+		}
+	}
+	vcursorFor := func(caller *querypb.VTGateCallerID) VCursor {
+		return &noopVCursor{ctx: callerid.NewContext(context.Background(), nil, caller)}
+	}
+
+	// The callers that the rules do not exempt get masked values.
+	result, err := mask().TryExecute(vcursorFor(callerid.NewImmediateCallerID("app")), nil, true)
+	require.NoError(t, err)
+	utils.MustMatch(t, sqltypes.MakeTestResult(sqltypes.MakeTestFields("id|ssn|email|name", "int64|varchar|varchar|varchar"),
+		"1|XXXXXXX6789|d648b243a3e817eaa3309e00e183483f2867baadf522099f0c2121770536b25a|null",
+		"2|null|0420c905334713da7d56c8919112d0e0a0eb074b658569e2e60093d6d2781066|null",
+	), result)
+	// the input is not changed
+	assert.Equal(t, "123-45-6789", input.Rows[0][1].ToString())
+
+	// A caller exempted by a rule gets the values of its column, and of the
+	// expressions on it, but not of the other columns.
+	caller := &querypb.VTGateCallerID{Username: "auditor", Groups: []string{"auditors"}}
+	var streamed []*sqltypes.Result
+	err = mask().TryStreamExecute(vcursorFor(caller), nil, true, func(qr *sqltypes.Result) error {
+		streamed = append(streamed, qr)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, streamed, 2)
+	assert.Equal(t, "123-45-6789", streamed[1].Rows[0][1].ToString())
+	assert.Equal(t, 64, len(streamed[1].Rows[0][2].ToString()))
+	assert.Equal(t, "x", streamed[1].Rows[0][3].ToString())
+
+	// The unresolved rules fail the query of the callers they do not exempt.
+	unresolved := &Mask{Input: &fakePrimitive{results: []*sqltypes.Result{input}}, Unresolved: []*masking.Rule{ssn}}
+	_, err = unresolved.TryExecute(vcursorFor(callerid.NewImmediateCallerID("app")), nil, true)
+	require.EqualError(t, err, "the columns of the query can not be masked for column ks.user.ssn, select the columns explicitly")
+	_, err = unresolved.TryExecute(vcursorFor(callerid.NewImmediateCallerID("hr")), nil, true)
+	require.NoError(t, err)
+}
+
+func TestMaskFields(t *testing.T) {
+	rule := &masking.Rule{Keyspace: "ks", Table: "user", Column: "salary", Method: masking.Hash}
+	m := &Mask{
+		Input:   &fakePrimitive{results: []*sqltypes.Result{sqltypes.MakeTestResult(sqltypes.MakeTestFields("id|salary", "int64|int64"))}},
+		Columns: []MaskedColumn{{Col: 1, Rules: []*masking.Rule{rule}}},
+	}
+	result, err := m.GetFields(&noopVCursor{}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, sqltypes.Int64, result.Fields[0].Type)
+	assert.Equal(t, sqltypes.VarChar, result.Fields[1].Type)
+}
@@ -51,6 +51,11 @@ type MergeSort struct {
 	Primitives              []StreamExecutor
 	OrderBy                 []OrderByParams
 	ScatterErrorsAsWarnings bool
+
+	// maxRows is the number of rows after which the merge stops, and the
+	// streams of the inputs are cancelled. If 0, all the rows are merged.
+	maxRows int
+
 	noInputs
 	noTxNeeded
 }
@@ -134,7 +139,17 @@ func (ms *MergeSort) TryStreamExecute(vcursor VCursor, bindVars map[string]*quer
 	// Pop a row from the heap and send it out.
 	// Then pull the next row from the stream the popped
 	// row came from and push it into the heap.
+	sent := 0
 	for len(sh.rows) != 0 {
+		if ms.maxRows > 0 && sent == ms.maxRows {
+			saved := len(sh.rows)
+			for _, handle := range handles {
+				saved += len(handle.row)
+			}
+			orderedLimitRowsSaved.Add(int64(saved))
+			break
+		}
+		sent++
 		sr := heap.Pop(sh).(streamRow)
 		if sh.err != nil {
 			// Unreachable: This should never fail.
@@ -89,6 +89,45 @@ func TestMergeSortNormal(t *testing.T) {
 	utils.MustMatch(t, wantResults, results)
 }
 
+func TestMergeSortMaxRows(t *testing.T) {
+	idColFields := sqltypes.MakeTestFields("id|col", "int32|varchar")
+	shardResults := []*shardResult{{
+		results: sqltypes.MakeTestStreamingResults(idColFields,
+			"1|a",
+			"7|g",
+		),
+	}, {
+		results: sqltypes.MakeTestStreamingResults(idColFields,
+			"2|b",
+			"---",
+			"3|c",
+		),
+	}}
+	ms := MergeSort{
+		Primitives: []StreamExecutor{shardResults[0], shardResults[1]},
+		OrderBy: []OrderByParams{{
+			WeightStringCol: -1,
+			Col:             0,
+		}},
+		maxRows: 2,
+	}
+
+	var results []*sqltypes.Result
+	err := ms.TryStreamExecute(&noopVCursor{}, nil, true, func(qr *sqltypes.Result) error {
+		results = append(results, qr)
+		return nil
+	})
+	require.NoError(t, err)
+
+	// The merge stops once it has produced the rows it needs.
+	wantResults := sqltypes.MakeTestStreamingResults(idColFields,
+		"1|a",
+		"---",
+		"2|b",
+	)
+	utils.MustMatch(t, wantResults, results)
+}
+
 func TestMergeSortWeightString(t *testing.T) {
 	idColFields := sqltypes.MakeTestFields("id|col", "varbinary|varchar")
 	shardResults := []*shardResult{{
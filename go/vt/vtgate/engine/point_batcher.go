@@ -0,0 +1,274 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/srvtopo"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// batchValuesName is the name of the list bind variable of the batch
+// queries, which holds the values of the combined point selects.
+const batchValuesName = "__batch_vals"
+
+// maxBatchQueries bounds the batch queries the batcher keeps, which are
+// reset once there are that many.
+const maxBatchQueries = 10000
+
+var pointQueriesBatched = stats.NewCounter("PointQueriesBatched", "Count of point selects combined with concurrent ones into a single IN query")
+
+// batcher combines the point selects of all the routes.
+var batcher = newPointBatcher()
+
+type (
+	// pointBatcher combines the concurrent point selects of the same route
+	// to the same shard, of the same caller and session state, into a
+	// single IN query. The first select of a batch
+	// waits for the others during the batch window, or until the batch is
+	// full, then executes the batch query, and every select of the batch
+	// gets the rows of its own value.
+	pointBatcher struct {
+		mu      sync.Mutex
+		queries map[string]batchQuery
+		pending map[string]*pointBatch
+	}
+
+	// batchQuery is the query of a point select rewritten for a batch: the
+	// comparison of the vindex column is replaced with an IN over the
+	// values of the batch, and the column is added to the select
+	// expressions, to tell which select each row belongs to.
+	batchQuery struct {
+		query string
+		ok    bool
+	}
+
+	pointBatch struct {
+		values []sqltypes.Value
+		full   chan struct{}
+		done   chan struct{}
+		result *sqltypes.Result
+		err    error
+		// leaderCanceled is set if the batch failed because the context
+		// of the select that executed it is done.
+		leaderCanceled bool
+	}
+)
+
+func newPointBatcher() *pointBatcher {
+	return &pointBatcher{
+		queries: map[string]batchQuery{},
+		pending: map[string]*pointBatch{},
+	}
+}
+
+// execute executes the point select of the route on the shard as part of a
+// batch of the selects with the same session key. It returns false if the
+// select can not be batched: its value is not an integer bind variable, or
+// its query is not a select of a single table whose only bind variable is
+// compared to a column, or the batch failed because its leader was canceled.
+func (b *pointBatcher) execute(vcursor VCursor, route *Route, rs *srvtopo.ResolvedShard, bindVars map[string]*querypb.BindVariable, window time.Duration, size int, sessionKey string) (*sqltypes.Result, bool, error) {
+	if len(route.Values) != 1 || route.Values[0].Key == "" {
+		return nil, false, nil
+	}
+	bv := bindVars[route.Values[0].Key]
+	if bv == nil || !sqltypes.IsIntegral(bv.Type) {
+		return nil, false, nil
+	}
+	value, err := sqltypes.BindVariableToValue(bv)
+	if err != nil {
+		return nil, false, nil
+	}
+	query, ok := b.batchQuery(route.Query, route.Values[0].Key)
+	if !ok {
+		return nil, false, nil
+	}
+
+	key := query + "@" + rs.Target.Keyspace + "/" + rs.Target.Shard + "@" + rs.Target.TabletType.String() + "@" + sessionKey
+	b.mu.Lock()
+	batch, joined := b.pending[key]
+	if !joined {
+		batch = &pointBatch{full: make(chan struct{}), done: make(chan struct{})}
+		b.pending[key] = batch
+	}
+	batch.values = append(batch.values, value)
+	if len(batch.values) >= size {
+		delete(b.pending, key)
+		close(batch.full)
+	}
+	b.mu.Unlock()
+
+	ctx := vcursor.Context()
+	if joined {
+		select {
+		case <-batch.done:
+		case <-ctx.Done():
+			return nil, true, ctx.Err()
+		}
+		if batch.leaderCanceled {
+			// the batch failed with the deadline of its leader, the select
+			// executes on its own
+			return nil, false, nil
+		}
+	} else {
+		timer := time.NewTimer(window)
+		select {
+		case <-timer.C:
+		case <-batch.full:
+			timer.Stop()
+		case <-ctx.Done():
+			timer.Stop()
+		}
+		b.mu.Lock()
+		if b.pending[key] == batch {
+			delete(b.pending, key)
+		}
+		b.mu.Unlock()
+		batch.result, batch.err = executeBatch(vcursor, rs, query, batch.values)
+		batch.leaderCanceled = batch.err != nil && ctx.Err() != nil
+		close(batch.done)
+	}
+
+	if batch.err != nil {
+		return nil, true, batch.err
+	}
+	result, err := batch.rowsFor(value)
+	return result, true, err
+}
+
+func (b *pointBatcher) batchQuery(query, key string) (string, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	bq, found := b.queries[query]
+	if !found {
+		if len(b.queries) >= maxBatchQueries {
+			b.queries = map[string]batchQuery{}
+		}
+		bq.query, bq.ok = newBatchQuery(query, key)
+		b.queries[query] = bq
+	}
+	return bq.query, bq.ok
+}
+
+// newBatchQuery rewrites the point select for a batch.
+func newBatchQuery(query, key string) (string, bool) {
+	stmt, err := sqlparser.Parse(query)
+	if err != nil {
+		return "", false
+	}
+	sel, ok := stmt.(*sqlparser.Select)
+	if !ok || sel.Distinct || sel.SQLCalcFoundRows || sel.GroupBy != nil || sel.Having != nil || sel.Limit != nil ||
+		sel.Lock != sqlparser.NoLock || sel.Into != nil || sel.Where == nil || len(sel.From) != 1 ||
+		sqlparser.ContainsAggregation(sel.SelectExprs) {
+		return "", false
+	}
+	if _, ok := sel.From[0].(*sqlparser.AliasedTableExpr); !ok {
+		return "", false
+	}
+
+	var col *sqlparser.ColName
+	predicates := sqlparser.SplitAndExpression(nil, sel.Where.Expr)
+	for i, predicate := range predicates {
+		cmp, ok := predicate.(*sqlparser.ComparisonExpr)
+		if !ok || cmp.Operator != sqlparser.EqualOp {
+			continue
+		}
+		left, isCol := cmp.Left.(*sqlparser.ColName)
+		arg, isArg := cmp.Right.(sqlparser.Argument)
+		if isCol && isArg && string(arg) == key && col == nil {
+			col = left
+			predicates[i] = &sqlparser.ComparisonExpr{
+				Operator: sqlparser.InOp,
+				Left:     left,
+				Right:    sqlparser.ListArg(batchValuesName),
+			}
+		}
+	}
+	if col == nil {
+		return "", false
+	}
+	sel.Where = sqlparser.NewWhere(sqlparser.WhereClause, sqlparser.AndExpressions(predicates...))
+
+	// the selects of a batch must not differ in any other bind variable
+	otherArgs := false
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case sqlparser.Argument:
+			otherArgs = true
+		case sqlparser.ListArg:
+			otherArgs = otherArgs || string(node) != batchValuesName
+		}
+		return !otherArgs, nil
+	}, sel)
+	if otherArgs {
+		return "", false
+	}
+
+	sel.SelectExprs = append(sel.SelectExprs, &sqlparser.AliasedExpr{Expr: col})
+	return sqlparser.String(sel), true
+}
+
+func executeBatch(vcursor VCursor, rs *srvtopo.ResolvedShard, query string, values []sqltypes.Value) (*sqltypes.Result, error) {
+	list := &querypb.BindVariable{Type: querypb.Type_TUPLE}
+	seen := make(map[string]bool, len(values))
+	for _, value := range values {
+		if !seen[value.ToString()] {
+			seen[value.ToString()] = true
+			list.Values = append(list.Values, sqltypes.ValueToProto(value))
+		}
+	}
+	if len(values) > 1 {
+		pointQueriesBatched.Add(int64(len(values)))
+	}
+	queries := []*querypb.BoundQuery{{
+		Sql:           query,
+		BindVariables: map[string]*querypb.BindVariable{batchValuesName: list},
+	}}
+	result, errs := vcursor.ExecuteMultiShard([]*srvtopo.ResolvedShard{rs}, queries, false /* rollbackOnError */, false /* autocommit */)
+	if err := vterrors.Aggregate(filterOutNilErrors(errs)); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// rowsFor returns the rows of the batch for the value, without the column
+// added to the select expressions.
+func (batch *pointBatch) rowsFor(value sqltypes.Value) (*sqltypes.Result, error) {
+	out := &sqltypes.Result{}
+	if len(batch.result.Fields) > 0 {
+		out.Fields = batch.result.Fields[:len(batch.result.Fields)-1]
+	}
+	for _, row := range batch.result.Rows {
+		last := len(row) - 1
+		cmp, err := evalengine.NullsafeCompare(row[last], value)
+		if err != nil {
+			return nil, err
+		}
+		if cmp == 0 {
+			out.Rows = append(out.Rows, row[:last])
+		}
+	}
+	return out.Copy(), nil
+}
@@ -0,0 +1,179 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/test/utils"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestNewBatchQuery(t *testing.T) {
+	tcases := []struct {
+		query, want string
+	}{{
+		query: "select a, b from t where id = :id",
+		want:  "select a, b, id from t where id in ::__batch_vals",
+	}, {
+		query: "select * from t as x where x.id = :id and x.c = 1 order by a",
+		want:  "select *, x.id from t as x where x.id in ::__batch_vals and x.c = 1 order by a asc",
+	}, {
+		query: "select a from t where id = :id and b = :b",
+	}, {
+		query: "select a from t where id = :id limit 1",
+	}, {
+		query: "select count(*) from t where id = :id",
+	}, {
+		query: "select a from t where id = :id for update",
+	}, {
+		query: "select a from t where id = 1",
+	}, {
+		query: "select a from t join u on t.x = u.x where t.id = :id",
+	}}
+	for _, tcase := range tcases {
+		t.Run(tcase.query, func(t *testing.T) {
+			got, ok := newBatchQuery(tcase.query, "id")
+			assert.Equal(t, tcase.want != "", ok)
+			assert.Equal(t, tcase.want, got)
+		})
+	}
+}
+
+func TestRoutePointQueryBatching(t *testing.T) {
+	ks := &vindexes.Keyspace{Name: "ks", Sharded: true}
+	sel := NewRoute(SelectEqualUnique, ks, "select a from t where id = :id", "select a from t where 1 != 1")
+	vindex, _ := vindexes.NewHash("", nil)
+	sel.Vindex = vindex.(vindexes.SingleColumn)
+	sel.Values = []sqltypes.PlanValue{{Key: "id"}}
+	batchResult := sqltypes.MakeTestResult(sqltypes.MakeTestFields("a|id", "varchar|int64"), "x|1", "y|2", "z|1")
+
+	// The selects wait for each other until the batch is full, and each one
+	// gets the rows of its own id.
+	var wg sync.WaitGroup
+	results := make([]*sqltypes.Result, 2)
+	vcs := make([]*loggingVCursor, 2)
+	for i := range vcs {
+		vcs[i] = &loggingVCursor{shards: []string{"-20", "20-"}, results: []*sqltypes.Result{batchResult}, batchWindow: time.Minute, batchSize: 2}
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			var err error
+			results[i], err = sel.TryExecute(vcs[i], map[string]*querypb.BindVariable{"id": sqltypes.Int64BindVariable(int64(i + 1))}, false)
+			assert.NoError(t, err)
+		}(i)
+	}
+	wg.Wait()
+
+	utils.MustMatch(t, sqltypes.MakeTestResult(sqltypes.MakeTestFields("a", "varchar"), "x", "z"), results[0])
+	utils.MustMatch(t, sqltypes.MakeTestResult(sqltypes.MakeTestFields("a", "varchar"), "y"), results[1])
+	var executed []string
+	for _, vc := range vcs {
+		for _, entry := range vc.log {
+			if strings.HasPrefix(entry, "ExecuteMultiShard") {
+				executed = append(executed, entry)
+			}
+		}
+	}
+	require.Len(t, executed, 1)
+	assert.Contains(t, executed[0], `select a, id from t where id in ::__batch_vals {__batch_vals: type:TUPLE values:{type:INT64 value:"`)
+
+	// Without batching, the select is executed as is.
+	vc := &loggingVCursor{shards: []string{"-20", "20-"}, results: []*sqltypes.Result{defaultSelectResult}}
+	_, err := sel.TryExecute(vc, map[string]*querypb.BindVariable{"id": sqltypes.Int64BindVariable(1)}, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [type:INT64 value:"1"] Destinations:DestinationKeyspaceID(166b40b44aba4bd6)`,
+		`ExecuteMultiShard ks.-20: select a from t where id = :id {id: type:INT64 value:"1"} false false`,
+	})
+}
+
+func TestRoutePointQueryBatchingSessionKey(t *testing.T) {
+	ks := &vindexes.Keyspace{Name: "ks", Sharded: true}
+	sel := NewRoute(SelectEqualUnique, ks, "select a from t where id = :id", "select a from t where 1 != 1")
+	vindex, _ := vindexes.NewHash("", nil)
+	sel.Vindex = vindex.(vindexes.SingleColumn)
+	sel.Values = []sqltypes.PlanValue{{Key: "id"}}
+	batchResult := sqltypes.MakeTestResult(sqltypes.MakeTestFields("a|id", "varchar|int64"), "x|1")
+
+	// The selects of different callers are not combined, each one executes
+	// its own batch when the window expires.
+	var wg sync.WaitGroup
+	vcs := make([]*loggingVCursor, 2)
+	for i, key := range []string{"user1", "user2"} {
+		vcs[i] = &loggingVCursor{shards: []string{"-20", "20-"}, results: []*sqltypes.Result{batchResult}, batchWindow: 10 * time.Millisecond, batchSize: 2, batchKey: key}
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, err := sel.TryExecute(vcs[i], map[string]*querypb.BindVariable{"id": sqltypes.Int64BindVariable(1)}, false)
+			assert.NoError(t, err)
+		}(i)
+	}
+	wg.Wait()
+	for _, vc := range vcs {
+		executed := 0
+		for _, entry := range vc.log {
+			if strings.HasPrefix(entry, "ExecuteMultiShard") {
+				executed++
+			}
+		}
+		assert.Equal(t, 1, executed)
+	}
+}
+
+func TestRoutePointQueryBatchingCanceled(t *testing.T) {
+	ks := &vindexes.Keyspace{Name: "ks", Sharded: true}
+	sel := NewRoute(SelectEqualUnique, ks, "select a from t where id = :id", "select a from t where 1 != 1")
+	vindex, _ := vindexes.NewHash("", nil)
+	sel.Vindex = vindex.(vindexes.SingleColumn)
+	sel.Values = []sqltypes.PlanValue{{Key: "id"}}
+	batchResult := sqltypes.MakeTestResult(sqltypes.MakeTestFields("a|id", "varchar|int64"), "x|1")
+
+	// The leader waits for the window, the select that joined its batch
+	// returns as soon as its own context is done.
+	leader := &loggingVCursor{shards: []string{"-20", "20-"}, results: []*sqltypes.Result{batchResult}, batchWindow: time.Second, batchSize: 10, batchKey: "canceled"}
+	leaderDone := make(chan struct{})
+	go func() {
+		defer close(leaderDone)
+		_, err := sel.TryExecute(leader, map[string]*querypb.BindVariable{"id": sqltypes.Int64BindVariable(1)}, false)
+		assert.NoError(t, err)
+	}()
+	require.Eventually(t, func() bool {
+		batcher.mu.Lock()
+		defer batcher.mu.Unlock()
+		return len(batcher.pending) > 0
+	}, time.Second, time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	joined := &loggingVCursor{noopVCursor: noopVCursor{ctx: ctx}, shards: []string{"-20", "20-"}, batchWindow: time.Second, batchSize: 10, batchKey: "canceled"}
+	start := time.Now()
+	_, err := sel.TryExecute(joined, map[string]*querypb.BindVariable{"id": sqltypes.Int64BindVariable(2)}, false)
+	assert.Equal(t, context.Canceled, err)
+	assert.Less(t, time.Since(start), time.Second)
+	<-leaderDone
+}
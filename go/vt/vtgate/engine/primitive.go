@@ -29,10 +29,12 @@ import (
 	"vitess.io/vitess/go/vt/schema"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/srvtopo"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
 
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
 )
 
@@ -61,6 +63,20 @@ type (
 		// if the max memory rows override directive is set to true
 		ExceedsMaxMemoryRows(numRows int) bool
 
+		// InListPruningThreshold returns the in_list_pruning_threshold flag value.
+		InListPruningThreshold() int
+
+		// FieldCacheVersion returns the version of the schema the fields of
+		// the field queries are cached for, and false if they are not cached.
+		FieldCacheVersion() (int64, bool)
+
+		// PointQueryBatching returns the window during which the point
+		// selects are combined with the concurrent ones, 0 if they are not,
+		// the maximum number of selects of a batch, and the key of the
+		// caller and the session state the selects execute with: only the
+		// selects with the same key are combined.
+		PointQueryBatching() (time.Duration, int, string)
+
 		// SetContextTimeout updates the context and sets a timeout.
 		SetContextTimeout(timeout time.Duration) context.CancelFunc
 
@@ -107,9 +123,16 @@ type (
 		// KeyspaceAvailable returns true when a keyspace is visible from vtgate
 		KeyspaceAvailable(ks string) bool
 
-		MessageStream(rss []*srvtopo.ResolvedShard, tableName string, callback func(*sqltypes.Result) error) error
+		MessageStream(rss []*srvtopo.ResolvedShard, tableName, consumerGroup string, callback func(*sqltypes.Result) error) error
 
 		VStream(rss []*srvtopo.ResolvedShard, filter *binlogdatapb.Filter, gtid string, callback func(evs []*binlogdatapb.VEvent) error) error
+
+		// CheckThrottler returns true if the tablet throttlers of the primary
+		// tablets of the shards let the app proceed.
+		CheckThrottler(rss []*srvtopo.ResolvedShard, appName string) bool
+
+		// SaveDDLStatus records the status of a DDL applied to several shards.
+		SaveDDLStatus(status *topodatapb.DDLStatus)
 	}
 
 	//SessionActions gives primitives ability to interact with the session state
@@ -135,15 +158,23 @@ type (
 		SetAutocommit(bool) error
 		SetClientFoundRows(bool) error
 		SetSkipQueryPlanCache(bool) error
+		SetSkipKeyspacePolicy(bool) error
 		SetSQLSelectLimit(int64) error
+		SetMaxExecutionTime(int64) error
 		SetTransactionMode(vtgatepb.TransactionMode)
 		SetWorkload(querypb.ExecuteOptions_Workload)
+		SetWorkloadName(string)
+		SetTenant(string) error
+		SetPriority(querypb.ExecuteOptions_Priority)
 		SetPlannerVersion(querypb.ExecuteOptions_PlannerVersion)
 		SetFoundRows(uint64)
 
 		SetDDLStrategy(string)
 		GetDDLStrategy() string
 
+		// GetSQLMode returns the sql_mode that vtgate evaluates expressions with
+		GetSQLMode() evalengine.SQLMode
+
 		GetSessionUUID() string
 
 		SetSessionEnableSystemSettings(bool) error
@@ -154,9 +185,16 @@ type (
 		SetReadAfterWriteTimeout(float64)
 		SetSessionTrackGTIDs(bool)
 
+		// SetConsistentSnapshotReads sets whether the transactions read the replicas at the GTID positions of the primaries at their start
+		SetConsistentSnapshotReads(bool) error
+
 		// HasCreatedTempTable will mark the session as having created temp tables
 		HasCreatedTempTable()
 		GetWarnings() []*querypb.QueryWarning
+
+		// GetDDLStatuses returns the status of the DDL with the given uuid,
+		// or the statuses of the latest DDLs if uuid is empty.
+		GetDDLStatuses(uuid string) ([]*topodatapb.DDLStatus, error)
 	}
 
 	// Plan represents the execution strategy for a given query.
@@ -165,11 +203,16 @@ type (
 	// each node does its part by combining the results of the
 	// sub-nodes.
 	Plan struct {
-		Type         sqlparser.StatementType // The type of query we have
-		Original     string                  // Original is the original query.
-		Instructions Primitive               // Instructions contains the instructions needed to fulfil the query.
-		BindVarNeeds *sqlparser.BindVarNeeds // Stores BindVars needed to be provided as part of expression rewriting
-		Warnings     []*querypb.QueryWarning // Warnings that need to be yielded every time this query runs
+		Type         sqlparser.StatementType         // The type of query we have
+		Original     string                          // Original is the original query.
+		Fingerprint  string                          // Fingerprint identifies the query in the stats and logs of vtgate and vttablet, see sqlparser.Fingerprint.
+		Target       string                          // Target is the target of the session the plan was built for.
+		Instructions Primitive                       // Instructions contains the instructions needed to fulfil the query.
+		BindVarNeeds *sqlparser.BindVarNeeds         // Stores BindVars needed to be provided as part of expression rewriting
+		Warnings     []*querypb.QueryWarning         // Warnings that need to be yielded every time this query runs
+		WorkloadName string                          // WorkloadName is set with the WORKLOAD_NAME directive of the query.
+		Priority     querypb.ExecuteOptions_Priority // Priority is set with the PRIORITY directive of the query, if HasPriority.
+		HasPriority  bool
 
 		ExecCount    uint64 // Count of times this plan was executed
 		ExecTime     uint64 // Total execution time
@@ -254,7 +297,7 @@ func Exists(m Match, p Primitive) bool {
 	return Find(m, p) != nil
 }
 
-//MarshalJSON serializes the plan into a JSON representation.
+// MarshalJSON serializes the plan into a JSON representation.
 func (p *Plan) MarshalJSON() ([]byte, error) {
 	var instructions *PrimitiveDescription
 	if p.Instructions != nil {
@@ -265,6 +308,8 @@ func (p *Plan) MarshalJSON() ([]byte, error) {
 	marshalPlan := struct {
 		QueryType    string
 		Original     string                `json:",omitempty"`
+		Fingerprint  string                `json:",omitempty"`
+		Target       string                `json:",omitempty"`
 		Instructions *PrimitiveDescription `json:",omitempty"`
 		ExecCount    uint64                `json:",omitempty"`
 		ExecTime     time.Duration         `json:",omitempty"`
@@ -275,6 +320,8 @@ func (p *Plan) MarshalJSON() ([]byte, error) {
 	}{
 		QueryType:    p.Type.String(),
 		Original:     p.Original,
+		Fingerprint:  p.Fingerprint,
+		Target:       p.Target,
 		Instructions: instructions,
 		ExecCount:    atomic.LoadUint64(&p.ExecCount),
 		ExecTime:     time.Duration(atomic.LoadUint64(&p.ExecTime)),
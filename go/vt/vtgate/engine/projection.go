@@ -40,6 +40,7 @@ func (p *Projection) TryExecute(vcursor VCursor, bindVars map[string]*querypb.Bi
 
 	env := evalengine.ExpressionEnv{
 		BindVars: bindVars,
+		SQLMode:  vcursor.Session().GetSQLMode(),
 	}
 
 	if wantfields {
@@ -73,6 +74,7 @@ func (p *Projection) TryStreamExecute(vcursor VCursor, bindVars map[string]*quer
 
 	env := evalengine.ExpressionEnv{
 		BindVars: bindVars,
+		SQLMode:  vcursor.Session().GetSQLMode(),
 	}
 
 	if wantields {
@@ -17,11 +17,13 @@ limitations under the License.
 package engine
 
 import (
+	"container/heap"
 	"encoding/json"
 	"fmt"
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"vitess.io/vitess/go/vt/log"
@@ -83,6 +85,11 @@ type Route struct {
 	// merge-sorted.
 	OrderBy []OrderByParams
 
+	// UpperLimit is the number of rows the limit above a merge-sorted
+	// route needs from it. The merge of the rows of the shards stops once
+	// it has produced that many rows.
+	UpperLimit sqltypes.PlanValue
+
 	// TruncateColumnCount specifies the number of columns to return
 	// in the final result. Rest of the columns are truncated
 	// from the result received. If 0, no truncation happens.
@@ -98,6 +105,9 @@ type Route struct {
 	SysTableTableSchema []evalengine.Expr
 	SysTableTableName   map[string]evalengine.Expr
 
+	// fieldCache holds the fields of the field query.
+	fieldCache fieldCache
+
 	// Route does not take inputs
 	noInputs
 
@@ -105,6 +115,59 @@ type Route struct {
 	noTxNeeded
 }
 
+// fieldCache holds the fields a field query returned, for the version of
+// the schema they were fetched at. The field queries that use bind
+// variables are never cached, since their fields may depend on the values.
+type fieldCache struct {
+	mu        sync.Mutex
+	version   int64
+	fields    *sqltypes.Result
+	checked   bool
+	cacheable bool
+}
+
+// get returns a copy of the cached fields, or nil if they were not fetched
+// at this version of the schema.
+func (fc *fieldCache) get(version int64) *sqltypes.Result {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+	if fc.fields == nil || fc.version != version {
+		return nil
+	}
+	return fc.fields.Copy()
+}
+
+// set caches the fields the field query returned at this version of the
+// schema, if the query does not use bind variables.
+func (fc *fieldCache) set(fieldQuery string, version int64, fields *sqltypes.Result) {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+	if !fc.checked {
+		fc.checked = true
+		fc.cacheable = !hasBindVars(fieldQuery)
+	}
+	if fc.cacheable {
+		fc.version = version
+		fc.fields = fields.Copy()
+	}
+}
+
+func hasBindVars(query string) bool {
+	stmt, err := sqlparser.Parse(query)
+	if err != nil {
+		return true
+	}
+	found := false
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node.(type) {
+		case sqlparser.Argument, sqlparser.ListArg:
+			found = true
+		}
+		return !found, nil
+	}, stmt)
+	return found
+}
+
 // NewSimpleRoute creates a Route with the bare minimum of parameters.
 func NewSimpleRoute(opcode RouteOpcode, keyspace *vindexes.Keyspace) *Route {
 	return &Route{
@@ -207,6 +270,8 @@ var routeName = map[RouteOpcode]string{
 
 var (
 	partialSuccessScatterQueries = stats.NewCounter("PartialSuccessScatterQueries", "Count of partially successful scatter queries")
+	orderedLimitRowsSaved        = stats.NewCounter("OrderedLimitRowsSaved", "Count of rows returned by the shards to merge-sorted queries with a limit that were not merged, since the limit was reached before")
+	fieldCacheHits               = stats.NewCounter("FieldCacheHits", "Count of field queries served from the fields cached on the plans")
 )
 
 // MarshalJSON serializes the RouteOpcode as a JSON string.
@@ -288,6 +353,14 @@ func (route *Route) executeInternal(vcursor VCursor, bindVars map[string]*queryp
 		return &sqltypes.Result{}, nil
 	}
 
+	if route.Opcode == SelectEqualUnique && len(rss) == 1 {
+		if window, size, sessionKey := vcursor.PointQueryBatching(); window > 0 {
+			if result, batched, err := batcher.execute(vcursor, route, rss[0], bvs[0], window, size, sessionKey); batched {
+				return result, err
+			}
+		}
+	}
+
 	queries := getQueries(route.Query, bvs)
 	result, errs := vcursor.ExecuteMultiShard(rss, queries, false /* rollbackOnError */, false /* autocommit */)
 
@@ -309,6 +382,13 @@ func (route *Route) executeInternal(vcursor VCursor, bindVars map[string]*queryp
 		return result, nil
 	}
 
+	if !route.UpperLimit.IsNull() {
+		count, err := route.fetchUpperLimit(bindVars)
+		if err != nil {
+			return nil, err
+		}
+		return route.mergeSortedRuns(result, count)
+	}
 	return route.sort(result)
 }
 
@@ -400,6 +480,13 @@ func (route *Route) mergeSort(vcursor VCursor, bindVars map[string]*querypb.Bind
 		OrderBy:                 route.OrderBy,
 		ScatterErrorsAsWarnings: route.ScatterErrorsAsWarnings,
 	}
+	if !route.UpperLimit.IsNull() {
+		count, err := route.fetchUpperLimit(bindVars)
+		if err != nil {
+			return err
+		}
+		ms.maxRows = count
+	}
 	return vcursor.StreamExecutePrimitive(&ms, bindVars, wantfields, func(qr *sqltypes.Result) error {
 		return callback(qr.Truncate(route.TruncateColumnCount))
 	})
@@ -407,6 +494,13 @@ func (route *Route) mergeSort(vcursor VCursor, bindVars map[string]*querypb.Bind
 
 // GetFields fetches the field info.
 func (route *Route) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	version, cacheFields := vcursor.FieldCacheVersion()
+	if cacheFields {
+		if qr := route.fieldCache.get(version); qr != nil {
+			fieldCacheHits.Add(1)
+			return qr, nil
+		}
+	}
 	rss, _, err := vcursor.ResolveDestinations(route.Keyspace.Name, nil, []key.Destination{key.DestinationAnyShard{}})
 	if err != nil {
 		return nil, err
@@ -419,7 +513,11 @@ func (route *Route) GetFields(vcursor VCursor, bindVars map[string]*querypb.Bind
 	if err != nil {
 		return nil, err
 	}
-	return qr.Truncate(route.TruncateColumnCount), nil
+	qr = qr.Truncate(route.TruncateColumnCount)
+	if cacheFields {
+		route.fieldCache.set(route.FieldQuery, version, qr)
+	}
+	return qr, nil
 }
 
 func (route *Route) paramsAllShards(vcursor VCursor, bindVars map[string]*querypb.BindVariable) ([]*srvtopo.ResolvedShard, []map[string]*querypb.BindVariable, error) {
@@ -457,6 +555,7 @@ func (route *Route) routeInfoSchemaQuery(vcursor VCursor, bindVars map[string]*q
 	env := evalengine.ExpressionEnv{
 		BindVars: bindVars,
 		Row:      []sqltypes.Value{},
+		SQLMode:  vcursor.Session().GetSQLMode(),
 	}
 
 	var specifiedKS string
@@ -679,6 +778,90 @@ func (route *Route) sort(in *sqltypes.Result) (*sqltypes.Result, error) {
 	return out, err
 }
 
+// mergeSortedRuns sorts the rows of the shards like sort, but only the
+// first count of them: the rows of each shard are sorted already, so the
+// runs of sorted rows are merged until count rows are produced.
+func (route *Route) mergeSortedRuns(in *sqltypes.Result, count int) (*sqltypes.Result, error) {
+	comparers := extractSlices(route.OrderBy)
+
+	// a new run starts where a row sorts before the previous one
+	var next, ends []int
+	for i := range in.Rows {
+		if i == 0 {
+			next = append(next, i)
+			continue
+		}
+		cmp, err := compareRows(comparers, in.Rows[i-1], in.Rows[i])
+		if err != nil {
+			return nil, err
+		}
+		if cmp > 0 {
+			ends = append(ends, i)
+			next = append(next, i)
+		}
+	}
+	ends = append(ends, len(in.Rows))
+
+	sh := &scatterHeap{comparers: comparers}
+	for id := range next {
+		sh.rows = append(sh.rows, streamRow{row: in.Rows[next[id]], id: id})
+		next[id]++
+	}
+	heap.Init(sh)
+	if sh.err != nil {
+		return nil, sh.err
+	}
+
+	out := &sqltypes.Result{
+		Fields:       in.Fields,
+		RowsAffected: in.RowsAffected,
+		InsertID:     in.InsertID,
+	}
+	for len(sh.rows) != 0 && len(out.Rows) < count {
+		sr := heap.Pop(sh).(streamRow)
+		if sh.err != nil {
+			return nil, sh.err
+		}
+		out.Rows = append(out.Rows, sr.row)
+		if next[sr.id] < ends[sr.id] {
+			sr.row = in.Rows[next[sr.id]]
+			next[sr.id]++
+			heap.Push(sh, sr)
+		}
+	}
+	if sh.err != nil {
+		return nil, sh.err
+	}
+	orderedLimitRowsSaved.Add(int64(len(in.Rows) - len(out.Rows)))
+	return out, nil
+}
+
+func compareRows(comparers []*comparer, r1, r2 []sqltypes.Value) (int, error) {
+	for _, c := range comparers {
+		cmp, err := c.compare(r1, r2)
+		if err != nil || cmp != 0 {
+			return cmp, err
+		}
+	}
+	return 0, nil
+}
+
+func (route *Route) fetchUpperLimit(bindVars map[string]*querypb.BindVariable) (int, error) {
+	resolved, err := route.UpperLimit.ResolveValue(bindVars)
+	if err != nil {
+		return 0, err
+	}
+	num, err := evalengine.ToUint64(resolved)
+	if err != nil {
+		return 0, err
+	}
+	count := int(num)
+	if count < 0 {
+		return 0, fmt.Errorf("requested limit is out of range: %v", num)
+	}
+	return count, nil
+}
+
 func resolveSingleShard(vcursor VCursor, vindex vindexes.SingleColumn, keyspace *vindexes.Keyspace, vindexKey sqltypes.Value) (*srvtopo.ResolvedShard, []byte, error) {
 	destinations, err := vindex.Map(vcursor, []sqltypes.Value{vindexKey})
 	if err != nil {
@@ -27,6 +27,7 @@ import (
 
 	"vitess.io/vitess/go/vt/sqlparser"
 
+	"vitess.io/vitess/go/test/utils"
 	"vitess.io/vitess/go/vt/vtgate/evalengine"
 
 	"github.com/stretchr/testify/require"
@@ -797,6 +798,55 @@ func TestRouteGetFields(t *testing.T) {
 	expectResult(t, "sel.StreamExecute", result, &sqltypes.Result{})
 }
 
+func TestRouteFieldCache(t *testing.T) {
+	sel := NewRoute(
+		SelectUnsharded,
+		&vindexes.Keyspace{Name: "ks"},
+		"select id from t",
+		"select id from t where 1 != 1",
+	)
+	fields := sqltypes.MakeTestResult(sqltypes.MakeTestFields("id", "int64"))
+
+	// The fields are served from the cache until the schema version changes.
+	vc := &loggingVCursor{shards: []string{"0"}, results: []*sqltypes.Result{fields.Copy(), fields.Copy()}, cacheFields: true}
+	for i := 0; i < 2; i++ {
+		result, err := sel.GetFields(vc, map[string]*querypb.BindVariable{})
+		require.NoError(t, err)
+		utils.MustMatch(t, fields, result)
+	}
+	vc.schemaVersion++
+	result, err := sel.GetFields(vc, map[string]*querypb.BindVariable{})
+	require.NoError(t, err)
+	utils.MustMatch(t, fields, result)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [] Destinations:DestinationAnyShard()`,
+		`ExecuteMultiShard ks.0: select id from t where 1 != 1 {} false false`,
+		`ResolveDestinations ks [] Destinations:DestinationAnyShard()`,
+		`ExecuteMultiShard ks.0: select id from t where 1 != 1 {} false false`,
+	})
+
+	// The callers get their own copy of the fields.
+	result.Fields[0].Name = "changed"
+	result, err = sel.GetFields(vc, map[string]*querypb.BindVariable{})
+	require.NoError(t, err)
+	utils.MustMatch(t, fields, result)
+
+	// The field queries with bind variables are not cached.
+	sel.FieldQuery = "select :a from t where 1 != 1"
+	sel.fieldCache = fieldCache{}
+	vc = &loggingVCursor{shards: []string{"0"}, results: []*sqltypes.Result{fields, fields}, cacheFields: true}
+	for i := 0; i < 2; i++ {
+		_, err := sel.GetFields(vc, map[string]*querypb.BindVariable{"a": sqltypes.Int64BindVariable(1)})
+		require.NoError(t, err)
+	}
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [] Destinations:DestinationAnyShard()`,
+		`ExecuteMultiShard ks.0: select :a from t where 1 != 1 {a: type:INT64 value:"1"} false false`,
+		`ResolveDestinations ks [] Destinations:DestinationAnyShard()`,
+		`ExecuteMultiShard ks.0: select :a from t where 1 != 1 {a: type:INT64 value:"1"} false false`,
+	})
+}
+
 func TestRouteSort(t *testing.T) {
 	sel := NewRoute(
 		SelectUnsharded,
@@ -1030,6 +1080,76 @@ func TestRouteSortTruncate(t *testing.T) {
 	expectResult(t, "sel.Execute", result, wantResult)
 }
 
+func TestRouteSortUpperLimit(t *testing.T) {
+	sel := NewRoute(
+		SelectScatter,
+		&vindexes.Keyspace{
+			Name:    "ks",
+			Sharded: true,
+		},
+		"dummy_select",
+		"dummy_select_field",
+	)
+	sel.OrderBy = []OrderByParams{{
+		Col:             0,
+		WeightStringCol: -1,
+	}}
+	sel.UpperLimit = sqltypes.PlanValue{Key: "__upper_limit"}
+
+	// The rows of each shard are sorted, and only the first rows of the
+	// merge are returned.
+	vc := &loggingVCursor{
+		shards: []string{"-20", "20-"},
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"id",
+					"int64",
+				),
+				"1",
+				"4",
+				"6",
+				"2",
+				"3",
+				"7",
+			),
+		},
+	}
+	before := orderedLimitRowsSaved.Get()
+	result, err := sel.TryExecute(vc, map[string]*querypb.BindVariable{"__upper_limit": sqltypes.Int64BindVariable(4)}, false)
+	require.NoError(t, err)
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"id",
+			"int64",
+		),
+		"1",
+		"2",
+		"3",
+		"4",
+	)
+	expectResult(t, "sel.Execute", result, wantResult)
+	assert.EqualValues(t, 2, orderedLimitRowsSaved.Get()-before)
+
+	sel.OrderBy[0].Desc = true
+	vc.Rewind()
+	result, err = sel.TryExecute(vc, map[string]*querypb.BindVariable{"__upper_limit": sqltypes.Int64BindVariable(10)}, false)
+	require.NoError(t, err)
+	wantResult = sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"id",
+			"int64",
+		),
+		"7",
+		"6",
+		"4",
+		"3",
+		"2",
+		"1",
+	)
+	expectResult(t, "sel.Execute", result, wantResult)
+}
+
 func TestRouteStreamTruncate(t *testing.T) {
 	sel := NewRoute(
 		SelectUnsharded,
@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"regexp"
 	"strings"
 
 	"vitess.io/vitess/go/vt/sysvars"
@@ -37,6 +38,7 @@ import (
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/schema"
+	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
 )
@@ -121,6 +123,7 @@ func (s *Set) TryExecute(vcursor VCursor, bindVars map[string]*querypb.BindVaria
 	env := evalengine.ExpressionEnv{
 		BindVars: bindVars,
 		Row:      input.Rows[0],
+		SQLMode:  vcursor.Session().GetSQLMode(),
 	}
 	for _, setOp := range s.Ops {
 		err := setOp.Execute(vcursor, env)
@@ -349,6 +352,10 @@ func (svs *SysVarReservedConn) checkAndUpdateSysVar(vcursor VCursor, res evaleng
 
 var _ SetOp = (*SysVarSetAware)(nil)
 
+// workloadNameRegexp matches the valid workload names, the same as the ones
+// of the workload pools of vttablet.
+var workloadNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9_]+$`)
+
 //MarshalJSON marshals all the json
 func (svss *SysVarSetAware) MarshalJSON() ([]byte, error) {
 	return json.Marshal(struct {
@@ -372,6 +379,8 @@ func (svss *SysVarSetAware) Execute(vcursor VCursor, env evalengine.ExpressionEn
 		err = svss.setBoolSysVar(env, vcursor.Session().SetClientFoundRows)
 	case sysvars.SkipQueryPlanCache.Name:
 		err = svss.setBoolSysVar(env, vcursor.Session().SetSkipQueryPlanCache)
+	case sysvars.SkipKeyspacePolicy.Name:
+		err = svss.setBoolSysVar(env, vcursor.Session().SetSkipKeyspacePolicy)
 	case sysvars.TxReadOnly.Name,
 		sysvars.TransactionReadOnly.Name:
 		// TODO (4127): This is a dangerous NOP.
@@ -383,6 +392,12 @@ func (svss *SysVarSetAware) Execute(vcursor VCursor, env evalengine.ExpressionEn
 			return err
 		}
 		vcursor.Session().SetSQLSelectLimit(intValue) // nolint:errcheck
+	case sysvars.MaxExecutionTime.Name:
+		intValue, err := svss.evalAsInt64(env)
+		if err != nil {
+			return err
+		}
+		return vcursor.Session().SetMaxExecutionTime(intValue)
 	case sysvars.TransactionMode.Name:
 		str, err := svss.evalAsString(env)
 		if err != nil {
@@ -403,6 +418,31 @@ func (svss *SysVarSetAware) Execute(vcursor VCursor, env evalengine.ExpressionEn
 			return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.WrongValueForVar, "invalid workload: %s", str)
 		}
 		vcursor.Session().SetWorkload(querypb.ExecuteOptions_Workload(out))
+	case sysvars.WorkloadName.Name:
+		str, err := svss.evalAsString(env)
+		if err != nil {
+			return err
+		}
+		if str != "" && !workloadNameRegexp.MatchString(str) {
+			return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.WrongValueForVar, "invalid workload_name: %s, it must only contain letters, digits and underscores", str)
+		}
+		vcursor.Session().SetWorkloadName(str)
+	case sysvars.Tenant.Name:
+		str, err := svss.evalAsString(env)
+		if err != nil {
+			return err
+		}
+		return vcursor.Session().SetTenant(str)
+	case sysvars.Priority.Name:
+		str, err := svss.evalAsString(env)
+		if err != nil {
+			return err
+		}
+		priority, err := sqlparser.ParsePriority(str)
+		if err != nil {
+			return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.WrongValueForVar, "invalid priority: %s", str)
+		}
+		vcursor.Session().SetPriority(priority)
 	case sysvars.DDLStrategy.Name:
 		str, err := svss.evalAsString(env)
 		if err != nil {
@@ -414,6 +454,8 @@ func (svss *SysVarSetAware) Execute(vcursor VCursor, env evalengine.ExpressionEn
 		vcursor.Session().SetDDLStrategy(str)
 	case sysvars.SessionEnableSystemSettings.Name:
 		err = svss.setBoolSysVar(env, vcursor.Session().SetSessionEnableSystemSettings)
+	case sysvars.ConsistentSnapshotReads.Name:
+		err = svss.setBoolSysVar(env, vcursor.Session().SetConsistentSnapshotReads)
 	case sysvars.Charset.Name, sysvars.Names.Name:
 		str, err := svss.evalAsString(env)
 		if err != nil {
@@ -146,7 +146,7 @@ func (upd *Update) execUpdateEqual(vcursor VCursor, bindVars map[string]*querypb
 		return &sqltypes.Result{}, nil
 	}
 	if len(upd.ChangedVindexValues) != 0 {
-		if err := upd.updateVindexEntries(vcursor, bindVars, []*srvtopo.ResolvedShard{rs}); err != nil {
+		if err := upd.updateVindexEntries(vcursor, bindVars, []*srvtopo.ResolvedShard{rs}, []map[string]*querypb.BindVariable{bindVars}); err != nil {
 			return nil, err
 		}
 	}
@@ -163,7 +163,7 @@ func (upd *Update) execUpdateIn(vcursor VCursor, bindVars map[string]*querypb.Bi
 		return nil, err
 	}
 	if len(upd.ChangedVindexValues) != 0 {
-		if err := upd.updateVindexEntries(vcursor, bindVars, rss); err != nil {
+		if err := upd.updateVindexEntries(vcursor, bindVars, rss, queryBindVars(queries)); err != nil {
 			return nil, err
 		}
 	}
@@ -190,7 +190,7 @@ func (upd *Update) execUpdateByDestination(vcursor VCursor, bindVars map[string]
 
 	// update any owned vindexes
 	if len(upd.ChangedVindexValues) != 0 {
-		if err := upd.updateVindexEntries(vcursor, bindVars, rss); err != nil {
+		if err := upd.updateVindexEntries(vcursor, bindVars, rss, queryBindVars(queries)); err != nil {
 			return nil, err
 		}
 	}
@@ -203,11 +203,8 @@ func (upd *Update) execUpdateByDestination(vcursor VCursor, bindVars map[string]
 // for DMLs to reuse existing transactions.
 // Note 2: While changes are being committed, the changing row could be
 // unreachable by either the new or old column values.
-func (upd *Update) updateVindexEntries(vcursor VCursor, bindVars map[string]*querypb.BindVariable, rss []*srvtopo.ResolvedShard) error {
-	queries := make([]*querypb.BoundQuery, len(rss))
-	for i := range rss {
-		queries[i] = &querypb.BoundQuery{Sql: upd.OwnedVindexQuery, BindVariables: bindVars}
-	}
+func (upd *Update) updateVindexEntries(vcursor VCursor, bindVars map[string]*querypb.BindVariable, rss []*srvtopo.ResolvedShard, bvs []map[string]*querypb.BindVariable) error {
+	queries := getQueries(upd.OwnedVindexQuery, bvs)
 	subQueryResult, errors := vcursor.ExecuteMultiShard(rss, queries, false, false)
 	for _, err := range errors {
 		if err != nil {
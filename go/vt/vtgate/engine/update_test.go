@@ -22,6 +22,7 @@ import (
 
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"vitess.io/vitess/go/sqltypes"
@@ -467,6 +468,47 @@ func TestUpdateIn(t *testing.T) {
 	})
 }
 
+func TestUpdateInListPruning(t *testing.T) {
+	ks := buildTestVSchema().Keyspaces["sharded"]
+	upd := &Update{DML: DML{
+		Opcode:   In,
+		Keyspace: ks.Keyspace,
+		Query:    "dummy_update",
+		Vindex:   ks.Vindexes["hash"].(vindexes.SingleColumn),
+		Values:   []sqltypes.PlanValue{{ListKey: "vals"}},
+	}}
+	bindVars := map[string]*querypb.BindVariable{
+		"vals": sqltypes.TestBindVariable([]interface{}{1, 2, 3}),
+	}
+
+	// Below the threshold, all the shards receive the whole list.
+	testInListPruningThreshold = 4
+	defer func() { testInListPruningThreshold = 0 }()
+	vc := newDMLTestVCursor("-20", "20-")
+	vc.shardForKsid = []string{"-20", "20-", "-20"}
+	_, err := upd.TryExecute(vc, bindVars, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations sharded [] Destinations:DestinationKeyspaceID(166b40b44aba4bd6),DestinationKeyspaceID(06e7ea22ce92708f),DestinationKeyspaceID(4eb190c9a2fa169c)`,
+		`ExecuteMultiShard sharded.-20: dummy_update {vals: type:TUPLE values:{type:INT64 value:"1"} values:{type:INT64 value:"2"} values:{type:INT64 value:"3"}} ` +
+			`sharded.20-: dummy_update {vals: type:TUPLE values:{type:INT64 value:"1"} values:{type:INT64 value:"2"} values:{type:INT64 value:"3"}} true false`,
+	})
+
+	// From the threshold on, each shard only receives its own values.
+	testInListPruningThreshold = 3
+	before := inListValuesPruned.Get()
+	vc = newDMLTestVCursor("-20", "20-")
+	vc.shardForKsid = []string{"-20", "20-", "-20"}
+	_, err = upd.TryExecute(vc, bindVars, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations sharded [type:INT64 value:"1" type:INT64 value:"2" type:INT64 value:"3"] Destinations:DestinationKeyspaceID(166b40b44aba4bd6),DestinationKeyspaceID(06e7ea22ce92708f),DestinationKeyspaceID(4eb190c9a2fa169c)`,
+		`ExecuteMultiShard sharded.-20: dummy_update {vals: type:TUPLE values:{type:INT64 value:"1"} values:{type:INT64 value:"3"}} ` +
+			`sharded.20-: dummy_update {vals: type:TUPLE values:{type:INT64 value:"2"}} true false`,
+	})
+	assert.EqualValues(t, 3, inListValuesPruned.Get()-before)
+}
+
 func TestUpdateInChangedVindex(t *testing.T) {
 	ks := buildTestVSchema().Keyspaces["sharded"]
 	upd := &Update{
@@ -0,0 +1,545 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"encoding/json"
+	"strings"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	"vitess.io/vitess/go/vt/srvtopo"
+)
+
+var _ Primitive = (*VExplain)(nil)
+
+// VExplain is a primitive that executes its input and returns, instead of the
+// rows of the input, the execution statistics of each primitive of the input
+// and of the queries each primitive sent to the shards: how many times it ran,
+// the rows it examined, returned and affected, the time it took and the memory
+// its results used.
+//
+// The shard queries of a primitive are sent one shard at a time, so that the
+// statistics of each shard can be told apart. The reported time of a primitive
+// that queries many shards is therefore the sum of the shard times, and not
+// the time a regular execution of the query, which queries them in parallel,
+// takes.
+type VExplain struct {
+	Input Primitive
+	// JSON makes the result a single JSON document, instead of a tree with
+	// a row for each primitive and shard.
+	JSON bool
+}
+
+// vexplainStats are the execution statistics of a primitive. RowsExamined
+// are the rows the primitive received from its inputs and from the shards.
+type vexplainStats struct {
+	description PrimitiveDescription
+
+	Executions   int
+	RowsExamined int
+	RowsReturned int
+	RowsAffected uint64
+	Time         time.Duration
+	Memory       int64
+
+	Shards []*vexplainShardStats
+	Inputs []*vexplainStats
+}
+
+// vexplainShardStats are the execution statistics of a query a primitive sent
+// to a shard.
+type vexplainShardStats struct {
+	Keyspace     string
+	Shard        string
+	Query        string
+	Executions   int
+	RowsReturned int
+	RowsAffected uint64
+	Time         time.Duration
+	Memory       int64
+}
+
+// vexplainRecorder collects the statistics of the primitives of a plan, which
+// may be executed concurrently.
+type vexplainRecorder struct {
+	mu    sync.Mutex
+	root  *vexplainStats
+	stats map[Primitive]*vexplainStats
+}
+
+func newVExplainRecorder(primitive Primitive) *vexplainRecorder {
+	r := &vexplainRecorder{stats: map[Primitive]*vexplainStats{}}
+	r.root = r.add(primitive)
+	return r
+}
+
+func (r *vexplainRecorder) add(primitive Primitive) *vexplainStats {
+	stats := &vexplainStats{description: primitive.description()}
+	r.stats[primitive] = stats
+	for _, input := range primitive.Inputs() {
+		stats.Inputs = append(stats.Inputs, r.add(input))
+	}
+	return stats
+}
+
+// statsOf returns the statistics of the primitive, which is executed by the
+// parent primitive. Primitives that are not in the plan, because the parent
+// builds them while it executes, are added as new inputs of the parent.
+func (r *vexplainRecorder) statsOf(primitive Primitive, parent *vexplainStats) *vexplainStats {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if stats, ok := r.stats[primitive]; ok {
+		return stats
+	}
+
+	stats := &vexplainStats{description: primitive.description()}
+	r.stats[primitive] = stats
+	parent.Inputs = append(parent.Inputs, stats)
+
+	return stats
+}
+
+func (r *vexplainRecorder) record(stats *vexplainStats, parent *vexplainStats, rows int, rowsAffected uint64, memory int64, d time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stats.Executions++
+	stats.RowsReturned += rows
+	stats.RowsAffected += rowsAffected
+	stats.Memory += memory
+	stats.Time += d
+
+	if parent != nil {
+		parent.RowsExamined += rows
+	}
+}
+
+func (r *vexplainRecorder) recordShard(stats *vexplainStats, rs *srvtopo.ResolvedShard, query string, rows int, rowsAffected uint64, memory int64, d time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	keyspace, shard := rs.Target.Keyspace, rs.Target.Shard
+
+	var shardStats *vexplainShardStats
+	for _, s := range stats.Shards {
+		if s.Keyspace == keyspace && s.Shard == shard && s.Query == query {
+			shardStats = s
+			break
+		}
+	}
+
+	if shardStats == nil {
+		shardStats = &vexplainShardStats{Keyspace: keyspace, Shard: shard, Query: query}
+		stats.Shards = append(stats.Shards, shardStats)
+	}
+
+	shardStats.Executions++
+	shardStats.RowsReturned += rows
+	shardStats.RowsAffected += rowsAffected
+	shardStats.Memory += memory
+	shardStats.Time += d
+
+	stats.RowsExamined += rows
+}
+
+// vexplainVCursor is the VCursor of a primitive executed by VExplain. It
+// records the statistics of the inputs the primitive executes and of the
+// queries it sends to the shards.
+type vexplainVCursor struct {
+	VCursor
+	recorder *vexplainRecorder
+	// stats are the statistics of the primitive using the vcursor, or nil
+	// for the VExplain primitive itself.
+	stats *vexplainStats
+}
+
+// vexplainPrimitive executes the primitive with the vcursor that records its
+// statistics, instead of the one it is executed with.
+type vexplainPrimitive struct {
+	Primitive
+	vcursor *vexplainVCursor
+}
+
+// TryExecute implements the Primitive interface.
+func (p *vexplainPrimitive) TryExecute(_ VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	return p.Primitive.TryExecute(p.vcursor, bindVars, wantfields)
+}
+
+// TryStreamExecute implements the Primitive interface.
+func (p *vexplainPrimitive) TryStreamExecute(_ VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	return p.Primitive.TryStreamExecute(p.vcursor, bindVars, wantfields, callback)
+}
+
+func (vc *vexplainVCursor) wrap(primitive Primitive) (*vexplainPrimitive, *vexplainStats) {
+	stats := vc.recorder.root
+	if vc.stats != nil {
+		stats = vc.recorder.statsOf(primitive, vc.stats)
+	}
+
+	return &vexplainPrimitive{
+		Primitive: primitive,
+		vcursor:   &vexplainVCursor{VCursor: vc.VCursor, recorder: vc.recorder, stats: stats},
+	}, stats
+}
+
+// ExecutePrimitive is part of the VCursor interface.
+func (vc *vexplainVCursor) ExecutePrimitive(primitive Primitive, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	p, stats := vc.wrap(primitive)
+
+	start := time.Now()
+	qr, err := vc.VCursor.ExecutePrimitive(p, bindVars, wantfields)
+	d := time.Since(start)
+
+	if qr != nil {
+		vc.recorder.record(stats, vc.stats, len(qr.Rows), qr.RowsAffected, qr.CachedSize(true), d)
+	} else {
+		vc.recorder.record(stats, vc.stats, 0, 0, 0, d)
+	}
+
+	return qr, err
+}
+
+// StreamExecutePrimitive is part of the VCursor interface.
+func (vc *vexplainVCursor) StreamExecutePrimitive(primitive Primitive, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	p, stats := vc.wrap(primitive)
+
+	var (
+		rows         int
+		rowsAffected uint64
+		memory       int64
+	)
+
+	start := time.Now()
+	err := vc.VCursor.StreamExecutePrimitive(p, bindVars, wantfields, func(qr *sqltypes.Result) error {
+		rows += len(qr.Rows)
+		rowsAffected += qr.RowsAffected
+		memory += qr.CachedSize(true)
+		return callback(qr)
+	})
+	vc.recorder.record(stats, vc.stats, rows, rowsAffected, memory, time.Since(start))
+
+	return err
+}
+
+// ExecuteMultiShard is part of the VCursor interface.
+func (vc *vexplainVCursor) ExecuteMultiShard(rss []*srvtopo.ResolvedShard, queries []*querypb.BoundQuery, rollbackOnError, canAutocommit bool) (*sqltypes.Result, []error) {
+	result := &sqltypes.Result{}
+	var errs []error
+
+	for i, rs := range rss {
+		start := time.Now()
+		qr, shardErrs := vc.VCursor.ExecuteMultiShard(rss[i:i+1], queries[i:i+1], rollbackOnError, canAutocommit)
+		d := time.Since(start)
+
+		errs = append(errs, shardErrs...)
+		if qr == nil {
+			vc.recordShard(rs, queries[i].Sql, 0, 0, 0, d)
+			continue
+		}
+
+		vc.recordShard(rs, queries[i].Sql, len(qr.Rows), qr.RowsAffected, qr.CachedSize(true), d)
+		result.AppendResult(qr)
+	}
+
+	return result, errs
+}
+
+// StreamExecuteMulti is part of the VCursor interface.
+func (vc *vexplainVCursor) StreamExecuteMulti(query string, rss []*srvtopo.ResolvedShard, bindVars []map[string]*querypb.BindVariable, callback func(reply *sqltypes.Result) error) []error {
+	var (
+		errs       []error
+		fieldsSent bool
+	)
+
+	for i, rs := range rss {
+		var (
+			rows         int
+			rowsAffected uint64
+			memory       int64
+		)
+
+		start := time.Now()
+		shardErrs := vc.VCursor.StreamExecuteMulti(query, rss[i:i+1], bindVars[i:i+1], func(qr *sqltypes.Result) error {
+			rows += len(qr.Rows)
+			rowsAffected += qr.RowsAffected
+			memory += qr.CachedSize(true)
+
+			// Like a regular execution, only send the fields once.
+			if qr.Fields != nil {
+				if fieldsSent {
+					qr = qr.Copy()
+					qr.Fields = nil
+				}
+				fieldsSent = true
+			}
+
+			return callback(qr)
+		})
+		vc.recordShard(rs, query, rows, rowsAffected, memory, time.Since(start))
+
+		errs = append(errs, shardErrs...)
+	}
+
+	return errs
+}
+
+// ExecuteStandalone is part of the VCursor interface.
+func (vc *vexplainVCursor) ExecuteStandalone(query string, bindVars map[string]*querypb.BindVariable, rs *srvtopo.ResolvedShard) (*sqltypes.Result, error) {
+	start := time.Now()
+	qr, err := vc.VCursor.ExecuteStandalone(query, bindVars, rs)
+	d := time.Since(start)
+
+	if qr != nil {
+		vc.recordShard(rs, query, len(qr.Rows), qr.RowsAffected, qr.CachedSize(true), d)
+	} else {
+		vc.recordShard(rs, query, 0, 0, 0, d)
+	}
+
+	return qr, err
+}
+
+func (vc *vexplainVCursor) recordShard(rs *srvtopo.ResolvedShard, query string, rows int, rowsAffected uint64, memory int64, d time.Duration) {
+	stats := vc.stats
+	if stats == nil {
+		stats = vc.recorder.root
+	}
+
+	vc.recorder.recordShard(stats, rs, query, rows, rowsAffected, memory, d)
+}
+
+// RouteType implements the Primitive interface
+func (v *VExplain) RouteType() string {
+	return "VExplain"
+}
+
+// GetKeyspaceName implements the Primitive interface
+func (v *VExplain) GetKeyspaceName() string {
+	return v.Input.GetKeyspaceName()
+}
+
+// GetTableName implements the Primitive interface
+func (v *VExplain) GetTableName() string {
+	return v.Input.GetTableName()
+}
+
+// NeedsTransaction implements the Primitive interface
+func (v *VExplain) NeedsTransaction() bool {
+	return v.Input.NeedsTransaction()
+}
+
+// TryExecute implements the Primitive interface
+func (v *VExplain) TryExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	recorder := newVExplainRecorder(v.Input)
+	vc := &vexplainVCursor{VCursor: vcursor, recorder: recorder}
+
+	if _, err := vc.ExecutePrimitive(v.Input, bindVars, true); err != nil {
+		return nil, err
+	}
+
+	if v.JSON {
+		return v.jsonResult(recorder.root)
+	}
+
+	return v.treeResult(recorder.root), nil
+}
+
+// TryStreamExecute implements the Primitive interface
+func (v *VExplain) TryStreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	qr, err := v.TryExecute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return err
+	}
+	return callback(qr)
+}
+
+// GetFields implements the Primitive interface
+func (v *VExplain) GetFields(VCursor, map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	return &sqltypes.Result{Fields: v.fields()}, nil
+}
+
+// Inputs implements the Primitive interface
+func (v *VExplain) Inputs() []Primitive {
+	return []Primitive{v.Input}
+}
+
+func (v *VExplain) description() PrimitiveDescription {
+	format := "tree"
+	if v.JSON {
+		format = "json"
+	}
+
+	return PrimitiveDescription{
+		OperatorType: "VExplain",
+		Variant:      "All",
+		Other:        map[string]interface{}{"Format": format},
+	}
+}
+
+func (v *VExplain) fields() []*querypb.Field {
+	if v.JSON {
+		return []*querypb.Field{{Name: "VExplain", Type: querypb.Type_VARCHAR}}
+	}
+
+	return []*querypb.Field{
+		{Name: "operator", Type: querypb.Type_VARCHAR},
+		{Name: "variant", Type: querypb.Type_VARCHAR},
+		{Name: "keyspace", Type: querypb.Type_VARCHAR},
+		{Name: "shard", Type: querypb.Type_VARCHAR},
+		{Name: "query", Type: querypb.Type_VARCHAR},
+		{Name: "executions", Type: querypb.Type_INT64},
+		{Name: "rows_examined", Type: querypb.Type_INT64},
+		{Name: "rows_returned", Type: querypb.Type_INT64},
+		{Name: "rows_affected", Type: querypb.Type_UINT64},
+		{Name: "time", Type: querypb.Type_VARCHAR},
+		{Name: "memory", Type: querypb.Type_INT64},
+	}
+}
+
+func (v *VExplain) jsonResult(root *vexplainStats) (*sqltypes.Result, error) {
+	data, err := json.MarshalIndent(root, "", "\t")
+	if err != nil {
+		return nil, err
+	}
+
+	return &sqltypes.Result{
+		Fields: v.fields(),
+		Rows:   [][]sqltypes.Value{{sqltypes.NewVarChar(string(data))}},
+	}, nil
+}
+
+func (v *VExplain) treeResult(root *vexplainStats) *sqltypes.Result {
+	qr := &sqltypes.Result{Fields: v.fields()}
+	root.appendRows(qr, "", "")
+	return qr
+}
+
+// appendRows appends a row for the primitive to the result, followed by rows
+// for its shard queries and its inputs, with the headers that draw the tree.
+func (s *vexplainStats) appendRows(qr *sqltypes.Result, header, childHeader string) {
+	qr.Rows = append(qr.Rows, []sqltypes.Value{
+		sqltypes.NewVarChar(header + s.description.OperatorType),
+		sqltypes.NewVarChar(s.description.Variant),
+		sqltypes.NewVarChar(s.keyspace()),
+		sqltypes.NewVarChar(""),
+		sqltypes.NewVarChar(""),
+		sqltypes.NewInt64(int64(s.Executions)),
+		sqltypes.NewInt64(int64(s.RowsExamined)),
+		sqltypes.NewInt64(int64(s.RowsReturned)),
+		sqltypes.NewUint64(s.RowsAffected),
+		sqltypes.NewVarChar(s.Time.String()),
+		sqltypes.NewInt64(s.Memory),
+	})
+
+	children := len(s.Shards) + len(s.Inputs)
+	for i, shard := range s.Shards {
+		h, _ := treeHeaders(childHeader, i == children-1)
+		qr.Rows = append(qr.Rows, []sqltypes.Value{
+			sqltypes.NewVarChar(h + "Shard"),
+			sqltypes.NewVarChar(""),
+			sqltypes.NewVarChar(shard.Keyspace),
+			sqltypes.NewVarChar(shard.Shard),
+			sqltypes.NewVarChar(shard.Query),
+			sqltypes.NewInt64(int64(shard.Executions)),
+			// MySQL does not report the rows a query examined.
+			sqltypes.NULL,
+			sqltypes.NewInt64(int64(shard.RowsReturned)),
+			sqltypes.NewUint64(shard.RowsAffected),
+			sqltypes.NewVarChar(shard.Time.String()),
+			sqltypes.NewInt64(shard.Memory),
+		})
+	}
+
+	for i, input := range s.Inputs {
+		h, ch := treeHeaders(childHeader, len(s.Shards)+i == children-1)
+		input.appendRows(qr, h, ch)
+	}
+}
+
+// treeHeaders returns the header of a child line of the tree, and the header
+// of the lines of the child's own children.
+func treeHeaders(header string, last bool) (string, string) {
+	if last {
+		return header + "└─ ", header + strings.Repeat(" ", 3)
+	}
+	return header + "├─ ", header + "│" + strings.Repeat(" ", 2)
+}
+
+func (s *vexplainStats) keyspace() string {
+	if s.description.Keyspace == nil {
+		return ""
+	}
+	return s.description.Keyspace.Name
+}
+
+// MarshalJSON serializes the statistics of the primitive, its shard queries
+// and its inputs.
+func (s *vexplainStats) MarshalJSON() ([]byte, error) {
+	type shardStats struct {
+		Keyspace     string
+		Shard        string
+		Query        string
+		Executions   int
+		RowsReturned int
+		RowsAffected uint64
+		Time         string
+		Memory       int64
+	}
+
+	shards := make([]shardStats, 0, len(s.Shards))
+	for _, shard := range s.Shards {
+		shards = append(shards, shardStats{
+			Keyspace:     shard.Keyspace,
+			Shard:        shard.Shard,
+			Query:        shard.Query,
+			Executions:   shard.Executions,
+			RowsReturned: shard.RowsReturned,
+			RowsAffected: shard.RowsAffected,
+			Time:         shard.Time.String(),
+			Memory:       shard.Memory,
+		})
+	}
+
+	return json.Marshal(struct {
+		OperatorType string
+		Variant      string `json:",omitempty"`
+		Keyspace     string `json:",omitempty"`
+		Executions   int
+		RowsExamined int
+		RowsReturned int
+		RowsAffected uint64
+		Time         string
+		Memory       int64
+		Shards       []shardStats     `json:",omitempty"`
+		Inputs       []*vexplainStats `json:",omitempty"`
+	}{
+		OperatorType: s.description.OperatorType,
+		Variant:      s.description.Variant,
+		Keyspace:     s.keyspace(),
+		Executions:   s.Executions,
+		RowsExamined: s.RowsExamined,
+		RowsReturned: s.RowsReturned,
+		RowsAffected: s.RowsAffected,
+		Time:         s.Time.String(),
+		Memory:       s.Memory,
+		Shards:       shards,
+		Inputs:       s.Inputs,
+	})
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+)
+
+func TestVExplain(t *testing.T) {
+	fields := sqltypes.MakeTestFields("col", "varchar")
+	newPlan := func(json bool) *VExplain {
+		return &VExplain{
+			Input: &Limit{
+				Count: int64PlanValue(2),
+				Input: NewRoute(
+					SelectScatter,
+					&vindexes.Keyspace{Name: "ks", Sharded: true},
+					"dummy_select",
+					"dummy_select_field",
+				),
+			},
+			JSON: json,
+		}
+	}
+	newVCursor := func() *loggingVCursor {
+		return &loggingVCursor{
+			shards: []string{"-20", "20-"},
+			results: []*sqltypes.Result{
+				sqltypes.MakeTestResult(fields, "a", "b"),
+				sqltypes.MakeTestResult(fields, "c"),
+			},
+		}
+	}
+
+	t.Run("tree", func(t *testing.T) {
+		vc := newVCursor()
+		result, err := newPlan(false).TryExecute(vc, map[string]*querypb.BindVariable{}, false)
+		require.NoError(t, err)
+		vc.ExpectLog(t, []string{
+			`ResolveDestinations ks [] Destinations:DestinationAllShards()`,
+			`ExecuteMultiShard ks.-20: dummy_select {__upper_limit: type:INT64 value:"2"} false false`,
+			`ExecuteMultiShard ks.20-: dummy_select {__upper_limit: type:INT64 value:"2"} false false`,
+		})
+
+		// The time and memory columns vary between runs.
+		var rows [][]string
+		for _, row := range result.Rows {
+			var r []string
+			for _, v := range row[:9] {
+				r = append(r, v.ToString())
+			}
+			rows = append(rows, r)
+		}
+
+		assert.Equal(t, [][]string{
+			{"Limit", "", "", "", "", "1", "3", "2", "0"},
+			{"└─ Route", "SelectScatter", "ks", "", "", "1", "3", "3", "0"},
+			{"   ├─ Shard", "", "ks", "-20", "dummy_select", "1", "", "2", "0"},
+			{"   └─ Shard", "", "ks", "20-", "dummy_select", "1", "", "1", "0"},
+		}, rows)
+	})
+
+	t.Run("json", func(t *testing.T) {
+		result, err := newPlan(true).TryExecute(newVCursor(), map[string]*querypb.BindVariable{}, false)
+		require.NoError(t, err)
+		require.Len(t, result.Rows, 1)
+
+		var stats struct {
+			OperatorType string
+			RowsExamined int
+			RowsReturned int
+			Inputs       []struct {
+				OperatorType string
+				Keyspace     string
+				RowsReturned int
+				Shards       []struct {
+					Shard        string
+					RowsReturned int
+				}
+			}
+		}
+		require.NoError(t, json.Unmarshal([]byte(result.Rows[0][0].ToString()), &stats))
+
+		assert.Equal(t, "Limit", stats.OperatorType)
+		assert.Equal(t, 3, stats.RowsExamined)
+		assert.Equal(t, 2, stats.RowsReturned)
+		require.Len(t, stats.Inputs, 1)
+		assert.Equal(t, "Route", stats.Inputs[0].OperatorType)
+		assert.Equal(t, "ks", stats.Inputs[0].Keyspace)
+		assert.Equal(t, 3, stats.Inputs[0].RowsReturned)
+		require.Len(t, stats.Inputs[0].Shards, 2)
+		assert.Equal(t, "-20", stats.Inputs[0].Shards[0].Shard)
+		assert.Equal(t, 2, stats.Inputs[0].Shards[0].RowsReturned)
+		assert.Equal(t, "20-", stats.Inputs[0].Shards[1].Shard)
+		assert.Equal(t, 1, stats.Inputs[0].Shards[1].RowsReturned)
+	})
+}
@@ -111,7 +111,7 @@ func (v *VStream) TryStreamExecute(vcursor VCursor, bindVars map[string]*querypb
 						vals = sqltypes.MakeRowTrusted(eventFields, change.After)
 					} else {
 						op = "-"
-						vals = sqltypes.MakeRowTrusted(eventFields, change.Before)
+						vals = beforeValues(eventFields, change)
 					}
 					newVals := append([]sqltypes.Value{sqltypes.NewVarChar(op)}, vals...)
 					result.Rows = append(result.Rows, newVals)
@@ -158,3 +158,31 @@ func (v *VStream) description() PrimitiveDescription {
 		Other:             other,
 	}
 }
+
+// beforeValues returns the values of the before image of a row change. The
+// columns missing from a partial before image have no value, and are NULL.
+func beforeValues(fields []*querypb.Field, change *binlogdatapb.RowChange) []sqltypes.Value {
+	if len(change.BeforeMissingColumns) == 0 {
+		return sqltypes.MakeRowTrusted(fields, change.Before)
+	}
+	present := make([]*querypb.Field, 0, len(fields))
+	positions := make([]int, 0, len(fields))
+	for i, field := range fields {
+		missing := false
+		for _, pos := range change.BeforeMissingColumns {
+			if pos == int64(i) {
+				missing = true
+				break
+			}
+		}
+		if !missing {
+			present = append(present, field)
+			positions = append(positions, i)
+		}
+	}
+	vals := make([]sqltypes.Value, len(fields))
+	for i, val := range sqltypes.MakeRowTrusted(present, change.Before) {
+		vals[positions[i]] = val
+	}
+	return vals
+}
@@ -0,0 +1,424 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+type (
+	// DateAdd is DATE_ADD(Date, INTERVAL Interval Unit), or DATE_SUB when Sub is set.
+	// It also implements `Date + INTERVAL Interval Unit` and TIMESTAMPADD.
+	DateAdd struct {
+		Date, Interval Expr
+		Unit           string
+		Sub            bool
+	}
+
+	// TimestampDiff is TIMESTAMPDIFF(Unit, Left, Right): Right - Left, in Unit.
+	TimestampDiff struct {
+		Unit        string
+		Left, Right Expr
+	}
+)
+
+var _ Expr = (*DateAdd)(nil)
+var _ Expr = (*TimestampDiff)(nil)
+
+const (
+	dateFormat     = "2006-01-02"
+	datetimeFormat = "2006-01-02 15:04:05"
+)
+
+// intervalUnit describes how the value of an INTERVAL with that unit is
+// split into parts: the parts, from the most to the least significant, are
+// years, months, days, hours, minutes, seconds and microseconds, and first
+// and last are the range of them the unit covers.
+type intervalUnit struct {
+	first, last int
+}
+
+const (
+	partYear = iota
+	partMonth
+	partDay
+	partHour
+	partMinute
+	partSecond
+	partMicrosecond
+	partCount
+)
+
+var intervalUnits = map[string]intervalUnit{
+	"microsecond":        {partMicrosecond, partMicrosecond},
+	"second":             {partSecond, partSecond},
+	"minute":             {partMinute, partMinute},
+	"hour":               {partHour, partHour},
+	"day":                {partDay, partDay},
+	"week":               {partDay, partDay},
+	"month":              {partMonth, partMonth},
+	"quarter":            {partMonth, partMonth},
+	"year":               {partYear, partYear},
+	"second_microsecond": {partSecond, partMicrosecond},
+	"minute_microsecond": {partMinute, partMicrosecond},
+	"minute_second":      {partMinute, partSecond},
+	"hour_microsecond":   {partHour, partMicrosecond},
+	"hour_second":        {partHour, partSecond},
+	"hour_minute":        {partHour, partMinute},
+	"day_microsecond":    {partDay, partMicrosecond},
+	"day_second":         {partDay, partSecond},
+	"day_minute":         {partDay, partMinute},
+	"day_hour":           {partDay, partHour},
+	"year_month":         {partYear, partMonth},
+}
+
+func lookupIntervalUnit(unit string) (intervalUnit, error) {
+	u, ok := intervalUnits[strings.ToLower(unit)]
+	if !ok {
+		return intervalUnit{}, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unsupported interval unit: %s", unit)
+	}
+	return u, nil
+}
+
+// hasTime returns true if the unit has parts smaller than a day, in which
+// case adding it to a DATE gives a DATETIME.
+func (u intervalUnit) hasTime() bool {
+	return u.last > partDay
+}
+
+// interval is the parsed value of an INTERVAL expression.
+type interval struct {
+	parts    [partCount]int64
+	negative bool
+}
+
+// parseInterval parses the value of an INTERVAL the way MySQL does: a single
+// unit takes a number, rounded to an integer except for SECOND, and a
+// compound unit takes a string of its parts, separated by any non-digit
+// characters and aligned to the least significant part when some are left
+// out.
+func parseInterval(value EvalResult, unit string) (interval, error) {
+	u, err := lookupIntervalUnit(unit)
+	if err != nil {
+		return interval{}, err
+	}
+
+	var iv interval
+	str := strings.TrimSpace(value.Value().ToString())
+	if strings.HasPrefix(str, "-") {
+		iv.negative = true
+		str = str[1:]
+	}
+
+	if u.first == u.last {
+		f, err := strconv.ParseFloat(str, 64)
+		if err != nil {
+			f = leadingNumber(str)
+		}
+		switch {
+		case u.first == partSecond:
+			iv.parts[partSecond] = int64(f)
+			iv.parts[partMicrosecond] = int64(math.Round((f - math.Trunc(f)) * 1e6))
+		default:
+			iv.parts[u.first] = int64(math.Round(f))
+		}
+
+		switch strings.ToLower(unit) {
+		case "week":
+			iv.parts[partDay] *= 7
+		case "quarter":
+			iv.parts[partMonth] *= 3
+		}
+		return iv, nil
+	}
+
+	fields := strings.FieldsFunc(str, func(r rune) bool { return r < '0' || r > '9' })
+	count := u.last - u.first + 1
+	if len(fields) > count {
+		return interval{}, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid interval value for %s: %s", unit, value.Value().ToString())
+	}
+	for i, field := range fields {
+		part, err := strconv.ParseInt(field, 10, 64)
+		if err != nil {
+			return interval{}, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid interval value for %s: %s", unit, value.Value().ToString())
+		}
+		iv.parts[u.last-len(fields)+1+i] = part
+	}
+	return iv, nil
+}
+
+// leadingNumber returns the number at the start of s, 0 if there is none, as
+// MySQL does when it casts a string to a number.
+func leadingNumber(s string) float64 {
+	end := 0
+	for end < len(s) && (s[end] >= '0' && s[end] <= '9' || s[end] == '.') {
+		end++
+	}
+	f, _ := strconv.ParseFloat(s[:end], 64)
+	return f
+}
+
+// addInterval adds the interval to t. Years and months are added first, and
+// the day is clamped to the last day of the resulting month, as in MySQL.
+func addInterval(t time.Time, iv interval, sub bool) time.Time {
+	sign := int64(1)
+	if iv.negative != sub {
+		sign = -1
+	}
+
+	months := sign * (iv.parts[partYear]*12 + iv.parts[partMonth])
+	if months != 0 {
+		total := int64(t.Year())*12 + int64(t.Month()) - 1 + months
+		if total < 0 {
+			// Before year 0, which is out of range anyway.
+			return time.Time{}
+		}
+		year, month := int(total/12), time.Month(total%12+1)
+		day := t.Day()
+		if last := daysIn(year, month); day > last {
+			day = last
+		}
+		t = time.Date(year, month, day, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+	}
+
+	duration := iv.parts[partDay]*24*3600*1e6 +
+		iv.parts[partHour]*3600*1e6 +
+		iv.parts[partMinute]*60*1e6 +
+		iv.parts[partSecond]*1e6 +
+		iv.parts[partMicrosecond]
+	days := duration / (24 * 3600 * 1e6)
+	micros := duration % (24 * 3600 * 1e6)
+	return t.AddDate(0, 0, int(sign*days)).Add(time.Duration(sign*micros) * time.Microsecond)
+}
+
+func daysIn(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// parseDatetime parses a DATE, DATETIME or TIMESTAMP value, or a string or
+// number in one of their formats. It returns false if the value is not a
+// valid date, including the zero date.
+func parseDatetime(v EvalResult) (t time.Time, hasTime bool, ok bool) {
+	str := strings.TrimSpace(v.Value().ToString())
+	if sqltypes.IsIntegral(v.typ) {
+		switch len(str) {
+		case 8:
+			str = str[0:4] + "-" + str[4:6] + "-" + str[6:8]
+		case 14:
+			str = str[0:4] + "-" + str[4:6] + "-" + str[6:8] + " " + str[8:10] + ":" + str[10:12] + ":" + str[12:14]
+		default:
+			return time.Time{}, false, false
+		}
+	}
+
+	str = strings.Replace(str, "T", " ", 1)
+	layout := dateFormat
+	if len(str) > len(dateFormat) {
+		layout = datetimeFormat
+		hasTime = true
+	}
+
+	t, err := time.Parse(layout, str)
+	if err != nil || t.Year() == 0 {
+		return time.Time{}, false, false
+	}
+	return t, hasTime || v.typ == sqltypes.Datetime || v.typ == sqltypes.Timestamp, true
+}
+
+func formatDatetime(t time.Time, withTime bool) []byte {
+	if !withTime {
+		return []byte(t.Format(dateFormat))
+	}
+	if t.Nanosecond() != 0 {
+		return []byte(t.Format(datetimeFormat + ".000000"))
+	}
+	return []byte(t.Format(datetimeFormat))
+}
+
+func isNull(v EvalResult) bool {
+	return v.typ == sqltypes.Null
+}
+
+// Evaluate implements the Expr interface
+func (d *DateAdd) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	date, err := d.Date.Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	value, err := d.Interval.Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	if isNull(date) || isNull(value) {
+		return EvalResult{typ: sqltypes.Null}, nil
+	}
+
+	u, err := lookupIntervalUnit(d.Unit)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	iv, err := parseInterval(value, d.Unit)
+	if err != nil {
+		return EvalResult{}, err
+	}
+
+	t, hasTime, ok := parseDatetime(date)
+	if !ok {
+		return invalidDatetime(date, env.SQLMode)
+	}
+
+	t = addInterval(t, iv, d.Sub)
+	if t.Year() < 1 || t.Year() > 9999 {
+		return EvalResult{typ: sqltypes.Null}, nil
+	}
+
+	typ := d.resultType(date.typ, u)
+	withTime := hasTime || u.hasTime()
+	if typ == sqltypes.Date {
+		withTime = false
+	}
+	return EvalResult{typ: typ, bytes: formatDatetime(t, withTime)}, nil
+}
+
+// resultType is the type MySQL gives the result of DATE_ADD: a DATE or a
+// DATETIME for temporal arguments, and a string otherwise.
+func (d *DateAdd) resultType(date querypb.Type, u intervalUnit) querypb.Type {
+	switch date {
+	case sqltypes.Date:
+		if u.hasTime() {
+			return sqltypes.Datetime
+		}
+		return sqltypes.Date
+	case sqltypes.Datetime, sqltypes.Timestamp:
+		return sqltypes.Datetime
+	case sqltypes.Null:
+		return sqltypes.Null
+	}
+	return sqltypes.VarChar
+}
+
+// Type implements the Expr interface
+func (d *DateAdd) Type(env ExpressionEnv) (querypb.Type, error) {
+	date, err := d.Date.Type(env)
+	if err != nil {
+		return 0, err
+	}
+	u, err := lookupIntervalUnit(d.Unit)
+	if err != nil {
+		return 0, err
+	}
+	return d.resultType(date, u), nil
+}
+
+// String implements the Expr interface
+func (d *DateAdd) String() string {
+	name := "date_add"
+	if d.Sub {
+		name = "date_sub"
+	}
+	return fmt.Sprintf("%s(%s, interval %s %s)", name, d.Date.String(), d.Interval.String(), strings.ToLower(d.Unit))
+}
+
+var timestampDiffUnits = map[string]int64{
+	"microsecond": 1,
+	"second":      1e6,
+	"minute":      60 * 1e6,
+	"hour":        3600 * 1e6,
+	"day":         24 * 3600 * 1e6,
+	"week":        7 * 24 * 3600 * 1e6,
+}
+
+var timestampDiffMonths = map[string]int64{
+	"month":   1,
+	"quarter": 3,
+	"year":    12,
+}
+
+// Evaluate implements the Expr interface
+func (d *TimestampDiff) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	left, err := d.Left.Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	right, err := d.Right.Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	if isNull(left) || isNull(right) {
+		return EvalResult{typ: sqltypes.Null}, nil
+	}
+
+	unit := strings.ToLower(d.Unit)
+	_, isMicros := timestampDiffUnits[unit]
+	_, isMonths := timestampDiffMonths[unit]
+	if !isMicros && !isMonths {
+		return EvalResult{}, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unsupported TIMESTAMPDIFF unit: %s", d.Unit)
+	}
+
+	t1, _, ok := parseDatetime(left)
+	if !ok {
+		return invalidDatetime(left, env.SQLMode)
+	}
+	t2, _, ok := parseDatetime(right)
+	if !ok {
+		return invalidDatetime(right, env.SQLMode)
+	}
+
+	if isMicros {
+		micros := (t2.Unix()-t1.Unix())*1e6 + int64(t2.Nanosecond()/1000-t1.Nanosecond()/1000)
+		return EvalResult{typ: sqltypes.Int64, ival: micros / timestampDiffUnits[unit]}, nil
+	}
+	return EvalResult{typ: sqltypes.Int64, ival: monthsBetween(t1, t2) / timestampDiffMonths[unit]}, nil
+}
+
+// monthsBetween returns the number of whole months from t1 to t2: a month is
+// only complete once t2 reaches the day and time of the month t1 is at.
+func monthsBetween(t1, t2 time.Time) int64 {
+	months := int64(t2.Year()-t1.Year())*12 + int64(t2.Month()-t1.Month())
+	rest := func(t time.Time) time.Duration {
+		return time.Duration(t.Day())*24*time.Hour + time.Duration(t.Hour())*time.Hour +
+			time.Duration(t.Minute())*time.Minute + time.Duration(t.Second())*time.Second +
+			time.Duration(t.Nanosecond()/1000)*time.Microsecond
+	}
+	switch {
+	case months > 0 && rest(t2) < rest(t1):
+		months--
+	case months < 0 && rest(t2) > rest(t1):
+		months++
+	}
+	return months
+}
+
+// Type implements the Expr interface
+func (d *TimestampDiff) Type(ExpressionEnv) (querypb.Type, error) {
+	return sqltypes.Int64, nil
+}
+
+// String implements the Expr interface
+func (d *TimestampDiff) String() string {
+	return fmt.Sprintf("timestampdiff(%s, %s, %s)", strings.ToLower(d.Unit), d.Left.String(), d.Right.String())
+}
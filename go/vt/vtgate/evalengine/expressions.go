@@ -40,6 +40,8 @@ type (
 	ExpressionEnv struct {
 		BindVars map[string]*querypb.BindVariable
 		Row      []sqltypes.Value
+		// SQLMode is the sql_mode the expression evaluates with
+		SQLMode SQLMode
 	}
 
 	// Expr is the interface that all evaluating expressions must implement
@@ -51,7 +53,7 @@ type (
 
 	//BinaryExpr allows binary expressions to not have to evaluate child expressions - this is done by the BinaryOp
 	BinaryExpr interface {
-		Evaluate(left, right EvalResult) (EvalResult, error)
+		Evaluate(left, right EvalResult, mode SQLMode) (EvalResult, error)
 		Type(left querypb.Type) querypb.Type
 		String() string
 	}
@@ -137,7 +139,7 @@ func (b *BinaryOp) Evaluate(env ExpressionEnv) (EvalResult, error) {
 	if err != nil {
 		return EvalResult{}, err
 	}
-	return b.Expr.Evaluate(lVal, rVal)
+	return b.Expr.Evaluate(lVal, rVal, env.SQLMode)
 }
 
 //Evaluate implements the Expr interface
@@ -162,25 +164,68 @@ func (c *Column) Evaluate(env ExpressionEnv) (EvalResult, error) {
 }
 
 //Evaluate implements the BinaryOp interface
-func (a *Addition) Evaluate(left, right EvalResult) (EvalResult, error) {
+func (a *Addition) Evaluate(left, right EvalResult, mode SQLMode) (EvalResult, error) {
+	left, right, err := toNumerics(left, right, mode)
+	if err != nil {
+		return EvalResult{}, err
+	}
 	return addNumericWithError(left, right)
 }
 
 //Evaluate implements the BinaryOp interface
-func (s *Subtraction) Evaluate(left, right EvalResult) (EvalResult, error) {
+func (s *Subtraction) Evaluate(left, right EvalResult, mode SQLMode) (EvalResult, error) {
+	left, right, err := toNumerics(left, right, mode)
+	if err != nil {
+		return EvalResult{}, err
+	}
 	return subtractNumericWithError(left, right)
 }
 
 //Evaluate implements the BinaryOp interface
-func (m *Multiplication) Evaluate(left, right EvalResult) (EvalResult, error) {
+func (m *Multiplication) Evaluate(left, right EvalResult, mode SQLMode) (EvalResult, error) {
+	left, right, err := toNumerics(left, right, mode)
+	if err != nil {
+		return EvalResult{}, err
+	}
 	return multiplyNumericWithError(left, right)
 }
 
 //Evaluate implements the BinaryOp interface
-func (d *Division) Evaluate(left, right EvalResult) (EvalResult, error) {
+func (d *Division) Evaluate(left, right EvalResult, mode SQLMode) (EvalResult, error) {
+	left, right, err := toNumerics(left, right, mode)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	if isZero(right) {
+		return divisionByZero(mode)
+	}
 	return divideNumericWithError(left, right)
 }
 
+func toNumerics(left, right EvalResult, mode SQLMode) (EvalResult, EvalResult, error) {
+	left, err := toNumeric(left, mode)
+	if err != nil {
+		return EvalResult{}, EvalResult{}, err
+	}
+	right, err = toNumeric(right, mode)
+	if err != nil {
+		return EvalResult{}, EvalResult{}, err
+	}
+	return left, right, nil
+}
+
+func isZero(v EvalResult) bool {
+	switch v.typ {
+	case sqltypes.Int64, sqltypes.Int32:
+		return v.ival == 0
+	case sqltypes.Uint64, sqltypes.Uint32:
+		return v.uval == 0
+	case sqltypes.Float64, sqltypes.Float32:
+		return v.fval == 0
+	}
+	return false
+}
+
 //Type implements the BinaryExpr interface
 func (a *Addition) Type(left querypb.Type) querypb.Type {
 	return left
@@ -317,6 +362,8 @@ func evaluateByType(val *querypb.BindVariable) (EvalResult, error) {
 		return EvalResult{typ: sqltypes.Float64, fval: fval}, nil
 	case sqltypes.VarChar, sqltypes.Text, sqltypes.VarBinary:
 		return EvalResult{typ: sqltypes.VarBinary, bytes: val.Value}, nil
+	case sqltypes.Date, sqltypes.Datetime, sqltypes.Timestamp, sqltypes.Time, sqltypes.TypeJSON:
+		return EvalResult{typ: val.Type, bytes: val.Value}, nil
 	case sqltypes.Null:
 		return EvalResult{typ: sqltypes.Null}, nil
 	}
@@ -0,0 +1,373 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+type (
+	// JSONExtract is JSON_EXTRACT(Doc, Paths...), and the `->` operator.
+	JSONExtract struct {
+		Doc   Expr
+		Paths []Expr
+	}
+
+	// JSONUnquote is JSON_UNQUOTE(Expr). `Doc ->> Path` is a JSONUnquote of
+	// a JSONExtract.
+	JSONUnquote struct {
+		Expr Expr
+	}
+)
+
+var _ Expr = (*JSONExtract)(nil)
+var _ Expr = (*JSONUnquote)(nil)
+
+// Evaluate implements the Expr interface
+func (j *JSONExtract) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	doc, err := j.Doc.Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	if isNull(doc) {
+		return EvalResult{typ: sqltypes.Null}, nil
+	}
+
+	value, err := parseJSON(doc.Value().Raw())
+	if err != nil {
+		return EvalResult{}, err
+	}
+
+	var matches []interface{}
+	wildcard := len(j.Paths) > 1
+	for _, p := range j.Paths {
+		pathValue, err := p.Evaluate(env)
+		if err != nil {
+			return EvalResult{}, err
+		}
+		if isNull(pathValue) {
+			return EvalResult{typ: sqltypes.Null}, nil
+		}
+
+		path, err := parseJSONPath(pathValue.Value().ToString())
+		if err != nil {
+			return EvalResult{}, err
+		}
+		wildcard = wildcard || path.hasWildcard()
+		matches = path.match(value, matches)
+	}
+
+	switch {
+	case len(matches) == 0:
+		return EvalResult{typ: sqltypes.Null}, nil
+	case !wildcard:
+		return EvalResult{typ: sqltypes.TypeJSON, bytes: formatJSON(nil, matches[0])}, nil
+	}
+	return EvalResult{typ: sqltypes.TypeJSON, bytes: formatJSON(nil, matches)}, nil
+}
+
+// Type implements the Expr interface
+func (j *JSONExtract) Type(ExpressionEnv) (querypb.Type, error) {
+	return sqltypes.TypeJSON, nil
+}
+
+// String implements the Expr interface
+func (j *JSONExtract) String() string {
+	args := []string{j.Doc.String()}
+	for _, p := range j.Paths {
+		args = append(args, p.String())
+	}
+	return fmt.Sprintf("json_extract(%s)", strings.Join(args, ", "))
+}
+
+// Evaluate implements the Expr interface
+func (j *JSONUnquote) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	val, err := j.Expr.Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	if isNull(val) {
+		return EvalResult{typ: sqltypes.Null}, nil
+	}
+
+	raw := val.Value().Raw()
+	if len(raw) < 2 || raw[0] != '"' || raw[len(raw)-1] != '"' {
+		return EvalResult{typ: sqltypes.VarChar, bytes: raw}, nil
+	}
+
+	var str string
+	if err := json.Unmarshal(raw, &str); err != nil {
+		return EvalResult{}, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Invalid JSON text in argument 1 to function json_unquote: %s", string(raw))
+	}
+	return EvalResult{typ: sqltypes.VarChar, bytes: []byte(str)}, nil
+}
+
+// Type implements the Expr interface
+func (j *JSONUnquote) Type(ExpressionEnv) (querypb.Type, error) {
+	return sqltypes.VarChar, nil
+}
+
+// String implements the Expr interface
+func (j *JSONUnquote) String() string {
+	return fmt.Sprintf("json_unquote(%s)", j.Expr.String())
+}
+
+func parseJSON(doc []byte) (interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(doc))
+	decoder.UseNumber()
+
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Invalid JSON text: %v", err)
+	}
+	if decoder.More() {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Invalid JSON text: The document root must not be followed by other values")
+	}
+	return value, nil
+}
+
+// formatJSON appends the value to buf the way MySQL prints JSON values: with
+// a space after the separators, and the keys of objects sorted.
+func formatJSON(buf []byte, value interface{}) []byte {
+	switch value := value.(type) {
+	case nil:
+		return append(buf, "null"...)
+	case bool:
+		return strconv.AppendBool(buf, value)
+	case json.Number:
+		return append(buf, value...)
+	case string:
+		return appendJSONString(buf, value)
+	case []interface{}:
+		buf = append(buf, '[')
+		for i, v := range value {
+			if i > 0 {
+				buf = append(buf, ", "...)
+			}
+			buf = formatJSON(buf, v)
+		}
+		return append(buf, ']')
+	case map[string]interface{}:
+		keys := sortedJSONKeys(value)
+
+		buf = append(buf, '{')
+		for i, k := range keys {
+			if i > 0 {
+				buf = append(buf, ", "...)
+			}
+			buf = appendJSONString(buf, k)
+			buf = append(buf, ": "...)
+			buf = formatJSON(buf, value[k])
+		}
+		return append(buf, '}')
+	}
+	return buf
+}
+
+// sortedJSONKeys returns the keys of the object in the order MySQL stores
+// them: by length first, and then by bytes.
+func sortedJSONKeys(obj map[string]interface{}) []string {
+	keys := make([]string, 0, len(obj))
+	for k := range obj {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if len(keys[i]) != len(keys[j]) {
+			return len(keys[i]) < len(keys[j])
+		}
+		return keys[i] < keys[j]
+	})
+	return keys
+}
+
+func appendJSONString(buf []byte, s string) []byte {
+	buf = append(buf, '"')
+	for _, r := range s {
+		switch r {
+		case '"', '\\':
+			buf = append(buf, '\\', byte(r))
+		case '\b':
+			buf = append(buf, `\b`...)
+		case '\f':
+			buf = append(buf, `\f`...)
+		case '\n':
+			buf = append(buf, `\n`...)
+		case '\r':
+			buf = append(buf, `\r`...)
+		case '\t':
+			buf = append(buf, `\t`...)
+		default:
+			if r < 0x20 {
+				buf = append(buf, fmt.Sprintf(`\u%04x`, r)...)
+			} else {
+				buf = append(buf, string(r)...)
+			}
+		}
+	}
+	return append(buf, '"')
+}
+
+// jsonPathLeg is a step of a JSON path: a member of an object, or an element
+// of an array, or all of them for a wildcard.
+type jsonPathLeg struct {
+	key      string
+	index    int
+	isArray  bool
+	wildcard bool
+}
+
+type jsonPath []jsonPathLeg
+
+// parseJSONPath parses a JSON path of the form `$.key."quoted key"[1][*].*`.
+func parseJSONPath(path string) (jsonPath, error) {
+	invalid := func() (jsonPath, error) {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Invalid JSON path expression: %s", path)
+	}
+
+	s := strings.TrimSpace(path)
+	if !strings.HasPrefix(s, "$") {
+		return invalid()
+	}
+	s = s[1:]
+
+	var legs jsonPath
+	for {
+		s = strings.TrimLeft(s, " ")
+		if s == "" {
+			return legs, nil
+		}
+
+		switch s[0] {
+		case '.':
+			s = strings.TrimLeft(s[1:], " ")
+			switch {
+			case strings.HasPrefix(s, "*"):
+				legs = append(legs, jsonPathLeg{wildcard: true})
+				s = s[1:]
+			case strings.HasPrefix(s, `"`):
+				end := 1
+				for end < len(s) && s[end] != '"' {
+					if s[end] == '\\' {
+						end++
+					}
+					end++
+				}
+				if end >= len(s) {
+					return invalid()
+				}
+				var key string
+				if err := json.Unmarshal([]byte(s[:end+1]), &key); err != nil {
+					return invalid()
+				}
+				legs = append(legs, jsonPathLeg{key: key})
+				s = s[end+1:]
+			default:
+				end := strings.IndexAny(s, ".[ ")
+				if end < 0 {
+					end = len(s)
+				}
+				if end == 0 {
+					return invalid()
+				}
+				legs = append(legs, jsonPathLeg{key: s[:end]})
+				s = s[end:]
+			}
+		case '[':
+			end := strings.IndexByte(s, ']')
+			if end < 0 {
+				return invalid()
+			}
+			index := strings.TrimSpace(s[1:end])
+			if index == "*" {
+				legs = append(legs, jsonPathLeg{isArray: true, wildcard: true})
+			} else {
+				i, err := strconv.Atoi(index)
+				if err != nil || i < 0 {
+					return invalid()
+				}
+				legs = append(legs, jsonPathLeg{index: i, isArray: true})
+			}
+			s = s[end+1:]
+		default:
+			return invalid()
+		}
+	}
+}
+
+func (p jsonPath) hasWildcard() bool {
+	for _, leg := range p {
+		if leg.wildcard {
+			return true
+		}
+	}
+	return false
+}
+
+// match appends the values of doc the path matches to matches.
+func (p jsonPath) match(doc interface{}, matches []interface{}) []interface{} {
+	if len(p) == 0 {
+		return append(matches, doc)
+	}
+
+	leg, rest := p[0], p[1:]
+	if leg.isArray {
+		arr, ok := doc.([]interface{})
+		if !ok {
+			// MySQL treats a scalar or an object as an array of one element.
+			if leg.wildcard || leg.index == 0 {
+				return rest.match(doc, matches)
+			}
+			return matches
+		}
+		if leg.wildcard {
+			for _, v := range arr {
+				matches = rest.match(v, matches)
+			}
+			return matches
+		}
+		if leg.index < len(arr) {
+			return rest.match(arr[leg.index], matches)
+		}
+		return matches
+	}
+
+	obj, ok := doc.(map[string]interface{})
+	if !ok {
+		return matches
+	}
+	if leg.wildcard {
+		for _, k := range sortedJSONKeys(obj) {
+			matches = rest.match(obj[k], matches)
+		}
+		return matches
+	}
+	if v, ok := obj[leg.key]; ok {
+		return rest.match(v, matches)
+	}
+	return matches
+}
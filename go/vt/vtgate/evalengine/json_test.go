@@ -0,0 +1,68 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// more tests in go/sqlparser/expressions_test.go
+
+func TestJSONPathMatch(t *testing.T) {
+	doc, err := parseJSON([]byte(`{"b": {"x": 1}, "aa": [1, [2, 3]], "a": "é\n", "c d": 1.50}`))
+	require.NoError(t, err)
+
+	tests := []struct {
+		path     string
+		expected string
+	}{
+		{"$", `{"a": "é\n", "b": {"x": 1}, "aa": [1, [2, 3]], "c d": 1.50}`},
+		{"$.*", `"é\n", {"x": 1}, [1, [2, 3]], 1.50`},
+		{"$.aa[1][*]", `2, 3`},
+		{"$.aa[5]", ``},
+		{`$."c d"`, `1.50`},
+		{"$.b[0].x", `1`},
+		{"$.b.x[0]", `1`},
+		{"$.b.x[1]", ``},
+	}
+
+	for _, test := range tests {
+		t.Run(test.path, func(t *testing.T) {
+			path, err := parseJSONPath(test.path)
+			require.NoError(t, err)
+
+			var out []byte
+			for i, match := range path.match(doc, nil) {
+				if i > 0 {
+					out = append(out, ", "...)
+				}
+				out = formatJSON(out, match)
+			}
+			assert.Equal(t, test.expected, string(out))
+		})
+	}
+}
+
+func TestParseJSONPathErrors(t *testing.T) {
+	for _, path := range []string{"", "a.b", "$.", "$[a]", "$[-1]", `$."a`, "$**.a"} {
+		_, err := parseJSONPath(path)
+		assert.Error(t, err, path)
+	}
+}
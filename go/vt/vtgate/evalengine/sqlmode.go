@@ -0,0 +1,189 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"strconv"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// SQLMode is the part of MySQL's sql_mode that changes how expressions
+// evaluate. With the zero SQLMode, expressions evaluate the way MySQL
+// evaluates them with an empty sql_mode: division by zero, invalid dates and
+// strings that are not entirely numbers in numeric contexts give NULL or the
+// leading number of the string. In strict mode, they are errors instead, as
+// they are for the values MySQL writes in strict mode:
+//   - division by zero, if ERROR_FOR_DIVISION_BY_ZERO is also set,
+//   - the zero date, if NO_ZERO_DATE is also set,
+//   - dates with a zero month or day, if NO_ZERO_IN_DATE is also set,
+//   - other invalid dates, and strings that are not entirely numbers.
+type SQLMode uint8
+
+const (
+	// StrictMode is STRICT_TRANS_TABLES or STRICT_ALL_TABLES.
+	StrictMode SQLMode = 1 << iota
+	// ErrorForDivisionByZero is ERROR_FOR_DIVISION_BY_ZERO.
+	ErrorForDivisionByZero
+	// NoZeroDate is NO_ZERO_DATE.
+	NoZeroDate
+	// NoZeroInDate is NO_ZERO_IN_DATE.
+	NoZeroInDate
+)
+
+var sqlModes = map[string]SQLMode{
+	"strict_trans_tables":        StrictMode,
+	"strict_all_tables":          StrictMode,
+	"error_for_division_by_zero": ErrorForDivisionByZero,
+	"no_zero_date":               NoZeroDate,
+	"no_zero_in_date":            NoZeroInDate,
+	"traditional":                StrictMode | ErrorForDivisionByZero | NoZeroDate | NoZeroInDate,
+}
+
+// ParseSQLMode parses a comma-separated sql_mode. The modes that do not
+// change how expressions evaluate, such as ONLY_FULL_GROUP_BY, are ignored.
+func ParseSQLMode(mode string) SQLMode {
+	var m SQLMode
+	for _, name := range strings.Split(mode, ",") {
+		m |= sqlModes[strings.ToLower(strings.TrimSpace(name))]
+	}
+	return m
+}
+
+// String returns the modes of m, in the format of sql_mode.
+func (m SQLMode) String() string {
+	var names []string
+	for _, mode := range []struct {
+		mode SQLMode
+		name string
+	}{
+		{StrictMode, "STRICT_TRANS_TABLES"},
+		{ErrorForDivisionByZero, "ERROR_FOR_DIVISION_BY_ZERO"},
+		{NoZeroDate, "NO_ZERO_DATE"},
+		{NoZeroInDate, "NO_ZERO_IN_DATE"},
+	} {
+		if m&mode.mode != 0 {
+			names = append(names, mode.name)
+		}
+	}
+	return strings.Join(names, ",")
+}
+
+func (m SQLMode) strict(mode SQLMode) bool {
+	return m&StrictMode != 0 && m&mode == mode
+}
+
+// toNumeric converts v to a number for arithmetic. A string that is not
+// entirely a number is an error in strict mode, and its leading number
+// otherwise.
+func toNumeric(v EvalResult, mode SQLMode) (EvalResult, error) {
+	if sqltypes.IsNumber(v.typ) || v.typ == sqltypes.Null {
+		return v, nil
+	}
+
+	str := strings.TrimSpace(string(v.bytes))
+	if ival, err := strconv.ParseInt(str, 10, 64); err == nil {
+		return EvalResult{ival: ival, typ: sqltypes.Int64}, nil
+	}
+	if fval, err := strconv.ParseFloat(str, 64); err == nil {
+		return EvalResult{fval: fval, typ: sqltypes.Float64}, nil
+	}
+
+	if mode.strict(StrictMode) {
+		return EvalResult{}, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.TruncatedWrongValue, "Truncated incorrect DOUBLE value: '%s'", string(v.bytes))
+	}
+
+	prefix := numericPrefix(str)
+	if ival, err := strconv.ParseInt(prefix, 10, 64); err == nil {
+		return EvalResult{ival: ival, typ: sqltypes.Int64}, nil
+	}
+	if fval, err := strconv.ParseFloat(prefix, 64); err == nil {
+		return EvalResult{fval: fval, typ: sqltypes.Float64}, nil
+	}
+	return EvalResult{ival: 0, typ: sqltypes.Int64}, nil
+}
+
+// numericPrefix returns the longest prefix of s that is a number.
+func numericPrefix(s string) string {
+	end := 0
+	digits := func() {
+		for end < len(s) && s[end] >= '0' && s[end] <= '9' {
+			end++
+		}
+	}
+
+	if end < len(s) && (s[end] == '+' || s[end] == '-') {
+		end++
+	}
+	digits()
+	if end < len(s) && s[end] == '.' {
+		end++
+		digits()
+	}
+	if end < len(s) && (s[end] == 'e' || s[end] == 'E') {
+		exponent := end
+		end++
+		if end < len(s) && (s[end] == '+' || s[end] == '-') {
+			end++
+		}
+		start := end
+		digits()
+		if end == start {
+			end = exponent
+		}
+	}
+	return s[:end]
+}
+
+// invalidDatetime returns the result of a function given the invalid date v:
+// NULL, or an error in strict mode.
+func invalidDatetime(v EvalResult, mode SQLMode) (EvalResult, error) {
+	str := strings.TrimSpace(v.Value().ToString())
+
+	check := StrictMode
+	switch {
+	case strings.Trim(str, "0-: .") == "":
+		check = NoZeroDate
+	case isZeroInDate(str):
+		check = NoZeroInDate
+	}
+
+	if mode.strict(check) {
+		return EvalResult{}, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.TruncatedWrongValue, "Incorrect datetime value: '%s'", str)
+	}
+	return EvalResult{typ: sqltypes.Null}, nil
+}
+
+// isZeroInDate returns true if the date, in the YYYY-MM-DD format, has a zero
+// month or day.
+func isZeroInDate(date string) bool {
+	if len(date) < len(dateFormat) || date[4] != '-' || date[7] != '-' {
+		return false
+	}
+	return date[5:7] == "00" || date[8:10] == "00"
+}
+
+func divisionByZero(mode SQLMode) (EvalResult, error) {
+	if mode.strict(ErrorForDivisionByZero) {
+		return EvalResult{}, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.DivisionByZero, "Division by 0")
+	}
+	return EvalResult{typ: sqltypes.Null}, nil
+}
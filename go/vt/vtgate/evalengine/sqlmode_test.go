@@ -0,0 +1,150 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+func TestParseSQLMode(t *testing.T) {
+	tests := []struct {
+		mode     string
+		expected SQLMode
+	}{
+		{"", 0},
+		{"ONLY_FULL_GROUP_BY", 0},
+		{"ONLY_FULL_GROUP_BY,STRICT_TRANS_TABLES,NO_ZERO_IN_DATE,NO_ZERO_DATE,ERROR_FOR_DIVISION_BY_ZERO,NO_ENGINE_SUBSTITUTION", StrictMode | NoZeroInDate | NoZeroDate | ErrorForDivisionByZero},
+		{"strict_all_tables, no_zero_date", StrictMode | NoZeroDate},
+		{"TRADITIONAL", StrictMode | NoZeroInDate | NoZeroDate | ErrorForDivisionByZero},
+	}
+
+	for _, test := range tests {
+		t.Run(test.mode, func(t *testing.T) {
+			assert.Equal(t, test.expected, ParseSQLMode(test.mode))
+		})
+	}
+
+	assert.Equal(t, "STRICT_TRANS_TABLES,NO_ZERO_DATE", (StrictMode | NoZeroDate).String())
+}
+
+func TestSQLModeEvaluation(t *testing.T) {
+	divide := func(l, r Expr) Expr {
+		return &BinaryOp{Expr: &Division{}, Left: l, Right: r}
+	}
+	add := func(l, r Expr) Expr {
+		return &BinaryOp{Expr: &Addition{}, Left: l, Right: r}
+	}
+	dateAdd := func(date string) Expr {
+		return &DateAdd{Date: NewLiteralString([]byte(date)), Interval: NewLiteralInt(1), Unit: "day"}
+	}
+	traditional := ParseSQLMode("TRADITIONAL")
+
+	tests := []struct {
+		name     string
+		expr     Expr
+		mode     SQLMode
+		expected sqltypes.Value
+		err      string
+		state    vterrors.State
+	}{{
+		name:     "division by zero",
+		expr:     divide(NewLiteralInt(1), NewLiteralInt(0)),
+		expected: sqltypes.NULL,
+	}, {
+		name:     "division by zero without error_for_division_by_zero",
+		expr:     divide(NewLiteralInt(1), NewLiteralString([]byte("0.0"))),
+		mode:     StrictMode,
+		expected: sqltypes.NULL,
+	}, {
+		name:  "division by zero in strict mode",
+		expr:  divide(NewLiteralInt(1), NewLiteralInt(0)),
+		mode:  traditional,
+		err:   "Division by 0",
+		state: vterrors.DivisionByZero,
+	}, {
+		name:     "truncated number",
+		expr:     add(NewLiteralInt(1), NewLiteralString([]byte(" 12.5abc"))),
+		expected: sqltypes.NewFloat64(13.5),
+	}, {
+		name:     "not a number",
+		expr:     add(NewLiteralInt(1), NewLiteralString([]byte("abc"))),
+		expected: sqltypes.NewInt64(1),
+	}, {
+		name:  "truncated number in strict mode",
+		expr:  add(NewLiteralInt(1), NewLiteralString([]byte("12abc"))),
+		mode:  StrictMode,
+		err:   "Truncated incorrect DOUBLE value: '12abc'",
+		state: vterrors.TruncatedWrongValue,
+	}, {
+		name:     "number in strict mode",
+		expr:     add(NewLiteralInt(1), NewLiteralString([]byte("1e1"))),
+		mode:     StrictMode,
+		expected: sqltypes.NewFloat64(11),
+	}, {
+		name:     "zero date",
+		expr:     dateAdd("0000-00-00"),
+		mode:     StrictMode,
+		expected: sqltypes.NULL,
+	}, {
+		name:  "zero date with no_zero_date",
+		expr:  dateAdd("0000-00-00 00:00:00"),
+		mode:  traditional,
+		err:   "Incorrect datetime value: '0000-00-00 00:00:00'",
+		state: vterrors.TruncatedWrongValue,
+	}, {
+		name:     "zero in date",
+		expr:     dateAdd("2021-00-10"),
+		mode:     StrictMode | NoZeroDate,
+		expected: sqltypes.NULL,
+	}, {
+		name:  "zero in date with no_zero_in_date",
+		expr:  dateAdd("2021-00-10"),
+		mode:  StrictMode | NoZeroInDate,
+		err:   "Incorrect datetime value: '2021-00-10'",
+		state: vterrors.TruncatedWrongValue,
+	}, {
+		name:     "invalid date",
+		expr:     dateAdd("2021-02-30"),
+		mode:     NoZeroDate | NoZeroInDate,
+		expected: sqltypes.NULL,
+	}, {
+		name:  "invalid date in strict mode",
+		expr:  dateAdd("2021-02-30"),
+		mode:  StrictMode,
+		err:   "Incorrect datetime value: '2021-02-30'",
+		state: vterrors.TruncatedWrongValue,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r, err := test.expr.Evaluate(ExpressionEnv{SQLMode: test.mode})
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				assert.Equal(t, test.state, vterrors.ErrState(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, r.Value())
+		})
+	}
+}
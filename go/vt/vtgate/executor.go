@@ -34,7 +34,9 @@ import (
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/sync2"
 	"vitess.io/vitess/go/trace"
+	"vitess.io/vitess/go/vt/auditlog"
 	"vitess.io/vitess/go/vt/callerid"
 	"vitess.io/vitess/go/vt/discovery"
 	"vitess.io/vitess/go/vt/key"
@@ -46,10 +48,13 @@ import (
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/masking"
 	"vitess.io/vitess/go/vt/vtgate/planbuilder"
+	"vitess.io/vitess/go/vt/vtgate/semantics"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
 	"vitess.io/vitess/go/vt/vtgate/vschemaacl"
 
+	auditlogdatapb "vitess.io/vitess/go/vt/proto/auditlogdata"
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
@@ -103,6 +108,24 @@ type Executor struct {
 
 	// allowScatter will fail planning if set to false and a plan contains any scatter queries
 	allowScatter bool
+
+	// rewriters are the query rewriters to run on the queries before they are planned
+	rewriters []namedQueryRewriter
+
+	// maskingPolicy masks the columns of the results of the callers it does not exempt
+	maskingPolicy *masking.Policy
+
+	messageGroups *messageGroups
+
+	throttlerChecker *throttlerChecker
+	ddlStatuses      *ddlStatuses
+
+	// mirror executes a sample of the read queries a second time, or is nil
+	mirror *queryMirror
+
+	// schemaVersion is the version of the schema the fields of the plans
+	// are cached for.
+	schemaVersion sync2.AtomicInt64
 }
 
 var executorOnce sync.Once
@@ -135,6 +158,11 @@ func NewExecutor(
 		streamSize:      streamSize,
 		schemaTracker:   schemaTracker,
 		allowScatter:    !noScatter,
+		messageGroups:   newMessageGroups(resolver.scatterConn.MessageStream),
+
+		throttlerChecker: newThrottlerChecker(resolver.scatterConn.gateway),
+		ddlStatuses:      newDDLStatuses(serv, *ddlStatusHistory),
+		mirror:           newQueryMirror(),
 	}
 
 	vschemaacl.Init()
@@ -157,6 +185,8 @@ func NewExecutor(
 		http.Handle(pathQueryPlans, e)
 		http.Handle(pathScatterStats, e)
 		http.Handle(pathVSchema, e)
+		http.Handle(pathPlanDiff, e)
+		http.Handle(pathVSchemaPlanDiff, e)
 	})
 	return e
 }
@@ -168,10 +198,36 @@ func (e *Executor) Execute(ctx context.Context, method string, safeSession *Safe
 	trace.AnnotateSQL(span, sql)
 	defer span.Finish()
 
+	limit, err := chunkedDMLLimit(sql)
+	if err != nil {
+		return nil, err
+	}
+	if limit > 0 {
+		// The progress of the chunks is only reported by StreamExecute.
+		rowsAffected, err := e.executeChunkedDML(ctx, method, safeSession, sql, bindVars, limit, func(uint64, uint64, uint64) error { return nil })
+		if err != nil {
+			return nil, err
+		}
+		return &sqltypes.Result{RowsAffected: rowsAffected}, nil
+	}
+
 	logStats := NewLogStats(ctx, method, sql, bindVars)
 	stmtType, result, err := e.execute(ctx, safeSession, sql, bindVars, logStats)
 	logStats.Error = err
+	if stmtType == sqlparser.StmtDDL {
+		e.schemaVersion.Add(1)
+		auditlog.Record(ctx, &auditlogdatapb.Event{
+			Actor:     auditlog.Actor(ctx),
+			Action:    "ddl",
+			Statement: sql,
+			Keyspace:  logStats.Keyspace,
+			Error:     auditlog.ErrorString(err),
+		})
+	}
 	saveSessionStats(safeSession, stmtType, result, err)
+	if err == nil && e.mirror != nil {
+		e.mirror.mirror(ctx, e, safeSession, stmtType, sql, bindVars, result, time.Since(logStats.StartTime))
+	}
 	if result != nil && len(result.Rows) > *warnMemoryRows {
 		warnings.Add("ResultsExceeded", 1)
 		piiSafeSQL, err := sqlparser.RedactSQLQuery(sql)
@@ -205,11 +261,15 @@ func saveSessionStats(safeSession *SafeSession, stmtType sqlparser.StatementType
 }
 
 func (e *Executor) execute(ctx context.Context, safeSession *SafeSession, sql string, bindVars map[string]*querypb.BindVariable, logStats *LogStats) (sqlparser.StatementType, *sqltypes.Result, error) {
+	budget := maxExecutionTime(safeSession)
+	ctx, cancel := withMaxExecutionTime(ctx, budget, logStats)
+	defer cancel()
+
 	stmtType, qr, err := e.newExecute(ctx, safeSession, sql, bindVars, logStats)
 	if err == planbuilder.ErrPlanNotSupported {
-		return e.legacyExecute(ctx, safeSession, sql, bindVars, logStats)
+		stmtType, qr, err = e.legacyExecute(ctx, safeSession, sql, bindVars, logStats)
 	}
-	return stmtType, qr, err
+	return stmtType, qr, maxExecutionTimeError(err, budget, logStats)
 }
 
 func (e *Executor) legacyExecute(ctx context.Context, safeSession *SafeSession, sql string, bindVars map[string]*querypb.BindVariable, logStats *LogStats) (sqlparser.StatementType, *sqltypes.Result, error) {
@@ -299,12 +359,24 @@ func (e *Executor) addNeededBindVars(bindVarNeeds *sqlparser.BindVarNeeds, bindV
 				v = options.ClientFoundRows
 			})
 			bindVars[key] = sqltypes.BoolBindVariable(v)
+		case sysvars.SkipKeyspacePolicy.Name:
+			var v bool
+			ifOptionsExist(session, func(options *querypb.ExecuteOptions) {
+				v = options.SkipKeyspacePolicy
+			})
+			bindVars[key] = sqltypes.BoolBindVariable(v)
 		case sysvars.SQLSelectLimit.Name:
 			var v int64
 			ifOptionsExist(session, func(options *querypb.ExecuteOptions) {
 				v = options.SqlSelectLimit
 			})
 			bindVars[key] = sqltypes.Int64BindVariable(v)
+		case sysvars.MaxExecutionTime.Name:
+			var v int64
+			ifOptionsExist(session, func(options *querypb.ExecuteOptions) {
+				v = options.MaxExecutionTimeMs
+			})
+			bindVars[key] = sqltypes.Int64BindVariable(v)
 		case sysvars.TransactionMode.Name:
 			bindVars[key] = sqltypes.StringBindVariable(session.TransactionMode.String())
 		case sysvars.Workload.Name:
@@ -313,12 +385,28 @@ func (e *Executor) addNeededBindVars(bindVarNeeds *sqlparser.BindVarNeeds, bindV
 				v = options.GetWorkload().String()
 			})
 			bindVars[key] = sqltypes.StringBindVariable(v)
+		case sysvars.WorkloadName.Name:
+			var v string
+			ifOptionsExist(session, func(options *querypb.ExecuteOptions) {
+				v = options.WorkloadName
+			})
+			bindVars[key] = sqltypes.StringBindVariable(v)
+		case sysvars.Tenant.Name:
+			bindVars[key] = sqltypes.StringBindVariable(session.Tenant)
+		case sysvars.Priority.Name:
+			var v string
+			ifOptionsExist(session, func(options *querypb.ExecuteOptions) {
+				v = priorityName(options.Priority)
+			})
+			bindVars[key] = sqltypes.StringBindVariable(v)
 		case sysvars.DDLStrategy.Name:
 			bindVars[key] = sqltypes.StringBindVariable(session.DDLStrategy)
 		case sysvars.SessionUUID.Name:
 			bindVars[key] = sqltypes.StringBindVariable(session.SessionUUID)
 		case sysvars.SessionEnableSystemSettings.Name:
 			bindVars[key] = sqltypes.BoolBindVariable(session.EnableSystemSettings)
+		case sysvars.ConsistentSnapshotReads.Name:
+			bindVars[key] = sqltypes.BoolBindVariable(session.ConsistentSnapshotReads)
 		case sysvars.ReadAfterWriteGTID.Name:
 			var v string
 			ifReadAfterWriteExist(session, func(raw *vtgatepb.ReadAfterWrite) {
@@ -385,6 +473,9 @@ func (e *Executor) handleBegin(ctx context.Context, safeSession *SafeSession, lo
 	execStart := time.Now()
 	logStats.PlanTime = execStart.Sub(logStats.StartTime)
 	err := e.txConn.Begin(ctx, safeSession)
+	if err == nil {
+		err = e.captureSnapshotGTIDs(ctx, safeSession)
+	}
 	logStats.ExecuteTime = time.Since(execStart)
 
 	e.updateQueryCounts("Begin", "", "", 0)
@@ -1025,9 +1116,21 @@ func (e *Executor) handleOther(ctx context.Context, safeSession *SafeSession, sq
 
 // StreamExecute executes a streaming query.
 func (e *Executor) StreamExecute(ctx context.Context, method string, safeSession *SafeSession, sql string, bindVars map[string]*querypb.BindVariable, target *querypb.Target, callback func(*sqltypes.Result) error) (err error) {
+	limit, err := chunkedDMLLimit(sql)
+	if err != nil {
+		return err
+	}
+	if limit > 0 {
+		return e.streamExecuteChunkedDML(ctx, method, safeSession, sql, bindVars, limit, callback)
+	}
+
 	logStats := NewLogStats(ctx, method, sql, bindVars)
 	defer logStats.Send()
 
+	budget := maxExecutionTime(safeSession)
+	ctx, cancel := withMaxExecutionTime(ctx, budget, logStats)
+	defer cancel()
+
 	if bindVars == nil {
 		bindVars = make(map[string]*querypb.BindVariable)
 	}
@@ -1052,6 +1155,7 @@ func (e *Executor) StreamExecute(ctx context.Context, method string, safeSession
 	case sqlparser.StmtBegin, sqlparser.StmtCommit, sqlparser.StmtRollback:
 		return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "OLAP does not supported statement type: %s", plan.Type)
 	}
+	vc.SetQueryFingerprint(plan.Fingerprint)
 
 	err = e.addNeededBindVars(plan.BindVarNeeds, bindVars, safeSession)
 	if err != nil {
@@ -1114,9 +1218,10 @@ func (e *Executor) StreamExecute(ctx context.Context, method string, safeSession
 
 	logStats.ExecuteTime = time.Since(execStart)
 	e.updateQueryCounts(plan.Instructions.RouteType(), plan.Instructions.GetKeyspaceName(), plan.Instructions.GetTableName(), int64(logStats.ShardQueries))
+	recordWorkloadQuery(plan, safeSession, logStats)
 
 	if err != nil {
-		return err
+		return maxExecutionTimeError(err, budget, logStats)
 	}
 
 	if plan.Type == sqlparser.StmtStream || plan.Type == sqlparser.StmtVStream {
@@ -1164,9 +1269,13 @@ func (e *Executor) SaveVSchema(vschema *vindexes.VSchema, stats *VSchemaStats) {
 	defer e.mu.Unlock()
 	if vschema != nil {
 		e.vschema = vschema
+		if e.scatterConn != nil {
+			e.scatterConn.gateway.SetTabletRoutingRules(vschema.TabletRoutingRules)
+		}
 	}
 	e.vschemaStats = stats
 	e.plans.Clear()
+	e.schemaVersion.Add(1)
 
 	if vschemaCounters != nil {
 		vschemaCounters.Add("Reload", 1)
@@ -1174,6 +1283,17 @@ func (e *Executor) SaveVSchema(vschema *vindexes.VSchema, stats *VSchemaStats) {
 
 }
 
+// SchemaVersion returns the version of the schema the fields of the plans are
+// cached for.
+func (e *Executor) SchemaVersion() int64 {
+	return e.schemaVersion.Get()
+}
+
+// MaskingPolicy returns the column masking policy of the executor, if any.
+func (e *Executor) MaskingPolicy() *masking.Policy {
+	return e.maskingPolicy
+}
+
 // ParseDestinationTarget parses destination target string and sets default keyspace if possible.
 func (e *Executor) ParseDestinationTarget(targetString string) (string, topodatapb.TabletType, key.Destination, error) {
 	destKeyspace, destTabletType, dest, err := topoproto.ParseDestination(targetString, defaultTabletType)
@@ -1203,6 +1323,13 @@ func (e *Executor) getPlan(vcursor *vcursorImpl, sql string, comments sqlparser.
 		return nil, err
 	}
 	query := sql
+	if len(e.rewriters) > 0 {
+		stmt, err = e.rewriteQuery(vcursor, stmt)
+		if err != nil {
+			return nil, err
+		}
+		query = sqlparser.String(stmt)
+	}
 	statement := stmt
 	reservedVars := sqlparser.NewReservedVars("vtg", reserved)
 	bindVarNeeds := &sqlparser.BindVarNeeds{}
@@ -1224,14 +1351,31 @@ func (e *Executor) getPlan(vcursor *vcursorImpl, sql string, comments sqlparser.
 		query = sqlparser.String(statement)
 	}
 
+	if vcursor.safeSession.Tenant != "" {
+		scoped, err := scopeToTenant(vcursor, statement, bindVars)
+		if err != nil {
+			return nil, err
+		}
+		if scoped {
+			query = sqlparser.String(statement)
+			if !bindVarNeeds.NeedsSysVar(sysvars.Tenant.Name) {
+				bindVarNeeds.AddSysVar(sysvars.Tenant.Name)
+			}
+		}
+	}
+
 	if logStats != nil {
 		logStats.SQL = comments.Leading + query + comments.Trailing
 		logStats.BindVariables = bindVars
 	}
 
 	planKey := vcursor.planPrefixKey() + ":" + query
-	if plan, ok := e.plans.Get(planKey); ok {
-		return plan.(*engine.Plan), nil
+	if cached, ok := e.plans.Get(planKey); ok {
+		plan := cached.(*engine.Plan)
+		if logStats != nil {
+			logStats.Fingerprint = plan.Fingerprint
+		}
+		return plan, nil
 	}
 
 	plan, err := planbuilder.BuildFromStmt(query, statement, reservedVars, vcursor, bindVarNeeds, *enableOnlineDDL, *enableDirectDDL)
@@ -1239,6 +1383,16 @@ func (e *Executor) getPlan(vcursor *vcursorImpl, sql string, comments sqlparser.
 		return nil, err
 	}
 
+	plan.Fingerprint = sqlparser.Fingerprint(statement)
+	plan.Target = vcursor.safeSession.TargetString
+	plan.WorkloadName, plan.Priority, plan.HasPriority, err = sqlparser.WorkloadDirectives(statement)
+	if err != nil {
+		return nil, err
+	}
+	if logStats != nil {
+		logStats.Fingerprint = plan.Fingerprint
+	}
+
 	plan.Warnings = vcursor.warnings
 	vcursor.warnings = nil
 
@@ -1288,6 +1442,10 @@ func (e *Executor) ServeHTTP(response http.ResponseWriter, request *http.Request
 		returnAsJSON(response, e.VSchema())
 	case pathScatterStats:
 		e.WriteScatterStats(response)
+	case pathPlanDiff:
+		e.servePlanDiff(response, request)
+	case pathVSchemaPlanDiff:
+		e.serveVSchemaPlanDiff(response, request)
 	default:
 		response.WriteHeader(http.StatusNotFound)
 	}
@@ -1470,9 +1628,41 @@ func (e *Executor) handlePrepare(ctx context.Context, safeSession *SafeSession,
 
 	plan.AddStats(1, time.Since(logStats.StartTime), logStats.ShardQueries, qr.RowsAffected, uint64(len(qr.Rows)), errCount)
 
+	setParamTypes(vcursor, query, bindVars)
 	return qr.Fields, err
 }
 
+// setParamTypes sets the types the semantic analysis infers for the
+// parameters of a prepared statement on their bind variables, so that they
+// are reported to the client. The parameters whose type can not be inferred
+// are left untyped.
+func setParamTypes(vcursor *vcursorImpl, query string, bindVars map[string]*querypb.BindVariable) {
+	stmt, err := sqlparser.Parse(query)
+	if err != nil {
+		return
+	}
+	sel, ok := stmt.(sqlparser.SelectStatement)
+	if !ok {
+		return
+	}
+	ksName := ""
+	if ks, _ := vcursor.DefaultKeyspace(); ks != nil {
+		ksName = ks.Name
+	}
+	semTable, err := semantics.Analyze(sel, ksName, vcursor, semantics.NoRewrite)
+	if err != nil {
+		return
+	}
+	for name, bv := range bindVars {
+		if bv.Type != sqltypes.Null || len(bv.Value) != 0 || len(bv.Values) != 0 {
+			continue
+		}
+		if typ := semTable.TypeFor(sqlparser.Argument(name)); typ != nil {
+			bv.Type = *typ
+		}
+	}
+}
+
 // ExecuteMultiShard implements the IExecutor interface
 func (e *Executor) ExecuteMultiShard(ctx context.Context, rss []*srvtopo.ResolvedShard, queries []*querypb.BoundQuery, session *SafeSession, autocommit bool, ignoreMaxMemoryRows bool) (qr *sqltypes.Result, errs []error) {
 	return e.scatterConn.ExecuteMultiShard(ctx, rss, queries, session, autocommit, ignoreMaxMemoryRows)
@@ -1489,10 +1679,28 @@ func (e *Executor) ExecuteLock(ctx context.Context, rs *srvtopo.ResolvedShard, q
 }
 
 // ExecuteMessageStream implements the IExecutor interface
-func (e *Executor) ExecuteMessageStream(ctx context.Context, rss []*srvtopo.ResolvedShard, tableName string, callback func(reply *sqltypes.Result) error) error {
+func (e *Executor) ExecuteMessageStream(ctx context.Context, rss []*srvtopo.ResolvedShard, tableName, consumerGroup string, callback func(reply *sqltypes.Result) error) error {
+	if consumerGroup != "" {
+		return e.messageGroups.Join(ctx, rss, tableName, consumerGroup, callback)
+	}
 	return e.scatterConn.MessageStream(ctx, rss, tableName, callback)
 }
 
+// CheckThrottler implements the IExecutor interface
+func (e *Executor) CheckThrottler(ctx context.Context, rss []*srvtopo.ResolvedShard, appName string) bool {
+	return e.throttlerChecker.Check(ctx, rss, appName)
+}
+
+// SaveDDLStatus implements the IExecutor interface
+func (e *Executor) SaveDDLStatus(status *topodatapb.DDLStatus) {
+	e.ddlStatuses.Save(status)
+}
+
+// GetDDLStatuses implements the IExecutor interface
+func (e *Executor) GetDDLStatuses(ctx context.Context, uuid string) ([]*topodatapb.DDLStatus, error) {
+	return e.ddlStatuses.Get(ctx, uuid)
+}
+
 // ExecuteVStream implements the IExecutor interface
 func (e *Executor) ExecuteVStream(ctx context.Context, rss []*srvtopo.ResolvedShard, filter *binlogdatapb.Filter, gtid string, callback func(evs []*binlogdatapb.VEvent) error) error {
 	return e.startVStream(ctx, rss, filter, gtid, callback)
@@ -1784,3 +1784,39 @@ func TestReservedConnDML(t *testing.T) {
 	_, err = executor.Execute(ctx, "TestReservedConnDML", session, "commit", nil)
 	require.NoError(t, err)
 }
+
+func TestChunkedDelete(t *testing.T) {
+	executor, _, _, sbclookup := createExecutorEnv()
+	// Each chunk commits on its own in autocommit mode.
+	primarySession.Reset()
+	primarySession.Autocommit = true
+	defer func() {
+		primarySession.Autocommit = false
+	}()
+
+	sql := "delete /*vt+ CHUNKED_DML */ from main1 where id < 100 limit 10"
+	sbclookup.SetResults([]*sqltypes.Result{{RowsAffected: 10}, {RowsAffected: 10}, {RowsAffected: 3}})
+	result, err := executorExec(executor, sql, nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 23, result.RowsAffected)
+	require.Len(t, sbclookup.Queries, 3)
+	assert.Equal(t, "delete /*vt+ CHUNKED_DML */ from main1 where id < 100 limit 10", sbclookup.Queries[2].Sql)
+
+	sbclookup.Queries = nil
+	sbclookup.SetResults([]*sqltypes.Result{{RowsAffected: 10}, {RowsAffected: 4}})
+	result, err = executorStream(executor, sql)
+	require.NoError(t, err)
+	utils.MustMatch(t, &sqltypes.Result{
+		Fields: chunkedDMLFields,
+		Rows: [][]sqltypes.Value{
+			{sqltypes.NewUint64(1), sqltypes.NewUint64(10), sqltypes.NewUint64(10)},
+			{sqltypes.NewUint64(2), sqltypes.NewUint64(4), sqltypes.NewUint64(14)},
+		},
+	}, result)
+	require.Len(t, sbclookup.Queries, 2)
+
+	_, err = executorExec(executor, "delete /*vt+ CHUNKED_DML */ from main1 where id < 100", nil)
+	assert.EqualError(t, err, "CHUNKED_DML requires a DELETE with a LIMIT")
+	_, err = executorExec(executor, "update /*vt+ CHUNKED_DML */ main1 set name = 'a' limit 10", nil)
+	assert.EqualError(t, err, "CHUNKED_DML without a chunk size is only supported for DELETE")
+}
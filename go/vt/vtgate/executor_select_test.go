@@ -37,6 +37,7 @@ import (
 
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/discovery"
+	"vitess.io/vitess/go/vt/sqlparser"
 	_ "vitess.io/vitess/go/vt/vtgate/vindexes"
 	"vitess.io/vitess/go/vt/vttablet/sandboxconn"
 
@@ -1928,6 +1929,28 @@ func TestJoinComments(t *testing.T) {
 	testQueryLog(t, logChan, "TestExecute", "SELECT", sql, 2)
 }
 
+func TestJoinFingerprint(t *testing.T) {
+	executor, sbc1, sbc2, _ := createLegacyExecutorEnv()
+
+	// The queries of the join carry the fingerprint of the query vtgate
+	// executes, not the ones of their own.
+	sql := "select u1.id, u2.id from user u1 join user u2 where u1.id = 1 and u2.id = 3"
+	want := sqlparser.FingerprintQuery(sql)
+	_, err := executorExec(executor, sql, nil)
+	require.NoError(t, err)
+	require.Len(t, sbc1.Options, 1)
+	assert.Equal(t, want, sbc1.Options[0].Fingerprint)
+	require.Len(t, sbc2.Options, 1)
+	assert.Equal(t, want, sbc2.Options[0].Fingerprint)
+
+	_, err = executorStream(executor, sql)
+	require.NoError(t, err)
+	require.Len(t, sbc1.Options, 2)
+	assert.Equal(t, want, sbc1.Options[1].Fingerprint)
+	require.Len(t, sbc2.Options, 2)
+	assert.Equal(t, want, sbc2.Options[1].Fingerprint)
+}
+
 func TestSimpleJoinStream(t *testing.T) {
 	executor, sbc1, sbc2, _ := createLegacyExecutorEnv()
 	logChan := QueryLogger.Subscribe("Test")
@@ -2473,6 +2496,45 @@ func TestSelectDatabasePrepare(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestSelectPrepareFieldCache(t *testing.T) {
+	executor, sbc1, sbc2, _ := createLegacyExecutorEnv()
+	*enableFieldCache = true
+	defer func() { *enableFieldCache = false }()
+
+	// The second prepare is served from the fields cached on the plan, until
+	// a DDL changes the schema version.
+	sql := "select id from user where textcol = 'a'"
+	for i := 0; i < 2; i++ {
+		_, err := executorPrepare(executor, sql, map[string]*querypb.BindVariable{})
+		require.NoError(t, err)
+		executor.plans.Wait()
+	}
+	assert.EqualValues(t, 1, sbc1.ExecCount.Get()+sbc2.ExecCount.Get())
+
+	_, err := executorExec(executor, "alter table user add column a int", nil)
+	require.NoError(t, err)
+	sbc1.ExecCount.Set(0)
+	sbc2.ExecCount.Set(0)
+	_, err = executorPrepare(executor, sql, map[string]*querypb.BindVariable{})
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, sbc1.ExecCount.Get()+sbc2.ExecCount.Get())
+}
+
+func TestSelectPrepareParamTypes(t *testing.T) {
+	executor, _, _, _ := createLegacyExecutorEnv()
+
+	// The parameters are typed like the columns they are compared to, and
+	// the others are left untyped.
+	sql := "select id from user where textcol = :v1 and :v2 = textcol and id = :v3 limit :v4"
+	bindVars := map[string]*querypb.BindVariable{"v1": {}, "v2": {}, "v3": {}, "v4": {}}
+	_, err := executorPrepare(executor, sql, bindVars)
+	require.NoError(t, err)
+	assert.Equal(t, sqltypes.VarChar, bindVars["v1"].Type)
+	assert.Equal(t, sqltypes.VarChar, bindVars["v2"].Type)
+	assert.Equal(t, sqltypes.Null, bindVars["v3"].Type)
+	assert.Equal(t, sqltypes.Int64, bindVars["v4"].Type)
+}
+
 func TestSelectWithUnionAll(t *testing.T) {
 	executor, sbc1, sbc2, _ := createLegacyExecutorEnv()
 	executor.normalize = true
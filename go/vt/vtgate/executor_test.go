@@ -1988,6 +1988,34 @@ func TestExecutorExplain(t *testing.T) {
 		fmt.Sprintf("%v", result.Rows), fmt.Sprintf("%v", result.Rows))
 }
 
+func TestExecutorVExplain(t *testing.T) {
+	executor, sbc1, _, _ := createLegacyExecutorEnv()
+	bindVars := map[string]*querypb.BindVariable{}
+
+	result, err := executorExec(executor, "vexplain all select id from user", bindVars)
+	require.NoError(t, err)
+	require.Len(t, result.Rows, 9)
+	assert.Equal(t, "Route", result.Rows[0][0].ToString())
+	assert.Equal(t, "├─ Shard", result.Rows[1][0].ToString())
+	assert.Equal(t, "-20", result.Rows[1][3].ToString())
+	assert.Equal(t, "└─ Shard", result.Rows[8][0].ToString())
+	assert.Equal(t, "e0-", result.Rows[8][3].ToString())
+
+	result, err = executorExec(executor, "vexplain all format = json select id from user", bindVars)
+	require.NoError(t, err)
+	require.Len(t, result.Rows, 1)
+	assert.Contains(t, result.Rows[0][0].ToString(), `"OperatorType": "Route"`)
+
+	// VEXPLAIN PLAN only plans the query.
+	sbc1.Queries = nil
+	result, err = executorExec(executor, "vexplain plan select id from user", bindVars)
+	require.NoError(t, err)
+	require.Len(t, result.Rows, 1)
+	assert.Equal(t, "Route", result.Rows[0][0].ToString())
+	assert.Equal(t, "select id from `user`", result.Rows[0][3].ToString())
+	assert.Empty(t, sbc1.Queries)
+}
+
 func TestExecutorOtherAdmin(t *testing.T) {
 	executor, sbc1, sbc2, sbclookup := createLegacyExecutorEnv()
 
@@ -177,11 +177,26 @@ func (conn *FakeVTGateConn) Prepare(ctx context.Context, session *vtgatepb.Sessi
 	return s, reply.Fields, nil
 }
 
+// StreamExecuteBatch please see vtgateconn.Impl.StreamExecuteBatch
+func (conn *FakeVTGateConn) StreamExecuteBatch(ctx context.Context, session *vtgatepb.Session, queries []*vtgatepb.BatchQuery, abortOnError bool) (vtgateconn.BatchResultStream, error) {
+	panic("not implemented")
+}
+
 // CloseSession please see vtgateconn.Impl.CloseSession
 func (conn *FakeVTGateConn) CloseSession(ctx context.Context, session *vtgatepb.Session) error {
 	panic("not implemented")
 }
 
+// ExportSession please see vtgateconn.Impl.ExportSession
+func (conn *FakeVTGateConn) ExportSession(ctx context.Context, session *vtgatepb.Session) (string, error) {
+	panic("not implemented")
+}
+
+// ImportSession please see vtgateconn.Impl.ImportSession
+func (conn *FakeVTGateConn) ImportSession(ctx context.Context, token string) (*vtgatepb.Session, error) {
+	panic("not implemented")
+}
+
 // ResolveTransaction please see vtgateconn.Impl.ResolveTransaction
 func (conn *FakeVTGateConn) ResolveTransaction(ctx context.Context, dtid string) error {
 	return nil
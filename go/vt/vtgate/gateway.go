@@ -71,6 +71,18 @@ type Gateway interface {
 
 	// TabletByAlias returns a QueryService
 	QueryServiceByAlias(alias *topodatapb.TabletAlias, target *querypb.Target) (queryservice.QueryService, error)
+
+	// PrimaryTablet returns a healthy primary tablet of the shard, or nil
+	// if there is none.
+	PrimaryTablet(keyspace, shard string) *topodatapb.Tablet
+
+	// SetTabletRoutingRules sets the tags of the tablets to prefer, by
+	// keyspace, as found in the VSchema.
+	SetTabletRoutingRules(rules map[string]map[string]string)
+
+	// CircuitBreakerStatus returns the state of the circuit breakers of the
+	// tablets.
+	CircuitBreakerStatus() CircuitBreakerStatusList
 }
 
 // Creator is the factory method which can create the actual gateway object.
@@ -163,6 +163,39 @@ func (conn *vtgateConn) StreamExecute(ctx context.Context, session *vtgatepb.Ses
 	}, nil
 }
 
+type streamExecuteBatchAdapter struct {
+	stream vtgateservicepb.Vitess_StreamExecuteBatchClient
+}
+
+func (a *streamExecuteBatchAdapter) Recv() (*vtgateconn.BatchQueryResult, error) {
+	r, err := a.stream.Recv()
+	if err != nil {
+		return nil, vterrors.FromGRPC(err)
+	}
+	return &vtgateconn.BatchQueryResult{
+		Index:   int(r.Index),
+		Session: r.Session,
+		Result:  sqltypes.Proto3ToResult(r.Result),
+		Err:     vterrors.FromVTRPC(r.Error),
+	}, nil
+}
+
+func (conn *vtgateConn) StreamExecuteBatch(ctx context.Context, session *vtgatepb.Session, queries []*vtgatepb.BatchQuery, abortOnError bool) (vtgateconn.BatchResultStream, error) {
+	req := &vtgatepb.StreamExecuteBatchRequest{
+		CallerId:     callerid.EffectiveCallerIDFromContext(ctx),
+		Session:      session,
+		Queries:      queries,
+		AbortOnError: abortOnError,
+	}
+	stream, err := conn.c.StreamExecuteBatch(ctx, req)
+	if err != nil {
+		return nil, vterrors.FromGRPC(err)
+	}
+	return &streamExecuteBatchAdapter{
+		stream: stream,
+	}, nil
+}
+
 func (conn *vtgateConn) Prepare(ctx context.Context, session *vtgatepb.Session, query string, bindVars map[string]*querypb.BindVariable) (*vtgatepb.Session, []*querypb.Field, error) {
 	request := &vtgatepb.PrepareRequest{
 		CallerId: callerid.EffectiveCallerIDFromContext(ctx),
@@ -197,6 +230,30 @@ func (conn *vtgateConn) CloseSession(ctx context.Context, session *vtgatepb.Sess
 	return nil
 }
 
+func (conn *vtgateConn) ExportSession(ctx context.Context, session *vtgatepb.Session) (string, error) {
+	request := &vtgatepb.ExportSessionRequest{
+		CallerId: callerid.EffectiveCallerIDFromContext(ctx),
+		Session:  session,
+	}
+	response, err := conn.c.ExportSession(ctx, request)
+	if err != nil {
+		return "", vterrors.FromGRPC(err)
+	}
+	return response.Token, nil
+}
+
+func (conn *vtgateConn) ImportSession(ctx context.Context, token string) (*vtgatepb.Session, error) {
+	request := &vtgatepb.ImportSessionRequest{
+		CallerId: callerid.EffectiveCallerIDFromContext(ctx),
+		Token:    token,
+	}
+	response, err := conn.c.ImportSession(ctx, request)
+	if err != nil {
+		return nil, vterrors.FromGRPC(err)
+	}
+	return response.Session, nil
+}
+
 func (conn *vtgateConn) ResolveTransaction(ctx context.Context, dtid string) error {
 	request := &vtgatepb.ResolveTransactionRequest{
 		CallerId: callerid.EffectiveCallerIDFromContext(ctx),
@@ -229,11 +229,26 @@ func (f *fakeVTGateService) Prepare(ctx context.Context, session *vtgatepb.Sessi
 	return session, execCase.result.Fields, nil
 }
 
+// StreamExecuteBatch is part of the VTGateService interface
+func (f *fakeVTGateService) StreamExecuteBatch(ctx context.Context, session *vtgatepb.Session, queries []*vtgatepb.BatchQuery, abortOnError bool, callback func(int, *vtgatepb.Session, *sqltypes.Result, error) error) error {
+	panic("unimplemented")
+}
+
 // CloseSession is part of the VTGateService interface
 func (f *fakeVTGateService) CloseSession(ctx context.Context, session *vtgatepb.Session) error {
 	panic("unimplemented")
 }
 
+// ExportSession is part of the VTGateService interface
+func (f *fakeVTGateService) ExportSession(ctx context.Context, session *vtgatepb.Session) (string, error) {
+	panic("unimplemented")
+}
+
+// ImportSession is part of the VTGateService interface
+func (f *fakeVTGateService) ImportSession(ctx context.Context, token string) (*vtgatepb.Session, error) {
+	panic("unimplemented")
+}
+
 // ResolveTransaction is part of the VTGateService interface
 func (f *fakeVTGateService) ResolveTransaction(ctx context.Context, dtid string) error {
 	if f.hasError {
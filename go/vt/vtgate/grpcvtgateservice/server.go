@@ -179,6 +179,28 @@ func (vtg *VTGate) StreamExecute(request *vtgatepb.StreamExecuteRequest, stream
 	return vterrors.ToGRPC(vtgErr)
 }
 
+// StreamExecuteBatch is the RPC version of vtgateservice.VTGateService method
+func (vtg *VTGate) StreamExecuteBatch(request *vtgatepb.StreamExecuteBatchRequest, stream vtgateservicepb.Vitess_StreamExecuteBatchServer) (err error) {
+	defer vtg.server.HandlePanic(&err)
+	ctx := withCallerIDContext(stream.Context(), request.CallerId)
+
+	session := request.Session
+	if session == nil {
+		session = &vtgatepb.Session{Autocommit: true}
+	}
+	vtgErr := vtg.server.StreamExecuteBatch(ctx, session, request.Queries, request.AbortOnError, func(index int, session *vtgatepb.Session, qr *sqltypes.Result, err error) error {
+		// Send is not safe to call concurrently, but vtgate
+		// guarantees that it's not.
+		return stream.Send(&vtgatepb.StreamExecuteBatchResponse{
+			Index:   int64(index),
+			Result:  sqltypes.ResultToProto3(qr),
+			Error:   vterrors.ToVTRPC(err),
+			Session: session,
+		})
+	})
+	return vterrors.ToGRPC(vtgErr)
+}
+
 // Prepare is the RPC version of vtgateservice.VTGateService method
 func (vtg *VTGate) Prepare(ctx context.Context, request *vtgatepb.PrepareRequest) (response *vtgatepb.PrepareResponse, err error) {
 	defer vtg.server.HandlePanic(&err)
@@ -212,6 +234,38 @@ func (vtg *VTGate) CloseSession(ctx context.Context, request *vtgatepb.CloseSess
 	}, nil
 }
 
+// ExportSession is the RPC version of vtgateservice.VTGateService method
+func (vtg *VTGate) ExportSession(ctx context.Context, request *vtgatepb.ExportSessionRequest) (response *vtgatepb.ExportSessionResponse, err error) {
+	defer vtg.server.HandlePanic(&err)
+	ctx = withCallerIDContext(ctx, request.CallerId)
+
+	session := request.Session
+	if session == nil {
+		session = &vtgatepb.Session{Autocommit: true}
+	}
+	token, vtgErr := vtg.server.ExportSession(ctx, session)
+	if vtgErr != nil {
+		return nil, vterrors.ToGRPC(vtgErr)
+	}
+	return &vtgatepb.ExportSessionResponse{
+		Token: token,
+	}, nil
+}
+
+// ImportSession is the RPC version of vtgateservice.VTGateService method
+func (vtg *VTGate) ImportSession(ctx context.Context, request *vtgatepb.ImportSessionRequest) (response *vtgatepb.ImportSessionResponse, err error) {
+	defer vtg.server.HandlePanic(&err)
+	ctx = withCallerIDContext(ctx, request.CallerId)
+
+	session, vtgErr := vtg.server.ImportSession(ctx, request.Token)
+	if vtgErr != nil {
+		return nil, vterrors.ToGRPC(vtgErr)
+	}
+	return &vtgatepb.ImportSessionResponse{
+		Session: session,
+	}, nil
+}
+
 // ResolveTransaction is the RPC version of vtgateservice.VTGateService method
 func (vtg *VTGate) ResolveTransaction(ctx context.Context, request *vtgatepb.ResolveTransactionRequest) (response *vtgatepb.ResolveTransactionResponse, err error) {
 	defer vtg.server.HandlePanic(&err)
@@ -0,0 +1,210 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"flag"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/discovery"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vttablet/queryservice"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+var (
+	hedgePercentile  = flag.Float64("gateway_hedge_percentile", 0, "if greater than 0, the read queries to replicas that have not returned after this percentile of the latencies of their shard over the last minute, e.g. 95, are also sent to another replica, and the first response is used. 0 disables hedging")
+	hedgeMinDelay    = flag.Duration("gateway_hedge_min_delay", 10*time.Millisecond, "minimum delay before a read query is hedged, also used when its shard had no query over the last minute")
+	hedgeBudgetRatio = flag.Float64("gateway_hedge_budget", 0.05, "fraction of the read queries that may be hedged, to bound the extra load on the replicas")
+
+	tabletGatewayHedgedQueries = stats.NewCountersWithMultiLabels(
+		"TabletGatewayHedgedQueries",
+		"Number of read queries the tablet gateway also sent to another replica, by the attempt whose response was used: original, hedge, or none when both failed",
+		[]string{"Keyspace", "ShardName", "TabletType", "Winner"})
+	tabletGatewayHedgesOverBudget = stats.NewCountersWithMultiLabels(
+		"TabletGatewayHedgesOverBudget",
+		"Number of slow read queries the tablet gateway did not hedge because the hedging budget was spent",
+		[]string{"Keyspace", "ShardName", "TabletType"})
+)
+
+// hedgeBudgetBurst is the number of hedges that the budget can save up.
+const hedgeBudgetBurst = 10
+
+// hedgeBudget bounds the hedged queries to -gateway_hedge_budget of the
+// queries that could be hedged. Each of those adds that fraction of a token
+// to the budget, and each hedge spends a token.
+type hedgeBudget struct {
+	mu     sync.Mutex
+	tokens float64
+}
+
+func (hb *hedgeBudget) deposit() {
+	hb.mu.Lock()
+	defer hb.mu.Unlock()
+	hb.tokens += *hedgeBudgetRatio
+	if hb.tokens > hedgeBudgetBurst {
+		hb.tokens = hedgeBudgetBurst
+	}
+}
+
+func (hb *hedgeBudget) withdraw() bool {
+	hb.mu.Lock()
+	defer hb.mu.Unlock()
+	if hb.tokens < 1 {
+		return false
+	}
+	hb.tokens--
+	return true
+}
+
+// hedgedConnFor returns the connection over which to send a query to the
+// tablet th, whose circuit breaker is cb, which also sends it to another
+// tablet if it is slow, or nil if the query may not be hedged. The returned
+// connection records the outcome of the query on both circuit breakers.
+//
+// Only the Execute calls outside of transactions and reserved connections
+// to replicas are hedged: inTransaction is also set for the reserved
+// connections, and the connection checks their IDs too. The other tablet is
+// the next one of tablets, in order of preference, that was not tried yet
+// and whose circuit breaker is closed.
+func (gw *TabletGateway) hedgedConnFor(target *querypb.Target, name string, inTransaction bool, th *discovery.TabletHealth, cb *circuitBreaker, tablets []*discovery.TabletHealth, invalidTablets map[string]bool) queryservice.QueryService {
+	if *hedgePercentile <= 0 || name != "Execute" || inTransaction || target.TabletType == topodatapb.TabletType_PRIMARY {
+		return nil
+	}
+	gw.hedgeBudget.deposit()
+
+	for _, t := range tablets {
+		alias := topoproto.TabletAliasString(t.Tablet.Alias)
+		if t == th || t.Conn == nil || invalidTablets[alias] {
+			continue
+		}
+		hedgeCB := gw.circuitBreakerFor(target, t.Tablet)
+		if !hedgeCB.closed() {
+			continue
+		}
+		return &hedgedConn{
+			QueryService: th.Conn,
+			gw:           gw,
+			originalCB:   cb,
+			hedge:        t,
+			hedgeCB:      hedgeCB,
+			delay:        gw.hedgeDelay(target),
+		}
+	}
+	return nil
+}
+
+// hedgeDelay returns how long to wait for a query to the target before
+// hedging it.
+func (gw *TabletGateway) hedgeDelay(target *querypb.Target) time.Duration {
+	delay, ok := gw.shardHealthTracker.latencyPercentile(target, time.Now(), *hedgePercentile/100)
+	if !ok || delay < *hedgeMinDelay {
+		return *hedgeMinDelay
+	}
+	return delay
+}
+
+// hedgedConn sends the Execute calls to the original tablet and, if they have
+// not returned after delay and the budget allows it, to the hedge tablet too.
+// It returns the first successful response.
+type hedgedConn struct {
+	queryservice.QueryService
+
+	gw         *TabletGateway
+	originalCB *circuitBreaker
+	hedge      *discovery.TabletHealth
+	hedgeCB    *circuitBreaker
+	delay      time.Duration
+}
+
+type hedgedResponse struct {
+	qr    *sqltypes.Result
+	err   error
+	hedge bool
+}
+
+// Execute is part of the queryservice.QueryService interface.
+func (hc *hedgedConn) Execute(ctx context.Context, target *querypb.Target, query string, bindVars map[string]*querypb.BindVariable, transactionID, reservedID int64, options *querypb.ExecuteOptions) (*sqltypes.Result, error) {
+	if transactionID != 0 || reservedID != 0 {
+		// The connection only exists on the original tablet.
+		qr, err := hc.QueryService.Execute(ctx, target, query, bindVars, transactionID, reservedID, options)
+		hc.originalCB.record(time.Now(), err)
+		return qr, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	// Cancel the attempt whose response is not used.
+	defer cancel()
+	responses := make(chan hedgedResponse, 2)
+	go func() {
+		qr, err := hc.QueryService.Execute(ctx, target, query, bindVars, transactionID, reservedID, options)
+		// The original query is canceled if the hedge answers first, which
+		// the breaker ignores.
+		hc.originalCB.record(time.Now(), err)
+		responses <- hedgedResponse{qr: qr, err: err}
+	}()
+
+	timer := time.NewTimer(hc.delay)
+	defer timer.Stop()
+	select {
+	case r := <-responses:
+		return r.qr, r.err
+	case <-timer.C:
+	}
+
+	labels := []string{target.Keyspace, target.Shard, topoproto.TabletTypeLString(target.TabletType)}
+	if !hc.gw.hedgeBudget.withdraw() {
+		tabletGatewayHedgesOverBudget.Add(labels, 1)
+		r := <-responses
+		return r.qr, r.err
+	}
+
+	go func() {
+		done := hc.gw.inflight.start(target, hc.hedge)
+		qr, err := hc.hedge.Conn.Execute(ctx, target, query, bindVars, transactionID, reservedID, options)
+		done()
+		if ctx.Err() == nil {
+			// The breaker only learns from the hedges that were not canceled
+			// because the original tablet answered first.
+			hc.hedgeCB.record(time.Now(), err)
+		}
+		responses <- hedgedResponse{qr: qr, err: err, hedge: true}
+	}()
+
+	r := <-responses
+	if r.err != nil {
+		// The other attempt may still succeed.
+		if other := <-responses; other.err == nil {
+			r = other
+		}
+	}
+	winner := "original"
+	switch {
+	case r.err != nil:
+		winner = "none"
+	case r.hedge:
+		winner = "hedge"
+	}
+	tabletGatewayHedgedQueries.Add(append(labels, winner), 1)
+	return r.qr, r.err
+}
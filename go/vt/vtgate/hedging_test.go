@@ -0,0 +1,234 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/discovery"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/queryservice"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// slowConn delays the Execute calls, or fails them if they are canceled.
+type slowConn struct {
+	queryservice.QueryService
+	delay    time.Duration
+	canceled chan bool
+}
+
+func (sc *slowConn) Execute(ctx context.Context, target *querypb.Target, query string, bindVars map[string]*querypb.BindVariable, transactionID, reservedID int64, options *querypb.ExecuteOptions) (*sqltypes.Result, error) {
+	select {
+	case <-time.After(sc.delay):
+	case <-ctx.Done():
+		sc.canceled <- true
+		return nil, vterrors.Errorf(vtrpcpb.Code_CANCELED, "canceled")
+	}
+	return sc.QueryService.Execute(ctx, target, query, bindVars, transactionID, reservedID, options)
+}
+
+func TestHedgeBudget(t *testing.T) {
+	defer func(ratio float64) { *hedgeBudgetRatio = ratio }(*hedgeBudgetRatio)
+	*hedgeBudgetRatio = 0.5
+
+	hb := &hedgeBudget{}
+	assert.False(t, hb.withdraw())
+	hb.deposit()
+	assert.False(t, hb.withdraw())
+	hb.deposit()
+	assert.True(t, hb.withdraw())
+	assert.False(t, hb.withdraw())
+
+	// The budget saves up to hedgeBudgetBurst hedges.
+	for i := 0; i < 100; i++ {
+		hb.deposit()
+	}
+	for i := 0; i < hedgeBudgetBurst; i++ {
+		assert.True(t, hb.withdraw())
+	}
+	assert.False(t, hb.withdraw())
+}
+
+func TestHedgedConnFor(t *testing.T) {
+	defer func(percentile float64) { *hedgePercentile = percentile }(*hedgePercentile)
+
+	target := &querypb.Target{Keyspace: "ks", Shard: "0", TabletType: topodatapb.TabletType_REPLICA}
+	hc := discovery.NewFakeHealthCheck()
+	tg := NewTabletGateway(context.Background(), hc, nil, "cell")
+	hc.AddTestTablet("cell", "1.1.1.1", 1001, "ks", "0", topodatapb.TabletType_REPLICA, true, 10, nil)
+	hc.AddTestTablet("cell", "1.1.1.2", 1001, "ks", "0", topodatapb.TabletType_REPLICA, true, 10, nil)
+	tablets := hc.GetHealthyTabletStats(target)
+	require.Len(t, tablets, 2)
+
+	// Hedging is disabled by default.
+	assert.Nil(t, tg.hedgedConnFor(target, "Execute", false, tablets[0], nil, tablets, nil))
+
+	*hedgePercentile = 95
+	hedged, ok := tg.hedgedConnFor(target, "Execute", false, tablets[0], nil, tablets, nil).(*hedgedConn)
+	require.True(t, ok)
+	assert.Equal(t, tablets[1], hedged.hedge)
+	// Without recent queries, the delay is the minimum one.
+	assert.Equal(t, *hedgeMinDelay, hedged.delay)
+
+	// The writes, the transactions, the other calls and the tablets that
+	// were tried already are not hedged.
+	primary := &querypb.Target{Keyspace: "ks", Shard: "0", TabletType: topodatapb.TabletType_PRIMARY}
+	assert.Nil(t, tg.hedgedConnFor(primary, "Execute", false, tablets[0], nil, tablets, nil))
+	assert.Nil(t, tg.hedgedConnFor(target, "Execute", true, tablets[0], nil, tablets, nil))
+	assert.Nil(t, tg.hedgedConnFor(target, "StreamExecute", false, tablets[0], nil, tablets, nil))
+	assert.Nil(t, tg.hedgedConnFor(target, "Execute", false, tablets[0], nil, tablets, map[string]bool{topoproto.TabletAliasString(tablets[1].Tablet.Alias): true}))
+	assert.Nil(t, tg.hedgedConnFor(target, "Execute", false, tablets[0], nil, tablets[:1], nil))
+}
+
+func TestHedgeDelay(t *testing.T) {
+	defer func(percentile float64) { *hedgePercentile = percentile }(*hedgePercentile)
+	*hedgePercentile = 50
+
+	target := &querypb.Target{Keyspace: "ks", Shard: "0", TabletType: topodatapb.TabletType_REPLICA}
+	tg := NewTabletGateway(context.Background(), discovery.NewFakeHealthCheck(), nil, "cell")
+	for i := 0; i < 10; i++ {
+		tg.shardHealthTracker.record(target, time.Now(), 50*time.Millisecond, nil)
+	}
+	assert.Equal(t, 50*time.Millisecond, tg.hedgeDelay(target))
+
+	// The delay is at least -gateway_hedge_min_delay.
+	tg.shardHealthTracker = newShardHealthTracker()
+	tg.shardHealthTracker.record(target, time.Now(), time.Millisecond, nil)
+	assert.Equal(t, *hedgeMinDelay, tg.hedgeDelay(target))
+}
+
+func TestHedgedConnExecute(t *testing.T) {
+	setCircuitBreakerFlags(t, 3, 0, 2, time.Second)
+	tabletGatewayHedgedQueries.ResetAll()
+	tabletGatewayHedgesOverBudget.ResetAll()
+	target := &querypb.Target{Keyspace: "ks", Shard: "hedged", TabletType: topodatapb.TabletType_REPLICA}
+	hc := discovery.NewFakeHealthCheck()
+	tg := NewTabletGateway(context.Background(), hc, nil, "cell")
+	original := hc.AddTestTablet("cell", "1.1.1.1", 1001, "ks", "hedged", topodatapb.TabletType_REPLICA, true, 10, nil)
+	hedge := hc.AddTestTablet("cell", "1.1.1.2", 1001, "ks", "hedged", topodatapb.TabletType_REPLICA, true, 10, nil)
+	var originalTablet, hedgeTablet *discovery.TabletHealth
+	for _, th := range hc.GetHealthyTabletStats(target) {
+		switch th.Conn {
+		case original:
+			originalTablet = th
+		case hedge:
+			hedgeTablet = th
+		}
+	}
+	require.NotNil(t, originalTablet)
+	require.NotNil(t, hedgeTablet)
+	originalCB := tg.circuitBreakerFor(target, originalTablet.Tablet)
+	require.NotNil(t, originalCB)
+
+	slow := &slowConn{QueryService: original, delay: time.Minute, canceled: make(chan bool, 1)}
+	conn := &hedgedConn{QueryService: slow, gw: tg, originalCB: originalCB, hedge: hedgeTablet, delay: time.Millisecond}
+
+	// Without budget, the slow query is not hedged.
+	slow.delay = 20 * time.Millisecond
+	_, err := conn.Execute(context.Background(), target, "select 1", nil, 0, 0, nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, original.ExecCount.Get())
+	assert.EqualValues(t, 0, hedge.ExecCount.Get())
+	assert.EqualValues(t, 1, tabletGatewayHedgesOverBudget.Counts()["ks.hedged.replica"])
+
+	// The hedge answers first and the original query is canceled. The
+	// original tablet is probing after failures: its canceled query does not
+	// count as a successful probe.
+	originalCB.mu.Lock()
+	originalCB.state, originalCB.probing = circuitHalfOpen, true
+	originalCB.mu.Unlock()
+	slow.delay = time.Minute
+	tg.hedgeBudget.tokens = 1
+	_, err = conn.Execute(context.Background(), target, "select 1", nil, 0, 0, nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, hedge.ExecCount.Get())
+	assert.EqualValues(t, 1, tabletGatewayHedgedQueries.Counts()["ks.hedged.replica.hedge"])
+	select {
+	case <-slow.canceled:
+	case <-time.After(10 * time.Second):
+		t.Fatal("the original query was not canceled")
+	}
+	assert.Eventually(t, func() bool {
+		originalCB.mu.Lock()
+		defer originalCB.mu.Unlock()
+		return !originalCB.probing
+	}, 10*time.Second, time.Millisecond, "the canceled query was not recorded")
+	originalCB.mu.Lock()
+	assert.Equal(t, circuitHalfOpen, originalCB.state)
+	assert.Zero(t, originalCB.probes)
+	originalCB.state = circuitClosed
+	originalCB.mu.Unlock()
+
+	// The queries over reserved connections only go to the original tablet.
+	slow.delay = 20 * time.Millisecond
+	tg.hedgeBudget.tokens = 1
+	_, err = conn.Execute(context.Background(), target, "select 1", nil, 0, 1, nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, original.ExecCount.Get())
+	assert.EqualValues(t, 1, hedge.ExecCount.Get())
+	assert.EqualValues(t, 1, tg.hedgeBudget.tokens)
+
+	// The original query is used when the hedge fails.
+	slow.delay = 20 * time.Millisecond
+	tg.hedgeBudget.tokens = 1
+	hedge.MustFailCodes[vtrpcpb.Code_UNAVAILABLE] = 1
+	_, err = conn.Execute(context.Background(), target, "select 1", nil, 0, 0, nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, original.ExecCount.Get())
+	assert.EqualValues(t, 1, tabletGatewayHedgedQueries.Counts()["ks.hedged.replica.original"])
+
+	// When both fail, the error is returned.
+	tg.hedgeBudget.tokens = 1
+	original.MustFailCodes[vtrpcpb.Code_UNAVAILABLE] = 1
+	hedge.MustFailCodes[vtrpcpb.Code_UNAVAILABLE] = 1
+	_, err = conn.Execute(context.Background(), target, "select 1", nil, 0, 0, nil)
+	assert.Equal(t, vtrpcpb.Code_UNAVAILABLE, vterrors.Code(err))
+	assert.EqualValues(t, 1, tabletGatewayHedgedQueries.Counts()["ks.hedged.replica.none"])
+}
+
+func TestTabletGatewayHedging(t *testing.T) {
+	defer func(percentile float64) { *hedgePercentile = percentile }(*hedgePercentile)
+	*hedgePercentile = 95
+
+	target := &querypb.Target{Keyspace: "ks", Shard: "0", TabletType: topodatapb.TabletType_REPLICA}
+	hc := discovery.NewFakeHealthCheck()
+	tg := NewTabletGateway(context.Background(), hc, nil, "cell")
+	hc.AddTestTablet("cell", "1.1.1.1", 1001, "ks", "0", topodatapb.TabletType_REPLICA, true, 10, nil)
+	hc.AddTestTablet("cell", "1.1.1.2", 1001, "ks", "0", topodatapb.TabletType_REPLICA, true, 10, nil)
+	tablets := hc.GetHealthyTabletStats(target)
+	require.Len(t, tablets, 2)
+	// One of the replicas hangs: the query still returns from the other one,
+	// whichever the gateway picks first.
+	tablets[0].Conn = &slowConn{QueryService: tablets[0].Conn, delay: time.Minute, canceled: make(chan bool, 1)}
+	tg.hedgeBudget.tokens = 1
+
+	start := time.Now()
+	_, err := tg.Execute(context.Background(), target, "select 1", nil, 0, 0, nil)
+	require.NoError(t, err)
+	assert.Less(t, int64(time.Since(start)), int64(10*time.Second))
+}
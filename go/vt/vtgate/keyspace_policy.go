@@ -0,0 +1,100 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"flag"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/protoutil"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/callerid"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var keyspacePolicyExemptUsers = flag.String("keyspace_policy_exempt_users", "", "List of users allowed to skip the keyspace policies with set skip_keyspace_policy = 1, or '%' to allow all users.")
+
+// keyspacePolicyExempt returns true if the caller may skip the keyspace
+// policies.
+func keyspacePolicyExempt(caller *querypb.VTGateCallerID) bool {
+	if *keyspacePolicyExemptUsers == "%" {
+		return true
+	}
+	user := caller.GetUsername()
+	for _, exempt := range strings.Split(*keyspacePolicyExemptUsers, ",") {
+		if strings.TrimSpace(exempt) == user && user != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// KeyspacePolicy returns the policy of the keyspace, from its SrvKeyspace in
+// the cell of vtgate, or nil if it has none.
+func (e *Executor) KeyspacePolicy(ctx context.Context, keyspace string) *topodatapb.KeyspacePolicy {
+	if e.serv == nil || ignoreKeyspace(keyspace) {
+		return nil
+	}
+	srvKeyspace, err := e.serv.GetSrvKeyspace(ctx, e.cell, keyspace)
+	if err != nil {
+		return nil
+	}
+	return srvKeyspace.GetPolicy()
+}
+
+// planKeyspacePolicy returns the policy applying to the execution of a plan:
+// the one of the keyspace of the plan, or else of the session target.
+func (e *Executor) planKeyspacePolicy(plan *engine.Plan, vcursor *vcursorImpl) *topodatapb.KeyspacePolicy {
+	if vcursor.safeSession.GetOptions().GetSkipKeyspacePolicy() {
+		return nil
+	}
+	keyspace := plan.Instructions.GetKeyspaceName()
+	if _, ok := vcursor.vschema.Keyspaces[keyspace]; !ok {
+		keyspace = vcursor.keyspace
+	}
+	return e.KeyspacePolicy(vcursor.ctx, keyspace)
+}
+
+// checkKeyspacePolicyDenied returns an error if the policy denies the queries
+// of the fingerprint from the immediate caller of the context.
+func checkKeyspacePolicyDenied(ctx context.Context, policy *topodatapb.KeyspacePolicy, fingerprint string) error {
+	if len(policy.GetDeniedQueries()) == 0 {
+		return nil
+	}
+	user := callerid.GetUsername(callerid.ImmediateCallerIDFromContext(ctx))
+	if denied := topoproto.KeyspacePolicyDeniedQuery(policy, fingerprint, user, time.Now()); denied != nil {
+		return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "query denied by the keyspace policy until %v", protoutil.TimeFromProto(denied.ExpireTime).UTC().Format(time.RFC3339))
+	}
+	return nil
+}
+
+// checkKeyspacePolicyRows returns an error if the result has more rows than
+// the policy allows.
+func checkKeyspacePolicyRows(policy *topodatapb.KeyspacePolicy, qr *sqltypes.Result) error {
+	if policy.GetMaxRows() == 0 || qr == nil || uint64(len(qr.Rows)) <= policy.MaxRows {
+		return nil
+	}
+	return vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "row count exceeded the limit of %d rows of the keyspace policy", policy.MaxRows)
+}
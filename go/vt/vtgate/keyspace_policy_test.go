@@ -0,0 +1,116 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/protoutil"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/callerid"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+func TestExecutorKeyspacePolicy(t *testing.T) {
+	executor, _, _, sbclookup := createExecutorEnv()
+	sand := getSandbox(KsTestUnsharded)
+	sand.KeyspacePolicy = &topodatapb.KeyspacePolicy{MaxRows: 1, DefaultWorkload: "olap"}
+	defer func() { sand.KeyspacePolicy = nil }()
+
+	// use applies the default workload of the keyspace.
+	session := NewSafeSession(&vtgatepb.Session{Autocommit: true})
+	_, err := executor.Execute(ctx, "TestExecutorKeyspacePolicy", session, "use "+KsTestUnsharded, nil)
+	require.NoError(t, err)
+	assert.Equal(t, querypb.ExecuteOptions_OLAP, session.GetOptions().GetWorkload())
+
+	// The returned rows are limited.
+	session = NewSafeSession(&vtgatepb.Session{TargetString: KsTestUnsharded})
+	twoRows := sqltypes.MakeTestResult(sqltypes.MakeTestFields("id", "int64"), "1", "2")
+	sbclookup.SetResults([]*sqltypes.Result{twoRows})
+	_, err = executor.Execute(ctx, "TestExecutorKeyspacePolicy", session, "select id from music_user_map", nil)
+	require.Error(t, err)
+	assert.Equal(t, vtrpcpb.Code_RESOURCE_EXHAUSTED, vterrors.Code(err))
+
+	// Only the exempt users can skip the policies.
+	_, err = executor.Execute(ctx, "TestExecutorKeyspacePolicy", session, "set skip_keyspace_policy = 1", nil)
+	require.Error(t, err)
+	assert.Equal(t, vtrpcpb.Code_PERMISSION_DENIED, vterrors.Code(err))
+
+	defer func(users string) { *keyspacePolicyExemptUsers = users }(*keyspacePolicyExemptUsers)
+	*keyspacePolicyExemptUsers = "%"
+	_, err = executor.Execute(ctx, "TestExecutorKeyspacePolicy", session, "set skip_keyspace_policy = 1", nil)
+	require.NoError(t, err)
+	assert.True(t, session.GetOptions().GetSkipKeyspacePolicy())
+	executor.normalize = true
+	qr, err := executor.Execute(ctx, "TestExecutorKeyspacePolicy", session, "select @@skip_keyspace_policy", nil)
+	require.NoError(t, err)
+	assert.Equal(t, [][]sqltypes.Value{{sqltypes.NewInt64(1)}}, qr.Rows)
+	sbclookup.SetResults([]*sqltypes.Result{twoRows})
+	qr, err = executor.Execute(ctx, "TestExecutorKeyspacePolicy", session, "select id from music_user_map", nil)
+	require.NoError(t, err)
+	assert.Len(t, qr.Rows, 2)
+}
+
+func TestExecutorKeyspacePolicyDeniedQueries(t *testing.T) {
+	executor, _, _, sbclookup := createExecutorEnv()
+	sand := getSandbox(KsTestUnsharded)
+	sand.KeyspacePolicy = &topodatapb.KeyspacePolicy{
+		DeniedQueries: []*topodatapb.DeniedQuery{{
+			Fingerprint: sqlparser.FingerprintQuery("select id from music_user_map where id = 1"),
+			ExpireTime:  protoutil.TimeToProto(time.Now().Add(time.Hour)),
+		}, {
+			Fingerprint: sqlparser.FingerprintQuery("select id from music_user_map"),
+			User:        "batch",
+			ExpireTime:  protoutil.TimeToProto(time.Now().Add(time.Hour)),
+		}},
+	}
+	defer func() { sand.KeyspacePolicy = nil }()
+
+	session := NewSafeSession(&vtgatepb.Session{TargetString: KsTestUnsharded})
+	_, err := executor.Execute(ctx, "TestExecutorKeyspacePolicyDeniedQueries", session, "select id from music_user_map where id = 2", nil)
+	require.Error(t, err)
+	assert.Equal(t, vtrpcpb.Code_FAILED_PRECONDITION, vterrors.Code(err))
+	assert.EqualValues(t, 0, sbclookup.ExecCount.Get())
+
+	// The denial of a user only applies to its queries.
+	_, err = executor.Execute(ctx, "TestExecutorKeyspacePolicyDeniedQueries", session, "select id from music_user_map", nil)
+	require.NoError(t, err)
+	batchCtx := callerid.NewContext(ctx, nil, callerid.NewImmediateCallerID("batch"))
+	_, err = executor.Execute(batchCtx, "TestExecutorKeyspacePolicyDeniedQueries", session, "select id from music_user_map", nil)
+	require.Error(t, err)
+	assert.Equal(t, vtrpcpb.Code_FAILED_PRECONDITION, vterrors.Code(err))
+}
+
+func TestKeyspacePolicyExempt(t *testing.T) {
+	defer func(users string) { *keyspacePolicyExemptUsers = users }(*keyspacePolicyExemptUsers)
+	*keyspacePolicyExemptUsers = "admin, ops"
+	assert.True(t, keyspacePolicyExempt(&querypb.VTGateCallerID{Username: "ops"}))
+	assert.False(t, keyspacePolicyExempt(&querypb.VTGateCallerID{Username: "app"}))
+	assert.False(t, keyspacePolicyExempt(nil))
+	*keyspacePolicyExemptUsers = ""
+	assert.False(t, keyspacePolicyExempt(&querypb.VTGateCallerID{Username: ""}))
+}
@@ -25,7 +25,6 @@ import (
 
 	"context"
 
-	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/streamlog"
 	"vitess.io/vitess/go/tb"
 	"vitess.io/vitess/go/vt/callerid"
@@ -44,6 +43,7 @@ type LogStats struct {
 	Table         string
 	StmtType      string
 	SQL           string
+	Fingerprint   string
 	BindVariables map[string]*querypb.BindVariable
 	StartTime     time.Time
 	EndTime       time.Time
@@ -99,6 +99,16 @@ func (stats *LogStats) TotalTime() time.Duration {
 	return stats.EndTime.Sub(stats.StartTime)
 }
 
+// FilterFields is part of the streamlog.Filterable interface.
+func (stats *LogStats) FilterFields() streamlog.FilterFields {
+	return streamlog.FilterFields{
+		User:        stats.ImmediateCaller(),
+		Keyspace:    stats.Keyspace,
+		Fingerprint: stats.Fingerprint,
+		Duration:    stats.TotalTime(),
+	}
+}
+
 // ContextHTML returns the HTML version of the context that was used, or "".
 // This is a method on LogStats instead of a field so that it doesn't need
 // to be passed by value everywhere.
@@ -138,14 +148,18 @@ func (stats *LogStats) Logf(w io.Writer, params url.Values) error {
 		}
 	}()
 
-	formattedBindVars := "\"[REDACTED]\""
-	if !*streamlog.RedactDebugUIQueries {
-		_, fullBindParams := params["full"]
-		formattedBindVars = sqltypes.FormatBindVariables(
-			stats.BindVariables,
-			fullBindParams,
-			*streamlog.QueryLogFormat == streamlog.QueryLogFormatJSON,
-		)
+	_, fullBindParams := params["full"]
+	formattedBindVars := streamlog.FormatBindVariables(
+		stats.BindVariables,
+		fullBindParams,
+		*streamlog.QueryLogFormat == streamlog.QueryLogFormatJSON,
+	)
+
+	// The queries that were not normalized, e.g. all of them without
+	// -normalize_queries, still embed their literals.
+	sql := stats.SQL
+	if *streamlog.QueryLogRedactBindVars != "" {
+		sql = redactedSQL(sql)
 	}
 
 	// TODO: remove username here we fully enforce immediate caller id
@@ -154,9 +168,9 @@ func (stats *LogStats) Logf(w io.Writer, params url.Values) error {
 	var fmtString string
 	switch *streamlog.QueryLogFormat {
 	case streamlog.QueryLogFormatText:
-		fmtString = "%v\t%v\t%v\t'%v'\t'%v'\t%v\t%v\t%.6f\t%.6f\t%.6f\t%.6f\t%v\t%q\t%v\t%v\t%v\t%q\t%q\t%q\t%q\t\n"
+		fmtString = "%v\t%v\t%v\t'%v'\t'%v'\t%v\t%v\t%.6f\t%.6f\t%.6f\t%.6f\t%v\t%q\t%v\t%v\t%v\t%q\t%q\t%q\t%q\t%q\t\n"
 	case streamlog.QueryLogFormatJSON:
-		fmtString = "{\"Method\": %q, \"RemoteAddr\": %q, \"Username\": %q, \"ImmediateCaller\": %q, \"Effective Caller\": %q, \"Start\": \"%v\", \"End\": \"%v\", \"TotalTime\": %.6f, \"PlanTime\": %v, \"ExecuteTime\": %v, \"CommitTime\": %v, \"StmtType\": %q, \"SQL\": %q, \"BindVars\": %v, \"ShardQueries\": %v, \"RowsAffected\": %v, \"Error\": %q,  \"Keyspace\": %q, \"Table\": %q, \"TabletType\": %q}\n"
+		fmtString = "{\"Method\": %q, \"RemoteAddr\": %q, \"Username\": %q, \"ImmediateCaller\": %q, \"Effective Caller\": %q, \"Start\": \"%v\", \"End\": \"%v\", \"TotalTime\": %.6f, \"PlanTime\": %v, \"ExecuteTime\": %v, \"CommitTime\": %v, \"StmtType\": %q, \"SQL\": %q, \"BindVars\": %v, \"ShardQueries\": %v, \"RowsAffected\": %v, \"Error\": %q,  \"Keyspace\": %q, \"Table\": %q, \"TabletType\": %q, \"Fingerprint\": %q}\n"
 	}
 
 	_, err := fmt.Fprintf(
@@ -174,7 +188,7 @@ func (stats *LogStats) Logf(w io.Writer, params url.Values) error {
 		stats.ExecuteTime.Seconds(),
 		stats.CommitTime.Seconds(),
 		stats.StmtType,
-		stats.SQL,
+		sql,
 		formattedBindVars,
 		stats.ShardQueries,
 		stats.RowsAffected,
@@ -182,6 +196,7 @@ func (stats *LogStats) Logf(w io.Writer, params url.Values) error {
 		stats.Keyspace,
 		stats.Table,
 		stats.TabletType,
+		stats.Fingerprint,
 	)
 	return err
 }
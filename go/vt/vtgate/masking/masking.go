@@ -0,0 +1,210 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package masking defines the column masking policies of vtgate: the columns
+// whose values are masked in the results of the queries of the callers that
+// are not allowed to see them.
+package masking
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// Method is how the values of a masked column are masked.
+type Method string
+
+const (
+	// Null replaces the values with NULL.
+	Null = Method("null")
+	// Hash replaces the values with the hex encoded SHA-256 of their bytes,
+	// which still lets the callers compare or count them.
+	Hash = Method("hash")
+	// Partial replaces all but the last Visible characters of the values
+	// with an X.
+	Partial = Method("partial")
+)
+
+// Rule masks a column of a table for the callers it does not exempt.
+type Rule struct {
+	Keyspace string `json:"keyspace"`
+	Table    string `json:"table"`
+	Column   string `json:"column"`
+	Method   Method `json:"method"`
+
+	// Visible is the number of trailing characters a Partial mask keeps.
+	Visible int `json:"visible,omitempty"`
+
+	// Unmasked are the users, and the groups as "group:<name>", that see
+	// the values of the column as they are.
+	Unmasked []string `json:"unmasked,omitempty"`
+}
+
+// Policy is a set of rules, at most one per column.
+type Policy struct {
+	Rules []*Rule `json:"rules"`
+
+	byColumn map[string]*Rule
+	tables   map[string]bool
+}
+
+// Load reads the policy of the JSON file.
+func Load(file string) (*Policy, error) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "can not read the column masking policy file %s", file)
+	}
+	policy := &Policy{}
+	if err := json.Unmarshal(data, policy); err != nil {
+		return nil, vterrors.Wrapf(err, "can not parse the column masking policy file %s", file)
+	}
+	return NewPolicy(policy.Rules)
+}
+
+// NewPolicy returns the policy of the rules, or an error if a rule is not
+// valid.
+func NewPolicy(rules []*Rule) (*Policy, error) {
+	policy := &Policy{
+		Rules:    rules,
+		byColumn: make(map[string]*Rule, len(rules)),
+		tables:   make(map[string]bool),
+	}
+	for _, rule := range rules {
+		if rule.Keyspace == "" || rule.Table == "" || rule.Column == "" {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "column masking rule %s.%s.%s: the keyspace, table and column are required", rule.Keyspace, rule.Table, rule.Column)
+		}
+		switch rule.Method {
+		case Null, Hash:
+		case Partial:
+			if rule.Visible < 0 {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "column masking rule %s.%s.%s: negative visible characters", rule.Keyspace, rule.Table, rule.Column)
+			}
+		default:
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "column masking rule %s.%s.%s: unknown method '%s', expected null, hash or partial", rule.Keyspace, rule.Table, rule.Column, rule.Method)
+		}
+		key := columnKey(rule.Keyspace, rule.Table, rule.Column)
+		if _, ok := policy.byColumn[key]; ok {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "column masking rule %s.%s.%s: the column already has a rule", rule.Keyspace, rule.Table, rule.Column)
+		}
+		policy.byColumn[key] = rule
+		policy.tables[tableKey(rule.Keyspace, rule.Table)] = true
+	}
+	return policy, nil
+}
+
+// Rule returns the rule of the column, if any. The names are case
+// insensitive.
+func (p *Policy) Rule(keyspace, table, column string) *Rule {
+	if p == nil {
+		return nil
+	}
+	return p.byColumn[columnKey(keyspace, table, column)]
+}
+
+// TableRules returns the rules of the columns of the table.
+func (p *Policy) TableRules(keyspace, table string) []*Rule {
+	if p == nil || !p.tables[tableKey(keyspace, table)] {
+		return nil
+	}
+	var rules []*Rule
+	for _, rule := range p.Rules {
+		if strings.EqualFold(rule.Keyspace, keyspace) && strings.EqualFold(rule.Table, table) {
+			rules = append(rules, rule)
+		}
+	}
+	return rules
+}
+
+// IsEmpty returns whether the policy masks no column.
+func (p *Policy) IsEmpty() bool {
+	return p == nil || len(p.Rules) == 0
+}
+
+// Exempts returns whether the caller sees the values of the column as they
+// are, by its username or one of its groups.
+func (r *Rule) Exempts(caller *querypb.VTGateCallerID) bool {
+	for _, unmasked := range r.Unmasked {
+		if group := strings.TrimPrefix(unmasked, "group:"); group != unmasked {
+			for _, g := range caller.GetGroups() {
+				if g == group {
+					return true
+				}
+			}
+			continue
+		}
+		if unmasked == caller.GetUsername() {
+			return true
+		}
+	}
+	return false
+}
+
+// Mask returns the masked value.
+func (r *Rule) Mask(value sqltypes.Value) sqltypes.Value {
+	if value.IsNull() {
+		return value
+	}
+	switch r.Method {
+	case Hash:
+		sum := sha256.Sum256(value.Raw())
+		return sqltypes.NewVarChar(hex.EncodeToString(sum[:]))
+	case Partial:
+		chars := []rune(value.ToString())
+		for i := 0; i < len(chars)-r.Visible; i++ {
+			chars[i] = 'X'
+		}
+		return sqltypes.NewVarChar(string(chars))
+	}
+	return sqltypes.NULL
+}
+
+// MaskField returns the field of the column once masked: the masks that
+// replace the values with strings make it a VARCHAR.
+func (r *Rule) MaskField(field *querypb.Field) *querypb.Field {
+	if r.Method == Null || field.Type == sqltypes.VarChar {
+		return field
+	}
+	masked := proto.Clone(field).(*querypb.Field)
+	masked.Type = sqltypes.VarChar
+	masked.Charset = mysql.CharacterSetUtf8
+	masked.Flags = 0
+	return masked
+}
+
+func columnKey(keyspace, table, column string) string {
+	return strings.ToLower(keyspace + "." + table + "." + column)
+}
+
+func tableKey(keyspace, table string) string {
+	return strings.ToLower(keyspace + "." + table)
+}
+
+// String implements fmt.Stringer.
+func (r *Rule) String() string {
+	return r.Keyspace + "." + r.Table + "." + r.Column
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package masking
+
+import (
+	"io/ioutil"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/callerid"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestLoad(t *testing.T) {
+	file := path.Join(t.TempDir(), "policy.json")
+	require.NoError(t, ioutil.WriteFile(file, []byte(`{"rules": [
+  {"keyspace": "ks", "table": "user", "column": "ssn", "method": "partial", "visible": 4, "unmasked": ["hr"]},
+  {"keyspace": "ks", "table": "user", "column": "email", "method": "hash"}
+]}`), 0644))
+	policy, err := Load(file)
+	require.NoError(t, err)
+	assert.Equal(t, Partial, policy.Rule("KS", "User", "SSN").Method)
+	assert.Nil(t, policy.Rule("ks", "user", "name"))
+	assert.Len(t, policy.TableRules("ks", "user"), 2)
+	assert.Empty(t, policy.TableRules("ks", "music"))
+
+	_, err = Load(path.Join(t.TempDir(), "missing.json"))
+	require.Error(t, err)
+}
+
+func TestNewPolicyErrors(t *testing.T) {
+	tcases := []struct {
+		rules []*Rule
+		err   string
+	}{{
+		rules: []*Rule{{Keyspace: "ks", Table: "user", Method: Null}},
+		err:   "column masking rule ks.user.: the keyspace, table and column are required",
+	}, {
+		rules: []*Rule{{Keyspace: "ks", Table: "user", Column: "ssn", Method: "shuffle"}},
+		err:   "column masking rule ks.user.ssn: unknown method 'shuffle', expected null, hash or partial",
+	}, {
+		rules: []*Rule{{Keyspace: "ks", Table: "user", Column: "ssn", Method: Null}, {Keyspace: "ks", Table: "USER", Column: "ssn", Method: Hash}},
+		err:   "column masking rule ks.USER.ssn: the column already has a rule",
+	}}
+	for _, tcase := range tcases {
+		_, err := NewPolicy(tcase.rules)
+		assert.EqualError(t, err, tcase.err)
+	}
+	policy, err := NewPolicy(nil)
+	require.NoError(t, err)
+	assert.True(t, policy.IsEmpty())
+}
+
+func TestRuleExempts(t *testing.T) {
+	rule := &Rule{Unmasked: []string{"hr", "group:auditors"}}
+	assert.True(t, rule.Exempts(callerid.NewImmediateCallerID("hr")))
+	assert.True(t, rule.Exempts(&querypb.VTGateCallerID{Username: "bob", Groups: []string{"dev", "auditors"}}))
+	assert.False(t, rule.Exempts(&querypb.VTGateCallerID{Username: "auditors"}))
+	assert.False(t, rule.Exempts(nil))
+}
+
+func TestRuleMask(t *testing.T) {
+	tcases := []struct {
+		rule  *Rule
+		value sqltypes.Value
+		want  sqltypes.Value
+	}{{
+		rule:  &Rule{Method: Null},
+		value: sqltypes.NewVarChar("secret"),
+		want:  sqltypes.NULL,
+	}, {
+		rule:  &Rule{Method: Hash},
+		value: sqltypes.NewInt64(1),
+		want:  sqltypes.NewVarChar("6b86b273ff34fce19d6b804eff5a3f5747ada4eaa22f1d49c01e52ddb7875b4b"),
+	}, {
+		rule:  &Rule{Method: Partial, Visible: 4},
+		value: sqltypes.NewVarChar("4111-1111-1111-1234"),
+		want:  sqltypes.NewVarChar("XXXXXXXXXXXXXXX1234"),
+	}, {
+		rule:  &Rule{Method: Partial, Visible: 4},
+		value: sqltypes.NewVarChar("abc"),
+		want:  sqltypes.NewVarChar("abc"),
+	}, {
+		rule:  &Rule{Method: Partial},
+		value: sqltypes.NewVarChar("né"),
+		want:  sqltypes.NewVarChar("XX"),
+	}, {
+		rule:  &Rule{Method: Hash},
+		value: sqltypes.NULL,
+		want:  sqltypes.NULL,
+	}}
+	for _, tcase := range tcases {
+		assert.Equal(t, tcase.want, tcase.rule.Mask(tcase.value))
+	}
+}
@@ -0,0 +1,58 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"time"
+
+	"vitess.io/vitess/go/vt/vterrors"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// maxExecutionTime returns the budget of the requests of the session, set
+// with the max_execution_time system variable, or 0 if it has none.
+func maxExecutionTime(safeSession *SafeSession) time.Duration {
+	return time.Duration(safeSession.GetOptions().GetMaxExecutionTimeMs()) * time.Millisecond
+}
+
+// withMaxExecutionTime bounds the context of a request with the budget of
+// its session, counted from the moment vtgate received the request. The
+// deadline travels with the shard queries, and vttablet passes what remains
+// of it to MySQL.
+func withMaxExecutionTime(ctx context.Context, budget time.Duration, logStats *LogStats) (context.Context, context.CancelFunc) {
+	if budget == 0 {
+		return ctx, func() {}
+	}
+	return context.WithDeadline(ctx, logStats.StartTime.Add(budget))
+}
+
+// maxExecutionTimeError amends the error of a request that ran out of its
+// budget with the time it spent planning and executing in vtgate. The
+// errors of the shards give the time spent in each vttablet.
+func maxExecutionTimeError(err error, budget time.Duration, logStats *LogStats) error {
+	if err == nil || budget == 0 {
+		return err
+	}
+	elapsed := time.Since(logStats.StartTime)
+	if elapsed < budget && vterrors.Code(err) != vtrpcpb.Code_DEADLINE_EXCEEDED {
+		return err
+	}
+	return vterrors.Errorf(vtrpcpb.Code_DEADLINE_EXCEEDED, "max_execution_time of %v exceeded after %v (vtgate planning: %v, execution: %v, shard queries: %d): %s",
+		budget, elapsed.Round(time.Millisecond), logStats.PlanTime.Round(time.Millisecond), logStats.ExecuteTime.Round(time.Millisecond), logStats.ShardQueries, err)
+}
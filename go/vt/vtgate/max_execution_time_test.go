@@ -0,0 +1,92 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+func TestExecutorMaxExecutionTime(t *testing.T) {
+	executor, _, _, _ := createExecutorEnv()
+	executor.normalize = true
+	session := NewSafeSession(&vtgatepb.Session{Autocommit: true})
+
+	_, err := executor.Execute(ctx, "TestExecutorMaxExecutionTime", session, "set max_execution_time = 100", nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 100, session.GetOptions().GetMaxExecutionTimeMs())
+	assert.Equal(t, 100*time.Millisecond, maxExecutionTime(session))
+
+	qr, err := executor.Execute(ctx, "TestExecutorMaxExecutionTime", session, "select @@max_execution_time", nil)
+	require.NoError(t, err)
+	assert.Equal(t, [][]sqltypes.Value{{sqltypes.NewInt64(100)}}, qr.Rows)
+
+	_, err = executor.Execute(ctx, "TestExecutorMaxExecutionTime", session, "set max_execution_time = -1", nil)
+	require.Error(t, err)
+	assert.Equal(t, vtrpcpb.Code_INVALID_ARGUMENT, vterrors.Code(err))
+}
+
+func TestWithMaxExecutionTime(t *testing.T) {
+	logStats := NewLogStats(context.Background(), "Execute", "select 1", nil)
+
+	ctx, cancel := withMaxExecutionTime(context.Background(), 0, logStats)
+	defer cancel()
+	_, ok := ctx.Deadline()
+	assert.False(t, ok)
+
+	// The budget counts from the start of the request.
+	ctx, cancel = withMaxExecutionTime(context.Background(), time.Second, logStats)
+	defer cancel()
+	deadline, ok := ctx.Deadline()
+	require.True(t, ok)
+	assert.Equal(t, logStats.StartTime.Add(time.Second), deadline)
+}
+
+func TestMaxExecutionTimeError(t *testing.T) {
+	logStats := NewLogStats(context.Background(), "Execute", "select 1", nil)
+	logStats.PlanTime = time.Millisecond
+	logStats.ExecuteTime = 9 * time.Millisecond
+	logStats.ShardQueries = 2
+	shardErr := vterrors.New(vtrpcpb.Code_DEADLINE_EXCEEDED, "target: ks.-80.primary: vttablet timing")
+
+	// The errors of the requests within their budget are left alone.
+	other := errors.New("other")
+	assert.Equal(t, other, maxExecutionTimeError(other, time.Hour, logStats))
+	assert.Equal(t, shardErr, maxExecutionTimeError(shardErr, 0, logStats))
+	assert.NoError(t, maxExecutionTimeError(nil, time.Hour, logStats))
+
+	err := maxExecutionTimeError(shardErr, time.Hour, logStats)
+	assert.Equal(t, vtrpcpb.Code_DEADLINE_EXCEEDED, vterrors.Code(err))
+	assert.Contains(t, err.Error(), "max_execution_time of 1h0m0s exceeded after ")
+	assert.Contains(t, err.Error(), "(vtgate planning: 1ms, execution: 9ms, shard queries: 2): target: ks.-80.primary: vttablet timing")
+
+	logStats.StartTime = time.Now().Add(-time.Second)
+	err = maxExecutionTimeError(other, 10*time.Millisecond, logStats)
+	assert.Equal(t, vtrpcpb.Code_DEADLINE_EXCEEDED, vterrors.Code(err))
+	assert.Contains(t, err.Error(), "): other")
+}
@@ -0,0 +1,246 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"io"
+	"strings"
+	"sync"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/callerid"
+	"vitess.io/vitess/go/vt/srvtopo"
+)
+
+// A consumer group lets the connections that stream the messages of a
+// table, with the CONSUMER_GROUP directive, share them: vtgate streams the
+// messages of the table once for the group, and sends each batch of
+// messages to one member of the group, in turn, so that the members get
+// disjoint messages. If a member goes away, the batch that could not be
+// sent to it is sent to the next member right away. The members ack the
+// messages as usual, and vttablet resends the messages that a member got
+// but did not ack, to the group, once their ack wait time is over.
+//
+// The group is per vtgate: the groups of several vtgates get disjoint
+// messages too, because vttablet sends each message to one stream only.
+
+var (
+	messageGroupMembers = stats.NewGaugesWithMultiLabels(
+		"MessageGroupMembers",
+		"Members of the consumer groups of the message tables",
+		[]string{"Keyspace", "Table", "Group"})
+	messageGroupDelivered = stats.NewCountersWithMultiLabels(
+		"MessageGroupDelivered",
+		"Messages sent to the members of the consumer groups",
+		[]string{"Keyspace", "Table", "Group"})
+	messageGroupRedelivered = stats.NewCountersWithMultiLabels(
+		"MessageGroupRedelivered",
+		"Messages sent to the next member of their consumer group, because the member they were sent to went away",
+		[]string{"Keyspace", "Table", "Group"})
+)
+
+// messageStreamFunc streams the messages of the table name from the shards.
+type messageStreamFunc func(ctx context.Context, rss []*srvtopo.ResolvedShard, name string, callback func(*sqltypes.Result) error) error
+
+// messageGroups holds the consumer groups of the vtgate.
+type messageGroups struct {
+	stream messageStreamFunc
+
+	mu     sync.Mutex
+	groups map[string]*messageGroup
+}
+
+func newMessageGroups(stream messageStreamFunc) *messageGroups {
+	return &messageGroups{
+		stream: stream,
+		groups: make(map[string]*messageGroup),
+	}
+}
+
+// Join makes callback a member of the consumer group of the table name
+// on the shards, and sends it its share of the messages until ctx is
+// done, callback fails, or the stream of the group fails.
+func (mgs *messageGroups) Join(ctx context.Context, rss []*srvtopo.ResolvedShard, name, group string, callback func(*sqltypes.Result) error) error {
+	member := &messageGroupMember{
+		callback: callback,
+		done:     make(chan struct{}),
+	}
+	g := mgs.join(ctx, rss, name, group, member)
+	select {
+	case <-ctx.Done():
+		g.leave(member)
+		return nil
+	case <-member.done:
+		return member.err
+	}
+}
+
+func (mgs *messageGroups) join(ctx context.Context, rss []*srvtopo.ResolvedShard, name, group string, member *messageGroupMember) *messageGroup {
+	keyspace := ""
+	shards := make([]string, 0, len(rss))
+	for _, rs := range rss {
+		keyspace = rs.Target.Keyspace
+		shards = append(shards, rs.Target.Shard)
+	}
+	// Members that stream from different shards need their own streams.
+	key := strings.Join([]string{keyspace, strings.Join(shards, ","), name, group}, "/")
+
+	mgs.mu.Lock()
+	defer mgs.mu.Unlock()
+	if g := mgs.groups[key]; g != nil && g.add(member) {
+		return g
+	}
+
+	// The stream of the group outlives the member that starts it,
+	// but it runs with its caller ids.
+	streamCtx := callerid.NewContext(context.Background(), callerid.EffectiveCallerIDFromContext(ctx), callerid.ImmediateCallerIDFromContext(ctx))
+	streamCtx, cancel := context.WithCancel(streamCtx)
+	g := &messageGroup{
+		labels: []string{keyspace, name, group},
+		cancel: cancel,
+	}
+	g.add(member)
+	mgs.groups[key] = g
+	go func() {
+		err := mgs.stream(streamCtx, rss, name, g.dispatch)
+		mgs.mu.Lock()
+		if mgs.groups[key] == g {
+			delete(mgs.groups, key)
+		}
+		mgs.mu.Unlock()
+		g.end(err)
+	}()
+	return g
+}
+
+type messageGroupMember struct {
+	callback func(*sqltypes.Result) error
+	// done is closed once the member is out of the group,
+	// and err is set to the reason.
+	done chan struct{}
+	err  error
+}
+
+// messageGroup is a consumer group, which shares one message stream.
+type messageGroup struct {
+	labels []string
+	cancel context.CancelFunc
+
+	// mu is held while sending to the members, so that a member
+	// does not get messages once it left the group.
+	mu      sync.Mutex
+	closed  bool
+	fields  *sqltypes.Result
+	members []*messageGroupMember
+	next    int
+}
+
+// add adds the member to the group. It returns false if the group is
+// closed, and so cannot take new members.
+func (g *messageGroup) add(member *messageGroupMember) bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.closed {
+		return false
+	}
+	g.members = append(g.members, member)
+	messageGroupMembers.Set(g.labels, int64(len(g.members)))
+	if g.fields != nil {
+		if err := member.callback(g.fields); err != nil {
+			g.removeLocked(member, err)
+		}
+	}
+	return true
+}
+
+func (g *messageGroup) leave(member *messageGroupMember) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.removeLocked(member, nil)
+}
+
+// removeLocked removes the member from the group, if it is still in it.
+// The group closes, and its stream stops, once it has no members left.
+func (g *messageGroup) removeLocked(member *messageGroupMember, err error) {
+	for i, cur := range g.members {
+		if cur != member {
+			continue
+		}
+		g.members = append(g.members[:i], g.members[i+1:]...)
+		member.err = err
+		close(member.done)
+		break
+	}
+	messageGroupMembers.Set(g.labels, int64(len(g.members)))
+	if len(g.members) == 0 && !g.closed {
+		g.closed = true
+		g.cancel()
+	}
+}
+
+// dispatch is the callback of the stream of the group. The fields go
+// to every member, and each batch of messages goes to one member.
+func (g *messageGroup) dispatch(qr *sqltypes.Result) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if len(qr.Fields) != 0 {
+		g.fields = &sqltypes.Result{Fields: qr.Fields}
+		for _, member := range append([]*messageGroupMember(nil), g.members...) {
+			if err := member.callback(g.fields); err != nil {
+				g.removeLocked(member, err)
+			}
+		}
+	}
+	if len(qr.Rows) == 0 {
+		return nil
+	}
+
+	rows := &sqltypes.Result{Rows: qr.Rows}
+	for redelivery := false; len(g.members) != 0; redelivery = true {
+		g.next %= len(g.members)
+		member := g.members[g.next]
+		g.next++
+		if redelivery {
+			messageGroupRedelivered.Add(g.labels, int64(len(rows.Rows)))
+		}
+		if err := member.callback(rows); err != nil {
+			g.removeLocked(member, err)
+			continue
+		}
+		messageGroupDelivered.Add(g.labels, int64(len(rows.Rows)))
+		return nil
+	}
+	// The group has no members left, and its stream is canceled.
+	return io.EOF
+}
+
+// end closes the group once its stream ended, and returns the error of
+// the stream to the members.
+func (g *messageGroup) end(err error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.closed = true
+	for _, member := range g.members {
+		member.err = err
+		close(member.done)
+	}
+	g.members = nil
+	messageGroupMembers.Set(g.labels, 0)
+	g.cancel()
+}
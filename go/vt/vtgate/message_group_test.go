@@ -0,0 +1,159 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/sync2"
+	"vitess.io/vitess/go/vt/srvtopo"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// fakeMessageStream streams the results sent to its channel, and counts
+// the streams it started.
+type fakeMessageStream struct {
+	results chan *sqltypes.Result
+	streams sync2.AtomicInt32
+}
+
+func (fms *fakeMessageStream) stream(ctx context.Context, rss []*srvtopo.ResolvedShard, name string, callback func(*sqltypes.Result) error) error {
+	fms.streams.Add(1)
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case qr := <-fms.results:
+			if err := callback(qr); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// groupMember returns a member callback that forwards the ids of the
+// messages it gets, or fails with err if set.
+func groupMember(ids chan string, err error) func(*sqltypes.Result) error {
+	return func(qr *sqltypes.Result) error {
+		if len(qr.Rows) == 0 {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		for _, row := range qr.Rows {
+			ids <- row[0].ToString()
+		}
+		return nil
+	}
+}
+
+func messageBatch(ids ...string) *sqltypes.Result {
+	qr := &sqltypes.Result{}
+	for _, id := range ids {
+		qr.Rows = append(qr.Rows, []sqltypes.Value{sqltypes.NewVarChar(id)})
+	}
+	return qr
+}
+
+func TestMessageGroup(t *testing.T) {
+	fms := &fakeMessageStream{results: make(chan *sqltypes.Result)}
+	mgs := newMessageGroups(fms.stream)
+	rss := []*srvtopo.ResolvedShard{{Target: &querypb.Target{Keyspace: "ks", Shard: "-80"}}}
+
+	ctx1, cancel1 := context.WithCancel(context.Background())
+	defer cancel1()
+	ids1 := make(chan string, 10)
+	done1 := make(chan error)
+	go func() {
+		done1 <- mgs.Join(ctx1, rss, "msg", "g1", groupMember(ids1, nil))
+	}()
+	fms.results <- &sqltypes.Result{Fields: []*querypb.Field{{Name: "id", Type: sqltypes.VarChar}}}
+
+	ids2 := make(chan string, 10)
+	done2 := make(chan error)
+	go func() {
+		done2 <- mgs.Join(context.Background(), rss, "msg", "g1", groupMember(ids2, nil))
+	}()
+	for messageGroupMembers.Counts()["ks.msg.g1"] != 2 {
+		time.Sleep(time.Millisecond)
+	}
+	assert.EqualValues(t, 1, fms.streams.Get())
+
+	// The members get the batches in turns.
+	fms.results <- messageBatch("1", "2")
+	fms.results <- messageBatch("3")
+	assert.Equal(t, "1", <-ids1)
+	assert.Equal(t, "2", <-ids1)
+	assert.Equal(t, "3", <-ids2)
+
+	// A member that fails is out of the group, and its batch goes to the
+	// next member.
+	ids3 := make(chan string, 10)
+	done3 := make(chan error)
+	go func() {
+		done3 <- mgs.Join(context.Background(), rss, "msg", "g1", groupMember(ids3, errors.New("consumer gone")))
+	}()
+	for messageGroupMembers.Counts()["ks.msg.g1"] != 3 {
+		time.Sleep(time.Millisecond)
+	}
+	redelivered := messageGroupRedelivered.Counts()["ks.msg.g1"]
+	fms.results <- messageBatch("4")
+	fms.results <- messageBatch("5")
+	require.EqualError(t, <-done3, "consumer gone")
+	assert.Equal(t, "4", <-ids2)
+	assert.Equal(t, "5", <-ids1)
+	assert.EqualValues(t, redelivered+1, messageGroupRedelivered.Counts()["ks.msg.g1"])
+
+	// A member that leaves does not get messages any more.
+	cancel1()
+	require.NoError(t, <-done1)
+	fms.results <- messageBatch("6")
+	assert.Equal(t, "6", <-ids2)
+
+	// Another group gets its own stream.
+	ctx4, cancel4 := context.WithCancel(context.Background())
+	done4 := make(chan error)
+	go func() {
+		done4 <- mgs.Join(ctx4, rss, "msg", "g2", groupMember(make(chan string, 10), nil))
+	}()
+	for fms.streams.Get() != 2 {
+		time.Sleep(time.Millisecond)
+	}
+	cancel4()
+	require.NoError(t, <-done4)
+}
+
+func TestMessageGroupStreamError(t *testing.T) {
+	mgs := newMessageGroups(func(ctx context.Context, rss []*srvtopo.ResolvedShard, name string, callback func(*sqltypes.Result) error) error {
+		return errors.New("stream failed")
+	})
+	err := mgs.Join(context.Background(), nil, "msg", "g1", groupMember(make(chan string), nil))
+	require.EqualError(t, err, "stream failed")
+
+	// The failed group is gone, and a new member starts a new stream.
+	err = mgs.Join(context.Background(), nil, "msg", "g1", groupMember(make(chan string), nil))
+	require.EqualError(t, err, "stream failed")
+}
@@ -31,6 +31,7 @@ import (
 	"google.golang.org/protobuf/proto"
 
 	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vttablet/sandboxconn"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
@@ -55,6 +56,7 @@ func TestMySQLProtocolExecute(t *testing.T) {
 	options := &querypb.ExecuteOptions{
 		IncludedFields: querypb.ExecuteOptions_ALL,
 		Workload:       querypb.ExecuteOptions_OLTP,
+		Fingerprint:    sqlparser.FingerprintQuery("select id from t1"),
 	}
 	if !proto.Equal(sbc.Options[0], options) {
 		t.Errorf("got ExecuteOptions \n%+v, want \n%+v", sbc.Options[0], options)
@@ -82,6 +84,7 @@ func TestMySQLProtocolStreamExecute(t *testing.T) {
 	options := &querypb.ExecuteOptions{
 		IncludedFields: querypb.ExecuteOptions_ALL,
 		Workload:       querypb.ExecuteOptions_OLAP,
+		Fingerprint:    sqlparser.FingerprintQuery("select id from t1"),
 	}
 	if !proto.Equal(sbc.Options[0], options) {
 		t.Errorf("got ExecuteOptions \n%+v, want \n%+v", sbc.Options[0], options)
@@ -157,6 +160,7 @@ func TestMySQLProtocolClientFoundRows(t *testing.T) {
 		IncludedFields:  querypb.ExecuteOptions_ALL,
 		ClientFoundRows: true,
 		Workload:        querypb.ExecuteOptions_OLTP,
+		Fingerprint:     sqlparser.FingerprintQuery("select id from t1"),
 	}
 
 	if !proto.Equal(sbc.Options[0], options) {
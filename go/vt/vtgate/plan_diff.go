@@ -0,0 +1,335 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/planbuilder"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+const pathPlanDiff = "/debug/plan_diff"
+
+// PlanDiff compares the plans two planner versions build for a query.
+type PlanDiff struct {
+	Query string
+	Left  *PlanSummary
+	Right *PlanSummary
+	// Differences describe how the plans differ. It is empty when the plans
+	// have the same routes, join order and pushed-down predicates.
+	Differences []string
+}
+
+// PlanSummary are the parts of a plan that PlanDiff compares: the routes the
+// plan sends queries to, the order it joins their tables in and the
+// predicates it pushes down to them.
+type PlanSummary struct {
+	Planner      string
+	Error        string                       `json:",omitempty"`
+	Routes       []*RouteSummary              `json:",omitempty"`
+	JoinOrder    []string                     `json:",omitempty"`
+	Instructions *engine.PrimitiveDescription `json:",omitempty"`
+}
+
+// RouteSummary describes a route of a plan.
+type RouteSummary struct {
+	Keyspace   string
+	Variant    string
+	Table      string
+	Query      string
+	Predicates []string `json:",omitempty"`
+}
+
+// PlanDiff plans each of the semicolon-separated queries with the left and
+// the right planner versions, and reports how the plans differ. The queries
+// are planned against the target the way a session using it would plan them,
+// but without the plan cache, and are not executed.
+func (e *Executor) PlanDiff(ctx context.Context, target string, sql string, left, right planbuilder.PlannerVersion) ([]*PlanDiff, error) {
+	if e.VSchema() == nil {
+		return nil, vterrors.New(vtrpcpb.Code_UNAVAILABLE, "vschema not initialized")
+	}
+
+	queries, err := sqlparser.SplitStatementToPieces(sql)
+	if err != nil {
+		return nil, err
+	}
+
+	diffs := make([]*PlanDiff, 0, len(queries))
+	for _, query := range queries {
+		query = strings.TrimSpace(query)
+		if query == "" {
+			continue
+		}
+
+		diff := &PlanDiff{
+			Query: query,
+			Left:  e.planSummary(ctx, e.VSchema(), target, query, left),
+			Right: e.planSummary(ctx, e.VSchema(), target, query, right),
+		}
+		diff.Differences = diffPlanSummaries(diff.Left, diff.Right)
+		diffs = append(diffs, diff)
+	}
+
+	return diffs, nil
+}
+
+func (e *Executor) planSummary(ctx context.Context, vschema *vindexes.VSchema, target string, query string, version planbuilder.PlannerVersion) *PlanSummary {
+	summary := &PlanSummary{Planner: version.String()}
+
+	plan, err := e.planWithVersion(ctx, vschema, target, query, version)
+	if err != nil {
+		summary.Error = err.Error()
+		return summary
+	}
+
+	description := engine.PrimitiveToPlanDescription(plan.Instructions)
+	summary.Instructions = &description
+	summarizePlan(summary, description)
+
+	return summary
+}
+
+// planWithVersion builds the plan of the query against the vschema with the
+// planner version, without the plan cache.
+func (e *Executor) planWithVersion(ctx context.Context, vschema *vindexes.VSchema, target string, query string, version planbuilder.PlannerVersion) (*engine.Plan, error) {
+	safeSession := NewSafeSession(&vtgatepb.Session{
+		TargetString: target,
+		Options:      &querypb.ExecuteOptions{PlannerVersion: version},
+	})
+	logStats := NewLogStats(ctx, "PlanDiff", query, nil)
+
+	vcursor, err := newVCursorImpl(ctx, safeSession, sqlparser.MarginComments{}, e, logStats, e.vm, vschema, e.resolver.resolver, e.serv, e.warnShardedOnly)
+	if err != nil {
+		return nil, err
+	}
+
+	stmt, reserved, err := sqlparser.Parse2(query)
+	if err != nil {
+		return nil, err
+	}
+
+	statement := stmt
+	reservedVars := sqlparser.NewReservedVars("vtg", reserved)
+	bindVarNeeds := &sqlparser.BindVarNeeds{}
+	if (e.normalize && sqlparser.CanNormalize(stmt)) || sqlparser.MustRewriteAST(stmt) {
+		result, err := sqlparser.PrepareAST(stmt, reservedVars, map[string]*querypb.BindVariable{}, e.normalize, vcursor.keyspace)
+		if err != nil {
+			return nil, err
+		}
+		statement = result.AST
+		bindVarNeeds = result.BindVarNeeds
+		query = sqlparser.String(statement)
+	}
+
+	return planbuilder.BuildFromStmt(query, statement, reservedVars, vcursor, bindVarNeeds, *enableOnlineDDL, *enableDirectDDL)
+}
+
+// summarizePlan adds the routes of the plan description to the summary, in
+// the order the plan executes them.
+func summarizePlan(summary *PlanSummary, description engine.PrimitiveDescription) {
+	if description.OperatorType == "Route" {
+		route := &RouteSummary{
+			Variant: description.Variant,
+			Table:   fmt.Sprint(description.Other["Table"]),
+			Query:   fmt.Sprint(description.Other["Query"]),
+		}
+		if description.Keyspace != nil {
+			route.Keyspace = description.Keyspace.Name
+		}
+		route.Predicates = pushedDownPredicates(route.Query)
+
+		summary.Routes = append(summary.Routes, route)
+		summary.JoinOrder = append(summary.JoinOrder, route.Table)
+	}
+
+	for _, input := range description.Inputs {
+		summarizePlan(summary, input)
+	}
+}
+
+// pushedDownPredicates returns the predicates of the WHERE clause and of the
+// join conditions of the query a route sends.
+func pushedDownPredicates(query string) []string {
+	stmt, err := sqlparser.Parse(query)
+	if err != nil {
+		return nil
+	}
+
+	var exprs []sqlparser.Expr
+	addWhere := func(where *sqlparser.Where) {
+		if where != nil {
+			exprs = sqlparser.SplitAndExpression(exprs, where.Expr)
+		}
+	}
+
+	switch stmt := stmt.(type) {
+	case *sqlparser.Select:
+		_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+			if join, ok := node.(*sqlparser.JoinTableExpr); ok && join.Condition != nil {
+				exprs = sqlparser.SplitAndExpression(exprs, join.Condition.On)
+			}
+			return true, nil
+		}, sqlparser.TableExprs(stmt.From))
+		addWhere(stmt.Where)
+	case *sqlparser.Update:
+		addWhere(stmt.Where)
+	case *sqlparser.Delete:
+		addWhere(stmt.Where)
+	}
+
+	var predicates []string
+	for _, expr := range exprs {
+		predicates = append(predicates, sqlparser.String(expr))
+	}
+
+	return predicates
+}
+
+func diffPlanSummaries(left, right *PlanSummary) []string {
+	var differences []string
+
+	switch {
+	case left.Error != "" && right.Error != "":
+		if left.Error != right.Error {
+			differences = append(differences, fmt.Sprintf("both planners failed, with different errors: %s vs %s", left.Error, right.Error))
+		}
+		return differences
+	case left.Error != "":
+		return append(differences, fmt.Sprintf("the left planner failed: %s", left.Error))
+	case right.Error != "":
+		return append(differences, fmt.Sprintf("the right planner failed: %s", right.Error))
+	}
+
+	if len(left.Routes) != len(right.Routes) {
+		differences = append(differences, fmt.Sprintf("the left plan has %d routes, the right plan has %d", len(left.Routes), len(right.Routes)))
+	}
+
+	if !sameJoinOrder(left.JoinOrder, right.JoinOrder) {
+		differences = append(differences, fmt.Sprintf("join order: %s vs %s", strings.Join(left.JoinOrder, " -> "), strings.Join(right.JoinOrder, " -> ")))
+	}
+
+	for i := 0; i < len(left.Routes) && i < len(right.Routes); i++ {
+		l, r := left.Routes[i], right.Routes[i]
+		if l.Keyspace != r.Keyspace || l.Variant != r.Variant {
+			differences = append(differences, fmt.Sprintf("route %d: %s %s vs %s %s", i+1, l.Keyspace, l.Variant, r.Keyspace, r.Variant))
+		}
+
+		if onlyLeft := missingPredicates(l.Predicates, r.Predicates); len(onlyLeft) > 0 {
+			differences = append(differences, fmt.Sprintf("route %d: predicates only pushed down by the left plan: %s", i+1, strings.Join(onlyLeft, ", ")))
+		}
+
+		if onlyRight := missingPredicates(r.Predicates, l.Predicates); len(onlyRight) > 0 {
+			differences = append(differences, fmt.Sprintf("route %d: predicates only pushed down by the right plan: %s", i+1, strings.Join(onlyRight, ", ")))
+		}
+	}
+
+	return differences
+}
+
+func sameJoinOrder(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// missingPredicates returns the predicates of a that are not in b.
+func missingPredicates(a, b []string) []string {
+	inB := make(map[string]bool, len(b))
+	for _, predicate := range b {
+		inB[predicate] = true
+	}
+
+	var missing []string
+	for _, predicate := range a {
+		if !inB[predicate] {
+			missing = append(missing, predicate)
+		}
+	}
+
+	return missing
+}
+
+// servePlanDiff serves the plan diff of the queries of the "sql" parameter,
+// or of the request body, between the "left" and "right" planner versions,
+// V3 and Gen4 by default. The "keyspace" parameter is the target to plan the
+// queries against.
+func (e *Executor) servePlanDiff(response http.ResponseWriter, request *http.Request) {
+	if err := request.ParseForm(); err != nil {
+		http.Error(response, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	sql := request.Form.Get("sql")
+	if sql == "" && request.Method == http.MethodPost {
+		body, err := ioutil.ReadAll(request.Body)
+		if err != nil {
+			http.Error(response, err.Error(), http.StatusBadRequest)
+			return
+		}
+		sql = string(body)
+	}
+
+	if sql == "" {
+		http.Error(response, "missing sql parameter", http.StatusBadRequest)
+		return
+	}
+
+	versions := make([]planbuilder.PlannerVersion, 2)
+	for i, param := range []struct {
+		name, defaultVersion string
+	}{{"left", "v3"}, {"right", "gen4"}} {
+		name := request.Form.Get(param.name)
+		if name == "" {
+			name = param.defaultVersion
+		}
+
+		version, ok := toPlannerVersion(name)
+		if !ok {
+			http.Error(response, fmt.Sprintf("unknown %s planner version %s", param.name, name), http.StatusBadRequest)
+			return
+		}
+		versions[i] = version
+	}
+
+	diffs, err := e.PlanDiff(request.Context(), request.Form.Get("keyspace"), sql, versions[0], versions[1])
+	if err != nil {
+		http.Error(response, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	returnAsJSON(response, diffs)
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/vtgate/planbuilder"
+)
+
+func TestPlanDiff(t *testing.T) {
+	executor, _, _, _ := createLegacyExecutorEnv()
+
+	diffs, err := executor.PlanDiff(context.Background(), "", "select id from user where id = 1; select m.id from music m join user u on u.col = m.col where u.id = 5; select nocol from nosuchtable", planbuilder.V3, planbuilder.Gen4)
+	require.NoError(t, err)
+	require.Len(t, diffs, 3)
+
+	assert.Equal(t, "select id from user where id = 1", diffs[0].Query)
+	assert.Equal(t, "V3", diffs[0].Left.Planner)
+	assert.Equal(t, "Gen4", diffs[0].Right.Planner)
+	require.Len(t, diffs[0].Left.Routes, 1)
+	assert.Equal(t, &RouteSummary{
+		Keyspace:   "TestExecutor",
+		Variant:    "SelectEqualUnique",
+		Table:      "`user`",
+		Query:      "select id from `user` where id = 1",
+		Predicates: []string{"id = 1"},
+	}, diffs[0].Left.Routes[0])
+	assert.Empty(t, diffs[0].Differences)
+
+	assert.Equal(t, []string{"music", "`user`"}, diffs[1].Left.JoinOrder)
+	assert.Equal(t, []string{"music", "`user`"}, diffs[1].Right.JoinOrder)
+	assert.Empty(t, diffs[1].Differences)
+
+	assert.Equal(t, "table nosuchtable not found", diffs[2].Left.Error)
+	assert.Equal(t, "table nosuchtable not found", diffs[2].Right.Error)
+	assert.Empty(t, diffs[2].Differences)
+}
+
+func TestDiffPlanSummaries(t *testing.T) {
+	route := func(keyspace, variant, table string, predicates ...string) *RouteSummary {
+		return &RouteSummary{Keyspace: keyspace, Variant: variant, Table: table, Predicates: predicates}
+	}
+
+	tests := []struct {
+		name     string
+		left     *PlanSummary
+		right    *PlanSummary
+		expected []string
+	}{
+		{
+			name: "same plans",
+			left: &PlanSummary{
+				Routes:    []*RouteSummary{route("ks", "SelectScatter", "t1", "a = 1", "b = 2")},
+				JoinOrder: []string{"t1"},
+			},
+			right: &PlanSummary{
+				Routes:    []*RouteSummary{route("ks", "SelectScatter", "t1", "b = 2", "a = 1")},
+				JoinOrder: []string{"t1"},
+			},
+		},
+		{
+			name: "different join order and predicates",
+			left: &PlanSummary{
+				Routes:    []*RouteSummary{route("ks", "SelectScatter", "t1", "a = 1"), route("ks", "SelectEqualUnique", "t2", "id = 5")},
+				JoinOrder: []string{"t1", "t2"},
+			},
+			right: &PlanSummary{
+				Routes:    []*RouteSummary{route("ks", "SelectEqualUnique", "t2", "id = 5"), route("ks", "SelectScatter", "t1", "a = 1", "t1.id = :t2_id")},
+				JoinOrder: []string{"t2", "t1"},
+			},
+			expected: []string{
+				"join order: t1 -> t2 vs t2 -> t1",
+				"route 1: ks SelectScatter vs ks SelectEqualUnique",
+				"route 1: predicates only pushed down by the left plan: a = 1",
+				"route 1: predicates only pushed down by the right plan: id = 5",
+				"route 2: ks SelectEqualUnique vs ks SelectScatter",
+				"route 2: predicates only pushed down by the left plan: id = 5",
+				"route 2: predicates only pushed down by the right plan: a = 1, t1.id = :t2_id",
+			},
+		},
+		{
+			name: "merged routes",
+			left: &PlanSummary{
+				Routes:    []*RouteSummary{route("ks", "SelectEqualUnique", "t1", "id = 1"), route("ks", "SelectEqualUnique", "t2", "id = 1")},
+				JoinOrder: []string{"t1", "t2"},
+			},
+			right: &PlanSummary{
+				Routes:    []*RouteSummary{route("ks", "SelectEqualUnique", "t1, t2", "id = 1")},
+				JoinOrder: []string{"t1, t2"},
+			},
+			expected: []string{
+				"the left plan has 2 routes, the right plan has 1",
+				"join order: t1 -> t2 vs t1, t2",
+			},
+		},
+		{
+			name:     "right planner failed",
+			left:     &PlanSummary{Routes: []*RouteSummary{route("ks", "SelectScatter", "t1")}},
+			right:    &PlanSummary{Error: "unsupported"},
+			expected: []string{"the right planner failed: unsupported"},
+		},
+		{
+			name:  "both planners failed",
+			left:  &PlanSummary{Error: "unsupported"},
+			right: &PlanSummary{Error: "unsupported"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, diffPlanSummaries(tt.left, tt.right))
+		})
+	}
+}
@@ -66,6 +66,7 @@ func (e *Executor) newExecute(ctx context.Context, safeSession *SafeSession, sql
 		safeSession.ClearWarnings()
 		return 0, nil, err
 	}
+	vcursor.SetQueryFingerprint(plan.Fingerprint)
 
 	if plan.Type != sqlparser.StmtShow {
 		safeSession.ClearWarnings()
@@ -128,6 +129,7 @@ func (e *Executor) startTxIfNecessary(ctx context.Context, safeSession *SafeSess
 		if err := e.txConn.Begin(ctx, safeSession); err != nil {
 			return err
 		}
+		return e.captureSnapshotGTIDs(ctx, safeSession)
 	}
 	return nil
 }
@@ -175,13 +177,26 @@ type currFunc func(*LogStats, *SafeSession) (sqlparser.StatementType, *sqltypes.
 func (e *Executor) executePlan(ctx context.Context, plan *engine.Plan, vcursor *vcursorImpl, bindVars map[string]*querypb.BindVariable, execStart time.Time) currFunc {
 	return func(logStats *LogStats, safeSession *SafeSession) (sqlparser.StatementType, *sqltypes.Result, error) {
 		// 4: Execute!
-		qr, err := vcursor.ExecutePrimitive(plan.Instructions, bindVars, true)
+		policy := e.planKeyspacePolicy(plan, vcursor)
+		if policy.GetQueryTimeoutMs() != 0 {
+			cancel := vcursor.SetContextTimeout(time.Duration(policy.QueryTimeoutMs) * time.Millisecond)
+			defer cancel()
+		}
+		var qr *sqltypes.Result
+		err := checkKeyspacePolicyDenied(vcursor.ctx, policy, plan.Fingerprint)
+		if err == nil {
+			qr, err = vcursor.ExecutePrimitive(plan.Instructions, bindVars, true)
+		}
+		if err == nil {
+			err = checkKeyspacePolicyRows(policy, qr)
+		}
 
 		// 5: Log and add statistics
 		logStats.Keyspace = plan.Instructions.GetKeyspaceName()
 		logStats.Table = plan.Instructions.GetTableName()
 		logStats.TabletType = vcursor.TabletType().String()
 		errCount := e.logExecutionEnd(logStats, execStart, plan, err, qr)
+		recordWorkloadQuery(plan, safeSession, logStats)
 		plan.AddStats(1, time.Since(logStats.StartTime), uint64(logStats.ShardQueries), logStats.RowsAffected, logStats.RowsReturned, errCount)
 
 		// Check if there was partial DML execution. If so, rollback the transaction.
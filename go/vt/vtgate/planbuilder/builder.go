@@ -29,6 +29,7 @@ import (
 	"vitess.io/vitess/go/vt/key"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/masking"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
 
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
@@ -64,6 +65,12 @@ type ContextVSchema interface {
 
 	// ForeignKeyMode returns the foreign_key flag value
 	ForeignKeyMode() string
+
+	// JoinOrderTables returns the gen4_join_order_tables flag value
+	JoinOrderTables() int
+
+	// MaskingPolicy returns the column masking policy, if any
+	MaskingPolicy() *masking.Policy
 }
 
 // PlannerVersion is an alias here to make the code more readable
@@ -156,7 +163,9 @@ func createInstructionFor(query string, stmt sqlparser.Statement, reservedVars *
 		if err != nil {
 			return nil, err
 		}
-		return buildRoutePlan(stmt, reservedVars, vschema, configuredPlanner(query))
+		return buildMaskedPlan(stmt, vschema, func() (engine.Primitive, error) {
+			return buildRoutePlan(stmt, reservedVars, vschema, configuredPlanner(query))
+		})
 	case *sqlparser.Insert:
 		return buildRoutePlan(stmt, reservedVars, vschema, buildInsertPlan)
 	case *sqlparser.Update:
@@ -164,7 +173,9 @@ func createInstructionFor(query string, stmt sqlparser.Statement, reservedVars *
 	case *sqlparser.Delete:
 		return buildRoutePlan(stmt, reservedVars, vschema, buildDeletePlan)
 	case *sqlparser.Union:
-		return buildRoutePlan(stmt, reservedVars, vschema, buildUnionPlan)
+		return buildMaskedPlan(stmt, vschema, func() (engine.Primitive, error) {
+			return buildRoutePlan(stmt, reservedVars, vschema, buildUnionPlan)
+		})
 	case sqlparser.DDLStatement:
 		return buildGeneralDDLPlan(query, stmt, reservedVars, vschema, enableOnlineDDL, enableDirectDDL)
 	case *sqlparser.AlterMigration:
@@ -0,0 +1,120 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"strings"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// chunkSize returns the chunk size of the CHUNKED_DML directive, or 0 if the
+// directive is not set with a chunk size.
+func chunkSize(comments sqlparser.Comments) (uint64, error) {
+	val, ok := sqlparser.ExtractCommentDirectives(comments)[sqlparser.DirectiveChunkedDML]
+	if !ok {
+		return 0, nil
+	}
+	if _, ok := val.(bool); ok {
+		// Without a chunk size, the DELETE is repeated by the executor.
+		return 0, nil
+	}
+	size, ok := val.(int)
+	if !ok || size <= 0 {
+		return 0, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid %s chunk size: %v", sqlparser.DirectiveChunkedDML, val)
+	}
+	return uint64(size), nil
+}
+
+// buildChunkedDMLPlan builds a ChunkedDML executing a DELETE or UPDATE
+// carrying the CHUNKED_DML directive in chunks of primary key ranges.
+func buildChunkedDMLPlan(stmt sqlparser.Statement, size uint64, reservedVars *sqlparser.ReservedVars, vschema ContextVSchema) (engine.Primitive, error) {
+	var tableExprs sqlparser.TableExprs
+	switch stmt := stmt.(type) {
+	case *sqlparser.Delete:
+		if len(stmt.Targets) > 1 || stmt.Limit != nil || len(stmt.OrderBy) > 0 {
+			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: %s with a chunk size on a multi-table delete, or a delete with a LIMIT or ORDER BY", sqlparser.DirectiveChunkedDML)
+		}
+		stmt.Comments = removeDirective(stmt.Comments, sqlparser.DirectiveChunkedDML)
+		tableExprs = stmt.TableExprs
+	case *sqlparser.Update:
+		if stmt.Limit != nil || len(stmt.OrderBy) > 0 {
+			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: %s with a chunk size on an update with a LIMIT or ORDER BY", sqlparser.DirectiveChunkedDML)
+		}
+		stmt.Comments = removeDirective(stmt.Comments, sqlparser.DirectiveChunkedDML)
+		tableExprs = stmt.TableExprs
+	}
+
+	var tableName sqlparser.TableName
+	if len(tableExprs) == 1 {
+		if aliased, ok := tableExprs[0].(*sqlparser.AliasedTableExpr); ok {
+			tableName, _ = aliased.Expr.(sqlparser.TableName)
+		}
+	}
+	if tableName.IsEmpty() {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: %s with a chunk size on a multi-table DML", sqlparser.DirectiveChunkedDML)
+	}
+	table, _, _, _, err := vschema.FindTable(tableName)
+	if err != nil {
+		return nil, err
+	}
+
+	// The chunks are planned when they are executed, but the DML is planned
+	// here to return its errors right away.
+	dml := sqlparser.CloneStatement(stmt)
+	if del, ok := dml.(*sqlparser.Delete); ok {
+		_, err = buildDeletePlan(del, reservedVars, vschema)
+	} else {
+		_, err = buildUpdatePlan(dml, reservedVars, vschema)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &engine.ChunkedDML{
+		Keyspace:  table.Keyspace,
+		TableName: table.Name.String(),
+		ChunkSize: size,
+		DML:       stmt,
+	}, nil
+}
+
+// removeDirective removes a directive from the comment directives.
+func removeDirective(comments sqlparser.Comments, directive string) sqlparser.Comments {
+	var result sqlparser.Comments
+	for _, comment := range comments {
+		if !strings.HasPrefix(comment, "/*vt+") {
+			result = append(result, comment)
+			continue
+		}
+		fields := strings.Fields(comment)
+		kept := []string{fields[0]}
+		for _, field := range fields[1 : len(fields)-1] {
+			if field != directive && !strings.HasPrefix(field, directive+"=") {
+				kept = append(kept, field)
+			}
+		}
+		if len(kept) > 1 {
+			result = append(result, strings.Join(append(kept, fields[len(fields)-1]), " "))
+		}
+	}
+	return result
+}
@@ -0,0 +1,252 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/planbuilder/abstract"
+)
+
+// decorrelateSubQuery plans a correlated subquery that can not be merged with
+// its outer query as a semi-join, when the subquery is an EXISTS or an IN in
+// a condition of the WHERE clause of the outer query:
+//
+//	select ... from outer where exists (select ... from inner where inner.col = outer.col)
+//	select ... from outer where outer.x in (select inner.y from inner where inner.col = outer.col)
+//
+// The condition is removed from the outer query, and the inner query is
+// executed for each row of the outer query, with the columns of the outer
+// query it depends on as arguments, and with inner.y = outer.x for an IN.
+// The rows of the outer query for which the inner query returns any row are
+// kept. It returns nil when the subquery can not be decorrelated.
+func decorrelateSubQuery(ctx planningContext, outer, inner queryTree, subq *abstract.SubQueryInner, correlated []sqlparser.Expr) (queryTree, error) {
+	innerRoute, ok := inner.(*routeTree)
+	if !ok || !canDecorrelate(subq) {
+		return nil, nil
+	}
+	solved := outer.tableID() | inner.tableID()
+	for _, predicate := range correlated {
+		if !ctx.semTable.BaseTableDependencies(predicate).IsSolvedBy(solved) {
+			// the subquery depends on a query further out
+			return nil, nil
+		}
+	}
+
+	outer, inLHS, err := removeSubQueryPredicate(ctx, outer.clone(), subq.ArgName)
+	if err != nil || outer == nil {
+		return nil, err
+	}
+
+	predicates := correlated
+	if subq.Type == engine.PulloutIn {
+		if inLHS == nil {
+			return nil, nil
+		}
+		predicates = append(predicates, &sqlparser.ComparisonExpr{
+			Operator: sqlparser.EqualOp,
+			Left:     subq.SelectStatement.SelectExprs[0].(*sqlparser.AliasedExpr).Expr,
+			Right:    inLHS,
+		})
+	}
+
+	tree := &joinTree{lhs: outer, rhs: innerRoute.clone(), semi: true, vars: map[string]int{}}
+	return pushJoinPredicate(ctx, predicates, tree)
+}
+
+// canDecorrelate returns whether the inner query returns a row for a row of
+// the outer query exactly when the semi-join finds one: it has no grouping
+// or aggregation, and a limit, if any, does not skip rows.
+func canDecorrelate(subq *abstract.SubQueryInner) bool {
+	sel := subq.SelectStatement
+	switch subq.Type {
+	case engine.PulloutExists:
+		if sel.Limit != nil {
+			rowcount, ok := sel.Limit.Rowcount.(*sqlparser.Literal)
+			if sel.Limit.Offset != nil || !ok || rowcount.Type != sqlparser.IntVal || rowcount.Val == "0" {
+				return false
+			}
+		}
+	case engine.PulloutIn:
+		if len(sel.SelectExprs) != 1 || sel.Limit != nil {
+			return false
+		}
+		if _, ok := sel.SelectExprs[0].(*sqlparser.AliasedExpr); !ok {
+			return false
+		}
+	default:
+		return false
+	}
+	return len(sel.GroupBy) == 0 && sel.Having == nil && !sqlparser.ContainsAggregation(sel.SelectExprs)
+}
+
+// removeSubQueryPredicate removes the condition of the subquery from the
+// tree: the argument of an EXISTS, or the IN comparison with the list
+// argument of an IN, whose left hand side it returns. It returns a nil tree
+// when the subquery is used elsewhere than in such a condition.
+func removeSubQueryPredicate(ctx planningContext, tree queryTree, argName string) (queryTree, sqlparser.Expr, error) {
+	switch node := tree.(type) {
+	case *routeTree:
+		return removeSubQueryPredicateFromRoute(ctx, node, argName)
+	case *joinTree:
+		lhs, lhsIn, err := removeSubQueryPredicate(ctx, node.lhs, argName)
+		if err != nil || lhs == nil {
+			return nil, nil, err
+		}
+		rhs, rhsIn, err := removeSubQueryPredicate(ctx, node.rhs, argName)
+		if err != nil || rhs == nil {
+			return nil, nil, err
+		}
+		if rhsIn != nil && hasArguments(rhsIn) {
+			// the columns of the left hand side were replaced by the
+			// arguments of this join, which the subquery can not use
+			return nil, nil, nil
+		}
+		node.lhs, node.rhs = lhs, rhs
+		if lhsIn != nil {
+			return node, lhsIn, nil
+		}
+		return node, rhsIn, nil
+	}
+	return nil, nil, nil
+}
+
+func removeSubQueryPredicateFromRoute(ctx planningContext, rp *routeTree, argName string) (queryTree, sqlparser.Expr, error) {
+	var inLHS sqlparser.Expr
+	var removed []sqlparser.Expr
+	keep := func(predicates []sqlparser.Expr) ([]sqlparser.Expr, bool) {
+		var kept []sqlparser.Expr
+		for _, predicate := range predicates {
+			if lhs, ok := subQueryPredicate(predicate, argName); ok {
+				removed = append(removed, predicate)
+				if lhs != nil {
+					inLHS = lhs
+				}
+				continue
+			}
+			if mentionsArgument(predicate, argName) {
+				return nil, false
+			}
+			kept = append(kept, predicate)
+		}
+		return kept, true
+	}
+
+	predicates, ok := keep(rp.predicates)
+	if !ok {
+		return nil, nil, nil
+	}
+	rp.predicates = predicates
+
+	tables := make(parenTables, len(rp.tables))
+	copy(tables, rp.tables)
+	for i, rel := range tables {
+		tbl, isTable := rel.(*routeTable)
+		if !isTable {
+			continue
+		}
+		predicates, ok := keep(tbl.qtable.Predicates)
+		if !ok {
+			return nil, nil, nil
+		}
+		qtable := *tbl.qtable
+		qtable.Predicates = predicates
+		tables[i] = &routeTable{qtable: &qtable, vtable: tbl.vtable}
+	}
+	rp.tables = tables
+
+	// the tables nested by outer joins keep their predicates
+	nested := false
+	_ = visitRelations(rp.tables, func(rel relation) (bool, error) {
+		if tbl, isTable := rel.(*routeTable); isTable {
+			for _, predicate := range tbl.qtable.Predicates {
+				nested = nested || mentionsArgument(predicate, argName)
+			}
+		}
+		return !nested, nil
+	})
+	if nested {
+		return nil, nil, nil
+	}
+
+	if rp.selected != nil && containsAnyExpr(rp.selected.predicates, removed) {
+		// the route used the result of the subquery to pick its shards
+		if _, single := rp.tables[0].(*routeTable); !single || len(rp.tables) != 1 {
+			return nil, nil, nil
+		}
+		if err := rp.resetRoutingSelections(ctx); err != nil {
+			return nil, nil, err
+		}
+	}
+	return rp, inLHS, nil
+}
+
+// subQueryPredicate returns whether the predicate is the condition of the
+// subquery with the argument, and the left hand side of an IN condition.
+func subQueryPredicate(predicate sqlparser.Expr, argName string) (sqlparser.Expr, bool) {
+	switch predicate := predicate.(type) {
+	case sqlparser.Argument:
+		return nil, string(predicate) == argName
+	case *sqlparser.ComparisonExpr:
+		listArg, ok := predicate.Right.(sqlparser.ListArg)
+		if predicate.Operator != sqlparser.InOp || !ok || string(listArg) != argName {
+			return nil, false
+		}
+		if _, isTuple := predicate.Left.(sqlparser.ValTuple); isTuple || mentionsArgument(predicate.Left, argName) {
+			return nil, false
+		}
+		return predicate.Left, true
+	}
+	return nil, false
+}
+
+func mentionsArgument(expr sqlparser.Expr, argName string) bool {
+	found := false
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case sqlparser.Argument:
+			found = found || string(node) == argName
+		case sqlparser.ListArg:
+			found = found || string(node) == argName
+		}
+		return !found, nil
+	}, expr)
+	return found
+}
+
+func hasArguments(expr sqlparser.Expr) bool {
+	found := false
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node.(type) {
+		case sqlparser.Argument, sqlparser.ListArg:
+			found = true
+		}
+		return !found, nil
+	}, expr)
+	return found
+}
+
+func containsAnyExpr(exprs, candidates []sqlparser.Expr) bool {
+	for _, expr := range exprs {
+		for _, candidate := range candidates {
+			if expr == candidate {
+				return true
+			}
+		}
+	}
+	return false
+}
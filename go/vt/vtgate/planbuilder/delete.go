@@ -26,7 +26,13 @@ import (
 // buildDeletePlan builds the instructions for a DELETE statement.
 func buildDeletePlan(stmt sqlparser.Statement, reservedVars *sqlparser.ReservedVars, vschema ContextVSchema) (engine.Primitive, error) {
 	del := stmt.(*sqlparser.Delete)
-	var err error
+	size, err := chunkSize(del.Comments)
+	if err != nil {
+		return nil, err
+	}
+	if size > 0 {
+		return buildChunkedDMLPlan(del, size, reservedVars, vschema)
+	}
 	if len(del.TableExprs) == 1 && len(del.Targets) == 1 {
 		del, err = rewriteSingleTbl(del)
 		if err != nil {
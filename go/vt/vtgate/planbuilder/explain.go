@@ -17,6 +17,7 @@ limitations under the License.
 package planbuilder
 
 import (
+	"encoding/json"
 	"strings"
 
 	"vitess.io/vitess/go/sqltypes"
@@ -38,6 +39,8 @@ func buildExplainPlan(stmt sqlparser.Explain, reservedVars *sqlparser.ReservedVa
 			return buildVitessTypePlan(explain, reservedVars, vschema, enableOnlineDDL, enableDirectDDL)
 		}
 		return buildOtherReadAndAdmin(sqlparser.String(explain), vschema)
+	case *sqlparser.VExplainStmt:
+		return buildVExplainPlan(explain, reservedVars, vschema, enableOnlineDDL, enableDirectDDL)
 	}
 	return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "[BUG] unexpected explain type: %T", stmt)
 }
@@ -101,6 +104,112 @@ func buildVitessTypePlan(explain *sqlparser.ExplainStmt, reservedVars *sqlparser
 	return engine.NewRowsPrimitive(rows, fields), nil
 }
 
+// buildVExplainPlan builds a plan that executes the statement and reports how
+// each primitive of its plan executed, or for VEXPLAIN PLAN, a plan that
+// reports the plan of the statement and how its join orders were chosen.
+func buildVExplainPlan(vexplain *sqlparser.VExplainStmt, reservedVars *sqlparser.ReservedVars, vschema ContextVSchema, enableOnlineDDL, enableDirectDDL bool) (engine.Primitive, error) {
+	if vexplain.Kind == sqlparser.VExplainPlan {
+		return buildVExplainJoinOrdersPlan(vexplain, reservedVars, vschema, enableOnlineDDL, enableDirectDDL)
+	}
+
+	input, err := createInstructionFor(sqlparser.String(vexplain.Statement), vexplain.Statement, reservedVars, vschema, enableOnlineDDL, enableDirectDDL)
+	if err != nil {
+		return nil, err
+	}
+
+	return &engine.VExplain{
+		Input: input,
+		JSON:  vexplain.Type == sqlparser.JSONType,
+	}, nil
+}
+
+// buildVExplainJoinOrdersPlan builds the plan of the statement, without
+// executing it, and returns its primitives followed by the join orders the
+// gen4 planner considered for each query graph of the statement, with their
+// estimated costs and rows.
+func buildVExplainJoinOrdersPlan(vexplain *sqlparser.VExplainStmt, reservedVars *sqlparser.ReservedVars, vschema ContextVSchema, enableOnlineDDL, enableDirectDDL bool) (engine.Primitive, error) {
+	joinOrders := &joinOrderRecorder{}
+	input, err := createInstructionFor(sqlparser.String(vexplain.Statement), vexplain.Statement, reservedVars, &joinOrderVSchema{ContextVSchema: vschema, joinOrders: joinOrders}, enableOnlineDDL, enableDirectDDL)
+	if err != nil {
+		return nil, err
+	}
+	description := engine.PrimitiveToPlanDescription(input)
+
+	if vexplain.Type == sqlparser.JSONType {
+		data, err := json.MarshalIndent(struct {
+			Plan       engine.PrimitiveDescription
+			JoinOrders []*joinOrderDecision `json:",omitempty"`
+		}{
+			Plan:       description,
+			JoinOrders: joinOrders.decisions,
+		}, "", "\t")
+		if err != nil {
+			return nil, err
+		}
+		fields := []*querypb.Field{{Name: "plan", Type: querypb.Type_VARCHAR}}
+		return engine.NewRowsPrimitive([][]sqltypes.Value{{sqltypes.NewVarChar(string(data))}}, fields), nil
+	}
+
+	var rows [][]sqltypes.Value
+	for _, line := range treeLines(description) {
+		keyspaceName := ""
+		if line.descr.Keyspace != nil {
+			keyspaceName = line.descr.Keyspace.Name
+		}
+		rows = append(rows, []sqltypes.Value{
+			sqltypes.NewVarChar(line.header + line.descr.OperatorType), // operator
+			sqltypes.NewVarChar(line.descr.Variant),                    // variant
+			sqltypes.NewVarChar(keyspaceName),                          // keyspace
+			sqltypes.NewVarChar(extractQuery(line.descr.Other)),        // query
+			sqltypes.NULL, // cost
+			sqltypes.NULL, // estimated_rows
+		})
+	}
+	for _, decision := range joinOrders.decisions {
+		rows = append(rows, []sqltypes.Value{
+			sqltypes.NewVarChar("JoinOrder"),
+			sqltypes.NewVarChar(decision.Strategy),
+			sqltypes.NewVarChar(""),
+			sqltypes.NewVarChar(""),
+			sqltypes.NULL,
+			sqltypes.NULL,
+		})
+		for i, alternative := range decision.Alternatives {
+			header := "├─ "
+			if i == len(decision.Alternatives)-1 {
+				header = "└─ "
+			}
+			operator := "Considered"
+			if i == decision.Chosen {
+				operator = "Chosen"
+			}
+			estimatedRows := sqltypes.NULL
+			if alternative.EstimatedRows > 0 {
+				estimatedRows = sqltypes.NewFloat64(alternative.EstimatedRows)
+			}
+			rows = append(rows, []sqltypes.Value{
+				sqltypes.NewVarChar(header + operator),
+				sqltypes.NewVarChar(""),
+				sqltypes.NewVarChar(""),
+				sqltypes.NewVarChar(strings.Join(alternative.Tables, ", ")),
+				sqltypes.NewInt64(int64(alternative.Cost)),
+				estimatedRows,
+			})
+		}
+	}
+
+	fields := []*querypb.Field{
+		{Name: "operator", Type: querypb.Type_VARCHAR},
+		{Name: "variant", Type: querypb.Type_VARCHAR},
+		{Name: "keyspace", Type: querypb.Type_VARCHAR},
+		{Name: "query", Type: querypb.Type_VARCHAR},
+		{Name: "cost", Type: querypb.Type_INT64},
+		{Name: "estimated_rows", Type: querypb.Type_FLOAT64},
+	}
+
+	return engine.NewRowsPrimitive(rows, fields), nil
+}
+
 func extractQuery(m map[string]interface{}) string {
 	queryObj, ok := m["Query"]
 	if !ok {
@@ -128,6 +128,9 @@ func newPlanningContext(reservedVars *sqlparser.ReservedVars, semTable *semantic
 		vschema:      vschema,
 		sqToReplace:  map[string]*sqlparser.Select{},
 	}
+	if vschema, ok := vschema.(*joinOrderVSchema); ok {
+		ctx.joinOrders = vschema.joinOrders
+	}
 	return ctx
 }
 
@@ -158,6 +161,9 @@ func checkUnsupportedConstructs(sel *sqlparser.Select) error {
 	if sel.SQLCalcFoundRows {
 		return semantics.Gen4NotSupportedF("sql_calc_found_rows")
 	}
+	if hasWindowFunctions(sel) {
+		return errWindowFunctions
+	}
 	return nil
 }
 
@@ -703,14 +703,107 @@ func (hp *horizonPlanning) planDistinct(ctx planningContext, plan logicalPlan) (
 
 		return hp.addDistinct(ctx, plan)
 	case *joinGen4:
+		// the routes of the join dedup their rows on the shards, which leaves
+		// fewer rows to join, and to dedup again at the vtgate level if the
+		// rows of the join are not known to be unique already
+		if distinctRows(ctx, p) && len(p.Cols) == len(hp.qp.SelectExprs) {
+			return plan, nil
+		}
 		return hp.addDistinct(ctx, plan)
 	case *orderedAggregate:
+		if groupedBySelectExprs(p, hp.qp.SelectExprs) {
+			// the rows are unique on the grouping keys, which are all selected
+			return plan, nil
+		}
 		return hp.planDistinctOA(p)
 	default:
 		return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "unknown plan type for DISTINCT %T", plan)
 	}
 }
 
+// distinctRows makes the routes of the plan distinct where it can, and
+// returns whether all the rows of the plan are unique then. The rows of a
+// route are unique when it targets one shard, or when it selects a column
+// of a unique vindex, which keeps the rows with the same values on the same
+// shard. The rows of a join are unique when the rows of its inputs are, and
+// it returns all their columns.
+func distinctRows(ctx planningContext, plan logicalPlan) bool {
+	switch p := plan.(type) {
+	case *route:
+		sel, ok := p.Select.(*sqlparser.Select)
+		if !ok || sel.Limit != nil {
+			return false
+		}
+		sel.MakeDistinct()
+		if p.isSingleShard() {
+			return true
+		}
+		for _, expr := range sel.SelectExprs {
+			aliased, ok := expr.(*sqlparser.AliasedExpr)
+			if ok && exprIsUniqueVindexColumn(ctx.vschema, ctx.semTable, aliased.Expr) {
+				return true
+			}
+		}
+		return false
+	case *joinGen4:
+		lhsDistinct := distinctRows(ctx, p.Left)
+		if p.Opcode == engine.SemiJoin {
+			// the right hand side only tells whether a row of the left
+			// hand side is returned
+			return lhsDistinct && joinReturnsAllColumns(p, p.Left, -1)
+		}
+		rhsDistinct := distinctRows(ctx, p.Right)
+		return lhsDistinct && rhsDistinct &&
+			joinReturnsAllColumns(p, p.Left, -1) &&
+			joinReturnsAllColumns(p, p.Right, 1)
+	}
+	return false
+}
+
+// joinReturnsAllColumns returns whether the join returns all the columns
+// of one of its inputs, whose offsets in the columns of the join have the
+// given sign.
+func joinReturnsAllColumns(join *joinGen4, input logicalPlan, sign int) bool {
+	var columns int
+	switch input := input.(type) {
+	case *route:
+		sel, ok := input.Select.(*sqlparser.Select)
+		if !ok {
+			return false
+		}
+		columns = len(sel.SelectExprs)
+	case *joinGen4:
+		columns = len(input.Cols)
+	default:
+		return false
+	}
+	returned := make(map[int]bool, columns)
+	for _, col := range join.Cols {
+		if col*sign > 0 {
+			returned[col*sign-1] = true
+		}
+	}
+	return len(returned) == columns
+}
+
+// groupedBySelectExprs returns whether all the grouping keys of the
+// aggregation are selected, in which case the rows are already distinct.
+func groupedBySelectExprs(oa *orderedAggregate, selectExprs []abstract.SelectExpr) bool {
+	for _, key := range oa.eaggr.GroupByKeys {
+		found := false
+		for _, sExpr := range selectExprs {
+			if !sExpr.Aggr && key.Expr != nil && sqlparser.EqualsExpr(sExpr.Col.Expr, key.Expr) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
 func (hp *horizonPlanning) planDistinctOA(currPlan *orderedAggregate) (logicalPlan, error) {
 	eaggr := &engine.OrderedAggregate{}
 	oa := &orderedAggregate{
@@ -0,0 +1,203 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"vitess.io/vitess/go/vt/vtgate/planbuilder/abstract"
+)
+
+const (
+	// exhaustiveJoinOrder is the strategy of the query graphs whose join
+	// orders are all enumerated.
+	exhaustiveJoinOrder = "Exhaustive"
+
+	// greedyJoinOrder is the strategy of the query graphs joined greedily.
+	greedyJoinOrder = "Greedy"
+)
+
+type (
+	// joinOrderAlternative is a join order the planner considered for the
+	// tables of a query graph, with its estimated cost.
+	joinOrderAlternative struct {
+		Tables        []string
+		Cost          int
+		EstimatedRows float64 `json:",omitempty"`
+	}
+
+	// joinOrderDecision is the join order the planner chose for the tables
+	// of a query graph, among the alternatives it considered.
+	joinOrderDecision struct {
+		Strategy     string
+		Chosen       int
+		Alternatives []joinOrderAlternative
+	}
+
+	// joinOrderRecorder collects the join order decisions of the query
+	// graphs of a query, for VEXPLAIN PLAN.
+	joinOrderRecorder struct {
+		decisions []*joinOrderDecision
+	}
+
+	// joinOrderVSchema is the ContextVSchema the plans of VEXPLAIN PLAN are
+	// built with, so that the planning context records the join orders.
+	joinOrderVSchema struct {
+		ContextVSchema
+		joinOrders *joinOrderRecorder
+	}
+)
+
+func (r *joinOrderRecorder) record(decision *joinOrderDecision) {
+	if r != nil {
+		r.decisions = append(r.decisions, decision)
+	}
+}
+
+// canEnumerateJoinOrders returns whether all the join orders of the tables
+// of a query graph are enumerated: the graph joins at most as many tables
+// as the gen4_join_order_tables flag allows, and the statistics of all of
+// them are known, without which all the orders would cost the same.
+func canEnumerateJoinOrders(ctx planningContext, seeds []queryTree) bool {
+	if len(seeds) < 2 || len(seeds) > ctx.vschema.JoinOrderTables() {
+		return false
+	}
+	for _, seed := range seeds {
+		if _, ok := estimatedRows(seed); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// exhaustiveSolve plans the query graph by enumerating the left deep join
+// orders of its tables and picking the cheapest one, the first one in the
+// order of the tables in the query on ties. Like the greedy planner, it
+// only joins the tables without predicates between them, which produces a
+// cartesian product, when no other table is left to join.
+func exhaustiveSolve(ctx planningContext, qg *abstract.QueryGraph, seeds []queryTree) (queryTree, error) {
+	decision := &joinOrderDecision{Strategy: exhaustiveJoinOrder}
+	var best queryTree
+	var order []string
+	used := make([]bool, len(seeds))
+
+	var enumerate func(acc queryTree) error
+	enumerate = func(acc queryTree) error {
+		if len(order) == len(seeds) {
+			decision.Alternatives = append(decision.Alternatives, newJoinOrderAlternative(acc, order))
+			if best == nil || acc.cost() < best.cost() {
+				best = acc
+				decision.Chosen = len(decision.Alternatives) - 1
+			}
+			return nil
+		}
+
+		connected := false
+		for i, seed := range seeds {
+			if !used[i] && acc != nil && len(qg.GetPredicates(acc.tableID(), seed.tableID())) > 0 {
+				connected = true
+				break
+			}
+		}
+
+		for i, seed := range seeds {
+			if used[i] {
+				continue
+			}
+			next := seed
+			if acc != nil {
+				joinPredicates := qg.GetPredicates(acc.tableID(), seed.tableID())
+				if connected && len(joinPredicates) == 0 {
+					continue
+				}
+				var err error
+				next, err = mergeOrJoinInner(ctx, acc, seed, joinPredicates)
+				if err != nil {
+					return err
+				}
+			}
+			used[i] = true
+			order = append(order, joinOrderTableName(qg.Tables[i]))
+			if err := enumerate(next); err != nil {
+				return err
+			}
+			used[i] = false
+			order = order[:len(order)-1]
+		}
+		return nil
+	}
+
+	if err := enumerate(nil); err != nil {
+		return nil, err
+	}
+	ctx.joinOrders.record(decision)
+	return best, nil
+}
+
+// recordGreedyJoinOrder records the join order the greedy planner chose for
+// a query graph of more than one table.
+func recordGreedyJoinOrder(ctx planningContext, qg *abstract.QueryGraph, tree queryTree) {
+	if ctx.joinOrders == nil || len(qg.Tables) < 2 {
+		return
+	}
+	ctx.joinOrders.record(&joinOrderDecision{
+		Strategy:     greedyJoinOrder,
+		Alternatives: []joinOrderAlternative{newJoinOrderAlternative(tree, joinOrderOf(tree))},
+	})
+}
+
+func newJoinOrderAlternative(tree queryTree, tables []string) joinOrderAlternative {
+	alternative := joinOrderAlternative{
+		Tables: append([]string(nil), tables...),
+		Cost:   tree.cost(),
+	}
+	if rows, ok := estimatedRows(tree); ok {
+		alternative.EstimatedRows = rows
+	}
+	return alternative
+}
+
+// joinOrderOf returns the tables of the tree, in the order it joins them.
+func joinOrderOf(tree queryTree) []string {
+	switch tree := tree.(type) {
+	case *joinTree:
+		return append(joinOrderOf(tree.lhs), joinOrderOf(tree.rhs)...)
+	case *routeTree:
+		var tables []string
+		_ = visitRelations(tree.tables, func(rel relation) (bool, error) {
+			switch rel := rel.(type) {
+			case *routeTable:
+				tables = append(tables, joinOrderTableName(rel.qtable))
+			case *derivedTable:
+				tables = append(tables, rel.alias)
+				return false, nil
+			}
+			return true, nil
+		})
+		return tables
+	case *derivedTree:
+		return []string{tree.alias}
+	}
+	return nil
+}
+
+// joinOrderTableName returns the name of the table in the join orders: its
+// alias, or else its name.
+func joinOrderTableName(table *abstract.QueryTable) string {
+	if table.Alias != nil && !table.Alias.As.IsEmpty() {
+		return table.Alias.As.String()
+	}
+	return table.Table.Name.String()
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+)
+
+func TestGen4JoinOrders(t *testing.T) {
+	vschema := &vschemaWrapper{
+		v:               loadSchema(t, "schema_test.json"),
+		version:         Gen4,
+		joinOrderTables: 6,
+	}
+	tables := vschema.v.Keyspaces["user"].Tables
+	query := "select user.id from user, user_extra, music where user.col = user_extra.col and user_extra.col = music.col"
+
+	// Without statistics, the tables are joined greedily.
+	plan, err := TestBuilder("vexplain plan "+query, vschema, vschema.currentDb())
+	require.NoError(t, err)
+	qr, err := plan.Instructions.TryExecute(nil, nil, false)
+	require.NoError(t, err)
+	last := qr.Rows[len(qr.Rows)-2:]
+	assert.Equal(t, `[[VARCHAR("JoinOrder") VARCHAR("Greedy") VARCHAR("") VARCHAR("") NULL NULL] [VARCHAR("└─ Chosen") VARCHAR("") VARCHAR("") VARCHAR("music, user, user_extra") INT64(60) NULL]]`, fmt.Sprintf("%v", last))
+
+	tables["user"].Statistics = &vindexes.TableStatistics{RowCount: 1000, Cardinalities: map[string]uint64{"col": 1000}}
+	tables["user_extra"].Statistics = &vindexes.TableStatistics{RowCount: 10, Cardinalities: map[string]uint64{"col": 10}}
+	tables["music"].Statistics = &vindexes.TableStatistics{RowCount: 100, Cardinalities: map[string]uint64{"col": 100}}
+	defer func() {
+		for _, table := range tables {
+			table.Statistics = nil
+		}
+	}()
+
+	// The smallest table drives the joins, and the orders that start with a
+	// cartesian product are not considered.
+	plan, err = TestBuilder(query, vschema, vschema.currentDb())
+	require.NoError(t, err)
+	outer := plan.Instructions.(*engine.Join)
+	assert.Contains(t, outer.Left.(*engine.Join).Left.(*engine.Route).Query, "from user_extra")
+	assert.Contains(t, outer.Left.(*engine.Join).Right.(*engine.Route).Query, "from `user`")
+	assert.Contains(t, outer.Right.(*engine.Route).Query, "from music")
+
+	plan, err = TestBuilder("vexplain plan "+query, vschema, vschema.currentDb())
+	require.NoError(t, err)
+	qr, err = plan.Instructions.TryExecute(nil, nil, false)
+	require.NoError(t, err)
+	var joinOrders []string
+	for _, row := range qr.Rows[len(qr.Rows)-5:] {
+		joinOrders = append(joinOrders, fmt.Sprintf("%s|%s|%s|%s", row[0].ToString(), row[1].ToString(), row[3].ToString(), row[4].ToString()))
+	}
+	assert.Equal(t, []string{
+		"JoinOrder|Exhaustive||",
+		"├─ Considered||user, user_extra, music|40020",
+		"├─ Chosen||user_extra, user, music|420",
+		"├─ Considered||user_extra, music, user|420",
+		"└─ Considered||music, user_extra, user|4020",
+	}, joinOrders)
+
+	plan, err = TestBuilder("vexplain plan format = json "+query, vschema, vschema.currentDb())
+	require.NoError(t, err)
+	qr, err = plan.Instructions.TryExecute(nil, nil, false)
+	require.NoError(t, err)
+	require.Len(t, qr.Rows, 1)
+	assert.Contains(t, qr.Rows[0][0].ToString(), `"Strategy": "Exhaustive"`)
+	assert.Contains(t, qr.Rows[0][0].ToString(), `"OperatorType": "Join"`)
+
+	// The greedy only planner does not enumerate the join orders.
+	vschema.version = Gen4GreedyOnly
+	plan, err = TestBuilder("vexplain plan "+query, vschema, vschema.currentDb())
+	require.NoError(t, err)
+	qr, err = plan.Instructions.TryExecute(nil, nil, false)
+	require.NoError(t, err)
+	assert.Equal(t, "Greedy", qr.Rows[len(qr.Rows)-2][1].ToString())
+}
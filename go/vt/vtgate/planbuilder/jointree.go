@@ -32,6 +32,10 @@ type joinTree struct {
 	lhs, rhs queryTree
 
 	outer bool
+
+	// semi makes the join a semi-join, which keeps the rows of the LHS for
+	// which the RHS returns any row
+	semi bool
 }
 
 var _ queryTree = (*joinTree)(nil)
@@ -45,13 +49,24 @@ func (jp *joinTree) clone() queryTree {
 		lhs:   jp.lhs.clone(),
 		rhs:   jp.rhs.clone(),
 		outer: jp.outer,
+		semi:  jp.semi,
 		vars:  jp.vars,
 	}
 	return result
 }
 
+// cost implements the queryTree interface. When the statistics of the tables
+// are known, the right hand side counts once for each row that the left hand
+// side is estimated to return, since it is executed for each of them.
 func (jp *joinTree) cost() int {
-	return jp.lhs.cost() + jp.rhs.cost()
+	lhsRows, ok := estimatedRows(jp.lhs)
+	if ok {
+		_, ok = estimatedRows(jp.rhs)
+	}
+	if !ok {
+		return jp.lhs.cost() + jp.rhs.cost()
+	}
+	return jp.lhs.cost() + int(lhsRows)*jp.rhs.cost()
 }
 
 func (jp *joinTree) pushOutputColumns(columns []*sqlparser.ColName, semTable *semantics.SemTable) ([]int, error) {
@@ -0,0 +1,228 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/masking"
+	"vitess.io/vitess/go/vt/vtgate/semantics"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+)
+
+// buildMaskedPlan builds the plan of the select statement, and puts a Mask
+// primitive on top of it when columns of its results come from columns that
+// the masking policy masks. The origin of the columns is found by the
+// semantic analysis of the statement, whatever the planner, before the plan
+// is built, since the planners can change the statement.
+func buildMaskedPlan(stmt sqlparser.SelectStatement, vschema ContextVSchema, build func() (engine.Primitive, error)) (engine.Primitive, error) {
+	policy := vschema.MaskingPolicy()
+	if policy.IsEmpty() {
+		return build()
+	}
+	mask := columnMasks(stmt, vschema, policy)
+	plan, err := build()
+	if err != nil || plan == nil || (len(mask.Columns) == 0 && len(mask.Unresolved) == 0) {
+		return plan, err
+	}
+	mask.Input = plan
+	return mask, nil
+}
+
+type masker struct {
+	policy   *masking.Policy
+	semTable *semantics.SemTable
+}
+
+// columnMasks returns the masks of the columns of the results of the
+// statement. The selects of a union are analyzed one by one, and the results
+// of all the masked tables of a select that can not be analyzed are
+// unresolved.
+func columnMasks(stmt sqlparser.SelectStatement, vschema ContextVSchema, policy *masking.Policy) *engine.Mask {
+	mask := &engine.Mask{}
+	ksName := ""
+	if ks, _ := vschema.DefaultKeyspace(); ks != nil {
+		ksName = ks.Name
+	}
+	for _, sel := range leafSelects(sqlparser.CloneSelectStatement(stmt)) {
+		semTable, err := semantics.Analyze(sel, ksName, vschema, starRewrite)
+		if err != nil {
+			mask.Unresolved = append(mask.Unresolved, statementRules(sel, ksName, vschema, policy)...)
+			continue
+		}
+		m := &masker{policy: policy, semTable: semTable}
+		known := true
+		for i, expr := range sel.SelectExprs {
+			switch expr := expr.(type) {
+			case *sqlparser.AliasedExpr:
+				rules, derived := m.exprRules(expr.Expr)
+				if len(rules) == 0 {
+					continue
+				}
+				if !known {
+					mask.Unresolved = append(mask.Unresolved, rules...)
+					continue
+				}
+				mask.Columns = addMaskedColumn(mask.Columns, engine.MaskedColumn{Col: i, Rules: rules, Derived: derived})
+			case *sqlparser.StarExpr:
+				// the star was not expanded, so the columns it selects, and
+				// the offsets of the ones after it, are not known
+				known = false
+				mask.Unresolved = append(mask.Unresolved, m.starRules(sel, expr)...)
+			}
+		}
+	}
+	return mask
+}
+
+// addMaskedColumn adds the rules of the column to the ones of the same
+// column of another select of a union.
+func addMaskedColumn(columns []engine.MaskedColumn, col engine.MaskedColumn) []engine.MaskedColumn {
+	for i := range columns {
+		if columns[i].Col == col.Col {
+			columns[i].Rules = append(columns[i].Rules, col.Rules...)
+			columns[i].Derived = true
+			return columns
+		}
+	}
+	return append(columns, col)
+}
+
+// exprRules returns the rules of the masked columns the expression uses,
+// and whether, rather than one of them, it is an expression on them.
+func (m *masker) exprRules(expr sqlparser.Expr) ([]*masking.Rule, bool) {
+	if col, ok := expr.(*sqlparser.ColName); ok {
+		return m.columnRules(col)
+	}
+	var rules []*masking.Rule
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if col, ok := node.(*sqlparser.ColName); ok {
+			colRules, _ := m.columnRules(col)
+			rules = append(rules, colRules...)
+		}
+		return true, nil
+	}, expr)
+	return rules, true
+}
+
+// columnRules returns the rules of the column: the one of the column itself
+// when it belongs to a table, or the ones of the expression it is a column
+// of when it belongs to a derived table.
+func (m *masker) columnRules(col *sqlparser.ColName) ([]*masking.Rule, bool) {
+	deps := m.semTable.Dependencies(col)
+	if deps.NumberOfTables() == 1 {
+		info, err := m.semTable.TableInfoFor(deps)
+		if err == nil {
+			if table := vindexTableOf(info); table != nil {
+				if rule := m.policy.Rule(table.Keyspace.Name, table.Name.String(), col.Name.String()); rule != nil {
+					return []*masking.Rule{rule}, false
+				}
+				return nil, false
+			}
+			if inner, err := info.GetExprFor(col.Name.String()); err == nil {
+				return m.exprRules(inner)
+			}
+		}
+	}
+	// the column is not found, so it may be any column of its tables
+	return m.tableRules(m.semTable.BaseTableDependencies(col)), true
+}
+
+// starRules returns the rules of the tables of the unexpanded star.
+func (m *masker) starRules(sel *sqlparser.Select, star *sqlparser.StarExpr) []*masking.Rule {
+	var rules []*masking.Rule
+	for _, info := range m.semTable.GetSelectTables(sel) {
+		if !star.TableName.IsEmpty() && !info.Matches(star.TableName) {
+			continue
+		}
+		if table := vindexTableOf(info); table != nil {
+			rules = append(rules, m.policy.TableRules(table.Keyspace.Name, table.Name.String())...)
+			continue
+		}
+		rules = append(rules, m.tableRules(m.semTable.TableSetFor(info.GetExpr()))...)
+	}
+	return rules
+}
+
+// tableRules returns the rules of all the masked columns of the tables.
+func (m *masker) tableRules(tables semantics.TableSet) []*masking.Rule {
+	var rules []*masking.Rule
+	for _, ts := range tables.Constituents() {
+		info, err := m.semTable.TableInfoFor(ts)
+		if err != nil {
+			continue
+		}
+		if table := vindexTableOf(info); table != nil {
+			rules = append(rules, m.policy.TableRules(table.Keyspace.Name, table.Name.String())...)
+		}
+	}
+	return rules
+}
+
+func vindexTableOf(info semantics.TableInfo) *vindexes.Table {
+	var table *vindexes.Table
+	switch info := info.(type) {
+	case *semantics.RealTable:
+		table = info.Table
+	case *semantics.AliasedTable:
+		table = info.Table
+	}
+	if table == nil || table.Keyspace == nil {
+		return nil
+	}
+	return table
+}
+
+// statementRules returns the rules of all the masked tables of the
+// statement.
+func statementRules(stmt sqlparser.SelectStatement, ksName string, vschema ContextVSchema, policy *masking.Policy) []*masking.Rule {
+	var rules []*masking.Rule
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		name, ok := node.(sqlparser.TableName)
+		if !ok {
+			return true, nil
+		}
+		keyspace, table := ksName, name.Name.String()
+		if !name.Qualifier.IsEmpty() {
+			keyspace = name.Qualifier.String()
+		}
+		if vtable, _, _, _, _, err := vschema.FindTableOrVindex(name); err == nil && vtable != nil && vtable.Keyspace != nil {
+			keyspace, table = vtable.Keyspace.Name, vtable.Name.String()
+		}
+		rules = append(rules, policy.TableRules(keyspace, table)...)
+		return true, nil
+	}, stmt)
+	return rules
+}
+
+// leafSelects returns the selects of the statement whose select
+// expressions are the columns of its results.
+func leafSelects(stmt sqlparser.SelectStatement) []*sqlparser.Select {
+	switch stmt := stmt.(type) {
+	case *sqlparser.Select:
+		return []*sqlparser.Select{stmt}
+	case *sqlparser.ParenSelect:
+		return leafSelects(stmt.Select)
+	case *sqlparser.Union:
+		selects := leafSelects(stmt.FirstStatement)
+		for _, us := range stmt.UnionSelects {
+			selects = append(selects, leafSelects(us.Statement)...)
+		}
+		return selects
+	}
+	return nil
+}
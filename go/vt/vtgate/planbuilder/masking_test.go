@@ -0,0 +1,91 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/masking"
+)
+
+func TestMaskedPlans(t *testing.T) {
+	col1 := &masking.Rule{Keyspace: "user", Table: "authoritative", Column: "col1", Method: masking.Partial, Visible: 2}
+	ssn := &masking.Rule{Keyspace: "user", Table: "user", Column: "ssn", Method: masking.Hash}
+	policy, err := masking.NewPolicy([]*masking.Rule{col1, ssn})
+	require.NoError(t, err)
+
+	tcases := []struct {
+		query      string
+		columns    []engine.MaskedColumn
+		unresolved []*masking.Rule
+		// v3Only is set for the queries gen4 does not plan
+		v3Only bool
+	}{{
+		query: "select id from user",
+	}, {
+		query:   "select col1, user_id from authoritative",
+		columns: []engine.MaskedColumn{{Col: 0, Rules: []*masking.Rule{col1}}},
+	}, {
+		query:   "select * from authoritative",
+		columns: []engine.MaskedColumn{{Col: 1, Rules: []*masking.Rule{col1}}},
+	}, {
+		query:   "select user_id, concat(col1, 'x') from authoritative",
+		columns: []engine.MaskedColumn{{Col: 1, Rules: []*masking.Rule{col1}, Derived: true}},
+	}, {
+		query:   "select t.c from (select col1 as c from authoritative) as t",
+		columns: []engine.MaskedColumn{{Col: 0, Rules: []*masking.Rule{col1}}},
+	}, {
+		query:   "select u.id, u.ssn from user as u",
+		columns: []engine.MaskedColumn{{Col: 1, Rules: []*masking.Rule{ssn}}},
+	}, {
+		query:      "select * from user",
+		unresolved: []*masking.Rule{ssn},
+		v3Only:     true,
+	}, {
+		query:   "select id from user union all select col1 from authoritative",
+		columns: []engine.MaskedColumn{{Col: 0, Rules: []*masking.Rule{col1}}},
+	}}
+	for _, version := range []PlannerVersion{V3, Gen4} {
+		vschema := &vschemaWrapper{
+			v:             loadSchema(t, "schema_test.json"),
+			version:       version,
+			maskingPolicy: policy,
+		}
+		for _, tcase := range tcases {
+			if tcase.v3Only && version != V3 {
+				continue
+			}
+			t.Run(version.String()+" "+tcase.query, func(t *testing.T) {
+				plan, err := TestBuilder(tcase.query, vschema, vschema.currentDb())
+				require.NoError(t, err)
+				mask, ok := plan.Instructions.(*engine.Mask)
+				if tcase.columns == nil && tcase.unresolved == nil {
+					assert.False(t, ok, "unexpected mask")
+					return
+				}
+				require.True(t, ok, "expected a mask: %T", plan.Instructions)
+				assert.Equal(t, tcase.columns, mask.Columns)
+				assert.Equal(t, tcase.unresolved, mask.Unresolved)
+				assert.NotNil(t, mask.Input)
+			})
+		}
+	}
+}
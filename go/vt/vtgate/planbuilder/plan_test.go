@@ -43,6 +43,7 @@ import (
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/masking"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
 
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
@@ -419,12 +420,23 @@ type vschemaWrapper struct {
 	dest          key.Destination
 	sysVarEnabled bool
 	version       PlannerVersion
+
+	joinOrderTables int
+	maskingPolicy   *masking.Policy
 }
 
 func (vw *vschemaWrapper) ForeignKeyMode() string {
 	return "allow"
 }
 
+func (vw *vschemaWrapper) JoinOrderTables() int {
+	return vw.joinOrderTables
+}
+
+func (vw *vschemaWrapper) MaskingPolicy() *masking.Policy {
+	return vw.maskingPolicy
+}
+
 func (vw *vschemaWrapper) AllKeyspace() ([]*vindexes.Keyspace, error) {
 	if vw.keyspace == nil {
 		return nil, errors.New("keyspace not available")
@@ -144,5 +144,18 @@ func createLimit(input logicalPlan, limit *sqlparser.Limit) (logicalPlan, error)
 		plan.elimit.Offset = pv
 	}
 
+	// the merge of the rows of a merge-sorted route right below the limit
+	// can stop once it has produced the rows the limit needs
+	if ms, ok := input.(*mergeSort); ok {
+		input = ms.input
+	}
+	if rb, ok := input.(*route); ok && len(rb.eroute.OrderBy) > 0 {
+		pv, err := sqlparser.NewPlanValue(sqlparser.NewArgument("__upper_limit"))
+		if err != nil {
+			return nil, err
+		}
+		rb.eroute.UpperLimit = pv
+	}
+
 	return plan, nil
 }
@@ -217,8 +217,17 @@ func transformJoinPlan(ctx planningContext, n *joinTree, semTable *semantics.Sem
 		return nil, err
 	}
 	opCode := engine.InnerJoin
-	if n.outer {
+	switch {
+	case n.outer:
 		opCode = engine.LeftJoin
+	case n.semi:
+		opCode = engine.SemiJoin
+		if rb, isRoute := rhs.(*route); isRoute {
+			// the RHS only needs to tell whether it has any row
+			sel := rb.Select.(*sqlparser.Select)
+			sel.SelectExprs = sqlparser.SelectExprs{&sqlparser.AliasedExpr{Expr: sqlparser.NewIntLiteral("1")}}
+			sel.Limit = &sqlparser.Limit{Rowcount: sqlparser.NewIntLiteral("1")}
+		}
 	}
 	return &joinGen4{
 		Left:   lhs,
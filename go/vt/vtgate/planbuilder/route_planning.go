@@ -39,6 +39,9 @@ type planningContext struct {
 	vschema      ContextVSchema
 	// these helps in replacing the argNames with the subquery
 	sqToReplace map[string]*sqlparser.Select
+	// joinOrders records the join orders of the query graphs of the query,
+	// when its plan is built for VEXPLAIN PLAN
+	joinOrders *joinOrderRecorder
 }
 
 func (c planningContext) isSubQueryToReplace(name string) bool {
@@ -115,6 +118,20 @@ func optimizeSubQuery(ctx planningContext, op *abstract.SubQuery) (queryTree, er
 		}
 
 		merged, err := tryMerge(ctx, outerTree, treeInner, preds, merger)
+		if merged == nil && len(preds) > 0 {
+			// the correlated subqueries that can not be merged, e.g.
+			// because they are in another keyspace, become semi-joins
+			decorrelated, decorrelateErr := decorrelateSubQuery(ctx, outerTree, treeInner, inner, preds)
+			if decorrelateErr != nil {
+				return nil, decorrelateErr
+			}
+			if decorrelated == nil && err == nil {
+				return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: cross-shard correlated subquery")
+			}
+			if decorrelated != nil {
+				merged, err = decorrelated, nil
+			}
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -122,9 +139,6 @@ func optimizeSubQuery(ctx planningContext, op *abstract.SubQuery) (queryTree, er
 			return nil, mergeErr
 		}
 		if merged == nil {
-			if len(preds) > 0 {
-				return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: cross-shard correlated subquery")
-			}
 			unmerged = append(unmerged, &subqueryTree{
 				subquery: inner.SelectStatement,
 				inner:    treeInner,
@@ -162,32 +176,59 @@ func mergeSubQuery(ctx planningContext, outer *routeTree, subq *abstract.SubQuer
 }
 
 func exprHasUniqueVindex(vschema ContextVSchema, semTable *semantics.SemTable, expr sqlparser.Expr) bool {
+	col, vschemaTable := columnVSchemaTable(vschema, semTable, expr)
+	if vschemaTable == nil {
+		return false
+	}
+	for _, vindex := range vschemaTable.ColumnVindexes {
+		if len(vindex.Columns) > 1 || !vindex.Vindex.IsUnique() {
+			return false
+		}
+		if col.Name.Equal(vindex.Columns[0]) {
+			return true
+		}
+	}
+	return false
+}
+
+// exprIsUniqueVindexColumn returns whether the expression is the column of
+// a single column unique vindex of its table. Unlike exprHasUniqueVindex,
+// it does not stop at the first multi-column or non-unique vindex.
+func exprIsUniqueVindexColumn(vschema ContextVSchema, semTable *semantics.SemTable, expr sqlparser.Expr) bool {
+	col, vschemaTable := columnVSchemaTable(vschema, semTable, expr)
+	if vschemaTable == nil {
+		return false
+	}
+	for _, vindex := range vschemaTable.ColumnVindexes {
+		if len(vindex.Columns) == 1 && vindex.Vindex.IsUnique() && col.Name.Equal(vindex.Columns[0]) {
+			return true
+		}
+	}
+	return false
+}
+
+// columnVSchemaTable returns the column of the expression and the vschema
+// table it belongs to, or nil if the expression is not a column of a table
+// of the vschema.
+func columnVSchemaTable(vschema ContextVSchema, semTable *semantics.SemTable, expr sqlparser.Expr) (*sqlparser.ColName, *vindexes.Table) {
 	col, isCol := expr.(*sqlparser.ColName)
 	if !isCol {
-		return false
+		return nil, nil
 	}
 	ts := semTable.BaseTableDependencies(expr)
 	tableInfo, err := semTable.TableInfoFor(ts)
 	if err != nil {
-		return false
+		return nil, nil
 	}
 	tableName, err := tableInfo.Name()
 	if err != nil {
-		return false
+		return nil, nil
 	}
 	vschemaTable, _, _, _, _, err := vschema.FindTableOrVindex(tableName)
 	if err != nil {
-		return false
-	}
-	for _, vindex := range vschemaTable.ColumnVindexes {
-		if len(vindex.Columns) > 1 || !vindex.Vindex.IsUnique() {
-			return false
-		}
-		if col.Name.Equal(vindex.Columns[0]) {
-			return true
-		}
+		return nil, nil
 	}
-	return false
+	return col, vschemaTable
 }
 
 func createSingleShardRoutePlan(sel *sqlparser.Select, rb *route) {
@@ -195,6 +236,7 @@ func createSingleShardRoutePlan(sel *sqlparser.Select, rb *route) {
 	ast.Distinct = sel.Distinct
 	ast.GroupBy = sel.GroupBy
 	ast.Having = sel.Having
+	ast.Windows = sel.Windows
 	ast.OrderBy = sel.OrderBy
 	ast.Comments = sel.Comments
 	ast.SelectExprs = sel.SelectExprs
@@ -251,6 +293,7 @@ func pushJoinPredicate(ctx planningContext, exprs []sqlparser.Expr, tree queryTr
 			lhs:   node.lhs,
 			rhs:   rhsPlan,
 			outer: node.outer,
+			semi:  node.semi,
 			vars:  node.vars,
 		}, nil
 	case *derivedTree:
@@ -351,10 +394,15 @@ func greedySolve(ctx planningContext, qg *abstract.QueryGraph) (queryTree, error
 		return nil, err
 	}
 
+	if ctx.vschema.Planner() != Gen4GreedyOnly && canEnumerateJoinOrders(ctx, joinTrees) {
+		return exhaustiveSolve(ctx, qg, joinTrees)
+	}
+
 	tree, err := mergeJoinTrees(ctx, qg, joinTrees, planCache, false)
 	if err != nil {
 		return nil, err
 	}
+	recordGreedyJoinOrder(ctx, qg, tree)
 	return tree, nil
 }
 
@@ -520,6 +520,9 @@ func (rp *routeTree) haveMatchingVindex(
 func (rp *routeTree) pickBestAvailableVindex() {
 	for _, v := range rp.vindexPreds {
 		option := v.bestOption()
+		if option != nil && rp.scatterIsCheaper(v, option) {
+			continue
+		}
 		if option != nil && (rp.selected == nil || less(option.cost, rp.selected.cost)) {
 			rp.selected = option
 			rp.routeOpCode = option.opcode
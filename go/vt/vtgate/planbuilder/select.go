@@ -100,6 +100,7 @@ func shouldRetryWithCNFRewriting(plan logicalPlan) bool {
 
 var errSQLCalcFoundRows = vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.CantUseOptionHere, "Incorrect usage/placement of 'SQL_CALC_FOUND_ROWS'")
 var errInto = vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.CantUseOptionHere, "Incorrect usage/placement of 'INTO'")
+var errWindowFunctions = vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: cross-shard query with window functions")
 
 // processSelect builds a primitive tree for the given query or subquery.
 // The tree built by this function has the following general structure:
@@ -192,6 +193,9 @@ func (pb *primitiveBuilder) processSelect(sel *sqlparser.Select, reservedVars *s
 			return err
 		}
 	}
+	if err := pb.checkWindowFunctions(sel); err != nil {
+		return err
+	}
 	if err := pb.checkAggregates(sel); err != nil {
 		return err
 	}
@@ -572,3 +576,35 @@ func (pb *primitiveBuilder) expandStar(inrcs []*resultColumn, expr *sqlparser.St
 	}
 	return inrcs, true, nil
 }
+
+// checkWindowFunctions only allows window functions in queries that go to a
+// single shard: on a scatter, each window would only see the rows of a shard.
+// The WINDOW clause is pushed down to the route with the window functions.
+func (pb *primitiveBuilder) checkWindowFunctions(sel *sqlparser.Select) error {
+	if !hasWindowFunctions(sel) {
+		return nil
+	}
+	rb, isRoute := pb.plan.(*route)
+	if !isRoute || !rb.isSingleShard() {
+		return errWindowFunctions
+	}
+	// The columns of the WINDOW clause are not pushed with the select
+	// expressions, and need to be resolved here.
+	err := sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if col, ok := node.(*sqlparser.ColName); ok {
+			if _, _, err := pb.st.Find(col); err != nil {
+				return false, err
+			}
+		}
+		return true, nil
+	}, sel.Windows)
+	if err != nil {
+		return err
+	}
+	rb.Select.(*sqlparser.Select).Windows = sel.Windows
+	return nil
+}
+
+func hasWindowFunctions(sel *sqlparser.Select) bool {
+	return len(sel.Windows) > 0 || sqlparser.ContainsWindowFunction(sel.SelectExprs) || sqlparser.ContainsWindowFunction(sel.OrderBy)
+}
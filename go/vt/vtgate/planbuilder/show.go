@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+	"time"
 
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
@@ -27,8 +28,10 @@ import (
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/logutil"
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	vttimepb "vitess.io/vitess/go/vt/proto/vttime"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vtgate/engine"
@@ -76,6 +79,8 @@ func buildShowBasicPlan(show *sqlparser.ShowBasic, vschema ContextVSchema) (engi
 		return buildShowGtidPlan(show, vschema)
 	case sqlparser.Warnings:
 		return buildWarnings()
+	case sqlparser.VitessDDLStatus:
+		return buildShowDDLStatusPlan(show)
 	}
 	return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "[BUG] unknown show query type %s", show.Command.ToString())
 
@@ -565,3 +570,68 @@ func buildWarnings() (engine.Primitive, error) {
 
 	return engine.NewSessionPrimitive("SHOW WARNINGS", f), nil
 }
+
+// buildShowDDLStatusPlan serves `SHOW VITESS_DDL_STATUS ...` queries, with a
+// row for each shard of the DDLs applied to several shards.
+func buildShowDDLStatusPlan(show *sqlparser.ShowBasic) (engine.Primitive, error) {
+	var uuid string
+	filter := regexp.MustCompile(".*")
+	if show.Filter != nil {
+		switch {
+		case show.Filter.Like != "":
+			filter = sqlparser.LikeToRegexp(show.Filter.Like)
+		default:
+			cmpExp, ok := show.Filter.Filter.(*sqlparser.ComparisonExpr)
+			if !ok || cmpExp.Operator != sqlparser.EqualOp {
+				return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "expect a 'LIKE' or '=' expression")
+			}
+			left, ok := cmpExp.Left.(*sqlparser.ColName)
+			if !ok || !left.Name.EqualString("uuid") {
+				return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "expect left side to be 'uuid'")
+			}
+			right, ok := cmpExp.Right.(*sqlparser.Literal)
+			if !ok || right.Type != sqlparser.StrVal {
+				return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "we expect the right side to be a string")
+			}
+			uuid = right.Val
+		}
+	}
+
+	f := func(sa engine.SessionActions) (*sqltypes.Result, error) {
+		statuses, err := sa.GetDDLStatuses(uuid)
+		if err != nil {
+			return nil, err
+		}
+		var rows [][]sqltypes.Value
+		for _, status := range statuses {
+			if !filter.MatchString(status.Uuid) {
+				continue
+			}
+			for _, shard := range status.Shards {
+				rows = append(rows, buildVarCharRow(
+					status.Uuid,
+					status.Keyspace,
+					shard.Shard,
+					status.Sql,
+					strings.ToLower(shard.State.String()),
+					shard.Error,
+					formatDDLStatusTime(status.StartedAt),
+					formatDDLStatusTime(status.CompletedAt),
+				))
+			}
+		}
+		return &sqltypes.Result{
+			Fields: buildVarCharFields("uuid", "keyspace", "shard", "sql", "state", "error", "started_at", "completed_at"),
+			Rows:   rows,
+		}, nil
+	}
+
+	return engine.NewSessionPrimitive("SHOW VITESS_DDL_STATUS", f), nil
+}
+
+func formatDDLStatusTime(t *vttimepb.Time) string {
+	if t == nil {
+		return ""
+	}
+	return logutil.ProtoToTime(t).UTC().Format(time.RFC3339)
+}
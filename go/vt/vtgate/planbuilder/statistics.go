@@ -0,0 +1,158 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"math"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/semantics"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+)
+
+const (
+	// lookupScatterRatio is the fraction of the rows of a table past which
+	// the rows matching the values of a non-unique vindex are expected to
+	// spread over most shards, so that routing with the vindex costs about
+	// as much as a scatter, plus the cost of the vindex itself.
+	lookupScatterRatio = 0.1
+
+	// maxEstimatedRows bounds the estimates, so that the costs computed
+	// from them do not overflow.
+	maxEstimatedRows = 1 << 40
+)
+
+// estimatedRows returns the number of rows a tree is estimated to return,
+// from the statistics of its tables. It only knows of the routes to a
+// single table with statistics, and of the joins between trees it knows
+// of, where the estimate of the right hand side is the rows it returns
+// for each row of the left hand side.
+func estimatedRows(tree queryTree) (float64, bool) {
+	switch tree := tree.(type) {
+	case *routeTree:
+		return tree.estimatedRows()
+	case *joinTree:
+		lhs, ok := estimatedRows(tree.lhs)
+		if !ok {
+			return 0, false
+		}
+		rhs, ok := estimatedRows(tree.rhs)
+		if !ok {
+			return 0, false
+		}
+		return math.Min(lhs*rhs, maxEstimatedRows), true
+	}
+	return 0, false
+}
+
+// estimatedRows returns the number of rows the route to a single table is
+// estimated to return: the rows of the table, reduced by the selectivity
+// of the equality and IN predicates on the columns with a cardinality.
+// The predicates are taken as independent.
+func (rp *routeTree) estimatedRows() (float64, bool) {
+	if len(rp.tables) != 1 || len(rp.leftJoins) > 0 {
+		return 0, false
+	}
+	table, ok := rp.tables[0].(*routeTable)
+	if !ok || table.vtable.Statistics == nil {
+		return 0, false
+	}
+	stats := table.vtable.Statistics
+	rows := float64(stats.RowCount)
+	for _, predicate := range rp.predicates {
+		for _, expr := range sqlparser.SplitAndExpression(nil, predicate) {
+			rows *= predicateSelectivity(stats, expr)
+		}
+	}
+	return math.Max(1, math.Min(rows, maxEstimatedRows)), true
+}
+
+// predicateSelectivity returns the estimated fraction of the rows of the
+// table that satisfy the predicate. All the columns of the predicates of a
+// route to a single table are the ones of the table, the ones of the other
+// tables being arguments.
+func predicateSelectivity(stats *vindexes.TableStatistics, expr sqlparser.Expr) float64 {
+	cmp, ok := expr.(*sqlparser.ComparisonExpr)
+	if !ok || stats.RowCount == 0 {
+		return 1
+	}
+	column, ok := cmp.Left.(*sqlparser.ColName)
+	other := cmp.Right
+	if !ok {
+		column, ok = cmp.Right.(*sqlparser.ColName)
+		other = cmp.Left
+	}
+	if !ok {
+		return 1
+	}
+	if _, isCol := other.(*sqlparser.ColName); isCol {
+		return 1
+	}
+	perValue, ok := stats.RowsPerValue(column.Name)
+	if !ok {
+		return 1
+	}
+	values := 1
+	switch cmp.Operator {
+	case sqlparser.EqualOp:
+	case sqlparser.InOp:
+		tuple, ok := other.(sqlparser.ValTuple)
+		if !ok {
+			return 1
+		}
+		values = len(tuple)
+	default:
+		return 1
+	}
+	return math.Min(1, perValue*float64(values)/float64(stats.RowCount))
+}
+
+// tableStatistics returns the statistics of the table of the route.
+func (rp *routeTree) tableStatistics(id semantics.TableSet) *vindexes.TableStatistics {
+	var stats *vindexes.TableStatistics
+	_ = visitRelations(rp.tables, func(rel relation) (bool, error) {
+		if tbl, ok := rel.(*routeTable); ok && tbl.qtable.TableID == id {
+			stats = tbl.vtable.Statistics
+			return false, nil
+		}
+		return true, nil
+	})
+	return stats
+}
+
+// scatterIsCheaper returns whether a scatter is expected to be cheaper than
+// routing with the non-unique vindex of the option, because the rows that
+// match its values make up a large fraction of the table.
+func (rp *routeTree) scatterIsCheaper(vpp *vindexPlusPredicates, option *vindexOption) bool {
+	if option.foundVindex == nil || option.foundVindex.IsUnique() || len(vpp.colVindex.Columns) != 1 {
+		return false
+	}
+	stats := rp.tableStatistics(vpp.tableID)
+	if stats == nil || stats.RowCount == 0 {
+		return false
+	}
+	perValue, ok := stats.RowsPerValue(vpp.colVindex.Columns[0])
+	if !ok {
+		return false
+	}
+	values := 1
+	if option.opcode == engine.SelectIN && len(option.values) == 1 && len(option.values[0].Values) > 0 {
+		values = len(option.values[0].Values)
+	}
+	return perValue*float64(values) >= lookupScatterRatio*float64(stats.RowCount)
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+)
+
+func TestGen4Statistics(t *testing.T) {
+	vschema := &vschemaWrapper{
+		v:       loadSchema(t, "schema_test.json"),
+		version: Gen4,
+	}
+	user := vschema.v.Keyspaces["user"].Tables["user"]
+	userExtra := vschema.v.Keyspaces["user"].Tables["user_extra"]
+
+	join := "select user.id from user, user_extra where user.col = user_extra.col"
+	lookup := "select id from user where name = 'foo'"
+	plan, err := TestBuilder(join, vschema, vschema.currentDb())
+	require.NoError(t, err)
+	assert.Contains(t, plan.Instructions.(*engine.Join).Left.(*engine.Route).Query, "from `user`", "without statistics, the tables are joined in their order")
+	plan, err = TestBuilder(lookup, vschema, vschema.currentDb())
+	require.NoError(t, err)
+	assert.Equal(t, engine.SelectEqual, plan.Instructions.(*engine.Route).Opcode)
+
+	// The smaller table drives the join, and the name matches half of the
+	// users, which are then likely to be on every shard.
+	user.Statistics = &vindexes.TableStatistics{RowCount: 1000, Cardinalities: map[string]uint64{"id": 1000, "col": 1000, "name": 2}}
+	userExtra.Statistics = &vindexes.TableStatistics{RowCount: 10, Cardinalities: map[string]uint64{"user_id": 10, "col": 10}}
+	plan, err = TestBuilder(join, vschema, vschema.currentDb())
+	require.NoError(t, err)
+	assert.Contains(t, plan.Instructions.(*engine.Join).Left.(*engine.Route).Query, "from user_extra")
+	plan, err = TestBuilder(lookup, vschema, vschema.currentDb())
+	require.NoError(t, err)
+	assert.Equal(t, engine.SelectScatter, plan.Instructions.(*engine.Route).Opcode)
+
+	// A selective name is still worth the lookup.
+	user.Statistics.Cardinalities["name"] = 500
+	plan, err = TestBuilder(lookup, vschema, vschema.currentDb())
+	require.NoError(t, err)
+	assert.Equal(t, engine.SelectEqual, plan.Instructions.(*engine.Route).Opcode)
+}
+
+func TestEstimatedRows(t *testing.T) {
+	stats := &vindexes.TableStatistics{RowCount: 1000, Cardinalities: map[string]uint64{"id": 1000, "status": 4}}
+	rp := &routeTree{tables: parenTables{&routeTable{vtable: &vindexes.Table{Statistics: stats}}}}
+	tcases := []struct {
+		predicates string
+		rows       float64
+	}{
+		{"", 1000},
+		{"status = 'active'", 250},
+		{"status in ('active', 'blocked')", 500},
+		{"status = 'active' and id = :id", 1},
+		{"status > 1", 1000},
+		{"unknown = 1", 1000},
+	}
+	for _, tcase := range tcases {
+		t.Run(tcase.predicates, func(t *testing.T) {
+			rp.predicates = nil
+			if tcase.predicates != "" {
+				stmt, err := sqlparser.Parse("select 1 from t where " + tcase.predicates)
+				require.NoError(t, err)
+				rp.predicates = append(rp.predicates, stmt.(*sqlparser.Select).Where.Expr)
+			}
+			rows, ok := rp.estimatedRows()
+			require.True(t, ok)
+			assert.Equal(t, tcase.rows, rows)
+		})
+	}
+
+	rp.tables = parenTables{&routeTable{vtable: &vindexes.Table{}}}
+	_, ok := rp.estimatedRows()
+	assert.False(t, ok, "no statistics")
+}
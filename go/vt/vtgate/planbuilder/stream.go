@@ -42,10 +42,12 @@ func buildStreamPlan(stmt *sqlparser.Stream, vschema ContextVSchema) (engine.Pri
 	if dest == nil {
 		dest = key.DestinationExactKeyRange{}
 	}
+	directives := sqlparser.ExtractCommentDirectives(stmt.Comments)
 	return &engine.MStream{
 		Keyspace:          table.Keyspace,
 		TargetDestination: dest,
 		TableName:         table.Name.CompliantName(),
+		ConsumerGroup:     directives.GetString(sqlparser.DirectiveConsumerGroup, ""),
 	}, nil
 }
 
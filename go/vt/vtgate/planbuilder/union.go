@@ -54,6 +54,9 @@ func (pb *primitiveBuilder) processUnion(union *sqlparser.Union, reservedVars *s
 		err := unionRouteMerge(pb.plan, rpb.plan, us)
 		if err != nil {
 			// we are merging between two routes - let's check if we can see so that we have the same amount of columns on both sides of the union
+			if us.Type != sqlparser.UnionType {
+				return vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: cross-shard INTERSECT or EXCEPT")
+			}
 			lhsCols := len(pb.plan.ResultColumns())
 			rhsCols := len(rpb.plan.ResultColumns())
 			if lhsCols != rhsCols {
@@ -145,6 +148,11 @@ func unionRouteMerge(left, right logicalPlan, us *sqlparser.UnionSelect) error {
 	if !ok {
 		return errors.New("unsupported: SELECT of UNION is non-trivial")
 	}
+	if us.Type != sqlparser.UnionType && !(lroute.isSingleShard() && rroute.isSingleShard()) {
+		// INTERSECT and EXCEPT of the results of each shard is not the
+		// INTERSECT or EXCEPT of the results of all the shards.
+		return errors.New("unsupported: INTERSECT and EXCEPT can only be executed on a single shard")
+	}
 	mergeSuccess := lroute.MergeUnion(rroute, us.Distinct)
 	if !mergeSuccess {
 		return errors.New("unsupported: UNION cannot be executed as a single route")
@@ -29,6 +29,13 @@ import (
 // buildUpdatePlan builds the instructions for an UPDATE statement.
 func buildUpdatePlan(stmt sqlparser.Statement, reservedVars *sqlparser.ReservedVars, vschema ContextVSchema) (engine.Primitive, error) {
 	upd := stmt.(*sqlparser.Update)
+	size, err := chunkSize(upd.Comments)
+	if err != nil {
+		return nil, err
+	}
+	if size > 0 {
+		return buildChunkedDMLPlan(upd, size, reservedVars, vschema)
+	}
 	dml, ksidVindex, ksidCol, err := buildDMLPlan(vschema, "update", stmt, reservedVars, upd.TableExprs, upd.Where, upd.OrderBy, upd.Limit, upd.Comments, upd.Exprs)
 	if err != nil {
 		return nil, err
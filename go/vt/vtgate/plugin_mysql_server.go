@@ -54,7 +54,7 @@ var (
 	mysqlServerBindAddress        = flag.String("mysql_server_bind_address", "", "Binds on this address when listening to MySQL binary protocol. Useful to restrict listening to 'localhost' only for instance.")
 	mysqlServerSocketPath         = flag.String("mysql_server_socket_path", "", "This option specifies the Unix socket file to use when listening for local connections. By default it will be empty and it won't listen to a unix socket")
 	mysqlTCPVersion               = flag.String("mysql_tcp_version", "tcp", "Select tcp, tcp4, or tcp6 to control the socket type.")
-	mysqlAuthServerImpl           = flag.String("mysql_auth_server_impl", "static", "Which auth server implementation to use. Options: none, ldap, clientcert, static, vault.")
+	mysqlAuthServerImpl           = flag.String("mysql_auth_server_impl", "static", "Which auth server implementation to use. Options: none, ldap, clientcert, static, vault, jwt, chain.")
 	mysqlAllowClearTextWithoutTLS = flag.Bool("mysql_allow_clear_text_without_tls", false, "If set, the server will allow the use of a clear text password over non-SSL connections.")
 	mysqlProxyProtocol            = flag.Bool("proxy_protocol", false, "Enable HAProxy PROXY protocol on MySQL listener socket")
 
@@ -68,8 +68,14 @@ var (
 
 	mysqlSslServerCA = flag.String("mysql_server_ssl_server_ca", "", "path to server CA in PEM format, which will be combine with server cert, return full certificate chain to clients")
 
+	mysqlTLSReload = flag.Bool("mysql_server_tls_reload", false, "Reload the mysql server plugin SSL cert, key and CA whenever their files change, without waiting for a SIGHUP")
+
 	mysqlSlowConnectWarnThreshold = flag.Duration("mysql_slow_connect_warn_threshold", 0, "Warn if it takes more than the given threshold for a mysql connection to establish")
 
+	mysqlMaxConnections        = flag.Int("mysql_server_max_connections", 0, "If set, the maximum number of MySQL protocol connections served at once, over TCP and the unix socket. Connections beyond it wait for up to mysql_server_conn_wait_timeout for a slot to free up, and are refused after that.")
+	mysqlMaxConnectionsPerUser = flag.Int("mysql_server_max_connections_per_user", 0, "If set, the maximum number of MySQL protocol connections served at once for each user.")
+	mysqlConnWaitTimeout       = flag.Duration("mysql_server_conn_wait_timeout", 0, "How long a MySQL protocol connection beyond mysql_server_max_connections or mysql_server_max_connections_per_user waits for a slot to free up. With zero, such connections are refused right away.")
+
 	mysqlConnReadTimeout  = flag.Duration("mysql_server_read_timeout", 0, "connection read timeout")
 	mysqlConnWriteTimeout = flag.Duration("mysql_server_write_timeout", 0, "connection write timeout")
 	mysqlQueryTimeout     = flag.Duration("mysql_server_query_timeout", 0, "mysql query timeout")
@@ -365,7 +371,11 @@ var vtgateHandle *vtgateHandler
 
 // initTLSConfig inits tls config for the given mysql listener
 func initTLSConfig(mysqlListener *mysql.Listener, mysqlSslCert, mysqlSslKey, mysqlSslCa, mysqlSslServerCA string, mysqlServerRequireSecureTransport bool, mysqlMinTLSVersion uint16) error {
-	serverConfig, err := vttls.ServerConfig(mysqlSslCert, mysqlSslKey, mysqlSslCa, mysqlSslServerCA, mysqlMinTLSVersion)
+	serverConfigFunc := vttls.ServerConfig
+	if *mysqlTLSReload {
+		serverConfigFunc = vttls.ServerConfigWithReload
+	}
+	serverConfig, err := serverConfigFunc(mysqlSslCert, mysqlSslKey, mysqlSslCa, mysqlSslServerCA, mysqlMinTLSVersion)
 	if err != nil {
 		log.Exitf("grpcutils.TLSServerConfig failed: %v", err)
 		return err
@@ -376,7 +386,7 @@ func initTLSConfig(mysqlListener *mysql.Listener, mysqlSslCert, mysqlSslKey, mys
 	signal.Notify(sigChan, syscall.SIGHUP)
 	go func() {
 		for range sigChan {
-			serverConfig, err := vttls.ServerConfig(mysqlSslCert, mysqlSslKey, mysqlSslCa, mysqlSslServerCA, mysqlMinTLSVersion)
+			serverConfig, err := serverConfigFunc(mysqlSslCert, mysqlSslKey, mysqlSslCa, mysqlSslServerCA, mysqlMinTLSVersion)
 			if err != nil {
 				log.Errorf("grpcutils.TLSServerConfig failed: %v", err)
 			} else {
@@ -420,6 +430,12 @@ func initMySQLProtocol() {
 		log.Exitf("-mysql_tcp_version must be one of [tcp, tcp4, tcp6]")
 	}
 
+	// The connection limits are shared by the TCP and unix socket listeners.
+	var connLimiter *mysql.ConnLimiter
+	if *mysqlMaxConnections > 0 || *mysqlMaxConnectionsPerUser > 0 {
+		connLimiter = mysql.NewConnLimiter(*mysqlMaxConnections, *mysqlMaxConnectionsPerUser, *mysqlConnWaitTimeout)
+	}
+
 	// Create a Listener.
 	var err error
 	vtgateHandle = newVtgateHandler(rpcVTGate)
@@ -445,6 +461,7 @@ func initMySQLProtocol() {
 			log.Infof("setting mysql slow connection threshold to %v", mysqlSlowConnectWarnThreshold)
 			mysqlListener.SlowConnectWarnThreshold.Set(*mysqlSlowConnectWarnThreshold)
 		}
+		mysqlListener.ConnLimiter = connLimiter
 		// Start listening for tcp
 		go mysqlListener.Accept()
 	}
@@ -459,6 +476,7 @@ func initMySQLProtocol() {
 			log.Exitf("mysql.NewListener failed: %v", err)
 			return
 		}
+		mysqlUnixListener.ConnLimiter = connLimiter
 		// Listen for unix socket
 		go mysqlUnixListener.Accept()
 	}
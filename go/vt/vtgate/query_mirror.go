@@ -0,0 +1,276 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"encoding/binary"
+	"hash/fnv"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/callerid"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/planbuilder"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+// The results of the mirrored queries.
+const (
+	mirrorMatch    = "Match"
+	mirrorMismatch = "Mismatch"
+	mirrorError    = "Error"
+	mirrorDropped  = "Dropped"
+)
+
+var (
+	queryMirrorResults   = stats.NewCountersWithSingleLabel("QueryMirrorResults", "Mirrored queries by the outcome of the comparison of their results with those of the original queries", "Result")
+	queryMirrorLatencies = stats.NewMultiTimings("QueryMirrorLatencies", "Latencies of the original and the mirrored queries", []string{"Execution"})
+	queryMirrorSlower    = stats.NewCounter("QueryMirrorSlower", "Mirrored queries that were slower than the original queries by more than -query_mirror_slow_threshold")
+)
+
+// queryMirror executes a deterministic sample of the read queries a second
+// time, asynchronously, against a shadow keyspace or with another planner
+// version, and compares their results and latencies with those of the
+// original queries.
+type queryMirror struct {
+	// threshold selects the queries whose hash is below it, out of
+	// mirrorHashRange.
+	threshold     uint32
+	keyspace      string
+	planner       planbuilder.PlannerVersion
+	slowThreshold time.Duration
+	timeout       time.Duration
+
+	// slots bounds the number of mirrored queries in flight. The queries
+	// that do not get a slot are dropped.
+	slots chan struct{}
+	wg    sync.WaitGroup
+}
+
+const mirrorHashRange = 10000
+
+// newQueryMirror returns the query mirror of the flags, or nil if mirroring
+// is disabled.
+func newQueryMirror() *queryMirror {
+	if *queryMirrorPercent <= 0 {
+		return nil
+	}
+	m := &queryMirror{
+		threshold:     uint32(*queryMirrorPercent * mirrorHashRange / 100),
+		keyspace:      *queryMirrorKeyspace,
+		slowThreshold: *queryMirrorSlowThreshold,
+		timeout:       *queryMirrorTimeout,
+		slots:         make(chan struct{}, *queryMirrorMaxConcurrency),
+	}
+	if *queryMirrorPlannerVersion != "" {
+		planner, ok := toPlannerVersion(*queryMirrorPlannerVersion)
+		if !ok {
+			log.Errorf("Invalid -query_mirror_planner_version %v, query mirroring is disabled", *queryMirrorPlannerVersion)
+			return nil
+		}
+		m.planner = planner
+	}
+	if m.keyspace == "" && *queryMirrorPlannerVersion == "" {
+		log.Errorf("-query_mirror_percent requires -query_mirror_keyspace or -query_mirror_planner_version, query mirroring is disabled")
+		return nil
+	}
+	return m
+}
+
+// sampled returns true if the query is part of the sample. The sample is
+// deterministic: a query is always or never mirrored, whichever the vtgate.
+func (m *queryMirror) sampled(sql string) bool {
+	h := fnv.New32a()
+	h.Write([]byte(sql))
+	return h.Sum32()%mirrorHashRange < m.threshold
+}
+
+// mirror executes the query again in the background if it is a read query
+// of the sample without side effects, of a session without a transaction or
+// settings, and compares its result with that of the original execution.
+func (m *queryMirror) mirror(ctx context.Context, e *Executor, safeSession *SafeSession, stmtType sqlparser.StatementType, sql string, bindVars map[string]*querypb.BindVariable, result *sqltypes.Result, latency time.Duration) {
+	if stmtType != sqlparser.StmtSelect || !m.sampled(sql) || !mirrorableSession(safeSession) || !mirrorable(sql) {
+		return
+	}
+
+	select {
+	case m.slots <- struct{}{}:
+	default:
+		queryMirrorResults.Add(mirrorDropped, 1)
+		return
+	}
+
+	session := m.mirrorSession(safeSession)
+	bindVars = sqltypes.CopyBindVariables(bindVars)
+	mirrorCtx := callerid.NewContext(context.Background(), callerid.EffectiveCallerIDFromContext(ctx), callerid.ImmediateCallerIDFromContext(ctx))
+	checksum, rowCount := resultChecksum(result), len(result.Rows)
+
+	m.wg.Add(1)
+	go func() {
+		defer func() {
+			<-m.slots
+			m.wg.Done()
+		}()
+
+		mirrorCtx, cancel := context.WithTimeout(mirrorCtx, m.timeout)
+		defer cancel()
+
+		logStats := NewLogStats(mirrorCtx, "QueryMirror", sql, bindVars)
+		start := time.Now()
+		_, mirrorResult, err := e.execute(mirrorCtx, session, sql, bindVars, logStats)
+		mirrorLatency := time.Since(start)
+		if err := e.CloseSession(context.Background(), session); err != nil {
+			log.Warningf("Failed to release the session of mirrored query %q: %v", redactedSQL(sql), err)
+		}
+
+		queryMirrorLatencies.Add([]string{"Original"}, latency)
+		queryMirrorLatencies.Add([]string{"Mirror"}, mirrorLatency)
+		if mirrorLatency > latency+m.slowThreshold {
+			queryMirrorSlower.Add(1)
+		}
+
+		switch {
+		case err != nil:
+			queryMirrorResults.Add(mirrorError, 1)
+			log.Warningf("Mirrored query %q failed: %v", redactedSQL(sql), err)
+		case resultChecksum(mirrorResult) != checksum:
+			queryMirrorResults.Add(mirrorMismatch, 1)
+			log.Warningf("Mirrored query %q returned %d rows that differ from the %d rows of the original query", redactedSQL(sql), len(mirrorResult.Rows), rowCount)
+		default:
+			queryMirrorResults.Add(mirrorMatch, 1)
+		}
+	}()
+}
+
+// mirrorableSession returns true if the queries of the session can be
+// mirrored: the session is not in a transaction, and it has no settings,
+// which the mirror session could not apply without a reserved connection.
+func mirrorableSession(safeSession *SafeSession) bool {
+	safeSession.mu.Lock()
+	defer safeSession.mu.Unlock()
+	return !safeSession.Session.InTransaction && !safeSession.Session.InReservedConn &&
+		len(safeSession.SystemVariables) == 0 && len(safeSession.UserDefinedVariables) == 0
+}
+
+// mirrorable returns true if the query is a read query without side
+// effects: it does not take locks, with FOR UPDATE, LOCK IN SHARE MODE or
+// the advisory lock functions, does not fetch sequence values, and does not
+// write into a file.
+func mirrorable(sql string) bool {
+	stmt, err := sqlparser.Parse(sql)
+	if err != nil {
+		return false
+	}
+	if _, ok := stmt.(sqlparser.SelectStatement); !ok {
+		return false
+	}
+	safe := true
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *sqlparser.Select:
+			safe = node.Lock == sqlparser.NoLock && node.Into == nil
+		case *sqlparser.Union:
+			safe = node.Lock == sqlparser.NoLock
+		case *sqlparser.Nextval:
+			safe = false
+		case *sqlparser.FuncExpr:
+			safe = !sqlparser.IsLockingFunc(node)
+		}
+		return safe, nil
+	}, stmt)
+	return safe
+}
+
+// mirrorSession returns the session the query is mirrored in: that of the
+// original query, with the target keyspace or the planner version of the
+// mirror.
+func (m *queryMirror) mirrorSession(safeSession *SafeSession) *SafeSession {
+	safeSession.mu.Lock()
+	session := &vtgatepb.Session{
+		TargetString: safeSession.TargetString,
+		Autocommit:   true,
+		Options:      &querypb.ExecuteOptions{},
+	}
+	if safeSession.Options != nil {
+		session.Options = proto.Clone(safeSession.Options).(*querypb.ExecuteOptions)
+	}
+	safeSession.mu.Unlock()
+
+	if m.keyspace != "" {
+		target := m.keyspace
+		if i := strings.IndexByte(session.TargetString, '@'); i >= 0 {
+			target += session.TargetString[i:]
+		}
+		session.TargetString = target
+	}
+	if m.planner != querypb.ExecuteOptions_DEFAULT_PLANNER {
+		session.Options.PlannerVersion = m.planner
+	}
+	return NewSafeSession(session)
+}
+
+// wait waits for the mirrored queries in flight.
+func (m *queryMirror) wait() {
+	m.wg.Wait()
+}
+
+// resultChecksum returns a checksum of the rows of a result that does not
+// depend on their order, since the shards of a scatter query return their
+// rows in any order.
+func resultChecksum(result *sqltypes.Result) uint64 {
+	rowHashes := make([]uint64, 0, len(result.Rows))
+	for _, row := range result.Rows {
+		h := fnv.New64a()
+		for _, value := range row {
+			if value.IsNull() {
+				h.Write([]byte{0})
+				continue
+			}
+			h.Write([]byte{1})
+			h.Write([]byte(value.ToString()))
+			h.Write([]byte{0})
+		}
+		rowHashes = append(rowHashes, h.Sum64())
+	}
+	sort.Slice(rowHashes, func(i, j int) bool { return rowHashes[i] < rowHashes[j] })
+
+	h := fnv.New64a()
+	var buf [8]byte
+	for _, rowHash := range rowHashes {
+		binary.LittleEndian.PutUint64(buf[:], rowHash)
+		h.Write(buf[:])
+	}
+	return h.Sum64()
+}
+
+func redactedSQL(sql string) string {
+	redacted, err := sqlparser.RedactSQLQuery(sql)
+	if err != nil {
+		return "<unparsable query>"
+	}
+	return redacted
+}
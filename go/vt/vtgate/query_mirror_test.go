@@ -0,0 +1,164 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vtgate/planbuilder"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+func setQueryMirrorFlags(t *testing.T, percent float64, keyspace, planner string) {
+	oldPercent, oldKeyspace, oldPlanner := *queryMirrorPercent, *queryMirrorKeyspace, *queryMirrorPlannerVersion
+	t.Cleanup(func() {
+		*queryMirrorPercent, *queryMirrorKeyspace, *queryMirrorPlannerVersion = oldPercent, oldKeyspace, oldPlanner
+	})
+	*queryMirrorPercent, *queryMirrorKeyspace, *queryMirrorPlannerVersion = percent, keyspace, planner
+}
+
+func TestQueryMirror(t *testing.T) {
+	setQueryMirrorFlags(t, 100, "", "gen4")
+	executor, sbc1, _, _ := createLegacyExecutorEnv()
+	executor.mirror = newQueryMirror()
+	require.NotNil(t, executor.mirror)
+
+	exec := func(sql string) {
+		session := NewSafeSession(&vtgatepb.Session{TargetString: "@primary", Autocommit: true})
+		_, err := executor.Execute(context.Background(), "TestQueryMirror", session, sql, nil)
+		require.NoError(t, err)
+		executor.mirror.wait()
+	}
+	counts := queryMirrorResults.Counts
+	before := counts()
+
+	result := sqltypes.MakeTestResult(sqltypes.MakeTestFields("id", "int64"), "1")
+	sbc1.SetResults([]*sqltypes.Result{result, result})
+	exec("select id from user where id = 1")
+	assert.EqualValues(t, 2, sbc1.ExecCount.Get())
+	assert.Equal(t, before[mirrorMatch]+1, counts()[mirrorMatch])
+
+	sbc1.SetResults([]*sqltypes.Result{result, sqltypes.MakeTestResult(sqltypes.MakeTestFields("id", "int64"), "2")})
+	exec("select id from user where id = 1")
+	assert.Equal(t, before[mirrorMismatch]+1, counts()[mirrorMismatch])
+
+	// Writes and the queries in transactions are not mirrored.
+	sbc1.ExecCount.Set(0)
+	exec("update user set a = 2 where id = 1")
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@primary", InTransaction: true})
+	_, err := executor.Execute(context.Background(), "TestQueryMirror", session, "select id from user where id = 1", nil)
+	require.NoError(t, err)
+	executor.mirror.wait()
+	assert.EqualValues(t, 2, sbc1.ExecCount.Get())
+	assert.Equal(t, before[mirrorMatch]+1, counts()[mirrorMatch])
+}
+
+func TestNewQueryMirror(t *testing.T) {
+	setQueryMirrorFlags(t, 0, "shadow", "")
+	assert.Nil(t, newQueryMirror())
+
+	setQueryMirrorFlags(t, 10, "", "")
+	assert.Nil(t, newQueryMirror())
+
+	setQueryMirrorFlags(t, 10, "", "nosuchplanner")
+	assert.Nil(t, newQueryMirror())
+
+	setQueryMirrorFlags(t, 12.5, "shadow", "gen4")
+	m := newQueryMirror()
+	require.NotNil(t, m)
+	assert.EqualValues(t, 1250, m.threshold)
+	assert.Equal(t, planbuilder.Gen4, m.planner)
+}
+
+func TestQueryMirrorSession(t *testing.T) {
+	setQueryMirrorFlags(t, 10, "shadow", "gen4")
+	m := newQueryMirror()
+
+	original := NewSafeSession(&vtgatepb.Session{
+		TargetString: "main@replica",
+		Options:      &querypb.ExecuteOptions{Workload: querypb.ExecuteOptions_OLAP},
+	})
+	session := m.mirrorSession(original)
+	assert.Equal(t, "shadow@replica", session.TargetString)
+	assert.Equal(t, planbuilder.Gen4, session.Options.PlannerVersion)
+	assert.Equal(t, querypb.ExecuteOptions_OLAP, session.Options.Workload)
+	assert.Equal(t, querypb.ExecuteOptions_DEFAULT_PLANNER, original.Options.PlannerVersion)
+
+	session = m.mirrorSession(NewSafeSession(&vtgatepb.Session{TargetString: "main"}))
+	assert.Equal(t, "shadow", session.TargetString)
+}
+
+func TestQueryMirrorable(t *testing.T) {
+	tcases := []struct {
+		sql  string
+		want bool
+	}{
+		{"select id from user where id = 1", true},
+		{"select id from user union select id from music", true},
+		{"select id from user where id = 1 for update", false},
+		{"select id from user where id = 1 lock in share mode", false},
+		{"select id from user union select id from music for update", false},
+		{"select next 10 values from user_seq", false},
+		{"select get_lock('lock', 10)", false},
+		{"select id from user where id in (select release_lock('lock'))", false},
+		{"select id from user into outfile 'x.txt'", false},
+		{"show tables", false},
+	}
+	for _, tcase := range tcases {
+		t.Run(tcase.sql, func(t *testing.T) {
+			assert.Equal(t, tcase.want, mirrorable(tcase.sql))
+		})
+	}
+
+	assert.True(t, mirrorableSession(NewSafeSession(&vtgatepb.Session{TargetString: "main"})))
+	assert.False(t, mirrorableSession(NewSafeSession(&vtgatepb.Session{InTransaction: true})))
+	assert.False(t, mirrorableSession(NewSafeSession(&vtgatepb.Session{InReservedConn: true})))
+	assert.False(t, mirrorableSession(NewSafeSession(&vtgatepb.Session{SystemVariables: map[string]string{"sql_mode": "''"}})))
+	assert.False(t, mirrorableSession(NewSafeSession(&vtgatepb.Session{UserDefinedVariables: map[string]*querypb.BindVariable{"x": sqltypes.Int64BindVariable(1)}})))
+}
+
+func TestQueryMirrorSampled(t *testing.T) {
+	setQueryMirrorFlags(t, 50, "shadow", "")
+	m := newQueryMirror()
+
+	sampled := 0
+	for i := 0; i < 1000; i++ {
+		sql := fmt.Sprintf("select * from user where id = %d", i)
+		if m.sampled(sql) {
+			sampled++
+		}
+		assert.Equal(t, m.sampled(sql), m.sampled(sql))
+	}
+	assert.InDelta(t, 500, sampled, 75)
+}
+
+func TestResultChecksum(t *testing.T) {
+	fields := sqltypes.MakeTestFields("id|name", "int64|varchar")
+	checksum := resultChecksum(sqltypes.MakeTestResult(fields, "1|a", "2|b"))
+	assert.Equal(t, checksum, resultChecksum(sqltypes.MakeTestResult(fields, "2|b", "1|a")))
+	assert.NotEqual(t, checksum, resultChecksum(sqltypes.MakeTestResult(fields, "1|a")))
+	assert.NotEqual(t, checksum, resultChecksum(sqltypes.MakeTestResult(fields, "1|ab", "2|")))
+	assert.NotEqual(t, resultChecksum(sqltypes.MakeTestResult(fields, "1|null")), resultChecksum(sqltypes.MakeTestResult(fields, "1|")))
+}
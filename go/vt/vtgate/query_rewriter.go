@@ -0,0 +1,102 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+// QueryRewriter rewrites the queries vtgate gets after they are parsed and
+// before they are planned, for example to add a tenant_id predicate to them,
+// or to rename legacy tables. The rewriters to run are registered with
+// RegisterQueryRewriter, typically in the init function of a plugin, and
+// enabled with the -query_rewriters flag.
+type QueryRewriter interface {
+	// Rewrite returns the statement to plan instead of stmt, which can be
+	// stmt itself, changed or not. keyspace is the keyspace targeted by the
+	// session, if any, and ctx holds the caller ids of the query. An error
+	// fails the query.
+	Rewrite(ctx context.Context, keyspace string, stmt sqlparser.Statement) (sqlparser.Statement, error)
+}
+
+var (
+	queryRewritersMu         sync.Mutex
+	registeredQueryRewriters = map[string]QueryRewriter{}
+
+	queryRewriterTimings = stats.NewTimings("QueryRewriterTimings", "Time spent rewriting the queries, by query rewriter", "Rewriter")
+	queryRewriterErrors  = stats.NewCountersWithSingleLabel("QueryRewriterErrors", "Queries failed by the query rewriters, by query rewriter", "Rewriter")
+)
+
+// RegisterQueryRewriter registers a query rewriter under name, for the
+// -query_rewriters flag to enable. It panics if the name is already
+// registered.
+func RegisterQueryRewriter(name string, rewriter QueryRewriter) {
+	queryRewritersMu.Lock()
+	defer queryRewritersMu.Unlock()
+	if _, ok := registeredQueryRewriters[name]; ok {
+		panic(fmt.Sprintf("query rewriter %s is already registered", name))
+	}
+	registeredQueryRewriters[name] = rewriter
+}
+
+type namedQueryRewriter struct {
+	name     string
+	rewriter QueryRewriter
+}
+
+// queryRewritersFor returns the registered query rewriters of the
+// comma-separated list of names, in the order of the list.
+func queryRewritersFor(names string) ([]namedQueryRewriter, error) {
+	queryRewritersMu.Lock()
+	defer queryRewritersMu.Unlock()
+
+	var rewriters []namedQueryRewriter
+	for _, name := range strings.Split(names, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		rewriter, ok := registeredQueryRewriters[name]
+		if !ok {
+			return nil, fmt.Errorf("query rewriter %s is not registered", name)
+		}
+		rewriters = append(rewriters, namedQueryRewriter{name: name, rewriter: rewriter})
+	}
+	return rewriters, nil
+}
+
+// rewriteQuery runs the query rewriters of the executor on stmt, in order.
+func (e *Executor) rewriteQuery(vcursor *vcursorImpl, stmt sqlparser.Statement) (sqlparser.Statement, error) {
+	for _, r := range e.rewriters {
+		start := time.Now()
+		rewritten, err := r.rewriter.Rewrite(vcursor.ctx, vcursor.keyspace, stmt)
+		queryRewriterTimings.Record(r.name, start)
+		if err != nil {
+			queryRewriterErrors.Add(r.name, 1)
+			return nil, err
+		}
+		stmt = rewritten
+	}
+	return stmt, nil
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+type queryRewriterFunc func(stmt sqlparser.Statement) (sqlparser.Statement, error)
+
+func (f queryRewriterFunc) Rewrite(_ context.Context, _ string, stmt sqlparser.Statement) (sqlparser.Statement, error) {
+	return f(stmt)
+}
+
+func init() {
+	// test_rename renames the legacy table to user
+	RegisterQueryRewriter("test_rename", queryRewriterFunc(func(stmt sqlparser.Statement) (sqlparser.Statement, error) {
+		return sqlparser.Rewrite(stmt, func(cursor *sqlparser.Cursor) bool {
+			if name, ok := cursor.Node().(sqlparser.TableName); ok && name.Name.String() == "legacy" {
+				cursor.Replace(sqlparser.TableName{Name: sqlparser.NewTableIdent("user")})
+			}
+			return true
+		}, nil).(sqlparser.Statement), nil
+	}))
+	// test_tenant adds a tenant_id predicate to the selects from user
+	RegisterQueryRewriter("test_tenant", queryRewriterFunc(func(stmt sqlparser.Statement) (sqlparser.Statement, error) {
+		sel, ok := stmt.(*sqlparser.Select)
+		if !ok || sqlparser.String(sqlparser.TableExprs(sel.From)) != "`user`" {
+			return stmt, nil
+		}
+		sel.AddWhere(&sqlparser.ComparisonExpr{
+			Operator: sqlparser.EqualOp,
+			Left:     sqlparser.NewColName("tenant_id"),
+			Right:    sqlparser.NewIntLiteral("42"),
+		})
+		return sel, nil
+	}))
+	RegisterQueryRewriter("test_fail", queryRewriterFunc(func(stmt sqlparser.Statement) (sqlparser.Statement, error) {
+		return nil, errors.New("rewrite failed")
+	}))
+}
+
+func TestQueryRewritersFor(t *testing.T) {
+	rewriters, err := queryRewritersFor("")
+	require.NoError(t, err)
+	assert.Empty(t, rewriters)
+
+	rewriters, err = queryRewritersFor("test_tenant, test_rename")
+	require.NoError(t, err)
+	require.Len(t, rewriters, 2)
+	assert.Equal(t, "test_tenant", rewriters[0].name)
+	assert.Equal(t, "test_rename", rewriters[1].name)
+
+	_, err = queryRewritersFor("test_rename,nosuchrewriter")
+	require.EqualError(t, err, "query rewriter nosuchrewriter is not registered")
+
+	assert.Panics(t, func() {
+		RegisterQueryRewriter("test_rename", queryRewriterFunc(nil))
+	})
+}
+
+func TestExecutorQueryRewriters(t *testing.T) {
+	tests := []struct {
+		rewriters string
+		query     string
+		err       string
+	}{{
+		rewriters: "test_rename,test_tenant",
+		query:     "select id from `user` where id = 1 and tenant_id = 42",
+	}, {
+		// the tenant rewriter has to run after the legacy table is renamed
+		rewriters: "test_tenant,test_rename",
+		query:     "select id from `user` where id = 1",
+	}, {
+		rewriters: "test_rename,test_fail,test_tenant",
+		err:       "rewrite failed",
+	}}
+
+	executor, sbc1, _, _ := createLegacyExecutorEnv()
+	for _, test := range tests {
+		t.Run(test.rewriters, func(t *testing.T) {
+			sbc1.Queries = nil
+			rewriters, err := queryRewritersFor(test.rewriters)
+			require.NoError(t, err)
+			executor.rewriters = rewriters
+
+			errorsBefore := queryRewriterErrors.Counts()["test_fail"]
+			_, err = executorExec(executor, "select id from legacy where id = 1", nil)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				assert.Equal(t, errorsBefore+1, queryRewriterErrors.Counts()["test_fail"])
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, sbc1.Queries, 1)
+			assert.Equal(t, test.query, sbc1.Queries[0].Sql)
+		})
+	}
+}
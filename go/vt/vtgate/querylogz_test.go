@@ -27,6 +27,8 @@ import (
 
 	"context"
 
+	"github.com/stretchr/testify/assert"
+
 	"vitess.io/vitess/go/streamlog"
 	"vitess.io/vitess/go/vt/callerid"
 )
@@ -157,6 +159,33 @@ func TestQuerylogzHandlerFormatting(t *testing.T) {
 
 }
 
+func TestQuerylogzHandlerFilter(t *testing.T) {
+	newLogStats := func(user, sql string) *LogStats {
+		ctx := callerid.NewContext(context.Background(), nil, callerid.NewImmediateCallerID(user))
+		logStats := NewLogStats(ctx, "Execute", sql, nil)
+		logStats.Keyspace = "ks"
+		logStats.EndTime = logStats.StartTime.Add(20 * time.Millisecond)
+		return logStats
+	}
+
+	// The filtered out queries do not count towards the limit.
+	req, _ := http.NewRequest("GET", "/querylogz?timeout=10&limit=1&user=batch&keyspace=ks&min_duration=10ms", nil)
+	response := httptest.NewRecorder()
+	ch := make(chan interface{}, 2)
+	ch <- newLogStats("app", "select name from app_table")
+	ch <- newLogStats("batch", "select name from batch_table")
+	querylogzHandler(ch, response, req)
+	close(ch)
+	body := response.Body.String()
+	assert.NotContains(t, body, "app_table")
+	assert.Contains(t, body, "batch_table")
+
+	req, _ = http.NewRequest("GET", "/querylogz?min_duration=soon", nil)
+	response = httptest.NewRecorder()
+	querylogzHandler(nil, response, req)
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+}
+
 func checkQuerylogzHasStats(t *testing.T, pattern []string, logStats *LogStats, page []byte) {
 	t.Helper()
 	matcher := regexp.MustCompile(strings.Join(pattern, `\s*`))
@@ -101,6 +101,7 @@ func (session *SafeSession) ResetTx() {
 	session.Session.InTransaction = false
 	session.commitOrder = vtgatepb.CommitOrder_NORMAL
 	session.Savepoints = nil
+	session.SnapshotGtids = nil
 	if !session.Session.InReservedConn {
 		session.ShardSessions = nil
 		session.PreSessions = nil
@@ -117,6 +118,7 @@ func (session *SafeSession) Reset() {
 	session.Session.InTransaction = false
 	session.commitOrder = vtgatepb.CommitOrder_NORMAL
 	session.Savepoints = nil
+	session.SnapshotGtids = nil
 	session.ShardSessions = nil
 	session.PreSessions = nil
 	session.PostSessions = nil
@@ -332,6 +334,14 @@ func (session *SafeSession) SetSystemVariable(name string, expr string) {
 	session.SystemVariables[name] = expr
 }
 
+// GetSystemVariable returns the expression the session set the system variable to.
+func (session *SafeSession) GetSystemVariable(name string) (string, bool) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	expr, ok := session.SystemVariables[name]
+	return expr, ok
+}
+
 // SetOptions sets the options
 func (session *SafeSession) SetOptions(options *querypb.ExecuteOptions) {
 	session.mu.Lock()
@@ -522,6 +532,50 @@ func (session *SafeSession) SetSessionTrackGtids(enable bool) {
 	session.ReadAfterWrite.SessionTrackGtids = enable
 }
 
+// SetConsistentSnapshotReads sets the ConsistentSnapshotReads setting.
+func (session *SafeSession) SetConsistentSnapshotReads(enable bool) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	session.ConsistentSnapshotReads = enable
+}
+
+// GetConsistentSnapshotReads returns the ConsistentSnapshotReads value.
+func (session *SafeSession) GetConsistentSnapshotReads() bool {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	return session.ConsistentSnapshotReads
+}
+
+// SetSnapshotGTIDs sets the GTID sets, keyed by keyspace/shard, that the
+// replicas must have executed before they begin the transaction.
+func (session *SafeSession) SetSnapshotGTIDs(gtids map[string]string) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	session.SnapshotGtids = gtids
+}
+
+// beginOptions returns the options to begin a transaction on the target
+// with. With the GTID sets of a consistent snapshot, the options carry the
+// set of the shard of the target.
+func (session *SafeSession) beginOptions(target *querypb.Target, options *querypb.ExecuteOptions) (*querypb.ExecuteOptions, error) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	if len(session.SnapshotGtids) == 0 {
+		return options, nil
+	}
+	gtidSet, ok := session.SnapshotGtids[target.Keyspace+"/"+target.Shard]
+	if !ok {
+		return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "consistent snapshot reads: no GTID set captured for %s/%s at the start of the transaction", target.Keyspace, target.Shard)
+	}
+	if options == nil {
+		options = &querypb.ExecuteOptions{}
+	} else {
+		options = proto.Clone(options).(*querypb.ExecuteOptions)
+	}
+	options.SnapshotGtidSet = gtidSet
+	return options, nil
+}
+
 func removeShard(tabletAlias *topodatapb.TabletAlias, sessions []*vtgatepb.Session_ShardSession) ([]*vtgatepb.Session_ShardSession, error) {
 	idx := -1
 	for i, session := range sessions {
@@ -116,6 +116,9 @@ type sandbox struct {
 
 	// VSchema specifies the vschema in JSON format.
 	VSchema string
+
+	// KeyspacePolicy specifies the policy of the SrvKeyspace.
+	KeyspacePolicy *topodatapb.KeyspacePolicy
 }
 
 // Reset cleans up sandbox internal state.
@@ -129,6 +132,7 @@ func (s *sandbox) Reset() {
 	s.KeyspaceServedFrom = ""
 	s.ShardSpec = DefaultShardSpec
 	s.SrvKeyspaceCallback = nil
+	s.KeyspacePolicy = nil
 }
 
 // DefaultShardSpec is the default sharding scheme for testing.
@@ -259,6 +263,15 @@ func (sct *sandboxTopo) GetSrvKeyspace(ctx context.Context, cell, keyspace strin
 		sand.SrvKeyspaceMustFail--
 		return nil, fmt.Errorf("topo error GetSrvKeyspace")
 	}
+	srvKeyspace, err := sand.srvKeyspaceLocked(keyspace)
+	if err != nil {
+		return nil, err
+	}
+	srvKeyspace.Policy = sand.KeyspacePolicy
+	return srvKeyspace, nil
+}
+
+func (sand *sandbox) srvKeyspaceLocked(keyspace string) (*topodatapb.SrvKeyspace, error) {
 	switch keyspace {
 	case KsTestUnshardedServedFrom:
 		servedFromKeyspace, err := createUnshardedKeyspace()
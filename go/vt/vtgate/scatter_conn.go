@@ -57,6 +57,35 @@ type ScatterConn struct {
 	legacyHealthCheck    discovery.LegacyHealthCheck
 }
 
+// queryFingerprintKey is the context key of the fingerprint of the query
+// vtgate is executing.
+type queryFingerprintKey struct{}
+
+// withQueryFingerprint returns a context with the fingerprint of the query
+// vtgate is executing, which ScatterConn sends along with the queries it
+// sends to vttablet for it.
+func withQueryFingerprint(ctx context.Context, fingerprint string) context.Context {
+	return context.WithValue(ctx, queryFingerprintKey{}, fingerprint)
+}
+
+// queryOptions returns the options of the queries sent to vttablet: the
+// given ones, with the fingerprint of the query vtgate is executing, if the
+// context has it. It does not modify the given options, which are the ones
+// of the session.
+func queryOptions(ctx context.Context, options *querypb.ExecuteOptions) *querypb.ExecuteOptions {
+	fingerprint, _ := ctx.Value(queryFingerprintKey{}).(string)
+	if fingerprint == "" {
+		return options
+	}
+	if options == nil {
+		options = &querypb.ExecuteOptions{}
+	} else {
+		options = proto.Clone(options).(*querypb.ExecuteOptions)
+	}
+	options.Fingerprint = fingerprint
+	return options
+}
+
 // shardActionFunc defines the contract for a shard action
 // outside of a transaction. Every such function executes the
 // necessary action on a shard, sends the results to sResults, and
@@ -187,6 +216,11 @@ func (stc *ScatterConn) ExecuteMultiShard(
 		}()
 	}
 
+	var sessionOpts *querypb.ExecuteOptions
+	if session != nil && session.Session != nil {
+		sessionOpts = queryOptions(ctx, session.Session.Options)
+	}
+
 	allErrors := stc.multiGoTransaction(
 		ctx,
 		"Execute",
@@ -205,7 +239,13 @@ func (stc *ScatterConn) ExecuteMultiShard(
 			reservedID := info.reservedID
 
 			if session != nil && session.Session != nil {
-				opts = session.Session.Options
+				opts = sessionOpts
+				if info.actionNeeded == begin || info.actionNeeded == reserveBegin {
+					opts, err = session.beginOptions(rs.Target, opts)
+					if err != nil {
+						return nil, err
+					}
+				}
 			}
 
 			if autocommit {
@@ -265,6 +305,9 @@ func (stc *ScatterConn) ExecuteMultiShard(
 			if err != nil {
 				return newInfo, err
 			}
+			for _, warning := range innerqr.Warnings {
+				session.RecordWarning(warning)
+			}
 			mu.Lock()
 			defer mu.Unlock()
 
@@ -346,6 +389,7 @@ func (stc *ScatterConn) StreamExecute(
 	var mu sync.Mutex
 	fieldSent := false
 
+	options = queryOptions(ctx, options)
 	allErrors := stc.multiGo("StreamExecute", rss, func(rs *srvtopo.ResolvedShard, i int) error {
 		return rs.Gateway.StreamExecute(ctx, rs.Target, query, bindVars, 0, options, func(qr *sqltypes.Result) error {
 			return stc.processOneStreamingResult(&mu, &fieldSent, qr, callback)
@@ -371,6 +415,7 @@ func (stc *ScatterConn) StreamExecuteMulti(
 	var mu sync.Mutex
 	fieldSent := false
 
+	options = queryOptions(ctx, options)
 	allErrors := stc.multiGo("StreamExecute", rss, func(rs *srvtopo.ResolvedShard, i int) error {
 		return rs.Gateway.StreamExecute(ctx, rs.Target, query, bindVars[i], 0, options, func(qr *sqltypes.Result) error {
 			return stc.processOneStreamingResult(&mu, &fieldSent, qr, callback)
@@ -634,7 +679,7 @@ func (stc *ScatterConn) ExecuteLock(
 		return nil, vterrors.New(vtrpcpb.Code_INTERNAL, "session cannot be nil")
 	}
 
-	opts = session.Session.Options
+	opts = queryOptions(ctx, session.Session.Options)
 	info, err := lockInfo(rs.Target, session)
 	// Lock session is created on alphabetic sorted keyspace.
 	// This error will occur if the existing session target does not match the current target.
@@ -17,6 +17,7 @@ limitations under the License.
 package vtgate
 
 import (
+	"context"
 	"testing"
 
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
@@ -41,6 +42,18 @@ import (
 
 // This file uses the sandbox_test framework.
 
+func TestQueryOptions(t *testing.T) {
+	options := &querypb.ExecuteOptions{Workload: querypb.ExecuteOptions_OLAP}
+	assert.Same(t, options, queryOptions(context.Background(), options))
+	assert.Nil(t, queryOptions(context.Background(), nil))
+
+	ctx := withQueryFingerprint(context.Background(), "fp")
+	utils.MustMatch(t, &querypb.ExecuteOptions{Fingerprint: "fp"}, queryOptions(ctx, nil))
+	utils.MustMatch(t, &querypb.ExecuteOptions{Workload: querypb.ExecuteOptions_OLAP, Fingerprint: "fp"}, queryOptions(ctx, options))
+	// The options of the session are left alone.
+	assert.Empty(t, options.Fingerprint)
+}
+
 func TestExecuteFailOnAutocommit(t *testing.T) {
 
 	createSandbox("TestExecuteFailOnAutocommit")
@@ -128,6 +141,24 @@ func TestReservedOnMultiReplica(t *testing.T) {
 	}
 }
 
+func TestExecuteRecordsTabletWarnings(t *testing.T) {
+	keyspace := "keyspace"
+	createSandbox(keyspace)
+	hc := discovery.NewFakeHealthCheck()
+	sc := newTestScatterConn(hc, new(sandboxTopo), "aa")
+	sbc0 := hc.AddTestTablet("aa", "0", 1, keyspace, "0", topodatapb.TabletType_REPLICA, true, 1, nil)
+	sbc1 := hc.AddTestTablet("aa", "1", 1, keyspace, "1", topodatapb.TabletType_REPLICA, true, 1, nil)
+
+	warning := &querypb.QueryWarning{Message: "transaction has been open for 25s, it will be rolled back once open for 30s"}
+	sbc0.SetResults([]*sqltypes.Result{{Warnings: []*querypb.QueryWarning{warning}}})
+	sbc1.SetResults([]*sqltypes.Result{{}})
+
+	res := srvtopo.NewResolver(&sandboxTopo{}, sc.gateway, "aa")
+	session := NewSafeSession(&vtgatepb.Session{InTransaction: true})
+	executeOnShards(t, res, keyspace, sc, session, []key.Destination{key.DestinationShard("0"), key.DestinationShard("1")})
+	utils.MustMatch(t, []*querypb.QueryWarning{warning}, session.Warnings, "")
+}
+
 func TestReservedBeginTableDriven(t *testing.T) {
 	type testAction struct {
 		transaction, reserved    bool
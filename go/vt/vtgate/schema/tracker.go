@@ -18,6 +18,7 @@ package schema
 
 import (
 	"context"
+	"strings"
 	"sync"
 	"time"
 
@@ -30,6 +31,7 @@ import (
 	"vitess.io/vitess/go/vt/discovery"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
 )
 
@@ -42,10 +44,11 @@ type (
 		ch     chan *discovery.TabletHealth
 		cancel context.CancelFunc
 
-		mu     sync.Mutex
-		tables *tableMap
-		ctx    context.Context
-		signal func() // a function that we'll call whenever we have new schema data
+		mu         sync.Mutex
+		tables     *tableMap
+		statistics map[keyspaceStr]map[tableNameStr]*vindexes.TableStatistics
+		ctx        context.Context
+		signal     func() // a function that we'll call whenever we have new schema data
 
 		// map of keyspace currently tracked
 		tracked      map[keyspaceStr]*updateController
@@ -62,6 +65,7 @@ func NewTracker(ch chan *discovery.TabletHealth) *Tracker {
 		ctx:          context.Background(),
 		ch:           ch,
 		tables:       &tableMap{m: map[keyspaceStr]map[tableNameStr][]vindexes.Column{}},
+		statistics:   map[keyspaceStr]map[tableNameStr]*vindexes.TableStatistics{},
 		tracked:      map[keyspaceStr]*updateController{},
 		consumeDelay: defaultConsumeDelay,
 	}
@@ -73,9 +77,20 @@ func (t *Tracker) LoadKeyspace(conn queryservice.QueryService, target *querypb.T
 	if err != nil {
 		return err
 	}
+	// The statistics are optional, the tablets only publish them with
+	// -queryserver-config-table-statistics-interval.
+	statsRes, err := conn.Execute(context.Background(), target, mysql.FetchTableStatistics, nil, 0, 0, nil)
+	if err != nil {
+		log.Warningf("Unable to load the table statistics of keyspace %s: %v", target.Keyspace, err)
+		statsRes = nil
+	}
 	t.mu.Lock()
 	defer t.mu.Unlock()
 	t.updateTables(target.Keyspace, res)
+	delete(t.statistics, target.Keyspace)
+	if statsRes != nil {
+		t.updateStatistics(target.Keyspace, statsRes)
+	}
 	t.tracked[target.Keyspace].setLoaded(true)
 	log.Infof("finished loading schema for keyspace %s. Found %d tables", target.Keyspace, len(res.Rows))
 	return nil
@@ -154,7 +169,75 @@ func (t *Tracker) Tables(ks string) map[string][]vindexes.Column {
 	return m
 }
 
+// TableStatistics returns a map with the statistics for the known tables in the keyspace
+func (t *Tracker) TableStatistics(ks string) map[string]*vindexes.TableStatistics {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.statistics[ks]
+}
+
 func (t *Tracker) updateSchema(th *discovery.TabletHealth) bool {
+	success := true
+	if len(th.Stats.TableSchemaChanged) > 0 {
+		success = t.updateColumns(th)
+	}
+	if len(th.Stats.TableStatisticsChanged) > 0 {
+		success = t.updateTableStatistics(th) && success
+	}
+	return success
+}
+
+func (t *Tracker) updateTableStatistics(th *discovery.TabletHealth) bool {
+	tablesUpdated := th.Stats.TableStatisticsChanged
+	tables, err := sqltypes.BuildBindVariable(tablesUpdated)
+	if err != nil {
+		log.Errorf("failed to read updated tables from TabletHealth: %v", err)
+		return false
+	}
+	bv := map[string]*querypb.BindVariable{"tableNames": tables}
+	res, err := th.Conn.Execute(t.ctx, th.Target, mysql.FetchUpdatedTableStatistics, bv, 0, 0, nil)
+	if err != nil {
+		log.Warningf("error fetching new statistics for %v: %v", tablesUpdated, err)
+		return false
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	// dropped tables will not show up in the result, so we empty all prior statistics first
+	for _, tbl := range tablesUpdated {
+		delete(t.statistics[th.Target.Keyspace], tbl)
+	}
+	t.updateStatistics(th.Target.Keyspace, res)
+	return true
+}
+
+func (t *Tracker) updateStatistics(keyspace string, res *sqltypes.Result) {
+	m := t.statistics[keyspace]
+	if m == nil {
+		m = make(map[tableNameStr]*vindexes.TableStatistics)
+		t.statistics[keyspace] = m
+	}
+	for _, row := range res.Rows {
+		tbl := row[0].ToString()
+		colName := row[1].ToString()
+		rowCount, _ := evalengine.ToUint64(row[2])
+		cardinality, _ := evalengine.ToUint64(row[3])
+
+		stats := m[tbl]
+		if stats == nil {
+			stats = &vindexes.TableStatistics{Cardinalities: map[string]uint64{}}
+			m[tbl] = stats
+		}
+		stats.RowCount = rowCount
+		if colName != "" {
+			stats.Cardinalities[strings.ToLower(colName)] = cardinality
+		}
+	}
+}
+
+func (t *Tracker) updateColumns(th *discovery.TabletHealth) bool {
 	tablesUpdated := th.Stats.TableSchemaChanged
 	tables, err := sqltypes.BuildBindVariable(tablesUpdated)
 	if err != nil {
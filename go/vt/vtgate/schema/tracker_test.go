@@ -159,6 +159,8 @@ func TestTracking(t *testing.T) {
 				}
 			}
 
+			// no table statistics
+			results = append(results, &sqltypes.Result{})
 			sbc.SetResults(results)
 			sbc.Queries = nil
 
@@ -180,7 +182,7 @@ func TestTracking(t *testing.T) {
 
 			require.False(t, waitTimeout(&wg, time.Second), "schema was updated but received no signal")
 
-			require.Equal(t, 1, len(sbc.StringQueries()))
+			require.Equal(t, []string{mysql.FetchTables, mysql.FetchTableStatistics}, sbc.StringQueries())
 
 			_, keyspacePresent := tracker.tracked[target.Keyspace]
 			require.Equal(t, true, keyspacePresent)
@@ -244,7 +246,7 @@ func TestTrackingUnHealthyTablet(t *testing.T) {
 		},
 	}
 
-	sbc.SetResults([]*sqltypes.Result{{}, {}, {}})
+	sbc.SetResults([]*sqltypes.Result{{}, {}, {}, {}, {}})
 	for _, tcase := range tcases {
 		ch <- &discovery.TabletHealth{
 			Conn:    sbc,
@@ -257,7 +259,50 @@ func TestTrackingUnHealthyTablet(t *testing.T) {
 	}
 
 	require.False(t, waitTimeout(&wg, time.Second), "schema was updated but received no signal")
-	require.Equal(t, []string{mysql.FetchTables, mysql.FetchUpdatedTables, mysql.FetchTables}, sbc.StringQueries())
+	require.Equal(t, []string{mysql.FetchTables, mysql.FetchTableStatistics, mysql.FetchUpdatedTables, mysql.FetchTables, mysql.FetchTableStatistics}, sbc.StringQueries())
+}
+
+func TestTrackingTableStatistics(t *testing.T) {
+	target := &querypb.Target{
+		Keyspace:   "ks",
+		Shard:      "-80",
+		TabletType: topodatapb.TabletType_PRIMARY,
+		Cell:       "aa",
+	}
+	tablet := &topodatapb.Tablet{
+		Keyspace: target.Keyspace,
+		Shard:    target.Shard,
+		Type:     target.TabletType,
+	}
+	fields := sqltypes.MakeTestFields("table_name|column_name|row_count|cardinality", "varchar|varchar|uint64|uint64")
+
+	sbc := sandboxconn.NewSandboxConn(tablet)
+	ch := make(chan *discovery.TabletHealth)
+	tracker := NewTracker(ch)
+	tracker.consumeDelay = 1 * time.Millisecond
+	tracker.Start()
+	defer tracker.Stop()
+
+	wg := sync.WaitGroup{}
+	wg.Add(2)
+	tracker.RegisterSignalReceiver(func() {
+		wg.Done()
+	})
+
+	sbc.SetResults([]*sqltypes.Result{
+		{},
+		sqltypes.MakeTestResult(fields, "t1||1000|1000", "t1|id|1000|1000", "t1|Status|1000|4", "t2||10|10"),
+		sqltypes.MakeTestResult(fields, "t1||2000|2000", "t1|id|2000|2000"),
+	})
+	ch <- &discovery.TabletHealth{Conn: sbc, Tablet: tablet, Target: target, Serving: true, Stats: &querypb.RealtimeStats{}}
+	time.Sleep(5 * time.Millisecond)
+	ch <- &discovery.TabletHealth{Conn: sbc, Tablet: tablet, Target: target, Serving: true, Stats: &querypb.RealtimeStats{TableStatisticsChanged: []string{"t1", "t2"}}}
+
+	require.False(t, waitTimeout(&wg, time.Second), "statistics were updated but received no signal")
+	require.Equal(t, []string{mysql.FetchTables, mysql.FetchTableStatistics, mysql.FetchUpdatedTableStatistics}, sbc.StringQueries())
+	utils.MustMatch(t, map[string]*vindexes.TableStatistics{
+		"t1": {RowCount: 2000, Cardinalities: map[string]uint64{"id": 2000}},
+	}, tracker.TableStatistics("ks"))
 }
 
 func waitTimeout(wg *sync.WaitGroup, timeout time.Duration) bool {
@@ -74,18 +74,8 @@ func (u *updateController) getItemFromQueueLocked() *discovery.TabletHealth {
 	// Only when we want to update selected tables.
 	if u.loaded {
 		for i := 1; i < itemsCount; i++ {
-			for _, table := range u.queue.items[i].Stats.TableSchemaChanged {
-				found := false
-				for _, itemTable := range item.Stats.TableSchemaChanged {
-					if itemTable == table {
-						found = true
-						break
-					}
-				}
-				if !found {
-					item.Stats.TableSchemaChanged = append(item.Stats.TableSchemaChanged, table)
-				}
-			}
+			item.Stats.TableSchemaChanged = mergeTables(item.Stats.TableSchemaChanged, u.queue.items[i].Stats.TableSchemaChanged)
+			item.Stats.TableStatisticsChanged = mergeTables(item.Stats.TableStatisticsChanged, u.queue.items[i].Stats.TableStatisticsChanged)
 		}
 	}
 	// emptying queue's items as all items from 0 to i (length of the queue) are merged
@@ -93,6 +83,23 @@ func (u *updateController) getItemFromQueueLocked() *discovery.TabletHealth {
 	return item
 }
 
+// mergeTables appends the tables that are not in the list yet.
+func mergeTables(tables, more []string) []string {
+	for _, table := range more {
+		found := false
+		for _, itemTable := range tables {
+			if itemTable == table {
+				found = true
+				break
+			}
+		}
+		if !found {
+			tables = append(tables, table)
+		}
+	}
+	return tables
+}
+
 func (u *updateController) add(th *discovery.TabletHealth) {
 	// For non-primary tablet health, there is no schema tracking.
 	if th.Tablet.Type != topodatapb.TabletType_PRIMARY {
@@ -109,8 +116,8 @@ func (u *updateController) add(th *discovery.TabletHealth) {
 	u.mu.Lock()
 	defer u.mu.Unlock()
 
-	// If the keyspace schema is loaded and there is no schema change detected. Then there is nothing to process.
-	if len(th.Stats.TableSchemaChanged) == 0 && u.loaded {
+	// If the keyspace schema is loaded and there is no schema nor statistics change detected. Then there is nothing to process.
+	if len(th.Stats.TableSchemaChanged) == 0 && len(th.Stats.TableStatisticsChanged) == 0 && u.loaded {
 		return
 	}
 
@@ -175,6 +175,7 @@ func (a *analyzer) analyzeUp(cursor *sqlparser.Cursor) bool {
 			a.setError(err)
 			return false
 		}
+		a.binder.up(cursor)
 	}
 
 	a.leaveProjection(cursor)
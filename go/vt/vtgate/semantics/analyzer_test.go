@@ -473,6 +473,42 @@ func TestMissingTable(t *testing.T) {
 	}
 }
 
+func TestAmbiguousColumn(t *testing.T) {
+	tbl := func(name string) *vindexes.Table {
+		return &vindexes.Table{
+			Name: sqlparser.NewTableIdent(name),
+			Columns: []vindexes.Column{{
+				Name: sqlparser.NewColIdent("col"),
+				Type: querypb.Type_INT32,
+			}},
+			ColumnListAuthoritative: true,
+		}
+	}
+	si := &FakeSI{Tables: map[string]*vindexes.Table{"a": tbl("a"), "b": tbl("b")}}
+
+	queries := []struct {
+		query, err string
+	}{{
+		query: "select 1 from a, b where col = 1",
+		err:   "Column 'col' in where clause at position 26 is ambiguous",
+	}, {
+		query: "select 1 from a join b on col = 1",
+		err:   "Column 'col' in on clause at position 27 is ambiguous",
+	}, {
+		query: "select 1 from a, b where a.col = 1 group by col",
+		err:   "Column 'col' in group statement at position 45 is ambiguous",
+	}}
+
+	for _, query := range queries {
+		t.Run(query.query, func(t *testing.T) {
+			parse, err := sqlparser.Parse(query.query)
+			require.NoError(t, err)
+			_, err = Analyze(parse.(sqlparser.SelectStatement), "", si, NoRewrite)
+			require.EqualError(t, err, query.err)
+		})
+	}
+}
+
 func TestUnknownColumnMap2(t *testing.T) {
 	query := "select col from a, b"
 	authoritativeTblA := vindexes.Table{
@@ -580,6 +616,29 @@ func TestUnknownColumnMap2(t *testing.T) {
 	}
 }
 
+func TestArgumentTypes(t *testing.T) {
+	queries := map[string]map[string]*querypb.Type{
+		"select 1 from t2 where uid = :v1 and :v2 = name":              {"v1": typ(querypb.Type_INT64), "v2": typ(querypb.Type_VARCHAR)},
+		"select 1 from t2 where uid in (:v1, 2) and name in ::list":    {"v1": typ(querypb.Type_INT64), "list": nil},
+		"select 1 from t2 where uid between :v1 and :v2":               {"v1": typ(querypb.Type_INT64), "v2": typ(querypb.Type_INT64)},
+		"select 1 from t2 where :v1 = :v2 limit :v3, :v4":              {"v1": nil, "v2": nil, "v3": typ(querypb.Type_INT64), "v4": typ(querypb.Type_INT64)},
+		"select 1 from t where col = :v1":                              {"v1": nil},
+		"select 1 from t2 where (uid, name) in ((:v1, :v2), (1, 'a'))": {"v1": nil, "v2": nil},
+	}
+	for query, want := range queries {
+		t.Run(query, func(t *testing.T) {
+			_, semTable := parseAndAnalyze(t, query, "d")
+			for name, typ := range want {
+				assert.Equal(t, typ, semTable.TypeFor(sqlparser.Argument(name)), name)
+			}
+		})
+	}
+}
+
+func typ(t querypb.Type) *querypb.Type {
+	return &t
+}
+
 func TestUnknownPredicate(t *testing.T) {
 	query := "select 1 from a, b where col = 1"
 	authoritativeTblA := &vindexes.Table{
@@ -622,7 +681,7 @@ func TestScoping(t *testing.T) {
 	}{
 		{
 			query:        "select 1 from u1, u2 left join u3 on u1.a = u2.a",
-			errorMessage: "symbol u1.a not found",
+			errorMessage: "symbol u1.a not found in on clause at position 38",
 		},
 	}
 	for _, query := range queries {
@@ -668,7 +727,7 @@ func TestScopingWDerivedTables(t *testing.T) {
 			expectation:          T2,
 		}, {
 			query:        "select t.id2 from (select foo as id from user) as t",
-			errorMessage: "symbol t.id2 not found",
+			errorMessage: "symbol t.id2 not found in field list at position 8",
 		}, {
 			query:                "select id from (select 42 as id) as t",
 			recursiveExpectation: T0,
@@ -679,7 +738,7 @@ func TestScopingWDerivedTables(t *testing.T) {
 			expectation:          T2,
 		}, {
 			query:        "select ks.t.id from (select 42 as id) as t",
-			errorMessage: "symbol ks.t.id not found",
+			errorMessage: "symbol ks.t.id not found in field list at position 8",
 		}, {
 			query:        "select * from (select id, id from user) as t",
 			errorMessage: "Duplicate column name 'id'",
@@ -42,6 +42,10 @@ type binder struct {
 	typer             *typer
 	subqueryMap       map[*sqlparser.Select][]*subquery
 	subqueryRef       map[*sqlparser.Subquery]*subquery
+
+	// clauses is the stack of the clauses we are binding columns in,
+	// so that the errors can tell where the column is in the query
+	clauses []string
 }
 
 func newBinder(scoper *scoper, org originable, tc *tableCollector, typer *typer) *binder {
@@ -58,6 +62,9 @@ func newBinder(scoper *scoper, org originable, tc *tableCollector, typer *typer)
 }
 
 func (b *binder) down(cursor *sqlparser.Cursor) error {
+	if clause, ok := clauseOf(cursor.Node()); ok {
+		b.clauses = append(b.clauses, clause)
+	}
 	switch node := cursor.Node().(type) {
 	case *sqlparser.Subquery:
 		currScope := b.scoper.currentScope()
@@ -123,6 +130,47 @@ func (b *binder) down(cursor *sqlparser.Cursor) error {
 	return nil
 }
 
+func (b *binder) up(cursor *sqlparser.Cursor) {
+	if _, ok := clauseOf(cursor.Node()); ok {
+		b.clauses = b.clauses[:len(b.clauses)-1]
+	}
+}
+
+// clauseOf returns the name MySQL uses in its errors for the clause that
+// starts at node, if it starts one
+func clauseOf(node sqlparser.SQLNode) (string, bool) {
+	switch node := node.(type) {
+	case sqlparser.SelectExprs:
+		return "field list", true
+	case *sqlparser.Where:
+		if node.Type == sqlparser.HavingClause {
+			return "having clause", true
+		}
+		return "where clause", true
+	case sqlparser.GroupBy:
+		return "group statement", true
+	case sqlparser.OrderBy:
+		return "order clause", true
+	case *sqlparser.JoinCondition:
+		return "on clause", true
+	}
+	return "", false
+}
+
+// columnPlace describes where the column is in the query for the errors
+// about it: the clause it is in, and its position in the query text when
+// it was parsed from one
+func (b *binder) columnPlace(col *sqlparser.ColName) string {
+	clause := "field list"
+	if len(b.clauses) > 0 {
+		clause = b.clauses[len(b.clauses)-1]
+	}
+	if col.Pos == 0 {
+		return clause
+	}
+	return fmt.Sprintf("%s at position %d", clause, col.Pos)
+}
+
 func (b *binder) analyzeOrderByGroupByExprForLiteral(input sqlparser.Expr, caller string) error {
 	l, ok := input.(*sqlparser.Literal)
 	if !ok {
@@ -173,7 +221,7 @@ func (b *binder) resolveQualifiedColumn(current *scope, expr *sqlparser.ColName)
 				return 0, 0, nil, err
 			}
 			if recursiveTs == nil {
-				return 0, 0, nil, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.BadFieldError, "symbol %s not found", sqlparser.String(expr))
+				return 0, 0, nil, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.BadFieldError, "symbol %s not found in %s", sqlparser.String(expr), b.columnPlace(expr))
 			}
 
 			ts, err := table.DepsFor(expr, b.org, len(current.tables) == 1)
@@ -184,7 +232,7 @@ func (b *binder) resolveQualifiedColumn(current *scope, expr *sqlparser.ColName)
 		}
 		current = current.parent
 	}
-	return 0, 0, nil, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.BadFieldError, "symbol %s not found", sqlparser.String(expr))
+	return 0, 0, nil, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.BadFieldError, "symbol %s not found in %s", sqlparser.String(expr), b.columnPlace(expr))
 }
 
 // resolveUnQualifiedColumn handles column that do not specify which table they belong to
@@ -199,7 +247,7 @@ func (b *binder) resolveUnQualifiedColumn(current *scope, expr *sqlparser.ColNam
 				return 0, 0, nil, err
 			}
 			if recursiveTs != nil && tspRecursive != nil {
-				return 0, 0, nil, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.NonUniqError, fmt.Sprintf("Column '%s' in field list is ambiguous", sqlparser.String(expr)))
+				return 0, 0, nil, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.NonUniqError, "Column '%s' in %s is ambiguous", sqlparser.String(expr), b.columnPlace(expr))
 			}
 			if recursiveTs != nil {
 				tspRecursive = recursiveTs
@@ -222,7 +270,7 @@ func (b *binder) resolveUnQualifiedColumn(current *scope, expr *sqlparser.ColNam
 	}
 
 	if tspRecursive == nil {
-		return 0, 0, nil, ProjError{vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.NonUniqError, fmt.Sprintf("Column '%s' in field list is ambiguous", sqlparser.String(expr)))}
+		return 0, 0, nil, ProjError{vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.NonUniqError, "Column '%s' in %s is ambiguous", sqlparser.String(expr), b.columnPlace(expr))}
 	}
 
 	if tsp == nil {
@@ -106,7 +106,13 @@ func (s *scoper) down(cursor *sqlparser.Cursor) {
 
 func (s *scoper) up(cursor *sqlparser.Cursor) error {
 	switch node := cursor.Node().(type) {
-	case sqlparser.SelectStatement, sqlparser.OrderBy, sqlparser.GroupBy:
+	case sqlparser.OrderBy:
+		if _, isWindow := cursor.Parent().(*sqlparser.WindowSpecification); isWindow {
+			// the ORDER BY of a window does not get its own scope
+			break
+		}
+		s.popScope()
+	case sqlparser.SelectStatement, sqlparser.GroupBy:
 		s.popScope()
 	case *sqlparser.Where:
 		if node.Type != sqlparser.HavingClause {
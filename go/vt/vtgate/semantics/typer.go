@@ -54,10 +54,48 @@ func (t *typer) up(cursor *sqlparser.Cursor) error {
 				t.exprTypes[node] = typ
 			}
 		}
+	case *sqlparser.ComparisonExpr:
+		// the parameters of a prepared statement get the type of the
+		// expression they are compared to
+		if tuple, ok := node.Right.(sqlparser.ValTuple); ok {
+			t.setArgumentTypes(sqlparser.Exprs(tuple), node.Left)
+			break
+		}
+		t.setArgumentTypes(sqlparser.Exprs{node.Right}, node.Left)
+		t.setArgumentTypes(sqlparser.Exprs{node.Left}, node.Right)
+	case *sqlparser.RangeCond:
+		t.setArgumentTypes(sqlparser.Exprs{node.From, node.To}, node.Left)
+	case *sqlparser.Limit:
+		for _, expr := range []sqlparser.Expr{node.Offset, node.Rowcount} {
+			if arg, ok := expr.(sqlparser.Argument); ok {
+				t.exprTypes[arg] = sqltypes.Int64
+			}
+		}
 	}
 	return nil
 }
 
+// setArgumentTypes sets the type of the other expression, if it is known,
+// on the arguments among the expressions that do not have a type yet.
+func (t *typer) setArgumentTypes(exprs sqlparser.Exprs, other sqlparser.Expr) {
+	if !validAsMapKey(other) {
+		return
+	}
+	typ, ok := t.exprTypes[other]
+	if !ok {
+		return
+	}
+	for _, expr := range exprs {
+		arg, isArg := expr.(sqlparser.Argument)
+		if !isArg {
+			continue
+		}
+		if _, typed := t.exprTypes[arg]; !typed {
+			t.exprTypes[arg] = typ
+		}
+	}
+}
+
 func (t *typer) setTypeFor(node *sqlparser.ColName, typ querypb.Type) {
 	t.exprTypes[node] = typ
 }
@@ -0,0 +1,136 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"flag"
+	"os"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"vitess.io/vitess/go/vt/vterrors"
+
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var (
+	sessionTokenKeyFile = flag.String("session_token_key_file", "", "File holding the key used to sign the session migration tokens. All the vtgates a session may migrate to must use the same key. ExportSession and ImportSession are disabled if unset.")
+	sessionTokenTTL     = flag.Duration("session_token_ttl", 5*time.Minute, "How long a session migration token returned by ExportSession can be imported.")
+)
+
+// sessionTokenVersion prefixes the tokens, so that their format can change
+// without older vtgates misreading newer tokens.
+const sessionTokenVersion = "v1"
+
+// sessionTokenKey reads the key of the session migration tokens. It is read
+// at each use so that the key can be rotated without restarting vtgate.
+func sessionTokenKey() ([]byte, error) {
+	if *sessionTokenKeyFile == "" {
+		return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "session migration tokens are disabled: -session_token_key_file is not set")
+	}
+	key, err := os.ReadFile(*sessionTokenKeyFile)
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "cannot read the session token key")
+	}
+	key = []byte(strings.TrimSpace(string(key)))
+	if len(key) == 0 {
+		return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "session token key file %v is empty", *sessionTokenKeyFile)
+	}
+	return key, nil
+}
+
+// newSessionToken returns a token carrying the state of the session that
+// survives a change of vtgate: its target, user variables and settings.
+// Sessions that hold a transaction or a lock cannot be migrated.
+func newSessionToken(session *vtgatepb.Session, key []byte, expireTime time.Time) (string, error) {
+	if session.InTransaction {
+		return "", vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "cannot export a session in a transaction")
+	}
+	if session.LockSession != nil {
+		return "", vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "cannot export a session holding a lock")
+	}
+
+	// The connections to the tablets are not migrated: a reserved session
+	// reserves new connections on the other vtgate, and applies its system
+	// variables to them.
+	migrated := &vtgatepb.Session{
+		Autocommit:              session.Autocommit,
+		TargetString:            session.TargetString,
+		Options:                 session.Options,
+		TransactionMode:         session.TransactionMode,
+		LastInsertId:            session.LastInsertId,
+		FoundRows:               session.FoundRows,
+		UserDefinedVariables:    session.UserDefinedVariables,
+		SystemVariables:         session.SystemVariables,
+		RowCount:                session.RowCount,
+		InReservedConn:          session.InReservedConn,
+		ReadAfterWrite:          session.ReadAfterWrite,
+		DDLStrategy:             session.DDLStrategy,
+		SessionUUID:             session.SessionUUID,
+		EnableSystemSettings:    session.EnableSystemSettings,
+		ConsistentSnapshotReads: session.ConsistentSnapshotReads,
+	}
+	payload, err := proto.Marshal(&vtgatepb.SessionToken{
+		Session:    migrated,
+		ExpireTime: expireTime.UnixNano(),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	encoded := base64.RawURLEncoding.EncodeToString(payload)
+	return sessionTokenVersion + "." + encoded + "." + signSessionToken(encoded, key), nil
+}
+
+// parseSessionToken checks the signature and the expiration of a token
+// returned by newSessionToken, and returns the session it carries.
+func parseSessionToken(token string, key []byte, now time.Time) (*vtgatepb.Session, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 || parts[0] != sessionTokenVersion {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid session token")
+	}
+	if !hmac.Equal([]byte(parts[2]), []byte(signSessionToken(parts[1], key))) {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid session token signature")
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid session token: %v", err)
+	}
+	sessionToken := &vtgatepb.SessionToken{}
+	if err := proto.Unmarshal(payload, sessionToken); err != nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid session token: %v", err)
+	}
+	if now.After(time.Unix(0, sessionToken.ExpireTime)) {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "session token expired at %v", time.Unix(0, sessionToken.ExpireTime).UTC())
+	}
+	if sessionToken.Session == nil {
+		return &vtgatepb.Session{Autocommit: true}, nil
+	}
+	return sessionToken.Session, nil
+}
+
+func signSessionToken(encoded string, key []byte) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(encoded))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/test/utils"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+func TestSessionToken(t *testing.T) {
+	key := []byte("key")
+	now := time.Now()
+	session := &vtgatepb.Session{
+		Autocommit:   true,
+		TargetString: "ks@replica",
+		ShardSessions: []*vtgatepb.Session_ShardSession{{
+			Target:     &querypb.Target{Keyspace: "ks", Shard: "0"},
+			ReservedId: 1,
+		}},
+		UserDefinedVariables: map[string]*querypb.BindVariable{
+			"a": sqltypes.Int64BindVariable(1),
+		},
+		SystemVariables: map[string]string{"sql_mode": "''"},
+		InReservedConn:  true,
+		Warnings:        []*querypb.QueryWarning{{Message: "warning"}},
+		DDLStrategy:     "online",
+		SessionUUID:     "uuid",
+	}
+
+	token, err := newSessionToken(session, key, now.Add(time.Minute))
+	require.NoError(t, err)
+
+	got, err := parseSessionToken(token, key, now)
+	require.NoError(t, err)
+	utils.MustMatch(t, &vtgatepb.Session{
+		Autocommit:   true,
+		TargetString: "ks@replica",
+		UserDefinedVariables: map[string]*querypb.BindVariable{
+			"a": sqltypes.Int64BindVariable(1),
+		},
+		SystemVariables: map[string]string{"sql_mode": "''"},
+		InReservedConn:  true,
+		DDLStrategy:     "online",
+		SessionUUID:     "uuid",
+	}, got)
+
+	_, err = parseSessionToken(token, []byte("other key"), now)
+	assert.EqualError(t, err, "invalid session token signature")
+
+	_, err = parseSessionToken(token, key, now.Add(2*time.Minute))
+	assert.Contains(t, err.Error(), "session token expired")
+
+	_, err = parseSessionToken("v2"+token[2:], key, now)
+	assert.EqualError(t, err, "invalid session token")
+
+	_, err = newSessionToken(&vtgatepb.Session{InTransaction: true}, key, now)
+	assert.EqualError(t, err, "cannot export a session in a transaction")
+
+	_, err = newSessionToken(&vtgatepb.Session{LockSession: &vtgatepb.Session_ShardSession{}}, key, now)
+	assert.EqualError(t, err, "cannot export a session holding a lock")
+}
+
+func TestExportImportSession(t *testing.T) {
+	ctx := context.Background()
+	defer func(keyFile string) {
+		*sessionTokenKeyFile = keyFile
+	}(*sessionTokenKeyFile)
+
+	*sessionTokenKeyFile = ""
+	_, err := rpcVTGate.ExportSession(ctx, &vtgatepb.Session{})
+	assert.Contains(t, err.Error(), "session migration tokens are disabled")
+
+	*sessionTokenKeyFile = filepath.Join(t.TempDir(), "key")
+	require.NoError(t, os.WriteFile(*sessionTokenKeyFile, []byte("key\n"), 0600))
+	token, err := rpcVTGate.ExportSession(ctx, &vtgatepb.Session{TargetString: "ks"})
+	require.NoError(t, err)
+	session, err := rpcVTGate.ImportSession(ctx, token)
+	require.NoError(t, err)
+	assert.Equal(t, "ks", session.TargetString)
+}
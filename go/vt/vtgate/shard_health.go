@@ -0,0 +1,335 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"flag"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var (
+	shardHealthErrorBudget   = flag.Float64("shard_health_error_budget", 0.001, "fraction of the queries to a shard that may fail within its SLO, e.g. 0.001 for 99.9% of successful queries. The error budget burn rate of a shard is its error rate over the last minute divided by this budget")
+	shardHealthLatencyTarget = flag.Duration("shard_health_latency_target", 100*time.Millisecond, "target p99 latency of the queries to a shard over the last minute")
+	shardHealthLagTarget     = flag.Duration("shard_health_lag_target", 30*time.Second, "target replication lag of the serving replicas of a shard")
+)
+
+const (
+	// shardHealthSlots is the number of one second slots of the window over
+	// which the queries to the shards are tracked.
+	shardHealthSlots = 60
+)
+
+// shardHealthLatencyCutoffs are the upper bounds of the buckets of the
+// latencies from which the p99 latency of a shard is computed.
+var shardHealthLatencyCutoffs = []time.Duration{
+	1 * time.Millisecond,
+	2 * time.Millisecond,
+	5 * time.Millisecond,
+	10 * time.Millisecond,
+	20 * time.Millisecond,
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	200 * time.Millisecond,
+	500 * time.Millisecond,
+	1 * time.Second,
+	2 * time.Second,
+	5 * time.Second,
+	10 * time.Second,
+}
+
+// isShardHealthError returns true if the error of a query counts against the
+// SLO of its shard. The errors caused by the queries themselves, e.g.
+// invalid or conflicting ones, do not.
+func isShardHealthError(err error) bool {
+	switch vterrors.Code(err) {
+	case vtrpcpb.Code_OK,
+		vtrpcpb.Code_CANCELED,
+		vtrpcpb.Code_INVALID_ARGUMENT,
+		vtrpcpb.Code_NOT_FOUND,
+		vtrpcpb.Code_ALREADY_EXISTS,
+		vtrpcpb.Code_PERMISSION_DENIED,
+		vtrpcpb.Code_UNAUTHENTICATED,
+		vtrpcpb.Code_FAILED_PRECONDITION,
+		vtrpcpb.Code_OUT_OF_RANGE:
+		return false
+	}
+	return true
+}
+
+type shardHealthKey struct {
+	keyspace string
+	shard    string
+}
+
+// shardHealthSlot counts the queries to a shard during one second.
+type shardHealthSlot struct {
+	second     int64
+	queries    int64
+	errors     int64
+	maxLatency time.Duration
+	// latencies are the counts of the queries by bucket of
+	// shardHealthLatencyCutoffs, the last one counting the slower ones.
+	latencies []int64
+}
+
+// shardHealthTracker tracks the queries to the shards over the last minute.
+type shardHealthTracker struct {
+	mu     sync.Mutex
+	shards map[shardHealthKey][]shardHealthSlot
+}
+
+func newShardHealthTracker() *shardHealthTracker {
+	return &shardHealthTracker{
+		shards: make(map[shardHealthKey][]shardHealthSlot),
+	}
+}
+
+// record counts a query to the shard of the target.
+func (sht *shardHealthTracker) record(target *querypb.Target, now time.Time, latency time.Duration, err error) {
+	key := shardHealthKey{keyspace: target.Keyspace, shard: target.Shard}
+	second := now.Unix()
+
+	sht.mu.Lock()
+	defer sht.mu.Unlock()
+	slots, ok := sht.shards[key]
+	if !ok {
+		slots = make([]shardHealthSlot, shardHealthSlots)
+		sht.shards[key] = slots
+	}
+	slot := &slots[second%shardHealthSlots]
+	if slot.second != second {
+		*slot = shardHealthSlot{second: second, latencies: make([]int64, len(shardHealthLatencyCutoffs)+1)}
+	}
+	slot.queries++
+	if isShardHealthError(err) {
+		slot.errors++
+	}
+	if latency > slot.maxLatency {
+		slot.maxLatency = latency
+	}
+	bucket := sort.Search(len(shardHealthLatencyCutoffs), func(i int) bool {
+		return latency <= shardHealthLatencyCutoffs[i]
+	})
+	slot.latencies[bucket]++
+}
+
+// shardHealth is the health of a shard, computed from its queries over the
+// last minute and the replication lag of its serving replicas.
+type shardHealth struct {
+	keyspace string
+	shard    string
+
+	queries int64
+	errors  int64
+	// burnRate is the error rate divided by -shard_health_error_budget.
+	burnRate   float64
+	p99Latency time.Duration
+	lag        time.Duration
+	// score goes from 100 when the shard meets all its targets down to 0,
+	// as the worst of ratios of the targets to the observed values.
+	score int64
+}
+
+// health returns the health of the shards that had queries over the last
+// minute or have serving replicas, sorted by keyspace and shard.
+func (sht *shardHealthTracker) health(now time.Time, lags map[shardHealthKey]time.Duration) []*shardHealth {
+	since := now.Unix() - shardHealthSlots
+	healths := make(map[shardHealthKey]*shardHealth)
+	get := func(key shardHealthKey) *shardHealth {
+		h, ok := healths[key]
+		if !ok {
+			h = &shardHealth{keyspace: key.keyspace, shard: key.shard}
+			healths[key] = h
+		}
+		return h
+	}
+
+	sht.mu.Lock()
+	for key, slots := range sht.shards {
+		sum := sumSlots(slots, since)
+		if sum.queries == 0 {
+			// Forget the shards without recent queries.
+			delete(sht.shards, key)
+			continue
+		}
+		healths[key] = &shardHealth{
+			keyspace:   key.keyspace,
+			shard:      key.shard,
+			queries:    sum.queries,
+			errors:     sum.errors,
+			p99Latency: percentileLatency(sum.latencies, sum.queries, 0.99, sum.maxLatency),
+		}
+	}
+	sht.mu.Unlock()
+
+	for key, lag := range lags {
+		get(key).lag = lag
+	}
+
+	result := make([]*shardHealth, 0, len(healths))
+	for _, h := range healths {
+		h.computeScore()
+		result = append(result, h)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].keyspace != result[j].keyspace {
+			return result[i].keyspace < result[j].keyspace
+		}
+		return result[i].shard < result[j].shard
+	})
+	return result
+}
+
+// latencyPercentile returns the percentile, e.g. 0.95, of the latencies of
+// the queries to the shard of the target over the last minute, or false if
+// there was none.
+func (sht *shardHealthTracker) latencyPercentile(target *querypb.Target, now time.Time, percentile float64) (time.Duration, bool) {
+	key := shardHealthKey{keyspace: target.Keyspace, shard: target.Shard}
+
+	sht.mu.Lock()
+	defer sht.mu.Unlock()
+	sum := sumSlots(sht.shards[key], now.Unix()-shardHealthSlots)
+	if sum.queries == 0 {
+		return 0, false
+	}
+	return percentileLatency(sum.latencies, sum.queries, percentile, sum.maxLatency), true
+}
+
+// sumSlots adds up the slots after the second.
+func sumSlots(slots []shardHealthSlot, since int64) shardHealthSlot {
+	sum := shardHealthSlot{latencies: make([]int64, len(shardHealthLatencyCutoffs)+1)}
+	for _, slot := range slots {
+		if slot.second <= since {
+			continue
+		}
+		sum.queries += slot.queries
+		sum.errors += slot.errors
+		if slot.maxLatency > sum.maxLatency {
+			sum.maxLatency = slot.maxLatency
+		}
+		for i, count := range slot.latencies {
+			sum.latencies[i] += count
+		}
+	}
+	return sum
+}
+
+// percentileLatency returns the upper bound of the bucket of the latencies
+// that holds the percentile, capped by the maximum latency.
+func percentileLatency(latencies []int64, count int64, percentile float64, maxLatency time.Duration) time.Duration {
+	rank := int64(math.Ceil(float64(count) * percentile))
+	var cumulative int64
+	for i, bucketCount := range latencies {
+		cumulative += bucketCount
+		if cumulative < rank {
+			continue
+		}
+		if i < len(shardHealthLatencyCutoffs) && shardHealthLatencyCutoffs[i] < maxLatency {
+			return shardHealthLatencyCutoffs[i]
+		}
+		break
+	}
+	return maxLatency
+}
+
+func (h *shardHealth) computeScore() {
+	score := 1.0
+	if h.queries > 0 && *shardHealthErrorBudget > 0 {
+		h.burnRate = float64(h.errors) / float64(h.queries) / *shardHealthErrorBudget
+		if h.burnRate > 1 {
+			score = math.Min(score, 1/h.burnRate)
+		}
+	}
+	if *shardHealthLatencyTarget > 0 && h.p99Latency > *shardHealthLatencyTarget {
+		score = math.Min(score, float64(*shardHealthLatencyTarget)/float64(h.p99Latency))
+	}
+	if *shardHealthLagTarget > 0 && h.lag > *shardHealthLagTarget {
+		score = math.Min(score, float64(*shardHealthLagTarget)/float64(h.lag))
+	}
+	h.score = int64(math.Floor(score * 100))
+}
+
+// replicaLags returns the highest replication lag of the serving replicas of
+// each shard.
+func (gw *TabletGateway) replicaLags() map[shardHealthKey]time.Duration {
+	lags := make(map[shardHealthKey]time.Duration)
+	for _, tcs := range gw.hc.CacheStatus() {
+		if tcs.Target.TabletType != topodatapb.TabletType_REPLICA {
+			continue
+		}
+		key := shardHealthKey{keyspace: tcs.Target.Keyspace, shard: tcs.Target.Shard}
+		for _, th := range tcs.TabletsStats {
+			if !th.Serving || th.Stats == nil {
+				continue
+			}
+			lag := time.Duration(th.Stats.ReplicationLagSeconds) * time.Second
+			if current, ok := lags[key]; !ok || lag > current {
+				lags[key] = lag
+			}
+		}
+	}
+	return lags
+}
+
+// shardHealth returns the health of the shards.
+func (gw *TabletGateway) shardHealth() []*shardHealth {
+	return gw.shardHealthTracker.health(time.Now(), gw.replicaLags())
+}
+
+// registerShardHealthStats exports the health of the shards.
+func (gw *TabletGateway) registerShardHealthStats() {
+	labels := []string{"Keyspace", "ShardName"}
+	byShard := func(value func(*shardHealth) int64) func() map[string]int64 {
+		return func() map[string]int64 {
+			values := make(map[string]int64)
+			for _, h := range gw.shardHealth() {
+				values[h.keyspace+"."+h.shard] = value(h)
+			}
+			return values
+		}
+	}
+	stats.NewGaugesFuncWithMultiLabels(
+		"ShardHealthScore",
+		"Health score of the shards, from 100 when they meet their error budget, p99 latency and replication lag targets down to 0",
+		labels,
+		byShard(func(h *shardHealth) int64 { return h.score }))
+	stats.NewGaugesFuncWithMultiLabels(
+		"ShardErrorBudgetBurnRatePercent",
+		"Error rate of the queries to the shards over the last minute, in percent of their error budget",
+		labels,
+		byShard(func(h *shardHealth) int64 { return int64(math.Round(h.burnRate * 100)) }))
+	stats.NewGaugesFuncWithMultiLabels(
+		"ShardQueryLatencyP99Ms",
+		"p99 latency of the queries to the shards over the last minute, in milliseconds",
+		labels,
+		byShard(func(h *shardHealth) int64 { return h.p99Latency.Milliseconds() }))
+	stats.NewGaugesFuncWithMultiLabels(
+		"ShardReplicationLagSeconds",
+		"Highest replication lag of the serving replicas of the shards",
+		labels,
+		byShard(func(h *shardHealth) int64 { return int64(h.lag.Seconds()) }))
+}
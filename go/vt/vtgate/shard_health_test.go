@@ -0,0 +1,103 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/discovery"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+func TestShardHealthTracker(t *testing.T) {
+	sht := newShardHealthTracker()
+	now := time.Now()
+	target := func(shard string) *querypb.Target {
+		return &querypb.Target{Keyspace: "ks", Shard: shard, TabletType: topodatapb.TabletType_REPLICA}
+	}
+
+	// Two errors out of 1000 queries burn the default budget of 0.1% twice
+	// as fast as allowed. The invalid queries are not the shard's fault.
+	for i := 0; i < 1000; i++ {
+		var err error
+		switch i {
+		case 0, 1:
+			err = vterrors.Errorf(vtrpcpb.Code_UNAVAILABLE, "unavailable")
+		case 2:
+			err = vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "syntax error")
+		}
+		sht.record(target("-80"), now.Add(-time.Duration(i%30)*time.Second), 10*time.Millisecond, err)
+	}
+	// The p99 latency of 500ms is five times the default target.
+	for i := 0; i < 100; i++ {
+		latency := 10 * time.Millisecond
+		if i < 2 {
+			latency = 500 * time.Millisecond
+		}
+		sht.record(target("80-"), now, latency, nil)
+	}
+	// The queries older than a minute are forgotten.
+	sht.record(target("old"), now.Add(-2*time.Minute), time.Millisecond, nil)
+
+	healths := sht.health(now, map[shardHealthKey]time.Duration{{keyspace: "ks", shard: "lagging"}: time.Minute})
+	require.Len(t, healths, 3)
+	assert.Equal(t, &shardHealth{keyspace: "ks", shard: "-80", queries: 1000, errors: 2, burnRate: 2, p99Latency: 10 * time.Millisecond, score: 50}, healths[0])
+	assert.Equal(t, &shardHealth{keyspace: "ks", shard: "80-", queries: 100, p99Latency: 500 * time.Millisecond, score: 20}, healths[1])
+	assert.Equal(t, &shardHealth{keyspace: "ks", shard: "lagging", lag: time.Minute, score: 50}, healths[2])
+	assert.NotContains(t, sht.shards, shardHealthKey{keyspace: "ks", shard: "old"})
+}
+
+func TestPercentileLatency(t *testing.T) {
+	// 1ms, 2ms, 5ms, ...: the p50 of 4 queries is in the 2ms bucket.
+	assert.Equal(t, 2*time.Millisecond, percentileLatency([]int64{1, 2, 1}, 4, 0.5, 5*time.Millisecond))
+	// The bucket is capped by the slowest query.
+	assert.Equal(t, 4*time.Millisecond, percentileLatency([]int64{1, 2, 1}, 4, 0.99, 4*time.Millisecond))
+	// The last bucket has no upper bound.
+	latencies := make([]int64, len(shardHealthLatencyCutoffs)+1)
+	latencies[len(shardHealthLatencyCutoffs)] = 1
+	assert.Equal(t, time.Minute, percentileLatency(latencies, 1, 0.99, time.Minute))
+}
+
+func TestTabletGatewayShardHealth(t *testing.T) {
+	target := &querypb.Target{Keyspace: "ks", Shard: "0", TabletType: topodatapb.TabletType_REPLICA}
+	hc := discovery.NewFakeHealthCheck()
+	tg := NewTabletGateway(context.Background(), hc, nil, "cell")
+	hc.AddTestTablet("cell", "1.1.1.1", 1001, "ks", "0", topodatapb.TabletType_REPLICA, true, 10, nil)
+	hc.AddTestTablet("cell", "1.1.1.2", 1001, "ks", "0", topodatapb.TabletType_REPLICA, true, 10, nil)
+	for i, th := range hc.GetHealthyTabletStats(target) {
+		th.Stats.ReplicationLagSeconds = uint32(45 + 45*i)
+	}
+
+	_, err := tg.Execute(context.Background(), target, "query", nil, 0, 0, nil)
+	require.NoError(t, err)
+
+	healths := tg.shardHealth()
+	require.Len(t, healths, 1)
+	assert.EqualValues(t, 1, healths[0].queries)
+	// The lag is the one of the most lagging replica.
+	assert.Equal(t, 90*time.Second, healths[0].lag)
+	assert.EqualValues(t, 33, healths[0].score)
+}
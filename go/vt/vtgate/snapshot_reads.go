@@ -0,0 +1,116 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"vitess.io/vitess/go/vt/concurrency"
+	"vitess.io/vitess/go/vt/srvtopo"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+const snapshotGTIDQuery = "select @@global.gtid_executed"
+
+// captureSnapshotGTIDs captures the GTID set executed by the primary of each
+// shard at the start of a transaction of a session with the
+// consistent_snapshot_reads mode, when the session reads from replicas.
+// The shard transactions then wait for their replica to execute the set of
+// their shard before they begin with a consistent snapshot, so that all the
+// reads of the transaction see the shards as of the same point, and see all
+// the writes committed before the transaction started. If the capture
+// fails, the transaction, which has no shard sessions yet, is cancelled.
+func (e *Executor) captureSnapshotGTIDs(ctx context.Context, safeSession *SafeSession) error {
+	if !safeSession.GetConsistentSnapshotReads() {
+		return nil
+	}
+	keyspace, tabletType, _, err := e.ParseDestinationTarget(safeSession.TargetString)
+	if err != nil {
+		safeSession.ResetTx()
+		return err
+	}
+	if tabletType == topodatapb.TabletType_PRIMARY {
+		// The primaries are always consistent with themselves.
+		return nil
+	}
+	gtids, err := e.primaryGTIDSets(ctx, keyspace)
+	if err != nil {
+		safeSession.ResetTx()
+		return vterrors.Wrap(err, "consistent snapshot reads: failed to capture the GTID sets of the primaries")
+	}
+	safeSession.SetSnapshotGTIDs(gtids)
+	return nil
+}
+
+// primaryGTIDSets returns the GTID sets executed by the primaries of the
+// shards of the keyspace, or of all the keyspaces if it is empty, keyed by
+// keyspace/shard.
+func (e *Executor) primaryGTIDSets(ctx context.Context, keyspace string) (map[string]string, error) {
+	var keyspaces []string
+	if keyspace != "" {
+		keyspaces = []string{keyspace}
+	} else {
+		for ks := range e.VSchema().Keyspaces {
+			keyspaces = append(keyspaces, ks)
+		}
+	}
+	var shards []*srvtopo.ResolvedShard
+	for _, ks := range keyspaces {
+		rss, _, err := e.resolver.resolver.GetAllShards(ctx, ks, topodatapb.TabletType_PRIMARY)
+		if err != nil {
+			return nil, err
+		}
+		shards = append(shards, rss...)
+	}
+
+	var (
+		mu    sync.Mutex
+		wg    sync.WaitGroup
+		gtids = make(map[string]string, len(shards))
+	)
+	allErrors := new(concurrency.AllErrorRecorder)
+	for _, rs := range shards {
+		wg.Add(1)
+		go func(rs *srvtopo.ResolvedShard) {
+			defer wg.Done()
+			qr, err := rs.Gateway.Execute(ctx, rs.Target, snapshotGTIDQuery, nil, 0, 0, nil)
+			if err != nil {
+				allErrors.RecordError(err)
+				return
+			}
+			if len(qr.Rows) != 1 || len(qr.Rows[0]) != 1 {
+				allErrors.RecordError(vterrors.Errorf(vtrpcpb.Code_INTERNAL, "unexpected result for %s on %s/%s: %v", snapshotGTIDQuery, rs.Target.Keyspace, rs.Target.Shard, qr.Rows))
+				return
+			}
+			// MySQL breaks the long GTID sets over several lines.
+			gtidSet := strings.Join(strings.Fields(qr.Rows[0][0].ToString()), "")
+			mu.Lock()
+			defer mu.Unlock()
+			gtids[rs.Target.Keyspace+"/"+rs.Target.Shard] = gtidSet
+		}(rs)
+	}
+	wg.Wait()
+	if err := allErrors.AggrError(vterrors.Aggregate); err != nil {
+		return nil, err
+	}
+	return gtids, nil
+}
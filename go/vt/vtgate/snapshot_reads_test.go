@@ -0,0 +1,85 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/discovery"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+func TestExecutorConsistentSnapshotReads(t *testing.T) {
+	executor, _, _, sbclookup := createExecutorEnv()
+	executor.normalize = true
+	// The legacy gateway refuses the transactions on the replicas.
+	*GatewayImplementation = tabletGatewayImplementation
+	defer func() { *GatewayImplementation = GatewayImplementationDiscovery }()
+	replica := vtgateHealthCheck.(*discovery.FakeHealthCheck).AddTestTablet("aa", "0r", 1, KsTestUnsharded, "0", topodatapb.TabletType_REPLICA, true, 1, nil)
+	session := NewSafeSession(&vtgatepb.Session{TargetString: KsTestUnsharded + "@replica"})
+
+	_, err := executor.Execute(ctx, "TestExecutorConsistentSnapshotReads", session, "set consistent_snapshot_reads = 1", nil)
+	require.NoError(t, err)
+	assert.True(t, session.ConsistentSnapshotReads)
+	qr, err := executor.Execute(ctx, "TestExecutorConsistentSnapshotReads", session, "select @@consistent_snapshot_reads", nil)
+	require.NoError(t, err)
+	assert.Equal(t, [][]sqltypes.Value{{sqltypes.NewInt64(1)}}, qr.Rows)
+	_, err = executor.Execute(ctx, "TestExecutorConsistentSnapshotReads", session, "set consistent_snapshot_reads = 'maybe'", nil)
+	require.Error(t, err)
+	assert.Equal(t, vtrpcpb.Code_INVALID_ARGUMENT, vterrors.Code(err))
+
+	// The GTID set of the primary is captured at the start of the
+	// transaction, and the replica begins at it.
+	sbclookup.SetResults([]*sqltypes.Result{sqltypes.MakeTestResult(sqltypes.MakeTestFields("gtid_executed", "varchar"), "uuid1:1-5,\nuuid2:1-3")})
+	_, err = executor.Execute(ctx, "TestExecutorConsistentSnapshotReads", session, "begin", nil)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"TestUnsharded/0": "uuid1:1-5,uuid2:1-3"}, session.SnapshotGtids)
+	assert.Equal(t, snapshotGTIDQuery, sbclookup.Queries[len(sbclookup.Queries)-1].Sql)
+
+	replica.Options = nil
+	_, err = executor.Execute(ctx, "TestExecutorConsistentSnapshotReads", session, "select id from music_user_map", nil)
+	require.NoError(t, err)
+	require.Len(t, replica.Options, 1)
+	assert.Equal(t, "uuid1:1-5,uuid2:1-3", replica.Options[0].SnapshotGtidSet)
+
+	_, err = executor.Execute(ctx, "TestExecutorConsistentSnapshotReads", session, "commit", nil)
+	require.NoError(t, err)
+	assert.Empty(t, session.SnapshotGtids)
+
+	// The transaction does not start if the GTID sets cannot be captured.
+	sbclookup.MustFailCodes[vtrpcpb.Code_UNAVAILABLE] = 1
+	_, err = executor.Execute(ctx, "TestExecutorConsistentSnapshotReads", session, "begin", nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "consistent snapshot reads: failed to capture the GTID sets of the primaries")
+	assert.False(t, session.InTransaction())
+
+	// Nothing is captured for the primaries.
+	primary := NewSafeSession(&vtgatepb.Session{TargetString: KsTestUnsharded + "@primary", ConsistentSnapshotReads: true})
+	sbclookup.Queries = nil
+	_, err = executor.Execute(ctx, "TestExecutorConsistentSnapshotReads", primary, "begin", nil)
+	require.NoError(t, err)
+	assert.Empty(t, primary.SnapshotGtids)
+	assert.Empty(t, sbclookup.Queries)
+}
@@ -0,0 +1,315 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"flag"
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+	"sync"
+
+	"vitess.io/vitess/go/flagutil"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/discovery"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+const (
+	// randomBalancer picks a random tablet, preferring the local cell.
+	randomBalancer = "random"
+	// roundRobinBalancer rotates through the tablets of a target, preferring
+	// the local cell.
+	roundRobinBalancer = "round_robin"
+	// leastOutstandingBalancer picks the tablet with the fewest inflight
+	// requests from this vtgate, in any cell.
+	leastOutstandingBalancer = "least_outstanding"
+	// zoneAffinityBalancer picks a random tablet in the local cell, unless all
+	// of them have too many inflight requests, in which case queries spill
+	// over to the least loaded tablets of the other cells.
+	zoneAffinityBalancer = "zone_affinity"
+	// cellAffinityBalancer picks the least loaded tablet, preferring the
+	// local cell, where the tablets of the remote cells of
+	// -allowed_remote_cells count a penalty of inflight requests on top of
+	// their own.
+	cellAffinityBalancer = "cell_affinity"
+)
+
+var (
+	tabletBalancerPolicy = flag.String("tablet_balancer_policy", randomBalancer, "the policy used to choose which healthy tablet of a target a query is sent to: random, round_robin, least_outstanding, zone_affinity or cell_affinity")
+	// tabletBalancerKeyspacePolicies overrides tabletBalancerPolicy for some keyspaces.
+	tabletBalancerKeyspacePolicies flagutil.StringMapValue
+	zoneAffinitySpillover          = flag.Int("tablet_balancer_spillover_inflight", 100, "with the zone_affinity tablet balancer policy, the number of inflight requests every tablet of the local cell must have reached for queries to spill over to the other cells")
+	remoteCellPenalty              = flag.Int("tablet_balancer_remote_cell_penalty", 100, "with the cell_affinity tablet balancer policy, the number of inflight requests added to those of the tablets of the remote cells of -allowed_remote_cells when comparing their load with that of the other tablets")
+
+	tabletInflightRequests = stats.NewGaugesWithMultiLabels("TabletGatewayInflightRequests", "Number of requests currently sent to each tablet by the tablet gateway", []string{"Keyspace", "ShardName", "TabletType", "TabletAlias"})
+)
+
+func init() {
+	flag.Var(&tabletBalancerKeyspacePolicies, "tablet_balancer_keyspace_policies", "comma-separated list of keyspace:policy pairs, overriding tablet_balancer_policy for the tablets of these keyspaces")
+}
+
+// tabletBalancer orders the healthy tablets of a target by preference. The
+// gateway sends a query to the first tablet, and retries with the next ones.
+type tabletBalancer interface {
+	sortTablets(target *querypb.Target, tablets []*discovery.TabletHealth)
+}
+
+// newTabletBalancer returns the tabletBalancer of a policy.
+func newTabletBalancer(policy string, gw *TabletGateway) (tabletBalancer, error) {
+	switch policy {
+	case randomBalancer:
+		return &randomTabletBalancer{gw: gw}, nil
+	case roundRobinBalancer:
+		return &roundRobinTabletBalancer{gw: gw, next: make(map[string]int)}, nil
+	case leastOutstandingBalancer:
+		return &leastOutstandingTabletBalancer{gw: gw}, nil
+	case zoneAffinityBalancer:
+		return &zoneAffinityTabletBalancer{gw: gw}, nil
+	case cellAffinityBalancer:
+		return &cellAffinityTabletBalancer{gw: gw}, nil
+	default:
+		return nil, fmt.Errorf("unknown tablet balancer policy %q", policy)
+	}
+}
+
+// newTabletBalancers returns the default tabletBalancer and the ones of the
+// keyspaces with a policy of their own, as configured by the flags.
+func newTabletBalancers(gw *TabletGateway) (tabletBalancer, map[string]tabletBalancer, error) {
+	def, err := newTabletBalancer(*tabletBalancerPolicy, gw)
+	if err != nil {
+		return nil, nil, err
+	}
+	keyspaceBalancers := make(map[string]tabletBalancer, len(tabletBalancerKeyspacePolicies))
+	for keyspace, policy := range tabletBalancerKeyspacePolicies {
+		balancer, err := newTabletBalancer(policy, gw)
+		if err != nil {
+			return nil, nil, fmt.Errorf("keyspace %v: %v", keyspace, err)
+		}
+		keyspaceBalancers[keyspace] = balancer
+	}
+	return def, keyspaceBalancers, nil
+}
+
+// randomTabletBalancer implements the random policy.
+type randomTabletBalancer struct {
+	gw *TabletGateway
+}
+
+func (b *randomTabletBalancer) sortTablets(_ *querypb.Target, tablets []*discovery.TabletHealth) {
+	b.gw.shuffleTablets(b.gw.localCell, tablets)
+}
+
+// roundRobinTabletBalancer implements the round_robin policy.
+type roundRobinTabletBalancer struct {
+	gw *TabletGateway
+
+	mu sync.Mutex
+	// next is the number of queries sent to each target so far.
+	next map[string]int
+}
+
+func (b *roundRobinTabletBalancer) sortTablets(target *querypb.Target, tablets []*discovery.TabletHealth) {
+	key := targetKey(target)
+	b.mu.Lock()
+	n := b.next[key]
+	b.next[key]++
+	b.mu.Unlock()
+
+	sort.Slice(tablets, func(i, j int) bool {
+		iLocal, jLocal := tablets[i].Tablet.Alias.Cell == b.gw.localCell, tablets[j].Tablet.Alias.Cell == b.gw.localCell
+		if iLocal != jLocal {
+			return iLocal
+		}
+		return topoproto.TabletAliasString(tablets[i].Tablet.Alias) < topoproto.TabletAliasString(tablets[j].Tablet.Alias)
+	})
+	local := 0
+	for local < len(tablets) && tablets[local].Tablet.Alias.Cell == b.gw.localCell {
+		local++
+	}
+	rotate(tablets[:local], n)
+	rotate(tablets[local:], n)
+}
+
+// rotate moves the first n%len(tablets) tablets to the end.
+func rotate(tablets []*discovery.TabletHealth, n int) {
+	if len(tablets) == 0 {
+		return
+	}
+	n %= len(tablets)
+	rotated := append(append([]*discovery.TabletHealth{}, tablets[n:]...), tablets[:n]...)
+	copy(tablets, rotated)
+}
+
+// leastOutstandingTabletBalancer implements the least_outstanding policy.
+type leastOutstandingTabletBalancer struct {
+	gw *TabletGateway
+}
+
+func (b *leastOutstandingTabletBalancer) sortTablets(_ *querypb.Target, tablets []*discovery.TabletHealth) {
+	// Shuffle first, so tablets with the same load are picked randomly.
+	rand.Shuffle(len(tablets), func(i, j int) {
+		tablets[i], tablets[j] = tablets[j], tablets[i]
+	})
+	b.gw.inflight.sortByInflight(tablets)
+}
+
+// zoneAffinityTabletBalancer implements the zone_affinity policy.
+type zoneAffinityTabletBalancer struct {
+	gw *TabletGateway
+}
+
+func (b *zoneAffinityTabletBalancer) sortTablets(_ *querypb.Target, tablets []*discovery.TabletHealth) {
+	b.gw.shuffleTablets(b.gw.localCell, tablets)
+	for i, th := range tablets {
+		if th.Tablet.Alias.Cell != b.gw.localCell {
+			// All the local tablets are too busy, if there are any.
+			break
+		}
+		if b.gw.inflight.get(th.Tablet.Alias) < int64(*zoneAffinitySpillover) {
+			tablets[0], tablets[i] = tablets[i], tablets[0]
+			return
+		}
+	}
+	b.gw.inflight.sortByInflight(tablets)
+}
+
+// cellAffinityTabletBalancer implements the cell_affinity policy.
+type cellAffinityTabletBalancer struct {
+	gw *TabletGateway
+}
+
+func (b *cellAffinityTabletBalancer) sortTablets(_ *querypb.Target, tablets []*discovery.TabletHealth) {
+	// Shuffle first, so tablets with the same cost are picked randomly.
+	b.gw.shuffleTablets(b.gw.localCell, tablets)
+
+	b.gw.inflight.mu.Lock()
+	costs := make([]int64, len(tablets))
+	for i, th := range tablets {
+		costs[i] = b.gw.inflight.counts[topoproto.TabletAliasString(th.Tablet.Alias)]
+		if discovery.IsRemoteCell(b.gw.localCell, th.Tablet.Alias.Cell) {
+			costs[i] += int64(*remoteCellPenalty)
+		}
+	}
+	b.gw.inflight.mu.Unlock()
+
+	sort.Stable(&tabletsByInflight{tablets: tablets, counts: costs})
+}
+
+// inflightRequests counts the requests currently sent to each tablet.
+type inflightRequests struct {
+	mu     sync.Mutex
+	counts map[string]int64
+	// labels has the label sets of tabletInflightRequests of each tablet, so
+	// they can be deleted once the tablet leaves the healthcheck.
+	labels map[string]map[string][]string
+}
+
+func newInflightRequests() *inflightRequests {
+	return &inflightRequests{
+		counts: make(map[string]int64),
+		labels: make(map[string]map[string][]string),
+	}
+}
+
+// start records a new request to a tablet. The returned function must be
+// called once it is done.
+func (ir *inflightRequests) start(target *querypb.Target, th *discovery.TabletHealth) (done func()) {
+	alias := topoproto.TabletAliasString(th.Tablet.Alias)
+	labels := []string{target.Keyspace, target.Shard, topoproto.TabletTypeLString(target.TabletType), alias}
+	ir.add(alias, labels, 1)
+	return func() {
+		ir.add(alias, labels, -1)
+	}
+}
+
+func (ir *inflightRequests) add(alias string, labels []string, delta int64) {
+	ir.mu.Lock()
+	defer ir.mu.Unlock()
+
+	ir.counts[alias] += delta
+	if ir.counts[alias] == 0 {
+		delete(ir.counts, alias)
+	}
+	if ir.labels[alias] == nil {
+		ir.labels[alias] = make(map[string][]string)
+	}
+	ir.labels[alias][strings.Join(labels, ".")] = labels
+	tabletInflightRequests.Add(labels, delta)
+}
+
+// prune deletes the gauges of the tablets without inflight requests that
+// are no longer in the healthcheck.
+func (ir *inflightRequests) prune(hc discovery.HealthCheck) {
+	ir.mu.Lock()
+	defer ir.mu.Unlock()
+
+	for alias, series := range ir.labels {
+		if ir.counts[alias] != 0 {
+			continue
+		}
+		if tabletAlias, err := topoproto.ParseTabletAlias(alias); err == nil {
+			if _, err := hc.TabletConnection(tabletAlias, nil); err == nil {
+				continue
+			}
+		}
+		for _, labels := range series {
+			tabletInflightRequests.Delete(labels)
+		}
+		delete(ir.labels, alias)
+	}
+}
+
+func (ir *inflightRequests) get(alias *topodatapb.TabletAlias) int64 {
+	ir.mu.Lock()
+	defer ir.mu.Unlock()
+
+	return ir.counts[topoproto.TabletAliasString(alias)]
+}
+
+// sortByInflight sorts the tablets by number of inflight requests, keeping
+// the current order of the tablets with the same number.
+func (ir *inflightRequests) sortByInflight(tablets []*discovery.TabletHealth) {
+	ir.mu.Lock()
+	counts := make([]int64, len(tablets))
+	for i, th := range tablets {
+		counts[i] = ir.counts[topoproto.TabletAliasString(th.Tablet.Alias)]
+	}
+	ir.mu.Unlock()
+
+	sort.Stable(&tabletsByInflight{tablets: tablets, counts: counts})
+}
+
+type tabletsByInflight struct {
+	tablets []*discovery.TabletHealth
+	counts  []int64
+}
+
+func (t *tabletsByInflight) Len() int           { return len(t.tablets) }
+func (t *tabletsByInflight) Less(i, j int) bool { return t.counts[i] < t.counts[j] }
+func (t *tabletsByInflight) Swap(i, j int) {
+	t.tablets[i], t.tablets[j] = t.tablets[j], t.tablets[i]
+	t.counts[i], t.counts[j] = t.counts[j], t.counts[i]
+}
+
+func targetKey(target *querypb.Target) string {
+	return fmt.Sprintf("%v/%v/%v", target.Keyspace, target.Shard, target.TabletType.String())
+}
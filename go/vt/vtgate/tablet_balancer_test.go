@@ -0,0 +1,202 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/discovery"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func newBalancerTestTablets(cells ...string) []*discovery.TabletHealth {
+	tablets := make([]*discovery.TabletHealth, len(cells))
+	for i, cell := range cells {
+		tablets[i] = &discovery.TabletHealth{
+			Tablet:  topo.NewTablet(uint32(i+1), cell, fmt.Sprintf("host%v", i+1)),
+			Target:  &querypb.Target{Keyspace: "k", Shard: "s", TabletType: topodatapb.TabletType_REPLICA},
+			Serving: true,
+			Stats:   &querypb.RealtimeStats{},
+		}
+	}
+	return tablets
+}
+
+func uids(tablets []*discovery.TabletHealth) []uint32 {
+	res := make([]uint32, len(tablets))
+	for i, th := range tablets {
+		res[i] = th.Tablet.Alias.Uid
+	}
+	return res
+}
+
+func TestTabletBalancerPolicies(t *testing.T) {
+	defer func() {
+		*tabletBalancerPolicy = randomBalancer
+		tabletBalancerKeyspacePolicies = nil
+	}()
+
+	*tabletBalancerPolicy = "fastest"
+	_, _, err := newTabletBalancers(&TabletGateway{})
+	assert.EqualError(t, err, `unknown tablet balancer policy "fastest"`)
+
+	*tabletBalancerPolicy = randomBalancer
+	require.NoError(t, tabletBalancerKeyspacePolicies.Set("rr:round_robin,lo:least_outstanding"))
+	gw := NewTabletGateway(context.Background(), nil, nil, "cell1")
+	assert.IsType(t, &randomTabletBalancer{}, gw.balancerFor(&querypb.Target{Keyspace: "other"}))
+	assert.IsType(t, &roundRobinTabletBalancer{}, gw.balancerFor(&querypb.Target{Keyspace: "rr"}))
+	assert.IsType(t, &leastOutstandingTabletBalancer{}, gw.balancerFor(&querypb.Target{Keyspace: "lo"}))
+}
+
+func TestRoundRobinTabletBalancer(t *testing.T) {
+	gw := &TabletGateway{localCell: "cell1"}
+	b, err := newTabletBalancer(roundRobinBalancer, gw)
+	require.NoError(t, err)
+	target := &querypb.Target{Keyspace: "k", Shard: "s", TabletType: topodatapb.TabletType_REPLICA}
+
+	var firsts []uint32
+	for i := 0; i < 4; i++ {
+		tablets := newBalancerTestTablets("cell2", "cell1", "cell1", "cell1")
+		b.sortTablets(target, tablets)
+		// The local tablets always come first.
+		assert.Equal(t, uint32(1), tablets[3].Tablet.Alias.Uid)
+		firsts = append(firsts, tablets[0].Tablet.Alias.Uid)
+	}
+	assert.Equal(t, []uint32{2, 3, 4, 2}, firsts)
+}
+
+func TestLeastOutstandingTabletBalancer(t *testing.T) {
+	gw := &TabletGateway{localCell: "cell1", inflight: newInflightRequests()}
+	b, err := newTabletBalancer(leastOutstandingBalancer, gw)
+	require.NoError(t, err)
+	target := &querypb.Target{Keyspace: "k", Shard: "s", TabletType: topodatapb.TabletType_REPLICA}
+
+	tablets := newBalancerTestTablets("cell1", "cell1", "cell2")
+	done1 := gw.inflight.start(target, tablets[0])
+	done2 := gw.inflight.start(target, tablets[1])
+	done3 := gw.inflight.start(target, tablets[1])
+
+	b.sortTablets(target, tablets)
+	assert.Equal(t, []uint32{3, 1, 2}, uids(tablets))
+	assert.Equal(t, int64(2), tabletInflightRequests.Counts()["k.s.replica.cell1-0000000002"])
+
+	done1()
+	done2()
+	done3()
+	assert.Zero(t, gw.inflight.get(tablets[2].Tablet.Alias))
+	assert.Zero(t, tabletInflightRequests.Counts()["k.s.replica.cell1-0000000002"])
+}
+
+func TestInflightRequestsPrune(t *testing.T) {
+	hc := discovery.NewFakeHealthCheck()
+	ir := newInflightRequests()
+	target := &querypb.Target{Keyspace: "k", Shard: "prune", TabletType: topodatapb.TabletType_REPLICA}
+	kept := hc.AddTestTablet("cell1", "host1", 1, "k", "prune", topodatapb.TabletType_REPLICA, true, 0, nil).Tablet()
+	removed := hc.AddTestTablet("cell1", "host2", 1, "k", "prune", topodatapb.TabletType_REPLICA, true, 0, nil).Tablet()
+	busy := hc.AddTestTablet("cell1", "host3", 1, "k", "prune", topodatapb.TabletType_REPLICA, true, 0, nil).Tablet()
+	for _, tablet := range []*topodatapb.Tablet{kept, removed} {
+		ir.start(target, &discovery.TabletHealth{Tablet: tablet})()
+	}
+	done := ir.start(target, &discovery.TabletHealth{Tablet: busy})
+	hc.RemoveTablet(removed)
+	hc.RemoveTablet(busy)
+
+	// Only the gauge of the idle tablet that left the healthcheck is deleted.
+	ir.prune(hc)
+	counts := tabletInflightRequests.Counts()
+	assert.Contains(t, counts, "k.prune.replica."+topoproto.TabletAliasString(kept.Alias))
+	assert.NotContains(t, counts, "k.prune.replica."+topoproto.TabletAliasString(removed.Alias))
+	assert.Equal(t, int64(1), counts["k.prune.replica."+topoproto.TabletAliasString(busy.Alias)])
+
+	done()
+	ir.prune(hc)
+	assert.NotContains(t, tabletInflightRequests.Counts(), "k.prune.replica."+topoproto.TabletAliasString(busy.Alias))
+}
+
+func TestZoneAffinityTabletBalancer(t *testing.T) {
+	defer func(spillover int) { *zoneAffinitySpillover = spillover }(*zoneAffinitySpillover)
+	*zoneAffinitySpillover = 1
+
+	gw := &TabletGateway{localCell: "cell1", inflight: newInflightRequests()}
+	b, err := newTabletBalancer(zoneAffinityBalancer, gw)
+	require.NoError(t, err)
+	target := &querypb.Target{Keyspace: "k", Shard: "s", TabletType: topodatapb.TabletType_REPLICA}
+
+	tablets := newBalancerTestTablets("cell2", "cell1", "cell1")
+	// Stay in the local cell while it has a tablet under the spillover.
+	done := gw.inflight.start(target, tablets[1])
+	for i := 0; i < 10; i++ {
+		b.sortTablets(target, tablets)
+		assert.Equal(t, uint32(3), tablets[0].Tablet.Alias.Uid)
+	}
+
+	// Spill over to the other cell once all local tablets are busy, by
+	// loading the last local tablet under the spillover.
+	defer gw.inflight.start(target, tablets[0])()
+	b.sortTablets(target, tablets)
+	assert.Equal(t, uint32(1), tablets[0].Tablet.Alias.Uid)
+
+	// And come back once they are not.
+	done()
+	b.sortTablets(target, tablets)
+	assert.Equal(t, "cell1", tablets[0].Tablet.Alias.Cell)
+}
+
+func TestCellAffinityTabletBalancer(t *testing.T) {
+	defer func(penalty int) { *remoteCellPenalty = penalty }(*remoteCellPenalty)
+	*remoteCellPenalty = 2
+	defer func() {
+		discovery.CellAffinityGroups = nil
+		discovery.AllowedRemoteCells = nil
+	}()
+	require.NoError(t, discovery.CellAffinityGroups.Set("cell1:east,cell2:east"))
+	require.NoError(t, discovery.AllowedRemoteCells.Set("cell3"))
+
+	gw := &TabletGateway{localCell: "cell1", inflight: newInflightRequests()}
+	b, err := newTabletBalancer(cellAffinityBalancer, gw)
+	require.NoError(t, err)
+	target := &querypb.Target{Keyspace: "k", Shard: "s", TabletType: topodatapb.TabletType_REPLICA}
+
+	tablets := newBalancerTestTablets("cell3", "cell2", "cell1")
+	// The local cell comes first among equally loaded tablets, and the
+	// remote cell last.
+	b.sortTablets(target, tablets)
+	assert.Equal(t, []uint32{3, 2, 1}, uids(tablets))
+
+	// The affinity group is not penalized.
+	defer gw.inflight.start(target, tablets[0])()
+	b.sortTablets(target, tablets)
+	assert.Equal(t, []uint32{2, 3, 1}, uids(tablets))
+
+	// The remote cell is picked once the others are loaded beyond the
+	// penalty.
+	for i := 0; i < 3; i++ {
+		defer gw.inflight.start(target, tablets[0])()
+		defer gw.inflight.start(target, tablets[1])()
+	}
+	b.sortTablets(target, tablets)
+	assert.Equal(t, uint32(1), tablets[0].Tablet.Alias.Uid)
+}
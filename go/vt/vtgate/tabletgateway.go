@@ -25,6 +25,7 @@ import (
 	"sync"
 	"time"
 
+	"vitess.io/vitess/go/stats"
 	"vitess.io/vitess/go/vt/topo/topoproto"
 
 	"vitess.io/vitess/go/vt/discovery"
@@ -52,6 +53,10 @@ var (
 	_ discovery.HealthCheck = (*discovery.HealthCheckImpl)(nil)
 	// CellsToWatch is the list of cells the healthcheck operates over. If it is empty, only the local cell is watched
 	CellsToWatch = flag.String("cells_to_watch", "", "comma-separated list of cells for watching tablets")
+
+	tabletGatewayCrossCellQueries = stats.NewCountersWithMultiLabels("TabletGatewayCrossCellQueries", "Number of queries the tablet gateway sent to the tablets of other cells than its own, by the cell of the tablet", []string{"Keyspace", "ShardName", "TabletType", "Cell"})
+
+	preferFreshReplicas = flag.Bool("gateway_prefer_fresh_replicas", false, "route queries for replicas to the healthy tablets with a replication lag under discovery_low_replication_lag first, keeping those of the local cell ahead, and only to the more lagged ones if they fail")
 )
 
 // TabletGateway implements the Gateway interface.
@@ -69,9 +74,27 @@ type TabletGateway struct {
 	// statusAggregators is a map indexed by the key
 	// keyspace/shard/tablet_type.
 	statusAggregators map[string]*TabletStatusAggregator
+	// tabletRoutingRules holds the tags of the preferred tablets, by
+	// keyspace. See vindexes.VSchema.TabletRoutingRules.
+	tabletRoutingRules map[string]map[string]string
+	// circuitBreakers holds the circuit breakers of the tablets, by alias
+	// and tablet type.
+	circuitBreakers map[string]*circuitBreaker
 
 	// buffer, if enabled, buffers requests during a detected PRIMARY failover.
 	buffer *buffer.Buffer
+
+	// balancer orders the healthy tablets of a target by preference, unless
+	// the keyspace of the target has its own in keyspaceBalancers.
+	balancer          tabletBalancer
+	keyspaceBalancers map[string]tabletBalancer
+	inflight          *inflightRequests
+
+	// shardHealthTracker tracks the queries to the shards to compute their
+	// health.
+	shardHealthTracker *shardHealthTracker
+	// hedgeBudget bounds the read queries that are hedged.
+	hedgeBudget *hedgeBudget
 }
 
 func createTabletGateway(ctx context.Context, _ discovery.LegacyHealthCheck, serv srvtopo.Server, cell string, _ int) Gateway {
@@ -105,12 +128,48 @@ func NewTabletGateway(ctx context.Context, hc discovery.HealthCheck, serv srvtop
 		localCell:         localCell,
 		retryCount:        *RetryCount,
 		statusAggregators: make(map[string]*TabletStatusAggregator),
+		circuitBreakers:   make(map[string]*circuitBreaker),
+		inflight:          newInflightRequests(),
+
+		shardHealthTracker: newShardHealthTracker(),
+		hedgeBudget:        &hedgeBudget{},
+	}
+	var err error
+	gw.balancer, gw.keyspaceBalancers, err = newTabletBalancers(gw)
+	if err != nil {
+		log.Exitf("Unable to create new TabletGateway: %v", err)
 	}
 	gw.setupBuffering(ctx)
+	go gw.pruneInflightRequests(ctx)
 	gw.QueryService = queryservice.Wrap(nil, gw.withRetry)
 	return gw
 }
 
+// pruneInflightRequests deletes the inflight requests gauges of the tablets
+// that left the healthcheck, every time the tablets are refreshed, until ctx
+// is done.
+func (gw *TabletGateway) pruneInflightRequests(ctx context.Context) {
+	if *discovery.RefreshInterval <= 0 {
+		return
+	}
+	ticker := time.NewTicker(*discovery.RefreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			gw.inflight.prune(gw.hc)
+		}
+	}
+}
+
+// HealthCheck returns the health check of the TabletGateway of this process,
+// or nil if there is none.
+func HealthCheck() discovery.HealthCheck {
+	return vtgateHealthCheck
+}
+
 func (gw *TabletGateway) setupBuffering(ctx context.Context) {
 	cfg := buffer.NewConfigFromFlags()
 	gw.buffer = buffer.New(cfg)
@@ -171,10 +230,27 @@ func (gw *TabletGateway) QueryServiceByAlias(alias *topodatapb.TabletAlias, targ
 	return gw.hc.TabletConnection(alias, target)
 }
 
+// PrimaryTablet is part of the Gateway interface.
+func (gw *TabletGateway) PrimaryTablet(keyspace, shard string) *topodatapb.Tablet {
+	tablets := gw.hc.GetHealthyTabletStats(&querypb.Target{Keyspace: keyspace, Shard: shard, TabletType: topodatapb.TabletType_PRIMARY})
+	if len(tablets) == 0 {
+		return nil
+	}
+	return tablets[0].Tablet
+}
+
+// SetTabletRoutingRules is part of the Gateway interface.
+func (gw *TabletGateway) SetTabletRoutingRules(rules map[string]map[string]string) {
+	gw.mu.Lock()
+	defer gw.mu.Unlock()
+	gw.tabletRoutingRules = rules
+}
+
 // RegisterStats registers the stats to export the lag since the last refresh
 // and the checksum of the topology
 func (gw *TabletGateway) RegisterStats() {
 	gw.hc.RegisterStats()
+	gw.registerShardHealthStats()
 }
 
 // WaitForTablets is part of the Gateway interface.
@@ -216,9 +292,10 @@ func (gw *TabletGateway) CacheStatus() TabletCacheStatusList {
 // it retries retryCount times before failing. It does not retry if the connection is in
 // the middle of a transaction. While returning the error check if it maybe a result of
 // a resharding event, and set the re-resolve bit and let the upper layers
-// re-resolve and retry.
+// re-resolve and retry. The slow read queries to replicas may be hedged, see
+// -gateway_hedge_percentile.
 func (gw *TabletGateway) withRetry(ctx context.Context, target *querypb.Target, _ queryservice.QueryService,
-	_ string, inTransaction bool, inner func(ctx context.Context, target *querypb.Target, conn queryservice.QueryService) (bool, error)) error {
+	name string, inTransaction bool, inner func(ctx context.Context, target *querypb.Target, conn queryservice.QueryService) (bool, error)) error {
 	// for transactions, we connect to a specific tablet instead of letting gateway choose one
 	if inTransaction && target.TabletType != topodatapb.TabletType_PRIMARY {
 		return vterrors.Errorf(vtrpcpb.Code_INTERNAL, "gateway's query service can only be used for non-transactional queries on replicas")
@@ -278,20 +355,36 @@ func (gw *TabletGateway) withRetry(ctx context.Context, target *querypb.Target,
 			err = vterrors.Errorf(vtrpcpb.Code_UNAVAILABLE, "no healthy tablet available for '%s'", target.String())
 			break
 		}
-		gw.shuffleTablets(gw.localCell, tablets)
+		gw.balancerFor(target).sortTablets(target, tablets)
+		gw.preferTaggedTablets(target, tablets)
+		if *preferFreshReplicas && target.TabletType != topodatapb.TabletType_PRIMARY {
+			gw.preferFreshTablets(gw.localCell, tablets)
+		}
 
 		var th *discovery.TabletHealth
-		// skip tablets we tried before
+		var cb *circuitBreaker
+		circuitOpen := false
+		// skip tablets we tried before, and the ones whose circuit breaker is open
 		for _, t := range tablets {
-			if _, ok := invalidTablets[topoproto.TabletAliasString(t.Tablet.Alias)]; !ok {
-				th = t
-				break
+			if _, ok := invalidTablets[topoproto.TabletAliasString(t.Tablet.Alias)]; ok {
+				continue
 			}
+			cb = gw.circuitBreakerFor(target, t.Tablet)
+			if !cb.allow(time.Now()) {
+				circuitOpen = true
+				continue
+			}
+			th = t
+			break
 		}
 		if th == nil {
 			// do not override error from last attempt.
 			if err == nil {
-				err = vterrors.New(vtrpcpb.Code_UNAVAILABLE, "no available connection")
+				if circuitOpen {
+					err = errCircuitBreakerOpen(target)
+				} else {
+					err = vterrors.New(vtrpcpb.Code_UNAVAILABLE, "no available connection")
+				}
 			}
 			break
 		}
@@ -300,13 +393,31 @@ func (gw *TabletGateway) withRetry(ctx context.Context, target *querypb.Target,
 		// execute
 		if th.Conn == nil {
 			err = vterrors.Errorf(vtrpcpb.Code_UNAVAILABLE, "no connection for tablet %v", tabletLastUsed)
+			cb.record(time.Now(), err)
 			invalidTablets[topoproto.TabletAliasString(tabletLastUsed.Alias)] = true
 			continue
 		}
 
+		if th.Tablet.Alias.Cell != gw.localCell {
+			tabletGatewayCrossCellQueries.Add([]string{target.Keyspace, target.Shard, topoproto.TabletTypeLString(target.TabletType), th.Tablet.Alias.Cell}, 1)
+		}
+
+		conn := th.Conn
+		hedged := gw.hedgedConnFor(target, name, inTransaction, th, cb, tablets, invalidTablets)
+		if hedged != nil {
+			conn = hedged
+		}
+
 		startTime := time.Now()
 		var canRetry bool
-		canRetry, err = inner(ctx, target, th.Conn)
+		canRetry, err = func() (bool, error) {
+			defer gw.inflight.start(target, th)()
+			return inner(ctx, target, conn)
+		}()
+		if hedged == nil {
+			// The hedged connections record the outcome of each tablet.
+			cb.record(time.Now(), err)
+		}
 		gw.updateStats(target, startTime, err)
 		if canRetry {
 			invalidTablets[topoproto.TabletAliasString(tabletLastUsed.Alias)] = true
@@ -321,6 +432,7 @@ func (gw *TabletGateway) updateStats(target *querypb.Target, startTime time.Time
 	elapsed := time.Since(startTime)
 	aggr := gw.getStatsAggregator(target)
 	aggr.UpdateQueryInfo("", target.TabletType, elapsed, err != nil)
+	gw.shardHealthTracker.record(target, time.Now(), elapsed, err)
 }
 
 func (gw *TabletGateway) getStatsAggregator(target *querypb.Target) *TabletStatusAggregator {
@@ -377,6 +489,55 @@ func (gw *TabletGateway) shuffleTablets(cell string, tablets []*discovery.Tablet
 	}
 }
 
+// balancerFor returns the tabletBalancer of a target.
+func (gw *TabletGateway) balancerFor(target *querypb.Target) tabletBalancer {
+	if balancer, ok := gw.keyspaceBalancers[target.Keyspace]; ok {
+		return balancer
+	}
+	return gw.balancer
+}
+
+// preferFreshTablets moves the tablets with a low replication lag ahead of the
+// others, while keeping the tablets of the given cell first, and the order of
+// the tablet balancer otherwise.
+func (gw *TabletGateway) preferFreshTablets(cell string, tablets []*discovery.TabletHealth) {
+	rank := func(th *discovery.TabletHealth) int {
+		r := 0
+		if th.Tablet.Alias.Cell != cell {
+			r += 2
+		}
+		if isLagging(th) {
+			r++
+		}
+		return r
+	}
+	sort.SliceStable(tablets, func(i, j int) bool {
+		return rank(tablets[i]) < rank(tablets[j])
+	})
+}
+
+// preferTaggedTablets moves the tablets with the tags preferred by the tablet
+// routing rule of the keyspace ahead of the others, keeping the order of the
+// tablet balancer otherwise.
+func (gw *TabletGateway) preferTaggedTablets(target *querypb.Target, tablets []*discovery.TabletHealth) {
+	gw.mu.Lock()
+	tags, ok := gw.tabletRoutingRules[target.Keyspace]
+	if !ok {
+		tags = gw.tabletRoutingRules[""]
+	}
+	gw.mu.Unlock()
+	if len(tags) == 0 {
+		return
+	}
+	sort.SliceStable(tablets, func(i, j int) bool {
+		return topoproto.TabletHasTags(tablets[i].Tablet, tags) && !topoproto.TabletHasTags(tablets[j].Tablet, tags)
+	})
+}
+
+func isLagging(th *discovery.TabletHealth) bool {
+	return th.Stats != nil && discovery.IsReplicationLagHigh(th)
+}
+
 func (gw *TabletGateway) nextTablet(cell string, tablets []*discovery.TabletHealth, offset, length int, sameCell bool) int {
 	for ; offset < length; offset++ {
 		if (tablets[offset].Tablet.Alias.Cell == cell) == sameCell {
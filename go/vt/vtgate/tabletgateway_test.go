@@ -163,6 +163,58 @@ func TestTabletGatewayShuffleTablets(t *testing.T) {
 	}
 }
 
+func TestTabletGatewayPreferFreshTablets(t *testing.T) {
+	tg := NewTabletGateway(context.Background(), nil, nil, "local")
+
+	newTablet := func(uid uint32, cell string, lag uint32) *discovery.TabletHealth {
+		return &discovery.TabletHealth{
+			Tablet:  topo.NewTablet(uid, cell, fmt.Sprintf("host%v", uid)),
+			Target:  &querypb.Target{Keyspace: "k", Shard: "s", TabletType: topodatapb.TabletType_REPLICA},
+			Serving: true,
+			Stats:   &querypb.RealtimeStats{ReplicationLagSeconds: lag},
+		}
+	}
+	staleLocal := newTablet(1, "cell1", 60)
+	freshLocal := newTablet(2, "cell1", 1)
+	staleRemote := newTablet(3, "cell2", 60)
+	freshRemote := newTablet(4, "cell2", 1)
+
+	tablets := []*discovery.TabletHealth{staleRemote, freshRemote, staleLocal, freshLocal}
+	tg.preferFreshTablets("cell1", tablets)
+	assert.Equal(t, []*discovery.TabletHealth{freshLocal, staleLocal, freshRemote, staleRemote}, tablets)
+}
+
+func TestTabletGatewayPreferTaggedTablets(t *testing.T) {
+	tg := NewTabletGateway(context.Background(), nil, nil, "local")
+	tg.SetTabletRoutingRules(map[string]map[string]string{
+		"":  {"ssd": "true"},
+		"k": {"pool": "batch"},
+	})
+
+	newTablet := func(uid uint32, keyspace string, tags map[string]string) *discovery.TabletHealth {
+		tablet := topo.NewTablet(uid, "cell1", fmt.Sprintf("host%v", uid))
+		tablet.Tags = tags
+		return &discovery.TabletHealth{
+			Tablet:  tablet,
+			Target:  &querypb.Target{Keyspace: keyspace, Shard: "s", TabletType: topodatapb.TabletType_REPLICA},
+			Serving: true,
+		}
+	}
+	untagged := newTablet(1, "k", nil)
+	ssd := newTablet(2, "k", map[string]string{"ssd": "true"})
+	batch := newTablet(3, "k", map[string]string{"pool": "batch", "ssd": "true"})
+	batch2 := newTablet(4, "k", map[string]string{"pool": "batch"})
+
+	tablets := []*discovery.TabletHealth{untagged, ssd, batch, batch2}
+	tg.preferTaggedTablets(tablets[0].Target, tablets)
+	assert.Equal(t, []*discovery.TabletHealth{batch, batch2, untagged, ssd}, tablets)
+
+	// The default rule applies to the other keyspaces.
+	tablets = []*discovery.TabletHealth{untagged, batch2, ssd}
+	tg.preferTaggedTablets(&querypb.Target{Keyspace: "other"}, tablets)
+	assert.Equal(t, []*discovery.TabletHealth{ssd, untagged, batch2}, tablets)
+}
+
 func TestTabletGatewayReplicaTransactionError(t *testing.T) {
 	keyspace := "ks"
 	shard := "0"
@@ -184,6 +236,29 @@ func TestTabletGatewayReplicaTransactionError(t *testing.T) {
 	verifyContainsError(t, err, "query service can only be used for non-transactional queries on replicas", vtrpcpb.Code_INTERNAL)
 }
 
+func TestTabletGatewayCrossCellQueries(t *testing.T) {
+	target := &querypb.Target{
+		Keyspace:   "crossks",
+		Shard:      "0",
+		TabletType: topodatapb.TabletType_REPLICA,
+	}
+	hc := discovery.NewFakeHealthCheck()
+	tg := NewTabletGateway(context.Background(), hc, nil, "cell")
+	before := tabletGatewayCrossCellQueries.Counts()["crossks.0.replica.cell2"]
+
+	// Only the queries sent to other cells are counted.
+	hc.AddTestTablet("cell", "1.1.1.1", 1001, target.Keyspace, target.Shard, target.TabletType, true, 10, nil)
+	_, err := tg.Execute(context.Background(), target, "query", nil, 0, 0, nil)
+	require.NoError(t, err)
+	assert.Equal(t, before, tabletGatewayCrossCellQueries.Counts()["crossks.0.replica.cell2"])
+
+	hc.Reset()
+	hc.AddTestTablet("cell2", "1.1.1.2", 1001, target.Keyspace, target.Shard, target.TabletType, true, 10, nil)
+	_, err = tg.Execute(context.Background(), target, "query", nil, 0, 0, nil)
+	require.NoError(t, err)
+	assert.Equal(t, before+1, tabletGatewayCrossCellQueries.Counts()["crossks.0.replica.cell2"])
+}
+
 func testTabletGatewayGeneric(t *testing.T, f func(tg *TabletGateway, target *querypb.Target) error) {
 	t.Helper()
 	keyspace := "ks"
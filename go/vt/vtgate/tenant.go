@@ -0,0 +1,382 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/sysvars"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/semantics"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// tenantArgName is the bind variable of the tenant of the session, the one
+// of the tenant system variable.
+var tenantArgName = bindVarPrefix + sysvars.Tenant.Name
+
+var tenantRejectedQueries = stats.NewCounter("TenantRejectedQueries", "Queries of tenant sessions rejected because they could access the rows of other tenants")
+
+// scopeToTenant restricts the statement of a session with a tenant to the
+// rows of the tenant in the tenant-scoped tables: the selects, updates and
+// deletes get a predicate on the tenant column of each of their
+// tenant-scoped tables, and the inserts get or check the tenant column of
+// their rows. The predicates are then verified with the semantic analysis
+// of the statement, and the statements that could access the rows of other
+// tenants fail. It returns whether the statement was changed.
+func scopeToTenant(vcursor *vcursorImpl, stmt sqlparser.Statement, bindVars map[string]*querypb.BindVariable) (bool, error) {
+	scoper := &tenantScoper{vcursor: vcursor, tenant: vcursor.safeSession.Tenant, bindVars: bindVars}
+	changed, err := scoper.scope(stmt)
+	if err == nil && changed {
+		err = scoper.verify(stmt)
+	}
+	if err != nil {
+		tenantRejectedQueries.Add(1)
+		return false, err
+	}
+	return changed, nil
+}
+
+type tenantScoper struct {
+	vcursor  *vcursorImpl
+	tenant   string
+	bindVars map[string]*querypb.BindVariable
+}
+
+func (ts *tenantScoper) scope(stmt sqlparser.Statement) (bool, error) {
+	changed := false
+	var err error
+	sqlparser.Rewrite(stmt, func(cursor *sqlparser.Cursor) bool {
+		var predicates []sqlparser.Expr
+		switch node := cursor.Node().(type) {
+		case *sqlparser.Select:
+			predicates, err = ts.fromPredicates(node.From)
+			for _, predicate := range predicates {
+				node.AddWhere(predicate)
+			}
+		case *sqlparser.Update:
+			if err = ts.checkUpdateExprs(node.TableExprs, node.Exprs); err != nil {
+				return false
+			}
+			predicates, err = ts.fromPredicates(node.TableExprs)
+			for _, predicate := range predicates {
+				node.AddWhere(predicate)
+			}
+		case *sqlparser.Delete:
+			predicates, err = ts.fromPredicates(node.TableExprs)
+			for _, predicate := range predicates {
+				node.Where = addWhere(node.Where, predicate)
+			}
+		case *sqlparser.Insert:
+			var scoped bool
+			scoped, err = ts.scopeInsert(node)
+			changed = changed || scoped
+		}
+		changed = changed || len(predicates) > 0
+		return err == nil
+	}, nil)
+	return changed, err
+}
+
+// fromPredicates returns the tenant predicates to add to the WHERE clause
+// of the tables. The ones of the inner tables of outer joins go to the ON
+// condition of their join instead, not to filter out the rows of the outer
+// tables, and are not returned.
+func (ts *tenantScoper) fromPredicates(tables sqlparser.TableExprs) ([]sqlparser.Expr, error) {
+	var predicates []sqlparser.Expr
+	for _, table := range tables {
+		tablePredicates, err := ts.tablePredicates(table)
+		if err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, tablePredicates...)
+	}
+	return predicates, nil
+}
+
+func (ts *tenantScoper) tablePredicates(table sqlparser.TableExpr) ([]sqlparser.Expr, error) {
+	switch node := table.(type) {
+	case *sqlparser.AliasedTableExpr:
+		name, ok := node.Expr.(sqlparser.TableName)
+		if !ok {
+			// the tables of derived tables get the predicates of their select
+			return nil, nil
+		}
+		tenant := ts.tenantOf(name)
+		if tenant == nil {
+			return nil, nil
+		}
+		if !node.As.IsEmpty() {
+			name = sqlparser.TableName{Name: node.As}
+		}
+		return []sqlparser.Expr{tenantPredicate(sqlparser.NewColNameWithQualifier(tenant.Column.String(), name))}, nil
+	case *sqlparser.ParenTableExpr:
+		return ts.fromPredicates(node.Exprs)
+	case *sqlparser.JoinTableExpr:
+		left, err := ts.tablePredicates(node.LeftExpr)
+		if err != nil {
+			return nil, err
+		}
+		right, err := ts.tablePredicates(node.RightExpr)
+		if err != nil {
+			return nil, err
+		}
+		switch node.Join {
+		case sqlparser.LeftJoinType, sqlparser.NaturalLeftJoinType:
+			return left, ts.addJoinPredicates(node, right)
+		case sqlparser.RightJoinType, sqlparser.NaturalRightJoinType:
+			return right, ts.addJoinPredicates(node, left)
+		}
+		return append(left, right...), nil
+	}
+	return nil, nil
+}
+
+func (ts *tenantScoper) addJoinPredicates(join *sqlparser.JoinTableExpr, predicates []sqlparser.Expr) error {
+	if len(predicates) == 0 {
+		return nil
+	}
+	if join.Condition == nil || join.Condition.Using != nil || join.Join == sqlparser.NaturalLeftJoinType || join.Join == sqlparser.NaturalRightJoinType {
+		return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: outer join with USING or NATURAL on tenant-scoped table in a session with a tenant, use an ON condition instead")
+	}
+	join.Condition.On = sqlparser.AndExpressions(append([]sqlparser.Expr{join.Condition.On}, predicates...)...)
+	return nil
+}
+
+func (ts *tenantScoper) checkUpdateExprs(tables sqlparser.TableExprs, exprs sqlparser.UpdateExprs) error {
+	columns := ts.tenantColumns(tables)
+	for _, expr := range exprs {
+		if columns[expr.Name.Name.Lowered()] {
+			return vterrors.Errorf(vtrpcpb.Code_PERMISSION_DENIED, "the tenant column %s can not be updated in a session with a tenant", sqlparser.String(expr.Name))
+		}
+	}
+	return nil
+}
+
+// tenantColumns returns the tenant columns of the tenant-scoped tables
+// among the tables.
+func (ts *tenantScoper) tenantColumns(tables sqlparser.TableExprs) map[string]bool {
+	columns := map[string]bool{}
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *sqlparser.DerivedTable:
+			return false, nil
+		case sqlparser.TableName:
+			if tenant := ts.tenantOf(node); tenant != nil {
+				columns[tenant.Column.Lowered()] = true
+			}
+		}
+		return true, nil
+	}, tables)
+	return columns
+}
+
+// scopeInsert adds the tenant column to the rows of the inserts into
+// tenant-scoped tables, or checks its values if it is in the insert.
+func (ts *tenantScoper) scopeInsert(insert *sqlparser.Insert) (bool, error) {
+	tenant := ts.tenantOf(insert.Table)
+	if tenant == nil {
+		return false, nil
+	}
+	tableName := sqlparser.String(insert.Table)
+	rows, ok := insert.Rows.(sqlparser.Values)
+	if !ok {
+		return false, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: insert with a select into tenant-scoped table %s in a session with a tenant", tableName)
+	}
+	if len(insert.Columns) == 0 {
+		return false, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "the columns of the insert into tenant-scoped table %s must be listed in a session with a tenant", tableName)
+	}
+	for _, expr := range insert.OnDup {
+		if expr.Name.Name.Equal(tenant.Column) {
+			return false, vterrors.Errorf(vtrpcpb.Code_PERMISSION_DENIED, "the tenant column %s can not be updated in a session with a tenant", sqlparser.String(expr.Name))
+		}
+	}
+	col := insert.Columns.FindColumn(tenant.Column)
+	if col == -1 {
+		insert.Columns = append(insert.Columns, tenant.Column)
+		for i := range rows {
+			rows[i] = append(rows[i], sqlparser.NewArgument(tenantArgName))
+		}
+		return true, nil
+	}
+	for _, row := range rows {
+		if col >= len(row) || !ts.isTenant(row[col]) {
+			return false, vterrors.Errorf(vtrpcpb.Code_PERMISSION_DENIED, "the rows inserted into tenant-scoped table %s in a session with a tenant must have tenant %s", tableName, ts.tenant)
+		}
+	}
+	return false, nil
+}
+
+// isTenant returns whether the value of the expression is the tenant of
+// the session.
+func (ts *tenantScoper) isTenant(expr sqlparser.Expr) bool {
+	switch expr := expr.(type) {
+	case *sqlparser.Literal:
+		return (expr.Type == sqlparser.StrVal || expr.Type == sqlparser.IntVal) && expr.Val == ts.tenant
+	case sqlparser.Argument:
+		if string(expr) == tenantArgName {
+			return true
+		}
+		bv, ok := ts.bindVars[string(expr)]
+		return ok && bv.Type != querypb.Type_TUPLE && string(bv.Value) == ts.tenant
+	}
+	return false
+}
+
+func (ts *tenantScoper) tenantOf(name sqlparser.TableName) *vindexes.Tenant {
+	table, err := ts.vcursor.FindRoutedTable(name)
+	if err != nil || table == nil {
+		return nil
+	}
+	return table.Tenant
+}
+
+// verify checks with the semantic analysis of the statement that each of
+// its tenant-scoped tables has the predicate of its tenant column.
+func (ts *tenantScoper) verify(stmt sqlparser.Statement) error {
+	var selects []sqlparser.SelectStatement
+	switch node := stmt.(type) {
+	case *sqlparser.Union:
+		selects = append(selects, unionSelects(node)...)
+	case sqlparser.SelectStatement:
+		selects = append(selects, node)
+	case *sqlparser.Update:
+		sel := &sqlparser.Select{From: node.TableExprs, Where: node.Where}
+		for _, expr := range node.Exprs {
+			sel.SelectExprs = append(sel.SelectExprs, &sqlparser.AliasedExpr{Expr: expr.Expr})
+		}
+		selects = append(selects, sel)
+	case *sqlparser.Delete:
+		selects = append(selects, &sqlparser.Select{
+			SelectExprs: sqlparser.SelectExprs{&sqlparser.AliasedExpr{Expr: sqlparser.NewIntLiteral("1")}},
+			From:        node.TableExprs,
+			Where:       node.Where,
+		})
+	case *sqlparser.Insert:
+		_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+			if subquery, ok := node.(*sqlparser.Subquery); ok {
+				selects = append(selects, subquery.Select)
+				return false, nil
+			}
+			return true, nil
+		}, node.Rows, node.OnDup)
+	}
+	ksName := ""
+	if ks, _ := ts.vcursor.DefaultKeyspace(); ks != nil {
+		ksName = ks.Name
+	}
+	for _, sel := range selects {
+		if err := ts.verifySelect(sel, ksName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (ts *tenantScoper) verifySelect(sel sqlparser.SelectStatement, ksName string) error {
+	semTable, err := semantics.Analyze(sel, ksName, ts.vcursor, semantics.NoRewrite)
+	if err != nil {
+		return vterrors.Wrapf(err, "the tenant predicates of the query can not be verified")
+	}
+	// the tenant predicates only scope their tables when they are one of
+	// the conditions of a WHERE clause or of the ON condition of a join
+	var conditions []sqlparser.Expr
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *sqlparser.Where:
+			if node.Type == sqlparser.WhereClause {
+				conditions = sqlparser.SplitAndExpression(conditions, node.Expr)
+			}
+		case *sqlparser.JoinCondition:
+			conditions = sqlparser.SplitAndExpression(conditions, node.On)
+		}
+		return true, nil
+	}, sel)
+	scoped := map[semantics.TableSet]map[string]bool{}
+	for _, condition := range conditions {
+		cmp, ok := condition.(*sqlparser.ComparisonExpr)
+		if !ok || cmp.Operator != sqlparser.EqualOp {
+			continue
+		}
+		col, isCol := cmp.Left.(*sqlparser.ColName)
+		arg, isArg := cmp.Right.(sqlparser.Argument)
+		if isCol && isArg && string(arg) == tenantArgName {
+			deps := semTable.Dependencies(col)
+			if scoped[deps] == nil {
+				scoped[deps] = map[string]bool{}
+			}
+			scoped[deps][col.Name.Lowered()] = true
+		}
+	}
+	for _, table := range semTable.Tables {
+		var vindexTable *vindexes.Table
+		switch table := table.(type) {
+		case *semantics.RealTable:
+			vindexTable = table.Table
+		case *semantics.AliasedTable:
+			vindexTable = table.Table
+		}
+		if vindexTable == nil || vindexTable.Tenant == nil {
+			continue
+		}
+		if !scoped[semTable.TableSetFor(table.GetExpr())][vindexTable.Tenant.Column.Lowered()] {
+			return vterrors.Errorf(vtrpcpb.Code_PERMISSION_DENIED, "the query could access the rows of other tenants in tenant-scoped table %s", vindexTable.Name.String())
+		}
+	}
+	return nil
+}
+
+func tenantPredicate(col *sqlparser.ColName) sqlparser.Expr {
+	return &sqlparser.ComparisonExpr{
+		Operator: sqlparser.EqualOp,
+		Left:     col,
+		Right:    sqlparser.NewArgument(tenantArgName),
+	}
+}
+
+func addWhere(where *sqlparser.Where, expr sqlparser.Expr) *sqlparser.Where {
+	if where == nil {
+		return &sqlparser.Where{Type: sqlparser.WhereClause, Expr: expr}
+	}
+	where.Expr = &sqlparser.AndExpr{Left: where.Expr, Right: expr}
+	return where
+}
+
+// unionSelects returns the selects of the union, which the semantic
+// analysis does not analyze as a whole.
+func unionSelects(union *sqlparser.Union) []sqlparser.SelectStatement {
+	var selects []sqlparser.SelectStatement
+	for _, sel := range append([]sqlparser.SelectStatement{union.FirstStatement}, unionStatements(union)...) {
+		if inner, ok := sel.(*sqlparser.Union); ok {
+			selects = append(selects, unionSelects(inner)...)
+			continue
+		}
+		selects = append(selects, sel)
+	}
+	return selects
+}
+
+func unionStatements(union *sqlparser.Union) []sqlparser.SelectStatement {
+	var statements []sqlparser.SelectStatement
+	for _, us := range union.UnionSelects {
+		statements = append(statements, us.Statement)
+	}
+	return statements
+}
@@ -0,0 +1,219 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+var tenantVSchema = `
+{
+	"sharded": true,
+	"vindexes": {
+		"hash_index": {
+			"type": "hash"
+		}
+	},
+	"tables": {
+		"customer": {
+			"column_vindexes": [{"column": "id", "name": "hash_index"}],
+			"tenant_column": "tenant_id"
+		},
+		"orders": {
+			"column_vindexes": [{"column": "customer_id", "name": "hash_index"}],
+			"tenant_column": "tenant_id"
+		},
+		"region": {
+			"column_vindexes": [{"column": "id", "name": "hash_index"}]
+		}
+	}
+}
+`
+
+func TestTenantScopedQueries(t *testing.T) {
+	executor, sbc1, _, _ := createCustomExecutor(tenantVSchema)
+
+	tcases := []struct {
+		query string
+		want  string
+	}{{
+		query: "select id from customer where id = 1",
+		want:  "select id from customer where id = 1 and customer.tenant_id = :__vttenant",
+	}, {
+		query: "select c.id, o.id from customer as c left join orders as o on c.id = o.customer_id where c.id = 1",
+		want:  "select c.id, o.id from customer as c left join orders as o on c.id = o.customer_id and o.tenant_id = :__vttenant where c.id = 1 and c.tenant_id = :__vttenant",
+	}, {
+		query: "select t.id from (select id from customer where id = 1) as t",
+		want:  "select t.id from (select id from customer where id = 1 and customer.tenant_id = :__vttenant) as t",
+	}, {
+		query: "select id from region where id = 1",
+		want:  "select id from region where id = 1",
+	}, {
+		query: "update customer set name = 'x' where id = 1",
+		want:  "update customer set `name` = 'x' where id = 1 and customer.tenant_id = :__vttenant",
+	}, {
+		query: "delete from orders where customer_id = 1",
+		want:  "delete from orders where customer_id = 1 and orders.tenant_id = :__vttenant",
+	}, {
+		query: "insert into customer(id, name) values (1, 'x')",
+		want:  "insert into customer(id, `name`, tenant_id) values (:_id_0, 'x', :__vttenant)",
+	}, {
+		query: "insert into customer(id, tenant_id) values (1, 'acme')",
+		want:  "insert into customer(id, tenant_id) values (:_id_0, 'acme')",
+	}}
+	for _, tcase := range tcases {
+		t.Run(tcase.query, func(t *testing.T) {
+			sbc1.Queries = nil
+			session := &vtgatepb.Session{TargetString: "@primary", Autocommit: true, Tenant: "acme"}
+			_, err := executorExecSession(executor, tcase.query, nil, session)
+			require.NoError(t, err)
+			require.Len(t, sbc1.Queries, 1)
+			assert.Equal(t, tcase.want, sbc1.Queries[0].Sql)
+			if strings.Contains(tcase.want, tenantArgName) {
+				assert.Equal(t, sqltypes.StringBindVariable("acme"), sbc1.Queries[0].BindVariables[tenantArgName])
+			}
+		})
+	}
+
+	// the sessions without a tenant are not scoped
+	sbc1.Queries = nil
+	_, err := executorExec(executor, "select id from customer where id = 1", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "select id from customer where id = 1", sbc1.Queries[0].Sql)
+}
+
+func TestTenantCrossingQueries(t *testing.T) {
+	executor, _, _, _ := createCustomExecutor(tenantVSchema)
+
+	tcases := []struct {
+		query string
+		err   string
+	}{{
+		query: "update customer set tenant_id = 'other' where id = 1",
+		err:   "the tenant column tenant_id can not be updated in a session with a tenant",
+	}, {
+		query: "insert into customer(id, tenant_id) values (1, 'other')",
+		err:   "the rows inserted into tenant-scoped table customer in a session with a tenant must have tenant acme",
+	}, {
+		query: "insert into customer values (1, 'acme')",
+		err:   "the columns of the insert into tenant-scoped table customer must be listed in a session with a tenant",
+	}, {
+		query: "insert into customer(id, name) values (1, 'x') on duplicate key update tenant_id = 'other'",
+		err:   "the tenant column tenant_id can not be updated in a session with a tenant",
+	}, {
+		query: "insert into customer(id, name) select id, name from region",
+		err:   "unsupported: insert with a select into tenant-scoped table customer in a session with a tenant",
+	}, {
+		query: "select c.id from region as r natural left join customer as c",
+		err:   "unsupported: outer join with USING or NATURAL on tenant-scoped table in a session with a tenant, use an ON condition instead",
+	}}
+	for _, tcase := range tcases {
+		t.Run(tcase.query, func(t *testing.T) {
+			session := &vtgatepb.Session{TargetString: "@primary", Autocommit: true, Tenant: "acme"}
+			_, err := executorExecSession(executor, tcase.query, nil, session)
+			require.EqualError(t, err, tcase.err)
+		})
+	}
+
+	before := tenantRejectedQueries.Get()
+	session := &vtgatepb.Session{TargetString: "@primary", Autocommit: true, Tenant: "acme"}
+	_, err := executorExecSession(executor, "insert into customer(id, tenant_id) values (:id, :tenant)", map[string]*querypb.BindVariable{
+		"id":     sqltypes.Int64BindVariable(1),
+		"tenant": sqltypes.StringBindVariable("other"),
+	}, session)
+	require.Error(t, err)
+	assert.Equal(t, before+1, tenantRejectedQueries.Get())
+}
+
+func TestSetTenant(t *testing.T) {
+	executor, _, _, _ := createCustomExecutor(tenantVSchema)
+	executor.normalize = true
+
+	session := NewSafeSession(&vtgatepb.Session{TargetString: KsTestUnsharded, Autocommit: true})
+	_, err := executor.Execute(context.Background(), "TestSetTenant", session, "set @@tenant = 'acme'", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "acme", session.Tenant)
+
+	qr, err := executor.Execute(context.Background(), "TestSetTenant", session, "select @@tenant from dual", nil)
+	require.NoError(t, err)
+	assert.Equal(t, `[[VARBINARY("acme")]]`, fmt.Sprintf("%v", qr.Rows))
+
+	_, err = executor.Execute(context.Background(), "TestSetTenant", session, "set @@tenant = 'other'", nil)
+	require.EqualError(t, err, "the tenant of the session can not be changed from acme")
+	_, err = executor.Execute(context.Background(), "TestSetTenant", session, "set @@tenant = 'acme'", nil)
+	require.NoError(t, err)
+}
+
+func TestTenantVerification(t *testing.T) {
+	executor, _, _, _ := createCustomExecutor(tenantVSchema)
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@primary", Tenant: "acme"})
+	vcursor, err := newVCursorImpl(context.Background(), session, makeComments(""), executor, nil, executor.vm, executor.VSchema(), executor.resolver.resolver, nil, false)
+	require.NoError(t, err)
+	scoper := &tenantScoper{vcursor: vcursor, tenant: "acme"}
+
+	tcases := []struct {
+		query string
+		err   string
+	}{{
+		query: "select id from customer where tenant_id = :__vttenant",
+	}, {
+		query: "select id from customer where id in (select customer_id from orders where orders.tenant_id = :__vttenant) and tenant_id = :__vttenant",
+	}, {
+		query: "select id from customer where id = 1 or tenant_id = :__vttenant",
+		err:   "the query could access the rows of other tenants in tenant-scoped table customer",
+	}, {
+		query: "select c.id from customer as c join orders as o on c.id = o.customer_id where c.tenant_id = :__vttenant",
+		err:   "the query could access the rows of other tenants in tenant-scoped table orders",
+	}, {
+		query: "select id from customer where tenant_id = :__vttenant union select id from region",
+	}, {
+		query: "delete from orders where customer_id = 1",
+		err:   "the query could access the rows of other tenants in tenant-scoped table orders",
+	}}
+	for _, tcase := range tcases {
+		t.Run(tcase.query, func(t *testing.T) {
+			stmt, err := sqlparser.Parse(tcase.query)
+			require.NoError(t, err)
+			err = scoper.verify(stmt)
+			if tcase.err == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, tcase.err)
+		})
+	}
+
+	// the selects of the unions and subqueries are all scoped
+	stmt, err := sqlparser.Parse("select id from customer union select customer_id from orders where customer_id in (select id from customer)")
+	require.NoError(t, err)
+	changed, err := scopeToTenant(vcursor, stmt, nil)
+	require.NoError(t, err)
+	require.True(t, changed)
+	assert.Equal(t, "select id from customer where customer.tenant_id = :__vttenant union select customer_id from orders where customer_id in (select id from customer where customer.tenant_id = :__vttenant) and orders.tenant_id = :__vttenant", sqlparser.String(stmt))
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"flag"
+	"sync"
+
+	"vitess.io/vitess/go/vt/srvtopo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/throttle/throttlerclient"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+var throttlerCheckInterval = flag.Duration("throttler_check_interval", throttlerclient.DefaultRefreshInterval, "How often vtgate refreshes the checks of the tablet throttlers of the primary tablets, e.g. for the chunked DMLs")
+
+// throttlerChecker checks the tablet throttlers of the primary tablets, with
+// one throttlerclient.Client per tablet.
+type throttlerChecker struct {
+	gateway Gateway
+
+	mu      sync.Mutex
+	tmc     tmclient.TabletManagerClient
+	clients map[string]*throttlerclient.Client
+}
+
+func newThrottlerChecker(gateway Gateway) *throttlerChecker {
+	return &throttlerChecker{
+		gateway: gateway,
+		clients: make(map[string]*throttlerclient.Client),
+	}
+}
+
+// Check returns true if the throttlers of the primary tablets of the shards
+// let the app proceed. The shards without a healthy primary tablet are not
+// checked: the queries fail on them anyway.
+func (tc *throttlerChecker) Check(ctx context.Context, rss []*srvtopo.ResolvedShard, appName string) bool {
+	check := &tabletmanagerdatapb.CheckThrottlerRequest_Check{
+		AppName:       appName,
+		OkIfNotExists: true,
+	}
+	for _, rs := range rss {
+		tablet := tc.gateway.PrimaryTablet(rs.Target.Keyspace, rs.Target.Shard)
+		if tablet == nil {
+			continue
+		}
+		if !tc.client(tablet).CheckOK(ctx, check) {
+			return false
+		}
+	}
+	return true
+}
+
+func (tc *throttlerChecker) client(tablet *topodatapb.Tablet) *throttlerclient.Client {
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+
+	if tc.tmc == nil {
+		tc.tmc = tmclient.NewTabletManagerClient()
+	}
+	alias := topoproto.TabletAliasString(tablet.Alias)
+	client, ok := tc.clients[alias]
+	if !ok {
+		client = throttlerclient.NewClient(tc.tmc, tablet, *throttlerCheckInterval)
+		tc.clients[alias] = client
+	}
+	return client
+}
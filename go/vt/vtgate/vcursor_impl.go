@@ -25,6 +25,7 @@ import (
 	"time"
 
 	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
 
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/sqltypes"
@@ -47,6 +48,8 @@ import (
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vtgate/buffer"
 	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+	"vitess.io/vitess/go/vt/vtgate/masking"
 	"vitess.io/vitess/go/vt/vtgate/planbuilder"
 	"vitess.io/vitess/go/vt/vtgate/semantics"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
@@ -65,8 +68,15 @@ type iExecute interface {
 	StreamExecuteMulti(ctx context.Context, s string, rss []*srvtopo.ResolvedShard, vars []map[string]*querypb.BindVariable, options *querypb.ExecuteOptions, callback func(reply *sqltypes.Result) error) []error
 	ExecuteLock(ctx context.Context, rs *srvtopo.ResolvedShard, query *querypb.BoundQuery, session *SafeSession) (*sqltypes.Result, error)
 	Commit(ctx context.Context, safeSession *SafeSession) error
-	ExecuteMessageStream(ctx context.Context, rss []*srvtopo.ResolvedShard, name string, callback func(*sqltypes.Result) error) error
+	ExecuteMessageStream(ctx context.Context, rss []*srvtopo.ResolvedShard, name, consumerGroup string, callback func(*sqltypes.Result) error) error
 	ExecuteVStream(ctx context.Context, rss []*srvtopo.ResolvedShard, filter *binlogdatapb.Filter, gtid string, callback func(evs []*binlogdatapb.VEvent) error) error
+	CheckThrottler(ctx context.Context, rss []*srvtopo.ResolvedShard, appName string) bool
+	SaveDDLStatus(status *topodatapb.DDLStatus)
+	GetDDLStatuses(ctx context.Context, uuid string) ([]*topodatapb.DDLStatus, error)
+	KeyspacePolicy(ctx context.Context, keyspace string) *topodatapb.KeyspacePolicy
+
+	SchemaVersion() int64
+	MaskingPolicy() *masking.Policy
 
 	// TODO: remove when resolver is gone
 	ParseDestinationTarget(targetString string) (string, topodatapb.TabletType, key.Destination, error)
@@ -167,6 +177,38 @@ func (vc *vcursorImpl) MaxMemoryRows() int {
 	return *maxMemoryRows
 }
 
+// InListPruningThreshold returns the inListPruningThreshold flag value.
+func (vc *vcursorImpl) InListPruningThreshold() int {
+	return *inListPruningThreshold
+}
+
+// FieldCacheVersion returns the schema version of the executor, and whether
+// the enable_field_cache flag is set.
+func (vc *vcursorImpl) FieldCacheVersion() (int64, bool) {
+	return vc.executor.SchemaVersion(), *enableFieldCache
+}
+
+// PointQueryBatching returns the point_query_batch_window and
+// point_query_batch_size flag values. The queries of transactions, reserved
+// connections and sessions with system settings are not batched. The batch
+// key holds the caller IDs, the execute options and the margin comments,
+// which the batch query executes with.
+func (vc *vcursorImpl) PointQueryBatching() (time.Duration, int, string) {
+	if *pointQueryBatchWindow <= 0 || vc.safeSession.InTransaction() || vc.safeSession.InReservedConn() || len(vc.safeSession.SetPreQueries()) > 0 {
+		return 0, 0, ""
+	}
+	options, err := proto.MarshalOptions{Deterministic: true}.Marshal(vc.safeSession.Options)
+	if err != nil {
+		return 0, 0, ""
+	}
+	ef := callerid.EffectiveCallerIDFromContext(vc.ctx)
+	key := fmt.Sprintf("%s/%s/%s|%s|%x|%s|%s",
+		callerid.GetPrincipal(ef), callerid.GetComponent(ef), callerid.GetSubcomponent(ef),
+		callerid.GetUsername(callerid.ImmediateCallerIDFromContext(vc.ctx)),
+		options, vc.marginComments.Leading, vc.marginComments.Trailing)
+	return *pointQueryBatchWindow, *pointQueryBatchSize, key
+}
+
 // ExceedsMaxMemoryRows returns a boolean indicating whether the maxMemoryRows value has been exceeded.
 // Returns false if the max memory rows override directive is set to true.
 func (vc *vcursorImpl) ExceedsMaxMemoryRows(numRows int) bool {
@@ -178,6 +220,12 @@ func (vc *vcursorImpl) SetIgnoreMaxMemoryRows(ignoreMaxMemoryRows bool) {
 	vc.ignoreMaxMemoryRows = ignoreMaxMemoryRows
 }
 
+// SetQueryFingerprint sets the fingerprint of the query the vcursor
+// executes, which vttablet gets with the queries sent for it.
+func (vc *vcursorImpl) SetQueryFingerprint(fingerprint string) {
+	vc.ctx = withQueryFingerprint(vc.ctx, fingerprint)
+}
+
 // SetContextTimeout updates context and sets a timeout.
 func (vc *vcursorImpl) SetContextTimeout(timeout time.Duration) context.CancelFunc {
 	ctx, cancel := context.WithTimeout(vc.ctx, timeout)
@@ -342,21 +390,31 @@ func (vc *vcursorImpl) Planner() planbuilder.PlannerVersion {
 		vc.safeSession.Options.PlannerVersion != querypb.ExecuteOptions_DEFAULT_PLANNER {
 		return vc.safeSession.Options.PlannerVersion
 	}
-	switch strings.ToLower(*plannerVersion) {
+	if version, ok := toPlannerVersion(*plannerVersion); ok {
+		return version
+	}
+
+	log.Warning("unknown planner version configured. using the default")
+	return planbuilder.V3
+}
+
+// toPlannerVersion returns the planner version with the name, as accepted by
+// the -planner_version flag.
+func toPlannerVersion(name string) (planbuilder.PlannerVersion, bool) {
+	switch strings.ToLower(name) {
 	case "v3":
-		return planbuilder.V3
+		return planbuilder.V3, true
 	case "gen4":
-		return planbuilder.Gen4
+		return planbuilder.Gen4, true
 	case "gen4greedy", "greedy":
-		return planbuilder.Gen4GreedyOnly
+		return planbuilder.Gen4GreedyOnly, true
 	case "left2right":
-		return planbuilder.Gen4Left2Right
+		return planbuilder.Gen4Left2Right, true
 	case "gen4fallback":
-		return planbuilder.Gen4WithFallback
+		return planbuilder.Gen4WithFallback, true
 	}
 
-	log.Warning("unknown planner version configured. using the default")
-	return planbuilder.V3
+	return 0, false
 }
 
 // GetSemTable implements the ContextVSchema interface
@@ -515,6 +573,16 @@ func (vc *vcursorImpl) SetTarget(target string) error {
 		return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.LockOrActiveTransaction, "can't execute the given command because you have an active transaction")
 	}
 	vc.safeSession.SetTargetString(target)
+
+	// The session takes the default workload of the keyspace it uses.
+	if !vc.safeSession.InTransaction() {
+		workload, err := topoprotopb.KeyspacePolicyWorkload(vc.executor.KeyspacePolicy(vc.ctx, keyspace))
+		if err != nil {
+			log.Warningf("Ignoring the default workload of the policy of keyspace %v: %v", keyspace, err)
+		} else if workload != querypb.ExecuteOptions_UNSPECIFIED {
+			vc.SetWorkload(workload)
+		}
+	}
 	return nil
 }
 
@@ -635,12 +703,31 @@ func (vc *vcursorImpl) SetSkipQueryPlanCache(skipQueryPlanCache bool) error {
 	return nil
 }
 
+// SetSkipKeyspacePolicy implements the SessionActions interface
+func (vc *vcursorImpl) SetSkipKeyspacePolicy(skipKeyspacePolicy bool) error {
+	user := callerid.ImmediateCallerIDFromContext(vc.ctx)
+	if skipKeyspacePolicy && !keyspacePolicyExempt(user) {
+		return vterrors.NewErrorf(vtrpcpb.Code_PERMISSION_DENIED, vterrors.AccessDeniedError, "User '%s' is not allowed to skip the keyspace policies", user.GetUsername())
+	}
+	vc.safeSession.GetOrCreateOptions().SkipKeyspacePolicy = skipKeyspacePolicy
+	return nil
+}
+
 // SetSkipQueryPlanCache implements the SessionActions interface
 func (vc *vcursorImpl) SetSQLSelectLimit(limit int64) error {
 	vc.safeSession.GetOrCreateOptions().SqlSelectLimit = limit
 	return nil
 }
 
+// SetMaxExecutionTime implements the SessionActions interface
+func (vc *vcursorImpl) SetMaxExecutionTime(maxExecutionTime int64) error {
+	if maxExecutionTime < 0 {
+		return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.WrongValueForVar, "Variable 'max_execution_time' can't be set to the value of '%d'", maxExecutionTime)
+	}
+	vc.safeSession.GetOrCreateOptions().MaxExecutionTimeMs = maxExecutionTime
+	return nil
+}
+
 // SetSkipQueryPlanCache implements the SessionActions interface
 func (vc *vcursorImpl) SetTransactionMode(mode vtgatepb.TransactionMode) {
 	vc.safeSession.TransactionMode = mode
@@ -651,6 +738,25 @@ func (vc *vcursorImpl) SetWorkload(workload querypb.ExecuteOptions_Workload) {
 	vc.safeSession.GetOrCreateOptions().Workload = workload
 }
 
+// SetWorkloadName implements the SessionActions interface
+func (vc *vcursorImpl) SetWorkloadName(workloadName string) {
+	vc.safeSession.GetOrCreateOptions().WorkloadName = workloadName
+}
+
+// SetTenant implements the SessionActions interface
+func (vc *vcursorImpl) SetTenant(tenant string) error {
+	if vc.safeSession.Tenant != "" && vc.safeSession.Tenant != tenant {
+		return vterrors.NewErrorf(vtrpcpb.Code_PERMISSION_DENIED, vterrors.WrongValueForVar, "the tenant of the session can not be changed from %s", vc.safeSession.Tenant)
+	}
+	vc.safeSession.Tenant = tenant
+	return nil
+}
+
+// SetPriority implements the SessionActions interface
+func (vc *vcursorImpl) SetPriority(priority querypb.ExecuteOptions_Priority) {
+	vc.safeSession.GetOrCreateOptions().Priority = priority
+}
+
 // SetPlannerVersion implements the SessionActions interface
 func (vc *vcursorImpl) SetPlannerVersion(v planbuilder.PlannerVersion) {
 	vc.safeSession.GetOrCreateOptions().PlannerVersion = v
@@ -672,6 +778,38 @@ func (vc *vcursorImpl) GetDDLStrategy() string {
 	return vc.safeSession.GetDDLStrategy()
 }
 
+// GetSQLMode implements the SessionActions interface
+func (vc *vcursorImpl) GetSQLMode() evalengine.SQLMode {
+	if expr, ok := vc.safeSession.GetSystemVariable("sql_mode"); ok {
+		if mode, ok := sqlModeFromExpr(expr); ok {
+			return mode
+		}
+	}
+	return evalengine.ParseSQLMode(*sqlMode)
+}
+
+// sqlModeFromExpr parses the sql_mode a session set, which is stored as a
+// quoted string.
+func sqlModeFromExpr(expr string) (evalengine.SQLMode, bool) {
+	stmt, err := sqlparser.Parse("select " + expr)
+	if err != nil {
+		return 0, false
+	}
+	sel, ok := stmt.(*sqlparser.Select)
+	if !ok || len(sel.SelectExprs) != 1 {
+		return 0, false
+	}
+	aliased, ok := sel.SelectExprs[0].(*sqlparser.AliasedExpr)
+	if !ok {
+		return 0, false
+	}
+	literal, ok := aliased.Expr.(*sqlparser.Literal)
+	if !ok || literal.Type != sqlparser.StrVal {
+		return 0, false
+	}
+	return evalengine.ParseSQLMode(literal.Val), true
+}
+
 // GetSessionUUID implements the SessionActions interface
 func (vc *vcursorImpl) GetSessionUUID() string {
 	return vc.safeSession.GetSessionUUID()
@@ -698,6 +836,12 @@ func (vc *vcursorImpl) SetReadAfterWriteTimeout(timeout float64) {
 	vc.safeSession.SetReadAfterWriteTimeout(timeout)
 }
 
+// SetConsistentSnapshotReads implements the SessionActions interface
+func (vc *vcursorImpl) SetConsistentSnapshotReads(enable bool) error {
+	vc.safeSession.SetConsistentSnapshotReads(enable)
+	return nil
+}
+
 // SetSessionTrackGTIDs implements the SessionActions interface
 func (vc *vcursorImpl) SetSessionTrackGTIDs(enable bool) {
 	vc.safeSession.SetSessionTrackGtids(enable)
@@ -752,6 +896,19 @@ func (vc *vcursorImpl) ForeignKeyMode() string {
 	return strings.ToLower(*foreignKeyMode)
 }
 
+// JoinOrderTables implements the ContextVSchema interface
+func (vc *vcursorImpl) JoinOrderTables() int {
+	if joinOrderTables == nil {
+		return 0
+	}
+	return *joinOrderTables
+}
+
+// MaskingPolicy implements the ContextVSchema interface
+func (vc *vcursorImpl) MaskingPolicy() *masking.Policy {
+	return vc.executor.MaskingPolicy()
+}
+
 // ParseDestinationTarget parses destination target string and sets default keyspace if possible.
 func parseDestinationTarget(targetString string, vschema *vindexes.VSchema) (string, topodatapb.TabletType, key.Destination, error) {
 	destKeyspace, destTabletType, dest, err := topoprotopb.ParseDestination(targetString, defaultTabletType)
@@ -827,9 +984,24 @@ func (vc *vcursorImpl) ExecuteVSchema(keyspace string, vschemaDDL *sqlparser.Alt
 
 }
 
-func (vc *vcursorImpl) MessageStream(rss []*srvtopo.ResolvedShard, tableName string, callback func(*sqltypes.Result) error) error {
+func (vc *vcursorImpl) MessageStream(rss []*srvtopo.ResolvedShard, tableName, consumerGroup string, callback func(*sqltypes.Result) error) error {
 	atomic.AddUint64(&vc.logStats.ShardQueries, uint64(len(rss)))
-	return vc.executor.ExecuteMessageStream(vc.ctx, rss, tableName, callback)
+	return vc.executor.ExecuteMessageStream(vc.ctx, rss, tableName, consumerGroup, callback)
+}
+
+// CheckThrottler is part of the engine.VCursor interface.
+func (vc *vcursorImpl) CheckThrottler(rss []*srvtopo.ResolvedShard, appName string) bool {
+	return vc.executor.CheckThrottler(vc.ctx, rss, appName)
+}
+
+// SaveDDLStatus is part of the engine.VCursor interface.
+func (vc *vcursorImpl) SaveDDLStatus(status *topodatapb.DDLStatus) {
+	vc.executor.SaveDDLStatus(status)
+}
+
+// GetDDLStatuses is part of the engine.SessionActions interface.
+func (vc *vcursorImpl) GetDDLStatuses(uuid string) ([]*topodatapb.DDLStatus, error) {
+	return vc.executor.GetDDLStatuses(vc.ctx, uuid)
 }
 
 func (vc *vcursorImpl) VStream(rss []*srvtopo.ResolvedShard, filter *binlogdatapb.Filter, gtid string, callback func(evs []*binlogdatapb.VEvent) error) error {
@@ -13,8 +13,10 @@ import (
 	"vitess.io/vitess/go/vt/topo"
 
 	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
@@ -311,3 +313,41 @@ func TestFirstSortedKeyspace(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, ks3Schema.Keyspace, ks)
 }
+
+func TestGetSQLMode(t *testing.T) {
+	defer func(mode string) { *sqlMode = mode }(*sqlMode)
+	*sqlMode = "ONLY_FULL_GROUP_BY,TRADITIONAL"
+
+	tests := []struct {
+		name     string
+		session  string
+		expected evalengine.SQLMode
+	}{{
+		name:     "flag",
+		expected: evalengine.StrictMode | evalengine.ErrorForDivisionByZero | evalengine.NoZeroDate | evalengine.NoZeroInDate,
+	}, {
+		name:     "session",
+		session:  "'STRICT_TRANS_TABLES,NO_ZERO_DATE'",
+		expected: evalengine.StrictMode | evalengine.NoZeroDate,
+	}, {
+		name:     "empty session sql_mode",
+		session:  "''",
+		expected: 0,
+	}, {
+		name:     "session sql_mode that is not a string",
+		session:  "concat(@@sql_mode, ',NO_ZERO_DATE')",
+		expected: evalengine.StrictMode | evalengine.ErrorForDivisionByZero | evalengine.NoZeroDate | evalengine.NoZeroInDate,
+	}}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ss := NewSafeSession(&vtgatepb.Session{})
+			if tc.session != "" {
+				ss.SetSystemVariable("sql_mode", tc.session)
+			}
+			vc, err := newVCursorImpl(context.Background(), ss, sqlparser.MarginComments{}, nil, nil, &fakeVSchemaOperator{vschema: vschemaWith1KS}, vschemaWith1KS, srvtopo.NewResolver(&fakeTopoServer{}, nil, ""), nil, false)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, vc.GetSQLMode())
+		})
+	}
+}
@@ -61,6 +61,11 @@ type VSchema struct {
 	uniqueTables   map[string]*Table
 	uniqueVindexes map[string]Vindex
 	Keyspaces      map[string]*KeyspaceSchema `json:"keyspaces"`
+
+	// TabletRoutingRules holds the tags of the preferred tablets, by
+	// keyspace. The rule of the empty keyspace applies to the keyspaces
+	// without a rule of their own.
+	TabletRoutingRules map[string]map[string]string `json:"tablet_routing_rules,omitempty"`
 }
 
 // RoutingRule represents one routing rule.
@@ -93,6 +98,34 @@ type Table struct {
 	Columns                 []Column             `json:"columns,omitempty"`
 	Pinned                  []byte               `json:"pinned,omitempty"`
 	ColumnListAuthoritative bool                 `json:"column_list_authoritative,omitempty"`
+	Statistics              *TableStatistics     `json:"statistics,omitempty"`
+	Tenant                  *Tenant              `json:"tenant,omitempty"`
+}
+
+// TableStatistics are the statistics of a table sampled by vttablet and
+// published through the schema tracker: its row count, and the cardinality
+// of the columns that lead an index, by lowercase column name. They are
+// the estimates of MySQL for the shard the tracker loaded them from.
+type TableStatistics struct {
+	RowCount      uint64            `json:"row_count"`
+	Cardinalities map[string]uint64 `json:"cardinalities,omitempty"`
+}
+
+// RowsPerValue returns the estimated number of rows of the table that
+// match a value of the column, if the column leads an index.
+func (ts *TableStatistics) RowsPerValue(column sqlparser.ColIdent) (float64, bool) {
+	if ts == nil {
+		return 0, false
+	}
+	cardinality, ok := ts.Cardinalities[column.Lowered()]
+	if !ok {
+		return 0, false
+	}
+	if cardinality == 0 {
+		// MySQL does not know yet; the table is likely to be empty.
+		return float64(ts.RowCount), true
+	}
+	return float64(ts.RowCount) / float64(cardinality), true
 }
 
 // Keyspace contains the keyspcae info for each Table.
@@ -161,6 +194,11 @@ type AutoIncrement struct {
 	Sequence *Table             `json:"sequence"`
 }
 
+// Tenant contains the tenant information of a tenant-scoped table.
+type Tenant struct {
+	Column sqlparser.ColIdent `json:"column"`
+}
+
 // BuildVSchema builds a VSchema from a SrvVSchema.
 func BuildVSchema(source *vschemapb.SrvVSchema) (vschema *VSchema) {
 	vschema = &VSchema{
@@ -173,6 +211,7 @@ func BuildVSchema(source *vschemapb.SrvVSchema) (vschema *VSchema) {
 	resolveAutoIncrement(source, vschema)
 	addDual(vschema)
 	buildRoutingRule(source, vschema)
+	buildTabletRoutingRules(source, vschema)
 	return vschema
 }
 
@@ -255,6 +294,9 @@ func buildTables(ks *vschemapb.Keyspace, vschema *VSchema, ksvschema *KeyspaceSc
 		default:
 			return fmt.Errorf("unidentified table type %s", table.Type)
 		}
+		if table.TenantColumn != "" {
+			t.Tenant = &Tenant{Column: sqlparser.NewColIdent(table.TenantColumn)}
+		}
 		if table.Pinned != "" {
 			decoded, err := hex.DecodeString(table.Pinned)
 			if err != nil {
@@ -442,6 +484,19 @@ outer:
 	}
 }
 
+func buildTabletRoutingRules(source *vschemapb.SrvVSchema, vschema *VSchema) {
+	if source.RoutingRules == nil || len(source.RoutingRules.TabletRules) == 0 {
+		return
+	}
+	vschema.TabletRoutingRules = make(map[string]map[string]string, len(source.RoutingRules.TabletRules))
+	for _, rule := range source.RoutingRules.TabletRules {
+		if len(rule.PreferredTags) == 0 {
+			continue
+		}
+		vschema.TabletRoutingRules[rule.Keyspace] = rule.PreferredTags
+	}
+}
+
 // FindTable returns a pointer to the Table. If a keyspace is specified, only tables
 // from that keyspace are searched. If the specified keyspace is unsharded
 // and no tables matched, it's considered valid: FindTable will construct a table
@@ -396,6 +396,25 @@ func TestVSchemaColumnsFail(t *testing.T) {
 	}
 }
 
+func TestVSchemaTenant(t *testing.T) {
+	good := vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"unsharded": {
+				Tables: map[string]*vschemapb.Table{
+					"t1": {
+						TenantColumn: "tenant_id",
+					},
+					"t2": {},
+				},
+			},
+		},
+	}
+	got := BuildVSchema(&good)
+	require.NoError(t, got.Keyspaces["unsharded"].Error)
+	assert.Equal(t, &Tenant{Column: sqlparser.NewColIdent("tenant_id")}, got.Keyspaces["unsharded"].Tables["t1"].Tenant)
+	assert.Nil(t, got.Keyspaces["unsharded"].Tables["t2"].Tenant)
+}
+
 func TestVSchemaPinned(t *testing.T) {
 	good := vschemapb.SrvVSchema{
 		Keyspaces: map[string]*vschemapb.Keyspace{
@@ -812,6 +831,27 @@ func TestVSchemaRoutingRules(t *testing.T) {
 	assert.Equal(t, string(wantb), string(gotb), string(gotb))
 }
 
+func TestVSchemaTabletRoutingRules(t *testing.T) {
+	input := vschemapb.SrvVSchema{
+		RoutingRules: &vschemapb.RoutingRules{
+			TabletRules: []*vschemapb.TabletRoutingRule{{
+				PreferredTags: map[string]string{"ssd": "true"},
+			}, {
+				Keyspace:      "ks1",
+				PreferredTags: map[string]string{"pool": "batch"},
+			}, {
+				Keyspace: "ks2",
+			}},
+		},
+	}
+	got := BuildVSchema(&input)
+	want := map[string]map[string]string{
+		"":    {"ssd": "true"},
+		"ks1": {"pool": "batch"},
+	}
+	assert.Equal(t, want, got.TabletRoutingRules)
+}
+
 func TestChooseVindexForType(t *testing.T) {
 	testcases := []struct {
 		in  querypb.Type
@@ -49,6 +49,7 @@ type VSchemaManager struct {
 // SchemaInfo is an interface to schema tracker.
 type SchemaInfo interface {
 	Tables(ks string) map[string][]vindexes.Column
+	TableStatistics(ks string) map[string]*vindexes.TableStatistics
 }
 
 // GetCurrentSrvVschema returns a copy of the latest SrvVschema from the
@@ -190,6 +191,7 @@ func (vm *VSchemaManager) buildAndEnhanceVSchema(v *vschemapb.SrvVSchema) *vinde
 func (vm *VSchemaManager) updateFromSchema(vschema *vindexes.VSchema) {
 	for ksName, ks := range vschema.Keyspaces {
 		m := vm.schema.Tables(ksName)
+		statistics := vm.schema.TableStatistics(ksName)
 
 		for tblName, columns := range m {
 			vTbl := ks.Tables[tblName]
@@ -200,9 +202,11 @@ func (vm *VSchemaManager) updateFromSchema(vschema *vindexes.VSchema) {
 					Keyspace:                ks.Keyspace,
 					Columns:                 columns,
 					ColumnListAuthoritative: true,
+					Statistics:              statistics[tblName],
 				}
 				continue
 			}
+			vTbl.Statistics = statistics[tblName]
 			if !vTbl.ColumnListAuthoritative {
 				// if we found the matching table and the vschema view of it is not authoritative, then we just update the columns of the table
 				vTbl.Columns = columns
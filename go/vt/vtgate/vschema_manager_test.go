@@ -258,3 +258,7 @@ var _ SchemaInfo = (*fakeSchema)(nil)
 func (f *fakeSchema) Tables(string) map[string][]vindexes.Column {
 	return f.t
 }
+
+func (f *fakeSchema) TableStatistics(string) map[string]*vindexes.TableStatistics {
+	return nil
+}
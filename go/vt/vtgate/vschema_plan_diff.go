@@ -0,0 +1,142 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"sort"
+
+	"google.golang.org/protobuf/proto"
+
+	"vitess.io/vitess/go/json2"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+const pathVSchemaPlanDiff = "/debug/vschema_plan_diff"
+
+// VSchemaPlanDiff describes how the plan of a query of the plan cache
+// changes with a candidate vschema. Left is the plan with the current
+// vschema, and Right the plan with the candidate one.
+type VSchemaPlanDiff struct {
+	Fingerprint string
+	Target      string
+	*PlanDiff
+}
+
+// VSchemaPlanDiff plans the queries of the plan cache again, with the
+// vschema of the keyspace replaced by the candidate one, and returns those
+// whose plans change, by fingerprint. Each query is planned for the target
+// of the session it was cached for, with the default planner version.
+func (e *Executor) VSchemaPlanDiff(ctx context.Context, keyspace string, candidate *vschemapb.Keyspace) ([]*VSchemaPlanDiff, error) {
+	current := e.VSchema()
+	srvVSchema := e.vm.GetCurrentSrvVschema()
+	if current == nil || srvVSchema == nil {
+		return nil, vterrors.New(vtrpcpb.Code_UNAVAILABLE, "vschema not initialized")
+	}
+
+	srvVSchema = proto.Clone(srvVSchema).(*vschemapb.SrvVSchema)
+	if srvVSchema.Keyspaces == nil {
+		srvVSchema.Keyspaces = map[string]*vschemapb.Keyspace{}
+	}
+	srvVSchema.Keyspaces[keyspace] = candidate
+	vschema := e.vm.buildAndEnhanceVSchema(srvVSchema)
+	if ks := vschema.Keyspaces[keyspace]; ks != nil && ks.Error != nil {
+		return nil, vterrors.Wrapf(ks.Error, "invalid vschema for keyspace %s", keyspace)
+	}
+
+	var plans []*engine.Plan
+	e.plans.ForEach(func(value interface{}) bool {
+		plans = append(plans, value.(*engine.Plan))
+		return true
+	})
+	// A query is cached once per target, and its fingerprint is the same
+	// for all of its values.
+	seen := make(map[[2]string]bool, len(plans))
+	var diffs []*VSchemaPlanDiff
+	for _, plan := range plans {
+		key := [2]string{plan.Fingerprint, plan.Target}
+		if plan.Original == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		diff := &PlanDiff{
+			Query: plan.Original,
+			Left:  e.planSummary(ctx, current, plan.Target, plan.Original, querypb.ExecuteOptions_DEFAULT_PLANNER),
+			Right: e.planSummary(ctx, vschema, plan.Target, plan.Original, querypb.ExecuteOptions_DEFAULT_PLANNER),
+		}
+		diff.Differences = diffPlanSummaries(diff.Left, diff.Right)
+		if len(diff.Differences) == 0 {
+			continue
+		}
+		diffs = append(diffs, &VSchemaPlanDiff{
+			Fingerprint: plan.Fingerprint,
+			Target:      plan.Target,
+			PlanDiff:    diff,
+		})
+	}
+
+	sort.Slice(diffs, func(i, j int) bool {
+		if diffs[i].Fingerprint != diffs[j].Fingerprint {
+			return diffs[i].Fingerprint < diffs[j].Fingerprint
+		}
+		return diffs[i].Target < diffs[j].Target
+	})
+	return diffs, nil
+}
+
+// serveVSchemaPlanDiff serves the plans of the plan cache that change when
+// the vschema of the "keyspace" parameter is replaced by the JSON vschema of
+// the request body.
+func (e *Executor) serveVSchemaPlanDiff(response http.ResponseWriter, request *http.Request) {
+	if request.Method != http.MethodPost {
+		http.Error(response, "the candidate vschema must be posted", http.StatusMethodNotAllowed)
+		return
+	}
+
+	keyspace := request.URL.Query().Get("keyspace")
+	if keyspace == "" {
+		http.Error(response, "missing keyspace parameter", http.StatusBadRequest)
+		return
+	}
+
+	body, err := ioutil.ReadAll(request.Body)
+	if err != nil {
+		http.Error(response, err.Error(), http.StatusBadRequest)
+		return
+	}
+	candidate := &vschemapb.Keyspace{}
+	if err := json2.Unmarshal(body, candidate); err != nil {
+		http.Error(response, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	diffs, err := e.VSchemaPlanDiff(request.Context(), keyspace, candidate)
+	if err != nil {
+		http.Error(response, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	returnAsJSON(response, diffs)
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+func TestVSchemaPlanDiff(t *testing.T) {
+	executor, _, _, _ := createLegacyExecutorEnv()
+
+	for _, sql := range []string{
+		"select id from user where id = 1",
+		"select id from user where id = 2",
+		"select id from music where id = 1",
+	} {
+		_, err := executorExecSession(executor, sql, nil, &vtgatepb.Session{TargetString: "@primary"})
+		require.NoError(t, err)
+	}
+	executor.plans.Wait()
+
+	// The candidate vschema shards user by textcol instead of id.
+	candidate := proto.Clone(executor.vm.GetCurrentSrvVschema().Keyspaces["TestExecutor"]).(*vschemapb.Keyspace)
+	candidate.Tables["user"].ColumnVindexes[0].Column = "textcol"
+
+	diffs, err := executor.VSchemaPlanDiff(context.Background(), "TestExecutor", candidate)
+	require.NoError(t, err)
+	require.Len(t, diffs, 1)
+	assert.Equal(t, sqlparser.FingerprintQuery("select id from user where id = 1"), diffs[0].Fingerprint)
+	assert.Equal(t, "@primary", diffs[0].Target)
+	assert.Equal(t, []string{"route 1: TestExecutor SelectEqualUnique vs TestExecutor SelectScatter"}, diffs[0].Differences)
+
+	// An invalid candidate vschema is an error.
+	candidate.Tables["user"].ColumnVindexes[0].Name = "nosuchvindex"
+	_, err = executor.VSchemaPlanDiff(context.Background(), "TestExecutor", candidate)
+	require.Error(t, err)
+}
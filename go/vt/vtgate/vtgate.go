@@ -35,6 +35,7 @@ import (
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/stats"
 	"vitess.io/vitess/go/tb"
+	"vitess.io/vitess/go/trace"
 	"vitess.io/vitess/go/vt/discovery"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/logutil"
@@ -44,6 +45,7 @@ import (
 	"vitess.io/vitess/go/vt/srvtopo"
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/masking"
 	"vitess.io/vitess/go/vt/vtgate/vtgateservice"
 
 	vtschema "vitess.io/vitess/go/vt/vtgate/schema"
@@ -69,6 +71,8 @@ var (
 	defaultDDLStrategy   = flag.String("ddl_strategy", string(schema.DDLStrategyDirect), "Set default strategy for DDL statements. Override with @@ddl_strategy session variable")
 	dbDDLPlugin          = flag.String("dbddl_plugin", "fail", "controls how to handle CREATE/DROP DATABASE. use it if you are using your own database provisioning service")
 	noScatter            = flag.Bool("no_scatter", false, "when set to true, the planner will fail instead of producing a plan that includes scatter queries")
+	queryRewriters       = flag.String("query_rewriters", "", "Comma-separated list of the registered query rewriters to run on the queries, in the order of the list, after they are parsed and before they are planned.")
+	sqlMode              = flag.String("sql_mode", "", "The sql_mode of the MySQL servers. Expressions that vtgate evaluates itself, instead of sending them to MySQL, follow it for division by zero, invalid dates and truncated numbers, unless the session sets its own sql_mode.")
 
 	// TODO(deepthi): change these two vars to unexported and move to healthcheck.go when LegacyHealthcheck is removed
 
@@ -89,11 +93,35 @@ var (
 
 	foreignKeyMode = flag.String("foreign_key_mode", "allow", "This is to provide how to handle foreign key constraint in create/alter table. Valid values are: allow, disallow")
 
+	// joinOrderTables bounds the query graphs whose join orders the gen4 planner enumerates
+	joinOrderTables = flag.Int("gen4_join_order_tables", 6, "The gen4 planner enumerates all the join orders of the queries that join up to this many tables, when the statistics of all of them are known, and picks the cheapest one. The larger joins are ordered greedily, and 0 disables the enumeration.")
+
+	// inListPruningThreshold is the size from which the IN lists of DML statements are split by shard
+	inListPruningThreshold = flag.Int("in_list_pruning_threshold", 100, "The IN lists of bind variables on the sharding column of DML statements with at least this many values are split by target shard, so that each shard only receives its own values. 0 disables the splitting.")
+
+	// enableFieldCache caches the fields of the field queries on the plans
+	enableFieldCache = flag.Bool("enable_field_cache", false, "Cache the fields of the field queries on the plans, and serve the requests for fields from the cache until the schema version changes. The version changes when the vschema is reloaded, which the schema tracker does on schema changes, and when vtgate executes a DDL.")
+
+	// point query batching combines the concurrent point selects of the same plan to the same shard
+	pointQueryBatchWindow = flag.Duration("point_query_batch_window", 0, "The point selects on a unique vindex outside of transactions wait this long for the concurrent ones of the same plan to the same shard, and are sent to it as a single IN query. 0 disables the batching.")
+	pointQueryBatchSize   = flag.Int("point_query_batch_size", 100, "The maximum number of point selects combined into a single IN query. A full batch is sent without waiting for the end of point_query_batch_window.")
+
+	// columnMaskingPolicy is the file of the column masking policy
+	columnMaskingPolicy = flag.String("column_masking_policy", "", "The JSON file of the column masking policy: the keyspace.table.column columns whose values are masked with NULL, a hash or a partial redaction in the results of the callers that are not among the users or groups unmasked by their rule.")
+
 	// flags to enable/disable online and direct DDL statements
 	enableOnlineDDL = flag.Bool("enable_online_ddl", true, "Allow users to submit, review and control Online DDL")
 	enableDirectDDL = flag.Bool("enable_direct_ddl", true, "Allow users to submit direct DDL statements")
 
 	enableSchemaChangeSignal = flag.Bool("schema_change_signal", false, "Enable the schema tracker")
+
+	// query mirroring executes a sample of the read queries a second time to compare their results
+	queryMirrorPercent        = flag.Float64("query_mirror_percent", 0, "Percentage of the read queries outside of transactions that are executed a second time, asynchronously, against -query_mirror_keyspace or with -query_mirror_planner_version, to compare their results and latencies with those of the original queries. The queries are sampled by a hash of their text, so that the same queries are mirrored by all the vtgates. 0 disables the mirroring.")
+	queryMirrorKeyspace       = flag.String("query_mirror_keyspace", "", "The shadow keyspace that the mirrored queries target instead of the keyspace of their session, e.g. the target keyspace of a migration.")
+	queryMirrorPlannerVersion = flag.String("query_mirror_planner_version", "", "The planner version that the mirrored queries are planned with instead of that of their session, e.g. to validate a planner upgrade.")
+	queryMirrorMaxConcurrency = flag.Int("query_mirror_max_concurrency", 10, "The maximum number of mirrored queries in flight. The queries sampled beyond it are not mirrored, and are counted as dropped.")
+	queryMirrorTimeout        = flag.Duration("query_mirror_timeout", 30*time.Second, "The timeout of the mirrored queries.")
+	queryMirrorSlowThreshold  = flag.Duration("query_mirror_slow_threshold", 10*time.Millisecond, "The mirrored queries that are slower than the original queries by more than this are counted in QueryMirrorSlower.")
 )
 
 func getTxMode() vtgatepb.TransactionMode {
@@ -216,6 +244,18 @@ func Init(ctx context.Context, serv srvtopo.Server, cell string, tabletTypesToWa
 	}
 
 	executor := NewExecutor(ctx, serv, cell, resolver, *normalizeQueries, *warnShardedOnly, *streamBufferSize, cacheCfg, si, *noScatter)
+	rewriters, err := queryRewritersFor(*queryRewriters)
+	if err != nil {
+		log.Fatalf("Invalid value for -query_rewriters: %v", err)
+	}
+	executor.rewriters = rewriters
+	if *columnMaskingPolicy != "" {
+		policy, err := masking.Load(*columnMaskingPolicy)
+		if err != nil {
+			log.Fatalf("Invalid value for -column_masking_policy: %v", err)
+		}
+		executor.maskingPolicy = policy
+	}
 
 	// connect the schema tracker with the vschema manager
 	if *enableSchemaChangeSignal {
@@ -275,7 +315,7 @@ func Init(ctx context.Context, serv srvtopo.Server, cell string, tabletTypesToWa
 	})
 	rpcVTGate.registerDebugHealthHandler()
 	rpcVTGate.registerDebugEnvHandler()
-	err := initQueryLogger(rpcVTGate)
+	err = initQueryLogger(rpcVTGate)
 	if err != nil {
 		log.Fatalf("error initializing query logger: %v", err)
 	}
@@ -360,7 +400,7 @@ func (vtg *VTGate) Execute(ctx context.Context, session *vtgatepb.Session, sql s
 	// In this context, we don't care if we can't fully parse destination
 	destKeyspace, destTabletType, _, _ := vtg.executor.ParseDestinationTarget(session.TargetString)
 	statsKey := []string{"Execute", destKeyspace, topoproto.TabletTypeLString(destTabletType)}
-	defer vtg.timings.Record(statsKey, time.Now())
+	defer vtg.timings.RecordWithTraceID(statsKey, time.Now(), trace.TraceID(ctx))
 
 	if bvErr := sqltypes.ValidateBindVariables(bindVariables); bvErr != nil {
 		err = vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%v", bvErr)
@@ -389,7 +429,7 @@ func (vtg *VTGate) ExecuteBatch(ctx context.Context, session *vtgatepb.Session,
 	// In this context, we don't care if we can't fully parse destination
 	destKeyspace, destTabletType, _, _ := vtg.executor.ParseDestinationTarget(session.TargetString)
 	statsKey := []string{"ExecuteBatch", destKeyspace, topoproto.TabletTypeLString(destTabletType)}
-	defer vtg.timings.Record(statsKey, time.Now())
+	defer vtg.timings.RecordWithTraceID(statsKey, time.Now(), trace.TraceID(ctx))
 
 	for _, bindVariables := range bindVariablesList {
 		if bvErr := sqltypes.ValidateBindVariables(bindVariables); bvErr != nil {
@@ -412,6 +452,78 @@ func (vtg *VTGate) ExecuteBatch(ctx context.Context, session *vtgatepb.Session,
 	return session, qrl, nil
 }
 
+// StreamExecuteBatch executes a batch of queries in order, each with its own
+// options, and calls callback with the outcome of each query as soon as it
+// completes. The failure of a query only stops the batch if abortOnError is
+// set. This is a V3 function.
+func (vtg *VTGate) StreamExecuteBatch(ctx context.Context, session *vtgatepb.Session, queries []*vtgatepb.BatchQuery, abortOnError bool, callback func(index int, session *vtgatepb.Session, qr *sqltypes.Result, err error) error) error {
+	// In this context, we don't care if we can't fully parse destination
+	destKeyspace, destTabletType, _, _ := vtg.executor.ParseDestinationTarget(session.TargetString)
+	statsKey := []string{"StreamExecuteBatch", destKeyspace, topoproto.TabletTypeLString(destTabletType)}
+	defer vtg.timings.RecordWithTraceID(statsKey, time.Now(), trace.TraceID(ctx))
+
+	for _, query := range queries {
+		if query.Query == nil {
+			return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "batch query has no query")
+		}
+		if bvErr := sqltypes.ValidateBindVariables(query.Query.BindVariables); bvErr != nil {
+			return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%v", bvErr)
+		}
+	}
+
+	for i, query := range queries {
+		var qr *sqltypes.Result
+		var err error
+		session, qr, err = vtg.executeBatchQuery(ctx, session, query)
+		if qr != nil {
+			vtg.rowsReturned.Add(statsKey, int64(len(qr.Rows)))
+			vtg.rowsAffected.Add(statsKey, int64(qr.RowsAffected))
+		}
+		if cbErr := callback(i, session, qr, err); cbErr != nil {
+			return cbErr
+		}
+		if err != nil && abortOnError {
+			return nil
+		}
+	}
+	return nil
+}
+
+// executeBatchQuery executes a query of StreamExecuteBatch, with its options
+// overriding the ones of the session for its duration only.
+func (vtg *VTGate) executeBatchQuery(ctx context.Context, session *vtgatepb.Session, query *vtgatepb.BatchQuery) (*vtgatepb.Session, *sqltypes.Result, error) {
+	if query.TimeoutMs > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(query.TimeoutMs)*time.Millisecond)
+		defer cancel()
+	}
+
+	targetString, readAfterWrite := session.TargetString, session.ReadAfterWrite
+	overriddenTarget := targetString
+	if query.TabletType != topodatapb.TabletType_UNKNOWN {
+		if last := strings.LastIndex(targetString, "@"); last != -1 {
+			overriddenTarget = targetString[:last]
+		}
+		overriddenTarget += "@" + topoproto.TabletTypeLString(query.TabletType)
+		session.TargetString = overriddenTarget
+	}
+	if query.ReadAfterWrite != nil {
+		session.ReadAfterWrite = query.ReadAfterWrite
+	}
+
+	session, qr, err := vtg.Execute(ctx, session, query.Query.Sql, query.Query.BindVariables)
+
+	// The settings of the session are restored, unless the query changed
+	// them itself, as a "use" statement would.
+	if session.TargetString == overriddenTarget {
+		session.TargetString = targetString
+	}
+	if query.ReadAfterWrite != nil && session.ReadAfterWrite == query.ReadAfterWrite {
+		session.ReadAfterWrite = readAfterWrite
+	}
+	return session, qr, err
+}
+
 // StreamExecute executes a streaming query. This is a V3 function.
 // Note we guarantee the callback will not be called concurrently
 // by multiple go routines.
@@ -420,7 +532,7 @@ func (vtg *VTGate) StreamExecute(ctx context.Context, session *vtgatepb.Session,
 	destKeyspace, destTabletType, _, _ := vtg.executor.ParseDestinationTarget(session.TargetString)
 	statsKey := []string{"StreamExecute", destKeyspace, topoproto.TabletTypeLString(destTabletType)}
 
-	defer vtg.timings.Record(statsKey, time.Now())
+	defer vtg.timings.RecordWithTraceID(statsKey, time.Now(), trace.TraceID(ctx))
 
 	var err error
 	if bvErr := sqltypes.ValidateBindVariables(bindVariables); bvErr != nil {
@@ -460,6 +572,26 @@ func (vtg *VTGate) CloseSession(ctx context.Context, session *vtgatepb.Session)
 	return vtg.executor.CloseSession(ctx, NewSafeSession(session))
 }
 
+// ExportSession returns a token carrying the state of the session, that
+// ImportSession can turn back into a session on another vtgate.
+func (vtg *VTGate) ExportSession(ctx context.Context, session *vtgatepb.Session) (string, error) {
+	key, err := sessionTokenKey()
+	if err != nil {
+		return "", err
+	}
+	return newSessionToken(session, key, time.Now().Add(*sessionTokenTTL))
+}
+
+// ImportSession returns the session carried by a token returned by
+// ExportSession.
+func (vtg *VTGate) ImportSession(ctx context.Context, token string) (*vtgatepb.Session, error) {
+	key, err := sessionTokenKey()
+	if err != nil {
+		return nil, err
+	}
+	return parseSessionToken(token, key, time.Now())
+}
+
 // ResolveTransaction resolves the specified 2PC transaction.
 func (vtg *VTGate) ResolveTransaction(ctx context.Context, dtid string) error {
 	return formatError(vtg.txConn.Resolve(ctx, dtid))
@@ -470,7 +602,7 @@ func (vtg *VTGate) Prepare(ctx context.Context, session *vtgatepb.Session, sql s
 	// In this context, we don't care if we can't fully parse destination
 	destKeyspace, destTabletType, _, _ := vtg.executor.ParseDestinationTarget(session.TargetString)
 	statsKey := []string{"Execute", destKeyspace, topoproto.TabletTypeLString(destTabletType)}
-	defer vtg.timings.Record(statsKey, time.Now())
+	defer vtg.timings.RecordWithTraceID(statsKey, time.Now(), trace.TraceID(ctx))
 
 	if bvErr := sqltypes.ValidateBindVariables(bindVariables); bvErr != nil {
 		err = vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%v", bvErr)
@@ -32,6 +32,7 @@ import (
 
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/discovery"
+	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/sandboxconn"
 
@@ -109,9 +110,16 @@ func TestVTGateExecute(t *testing.T) {
 	want := *sandboxconn.SingleRowResult
 	want.StatusFlags = 0 // VTGate result set does not contain status flags in sqltypes.Result
 	utils.MustMatch(t, &want, qr)
-	if !proto.Equal(sbc.Options[0], executeOptions) {
-		t.Errorf("got ExecuteOptions \n%+v, want \n%+v", sbc.Options[0], executeOptions)
+	// The options of the session are sent with the fingerprint of the query,
+	// which is not added to the ones of the session.
+	options := &querypb.ExecuteOptions{
+		IncludedFields: querypb.ExecuteOptions_TYPE_ONLY,
+		Fingerprint:    sqlparser.FingerprintQuery("select id from t1"),
 	}
+	if !proto.Equal(sbc.Options[0], options) {
+		t.Errorf("got ExecuteOptions \n%+v, want \n%+v", sbc.Options[0], options)
+	}
+	assert.Empty(t, executeOptions.Fingerprint)
 }
 
 func TestVTGateExecuteWithKeyspaceShard(t *testing.T) {
@@ -203,11 +211,61 @@ func TestVTGateStreamExecute(t *testing.T) {
 		Rows: sandboxconn.StreamRowResult.Rows,
 	}}
 	utils.MustMatch(t, want, qrs)
-	if !proto.Equal(sbc.Options[0], executeOptions) {
-		t.Errorf("got ExecuteOptions \n%+v, want \n%+v", sbc.Options[0], executeOptions)
+	options := &querypb.ExecuteOptions{
+		IncludedFields: querypb.ExecuteOptions_TYPE_ONLY,
+		Fingerprint:    sqlparser.FingerprintQuery("select id from t1"),
+	}
+	if !proto.Equal(sbc.Options[0], options) {
+		t.Errorf("got ExecuteOptions \n%+v, want \n%+v", sbc.Options[0], options)
 	}
 }
 
+func TestVTGateStreamExecuteBatch(t *testing.T) {
+	ks := KsTestUnsharded
+	createSandbox(ks)
+	hcVTGateTest.Reset()
+	primary := hcVTGateTest.AddTestTablet("aa", "1.1.1.1", 1001, ks, "0", topodatapb.TabletType_PRIMARY, true, 1, nil)
+	replica := hcVTGateTest.AddTestTablet("aa", "1.1.1.2", 1001, ks, "0", topodatapb.TabletType_REPLICA, true, 1, nil)
+	queries := []*vtgatepb.BatchQuery{{
+		Query: &querypb.BoundQuery{Sql: "select id from t1"},
+	}, {
+		Query:      &querypb.BoundQuery{Sql: "select id from t1"},
+		TabletType: topodatapb.TabletType_REPLICA,
+	}, {
+		Query: &querypb.BoundQuery{Sql: "select id from t1"},
+	}, {
+		Query: &querypb.BoundQuery{Sql: "select id from t1"},
+	}}
+
+	var indexes []int
+	var errs []error
+	callback := func(index int, session *vtgatepb.Session, qr *sqltypes.Result, err error) error {
+		assert.Equal(t, "@primary", session.TargetString)
+		indexes = append(indexes, index)
+		errs = append(errs, err)
+		return nil
+	}
+
+	// The queries sent to the primary fail, which does not stop the batch.
+	primary.MustFailCodes[vtrpcpb.Code_INVALID_ARGUMENT] = 2
+	err := rpcVTGate.StreamExecuteBatch(context.Background(), &vtgatepb.Session{Autocommit: true, TargetString: "@primary"}, queries, false, callback)
+	require.NoError(t, err)
+	assert.Equal(t, []int{0, 1, 2, 3}, indexes)
+	assert.Error(t, errs[0])
+	assert.NoError(t, errs[1])
+	assert.Error(t, errs[2])
+	assert.NoError(t, errs[3])
+	assert.EqualValues(t, 1, replica.ExecCount.Get())
+
+	// With abortOnError, the batch stops at the first failure.
+	indexes, errs = nil, nil
+	primary.MustFailCodes[vtrpcpb.Code_INVALID_ARGUMENT] = 1
+	err = rpcVTGate.StreamExecuteBatch(context.Background(), &vtgatepb.Session{Autocommit: true, TargetString: "@primary"}, queries, true, callback)
+	require.NoError(t, err)
+	assert.Equal(t, []int{0}, indexes)
+	assert.Error(t, errs[0])
+}
+
 func TestVTGateBindVarError(t *testing.T) {
 	ks := KsTestUnsharded
 	createSandbox(ks)
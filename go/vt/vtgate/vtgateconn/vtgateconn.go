@@ -55,6 +55,19 @@ func (conn *VTGateConn) Session(targetString string, options *querypb.ExecuteOpt
 	}
 }
 
+// ImportSession returns a VTGateSession continuing the session carried by
+// a token returned by VTGateSession.Export, possibly on another vtgate.
+func (conn *VTGateConn) ImportSession(ctx context.Context, token string) (*VTGateSession, error) {
+	session, err := conn.impl.ImportSession(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	return &VTGateSession{
+		session: session,
+		impl:    conn.impl,
+	}, nil
+}
+
 // ResolveTransaction resolves the 2pc transaction.
 func (conn *VTGateConn) ResolveTransaction(ctx context.Context, dtid string) error {
 	return conn.impl.ResolveTransaction(ctx, dtid)
@@ -115,6 +128,54 @@ func (sn *VTGateSession) StreamExecute(ctx context.Context, query string, bindVa
 	return sn.impl.StreamExecute(ctx, sn.session, query, bindVars)
 }
 
+// BatchQueryResult is the outcome of a query of StreamExecuteBatch.
+type BatchQueryResult struct {
+	// Index is the position of the query in the batch.
+	Index int
+	// Session is the session, as updated by the query.
+	Session *vtgatepb.Session
+	// Result is the result of the query, unless Err is set.
+	Result *sqltypes.Result
+	// Err is the error of the query.
+	Err error
+}
+
+// BatchResultStream is returned by StreamExecuteBatch.
+type BatchResultStream interface {
+	// Recv returns the outcome of the next completed query.
+	// It will return io.EOF if the batch ended.
+	Recv() (*BatchQueryResult, error)
+}
+
+// StreamExecuteBatch executes a list of queries on vtgate, in order, and
+// streams back the outcome of each query as it completes. The failure of a
+// query does not stop the batch, unless abortOnError is set.
+func (sn *VTGateSession) StreamExecuteBatch(ctx context.Context, queries []*vtgatepb.BatchQuery, abortOnError bool) (BatchResultStream, error) {
+	stream, err := sn.impl.StreamExecuteBatch(ctx, sn.session, queries, abortOnError)
+	if err != nil {
+		return nil, err
+	}
+	return &sessionBatchResultStream{stream: stream, sn: sn}, nil
+}
+
+// sessionBatchResultStream keeps the session up to date with the results
+// of the batch.
+type sessionBatchResultStream struct {
+	stream BatchResultStream
+	sn     *VTGateSession
+}
+
+func (s *sessionBatchResultStream) Recv() (*BatchQueryResult, error) {
+	result, err := s.stream.Recv()
+	if err != nil {
+		return nil, err
+	}
+	if result.Session != nil {
+		s.sn.session = result.Session
+	}
+	return result, nil
+}
+
 // Prepare performs a VTGate Prepare.
 func (sn *VTGateSession) Prepare(ctx context.Context, query string, bindVars map[string]*querypb.BindVariable) ([]*querypb.Field, error) {
 	session, fields, err := sn.impl.Prepare(ctx, sn.session, query, bindVars)
@@ -122,6 +183,13 @@ func (sn *VTGateSession) Prepare(ctx context.Context, query string, bindVars map
 	return fields, err
 }
 
+// Export returns a token carrying the state of the session, so that it can
+// be continued with VTGateConn.ImportSession on another vtgate. The session
+// must not be in a transaction.
+func (sn *VTGateSession) Export(ctx context.Context) (string, error) {
+	return sn.impl.ExportSession(ctx, sn.session)
+}
+
 //
 // The rest of this file is for the protocol implementations.
 //
@@ -141,9 +209,19 @@ type Impl interface {
 	// Prepare returns the fields information for the query as part of supporting prepare statements.
 	Prepare(ctx context.Context, session *vtgatepb.Session, sql string, bindVariables map[string]*querypb.BindVariable) (*vtgatepb.Session, []*querypb.Field, error)
 
+	// StreamExecuteBatch executes a list of queries on vtgate, streaming back
+	// the outcome of each query. This is a V3 function.
+	StreamExecuteBatch(ctx context.Context, session *vtgatepb.Session, queries []*vtgatepb.BatchQuery, abortOnError bool) (BatchResultStream, error)
+
 	// CloseSession closes the session provided by rolling back any active transaction.
 	CloseSession(ctx context.Context, session *vtgatepb.Session) error
 
+	// ExportSession returns a token carrying the state of the session.
+	ExportSession(ctx context.Context, session *vtgatepb.Session) (string, error)
+
+	// ImportSession returns the session carried by a token returned by ExportSession.
+	ImportSession(ctx context.Context, token string) (*vtgatepb.Session, error)
+
 	// ResolveTransaction resolves the specified 2pc transaction.
 	ResolveTransaction(ctx context.Context, dtid string) error
 
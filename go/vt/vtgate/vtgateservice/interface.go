@@ -35,6 +35,7 @@ type VTGateService interface {
 	// V3 API
 	Execute(ctx context.Context, session *vtgatepb.Session, sql string, bindVariables map[string]*querypb.BindVariable) (*vtgatepb.Session, *sqltypes.Result, error)
 	ExecuteBatch(ctx context.Context, session *vtgatepb.Session, sqlList []string, bindVariablesList []map[string]*querypb.BindVariable) (*vtgatepb.Session, []sqltypes.QueryResponse, error)
+	StreamExecuteBatch(ctx context.Context, session *vtgatepb.Session, queries []*vtgatepb.BatchQuery, abortOnError bool, callback func(index int, session *vtgatepb.Session, qr *sqltypes.Result, err error) error) error
 	StreamExecute(ctx context.Context, session *vtgatepb.Session, sql string, bindVariables map[string]*querypb.BindVariable, callback func(*sqltypes.Result) error) error
 	// Prepare statement support
 	Prepare(ctx context.Context, session *vtgatepb.Session, sql string, bindVariables map[string]*querypb.BindVariable) (*vtgatepb.Session, []*querypb.Field, error)
@@ -44,6 +45,14 @@ type VTGateService interface {
 	// but does not affect the query statistics.
 	CloseSession(ctx context.Context, session *vtgatepb.Session) error
 
+	// ExportSession returns a token carrying the state of a session that is
+	// not in a transaction, to continue the session on another vtgate.
+	ExportSession(ctx context.Context, session *vtgatepb.Session) (string, error)
+
+	// ImportSession returns the session carried by a token returned by
+	// ExportSession.
+	ImportSession(ctx context.Context, token string) (*vtgatepb.Session, error)
+
 	// 2PC support
 	ResolveTransaction(ctx context.Context, dtid string) error
 
@@ -0,0 +1,64 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"strings"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// defaultWorkloadName labels the queries without a workload name in the
+// metrics.
+const defaultWorkloadName = "default"
+
+var queriesByWorkload = stats.NewMultiTimings("QueriesByWorkload", "Queries processed at vtgate by workload name and priority", []string{"Workload", "Priority"})
+
+// priorityName returns the name of a priority, as set with the priority
+// system variable.
+func priorityName(priority querypb.ExecuteOptions_Priority) string {
+	return strings.ToLower(strings.TrimSuffix(priority.String(), "_PRIORITY"))
+}
+
+// queryWorkload returns the workload name and the priority of the query of
+// a plan: the ones set with its directives, or else the ones of the session.
+// vttablet resolves them the same way, from the directives of the queries
+// it receives and the options of the session.
+func queryWorkload(plan *engine.Plan, safeSession *SafeSession) (string, querypb.ExecuteOptions_Priority) {
+	options := safeSession.GetOptions()
+	workloadName, priority := options.GetWorkloadName(), options.GetPriority()
+	if plan.WorkloadName != "" {
+		workloadName = plan.WorkloadName
+	}
+	if plan.HasPriority {
+		priority = plan.Priority
+	}
+	return workloadName, priority
+}
+
+// recordWorkloadQuery records the execution of a plan in the metrics of its
+// workload.
+func recordWorkloadQuery(plan *engine.Plan, safeSession *SafeSession, logStats *LogStats) {
+	workloadName, priority := queryWorkload(plan, safeSession)
+	if workloadName == "" {
+		workloadName = defaultWorkloadName
+	}
+	queriesByWorkload.Record([]string{workloadName, priorityName(priority)}, logStats.StartTime)
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+func TestExecutorWorkloadLabels(t *testing.T) {
+	executor, sbc1, _, _ := createExecutorEnv()
+	executor.normalize = true
+	session := NewSafeSession(&vtgatepb.Session{Autocommit: true, TargetString: "@primary"})
+
+	_, err := executor.Execute(ctx, "TestExecutorWorkloadLabels", session, "set workload_name = reports, priority = 'low'", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "reports", session.GetOptions().GetWorkloadName())
+	assert.Equal(t, querypb.ExecuteOptions_LOW_PRIORITY, session.GetOptions().GetPriority())
+
+	qr, err := executor.Execute(ctx, "TestExecutorWorkloadLabels", session, "select @@workload_name, @@priority", nil)
+	require.NoError(t, err)
+	assert.Equal(t, [][]sqltypes.Value{{sqltypes.NewVarBinary("reports"), sqltypes.NewVarBinary("low")}}, qr.Rows)
+
+	// The labels travel to vttablet with the options of the session, and
+	// the directives of the queries override them in the metrics.
+	before := queriesByWorkload.Counts()
+	sbc1.Options = nil
+	_, err = executor.Execute(ctx, "TestExecutorWorkloadLabels", session, "select id from user where id = 1", nil)
+	require.NoError(t, err)
+	require.Len(t, sbc1.Options, 1)
+	assert.Equal(t, "reports", sbc1.Options[0].WorkloadName)
+	assert.Equal(t, querypb.ExecuteOptions_LOW_PRIORITY, sbc1.Options[0].Priority)
+	_, err = executor.Execute(ctx, "TestExecutorWorkloadLabels", session, "select /*vt+ WORKLOAD_NAME=batch PRIORITY=high */ id from user where id = 1", nil)
+	require.NoError(t, err)
+	after := queriesByWorkload.Counts()
+	assert.EqualValues(t, 1, after["reports.low"]-before["reports.low"])
+	assert.EqualValues(t, 1, after["batch.high"]-before["batch.high"])
+
+	for _, query := range []string{
+		"set workload_name = 'not-a-name'",
+		"set priority = urgent",
+		"select /*vt+ PRIORITY=urgent */ id from user where id = 1",
+	} {
+		_, err = executor.Execute(ctx, "TestExecutorWorkloadLabels", session, query, nil)
+		require.Error(t, err, query)
+		assert.Equal(t, vtrpcpb.Code_INVALID_ARGUMENT, vterrors.Code(err), query)
+	}
+
+	_, err = executor.Execute(ctx, "TestExecutorWorkloadLabels", session, "set workload_name = '', priority = normal", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "", session.GetOptions().GetWorkloadName())
+	assert.Equal(t, querypb.ExecuteOptions_NORMAL_PRIORITY, session.GetOptions().GetPriority())
+}
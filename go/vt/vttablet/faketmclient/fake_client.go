@@ -23,6 +23,7 @@ package faketmclient
 
 import (
 	"io"
+	"net/http"
 	"time"
 
 	"context"
@@ -83,6 +84,16 @@ func (client *FakeTabletManagerClient) ExecuteHook(ctx context.Context, tablet *
 	return &hr, nil
 }
 
+// GetLifecycleHooks is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) GetLifecycleHooks(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.GetLifecycleHooksResponse, error) {
+	return &tabletmanagerdatapb.GetLifecycleHooksResponse{}, nil
+}
+
+// GetVersion is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) GetVersion(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.GetVersionResponse, error) {
+	return &tabletmanagerdatapb.GetVersionResponse{}, nil
+}
+
 // GetSchema is part of the tmclient.TabletManagerClient interface.
 func (client *FakeTabletManagerClient) GetSchema(ctx context.Context, tablet *topodatapb.Tablet, tables, excludeTables []string, includeViews bool) (*tabletmanagerdatapb.SchemaDefinition, error) {
 	return client.tmc.GetSchema(ctx, tablet, tables, excludeTables, includeViews)
@@ -122,6 +133,11 @@ func (client *FakeTabletManagerClient) ChangeType(ctx context.Context, tablet *t
 	return nil
 }
 
+// ChangeTags is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) ChangeTags(ctx context.Context, tablet *topodatapb.Tablet, tags map[string]string, replace bool) (map[string]string, error) {
+	return tags, nil
+}
+
 // RefreshState is part of the tmclient.TabletManagerClient interface.
 func (client *FakeTabletManagerClient) RefreshState(ctx context.Context, tablet *topodatapb.Tablet) error {
 	return nil
@@ -172,6 +188,11 @@ func (client *FakeTabletManagerClient) ExecuteFetchAsApp(ctx context.Context, ta
 	return &querypb.QueryResult{}, nil
 }
 
+// KillQueries is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) KillQueries(ctx context.Context, tablet *topodatapb.Tablet, fingerprint, user string) (int64, error) {
+	return 0, nil
+}
+
 //
 // Replication related methods
 //
@@ -226,6 +247,11 @@ func (client *FakeTabletManagerClient) ReplicaWasPromoted(ctx context.Context, t
 	return nil
 }
 
+// SetReplicationDelay is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) SetReplicationDelay(ctx context.Context, tablet *topodatapb.Tablet, delay time.Duration) error {
+	return nil
+}
+
 // ReplicaWasRestarted is part of the tmclient.TabletManagerClient interface.
 func (client *FakeTabletManagerClient) ReplicaWasRestarted(ctx context.Context, tablet *topodatapb.Tablet, parent *topodatapb.TabletAlias) error {
 	return nil
@@ -271,6 +297,15 @@ func (client *FakeTabletManagerClient) VReplicationWaitForPos(ctx context.Contex
 	return nil
 }
 
+// CheckThrottler is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) CheckThrottler(ctx context.Context, tablet *topodatapb.Tablet, checks []*tabletmanagerdatapb.CheckThrottlerRequest_Check) ([]*tabletmanagerdatapb.CheckThrottlerResponse_Result, error) {
+	results := make([]*tabletmanagerdatapb.CheckThrottlerResponse_Result, len(checks))
+	for i := range checks {
+		results[i] = &tabletmanagerdatapb.CheckThrottlerResponse_Result{StatusCode: http.StatusOK}
+	}
+	return results, nil
+}
+
 //
 // Reparenting related functions
 //
@@ -346,7 +381,7 @@ func (e *eofEventStream) Recv() (*logutilpb.Event, error) {
 }
 
 // Backup is part of the tmclient.TabletManagerClient interface.
-func (client *FakeTabletManagerClient) Backup(ctx context.Context, tablet *topodatapb.Tablet, concurrency int, allowMaster bool) (logutil.EventStream, error) {
+func (client *FakeTabletManagerClient) Backup(ctx context.Context, tablet *topodatapb.Tablet, concurrency int, allowMaster bool, compressionEngine string) (logutil.EventStream, error) {
 	return &eofEventStream{}, nil
 }
 
@@ -355,6 +390,16 @@ func (client *FakeTabletManagerClient) RestoreFromBackup(ctx context.Context, ta
 	return &eofEventStream{}, nil
 }
 
+// GetRestoreProgress is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) GetRestoreProgress(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.RestoreProgress, error) {
+	return &tabletmanagerdatapb.RestoreProgress{}, nil
+}
+
+// StreamArchivedBinlogs is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) StreamArchivedBinlogs(ctx context.Context, tablet *topodatapb.Tablet, position string, callback func(*tabletmanagerdatapb.StreamArchivedBinlogsResponse) error) error {
+	return nil
+}
+
 //
 // Management related methods
 //
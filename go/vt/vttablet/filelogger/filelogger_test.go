@@ -65,7 +65,7 @@ func TestFileLog(t *testing.T) {
 	for i := 0; i < 10; i++ {
 		time.Sleep(10 * time.Millisecond)
 
-		want := "\t\t\t''\t''\t0001-01-01 00:00:00.000000\t0001-01-01 00:00:00.000000\t0.000000\t\t\"test 1\"\tmap[]\t1\t\"test 1 PII\"\tmysql\t0.000000\t0.000000\t0\t0\t0\t\"\"\t\n\t\t\t''\t''\t0001-01-01 00:00:00.000000\t0001-01-01 00:00:00.000000\t0.000000\t\t\"test 2\"\tmap[]\t1\t\"test 2 PII\"\tmysql\t0.000000\t0.000000\t0\t0\t0\t\"\"\t\n"
+		want := "\t\t\t''\t''\t0001-01-01 00:00:00.000000\t0001-01-01 00:00:00.000000\t0.000000\t\t\"test 1\"\tmap[]\t1\t\"test 1 PII\"\tmysql\t0.000000\t0.000000\t0\t0\t0\t\"\"\t\"\"\t\n\t\t\t''\t''\t0001-01-01 00:00:00.000000\t0001-01-01 00:00:00.000000\t0.000000\t\t\"test 2\"\tmap[]\t1\t\"test 2 PII\"\tmysql\t0.000000\t0.000000\t0\t0\t0\t\"\"\t\"\"\t\n"
 		contents, _ := ioutil.ReadFile(logPath)
 		got := string(contents)
 		if want == got {
@@ -102,24 +102,24 @@ func TestFileLogRedacted(t *testing.T) {
 
 	log1 := &tabletenv.LogStats{
 		Ctx:         ctx,
-		OriginalSQL: "test 1",
+		OriginalSQL: "select * from t1 where name = 'PII'",
 	}
-	log1.AddRewrittenSQL("test 1 PII", time.Time{})
+	log1.AddRewrittenSQL("select * from t1 where name = 'PII' limit 10001", time.Time{})
 	log1.MysqlResponseTime = 0
 	tabletenv.StatsLogger.Send(log1)
 
 	log2 := &tabletenv.LogStats{
 		Ctx:         ctx,
-		OriginalSQL: "test 2",
+		OriginalSQL: "select * from t2 where name = 'PII'",
 	}
-	log2.AddRewrittenSQL("test 2 PII", time.Time{})
+	log2.AddRewrittenSQL("select * from t2 where name = 'PII' limit 10001", time.Time{})
 	log2.MysqlResponseTime = 0
 	tabletenv.StatsLogger.Send(log2)
 
 	// Allow time for propagation
 	time.Sleep(10 * time.Millisecond)
 
-	want := "\t\t\t''\t''\t0001-01-01 00:00:00.000000\t0001-01-01 00:00:00.000000\t0.000000\t\t\"test 1\"\t\"[REDACTED]\"\t1\t\"[REDACTED]\"\tmysql\t0.000000\t0.000000\t0\t0\t0\t\"\"\t\n\t\t\t''\t''\t0001-01-01 00:00:00.000000\t0001-01-01 00:00:00.000000\t0.000000\t\t\"test 2\"\t\"[REDACTED]\"\t1\t\"[REDACTED]\"\tmysql\t0.000000\t0.000000\t0\t0\t0\t\"\"\t\n"
+	want := "\t\t\t''\t''\t0001-01-01 00:00:00.000000\t0001-01-01 00:00:00.000000\t0.000000\t\t\"select * from t1 where `name` = :redacted1\"\t\"[REDACTED]\"\t1\t\"[REDACTED]\"\tmysql\t0.000000\t0.000000\t0\t0\t0\t\"\"\t\"\"\t\n\t\t\t''\t''\t0001-01-01 00:00:00.000000\t0001-01-01 00:00:00.000000\t0.000000\t\t\"select * from t2 where `name` = :redacted1\"\t\"[REDACTED]\"\t1\t\"[REDACTED]\"\tmysql\t0.000000\t0.000000\t0\t0\t0\t\"\"\t\"\"\t\n"
 	contents, _ := ioutil.ReadFile(logPath)
 	got := string(contents)
 	if want != string(got) {
@@ -23,8 +23,6 @@ import (
 
 	"context"
 
-	"google.golang.org/grpc"
-
 	"vitess.io/vitess/go/netutil"
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/callerid"
@@ -45,6 +43,9 @@ var (
 	key  = flag.String("tablet_grpc_key", "", "the key to use to connect")
 	ca   = flag.String("tablet_grpc_ca", "", "the server ca to use to validate servers when connecting")
 	name = flag.String("tablet_grpc_server_name", "", "the server name to use to validate server certificate")
+	// poolSize is the number of gRPC channels to each tablet. More than one
+	// spreads the queries of a busy vtgate over several HTTP/2 connections.
+	poolSize = flag.Int("tablet_grpc_channel_pool_size", 1, "number of gRPC channels to open to each tablet, over which the queries are spread")
 )
 
 func init() {
@@ -57,9 +58,8 @@ type gRPCQueryClient struct {
 	tablet *topodatapb.Tablet
 
 	// mu protects the next fields
-	mu sync.RWMutex
-	cc *grpc.ClientConn
-	c  queryservicepb.QueryClient
+	mu   sync.RWMutex
+	pool *grpcclient.ConnPool
 }
 
 var _ queryservice.QueryService = (*gRPCQueryClient)(nil)
@@ -77,16 +77,14 @@ func DialTablet(tablet *topodatapb.Tablet, failFast grpcclient.FailFast) (querys
 	if err != nil {
 		return nil, err
 	}
-	cc, err := grpcclient.Dial(addr, failFast, opt)
+	pool, err := grpcclient.DialPool("tablet", addr, *poolSize, failFast, opt)
 	if err != nil {
 		return nil, err
 	}
-	c := queryservicepb.NewQueryClient(cc)
 
 	result := &gRPCQueryClient{
 		tablet: tablet,
-		cc:     cc,
-		c:      c,
+		pool:   pool,
 	}
 
 	return result, nil
@@ -96,7 +94,7 @@ func DialTablet(tablet *topodatapb.Tablet, failFast grpcclient.FailFast) (querys
 func (conn *gRPCQueryClient) Execute(ctx context.Context, target *querypb.Target, query string, bindVars map[string]*querypb.BindVariable, transactionID, reservedID int64, options *querypb.ExecuteOptions) (*sqltypes.Result, error) {
 	conn.mu.RLock()
 	defer conn.mu.RUnlock()
-	if conn.cc == nil {
+	if conn.pool == nil {
 		return nil, tabletconn.ConnClosed
 	}
 
@@ -112,7 +110,7 @@ func (conn *gRPCQueryClient) Execute(ctx context.Context, target *querypb.Target
 		Options:       options,
 		ReservedId:    reservedID,
 	}
-	er, err := conn.c.Execute(ctx, req)
+	er, err := conn.client().Execute(ctx, req)
 	if err != nil {
 		return nil, tabletconn.ErrorFromGRPC(err)
 	}
@@ -123,7 +121,7 @@ func (conn *gRPCQueryClient) Execute(ctx context.Context, target *querypb.Target
 func (conn *gRPCQueryClient) ExecuteBatch(ctx context.Context, target *querypb.Target, queries []*querypb.BoundQuery, asTransaction bool, transactionID int64, options *querypb.ExecuteOptions) ([]sqltypes.Result, error) {
 	conn.mu.RLock()
 	defer conn.mu.RUnlock()
-	if conn.cc == nil {
+	if conn.pool == nil {
 		return nil, tabletconn.ConnClosed
 	}
 
@@ -136,7 +134,7 @@ func (conn *gRPCQueryClient) ExecuteBatch(ctx context.Context, target *querypb.T
 		TransactionId:     transactionID,
 		Options:           options,
 	}
-	ebr, err := conn.c.ExecuteBatch(ctx, req)
+	ebr, err := conn.client().ExecuteBatch(ctx, req)
 	if err != nil {
 		return nil, tabletconn.ErrorFromGRPC(err)
 	}
@@ -159,7 +157,7 @@ func (conn *gRPCQueryClient) StreamExecute(ctx context.Context, target *querypb.
 	stream, err := func() (queryservicepb.Query_StreamExecuteClient, error) {
 		conn.mu.RLock()
 		defer conn.mu.RUnlock()
-		if conn.cc == nil {
+		if conn.pool == nil {
 			return nil, tabletconn.ConnClosed
 		}
 
@@ -174,7 +172,7 @@ func (conn *gRPCQueryClient) StreamExecute(ctx context.Context, target *querypb.
 			Options:       options,
 			TransactionId: transactionID,
 		}
-		stream, err := conn.c.StreamExecute(ctx, req)
+		stream, err := conn.client().StreamExecute(ctx, req)
 		if err != nil {
 			return nil, tabletconn.ErrorFromGRPC(err)
 		}
@@ -205,7 +203,7 @@ func (conn *gRPCQueryClient) StreamExecute(ctx context.Context, target *querypb.
 func (conn *gRPCQueryClient) Begin(ctx context.Context, target *querypb.Target, options *querypb.ExecuteOptions) (transactionID int64, alias *topodatapb.TabletAlias, err error) {
 	conn.mu.RLock()
 	defer conn.mu.RUnlock()
-	if conn.cc == nil {
+	if conn.pool == nil {
 		return 0, nil, tabletconn.ConnClosed
 	}
 
@@ -215,7 +213,7 @@ func (conn *gRPCQueryClient) Begin(ctx context.Context, target *querypb.Target,
 		ImmediateCallerId: callerid.ImmediateCallerIDFromContext(ctx),
 		Options:           options,
 	}
-	br, err := conn.c.Begin(ctx, req)
+	br, err := conn.client().Begin(ctx, req)
 	if err != nil {
 		return 0, nil, tabletconn.ErrorFromGRPC(err)
 	}
@@ -230,7 +228,7 @@ func (conn *gRPCQueryClient) Begin(ctx context.Context, target *querypb.Target,
 func (conn *gRPCQueryClient) Commit(ctx context.Context, target *querypb.Target, transactionID int64) (int64, error) {
 	conn.mu.RLock()
 	defer conn.mu.RUnlock()
-	if conn.cc == nil {
+	if conn.pool == nil {
 		return 0, tabletconn.ConnClosed
 	}
 
@@ -240,7 +238,7 @@ func (conn *gRPCQueryClient) Commit(ctx context.Context, target *querypb.Target,
 		ImmediateCallerId: callerid.ImmediateCallerIDFromContext(ctx),
 		TransactionId:     transactionID,
 	}
-	resp, err := conn.c.Commit(ctx, req)
+	resp, err := conn.client().Commit(ctx, req)
 	if err != nil {
 		return 0, tabletconn.ErrorFromGRPC(err)
 	}
@@ -251,7 +249,7 @@ func (conn *gRPCQueryClient) Commit(ctx context.Context, target *querypb.Target,
 func (conn *gRPCQueryClient) Rollback(ctx context.Context, target *querypb.Target, transactionID int64) (int64, error) {
 	conn.mu.RLock()
 	defer conn.mu.RUnlock()
-	if conn.cc == nil {
+	if conn.pool == nil {
 		return 0, tabletconn.ConnClosed
 	}
 
@@ -261,7 +259,7 @@ func (conn *gRPCQueryClient) Rollback(ctx context.Context, target *querypb.Targe
 		ImmediateCallerId: callerid.ImmediateCallerIDFromContext(ctx),
 		TransactionId:     transactionID,
 	}
-	resp, err := conn.c.Rollback(ctx, req)
+	resp, err := conn.client().Rollback(ctx, req)
 	if err != nil {
 		return 0, tabletconn.ErrorFromGRPC(err)
 	}
@@ -272,7 +270,7 @@ func (conn *gRPCQueryClient) Rollback(ctx context.Context, target *querypb.Targe
 func (conn *gRPCQueryClient) Prepare(ctx context.Context, target *querypb.Target, transactionID int64, dtid string) error {
 	conn.mu.RLock()
 	defer conn.mu.RUnlock()
-	if conn.cc == nil {
+	if conn.pool == nil {
 		return tabletconn.ConnClosed
 	}
 
@@ -283,7 +281,7 @@ func (conn *gRPCQueryClient) Prepare(ctx context.Context, target *querypb.Target
 		TransactionId:     transactionID,
 		Dtid:              dtid,
 	}
-	_, err := conn.c.Prepare(ctx, req)
+	_, err := conn.client().Prepare(ctx, req)
 	if err != nil {
 		return tabletconn.ErrorFromGRPC(err)
 	}
@@ -294,7 +292,7 @@ func (conn *gRPCQueryClient) Prepare(ctx context.Context, target *querypb.Target
 func (conn *gRPCQueryClient) CommitPrepared(ctx context.Context, target *querypb.Target, dtid string) error {
 	conn.mu.RLock()
 	defer conn.mu.RUnlock()
-	if conn.cc == nil {
+	if conn.pool == nil {
 		return tabletconn.ConnClosed
 	}
 
@@ -304,7 +302,7 @@ func (conn *gRPCQueryClient) CommitPrepared(ctx context.Context, target *querypb
 		ImmediateCallerId: callerid.ImmediateCallerIDFromContext(ctx),
 		Dtid:              dtid,
 	}
-	_, err := conn.c.CommitPrepared(ctx, req)
+	_, err := conn.client().CommitPrepared(ctx, req)
 	if err != nil {
 		return tabletconn.ErrorFromGRPC(err)
 	}
@@ -315,7 +313,7 @@ func (conn *gRPCQueryClient) CommitPrepared(ctx context.Context, target *querypb
 func (conn *gRPCQueryClient) RollbackPrepared(ctx context.Context, target *querypb.Target, dtid string, originalID int64) error {
 	conn.mu.RLock()
 	defer conn.mu.RUnlock()
-	if conn.cc == nil {
+	if conn.pool == nil {
 		return tabletconn.ConnClosed
 	}
 
@@ -326,7 +324,7 @@ func (conn *gRPCQueryClient) RollbackPrepared(ctx context.Context, target *query
 		TransactionId:     originalID,
 		Dtid:              dtid,
 	}
-	_, err := conn.c.RollbackPrepared(ctx, req)
+	_, err := conn.client().RollbackPrepared(ctx, req)
 	if err != nil {
 		return tabletconn.ErrorFromGRPC(err)
 	}
@@ -337,7 +335,7 @@ func (conn *gRPCQueryClient) RollbackPrepared(ctx context.Context, target *query
 func (conn *gRPCQueryClient) CreateTransaction(ctx context.Context, target *querypb.Target, dtid string, participants []*querypb.Target) error {
 	conn.mu.RLock()
 	defer conn.mu.RUnlock()
-	if conn.cc == nil {
+	if conn.pool == nil {
 		return tabletconn.ConnClosed
 	}
 
@@ -348,7 +346,7 @@ func (conn *gRPCQueryClient) CreateTransaction(ctx context.Context, target *quer
 		Dtid:              dtid,
 		Participants:      participants,
 	}
-	_, err := conn.c.CreateTransaction(ctx, req)
+	_, err := conn.client().CreateTransaction(ctx, req)
 	if err != nil {
 		return tabletconn.ErrorFromGRPC(err)
 	}
@@ -360,7 +358,7 @@ func (conn *gRPCQueryClient) CreateTransaction(ctx context.Context, target *quer
 func (conn *gRPCQueryClient) StartCommit(ctx context.Context, target *querypb.Target, transactionID int64, dtid string) error {
 	conn.mu.RLock()
 	defer conn.mu.RUnlock()
-	if conn.cc == nil {
+	if conn.pool == nil {
 		return tabletconn.ConnClosed
 	}
 
@@ -371,7 +369,7 @@ func (conn *gRPCQueryClient) StartCommit(ctx context.Context, target *querypb.Ta
 		TransactionId:     transactionID,
 		Dtid:              dtid,
 	}
-	_, err := conn.c.StartCommit(ctx, req)
+	_, err := conn.client().StartCommit(ctx, req)
 	if err != nil {
 		return tabletconn.ErrorFromGRPC(err)
 	}
@@ -383,7 +381,7 @@ func (conn *gRPCQueryClient) StartCommit(ctx context.Context, target *querypb.Ta
 func (conn *gRPCQueryClient) SetRollback(ctx context.Context, target *querypb.Target, dtid string, transactionID int64) error {
 	conn.mu.RLock()
 	defer conn.mu.RUnlock()
-	if conn.cc == nil {
+	if conn.pool == nil {
 		return tabletconn.ConnClosed
 	}
 
@@ -394,7 +392,7 @@ func (conn *gRPCQueryClient) SetRollback(ctx context.Context, target *querypb.Ta
 		TransactionId:     transactionID,
 		Dtid:              dtid,
 	}
-	_, err := conn.c.SetRollback(ctx, req)
+	_, err := conn.client().SetRollback(ctx, req)
 	if err != nil {
 		return tabletconn.ErrorFromGRPC(err)
 	}
@@ -406,7 +404,7 @@ func (conn *gRPCQueryClient) SetRollback(ctx context.Context, target *querypb.Ta
 func (conn *gRPCQueryClient) ConcludeTransaction(ctx context.Context, target *querypb.Target, dtid string) error {
 	conn.mu.RLock()
 	defer conn.mu.RUnlock()
-	if conn.cc == nil {
+	if conn.pool == nil {
 		return tabletconn.ConnClosed
 	}
 
@@ -416,7 +414,7 @@ func (conn *gRPCQueryClient) ConcludeTransaction(ctx context.Context, target *qu
 		ImmediateCallerId: callerid.ImmediateCallerIDFromContext(ctx),
 		Dtid:              dtid,
 	}
-	_, err := conn.c.ConcludeTransaction(ctx, req)
+	_, err := conn.client().ConcludeTransaction(ctx, req)
 	if err != nil {
 		return tabletconn.ErrorFromGRPC(err)
 	}
@@ -427,7 +425,7 @@ func (conn *gRPCQueryClient) ConcludeTransaction(ctx context.Context, target *qu
 func (conn *gRPCQueryClient) ReadTransaction(ctx context.Context, target *querypb.Target, dtid string) (*querypb.TransactionMetadata, error) {
 	conn.mu.RLock()
 	defer conn.mu.RUnlock()
-	if conn.cc == nil {
+	if conn.pool == nil {
 		return nil, tabletconn.ConnClosed
 	}
 
@@ -437,7 +435,7 @@ func (conn *gRPCQueryClient) ReadTransaction(ctx context.Context, target *queryp
 		ImmediateCallerId: callerid.ImmediateCallerIDFromContext(ctx),
 		Dtid:              dtid,
 	}
-	response, err := conn.c.ReadTransaction(ctx, req)
+	response, err := conn.client().ReadTransaction(ctx, req)
 	if err != nil {
 		return nil, tabletconn.ErrorFromGRPC(err)
 	}
@@ -448,7 +446,7 @@ func (conn *gRPCQueryClient) ReadTransaction(ctx context.Context, target *queryp
 func (conn *gRPCQueryClient) BeginExecute(ctx context.Context, target *querypb.Target, preQueries []string, query string, bindVars map[string]*querypb.BindVariable, reservedID int64, options *querypb.ExecuteOptions) (result *sqltypes.Result, transactionID int64, alias *topodatapb.TabletAlias, err error) {
 	conn.mu.RLock()
 	defer conn.mu.RUnlock()
-	if conn.cc == nil {
+	if conn.pool == nil {
 		return nil, 0, nil, tabletconn.ConnClosed
 	}
 
@@ -464,7 +462,7 @@ func (conn *gRPCQueryClient) BeginExecute(ctx context.Context, target *querypb.T
 		ReservedId: reservedID,
 		Options:    options,
 	}
-	reply, err := conn.c.BeginExecute(ctx, req)
+	reply, err := conn.client().BeginExecute(ctx, req)
 	if err != nil {
 		return nil, 0, nil, tabletconn.ErrorFromGRPC(err)
 	}
@@ -478,7 +476,7 @@ func (conn *gRPCQueryClient) BeginExecute(ctx context.Context, target *querypb.T
 func (conn *gRPCQueryClient) BeginExecuteBatch(ctx context.Context, target *querypb.Target, queries []*querypb.BoundQuery, asTransaction bool, options *querypb.ExecuteOptions) (results []sqltypes.Result, transactionID int64, alias *topodatapb.TabletAlias, err error) {
 	conn.mu.RLock()
 	defer conn.mu.RUnlock()
-	if conn.cc == nil {
+	if conn.pool == nil {
 		return nil, 0, nil, tabletconn.ConnClosed
 	}
 
@@ -491,7 +489,7 @@ func (conn *gRPCQueryClient) BeginExecuteBatch(ctx context.Context, target *quer
 		Options:           options,
 	}
 
-	reply, err := conn.c.BeginExecuteBatch(ctx, req)
+	reply, err := conn.client().BeginExecuteBatch(ctx, req)
 	if err != nil {
 		return nil, 0, nil, tabletconn.ErrorFromGRPC(err)
 	}
@@ -510,7 +508,7 @@ func (conn *gRPCQueryClient) MessageStream(ctx context.Context, target *querypb.
 	stream, err := func() (queryservicepb.Query_MessageStreamClient, error) {
 		conn.mu.RLock()
 		defer conn.mu.RUnlock()
-		if conn.cc == nil {
+		if conn.pool == nil {
 			return nil, tabletconn.ConnClosed
 		}
 
@@ -520,7 +518,7 @@ func (conn *gRPCQueryClient) MessageStream(ctx context.Context, target *querypb.
 			ImmediateCallerId: callerid.ImmediateCallerIDFromContext(ctx),
 			Name:              name,
 		}
-		stream, err := conn.c.MessageStream(ctx, req)
+		stream, err := conn.client().MessageStream(ctx, req)
 		if err != nil {
 			return nil, tabletconn.ErrorFromGRPC(err)
 		}
@@ -551,7 +549,7 @@ func (conn *gRPCQueryClient) MessageStream(ctx context.Context, target *querypb.
 func (conn *gRPCQueryClient) MessageAck(ctx context.Context, target *querypb.Target, name string, ids []*querypb.Value) (int64, error) {
 	conn.mu.RLock()
 	defer conn.mu.RUnlock()
-	if conn.cc == nil {
+	if conn.pool == nil {
 		return 0, tabletconn.ConnClosed
 	}
 	req := &querypb.MessageAckRequest{
@@ -561,7 +559,7 @@ func (conn *gRPCQueryClient) MessageAck(ctx context.Context, target *querypb.Tar
 		Name:              name,
 		Ids:               ids,
 	}
-	reply, err := conn.c.MessageAck(ctx, req)
+	reply, err := conn.client().MessageAck(ctx, req)
 	if err != nil {
 		return 0, tabletconn.ErrorFromGRPC(err)
 	}
@@ -577,11 +575,11 @@ func (conn *gRPCQueryClient) StreamHealth(ctx context.Context, callback func(*qu
 	stream, err := func() (queryservicepb.Query_StreamHealthClient, error) {
 		conn.mu.RLock()
 		defer conn.mu.RUnlock()
-		if conn.cc == nil {
+		if conn.pool == nil {
 			return nil, tabletconn.ConnClosed
 		}
 
-		stream, err := conn.c.StreamHealth(ctx, &querypb.StreamHealthRequest{})
+		stream, err := conn.client().StreamHealth(ctx, &querypb.StreamHealthRequest{})
 		if err != nil {
 			return nil, tabletconn.ErrorFromGRPC(err)
 		}
@@ -609,7 +607,7 @@ func (conn *gRPCQueryClient) VStream(ctx context.Context, target *querypb.Target
 	stream, err := func() (queryservicepb.Query_VStreamClient, error) {
 		conn.mu.RLock()
 		defer conn.mu.RUnlock()
-		if conn.cc == nil {
+		if conn.pool == nil {
 			return nil, tabletconn.ConnClosed
 		}
 
@@ -621,7 +619,7 @@ func (conn *gRPCQueryClient) VStream(ctx context.Context, target *querypb.Target
 			Filter:            filter,
 			TableLastPKs:      tablePKs,
 		}
-		stream, err := conn.c.VStream(ctx, req)
+		stream, err := conn.client().VStream(ctx, req)
 		if err != nil {
 			return nil, tabletconn.ErrorFromGRPC(err)
 		}
@@ -654,7 +652,7 @@ func (conn *gRPCQueryClient) VStreamRows(ctx context.Context, target *querypb.Ta
 	stream, err := func() (queryservicepb.Query_VStreamRowsClient, error) {
 		conn.mu.RLock()
 		defer conn.mu.RUnlock()
-		if conn.cc == nil {
+		if conn.pool == nil {
 			return nil, tabletconn.ConnClosed
 		}
 
@@ -665,7 +663,7 @@ func (conn *gRPCQueryClient) VStreamRows(ctx context.Context, target *querypb.Ta
 			Query:             query,
 			Lastpk:            lastpk,
 		}
-		stream, err := conn.c.VStreamRows(ctx, req)
+		stream, err := conn.client().VStreamRows(ctx, req)
 		if err != nil {
 			return nil, tabletconn.ErrorFromGRPC(err)
 		}
@@ -695,7 +693,7 @@ func (conn *gRPCQueryClient) VStreamResults(ctx context.Context, target *querypb
 	stream, err := func() (queryservicepb.Query_VStreamResultsClient, error) {
 		conn.mu.RLock()
 		defer conn.mu.RUnlock()
-		if conn.cc == nil {
+		if conn.pool == nil {
 			return nil, tabletconn.ConnClosed
 		}
 
@@ -705,7 +703,7 @@ func (conn *gRPCQueryClient) VStreamResults(ctx context.Context, target *querypb
 			ImmediateCallerId: callerid.ImmediateCallerIDFromContext(ctx),
 			Query:             query,
 		}
-		stream, err := conn.c.VStreamResults(ctx, req)
+		stream, err := conn.client().VStreamResults(ctx, req)
 		if err != nil {
 			return nil, tabletconn.ErrorFromGRPC(err)
 		}
@@ -738,7 +736,7 @@ func (conn *gRPCQueryClient) HandlePanic(err *error) {
 func (conn *gRPCQueryClient) ReserveBeginExecute(ctx context.Context, target *querypb.Target, preQueries []string, sql string, bindVariables map[string]*querypb.BindVariable, options *querypb.ExecuteOptions) (*sqltypes.Result, int64, int64, *topodatapb.TabletAlias, error) {
 	conn.mu.RLock()
 	defer conn.mu.RUnlock()
-	if conn.cc == nil {
+	if conn.pool == nil {
 		return nil, 0, 0, nil, tabletconn.ConnClosed
 	}
 
@@ -753,7 +751,7 @@ func (conn *gRPCQueryClient) ReserveBeginExecute(ctx context.Context, target *qu
 			BindVariables: bindVariables,
 		},
 	}
-	reply, err := conn.c.ReserveBeginExecute(ctx, req)
+	reply, err := conn.client().ReserveBeginExecute(ctx, req)
 	if err != nil {
 		return nil, 0, 0, nil, tabletconn.ErrorFromGRPC(err)
 	}
@@ -768,7 +766,7 @@ func (conn *gRPCQueryClient) ReserveBeginExecute(ctx context.Context, target *qu
 func (conn *gRPCQueryClient) ReserveExecute(ctx context.Context, target *querypb.Target, preQueries []string, sql string, bindVariables map[string]*querypb.BindVariable, transactionID int64, options *querypb.ExecuteOptions) (*sqltypes.Result, int64, *topodatapb.TabletAlias, error) {
 	conn.mu.RLock()
 	defer conn.mu.RUnlock()
-	if conn.cc == nil {
+	if conn.pool == nil {
 		return nil, 0, nil, tabletconn.ConnClosed
 	}
 
@@ -784,7 +782,7 @@ func (conn *gRPCQueryClient) ReserveExecute(ctx context.Context, target *querypb
 		Options:       options,
 		PreQueries:    preQueries,
 	}
-	reply, err := conn.c.ReserveExecute(ctx, req)
+	reply, err := conn.client().ReserveExecute(ctx, req)
 	if err != nil {
 		return nil, 0, nil, tabletconn.ErrorFromGRPC(err)
 	}
@@ -798,7 +796,7 @@ func (conn *gRPCQueryClient) ReserveExecute(ctx context.Context, target *querypb
 func (conn *gRPCQueryClient) Release(ctx context.Context, target *querypb.Target, transactionID, reservedID int64) error {
 	conn.mu.RLock()
 	defer conn.mu.RUnlock()
-	if conn.cc == nil {
+	if conn.pool == nil {
 		return tabletconn.ConnClosed
 	}
 
@@ -809,7 +807,7 @@ func (conn *gRPCQueryClient) Release(ctx context.Context, target *querypb.Target
 		TransactionId:     transactionID,
 		ReservedId:        reservedID,
 	}
-	_, err := conn.c.Release(ctx, req)
+	_, err := conn.client().Release(ctx, req)
 	if err != nil {
 		return tabletconn.ErrorFromGRPC(err)
 	}
@@ -820,13 +818,19 @@ func (conn *gRPCQueryClient) Release(ctx context.Context, target *querypb.Target
 func (conn *gRPCQueryClient) Close(ctx context.Context) error {
 	conn.mu.Lock()
 	defer conn.mu.Unlock()
-	if conn.cc == nil {
+	if conn.pool == nil {
 		return nil
 	}
 
-	cc := conn.cc
-	conn.cc = nil
-	return cc.Close()
+	pool := conn.pool
+	conn.pool = nil
+	return pool.Close()
+}
+
+// client returns a client on the next channel of the pool. It must be called
+// with the lock held, and the connection not closed.
+func (conn *gRPCQueryClient) client() queryservicepb.QueryClient {
+	return queryservicepb.NewQueryClient(conn.pool.Conn())
 }
 
 // Tablet returns the rpc end point.
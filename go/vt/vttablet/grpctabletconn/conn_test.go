@@ -63,6 +63,40 @@ func TestGRPCTabletConn(t *testing.T) {
 	}, service, nil)
 }
 
+// This test makes sure the go rpc service works over a pool of channels
+func TestGRPCTabletConnPool(t *testing.T) {
+	defer func(size int) { *poolSize = size }(*poolSize)
+	*poolSize = 3
+
+	// fake service
+	service := tabletconntest.CreateFakeServer(t)
+
+	// listen on a random port
+	listener, err := net.Listen("tcp", ":0")
+	if err != nil {
+		t.Fatalf("Cannot listen: %v", err)
+	}
+	host := listener.Addr().(*net.TCPAddr).IP.String()
+	port := listener.Addr().(*net.TCPAddr).Port
+
+	// Create a gRPC server and listen on the port
+	server := grpc.NewServer()
+	grpcqueryservice.Register(server, service)
+	go server.Serve(listener)
+
+	// run the test suite
+	tabletconntest.TestSuite(t, protocolName, &topodatapb.Tablet{
+		Keyspace: tabletconntest.TestTarget.Keyspace,
+		Shard:    tabletconntest.TestTarget.Shard,
+		Type:     tabletconntest.TestTarget.TabletType,
+		Alias:    tabletconntest.TestAlias,
+		Hostname: host,
+		PortMap: map[string]int32{
+			"grpc": int32(port),
+		},
+	}, service, nil)
+}
+
 // This test makes sure the go rpc client auth works
 func TestGRPCTabletAuthConn(t *testing.T) {
 	// fake service
@@ -230,6 +230,26 @@ func (client *Client) ExecuteHook(ctx context.Context, tablet *topodatapb.Tablet
 	}, nil
 }
 
+// GetLifecycleHooks is part of the tmclient.TabletManagerClient interface.
+func (client *Client) GetLifecycleHooks(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.GetLifecycleHooksResponse, error) {
+	c, closer, err := client.dialer.dial(ctx, tablet)
+	if err != nil {
+		return nil, err
+	}
+	defer closer.Close()
+	return c.GetLifecycleHooks(ctx, &tabletmanagerdatapb.GetLifecycleHooksRequest{})
+}
+
+// GetVersion is part of the tmclient.TabletManagerClient interface.
+func (client *Client) GetVersion(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.GetVersionResponse, error) {
+	c, closer, err := client.dialer.dial(ctx, tablet)
+	if err != nil {
+		return nil, err
+	}
+	defer closer.Close()
+	return c.GetVersion(ctx, &tabletmanagerdatapb.GetVersionRequest{})
+}
+
 // GetSchema is part of the tmclient.TabletManagerClient interface.
 func (client *Client) GetSchema(ctx context.Context, tablet *topodatapb.Tablet, tables, excludeTables []string, includeViews bool) (*tabletmanagerdatapb.SchemaDefinition, error) {
 	c, closer, err := client.dialer.dial(ctx, tablet)
@@ -301,6 +321,23 @@ func (client *Client) ChangeType(ctx context.Context, tablet *topodatapb.Tablet,
 	return err
 }
 
+// ChangeTags is part of the tmclient.TabletManagerClient interface.
+func (client *Client) ChangeTags(ctx context.Context, tablet *topodatapb.Tablet, tags map[string]string, replace bool) (map[string]string, error) {
+	c, closer, err := client.dialer.dial(ctx, tablet)
+	if err != nil {
+		return nil, err
+	}
+	defer closer.Close()
+	response, err := c.ChangeTags(ctx, &tabletmanagerdatapb.ChangeTagsRequest{
+		Tags:    tags,
+		Replace: replace,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response.Tags, nil
+}
+
 // RefreshState is part of the tmclient.TabletManagerClient interface.
 func (client *Client) RefreshState(ctx context.Context, tablet *topodatapb.Tablet) error {
 	c, closer, err := client.dialer.dial(ctx, tablet)
@@ -520,6 +557,23 @@ func (client *Client) ExecuteFetchAsApp(ctx context.Context, tablet *topodatapb.
 	return response.Result, nil
 }
 
+// KillQueries is part of the tmclient.TabletManagerClient interface.
+func (client *Client) KillQueries(ctx context.Context, tablet *topodatapb.Tablet, fingerprint, user string) (int64, error) {
+	c, closer, err := client.dialer.dial(ctx, tablet)
+	if err != nil {
+		return 0, err
+	}
+	defer closer.Close()
+	response, err := c.KillQueries(ctx, &tabletmanagerdatapb.KillQueriesRequest{
+		Fingerprint: fingerprint,
+		User:        user,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return response.Killed, nil
+}
+
 //
 // Replication related methods
 //
@@ -696,6 +750,20 @@ func (client *Client) VReplicationWaitForPos(ctx context.Context, tablet *topoda
 	return nil
 }
 
+// CheckThrottler is part of the tmclient.TabletManagerClient interface.
+func (client *Client) CheckThrottler(ctx context.Context, tablet *topodatapb.Tablet, checks []*tabletmanagerdatapb.CheckThrottlerRequest_Check) ([]*tabletmanagerdatapb.CheckThrottlerResponse_Result, error) {
+	c, closer, err := client.dialer.dial(ctx, tablet)
+	if err != nil {
+		return nil, err
+	}
+	defer closer.Close()
+	response, err := c.CheckThrottler(ctx, &tabletmanagerdatapb.CheckThrottlerRequest{Checks: checks})
+	if err != nil {
+		return nil, err
+	}
+	return response.Results, nil
+}
+
 //
 // Reparenting related functions
 //
@@ -837,6 +905,19 @@ func (client *Client) SetReplicationSource(ctx context.Context, tablet *topodata
 	return err
 }
 
+// SetReplicationDelay is part of the tmclient.TabletManagerClient interface.
+func (client *Client) SetReplicationDelay(ctx context.Context, tablet *topodatapb.Tablet, delay time.Duration) error {
+	c, closer, err := client.dialer.dial(ctx, tablet)
+	if err != nil {
+		return err
+	}
+	defer closer.Close()
+	_, err = c.SetReplicationDelay(ctx, &tabletmanagerdatapb.SetReplicationDelayRequest{
+		DelaySeconds: int32(delay / time.Second),
+	})
+	return err
+}
+
 // ReplicaWasRestarted is part of the tmclient.TabletManagerClient interface.
 func (client *Client) ReplicaWasRestarted(ctx context.Context, tablet *topodatapb.Tablet, parent *topodatapb.TabletAlias) error {
 	c, closer, err := client.dialer.dial(ctx, tablet)
@@ -902,15 +983,16 @@ func (e *backupStreamAdapter) Recv() (*logutilpb.Event, error) {
 }
 
 // Backup is part of the tmclient.TabletManagerClient interface.
-func (client *Client) Backup(ctx context.Context, tablet *topodatapb.Tablet, concurrency int, allowPrimary bool) (logutil.EventStream, error) {
+func (client *Client) Backup(ctx context.Context, tablet *topodatapb.Tablet, concurrency int, allowPrimary bool, compressionEngine string) (logutil.EventStream, error) {
 	c, closer, err := client.dialer.dial(ctx, tablet)
 	if err != nil {
 		return nil, err
 	}
 
 	stream, err := c.Backup(ctx, &tabletmanagerdatapb.BackupRequest{
-		Concurrency:  int64(concurrency),
-		AllowPrimary: allowPrimary,
+		Concurrency:       int64(concurrency),
+		AllowPrimary:      allowPrimary,
+		CompressionEngine: compressionEngine,
 	})
 	if err != nil {
 		closer.Close()
@@ -954,6 +1036,48 @@ func (client *Client) RestoreFromBackup(ctx context.Context, tablet *topodatapb.
 	}, nil
 }
 
+// GetRestoreProgress is part of the tmclient.TabletManagerClient interface.
+func (client *Client) GetRestoreProgress(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.RestoreProgress, error) {
+	c, closer, err := client.dialer.dial(ctx, tablet)
+	if err != nil {
+		return nil, err
+	}
+	defer closer.Close()
+	response, err := c.GetRestoreProgress(ctx, &tabletmanagerdatapb.GetRestoreProgressRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return response.Progress, nil
+}
+
+// StreamArchivedBinlogs is part of the tmclient.TabletManagerClient interface.
+func (client *Client) StreamArchivedBinlogs(ctx context.Context, tablet *topodatapb.Tablet, position string, callback func(*tabletmanagerdatapb.StreamArchivedBinlogsResponse) error) error {
+	c, closer, err := client.dialer.dial(ctx, tablet)
+	if err != nil {
+		return err
+	}
+	defer closer.Close()
+
+	stream, err := c.StreamArchivedBinlogs(ctx, &tabletmanagerdatapb.StreamArchivedBinlogsRequest{
+		Position: position,
+	})
+	if err != nil {
+		return err
+	}
+	for {
+		response, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := callback(response); err != nil {
+			return err
+		}
+	}
+}
+
 // Close is part of the tmclient.TabletManagerClient interface.
 func (client *Client) Close() {
 	client.dialer.Close()
@@ -75,6 +75,19 @@ func (s *server) ExecuteHook(ctx context.Context, request *tabletmanagerdatapb.E
 	return response, nil
 }
 
+func (s *server) GetLifecycleHooks(ctx context.Context, request *tabletmanagerdatapb.GetLifecycleHooksRequest) (response *tabletmanagerdatapb.GetLifecycleHooksResponse, err error) {
+	defer s.tm.HandleRPCPanic(ctx, "GetLifecycleHooks", request, response, false /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = s.tm.GetLifecycleHooks(ctx)
+	return response, nil
+}
+
+func (s *server) GetVersion(ctx context.Context, request *tabletmanagerdatapb.GetVersionRequest) (response *tabletmanagerdatapb.GetVersionResponse, err error) {
+	defer s.tm.HandleRPCPanic(ctx, "GetVersion", request, response, false /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	return s.tm.GetVersion(ctx)
+}
+
 func (s *server) GetSchema(ctx context.Context, request *tabletmanagerdatapb.GetSchemaRequest) (response *tabletmanagerdatapb.GetSchemaResponse, err error) {
 	defer s.tm.HandleRPCPanic(ctx, "GetSchema", request, response, false /*verbose*/, &err)
 	ctx = callinfo.GRPCCallInfo(ctx)
@@ -122,6 +135,14 @@ func (s *server) ChangeType(ctx context.Context, request *tabletmanagerdatapb.Ch
 	return response, s.tm.ChangeType(ctx, request.TabletType)
 }
 
+func (s *server) ChangeTags(ctx context.Context, request *tabletmanagerdatapb.ChangeTagsRequest) (response *tabletmanagerdatapb.ChangeTagsResponse, err error) {
+	defer s.tm.HandleRPCPanic(ctx, "ChangeTags", request, response, true /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.ChangeTagsResponse{}
+	response.Tags, err = s.tm.ChangeTags(ctx, request.Tags, request.Replace)
+	return response, err
+}
+
 func (s *server) RefreshState(ctx context.Context, request *tabletmanagerdatapb.RefreshStateRequest) (response *tabletmanagerdatapb.RefreshStateResponse, err error) {
 	defer s.tm.HandleRPCPanic(ctx, "RefreshState", request, response, true /*verbose*/, &err)
 	ctx = callinfo.GRPCCallInfo(ctx)
@@ -244,6 +265,15 @@ func (s *server) ExecuteFetchAsApp(ctx context.Context, request *tabletmanagerda
 	return response, nil
 }
 
+func (s *server) KillQueries(ctx context.Context, request *tabletmanagerdatapb.KillQueriesRequest) (response *tabletmanagerdatapb.KillQueriesResponse, err error) {
+	defer s.tm.HandleRPCPanic(ctx, "KillQueries", request, response, true /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.KillQueriesResponse{
+		Killed: int64(s.tm.KillQueries(ctx, request.Fingerprint, request.User)),
+	}
+	return response, nil
+}
+
 //
 // Replication related methods
 //
@@ -376,6 +406,14 @@ func (s *server) VReplicationWaitForPos(ctx context.Context, request *tabletmana
 	return &tabletmanagerdatapb.VReplicationWaitForPosResponse{}, err
 }
 
+func (s *server) CheckThrottler(ctx context.Context, request *tabletmanagerdatapb.CheckThrottlerRequest) (response *tabletmanagerdatapb.CheckThrottlerResponse, err error) {
+	defer s.tm.HandleRPCPanic(ctx, "CheckThrottler", request, response, false /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.CheckThrottlerResponse{}
+	response.Results = s.tm.CheckThrottler(ctx, request.Checks)
+	return response, nil
+}
+
 //
 // Reparenting related functions
 //
@@ -484,6 +522,13 @@ func (s *server) SetReplicationSource(ctx context.Context, request *tabletmanage
 	return response, s.tm.SetReplicationSource(ctx, request.Parent, request.TimeCreatedNs, request.WaitPosition, request.ForceStartReplication)
 }
 
+func (s *server) SetReplicationDelay(ctx context.Context, request *tabletmanagerdatapb.SetReplicationDelayRequest) (response *tabletmanagerdatapb.SetReplicationDelayResponse, err error) {
+	defer s.tm.HandleRPCPanic(ctx, "SetReplicationDelay", request, response, true /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.SetReplicationDelayResponse{}
+	return response, s.tm.SetReplicationDelay(ctx, time.Duration(request.DelaySeconds)*time.Second)
+}
+
 func (s *server) ReplicaWasRestarted(ctx context.Context, request *tabletmanagerdatapb.ReplicaWasRestartedRequest) (response *tabletmanagerdatapb.ReplicaWasRestartedResponse, err error) {
 	defer s.tm.HandleRPCPanic(ctx, "ReplicaWasRestarted", request, response, true /*verbose*/, &err)
 	ctx = callinfo.GRPCCallInfo(ctx)
@@ -530,7 +575,7 @@ func (s *server) Backup(request *tabletmanagerdatapb.BackupRequest, stream table
 		})
 	})
 
-	return s.tm.Backup(ctx, int(request.Concurrency), logger, request.AllowPrimary)
+	return s.tm.Backup(ctx, int(request.Concurrency), logger, request.AllowPrimary, request.CompressionEngine)
 }
 
 func (s *server) RestoreFromBackup(request *tabletmanagerdatapb.RestoreFromBackupRequest, stream tabletmanagerservicepb.TabletManager_RestoreFromBackupServer) (err error) {
@@ -551,6 +596,22 @@ func (s *server) RestoreFromBackup(request *tabletmanagerdatapb.RestoreFromBacku
 	return s.tm.RestoreFromBackup(ctx, logger)
 }
 
+func (s *server) GetRestoreProgress(ctx context.Context, request *tabletmanagerdatapb.GetRestoreProgressRequest) (response *tabletmanagerdatapb.GetRestoreProgressResponse, err error) {
+	defer s.tm.HandleRPCPanic(ctx, "GetRestoreProgress", request, response, false /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.GetRestoreProgressResponse{
+		Progress: s.tm.GetRestoreProgress(ctx),
+	}
+	return response, nil
+}
+
+func (s *server) StreamArchivedBinlogs(request *tabletmanagerdatapb.StreamArchivedBinlogsRequest, stream tabletmanagerservicepb.TabletManager_StreamArchivedBinlogsServer) (err error) {
+	ctx := stream.Context()
+	defer s.tm.HandleRPCPanic(ctx, "StreamArchivedBinlogs", request, nil, false /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	return s.tm.StreamArchivedBinlogs(ctx, request.Position, stream.Send)
+}
+
 // registration glue
 
 func init() {
@@ -16,6 +16,8 @@ limitations under the License.
 
 package onlineddl
 
+import "vitess.io/vitess/go/vt/sidecardb"
+
 const (
 	// SchemaMigrationsTableName is used by VExec interceptor to call the correct handler
 	sqlCreateSidecarDB             = "create database if not exists _vt"
@@ -524,3 +526,7 @@ var ApplyDDL = []string{
 	alterSchemaMigrationsTableRemovedUniqueKeys,
 	alterSchemaMigrationsTableLogFile,
 }
+
+func init() {
+	sidecardb.Register("onlineddl", ApplyDDL)
+}
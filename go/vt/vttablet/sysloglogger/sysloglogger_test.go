@@ -86,14 +86,14 @@ func (fw *failingFakeWriter) Close() error          { return nil }
 // expectedLogStatsText returns the results expected from the plugin processing a dummy message generated by mockLogStats(...).
 func expectedLogStatsText(originalSQL string) string {
 	return fmt.Sprintf("Execute\t\t\t''\t''\t0001-01-01 00:00:00.000000\t0001-01-01 00:00:00.000000\t0.000000\tPASS_SELECT\t"+
-		"\"%s\"\t%s\t1\t\"%s\"\tmysql\t0.000000\t0.000000\t0\t0\t0\t\"\"", originalSQL, "map[]", originalSQL)
+		"\"%s\"\t%s\t1\t\"%s\"\tmysql\t0.000000\t0.000000\t0\t0\t0\t\"\"\t\"\"", originalSQL, "map[]", originalSQL)
 }
 
 // expectedRedactedLogStatsText returns the results expected from the plugin processing a dummy message generated by mockLogStats(...)
 // when redaction is enabled.
 func expectedRedactedLogStatsText(originalSQL string) string {
 	return fmt.Sprintf("Execute\t\t\t''\t''\t0001-01-01 00:00:00.000000\t0001-01-01 00:00:00.000000\t0.000000\tPASS_SELECT\t"+
-		"\"%s\"\t%q\t1\t\"%s\"\tmysql\t0.000000\t0.000000\t0\t0\t0\t\"\"", originalSQL, "[REDACTED]", "[REDACTED]")
+		"\"%s\"\t%q\t1\t\"%s\"\tmysql\t0.000000\t0.000000\t0\t0\t0\t\"\"\t\"\"", originalSQL, "[REDACTED]", "[REDACTED]")
 }
 
 // TestSyslog sends a stream of five query records to the plugin, and verifies that they are logged.
@@ -157,11 +157,9 @@ func TestSyslogRedacted(t *testing.T) {
 	}()
 
 	// Send fake messages to the mock channel, and then close the channel to end the plugin loop
-	ch <- mockLogStats("select 1")
-	ch <- mockLogStats("select 2")
-	ch <- mockLogStats("select 3")
-	ch <- mockLogStats("select 4")
-	ch <- mockLogStats("select 5")
+	for i := 1; i <= 5; i++ {
+		ch <- mockLogStats(fmt.Sprintf("select * from t%d where id = %d", i, i))
+	}
 	close(ch)
 	<-syncChannel
 
@@ -172,13 +170,14 @@ func TestSyslogRedacted(t *testing.T) {
 		queriesLogged[received] = true
 	}
 
-	// Verify the count and contents
+	// Verify the count and contents: the literals of the queries are redacted
 	if len(queriesLogged) != 5 {
 		t.Fatalf("Expected 5 queries to be logged, but found %d", len(queriesLogged))
 	}
 	for i := 1; i <= 5; i++ {
-		if _, ok := queriesLogged[expectedRedactedLogStatsText("select "+strconv.Itoa(i))]; !ok {
-			t.Fatalf("Expected query \"%s\" was not logged", expectedRedactedLogStatsText("select "+strconv.Itoa(i)))
+		redacted := fmt.Sprintf("select * from t%d where id = :redacted1", i)
+		if _, ok := queriesLogged[expectedRedactedLogStatsText(redacted)]; !ok {
+			t.Fatalf("Expected query \"%s\" was not logged", expectedRedactedLogStatsText(redacted))
 		}
 	}
 }
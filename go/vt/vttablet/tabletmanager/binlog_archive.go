@@ -0,0 +1,177 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletmanager
+
+import (
+	"context"
+	"flag"
+	"time"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/proto/vttime"
+)
+
+var (
+	binlogArchiveInterval = flag.Duration("binlog_archive_interval", 0, "if set, the tablet archives the binlogs of its mysqld to the backup storage at this interval, for point in time recoveries and catch-ups from positions purged from the mysqlds of the shard")
+
+	// binlogArchiveBatchSize is the size of the events sent in one
+	// StreamArchivedBinlogs response, unless one event is larger.
+	binlogArchiveBatchSize = 1024 * 1024
+)
+
+// startBinlogArchiver starts the loop archiving the binlogs of the mysqld,
+// if -binlog_archive_interval is set.
+func (tm *TabletManager) startBinlogArchiver() {
+	if *binlogArchiveInterval <= 0 {
+		return
+	}
+	tm.mutex.Lock()
+	defer tm.mutex.Unlock()
+	tm._binlogArchiverDone = make(chan struct{})
+	ctx, cancel := context.WithCancel(context.Background())
+	tm._binlogArchiverCancel = cancel
+	go tm.binlogArchiverLoop(ctx, tm._binlogArchiverDone)
+}
+
+func (tm *TabletManager) stopBinlogArchiver() {
+	tm.mutex.Lock()
+	if tm._binlogArchiverCancel != nil {
+		tm._binlogArchiverCancel()
+	}
+	doneChan := tm._binlogArchiverDone
+	tm.mutex.Unlock()
+
+	// If the binlog archiver was running, wait for it to fully stop.
+	if doneChan != nil {
+		<-doneChan
+	}
+}
+
+func (tm *TabletManager) binlogArchiverLoop(ctx context.Context, doneChan chan<- struct{}) {
+	defer close(doneChan)
+
+	ticker := time.NewTicker(*binlogArchiveInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if err := tm.archiveBinlogs(ctx); err != nil {
+			log.Warningf("Failed to archive binlogs: %v", err)
+		}
+	}
+}
+
+// archiveBinlogs archives the binlogs of the mysqld up to its current
+// position.
+func (tm *TabletManager) archiveBinlogs(ctx context.Context) error {
+	tablet := tm.Tablet()
+	if tablet.Type == topodatapb.TabletType_RESTORE {
+		// The data of the mysqld is being replaced.
+		return nil
+	}
+	pos, err := tm.MysqlDaemon.PrimaryPosition()
+	if err != nil {
+		return err
+	}
+	bs, err := backupstorage.GetBackupStorage()
+	if err != nil {
+		return err
+	}
+	defer bs.Close()
+	_, err = mysqlctl.ArchiveBinlogs(ctx, bs, mysqlctl.ArchiveBinlogsParams{
+		Logger:      logutil.NewConsoleLogger(),
+		Connector:   tm.DBConfigs.DbaWithDB(),
+		Keyspace:    tablet.Keyspace,
+		Shard:       tablet.Shard,
+		TabletAlias: topoproto.TabletAliasString(tablet.Alias),
+		ToPosition:  pos,
+	})
+	return err
+}
+
+// StreamArchivedBinlogs streams the events of the binlog archive of the
+// shard of the tablet, from the segment that contains the transaction after
+// a position.
+func (tm *TabletManager) StreamArchivedBinlogs(ctx context.Context, position string, callback func(*tabletmanagerdatapb.StreamArchivedBinlogsResponse) error) error {
+	pos, err := mysql.DecodePosition(position)
+	if err != nil {
+		return vterrors.Wrapf(err, "invalid position %v", position)
+	}
+	if pos.IsZero() {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "a position is required to stream archived binlogs")
+	}
+	bs, err := backupstorage.GetBackupStorage()
+	if err != nil {
+		return err
+	}
+	defer bs.Close()
+
+	tablet := tm.Tablet()
+	segments, err := mysqlctl.FindArchivedBinlogs(ctx, logutil.NewConsoleLogger(), bs, tablet.Keyspace, tablet.Shard, pos)
+	if err != nil {
+		return err
+	}
+	for _, segment := range segments {
+		response := &tabletmanagerdatapb.StreamArchivedBinlogsResponse{Segment: segment.Handle.Name()}
+		size := 0
+		err := mysqlctl.ReadArchivedBinlogEvents(ctx, segment, func(event []byte) error {
+			if size > 0 && size+len(event) > binlogArchiveBatchSize {
+				if err := callback(response); err != nil {
+					return err
+				}
+				response = &tabletmanagerdatapb.StreamArchivedBinlogsResponse{Segment: segment.Handle.Name()}
+				size = 0
+			}
+			response.Events = append(response.Events, event)
+			size += len(event)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		response.Position = mysql.EncodePosition(segment.Manifest.Position)
+		if err := callback(response); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// restoreToTimeFromBinlogArchive applies the transactions of the binlog
+// archive of the shard after a position, up to a time. It returns false if
+// the archive does not cover the position.
+func (tm *TabletManager) restoreToTimeFromBinlogArchive(ctx context.Context, logger logutil.Logger, keyspace, shard string, pos mysql.Position, restoreTime *vttime.Time) (bool, error) {
+	bs, err := backupstorage.GetBackupStorage()
+	if err != nil {
+		return false, err
+	}
+	defer bs.Close()
+	return mysqlctl.RestoreFromBinlogArchive(ctx, logger, bs, tm.MysqlDaemon, keyspace, shard, pos, logutil.ProtoToTime(restoreTime))
+}
@@ -0,0 +1,217 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletmanager
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"vitess.io/vitess/go/protoutil"
+	"vitess.io/vitess/go/vt/auditlog"
+	"vitess.io/vitess/go/vt/hook"
+	"vitess.io/vitess/go/vt/log"
+
+	auditlogdatapb "vitess.io/vitess/go/vt/proto/auditlogdata"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+var lifecycleHooksConfig = flag.String("lifecycle_hooks_config", "", "JSON file declaring the lifecycle hooks of the tablet, as a list of objects with an Event (pre-backup, post-restore, pre-reparent or post-promotion), the Name of a hook of $VTROOT/vthook, its Parameters, a Timeout (1m by default), and Required for the backup or the reparent to fail when a pre- hook fails. When it is set, ExecuteHook only runs the declared hooks.")
+
+// The events of the lifecycle of a tablet on which hooks run.
+const (
+	lifecyclePreBackup     = "pre-backup"
+	lifecyclePostRestore   = "post-restore"
+	lifecyclePreReparent   = "pre-reparent"
+	lifecyclePostPromotion = "post-promotion"
+)
+
+const (
+	defaultLifecycleHookTimeout = time.Minute
+
+	// maxLifecycleHookRuns is the number of recent executions of the hooks
+	// that are kept for GetLifecycleHooks.
+	maxLifecycleHookRuns = 100
+
+	// maxLifecycleHookOutput is the size up to which the stdout and the
+	// stderr of the executions are kept.
+	maxLifecycleHookOutput = 16 * 1024
+)
+
+// lifecycleHookConfig is a hook of the -lifecycle_hooks_config file.
+type lifecycleHookConfig struct {
+	Event      string
+	Name       string
+	Parameters []string
+	Timeout    string
+	Required   bool
+}
+
+// lifecycleHooks is the registry of the hooks of -lifecycle_hooks_config.
+// A nil registry has no hooks, and lets ExecuteHook run any hook.
+type lifecycleHooks struct {
+	hooks []*tabletmanagerdatapb.LifecycleHook
+
+	mu sync.Mutex
+	// runs are the recent executions of the hooks, the most recent first.
+	runs []*tabletmanagerdatapb.LifecycleHookRun
+}
+
+// loadLifecycleHooks returns the registry declared in the file, or nil if
+// there is no file.
+func loadLifecycleHooks(file string) (*lifecycleHooks, error) {
+	if file == "" {
+		return nil, nil
+	}
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read the lifecycle hooks config: %v", err)
+	}
+	var configs []lifecycleHookConfig
+	if err := json.Unmarshal(data, &configs); err != nil {
+		return nil, fmt.Errorf("cannot parse the lifecycle hooks config %v: %v", file, err)
+	}
+	lh := &lifecycleHooks{}
+	for i, config := range configs {
+		switch config.Event {
+		case lifecyclePreBackup, lifecyclePostRestore, lifecyclePreReparent, lifecyclePostPromotion:
+		default:
+			return nil, fmt.Errorf("lifecycle hook %d of %v has an invalid event %q", i, file, config.Event)
+		}
+		if config.Name == "" || strings.Contains(config.Name, "/") {
+			return nil, fmt.Errorf("lifecycle hook %d of %v has an invalid name %q", i, file, config.Name)
+		}
+		timeout := defaultLifecycleHookTimeout
+		if config.Timeout != "" {
+			timeout, err = time.ParseDuration(config.Timeout)
+			if err != nil || timeout <= 0 {
+				return nil, fmt.Errorf("lifecycle hook %d of %v has an invalid timeout %q", i, file, config.Timeout)
+			}
+		}
+		lh.hooks = append(lh.hooks, &tabletmanagerdatapb.LifecycleHook{
+			Event:      config.Event,
+			Name:       config.Name,
+			Parameters: config.Parameters,
+			Timeout:    protoutil.DurationToProto(timeout),
+			Required:   config.Required,
+		})
+	}
+	return lh, nil
+}
+
+// declares returns true if a hook of the registry has the name.
+func (lh *lifecycleHooks) declares(name string) bool {
+	for _, hk := range lh.hooks {
+		if hk.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// run executes the hooks of the event in order, with the environment of the
+// tablet and LIFECYCLE_EVENT set to the event. It returns an error if a
+// required hook of a pre- event fails, and does not run the next hooks then.
+func (lh *lifecycleHooks) run(ctx context.Context, tm *TabletManager, event string) error {
+	if lh == nil {
+		return nil
+	}
+	tablet := tm.Tablet()
+	for _, lhk := range lh.hooks {
+		if lhk.Event != event {
+			continue
+		}
+		env := tm.hookExtraEnv()
+		env["LIFECYCLE_EVENT"] = event
+		hk := hook.NewHookWithEnv(lhk.Name, lhk.Parameters, env)
+
+		timeout, _, _ := protoutil.DurationFromProto(lhk.Timeout)
+		hookCtx, cancel := context.WithTimeout(ctx, timeout)
+		start := time.Now()
+		hr := hk.ExecuteContext(hookCtx)
+		cancel()
+		lh.record(&tabletmanagerdatapb.LifecycleHookRun{
+			Hook:       lhk,
+			StartTime:  protoutil.TimeToProto(start),
+			Duration:   protoutil.DurationToProto(time.Since(start)),
+			ExitStatus: int64(hr.ExitStatus),
+			Stdout:     truncateHookOutput(hr.Stdout),
+			Stderr:     truncateHookOutput(hr.Stderr),
+		})
+
+		var err error
+		if hr.ExitStatus != hook.HOOK_SUCCESS {
+			err = fmt.Errorf("%v hook %v failed(%v): %v", event, lhk.Name, hr.ExitStatus, hr.Stderr)
+		}
+		auditlog.Record(ctx, &auditlogdatapb.Event{
+			Actor:     auditlog.Actor(ctx),
+			Action:    "lifecycle_hook",
+			Statement: strings.TrimSpace(fmt.Sprintf("%v: %v %v", event, lhk.Name, strings.Join(lhk.Parameters, " "))),
+			Keyspace:  tablet.Keyspace,
+			Shard:     tablet.Shard,
+			Error:     auditlog.ErrorString(err),
+		})
+		if err == nil {
+			continue
+		}
+		if lhk.Required && strings.HasPrefix(event, "pre-") {
+			return err
+		}
+		log.Warningf("Ignoring the failure of a lifecycle hook: %v", err)
+	}
+	return nil
+}
+
+func (lh *lifecycleHooks) record(run *tabletmanagerdatapb.LifecycleHookRun) {
+	lh.mu.Lock()
+	defer lh.mu.Unlock()
+	lh.runs = append([]*tabletmanagerdatapb.LifecycleHookRun{run}, lh.runs...)
+	if len(lh.runs) > maxLifecycleHookRuns {
+		lh.runs = lh.runs[:maxLifecycleHookRuns]
+	}
+}
+
+// list returns the hooks of the registry and their recent executions.
+func (lh *lifecycleHooks) list() *tabletmanagerdatapb.GetLifecycleHooksResponse {
+	response := &tabletmanagerdatapb.GetLifecycleHooksResponse{}
+	if lh == nil {
+		return response
+	}
+	for _, hk := range lh.hooks {
+		response.Hooks = append(response.Hooks, proto.Clone(hk).(*tabletmanagerdatapb.LifecycleHook))
+	}
+	lh.mu.Lock()
+	defer lh.mu.Unlock()
+	for _, run := range lh.runs {
+		response.Runs = append(response.Runs, proto.Clone(run).(*tabletmanagerdatapb.LifecycleHookRun))
+	}
+	return response
+}
+
+func truncateHookOutput(output string) string {
+	if len(output) <= maxLifecycleHookOutput {
+		return output
+	}
+	return output[:maxLifecycleHookOutput] + "\n[truncated]\n"
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletmanager
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/protoutil"
+	"vitess.io/vitess/go/vt/hook"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+)
+
+func writeLifecycleHooksConfig(t *testing.T, config string) string {
+	file := path.Join(t.TempDir(), "lifecycle_hooks.json")
+	require.NoError(t, ioutil.WriteFile(file, []byte(config), 0644))
+	return file
+}
+
+func TestLoadLifecycleHooks(t *testing.T) {
+	lh, err := loadLifecycleHooks("")
+	require.NoError(t, err)
+	assert.Nil(t, lh)
+	assert.Empty(t, lh.list().Hooks)
+
+	lh, err = loadLifecycleHooks(writeLifecycleHooksConfig(t, `[
+  {"Event": "pre-backup", "Name": "flush", "Parameters": ["-fast"], "Timeout": "10s", "Required": true},
+  {"Event": "post-promotion", "Name": "notify"}
+]`))
+	require.NoError(t, err)
+	hooks := lh.list().Hooks
+	require.Len(t, hooks, 2)
+	assert.Equal(t, "flush", hooks[0].Name)
+	assert.Equal(t, []string{"-fast"}, hooks[0].Parameters)
+	assert.True(t, hooks[0].Required)
+	timeout, _, _ := protoutil.DurationFromProto(hooks[0].Timeout)
+	assert.Equal(t, 10*time.Second, timeout)
+	timeout, _, _ = protoutil.DurationFromProto(hooks[1].Timeout)
+	assert.Equal(t, defaultLifecycleHookTimeout, timeout)
+	assert.True(t, lh.declares("notify"))
+	assert.False(t, lh.declares("other"))
+
+	for _, config := range []string{
+		`[{"Event": "pre-shutdown", "Name": "flush"}]`,
+		`[{"Event": "pre-backup", "Name": "../flush"}]`,
+		`[{"Event": "pre-backup", "Name": "flush", "Timeout": "-1s"}]`,
+		`{"Event": "pre-backup"}`,
+	} {
+		_, err := loadLifecycleHooks(writeLifecycleHooksConfig(t, config))
+		assert.Error(t, err, config)
+	}
+}
+
+func TestLifecycleHooksRun(t *testing.T) {
+	vtroot := t.TempDir()
+	t.Setenv("VTROOT", vtroot)
+	require.NoError(t, os.Mkdir(path.Join(vtroot, "vthook"), 0755))
+	writeHook := func(name, script string) {
+		require.NoError(t, ioutil.WriteFile(path.Join(vtroot, "vthook", name), []byte("#!/bin/sh\n"+script), 0755))
+	}
+	writeHook("ok", `echo "$LIFECYCLE_EVENT $KEYSPACE/$SHARD $1"`)
+	writeHook("fail", `echo failed >&2; exit 3`)
+	writeHook("slow", `exec sleep 5`)
+
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1")
+	tm := newTestTM(t, ts, 1, "ks", "0")
+	defer tm.Stop()
+
+	lh, err := loadLifecycleHooks(writeLifecycleHooksConfig(t, `[
+  {"Event": "pre-backup", "Name": "ok", "Parameters": ["first"]},
+  {"Event": "pre-backup", "Name": "fail", "Required": true},
+  {"Event": "pre-backup", "Name": "ok", "Parameters": ["never"]},
+  {"Event": "post-restore", "Name": "fail", "Required": true},
+  {"Event": "pre-reparent", "Name": "slow", "Timeout": "10ms"}
+]`))
+	require.NoError(t, err)
+
+	// A required pre- hook that fails aborts the operation.
+	err = lh.run(ctx, tm, lifecyclePreBackup)
+	assert.EqualError(t, err, "pre-backup hook fail failed(3): failed\nERROR: exit status 3\n")
+	runs := lh.list().Runs
+	require.Len(t, runs, 2)
+	assert.Equal(t, "fail", runs[0].Hook.Name)
+	assert.EqualValues(t, 3, runs[0].ExitStatus)
+	assert.Equal(t, "ok", runs[1].Hook.Name)
+	assert.Equal(t, "pre-backup ks/0 first\n", runs[1].Stdout)
+
+	// The failures of the post- hooks are only reported.
+	assert.NoError(t, lh.run(ctx, tm, lifecyclePostRestore))
+	assert.NoError(t, lh.run(ctx, tm, lifecyclePreReparent))
+	runs = lh.list().Runs
+	require.Len(t, runs, 4)
+	assert.EqualValues(t, hook.HOOK_TIMEOUT_ERROR, runs[0].ExitStatus)
+
+	// Only the declared hooks can be executed.
+	tm.lifecycleHooks = lh
+	hr := tm.ExecuteHook(ctx, hook.NewSimpleHook("other"))
+	assert.Equal(t, hook.HOOK_INVALID_NAME, hr.ExitStatus)
+	hr = tm.ExecuteHook(ctx, hook.NewHook("ok", []string{"rpc"}))
+	assert.Equal(t, hook.HOOK_SUCCESS, hr.ExitStatus)
+	assert.Len(t, tm.GetLifecycleHooks(ctx).Runs, 4)
+}
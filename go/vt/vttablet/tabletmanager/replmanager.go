@@ -30,6 +30,8 @@ import (
 	"vitess.io/vitess/go/vt/mysqlctl"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
 )
 
 const (
@@ -43,17 +45,23 @@ const (
 // fixed. On state change, SetTabletType must be called before changing
 // the tabletserver state. This will ensure that replication is fixed
 // upfront, allowing tabletserver to start off healthy.
+//
+// The poller also compares the GTIDs of the replica with those of the
+// primary, and publishes the errant ones in the tablet health.
 type replManager struct {
-	ctx        context.Context
-	tm         *TabletManager
-	markerFile string
-	ticks      *timer.Timer
-	failed     bool
+	ctx               context.Context
+	tm                *TabletManager
+	markerFile        string
+	ticks             *timer.Timer
+	failed            bool
+	errantCheckFailed bool
 
 	// replStopped is tri-state.
 	// A nil value signifies that the value is not set.
 	mu          sync.Mutex
 	replStopped *bool
+	// errantGTIDs are the errant GTIDs published in the tablet health.
+	errantGTIDs string
 }
 
 func newReplManager(ctx context.Context, tm *TabletManager, interval time.Duration) *replManager {
@@ -71,6 +79,7 @@ func (rm *replManager) SetTabletType(tabletType topodatapb.TabletType) {
 	}
 	if !topo.IsReplicaType(tabletType) {
 		rm.ticks.Stop()
+		rm.setErrantGTIDs("")
 		return
 	}
 	if rm.replicationStopped() {
@@ -107,6 +116,8 @@ func (rm *replManager) checkActionLocked() {
 			return
 		}
 	} else {
+		rm.checkErrantGTIDs(status.Position)
+
 		// If only one of the threads is stopped, it's probably
 		// intentional. So, we don't repair replication.
 		if status.SQLThreadRunning || status.IOThreadRunning {
@@ -130,6 +141,81 @@ func (rm *replManager) checkActionLocked() {
 	rm.failed = false
 }
 
+// checkErrantGTIDs compares the position of the replica with that of the
+// primary, and publishes the GTIDs that the primary does not have.
+func (rm *replManager) checkErrantGTIDs(position mysql.Position) {
+	gtids, err := rm.readErrantGTIDs(position)
+	if err != nil {
+		if !rm.errantCheckFailed {
+			rm.errantCheckFailed = true
+			log.Warningf("Failed to check the errant GTIDs: %v, will keep retrying.", err)
+		}
+		return
+	}
+	rm.errantCheckFailed = false
+	rm.setErrantGTIDs(gtids)
+}
+
+// readErrantGTIDs returns the GTIDs of a replica position that the primary
+// does not have. The position must be read before calling it, so that the
+// primary has all the transactions the replica received from it.
+func (rm *replManager) readErrantGTIDs(position mysql.Position) (string, error) {
+	if _, ok := position.GTIDSet.(mysql.Mysql56GTIDSet); !ok {
+		// Errant GTIDs are only detected on the MySQL flavor.
+		return "", nil
+	}
+
+	ctx, cancel := context.WithTimeout(rm.ctx, 5*time.Second)
+	defer cancel()
+
+	tablet := rm.tm.Tablet()
+	si, err := rm.tm.TopoServer.GetShard(ctx, tablet.Keyspace, tablet.Shard)
+	if err != nil {
+		return "", vterrors.Wrap(err, "cannot read shard")
+	}
+	if !si.HasPrimary() {
+		return "", nil
+	}
+	primary, err := rm.tm.TopoServer.GetTablet(ctx, si.PrimaryAlias)
+	if err != nil {
+		return "", vterrors.Wrapf(err, "cannot read primary tablet %v", si.PrimaryAlias)
+	}
+
+	tmc := tmclient.NewTabletManagerClient()
+	defer tmc.Close()
+	posStr, err := tmc.MasterPosition(ctx, primary.Tablet)
+	if err != nil {
+		return "", vterrors.Wrap(err, "cannot read primary position")
+	}
+	primaryPos, err := mysql.DecodePosition(posStr)
+	if err != nil {
+		return "", vterrors.Wrapf(err, "cannot decode primary position %q", posStr)
+	}
+	errant, err := mysql.ErrantGTIDs(position, primaryPos)
+	if err != nil || errant == nil {
+		return "", err
+	}
+	return errant.String(), nil
+}
+
+// setErrantGTIDs publishes the errant GTIDs of the replica in the tablet
+// health if they changed.
+func (rm *replManager) setErrantGTIDs(gtids string) {
+	rm.mu.Lock()
+	defer rm.mu.Unlock()
+
+	if rm.errantGTIDs == gtids {
+		return
+	}
+	if gtids != "" {
+		log.Warningf("Replica has errant GTIDs that the primary does not have: %v", gtids)
+	} else {
+		log.Info("Replica no longer has errant GTIDs.")
+	}
+	rm.errantGTIDs = gtids
+	rm.tm.QueryServiceControl.SetErrantGTIDs(gtids)
+}
+
 // setReplicationStopped performs a best effort attempt of
 // remembering a decision to stop replication.
 func (rm *replManager) setReplicationStopped(stopped bool) {
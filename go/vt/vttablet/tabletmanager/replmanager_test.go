@@ -26,6 +26,7 @@ import (
 
 	"vitess.io/vitess/go/vt/mysqlctl"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	"vitess.io/vitess/go/vt/vttablet/tabletservermock"
 )
 
 func TestReplManagerSetTabletType(t *testing.T) {
@@ -80,3 +81,20 @@ func TestReplManagerSetReplicationStopped(t *testing.T) {
 	tm.replManager.setReplicationStopped(true)
 	assert.False(t, tm.replManager.ticks.Running())
 }
+
+func TestReplManagerErrantGTIDs(t *testing.T) {
+	defer func(saved bool) { *mysqlctl.DisableActiveReparents = saved }(*mysqlctl.DisableActiveReparents)
+	*mysqlctl.DisableActiveReparents = false
+
+	qsc := tabletservermock.NewController()
+	tm := &TabletManager{QueryServiceControl: qsc}
+	tm.replManager = newReplManager(context.Background(), tm, 100*time.Millisecond)
+
+	// The errant GTIDs of a replica are published.
+	tm.replManager.setErrantGTIDs("00000000-0000-0000-0000-000000000002:1-3")
+	assert.Equal(t, "00000000-0000-0000-0000-000000000002:1-3", qsc.ErrantGTIDs())
+
+	// A primary has no errant GTIDs.
+	tm.replManager.SetTabletType(topodatapb.TabletType_PRIMARY)
+	assert.Equal(t, "", qsc.ErrantGTIDs())
+}
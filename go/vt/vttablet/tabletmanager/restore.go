@@ -47,6 +47,7 @@ import (
 var (
 	restoreFromBackup     = flag.Bool("restore_from_backup", false, "(init restore parameter) will check BackupStorage for a recent backup at startup and start there")
 	restoreConcurrency    = flag.Int("restore_concurrency", 4, "(init restore parameter) how many concurrent files to restore at once")
+	restoreMaxBandwidth   = flag.Int64("restore_max_bandwidth", 0, "(init restore parameter) if greater than 0, the maximum number of bytes per second to read from the backup storage while restoring, across all the concurrent files")
 	waitForBackupInterval = flag.Duration("wait_for_backup_interval", 0, "(init restore parameter) if this is greater than 0, instead of starting up empty when no backups are found, keep checking at this interval for a backup to appear")
 
 	// Flags for PITR
@@ -123,6 +124,9 @@ func (tm *TabletManager) RestoreData(ctx context.Context, logger logutil.Logger,
 	if err != nil {
 		return err
 	}
+	if err := tm.lifecycleHooks.run(ctx, tm, lifecyclePostRestore); err != nil {
+		return err
+	}
 
 	// Tell Orchestrator we're no longer stopped on purpose.
 	// Do this in the background, as it's best-effort.
@@ -167,6 +171,7 @@ func (tm *TabletManager) restoreDataLocked(ctx context.Context, logger logutil.L
 		Mysqld:              tm.MysqlDaemon,
 		Logger:              logger,
 		Concurrency:         *restoreConcurrency,
+		MaxBandwidth:        *restoreMaxBandwidth,
 		HookExtraEnv:        tm.hookExtraEnv(),
 		LocalMetadata:       localMetadata,
 		DeleteBeforeRestore: deleteBeforeRestore,
@@ -224,7 +229,7 @@ func (tm *TabletManager) restoreDataLocked(ctx context.Context, logger logutil.L
 	}
 	// If SnapshotTime is set , then apply the incremental change
 	if keyspaceInfo.SnapshotTime != nil {
-		err = tm.restoreToTimeFromBinlog(ctx, pos, keyspaceInfo.SnapshotTime)
+		err = tm.restoreToTimeFromBinlog(ctx, logger, keyspace, tablet.Shard, pos, keyspaceInfo.SnapshotTime)
 		if err != nil {
 			log.Errorf("unable to restore to the specified time %s, error : %v", keyspaceInfo.SnapshotTime.String(), err)
 			return nil
@@ -265,7 +270,17 @@ func (tm *TabletManager) restoreDataLocked(ctx context.Context, logger logutil.L
 
 // restoreToTimeFromBinlog restores to the snapshot time of the keyspace
 // currently this works with mysql based database only (as it uses mysql specific queries for restoring)
-func (tm *TabletManager) restoreToTimeFromBinlog(ctx context.Context, pos mysql.Position, restoreTime *vttime.Time) error {
+// Without a binlog server, the binlog archive of the shard is used, if any.
+func (tm *TabletManager) restoreToTimeFromBinlog(ctx context.Context, logger logutil.Logger, keyspace, shard string, pos mysql.Position, restoreTime *vttime.Time) error {
+	if *binlogHost == "" {
+		applied, err := tm.restoreToTimeFromBinlogArchive(ctx, logger, keyspace, shard, pos, restoreTime)
+		if err != nil {
+			return err
+		}
+		if applied {
+			return nil
+		}
+	}
 	// validate the minimal settings necessary for connecting to binlog server
 	if *binlogHost == "" || *binlogPort <= 0 || *binlogUser == "" {
 		log.Warning("invalid binlog server setting, restoring to last available backup.")
@@ -525,7 +540,7 @@ func (tm *TabletManager) startReplication(ctx context.Context, pos mysql.Positio
 	}
 
 	// If using semi-sync, we need to enable it before connecting to primary.
-	if err := tm.fixSemiSync(tabletType); err != nil {
+	if err := tm.fixSemiSync(tabletType, si.PrimaryAlias); err != nil {
 		return err
 	}
 
@@ -533,6 +548,9 @@ func (tm *TabletManager) startReplication(ctx context.Context, pos mysql.Positio
 	if err := tm.MysqlDaemon.SetReplicationSource(ctx, ti.Tablet.MysqlHostname, int(ti.Tablet.MysqlPort), false /* stopReplicationBefore */, !*mysqlctl.DisableActiveReparents /* startReplicationAfter */); err != nil {
 		return vterrors.Wrap(err, "MysqlDaemon.SetReplicationSource failed")
 	}
+	if err := tm.applyReplicationDelay(ctx); err != nil {
+		return vterrors.Wrap(err, "MysqlDaemon.SetReplicationDelay failed")
+	}
 
 	// If active reparents are disabled, we don't restart replication. So it makes no sense to wait for an update on the replica.
 	// Return immediately.
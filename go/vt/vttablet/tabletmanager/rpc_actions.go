@@ -95,6 +95,15 @@ func (tm *TabletManager) changeTypeLocked(ctx context.Context, tabletType topoda
 	return nil
 }
 
+// ChangeTags changes the tags of the tablet, and returns the new tags.
+func (tm *TabletManager) ChangeTags(ctx context.Context, tags map[string]string, replace bool) (map[string]string, error) {
+	if err := tm.lock(ctx); err != nil {
+		return nil, err
+	}
+	defer tm.unlock()
+	return tm.tmState.ChangeTabletTags(ctx, tags, replace), nil
+}
+
 // Sleep sleeps for the duration
 func (tm *TabletManager) Sleep(ctx context.Context, duration time.Duration) {
 	if err := tm.lock(ctx); err != nil {
@@ -114,11 +123,49 @@ func (tm *TabletManager) ExecuteHook(ctx context.Context, hk *hook.Hook) *hook.H
 	}
 	defer tm.unlock()
 
+	// Only the declared hooks run when there is a lifecycle hook registry.
+	if tm.lifecycleHooks != nil && !tm.lifecycleHooks.declares(hk.Name) {
+		return &hook.HookResult{
+			ExitStatus: hook.HOOK_INVALID_NAME,
+			Stderr:     fmt.Sprintf("hook %v is not declared in -lifecycle_hooks_config\n", hk.Name),
+		}
+	}
+
 	// Execute the hooks
 	topotools.ConfigureTabletHook(hk, tm.tabletAlias)
 	return hk.Execute()
 }
 
+// GetLifecycleHooks returns the lifecycle hooks of the tablet and their
+// recent executions.
+func (tm *TabletManager) GetLifecycleHooks(ctx context.Context) *tabletmanagerdatapb.GetLifecycleHooksResponse {
+	return tm.lifecycleHooks.list()
+}
+
+// GetVersion returns the version of the running mysqld, parsed the way
+// mysqlctl parses the version of the mysqld binary.
+func (tm *TabletManager) GetVersion(ctx context.Context) (*tabletmanagerdatapb.GetVersionResponse, error) {
+	qr, err := tm.MysqlDaemon.FetchSuperQuery(ctx, "select @@version, @@version_comment")
+	if err != nil {
+		return nil, err
+	}
+	if len(qr.Rows) != 1 || len(qr.Rows[0]) != 2 {
+		return nil, fmt.Errorf("unexpected result for the version of mysqld: %v", qr.Rows)
+	}
+	version := qr.Rows[0][0].ToString()
+	flavor, ver, err := mysqlctl.ParseVersionString(fmt.Sprintf("Ver %v %v", version, qr.Rows[0][1].ToString()))
+	if err != nil {
+		return nil, err
+	}
+	return &tabletmanagerdatapb.GetVersionResponse{
+		Version: version,
+		Flavor:  string(flavor),
+		Major:   int32(ver.Major),
+		Minor:   int32(ver.Minor),
+		Patch:   int32(ver.Patch),
+	}, nil
+}
+
 // RefreshState reload the tablet record from the topo server.
 func (tm *TabletManager) RefreshState(ctx context.Context) error {
 	if err := tm.lock(ctx); err != nil {
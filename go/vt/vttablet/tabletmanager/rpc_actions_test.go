@@ -0,0 +1,59 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletmanager
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/mysqlctl/fakemysqldaemon"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+func TestTabletManager_GetVersion(t *testing.T) {
+	ctx := context.Background()
+	daemon := fakemysqldaemon.NewFakeMysqlDaemon(nil)
+	tm := &TabletManager{MysqlDaemon: daemon}
+
+	daemon.FetchSuperQueryMap = map[string]*sqltypes.Result{
+		"select @@version, @@version_comment": sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields("@@version|@@version_comment", "varchar|varchar"),
+			"8.0.23-14|Percona Server (GPL), Release 14, Revision 3558242",
+		),
+	}
+	version, err := tm.GetVersion(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, &tabletmanagerdatapb.GetVersionResponse{
+		Version: "8.0.23-14",
+		Flavor:  "percona",
+		Major:   8,
+		Minor:   0,
+		Patch:   23,
+	}, version)
+
+	daemon.FetchSuperQueryMap["select @@version, @@version_comment"] = sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("@@version|@@version_comment", "varchar|varchar"),
+		"unknown|",
+	)
+	_, err = tm.GetVersion(ctx)
+	assert.Error(t, err)
+}
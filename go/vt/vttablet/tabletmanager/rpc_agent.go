@@ -50,10 +50,16 @@ type RPCTM interface {
 
 	ChangeType(ctx context.Context, tabletType topodatapb.TabletType) error
 
+	ChangeTags(ctx context.Context, tags map[string]string, replace bool) (map[string]string, error)
+
 	Sleep(ctx context.Context, duration time.Duration)
 
 	ExecuteHook(ctx context.Context, hk *hook.Hook) *hook.HookResult
 
+	GetLifecycleHooks(ctx context.Context) *tabletmanagerdatapb.GetLifecycleHooksResponse
+
+	GetVersion(ctx context.Context) (*tabletmanagerdatapb.GetVersionResponse, error)
+
 	RefreshState(ctx context.Context) error
 
 	RunHealthCheck(ctx context.Context)
@@ -78,6 +84,8 @@ type RPCTM interface {
 
 	ExecuteFetchAsApp(ctx context.Context, query []byte, maxrows int) (*querypb.QueryResult, error)
 
+	KillQueries(ctx context.Context, fingerprint, user string) int
+
 	// Replication related methods
 	// Deprecated, use PrimaryStatus instead
 	MasterStatus(ctx context.Context) (*replicationdatapb.PrimaryStatus, error)
@@ -109,6 +117,9 @@ type RPCTM interface {
 	VReplicationExec(ctx context.Context, query string) (*querypb.QueryResult, error)
 	VReplicationWaitForPos(ctx context.Context, id int, pos string) error
 
+	// Throttler API
+	CheckThrottler(ctx context.Context, checks []*tabletmanagerdatapb.CheckThrottlerRequest_Check) []*tabletmanagerdatapb.CheckThrottlerResponse_Result
+
 	// Reparenting related functions
 
 	ResetReplication(ctx context.Context) error
@@ -141,16 +152,22 @@ type RPCTM interface {
 
 	StopReplicationAndGetStatus(ctx context.Context, stopReplicationMode replicationdatapb.StopReplicationMode) (StopReplicationAndGetStatusResponse, error)
 
+	SetReplicationDelay(ctx context.Context, delay time.Duration) error
+
 	ReplicaWasRestarted(ctx context.Context, parent *topodatapb.TabletAlias) error
 
 	PromoteReplica(ctx context.Context) (string, error)
 
 	// Backup / restore related methods
 
-	Backup(ctx context.Context, concurrency int, logger logutil.Logger, allowPrimary bool) error
+	Backup(ctx context.Context, concurrency int, logger logutil.Logger, allowPrimary bool, compressionEngine string) error
 
 	RestoreFromBackup(ctx context.Context, logger logutil.Logger) error
 
+	GetRestoreProgress(ctx context.Context) *tabletmanagerdatapb.RestoreProgress
+
+	StreamArchivedBinlogs(ctx context.Context, position string, callback func(*tabletmanagerdatapb.StreamArchivedBinlogsResponse) error) error
+
 	// HandleRPCPanic is to be called in a defer statement in each
 	// RPC input point.
 	HandleRPCPanic(ctx context.Context, name string, args, reply interface{}, verbose bool, err *error)
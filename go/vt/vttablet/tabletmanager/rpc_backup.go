@@ -22,11 +22,13 @@ import (
 
 	"context"
 
+	"vitess.io/vitess/go/protoutil"
 	"vitess.io/vitess/go/vt/logutil"
 	"vitess.io/vitess/go/vt/mysqlctl"
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/vterrors"
 
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 )
 
@@ -35,8 +37,9 @@ const (
 	backupModeOffline = "offline"
 )
 
-// Backup takes a db backup and sends it to the BackupStorage
-func (tm *TabletManager) Backup(ctx context.Context, concurrency int, logger logutil.Logger, allowPrimary bool) error {
+// Backup takes a db backup and sends it to the BackupStorage. If
+// compressionEngine is not empty, it overrides -compression_engine_name.
+func (tm *TabletManager) Backup(ctx context.Context, concurrency int, logger logutil.Logger, allowPrimary bool, compressionEngine string) error {
 	if tm.Cnf == nil {
 		return fmt.Errorf("cannot perform backup without my.cnf, please restart vttablet with a my.cnf file specified")
 	}
@@ -72,6 +75,10 @@ func (tm *TabletManager) Backup(ctx context.Context, concurrency int, logger log
 	}
 	defer tm.endBackup(backupMode)
 
+	if err := tm.lifecycleHooks.run(ctx, tm, lifecyclePreBackup); err != nil {
+		return err
+	}
+
 	var originalType topodatapb.TabletType
 	if engine.ShouldDrainForBackup() {
 		if err := tm.lock(ctx); err != nil {
@@ -104,16 +111,17 @@ func (tm *TabletManager) Backup(ctx context.Context, concurrency int, logger log
 
 	// now we can run the backup
 	backupParams := mysqlctl.BackupParams{
-		Cnf:          tm.Cnf,
-		Mysqld:       tm.MysqlDaemon,
-		Logger:       l,
-		Concurrency:  concurrency,
-		HookExtraEnv: tm.hookExtraEnv(),
-		TopoServer:   tm.TopoServer,
-		Keyspace:     tablet.Keyspace,
-		Shard:        tablet.Shard,
-		TabletAlias:  topoproto.TabletAliasString(tablet.Alias),
-		BackupTime:   time.Now(),
+		Cnf:               tm.Cnf,
+		Mysqld:            tm.MysqlDaemon,
+		Logger:            l,
+		Concurrency:       concurrency,
+		HookExtraEnv:      tm.hookExtraEnv(),
+		TopoServer:        tm.TopoServer,
+		Keyspace:          tablet.Keyspace,
+		Shard:             tablet.Shard,
+		TabletAlias:       topoproto.TabletAliasString(tablet.Alias),
+		BackupTime:        time.Now(),
+		CompressionEngine: compressionEngine,
 	}
 
 	returnErr := mysqlctl.Backup(ctx, backupParams)
@@ -170,6 +178,9 @@ func (tm *TabletManager) RestoreFromBackup(ctx context.Context, logger logutil.L
 
 	// now we can run restore
 	err = tm.restoreDataLocked(ctx, l, 0 /* waitForBackupInterval */, true /* deleteBeforeRestore */)
+	if err == nil {
+		err = tm.lifecycleHooks.run(ctx, tm, lifecyclePostRestore)
+	}
 
 	// re-run health check to be sure to capture any replication delay
 	tm.QueryServiceControl.BroadcastHealth()
@@ -177,6 +188,24 @@ func (tm *TabletManager) RestoreFromBackup(ctx context.Context, logger logutil.L
 	return err
 }
 
+// GetRestoreProgress returns the progress of the restore from backup of the
+// tablet, if any.
+func (tm *TabletManager) GetRestoreProgress(ctx context.Context) *tabletmanagerdatapb.RestoreProgress {
+	status := mysqlctl.GetRestoreProgress()
+	progress := &tabletmanagerdatapb.RestoreProgress{
+		InProgress:    status.InProgress,
+		FilesTotal:    int64(status.FilesTotal),
+		FilesRestored: int64(status.FilesRestored),
+		BytesTotal:    status.BytesTotal,
+		BytesRestored: status.BytesRestored,
+		Elapsed:       protoutil.DurationToProto(status.Elapsed),
+	}
+	if status.ETA > 0 {
+		progress.Eta = protoutil.DurationToProto(status.ETA)
+	}
+	return progress
+}
+
 func (tm *TabletManager) beginBackup(backupMode string) error {
 	tm.mutex.Lock()
 	defer tm.mutex.Unlock()
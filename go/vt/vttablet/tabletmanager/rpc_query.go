@@ -110,6 +110,14 @@ func (tm *TabletManager) ExecuteFetchAsApp(ctx context.Context, query []byte, ma
 	return sqltypes.ResultToProto3(result), err
 }
 
+// KillQueries kills the in-flight queries of a fingerprint and of a user, an
+// empty one matching any, and returns the number of killed queries.
+func (tm *TabletManager) KillQueries(ctx context.Context, fingerprint, user string) int {
+	killed := tm.QueryServiceControl.KillQueries(fingerprint, user)
+	log.Infof("KillQueries(fingerprint=%q, user=%q) killed %d queries", fingerprint, user, killed)
+	return killed
+}
+
 // ExecuteQuery submits a new online DDL request
 func (tm *TabletManager) ExecuteQuery(ctx context.Context, query []byte, dbName string, maxrows int) (*querypb.QueryResult, error) {
 	// get the db name from the tablet
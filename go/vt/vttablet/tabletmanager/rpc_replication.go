@@ -30,7 +30,9 @@ import (
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/logutil"
 	"vitess.io/vitess/go/vt/mysqlctl"
+	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vtctl/reparentutil"
 	"vitess.io/vitess/go/vt/vterrors"
 
 	replicationdatapb "vitess.io/vitess/go/vt/proto/replicationdata"
@@ -192,7 +194,7 @@ func (tm *TabletManager) StartReplication(ctx context.Context) error {
 		}
 	}()
 
-	if err := tm.fixSemiSync(tm.Tablet().Type); err != nil {
+	if err := tm.fixSemiSync(tm.Tablet().Type, nil); err != nil {
 		return err
 	}
 	return tm.MysqlDaemon.StartReplication(tm.hookExtraEnv())
@@ -274,10 +276,11 @@ func (tm *TabletManager) InitPrimary(ctx context.Context) (string, error) {
 
 	// Enforce semi-sync after changing the tablet)type to PRIMARY. Otherwise, the
 	// primary will hang while trying to create the database.
-	if err := tm.fixSemiSync(topodatapb.TabletType_PRIMARY); err != nil {
+	if err := tm.fixSemiSync(topodatapb.TabletType_PRIMARY, nil); err != nil {
 		return "", err
 	}
 
+	tm.lifecycleHooks.run(ctx, tm, lifecyclePostPromotion)
 	return mysql.EncodePosition(pos), nil
 }
 
@@ -330,7 +333,7 @@ func (tm *TabletManager) InitReplica(ctx context.Context, parent *topodatapb.Tab
 	if tt == topodatapb.TabletType_PRIMARY {
 		tt = topodatapb.TabletType_REPLICA
 	}
-	if err := tm.fixSemiSync(tt); err != nil {
+	if err := tm.fixSemiSync(tt, parent); err != nil {
 		return err
 	}
 
@@ -389,6 +392,12 @@ func (tm *TabletManager) demotePrimary(ctx context.Context, revertPartialFailure
 		return nil, err
 	}
 
+	if wasPrimary {
+		if err := tm.lifecycleHooks.run(ctx, tm, lifecyclePreReparent); err != nil {
+			return nil, err
+		}
+	}
+
 	// If we are a primary tablet and not yet read-only, stop accepting new
 	// queries and wait for in-flight queries to complete. If we are not primary,
 	// or if we are already read-only, there's no need to stop the queryservice
@@ -448,13 +457,13 @@ func (tm *TabletManager) demotePrimary(ctx context.Context, revertPartialFailure
 	}()
 
 	// If using semi-sync, we need to disable primary-side.
-	if err := tm.fixSemiSync(topodatapb.TabletType_REPLICA); err != nil {
+	if err := tm.fixSemiSync(topodatapb.TabletType_REPLICA, nil); err != nil {
 		return nil, err
 	}
 	defer func() {
 		if finalErr != nil && revertPartialFailure && wasPrimary {
 			// enable primary-side semi-sync again
-			if err := tm.fixSemiSync(topodatapb.TabletType_PRIMARY); err != nil {
+			if err := tm.fixSemiSync(topodatapb.TabletType_PRIMARY, nil); err != nil {
 				log.Warningf("fixSemiSync(PRIMARY) failed during revert: %v", err)
 			}
 		}
@@ -484,7 +493,7 @@ func (tm *TabletManager) UndoDemotePrimary(ctx context.Context) error {
 	defer tm.unlock()
 
 	// If using semi-sync, we need to enable source-side.
-	if err := tm.fixSemiSync(topodatapb.TabletType_PRIMARY); err != nil {
+	if err := tm.fixSemiSync(topodatapb.TabletType_PRIMARY, nil); err != nil {
 		return err
 	}
 
@@ -609,7 +618,7 @@ func (tm *TabletManager) setReplicationSourceLocked(ctx context.Context, parentA
 	if tabletType == topodatapb.TabletType_PRIMARY {
 		tabletType = topodatapb.TabletType_REPLICA
 	}
-	if err := tm.fixSemiSync(tabletType); err != nil {
+	if err := tm.fixSemiSync(tabletType, parentAlias); err != nil {
 		return err
 	}
 	// Update the primary/source address only if needed.
@@ -631,6 +640,9 @@ func (tm *TabletManager) setReplicationSourceLocked(ctx context.Context, parentA
 				return err
 			}
 		}
+		if err := tm.applyReplicationDelay(ctx); err != nil {
+			return err
+		}
 	} else if shouldbeReplicating {
 		// The address is correct. Just start replication if needed.
 		if !status.ReplicationRunning() {
@@ -668,6 +680,47 @@ func (tm *TabletManager) setReplicationSourceLocked(ctx context.Context, parentA
 	return nil
 }
 
+// SetReplicationDelay makes the tablet a delayed replica, which applies the
+// events of its source with a delay and does not serve queries, or a regular
+// replica again if the delay is zero.
+func (tm *TabletManager) SetReplicationDelay(ctx context.Context, delay time.Duration) error {
+	log.Infof("SetReplicationDelay: delay: %v", delay)
+	if err := tm.lock(ctx); err != nil {
+		return err
+	}
+	defer tm.unlock()
+
+	if delay < 0 {
+		return vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "replication delay cannot be negative: %v", delay)
+	}
+	if tm.Tablet().Type == topodatapb.TabletType_PRIMARY {
+		return vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "cannot delay the replication of primary tablet %v", topoproto.TabletAliasString(tm.tabletAlias))
+	}
+
+	// Stop serving before mysqld starts lagging, and serve again only once
+	// it stopped.
+	delaySeconds := int32(delay / time.Second)
+	if delaySeconds > 0 {
+		tm.tmState.SetReplicationDelay(ctx, delaySeconds)
+		return tm.MysqlDaemon.SetReplicationDelay(ctx, delay)
+	}
+	if err := tm.MysqlDaemon.SetReplicationDelay(ctx, 0); err != nil {
+		return err
+	}
+	tm.tmState.SetReplicationDelay(ctx, 0)
+	return nil
+}
+
+// applyReplicationDelay sets the replication delay of the tablet record on
+// mysqld, after its replication was reset or its source changed.
+func (tm *TabletManager) applyReplicationDelay(ctx context.Context) error {
+	delaySeconds := tm.Tablet().ReplicationDelaySeconds
+	if delaySeconds <= 0 {
+		return nil
+	}
+	return tm.MysqlDaemon.SetReplicationDelay(ctx, time.Duration(delaySeconds)*time.Second)
+}
+
 // ReplicaWasRestarted updates the parent record for a tablet.
 func (tm *TabletManager) ReplicaWasRestarted(ctx context.Context, parent *topodatapb.TabletAlias) error {
 	log.Infof("ReplicaWasRestarted: parent: %v", parent)
@@ -791,7 +844,7 @@ func (tm *TabletManager) PromoteReplica(ctx context.Context) (string, error) {
 	}
 
 	// If using semi-sync, we need to enable it before going read-write.
-	if err := tm.fixSemiSync(topodatapb.TabletType_PRIMARY); err != nil {
+	if err := tm.fixSemiSync(topodatapb.TabletType_PRIMARY, nil); err != nil {
 		return "", err
 	}
 
@@ -803,6 +856,7 @@ func (tm *TabletManager) PromoteReplica(ctx context.Context) (string, error) {
 	// or we might block replication the next time we demote it
 	tm.replManager.setReplicationStopped(false)
 
+	tm.lifecycleHooks.run(ctx, tm, lifecyclePostPromotion)
 	return mysql.EncodePosition(pos), nil
 }
 
@@ -815,29 +869,65 @@ func isPrimaryEligible(tabletType topodatapb.TabletType) bool {
 	return false
 }
 
-func (tm *TabletManager) fixSemiSync(tabletType topodatapb.TabletType) error {
-	if !*enableSemiSync {
-		// Semi-sync handling is not enabled.
-		return nil
+// semiSyncSettings returns the primary-side and the replica-side semi-sync
+// settings of the tablet, when it becomes of the given type. The durability
+// policy of the keyspace, if it has one, overrides -enable_semi_sync. The
+// replica-side setting depends on the primary, which is the one of the shard
+// record if primaryAlias is nil. managed is false if semi-sync is not
+// handled at all.
+func (tm *TabletManager) semiSyncSettings(tabletType topodatapb.TabletType, primaryAlias *topodatapb.TabletAlias) (managed, primary, replica bool) {
+	tablet := tm.Tablet()
+	ctx, cancel := context.WithTimeout(context.Background(), *topo.RemoteOperationTimeout)
+	defer cancel()
+	durability, err := reparentutil.GetKeyspaceDurability(ctx, tm.TopoServer, tablet.Keyspace)
+	if err != nil {
+		log.Warningf("Cannot read the durability policy of keyspace %v, using -enable_semi_sync: %v", tablet.Keyspace, err)
 	}
-
-	// Only enable if we're eligible for becoming primary (REPLICA type).
-	// Ineligible tablets (RDONLY) shouldn't ACK because we'll never promote them.
-	if !isPrimaryEligible(tabletType) {
-		return tm.MysqlDaemon.SetSemiSyncEnabled(false, false)
+	if durability == nil {
+		if !*enableSemiSync {
+			// Semi-sync handling is not enabled.
+			return false, false, false
+		}
+		// Only enable if we're eligible for becoming primary (REPLICA type).
+		// Ineligible tablets (RDONLY) shouldn't ACK because we'll never promote them.
+		// Always enable replica-side since it doesn't hurt to keep it on for a primary.
+		// The primary-side needs to be off for a replica, or else it will get stuck.
+		eligible := isPrimaryEligible(tabletType)
+		return true, eligible && tabletType == topodatapb.TabletType_PRIMARY, eligible
 	}
 
-	// Always enable replica-side since it doesn't hurt to keep it on for a primary.
-	// The primary-side needs to be off for a replica, or else it will get stuck.
-	return tm.MysqlDaemon.SetSemiSyncEnabled(tabletType == topodatapb.TabletType_PRIMARY, true)
+	// tablet is a copy.
+	tablet.Type = tabletType
+	if tabletType == topodatapb.TabletType_PRIMARY {
+		semiSync := durability.SemiSyncAckers(tablet) > 0
+		return true, semiSync, semiSync
+	}
+	if primaryAlias == nil {
+		if si, err := tm.TopoServer.GetShard(ctx, tablet.Keyspace, tablet.Shard); err == nil {
+			primaryAlias = si.PrimaryAlias
+		} else {
+			log.Warningf("Cannot read the primary of shard %v/%v: %v", tablet.Keyspace, tablet.Shard, err)
+		}
+	}
+	// The policies only need the alias of the primary.
+	primaryTablet := &topodatapb.Tablet{
+		Alias:    primaryAlias,
+		Keyspace: tablet.Keyspace,
+		Shard:    tablet.Shard,
+		Type:     topodatapb.TabletType_PRIMARY,
+	}
+	return true, false, durability.IsReplicaSemiSync(primaryTablet, tablet)
 }
 
-func (tm *TabletManager) fixSemiSyncAndReplication(tabletType topodatapb.TabletType) error {
-	if !*enableSemiSync {
-		// Semi-sync handling is not enabled.
+func (tm *TabletManager) fixSemiSync(tabletType topodatapb.TabletType, primaryAlias *topodatapb.TabletAlias) error {
+	managed, primary, replica := tm.semiSyncSettings(tabletType, primaryAlias)
+	if !managed {
 		return nil
 	}
+	return tm.MysqlDaemon.SetSemiSyncEnabled(primary, replica)
+}
 
+func (tm *TabletManager) fixSemiSyncAndReplication(tabletType topodatapb.TabletType) error {
 	if tabletType == topodatapb.TabletType_PRIMARY {
 		// Primary is special. It is always handled at the
 		// right time by the reparent operations, it doesn't
@@ -845,7 +935,12 @@ func (tm *TabletManager) fixSemiSyncAndReplication(tabletType topodatapb.TabletT
 		return nil
 	}
 
-	if err := tm.fixSemiSync(tabletType); err != nil {
+	managed, primary, shouldAck := tm.semiSyncSettings(tabletType, nil)
+	if !managed {
+		// Semi-sync handling is not enabled.
+		return nil
+	}
+	if err := tm.MysqlDaemon.SetSemiSyncEnabled(primary, shouldAck); err != nil {
 		return vterrors.Wrapf(err, "failed to fixSemiSync(%v)", tabletType)
 	}
 
@@ -862,7 +957,6 @@ func (tm *TabletManager) fixSemiSyncAndReplication(tabletType topodatapb.TabletT
 		return nil
 	}
 
-	shouldAck := isPrimaryEligible(tabletType)
 	acking, err := tm.MysqlDaemon.SemiSyncReplicationStatus()
 	if err != nil {
 		return vterrors.Wrap(err, "failed to get SemiSyncReplicationStatus")
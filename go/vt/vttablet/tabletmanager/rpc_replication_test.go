@@ -0,0 +1,75 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletmanager
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func TestSemiSyncSettings(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1")
+	tm := newTestTM(t, ts, 1, "ks", "0")
+	defer tm.Stop()
+
+	setDurabilityPolicy := func(name string) {
+		lctx, unlock, err := ts.LockKeyspace(ctx, "ks", "test")
+		require.NoError(t, err)
+		defer unlock(&err)
+		ki, err := ts.GetKeyspace(lctx, "ks")
+		require.NoError(t, err)
+		ki.DurabilityPolicy = name
+		require.NoError(t, ts.UpdateKeyspace(lctx, ki))
+	}
+
+	// Without policy, the flag decides.
+	managed, _, _ := tm.semiSyncSettings(topodatapb.TabletType_REPLICA, nil)
+	assert.False(t, managed)
+
+	setDurabilityPolicy("semi_sync")
+	managed, primary, replica := tm.semiSyncSettings(topodatapb.TabletType_PRIMARY, nil)
+	assert.True(t, managed)
+	assert.True(t, primary)
+	assert.True(t, replica)
+	managed, primary, replica = tm.semiSyncSettings(topodatapb.TabletType_REPLICA, nil)
+	assert.True(t, managed)
+	assert.False(t, primary)
+	assert.True(t, replica)
+	_, _, replica = tm.semiSyncSettings(topodatapb.TabletType_RDONLY, nil)
+	assert.False(t, replica)
+
+	// The cross_cell replicas only ack the primaries of other cells.
+	setDurabilityPolicy("cross_cell")
+	_, _, replica = tm.semiSyncSettings(topodatapb.TabletType_REPLICA, &topodatapb.TabletAlias{Cell: "cell1", Uid: 2})
+	assert.False(t, replica)
+	_, _, replica = tm.semiSyncSettings(topodatapb.TabletType_REPLICA, &topodatapb.TabletAlias{Cell: "cell2", Uid: 2})
+	assert.True(t, replica)
+
+	setDurabilityPolicy("none")
+	managed, primary, replica = tm.semiSyncSettings(topodatapb.TabletType_PRIMARY, nil)
+	assert.True(t, managed)
+	assert.False(t, primary)
+	assert.False(t, replica)
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletmanager
+
+import (
+	"context"
+	"strings"
+
+	"vitess.io/vitess/go/vt/callinfo"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/throttle"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+// CheckThrottler runs a batch of checks of the tablet throttler.
+func (tm *TabletManager) CheckThrottler(ctx context.Context, checks []*tabletmanagerdatapb.CheckThrottlerRequest_Check) []*tabletmanagerdatapb.CheckThrottlerResponse_Result {
+	remoteAddr := ""
+	if ci, ok := callinfo.FromContext(ctx); ok {
+		remoteAddr = strings.Split(ci.RemoteAddr(), ":")[0]
+	}
+	results := make([]*tabletmanagerdatapb.CheckThrottlerResponse_Result, 0, len(checks))
+	for _, check := range checks {
+		appName := check.AppName
+		if appName == "" {
+			appName = throttle.DefaultAppName
+		}
+		checkType := throttle.ThrottleCheckPrimaryWrite
+		if check.Self {
+			checkType = throttle.ThrottleCheckSelf
+		}
+		flags := &throttle.CheckFlags{
+			LowPriority:       check.LowPriority,
+			OverrideThreshold: check.OverrideThreshold,
+			OKIfNotExists:     check.OkIfNotExists,
+		}
+		checkResult := tm.QueryServiceControl.CheckThrottler(ctx, appName, remoteAddr, flags, checkType)
+		result := &tabletmanagerdatapb.CheckThrottlerResponse_Result{
+			StatusCode: int32(checkResult.StatusCode),
+			Value:      checkResult.Value,
+			Threshold:  checkResult.Threshold,
+			Message:    checkResult.Message,
+			MetricName: checkResult.MetricName,
+		}
+		if checkResult.Error != nil {
+			result.Error = checkResult.Error.Error()
+		}
+		results = append(results, result)
+	}
+	return results
+}
@@ -80,6 +80,8 @@ var (
 	initDbNameOverride = flag.String("init_db_name_override", "", "(init parameter) override the name of the db used by vttablet. Without this flag, the db name defaults to vt_<keyspacename>")
 	initTags           flagutil.StringMapValue
 
+	initReplicationDelay = flag.Duration("init_replication_delay", 0, "(init parameter) make the tablet a delayed replica, which applies the events of its source with this delay, does not serve queries and is never promoted. If not set, the tablet keeps the delay of its existing tablet record.")
+
 	initPopulateMetadata = flag.Bool("init_populate_metadata", false, "(init parameter) populate metadata tables even if restore_from_backup is disabled. If restore_from_backup is enabled, metadata tables are always populated regardless of this flag.")
 	initTimeout          = flag.Duration("init_timeout", 1*time.Minute, "(init parameter) timeout to use for the init phase.")
 
@@ -158,6 +160,10 @@ type TabletManager struct {
 	// It's only set once in NewTabletManager() and never modified after that.
 	orc *orcClient
 
+	// lifecycleHooks is the registry of -lifecycle_hooks_config, or nil.
+	// It's only set once in Start() and never modified after that.
+	lifecycleHooks *lifecycleHooks
+
 	// mutex protects all the following fields (that start with '_'),
 	// only hold the mutex to update the fields, nothing else.
 	mutex sync.Mutex
@@ -176,6 +182,13 @@ type TabletManager struct {
 	// _shardSyncCancel is the function to stop the background shard sync goroutine.
 	_shardSyncCancel context.CancelFunc
 
+	// _binlogArchiverDone is a channel for waiting until the binlog archiver
+	// goroutine has really finished after _binlogArchiverCancel was called.
+	_binlogArchiverDone chan struct{}
+
+	// _binlogArchiverCancel is the function to stop the binlog archiver goroutine.
+	_binlogArchiverCancel context.CancelFunc
+
 	// _rebuildKeyspaceDone is a channel for waiting until the current keyspace
 	// has been rebuilt
 	_rebuildKeyspaceDone chan struct{}
@@ -224,6 +237,9 @@ func BuildTabletFromInput(alias *topodatapb.TabletAlias, port, grpcPort int32) (
 	default:
 		return nil, fmt.Errorf("invalid init_tablet_type %v; can only be REPLICA, RDONLY or SPARE", tabletType)
 	}
+	if *initReplicationDelay < 0 {
+		return nil, fmt.Errorf("invalid init_replication_delay %v; cannot be negative", *initReplicationDelay)
+	}
 
 	return &topodatapb.Tablet{
 		Alias:    alias,
@@ -238,6 +254,8 @@ func BuildTabletFromInput(alias *topodatapb.TabletAlias, port, grpcPort int32) (
 		Type:           tabletType,
 		DbNameOverride: *initDbNameOverride,
 		Tags:           initTags,
+
+		ReplicationDelaySeconds: int32(*initReplicationDelay / time.Second),
 	}, nil
 }
 
@@ -251,6 +269,12 @@ func (tm *TabletManager) Start(tablet *topodatapb.Tablet, healthCheckInterval ti
 
 	tm.baseTabletType = tablet.Type
 
+	lifecycleHooks, err := loadLifecycleHooks(*lifecycleHooksConfig)
+	if err != nil {
+		return err
+	}
+	tm.lifecycleHooks = lifecycleHooks
+
 	ctx, cancel := context.WithTimeout(tm.BatchCtx, *initTimeout)
 	defer cancel()
 	si, err := tm.createKeyspaceShard(ctx)
@@ -266,6 +290,11 @@ func (tm *TabletManager) Start(tablet *topodatapb.Tablet, healthCheckInterval ti
 	if err := tm.initTablet(ctx); err != nil {
 		return err
 	}
+	// mysqld may not replicate yet, in which case the delay is set when its
+	// source is.
+	if err := tm.applyReplicationDelay(ctx); err != nil {
+		log.Warningf("Cannot set the replication delay of mysqld: %v", err)
+	}
 
 	err = tm.QueryServiceControl.InitDBConfig(&querypb.Target{
 		Keyspace:   tablet.Keyspace,
@@ -291,6 +320,7 @@ func (tm *TabletManager) Start(tablet *topodatapb.Tablet, healthCheckInterval ti
 	// The following initializations don't need to be done
 	// in any specific order.
 	tm.startShardSync()
+	tm.startBinlogArchiver()
 	tm.exportStats()
 	orc, err := newOrcClient()
 	if err != nil {
@@ -324,6 +354,7 @@ func (tm *TabletManager) Close() {
 	// rather than registering it as an OnTerm hook so the shard sync loop keeps
 	// running during lame duck.
 	tm.stopShardSync()
+	tm.stopBinlogArchiver()
 	tm.stopRebuildKeyspace()
 
 	// cleanup initialized fields in the tablet entry
@@ -355,6 +386,7 @@ func (tm *TabletManager) Stop() {
 	// Stop the shard sync loop and wait for it to exit. This needs to be done
 	// here in addition to in Close() because tests do not call Close().
 	tm.stopShardSync()
+	tm.stopBinlogArchiver()
 	tm.stopRebuildKeyspace()
 
 	if tm.UpdateStream != nil {
@@ -604,6 +636,15 @@ func (tm *TabletManager) initTablet(ctx context.Context) error {
 			return fmt.Errorf("initTablet failed because existing tablet keyspace and shard %v/%v differ from the provided ones %v/%v", oldTablet.Keyspace, oldTablet.Shard, tablet.Keyspace, tablet.Shard)
 		}
 
+		// A delayed replica stays delayed across restarts, unless
+		// -init_replication_delay is set.
+		if *initReplicationDelay == 0 && tablet.Type != topodatapb.TabletType_PRIMARY && oldTablet.ReplicationDelaySeconds > 0 {
+			tm.tmState.UpdateTablet(func(t *topodatapb.Tablet) {
+				t.ReplicationDelaySeconds = oldTablet.ReplicationDelaySeconds
+			})
+			tablet = tm.Tablet()
+		}
+
 		// Update ShardReplication in any case, to be sure.  This is
 		// meant to fix the case when a Tablet record was created, but
 		// then the ShardReplication record was not (because for
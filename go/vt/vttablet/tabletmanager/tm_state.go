@@ -39,6 +39,7 @@ import (
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/topotools"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/planbuilder"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/rules"
 )
 
@@ -67,9 +68,14 @@ type tmState struct {
 	isShardServing           map[topodatapb.TabletType]bool
 	tabletControls           map[topodatapb.TabletType]bool
 	deniedTables             map[topodatapb.TabletType][]string
+	writeFence               *topodatapb.Shard_WriteFence
 	tablet                   *topodatapb.Tablet
 	isPublishing             bool
 	hasCreatedMetadataTables bool
+	// isWarmingUp is true while the tablet replays the recent queries
+	// of a peer, and hasWarmedUp once it has started to.
+	isWarmingUp bool
+	hasWarmedUp bool
 
 	// displayState contains the current snapshot of the internal state
 	// and has its own mutex.
@@ -142,6 +148,7 @@ func (ts *tmState) RefreshFromTopoInfo(ctx context.Context, shardInfo *topo.Shar
 				ts.deniedTables[tc.TabletType] = tc.DeniedTables
 			}
 		}
+		ts.writeFence = shardInfo.WriteFence
 	}
 
 	if srvKeyspace != nil {
@@ -193,6 +200,8 @@ func (ts *tmState) ChangeTabletType(ctx context.Context, tabletType topodatapb.T
 
 		ts.tablet.Type = tabletType
 		ts.tablet.PrimaryTermStartTime = PrimaryTermStartTime
+		// The promotion reset the replication of mysqld, and its delay.
+		ts.tablet.ReplicationDelaySeconds = 0
 	} else {
 		ts.tablet.Type = tabletType
 		ts.tablet.PrimaryTermStartTime = nil
@@ -208,6 +217,52 @@ func (ts *tmState) ChangeTabletType(ctx context.Context, tabletType topodatapb.T
 	return nil
 }
 
+// ChangeTabletTags sets the tags of the tablet, or replaces all of them, and
+// publishes them. A tag with an empty value is removed. It returns the new
+// tags.
+func (ts *tmState) ChangeTabletTags(ctx context.Context, tags map[string]string, replace bool) map[string]string {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	log.Infof("Changing Tablet Tags: %v, replace: %v", tags, replace)
+
+	newTags := make(map[string]string)
+	if !replace {
+		for name, value := range ts.tablet.Tags {
+			newTags[name] = value
+		}
+	}
+	for name, value := range tags {
+		if value == "" {
+			delete(newTags, name)
+			continue
+		}
+		newTags[name] = value
+	}
+	ts.tablet.Tags = newTags
+
+	ts.publishStateLocked(ctx)
+	ts.publishForDisplay()
+
+	result := make(map[string]string, len(newTags))
+	for name, value := range newTags {
+		result[name] = value
+	}
+	return result
+}
+
+// SetReplicationDelay sets the replication delay of the tablet, and
+// publishes it. A delayed replica does not serve queries.
+func (ts *tmState) SetReplicationDelay(ctx context.Context, delaySeconds int32) {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	log.Infof("Changing Replication Delay: %vs", delaySeconds)
+
+	ts.tablet.ReplicationDelaySeconds = delaySeconds
+
+	ts.updateLocked(ctx)
+	ts.publishStateLocked(ctx)
+}
+
 func (ts *tmState) SetMysqlPort(mport int32) {
 	ts.mu.Lock()
 	defer ts.mu.Unlock()
@@ -238,6 +293,10 @@ func (ts *tmState) updateLocked(ctx context.Context) {
 	// Disable TabletServer first so the nonserving state gets advertised
 	// before other services are shutdown.
 	reason := ts.canServe(ts.tablet.Type)
+	if reason == "" && ts.needsWarmUpLocked(ts.tablet.Type) {
+		ts.startWarmUpLocked()
+		reason = ts.canServe(ts.tablet.Type)
+	}
 	if reason != "" {
 		log.Infof("Disabling query service: %v", reason)
 		if err := ts.tm.QueryServiceControl.SetServingType(ts.tablet.Type, terTime, false, reason); err != nil {
@@ -320,9 +379,30 @@ func (ts *tmState) canServe(tabletType topodatapb.TabletType) string {
 	if tabletType == topodatapb.TabletType_PRIMARY && ts.isResharding {
 		return "primary tablet with filtered replication on"
 	}
+	if tabletType != topodatapb.TabletType_PRIMARY && ts.tablet.ReplicationDelaySeconds > 0 {
+		return fmt.Sprintf("delayed replica(%vs)", ts.tablet.ReplicationDelaySeconds)
+	}
+	if tabletType != topodatapb.TabletType_PRIMARY && ts.isWarmingUp {
+		return "warming up"
+	}
 	return ""
 }
 
+// writePlanTypes are the plans denied by a shard write fence.
+var writePlanTypes = []planbuilder.PlanType{
+	planbuilder.PlanInsert,
+	planbuilder.PlanInsertMessage,
+	planbuilder.PlanUpdate,
+	planbuilder.PlanUpdateLimit,
+	planbuilder.PlanDelete,
+	planbuilder.PlanDeleteLimit,
+	planbuilder.PlanDDL,
+	planbuilder.PlanNextval,
+	planbuilder.PlanLoad,
+	planbuilder.PlanAlterMigration,
+	planbuilder.PlanRevertMigration,
+}
+
 func (ts *tmState) applyDenyList(ctx context.Context) (err error) {
 	denyListRules := rules.New()
 	deniedTables := ts.deniedTables[ts.tablet.Type]
@@ -344,6 +424,16 @@ func (ts *tmState) applyDenyList(ctx context.Context) (err error) {
 		}
 	}
 
+	if ts.tablet.Type == topodatapb.TabletType_PRIMARY && ts.writeFence != nil {
+		log.Infof("Denying writes: shard write fence set: %v", ts.writeFence.Reason)
+		desc := fmt.Sprintf("writes to shard %s/%s are fenced: %s", ts.tablet.Keyspace, ts.tablet.Shard, ts.writeFence.Reason)
+		qr := rules.NewQueryRule(desc, "write_fence", rules.QRFailRetry)
+		for _, planType := range writePlanTypes {
+			qr.AddPlanCond(planType)
+		}
+		denyListRules.Add(qr)
+	}
+
 	loadRuleErr := ts.tm.QueryServiceControl.SetQueryRules(denyListQueryList, denyListRules)
 	if loadRuleErr != nil {
 		log.Warningf("Fail to load query rule set %s: %s", denyListQueryList, loadRuleErr)
@@ -18,7 +18,11 @@ package tabletmanager
 
 import (
 	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"os"
+	"strconv"
 	"testing"
 	"time"
 
@@ -33,6 +37,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"vitess.io/vitess/go/vt/mysqlctl/fakemysqldaemon"
+	querypb "vitess.io/vitess/go/vt/proto/query"
 	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	"vitess.io/vitess/go/vt/topo"
@@ -129,6 +134,32 @@ func TestStateDenyList(t *testing.T) {
 	assert.Equal(t, `[{"Description":"enforce denied tables","Name":"denied_table","TableNames":["t1"],"Action":"FAIL_RETRY"}]`, string(b))
 }
 
+func TestStateWriteFence(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1")
+	tm := newTestTM(t, ts, 1, "ks", "0")
+	defer tm.Stop()
+
+	err := tm.tmState.ChangeTabletType(ctx, topodatapb.TabletType_PRIMARY, DBActionNone)
+	require.NoError(t, err)
+
+	si := &topo.ShardInfo{
+		Shard: &topodatapb.Shard{
+			WriteFence: &topodatapb.Shard_WriteFence{Reason: "maintenance"},
+		},
+	}
+	tm.tmState.RefreshFromTopoInfo(ctx, si, nil)
+
+	qsc := tm.QueryServiceControl.(*tabletservermock.Controller)
+	b, _ := json.Marshal(qsc.GetQueryRules(denyListQueryList))
+	assert.Equal(t, `[{"Description":"writes to shard ks/0 are fenced: maintenance","Name":"write_fence","Plans":["Insert","InsertMessage","Update","UpdateLimit","Delete","DeleteLimit","DDL","Nextval","Load","AlterMigration","RevertMigration"],"Action":"FAIL_RETRY"}]`, string(b))
+
+	// Removing the fence lifts the rule.
+	tm.tmState.RefreshFromTopoInfo(ctx, &topo.ShardInfo{Shard: &topodatapb.Shard{}}, nil)
+	b, _ = json.Marshal(qsc.GetQueryRules(denyListQueryList))
+	assert.Equal(t, `[]`, string(b))
+}
+
 func TestStateTabletControls(t *testing.T) {
 	ctx := context.Background()
 	ts := memorytopo.NewServer("cell1")
@@ -344,6 +375,90 @@ func TestStateNonServing(t *testing.T) {
 	assert.False(t, qsc.IsServing())
 }
 
+func TestStateReplicationDelay(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1")
+	tm := newTestTM(t, ts, 2, "ks", "0")
+	defer tm.Stop()
+
+	alias := &topodatapb.TabletAlias{
+		Cell: "cell1",
+		Uid:  2,
+	}
+	qsc := tm.QueryServiceControl.(*tabletservermock.Controller)
+	fmd := tm.MysqlDaemon.(*fakemysqldaemon.FakeMysqlDaemon)
+	require.True(t, qsc.IsServing())
+
+	err := tm.SetReplicationDelay(ctx, time.Hour)
+	require.NoError(t, err)
+	assert.False(t, qsc.IsServing())
+	assert.Equal(t, time.Hour, fmd.ReplicationDelay)
+	ti, err := ts.GetTablet(ctx, alias)
+	require.NoError(t, err)
+	assert.Equal(t, int32(3600), ti.ReplicationDelaySeconds)
+
+	err = tm.SetReplicationDelay(ctx, 0)
+	require.NoError(t, err)
+	assert.True(t, qsc.IsServing())
+	assert.Equal(t, time.Duration(0), fmd.ReplicationDelay)
+	ti, err = ts.GetTablet(ctx, alias)
+	require.NoError(t, err)
+	assert.Equal(t, int32(0), ti.ReplicationDelaySeconds)
+
+	err = tm.SetReplicationDelay(ctx, -time.Hour)
+	assert.Error(t, err)
+}
+
+func TestStateWarmUp(t *testing.T) {
+	defer func(saved int) { *warmUpQueries = saved }(*warmUpQueries)
+	*warmUpQueries = 1
+
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1")
+
+	// The peer serves its recent queries, of which only the first one is
+	// replayed.
+	peerQueries := []*querypb.BoundQuery{{Sql: "select 1"}, {Sql: "select 2"}}
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		assert.Equal(t, "/debug/warmup_queries", r.URL.Path)
+		json.NewEncoder(w).Encode(peerQueries)
+	}))
+	defer server.Close()
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	port, err := strconv.Atoi(serverURL.Port())
+	require.NoError(t, err)
+	peer := newTestTablet(t, 2, "ks", "0")
+	peer.Hostname = serverURL.Hostname()
+	peer.PortMap["vt"] = int32(port)
+	require.NoError(t, ts.CreateTablet(ctx, peer))
+
+	tm := newTestTM(t, ts, 1, "ks", "0")
+	defer tm.Stop()
+	qsc := tm.QueryServiceControl.(*tabletservermock.Controller)
+	assert.False(t, qsc.IsServing())
+	tm.tmState.mu.Lock()
+	assert.Equal(t, "warming up", tm.tmState.canServe(topodatapb.TabletType_REPLICA))
+	assert.Equal(t, "", tm.tmState.canServe(topodatapb.TabletType_PRIMARY))
+	tm.tmState.mu.Unlock()
+
+	close(release)
+	for i := 0; !qsc.IsServing(); i++ {
+		require.Less(t, i, 100, "the tablet did not start serving after its warm up")
+		time.Sleep(10 * time.Millisecond)
+	}
+	utils.MustMatch(t, peerQueries[:1], qsc.WarmUpQueries())
+
+	// The tablet warms up once.
+	tm.tmState.mu.Lock()
+	tm.tmState.tablet.Type = topodatapb.TabletType_RDONLY
+	tm.tmState.updateLocked(ctx)
+	tm.tmState.mu.Unlock()
+	assert.True(t, qsc.IsServing())
+}
+
 func TestStateChangeTabletType(t *testing.T) {
 	ctx := context.Background()
 	ts := memorytopo.NewServer("cell1")
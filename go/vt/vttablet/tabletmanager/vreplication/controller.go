@@ -63,6 +63,9 @@ type controller struct {
 	source       *binlogdatapb.BinlogSource
 	stopPos      string
 	tabletPicker *discovery.TabletPicker
+	// externalTopo is the topo of the external cluster the stream
+	// replicates from, if any. It is closed when the stream stops.
+	externalTopo *topo.Server
 
 	cancel context.CancelFunc
 	done   chan struct{}
@@ -96,8 +99,8 @@ func newController(ctx context.Context, params map[string]string, dbClientFactor
 	ct.workflow = params["workflow"]
 
 	blpStats.State.Set(params["state"])
-	// Nothing to do if replication is stopped.
-	if params["state"] == binlogplayer.BlpStopped {
+	// Nothing to do if replication is stopped or paused.
+	if params["state"] == binlogplayer.BlpStopped || params["state"] == binlogplayer.BlpPaused {
 		ct.cancel = func() {}
 		close(ct.done)
 		return ct, nil
@@ -126,9 +129,13 @@ func newController(ctx context.Context, params map[string]string, dbClientFactor
 			if err != nil {
 				return nil, err
 			}
+			ct.externalTopo = sourceTopo
 		}
 		tp, err := discovery.NewTabletPicker(sourceTopo, cells, ct.source.Keyspace, ct.source.Shard, tabletTypesStr)
 		if err != nil {
+			if ct.externalTopo != nil {
+				ct.externalTopo.Close()
+			}
 			return nil, err
 		}
 		ct.tabletPicker = tp
@@ -145,6 +152,9 @@ func newController(ctx context.Context, params map[string]string, dbClientFactor
 func (ct *controller) run(ctx context.Context) {
 	defer func() {
 		log.Infof("stream %v: stopped", ct.id)
+		if ct.externalTopo != nil {
+			ct.externalTopo.Close()
+		}
 		close(ct.done)
 	}()
 
@@ -255,7 +265,7 @@ func (ct *controller) runBlp(ctx context.Context) (err error) {
 		var vsClient VStreamerClient
 		var err error
 		if name := ct.source.GetExternalMysql(); name != "" {
-			vsClient, err = ct.vre.ec.Get(name)
+			vsClient, err = ct.vre.ec.Get(ctx, name)
 			if err != nil {
 				return err
 			}
@@ -36,6 +36,8 @@ import (
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/sidecardb"
+	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vtgate/evalengine"
@@ -76,6 +78,7 @@ func init() {
 	allddls = append(allddls, createReshardingJournalTable, createCopyState)
 	allddls = append(allddls, createVReplicationLogTable)
 	withDDL = withddl.New(allddls)
+	sidecardb.Register("vreplication", allddls)
 
 	withDDLInitialQueries = append(withDDLInitialQueries, binlogplayer.WithDDLInitialQueries...)
 }
@@ -125,6 +128,16 @@ type Engine struct {
 	ec        *externalConnector
 
 	throttlerClient *throttle.Client
+
+	// onlineDDL submits the DDLs of the streams with
+	// on_ddl=EXEC_ONLINE_DDL. It is nil if the tablet cannot run online DDLs.
+	onlineDDL OnlineDDLSubmitter
+}
+
+// OnlineDDLSubmitter submits online DDL migrations to the tablet. The
+// statements carry the /*vt+ */ directives of schema.NewOnlineDDL.
+type OnlineDDLSubmitter interface {
+	SubmitMigration(ctx context.Context, stmt sqlparser.Statement) (*sqltypes.Result, error)
 }
 
 type journalEvent struct {
@@ -135,15 +148,16 @@ type journalEvent struct {
 
 // NewEngine creates a new Engine.
 // A nil ts means that the Engine is disabled.
-func NewEngine(config *tabletenv.TabletConfig, ts *topo.Server, cell string, mysqld mysqlctl.MysqlDaemon, lagThrottler *throttle.Throttler) *Engine {
+func NewEngine(config *tabletenv.TabletConfig, ts *topo.Server, cell string, mysqld mysqlctl.MysqlDaemon, lagThrottler *throttle.Throttler, onlineDDL OnlineDDLSubmitter) *Engine {
 	vre := &Engine{
 		controllers:     make(map[int]*controller),
 		ts:              ts,
 		cell:            cell,
 		mysqld:          mysqld,
 		journaler:       make(map[string]*journalEvent),
-		ec:              newExternalConnector(config.ExternalConnections),
+		ec:              newExternalConnector(config.ExternalConnections, ts),
 		throttlerClient: throttle.NewBackgroundClient(lagThrottler, throttlerAppName, throttle.ThrottleCheckPrimaryWrite),
+		onlineDDL:       onlineDDL,
 	}
 
 	return vre
@@ -175,7 +189,7 @@ func NewTestEngine(ts *topo.Server, cell string, mysqld mysqlctl.MysqlDaemon, db
 		dbClientFactoryDba:      dbClientFactoryDba,
 		dbName:                  dbname,
 		journaler:               make(map[string]*journalEvent),
-		ec:                      newExternalConnector(externalConfig),
+		ec:                      newExternalConnector(externalConfig, ts),
 	}
 	return vre
 }
@@ -430,7 +444,12 @@ func (vre *Engine) exec(query string, runAsAdmin bool) (*sqltypes.Result, error)
 				return nil, err
 			}
 			vre.controllers[id] = ct
-			if err := insertLog(vdbc, LogStateChange, uint32(id), params["state"], ""); err != nil {
+			message := ""
+			if params["state"] == binlogplayer.BlpPaused {
+				// Keep the position and the reason of the pause in the log.
+				message = params["message"]
+			}
+			if err := insertLog(vdbc, LogStateChange, uint32(id), params["state"], message); err != nil {
 				return nil, err
 			}
 		}
@@ -733,7 +752,7 @@ func (vre *Engine) WaitForPos(ctx context.Context, id int, pos string) error {
 			return nil
 		}
 
-		if qr.Rows[0][1].ToString() == binlogplayer.BlpStopped {
+		if state := qr.Rows[0][1].ToString(); state == binlogplayer.BlpStopped || state == binlogplayer.BlpPaused {
 			return fmt.Errorf("replication has stopped at %v before reaching position %v, message: %s", current, mPos, qr.Rows[0][2].ToString())
 		}
 
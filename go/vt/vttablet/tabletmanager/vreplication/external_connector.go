@@ -28,6 +28,7 @@ import (
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/queryservice"
 	"vitess.io/vitess/go/vt/vttablet/tabletconn"
@@ -53,15 +54,19 @@ type VStreamerClient interface {
 	VStreamRows(ctx context.Context, query string, lastpk *querypb.QueryResult, send func(*binlogdatapb.VStreamRowsResponse) error) error
 }
 
+// externalConnector connects to the external mysqls of the config of the
+// tablet, or else to the ones registered in the topo.
 type externalConnector struct {
 	mu         sync.Mutex
 	dbconfigs  map[string]*dbconfigs.DBConfigs
+	ts         *topo.Server
 	connectors map[string]*mysqlConnector
 }
 
-func newExternalConnector(dbcfgs map[string]*dbconfigs.DBConfigs) *externalConnector {
+func newExternalConnector(dbcfgs map[string]*dbconfigs.DBConfigs, ts *topo.Server) *externalConnector {
 	return &externalConnector{
 		dbconfigs:  dbcfgs,
+		ts:         ts,
 		connectors: make(map[string]*mysqlConnector),
 	}
 }
@@ -73,7 +78,7 @@ func (ec *externalConnector) Close() {
 	ec.connectors = make(map[string]*mysqlConnector)
 }
 
-func (ec *externalConnector) Get(name string) (*mysqlConnector, error) {
+func (ec *externalConnector) Get(ctx context.Context, name string) (*mysqlConnector, error) {
 	ec.mu.Lock()
 	defer ec.mu.Unlock()
 	if c, ok := ec.connectors[name]; ok {
@@ -83,6 +88,15 @@ func (ec *externalConnector) Get(name string) (*mysqlConnector, error) {
 	// Construct
 	config := tabletenv.NewDefaultConfig()
 	config.DB = ec.dbconfigs[name]
+	if config.DB == nil && ec.ts != nil {
+		em, err := ec.ts.GetExternalMysql(ctx, name)
+		if err != nil {
+			return nil, vterrors.Wrapf(err, "external mysqlConnector: %v", name)
+		}
+		if em != nil {
+			config.DB = dbconfigs.NewExternalMysqlDBConfigs(em.ExternalMysql)
+		}
+	}
 	if config.DB == nil {
 		return nil, vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "external mysqlConnector %v not found", name)
 	}
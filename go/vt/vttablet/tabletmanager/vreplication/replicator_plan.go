@@ -310,9 +310,12 @@ func (tp *TablePlan) bindFieldVal(field *querypb.Field, val *sqltypes.Value) (*q
 			var err error
 			valString, err = fromEncoding.NewDecoder().String(valString)
 			if err != nil {
-				return nil, err
+				return nil, vterrors.Wrapf(err, "cannot convert column %s from %s", field.Name, conversion.FromCharset)
 			}
 		}
+		if tp.Stats != nil {
+			tp.Stats.ConvertedValueCount.Add(tp.TargetName+"."+field.Name, 1)
+		}
 		return sqltypes.StringBindVariable(valString), nil
 	}
 	if enumValues, ok := tp.EnumValuesMap[field.Name]; ok && !val.IsNull() {
@@ -336,8 +339,19 @@ func (tp *TablePlan) applyChange(rowChange *binlogdatapb.RowChange, executor fun
 	bindvars := make(map[string]*querypb.BindVariable, len(tp.Fields))
 	if rowChange.Before != nil {
 		before = true
-		vals := sqltypes.MakeRowTrusted(tp.Fields, rowChange.Before)
-		for i, field := range tp.Fields {
+		// The columns missing from a partial before image have no bind
+		// variable, so that the statements that need them fail.
+		fields := tp.Fields
+		if len(rowChange.BeforeMissingColumns) != 0 {
+			fields = make([]*querypb.Field, 0, len(tp.Fields))
+			for i, field := range tp.Fields {
+				if !isMissingColumn(rowChange.BeforeMissingColumns, i) {
+					fields = append(fields, field)
+				}
+			}
+		}
+		vals := sqltypes.MakeRowTrusted(fields, rowChange.Before)
+		for i, field := range fields {
 			bindVar, err := tp.bindFieldVal(field, &vals[i])
 			if err != nil {
 				return nil, err
@@ -386,6 +400,15 @@ func (tp *TablePlan) applyChange(rowChange *binlogdatapb.RowChange, executor fun
 	return nil, nil
 }
 
+func isMissingColumn(missing []int64, pos int) bool {
+	for _, m := range missing {
+		if m == int64(pos) {
+			return true
+		}
+	}
+	return false
+}
+
 func execParsedQuery(pq *sqlparser.ParsedQuery, bindvars map[string]*querypb.BindVariable, executor func(string) (*sqltypes.Result, error)) (*sqltypes.Result, error) {
 	sql, err := pq.GenerateQuery(bindvars, nil)
 	if err != nil {
@@ -24,6 +24,7 @@ import (
 	"vitess.io/vitess/go/vt/binlog/binlogplayer"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"vitess.io/vitess/go/sqltypes"
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
@@ -768,3 +769,42 @@ func TestBuildPlayerPlanExclude(t *testing.T) {
 	wantPlan, _ := json.Marshal(want)
 	assert.Equal(t, string(gotPlan), string(wantPlan))
 }
+
+func TestApplyChangePartialBeforeImage(t *testing.T) {
+	input := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match:  "t1",
+			Filter: "select * from t1",
+		}},
+	}
+	rp, err := buildReplicatorPlan(input, map[string][]*ColumnInfo{"t1": {&ColumnInfo{Name: "c1", IsPK: true}}}, nil, binlogplayer.NewStats())
+	require.NoError(t, err)
+	tp, err := rp.buildExecutionPlan(&binlogdatapb.FieldEvent{
+		TableName: "t1",
+		Fields:    sqltypes.MakeTestFields("c1|c2|c3", "int64|varchar|varchar"),
+	})
+	require.NoError(t, err)
+
+	var executed []string
+	executor := func(sql string) (*sqltypes.Result, error) {
+		executed = append(executed, sql)
+		return &sqltypes.Result{}, nil
+	}
+	before := sqltypes.RowToProto3([]sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewVarChar("b")})
+	after := sqltypes.RowToProto3([]sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewVarChar("b"), sqltypes.NewVarChar("c")})
+
+	// The before image only has the primary key and c2, which is all an
+	// update or a delete needs.
+	_, err = tp.applyChange(&binlogdatapb.RowChange{Before: before, After: after, BeforeMissingColumns: []int64{2}}, executor)
+	require.NoError(t, err)
+	_, err = tp.applyChange(&binlogdatapb.RowChange{Before: before, BeforeMissingColumns: []int64{2}}, executor)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"update t1 set c2='b', c3='c' where c1=1",
+		"delete from t1 where c1=1",
+	}, executed)
+
+	// A statement that needs a missing column fails.
+	_, err = tp.applyChange(&binlogdatapb.RowChange{Before: sqltypes.RowToProto3([]sqltypes.Value{sqltypes.NewVarChar("b")}), BeforeMissingColumns: []int64{0, 2}}, executor)
+	assert.Error(t, err)
+}
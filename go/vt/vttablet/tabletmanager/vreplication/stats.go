@@ -18,6 +18,7 @@ package vreplication
 
 import (
 	"fmt"
+	"math"
 	"sort"
 	"strings"
 	"sync"
@@ -304,6 +305,21 @@ func (st *vrStats) register() {
 			}
 			return result
 		})
+	stats.NewGaugesFuncWithMultiLabels(
+		"VReplicationConvertedValueCount",
+		"vreplication values converted to the charset of the target per stream, table and column",
+		[]string{"source_keyspace", "source_shard", "workflow", "counts", "table", "column"},
+		func() map[string]int64 {
+			st.mu.Lock()
+			defer st.mu.Unlock()
+			result := make(map[string]int64, len(st.controllers))
+			for _, ct := range st.controllers {
+				for label, count := range ct.blpStats.ConvertedValueCount.Counts() {
+					result[ct.source.Keyspace+"."+ct.source.Shard+"."+ct.workflow+"."+fmt.Sprintf("%v", ct.id)+"."+label] = count
+				}
+			}
+			return result
+		})
 	stats.NewGaugesFuncWithMultiLabels(
 		"VReplicationCopyRowCount",
 		"vreplication rows copied in copy phase per stream",
@@ -386,6 +402,98 @@ func (st *vrStats) register() {
 			return result
 		})
 
+	// The following stats aggregate the streams of each workflow.
+	stats.NewGaugesFuncWithMultiLabels(
+		"VReplicationWorkflowLagSecondsMax",
+		"Max vreplication seconds behind source across the streams of a workflow",
+		[]string{"workflow"},
+		func() map[string]int64 {
+			return st.perWorkflow(func(ct *controller) int64 { return ct.blpStats.ReplicationLagSeconds.Get() }, maxInt64)
+		})
+	stats.NewCountersFuncWithMultiLabels(
+		"VReplicationWorkflowEvents",
+		"Events received by the streams of a workflow",
+		[]string{"workflow"},
+		func() map[string]int64 {
+			return st.perWorkflow(func(ct *controller) int64 { return ct.blpStats.EventCounts.Counts()["Events"] }, sumInt64)
+		})
+	stats.NewCountersFuncWithMultiLabels(
+		"VReplicationWorkflowEventBytes",
+		"Bytes of the rows of the events received by the streams of a workflow",
+		[]string{"workflow"},
+		func() map[string]int64 {
+			return st.perWorkflow(func(ct *controller) int64 { return ct.blpStats.EventCounts.Counts()["Bytes"] }, sumInt64)
+		})
+	stats.NewGaugesFuncWithMultiLabels(
+		"VReplicationWorkflowEventsPerSecond",
+		"Events received per second by the streams of a workflow, over the last sampling interval",
+		[]string{"workflow"},
+		func() map[string]int64 {
+			return st.perWorkflow(func(ct *controller) int64 { return latestRate(ct.blpStats.EventRates, "Events") }, sumInt64)
+		})
+	stats.NewGaugesFuncWithMultiLabels(
+		"VReplicationWorkflowEventBytesPerSecond",
+		"Bytes of the rows of the events received per second by the streams of a workflow, over the last sampling interval",
+		[]string{"workflow"},
+		func() map[string]int64 {
+			return st.perWorkflow(func(ct *controller) int64 { return latestRate(ct.blpStats.EventRates, "Bytes") }, sumInt64)
+		})
+	stats.NewCountersFuncWithMultiLabels(
+		"VReplicationWorkflowRetryableErrors",
+		"Errors after which the streams of a workflow were retried",
+		[]string{"workflow"},
+		func() map[string]int64 {
+			return st.perWorkflow(func(ct *controller) int64 { return ct.blpStats.ErrorCounts.Counts()["Stream Error"] }, sumInt64)
+		})
+	stats.NewCountersFuncWithMultiLabels(
+		"VReplicationWorkflowFatalErrors",
+		"Errors that stopped the streams of a workflow",
+		[]string{"workflow"},
+		func() map[string]int64 {
+			return st.perWorkflow(func(ct *controller) int64 { return ct.blpStats.FatalErrorCount.Get() }, sumInt64)
+		})
+	stats.NewGaugesFuncWithMultiLabels(
+		"VReplicationWorkflowTablesToCopy",
+		"Tables left to copy in the copy phase of the streams of a workflow",
+		[]string{"workflow"},
+		func() map[string]int64 {
+			return st.perWorkflow(func(ct *controller) int64 { return ct.blpStats.TablesToCopy.Get() }, sumInt64)
+		})
+}
+
+// perWorkflow aggregates a value of the streams of each workflow.
+func (st *vrStats) perWorkflow(value func(ct *controller) int64, aggregate func(a, b int64) int64) map[string]int64 {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	result := make(map[string]int64)
+	for _, ct := range st.controllers {
+		if current, ok := result[ct.workflow]; ok {
+			result[ct.workflow] = aggregate(current, value(ct))
+		} else {
+			result[ct.workflow] = value(ct)
+		}
+	}
+	return result
+}
+
+func sumInt64(a, b int64) int64 {
+	return a + b
+}
+
+func maxInt64(a, b int64) int64 {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// latestRate returns the rate of a category over the last sampling interval.
+func latestRate(rates *stats.Rates, category string) int64 {
+	values := rates.Get()[category]
+	if len(values) == 0 {
+		return 0
+	}
+	return int64(math.Round(values[len(values)-1]))
 }
 
 func (st *vrStats) numControllers() int64 {
@@ -165,3 +165,44 @@ func TestVReplicationStats(t *testing.T) {
 	blpStats.RecordHeartbeat(tm)
 	require.Equal(t, tm, blpStats.Heartbeat())
 }
+
+func TestVReplicationWorkflowStats(t *testing.T) {
+	newController := func(id int, workflow string) *controller {
+		return &controller{
+			id:       uint32(id),
+			workflow: workflow,
+			source: &binlogdata.BinlogSource{
+				Keyspace: "ks",
+				Shard:    "0",
+			},
+			blpStats: binlogplayer.NewStats(),
+			done:     make(chan struct{}),
+		}
+	}
+	testStats := &vrStats{}
+	testStats.isOpen = true
+	testStats.controllers = map[int]*controller{
+		1: newController(1, "wf1"),
+		2: newController(2, "wf1"),
+		3: newController(3, "wf2"),
+	}
+	testStats.controllers[1].blpStats.ReplicationLagSeconds.Set(3)
+	testStats.controllers[2].blpStats.ReplicationLagSeconds.Set(7)
+	testStats.controllers[3].blpStats.ReplicationLagSeconds.Set(1)
+	testStats.controllers[1].blpStats.EventCounts.Add("Events", 10)
+	testStats.controllers[2].blpStats.EventCounts.Add("Events", 5)
+	testStats.controllers[2].blpStats.ErrorCounts.Add([]string{"Stream Error"}, 2)
+	testStats.controllers[3].blpStats.FatalErrorCount.Add(1)
+	testStats.controllers[1].blpStats.TablesToCopy.Set(2)
+
+	lag := func(ct *controller) int64 { return ct.blpStats.ReplicationLagSeconds.Get() }
+	require.Equal(t, map[string]int64{"wf1": 7, "wf2": 1}, testStats.perWorkflow(lag, maxInt64))
+	events := func(ct *controller) int64 { return ct.blpStats.EventCounts.Counts()["Events"] }
+	require.Equal(t, map[string]int64{"wf1": 15, "wf2": 0}, testStats.perWorkflow(events, sumInt64))
+	retryable := func(ct *controller) int64 { return ct.blpStats.ErrorCounts.Counts()["Stream Error"] }
+	require.Equal(t, map[string]int64{"wf1": 2, "wf2": 0}, testStats.perWorkflow(retryable, sumInt64))
+	fatal := func(ct *controller) int64 { return ct.blpStats.FatalErrorCount.Get() }
+	require.Equal(t, map[string]int64{"wf1": 0, "wf2": 1}, testStats.perWorkflow(fatal, sumInt64))
+	tablesToCopy := func(ct *controller) int64 { return ct.blpStats.TablesToCopy.Get() }
+	require.Equal(t, map[string]int64{"wf1": 2, "wf2": 0}, testStats.perWorkflow(tablesToCopy, sumInt64))
+}
@@ -59,6 +59,8 @@ const (
 	LogCopyEnd = "Ended Copy Phase"
 	// LogStateChange is used when the state of the stream changes
 	LogStateChange = "State Changed"
+	// LogDDL is used when a stream ignores, executes or submits a DDL of its source
+	LogDDL = "DDL"
 
 	// TODO: LogError is not used atm. Currently irrecoverable errors, resumable errors and informational messages
 	//  are all treated the same: the message column is updated and state left as Running.
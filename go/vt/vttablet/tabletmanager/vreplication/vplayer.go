@@ -32,6 +32,8 @@ import (
 
 	"vitess.io/vitess/go/vt/binlog/binlogplayer"
 	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/schema"
+	"vitess.io/vitess/go/vt/sqlparser"
 
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
 )
@@ -367,6 +369,10 @@ func (vp *vplayer) applyEvents(ctx context.Context, relay *relayLog) error {
 				return nil
 			}
 		}
+		for _, events := range items {
+			vp.vr.stats.EventCounts.Add("Events", int64(len(events)))
+			vp.vr.stats.EventCounts.Add("Bytes", int64(eventsSize(events)))
+		}
 		for i, events := range items {
 			for j, event := range events {
 				if event.Timestamp != 0 {
@@ -558,6 +564,9 @@ func (vp *vplayer) applyEvent(ctx context.Context, event *binlogdatapb.VEvent, m
 				return err
 			}
 			stats.Send(fmt.Sprintf("%v", event.Statement))
+			if err := vp.vr.insertLog(LogDDL, fmt.Sprintf("Executed DDL %s", event.Statement)); err != nil {
+				return err
+			}
 			posReached, err := vp.updatePos(event.Timestamp)
 			if err != nil {
 				return err
@@ -566,10 +575,33 @@ func (vp *vplayer) applyEvent(ctx context.Context, event *binlogdatapb.VEvent, m
 				return io.EOF
 			}
 		case binlogdatapb.OnDDLAction_EXEC_IGNORE:
+			message := fmt.Sprintf("Executed DDL %s", event.Statement)
 			if _, err := vp.vr.dbClient.ExecuteWithRetry(ctx, event.Statement); err != nil {
 				log.Infof("Ignoring error: %v for DDL: %s", err, event.Statement)
+				message = fmt.Sprintf("Ignored the failure of DDL %s: %v", event.Statement, err)
 			}
 			stats.Send(fmt.Sprintf("%v", event.Statement))
+			if err := vp.vr.insertLog(LogDDL, message); err != nil {
+				return err
+			}
+			posReached, err := vp.updatePos(event.Timestamp)
+			if err != nil {
+				return err
+			}
+			if posReached {
+				return io.EOF
+			}
+		case binlogdatapb.OnDDLAction_EXEC_ONLINE_DDL:
+			// As with EXEC, the migrations are submitted before the position
+			// is saved, and may be submitted twice after a partial failure.
+			uuids, err := vp.submitOnlineDDL(ctx, event.Statement)
+			if err != nil {
+				return err
+			}
+			stats.Send(fmt.Sprintf("%v", event.Statement))
+			if err := vp.vr.insertLog(LogDDL, fmt.Sprintf("Submitted DDL %s as online DDL migrations %s", event.Statement, strings.Join(uuids, ", "))); err != nil {
+				return err
+			}
 			posReached, err := vp.updatePos(event.Timestamp)
 			if err != nil {
 				return err
@@ -606,6 +638,7 @@ func (vp *vplayer) applyEvent(ctx context.Context, event *binlogdatapb.VEvent, m
 			switch {
 			case found && notFound:
 				// Some were found and some were not found. We can't handle this.
+				vp.vr.stats.FatalErrorCount.Add(1)
 				if err := vp.vr.setState(binlogplayer.BlpStopped, "unable to handle journal event: tables were partially matched"); err != nil {
 					return err
 				}
@@ -618,6 +651,7 @@ func (vp *vplayer) applyEvent(ctx context.Context, event *binlogdatapb.VEvent, m
 		}
 		log.Infof("Binlog event registering journal event %+v", event.Journal)
 		if err := vp.vr.vre.registerJournal(event.Journal, int(vp.vr.id)); err != nil {
+			vp.vr.stats.FatalErrorCount.Add(1)
 			if err := vp.vr.setState(binlogplayer.BlpStopped, err.Error()); err != nil {
 				return err
 			}
@@ -637,3 +671,43 @@ func (vp *vplayer) applyEvent(ctx context.Context, event *binlogdatapb.VEvent, m
 
 	return nil
 }
+
+// submitOnlineDDL submits a DDL of the source as online DDL migrations of the
+// target, with the strategy of -vreplication_on_ddl_strategy. It returns the
+// UUIDs of the migrations.
+func (vp *vplayer) submitOnlineDDL(ctx context.Context, sql string) ([]string, error) {
+	if vp.vr.vre == nil || vp.vr.vre.onlineDDL == nil {
+		return nil, fmt.Errorf("cannot submit DDL %s: online DDL is not available on this tablet", sql)
+	}
+	strategySetting, err := schema.ParseDDLStrategy(*onDDLStrategy)
+	if err != nil {
+		return nil, err
+	}
+	if strategySetting.Strategy.IsDirect() {
+		return nil, fmt.Errorf("cannot submit DDL %s: %v is not an online DDL strategy", sql, strategySetting.Strategy)
+	}
+	stmt, err := sqlparser.Parse(sql)
+	if err != nil {
+		return nil, err
+	}
+	ddlStmt, ok := stmt.(sqlparser.DDLStatement)
+	if !ok {
+		return nil, fmt.Errorf("cannot submit %s as an online DDL: not a DDL statement", sql)
+	}
+	onlineDDLs, err := schema.NewOnlineDDLs("", sql, ddlStmt, strategySetting, fmt.Sprintf("vreplication:%d", vp.vr.id))
+	if err != nil {
+		return nil, err
+	}
+	uuids := make([]string, 0, len(onlineDDLs))
+	for _, onlineDDL := range onlineDDLs {
+		migrationStmt, err := sqlparser.Parse(onlineDDL.SQL)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := vp.vr.vre.onlineDDL.SubmitMigration(ctx, migrationStmt); err != nil {
+			return nil, err
+		}
+		uuids = append(uuids, onlineDDL.UUID)
+	}
+	return uuids, nil
+}
@@ -37,6 +37,7 @@ import (
 
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/sqlparser"
 
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
 )
@@ -1599,6 +1600,46 @@ func TestPlayerDDL(t *testing.T) {
 		"/update _vt.vreplication set pos=",
 	})
 	cancel()
+
+	// EXEC_ONLINE_DDL submits the DDL as an online DDL migration instead of
+	// executing it.
+	submitter := &fakeOnlineDDLSubmitter{}
+	playerEngine.onlineDDL = submitter
+	defer func() { playerEngine.onlineDDL = nil }()
+	bls = &binlogdatapb.BinlogSource{
+		Keyspace: env.KeyspaceName,
+		Shard:    env.ShardName,
+		Filter:   filter,
+		OnDdl:    binlogdatapb.OnDDLAction_EXEC_ONLINE_DDL,
+	}
+	cancel, _ = startVReplication(t, bls, "")
+	execStatements(t, []string{"alter table t1 add column val3 varchar(128)"})
+	expectDBClientQueries(t, []string{
+		"/update _vt.vreplication set pos=",
+	})
+	cancel()
+	submitted := submitter.submitted()
+	require.Len(t, submitted, 1)
+	require.Contains(t, submitted[0], "add column val3 varchar(128)")
+	require.Contains(t, submitted[0], "/*vt+ uuid=")
+}
+
+type fakeOnlineDDLSubmitter struct {
+	mu         sync.Mutex
+	statements []string
+}
+
+func (f *fakeOnlineDDLSubmitter) SubmitMigration(ctx context.Context, stmt sqlparser.Statement) (*sqltypes.Result, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.statements = append(f.statements, sqlparser.String(stmt))
+	return &sqltypes.Result{}, nil
+}
+
+func (f *fakeOnlineDDLSubmitter) submitted() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]string(nil), f.statements...)
 }
 
 func TestGTIDCompress(t *testing.T) {
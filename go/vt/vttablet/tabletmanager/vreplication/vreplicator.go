@@ -38,6 +38,7 @@ import (
 	"vitess.io/vitess/go/vt/binlog/binlogplayer"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/mysqlctl"
+	"vitess.io/vitess/go/vt/schema"
 
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
 )
@@ -70,6 +71,8 @@ var (
 	vreplicationExperimentalFlagOptimizeInserts int64 = 1
 
 	vreplicationStoreCompressedGTID = flag.Bool("vreplication_store_compressed_gtid", false, "Store compressed gtids in the pos column of _vt.vreplication")
+
+	onDDLStrategy = flag.String("vreplication_on_ddl_strategy", string(schema.DDLStrategyOnline), "DDL strategy of the online DDL migrations that streams with on_ddl=EXEC_ONLINE_DDL submit for the DDLs of their source, e.g. 'online' or 'gh-ost'")
 )
 
 // vreplicator provides the core logic to start vreplication streams
@@ -182,6 +185,7 @@ func (vr *vreplicator) replicate(ctx context.Context) error {
 		if err != nil {
 			return err
 		}
+		vr.stats.TablesToCopy.Set(numTablesToCopy)
 		// If any of the operations below changed state to Stopped, we should return.
 		if settings.State == binlogplayer.BlpStopped {
 			return nil
@@ -201,6 +205,7 @@ func (vr *vreplicator) replicate(ctx context.Context) error {
 			if err != nil {
 				return err
 			}
+			vr.stats.TablesToCopy.Set(numTablesToCopy)
 			if numTablesToCopy == 0 {
 				if err := vr.insertLog(LogCopyEnd, fmt.Sprintf("Copy phase completed at gtid %s", settings.StartPos)); err != nil {
 					return err
@@ -0,0 +1,145 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletmanager
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+var (
+	warmUpQueries = flag.Int("warmup_queries", 0, "the number of recent queries of a peer tablet of the shard, from its /debug/warmup_queries, that a replica or rdonly tablet replays before it starts serving for the first time, to build their plans and load their data. The peer must run with -queryserver-config-warmup-sample-size. 0 disables the warm up.")
+	warmUpTimeout = flag.Duration("warmup_timeout", 2*time.Minute, "how long a tablet replays the queries of -warmup_queries at most before it starts serving")
+)
+
+// needsWarmUpLocked returns true if the tablet has to warm up before it
+// serves as a tablet of this type. Tablets warm up once, the first time
+// they would serve as a replica or rdonly.
+func (ts *tmState) needsWarmUpLocked(tabletType topodatapb.TabletType) bool {
+	if *warmUpQueries <= 0 || ts.hasWarmedUp {
+		return false
+	}
+	return tabletType == topodatapb.TabletType_REPLICA || tabletType == topodatapb.TabletType_RDONLY
+}
+
+// startWarmUpLocked makes the tablet not serve while it replays the recent
+// queries of a peer in the background. The state is updated again once the
+// warm up is done, or has failed.
+func (ts *tmState) startWarmUpLocked() {
+	ts.hasWarmedUp = true
+	ts.isWarmingUp = true
+	tablet := proto.Clone(ts.tablet).(*topodatapb.Tablet)
+	go func() {
+		ctx, cancel := context.WithTimeout(ts.ctx, *warmUpTimeout)
+		defer cancel()
+		if err := ts.tm.warmUp(ctx, tablet); err != nil {
+			log.Warningf("Can not warm up, starting to serve cold: %v", err)
+		}
+
+		ts.mu.Lock()
+		defer ts.mu.Unlock()
+		ts.isWarmingUp = false
+		ts.updateLocked(ts.ctx)
+	}()
+}
+
+// warmUp replays the recent queries of a peer of the tablet.
+func (tm *TabletManager) warmUp(ctx context.Context, tablet *topodatapb.Tablet) error {
+	peer, err := tm.warmUpPeer(ctx, tablet)
+	if err != nil {
+		return err
+	}
+	queries, err := fetchWarmUpQueries(ctx, peer)
+	if err != nil {
+		return fmt.Errorf("can not fetch the recent queries of %v: %v", topoproto.TabletAliasString(peer.Alias), err)
+	}
+	if len(queries) > *warmUpQueries {
+		queries = queries[:*warmUpQueries]
+	}
+	start := time.Now()
+	warmed := tm.QueryServiceControl.WarmUp(ctx, queries)
+	log.Infof("Warmed up with %d of the %d recent queries of %v in %v", warmed, len(queries), topoproto.TabletAliasString(peer.Alias), time.Since(start))
+	return nil
+}
+
+// warmUpPeer returns the tablet of the shard the recent queries are fetched
+// from: a tablet of the same type if possible, of the same cell if possible.
+func (tm *TabletManager) warmUpPeer(ctx context.Context, tablet *topodatapb.Tablet) (*topo.TabletInfo, error) {
+	tablets, err := tm.TopoServer.GetTabletMapForShard(ctx, tablet.Keyspace, tablet.Shard)
+	if err != nil {
+		return nil, err
+	}
+	var peer *topo.TabletInfo
+	peerScore := 0
+	for _, ti := range tablets {
+		if topoproto.TabletAliasEqual(ti.Alias, tablet.Alias) || !topo.IsRunningQueryService(ti.Type) {
+			continue
+		}
+		score := 1
+		if ti.Type == tablet.Type {
+			score += 2
+		}
+		if ti.Alias.Cell == tablet.Alias.Cell {
+			score++
+		}
+		if score > peerScore {
+			peer, peerScore = ti, score
+		}
+	}
+	if peer == nil {
+		return nil, fmt.Errorf("no serving peer in shard %v/%v", tablet.Keyspace, tablet.Shard)
+	}
+	return peer, nil
+}
+
+func fetchWarmUpQueries(ctx context.Context, peer *topo.TabletInfo) ([]*querypb.BoundQuery, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", "http://"+peer.Addr()+"/debug/warmup_queries", nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%v: %s", resp.Status, body)
+	}
+	var queries []*querypb.BoundQuery
+	if err := json.Unmarshal(body, &queries); err != nil {
+		return nil, err
+	}
+	return queries, nil
+}
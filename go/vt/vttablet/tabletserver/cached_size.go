@@ -23,7 +23,7 @@ func (cached *TabletPlan) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(128)
+		size += int64(168)
 	}
 	// field Plan *vitess.io/vitess/go/vt/vttablet/tabletserver/planbuilder.Plan
 	size += cached.Plan.CachedSize(true)
@@ -45,5 +45,7 @@ func (cached *TabletPlan) CachedSize(alloc bool) int64 {
 			size += elem.CachedSize(true)
 		}
 	}
+	// field fingerprint string
+	size += int64(len(cached.fingerprint))
 	return size
 }
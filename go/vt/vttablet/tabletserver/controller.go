@@ -26,6 +26,7 @@ import (
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/rules"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/throttle"
 	"vitess.io/vitess/go/vt/vttablet/vexec"
 
 	"time"
@@ -91,8 +92,23 @@ type Controller interface {
 	// BroadcastHealth sends the current health to all listeners
 	BroadcastHealth()
 
+	// WarmUp replays the queries before the tablet starts serving, and
+	// returns the number of queries that succeeded.
+	WarmUp(ctx context.Context, queries []*querypb.BoundQuery) int
+
+	// SetErrantGTIDs sets the errant GTIDs that the tablet publishes in its
+	// health, or clears them if gtids is empty.
+	SetErrantGTIDs(gtids string)
+
+	// KillQueries kills the in-flight queries of a fingerprint and of a user,
+	// and returns the number of killed queries.
+	KillQueries(fingerprint, user string) int
+
 	// TopoServer returns the topo server.
 	TopoServer() *topo.Server
+
+	// CheckThrottler runs a check of the tablet throttler for an app.
+	CheckThrottler(ctx context.Context, appName string, remoteAddr string, flags *throttle.CheckFlags, checkType throttle.ThrottleCheckType) *throttle.CheckResult
 }
 
 // Ensure TabletServer satisfies Controller interface.
@@ -110,6 +110,12 @@ func debugEnvHandler(tsv *TabletServer, w http.ResponseWriter, r *http.Request)
 			setDurationVal(tsv.sm.SetUnhealthyThreshold)
 		case "ThrottleMetricThreshold":
 			setFloat64Val(tsv.SetThrottleMetricThreshold)
+		case "HotRowProtectionMaxQueueSize":
+			setIntVal(tsv.SetHotRowProtectionMaxQueueSize)
+		case "HotRowProtectionMaxGlobalQueueSize":
+			setIntVal(tsv.SetHotRowProtectionMaxGlobalQueueSize)
+		case "HotRowProtectionConcurrentTransactions":
+			setIntVal(tsv.SetHotRowProtectionConcurrentTransactions)
 		case "Consolidator":
 			tsv.SetConsolidatorMode(value)
 			msg = fmt.Sprintf("Setting %v to: %v", varname, value)
@@ -143,6 +149,9 @@ func debugEnvHandler(tsv *TabletServer, w http.ResponseWriter, r *http.Request)
 	addIntVar("WarnResultSize", tsv.WarnResultSize)
 	addDurationVar("UnhealthyThreshold", tsv.Config().Healthcheck.UnhealthyThresholdSeconds.Get)
 	addFloat64Var("ThrottleMetricThreshold", tsv.ThrottleMetricThreshold)
+	addIntVar("HotRowProtectionMaxQueueSize", tsv.HotRowProtectionMaxQueueSize)
+	addIntVar("HotRowProtectionMaxGlobalQueueSize", tsv.HotRowProtectionMaxGlobalQueueSize)
+	addIntVar("HotRowProtectionConcurrentTransactions", tsv.HotRowProtectionConcurrentTransactions)
 	vars = append(vars, envValue{
 		VarName: "Consolidator",
 		Value:   tsv.ConsolidatorMode(),
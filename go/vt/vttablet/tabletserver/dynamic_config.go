@@ -0,0 +1,292 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"vitess.io/vitess/go/acl"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+var dynamicConfigRetryDelay = flag.Duration("dynamic_tablet_config_retry_delay", 30*time.Second, "with enable_dynamic_tablet_config, how long to wait before watching a DynamicTabletConfig again, after it was deleted, missing or the watch failed")
+
+const (
+	keyspaceDynamicConfig = "keyspace"
+	tabletDynamicConfig   = "tablet"
+)
+
+// dynamicConfig watches the DynamicTabletConfig objects of the keyspace and
+// of the tablet in the topo, and applies their settings to the TabletServer.
+// The settings of the tablet override the ones of the keyspace, which
+// override the ones of the flags.
+//
+// Only the settings which change are applied: a setting changed through
+// /debug/env is kept until the DynamicTabletConfig objects change it.
+// Like with /debug/env, the pool sizes cannot exceed the ones of the flags.
+type dynamicConfig struct {
+	tsv *TabletServer
+	// flags contains the settings of the flags. It is immutable.
+	flags *topodatapb.DynamicTabletConfig
+
+	reloads, errors *stats.CountersWithSingleLabel
+
+	mu     sync.Mutex
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+	// sources are the keyspace and tablet DynamicTabletConfig objects.
+	sources map[string]*dynamicConfigSource
+	// applied is the config last applied to the TabletServer.
+	applied *topodatapb.DynamicTabletConfig
+}
+
+// dynamicConfigSource is the last DynamicTabletConfig object read from the
+// topo for the keyspace or the tablet.
+type dynamicConfigSource struct {
+	// Config is nil if there is no object.
+	Config  *topodatapb.DynamicTabletConfig `json:"config,omitempty"`
+	Version string                          `json:"version,omitempty"`
+	// Error is the last error, e.g. if the object is invalid, in which case
+	// the previous valid object is still applied.
+	Error string `json:"error,omitempty"`
+}
+
+func newDynamicConfig(tsv *TabletServer) *dynamicConfig {
+	config := tsv.config
+	flags := &topodatapb.DynamicTabletConfig{
+		PoolSize:                  int32(config.OltpReadPool.Size),
+		StreamPoolSize:            int32(config.OlapReadPool.Size),
+		TransactionPoolSize:       int32(config.TxPool.Size),
+		QueryTimeoutSeconds:       float64(config.Oltp.QueryTimeoutSeconds),
+		TransactionTimeoutSeconds: float64(config.Oltp.TxTimeoutSeconds),
+		MaxResultSize:             int32(config.Oltp.MaxRows),
+		WarnResultSize:            int32(config.Oltp.WarnRows),
+		Consolidator:              config.Consolidator,
+	}
+	return &dynamicConfig{
+		tsv:     tsv,
+		flags:   flags,
+		reloads: tsv.exporter.NewCountersWithSingleLabel("DynamicTabletConfigReloads", "Number of DynamicTabletConfig objects read from the topo", "Source"),
+		errors:  tsv.exporter.NewCountersWithSingleLabel("DynamicTabletConfigErrors", "Number of DynamicTabletConfig objects which could not be read from the topo or were invalid", "Source"),
+		sources: map[string]*dynamicConfigSource{
+			keyspaceDynamicConfig: {},
+			tabletDynamicConfig:   {},
+		},
+		applied: proto.Clone(flags).(*topodatapb.DynamicTabletConfig),
+	}
+}
+
+// Open starts watching the DynamicTabletConfig objects of the keyspace and
+// of the tablet. It is a no-op if it is already watching.
+func (dc *dynamicConfig) Open(ts *topo.Server, keyspace string, alias *topodatapb.TabletAlias) {
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+	if dc.cancel != nil {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	dc.cancel = cancel
+	dc.wg.Add(2)
+	go dc.watch(ctx, keyspaceDynamicConfig, func(ctx context.Context) (*topo.WatchDynamicTabletConfigData, <-chan *topo.WatchDynamicTabletConfigData, topo.CancelFunc) {
+		return ts.WatchKeyspaceDynamicTabletConfig(ctx, keyspace)
+	})
+	go dc.watch(ctx, tabletDynamicConfig, func(ctx context.Context) (*topo.WatchDynamicTabletConfigData, <-chan *topo.WatchDynamicTabletConfigData, topo.CancelFunc) {
+		return ts.WatchTabletDynamicTabletConfig(ctx, alias)
+	})
+}
+
+// Close stops watching the DynamicTabletConfig objects. The settings
+// applied so far are kept.
+func (dc *dynamicConfig) Close() {
+	dc.mu.Lock()
+	cancel := dc.cancel
+	dc.cancel = nil
+	dc.mu.Unlock()
+	if cancel == nil {
+		return
+	}
+	cancel()
+	dc.wg.Wait()
+}
+
+func (dc *dynamicConfig) watch(ctx context.Context, source string, watch func(context.Context) (*topo.WatchDynamicTabletConfigData, <-chan *topo.WatchDynamicTabletConfigData, topo.CancelFunc)) {
+	defer dc.wg.Done()
+	for {
+		current, changes, cancel := watch(ctx)
+		dc.update(source, current)
+		if current.Err == nil {
+			// The topo watch is not interrupted by ctx: cancel it on Close,
+			// which makes it send an Interrupted error and close changes.
+			done := make(chan struct{})
+			go func() {
+				select {
+				case <-ctx.Done():
+					cancel()
+				case <-done:
+				}
+			}()
+			for wd := range changes {
+				dc.update(source, wd)
+			}
+			close(done)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(*dynamicConfigRetryDelay):
+		}
+	}
+}
+
+// update records a DynamicTabletConfig object read from the topo, and
+// applies the resulting settings.
+func (dc *dynamicConfig) update(source string, wd *topo.WatchDynamicTabletConfigData) {
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+
+	src := dc.sources[source]
+	switch {
+	case topo.IsErrType(wd.Err, topo.Interrupted):
+		// Closed.
+		return
+	case topo.IsErrType(wd.Err, topo.NoNode):
+		if src.Config != nil {
+			log.Infof("The DynamicTabletConfig of the %v was deleted", source)
+		}
+		*src = dynamicConfigSource{}
+	case wd.Err != nil:
+		log.Warningf("Cannot watch the DynamicTabletConfig of the %v: %v", source, wd.Err)
+		dc.errors.Add(source, 1)
+		src.Error = wd.Err.Error()
+		return
+	default:
+		dc.reloads.Add(source, 1)
+		if err := tabletenv.VerifyDynamicTabletConfig(wd.Value); err != nil {
+			log.Errorf("Ignoring the invalid DynamicTabletConfig of the %v, version %v: %v", source, wd.Version, err)
+			dc.errors.Add(source, 1)
+			src.Error = fmt.Sprintf("version %v: %v", wd.Version, err)
+			return
+		}
+		log.Infof("Applying the DynamicTabletConfig of the %v, version %v: %v", source, wd.Version, wd.Value)
+		*src = dynamicConfigSource{Config: wd.Value, Version: wd.Version.String()}
+	}
+	dc.applyLocked()
+}
+
+// merge overrides the settings of config with the ones set in the source.
+func (src *dynamicConfigSource) merge(config *topodatapb.DynamicTabletConfig) {
+	if src.Config != nil {
+		// The unset fields are the zero values, which Merge skips.
+		proto.Merge(config, src.Config)
+	}
+}
+
+// applyLocked applies the settings of the flags, overridden by the ones of
+// the keyspace and of the tablet.
+func (dc *dynamicConfig) applyLocked() {
+	config := proto.Clone(dc.flags).(*topodatapb.DynamicTabletConfig)
+	dc.sources[keyspaceDynamicConfig].merge(config)
+	dc.sources[tabletDynamicConfig].merge(config)
+
+	tsv, applied := dc.tsv, dc.applied
+	if config.PoolSize != applied.PoolSize {
+		tsv.SetPoolSize(int(config.PoolSize))
+	}
+	if config.StreamPoolSize != applied.StreamPoolSize {
+		tsv.SetStreamPoolSize(int(config.StreamPoolSize))
+	}
+	if config.TransactionPoolSize != applied.TransactionPoolSize {
+		tsv.SetTxPoolSize(int(config.TransactionPoolSize))
+	}
+	if config.QueryTimeoutSeconds != applied.QueryTimeoutSeconds {
+		tsv.QueryTimeout.Set(tabletenv.Seconds(config.QueryTimeoutSeconds).Get())
+	}
+	if config.TransactionTimeoutSeconds != applied.TransactionTimeoutSeconds {
+		tsv.SetTxTimeout(tabletenv.Seconds(config.TransactionTimeoutSeconds).Get())
+	}
+	if config.MaxResultSize != applied.MaxResultSize {
+		tsv.SetMaxResultSize(int(config.MaxResultSize))
+	}
+	if config.WarnResultSize != applied.WarnResultSize {
+		tsv.SetWarnResultSize(int(config.WarnResultSize))
+	}
+	if config.Consolidator != applied.Consolidator {
+		tsv.SetConsolidatorMode(config.Consolidator)
+	}
+	dc.applied = config
+}
+
+// current returns the settings currently in use by the TabletServer.
+func (dc *dynamicConfig) current() *topodatapb.DynamicTabletConfig {
+	tsv := dc.tsv
+	return &topodatapb.DynamicTabletConfig{
+		PoolSize:                  int32(tsv.PoolSize()),
+		StreamPoolSize:            int32(tsv.StreamPoolSize()),
+		TransactionPoolSize:       int32(tsv.TxPoolSize()),
+		QueryTimeoutSeconds:       tsv.QueryTimeout.Get().Seconds(),
+		TransactionTimeoutSeconds: tsv.TxTimeout().Seconds(),
+		MaxResultSize:             int32(tsv.MaxResultSize()),
+		WarnResultSize:            int32(tsv.WarnResultSize()),
+		Consolidator:              tsv.ConsolidatorMode(),
+	}
+}
+
+// ServeHTTP shows the settings of the flags, of the DynamicTabletConfig
+// objects, and the effective ones.
+func (dc *dynamicConfig) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if err := acl.CheckAccessHTTP(r, acl.DEBUGGING); err != nil {
+		acl.SendError(w, err)
+		return
+	}
+
+	dc.mu.Lock()
+	status := struct {
+		Enabled   bool                            `json:"enabled"`
+		Flags     *topodatapb.DynamicTabletConfig `json:"flags"`
+		Keyspace  dynamicConfigSource             `json:"keyspace"`
+		Tablet    dynamicConfigSource             `json:"tablet"`
+		Effective *topodatapb.DynamicTabletConfig `json:"effective"`
+	}{
+		Enabled:   dc.cancel != nil,
+		Flags:     dc.flags,
+		Keyspace:  *dc.sources[keyspaceDynamicConfig],
+		Tablet:    *dc.sources[tabletDynamicConfig],
+		Effective: dc.current(),
+	}
+	b, err := json.MarshalIndent(status, "", "  ")
+	dc.mu.Unlock()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(b)
+}
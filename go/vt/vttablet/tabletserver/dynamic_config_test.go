@@ -0,0 +1,125 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func TestDynamicConfig(t *testing.T) {
+	defer func(delay time.Duration) { *dynamicConfigRetryDelay = delay }(*dynamicConfigRetryDelay)
+	*dynamicConfigRetryDelay = 10 * time.Millisecond
+
+	ctx := context.Background()
+	config := tabletenv.NewDefaultConfig()
+	config.OltpReadPool.Size = 16
+	config.Oltp.QueryTimeoutSeconds = 30
+	db, tsv := setupTabletServerTestCustom(t, config, "ks")
+	defer db.Close()
+	defer tsv.StopService()
+	ts, alias := tsv.topoServer, &topodatapb.TabletAlias{Uid: 100}
+	dc := tsv.dynamicConfig
+	dc.reloads.ResetAll()
+	dc.errors.ResetAll()
+	dc.Open(ts, "ks", alias)
+	defer dc.Close()
+
+	// The keyspace config overrides the flags, and the tablet config
+	// overrides the keyspace config.
+	_, err := ts.UpdateKeyspaceDynamicTabletConfig(ctx, "ks", &topodatapb.DynamicTabletConfig{PoolSize: 10, QueryTimeoutSeconds: 10}, nil)
+	require.NoError(t, err)
+	_, err = ts.UpdateTabletDynamicTabletConfig(ctx, alias, &topodatapb.DynamicTabletConfig{PoolSize: 5, Consolidator: tabletenv.Disable}, nil)
+	require.NoError(t, err)
+	assert.Eventually(t, func() bool {
+		return tsv.PoolSize() == 5 && tsv.QueryTimeout.Get() == 10*time.Second && tsv.ConsolidatorMode() == tabletenv.Disable
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// An invalid config is not applied.
+	_, err = ts.UpdateTabletDynamicTabletConfig(ctx, alias, &topodatapb.DynamicTabletConfig{PoolSize: -1}, nil)
+	require.NoError(t, err)
+	assert.Eventually(t, func() bool {
+		return dc.errors.Counts()[tabletDynamicConfig] == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, 5, tsv.PoolSize())
+
+	// Deleting the configs restores the flags.
+	require.NoError(t, ts.DeleteTabletDynamicTabletConfig(ctx, alias))
+	assert.Eventually(t, func() bool {
+		return tsv.PoolSize() == 10 && tsv.ConsolidatorMode() == tabletenv.Enable
+	}, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, ts.DeleteKeyspaceDynamicTabletConfig(ctx, "ks"))
+	assert.Eventually(t, func() bool {
+		return tsv.PoolSize() == 16 && tsv.QueryTimeout.Get() == 30*time.Second
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// And a new config is picked up once the watch is retried.
+	_, err = ts.UpdateTabletDynamicTabletConfig(ctx, alias, &topodatapb.DynamicTabletConfig{MaxResultSize: 100}, nil)
+	require.NoError(t, err)
+	assert.Eventually(t, func() bool {
+		return tsv.MaxResultSize() == 100
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestDynamicConfigServeHTTP(t *testing.T) {
+	ctx := context.Background()
+	config := tabletenv.NewDefaultConfig()
+	config.OltpReadPool.Size = 16
+	db, tsv := setupTabletServerTestCustom(t, config, "ks")
+	defer db.Close()
+	defer tsv.StopService()
+	ts, alias := tsv.topoServer, &topodatapb.TabletAlias{Uid: 100}
+	dc := tsv.dynamicConfig
+	_, err := ts.UpdateKeyspaceDynamicTabletConfig(ctx, "ks", &topodatapb.DynamicTabletConfig{PoolSize: 10}, nil)
+	require.NoError(t, err)
+	dc.Open(ts, "ks", alias)
+	defer dc.Close()
+	assert.Eventually(t, func() bool {
+		return tsv.PoolSize() == 10
+	}, 5*time.Second, 10*time.Millisecond)
+
+	rec := httptest.NewRecorder()
+	dc.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/config", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var status struct {
+		Enabled  bool
+		Flags    *topodatapb.DynamicTabletConfig
+		Keyspace struct {
+			Config  *topodatapb.DynamicTabletConfig
+			Version string
+		}
+		Effective *topodatapb.DynamicTabletConfig
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &status))
+	assert.True(t, status.Enabled)
+	assert.EqualValues(t, 16, status.Flags.PoolSize)
+	assert.EqualValues(t, 10, status.Keyspace.Config.PoolSize)
+	assert.NotEmpty(t, status.Keyspace.Version)
+	assert.EqualValues(t, 10, status.Effective.PoolSize)
+	assert.Equal(t, tabletenv.Enable, status.Effective.Consolidator)
+}
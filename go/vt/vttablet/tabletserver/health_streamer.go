@@ -78,6 +78,11 @@ type healthStreamer struct {
 	conns                  *connpool.Pool
 	initSuccess            bool
 	signalWhenSchemaChange bool
+
+	// statisticsInterval is the interval at which the reloads sample the
+	// table statistics, statisticsSampled the time of the last sample.
+	statisticsInterval time.Duration
+	statisticsSampled  time.Time
 }
 
 func newHealthStreamer(env tabletenv.Env, alias *topodatapb.TabletAlias) *healthStreamer {
@@ -110,6 +115,7 @@ func newHealthStreamer(env tabletenv.Env, alias *topodatapb.TabletAlias) *health
 		ticks:                  newTimer,
 		conns:                  pool,
 		signalWhenSchemaChange: env.Config().SignalWhenSchemaChange,
+		statisticsInterval:     env.Config().TableStatisticsIntervalSeconds.Get(),
 	}
 }
 
@@ -291,10 +297,31 @@ func (hs *healthStreamer) AppendDetails(details []*kv) []*kv {
 			Value: hs.state.RealtimeStats.HealthError,
 		})
 	}
+	if hs.state.RealtimeStats.ErrantGtids != "" {
+		details = append(details, &kv{
+			Key:   "Errant GTIDs",
+			Class: unhappyClass,
+			Value: hs.state.RealtimeStats.ErrantGtids,
+		})
+	}
 
 	return details
 }
 
+// SetErrantGTIDs sets the errant GTIDs of the tablet, and broadcasts them
+// if they changed.
+func (hs *healthStreamer) SetErrantGTIDs(gtids string) {
+	hs.mu.Lock()
+	defer hs.mu.Unlock()
+
+	if hs.state.RealtimeStats.ErrantGtids == gtids {
+		return
+	}
+	hs.state.RealtimeStats.ErrantGtids = gtids
+	shr := proto.Clone(hs.state).(*querypb.StreamHealthResponse)
+	hs.broadCastToClients(shr)
+}
+
 func (hs *healthStreamer) SetUnhealthyThreshold(v time.Duration) {
 	hs.unhealthyThreshold.Set(v)
 	shr := proto.Clone(hs.state).(*querypb.StreamHealthResponse)
@@ -332,6 +359,35 @@ func (hs *healthStreamer) reload() error {
 		}
 	}
 
+	tables, err := hs.reloadSchemaCopyLocked(ctx, conn)
+	if err != nil {
+		return err
+	}
+	statisticsTables, err := hs.sampleTableStatisticsLocked(ctx, conn)
+	if err != nil {
+		// The statistics only tune the plans, they must not hold back
+		// the schema changes.
+		log.Errorf("table statistics sampling failed in health stream: %v", err)
+	}
+
+	// If no change detected, then there is nothing to signal
+	if len(tables) == 0 && len(statisticsTables) == 0 {
+		return nil
+	}
+
+	hs.state.RealtimeStats.TableSchemaChanged = tables
+	hs.state.RealtimeStats.TableStatisticsChanged = statisticsTables
+	shr := proto.Clone(hs.state).(*querypb.StreamHealthResponse)
+	hs.broadCastToClients(shr)
+	hs.state.RealtimeStats.TableSchemaChanged = nil
+	hs.state.RealtimeStats.TableStatisticsChanged = nil
+
+	return nil
+}
+
+// reloadSchemaCopyLocked updates the copy of the schema of the tables that
+// changed, and returns them.
+func (hs *healthStreamer) reloadSchemaCopyLocked(ctx context.Context, conn *connpool.DBConn) ([]string, error) {
 	var tables []string
 	var tableNames []string
 
@@ -348,14 +404,14 @@ func (hs *healthStreamer) reload() error {
 	}
 	alloc := func() *sqltypes.Result { return &sqltypes.Result{} }
 	bufferSize := 1000
-	err = conn.Stream(ctx, mysql.DetectSchemaChange, callback, alloc, bufferSize, 0)
+	err := conn.Stream(ctx, mysql.DetectSchemaChange, callback, alloc, bufferSize, 0)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// If no change detected, then return
 	if len(tables) == 0 {
-		return nil
+		return nil, nil
 	}
 
 	tableNamePredicate := fmt.Sprintf("table_name IN (%s)", strings.Join(tableNames, ", "))
@@ -365,31 +421,26 @@ func (hs *healthStreamer) reload() error {
 	// Reload the schema in a transaction.
 	_, err = conn.Exec(ctx, "begin", 1, false)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	defer conn.Exec(ctx, "rollback", 1, false)
 
 	_, err = conn.Exec(ctx, del, 1, false)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	_, err = conn.Exec(ctx, upd, 1, false)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	_, err = conn.Exec(ctx, "commit", 1, false)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	hs.state.RealtimeStats.TableSchemaChanged = tables
-	shr := proto.Clone(hs.state).(*querypb.StreamHealthResponse)
-	hs.broadCastToClients(shr)
-	hs.state.RealtimeStats.TableSchemaChanged = nil
-
-	return nil
+	return tables, nil
 }
 
 func (hs *healthStreamer) InitSchemaLocked(conn *connpool.DBConn) (bool, error) {
@@ -24,6 +24,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/mysql/fakesqldb"
@@ -171,6 +172,7 @@ func TestReloadSchema(t *testing.T) {
 
 	db.AddQuery(mysql.CreateVTDatabase, &sqltypes.Result{})
 	db.AddQuery(mysql.CreateSchemaCopyTable, &sqltypes.Result{})
+	db.AddQuery(mysql.CreateTableStatisticsTable, &sqltypes.Result{})
 	db.AddQueryPattern(mysql.ClearSchemaCopy+".*", &sqltypes.Result{})
 	db.AddQueryPattern(mysql.InsertIntoSchemaCopy+".*", &sqltypes.Result{})
 	db.AddQuery("begin", &sqltypes.Result{})
@@ -284,6 +286,7 @@ func TestInitialReloadSchema(t *testing.T) {
 
 	db.AddQuery(mysql.CreateVTDatabase, &sqltypes.Result{})
 	db.AddQuery(mysql.CreateSchemaCopyTable, &sqltypes.Result{})
+	db.AddQuery(mysql.CreateTableStatisticsTable, &sqltypes.Result{})
 	db.AddQueryPattern(mysql.ClearSchemaCopy+".*", &sqltypes.Result{})
 	db.AddQueryPattern(mysql.InsertIntoSchemaCopy+".*", &sqltypes.Result{})
 	db.AddQuery("begin", &sqltypes.Result{})
@@ -326,6 +329,66 @@ func TestInitialReloadSchema(t *testing.T) {
 	}
 }
 
+func TestReloadTableStatistics(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	config := newConfig(db)
+	config.SignalSchemaChangeReloadIntervalSeconds.Set(1 * time.Minute)
+	config.SignalWhenSchemaChange = true
+	config.TableStatisticsIntervalSeconds.Set(1 * time.Minute)
+
+	env := tabletenv.NewEnv(config, "ReplTrackerTest")
+	alias := &topodatapb.TabletAlias{
+		Cell: "cell",
+		Uid:  1,
+	}
+	blpFunc = testBlpFunc
+	hs := newHealthStreamer(env, alias)
+
+	target := &querypb.Target{TabletType: topodatapb.TabletType_PRIMARY}
+	configs := config.DB
+
+	statisticsFields := sqltypes.MakeTestFields("table_name|column_name|row_count|cardinality", "varchar|varchar|uint64|uint64")
+	db.AddQuery(mysql.CreateVTDatabase, &sqltypes.Result{})
+	db.AddQuery(mysql.CreateSchemaCopyTable, &sqltypes.Result{})
+	db.AddQuery(mysql.CreateTableStatisticsTable, &sqltypes.Result{})
+	db.AddQuery(mysql.DetectSchemaChange, &sqltypes.Result{})
+	db.AddQuery("begin", &sqltypes.Result{})
+	db.AddQuery("commit", &sqltypes.Result{})
+	db.AddQuery("rollback", &sqltypes.Result{})
+	// users moved a little, product is new and orders was dropped.
+	db.AddQuery(mysql.SampleTableStatistics, sqltypes.MakeTestResult(statisticsFields,
+		"users||1050|1050",
+		"users|id|1050|1050",
+		"product||10|10",
+		"product|id|10|10",
+	))
+	db.AddQuery(mysql.FetchTableStatistics, sqltypes.MakeTestResult(statisticsFields,
+		"users||1000|1000",
+		"users|id|1000|1000",
+		"orders||5|5",
+	))
+	db.AddQuery(mysql.ClearTableStatistics+" AND table_name IN ('orders', 'product')", &sqltypes.Result{})
+	db.AddQuery(mysql.InsertIntoTableStatistics+"(database(), 'product', '', 10, 10), (database(), 'product', 'id', 10, 10)", &sqltypes.Result{})
+
+	hs.InitDBConfig(target, configs.DbaWithDB())
+	hs.Open()
+	defer hs.Close()
+	ch, cancel := testStream(hs)
+	defer cancel()
+	<-ch
+
+	require.NoError(t, hs.reload())
+	shr := <-ch
+	assert.Empty(t, shr.RealtimeStats.TableSchemaChanged)
+	assert.Equal(t, []string{"orders", "product"}, shr.RealtimeStats.TableStatisticsChanged)
+
+	// The tables are only sampled once per interval.
+	db.ResetQueryLog()
+	require.NoError(t, hs.reload())
+	assert.NotContains(t, db.QueryLog(), "information_schema.statistics")
+}
+
 func testStream(hs *healthStreamer) (<-chan *querypb.StreamHealthResponse, context.CancelFunc) {
 	ctx, cancel := context.WithCancel(context.Background())
 	ch := make(chan *querypb.StreamHealthResponse)
@@ -0,0 +1,172 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"vitess.io/vitess/go/protoutil"
+	"vitess.io/vitess/go/vt/callerid"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// keyspaceQueryRuleSource is the query rule source of the query rules of the
+// SrvKeyspace.
+const keyspaceQueryRuleSource = "KEYSPACE_QUERY_RULES"
+
+// keyspacePolicyRetryDelay is how long to wait before watching the SrvKeyspace
+// again, after the watch failed.
+var keyspacePolicyRetryDelay = 30 * time.Second
+
+// keyspacePolicy watches the KeyspacePolicy of the SrvKeyspace of the tablet
+// cell. The TabletServer caps the timeout and the row count of the queries
+// with it, unless the session skips it.
+type keyspacePolicy struct {
+	mu         sync.Mutex
+	policy     *topodatapb.KeyspacePolicy
+	queryRules []*topodatapb.QueryRule
+	opened     bool
+
+	// onQueryRules, if set, is called with the query rules of the
+	// SrvKeyspace every time they change.
+	onQueryRules func([]*topodatapb.QueryRule)
+}
+
+// Open starts watching the policy of the keyspace. It is a no-op if it is
+// already watching.
+func (kp *keyspacePolicy) Open(ts *topo.Server, cell, keyspace string) {
+	kp.mu.Lock()
+	defer kp.mu.Unlock()
+	if kp.opened {
+		return
+	}
+	kp.opened = true
+	go kp.watch(context.Background(), ts, cell, keyspace)
+}
+
+func (kp *keyspacePolicy) watch(ctx context.Context, ts *topo.Server, cell, keyspace string) {
+	for {
+		current, changes, cancel := ts.WatchSrvKeyspace(ctx, cell, keyspace)
+		switch {
+		case current.Err == nil:
+			kp.set(current.Value)
+			for wd := range changes {
+				if wd.Err != nil {
+					if topo.IsErrType(wd.Err, topo.NoNode) {
+						kp.set(nil)
+					}
+					break
+				}
+				kp.set(wd.Value)
+			}
+			cancel()
+		case topo.IsErrType(current.Err, topo.NoNode):
+			kp.set(nil)
+		default:
+			log.Warningf("Cannot watch the SrvKeyspace of keyspace %v for its policy: %v", keyspace, current.Err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(keyspacePolicyRetryDelay):
+		}
+	}
+}
+
+func (kp *keyspacePolicy) set(srvKeyspace *topodatapb.SrvKeyspace) {
+	policy := srvKeyspace.GetPolicy()
+	queryRules := srvKeyspace.GetQueryRules()
+
+	kp.mu.Lock()
+	if !proto.Equal(policy, kp.policy) {
+		log.Infof("Applying keyspace policy: %v", policy)
+		kp.policy = policy
+	}
+	changed := !queryRulesEqual(queryRules, kp.queryRules)
+	kp.queryRules = queryRules
+	onQueryRules := kp.onQueryRules
+	kp.mu.Unlock()
+
+	if changed && onQueryRules != nil {
+		onQueryRules(queryRules)
+	}
+}
+
+func queryRulesEqual(left, right []*topodatapb.QueryRule) bool {
+	if len(left) != len(right) {
+		return false
+	}
+	for i := range left {
+		if !proto.Equal(left[i], right[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// get returns the policy applying to a query, which may be nil.
+func (kp *keyspacePolicy) get(options *querypb.ExecuteOptions) *topodatapb.KeyspacePolicy {
+	if options.GetSkipKeyspacePolicy() {
+		return nil
+	}
+	kp.mu.Lock()
+	defer kp.mu.Unlock()
+	return kp.policy
+}
+
+// checkDenied returns an error if the policy denies the queries of the
+// fingerprint from the immediate caller of the context.
+func (kp *keyspacePolicy) checkDenied(ctx context.Context, fingerprint string, options *querypb.ExecuteOptions) error {
+	policy := kp.get(options)
+	if len(policy.GetDeniedQueries()) == 0 {
+		return nil
+	}
+	user := callerid.GetUsername(callerid.ImmediateCallerIDFromContext(ctx))
+	if denied := topoproto.KeyspacePolicyDeniedQuery(policy, fingerprint, user, time.Now()); denied != nil {
+		return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "query denied by the keyspace policy until %v", protoutil.TimeFromProto(denied.ExpireTime).UTC().Format(time.RFC3339))
+	}
+	return nil
+}
+
+// queryTimeout caps the timeout of a query with the policy.
+func (kp *keyspacePolicy) queryTimeout(timeout time.Duration, options *querypb.ExecuteOptions) time.Duration {
+	policy := kp.get(options)
+	if policy.GetQueryTimeoutMs() == 0 {
+		return timeout
+	}
+	return smallerTimeout(timeout, time.Duration(policy.QueryTimeoutMs)*time.Millisecond)
+}
+
+// maxRows caps the row count limit of a query with the policy.
+func (kp *keyspacePolicy) maxRows(maxRows int64, options *querypb.ExecuteOptions) int64 {
+	policy := kp.get(options)
+	if policy.GetMaxRows() == 0 || (maxRows > 0 && policy.MaxRows >= uint64(maxRows)) {
+		return maxRows
+	}
+	return int64(policy.MaxRows)
+}
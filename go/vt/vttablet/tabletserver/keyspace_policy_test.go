@@ -0,0 +1,137 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/protoutil"
+	"vitess.io/vitess/go/test/utils"
+	"vitess.io/vitess/go/vt/callerid"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func TestKeyspacePolicy(t *testing.T) {
+	defer func(delay time.Duration) { keyspacePolicyRetryDelay = delay }(keyspacePolicyRetryDelay)
+	keyspacePolicyRetryDelay = 10 * time.Millisecond
+
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1")
+	kp := &keyspacePolicy{}
+	kp.Open(ts, "cell1", "ks")
+
+	// Without a policy, the limits are unchanged.
+	assert.Equal(t, 30*time.Second, kp.queryTimeout(30*time.Second, nil))
+	assert.EqualValues(t, 10000, kp.maxRows(10000, nil))
+
+	require.NoError(t, ts.UpdateSrvKeyspace(ctx, "cell1", "ks", &topodatapb.SrvKeyspace{
+		Policy: &topodatapb.KeyspacePolicy{QueryTimeoutMs: 2000, MaxRows: 100},
+	}))
+	assert.Eventually(t, func() bool {
+		return kp.get(nil) != nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// The policy only lowers the limits.
+	assert.Equal(t, 2*time.Second, kp.queryTimeout(30*time.Second, nil))
+	assert.Equal(t, time.Second, kp.queryTimeout(time.Second, nil))
+	assert.Equal(t, 2*time.Second, kp.queryTimeout(0, nil))
+	assert.EqualValues(t, 100, kp.maxRows(10000, nil))
+	assert.EqualValues(t, 10, kp.maxRows(10, nil))
+
+	// The exempt sessions skip it.
+	skip := &querypb.ExecuteOptions{SkipKeyspacePolicy: true}
+	assert.Equal(t, 30*time.Second, kp.queryTimeout(30*time.Second, skip))
+	assert.EqualValues(t, 10000, kp.maxRows(10000, skip))
+
+	// Deleting the SrvKeyspace removes the policy, and the watch is retried.
+	require.NoError(t, ts.DeleteSrvKeyspace(ctx, "cell1", "ks"))
+	assert.Eventually(t, func() bool {
+		return kp.get(nil) == nil
+	}, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, ts.UpdateSrvKeyspace(ctx, "cell1", "ks", &topodatapb.SrvKeyspace{
+		Policy: &topodatapb.KeyspacePolicy{MaxRows: 5},
+	}))
+	assert.Eventually(t, func() bool {
+		return kp.maxRows(10000, nil) == 5
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, 30*time.Second, kp.queryTimeout(30*time.Second, nil))
+}
+
+func TestKeyspacePolicyDeniedQueries(t *testing.T) {
+	kp := &keyspacePolicy{}
+	kp.set(&topodatapb.SrvKeyspace{Policy: &topodatapb.KeyspacePolicy{
+		DeniedQueries: []*topodatapb.DeniedQuery{{
+			Fingerprint: "f1",
+			ExpireTime:  protoutil.TimeToProto(time.Now().Add(time.Hour)),
+		}, {
+			User:       "batch",
+			ExpireTime: protoutil.TimeToProto(time.Now().Add(time.Hour)),
+		}, {
+			Fingerprint: "f2",
+			ExpireTime:  protoutil.TimeToProto(time.Now().Add(-time.Second)),
+		}},
+	}})
+	ctx := callerid.NewContext(context.Background(), nil, callerid.NewImmediateCallerID("app"))
+	batchCtx := callerid.NewContext(context.Background(), nil, callerid.NewImmediateCallerID("batch"))
+
+	assert.Error(t, kp.checkDenied(ctx, "f1", nil))
+	assert.Error(t, kp.checkDenied(batchCtx, "f3", nil))
+	// The expired denials do not apply.
+	assert.NoError(t, kp.checkDenied(ctx, "f2", nil))
+	assert.NoError(t, kp.checkDenied(ctx, "f3", nil))
+	// The exempt sessions skip them.
+	assert.NoError(t, kp.checkDenied(ctx, "f1", &querypb.ExecuteOptions{SkipKeyspacePolicy: true}))
+}
+
+func TestKeyspacePolicyQueryRules(t *testing.T) {
+	var mu sync.Mutex
+	var applied [][]*topodatapb.QueryRule
+	kp := &keyspacePolicy{onQueryRules: func(queryRules []*topodatapb.QueryRule) {
+		mu.Lock()
+		defer mu.Unlock()
+		applied = append(applied, queryRules)
+	}}
+	appliedCount := func() int {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(applied)
+	}
+
+	queryRules := []*topodatapb.QueryRule{{Name: "deny", Query: "select.*", Action: "FAIL"}}
+	kp.set(&topodatapb.SrvKeyspace{QueryRules: queryRules})
+	require.Equal(t, 1, appliedCount())
+	utils.MustMatch(t, queryRules, applied[0])
+
+	// The rules are only applied again when they change.
+	kp.set(&topodatapb.SrvKeyspace{
+		Policy:     &topodatapb.KeyspacePolicy{MaxRows: 5},
+		QueryRules: []*topodatapb.QueryRule{{Name: "deny", Query: "select.*", Action: "FAIL"}},
+	})
+	assert.Equal(t, 1, appliedCount())
+	kp.set(nil)
+	require.Equal(t, 2, appliedCount())
+	assert.Empty(t, applied[1])
+}
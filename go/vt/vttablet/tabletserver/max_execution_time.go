@@ -0,0 +1,70 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vterrors"
+	p "vitess.io/vitess/go/vt/vttablet/tabletserver/planbuilder"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// addMaxExecutionTimeHint adds a MAX_EXECUTION_TIME optimizer hint to a
+// select, so that MySQL aborts it when the budget of its request runs out.
+// The budget is the one of the session, set with max_execution_time, and
+// what remains of it is the time left until the deadline of the request.
+// Once the deadline passes, the query is killed as for any other timeout.
+func (qre *QueryExecutor) addMaxExecutionTimeHint(query string) string {
+	if qre.options.GetMaxExecutionTimeMs() == 0 {
+		return query
+	}
+	switch qre.plan.PlanID {
+	case p.PlanSelect, p.PlanSelectImpossible, p.PlanSelectStream:
+	default:
+		return query
+	}
+	deadline, ok := qre.ctx.Deadline()
+	if !ok || !strings.HasPrefix(query, "select ") || strings.HasPrefix(query, "select /*+") {
+		return query
+	}
+	remaining := time.Until(deadline).Milliseconds()
+	if remaining < 1 {
+		remaining = 1
+	}
+	return fmt.Sprintf("select /*+ MAX_EXECUTION_TIME(%d) */ %s", remaining, query[len("select "):])
+}
+
+// maxExecutionTimeError amends the error of a query that ran out of the
+// budget of its request with the time it spent in this vttablet. It is a
+// no-op on a nil QueryExecutor, when the query failed before its plan.
+func (qre *QueryExecutor) maxExecutionTimeError(err error) error {
+	if qre == nil || err == nil || qre.options.GetMaxExecutionTimeMs() == 0 {
+		return err
+	}
+	deadline, ok := qre.ctx.Deadline()
+	if !ok || (time.Now().Before(deadline) && vterrors.Code(err) != vtrpcpb.Code_DEADLINE_EXCEEDED) {
+		return err
+	}
+	return vterrors.Errorf(vtrpcpb.Code_DEADLINE_EXCEEDED, "vttablet %s: %v to the deadline on arrival, waited %v for a connection, ran %v in mysql: %s",
+		topoproto.TabletAliasString(qre.tsv.alias), deadline.Sub(qre.logStats.StartTime).Round(time.Millisecond),
+		qre.logStats.WaitingForConnection.Round(time.Millisecond), qre.logStats.MysqlResponseTime.Round(time.Millisecond), err)
+}
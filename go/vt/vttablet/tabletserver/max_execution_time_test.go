@@ -0,0 +1,96 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/planbuilder"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+func TestAddMaxExecutionTimeHint(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Hour)
+	defer cancel()
+	budget := &querypb.ExecuteOptions{MaxExecutionTimeMs: 1000}
+	newQRE := func(ctx context.Context, planID planbuilder.PlanType, options *querypb.ExecuteOptions) *QueryExecutor {
+		return &QueryExecutor{
+			ctx:     ctx,
+			options: options,
+			plan:    &TabletPlan{Plan: &planbuilder.Plan{PlanID: planID}},
+		}
+	}
+
+	hinted := newQRE(ctx, planbuilder.PlanSelect, budget).addMaxExecutionTimeHint("select a from t")
+	assert.Regexp(t, regexp.MustCompile(`^select /\*\+ MAX_EXECUTION_TIME\([0-9]+\) \*/ a from t$`), hinted)
+
+	// The hint is only added to the selects of the sessions with a budget,
+	// and never replaces the hints of the query.
+	for _, qre := range []*QueryExecutor{
+		newQRE(ctx, planbuilder.PlanSelect, nil),
+		newQRE(ctx, planbuilder.PlanInsert, budget),
+		newQRE(context.Background(), planbuilder.PlanSelect, budget),
+	} {
+		assert.Equal(t, "select a from t", qre.addMaxExecutionTimeHint("select a from t"))
+	}
+	assert.Equal(t, "select /*+ BKA(t) */ a from t", newQRE(ctx, planbuilder.PlanSelect, budget).addMaxExecutionTimeHint("select /*+ BKA(t) */ a from t"))
+
+	// Past the deadline, the hint still stops MySQL at once.
+	expired, cancel := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancel()
+	assert.Equal(t, "select /*+ MAX_EXECUTION_TIME(1) */ a from t", newQRE(expired, planbuilder.PlanSelectStream, budget).addMaxExecutionTimeHint("select a from t"))
+}
+
+func TestMaxExecutionTimeError(t *testing.T) {
+	start := time.Now()
+	ctx, cancel := context.WithDeadline(context.Background(), start.Add(10*time.Millisecond))
+	defer cancel()
+	logStats := tabletenv.NewLogStats(ctx, "Execute")
+	logStats.StartTime = start
+	logStats.WaitingForConnection = 2 * time.Millisecond
+	logStats.MysqlResponseTime = 8 * time.Millisecond
+	qre := &QueryExecutor{
+		ctx:      ctx,
+		options:  &querypb.ExecuteOptions{MaxExecutionTimeMs: 10},
+		logStats: logStats,
+		tsv:      &TabletServer{alias: &topodatapb.TabletAlias{Cell: "cell1", Uid: 100}},
+	}
+	timeout := vterrors.New(vtrpcpb.Code_DEADLINE_EXCEEDED, "query timeout")
+
+	err := qre.maxExecutionTimeError(timeout)
+	assert.Equal(t, vtrpcpb.Code_DEADLINE_EXCEEDED, vterrors.Code(err))
+	assert.EqualError(t, err, "vttablet cell1-0000000100: 10ms to the deadline on arrival, waited 2ms for a connection, ran 8ms in mysql: query timeout")
+
+	// The other errors are left alone before the deadline.
+	other := errors.New("other")
+	assert.Equal(t, other, qre.maxExecutionTimeError(other))
+	var noPlan *QueryExecutor
+	assert.Equal(t, timeout, noPlan.maxExecutionTimeError(timeout))
+	qre.options = nil
+	assert.Equal(t, timeout, qre.maxExecutionTimeError(timeout))
+}
@@ -32,7 +32,10 @@ type MessageRow struct {
 	TimeNext  int64
 	Epoch     int64
 	TimeAcked int64
-	Row       []sqltypes.Value
+	// TimeScheduled is 0 if the table has no time_scheduled
+	// column, or if it is not set for the row.
+	TimeScheduled int64
+	Row           []sqltypes.Value
 
 	// defunct is set if the row was asked to be removed
 	// from cache.
@@ -119,13 +122,15 @@ func (mc *cache) Clear() {
 }
 
 // Add adds a MessageRow to the cache. It returns
-// false if the cache is full.
+// false if the cache is full. If so, the message still
+// replaces the least important message of the cache if it
+// is more important, so that a cache full of low priority
+// messages does not hold back the urgent ones. Either way,
+// a message that is not in the cache has to be reloaded
+// from the table later.
 func (mc *cache) Add(mr *MessageRow) bool {
 	mc.mu.Lock()
 	defer mc.mu.Unlock()
-	if len(mc.sendQueue) >= mc.size {
-		return false
-	}
 	id := mr.Row[0].ToString()
 	if mc.inFlight[id] {
 		return true
@@ -133,11 +138,40 @@ func (mc *cache) Add(mr *MessageRow) bool {
 	if _, ok := mc.inQueue[id]; ok {
 		return true
 	}
+	if len(mc.sendQueue) >= mc.size {
+		mc.evictLessImportant(mr)
+		return false
+	}
 	heap.Push(&mc.sendQueue, mr)
 	mc.inQueue[id] = mr
 	return true
 }
 
+// evictLessImportant replaces the least important message
+// of the send queue with mr, if mr is more important.
+func (mc *cache) evictLessImportant(mr *MessageRow) {
+	worst := -1
+	for i, qmr := range mc.sendQueue {
+		if qmr.defunct {
+			// Defunct messages are the best to evict.
+			worst = i
+			break
+		}
+		if worst == -1 || mc.sendQueue.Less(worst, i) {
+			worst = i
+		}
+	}
+	if worst == -1 || (!mc.sendQueue[worst].defunct && mc.sendQueue[worst].Priority <= mr.Priority) {
+		return
+	}
+	evicted := heap.Remove(&mc.sendQueue, worst).(*MessageRow)
+	if !evicted.defunct {
+		delete(mc.inQueue, evicted.Row[0].ToString())
+	}
+	heap.Push(&mc.sendQueue, mr)
+	mc.inQueue[mr.Row[0].ToString()] = mr
+}
+
 // Pop removes the next MessageRow. Once the
 // message has been sent, Discard must be called.
 // The discard has to happen as a separate operation
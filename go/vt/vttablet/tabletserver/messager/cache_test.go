@@ -190,6 +190,58 @@ func TestMessagerCacheFull(t *testing.T) {
 	}
 }
 
+func TestMessagerCacheFullPriority(t *testing.T) {
+	mc := newCache(2)
+	if !mc.Add(&MessageRow{
+		Priority: 2,
+		TimeNext: 1,
+		Row:      []sqltypes.Value{sqltypes.NewVarBinary("row21")},
+	}) {
+		t.Fatal("Add returned false")
+	}
+	if !mc.Add(&MessageRow{
+		Priority: 1,
+		TimeNext: 1,
+		Row:      []sqltypes.Value{sqltypes.NewVarBinary("row11")},
+	}) {
+		t.Fatal("Add returned false")
+	}
+	// Same priority as the least important message: not added.
+	if mc.Add(&MessageRow{
+		Priority: 2,
+		TimeNext: 2,
+		Row:      []sqltypes.Value{sqltypes.NewVarBinary("row22")},
+	}) {
+		t.Error("Add(full): returned true, want false")
+	}
+	// More important: replaces row21.
+	if mc.Add(&MessageRow{
+		Priority: 0,
+		TimeNext: 1,
+		Row:      []sqltypes.Value{sqltypes.NewVarBinary("row01")},
+	}) {
+		t.Error("Add(full): returned true, want false")
+	}
+	var rows []string
+	for mr := mc.Pop(); mr != nil; mr = mc.Pop() {
+		rows = append(rows, mr.Row[0].ToString())
+	}
+	want := []string{"row01", "row11"}
+	if !reflect.DeepEqual(rows, want) {
+		t.Errorf("Pop order: %+v, want %+v", rows, want)
+	}
+
+	// row21 was evicted, and can be added again.
+	mc.Discard(rows)
+	if !mc.Add(&MessageRow{
+		Priority: 2,
+		TimeNext: 1,
+		Row:      []sqltypes.Value{sqltypes.NewVarBinary("row21")},
+	}) {
+		t.Fatal("Add returned false")
+	}
+}
+
 func TestMessagerCacheEmpty(t *testing.T) {
 	mc := newCache(2)
 	if !mc.Add(&MessageRow{
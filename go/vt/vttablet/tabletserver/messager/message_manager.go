@@ -142,8 +142,14 @@ type receiverWithStatus struct {
 // loads are less than the cache size and all cache adds are successful.
 // If so, the system reverts to the steady state mode.
 //
+// Priorities
+// Messages with a lower priority value are more important, and are
+// sent first. If the cache is full, a new message takes the place of
+// the least important message of the cache if it is more important.
+// The replaced message is reloaded by the poller later.
+//
 // Rate limiting
-// There are two ways for the system to rate-limit:
+// There are three ways for the system to rate-limit:
 // 1. Client ingestion rate. If clients ingest messages slowly,
 // that makes the senders wait on them to send more messages.
 // 2. Postpone rate limiting: A client is considered to be non-busy only
@@ -152,6 +158,10 @@ type receiverWithStatus struct {
 // limit the send rate to how fast messages can be postponed.
 // The postpone functions also needs to obtain a semaphore that limits
 // the number of tx pool connections they can occupy.
+// 3. Subscriber rate limiting: if the table sets vt_subscriber_rate,
+// a client stays busy after a send until its rate allows for the
+// messages it was sent. The other clients keep receiving messages
+// in the meantime.
 //
 // Client load balancing
 // The messages are sent to the clients in a round-robin fashion.
@@ -161,20 +171,33 @@ type receiverWithStatus struct {
 // The Purge thread
 // This thread is mostly independent. It wakes up periodically
 // to delete old rows that were successfully acked.
+//
+// Scheduled messages
+// If the table has the optional time_scheduled column, a message is
+// not sent before its time_scheduled, even if its time_next is due.
+// The vstream and the poller skip such messages, and the poller picks
+// them up once they are due. If the poller is active, it also reports
+// the number of messages that are scheduled in the future. An index
+// on (time_scheduled) makes this count cheap.
 type messageManager struct {
 	tsv TabletService
 	vs  VStreamer
 
-	name         sqlparser.TableIdent
-	fieldResult  *sqltypes.Result
-	ackWaitTime  time.Duration
-	purgeAfter   time.Duration
-	minBackoff   time.Duration
-	maxBackoff   time.Duration
-	batchSize    int
-	pollerTicks  *timer.Timer
-	purgeTicks   *timer.Timer
-	postponeSema *sync2.Semaphore
+	name        sqlparser.TableIdent
+	fieldResult *sqltypes.Result
+	ackWaitTime time.Duration
+	purgeAfter  time.Duration
+	minBackoff  time.Duration
+	maxBackoff  time.Duration
+	batchSize   int
+	// subscriberRate is the max number of messages per second
+	// sent to each receiver, if not 0.
+	subscriberRate int
+	pollerTicks    *timer.Timer
+	purgeTicks     *timer.Timer
+	postponeSema   *sync2.Semaphore
+
+	hasTimeScheduled bool
 
 	mu     sync.Mutex
 	isOpen bool
@@ -214,6 +237,7 @@ type messageManager struct {
 	ackQuery                  *sqlparser.ParsedQuery
 	postponeQuery             *sqlparser.ParsedQuery
 	purgeQuery                *sqlparser.ParsedQuery
+	scheduledCountQuery       *sqlparser.ParsedQuery
 }
 
 // newMessageManager creates a new message manager.
@@ -227,35 +251,50 @@ func newMessageManager(tsv TabletService, vs VStreamer, table *schema.Table, pos
 		fieldResult: &sqltypes.Result{
 			Fields: table.MessageInfo.Fields,
 		},
-		ackWaitTime:     table.MessageInfo.AckWaitDuration,
-		purgeAfter:      table.MessageInfo.PurgeAfterDuration,
-		minBackoff:      table.MessageInfo.MinBackoff,
-		maxBackoff:      table.MessageInfo.MaxBackoff,
-		batchSize:       table.MessageInfo.BatchSize,
-		cache:           newCache(table.MessageInfo.CacheSize),
-		pollerTicks:     timer.NewTimer(table.MessageInfo.PollInterval),
-		purgeTicks:      timer.NewTimer(table.MessageInfo.PollInterval),
-		postponeSema:    postponeSema,
-		messagesPending: true,
+		ackWaitTime:      table.MessageInfo.AckWaitDuration,
+		purgeAfter:       table.MessageInfo.PurgeAfterDuration,
+		minBackoff:       table.MessageInfo.MinBackoff,
+		maxBackoff:       table.MessageInfo.MaxBackoff,
+		batchSize:        table.MessageInfo.BatchSize,
+		subscriberRate:   table.MessageInfo.SubscriberRate,
+		cache:            newCache(table.MessageInfo.CacheSize),
+		pollerTicks:      timer.NewTimer(table.MessageInfo.PollInterval),
+		purgeTicks:       timer.NewTimer(table.MessageInfo.PollInterval),
+		postponeSema:     postponeSema,
+		hasTimeScheduled: table.MessageInfo.HasTimeScheduled,
+		messagesPending:  true,
 	}
 	mm.cond.L = &mm.mu
 
 	columnList := buildSelectColumnList(table)
-	vsQuery := fmt.Sprintf("select priority, time_next, epoch, time_acked, %s from %v", columnList, mm.name)
+	headerList := "priority, time_next, epoch, time_acked"
+	dueCondition := "time_next < %a"
+	dueArgs := []interface{}{":time_next"}
+	if mm.hasTimeScheduled {
+		headerList += ", time_scheduled"
+		dueCondition += " and (time_scheduled is null or time_scheduled < %a)"
+		dueArgs = append(dueArgs, ":time_next")
+	}
+	vsQuery := fmt.Sprintf("select %s, %s from %v", headerList, columnList, mm.name)
 	mm.vsFilter = &binlogdatapb.Filter{
 		Rules: []*binlogdatapb.Rule{{
 			Match:  table.Name.String(),
 			Filter: vsQuery,
 		}},
 	}
+	readArgs := append([]interface{}{columnList, mm.name}, dueArgs...)
 	mm.readByPriorityAndTimeNext = sqlparser.BuildParsedQuery(
-		"select priority, time_next, epoch, time_acked, %s from %v where time_next < %a order by priority, time_next desc limit %a",
-		columnList, mm.name, ":time_next", ":max")
+		"select "+headerList+", %s from %v where "+dueCondition+" order by priority, time_next desc limit %a",
+		append(readArgs, ":max")...)
 	mm.ackQuery = sqlparser.BuildParsedQuery(
 		"update %v set time_acked = %a, time_next = null where id in %a and time_acked is null",
 		mm.name, ":time_acked", "::ids")
 	mm.purgeQuery = sqlparser.BuildParsedQuery(
 		"delete from %v where time_acked < %a limit 500", mm.name, ":time_acked")
+	if mm.hasTimeScheduled {
+		mm.scheduledCountQuery = sqlparser.BuildParsedQuery(
+			"select count(*) from %v where time_scheduled >= %a and time_acked is null", mm.name, ":time_now")
+	}
 
 	mm.postponeQuery = buildPostponeQuery(mm.name, mm.minBackoff, mm.maxBackoff)
 
@@ -579,6 +618,7 @@ func (mm *messageManager) send(receiver *receiverWithStatus, qr *sqltypes.Result
 		}
 	}()
 
+	start := time.Now()
 	if err := receiver.receiver.Send(qr); err != nil {
 		// Log the error, but we still want to postpone the message.
 		// Otherwise, if this is a chronic failure like "message too
@@ -586,6 +626,27 @@ func (mm *messageManager) send(receiver *receiverWithStatus, qr *sqltypes.Result
 		log.Errorf("Error sending messages: %v: %v", qr, err)
 	}
 	mm.postpone(mm.tsv, mm.name.String(), mm.ackWaitTime, ids)
+	mm.waitForSubscriberRate(receiver, start, len(ids))
+}
+
+// waitForSubscriberRate waits until the subscriber rate allows for
+// the count messages sent to the receiver at start. The receiver
+// remains busy while waiting.
+func (mm *messageManager) waitForSubscriberRate(receiver *receiverWithStatus, start time.Time, count int) {
+	if mm.subscriberRate == 0 {
+		return
+	}
+	wait := time.Until(start.Add(time.Duration(count) * time.Second / time.Duration(mm.subscriberRate)))
+	if wait <= 0 {
+		return
+	}
+	MessageStats.Add([]string{mm.name.String(), "RateLimited"}, int64(count))
+	tmr := time.NewTimer(wait)
+	defer tmr.Stop()
+	select {
+	case <-tmr.C:
+	case <-receiver.receiver.ctx.Done():
+	}
 }
 
 func (mm *messageManager) postpone(tsv TabletService, name string, ackWaitTime time.Duration, ids []string) {
@@ -721,11 +782,11 @@ func (mm *messageManager) processRowEvent(fields []*querypb.Field, rowEvent *bin
 			continue
 		}
 		row := sqltypes.MakeRowTrusted(fields, rc.After)
-		mr, err := BuildMessageRow(row)
+		mr, err := BuildMessageRow(row, mm.hasTimeScheduled)
 		if err != nil {
 			return err
 		}
-		if mr.TimeAcked != 0 || mr.TimeNext > now {
+		if mr.TimeAcked != 0 || mr.TimeNext > now || mr.TimeScheduled > now {
 			continue
 		}
 		mm.Add(mr)
@@ -748,9 +809,14 @@ func (mm *messageManager) runPoller() {
 		cancel()
 	}()
 
+	now := time.Now().UnixNano()
+	if mm.hasTimeScheduled {
+		mm.reportScheduled(ctx, now)
+	}
+
 	size := mm.cache.Size()
 	bindVars := map[string]*querypb.BindVariable{
-		"time_next": sqltypes.Int64BindVariable(time.Now().UnixNano()),
+		"time_next": sqltypes.Int64BindVariable(now),
 		"max":       sqltypes.Int64BindVariable(int64(size)),
 	}
 	qr, err := mm.readPending(ctx, bindVars)
@@ -776,7 +842,7 @@ func (mm *messageManager) runPoller() {
 		defer mm.cond.Broadcast()
 	}
 	for _, row := range qr.Rows {
-		mr, err := BuildMessageRow(row)
+		mr, err := BuildMessageRow(row, mm.hasTimeScheduled)
 		if err != nil {
 			mm.tsv.Stats().InternalErrors.Add("Messages", 1)
 			log.Errorf("Error reading message row: %v", err)
@@ -869,9 +935,20 @@ func (mm *messageManager) GeneratePurgeQuery(timeCutoff int64) (string, map[stri
 	}
 }
 
-// BuildMessageRow builds a MessageRow for a db row.
-func BuildMessageRow(row []sqltypes.Value) (*MessageRow, error) {
+// BuildMessageRow builds a MessageRow for a db row. If hasTimeScheduled
+// is set, the row has the time_scheduled column after time_acked.
+func BuildMessageRow(row []sqltypes.Value, hasTimeScheduled bool) (*MessageRow, error) {
 	mr := &MessageRow{Row: row[4:]}
+	if hasTimeScheduled {
+		mr.Row = row[5:]
+		if !row[4].IsNull() {
+			v, err := evalengine.ToInt64(row[4])
+			if err != nil {
+				return nil, err
+			}
+			mr.TimeScheduled = v
+		}
+	}
 	if !row[0].IsNull() {
 		v, err := evalengine.ToInt64(row[0])
 		if err != nil {
@@ -909,6 +986,43 @@ func (mm *messageManager) receiverCount() int {
 	return len(mm.receivers)
 }
 
+// reportScheduled updates the Scheduled stat with the number of unacked
+// messages that are scheduled in the future.
+func (mm *messageManager) reportScheduled(ctx context.Context, now int64) {
+	query, err := mm.scheduledCountQuery.GenerateQuery(map[string]*querypb.BindVariable{
+		"time_now": sqltypes.Int64BindVariable(now),
+	}, nil)
+	if err != nil {
+		mm.tsv.Stats().InternalErrors.Add("Messages", 1)
+		log.Errorf("Error counting scheduled rows of message table: %v", err)
+		return
+	}
+	var fields []*querypb.Field
+	var count int64
+	err = mm.vs.StreamResults(ctx, query, func(response *binlogdatapb.VStreamResultsResponse) error {
+		// The position of the snapshot is not recorded: the
+		// count has no bearing on the rows of the cache.
+		if response.Fields != nil {
+			fields = response.Fields
+		}
+		if len(response.Rows) == 0 {
+			return nil
+		}
+		row := sqltypes.MakeRowTrusted(fields, response.Rows[0])
+		if len(row) == 0 {
+			return nil
+		}
+		var err error
+		count, err = evalengine.ToInt64(row[0])
+		return err
+	})
+	if err != nil {
+		log.Errorf("Error counting scheduled rows of message table: %v", err)
+		return
+	}
+	MessageStats.Set([]string{mm.name.String(), "Scheduled"}, count)
+}
+
 func (mm *messageManager) readPending(ctx context.Context, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
 	query, err := mm.readByPriorityAndTimeNext.GenerateQuery(bindVars, nil)
 	if err != nil {
@@ -22,6 +22,7 @@ import (
 	"io"
 	"reflect"
 	"runtime"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -299,6 +300,35 @@ func TestMessageManagerSend(t *testing.T) {
 	<-r1.ch
 }
 
+func TestMessageManagerSubscriberRate(t *testing.T) {
+	ti := newMMTable()
+	ti.MessageInfo.BatchSize = 2
+	ti.MessageInfo.SubscriberRate = 10
+	mm := newMessageManager(newFakeTabletServer(), newFakeVStreamer(), ti, sync2.NewSemaphore(1, 0))
+	mm.Open()
+	defer mm.Close()
+
+	r1 := newTestReceiver(1)
+	mm.Subscribe(context.Background(), r1.rcv)
+	<-r1.ch
+
+	rateLimited := MessageStats.Counts()["foo.RateLimited"]
+	start := time.Now()
+	for i := 1; i <= 4; i++ {
+		mm.Add(&MessageRow{Row: []sqltypes.Value{sqltypes.NewVarBinary(fmt.Sprintf("%d", i))}})
+	}
+	count := 0
+	for count < 4 {
+		count += len((<-r1.ch).Rows)
+	}
+	// The second batch of 2 messages has to wait for the first one
+	// to be allowed by the rate of 10 messages per second.
+	if elapsed := time.Since(start); elapsed < 200*time.Millisecond {
+		t.Errorf("4 messages were sent in %v, want at least 200ms", elapsed)
+	}
+	assert.GreaterOrEqual(t, MessageStats.Counts()["foo.RateLimited"]-rateLimited, int64(2))
+}
+
 func TestMessageManagerPostponeThrottle(t *testing.T) {
 	tsv := newFakeTabletServer()
 	mm := newMessageManager(tsv, newFakeVStreamer(), newMMTable(), sync2.NewSemaphore(1, 0))
@@ -640,6 +670,93 @@ func TestMessageManagerPoller(t *testing.T) {
 	}
 }
 
+func TestMessageManagerScheduled(t *testing.T) {
+	ti := newMMTable()
+	ti.MessageInfo.HasTimeScheduled = true
+	dbFields := append([]*querypb.Field{}, testDBFields[:4]...)
+	dbFields = append(dbFields, &querypb.Field{Type: sqltypes.Int64})
+	dbFields = append(dbFields, testDBFields[4:]...)
+	newScheduledRow := func(id int64, timeScheduled sqltypes.Value) *querypb.Row {
+		return sqltypes.RowToProto3([]sqltypes.Value{
+			sqltypes.NewInt64(1),
+			sqltypes.NewInt64(1),
+			sqltypes.NewInt64(0),
+			sqltypes.NULL,
+			timeScheduled,
+			sqltypes.NewInt64(id),
+			sqltypes.NewVarBinary(fmt.Sprintf("%v", id)),
+		})
+	}
+
+	fvs := newFakeVStreamer()
+	fvs.setCountResponse([]*binlogdatapb.VStreamResultsResponse{{
+		Fields: []*querypb.Field{{Type: sqltypes.Int64}},
+		Gtid:   "MySQL56/33333333-3333-3333-3333-333333333333:1-100",
+	}, {
+		Rows: []*querypb.Row{sqltypes.RowToProto3([]sqltypes.Value{sqltypes.NewInt64(5)})},
+	}})
+	fvs.setStreamerResponse([][]*binlogdatapb.VEvent{{{
+		Type: binlogdatapb.VEventType_FIELD,
+		FieldEvent: &binlogdatapb.FieldEvent{
+			TableName: "foo",
+			Fields:    dbFields,
+		},
+	}}, {{
+		// The first row is scheduled in the future and must not be sent.
+		Type: binlogdatapb.VEventType_ROW,
+		RowEvent: &binlogdatapb.RowEvent{
+			TableName: "foo",
+			RowChanges: []*binlogdatapb.RowChange{{
+				After: newScheduledRow(1, sqltypes.NewInt64(time.Now().Add(time.Hour).UnixNano())),
+			}, {
+				After: newScheduledRow(2, sqltypes.NewInt64(1)),
+			}, {
+				After: newScheduledRow(3, sqltypes.NULL),
+			}},
+		},
+	}, {
+		Type: binlogdatapb.VEventType_GTID,
+		Gtid: "MySQL56/33333333-3333-3333-3333-333333333333:1-101",
+	}, {
+		Type: binlogdatapb.VEventType_COMMIT,
+	}}})
+	mm := newMessageManager(newFakeTabletServer(), fvs, ti, sync2.NewSemaphore(1, 0))
+
+	assert.Equal(t, "select priority, time_next, epoch, time_acked, time_scheduled, id, message from foo", mm.vsFilter.Rules[0].Filter)
+	assert.Equal(t, "select priority, time_next, epoch, time_acked, time_scheduled, id, message from foo where time_next < :time_next and (time_scheduled is null or time_scheduled < :time_next) order by priority, time_next desc limit :max", mm.readByPriorityAndTimeNext.Query)
+
+	mm.Open()
+	defer mm.Close()
+
+	r1 := newTestReceiver(1)
+	mm.Subscribe(context.Background(), r1.rcv)
+	<-r1.ch
+
+	var got [][]sqltypes.Value
+	for i := 0; i < 2; i++ {
+		qr := <-r1.ch
+		got = append(got, qr.Rows...)
+	}
+	want := [][]sqltypes.Value{{
+		sqltypes.NewInt64(2),
+		sqltypes.NewVarBinary("2"),
+	}, {
+		sqltypes.NewInt64(3),
+		sqltypes.NewVarBinary("3"),
+	}}
+	assert.ElementsMatch(t, want, got)
+	select {
+	case qr := <-r1.ch:
+		t.Errorf("Expecting no value, got: %v", qr)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	for MessageStats.Counts()["foo.Scheduled"] != 5 {
+		runtime.Gosched()
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
 // TestMessagesPending1 tests for the case where you can't
 // add items because the cache is full.
 func TestMessagesPending1(t *testing.T) {
@@ -876,6 +993,7 @@ type fakeVStreamer struct {
 	mu                sync.Mutex
 	streamerResponse  [][]*binlogdatapb.VEvent
 	pollerResponse    []*binlogdatapb.VStreamResultsResponse
+	countResponse     []*binlogdatapb.VStreamResultsResponse
 }
 
 func newFakeVStreamer() *fakeVStreamer { return &fakeVStreamer{} }
@@ -892,6 +1010,12 @@ func (fv *fakeVStreamer) setPollerResponse(pr []*binlogdatapb.VStreamResultsResp
 	fv.pollerResponse = pr
 }
 
+func (fv *fakeVStreamer) setCountResponse(cr []*binlogdatapb.VStreamResultsResponse) {
+	fv.mu.Lock()
+	defer fv.mu.Unlock()
+	fv.countResponse = cr
+}
+
 func (fv *fakeVStreamer) Stream(ctx context.Context, startPos string, tablePKs []*binlogdatapb.TableLastPK, filter *binlogdatapb.Filter, send func([]*binlogdatapb.VEvent) error) error {
 	fv.streamInvocations.Add(1)
 	for {
@@ -917,7 +1041,11 @@ func (fv *fakeVStreamer) Stream(ctx context.Context, startPos string, tablePKs [
 func (fv *fakeVStreamer) StreamResults(ctx context.Context, query string, send func(*binlogdatapb.VStreamResultsResponse) error) error {
 	fv.mu.Lock()
 	defer fv.mu.Unlock()
-	for _, r := range fv.pollerResponse {
+	responses := fv.pollerResponse
+	if strings.HasPrefix(query, "select count(*)") {
+		responses = fv.countResponse
+	}
+	for _, r := range responses {
 		if err := send(r); err != nil {
 			return err
 		}
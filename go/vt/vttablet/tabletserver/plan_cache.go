@@ -0,0 +1,237 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"sync/atomic"
+	"time"
+
+	"vitess.io/vitess/go/acl"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/planbuilder"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// planCacheEntry describes a plan of the query plan cache in
+// /debug/query_plan_cache. Its output can be used as the warm up file of the
+// cache, of which only the Query and Pinned fields are read.
+type planCacheEntry struct {
+	Query       string
+	Fingerprint string `json:",omitempty"`
+	Table       string `json:",omitempty"`
+	Plan        planbuilder.PlanType
+	Hits        uint64     `json:",omitempty"`
+	Size        int64      `json:",omitempty"`
+	LastUsed    *time.Time `json:",omitempty"`
+	Pinned      bool       `json:",omitempty"`
+}
+
+// warmUpEntry is a query of the warm up file of the query plan cache.
+type warmUpEntry struct {
+	Query  string
+	Pinned bool
+}
+
+// PinPlan builds the plan of the query, and keeps it in the query plan
+// cache until it is unpinned. A pinned plan that a schema change
+// invalidates is built again the next time the query is executed.
+func (qe *QueryEngine) PinPlan(ctx context.Context, sql string) error {
+	qe.pinMu.Lock()
+	_, pinned := qe.pinned[sql]
+	if !pinned {
+		qe.pinned[sql] = nil
+	}
+	qe.pinMu.Unlock()
+
+	plan, err := qe.GetPlan(ctx, tabletenv.NewLogStats(ctx, "PinPlan"), sql, false, false)
+	if err == nil && (plan.PlanID == planbuilder.PlanDDL || plan.PlanID == planbuilder.PlanSet) {
+		err = vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "the plans of %s queries are not cached and can not be pinned", plan.PlanID.String())
+	}
+	if err != nil {
+		if !pinned {
+			qe.UnpinPlan(sql)
+		}
+		return err
+	}
+	qe.setPinnedPlan(sql, plan)
+	return nil
+}
+
+// UnpinPlan lets the plan of the query be evicted from the query plan cache.
+func (qe *QueryEngine) UnpinPlan(sql string) {
+	qe.pinMu.Lock()
+	defer qe.pinMu.Unlock()
+	delete(qe.pinned, sql)
+}
+
+func (qe *QueryEngine) pinnedPlan(sql string) *TabletPlan {
+	qe.pinMu.Lock()
+	defer qe.pinMu.Unlock()
+	return qe.pinned[sql]
+}
+
+// setPinnedPlan keeps the plan if its query is pinned.
+func (qe *QueryEngine) setPinnedPlan(sql string, plan *TabletPlan) {
+	qe.pinMu.Lock()
+	defer qe.pinMu.Unlock()
+	if _, pinned := qe.pinned[sql]; pinned {
+		qe.pinned[sql] = plan
+	}
+}
+
+// unsetPinnedPlans drops the plans of the pinned queries, which stay
+// pinned, when the cache is cleared.
+func (qe *QueryEngine) unsetPinnedPlans() {
+	qe.pinMu.Lock()
+	defer qe.pinMu.Unlock()
+	for sql := range qe.pinned {
+		qe.pinned[sql] = nil
+	}
+}
+
+// warmUpPlans builds the plans of the queries of the warm up file, and pins
+// the ones marked so. The queries whose plans can not be built are skipped.
+func (qe *QueryEngine) warmUpPlans(file string) {
+	if file == "" {
+		return
+	}
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		log.Errorf("Query Engine: can not read the query plan cache warm up file: %v", err)
+		return
+	}
+	var entries []warmUpEntry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		log.Errorf("Query Engine: can not parse the query plan cache warm up file %s: %v", file, err)
+		return
+	}
+
+	ctx := tabletenv.LocalContext()
+	warmed := 0
+	for _, entry := range entries {
+		var err error
+		if entry.Pinned {
+			err = qe.PinPlan(ctx, entry.Query)
+		} else {
+			_, err = qe.GetPlan(ctx, tabletenv.NewLogStats(ctx, "WarmUpPlans"), entry.Query, false, false)
+		}
+		if err != nil {
+			log.Warningf("Query Engine: can not warm up the plan of %q: %v", entry.Query, err)
+			continue
+		}
+		warmed++
+	}
+	log.Infof("Query Engine: warmed up the plans of %d of the %d queries of %s", warmed, len(entries), file)
+}
+
+// planCacheEntries returns the plans of the cache and the pinned plans, the
+// most used first.
+func (qe *QueryEngine) planCacheEntries() []planCacheEntry {
+	qe.pinMu.Lock()
+	pinned := make(map[string]*TabletPlan, len(qe.pinned))
+	for sql, plan := range qe.pinned {
+		pinned[sql] = plan
+	}
+	qe.pinMu.Unlock()
+
+	var entries []planCacheEntry
+	add := func(sql string, plan *TabletPlan) {
+		_, isPinned := pinned[sql]
+		delete(pinned, sql)
+		entry := planCacheEntry{Query: sql, Pinned: isPinned}
+		if plan != nil {
+			entry.Fingerprint = plan.Fingerprint()
+			entry.Table = plan.TableName().String()
+			entry.Plan = plan.PlanID
+			entry.Hits = atomic.LoadUint64(&plan.hits)
+			entry.Size = plan.CachedSize(true)
+			if lastUsed := atomic.LoadInt64(&plan.lastUsed); lastUsed != 0 {
+				t := time.Unix(0, lastUsed)
+				entry.LastUsed = &t
+			}
+		}
+		entries = append(entries, entry)
+	}
+	qe.plans.ForEach(func(value interface{}) bool {
+		plan := value.(*TabletPlan)
+		add(plan.Original, plan)
+		return true
+	})
+	for sql, plan := range pinned {
+		add(sql, plan)
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Hits != entries[j].Hits {
+			return entries[i].Hits > entries[j].Hits
+		}
+		return entries[i].Query < entries[j].Query
+	})
+	return entries
+}
+
+func (qe *QueryEngine) handleHTTPQueryPlanCache(response http.ResponseWriter, request *http.Request) {
+	if err := acl.CheckAccessHTTP(request, acl.DEBUGGING); err != nil {
+		acl.SendError(response, err)
+		return
+	}
+	response.Header().Set("Content-Type", "application/json; charset=utf-8")
+	if b, err := json.MarshalIndent(qe.planCacheEntries(), "", "  "); err != nil {
+		response.Write([]byte(err.Error()))
+	} else {
+		response.Write(b)
+	}
+}
+
+func (qe *QueryEngine) handleHTTPPinPlan(response http.ResponseWriter, request *http.Request) {
+	if err := acl.CheckAccessHTTP(request, acl.ADMIN); err != nil {
+		acl.SendError(response, err)
+		return
+	}
+	query := request.FormValue("query")
+	if query == "" {
+		http.Error(response, "missing query", http.StatusBadRequest)
+		return
+	}
+	if err := qe.PinPlan(request.Context(), query); err != nil {
+		http.Error(response, err.Error(), http.StatusBadRequest)
+		return
+	}
+	response.Write([]byte("pinned\n"))
+}
+
+func (qe *QueryEngine) handleHTTPUnpinPlan(response http.ResponseWriter, request *http.Request) {
+	if err := acl.CheckAccessHTTP(request, acl.ADMIN); err != nil {
+		acl.SendError(response, err)
+		return
+	}
+	query := request.FormValue("query")
+	if query == "" {
+		http.Error(response, "missing query", http.StatusBadRequest)
+		return
+	}
+	qe.UnpinPlan(query)
+	response.Write([]byte("unpinned\n"))
+}
@@ -0,0 +1,155 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql/fakesqldb"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/planbuilder"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema/schematest"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+)
+
+func newPlanCacheTestDB(t *testing.T) *fakesqldb.DB {
+	db := fakesqldb.New(t)
+	for query, result := range schematest.Queries() {
+		db.AddQuery(query, result)
+	}
+	addSchemaEngineQueries(db)
+	return db
+}
+
+func TestQueryPlanCacheEntries(t *testing.T) {
+	db := newPlanCacheTestDB(t)
+	defer db.Close()
+	qe := newTestQueryEngine(10*time.Second, true, newDBConfigs(db))
+	qe.se.Open()
+	qe.Open()
+	defer qe.Close()
+
+	ctx := context.Background()
+	logStats := tabletenv.NewLogStats(ctx, "GetPlanStats")
+	for _, query := range []string{"select * from test_table_01", "select * from test_table_02", "select * from test_table_02"} {
+		_, err := qe.GetPlan(ctx, logStats, query, false, false /* inReservedConn */)
+		require.NoError(t, err)
+		qe.plans.Wait()
+	}
+
+	request, _ := http.NewRequest("GET", "/debug/query_plan_cache", nil)
+	response := httptest.NewRecorder()
+	qe.handleHTTPQueryPlanCache(response, request)
+	var entries []struct {
+		planCacheEntry
+		Plan string
+	}
+	require.NoError(t, json.Unmarshal(response.Body.Bytes(), &entries))
+	require.Len(t, entries, 2)
+	assert.Equal(t, "select * from test_table_02", entries[0].Query)
+	assert.EqualValues(t, 1, entries[0].Hits)
+	assert.NotNil(t, entries[0].LastUsed)
+	assert.Equal(t, "test_table_02", entries[0].Table)
+	assert.Equal(t, planbuilder.PlanSelect.String(), entries[0].Plan)
+	assert.NotZero(t, entries[0].Size)
+	assert.Equal(t, "select * from test_table_01", entries[1].Query)
+	assert.Zero(t, entries[1].Hits)
+	assert.Nil(t, entries[1].LastUsed)
+}
+
+func TestPinPlan(t *testing.T) {
+	db := newPlanCacheTestDB(t)
+	defer db.Close()
+	qe := newTestQueryEngine(10*time.Second, true, newDBConfigs(db))
+	qe.se.Open()
+	qe.Open()
+	defer qe.Close()
+
+	ctx := context.Background()
+	query := "select * from test_table_01"
+	require.NoError(t, qe.PinPlan(ctx, query))
+	plan := qe.pinnedPlan(query)
+	require.NotNil(t, plan)
+
+	// The pinned plan is found once the cache is cleared, until a schema
+	// change invalidates it, and it is built again.
+	qe.plans.Clear()
+	assert.Equal(t, plan, qe.getQuery(query))
+	qe.ClearQueryPlanCache()
+	assert.Nil(t, qe.getQuery(query))
+	rebuilt, err := qe.GetPlan(ctx, tabletenv.NewLogStats(ctx, "GetPlanStats"), query, false, false /* inReservedConn */)
+	require.NoError(t, err)
+	assert.NotEqual(t, plan, rebuilt)
+	assert.Equal(t, rebuilt, qe.pinnedPlan(query))
+
+	qe.UnpinPlan(query)
+	qe.plans.Clear()
+	assert.Nil(t, qe.getQuery(query))
+
+	err = qe.PinPlan(ctx, "alter table test_table_01 add column c int")
+	require.EqualError(t, err, "the plans of DDL queries are not cached and can not be pinned")
+	assert.Empty(t, qe.pinned)
+
+	// The pin and unpin endpoints take the query as a form value.
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest("POST", "/debug/query_plan_cache/pin", strings.NewReader(url.Values{"query": {query}}.Encode()))
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	qe.handleHTTPPinPlan(response, request)
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.NotNil(t, qe.pinnedPlan(query))
+
+	response = httptest.NewRecorder()
+	qe.handleHTTPUnpinPlan(response, httptest.NewRequest("POST", "/debug/query_plan_cache/unpin", nil))
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+	response = httptest.NewRecorder()
+	qe.handleHTTPUnpinPlan(response, httptest.NewRequest("POST", "/debug/query_plan_cache/unpin?query="+url.QueryEscape(query), nil))
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.Empty(t, qe.pinned)
+}
+
+func TestWarmUpPlans(t *testing.T) {
+	db := newPlanCacheTestDB(t)
+	defer db.Close()
+
+	file := path.Join(t.TempDir(), "warmup.json")
+	require.NoError(t, ioutil.WriteFile(file, []byte(`[
+  {"Query": "select * from test_table_01", "Hits": 10},
+  {"Query": "select * from test_table_02", "Pinned": true},
+  {"Query": "select * from"}
+]`), 0644))
+
+	qe := newTestQueryEngine(10*time.Second, true, newDBConfigs(db))
+	qe.env.Config().QueryCacheWarmupFile = file
+	qe.se.Open()
+	qe.Open()
+	defer qe.Close()
+
+	assertPlanCacheSize(t, qe, 2)
+	assert.NotNil(t, qe.pinnedPlan("select * from test_table_02"))
+	assert.Len(t, qe.pinned, 1)
+}
@@ -39,7 +39,7 @@ func (cached *Plan) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(168)
+		size += int64(200)
 	}
 	// field Table *vitess.io/vitess/go/vt/vttablet/tabletserver/schema.Table
 	size += cached.Table.CachedSize(true)
@@ -58,6 +58,8 @@ func (cached *Plan) CachedSize(alloc bool) int64 {
 	size += cached.NextCount.CachedSize(false)
 	// field WhereClause *vitess.io/vitess/go/vt/sqlparser.ParsedQuery
 	size += cached.WhereClause.CachedSize(true)
+	// field WorkloadName string
+	size += int64(len(cached.WorkloadName))
 	// field FullStmt vitess.io/vitess/go/vt/sqlparser.Statement
 	if cc, ok := cached.FullStmt.(cachedObject); ok {
 		size += cc.CachedSize(true)
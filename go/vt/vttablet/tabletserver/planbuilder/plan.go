@@ -26,6 +26,7 @@ import (
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
 
+	querypb "vitess.io/vitess/go/vt/proto/query"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 )
 
@@ -169,6 +170,17 @@ type Plan struct {
 	// to serialize e.g. UPDATEs going to the same row.
 	WhereClause *sqlparser.ParsedQuery
 
+	// SkipConsolidator is set for SELECTs with the SKIP_CONSOLIDATOR
+	// directive, which must not be consolidated with identical queries.
+	SkipConsolidator bool
+
+	// WorkloadName and Priority are set with the WORKLOAD_NAME and
+	// PRIORITY directives, which override the labels of the options.
+	// Priority is only set if HasPriority.
+	WorkloadName string
+	Priority     querypb.ExecuteOptions_Priority
+	HasPriority  bool
+
 	// FullStmt can be used when the query does not operate on tables
 	FullStmt sqlparser.Statement
 }
@@ -249,6 +261,11 @@ func Build(statement sqlparser.Statement, tables map[string]*schema.Table, isRes
 		return nil, err
 	}
 	plan.Permissions = BuildPermissions(statement)
+	plan.SkipConsolidator = sqlparser.SkipConsolidatorDirective(statement)
+	plan.WorkloadName, plan.Priority, plan.HasPriority, err = sqlparser.WorkloadDirectives(statement)
+	if err != nil {
+		return nil, err
+	}
 	return plan, nil
 }
 
@@ -267,9 +284,14 @@ func BuildStreaming(sql string, tables map[string]*schema.Table, isReservedConn
 	}
 
 	plan := &Plan{
-		PlanID:      PlanSelectStream,
-		FullQuery:   GenerateFullQuery(statement),
-		Permissions: BuildPermissions(statement),
+		PlanID:           PlanSelectStream,
+		FullQuery:        GenerateFullQuery(statement),
+		Permissions:      BuildPermissions(statement),
+		SkipConsolidator: sqlparser.SkipConsolidatorDirective(statement),
+	}
+	plan.WorkloadName, plan.Priority, plan.HasPriority, err = sqlparser.WorkloadDirectives(statement)
+	if err != nil {
+		return nil, err
 	}
 
 	switch stmt := statement.(type) {
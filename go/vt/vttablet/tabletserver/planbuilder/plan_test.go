@@ -40,20 +40,22 @@ import (
 // This is only for testing.
 func (p *Plan) MarshalJSON() ([]byte, error) {
 	mplan := struct {
-		PlanID      PlanType
-		TableName   sqlparser.TableIdent   `json:",omitempty"`
-		Permissions []Permission           `json:",omitempty"`
-		FieldQuery  *sqlparser.ParsedQuery `json:",omitempty"`
-		FullQuery   *sqlparser.ParsedQuery `json:",omitempty"`
-		NextCount   string                 `json:",omitempty"`
-		WhereClause *sqlparser.ParsedQuery `json:",omitempty"`
+		PlanID           PlanType
+		TableName        sqlparser.TableIdent   `json:",omitempty"`
+		Permissions      []Permission           `json:",omitempty"`
+		FieldQuery       *sqlparser.ParsedQuery `json:",omitempty"`
+		FullQuery        *sqlparser.ParsedQuery `json:",omitempty"`
+		NextCount        string                 `json:",omitempty"`
+		WhereClause      *sqlparser.ParsedQuery `json:",omitempty"`
+		SkipConsolidator bool                   `json:",omitempty"`
 	}{
-		PlanID:      p.PlanID,
-		TableName:   p.TableName(),
-		Permissions: p.Permissions,
-		FieldQuery:  p.FieldQuery,
-		FullQuery:   p.FullQuery,
-		WhereClause: p.WhereClause,
+		PlanID:           p.PlanID,
+		TableName:        p.TableName(),
+		Permissions:      p.Permissions,
+		FieldQuery:       p.FieldQuery,
+		FullQuery:        p.FullQuery,
+		WhereClause:      p.WhereClause,
+		SkipConsolidator: p.SkipConsolidator,
 	}
 	if !p.NextCount.IsNull() {
 		b, _ := p.NextCount.MarshalJSON()
@@ -61,12 +61,22 @@ type TabletPlan struct {
 	Rules      *rules.Rules
 	Authorized []*tableacl.ACLResult
 
+	fingerprint string
+
 	QueryCount   uint64
 	Time         uint64
 	MysqlTime    uint64
 	RowsAffected uint64
 	RowsReturned uint64
 	ErrorCount   uint64
+	// Consolidations is the number of queries which waited for the result
+	// of an identical query instead of being executed.
+	Consolidations uint64
+
+	// hits is the number of times the plan was found in the cache, and
+	// lastUsed the time it last was, in unix nanoseconds.
+	hits     uint64
+	lastUsed int64
 }
 
 // AddStats updates the stats for the current TabletPlan.
@@ -90,6 +100,27 @@ func (ep *TabletPlan) Stats() (queryCount uint64, duration, mysqlTime time.Durat
 	return
 }
 
+// AddConsolidation records that a query of the TabletPlan was consolidated
+// with an identical one.
+func (ep *TabletPlan) AddConsolidation() {
+	atomic.AddUint64(&ep.Consolidations, 1)
+}
+
+// markUsed records a hit of the TabletPlan in the query plan cache.
+func (ep *TabletPlan) markUsed() {
+	atomic.AddUint64(&ep.hits, 1)
+	atomic.StoreInt64(&ep.lastUsed, time.Now().UnixNano())
+}
+
+// Fingerprint identifies the query of the TabletPlan in the stats, where the
+// query itself would be too long, see sqlparser.Fingerprint. It is the one
+// of the query vttablet receives, which may differ from the one vtgate
+// reports for the query it came from: vttablet uses the latter, sent in the
+// ExecuteOptions, for its logs, the keyspace policy and KillQueries.
+func (ep *TabletPlan) Fingerprint() string {
+	return ep.fingerprint
+}
+
 // buildAuthorized builds 'Authorized', which is the runtime part for 'Permissions'.
 func (ep *TabletPlan) buildAuthorized() {
 	ep.Authorized = make([]*tableacl.ACLResult, len(ep.Permissions))
@@ -121,6 +152,10 @@ type QueryEngine struct {
 	// Pools
 	conns       *connpool.Pool
 	streamConns *connpool.Pool
+	// workloadConns and workloadStreamConns partition conns and streamConns
+	// by workload.
+	workloadConns       *workloadPools
+	workloadStreamConns *workloadPools
 
 	// Services
 	consolidator       *sync2.Consolidator
@@ -131,6 +166,7 @@ type QueryEngine struct {
 	// that we start more than one transaction per hot row (range).
 	// For implementation details, please see BeginExecute() in tabletserver.go.
 	txSerializer *txserializer.TxSerializer
+	resultCache  *ResultCache
 
 	// Vars
 	maxResultSize    sync2.AtomicInt64
@@ -146,11 +182,18 @@ type QueryEngine struct {
 
 	strictTransTables bool
 
+	// pinned are the plans that are never evicted from the query plan
+	// cache, by query. A pinned query whose plan is not built yet, or was
+	// invalidated by a schema change, has a nil plan.
+	pinMu  sync.Mutex
+	pinned map[string]*TabletPlan
+
 	consolidatorMode            sync2.AtomicString
 	enableQueryPlanFieldCaching bool
 
 	// stats
 	queryCounts, queryTimes, queryRowCounts, queryErrorCounts *stats.CountersWithMultiLabels
+	queryRuleHits                                             *stats.CountersWithMultiLabels
 
 	// Loggers
 	accessCheckerLogger *logutil.ThrottledLogger
@@ -173,10 +216,17 @@ func NewQueryEngine(env tabletenv.Env, se *schema.Engine) *QueryEngine {
 		tables:           make(map[string]*schema.Table),
 		plans:            cache.NewDefaultCacheImpl(cacheCfg),
 		queryRuleSources: rules.NewMap(),
+		pinned:           make(map[string]*TabletPlan),
 	}
 
 	qe.conns = connpool.NewPool(env, "ConnPool", config.OltpReadPool)
 	qe.streamConns = connpool.NewPool(env, "StreamConnPool", config.OlapReadPool)
+	qe.workloadConns = newWorkloadPools(env, "ConnPool", config.OltpReadPool, func(wp *tabletenv.WorkloadPoolConfig) tabletenv.ConnPoolConfig {
+		return wp.OltpReadPool
+	})
+	qe.workloadStreamConns = newWorkloadPools(env, "StreamConnPool", config.OlapReadPool, func(wp *tabletenv.WorkloadPoolConfig) tabletenv.ConnPoolConfig {
+		return wp.OlapReadPool
+	})
 	qe.consolidatorMode.Set(config.Consolidator)
 	qe.enableQueryPlanFieldCaching = config.CacheResultFields
 	qe.consolidator = sync2.NewConsolidator()
@@ -184,6 +234,7 @@ func NewQueryEngine(env tabletenv.Env, se *schema.Engine) *QueryEngine {
 		qe.streamConsolidator = NewStreamConsolidator(config.ConsolidatorStreamTotalSize, config.ConsolidatorStreamQuerySize, returnStreamResult)
 	}
 	qe.txSerializer = txserializer.New(env)
+	qe.resultCache = NewResultCache(env)
 
 	qe.strictTableACL = config.StrictTableACL
 	qe.enableTableACLDryRun = config.EnableTableACLDryRun
@@ -226,13 +277,19 @@ func NewQueryEngine(env tabletenv.Env, se *schema.Engine) *QueryEngine {
 	qe.queryTimes = env.Exporter().NewCountersWithMultiLabels("QueryTimesNs", "query times in ns", []string{"Table", "Plan"})
 	qe.queryRowCounts = env.Exporter().NewCountersWithMultiLabels("QueryRowCounts", "query row counts", []string{"Table", "Plan"})
 	qe.queryErrorCounts = env.Exporter().NewCountersWithMultiLabels("QueryErrorCounts", "query error counts", []string{"Table", "Plan"})
+	qe.queryRuleHits = env.Exporter().NewCountersWithMultiLabels("QueryRuleHits", "number of queries matching a query rule, by rule source and name", []string{"Source", "Rule"})
+	env.Exporter().NewCountersFuncWithMultiLabels("QueryConsolidations", "number of queries consolidated with an identical one, by query fingerprint (see /debug/query_stats)", []string{"Table", "Plan", "Fingerprint"}, qe.consolidationCounts)
 
 	env.Exporter().HandleFunc("/debug/hotrows", qe.txSerializer.ServeHTTP)
+	env.Exporter().HandleFunc("/debug/hotrows/keys", qe.txSerializer.ServeHotKeysHTTP)
 	env.Exporter().HandleFunc("/debug/tablet_plans", qe.handleHTTPQueryPlans)
 	env.Exporter().HandleFunc("/debug/query_stats", qe.handleHTTPQueryStats)
 	env.Exporter().HandleFunc("/debug/query_rules", qe.handleHTTPQueryRules)
 	env.Exporter().HandleFunc("/debug/consolidations", qe.handleHTTPConsolidations)
 	env.Exporter().HandleFunc("/debug/acl", qe.handleHTTPAclJSON)
+	env.Exporter().HandleFunc("/debug/query_plan_cache", qe.handleHTTPQueryPlanCache)
+	env.Exporter().HandleFunc("/debug/query_plan_cache/pin", qe.handleHTTPPinPlan)
+	env.Exporter().HandleFunc("/debug/query_plan_cache/unpin", qe.handleHTTPUnpinPlan)
 
 	return qe
 }
@@ -262,8 +319,11 @@ func (qe *QueryEngine) Open() error {
 	}
 
 	qe.streamConns.Open(qe.env.Config().DB.AppWithDB(), qe.env.Config().DB.DbaWithDB(), qe.env.Config().DB.AppDebugWithDB())
+	qe.workloadConns.Open(qe.env.Config().DB.AppWithDB(), qe.env.Config().DB.DbaWithDB(), qe.env.Config().DB.AppDebugWithDB())
+	qe.workloadStreamConns.Open(qe.env.Config().DB.AppWithDB(), qe.env.Config().DB.DbaWithDB(), qe.env.Config().DB.AppDebugWithDB())
 	qe.se.RegisterNotifier("qe", qe.schemaChanged)
 	qe.isOpen = true
+	qe.warmUpPlans(qe.env.Config().QueryCacheWarmupFile)
 	return nil
 }
 
@@ -277,7 +337,10 @@ func (qe *QueryEngine) Close() {
 	// Close in reverse order of Open.
 	qe.se.UnregisterNotifier("qe")
 	qe.plans.Clear()
+	qe.unsetPinnedPlans()
 	qe.tables = make(map[string]*schema.Table)
+	qe.workloadStreamConns.Close()
+	qe.workloadConns.Close()
 	qe.streamConns.Close()
 	qe.conns.Close()
 	qe.isOpen = false
@@ -310,7 +373,7 @@ func (qe *QueryEngine) GetPlan(ctx context.Context, logStats *tabletenv.LogStats
 	if err != nil {
 		return nil, err
 	}
-	plan := &TabletPlan{Plan: splan, Original: sql}
+	plan := &TabletPlan{Plan: splan, Original: sql, fingerprint: sqlparser.Fingerprint(statement)}
 	plan.Rules = qe.queryRuleSources.FilterByPlan(sql, plan.PlanID, plan.TableName().String())
 	plan.buildAuthorized()
 	if plan.PlanID.IsSelect() {
@@ -335,6 +398,7 @@ func (qe *QueryEngine) GetPlan(ctx context.Context, logStats *tabletenv.LogStats
 	}
 	if !skipQueryPlanCache && !sqlparser.SkipQueryPlanCacheDirective(statement) {
 		qe.plans.Set(sql, plan)
+		qe.setPinnedPlan(sql, plan)
 	}
 	return plan, nil
 }
@@ -348,7 +412,7 @@ func (qe *QueryEngine) GetStreamPlan(sql string, isReservedConn bool) (*TabletPl
 	if err != nil {
 		return nil, err
 	}
-	plan := &TabletPlan{Plan: splan, Original: sql}
+	plan := &TabletPlan{Plan: splan, Original: sql, fingerprint: sqlparser.FingerprintQuery(sql)}
 	plan.Rules = qe.queryRuleSources.FilterByPlan(sql, plan.PlanID, plan.TableName().String())
 	plan.buildAuthorized()
 	return plan, nil
@@ -362,7 +426,7 @@ func (qe *QueryEngine) GetMessageStreamPlan(name string) (*TabletPlan, error) {
 	if err != nil {
 		return nil, err
 	}
-	plan := &TabletPlan{Plan: splan}
+	plan := &TabletPlan{Plan: splan, fingerprint: sqlparser.FingerprintQuery("stream from " + name)}
 	plan.Rules = qe.queryRuleSources.FilterByPlan("stream from "+name, plan.PlanID, plan.TableName().String())
 	plan.buildAuthorized()
 	return plan, nil
@@ -371,6 +435,7 @@ func (qe *QueryEngine) GetMessageStreamPlan(name string) (*TabletPlan, error) {
 // ClearQueryPlanCache should be called if query plan cache is potentially obsolete
 func (qe *QueryEngine) ClearQueryPlanCache() {
 	qe.plans.Clear()
+	qe.unsetPinnedPlans()
 }
 
 // IsMySQLReachable returns an error if it cannot connect to MySQL.
@@ -393,15 +458,21 @@ func (qe *QueryEngine) schemaChanged(tables map[string]*schema.Table, created, a
 	qe.tables = tables
 	if len(altered) != 0 || len(dropped) != 0 {
 		qe.plans.Clear()
+		qe.unsetPinnedPlans()
 	}
 }
 
-// getQuery fetches the plan and makes it the most recent.
+// getQuery fetches the plan and makes it the most recent. The pinned plans
+// are found even once they were evicted from the cache.
 func (qe *QueryEngine) getQuery(sql string) *TabletPlan {
+	var plan *TabletPlan
 	if cacheResult, ok := qe.plans.Get(sql); ok {
-		return cacheResult.(*TabletPlan)
+		plan = cacheResult.(*TabletPlan)
+	} else if plan = qe.pinnedPlan(sql); plan == nil {
+		return nil
 	}
-	return nil
+	plan.markUsed()
+	return plan
 }
 
 // SetQueryPlanCacheCap sets the query plan cache capacity.
@@ -433,16 +504,32 @@ func (qe *QueryEngine) AddStats(planName, tableName string, queryCount int64, du
 	qe.queryErrorCounts.Add(keys, errorCount)
 }
 
+// consolidationCounts returns the number of consolidations of the cached
+// plans which were consolidated at least once.
+func (qe *QueryEngine) consolidationCounts() map[string]int64 {
+	counts := make(map[string]int64)
+	qe.plans.ForEach(func(value interface{}) bool {
+		plan := value.(*TabletPlan)
+		if count := atomic.LoadUint64(&plan.Consolidations); count > 0 {
+			counts[plan.TableName().String()+"."+plan.PlanID.String()+"."+plan.Fingerprint()] += int64(count)
+		}
+		return true
+	})
+	return counts
+}
+
 type perQueryStats struct {
-	Query        string
-	Table        string
-	Plan         planbuilder.PlanType
-	QueryCount   uint64
-	Time         time.Duration
-	MysqlTime    time.Duration
-	RowsAffected uint64
-	RowsReturned uint64
-	ErrorCount   uint64
+	Query          string
+	Fingerprint    string
+	Table          string
+	Plan           planbuilder.PlanType
+	QueryCount     uint64
+	Time           time.Duration
+	MysqlTime      time.Duration
+	RowsAffected   uint64
+	RowsReturned   uint64
+	ErrorCount     uint64
+	Consolidations uint64
 }
 
 func (qe *QueryEngine) handleHTTPQueryPlans(response http.ResponseWriter, request *http.Request) {
@@ -477,9 +564,11 @@ func (qe *QueryEngine) handleHTTPQueryStats(response http.ResponseWriter, reques
 
 		var pqstats perQueryStats
 		pqstats.Query = unicoded(sqlparser.TruncateForUI(plan.Original))
+		pqstats.Fingerprint = plan.Fingerprint()
 		pqstats.Table = plan.TableName().String()
 		pqstats.Plan = plan.PlanID
 		pqstats.QueryCount, pqstats.Time, pqstats.MysqlTime, pqstats.RowsAffected, pqstats.RowsReturned, pqstats.ErrorCount = plan.Stats()
+		pqstats.Consolidations = atomic.LoadUint64(&plan.Consolidations)
 
 		qstats = append(qstats, pqstats)
 		return true
@@ -91,7 +91,19 @@ var sequenceFields = []*querypb.Field{
 	},
 }
 
+// fingerprint returns the fingerprint of the query: the one of the query
+// vtgate executes, if it sent it, or else the one of the plan.
+func (qre *QueryExecutor) fingerprint() string {
+	if fingerprint := qre.options.GetFingerprint(); fingerprint != "" {
+		return fingerprint
+	}
+	return qre.plan.Fingerprint()
+}
+
 func (qre *QueryExecutor) shouldConsolidate() bool {
+	if qre.plan.SkipConsolidator {
+		return false
+	}
 	cm := qre.tsv.qe.consolidatorMode.Get()
 	return cm == tabletenv.Enable || ((cm == tabletenv.NotOnMaster || cm == tabletenv.NotOnPrimary) && qre.tabletType != topodatapb.TabletType_PRIMARY)
 }
@@ -100,10 +112,12 @@ func (qre *QueryExecutor) shouldConsolidate() bool {
 func (qre *QueryExecutor) Execute() (reply *sqltypes.Result, err error) {
 	planName := qre.plan.PlanID.String()
 	qre.logStats.PlanType = planName
+	qre.logStats.Fingerprint = qre.fingerprint()
 	defer func(start time.Time) {
 		duration := time.Since(start)
-		qre.tsv.stats.QueryTimings.Add(planName, duration)
+		qre.tsv.stats.QueryTimings.AddWithTraceID(planName, duration, trace.TraceID(qre.ctx))
 		qre.recordUserQuery("Execute", int64(duration))
+		qre.recordWorkloadQuery(start)
 
 		mysqlTime := qre.logStats.MysqlResponseTime
 		tableName := qre.plan.TableName().String()
@@ -126,6 +140,9 @@ func (qre *QueryExecutor) Execute() (reply *sqltypes.Result, err error) {
 	if err := qre.checkPermissions(); err != nil {
 		return nil, err
 	}
+	if err := qre.checkThrottler(); err != nil {
+		return nil, err
+	}
 
 	switch qre.plan.PlanID {
 	case p.PlanNextval:
@@ -147,7 +164,14 @@ func (qre *QueryExecutor) Execute() (reply *sqltypes.Result, err error) {
 			return nil, err
 		}
 		defer conn.Unlock()
-		return qre.txConnExec(conn)
+		qr, err := qre.txConnExec(conn)
+		if err != nil {
+			return nil, err
+		}
+		if warning := qre.tsv.te.txPool.TimeoutWarning(conn); warning != nil {
+			qr.Warnings = append(qr.Warnings, warning)
+		}
+		return qr, nil
 	}
 
 	switch qre.plan.PlanID {
@@ -269,15 +293,20 @@ func (qre *QueryExecutor) txConnExec(conn *StatefulConnection) (*sqltypes.Result
 // Stream performs a streaming query execution.
 func (qre *QueryExecutor) Stream(callback StreamCallback) error {
 	qre.logStats.PlanType = qre.plan.PlanID.String()
+	qre.logStats.Fingerprint = qre.fingerprint()
 
 	defer func(start time.Time) {
-		qre.tsv.stats.QueryTimings.Record(qre.plan.PlanID.String(), start)
+		qre.tsv.stats.QueryTimings.RecordWithTraceID(qre.plan.PlanID.String(), start, trace.TraceID(qre.ctx))
 		qre.recordUserQuery("Stream", int64(time.Since(start)))
+		qre.recordWorkloadQuery(start)
 	}(time.Now())
 
 	if err := qre.checkPermissions(); err != nil {
 		return err
 	}
+	if err := qre.checkThrottler(); err != nil {
+		return err
+	}
 
 	sql, sqlWithoutComments, err := qre.generateFinalSQL(qre.plan.FullQuery, qre.bindVars)
 	if err != nil {
@@ -291,7 +320,7 @@ func (qre *QueryExecutor) Stream(callback StreamCallback) error {
 
 	if consolidator := qre.tsv.qe.streamConsolidator; consolidator != nil {
 		if qre.connID == 0 && qre.plan.PlanID == p.PlanSelectStream && qre.shouldConsolidate() {
-			return consolidator.Consolidate(qre.logStats, sqlWithoutComments, callback,
+			err := consolidator.Consolidate(qre.logStats, qre.consolidationKey(sqlWithoutComments), callback,
 				func(callback StreamCallback) error {
 					dbConn, err := qre.getStreamConn()
 					if err != nil {
@@ -309,6 +338,11 @@ func (qre *QueryExecutor) Stream(callback StreamCallback) error {
 						return callback(result)
 					})
 				})
+			if qre.logStats.QuerySources&tabletenv.QuerySourceConsolidator != 0 {
+				qre.plan.AddConsolidation()
+				qre.tsv.qe.consolidator.Record(sqlWithoutComments)
+			}
+			return err
 		}
 	}
 
@@ -346,9 +380,10 @@ func (qre *QueryExecutor) Stream(callback StreamCallback) error {
 func (qre *QueryExecutor) MessageStream(callback StreamCallback) error {
 	qre.logStats.OriginalSQL = qre.query
 	qre.logStats.PlanType = qre.plan.PlanID.String()
+	qre.logStats.Fingerprint = qre.fingerprint()
 
 	defer func(start time.Time) {
-		qre.tsv.stats.QueryTimings.Record(qre.plan.PlanID.String(), start)
+		qre.tsv.stats.QueryTimings.RecordWithTraceID(qre.plan.PlanID.String(), start, trace.TraceID(qre.ctx))
 		qre.recordUserQuery("MessageStream", int64(time.Since(start)))
 	}(time.Now())
 
@@ -387,12 +422,17 @@ func (qre *QueryExecutor) checkPermissions() error {
 		remoteAddr = ci.RemoteAddr()
 		username = ci.Username()
 	}
-	action, desc := qre.plan.Rules.GetAction(remoteAddr, username, qre.bindVars, qre.marginComments)
+	action, qr := qre.plan.Rules.GetActionRule(remoteAddr, username, qre.bindVars, qre.marginComments, func(qr *rules.Rule) {
+		qre.tsv.qe.queryRuleHits.Add([]string{qr.Source(), qr.Name}, 1)
+	})
 	switch action {
 	case rules.QRFail:
-		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "disallowed due to rule: %s", desc)
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "disallowed due to rule: %s", qr.Description)
 	case rules.QRFailRetry:
-		return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "disallowed due to rule: %s", desc)
+		return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "disallowed due to rule: %s", qr.Description)
+	}
+	if err := qre.tsv.keyspacePolicy.checkDenied(qre.ctx, qre.fingerprint(), qre.options); err != nil {
+		return err
 	}
 
 	// Skip ACL check for queries against the dummy dual table
@@ -594,6 +634,32 @@ func (qre *QueryExecutor) execNextval() (*sqltypes.Result, error) {
 // execSelect sends a query to mysql only if another identical query is not running. Otherwise, it waits and
 // reuses the result. If the plan is missing field info, it sends the query to mysql requesting full info.
 func (qre *QueryExecutor) execSelect() (*sqltypes.Result, error) {
+	if qre.tsv.qe.resultCache.tables(qre.plan) != nil {
+		return qre.execCachedSelect()
+	}
+	return qre.execUncachedSelect()
+}
+
+// execCachedSelect serves a SELECT from the result cache, or caches its
+// result.
+func (qre *QueryExecutor) execCachedSelect() (*sqltypes.Result, error) {
+	_, sqlWithoutComments, err := qre.generateFinalSQL(qre.plan.FullQuery, qre.bindVars)
+	if err != nil {
+		return nil, err
+	}
+	result, snapshot := qre.tsv.qe.resultCache.Get(qre.plan, sqlWithoutComments)
+	if result != nil {
+		qre.logStats.QuerySources |= tabletenv.QuerySourceResultCache
+		return result, nil
+	}
+	result, err = qre.execUncachedSelect()
+	if err == nil && snapshot != nil {
+		qre.tsv.qe.resultCache.Set(snapshot, result)
+	}
+	return result, err
+}
+
+func (qre *QueryExecutor) execUncachedSelect() (*sqltypes.Result, error) {
 	if qre.tsv.qe.enableQueryPlanFieldCaching && qre.plan.Fields != nil {
 		result, err := qre.qFetch(qre.logStats, qre.plan.FullQuery, qre.bindVars)
 		if err != nil {
@@ -618,7 +684,7 @@ func (qre *QueryExecutor) execSelect() (*sqltypes.Result, error) {
 }
 
 func (qre *QueryExecutor) execDMLLimit(conn *StatefulConnection) (*sqltypes.Result, error) {
-	maxrows := qre.tsv.qe.maxResultSize.Get()
+	maxrows := qre.maxResultSize()
 	qre.bindVars["#maxLimit"] = sqltypes.Int64BindVariable(maxrows + 1)
 	result, err := qre.txFetch(conn, true)
 	if err != nil {
@@ -660,7 +726,7 @@ func (qre *QueryExecutor) getConn() (*connpool.DBConn, error) {
 	defer span.Finish()
 
 	start := time.Now()
-	conn, err := qre.tsv.qe.conns.Get(ctx)
+	conn, err := qre.tsv.qe.workloadConns.get(ctx, qre.workloadName(), qre.tsv.qe.conns).Get(ctx)
 	switch err {
 	case nil:
 		qre.logStats.WaitingForConnection += time.Since(start)
@@ -676,7 +742,7 @@ func (qre *QueryExecutor) getStreamConn() (*connpool.DBConn, error) {
 	defer span.Finish()
 
 	start := time.Now()
-	conn, err := qre.tsv.qe.streamConns.Get(ctx)
+	conn, err := qre.tsv.qe.workloadStreamConns.get(ctx, qre.workloadName(), qre.tsv.qe.streamConns).Get(ctx)
 	switch err {
 	case nil:
 		qre.logStats.WaitingForConnection += time.Since(start)
@@ -694,7 +760,7 @@ func (qre *QueryExecutor) qFetch(logStats *tabletenv.LogStats, parsedQuery *sqlp
 	}
 	// Check tablet type.
 	if qre.shouldConsolidate() {
-		q, original := qre.tsv.qe.consolidator.Create(sqlWithoutComments)
+		q, original := qre.tsv.qe.consolidator.Create(qre.consolidationKey(sqlWithoutComments))
 		if original {
 			defer q.Broadcast()
 			conn, err := qre.getConn()
@@ -707,6 +773,7 @@ func (qre *QueryExecutor) qFetch(logStats *tabletenv.LogStats, parsedQuery *sqlp
 			}
 		} else {
 			logStats.QuerySources |= tabletenv.QuerySourceConsolidator
+			qre.plan.AddConsolidation()
 			startTime := time.Now()
 			q.Wait()
 			qre.tsv.stats.WaitTimings.Record("Consolidations", startTime)
@@ -750,14 +817,15 @@ func (qre *QueryExecutor) generateFinalSQL(parsedQuery *sqlparser.ParsedQuery, b
 	if err != nil {
 		return "", "", vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%s", err)
 	}
+	sql := qre.addMaxExecutionTimeHint(query)
 
 	if qre.marginComments.Leading == "" && qre.marginComments.Trailing == "" {
-		return query, query, nil
+		return sql, query, nil
 	}
 
 	var buf strings.Builder
 	buf.WriteString(qre.marginComments.Leading)
-	buf.WriteString(query)
+	buf.WriteString(sql)
 	buf.WriteString(qre.marginComments.Trailing)
 	return buf.String(), query, nil
 }
@@ -871,8 +939,13 @@ func (qre *QueryExecutor) drainResultSetOnConn(conn *connpool.DBConn) error {
 	return nil
 }
 
+// maxResultSize returns the row count limit of the query.
+func (qre *QueryExecutor) maxResultSize() int64 {
+	return qre.tsv.keyspacePolicy.maxRows(qre.tsv.qe.maxResultSize.Get(), qre.options)
+}
+
 func (qre *QueryExecutor) getSelectLimit() int64 {
-	maxRows := qre.tsv.qe.maxResultSize.Get()
+	maxRows := qre.maxResultSize()
 	sqlLimit := qre.options.GetSqlSelectLimit()
 	if sqlLimit > 0 && sqlLimit < maxRows {
 		return sqlLimit
@@ -880,17 +953,25 @@ func (qre *QueryExecutor) getSelectLimit() int64 {
 	return maxRows
 }
 
+func (qre *QueryExecutor) newQueryDetail(conn killable) *QueryDetail {
+	qd := NewQueryDetail(qre.logStats.Ctx, conn)
+	if qre.plan != nil {
+		qd.fingerprint = qre.fingerprint()
+	}
+	return qd
+}
+
 func (qre *QueryExecutor) execDBConn(conn *connpool.DBConn, sql string, wantfields bool) (*sqltypes.Result, error) {
 	span, ctx := trace.NewSpan(qre.ctx, "QueryExecutor.execDBConn")
 	defer span.Finish()
 
 	defer qre.logStats.AddRewrittenSQL(sql, time.Now())
 
-	qd := NewQueryDetail(qre.logStats.Ctx, conn)
+	qd := qre.newQueryDetail(conn)
 	qre.tsv.statelessql.Add(qd)
 	defer qre.tsv.statelessql.Remove(qd)
 
-	return conn.Exec(ctx, sql, int(qre.tsv.qe.maxResultSize.Get()), wantfields)
+	return conn.Exec(ctx, sql, int(qre.maxResultSize()), wantfields)
 }
 
 func (qre *QueryExecutor) execStatefulConn(conn *StatefulConnection, sql string, wantfields bool) (*sqltypes.Result, error) {
@@ -899,11 +980,11 @@ func (qre *QueryExecutor) execStatefulConn(conn *StatefulConnection, sql string,
 
 	defer qre.logStats.AddRewrittenSQL(sql, time.Now())
 
-	qd := NewQueryDetail(qre.logStats.Ctx, conn)
+	qd := qre.newQueryDetail(conn)
 	qre.tsv.statefulql.Add(qd)
 	defer qre.tsv.statefulql.Remove(qd)
 
-	return conn.Exec(ctx, sql, int(qre.tsv.qe.maxResultSize.Get()), wantfields)
+	return conn.Exec(ctx, sql, int(qre.maxResultSize()), wantfields)
 }
 
 func (qre *QueryExecutor) execStreamSQL(conn *connpool.DBConn, sql string, callback func(*sqltypes.Result) error) error {
@@ -914,7 +995,7 @@ func (qre *QueryExecutor) execStreamSQL(conn *connpool.DBConn, sql string, callb
 		return callback(result)
 	}
 
-	qd := NewQueryDetail(qre.logStats.Ctx, conn)
+	qd := qre.newQueryDetail(conn)
 	qre.tsv.olapql.Add(qd)
 	defer qre.tsv.olapql.Remove(qd)
 
@@ -22,7 +22,9 @@ import (
 	"math/rand"
 	"reflect"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tx"
 
@@ -33,10 +35,12 @@ import (
 
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/mysql/fakesqldb"
+	"vitess.io/vitess/go/protoutil"
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/callerid"
 	"vitess.io/vitess/go/vt/callinfo"
 	"vitess.io/vitess/go/vt/callinfo/fakecallinfo"
+	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/tableacl"
 	"vitess.io/vitess/go/vt/tableacl/simpleacl"
 	"vitess.io/vitess/go/vt/topo/memorytopo"
@@ -556,6 +560,81 @@ func TestQueryExecutorPlanPassSelectWithLockOutsideATransaction(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestQueryExecutorPlanSelectResultCache(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "select * from test_table"
+	want := &sqltypes.Result{
+		Fields: getTestTableFields(),
+		Rows:   [][]sqltypes.Value{{sqltypes.NewInt32(1), sqltypes.NewInt32(2), sqltypes.NewInt32(3)}},
+	}
+	db.AddQuery("select * from test_table limit 10001", want)
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, enableResultCache, db)
+	defer tsv.StopService()
+	// The binlog watcher would activate the result cache on a replica.
+	tsv.qe.resultCache.setActive(true)
+
+	qre := newTestQueryExecutor(ctx, tsv, query, 0)
+	got, err := qre.Execute()
+	require.NoError(t, err)
+	assert.Equal(t, want.Rows, got.Rows)
+	assert.Equal(t, "mysql", qre.logStats.FmtQuerySources())
+
+	qre = newTestQueryExecutor(ctx, tsv, query, 0)
+	got, err = qre.Execute()
+	require.NoError(t, err)
+	assert.Equal(t, want.Rows, got.Rows)
+	assert.Equal(t, "resultcache", qre.logStats.FmtQuerySources())
+	assert.Equal(t, 1, db.GetQueryCalledNum("select * from test_table limit 10001"))
+
+	// Once invalidated, the query goes to MySQL again.
+	tsv.qe.resultCache.Invalidate("test_table")
+	qre = newTestQueryExecutor(ctx, tsv, query, 0)
+	_, err = qre.Execute()
+	require.NoError(t, err)
+	assert.Equal(t, 2, db.GetQueryCalledNum("select * from test_table limit 10001"))
+}
+
+func TestQueryExecutorConsolidations(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "select * from test_table"
+	db.AddQuery("select * from test_table limit 10001", &sqltypes.Result{Fields: getTestTableFields()})
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+
+	// The first query is held in MySQL until the second one waits for it.
+	db.SetBeforeFunc("select * from test_table limit 10001", func() {
+		for len(tsv.qe.consolidator.Items()) == 0 {
+			time.Sleep(time.Millisecond)
+		}
+	})
+	qres := []*QueryExecutor{newTestQueryExecutor(ctx, tsv, query, 0)}
+	tsv.qe.plans.Wait()
+	qres = append(qres, newTestQueryExecutor(ctx, tsv, query, 0))
+	var wg sync.WaitGroup
+	for _, qre := range qres {
+		wg.Add(1)
+		go func(qre *QueryExecutor) {
+			defer wg.Done()
+			_, err := qre.Execute()
+			assert.NoError(t, err)
+		}(qre)
+	}
+	wg.Wait()
+	assert.Equal(t, 1, db.GetQueryCalledNum("select * from test_table limit 10001"))
+
+	plan := qres[0].plan
+	assert.EqualValues(t, 1, plan.Consolidations)
+	assert.Equal(t, map[string]int64{"test_table.Select." + plan.Fingerprint(): 1}, tsv.qe.consolidationCounts())
+
+	// The SKIP_CONSOLIDATOR directive opts out.
+	assert.True(t, newTestQueryExecutor(ctx, tsv, query, 0).shouldConsolidate())
+	assert.False(t, newTestQueryExecutor(ctx, tsv, "select /*vt+ SKIP_CONSOLIDATOR=1 */ * from test_table", 0).shouldConsolidate())
+}
+
 func TestQueryExecutorPlanNextval(t *testing.T) {
 	db := setUpQueryExecutorTest(t)
 	defer db.Close()
@@ -1132,6 +1211,98 @@ func TestQueryExecutorDenyListQRRetry(t *testing.T) {
 	}
 }
 
+func TestQueryExecutorKeyspaceQueryRules(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "select * from test_table where name = 1 limit 1000"
+	expected := &sqltypes.Result{
+		Fields: getTestTableFields(),
+	}
+	db.AddQuery("select * from test_table where `name` = 1 limit 1000", expected)
+	db.AddQuery("select * from test_table where 1 != 1", &sqltypes.Result{
+		Fields: getTestTableFields(),
+	})
+
+	ctx := callinfo.NewContext(context.Background(), &fakecallinfo.FakeCallInfo{User: "app"})
+	adminCtx := callinfo.NewContext(context.Background(), &fakecallinfo.FakeCallInfo{User: "admin"})
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+	tsv.setKeyspaceQueryRules([]*topodatapb.QueryRule{{
+		Name:   "allow-admin",
+		User:   "admin",
+		Action: "ALLOW",
+	}, {
+		Name:        "deny-scan",
+		Description: "scans test_table",
+		Fingerprint: sqlparser.FingerprintQuery(query),
+		Action:      "FAIL",
+	}, {
+		Name:   "invalid",
+		Action: "DROP",
+	}})
+
+	qre := newTestQueryExecutor(ctx, tsv, query, 0)
+	_, err := qre.Execute()
+	assert.Equal(t, vtrpcpb.Code_INVALID_ARGUMENT, vterrors.Code(err))
+	assert.Contains(t, err.Error(), "disallowed due to rule: scans test_table")
+
+	qre = newTestQueryExecutor(adminCtx, tsv, query, 0)
+	_, err = qre.Execute()
+	require.NoError(t, err)
+
+	hits := tsv.qe.queryRuleHits.Counts()
+	assert.EqualValues(t, 1, hits[keyspaceQueryRuleSource+".deny-scan"])
+	assert.EqualValues(t, 1, hits[keyspaceQueryRuleSource+".allow-admin"])
+
+	// Removing the rules of the keyspace lets the query run.
+	tsv.setKeyspaceQueryRules(nil)
+	qre = newTestQueryExecutor(ctx, tsv, query, 0)
+	_, err = qre.Execute()
+	require.NoError(t, err)
+}
+
+func TestQueryExecutorVTGateFingerprint(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "select * from test_table where name = 1 limit 1000"
+	db.AddQuery("select * from test_table where `name` = 1 limit 1000", &sqltypes.Result{
+		Fields: getTestTableFields(),
+	})
+	db.AddQuery("select * from test_table where 1 != 1", &sqltypes.Result{
+		Fields: getTestTableFields(),
+	})
+
+	ctx := callinfo.NewContext(context.Background(), &fakecallinfo.FakeCallInfo{User: "app"})
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+
+	// Without the fingerprint of vtgate, the one of the plan is used.
+	qre := newTestQueryExecutor(ctx, tsv, query, 0)
+	_, err := qre.Execute()
+	require.NoError(t, err)
+	assert.Equal(t, qre.plan.Fingerprint(), qre.logStats.Fingerprint)
+
+	qre = newTestQueryExecutor(ctx, tsv, query, 0)
+	qre.options = &querypb.ExecuteOptions{Fingerprint: "vtgatefp"}
+	_, err = qre.Execute()
+	require.NoError(t, err)
+	assert.Equal(t, "vtgatefp", qre.logStats.Fingerprint)
+
+	// The denied queries of the keyspace policy match the fingerprint of
+	// vtgate, not the one of the plan.
+	tsv.keyspacePolicy.set(&topodatapb.SrvKeyspace{Policy: &topodatapb.KeyspacePolicy{
+		DeniedQueries: []*topodatapb.DeniedQuery{{
+			Fingerprint: "vtgatefp",
+			ExpireTime:  protoutil.TimeToProto(time.Now().Add(time.Hour)),
+		}},
+	}})
+	_, err = qre.Execute()
+	assert.Equal(t, vtrpcpb.Code_FAILED_PRECONDITION, vterrors.Code(err))
+	qre = newTestQueryExecutor(ctx, tsv, query, 0)
+	_, err = qre.Execute()
+	require.NoError(t, err)
+}
+
 type executorFlags int64
 
 const (
@@ -1142,6 +1313,7 @@ const (
 	shortTwopcAge
 	smallResultSize
 	disableOnlineDDL
+	enableResultCache
 )
 
 // newTestQueryExecutor uses a package level variable testTabletServer defined in tabletserver_test.go
@@ -1177,6 +1349,10 @@ func newTestTabletServer(ctx context.Context, flags executorFlags, db *fakesqldb
 	if flags&smallResultSize > 0 {
 		config.Oltp.MaxRows = 2
 	}
+	if flags&enableResultCache > 0 {
+		config.ResultCache.MemoryBytes = 1024 * 1024
+		config.ResultCache.Tables = map[string]tabletenv.ResultCacheTableConfig{"test_table": {}}
+	}
 	dbconfigs := newDBConfigs(db)
 	config.DB = dbconfigs
 	tsv := NewTabletServer("TabletServerTest", config, memorytopo.NewServer(""), &topodatapb.TabletAlias{})
@@ -25,6 +25,7 @@ import (
 	"context"
 
 	"vitess.io/vitess/go/streamlog"
+	"vitess.io/vitess/go/vt/callerid"
 	"vitess.io/vitess/go/vt/callinfo"
 	"vitess.io/vitess/go/vt/sqlparser"
 )
@@ -35,6 +36,10 @@ type QueryDetail struct {
 	conn   killable
 	connID int64
 	start  time.Time
+
+	// fingerprint is the fingerprint of the query, see
+	// QueryExecutor.fingerprint, if any.
+	fingerprint string
 }
 
 type killable interface {
@@ -90,6 +95,27 @@ func (ql *QueryList) Terminate(connID int64) bool {
 	return true
 }
 
+// TerminateMatching kills the connections of the queries of a fingerprint
+// and of an immediate caller, an empty one matching any, and returns the
+// number of killed queries.
+func (ql *QueryList) TerminateMatching(fingerprint, user string) int {
+	ql.mu.Lock()
+	defer ql.mu.Unlock()
+	killed := 0
+	for _, qd := range ql.queryDetails {
+		if fingerprint != "" && qd.fingerprint != fingerprint {
+			continue
+		}
+		if user != "" && callerid.GetUsername(callerid.ImmediateCallerIDFromContext(qd.ctx)) != user {
+			continue
+		}
+		if err := qd.conn.Kill("QueryList.TerminateMatching()", time.Since(qd.start)); err == nil {
+			killed++
+		}
+	}
+	return killed
+}
+
 // TerminateAll terminates all queries and kills the MySQL connections
 func (ql *QueryList) TerminateAll() {
 	ql.mu.Lock()
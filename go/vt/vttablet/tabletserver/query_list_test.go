@@ -21,6 +21,8 @@ import (
 	"time"
 
 	"context"
+
+	"vitess.io/vitess/go/vt/callerid"
 )
 
 type testConn struct {
@@ -66,3 +68,25 @@ func TestQueryList(t *testing.T) {
 		t.Errorf("failed to remove from QueryList")
 	}
 }
+
+func TestQueryListTerminateMatching(t *testing.T) {
+	ql := NewQueryList("test")
+	appCtx := callerid.NewContext(context.Background(), nil, callerid.NewImmediateCallerID("app"))
+	batchCtx := callerid.NewContext(context.Background(), nil, callerid.NewImmediateCallerID("batch"))
+	conns := []*testConn{{id: 1}, {id: 2}, {id: 3}}
+	for i, ctx := range []context.Context{appCtx, appCtx, batchCtx} {
+		qd := NewQueryDetail(ctx, conns[i])
+		qd.fingerprint = []string{"f1", "f2", "f1"}[i]
+		ql.Add(qd)
+	}
+
+	if killed := ql.TerminateMatching("f1", "app"); killed != 1 || !conns[0].killed || conns[2].killed {
+		t.Errorf("TerminateMatching(f1, app) killed %d queries", killed)
+	}
+	if killed := ql.TerminateMatching("", "batch"); killed != 1 || !conns[2].killed || conns[1].killed {
+		t.Errorf("TerminateMatching(, batch) killed %d queries", killed)
+	}
+	if killed := ql.TerminateMatching("f3", ""); killed != 0 {
+		t.Errorf("TerminateMatching(f3, ) killed %d queries", killed)
+	}
+}
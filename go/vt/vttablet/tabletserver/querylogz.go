@@ -26,6 +26,7 @@ import (
 	"time"
 
 	"vitess.io/vitess/go/acl"
+	"vitess.io/vitess/go/streamlog"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/logz"
 	"vitess.io/vitess/go/vt/sqlparser"
@@ -102,6 +103,11 @@ func querylogzHandler(ch chan interface{}, w http.ResponseWriter, r *http.Reques
 		acl.SendError(w, err)
 		return
 	}
+	filter, err := streamlog.ParseFilter(r.URL.Query())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
 	timeout, limit := parseTimeoutLimitParams(r)
 	logz.StartHTMLTable(w)
 	defer logz.EndHTMLTable(w)
@@ -117,6 +123,11 @@ func querylogzHandler(ch chan interface{}, w http.ResponseWriter, r *http.Reques
 				return
 			default:
 			}
+			if !filter.Match(out) {
+				// The filtered out messages do not count towards the limit.
+				i--
+				continue
+			}
 			stats, ok := out.(*tabletenv.LogStats)
 			if !ok {
 				err := fmt.Errorf("unexpected value in %s: %#v (expecting value of type %T)", tabletenv.TxLogger.Name(), out, &tabletenv.LogStats{})
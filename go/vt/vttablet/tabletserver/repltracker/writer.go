@@ -31,6 +31,7 @@ import (
 	"vitess.io/vitess/go/timer"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/sidecardb"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/connpool"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
@@ -54,6 +55,10 @@ var withDDL = withddl.New([]string{
 	fmt.Sprintf(sqlCreateHeartbeatTable, "_vt"),
 })
 
+func init() {
+	sidecardb.Register("heartbeat", withDDL.DDLs())
+}
+
 // heartbeatWriter runs on primary tablets and writes heartbeats to the _vt.heartbeat
 // table at a regular interval, defined by heartbeat_interval.
 type heartbeatWriter struct {
@@ -0,0 +1,264 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/cache"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/planbuilder"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+)
+
+// ResultCache caches the results of the SELECTs which only read from the
+// tables configured in tabletenv.ResultCacheConfig, keyed by their final SQL.
+//
+// Results are invalidated per table, as the BinlogWatcher sees the DMLs of
+// the table in the replication stream. Results are only cached and served
+// while the BinlogWatcher is streaming, i.e. on non-primary tablets: a
+// primary would have to invalidate on commit, which it doesn't do.
+//
+// Instead of tracking the results of every table, the cache keeps a
+// generation number per table, which is incremented on invalidation. A
+// result is valid as long as the generations of its tables didn't change
+// since the query was sent to MySQL.
+type ResultCache struct {
+	config tabletenv.ResultCacheConfig
+
+	// entries is thread-safe. The values are *resultCacheEntry.
+	entries *cache.LRUCache
+
+	mu sync.Mutex
+	// active is true while the BinlogWatcher streams the replication stream.
+	active bool
+	// generation is incremented when all results are invalidated.
+	generation int64
+	// tableGenerations is incremented when the results of a table are
+	// invalidated.
+	tableGenerations map[string]int64
+
+	hits, misses, invalidations *stats.CountersWithSingleLabel
+}
+
+type resultCacheEntry struct {
+	result           *sqltypes.Result
+	expires          time.Time
+	generation       int64
+	tableGenerations map[string]int64
+}
+
+// resultCacheSnapshot records the generations of the tables of a query,
+// before it is sent to MySQL.
+type resultCacheSnapshot struct {
+	key              string
+	tables           []string
+	generation       int64
+	tableGenerations map[string]int64
+}
+
+// NewResultCache creates a new ResultCache.
+func NewResultCache(env tabletenv.Env) *ResultCache {
+	config := env.Config().ResultCache
+	return &ResultCache{
+		config: config,
+		entries: cache.NewLRUCache(config.MemoryBytes, func(v interface{}) int64 {
+			return v.(*resultCacheEntry).result.CachedSize(true)
+		}),
+		tableGenerations: make(map[string]int64),
+		hits:             env.Exporter().NewCountersWithSingleLabel("ResultCacheHits", "Number of SELECTs served by the result cache, by tables read", "Tables"),
+		misses:           env.Exporter().NewCountersWithSingleLabel("ResultCacheMisses", "Number of cacheable SELECTs not found in the result cache, by tables read", "Tables"),
+		invalidations:    env.Exporter().NewCountersWithSingleLabel("ResultCacheInvalidations", "Number of times the cached results of a table were invalidated", "Table"),
+	}
+}
+
+// tables returns the tables read by a plan if all of them are cacheable.
+func (rc *ResultCache) tables(plan *TabletPlan) []string {
+	if rc == nil || !rc.config.Enabled() || plan.PlanID != planbuilder.PlanSelect || len(plan.Permissions) == 0 {
+		return nil
+	}
+	tables := make([]string, 0, len(plan.Permissions))
+	for _, permission := range plan.Permissions {
+		if _, ok := rc.config.Tables[permission.TableName]; !ok {
+			return nil
+		}
+		tables = append(tables, permission.TableName)
+	}
+	sort.Strings(tables)
+	return tables
+}
+
+// Get returns a copy of the cached result of a query, which the caller may
+// modify. If there is none, it returns the snapshot to pass to Set with the
+// result from MySQL. If the query cannot be cached, both are nil.
+func (rc *ResultCache) Get(plan *TabletPlan, sql string) (*sqltypes.Result, *resultCacheSnapshot) {
+	tables := rc.tables(plan)
+	if tables == nil {
+		return nil, nil
+	}
+
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	if !rc.active {
+		return nil, nil
+	}
+	// The stats of the SELECTs reading several tables are labeled with all
+	// of them, sorted.
+	label := strings.Join(tables, ",")
+	if v, ok := rc.entries.Get(sql); ok {
+		entry := v.(*resultCacheEntry)
+		if rc.validLocked(entry) {
+			rc.hits.Add(label, 1)
+			return entry.result.Copy(), nil
+		}
+		rc.entries.Delete(sql)
+	}
+	rc.misses.Add(label, 1)
+
+	snapshot := &resultCacheSnapshot{
+		key:              sql,
+		tables:           tables,
+		generation:       rc.generation,
+		tableGenerations: make(map[string]int64, len(tables)),
+	}
+	for _, table := range tables {
+		snapshot.tableGenerations[table] = rc.tableGenerations[table]
+	}
+	return nil, snapshot
+}
+
+// Set caches a copy of the result of a query, unless its tables were
+// invalidated since the snapshot was taken.
+func (rc *ResultCache) Set(snapshot *resultCacheSnapshot, result *sqltypes.Result) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	entry := &resultCacheEntry{
+		result:           result.Copy(),
+		generation:       snapshot.generation,
+		tableGenerations: snapshot.tableGenerations,
+	}
+	if !rc.active || !rc.validLocked(entry) {
+		return
+	}
+	var ttl time.Duration
+	for _, table := range snapshot.tables {
+		if t := rc.config.TTL(table); t > 0 && (ttl == 0 || t < ttl) {
+			ttl = t
+		}
+	}
+	if ttl > 0 {
+		entry.expires = time.Now().Add(ttl)
+	}
+	rc.entries.Set(snapshot.key, entry)
+}
+
+func (rc *ResultCache) validLocked(entry *resultCacheEntry) bool {
+	if entry.generation != rc.generation {
+		return false
+	}
+	for table, generation := range entry.tableGenerations {
+		if rc.tableGenerations[table] != generation {
+			return false
+		}
+	}
+	return entry.expires.IsZero() || time.Now().Before(entry.expires)
+}
+
+// Invalidate invalidates the cached results of a table.
+func (rc *ResultCache) Invalidate(table string) {
+	if rc == nil || !rc.config.Enabled() {
+		return
+	}
+	if _, ok := rc.config.Tables[table]; !ok {
+		return
+	}
+
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	rc.tableGenerations[table]++
+	rc.invalidations.Add(table, 1)
+}
+
+// InvalidateAll invalidates all the cached results.
+func (rc *ResultCache) InvalidateAll() {
+	if rc == nil || !rc.config.Enabled() {
+		return
+	}
+
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	rc.invalidateAllLocked()
+}
+
+func (rc *ResultCache) invalidateAllLocked() {
+	rc.generation++
+	rc.entries.Clear()
+	for table := range rc.config.Tables {
+		rc.invalidations.Add(table, 1)
+	}
+}
+
+// setActive starts or stops caching results. All the cached results are
+// invalidated either way: changes may have been missed while inactive.
+func (rc *ResultCache) setActive(active bool) {
+	if rc == nil || !rc.config.Enabled() {
+		return
+	}
+
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	if rc.active == active {
+		return
+	}
+	if active {
+		log.Info("Result cache: caching")
+	} else {
+		log.Info("Result cache: not caching")
+	}
+	rc.active = active
+	rc.invalidateAllLocked()
+}
+
+// processEvents invalidates the results of the tables modified by the
+// events of the replication stream.
+func (rc *ResultCache) processEvents(events []*binlogdatapb.VEvent) {
+	if rc == nil || !rc.config.Enabled() {
+		return
+	}
+	// Receiving events means the stream is up.
+	rc.setActive(true)
+	for _, event := range events {
+		switch event.Type {
+		case binlogdatapb.VEventType_ROW:
+			rc.Invalidate(event.RowEvent.TableName)
+		case binlogdatapb.VEventType_DDL:
+			rc.InvalidateAll()
+		}
+	}
+}
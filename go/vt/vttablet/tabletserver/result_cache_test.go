@@ -0,0 +1,168 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/test/utils"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/planbuilder"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+)
+
+func newTestResultCache() *ResultCache {
+	config := tabletenv.NewDefaultConfig()
+	config.ResultCache = tabletenv.ResultCacheConfig{
+		MemoryBytes: 1024 * 1024,
+		Tables: map[string]tabletenv.ResultCacheTableConfig{
+			"t1": {},
+			"t2": {TTLSeconds: 0.05},
+		},
+	}
+	rc := NewResultCache(tabletenv.NewEnv(config, "ResultCacheTest"))
+	rc.setActive(true)
+	rc.hits.ResetAll()
+	rc.misses.ResetAll()
+	rc.invalidations.ResetAll()
+	return rc
+}
+
+func newTestSelectPlan(tables ...string) *TabletPlan {
+	plan := &planbuilder.Plan{PlanID: planbuilder.PlanSelect}
+	for _, table := range tables {
+		plan.Permissions = append(plan.Permissions, planbuilder.Permission{TableName: table})
+	}
+	return &TabletPlan{Plan: plan}
+}
+
+func TestResultCache(t *testing.T) {
+	rc := newTestResultCache()
+	plan := newTestSelectPlan("t1")
+	result := sqltypes.MakeTestResult(sqltypes.MakeTestFields("id", "int64"), "1")
+
+	got, snapshot := rc.Get(plan, "select id from t1")
+	assert.Nil(t, got)
+	require.NotNil(t, snapshot)
+	rc.Set(snapshot, result)
+
+	got, snapshot = rc.Get(plan, "select id from t1")
+	utils.MustMatch(t, result, got)
+	assert.Nil(t, snapshot)
+	assert.Equal(t, int64(1), rc.hits.Counts()["t1"])
+	assert.Equal(t, int64(1), rc.misses.Counts()["t1"])
+
+	// The callers may modify the results they get or set.
+	got.Rows[0][0] = sqltypes.NewInt64(2)
+	result.Rows = nil
+	got, _ = rc.Get(plan, "select id from t1")
+	utils.MustMatch(t, sqltypes.MakeTestResult(sqltypes.MakeTestFields("id", "int64"), "1"), got)
+	result = got
+
+	// A DML on another table doesn't invalidate the result.
+	rc.processEvents([]*binlogdatapb.VEvent{{
+		Type:     binlogdatapb.VEventType_ROW,
+		RowEvent: &binlogdatapb.RowEvent{TableName: "t2"},
+	}})
+	got, _ = rc.Get(plan, "select id from t1")
+	utils.MustMatch(t, result, got)
+
+	rc.processEvents([]*binlogdatapb.VEvent{{
+		Type:     binlogdatapb.VEventType_ROW,
+		RowEvent: &binlogdatapb.RowEvent{TableName: "t1"},
+	}})
+	got, snapshot = rc.Get(plan, "select id from t1")
+	assert.Nil(t, got)
+	require.NotNil(t, snapshot)
+	assert.Equal(t, int64(1), rc.invalidations.Counts()["t1"])
+
+	// A result read before an invalidation is not cached.
+	rc.Invalidate("t1")
+	rc.Set(snapshot, result)
+	got, _ = rc.Get(plan, "select id from t1")
+	assert.Nil(t, got)
+
+	// A DDL invalidates everything.
+	_, snapshot = rc.Get(plan, "select id from t1")
+	rc.Set(snapshot, result)
+	rc.processEvents([]*binlogdatapb.VEvent{{Type: binlogdatapb.VEventType_DDL}})
+	got, _ = rc.Get(plan, "select id from t1")
+	assert.Nil(t, got)
+}
+
+func TestResultCacheCacheable(t *testing.T) {
+	rc := newTestResultCache()
+
+	assert.Equal(t, []string{"t1", "t2"}, rc.tables(newTestSelectPlan("t1", "t2")))
+	// All the tables must be cacheable.
+	assert.Nil(t, rc.tables(newTestSelectPlan("t1", "t3")))
+	// SELECTs without tables aren't cached.
+	assert.Nil(t, rc.tables(newTestSelectPlan()))
+	plan := newTestSelectPlan("t1")
+	plan.PlanID = planbuilder.PlanShow
+	assert.Nil(t, rc.tables(plan))
+
+	// A disabled result cache caches nothing.
+	config := tabletenv.NewDefaultConfig()
+	rc = NewResultCache(tabletenv.NewEnv(config, "ResultCacheTest"))
+	rc.setActive(true)
+	assert.Nil(t, rc.tables(newTestSelectPlan("t1")))
+}
+
+func TestResultCacheTTL(t *testing.T) {
+	rc := newTestResultCache()
+	result := sqltypes.MakeTestResult(sqltypes.MakeTestFields("id", "int64"), "1")
+
+	// The shortest TTL of the tables applies.
+	plan := newTestSelectPlan("t2", "t1")
+	_, snapshot := rc.Get(plan, "select id from t1 join t2")
+	rc.Set(snapshot, result)
+	got, _ := rc.Get(plan, "select id from t1 join t2")
+	utils.MustMatch(t, result, got)
+	assert.Equal(t, int64(1), rc.hits.Counts()["t1,t2"])
+
+	time.Sleep(100 * time.Millisecond)
+	got, _ = rc.Get(plan, "select id from t1 join t2")
+	assert.Nil(t, got)
+}
+
+func TestResultCacheInactive(t *testing.T) {
+	rc := newTestResultCache()
+	plan := newTestSelectPlan("t1")
+	result := sqltypes.MakeTestResult(sqltypes.MakeTestFields("id", "int64"), "1")
+
+	_, snapshot := rc.Get(plan, "select id from t1")
+	rc.Set(snapshot, result)
+
+	// Results are dropped while the replication stream is down, since
+	// changes could be missed.
+	rc.setActive(false)
+	got, snapshot := rc.Get(plan, "select id from t1")
+	assert.Nil(t, got)
+	assert.Nil(t, snapshot)
+
+	// And the old results are not served once it's back.
+	rc.processEvents(nil)
+	got, _ = rc.Get(plan, "select id from t1")
+	assert.Nil(t, got)
+}
@@ -90,8 +90,12 @@ func (qri *Map) FilterByPlan(query string, planid planbuilder.PlanType, tableNam
 	qri.mu.Lock()
 	defer qri.mu.Unlock()
 	newqrs = New()
-	for _, rules := range qri.queryRulesMap {
-		newqrs.Append(rules.FilterByPlan(query, planid, tableName))
+	for source, rules := range qri.queryRulesMap {
+		filtered := rules.FilterByPlan(query, planid, tableName)
+		for _, qr := range filtered.rules {
+			qr.source = source
+		}
+		newqrs.Append(filtered)
 	}
 	return newqrs
 }
@@ -32,6 +32,7 @@ import (
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/planbuilder"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 )
 
@@ -173,12 +174,45 @@ func (qrs *Rules) GetAction(
 	bindVars map[string]*querypb.BindVariable,
 	marginComments sqlparser.MarginComments,
 ) (action Action, desc string) {
+	action, qr := qrs.GetActionRule(ip, user, bindVars, marginComments, nil)
+	if qr == nil {
+		return action, ""
+	}
+	return action, qr.Description
+}
+
+// GetActionRule is like GetAction, but returns the rule which fired, if any,
+// and calls hit, if not nil, for each rule which matched, including the
+// ALLOW rules. An ALLOW rule makes the input skip the next rules of its
+// source.
+func (qrs *Rules) GetActionRule(
+	ip,
+	user string,
+	bindVars map[string]*querypb.BindVariable,
+	marginComments sqlparser.MarginComments,
+	hit func(*Rule),
+) (action Action, qr *Rule) {
+	var allowed map[string]bool
 	for _, qr := range qrs.rules {
-		if act := qr.GetAction(ip, user, bindVars, marginComments); act != QRContinue {
-			return act, qr.Description
+		if allowed[qr.source] {
+			continue
+		}
+		act := qr.GetAction(ip, user, bindVars, marginComments)
+		if act == QRContinue {
+			continue
 		}
+		if hit != nil {
+			hit(qr)
+		}
+		if act != QRAllow {
+			return act, qr
+		}
+		if allowed == nil {
+			allowed = map[string]bool{}
+		}
+		allowed[qr.source] = true
 	}
-	return QRContinue, ""
+	return QRContinue, nil
 }
 
 //-----------------------------------------------
@@ -200,6 +234,10 @@ type Rule struct {
 	// Regexp conditions. nil conditions are ignored (TRUE).
 	requestIP, user, query, leadingComment, trailingComment namedRegexp
 
+	// fingerprint must be the one of the query, see sqlparser.Fingerprint,
+	// if not empty.
+	fingerprint string
+
 	// Any matched plan will make this condition true (OR)
 	plans []planbuilder.PlanType
 
@@ -211,6 +249,10 @@ type Rule struct {
 
 	// Action to be performed on trigger
 	act Action
+
+	// source is the name of the Map source of the rule, set by
+	// Map.FilterByPlan.
+	source string
 }
 
 type namedRegexp struct {
@@ -249,6 +291,7 @@ func (qr *Rule) Equal(other *Rule) bool {
 		qr.query.Equal(other.query) &&
 		qr.leadingComment.Equal(other.leadingComment) &&
 		qr.trailingComment.Equal(other.trailingComment) &&
+		qr.fingerprint == other.fingerprint &&
 		reflect.DeepEqual(qr.plans, other.plans) &&
 		reflect.DeepEqual(qr.tableNames, other.tableNames) &&
 		reflect.DeepEqual(qr.bindVarConds, other.bindVarConds) &&
@@ -265,7 +308,9 @@ func (qr *Rule) Copy() (newqr *Rule) {
 		query:           qr.query,
 		leadingComment:  qr.leadingComment,
 		trailingComment: qr.trailingComment,
+		fingerprint:     qr.fingerprint,
 		act:             qr.act,
+		source:          qr.source,
 	}
 	if qr.plans != nil {
 		newqr.plans = make([]planbuilder.PlanType, len(qr.plans))
@@ -302,6 +347,9 @@ func (qr *Rule) MarshalJSON() ([]byte, error) {
 	if qr.trailingComment.Regexp != nil {
 		safeEncode(b, `,"TrailingComment":`, qr.trailingComment)
 	}
+	if qr.fingerprint != "" {
+		safeEncode(b, `,"Fingerprint":`, qr.fingerprint)
+	}
 	if qr.plans != nil {
 		safeEncode(b, `,"Plans":`, qr.plans)
 	}
@@ -369,6 +417,17 @@ func (qr *Rule) SetTrailingCommentCond(pattern string) (err error) {
 	return
 }
 
+// SetFingerprintCond adds a condition for the fingerprint of the query, see
+// sqlparser.Fingerprint.
+func (qr *Rule) SetFingerprintCond(fingerprint string) {
+	qr.fingerprint = fingerprint
+}
+
+// Source returns the name of the Map source of the rule, if any.
+func (qr *Rule) Source() string {
+	return qr.source
+}
+
 // makeExact forces a full string match for the regex instead of substring
 func makeExact(pattern string) string {
 	return fmt.Sprintf("^%s$", pattern)
@@ -446,8 +505,12 @@ func (qr *Rule) FilterByPlan(query string, planid planbuilder.PlanType, tableNam
 	if !tableMatch(qr.tableNames, tableName) {
 		return nil
 	}
+	if qr.fingerprint != "" && qr.fingerprint != sqlparser.FingerprintQuery(query) {
+		return nil
+	}
 	newqr = qr.Copy()
 	newqr.query = namedRegexp{}
+	newqr.fingerprint = ""
 	// Note we explicitly don't remove the leading/trailing comments as they
 	// must be evaluated at execution time.
 	newqr.plans = nil
@@ -533,6 +596,7 @@ const (
 	QRContinue = Action(iota)
 	QRFail
 	QRFailRetry
+	QRAllow
 )
 
 // MarshalJSON marshals to JSON.
@@ -544,6 +608,8 @@ func (act Action) MarshalJSON() ([]byte, error) {
 		str = "FAIL"
 	case QRFailRetry:
 		str = "FAIL_RETRY"
+	case QRAllow:
+		str = "ALLOW"
 	default:
 		str = "INVALID"
 	}
@@ -826,6 +892,40 @@ func MapStrOperator(strop string) (op Operator, err error) {
 	return QRNoOp, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid Operator %s", strop)
 }
 
+// BuildQueryRuleFromProto builds a query rule from a rule of the Keyspace
+// record of the topo.
+func BuildQueryRuleFromProto(rule *topodatapb.QueryRule) (*Rule, error) {
+	ruleInfo := map[string]interface{}{
+		"Name":        rule.Name,
+		"Description": rule.Description,
+		"Action":      rule.Action,
+	}
+	if rule.Fingerprint != "" {
+		ruleInfo["Fingerprint"] = rule.Fingerprint
+	}
+	if rule.Query != "" {
+		ruleInfo["Query"] = rule.Query
+	}
+	if rule.User != "" {
+		ruleInfo["User"] = rule.User
+	}
+	if len(rule.Plans) > 0 {
+		var plans []interface{}
+		for _, plan := range rule.Plans {
+			plans = append(plans, plan)
+		}
+		ruleInfo["Plans"] = plans
+	}
+	if len(rule.TableNames) > 0 {
+		var tableNames []interface{}
+		for _, tableName := range rule.TableNames {
+			tableNames = append(tableNames, tableName)
+		}
+		ruleInfo["TableNames"] = tableNames
+	}
+	return BuildQueryRule(ruleInfo)
+}
+
 // BuildQueryRule builds a query rule from a ruleInfo.
 func BuildQueryRule(ruleInfo map[string]interface{}) (qr *Rule, err error) {
 	qr = NewQueryRule("", "", QRFail)
@@ -834,7 +934,7 @@ func BuildQueryRule(ruleInfo map[string]interface{}) (qr *Rule, err error) {
 		var lv []interface{}
 		var ok bool
 		switch k {
-		case "Name", "Description", "RequestIP", "User", "Query", "Action", "LeadingComment", "TrailingComment":
+		case "Name", "Description", "RequestIP", "User", "Query", "Fingerprint", "Action", "LeadingComment", "TrailingComment":
 			sv, ok = v.(string)
 			if !ok {
 				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want string for %s", k)
@@ -867,6 +967,8 @@ func BuildQueryRule(ruleInfo map[string]interface{}) (qr *Rule, err error) {
 			if err != nil {
 				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "could not set Query condition: %v", sv)
 			}
+		case "Fingerprint":
+			qr.SetFingerprintCond(sv)
 		case "LeadingComment":
 			err = qr.SetLeadingCommentCond(sv)
 			if err != nil {
@@ -914,6 +1016,8 @@ func BuildQueryRule(ruleInfo map[string]interface{}) (qr *Rule, err error) {
 				qr.act = QRFail
 			case "FAIL_RETRY":
 				qr.act = QRFailRetry
+			case "ALLOW":
+				qr.act = QRAllow
 			default:
 				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid Action %s", sv)
 			}
@@ -25,6 +25,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/sqlparser"
@@ -32,6 +33,7 @@ import (
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/planbuilder"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 )
 
@@ -544,6 +546,71 @@ func TestAction(t *testing.T) {
 	assert.Equalf(t, desc, "rule 5", "want rule 5, got %s", desc)
 }
 
+func TestActionAllow(t *testing.T) {
+	allow := NewQueryRule("allow admin", "allow", QRAllow)
+	allow.SetUserCond("admin")
+	deny := NewQueryRule("deny all", "deny", QRFail)
+	qrs := New()
+	qrs.Add(allow)
+	qrs.Add(deny)
+
+	qri := NewMap()
+	qri.RegisterSource("source1")
+	qri.RegisterSource("source2")
+	qri.SetRules("source1", qrs)
+	filtered := qri.FilterByPlan("select * from a", planbuilder.PlanSelect, "a")
+
+	var hits []string
+	hit := func(qr *Rule) { hits = append(hits, qr.Source()+"."+qr.Name) }
+	action, qr := filtered.GetActionRule("", "admin", nil, sqlparser.MarginComments{}, hit)
+	assert.Equal(t, QRContinue, action)
+	assert.Nil(t, qr)
+	action, qr = filtered.GetActionRule("", "app", nil, sqlparser.MarginComments{}, hit)
+	assert.Equal(t, QRFail, action)
+	assert.Equal(t, "deny", qr.Name)
+	assert.Equal(t, []string{"source1.allow", "source1.deny"}, hits)
+
+	// An ALLOW rule does not skip the rules of the other sources.
+	qri.SetRules("source2", qrs.Copy())
+	qrs = New()
+	qrs.Add(allow)
+	qri.SetRules("source1", qrs)
+	filtered = qri.FilterByPlan("select * from a", planbuilder.PlanSelect, "a")
+	action, qr = filtered.GetActionRule("", "admin", nil, sqlparser.MarginComments{}, nil)
+	assert.Equal(t, QRContinue, action)
+	action, qr = filtered.GetActionRule("", "app", nil, sqlparser.MarginComments{}, nil)
+	assert.Equal(t, QRFail, action)
+	assert.Equal(t, "source2", qr.Source())
+}
+
+func TestFingerprintCond(t *testing.T) {
+	qr := NewQueryRule("deny", "deny", QRFail)
+	qr.SetFingerprintCond(sqlparser.FingerprintQuery("select * from a where id = 1"))
+	assert.NotNil(t, qr.FilterByPlan("select * from a where id = 2", planbuilder.PlanSelect, "a"))
+	assert.Nil(t, qr.FilterByPlan("select * from a where name = 'x'", planbuilder.PlanSelect, "a"))
+
+	built, err := BuildQueryRuleFromProto(&topodatapb.QueryRule{
+		Name:        "deny",
+		Fingerprint: qr.fingerprint,
+		Plans:       []string{"Select"},
+		TableNames:  []string{"a"},
+		Action:      "FAIL_RETRY",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, QRFailRetry, built.act)
+	assert.Equal(t, qr.fingerprint, built.fingerprint)
+	assert.Equal(t, []planbuilder.PlanType{planbuilder.PlanSelect}, built.plans)
+	assert.Equal(t, []string{"a"}, built.tableNames)
+	data, err := json.Marshal(built)
+	require.NoError(t, err)
+	assert.Equal(t, `{"Description":"","Name":"deny","Fingerprint":"`+qr.fingerprint+`","Plans":["Select"],"TableNames":["a"],"Action":"FAIL_RETRY"}`, string(data))
+
+	_, err = BuildQueryRuleFromProto(&topodatapb.QueryRule{Name: "deny", Plans: []string{"Drop"}, Action: "FAIL"})
+	assert.Error(t, err)
+	_, err = BuildQueryRuleFromProto(&topodatapb.QueryRule{Name: "deny", Action: "DROP"})
+	assert.Error(t, err)
+}
+
 func TestImport(t *testing.T) {
 	var qrs = New()
 	jsondata := `[{
@@ -62,6 +62,8 @@ func loadMessageInfo(ta *Table, comment string) error {
 		"time_next":  {},
 		"epoch":      {},
 		"time_acked": {},
+		// time_scheduled is optional, see MessageInfo.HasTimeScheduled.
+		"time_scheduled": {},
 	}
 
 	requiredCols := []string{
@@ -110,6 +112,13 @@ func loadMessageInfo(ta *Table, comment string) error {
 
 	ta.MessageInfo.MaxBackoff, _ = getDuration(keyvals, "vt_max_backoff")
 
+	// vt_subscriber_rate is optional, and no limit is the default.
+	if _, ok := keyvals["vt_subscriber_rate"]; ok {
+		if ta.MessageInfo.SubscriberRate, err = getNum(keyvals, "vt_subscriber_rate"); err != nil {
+			return err
+		}
+	}
+
 	for _, col := range requiredCols {
 		num := ta.FindColumn(sqlparser.NewColIdent(col))
 		if num == -1 {
@@ -117,6 +126,8 @@ func loadMessageInfo(ta *Table, comment string) error {
 		}
 	}
 
+	ta.MessageInfo.HasTimeScheduled = ta.FindColumn(sqlparser.NewColIdent("time_scheduled")) != -1
+
 	// Load user-defined columns. Any "unrecognized" column is user-defined.
 	for _, field := range ta.Fields {
 		if _, ok := hiddenCols[strings.ToLower(field.Name)]; ok {
@@ -141,6 +141,29 @@ func TestLoadTableMessage(t *testing.T) {
 	want.MessageInfo.MaxBackoff = 100 * time.Second
 	assert.Equal(t, want, table)
 
+	// Test loading the optional time_scheduled column, which is hidden
+	// from the subscribers.
+	fields := getMessageTableQueries()["select * from test_table where 1 != 1"].Fields
+	fields = append(fields, &querypb.Field{
+		Name: "time_scheduled",
+		Type: sqltypes.Int64,
+	})
+	db.AddQuery("select * from test_table where 1 != 1", &sqltypes.Result{Fields: fields})
+	table, err = newTestLoadTable("USER_TABLE", "vitess_message,vt_ack_wait=30,vt_purge_after=120,vt_batch_size=1,vt_cache_size=10,vt_poller_interval=30,vt_min_backoff=10,vt_max_backoff=100", db)
+	require.NoError(t, err)
+	want.Fields = fields
+	want.MessageInfo.HasTimeScheduled = true
+	assert.Equal(t, want, table)
+
+	// Test loading the subscriber rate
+	table, err = newTestLoadTable("USER_TABLE", "vitess_message,vt_ack_wait=30,vt_purge_after=120,vt_batch_size=1,vt_cache_size=10,vt_poller_interval=30,vt_min_backoff=10,vt_max_backoff=100,vt_subscriber_rate=50", db)
+	require.NoError(t, err)
+	want.MessageInfo.SubscriberRate = 50
+	assert.Equal(t, want, table)
+
+	_, err = newTestLoadTable("USER_TABLE", "vitess_message,vt_ack_wait=30,vt_purge_after=120,vt_batch_size=1,vt_cache_size=10,vt_poller_interval=30,vt_subscriber_rate=fast", db)
+	require.Error(t, err)
+
 	// Missing property
 	_, err = newTestLoadTable("USER_TABLE", "vitess_message,vt_ack_wait=30", db)
 	wanterr := "not specified for message table"
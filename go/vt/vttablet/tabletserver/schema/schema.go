@@ -103,6 +103,15 @@ type MessageInfo struct {
 	// MaxBackoff specifies the longest duration message manager
 	// should wait before rescheduling a message
 	MaxBackoff time.Duration
+
+	// HasTimeScheduled is set if the table has the optional
+	// time_scheduled column. A message is not sent before its
+	// time_scheduled, if set, even if its time_next is due.
+	HasTimeScheduled bool
+
+	// SubscriberRate specifies the max number of messages per
+	// second sent to each subscriber. 0 means no limit.
+	SubscriberRate int
 }
 
 // NewTable creates a new Table.
@@ -35,6 +35,7 @@ import (
 	"vitess.io/vitess/go/vt/log"
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/sidecardb"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
 	"vitess.io/vitess/go/vt/withddl"
 )
@@ -58,6 +59,10 @@ var withDDL = withddl.New([]string{
 	alterSchemaTrackingTableSchemaxBlob,
 })
 
+func init() {
+	sidecardb.Register("schema_tracker", withDDL.DDLs())
+}
+
 // VStreamer defines  the functions of VStreamer
 // that the replicationWatcher needs.
 type VStreamer interface {
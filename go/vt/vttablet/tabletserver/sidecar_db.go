@@ -0,0 +1,42 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"vitess.io/vitess/go/vt/dbconnpool"
+	"vitess.io/vitess/go/vt/sidecardb"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+)
+
+// sidecarDBInitializer brings the schema of the _vt sidecar database up to
+// date, with the DDLs registered to sidecardb.
+type sidecarDBInitializer struct {
+	env tabletenv.Env
+}
+
+// Init applies the pending DDLs, if -init_sidecar_db is set.
+func (si *sidecarDBInitializer) Init() error {
+	if !*sidecardb.InitEnabled {
+		return nil
+	}
+	conn, err := dbconnpool.NewDBConnection(tabletenv.LocalContext(), si.env.Config().DB.DbaConnector())
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	return sidecardb.Init(conn.ExecuteFetch)
+}
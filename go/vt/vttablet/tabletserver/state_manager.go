@@ -115,6 +115,7 @@ type stateManager struct {
 	ddle        onlineDDLExecutor
 	throttler   lagThrottler
 	tableGC     tableGarbageCollector
+	sidecar     sidecarDB
 
 	// hcticks starts on initialiazation and runs forever.
 	hcticks *timer.Timer
@@ -180,6 +181,10 @@ type (
 		Open() error
 		Close()
 	}
+
+	sidecarDB interface {
+		Init() error
+	}
 )
 
 // Init performs the second phase of initialization.
@@ -409,6 +414,10 @@ func (sm *stateManager) servePrimary() error {
 	if err := sm.connect(topodatapb.TabletType_PRIMARY); err != nil {
 		return err
 	}
+	// The components create the tables they need on first use anyway.
+	if err := sm.sidecar.Init(); err != nil {
+		log.Errorf("Cannot initialize the sidecar database: %v", err)
+	}
 
 	sm.rt.MakePrimary()
 	sm.tracker.Open()
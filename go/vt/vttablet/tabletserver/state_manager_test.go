@@ -92,6 +92,7 @@ func TestStateManagerServePrimary(t *testing.T) {
 
 	assert.False(t, sm.se.(*testSchemaEngine).nonPrimary)
 	assert.True(t, sm.se.(*testSchemaEngine).ensureCalled)
+	assert.Equal(t, 1, sm.sidecar.(*testSidecarDB).inits)
 
 	assert.Equal(t, topodatapb.TabletType_PRIMARY, sm.target.TabletType)
 	assert.Equal(t, StateServing, sm.state)
@@ -698,6 +699,7 @@ func newTestStateManager(t *testing.T) *stateManager {
 		ddle:        &testOnlineDDLExecutor{},
 		throttler:   &testLagThrottler{},
 		tableGC:     &testTableGC{},
+		sidecar:     &testSidecarDB{},
 	}
 	sm.Init(env, &querypb.Target{})
 	sm.hs.InitDBConfig(&querypb.Target{}, fakesqldb.New(t).ConnParams())
@@ -903,6 +905,15 @@ func (te *testLagThrottler) Close() {
 	te.state = testStateClosed
 }
 
+type testSidecarDB struct {
+	inits int
+}
+
+func (sd *testSidecarDB) Init() error {
+	sd.inits++
+	return nil
+}
+
 type testTableGC struct {
 	testOrderState
 }
@@ -56,6 +56,8 @@ type StatefulConnectionPool struct {
 	// pool is needed because this option can only be set at
 	// connection time.
 	foundRowsPool *connpool.Pool
+	// workloadConns partitions conns by workload.
+	workloadConns *workloadPools
 	active        *pools.Numbered
 	lastID        sync2.AtomicInt64
 }
@@ -68,8 +70,11 @@ func NewStatefulConnPool(env tabletenv.Env) *StatefulConnectionPool {
 		env:           env,
 		conns:         connpool.NewPool(env, "TransactionPool", config.TxPool),
 		foundRowsPool: connpool.NewPool(env, "FoundRowsPool", config.TxPool),
-		active:        pools.NewNumbered(),
-		lastID:        sync2.NewAtomicInt64(time.Now().UnixNano()),
+		workloadConns: newWorkloadPools(env, "TransactionPool", config.TxPool, func(wp *tabletenv.WorkloadPoolConfig) tabletenv.ConnPoolConfig {
+			return wp.TxPool
+		}),
+		active: pools.NewNumbered(),
+		lastID: sync2.NewAtomicInt64(time.Now().UnixNano()),
 	}
 }
 
@@ -78,6 +83,7 @@ func NewStatefulConnPool(env tabletenv.Env) *StatefulConnectionPool {
 func (sf *StatefulConnectionPool) Open(appParams, dbaParams, appDebugParams dbconfigs.Connector) {
 	log.Infof("Starting transaction id: %d", sf.lastID)
 	sf.conns.Open(appParams, dbaParams, appDebugParams)
+	sf.workloadConns.Open(appParams, dbaParams, appDebugParams)
 	foundRowsParam, _ := appParams.MysqlParams()
 	foundRowsParam.EnableClientFoundRows()
 	appParams = dbconfigs.New(foundRowsParam)
@@ -99,6 +105,7 @@ func (sf *StatefulConnectionPool) Close() {
 		conn.Releasef("pool closed")
 	}
 	sf.conns.Close()
+	sf.workloadConns.Close()
 	sf.foundRowsPool.Close()
 	sf.state.Set(scpClosed)
 }
@@ -142,6 +149,14 @@ func (sf *StatefulConnectionPool) GetOutdated(age time.Duration, purpose string)
 	return mapToTxConn(sf.active.GetOutdated(age, purpose))
 }
 
+// GetOutdatedFunc is like GetOutdated, but the age a connection must be older
+// than is returned by age, for each connection.
+func (sf *StatefulConnectionPool) GetOutdatedFunc(age func(*StatefulConnection) time.Duration, purpose string) []*StatefulConnection {
+	return mapToTxConn(sf.active.GetOutdatedFunc(func(val interface{}) time.Duration {
+		return age(val.(*StatefulConnection))
+	}, purpose))
+}
+
 func mapToTxConn(outdated []interface{}) []*StatefulConnection {
 	result := make([]*StatefulConnection, len(outdated))
 	for i, el := range outdated {
@@ -166,8 +181,9 @@ func (sf *StatefulConnectionPool) GetAndLock(id int64, reason string) (*Stateful
 	return conn.(*StatefulConnection), nil
 }
 
-// NewConn creates a new StatefulConnection. It will be created from either the normal pool or
-// the found_rows pool, depending on the options provided
+// NewConn creates a new StatefulConnection. It will be created from either the normal pool,
+// the pool of the workload of the caller or of the workload_name of the options, or the
+// found_rows pool, depending on the options provided
 func (sf *StatefulConnectionPool) NewConn(ctx context.Context, options *querypb.ExecuteOptions) (*StatefulConnection, error) {
 
 	var conn *connpool.DBConn
@@ -176,7 +192,7 @@ func (sf *StatefulConnectionPool) NewConn(ctx context.Context, options *querypb.
 	if options.GetClientFoundRows() {
 		conn, err = sf.foundRowsPool.Get(ctx)
 	} else {
-		conn, err = sf.conns.Get(ctx)
+		conn, err = sf.workloadConns.get(ctx, options.GetWorkloadName(), sf.conns).Get(ctx)
 	}
 	if err != nil {
 		return nil, err
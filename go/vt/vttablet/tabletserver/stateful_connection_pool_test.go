@@ -25,7 +25,9 @@ import (
 
 	"vitess.io/vitess/go/mysql/fakesqldb"
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/callerid"
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tx"
 )
 
@@ -57,6 +59,52 @@ func TestActivePoolClientRowsFound(t *testing.T) {
 	assert.Equal(t, startFoundRowsSize, pool.conns.Available(), "default pool not restored after release")
 }
 
+func TestActivePoolWorkloadPools(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+
+	config := tabletenv.NewDefaultConfig()
+	config.TxPool.Size = 5
+	config.WorkloadPools = []tabletenv.WorkloadPoolConfig{{
+		Name:       "analytics",
+		Usernames:  []string{"analytics_user"},
+		Principals: []string{"analytics_principal"},
+		TxPool:     tabletenv.ConnPoolConfig{Size: 1, TimeoutSeconds: 0.01},
+	}}
+	pool := NewStatefulConnPool(tabletenv.NewEnv(config, "ActivePoolWorkloadTest"))
+	pool.Open(db.ConnParams(), db.ConnParams(), db.ConnParams())
+	defer pool.Close()
+	workloadPool := pool.workloadConns.pools[0]
+
+	analyticsCtx := callerid.NewContext(ctx, nil, callerid.NewImmediateCallerID("analytics_user"))
+	conn1, err := pool.NewConn(analyticsCtx, &querypb.ExecuteOptions{})
+	require.NoError(t, err)
+	assert.EqualValues(t, 0, workloadPool.Available())
+	assert.EqualValues(t, 5, pool.conns.Available())
+
+	// The workload pool is exhausted, but not the regular one.
+	_, err = pool.NewConn(callerid.NewContext(ctx, callerid.NewEffectiveCallerID("analytics_principal", "", ""), nil), &querypb.ExecuteOptions{})
+	require.Error(t, err)
+	conn2, err := pool.NewConn(callerid.NewContext(ctx, nil, callerid.NewImmediateCallerID("oltp_user")), &querypb.ExecuteOptions{})
+	require.NoError(t, err)
+	assert.EqualValues(t, 4, pool.conns.Available())
+
+	conn1.Release(tx.TxClose)
+	conn2.Release(tx.TxClose)
+	assert.EqualValues(t, 1, workloadPool.Available())
+	assert.EqualValues(t, 5, pool.conns.Available())
+
+	// The other callers join the workload by labelling their queries with
+	// its name, but its callers cannot label their queries out of it.
+	conn3, err := pool.NewConn(callerid.NewContext(ctx, nil, callerid.NewImmediateCallerID("oltp_user")), &querypb.ExecuteOptions{WorkloadName: "analytics"})
+	require.NoError(t, err)
+	assert.EqualValues(t, 0, workloadPool.Available())
+	_, err = pool.NewConn(analyticsCtx, &querypb.ExecuteOptions{WorkloadName: "other"})
+	require.Error(t, err)
+	assert.EqualValues(t, 5, pool.conns.Available())
+	conn3.Release(tx.TxClose)
+}
+
 func TestActivePoolForAllTxProps(t *testing.T) {
 	db := fakesqldb.New(t)
 	defer db.Close()
@@ -0,0 +1,167 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/connpool"
+)
+
+// tableStatisticsChangeRatio is the relative change of the row count of a
+// table, or of the cardinality of one of its columns, past which its
+// statistics are published again. The estimates of MySQL move all the time,
+// and the plans do not need to follow them closely.
+const tableStatisticsChangeRatio = 0.1
+
+type (
+	// columnStatistics are the statistics of a column of a table, or of the
+	// table itself for the empty column name.
+	columnStatistics struct {
+		rowCount    uint64
+		cardinality uint64
+	}
+
+	// tableStatistics are the statistics of the tables, by table and column.
+	tableStatistics map[string]map[string]columnStatistics
+)
+
+// sampleTableStatisticsLocked samples the row counts and key cardinalities
+// of the tables, if the statistics interval passed since the last sample.
+// It stores the statistics of the tables that changed significantly in
+// _vt.table_statistics, for the vtgates to fetch, and returns the tables.
+func (hs *healthStreamer) sampleTableStatisticsLocked(ctx context.Context, conn *connpool.DBConn) ([]string, error) {
+	if hs.statisticsInterval == 0 || time.Since(hs.statisticsSampled) < hs.statisticsInterval {
+		return nil, nil
+	}
+	hs.statisticsSampled = time.Now()
+
+	qr, err := conn.Exec(ctx, mysql.SampleTableStatistics, math.MaxInt32, false)
+	if err != nil {
+		return nil, err
+	}
+	sampled := newTableStatistics(qr)
+	qr, err = conn.Exec(ctx, mysql.FetchTableStatistics, math.MaxInt32, false)
+	if err != nil {
+		return nil, err
+	}
+	published := newTableStatistics(qr)
+
+	var tables, tableNames, values []string
+	for table := range sampled {
+		if !published.changed(sampled, table) {
+			continue
+		}
+		tables = append(tables, table)
+	}
+	for table := range published {
+		if _, ok := sampled[table]; !ok {
+			// The table was dropped.
+			tables = append(tables, table)
+		}
+	}
+	if len(tables) == 0 {
+		return nil, nil
+	}
+	sort.Strings(tables)
+	for _, table := range tables {
+		tableNames = append(tableNames, sqlparser.String(sqlparser.NewStrLiteral(table)))
+		for column, stats := range sampled[table] {
+			values = append(values, fmt.Sprintf("(database(), %s, %s, %d, %d)",
+				sqltypes.EncodeStringSQL(table), sqltypes.EncodeStringSQL(column), stats.rowCount, stats.cardinality))
+		}
+	}
+
+	// Publish the statistics in a transaction.
+	_, err = conn.Exec(ctx, "begin", 1, false)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Exec(ctx, "rollback", 1, false)
+
+	del := fmt.Sprintf("%s AND table_name IN (%s)", mysql.ClearTableStatistics, strings.Join(tableNames, ", "))
+	_, err = conn.Exec(ctx, del, 1, false)
+	if err != nil {
+		return nil, err
+	}
+	if len(values) > 0 {
+		sort.Strings(values)
+		_, err = conn.Exec(ctx, mysql.InsertIntoTableStatistics+strings.Join(values, ", "), 1, false)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	_, err = conn.Exec(ctx, "commit", 1, false)
+	if err != nil {
+		return nil, err
+	}
+	return tables, nil
+}
+
+// newTableStatistics reads the rows of table_name, column_name, row_count
+// and cardinality returned by the statistics queries.
+func newTableStatistics(qr *sqltypes.Result) tableStatistics {
+	ts := tableStatistics{}
+	for _, row := range qr.Rows {
+		table := row[0].ToString()
+		columns := ts[table]
+		if columns == nil {
+			columns = map[string]columnStatistics{}
+			ts[table] = columns
+		}
+		rowCount, _ := evalengine.ToUint64(row[2])
+		cardinality, _ := evalengine.ToUint64(row[3])
+		columns[row[1].ToString()] = columnStatistics{rowCount: rowCount, cardinality: cardinality}
+	}
+	return ts
+}
+
+// changed returns whether the sampled statistics of the table changed
+// significantly from the published ones.
+func (ts tableStatistics) changed(sampled tableStatistics, table string) bool {
+	published, ok := ts[table]
+	if !ok || len(published) != len(sampled[table]) {
+		return true
+	}
+	for column, stats := range sampled[table] {
+		old, ok := published[column]
+		if !ok || significantChange(old.rowCount, stats.rowCount) || significantChange(old.cardinality, stats.cardinality) {
+			return true
+		}
+	}
+	return false
+}
+
+func significantChange(published, sampled uint64) bool {
+	if published == sampled {
+		return false
+	}
+	if published == 0 {
+		return true
+	}
+	return math.Abs(float64(sampled)-float64(published))/float64(published) > tableStatisticsChangeRatio
+}
@@ -20,6 +20,7 @@ import (
 	"errors"
 	"flag"
 	"fmt"
+	"regexp"
 	"time"
 
 	"google.golang.org/protobuf/encoding/prototext"
@@ -30,6 +31,8 @@ import (
 	"vitess.io/vitess/go/vt/dbconfigs"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/throttler"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 )
 
 // These constants represent values for various config parameters.
@@ -82,6 +85,7 @@ var (
 	unhealthyThreshold           time.Duration
 	transitionGracePeriod        time.Duration
 	enableReplicationReporter    bool
+	resultCacheTables            flagutil.StringListValue
 )
 
 func init() {
@@ -96,6 +100,7 @@ func init() {
 	flag.IntVar(&currentConfig.MessagePostponeParallelism, "queryserver-config-message-postpone-cap", defaultConfig.MessagePostponeParallelism, "query server message postpone cap is the maximum number of messages that can be postponed at any given time. Set this number to substantially lower than transaction cap, so that the transaction pool isn't exhausted by the message subsystem.")
 	flag.IntVar(&deprecatedFoundRowsPoolSize, "client-found-rows-pool-size", 0, "DEPRECATED: queryserver-config-transaction-cap will be used instead.")
 	SecondsVar(&currentConfig.Oltp.TxTimeoutSeconds, "queryserver-config-transaction-timeout", defaultConfig.Oltp.TxTimeoutSeconds, "query server transaction timeout (in seconds), a transaction will be killed if it takes longer than this value")
+	SecondsVar(&currentConfig.Oltp.TxWarningSeconds, "queryserver-config-transaction-warning", defaultConfig.Oltp.TxWarningSeconds, "query server transaction warning threshold (in seconds), the queries of a transaction older than this value return a warning that it is about to be killed. 0 disables the warning")
 	SecondsVar(&currentConfig.GracePeriods.ShutdownSeconds, "shutdown_grace_period", defaultConfig.GracePeriods.ShutdownSeconds, "how long to wait (in seconds) for queries and transactions to complete during graceful shutdown.")
 	SecondsVar(&currentConfig.GracePeriods.ShutdownSeconds, "transaction_shutdown_grace_period", defaultConfig.GracePeriods.ShutdownSeconds, "DEPRECATED: use shutdown_grace_period instead.")
 	flag.IntVar(&currentConfig.Oltp.MaxRows, "queryserver-config-max-result-size", defaultConfig.Oltp.MaxRows, "query server max result size, maximum number of rows allowed to return from vttablet for non-streaming queries.")
@@ -108,9 +113,12 @@ func init() {
 	flag.IntVar(&currentConfig.QueryCacheSize, "queryserver-config-query-cache-size", defaultConfig.QueryCacheSize, "query server query cache size, maximum number of queries to be cached. vttablet analyzes every incoming query and generate a query plan, these plans are being cached in a lru cache. This config controls the capacity of the lru cache.")
 	flag.Int64Var(&currentConfig.QueryCacheMemory, "queryserver-config-query-cache-memory", defaultConfig.QueryCacheMemory, "query server query cache size in bytes, maximum amount of memory to be used for caching. vttablet analyzes every incoming query and generate a query plan, these plans are being cached in a lru cache. This config controls the capacity of the lru cache.")
 	flag.BoolVar(&currentConfig.QueryCacheLFU, "queryserver-config-query-cache-lfu", defaultConfig.QueryCacheLFU, "query server cache algorithm. when set to true, a new cache algorithm based on a TinyLFU admission policy will be used to improve cache behavior and prevent pollution from sparse queries")
+	flag.StringVar(&currentConfig.QueryCacheWarmupFile, "queryserver-config-query-cache-warmup-file", defaultConfig.QueryCacheWarmupFile, "query server query cache warm up file: the plans of its queries are built when the query engine opens, to avoid the latency of a cold cache after a restart. It is a JSON list of objects with a Query, and Pinned set for the plans never to be evicted, like the output of /debug/query_plan_cache.")
+	flag.IntVar(&currentConfig.WarmUpSampleSize, "queryserver-config-warmup-sample-size", defaultConfig.WarmUpSampleSize, "query server warm up sample size: the number of recent distinct SELECTs, with their bind variables, that are exported in /debug/warmup_queries for new tablets to replay before they start serving. 0 disables the sample.")
 	SecondsVar(&currentConfig.SchemaReloadIntervalSeconds, "queryserver-config-schema-reload-time", defaultConfig.SchemaReloadIntervalSeconds, "query server schema reload time, how often vttablet reloads schemas from underlying MySQL instance in seconds. vttablet keeps table schemas in its own memory and periodically refreshes it from MySQL. This config controls the reload time.")
 	SecondsVar(&currentConfig.SignalSchemaChangeReloadIntervalSeconds, "queryserver-config-schema-change-signal-interval", defaultConfig.SignalSchemaChangeReloadIntervalSeconds, "query server schema change signal interval defines at which interval the query server shall send schema updates to vtgate.")
 	flag.BoolVar(&currentConfig.SignalWhenSchemaChange, "queryserver-config-schema-change-signal", defaultConfig.SignalWhenSchemaChange, "query server schema signal, will signal connected vtgates that schema has changed whenever this is detected.")
+	SecondsVar(&currentConfig.TableStatisticsIntervalSeconds, "queryserver-config-table-statistics-interval", defaultConfig.TableStatisticsIntervalSeconds, "query server table statistics interval defines at which interval the query server samples the row counts and key cardinalities of the tables, and signals connected vtgates of the significant changes, for the cost model of the gen4 planner. It needs -queryserver-config-schema-change-signal, and 0 disables the sampling.")
 	SecondsVar(&currentConfig.Oltp.QueryTimeoutSeconds, "queryserver-config-query-timeout", defaultConfig.Oltp.QueryTimeoutSeconds, "query server query timeout (in seconds), this is the query timeout in vttablet side. If a query takes more than this timeout, it will be killed.")
 	SecondsVar(&currentConfig.OltpReadPool.TimeoutSeconds, "queryserver-config-query-pool-timeout", defaultConfig.OltpReadPool.TimeoutSeconds, "query server query pool timeout (in seconds), it is how long vttablet waits for a connection from the query pool. If set to 0 (default) then the overall query timeout is used instead.")
 	SecondsVar(&currentConfig.OlapReadPool.TimeoutSeconds, "queryserver-config-stream-pool-timeout", defaultConfig.OlapReadPool.TimeoutSeconds, "query server stream pool timeout (in seconds), it is how long vttablet waits for a connection from the stream pool. If set to 0 (default) then there is no timeout.")
@@ -151,6 +159,9 @@ func init() {
 	flag.BoolVar(&enableHeartbeat, "heartbeat_enable", false, "If true, vttablet records (if master) or checks (if replica) the current time of a replication heartbeat in the table _vt.heartbeat. The result is used to inform the serving state of the vttablet via healthchecks.")
 	flag.DurationVar(&heartbeatInterval, "heartbeat_interval", 1*time.Second, "How frequently to read and write replication heartbeat.")
 	flagutil.DualFormatBoolVar(&currentConfig.EnableLagThrottler, "enable_lag_throttler", defaultConfig.EnableLagThrottler, "If true, vttablet will run a throttler service, and will implicitly enable heartbeats")
+	flag.BoolVar(&currentConfig.ThrottleLowPriorityQueries, "throttle_low_priority_queries", defaultConfig.ThrottleLowPriorityQueries, "If true, vttablet checks the lag throttler before running the queries of LOW priority, and rejects them while it is throttled. Requires -enable_lag_throttler.")
+
+	flag.BoolVar(&currentConfig.EnableDynamicConfig, "enable_dynamic_tablet_config", defaultConfig.EnableDynamicConfig, "If true, vttablet watches the DynamicTabletConfig objects of its keyspace and of itself in the topo, and applies their settings (pool sizes, timeouts, result sizes, consolidator) at runtime. The settings of the tablet override the ones of the keyspace, which override the flags.")
 
 	flag.BoolVar(&currentConfig.EnforceStrictTransTables, "enforce_strict_trans_tables", defaultConfig.EnforceStrictTransTables, "If true, vttablet requires MySQL to run with STRICT_TRANS_TABLES or STRICT_ALL_TABLES on. It is recommended to not turn this flag off. Otherwise MySQL may alter your supplied values before saving them to the database.")
 	flagutil.DualFormatBoolVar(&enableConsolidator, "enable_consolidator", true, "This option enables the query consolidator.")
@@ -163,6 +174,10 @@ func init() {
 	flag.DurationVar(&transitionGracePeriod, "serving_state_grace_period", 0, "how long to pause after broadcasting health to vtgate, before enforcing a new serving state")
 
 	flag.BoolVar(&enableReplicationReporter, "enable_replication_reporter", false, "Use polling to track replication lag.")
+
+	flag.Int64Var(&currentConfig.ResultCache.MemoryBytes, "queryserver-config-result-cache-memory", defaultConfig.ResultCache.MemoryBytes, "query server result cache size in bytes. Replicas cache the results of the SELECTs which only read from the tables of -queryserver-config-result-cache-tables up to this size, and invalidate them as they see DMLs in the replication stream. 0 disables the result cache.")
+	flag.Var(&resultCacheTables, "queryserver-config-result-cache-tables", "comma separated list of the tables whose SELECT results can be cached by the result cache. Their results must not depend on anything else than the content of these tables, e.g. on the current time.")
+	SecondsVar(&currentConfig.ResultCache.TTLSeconds, "queryserver-config-result-cache-ttl", defaultConfig.ResultCache.TTLSeconds, "query server result cache TTL (in seconds), how long results are kept in the result cache at most. 0 means until they are invalidated or evicted.")
 	flag.BoolVar(&currentConfig.EnableOnlineDDL, "queryserver_enable_online_ddl", true, "Enable online DDL.")
 }
 
@@ -209,6 +224,13 @@ func Init() {
 		currentConfig.ReplicationTracker.Mode = Disable
 	}
 
+	for _, table := range resultCacheTables {
+		if currentConfig.ResultCache.Tables == nil {
+			currentConfig.ResultCache.Tables = make(map[string]ResultCacheTableConfig)
+		}
+		currentConfig.ResultCache.Tables[table] = ResultCacheTableConfig{}
+	}
+
 	currentConfig.Healthcheck.IntervalSeconds.Set(healthCheckInterval)
 	currentConfig.Healthcheck.DegradedThresholdSeconds.Set(degradedThreshold)
 	currentConfig.Healthcheck.UnhealthyThresholdSeconds.Set(unhealthyThreshold)
@@ -237,6 +259,8 @@ type TabletConfig struct {
 	OltpReadPool ConnPoolConfig `json:"oltpReadPool,omitempty"`
 	OlapReadPool ConnPoolConfig `json:"olapReadPool,omitempty"`
 	TxPool       ConnPoolConfig `json:"txPool,omitempty"`
+	// WorkloadPools reserve separate pools to the queries of some callers.
+	WorkloadPools []WorkloadPoolConfig `json:"workloadPools,omitempty"`
 
 	Oltp             OltpConfig             `json:"oltp,omitempty"`
 	HotRowProtection HotRowProtectionConfig `json:"hotRowProtection,omitempty"`
@@ -246,6 +270,8 @@ type TabletConfig struct {
 
 	ReplicationTracker ReplicationTrackerConfig `json:"replicationTracker,omitempty"`
 
+	ResultCache ResultCacheConfig `json:"resultCache,omitempty"`
+
 	// Consolidator can be enable, disable, or notOnPrimary. Default is enable.
 	// notOnMaster is the deprecated value that is the same as notOnPrimary.
 	Consolidator                            string  `json:"consolidator,omitempty"`
@@ -256,6 +282,8 @@ type TabletConfig struct {
 	QueryCacheSize                          int     `json:"queryCacheSize,omitempty"`
 	QueryCacheMemory                        int64   `json:"queryCacheMemory,omitempty"`
 	QueryCacheLFU                           bool    `json:"queryCacheLFU,omitempty"`
+	QueryCacheWarmupFile                    string  `json:"queryCacheWarmupFile,omitempty"`
+	WarmUpSampleSize                        int     `json:"warmUpSampleSize,omitempty"`
 	SchemaReloadIntervalSeconds             Seconds `json:"schemaReloadIntervalSeconds,omitempty"`
 	SignalSchemaChangeReloadIntervalSeconds Seconds `json:"signalSchemaChangeReloadIntervalSeconds,omitempty"`
 	WatchReplication                        bool    `json:"watchReplication,omitempty"`
@@ -264,6 +292,7 @@ type TabletConfig struct {
 	MessagePostponeParallelism              int     `json:"messagePostponeParallelism,omitempty"`
 	CacheResultFields                       bool    `json:"cacheResultFields,omitempty"`
 	SignalWhenSchemaChange                  bool    `json:"signalWhenSchemaChange,omitempty"`
+	TableStatisticsIntervalSeconds          Seconds `json:"tableStatisticsIntervalSeconds,omitempty"`
 
 	ExternalConnections map[string]*dbconfigs.DBConfigs `json:"externalConnections,omitempty"`
 
@@ -279,6 +308,13 @@ type TabletConfig struct {
 	TxThrottlerHealthCheckCells []string `json:"-"`
 
 	EnableLagThrottler bool `json:"-"`
+	// ThrottleLowPriorityQueries makes the lag throttler reject the queries
+	// of LOW priority while it is throttled.
+	ThrottleLowPriorityQueries bool `json:"-"`
+
+	// EnableDynamicConfig makes vttablet watch and apply the
+	// DynamicTabletConfig objects of its keyspace and of itself in the topo.
+	EnableDynamicConfig bool `json:"-"`
 
 	TransactionLimitConfig `json:"-"`
 
@@ -295,10 +331,40 @@ type ConnPoolConfig struct {
 	MaxWaiters         int     `json:"maxWaiters,omitempty"`
 }
 
+// WorkloadPoolConfig contains the config for the pools of a workload, i.e.
+// of the queries of some callers. These queries get their connections from
+// the pools of their workload instead of the regular ones, so that they
+// cannot starve the other queries of connections.
+type WorkloadPoolConfig struct {
+	// Name identifies the workload in the names of the pool variables. It
+	// must only contain letters, digits and underscores. The queries of the
+	// other callers labelled with it, with the workload_name system variable
+	// of vtgate or the WORKLOAD_NAME directive, join the workload.
+	Name string `json:"name,omitempty"`
+	// Usernames are the immediate caller usernames of the workload, e.g.
+	// the users logged in to vtgate.
+	Usernames []string `json:"usernames,omitempty"`
+	// Principals are the effective caller principals of the workload.
+	Principals []string `json:"principals,omitempty"`
+
+	// The pools of the workload. The regular pool is used instead of any
+	// pool with a size of 0. The idle timeouts default to the ones of the
+	// regular pools.
+	OltpReadPool ConnPoolConfig `json:"oltpReadPool,omitempty"`
+	OlapReadPool ConnPoolConfig `json:"olapReadPool,omitempty"`
+	TxPool       ConnPoolConfig `json:"txPool,omitempty"`
+
+	// TxTimeoutSeconds and TxWarningSeconds override the ones of Oltp for
+	// the transactions of the workload, if not 0.
+	TxTimeoutSeconds Seconds `json:"txTimeoutSeconds,omitempty"`
+	TxWarningSeconds Seconds `json:"txWarningSeconds,omitempty"`
+}
+
 // OltpConfig contains the config for oltp settings.
 type OltpConfig struct {
 	QueryTimeoutSeconds Seconds `json:"queryTimeoutSeconds,omitempty"`
 	TxTimeoutSeconds    Seconds `json:"txTimeoutSeconds,omitempty"`
+	TxWarningSeconds    Seconds `json:"txWarningSeconds,omitempty"`
 	MaxRows             int     `json:"maxRpws,omitempty"`
 	WarnRows            int     `json:"warnRows,omitempty"`
 }
@@ -333,6 +399,37 @@ type ReplicationTrackerConfig struct {
 	HeartbeatIntervalSeconds Seconds `json:"heartbeatIntervalSeconds,omitempty"`
 }
 
+// ResultCacheConfig contains the config for the result cache.
+type ResultCacheConfig struct {
+	// MemoryBytes is the size of the result cache. 0 disables it.
+	MemoryBytes int64 `json:"memoryBytes,omitempty"`
+	// TTLSeconds is how long results are cached at most, for the tables
+	// without a TTL of their own. 0 means until they are invalidated.
+	TTLSeconds Seconds `json:"ttlSeconds,omitempty"`
+	// Tables lists the tables whose SELECT results can be cached.
+	Tables map[string]ResultCacheTableConfig `json:"tables,omitempty"`
+}
+
+// ResultCacheTableConfig contains the result cache config of a table.
+type ResultCacheTableConfig struct {
+	// TTLSeconds overrides ResultCacheConfig.TTLSeconds for the table.
+	TTLSeconds Seconds `json:"ttlSeconds,omitempty"`
+}
+
+// Enabled returns true if results can be cached.
+func (c *ResultCacheConfig) Enabled() bool {
+	return c.MemoryBytes > 0 && len(c.Tables) > 0
+}
+
+// TTL returns how long the results of a table are cached at most, or 0 if
+// they don't expire.
+func (c *ResultCacheConfig) TTL(table string) time.Duration {
+	if ttl := c.Tables[table].TTLSeconds; ttl > 0 {
+		return ttl.Get()
+	}
+	return c.TTLSeconds.Get()
+}
+
 // TransactionLimitConfig captures configuration of transaction pool slots
 // limiter configuration.
 type TransactionLimitConfig struct {
@@ -361,6 +458,15 @@ func (c *TabletConfig) Clone() *TabletConfig {
 	if tc.DB != nil {
 		tc.DB = c.DB.Clone()
 	}
+	if c.WorkloadPools != nil {
+		tc.WorkloadPools = append([]WorkloadPoolConfig(nil), c.WorkloadPools...)
+	}
+	if c.ResultCache.Tables != nil {
+		tc.ResultCache.Tables = make(map[string]ResultCacheTableConfig, len(c.ResultCache.Tables))
+		for table, tableConfig := range c.ResultCache.Tables {
+			tc.ResultCache.Tables[table] = tableConfig
+		}
+	}
 	return &tc
 }
 
@@ -369,6 +475,9 @@ func (c *TabletConfig) Verify() error {
 	if err := c.verifyTransactionLimitConfig(); err != nil {
 		return err
 	}
+	if err := c.verifyWorkloadPoolsConfig(); err != nil {
+		return err
+	}
 	if v := c.HotRowProtection.MaxQueueSize; v <= 0 {
 		return fmt.Errorf("-hot_row_protection_max_queue_size must be > 0 (specified value: %v)", v)
 	}
@@ -414,6 +523,64 @@ func (c *TabletConfig) verifyTransactionLimitConfig() error {
 	return nil
 }
 
+// verifyWorkloadPoolsConfig checks WorkloadPools for sanity.
+func (c *TabletConfig) verifyWorkloadPoolsConfig() error {
+	names := make(map[string]bool)
+	callers := make(map[string]string)
+	for _, wp := range c.WorkloadPools {
+		if !workloadNameRegexp.MatchString(wp.Name) {
+			return fmt.Errorf("invalid workload pool name %q: it must only contain letters, digits and underscores", wp.Name)
+		}
+		if names[wp.Name] {
+			return fmt.Errorf("duplicate workload pool %v", wp.Name)
+		}
+		names[wp.Name] = true
+		var workloadCallers []string
+		for _, username := range wp.Usernames {
+			workloadCallers = append(workloadCallers, "username "+username)
+		}
+		for _, principal := range wp.Principals {
+			workloadCallers = append(workloadCallers, "principal "+principal)
+		}
+		for _, caller := range workloadCallers {
+			if other, ok := callers[caller]; ok {
+				return fmt.Errorf("%v belongs to both workload pools %v and %v", caller, other, wp.Name)
+			}
+			callers[caller] = wp.Name
+		}
+		for _, size := range []int{wp.OltpReadPool.Size, wp.OlapReadPool.Size, wp.TxPool.Size} {
+			if size < 0 {
+				return fmt.Errorf("workload pool %v: pool sizes must be >= 0", wp.Name)
+			}
+		}
+		if wp.TxTimeoutSeconds < 0 || wp.TxWarningSeconds < 0 {
+			return fmt.Errorf("workload pool %v: transaction timeouts must be >= 0", wp.Name)
+		}
+	}
+	return nil
+}
+
+var workloadNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9_]+$`)
+
+// VerifyDynamicTabletConfig checks a DynamicTabletConfig for sanity.
+func VerifyDynamicTabletConfig(config *topodatapb.DynamicTabletConfig) error {
+	if config.PoolSize < 0 || config.StreamPoolSize < 0 || config.TransactionPoolSize < 0 {
+		return errors.New("pool sizes must be >= 0")
+	}
+	if config.QueryTimeoutSeconds < 0 || config.TransactionTimeoutSeconds < 0 {
+		return errors.New("timeouts must be >= 0")
+	}
+	if config.MaxResultSize < 0 || config.WarnResultSize < 0 {
+		return errors.New("result sizes must be >= 0")
+	}
+	switch config.Consolidator {
+	case "", Enable, Disable, NotOnPrimary, NotOnMaster:
+	default:
+		return fmt.Errorf("invalid consolidator mode %q: it must be %v, %v or %v", config.Consolidator, Enable, Disable, NotOnPrimary)
+	}
+	return nil
+}
+
 // Some of these values are for documentation purposes.
 // They actually get overwritten during Init.
 var defaultConfig = TabletConfig{
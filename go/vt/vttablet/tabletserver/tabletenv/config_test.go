@@ -28,6 +28,8 @@ import (
 	"vitess.io/vitess/go/cache"
 	"vitess.io/vitess/go/vt/dbconfigs"
 	"vitess.io/vitess/go/yaml2"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 )
 
 func TestConfigParse(t *testing.T) {
@@ -79,6 +81,7 @@ oltpReadPool:
   size: 16
   timeoutSeconds: 10
 replicationTracker: {}
+resultCache: {}
 txPool: {}
 `
 	assert.Equal(t, wantBytes, string(gotBytes))
@@ -141,6 +144,7 @@ queryCacheSize: 5000
 replicationTracker:
   heartbeatIntervalSeconds: 0.25
   mode: disable
+resultCache: {}
 schemaReloadIntervalSeconds: 1800
 signalSchemaChangeReloadIntervalSeconds: 5
 streamBufferSize: 32768
@@ -333,3 +337,73 @@ func TestFlags(t *testing.T) {
 	want.GracePeriods.TransitionSeconds = 4
 	assert.Equal(t, want, currentConfig)
 }
+
+func TestVerifyWorkloadPools(t *testing.T) {
+	testcases := []struct {
+		pools []WorkloadPoolConfig
+		err   string
+	}{{
+		pools: []WorkloadPoolConfig{{Name: "analytics", Usernames: []string{"a"}, Principals: []string{"b"}, TxPool: ConnPoolConfig{Size: 2}}, {Name: "batch", Usernames: []string{"b"}}},
+	}, {
+		pools: []WorkloadPoolConfig{{Name: "analytics-1", Usernames: []string{"a"}}},
+		err:   `invalid workload pool name "analytics-1"`,
+	}, {
+		pools: []WorkloadPoolConfig{{Name: "analytics", Usernames: []string{"a"}}, {Name: "analytics", Usernames: []string{"b"}}},
+		err:   "duplicate workload pool analytics",
+	}, {
+		// A workload without callers is only joined by the labelled queries.
+		pools: []WorkloadPoolConfig{{Name: "analytics", OlapReadPool: ConnPoolConfig{Size: 2}}},
+	}, {
+		pools: []WorkloadPoolConfig{{Name: "analytics", Usernames: []string{"a"}}, {Name: "batch", Usernames: []string{"a"}}},
+		err:   "username a belongs to both workload pools analytics and batch",
+	}, {
+		pools: []WorkloadPoolConfig{{Name: "analytics", Usernames: []string{"a"}, OltpReadPool: ConnPoolConfig{Size: -1}}},
+		err:   "workload pool analytics: pool sizes must be >= 0",
+	}, {
+		pools: []WorkloadPoolConfig{{Name: "analytics", Usernames: []string{"a"}, TxTimeoutSeconds: -1}},
+		err:   "workload pool analytics: transaction timeouts must be >= 0",
+	}}
+	for _, tc := range testcases {
+		config := NewDefaultConfig()
+		config.WorkloadPools = tc.pools
+		err := config.Verify()
+		if tc.err == "" {
+			assert.NoError(t, err)
+			continue
+		}
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), tc.err)
+		}
+	}
+}
+
+func TestVerifyDynamicTabletConfig(t *testing.T) {
+	testcases := []struct {
+		config *topodatapb.DynamicTabletConfig
+		err    string
+	}{{
+		config: &topodatapb.DynamicTabletConfig{PoolSize: 10, QueryTimeoutSeconds: 0.5, Consolidator: NotOnPrimary},
+	}, {
+		config: &topodatapb.DynamicTabletConfig{TransactionPoolSize: -1},
+		err:    "pool sizes must be >= 0",
+	}, {
+		config: &topodatapb.DynamicTabletConfig{TransactionTimeoutSeconds: -1},
+		err:    "timeouts must be >= 0",
+	}, {
+		config: &topodatapb.DynamicTabletConfig{WarnResultSize: -1},
+		err:    "result sizes must be >= 0",
+	}, {
+		config: &topodatapb.DynamicTabletConfig{Consolidator: "sometimes"},
+		err:    `invalid consolidator mode "sometimes"`,
+	}}
+	for _, tc := range testcases {
+		err := VerifyDynamicTabletConfig(tc.config)
+		if tc.err == "" {
+			assert.NoError(t, err)
+			continue
+		}
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), tc.err)
+		}
+	}
+}
@@ -30,6 +30,7 @@ import (
 	"vitess.io/vitess/go/streamlog"
 	"vitess.io/vitess/go/vt/callerid"
 	"vitess.io/vitess/go/vt/callinfo"
+	"vitess.io/vitess/go/vt/sqlparser"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
 )
@@ -39,6 +40,8 @@ const (
 	QuerySourceConsolidator = 1 << iota
 	// QuerySourceMySQL means query result is returned from MySQL.
 	QuerySourceMySQL
+	// QuerySourceResultCache means query result is found in the result cache.
+	QuerySourceResultCache
 )
 
 // LogStats records the stats for a single query
@@ -48,6 +51,7 @@ type LogStats struct {
 	Target               *querypb.Target
 	PlanType             string
 	OriginalSQL          string
+	Fingerprint          string
 	BindVariables        map[string]*querypb.BindVariable
 	rewrittenSqls        []string
 	RowsAffected         int
@@ -142,7 +146,7 @@ func (stats *LogStats) FmtQuerySources() string {
 	if stats.QuerySources == 0 {
 		return "none"
 	}
-	sources := make([]string, 2)
+	sources := make([]string, 3)
 	n := 0
 	if stats.QuerySources&QuerySourceMySQL != 0 {
 		sources[n] = "mysql"
@@ -152,9 +156,23 @@ func (stats *LogStats) FmtQuerySources() string {
 		sources[n] = "consolidator"
 		n++
 	}
+	if stats.QuerySources&QuerySourceResultCache != 0 {
+		sources[n] = "resultcache"
+		n++
+	}
 	return strings.Join(sources[:n], ",")
 }
 
+// FilterFields is part of the streamlog.Filterable interface.
+func (stats *LogStats) FilterFields() streamlog.FilterFields {
+	return streamlog.FilterFields{
+		User:        stats.ImmediateCaller(),
+		Keyspace:    stats.Target.GetKeyspace(),
+		Fingerprint: stats.Fingerprint,
+		Duration:    stats.TotalTime(),
+	}
+}
+
 // ContextHTML returns the HTML version of the context that was used, or "".
 // This is a method on LogStats instead of a field so that it doesn't need
 // to be passed by value everywhere.
@@ -186,19 +204,20 @@ func (stats *LogStats) Logf(w io.Writer, params url.Values) error {
 		return nil
 	}
 
-	rewrittenSQL := "[REDACTED]"
-	formattedBindVars := "\"[REDACTED]\""
-
-	if !*streamlog.RedactDebugUIQueries {
+	// The rewritten queries embed the values of the bind variables, and the
+	// original queries their literals when they were not normalized.
+	originalSQL, rewrittenSQL := stats.OriginalSQL, "[REDACTED]"
+	if streamlog.RedactsBindVars() {
+		originalSQL = redactedSQL(originalSQL)
+	} else {
 		rewrittenSQL = stats.RewrittenSQL()
-
-		_, fullBindParams := params["full"]
-		formattedBindVars = sqltypes.FormatBindVariables(
-			stats.BindVariables,
-			fullBindParams,
-			*streamlog.QueryLogFormat == streamlog.QueryLogFormatJSON,
-		)
 	}
+	_, fullBindParams := params["full"]
+	formattedBindVars := streamlog.FormatBindVariables(
+		stats.BindVariables,
+		fullBindParams,
+		*streamlog.QueryLogFormat == streamlog.QueryLogFormatJSON,
+	)
 
 	// TODO: remove username here we fully enforce immediate caller id
 	callInfo, username := stats.CallInfo()
@@ -207,9 +226,9 @@ func (stats *LogStats) Logf(w io.Writer, params url.Values) error {
 	var fmtString string
 	switch *streamlog.QueryLogFormat {
 	case streamlog.QueryLogFormatText:
-		fmtString = "%v\t%v\t%v\t'%v'\t'%v'\t%v\t%v\t%.6f\t%v\t%q\t%v\t%v\t%q\t%v\t%.6f\t%.6f\t%v\t%v\t%v\t%q\t\n"
+		fmtString = "%v\t%v\t%v\t'%v'\t'%v'\t%v\t%v\t%.6f\t%v\t%q\t%v\t%v\t%q\t%v\t%.6f\t%.6f\t%v\t%v\t%v\t%q\t%q\t\n"
 	case streamlog.QueryLogFormatJSON:
-		fmtString = "{\"Method\": %q, \"CallInfo\": %q, \"Username\": %q, \"ImmediateCaller\": %q, \"Effective Caller\": %q, \"Start\": \"%v\", \"End\": \"%v\", \"TotalTime\": %.6f, \"PlanType\": %q, \"OriginalSQL\": %q, \"BindVars\": %v, \"Queries\": %v, \"RewrittenSQL\": %q, \"QuerySources\": %q, \"MysqlTime\": %.6f, \"ConnWaitTime\": %.6f, \"RowsAffected\": %v,\"TransactionID\": %v,\"ResponseSize\": %v, \"Error\": %q}\n"
+		fmtString = "{\"Method\": %q, \"CallInfo\": %q, \"Username\": %q, \"ImmediateCaller\": %q, \"Effective Caller\": %q, \"Start\": \"%v\", \"End\": \"%v\", \"TotalTime\": %.6f, \"PlanType\": %q, \"OriginalSQL\": %q, \"BindVars\": %v, \"Queries\": %v, \"RewrittenSQL\": %q, \"QuerySources\": %q, \"MysqlTime\": %.6f, \"ConnWaitTime\": %.6f, \"RowsAffected\": %v,\"TransactionID\": %v,\"ResponseSize\": %v, \"Error\": %q, \"Fingerprint\": %q}\n"
 	}
 
 	_, err := fmt.Fprintf(
@@ -224,7 +243,7 @@ func (stats *LogStats) Logf(w io.Writer, params url.Values) error {
 		stats.EndTime.Format("2006-01-02 15:04:05.000000"),
 		stats.TotalTime().Seconds(),
 		stats.PlanType,
-		stats.OriginalSQL,
+		originalSQL,
 		formattedBindVars,
 		stats.NumberOfQueries,
 		rewrittenSQL,
@@ -235,6 +254,15 @@ func (stats *LogStats) Logf(w io.Writer, params url.Values) error {
 		stats.TransactionID,
 		stats.SizeOfResponse(),
 		stats.ErrorStr(),
+		stats.Fingerprint,
 	)
 	return err
 }
+
+func redactedSQL(sql string) string {
+	redacted, err := sqlparser.RedactSQLQuery(sql)
+	if err != nil {
+		return "<unparsable query>"
+	}
+	return redacted
+}
@@ -47,6 +47,9 @@ type Stats struct {
 	UserActiveReservedCount *stats.CountersWithSingleLabel // Per CallerID active reserved connection counts
 	UserReservedCount       *stats.CountersWithSingleLabel // Per CallerID reserved connection counts
 	UserReservedTimesNs     *stats.CountersWithSingleLabel // Per CallerID reserved connection duration
+
+	WorkloadQueryTimings *servenv.MultiTimingsWrapper   // Per workload name/priority query timings
+	WorkloadThrottled    *stats.CountersWithMultiLabels // Per workload name/priority throttled queries
 }
 
 // NewStats instantiates a new set of stats scoped by exporter.
@@ -94,6 +97,9 @@ func NewStats(exporter *servenv.Exporter) *Stats {
 		UserActiveReservedCount: exporter.NewCountersWithSingleLabel("UserActiveReservedCount", "active reserved connection for each CallerID", "CallerID"),
 		UserReservedCount:       exporter.NewCountersWithSingleLabel("UserReservedCount", "reserved connection received for each CallerID", "CallerID"),
 		UserReservedTimesNs:     exporter.NewCountersWithSingleLabel("UserReservedTimesNs", "Total reserved connection latency for each CallerID", "CallerID"),
+
+		WorkloadQueryTimings: exporter.NewMultiTimings("WorkloadQueries", "Query timings for each workload name and priority", []string{"Workload", "Priority"}),
+		WorkloadThrottled:    exporter.NewCountersWithMultiLabels("WorkloadQueriesThrottled", "Queries rejected by the lag throttler for each workload name and priority", []string{"Workload", "Priority"}),
 	}
 	stats.QPSRates = exporter.NewRates("QPS", stats.QueryTimings, 15*60/5, 5*time.Second)
 	return stats
@@ -117,6 +117,12 @@ type TabletServer struct {
 	lagThrottler *throttle.Throttler
 	tableGC      *gc.TableGC
 
+	dynamicConfig *dynamicConfig
+	warmUpSample  *warmUpSample
+	// keyspacePolicy caps the query timeouts and row counts, denies the
+	// queries of KillQueries, and applies the query rules of the keyspace.
+	keyspacePolicy keyspacePolicy
+
 	// sm manages state transitions.
 	sm                *stateManager
 	onlineDDLExecutor *onlineddl.Executor
@@ -170,20 +176,24 @@ func NewTabletServer(name string, config *tabletenv.TabletConfig, topoServer *to
 	tsv.statelessql = NewQueryList("oltp-stateless")
 	tsv.statefulql = NewQueryList("oltp-stateful")
 	tsv.olapql = NewQueryList("olap")
-	tsv.lagThrottler = throttle.NewThrottler(tsv, topoServer, tabletTypeFunc)
+	tsv.lagThrottler = throttle.NewThrottler(tsv, topoServer, alias.Cell, tabletTypeFunc)
 	tsv.hs = newHealthStreamer(tsv, alias)
 	tsv.se = schema.NewEngine(tsv)
 	tsv.rt = repltracker.NewReplTracker(tsv, alias)
 	tsv.vstreamer = vstreamer.NewEngine(tsv, srvTopoServer, tsv.se, tsv.lagThrottler, alias.Cell)
 	tsv.tracker = schema.NewTracker(tsv, tsv.vstreamer, tsv.se)
-	tsv.watcher = NewBinlogWatcher(tsv, tsv.vstreamer, tsv.config)
 	tsv.qe = NewQueryEngine(tsv, tsv.se)
+	tsv.watcher = NewBinlogWatcher(tsv, tsv.vstreamer, tsv.config, tsv.qe.resultCache)
 	tsv.txThrottler = txthrottler.NewTxThrottler(tsv.config, topoServer)
 	tsv.te = NewTxEngine(tsv)
 	tsv.messager = messager.NewEngine(tsv, tsv.se, tsv.vstreamer)
 
 	tsv.onlineDDLExecutor = onlineddl.NewExecutor(tsv, alias, topoServer, tabletTypeFunc)
 	tsv.tableGC = gc.NewTableGC(tsv, topoServer, tabletTypeFunc, tsv.lagThrottler)
+	tsv.dynamicConfig = newDynamicConfig(tsv)
+	tsv.warmUpSample = newWarmUpSample(config.WarmUpSampleSize)
+	tsv.RegisterQueryRuleSource(keyspaceQueryRuleSource)
+	tsv.keyspacePolicy.onQueryRules = tsv.setKeyspaceQueryRules
 
 	tsv.sm = &stateManager{
 		statelessql: tsv.statelessql,
@@ -202,6 +212,7 @@ func NewTabletServer(name string, config *tabletenv.TabletConfig, topoServer *to
 		ddle:        tsv.onlineDDLExecutor,
 		throttler:   tsv.lagThrottler,
 		tableGC:     tsv.tableGC,
+		sidecar:     &sidecarDBInitializer{env: tsv},
 	}
 
 	tsv.exporter.NewGaugeFunc("TabletState", "Tablet server state", func() int64 { return int64(tsv.sm.State()) })
@@ -222,6 +233,8 @@ func NewTabletServer(name string, config *tabletenv.TabletConfig, topoServer *to
 	tsv.registerMigrationStatusHandler()
 	tsv.registerThrottlerHandlers()
 	tsv.registerDebugEnvHandler()
+	tsv.exporter.HandleFunc("/debug/config", tsv.dynamicConfig.ServeHTTP)
+	tsv.exporter.HandleFunc("/debug/warmup_queries", tsv.warmUpSample.ServeHTTP)
 
 	return tsv
 }
@@ -244,6 +257,12 @@ func (tsv *TabletServer) InitDBConfig(target *querypb.Target, dbcfgs *dbconfigs.
 	tsv.onlineDDLExecutor.InitDBConfig(target.Keyspace, target.Shard, dbcfgs.DBName)
 	tsv.lagThrottler.InitDBConfig(target.Keyspace, target.Shard)
 	tsv.tableGC.InitDBConfig(target.Keyspace, target.Shard, dbcfgs.DBName)
+	if tsv.config.EnableDynamicConfig && tsv.topoServer != nil {
+		tsv.dynamicConfig.Open(tsv.topoServer, target.Keyspace, tsv.alias)
+	}
+	if tsv.topoServer != nil {
+		tsv.keyspacePolicy.Open(tsv.topoServer, tsv.alias.Cell, target.Keyspace)
+	}
 	return nil
 }
 
@@ -288,6 +307,25 @@ func (tsv *TabletServer) UnRegisterQueryRuleSource(ruleSource string) {
 	tsv.qe.queryRuleSources.UnRegisterSource(ruleSource)
 }
 
+// setKeyspaceQueryRules applies the query rules of the SrvKeyspace. The
+// invalid rules are ignored.
+func (tsv *TabletServer) setKeyspaceQueryRules(queryRules []*topodatapb.QueryRule) {
+	qrs := rules.New()
+	for _, rule := range queryRules {
+		qr, err := rules.BuildQueryRuleFromProto(rule)
+		if err != nil {
+			log.Errorf("Ignoring the invalid query rule %v of the keyspace: %v", rule.Name, err)
+			continue
+		}
+		qrs.Add(qr)
+	}
+	if err := tsv.SetQueryRules(keyspaceQueryRuleSource, qrs); err != nil {
+		log.Errorf("Cannot apply the query rules of the keyspace: %v", err)
+		return
+	}
+	log.Infof("Applied %d query rules of the keyspace", len(queryRules))
+}
+
 // SetQueryRules sets the query rules for a registered ruleSource.
 func (tsv *TabletServer) SetQueryRules(ruleSource string, qrs *rules.Rules) error {
 	err := tsv.qe.queryRuleSources.SetRules(ruleSource, qrs)
@@ -445,6 +483,12 @@ func (tsv *TabletServer) OnlineDDLExecutor() vexec.Executor {
 	return tsv.onlineDDLExecutor
 }
 
+// OnlineDDLSubmitter returns the onlineddl.Executor part of TabletServer, to
+// submit online DDL migrations.
+func (tsv *TabletServer) OnlineDDLSubmitter() *onlineddl.Executor {
+	return tsv.onlineDDLExecutor
+}
+
 // LagThrottler returns the throttle.Throttler part of TabletServer.
 func (tsv *TabletServer) LagThrottler() *throttle.Throttler {
 	return tsv.lagThrottler
@@ -704,13 +748,14 @@ func (tsv *TabletServer) Execute(ctx context.Context, target *querypb.Target, sq
 	}
 
 	allowOnShutdown := false
-	timeout := tsv.QueryTimeout.Get()
+	timeout := tsv.keyspacePolicy.queryTimeout(tsv.QueryTimeout.Get(), options)
 	if transactionID != 0 {
 		allowOnShutdown = true
 		// Use the smaller of the two values (0 means infinity).
 		// TODO(sougou): Assign deadlines to each transaction and set query timeout accordingly.
 		timeout = smallerTimeout(timeout, tsv.txTimeout.Get())
 	}
+	var qre *QueryExecutor
 	err = tsv.execRequest(
 		ctx, timeout,
 		"Execute", sql, bindVariables,
@@ -731,7 +776,7 @@ func (tsv *TabletServer) Execute(ctx context.Context, target *querypb.Target, sq
 			}
 			logStats.ReservedID = reservedID
 			logStats.TransactionID = transactionID
-			qre := &QueryExecutor{
+			qre = &QueryExecutor{
 				query:          query,
 				marginComments: comments,
 				bindVars:       bindVariables,
@@ -747,6 +792,9 @@ func (tsv *TabletServer) Execute(ctx context.Context, target *querypb.Target, sq
 			if err != nil {
 				return err
 			}
+			if plan.PlanID == planbuilder.PlanSelect && connID == 0 {
+				tsv.warmUpSample.record(query, bindVariables)
+			}
 			result = result.StripMetadata(sqltypes.IncludeFieldsOrDefault(options))
 
 			// Change database name in mysql output to the keyspace name
@@ -765,7 +813,7 @@ func (tsv *TabletServer) Execute(ctx context.Context, target *querypb.Target, sq
 			return nil
 		},
 	)
-	return result, err
+	return result, qre.maxExecutionTimeError(err)
 }
 
 // smallerTimeout returns the smaller of the two timeouts.
@@ -787,8 +835,9 @@ func smallerTimeout(t1, t2 time.Duration) time.Duration {
 // The first QueryResult will have Fields set (and Rows nil).
 // The subsequent QueryResult will have Rows set (and Fields nil).
 func (tsv *TabletServer) StreamExecute(ctx context.Context, target *querypb.Target, sql string, bindVariables map[string]*querypb.BindVariable, transactionID int64, options *querypb.ExecuteOptions, callback func(*sqltypes.Result) error) (err error) {
-	return tsv.execRequest(
-		ctx, 0,
+	var qre *QueryExecutor
+	err = tsv.execRequest(
+		ctx, tsv.keyspacePolicy.queryTimeout(0, options),
 		"StreamExecute", sql, bindVariables,
 		target, options, false, /* allowOnShutdown */
 		func(ctx context.Context, logStats *tabletenv.LogStats) error {
@@ -801,7 +850,7 @@ func (tsv *TabletServer) StreamExecute(ctx context.Context, target *querypb.Targ
 			if err != nil {
 				return err
 			}
-			qre := &QueryExecutor{
+			qre = &QueryExecutor{
 				query:          query,
 				marginComments: comments,
 				bindVars:       bindVariables,
@@ -815,6 +864,7 @@ func (tsv *TabletServer) StreamExecute(ctx context.Context, target *querypb.Targ
 			return qre.Stream(callback)
 		},
 	)
+	return qre.maxExecutionTimeError(err)
 }
 
 // ExecuteBatch executes a group of queries and returns their results as a list.
@@ -1415,7 +1465,7 @@ func convertErrorCode(err error) vtrpcpb.Code {
 	case mysql.ERDiskFull, mysql.EROutOfMemory, mysql.EROutOfSortMemory, mysql.ERConCount, mysql.EROutOfResources, mysql.ERRecordFileFull, mysql.ERHostIsBlocked,
 		mysql.ERCantCreateThread, mysql.ERTooManyDelayedThreads, mysql.ERNetPacketTooLarge, mysql.ERTooManyUserConnections, mysql.ERLockTableFull, mysql.ERUserLimitReached, mysql.ERVitessMaxRowsExceeded:
 		errCode = vtrpcpb.Code_RESOURCE_EXHAUSTED
-	case mysql.ERLockWaitTimeout:
+	case mysql.ERLockWaitTimeout, mysql.ERQueryTimeout:
 		errCode = vtrpcpb.Code_DEADLINE_EXCEEDED
 	case mysql.CRServerGone, mysql.ERServerShutdown, mysql.ERServerIsntAvailable:
 		errCode = vtrpcpb.Code_UNAVAILABLE
@@ -1479,6 +1529,23 @@ func (tsv *TabletServer) BroadcastHealth() {
 	tsv.sm.Broadcast()
 }
 
+// SetErrantGTIDs sets the errant GTIDs that the tablet publishes in its
+// health.
+func (tsv *TabletServer) SetErrantGTIDs(gtids string) {
+	tsv.hs.SetErrantGTIDs(gtids)
+}
+
+// KillQueries kills the in-flight queries of a fingerprint and of an
+// immediate caller, an empty one matching any, and returns the number of
+// killed queries.
+func (tsv *TabletServer) KillQueries(fingerprint, user string) int {
+	killed := 0
+	for _, ql := range []*QueryList{tsv.statelessql, tsv.statefulql, tsv.olapql} {
+		killed += ql.TerminateMatching(fingerprint, user)
+	}
+	return killed
+}
+
 // EnterLameduck causes tabletserver to enter the lameduck state. This
 // state causes health checks to fail, but the behavior of tabletserver
 // otherwise remains the same. Any subsequent calls to SetServingType will
@@ -1539,9 +1606,15 @@ func (tsv *TabletServer) healthzHandler(w http.ResponseWriter, r *http.Request)
 		http.Error(w, "500 internal server error: vttablet is not serving", http.StatusInternalServerError)
 		return
 	}
-	w.Header().Set("Content-Length", fmt.Sprintf("%v", len(okMessage)))
+	message := okMessage
+	if progress := mysqlctl.GetRestoreProgress(); progress.InProgress {
+		// A restore does not change the health of the tablet, its progress
+		// is only reported.
+		message = []byte(fmt.Sprintf("ok\nrestoring from backup: %v\n", progress))
+	}
+	w.Header().Set("Content-Length", fmt.Sprintf("%v", len(message)))
 	w.WriteHeader(http.StatusOK)
-	w.Write(okMessage)
+	w.Write(message)
 }
 
 // Query service health check
@@ -1600,6 +1673,19 @@ func (tsv *TabletServer) registerMigrationStatusHandler() {
 	})
 }
 
+// CheckThrottler runs a throttler check for an app, like the /throttler/check
+// and /throttler/check-self endpoints, and the CheckThrottler RPC.
+func (tsv *TabletServer) CheckThrottler(ctx context.Context, appName string, remoteAddr string, flags *throttle.CheckFlags, checkType throttle.ThrottleCheckType) *throttle.CheckResult {
+	checkResult := tsv.lagThrottler.CheckByType(ctx, appName, remoteAddr, flags, checkType)
+	if checkResult.StatusCode == http.StatusNotFound && flags.OKIfNotExists {
+		// The results may be shared: do not alter them.
+		okResult := *checkResult
+		okResult.StatusCode = http.StatusOK // 200
+		checkResult = &okResult
+	}
+	return checkResult
+}
+
 // registerThrottlerCheckHandlers registers throttler "check" requests
 func (tsv *TabletServer) registerThrottlerCheckHandlers() {
 	handle := func(path string, checkType throttle.ThrottleCheckType) {
@@ -1617,10 +1703,7 @@ func (tsv *TabletServer) registerThrottlerCheckHandlers() {
 			flags := &throttle.CheckFlags{
 				LowPriority: (r.URL.Query().Get("p") == "low"),
 			}
-			checkResult := tsv.lagThrottler.CheckByType(ctx, appName, remoteAddr, flags, checkType)
-			if checkResult.StatusCode == http.StatusNotFound && flags.OKIfNotExists {
-				checkResult.StatusCode = http.StatusOK // 200
-			}
+			checkResult := tsv.CheckThrottler(ctx, appName, remoteAddr, flags, checkType)
 
 			if r.Method == http.MethodGet {
 				w.Header().Set("Content-Type", "application/json")
@@ -1824,6 +1907,42 @@ func (tsv *TabletServer) ConsolidatorMode() string {
 	return tsv.qe.consolidatorMode.Get()
 }
 
+// SetHotRowProtectionMaxQueueSize changes the maximum number of transactions
+// queued for the same row range by the hot row protection.
+func (tsv *TabletServer) SetHotRowProtectionMaxQueueSize(val int) {
+	tsv.qe.txSerializer.SetMaxQueueSize(val)
+}
+
+// HotRowProtectionMaxQueueSize returns the maximum number of transactions
+// queued for the same row range by the hot row protection.
+func (tsv *TabletServer) HotRowProtectionMaxQueueSize() int {
+	return tsv.qe.txSerializer.MaxQueueSize()
+}
+
+// SetHotRowProtectionMaxGlobalQueueSize changes the maximum number of
+// transactions queued across all row ranges by the hot row protection.
+func (tsv *TabletServer) SetHotRowProtectionMaxGlobalQueueSize(val int) {
+	tsv.qe.txSerializer.SetMaxGlobalQueueSize(val)
+}
+
+// HotRowProtectionMaxGlobalQueueSize returns the maximum number of
+// transactions queued across all row ranges by the hot row protection.
+func (tsv *TabletServer) HotRowProtectionMaxGlobalQueueSize() int {
+	return tsv.qe.txSerializer.MaxGlobalQueueSize()
+}
+
+// SetHotRowProtectionConcurrentTransactions changes the number of transactions
+// let through concurrently for the same row range by the hot row protection.
+func (tsv *TabletServer) SetHotRowProtectionConcurrentTransactions(val int) {
+	tsv.qe.txSerializer.SetConcurrentTransactions(val)
+}
+
+// HotRowProtectionConcurrentTransactions returns the number of transactions
+// let through concurrently for the same row range by the hot row protection.
+func (tsv *TabletServer) HotRowProtectionConcurrentTransactions() int {
+	return tsv.qe.txSerializer.ConcurrentTransactions()
+}
+
 // queryAsString returns a readable version of query+bind variables.
 func queryAsString(sql string, bindVariables map[string]*querypb.BindVariable) string {
 	buf := &bytes.Buffer{}
@@ -25,6 +25,9 @@ const (
 	frenoAppName   = "freno"
 
 	selfCheckInterval = 250 * time.Millisecond
+
+	// lowPriority is the highest priority of the low priority requests.
+	lowPriority = -1
 )
 
 // CheckFlags provide hints for a check
@@ -33,6 +36,8 @@ type CheckFlags struct {
 	OverrideThreshold float64
 	LowPriority       bool
 	OKIfNotExists     bool
+	// Priority is the priority of the app, from its AppPolicy.
+	Priority int32
 }
 
 // StandardCheckFlags have no special hints
@@ -55,12 +60,14 @@ func (check *ThrottlerCheck) checkAppMetricResult(ctx context.Context, appName s
 	// Handle deprioritized app logic
 	denyApp := false
 	metricName := fmt.Sprintf("%s/%s", storeType, storeName)
-	if flags.LowPriority {
-		if _, exists := check.throttler.nonLowPriorityAppRequestsThrottled.Get(metricName); exists {
-			// a non-deprioritized app, ie a "normal" app, has recently been throttled.
-			// This is now a deprioritized app. Deny access to this request.
-			denyApp = true
-		}
+	priority := flags.Priority
+	if flags.LowPriority && priority > lowPriority {
+		priority = lowPriority
+	}
+	if throttledPriority, exists := check.throttler.throttledAppPriorities.Get(metricName); exists && throttledPriority.(int32) > priority {
+		// an app of higher priority, e.g. a "normal" app for a low priority
+		// request, has recently been throttled. Deny access to this request.
+		denyApp = true
 	}
 	//
 	metricResult, threshold := check.throttler.AppRequestMetricResult(ctx, appName, metricResultFunc, denyApp)
@@ -88,9 +95,9 @@ func (check *ThrottlerCheck) checkAppMetricResult(ctx context.Context, appName s
 		statusCode = http.StatusTooManyRequests // 429
 		err = base.ErrThresholdExceeded
 
-		if !flags.LowPriority && !flags.ReadCheck && appName != frenoAppName {
-			// low priority requests will henceforth be denied
-			go check.throttler.nonLowPriorityAppRequestsThrottled.SetDefault(metricName, true)
+		if !flags.ReadCheck && appName != frenoAppName {
+			// lower priority requests will henceforth be denied
+			go check.throttler.markAppPriorityThrottled(metricName, priority)
 		}
 	} else {
 		// all good!
@@ -19,6 +19,9 @@ type CheckResult struct {
 	Threshold  float64 `json:"Threshold"`
 	Error      error   `json:"-"`
 	Message    string  `json:"Message"`
+	// MetricName is the metric which was checked last: the one which
+	// throttled the app, if any.
+	MetricName string `json:"MetricName,omitempty"`
 }
 
 // NewCheckResult returns a CheckResult
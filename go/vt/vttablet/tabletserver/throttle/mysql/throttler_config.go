@@ -0,0 +1,102 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysql
+
+import (
+	"fmt"
+	"regexp"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+const (
+	// LagMetricName is the name of the replication lag metric, which is
+	// always checked.
+	LagMetricName = "lag"
+	// ThreadsRunningMetricName is the name of the builtin threads_running
+	// metric.
+	ThreadsRunningMetricName = "threads_running"
+)
+
+// builtinMetricQueries are the default queries of the builtin metrics,
+// except for the replication lag metric, whose default query depends on the
+// flags.
+var builtinMetricQueries = map[string]string{
+	ThreadsRunningMetricName: "show global status like 'threads_running'",
+}
+
+var metricNameRegexp = regexp.MustCompile(`^[a-z0-9_]+$`)
+
+// MetricQuery returns the query of a metric, which defaults to the one of
+// the builtin metric of the same name.
+func MetricQuery(name string, metric *topodatapb.ThrottlerConfig_Metric) string {
+	if query := metric.GetQuery(); query != "" {
+		return query
+	}
+	return builtinMetricQueries[name]
+}
+
+// ValidateThrottlerConfig returns an error if the metrics or the app
+// policies of a ThrottlerConfig are invalid.
+func ValidateThrottlerConfig(config *topodatapb.ThrottlerConfig) error {
+	isMetric := func(name string) bool {
+		_, ok := config.GetMetrics()[name]
+		return ok || name == LagMetricName
+	}
+	for name, metric := range config.GetMetrics() {
+		if !metricNameRegexp.MatchString(name) {
+			return fmt.Errorf("invalid metric name %q: it must only contain lowercase letters, digits and underscores", name)
+		}
+		switch {
+		case name == LagMetricName && metric.GetQuery() == "":
+			// The lag metric of the flags, with an optional new threshold.
+			if metric.GetThreshold() < 0 {
+				return fmt.Errorf("metric %s: the threshold must be >= 0", name)
+			}
+			continue
+		case MetricQuery(name, metric) == "":
+			return fmt.Errorf("metric %s: a query is required for a custom metric", name)
+		}
+		switch GetMetricsQueryType(MetricQuery(name, metric)) {
+		case MetricsQueryTypeSelect, MetricsQueryTypeShowGlobal:
+		default:
+			return fmt.Errorf("metric %s: the query must be a SELECT or a SHOW GLOBAL query", name)
+		}
+		if metric.GetThreshold() <= 0 {
+			return fmt.Errorf("metric %s: the threshold must be > 0", name)
+		}
+	}
+	for appName, policy := range config.GetAppPolicies() {
+		if appName == "" {
+			return fmt.Errorf("an app policy has no app name")
+		}
+		for _, name := range policy.GetMetrics() {
+			if !isMetric(name) {
+				return fmt.Errorf("app %s: unknown metric %s", appName, name)
+			}
+		}
+		for name, threshold := range policy.GetThresholds() {
+			if !isMetric(name) {
+				return fmt.Errorf("app %s: unknown metric %s", appName, name)
+			}
+			if threshold <= 0 {
+				return fmt.Errorf("app %s: the threshold of metric %s must be > 0", appName, name)
+			}
+		}
+	}
+	return nil
+}
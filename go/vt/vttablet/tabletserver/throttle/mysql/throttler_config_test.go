@@ -0,0 +1,76 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func TestValidateThrottlerConfig(t *testing.T) {
+	type metrics = map[string]*topodatapb.ThrottlerConfig_Metric
+	type policies = map[string]*topodatapb.ThrottlerConfig_AppPolicy
+	testcases := []struct {
+		config *topodatapb.ThrottlerConfig
+		err    string
+	}{{
+		config: &topodatapb.ThrottlerConfig{
+			Metrics: metrics{
+				"lag":             {Threshold: 2},
+				"threads_running": {Threshold: 50},
+				"history_length":  {Query: "select count from information_schema.innodb_metrics where name = 'trx_rseg_history_len'", Threshold: 10000},
+			},
+			AppPolicies: policies{
+				"online-ddl": {Metrics: []string{"lag", "history_length"}, Thresholds: map[string]float64{"lag": 5}, Priority: -1},
+			},
+		},
+	}, {
+		config: &topodatapb.ThrottlerConfig{Metrics: metrics{"Threads-Running": {Threshold: 50}}},
+		err:    `invalid metric name "Threads-Running"`,
+	}, {
+		config: &topodatapb.ThrottlerConfig{Metrics: metrics{"history_length": {Threshold: 10}}},
+		err:    "metric history_length: a query is required for a custom metric",
+	}, {
+		config: &topodatapb.ThrottlerConfig{Metrics: metrics{"history_length": {Query: "delete from t", Threshold: 10}}},
+		err:    "metric history_length: the query must be a SELECT or a SHOW GLOBAL query",
+	}, {
+		config: &topodatapb.ThrottlerConfig{Metrics: metrics{"threads_running": {}}},
+		err:    "metric threads_running: the threshold must be > 0",
+	}, {
+		config: &topodatapb.ThrottlerConfig{Metrics: metrics{"lag": {Query: "show global status like 'Seconds_Behind'"}}},
+		err:    "metric lag: the threshold must be > 0",
+	}, {
+		config: &topodatapb.ThrottlerConfig{AppPolicies: policies{"vreplication": {Metrics: []string{"threads_running"}}}},
+		err:    "app vreplication: unknown metric threads_running",
+	}, {
+		config: &topodatapb.ThrottlerConfig{AppPolicies: policies{"vreplication": {Thresholds: map[string]float64{"lag": 0}}}},
+		err:    "app vreplication: the threshold of metric lag must be > 0",
+	}}
+	for _, tc := range testcases {
+		err := ValidateThrottlerConfig(tc.config)
+		if tc.err == "" {
+			assert.NoError(t, err)
+			continue
+		}
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), tc.err)
+		}
+	}
+}
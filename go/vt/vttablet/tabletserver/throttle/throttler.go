@@ -13,12 +13,15 @@ import (
 	"math"
 	"math/rand"
 	"net/http"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
 
+	"google.golang.org/protobuf/proto"
+
 	"vitess.io/vitess/go/sync2"
 	"vitess.io/vitess/go/textutil"
 	"vitess.io/vitess/go/timer"
@@ -48,8 +51,10 @@ const (
 	throttledAppsSnapshotInterval = 5 * time.Second
 	recentAppsExpiration          = time.Hour * 24
 
-	nonDeprioritizedAppMapExpiration = time.Second
-	nonDeprioritizedAppMapInterval   = 10 * time.Second
+	throttledAppPrioritiesExpiration = time.Second
+	throttledAppPrioritiesInterval   = 10 * time.Second
+
+	throttlerConfigRetryInterval = 10 * time.Second
 
 	dormantPeriod             = time.Minute
 	defaultThrottleTTLMinutes = 60
@@ -101,6 +106,7 @@ func init() {
 type Throttler struct {
 	keyspace string
 	shard    string
+	cell     string
 
 	check    *ThrottlerCheck
 	isLeader int64
@@ -116,12 +122,17 @@ type Throttler struct {
 	mysqlThrottleMetricChan chan *mysql.MySQLThrottleMetric
 	mysqlInventoryChan      chan *mysql.Inventory
 	mysqlClusterProbesChan  chan *mysql.ClusterProbes
+	throttlerConfigChan     chan *topodatapb.ThrottlerConfig
 
 	mysqlInventory *mysql.Inventory
 
 	metricsQuery     string
 	MetricsThreshold sync2.AtomicFloat64
-	metricsQueryType mysql.MetricsQueryType
+
+	// throttlerConfig is the ThrottlerConfig of the SrvKeyspace. It is only
+	// updated by the Operate goroutine.
+	throttlerConfig      *topodatapb.ThrottlerConfig
+	throttlerConfigMutex sync.Mutex
 
 	mysqlClusterThresholds *cache.Cache
 	aggregatedMetrics      *cache.Cache
@@ -135,8 +146,11 @@ type Throttler struct {
 	throttledAppsMutex sync.Mutex
 	tickers            [](*timer.SuspendableTicker)
 
-	nonLowPriorityAppRequestsThrottled *cache.Cache
-	httpClient                         *http.Client
+	// throttledAppPriorities has the highest priority of the apps recently
+	// throttled, by metric. The apps of lower priorities are denied.
+	throttledAppPriorities      *cache.Cache
+	throttledAppPrioritiesMutex sync.Mutex
+	httpClient                  *http.Client
 }
 
 // ThrottlerStatus published some status values from the throttler
@@ -150,13 +164,16 @@ type ThrottlerStatus struct {
 
 	AggregatedMetrics map[string]base.MetricResult
 	MetricsHealth     base.MetricHealthMap
+	Config            *topodatapb.ThrottlerConfig
 }
 
-// NewThrottler creates a Throttler
-func NewThrottler(env tabletenv.Env, ts *topo.Server, tabletTypeFunc func() topodatapb.TabletType) *Throttler {
+// NewThrottler creates a Throttler. It reads its ThrottlerConfig from the
+// SrvKeyspace of the cell.
+func NewThrottler(env tabletenv.Env, ts *topo.Server, cell string, tabletTypeFunc func() topodatapb.TabletType) *Throttler {
 	throttler := &Throttler{
 		isLeader: 0,
 		isOpen:   0,
+		cell:     cell,
 
 		env:            env,
 		tabletTypeFunc: tabletTypeFunc,
@@ -172,6 +189,7 @@ func NewThrottler(env tabletenv.Env, ts *topo.Server, tabletTypeFunc func() topo
 
 		throttler.mysqlInventoryChan = make(chan *mysql.Inventory, 1)
 		throttler.mysqlClusterProbesChan = make(chan *mysql.ClusterProbes)
+		throttler.throttlerConfigChan = make(chan *topodatapb.ThrottlerConfig)
 		throttler.mysqlInventory = mysql.NewInventory()
 
 		throttler.metricsQuery = replicationLagQuery
@@ -184,7 +202,7 @@ func NewThrottler(env tabletenv.Env, ts *topo.Server, tabletTypeFunc func() topo
 		throttler.metricsHealth = cache.New(cache.NoExpiration, 0)
 
 		throttler.tickers = [](*timer.SuspendableTicker){}
-		throttler.nonLowPriorityAppRequestsThrottled = cache.New(nonDeprioritizedAppMapExpiration, nonDeprioritizedAppMapInterval)
+		throttler.throttledAppPriorities = cache.New(throttledAppPrioritiesExpiration, throttledAppPrioritiesInterval)
 
 		throttler.httpClient = base.SetupHTTPClient(0)
 		throttler.initThrottleTabletTypes()
@@ -218,7 +236,89 @@ func (throttler *Throttler) InitDBConfig(keyspace, shard string) {
 	throttler.shard = shard
 	if throttler.env.Config().EnableLagThrottler {
 		go throttler.Operate(context.Background())
+		go throttler.watchThrottlerConfig(context.Background())
+	}
+}
+
+// watchThrottlerConfig watches the ThrottlerConfig of the SrvKeyspace, and
+// sends it to the Operate goroutine when it changes.
+func (throttler *Throttler) watchThrottlerConfig(ctx context.Context) {
+	if throttler.ts == nil {
+		return
+	}
+	var last *topodatapb.ThrottlerConfig
+	update := func(throttlerConfig *topodatapb.ThrottlerConfig) {
+		if proto.Equal(throttlerConfig, last) {
+			return
+		}
+		last = throttlerConfig
+		throttler.throttlerConfigChan <- throttlerConfig
+	}
+	for {
+		current, changes, cancel := throttler.ts.WatchSrvKeyspace(ctx, throttler.cell, throttler.keyspace)
+		switch {
+		case current.Err == nil:
+			update(current.Value.GetThrottlerConfig())
+			for wd := range changes {
+				if wd.Err != nil {
+					if topo.IsErrType(wd.Err, topo.NoNode) {
+						update(nil)
+					}
+					break
+				}
+				update(wd.Value.GetThrottlerConfig())
+			}
+			cancel()
+		case topo.IsErrType(current.Err, topo.NoNode):
+			update(nil)
+		default:
+			log.Warningf("Throttler: cannot watch the SrvKeyspace: %v", current.Err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(throttlerConfigRetryInterval):
+		}
+	}
+}
+
+// applyThrottlerConfig replaces the ThrottlerConfig, and the clusters which
+// depend on it.
+func (throttler *Throttler) applyThrottlerConfig(throttlerConfig *topodatapb.ThrottlerConfig) {
+	log.Infof("Throttler: applying config %v", throttlerConfig)
+	throttler.throttlerConfigMutex.Lock()
+	throttler.throttlerConfig = throttlerConfig
+	throttler.throttlerConfigMutex.Unlock()
+
+	// Keep the account of the shard clusters, if this is the leader.
+	password := ""
+	if clusterSettings, ok := config.Settings().Stores.MySQL.Clusters[shardStoreName]; ok {
+		password = clusterSettings.Password
 	}
+	throttler.initConfig(password)
+}
+
+// getThrottlerConfig returns the current ThrottlerConfig, which may be nil.
+func (throttler *Throttler) getThrottlerConfig() *topodatapb.ThrottlerConfig {
+	throttler.throttlerConfigMutex.Lock()
+	defer throttler.throttlerConfigMutex.Unlock()
+	return throttler.throttlerConfig
+}
+
+// metricStoreName returns the name of the cluster of a metric: the
+// replication lag is checked in the "self" and "shard" clusters, and the
+// other metrics in e.g. the "self-threads_running" and
+// "shard-threads_running" clusters.
+func metricStoreName(storeName, metricName string) string {
+	if metricName == mysql.LagMetricName {
+		return storeName
+	}
+	return storeName + "-" + metricName
+}
+
+// isSelfStore returns true for the clusters which only probe this tablet.
+func isSelfStore(clusterName string) bool {
+	return clusterName == selfStoreName || strings.HasPrefix(clusterName, selfStoreName+"-")
 }
 
 // initThrottler initializes config
@@ -238,23 +338,41 @@ func (throttler *Throttler) initConfig(password string) {
 	if *throttleMetricThreshold != math.MaxFloat64 {
 		throttler.MetricsThreshold = sync2.NewAtomicFloat64(*throttleMetricThreshold)
 	}
-	throttler.metricsQueryType = mysql.GetMetricsQueryType(throttler.metricsQuery)
-
-	config.Instance.Stores.MySQL.Clusters[selfStoreName] = &config.MySQLClusterConfigurationSettings{
-		User:              "", // running on local tablet server, will use vttablet DBA user
-		Password:          "", // running on local tablet server, will use vttablet DBA user
-		MetricQuery:       throttler.metricsQuery,
-		ThrottleThreshold: throttler.MetricsThreshold.Get(),
-		IgnoreHostsCount:  0,
-	}
-	if password != "" {
-		config.Instance.Stores.MySQL.Clusters[shardStoreName] = &config.MySQLClusterConfigurationSettings{
-			User:              throttlerUser,
-			Password:          password,
-			MetricQuery:       throttler.metricsQuery,
-			ThrottleThreshold: throttler.MetricsThreshold.Get(),
+
+	// The replication lag of the flags, which the ThrottlerConfig may
+	// override, and the other metrics of the ThrottlerConfig.
+	metrics := map[string]*topodatapb.ThrottlerConfig_Metric{
+		mysql.LagMetricName: {Query: throttler.metricsQuery, Threshold: throttler.MetricsThreshold.Get()},
+	}
+	for metricName, metric := range throttler.getThrottlerConfig().GetMetrics() {
+		metric = &topodatapb.ThrottlerConfig_Metric{Query: mysql.MetricQuery(metricName, metric), Threshold: metric.Threshold}
+		if metricName == mysql.LagMetricName {
+			if metric.Query == "" {
+				metric.Query = throttler.metricsQuery
+			}
+			if metric.Threshold == 0 {
+				metric.Threshold = throttler.MetricsThreshold.Get()
+			}
+		}
+		metrics[metricName] = metric
+	}
+	for metricName, metric := range metrics {
+		config.Instance.Stores.MySQL.Clusters[metricStoreName(selfStoreName, metricName)] = &config.MySQLClusterConfigurationSettings{
+			User:              "", // running on local tablet server, will use vttablet DBA user
+			Password:          "", // running on local tablet server, will use vttablet DBA user
+			MetricQuery:       metric.Query,
+			ThrottleThreshold: metric.Threshold,
 			IgnoreHostsCount:  0,
 		}
+		if password != "" {
+			config.Instance.Stores.MySQL.Clusters[metricStoreName(shardStoreName, metricName)] = &config.MySQLClusterConfigurationSettings{
+				User:              throttlerUser,
+				Password:          password,
+				MetricQuery:       metric.Query,
+				ThrottleThreshold: metric.Threshold,
+				IgnoreHostsCount:  0,
+			}
+		}
 	}
 }
 
@@ -354,9 +472,9 @@ func (throttler *Throttler) createThrottlerUser(ctx context.Context) (password s
 }
 
 // readSelfMySQLThrottleMetric reads the mysql metric from thi very tablet's backend mysql.
-func (throttler *Throttler) readSelfMySQLThrottleMetric() *mysql.MySQLThrottleMetric {
+func (throttler *Throttler) readSelfMySQLThrottleMetric(clusterName string, metricsQuery string) *mysql.MySQLThrottleMetric {
 	metric := &mysql.MySQLThrottleMetric{
-		ClusterName: selfStoreName,
+		ClusterName: clusterName,
 		Key:         *mysql.SelfInstanceKey,
 		Value:       0,
 		Err:         nil,
@@ -369,7 +487,7 @@ func (throttler *Throttler) readSelfMySQLThrottleMetric() *mysql.MySQLThrottleMe
 	}
 	defer conn.Recycle()
 
-	tm, err := conn.Exec(ctx, throttler.metricsQuery, 1, true)
+	tm, err := conn.Exec(ctx, metricsQuery, 1, true)
 	if err != nil {
 		metric.Err = err
 		return metric
@@ -380,7 +498,7 @@ func (throttler *Throttler) readSelfMySQLThrottleMetric() *mysql.MySQLThrottleMe
 		return metric
 	}
 
-	switch throttler.metricsQueryType {
+	switch mysql.GetMetricsQueryType(metricsQuery) {
 	case mysql.MetricsQueryTypeSelect:
 		// We expect a single row, single column result.
 		// The "for" iteration below is just a way to get first result without knowning column name
@@ -390,7 +508,7 @@ func (throttler *Throttler) readSelfMySQLThrottleMetric() *mysql.MySQLThrottleMe
 	case mysql.MetricsQueryTypeShowGlobal:
 		metric.Value, metric.Err = strconv.ParseFloat(row["Value"].ToString(), 64)
 	default:
-		metric.Err = fmt.Errorf("Unsupported metrics query type for query %s", throttler.metricsQuery)
+		metric.Err = fmt.Errorf("Unsupported metrics query type for query %s", metricsQuery)
 	}
 
 	return metric
@@ -490,9 +608,16 @@ func (throttler *Throttler) Operate(ctx context.Context) {
 				// incoming MySQL metric, frequent, as result of collectMySQLMetrics()
 				throttler.mysqlInventory.InstanceKeyMetrics[metric.GetClusterInstanceKey()] = metric
 			}
+		case throttlerConfig := <-throttler.throttlerConfigChan:
+			{
+				// sparse, the metrics changed
+				throttler.applyThrottlerConfig(throttlerConfig)
+				go mysqlRefreshTicker.TickNow()
+			}
 		case <-mysqlRefreshTicker.C:
 			{
 				// sparse
+				throttler.pruneMySQLInventory()
 				if atomic.LoadInt64(&throttler.isOpen) > 0 {
 					go throttler.refreshMySQLInventory(ctx)
 				}
@@ -538,9 +663,11 @@ func (throttler *Throttler) collectMySQLMetrics(ctx context.Context) error {
 
 					// Apply an override to metrics read, if this is the special "self" cluster
 					// (where we incidentally know there's a single probe)
-					overrideGetMySQLThrottleMetricFunc := throttler.readSelfMySQLThrottleMetric
-					if clusterName != selfStoreName {
-						overrideGetMySQLThrottleMetricFunc = nil
+					var overrideGetMySQLThrottleMetricFunc func() *mysql.MySQLThrottleMetric
+					if isSelfStore(clusterName) {
+						overrideGetMySQLThrottleMetricFunc = func() *mysql.MySQLThrottleMetric {
+							return throttler.readSelfMySQLThrottleMetric(clusterName, probe.MetricQuery)
+						}
 					}
 					throttleMetrics := mysql.ReadThrottleMetric(probe, clusterName, overrideGetMySQLThrottleMetricFunc)
 					throttler.mysqlThrottleMetricChan <- throttleMetrics
@@ -589,7 +716,7 @@ func (throttler *Throttler) refreshMySQLInventory(ctx context.Context) error {
 				InstanceProbes:   mysql.NewProbes(),
 			}
 
-			if clusterName == selfStoreName {
+			if isSelfStore(clusterName) {
 				// special case: just looking at this tablet's MySQL server
 				// We will probe this "cluster" (of one server) is a special way.
 				addInstanceKey(mysql.SelfInstanceKey, clusterName, clusterSettings, clusterProbes.InstanceProbes)
@@ -627,6 +754,27 @@ func (throttler *Throttler) refreshMySQLInventory(ctx context.Context) error {
 	return nil
 }
 
+// pruneMySQLInventory synchronously removes the clusters of the metrics
+// which were removed from the ThrottlerConfig.
+func (throttler *Throttler) pruneMySQLInventory() {
+	clusters := config.Settings().Stores.MySQL.Clusters
+	for clusterName := range throttler.mysqlInventory.ClustersProbes {
+		if _, ok := clusters[clusterName]; ok {
+			continue
+		}
+		delete(throttler.mysqlInventory.ClustersProbes, clusterName)
+		delete(throttler.mysqlInventory.IgnoreHostsCount, clusterName)
+		delete(throttler.mysqlInventory.IgnoreHostsThreshold, clusterName)
+		for key := range throttler.mysqlInventory.InstanceKeyMetrics {
+			if key.ClusterName == clusterName {
+				delete(throttler.mysqlInventory.InstanceKeyMetrics, key)
+			}
+		}
+		throttler.mysqlClusterThresholds.Delete(clusterName)
+		throttler.aggregatedMetrics.Delete(fmt.Sprintf("mysql/%s", clusterName))
+	}
+}
+
 // synchronous update of inventory
 func (throttler *Throttler) updateMySQLClusterProbes(ctx context.Context, clusterProbes *mysql.ClusterProbes) error {
 	throttler.mysqlInventory.ClustersProbes[clusterProbes.ClusterName] = clusterProbes.InstanceProbes
@@ -752,6 +900,18 @@ func (throttler *Throttler) IsAppThrottled(appName string) bool {
 	return false
 }
 
+// markAppPriorityThrottled takes note that an app of the given priority was
+// throttled on a metric, so that the apps of lower priorities are denied.
+func (throttler *Throttler) markAppPriorityThrottled(metricName string, priority int32) {
+	throttler.throttledAppPrioritiesMutex.Lock()
+	defer throttler.throttledAppPrioritiesMutex.Unlock()
+
+	if throttledPriority, exists := throttler.throttledAppPriorities.Get(metricName); exists && throttledPriority.(int32) > priority {
+		return
+	}
+	throttler.throttledAppPriorities.SetDefault(metricName, priority)
+}
+
 // ThrottledAppsMap returns a (copy) map of currently throttled apps
 func (throttler *Throttler) ThrottledAppsMap() (result map[string](*base.AppThrottle)) {
 	result = make(map[string](*base.AppThrottle))
@@ -813,12 +973,59 @@ func (throttler *Throttler) AppRequestMetricResult(ctx context.Context, appName
 	return metricResultFunc()
 }
 
-// checkStore checks the aggregated value of given MySQL store
+// appPolicy returns the policy of an app, or of the first part of a
+// "app1:app2" app name which has a policy. It returns nil if there is none.
+func (throttler *Throttler) appPolicy(appName string) *topodatapb.ThrottlerConfig_AppPolicy {
+	policies := throttler.getThrottlerConfig().GetAppPolicies()
+	if policy, ok := policies[appName]; ok {
+		return policy
+	}
+	for _, singleAppName := range strings.Split(appName, ":") {
+		if policy, ok := policies[singleAppName]; ok {
+			return policy
+		}
+	}
+	return nil
+}
+
+// appMetricNames returns the metrics to check for an app: the replication
+// lag first, then the other metrics of the ThrottlerConfig by name.
+func (throttler *Throttler) appMetricNames(policy *topodatapb.ThrottlerConfig_AppPolicy) []string {
+	if len(policy.GetMetrics()) > 0 {
+		return policy.GetMetrics()
+	}
+	metricNames := []string{mysql.LagMetricName}
+	for metricName := range throttler.getThrottlerConfig().GetMetrics() {
+		if metricName != mysql.LagMetricName {
+			metricNames = append(metricNames, metricName)
+		}
+	}
+	sort.Strings(metricNames[1:])
+	return metricNames
+}
+
+// checkStore checks the aggregated values of the metrics of given MySQL
+// store for the app. It returns the result of the first metric which
+// throttles the app, if any.
 func (throttler *Throttler) checkStore(ctx context.Context, appName string, storeName string, remoteAddr string, flags *CheckFlags) (checkResult *CheckResult) {
 	if !throttler.env.Config().EnableLagThrottler {
 		return okMetricCheckResult
 	}
-	return throttler.check.Check(ctx, appName, "mysql", storeName, remoteAddr, flags)
+	policy := throttler.appPolicy(appName)
+	for _, metricName := range throttler.appMetricNames(policy) {
+		metricFlags := *flags
+		metricFlags.Priority = policy.GetPriority()
+		if metricFlags.OverrideThreshold == 0 {
+			metricFlags.OverrideThreshold = policy.GetThresholds()[metricName]
+		}
+		result := *throttler.check.Check(ctx, appName, "mysql", metricStoreName(storeName, metricName), remoteAddr, &metricFlags)
+		result.MetricName = metricName
+		checkResult = &result
+		if checkResult.StatusCode != http.StatusOK {
+			break
+		}
+	}
+	return checkResult
 }
 
 // checkShard checks the health of the shard, and runs on the primary tablet only
@@ -858,5 +1065,6 @@ func (throttler *Throttler) Status() *ThrottlerStatus {
 
 		AggregatedMetrics: throttler.aggregatedMetricsSnapshot(),
 		MetricsHealth:     throttler.metricsHealthSnapshot(),
+		Config:            throttler.getThrottlerConfig(),
 	}
 }
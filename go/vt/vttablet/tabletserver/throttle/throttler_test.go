@@ -0,0 +1,138 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package throttle
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/patrickmn/go-cache"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/throttle/base"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// newTestThrottler returns a Throttler whose clusters have the given
+// metrics, under the given thresholds.
+func newTestThrottler(t *testing.T, metrics map[string]float64, thresholds map[string]float64) *Throttler {
+	config := tabletenv.NewDefaultConfig()
+	config.EnableLagThrottler = true
+	throttler := NewThrottler(tabletenv.NewEnv(config, t.Name()), nil, "", nil)
+	for clusterName, value := range metrics {
+		throttler.aggregatedMetrics.Set("mysql/"+clusterName, base.NewSimpleMetricResult(value), cache.DefaultExpiration)
+	}
+	for clusterName, threshold := range thresholds {
+		throttler.mysqlClusterThresholds.Set(clusterName, threshold, cache.DefaultExpiration)
+	}
+	return throttler
+}
+
+func TestCheckStoreMetrics(t *testing.T) {
+	ctx := context.Background()
+	throttler := newTestThrottler(t,
+		map[string]float64{"self": 1, "self-threads_running": 80},
+		map[string]float64{"self": 2, "self-threads_running": 50},
+	)
+
+	// Without config, only the replication lag is checked.
+	result := throttler.checkStore(ctx, "vreplication", selfStoreName, "", &CheckFlags{ReadCheck: true})
+	assert.Equal(t, http.StatusOK, result.StatusCode)
+	assert.Equal(t, "lag", result.MetricName)
+
+	throttler.throttlerConfig = &topodatapb.ThrottlerConfig{
+		Metrics: map[string]*topodatapb.ThrottlerConfig_Metric{
+			"threads_running": {Threshold: 50},
+		},
+		AppPolicies: map[string]*topodatapb.ThrottlerConfig_AppPolicy{
+			"online-ddl": {Metrics: []string{"lag"}},
+			"vstreamer":  {Thresholds: map[string]float64{"threads_running": 100}},
+		},
+	}
+
+	// All the metrics are checked by default.
+	result = throttler.checkStore(ctx, "vreplication", selfStoreName, "", &CheckFlags{ReadCheck: true})
+	assert.Equal(t, http.StatusTooManyRequests, result.StatusCode)
+	assert.Equal(t, "threads_running", result.MetricName)
+	assert.Equal(t, float64(80), result.Value)
+	assert.Equal(t, float64(50), result.Threshold)
+
+	// An app policy can restrict the metrics, also for "app1:app2" names.
+	result = throttler.checkStore(ctx, "online-ddl:1234", selfStoreName, "", &CheckFlags{ReadCheck: true})
+	assert.Equal(t, http.StatusOK, result.StatusCode)
+	assert.Equal(t, "lag", result.MetricName)
+
+	// An app policy can override the thresholds, and the flags override it.
+	result = throttler.checkStore(ctx, "vstreamer", selfStoreName, "", &CheckFlags{ReadCheck: true})
+	assert.Equal(t, http.StatusOK, result.StatusCode)
+	result = throttler.checkStore(ctx, "vstreamer", selfStoreName, "", &CheckFlags{ReadCheck: true, OverrideThreshold: 0.5})
+	assert.Equal(t, http.StatusTooManyRequests, result.StatusCode)
+	assert.Equal(t, "lag", result.MetricName)
+}
+
+func TestCheckStorePriorities(t *testing.T) {
+	ctx := context.Background()
+	throttler := newTestThrottler(t,
+		map[string]float64{"self": 3},
+		map[string]float64{"self": 2},
+	)
+	throttler.throttlerConfig = &topodatapb.ThrottlerConfig{
+		AppPolicies: map[string]*topodatapb.ThrottlerConfig_AppPolicy{
+			"critical":   {Thresholds: map[string]float64{"lag": 5}, Priority: 1},
+			"online-ddl": {Thresholds: map[string]float64{"lag": 5}, Priority: -2},
+		},
+	}
+
+	// Nothing was throttled yet.
+	result := throttler.checkStore(ctx, "critical", selfStoreName, "", &CheckFlags{})
+	assert.Equal(t, http.StatusOK, result.StatusCode)
+	result = throttler.checkStore(ctx, "online-ddl", selfStoreName, "", &CheckFlags{})
+	assert.Equal(t, http.StatusOK, result.StatusCode)
+
+	// A low priority app is throttled: the apps of lower priorities are
+	// denied, the others are not.
+	result = throttler.checkStore(ctx, "vreplication", selfStoreName, "", &CheckFlags{LowPriority: true})
+	require.Equal(t, http.StatusTooManyRequests, result.StatusCode)
+	assert.Eventually(t, func() bool {
+		result = throttler.checkStore(ctx, "online-ddl", selfStoreName, "", &CheckFlags{})
+		return result.StatusCode == http.StatusExpectationFailed
+	}, 5*time.Second, 10*time.Millisecond)
+	result = throttler.checkStore(ctx, "critical", selfStoreName, "", &CheckFlags{})
+	assert.Equal(t, http.StatusOK, result.StatusCode)
+
+	// A normal app is throttled: the low priority requests are denied.
+	result = throttler.checkStore(ctx, "vreplication", selfStoreName, "", &CheckFlags{})
+	require.Equal(t, http.StatusTooManyRequests, result.StatusCode)
+	assert.Eventually(t, func() bool {
+		result = throttler.checkStore(ctx, "vreplication", selfStoreName, "", &CheckFlags{LowPriority: true})
+		return result.StatusCode == http.StatusExpectationFailed
+	}, 5*time.Second, 10*time.Millisecond)
+	result = throttler.checkStore(ctx, "critical", selfStoreName, "", &CheckFlags{})
+	assert.Equal(t, http.StatusOK, result.StatusCode)
+
+	// Read checks do not deny the other apps.
+	result = throttler.checkStore(ctx, "critical", selfStoreName, "", &CheckFlags{ReadCheck: true, OverrideThreshold: 1})
+	require.Equal(t, http.StatusTooManyRequests, result.StatusCode)
+	time.Sleep(50 * time.Millisecond)
+	priority, _ := throttler.throttledAppPriorities.Get("mysql/self")
+	assert.EqualValues(t, 0, priority)
+}
@@ -0,0 +1,250 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package throttlerclient checks the tablet throttler of a tablet through
+// the CheckThrottler RPC. It caches the results of the checks, and refreshes
+// them in batches in the background, so that apps may check the throttler
+// for every request.
+package throttlerclient
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net/http"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+const (
+	// DefaultRefreshInterval is the default interval between two refreshes
+	// of the results, which is the interval of the throttler checks of the
+	// in-process throttle.Client.
+	DefaultRefreshInterval = 250 * time.Millisecond
+
+	// refreshJitter is the fraction of the refresh interval by which each
+	// refresh is randomly advanced or delayed, so that the clients of a
+	// tablet do not all call it at once.
+	refreshJitter = 0.2
+
+	// checkTimeout is the timeout of the CheckThrottler RPCs of the
+	// background refreshes.
+	checkTimeout = 5 * time.Second
+
+	// defaultIdleTimeout is how long the checks which are not used any more
+	// keep being refreshed.
+	defaultIdleTimeout = time.Minute
+)
+
+var errUnexpectedResults = errors.New("the tablet did not return one result per check")
+
+// Client checks the tablet throttler of a tablet. It is safe for concurrent
+// use.
+type Client struct {
+	tmc             tmclient.TabletManagerClient
+	tablet          *topodatapb.Tablet
+	refreshInterval time.Duration
+	idleTimeout     time.Duration
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	mu     sync.Mutex
+	checks map[checkKey]*cachedCheck
+}
+
+// checkKey identifies the checks with the same parameters.
+type checkKey struct {
+	appName           string
+	self              bool
+	lowPriority       bool
+	overrideThreshold float64
+	okIfNotExists     bool
+}
+
+// cachedCheck is a check and its last result.
+type cachedCheck struct {
+	check *tabletmanagerdatapb.CheckThrottlerRequest_Check
+	// result is nil until the first check.
+	result     *tabletmanagerdatapb.CheckThrottlerResponse_Result
+	resultTime time.Time
+	lastUsed   time.Time
+}
+
+// NewClient returns a Client which checks the throttler of the tablet, and
+// refreshes the results every refreshInterval, with some jitter. Close
+// stops the refreshes.
+func NewClient(tmc tmclient.TabletManagerClient, tablet *topodatapb.Tablet, refreshInterval time.Duration) *Client {
+	if refreshInterval <= 0 {
+		refreshInterval = DefaultRefreshInterval
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	c := &Client{
+		tmc:             tmc,
+		tablet:          tablet,
+		refreshInterval: refreshInterval,
+		idleTimeout:     defaultIdleTimeout,
+		cancel:          cancel,
+		checks:          make(map[checkKey]*cachedCheck),
+	}
+	c.wg.Add(1)
+	go c.refreshLoop(ctx)
+	return c
+}
+
+// Close stops the background refreshes. The Client must not be used
+// afterwards.
+func (c *Client) Close() {
+	c.cancel()
+	c.wg.Wait()
+}
+
+// Check returns the result of a check of the throttler. The result is the
+// cached one if it is recent enough, otherwise the tablet is called. The
+// errors of the RPC are returned as results with a 500 status code.
+func (c *Client) Check(ctx context.Context, check *tabletmanagerdatapb.CheckThrottlerRequest_Check) *tabletmanagerdatapb.CheckThrottlerResponse_Result {
+	key := checkKey{
+		appName:           check.AppName,
+		self:              check.Self,
+		lowPriority:       check.LowPriority,
+		overrideThreshold: check.OverrideThreshold,
+		okIfNotExists:     check.OkIfNotExists,
+	}
+	now := time.Now()
+
+	c.mu.Lock()
+	cached, ok := c.checks[key]
+	if !ok {
+		cached = &cachedCheck{check: check}
+		c.checks[key] = cached
+	}
+	cached.lastUsed = now
+	if cached.result != nil && now.Sub(cached.resultTime) < c.maxResultAge() {
+		result := cached.result
+		c.mu.Unlock()
+		return result
+	}
+	c.mu.Unlock()
+
+	results, _ := c.checkThrottler(ctx, []*tabletmanagerdatapb.CheckThrottlerRequest_Check{check})
+	result := results[0]
+	c.mu.Lock()
+	c.setResultLocked(cached, result, now)
+	c.mu.Unlock()
+	return result
+}
+
+// CheckOK returns true if the app may proceed.
+func (c *Client) CheckOK(ctx context.Context, check *tabletmanagerdatapb.CheckThrottlerRequest_Check) bool {
+	return c.Check(ctx, check).StatusCode == http.StatusOK
+}
+
+// maxResultAge is the age after which a result is not used any more: the
+// background refreshes should have replaced it by then.
+func (c *Client) maxResultAge() time.Duration {
+	return 2 * c.refreshInterval
+}
+
+// setResultLocked records a result, unless a more recent one was recorded
+// in the meantime.
+func (c *Client) setResultLocked(cached *cachedCheck, result *tabletmanagerdatapb.CheckThrottlerResponse_Result, resultTime time.Time) {
+	if cached.result != nil && cached.resultTime.After(resultTime) {
+		return
+	}
+	cached.result = result
+	cached.resultTime = resultTime
+}
+
+// checkThrottler calls the tablet, and returns one result per check even if
+// the RPC fails.
+func (c *Client) checkThrottler(ctx context.Context, checks []*tabletmanagerdatapb.CheckThrottlerRequest_Check) ([]*tabletmanagerdatapb.CheckThrottlerResponse_Result, error) {
+	results, err := c.tmc.CheckThrottler(ctx, c.tablet, checks)
+	if err == nil && len(results) != len(checks) {
+		err = errUnexpectedResults
+	}
+	if err != nil {
+		results = make([]*tabletmanagerdatapb.CheckThrottlerResponse_Result, len(checks))
+		for i := range results {
+			results[i] = &tabletmanagerdatapb.CheckThrottlerResponse_Result{
+				StatusCode: http.StatusInternalServerError,
+				Error:      err.Error(),
+				Message:    err.Error(),
+			}
+		}
+	}
+	return results, err
+}
+
+func (c *Client) refreshLoop(ctx context.Context) {
+	defer c.wg.Done()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(c.jitteredRefreshInterval()):
+		}
+		c.refresh(ctx)
+	}
+}
+
+// jitteredRefreshInterval returns the refresh interval, randomly advanced or
+// delayed by up to refreshJitter.
+func (c *Client) jitteredRefreshInterval() time.Duration {
+	return time.Duration(float64(c.refreshInterval) * (1 + refreshJitter*(2*rand.Float64()-1)))
+}
+
+// refresh checks all the checks used recently in one RPC, and forgets about
+// the other ones.
+func (c *Client) refresh(ctx context.Context) {
+	now := time.Now()
+	var keys []checkKey
+	var checks []*tabletmanagerdatapb.CheckThrottlerRequest_Check
+	c.mu.Lock()
+	for key, cached := range c.checks {
+		if now.Sub(cached.lastUsed) > c.idleTimeout {
+			delete(c.checks, key)
+			continue
+		}
+		keys = append(keys, key)
+		checks = append(checks, cached.check)
+	}
+	c.mu.Unlock()
+	if len(checks) == 0 {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, checkTimeout)
+	defer cancel()
+	results, err := c.checkThrottler(ctx, checks)
+	if err != nil {
+		log.Warningf("Cannot check the throttler of tablet %v: %v", topoproto.TabletAliasString(c.tablet.Alias), err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for i, key := range keys {
+		if cached, ok := c.checks[key]; ok {
+			c.setResultLocked(cached, results[i], now)
+		}
+	}
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package throttlerclient
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// fakeTMC returns the status codes of the apps, and records the batches of
+// checks.
+type fakeTMC struct {
+	tmclient.TabletManagerClient
+
+	mu          sync.Mutex
+	statusCodes map[string]int32
+	err         error
+	batches     [][]string
+}
+
+func (tmc *fakeTMC) CheckThrottler(ctx context.Context, tablet *topodatapb.Tablet, checks []*tabletmanagerdatapb.CheckThrottlerRequest_Check) ([]*tabletmanagerdatapb.CheckThrottlerResponse_Result, error) {
+	tmc.mu.Lock()
+	defer tmc.mu.Unlock()
+	if tmc.err != nil {
+		return nil, tmc.err
+	}
+	var appNames []string
+	var results []*tabletmanagerdatapb.CheckThrottlerResponse_Result
+	for _, check := range checks {
+		appNames = append(appNames, check.AppName)
+		results = append(results, &tabletmanagerdatapb.CheckThrottlerResponse_Result{StatusCode: tmc.statusCodes[check.AppName]})
+	}
+	tmc.batches = append(tmc.batches, appNames)
+	return results, nil
+}
+
+func (tmc *fakeTMC) set(appName string, statusCode int32) {
+	tmc.mu.Lock()
+	defer tmc.mu.Unlock()
+	tmc.statusCodes[appName] = statusCode
+}
+
+func (tmc *fakeTMC) lastBatch() ([]string, int) {
+	tmc.mu.Lock()
+	defer tmc.mu.Unlock()
+	if len(tmc.batches) == 0 {
+		return nil, 0
+	}
+	return tmc.batches[len(tmc.batches)-1], len(tmc.batches)
+}
+
+func TestClientCheck(t *testing.T) {
+	ctx := context.Background()
+	tmc := &fakeTMC{statusCodes: map[string]int32{"app1": http.StatusOK, "app2": http.StatusTooManyRequests}}
+	// Refreshes are too far apart to happen during the test.
+	c := NewClient(tmc, &topodatapb.Tablet{}, time.Hour)
+	defer c.Close()
+
+	app1 := &tabletmanagerdatapb.CheckThrottlerRequest_Check{AppName: "app1"}
+	assert.True(t, c.CheckOK(ctx, app1))
+	assert.False(t, c.CheckOK(ctx, &tabletmanagerdatapb.CheckThrottlerRequest_Check{AppName: "app2"}))
+	_, calls := tmc.lastBatch()
+	assert.Equal(t, 2, calls)
+
+	// The results are cached.
+	tmc.set("app1", http.StatusTooManyRequests)
+	assert.True(t, c.CheckOK(ctx, &tabletmanagerdatapb.CheckThrottlerRequest_Check{AppName: "app1"}))
+	_, calls = tmc.lastBatch()
+	assert.Equal(t, 2, calls)
+
+	// The errors of the RPC are results.
+	tmc.err = assert.AnError
+	result := c.Check(ctx, &tabletmanagerdatapb.CheckThrottlerRequest_Check{AppName: "app1", Self: true})
+	assert.EqualValues(t, http.StatusInternalServerError, result.StatusCode)
+	assert.Equal(t, assert.AnError.Error(), result.Error)
+}
+
+func TestClientRefresh(t *testing.T) {
+	ctx := context.Background()
+	tmc := &fakeTMC{statusCodes: map[string]int32{"app1": http.StatusOK, "app2": http.StatusOK}}
+	c := NewClient(tmc, &topodatapb.Tablet{}, 10*time.Millisecond)
+	defer c.Close()
+
+	assert.True(t, c.CheckOK(ctx, &tabletmanagerdatapb.CheckThrottlerRequest_Check{AppName: "app1"}))
+	assert.True(t, c.CheckOK(ctx, &tabletmanagerdatapb.CheckThrottlerRequest_Check{AppName: "app2"}))
+
+	// The checks are refreshed in one batch.
+	assert.Eventually(t, func() bool {
+		batch, _ := tmc.lastBatch()
+		return len(batch) == 2
+	}, 5*time.Second, time.Millisecond)
+	tmc.set("app1", http.StatusTooManyRequests)
+	assert.Eventually(t, func() bool {
+		return !c.CheckOK(ctx, &tabletmanagerdatapb.CheckThrottlerRequest_Check{AppName: "app1"})
+	}, 5*time.Second, time.Millisecond)
+
+	// The checks which are not used any more are not refreshed.
+	c.mu.Lock()
+	c.idleTimeout = 50 * time.Millisecond
+	c.mu.Unlock()
+	assert.Eventually(t, func() bool {
+		c.CheckOK(ctx, &tabletmanagerdatapb.CheckThrottlerRequest_Check{AppName: "app1"})
+		batch, _ := tmc.lastBatch()
+		return len(batch) == 1 && batch[0] == "app1"
+	}, 5*time.Second, time.Millisecond)
+}
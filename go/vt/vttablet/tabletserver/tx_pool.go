@@ -17,10 +17,14 @@ limitations under the License.
 package tabletserver
 
 import (
+	"fmt"
+	"math"
 	"sync"
 	"time"
 
 	"vitess.io/vitess/go/pools"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/stats"
 
 	"vitess.io/vitess/go/vt/servenv"
 
@@ -35,6 +39,7 @@ import (
 	"vitess.io/vitess/go/vt/dbconfigs"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/txlimiter"
 
@@ -44,6 +49,10 @@ import (
 
 const txLogInterval = 1 * time.Minute
 
+// defaultWorkload labels the transactions of the callers which are not part
+// of a workload.
+const defaultWorkload = "default"
+
 var txIsolations = map[querypb.ExecuteOptions_TransactionIsolation]queries{
 	querypb.ExecuteOptions_DEFAULT:                       {setIsolationLevel: "", openTransaction: "begin"},
 	querypb.ExecuteOptions_REPEATABLE_READ:               {setIsolationLevel: "REPEATABLE READ", openTransaction: "begin"},
@@ -67,6 +76,22 @@ type (
 		logMu   sync.Mutex
 		lastLog time.Time
 		txStats *servenv.TimingsWrapper
+
+		// txWarning and the policies are immutable.
+		txWarning           time.Duration
+		policiesByUsername  map[string]*txDurationPolicy
+		policiesByPrincipal map[string]*txDurationPolicy
+
+		timeoutKills    *stats.CountersWithMultiLabels
+		timeoutWarnings *stats.CountersWithSingleLabel
+	}
+	// txDurationPolicy contains the age limits of the transactions of a
+	// workload of tabletenv.TabletConfig.WorkloadPools. The limits of the
+	// TxPool apply instead of the ones which are 0.
+	txDurationPolicy struct {
+		workload string
+		timeout  time.Duration
+		warning  time.Duration
 	}
 	queries struct {
 		setIsolationLevel string
@@ -79,13 +104,31 @@ func NewTxPool(env tabletenv.Env, limiter txlimiter.TxLimiter) *TxPool {
 	config := env.Config()
 	transactionTimeout := config.Oltp.TxTimeoutSeconds.Get()
 	axp := &TxPool{
-		env:                env,
-		scp:                NewStatefulConnPool(env),
-		transactionTimeout: sync2.NewAtomicDuration(transactionTimeout),
-		ticks:              timer.NewTimer(transactionTimeout / 10),
-		limiter:            limiter,
-		txStats:            env.Exporter().NewTimings("Transactions", "Transaction stats", "operation"),
+		env:                 env,
+		scp:                 NewStatefulConnPool(env),
+		transactionTimeout:  sync2.NewAtomicDuration(transactionTimeout),
+		limiter:             limiter,
+		txStats:             env.Exporter().NewTimings("Transactions", "Transaction stats", "operation"),
+		txWarning:           config.Oltp.TxWarningSeconds.Get(),
+		policiesByUsername:  make(map[string]*txDurationPolicy),
+		policiesByPrincipal: make(map[string]*txDurationPolicy),
+		timeoutKills:        env.Exporter().NewCountersWithMultiLabels("TransactionTimeoutKills", "Number of transactions killed for exceeding their timeout", []string{"Workload", "User"}),
+		timeoutWarnings:     env.Exporter().NewCountersWithSingleLabel("TransactionTimeoutWarnings", "Number of queries which returned a warning that their transaction is about to exceed its timeout", "Workload"),
+	}
+	for _, workload := range config.WorkloadPools {
+		policy := &txDurationPolicy{
+			workload: workload.Name,
+			timeout:  workload.TxTimeoutSeconds.Get(),
+			warning:  workload.TxWarningSeconds.Get(),
+		}
+		for _, username := range workload.Usernames {
+			axp.policiesByUsername[username] = policy
+		}
+		for _, principal := range workload.Principals {
+			axp.policiesByPrincipal[principal] = policy
+		}
 	}
+	axp.ticks = timer.NewTimer(axp.killerInterval())
 	// Careful: conns also exports name+"xxx" vars,
 	// but we know it doesn't export Timeout.
 	env.Exporter().NewGaugeDurationFunc("TransactionTimeout", "Transaction timeout", axp.transactionTimeout.Get)
@@ -122,8 +165,19 @@ func (tp *TxPool) Shutdown(ctx context.Context) {
 
 func (tp *TxPool) transactionKiller() {
 	defer tp.env.LogError()
-	for _, conn := range tp.scp.GetOutdated(tp.Timeout(), "for tx killer rollback") {
-		log.Warningf("killing transaction (exceeded timeout: %v): %s", tp.Timeout(), conn.String())
+	outdated := tp.scp.GetOutdatedFunc(func(conn *StatefulConnection) time.Duration {
+		return tp.txDurationPolicy(conn.TxProperties()).timeout
+	}, "for tx killer rollback")
+	for _, conn := range outdated {
+		policy := tp.txDurationPolicy(conn.TxProperties())
+		if props := conn.TxProperties(); props != nil {
+			username := callerid.GetUsername(props.ImmediateCaller)
+			log.Warningf("killing transaction (exceeded timeout: %v): id=%v workload=%v age=%v username=%q principal=%q queries=%d",
+				policy.timeout, conn.ConnID, policy.workload, time.Since(props.StartTime), username, callerid.GetPrincipal(props.EffectiveCaller), len(props.Queries))
+			tp.timeoutKills.Add([]string{policy.workload, username}, 1)
+		} else {
+			log.Warningf("killing transaction (exceeded timeout: %v): %s", policy.timeout, conn.String())
+		}
 		switch {
 		case conn.IsTainted():
 			conn.Close()
@@ -142,7 +196,71 @@ func (tp *TxPool) transactionKiller() {
 		if conn.IsInTransaction() {
 			tp.txComplete(conn, tx.TxKill)
 		}
-		conn.Releasef("exceeded timeout: %v", tp.Timeout())
+		conn.Releasef("exceeded timeout: %v", policy.timeout)
+	}
+}
+
+// txDurationPolicy returns the age limits of a transaction, from the
+// workload of its callers. The connections which are not in a transaction
+// have the limits of the TxPool.
+func (tp *TxPool) txDurationPolicy(props *tx.Properties) txDurationPolicy {
+	policy := txDurationPolicy{
+		workload: defaultWorkload,
+		timeout:  tp.Timeout(),
+		warning:  tp.txWarning,
+	}
+	if props == nil {
+		return policy
+	}
+	workload := tp.policiesByUsername[callerid.GetUsername(props.ImmediateCaller)]
+	if workload == nil {
+		workload = tp.policiesByPrincipal[callerid.GetPrincipal(props.EffectiveCaller)]
+	}
+	if workload == nil {
+		return policy
+	}
+	policy.workload = workload.workload
+	if workload.timeout != 0 {
+		policy.timeout = workload.timeout
+	}
+	if workload.warning != 0 {
+		policy.warning = workload.warning
+	}
+	return policy
+}
+
+// killerInterval returns the interval of the transaction killer, a tenth
+// of the shortest transaction timeout.
+func (tp *TxPool) killerInterval() time.Duration {
+	timeout := tp.Timeout()
+	for _, policy := range tp.policiesByUsername {
+		if policy.timeout != 0 && policy.timeout < timeout {
+			timeout = policy.timeout
+		}
+	}
+	for _, policy := range tp.policiesByPrincipal {
+		if policy.timeout != 0 && policy.timeout < timeout {
+			timeout = policy.timeout
+		}
+	}
+	return timeout / 10
+}
+
+// TimeoutWarning returns the warning to return with the results of the
+// queries of a transaction older than its warning threshold, or nil.
+func (tp *TxPool) TimeoutWarning(conn *StatefulConnection) *querypb.QueryWarning {
+	props := conn.TxProperties()
+	if props == nil {
+		return nil
+	}
+	policy := tp.txDurationPolicy(props)
+	age := time.Since(props.StartTime)
+	if policy.warning == 0 || age < policy.warning {
+		return nil
+	}
+	tp.timeoutWarnings.Add(policy.workload, 1)
+	return &querypb.QueryWarning{
+		Message: fmt.Sprintf("transaction has been open for %v, it will be rolled back once open for %v", age.Round(time.Millisecond), policy.timeout),
 	}
 }
 
@@ -293,28 +411,38 @@ func (tp *TxPool) createConn(ctx context.Context, options *querypb.ExecuteOption
 func createTransaction(ctx context.Context, options *querypb.ExecuteOptions, conn *StatefulConnection, readOnly bool, preQueries []string) (string, bool, error) {
 	beginQueries := ""
 
+	isolation := options.GetTransactionIsolation()
+	if gtidSet := options.GetSnapshotGtidSet(); gtidSet != "" {
+		waitQuery, err := waitForGTIDSet(ctx, conn, gtidSet)
+		if err != nil {
+			return "", false, err
+		}
+		beginQueries = waitQuery + "; "
+		isolation = querypb.ExecuteOptions_CONSISTENT_SNAPSHOT_READ_ONLY
+	}
+
 	autocommitTransaction := false
-	if queries, ok := txIsolations[options.GetTransactionIsolation()]; ok {
+	if queries, ok := txIsolations[isolation]; ok {
 		if queries.setIsolationLevel != "" {
 			txQuery := "set transaction isolation level " + queries.setIsolationLevel
 			if err := conn.execWithRetry(ctx, txQuery, 1, false); err != nil {
 				return "", false, err
 			}
-			beginQueries = queries.setIsolationLevel + "; "
+			beginQueries = beginQueries + queries.setIsolationLevel + "; "
 		}
 		beginSQL := queries.openTransaction
 		if readOnly &&
-			options.GetTransactionIsolation() != querypb.ExecuteOptions_CONSISTENT_SNAPSHOT_READ_ONLY {
+			isolation != querypb.ExecuteOptions_CONSISTENT_SNAPSHOT_READ_ONLY {
 			beginSQL = "start transaction read only"
 		}
 		if err := conn.execWithRetry(ctx, beginSQL, 1, false); err != nil {
 			return "", false, err
 		}
 		beginQueries = beginQueries + beginSQL
-	} else if options.GetTransactionIsolation() == querypb.ExecuteOptions_AUTOCOMMIT {
+	} else if isolation == querypb.ExecuteOptions_AUTOCOMMIT {
 		autocommitTransaction = true
 	} else {
-		return "", false, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "don't know how to open a transaction of this type: %v", options.GetTransactionIsolation())
+		return "", false, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "don't know how to open a transaction of this type: %v", isolation)
 	}
 
 	for _, preQuery := range preQueries {
@@ -325,6 +453,34 @@ func createTransaction(ctx context.Context, options *querypb.ExecuteOptions, con
 	return beginQueries, autocommitTransaction, nil
 }
 
+// waitForGTIDSet waits until MySQL has executed the GTID set, for at most
+// the time left until the deadline of the request, or else the query
+// timeout. Without either, MySQL waits as long as it takes. It returns the
+// query it ran.
+func waitForGTIDSet(ctx context.Context, conn *StatefulConnection, gtidSet string) (string, error) {
+	var seconds int64
+	if deadline, ok := ctx.Deadline(); ok {
+		seconds = int64(math.Ceil(time.Until(deadline).Seconds()))
+		if seconds < 1 {
+			seconds = 1
+		}
+	} else {
+		seconds = int64(math.Ceil(conn.env.Config().Oltp.QueryTimeoutSeconds.Get().Seconds()))
+	}
+	query := fmt.Sprintf("select wait_for_executed_gtid_set(%s, %d)", sqltypes.EncodeStringSQL(gtidSet), seconds)
+	qr, err := conn.Exec(ctx, query, 1, false)
+	if err != nil {
+		return "", err
+	}
+	if len(qr.Rows) != 1 || len(qr.Rows[0]) != 1 {
+		return "", vterrors.Errorf(vtrpcpb.Code_INTERNAL, "unexpected result for %s: %v", query, qr.Rows)
+	}
+	if timedOut, _ := evalengine.ToInt64(qr.Rows[0][0]); timedOut != 0 {
+		return "", vterrors.Errorf(vtrpcpb.Code_DEADLINE_EXCEEDED, "timed out after %ds waiting for the snapshot GTID set %s", seconds, gtidSet)
+	}
+	return query, nil
+}
+
 // LogActive causes all existing transactions to be logged when they complete.
 // The logging is throttled to no more than once every txLogInterval.
 func (tp *TxPool) LogActive() {
@@ -347,7 +503,7 @@ func (tp *TxPool) Timeout() time.Duration {
 // SetTimeout sets the transaction timeout.
 func (tp *TxPool) SetTimeout(timeout time.Duration) {
 	tp.transactionTimeout.Set(timeout)
-	tp.ticks.SetInterval(timeout / 10)
+	tp.ticks.SetInterval(tp.killerInterval())
 }
 
 func (tp *TxPool) txComplete(conn *StatefulConnection, reason tx.ReleaseReason) {
@@ -146,6 +146,27 @@ func TestTxPoolTransactionIsolation(t *testing.T) {
 	assert.Equal(t, "set transaction isolation level read committed;begin", db.QueryLog())
 }
 
+func TestTxPoolSnapshotGTIDSet(t *testing.T) {
+	db, txPool, _, closer := setup(t)
+	defer closer()
+	waitQuery := "select wait_for_executed_gtid_set('uuid:1-5', 30)"
+	db.AddQuery(waitQuery, sqltypes.MakeTestResult(sqltypes.MakeTestFields("wait", "int64"), "0"))
+
+	c1, beginSQL, err := txPool.Begin(ctx, &querypb.ExecuteOptions{SnapshotGtidSet: "uuid:1-5"}, false, 0, nil)
+	require.NoError(t, err)
+	c1.Release(tx.TxClose)
+	assert.Equal(t, waitQuery+"; REPEATABLE READ; start transaction with consistent snapshot, read only", beginSQL)
+	assert.Equal(t, waitQuery+";set transaction isolation level repeatable read;start transaction with consistent snapshot, read only", db.QueryLog())
+
+	// The replica did not catch up with the GTID set in time.
+	db.ResetQueryLog()
+	db.AddQuery(waitQuery, sqltypes.MakeTestResult(sqltypes.MakeTestFields("wait", "int64"), "1"))
+	_, _, err = txPool.Begin(ctx, &querypb.ExecuteOptions{SnapshotGtidSet: "uuid:1-5"}, false, 0, nil)
+	require.EqualError(t, err, "timed out after 30s waiting for the snapshot GTID set uuid:1-5")
+	assert.Equal(t, vtrpcpb.Code_DEADLINE_EXCEEDED, vterrors.Code(err))
+	assert.Equal(t, waitQuery, db.QueryLog())
+}
+
 func TestTxPoolAutocommit(t *testing.T) {
 	db, txPool, _, closer := setup(t)
 	defer closer()
@@ -427,6 +448,49 @@ func TestTxTimeoutKillsTransactions(t *testing.T) {
 		}, limiter.Actions())
 }
 
+func TestTxTimeoutWorkloadPolicies(t *testing.T) {
+	env := newEnv("TabletServerTest")
+	env.Config().WorkloadPools = []tabletenv.WorkloadPoolConfig{{
+		Name:             "batch",
+		Usernames:        []string{"batch_user"},
+		TxTimeoutSeconds: 0.2,
+		TxWarningSeconds: 0.1,
+	}}
+	_, txPool, _, closer := setupWithEnv(t, env)
+	defer closer()
+	txPool.timeoutKills.ResetAll()
+	txPool.timeoutWarnings.ResetAll()
+	require.Equal(t, 20*time.Millisecond, txPool.killerInterval())
+
+	batchCtx := callerid.NewContext(ctx, nil, &querypb.VTGateCallerID{Username: "batch_user"})
+	batchConn, _, err := txPool.Begin(batchCtx, &querypb.ExecuteOptions{}, false, 0, nil)
+	require.NoError(t, err)
+	otherCtx := callerid.NewContext(ctx, nil, &querypb.VTGateCallerID{Username: "user"})
+	otherConn, _, err := txPool.Begin(otherCtx, &querypb.ExecuteOptions{}, false, 0, nil)
+	require.NoError(t, err)
+	require.Nil(t, txPool.TimeoutWarning(batchConn))
+
+	time.Sleep(120 * time.Millisecond)
+	warning := txPool.TimeoutWarning(batchConn)
+	require.NotNil(t, warning)
+	require.Contains(t, warning.Message, "it will be rolled back once open for 200ms")
+	require.Nil(t, txPool.TimeoutWarning(otherConn))
+	require.Equal(t, map[string]int64{"batch": 1}, txPool.timeoutWarnings.Counts())
+	batchID := batchConn.ReservedID()
+	batchConn.Unlock()
+	otherConn.Unlock()
+
+	// Only the transaction of the workload is killed.
+	time.Sleep(200 * time.Millisecond)
+	_, err = txPool.GetAndLock(batchID, "for query")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "exceeded timeout: 200ms")
+	otherConn, err = txPool.GetAndLock(otherConn.ReservedID(), "for query")
+	require.NoError(t, err)
+	txPool.RollbackAndRelease(ctx, otherConn)
+	require.Equal(t, map[string]int64{"batch.batch_user": 1}, txPool.timeoutKills.Counts())
+}
+
 func newTxPool() (*TxPool, *fakeLimiter) {
 	return newTxPoolWithEnv(newEnv("TabletServerTest"))
 }
@@ -19,18 +19,22 @@ limitations under the License.
 package txserializer
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"sort"
 	"sync"
 	"time"
 
 	"context"
 
 	"vitess.io/vitess/go/acl"
+	"vitess.io/vitess/go/cache"
 	"vitess.io/vitess/go/stats"
 	"vitess.io/vitess/go/streamlog"
 	"vitess.io/vitess/go/sync2"
 	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/servenv"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
 
@@ -57,10 +61,7 @@ type TxSerializer struct {
 	*sync2.ConsolidatorCache
 
 	// Immutable fields.
-	dryRun                 bool
-	maxQueueSize           int
-	maxGlobalQueueSize     int
-	concurrentTransactions int
+	dryRun bool
 
 	// waits stores how many times a transaction was queued because another
 	// transaction was already in flight for the same row (range).
@@ -79,6 +80,8 @@ type TxSerializer struct {
 	// globalQueueExceeded is the same as queueExceeded but for the global queue.
 	waits, waitsDryRun, queueExceeded, queueExceededDryRun *stats.CountersWithSingleLabel
 	globalQueueExceeded, globalQueueExceededDryRun         *stats.Counter
+	// waitTime records per table how long transactions were queued.
+	waitTime *servenv.TimingsWrapper
 
 	log                          *logutil.ThrottledLogger
 	logDryRun                    *logutil.ThrottledLogger
@@ -89,12 +92,44 @@ type TxSerializer struct {
 	mu         sync.Mutex
 	queues     map[string]*queue
 	globalSize int
+	// The queue limits can be changed at runtime, see SetMaxQueueSize() and
+	// its siblings.
+	maxQueueSize           int
+	maxGlobalQueueSize     int
+	concurrentTransactions int
+	// recentHotKeys keeps the stats of the most recent row ranges which were
+	// hot, once their queue is gone. The values are *HotKey.
+	recentHotKeys *cache.LRUCache
+}
+
+// recentHotKeysCapacity is the number of hot row ranges kept in
+// TxSerializer.recentHotKeys.
+const recentHotKeysCapacity = 100
+
+// HotKey describes the queue of a row range which is or was recently hot.
+type HotKey struct {
+	Key   string
+	Table string
+	// QueueDepth is the number of transactions currently queued or in flight
+	// for the row range. It is 0 if the row range is not hot anymore.
+	QueueDepth int
+	// MaxQueueDepth is the maximum number of transactions which were
+	// simultaneously queued or in flight for the row range.
+	MaxQueueDepth int
+	// Transactions is the number of transactions which went through the queue.
+	Transactions int
+	// Waits is the number of transactions which had to wait for a slot.
+	Waits int
+	// WaitTime is the total time transactions waited for a slot.
+	WaitTime time.Duration
+	// LastHot is when the row range was last seen hot.
+	LastHot time.Time
 }
 
 // New returns a TxSerializer object.
 func New(env tabletenv.Env) *TxSerializer {
 	config := env.Config()
-	return &TxSerializer{
+	txs := &TxSerializer{
 		env:                    env,
 		ConsolidatorCache:      sync2.NewConsolidatorCache(1000),
 		dryRun:                 config.HotRowProtection.Mode == tabletenv.Dryrun,
@@ -123,14 +158,26 @@ func New(env tabletenv.Env) *TxSerializer {
 		globalQueueExceededDryRun: env.Exporter().NewCounter(
 			"TxSerializerGlobalQueueExceededDryRun",
 			"Dry-run stats for TxSerializerGlobalQueueExceeded"),
+		waitTime: env.Exporter().NewTimings(
+			"TxSerializerWaitTime",
+			"Time transactions were queued because another transaction was already in flight for the same row range",
+			"table_name"),
 		log:                          logutil.NewThrottledLogger("HotRowProtection", 5*time.Second),
 		logDryRun:                    logutil.NewThrottledLogger("HotRowProtection DryRun", 5*time.Second),
 		logWaitsDryRun:               logutil.NewThrottledLogger("HotRowProtection Waits DryRun", 5*time.Second),
 		logQueueExceededDryRun:       logutil.NewThrottledLogger("HotRowProtection QueueExceeded DryRun", 5*time.Second),
 		logGlobalQueueExceededDryRun: logutil.NewThrottledLogger("HotRowProtection GlobalQueueExceeded DryRun", 5*time.Second),
 		queues:                       make(map[string]*queue),
+		recentHotKeys: cache.NewLRUCache(recentHotKeysCapacity, func(_ interface{}) int64 {
+			return 1
+		}),
 	}
-
+	env.Exporter().NewGaugesFuncWithMultiLabels(
+		"TxSerializerQueueDepth",
+		"Number of transactions currently queued or in flight for the hot row ranges of a table",
+		[]string{"table_name"},
+		txs.queueDepths)
+	return txs
 }
 
 // DoneFunc is returned by Wait() and must be called by the caller.
@@ -146,7 +193,11 @@ func (txs *TxSerializer) Wait(ctx context.Context, key, table string) (done Done
 	txs.mu.Lock()
 	defer txs.mu.Unlock()
 
+	start := time.Now()
 	waited, err = txs.lockLocked(ctx, key, table)
+	if waited {
+		txs.recordWaitLocked(key, table, start)
+	}
 	if err != nil {
 		if waited {
 			// Waiting failed early e.g. due a canceled context and we did NOT get the
@@ -165,7 +216,7 @@ func (txs *TxSerializer) lockLocked(ctx context.Context, key, table string) (boo
 	q, ok := txs.queues[key]
 	if !ok {
 		// First transaction in the queue i.e. we don't wait and return immediately.
-		txs.queues[key] = newQueueForFirstTransaction(txs.concurrentTransactions)
+		txs.queues[key] = newQueueForFirstTransaction(table, txs.concurrentTransactions)
 		txs.globalSize++
 		return false, nil
 	}
@@ -243,6 +294,16 @@ func (txs *TxSerializer) lockLocked(ctx context.Context, key, table string) (boo
 	}
 }
 
+// recordWaitLocked records that a transaction waited for a slot since start.
+// The method has the suffix "Locked" to clarify that "txs.mu" must be locked.
+func (txs *TxSerializer) recordWaitLocked(key, table string, start time.Time) {
+	txs.waitTime.Record(table, start)
+	if q, ok := txs.queues[key]; ok {
+		q.waits++
+		q.waitTime += time.Since(start)
+	}
+}
+
 func (txs *TxSerializer) unlock(key string) {
 	txs.mu.Lock()
 	defer txs.mu.Unlock()
@@ -260,6 +321,7 @@ func (txs *TxSerializer) unlockLocked(key string, returnSlot bool) {
 		delete(txs.queues, key)
 
 		if q.max > 1 {
+			txs.recordHotKeyLocked(key, q)
 			if txs.dryRun {
 				txs.logDryRun.Infof("%v simultaneous transactions (%v in total) for the same row range (%v) would have been queued.", q.max, q.count, key)
 			} else {
@@ -304,6 +366,121 @@ func (txs *TxSerializer) Pending(key string) int {
 	return q.size
 }
 
+// recordHotKeyLocked adds the stats of the queue of a hot row range, which is
+// being removed, to recentHotKeys.
+// The method has the suffix "Locked" to clarify that "txs.mu" must be locked.
+func (txs *TxSerializer) recordHotKeyLocked(key string, q *queue) {
+	hk := &HotKey{Key: key, Table: q.table}
+	if v, ok := txs.recentHotKeys.Get(key); ok {
+		*hk = *v.(*HotKey)
+	}
+	q.addTo(hk)
+	hk.QueueDepth = 0
+	txs.recentHotKeys.Set(key, hk)
+}
+
+// HotKeys returns the row ranges which are currently hot, followed by the
+// ones which were recently hot.
+func (txs *TxSerializer) HotKeys() []HotKey {
+	txs.mu.Lock()
+	defer txs.mu.Unlock()
+
+	var current, recent []HotKey
+	seen := make(map[string]bool)
+	for key, q := range txs.queues {
+		if q.availableSlots == nil {
+			// Not hot.
+			continue
+		}
+		hk := HotKey{Key: key, Table: q.table}
+		if v, ok := txs.recentHotKeys.Get(key); ok {
+			hk = *v.(*HotKey)
+		}
+		q.addTo(&hk)
+		current = append(current, hk)
+		seen[key] = true
+	}
+	for _, item := range txs.recentHotKeys.Items() {
+		if !seen[item.Key] {
+			recent = append(recent, *item.Value.(*HotKey))
+		}
+	}
+	sort.Slice(current, func(i, j int) bool {
+		return current[i].QueueDepth > current[j].QueueDepth
+	})
+	return append(current, recent...)
+}
+
+// queueDepths returns the number of transactions queued or in flight for the
+// hot row ranges, per table.
+func (txs *TxSerializer) queueDepths() map[string]int64 {
+	txs.mu.Lock()
+	defer txs.mu.Unlock()
+
+	depths := make(map[string]int64)
+	for _, q := range txs.queues {
+		if q.availableSlots != nil {
+			depths[q.table] += int64(q.size)
+		}
+	}
+	return depths
+}
+
+// MaxQueueSize returns the maximum number of transactions queued for the same
+// row range.
+func (txs *TxSerializer) MaxQueueSize() int {
+	txs.mu.Lock()
+	defer txs.mu.Unlock()
+	return txs.maxQueueSize
+}
+
+// SetMaxQueueSize changes the maximum number of transactions queued for the
+// same row range. Non-positive values are ignored.
+func (txs *TxSerializer) SetMaxQueueSize(size int) {
+	txs.mu.Lock()
+	defer txs.mu.Unlock()
+	if size > 0 {
+		txs.maxQueueSize = size
+	}
+}
+
+// MaxGlobalQueueSize returns the maximum number of transactions queued across
+// all row ranges.
+func (txs *TxSerializer) MaxGlobalQueueSize() int {
+	txs.mu.Lock()
+	defer txs.mu.Unlock()
+	return txs.maxGlobalQueueSize
+}
+
+// SetMaxGlobalQueueSize changes the maximum number of transactions queued
+// across all row ranges. Non-positive values are ignored.
+func (txs *TxSerializer) SetMaxGlobalQueueSize(size int) {
+	txs.mu.Lock()
+	defer txs.mu.Unlock()
+	if size > 0 {
+		txs.maxGlobalQueueSize = size
+	}
+}
+
+// ConcurrentTransactions returns the number of transactions let through
+// concurrently for the same row range.
+func (txs *TxSerializer) ConcurrentTransactions() int {
+	txs.mu.Lock()
+	defer txs.mu.Unlock()
+	return txs.concurrentTransactions
+}
+
+// SetConcurrentTransactions changes the number of transactions let through
+// concurrently for the same row range. It only applies to the row ranges which
+// are not hot yet. Non-positive values are ignored.
+func (txs *TxSerializer) SetConcurrentTransactions(n int) {
+	txs.mu.Lock()
+	defer txs.mu.Unlock()
+	if n > 0 {
+		txs.concurrentTransactions = n
+	}
+}
+
 // ServeHTTP lists the most recent, cached queries and their count.
 func (txs *TxSerializer) ServeHTTP(response http.ResponseWriter, request *http.Request) {
 	if *streamlog.RedactDebugUIQueries {
@@ -335,6 +512,35 @@ func (txs *TxSerializer) ServeHTTP(response http.ResponseWriter, request *http.R
 	}
 }
 
+// ServeHotKeysHTTP lists the row ranges which are currently or were recently
+// hot, and the stats of their queue, in JSON.
+func (txs *TxSerializer) ServeHotKeysHTTP(response http.ResponseWriter, request *http.Request) {
+	if *streamlog.RedactDebugUIQueries {
+		response.Write([]byte(`
+	<!DOCTYPE html>
+	<html>
+	<body>
+	<h1>Redacted</h1>
+	<p>/debug/hotrows/keys has been redacted for your protection</p>
+	</body>
+	</html>
+		`))
+		return
+	}
+
+	if err := acl.CheckAccessHTTP(request, acl.DEBUGGING); err != nil {
+		acl.SendError(response, err)
+		return
+	}
+	b, err := json.MarshalIndent(txs.HotKeys(), "", "  ")
+	if err != nil {
+		response.Write([]byte(err.Error()))
+		return
+	}
+	response.Header().Set("Content-Type", "application/json; charset=utf-8")
+	response.Write(b)
+}
+
 // queue represents the local queue for a particular row (range).
 //
 // Note that we don't use a dedicated queue structure for all waiting
@@ -344,6 +550,9 @@ func (txs *TxSerializer) ServeHTTP(response http.ResponseWriter, request *http.R
 // transactions which can access the tx pool). All queued transactions are
 // competing for these slots and try to add themselves to the channel.
 type queue struct {
+	// table is the table of the row range.
+	table string
+
 	// NOTE: The following fields are guarded by TxSerializer.mu.
 	// size counts how many transactions are currently queued/in flight (includes
 	// the transactions which are not waiting.)
@@ -353,6 +562,10 @@ type queue struct {
 	// max is the max of "size", i.e. the maximum number of transactions which
 	// were simultaneously queued for the same row range.
 	max int
+	// waits is the number of transactions which had to wait for a slot.
+	waits int
+	// waitTime is the total time transactions waited for a slot.
+	waitTime time.Duration
 
 	// availableSlots limits the number of concurrent transactions *per*
 	// hot row (range). It holds one element for each allowed pending
@@ -364,10 +577,23 @@ type queue struct {
 	availableSlots chan struct{}
 }
 
-func newQueueForFirstTransaction(concurrentTransactions int) *queue {
+func newQueueForFirstTransaction(table string, concurrentTransactions int) *queue {
 	return &queue{
+		table: table,
 		size:  1,
 		count: 1,
 		max:   1,
 	}
 }
+
+// addTo adds the stats of the queue to hk.
+func (q *queue) addTo(hk *HotKey) {
+	hk.QueueDepth = q.size
+	if q.max > hk.MaxQueueDepth {
+		hk.MaxQueueDepth = q.max
+	}
+	hk.Transactions += q.count
+	hk.Waits += q.waits
+	hk.WaitTime += q.waitTime
+	hk.LastHot = time.Now()
+}
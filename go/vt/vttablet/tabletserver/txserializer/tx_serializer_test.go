@@ -41,6 +41,7 @@ func resetVariables(txs *TxSerializer) {
 	txs.queueExceededDryRun.ResetAll()
 	txs.globalQueueExceeded.Reset()
 	txs.globalQueueExceededDryRun.Reset()
+	txs.waitTime.Reset()
 }
 
 func TestTxSerializer_NoHotRow(t *testing.T) {
@@ -521,6 +522,117 @@ func TestTxSerializerPending(t *testing.T) {
 	}
 }
 
+func TestTxSerializerHotKeys(t *testing.T) {
+	config := tabletenv.NewDefaultConfig()
+	config.HotRowProtection.MaxQueueSize = 2
+	config.HotRowProtection.MaxGlobalQueueSize = 3
+	config.HotRowProtection.MaxConcurrency = 1
+	txs := New(tabletenv.NewEnv(config, "TxSerializerTest"))
+	resetVariables(txs)
+
+	done1, _, err := txs.Wait(context.Background(), "t1 where1", "t1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// A row range is not hot with a single transaction.
+	if got := txs.HotKeys(); len(got) != 0 {
+		t.Errorf("unexpected hot keys: %v", got)
+	}
+
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		done2, _, err := txs.Wait(context.Background(), "t1 where1", "t1")
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		done2()
+	}()
+	if err := waitForPending(txs, "t1 where1", 2); err != nil {
+		t.Fatal(err)
+	}
+
+	got := txs.HotKeys()
+	if len(got) != 1 || got[0].Key != "t1 where1" || got[0].Table != "t1" || got[0].QueueDepth != 2 {
+		t.Errorf("wrong current hot keys: %+v", got)
+	}
+	if got, want := txs.queueDepths()["t1"], int64(2); got != want {
+		t.Errorf("wrong queue depth: got = %v, want = %v", got, want)
+	}
+
+	time.Sleep(10 * time.Millisecond)
+	done1()
+	wg.Wait()
+
+	// The row range is not hot anymore, but it's remembered.
+	got = txs.HotKeys()
+	if len(got) != 1 {
+		t.Fatalf("wrong recent hot keys: %+v", got)
+	}
+	hk := got[0]
+	if hk.QueueDepth != 0 || hk.MaxQueueDepth != 2 || hk.Transactions != 2 || hk.Waits != 1 || hk.WaitTime < 10*time.Millisecond {
+		t.Errorf("wrong recent hot key: %+v", hk)
+	}
+	if got := txs.queueDepths(); len(got) != 0 {
+		t.Errorf("unexpected queue depths: %v", got)
+	}
+	if got, want := txs.waitTime.Counts()["TxSerializerTest.t1"], int64(1); got != want {
+		t.Errorf("wrong wait time count: got = %v, want = %v", got, want)
+	}
+
+	req, err := http.NewRequest("GET", "/path-is-ignored-in-test", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rr := httptest.NewRecorder()
+	txs.ServeHotKeysHTTP(rr, req)
+	if got, want := rr.Body.String(), `"Key": "t1 where1"`; !strings.Contains(got, want) {
+		t.Errorf("/debug/hotrows/keys: got = %v, want it to contain %v", got, want)
+	}
+}
+
+func TestTxSerializerSetLimits(t *testing.T) {
+	config := tabletenv.NewDefaultConfig()
+	config.HotRowProtection.MaxQueueSize = 1
+	config.HotRowProtection.MaxGlobalQueueSize = 1
+	config.HotRowProtection.MaxConcurrency = 1
+	txs := New(tabletenv.NewEnv(config, "TxSerializerTest"))
+	resetVariables(txs)
+
+	done1, _, err := txs.Wait(context.Background(), "t1 where1", "t1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The queue is full.
+	if _, _, err := txs.Wait(context.Background(), "t1 where1", "t1"); vterrors.Code(err) != vtrpcpb.Code_RESOURCE_EXHAUSTED {
+		t.Errorf("wrong error: %v", err)
+	}
+
+	txs.SetMaxQueueSize(2)
+	txs.SetMaxGlobalQueueSize(2)
+	txs.SetConcurrentTransactions(2)
+	// Non-positive values are ignored.
+	txs.SetMaxQueueSize(0)
+	if got, want := txs.MaxQueueSize(), 2; got != want {
+		t.Errorf("wrong max queue size: got = %v, want = %v", got, want)
+	}
+
+	// The queue has room now, and both transactions are let through.
+	done2, waited, err := txs.Wait(context.Background(), "t1 where1", "t1")
+	if err != nil || waited {
+		t.Errorf("tx2 must not wait: waited = %v, err = %v", waited, err)
+	}
+	done2()
+	done1()
+
+	if got, want := txs.globalSize, 0; got != want {
+		t.Errorf("wrong global queue size: got = %v, want = %v", got, want)
+	}
+}
+
 func BenchmarkTxSerializer_NoHotRow(b *testing.B) {
 	config := tabletenv.NewDefaultConfig()
 	config.HotRowProtection.MaxQueueSize = 1
@@ -0,0 +1,241 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vstreamer
+
+import (
+	"flag"
+	"fmt"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// fillPartialRowImages allows streaming the binlogs of mysqlds that do not log full row images.
+var fillPartialRowImages = flag.Bool("vstream_fill_partial_row_images", false, "Stream the binlogs of mysqlds with binlog_row_image set to 'minimal' or 'noblob' by reading the columns missing from the row images from the tables. The columns are read when the events are streamed, and the value of a row converges to its current value once its later events are applied. The row changes list the columns missing from their before images, and those read for their after images.")
+
+var errPartialRowImage = fmt.Errorf("partial row image encountered: ensure binlog_row_image is set to 'full', or set -vstream_fill_partial_row_images")
+
+// partialRowFiller fills the columns missing from the partial row images of
+// a rows event. It connects to the mysqld on the first row that needs it,
+// and must be closed once the event is processed.
+type partialRowFiller struct {
+	vs        *vstreamer
+	conn      *mysql.Conn
+	pkColumns []int
+}
+
+func (f *partialRowFiller) close() {
+	if f.conn != nil {
+		f.conn.Close()
+	}
+}
+
+// fill returns the before and after images of a row. The missing columns of
+// the after image are read from the table. The missing columns of the before
+// image are left unset, but for those that the filter of the plan needs: they
+// are those of the after image if they did not change. If the row no longer
+// exists, an update is streamed as a delete, and an insert is skipped.
+func (f *partialRowFiller) fill(plan *streamerPlan, before []sqltypes.Value, beforeMissing []int, after []sqltypes.Value, afterMissing []int) ([]sqltypes.Value, []sqltypes.Value, error) {
+	if len(afterMissing) > 0 {
+		found, err := f.fillAfter(plan, before, beforeMissing, after, afterMissing)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !found {
+			after, afterMissing = nil, nil
+		}
+	}
+	if len(beforeMissing) == 0 {
+		return before, after, nil
+	}
+	needed := filterColumns(plan)
+	for _, colNum := range beforeMissing {
+		if !needed[colNum] {
+			continue
+		}
+		if after == nil || !containsColumn(afterMissing, colNum) {
+			return nil, nil, fmt.Errorf("column %s of table %s is missing from a partial row image, and is needed to filter the row", plan.Table.Fields[colNum].Name, plan.Table.Name)
+		}
+		before[colNum] = after[colNum]
+	}
+	return before, after, nil
+}
+
+// fillAfter reads the columns missing from an after image from the table.
+// It returns false if the row no longer exists.
+func (f *partialRowFiller) fillAfter(plan *streamerPlan, before []sqltypes.Value, beforeMissing []int, after []sqltypes.Value, afterMissing []int) (bool, error) {
+	pkColumns, err := f.primaryKey(plan)
+	if err != nil {
+		return false, err
+	}
+	buf := sqlparser.NewTrackedBuffer(nil)
+	buf.Myprintf("select ")
+	for i, colNum := range afterMissing {
+		if i != 0 {
+			buf.Myprintf(", ")
+		}
+		field := plan.Table.Fields[colNum]
+		switch field.Type {
+		case querypb.Type_ENUM, querypb.Type_SET:
+			// The binlogs have the numbers of enum and set values.
+			buf.Myprintf("%v+0", sqlparser.NewColIdent(field.Name))
+		default:
+			buf.Myprintf("%v", sqlparser.NewColIdent(field.Name))
+		}
+	}
+	buf.Myprintf(" from %v.%v where ", sqlparser.NewTableIdent(f.vs.cp.DBName()), sqlparser.NewTableIdent(plan.Table.Name))
+	for i, colNum := range pkColumns {
+		var value sqltypes.Value
+		switch {
+		case !containsColumn(afterMissing, colNum):
+			value = after[colNum]
+		case before != nil && !containsColumn(beforeMissing, colNum):
+			value = before[colNum]
+		default:
+			return false, fmt.Errorf("primary key column %s of table %s is missing from the row images", plan.Table.Fields[colNum].Name, plan.Table.Name)
+		}
+		if i != 0 {
+			buf.Myprintf(" and ")
+		}
+		buf.Myprintf("%v = ", sqlparser.NewColIdent(plan.Table.Fields[colNum].Name))
+		value.EncodeSQL(buf)
+	}
+
+	if f.conn == nil {
+		conn, err := f.vs.cp.Connect(f.vs.ctx)
+		if err != nil {
+			return false, err
+		}
+		f.conn = conn
+	}
+	qr, err := f.conn.ExecuteFetch(buf.String(), 1, false)
+	if err != nil {
+		return false, err
+	}
+	if len(qr.Rows) == 0 {
+		return false, nil
+	}
+	for i, colNum := range afterMissing {
+		value := qr.Rows[0][i]
+		if value.IsNull() {
+			after[colNum] = sqltypes.NULL
+			continue
+		}
+		after[colNum] = sqltypes.MakeTrusted(plan.Table.Fields[colNum].Type, value.Raw())
+	}
+	return true, nil
+}
+
+// primaryKey returns the column numbers of the primary key of the table of
+// the plan.
+func (f *partialRowFiller) primaryKey(plan *streamerPlan) ([]int, error) {
+	if f.pkColumns != nil {
+		return f.pkColumns, nil
+	}
+	st, err := f.vs.se.GetTableForPos(sqlparser.NewTableIdent(plan.Table.Name), mysql.EncodePosition(f.vs.pos))
+	if err != nil {
+		return nil, err
+	}
+	if len(st.PKColumns) == 0 {
+		return nil, fmt.Errorf("table %s has no primary key to read the columns missing from its partial row images", plan.Table.Name)
+	}
+	for _, pk := range st.PKColumns {
+		if int(pk) >= len(plan.Table.Fields) {
+			return nil, fmt.Errorf("primary key column %d of table %s is not in the binlog event", pk, plan.Table.Name)
+		}
+		f.pkColumns = append(f.pkColumns, int(pk))
+	}
+	return f.pkColumns, nil
+}
+
+// filterColumns returns the column numbers of the table that the filter of
+// the plan reads.
+func filterColumns(plan *streamerPlan) map[int]bool {
+	needed := make(map[int]bool)
+	for _, filter := range plan.Filters {
+		if filter.Opcode == VindexMatch {
+			for _, colNum := range filter.VindexColumns {
+				needed[colNum] = true
+			}
+			continue
+		}
+		needed[filter.ColNum] = true
+	}
+	for _, colExpr := range plan.ColExprs {
+		for _, colNum := range colExpr.VindexColumns {
+			needed[colNum] = true
+		}
+	}
+	return needed
+}
+
+// streamedColumns returns the positions of the columns streamed by the plan
+// whose values come from the given columns of the table.
+func streamedColumns(plan *streamerPlan, columns []int) []int64 {
+	if len(columns) == 0 {
+		return nil
+	}
+	var positions []int64
+	for i, colExpr := range plan.ColExprs {
+		from := []int{colExpr.ColNum}
+		if colExpr.Vindex != nil {
+			from = colExpr.VindexColumns
+		}
+		for _, colNum := range from {
+			if containsColumn(columns, colNum) {
+				positions = append(positions, int64(i))
+				break
+			}
+		}
+	}
+	return positions
+}
+
+// withoutColumns returns the values but those of the given positions.
+func withoutColumns(values []sqltypes.Value, positions []int64) []sqltypes.Value {
+	if len(positions) == 0 {
+		return values
+	}
+	kept := make([]sqltypes.Value, 0, len(values)-len(positions))
+	for i, value := range values {
+		if !containsPosition(positions, i) {
+			kept = append(kept, value)
+		}
+	}
+	return kept
+}
+
+func containsPosition(positions []int64, pos int) bool {
+	for _, p := range positions {
+		if p == int64(pos) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsColumn(columns []int, colNum int) bool {
+	for _, c := range columns {
+		if c == colNum {
+			return true
+		}
+	}
+	return false
+}
@@ -31,9 +31,9 @@ import (
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/sync2"
-	"vitess.io/vitess/go/vt/binlog"
 	"vitess.io/vitess/go/vt/dbconfigs"
 	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/mysqlctl"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
 
@@ -165,7 +165,7 @@ func (vs *vstreamer) replicate(ctx context.Context) error {
 		return wrapError(err, vs.pos, vs.vse)
 	}
 
-	conn, err := binlog.NewBinlogConnection(vs.cp)
+	conn, err := mysqlctl.NewBinlogConnection(vs.cp)
 	if err != nil {
 		return wrapError(err, vs.pos, vs.vse)
 	}
@@ -815,12 +815,30 @@ nextrow:
 
 func (vs *vstreamer) processRowEvent(vevents []*binlogdatapb.VEvent, plan *streamerPlan, rows mysql.Rows) ([]*binlogdatapb.VEvent, error) {
 	rowChanges := make([]*binlogdatapb.RowChange, 0, len(rows.Rows))
+	filler := &partialRowFiller{vs: vs}
+	defer filler.close()
 	for _, row := range rows.Rows {
-		beforeOK, beforeValues, err := vs.extractRowAndFilter(plan, row.Identify, rows.IdentifyColumns, row.NullIdentifyColumns)
+		before, beforeMissing, err := vs.extractRow(plan, row.Identify, rows.IdentifyColumns, row.NullIdentifyColumns)
 		if err != nil {
 			return nil, err
 		}
-		afterOK, afterValues, err := vs.extractRowAndFilter(plan, row.Data, rows.DataColumns, row.NullColumns)
+		after, afterMissing, err := vs.extractRow(plan, row.Data, rows.DataColumns, row.NullColumns)
+		if err != nil {
+			return nil, err
+		}
+		if len(beforeMissing) != 0 || len(afterMissing) != 0 {
+			if !*fillPartialRowImages {
+				return nil, errPartialRowImage
+			}
+			if before, after, err = filler.fill(plan, before, beforeMissing, after, afterMissing); err != nil {
+				return nil, err
+			}
+		}
+		beforeOK, beforeValues, err := vs.filterRow(plan, before)
+		if err != nil {
+			return nil, err
+		}
+		afterOK, afterValues, err := vs.filterRow(plan, after)
 		if err != nil {
 			return nil, err
 		}
@@ -829,10 +847,12 @@ func (vs *vstreamer) processRowEvent(vevents []*binlogdatapb.VEvent, plan *strea
 		}
 		rowChange := &binlogdatapb.RowChange{}
 		if beforeOK {
-			rowChange.Before = sqltypes.RowToProto3(beforeValues)
+			rowChange.BeforeMissingColumns = streamedColumns(plan, beforeMissing)
+			rowChange.Before = sqltypes.RowToProto3(withoutColumns(beforeValues, rowChange.BeforeMissingColumns))
 		}
 		if afterOK {
 			rowChange.After = sqltypes.RowToProto3(afterValues)
+			rowChange.AfterFilledColumns = streamedColumns(plan, afterMissing)
 		}
 		rowChanges = append(rowChanges, rowChange)
 	}
@@ -873,15 +893,30 @@ func (vs *vstreamer) rebuildPlans() error {
 }
 
 func (vs *vstreamer) extractRowAndFilter(plan *streamerPlan, data []byte, dataColumns, nullColumns mysql.Bitmap) (bool, []sqltypes.Value, error) {
+	values, missing, err := vs.extractRow(plan, data, dataColumns, nullColumns)
+	if err != nil {
+		return false, nil, err
+	}
+	if len(missing) != 0 {
+		return false, nil, errPartialRowImage
+	}
+	return vs.filterRow(plan, values)
+}
+
+// extractRow decodes a row image. It returns nil if the image is empty, and
+// the numbers of the columns missing from a partial image.
+func (vs *vstreamer) extractRow(plan *streamerPlan, data []byte, dataColumns, nullColumns mysql.Bitmap) ([]sqltypes.Value, []int, error) {
 	if len(data) == 0 {
-		return false, nil, nil
+		return nil, nil, nil
 	}
 	values := make([]sqltypes.Value, dataColumns.Count())
+	var missing []int
 	valueIndex := 0
 	pos := 0
 	for colNum := 0; colNum < dataColumns.Count(); colNum++ {
 		if !dataColumns.Bit(colNum) {
-			return false, nil, fmt.Errorf("partial row image encountered: ensure binlog_row_image is set to 'full'")
+			missing = append(missing, colNum)
+			continue
 		}
 		if nullColumns.Bit(valueIndex) {
 			valueIndex++
@@ -889,15 +924,22 @@ func (vs *vstreamer) extractRowAndFilter(plan *streamerPlan, data []byte, dataCo
 		}
 		value, l, err := mysql.CellValue(data, pos, plan.TableMap.Types[colNum], plan.TableMap.Metadata[colNum], plan.Table.Fields[colNum].Type)
 		if err != nil {
-			log.Errorf("extractRowAndFilter: %s, table: %s, colNum: %d, fields: %+v, current values: %+v",
+			log.Errorf("extractRow: %s, table: %s, colNum: %d, fields: %+v, current values: %+v",
 				err, plan.Table.Name, colNum, plan.Table.Fields, values)
-			return false, nil, err
+			return nil, nil, err
 		}
 		pos += l
 
 		values[colNum] = value
 		valueIndex++
 	}
+	return values, missing, nil
+}
+
+func (vs *vstreamer) filterRow(plan *streamerPlan, values []sqltypes.Value) (bool, []sqltypes.Value, error) {
+	if values == nil {
+		return false, nil, nil
+	}
 	filtered := make([]sqltypes.Value, len(plan.ColExprs))
 	ok, err := plan.filter(values, filtered)
 	return ok, filtered, err
@@ -1798,6 +1798,60 @@ func TestMinimalMode(t *testing.T) {
 	}
 }
 
+func TestMinimalModeFillPartialRowImages(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	defer func(fill bool) { *fillPartialRowImages = fill }(*fillPartialRowImages)
+	*fillPartialRowImages = true
+
+	execStatements(t, []string{
+		"create table t1(id int, val1 varbinary(128), val2 varbinary(128), primary key(id))",
+		"insert into t1 values(1, 'aaa', 'bbb')",
+	})
+	defer execStatements(t, []string{
+		"drop table t1",
+	})
+	engine.se.Reload(context.Background())
+
+	testcases := []testcase{{
+		input: []string{
+			"set @@session.binlog_row_image='minimal'",
+			"update t1 set val1='ccc' where id=1",
+			"set @@session.binlog_row_image='full'",
+		},
+		// The update reads id and val2 from the table for its after image,
+		// and its before image only has the id.
+		output: [][]string{{
+			`begin`,
+			`type:FIELD field_event:{table_name:"t1" fields:{name:"id" type:INT32 table:"t1" org_table:"t1" database:"vttest" org_name:"id" column_length:11 charset:63} fields:{name:"val1" type:VARBINARY table:"t1" org_table:"t1" database:"vttest" org_name:"val1" column_length:128 charset:63} fields:{name:"val2" type:VARBINARY table:"t1" org_table:"t1" database:"vttest" org_name:"val2" column_length:128 charset:63}}`,
+			`type:ROW row_event:{table_name:"t1" row_changes:{before:{lengths:1 values:"1"} after:{lengths:1 lengths:3 lengths:3 values:"1cccbbb"} before_missing_columns:1 before_missing_columns:2 after_filled_columns:0 after_filled_columns:2}}`,
+			`gtid`,
+			`commit`,
+		}},
+	}, {
+		input: []string{
+			"set @@session.binlog_row_image='minimal'",
+			"insert into t1(id, val1) values(2, 'aaa')",
+			"update t1 set val1='bbb' where id=2",
+			"set @@session.binlog_row_image='full'",
+		},
+		// The val2 of the row is NULL.
+		output: [][]string{{
+			`begin`,
+			`type:ROW row_event:{table_name:"t1" row_changes:{after:{lengths:1 lengths:3 lengths:-1 values:"2aaa"} after_filled_columns:2}}`,
+			`gtid`,
+			`commit`,
+		}, {
+			`begin`,
+			`type:ROW row_event:{table_name:"t1" row_changes:{before:{lengths:1 values:"2"} after:{lengths:1 lengths:3 lengths:-1 values:"2bbb"} before_missing_columns:1 before_missing_columns:2 after_filled_columns:0 after_filled_columns:2}}`,
+			`gtid`,
+			`commit`,
+		}},
+	}}
+	runCases(t, nil, testcases, "current", nil)
+}
+
 func TestStatementMode(t *testing.T) {
 	if testing.Short() {
 		t.Skip()
@@ -0,0 +1,173 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"container/list"
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+
+	"vitess.io/vitess/go/acl"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/streamlog"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/planbuilder"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// warmUpSample keeps the most recent distinct SELECTs the tablet executed
+// outside of transactions, with the bind variables of their last execution.
+// New tablets fetch it from /debug/warmup_queries and replay it before they
+// start serving.
+type warmUpSample struct {
+	size int
+
+	mu      sync.Mutex
+	queries *list.List // of *querypb.BoundQuery, the most recent first
+	index   map[string]*list.Element
+}
+
+func newWarmUpSample(size int) *warmUpSample {
+	return &warmUpSample{
+		size:    size,
+		queries: list.New(),
+		index:   make(map[string]*list.Element),
+	}
+}
+
+// record adds a query to the sample, or makes it the most recent one. It is
+// a no-op if the sample is disabled.
+func (s *warmUpSample) record(sql string, bindVars map[string]*querypb.BindVariable) {
+	if s.size <= 0 {
+		return
+	}
+	query := &querypb.BoundQuery{
+		Sql:           sql,
+		BindVariables: make(map[string]*querypb.BindVariable, len(bindVars)),
+	}
+	for name, bv := range bindVars {
+		// The bind variables the query executor adds are set again when the
+		// query is replayed.
+		if name == "#maxLimit" || name == sqltypes.BvSchemaName {
+			continue
+		}
+		query.BindVariables[name] = bv
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if elem, ok := s.index[sql]; ok {
+		elem.Value = query
+		s.queries.MoveToFront(elem)
+		return
+	}
+	s.index[sql] = s.queries.PushFront(query)
+	if s.queries.Len() > s.size {
+		oldest := s.queries.Back()
+		s.queries.Remove(oldest)
+		delete(s.index, oldest.Value.(*querypb.BoundQuery).Sql)
+	}
+}
+
+// list returns the queries of the sample, the most recent first.
+func (s *warmUpSample) list() []*querypb.BoundQuery {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	queries := make([]*querypb.BoundQuery, 0, s.queries.Len())
+	for elem := s.queries.Front(); elem != nil; elem = elem.Next() {
+		queries = append(queries, elem.Value.(*querypb.BoundQuery))
+	}
+	return queries
+}
+
+func (s *warmUpSample) ServeHTTP(response http.ResponseWriter, request *http.Request) {
+	if err := acl.CheckAccessHTTP(request, acl.DEBUGGING); err != nil {
+		acl.SendError(response, err)
+		return
+	}
+	queries := s.list()
+	if streamlog.RedactsBindVars() {
+		// The bind values are omitted like in the other debug pages and the
+		// query logs. The new tablets still warm up the plans of the queries.
+		redacted := make([]*querypb.BoundQuery, 0, len(queries))
+		for _, query := range queries {
+			redacted = append(redacted, &querypb.BoundQuery{Sql: query.Sql})
+		}
+		queries = redacted
+	}
+	response.Header().Set("Content-Type", "application/json; charset=utf-8")
+	if b, err := json.MarshalIndent(queries, "", "  "); err != nil {
+		response.Write([]byte(err.Error()))
+	} else {
+		response.Write(b)
+	}
+}
+
+// WarmUp builds and caches the plans of the queries, and executes their
+// SELECTs to load the data they read into the buffer pool of MySQL and into
+// the result cache. It is meant to be called while the tablet is not serving
+// yet, and returns the number of queries that succeeded. It stops when the
+// context is done.
+func (tsv *TabletServer) WarmUp(ctx context.Context, queries []*querypb.BoundQuery) int {
+	warmed := 0
+	for _, query := range queries {
+		if ctx.Err() != nil {
+			break
+		}
+		if err := tsv.warmUpQuery(ctx, query); err != nil {
+			log.Warningf("Can not warm up with %q: %v", sqlparser.TruncateForLog(query.Sql), err)
+			continue
+		}
+		warmed++
+	}
+	log.Infof("Warmed up with %d of %d queries", warmed, len(queries))
+	return warmed
+}
+
+func (tsv *TabletServer) warmUpQuery(ctx context.Context, query *querypb.BoundQuery) error {
+	logStats := tabletenv.NewLogStats(ctx, "WarmUp")
+	plan, err := tsv.qe.GetPlan(ctx, logStats, query.Sql, false, false)
+	if err != nil {
+		return err
+	}
+	if plan.PlanID != planbuilder.PlanSelect {
+		return nil
+	}
+	bindVars := sqltypes.CopyBindVariables(query.BindVariables)
+	if bindVars == nil {
+		bindVars = make(map[string]*querypb.BindVariable)
+	}
+	qre := &QueryExecutor{
+		query:    query.Sql,
+		bindVars: bindVars,
+		plan:     plan,
+		ctx:      ctx,
+		logStats: logStats,
+		tsv:      tsv,
+	}
+	qre.bindVars["#maxLimit"] = sqltypes.Int64BindVariable(qre.getSelectLimit() + 1)
+	if qre.bindVars[sqltypes.BvReplaceSchemaName] != nil {
+		qre.bindVars[sqltypes.BvSchemaName] = sqltypes.StringBindVariable(tsv.config.DB.DBName)
+	}
+	_, err = qre.execSelect()
+	return err
+}
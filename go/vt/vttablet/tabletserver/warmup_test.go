@@ -0,0 +1,100 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/streamlog"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestWarmUpSample(t *testing.T) {
+	s := newWarmUpSample(2)
+	s.record("select 1", nil)
+	s.record("select 2", map[string]*querypb.BindVariable{"a": sqltypes.Int64BindVariable(1), "#maxLimit": sqltypes.Int64BindVariable(10001)})
+	s.record("select 1", nil)
+	s.record("select 2", map[string]*querypb.BindVariable{"a": sqltypes.Int64BindVariable(2)})
+	s.record("select 3", nil)
+
+	queries := s.list()
+	require.Len(t, queries, 2)
+	assert.Equal(t, "select 3", queries[0].Sql)
+	assert.Equal(t, "select 2", queries[1].Sql)
+	assert.Equal(t, map[string]*querypb.BindVariable{"a": sqltypes.Int64BindVariable(2)}, queries[1].BindVariables)
+
+	response := httptest.NewRecorder()
+	s.ServeHTTP(response, httptest.NewRequest("GET", "/debug/warmup_queries", nil))
+	var served []*querypb.BoundQuery
+	require.NoError(t, json.Unmarshal(response.Body.Bytes(), &served))
+	require.Len(t, served, 2)
+	assert.Equal(t, "select 2", served[1].Sql)
+	assert.EqualValues(t, "2", served[1].BindVariables["a"].Value)
+
+	// The bind values are omitted when the debug pages redact them.
+	*streamlog.RedactDebugUIQueries = true
+	defer func() { *streamlog.RedactDebugUIQueries = false }()
+	response = httptest.NewRecorder()
+	s.ServeHTTP(response, httptest.NewRequest("GET", "/debug/warmup_queries", nil))
+	served = nil
+	require.NoError(t, json.Unmarshal(response.Body.Bytes(), &served))
+	require.Len(t, served, 2)
+	assert.Equal(t, "select 2", served[1].Sql)
+	assert.Empty(t, served[1].BindVariables)
+	assert.NotContains(t, response.Body.String(), `"2"`)
+
+	disabled := newWarmUpSample(0)
+	disabled.record("select 1", nil)
+	assert.Empty(t, disabled.list())
+}
+
+func TestTabletServerWarmUp(t *testing.T) {
+	config := tabletenv.NewDefaultConfig()
+	config.WarmUpSampleSize = 10
+	db, tsv := setupTabletServerTestCustom(t, config, "")
+	defer db.Close()
+	defer tsv.StopService()
+
+	ctx := context.Background()
+	query := "select * from test_table where pk = :pk"
+	fullQuery := "select * from test_table where pk = 1 limit 10001"
+	db.AddQuery(fullQuery, &sqltypes.Result{Fields: getTestTableFields()})
+	db.AddQuery("update test_table set `name` = 2 where pk = 1 limit 10001", &sqltypes.Result{})
+	_, err := tsv.Execute(ctx, tsv.sm.target, query, map[string]*querypb.BindVariable{"pk": sqltypes.Int64BindVariable(1)}, 0, 0, nil)
+	require.NoError(t, err)
+	_, err = tsv.Execute(ctx, tsv.sm.target, "update test_table set `name` = 2 where pk = 1", nil, 0, 0, nil)
+	require.NoError(t, err)
+
+	queries := tsv.warmUpSample.list()
+	require.Len(t, queries, 1)
+	assert.Equal(t, query, queries[0].Sql)
+
+	tsv.ClearQueryPlanCache()
+	queries = append(queries, &querypb.BoundQuery{Sql: "select * from"})
+	assert.Equal(t, 1, tsv.WarmUp(ctx, queries))
+	assert.Equal(t, 2, db.GetQueryCalledNum(fullQuery))
+	assertPlanCacheSize(t, tsv.qe, 1)
+}
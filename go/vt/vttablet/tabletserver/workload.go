@@ -0,0 +1,107 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/throttle"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+const (
+	// defaultWorkloadName labels the queries without a workload name in
+	// the metrics.
+	defaultWorkloadName = "default"
+
+	// lowPriorityQueriesThrottlerApp is the app name of the throttler
+	// checks of the queries of LOW priority.
+	lowPriorityQueriesThrottlerApp = "low-priority-queries"
+)
+
+// workloadName returns the workload name of the query: the one set with
+// its WORKLOAD_NAME directive, or else the one of its options.
+func (qre *QueryExecutor) workloadName() string {
+	if qre.plan.WorkloadName != "" {
+		return qre.plan.WorkloadName
+	}
+	return qre.options.GetWorkloadName()
+}
+
+// priority returns the priority of the query: the one set with its
+// PRIORITY directive, or else the one of its options.
+func (qre *QueryExecutor) priority() querypb.ExecuteOptions_Priority {
+	if qre.plan.HasPriority {
+		return qre.plan.Priority
+	}
+	return qre.options.GetPriority()
+}
+
+// workloadLabels returns the labels of the query in the workload metrics.
+func (qre *QueryExecutor) workloadLabels() []string {
+	workloadName := qre.workloadName()
+	if workloadName == "" {
+		workloadName = defaultWorkloadName
+	}
+	return []string{workloadName, strings.ToLower(strings.TrimSuffix(qre.priority().String(), "_PRIORITY"))}
+}
+
+// recordWorkloadQuery records the query in the metrics of its workload.
+func (qre *QueryExecutor) recordWorkloadQuery(start time.Time) {
+	qre.tsv.stats.WorkloadQueryTimings.Record(qre.workloadLabels(), start)
+}
+
+// consolidationKey returns the key of the query in the consolidators. The
+// queries are only consolidated with the ones of the same priority, so that
+// a query never waits on one of a lower priority, which may be served from
+// a smaller pool.
+func (qre *QueryExecutor) consolidationKey(sqlWithoutComments string) string {
+	if priority := qre.priority(); priority != querypb.ExecuteOptions_NORMAL_PRIORITY {
+		return priority.String() + ":" + sqlWithoutComments
+	}
+	return sqlWithoutComments
+}
+
+// checkThrottler rejects the queries of LOW priority while the lag
+// throttler throttles them, if -throttle_low_priority_queries is set. The
+// queries are checked as low priority apps, which are also throttled as
+// soon as any app of a higher priority is.
+func (qre *QueryExecutor) checkThrottler() error {
+	if !qre.tsv.config.ThrottleLowPriorityQueries || qre.priority() != querypb.ExecuteOptions_LOW_PRIORITY {
+		return nil
+	}
+	checkType := throttle.ThrottleCheckSelf
+	if qre.tabletType == topodatapb.TabletType_PRIMARY {
+		checkType = throttle.ThrottleCheckPrimaryWrite
+	}
+	checkResult := qre.tsv.lagThrottler.CheckByType(qre.ctx, lowPriorityQueriesThrottlerApp, "", &throttle.CheckFlags{LowPriority: true}, checkType)
+	switch checkResult.StatusCode {
+	case http.StatusTooManyRequests, http.StatusExpectationFailed:
+	default:
+		// The throttler lets the query through, or cannot tell yet,
+		// e.g. while it has not collected its metrics.
+		return nil
+	}
+	qre.tsv.stats.WorkloadThrottled.Add(qre.workloadLabels(), 1)
+	return vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "low priority query throttled: %s", checkResult.Message)
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"context"
+	"strings"
+
+	"vitess.io/vitess/go/vt/callerid"
+	"vitess.io/vitess/go/vt/dbconfigs"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/connpool"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+)
+
+// workloadPools are the pools reserved to the workloads of
+// tabletenv.TabletConfig.WorkloadPools, for one of the regular pools.
+type workloadPools struct {
+	// Immutable fields.
+	byUsername  map[string]*connpool.Pool
+	byPrincipal map[string]*connpool.Pool
+	byName      map[string]*connpool.Pool
+	pools       []*connpool.Pool
+}
+
+// newWorkloadPools creates the workload pools partitioning the regular pool
+// name. poolConfig returns the config of the pool in a workload config.
+func newWorkloadPools(env tabletenv.Env, name string, regular tabletenv.ConnPoolConfig, poolConfig func(*tabletenv.WorkloadPoolConfig) tabletenv.ConnPoolConfig) *workloadPools {
+	wp := &workloadPools{
+		byUsername:  make(map[string]*connpool.Pool),
+		byPrincipal: make(map[string]*connpool.Pool),
+		byName:      make(map[string]*connpool.Pool),
+	}
+	for i := range env.Config().WorkloadPools {
+		workload := &env.Config().WorkloadPools[i]
+		cfg := poolConfig(workload)
+		if cfg.Size == 0 {
+			continue
+		}
+		if cfg.IdleTimeoutSeconds == 0 {
+			cfg.IdleTimeoutSeconds = regular.IdleTimeoutSeconds
+		}
+		// The variables of the pool are named after the workload, e.g.
+		// ConnPoolAnalyticsCapacity.
+		pool := connpool.NewPool(env, name+strings.ToUpper(workload.Name[:1])+workload.Name[1:], cfg)
+		for _, username := range workload.Usernames {
+			wp.byUsername[username] = pool
+		}
+		for _, principal := range workload.Principals {
+			wp.byPrincipal[principal] = pool
+		}
+		wp.byName[workload.Name] = pool
+		wp.pools = append(wp.pools, pool)
+	}
+	return wp
+}
+
+// Open opens all the pools.
+func (wp *workloadPools) Open(appParams, dbaParams, appDebugParams dbconfigs.Connector) {
+	for _, pool := range wp.pools {
+		pool.Open(appParams, dbaParams, appDebugParams)
+	}
+}
+
+// Close closes all the pools.
+func (wp *workloadPools) Close() {
+	for _, pool := range wp.pools {
+		pool.Close()
+	}
+}
+
+// get returns the pool of the workload of the caller, or else of the
+// workload named by the label of the query, or regular if neither has a
+// pool of its own. The callers of a workload cannot label their queries out
+// of it.
+func (wp *workloadPools) get(ctx context.Context, workloadName string, regular *connpool.Pool) *connpool.Pool {
+	if len(wp.pools) == 0 {
+		return regular
+	}
+	if immediate := callerid.ImmediateCallerIDFromContext(ctx); immediate != nil {
+		if pool, ok := wp.byUsername[immediate.Username]; ok {
+			return pool
+		}
+	}
+	if effective := callerid.EffectiveCallerIDFromContext(ctx); effective != nil {
+		if pool, ok := wp.byPrincipal[effective.Principal]; ok {
+			return pool
+		}
+	}
+	if pool, ok := wp.byName[workloadName]; ok {
+		return pool
+	}
+	return regular
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestQueryExecutorWorkload(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "select /*vt+ WORKLOAD_NAME=reports PRIORITY=low */ * from test_table"
+	db.AddQuery("select /*vt+ WORKLOAD_NAME=reports PRIORITY=low */ * from test_table limit 10001", &sqltypes.Result{Fields: getTestTableFields()})
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+	options := &querypb.ExecuteOptions{WorkloadName: "batch", Priority: querypb.ExecuteOptions_HIGH_PRIORITY}
+
+	// The directives override the labels of the options.
+	qre := newTestQueryExecutor(ctx, tsv, query, 0)
+	qre.options = options
+	assert.Equal(t, "reports", qre.workloadName())
+	assert.Equal(t, querypb.ExecuteOptions_LOW_PRIORITY, qre.priority())
+	assert.Equal(t, "LOW_PRIORITY:select * from test_table", qre.consolidationKey("select * from test_table"))
+
+	plain := newTestQueryExecutor(ctx, tsv, "select * from test_table", 0)
+	assert.Equal(t, "", plain.workloadName())
+	assert.Equal(t, []string{"default", "normal"}, plain.workloadLabels())
+	assert.Equal(t, "select * from test_table", plain.consolidationKey("select * from test_table"))
+	plain.options = options
+	assert.Equal(t, "batch", plain.workloadName())
+	assert.Equal(t, querypb.ExecuteOptions_HIGH_PRIORITY, plain.priority())
+
+	// The lag throttler is disabled, so it lets the low priority query run.
+	tsv.config.ThrottleLowPriorityQueries = true
+	defer func() { tsv.config.ThrottleLowPriorityQueries = false }()
+	_, err := qre.Execute()
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, tsv.stats.WorkloadQueryTimings.Counts()["TabletServerTest.reports.low"])
+	assert.Empty(t, tsv.stats.WorkloadThrottled.Counts())
+
+	_, err = tsv.qe.GetPlan(ctx, tabletenv.NewLogStats(ctx, "TestQueryExecutor"), "select /*vt+ PRIORITY=urgent */ * from test_table", false, false /* inReservedConn */)
+	assert.EqualError(t, err, "invalid priority: urgent, it must be one of HIGH, NORMAL or LOW")
+}
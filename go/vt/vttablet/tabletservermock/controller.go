@@ -18,6 +18,7 @@ limitations under the License.
 package tabletservermock
 
 import (
+	"net/http"
 	"sync"
 
 	"google.golang.org/protobuf/proto"
@@ -34,6 +35,7 @@ import (
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/rules"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/throttle"
 	"vitess.io/vitess/go/vt/vttablet/vexec"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
@@ -95,6 +97,15 @@ type Controller struct {
 
 	// queryRulesMap has the latest query rules.
 	queryRulesMap map[string]*rules.Rules
+
+	// errantGTIDs are the errant GTIDs set by SetErrantGTIDs.
+	errantGTIDs string
+
+	// warmUpQueries are the queries of the calls to WarmUp.
+	warmUpQueries []*querypb.BoundQuery
+
+	// KilledQueries is the number of queries KillQueries returns.
+	KilledQueries int
 }
 
 // NewController returns a mock of tabletserver.Controller
@@ -221,11 +232,57 @@ func (tqsc *Controller) BroadcastHealth() {
 	}
 }
 
+// SetErrantGTIDs is part of the tabletserver.Controller interface.
+func (tqsc *Controller) SetErrantGTIDs(gtids string) {
+	tqsc.mu.Lock()
+	defer tqsc.mu.Unlock()
+
+	tqsc.errantGTIDs = gtids
+}
+
+// ErrantGTIDs allows a test to check what was set by SetErrantGTIDs.
+func (tqsc *Controller) ErrantGTIDs() string {
+	tqsc.mu.Lock()
+	defer tqsc.mu.Unlock()
+
+	return tqsc.errantGTIDs
+}
+
+// WarmUp is part of the tabletserver.Controller interface.
+func (tqsc *Controller) WarmUp(ctx context.Context, queries []*querypb.BoundQuery) int {
+	tqsc.mu.Lock()
+	defer tqsc.mu.Unlock()
+
+	tqsc.warmUpQueries = append(tqsc.warmUpQueries, queries...)
+	return len(queries)
+}
+
+// WarmUpQueries allows a test to check the queries passed to WarmUp.
+func (tqsc *Controller) WarmUpQueries() []*querypb.BoundQuery {
+	tqsc.mu.Lock()
+	defer tqsc.mu.Unlock()
+
+	return tqsc.warmUpQueries
+}
+
+// KillQueries is part of the tabletserver.Controller interface.
+func (tqsc *Controller) KillQueries(fingerprint, user string) int {
+	tqsc.mu.Lock()
+	defer tqsc.mu.Unlock()
+
+	return tqsc.KilledQueries
+}
+
 // TopoServer is part of the tabletserver.Controller interface.
 func (tqsc *Controller) TopoServer() *topo.Server {
 	return tqsc.TS
 }
 
+// CheckThrottler is part of the tabletserver.Controller interface.
+func (tqsc *Controller) CheckThrottler(ctx context.Context, appName string, remoteAddr string, flags *throttle.CheckFlags, checkType throttle.ThrottleCheckType) *throttle.CheckResult {
+	return &throttle.CheckResult{StatusCode: http.StatusOK}
+}
+
 // EnterLameduck implements tabletserver.Controller.
 func (tqsc *Controller) EnterLameduck() {
 	tqsc.mu.Lock()
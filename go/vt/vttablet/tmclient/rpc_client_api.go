@@ -65,12 +65,23 @@ type TabletManagerClient interface {
 	// ChangeType asks the remote tablet to change its type
 	ChangeType(ctx context.Context, tablet *topodatapb.Tablet, dbType topodatapb.TabletType) error
 
+	// ChangeTags asks the remote tablet to change its tags, and returns
+	// its new tags. A tag with an empty value is removed.
+	ChangeTags(ctx context.Context, tablet *topodatapb.Tablet, tags map[string]string, replace bool) (map[string]string, error)
+
 	// Sleep will sleep for a duration (used for tests)
 	Sleep(ctx context.Context, tablet *topodatapb.Tablet, duration time.Duration) error
 
 	// ExecuteHook executes the provided hook remotely
 	ExecuteHook(ctx context.Context, tablet *topodatapb.Tablet, hk *hook.Hook) (*hook.HookResult, error)
 
+	// GetLifecycleHooks returns the lifecycle hooks of the remote tablet and
+	// their recent executions
+	GetLifecycleHooks(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.GetLifecycleHooksResponse, error)
+
+	// GetVersion returns the version of the mysqld of the remote tablet
+	GetVersion(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.GetVersionResponse, error)
+
 	// RefreshState asks the remote tablet to reload its tablet record
 	RefreshState(ctx context.Context, tablet *topodatapb.Tablet) error
 
@@ -108,6 +119,10 @@ type TabletManagerClient interface {
 	// query faster. Close() should close the pool in that case.
 	ExecuteFetchAsApp(ctx context.Context, tablet *topodatapb.Tablet, usePool bool, query []byte, maxRows int) (*querypb.QueryResult, error)
 
+	// KillQueries kills the in-flight queries of a fingerprint and of a
+	// user on the remote tablet, and returns the number of killed queries.
+	KillQueries(ctx context.Context, tablet *topodatapb.Tablet, fingerprint, user string) (int64, error)
+
 	//
 	// Replication related methods
 	//
@@ -153,6 +168,14 @@ type TabletManagerClient interface {
 	VReplicationExec(ctx context.Context, tablet *topodatapb.Tablet, query string) (*querypb.QueryResult, error)
 	VReplicationWaitForPos(ctx context.Context, tablet *topodatapb.Tablet, id int, pos string) error
 
+	//
+	// Throttler related methods
+	//
+
+	// CheckThrottler runs a batch of checks of the tablet throttler, and
+	// returns their results in the same order.
+	CheckThrottler(ctx context.Context, tablet *topodatapb.Tablet, checks []*tabletmanagerdatapb.CheckThrottlerRequest_Check) ([]*tabletmanagerdatapb.CheckThrottlerResponse_Result, error)
+
 	//
 	// Reparenting related functions
 	//
@@ -210,6 +233,10 @@ type TabletManagerClient interface {
 	// reparent_journal table (if timeCreatedNS is non-zero).
 	SetReplicationSource(ctx context.Context, tablet *topodatapb.Tablet, parent *topodatapb.TabletAlias, timeCreatedNS int64, waitPosition string, forceStartReplication bool) error
 
+	// SetReplicationDelay tells a replica tablet to apply the events of its
+	// source with a delay. A zero delay makes it a regular replica again.
+	SetReplicationDelay(ctx context.Context, tablet *topodatapb.Tablet, delay time.Duration) error
+
 	// ReplicaWasRestarted tells the replica tablet its primary has changed
 	ReplicaWasRestarted(ctx context.Context, tablet *topodatapb.Tablet, parent *topodatapb.TabletAlias) error
 
@@ -224,12 +251,22 @@ type TabletManagerClient interface {
 	// Backup / restore related methods
 	//
 
-	// Backup creates a database backup
-	Backup(ctx context.Context, tablet *topodatapb.Tablet, concurrency int, allowPrimary bool) (logutil.EventStream, error)
+	// Backup creates a database backup. If compressionEngine is not empty,
+	// it overrides the compression engine of the tablet.
+	Backup(ctx context.Context, tablet *topodatapb.Tablet, concurrency int, allowPrimary bool, compressionEngine string) (logutil.EventStream, error)
 
 	// RestoreFromBackup deletes local data and restores database from backup
 	RestoreFromBackup(ctx context.Context, tablet *topodatapb.Tablet) (logutil.EventStream, error)
 
+	// GetRestoreProgress returns the progress of the restore from backup of
+	// the tablet
+	GetRestoreProgress(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.RestoreProgress, error)
+
+	// StreamArchivedBinlogs streams the binlogs archived for the shard of
+	// the tablet, from the segment that contains the transaction after the
+	// position.
+	StreamArchivedBinlogs(ctx context.Context, tablet *topodatapb.Tablet, position string, callback func(*tabletmanagerdatapb.StreamArchivedBinlogsResponse) error) error
+
 	//
 	// Management methods
 	//
@@ -393,6 +393,34 @@ func tmRPCTestChangeTypePanic(ctx context.Context, t *testing.T, client tmclient
 	expectHandleRPCPanic(t, "ChangeType", true /*verbose*/, err)
 }
 
+var (
+	testChangeTagsValue   = map[string]string{"ssd": "true", "pool": ""}
+	testChangeTagsReply   = map[string]string{"ssd": "true"}
+	testChangeTagsReplace = true
+)
+
+func (fra *fakeRPCTM) ChangeTags(ctx context.Context, tags map[string]string, replace bool) (map[string]string, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "ChangeTags tags", tags, testChangeTagsValue)
+	compare(fra.t, "ChangeTags replace", replace, testChangeTagsReplace)
+	return testChangeTagsReply, nil
+}
+
+func tmRPCTestChangeTags(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	tags, err := client.ChangeTags(ctx, tablet, testChangeTagsValue, testChangeTagsReplace)
+	if err != nil {
+		t.Errorf("ChangeTags failed: %v", err)
+	}
+	compare(t, "ChangeTags reply", tags, testChangeTagsReply)
+}
+
+func tmRPCTestChangeTagsPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.ChangeTags(ctx, tablet, testChangeTagsValue, testChangeTagsReplace)
+	expectHandleRPCPanic(t, "ChangeTags", true /*verbose*/, err)
+}
+
 var testSleepDuration = time.Minute
 
 func (fra *fakeRPCTM) Sleep(ctx context.Context, duration time.Duration) {
@@ -446,6 +474,62 @@ func tmRPCTestExecuteHookPanic(ctx context.Context, t *testing.T, client tmclien
 	expectHandleRPCPanic(t, "ExecuteHook", true /*verbose*/, err)
 }
 
+var testGetLifecycleHooksResponse = &tabletmanagerdatapb.GetLifecycleHooksResponse{
+	Hooks: []*tabletmanagerdatapb.LifecycleHook{{
+		Event:      "pre-backup",
+		Name:       "flush",
+		Parameters: []string{"-fast"},
+		Required:   true,
+	}},
+	Runs: []*tabletmanagerdatapb.LifecycleHookRun{{
+		Hook:       &tabletmanagerdatapb.LifecycleHook{Event: "pre-backup", Name: "flush"},
+		ExitStatus: 1,
+		Stderr:     "err",
+	}},
+}
+
+func (fra *fakeRPCTM) GetLifecycleHooks(ctx context.Context) *tabletmanagerdatapb.GetLifecycleHooksResponse {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	return testGetLifecycleHooksResponse
+}
+
+func tmRPCTestGetLifecycleHooks(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	response, err := client.GetLifecycleHooks(ctx, tablet)
+	compareError(t, "GetLifecycleHooks", err, response, testGetLifecycleHooksResponse)
+}
+
+func tmRPCTestGetLifecycleHooksPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.GetLifecycleHooks(ctx, tablet)
+	expectHandleRPCPanic(t, "GetLifecycleHooks", false /*verbose*/, err)
+}
+
+var testGetVersionResponse = &tabletmanagerdatapb.GetVersionResponse{
+	Version: "8.0.23-14",
+	Flavor:  "percona",
+	Major:   8,
+	Minor:   0,
+	Patch:   23,
+}
+
+func (fra *fakeRPCTM) GetVersion(ctx context.Context) (*tabletmanagerdatapb.GetVersionResponse, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	return testGetVersionResponse, nil
+}
+
+func tmRPCTestGetVersion(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	response, err := client.GetVersion(ctx, tablet)
+	compareError(t, "GetVersion", err, response, testGetVersionResponse)
+}
+
+func tmRPCTestGetVersionPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.GetVersion(ctx, tablet)
+	expectHandleRPCPanic(t, "GetVersion", false /*verbose*/, err)
+}
+
 var testRefreshStateCalled = false
 
 func (fra *fakeRPCTM) RefreshState(ctx context.Context) error {
@@ -700,6 +784,31 @@ func tmRPCTestExecuteFetchPanic(ctx context.Context, t *testing.T, client tmclie
 	expectHandleRPCPanic(t, "ExecuteFetchAsAllPrivs", false /*verbose*/, err)
 }
 
+var (
+	testKillQueriesFingerprint = "f1d2d2f924e986ac"
+	testKillQueriesUser        = "app"
+	testKillQueriesKilled      = 3
+)
+
+func (fra *fakeRPCTM) KillQueries(ctx context.Context, fingerprint, user string) int {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "KillQueries fingerprint", fingerprint, testKillQueriesFingerprint)
+	compare(fra.t, "KillQueries user", user, testKillQueriesUser)
+	return testKillQueriesKilled
+}
+
+func tmRPCTestKillQueries(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	killed, err := client.KillQueries(ctx, tablet, testKillQueriesFingerprint, testKillQueriesUser)
+	compareError(t, "KillQueries", err, killed, int64(testKillQueriesKilled))
+}
+
+func tmRPCTestKillQueriesPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.KillQueries(ctx, tablet, testKillQueriesFingerprint, testKillQueriesUser)
+	expectHandleRPCPanic(t, "KillQueries", true /*verbose*/, err)
+}
+
 //
 // Replication related methods
 //
@@ -926,6 +1035,38 @@ func tmRPCTestVReplicationWaitForPosPanic(ctx context.Context, t *testing.T, cli
 	expectHandleRPCPanic(t, "VReplicationWaitForPos", true /*verbose*/, err)
 }
 
+//
+// Throttler related methods
+//
+
+var testCheckThrottlerChecks = []*tabletmanagerdatapb.CheckThrottlerRequest_Check{
+	{AppName: "vreplication", LowPriority: true},
+	{AppName: "online-ddl", Self: true, OverrideThreshold: 5},
+}
+
+var testCheckThrottlerResults = []*tabletmanagerdatapb.CheckThrottlerResponse_Result{
+	{StatusCode: 200, Value: 0.5, Threshold: 1, MetricName: "lag"},
+	{StatusCode: 429, Value: 6, Threshold: 5, Error: "threshold exceeded", Message: "threshold exceeded", MetricName: "lag"},
+}
+
+func (fra *fakeRPCTM) CheckThrottler(ctx context.Context, checks []*tabletmanagerdatapb.CheckThrottlerRequest_Check) []*tabletmanagerdatapb.CheckThrottlerResponse_Result {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "CheckThrottler checks", checks, testCheckThrottlerChecks)
+	return testCheckThrottlerResults
+}
+
+func tmRPCTestCheckThrottler(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	results, err := client.CheckThrottler(ctx, tablet, testCheckThrottlerChecks)
+	compareError(t, "CheckThrottler", err, results, testCheckThrottlerResults)
+}
+
+func tmRPCTestCheckThrottlerPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.CheckThrottler(ctx, tablet, testCheckThrottlerChecks)
+	expectHandleRPCPanic(t, "CheckThrottler", false /*verbose*/, err)
+}
+
 //
 // Reparenting related functions
 //
@@ -1155,6 +1296,28 @@ func (fra *fakeRPCTM) StopReplicationAndGetStatus(ctx context.Context, stopRepli
 	}, nil
 }
 
+var testReplicationDelay = 15 * time.Minute
+var testSetReplicationDelayCalled = false
+
+func (fra *fakeRPCTM) SetReplicationDelay(ctx context.Context, delay time.Duration) error {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "SetReplicationDelay delay", delay, testReplicationDelay)
+	testSetReplicationDelayCalled = true
+	return nil
+}
+
+func tmRPCTestSetReplicationDelay(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	err := client.SetReplicationDelay(ctx, tablet, testReplicationDelay)
+	compareError(t, "SetReplicationDelay", err, true, testSetReplicationDelayCalled)
+}
+
+func tmRPCTestSetReplicationDelayPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	err := client.SetReplicationDelay(ctx, tablet, testReplicationDelay)
+	expectHandleRPCPanic(t, "SetReplicationDelay", true /*verbose*/, err)
+}
+
 var testReplicaWasRestartedParent = &topodatapb.TabletAlias{
 	Cell: "prison",
 	Uid:  42,
@@ -1215,22 +1378,24 @@ func tmRPCTestPromoteReplicaPanic(ctx context.Context, t *testing.T, client tmcl
 
 var testBackupConcurrency = 24
 var testBackupAllowPrimary = false
+var testBackupCompressionEngine = "zstd"
 var testBackupCalled = false
 var testRestoreFromBackupCalled = false
 
-func (fra *fakeRPCTM) Backup(ctx context.Context, concurrency int, logger logutil.Logger, allowPrimary bool) error {
+func (fra *fakeRPCTM) Backup(ctx context.Context, concurrency int, logger logutil.Logger, allowPrimary bool, compressionEngine string) error {
 	if fra.panics {
 		panic(fmt.Errorf("test-triggered panic"))
 	}
 	compare(fra.t, "Backup args", concurrency, testBackupConcurrency)
 	compare(fra.t, "Backup args", allowPrimary, testBackupAllowPrimary)
+	compare(fra.t, "Backup args", compressionEngine, testBackupCompressionEngine)
 	logStuff(logger, 10)
 	testBackupCalled = true
 	return nil
 }
 
 func tmRPCTestBackup(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
-	stream, err := client.Backup(ctx, tablet, testBackupConcurrency, testBackupAllowPrimary)
+	stream, err := client.Backup(ctx, tablet, testBackupConcurrency, testBackupAllowPrimary, testBackupCompressionEngine)
 	if err != nil {
 		t.Fatalf("Backup failed: %v", err)
 	}
@@ -1239,7 +1404,7 @@ func tmRPCTestBackup(ctx context.Context, t *testing.T, client tmclient.TabletMa
 }
 
 func tmRPCTestBackupPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
-	stream, err := client.Backup(ctx, tablet, testBackupConcurrency, testBackupAllowPrimary)
+	stream, err := client.Backup(ctx, tablet, testBackupConcurrency, testBackupAllowPrimary, testBackupCompressionEngine)
 	if err != nil {
 		t.Fatalf("Backup failed: %v", err)
 	}
@@ -1280,6 +1445,73 @@ func tmRPCTestRestoreFromBackupPanic(ctx context.Context, t *testing.T, client t
 	expectHandleRPCPanic(t, "RestoreFromBackup", true /*verbose*/, err)
 }
 
+var testRestoreProgress = &tabletmanagerdatapb.RestoreProgress{
+	InProgress:    true,
+	FilesTotal:    10,
+	FilesRestored: 4,
+	BytesTotal:    1000,
+	BytesRestored: 400,
+}
+
+func (fra *fakeRPCTM) GetRestoreProgress(ctx context.Context) *tabletmanagerdatapb.RestoreProgress {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	return testRestoreProgress
+}
+
+func tmRPCTestGetRestoreProgress(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	progress, err := client.GetRestoreProgress(ctx, tablet)
+	compareError(t, "GetRestoreProgress", err, progress, testRestoreProgress)
+}
+
+func tmRPCTestGetRestoreProgressPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.GetRestoreProgress(ctx, tablet)
+	expectHandleRPCPanic(t, "GetRestoreProgress", false /*verbose*/, err)
+}
+
+var testStreamArchivedBinlogsPosition = "MySQL56/1d34d3f0-3c4e-11eb-9f02-0242ac110002:1-10"
+var testStreamArchivedBinlogsResponses = []*tabletmanagerdatapb.StreamArchivedBinlogsResponse{{
+	Segment: "segment1",
+	Events:  [][]byte{[]byte("event1"), []byte("event2")},
+}, {
+	Segment:  "segment1",
+	Events:   [][]byte{[]byte("event3")},
+	Position: "MySQL56/1d34d3f0-3c4e-11eb-9f02-0242ac110002:1-20",
+}}
+
+func (fra *fakeRPCTM) StreamArchivedBinlogs(ctx context.Context, position string, callback func(*tabletmanagerdatapb.StreamArchivedBinlogsResponse) error) error {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "StreamArchivedBinlogs position", position, testStreamArchivedBinlogsPosition)
+	for _, response := range testStreamArchivedBinlogsResponses {
+		if err := callback(response); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func tmRPCTestStreamArchivedBinlogs(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	var responses []*tabletmanagerdatapb.StreamArchivedBinlogsResponse
+	err := client.StreamArchivedBinlogs(ctx, tablet, testStreamArchivedBinlogsPosition, func(response *tabletmanagerdatapb.StreamArchivedBinlogsResponse) error {
+		responses = append(responses, response)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("StreamArchivedBinlogs failed: %v", err)
+	}
+	compare(t, "StreamArchivedBinlogs responses", responses, testStreamArchivedBinlogsResponses)
+}
+
+func tmRPCTestStreamArchivedBinlogsPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	err := client.StreamArchivedBinlogs(ctx, tablet, testStreamArchivedBinlogsPosition, func(response *tabletmanagerdatapb.StreamArchivedBinlogsResponse) error {
+		return nil
+	})
+	expectHandleRPCPanic(t, "StreamArchivedBinlogs", false /*verbose*/, err)
+}
+
 //
 // RPC helpers
 //
@@ -1313,8 +1545,11 @@ func Run(t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.T
 	// Various read-write methods
 	tmRPCTestSetReadOnly(ctx, t, client, tablet)
 	tmRPCTestChangeType(ctx, t, client, tablet)
+	tmRPCTestChangeTags(ctx, t, client, tablet)
 	tmRPCTestSleep(ctx, t, client, tablet)
 	tmRPCTestExecuteHook(ctx, t, client, tablet)
+	tmRPCTestGetLifecycleHooks(ctx, t, client, tablet)
+	tmRPCTestGetVersion(ctx, t, client, tablet)
 	tmRPCTestRefreshState(ctx, t, client, tablet)
 	tmRPCTestRunHealthCheck(ctx, t, client, tablet)
 	tmRPCTestIgnoreHealthError(ctx, t, client, tablet)
@@ -1322,6 +1557,7 @@ func Run(t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.T
 	tmRPCTestPreflightSchema(ctx, t, client, tablet)
 	tmRPCTestApplySchema(ctx, t, client, tablet)
 	tmRPCTestExecuteFetch(ctx, t, client, tablet)
+	tmRPCTestKillQueries(ctx, t, client, tablet)
 
 	// Replication related methods
 	tmRPCTestMasterPosition(ctx, t, client, tablet)
@@ -1338,6 +1574,9 @@ func Run(t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.T
 	tmRPCTestVReplicationExec(ctx, t, client, tablet)
 	tmRPCTestVReplicationWaitForPos(ctx, t, client, tablet)
 
+	// Throttler related methods
+	tmRPCTestCheckThrottler(ctx, t, client, tablet)
+
 	// Reparenting related functions
 	tmRPCTestResetReplication(ctx, t, client, tablet)
 	tmRPCTestInitMaster(ctx, t, client, tablet)
@@ -1351,10 +1590,13 @@ func Run(t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.T
 	tmRPCTestInitReplica(ctx, t, client, tablet)
 	tmRPCTestReplicaWasPromoted(ctx, t, client, tablet)
 	tmRPCTestReplicaWasRestarted(ctx, t, client, tablet)
+	tmRPCTestSetReplicationDelay(ctx, t, client, tablet)
 
 	// Backup / restore related methods
 	tmRPCTestBackup(ctx, t, client, tablet)
 	tmRPCTestRestoreFromBackup(ctx, t, client, tablet)
+	tmRPCTestGetRestoreProgress(ctx, t, client, tablet)
+	tmRPCTestStreamArchivedBinlogs(ctx, t, client, tablet)
 
 	//
 	// Tests panic handling everywhere now
@@ -1369,8 +1611,11 @@ func Run(t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.T
 	// Various read-write methods
 	tmRPCTestSetReadOnlyPanic(ctx, t, client, tablet)
 	tmRPCTestChangeTypePanic(ctx, t, client, tablet)
+	tmRPCTestChangeTagsPanic(ctx, t, client, tablet)
 	tmRPCTestSleepPanic(ctx, t, client, tablet)
 	tmRPCTestExecuteHookPanic(ctx, t, client, tablet)
+	tmRPCTestGetLifecycleHooksPanic(ctx, t, client, tablet)
+	tmRPCTestGetVersionPanic(ctx, t, client, tablet)
 	tmRPCTestRefreshStatePanic(ctx, t, client, tablet)
 	tmRPCTestRunHealthCheckPanic(ctx, t, client, tablet)
 	tmRPCTestIgnoreHealthErrorPanic(ctx, t, client, tablet)
@@ -1378,6 +1623,7 @@ func Run(t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.T
 	tmRPCTestPreflightSchemaPanic(ctx, t, client, tablet)
 	tmRPCTestApplySchemaPanic(ctx, t, client, tablet)
 	tmRPCTestExecuteFetchPanic(ctx, t, client, tablet)
+	tmRPCTestKillQueriesPanic(ctx, t, client, tablet)
 
 	// Replication related methods
 	tmRPCTestMasterPositionPanic(ctx, t, client, tablet)
@@ -1390,6 +1636,9 @@ func Run(t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.T
 	tmRPCTestVReplicationExecPanic(ctx, t, client, tablet)
 	tmRPCTestVReplicationWaitForPosPanic(ctx, t, client, tablet)
 
+	// Throttler related methods
+	tmRPCTestCheckThrottlerPanic(ctx, t, client, tablet)
+
 	// Reparenting related functions
 	tmRPCTestResetReplicationPanic(ctx, t, client, tablet)
 	tmRPCTestInitMasterPanic(ctx, t, client, tablet)
@@ -1403,9 +1652,12 @@ func Run(t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.T
 	tmRPCTestInitReplicaPanic(ctx, t, client, tablet)
 	tmRPCTestReplicaWasPromotedPanic(ctx, t, client, tablet)
 	tmRPCTestReplicaWasRestartedPanic(ctx, t, client, tablet)
+	tmRPCTestSetReplicationDelayPanic(ctx, t, client, tablet)
 	// Backup / restore related methods
 	tmRPCTestBackupPanic(ctx, t, client, tablet)
 	tmRPCTestRestoreFromBackupPanic(ctx, t, client, tablet)
+	tmRPCTestGetRestoreProgressPanic(ctx, t, client, tablet)
+	tmRPCTestStreamArchivedBinlogsPanic(ctx, t, client, tablet)
 
 	client.Close()
 }
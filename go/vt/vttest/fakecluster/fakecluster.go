@@ -0,0 +1,402 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package fakecluster provides an in-memory Vitess cluster that applications
+// can embed in their unit tests, without launching MySQL or any Vitess
+// process.
+//
+// The cluster is built from the vschema and the schema of the application
+// with the vtexplain simulator: the queries are planned and routed by a real
+// vtgate executor, and sent to fake tablets that answer with synthetic rows.
+// The application sends its queries through the database/sql driver of
+// vitessdriver, and the tests then assert how they were routed and planned:
+//
+//	cluster, err := fakecluster.New(fakecluster.Config{VSchema: vschema, Schema: schema})
+//	...
+//	defer cluster.Close()
+//	db, err := cluster.DB()
+//	...
+//	rows, err := db.Query("select name from user where id = ?", 1)
+//	...
+//	assert.Equal(t, []string{"user/-80"}, cluster.LastQuery().Tablets())
+//
+// Tests can also register canned results, which are returned to the
+// application instead of the synthetic rows.
+//
+// The vtexplain simulator is global, so only one cluster can run at a time.
+package fakecluster
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vitessdriver"
+	"vitess.io/vitess/go/vt/vtexplain"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/vtgateconn"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+// Protocol is the vtgateconn protocol that connects to the running cluster.
+const Protocol = "fakecluster"
+
+var (
+	runningMu sync.Mutex
+	running   *Cluster
+)
+
+func init() {
+	vtgateconn.RegisterDialer(Protocol, dial)
+}
+
+// Config describes the keyspaces and the tables of a cluster.
+type Config struct {
+	// VSchema maps the name of each keyspace to its vschema, in JSON, like
+	// the -vschema input of vtexplain.
+	VSchema string
+
+	// Schema has the CREATE TABLE statements of the tables of all the
+	// keyspaces.
+	Schema string
+
+	// KeyspaceShardMap optionally maps the name of each keyspace to its
+	// shards, in JSON, like the -ks-shard-map input of vtexplain. The
+	// keyspaces that it does not have get NumShards shards if they are
+	// sharded, and a single one otherwise.
+	KeyspaceShardMap string
+
+	// NumShards is the number of shards of the sharded keyspaces. It
+	// defaults to 2.
+	NumShards int
+
+	// Target is the target of the sessions of the connections, e.g. the
+	// keyspace that the queries of the application use by default.
+	Target string
+}
+
+// Query is a query that the application sent to the cluster.
+type Query struct {
+	// SQL is the query, as the application sent it.
+	SQL string
+
+	// BindVars are the bind variables of the query.
+	BindVars map[string]*querypb.BindVariable
+
+	// Explain has the vtgate plans of the query, and the queries that were
+	// sent to each tablet.
+	Explain *vtexplain.Explain
+}
+
+// Tablets returns the sorted names of the tablets that the query was routed
+// to, as <keyspace>/<shard>.
+func (q *Query) Tablets() []string {
+	tablets := make([]string, 0, len(q.Explain.TabletActions))
+	for tablet := range q.Explain.TabletActions {
+		tablets = append(tablets, tablet)
+	}
+	sort.Strings(tablets)
+	return tablets
+}
+
+// TabletQueries returns the queries that were sent to a tablet, named as
+// <keyspace>/<shard>.
+func (q *Query) TabletQueries(tablet string) []string {
+	actions, ok := q.Explain.TabletActions[tablet]
+	if !ok {
+		return nil
+	}
+	queries := make([]string, 0, len(actions.TabletQueries))
+	for _, tq := range actions.TabletQueries {
+		queries = append(queries, tq.SQL)
+	}
+	return queries
+}
+
+// RouteTypes returns the route types of the primitives at the leaves of the
+// plans of the query, e.g. SelectEqualUnique or SelectScatter.
+func (q *Query) RouteTypes() []string {
+	var routeTypes []string
+	var visit func(engine.Primitive)
+	visit = func(p engine.Primitive) {
+		inputs := p.Inputs()
+		if len(inputs) == 0 {
+			routeTypes = append(routeTypes, p.RouteType())
+			return
+		}
+		for _, input := range inputs {
+			visit(input)
+		}
+	}
+	for _, plan := range q.Explain.Plans {
+		if plan.Instructions != nil {
+			visit(plan.Instructions)
+		}
+	}
+	return routeTypes
+}
+
+// Cluster is an in-memory Vitess cluster.
+type Cluster struct {
+	target string
+
+	mu      sync.Mutex
+	results map[string]*sqltypes.Result
+	queries []*Query
+}
+
+// New starts a cluster. It must be closed before another one is started.
+func New(config Config) (*Cluster, error) {
+	runningMu.Lock()
+	defer runningMu.Unlock()
+
+	if running != nil {
+		return nil, errors.New("a fake cluster is already running, only one can run at a time")
+	}
+
+	numShards := config.NumShards
+	if numShards == 0 {
+		numShards = 2
+	}
+	opts := &vtexplain.Options{
+		NumShards:       numShards,
+		ReplicationMode: "ROW",
+		Normalize:       true,
+		ExecutionMode:   vtexplain.ModeMulti,
+		Target:          config.Target,
+	}
+	if err := vtexplain.Init(config.VSchema, config.Schema, config.KeyspaceShardMap, opts); err != nil {
+		vtexplain.Stop()
+		return nil, fmt.Errorf("cannot start the fake cluster: %v", err)
+	}
+
+	running = &Cluster{
+		target:  config.Target,
+		results: make(map[string]*sqltypes.Result),
+	}
+	return running, nil
+}
+
+// Close stops the cluster.
+func (c *Cluster) Close() {
+	runningMu.Lock()
+	defer runningMu.Unlock()
+
+	if running != c {
+		return
+	}
+	vtexplain.Stop()
+	running = nil
+}
+
+// DB returns a database/sql handle to the cluster.
+func (c *Cluster) DB() (*sql.DB, error) {
+	return vitessdriver.OpenWithConfiguration(vitessdriver.Configuration{
+		Protocol: Protocol,
+		Address:  Protocol,
+		Target:   c.target,
+	})
+}
+
+// AddQueryResult sets the result that the cluster returns for a query,
+// instead of the rows synthesized by the fake tablets. The query is still
+// planned and routed. It is matched regardless of its formatting, and with
+// the placeholders of its bind variables, as the application sends it.
+func (c *Cluster) AddQueryResult(query string, result *sqltypes.Result) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.results[canonicalQuery(query)] = result
+}
+
+// Queries returns the queries that the application sent to the cluster, in
+// order, except for the transaction statements.
+func (c *Cluster) Queries() []*Query {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return append([]*Query(nil), c.queries...)
+}
+
+// LastQuery returns the last query that the application sent to the
+// cluster, or nil if there is none.
+func (c *Cluster) LastQuery() *Query {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.queries) == 0 {
+		return nil
+	}
+	return c.queries[len(c.queries)-1]
+}
+
+// ResetQueries forgets the queries that the application sent to the cluster.
+func (c *Cluster) ResetQueries() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.queries = nil
+}
+
+// execute runs a query in a session. The queries are serialized, since the
+// vtexplain simulator is global.
+func (c *Cluster) execute(session *vtgatepb.Session, query string, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	explain, result, err := vtexplain.Execute(session, query, bindVars)
+	if err != nil {
+		return nil, err
+	}
+
+	switch sqlparser.Preview(query) {
+	case sqlparser.StmtBegin, sqlparser.StmtCommit, sqlparser.StmtRollback:
+		return result, nil
+	}
+	c.queries = append(c.queries, &Query{
+		SQL:      query,
+		BindVars: bindVars,
+		Explain:  explain,
+	})
+	if canned, ok := c.results[canonicalQuery(query)]; ok {
+		return canned.Copy(), nil
+	}
+	return result, nil
+}
+
+// canonicalQuery formats a query the way sqlparser does, so that queries
+// match regardless of their formatting.
+func canonicalQuery(query string) string {
+	stmt, err := sqlparser.Parse(query)
+	if err != nil {
+		return query
+	}
+	return sqlparser.String(stmt)
+}
+
+// conn is the vtgateconn.Impl of the cluster.
+type conn struct {
+	cluster *Cluster
+}
+
+var _ vtgateconn.Impl = (*conn)(nil)
+
+func dial(ctx context.Context, address string) (vtgateconn.Impl, error) {
+	runningMu.Lock()
+	defer runningMu.Unlock()
+
+	if running == nil {
+		return nil, errors.New("no fake cluster is running")
+	}
+	return &conn{cluster: running}, nil
+}
+
+// Execute is part of the vtgateconn.Impl interface.
+func (c *conn) Execute(ctx context.Context, session *vtgatepb.Session, query string, bindVars map[string]*querypb.BindVariable) (*vtgatepb.Session, *sqltypes.Result, error) {
+	result, err := c.cluster.execute(session, query, bindVars)
+	return session, result, err
+}
+
+// ExecuteBatch is part of the vtgateconn.Impl interface.
+func (c *conn) ExecuteBatch(ctx context.Context, session *vtgatepb.Session, queryList []string, bindVarsList []map[string]*querypb.BindVariable) (*vtgatepb.Session, []sqltypes.QueryResponse, error) {
+	responses := make([]sqltypes.QueryResponse, len(queryList))
+	for i, query := range queryList {
+		var bindVars map[string]*querypb.BindVariable
+		if len(bindVarsList) != 0 {
+			bindVars = bindVarsList[i]
+		}
+		result, err := c.cluster.execute(session, query, bindVars)
+		responses[i] = sqltypes.QueryResponse{QueryResult: result, QueryError: err}
+	}
+	return session, responses, nil
+}
+
+// StreamExecute is part of the vtgateconn.Impl interface.
+func (c *conn) StreamExecute(ctx context.Context, session *vtgatepb.Session, query string, bindVars map[string]*querypb.BindVariable) (sqltypes.ResultStream, error) {
+	result, err := c.cluster.execute(session, query, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	return &resultStream{result: result}, nil
+}
+
+// Prepare is part of the vtgateconn.Impl interface.
+func (c *conn) Prepare(ctx context.Context, session *vtgatepb.Session, query string, bindVars map[string]*querypb.BindVariable) (*vtgatepb.Session, []*querypb.Field, error) {
+	return nil, nil, errors.New("Prepare is not supported by the fake cluster")
+}
+
+// StreamExecuteBatch is part of the vtgateconn.Impl interface.
+func (c *conn) StreamExecuteBatch(ctx context.Context, session *vtgatepb.Session, queries []*vtgatepb.BatchQuery, abortOnError bool) (vtgateconn.BatchResultStream, error) {
+	return nil, errors.New("StreamExecuteBatch is not supported by the fake cluster")
+}
+
+// CloseSession is part of the vtgateconn.Impl interface.
+func (c *conn) CloseSession(ctx context.Context, session *vtgatepb.Session) error {
+	if !session.GetInTransaction() {
+		return nil
+	}
+	_, err := c.cluster.execute(session, "rollback", nil)
+	return err
+}
+
+// ExportSession is part of the vtgateconn.Impl interface.
+func (c *conn) ExportSession(ctx context.Context, session *vtgatepb.Session) (string, error) {
+	return "", errors.New("ExportSession is not supported by the fake cluster")
+}
+
+// ImportSession is part of the vtgateconn.Impl interface.
+func (c *conn) ImportSession(ctx context.Context, token string) (*vtgatepb.Session, error) {
+	return nil, errors.New("ImportSession is not supported by the fake cluster")
+}
+
+// ResolveTransaction is part of the vtgateconn.Impl interface.
+func (c *conn) ResolveTransaction(ctx context.Context, dtid string) error {
+	return errors.New("ResolveTransaction is not supported by the fake cluster")
+}
+
+// VStream is part of the vtgateconn.Impl interface.
+func (c *conn) VStream(ctx context.Context, tabletType topodatapb.TabletType, vgtid *binlogdatapb.VGtid, filter *binlogdatapb.Filter, flags *vtgatepb.VStreamFlags) (vtgateconn.VStreamReader, error) {
+	return nil, errors.New("VStream is not supported by the fake cluster")
+}
+
+// Close is part of the vtgateconn.Impl interface.
+func (c *conn) Close() {
+}
+
+// resultStream streams a single result.
+type resultStream struct {
+	result *sqltypes.Result
+}
+
+// Recv is part of the sqltypes.ResultStream interface.
+func (s *resultStream) Recv() (*sqltypes.Result, error) {
+	if s.result == nil {
+		return nil, io.EOF
+	}
+	result := s.result
+	s.result = nil
+	return result, nil
+}
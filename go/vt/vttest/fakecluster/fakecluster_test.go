@@ -0,0 +1,114 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fakecluster
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+)
+
+const testVSchema = `{
+	"user": {
+		"sharded": true,
+		"vindexes": {
+			"hash": {"type": "hash"}
+		},
+		"tables": {
+			"user": {
+				"column_vindexes": [{"column": "id", "name": "hash"}]
+			}
+		}
+	},
+	"main": {
+		"tables": {
+			"settings": {}
+		}
+	}
+}`
+
+const testSchema = `
+create table user (
+	id bigint,
+	name varchar(64),
+	primary key (id)
+);
+
+create table settings (
+	name varchar(64),
+	value varchar(64),
+	primary key (name)
+);
+`
+
+func TestCluster(t *testing.T) {
+	cluster, err := New(Config{VSchema: testVSchema, Schema: testSchema, Target: "user"})
+	require.NoError(t, err)
+	defer cluster.Close()
+
+	_, err = New(Config{VSchema: testVSchema, Schema: testSchema})
+	assert.EqualError(t, err, "a fake cluster is already running, only one can run at a time")
+
+	db, err := cluster.DB()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// A query on the sharding key is routed to a single shard.
+	var name string
+	err = db.QueryRow("select name from user where id = ?", 1).Scan(&name)
+	require.NoError(t, err)
+	query := cluster.LastQuery()
+	assert.Equal(t, []string{"user/-80"}, query.Tablets())
+	assert.Equal(t, []string{"SelectEqualUnique"}, query.RouteTypes())
+	assert.Equal(t, 1, len(query.TabletQueries("user/-80")))
+
+	// Other queries are scattered.
+	rows, err := db.Query("select id, name from user where name = 'alice'")
+	require.NoError(t, err)
+	require.NoError(t, rows.Close())
+	query = cluster.LastQuery()
+	assert.Equal(t, []string{"user/-80", "user/80-"}, query.Tablets())
+	assert.Equal(t, []string{"SelectScatter"}, query.RouteTypes())
+
+	// The canned results are returned instead of the synthetic rows.
+	cluster.AddQueryResult("select value from main.settings where name = 'color'", sqltypes.MakeTestResult(sqltypes.MakeTestFields("value", "varchar"), "blue"))
+	var value string
+	err = db.QueryRow("select value from main.settings   where name = 'color'").Scan(&value)
+	require.NoError(t, err)
+	assert.Equal(t, "blue", value)
+	assert.Equal(t, []string{"main/-"}, cluster.LastQuery().Tablets())
+
+	// The transaction statements are not recorded.
+	cluster.ResetQueries()
+	tx, err := db.Begin()
+	require.NoError(t, err)
+	_, err = tx.Exec("insert into user (id, name) values (1, 'alice'), (4, 'bob')")
+	require.NoError(t, err)
+	require.NoError(t, tx.Commit())
+	queries := cluster.Queries()
+	require.Len(t, queries, 1)
+	assert.Equal(t, "insert into user (id, name) values (1, 'alice'), (4, 'bob')", queries[0].SQL)
+	assert.Equal(t, []string{"user/-80", "user/80-"}, queries[0].Tablets())
+
+	cluster.Close()
+	cluster, err = New(Config{VSchema: testVSchema, Schema: testSchema})
+	require.NoError(t, err)
+	cluster.Close()
+}
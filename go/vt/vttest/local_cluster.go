@@ -291,6 +291,10 @@ func (db *LocalCluster) Setup() error {
 			return err
 		}
 
+		if err := db.loadSeedFiles(); err != nil {
+			return err
+		}
+
 		if db.Seed != nil {
 			log.Info("Populating database with random data...")
 			if err := db.populateWithRandomData(); err != nil {
@@ -424,6 +428,69 @@ func (db *LocalCluster) loadSchema(shouldRunDatabaseMigrations bool) error {
 	return nil
 }
 
+// loadSeedFiles executes the seed files of each keyspace in the topology.
+// The statements are sent to vtgate, so that the rows are routed to their
+// shards by the vschema of the keyspace. If only MySQL is started, they are
+// executed on the database of each shard.
+func (db *LocalCluster) loadSeedFiles() error {
+	for _, kpb := range db.Topology.Keyspaces {
+		if kpb.ServedFrom != "" || len(kpb.SeedFiles) == 0 {
+			continue
+		}
+
+		log.Infof("Loading the seed files of keyspace %s...", kpb.Name)
+		for _, file := range kpb.SeedFiles {
+			cmds, err := LoadSQLFile(file, path.Dir(file))
+			if err != nil {
+				return err
+			}
+
+			if !db.OnlyMySQL {
+				if err := db.executeOnVtgate(cmds, kpb.Name); err != nil {
+					return fmt.Errorf("cannot load seed file %s for keyspace %s: %v", file, kpb.Name, err)
+				}
+				continue
+			}
+
+			for _, dbname := range db.shardNames(kpb) {
+				if err := db.Execute(cmds, dbname); err != nil {
+					return fmt.Errorf("cannot load seed file %s for keyspace %s: %v", file, kpb.Name, err)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// executeOnVtgate runs a series of SQL statements on a keyspace through the
+// mysql protocol port of vtcombo.
+func (db *LocalCluster) executeOnVtgate(sql []string, keyspace string) error {
+	host := db.MySQLBindHost
+	if host == "" {
+		host = "localhost"
+	}
+	params := mysql.ConnParams{
+		Host:   host,
+		Port:   db.Env.PortForProtocol("vtcombo_mysql_port", ""),
+		DbName: keyspace,
+	}
+	conn, err := mysql.Connect(context.Background(), &params)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	for _, cmd := range sql {
+		log.Infof("Execute(%s): \"%s\"", keyspace, cmd)
+		if _, err := conn.ExecuteFetch(cmd, 0, false); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (db *LocalCluster) createDatabases() error {
 	log.Info("Creating databases in cluster...")
 
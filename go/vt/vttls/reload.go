@@ -0,0 +1,225 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vttls
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+
+	"github.com/fsnotify/fsnotify"
+
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// certificateWatcher keeps a certificate and a CA pool up to date with the
+// files they are loaded from. It watches the directories of the files
+// rather than the files themselves, so that it follows the atomic
+// replacements of the files, as done for instance for the Kubernetes
+// secrets.
+type certificateWatcher struct {
+	cert, key, ca, serverCA string
+
+	// certificate holds a *tls.Certificate, if cert and key are set.
+	certificate atomic.Value
+	// caPool holds a *x509.CertPool, if ca is set.
+	caPool atomic.Value
+}
+
+var certificateWatchers = sync.Map{}
+
+// loadCertificateWatcher returns the watcher of the files, so that the
+// configs built for every new connection share a single watcher.
+func loadCertificateWatcher(cert, key, ca, serverCA string) (*certificateWatcher, error) {
+	identifier := tlsCertificatesIdentifier("watcher", cert, key, ca, serverCA)
+	once, _ := onceByKeys.LoadOrStore(identifier, &sync.Once{})
+
+	var err error
+	once.(*sync.Once).Do(func() {
+		var w *certificateWatcher
+		if w, err = newCertificateWatcher(cert, key, ca, serverCA); err == nil {
+			certificateWatchers.Store(identifier, w)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result, ok := certificateWatchers.Load(identifier)
+	if !ok {
+		return nil, vterrors.Errorf(vtrpc.Code_NOT_FOUND, "Cannot find tls certificate watcher with cert: %s, key: %s, ca: %s", cert, key, ca)
+	}
+
+	return result.(*certificateWatcher), nil
+}
+
+func newCertificateWatcher(cert, key, ca, serverCA string) (*certificateWatcher, error) {
+	w := &certificateWatcher{
+		cert:     cert,
+		key:      key,
+		ca:       ca,
+		serverCA: serverCA,
+	}
+	if err := w.load(); err != nil {
+		return nil, err
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "cannot watch the tls certificates")
+	}
+	dirs := make(map[string]bool)
+	for _, file := range []string{cert, key, ca, serverCA} {
+		if file != "" {
+			dirs[filepath.Dir(file)] = true
+		}
+	}
+	for dir := range dirs {
+		if err := watcher.Add(dir); err != nil {
+			watcher.Close()
+			return nil, vterrors.Wrapf(err, "cannot watch the tls certificates in %s", dir)
+		}
+	}
+	go w.watch(watcher)
+
+	return w, nil
+}
+
+// load reads the files, and only replaces the certificate and CA pool if
+// all of them could be read.
+func (w *certificateWatcher) load() error {
+	var crt tls.Certificate
+	var err error
+	if w.cert != "" && w.key != "" {
+		if w.serverCA != "" {
+			crt, err = readCombinedTLSCertificate(w.serverCA, w.cert, w.key)
+		} else {
+			crt, err = readTLSCertificate(w.cert, w.key)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	var cp *x509.CertPool
+	if w.ca != "" {
+		cp, err = readx509CertPool(w.ca)
+		if err != nil {
+			return err
+		}
+	}
+
+	if w.cert != "" && w.key != "" {
+		w.certificate.Store(&crt)
+	}
+	if cp != nil {
+		w.caPool.Store(cp)
+	}
+	return nil
+}
+
+func (w *certificateWatcher) watch(watcher *fsnotify.Watcher) {
+	defer watcher.Close()
+	for {
+		select {
+		case _, ok := <-watcher.Events:
+			if !ok {
+				return
+			}
+			// A file may be caught while it is only partially written, or
+			// the certificate while its key is not yet replaced. The
+			// current certificate is kept until the next event then.
+			if err := w.load(); err != nil {
+				log.Warningf("Cannot reload tls certificate %s: %v", w.cert, err)
+				continue
+			}
+			log.Infof("Reloaded tls certificate %s", w.cert)
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return
+			}
+			log.Warningf("Error watching tls certificate %s: %v", w.cert, err)
+		}
+	}
+}
+
+func (w *certificateWatcher) getCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	return w.certificate.Load().(*tls.Certificate), nil
+}
+
+func (w *certificateWatcher) getClientCertificate(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	return w.certificate.Load().(*tls.Certificate), nil
+}
+
+func (w *certificateWatcher) getCAPool() *x509.CertPool {
+	return w.caPool.Load().(*x509.CertPool)
+}
+
+// ServerConfigWithReload returns the TLS config to use for a server to
+// accept client connections, like ServerConfig, except that its certificate
+// and CA are reloaded whenever their files change.
+func ServerConfigWithReload(cert, key, ca, serverCA string, minTLSVersion uint16) (*tls.Config, error) {
+	w, err := loadCertificateWatcher(cert, key, ca, serverCA)
+	if err != nil {
+		return nil, err
+	}
+	if w.certificate.Load() == nil {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "a server tls config requires a certificate and a key")
+	}
+
+	config := newTLSConfig(minTLSVersion)
+	config.GetCertificate = w.getCertificate
+
+	// if specified, load ca to validate client,
+	// and enforce clients present valid certs.
+	if ca != "" {
+		config.ClientCAs = w.getCAPool()
+		config.ClientAuth = tls.RequireAndVerifyClientCert
+		config.GetConfigForClient = func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			clientConfig := config.Clone()
+			clientConfig.ClientCAs = w.getCAPool()
+			clientConfig.GetConfigForClient = nil
+			return clientConfig, nil
+		}
+	}
+
+	return config, nil
+}
+
+// ClientConfigWithReload returns the TLS config to use for a client to
+// connect to a server, like ClientConfig, except that its certificate is
+// reloaded whenever its files change.
+func ClientConfigWithReload(mode SslMode, cert, key, ca, name string, minTLSVersion uint16) (*tls.Config, error) {
+	config, err := ClientConfig(mode, "", "", ca, name, minTLSVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	if cert != "" && key != "" {
+		w, err := loadCertificateWatcher(cert, key, "", "")
+		if err != nil {
+			return nil, err
+		}
+		config.GetClientCertificate = w.getClientCertificate
+	}
+
+	return config, nil
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vttls
+
+import (
+	"crypto/tls"
+	"net/url"
+
+	"vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// spiffeIDMatcher matches the SPIFFE ID of a peer, carried as the URI SAN
+// of its X509-SVID certificate, against allowed IDs and trust domains.
+type spiffeIDMatcher struct {
+	ids          map[string]bool
+	trustDomains map[string]bool
+}
+
+// newSPIFFEIDMatcher parses the allowed SPIFFE IDs. An ID without a path,
+// such as spiffe://example.org, allows all the IDs of its trust domain.
+func newSPIFFEIDMatcher(allowed []string) (*spiffeIDMatcher, error) {
+	if len(allowed) == 0 {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "no SPIFFE ID is allowed")
+	}
+
+	m := &spiffeIDMatcher{
+		ids:          make(map[string]bool),
+		trustDomains: make(map[string]bool),
+	}
+	for _, id := range allowed {
+		u, err := url.Parse(id)
+		if err != nil || u.Scheme != "spiffe" || u.Host == "" {
+			return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "invalid SPIFFE ID %q: must be spiffe://<trust domain>[/<path>]", id)
+		}
+		if u.Path == "" || u.Path == "/" {
+			m.trustDomains[u.Host] = true
+		} else {
+			m.ids[u.String()] = true
+		}
+	}
+	return m, nil
+}
+
+// verify checks that the certificate of the peer carries an allowed
+// SPIFFE ID. The certificate chain must be verified separately.
+func (m *spiffeIDMatcher) verify(cs tls.ConnectionState) error {
+	if len(cs.PeerCertificates) == 0 {
+		return vterrors.Errorf(vtrpc.Code_UNAUTHENTICATED, "peer presented no certificate")
+	}
+	for _, u := range cs.PeerCertificates[0].URIs {
+		if u.Scheme != "spiffe" {
+			continue
+		}
+		if m.ids[u.String()] || m.trustDomains[u.Host] {
+			return nil
+		}
+		return vterrors.Errorf(vtrpc.Code_PERMISSION_DENIED, "peer SPIFFE ID %s is not allowed", u)
+	}
+	return vterrors.Errorf(vtrpc.Code_PERMISSION_DENIED, "peer certificate carries no SPIFFE ID")
+}
+
+// VerifyClientSPIFFEIDs makes a server TLS config only accept the clients
+// whose certificate carries one of the allowed SPIFFE IDs. The config must
+// require and verify client certificates.
+func VerifyClientSPIFFEIDs(config *tls.Config, allowed []string) error {
+	if config.ClientAuth != tls.RequireAndVerifyClientCert {
+		return vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "verifying the SPIFFE IDs of the clients requires a CA to verify their certificates")
+	}
+	m, err := newSPIFFEIDMatcher(allowed)
+	if err != nil {
+		return err
+	}
+
+	config.VerifyConnection = m.verify
+	return nil
+}
+
+// VerifyServerSPIFFEIDs makes a client TLS config only connect to the
+// servers whose certificate carries one of the allowed SPIFFE IDs. The
+// SPIFFE ID replaces the verification of the server name, as X509-SVID
+// certificates need not carry DNS names, but the certificate chain of the
+// server is still verified against the CA of the config.
+func VerifyServerSPIFFEIDs(config *tls.Config, allowed []string) error {
+	m, err := newSPIFFEIDMatcher(allowed)
+	if err != nil {
+		return err
+	}
+
+	verifyChain := config.VerifyConnection
+	if !config.InsecureSkipVerify {
+		config.InsecureSkipVerify = true
+		verifyChain = func(cs tls.ConnectionState) error {
+			return verifyPeerChain(config.RootCAs, cs)
+		}
+	}
+	if verifyChain == nil {
+		return vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "verifying the SPIFFE IDs of the servers requires verifying their certificates")
+	}
+	config.VerifyConnection = func(cs tls.ConnectionState) error {
+		if err := verifyChain(cs); err != nil {
+			return err
+		}
+		return m.verify(cs)
+	}
+	return nil
+}
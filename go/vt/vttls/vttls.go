@@ -166,23 +166,7 @@ func ClientConfig(mode SslMode, cert, key, ca, name string, minTLSVersion uint16
 	case VerifyCA:
 		config.InsecureSkipVerify = true
 		config.VerifyConnection = func(cs tls.ConnectionState) error {
-			caRoots := config.RootCAs
-			if caRoots == nil {
-				var err error
-				caRoots, err = x509.SystemCertPool()
-				if err != nil {
-					return err
-				}
-			}
-			opts := x509.VerifyOptions{
-				Roots:         caRoots,
-				Intermediates: x509.NewCertPool(),
-			}
-			for _, cert := range cs.PeerCertificates[1:] {
-				opts.Intermediates.AddCert(cert)
-			}
-			_, err := cs.PeerCertificates[0].Verify(opts)
-			return err
+			return verifyPeerChain(config.RootCAs, cs)
 		}
 	case VerifyIdentity:
 		// Nothing to do here, default config is the strictest and correct.
@@ -193,6 +177,31 @@ func ClientConfig(mode SslMode, cert, key, ca, name string, minTLSVersion uint16
 	return config, nil
 }
 
+// verifyPeerChain verifies the certificate chain presented by the peer
+// against the roots, or the system roots if roots is nil, without verifying
+// the name of the peer.
+func verifyPeerChain(roots *x509.CertPool, cs tls.ConnectionState) error {
+	if roots == nil {
+		var err error
+		roots, err = x509.SystemCertPool()
+		if err != nil {
+			return err
+		}
+	}
+	if len(cs.PeerCertificates) == 0 {
+		return vterrors.Errorf(vtrpc.Code_UNAUTHENTICATED, "peer presented no certificate")
+	}
+	opts := x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: x509.NewCertPool(),
+	}
+	for _, cert := range cs.PeerCertificates[1:] {
+		opts.Intermediates.AddCert(cert)
+	}
+	_, err := cs.PeerCertificates[0].Verify(opts)
+	return err
+}
+
 // ServerConfig returns the TLS config to use for a server to
 // accept client connections.
 func ServerConfig(cert, key, ca, serverCA string, minTLSVersion uint16) (*tls.Config, error) {
@@ -251,19 +260,28 @@ func loadx509CertPool(ca string) (*x509.CertPool, error) {
 }
 
 func doLoadx509CertPool(ca string) error {
+	cp, err := readx509CertPool(ca)
+	if err != nil {
+		return err
+	}
+
+	certPools.Store(ca, cp)
+
+	return nil
+}
+
+func readx509CertPool(ca string) (*x509.CertPool, error) {
 	b, err := ioutil.ReadFile(ca)
 	if err != nil {
-		return vterrors.Errorf(vtrpc.Code_NOT_FOUND, "failed to read ca file: %s", ca)
+		return nil, vterrors.Errorf(vtrpc.Code_NOT_FOUND, "failed to read ca file: %s", ca)
 	}
 
 	cp := x509.NewCertPool()
 	if !cp.AppendCertsFromPEM(b) {
-		return vterrors.Errorf(vtrpc.Code_UNKNOWN, "failed to append certificates")
+		return nil, vterrors.Errorf(vtrpc.Code_UNKNOWN, "failed to append certificates")
 	}
 
-	certPools.Store(ca, cp)
-
-	return nil
+	return cp, nil
 }
 
 var tlsCertificates = sync.Map{}
@@ -297,20 +315,28 @@ func loadTLSCertificate(cert, key string) (*[]tls.Certificate, error) {
 func doLoadTLSCertificate(cert, key string) error {
 	tlsIdentifier := tlsCertificatesIdentifier(cert, key)
 
-	var certificate []tls.Certificate
-	// Load the server cert and key.
-	crt, err := tls.LoadX509KeyPair(cert, key)
+	crt, err := readTLSCertificate(cert, key)
 	if err != nil {
-		return vterrors.Errorf(vtrpc.Code_NOT_FOUND, "failed to load tls certificate, cert %s, key: %s", cert, key)
+		return err
 	}
 
-	certificate = []tls.Certificate{crt}
+	certificate := []tls.Certificate{crt}
 
 	tlsCertificates.Store(tlsIdentifier, &certificate)
 
 	return nil
 }
 
+func readTLSCertificate(cert, key string) (tls.Certificate, error) {
+	// Load the server cert and key.
+	crt, err := tls.LoadX509KeyPair(cert, key)
+	if err != nil {
+		return tls.Certificate{}, vterrors.Errorf(vtrpc.Code_NOT_FOUND, "failed to load tls certificate, cert %s, key: %s", cert, key)
+	}
+
+	return crt, nil
+}
+
 var combinedTLSCertificates = sync.Map{}
 
 func combineAndLoadTLSCertificates(ca, cert, key string) (*[]tls.Certificate, error) {
@@ -338,34 +364,42 @@ func combineAndLoadTLSCertificates(ca, cert, key string) (*[]tls.Certificate, er
 func doLoadAndCombineTLSCertificates(ca, cert, key string) error {
 	combinedTLSIdentifier := tlsCertificatesIdentifier(ca, cert, key)
 
+	crt, err := readCombinedTLSCertificate(ca, cert, key)
+	if err != nil {
+		return err
+	}
+
+	certificate := []tls.Certificate{crt}
+
+	combinedTLSCertificates.Store(combinedTLSIdentifier, &certificate)
+
+	return nil
+}
+
+func readCombinedTLSCertificate(ca, cert, key string) (tls.Certificate, error) {
 	// Read CA certificates chain
 	caB, err := ioutil.ReadFile(ca)
 	if err != nil {
-		return vterrors.Errorf(vtrpc.Code_NOT_FOUND, "failed to read ca file: %s", ca)
+		return tls.Certificate{}, vterrors.Errorf(vtrpc.Code_NOT_FOUND, "failed to read ca file: %s", ca)
 	}
 
 	// Read server certificate
 	certB, err := ioutil.ReadFile(cert)
 	if err != nil {
-		return vterrors.Errorf(vtrpc.Code_NOT_FOUND, "failed to read server cert file: %s", cert)
+		return tls.Certificate{}, vterrors.Errorf(vtrpc.Code_NOT_FOUND, "failed to read server cert file: %s", cert)
 	}
 
 	// Read server key file
 	keyB, err := ioutil.ReadFile(key)
 	if err != nil {
-		return vterrors.Errorf(vtrpc.Code_NOT_FOUND, "failed to read key file: %s", key)
+		return tls.Certificate{}, vterrors.Errorf(vtrpc.Code_NOT_FOUND, "failed to read key file: %s", key)
 	}
 
 	// Load CA, server cert and key.
-	var certificate []tls.Certificate
 	crt, err := tls.X509KeyPair(append(certB, caB...), keyB)
 	if err != nil {
-		return vterrors.Errorf(vtrpc.Code_NOT_FOUND, "failed to load and merge tls certificate with CA, ca %s, cert %s, key: %s", ca, cert, key)
+		return tls.Certificate{}, vterrors.Errorf(vtrpc.Code_NOT_FOUND, "failed to load and merge tls certificate with CA, ca %s, cert %s, key: %s", ca, cert, key)
 	}
 
-	certificate = []tls.Certificate{crt}
-
-	combinedTLSCertificates.Store(combinedTLSIdentifier, &certificate)
-
-	return nil
+	return crt, nil
 }
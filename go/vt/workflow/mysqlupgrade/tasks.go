@@ -0,0 +1,187 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlupgrade
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"vitess.io/vitess/go/vt/hook"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/workflow"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	workflowpb "vitess.io/vitess/go/vt/proto/workflow"
+)
+
+func createTaskID(phase workflow.PhaseType, name string) string {
+	return fmt.Sprintf("%s/%s", phase, name)
+}
+
+// taskNames returns the names of the tasks of a phase, in execution order.
+func (uw *mysqlUpgradeWorkflow) taskNames(phase workflow.PhaseType) []string {
+	switch phase {
+	case phaseUpgradeReplicas:
+		if uw.checkpoint.Settings["replicas"] == "" {
+			return nil
+		}
+		return strings.Split(uw.checkpoint.Settings["replicas"], ",")
+	case phaseReparent:
+		return []string{uw.checkpoint.Settings["shard"]}
+	case phaseUpgradeOldPrimary:
+		return []string{uw.checkpoint.Settings["old_primary"]}
+	default:
+		log.Fatalf("BUG: unknown phase type: %v", phase)
+	}
+	return nil
+}
+
+// GetTasks returns selected tasks for a phase from the checkpoint
+// with expected execution order.
+func (uw *mysqlUpgradeWorkflow) GetTasks(phase workflow.PhaseType) []*workflowpb.Task {
+	var tasks []*workflowpb.Task
+	for _, name := range uw.taskNames(phase) {
+		tasks = append(tasks, uw.checkpoint.Tasks[createTaskID(phase, name)])
+	}
+	return tasks
+}
+
+// runUpgradeTablet takes the tablet out of serving, runs the upgrade hook on
+// it, checks that its mysqld runs the target version and makes it serve
+// again. A tablet that already runs the target version, e.g. because the
+// task is retried, is not upgraded again, and only made serve again if it is
+// drained. When the upgrade fails, the tablet is left drained.
+func (uw *mysqlUpgradeWorkflow) runUpgradeTablet(ctx context.Context, t *workflowpb.Task) error {
+	alias, err := topoproto.ParseTabletAlias(t.Attributes["tablet_alias"])
+	if err != nil {
+		return err
+	}
+	tabletType, err := topoproto.ParseTabletType(t.Attributes["tablet_type"])
+	if err != nil {
+		return err
+	}
+	ti, err := uw.topoServer.GetTablet(ctx, alias)
+	if err != nil {
+		return err
+	}
+
+	version, err := uw.wr.TabletManagerClient().GetVersion(ctx, ti.Tablet)
+	if err != nil {
+		return fmt.Errorf("cannot get the MySQL version of %v: %v", t.Attributes["tablet_alias"], err)
+	}
+	if !uw.isTargetVersion(version) {
+		if ti.Type != topodatapb.TabletType_DRAINED {
+			if err := uw.wr.ChangeTabletType(ctx, alias, topodatapb.TabletType_DRAINED); err != nil {
+				return fmt.Errorf("cannot take %v out of serving: %v", t.Attributes["tablet_alias"], err)
+			}
+		}
+		if err := uw.upgrade(ctx, alias); err != nil {
+			return uw.rollbackError(t, err)
+		}
+		version, err = uw.wr.TabletManagerClient().GetVersion(ctx, ti.Tablet)
+		if err != nil {
+			return uw.rollbackError(t, fmt.Errorf("cannot get the MySQL version: %v", err))
+		}
+		if !uw.isTargetVersion(version) {
+			return uw.rollbackError(t, fmt.Errorf("MySQL runs %v after the upgrade", version.Version))
+		}
+	} else if ti.Type != topodatapb.TabletType_DRAINED {
+		return nil
+	}
+	if err := uw.wr.ChangeTabletType(ctx, alias, tabletType); err != nil {
+		return fmt.Errorf("%v runs MySQL %v but cannot serve as %v again: %v", t.Attributes["tablet_alias"], version.Version, tabletType, err)
+	}
+	return nil
+}
+
+// upgrade runs the upgrade hook on the tablet.
+func (uw *mysqlUpgradeWorkflow) upgrade(ctx context.Context, alias *topodatapb.TabletAlias) error {
+	hk := hook.NewHook(uw.checkpoint.Settings["upgrade_hook"], []string{"--target-version=" + uw.checkpoint.Settings["target_version"]})
+	hr, err := uw.wr.ExecuteHook(ctx, alias, hk)
+	if err != nil {
+		return err
+	}
+	if hr.ExitStatus != hook.HOOK_SUCCESS {
+		return fmt.Errorf("hook %v failed(%v): %v", hk.Name, hr.ExitStatus, hr.Stderr)
+	}
+	return nil
+}
+
+// rollbackError wraps the error of a failed upgrade of a drained tablet with
+// how to make it serve again.
+func (uw *mysqlUpgradeWorkflow) rollbackError(t *workflowpb.Task, err error) error {
+	return fmt.Errorf("cannot upgrade MySQL of %v: %v. The tablet is drained: fix its mysqld and retry the task, or restore the previous version and run ChangeTabletType %v %v to roll it back", t.Attributes["tablet_alias"], err, t.Attributes["tablet_alias"], t.Attributes["tablet_type"])
+}
+
+// isTargetVersion returns true if the version matches every part of the
+// target version.
+func (uw *mysqlUpgradeWorkflow) isTargetVersion(version *tabletmanagerdatapb.GetVersionResponse) bool {
+	parts := []int32{version.Major, version.Minor, version.Patch}
+	for i, part := range uw.targetVersion {
+		if parts[i] != int32(part) {
+			return false
+		}
+	}
+	return true
+}
+
+// runReparent reparents the shard to an upgraded replica, unless its
+// primary already runs the target version, e.g. because the shard was
+// already reparented.
+func (uw *mysqlUpgradeWorkflow) runReparent(ctx context.Context, t *workflowpb.Task) error {
+	keyspace := t.Attributes["keyspace"]
+	shard := t.Attributes["shard"]
+	newPrimary, err := topoproto.ParseTabletAlias(t.Attributes["new_primary"])
+	if err != nil {
+		return err
+	}
+	si, err := uw.topoServer.GetShard(ctx, keyspace, shard)
+	if err != nil {
+		return err
+	}
+	if topoproto.TabletAliasEqual(si.PrimaryAlias, newPrimary) {
+		return nil
+	}
+	if si.HasPrimary() {
+		primary, err := uw.topoServer.GetTablet(ctx, si.PrimaryAlias)
+		if err != nil {
+			return err
+		}
+		if version, err := uw.wr.TabletManagerClient().GetVersion(ctx, primary.Tablet); err == nil && uw.isTargetVersion(version) {
+			return nil
+		}
+	}
+
+	ti, err := uw.topoServer.GetTablet(ctx, newPrimary)
+	if err != nil {
+		return err
+	}
+	version, err := uw.wr.TabletManagerClient().GetVersion(ctx, ti.Tablet)
+	if err != nil {
+		return fmt.Errorf("cannot get the MySQL version of %v: %v", t.Attributes["new_primary"], err)
+	}
+	if !uw.isTargetVersion(version) {
+		return fmt.Errorf("new primary %v runs MySQL %v, not %v", t.Attributes["new_primary"], version.Version, uw.checkpoint.Settings["target_version"])
+	}
+	if err := uw.wr.PlannedReparentShard(ctx, keyspace, shard, newPrimary, nil /* avoidTabletAlias */, uw.waitReplicasTimeout); err != nil {
+		return fmt.Errorf("cannot reparent shard %v to %v: %v. Check that %v is still the primary and retry the task", topoproto.KeyspaceShardString(keyspace, shard), t.Attributes["new_primary"], err, t.Attributes["old_primary"])
+	}
+	return nil
+}
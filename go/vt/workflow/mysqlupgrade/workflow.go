@@ -0,0 +1,429 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package mysqlupgrade contains a workflow that upgrades MySQL across a
+// shard: the replicas are upgraded one at a time, then the shard is
+// reparented to one of them and the old primary is upgraded too.
+//
+// The upgrade itself is done on each tablet by a hook of $VTROOT/vthook,
+// the callback to the operator or the tooling that installs the new mysqld.
+// The phases wait for an approval in the UI before their first and their
+// remaining tasks, and a failed task waits for a retry. The tasks skip the
+// tablets that already run the target version, so a stopped workflow is
+// resumed by creating it again with the same arguments.
+package mysqlupgrade
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+	"vitess.io/vitess/go/vt/workflow"
+	"vitess.io/vitess/go/vt/wrangler"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	workflowpb "vitess.io/vitess/go/vt/proto/workflow"
+)
+
+const (
+	codeVersion                                = 1
+	mysqlUpgradeFactoryName                    = "mysql_upgrade"
+	phaseUpgradeReplicas    workflow.PhaseType = "upgrade_replicas"
+	phaseReparent           workflow.PhaseType = "reparent"
+	phaseUpgradeOldPrimary  workflow.PhaseType = "upgrade_old_primary"
+)
+
+// Register registers the MySQL upgrade Factory in the workflow framework.
+func Register() {
+	workflow.Register(mysqlUpgradeFactoryName, &Factory{})
+}
+
+// Factory is the factory to create a MySQL upgrade workflow.
+type Factory struct{}
+
+// Init is part of the workflow.Factory interface.
+func (*Factory) Init(m *workflow.Manager, w *workflowpb.Workflow, args []string) error {
+	subFlags := flag.NewFlagSet(mysqlUpgradeFactoryName, flag.ContinueOnError)
+	keyspace := subFlags.String("keyspace", "", "Name of the keyspace of the shard to upgrade")
+	shard := subFlags.String("shard", "", "Name of the shard to upgrade")
+	targetVersion := subFlags.String("target_version", "", "MySQL version the tablets must run once upgraded, as major[.minor[.patch]], e.g. 8.0 or 8.0.23")
+	upgradeHook := subFlags.String("upgrade_hook", "upgrade_mysql", "Hook of $VTROOT/vthook run on each tablet, while it does not serve, to upgrade its mysqld. It is passed --target-version=<target_version>")
+	newPrimary := subFlags.String("new_primary", "", "Alias of the replica to reparent the shard to once the replicas are upgraded. By default, a replica of the cell of the primary")
+	waitReplicasTimeout := subFlags.Duration("wait_replicas_timeout", wrangler.DefaultWaitReplicasTimeout, "Time to wait for the replicas to catch up during the reparent")
+	phaseEnableApprovalsDesc := fmt.Sprintf("Comma separated phases that require explicit approval in the UI to execute. Phase names are: %v", strings.Join(WorkflowPhases(), ","))
+	phaseEnableApprovalsStr := subFlags.String("phase_enable_approvals", strings.Join(WorkflowPhases(), ","), phaseEnableApprovalsDesc)
+
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if *keyspace == "" || *shard == "" || *targetVersion == "" || *upgradeHook == "" {
+		return fmt.Errorf("keyspace, shard, target version and upgrade hook must be provided for the MySQL upgrade")
+	}
+	if _, err := parseTargetVersion(*targetVersion); err != nil {
+		return err
+	}
+	if strings.Contains(*upgradeHook, "/") {
+		return fmt.Errorf("hook name cannot have a '/' in it")
+	}
+	for _, phase := range parsePhaseEnableApprovals(*phaseEnableApprovalsStr) {
+		validPhase := false
+		for _, registeredPhase := range WorkflowPhases() {
+			if phase == registeredPhase {
+				validPhase = true
+			}
+		}
+		if !validPhase {
+			return fmt.Errorf("invalid phase in phase_enable_approvals: %v", phase)
+		}
+	}
+
+	primary, replicas, newPrimaryAlias, err := initTablets(context.TODO(), m.TopoServer(), *keyspace, *shard, *newPrimary)
+	if err != nil {
+		return err
+	}
+
+	w.Name = fmt.Sprintf("Upgrade MySQL of shard %v to %v.", topoproto.KeyspaceShardString(*keyspace, *shard), *targetVersion)
+	checkpoint := initCheckpoint(*keyspace, *shard, primary, replicas, newPrimaryAlias)
+	checkpoint.Settings["target_version"] = *targetVersion
+	checkpoint.Settings["upgrade_hook"] = *upgradeHook
+	checkpoint.Settings["wait_replicas_timeout"] = waitReplicasTimeout.String()
+	checkpoint.Settings["phase_enable_approvals"] = *phaseEnableApprovalsStr
+
+	w.Data, err = proto.Marshal(checkpoint)
+	return err
+}
+
+// Instantiate is part the workflow.Factory interface.
+func (*Factory) Instantiate(m *workflow.Manager, w *workflowpb.Workflow, rootNode *workflow.Node) (workflow.Workflow, error) {
+	rootNode.Message = "This is a workflow to upgrade MySQL across a shard. To roll back, stop it, downgrade the tablets that were upgraded with the same hook, and reparent back to a downgraded replica if the primary was already moved."
+
+	checkpoint := &workflowpb.WorkflowCheckpoint{}
+	if err := proto.Unmarshal(w.Data, checkpoint); err != nil {
+		return nil, err
+	}
+	targetVersion, err := parseTargetVersion(checkpoint.Settings["target_version"])
+	if err != nil {
+		return nil, err
+	}
+	waitReplicasTimeout, err := time.ParseDuration(checkpoint.Settings["wait_replicas_timeout"])
+	if err != nil {
+		return nil, err
+	}
+
+	phaseEnableApprovals := make(map[string]bool)
+	for _, phase := range parsePhaseEnableApprovals(checkpoint.Settings["phase_enable_approvals"]) {
+		phaseEnableApprovals[phase] = true
+	}
+
+	uw := &mysqlUpgradeWorkflow{
+		checkpoint:           checkpoint,
+		rootUINode:           rootNode,
+		logger:               logutil.NewMemoryLogger(),
+		wr:                   wrangler.New(logutil.NewConsoleLogger(), m.TopoServer(), tmclient.NewTabletManagerClient()),
+		topoServer:           m.TopoServer(),
+		targetVersion:        targetVersion,
+		waitReplicasTimeout:  waitReplicasTimeout,
+		phaseEnableApprovals: phaseEnableApprovals,
+	}
+
+	upgradeReplicasUINode := &workflow.Node{
+		Name:     "UpgradeReplicas",
+		PathName: string(phaseUpgradeReplicas),
+	}
+	reparentUINode := &workflow.Node{
+		Name:     "PlannedReparentShard",
+		PathName: string(phaseReparent),
+	}
+	upgradeOldPrimaryUINode := &workflow.Node{
+		Name:     "UpgradeOldPrimary",
+		PathName: string(phaseUpgradeOldPrimary),
+	}
+	uw.rootUINode.Children = []*workflow.Node{
+		upgradeReplicasUINode,
+		reparentUINode,
+		upgradeOldPrimaryUINode,
+	}
+	for _, phase := range []workflow.PhaseType{phaseUpgradeReplicas, phaseReparent, phaseUpgradeOldPrimary} {
+		if err := createUINodes(uw.rootUINode, phase, uw.taskNames(phase)); err != nil {
+			return uw, err
+		}
+	}
+	return uw, nil
+}
+
+func createUINodes(rootNode *workflow.Node, phaseName workflow.PhaseType, names []string) error {
+	phaseNode, err := rootNode.GetChildByPath(string(phaseName))
+	if err != nil {
+		return fmt.Errorf("fails to find phase node for: %v", phaseName)
+	}
+
+	for _, name := range names {
+		prefix := "Tablet "
+		if phaseName == phaseReparent {
+			prefix = "Shard "
+		}
+		taskUINode := &workflow.Node{
+			Name:     prefix + name,
+			PathName: name,
+		}
+		phaseNode.Children = append(phaseNode.Children, taskUINode)
+	}
+	return nil
+}
+
+// initTablets returns the primary of the shard, its replica and rdonly
+// tablets sorted by alias, and the replica the shard will be reparented to.
+// The tablets of the other types are not upgraded.
+func initTablets(ctx context.Context, ts *topo.Server, keyspace, shard, newPrimary string) (*topodatapb.Tablet, []*topodatapb.Tablet, *topodatapb.TabletAlias, error) {
+	si, err := ts.GetShard(ctx, keyspace, shard)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("cannot get shard %v: %v", topoproto.KeyspaceShardString(keyspace, shard), err)
+	}
+	if !si.HasPrimary() {
+		return nil, nil, nil, fmt.Errorf("shard %v has no primary", topoproto.KeyspaceShardString(keyspace, shard))
+	}
+	tablets, err := ts.GetTabletMapForShard(ctx, keyspace, shard)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("cannot get the tablets of shard %v: %v", topoproto.KeyspaceShardString(keyspace, shard), err)
+	}
+
+	var primary *topodatapb.Tablet
+	var replicas []*topodatapb.Tablet
+	for _, ti := range tablets {
+		switch {
+		case topoproto.TabletAliasEqual(ti.Alias, si.PrimaryAlias):
+			primary = ti.Tablet
+		case ti.Type == topodatapb.TabletType_REPLICA || ti.Type == topodatapb.TabletType_RDONLY:
+			replicas = append(replicas, ti.Tablet)
+		}
+	}
+	if primary == nil {
+		return nil, nil, nil, fmt.Errorf("primary %v of shard %v is not in the topology", topoproto.TabletAliasString(si.PrimaryAlias), topoproto.KeyspaceShardString(keyspace, shard))
+	}
+	sort.Slice(replicas, func(i, j int) bool {
+		return topoproto.TabletAliasString(replicas[i].Alias) < topoproto.TabletAliasString(replicas[j].Alias)
+	})
+
+	var newPrimaryAlias *topodatapb.TabletAlias
+	if newPrimary != "" {
+		newPrimaryAlias, err = topoproto.ParseTabletAlias(newPrimary)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+	}
+	for _, replica := range replicas {
+		if replica.Type != topodatapb.TabletType_REPLICA {
+			continue
+		}
+		if newPrimary != "" {
+			if topoproto.TabletAliasEqual(replica.Alias, newPrimaryAlias) {
+				return primary, replicas, newPrimaryAlias, nil
+			}
+			continue
+		}
+		if newPrimaryAlias == nil || (replica.Alias.Cell == primary.Alias.Cell && newPrimaryAlias.Cell != primary.Alias.Cell) {
+			newPrimaryAlias = replica.Alias
+		}
+	}
+	if newPrimary != "" {
+		return nil, nil, nil, fmt.Errorf("new primary %v is not a replica of shard %v", newPrimary, topoproto.KeyspaceShardString(keyspace, shard))
+	}
+	if newPrimaryAlias == nil {
+		return nil, nil, nil, fmt.Errorf("shard %v has no replica to reparent to", topoproto.KeyspaceShardString(keyspace, shard))
+	}
+	return primary, replicas, newPrimaryAlias, nil
+}
+
+// initCheckpoint initializes the checkpoint of the MySQL upgrade workflow.
+func initCheckpoint(keyspace, shard string, primary *topodatapb.Tablet, replicas []*topodatapb.Tablet, newPrimary *topodatapb.TabletAlias) *workflowpb.WorkflowCheckpoint {
+	tasks := make(map[string]*workflowpb.Task)
+	var replicaAliases []string
+	for _, replica := range replicas {
+		alias := topoproto.TabletAliasString(replica.Alias)
+		replicaAliases = append(replicaAliases, alias)
+		initTask(tasks, phaseUpgradeReplicas, alias, map[string]string{
+			"keyspace":     keyspace,
+			"shard":        shard,
+			"tablet_alias": alias,
+			"tablet_type":  replica.Type.String(),
+		})
+	}
+	primaryAlias := topoproto.TabletAliasString(primary.Alias)
+	initTask(tasks, phaseReparent, shard, map[string]string{
+		"keyspace":    keyspace,
+		"shard":       shard,
+		"old_primary": primaryAlias,
+		"new_primary": topoproto.TabletAliasString(newPrimary),
+	})
+	// The old primary is a replica once the shard is reparented.
+	initTask(tasks, phaseUpgradeOldPrimary, primaryAlias, map[string]string{
+		"keyspace":     keyspace,
+		"shard":        shard,
+		"tablet_alias": primaryAlias,
+		"tablet_type":  topodatapb.TabletType_REPLICA.String(),
+	})
+
+	return &workflowpb.WorkflowCheckpoint{
+		CodeVersion: codeVersion,
+		Tasks:       tasks,
+		Settings: map[string]string{
+			"keyspace":    keyspace,
+			"shard":       shard,
+			"replicas":    strings.Join(replicaAliases, ","),
+			"old_primary": primaryAlias,
+		},
+	}
+}
+
+func initTask(tasks map[string]*workflowpb.Task, phase workflow.PhaseType, name string, attributes map[string]string) {
+	taskID := createTaskID(phase, name)
+	tasks[taskID] = &workflowpb.Task{
+		Id:         taskID,
+		State:      workflowpb.TaskState_TaskNotStarted,
+		Attributes: attributes,
+	}
+}
+
+// mysqlUpgradeWorkflow contains meta-information and methods to control the
+// MySQL upgrade workflow.
+type mysqlUpgradeWorkflow struct {
+	ctx        context.Context
+	wr         Wrangler
+	topoServer *topo.Server
+	wi         *topo.WorkflowInfo
+	// logger is the logger we export UI logs from.
+	logger *logutil.MemoryLogger
+
+	// rootUINode is the root node representing the workflow in the UI.
+	rootUINode *workflow.Node
+
+	checkpoint       *workflowpb.WorkflowCheckpoint
+	checkpointWriter *workflow.CheckpointWriter
+
+	targetVersion        []int
+	waitReplicasTimeout  time.Duration
+	phaseEnableApprovals map[string]bool
+}
+
+// Run executes the MySQL upgrade process.
+// It implements the workflow.Workflow interface.
+func (uw *mysqlUpgradeWorkflow) Run(ctx context.Context, manager *workflow.Manager, wi *topo.WorkflowInfo) error {
+	uw.ctx = ctx
+	uw.wi = wi
+	uw.checkpointWriter = workflow.NewCheckpointWriter(uw.topoServer, uw.checkpoint, uw.wi)
+	uw.rootUINode.Display = workflow.NodeDisplayDeterminate
+	uw.rootUINode.BroadcastChanges(true /* updateChildren */)
+
+	if err := uw.runWorkflow(); err != nil {
+		return err
+	}
+	if ctx.Err() == nil {
+		uw.setUIMessage(fmt.Sprintf("MySQL of shard %v is upgraded to %v.", topoproto.KeyspaceShardString(uw.checkpoint.Settings["keyspace"], uw.checkpoint.Settings["shard"]), uw.checkpoint.Settings["target_version"]))
+	}
+	return nil
+}
+
+func (uw *mysqlUpgradeWorkflow) runWorkflow() error {
+	if tasks := uw.GetTasks(phaseUpgradeReplicas); len(tasks) > 0 {
+		upgradeReplicasRunner := workflow.NewParallelRunner(uw.ctx, uw.rootUINode, uw.checkpointWriter, tasks, uw.runUpgradeTablet, workflow.Sequential, uw.phaseEnableApprovals[string(phaseUpgradeReplicas)])
+		if err := upgradeReplicasRunner.Run(); err != nil {
+			return err
+		}
+	}
+	// The primary must not move while a replica is still not upgraded, or
+	// was left drained by a failed upgrade.
+	if !uw.phaseDone(phaseUpgradeReplicas) {
+		return nil
+	}
+
+	reparentRunner := workflow.NewParallelRunner(uw.ctx, uw.rootUINode, uw.checkpointWriter, uw.GetTasks(phaseReparent), uw.runReparent, workflow.Sequential, uw.phaseEnableApprovals[string(phaseReparent)])
+	if err := reparentRunner.Run(); err != nil {
+		return err
+	}
+	if !uw.phaseDone(phaseReparent) {
+		return nil
+	}
+
+	upgradeOldPrimaryRunner := workflow.NewParallelRunner(uw.ctx, uw.rootUINode, uw.checkpointWriter, uw.GetTasks(phaseUpgradeOldPrimary), uw.runUpgradeTablet, workflow.Sequential, uw.phaseEnableApprovals[string(phaseUpgradeOldPrimary)])
+	return upgradeOldPrimaryRunner.Run()
+}
+
+// phaseDone returns true if all the tasks of the phase succeeded.
+func (uw *mysqlUpgradeWorkflow) phaseDone(phase workflow.PhaseType) bool {
+	for _, task := range uw.GetTasks(phase) {
+		if task.State != workflowpb.TaskState_TaskDone || task.Error != "" {
+			return false
+		}
+	}
+	return true
+}
+
+func (uw *mysqlUpgradeWorkflow) setUIMessage(message string) {
+	log.Infof("MySQL upgrade : %v.", message)
+	uw.logger.Infof(message)
+	uw.rootUINode.Log = uw.logger.String()
+	uw.rootUINode.Message = message
+	uw.rootUINode.BroadcastChanges(false /* updateChildren */)
+}
+
+// WorkflowPhases returns phases for the MySQL upgrade workflow.
+func WorkflowPhases() []string {
+	return []string{
+		string(phaseUpgradeReplicas),
+		string(phaseReparent),
+		string(phaseUpgradeOldPrimary),
+	}
+}
+
+func parsePhaseEnableApprovals(phaseEnableApprovalsStr string) []string {
+	var phaseEnableApprovals []string
+	if phaseEnableApprovalsStr == "" {
+		return phaseEnableApprovals
+	}
+	phaseEnableApprovals = strings.Split(phaseEnableApprovalsStr, ",")
+	for i, phase := range phaseEnableApprovals {
+		phaseEnableApprovals[i] = strings.Trim(phase, " ")
+	}
+	return phaseEnableApprovals
+}
+
+// parseTargetVersion parses a major[.minor[.patch]] version.
+func parseTargetVersion(version string) ([]int, error) {
+	parts := strings.Split(version, ".")
+	if len(parts) > 3 {
+		return nil, fmt.Errorf("invalid target version: %v", version)
+	}
+	targetVersion := make([]int, len(parts))
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return nil, fmt.Errorf("invalid target version: %v", version)
+		}
+		targetVersion[i] = n
+	}
+	return targetVersion, nil
+}
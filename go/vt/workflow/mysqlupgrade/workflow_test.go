@@ -0,0 +1,303 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlupgrade
+
+import (
+	"context"
+	"path"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"vitess.io/vitess/go/vt/hook"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+	"vitess.io/vitess/go/vt/workflow"
+
+	// import the gRPC client implementation for tablet manager
+	_ "vitess.io/vitess/go/vt/vttablet/grpctmclient"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	workflowpb "vitess.io/vitess/go/vt/proto/workflow"
+)
+
+const (
+	testKeyspace = "test_keyspace"
+	testShard    = "0"
+)
+
+func init() {
+	Register()
+}
+
+// fakeWrangler upgrades the tablets of a memorytopo, and keeps the version
+// of their mysqld.
+type fakeWrangler struct {
+	ts *topo.Server
+
+	mu sync.Mutex
+	// versions are the MySQL versions of the tablets, by alias.
+	versions map[string]*tabletmanagerdatapb.GetVersionResponse
+	// upgraded are the aliases of the tablets the hook ran on, in order.
+	upgraded []string
+	// failures is the number of times the hook fails on each tablet.
+	failures   map[string]int
+	reparented []string
+}
+
+func (fw *fakeWrangler) ChangeTabletType(ctx context.Context, tabletAlias *topodatapb.TabletAlias, tabletType topodatapb.TabletType) error {
+	_, err := fw.ts.UpdateTabletFields(ctx, tabletAlias, func(tablet *topodatapb.Tablet) error {
+		tablet.Type = tabletType
+		return nil
+	})
+	return err
+}
+
+func (fw *fakeWrangler) ExecuteHook(ctx context.Context, tabletAlias *topodatapb.TabletAlias, hk *hook.Hook) (*hook.HookResult, error) {
+	fw.mu.Lock()
+	defer fw.mu.Unlock()
+	alias := topoproto.TabletAliasString(tabletAlias)
+	if hk.Name != "upgrade_mysql" || len(hk.Parameters) != 1 || hk.Parameters[0] != "--target-version=8.0" {
+		return &hook.HookResult{ExitStatus: hook.HOOK_INVALID_NAME}, nil
+	}
+	if fw.failures[alias] > 0 {
+		fw.failures[alias]--
+		return &hook.HookResult{ExitStatus: 1, Stderr: "no space left on device"}, nil
+	}
+	fw.upgraded = append(fw.upgraded, alias)
+	fw.versions[alias] = &tabletmanagerdatapb.GetVersionResponse{Version: "8.0.23", Flavor: "mysql", Major: 8, Minor: 0, Patch: 23}
+	return &hook.HookResult{ExitStatus: hook.HOOK_SUCCESS}, nil
+}
+
+func (fw *fakeWrangler) PlannedReparentShard(ctx context.Context, keyspace, shard string, primaryElectTabletAlias, avoidTabletAlias *topodatapb.TabletAlias, waitReplicasTimeout time.Duration) error {
+	si, err := fw.ts.GetShard(ctx, keyspace, shard)
+	if err != nil {
+		return err
+	}
+	if err := fw.ChangeTabletType(ctx, si.PrimaryAlias, topodatapb.TabletType_REPLICA); err != nil {
+		return err
+	}
+	if err := fw.ChangeTabletType(ctx, primaryElectTabletAlias, topodatapb.TabletType_PRIMARY); err != nil {
+		return err
+	}
+	if _, err := fw.ts.UpdateShardFields(ctx, keyspace, shard, func(si *topo.ShardInfo) error {
+		si.PrimaryAlias = primaryElectTabletAlias
+		return nil
+	}); err != nil {
+		return err
+	}
+	fw.mu.Lock()
+	defer fw.mu.Unlock()
+	fw.reparented = append(fw.reparented, topoproto.TabletAliasString(primaryElectTabletAlias))
+	return nil
+}
+
+func (fw *fakeWrangler) TabletManagerClient() tmclient.TabletManagerClient {
+	return &fakeTabletManagerClient{fw: fw}
+}
+
+// fakeTabletManagerClient returns the versions of the fakeWrangler. It only
+// implements GetVersion.
+type fakeTabletManagerClient struct {
+	tmclient.TabletManagerClient
+	fw *fakeWrangler
+}
+
+func (client *fakeTabletManagerClient) GetVersion(ctx context.Context, tablet *topodatapb.Tablet) (*tabletmanagerdatapb.GetVersionResponse, error) {
+	client.fw.mu.Lock()
+	defer client.fw.mu.Unlock()
+	return client.fw.versions[topoproto.TabletAliasString(tablet.Alias)], nil
+}
+
+func (fw *fakeWrangler) calls() ([]string, []string) {
+	fw.mu.Lock()
+	defer fw.mu.Unlock()
+	return append([]string(nil), fw.upgraded...), append([]string(nil), fw.reparented...)
+}
+
+// setupTopology creates a shard with a primary, two replicas in its cell,
+// one of them rdonly, and a replica in another cell. They all run MySQL
+// 5.7.
+func setupTopology(ctx context.Context, t *testing.T) (*topo.Server, *fakeWrangler) {
+	ts := memorytopo.NewServer("cell1", "cell2")
+	require.NoError(t, ts.CreateKeyspace(ctx, testKeyspace, &topodatapb.Keyspace{}))
+	require.NoError(t, ts.CreateShard(ctx, testKeyspace, testShard))
+	fw := &fakeWrangler{
+		ts:       ts,
+		versions: make(map[string]*tabletmanagerdatapb.GetVersionResponse),
+		failures: make(map[string]int),
+	}
+	for _, tablet := range []*topodatapb.Tablet{
+		{Alias: &topodatapb.TabletAlias{Cell: "cell2", Uid: 100}, Type: topodatapb.TabletType_REPLICA},
+		{Alias: &topodatapb.TabletAlias{Cell: "cell1", Uid: 101}, Type: topodatapb.TabletType_PRIMARY},
+		{Alias: &topodatapb.TabletAlias{Cell: "cell1", Uid: 102}, Type: topodatapb.TabletType_RDONLY},
+		{Alias: &topodatapb.TabletAlias{Cell: "cell1", Uid: 103}, Type: topodatapb.TabletType_REPLICA},
+		{Alias: &topodatapb.TabletAlias{Cell: "cell1", Uid: 104}, Type: topodatapb.TabletType_BACKUP},
+	} {
+		tablet.Keyspace = testKeyspace
+		tablet.Shard = testShard
+		require.NoError(t, ts.CreateTablet(ctx, tablet))
+		fw.versions[topoproto.TabletAliasString(tablet.Alias)] = &tabletmanagerdatapb.GetVersionResponse{Version: "5.7.31", Flavor: "mysql", Major: 5, Minor: 7, Patch: 31}
+	}
+	_, err := ts.UpdateShardFields(ctx, testKeyspace, testShard, func(si *topo.ShardInfo) error {
+		si.PrimaryAlias = &topodatapb.TabletAlias{Cell: "cell1", Uid: 101}
+		return nil
+	})
+	require.NoError(t, err)
+	return ts, fw
+}
+
+func startWorkflow(ctx context.Context, t *testing.T, m *workflow.Manager, fw *fakeWrangler, args ...string) string {
+	uuid, err := m.Create(ctx, mysqlUpgradeFactoryName, append([]string{"-keyspace=" + testKeyspace, "-shard=" + testShard, "-target_version=8.0", "-phase_enable_approvals="}, args...))
+	require.NoError(t, err)
+	w, err := m.WorkflowForTesting(uuid)
+	require.NoError(t, err)
+	w.(*mysqlUpgradeWorkflow).wr = fw
+	require.NoError(t, m.Start(ctx, uuid))
+	return uuid
+}
+
+func tabletType(ctx context.Context, t *testing.T, ts *topo.Server, uid uint32) topodatapb.TabletType {
+	cell := "cell1"
+	if uid == 100 {
+		cell = "cell2"
+	}
+	ti, err := ts.GetTablet(ctx, &topodatapb.TabletAlias{Cell: cell, Uid: uid})
+	require.NoError(t, err)
+	return ti.Type
+}
+
+func TestMySQLUpgrade(t *testing.T) {
+	ctx := context.Background()
+	ts, fw := setupTopology(ctx, t)
+	m := workflow.NewManager(ts)
+	wg, _, cancel := workflow.StartManager(m)
+	defer func() {
+		cancel()
+		wg.Wait()
+	}()
+
+	uuid := startWorkflow(ctx, t, m, fw)
+	require.NoError(t, m.Wait(ctx, uuid))
+	require.NoError(t, workflow.VerifyAllTasksDone(ctx, ts, uuid))
+
+	// The replicas are upgraded one at a time, the shard is reparented to
+	// the replica of the cell of the primary, and the old primary is
+	// upgraded last. The backup tablet is left alone.
+	upgraded, reparented := fw.calls()
+	assert.Equal(t, []string{"cell1-0000000102", "cell1-0000000103", "cell2-0000000100", "cell1-0000000101"}, upgraded)
+	assert.Equal(t, []string{"cell1-0000000103"}, reparented)
+	assert.Equal(t, topodatapb.TabletType_REPLICA, tabletType(ctx, t, ts, 100))
+	assert.Equal(t, topodatapb.TabletType_REPLICA, tabletType(ctx, t, ts, 101))
+	assert.Equal(t, topodatapb.TabletType_RDONLY, tabletType(ctx, t, ts, 102))
+	assert.Equal(t, topodatapb.TabletType_PRIMARY, tabletType(ctx, t, ts, 103))
+	assert.Equal(t, topodatapb.TabletType_BACKUP, tabletType(ctx, t, ts, 104))
+
+	// Running it again does not upgrade or reparent anything.
+	uuid = startWorkflow(ctx, t, m, fw)
+	require.NoError(t, m.Wait(ctx, uuid))
+	require.NoError(t, workflow.VerifyAllTasksDone(ctx, ts, uuid))
+	upgraded, reparented = fw.calls()
+	assert.Len(t, upgraded, 4)
+	assert.Len(t, reparented, 1)
+	assert.Equal(t, topodatapb.TabletType_PRIMARY, tabletType(ctx, t, ts, 103))
+}
+
+func TestMySQLUpgradeRetry(t *testing.T) {
+	ctx := context.Background()
+	ts, fw := setupTopology(ctx, t)
+	fw.failures["cell1-0000000103"] = 1
+	m := workflow.NewManager(ts)
+	wg, _, cancel := workflow.StartManager(m)
+	defer func() {
+		cancel()
+		wg.Wait()
+	}()
+
+	uuid := startWorkflow(ctx, t, m, fw, "-new_primary=cell2-0000000100")
+
+	// The failed upgrade leaves the tablet drained, and the workflow waits
+	// for a retry before it upgrades anything else.
+	taskID := createTaskID(phaseUpgradeReplicas, "cell1-0000000103")
+	deadline := time.Now().Add(10 * time.Second)
+	for {
+		wi, err := ts.GetWorkflow(ctx, uuid)
+		require.NoError(t, err)
+		checkpoint := &workflowpb.WorkflowCheckpoint{}
+		require.NoError(t, proto.Unmarshal(wi.Data, checkpoint))
+		if task := checkpoint.Tasks[taskID]; task.State == workflowpb.TaskState_TaskDone {
+			assert.Contains(t, task.Error, "cannot upgrade MySQL of cell1-0000000103: hook upgrade_mysql failed(1): no space left on device. The tablet is drained")
+			break
+		}
+		require.True(t, time.Now().Before(deadline), "the upgrade did not fail")
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.Equal(t, topodatapb.TabletType_DRAINED, tabletType(ctx, t, ts, 103))
+	upgraded, reparented := fw.calls()
+	assert.Equal(t, []string{"cell1-0000000102"}, upgraded)
+	assert.Empty(t, reparented)
+
+	// The retry action is added once the failure is checkpointed.
+	for {
+		err := m.NodeManager().Action(ctx, &workflow.ActionParameters{Path: path.Join("/", uuid, taskID), Name: "Retry"})
+		if err == nil {
+			break
+		}
+		require.True(t, time.Now().Before(deadline), "cannot retry: %v", err)
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	require.NoError(t, m.Wait(ctx, uuid))
+	require.NoError(t, workflow.VerifyAllTasksDone(ctx, ts, uuid))
+	upgraded, reparented = fw.calls()
+	assert.Equal(t, []string{"cell1-0000000102", "cell1-0000000103", "cell2-0000000100", "cell1-0000000101"}, upgraded)
+	assert.Equal(t, []string{"cell2-0000000100"}, reparented)
+	assert.Equal(t, topodatapb.TabletType_REPLICA, tabletType(ctx, t, ts, 103))
+}
+
+func TestMySQLUpgradeInit(t *testing.T) {
+	ctx := context.Background()
+	ts, fw := setupTopology(ctx, t)
+	m := workflow.NewManager(ts)
+	wg, _, cancel := workflow.StartManager(m)
+	defer func() {
+		cancel()
+		wg.Wait()
+	}()
+
+	for args, want := range map[string]string{
+		"-keyspace=test_keyspace -shard=0":                                                     "keyspace, shard, target version and upgrade hook must be provided for the MySQL upgrade",
+		"-keyspace=test_keyspace -shard=0 -target_version=8.x":                                 "invalid target version: 8.x",
+		"-keyspace=test_keyspace -shard=0 -target_version=8.0 -phase_enable_approvals=upgrade": "invalid phase in phase_enable_approvals: upgrade",
+		"-keyspace=test_keyspace -shard=0 -target_version=8.0 -new_primary=cell1-0000000102":   "new primary cell1-0000000102 is not a replica of shard test_keyspace/0",
+		"-keyspace=test_keyspace -shard=80- -target_version=8.0":                               "cannot get shard test_keyspace/80-: node doesn't exist: keyspaces/test_keyspace/shards/80-/Shard",
+		"-keyspace=test_keyspace -shard=0 -target_version=8.0 -upgrade_hook=../upgrade_mysql":  "hook name cannot have a '/' in it",
+	} {
+		_, err := m.Create(ctx, mysqlUpgradeFactoryName, strings.Split(args, " "))
+		assert.EqualError(t, err, want, args)
+	}
+	upgraded, _ := fw.calls()
+	assert.Empty(t, upgraded)
+}
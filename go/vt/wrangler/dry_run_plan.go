@@ -0,0 +1,61 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+// DryRunActionType is the kind of change that a DryRunAction describes.
+type DryRunActionType string
+
+// The types of the dry run actions.
+const (
+	// DryRunLock locks or unlocks a keyspace.
+	DryRunLock = DryRunActionType("Lock")
+	// DryRunTopo changes a record of the topo: a shard, a SrvKeyspace,
+	// a vschema or a SrvVSchema.
+	DryRunTopo = DryRunActionType("Topo")
+	// DryRunRoutingRules changes the routing rules.
+	DryRunRoutingRules = DryRunActionType("RoutingRules")
+	// DryRunQuery runs a query on a tablet.
+	DryRunQuery = DryRunActionType("Query")
+	// DryRunTabletRPC calls a tablet manager RPC on a tablet.
+	DryRunTabletRPC = DryRunActionType("TabletRPC")
+	// DryRunWait waits for the streams of the workflow.
+	DryRunWait = DryRunActionType("Wait")
+)
+
+// DryRunAction is one change that a workflow action would make.
+type DryRunAction struct {
+	Type        DryRunActionType `json:"type"`
+	Description string           `json:"description"`
+	Keyspace    string           `json:"keyspace,omitempty"`
+	Shard       string           `json:"shard,omitempty"`
+	Tablet      string           `json:"tablet,omitempty"`
+	Cells       []string         `json:"cells,omitempty"`
+	Query       string           `json:"query,omitempty"`
+	// RoutingRules are the routing rules that would be added or changed.
+	RoutingRules map[string][]string `json:"routing_rules,omitempty"`
+	// DeletedRoutingRules are the routing rules that would be deleted.
+	DeletedRoutingRules []string `json:"deleted_routing_rules,omitempty"`
+}
+
+// DryRunPlan lists, in order, the changes that a workflow action would make
+// without -dry_run.
+type DryRunPlan struct {
+	Action   string          `json:"action"`
+	Keyspace string          `json:"keyspace"`
+	Workflow string          `json:"workflow"`
+	Actions  []*DryRunAction `json:"actions"`
+}
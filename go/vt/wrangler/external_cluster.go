@@ -18,8 +18,17 @@ package wrangler
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 
+	"google.golang.org/protobuf/encoding/prototext"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"vitess.io/vitess/go/sqltypes"
+	vtctldvexec "vitess.io/vitess/go/vt/vtctl/workflow/vexec" // renamed to avoid a collision with the vexec struct in this package
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
 	"vitess.io/vitess/go/vt/proto/topodata"
 )
 
@@ -32,6 +41,13 @@ func (wr *Wrangler) MountExternalVitessCluster(ctx context.Context, clusterName,
 	if vci != nil {
 		return fmt.Errorf("there is already a vitess cluster named %s", clusterName)
 	}
+	em, err := wr.TopoServer().GetExternalMysql(ctx, clusterName)
+	if err != nil {
+		return err
+	}
+	if em != nil {
+		return fmt.Errorf("there is already an external mysql named %s", clusterName)
+	}
 	vc := &topodata.ExternalVitessCluster{
 		TopoConfig: &topodata.TopoConfig{
 			TopoType: topoType,
@@ -42,7 +58,8 @@ func (wr *Wrangler) MountExternalVitessCluster(ctx context.Context, clusterName,
 	return wr.TopoServer().CreateExternalVitessCluster(ctx, clusterName, vc)
 }
 
-// UnmountExternalVitessCluster deletes a mounted cluster from the topo
+// UnmountExternalVitessCluster deletes a mounted cluster from the topo. It
+// refuses to unmount a cluster that workflows still replicate from.
 func (wr *Wrangler) UnmountExternalVitessCluster(ctx context.Context, clusterName string) error {
 	vci, err := wr.TopoServer().GetExternalVitessCluster(ctx, clusterName)
 	if err != nil {
@@ -51,5 +68,45 @@ func (wr *Wrangler) UnmountExternalVitessCluster(ctx context.Context, clusterNam
 	if vci == nil {
 		return fmt.Errorf("there is no vitess cluster named %s", clusterName)
 	}
+	workflows, err := wr.getExternalClusterWorkflows(ctx, clusterName)
+	if err != nil {
+		return err
+	}
+	if len(workflows) > 0 {
+		return fmt.Errorf("cannot unmount vitess cluster %s, workflows %s replicate from it", clusterName, strings.Join(workflows, ","))
+	}
 	return wr.TopoServer().DeleteExternalVitessCluster(ctx, clusterName)
 }
+
+// getExternalClusterWorkflows returns the workflows, as keyspace.workflow,
+// with streams replicating from a mounted cluster.
+func (wr *Wrangler) getExternalClusterWorkflows(ctx context.Context, clusterName string) ([]string, error) {
+	keyspaces, err := wr.ts.GetKeyspaces(ctx)
+	if err != nil {
+		return nil, err
+	}
+	workflows := sets.NewString()
+	for _, keyspace := range keyspaces {
+		vx := vtctldvexec.NewVExec(keyspace, "", wr.ts, wr.tmc)
+		results, err := vx.QueryContext(ctx, "select workflow, source from _vt.vreplication")
+		if errors.Is(err, vtctldvexec.ErrNoShardsForKeyspace) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		for _, result := range results {
+			qr := sqltypes.Proto3ToResult(result)
+			for _, row := range qr.Rows {
+				bls := &binlogdatapb.BinlogSource{}
+				if err := prototext.Unmarshal(row[1].ToBytes(), bls); err != nil {
+					return nil, err
+				}
+				if bls.ExternalCluster == clusterName {
+					workflows.Insert(fmt.Sprintf("%s.%s", keyspace, row[0].ToString()))
+				}
+			}
+		}
+	}
+	return workflows.List(), nil
+}
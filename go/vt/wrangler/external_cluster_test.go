@@ -2,14 +2,18 @@ package wrangler
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"vitess.io/vitess/go/test/utils"
 
 	"github.com/stretchr/testify/require"
 
+	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/proto/binlogdata"
 	"vitess.io/vitess/go/vt/proto/topodata"
+	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/topo/memorytopo"
 )
 
@@ -67,3 +71,40 @@ func TestVitessCluster(t *testing.T) {
 		require.EqualValues(t, []string{"c2"}, clusters)
 	})
 }
+
+func TestUnmountVitessClusterWithWorkflows(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("zone1")
+	tmc := newTestWranglerTMClient()
+	wr := New(logutil.NewConsoleLogger(), ts, tmc)
+
+	require.NoError(t, wr.MountExternalVitessCluster(ctx, "ext", "x", "y", "z"))
+	require.NoError(t, ts.CreateKeyspace(ctx, "ks", &topodata.Keyspace{}))
+	require.NoError(t, ts.CreateShard(ctx, "ks", "0"))
+	primary := &topodata.Tablet{
+		Alias:    &topodata.TabletAlias{Cell: "zone1", Uid: 100},
+		Keyspace: "ks",
+		Shard:    "0",
+		Type:     topodata.TabletType_PRIMARY,
+	}
+	require.NoError(t, ts.CreateTablet(ctx, primary))
+	_, err := ts.UpdateShardFields(ctx, "ks", "0", func(si *topo.ShardInfo) error {
+		si.PrimaryAlias = primary.Alias
+		return nil
+	})
+	require.NoError(t, err)
+
+	query := "select workflow, source from _vt.vreplication where db_name = 'vt_ks'"
+	fields := sqltypes.MakeTestFields("workflow|source", "varchar|varchar")
+	bls := &binlogdata.BinlogSource{Keyspace: "commerce", Shard: "0", ExternalCluster: "ext"}
+	tmc.setVRResults(primary, query, sqltypes.MakeTestResult(fields, fmt.Sprintf("migrate|%v", bls)))
+	err = wr.UnmountExternalVitessCluster(ctx, "ext")
+	require.EqualError(t, err, "cannot unmount vitess cluster ext, workflows ks.migrate replicate from it")
+
+	bls.ExternalCluster = ""
+	tmc.setVRResults(primary, query, sqltypes.MakeTestResult(fields, fmt.Sprintf("movetables|%v", bls)))
+	require.NoError(t, wr.UnmountExternalVitessCluster(ctx, "ext"))
+	vci, err := ts.GetExternalVitessCluster(ctx, "ext")
+	require.NoError(t, err)
+	require.Nil(t, vci)
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"fmt"
+
+	"vitess.io/vitess/go/sqlescape"
+	"vitess.io/vitess/go/vt/dbconfigs"
+	"vitess.io/vitess/go/vt/topo"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// externalMysqlShard is the name of the only shard of an external mysql in
+// the streams of the workflows that replicate from it.
+const externalMysqlShard = "0"
+
+// getExternalMysqlSource returns the external mysql of the topo a workflow
+// replicates from, or nil if the external cluster of the workflow is a
+// mounted vitess cluster. The source keyspace of the workflow must be the
+// database of the external mysql.
+func (wr *Wrangler) getExternalMysqlSource(ctx context.Context, externalCluster, sourceKeyspace string) (*topo.ExternalMysqlInfo, error) {
+	if externalCluster == "" {
+		return nil, nil
+	}
+	em, err := wr.ts.GetExternalMysql(ctx, externalCluster)
+	if err != nil || em == nil {
+		return nil, err
+	}
+	if sourceKeyspace != em.DbName {
+		return nil, fmt.Errorf("the external mysql %s replicates database %s, not %s", externalCluster, em.DbName, sourceKeyspace)
+	}
+	return em, nil
+}
+
+// getExternalMysqlTableDDLs returns the create statements of the tables of
+// the database of an external mysql.
+func getExternalMysqlTableDDLs(ctx context.Context, em *topodatapb.ExternalMysql) (map[string]string, error) {
+	conn, err := dbconfigs.NewExternalMysqlDBConfigs(em).AppWithDB().Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	qr, err := conn.ExecuteFetch("show full tables where Table_type = 'BASE TABLE'", 100000, false)
+	if err != nil {
+		return nil, err
+	}
+	ddls := make(map[string]string, len(qr.Rows))
+	for _, row := range qr.Rows {
+		table := row[0].ToString()
+		create, err := conn.ExecuteFetch(fmt.Sprintf("show create table %s", sqlescape.EscapeID(table)), 1, false)
+		if err != nil {
+			return nil, err
+		}
+		if len(create.Rows) != 1 || len(create.Rows[0]) < 2 {
+			return nil, fmt.Errorf("unexpected result for show create table %s: %v", table, create.Rows)
+		}
+		ddls[table] = create.Rows[0][1].ToString()
+	}
+	return ddls, nil
+}
@@ -37,11 +37,13 @@ import (
 	"context"
 
 	"vitess.io/vitess/go/json2"
+	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/binlog/binlogplayer"
 	"vitess.io/vitess/go/vt/concurrency"
 	"vitess.io/vitess/go/vt/key"
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
 	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
 	"vitess.io/vitess/go/vt/sqlparser"
@@ -57,6 +59,9 @@ type materializer struct {
 	targetVSchema *vindexes.KeyspaceSchema
 	sourceShards  []*topo.ShardInfo
 	targetShards  []*topo.ShardInfo
+	// externalMysql is the external mysql the streams replicate from, if any.
+	externalMysql *topo.ExternalMysqlInfo
+	onDDL         binlogdatapb.OnDDLAction
 }
 
 const (
@@ -67,18 +72,30 @@ const (
 // MoveTables initiates moving table(s) over to another keyspace
 func (wr *Wrangler) MoveTables(ctx context.Context, workflow, sourceKeyspace, targetKeyspace, tableSpecs,
 	cell, tabletTypes string, allTables bool, excludeTables string, autoStart, stopAfterCopy bool,
-	externalCluster string) error {
+	externalCluster, onDDL string) error {
 	//FIXME validate tableSpecs, allTables, excludeTables
 	var tables []string
 	var externalTopo *topo.Server
 	var err error
 
-	if externalCluster != "" {
+	if _, err := parseOnDDL(onDDL); err != nil {
+		return err
+	}
+
+	externalMysql, err := wr.getExternalMysqlSource(ctx, externalCluster, sourceKeyspace)
+	if err != nil {
+		return err
+	}
+	if externalCluster != "" && externalMysql == nil {
 		externalTopo, err = wr.ts.OpenExternalVitessClusterServer(ctx, externalCluster)
 		if err != nil {
 			return err
 		}
 		wr.sourceTs = externalTopo
+		defer func() {
+			wr.sourceTs = wr.ts
+			externalTopo.Close()
+		}()
 		log.Infof("Successfully opened external topo: %+v", externalTopo)
 	}
 	var vschema *vschemapb.Keyspace
@@ -106,9 +123,21 @@ func (wr *Wrangler) MoveTables(ctx context.Context, workflow, sourceKeyspace, ta
 		if len(strings.TrimSpace(tableSpecs)) > 0 {
 			tables = strings.Split(tableSpecs, ",")
 		}
-		ksTables, err := wr.getKeyspaceTables(ctx, sourceKeyspace, wr.sourceTs)
-		if err != nil {
-			return err
+		var ksTables []string
+		if externalMysql != nil {
+			ddls, err := getExternalMysqlTableDDLs(ctx, externalMysql.ExternalMysql)
+			if err != nil {
+				return err
+			}
+			for table := range ddls {
+				ksTables = append(ksTables, table)
+			}
+			sort.Strings(ksTables)
+		} else {
+			ksTables, err = wr.getKeyspaceTables(ctx, sourceKeyspace, wr.sourceTs)
+			if err != nil {
+				return err
+			}
 		}
 		if len(tables) > 0 {
 			err = wr.validateSourceTablesExist(ctx, sourceKeyspace, ksTables, tables)
@@ -157,7 +186,7 @@ func (wr *Wrangler) MoveTables(ctx context.Context, workflow, sourceKeyspace, ta
 			}
 		}
 	}
-	if externalTopo == nil {
+	if externalCluster == "" {
 		// Save routing rules before vschema. If we save vschema first, and routing rules
 		// fails to save, we may generate duplicate table errors.
 		rules, err := topotools.GetRoutingRules(ctx, wr.ts)
@@ -198,6 +227,7 @@ func (wr *Wrangler) MoveTables(ctx context.Context, workflow, sourceKeyspace, ta
 		TabletTypes:           tabletTypes,
 		StopAfterCopy:         stopAfterCopy,
 		ExternalCluster:       externalCluster,
+		OnDdl:                 onDDL,
 	}
 	for _, table := range tables {
 		buf := sqlparser.NewTrackedBuffer(nil)
@@ -861,10 +891,30 @@ func (wr *Wrangler) buildMaterializer(ctx context.Context, ms *vtctldatapb.Mater
 		}
 	}
 
-	sourceShards, err := wr.sourceTs.GetServingShards(ctx, ms.SourceKeyspace)
+	for _, ts := range ms.TableSettings {
+		if err := validateCharsetConversions(ts); err != nil {
+			return nil, err
+		}
+	}
+	onDDL, err := parseOnDDL(ms.OnDdl)
 	if err != nil {
 		return nil, err
 	}
+
+	externalMysql, err := wr.getExternalMysqlSource(ctx, ms.ExternalCluster, ms.SourceKeyspace)
+	if err != nil {
+		return nil, err
+	}
+	var sourceShards []*topo.ShardInfo
+	if externalMysql != nil {
+		// An external mysql is a single unsharded source.
+		sourceShards = []*topo.ShardInfo{topo.NewShardInfo(ms.SourceKeyspace, externalMysqlShard, &topodatapb.Shard{}, nil)}
+	} else {
+		sourceShards, err = wr.sourceTs.GetServingShards(ctx, ms.SourceKeyspace)
+		if err != nil {
+			return nil, err
+		}
+	}
 	targetShards, err := wr.ts.GetServingShards(ctx, ms.TargetKeyspace)
 	if err != nil {
 		return nil, err
@@ -875,10 +925,15 @@ func (wr *Wrangler) buildMaterializer(ctx context.Context, ms *vtctldatapb.Mater
 		targetVSchema: targetVSchema,
 		sourceShards:  sourceShards,
 		targetShards:  targetShards,
+		externalMysql: externalMysql,
+		onDDL:         onDDL,
 	}, nil
 }
 
 func (mz *materializer) getSourceTableDDLs(ctx context.Context) (map[string]string, error) {
+	if mz.externalMysql != nil {
+		return getExternalMysqlTableDDLs(ctx, mz.externalMysql.ExternalMysql)
+	}
 	sourceDDLs := make(map[string]string)
 	allTables := []string{"/.*/"}
 
@@ -1037,10 +1092,16 @@ func (mz *materializer) generateInserts(ctx context.Context, targetShard *topo.S
 			Filter:          &binlogdatapb.Filter{},
 			StopAfterCopy:   mz.ms.StopAfterCopy,
 			ExternalCluster: mz.ms.ExternalCluster,
+			OnDdl:           mz.onDDL,
+		}
+		if mz.externalMysql != nil {
+			bls.ExternalCluster = ""
+			bls.ExternalMysql = mz.externalMysql.Name
 		}
 		for _, ts := range mz.ms.TableSettings {
 			rule := &binlogdatapb.Rule{
-				Match: ts.TargetTable,
+				Match:          ts.TargetTable,
+				ConvertCharset: ts.ConvertCharset,
 			}
 
 			if ts.SourceExpression == "" {
@@ -1109,6 +1170,61 @@ func (mz *materializer) generateInserts(ctx context.Context, targetShard *topo.S
 	return ig.String(), nil
 }
 
+// parseOnDDL returns the action named by the on_ddl of a workflow, which
+// defaults to IGNORE.
+func parseOnDDL(onDDL string) (binlogdatapb.OnDDLAction, error) {
+	if onDDL == "" {
+		return binlogdatapb.OnDDLAction_IGNORE, nil
+	}
+	action, ok := binlogdatapb.OnDDLAction_value[strings.ToUpper(onDDL)]
+	if !ok {
+		return 0, fmt.Errorf("invalid on_ddl action %v, must be one of IGNORE, STOP, EXEC, EXEC_IGNORE or EXEC_ONLINE_DDL", onDDL)
+	}
+	return binlogdatapb.OnDDLAction(action), nil
+}
+
+// validateCharsetConversions checks that the applier can convert the
+// charsets of the columns of a table, and that its source expression
+// selects them.
+func validateCharsetConversions(ts *vtctldatapb.TableMaterializeSettings) error {
+	if len(ts.ConvertCharset) == 0 {
+		return nil
+	}
+	var sel *sqlparser.Select
+	if ts.SourceExpression != "" {
+		stmt, err := sqlparser.Parse(ts.SourceExpression)
+		if err != nil {
+			return err
+		}
+		var ok bool
+		if sel, ok = stmt.(*sqlparser.Select); !ok {
+			return fmt.Errorf("unrecognized statement: %s", ts.SourceExpression)
+		}
+	}
+	columns := make([]string, 0, len(ts.ConvertCharset))
+	for column := range ts.ConvertCharset {
+		columns = append(columns, column)
+	}
+	sort.Strings(columns)
+	for _, column := range columns {
+		conversion := ts.ConvertCharset[column]
+		if conversion == nil {
+			return fmt.Errorf("no charset conversion for column %s of table %s", column, ts.TargetTable)
+		}
+		for _, charset := range []string{conversion.FromCharset, conversion.ToCharset} {
+			if _, ok := mysql.CharacterSetEncoding[charset]; !ok {
+				return fmt.Errorf("charset %q of column %s of table %s is not supported", charset, column, ts.TargetTable)
+			}
+		}
+		if sel != nil {
+			if _, err := matchColInSelect(sqlparser.NewColIdent(column), sel); err != nil {
+				return fmt.Errorf("cannot convert the charset of column %s of table %s: the column is not selected by %s", column, ts.TargetTable, ts.SourceExpression)
+			}
+		}
+	}
+	return nil
+}
+
 func matchColInSelect(col sqlparser.ColIdent, sel *sqlparser.Select) (*sqlparser.ColName, error) {
 	for _, selExpr := range sel.SelectExprs {
 		switch selExpr := selExpr.(type) {
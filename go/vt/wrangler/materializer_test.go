@@ -29,6 +29,7 @@ import (
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/test/utils"
 	"vitess.io/vitess/go/vt/logutil"
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
@@ -62,7 +63,7 @@ func TestMigrateTables(t *testing.T) {
 	env.tmc.expectVRQuery(200, mzUpdateQuery, &sqltypes.Result{})
 
 	ctx := context.Background()
-	err := env.wr.MoveTables(ctx, "workflow", "sourceks", "targetks", "t1", "", "", false, "", true, false, "")
+	err := env.wr.MoveTables(ctx, "workflow", "sourceks", "targetks", "t1", "", "", false, "", true, false, "", "")
 	require.NoError(t, err)
 	vschema, err := env.wr.ts.GetSrvVSchema(ctx, env.cell)
 	require.NoError(t, err)
@@ -77,6 +78,42 @@ func TestMigrateTables(t *testing.T) {
 	}
 }
 
+func TestMoveTablesOnDDL(t *testing.T) {
+	ms := &vtctldatapb.MaterializeSettings{
+		Workflow:       "workflow",
+		SourceKeyspace: "sourceks",
+		TargetKeyspace: "targetks",
+		TableSettings: []*vtctldatapb.TableMaterializeSettings{{
+			TargetTable:      "t1",
+			SourceExpression: "select * from t1",
+		}},
+	}
+	ctx := context.Background()
+
+	t.Run("exec_online_ddl", func(t *testing.T) {
+		env := newTestMaterializerEnv(t, ms, []string{"0"}, []string{"0"})
+		defer env.close()
+
+		env.tmc.expectVRQuery(100, mzCheckJournal, &sqltypes.Result{})
+		env.tmc.expectVRQuery(200, mzSelectFrozenQuery, &sqltypes.Result{})
+		env.tmc.expectVRQuery(200, `/insert into _vt.vreplication.*on_ddl:EXEC_ONLINE_DDL`, &sqltypes.Result{})
+		env.tmc.expectVRQuery(200, mzSelectIDQuery, &sqltypes.Result{})
+		env.tmc.expectVRQuery(200, mzUpdateQuery, &sqltypes.Result{})
+
+		err := env.wr.MoveTables(ctx, "workflow", "sourceks", "targetks", "t1", "", "", false, "", true, false, "", "exec_online_ddl")
+		require.NoError(t, err)
+		env.tmc.verifyQueries(t)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		env := newTestMaterializerEnv(t, ms, []string{"0"}, []string{"0"})
+		defer env.close()
+
+		err := env.wr.MoveTables(ctx, "workflow", "sourceks", "targetks", "t1", "", "", false, "", true, false, "", "apply")
+		require.EqualError(t, err, "invalid on_ddl action apply, must be one of IGNORE, STOP, EXEC, EXEC_IGNORE or EXEC_ONLINE_DDL")
+	})
+}
+
 func TestMissingTables(t *testing.T) {
 	ms := &vtctldatapb.MaterializeSettings{
 		Workflow:       "workflow",
@@ -103,11 +140,11 @@ func TestMissingTables(t *testing.T) {
 	env.tmc.expectVRQuery(200, mzUpdateQuery, &sqltypes.Result{})
 
 	ctx := context.Background()
-	err := env.wr.MoveTables(ctx, "workflow", "sourceks", "targetks", "t1,tyt", "", "", false, "", true, false, "")
+	err := env.wr.MoveTables(ctx, "workflow", "sourceks", "targetks", "t1,tyt", "", "", false, "", true, false, "", "")
 	require.EqualError(t, err, "table(s) not found in source keyspace sourceks: tyt")
-	err = env.wr.MoveTables(ctx, "workflow", "sourceks", "targetks", "t1,tyt,t2,txt", "", "", false, "", true, false, "")
+	err = env.wr.MoveTables(ctx, "workflow", "sourceks", "targetks", "t1,tyt,t2,txt", "", "", false, "", true, false, "", "")
 	require.EqualError(t, err, "table(s) not found in source keyspace sourceks: tyt,txt")
-	err = env.wr.MoveTables(ctx, "workflow", "sourceks", "targetks", "t1", "", "", false, "", true, false, "")
+	err = env.wr.MoveTables(ctx, "workflow", "sourceks", "targetks", "t1", "", "", false, "", true, false, "", "")
 	require.NoError(t, err)
 }
 
@@ -163,7 +200,7 @@ func TestMoveTablesAllAndExclude(t *testing.T) {
 			env.tmc.expectVRQuery(200, insertPrefix, &sqltypes.Result{})
 			env.tmc.expectVRQuery(200, mzSelectIDQuery, &sqltypes.Result{})
 			env.tmc.expectVRQuery(200, mzUpdateQuery, &sqltypes.Result{})
-			err = env.wr.MoveTables(ctx, "workflow", "sourceks", "targetks", "", "", "", tcase.allTables, tcase.excludeTables, true, false, "")
+			err = env.wr.MoveTables(ctx, "workflow", "sourceks", "targetks", "", "", "", tcase.allTables, tcase.excludeTables, true, false, "", "")
 			require.NoError(t, err)
 			require.EqualValues(t, tcase.want, targetTables(env))
 		})
@@ -197,7 +234,7 @@ func TestMoveTablesStopFlags(t *testing.T) {
 		env.tmc.expectVRQuery(200, mzSelectIDQuery, &sqltypes.Result{})
 		// -auto_start=false is tested by NOT expecting the update query which sets state to RUNNING
 		err = env.wr.MoveTables(ctx, "workflow", "sourceks", "targetks", "t1", "",
-			"", false, "", false, true, "")
+			"", false, "", false, true, "", "")
 		require.NoError(t, err)
 		env.tmc.verifyQueries(t)
 	})
@@ -223,7 +260,7 @@ func TestMigrateVSchema(t *testing.T) {
 	env.tmc.expectVRQuery(200, mzUpdateQuery, &sqltypes.Result{})
 
 	ctx := context.Background()
-	err := env.wr.MoveTables(ctx, "workflow", "sourceks", "targetks", `{"t1":{}}`, "", "", false, "", true, false, "")
+	err := env.wr.MoveTables(ctx, "workflow", "sourceks", "targetks", `{"t1":{}}`, "", "", false, "", true, false, "", "")
 	require.NoError(t, err)
 	vschema, err := env.wr.ts.GetSrvVSchema(ctx, env.cell)
 	require.NoError(t, err)
@@ -1790,6 +1827,90 @@ func TestMaterializerOneToOne(t *testing.T) {
 	env.tmc.verifyQueries(t)
 }
 
+func TestMaterializerConvertCharset(t *testing.T) {
+	ms := &vtctldatapb.MaterializeSettings{
+		Workflow:       "workflow",
+		SourceKeyspace: "sourceks",
+		TargetKeyspace: "targetks",
+		TableSettings: []*vtctldatapb.TableMaterializeSettings{{
+			TargetTable:      "t1",
+			SourceExpression: "select * from t1",
+			CreateDdl:        "t1ddl",
+			ConvertCharset: map[string]*binlogdatapb.CharsetConversion{
+				"val": {FromCharset: "latin1", ToCharset: "utf8mb4"},
+			},
+		}},
+		Cell:        "zone1",
+		TabletTypes: "primary",
+	}
+	env := newTestMaterializerEnv(t, ms, []string{"0"}, []string{"0"})
+	defer env.close()
+
+	env.tmc.expectVRQuery(200, mzSelectFrozenQuery, &sqltypes.Result{})
+	env.tmc.expectVRQuery(
+		200,
+		insertPrefix+
+			`\(`+
+			`'workflow', `+
+			(`'keyspace:\\"sourceks\\" shard:\\"0\\" `+
+				`filter:{`+
+				`rules:{match:\\"t1\\" filter:\\"select.*t1\\" convert_charset:{key:\\"val\\" value:{from_charset:\\"latin1\\" to_charset:\\"utf8mb4\\"}}}`+
+				`}', `)+
+			`'', [0-9]*, [0-9]*, 'zone1', 'primary', [0-9]*, 0, 'Stopped', 'vt_targetks'`+
+			`\)`+eol,
+		&sqltypes.Result{},
+	)
+	env.tmc.expectVRQuery(200, mzUpdateQuery, &sqltypes.Result{})
+
+	err := env.wr.Materialize(context.Background(), ms)
+	require.NoError(t, err)
+	env.tmc.verifyQueries(t)
+}
+
+func TestMaterializerConvertCharsetValidation(t *testing.T) {
+	testcases := []struct {
+		sourceExpression string
+		conversion       *binlogdatapb.CharsetConversion
+		err              string
+	}{{
+		sourceExpression: "select * from t1",
+		conversion:       &binlogdatapb.CharsetConversion{FromCharset: "latin9", ToCharset: "utf8mb4"},
+		err:              `charset "latin9" of column val of table t1 is not supported`,
+	}, {
+		sourceExpression: "select * from t1",
+		conversion:       &binlogdatapb.CharsetConversion{FromCharset: "latin1"},
+		err:              `charset "" of column val of table t1 is not supported`,
+	}, {
+		sourceExpression: "select id from t1",
+		conversion:       &binlogdatapb.CharsetConversion{FromCharset: "latin1", ToCharset: "utf8mb4"},
+		err:              "cannot convert the charset of column val of table t1: the column is not selected by select id from t1",
+	}, {
+		sourceExpression: "select * from t1",
+		err:              "no charset conversion for column val of table t1",
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.err, func(t *testing.T) {
+			ms := &vtctldatapb.MaterializeSettings{
+				Workflow:       "workflow",
+				SourceKeyspace: "sourceks",
+				TargetKeyspace: "targetks",
+				TableSettings: []*vtctldatapb.TableMaterializeSettings{{
+					TargetTable:      "t1",
+					SourceExpression: tcase.sourceExpression,
+					CreateDdl:        "t1ddl",
+					ConvertCharset:   map[string]*binlogdatapb.CharsetConversion{"val": tcase.conversion},
+				}},
+			}
+			env := newTestMaterializerEnv(t, ms, []string{"0"}, []string{"0"})
+			defer env.close()
+
+			env.tmc.expectVRQuery(200, mzSelectFrozenQuery, &sqltypes.Result{})
+			err := env.wr.Materialize(context.Background(), ms)
+			require.EqualError(t, err, tcase.err)
+		})
+	}
+}
+
 func TestMaterializerManyToOne(t *testing.T) {
 	ms := &vtctldatapb.MaterializeSettings{
 		Workflow:       "workflow",
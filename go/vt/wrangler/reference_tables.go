@@ -0,0 +1,296 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/protoutil"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/concurrency"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// A reference tables workflow is a Materialize workflow per target keyspace,
+// of the same name, which copies the tables unfiltered to every shard. Its
+// definition is stored in the topo, so that a refresh can create the streams
+// of the shards that a resharding added to a target keyspace.
+
+// CreateReferenceTablesWorkflow saves the reference tables workflow, and
+// creates and starts its streams on all the shards of its target keyspaces.
+func (wr *Wrangler) CreateReferenceTablesWorkflow(ctx context.Context, workflow *topodatapb.ReferenceTablesWorkflow) ([]*vtctldatapb.ReferenceTablesShardStatus, error) {
+	ksTables, err := wr.getKeyspaceTables(ctx, workflow.SourceKeyspace, wr.sourceTs)
+	if err != nil {
+		return nil, err
+	}
+	if err := wr.validateSourceTablesExist(ctx, workflow.SourceKeyspace, ksTables, workflow.Tables); err != nil {
+		return nil, err
+	}
+
+	for _, keyspace := range workflow.TargetKeyspaces {
+		if keyspace == workflow.SourceKeyspace {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "the source keyspace %s cannot be a target keyspace", keyspace)
+		}
+		vschema, err := wr.ts.GetVSchema(ctx, keyspace)
+		if err != nil {
+			return nil, vterrors.Wrapf(err, "GetVSchema(%s)", keyspace)
+		}
+		if vschema.Sharded {
+			for _, table := range workflow.Tables {
+				if vschema.Tables[table].GetType() != vindexes.TypeReference {
+					return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "table %s must be a reference table in the vschema of keyspace %s", table, keyspace)
+				}
+			}
+		}
+		if err := wr.validateNewWorkflow(ctx, keyspace, workflow.Name); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := wr.ts.CreateReferenceTablesWorkflow(ctx, workflow); err != nil {
+		return nil, err
+	}
+	wr.Logger().Infof("Created the reference tables workflow %v, from %v to %v", workflow.Name, workflow.SourceKeyspace, strings.Join(workflow.TargetKeyspaces, ","))
+
+	return wr.refreshReferenceTablesWorkflow(ctx, workflow)
+}
+
+// RefreshReferenceTablesWorkflows creates the missing streams of the
+// reference tables workflows, or of all of them if names is empty, and
+// returns the status of the streams of every target shard.
+func (wr *Wrangler) RefreshReferenceTablesWorkflows(ctx context.Context, names []string) ([]*vtctldatapb.ReferenceTablesShardStatus, error) {
+	if len(names) == 0 {
+		var err error
+		if names, err = wr.ts.GetReferenceTablesWorkflowNames(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	var (
+		statuses []*vtctldatapb.ReferenceTablesShardStatus
+		rec      concurrency.AllErrorRecorder
+	)
+	for _, name := range names {
+		workflow, err := wr.ts.GetReferenceTablesWorkflow(ctx, name)
+		if err != nil {
+			rec.RecordError(vterrors.Wrapf(err, "GetReferenceTablesWorkflow(%s)", name))
+			continue
+		}
+		workflowStatuses, err := wr.refreshReferenceTablesWorkflow(ctx, workflow)
+		statuses = append(statuses, workflowStatuses...)
+		rec.RecordError(err)
+	}
+	return statuses, rec.AggrError(vterrors.Aggregate)
+}
+
+// DeleteReferenceTablesWorkflow deletes the streams of the reference tables
+// workflow on all the shards of its target keyspaces, and then the workflow.
+// The replicated tables are kept.
+func (wr *Wrangler) DeleteReferenceTablesWorkflow(ctx context.Context, name string) error {
+	workflow, err := wr.ts.GetReferenceTablesWorkflow(ctx, name)
+	if err != nil {
+		return vterrors.Wrapf(err, "GetReferenceTablesWorkflow(%s)", name)
+	}
+
+	for _, keyspace := range workflow.TargetKeyspaces {
+		shards, err := wr.ts.FindAllShardsInKeyspace(ctx, keyspace)
+		if err != nil {
+			return err
+		}
+		rec := &concurrency.AllErrorRecorder{}
+		var wg sync.WaitGroup
+		for _, si := range shards {
+			if si.PrimaryAlias == nil {
+				continue
+			}
+			wg.Add(1)
+			go func(si *topo.ShardInfo) {
+				defer wg.Done()
+
+				primary, err := wr.ts.GetTablet(ctx, si.PrimaryAlias)
+				if err != nil {
+					rec.RecordError(vterrors.Wrapf(err, "GetTablet(%v) failed", topoproto.TabletAliasString(si.PrimaryAlias)))
+					return
+				}
+				query := fmt.Sprintf("delete from _vt.vreplication where db_name=%s and workflow=%s", encodeString(primary.DbName()), encodeString(workflow.Name))
+				if _, err := wr.tmc.VReplicationExec(ctx, primary.Tablet, query); err != nil {
+					rec.RecordError(vterrors.Wrapf(err, "VReplicationExec(%v, %s)", topoproto.TabletAliasString(si.PrimaryAlias), query))
+				}
+			}(si)
+		}
+		wg.Wait()
+		if rec.HasErrors() {
+			return rec.AggrError(vterrors.Aggregate)
+		}
+		wr.Logger().Infof("Deleted the streams of the reference tables workflow %v in %v", workflow.Name, keyspace)
+	}
+
+	return wr.ts.DeleteReferenceTablesWorkflow(ctx, name)
+}
+
+func (wr *Wrangler) refreshReferenceTablesWorkflow(ctx context.Context, workflow *topodatapb.ReferenceTablesWorkflow) ([]*vtctldatapb.ReferenceTablesShardStatus, error) {
+	var (
+		statuses []*vtctldatapb.ReferenceTablesShardStatus
+		rec      concurrency.AllErrorRecorder
+	)
+	for _, keyspace := range workflow.TargetKeyspaces {
+		keyspaceStatuses, err := wr.refreshReferenceTablesKeyspace(ctx, workflow, keyspace)
+		statuses = append(statuses, keyspaceStatuses...)
+		if err != nil {
+			rec.RecordError(vterrors.Wrapf(err, "cannot refresh the reference tables workflow %v in %v", workflow.Name, keyspace))
+		}
+	}
+	return statuses, rec.AggrError(vterrors.Aggregate)
+}
+
+// refreshReferenceTablesKeyspace creates the streams of the workflow on the
+// serving shards of the target keyspace that have none.
+func (wr *Wrangler) refreshReferenceTablesKeyspace(ctx context.Context, workflow *topodatapb.ReferenceTablesWorkflow, keyspace string) ([]*vtctldatapb.ReferenceTablesShardStatus, error) {
+	ms := &vtctldatapb.MaterializeSettings{
+		Workflow:       workflow.Name,
+		SourceKeyspace: workflow.SourceKeyspace,
+		TargetKeyspace: keyspace,
+		Cell:           strings.Join(workflow.Cells, ","),
+		TabletTypes:    tabletTypesCSV(workflow.TabletTypes),
+	}
+	for _, table := range workflow.Tables {
+		ms.TableSettings = append(ms.TableSettings, &vtctldatapb.TableMaterializeSettings{
+			TargetTable: table,
+			CreateDdl:   createDDLAsCopy,
+		})
+	}
+	mz, err := wr.buildMaterializer(ctx, ms)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		mu       sync.Mutex
+		statuses []*vtctldatapb.ReferenceTablesShardStatus
+		missing  []*topo.ShardInfo
+	)
+	err = mz.forAllTargets(func(target *topo.ShardInfo) error {
+		status, err := wr.referenceTablesShardStatus(ctx, workflow.Name, keyspace, target)
+		if err != nil {
+			return err
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		if status == nil {
+			missing = append(missing, target)
+		} else {
+			statuses = append(statuses, status)
+		}
+		return nil
+	})
+	if err != nil {
+		return statuses, err
+	}
+
+	if len(missing) > 0 {
+		mz.targetShards = missing
+		if err := mz.deploySchema(ctx); err != nil {
+			return statuses, err
+		}
+		insertMap := make(map[string]string, len(missing))
+		for _, target := range missing {
+			inserts, err := mz.generateInserts(ctx, target)
+			if err != nil {
+				return statuses, err
+			}
+			insertMap[target.ShardName()] = inserts
+		}
+		if err := mz.createStreams(ctx, insertMap); err != nil {
+			return statuses, err
+		}
+		if err := mz.startStreams(ctx); err != nil {
+			return statuses, err
+		}
+		for _, target := range missing {
+			wr.Logger().Infof("Created the streams of the reference tables workflow %v on %v/%v", workflow.Name, keyspace, target.ShardName())
+			statuses = append(statuses, &vtctldatapb.ReferenceTablesShardStatus{
+				Workflow: workflow.Name,
+				Keyspace: keyspace,
+				Shard:    target.ShardName(),
+				Created:  true,
+				State:    binlogplayer.BlpRunning,
+			})
+		}
+	}
+
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].Shard < statuses[j].Shard })
+	return statuses, nil
+}
+
+// referenceTablesShardStatus returns the status of the streams of the
+// workflow on the target shard, or nil if it has none.
+func (wr *Wrangler) referenceTablesShardStatus(ctx context.Context, workflow, keyspace string, target *topo.ShardInfo) (*vtctldatapb.ReferenceTablesShardStatus, error) {
+	if target.PrimaryAlias == nil {
+		return nil, fmt.Errorf("shard %v/%v has no primary", keyspace, target.ShardName())
+	}
+	primary, err := wr.ts.GetTablet(ctx, target.PrimaryAlias)
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "GetTablet(%v) failed", topoproto.TabletAliasString(target.PrimaryAlias))
+	}
+	query := fmt.Sprintf("select state, message, time_updated from _vt.vreplication where db_name=%s and workflow=%s", encodeString(primary.DbName()), encodeString(workflow))
+	p3qr, err := wr.tmc.VReplicationExec(ctx, primary.Tablet, query)
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "VReplicationExec(%v, %s)", topoproto.TabletAliasString(target.PrimaryAlias), query)
+	}
+	qr := sqltypes.Proto3ToResult(p3qr)
+	if len(qr.Rows) == 0 {
+		return nil, nil
+	}
+
+	status := &vtctldatapb.ReferenceTablesShardStatus{
+		Workflow: workflow,
+		Keyspace: keyspace,
+		Shard:    target.ShardName(),
+		State:    binlogplayer.BlpRunning,
+	}
+	var staleness time.Duration
+	for _, row := range qr.Rows {
+		if state := row[0].ToString(); state != binlogplayer.BlpRunning && status.State == binlogplayer.BlpRunning {
+			status.State = state
+			status.Message = row[1].ToString()
+		}
+		timeUpdated, err := evalengine.ToInt64(row[2])
+		if err != nil {
+			return nil, err
+		}
+		if s := time.Since(time.Unix(timeUpdated, 0)); s > staleness {
+			staleness = s
+		}
+	}
+	status.Staleness = protoutil.DurationToProto(staleness)
+	return status, nil
+}
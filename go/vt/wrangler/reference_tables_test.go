@@ -0,0 +1,143 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/protoutil"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/topo"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+const (
+	referenceTablesStatusQuery = "select state, message, time_updated from _vt.vreplication where db_name='vt_targetks' and workflow='workflow'"
+	referenceTablesInsertQuery = insertPrefix + `.*shard:\\"0\\" filter:{rules:{match:\\"t1\\"}}.*`
+)
+
+func TestReferenceTablesWorkflow(t *testing.T) {
+	ctx := context.Background()
+	ms := &vtctldatapb.MaterializeSettings{
+		Workflow:       "workflow",
+		SourceKeyspace: "sourceks",
+		TargetKeyspace: "targetks",
+		TableSettings: []*vtctldatapb.TableMaterializeSettings{{
+			TargetTable: "t1",
+			CreateDdl:   createDDLAsCopy,
+		}},
+	}
+	env := newTestMaterializerEnv(t, ms, []string{"0"}, []string{"-80", "80-"})
+	defer env.close()
+
+	require.NoError(t, env.topoServ.SaveVSchema(ctx, "targetks", &vschemapb.Keyspace{
+		Sharded: true,
+		Tables: map[string]*vschemapb.Table{
+			"t1": {Type: "reference"},
+		},
+	}))
+
+	// The streams of all the target shards are created.
+	for _, tabletID := range []int{200, 210} {
+		env.tmc.expectVRQuery(tabletID, mzSelectFrozenQuery, &sqltypes.Result{})
+		env.tmc.expectVRQuery(tabletID, referenceTablesStatusQuery, &sqltypes.Result{})
+		env.tmc.expectVRQuery(tabletID, referenceTablesInsertQuery, &sqltypes.Result{})
+		env.tmc.expectVRQuery(tabletID, mzUpdateQuery, &sqltypes.Result{})
+	}
+	workflow := &topodatapb.ReferenceTablesWorkflow{
+		Name:            "workflow",
+		SourceKeyspace:  "sourceks",
+		TargetKeyspaces: []string{"targetks"},
+		Tables:          []string{"t1"},
+	}
+	statuses, err := env.wr.CreateReferenceTablesWorkflow(ctx, workflow)
+	require.NoError(t, err)
+	env.tmc.verifyQueries(t)
+	require.Len(t, statuses, 2)
+	for i, shard := range []string{"-80", "80-"} {
+		assert.Equal(t, shard, statuses[i].Shard)
+		assert.True(t, statuses[i].Created)
+	}
+
+	// The workflow cannot be created twice.
+	env.expectValidation()
+	env.tmc.expectVRQuery(200, mzSelectFrozenQuery, &sqltypes.Result{})
+	env.tmc.expectVRQuery(210, mzSelectFrozenQuery, &sqltypes.Result{})
+	_, err = env.wr.CreateReferenceTablesWorkflow(ctx, workflow)
+	assert.Error(t, err)
+	env.tmc.verifyQueries(t)
+
+	// After 80- is split, the refresh creates the streams of its new shards.
+	_, err = env.topoServ.UpdateShardFields(ctx, "targetks", "80-", func(si *topo.ShardInfo) error {
+		si.IsPrimaryServing = false
+		return nil
+	})
+	require.NoError(t, err)
+	env.addTablet(220, "targetks", "80-c0", topodatapb.TabletType_PRIMARY)
+	env.addTablet(230, "targetks", "c0-", topodatapb.TabletType_PRIMARY)
+	for _, shard := range []string{"80-c0", "c0-"} {
+		_, err = env.topoServ.UpdateShardFields(ctx, "targetks", shard, func(si *topo.ShardInfo) error {
+			si.IsPrimaryServing = true
+			return nil
+		})
+		require.NoError(t, err)
+	}
+
+	timeUpdated := time.Now().Add(-time.Minute).Unix()
+	env.tmc.expectVRQuery(200, referenceTablesStatusQuery, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("state|message|time_updated", "varchar|varchar|int64"),
+		fmt.Sprintf("Running||%d", timeUpdated),
+	))
+	for _, tabletID := range []int{220, 230} {
+		env.tmc.expectVRQuery(tabletID, referenceTablesStatusQuery, &sqltypes.Result{})
+		env.tmc.expectVRQuery(tabletID, referenceTablesInsertQuery, &sqltypes.Result{})
+		env.tmc.expectVRQuery(tabletID, mzUpdateQuery, &sqltypes.Result{})
+	}
+	statuses, err = env.wr.RefreshReferenceTablesWorkflows(ctx, nil)
+	require.NoError(t, err)
+	env.tmc.verifyQueries(t)
+	require.Len(t, statuses, 3)
+	assert.Equal(t, "-80", statuses[0].Shard)
+	assert.False(t, statuses[0].Created)
+	assert.Equal(t, "Running", statuses[0].State)
+	staleness, _, err := protoutil.DurationFromProto(statuses[0].Staleness)
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, staleness, time.Minute)
+	assert.Equal(t, "80-c0", statuses[1].Shard)
+	assert.True(t, statuses[1].Created)
+	assert.Equal(t, "c0-", statuses[2].Shard)
+	assert.True(t, statuses[2].Created)
+
+	// Deleting the workflow deletes the streams of every shard.
+	for _, tabletID := range []int{200, 210, 220, 230} {
+		env.tmc.expectVRQuery(tabletID, "delete from _vt.vreplication where db_name='vt_targetks' and workflow='workflow'", &sqltypes.Result{})
+	}
+	require.NoError(t, env.wr.DeleteReferenceTablesWorkflow(ctx, "workflow"))
+	env.tmc.verifyQueries(t)
+	names, err := env.topoServ.GetReferenceTablesWorkflowNames(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, names)
+}
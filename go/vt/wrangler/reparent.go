@@ -150,16 +150,18 @@ func (wr *Wrangler) PlannedReparentShard(ctx context.Context, keyspace, shard st
 }
 
 // EmergencyReparentShard will make the provided tablet the primary for
-// the shard, when the old primary is completely unreachable.
-func (wr *Wrangler) EmergencyReparentShard(ctx context.Context, keyspace, shard string, primaryElectTabletAlias *topodatapb.TabletAlias, waitReplicasTimeout time.Duration, ignoredTablets sets.String) (err error) {
+// the shard, when the old primary is completely unreachable. Without a
+// provided tablet, the rules choose the new primary.
+func (wr *Wrangler) EmergencyReparentShard(ctx context.Context, keyspace, shard string, primaryElectTabletAlias *topodatapb.TabletAlias, waitReplicasTimeout time.Duration, ignoredTablets sets.String, rules reparentutil.CandidateSelectionRules) (err error) {
 	_, err = reparentutil.NewEmergencyReparenter(wr.ts, wr.tmc, wr.logger).ReparentShard(
 		ctx,
 		keyspace,
 		shard,
 		reparentutil.EmergencyReparentOptions{
-			NewPrimaryAlias:     primaryElectTabletAlias,
-			WaitReplicasTimeout: waitReplicasTimeout,
-			IgnoreReplicas:      ignoredTablets,
+			NewPrimaryAlias:         primaryElectTabletAlias,
+			WaitReplicasTimeout:     waitReplicasTimeout,
+			IgnoreReplicas:          ignoredTablets,
+			CandidateSelectionRules: rules,
 		},
 	)
 
@@ -19,6 +19,7 @@ package wrangler
 import (
 	"context"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
@@ -34,8 +35,11 @@ import (
 	"vitess.io/vitess/go/vt/concurrency"
 	"vitess.io/vitess/go/vt/key"
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
 	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+	"vitess.io/vitess/go/vt/schema"
 	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/topotools"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
@@ -50,6 +54,7 @@ type resharder struct {
 	sourcePrimaries map[string]*topo.TabletInfo
 	targetShards    []*topo.ShardInfo
 	targetPrimaries map[string]*topo.TabletInfo
+	targetSources   map[string][]*topo.ShardInfo
 	vschema         *vschemapb.Keyspace
 	refStreams      map[string]*refStream
 	cell            string //single cell or cellsAlias or comma-separated list of cells/cellsAliases
@@ -82,6 +87,9 @@ func (wr *Wrangler) Reshard(ctx context.Context, keyspace, workflow string, sour
 	}
 
 	rs.stopAfterCopy = stopAfterCopy
+	if err := rs.verifyMergeSources(ctx); err != nil {
+		return vterrors.Wrap(err, "verifyMergeSources")
+	}
 	if !skipSchemaCopy {
 		if err := rs.copySchema(ctx); err != nil {
 			return vterrors.Wrap(err, "copySchema")
@@ -144,6 +152,7 @@ func (wr *Wrangler) buildResharder(ctx context.Context, keyspace, workflow strin
 	if err := topotools.ValidateForReshard(rs.sourceShards, rs.targetShards); err != nil {
 		return nil, vterrors.Wrap(err, "ValidateForReshard")
 	}
+	rs.selectTargetSources()
 	if err := rs.validateTargets(ctx); err != nil {
 		return nil, vterrors.Wrap(err, "validateTargets")
 	}
@@ -160,6 +169,116 @@ func (wr *Wrangler) buildResharder(ctx context.Context, keyspace, workflow strin
 	return rs, nil
 }
 
+// selectTargetSources selects the source shards of each target shard: those
+// whose keyranges intersect its keyrange. A target shard of a merge has more
+// than one source shard.
+func (rs *resharder) selectTargetSources() {
+	rs.targetSources = make(map[string][]*topo.ShardInfo, len(rs.targetShards))
+	for _, target := range rs.targetShards {
+		for _, source := range rs.sourceShards {
+			if key.KeyRangesIntersect(target.KeyRange, source.KeyRange) {
+				rs.targetSources[target.ShardName()] = append(rs.targetSources[target.ShardName()], source)
+			}
+		}
+	}
+}
+
+// verifyMergeSources verifies that the source shards merged into a target
+// shard can be combined. They must have the same tables, since the schema of
+// the target is copied from only one of them. In a sharded keyspace, every
+// sharded table must also have the columns of its primary vindex on each of
+// them, since the streams compute the keyspace ids of the rows from these.
+func (rs *resharder) verifyMergeSources(ctx context.Context) error {
+	var merged []*topo.ShardInfo
+	isMerged := make(map[string]bool)
+	for _, source := range rs.sourceShards {
+		for _, target := range rs.targetShards {
+			if len(rs.targetSources[target.ShardName()]) > 1 && key.KeyRangesIntersect(target.KeyRange, source.KeyRange) {
+				merged = append(merged, source)
+				isMerged[source.ShardName()] = true
+				break
+			}
+		}
+	}
+	if len(merged) == 0 {
+		return nil
+	}
+
+	var mu sync.Mutex
+	schemas := make(map[string]map[string]*tabletmanagerdatapb.TableDefinition, len(merged))
+	err := rs.forAll(merged, func(source *topo.ShardInfo) error {
+		sourcePrimary := rs.sourcePrimaries[source.ShardName()]
+		schm, err := rs.wr.tmc.GetSchema(ctx, sourcePrimary.Tablet, nil, nil, false)
+		if err != nil {
+			return vterrors.Wrapf(err, "GetSchema(%v)", topoproto.TabletAliasString(sourcePrimary.Alias))
+		}
+		tables := make(map[string]*tabletmanagerdatapb.TableDefinition)
+		for _, td := range schm.GetTableDefinitions() {
+			if schema.IsInternalOperationTableName(td.Name) {
+				continue
+			}
+			tables[td.Name] = td
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		schemas[source.ShardName()] = tables
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	first := merged[0].ShardName()
+	for _, source := range merged[1:] {
+		for name := range schemas[first] {
+			if schemas[source.ShardName()][name] == nil {
+				return fmt.Errorf("table %v of source shard %v is missing from source shard %v", name, first, source.ShardName())
+			}
+		}
+		for name := range schemas[source.ShardName()] {
+			if schemas[first][name] == nil {
+				return fmt.Errorf("table %v of source shard %v is missing from source shard %v", name, source.ShardName(), first)
+			}
+		}
+	}
+	if !rs.vschema.Sharded {
+		return nil
+	}
+	for name := range schemas[first] {
+		vtable, ok := rs.vschema.Tables[name]
+		if !ok {
+			return fmt.Errorf("table %v not found in vschema", name)
+		}
+		if vtable.Type == vindexes.TypeReference {
+			continue
+		}
+		if len(vtable.ColumnVindexes) == 0 {
+			return fmt.Errorf("table %v has no primary vindex", name)
+		}
+		columns := vtable.ColumnVindexes[0].Columns
+		if len(columns) == 0 {
+			columns = []string{vtable.ColumnVindexes[0].Column}
+		}
+		for _, source := range merged {
+			for _, column := range columns {
+				if !hasColumn(schemas[source.ShardName()][name], column) {
+					return fmt.Errorf("column %v of the primary vindex of table %v is missing from source shard %v", column, name, source.ShardName())
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func hasColumn(td *tabletmanagerdatapb.TableDefinition, column string) bool {
+	for _, c := range td.Columns {
+		if strings.EqualFold(c, column) {
+			return true
+		}
+	}
+	return false
+}
+
 func (rs *resharder) validateTargets(ctx context.Context) error {
 	err := rs.forAll(rs.targetShards, func(target *topo.ShardInfo) error {
 		targetPrimary := rs.targetPrimaries[target.ShardName()]
@@ -283,8 +402,8 @@ func (rs *resharder) identifyRuleType(rule *binlogdatapb.Rule) (workflow.StreamT
 }
 
 func (rs *resharder) copySchema(ctx context.Context) error {
-	oneSource := rs.sourceShards[0].PrimaryAlias
 	err := rs.forAll(rs.targetShards, func(target *topo.ShardInfo) error {
+		oneSource := rs.targetSources[target.ShardName()][0].PrimaryAlias
 		return rs.wr.CopySchemaShard(ctx, oneSource, []string{"/.*"}, nil, false, rs.keyspace, target.ShardName(), 1*time.Second, false)
 	})
 	return err
@@ -308,10 +427,7 @@ func (rs *resharder) createStreams(ctx context.Context) error {
 
 		// copy excludeRules to prevent data race.
 		copyExcludeRules := append([]*binlogdatapb.Rule(nil), excludeRules...)
-		for _, source := range rs.sourceShards {
-			if !key.KeyRangesIntersect(target.KeyRange, source.KeyRange) {
-				continue
-			}
+		for _, source := range rs.targetSources[target.ShardName()] {
 			filter := &binlogdatapb.Filter{
 				Rules: append(copyExcludeRules, &binlogdatapb.Rule{
 					Match:  "/.*",
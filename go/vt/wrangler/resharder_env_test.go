@@ -185,6 +185,8 @@ func (env *testResharderEnv) deleteTablet(tablet *topodatapb.Tablet) {
 type testResharderTMClient struct {
 	tmclient.TabletManagerClient
 	schema *tabletmanagerdatapb.SchemaDefinition
+	// schemas override schema for some tablets, by their uids.
+	schemas map[int]*tabletmanagerdatapb.SchemaDefinition
 
 	mu        sync.Mutex
 	vrQueries map[int][]*queryResult
@@ -202,6 +204,9 @@ func newTestResharderTMClient() *testResharderTMClient {
 }
 
 func (tmc *testResharderTMClient) GetSchema(ctx context.Context, tablet *topodatapb.Tablet, tables, excludeTables []string, includeViews bool) (*tabletmanagerdatapb.SchemaDefinition, error) {
+	if schm, ok := tmc.schemas[int(tablet.Alias.Uid)]; ok {
+		return schm, nil
+	}
 	return tmc.schema, nil
 }
 
@@ -147,6 +147,106 @@ func TestResharderManyToOne(t *testing.T) {
 	env.tmc.verifyQueries(t)
 }
 
+func TestResharderMergeMismatchedSchemas(t *testing.T) {
+	env := newTestResharderEnv(t, []string{"-40", "40-"}, []string{"0"})
+	defer env.close()
+
+	env.tmc.schema = &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{{
+			Name:    "t1",
+			Columns: []string{"c1", "c2"},
+		}, {
+			Name:    "t2",
+			Columns: []string{"c1"},
+		}},
+	}
+	env.tmc.schemas = map[int]*tabletmanagerdatapb.SchemaDefinition{
+		110: {
+			TableDefinitions: []*tabletmanagerdatapb.TableDefinition{{
+				Name:    "t1",
+				Columns: []string{"c1", "c2"},
+			}},
+		},
+	}
+
+	env.expectValidation()
+	env.expectNoRefStream()
+
+	err := env.wr.Reshard(context.Background(), env.keyspace, env.workflow, env.sources, env.targets, true, "", "", true, false)
+	assert.EqualError(t, err, "verifyMergeSources: table t2 of source shard -40 is missing from source shard 40-")
+}
+
+func TestResharderMergeVindexColumns(t *testing.T) {
+	env := newTestResharderEnv(t, []string{"-40", "40-"}, []string{"0"})
+	defer env.close()
+
+	env.tmc.schema = &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{{
+			Name:    "t1",
+			Columns: []string{"c1", "c2"},
+		}},
+	}
+	env.tmc.schemas = map[int]*tabletmanagerdatapb.SchemaDefinition{
+		110: {
+			TableDefinitions: []*tabletmanagerdatapb.TableDefinition{{
+				Name:    "t1",
+				Columns: []string{"c2"},
+			}},
+		},
+	}
+	vs := &vschemapb.Keyspace{
+		Sharded: true,
+		Vindexes: map[string]*vschemapb.Vindex{
+			"hash": {Type: "hash"},
+		},
+		Tables: map[string]*vschemapb.Table{
+			"t1": {
+				ColumnVindexes: []*vschemapb.ColumnVindex{{
+					Column: "c1",
+					Name:   "hash",
+				}},
+			},
+		},
+	}
+	require.NoError(t, env.wr.ts.SaveVSchema(context.Background(), env.keyspace, vs))
+
+	env.expectValidation()
+	env.expectNoRefStream()
+
+	err := env.wr.Reshard(context.Background(), env.keyspace, env.workflow, env.sources, env.targets, true, "", "", true, false)
+	assert.EqualError(t, err, "verifyMergeSources: column c1 of the primary vindex of table t1 is missing from source shard 40-")
+
+	// The tables of a sharded keyspace must be in its vschema.
+	t1 := vs.Tables["t1"]
+	delete(vs.Tables, "t1")
+	require.NoError(t, env.wr.ts.SaveVSchema(context.Background(), env.keyspace, vs))
+	env.expectValidation()
+	env.expectNoRefStream()
+
+	err = env.wr.Reshard(context.Background(), env.keyspace, env.workflow, env.sources, env.targets, true, "", "", true, false)
+	assert.EqualError(t, err, "verifyMergeSources: table t1 not found in vschema")
+
+	// The streams are created once the sources agree.
+	t1.ColumnVindexes[0].Column = "c2"
+	vs.Tables["t1"] = t1
+	require.NoError(t, env.wr.ts.SaveVSchema(context.Background(), env.keyspace, vs))
+	env.expectValidation()
+	env.expectNoRefStream()
+	env.tmc.expectVRQuery(
+		200,
+		insertPrefix+
+			`\('resharderTest', 'keyspace:\\"ks\\" shard:\\"-40\\" filter:{rules:{match:\\"/.*\\" filter:\\"-\\"}}', '', [0-9]*, [0-9]*, '', '', [0-9]*, 0, 'Stopped', 'vt_ks'\).*`+
+			`\('resharderTest', 'keyspace:\\"ks\\" shard:\\"40-\\" filter:{rules:{match:\\"/.*\\" filter:\\"-\\"}}', '', [0-9]*, [0-9]*, '', '', [0-9]*, 0, 'Stopped', 'vt_ks'\)`+
+			eol,
+		&sqltypes.Result{},
+	)
+	env.tmc.expectVRQuery(200, "update _vt.vreplication set state='Running' where db_name='vt_ks'", &sqltypes.Result{})
+
+	err = env.wr.Reshard(context.Background(), env.keyspace, env.workflow, env.sources, env.targets, true, "", "", true, false)
+	assert.NoError(t, err)
+	env.tmc.verifyQueries(t)
+}
+
 func TestResharderManyToMany(t *testing.T) {
 	env := newTestResharderEnv(t, []string{"-40", "40-"}, []string{"-80", "80-"})
 	defer env.close()
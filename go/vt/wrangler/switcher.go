@@ -136,3 +136,7 @@ func (r *switcher) dropTargetShards(ctx context.Context) error {
 func (r *switcher) logs() *[]string {
 	return nil
 }
+
+func (r *switcher) dryRunActions() []*DryRunAction {
+	return nil
+}
@@ -24,7 +24,10 @@ import (
 	"time"
 
 	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/vtctl/workflow"
+	"vitess.io/vitess/go/vt/vttablet/tabletmanager/vreplication"
 
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
@@ -33,17 +36,80 @@ import (
 var _ iswitcher = (*switcherDryRun)(nil)
 
 type switcherDryRun struct {
-	drLog *LogRecorder
-	ts    *trafficSwitcher
+	drLog   *LogRecorder
+	ts      *trafficSwitcher
+	actions []*DryRunAction
+}
+
+func (dr *switcherDryRun) record(action *DryRunAction) {
+	dr.actions = append(dr.actions, action)
+}
+
+// forSources calls f for the sources of the workflow, in the order of their
+// shards, so that the actions of a dry run are predictable.
+func (dr *switcherDryRun) forSources(f func(source *workflow.MigrationSource)) {
+	shards := make([]string, 0, len(dr.ts.sources))
+	for shard := range dr.ts.sources {
+		shards = append(shards, shard)
+	}
+	sort.Sort(vreplication.ShardSorter(shards))
+	for _, shard := range shards {
+		f(dr.ts.sources[shard])
+	}
+}
+
+// forTargets calls f for the targets of the workflow, in the order of their
+// shards.
+func (dr *switcherDryRun) forTargets(f func(target *workflow.MigrationTarget)) {
+	shards := make([]string, 0, len(dr.ts.targets))
+	for shard := range dr.ts.targets {
+		shards = append(shards, shard)
+	}
+	sort.Sort(vreplication.ShardSorter(shards))
+	for _, shard := range shards {
+		f(dr.ts.targets[shard])
+	}
+}
+
+// recordQuery records a query that would run on a primary.
+func (dr *switcherDryRun) recordQuery(primary *topo.TabletInfo, description, query string) {
+	dr.record(&DryRunAction{
+		Type:        DryRunQuery,
+		Description: description,
+		Keyspace:    primary.Keyspace,
+		Shard:       primary.Shard,
+		Tablet:      topoproto.TabletAliasString(primary.Alias),
+		Query:       query,
+	})
+}
+
+// recordShardChange records a change of the record of a shard.
+func (dr *switcherDryRun) recordShardChange(si *topo.ShardInfo, description string) {
+	dr.record(&DryRunAction{
+		Type:        DryRunTopo,
+		Description: description,
+		Keyspace:    si.Keyspace(),
+		Shard:       si.ShardName(),
+	})
 }
 
 func (dr *switcherDryRun) addParticipatingTablesToKeyspace(ctx context.Context, keyspace, tableSpecs string) error {
 	dr.drLog.Log("All source tables will be added to the target keyspace vschema")
+	dr.record(&DryRunAction{
+		Type:        DryRunTopo,
+		Description: fmt.Sprintf("Add the tables [%s] to the vschema of keyspace %s", strings.Join(dr.ts.tables, ","), keyspace),
+		Keyspace:    keyspace,
+	})
 	return nil
 }
 
 func (dr *switcherDryRun) deleteRoutingRules(ctx context.Context) error {
 	dr.drLog.Log("Routing rules for participating tables will be deleted")
+	dr.record(&DryRunAction{
+		Type:                DryRunRoutingRules,
+		Description:         fmt.Sprintf("Delete the routing rules of tables [%s]", strings.Join(dr.ts.tables, ",")),
+		DeletedRoutingRules: dr.ts.workflowRoutingRules(),
+	})
 	return nil
 }
 
@@ -58,13 +124,24 @@ func (dr *switcherDryRun) switchShardReads(ctx context.Context, cells []string,
 	}
 	sort.Strings(sourceShards)
 	sort.Strings(targetShards)
+	fromShards, toShards := sourceShards, targetShards
 	if direction == workflow.DirectionForward {
 		dr.drLog.Log(fmt.Sprintf("Switch reads from keyspace %s to keyspace %s for shards %s to shards %s",
 			dr.ts.sourceKeyspace, dr.ts.targetKeyspace, strings.Join(sourceShards, ","), strings.Join(targetShards, ",")))
 	} else {
+		fromShards, toShards = targetShards, sourceShards
 		dr.drLog.Log(fmt.Sprintf("Switch reads from keyspace %s to keyspace %s for shards %s to shards %s",
 			dr.ts.targetKeyspace, dr.ts.sourceKeyspace, strings.Join(targetShards, ","), strings.Join(sourceShards, ",")))
 	}
+	for _, servedType := range servedTypes {
+		dr.record(&DryRunAction{
+			Type: DryRunTopo,
+			Description: fmt.Sprintf("Serve the %s reads of keyspace %s from shards %s instead of shards %s, in the shard records and the SrvKeyspaces",
+				servedType, dr.ts.sourceKeyspace, strings.Join(toShards, ","), strings.Join(fromShards, ",")),
+			Keyspace: dr.ts.sourceKeyspace,
+			Cells:    cells,
+		})
+	}
 	return nil
 }
 
@@ -81,20 +158,48 @@ func (dr *switcherDryRun) switchTableReads(ctx context.Context, cells []string,
 	dr.drLog.Log(fmt.Sprintf("Switch reads for tables [%s] to keyspace %s for tablet types [%s]",
 		tables, ks, strings.Join(tabletTypes, ",")))
 	dr.drLog.Log(fmt.Sprintf("Routing rules for tables [%s] will be updated", tables))
+	dr.record(&DryRunAction{
+		Type:         DryRunRoutingRules,
+		Description:  fmt.Sprintf("Route the reads of tables [%s] for tablet types [%s] to keyspace %s", tables, strings.Join(tabletTypes, ","), ks),
+		RoutingRules: dr.ts.tableReadsRoutingRules(servedTypes, direction),
+	})
+	dr.recordRebuildSrvVSchema(cells)
 	return nil
 }
 
+func (dr *switcherDryRun) recordRebuildSrvVSchema(cells []string) {
+	dr.record(&DryRunAction{
+		Type:        DryRunTopo,
+		Description: "Rebuild the SrvVSchema",
+		Cells:       cells,
+	})
+}
+
 func (dr *switcherDryRun) createJournals(ctx context.Context, sourceWorkflows []string) error {
 	dr.drLog.Log("Create journal entries on source databases")
 	if len(sourceWorkflows) > 0 {
 		dr.drLog.Log("Source workflows found: ")
 		dr.drLog.LogSlice(sourceWorkflows)
 	}
+	dr.forSources(func(source *workflow.MigrationSource) {
+		if source.Journaled {
+			return
+		}
+		dr.recordQuery(source.GetPrimary(), "Create the journal of the migration, with the positions at which the writes stopped",
+			fmt.Sprintf("insert into _vt.resharding_journal (id, db_name, val) values (%v, %v, <journal>)", dr.ts.id, encodeString(source.GetPrimary().DbName())))
+	})
 	return nil
 }
 
 func (dr *switcherDryRun) allowTargetWrites(ctx context.Context) error {
 	dr.drLog.Log(fmt.Sprintf("Enable writes on keyspace %s tables [%s]", dr.ts.targetKeyspace, strings.Join(dr.ts.tables, ",")))
+	dr.forTargets(func(target *workflow.MigrationTarget) {
+		if dr.ts.migrationType == binlogdatapb.MigrationType_TABLES {
+			dr.recordShardChange(target.GetShard(), fmt.Sprintf("Remove the tables [%s] from the denied tables of the primary", strings.Join(dr.ts.tables, ",")))
+		} else {
+			dr.recordShardChange(target.GetShard(), "Enable the query service of the primary")
+		}
+	})
 	return nil
 }
 
@@ -104,6 +209,14 @@ func (dr *switcherDryRun) changeRouting(ctx context.Context) error {
 	if dr.ts.migrationType == binlogdatapb.MigrationType_TABLES {
 		tables := strings.Join(dr.ts.tables, ",")
 		dr.drLog.Log(fmt.Sprintf("Routing rules for tables [%s] will be updated", tables))
+		changed, deleted := dr.ts.writeRoutingRules()
+		dr.record(&DryRunAction{
+			Type:                DryRunRoutingRules,
+			Description:         fmt.Sprintf("Route the writes of tables [%s] to keyspace %s", tables, dr.ts.targetKeyspace),
+			RoutingRules:        changed,
+			DeletedRoutingRules: deleted,
+		})
+		dr.recordRebuildSrvVSchema(nil)
 		return nil
 	}
 	deleteLogs = nil
@@ -120,6 +233,17 @@ func (dr *switcherDryRun) changeRouting(ctx context.Context) error {
 		dr.drLog.Log("IsPrimaryServing will be set to true for:")
 		dr.drLog.LogSlice(addLogs)
 	}
+	dr.forSources(func(source *workflow.MigrationSource) {
+		dr.recordShardChange(source.GetShard(), "Set IsPrimaryServing to false")
+	})
+	dr.forTargets(func(target *workflow.MigrationTarget) {
+		dr.recordShardChange(target.GetShard(), "Set IsPrimaryServing to true")
+	})
+	dr.record(&DryRunAction{
+		Type:        DryRunTopo,
+		Description: fmt.Sprintf("Serve the PRIMARY tablet type of keyspace %s from the target shards in the SrvKeyspaces", dr.ts.targetKeyspace),
+		Keyspace:    dr.ts.targetKeyspace,
+	})
 	return nil
 }
 
@@ -130,6 +254,15 @@ func (dr *switcherDryRun) streamMigraterfinalize(ctx context.Context, ts *traffi
 		logs = append(logs, fmt.Sprintf("\ttablet %d", t.GetPrimary().Alias.Uid))
 	}
 	dr.drLog.LogSlice(logs)
+	dr.forTargets(func(target *workflow.MigrationTarget) {
+		dr.record(&DryRunAction{
+			Type:        DryRunQuery,
+			Description: fmt.Sprintf("Start the migrated streams of workflows [%s], and delete the ones of the source", strings.Join(workflows, ",")),
+			Keyspace:    target.GetPrimary().Keyspace,
+			Shard:       target.GetPrimary().Shard,
+			Tablet:      topoproto.TabletAliasString(target.GetPrimary().Alias),
+		})
+	})
 	return nil
 }
 
@@ -140,11 +273,17 @@ func (dr *switcherDryRun) startReverseVReplication(ctx context.Context) error {
 		logs = append(logs, fmt.Sprintf("\ttablet %d", t.GetPrimary().Alias.Uid))
 	}
 	dr.drLog.LogSlice(logs)
+	dr.forSources(func(source *workflow.MigrationSource) {
+		dr.recordQuery(source.GetPrimary(), "Start the reverse streams", startReverseVReplicationQuery(source.GetPrimary().DbName()))
+	})
 	return nil
 }
 
 func (dr *switcherDryRun) createReverseVReplication(ctx context.Context) error {
 	dr.drLog.Log(fmt.Sprintf("Create reverse replication workflow %s", dr.ts.reverseWorkflow))
+	dr.forSources(func(source *workflow.MigrationSource) {
+		dr.recordQuery(source.GetPrimary(), fmt.Sprintf("Create the streams of the reverse workflow %s, from the positions of the targets", dr.ts.reverseWorkflow), "")
+	})
 	return nil
 }
 
@@ -178,11 +317,25 @@ func (dr *switcherDryRun) migrateStreams(ctx context.Context, sm *workflow.Strea
 		dr.drLog.Log("Target streams will be created (as stopped):")
 		dr.drLog.LogSlice(logs)
 	}
+	dr.forTargets(func(target *workflow.MigrationTarget) {
+		dr.record(&DryRunAction{
+			Type:        DryRunQuery,
+			Description: fmt.Sprintf("Create %d migrated streams, stopped", len(templates)),
+			Keyspace:    target.GetPrimary().Keyspace,
+			Shard:       target.GetPrimary().Shard,
+			Tablet:      topoproto.TabletAliasString(target.GetPrimary().Alias),
+		})
+	})
 	return nil
 }
 
 func (dr *switcherDryRun) waitForCatchup(ctx context.Context, filteredReplicationWaitTime time.Duration) error {
 	dr.drLog.Log(fmt.Sprintf("Wait for VReplication on stopped streams to catchup for upto %v", filteredReplicationWaitTime))
+	dr.record(&DryRunAction{
+		Type:        DryRunWait,
+		Description: fmt.Sprintf("Wait up to %v for the streams to catch up with the positions at which the writes stopped, then stop them", filteredReplicationWaitTime),
+		Keyspace:    dr.ts.targetKeyspace,
+	})
 	return nil
 }
 
@@ -193,12 +346,46 @@ func (dr *switcherDryRun) stopSourceWrites(ctx context.Context) error {
 		logs = append(logs, fmt.Sprintf("\tKeyspace %s, Shard %s at Position %s", dr.ts.sourceKeyspace, source.GetShard().ShardName(), position))
 	}
 	if len(logs) > 0 {
-		dr.drLog.Log(fmt.Sprintf("Stop writes on keyspace %s, tables [%s]:", dr.ts.sourceKeyspace, strings.Join(dr.ts.tables, ",")))
+		if dr.ts.externalCluster != "" {
+			dr.drLog.Log(fmt.Sprintf("Set the primaries of keyspace %s of vitess cluster %s read-only:", dr.ts.sourceKeyspace, dr.ts.externalCluster))
+		} else {
+			dr.drLog.Log(fmt.Sprintf("Stop writes on keyspace %s, tables [%s]:", dr.ts.sourceKeyspace, strings.Join(dr.ts.tables, ",")))
+		}
 		dr.drLog.LogSlice(logs)
 	}
+	dr.recordSourceWrites(disallowWrites)
 	return nil
 }
 
+// recordSourceWrites records the changes that stop or allow the writes on the
+// sources.
+func (dr *switcherDryRun) recordSourceWrites(access accessType) {
+	dr.forSources(func(source *workflow.MigrationSource) {
+		switch {
+		case dr.ts.externalCluster != "":
+			rpc := "SetReadOnly"
+			if access == allowWrites {
+				rpc = "SetReadWrite"
+			}
+			dr.record(&DryRunAction{
+				Type:        DryRunTabletRPC,
+				Description: fmt.Sprintf("%s on the primary of vitess cluster %s", rpc, dr.ts.externalCluster),
+				Keyspace:    source.GetPrimary().Keyspace,
+				Shard:       source.GetPrimary().Shard,
+				Tablet:      topoproto.TabletAliasString(source.GetPrimary().Alias),
+			})
+		case dr.ts.migrationType == binlogdatapb.MigrationType_TABLES && access == allowWrites:
+			dr.recordShardChange(source.GetShard(), fmt.Sprintf("Remove the tables [%s] from the denied tables of the primary", strings.Join(dr.ts.tables, ",")))
+		case dr.ts.migrationType == binlogdatapb.MigrationType_TABLES:
+			dr.recordShardChange(source.GetShard(), fmt.Sprintf("Add the tables [%s] to the denied tables of the primary", strings.Join(dr.ts.tables, ",")))
+		case access == allowWrites:
+			dr.recordShardChange(source.GetShard(), "Enable the query service of the primary")
+		default:
+			dr.recordShardChange(source.GetShard(), "Disable the query service of the primary")
+		}
+	})
+}
+
 func (dr *switcherDryRun) stopStreams(ctx context.Context, sm *workflow.StreamMigrator) ([]string, error) {
 	logs := make([]string, 0)
 	for _, streams := range sm.Streams() {
@@ -211,17 +398,37 @@ func (dr *switcherDryRun) stopStreams(ctx context.Context, sm *workflow.StreamMi
 		dr.drLog.Log(fmt.Sprintf("Stop streams on keyspace %s", dr.ts.sourceKeyspace))
 		dr.drLog.LogSlice(logs)
 	}
+	dr.forSources(func(source *workflow.MigrationSource) {
+		if streams := sm.Streams()[source.GetShard().ShardName()]; len(streams) > 0 {
+			dr.record(&DryRunAction{
+				Type:        DryRunQuery,
+				Description: fmt.Sprintf("Stop the %d streams of the source that replicate from the tables of the workflow", len(streams)),
+				Keyspace:    source.GetPrimary().Keyspace,
+				Shard:       source.GetPrimary().Shard,
+				Tablet:      topoproto.TabletAliasString(source.GetPrimary().Alias),
+			})
+		}
+	})
 	return nil, nil
 }
 
 func (dr *switcherDryRun) cancelMigration(ctx context.Context, sm *workflow.StreamMigrator) {
 	dr.drLog.Log("Cancel stream migrations as requested")
+	dr.recordSourceWrites(allowWrites)
+	dr.forTargets(func(target *workflow.MigrationTarget) {
+		dr.recordQuery(target.GetPrimary(), "Restart the streams", restartWorkflowQuery(target.GetPrimary().DbName(), dr.ts.workflow))
+	})
+	dr.forSources(func(source *workflow.MigrationSource) {
+		dr.recordQuery(source.GetPrimary(), "Delete the reverse streams", deleteWorkflowQuery(source.GetPrimary().DbName(), dr.ts.reverseWorkflow))
+	})
 }
 
 func (dr *switcherDryRun) lockKeyspace(ctx context.Context, keyspace, _ string) (context.Context, func(*error), error) {
 	dr.drLog.Log(fmt.Sprintf("Lock keyspace %s", keyspace))
+	dr.record(&DryRunAction{Type: DryRunLock, Description: fmt.Sprintf("Lock keyspace %s", keyspace), Keyspace: keyspace})
 	return ctx, func(e *error) {
 		dr.drLog.Log(fmt.Sprintf("Unlock keyspace %s", keyspace))
+		dr.record(&DryRunAction{Type: DryRunLock, Description: fmt.Sprintf("Unlock keyspace %s", keyspace), Keyspace: keyspace})
 	}, nil
 }
 
@@ -242,9 +449,41 @@ func (dr *switcherDryRun) removeSourceTables(ctx context.Context, removalType wo
 			action, dr.ts.sourceKeyspace))
 		dr.drLog.LogSlice(logs)
 	}
+	dr.forSources(func(source *workflow.MigrationSource) {
+		for _, tableName := range dr.ts.tables {
+			dr.recordQuery(source.GetPrimary(), fmt.Sprintf("%s %s", removalType, tableName), removeTableQuery(source.GetPrimary().DbName(), tableName, removalType))
+		}
+	})
+	dr.recordRemoveTablesFromVSchema(dr.ts.sourceKeyspace)
 	return nil
 }
 
+func (dr *switcherDryRun) recordRemoveTablesFromVSchema(keyspace string) {
+	dr.record(&DryRunAction{
+		Type:        DryRunTopo,
+		Description: fmt.Sprintf("Remove the tables [%s] from the vschema of keyspace %s", strings.Join(dr.ts.tables, ","), keyspace),
+		Keyspace:    keyspace,
+	})
+}
+
+// recordDeleteShards records the deletion of shards, with their tablets.
+func (dr *switcherDryRun) recordDeleteShards(tabletsList map[string][]string, shards []*topo.ShardInfo) {
+	byName := make(map[string]*topo.ShardInfo, len(shards))
+	names := make([]string, 0, len(shards))
+	for _, si := range shards {
+		byName[si.ShardName()] = si
+		names = append(names, si.ShardName())
+	}
+	sort.Sort(vreplication.ShardSorter(names))
+	for _, name := range names {
+		var tablets []string
+		for _, uid := range tabletsList[name] {
+			tablets = append(tablets, strings.TrimSpace(uid))
+		}
+		dr.recordShardChange(byName[name], fmt.Sprintf("Delete the shard and its tablets [%s]", strings.Join(tablets, ",")))
+	}
+}
+
 func (dr *switcherDryRun) dropSourceShards(ctx context.Context) error {
 	logs := make([]string, 0)
 	tabletsList := make(map[string][]string)
@@ -265,7 +504,7 @@ func (dr *switcherDryRun) dropSourceShards(ctx context.Context) error {
 		dr.drLog.Log("Deleting following shards (and all related tablets):")
 		dr.drLog.LogSlice(logs)
 	}
-
+	dr.recordDeleteShards(tabletsList, dr.ts.sourceShards())
 	return nil
 }
 
@@ -281,6 +520,9 @@ func (dr *switcherDryRun) dropTargetVReplicationStreams(ctx context.Context) err
 			t.GetShard().Keyspace(), t.GetShard().ShardName(), dr.ts.workflow, t.GetPrimary().DbName(), t.GetPrimary().Alias.Uid))
 	}
 	dr.drLog.LogSlice(logs)
+	dr.forTargets(func(target *workflow.MigrationTarget) {
+		dr.recordQuery(target.GetPrimary(), "Delete the streams", deleteWorkflowQuery(target.GetPrimary().DbName(), dr.ts.workflow))
+	})
 	return nil
 }
 
@@ -292,6 +534,9 @@ func (dr *switcherDryRun) dropSourceReverseVReplicationStreams(ctx context.Conte
 			t.GetShard().Keyspace(), t.GetShard().ShardName(), workflow.ReverseWorkflowName(dr.ts.workflow), t.GetPrimary().DbName(), t.GetPrimary().Alias.Uid))
 	}
 	dr.drLog.LogSlice(logs)
+	dr.forSources(func(source *workflow.MigrationSource) {
+		dr.recordQuery(source.GetPrimary(), "Delete the reverse streams", deleteWorkflowQuery(source.GetPrimary().DbName(), workflow.ReverseWorkflowName(dr.ts.workflow)))
+	})
 	return nil
 }
 
@@ -305,6 +550,9 @@ func (dr *switcherDryRun) freezeTargetVReplication(ctx context.Context) error {
 		dr.drLog.Log("Mark vreplication streams frozen on:")
 		dr.drLog.LogSlice(logs)
 	}
+	dr.forTargets(func(target *workflow.MigrationTarget) {
+		dr.recordQuery(target.GetPrimary(), "Freeze the streams", freezeWorkflowQuery(target.GetPrimary().DbName(), dr.ts.workflow))
+	})
 	return nil
 }
 
@@ -317,6 +565,9 @@ func (dr *switcherDryRun) dropSourceDeniedTables(ctx context.Context) error {
 		dr.drLog.Log(fmt.Sprintf("Denied tables [%s] will be removed from:", strings.Join(dr.ts.tables, ",")))
 		dr.drLog.LogSlice(logs)
 	}
+	dr.forSources(func(source *workflow.MigrationSource) {
+		dr.recordShardChange(source.GetShard(), fmt.Sprintf("Remove the tables [%s] from the denied tables of the primary", strings.Join(dr.ts.tables, ",")))
+	})
 	return nil
 }
 
@@ -324,6 +575,10 @@ func (dr *switcherDryRun) logs() *[]string {
 	return &dr.drLog.logs
 }
 
+func (dr *switcherDryRun) dryRunActions() []*DryRunAction {
+	return dr.actions
+}
+
 func (dr *switcherDryRun) removeTargetTables(ctx context.Context) error {
 	logs := make([]string, 0)
 	for _, target := range dr.ts.targets {
@@ -337,6 +592,12 @@ func (dr *switcherDryRun) removeTargetTables(ctx context.Context) error {
 			dr.ts.targetKeyspace))
 		dr.drLog.LogSlice(logs)
 	}
+	dr.forTargets(func(target *workflow.MigrationTarget) {
+		for _, tableName := range dr.ts.tables {
+			dr.recordQuery(target.GetPrimary(), fmt.Sprintf("%s %s", workflow.DropTable, tableName), removeTableQuery(target.GetPrimary().DbName(), tableName, workflow.DropTable))
+		}
+	})
+	dr.recordRemoveTablesFromVSchema(dr.ts.targetKeyspace)
 	return nil
 }
 
@@ -360,6 +621,6 @@ func (dr *switcherDryRun) dropTargetShards(ctx context.Context) error {
 		dr.drLog.Log("Deleting following shards (and all related tablets):")
 		dr.drLog.LogSlice(logs)
 	}
-
+	dr.recordDeleteShards(tabletsList, dr.ts.targetShards())
 	return nil
 }
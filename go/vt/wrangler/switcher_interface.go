@@ -52,4 +52,5 @@ type iswitcher interface {
 	deleteRoutingRules(ctx context.Context) error
 	addParticipatingTablesToKeyspace(ctx context.Context, keyspace, tableSpecs string) error
 	logs() *[]string
+	dryRunActions() []*DryRunAction
 }
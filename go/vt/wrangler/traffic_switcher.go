@@ -86,6 +86,13 @@ type trafficSwitcher struct {
 	optTabletTypes  string //tabletTypes option passed to MoveTables/Reshard
 	externalCluster string
 	externalTopo    *topo.Server
+	// reverseExternalCluster is the name of the target cluster in the
+	// topo of the external cluster, from which the reverse streams of a
+	// Migrate workflow replicate.
+	reverseExternalCluster string
+	// externalMysql is the external mysql the workflow replicates from, if
+	// any. It has no shards, tablets or vschema.
+	externalMysql string
 }
 
 /*
@@ -207,6 +214,11 @@ func (wr *Wrangler) getWorkflowState(ctx context.Context, targetKeyspace, workfl
 				ws.WritesSwitched = true
 			}
 		}
+		// Migrate workflows have no routing rules: their streams are frozen
+		// once the writes of the external cluster have been switched.
+		if ts.externalCluster != "" || ts.externalMysql != "" {
+			ws.WritesSwitched = ts.frozen
+		}
 	} else {
 		ws.WorkflowType = workflow.TypeReshard
 
@@ -272,6 +284,16 @@ func (wr *Wrangler) doCellsHaveRdonlyTablets(ctx context.Context, cells []string
 // SwitchReads is a generic way of switching read traffic for a resharding workflow.
 func (wr *Wrangler) SwitchReads(ctx context.Context, targetKeyspace, workflowName string, servedTypes []topodatapb.TabletType,
 	cells []string, direction workflow.TrafficSwitchDirection, dryRun bool) (*[]string, error) {
+	sw, err := wr.switchReads(ctx, targetKeyspace, workflowName, servedTypes, cells, direction, dryRun)
+	if err != nil {
+		return nil, err
+	}
+	return sw.logs(), nil
+}
+
+// switchReads switches the reads of a workflow, and returns the switcher that did.
+func (wr *Wrangler) switchReads(ctx context.Context, targetKeyspace, workflowName string, servedTypes []topodatapb.TabletType,
+	cells []string, direction workflow.TrafficSwitchDirection, dryRun bool) (iswitcher, error) {
 
 	ts, ws, err := wr.getWorkflowState(ctx, targetKeyspace, workflowName)
 	if err != nil {
@@ -351,7 +373,7 @@ func (wr *Wrangler) SwitchReads(ctx context.Context, targetKeyspace, workflowNam
 			ts.wr.Logger().Errorf("switchTableReads failed: %v", err)
 			return nil, err
 		}
-		return sw.logs(), nil
+		return sw, nil
 	}
 	wr.Logger().Infof("About to switchShardReads: %+v, %+v, %+v", cells, servedTypes, direction)
 	if err := ts.switchShardReads(ctx, cells, servedTypes, direction); err != nil {
@@ -366,7 +388,7 @@ func (wr *Wrangler) SwitchReads(ctx context.Context, targetKeyspace, workflowNam
 		log.Errorf("%w", err2)
 		return nil, err2
 	}
-	return sw.logs(), nil
+	return sw, nil
 }
 
 func (wr *Wrangler) areTabletsAvailableToStreamFrom(ctx context.Context, ts *trafficSwitcher, keyspace string, shards []*topo.ShardInfo) error {
@@ -415,6 +437,16 @@ func (wr *Wrangler) areTabletsAvailableToStreamFrom(ctx context.Context, ts *tra
 // SwitchWrites is a generic way of migrating write traffic for a resharding workflow.
 func (wr *Wrangler) SwitchWrites(ctx context.Context, targetKeyspace, workflowName string, timeout time.Duration,
 	cancel, reverse, reverseReplication bool, dryRun bool) (journalID int64, dryRunResults *[]string, err error) {
+	journalID, sw, err := wr.switchWrites(ctx, targetKeyspace, workflowName, timeout, cancel, reverse, reverseReplication, dryRun)
+	if err != nil {
+		return 0, nil, err
+	}
+	return journalID, sw.logs(), nil
+}
+
+// switchWrites switches the writes of a workflow, and returns the switcher that did.
+func (wr *Wrangler) switchWrites(ctx context.Context, targetKeyspace, workflowName string, timeout time.Duration,
+	cancel, reverse, reverseReplication bool, dryRun bool) (journalID int64, _ iswitcher, err error) {
 	ts, ws, err := wr.getWorkflowState(ctx, targetKeyspace, workflowName)
 	_ = ws
 	if err != nil {
@@ -436,7 +468,7 @@ func (wr *Wrangler) SwitchWrites(ctx context.Context, targetKeyspace, workflowNa
 
 	if ts.frozen {
 		ts.wr.Logger().Warningf("Writes have already been switched for workflow %s, nothing to do here", ts.workflow)
-		return 0, sw.logs(), nil
+		return 0, sw, nil
 	}
 
 	ts.wr.Logger().Infof("Built switching metadata: %+v", ts)
@@ -485,7 +517,7 @@ func (wr *Wrangler) SwitchWrites(ctx context.Context, targetKeyspace, workflowNa
 		}
 		if cancel {
 			sw.cancelMigration(ctx, sm)
-			return 0, sw.logs(), nil
+			return 0, sw, nil
 		}
 		ts.wr.Logger().Infof("Stopping streams")
 		sourceWorkflows, err = sw.stopStreams(ctx, sm)
@@ -570,11 +602,20 @@ func (wr *Wrangler) SwitchWrites(ctx context.Context, targetKeyspace, workflowNa
 		return 0, nil, err
 	}
 
-	return ts.id, sw.logs(), nil
+	return ts.id, sw, nil
 }
 
 // DropTargets cleans up target tables, shards and denied tables if a MoveTables/Reshard is cancelled
 func (wr *Wrangler) DropTargets(ctx context.Context, targetKeyspace, workflow string, keepData, dryRun bool) (*[]string, error) {
+	sw, err := wr.dropTargets(ctx, targetKeyspace, workflow, keepData, dryRun)
+	if err != nil {
+		return nil, err
+	}
+	return sw.logs(), nil
+}
+
+// dropTargets cancels a workflow, and returns the switcher that did.
+func (wr *Wrangler) dropTargets(ctx context.Context, targetKeyspace, workflow string, keepData, dryRun bool) (iswitcher, error) {
 	ts, err := wr.buildTrafficSwitcher(ctx, targetKeyspace, workflow)
 	if err != nil {
 		wr.Logger().Errorf("buildTrafficSwitcher failed: %v", err)
@@ -626,7 +667,7 @@ func (wr *Wrangler) DropTargets(ctx context.Context, targetKeyspace, workflow st
 	if err := ts.wr.ts.RebuildSrvVSchema(ctx, nil); err != nil {
 		return nil, err
 	}
-	return sw.logs(), nil
+	return sw, nil
 }
 
 func (wr *Wrangler) dropArtifacts(ctx context.Context, sw iswitcher) error {
@@ -646,7 +687,7 @@ func (wr *Wrangler) dropArtifacts(ctx context.Context, sw iswitcher) error {
 // finalizeMigrateWorkflow deletes the streams for the Migrate workflow.
 // We only cleanup the target for external sources
 func (wr *Wrangler) finalizeMigrateWorkflow(ctx context.Context, targetKeyspace, workflow, tableSpecs string,
-	cancel, keepData, dryRun bool) (*[]string, error) {
+	cancel, keepData, dryRun bool) (iswitcher, error) {
 	ts, err := wr.buildTrafficSwitcher(ctx, targetKeyspace, workflow)
 	if err != nil {
 		wr.Logger().Errorf("buildTrafficSwitcher failed: %v", err)
@@ -669,6 +710,13 @@ func (wr *Wrangler) finalizeMigrateWorkflow(ctx context.Context, targetKeyspace,
 	if err := sw.dropTargetVReplicationStreams(ctx); err != nil {
 		return nil, err
 	}
+	if ts.frozen && ts.externalCluster != "" {
+		// The writes were switched with SwitchMigrateWrites, which may have
+		// created reverse streams in the external cluster.
+		if err := sw.dropSourceReverseVReplicationStreams(ctx); err != nil {
+			return nil, err
+		}
+	}
 	if !cancel {
 		sw.addParticipatingTablesToKeyspace(ctx, targetKeyspace, tableSpecs)
 		if err := ts.wr.ts.RebuildSrvVSchema(ctx, nil); err != nil {
@@ -681,11 +729,115 @@ func (wr *Wrangler) finalizeMigrateWorkflow(ctx context.Context, targetKeyspace,
 			return nil, err
 		}
 	}
+	return sw, nil
+}
+
+// SwitchMigrateWrites switches the writes of a Migrate workflow from a mounted
+// vitess cluster: the primaries of the external cluster are made read-only, the
+// streams catch up with them and are frozen. If reverseReplication is set,
+// reverse streams are created on the primaries of the external cluster, which
+// replicate from the target keyspace of this cluster, mounted as
+// reverseExternalCluster in the external cluster. The topo of the external
+// cluster is not changed.
+func (wr *Wrangler) SwitchMigrateWrites(ctx context.Context, targetKeyspace, workflowName, reverseExternalCluster string,
+	timeout time.Duration, reverseReplication, dryRun bool) (dryRunResults *[]string, err error) {
+	sw, err := wr.switchMigrateWrites(ctx, targetKeyspace, workflowName, reverseExternalCluster, timeout, reverseReplication, dryRun)
+	if err != nil {
+		return nil, err
+	}
 	return sw.logs(), nil
 }
 
+// switchMigrateWrites switches the writes of a Migrate workflow, and returns
+// the switcher that did.
+func (wr *Wrangler) switchMigrateWrites(ctx context.Context, targetKeyspace, workflowName, reverseExternalCluster string,
+	timeout time.Duration, reverseReplication, dryRun bool) (_ iswitcher, err error) {
+	ts, err := wr.buildTrafficSwitcher(ctx, targetKeyspace, workflowName)
+	if err != nil {
+		wr.Logger().Errorf("buildTrafficSwitcher failed: %v", err)
+		return nil, err
+	}
+	if ts.externalCluster == "" {
+		return nil, fmt.Errorf("workflow %s.%s does not replicate from a mounted vitess cluster", targetKeyspace, workflowName)
+	}
+	defer ts.externalTopo.Close()
+
+	var sw iswitcher
+	if dryRun {
+		sw = &switcherDryRun{ts: ts, drLog: NewLogRecorder()}
+	} else {
+		sw = &switcher{ts: ts, wr: wr}
+	}
+	if ts.frozen {
+		ts.wr.Logger().Warningf("Writes have already been switched for workflow %s, nothing to do here", ts.workflow)
+		return sw, nil
+	}
+	if reverseReplication {
+		if reverseExternalCluster == "" {
+			return nil, fmt.Errorf("the name of this cluster in the topo of the vitess cluster %s is required for reverse replication", ts.externalCluster)
+		}
+		vci, err := ts.externalTopo.GetExternalVitessCluster(ctx, reverseExternalCluster)
+		if err != nil {
+			return nil, err
+		}
+		if vci == nil {
+			return nil, fmt.Errorf("there is no vitess cluster named %s in the topo of the vitess cluster %s", reverseExternalCluster, ts.externalCluster)
+		}
+		ts.reverseExternalCluster = reverseExternalCluster
+	}
+
+	// The keyspace of the external cluster cannot be locked, as its topo is
+	// read-only.
+	tctx, targetUnlock, lockErr := sw.lockKeyspace(ctx, ts.targetKeyspace, "SwitchMigrateWrites")
+	if lockErr != nil {
+		ts.wr.Logger().Errorf("LockKeyspace failed: %v", lockErr)
+		return nil, lockErr
+	}
+	ctx = tctx
+	defer targetUnlock(&err)
+
+	ts.wr.Logger().Infof("Stopping source writes")
+	if err := sw.stopSourceWrites(ctx); err != nil {
+		ts.wr.Logger().Errorf("stopSourceWrites failed: %v", err)
+		sw.cancelMigration(ctx, nil)
+		return nil, err
+	}
+	ts.wr.Logger().Infof("Waiting for streams to catchup")
+	if err := sw.waitForCatchup(ctx, timeout); err != nil {
+		ts.wr.Logger().Errorf("waitForCatchup failed: %v", err)
+		sw.cancelMigration(ctx, nil)
+		return nil, err
+	}
+	if reverseReplication {
+		ts.wr.Logger().Infof("Creating reverse streams")
+		if err := sw.createReverseVReplication(ctx); err != nil {
+			ts.wr.Logger().Errorf("createReverseVReplication failed: %v", err)
+			sw.cancelMigration(ctx, nil)
+			return nil, err
+		}
+		if err := sw.startReverseVReplication(ctx); err != nil {
+			ts.wr.Logger().Errorf("startReverseVReplication failed: %v", err)
+			return nil, err
+		}
+	}
+	if err := sw.freezeTargetVReplication(ctx); err != nil {
+		ts.wr.Logger().Errorf("freezeTargetVReplication failed: %v", err)
+		return nil, err
+	}
+	return sw, nil
+}
+
 // DropSources cleans up source tables, shards and denied tables after a MoveTables/Reshard is completed
 func (wr *Wrangler) DropSources(ctx context.Context, targetKeyspace, workflowName string, removalType workflow.TableRemovalType, keepData, force, dryRun bool) (*[]string, error) {
+	sw, err := wr.dropSources(ctx, targetKeyspace, workflowName, removalType, keepData, force, dryRun)
+	if err != nil {
+		return nil, err
+	}
+	return sw.logs(), nil
+}
+
+// dropSources completes a workflow, and returns the switcher that did.
+func (wr *Wrangler) dropSources(ctx context.Context, targetKeyspace, workflowName string, removalType workflow.TableRemovalType, keepData, force, dryRun bool) (iswitcher, error) {
 	ts, err := wr.buildTrafficSwitcher(ctx, targetKeyspace, workflowName)
 	if err != nil {
 		wr.Logger().Errorf("buildTrafficSwitcher failed: %v", err)
@@ -745,7 +897,7 @@ func (wr *Wrangler) DropSources(ctx context.Context, targetKeyspace, workflowNam
 		return nil, err
 	}
 
-	return sw.logs(), nil
+	return sw, nil
 }
 
 func (wr *Wrangler) buildTrafficSwitcher(ctx context.Context, targetKeyspace, workflowName string) (*trafficSwitcher, error) {
@@ -777,6 +929,7 @@ func (wr *Wrangler) buildTrafficSwitcher(ctx context.Context, targetKeyspace, wo
 			if ts.sourceKeyspace == "" {
 				ts.sourceKeyspace = bls.Keyspace
 				ts.externalCluster = bls.ExternalCluster
+				ts.externalMysql = bls.ExternalMysql
 				if ts.externalCluster != "" {
 					externalTopo, err := wr.ts.OpenExternalVitessClusterServer(ctx, ts.externalCluster)
 					if err != nil {
@@ -805,7 +958,7 @@ func (wr *Wrangler) buildTrafficSwitcher(ctx context.Context, targetKeyspace, wo
 				}
 			}
 
-			if _, ok := ts.sources[bls.Shard]; ok {
+			if _, ok := ts.sources[bls.Shard]; ok || ts.externalMysql != "" {
 				continue
 			}
 			sourcesi, err := sourceTopo.GetShard(ctx, bls.Keyspace, bls.Shard)
@@ -819,7 +972,7 @@ func (wr *Wrangler) buildTrafficSwitcher(ctx context.Context, targetKeyspace, wo
 			ts.sources[bls.Shard] = workflow.NewMigrationSource(sourcesi, sourcePrimary)
 		}
 	}
-	if ts.sourceKeyspace != ts.targetKeyspace || ts.externalCluster != "" {
+	if ts.sourceKeyspace != ts.targetKeyspace || ts.externalCluster != "" || ts.externalMysql != "" {
 		ts.migrationType = binlogdatapb.MigrationType_TABLES
 	} else {
 		// TODO(sougou): for shard migration, validate that source and target combined
@@ -833,9 +986,12 @@ func (wr *Wrangler) buildTrafficSwitcher(ctx context.Context, targetKeyspace, wo
 			}
 		}
 	}
-	vs, err := sourceTopo.GetVSchema(ctx, ts.sourceKeyspace)
-	if err != nil {
-		return nil, err
+	vs := &vschemapb.Keyspace{}
+	if ts.externalMysql == "" {
+		vs, err = sourceTopo.GetVSchema(ctx, ts.sourceKeyspace)
+		if err != nil {
+			return nil, err
+		}
 	}
 	ts.sourceKSSchema, err = vindexes.BuildKeyspaceSchema(vs, ts.sourceKeyspace)
 	if err != nil {
@@ -890,6 +1046,19 @@ func (ts *trafficSwitcher) switchTableReads(ctx context.Context, cells []string,
 	if err != nil {
 		return err
 	}
+	for fromTable, toTables := range ts.tableReadsRoutingRules(servedTypes, direction) {
+		rules[fromTable] = toTables
+	}
+	if err := topotools.SaveRoutingRules(ctx, ts.wr.ts, rules); err != nil {
+		return err
+	}
+	return ts.wr.ts.RebuildSrvVSchema(ctx, cells)
+}
+
+// tableReadsRoutingRules returns the routing rules that switch the reads of
+// the tables of the workflow for the served types.
+func (ts *trafficSwitcher) tableReadsRoutingRules(servedTypes []topodatapb.TabletType, direction workflow.TrafficSwitchDirection) map[string][]string {
+	rules := make(map[string][]string)
 	// We assume that the following rules were setup when the targets were created:
 	// table -> sourceKeyspace.table
 	// targetKeyspace.table -> sourceKeyspace.table
@@ -913,10 +1082,7 @@ func (ts *trafficSwitcher) switchTableReads(ctx context.Context, cells []string,
 			}
 		}
 	}
-	if err := topotools.SaveRoutingRules(ctx, ts.wr.ts, rules); err != nil {
-		return err
-	}
-	return ts.wr.ts.RebuildSrvVSchema(ctx, cells)
+	return rules
 }
 
 func (ts *trafficSwitcher) switchShardReads(ctx context.Context, cells []string, servedTypes []topodatapb.TabletType, direction workflow.TrafficSwitchDirection) error {
@@ -982,7 +1148,11 @@ func (ts *trafficSwitcher) checkJournals(ctx context.Context) (journalsExist boo
 
 func (ts *trafficSwitcher) stopSourceWrites(ctx context.Context) error {
 	var err error
-	if ts.migrationType == binlogdatapb.MigrationType_TABLES {
+	if ts.externalCluster != "" {
+		// The topo of an external cluster is read-only: stop the writes on
+		// its primaries instead.
+		err = ts.changeExternalSourceWrites(ctx, disallowWrites)
+	} else if ts.migrationType == binlogdatapb.MigrationType_TABLES {
 		err = ts.changeTableSourceWrites(ctx, disallowWrites)
 	} else {
 		err = ts.changeShardsAccess(ctx, ts.sourceKeyspace, ts.sourceShards(), disallowWrites)
@@ -1014,6 +1184,17 @@ func (ts *trafficSwitcher) changeTableSourceWrites(ctx context.Context, access a
 	})
 }
 
+// changeExternalSourceWrites makes the primaries of the external cluster a
+// Migrate workflow replicates from read-only, or read-write.
+func (ts *trafficSwitcher) changeExternalSourceWrites(ctx context.Context, access accessType) error {
+	return ts.forAllSources(func(source *workflow.MigrationSource) error {
+		if access == allowWrites {
+			return ts.wr.tmc.SetReadWrite(ctx, source.GetPrimary().Tablet)
+		}
+		return ts.wr.tmc.SetReadOnly(ctx, source.GetPrimary().Tablet)
+	})
+}
+
 func (ts *trafficSwitcher) waitForCatchup(ctx context.Context, filteredReplicationWaitTime time.Duration) error {
 	ctx, cancel := context.WithTimeout(ctx, filteredReplicationWaitTime)
 	defer cancel()
@@ -1051,7 +1232,9 @@ func (ts *trafficSwitcher) waitForCatchup(ctx context.Context, filteredReplicati
 
 func (ts *trafficSwitcher) cancelMigration(ctx context.Context, sm *workflow.StreamMigrator) {
 	var err error
-	if ts.migrationType == binlogdatapb.MigrationType_TABLES {
+	if ts.externalCluster != "" {
+		err = ts.changeExternalSourceWrites(ctx, allowWrites)
+	} else if ts.migrationType == binlogdatapb.MigrationType_TABLES {
 		err = ts.changeTableSourceWrites(ctx, allowWrites)
 	} else {
 		err = ts.changeShardsAccess(ctx, ts.sourceKeyspace, ts.sourceShards(), allowWrites)
@@ -1060,10 +1243,13 @@ func (ts *trafficSwitcher) cancelMigration(ctx context.Context, sm *workflow.Str
 		ts.wr.Logger().Errorf("Cancel migration failed:", err)
 	}
 
-	sm.CancelMigration(ctx)
+	// Migrate workflows do not migrate the streams of the external cluster.
+	if sm != nil {
+		sm.CancelMigration(ctx)
+	}
 
 	err = ts.forAllTargets(func(target *workflow.MigrationTarget) error {
-		query := fmt.Sprintf("update _vt.vreplication set state='Running', message='' where db_name=%s and workflow=%s", encodeString(target.GetPrimary().DbName()), encodeString(ts.workflow))
+		query := restartWorkflowQuery(target.GetPrimary().DbName(), ts.workflow)
 		_, err := ts.wr.tmc.VReplicationExec(ctx, target.GetPrimary().Tablet, query)
 		return err
 	})
@@ -1103,11 +1289,12 @@ func (ts *trafficSwitcher) createReverseVReplication(ctx context.Context) error
 		bls := target.Sources[uid]
 		source := ts.sources[bls.Shard]
 		reverseBls := &binlogdatapb.BinlogSource{
-			Keyspace:   ts.targetKeyspace,
-			Shard:      target.GetShard().ShardName(),
-			TabletType: bls.TabletType,
-			Filter:     &binlogdatapb.Filter{},
-			OnDdl:      bls.OnDdl,
+			Keyspace:        ts.targetKeyspace,
+			Shard:           target.GetShard().ShardName(),
+			TabletType:      bls.TabletType,
+			Filter:          &binlogdatapb.Filter{},
+			OnDdl:           bls.OnDdl,
+			ExternalCluster: ts.reverseExternalCluster,
 		}
 		for _, rule := range bls.Filter.Rules {
 			if rule.Filter == "exclude" {
@@ -1139,7 +1326,7 @@ func (ts *trafficSwitcher) createReverseVReplication(ctx context.Context) error
 		}
 		log.Infof("Creating reverse workflow vreplication stream on tablet %s: workflow %s, startPos %s",
 			source.GetPrimary().Alias, ts.reverseWorkflow, target.Position)
-		_, err := ts.wr.VReplicationExec(ctx, source.GetPrimary().Alias, binlogplayer.CreateVReplicationState(ts.reverseWorkflow, reverseBls, target.Position, binlogplayer.BlpStopped, source.GetPrimary().DbName()))
+		_, err := ts.wr.tmc.VReplicationExec(ctx, source.GetPrimary().Tablet, binlogplayer.CreateVReplicationState(ts.reverseWorkflow, reverseBls, target.Position, binlogplayer.BlpStopped, source.GetPrimary().DbName()))
 		if err != nil {
 			return err
 		}
@@ -1148,7 +1335,7 @@ func (ts *trafficSwitcher) createReverseVReplication(ctx context.Context) error
 		updateQuery := ts.getReverseVReplicationUpdateQuery(target.GetPrimary().Alias.Cell, source.GetPrimary().Alias.Cell, source.GetPrimary().DbName())
 		if updateQuery != "" {
 			log.Infof("Updating vreplication stream entry on %s with: %s", source.GetPrimary().Alias, updateQuery)
-			_, err = ts.wr.VReplicationExec(ctx, source.GetPrimary().Alias, updateQuery)
+			_, err = ts.wr.tmc.VReplicationExec(ctx, source.GetPrimary().Tablet, updateQuery)
 			return err
 		}
 		return nil
@@ -1174,7 +1361,7 @@ func (ts *trafficSwitcher) getReverseVReplicationUpdateQuery(targetCell string,
 
 func (ts *trafficSwitcher) deleteReverseVReplication(ctx context.Context) error {
 	return ts.forAllSources(func(source *workflow.MigrationSource) error {
-		query := fmt.Sprintf("delete from _vt.vreplication where db_name=%s and workflow=%s", encodeString(source.GetPrimary().DbName()), encodeString(ts.reverseWorkflow))
+		query := deleteWorkflowQuery(source.GetPrimary().DbName(), ts.reverseWorkflow)
 		_, err := ts.wr.tmc.VReplicationExec(ctx, source.GetPrimary().Tablet, query)
 		return err
 	})
@@ -1196,14 +1383,22 @@ func (ts *trafficSwitcher) createJournals(ctx context.Context, sourceWorkflows [
 			Participants:    participants,
 			SourceWorkflows: sourceWorkflows,
 		}
+		// The journal of every source lists all the target shards, and
+		// all the sources they replicate from: in a merge, the consumers
+		// of one source must switch to the targets of all of them at once.
+		targetShards := make([]string, 0, len(ts.targets))
 		for targetShard, target := range ts.targets {
 			for _, tsource := range target.Sources {
 				participantMap[tsource.Shard] = true
 			}
+			targetShards = append(targetShards, targetShard)
+		}
+		sort.Sort(vreplication.ShardSorter(targetShards))
+		for _, targetShard := range targetShards {
 			journal.ShardGtids = append(journal.ShardGtids, &binlogdatapb.ShardGtid{
 				Keyspace: ts.targetKeyspace,
 				Shard:    targetShard,
-				Gtid:     target.Position,
+				Gtid:     ts.targets[targetShard].Position,
 			})
 		}
 		shards := make([]string, 0)
@@ -1261,12 +1456,14 @@ func (ts *trafficSwitcher) changeWriteRoute(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	for _, table := range ts.tables {
-		delete(rules, ts.targetKeyspace+"."+table)
-		ts.wr.Logger().Infof("Delete routing: %v", ts.targetKeyspace+"."+table)
-		rules[table] = []string{ts.targetKeyspace + "." + table}
-		rules[ts.sourceKeyspace+"."+table] = []string{ts.targetKeyspace + "." + table}
-		ts.wr.Logger().Infof("Add routing: %v %v", table, ts.sourceKeyspace+"."+table)
+	changed, deleted := ts.writeRoutingRules()
+	for _, fromTable := range deleted {
+		delete(rules, fromTable)
+		ts.wr.Logger().Infof("Delete routing: %v", fromTable)
+	}
+	for fromTable, toTables := range changed {
+		rules[fromTable] = toTables
+		ts.wr.Logger().Infof("Add routing: %v %v", fromTable, toTables)
 	}
 	if err := topotools.SaveRoutingRules(ctx, ts.wr.ts, rules); err != nil {
 		return err
@@ -1274,6 +1471,18 @@ func (ts *trafficSwitcher) changeWriteRoute(ctx context.Context) error {
 	return ts.wr.ts.RebuildSrvVSchema(ctx, nil)
 }
 
+// writeRoutingRules returns the routing rules that switch the writes of the
+// tables of the workflow to the target keyspace, and the ones it deletes.
+func (ts *trafficSwitcher) writeRoutingRules() (changed map[string][]string, deleted []string) {
+	changed = make(map[string][]string)
+	for _, table := range ts.tables {
+		deleted = append(deleted, ts.targetKeyspace+"."+table)
+		changed[table] = []string{ts.targetKeyspace + "." + table}
+		changed[ts.sourceKeyspace+"."+table] = []string{ts.targetKeyspace + "." + table}
+	}
+	return changed, deleted
+}
+
 func (ts *trafficSwitcher) changeShardRouting(ctx context.Context) error {
 	if err := ts.wr.ts.ValidateSrvKeyspace(ctx, ts.targetKeyspace, ""); err != nil {
 		err2 := vterrors.Wrapf(err, "Before changing shard routes, found SrvKeyspace for %s is corrupt", ts.targetKeyspace)
@@ -1314,8 +1523,8 @@ func (ts *trafficSwitcher) changeShardRouting(ctx context.Context) error {
 
 func (ts *trafficSwitcher) startReverseVReplication(ctx context.Context) error {
 	return ts.forAllSources(func(source *workflow.MigrationSource) error {
-		query := fmt.Sprintf("update _vt.vreplication set state='Running', message='' where db_name=%s", encodeString(source.GetPrimary().DbName()))
-		_, err := ts.wr.VReplicationExec(ctx, source.GetPrimary().Alias, query)
+		query := startReverseVReplicationQuery(source.GetPrimary().DbName())
+		_, err := ts.wr.tmc.VReplicationExec(ctx, source.GetPrimary().Tablet, query)
 		return err
 	})
 }
@@ -1464,16 +1673,37 @@ func getRenameFileName(tableName string) string {
 	return fmt.Sprintf(renameTableTemplate, tableName)
 }
 
+func removeTableQuery(dbName, tableName string, removalType workflow.TableRemovalType) string {
+	if removalType == workflow.RenameTable {
+		return fmt.Sprintf("rename table %s.%s TO %s.%s", dbName, tableName, dbName, getRenameFileName(tableName))
+	}
+	return fmt.Sprintf("drop table %s.%s", dbName, tableName)
+}
+
+func startReverseVReplicationQuery(dbName string) string {
+	return fmt.Sprintf("update _vt.vreplication set state='Running', message='' where db_name=%s", encodeString(dbName))
+}
+
+func restartWorkflowQuery(dbName, workflow string) string {
+	return fmt.Sprintf("update _vt.vreplication set state='Running', message='' where db_name=%s and workflow=%s", encodeString(dbName), encodeString(workflow))
+}
+
+func freezeWorkflowQuery(dbName, workflow string) string {
+	return fmt.Sprintf("update _vt.vreplication set message = '%s' where db_name=%s and workflow=%s", frozenStr, encodeString(dbName), encodeString(workflow))
+}
+
+func deleteWorkflowQuery(dbName, workflow string) string {
+	return fmt.Sprintf("delete from _vt.vreplication where db_name=%s and workflow=%s", encodeString(dbName), encodeString(workflow))
+}
+
 func (ts *trafficSwitcher) removeSourceTables(ctx context.Context, removalType workflow.TableRemovalType) error {
 	err := ts.forAllSources(func(source *workflow.MigrationSource) error {
 		for _, tableName := range ts.tables {
-			query := fmt.Sprintf("drop table %s.%s", source.GetPrimary().DbName(), tableName)
+			query := removeTableQuery(source.GetPrimary().DbName(), tableName, removalType)
 			if removalType == workflow.DropTable {
 				ts.wr.Logger().Infof("Dropping table %s.%s\n", source.GetPrimary().DbName(), tableName)
 			} else {
-				renameName := getRenameFileName(tableName)
-				ts.wr.Logger().Infof("Renaming table %s.%s to %s.%s\n", source.GetPrimary().DbName(), tableName, source.GetPrimary().DbName(), renameName)
-				query = fmt.Sprintf("rename table %s.%s TO %s.%s", source.GetPrimary().DbName(), tableName, source.GetPrimary().DbName(), renameName)
+				ts.wr.Logger().Infof("Renaming table %s.%s to %s.%s\n", source.GetPrimary().DbName(), tableName, source.GetPrimary().DbName(), getRenameFileName(tableName))
 			}
 			_, err := ts.wr.ExecuteFetchAsDba(ctx, source.GetPrimary().Alias, query, 1, false, true)
 			if err != nil {
@@ -1522,7 +1752,7 @@ func (ts *trafficSwitcher) freezeTargetVReplication(ctx context.Context) error {
 	// re-invoked after a freeze, it will skip all the previous steps
 	err := ts.forAllTargets(func(target *workflow.MigrationTarget) error {
 		ts.wr.Logger().Infof("Marking target streams frozen for workflow %s db_name %s", ts.workflow, target.GetPrimary().DbName())
-		query := fmt.Sprintf("update _vt.vreplication set message = '%s' where db_name=%s and workflow=%s", frozenStr, encodeString(target.GetPrimary().DbName()), encodeString(ts.workflow))
+		query := freezeWorkflowQuery(target.GetPrimary().DbName(), ts.workflow)
 		_, err := ts.wr.tmc.VReplicationExec(ctx, target.GetPrimary().Tablet, query)
 		return err
 	})
@@ -1535,7 +1765,7 @@ func (ts *trafficSwitcher) freezeTargetVReplication(ctx context.Context) error {
 func (ts *trafficSwitcher) dropTargetVReplicationStreams(ctx context.Context) error {
 	return ts.forAllTargets(func(target *workflow.MigrationTarget) error {
 		ts.wr.Logger().Infof("Deleting target streams for workflow %s db_name %s", ts.workflow, target.GetPrimary().DbName())
-		query := fmt.Sprintf("delete from _vt.vreplication where db_name=%s and workflow=%s", encodeString(target.GetPrimary().DbName()), encodeString(ts.workflow))
+		query := deleteWorkflowQuery(target.GetPrimary().DbName(), ts.workflow)
 		_, err := ts.wr.tmc.VReplicationExec(ctx, target.GetPrimary().Tablet, query)
 		return err
 	})
@@ -1544,8 +1774,7 @@ func (ts *trafficSwitcher) dropTargetVReplicationStreams(ctx context.Context) er
 func (ts *trafficSwitcher) dropSourceReverseVReplicationStreams(ctx context.Context) error {
 	return ts.forAllSources(func(source *workflow.MigrationSource) error {
 		ts.wr.Logger().Infof("Deleting reverse streams for workflow %s db_name %s", ts.workflow, source.GetPrimary().DbName())
-		query := fmt.Sprintf("delete from _vt.vreplication where db_name=%s and workflow=%s",
-			encodeString(source.GetPrimary().DbName()), encodeString(workflow.ReverseWorkflowName(ts.workflow)))
+		query := deleteWorkflowQuery(source.GetPrimary().DbName(), workflow.ReverseWorkflowName(ts.workflow))
 		_, err := ts.wr.tmc.VReplicationExec(ctx, source.GetPrimary().Tablet, query)
 		return err
 	})
@@ -1555,7 +1784,7 @@ func (ts *trafficSwitcher) removeTargetTables(ctx context.Context) error {
 	log.Infof("removeTargetTables")
 	err := ts.forAllTargets(func(target *workflow.MigrationTarget) error {
 		for _, tableName := range ts.tables {
-			query := fmt.Sprintf("drop table %s.%s", target.GetPrimary().DbName(), tableName)
+			query := removeTableQuery(target.GetPrimary().DbName(), tableName, workflow.DropTable)
 			ts.wr.Logger().Infof("Dropping table %s.%s\n", target.GetPrimary().DbName(), tableName)
 			_, err := ts.wr.ExecuteFetchAsDba(ctx, target.GetPrimary().Alias, query, 1, false, true)
 			if err != nil {
@@ -1593,16 +1822,8 @@ func (ts *trafficSwitcher) deleteRoutingRules(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	for _, table := range ts.tables {
-		delete(rules, table)
-		delete(rules, table+"@replica")
-		delete(rules, table+"@rdonly")
-		delete(rules, ts.targetKeyspace+"."+table)
-		delete(rules, ts.targetKeyspace+"."+table+"@replica")
-		delete(rules, ts.targetKeyspace+"."+table+"@rdonly")
-		delete(rules, ts.sourceKeyspace+"."+table)
-		delete(rules, ts.sourceKeyspace+"."+table+"@replica")
-		delete(rules, ts.sourceKeyspace+"."+table+"@rdonly")
+	for _, fromTable := range ts.workflowRoutingRules() {
+		delete(rules, fromTable)
 	}
 	if err := topotools.SaveRoutingRules(ctx, ts.wr.ts, rules); err != nil {
 		return err
@@ -1610,6 +1831,18 @@ func (ts *trafficSwitcher) deleteRoutingRules(ctx context.Context) error {
 	return nil
 }
 
+// workflowRoutingRules returns the routing rules that the workflow may have
+// created for its tables.
+func (ts *trafficSwitcher) workflowRoutingRules() []string {
+	var fromTables []string
+	for _, table := range ts.tables {
+		for _, keyspace := range []string{"", ts.targetKeyspace + ".", ts.sourceKeyspace + "."} {
+			fromTables = append(fromTables, keyspace+table, keyspace+table+"@replica", keyspace+table+"@rdonly")
+		}
+	}
+	return fromTables
+}
+
 // addParticipatingTablesToKeyspace updates the vschema with the new tables that were created as part of the
 // Migrate flow. It is called when the Migrate flow is Completed
 func (ts *trafficSwitcher) addParticipatingTablesToKeyspace(ctx context.Context, keyspace, tableSpecs string) error {
@@ -2032,6 +2032,132 @@ func TestShardMigrateNoAvailableTabletsForReverseReplication(t *testing.T) {
 	verifyQueries(t, tme.allDBClients)
 }
 
+// mountedTopoFactory opens the topo of a test migrater env as the topo of a
+// mounted cluster, so that Migrate workflows from the source keyspace of the
+// env can be tested.
+type mountedTopoFactory struct {
+	ts *topo.Server
+}
+
+// unclosableConn keeps the topo of the env open when the topo of the mounted
+// cluster is closed.
+type unclosableConn struct {
+	topo.Conn
+}
+
+func (unclosableConn) Close() {}
+
+func (f *mountedTopoFactory) HasGlobalReadOnlyCell(serverAddr, root string) bool {
+	return false
+}
+
+func (f *mountedTopoFactory) Create(cell, serverAddr, root string) (topo.Conn, error) {
+	conn, err := f.ts.ConnForCell(context.Background(), cell)
+	if err != nil {
+		return nil, err
+	}
+	return unclosableConn{conn}, nil
+}
+
+var testMountedTopoFactory = &mountedTopoFactory{}
+
+func init() {
+	topo.RegisterFactory("wranglertest-mounted", testMountedTopoFactory)
+}
+
+func TestMigrateSwitchWrites(t *testing.T) {
+	ctx := context.Background()
+	tme := newTestTableMigraterCustom(ctx, t, []string{"0"}, []string{"-80", "80-"}, "select * %s")
+	defer tme.stopTablets(t)
+
+	// ks1 is the keyspace of the mounted cluster ext, in which this cluster
+	// is mounted as local.
+	testMountedTopoFactory.ts = tme.ts
+	mounted := &topodatapb.ExternalVitessCluster{TopoConfig: &topodatapb.TopoConfig{TopoType: "wranglertest-mounted"}}
+	require.NoError(t, tme.ts.CreateExternalVitessCluster(ctx, "ext", mounted))
+	require.NoError(t, tme.ts.CreateExternalVitessCluster(ctx, "local", mounted))
+	for i, targetShard := range tme.targetShards {
+		bls := &binlogdatapb.BinlogSource{
+			Keyspace: "ks1",
+			Shard:    "0",
+			Filter: &binlogdatapb.Filter{
+				Rules: []*binlogdatapb.Rule{{
+					Match:  "t1",
+					Filter: fmt.Sprintf("select * from t1 where in_keyrange('%s')", targetShard),
+				}, {
+					Match:  "t2",
+					Filter: fmt.Sprintf("select * from t2 where in_keyrange('%s')", targetShard),
+				}},
+			},
+			ExternalCluster: "ext",
+		}
+		tme.dbTargetClients[i].addInvariant(vreplQueryks2, sqltypes.MakeTestResult(sqltypes.MakeTestFields(
+			"id|source|message|cell|tablet_types",
+			"int64|varchar|varchar|varchar|varchar"),
+			fmt.Sprintf("1|%v|||", bls)),
+		)
+	}
+
+	_, err := tme.wr.SwitchMigrateWrites(ctx, tme.targetKeyspace, "test", "", 1*time.Second, true, false)
+	require.EqualError(t, err, "the name of this cluster in the topo of the vitess cluster ext is required for reverse replication")
+	_, err = tme.wr.SwitchMigrateWrites(ctx, tme.targetKeyspace, "test", "nope", 1*time.Second, true, false)
+	require.EqualError(t, err, "there is no vitess cluster named nope in the topo of the vitess cluster ext")
+
+	waitForCatchup := func() {
+		state := sqltypes.MakeTestResult(sqltypes.MakeTestFields(
+			"pos|state|message",
+			"varchar|varchar|varchar"),
+			"MariaDB/5-456-892|Running",
+		)
+		tme.dbTargetClients[0].addQuery("select pos, state, message from _vt.vreplication where id=1", state, nil)
+		tme.dbTargetClients[1].addQuery("select pos, state, message from _vt.vreplication where id=1", state, nil)
+		tme.dbTargetClients[0].addQuery("select id from _vt.vreplication where id = 1", resultid1, nil)
+		tme.dbTargetClients[0].addQuery("update _vt.vreplication set state = 'Stopped', message = 'stopped for cutover' where id in (1)", &sqltypes.Result{}, nil)
+		tme.dbTargetClients[1].addQuery("select id from _vt.vreplication where id = 1", resultid1, nil)
+		tme.dbTargetClients[1].addQuery("update _vt.vreplication set state = 'Stopped', message = 'stopped for cutover' where id in (1)", &sqltypes.Result{}, nil)
+		tme.dbTargetClients[0].addQuery("select * from _vt.vreplication where id = 1", stoppedResult(1), nil)
+		tme.dbTargetClients[1].addQuery("select * from _vt.vreplication where id = 1", stoppedResult(1), nil)
+	}
+	waitForCatchup()
+
+	createReverseVReplication := func() {
+		tme.dbSourceClients[0].addQuery("select id from _vt.vreplication where db_name = 'vt_ks1' and workflow = 'test_reverse'", &sqltypes.Result{}, nil)
+		// The reverse streams replicate from this cluster, as mounted in ext.
+		tme.dbSourceClients[0].addQueryRE(`insert into _vt.vreplication.*test_reverse.*ks2.*-80.*t1.*from t1\\".*t2.*from t2\\".*external_cluster.*local`, &sqltypes.Result{InsertID: 1}, nil)
+		tme.dbSourceClients[0].addQueryRE(`insert into _vt.vreplication.*test_reverse.*ks2.*80-.*t1.*from t1\\".*t2.*from t2\\".*external_cluster.*local`, &sqltypes.Result{InsertID: 2}, nil)
+		tme.dbSourceClients[0].addQuery("select * from _vt.vreplication where id = 1", stoppedResult(1), nil)
+		tme.dbSourceClients[0].addQuery("select * from _vt.vreplication where id = 2", stoppedResult(2), nil)
+	}
+	createReverseVReplication()
+
+	startReverseVReplication := func() {
+		tme.dbSourceClients[0].addQuery("select id from _vt.vreplication where db_name = 'vt_ks1'", resultid12, nil)
+		tme.dbSourceClients[0].addQuery("update _vt.vreplication set state = 'Running', message = '' where id in (1, 2)", &sqltypes.Result{}, nil)
+		tme.dbSourceClients[0].addQuery("select * from _vt.vreplication where id = 1", runningResult(1), nil)
+		tme.dbSourceClients[0].addQuery("select * from _vt.vreplication where id = 2", runningResult(2), nil)
+	}
+	startReverseVReplication()
+
+	freezeTargetVReplication := func() {
+		tme.dbTargetClients[0].addQuery("select id from _vt.vreplication where db_name = 'vt_ks2' and workflow = 'test'", resultid1, nil)
+		tme.dbTargetClients[1].addQuery("select id from _vt.vreplication where db_name = 'vt_ks2' and workflow = 'test'", resultid1, nil)
+		tme.dbTargetClients[0].addQuery("update _vt.vreplication set message = 'FROZEN' where id in (1)", &sqltypes.Result{}, nil)
+		tme.dbTargetClients[0].addQuery("select * from _vt.vreplication where id = 1", stoppedResult(1), nil)
+		tme.dbTargetClients[1].addQuery("update _vt.vreplication set message = 'FROZEN' where id in (1)", &sqltypes.Result{}, nil)
+		tme.dbTargetClients[1].addQuery("select * from _vt.vreplication where id = 1", stoppedResult(1), nil)
+	}
+	freezeTargetVReplication()
+
+	_, err = tme.wr.SwitchMigrateWrites(ctx, tme.targetKeyspace, "test", "local", 1*time.Second, true, false)
+	require.NoError(t, err)
+	verifyQueries(t, tme.allDBClients)
+
+	// The writes were stopped on the primary of the mounted cluster, not in
+	// its topo.
+	require.True(t, tme.sourcePrimaries[0].FakeMysqlDaemon.ReadOnly)
+	checkDenyList(t, tme.ts, "ks1:0", nil)
+}
+
 func checkRouting(t *testing.T, wr *Wrangler, want map[string][]string) {
 	t.Helper()
 	ctx := context.Background()
@@ -813,6 +813,65 @@ func TestVDiffSharded(t *testing.T) {
 	assert.Equal(t, wantdr, dr["t1"])
 }
 
+func TestVDiffMerge(t *testing.T) {
+	// Each source of a merge is diffed from the position of its own stream
+	// into the target.
+	env := newTestVDiffEnv([]string{"-40", "40-"}, []string{"0"}, "", map[string]string{
+		"-400": "MariaDB/5-456-890",
+	})
+	defer env.close()
+	env.tmc.waitpos[101] = "MariaDB/5-456-890"
+
+	schm := &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{{
+			Name:              "t1",
+			Columns:           []string{"c1", "c2"},
+			PrimaryKeyColumns: []string{"c1"},
+			Fields:            sqltypes.MakeTestFields("c1|c2", "int64|int64"),
+		}},
+	}
+	env.tmc.schema = schm
+
+	query := "select c1, c2 from t1 order by c1 asc"
+	fields := sqltypes.MakeTestFields(
+		"c1|c2",
+		"int64|int64",
+	)
+
+	env.tablets[101].setResults(
+		query,
+		vdiffSourceGtid,
+		sqltypes.MakeTestStreamingResults(fields,
+			"1|3",
+			"3|4",
+		),
+	)
+	env.tablets[111].setResults(
+		query,
+		vdiffSourceGtid,
+		sqltypes.MakeTestStreamingResults(fields,
+			"2|4",
+			"4|5",
+		),
+	)
+	env.tablets[201].setResults(
+		query,
+		vdiffTargetPrimaryPosition,
+		sqltypes.MakeTestStreamingResults(fields,
+			"1|3",
+			"2|4",
+			"3|5",
+		),
+	)
+
+	dr, err := env.wr.VDiff(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 30*time.Second, "", 100, "", false /*debug*/, false /*onlyPks*/)
+	require.NoError(t, err)
+	assert.Equal(t, 4, dr["t1"].ProcessedRows)
+	assert.Equal(t, 2, dr["t1"].MatchingRows)
+	assert.Equal(t, 1, dr["t1"].MismatchedRows)
+	assert.Equal(t, 1, dr["t1"].ExtraRowsSource)
+}
+
 func TestVDiffAggregates(t *testing.T) {
 	env := newTestVDiffEnv([]string{"-40", "40-"}, []string{"-80", "80-"}, "select c1, count(*) c2, sum(c3) c3 from t group by c1", nil)
 	defer env.close()
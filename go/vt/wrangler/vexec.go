@@ -283,7 +283,7 @@ func (wr *Wrangler) convertQueryResultToSQLTypesResult(results map[*topo.TabletI
 	return retResults
 }
 
-// WorkflowAction can start/stop/delete or list streams in _vt.vreplication on all primaries in the target keyspace of the workflow.
+// WorkflowAction can start/stop/resume/delete or list streams in _vt.vreplication on all primaries in the target keyspace of the workflow.
 func (wr *Wrangler) WorkflowAction(ctx context.Context, workflow, keyspace, action string, dryRun bool) (map[*topo.TabletInfo]*sqltypes.Result, error) {
 
 	if action == "show" {
@@ -312,7 +312,10 @@ func (wr *Wrangler) getWorkflowActionQuery(action string) (string, error) {
 	case "stop":
 		query = fmt.Sprintf(updateSQL, encodeString("Stopped"))
 	case "start":
-		query = fmt.Sprintf(updateSQL, encodeString("Running"))
+		// Paused streams are only restarted by a resume.
+		query = fmt.Sprintf(updateSQL+" where state != %s", encodeString("Running"), encodeString(binlogplayer.BlpPaused))
+	case "resume":
+		query = fmt.Sprintf(updateSQL+", message = '' where state = %s", encodeString("Running"), encodeString(binlogplayer.BlpPaused))
 	case "delete":
 		query = "delete from _vt.vreplication"
 	default:
@@ -329,6 +332,21 @@ func (wr *Wrangler) execWorkflowAction(ctx context.Context, workflow, keyspace,
 	return wr.runVexec(ctx, workflow, keyspace, query, dryRun)
 }
 
+// WorkflowPause pauses the streams of a workflow on all primaries in its target keyspace.
+// The streams stop at a transaction boundary, and their message records the position
+// they stopped at and the reason of the pause. Only a resume restarts them.
+func (wr *Wrangler) WorkflowPause(ctx context.Context, workflow, keyspace, reason string, dryRun bool) (map[*topo.TabletInfo]*sqltypes.Result, error) {
+	if strings.TrimSpace(reason) == "" {
+		return nil, fmt.Errorf("a reason is required to pause a workflow")
+	}
+	// The engine stops the streams before it runs the update, so pos is the
+	// position of the last transaction they applied.
+	query := fmt.Sprintf("update _vt.vreplication set state = %s, message = concat('Paused at position ', pos, ': ', %s) where state != %s",
+		encodeString(binlogplayer.BlpPaused), encodeString(binlogplayer.MessageTruncate(reason)), encodeString(binlogplayer.BlpPaused))
+	results, err := wr.runVexec(ctx, workflow, keyspace, query, dryRun)
+	return wr.convertQueryResultToSQLTypesResult(results), err
+}
+
 // WorkflowTagAction sets or clears the tags for a workflow in a keyspace
 func (wr *Wrangler) WorkflowTagAction(ctx context.Context, keyspace string, workflow string, tags string) (map[*topo.TabletInfo]*sqltypes.Result, error) {
 	query := fmt.Sprintf("update _vt.vreplication set tags = %s", encodeString(tags))
@@ -401,6 +419,16 @@ type ReplicationStatus struct {
 
 	// CopyState represents the rows from the _vt.copy_state table.
 	CopyState []copyState
+	// DDLEvents are the latest DDLs of the source that the stream executed
+	// or submitted, most recent first. They are only read for the streams
+	// that do not ignore DDLs.
+	DDLEvents []ddlEvent `json:",omitempty"`
+}
+
+type ddlEvent struct {
+	Message   string
+	Count     int64
+	UpdatedAt string
 }
 
 func (wr *Wrangler) getReplicationStatusFromRow(ctx context.Context, row []sqltypes.Value, primary *topo.TabletInfo) (*ReplicationStatus, string, error) {
@@ -458,6 +486,12 @@ func (wr *Wrangler) getReplicationStatusFromRow(ctx context.Context, row []sqlty
 	if err != nil {
 		return nil, "", err
 	}
+	if bls.OnDdl != binlogdatapb.OnDDLAction_IGNORE {
+		status.DDLEvents, err = wr.getDDLEvents(ctx, primary, id)
+		if err != nil {
+			return nil, "", err
+		}
+	}
 
 	status.State = updateState(message, status.State, status.CopyState, timeUpdated)
 	return status, bls.Keyspace, nil
@@ -572,6 +606,10 @@ func (wr *Wrangler) ShowWorkflow(ctx context.Context, workflow, keyspace string)
 }
 
 func updateState(message, state string, cs []copyState, timeUpdated int64) string {
+	if state == binlogplayer.BlpPaused {
+		// The message is the reason of the pause.
+		return state
+	}
 	if strings.Contains(strings.ToLower(message), "error") {
 		state = "Error"
 	} else if state == "Running" && len(cs) > 0 {
@@ -600,6 +638,35 @@ func (wr *Wrangler) printWorkflowList(keyspace string, workflows []string) {
 	wr.Logger().Printf("Following workflow(s) found in keyspace %s: %v\n", keyspace, list)
 }
 
+// maxDDLEvents is the number of DDL events of a stream that ShowWorkflow
+// reports.
+const maxDDLEvents = 10
+
+func (wr *Wrangler) getDDLEvents(ctx context.Context, tablet *topo.TabletInfo, id int64) ([]ddlEvent, error) {
+	var events []ddlEvent
+	query := fmt.Sprintf("select message, count, updated_at from _vt.vreplication_log where vrepl_id = %d and type = 'DDL' order by id desc limit %d", id, maxDDLEvents)
+	qr, err := wr.VReplicationExec(ctx, tablet.Alias, query)
+	if err != nil {
+		return nil, err
+	}
+
+	result := sqltypes.Proto3ToResult(qr)
+	if result != nil {
+		for _, row := range result.Rows {
+			count, err := evalengine.ToInt64(row[1])
+			if err != nil {
+				return nil, err
+			}
+			events = append(events, ddlEvent{
+				Message:   row[0].ToString(),
+				Count:     count,
+				UpdatedAt: row[2].ToString(),
+			})
+		}
+	}
+	return events, nil
+}
+
 func (wr *Wrangler) getCopyState(ctx context.Context, tablet *topo.TabletInfo, id int64) ([]copyState, error) {
 	var cs []copyState
 	query := fmt.Sprintf("select table_name, lastpk from _vt.copy_state where vrepl_id = %d", id)
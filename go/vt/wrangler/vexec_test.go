@@ -173,6 +173,27 @@ func TestWorkflowStatusUpdate(t *testing.T) {
 	require.Equal(t, "Lagging", updateState("", "Running", nil, int64(time.Now().Second())-100))
 	require.Equal(t, "Copying", updateState("", "Running", []copyState{{Table: "t1", LastPK: "[[INT64(10)]]"}}, int64(time.Now().Second())))
 	require.Equal(t, "Error", updateState("error: primary tablet not contactable", "Running", nil, 0))
+	require.Equal(t, "Paused", updateState("Paused at position MySQL56/x:1-10: error budget", "Paused", nil, 0))
+}
+
+func TestWorkflowPause(t *testing.T) {
+	ctx := context.Background()
+	workflow := "wrWorkflow"
+	keyspace := "target"
+	env := newWranglerTestEnv([]string{"0"}, []string{"-80", "80-"}, "", nil, 1234)
+	defer env.close()
+	logger := logutil.NewMemoryLogger()
+	wr := New(logger, env.topoServ, env.tmc)
+
+	_, err := wr.WorkflowPause(ctx, workflow, keyspace, " ", false)
+	require.EqualError(t, err, "a reason is required to pause a workflow")
+
+	results, err := wr.WorkflowPause(ctx, workflow, keyspace, "schema change on the target", true)
+	require.NoError(t, err)
+	require.Empty(t, results)
+	require.Contains(t, logger.String(), "Query: update _vt.vreplication set state = 'Paused', "+
+		"message = concat('Paused at position ', pos, ': ', 'schema change on the target') "+
+		"where state != 'Paused' and db_name = 'vt_target' and workflow = 'wrWorkflow'\n")
 }
 
 func TestWorkflowListStreams(t *testing.T) {
@@ -402,7 +423,12 @@ func TestVExecValidations(t *testing.T) {
 	actions := []action{
 		{
 			name:          "start",
-			want:          fmt.Sprintf(updateSQL, encodeString("Running")),
+			want:          fmt.Sprintf(updateSQL+" where state != 'Paused'", encodeString("Running")),
+			expectedError: nil,
+		},
+		{
+			name:          "resume",
+			want:          fmt.Sprintf(updateSQL+", message = '' where state = 'Paused'", encodeString("Running")),
 			expectedError: nil,
 		},
 		{
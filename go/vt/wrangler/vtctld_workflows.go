@@ -0,0 +1,365 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"math"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/protoutil"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vtctl/grpcvtctldserver"
+	"vitess.io/vitess/go/vt/vtctl/workflow"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+func init() {
+	grpcvtctldserver.RegisterWorkflowManagerFactory(newVtctldWorkflowManager)
+}
+
+// vtctldWorkflowManager implements the VReplication workflows of the
+// VtctldServer with a Wrangler per request, whose events are returned in
+// the response.
+type vtctldWorkflowManager struct {
+	ts  *topo.Server
+	tmc tmclient.TabletManagerClient
+}
+
+func newVtctldWorkflowManager(ts *topo.Server, tmc tmclient.TabletManagerClient) grpcvtctldserver.WorkflowManager {
+	return &vtctldWorkflowManager{ts: ts, tmc: tmc}
+}
+
+// newWrangler returns a Wrangler whose events are recorded in the returned
+// logger, and logged by the vtctld.
+func (wm *vtctldWorkflowManager) newWrangler() (*Wrangler, *logutil.MemoryLogger) {
+	logger := logutil.NewMemoryLogger()
+	return New(logutil.NewTeeLogger(logger, logutil.NewConsoleLogger()), wm.ts, wm.tmc), logger
+}
+
+// existingVReplicationWorkflow returns the existing MoveTables, Reshard or
+// Migrate workflow of the params, whose type is deduced from its streams.
+func (wr *Wrangler) existingVReplicationWorkflow(ctx context.Context, params *VReplicationWorkflowParams) (*VReplicationWorkflow, error) {
+	vrw, err := wr.NewVReplicationWorkflow(ctx, MoveTablesWorkflow, params)
+	if err != nil {
+		return nil, err
+	}
+	if !vrw.Exists() {
+		return nil, vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "workflow %s.%s does not exist", params.TargetKeyspace, params.Workflow)
+	}
+
+	switch {
+	case vrw.ts.externalCluster != "" || vrw.ts.externalMysql != "":
+		vrw.workflowType = MigrateWorkflow
+	case vrw.ws.WorkflowType == workflow.TypeReshard:
+		vrw.workflowType = ReshardWorkflow
+	}
+	vrw.params.WorkflowType = vrw.workflowType
+
+	return vrw, nil
+}
+
+// MaterializeCreate is part of the grpcvtctldserver.WorkflowManager interface.
+func (wm *vtctldWorkflowManager) MaterializeCreate(ctx context.Context, req *vtctldatapb.MaterializeCreateRequest) (*vtctldatapb.MaterializeCreateResponse, error) {
+	wr, logger := wm.newWrangler()
+	err := wr.Materialize(ctx, req.Settings)
+	return &vtctldatapb.MaterializeCreateResponse{Events: logger.Events}, err
+}
+
+// MoveTablesCreate is part of the grpcvtctldserver.WorkflowManager interface.
+func (wm *vtctldWorkflowManager) MoveTablesCreate(ctx context.Context, req *vtctldatapb.MoveTablesCreateRequest) (*vtctldatapb.MoveTablesCreateResponse, error) {
+	workflowType := MoveTablesWorkflow
+	if req.ExternalClusterName != "" {
+		workflowType = MigrateWorkflow
+	}
+
+	wr, logger := wm.newWrangler()
+	vrw, err := wr.NewVReplicationWorkflow(ctx, workflowType, &VReplicationWorkflowParams{
+		WorkflowType:    workflowType,
+		Workflow:        req.Workflow,
+		SourceKeyspace:  req.SourceKeyspace,
+		TargetKeyspace:  req.TargetKeyspace,
+		Cells:           strings.Join(req.Cells, ","),
+		TabletTypes:     tabletTypesCSV(req.TabletTypes),
+		Tables:          strings.Join(req.IncludeTables, ","),
+		AllTables:       req.AllTables,
+		ExcludeTables:   strings.Join(req.ExcludeTables, ","),
+		AutoStart:       req.AutoStart,
+		StopAfterCopy:   req.StopAfterCopy,
+		ExternalCluster: req.ExternalClusterName,
+	})
+	if err == nil {
+		err = vrw.Create(ctx)
+	}
+
+	return &vtctldatapb.MoveTablesCreateResponse{Events: logger.Events}, err
+}
+
+// ReferenceTablesCreate is part of the grpcvtctldserver.WorkflowManager interface.
+func (wm *vtctldWorkflowManager) ReferenceTablesCreate(ctx context.Context, req *vtctldatapb.ReferenceTablesCreateRequest) (*vtctldatapb.ReferenceTablesCreateResponse, error) {
+	wr, logger := wm.newWrangler()
+	shards, err := wr.CreateReferenceTablesWorkflow(ctx, &topodatapb.ReferenceTablesWorkflow{
+		Name:            req.Workflow,
+		SourceKeyspace:  req.SourceKeyspace,
+		TargetKeyspaces: req.TargetKeyspaces,
+		Tables:          req.Tables,
+		Cells:           req.Cells,
+		TabletTypes:     req.TabletTypes,
+	})
+	return &vtctldatapb.ReferenceTablesCreateResponse{Shards: shards, Events: logger.Events}, err
+}
+
+// ReferenceTablesDelete is part of the grpcvtctldserver.WorkflowManager interface.
+func (wm *vtctldWorkflowManager) ReferenceTablesDelete(ctx context.Context, req *vtctldatapb.ReferenceTablesDeleteRequest) (*vtctldatapb.ReferenceTablesDeleteResponse, error) {
+	wr, logger := wm.newWrangler()
+	err := wr.DeleteReferenceTablesWorkflow(ctx, req.Workflow)
+	return &vtctldatapb.ReferenceTablesDeleteResponse{Events: logger.Events}, err
+}
+
+// ReferenceTablesRefresh is part of the grpcvtctldserver.WorkflowManager interface.
+func (wm *vtctldWorkflowManager) ReferenceTablesRefresh(ctx context.Context, req *vtctldatapb.ReferenceTablesRefreshRequest) (*vtctldatapb.ReferenceTablesRefreshResponse, error) {
+	wr, logger := wm.newWrangler()
+	shards, err := wr.RefreshReferenceTablesWorkflows(ctx, req.Workflows)
+	return &vtctldatapb.ReferenceTablesRefreshResponse{Shards: shards, Events: logger.Events}, err
+}
+
+// ReshardCreate is part of the grpcvtctldserver.WorkflowManager interface.
+func (wm *vtctldWorkflowManager) ReshardCreate(ctx context.Context, req *vtctldatapb.ReshardCreateRequest) (*vtctldatapb.ReshardCreateResponse, error) {
+	wr, logger := wm.newWrangler()
+	vrw, err := wr.NewVReplicationWorkflow(ctx, ReshardWorkflow, &VReplicationWorkflowParams{
+		WorkflowType:   ReshardWorkflow,
+		Workflow:       req.Workflow,
+		SourceKeyspace: req.Keyspace,
+		TargetKeyspace: req.Keyspace,
+		SourceShards:   req.SourceShards,
+		TargetShards:   req.TargetShards,
+		Cells:          strings.Join(req.Cells, ","),
+		TabletTypes:    tabletTypesCSV(req.TabletTypes),
+		SkipSchemaCopy: req.SkipSchemaCopy,
+		AutoStart:      req.AutoStart,
+		StopAfterCopy:  req.StopAfterCopy,
+	})
+	if err == nil {
+		err = vrw.Create(ctx)
+	}
+
+	return &vtctldatapb.ReshardCreateResponse{Events: logger.Events}, err
+}
+
+// VDiff is part of the grpcvtctldserver.WorkflowManager interface.
+func (wm *vtctldWorkflowManager) VDiff(ctx context.Context, req *vtctldatapb.VDiffRequest) (*vtctldatapb.VDiffResponse, error) {
+	waitTime, ok, err := protoutil.DurationFromProto(req.FilteredReplicationWaitTime)
+	if err != nil {
+		return nil, err
+	} else if !ok {
+		waitTime = 30 * time.Second
+	}
+
+	maxRows := req.MaxRows
+	if maxRows == 0 {
+		maxRows = math.MaxInt64
+	}
+
+	wr, _ := wm.newWrangler()
+	reports, err := wr.VDiff(ctx, req.Keyspace, req.Workflow, req.SourceCell, req.TargetCell, tabletTypesCSV(req.TabletTypes),
+		waitTime, "json", maxRows, strings.Join(req.Tables, ","), req.DebugQuery, req.OnlyPks)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &vtctldatapb.VDiffResponse{
+		TableReports: make(map[string]*vtctldatapb.VDiffResponse_TableReport, len(reports)),
+	}
+	for table, report := range reports {
+		tableReport := &vtctldatapb.VDiffResponse_TableReport{
+			TableName:       report.TableName,
+			ProcessedRows:   int64(report.ProcessedRows),
+			MatchingRows:    int64(report.MatchingRows),
+			MismatchedRows:  int64(report.MismatchedRows),
+			ExtraRowsSource: int64(report.ExtraRowsSource),
+			ExtraRowsTarget: int64(report.ExtraRowsTarget),
+		}
+		for _, row := range report.ExtraRowsSourceSample {
+			tableReport.ExtraRowsSourceSample = append(tableReport.ExtraRowsSourceSample, rowDiffToProto(row))
+		}
+		for _, row := range report.ExtraRowsTargetSample {
+			tableReport.ExtraRowsTargetSample = append(tableReport.ExtraRowsTargetSample, rowDiffToProto(row))
+		}
+		for _, mismatch := range report.MismatchedRowsSample {
+			tableReport.MismatchedRowsSample = append(tableReport.MismatchedRowsSample, &vtctldatapb.VDiffResponse_Mismatch{
+				Source: rowDiffToProto(mismatch.Source),
+				Target: rowDiffToProto(mismatch.Target),
+			})
+		}
+		resp.TableReports[table] = tableReport
+	}
+
+	return resp, nil
+}
+
+// WorkflowCancel is part of the grpcvtctldserver.WorkflowManager interface.
+func (wm *vtctldWorkflowManager) WorkflowCancel(ctx context.Context, req *vtctldatapb.WorkflowCancelRequest) (*vtctldatapb.WorkflowCancelResponse, error) {
+	wr, logger := wm.newWrangler()
+	vrw, err := wr.existingVReplicationWorkflow(ctx, &VReplicationWorkflowParams{
+		Workflow:       req.Workflow,
+		TargetKeyspace: req.Keyspace,
+		KeepData:       req.KeepData,
+	})
+	if err == nil {
+		_, err = vrw.Cancel()
+	}
+
+	return &vtctldatapb.WorkflowCancelResponse{Events: logger.Events}, err
+}
+
+// WorkflowComplete is part of the grpcvtctldserver.WorkflowManager interface.
+func (wm *vtctldWorkflowManager) WorkflowComplete(ctx context.Context, req *vtctldatapb.WorkflowCompleteRequest) (*vtctldatapb.WorkflowCompleteResponse, error) {
+	wr, logger := wm.newWrangler()
+	resp := &vtctldatapb.WorkflowCompleteResponse{}
+	vrw, err := wr.existingVReplicationWorkflow(ctx, &VReplicationWorkflowParams{
+		Workflow:       req.Workflow,
+		TargetKeyspace: req.Keyspace,
+		KeepData:       req.KeepData,
+		RenameTables:   req.RenameTables,
+		DryRun:         req.DryRun,
+	})
+	if err == nil {
+		var dryRunResults *[]string
+		if dryRunResults, err = vrw.Complete(); err == nil && req.DryRun && dryRunResults != nil {
+			resp.DryRunResults = *dryRunResults
+		}
+	}
+
+	resp.Events = logger.Events
+	return resp, err
+}
+
+// WorkflowPause is part of the grpcvtctldserver.WorkflowManager interface.
+func (wm *vtctldWorkflowManager) WorkflowPause(ctx context.Context, req *vtctldatapb.WorkflowPauseRequest) (*vtctldatapb.WorkflowPauseResponse, error) {
+	wr, logger := wm.newWrangler()
+	results, err := wr.WorkflowPause(ctx, req.Workflow, req.Keyspace, req.Reason, req.DryRun)
+
+	return &vtctldatapb.WorkflowPauseResponse{StreamsByTablet: streamsByTablet(results), Events: logger.Events}, err
+}
+
+// WorkflowResume is part of the grpcvtctldserver.WorkflowManager interface.
+func (wm *vtctldWorkflowManager) WorkflowResume(ctx context.Context, req *vtctldatapb.WorkflowResumeRequest) (*vtctldatapb.WorkflowResumeResponse, error) {
+	wr, logger := wm.newWrangler()
+	results, err := wr.WorkflowAction(ctx, req.Workflow, req.Keyspace, "resume", req.DryRun)
+
+	return &vtctldatapb.WorkflowResumeResponse{StreamsByTablet: streamsByTablet(results), Events: logger.Events}, err
+}
+
+// WorkflowSwitchTraffic is part of the grpcvtctldserver.WorkflowManager
+// interface.
+func (wm *vtctldWorkflowManager) WorkflowSwitchTraffic(ctx context.Context, req *vtctldatapb.WorkflowSwitchTrafficRequest) (*vtctldatapb.WorkflowSwitchTrafficResponse, error) {
+	timeout, ok, err := protoutil.DurationFromProto(req.Timeout)
+	if err != nil {
+		return nil, err
+	} else if !ok {
+		timeout = 30 * time.Second
+	}
+
+	tabletTypes := tabletTypesCSV(req.TabletTypes)
+	if tabletTypes == "" {
+		tabletTypes = "primary,replica,rdonly"
+	}
+
+	wr, logger := wm.newWrangler()
+	resp := &vtctldatapb.WorkflowSwitchTrafficResponse{}
+	vrw, err := wr.existingVReplicationWorkflow(ctx, &VReplicationWorkflowParams{
+		Workflow:                 req.Workflow,
+		TargetKeyspace:           req.Keyspace,
+		Cells:                    strings.Join(req.Cells, ","),
+		TabletTypes:              tabletTypes,
+		Timeout:                  timeout,
+		EnableReverseReplication: req.EnableReverseReplication,
+		DryRun:                   req.DryRun,
+	})
+	if err == nil {
+		resp.StartState = vrw.CachedState()
+
+		var dryRunResults *[]string
+		if workflow.TrafficSwitchDirection(req.Direction) == workflow.DirectionBackward {
+			dryRunResults, err = vrw.ReverseTraffic()
+		} else {
+			dryRunResults, err = vrw.SwitchTraffic(workflow.DirectionForward)
+		}
+
+		switch {
+		case err != nil:
+		case req.DryRun:
+			if dryRunResults != nil {
+				resp.DryRunResults = *dryRunResults
+			}
+			resp.CurrentState = resp.StartState
+		default:
+			resp.CurrentState = vrw.CurrentState()
+		}
+	}
+
+	resp.Events = logger.Events
+	return resp, err
+}
+
+// tabletTypesCSV returns the tablet types in the comma-separated format of
+// the wrangler, in order.
+func tabletTypesCSV(tabletTypes []topodatapb.TabletType) string {
+	strs := make([]string, len(tabletTypes))
+	for i, tabletType := range tabletTypes {
+		strs[i] = topoproto.TabletTypeLString(tabletType)
+	}
+	return strings.Join(strs, ",")
+}
+
+// streamsByTablet returns the numbers of streams updated by a workflow
+// action on each target primary, by tablet alias.
+func streamsByTablet(results map[*topo.TabletInfo]*sqltypes.Result) map[string]uint64 {
+	if len(results) == 0 {
+		return nil
+	}
+
+	streams := make(map[string]uint64, len(results))
+	for tablet, result := range results {
+		streams[topoproto.TabletAliasString(tablet.Alias)] = result.RowsAffected
+	}
+	return streams
+}
+
+func rowDiffToProto(row *RowDiff) *vtctldatapb.VDiffResponse_RowDiff {
+	if row == nil {
+		return nil
+	}
+
+	rowDiff := &vtctldatapb.VDiffResponse_RowDiff{
+		Row:   make(map[string]string, len(row.Row)),
+		Query: row.Query,
+	}
+	for column, value := range row.Row {
+		rowDiff.Row[column] = value.ToString()
+	}
+	return rowDiff
+}
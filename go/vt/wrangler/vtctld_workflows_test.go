@@ -0,0 +1,100 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+func TestVtctldWorkflowManagerMaterializeCreate(t *testing.T) {
+	ms := &vtctldatapb.MaterializeSettings{
+		Workflow:       "workflow",
+		SourceKeyspace: "sourceks",
+		TargetKeyspace: "targetks",
+		TableSettings: []*vtctldatapb.TableMaterializeSettings{{
+			TargetTable:      "t1",
+			SourceExpression: "select * from t1",
+			CreateDdl:        "t1ddl",
+		}},
+	}
+	env := newTestMaterializerEnv(t, ms, []string{"0"}, []string{"0"})
+	defer env.close()
+
+	env.tmc.expectVRQuery(200, mzSelectFrozenQuery, &sqltypes.Result{})
+	env.tmc.expectVRQuery(200, insertPrefix, &sqltypes.Result{})
+	env.tmc.expectVRQuery(200, mzUpdateQuery, &sqltypes.Result{})
+
+	wm := newVtctldWorkflowManager(env.wr.ts, env.tmc)
+	resp, err := wm.MaterializeCreate(context.Background(), &vtctldatapb.MaterializeCreateRequest{Settings: ms})
+	require.NoError(t, err)
+	assert.NotNil(t, resp)
+	env.tmc.verifyQueries(t)
+}
+
+func TestVtctldWorkflowManagerWorkflowPause(t *testing.T) {
+	env := newWranglerTestEnv([]string{"0"}, []string{"-80", "80-"}, "", nil, 1234)
+	defer env.close()
+
+	wm := newVtctldWorkflowManager(env.topoServ, env.tmc)
+	resp, err := wm.WorkflowPause(context.Background(), &vtctldatapb.WorkflowPauseRequest{
+		Keyspace: "target",
+		Workflow: "wrWorkflow",
+		Reason:   "schema change on the target",
+		DryRun:   true,
+	})
+	require.NoError(t, err)
+	assert.Empty(t, resp.StreamsByTablet)
+	require.NotEmpty(t, resp.Events)
+	assert.Contains(t, resp.Events[0].Value, "Query: update _vt.vreplication set state = 'Paused'")
+
+	query := "update _vt.vreplication set state = 'Paused', message = concat('Paused at position ', pos, ': ', 'schema change on the target') " +
+		"where state != 'Paused' and db_name = 'vt_target' and workflow = 'wrWorkflow'"
+	for _, id := range []int{200, 210} {
+		env.tmc.setVRResults(env.tablets[id].tablet, query, &sqltypes.Result{RowsAffected: 1})
+	}
+	resp, err = wm.WorkflowPause(context.Background(), &vtctldatapb.WorkflowPauseRequest{
+		Keyspace: "target",
+		Workflow: "wrWorkflow",
+		Reason:   "schema change on the target",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]uint64{"zone1-0000000200": 1, "zone1-0000000210": 1}, resp.StreamsByTablet)
+}
+
+func TestVtctldWorkflowManagerWorkflowResume(t *testing.T) {
+	env := newWranglerTestEnv([]string{"0"}, []string{"-80", "80-"}, "", nil, 1234)
+	defer env.close()
+
+	wm := newVtctldWorkflowManager(env.topoServ, env.tmc)
+	resp, err := wm.WorkflowResume(context.Background(), &vtctldatapb.WorkflowResumeRequest{
+		Keyspace: "target",
+		Workflow: "wrWorkflow",
+		DryRun:   true,
+	})
+	require.NoError(t, err)
+	assert.Empty(t, resp.StreamsByTablet)
+	require.NotEmpty(t, resp.Events)
+	assert.Contains(t, resp.Events[0].Value, "Query: update _vt.vreplication set state = 'Running', message = '' where state = 'Paused'")
+}
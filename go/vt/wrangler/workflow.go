@@ -3,6 +3,7 @@ package wrangler
 import (
 	"context"
 	"fmt"
+	"math"
 	"sort"
 	"strings"
 	"time"
@@ -11,6 +12,7 @@ import (
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/vtctl/workflow"
+	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vtgate/evalengine"
 
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
@@ -45,6 +47,9 @@ type VReplicationWorkflow struct {
 	params       *VReplicationWorkflowParams
 	ts           *trafficSwitcher
 	ws           *workflow.State
+
+	// dryRunActions are the actions recorded by the dry runs of the workflow.
+	dryRunActions []*DryRunAction
 }
 
 func (vrw *VReplicationWorkflow) String() string {
@@ -63,10 +68,18 @@ type VReplicationWorkflowParams struct {
 	KeepData                          bool
 	Timeout                           time.Duration
 	Direction                         workflow.TrafficSwitchDirection
+	// ReverseVDiffWindow, if set, makes SwitchTraffic diff the source
+	// keyspace against the target keyspace through the reverse workflow once
+	// the writes are switched, and fail if they diverged. The diff must
+	// complete within the window.
+	ReverseVDiffWindow time.Duration
 
 	// MoveTables specific
 	SourceKeyspace, Tables  string
 	AllTables, RenameTables bool
+	// OnDDL is the action of the streams on the DDLs of the source tables,
+	// one of the binlogdata.OnDDLAction names.
+	OnDDL string
 
 	// Reshard specific
 	SourceShards, TargetShards []string
@@ -75,6 +88,9 @@ type VReplicationWorkflowParams struct {
 
 	// Migrate specific
 	ExternalCluster string
+	// ReverseExternalCluster is the name of this cluster in the topo of the
+	// external cluster, used by the reverse streams of SwitchTraffic.
+	ReverseExternalCluster string
 }
 
 // NewVReplicationWorkflow sets up a MoveTables or Reshard workflow based on options provided, deduces the state of the
@@ -250,8 +266,13 @@ func (vrw *VReplicationWorkflow) SwitchTraffic(direction workflow.TrafficSwitchD
 	if !vrw.Exists() {
 		return nil, fmt.Errorf("workflow has not yet been started")
 	}
-	if vrw.workflowType == MigrateWorkflow {
-		return nil, fmt.Errorf("invalid action for Migrate workflow: SwitchTraffic")
+	if vrw.workflowType == MigrateWorkflow && direction == workflow.DirectionBackward {
+		return nil, fmt.Errorf("invalid action for Migrate workflow: ReverseTraffic")
+	}
+
+	reverseVDiff := direction == workflow.DirectionForward && vrw.params.ReverseVDiffWindow > 0 && !vrw.params.DryRun
+	if reverseVDiff && (vrw.workflowType == MigrateWorkflow || !vrw.params.EnableReverseReplication) {
+		return nil, fmt.Errorf("a reverse vdiff requires the reverse replication of a MoveTables or Reshard workflow")
 	}
 
 	isCopyInProgress, err = vrw.IsCopyInProgress()
@@ -262,6 +283,17 @@ func (vrw *VReplicationWorkflow) SwitchTraffic(direction workflow.TrafficSwitchD
 		return nil, fmt.Errorf("cannot switch traffic at this time, copy is still in progress for this workflow")
 	}
 
+	if vrw.workflowType == MigrateWorkflow {
+		// The reads and writes of the tables are served by the cluster the
+		// application connects to, so all tablet types are switched at once.
+		sw, err := vrw.wr.switchMigrateWrites(vrw.ctx, vrw.params.TargetKeyspace, vrw.params.Workflow, vrw.params.ReverseExternalCluster,
+			vrw.params.Timeout, vrw.params.EnableReverseReplication, vrw.params.DryRun)
+		if err != nil {
+			return nil, err
+		}
+		return vrw.dryRunResults(sw), nil
+	}
+
 	vrw.params.Direction = direction
 	hasReplica, hasRdonly, hasPrimary, err = vrw.parseTabletTypes()
 	if err != nil {
@@ -283,6 +315,11 @@ func (vrw *VReplicationWorkflow) SwitchTraffic(direction workflow.TrafficSwitchD
 	if wrDryRunResults != nil {
 		dryRunResults = append(dryRunResults, *wrDryRunResults...)
 	}
+	if hasPrimary && reverseVDiff {
+		if err = vrw.reverseVDiff(); err != nil {
+			return nil, err
+		}
+	}
 	return &dryRunResults, nil
 }
 
@@ -305,13 +342,16 @@ const (
 
 // Complete cleans up a successful workflow
 func (vrw *VReplicationWorkflow) Complete() (*[]string, error) {
-	var dryRunResults *[]string
+	var sw iswitcher
 	var err error
 	ws := vrw.ws
 
 	if vrw.workflowType == MigrateWorkflow {
-		return vrw.wr.finalizeMigrateWorkflow(vrw.ctx, ws.TargetKeyspace, ws.Workflow, vrw.params.Tables,
-			false, vrw.params.KeepData, vrw.params.DryRun)
+		if sw, err = vrw.wr.finalizeMigrateWorkflow(vrw.ctx, ws.TargetKeyspace, ws.Workflow, vrw.params.Tables,
+			false, vrw.params.KeepData, vrw.params.DryRun); err != nil {
+			return nil, err
+		}
+		return vrw.dryRunResults(sw), nil
 	}
 
 	if !ws.WritesSwitched || len(ws.ReplicaCellsNotSwitched) > 0 || len(ws.RdonlyCellsNotSwitched) > 0 {
@@ -323,30 +363,57 @@ func (vrw *VReplicationWorkflow) Complete() (*[]string, error) {
 	} else {
 		renameTable = workflow.DropTable
 	}
-	if dryRunResults, err = vrw.wr.DropSources(vrw.ctx, vrw.ws.TargetKeyspace, vrw.ws.Workflow, renameTable,
+	if sw, err = vrw.wr.dropSources(vrw.ctx, vrw.ws.TargetKeyspace, vrw.ws.Workflow, renameTable,
 		false, vrw.params.KeepData, vrw.params.DryRun); err != nil {
 		return nil, err
 	}
-	return dryRunResults, nil
+	return vrw.dryRunResults(sw), nil
 }
 
 // Cancel deletes all artifacts from a workflow which has not yet been switched
-func (vrw *VReplicationWorkflow) Cancel() error {
+func (vrw *VReplicationWorkflow) Cancel() (*[]string, error) {
+	var sw iswitcher
+	var err error
 	ws := vrw.ws
 	if vrw.workflowType == MigrateWorkflow {
-		_, err := vrw.wr.finalizeMigrateWorkflow(vrw.ctx, ws.TargetKeyspace, ws.Workflow, "",
-			true, vrw.params.KeepData, vrw.params.DryRun)
-		return err
+		if ws.WritesSwitched {
+			return nil, fmt.Errorf(ErrWorkflowPartiallySwitched)
+		}
+		if sw, err = vrw.wr.finalizeMigrateWorkflow(vrw.ctx, ws.TargetKeyspace, ws.Workflow, "",
+			true, vrw.params.KeepData, vrw.params.DryRun); err != nil {
+			return nil, err
+		}
+		return vrw.dryRunResults(sw), nil
 	}
 
 	if ws.WritesSwitched || len(ws.ReplicaCellsSwitched) > 0 || len(ws.RdonlyCellsSwitched) > 0 {
-		return fmt.Errorf(ErrWorkflowPartiallySwitched)
+		return nil, fmt.Errorf(ErrWorkflowPartiallySwitched)
 	}
-	if _, err := vrw.wr.DropTargets(vrw.ctx, vrw.ws.TargetKeyspace, vrw.ws.Workflow, vrw.params.KeepData, false); err != nil {
-		return err
+	if sw, err = vrw.wr.dropTargets(vrw.ctx, vrw.ws.TargetKeyspace, vrw.ws.Workflow, vrw.params.KeepData, vrw.params.DryRun); err != nil {
+		return nil, err
 	}
-	vrw.ts = nil
-	return nil
+	if !vrw.params.DryRun {
+		vrw.ts = nil
+	}
+	return vrw.dryRunResults(sw), nil
+}
+
+// DryRunPlan returns the actions that the dry runs of the workflow recorded,
+// in the order in which the workflow action would have made them.
+func (vrw *VReplicationWorkflow) DryRunPlan(action string) *DryRunPlan {
+	return &DryRunPlan{
+		Action:   action,
+		Keyspace: vrw.ws.TargetKeyspace,
+		Workflow: vrw.ws.Workflow,
+		Actions:  vrw.dryRunActions,
+	}
+}
+
+// dryRunResults records the actions of the dry run of a switcher, and
+// returns its logs.
+func (vrw *VReplicationWorkflow) dryRunResults(sw iswitcher) *[]string {
+	vrw.dryRunActions = append(vrw.dryRunActions, sw.dryRunActions()...)
+	return sw.logs()
 }
 
 // endregion
@@ -395,7 +462,7 @@ func (vrw *VReplicationWorkflow) initMoveTables() error {
 	log.Infof("In VReplicationWorkflow.initMoveTables() for %+v", vrw)
 	return vrw.wr.MoveTables(vrw.ctx, vrw.params.Workflow, vrw.params.SourceKeyspace, vrw.params.TargetKeyspace,
 		vrw.params.Tables, vrw.params.Cells, vrw.params.TabletTypes, vrw.params.AllTables, vrw.params.ExcludeTables,
-		vrw.params.AutoStart, vrw.params.StopAfterCopy, vrw.params.ExternalCluster)
+		vrw.params.AutoStart, vrw.params.StopAfterCopy, vrw.params.ExternalCluster, vrw.params.OnDDL)
 }
 
 func (vrw *VReplicationWorkflow) initReshard() error {
@@ -412,19 +479,17 @@ func (vrw *VReplicationWorkflow) switchReads() (*[]string, error) {
 			tabletTypes = append(tabletTypes, tt)
 		}
 	}
-	var dryRunResults *[]string
-	var err error
-	dryRunResults, err = vrw.wr.SwitchReads(vrw.ctx, vrw.params.TargetKeyspace, vrw.params.Workflow, tabletTypes,
+	sw, err := vrw.wr.switchReads(vrw.ctx, vrw.params.TargetKeyspace, vrw.params.Workflow, tabletTypes,
 		vrw.getCellsAsArray(), vrw.params.Direction, vrw.params.DryRun)
 	if err != nil {
 		return nil, err
 	}
-	return dryRunResults, nil
+	return vrw.dryRunResults(sw), nil
 }
 
 func (vrw *VReplicationWorkflow) switchWrites() (*[]string, error) {
 	var journalID int64
-	var dryRunResults *[]string
+	var sw iswitcher
 	var err error
 	log.Infof("In VReplicationWorkflow.switchWrites() for %+v", vrw)
 	if vrw.params.Direction == workflow.DirectionBackward {
@@ -434,13 +499,41 @@ func (vrw *VReplicationWorkflow) switchWrites() (*[]string, error) {
 		vrw.params.Workflow = workflow.ReverseWorkflowName(vrw.params.Workflow)
 		log.Infof("In VReplicationWorkflow.switchWrites(reverse) for %+v", vrw)
 	}
-	journalID, dryRunResults, err = vrw.wr.SwitchWrites(vrw.ctx, vrw.params.TargetKeyspace, vrw.params.Workflow, vrw.params.Timeout,
+	journalID, sw, err = vrw.wr.switchWrites(vrw.ctx, vrw.params.TargetKeyspace, vrw.params.Workflow, vrw.params.Timeout,
 		false, vrw.params.Direction == workflow.DirectionBackward, vrw.params.EnableReverseReplication, vrw.params.DryRun)
 	if err != nil {
 		return nil, err
 	}
 	log.Infof("switchWrites succeeded with journal id %s", journalID)
-	return dryRunResults, nil
+	return vrw.dryRunResults(sw), nil
+}
+
+// reverseVDiff diffs the source keyspace against the target keyspace through
+// the reverse workflow, after the writes were switched. Rows that differ were
+// written to the source keyspace after the cut-over, which the reverse
+// streams would overwrite or replicate on top of.
+func (vrw *VReplicationWorkflow) reverseVDiff() error {
+	ctx, cancel := context.WithTimeout(vrw.ctx, vrw.params.ReverseVDiffWindow)
+	defer cancel()
+	reverseWorkflow := workflow.ReverseWorkflowName(vrw.params.Workflow)
+	log.Infof("In VReplicationWorkflow.reverseVDiff() for %s.%s", vrw.params.SourceKeyspace, reverseWorkflow)
+	diffReports, err := vrw.wr.VDiff(ctx, vrw.params.SourceKeyspace, reverseWorkflow, "", "", "primary,replica,rdonly",
+		vrw.params.ReverseVDiffWindow, "", math.MaxInt64, "", false, true)
+	if err != nil {
+		return vterrors.Wrapf(err, "writes were switched, but the reverse vdiff of %s.%s failed", vrw.params.SourceKeyspace, reverseWorkflow)
+	}
+	var diverged []string
+	for table, dr := range diffReports {
+		if dr.MismatchedRows > 0 || dr.ExtraRowsSource > 0 || dr.ExtraRowsTarget > 0 {
+			diverged = append(diverged, table)
+		}
+	}
+	if len(diverged) > 0 {
+		sort.Strings(diverged)
+		return fmt.Errorf("writes were switched, but the reverse vdiff of %s.%s found differences in tables %s",
+			vrw.params.SourceKeyspace, reverseWorkflow, strings.Join(diverged, ","))
+	}
+	return nil
 }
 
 // endregion
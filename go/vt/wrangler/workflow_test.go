@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -63,7 +64,8 @@ func TestReshardingWorkflowErrorsAndMisc(t *testing.T) {
 	require.True(t, mtwf.Exists())
 	require.Errorf(t, testComplete(t, mtwf), ErrWorkflowNotFullySwitched)
 	mtwf.ws.WritesSwitched = true
-	require.Errorf(t, mtwf.Cancel(), ErrWorkflowPartiallySwitched)
+	_, err := mtwf.Cancel()
+	require.Errorf(t, err, ErrWorkflowPartiallySwitched)
 
 	require.ElementsMatch(t, mtwf.getCellsAsArray(), []string{"cell1", "cell2"})
 	require.ElementsMatch(t, mtwf.getTabletTypes(), []topodata.TabletType{topodata.TabletType_REPLICA, topodata.TabletType_RDONLY})
@@ -194,6 +196,27 @@ func TestMoveTablesV2(t *testing.T) {
 	require.Equal(t, WorkflowStateNotSwitched, wf.CurrentState())
 }
 
+func TestMoveTablesV2ReverseVDiffWithoutReverseReplication(t *testing.T) {
+	ctx := context.Background()
+	p := &VReplicationWorkflowParams{
+		Workflow:           "test",
+		SourceKeyspace:     "ks1",
+		TargetKeyspace:     "ks2",
+		Tables:             "t1,t2",
+		Cells:              "cell1,cell2",
+		TabletTypes:        "replica,rdonly,primary",
+		Timeout:            DefaultActionTimeout,
+		ReverseVDiffWindow: time.Minute,
+	}
+	tme := newTestTableMigrater(ctx, t)
+	defer tme.stopTablets(t)
+	wf, err := tme.wr.NewVReplicationWorkflow(ctx, MoveTablesWorkflow, p)
+	require.NoError(t, err)
+	_, err = wf.SwitchTraffic(workflow.DirectionForward)
+	require.EqualError(t, err, "a reverse vdiff requires the reverse replication of a MoveTables or Reshard workflow")
+	require.Equal(t, WorkflowStateNotSwitched, wf.CurrentState())
+}
+
 func validateRoutingRuleCount(ctx context.Context, t *testing.T, ts *topo.Server, cnt int) {
 	rr, err := ts.GetRoutingRules(ctx)
 	fmt.Printf("Rules %+v\n", rr.Rules)
@@ -341,7 +364,8 @@ func TestMoveTablesV2Cancel(t *testing.T) {
 	require.True(t, checkIfTableExistInVSchema(ctx, t, wf.wr.ts, "ks2", "t1"))
 	require.True(t, checkIfTableExistInVSchema(ctx, t, wf.wr.ts, "ks2", "t2"))
 
-	require.NoError(t, wf.Cancel())
+	_, err = wf.Cancel()
+	require.NoError(t, err)
 
 	validateRoutingRuleCount(ctx, t, wf.wr.ts, 0)
 
@@ -351,6 +375,57 @@ func TestMoveTablesV2Cancel(t *testing.T) {
 	require.False(t, checkIfTableExistInVSchema(ctx, t, wf.wr.ts, "ks2", "t2"))
 }
 
+func TestMoveTablesV2CancelDryRun(t *testing.T) {
+	ctx := context.Background()
+	p := &VReplicationWorkflowParams{
+		Workflow:       "test",
+		SourceKeyspace: "ks1",
+		TargetKeyspace: "ks2",
+		Tables:         "t1,t2",
+		Cells:          "cell1,cell2",
+		TabletTypes:    "replica,rdonly,primary",
+		Timeout:        DefaultActionTimeout,
+		DryRun:         true,
+	}
+	tme := newTestTableMigrater(ctx, t)
+	defer tme.stopTablets(t)
+	expectMoveTablesQueries(t, tme)
+	wf, err := tme.wr.NewVReplicationWorkflow(ctx, MoveTablesWorkflow, p)
+	require.NoError(t, err)
+	require.NotNil(t, wf)
+
+	dryRunResults, err := wf.Cancel()
+	require.NoError(t, err)
+	require.NotEmpty(t, *dryRunResults)
+	validateRoutingRuleCount(ctx, t, wf.wr.ts, 4)
+	require.True(t, checkIfTableExistInVSchema(ctx, t, wf.wr.ts, "ks2", "t1"))
+
+	plan := wf.DryRunPlan("Cancel")
+	require.Equal(t, "ks2", plan.Keyspace)
+	require.Equal(t, "test", plan.Workflow)
+	require.Equal(t, &DryRunAction{Type: DryRunLock, Description: "Lock keyspace ks1", Keyspace: "ks1"}, plan.Actions[0])
+	require.Equal(t, &DryRunAction{Type: DryRunLock, Description: "Unlock keyspace ks1", Keyspace: "ks1"}, plan.Actions[len(plan.Actions)-1])
+	require.Contains(t, plan.Actions, &DryRunAction{
+		Type:        DryRunQuery,
+		Description: "DROP TABLE t1",
+		Keyspace:    "ks2",
+		Shard:       "80-",
+		Tablet:      "cell1-0000000040",
+		Query:       "drop table vt_ks2.t1",
+	})
+	require.Contains(t, plan.Actions, &DryRunAction{
+		Type:        DryRunQuery,
+		Description: "Delete the streams",
+		Keyspace:    "ks2",
+		Shard:       "-80",
+		Tablet:      "cell1-0000000030",
+		Query:       "delete from _vt.vreplication where db_name='vt_ks2' and workflow='test'",
+	})
+	deleteRules := plan.Actions[len(plan.Actions)-3]
+	require.Equal(t, DryRunRoutingRules, deleteRules.Type)
+	require.Contains(t, deleteRules.DeletedRoutingRules, "ks2.t1@replica")
+}
+
 func TestReshardV2(t *testing.T) {
 	ctx := context.Background()
 	sourceShards := []string{"-40", "40-"}
@@ -443,7 +518,8 @@ func TestReshardV2Cancel(t *testing.T) {
 	require.Equal(t, WorkflowStateNotSwitched, wf.CurrentState())
 	tme.expectNoPreviousJournals()
 	expectReshardQueries(t, tme)
-	require.NoError(t, wf.Cancel())
+	_, err = wf.Cancel()
+	require.NoError(t, err)
 }
 
 func expectReshardQueries(t *testing.T, tme *testShardMigraterEnv) {